@@ -9,7 +9,7 @@ let ecs, sqs;
 
 /**
  * This lambda function calculates the backlog of SQS messages per running ECS tasks,
- * and writes the metric to CloudWatch.
+ * and writes the metric to CloudWatch along with the number of running tasks.
  */
 exports.handler = async (event, context) => {
   setupClients();
@@ -26,7 +26,7 @@ exports.handler = async (event, context) => {
     );
     const timestamp = Date.now();
     for (const {queueName, backlogPerTask} of backlogs) {
-      emitBacklogPerTaskMetric(process.env.NAMESPACE, timestamp, queueName, backlogPerTask);
+      emitBacklogPerTaskMetric(process.env.NAMESPACE, timestamp, queueName, backlogPerTask, runningCount);
     }
   } catch(err) {
     // If there is any issue we won't log a metric.
@@ -51,25 +51,29 @@ const getBacklogPerTask = async (queueUrl, runningTaskCount) => {
 }
 
 /**
- * Writes the backlogPerTask metric for the given queue to stdout following the CloudWatch embedded metric format.
+ * Writes the backlogPerTask and runningTaskCount metrics for the given queue to stdout following the CloudWatch embedded metric format.
+ * The running task count lets an alarm scale the service out from zero tasks, since the backlog per task alone can't tell
+ * whether any task is consuming the queue.
  * @see https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/CloudWatch_Embedded_Metric_Format_Generation.html
  * @param namespace The namespace for the metric.
  * @param timestamp The number of milliseconds after Jan 1, 1970 00:00:00 UTC used to emit the metric.
  * @param queueName The name of the queue.
  * @param backlogPerTask The number of messages in the queue divided by the number of running tasks.
+ * @param runningTaskCount The number of running tasks part of the ECS service.
  */
-const emitBacklogPerTaskMetric = (namespace, timestamp, queueName, backlogPerTask) => {
+const emitBacklogPerTaskMetric = (namespace, timestamp, queueName, backlogPerTask, runningTaskCount) => {
   console.log(JSON.stringify({
     "_aws": {
       "Timestamp": timestamp,
       "CloudWatchMetrics": [{
         "Namespace": namespace,
         "Dimensions": [["QueueName"]],
-        "Metrics": [{"Name":"BacklogPerTask", "Unit": "Count"}]
+        "Metrics": [{"Name":"BacklogPerTask", "Unit": "Count"}, {"Name":"RunningTaskCount", "Unit": "Count"}]
       }],
     },
     "QueueName": queueName,
     "BacklogPerTask": backlogPerTask,
+    "RunningTaskCount": runningTaskCount,
   }));
 }
 
@@ -0,0 +1,382 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+"use strict";
+
+const aws = require("aws-sdk");
+
+// Resource types served by this function.
+const serviceStateResourceType = "Custom::BlueGreenServiceStateFunction";
+const deploymentResourceType = "Custom::BlueGreenDeploymentFunction";
+
+const defaultSleep = function (ms) {
+  return new Promise((resolve) => setTimeout(resolve, ms));
+};
+let sleep = defaultSleep;
+
+/**
+ * Main handler, invoked by Lambda.
+ *
+ * The same function backs two custom resources of a service deployed with CodeDeploy blue/green deployments:
+ * - Custom::BlueGreenServiceStateFunction returns the task definition and target group that CloudFormation should
+ *   keep on the ECS service and the listener rule, since CodeDeploy owns them after the first deployment.
+ * - Custom::BlueGreenDeploymentFunction creates a CodeDeploy deployment if the service doesn't run the task definition yet,
+ *   and waits until the production traffic is shifted to the replacement tasks.
+ */
+exports.handler = async function (event, context) {
+  let physicalResourceId = event.PhysicalResourceId || event.LogicalResourceId;
+  let responseData = {};
+
+  const handler = async function () {
+    const props = event.ResourceProperties;
+    switch (event.RequestType) {
+      case "Create":
+      case "Update":
+        if (event.ResourceType === serviceStateResourceType) {
+          if (event.RequestType === "Create") {
+            // The service is created with the task definition, and the task definition is never updated by CloudFormation afterwards.
+            physicalResourceId = props.TaskDefinition;
+          }
+          responseData = {
+            TaskDefinition: physicalResourceId,
+            ActiveTargetGroup: (await activeTargetGroup(props.ApplicationName, props.DeploymentGroupName)) || props.TargetGroup,
+          };
+          break;
+        }
+        if (event.ResourceType === deploymentResourceType) {
+          await deploy(props);
+          break;
+        }
+        throw new Error(`Unsupported resource type ${event.ResourceType}`);
+      case "Delete":
+        // Do nothing on delete, since these aren't "real" resources.
+        break;
+      default:
+        throw new Error(`Unsupported request type ${event.RequestType}`);
+    }
+  };
+
+  try {
+    await Promise.race([exports.deadlineExpired(), handler()]);
+    await report(event, context, "SUCCESS", physicalResourceId, responseData);
+  } catch (err) {
+    console.error(`caught error: ${err}`);
+    await report(
+      event,
+      context,
+      "FAILED",
+      physicalResourceId,
+      null,
+      `${err.message} (Log: ${context.logGroupName}/${context.logStreamName})`
+    );
+  }
+};
+
+/**
+ * Returns the ECS service of a CodeDeploy deployment group, or undefined if the deployment group doesn't exist yet.
+ *
+ * @param {string} applicationName Name of the CodeDeploy application.
+ * @param {string} deploymentGroupName Name of the CodeDeploy deployment group.
+ * @returns {object} The ECS service.
+ */
+const describeDeploymentGroupService = async function (applicationName, deploymentGroupName) {
+  const codedeploy = new aws.CodeDeploy();
+  let group;
+  try {
+    const out = await codedeploy
+      .getDeploymentGroup({
+        applicationName: applicationName,
+        deploymentGroupName: deploymentGroupName,
+      })
+      .promise();
+    group = out.deploymentGroupInfo;
+  } catch (err) {
+    if (err.code === "ApplicationDoesNotExistException" || err.code === "DeploymentGroupDoesNotExistException") {
+      return undefined;
+    }
+    throw err;
+  }
+  if (!group.ecsServices || group.ecsServices.length === 0) {
+    return undefined;
+  }
+  return describeService(group.ecsServices[0].clusterName, group.ecsServices[0].serviceName);
+};
+
+/**
+ * Returns the description of an ECS service.
+ *
+ * @param {string} cluster Name of the cluster.
+ * @param {string} service Name of the service.
+ * @returns {object} The ECS service.
+ */
+const describeService = async function (cluster, service) {
+  const ecs = new aws.ECS();
+  const out = await ecs
+    .describeServices({
+      cluster: cluster,
+      services: [service],
+    })
+    .promise();
+  if (out.services.length !== 1) {
+    throw new Error(`Cannot find service ${service} in cluster ${cluster}`);
+  }
+  return out.services[0];
+};
+
+/**
+ * Returns the task set of the service that receives the production traffic.
+ *
+ * @param {object} service The ECS service.
+ * @returns {object} The primary task set, or undefined if the service has none.
+ */
+const primaryTaskSet = function (service) {
+  return (service.taskSets || []).find((taskSet) => taskSet.status === "PRIMARY");
+};
+
+/**
+ * Returns the target group that currently receives the production traffic of the deployment group's service.
+ *
+ * @param {string} applicationName Name of the CodeDeploy application.
+ * @param {string} deploymentGroupName Name of the CodeDeploy deployment group.
+ * @returns {string} ARN of the target group, or undefined if it can't be found.
+ */
+const activeTargetGroup = async function (applicationName, deploymentGroupName) {
+  const service = await describeDeploymentGroupService(applicationName, deploymentGroupName);
+  if (!service) {
+    return undefined;
+  }
+  const taskSet = primaryTaskSet(service);
+  if (!taskSet || !taskSet.loadBalancers || taskSet.loadBalancers.length === 0) {
+    return undefined;
+  }
+  return taskSet.loadBalancers[0].targetGroupArn;
+};
+
+/**
+ * Deploys the task definition to the service with CodeDeploy, if the service doesn't already run it.
+ *
+ * @param {object} props Properties of the custom resource.
+ */
+const deploy = async function (props) {
+  const service = await describeService(props.Cluster, props.Service);
+  const taskSet = primaryTaskSet(service);
+  const current = taskSet ? taskSet.taskDefinition : service.taskDefinition;
+  if (current === props.TaskDefinition) {
+    // The service already runs the task definition, for example when it was just created, or when the stack is rolled back
+    // after CodeDeploy rolled back the deployment.
+    return;
+  }
+
+  const codedeploy = new aws.CodeDeploy();
+  await completeTerminationWaits(codedeploy, props.ApplicationName, props.DeploymentGroupName);
+  const { deploymentId } = await codedeploy
+    .createDeployment({
+      applicationName: props.ApplicationName,
+      deploymentGroupName: props.DeploymentGroupName,
+      revision: {
+        revisionType: "AppSpecContent",
+        appSpecContent: {
+          content: JSON.stringify(appSpec(props)),
+        },
+      },
+    })
+    .promise();
+  console.log(`Started deployment ${deploymentId}`);
+  await waitForTrafficShifted(codedeploy, deploymentId);
+};
+
+/**
+ * Returns the AppSpec of a deployment of the task definition.
+ *
+ * @param {object} props Properties of the custom resource.
+ * @returns {object} The AppSpec.
+ */
+const appSpec = function (props) {
+  const spec = {
+    version: 0.0,
+    Resources: [
+      {
+        TargetService: {
+          Type: "AWS::ECS::Service",
+          Properties: {
+            TaskDefinition: props.TaskDefinition,
+            LoadBalancerInfo: {
+              ContainerName: props.ContainerName,
+              ContainerPort: parseInt(props.ContainerPort, 10),
+            },
+          },
+        },
+      },
+    ],
+  };
+  const hooks = props.Hooks || [];
+  if (hooks.length > 0) {
+    spec.Hooks = hooks.map((hook) => ({ [hook.Event]: hook.Function }));
+  }
+  return spec;
+};
+
+/**
+ * Ends the wait of in-progress deployments that shifted all their traffic and are waiting to terminate the original tasks,
+ * so that a new deployment can be created.
+ *
+ * @param {aws.CodeDeploy} codedeploy CodeDeploy client.
+ * @param {string} applicationName Name of the CodeDeploy application.
+ * @param {string} deploymentGroupName Name of the CodeDeploy deployment group.
+ */
+const completeTerminationWaits = async function (codedeploy, applicationName, deploymentGroupName) {
+  const { deployments } = await codedeploy
+    .listDeployments({
+      applicationName: applicationName,
+      deploymentGroupName: deploymentGroupName,
+      includeOnlyStatuses: ["Created", "Queued", "InProgress", "Ready"],
+    })
+    .promise();
+  for (const deploymentId of deployments || []) {
+    const { deploymentInfo } = await codedeploy.getDeployment({ deploymentId }).promise();
+    if (!deploymentInfo.instanceTerminationWaitTimeStarted) {
+      throw new Error(`Deployment ${deploymentId} of deployment group ${deploymentGroupName} is still in progress`);
+    }
+    console.log(`Terminating the original tasks of deployment ${deploymentId}`);
+    await codedeploy
+      .continueDeployment({
+        deploymentId: deploymentId,
+        deploymentWaitType: "TERMINATION_WAIT",
+      })
+      .promise();
+    await waitForDeployment(codedeploy, deploymentId, () => false);
+  }
+};
+
+/**
+ * Waits until the production traffic of the deployment is shifted to the replacement tasks.
+ *
+ * @param {aws.CodeDeploy} codedeploy CodeDeploy client.
+ * @param {string} deploymentId ID of the deployment.
+ */
+const waitForTrafficShifted = function (codedeploy, deploymentId) {
+  return waitForDeployment(codedeploy, deploymentId, (deploymentInfo) => deploymentInfo.instanceTerminationWaitTimeStarted);
+};
+
+/**
+ * Waits until the deployment succeeds, or until isDone returns true.
+ *
+ * @param {aws.CodeDeploy} codedeploy CodeDeploy client.
+ * @param {string} deploymentId ID of the deployment.
+ * @param {function} isDone Returns true if the deployment doesn't need to be waited on anymore.
+ */
+const waitForDeployment = async function (codedeploy, deploymentId, isDone) {
+  while (true) {
+    const { deploymentInfo } = await codedeploy.getDeployment({ deploymentId }).promise();
+    switch (deploymentInfo.status) {
+      case "Succeeded":
+        return;
+      case "Failed":
+      case "Stopped": {
+        const reason = deploymentInfo.errorInformation ? `: ${deploymentInfo.errorInformation.message}` : "";
+        throw new Error(`Deployment ${deploymentId} ${deploymentInfo.status.toLowerCase()}${reason}`);
+      }
+    }
+    if (isDone(deploymentInfo)) {
+      return;
+    }
+    await sleep(15000);
+  }
+};
+
+exports.deadlineExpired = function () {
+  return new Promise((resolve, reject) => {
+    setTimeout(
+      reject,
+      14 * 60 * 1000 /* 14 minutes */,
+      new Error("Lambda took longer than 14 minutes")
+    );
+  });
+};
+
+/**
+ * Upload a CloudFormation response object to S3.
+ *
+ * @param {object} event the Lambda event payload received by the handler function
+ * @param {object} context the Lambda context received by the handler function
+ * @param {string} responseStatus the response status, either 'SUCCESS' or 'FAILED'
+ * @param {string} physicalResourceId CloudFormation physical resource ID
+ * @param {object} [responseData] arbitrary response data object
+ * @param {string} [reason] reason for failure, if any, to convey to the user
+ * @returns {Promise} Promise that is resolved on success, or rejected on connection error or HTTP error response
+ */
+const report = function (
+  event,
+  context,
+  responseStatus,
+  physicalResourceId,
+  responseData,
+  reason
+) {
+  return new Promise((resolve, reject) => {
+    const https = require("https");
+    const { URL } = require("url");
+
+    let responseBody = JSON.stringify({
+      Status: responseStatus,
+      Reason: reason,
+      PhysicalResourceId: physicalResourceId,
+      StackId: event.StackId,
+      RequestId: event.RequestId,
+      LogicalResourceId: event.LogicalResourceId,
+      Data: responseData,
+    });
+
+    const parsedUrl = new URL(event.ResponseURL);
+    const options = {
+      hostname: parsedUrl.hostname,
+      port: 443,
+      path: parsedUrl.pathname + parsedUrl.search,
+      method: "PUT",
+      headers: {
+        "Content-Type": "",
+        "Content-Length": responseBody.length,
+      },
+    };
+
+    https
+      .request(options)
+      .on("error", reject)
+      .on("response", (res) => {
+        res.resume();
+        if (res.statusCode >= 400) {
+          reject(new Error(`Error ${res.statusCode}: ${res.statusMessage}`));
+        } else {
+          resolve();
+        }
+      })
+      .end(responseBody, "utf8");
+  });
+};
+
+/**
+ * @private
+ * withDeadlineExpired overrides the default deadlineExpired function.
+ * Used for testing.
+ */
+exports.withDeadlineExpired = function (d) {
+  exports.deadlineExpired = d;
+};
+
+/**
+ * @private
+ * withSleep overrides the default sleep function.
+ * Used for testing.
+ */
+exports.withSleep = function (s) {
+  sleep = s;
+};
+
+/**
+ * @private
+ * reset restores the default sleep function.
+ * Used for testing.
+ */
+exports.reset = function () {
+  sleep = defaultSleep;
+};
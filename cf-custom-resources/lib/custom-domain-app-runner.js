@@ -14,12 +14,27 @@ const DOMAIN_STATUS_DELETE_FAILED = "delete_failed";
 const ATTEMPTS_WAIT_FOR_PENDING = 10;
 // Expectedly lambda time out would be triggered before 20-th attempt. This ensures that we attempts to wait for it to be disassociated as much as possible.
 const ATTEMPTS_WAIT_FOR_DISASSOCIATED = 20;
+// Hosted zone IDs of the App Runner service endpoints, used as the target of alias records for apex domains.
+// See https://docs.aws.amazon.com/general/latest/gr/apprunner.html
+const APP_RUNNER_HOSTED_ZONE_IDS = {
+    "us-east-1": "Z01915732ZBZKC8D32TPT",
+    "us-east-2": "Z0224347AD7KVHMLOX31",
+    "us-west-2": "Z02243383FTQ64HJ5772Q",
+    "ap-south-1": "Z00855883LBHKTIC4ODF2",
+    "ap-southeast-1": "Z09819469CZ3KQ8PWMCL",
+    "ap-southeast-2": "Z03657752RA8799S0TI5I",
+    "ap-northeast-1": "Z08491812XW6IPYLR6CCA",
+    "eu-central-1": "Z0334911C2FDI2Q9M4FZ",
+    "eu-west-1": "Z087551914Z2PCAU0QHMW",
+    "eu-west-2": "Z098228427VC6B3IVMNN",
+    "eu-west-3": "Z087117439MBKHYM69QS",
+};
 
 let defaultSleep = function (ms) {
     return new Promise((resolve) => setTimeout(resolve, ms));
 };
 let sleep = defaultSleep;
-let appRoute53Client, appRunnerClient, appHostedZoneID;
+let appRoute53Client, appRunnerClient, appHostedZoneID, appDomainName;
 
 /**
  * Upload a CloudFormation response object to S3.
@@ -96,6 +111,7 @@ exports.handler = async function (event, context) {
         });
         appRunnerClient = new AWS.AppRunner();
         appHostedZoneID = await domainHostedZoneID(appDNSName);
+        appDomainName = appDNSName;
         switch (event.RequestType) {
             case "Create":
             case "Update":
@@ -173,7 +189,7 @@ async function addCustomDomain(serviceARN, customDomainName) {
     }
 
     return Promise.all([
-        updateCNAMERecordAndWait(customDomainName, data.DNSTarget, appHostedZoneID, "UPSERT"), // Upsert the record that maps `customDomainName` to the DNS of the app runner service.
+        updateDomainRecordAndWait(customDomainName, data.DNSTarget, "UPSERT"), // Upsert the record that maps `customDomainName` to the DNS of the app runner service.
         validateCertForDomain(serviceARN, customDomainName),
     ]);
 }
@@ -290,7 +306,7 @@ async function removeCustomDomain(serviceARN, customDomainName) {
     }
 
     return Promise.all([
-        updateCNAMERecordAndWait(customDomainName, data.DNSTarget, appHostedZoneID, "DELETE"), // Delete the record that maps `customDomainName` to the DNS of the app runner service.
+        updateDomainRecordAndWait(customDomainName, data.DNSTarget, "DELETE"), // Delete the record that maps `customDomainName` to the DNS of the app runner service.
         removeValidationRecords(data.CustomDomain),
     ]);
 }
@@ -347,6 +363,46 @@ async function waitForCustomDomainToBeDisassociated(serviceARN, customDomainName
     throw new Error(`fail to wait for domain ${customDomainName} to be disassociated`);
 }
 
+/**
+ * Updates the record that maps the custom domain to the DNS of the app runner service.
+ * An apex domain can't have a CNAME record, so it's mapped with an alias record instead.
+ *
+ * @param {string} customDomainName the custom domain name.
+ * @param {string} dnsTarget the DNS of the app runner service.
+ * @param {string} action the action to perform; can be "CREATE", "DELETE", or "UPSERT".
+ * @throws wrapped error.
+ */
+async function updateDomainRecordAndWait(customDomainName, dnsTarget, action) {
+    if (!isApexDomain(customDomainName)) {
+        return updateCNAMERecordAndWait(customDomainName, dnsTarget, appHostedZoneID, action);
+    }
+    const region = process.env.AWS_REGION;
+    const targetHostedZoneID = APP_RUNNER_HOSTED_ZONE_IDS[region];
+    if (!targetHostedZoneID) {
+        throw new Error(`update record ${customDomainName}: apex domains are not supported for App Runner services in region ${region}`);
+    }
+    return changeRecordSetAndWait({
+        Name: customDomainName,
+        Type: "A",
+        AliasTarget: {
+            HostedZoneId: targetHostedZoneID,
+            DNSName: dnsTarget,
+            EvaluateTargetHealth: false,
+        },
+    }, appHostedZoneID, action);
+}
+
+/**
+ * Returns true if the domain is the apex of the app's hosted zone.
+ *
+ * @param {string} domainName
+ * @returns {boolean}
+ */
+function isApexDomain(domainName) {
+    const normalize = (name) => (name || "").toLowerCase().replace(/\.$/, "");
+    return normalize(domainName) === normalize(appDomainName);
+}
+
 /**
  * Upserts a CNAME record and wait for the change to have taken place.
  *
@@ -357,21 +413,34 @@ async function waitForCustomDomainToBeDisassociated(serviceARN, customDomainName
  * @throws wrapped error.
  */
 async function updateCNAMERecordAndWait(recordName, recordValue, hostedZoneID, action) {
+    return changeRecordSetAndWait({
+        Name: recordName,
+        Type: "CNAME",
+        TTL: 60,
+        ResourceRecords: [
+            {
+                Value: recordValue,
+            },
+        ],
+    }, hostedZoneID, action);
+}
+
+/**
+ * Changes a record set and wait for the change to have taken place.
+ *
+ * @param {object} recordSet the record set to change.
+ * @param {string} hostedZoneID the ID of the hosted zone of the record set.
+ * @param {string} action the action to perform; can be "CREATE", "DELETE", or "UPSERT".
+ * @throws wrapped error.
+ */
+async function changeRecordSetAndWait(recordSet, hostedZoneID, action) {
+    const recordName = recordSet.Name;
     let params = {
         ChangeBatch: {
             Changes: [
                 {
                     Action: action,
-                    ResourceRecordSet: {
-                        Name: recordName,
-                        Type: "CNAME",
-                        TTL: 60,
-                        ResourceRecords: [
-                            {
-                                Value: recordValue,
-                            },
-                        ],
-                    },
+                    ResourceRecordSet: recordSet,
                 },
             ],
         },
@@ -382,7 +451,7 @@ async function updateCNAMERecordAndWait(recordName, recordValue, hostedZoneID, a
     try {
         data = await appRoute53Client.changeResourceRecordSets(params).promise();
     } catch (err) {
-        let recordSetNotFoundErrMessageRegex = /Tried to delete resource record set \[name='.*', type='CNAME'] but it was not found/;
+        let recordSetNotFoundErrMessageRegex = /Tried to delete resource record set \[name='.*', type='(CNAME|A)'] but it was not found/;
         if (action === "DELETE" && err.message.search(recordSetNotFoundErrMessageRegex) !== -1) {
             return; // If we attempt to `DELETE` a record that doesn't exist, the job is already done, skip waiting.
         }
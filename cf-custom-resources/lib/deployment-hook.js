@@ -0,0 +1,264 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+"use strict";
+
+const aws = require("aws-sdk");
+
+const defaultSleep = function (ms) {
+  return new Promise((resolve) => setTimeout(resolve, ms));
+};
+let sleep = defaultSleep;
+
+/**
+ * Main handler, invoked by Lambda.
+ *
+ * Runs a one-off task with the task definition of a service and a command for its main container,
+ * and waits until the task stops. The hook fails if the main container exits with a non-zero exit code.
+ * The hook is skipped while the stack rolls back, so that the hook of the previous deployment doesn't run again.
+ */
+exports.handler = async function (event, context) {
+  const physicalResourceId = event.PhysicalResourceId || event.LogicalResourceId;
+  const props = event.ResourceProperties;
+  let task;
+
+  const handler = async function () {
+    switch (event.RequestType) {
+      case "Create":
+      case "Update":
+        if (await isRollingBack(event.StackId)) {
+          console.log("Skip the hook since the stack is rolling back.");
+          break;
+        }
+        task = await runTask(props);
+        await waitUntilSucceeded(props.Cluster, task, props.ContainerName);
+        task = undefined;
+        break;
+      case "Delete":
+        // Do nothing on delete, since this isn't a "real" resource.
+        break;
+      default:
+        throw new Error(`Unsupported request type ${event.RequestType}`);
+    }
+  };
+
+  try {
+    await Promise.race([exports.deadlineExpired(), handler()]);
+    await report(event, context, "SUCCESS", physicalResourceId);
+  } catch (err) {
+    console.error(`caught error: ${err}`);
+    if (task) {
+      await stopTask(props.Cluster, task, err.message);
+    }
+    await report(
+      event,
+      context,
+      "FAILED",
+      physicalResourceId,
+      null,
+      `${err.message} (Log: ${context.logGroupName}/${context.logStreamName})`
+    );
+  }
+};
+
+/**
+ * Returns true if the stack is rolling back.
+ *
+ * @param {string} stackId ID of the stack.
+ * @returns {boolean} Whether the stack is rolling back.
+ */
+const isRollingBack = async function (stackId) {
+  const cfn = new aws.CloudFormation();
+  const { Stacks } = await cfn.describeStacks({ StackName: stackId }).promise();
+  if (!Stacks || Stacks.length === 0) {
+    return false;
+  }
+  return Stacks[0].StackStatus.includes("ROLLBACK");
+};
+
+/**
+ * Runs the task of the hook.
+ *
+ * @param {object} props Properties of the custom resource.
+ * @returns {string} ARN of the task.
+ */
+const runTask = async function (props) {
+  const ecs = new aws.ECS();
+  const vpc = props.NetworkConfiguration.AwsvpcConfiguration;
+  // Tasks of workloads on EC2 are placed on the instances of the capacity provider of the environment.
+  const placement = props.CapacityProvider
+    ? { capacityProviderStrategy: [{ capacityProvider: props.CapacityProvider, weight: 1 }] }
+    : { launchType: "FARGATE", platformVersion: props.PlatformVersion };
+  const out = await ecs
+    .runTask({
+      cluster: props.Cluster,
+      taskDefinition: props.TaskDefinition,
+      ...placement,
+      networkConfiguration: {
+        awsvpcConfiguration: {
+          assignPublicIp: vpc.AssignPublicIp,
+          subnets: vpc.Subnets,
+          securityGroups: vpc.SecurityGroups,
+        },
+      },
+      overrides: {
+        containerOverrides: [
+          {
+            name: props.ContainerName,
+            command: props.Command,
+          },
+        ],
+      },
+      startedBy: "copilot-deployment-hook",
+    })
+    .promise();
+  if (out.failures && out.failures.length > 0) {
+    throw new Error(`Failed to run task: ${out.failures[0].reason}`);
+  }
+  const taskArn = out.tasks[0].taskArn;
+  console.log(`Started task ${taskArn}.`);
+  return taskArn;
+};
+
+/**
+ * Waits until the task stops, and throws an error if the container didn't exit successfully.
+ *
+ * @param {string} cluster Name of the cluster.
+ * @param {string} taskArn ARN of the task.
+ * @param {string} containerName Name of the container that runs the command of the hook.
+ */
+const waitUntilSucceeded = async function (cluster, taskArn, containerName) {
+  const ecs = new aws.ECS();
+  while (true) {
+    const { tasks } = await ecs.describeTasks({ cluster, tasks: [taskArn] }).promise();
+    if (!tasks || tasks.length === 0) {
+      throw new Error(`Task ${taskArn} not found`);
+    }
+    const task = tasks[0];
+    if (task.lastStatus === "STOPPED") {
+      const container = (task.containers || []).find((c) => c.name === containerName);
+      if (!container || container.exitCode === undefined || container.exitCode === null) {
+        throw new Error(`Task ${taskArn} stopped: ${task.stoppedReason}`);
+      }
+      if (container.exitCode !== 0) {
+        throw new Error(`Container ${containerName} in task ${taskArn} exited with status code ${container.exitCode}`);
+      }
+      console.log(`Task ${taskArn} succeeded.`);
+      return;
+    }
+    await sleep(10000);
+  }
+};
+
+/**
+ * Stops the task of the hook, for example if the hook took too long.
+ *
+ * @param {string} cluster Name of the cluster.
+ * @param {string} taskArn ARN of the task.
+ * @param {string} reason Reason to stop the task.
+ */
+const stopTask = async function (cluster, taskArn, reason) {
+  const ecs = new aws.ECS();
+  try {
+    await ecs.stopTask({ cluster, task: taskArn, reason: reason.substring(0, 255) }).promise();
+  } catch (err) {
+    console.error(`stop task ${taskArn}: ${err}`);
+  }
+};
+
+exports.deadlineExpired = function () {
+  return new Promise((resolve, reject) => {
+    setTimeout(
+      reject,
+      14 * 60 * 1000 /* 14 minutes */,
+      new Error("Lambda took longer than 14 minutes")
+    );
+  });
+};
+
+/**
+ * Upload a CloudFormation response object to S3.
+ *
+ * @param {object} event the Lambda event payload received by the handler function
+ * @param {object} context the Lambda context received by the handler function
+ * @param {string} responseStatus the response status, either 'SUCCESS' or 'FAILED'
+ * @param {string} physicalResourceId CloudFormation physical resource ID
+ * @param {object} [responseData] arbitrary response data object
+ * @param {string} [reason] reason for failure, if any, to convey to the user
+ * @returns {Promise} Promise that is resolved on success, or rejected on connection error or HTTP error response
+ */
+const report = function (
+  event,
+  context,
+  responseStatus,
+  physicalResourceId,
+  responseData,
+  reason
+) {
+  return new Promise((resolve, reject) => {
+    const https = require("https");
+    const { URL } = require("url");
+
+    let responseBody = JSON.stringify({
+      Status: responseStatus,
+      Reason: reason,
+      PhysicalResourceId: physicalResourceId,
+      StackId: event.StackId,
+      RequestId: event.RequestId,
+      LogicalResourceId: event.LogicalResourceId,
+      Data: responseData,
+    });
+
+    const parsedUrl = new URL(event.ResponseURL);
+    const options = {
+      hostname: parsedUrl.hostname,
+      port: 443,
+      path: parsedUrl.pathname + parsedUrl.search,
+      method: "PUT",
+      headers: {
+        "Content-Type": "",
+        "Content-Length": responseBody.length,
+      },
+    };
+
+    https
+      .request(options)
+      .on("error", reject)
+      .on("response", (res) => {
+        res.resume();
+        if (res.statusCode >= 400) {
+          reject(new Error(`Error ${res.statusCode}: ${res.statusMessage}`));
+        } else {
+          resolve();
+        }
+      })
+      .end(responseBody, "utf8");
+  });
+};
+
+/**
+ * @private
+ * withDeadlineExpired overrides the default deadlineExpired function.
+ * Used for testing.
+ */
+exports.withDeadlineExpired = function (d) {
+  exports.deadlineExpired = d;
+};
+
+/**
+ * @private
+ * withSleep overrides the default sleep function.
+ * Used for testing.
+ */
+exports.withSleep = function (s) {
+  sleep = s;
+};
+
+/**
+ * @private
+ * reset restores the default sleep function.
+ * Used for testing.
+ */
+exports.reset = function () {
+  sleep = defaultSleep;
+};
@@ -90,11 +90,12 @@ describe("BacklogPerTask metric calculator", () => {
           "CloudWatchMetrics": [{
             "Namespace": "app-env-service",
             "Dimensions": [["QueueName"]],
-            "Metrics": [{"Name":"BacklogPerTask", "Unit": "Count"}]
+            "Metrics": [{"Name":"BacklogPerTask", "Unit": "Count"}, {"Name":"RunningTaskCount", "Unit": "Count"}]
           }],
         },
         "QueueName": "queue1",
         "BacklogPerTask": 100,
+        "RunningTaskCount": 0,
       }));
       sinon.assert.notCalled(console.error);
     });
@@ -148,11 +149,12 @@ describe("BacklogPerTask metric calculator", () => {
           "CloudWatchMetrics": [{
             "Namespace": "app-env-service",
             "Dimensions": [["QueueName"]],
-            "Metrics": [{"Name":"BacklogPerTask", "Unit": "Count"}]
+            "Metrics": [{"Name":"BacklogPerTask", "Unit": "Count"}, {"Name":"RunningTaskCount", "Unit": "Count"}]
           }],
         },
         "QueueName": "queue1",
         "BacklogPerTask": 34,
+        "RunningTaskCount": 3,
       }));
       sinon.assert.calledWith(console.log.secondCall, JSON.stringify({
         "_aws": {
@@ -160,11 +162,12 @@ describe("BacklogPerTask metric calculator", () => {
           "CloudWatchMetrics": [{
             "Namespace": "app-env-service",
             "Dimensions": [["QueueName"]],
-            "Metrics": [{"Name":"BacklogPerTask", "Unit": "Count"}]
+            "Metrics": [{"Name":"BacklogPerTask", "Unit": "Count"}, {"Name":"RunningTaskCount", "Unit": "Count"}]
           }],
         },
         "QueueName": "queue2",
         "BacklogPerTask": 165,
+        "RunningTaskCount": 3,
       }));
       sinon.assert.notCalled(console.error);
     });
@@ -0,0 +1,340 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+"use strict";
+
+describe("blue/green deployment", () => {
+  const aws = require("aws-sdk-mock");
+  const lambdaTester = require("lambda-tester").noVersionCheck();
+  const nock = require("nock");
+  const sinon = require("sinon");
+  const handler = require("../lib/blue-green-deployment");
+
+  const responseURL = "https://cloudwatch-response-mock.example.com/";
+  const logGroup = "/aws/lambda/testLambda";
+  const logStream = "2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd";
+  const testRequestId = "f4ef1b10-c39a-44e3-99c0-fbf7e53c3943";
+
+  const stateResourceType = "Custom::BlueGreenServiceStateFunction";
+  const deploymentResourceType = "Custom::BlueGreenDeploymentFunction";
+  const oldTaskDef = "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-frontend:1";
+  const newTaskDef = "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-frontend:2";
+  const blueTargetGroup = "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/blue/1";
+  const greenTargetGroup = "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/green/2";
+  const deploymentProps = {
+    ApplicationName: "phonetool-test-frontend",
+    DeploymentGroupName: "phonetool-test-frontend",
+    Cluster: "phonetool-test-Cluster",
+    Service: "phonetool-test-frontend-Service",
+    TaskDefinition: newTaskDef,
+    ContainerName: "frontend",
+    ContainerPort: "80",
+  };
+
+  const origConsole = console;
+
+  beforeEach(() => {
+    handler.withSleep(() => Promise.resolve());
+    handler.withDeadlineExpired(() => {
+      return new Promise((resolve, reject) => {});
+    });
+    console.log = () => {};
+  });
+  afterEach(() => {
+    handler.reset();
+    aws.restore();
+  });
+  afterAll(() => {
+    console = origConsole;
+  });
+
+  const invoke = (event) =>
+    lambdaTester(handler.handler)
+      .context({
+        logGroupName: logGroup,
+        logStreamName: logStream,
+      })
+      .event({
+        ResponseURL: responseURL,
+        RequestId: testRequestId,
+        LogicalResourceId: "mockID",
+        ...event,
+      });
+
+  test("bogus operation fails", () => {
+    console.error = () => {};
+    const request = nock(responseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason ===
+            "Unsupported request type bogus (Log: /aws/lambda/testLambda/2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd)"
+        );
+      })
+      .reply(200);
+    return invoke({
+      RequestType: "bogus",
+      ResourceType: deploymentResourceType,
+      ResourceProperties: {},
+    }).expectResolve(() => {
+      expect(request.isDone()).toBe(true);
+    });
+  });
+
+  test("delete event is a no-op", () => {
+    const request = nock(responseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS" && body.PhysicalResourceId === "randomID";
+      })
+      .reply(200);
+    return invoke({
+      RequestType: "Delete",
+      ResourceType: deploymentResourceType,
+      ResourceProperties: {},
+      PhysicalResourceId: "randomID",
+    }).expectResolve(() => {
+      expect(request.isDone()).toBe(true);
+    });
+  });
+
+  test("service state on create returns the initial task definition and target group", () => {
+    const request = nock(responseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.PhysicalResourceId === oldTaskDef &&
+          body.Data.TaskDefinition === oldTaskDef &&
+          body.Data.ActiveTargetGroup === blueTargetGroup
+        );
+      })
+      .reply(200);
+    const getDeploymentGroup = sinon.fake.rejects({ code: "DeploymentGroupDoesNotExistException" });
+    aws.mock("CodeDeploy", "getDeploymentGroup", getDeploymentGroup);
+
+    return invoke({
+      RequestType: "Create",
+      ResourceType: stateResourceType,
+      ResourceProperties: {
+        ApplicationName: "phonetool-test-frontend",
+        DeploymentGroupName: "phonetool-test-frontend",
+        TaskDefinition: oldTaskDef,
+        TargetGroup: blueTargetGroup,
+      },
+    }).expectResolve(() => {
+      expect(request.isDone()).toBe(true);
+    });
+  });
+
+  test("service state on update keeps the initial task definition and returns the target group receiving traffic", () => {
+    const request = nock(responseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.PhysicalResourceId === oldTaskDef &&
+          body.Data.TaskDefinition === oldTaskDef &&
+          body.Data.ActiveTargetGroup === greenTargetGroup
+        );
+      })
+      .reply(200);
+    aws.mock(
+      "CodeDeploy",
+      "getDeploymentGroup",
+      sinon.fake.resolves({
+        deploymentGroupInfo: {
+          ecsServices: [{ clusterName: "phonetool-test-Cluster", serviceName: "phonetool-test-frontend-Service" }],
+        },
+      })
+    );
+    const describeServices = sinon.fake.resolves({
+      services: [
+        {
+          taskSets: [
+            { status: "ACTIVE", loadBalancers: [{ targetGroupArn: blueTargetGroup }] },
+            { status: "PRIMARY", loadBalancers: [{ targetGroupArn: greenTargetGroup }] },
+          ],
+        },
+      ],
+    });
+    aws.mock("ECS", "describeServices", describeServices);
+
+    return invoke({
+      RequestType: "Update",
+      ResourceType: stateResourceType,
+      PhysicalResourceId: oldTaskDef,
+      ResourceProperties: {
+        ApplicationName: "phonetool-test-frontend",
+        DeploymentGroupName: "phonetool-test-frontend",
+        TaskDefinition: newTaskDef,
+        TargetGroup: blueTargetGroup,
+      },
+    }).expectResolve(() => {
+      expect(request.isDone()).toBe(true);
+      sinon.assert.calledWith(describeServices, {
+        cluster: "phonetool-test-Cluster",
+        services: ["phonetool-test-frontend-Service"],
+      });
+    });
+  });
+
+  test("deployment is skipped if the service already runs the task definition", () => {
+    const request = nock(responseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+    aws.mock(
+      "ECS",
+      "describeServices",
+      sinon.fake.resolves({
+        services: [{ taskSets: [{ status: "PRIMARY", taskDefinition: newTaskDef }] }],
+      })
+    );
+    const createDeployment = sinon.fake.resolves({});
+    aws.mock("CodeDeploy", "createDeployment", createDeployment);
+
+    return invoke({
+      RequestType: "Create",
+      ResourceType: deploymentResourceType,
+      ResourceProperties: deploymentProps,
+    }).expectResolve(() => {
+      expect(request.isDone()).toBe(true);
+      sinon.assert.notCalled(createDeployment);
+    });
+  });
+
+  test("deployment waits until the traffic is shifted", () => {
+    const request = nock(responseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS" && body.PhysicalResourceId === "mockID";
+      })
+      .reply(200);
+    aws.mock(
+      "ECS",
+      "describeServices",
+      sinon.fake.resolves({
+        services: [{ taskSets: [{ status: "PRIMARY", taskDefinition: oldTaskDef }] }],
+      })
+    );
+    aws.mock("CodeDeploy", "listDeployments", sinon.fake.resolves({ deployments: [] }));
+    const createDeployment = sinon.fake.resolves({ deploymentId: "d-1" });
+    aws.mock("CodeDeploy", "createDeployment", createDeployment);
+    const getDeployment = sinon.stub();
+    getDeployment.onFirstCall().resolves({ deploymentInfo: { status: "InProgress" } });
+    getDeployment.onSecondCall().resolves({
+      deploymentInfo: { status: "InProgress", instanceTerminationWaitTimeStarted: true },
+    });
+    aws.mock("CodeDeploy", "getDeployment", getDeployment);
+
+    return invoke({
+      RequestType: "Update",
+      ResourceType: deploymentResourceType,
+      ResourceProperties: {
+        ...deploymentProps,
+        Hooks: [{ Event: "BeforeAllowTraffic", Function: "validate" }],
+      },
+    }).expectResolve(() => {
+      expect(request.isDone()).toBe(true);
+      sinon.assert.calledOnce(createDeployment);
+      const input = createDeployment.getCall(0).args[0];
+      expect(input.applicationName).toBe("phonetool-test-frontend");
+      expect(input.deploymentGroupName).toBe("phonetool-test-frontend");
+      expect(JSON.parse(input.revision.appSpecContent.content)).toEqual({
+        version: 0,
+        Resources: [
+          {
+            TargetService: {
+              Type: "AWS::ECS::Service",
+              Properties: {
+                TaskDefinition: newTaskDef,
+                LoadBalancerInfo: {
+                  ContainerName: "frontend",
+                  ContainerPort: 80,
+                },
+              },
+            },
+          },
+        ],
+        Hooks: [{ BeforeAllowTraffic: "validate" }],
+      });
+      sinon.assert.calledTwice(getDeployment);
+    });
+  });
+
+  test("deployment ends the termination wait of the previous deployment", () => {
+    const request = nock(responseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+    aws.mock(
+      "ECS",
+      "describeServices",
+      sinon.fake.resolves({
+        services: [{ taskSets: [{ status: "PRIMARY", taskDefinition: oldTaskDef }] }],
+      })
+    );
+    aws.mock("CodeDeploy", "listDeployments", sinon.fake.resolves({ deployments: ["d-0"] }));
+    const continueDeployment = sinon.fake.resolves({});
+    aws.mock("CodeDeploy", "continueDeployment", continueDeployment);
+    aws.mock("CodeDeploy", "createDeployment", sinon.fake.resolves({ deploymentId: "d-1" }));
+    const getDeployment = sinon.stub();
+    getDeployment.onCall(0).resolves({
+      deploymentInfo: { status: "InProgress", instanceTerminationWaitTimeStarted: true },
+    });
+    getDeployment.onCall(1).resolves({ deploymentInfo: { status: "Succeeded" } });
+    getDeployment.onCall(2).resolves({ deploymentInfo: { status: "Succeeded" } });
+    aws.mock("CodeDeploy", "getDeployment", getDeployment);
+
+    return invoke({
+      RequestType: "Update",
+      ResourceType: deploymentResourceType,
+      ResourceProperties: deploymentProps,
+    }).expectResolve(() => {
+      expect(request.isDone()).toBe(true);
+      sinon.assert.calledWith(continueDeployment, {
+        deploymentId: "d-0",
+        deploymentWaitType: "TERMINATION_WAIT",
+      });
+    });
+  });
+
+  test("deployment fails if CodeDeploy fails the deployment", () => {
+    console.error = () => {};
+    const request = nock(responseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason ===
+            "Deployment d-1 failed: The ECS service cannot be updated (Log: /aws/lambda/testLambda/2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd)"
+        );
+      })
+      .reply(200);
+    aws.mock(
+      "ECS",
+      "describeServices",
+      sinon.fake.resolves({
+        services: [{ taskSets: [{ status: "PRIMARY", taskDefinition: oldTaskDef }] }],
+      })
+    );
+    aws.mock("CodeDeploy", "listDeployments", sinon.fake.resolves({ deployments: [] }));
+    aws.mock("CodeDeploy", "createDeployment", sinon.fake.resolves({ deploymentId: "d-1" }));
+    aws.mock(
+      "CodeDeploy",
+      "getDeployment",
+      sinon.fake.resolves({
+        deploymentInfo: {
+          status: "Failed",
+          errorInformation: { message: "The ECS service cannot be updated" },
+        },
+      })
+    );
+
+    return invoke({
+      RequestType: "Update",
+      ResourceType: deploymentResourceType,
+      ResourceProperties: deploymentProps,
+    }).expectResolve(() => {
+      expect(request.isDone()).toBe(true);
+    });
+  });
+});
@@ -32,6 +32,7 @@ describe("Custom Domain for App Runner Service", () => {
         console.log = origLog;
         AWS.restore();
         reset();
+        delete process.env.AWS_REGION;
     });
 
     describe("During CREATE", () => {
@@ -714,6 +715,140 @@ describe("Custom Domain for App Runner Service", () => {
                 });
         });
 
+        test("success with an apex domain", () => {
+            const mockListHostedZonesByName = sinon.fake.resolves({
+                HostedZones: [
+                    {
+                        Id: "/hostedzone/mockHostedZoneID",
+                    },
+                ],
+            }); // Able to retrieve the hosted zone ID.
+            const mockAssociateCustomDomain = sinon.fake.resolves({DNSTarget: mockTarget,});
+            const mockWaitFor = sinon.fake.resolves();
+            const mockDescribeCustomDomains = sinon.stub().resolves({
+                CustomDomains: [
+                    {
+                        DomainName: mockAppDNSName,
+                        CertificateValidationRecords: [
+                            {
+                                Name: "mock-record-name-1",
+                                Value: "mock-record-value-1",
+                            },
+                        ],
+                        Status: domainStatusPendingVerification,
+                    },
+                ],
+            });
+            const mockChangeResourceRecordSets = sinon.stub().resolves({ChangeInfo: {Id: "mockID",},});
+
+            AWS.mock("AppRunner", "associateCustomDomain", mockAssociateCustomDomain);
+            AWS.mock("Route53", "changeResourceRecordSets", mockChangeResourceRecordSets);
+            AWS.mock("Route53", "waitFor", mockWaitFor);
+            AWS.mock("AppRunner", "describeCustomDomains", mockDescribeCustomDomains);
+            AWS.mock("Route53", "listHostedZonesByName", mockListHostedZonesByName);
+            process.env.AWS_REGION = "us-west-2";
+
+            const expectedResponse = nock(mockResponseURL)
+                .put("/", (body) => {
+                    return body.Status === "SUCCESS" &&
+                        body.PhysicalResourceId === "/associate-domain-app-runner/mockAppDNSName";
+                })
+                .reply(200);
+            return LambdaTester(handler)
+                .event({
+                    RequestType: "Create",
+                    ResponseURL: mockResponseURL,
+                    ResourceProperties: {
+                        ServiceARN: mockServiceARN,
+                        AppDNSRole: "",
+                        CustomDomain: mockAppDNSName,
+                        AppDNSName: mockAppDNSName,
+                    },
+                    PhysicalResourceId: mockPhysicalResourceID,
+                    LogicalResourceId: mockLogicalResourceID,
+                })
+                .expectResolve(() => {
+                    expect(expectedResponse.isDone()).toBe(true);
+                    // The apex domain is mapped with an alias record, while the validation records are still CNAME records.
+                    sinon.assert.calledWith(mockChangeResourceRecordSets, sinon.match({
+                        ChangeBatch: {
+                            Changes: [{
+                                Action: "UPSERT",
+                                ResourceRecordSet: {
+                                    Name: mockAppDNSName,
+                                    Type: "A",
+                                    AliasTarget: {
+                                        HostedZoneId: "Z02243383FTQ64HJ5772Q",
+                                        DNSName: mockTarget,
+                                        EvaluateTargetHealth: false,
+                                    },
+                                },
+                            }],
+                        },
+                        HostedZoneId: mockHostedZoneID,
+                    }));
+                    sinon.assert.calledWith(mockChangeResourceRecordSets, sinon.match({
+                        ChangeBatch: {
+                            Changes: [{
+                                Action: "UPSERT",
+                                ResourceRecordSet: {
+                                    Name: "mock-record-name-1",
+                                    Type: "CNAME",
+                                },
+                            }],
+                        },
+                    }));
+                });
+        });
+
+        test("fail to add an apex domain in a region without App Runner", () => {
+            const mockListHostedZonesByName = sinon.fake.resolves({
+                HostedZones: [
+                    {
+                        Id: "/hostedzone/mockHostedZoneID",
+                    },
+                ],
+            }); // Able to retrieve the hosted zone ID.
+            const mockAssociateCustomDomain = sinon.fake.resolves({DNSTarget: mockTarget,});
+            const mockDescribeCustomDomains = sinon.stub().resolves({
+                CustomDomains: [
+                    {
+                        DomainName: mockAppDNSName,
+                        CertificateValidationRecords: [],
+                        Status: domainStatusPendingVerification,
+                    },
+                ],
+            });
+            AWS.mock("AppRunner", "associateCustomDomain", mockAssociateCustomDomain);
+            AWS.mock("AppRunner", "describeCustomDomains", mockDescribeCustomDomains);
+            AWS.mock("Route53", "listHostedZonesByName", mockListHostedZonesByName);
+            process.env.AWS_REGION = "mock-region-1";
+
+            const expectedResponse = nock(mockResponseURL)
+                .put("/", (body) => {
+                    let expectedErrMessageRegex = /^update record mockAppDNSName: apex domains are not supported for App Runner services in region mock-region-1 \(Log: .*\)$/;
+                    return body.Status === "FAILED" &&
+                        body.Reason.search(expectedErrMessageRegex) !== -1;
+                })
+                .reply(200);
+            return LambdaTester(handler)
+                .event({
+                    RequestType: "Create",
+                    ResponseURL: mockResponseURL,
+                    ResourceProperties: {
+                        ServiceARN: mockServiceARN,
+                        AppDNSRole: "",
+                        CustomDomain: mockAppDNSName,
+                        AppDNSName: mockAppDNSName,
+                    },
+                    PhysicalResourceId: mockPhysicalResourceID,
+                    LogicalResourceId: mockLogicalResourceID,
+                })
+                .expectResolve(() => {
+                    expect(expectedResponse.isDone()).toBe(true);
+                });
+        });
+
         test("success when domain is already associated", () => {
             const mockListHostedZonesByName = sinon.fake.resolves({
                 HostedZones: [
@@ -0,0 +1,311 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+"use strict";
+
+describe("deployment hook", () => {
+  const aws = require("aws-sdk-mock");
+  const lambdaTester = require("lambda-tester").noVersionCheck();
+  const nock = require("nock");
+  const sinon = require("sinon");
+  const handler = require("../lib/deployment-hook");
+
+  const responseURL = "https://cloudwatch-response-mock.example.com/";
+  const logGroup = "/aws/lambda/testLambda";
+  const logStream = "2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd";
+  const testRequestId = "f4ef1b10-c39a-44e3-99c0-fbf7e53c3943";
+
+  const stackId = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api/1234";
+  const taskArn = "arn:aws:ecs:us-west-2:123456789012:task/phonetool-test-Cluster/1234";
+  const hookProps = {
+    Cluster: "phonetool-test-Cluster",
+    TaskDefinition: "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:2",
+    ContainerName: "api",
+    Command: ["rake", "db:migrate"],
+    PlatformVersion: "LATEST",
+    NetworkConfiguration: {
+      AwsvpcConfiguration: {
+        AssignPublicIp: "DISABLED",
+        Subnets: ["subnet-1", "subnet-2"],
+        SecurityGroups: ["sg-1"],
+      },
+    },
+  };
+
+  const origConsole = console;
+
+  beforeEach(() => {
+    handler.withSleep(() => Promise.resolve());
+    handler.withDeadlineExpired(() => {
+      return new Promise((resolve, reject) => {});
+    });
+    console.log = () => {};
+  });
+  afterEach(() => {
+    handler.reset();
+    aws.restore();
+  });
+  afterAll(() => {
+    console = origConsole;
+  });
+
+  const invoke = (event) =>
+    lambdaTester(handler.handler)
+      .context({
+        logGroupName: logGroup,
+        logStreamName: logStream,
+      })
+      .event({
+        ResponseURL: responseURL,
+        RequestId: testRequestId,
+        StackId: stackId,
+        LogicalResourceId: "mockID",
+        ...event,
+      });
+
+  const mockStackStatus = (status) => {
+    aws.mock("CloudFormation", "describeStacks", sinon.fake.resolves({ Stacks: [{ StackStatus: status }] }));
+  };
+
+  test("bogus operation fails", () => {
+    console.error = () => {};
+    const request = nock(responseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason ===
+            "Unsupported request type bogus (Log: /aws/lambda/testLambda/2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd)"
+        );
+      })
+      .reply(200);
+    return invoke({
+      RequestType: "bogus",
+      ResourceProperties: {},
+    }).expectResolve(() => {
+      expect(request.isDone()).toBe(true);
+    });
+  });
+
+  test("delete event is a no-op", () => {
+    const request = nock(responseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS" && body.PhysicalResourceId === "randomID";
+      })
+      .reply(200);
+    return invoke({
+      RequestType: "Delete",
+      ResourceProperties: {},
+      PhysicalResourceId: "randomID",
+    }).expectResolve(() => {
+      expect(request.isDone()).toBe(true);
+    });
+  });
+
+  test("skip the hook while the stack is rolling back", () => {
+    const request = nock(responseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+    mockStackStatus("UPDATE_ROLLBACK_IN_PROGRESS");
+    const runTask = sinon.fake.resolves({});
+    aws.mock("ECS", "runTask", runTask);
+
+    return invoke({
+      RequestType: "Update",
+      ResourceProperties: hookProps,
+    }).expectResolve(() => {
+      expect(request.isDone()).toBe(true);
+      sinon.assert.notCalled(runTask);
+    });
+  });
+
+  test("run the task with the command of the hook and wait until it succeeds", () => {
+    const request = nock(responseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS" && body.PhysicalResourceId === "mockID";
+      })
+      .reply(200);
+    mockStackStatus("UPDATE_IN_PROGRESS");
+    const runTask = sinon.fake.resolves({ tasks: [{ taskArn }], failures: [] });
+    aws.mock("ECS", "runTask", runTask);
+    const describeTasks = sinon.stub();
+    describeTasks.onFirstCall().resolves({ tasks: [{ taskArn, lastStatus: "RUNNING" }] });
+    describeTasks.onSecondCall().resolves({
+      tasks: [{ taskArn, lastStatus: "STOPPED", containers: [{ name: "api", exitCode: 0 }] }],
+    });
+    aws.mock("ECS", "describeTasks", describeTasks);
+
+    return invoke({
+      RequestType: "Update",
+      ResourceProperties: hookProps,
+    }).expectResolve(() => {
+      expect(request.isDone()).toBe(true);
+      sinon.assert.calledWith(runTask, {
+        cluster: "phonetool-test-Cluster",
+        taskDefinition: "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:2",
+        launchType: "FARGATE",
+        platformVersion: "LATEST",
+        networkConfiguration: {
+          awsvpcConfiguration: {
+            assignPublicIp: "DISABLED",
+            subnets: ["subnet-1", "subnet-2"],
+            securityGroups: ["sg-1"],
+          },
+        },
+        overrides: {
+          containerOverrides: [{ name: "api", command: ["rake", "db:migrate"] }],
+        },
+        startedBy: "copilot-deployment-hook",
+      });
+      sinon.assert.calledTwice(describeTasks);
+    });
+  });
+
+  test("run the task on the capacity provider of the hook", () => {
+    const request = nock(responseURL)
+      .put("/", (body) => {
+        return body.Status === "SUCCESS";
+      })
+      .reply(200);
+    mockStackStatus("UPDATE_IN_PROGRESS");
+    const runTask = sinon.fake.resolves({ tasks: [{ taskArn }], failures: [] });
+    aws.mock("ECS", "runTask", runTask);
+    aws.mock(
+      "ECS",
+      "describeTasks",
+      sinon.fake.resolves({
+        tasks: [{ taskArn, lastStatus: "STOPPED", containers: [{ name: "api", exitCode: 0 }] }],
+      })
+    );
+
+    return invoke({
+      RequestType: "Create",
+      ResourceProperties: {
+        ...hookProps,
+        PlatformVersion: undefined,
+        CapacityProvider: "phonetool-test-EC2CapacityProvider",
+      },
+    }).expectResolve(() => {
+      expect(request.isDone()).toBe(true);
+      sinon.assert.calledWith(
+        runTask,
+        sinon.match({
+          capacityProviderStrategy: [{ capacityProvider: "phonetool-test-EC2CapacityProvider", weight: 1 }],
+        })
+      );
+      sinon.assert.calledWith(runTask, sinon.match((input) => input.launchType === undefined));
+    });
+  });
+
+  test("fail if the task cannot be run", () => {
+    console.error = () => {};
+    const request = nock(responseURL)
+      .put("/", (body) => {
+        return body.Status === "FAILED" && body.Reason.startsWith("Failed to run task: RESOURCE:MEMORY");
+      })
+      .reply(200);
+    mockStackStatus("CREATE_IN_PROGRESS");
+    aws.mock("ECS", "runTask", sinon.fake.resolves({ tasks: [], failures: [{ reason: "RESOURCE:MEMORY" }] }));
+
+    return invoke({
+      RequestType: "Create",
+      ResourceProperties: hookProps,
+    }).expectResolve(() => {
+      expect(request.isDone()).toBe(true);
+    });
+  });
+
+  test("fail if the container exits with a non-zero exit code", () => {
+    console.error = () => {};
+    const request = nock(responseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason.startsWith(`Container api in task ${taskArn} exited with status code 1`)
+        );
+      })
+      .reply(200);
+    mockStackStatus("UPDATE_IN_PROGRESS");
+    aws.mock("ECS", "runTask", sinon.fake.resolves({ tasks: [{ taskArn }] }));
+    aws.mock(
+      "ECS",
+      "describeTasks",
+      sinon.fake.resolves({
+        tasks: [{ taskArn, lastStatus: "STOPPED", containers: [{ name: "api", exitCode: 1 }] }],
+      })
+    );
+    const stopTask = sinon.fake.resolves({});
+    aws.mock("ECS", "stopTask", stopTask);
+
+    return invoke({
+      RequestType: "Update",
+      ResourceProperties: hookProps,
+    }).expectResolve(() => {
+      expect(request.isDone()).toBe(true);
+    });
+  });
+
+  test("fail if the task stops before the container runs", () => {
+    console.error = () => {};
+    const request = nock(responseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason.startsWith(`Task ${taskArn} stopped: CannotPullContainerError`)
+        );
+      })
+      .reply(200);
+    mockStackStatus("UPDATE_IN_PROGRESS");
+    aws.mock("ECS", "runTask", sinon.fake.resolves({ tasks: [{ taskArn }] }));
+    aws.mock(
+      "ECS",
+      "describeTasks",
+      sinon.fake.resolves({
+        tasks: [{ taskArn, lastStatus: "STOPPED", stoppedReason: "CannotPullContainerError", containers: [{ name: "api" }] }],
+      })
+    );
+    aws.mock("ECS", "stopTask", sinon.fake.resolves({}));
+
+    return invoke({
+      RequestType: "Update",
+      ResourceProperties: hookProps,
+    }).expectResolve(() => {
+      expect(request.isDone()).toBe(true);
+    });
+  });
+
+  test("stop the task if the lambda is about to time out", () => {
+    console.error = () => {};
+    handler.withDeadlineExpired(() => {
+      return new Promise((resolve, reject) => setTimeout(reject, 50, new Error("Lambda took longer than 14 minutes")));
+    });
+    handler.withSleep(() => new Promise((resolve) => setTimeout(resolve, 10)));
+    const request = nock(responseURL)
+      .put("/", (body) => {
+        return body.Status === "FAILED" && body.Reason.startsWith("Lambda took longer than 14 minutes");
+      })
+      .reply(200);
+    mockStackStatus("UPDATE_IN_PROGRESS");
+    aws.mock("ECS", "runTask", sinon.fake.resolves({ tasks: [{ taskArn }] }));
+    let lastStatus = "RUNNING";
+    aws.mock("ECS", "describeTasks", () => Promise.resolve({ tasks: [{ taskArn, lastStatus, containers: [] }] }));
+    const stopTask = sinon.fake(() => {
+      lastStatus = "STOPPED";
+      return Promise.resolve({});
+    });
+    aws.mock("ECS", "stopTask", stopTask);
+
+    return invoke({
+      RequestType: "Update",
+      ResourceProperties: hookProps,
+    }).expectResolve(() => {
+      expect(request.isDone()).toBe(true);
+      sinon.assert.calledWith(stopTask, {
+        cluster: "phonetool-test-Cluster",
+        task: taskArn,
+        reason: "Lambda took longer than 14 minutes",
+      });
+    });
+  });
+});
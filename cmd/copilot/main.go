@@ -6,14 +6,84 @@ package main
 
 import (
 	"errors"
+	"fmt"
 	"os"
+	"runtime"
+	"strings"
+	"time"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/errs"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/plugin"
+	"github.com/aws/copilot-cli/internal/pkg/telemetry"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/i18n"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	colorFlag            = "color"
+	colorFlagDescription = `Whether to color the output: "auto", "always", or "never".
+"auto" respects the COLOR, NO_COLOR, CLICOLOR and CLICOLOR_FORCE environment variables.`
+
+	jsonFlag            = "json"
+	jsonFlagDescription = `Output the results in JSON format to stdout, for the commands that list, show or deploy resources.
+Progress and diagnostic messages are written to stderr.`
+
+	progressFlag            = "progress"
+	progressFlagDescription = `How to display the progress of deployments: "tree", "plain", or "quiet".
+"plain" appends a line for every update instead of updating lines in-place, for CI logs.`
+
+	accessibleFlag            = "accessible"
+	accessibleFlagDescription = `Write output that works well with screen readers: progress is appended line by line
+instead of being animated or updated in-place, and diffs label changes with words.
+Can also be turned on with the COPILOT_ACCESSIBLE=true environment variable.`
+
+	yesFlag            = "yes"
+	yesFlagDescription = `Accept the default answer of every prompt.
+Prompts without a default answer fail instead of waiting for input.`
+	answersFlag            = "answers"
+	answersFlagDescription = `Path to a YAML file that maps prompt messages to their answers.
+Prompts that aren't in the file are asked, or answered with their default with --yes.`
+	recordAnswersFlag            = "record-answers"
+	recordAnswersFlagDescription = `Path to a YAML file to record the answers to prompts to, for use with --answers.
+Answers to secret prompts aren't recorded.`
+
+	debugFlag               = "debug"
+	debugFlagDescription    = `Record every AWS API call in a trace file, and print a summary of the calls when the command ends.`
+	debugHARFlag            = "debug-har"
+	debugHARFlagDescription = `Path to also write the AWS API calls to as an HTTP Archive (HAR) file. Implies --debug.`
+
+	workspaceFlag            = "workspace"
+	workspaceFlagDescription = `Directory of the workspace to run the command in, or the name of an application
+listed in the ` + workspace.IndexFileName + ` file of a monorepo.
+Relative paths of other flags are resolved from the workspace directory.`
+)
+
+var (
+	colorMode     string
+	progressMode  string
+	accessible    bool
+	outputJSON    bool
+	acceptDefault bool
+	answersFile   string
+	recordFile    string
+	workspaceName string
+	debug         bool
+	debugHARFile  string
+
+	debugTrace *trace // Set once the AWS API calls of the command are traced.
 )
 
 type actionRecommender interface {
@@ -26,27 +96,110 @@ type exitCodeError interface {
 
 func init() {
 	color.DisableColorBasedOnEnvVar()
+	if err := color.SetThemeBasedOnEnvVar(); err != nil {
+		log.Warningln(err.Error())
+	}
+	if err := i18n.SetLanguageBasedOnEnvVar(); err != nil {
+		log.Warningln(err.Error())
+	}
 	cobra.EnableCommandSorting = false // Maintain the order in which we add commands.
 }
 
 func main() {
-	cmd := buildRootCmd()
-	if err := cmd.Execute(); err != nil {
+	err := useWorkspace(os.Args[1:])
+	var cmd *cobra.Command
+	if err == nil {
+		root := buildRootCmd()
+		if path, ok := plugin.Find(os.Args[1:], builtinCommands(root)); ok {
+			runPlugin(path, os.Args[2:])
+		}
+		start := time.Now()
+		cmd, err = root.ExecuteC()
+		recordTelemetry(cmd, err, time.Since(start))
+		debugTrace.finish()
+	}
+	if err != nil {
 		var ac actionRecommender
 		var exitCodeErr exitCodeError
 
+		exitCode := 1
+		if errors.As(err, &exitCodeErr) {
+			exitCode = exitCodeErr.ExitCode()
+		}
+		if writesJSON(cmd) {
+			if writeErr := errs.WriteJSON(log.OutputWriter, err); writeErr == nil {
+				os.Exit(exitCode)
+			}
+		}
 		if errors.As(err, &ac) {
 			log.Infoln(ac.RecommendActions())
 		}
-		if errors.As(err, &exitCodeErr) {
+		if exitCodeErr != nil {
 			log.Infoln(err.Error())
-			os.Exit(exitCodeErr.ExitCode())
+			os.Exit(exitCode)
+		}
+		log.Errorln(i18n.Error(err))
+		if details := errs.Details(err); details != "" {
+			log.Infoln(details)
 		}
+		os.Exit(exitCode)
+	}
+}
+
+// builtinCommands returns the names and aliases of the commands of copilot, which plugins can't replace.
+func builtinCommands(root *cobra.Command) []string {
+	names := []string{"help", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd}
+	for _, cmd := range root.Commands() {
+		names = append(names, cmd.Name())
+		names = append(names, cmd.Aliases...)
+	}
+	return names
+}
+
+// runPlugin runs the plugin and exits with its exit code.
+func runPlugin(path string, args []string) {
+	code, err := plugin.Run(path, args)
+	if err != nil {
 		log.Errorln(err.Error())
-		os.Exit(1)
+	}
+	os.Exit(code)
+}
+
+// recordTelemetry records the duration and result of the command, if the user opted in to telemetry.
+// Only the names of the flags are recorded, not their values.
+func recordTelemetry(cmd *cobra.Command, err error, duration time.Duration) {
+	if cmd == nil || !cmd.Runnable() || cmd.Name() == cobra.ShellCompRequestCmd || cmd.Name() == cobra.ShellCompNoDescRequestCmd {
+		return
+	}
+	event := telemetry.Event{
+		Time:       time.Now().UTC(),
+		Command:    strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" "),
+		DurationMS: duration.Milliseconds(),
+		Result:     telemetry.ResultSuccess,
+		Version:    version.Version,
+		OS:         runtime.GOOS,
+	}
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		event.Flags = append(event.Flags, f.Name)
+	})
+	if err != nil {
+		event.Result = telemetry.ResultFailure
+		event.Failure = string(errs.CodeOf(err))
+	}
+	if err := telemetry.New().Record(event); err != nil {
+		log.Debugln(err.Error())
 	}
 }
 
+// writesJSON returns true if the command that ran was asked to write its results in JSON.
+func writesJSON(cmd *cobra.Command) bool {
+	if cmd == nil {
+		return false
+	}
+	flag := cmd.Flags().Lookup(jsonFlag)
+	return flag != nil && flag.Value.String() == "true"
+}
+
 func buildRootCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "copilot",
@@ -54,14 +207,54 @@ func buildRootCmd() *cobra.Command {
 		Example: `
   Displays the help menu for the "init" command.
   /code $ copilot init --help`,
-		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			// If we don't set a Run() function the help menu doesn't show up.
 			// See https://github.com/spf13/cobra/issues/790
+			if err := color.SetMode(colorMode); err != nil {
+				return err
+			}
+			color.DisableColorBasedOnEnvVar()
+			if err := progress.SetMode(progressMode); err != nil {
+				return err
+			}
+			color.SetAccessible(accessible)
+			if err := setUpPromptScripting(); err != nil {
+				return err
+			}
+			if debug || debugHARFile != "" {
+				t, err := startTrace(debugHARFile)
+				if err != nil {
+					return err
+				}
+				debugTrace = t
+			}
+			if err := useEnvCredentials(); err != nil {
+				return err
+			}
+			sessions.OnExpiredSSOToken(loginWithSSO)
+			if writesJSON(cmd) {
+				// Keep stdout for the JSON results: help and usage messages go to stderr with the logs, spinners and progress.
+				cmd.Root().SetOut(log.DiagnosticWriter)
+			}
+			return nil
 		},
 		SilenceUsage:  true,
 		SilenceErrors: true,
 	}
 
+	cmd.PersistentFlags().StringVar(&colorMode, colorFlag, color.ModeAuto, colorFlagDescription)
+	cmd.PersistentFlags().StringVar(&progressMode, progressFlag, progress.ModeTree, progressFlagDescription)
+	cmd.PersistentFlags().BoolVar(&accessible, accessibleFlag, false, accessibleFlagDescription)
+	// Commands that define their own --json flag, such as to write a diff as JSON, take precedence over the global one.
+	cmd.PersistentFlags().BoolVar(&outputJSON, jsonFlag, false, jsonFlagDescription)
+	// Commands that define their own --yes flag, such as to skip a confirmation, take precedence over the global one.
+	cmd.PersistentFlags().BoolVar(&acceptDefault, yesFlag, false, yesFlagDescription)
+	cmd.PersistentFlags().StringVar(&answersFile, answersFlag, "", answersFlagDescription)
+	cmd.PersistentFlags().StringVar(&recordFile, recordAnswersFlag, "", recordAnswersFlagDescription)
+	cmd.PersistentFlags().StringVar(&workspaceName, workspaceFlag, "", workspaceFlagDescription)
+	cmd.PersistentFlags().BoolVar(&debug, debugFlag, false, debugFlagDescription)
+	cmd.PersistentFlags().StringVar(&debugHARFile, debugHARFlag, "", debugHARFlagDescription)
+
 	cmd.SetOut(log.OutputWriter)
 	cmd.SetErr(log.DiagnosticWriter)
 
@@ -81,20 +274,123 @@ func buildRootCmd() *cobra.Command {
 	cmd.AddCommand(cli.BuildSvcCmd())
 	cmd.AddCommand(cli.BuildJobCmd())
 	cmd.AddCommand(cli.BuildTaskCmd())
-	cmd.AddCommand(cli.BuildLocalRunCmd())
+	cmd.AddCommand(cli.BuildRunCmd())
+	cmd.AddCommand(cli.BuildQueueCmd())
 
 	// "Extend" command group
 	cmd.AddCommand(cli.BuildStorageCmd())
 	cmd.AddCommand(cli.BuildSecretCmd())
+	cmd.AddCommand(cli.BuildOverrideCmd())
 
 	// "Settings" command group.
 	cmd.AddCommand(cli.BuildVersionCmd())
+	cmd.AddCommand(cli.BuildDoctorCmd())
 	cmd.AddCommand(cli.BuildCompletionCmd(cmd))
+	cmd.AddCommand(cli.BuildCacheCmd())
+	cmd.AddCommand(cli.BuildStatsCmd())
 
 	// "Release" command group.
 	cmd.AddCommand(cli.BuildPipelineCmd())
 	cmd.AddCommand(cli.BuildDeployCmd())
 
+	// Completions are registered once every command is added.
+	cli.RegisterFlagCompletions(cmd)
+
 	cmd.SetUsageTemplate(template.RootUsage)
 	return cmd
 }
+
+// setUpPromptScripting configures prompts to be answered without user input based on the global flags.
+func setUpPromptScripting() error {
+	if acceptDefault {
+		prompt.AcceptDefaults()
+	}
+	if answersFile != "" {
+		if err := prompt.LoadAnswers(answersFile); err != nil {
+			return err
+		}
+	}
+	if recordFile != "" {
+		prompt.RecordAnswers(recordFile)
+	}
+	return nil
+}
+
+// useEnvCredentials makes the sessions of the environments of the workspace assume their manager role
+// with the credentials of the environments section of the copilot/.workspace file.
+func useEnvCredentials() error {
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil // Commands that need a workspace report the error themselves.
+	}
+	summary, err := ws.Summary()
+	if err != nil || len(summary.Environments) == 0 {
+		return nil
+	}
+	sources := make(map[string]sessions.RoleSource, len(summary.Environments))
+	for env, creds := range summary.Environments {
+		if creds.Profile != "" && creds.Role != "" {
+			return fmt.Errorf(`environment %s in %s must set only one of "profile" or "role"`, env, summary.Path)
+		}
+		sources[fmt.Sprintf("%s-EnvManagerRole", stack.NameForEnv(summary.Application, env))] = sessions.RoleSource{
+			Profile: creds.Profile,
+			RoleARN: creds.Role,
+		}
+	}
+	sessions.UseRoleSources(sources)
+	return nil
+}
+
+// loginWithSSO asks to run "aws sso login" for the profile whose SSO session expired, and runs it if confirmed.
+func loginWithSSO(profile string) error {
+	args := []string{"sso", "login"}
+	msg := "Your AWS SSO session has expired."
+	if profile != "" {
+		args = append(args, "--profile", profile)
+		msg = fmt.Sprintf("The AWS SSO session of profile %s has expired.", profile)
+	}
+	login, err := prompt.New().Confirm(
+		fmt.Sprintf("%s Log in again to continue?", msg),
+		`Runs "aws `+strings.Join(args, " ")+`" and retries the request once you're logged in.`,
+		prompt.WithTrueDefault())
+	if err != nil {
+		return fmt.Errorf("confirm SSO login: %w", err)
+	}
+	if !login {
+		return errors.New("SSO login declined")
+	}
+	return exec.NewCmd().Run("aws", args, exec.Stdin(os.Stdin), exec.Stdout(os.Stderr), exec.Stderr(os.Stderr))
+}
+
+// useWorkspace changes the working directory to the workspace of the --workspace flag, if any.
+// The flag is read before the commands are built, since the default value of their --app flag comes from the workspace.
+func useWorkspace(args []string) error {
+	name := workspaceFromArgs(args)
+	if name == "" {
+		return nil
+	}
+	dir, err := workspace.Locate(afero.NewOsFs(), name)
+	if err != nil {
+		return err
+	}
+	if err := os.Chdir(dir); err != nil {
+		return fmt.Errorf("change directory to workspace %s: %w", dir, err)
+	}
+	return nil
+}
+
+// workspaceFromArgs returns the value of the --workspace flag, or an empty string if it's not set.
+func workspaceFromArgs(args []string) string {
+	flag := "--" + workspaceFlag
+	for i, arg := range args {
+		switch {
+		case arg == "--":
+			return "" // The remaining arguments belong to another program, such as with "svc exec".
+		case arg == flag && i+1 < len(args):
+			return args[i+1]
+		case strings.HasPrefix(arg, flag+"="):
+			return strings.TrimPrefix(arg, flag+"=")
+		}
+	}
+	return ""
+}
@@ -0,0 +1,113 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+const (
+	traceDirName       = "debug"
+	maxSummaryOpsShown = 10 // Number of operations listed in the summary, from the one that took the longest.
+)
+
+// trace records the AWS API calls of the command in a file, for --debug.
+type trace struct {
+	file    *os.File
+	harPath string
+	tracer  *sessions.Tracer
+}
+
+// startTrace traces the AWS API calls of the sessions created from now on, in a file of the user's cache directory.
+// The calls are also written as an HTTP Archive to harPath once the command ends, unless it's empty.
+func startTrace(harPath string) (*trace, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	dir = filepath.Join(dir, "copilot", traceDirName)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("create directory for the trace of AWS API calls: %w", err)
+	}
+	f, err := os.Create(filepath.Join(dir, fmt.Sprintf("%s.jsonl", time.Now().UTC().Format("20060102T150405.000Z"))))
+	if err != nil {
+		return nil, fmt.Errorf("create trace file of AWS API calls: %w", err)
+	}
+	t := &trace{
+		file:    f,
+		harPath: harPath,
+		tracer:  sessions.NewTracer(f),
+	}
+	sessions.TraceCalls(t.tracer)
+	return t, nil
+}
+
+// finish prints a summary of the calls, and writes the HTTP Archive if one was requested.
+func (t *trace) finish() {
+	if t == nil {
+		return
+	}
+	sessions.TraceCalls(nil)
+	if err := t.file.Close(); err != nil {
+		log.Warningf("close trace file of AWS API calls: %v\n", err)
+	}
+	calls := t.tracer.Calls()
+	writeTraceSummary(log.DiagnosticWriter, calls)
+	log.Debugf("Trace of the AWS API calls written to %s.\n", t.file.Name())
+	if t.harPath == "" {
+		return
+	}
+	if err := writeHARFile(t.harPath, calls); err != nil {
+		log.Warningf("write HTTP Archive of AWS API calls: %v\n", err)
+		return
+	}
+	log.Debugf("HTTP Archive of the AWS API calls written to %s.\n", t.harPath)
+}
+
+func writeHARFile(path string, calls []sessions.Call) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := sessions.WriteHAR(f, calls); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// writeTraceSummary writes the number of calls, retries and throttles, and the operations that took the longest.
+func writeTraceSummary(w io.Writer, calls []sessions.Call) {
+	stats := sessions.Summarize(calls)
+	var total time.Duration
+	var retries, throttles int
+	for _, s := range stats {
+		total += s.Duration
+		retries += s.Retries
+		throttles += s.Throttles
+	}
+	fmt.Fprintf(w, "\nAWS API calls: %d, taking %s in total, with %d retries and %d throttled attempts.\n",
+		len(calls), total.Round(time.Millisecond), retries, throttles)
+	if len(stats) == 0 {
+		return
+	}
+	if len(stats) > maxSummaryOpsShown {
+		stats = stats[:maxSummaryOpsShown]
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "  Operation\tCalls\tTotal\tSlowest\tRetries\tThrottled\tErrors")
+	for _, s := range stats {
+		fmt.Fprintf(tw, "  %s/%s\t%d\t%s\t%s\t%d\t%d\t%d\n", s.Service, s.Operation, s.Calls,
+			s.Duration.Round(time.Millisecond), s.Slowest.Round(time.Millisecond), s.Retries, s.Throttles, s.Errors)
+	}
+	tw.Flush()
+}
@@ -64,6 +64,49 @@ func TestAddons(t *testing.T) {
 			}),
 			outFileName: "ddb.yml",
 		},
+		"aurora with capacity range": {
+			addonMarshaler: addon.EnvServerlessTemplate(addon.RDSProps{
+				ClusterName:   "aurora",
+				Engine:        "PostgreSQL",
+				InitialDBName: "main",
+				Envs:          []string{"test"},
+				MinCapacity:   1,
+				MaxCapacity:   16.5,
+			}),
+			outFileName: "aurora-capacity.yml",
+		},
+		"ddb with gsi, ttl and stream": {
+			addonMarshaler: addon.EnvDDBTemplate(&addon.DynamoDBProps{
+				StorageProps: &addon.StorageProps{
+					Name: "ddb",
+				},
+				Attributes: []addon.DDBAttribute{
+					{
+						Name:     aws.String("primary"),
+						DataType: aws.String("S"),
+					},
+					{
+						Name:     aws.String("status"),
+						DataType: aws.String("S"),
+					},
+					{
+						Name:     aws.String("createdAt"),
+						DataType: aws.String("N"),
+					},
+				},
+				PartitionKey: aws.String("primary"),
+				GSIs: []addon.DDBGlobalSecondaryIndex{
+					{
+						Name:         aws.String("statuscreatedAtIndex"),
+						PartitionKey: aws.String("status"),
+						SortKey:      aws.String("createdAt"),
+					},
+				},
+				TTLAttribute:   "expiresAt",
+				StreamViewType: "NEW_AND_OLD_IMAGES",
+			}),
+			outFileName: "ddb-gsi.yml",
+		},
 		"s3": {
 			addonMarshaler: addon.WorkloadS3Template(&addon.S3Props{
 				StorageProps: &addon.StorageProps{
@@ -72,6 +115,35 @@ func TestAddons(t *testing.T) {
 			}),
 			outFileName: "bucket.yml",
 		},
+		"efs": {
+			addonMarshaler: addon.EnvEFSTemplate(&addon.EFSProps{
+				StorageProps: &addon.StorageProps{
+					Name: "efs",
+				},
+				ThroughputMode: "elastic",
+				TransitionToIA: "AFTER_30_DAYS",
+				EnableBackup:   true,
+			}),
+			outFileName: "efs.yml",
+		},
+		"efs access point": {
+			addonMarshaler: addon.EnvEFSAccessPointTemplate(addon.EFSAccessPointProps{
+				Name:         "efs",
+				WorkloadName: "api",
+				UID:          1000,
+				GID:          1000,
+			}),
+			outFileName: "efs-access-point.yml",
+		},
+		"redis": {
+			addonMarshaler: addon.EnvRedisTemplate(&addon.RedisProps{
+				StorageProps: &addon.StorageProps{
+					Name: "redis",
+				},
+				Envs: []string{"test"},
+			}),
+			outFileName: "redis.yml",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -24,10 +24,11 @@ var (
 	envAddonsParameterReservedKeys  = []string{"App", "Env"}
 )
 
+const paramFilePrefix = "addons.parameters"
+
 var (
 	yamlExtensions     = []string{".yaml", ".yml"}
 	parameterFileNames = func() []string {
-		const paramFilePrefix = "addons.parameters"
 		var fnames []string
 		for _, ext := range yamlExtensions {
 			fnames = append(fnames, fmt.Sprintf("%s%s", paramFilePrefix, ext))
@@ -36,6 +37,16 @@ var (
 	}()
 )
 
+// envParameterFileNames returns the names of the parameters files whose parameters only apply to the environment,
+// such as "addons.parameters.test.yml".
+func envParameterFileNames(env string) []string {
+	var fnames []string
+	for _, ext := range yamlExtensions {
+		fnames = append(fnames, fmt.Sprintf("%s.%s%s", paramFilePrefix, env, ext))
+	}
+	return fnames
+}
+
 // WorkspaceAddonsReader finds and reads addons from a workspace.
 type WorkspaceAddonsReader interface {
 	WorkloadAddonsAbsPath(name string) string
@@ -64,6 +75,7 @@ type stack struct {
 
 type parser struct {
 	ws                 WorkspaceAddonsReader
+	envName            string // Name of the environment whose parameters file overrides the common one, if any.
 	addonsDirPath      func() string
 	addonsFilePath     func(fName string) string
 	validateParameters func(tplParams, customParams yaml.Node) error
@@ -100,9 +112,11 @@ func ParseFromWorkload(workloadName string, ws WorkspaceAddonsReader) (*Workload
 // and returns a Stack created by merging the CloudFormation templates
 // files found there. If no addons are found, ParseFromWorkload returns a nil
 // Stack and ErrAddonsNotFound.
-func ParseFromEnv(ws WorkspaceAddonsReader) (*EnvironmentStack, error) {
+// The parameters of the "addons.parameters.<envName>.yml" file override the ones of the "addons.parameters.yml" file.
+func ParseFromEnv(envName string, ws WorkspaceAddonsReader) (*EnvironmentStack, error) {
 	parser := parser{
 		ws:             ws,
+		envName:        envName,
 		addonsDirPath:  ws.EnvAddonsAbsPath,
 		addonsFilePath: ws.EnvAddonFileAbsPath,
 		validateParameters: func(tplParams, customParams yaml.Node) error {
@@ -211,12 +225,27 @@ func (p *parser) parseTemplate(fNames []string) (*cfnTemplate, error) {
 // If there are multiple parameters files, then returns "" and cannot define multiple parameter files error.
 // If the addons parameters use the reserved parameter names, then returns "" and a reserved parameter error.
 func (p *parser) parseParameters(fNames []string) (yaml.Node, error) {
-	paramFiles := filterFiles(fNames, paramsMatcher)
+	params, err := p.parseParametersFile(filterFiles(fNames, paramsMatcher), parameterFileNames)
+	if err != nil || p.envName == "" {
+		return params, err
+	}
+	envFileNames := envParameterFileNames(p.envName)
+	envParams, err := p.parseParametersFile(filterFiles(fNames, func(fileName string) bool {
+		return contains(envFileNames, fileName)
+	}), envFileNames)
+	if err != nil {
+		return yaml.Node{}, err
+	}
+	return mergeParameters(params, envParams), nil
+}
+
+// parseParametersFile returns the "Parameters" field of the only file among paramFiles, which can be any of fileNames.
+func (p *parser) parseParametersFile(paramFiles, fileNames []string) (yaml.Node, error) {
 	if len(paramFiles) == 0 {
 		return yaml.Node{}, nil
 	}
 	if len(paramFiles) > 1 {
-		return yaml.Node{}, fmt.Errorf("defining %s is not allowed under addons/", english.WordSeries(parameterFileNames, "and"))
+		return yaml.Node{}, fmt.Errorf("defining %s is not allowed under addons/", english.WordSeries(fileNames, "and"))
 	}
 	paramFile := paramFiles[0]
 	path := p.addonsFilePath(paramFile)
@@ -236,6 +265,33 @@ func (p *parser) parseParameters(fNames []string) (yaml.Node, error) {
 	return content.Parameters, nil
 }
 
+// mergeParameters returns the parameters with the values of the overrides replacing the ones of the same name.
+func mergeParameters(params, overrides yaml.Node) yaml.Node {
+	if overrides.Kind != yaml.MappingNode {
+		return params
+	}
+	if params.Kind != yaml.MappingNode {
+		return overrides
+	}
+	merged := params
+	merged.Content = append([]*yaml.Node(nil), params.Content...)
+	for i := 0; i+1 < len(overrides.Content); i += 2 {
+		key, value := overrides.Content[i], overrides.Content[i+1]
+		replaced := false
+		for j := 0; j+1 < len(merged.Content); j += 2 {
+			if merged.Content[j].Value == key.Value {
+				merged.Content[j+1] = value
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged.Content = append(merged.Content, key, value)
+		}
+	}
+	return merged
+}
+
 func validateParameters(tplParamsNode, customParamsNode yaml.Node, reservedKeys []string) error {
 	customParams := make(map[string]yaml.Node)
 	if err := customParamsNode.Decode(customParams); err != nil {
@@ -303,8 +359,9 @@ func paramsMatcher(fileName string) bool {
 	return contains(parameterFileNames, fileName)
 }
 
+// nonParamsMatcher matches the files that are neither the common parameters file nor the one of an environment.
 func nonParamsMatcher(fileName string) bool {
-	return !paramsMatcher(fileName)
+	return !paramsMatcher(fileName) && !strings.HasPrefix(fileName, paramFilePrefix+".")
 }
 
 func contains(arr []string, el string) bool {
@@ -540,7 +540,7 @@ func TestEnv_Template(t *testing.T) {
 			}
 
 			// WHEN
-			stack, err := ParseFromEnv(m.ws)
+			stack, err := ParseFromEnv("test", m.ws)
 			if tc.wantedErr != nil {
 				require.EqualError(t, err, tc.wantedErr.Error())
 				return
@@ -667,6 +667,51 @@ ServiceName: !Ref Service
 SecurityGroupId:
   Fn::GetAtt: [ServiceSecurityGroup, Id]
 DiscoveryServiceArn: !GetAtt DiscoveryService.Arn
+`,
+		},
+		"returns an error if there are multiple parameter files defined for the environment": {
+			setupMocks: func(m addonMocks) {
+				m.ws.EXPECT().EnvAddonsAbsPath().Return("mockPath")
+				m.ws.EXPECT().ListFiles("mockPath").Return([]string{"template.yml", "addons.parameters.test.yml", "addons.parameters.test.yaml"}, nil)
+				m.ws.EXPECT().EnvAddonFileAbsPath("template.yml").Return("mockPath")
+				m.ws.EXPECT().ReadFile("mockPath").Return([]byte(mockTemplate), nil)
+			},
+			wantedErr: errors.New("defining addons.parameters.test.yaml and addons.parameters.test.yml is not allowed under addons/"),
+		},
+		"overrides the parameters with the ones of the environment": {
+			setupMocks: func(m addonMocks) {
+				m.ws.EXPECT().EnvAddonsAbsPath().Return("mockPath")
+				m.ws.EXPECT().ListFiles("mockPath").Return([]string{"template.yaml", "addons.parameters.yml", "addons.parameters.prod.yml", "addons.parameters.test.yml"}, nil)
+				m.ws.EXPECT().EnvAddonFileAbsPath("template.yaml").Return("mockTemplatePath")
+				m.ws.EXPECT().ReadFile("mockTemplatePath").Return([]byte(`Parameters:
+  App:
+    Type: String
+  Env:
+    Type: String
+  InstanceClass:
+    Type: String
+  MinCapacity:
+    Type: Number
+  BackupRetention:
+    Type: Number
+    Default: 7
+`), nil)
+				m.ws.EXPECT().EnvAddonFileAbsPath("addons.parameters.yml").Return("mockParametersPath")
+				m.ws.EXPECT().ReadFile("mockParametersPath").Return([]byte(`
+Parameters:
+  InstanceClass: db.t3.medium
+  MinCapacity: 1
+`), nil)
+				m.ws.EXPECT().EnvAddonFileAbsPath("addons.parameters.test.yml").Return("mockEnvParametersPath")
+				m.ws.EXPECT().ReadFile("mockEnvParametersPath").Return([]byte(`
+Parameters:
+  MinCapacity: 0
+  BackupRetention: 1
+`), nil)
+			},
+			wantedParams: `InstanceClass: db.t3.medium
+MinCapacity: 0
+BackupRetention: 1
 `,
 		},
 	}
@@ -685,7 +730,7 @@ DiscoveryServiceArn: !GetAtt DiscoveryService.Arn
 			}
 
 			// WHEN
-			stack, err := ParseFromEnv(mocks.ws)
+			stack, err := ParseFromEnv("test", mocks.ws)
 			if tc.wantedErr != nil {
 				require.EqualError(t, err, tc.wantedErr.Error())
 				return
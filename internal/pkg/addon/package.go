@@ -397,11 +397,32 @@ type asset struct {
 	hash string
 }
 
+// Permissions of the files in a zipped asset.
+// Only the executable bit of the local file is kept, so that the umask
+// or the OS of the machine packaging the asset doesn't change the archive.
+// Executable files, like the bootstrap of a Lambda function with a custom runtime, stay executable.
+const (
+	zipAssetFileMode       fs.FileMode = 0644
+	zipAssetExecutableMode fs.FileMode = 0755
+)
+
+// zipAssetMode returns the permission of a file in a zipped asset.
+func zipAssetMode(info fs.FileInfo) fs.FileMode {
+	if info.Mode()&0111 != 0 {
+		return zipAssetExecutableMode
+	}
+	return zipAssetFileMode
+}
+
 // zipAsset creates an asset from the directory or file specified by root
 // where the data is the compressed zip archive, and the hash is
 // a hash of each files name, permission, and content. The zip file
 // itself is not hashed to avoid a changing hash when non-relevant
 // file metadata changes, like modification time.
+//
+// File names use forward slashes, permissions only keep the executable bit,
+// and modification times are left out, so that the archive is byte-identical
+// no matter the OS that packaged it.
 func (p *PackageConfig) zipAsset(root string) (asset, error) {
 	buf := &bytes.Buffer{}
 	archive := zip.NewWriter(buf)
@@ -424,6 +445,7 @@ func (p *PackageConfig) zipAsset(root string) (asset, error) {
 		case fname == ".": // happens when root == path; when a file (not a dir) is passed to `zipAsset()`
 			fname = info.Name()
 		}
+		fname = filepath.ToSlash(fname)
 
 		f, err := p.FS.Open(path)
 		if err != nil {
@@ -431,13 +453,12 @@ func (p *PackageConfig) zipAsset(root string) (asset, error) {
 		}
 		defer f.Close()
 
-		header, err := zip.FileInfoHeader(info)
-		if err != nil {
-			return fmt.Errorf("create zip file header: %w", err)
+		header := &zip.FileHeader{
+			Name:   fname,
+			Method: zip.Deflate,
 		}
-
-		header.Name = fname
-		header.Method = zip.Deflate
+		mode := zipAssetMode(info)
+		header.SetMode(mode)
 
 		zf, err := archive.CreateHeader(header)
 		if err != nil {
@@ -445,7 +466,7 @@ func (p *PackageConfig) zipAsset(root string) (asset, error) {
 		}
 
 		// include the file name and permissions as part of the hash
-		hash.Write([]byte(fmt.Sprintf("%s %s", fname, info.Mode().String())))
+		hash.Write([]byte(fmt.Sprintf("%s %s", fname, mode.String())))
 		_, err = io.Copy(io.MultiWriter(zf, hash), f)
 		return err
 	}); err != nil {
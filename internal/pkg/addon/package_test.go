@@ -4,13 +4,17 @@
 package addon
 
 import (
+	"archive/zip"
+	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/addon/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
@@ -46,13 +50,11 @@ func TestPackage(t *testing.T) {
 
 	f, _ := fs.Create("/lambda/index.js")
 	defer f.Close()
-	info, _ := f.Stat()
-	io.MultiWriter(lambdaZipHash, indexZipHash).Write([]byte("index.js " + info.Mode().String()))
+	io.MultiWriter(lambdaZipHash, indexZipHash).Write([]byte("index.js " + zipAssetFileMode.String()))
 	io.MultiWriter(f, lambdaZipHash, indexZipHash, indexFileHash).Write([]byte(`exports.handler = function(event, context) {}`))
 
-	f2, _ := fs.Create("/lambda/test.js")
-	info, _ = f2.Stat()
-	lambdaZipHash.Write([]byte("test.js " + info.Mode().String()))
+	fs.Create("/lambda/test.js")
+	lambdaZipHash.Write([]byte("test.js " + zipAssetFileMode.String()))
 
 	lambdaZipS3Path := fmt.Sprintf("manual/addons/mock-wl/assets/%s", hex.EncodeToString(lambdaZipHash.Sum(nil)))
 	indexZipS3Path := fmt.Sprintf("manual/addons/mock-wl/assets/%s", hex.EncodeToString(indexZipHash.Sum(nil)))
@@ -447,13 +449,11 @@ func TestEnvironmentAddonStack_PackagePackage(t *testing.T) {
 
 	f, _ := fs.Create("/lambda/index.js")
 	defer f.Close()
-	info, _ := f.Stat()
-	io.MultiWriter(lambdaZipHash, indexZipHash).Write([]byte("index.js " + info.Mode().String()))
+	io.MultiWriter(lambdaZipHash, indexZipHash).Write([]byte("index.js " + zipAssetFileMode.String()))
 	io.MultiWriter(f, lambdaZipHash, indexZipHash, indexFileHash).Write([]byte(`exports.handler = function(event, context) {}`))
 
-	f2, _ := fs.Create("/lambda/test.js")
-	info, _ = f2.Stat()
-	lambdaZipHash.Write([]byte("test.js " + info.Mode().String()))
+	fs.Create("/lambda/test.js")
+	lambdaZipHash.Write([]byte("test.js " + zipAssetFileMode.String()))
 
 	indexZipS3PathForEnvironmentAddon := fmt.Sprintf("manual/addons/environments/assets/%s", hex.EncodeToString(indexZipHash.Sum(nil)))
 	t.Run("package zipped AWS::Lambda::Function for environment addons", func(t *testing.T) {
@@ -520,3 +520,49 @@ Resources:
 	})
 
 }
+
+func TestPackageConfig_zipAsset(t *testing.T) {
+	newFS := func(mode os.FileMode, modTime time.Time) afero.Fs {
+		fs := afero.NewMemMapFs()
+		for name, content := range map[string]string{
+			"/lambda/index.js":     `exports.handler = function(event, context) {}`,
+			"/lambda/lib/utils.js": `module.exports = {}`,
+		} {
+			_ = afero.WriteFile(fs, name, []byte(content), mode)
+			_ = fs.Chmod(name, mode)
+			_ = fs.Chtimes(name, modTime, modTime)
+		}
+		_ = afero.WriteFile(fs, "/lambda/bootstrap", []byte(`#!/bin/sh`), 0755)
+		_ = fs.Chmod("/lambda/bootstrap", mode|0111)
+		_ = fs.Chtimes("/lambda/bootstrap", modTime, modTime)
+		return fs
+	}
+	linux := &PackageConfig{FS: newFS(0644, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))}
+	groupWritable := &PackageConfig{FS: newFS(0664, time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC))}
+
+	// WHEN
+	linuxAsset, err := linux.zipAsset("/lambda")
+	require.NoError(t, err)
+	groupWritableAsset, err := groupWritable.zipAsset("/lambda")
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, linuxAsset.hash, groupWritableAsset.hash)
+	linuxZip, err := io.ReadAll(linuxAsset.data)
+	require.NoError(t, err)
+	groupWritableZip, err := io.ReadAll(groupWritableAsset.data)
+	require.NoError(t, err)
+	require.Equal(t, linuxZip, groupWritableZip)
+
+	r, err := zip.NewReader(bytes.NewReader(linuxZip), int64(len(linuxZip)))
+	require.NoError(t, err)
+	modes := make(map[string]os.FileMode)
+	for _, f := range r.File {
+		modes[f.Name] = f.Mode()
+	}
+	require.Equal(t, map[string]os.FileMode{
+		"bootstrap":    0755,
+		"index.js":     0644,
+		"lib/utils.js": 0644,
+	}, modes)
+}
@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
 
@@ -20,6 +21,7 @@ const (
 	rdsRDWSTemplatePath   = "addons/aurora/rdws/cf.yml"
 	rdsV2RDWSTemplatePath = "addons/aurora/rdws/serverlessv2.yml"
 	rdsRDWSParamsPath     = "addons/aurora/rdws/addons.parameters.yml"
+	redisTemplatePath     = "addons/redis/cf.yml"
 
 	envS3TemplatePath                   = "addons/s3/env/cf.yml"
 	envS3AccessPolicyTemplatePath       = "addons/s3/env/access_policy.yml"
@@ -30,6 +32,11 @@ const (
 	envRDSForRDWSTemplatePath           = "addons/aurora/env/rdws/serverlessv2.yml"
 	envRDSIngressForRDWSTemplatePath    = "addons/aurora/env/rdws/ingress.yml"
 	envRDSIngressForRDWSParamsPath      = "addons/aurora/env/rdws/ingress.addons.parameters.yml"
+	envEFSTemplatePath                  = "addons/efs/env/cf.yml"
+	envEFSParamsPath                    = "addons/efs/env/addons.parameters.yml"
+	envEFSAccessPointTemplatePath       = "addons/efs/env/access_point.yml"
+	envRedisTemplatePath                = "addons/redis/env/cf.yml"
+	envRedisParamsPath                  = "addons/redis/env/addons.parameters.yml"
 )
 
 const (
@@ -101,11 +108,14 @@ func (s *S3Template) MarshalBinary() ([]byte, error) {
 // DynamoDBProps contains DynamoDB-specific properties.
 type DynamoDBProps struct {
 	*StorageProps
-	Attributes   []DDBAttribute
-	LSIs         []DDBLocalSecondaryIndex
-	SortKey      *string
-	PartitionKey *string
-	HasLSI       bool
+	Attributes     []DDBAttribute
+	LSIs           []DDBLocalSecondaryIndex
+	SortKey        *string
+	PartitionKey   *string
+	HasLSI         bool
+	GSIs           []DDBGlobalSecondaryIndex
+	TTLAttribute   string // The name of the attribute that holds the expiration time of the items.
+	StreamViewType string // The information written to the stream of the table, such as "NEW_AND_OLD_IMAGES".
 }
 
 // WorkloadDDBTemplate creates a marshaler for a workload-level DynamoDB addon specifying attributes,
@@ -200,6 +210,50 @@ func (p *DynamoDBProps) BuildLocalSecondaryIndex(noLSI bool, lsiSorts []string)
 	return true, nil
 }
 
+// BuildGlobalSecondaryIndex generates the GlobalSecondaryIndex property configuration based on customer input.
+// Each index is specified as "PartitionKey:T" or "PartitionKey:T,SortKey:T". The attributes of the indexes are
+// added to the attribute definitions if they are not already defined by the table's keys or local secondary indexes.
+func (p *DynamoDBProps) BuildGlobalSecondaryIndex(noGSI bool, gsis []string) (bool, error) {
+	if noGSI || len(gsis) == 0 {
+		return false, nil
+	}
+	for _, gsi := range gsis {
+		keys := strings.Split(gsi, ",")
+		if len(keys) > 2 {
+			return false, fmt.Errorf("parse global secondary index %s: must have at most a partition key and a sort key", gsi)
+		}
+		var attrs []DDBAttribute
+		for _, key := range keys {
+			attr, err := DDBAttributeFromKey(key)
+			if err != nil {
+				return false, err
+			}
+			attrs = append(attrs, attr)
+			p.addAttribute(attr)
+		}
+		index := DDBGlobalSecondaryIndex{
+			PartitionKey: attrs[0].Name,
+		}
+		name := aws.StringValue(attrs[0].Name)
+		if len(attrs) == 2 {
+			index.SortKey = attrs[1].Name
+			name += aws.StringValue(attrs[1].Name)
+		}
+		index.Name = aws.String(name + "Index")
+		p.GSIs = append(p.GSIs, index)
+	}
+	return true, nil
+}
+
+func (p *DynamoDBProps) addAttribute(attr DDBAttribute) {
+	for _, existing := range p.Attributes {
+		if aws.StringValue(existing.Name) == aws.StringValue(attr.Name) {
+			return
+		}
+	}
+	p.Attributes = append(p.Attributes, attr)
+}
+
 // DDBAttribute holds the attribute definition of a DynamoDB attribute (keys, local secondary indices).
 type DDBAttribute struct {
 	Name     *string
@@ -226,6 +280,13 @@ type DDBLocalSecondaryIndex struct {
 	Name         *string
 }
 
+// DDBGlobalSecondaryIndex holds a representation of a GSI.
+type DDBGlobalSecondaryIndex struct {
+	PartitionKey *string
+	SortKey      *string
+	Name         *string
+}
+
 // AccessPolicyProps holds properties to configure an access policy to an S3 or DDB storage.
 type AccessPolicyProps StorageProps
 
@@ -273,6 +334,8 @@ type RDSProps struct {
 	InitialDBName  string   // The name of the initial database created inside the cluster.
 	ParameterGroup string   // The parameter group to use for the cluster.
 	Envs           []string // The copilot environments found inside the current app.
+	MinCapacity    float64  // The minimum capacity of an Aurora Serverless v2 cluster in ACUs. Defaults to 0.5.
+	MaxCapacity    float64  // The maximum capacity of an Aurora Serverless v2 cluster in ACUs. Defaults to 8.
 }
 
 // WorkloadServerlessV1Template creates a marshaler for a workload-level Aurora Serverless v1 addon.
@@ -420,6 +483,162 @@ func (r *RDSParams) MarshalBinary() ([]byte, error) {
 	return content.Bytes(), nil
 }
 
+// EFSProps holds EFS-specific properties.
+type EFSProps struct {
+	*StorageProps
+	ThroughputMode string // The throughput mode of the file system, either "bursting" or "elastic".
+	TransitionToIA string // When files are moved to the Infrequent Access storage class, such as "AFTER_30_DAYS".
+	EnableBackup   bool   // Whether the file system is backed up automatically by AWS Backup.
+}
+
+// EnvEFSTemplate creates a marshaler for an environment-level EFS file system addon.
+func EnvEFSTemplate(input *EFSProps) *EFSTemplate {
+	return &EFSTemplate{
+		EFSProps: *input,
+		parser:   template.New(),
+		tmplPath: envEFSTemplatePath,
+	}
+}
+
+// EFSTemplate contains configuration options which fully describe an EFS file system.
+// Implements the encoding.BinaryMarshaler interface.
+type EFSTemplate struct {
+	EFSProps
+	parser   template.Parser
+	tmplPath string
+}
+
+// MarshalBinary serializes the content of the template into binary.
+func (t *EFSTemplate) MarshalBinary() ([]byte, error) {
+	content, err := t.parser.Parse(t.tmplPath, *t, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// EFSAccessPointProps holds properties to create the access point of a workload to an EFS file system.
+type EFSAccessPointProps struct {
+	Name         string // The name of the file system.
+	WorkloadName string // The name of the workload that owns the access point.
+	UID          uint32 // The POSIX user ID of the files created through the access point.
+	GID          uint32 // The POSIX group ID of the files created through the access point.
+}
+
+// EnvEFSAccessPointTemplate creates a marshaler for the access point of a workload to an environment-level EFS file system.
+// The access point restricts the workload to its own directory in the file system.
+func EnvEFSAccessPointTemplate(input EFSAccessPointProps) *EFSAccessPointTemplate {
+	return &EFSAccessPointTemplate{
+		EFSAccessPointProps: input,
+		parser:              template.New(),
+		tmplPath:            envEFSAccessPointTemplatePath,
+	}
+}
+
+// EFSAccessPointTemplate contains configuration options which describe an access point to an EFS file system.
+// Implements the encoding.BinaryMarshaler interface.
+type EFSAccessPointTemplate struct {
+	EFSAccessPointProps
+	parser   template.Parser
+	tmplPath string
+}
+
+// MarshalBinary serializes the content of the template into binary.
+func (t *EFSAccessPointTemplate) MarshalBinary() ([]byte, error) {
+	content, err := t.parser.Parse(t.tmplPath, *t, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// EnvParamsForEFS creates a parameter marshaler for an environment-level EFS addon.
+func EnvParamsForEFS() *EFSParams {
+	return &EFSParams{
+		parser:   template.New(),
+		tmplPath: envEFSParamsPath,
+	}
+}
+
+// EFSParams represents the addons.parameters.yml file for an EFS file system.
+type EFSParams struct {
+	parser   template.Parser
+	tmplPath string
+}
+
+// MarshalBinary serializes the content of the params file into binary.
+func (p *EFSParams) MarshalBinary() ([]byte, error) {
+	content, err := p.parser.Parse(p.tmplPath, *p, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// RedisProps holds Redis-specific properties.
+type RedisProps struct {
+	*StorageProps
+	Envs []string // The copilot environments found inside the current app.
+}
+
+// WorkloadRedisTemplate creates a marshaler for a workload-level ElastiCache for Redis addon.
+func WorkloadRedisTemplate(input *RedisProps) *RedisTemplate {
+	return &RedisTemplate{
+		RedisProps: *input,
+		parser:     template.New(),
+		tmplPath:   redisTemplatePath,
+	}
+}
+
+// EnvRedisTemplate creates a marshaler for an environment-level ElastiCache for Redis addon.
+func EnvRedisTemplate(input *RedisProps) *RedisTemplate {
+	return &RedisTemplate{
+		RedisProps: *input,
+		parser:     template.New(),
+		tmplPath:   envRedisTemplatePath,
+	}
+}
+
+// RedisTemplate contains configuration options which fully describe an ElastiCache for Redis replication group.
+// Implements the encoding.BinaryMarshaler interface.
+type RedisTemplate struct {
+	RedisProps
+	parser   template.Parser
+	tmplPath string
+}
+
+// MarshalBinary serializes the content of the template into binary.
+func (t *RedisTemplate) MarshalBinary() ([]byte, error) {
+	content, err := t.parser.Parse(t.tmplPath, *t, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// EnvParamsForRedis creates a parameter marshaler for an environment-level Redis addon.
+func EnvParamsForRedis() *RedisParams {
+	return &RedisParams{
+		parser:   template.New(),
+		tmplPath: envRedisParamsPath,
+	}
+}
+
+// RedisParams represents the addons.parameters.yml file for an ElastiCache for Redis replication group.
+type RedisParams struct {
+	parser   template.Parser
+	tmplPath string
+}
+
+// MarshalBinary serializes the content of the params file into binary.
+func (p *RedisParams) MarshalBinary() ([]byte, error) {
+	content, err := p.parser.Parse(p.tmplPath, *p, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
 func newLSI(partitionKey string, lsis []string) ([]DDBLocalSecondaryIndex, error) {
 	var output []DDBLocalSecondaryIndex
 	for _, lsi := range lsis {
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/golang/mock/gomock"
@@ -251,6 +252,141 @@ func TestRDSParams_MarshalBinary(t *testing.T) {
 	}
 }
 
+func TestEFSTemplate_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, efs *EFSTemplate)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mockDependencies: func(ctrl *gomock.Controller, efs *EFSTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				efs.parser = m
+				m.EXPECT().Parse("mockPath", *efs, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns rendered content": {
+			mockDependencies: func(ctrl *gomock.Controller, efs *EFSTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				efs.parser = m
+				m.EXPECT().Parse("mockPath", *efs, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("hello")}, nil)
+			},
+			wantedBinary: []byte("hello"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addon := &EFSTemplate{
+				tmplPath: "mockPath",
+			}
+			tc.mockDependencies(ctrl, addon)
+
+			// WHEN
+			b, err := addon.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
+func TestRedisTemplate_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, redis *RedisTemplate)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mockDependencies: func(ctrl *gomock.Controller, redis *RedisTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				redis.parser = m
+				m.EXPECT().Parse("mockPath", *redis, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns rendered content": {
+			mockDependencies: func(ctrl *gomock.Controller, redis *RedisTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				redis.parser = m
+				m.EXPECT().Parse("mockPath", *redis, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("hello")}, nil)
+			},
+			wantedBinary: []byte("hello"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addon := &RedisTemplate{
+				tmplPath: "mockPath",
+			}
+			tc.mockDependencies(ctrl, addon)
+
+			// WHEN
+			b, err := addon.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
+func TestEFSAccessPointTemplate_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(ctrl *gomock.Controller, ap *EFSAccessPointTemplate)
+
+		wantedBinary []byte
+		wantedError  error
+	}{
+		"error parsing template": {
+			mockDependencies: func(ctrl *gomock.Controller, ap *EFSAccessPointTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				ap.parser = m
+				m.EXPECT().Parse("mockPath", *ap, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns rendered content": {
+			mockDependencies: func(ctrl *gomock.Controller, ap *EFSAccessPointTemplate) {
+				m := mocks.NewMockParser(ctrl)
+				ap.parser = m
+				m.EXPECT().Parse("mockPath", *ap, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("hello")}, nil)
+			},
+			wantedBinary: []byte("hello"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			addon := &EFSAccessPointTemplate{
+				tmplPath: "mockPath",
+			}
+			tc.mockDependencies(ctrl, addon)
+
+			// WHEN
+			b, err := addon.MarshalBinary()
+
+			// THEN
+			require.Equal(t, tc.wantedError, err)
+			require.Equal(t, tc.wantedBinary, b)
+		})
+	}
+}
+
 func TestDDBAttributeFromKey(t *testing.T) {
 	testCases := map[string]struct {
 		input     string
@@ -517,6 +653,72 @@ func TestBuildLocalSecondaryIndex(t *testing.T) {
 	}
 }
 
+func TestDynamoDBProps_BuildGlobalSecondaryIndex(t *testing.T) {
+	testCases := map[string]struct {
+		inAttributes []DDBAttribute
+		inNoGSI      bool
+		inGSIs       []string
+
+		wantedHasGSI     bool
+		wantedGSIs       []DDBGlobalSecondaryIndex
+		wantedAttributes []DDBAttribute
+		wantedError      error
+	}{
+		"no GSI if noGSI specified": {
+			inNoGSI: true,
+			inGSIs:  []string{"status:S"},
+		},
+		"no GSI if length of GSIs is 0": {},
+		"error if the index has more than two keys": {
+			inGSIs:      []string{"status:S,createdAt:N,owner:S"},
+			wantedError: errors.New("parse global secondary index status:S,createdAt:N,owner:S: must have at most a partition key and a sort key"),
+		},
+		"error if a key is malformed": {
+			inGSIs:      []string{"status"},
+			wantedError: errors.New("parse attribute from key: status"),
+		},
+		"GSIs specified correctly without duplicating the attributes": {
+			inAttributes: []DDBAttribute{
+				{Name: aws.String("email"), DataType: aws.String("S")},
+			},
+			inGSIs:       []string{"status:S,email:S", "createdAt:n"},
+			wantedHasGSI: true,
+			wantedGSIs: []DDBGlobalSecondaryIndex{
+				{
+					Name:         aws.String("statusemailIndex"),
+					PartitionKey: aws.String("status"),
+					SortKey:      aws.String("email"),
+				},
+				{
+					Name:         aws.String("createdAtIndex"),
+					PartitionKey: aws.String("createdAt"),
+				},
+			},
+			wantedAttributes: []DDBAttribute{
+				{Name: aws.String("email"), DataType: aws.String("S")},
+				{Name: aws.String("status"), DataType: aws.String("S")},
+				{Name: aws.String("createdAt"), DataType: aws.String("N")},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			props := DynamoDBProps{
+				Attributes: tc.inAttributes,
+			}
+			got, err := props.BuildGlobalSecondaryIndex(tc.inNoGSI, tc.inGSIs)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedHasGSI, got)
+				require.Equal(t, tc.wantedGSIs, props.GSIs)
+				require.Equal(t, tc.wantedAttributes, props.Attributes)
+			}
+		})
+	}
+}
+
 func TestConstructors(t *testing.T) {
 	t.Run("marshaler for workload-level S3", func(t *testing.T) {
 		out := WorkloadS3Template(&S3Props{})
@@ -597,4 +799,34 @@ func TestConstructors(t *testing.T) {
 		out := EnvServerlessRDWSIngressTemplate(RDSIngressProps{})
 		require.Equal(t, envRDSIngressForRDWSTemplatePath, out.tmplPath)
 	})
+
+	t.Run("marshaler for env-level efs", func(t *testing.T) {
+		out := EnvEFSTemplate(&EFSProps{})
+		require.Equal(t, envEFSTemplatePath, out.tmplPath)
+	})
+
+	t.Run("parameter marshaler for env-level efs", func(t *testing.T) {
+		out := EnvParamsForEFS()
+		require.Equal(t, envEFSParamsPath, out.tmplPath)
+	})
+
+	t.Run("marshaler for the access point of a workload to an env-level efs", func(t *testing.T) {
+		out := EnvEFSAccessPointTemplate(EFSAccessPointProps{})
+		require.Equal(t, envEFSAccessPointTemplatePath, out.tmplPath)
+	})
+
+	t.Run("marshaler for workload-level redis", func(t *testing.T) {
+		out := WorkloadRedisTemplate(&RedisProps{})
+		require.Equal(t, redisTemplatePath, out.tmplPath)
+	})
+
+	t.Run("marshaler for env-level redis", func(t *testing.T) {
+		out := EnvRedisTemplate(&RedisProps{})
+		require.Equal(t, envRedisTemplatePath, out.tmplPath)
+	})
+
+	t.Run("parameter marshaler for env-level redis", func(t *testing.T) {
+		out := EnvParamsForRedis()
+		require.Equal(t, envRedisParamsPath, out.tmplPath)
+	})
 }
@@ -19,8 +19,17 @@ const (
 	ecsServiceNamespace = "ecs"
 )
 
+const ecsDesiredCountDimension = "ecs:service:DesiredCount"
+
 type api interface {
 	DescribeScalingPolicies(input *aas.DescribeScalingPoliciesInput) (*aas.DescribeScalingPoliciesOutput, error)
+	DescribeScalableTargets(input *aas.DescribeScalableTargetsInput) (*aas.DescribeScalableTargetsOutput, error)
+}
+
+// Capacity is the range of the number of tasks that autoscaling keeps a service in.
+type Capacity struct {
+	Min int64
+	Max int64
 }
 
 // ApplicationAutoscaling wraps an Amazon Application Auto Scaling client.
@@ -62,3 +71,24 @@ func (a *ApplicationAutoscaling) ECSServiceAlarmNames(cluster, service string) (
 	}
 	return alarms, nil
 }
+
+// ECSServiceCapacity returns the range of the desired count that autoscaling keeps the ECS service in,
+// or nil if the service doesn't autoscale.
+func (a *ApplicationAutoscaling) ECSServiceCapacity(cluster, service string) (*Capacity, error) {
+	out, err := a.client.DescribeScalableTargets(&aas.DescribeScalableTargetsInput{
+		ResourceIds:       aws.StringSlice([]string{fmt.Sprintf(fmtECSResourceID, cluster, service)}),
+		ScalableDimension: aws.String(ecsDesiredCountDimension),
+		ServiceNamespace:  aws.String(ecsServiceNamespace),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe scalable targets for ECS service %s/%s: %w", cluster, service, err)
+	}
+	if len(out.ScalableTargets) == 0 {
+		return nil, nil
+	}
+	target := out.ScalableTargets[0]
+	return &Capacity{
+		Min: aws.Int64Value(target.MinCapacity),
+		Max: aws.Int64Value(target.MaxCapacity),
+	}, nil
+}
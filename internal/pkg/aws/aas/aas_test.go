@@ -139,3 +139,64 @@ func TestCloudWatch_ECSServiceAutoscalingAlarms(t *testing.T) {
 
 	}
 }
+
+func TestApplicationAutoscaling_ECSServiceCapacity(t *testing.T) {
+	wantedInput := &aas.DescribeScalableTargetsInput{
+		ResourceIds:       aws.StringSlice([]string{"service/mockCluster/mockService"}),
+		ScalableDimension: aws.String(ecsDesiredCountDimension),
+		ServiceNamespace:  aws.String(ecsServiceNamespace),
+	}
+	testCases := map[string]struct {
+		setupMocks func(m aasMocks)
+
+		wanted    *Capacity
+		wantedErr error
+	}{
+		"errors if failed to describe the scalable targets": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(wantedInput).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe scalable targets for ECS service mockCluster/mockService: some error"),
+		},
+		"nil if the service doesn't autoscale": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(wantedInput).Return(&aas.DescribeScalableTargetsOutput{}, nil)
+			},
+		},
+		"success": {
+			setupMocks: func(m aasMocks) {
+				m.client.EXPECT().DescribeScalableTargets(wantedInput).Return(&aas.DescribeScalableTargetsOutput{
+					ScalableTargets: []*aas.ScalableTarget{
+						{
+							MinCapacity: aws.Int64(2),
+							MaxCapacity: aws.Int64(10),
+						},
+					},
+				}, nil)
+			},
+			wanted: &Capacity{Min: 2, Max: 10},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(aasMocks{client: mockClient})
+			client := ApplicationAutoscaling{client: mockClient}
+
+			// WHEN
+			got, err := client.ECSServiceCapacity("mockCluster", "mockService")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
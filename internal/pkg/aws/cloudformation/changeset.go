@@ -111,6 +111,9 @@ func (cs *changeSet) create(conf *stackConfig) error {
 	if conf.TemplateURL != "" {
 		input.TemplateURL = aws.String(conf.TemplateURL)
 	}
+	if conf.UsePreviousTemplate {
+		input.UsePreviousTemplate = aws.Bool(true)
+	}
 
 	out, err := cs.client.CreateChangeSet(input)
 	if err != nil {
@@ -31,6 +31,9 @@ var waiters = []request.WaiterOption{
 	request.WithWaiterMaxAttempts(1080),                                   // Wait for at most 90 mins for any cfn action.
 }
 
+// driftDetectionPollDelay is how long to wait in between polls for the status of a drift detection.
+var driftDetectionPollDelay = 5 * time.Second
+
 // CloudFormation represents a client to make requests to AWS CloudFormation.
 type CloudFormation struct {
 	client
@@ -119,6 +122,79 @@ func (c *CloudFormation) Update(stack *Stack) (changeSetID string, err error) {
 	return c.update(stack)
 }
 
+// UpdateTags updates the tags of an existing stack while keeping its template and parameter values,
+// so that CloudFormation propagates the tags to the resources of the stack.
+// If the stack already has the tags, deletes the empty change set and returns ErrChangeSetEmpty.
+func (c *CloudFormation) UpdateTags(stackName string, tags map[string]string) (changeSetID string, err error) {
+	descr, err := c.Describe(stackName)
+	if err != nil {
+		return "", err
+	}
+	status := StackStatus(aws.StringValue(descr.StackStatus))
+	if status.InProgress() {
+		return "", &ErrStackUpdateInProgress{
+			Name: stackName,
+		}
+	}
+	params := make([]*cloudformation.Parameter, len(descr.Parameters))
+	for i, param := range descr.Parameters {
+		params[i] = &cloudformation.Parameter{
+			ParameterKey:     param.ParameterKey,
+			UsePreviousValue: aws.Bool(true),
+		}
+	}
+	s := &Stack{
+		Name: stackName,
+		stackConfig: &stackConfig{
+			UsePreviousTemplate: true,
+			Parameters:          params,
+			RoleARN:             descr.RoleARN,
+		},
+	}
+	WithTags(tags)(s)
+	return c.update(s)
+}
+
+// UpdateParameters updates the values of the parameters in params of an existing stack while keeping its template
+// and the values of its other parameters.
+// If the stack already has the values, deletes the empty change set and returns ErrChangeSetEmpty.
+func (c *CloudFormation) UpdateParameters(stackName string, params map[string]string) (changeSetID string, err error) {
+	descr, err := c.Describe(stackName)
+	if err != nil {
+		return "", err
+	}
+	status := StackStatus(aws.StringValue(descr.StackStatus))
+	if status.InProgress() {
+		return "", &ErrStackUpdateInProgress{
+			Name: stackName,
+		}
+	}
+	updated := make([]*cloudformation.Parameter, len(descr.Parameters))
+	for i, param := range descr.Parameters {
+		val, ok := params[aws.StringValue(param.ParameterKey)]
+		if !ok {
+			updated[i] = &cloudformation.Parameter{
+				ParameterKey:     param.ParameterKey,
+				UsePreviousValue: aws.Bool(true),
+			}
+			continue
+		}
+		updated[i] = &cloudformation.Parameter{
+			ParameterKey:   param.ParameterKey,
+			ParameterValue: aws.String(val),
+		}
+	}
+	return c.update(&Stack{
+		Name: stackName,
+		stackConfig: &stackConfig{
+			UsePreviousTemplate: true,
+			Parameters:          updated,
+			Tags:                descr.Tags,
+			RoleARN:             descr.RoleARN,
+		},
+	})
+}
+
 // UpdateAndWait calls Update and then blocks until the stack is updated or until the max attempt window expires.
 func (c *CloudFormation) UpdateAndWait(stack *Stack) error {
 	if _, err := c.Update(stack); err != nil {
@@ -283,11 +359,15 @@ func (c *CloudFormation) Events(stackName string) ([]StackEvent, error) {
 }
 
 // StackResources returns the list of resources created as part of a CloudFormation stack.
+// If the stack does not exist, returns ErrStackNotFound.
 func (c *CloudFormation) StackResources(name string) ([]*StackResource, error) {
 	out, err := c.DescribeStackResources(&cloudformation.DescribeStackResourcesInput{
 		StackName: aws.String(name),
 	})
 	if err != nil {
+		if stackDoesNotExist(err) {
+			return nil, &ErrStackNotFound{name: name}
+		}
 		return nil, fmt.Errorf("describe resources for stack %s: %w", name, err)
 	}
 	var resources []*StackResource
@@ -386,6 +466,69 @@ func (c *CloudFormation) CancelUpdateStack(stackName string) error {
 	return nil
 }
 
+// DetectDrift detects drift on the stack and waits until the detection completes.
+// It returns the resources whose actual configuration differs from the expected one, or that were deleted.
+// If the stack does not exist, returns ErrStackNotFound.
+func (c *CloudFormation) DetectDrift(ctx context.Context, stackName string) ([]StackResourceDrift, error) {
+	out, err := c.client.DetectStackDrift(&cloudformation.DetectStackDriftInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		if stackDoesNotExist(err) {
+			return nil, &ErrStackNotFound{name: stackName}
+		}
+		return nil, fmt.Errorf("detect drift of stack %s: %w", stackName, err)
+	}
+	if err := c.waitForDriftDetection(ctx, stackName, aws.StringValue(out.StackDriftDetectionId)); err != nil {
+		return nil, err
+	}
+	var nextToken *string
+	var drifts []StackResourceDrift
+	for {
+		out, err := c.client.DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+			NextToken: nextToken,
+			StackName: aws.String(stackName),
+			StackResourceDriftStatusFilters: aws.StringSlice([]string{
+				cloudformation.StackResourceDriftStatusModified,
+				cloudformation.StackResourceDriftStatusDeleted,
+			}),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe resource drifts of stack %s: %w", stackName, err)
+		}
+		for _, drift := range out.StackResourceDrifts {
+			drifts = append(drifts, StackResourceDrift(*drift))
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return drifts, nil
+}
+
+func (c *CloudFormation) waitForDriftDetection(ctx context.Context, stackName, detectionID string) error {
+	for {
+		out, err := c.client.DescribeStackDriftDetectionStatus(&cloudformation.DescribeStackDriftDetectionStatusInput{
+			StackDriftDetectionId: aws.String(detectionID),
+		})
+		if err != nil {
+			return fmt.Errorf("describe drift detection status of stack %s: %w", stackName, err)
+		}
+		switch aws.StringValue(out.DetectionStatus) {
+		case cloudformation.StackDriftDetectionStatusDetectionComplete:
+			return nil
+		case cloudformation.StackDriftDetectionStatusDetectionFailed:
+			return fmt.Errorf("detect drift of stack %s: %s", stackName, aws.StringValue(out.DetectionStatusReason))
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for drift detection of stack %s: %w", stackName, ctx.Err())
+		case <-time.After(driftDetectionPollDelay):
+		}
+	}
+}
+
 func (c *CloudFormation) create(stack *Stack) (string, error) {
 	cs, err := newCreateChangeSet(c.client, stack.Name)
 	if err != nil {
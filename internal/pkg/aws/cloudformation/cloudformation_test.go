@@ -614,6 +614,189 @@ func TestCloudFormation_Update(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_UpdateTags(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) client
+		wantedErr  error
+	}{
+		"fail if the stack is already in progress": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{{StackStatus: aws.String(cloudformation.StackStatusUpdateInProgress)}},
+				}, nil)
+				return m
+			},
+			wantedErr: &ErrStackUpdateInProgress{
+				Name: "id",
+			},
+		},
+		"update the tags with the previous template and parameter values": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusUpdateComplete),
+							RoleARN:     aws.String("arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole"),
+							Parameters: []*cloudformation.Parameter{
+								{ParameterKey: aws.String("TaskCount"), ParameterValue: aws.String("2")},
+							},
+						},
+					},
+				}, nil)
+				m.EXPECT().CreateChangeSet(&cloudformation.CreateChangeSetInput{
+					ChangeSetName:       aws.String(mockChangeSetName),
+					StackName:           aws.String("id"),
+					ChangeSetType:       aws.String("UPDATE"),
+					UsePreviousTemplate: aws.Bool(true),
+					Parameters: []*cloudformation.Parameter{
+						{ParameterKey: aws.String("TaskCount"), UsePreviousValue: aws.Bool(true)},
+					},
+					Tags: []*cloudformation.Tag{
+						{Key: aws.String("team"), Value: aws.String("payments")},
+					},
+					RoleARN:             aws.String("arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole"),
+					IncludeNestedStacks: aws.Bool(true),
+					Capabilities: aws.StringSlice([]string{
+						cloudformation.CapabilityCapabilityIam,
+						cloudformation.CapabilityCapabilityNamedIam,
+						cloudformation.CapabilityCapabilityAutoExpand,
+					}),
+				}).Return(&cloudformation.CreateChangeSetOutput{
+					Id: aws.String(mockChangeSetName),
+				}, nil)
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+				}, nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Return(nil, nil)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			seed := bytes.NewBufferString("12345678901233456789") // always generate the same UUID
+			uuid.SetRand(seed)
+			defer uuid.SetRand(nil)
+
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			id, err := c.UpdateTags("id", map[string]string{"team": "payments"})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, mockChangeSetName, id)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_UpdateParameters(t *testing.T) {
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) client
+		wantedErr  error
+	}{
+		"fail if the stack is already in progress": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{{StackStatus: aws.String(cloudformation.StackStatusUpdateInProgress)}},
+				}, nil)
+				return m
+			},
+			wantedErr: &ErrStackUpdateInProgress{
+				Name: "id",
+			},
+		},
+		"update the parameters with the previous template and the other parameter values": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{
+						{
+							StackStatus: aws.String(cloudformation.StackStatusUpdateComplete),
+							RoleARN:     aws.String("arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole"),
+							Parameters: []*cloudformation.Parameter{
+								{ParameterKey: aws.String("TaskCount"), ParameterValue: aws.String("2")},
+								{ParameterKey: aws.String("AddonsTemplateURL"), ParameterValue: aws.String("old.yml")},
+							},
+							Tags: []*cloudformation.Tag{
+								{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+							},
+						},
+					},
+				}, nil)
+				m.EXPECT().CreateChangeSet(&cloudformation.CreateChangeSetInput{
+					ChangeSetName:       aws.String(mockChangeSetName),
+					StackName:           aws.String("id"),
+					ChangeSetType:       aws.String("UPDATE"),
+					UsePreviousTemplate: aws.Bool(true),
+					Parameters: []*cloudformation.Parameter{
+						{ParameterKey: aws.String("TaskCount"), UsePreviousValue: aws.Bool(true)},
+						{ParameterKey: aws.String("AddonsTemplateURL"), ParameterValue: aws.String("new.yml")},
+					},
+					Tags: []*cloudformation.Tag{
+						{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+					},
+					RoleARN:             aws.String("arn:aws:iam::123456789012:role/phonetool-test-CFNExecutionRole"),
+					IncludeNestedStacks: aws.Bool(true),
+					Capabilities: aws.StringSlice([]string{
+						cloudformation.CapabilityCapabilityIam,
+						cloudformation.CapabilityCapabilityNamedIam,
+						cloudformation.CapabilityCapabilityAutoExpand,
+					}),
+				}).Return(&cloudformation.CreateChangeSetOutput{
+					Id: aws.String(mockChangeSetName),
+				}, nil)
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().DescribeChangeSet(gomock.Any()).Return(&cloudformation.DescribeChangeSetOutput{
+					ExecutionStatus: aws.String(cloudformation.ExecutionStatusAvailable),
+				}, nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Return(nil, nil)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			seed := bytes.NewBufferString("12345678901233456789") // always generate the same UUID
+			uuid.SetRand(seed)
+			defer uuid.SetRand(nil)
+
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			id, err := c.UpdateParameters("id", map[string]string{"AddonsTemplateURL": "new.yml"})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, mockChangeSetName, id)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_UpdateAndWait(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) client
@@ -1274,6 +1457,14 @@ func TestStackDescriber_StackResources(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("describe resources for stack phonetool-test-api: some error"),
 		},
+		"return ErrStackNotFound if the stack does not exist": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStackResources(gomock.Any()).Return(nil, errDoesNotExist)
+				return m
+			},
+			wantedError: &ErrStackNotFound{name: "phonetool-test-api"},
+		},
 		"returns type-casted stack resources on success": {
 			createMock: func(ctrl *gomock.Controller) client {
 				m := mocks.NewMockclient(ctrl)
@@ -1613,3 +1804,119 @@ func addDeployCalls(m *mocks.Mockclient, changeSetType string) {
 		StackName:     aws.String(mockStack.Name),
 	})
 }
+
+func TestCloudFormation_DetectDrift(t *testing.T) {
+	driftDetectionPollDelay = 0
+	mockDrift := &cloudformation.StackResourceDrift{
+		LogicalResourceId:        aws.String("PublicLoadBalancer"),
+		ResourceType:             aws.String("AWS::ElasticLoadBalancingV2::LoadBalancer"),
+		StackResourceDriftStatus: aws.String(cloudformation.StackResourceDriftStatusModified),
+	}
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) client
+
+		wantedDrifts []StackResourceDrift
+		wantedErr    error
+	}{
+		"return ErrStackNotFound if the stack does not exist": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDrift(&cloudformation.DetectStackDriftInput{
+					StackName: aws.String("phonetool-test"),
+				}).Return(nil, errDoesNotExist)
+				return m
+			},
+			wantedErr: &ErrStackNotFound{name: "phonetool-test"},
+		},
+		"return a wrapped error if the detection fails": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String("1234"),
+				}, nil)
+				m.EXPECT().DescribeStackDriftDetectionStatus(&cloudformation.DescribeStackDriftDetectionStatusInput{
+					StackDriftDetectionId: aws.String("1234"),
+				}).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus:       aws.String(cloudformation.StackDriftDetectionStatusDetectionFailed),
+					DetectionStatusReason: aws.String("some reason"),
+				}, nil)
+				return m
+			},
+			wantedErr: errors.New("detect drift of stack phonetool-test: some reason"),
+		},
+		"return a wrapped error if fail to describe the resource drifts": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DetectStackDrift(gomock.Any()).Return(&cloudformation.DetectStackDriftOutput{
+					StackDriftDetectionId: aws.String("1234"),
+				}, nil)
+				m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+					DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionComplete),
+				}, nil)
+				m.EXPECT().DescribeStackResourceDrifts(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("describe resource drifts of stack phonetool-test: some error"),
+		},
+		"wait for the detection to complete and return the drifted resources": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				gomock.InOrder(
+					m.EXPECT().DetectStackDrift(gomock.Any()).Return(&cloudformation.DetectStackDriftOutput{
+						StackDriftDetectionId: aws.String("1234"),
+					}, nil),
+					m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+						DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionInProgress),
+					}, nil),
+					m.EXPECT().DescribeStackDriftDetectionStatus(gomock.Any()).Return(&cloudformation.DescribeStackDriftDetectionStatusOutput{
+						DetectionStatus: aws.String(cloudformation.StackDriftDetectionStatusDetectionComplete),
+					}, nil),
+					m.EXPECT().DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+						StackName: aws.String("phonetool-test"),
+						StackResourceDriftStatusFilters: aws.StringSlice([]string{
+							cloudformation.StackResourceDriftStatusModified,
+							cloudformation.StackResourceDriftStatusDeleted,
+						}),
+					}).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+						StackResourceDrifts: []*cloudformation.StackResourceDrift{mockDrift},
+						NextToken:           aws.String("abcd"),
+					}, nil),
+					m.EXPECT().DescribeStackResourceDrifts(&cloudformation.DescribeStackResourceDriftsInput{
+						NextToken: aws.String("abcd"),
+						StackName: aws.String("phonetool-test"),
+						StackResourceDriftStatusFilters: aws.StringSlice([]string{
+							cloudformation.StackResourceDriftStatusModified,
+							cloudformation.StackResourceDriftStatusDeleted,
+						}),
+					}).Return(&cloudformation.DescribeStackResourceDriftsOutput{
+						StackResourceDrifts: []*cloudformation.StackResourceDrift{mockDrift},
+					}, nil),
+				)
+				return m
+			},
+			wantedDrifts: []StackResourceDrift{StackResourceDrift(*mockDrift), StackResourceDrift(*mockDrift)},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			drifts, err := c.DetectDrift(context.Background(), "phonetool-test")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDrifts, drifts)
+			}
+		})
+	}
+}
@@ -18,6 +18,8 @@ type Double struct {
 	WaitForCreateFn             func(ctx context.Context, stackName string) error
 	UpdateFn                    func(stack *cfn.Stack) (string, error)
 	UpdateAndWaitFn             func(stack *cfn.Stack) error
+	UpdateTagsFn                func(stackName string, tags map[string]string) (string, error)
+	UpdateParametersFn          func(stackName string, params map[string]string) (string, error)
 	WaitForUpdateFn             func(ctx context.Context, stackName string) error
 	DeleteFn                    func(stackName string) error
 	DeleteAndWaitFn             func(stackName string) error
@@ -66,6 +68,16 @@ func (d *Double) UpdateAndWait(stack *cfn.Stack) error {
 	return d.UpdateAndWaitFn(stack)
 }
 
+// UpdateTags calls the stubbed function.
+func (d *Double) UpdateTags(stackName string, tags map[string]string) (string, error) {
+	return d.UpdateTagsFn(stackName, tags)
+}
+
+// UpdateParameters calls the stubbed function.
+func (d *Double) UpdateParameters(stackName string, params map[string]string) (string, error) {
+	return d.UpdateParametersFn(stackName, params)
+}
+
 // WaitForUpdate calls the stubbed function.
 func (d *Double) WaitForUpdate(ctx context.Context, stackName string) error {
 	return d.WaitForUpdateFn(ctx, stackName)
@@ -15,12 +15,13 @@ type Stack struct {
 }
 
 type stackConfig struct {
-	TemplateBody    string
-	TemplateURL     string
-	Parameters      []*cloudformation.Parameter
-	Tags            []*cloudformation.Tag
-	RoleARN         *string
-	DisableRollback bool
+	TemplateBody        string
+	TemplateURL         string
+	UsePreviousTemplate bool
+	Parameters          []*cloudformation.Parameter
+	Tags                []*cloudformation.Tag
+	RoleARN             *string
+	DisableRollback     bool
 }
 
 // StackOption allows you to initialize a Stack with additional properties.
@@ -105,6 +106,9 @@ type StackDescription cloudformation.Stack
 // StackResource is an alias the SDK's StackResource type.
 type StackResource cloudformation.StackResource
 
+// StackResourceDrift is an alias the SDK's StackResourceDrift type.
+type StackResourceDrift cloudformation.StackResourceDrift
+
 // SDK returns the underlying struct from the AWS SDK.
 func (d *StackDescription) SDK() *cloudformation.Stack {
 	raw := cloudformation.Stack(*d)
@@ -1,7 +1,18 @@
 // Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
 // SPDX-License-Identifier: Apache-2.0
+
+// Package cloudfront provides a client to make API requests to Amazon CloudFront.
 package cloudfront
 
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+)
+
 const (
 	// CertRegion is the only AWS region accepted by CloudFront while attaching certificates to a distribution.
 	CertRegion = "us-east-1"
@@ -10,3 +21,69 @@ const (
 	// See https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/distribution-web-values-specify.html#DownloadDistValuesDomainName
 	S3BucketOriginDomainFormat = `.+\.s3.*\.\w+-\w+-\d+\.amazonaws\.com`
 )
+
+// InvalidationStatusCompleted is the status of an invalidation whose files are removed from the edge caches.
+const InvalidationStatusCompleted = "Completed"
+
+type api interface {
+	ListInvalidations(input *cloudfront.ListInvalidationsInput) (*cloudfront.ListInvalidationsOutput, error)
+	GetInvalidation(input *cloudfront.GetInvalidationInput) (*cloudfront.GetInvalidationOutput, error)
+}
+
+// Invalidation holds the description of an invalidation of the files cached by a distribution.
+type Invalidation struct {
+	ID         string
+	Status     string
+	CreateTime time.Time
+}
+
+// CloudFront wraps an AWS CloudFront client.
+type CloudFront struct {
+	client api
+}
+
+// New returns CloudFront configured against the input session.
+func New(s *session.Session) *CloudFront {
+	return &CloudFront{
+		client: cloudfront.New(s),
+	}
+}
+
+// LatestInvalidation returns the most recent invalidation of the distribution, or nil if it has none.
+func (c *CloudFront) LatestInvalidation(distributionID string) (*Invalidation, error) {
+	out, err := c.client.ListInvalidations(&cloudfront.ListInvalidationsInput{
+		DistributionId: aws.String(distributionID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list invalidations of distribution %s: %w", distributionID, err)
+	}
+	if out.InvalidationList == nil {
+		return nil, nil
+	}
+	var latest *Invalidation
+	// Invalidations are listed from the most recent, but the order isn't documented.
+	for _, summary := range out.InvalidationList.Items {
+		created := aws.TimeValue(summary.CreateTime)
+		if latest != nil && !created.After(latest.CreateTime) {
+			continue
+		}
+		latest = &Invalidation{
+			ID:         aws.StringValue(summary.Id),
+			Status:     aws.StringValue(summary.Status),
+			CreateTime: created,
+		}
+	}
+	return latest, nil
+}
+
+// InvalidationStatus returns the status of an invalidation of the distribution, either "InProgress" or "Completed".
+func (c *CloudFront) InvalidationStatus(distributionID, invalidationID string) (string, error) {
+	out, err := c.client.GetInvalidation(&cloudfront.GetInvalidationInput{
+		DistributionId: aws.String(distributionID),
+		Id:             aws.String(invalidationID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get invalidation %s of distribution %s: %w", invalidationID, distributionID, err)
+	}
+	return aws.StringValue(out.Invalidation.Status), nil
+}
@@ -0,0 +1,94 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudfront
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudfront/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudFront_LatestInvalidation(t *testing.T) {
+	now := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wanted    *Invalidation
+		wantedErr string
+	}{
+		"error if invalidations can't be listed": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListInvalidations(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "list invalidations of distribution E123: some error",
+		},
+		"nil if the distribution was never invalidated": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListInvalidations(gomock.Any()).Return(&cloudfront.ListInvalidationsOutput{
+					InvalidationList: &cloudfront.InvalidationList{},
+				}, nil)
+			},
+		},
+		"most recent invalidation": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListInvalidations(&cloudfront.ListInvalidationsInput{
+					DistributionId: aws.String("E123"),
+				}).Return(&cloudfront.ListInvalidationsOutput{
+					InvalidationList: &cloudfront.InvalidationList{
+						Items: []*cloudfront.InvalidationSummary{
+							{Id: aws.String("I1"), Status: aws.String("Completed"), CreateTime: aws.Time(now.Add(-time.Hour))},
+							{Id: aws.String("I2"), Status: aws.String("InProgress"), CreateTime: aws.Time(now)},
+							{Id: aws.String("I3"), Status: aws.String("Completed"), CreateTime: aws.Time(now.Add(-time.Minute))},
+						},
+					},
+				}, nil)
+			},
+			wanted: &Invalidation{ID: "I2", Status: "InProgress", CreateTime: now},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			cf := CloudFront{client: m}
+
+			got, err := cf.LatestInvalidation("E123")
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestCloudFront_InvalidationStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockapi(ctrl)
+	m.EXPECT().GetInvalidation(&cloudfront.GetInvalidationInput{
+		DistributionId: aws.String("E123"),
+		Id:             aws.String("I1"),
+	}).Return(&cloudfront.GetInvalidationOutput{
+		Invalidation: &cloudfront.Invalidation{Status: aws.String("Completed")},
+	}, nil)
+	m.EXPECT().GetInvalidation(gomock.Any()).Return(nil, errors.New("some error"))
+	cf := CloudFront{client: m}
+
+	status, err := cf.InvalidationStatus("E123", "I1")
+	require.NoError(t, err)
+	require.Equal(t, InvalidationStatusCompleted, status)
+
+	_, err = cf.InvalidationStatus("E123", "I2")
+	require.EqualError(t, err, "get invalidation I2 of distribution E123: some error")
+}
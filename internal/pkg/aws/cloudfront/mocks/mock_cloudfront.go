@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/aws/cloudfront/cloudfront.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	cloudfront "github.com/aws/aws-sdk-go/service/cloudfront"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// Mockapi is a mock of api interface.
+type Mockapi struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiMockRecorder
+}
+
+// MockapiMockRecorder is the mock recorder for Mockapi.
+type MockapiMockRecorder struct {
+	mock *Mockapi
+}
+
+// NewMockapi creates a new mock instance.
+func NewMockapi(ctrl *gomock.Controller) *Mockapi {
+	mock := &Mockapi{ctrl: ctrl}
+	mock.recorder = &MockapiMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockapi) EXPECT() *MockapiMockRecorder {
+	return m.recorder
+}
+
+// GetInvalidation mocks base method.
+func (m *Mockapi) GetInvalidation(input *cloudfront.GetInvalidationInput) (*cloudfront.GetInvalidationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvalidation", input)
+	ret0, _ := ret[0].(*cloudfront.GetInvalidationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvalidation indicates an expected call of GetInvalidation.
+func (mr *MockapiMockRecorder) GetInvalidation(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvalidation", reflect.TypeOf((*Mockapi)(nil).GetInvalidation), input)
+}
+
+// ListInvalidations mocks base method.
+func (m *Mockapi) ListInvalidations(input *cloudfront.ListInvalidationsInput) (*cloudfront.ListInvalidationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvalidations", input)
+	ret0, _ := ret[0].(*cloudfront.ListInvalidationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvalidations indicates an expected call of ListInvalidations.
+func (mr *MockapiMockRecorder) ListInvalidations(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvalidations", reflect.TypeOf((*Mockapi)(nil).ListInvalidations), input)
+}
@@ -6,6 +6,7 @@ package cloudwatch
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -29,6 +30,9 @@ var humanizeDuration = humanize.RelTime
 
 type api interface {
 	DescribeAlarms(input *cloudwatch.DescribeAlarmsInput) (*cloudwatch.DescribeAlarmsOutput, error)
+	GetMetricStatistics(input *cloudwatch.GetMetricStatisticsInput) (*cloudwatch.GetMetricStatisticsOutput, error)
+	ListMetrics(input *cloudwatch.ListMetricsInput) (*cloudwatch.ListMetricsOutput, error)
+	PutDashboard(input *cloudwatch.PutDashboardInput) (*cloudwatch.PutDashboardOutput, error)
 }
 
 type resourceGetter interface {
@@ -225,6 +229,142 @@ func (cw *CloudWatch) metricAlarmsStatus(alarms []*cloudwatch.MetricAlarm) []Ala
 	return alarmStatusList
 }
 
+// MetricQuery identifies a metric and the time range of its datapoints.
+type MetricQuery struct {
+	Namespace  string
+	Name       string
+	Dimensions map[string]string
+	StartTime  time.Time
+	EndTime    time.Time // The duration since StartTime must be a multiple of 60 seconds.
+}
+
+// MetricMaximum returns the maximum of the datapoints of a metric within the time range of the query.
+// It returns nil if the metric has no datapoint in the time range.
+func (cw *CloudWatch) MetricMaximum(q MetricQuery) (*float64, error) {
+	datapoints, err := cw.metricDatapoints(q, cloudwatch.StatisticMaximum)
+	if err != nil {
+		return nil, err
+	}
+	var max *float64
+	for _, datapoint := range datapoints {
+		if datapoint.Maximum == nil {
+			continue
+		}
+		if max == nil || aws.Float64Value(datapoint.Maximum) > aws.Float64Value(max) {
+			max = datapoint.Maximum
+		}
+	}
+	return max, nil
+}
+
+// MetricSum returns the sum of the datapoints of a metric within the time range of the query.
+// It returns nil if the metric has no datapoint in the time range.
+func (cw *CloudWatch) MetricSum(q MetricQuery) (*float64, error) {
+	datapoints, err := cw.metricDatapoints(q, cloudwatch.StatisticSum)
+	if err != nil {
+		return nil, err
+	}
+	var sum *float64
+	for _, datapoint := range datapoints {
+		if datapoint.Sum == nil {
+			continue
+		}
+		sum = aws.Float64(aws.Float64Value(sum) + aws.Float64Value(datapoint.Sum))
+	}
+	return sum, nil
+}
+
+// MetricAverage returns the average of the datapoints of a metric within the time range of the query.
+// It returns nil if the metric has no datapoint in the time range.
+func (cw *CloudWatch) MetricAverage(q MetricQuery) (*float64, error) {
+	datapoints, err := cw.metricDatapoints(q, cloudwatch.StatisticAverage)
+	if err != nil {
+		return nil, err
+	}
+	var total float64
+	var count int
+	for _, datapoint := range datapoints {
+		if datapoint.Average == nil {
+			continue
+		}
+		total += aws.Float64Value(datapoint.Average)
+		count++
+	}
+	if count == 0 {
+		return nil, nil
+	}
+	return aws.Float64(total / float64(count)), nil
+}
+
+// metricDatapoints returns the datapoints of a metric aggregated over the whole time range of the query.
+func (cw *CloudWatch) metricDatapoints(q MetricQuery, statistic string) ([]*cloudwatch.Datapoint, error) {
+	out, err := cw.client.GetMetricStatistics(&cloudwatch.GetMetricStatisticsInput{
+		Namespace:  aws.String(q.Namespace),
+		MetricName: aws.String(q.Name),
+		Dimensions: toDimensions(q.Dimensions),
+		StartTime:  aws.Time(q.StartTime),
+		EndTime:    aws.Time(q.EndTime),
+		Period:     aws.Int64(int64(q.EndTime.Sub(q.StartTime).Seconds())),
+		Statistics: aws.StringSlice([]string{statistic}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get statistics of metric %s in namespace %s: %w", q.Name, q.Namespace, err)
+	}
+	return out.Datapoints, nil
+}
+
+// MetricDimensions returns the dimensions of the metrics with the name in the namespace that were active
+// in the last three hours, and whose dimensions include all the filters.
+func (cw *CloudWatch) MetricDimensions(namespace, name string, filters map[string]string) ([]map[string]string, error) {
+	in := &cloudwatch.ListMetricsInput{
+		Namespace:      aws.String(namespace),
+		MetricName:     aws.String(name),
+		RecentlyActive: aws.String(cloudwatch.RecentlyActivePt3h),
+	}
+	for _, dimension := range toDimensions(filters) {
+		in.Dimensions = append(in.Dimensions, &cloudwatch.DimensionFilter{
+			Name:  dimension.Name,
+			Value: dimension.Value,
+		})
+	}
+	var dimensions []map[string]string
+	for {
+		out, err := cw.client.ListMetrics(in)
+		if err != nil {
+			return nil, fmt.Errorf("list metrics %s in namespace %s: %w", name, namespace, err)
+		}
+		for _, metric := range out.Metrics {
+			m := make(map[string]string, len(metric.Dimensions))
+			for _, dimension := range metric.Dimensions {
+				m[aws.StringValue(dimension.Name)] = aws.StringValue(dimension.Value)
+			}
+			dimensions = append(dimensions, m)
+		}
+		if out.NextToken == nil {
+			break
+		}
+		in.NextToken = out.NextToken
+	}
+	return dimensions, nil
+}
+
+// toDimensions returns the dimensions sorted by name.
+func toDimensions(m map[string]string) []*cloudwatch.Dimension {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	dimensions := make([]*cloudwatch.Dimension, len(names))
+	for i, name := range names {
+		dimensions[i] = &cloudwatch.Dimension{
+			Name:  aws.String(name),
+			Value: aws.String(m[name]),
+		}
+	}
+	return dimensions
+}
+
 // getAlarmName gets the alarm name given a specific alarm ARN.
 // For example: arn:aws:cloudwatch:us-west-2:1234567890:alarm:SDc-ReadCapacityUnitsLimit-BasicAlarm
 // returns SDc-ReadCapacityUnitsLimit-BasicAlarm
@@ -602,3 +602,285 @@ func TestCloudWatch_AlarmDescriptions(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudWatch_MetricMaximum(t *testing.T) {
+	mockStart := time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)
+	mockEnd := mockStart.Add(5 * time.Minute)
+	testCases := map[string]struct {
+		setupMocks func(m cloudWatchMocks)
+
+		wanted    *float64
+		wantedErr error
+	}{
+		"return the maximum of the datapoints": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().GetMetricStatistics(&cloudwatch.GetMetricStatisticsInput{
+					Namespace:  aws.String("AWS/SQS"),
+					MetricName: aws.String("ApproximateAgeOfOldestMessage"),
+					Dimensions: []*cloudwatch.Dimension{
+						{
+							Name:  aws.String("QueueName"),
+							Value: aws.String("phonetool-test-worker-EventsQueue"),
+						},
+					},
+					StartTime:  aws.Time(mockStart),
+					EndTime:    aws.Time(mockEnd),
+					Period:     aws.Int64(300),
+					Statistics: aws.StringSlice([]string{"Maximum"}),
+				}).Return(&cloudwatch.GetMetricStatisticsOutput{
+					Datapoints: []*cloudwatch.Datapoint{
+						{Maximum: aws.Float64(30)},
+						{Maximum: aws.Float64(120)},
+						{},
+					},
+				}, nil)
+			},
+			wanted: aws.Float64(120),
+		},
+		"return nil if there are no datapoints": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().GetMetricStatistics(gomock.Any()).Return(&cloudwatch.GetMetricStatisticsOutput{}, nil)
+			},
+		},
+		"wrap the error": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().GetMetricStatistics(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get statistics of metric ApproximateAgeOfOldestMessage in namespace AWS/SQS: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockcwClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(cloudWatchMocks{
+				cw: mockcwClient,
+			})
+			cwSvc := CloudWatch{
+				client: mockcwClient,
+			}
+
+			// WHEN
+			got, err := cwSvc.MetricMaximum(MetricQuery{
+				Namespace: "AWS/SQS",
+				Name:      "ApproximateAgeOfOldestMessage",
+				Dimensions: map[string]string{
+					"QueueName": "phonetool-test-worker-EventsQueue",
+				},
+				StartTime: mockStart,
+				EndTime:   mockEnd,
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestCloudWatch_MetricSumAndAverage(t *testing.T) {
+	mockStart := time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)
+	mockEnd := mockStart.Add(time.Hour)
+	query := MetricQuery{
+		Namespace: "AWS/ECS",
+		Name:      "RequestCount",
+		Dimensions: map[string]string{
+			"ServiceName":   "phonetool-test-api-Service-abc",
+			"ClusterName":   "phonetool-test-Cluster",
+			"DiscoveryName": "api",
+		},
+		StartTime: mockStart,
+		EndTime:   mockEnd,
+	}
+	wantedInput := func(statistic string) *cloudwatch.GetMetricStatisticsInput {
+		return &cloudwatch.GetMetricStatisticsInput{
+			Namespace:  aws.String("AWS/ECS"),
+			MetricName: aws.String("RequestCount"),
+			Dimensions: []*cloudwatch.Dimension{
+				{Name: aws.String("ClusterName"), Value: aws.String("phonetool-test-Cluster")},
+				{Name: aws.String("DiscoveryName"), Value: aws.String("api")},
+				{Name: aws.String("ServiceName"), Value: aws.String("phonetool-test-api-Service-abc")},
+			},
+			StartTime:  aws.Time(mockStart),
+			EndTime:    aws.Time(mockEnd),
+			Period:     aws.Int64(3600),
+			Statistics: aws.StringSlice([]string{statistic}),
+		}
+	}
+	testCases := map[string]struct {
+		get        func(cw CloudWatch) (*float64, error)
+		setupMocks func(m cloudWatchMocks)
+
+		wanted    *float64
+		wantedErr error
+	}{
+		"return the sum of the datapoints": {
+			get: func(cw CloudWatch) (*float64, error) { return cw.MetricSum(query) },
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().GetMetricStatistics(wantedInput("Sum")).Return(&cloudwatch.GetMetricStatisticsOutput{
+					Datapoints: []*cloudwatch.Datapoint{
+						{Sum: aws.Float64(30)},
+						{Sum: aws.Float64(12)},
+						{},
+					},
+				}, nil)
+			},
+			wanted: aws.Float64(42),
+		},
+		"return a nil sum if there are no datapoints": {
+			get: func(cw CloudWatch) (*float64, error) { return cw.MetricSum(query) },
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().GetMetricStatistics(gomock.Any()).Return(&cloudwatch.GetMetricStatisticsOutput{}, nil)
+			},
+		},
+		"return the average of the datapoints": {
+			get: func(cw CloudWatch) (*float64, error) { return cw.MetricAverage(query) },
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().GetMetricStatistics(wantedInput("Average")).Return(&cloudwatch.GetMetricStatisticsOutput{
+					Datapoints: []*cloudwatch.Datapoint{
+						{Average: aws.Float64(10)},
+						{Average: aws.Float64(20)},
+					},
+				}, nil)
+			},
+			wanted: aws.Float64(15),
+		},
+		"return a nil average if there are no datapoints": {
+			get: func(cw CloudWatch) (*float64, error) { return cw.MetricAverage(query) },
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().GetMetricStatistics(gomock.Any()).Return(&cloudwatch.GetMetricStatisticsOutput{
+					Datapoints: []*cloudwatch.Datapoint{{}},
+				}, nil)
+			},
+		},
+		"wrap the error": {
+			get: func(cw CloudWatch) (*float64, error) { return cw.MetricAverage(query) },
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().GetMetricStatistics(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get statistics of metric RequestCount in namespace AWS/ECS: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockcwClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(cloudWatchMocks{
+				cw: mockcwClient,
+			})
+			cwSvc := CloudWatch{
+				client: mockcwClient,
+			}
+
+			// WHEN
+			got, err := tc.get(cwSvc)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestCloudWatch_MetricDimensions(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m cloudWatchMocks)
+
+		wanted    []map[string]string
+		wantedErr error
+	}{
+		"return the dimensions of all the pages of metrics": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().ListMetrics(&cloudwatch.ListMetricsInput{
+					Namespace:      aws.String("AWS/ECS"),
+					MetricName:     aws.String("NewConnectionCount"),
+					RecentlyActive: aws.String("PT3H"),
+					Dimensions: []*cloudwatch.DimensionFilter{
+						{Name: aws.String("ClusterName"), Value: aws.String("phonetool-test-Cluster")},
+						{Name: aws.String("TargetDiscoveryName"), Value: aws.String("api")},
+					},
+				}).Return(&cloudwatch.ListMetricsOutput{
+					Metrics: []*cloudwatch.Metric{
+						{
+							Dimensions: []*cloudwatch.Dimension{
+								{Name: aws.String("ServiceName"), Value: aws.String("frontend")},
+							},
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.cw.EXPECT().ListMetrics(&cloudwatch.ListMetricsInput{
+					Namespace:      aws.String("AWS/ECS"),
+					MetricName:     aws.String("NewConnectionCount"),
+					RecentlyActive: aws.String("PT3H"),
+					Dimensions: []*cloudwatch.DimensionFilter{
+						{Name: aws.String("ClusterName"), Value: aws.String("phonetool-test-Cluster")},
+						{Name: aws.String("TargetDiscoveryName"), Value: aws.String("api")},
+					},
+					NextToken: aws.String("next"),
+				}).Return(&cloudwatch.ListMetricsOutput{
+					Metrics: []*cloudwatch.Metric{
+						{
+							Dimensions: []*cloudwatch.Dimension{
+								{Name: aws.String("ServiceName"), Value: aws.String("worker")},
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: []map[string]string{
+				{"ServiceName": "frontend"},
+				{"ServiceName": "worker"},
+			},
+		},
+		"wrap the error": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().ListMetrics(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list metrics NewConnectionCount in namespace AWS/ECS: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockcwClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(cloudWatchMocks{
+				cw: mockcwClient,
+			})
+			cwSvc := CloudWatch{
+				client: mockcwClient,
+			}
+
+			// WHEN
+			got, err := cwSvc.MetricDimensions("AWS/ECS", "NewConnectionCount", map[string]string{
+				"TargetDiscoveryName": "api",
+				"ClusterName":         "phonetool-test-Cluster",
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatch
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+// Types of dashboard widgets.
+const (
+	DashboardWidgetMetric = "metric"
+	DashboardWidgetAlarm  = "alarm"
+)
+
+const (
+	dashboardWidth        = 24 // Dashboards are 24 grid units wide.
+	dashboardWidgetHeight = 6
+)
+
+// Dashboard is the body of a CloudWatch dashboard.
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html
+type Dashboard struct {
+	Widgets []*DashboardWidget `json:"widgets"`
+}
+
+// DashboardWidget is a widget of a CloudWatch dashboard.
+type DashboardWidget struct {
+	Type       string                    `json:"type"`
+	X          int                       `json:"x"`
+	Y          int                       `json:"y"`
+	Width      int                       `json:"width"`
+	Height     int                       `json:"height"`
+	Properties DashboardWidgetProperties `json:"properties"`
+}
+
+// DashboardWidgetProperties holds the properties of a metric or an alarm widget.
+type DashboardWidgetProperties struct {
+	Title   string          `json:"title"`
+	Region  string          `json:"region,omitempty"`
+	View    string          `json:"view,omitempty"`
+	Stat    string          `json:"stat,omitempty"`
+	Period  int             `json:"period,omitempty"`
+	Metrics [][]interface{} `json:"metrics,omitempty"`
+	Alarms  []string        `json:"alarms,omitempty"`
+}
+
+// DashboardMetric identifies a metric to graph in a widget.
+type DashboardMetric struct {
+	Namespace  string
+	Name       string
+	Dimensions map[string]string
+}
+
+// NewDashboard lays out each row of widgets under the previous one.
+// The widgets of a row share the width of the dashboard equally.
+func NewDashboard(rows ...[]*DashboardWidget) *Dashboard {
+	dashboard := &Dashboard{}
+	y := 0
+	for _, row := range rows {
+		if len(row) == 0 {
+			continue
+		}
+		width := dashboardWidth / len(row)
+		for x, widget := range row {
+			widget.X = x * width
+			widget.Y = y
+			widget.Width = width
+			widget.Height = dashboardWidgetHeight
+			dashboard.Widgets = append(dashboard.Widgets, widget)
+		}
+		y += dashboardWidgetHeight
+	}
+	return dashboard
+}
+
+// NewMetricWidget returns a time series widget that graphs the statistic of the metrics.
+func NewMetricWidget(title, region, stat string, metrics ...DashboardMetric) *DashboardWidget {
+	widget := &DashboardWidget{
+		Type: DashboardWidgetMetric,
+		Properties: DashboardWidgetProperties{
+			Title:  title,
+			Region: region,
+			View:   "timeSeries",
+			Stat:   stat,
+			Period: 60,
+		},
+	}
+	for _, metric := range metrics {
+		line := []interface{}{metric.Namespace, metric.Name}
+		for _, dimension := range toDimensions(metric.Dimensions) {
+			line = append(line, aws.StringValue(dimension.Name), aws.StringValue(dimension.Value))
+		}
+		widget.Properties.Metrics = append(widget.Properties.Metrics, line)
+	}
+	return widget
+}
+
+// NewAlarmWidget returns a widget that shows the status of the alarms.
+func NewAlarmWidget(title string, alarmARNs []string) *DashboardWidget {
+	return &DashboardWidget{
+		Type: DashboardWidgetAlarm,
+		Properties: DashboardWidgetProperties{
+			Title:  title,
+			Alarms: alarmARNs,
+		},
+	}
+}
+
+// PutDashboard creates the dashboard, or replaces its body if it already exists.
+func (cw *CloudWatch) PutDashboard(name string, dashboard *Dashboard) error {
+	body, err := json.Marshal(dashboard)
+	if err != nil {
+		return fmt.Errorf("marshal dashboard %s: %w", name, err)
+	}
+	// Invalid bodies are rejected with an error, while the validation messages of the output are only warnings.
+	if _, err := cw.client.PutDashboard(&cloudwatch.PutDashboardInput{
+		DashboardName: aws.String(name),
+		DashboardBody: aws.String(string(body)),
+	}); err != nil {
+		return fmt.Errorf("put dashboard %s: %w", name, err)
+	}
+	return nil
+}
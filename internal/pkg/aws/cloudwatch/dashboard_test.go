@@ -0,0 +1,113 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudwatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDashboard(t *testing.T) {
+	// WHEN
+	got := NewDashboard(
+		[]*DashboardWidget{NewAlarmWidget("Alarms", []string{"arn:aws:cloudwatch:us-west-2:1234567890:alarm:phonetool-test-api-CopilotCPUAlarm"})},
+		nil,
+		[]*DashboardWidget{
+			NewMetricWidget("api CPU", "us-west-2", "Average", DashboardMetric{
+				Namespace: "AWS/ECS",
+				Name:      "CPUUtilization",
+				Dimensions: map[string]string{
+					"ServiceName": "phonetool-test-api-Service",
+					"ClusterName": "phonetool-test-Cluster",
+				},
+			}),
+			NewMetricWidget("api Memory", "us-west-2", "Average"),
+			NewMetricWidget("api Requests", "us-west-2", "Sum"),
+		},
+	)
+
+	// THEN
+	require.Len(t, got.Widgets, 4)
+	require.Equal(t, &DashboardWidget{
+		Type:   "alarm",
+		Width:  24,
+		Height: 6,
+		Properties: DashboardWidgetProperties{
+			Title:  "Alarms",
+			Alarms: []string{"arn:aws:cloudwatch:us-west-2:1234567890:alarm:phonetool-test-api-CopilotCPUAlarm"},
+		},
+	}, got.Widgets[0])
+	require.Equal(t, &DashboardWidget{
+		Type:   "metric",
+		Y:      6,
+		Width:  8,
+		Height: 6,
+		Properties: DashboardWidgetProperties{
+			Title:  "api CPU",
+			Region: "us-west-2",
+			View:   "timeSeries",
+			Stat:   "Average",
+			Period: 60,
+			Metrics: [][]interface{}{
+				{"AWS/ECS", "CPUUtilization", "ClusterName", "phonetool-test-Cluster", "ServiceName", "phonetool-test-api-Service"},
+			},
+		},
+	}, got.Widgets[1], "empty rows are skipped and dimensions are sorted by name")
+	require.Equal(t, 16, got.Widgets[3].X)
+	require.Equal(t, 6, got.Widgets[3].Y)
+}
+
+func TestCloudWatch_PutDashboard(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m cloudWatchMocks)
+
+		wantedErr error
+	}{
+		"put the dashboard body": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().PutDashboard(&cloudwatch.PutDashboardInput{
+					DashboardName: aws.String("phonetool-test"),
+					DashboardBody: aws.String(`{"widgets":[{"type":"alarm","x":0,"y":0,"width":24,"height":6,"properties":{"title":"Alarms","alarms":["arn"]}}]}`),
+				}).Return(&cloudwatch.PutDashboardOutput{}, nil)
+			},
+		},
+		"wrap the error": {
+			setupMocks: func(m cloudWatchMocks) {
+				m.cw.EXPECT().PutDashboard(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("put dashboard phonetool-test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockcwClient := mocks.NewMockapi(ctrl)
+			tc.setupMocks(cloudWatchMocks{
+				cw: mockcwClient,
+			})
+			cwSvc := CloudWatch{
+				client: mockcwClient,
+			}
+
+			// WHEN
+			err := cwSvc.PutDashboard("phonetool-test", NewDashboard([]*DashboardWidget{NewAlarmWidget("Alarms", []string{"arn"})}))
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
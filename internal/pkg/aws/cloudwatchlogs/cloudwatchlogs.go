@@ -12,11 +12,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
 	// SleepDuration is the sleep time for making the next request for log events.
 	SleepDuration = 1 * time.Second
+
+	maxConcurrentLogStreams = 10 // Stay well below the GetLogEvents quota of 25 requests per second.
 )
 
 var (
@@ -25,6 +28,8 @@ var (
 )
 
 type api interface {
+	DeleteLogGroup(input *cloudwatchlogs.DeleteLogGroupInput) (*cloudwatchlogs.DeleteLogGroupOutput, error)
+	DescribeLogGroups(input *cloudwatchlogs.DescribeLogGroupsInput) (*cloudwatchlogs.DescribeLogGroupsOutput, error)
 	DescribeLogStreams(input *cloudwatchlogs.DescribeLogStreamsInput) (*cloudwatchlogs.DescribeLogStreamsOutput, error)
 	GetLogEvents(input *cloudwatchlogs.GetLogEventsInput) (*cloudwatchlogs.GetLogEventsOutput, error)
 }
@@ -54,6 +59,12 @@ type LogEventsOpts struct {
 	LogStreamLimit int
 }
 
+// LogGroup holds the name and creation time of a log group.
+type LogGroup struct {
+	Name         string
+	CreationTime time.Time
+}
+
 // New returns a CloudWatchLogs configured against the input session.
 func New(s *session.Session) *CloudWatchLogs {
 	return &CloudWatchLogs{
@@ -61,6 +72,40 @@ func New(s *session.Session) *CloudWatchLogs {
 	}
 }
 
+// LogGroups returns the log groups whose name starts with prefix.
+func (c *CloudWatchLogs) LogGroups(prefix string) ([]LogGroup, error) {
+	var groups []LogGroup
+	in := &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: aws.String(prefix),
+	}
+	for {
+		out, err := c.client.DescribeLogGroups(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe log groups with prefix %s: %w", prefix, err)
+		}
+		for _, group := range out.LogGroups {
+			groups = append(groups, LogGroup{
+				Name:         aws.StringValue(group.LogGroupName),
+				CreationTime: time.UnixMilli(aws.Int64Value(group.CreationTime)),
+			})
+		}
+		if aws.StringValue(out.NextToken) == "" {
+			return groups, nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
+// DeleteLogGroup deletes a log group and all of its log events.
+func (c *CloudWatchLogs) DeleteLogGroup(name string) error {
+	if _, err := c.client.DeleteLogGroup(&cloudwatchlogs.DeleteLogGroupInput{
+		LogGroupName: aws.String(name),
+	}); err != nil {
+		return fmt.Errorf("delete log group %s: %w", name, err)
+	}
+	return nil
+}
+
 // logStreams returns all name of the log streams in a log group with optional limit and prefix filters.
 func (c *CloudWatchLogs) logStreams(logGroup string, logStreamLimit int, logStreamPrefixes ...string) ([]string, error) {
 	var logStreamNames []string
@@ -105,47 +150,44 @@ func (c *CloudWatchLogs) logStreams(logGroup string, logStreamLimit int, logStre
 }
 
 // LogEvents returns an array of Cloudwatch Logs events.
+// The events of the log streams, such as the streams of the containers in a task, are retrieved concurrently
+// and interleaved by their timestamps.
 func (c *CloudWatchLogs) LogEvents(opts LogEventsOpts) (*LogEventsOutput, error) {
-	var events []*Event
-	in := initGetLogEventsInput(opts)
-
 	logStreams, err := c.logStreams(opts.LogGroup, opts.LogStreamLimit, opts.LogStreamPrefixFilters...)
 	if err != nil {
 		return nil, err
 	}
+	streamEvents := make([][]*Event, len(logStreams))
+	g := new(errgroup.Group)
+	g.SetLimit(maxConcurrentLogStreams)
+	for i, logStream := range logStreams {
+		i, logStream := i, logStream
+		g.Go(func() error {
+			events, err := c.logStreamEvents(opts, logStream)
+			if err != nil {
+				return err
+			}
+			streamEvents[i] = events
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	var events []*Event
 	streamLastEventTime := make(map[string]int64)
 	for k, v := range opts.StreamLastEventTime {
 		streamLastEventTime[k] = v
 	}
-	for _, logStream := range logStreams {
-		// Set override value
-		in.SetLogStreamName(logStream)
-		if streamLastEventTime[logStream] != 0 {
-			// If last event for this log stream exists, increment last log event timestamp
-			// by one to get logs after the last event.
-			in.SetStartTime(streamLastEventTime[logStream] + 1)
-		}
-		// TODO: https://github.com/aws/copilot-cli/pull/628#discussion_r374291068 and https://github.com/aws/copilot-cli/pull/628#discussion_r374294362
-		resp, err := c.client.GetLogEvents(in)
-		if err != nil {
-			return nil, fmt.Errorf("get log events of %s/%s: %w", opts.LogGroup, logStream, err)
-		}
-
-		for _, event := range resp.Events {
-			log := &Event{
-				LogStreamName: logStream,
-				IngestionTime: aws.Int64Value(event.IngestionTime),
-				Message:       aws.StringValue(event.Message),
-				Timestamp:     aws.Int64Value(event.Timestamp),
-			}
-			events = append(events, log)
-		}
-		if len(resp.Events) != 0 {
-			streamLastEventTime[logStream] = *resp.Events[len(resp.Events)-1].Timestamp
+	for i, logStream := range logStreams {
+		events = append(events, streamEvents[i]...)
+		if n := len(streamEvents[i]); n != 0 {
+			streamLastEventTime[logStream] = streamEvents[i][n-1].Timestamp
 		}
 	}
 	sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp < events[j].Timestamp })
-	limit := int(aws.Int64Value(in.Limit))
+	limit := int(aws.Int64Value(opts.Limit))
 	if limit != 0 {
 		return &LogEventsOutput{
 			Events:              truncateEvents(limit, events),
@@ -158,6 +200,32 @@ func (c *CloudWatchLogs) LogEvents(opts LogEventsOpts) (*LogEventsOutput, error)
 	}, nil
 }
 
+// logStreamEvents returns the events of a log stream after its last event that was retrieved.
+func (c *CloudWatchLogs) logStreamEvents(opts LogEventsOpts, logStream string) ([]*Event, error) {
+	in := initGetLogEventsInput(opts)
+	in.SetLogStreamName(logStream)
+	if lastEventTime := opts.StreamLastEventTime[logStream]; lastEventTime != 0 {
+		// If last event for this log stream exists, increment last log event timestamp
+		// by one to get logs after the last event.
+		in.SetStartTime(lastEventTime + 1)
+	}
+	// TODO: https://github.com/aws/copilot-cli/pull/628#discussion_r374291068 and https://github.com/aws/copilot-cli/pull/628#discussion_r374294362
+	resp, err := c.client.GetLogEvents(in)
+	if err != nil {
+		return nil, fmt.Errorf("get log events of %s/%s: %w", opts.LogGroup, logStream, err)
+	}
+	var events []*Event
+	for _, event := range resp.Events {
+		events = append(events, &Event{
+			LogStreamName: logStream,
+			IngestionTime: aws.Int64Value(event.IngestionTime),
+			Message:       aws.StringValue(event.Message),
+			Timestamp:     aws.Int64Value(event.Timestamp),
+		})
+	}
+	return events, nil
+}
+
 func truncateEvents(limit int, events []*Event) []*Event {
 	if len(events) <= limit {
 		return events
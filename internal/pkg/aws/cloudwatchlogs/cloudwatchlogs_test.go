@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
@@ -391,3 +392,76 @@ func TestLogEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestLogGroups(t *testing.T) {
+	testCases := map[string]struct {
+		mockcloudwatchlogsClient func(m *mocks.Mockapi)
+
+		wanted  []LogGroup
+		wantErr error
+	}{
+		"should wrap the error if log groups can't be described": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogGroups(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("describe log groups with prefix /copilot/phonetool-test-: some error"),
+		},
+		"should return the log groups of every page": {
+			mockcloudwatchlogsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLogGroups(&cloudwatchlogs.DescribeLogGroupsInput{
+					LogGroupNamePrefix: aws.String("/copilot/phonetool-test-"),
+				}).Return(&cloudwatchlogs.DescribeLogGroupsOutput{
+					LogGroups: []*cloudwatchlogs.LogGroup{
+						{
+							LogGroupName: aws.String("/copilot/phonetool-test-api"),
+							CreationTime: aws.Int64(1677628800000),
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeLogGroups(&cloudwatchlogs.DescribeLogGroupsInput{
+					LogGroupNamePrefix: aws.String("/copilot/phonetool-test-"),
+					NextToken:          aws.String("next"),
+				}).Return(&cloudwatchlogs.DescribeLogGroupsOutput{
+					LogGroups: []*cloudwatchlogs.LogGroup{
+						{
+							LogGroupName: aws.String("/copilot/phonetool-test-worker"),
+							CreationTime: aws.Int64(1677628800000),
+						},
+					},
+				}, nil)
+			},
+			wanted: []LogGroup{
+				{
+					Name:         "/copilot/phonetool-test-api",
+					CreationTime: time.UnixMilli(1677628800000),
+				},
+				{
+					Name:         "/copilot/phonetool-test-worker",
+					CreationTime: time.UnixMilli(1677628800000),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockcloudwatchlogsClient(m)
+			client := CloudWatchLogs{
+				client: m,
+			}
+
+			got, err := client.LogGroups("/copilot/phonetool-test-")
+
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
@@ -7,7 +7,9 @@ package cloudwatchlogs
 import (
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"regexp"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	c "github.com/fatih/color"
@@ -17,6 +19,10 @@ const (
 	shortLogStreamNameLength = 25
 )
 
+// containerColors are the colors of the log stream names, so that the events of each container stand out when
+// the events of multiple containers are interleaved.
+var containerColors = []*c.Color{color.Cyan, color.Magenta, color.DullGreen, color.DullBlue, color.HiCyan, color.Blue}
+
 // Event represents a log event.
 type Event struct {
 	LogStreamName string `json:"logStreamName"`
@@ -42,7 +48,27 @@ func (l *Event) HumanString() string {
 	for _, code := range warningCodes {
 		l.Message = colorCodeMessage(l.Message, code, color.Yellow)
 	}
-	return fmt.Sprintf("%s %s\n", color.Grey.Sprint(l.shortLogStreamName()), l.Message)
+	return fmt.Sprintf("%s %s\n", l.streamColor().Sprint(l.shortLogStreamName()), l.Message)
+}
+
+// ContainerName returns the name of the container that emitted the event if the log stream is named after the
+// convention "prefix/container/taskID" of the awslogs driver, such as "copilot/nginx/1234". Otherwise, it returns "".
+func (l *Event) ContainerName() string {
+	parts := strings.Split(l.LogStreamName, "/")
+	if len(parts) != 3 {
+		return ""
+	}
+	return parts[1]
+}
+
+func (l *Event) streamColor() *c.Color {
+	container := l.ContainerName()
+	if container == "" {
+		return color.Grey
+	}
+	h := fnv.New32a()
+	h.Write([]byte(container))
+	return containerColors[h.Sum32()%uint32(len(containerColors))]
 }
 
 func (l *Event) shortLogStreamName() string {
@@ -59,3 +59,39 @@ func TestColorCodeMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestEvent_ContainerName(t *testing.T) {
+	testCases := map[string]struct {
+		logStreamName string
+		wanted        string
+	}{
+		"log stream of a container in a task": {
+			logStreamName: "copilot/nginx/4d4b1a7ab0f84fe088a7b5b4d0f1e04c",
+			wanted:        "nginx",
+		},
+		"log stream that doesn't follow the convention": {
+			logStreamName: "instance/4d4b1a7ab0f84fe088a7b5b4d0f1e04c",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			e := &Event{LogStreamName: tc.logStreamName}
+			require.Equal(t, tc.wanted, e.ContainerName())
+		})
+	}
+}
+
+func TestEvent_HumanString_ColorsByContainer(t *testing.T) {
+	noColor := c.NoColor
+	defer func() {
+		c.NoColor = noColor
+	}()
+	c.NoColor = false
+	web := &Event{LogStreamName: "copilot/web/1234", Message: "hello"}
+	webOtherTask := &Event{LogStreamName: "copilot/web/5678", Message: "hello"}
+	other := &Event{LogStreamName: "states/1234", Message: "hello"}
+
+	require.Equal(t, web.streamColor(), webOtherTask.streamColor(), "events of the same container should have the same color")
+	require.Equal(t, color.Grey, other.streamColor())
+	require.Equal(t, fmt.Sprintf("%s hello\n", web.streamColor().Sprint("copilot/web/1234")), web.HumanString())
+}
@@ -0,0 +1,144 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codebuild provides a client to make API requests to AWS CodeBuild.
+package codebuild
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+)
+
+// Statuses of a build.
+const (
+	BuildStatusInProgress = codebuild.StatusTypeInProgress
+	BuildStatusSucceeded  = codebuild.StatusTypeSucceeded
+)
+
+// Environment types of the build container.
+const (
+	EnvironmentTypeLinux = codebuild.EnvironmentTypeLinuxContainer
+	EnvironmentTypeARM   = codebuild.EnvironmentTypeArmContainer
+)
+
+type api interface {
+	StartBuild(input *codebuild.StartBuildInput) (*codebuild.StartBuildOutput, error)
+	BatchGetBuilds(input *codebuild.BatchGetBuildsInput) (*codebuild.BatchGetBuildsOutput, error)
+	StopBuild(input *codebuild.StopBuildInput) (*codebuild.StopBuildOutput, error)
+}
+
+// CodeBuild wraps an AWS CodeBuild client.
+type CodeBuild struct {
+	client api
+}
+
+// StartBuildInput holds the overrides to start a build of a project with.
+type StartBuildInput struct {
+	ProjectName     string
+	SourceLocation  string            // The S3 location of the zipped source, in the format "bucket/key".
+	Buildspec       string            // The buildspec to run, in YAML.
+	EnvironmentType string            // Optional. One of EnvironmentTypeLinux or EnvironmentTypeARM.
+	Image           string            // Optional. The image of the build container.
+	EnvVars         map[string]string // Optional. Plaintext environment variables of the build.
+}
+
+// Build represents a build of a project.
+type Build struct {
+	ID     string
+	Status string
+	Phase  string
+
+	// Location of the logs of the build, which are empty until the build container starts.
+	LogGroup  string
+	LogStream string
+
+	ExportedEnvVars map[string]string // Variables exported by the buildspec, once the build is done.
+}
+
+// Done returns true if the build is not in progress anymore.
+func (b *Build) Done() bool {
+	return b.Status != BuildStatusInProgress
+}
+
+// Succeeded returns true if the build is done and succeeded.
+func (b *Build) Succeeded() bool {
+	return b.Status == BuildStatusSucceeded
+}
+
+// New returns a CodeBuild client configured against the input session.
+func New(s *session.Session) *CodeBuild {
+	return &CodeBuild{
+		client: codebuild.New(s),
+	}
+}
+
+// StartBuild starts a build of the project from a source in S3, and returns the ID of the build.
+func (c *CodeBuild) StartBuild(in *StartBuildInput) (string, error) {
+	input := &codebuild.StartBuildInput{
+		ProjectName:            aws.String(in.ProjectName),
+		SourceTypeOverride:     aws.String(codebuild.SourceTypeS3),
+		SourceLocationOverride: aws.String(in.SourceLocation),
+		BuildspecOverride:      aws.String(in.Buildspec),
+	}
+	if in.EnvironmentType != "" {
+		input.EnvironmentTypeOverride = aws.String(in.EnvironmentType)
+	}
+	if in.Image != "" {
+		input.ImageOverride = aws.String(in.Image)
+	}
+	for name, value := range in.EnvVars {
+		input.EnvironmentVariablesOverride = append(input.EnvironmentVariablesOverride, &codebuild.EnvironmentVariable{
+			Name:  aws.String(name),
+			Type:  aws.String(codebuild.EnvironmentVariableTypePlaintext),
+			Value: aws.String(value),
+		})
+	}
+	out, err := c.client.StartBuild(input)
+	if err != nil {
+		return "", fmt.Errorf("start build of project %s: %w", in.ProjectName, err)
+	}
+	return aws.StringValue(out.Build.Id), nil
+}
+
+// Build returns the build with the ID.
+func (c *CodeBuild) Build(id string) (*Build, error) {
+	out, err := c.client.BatchGetBuilds(&codebuild.BatchGetBuildsInput{
+		Ids: aws.StringSlice([]string{id}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get build %s: %w", id, err)
+	}
+	if len(out.Builds) == 0 {
+		return nil, fmt.Errorf("build %s not found", id)
+	}
+	raw := out.Builds[0]
+	build := &Build{
+		ID:     aws.StringValue(raw.Id),
+		Status: aws.StringValue(raw.BuildStatus),
+		Phase:  aws.StringValue(raw.CurrentPhase),
+	}
+	if raw.Logs != nil {
+		build.LogGroup = aws.StringValue(raw.Logs.GroupName)
+		build.LogStream = aws.StringValue(raw.Logs.StreamName)
+	}
+	if len(raw.ExportedEnvironmentVariables) != 0 {
+		build.ExportedEnvVars = make(map[string]string, len(raw.ExportedEnvironmentVariables))
+		for _, v := range raw.ExportedEnvironmentVariables {
+			build.ExportedEnvVars[aws.StringValue(v.Name)] = aws.StringValue(v.Value)
+		}
+	}
+	return build, nil
+}
+
+// StopBuild stops the build with the ID.
+func (c *CodeBuild) StopBuild(id string) error {
+	if _, err := c.client.StopBuild(&codebuild.StopBuildInput{
+		Id: aws.String(id),
+	}); err != nil {
+		return fmt.Errorf("stop build %s: %w", id, err)
+	}
+	return nil
+}
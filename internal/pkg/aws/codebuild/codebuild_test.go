@@ -0,0 +1,232 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codebuild
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeBuild_StartBuild(t *testing.T) {
+	testCases := map[string]struct {
+		in         *StartBuildInput
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      string
+		wantedError error
+	}{
+		"returns a wrapped error if fail to start the build": {
+			in: &StartBuildInput{
+				ProjectName: "phonetool-remote-builder",
+			},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartBuild(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("start build of project phonetool-remote-builder: some error"),
+		},
+		"starts the build with the overrides": {
+			in: &StartBuildInput{
+				ProjectName:     "phonetool-remote-builder",
+				SourceLocation:  "bucket/builds/context.zip",
+				Buildspec:       "version: 0.2",
+				EnvironmentType: EnvironmentTypeARM,
+				Image:           "aws/codebuild/amazonlinux2-aarch64-standard:3.0",
+				EnvVars: map[string]string{
+					"IMAGE_URI": "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
+				},
+			},
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StartBuild(&codebuild.StartBuildInput{
+					ProjectName:             aws.String("phonetool-remote-builder"),
+					SourceTypeOverride:      aws.String("S3"),
+					SourceLocationOverride:  aws.String("bucket/builds/context.zip"),
+					BuildspecOverride:       aws.String("version: 0.2"),
+					EnvironmentTypeOverride: aws.String("ARM_CONTAINER"),
+					ImageOverride:           aws.String("aws/codebuild/amazonlinux2-aarch64-standard:3.0"),
+					EnvironmentVariablesOverride: []*codebuild.EnvironmentVariable{
+						{
+							Name:  aws.String("IMAGE_URI"),
+							Type:  aws.String("PLAINTEXT"),
+							Value: aws.String("1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend"),
+						},
+					},
+				}).Return(&codebuild.StartBuildOutput{
+					Build: &codebuild.Build{
+						Id: aws.String("phonetool-remote-builder:1234"),
+					},
+				}, nil)
+			},
+			wanted: "phonetool-remote-builder:1234",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := CodeBuild{client: m}
+
+			// WHEN
+			got, err := client.StartBuild(tc.in)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestCodeBuild_Build(t *testing.T) {
+	const mockID = "phonetool-remote-builder:1234"
+	mockInput := &codebuild.BatchGetBuildsInput{
+		Ids: aws.StringSlice([]string{mockID}),
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted          *Build
+		wantedDone      bool
+		wantedSucceeded bool
+		wantedError     error
+	}{
+		"returns a wrapped error if fail to get the build": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(mockInput).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get build phonetool-remote-builder:1234: some error"),
+		},
+		"returns an error if the build is not found": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(mockInput).Return(&codebuild.BatchGetBuildsOutput{}, nil)
+			},
+			wantedError: errors.New("build phonetool-remote-builder:1234 not found"),
+		},
+		"returns a build in progress without logs": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(mockInput).Return(&codebuild.BatchGetBuildsOutput{
+					Builds: []*codebuild.Build{
+						{
+							Id:           aws.String(mockID),
+							BuildStatus:  aws.String("IN_PROGRESS"),
+							CurrentPhase: aws.String("QUEUED"),
+						},
+					},
+				}, nil)
+			},
+			wanted: &Build{
+				ID:     mockID,
+				Status: "IN_PROGRESS",
+				Phase:  "QUEUED",
+			},
+		},
+		"returns a succeeded build with its logs and exported variables": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(mockInput).Return(&codebuild.BatchGetBuildsOutput{
+					Builds: []*codebuild.Build{
+						{
+							Id:           aws.String(mockID),
+							BuildStatus:  aws.String("SUCCEEDED"),
+							CurrentPhase: aws.String("COMPLETED"),
+							Logs: &codebuild.LogsLocation{
+								GroupName:  aws.String("/aws/codebuild/phonetool-remote-builder"),
+								StreamName: aws.String("1234"),
+							},
+							ExportedEnvironmentVariables: []*codebuild.ExportedEnvironmentVariable{
+								{
+									Name:  aws.String("IMAGE_DIGEST"),
+									Value: aws.String("sha256:1234"),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: &Build{
+				ID:        mockID,
+				Status:    "SUCCEEDED",
+				Phase:     "COMPLETED",
+				LogGroup:  "/aws/codebuild/phonetool-remote-builder",
+				LogStream: "1234",
+				ExportedEnvVars: map[string]string{
+					"IMAGE_DIGEST": "sha256:1234",
+				},
+			},
+			wantedDone:      true,
+			wantedSucceeded: true,
+		},
+		"returns a failed build": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetBuilds(mockInput).Return(&codebuild.BatchGetBuildsOutput{
+					Builds: []*codebuild.Build{
+						{
+							Id:           aws.String(mockID),
+							BuildStatus:  aws.String("FAILED"),
+							CurrentPhase: aws.String("COMPLETED"),
+						},
+					},
+				}, nil)
+			},
+			wanted: &Build{
+				ID:     mockID,
+				Status: "FAILED",
+				Phase:  "COMPLETED",
+			},
+			wantedDone: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := CodeBuild{client: m}
+
+			// WHEN
+			got, err := client.Build(mockID)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+			require.Equal(t, tc.wantedDone, got.Done())
+			require.Equal(t, tc.wantedSucceeded, got.Succeeded())
+		})
+	}
+}
+
+func TestCodeBuild_StopBuild(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockapi(ctrl)
+	m.EXPECT().StopBuild(&codebuild.StopBuildInput{
+		Id: aws.String("phonetool-remote-builder:1234"),
+	}).Return(nil, errors.New("some error"))
+	client := CodeBuild{client: m}
+
+	// WHEN
+	err := client.StopBuild("phonetool-remote-builder:1234")
+
+	// THEN
+	require.EqualError(t, err, "stop build phonetool-remote-builder:1234: some error")
+}
@@ -0,0 +1,156 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codedeploy provides a client to make API requests to AWS CodeDeploy.
+package codedeploy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+)
+
+// Statuses of a deployment.
+const (
+	DeploymentStatusSucceeded = codedeploy.DeploymentStatusSucceeded
+	DeploymentStatusFailed    = codedeploy.DeploymentStatusFailed
+	DeploymentStatusStopped   = codedeploy.DeploymentStatusStopped
+)
+
+type api interface {
+	ListDeployments(input *codedeploy.ListDeploymentsInput) (*codedeploy.ListDeploymentsOutput, error)
+	GetDeployment(input *codedeploy.GetDeploymentInput) (*codedeploy.GetDeploymentOutput, error)
+	ListDeploymentTargets(input *codedeploy.ListDeploymentTargetsInput) (*codedeploy.ListDeploymentTargetsOutput, error)
+	GetDeploymentTarget(input *codedeploy.GetDeploymentTargetInput) (*codedeploy.GetDeploymentTargetOutput, error)
+}
+
+// CodeDeploy wraps an AWS CodeDeploy client.
+type CodeDeploy struct {
+	client api
+}
+
+// Deployment represents a deployment of a CodeDeploy deployment group.
+type Deployment struct {
+	ID           string
+	Status       string
+	ErrorMessage string
+	CreatedAt    time.Time
+
+	// TrafficShifted is true once all the traffic is routed to the replacement tasks,
+	// and the deployment is waiting to terminate the original tasks.
+	TrafficShifted bool
+
+	LifecycleEvents []LifecycleEvent // Lifecycle events of the ECS target of the deployment, in the order they run.
+}
+
+// LifecycleEvent represents a phase of the deployment to an ECS target, such as "Install" or "AllowTraffic".
+type LifecycleEvent struct {
+	Name   string
+	Status string
+}
+
+// Done returns true if the deployment succeeded, failed, or was stopped.
+func (d *Deployment) Done() bool {
+	switch d.Status {
+	case DeploymentStatusSucceeded, DeploymentStatusFailed, DeploymentStatusStopped:
+		return true
+	}
+	return false
+}
+
+// New returns a CodeDeploy client configured against the input session.
+func New(s *session.Session) *CodeDeploy {
+	return &CodeDeploy{
+		client: codedeploy.New(s),
+	}
+}
+
+// LatestDeployment returns the most recent deployment of the deployment group that was created after the input time.
+// If there is no such deployment, returns nil.
+func (c *CodeDeploy) LatestDeployment(app, deploymentGroup string, since time.Time) (*Deployment, error) {
+	var ids []*string
+	var nextToken *string
+	for {
+		out, err := c.client.ListDeployments(&codedeploy.ListDeploymentsInput{
+			ApplicationName:     aws.String(app),
+			DeploymentGroupName: aws.String(deploymentGroup),
+			CreateTimeRange: &codedeploy.TimeRange{
+				Start: aws.Time(since),
+			},
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list deployments of deployment group %s: %w", deploymentGroup, err)
+		}
+		ids = append(ids, out.Deployments...)
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	var latest *codedeploy.DeploymentInfo
+	for _, id := range ids {
+		out, err := c.client.GetDeployment(&codedeploy.GetDeploymentInput{
+			DeploymentId: id,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get deployment %s: %w", aws.StringValue(id), err)
+		}
+		if latest == nil || aws.TimeValue(out.DeploymentInfo.CreateTime).After(aws.TimeValue(latest.CreateTime)) {
+			latest = out.DeploymentInfo
+		}
+	}
+	if latest == nil {
+		return nil, nil
+	}
+	deployment := &Deployment{
+		ID:             aws.StringValue(latest.DeploymentId),
+		Status:         aws.StringValue(latest.Status),
+		CreatedAt:      aws.TimeValue(latest.CreateTime),
+		TrafficShifted: aws.BoolValue(latest.InstanceTerminationWaitTimeStarted),
+	}
+	if latest.ErrorInformation != nil {
+		deployment.ErrorMessage = aws.StringValue(latest.ErrorInformation.Message)
+	}
+	events, err := c.lifecycleEvents(deployment.ID)
+	if err != nil {
+		return nil, err
+	}
+	deployment.LifecycleEvents = events
+	return deployment, nil
+}
+
+// lifecycleEvents returns the lifecycle events of the ECS target of a deployment.
+// An ECS deployment has a single target, which is the service.
+func (c *CodeDeploy) lifecycleEvents(deploymentID string) ([]LifecycleEvent, error) {
+	targets, err := c.client.ListDeploymentTargets(&codedeploy.ListDeploymentTargetsInput{
+		DeploymentId: aws.String(deploymentID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list targets of deployment %s: %w", deploymentID, err)
+	}
+	if len(targets.TargetIds) == 0 {
+		return nil, nil
+	}
+	out, err := c.client.GetDeploymentTarget(&codedeploy.GetDeploymentTargetInput{
+		DeploymentId: aws.String(deploymentID),
+		TargetId:     targets.TargetIds[0],
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get target %s of deployment %s: %w", aws.StringValue(targets.TargetIds[0]), deploymentID, err)
+	}
+	if out.DeploymentTarget == nil || out.DeploymentTarget.EcsTarget == nil {
+		return nil, nil
+	}
+	var events []LifecycleEvent
+	for _, event := range out.DeploymentTarget.EcsTarget.LifecycleEvents {
+		events = append(events, LifecycleEvent{
+			Name:   aws.StringValue(event.LifecycleEventName),
+			Status: aws.StringValue(event.Status),
+		})
+	}
+	return events, nil
+}
@@ -0,0 +1,161 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codedeploy
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeDeploy_LatestDeployment(t *testing.T) {
+	mockSince := time.Unix(1672531200, 0)
+	mockListInput := func(nextToken *string) *codedeploy.ListDeploymentsInput {
+		return &codedeploy.ListDeploymentsInput{
+			ApplicationName:     aws.String("phonetool-test-frontend"),
+			DeploymentGroupName: aws.String("phonetool-test-frontend"),
+			CreateTimeRange: &codedeploy.TimeRange{
+				Start: aws.Time(mockSince),
+			},
+			NextToken: nextToken,
+		}
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      *Deployment
+		wantedError error
+	}{
+		"returns a wrapped error if fail to list deployments": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ListDeployments(mockListInput(nil)).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list deployments of deployment group phonetool-test-frontend: some error"),
+		},
+		"returns nil if there are no deployments": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ListDeployments(mockListInput(nil)).Return(&codedeploy.ListDeploymentsOutput{}, nil)
+			},
+		},
+		"returns a wrapped error if fail to get a deployment": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ListDeployments(mockListInput(nil)).Return(&codedeploy.ListDeploymentsOutput{
+					Deployments: aws.StringSlice([]string{"d-1"}),
+				}, nil)
+				m.EXPECT().GetDeployment(&codedeploy.GetDeploymentInput{DeploymentId: aws.String("d-1")}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get deployment d-1: some error"),
+		},
+		"returns a wrapped error if fail to get the target of the deployment": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ListDeployments(mockListInput(nil)).Return(&codedeploy.ListDeploymentsOutput{
+					Deployments: aws.StringSlice([]string{"d-1"}),
+				}, nil)
+				m.EXPECT().GetDeployment(gomock.Any()).Return(&codedeploy.GetDeploymentOutput{
+					DeploymentInfo: &codedeploy.DeploymentInfo{
+						DeploymentId: aws.String("d-1"),
+					},
+				}, nil)
+				m.EXPECT().ListDeploymentTargets(&codedeploy.ListDeploymentTargetsInput{DeploymentId: aws.String("d-1")}).Return(&codedeploy.ListDeploymentTargetsOutput{
+					TargetIds: aws.StringSlice([]string{"cluster:service"}),
+				}, nil)
+				m.EXPECT().GetDeploymentTarget(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get target cluster:service of deployment d-1: some error"),
+		},
+		"returns the latest deployment across pages with its lifecycle events": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ListDeployments(mockListInput(nil)).Return(&codedeploy.ListDeploymentsOutput{
+					Deployments: aws.StringSlice([]string{"d-1"}),
+					NextToken:   aws.String("token"),
+				}, nil)
+				m.EXPECT().ListDeployments(mockListInput(aws.String("token"))).Return(&codedeploy.ListDeploymentsOutput{
+					Deployments: aws.StringSlice([]string{"d-2"}),
+				}, nil)
+				m.EXPECT().GetDeployment(&codedeploy.GetDeploymentInput{DeploymentId: aws.String("d-1")}).Return(&codedeploy.GetDeploymentOutput{
+					DeploymentInfo: &codedeploy.DeploymentInfo{
+						DeploymentId: aws.String("d-1"),
+						Status:       aws.String("Failed"),
+						CreateTime:   aws.Time(mockSince.Add(time.Minute)),
+					},
+				}, nil)
+				m.EXPECT().GetDeployment(&codedeploy.GetDeploymentInput{DeploymentId: aws.String("d-2")}).Return(&codedeploy.GetDeploymentOutput{
+					DeploymentInfo: &codedeploy.DeploymentInfo{
+						DeploymentId:                       aws.String("d-2"),
+						Status:                             aws.String("InProgress"),
+						CreateTime:                         aws.Time(mockSince.Add(2 * time.Minute)),
+						InstanceTerminationWaitTimeStarted: aws.Bool(true),
+						ErrorInformation: &codedeploy.ErrorInformation{
+							Message: aws.String("some message"),
+						},
+					},
+				}, nil)
+				m.EXPECT().ListDeploymentTargets(&codedeploy.ListDeploymentTargetsInput{DeploymentId: aws.String("d-2")}).Return(&codedeploy.ListDeploymentTargetsOutput{
+					TargetIds: aws.StringSlice([]string{"cluster:service"}),
+				}, nil)
+				m.EXPECT().GetDeploymentTarget(&codedeploy.GetDeploymentTargetInput{
+					DeploymentId: aws.String("d-2"),
+					TargetId:     aws.String("cluster:service"),
+				}).Return(&codedeploy.GetDeploymentTargetOutput{
+					DeploymentTarget: &codedeploy.DeploymentTarget{
+						EcsTarget: &codedeploy.ECSTarget{
+							LifecycleEvents: []*codedeploy.LifecycleEvent{
+								{LifecycleEventName: aws.String("Install"), Status: aws.String("Succeeded")},
+								{LifecycleEventName: aws.String("AllowTraffic"), Status: aws.String("InProgress")},
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: &Deployment{
+				ID:             "d-2",
+				Status:         "InProgress",
+				ErrorMessage:   "some message",
+				CreatedAt:      mockSince.Add(2 * time.Minute),
+				TrafficShifted: true,
+				LifecycleEvents: []LifecycleEvent{
+					{Name: "Install", Status: "Succeeded"},
+					{Name: "AllowTraffic", Status: "InProgress"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			cd := CodeDeploy{
+				client: m,
+			}
+
+			// WHEN
+			got, err := cd.LatestDeployment("phonetool-test-frontend", "phonetool-test-frontend", mockSince)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestDeployment_Done(t *testing.T) {
+	require.False(t, (&Deployment{Status: "InProgress"}).Done())
+	require.True(t, (&Deployment{Status: "Succeeded"}).Done())
+	require.True(t, (&Deployment{Status: "Failed"}).Done())
+	require.True(t, (&Deployment{Status: "Stopped"}).Done())
+}
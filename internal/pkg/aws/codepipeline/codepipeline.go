@@ -7,6 +7,7 @@ package codepipeline
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/xlab/treeprint"
@@ -19,6 +20,15 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
 
+const (
+	// ActionStatusInProgress is the status of an action that is running.
+	ActionStatusInProgress = cp.ActionExecutionStatusInProgress
+	// ActionStatusFailed is the status of an action whose latest execution failed.
+	ActionStatusFailed = cp.ActionExecutionStatusFailed
+
+	shortRevisionLength = 7 // Length of the abbreviated commit IDs shown by git.
+)
+
 type api interface {
 	GetPipeline(*cp.GetPipelineInput) (*cp.GetPipelineOutput, error)
 	GetPipelineState(*cp.GetPipelineStateInput) (*cp.GetPipelineStateOutput, error)
@@ -71,8 +81,13 @@ type StageState struct {
 
 // StageAction wraps a CodePipeline stage action.
 type StageAction struct {
-	Name   string `json:"name"`
-	Status string `json:"status"`
+	Name                string   `json:"name"`
+	Status              string   `json:"status"`
+	Revision            string   `json:"revision,omitempty"`            // Revision of the source, such as a commit ID, for source actions.
+	Summary             string   `json:"summary,omitempty"`             // Summary of the latest execution, such as a commit message for source actions.
+	ErrorMessage        string   `json:"errorMessage,omitempty"`        // Error of the latest execution if it failed.
+	ExternalExecutionID string   `json:"externalExecutionId,omitempty"` // ID of the execution in the external system, such as a CodeBuild build ID.
+	Logs                []string `json:"logs,omitempty"`                // Last lines of the logs of the latest execution if it failed.
 }
 
 // AggregateStatus returns the collective status of a stage by looking at each individual action's status.
@@ -170,7 +185,7 @@ func (c *CodePipeline) RetryStageExecution(pipelineName, stageName string) error
 	}); err != nil {
 		noFailedActions := &cp.StageNotRetryableException{}
 		if !errors.As(err, &noFailedActions) {
-			return fmt.Errorf("retry pipeline source stage: %w", err)
+			return fmt.Errorf("retry pipeline stage %s: %w", stageName, err)
 		}
 	}
 	return nil
@@ -201,10 +216,19 @@ func (c *CodePipeline) GetPipelineState(name string) (*PipelineState, error) {
 		var actions []StageAction
 		for _, actionState := range stage.ActionStates {
 			if actionState.LatestExecution != nil {
-				actions = append(actions, StageAction{
-					Name:   aws.StringValue(actionState.ActionName),
-					Status: aws.StringValue(actionState.LatestExecution.Status),
-				})
+				action := StageAction{
+					Name:                aws.StringValue(actionState.ActionName),
+					Status:              aws.StringValue(actionState.LatestExecution.Status),
+					Summary:             aws.StringValue(actionState.LatestExecution.Summary),
+					ExternalExecutionID: aws.StringValue(actionState.LatestExecution.ExternalExecutionId),
+				}
+				if actionState.CurrentRevision != nil {
+					action.Revision = aws.StringValue(actionState.CurrentRevision.RevisionId)
+				}
+				if actionState.LatestExecution.ErrorDetails != nil {
+					action.ErrorMessage = aws.StringValue(actionState.LatestExecution.ErrorDetails.Message)
+				}
+				actions = append(actions, action)
 			}
 		}
 		stageStates = append(stageStates, &StageState{
@@ -296,6 +320,29 @@ func (sa StageAction) humanString() string {
 	return sa.Name + "\t\t" + fmtStatus(sa.Status)
 }
 
+// Details returns the source revision of the action, and the error and logs of the action if it failed.
+func (sa StageAction) Details() []string {
+	var details []string
+	if sa.Revision != "" {
+		revision := sa.Revision
+		if len(revision) > shortRevisionLength {
+			revision = revision[:shortRevisionLength]
+		}
+		summary, _, _ := strings.Cut(sa.Summary, "\n")
+		details = append(details, strings.TrimSpace(fmt.Sprintf("Revision: %s %s", revision, summary)))
+	}
+	if sa.Status != ActionStatusFailed {
+		return details
+	}
+	if sa.ErrorMessage != "" {
+		details = append(details, fmt.Sprintf("Error: %s", sa.ErrorMessage))
+	}
+	for _, line := range sa.Logs {
+		details = append(details, color.Faint.Sprint(line))
+	}
+	return details
+}
+
 func fmtStatus(status string) string {
 	const empty = "  -"
 	switch status {
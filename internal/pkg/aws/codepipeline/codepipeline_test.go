@@ -265,8 +265,14 @@ func TestCodePipeline_GetPipelineState(t *testing.T) {
 			{
 				ActionStates: []*codepipeline.ActionState{
 					{
-						ActionName:      aws.String("action1"),
-						LatestExecution: &codepipeline.ActionExecution{Status: aws.String(codepipeline.ActionExecutionStatusSucceeded)},
+						ActionName: aws.String("action1"),
+						CurrentRevision: &codepipeline.ActionRevision{
+							RevisionId: aws.String("8cb4a5e3f2c1d0b9a8f7e6d5c4b3a2918f7e6d5c"),
+						},
+						LatestExecution: &codepipeline.ActionExecution{
+							Status:  aws.String(codepipeline.ActionExecutionStatusSucceeded),
+							Summary: aws.String("Update README"),
+						},
 					},
 					{
 						ActionName:      aws.String("action2"),
@@ -279,8 +285,14 @@ func TestCodePipeline_GetPipelineState(t *testing.T) {
 				InboundTransitionState: &codepipeline.TransitionState{Enabled: aws.Bool(true)},
 				ActionStates: []*codepipeline.ActionState{
 					{
-						ActionName:      aws.String("action1"),
-						LatestExecution: &codepipeline.ActionExecution{Status: aws.String(codepipeline.ActionExecutionStatusFailed)},
+						ActionName: aws.String("action1"),
+						LatestExecution: &codepipeline.ActionExecution{
+							Status:              aws.String(codepipeline.ActionExecutionStatusFailed),
+							ExternalExecutionId: aws.String("pipeline-dinder-badgoose-repo-BuildProject:1a2b3c4d"),
+							ErrorDetails: &codepipeline.ErrorDetails{
+								Message: aws.String("Error while executing command: make test. Reason: exit status 2"),
+							},
+						},
 					},
 					{
 						ActionName:      aws.String("action2"),
@@ -338,8 +350,10 @@ func TestCodePipeline_GetPipelineState(t *testing.T) {
 						StageName: "Source",
 						Actions: []StageAction{
 							{
-								Name:   "action1",
-								Status: "Succeeded",
+								Name:     "action1",
+								Status:   "Succeeded",
+								Revision: "8cb4a5e3f2c1d0b9a8f7e6d5c4b3a2918f7e6d5c",
+								Summary:  "Update README",
 							},
 							{
 								Name:   "action2",
@@ -352,8 +366,10 @@ func TestCodePipeline_GetPipelineState(t *testing.T) {
 						StageName: "Build",
 						Actions: []StageAction{
 							{
-								Name:   "action1",
-								Status: "Failed",
+								Name:                "action1",
+								Status:              "Failed",
+								ErrorMessage:        "Error while executing command: make test. Reason: exit status 2",
+								ExternalExecutionID: "pipeline-dinder-badgoose-repo-BuildProject:1a2b3c4d",
 							},
 							{
 								Name:   "action2",
@@ -546,7 +562,7 @@ func TestCodePipeline_RetryStageExecution(t *testing.T) {
 					}).Return(nil, mockErr)
 			},
 			expectedOut:   nil,
-			expectedError: fmt.Errorf("retry pipeline source stage: some error"),
+			expectedError: fmt.Errorf("retry pipeline stage Source: some error"),
 		},
 	}
 
@@ -0,0 +1,96 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package dynamodb provides a client to make API requests to Amazon DynamoDB.
+package dynamodb
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+const (
+	// ResourceTypeTable is the resource type of a DynamoDB table.
+	ResourceTypeTable = "dynamodb:table"
+
+	// BillingModeProvisioned is the billing mode of a table without a billing mode summary.
+	BillingModeProvisioned = dynamodb.BillingModeProvisioned
+)
+
+type api interface {
+	DescribeTable(input *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error)
+	DescribeTimeToLive(input *dynamodb.DescribeTimeToLiveInput) (*dynamodb.DescribeTimeToLiveOutput, error)
+}
+
+// DynamoDB wraps an Amazon DynamoDB client.
+type DynamoDB struct {
+	client api
+}
+
+// New returns a DynamoDB client configured against the input session.
+func New(s *session.Session) *DynamoDB {
+	return &DynamoDB{
+		client: dynamodb.New(s),
+	}
+}
+
+// Table holds the description of a DynamoDB table.
+type Table struct {
+	Name                   string
+	ARN                    string
+	Status                 string
+	BillingMode            string
+	ItemCount              int64
+	SizeInBytes            int64
+	StreamViewType         string
+	StreamARN              string
+	GlobalSecondaryIndexes []string
+}
+
+// DescribeTable returns the description of a table.
+func (d *DynamoDB) DescribeTable(name string) (*Table, error) {
+	out, err := d.client.DescribeTable(&dynamodb.DescribeTableInput{
+		TableName: aws.String(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe table %s: %w", name, err)
+	}
+	desc := out.Table
+	table := &Table{
+		Name:        aws.StringValue(desc.TableName),
+		ARN:         aws.StringValue(desc.TableArn),
+		Status:      aws.StringValue(desc.TableStatus),
+		BillingMode: BillingModeProvisioned,
+		ItemCount:   aws.Int64Value(desc.ItemCount),
+		SizeInBytes: aws.Int64Value(desc.TableSizeBytes),
+		StreamARN:   aws.StringValue(desc.LatestStreamArn),
+	}
+	if desc.BillingModeSummary != nil {
+		table.BillingMode = aws.StringValue(desc.BillingModeSummary.BillingMode)
+	}
+	if desc.StreamSpecification != nil && aws.BoolValue(desc.StreamSpecification.StreamEnabled) {
+		table.StreamViewType = aws.StringValue(desc.StreamSpecification.StreamViewType)
+	}
+	for _, gsi := range desc.GlobalSecondaryIndexes {
+		table.GlobalSecondaryIndexes = append(table.GlobalSecondaryIndexes, aws.StringValue(gsi.IndexName))
+	}
+	return table, nil
+}
+
+// TTLAttribute returns the name of the time to live attribute of a table.
+// An empty string is returned if time to live is not enabled on the table.
+func (d *DynamoDB) TTLAttribute(name string) (string, error) {
+	out, err := d.client.DescribeTimeToLive(&dynamodb.DescribeTimeToLiveInput{
+		TableName: aws.String(name),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe time to live of table %s: %w", name, err)
+	}
+	if out.TimeToLiveDescription == nil || aws.StringValue(out.TimeToLiveDescription.TimeToLiveStatus) != dynamodb.TimeToLiveStatusEnabled {
+		return "", nil
+	}
+	return aws.StringValue(out.TimeToLiveDescription.AttributeName), nil
+}
@@ -0,0 +1,157 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package dynamodb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/copilot-cli/internal/pkg/aws/dynamodb/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamoDB_DescribeTable(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      *Table
+		wantedError error
+	}{
+		"return the description of an on-demand table with a stream": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTable(&dynamodb.DescribeTableInput{
+					TableName: aws.String("phonetool-test-users"),
+				}).Return(&dynamodb.DescribeTableOutput{
+					Table: &dynamodb.TableDescription{
+						TableName:          aws.String("phonetool-test-users"),
+						TableArn:           aws.String("arn:aws:dynamodb:us-west-2:123456789012:table/phonetool-test-users"),
+						TableStatus:        aws.String("ACTIVE"),
+						BillingModeSummary: &dynamodb.BillingModeSummary{BillingMode: aws.String("PAY_PER_REQUEST")},
+						ItemCount:          aws.Int64(42),
+						TableSizeBytes:     aws.Int64(2048),
+						StreamSpecification: &dynamodb.StreamSpecification{
+							StreamEnabled:  aws.Bool(true),
+							StreamViewType: aws.String("NEW_AND_OLD_IMAGES"),
+						},
+						LatestStreamArn: aws.String("arn:aws:dynamodb:us-west-2:123456789012:table/phonetool-test-users/stream/2023-03-01T00:00:00.000"),
+						GlobalSecondaryIndexes: []*dynamodb.GlobalSecondaryIndexDescription{
+							{IndexName: aws.String("statusIndex")},
+						},
+					},
+				}, nil)
+			},
+			wanted: &Table{
+				Name:                   "phonetool-test-users",
+				ARN:                    "arn:aws:dynamodb:us-west-2:123456789012:table/phonetool-test-users",
+				Status:                 "ACTIVE",
+				BillingMode:            "PAY_PER_REQUEST",
+				ItemCount:              42,
+				SizeInBytes:            2048,
+				StreamViewType:         "NEW_AND_OLD_IMAGES",
+				StreamARN:              "arn:aws:dynamodb:us-west-2:123456789012:table/phonetool-test-users/stream/2023-03-01T00:00:00.000",
+				GlobalSecondaryIndexes: []string{"statusIndex"},
+			},
+		},
+		"default to provisioned billing mode": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTable(gomock.Any()).Return(&dynamodb.DescribeTableOutput{
+					Table: &dynamodb.TableDescription{
+						TableName:   aws.String("phonetool-test-users"),
+						TableStatus: aws.String("ACTIVE"),
+					},
+				}, nil)
+			},
+			wanted: &Table{
+				Name:        "phonetool-test-users",
+				Status:      "ACTIVE",
+				BillingMode: "PROVISIONED",
+			},
+		},
+		"wrap the error": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTable(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe table phonetool-test-users: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := DynamoDB{client: m}
+
+			got, err := client.DescribeTable("phonetool-test-users")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestDynamoDB_TTLAttribute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      string
+		wantedError error
+	}{
+		"return the time to live attribute": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTimeToLive(&dynamodb.DescribeTimeToLiveInput{
+					TableName: aws.String("phonetool-test-users"),
+				}).Return(&dynamodb.DescribeTimeToLiveOutput{
+					TimeToLiveDescription: &dynamodb.TimeToLiveDescription{
+						AttributeName:    aws.String("expiresAt"),
+						TimeToLiveStatus: aws.String("ENABLED"),
+					},
+				}, nil)
+			},
+			wanted: "expiresAt",
+		},
+		"return empty if time to live is disabled": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTimeToLive(gomock.Any()).Return(&dynamodb.DescribeTimeToLiveOutput{
+					TimeToLiveDescription: &dynamodb.TimeToLiveDescription{
+						TimeToLiveStatus: aws.String("DISABLED"),
+					},
+				}, nil)
+			},
+		},
+		"wrap the error": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTimeToLive(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe time to live of table phonetool-test-users: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := DynamoDB{client: m}
+
+			got, err := client.TTLAttribute("phonetool-test-users")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
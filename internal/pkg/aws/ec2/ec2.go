@@ -40,6 +40,7 @@ type api interface {
 	DescribeRouteTables(input *ec2.DescribeRouteTablesInput) (*ec2.DescribeRouteTablesOutput, error)
 	DescribeAvailabilityZones(input *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error)
 	DescribeManagedPrefixLists(input *ec2.DescribeManagedPrefixListsInput) (*ec2.DescribeManagedPrefixListsOutput, error)
+	DescribeAddresses(input *ec2.DescribeAddressesInput) (*ec2.DescribeAddressesOutput, error)
 }
 
 // Filter contains the name and values of a filter.
@@ -83,6 +84,7 @@ type Resource struct {
 // VPC contains the ID and name of a VPC.
 type VPC struct {
 	Resource
+	CIDRBlock string
 }
 
 // Subnet contains the ID and name of a subnet.
@@ -165,6 +167,22 @@ func (c *EC2) PublicIP(eni string) (string, error) {
 	return aws.StringValue(association.PublicIp), nil
 }
 
+// ElasticIPCount returns the number of Elastic IP addresses allocated for use in VPCs.
+func (c *EC2) ElasticIPCount() (int, error) {
+	out, err := c.client.DescribeAddresses(&ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("domain"),
+				Values: aws.StringSlice([]string{ec2.DomainTypeVpc}),
+			},
+		},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("describe elastic IP addresses: %w", err)
+	}
+	return len(out.Addresses), nil
+}
+
 // ListVPCs returns names and IDs (or just IDs, if Name tag does not exist) of all VPCs.
 func (c *EC2) ListVPCs() ([]VPC, error) {
 	var ec2vpcs []*ec2.Vpc
@@ -196,6 +214,7 @@ func (c *EC2) ListVPCs() ([]VPC, error) {
 				ID:   aws.StringValue(vpc.VpcId),
 				Name: name,
 			},
+			CIDRBlock: aws.StringValue(vpc.CidrBlock),
 		})
 	}
 	return vpcs, nil
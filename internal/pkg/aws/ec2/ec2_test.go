@@ -809,6 +809,61 @@ func TestEC2_PublicIP(t *testing.T) {
 	}
 }
 
+func TestEC2_ElasticIPCount(t *testing.T) {
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wanted    int
+		wantedErr error
+	}{
+		"failed to describe addresses": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAddresses(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("describe elastic IP addresses: some error"),
+		},
+		"counts the addresses of VPCs": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAddresses(&ec2.DescribeAddressesInput{
+					Filters: []*ec2.Filter{
+						{
+							Name:   aws.String("domain"),
+							Values: aws.StringSlice([]string{"vpc"}),
+						},
+					},
+				}).Return(&ec2.DescribeAddressesOutput{
+					Addresses: []*ec2.Address{
+						{AllocationId: aws.String("eipalloc-1")},
+						{AllocationId: aws.String("eipalloc-2")},
+					},
+				}, nil)
+			},
+			wanted: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			out, err := ec2Client.ElasticIPCount()
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, out)
+			}
+		})
+	}
+}
+
 func TestEC2_SubnetIDs(t *testing.T) {
 	mockNextToken := aws.String("mockNextToken")
 	testCases := map[string]struct {
@@ -5,10 +5,16 @@
 package ecr
 
 import (
+	"context"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -16,6 +22,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/cache"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 )
 
@@ -31,22 +38,58 @@ type api interface {
 	GetAuthorizationToken(*ecr.GetAuthorizationTokenInput) (*ecr.GetAuthorizationTokenOutput, error)
 	DescribeRepositories(*ecr.DescribeRepositoriesInput) (*ecr.DescribeRepositoriesOutput, error)
 	BatchDeleteImage(*ecr.BatchDeleteImageInput) (*ecr.BatchDeleteImageOutput, error)
+	DescribeImageScanFindings(*ecr.DescribeImageScanFindingsInput) (*ecr.DescribeImageScanFindingsOutput, error)
+	StartImageScan(*ecr.StartImageScanInput) (*ecr.StartImageScanOutput, error)
+	BatchGetImage(*ecr.BatchGetImageInput) (*ecr.BatchGetImageOutput, error)
+	PutImage(*ecr.PutImageInput) (*ecr.PutImageOutput, error)
+	GetDownloadUrlForLayer(*ecr.GetDownloadUrlForLayerInput) (*ecr.GetDownloadUrlForLayerOutput, error)
 }
 
+type httpClient interface {
+	Get(url string) (*http.Response, error)
+}
+
+// scanFindingsPollDelay is how long to wait in between polls for the status of an image scan.
+var scanFindingsPollDelay = 5 * time.Second
+
+// authExpiryMargin is how long before its expiry a cached authorization token stops being reused,
+// so that a push that starts with the token doesn't outlive it.
+const authExpiryMargin = 30 * time.Minute
+
 // ECR wraps an AWS ECR client.
 type ECR struct {
 	client api
+	http   httpClient
+
+	cache    *cache.Cache // Keeps the authorization token for the next commands. If nil, it's requested every time.
+	cacheKey string
 }
 
 // New returns a ECR configured against the input session.
 func New(s *session.Session) ECR {
-	return ECR{
+	c := ECR{
 		client: ecr.New(s),
+		http:   http.DefaultClient,
+	}
+	if scope := cache.Scope(s.Config.Credentials, aws.StringValue(s.Config.Region)); scope != "" {
+		c.cache, c.cacheKey = cache.New(), "ecr/"+scope+"/auth"
 	}
+	return c
+}
+
+// registryAuth is the basic authentication credentials of the registry in the cache.
+type registryAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
 }
 
 // Auth returns the basic authentication credentials needed to push images.
+// The credentials are reused by the next commands until shortly before they expire.
 func (c ECR) Auth() (username string, password string, err error) {
+	var auth registryAuth
+	if c.cache.Get(c.cacheKey, &auth) {
+		return auth.Username, auth.Password, nil
+	}
 	response, err := c.client.GetAuthorizationToken(&ecr.GetAuthorizationTokenInput{})
 
 	if err != nil {
@@ -60,6 +103,9 @@ func (c ECR) Auth() (username string, password string, err error) {
 	}
 
 	tokenStrings := strings.Split(string(authToken), ":")
+	if ttl := time.Until(aws.TimeValue(response.AuthorizationData[0].ExpiresAt)) - authExpiryMargin; ttl > 0 {
+		_ = c.cache.Set(c.cacheKey, registryAuth{Username: tokenStrings[0], Password: tokenStrings[1]}, ttl)
+	}
 	return tokenStrings[0], tokenStrings[1], nil
 }
 
@@ -86,7 +132,20 @@ func (c ECR) RepositoryURI(name string) (string, error) {
 
 // Image houses metadata for ECR repository images.
 type Image struct {
-	Digest string
+	Digest   string
+	Tags     []string
+	PushedAt time.Time
+}
+
+func newImage(details *ecr.ImageDetail) Image {
+	img := Image{
+		Digest:   aws.StringValue(details.ImageDigest),
+		PushedAt: aws.TimeValue(details.ImagePushedAt),
+	}
+	for _, tag := range details.ImageTags {
+		img.Tags = append(img.Tags, aws.StringValue(tag))
+	}
+	return img
 }
 
 func (i Image) imageIdentifier() *ecr.ImageIdentifier {
@@ -106,9 +165,7 @@ func (c ECR) ListImages(repoName string) ([]Image, error) {
 		return nil, fmt.Errorf("ecr repo %s describe images: %w", repoName, err)
 	}
 	for _, imageDetails := range resp.ImageDetails {
-		images = append(images, Image{
-			Digest: *imageDetails.ImageDigest,
-		})
+		images = append(images, newImage(imageDetails))
 	}
 	for resp.NextToken != nil {
 		resp, err = c.client.DescribeImages(&ecr.DescribeImagesInput{
@@ -119,9 +176,7 @@ func (c ECR) ListImages(repoName string) ([]Image, error) {
 			return nil, fmt.Errorf("ecr repo %s describe images: %w", repoName, err)
 		}
 		for _, imageDetails := range resp.ImageDetails {
-			images = append(images, Image{
-				Digest: *imageDetails.ImageDigest,
-			})
+			images = append(images, newImage(imageDetails))
 		}
 	}
 	return images, nil
@@ -175,6 +230,259 @@ func (c ECR) ClearRepository(repoName string) error {
 	return err
 }
 
+// ErrImageNotFound occurs when an image doesn't exist in a repository.
+type ErrImageNotFound struct {
+	repoName string
+	digest   string
+}
+
+func (e *ErrImageNotFound) Error() string {
+	return fmt.Sprintf("image %s not found in repository %s", e.digest, e.repoName)
+}
+
+// TagImage adds the tags to the image with the digest, without pulling or pushing its layers.
+// It returns an ErrImageNotFound if the image was deleted from the repository.
+func (c ECR) TagImage(repoName, digest string, tags ...string) error {
+	resp, err := c.client.BatchGetImage(&ecr.BatchGetImageInput{
+		RepositoryName: aws.String(repoName),
+		ImageIds: []*ecr.ImageIdentifier{
+			{
+				ImageDigest: aws.String(digest),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("ecr repo %s batch get image %s: %w", repoName, digest, err)
+	}
+	if len(resp.Images) == 0 {
+		return &ErrImageNotFound{
+			repoName: repoName,
+			digest:   digest,
+		}
+	}
+	img := resp.Images[0]
+	for _, tag := range tags {
+		_, err := c.client.PutImage(&ecr.PutImageInput{
+			RepositoryName:         aws.String(repoName),
+			ImageDigest:            aws.String(digest),
+			ImageManifest:          img.ImageManifest,
+			ImageManifestMediaType: img.ImageManifestMediaType,
+			ImageTag:               aws.String(tag),
+		})
+		if err != nil && !isImageAlreadyExistsErr(err) {
+			return fmt.Errorf("ecr repo %s tag image %s with %s: %w", repoName, digest, tag, err)
+		}
+	}
+	return nil
+}
+
+// imageURIRegexp matches the URI of an image in an ECR repository referenced by its digest,
+// such as 123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend@sha256:18f7eb6c...
+var imageURIRegexp = regexp.MustCompile(`^(\d{12})\.dkr\.ecr\.([a-z0-9-]+)\.amazonaws\.com(?:\.cn)?/([a-z0-9._/-]+)@(sha256:[a-f0-9]{64})$`)
+
+// ImageURI is an image in an ECR repository referenced by its digest.
+type ImageURI struct {
+	RegistryID string // ID of the account of the registry.
+	Region     string
+	RepoName   string
+	Digest     string
+}
+
+// ParseImageURI parses the URI of an image in an ECR repository that's referenced by its digest.
+func ParseImageURI(uri string) (ImageURI, error) {
+	m := imageURIRegexp.FindStringSubmatch(uri)
+	if m == nil {
+		return ImageURI{}, fmt.Errorf("image %q must be an ECR repository URI followed by an image digest, such as 123456789012.dkr.ecr.us-west-2.amazonaws.com/repo@sha256:<digest>", uri)
+	}
+	return ImageURI{
+		RegistryID: m[1],
+		Region:     m[2],
+		RepoName:   m[3],
+		Digest:     m[4],
+	}, nil
+}
+
+// Media types of the image manifests that ImagePlatforms accepts.
+var imageManifestMediaTypes = []string{
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+}
+
+// imageManifest holds the fields of an image manifest, or of a manifest list for multi-platform images.
+type imageManifest struct {
+	Manifests []struct {
+		Platform imagePlatform `json:"platform"`
+	} `json:"manifests"`
+	Config struct {
+		Digest string `json:"digest"`
+	} `json:"config"`
+}
+
+type imagePlatform struct {
+	OS           string `json:"os"`
+	Architecture string `json:"architecture"`
+}
+
+// ImagePlatforms returns the platforms, such as "linux/amd64", that the image can run on.
+// It returns an ErrImageNotFound if the image doesn't exist in the repository.
+func (c ECR) ImagePlatforms(img ImageURI) ([]string, error) {
+	resp, err := c.client.BatchGetImage(&ecr.BatchGetImageInput{
+		RegistryId:         aws.String(img.RegistryID),
+		RepositoryName:     aws.String(img.RepoName),
+		ImageIds:           []*ecr.ImageIdentifier{{ImageDigest: aws.String(img.Digest)}},
+		AcceptedMediaTypes: aws.StringSlice(imageManifestMediaTypes),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ecr repo %s batch get image %s: %w", img.RepoName, img.Digest, err)
+	}
+	if len(resp.Images) == 0 {
+		return nil, &ErrImageNotFound{
+			repoName: img.RepoName,
+			digest:   img.Digest,
+		}
+	}
+	var mft imageManifest
+	if err := json.Unmarshal([]byte(aws.StringValue(resp.Images[0].ImageManifest)), &mft); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest of image %s: %w", img.Digest, err)
+	}
+	if len(mft.Manifests) != 0 {
+		var platforms []string
+		for _, m := range mft.Manifests {
+			if m.Platform.OS == "unknown" {
+				continue // Attestations such as provenance are attached to the list with an unknown platform.
+			}
+			platforms = append(platforms, m.Platform.String())
+		}
+		return platforms, nil
+	}
+	// The platform of a single image is only in its configuration, stored as a layer.
+	platform, err := c.imageConfigPlatform(img, mft.Config.Digest)
+	if err != nil {
+		return nil, err
+	}
+	return []string{platform.String()}, nil
+}
+
+func (c ECR) imageConfigPlatform(img ImageURI, configDigest string) (imagePlatform, error) {
+	resp, err := c.client.GetDownloadUrlForLayer(&ecr.GetDownloadUrlForLayerInput{
+		RegistryId:     aws.String(img.RegistryID),
+		RepositoryName: aws.String(img.RepoName),
+		LayerDigest:    aws.String(configDigest),
+	})
+	if err != nil {
+		return imagePlatform{}, fmt.Errorf("ecr repo %s get configuration of image %s: %w", img.RepoName, img.Digest, err)
+	}
+	dl, err := c.http.Get(aws.StringValue(resp.DownloadUrl))
+	if err != nil {
+		return imagePlatform{}, fmt.Errorf("download configuration of image %s: %w", img.Digest, err)
+	}
+	defer dl.Body.Close()
+	if dl.StatusCode != http.StatusOK {
+		return imagePlatform{}, fmt.Errorf("download configuration of image %s: unexpected status %s", img.Digest, dl.Status)
+	}
+	content, err := io.ReadAll(dl.Body)
+	if err != nil {
+		return imagePlatform{}, fmt.Errorf("read configuration of image %s: %w", img.Digest, err)
+	}
+	var platform imagePlatform
+	if err := json.Unmarshal(content, &platform); err != nil {
+		return imagePlatform{}, fmt.Errorf("unmarshal configuration of image %s: %w", img.Digest, err)
+	}
+	return platform, nil
+}
+
+// String returns the platform in the "os/arch" format.
+func (p imagePlatform) String() string {
+	return fmt.Sprintf("%s/%s", p.OS, p.Architecture)
+}
+
+// ScanFinding is a vulnerability found by scanning an image.
+type ScanFinding struct {
+	Name     string // CVE identifier or title of the vulnerability.
+	Severity string
+	Package  string // Name of the vulnerable package, only available with enhanced scanning.
+	URI      string
+}
+
+// ImageScanFindings waits for the scan of the image with the digest to complete and returns its findings.
+// If the repository uses basic scanning and the image was not scanned on push, a scan is started.
+func (c ECR) ImageScanFindings(ctx context.Context, repoName, digest string) ([]ScanFinding, error) {
+	in := &ecr.DescribeImageScanFindingsInput{
+		RepositoryName: aws.String(repoName),
+		ImageId: &ecr.ImageIdentifier{
+			ImageDigest: aws.String(digest),
+		},
+	}
+	var started bool
+	for {
+		out, err := c.client.DescribeImageScanFindings(in)
+		if err != nil {
+			if !isScanNotFoundErr(err) || started {
+				return nil, fmt.Errorf("describe scan findings of image %s in ecr repo %s: %w", digest, repoName, err)
+			}
+			if _, err := c.client.StartImageScan(&ecr.StartImageScanInput{
+				RepositoryName: in.RepositoryName,
+				ImageId:        in.ImageId,
+			}); err != nil {
+				return nil, fmt.Errorf("start scan of image %s in ecr repo %s: %w", digest, repoName, err)
+			}
+			started = true
+		} else {
+			switch status := aws.StringValue(out.ImageScanStatus.Status); status {
+			case ecr.ScanStatusComplete, ecr.ScanStatusActive:
+				return c.scanFindings(in, out)
+			case ecr.ScanStatusFailed, ecr.ScanStatusUnsupportedImage, ecr.ScanStatusFindingsUnavailable, ecr.ScanStatusScanEligibilityExpired:
+				return nil, fmt.Errorf("scan image %s in ecr repo %s: %s: %s", digest, repoName, status, aws.StringValue(out.ImageScanStatus.Description))
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("wait for scan of image %s in ecr repo %s: %w", digest, repoName, ctx.Err())
+		case <-time.After(scanFindingsPollDelay):
+		}
+	}
+}
+
+func (c ECR) scanFindings(in *ecr.DescribeImageScanFindingsInput, out *ecr.DescribeImageScanFindingsOutput) ([]ScanFinding, error) {
+	var findings []ScanFinding
+	for {
+		if out.ImageScanFindings != nil {
+			for _, f := range out.ImageScanFindings.Findings {
+				findings = append(findings, ScanFinding{
+					Name:     aws.StringValue(f.Name),
+					Severity: aws.StringValue(f.Severity),
+					URI:      aws.StringValue(f.Uri),
+				})
+			}
+			for _, f := range out.ImageScanFindings.EnhancedFindings {
+				finding := ScanFinding{
+					Name:     aws.StringValue(f.Title),
+					Severity: aws.StringValue(f.Severity),
+				}
+				if details := f.PackageVulnerabilityDetails; details != nil {
+					finding.Name = aws.StringValue(details.VulnerabilityId)
+					finding.URI = aws.StringValue(details.SourceUrl)
+					if len(details.VulnerablePackages) > 0 {
+						finding.Package = aws.StringValue(details.VulnerablePackages[0].Name)
+					}
+				}
+				findings = append(findings, finding)
+			}
+		}
+		if out.NextToken == nil {
+			return findings, nil
+		}
+		in.NextToken = out.NextToken
+		var err error
+		out, err = c.client.DescribeImageScanFindings(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe scan findings of image %s in ecr repo %s: %w", aws.StringValue(in.ImageId.ImageDigest), aws.StringValue(in.RepositoryName), err)
+		}
+	}
+}
+
 // URIFromARN converts an ECR Repo ARN to a Repository URI
 func URIFromARN(repositoryARN string) (string, error) {
 	repoARN, err := arn.Parse(repositoryARN)
@@ -204,3 +512,13 @@ func isRepoNotFoundErr(err error) bool {
 	}
 	return false
 }
+
+func isScanNotFoundErr(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == ecr.ErrCodeScanNotFoundException
+}
+
+func isImageAlreadyExistsErr(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == ecr.ErrCodeImageAlreadyExistsException
+}
@@ -4,10 +4,15 @@
 package ecr
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -63,7 +68,7 @@ func TestAuth(t *testing.T) {
 			tc.mockECRClient(mockECRAPI)
 
 			client := ECR{
-				mockECRAPI,
+				client: mockECRAPI,
 			}
 
 			gotUsername, gotPassword, gotErr := client.Auth()
@@ -130,7 +135,7 @@ func TestRepositoryURI(t *testing.T) {
 			tc.mockECRClient(mockECRAPI)
 
 			client := ECR{
-				mockECRAPI,
+				client: mockECRAPI,
 			}
 
 			gotURI, gotErr := client.RepositoryURI(mockRepoName)
@@ -207,12 +212,14 @@ func TestListImages(t *testing.T) {
 				m.EXPECT().DescribeImages(gomock.Any()).Return(&ecr.DescribeImagesOutput{
 					ImageDetails: []*ecr.ImageDetail{
 						{
-							ImageDigest: aws.String(mockDigest),
+							ImageDigest:   aws.String(mockDigest),
+							ImageTags:     aws.StringSlice([]string{"latest"}),
+							ImagePushedAt: aws.Time(time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)),
 						},
 					},
 				}, nil)
 			},
-			wantImages: []Image{{Digest: mockDigest}},
+			wantImages: []Image{{Digest: mockDigest, Tags: []string{"latest"}, PushedAt: time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)}},
 			wantError:  nil,
 		},
 		"should return all images when paginated": {
@@ -253,7 +260,7 @@ func TestListImages(t *testing.T) {
 			tc.mockECRClient(mockECRAPI)
 
 			client := ECR{
-				mockECRAPI,
+				client: mockECRAPI,
 			}
 
 			gotImages, gotError := client.ListImages(mockRepoName)
@@ -362,7 +369,7 @@ func TestDeleteImages(t *testing.T) {
 			tc.mockECRClient(mockECRAPI)
 
 			client := ECR{
-				mockECRAPI,
+				client: mockECRAPI,
 			}
 
 			got := client.DeleteImages(tc.images, mockRepoName)
@@ -441,7 +448,7 @@ func TestClearRepository(t *testing.T) {
 			tc.mockECRClient(mockECRAPI)
 
 			client := ECR{
-				mockECRAPI,
+				client: mockECRAPI,
 			}
 
 			gotError := client.ClearRepository(mockRepoName)
@@ -450,3 +457,371 @@ func TestClearRepository(t *testing.T) {
 		})
 	}
 }
+
+func TestTagImage(t *testing.T) {
+	const (
+		mockRepoName = "phonetool/frontend"
+		mockDigest   = "sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7"
+	)
+	mockBatchGetImageInput := &ecr.BatchGetImageInput{
+		RepositoryName: aws.String(mockRepoName),
+		ImageIds: []*ecr.ImageIdentifier{
+			{
+				ImageDigest: aws.String(mockDigest),
+			},
+		},
+	}
+	mockImage := &ecr.Image{
+		ImageManifest:          aws.String("{}"),
+		ImageManifestMediaType: aws.String("application/vnd.docker.distribution.manifest.v2+json"),
+	}
+	putImageInput := func(tag string) *ecr.PutImageInput {
+		return &ecr.PutImageInput{
+			RepositoryName:         aws.String(mockRepoName),
+			ImageDigest:            aws.String(mockDigest),
+			ImageManifest:          mockImage.ImageManifest,
+			ImageManifestMediaType: mockImage.ImageManifestMediaType,
+			ImageTag:               aws.String(tag),
+		}
+	}
+
+	tests := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantedErr error
+	}{
+		"returns ErrImageNotFound if the image was deleted": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetImage(mockBatchGetImageInput).Return(&ecr.BatchGetImageOutput{
+					Failures: []*ecr.ImageFailure{
+						{
+							FailureCode: aws.String(ecr.ImageFailureCodeImageNotFound),
+						},
+					},
+				}, nil)
+			},
+			wantedErr: &ErrImageNotFound{repoName: mockRepoName, digest: mockDigest},
+		},
+		"adds each tag to the image": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetImage(mockBatchGetImageInput).Return(&ecr.BatchGetImageOutput{
+					Images: []*ecr.Image{mockImage},
+				}, nil)
+				m.EXPECT().PutImage(putImageInput("latest")).Return(nil, awserr.New(ecr.ErrCodeImageAlreadyExistsException, "tag exists", nil))
+				m.EXPECT().PutImage(putImageInput("8fa2b1c")).Return(&ecr.PutImageOutput{}, nil)
+			},
+		},
+		"returns the error of a tag that can't be added": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().BatchGetImage(mockBatchGetImageInput).Return(&ecr.BatchGetImageOutput{
+					Images: []*ecr.Image{mockImage},
+				}, nil)
+				m.EXPECT().PutImage(putImageInput("latest")).Return(nil, errors.New("some error"))
+			},
+			wantedErr: fmt.Errorf("ecr repo %s tag image %s with latest: some error", mockRepoName, mockDigest),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				client: mockECRAPI,
+			}
+
+			// WHEN
+			err := client.TagImage(mockRepoName, mockDigest, "latest", "8fa2b1c")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseImageURI(t *testing.T) {
+	const digest = "sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7"
+	testCases := map[string]struct {
+		in string
+
+		wanted      ImageURI
+		wantedError string
+	}{
+		"image referenced by digest": {
+			in: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend@" + digest,
+			wanted: ImageURI{
+				RegistryID: "123456789012",
+				Region:     "us-west-2",
+				RepoName:   "phonetool/frontend",
+				Digest:     digest,
+			},
+		},
+		"image in the China partition": {
+			in: "123456789012.dkr.ecr.cn-north-1.amazonaws.com.cn/frontend@" + digest,
+			wanted: ImageURI{
+				RegistryID: "123456789012",
+				Region:     "cn-north-1",
+				RepoName:   "frontend",
+				Digest:     digest,
+			},
+		},
+		"error if the image is referenced by tag": {
+			in:          "123456789012.dkr.ecr.us-west-2.amazonaws.com/frontend:v1.2",
+			wantedError: `image "123456789012.dkr.ecr.us-west-2.amazonaws.com/frontend:v1.2" must be an ECR repository URI followed by an image digest, such as 123456789012.dkr.ecr.us-west-2.amazonaws.com/repo@sha256:<digest>`,
+		},
+		"error if the image is not in ECR": {
+			in:          "public.ecr.aws/nginx/nginx@" + digest,
+			wantedError: `image "public.ecr.aws/nginx/nginx@` + digest + `" must be an ECR repository URI followed by an image digest, such as 123456789012.dkr.ecr.us-west-2.amazonaws.com/repo@sha256:<digest>`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseImageURI(tc.in)
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestImagePlatforms(t *testing.T) {
+	img := ImageURI{
+		RegistryID: "123456789012",
+		Region:     "us-west-2",
+		RepoName:   "phonetool/frontend",
+		Digest:     "sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7",
+	}
+	const configDigest = "sha256:0f5f445df8ccbd8a062ad3d02d459e8549d9998c62a5b7cbf77baf68aa73bf5b"
+	batchGetImageInput := &ecr.BatchGetImageInput{
+		RegistryId:         aws.String(img.RegistryID),
+		RepositoryName:     aws.String(img.RepoName),
+		ImageIds:           []*ecr.ImageIdentifier{{ImageDigest: aws.String(img.Digest)}},
+		AcceptedMediaTypes: aws.StringSlice(imageManifestMediaTypes),
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi, h *mocks.MockhttpClient)
+
+		wanted      []string
+		wantedError string
+	}{
+		"returns ErrImageNotFound if the image doesn't exist": {
+			setupMocks: func(m *mocks.Mockapi, h *mocks.MockhttpClient) {
+				m.EXPECT().BatchGetImage(batchGetImageInput).Return(&ecr.BatchGetImageOutput{}, nil)
+			},
+			wantedError: (&ErrImageNotFound{repoName: img.RepoName, digest: img.Digest}).Error(),
+		},
+		"returns the platforms of a multi-platform image without its attestations": {
+			setupMocks: func(m *mocks.Mockapi, h *mocks.MockhttpClient) {
+				m.EXPECT().BatchGetImage(batchGetImageInput).Return(&ecr.BatchGetImageOutput{
+					Images: []*ecr.Image{{ImageManifest: aws.String(`{"manifests": [
+  {"platform": {"os": "linux", "architecture": "amd64"}},
+  {"platform": {"os": "linux", "architecture": "arm64"}},
+  {"platform": {"os": "unknown", "architecture": "unknown"}}
+]}`)}},
+				}, nil)
+			},
+			wanted: []string{"linux/amd64", "linux/arm64"},
+		},
+		"returns the platform of a single image from its configuration": {
+			setupMocks: func(m *mocks.Mockapi, h *mocks.MockhttpClient) {
+				m.EXPECT().BatchGetImage(batchGetImageInput).Return(&ecr.BatchGetImageOutput{
+					Images: []*ecr.Image{{ImageManifest: aws.String(`{"config": {"digest": "` + configDigest + `"}}`)}},
+				}, nil)
+				m.EXPECT().GetDownloadUrlForLayer(&ecr.GetDownloadUrlForLayerInput{
+					RegistryId:     aws.String(img.RegistryID),
+					RepositoryName: aws.String(img.RepoName),
+					LayerDigest:    aws.String(configDigest),
+				}).Return(&ecr.GetDownloadUrlForLayerOutput{DownloadUrl: aws.String("https://layer")}, nil)
+				h.EXPECT().Get("https://layer").Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"os": "linux", "architecture": "arm64", "config": {}}`)),
+				}, nil)
+			},
+			wanted: []string{"linux/arm64"},
+		},
+		"wraps the error": {
+			setupMocks: func(m *mocks.Mockapi, h *mocks.MockhttpClient) {
+				m.EXPECT().BatchGetImage(batchGetImageInput).Return(nil, errors.New("some error"))
+			},
+			wantedError: "ecr repo phonetool/frontend batch get image " + img.Digest + ": some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m, h := mocks.NewMockapi(ctrl), mocks.NewMockhttpClient(ctrl)
+			tc.setupMocks(m, h)
+			client := ECR{
+				client: m,
+				http:   h,
+			}
+
+			got, err := client.ImagePlatforms(img)
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestImageScanFindings(t *testing.T) {
+	scanFindingsPollDelay = 0
+	const (
+		mockRepoName = "phonetool/api"
+		mockDigest   = "sha256:abc"
+	)
+	mockInput := &ecr.DescribeImageScanFindingsInput{
+		RepositoryName: aws.String(mockRepoName),
+		ImageId: &ecr.ImageIdentifier{
+			ImageDigest: aws.String(mockDigest),
+		},
+	}
+	tests := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantFindings []ScanFinding
+		wantError    error
+	}{
+		"start a scan if the image was not scanned on push": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().DescribeImageScanFindings(mockInput).Return(nil, awserr.New(ecr.ErrCodeScanNotFoundException, "not found", nil)),
+					m.EXPECT().StartImageScan(&ecr.StartImageScanInput{
+						RepositoryName: aws.String(mockRepoName),
+						ImageId:        mockInput.ImageId,
+					}).Return(&ecr.StartImageScanOutput{}, nil),
+					m.EXPECT().DescribeImageScanFindings(mockInput).Return(&ecr.DescribeImageScanFindingsOutput{
+						ImageScanStatus: &ecr.ImageScanStatus{Status: aws.String(ecr.ScanStatusInProgress)},
+					}, nil),
+					m.EXPECT().DescribeImageScanFindings(mockInput).Return(&ecr.DescribeImageScanFindingsOutput{
+						ImageScanStatus: &ecr.ImageScanStatus{Status: aws.String(ecr.ScanStatusComplete)},
+						ImageScanFindings: &ecr.ImageScanFindings{
+							Findings: []*ecr.ImageScanFinding{
+								{
+									Name:     aws.String("CVE-2023-0001"),
+									Severity: aws.String(ecr.FindingSeverityCritical),
+									Uri:      aws.String("https://cve.example.com/CVE-2023-0001"),
+								},
+							},
+						},
+					}, nil),
+				)
+			},
+			wantFindings: []ScanFinding{
+				{
+					Name:     "CVE-2023-0001",
+					Severity: "CRITICAL",
+					URI:      "https://cve.example.com/CVE-2023-0001",
+				},
+			},
+		},
+		"return the paginated findings of an enhanced scan": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImageScanFindings(mockInput).Return(&ecr.DescribeImageScanFindingsOutput{
+					ImageScanStatus: &ecr.ImageScanStatus{Status: aws.String(ecr.ScanStatusActive)},
+					ImageScanFindings: &ecr.ImageScanFindings{
+						EnhancedFindings: []*ecr.EnhancedImageScanFinding{
+							{
+								Title:    aws.String("CVE-2023-0002 - openssl"),
+								Severity: aws.String("HIGH"),
+								PackageVulnerabilityDetails: &ecr.PackageVulnerabilityDetails{
+									VulnerabilityId: aws.String("CVE-2023-0002"),
+									SourceUrl:       aws.String("https://nvd.example.com/CVE-2023-0002"),
+									VulnerablePackages: []*ecr.VulnerablePackage{
+										{Name: aws.String("openssl")},
+									},
+								},
+							},
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeImageScanFindings(gomock.Any()).Return(&ecr.DescribeImageScanFindingsOutput{
+					ImageScanStatus: &ecr.ImageScanStatus{Status: aws.String(ecr.ScanStatusActive)},
+					ImageScanFindings: &ecr.ImageScanFindings{
+						EnhancedFindings: []*ecr.EnhancedImageScanFinding{
+							{
+								Title:    aws.String("Weak configuration"),
+								Severity: aws.String("LOW"),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantFindings: []ScanFinding{
+				{
+					Name:     "CVE-2023-0002",
+					Severity: "HIGH",
+					Package:  "openssl",
+					URI:      "https://nvd.example.com/CVE-2023-0002",
+				},
+				{
+					Name:     "Weak configuration",
+					Severity: "LOW",
+				},
+			},
+		},
+		"error if the scan failed": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImageScanFindings(mockInput).Return(&ecr.DescribeImageScanFindingsOutput{
+					ImageScanStatus: &ecr.ImageScanStatus{
+						Status:      aws.String(ecr.ScanStatusUnsupportedImage),
+						Description: aws.String("unsupported OS"),
+					},
+				}, nil)
+			},
+			wantError: errors.New("scan image sha256:abc in ecr repo phonetool/api: UNSUPPORTED_IMAGE: unsupported OS"),
+		},
+		"error if the scan can't be started": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImageScanFindings(mockInput).Return(nil, awserr.New(ecr.ErrCodeScanNotFoundException, "not found", nil))
+				m.EXPECT().StartImageScan(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantError: errors.New("start scan of image sha256:abc in ecr repo phonetool/api: some error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				client: mockECRAPI,
+			}
+
+			// WHEN
+			gotFindings, gotError := client.ImageScanFindings(context.Background(), mockRepoName, mockDigest)
+
+			// THEN
+			if tc.wantError != nil {
+				require.EqualError(t, gotError, tc.wantError.Error())
+			} else {
+				require.NoError(t, gotError)
+				require.Equal(t, tc.wantFindings, gotFindings)
+			}
+		})
+	}
+}
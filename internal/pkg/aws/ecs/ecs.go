@@ -33,10 +33,16 @@ type api interface {
 	DescribeServices(input *ecs.DescribeServicesInput) (*ecs.DescribeServicesOutput, error)
 	DescribeTasks(input *ecs.DescribeTasksInput) (*ecs.DescribeTasksOutput, error)
 	DescribeTaskDefinition(input *ecs.DescribeTaskDefinitionInput) (*ecs.DescribeTaskDefinitionOutput, error)
+	DeregisterTaskDefinition(input *ecs.DeregisterTaskDefinitionInput) (*ecs.DeregisterTaskDefinitionOutput, error)
 	ExecuteCommand(input *ecs.ExecuteCommandInput) (*ecs.ExecuteCommandOutput, error)
+	ListTagsForResource(input *ecs.ListTagsForResourceInput) (*ecs.ListTagsForResourceOutput, error)
+	ListTaskDefinitions(input *ecs.ListTaskDefinitionsInput) (*ecs.ListTaskDefinitionsOutput, error)
 	ListTasks(input *ecs.ListTasksInput) (*ecs.ListTasksOutput, error)
+	RegisterTaskDefinition(input *ecs.RegisterTaskDefinitionInput) (*ecs.RegisterTaskDefinitionOutput, error)
 	RunTask(input *ecs.RunTaskInput) (*ecs.RunTaskOutput, error)
 	StopTask(input *ecs.StopTaskInput) (*ecs.StopTaskOutput, error)
+	TagResource(input *ecs.TagResourceInput) (*ecs.TagResourceOutput, error)
+	UntagResource(input *ecs.UntagResourceInput) (*ecs.UntagResourceOutput, error)
 	UpdateService(input *ecs.UpdateServiceInput) (*ecs.UpdateServiceOutput, error)
 	WaitUntilTasksRunning(input *ecs.DescribeTasksInput) error
 }
@@ -98,6 +104,81 @@ func (e *ECS) TaskDefinition(taskDefName string) (*TaskDefinition, error) {
 	return &td, nil
 }
 
+// ActiveTaskDefinitions returns the ARNs of the active task definition revisions whose family starts with familyPrefix.
+func (e *ECS) ActiveTaskDefinitions(familyPrefix string) ([]string, error) {
+	var arns []string
+	in := &ecs.ListTaskDefinitionsInput{
+		FamilyPrefix: aws.String(familyPrefix),
+		Status:       aws.String(ecs.TaskDefinitionStatusActive),
+	}
+	for {
+		out, err := e.client.ListTaskDefinitions(in)
+		if err != nil {
+			return nil, fmt.Errorf("list task definitions with family prefix %s: %w", familyPrefix, err)
+		}
+		arns = append(arns, aws.StringValueSlice(out.TaskDefinitionArns)...)
+		if aws.StringValue(out.NextToken) == "" {
+			return arns, nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
+// DeregisterTaskDefinition marks a task definition revision as inactive.
+func (e *ECS) DeregisterTaskDefinition(taskDefARN string) error {
+	if _, err := e.client.DeregisterTaskDefinition(&ecs.DeregisterTaskDefinitionInput{
+		TaskDefinition: aws.String(taskDefARN),
+	}); err != nil {
+		return fmt.Errorf("deregister task definition %s: %w", taskDefARN, err)
+	}
+	return nil
+}
+
+// RegisterTaskDefinitionWithImages registers a new revision of the task definition in which the images of
+// the containers are replaced, and returns the ARN of the new revision.
+// The images are keyed by container name, and containers that aren't in images keep their image.
+func (e *ECS) RegisterTaskDefinitionWithImages(taskDefName string, images map[string]string) (string, error) {
+	resp, err := e.client.DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(taskDefName),
+		Include:        aws.StringSlice([]string{ecs.TaskDefinitionFieldTags}),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe task definition %s: %w", taskDefName, err)
+	}
+	td := resp.TaskDefinition
+	for _, container := range td.ContainerDefinitions {
+		if image, ok := images[aws.StringValue(container.Name)]; ok {
+			container.Image = aws.String(image)
+		}
+	}
+	in := &ecs.RegisterTaskDefinitionInput{
+		Family:                  td.Family,
+		ContainerDefinitions:    td.ContainerDefinitions,
+		Cpu:                     td.Cpu,
+		Memory:                  td.Memory,
+		EphemeralStorage:        td.EphemeralStorage,
+		ExecutionRoleArn:        td.ExecutionRoleArn,
+		TaskRoleArn:             td.TaskRoleArn,
+		InferenceAccelerators:   td.InferenceAccelerators,
+		IpcMode:                 td.IpcMode,
+		NetworkMode:             td.NetworkMode,
+		PidMode:                 td.PidMode,
+		PlacementConstraints:    td.PlacementConstraints,
+		ProxyConfiguration:      td.ProxyConfiguration,
+		RequiresCompatibilities: td.RequiresCompatibilities,
+		RuntimePlatform:         td.RuntimePlatform,
+		Volumes:                 td.Volumes,
+	}
+	if len(resp.Tags) > 0 {
+		in.Tags = resp.Tags
+	}
+	out, err := e.client.RegisterTaskDefinition(in)
+	if err != nil {
+		return "", fmt.Errorf("register task definition %s: %w", aws.StringValue(td.Family), err)
+	}
+	return aws.StringValue(out.TaskDefinition.TaskDefinitionArn), nil
+}
+
 // Service calls ECS API and returns the specified service running in the cluster.
 func (e *ECS) Service(clusterName, serviceName string) (*Service, error) {
 	resp, err := e.client.DescribeServices(&ecs.DescribeServicesInput{
@@ -126,6 +207,20 @@ func WithForceUpdate() UpdateServiceOpts {
 	}
 }
 
+// WithTaskDefinition sets the task definition that the service runs.
+func WithTaskDefinition(taskDefARN string) UpdateServiceOpts {
+	return func(in *ecs.UpdateServiceInput) {
+		in.TaskDefinition = aws.String(taskDefARN)
+	}
+}
+
+// WithDesiredCount sets the number of tasks that the service keeps running.
+func WithDesiredCount(count int64) UpdateServiceOpts {
+	return func(in *ecs.UpdateServiceInput) {
+		in.DesiredCount = aws.Int64(count)
+	}
+}
+
 // UpdateService calls ECS API and updates the specific service running in the cluster.
 func (e *ECS) UpdateService(clusterName, serviceName string, opts ...UpdateServiceOpts) error {
 	in := &ecs.UpdateServiceInput{
@@ -146,6 +241,50 @@ func (e *ECS) UpdateService(clusterName, serviceName string, opts ...UpdateServi
 	return nil
 }
 
+// ServiceTags returns the tags of a service.
+func (e *ECS) ServiceTags(serviceARN string) (map[string]string, error) {
+	out, err := e.client.ListTagsForResource(&ecs.ListTagsForResourceInput{
+		ResourceArn: aws.String(serviceARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list tags of service %s: %w", serviceARN, err)
+	}
+	tags := make(map[string]string, len(out.Tags))
+	for _, tag := range out.Tags {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return tags, nil
+}
+
+// TagService adds or overwrites the tags of a service.
+func (e *ECS) TagService(serviceARN string, tags map[string]string) error {
+	var ecsTags []*ecs.Tag
+	for k, v := range tags {
+		ecsTags = append(ecsTags, &ecs.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+	if _, err := e.client.TagResource(&ecs.TagResourceInput{
+		ResourceArn: aws.String(serviceARN),
+		Tags:        ecsTags,
+	}); err != nil {
+		return fmt.Errorf("tag service %s: %w", serviceARN, err)
+	}
+	return nil
+}
+
+// UntagService removes the tags with the given keys from a service.
+func (e *ECS) UntagService(serviceARN string, keys []string) error {
+	if _, err := e.client.UntagResource(&ecs.UntagResourceInput{
+		ResourceArn: aws.String(serviceARN),
+		TagKeys:     aws.StringSlice(keys),
+	}); err != nil {
+		return fmt.Errorf("untag service %s: %w", serviceARN, err)
+	}
+	return nil
+}
+
 // waitUntilServiceStable waits until the service is stable.
 // See https://docs.aws.amazon.com/cli/latest/reference/ecs/wait/services-stable.html
 func (e *ECS) waitUntilServiceStable(svc *Service) error {
@@ -104,6 +104,125 @@ func TestECS_TaskDefinition(t *testing.T) {
 	}
 }
 
+func TestECS_RegisterTaskDefinitionWithImages(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantErr error
+		wantARN string
+	}{
+		"errors if failed to describe the task definition": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTaskDefinition(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("describe task definition arn:aws:ecs:us-west-2:123456789012:task-definition/app-test-api:3: some error"),
+		},
+		"errors if failed to register the task definition": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTaskDefinition(gomock.Any()).Return(&ecs.DescribeTaskDefinitionOutput{
+					TaskDefinition: &ecs.TaskDefinition{
+						Family: aws.String("app-test-api"),
+					},
+				}, nil)
+				m.EXPECT().RegisterTaskDefinition(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("register task definition app-test-api: some error"),
+		},
+		"registers a new revision with the images replaced": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeTaskDefinition(&ecs.DescribeTaskDefinitionInput{
+					TaskDefinition: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/app-test-api:3"),
+					Include:        aws.StringSlice([]string{ecs.TaskDefinitionFieldTags}),
+				}).Return(&ecs.DescribeTaskDefinitionOutput{
+					TaskDefinition: &ecs.TaskDefinition{
+						Family:           aws.String("app-test-api"),
+						Cpu:              aws.String("256"),
+						Memory:           aws.String("512"),
+						NetworkMode:      aws.String("awsvpc"),
+						ExecutionRoleArn: aws.String("execution-role"),
+						TaskRoleArn:      aws.String("task-role"),
+						Revision:         aws.Int64(3),
+						Status:           aws.String("ACTIVE"),
+						ContainerDefinitions: []*ecs.ContainerDefinition{
+							{
+								Name:  aws.String("api"),
+								Image: aws.String("repo/api@sha256:old"),
+							},
+							{
+								Name:  aws.String("nginx"),
+								Image: aws.String("nginx:latest"),
+							},
+						},
+					},
+					Tags: []*ecs.Tag{
+						{
+							Key:   aws.String("copilot-application"),
+							Value: aws.String("app"),
+						},
+					},
+				}, nil)
+				m.EXPECT().RegisterTaskDefinition(&ecs.RegisterTaskDefinitionInput{
+					Family:           aws.String("app-test-api"),
+					Cpu:              aws.String("256"),
+					Memory:           aws.String("512"),
+					NetworkMode:      aws.String("awsvpc"),
+					ExecutionRoleArn: aws.String("execution-role"),
+					TaskRoleArn:      aws.String("task-role"),
+					ContainerDefinitions: []*ecs.ContainerDefinition{
+						{
+							Name:  aws.String("api"),
+							Image: aws.String("repo/api@sha256:new"),
+						},
+						{
+							Name:  aws.String("nginx"),
+							Image: aws.String("nginx:latest"),
+						},
+					},
+					Tags: []*ecs.Tag{
+						{
+							Key:   aws.String("copilot-application"),
+							Value: aws.String("app"),
+						},
+					},
+				}).Return(&ecs.RegisterTaskDefinitionOutput{
+					TaskDefinition: &ecs.TaskDefinition{
+						TaskDefinitionArn: aws.String("arn:aws:ecs:us-west-2:123456789012:task-definition/app-test-api:4"),
+					},
+				}, nil)
+			},
+			wantARN: "arn:aws:ecs:us-west-2:123456789012:task-definition/app-test-api:4",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			// WHEN
+			got, err := service.RegisterTaskDefinitionWithImages("arn:aws:ecs:us-west-2:123456789012:task-definition/app-test-api:3", map[string]string{
+				"api": "repo/api@sha256:new",
+			})
+
+			// THEN
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantARN, got)
+			}
+		})
+	}
+}
+
 func TestECS_Service(t *testing.T) {
 	testCases := map[string]struct {
 		clusterName   string
@@ -341,6 +460,160 @@ func TestECS_UpdateService(t *testing.T) {
 	}
 }
 
+func TestECS_ServiceTags(t *testing.T) {
+	const mockServiceARN = "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wantedTags  map[string]string
+		wantedError error
+	}{
+		"error if fail to list tags": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTagsForResource(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("list tags of service %s: some error", mockServiceARN),
+		},
+		"success": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTagsForResource(&ecs.ListTagsForResourceInput{
+					ResourceArn: aws.String(mockServiceARN),
+				}).Return(&ecs.ListTagsForResourceOutput{
+					Tags: []*ecs.Tag{
+						{Key: aws.String("copilot-service"), Value: aws.String("api")},
+					},
+				}, nil)
+			},
+			wantedTags: map[string]string{
+				"copilot-service": "api",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			tags, err := service.ServiceTags(mockServiceARN)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTags, tags)
+			}
+		})
+	}
+}
+
+func TestECS_ActiveTaskDefinitions(t *testing.T) {
+	testCases := map[string]struct {
+		mockECSClient func(m *mocks.Mockapi)
+
+		wanted      []string
+		wantedError error
+	}{
+		"error if fail to list task definitions": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTaskDefinitions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list task definitions with family prefix phonetool-test-: some error"),
+		},
+		"returns the task definitions of every page": {
+			mockECSClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListTaskDefinitions(&ecs.ListTaskDefinitionsInput{
+					FamilyPrefix: aws.String("phonetool-test-"),
+					Status:       aws.String("ACTIVE"),
+				}).Return(&ecs.ListTaskDefinitionsOutput{
+					TaskDefinitionArns: aws.StringSlice([]string{"arn:aws:ecs:us-west-2:1234567890:task-definition/phonetool-test-api:1"}),
+					NextToken:          aws.String("next"),
+				}, nil)
+				m.EXPECT().ListTaskDefinitions(&ecs.ListTaskDefinitionsInput{
+					FamilyPrefix: aws.String("phonetool-test-"),
+					Status:       aws.String("ACTIVE"),
+					NextToken:    aws.String("next"),
+				}).Return(&ecs.ListTaskDefinitionsOutput{
+					TaskDefinitionArns: aws.StringSlice([]string{"arn:aws:ecs:us-west-2:1234567890:task-definition/phonetool-test-api:2"}),
+				}, nil)
+			},
+			wanted: []string{
+				"arn:aws:ecs:us-west-2:1234567890:task-definition/phonetool-test-api:1",
+				"arn:aws:ecs:us-west-2:1234567890:task-definition/phonetool-test-api:2",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECSClient := mocks.NewMockapi(ctrl)
+			tc.mockECSClient(mockECSClient)
+
+			service := ECS{
+				client: mockECSClient,
+			}
+
+			got, err := service.ActiveTaskDefinitions("phonetool-test-")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestECS_DeregisterTaskDefinition(t *testing.T) {
+	const mockTaskDefARN = "arn:aws:ecs:us-west-2:1234567890:task-definition/phonetool-test-api:1"
+	t.Run("wraps the error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockapi(ctrl)
+		m.EXPECT().DeregisterTaskDefinition(&ecs.DeregisterTaskDefinitionInput{
+			TaskDefinition: aws.String(mockTaskDefARN),
+		}).Return(nil, errors.New("some error"))
+		service := ECS{
+			client: m,
+		}
+
+		err := service.DeregisterTaskDefinition(mockTaskDefARN)
+
+		require.EqualError(t, err, fmt.Sprintf("deregister task definition %s: some error", mockTaskDefARN))
+	})
+}
+
+func TestECS_UntagService(t *testing.T) {
+	const mockServiceARN = "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+	t.Run("wraps the error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockapi(ctrl)
+		m.EXPECT().UntagResource(&ecs.UntagResourceInput{
+			ResourceArn: aws.String(mockServiceARN),
+			TagKeys:     aws.StringSlice([]string{"copilot-paused-desired-count"}),
+		}).Return(nil, errors.New("some error"))
+		service := ECS{
+			client: m,
+		}
+
+		err := service.UntagService(mockServiceARN, []string{"copilot-paused-desired-count"})
+
+		require.EqualError(t, err, fmt.Sprintf("untag service %s: some error", mockServiceARN))
+	})
+}
+
 func TestECS_Tasks(t *testing.T) {
 	testCases := map[string]struct {
 		clusterName   string
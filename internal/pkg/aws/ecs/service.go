@@ -72,34 +72,54 @@ func (s *Service) ServiceStatus() ServiceStatus {
 
 // ServiceConnectAliases returns the ECS Service Connect client aliases for a service.
 func (s *Service) ServiceConnectAliases() []string {
+	_, endpoints := s.ServiceConnectEndpoints()
+	var aliases []string
+	for _, endpoint := range endpoints {
+		aliases = append(aliases, endpoint.Aliases...)
+	}
+	return aliases
+}
+
+// ServiceConnectEndpoint is an endpoint that a service exposes to the other services of its Service Connect namespace.
+type ServiceConnectEndpoint struct {
+	DiscoveryName string
+	Aliases       []string
+}
+
+// ServiceConnectEndpoints returns the namespace and the endpoints of the ECS Service Connect configuration
+// of the last deployment of a service. The namespace is empty if Service Connect is not enabled.
+func (s *Service) ServiceConnectEndpoints() (string, []ServiceConnectEndpoint) {
 	if len(s.Deployments) == 0 {
-		return nil
+		return "", nil
 	}
 	lastDeployment := s.Deployments[0]
 	scConfig := lastDeployment.ServiceConnectConfiguration
 	if scConfig == nil || !aws.BoolValue(scConfig.Enabled) {
-		return nil
+		return "", nil
 	}
-	var aliases []string
+	var endpoints []ServiceConnectEndpoint
 	for _, service := range scConfig.Services {
 		defaultName := aws.StringValue(service.PortName)
 		if aws.StringValue(service.DiscoveryName) != "" {
 			defaultName = aws.StringValue(service.DiscoveryName)
 		}
+		endpoint := ServiceConnectEndpoint{
+			DiscoveryName: defaultName,
+		}
 		defaultAlias := fmt.Sprintf("%s.%s", defaultName, aws.StringValue(scConfig.Namespace))
 		if len(service.ClientAliases) == 0 {
-			aliases = append(aliases, defaultAlias)
-			continue
+			endpoint.Aliases = append(endpoint.Aliases, defaultAlias)
 		}
 		for _, clientAlias := range service.ClientAliases {
 			alias := defaultAlias
 			if aws.StringValue(clientAlias.DnsName) != "" {
 				alias = aws.StringValue(clientAlias.DnsName)
 			}
-			aliases = append(aliases, fmt.Sprintf("%s:%v", alias, aws.Int64Value(clientAlias.Port)))
+			endpoint.Aliases = append(endpoint.Aliases, fmt.Sprintf("%s:%v", alias, aws.Int64Value(clientAlias.Port)))
 		}
+		endpoints = append(endpoints, endpoint)
 	}
-	return aliases
+	return aws.StringValue(scConfig.Namespace), endpoints
 }
 
 // LastUpdatedAt returns the last updated time of the ECS service.
@@ -180,6 +180,93 @@ func TestService_ServiceConnectAliases(t *testing.T) {
 	}
 }
 
+func TestService_ServiceConnectEndpoints(t *testing.T) {
+	tests := map[string]struct {
+		inService *Service
+
+		wantedNamespace string
+		wantedEndpoints []ServiceConnectEndpoint
+	}{
+		"empty if not enabled": {
+			inService: &Service{
+				Deployments: []*ecs.Deployment{
+					{
+						ServiceConnectConfiguration: &ecs.ServiceConnectConfiguration{
+							Enabled:   aws.Bool(false),
+							Namespace: aws.String("foobar.local"),
+						},
+					},
+				},
+			},
+		},
+		"client only service": {
+			inService: &Service{
+				Deployments: []*ecs.Deployment{
+					{
+						ServiceConnectConfiguration: &ecs.ServiceConnectConfiguration{
+							Enabled:   aws.Bool(true),
+							Namespace: aws.String("foobar.local"),
+						},
+					},
+				},
+			},
+			wantedNamespace: "foobar.local",
+		},
+		"success": {
+			inService: &Service{
+				Deployments: []*ecs.Deployment{
+					{
+						ServiceConnectConfiguration: &ecs.ServiceConnectConfiguration{
+							Enabled:   aws.Bool(true),
+							Namespace: aws.String("foobar.local"),
+							Services: []*ecs.ServiceConnectService{
+								{
+									PortName:      aws.String("frontend"),
+									DiscoveryName: aws.String("front"),
+								},
+								{
+									PortName: aws.String("api"),
+									ClientAliases: []*ecs.ServiceConnectClientAlias{
+										{
+											Port: aws.Int64(5000),
+										},
+										{
+											DnsName: aws.String("api"),
+											Port:    aws.Int64(80),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedNamespace: "foobar.local",
+			wantedEndpoints: []ServiceConnectEndpoint{
+				{
+					DiscoveryName: "front",
+					Aliases:       []string{"front.foobar.local"},
+				},
+				{
+					DiscoveryName: "api",
+					Aliases:       []string{"api.foobar.local:5000", "api:80"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			namespace, endpoints := tc.inService.ServiceConnectEndpoints()
+
+			// THEN
+			require.Equal(t, tc.wantedNamespace, namespace)
+			require.Equal(t, tc.wantedEndpoints, endpoints)
+		})
+	}
+}
+
 func TestParseServiceArn(t *testing.T) {
 	tests := map[string]struct {
 		inArnStr string
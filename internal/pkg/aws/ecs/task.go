@@ -38,6 +38,8 @@ const (
 	TaskCapacityProviderFargateSpot = "FARGATE_SPOT"
 	// TaskStatusRunning is the task status running.
 	TaskStatusRunning = "RUNNING"
+	// TaskStopCodeSpotInterruption is the stop code of a task stopped because its Fargate Spot capacity was reclaimed.
+	TaskStopCodeSpotInterruption = ecs.TaskStopCodeSpotInterruption
 )
 
 // Image contains very basic info of a container image.
@@ -93,11 +95,17 @@ func (t *Task) TaskStatus() (*TaskStatus, error) {
 		StartedAt:        startedAt,
 		StoppedAt:        stoppedAt,
 		StoppedReason:    stoppedReason,
+		StopCode:         aws.StringValue(t.StopCode),
 		CapacityProvider: aws.StringValue(t.CapacityProviderName),
 		TaskDefinition:   aws.StringValue(t.TaskDefinitionArn),
 	}, nil
 }
 
+// SpotInterrupted returns true if the task was stopped because its Fargate Spot capacity was reclaimed.
+func (t *Task) SpotInterrupted() bool {
+	return aws.StringValue(t.StopCode) == TaskStopCodeSpotInterruption
+}
+
 // ENI returns the network interface ID of the running task.
 // Every Fargate task is provided with an ENI by default (https://docs.aws.amazon.com/AmazonECS/latest/userguide/fargate-task-networking.html).
 func (t *Task) ENI() (string, error) {
@@ -134,6 +142,30 @@ func (t *Task) PrivateIP() (string, error) {
 	}
 }
 
+// SessionTarget returns the Session Manager target of a container in the task,
+// in the format "ecs:<cluster name>_<task ID>_<container runtime ID>".
+func (t *Task) SessionTarget(containerName string) (string, error) {
+	parsedARN, err := arn.Parse(aws.StringValue(t.ClusterArn))
+	if err != nil {
+		return "", fmt.Errorf("parse ECS cluster ARN: %w", err)
+	}
+	cluster := strings.TrimPrefix(parsedARN.Resource, "cluster/")
+	taskID, err := TaskID(aws.StringValue(t.TaskArn))
+	if err != nil {
+		return "", err
+	}
+	for _, container := range t.Containers {
+		if aws.StringValue(container.Name) != containerName {
+			continue
+		}
+		if aws.StringValue(container.RuntimeId) == "" {
+			return "", fmt.Errorf("container %s in task %s does not have a runtime ID yet", containerName, taskID)
+		}
+		return fmt.Sprintf("ecs:%s_%s_%s", cluster, taskID, aws.StringValue(container.RuntimeId)), nil
+	}
+	return "", fmt.Errorf("container %s not found in task %s", containerName, taskID)
+}
+
 func (t *Task) attachmentENI() (*ecs.Attachment, error) {
 	// Every Fargate task is provided with an ENI by default (https://docs.aws.amazon.com/AmazonECS/latest/userguide/fargate-task-networking.html).
 	// So an error is warranted if there is no ENI found.
@@ -162,6 +194,7 @@ type TaskStatus struct {
 	StartedAt        time.Time `json:"startedAt"`
 	StoppedAt        time.Time `json:"stoppedAt"`
 	StoppedReason    string    `json:"stoppedReason"`
+	StopCode         string    `json:"stopCode"`
 	CapacityProvider string    `json:"capacityProvider"`
 	TaskDefinition   string    `json:"taskDefinitionARN"`
 }
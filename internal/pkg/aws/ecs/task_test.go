@@ -27,6 +27,7 @@ func TestTask_TaskStatus(t *testing.T) {
 		startedAt     time.Time
 		stoppedAt     time.Time
 		stoppedReason *string
+		stopCode      *string
 
 		wantTaskStatus *TaskStatus
 		wantErr        error
@@ -96,6 +97,7 @@ func TestTask_TaskStatus(t *testing.T) {
 			startedAt:     startTime,
 			stoppedAt:     stopTime,
 			stoppedReason: aws.String("some reason"),
+			stopCode:      aws.String("SpotInterruption"),
 
 			wantTaskStatus: &TaskStatus{
 				Health: "HEALTHY",
@@ -110,6 +112,7 @@ func TestTask_TaskStatus(t *testing.T) {
 				StartedAt:     startTime,
 				StoppedAt:     stopTime,
 				StoppedReason: "some reason",
+				StopCode:      "SpotInterruption",
 			},
 		},
 	}
@@ -128,6 +131,7 @@ func TestTask_TaskStatus(t *testing.T) {
 				StartedAt:     &tc.startedAt,
 				StoppedAt:     &tc.stoppedAt,
 				StoppedReason: tc.stoppedReason,
+				StopCode:      tc.stopCode,
 			}
 
 			gotTaskStatus, gotErr := task.TaskStatus()
@@ -306,6 +310,77 @@ func TestTask_PrivateIP(t *testing.T) {
 	}
 }
 
+func TestTask_SessionTarget(t *testing.T) {
+	const (
+		mockClusterARN = "arn:aws:ecs:us-west-2:123456789012:cluster/my-project-test-Cluster-9F7Y0RLP60R7"
+		mockTaskARN    = "arn:aws:ecs:us-west-2:123456789012:task/my-project-test-Cluster-9F7Y0RLP60R7/4082490ee6c245e09d2145010aa1ba8d"
+	)
+	testCases := map[string]struct {
+		task      Task
+		container string
+
+		wantedTarget string
+		wantedErr    error
+	}{
+		"bad unparsable cluster ARN": {
+			task: Task{
+				ClusterArn: aws.String("mockBadClusterARN"),
+			},
+			container: "frontend",
+			wantedErr: errors.New("parse ECS cluster ARN: arn: invalid prefix"),
+		},
+		"container not found": {
+			task: Task{
+				ClusterArn: aws.String(mockClusterARN),
+				TaskArn:    aws.String(mockTaskARN),
+				Containers: []*ecs.Container{
+					{Name: aws.String("nginx"), RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-1234")},
+				},
+			},
+			container: "frontend",
+			wantedErr: errors.New("container frontend not found in task 4082490ee6c245e09d2145010aa1ba8d"),
+		},
+		"container without a runtime ID": {
+			task: Task{
+				ClusterArn: aws.String(mockClusterARN),
+				TaskArn:    aws.String(mockTaskARN),
+				Containers: []*ecs.Container{
+					{Name: aws.String("frontend")},
+				},
+			},
+			container: "frontend",
+			wantedErr: errors.New("container frontend in task 4082490ee6c245e09d2145010aa1ba8d does not have a runtime ID yet"),
+		},
+		"success": {
+			task: Task{
+				ClusterArn: aws.String(mockClusterARN),
+				TaskArn:    aws.String(mockTaskARN),
+				Containers: []*ecs.Container{
+					{Name: aws.String("nginx"), RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-5678")},
+					{Name: aws.String("frontend"), RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-1234")},
+				},
+			},
+			container:    "frontend",
+			wantedTarget: "ecs:my-project-test-Cluster-9F7Y0RLP60R7_4082490ee6c245e09d2145010aa1ba8d_4082490ee6c245e09d2145010aa1ba8d-1234",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			out, err := tc.task.SessionTarget(tc.container)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTarget, out)
+			}
+		})
+	}
+}
+
 func Test_TaskID(t *testing.T) {
 	testCases := map[string]struct {
 		taskARN string
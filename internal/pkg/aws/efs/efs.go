@@ -0,0 +1,212 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package efs provides a client to make API requests to Amazon Elastic File System.
+package efs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/efs"
+)
+
+const (
+	// BackupStatusDisabled is the status of the automatic backups of a file system without a backup policy.
+	BackupStatusDisabled = efs.StatusDisabled
+)
+
+type api interface {
+	DescribeFileSystems(input *efs.DescribeFileSystemsInput) (*efs.DescribeFileSystemsOutput, error)
+	DescribeMountTargets(input *efs.DescribeMountTargetsInput) (*efs.DescribeMountTargetsOutput, error)
+	DescribeAccessPoints(input *efs.DescribeAccessPointsInput) (*efs.DescribeAccessPointsOutput, error)
+	DescribeLifecycleConfiguration(input *efs.DescribeLifecycleConfigurationInput) (*efs.DescribeLifecycleConfigurationOutput, error)
+	DescribeBackupPolicy(input *efs.DescribeBackupPolicyInput) (*efs.DescribeBackupPolicyOutput, error)
+}
+
+// EFS wraps an Amazon Elastic File System client.
+type EFS struct {
+	client api
+}
+
+// New returns an EFS client configured against the input session.
+func New(s *session.Session) *EFS {
+	return &EFS{
+		client: efs.New(s),
+	}
+}
+
+// FileSystem holds the description of an EFS file system.
+type FileSystem struct {
+	ID                   string
+	Name                 string
+	LifeCycleState       string
+	ThroughputMode       string
+	SizeInBytes          int64
+	NumberOfMountTargets int64
+	Tags                 map[string]string
+}
+
+// MountTarget holds the description of a mount target of a file system.
+type MountTarget struct {
+	ID               string
+	SubnetID         string
+	AvailabilityZone string
+	IPAddress        string
+	LifeCycleState   string
+}
+
+// AccessPoint holds the description of an access point of a file system.
+type AccessPoint struct {
+	ID            string
+	Name          string
+	RootDirectory string
+}
+
+// ListFileSystems returns the file systems that have all the tags.
+func (e *EFS) ListFileSystems(tags map[string]string) ([]FileSystem, error) {
+	var fileSystems []FileSystem
+	var marker *string
+	for {
+		out, err := e.client.DescribeFileSystems(&efs.DescribeFileSystemsInput{
+			Marker: marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe file systems: %w", err)
+		}
+		for _, fs := range out.FileSystems {
+			fsTags := make(map[string]string, len(fs.Tags))
+			for _, tag := range fs.Tags {
+				fsTags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+			if !hasTags(fsTags, tags) {
+				continue
+			}
+			var size int64
+			if fs.SizeInBytes != nil {
+				size = aws.Int64Value(fs.SizeInBytes.Value)
+			}
+			fileSystems = append(fileSystems, FileSystem{
+				ID:                   aws.StringValue(fs.FileSystemId),
+				Name:                 aws.StringValue(fs.Name),
+				LifeCycleState:       aws.StringValue(fs.LifeCycleState),
+				ThroughputMode:       aws.StringValue(fs.ThroughputMode),
+				SizeInBytes:          size,
+				NumberOfMountTargets: aws.Int64Value(fs.NumberOfMountTargets),
+				Tags:                 fsTags,
+			})
+		}
+		if out.NextMarker == nil {
+			break
+		}
+		marker = out.NextMarker
+	}
+	return fileSystems, nil
+}
+
+// MountTargets returns the mount targets of a file system.
+func (e *EFS) MountTargets(fsID string) ([]MountTarget, error) {
+	var mountTargets []MountTarget
+	var marker *string
+	for {
+		out, err := e.client.DescribeMountTargets(&efs.DescribeMountTargetsInput{
+			FileSystemId: aws.String(fsID),
+			Marker:       marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe mount targets of file system %s: %w", fsID, err)
+		}
+		for _, mt := range out.MountTargets {
+			mountTargets = append(mountTargets, MountTarget{
+				ID:               aws.StringValue(mt.MountTargetId),
+				SubnetID:         aws.StringValue(mt.SubnetId),
+				AvailabilityZone: aws.StringValue(mt.AvailabilityZoneName),
+				IPAddress:        aws.StringValue(mt.IpAddress),
+				LifeCycleState:   aws.StringValue(mt.LifeCycleState),
+			})
+		}
+		if out.NextMarker == nil {
+			break
+		}
+		marker = out.NextMarker
+	}
+	return mountTargets, nil
+}
+
+// AccessPoints returns the access points of a file system.
+func (e *EFS) AccessPoints(fsID string) ([]AccessPoint, error) {
+	var accessPoints []AccessPoint
+	var nextToken *string
+	for {
+		out, err := e.client.DescribeAccessPoints(&efs.DescribeAccessPointsInput{
+			FileSystemId: aws.String(fsID),
+			NextToken:    nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe access points of file system %s: %w", fsID, err)
+		}
+		for _, ap := range out.AccessPoints {
+			var rootDir string
+			if ap.RootDirectory != nil {
+				rootDir = aws.StringValue(ap.RootDirectory.Path)
+			}
+			accessPoints = append(accessPoints, AccessPoint{
+				ID:            aws.StringValue(ap.AccessPointId),
+				Name:          aws.StringValue(ap.Name),
+				RootDirectory: rootDir,
+			})
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	return accessPoints, nil
+}
+
+// TransitionToIA returns when files are moved to the Infrequent Access storage class, such as "AFTER_30_DAYS".
+// An empty string is returned if the file system has no such lifecycle policy.
+func (e *EFS) TransitionToIA(fsID string) (string, error) {
+	out, err := e.client.DescribeLifecycleConfiguration(&efs.DescribeLifecycleConfigurationInput{
+		FileSystemId: aws.String(fsID),
+	})
+	if err != nil {
+		return "", fmt.Errorf("describe lifecycle configuration of file system %s: %w", fsID, err)
+	}
+	for _, policy := range out.LifecyclePolicies {
+		if policy.TransitionToIA != nil {
+			return aws.StringValue(policy.TransitionToIA), nil
+		}
+	}
+	return "", nil
+}
+
+// BackupStatus returns the status of the automatic backups of a file system, such as "ENABLED".
+func (e *EFS) BackupStatus(fsID string) (string, error) {
+	out, err := e.client.DescribeBackupPolicy(&efs.DescribeBackupPolicyInput{
+		FileSystemId: aws.String(fsID),
+	})
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == efs.ErrCodePolicyNotFound {
+			return BackupStatusDisabled, nil
+		}
+		return "", fmt.Errorf("describe backup policy of file system %s: %w", fsID, err)
+	}
+	if out.BackupPolicy == nil {
+		return BackupStatusDisabled, nil
+	}
+	return aws.StringValue(out.BackupPolicy.Status), nil
+}
+
+func hasTags(tags, wanted map[string]string) bool {
+	for k, v := range wanted {
+		if tags[k] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,341 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package efs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/efs/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEFS_ListFileSystems(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      []FileSystem
+		wantedError error
+	}{
+		"return the file systems with all the tags across pages": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeFileSystems(&efs.DescribeFileSystemsInput{}).Return(&efs.DescribeFileSystemsOutput{
+					FileSystems: []*efs.FileSystemDescription{
+						{
+							FileSystemId:         aws.String("fs-1234"),
+							Name:                 aws.String("copilot-phonetool-test-data"),
+							LifeCycleState:       aws.String("available"),
+							ThroughputMode:       aws.String("bursting"),
+							SizeInBytes:          &efs.FileSystemSize{Value: aws.Int64(6144)},
+							NumberOfMountTargets: aws.Int64(2),
+							Tags: []*efs.Tag{
+								{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+								{Key: aws.String("copilot-environment"), Value: aws.String("test")},
+							},
+						},
+						{
+							FileSystemId: aws.String("fs-5678"),
+							Tags: []*efs.Tag{
+								{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+							},
+						},
+					},
+					NextMarker: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeFileSystems(&efs.DescribeFileSystemsInput{
+					Marker: aws.String("next"),
+				}).Return(&efs.DescribeFileSystemsOutput{
+					FileSystems: []*efs.FileSystemDescription{
+						{
+							FileSystemId:   aws.String("fs-abcd"),
+							Name:           aws.String("copilot-phonetool-test-uploads"),
+							ThroughputMode: aws.String("elastic"),
+							Tags: []*efs.Tag{
+								{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+								{Key: aws.String("copilot-environment"), Value: aws.String("test")},
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: []FileSystem{
+				{
+					ID:                   "fs-1234",
+					Name:                 "copilot-phonetool-test-data",
+					LifeCycleState:       "available",
+					ThroughputMode:       "bursting",
+					SizeInBytes:          6144,
+					NumberOfMountTargets: 2,
+					Tags: map[string]string{
+						"copilot-application": "phonetool",
+						"copilot-environment": "test",
+					},
+				},
+				{
+					ID:             "fs-abcd",
+					Name:           "copilot-phonetool-test-uploads",
+					ThroughputMode: "elastic",
+					Tags: map[string]string{
+						"copilot-application": "phonetool",
+						"copilot-environment": "test",
+					},
+				},
+			},
+		},
+		"wrap the error": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeFileSystems(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe file systems: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := EFS{client: m}
+
+			got, err := client.ListFileSystems(map[string]string{
+				"copilot-application": "phonetool",
+				"copilot-environment": "test",
+			})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestEFS_MountTargets(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      []MountTarget
+		wantedError error
+	}{
+		"return the mount targets": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeMountTargets(&efs.DescribeMountTargetsInput{
+					FileSystemId: aws.String("fs-1234"),
+				}).Return(&efs.DescribeMountTargetsOutput{
+					MountTargets: []*efs.MountTargetDescription{
+						{
+							MountTargetId:        aws.String("fsmt-1"),
+							SubnetId:             aws.String("subnet-1"),
+							AvailabilityZoneName: aws.String("us-west-2a"),
+							IpAddress:            aws.String("10.0.1.10"),
+							LifeCycleState:       aws.String("available"),
+						},
+					},
+				}, nil)
+			},
+			wanted: []MountTarget{
+				{
+					ID:               "fsmt-1",
+					SubnetID:         "subnet-1",
+					AvailabilityZone: "us-west-2a",
+					IPAddress:        "10.0.1.10",
+					LifeCycleState:   "available",
+				},
+			},
+		},
+		"wrap the error": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeMountTargets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe mount targets of file system fs-1234: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := EFS{client: m}
+
+			got, err := client.MountTargets("fs-1234")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestEFS_AccessPoints(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      []AccessPoint
+		wantedError error
+	}{
+		"return the access points": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAccessPoints(&efs.DescribeAccessPointsInput{
+					FileSystemId: aws.String("fs-1234"),
+				}).Return(&efs.DescribeAccessPointsOutput{
+					AccessPoints: []*efs.AccessPointDescription{
+						{
+							AccessPointId: aws.String("fsap-1"),
+							Name:          aws.String("copilot-phonetool-test-api"),
+							RootDirectory: &efs.RootDirectory{Path: aws.String("/api")},
+						},
+					},
+				}, nil)
+			},
+			wanted: []AccessPoint{
+				{
+					ID:            "fsap-1",
+					Name:          "copilot-phonetool-test-api",
+					RootDirectory: "/api",
+				},
+			},
+		},
+		"wrap the error": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAccessPoints(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe access points of file system fs-1234: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := EFS{client: m}
+
+			got, err := client.AccessPoints("fs-1234")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestEFS_TransitionToIA(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      string
+		wantedError error
+	}{
+		"return the transition to IA policy": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLifecycleConfiguration(&efs.DescribeLifecycleConfigurationInput{
+					FileSystemId: aws.String("fs-1234"),
+				}).Return(&efs.DescribeLifecycleConfigurationOutput{
+					LifecyclePolicies: []*efs.LifecyclePolicy{
+						{TransitionToPrimaryStorageClass: aws.String("AFTER_1_ACCESS")},
+						{TransitionToIA: aws.String("AFTER_30_DAYS")},
+					},
+				}, nil)
+			},
+			wanted: "AFTER_30_DAYS",
+		},
+		"return empty if there is no transition to IA policy": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLifecycleConfiguration(gomock.Any()).Return(&efs.DescribeLifecycleConfigurationOutput{}, nil)
+			},
+		},
+		"wrap the error": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLifecycleConfiguration(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe lifecycle configuration of file system fs-1234: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := EFS{client: m}
+
+			got, err := client.TransitionToIA("fs-1234")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestEFS_BackupStatus(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      string
+		wantedError error
+	}{
+		"return the status of the backup policy": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeBackupPolicy(&efs.DescribeBackupPolicyInput{
+					FileSystemId: aws.String("fs-1234"),
+				}).Return(&efs.DescribeBackupPolicyOutput{
+					BackupPolicy: &efs.BackupPolicy{Status: aws.String("ENABLED")},
+				}, nil)
+			},
+			wanted: "ENABLED",
+		},
+		"return disabled if there is no backup policy": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeBackupPolicy(gomock.Any()).Return(nil, awserr.New(efs.ErrCodePolicyNotFound, "not found", nil))
+			},
+			wanted: "DISABLED",
+		},
+		"wrap the error": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeBackupPolicy(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe backup policy of file system fs-1234: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := EFS{client: m}
+
+			got, err := client.BackupStatus("fs-1234")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
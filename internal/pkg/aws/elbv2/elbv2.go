@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
@@ -20,12 +21,17 @@ import (
 const (
 	// TargetHealthStateHealthy wraps the ELBV2 health status HEALTHY.
 	TargetHealthStateHealthy = elbv2.TargetHealthStateEnumHealthy
+	// SchemeInternetFacing wraps the ELBV2 load balancer scheme "internet-facing".
+	SchemeInternetFacing = elbv2.LoadBalancerSchemeEnumInternetFacing
 )
 
 type api interface {
 	DescribeTargetHealth(*elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error)
 	DescribeRules(*elbv2.DescribeRulesInput) (*elbv2.DescribeRulesOutput, error)
 	DescribeRulesWithContext(context.Context, *elbv2.DescribeRulesInput, ...request.Option) (*elbv2.DescribeRulesOutput, error)
+	DescribeLoadBalancers(*elbv2.DescribeLoadBalancersInput) (*elbv2.DescribeLoadBalancersOutput, error)
+	DescribeListeners(*elbv2.DescribeListenersInput) (*elbv2.DescribeListenersOutput, error)
+	DescribeAccountLimits(*elbv2.DescribeAccountLimitsInput) (*elbv2.DescribeAccountLimitsOutput, error)
 }
 
 // ELBV2 wraps an AWS ELBV2 client.
@@ -107,6 +113,114 @@ func (r *Rule) HasRedirectAction() bool {
 	return false
 }
 
+// LoadBalancer contains information about an Application Load Balancer.
+type LoadBalancer struct {
+	ARN                   string
+	Name                  string
+	DNSName               string
+	Scheme                string
+	CanonicalHostedZoneID string
+	SecurityGroups        []string
+}
+
+// LoadBalancer returns information about the load balancer with the given ARN.
+func (e *ELBV2) LoadBalancer(arn string) (*LoadBalancer, error) {
+	out, err := e.client.DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{
+		LoadBalancerArns: aws.StringSlice([]string{arn}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe load balancer %s: %w", arn, err)
+	}
+	if len(out.LoadBalancers) == 0 {
+		return nil, fmt.Errorf("cannot find load balancer %s", arn)
+	}
+	lb := out.LoadBalancers[0]
+	return &LoadBalancer{
+		ARN:                   aws.StringValue(lb.LoadBalancerArn),
+		Name:                  aws.StringValue(lb.LoadBalancerName),
+		DNSName:               aws.StringValue(lb.DNSName),
+		Scheme:                aws.StringValue(lb.Scheme),
+		CanonicalHostedZoneID: aws.StringValue(lb.CanonicalHostedZoneId),
+		SecurityGroups:        aws.StringValueSlice(lb.SecurityGroups),
+	}, nil
+}
+
+// ListenerRuleCount returns the number of rules, other than the default ones, of the listeners of a load balancer.
+func (e *ELBV2) ListenerRuleCount(lbARN string) (int, error) {
+	var count int
+	var marker *string
+	for {
+		out, err := e.client.DescribeListeners(&elbv2.DescribeListenersInput{
+			LoadBalancerArn: aws.String(lbARN),
+			Marker:          marker,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("describe listeners of load balancer %s: %w", lbARN, err)
+		}
+		for _, listener := range out.Listeners {
+			n, err := e.listenerRuleCount(aws.StringValue(listener.ListenerArn))
+			if err != nil {
+				return 0, err
+			}
+			count += n
+		}
+		marker = out.NextMarker
+		if marker == nil {
+			return count, nil
+		}
+	}
+}
+
+func (e *ELBV2) listenerRuleCount(listenerARN string) (int, error) {
+	var count int
+	var marker *string
+	for {
+		out, err := e.client.DescribeRules(&elbv2.DescribeRulesInput{
+			ListenerArn: aws.String(listenerARN),
+			Marker:      marker,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("describe rules of listener %s: %w", listenerARN, err)
+		}
+		for _, rule := range out.Rules {
+			if !aws.BoolValue(rule.IsDefault) {
+				count++
+			}
+		}
+		marker = out.NextMarker
+		if marker == nil {
+			return count, nil
+		}
+	}
+}
+
+// AccountLimit returns the maximum of a resource of Elastic Load Balancing for the account, such as "rules-per-application-load-balancer".
+func (e *ELBV2) AccountLimit(name string) (int, error) {
+	var marker *string
+	for {
+		out, err := e.client.DescribeAccountLimits(&elbv2.DescribeAccountLimitsInput{
+			Marker: marker,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("describe account limits: %w", err)
+		}
+		for _, limit := range out.Limits {
+			if aws.StringValue(limit.Name) != name {
+				continue
+			}
+			max, err := strconv.Atoi(aws.StringValue(limit.Max))
+			if err != nil {
+				return 0, fmt.Errorf("parse account limit %s: %w", name, err)
+			}
+			return max, nil
+		}
+		marker = out.NextMarker
+		if marker == nil {
+			return 0, fmt.Errorf("cannot find account limit %s", name)
+		}
+	}
+}
+
 // TargetHealth wraps up elbv2.TargetHealthDescription.
 type TargetHealth elbv2.TargetHealthDescription
 
@@ -330,6 +330,212 @@ func TestELBV2_DescribeRule(t *testing.T) {
 	}
 }
 
+func TestELBV2_LoadBalancer(t *testing.T) {
+	mockARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/1234567890abcdef"
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		expectedErr string
+		expected    *LoadBalancer
+	}{
+		"fail to describe load balancers": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLoadBalancers(&elbv2.DescribeLoadBalancersInput{
+					LoadBalancerArns: aws.StringSlice([]string{mockARN}),
+				}).Return(nil, errors.New("some error"))
+			},
+			expectedErr: fmt.Sprintf("describe load balancer %s: some error", mockARN),
+		},
+		"cannot find load balancer": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLoadBalancers(gomock.Any()).Return(&elbv2.DescribeLoadBalancersOutput{}, nil)
+			},
+			expectedErr: fmt.Sprintf("cannot find load balancer %s", mockARN),
+		},
+		"success": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeLoadBalancers(gomock.Any()).Return(&elbv2.DescribeLoadBalancersOutput{
+					LoadBalancers: []*elbv2.LoadBalancer{
+						{
+							LoadBalancerArn:       aws.String(mockARN),
+							LoadBalancerName:      aws.String("shared"),
+							DNSName:               aws.String("shared-1234.us-west-2.elb.amazonaws.com"),
+							Scheme:                aws.String(elbv2.LoadBalancerSchemeEnumInternetFacing),
+							CanonicalHostedZoneId: aws.String("Z1H1FL5HABSF5"),
+							SecurityGroups:        aws.StringSlice([]string{"sg-1", "sg-2"}),
+						},
+					},
+				}, nil)
+			},
+			expected: &LoadBalancer{
+				ARN:                   mockARN,
+				Name:                  "shared",
+				DNSName:               "shared-1234.us-west-2.elb.amazonaws.com",
+				Scheme:                "internet-facing",
+				CanonicalHostedZoneID: "Z1H1FL5HABSF5",
+				SecurityGroups:        []string{"sg-1", "sg-2"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			actual, err := elbv2Client.LoadBalancer(mockARN)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestELBV2_ListenerRuleCount(t *testing.T) {
+	mockARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/1234567890abcdef"
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		expectedErr string
+		expected    int
+	}{
+		"fail to describe listeners": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeListeners(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedErr: fmt.Sprintf("describe listeners of load balancer %s: some error", mockARN),
+		},
+		"fail to describe rules": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeListeners(gomock.Any()).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []*elbv2.Listener{{ListenerArn: aws.String("listener-1")}},
+				}, nil)
+				m.EXPECT().DescribeRules(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedErr: "describe rules of listener listener-1: some error",
+		},
+		"counts the rules of every listener except the default ones": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeListeners(&elbv2.DescribeListenersInput{
+					LoadBalancerArn: aws.String(mockARN),
+				}).Return(&elbv2.DescribeListenersOutput{
+					Listeners: []*elbv2.Listener{{ListenerArn: aws.String("listener-1")}, {ListenerArn: aws.String("listener-2")}},
+				}, nil)
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String("listener-1"),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules:      []*elbv2.Rule{{IsDefault: aws.Bool(true)}, {IsDefault: aws.Bool(false)}},
+					NextMarker: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String("listener-1"),
+					Marker:      aws.String("next"),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{{IsDefault: aws.Bool(false)}},
+				}, nil)
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String("listener-2"),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{{IsDefault: aws.Bool(true)}},
+				}, nil)
+			},
+			expected: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			actual, err := elbv2Client.ListenerRuleCount(mockARN)
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestELBV2_AccountLimit(t *testing.T) {
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		expectedErr string
+		expected    int
+	}{
+		"fail to describe account limits": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAccountLimits(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedErr: "describe account limits: some error",
+		},
+		"cannot find the limit": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAccountLimits(gomock.Any()).Return(&elbv2.DescribeAccountLimitsOutput{
+					Limits: []*elbv2.Limit{{Name: aws.String("target-groups"), Max: aws.String("3000")}},
+				}, nil)
+			},
+			expectedErr: "cannot find account limit rules-per-application-load-balancer",
+		},
+		"success": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeAccountLimits(&elbv2.DescribeAccountLimitsInput{}).Return(&elbv2.DescribeAccountLimitsOutput{
+					Limits:     []*elbv2.Limit{{Name: aws.String("target-groups"), Max: aws.String("3000")}},
+					NextMarker: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeAccountLimits(&elbv2.DescribeAccountLimitsInput{
+					Marker: aws.String("next"),
+				}).Return(&elbv2.DescribeAccountLimitsOutput{
+					Limits: []*elbv2.Limit{{Name: aws.String("rules-per-application-load-balancer"), Max: aws.String("100")}},
+				}, nil)
+			},
+			expected: 100,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			actual, err := elbv2Client.AccountLimit("rules-per-application-load-balancer")
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
 func TestELBV2Rule_HasRedirectAction(t *testing.T) {
 	testCases := map[string]struct {
 		rule     Rule
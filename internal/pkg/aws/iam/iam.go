@@ -23,9 +23,12 @@ type api interface {
 	ListRoleTags(input *iam.ListRoleTagsInput) (*iam.ListRoleTagsOutput, error)
 	DeleteRolePolicy(input *iam.DeleteRolePolicyInput) (*iam.DeleteRolePolicyOutput, error)
 	ListRolePolicies(input *iam.ListRolePoliciesInput) (*iam.ListRolePoliciesOutput, error)
+	ListAttachedRolePolicies(input *iam.ListAttachedRolePoliciesInput) (*iam.ListAttachedRolePoliciesOutput, error)
 	DeleteRole(input *iam.DeleteRoleInput) (*iam.DeleteRoleOutput, error)
 	CreateServiceLinkedRole(input *iam.CreateServiceLinkedRoleInput) (*iam.CreateServiceLinkedRoleOutput, error)
 	ListPolicies(input *iam.ListPoliciesInput) (*iam.ListPoliciesOutput, error)
+	ListOpenIDConnectProviders(input *iam.ListOpenIDConnectProvidersInput) (*iam.ListOpenIDConnectProvidersOutput, error)
+	SimulatePrincipalPolicy(input *iam.SimulatePrincipalPolicyInput) (*iam.SimulatePolicyResponse, error)
 }
 
 // IAM wraps the AWS SDK's IAM client.
@@ -125,6 +128,98 @@ func (c *IAM) ListPolicyNames() ([]string, error) {
 	return policyNames, nil
 }
 
+// OIDCProviderARN returns the ARN of the IAM OpenID Connect provider for the URL, such as "token.actions.githubusercontent.com".
+// An empty string is returned if the account doesn't have a provider for the URL.
+func (c *IAM) OIDCProviderARN(url string) (string, error) {
+	out, err := c.client.ListOpenIDConnectProviders(&iam.ListOpenIDConnectProvidersInput{})
+	if err != nil {
+		return "", fmt.Errorf("list OpenID Connect providers: %w", err)
+	}
+	url = strings.TrimPrefix(url, "https://")
+	for _, provider := range out.OpenIDConnectProviderList {
+		// The ARN of a provider is of the form arn:aws:iam::123456789012:oidc-provider/{url}.
+		if strings.HasSuffix(aws.StringValue(provider.Arn), ":oidc-provider/"+url) {
+			return aws.StringValue(provider.Arn), nil
+		}
+	}
+	return "", nil
+}
+
+// ListRolePolicyNames returns the names of the inline policies embedded in an IAM role.
+func (c *IAM) ListRolePolicyNames(roleName string) ([]string, error) {
+	names, err := c.listRolePolicyNames(roleName)
+	if err != nil {
+		return nil, err
+	}
+	return aws.StringValueSlice(names), nil
+}
+
+// ListAttachedRolePolicyARNs returns the ARNs of the managed policies attached to an IAM role.
+func (c *IAM) ListAttachedRolePolicyARNs(roleName string) ([]string, error) {
+	var arns []string
+	var marker *string
+	for {
+		out, err := c.client.ListAttachedRolePolicies(&iam.ListAttachedRolePoliciesInput{
+			Marker:   marker,
+			RoleName: aws.String(roleName),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list attached policies for role %s: %w", roleName, err)
+		}
+		for _, policy := range out.AttachedPolicies {
+			arns = append(arns, aws.StringValue(policy.PolicyArn))
+		}
+		if !aws.BoolValue(out.IsTruncated) {
+			return arns, nil
+		}
+		marker = out.Marker
+	}
+}
+
+// DeniedActions simulates the policies of a principal, and returns the actions that the principal isn't allowed to call.
+// The principal can be an IAM user, an IAM role, or an STS assumed-role session, in which case the policies of its role are simulated.
+func (c *IAM) DeniedActions(principalARN string, actions []string) ([]string, error) {
+	principalARN = principalFromSession(principalARN)
+	var denied []string
+	var marker *string
+	for {
+		out, err := c.client.SimulatePrincipalPolicy(&iam.SimulatePrincipalPolicyInput{
+			PolicySourceArn: aws.String(principalARN),
+			ActionNames:     aws.StringSlice(actions),
+			Marker:          marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("simulate policies of principal %s: %w", principalARN, err)
+		}
+		for _, result := range out.EvaluationResults {
+			if aws.StringValue(result.EvalDecision) != iam.PolicyEvaluationDecisionTypeAllowed {
+				denied = append(denied, aws.StringValue(result.EvalActionName))
+			}
+		}
+		if !aws.BoolValue(out.IsTruncated) {
+			return denied, nil
+		}
+		marker = out.Marker
+	}
+}
+
+// principalFromSession returns the ARN of the IAM role of an STS assumed-role session,
+// such as "arn:aws:iam::1111:role/Admin" for "arn:aws:sts::1111:assumed-role/Admin/session".
+// Other ARNs are returned as is.
+func principalFromSession(principalARN string) string {
+	parsed, err := arn.Parse(principalARN)
+	if err != nil || parsed.Service != "sts" || !strings.HasPrefix(parsed.Resource, "assumed-role/") {
+		return principalARN
+	}
+	parts := strings.Split(parsed.Resource, "/")
+	return arn.ARN{
+		Partition: parsed.Partition,
+		Service:   "iam",
+		AccountID: parsed.AccountID,
+		Resource:  "role/" + parts[1],
+	}.String()
+}
+
 func (c *IAM) deleteRolePolicies(roleName string) error {
 	policyNames, err := c.listRolePolicyNames(roleName)
 	if err != nil {
@@ -303,3 +303,203 @@ func TestIAM_ListPolicies(t *testing.T) {
 		})
 	}
 }
+
+func TestIAM_OIDCProviderARN(t *testing.T) {
+	testCases := map[string]struct {
+		inClient func(ctrl *gomock.Controller) *mocks.Mockapi
+
+		wanted    string
+		wantedErr error
+	}{
+		"wraps error on failure": {
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ListOpenIDConnectProviders(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("list OpenID Connect providers: some error"),
+		},
+		"returns the ARN of the provider for the URL": {
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ListOpenIDConnectProviders(&iam.ListOpenIDConnectProvidersInput{}).Return(&iam.ListOpenIDConnectProvidersOutput{
+					OpenIDConnectProviderList: []*iam.OpenIDConnectProviderListEntry{
+						{Arn: aws.String("arn:aws:iam::123456789012:oidc-provider/gitlab.com")},
+						{Arn: aws.String("arn:aws:iam::123456789012:oidc-provider/token.actions.githubusercontent.com")},
+					},
+				}, nil)
+				return m
+			},
+			wanted: "arn:aws:iam::123456789012:oidc-provider/token.actions.githubusercontent.com",
+		},
+		"returns empty if there is no provider for the URL": {
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ListOpenIDConnectProviders(gomock.Any()).Return(&iam.ListOpenIDConnectProvidersOutput{
+					OpenIDConnectProviderList: []*iam.OpenIDConnectProviderListEntry{
+						{Arn: aws.String("arn:aws:iam::123456789012:oidc-provider/gitlab.com")},
+					},
+				}, nil)
+				return m
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := &IAM{
+				client: tc.inClient(ctrl),
+			}
+
+			// WHEN
+			got, err := client.OIDCProviderARN("https://token.actions.githubusercontent.com")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestIAM_ListAttachedRolePolicyARNs(t *testing.T) {
+	testCases := map[string]struct {
+		inClient func(ctrl *gomock.Controller) *mocks.Mockapi
+
+		wanted    []string
+		wantedErr error
+	}{
+		"wraps error on failure": {
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().ListAttachedRolePolicies(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("list attached policies for role phonetool-test-api-TaskRole: some error"),
+		},
+		"collects the policy ARNs until the response is no longer truncated": {
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				gomock.InOrder(
+					m.EXPECT().ListAttachedRolePolicies(&iam.ListAttachedRolePoliciesInput{
+						RoleName: aws.String("phonetool-test-api-TaskRole"),
+					}).Return(&iam.ListAttachedRolePoliciesOutput{
+						AttachedPolicies: []*iam.AttachedPolicy{
+							{PolicyArn: aws.String("arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess")},
+						},
+						IsTruncated: aws.Bool(true),
+						Marker:      aws.String("marker"),
+					}, nil),
+					m.EXPECT().ListAttachedRolePolicies(&iam.ListAttachedRolePoliciesInput{
+						RoleName: aws.String("phonetool-test-api-TaskRole"),
+						Marker:   aws.String("marker"),
+					}).Return(&iam.ListAttachedRolePoliciesOutput{
+						AttachedPolicies: []*iam.AttachedPolicy{
+							{PolicyArn: aws.String("arn:aws:iam::123456789012:policy/phonetool-test-api-AddonsStack-TableAccessPolicy")},
+						},
+					}, nil),
+				)
+				return m
+			},
+			wanted: []string{
+				"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess",
+				"arn:aws:iam::123456789012:policy/phonetool-test-api-AddonsStack-TableAccessPolicy",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := &IAM{
+				client: tc.inClient(ctrl),
+			}
+
+			// WHEN
+			got, err := client.ListAttachedRolePolicyARNs("phonetool-test-api-TaskRole")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestIAM_DeniedActions(t *testing.T) {
+	testCases := map[string]struct {
+		inPrincipal string
+		inClient    func(ctrl *gomock.Controller) *mocks.Mockapi
+
+		wanted    []string
+		wantedErr error
+	}{
+		"wraps error on failure": {
+			inPrincipal: "arn:aws:iam::123456789012:user/alice",
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().SimulatePrincipalPolicy(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("simulate policies of principal arn:aws:iam::123456789012:user/alice: some error"),
+		},
+		"simulates the role of an assumed-role session across pages": {
+			inPrincipal: "arn:aws:sts::123456789012:assumed-role/Admin/bob",
+			inClient: func(ctrl *gomock.Controller) *mocks.Mockapi {
+				m := mocks.NewMockapi(ctrl)
+				m.EXPECT().SimulatePrincipalPolicy(&iam.SimulatePrincipalPolicyInput{
+					PolicySourceArn: aws.String("arn:aws:iam::123456789012:role/Admin"),
+					ActionNames:     aws.StringSlice([]string{"ssm:GetParameter", "iam:PassRole", "s3:PutObject"}),
+				}).Return(&iam.SimulatePolicyResponse{
+					EvaluationResults: []*iam.EvaluationResult{
+						{EvalActionName: aws.String("ssm:GetParameter"), EvalDecision: aws.String(iam.PolicyEvaluationDecisionTypeAllowed)},
+						{EvalActionName: aws.String("iam:PassRole"), EvalDecision: aws.String(iam.PolicyEvaluationDecisionTypeImplicitDeny)},
+					},
+					IsTruncated: aws.Bool(true),
+					Marker:      aws.String("next"),
+				}, nil)
+				m.EXPECT().SimulatePrincipalPolicy(&iam.SimulatePrincipalPolicyInput{
+					PolicySourceArn: aws.String("arn:aws:iam::123456789012:role/Admin"),
+					ActionNames:     aws.StringSlice([]string{"ssm:GetParameter", "iam:PassRole", "s3:PutObject"}),
+					Marker:          aws.String("next"),
+				}).Return(&iam.SimulatePolicyResponse{
+					EvaluationResults: []*iam.EvaluationResult{
+						{EvalActionName: aws.String("s3:PutObject"), EvalDecision: aws.String(iam.PolicyEvaluationDecisionTypeExplicitDeny)},
+					},
+				}, nil)
+				return m
+			},
+			wanted: []string{"iam:PassRole", "s3:PutObject"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := &IAM{
+				client: tc.inClient(ctrl),
+			}
+
+			// WHEN
+			got, err := client.DeniedActions(tc.inPrincipal, []string{"ssm:GetParameter", "iam:PassRole", "s3:PutObject"})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
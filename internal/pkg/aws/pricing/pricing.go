@@ -0,0 +1,141 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package pricing provides a client to make API requests to the AWS Price List Service.
+package pricing
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/pricing"
+)
+
+// The Price List Service is only available in a few regions, but returns the prices of all regions.
+const endpointRegion = "us-east-1"
+
+const (
+	attributeRegionCode = "regionCode"
+	attributeUsageType  = "usagetype"
+	currencyUSD         = "USD"
+)
+
+type api interface {
+	GetProducts(input *pricing.GetProductsInput) (*pricing.GetProductsOutput, error)
+}
+
+// Pricing wraps an AWS Price List Service client.
+type Pricing struct {
+	client api
+}
+
+// New returns a Pricing client configured against the input session.
+func New(s *session.Session) *Pricing {
+	return &Pricing{
+		client: pricing.New(s, aws.NewConfig().WithRegion(endpointRegion)),
+	}
+}
+
+// Price is the on-demand price of a product.
+type Price struct {
+	UsageType string  // For example, "USW2-Fargate-vCPU-Hours:perCPU".
+	Unit      string  // For example, "hours".
+	USD       float64 // Price in US dollars per unit.
+}
+
+// Prices returns the on-demand prices of the products of a service in a region that match all the attributes.
+func (p *Pricing) Prices(serviceCode, region string, attributes map[string]string) ([]Price, error) {
+	filters := []*pricing.Filter{
+		{
+			Field: aws.String(attributeRegionCode),
+			Type:  aws.String(pricing.FilterTypeTermMatch),
+			Value: aws.String(region),
+		},
+	}
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		filters = append(filters, &pricing.Filter{
+			Field: aws.String(key),
+			Type:  aws.String(pricing.FilterTypeTermMatch),
+			Value: aws.String(attributes[key]),
+		})
+	}
+
+	var prices []Price
+	var nextToken *string
+	for {
+		out, err := p.client.GetProducts(&pricing.GetProductsInput{
+			ServiceCode: aws.String(serviceCode),
+			Filters:     filters,
+			NextToken:   nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get products of service %s in region %s: %w", serviceCode, region, err)
+		}
+		for _, item := range out.PriceList {
+			price, err := parsePrices(item)
+			if err != nil {
+				return nil, fmt.Errorf("parse price list of service %s: %w", serviceCode, err)
+			}
+			prices = append(prices, price...)
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	return prices, nil
+}
+
+// product is the part of a price list item that holds the on-demand prices.
+type product struct {
+	Product struct {
+		Attributes map[string]string `json:"attributes"`
+	} `json:"product"`
+	Terms struct {
+		OnDemand map[string]struct {
+			PriceDimensions map[string]struct {
+				Unit         string            `json:"unit"`
+				PricePerUnit map[string]string `json:"pricePerUnit"`
+			} `json:"priceDimensions"`
+		} `json:"OnDemand"`
+	} `json:"terms"`
+}
+
+func parsePrices(item aws.JSONValue) ([]Price, error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	var p product
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, err
+	}
+	var prices []Price
+	for _, term := range p.Terms.OnDemand {
+		for _, dimension := range term.PriceDimensions {
+			usd, ok := dimension.PricePerUnit[currencyUSD]
+			if !ok {
+				continue
+			}
+			val, err := strconv.ParseFloat(usd, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parse price %q: %w", usd, err)
+			}
+			prices = append(prices, Price{
+				UsageType: p.Product.Attributes[attributeUsageType],
+				Unit:      dimension.Unit,
+				USD:       val,
+			})
+		}
+	}
+	return prices, nil
+}
@@ -0,0 +1,132 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package pricing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/copilot-cli/internal/pkg/aws/pricing/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func priceListItem(usageType, unit, usd string) aws.JSONValue {
+	return aws.JSONValue{
+		"product": map[string]interface{}{
+			"attributes": map[string]interface{}{
+				"usagetype": usageType,
+			},
+		},
+		"terms": map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				"ABC.JRTCKXETXF": map[string]interface{}{
+					"priceDimensions": map[string]interface{}{
+						"ABC.JRTCKXETXF.6YS6EN2CT7": map[string]interface{}{
+							"unit": unit,
+							"pricePerUnit": map[string]interface{}{
+								"USD": usd,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestPricing_Prices(t *testing.T) {
+	wantedFilters := []*pricing.Filter{
+		{
+			Field: aws.String("regionCode"),
+			Type:  aws.String("TERM_MATCH"),
+			Value: aws.String("us-west-2"),
+		},
+		{
+			Field: aws.String("productFamily"),
+			Type:  aws.String("TERM_MATCH"),
+			Value: aws.String("Compute"),
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      []Price
+		wantedError error
+	}{
+		"return the on-demand prices across pages": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetProducts(&pricing.GetProductsInput{
+					ServiceCode: aws.String("AmazonECS"),
+					Filters:     wantedFilters,
+				}).Return(&pricing.GetProductsOutput{
+					PriceList: []aws.JSONValue{
+						priceListItem("USW2-Fargate-vCPU-Hours:perCPU", "hours", "0.0404800000"),
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().GetProducts(&pricing.GetProductsInput{
+					ServiceCode: aws.String("AmazonECS"),
+					Filters:     wantedFilters,
+					NextToken:   aws.String("next"),
+				}).Return(&pricing.GetProductsOutput{
+					PriceList: []aws.JSONValue{
+						priceListItem("USW2-Fargate-GB-Hours", "GB-Hours", "0.0044450000"),
+					},
+				}, nil)
+			},
+			wanted: []Price{
+				{
+					UsageType: "USW2-Fargate-vCPU-Hours:perCPU",
+					Unit:      "hours",
+					USD:       0.04048,
+				},
+				{
+					UsageType: "USW2-Fargate-GB-Hours",
+					Unit:      "GB-Hours",
+					USD:       0.004445,
+				},
+			},
+		},
+		"wrap the error": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetProducts(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get products of service AmazonECS in region us-west-2: some error"),
+		},
+		"return an error if a price is not a number": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetProducts(gomock.Any()).Return(&pricing.GetProductsOutput{
+					PriceList: []aws.JSONValue{
+						priceListItem("USW2-Fargate-GB-Hours", "GB-Hours", "free"),
+					},
+				}, nil)
+			},
+			wantedError: errors.New(`parse price list of service AmazonECS: parse price "free": strconv.ParseFloat: parsing "free": invalid syntax`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := Pricing{client: m}
+
+			got, err := client.Prices("AmazonECS", "us-west-2", map[string]string{
+				"productFamily": "Compute",
+			})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ const (
 
 type sectionsGetter interface {
 	Sections() []string
+	Get(section, key string) (string, bool)
 }
 
 // Config represents the local AWS config file.
@@ -55,6 +56,33 @@ func (c *Config) Names() []string {
 	return profiles
 }
 
+// AssumedRole is a role assumed by a named profile.
+type AssumedRole struct {
+	Profile string
+	RoleARN string
+}
+
+// RoleChain returns the roles assumed in order to get the credentials of the named profile,
+// starting from the profile holding the source credentials. It returns nil if the profile doesn't assume a role.
+func (c *Config) RoleChain(name string) []AssumedRole {
+	var chain []AssumedRole
+	visited := make(map[string]bool)
+	for name != "" && !visited[name] {
+		visited[name] = true
+		section := "profile " + name
+		if name == "default" {
+			section = name
+		}
+		roleARN, ok := c.f.Get(section, "role_arn")
+		if !ok {
+			break
+		}
+		chain = append([]AssumedRole{{Profile: name, RoleARN: roleARN}}, chain...)
+		name, _ = c.f.Get(section, "source_profile")
+	}
+	return chain
+}
+
 func cfgPath() (string, error) {
 	if os.Getenv("AWS_CONFIG_FILE") != "" {
 		return os.Getenv("AWS_CONFIG_FILE"), nil
@@ -11,12 +11,18 @@ import (
 
 type mockINI struct {
 	sections []string
+	values   map[string]map[string]string
 }
 
 func (m *mockINI) Sections() []string {
 	return m.sections
 }
 
+func (m *mockINI) Get(section, key string) (string, bool) {
+	v, ok := m.values[section][key]
+	return v, ok
+}
+
 func TestConfig_Names(t *testing.T) {
 	testCases := map[string]struct {
 		ini *mockINI
@@ -60,3 +66,77 @@ func TestConfig_Names(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_RoleChain(t *testing.T) {
+	testCases := map[string]struct {
+		ini     *mockINI
+		profile string
+
+		wantedChain []AssumedRole
+	}{
+		"return nil if the profile doesn't assume a role": {
+			ini: &mockINI{
+				values: map[string]map[string]string{
+					"default": {"region": "us-west-2"},
+				},
+			},
+			profile: "default",
+		},
+		"return the roles assumed from the source profile": {
+			ini: &mockINI{
+				values: map[string]map[string]string{
+					"default": {"region": "us-west-2"},
+					"profile admin": {
+						"role_arn":       "arn:aws:iam::111111111111:role/Admin",
+						"source_profile": "default",
+					},
+					"profile deployer": {
+						"role_arn":       "arn:aws:iam::222222222222:role/Deployer",
+						"source_profile": "admin",
+					},
+				},
+			},
+			profile: "deployer",
+
+			wantedChain: []AssumedRole{
+				{Profile: "admin", RoleARN: "arn:aws:iam::111111111111:role/Admin"},
+				{Profile: "deployer", RoleARN: "arn:aws:iam::222222222222:role/Deployer"},
+			},
+		},
+		"stop at profiles that reference each other": {
+			ini: &mockINI{
+				values: map[string]map[string]string{
+					"profile a": {
+						"role_arn":       "arn:aws:iam::111111111111:role/A",
+						"source_profile": "b",
+					},
+					"profile b": {
+						"role_arn":       "arn:aws:iam::111111111111:role/B",
+						"source_profile": "a",
+					},
+				},
+			},
+			profile: "a",
+
+			wantedChain: []AssumedRole{
+				{Profile: "b", RoleARN: "arn:aws:iam::111111111111:role/B"},
+				{Profile: "a", RoleARN: "arn:aws:iam::111111111111:role/A"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			conf := &Config{
+				f: tc.ini,
+			}
+
+			// WHEN
+			chain := conf.RoleChain(tc.profile)
+
+			// THEN
+			require.Equal(t, tc.wantedChain, chain)
+		})
+	}
+}
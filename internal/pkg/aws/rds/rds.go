@@ -0,0 +1,112 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rds provides a client to make API requests to Amazon Relational Database Service.
+package rds
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+type api interface {
+	DescribeDBClusters(input *rds.DescribeDBClustersInput) (*rds.DescribeDBClustersOutput, error)
+	StopDBCluster(input *rds.StopDBClusterInput) (*rds.StopDBClusterOutput, error)
+}
+
+// RDS wraps an Amazon Relational Database Service client.
+type RDS struct {
+	client api
+}
+
+// New returns an RDS client configured against the input session.
+func New(s *session.Session) *RDS {
+	return &RDS{
+		client: rds.New(s),
+	}
+}
+
+// Cluster holds the description of an Aurora DB cluster.
+type Cluster struct {
+	ID             string
+	Status         string
+	Engine         string
+	EngineVersion  string
+	EngineMode     string
+	Endpoint       string
+	ReaderEndpoint string
+	Port           int64
+	MinCapacity    float64 // The minimum capacity in ACUs of an Aurora Serverless cluster.
+	MaxCapacity    float64 // The maximum capacity in ACUs of an Aurora Serverless cluster.
+	Tags           map[string]string
+}
+
+// ListClusters returns the DB clusters that have all the tags.
+func (r *RDS) ListClusters(tags map[string]string) ([]Cluster, error) {
+	var clusters []Cluster
+	var marker *string
+	for {
+		out, err := r.client.DescribeDBClusters(&rds.DescribeDBClustersInput{
+			Marker: marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe DB clusters: %w", err)
+		}
+		for _, c := range out.DBClusters {
+			clusterTags := make(map[string]string, len(c.TagList))
+			for _, tag := range c.TagList {
+				clusterTags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+			if !hasTags(clusterTags, tags) {
+				continue
+			}
+			cluster := Cluster{
+				ID:             aws.StringValue(c.DBClusterIdentifier),
+				Status:         aws.StringValue(c.Status),
+				Engine:         aws.StringValue(c.Engine),
+				EngineVersion:  aws.StringValue(c.EngineVersion),
+				EngineMode:     aws.StringValue(c.EngineMode),
+				Endpoint:       aws.StringValue(c.Endpoint),
+				ReaderEndpoint: aws.StringValue(c.ReaderEndpoint),
+				Port:           aws.Int64Value(c.Port),
+				Tags:           clusterTags,
+			}
+			switch {
+			case c.ServerlessV2ScalingConfiguration != nil:
+				cluster.MinCapacity = aws.Float64Value(c.ServerlessV2ScalingConfiguration.MinCapacity)
+				cluster.MaxCapacity = aws.Float64Value(c.ServerlessV2ScalingConfiguration.MaxCapacity)
+			case c.ScalingConfigurationInfo != nil:
+				cluster.MinCapacity = float64(aws.Int64Value(c.ScalingConfigurationInfo.MinCapacity))
+				cluster.MaxCapacity = float64(aws.Int64Value(c.ScalingConfigurationInfo.MaxCapacity))
+			}
+			clusters = append(clusters, cluster)
+		}
+		if out.Marker == nil {
+			break
+		}
+		marker = out.Marker
+	}
+	return clusters, nil
+}
+
+// StopCluster stops a DB cluster. The cluster is started again automatically by RDS after seven days.
+func (r *RDS) StopCluster(id string) error {
+	if _, err := r.client.StopDBCluster(&rds.StopDBClusterInput{
+		DBClusterIdentifier: aws.String(id),
+	}); err != nil {
+		return fmt.Errorf("stop DB cluster %s: %w", id, err)
+	}
+	return nil
+}
+
+func hasTags(tags, wanted map[string]string) bool {
+	for k, v := range wanted {
+		if tags[k] != v {
+			return false
+		}
+	}
+	return true
+}
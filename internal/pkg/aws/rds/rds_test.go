@@ -0,0 +1,171 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package rds
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/copilot-cli/internal/pkg/aws/rds/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRDS_ListClusters(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      []Cluster
+		wantedError error
+	}{
+		"return the clusters with all the tags across pages": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeDBClusters(&rds.DescribeDBClustersInput{}).Return(&rds.DescribeDBClustersOutput{
+					DBClusters: []*rds.DBCluster{
+						{
+							DBClusterIdentifier: aws.String("phonetool-test-ordersdbcluster"),
+							Status:              aws.String("available"),
+							Engine:              aws.String("aurora-postgresql"),
+							EngineVersion:       aws.String("14.4"),
+							EngineMode:          aws.String("provisioned"),
+							Endpoint:            aws.String("orders.cluster-abc.us-west-2.rds.amazonaws.com"),
+							ReaderEndpoint:      aws.String("orders.cluster-ro-abc.us-west-2.rds.amazonaws.com"),
+							Port:                aws.Int64(5432),
+							ServerlessV2ScalingConfiguration: &rds.ServerlessV2ScalingConfigurationInfo{
+								MinCapacity: aws.Float64(0.5),
+								MaxCapacity: aws.Float64(8),
+							},
+							TagList: []*rds.Tag{
+								{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+								{Key: aws.String("copilot-environment"), Value: aws.String("test")},
+							},
+						},
+						{
+							DBClusterIdentifier: aws.String("other"),
+						},
+					},
+					Marker: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeDBClusters(&rds.DescribeDBClustersInput{
+					Marker: aws.String("next"),
+				}).Return(&rds.DescribeDBClustersOutput{
+					DBClusters: []*rds.DBCluster{
+						{
+							DBClusterIdentifier: aws.String("phonetool-test-api-usersdbcluster"),
+							Engine:              aws.String("aurora-mysql"),
+							EngineMode:          aws.String("serverless"),
+							ScalingConfigurationInfo: &rds.ScalingConfigurationInfo{
+								MinCapacity: aws.Int64(1),
+								MaxCapacity: aws.Int64(8),
+							},
+							TagList: []*rds.Tag{
+								{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+								{Key: aws.String("copilot-environment"), Value: aws.String("test")},
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: []Cluster{
+				{
+					ID:             "phonetool-test-ordersdbcluster",
+					Status:         "available",
+					Engine:         "aurora-postgresql",
+					EngineVersion:  "14.4",
+					EngineMode:     "provisioned",
+					Endpoint:       "orders.cluster-abc.us-west-2.rds.amazonaws.com",
+					ReaderEndpoint: "orders.cluster-ro-abc.us-west-2.rds.amazonaws.com",
+					Port:           5432,
+					MinCapacity:    0.5,
+					MaxCapacity:    8,
+					Tags: map[string]string{
+						"copilot-application": "phonetool",
+						"copilot-environment": "test",
+					},
+				},
+				{
+					ID:          "phonetool-test-api-usersdbcluster",
+					Engine:      "aurora-mysql",
+					EngineMode:  "serverless",
+					MinCapacity: 1,
+					MaxCapacity: 8,
+					Tags: map[string]string{
+						"copilot-application": "phonetool",
+						"copilot-environment": "test",
+					},
+				},
+			},
+		},
+		"wrap the error": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeDBClusters(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe DB clusters: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := RDS{client: m}
+
+			got, err := client.ListClusters(map[string]string{
+				"copilot-application": "phonetool",
+				"copilot-environment": "test",
+			})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestRDS_StopCluster(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedError error
+	}{
+		"wrap the error": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StopDBCluster(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("stop DB cluster phonetool-test-db: some error"),
+		},
+		"stop the cluster": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().StopDBCluster(&rds.StopDBClusterInput{
+					DBClusterIdentifier: aws.String("phonetool-test-db"),
+				}).Return(&rds.StopDBClusterOutput{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := RDS{client: m}
+
+			err := client.StopCluster("phonetool-test-db")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
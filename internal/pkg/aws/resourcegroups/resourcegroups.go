@@ -16,6 +16,8 @@ import (
 const (
 	// ResourceTypeStateMachine is the resource type for the state machine of a job.
 	ResourceTypeStateMachine = "states:stateMachine"
+	// ResourceTypeLoadBalancer is the resource type for Application and Network Load Balancers.
+	ResourceTypeLoadBalancer = "elasticloadbalancing:loadbalancer"
 )
 
 type api interface {
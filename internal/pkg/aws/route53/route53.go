@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/copilot-cli/internal/pkg/cache"
 )
 
 const (
@@ -31,21 +32,30 @@ type nameserverResolver interface {
 	LookupNS(ctx context.Context, name string) ([]*net.NS, error)
 }
 
+// hostedZoneCacheTTL is how long the hosted zone of a domain is reused by the next commands.
+const hostedZoneCacheTTL = time.Hour
+
 // Route53 wraps an Route53 client.
 type Route53 struct {
 	client api
 	dns    nameserverResolver
 
 	hostedZoneIDFor map[string]string
+	cache           *cache.Cache // Keeps the hosted zones of domains for the next commands. If nil, they're looked up every time.
+	cacheScope      string
 }
 
 // New returns a Route53 struct configured against the input session.
 func New(s *session.Session) *Route53 {
-	return &Route53{
+	r53 := &Route53{
 		client:          route53.New(s, aws.NewConfig().WithRegion(route53Region)),
 		dns:             new(net.Resolver),
 		hostedZoneIDFor: make(map[string]string),
 	}
+	if scope := cache.Scope(s.Config.Credentials, route53Region); scope != "" {
+		r53.cache, r53.cacheScope = cache.New(), scope
+	}
+	return r53
 }
 
 // DomainHostedZoneID returns the Hosted Zone ID of a domain.
@@ -53,6 +63,18 @@ func (r53 *Route53) DomainHostedZoneID(domainName string) (string, error) {
 	if id, ok := r53.hostedZoneIDFor[domainName]; ok {
 		return id, nil
 	}
+	key := "route53/" + r53.cacheScope + "/hostedzone/" + domainName
+	id, err := cache.Fetch(r53.cache, key, hostedZoneCacheTTL, func() (string, error) {
+		return r53.lookupHostedZoneID(domainName)
+	})
+	if err != nil {
+		return "", err
+	}
+	r53.hostedZoneIDFor[domainName] = id
+	return id, nil
+}
+
+func (r53 *Route53) lookupHostedZoneID(domainName string) (string, error) {
 
 	in := &route53.ListHostedZonesByNameInput{DNSName: aws.String(domainName)}
 	resp, err := r53.client.ListHostedZonesByName(in)
@@ -63,9 +85,7 @@ func (r53 *Route53) DomainHostedZoneID(domainName string) (string, error) {
 		hostedZones := filterHostedZones(resp.HostedZones, matchesDomain(domainName))
 		if len(hostedZones) > 0 {
 			// return the first match.
-			id := strings.TrimPrefix(aws.StringValue(hostedZones[0].Id), "/hostedzone/")
-			r53.hostedZoneIDFor[domainName] = id
-			return id, nil
+			return strings.TrimPrefix(aws.StringValue(hostedZones[0].Id), "/hostedzone/"), nil
 		}
 		if !aws.BoolValue(resp.IsTruncated) {
 			return "", &ErrDomainHostedZoneNotFound{
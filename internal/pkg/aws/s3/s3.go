@@ -11,6 +11,7 @@ import (
 	"mime"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	awsarn "github.com/aws/aws-sdk-go/aws/arn"
@@ -46,6 +47,7 @@ type s3API interface {
 	ListObjectsV2(input *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error)
 	DeleteObjects(input *s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
 	HeadBucket(input *s3.HeadBucketInput) (*s3.HeadBucketOutput, error)
+	GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error)
 }
 
 // NamedBinary is a named binary to be uploaded.
@@ -78,6 +80,60 @@ func (s *S3) Upload(bucket, key string, data io.Reader) (string, error) {
 	return s.upload(bucket, key, data)
 }
 
+// Download returns the content of the object stored under the key in an S3 bucket.
+func (s *S3) Download(bucket, key string) ([]byte, error) {
+	out, err := s.s3Client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get object %s from bucket %s: %w", key, bucket, err)
+	}
+	defer out.Body.Close()
+	content, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read object %s from bucket %s: %w", key, bucket, err)
+	}
+	return content, nil
+}
+
+// ObjectKeys returns the keys of all objects in an S3 bucket that start with the prefix, in ascending order.
+func (s *S3) ObjectKeys(bucket, prefix string) ([]string, error) {
+	return s.objectKeys(bucket, prefix, func(*s3.Object) bool { return true })
+}
+
+// ObjectKeysModifiedAfter returns the keys of the objects in an S3 bucket that start with the prefix
+// and were last modified after the time, in ascending order.
+func (s *S3) ObjectKeysModifiedAfter(bucket, prefix string, after time.Time) ([]string, error) {
+	return s.objectKeys(bucket, prefix, func(object *s3.Object) bool {
+		return aws.TimeValue(object.LastModified).After(after)
+	})
+}
+
+func (s *S3) objectKeys(bucket, prefix string, include func(*s3.Object) bool) ([]string, error) {
+	var keys []string
+	var token *string
+	for {
+		listResp, err := s.s3Client.ListObjectsV2(&s3.ListObjectsV2Input{
+			Bucket:            aws.String(bucket),
+			Prefix:            aws.String(prefix),
+			ContinuationToken: token,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list objects with prefix %s for bucket %s: %w", prefix, bucket, err)
+		}
+		for _, object := range listResp.Contents {
+			if include(object) {
+				keys = append(keys, aws.StringValue(object.Key))
+			}
+		}
+		if listResp.NextContinuationToken == nil {
+			return keys, nil
+		}
+		token = listResp.NextContinuationToken
+	}
+}
+
 // EmptyBucket deletes all objects within the bucket.
 func (s *S3) EmptyBucket(bucket string) error {
 	var listResp *s3.ListObjectVersionsOutput
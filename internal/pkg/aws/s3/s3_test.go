@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
@@ -333,6 +334,147 @@ first failed on key "mock/key": some error`),
 	}
 }
 
+func TestS3_Download(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wantedContent []byte
+		wantErr       error
+	}{
+		"should return wrapped error if fail to get the object": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(&s3.GetObjectInput{
+					Bucket: aws.String("mockBucket"),
+					Key:    aws.String("mockKey"),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("get object mockKey from bucket mockBucket: some error"),
+		},
+		"should return the content of the object": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().GetObject(&s3.GetObjectInput{
+					Bucket: aws.String("mockBucket"),
+					Key:    aws.String("mockKey"),
+				}).Return(&s3.GetObjectOutput{
+					Body: io.NopCloser(bytes.NewBufferString("bar")),
+				}, nil)
+			},
+			wantedContent: []byte("bar"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			gotContent, gotErr := service.Download("mockBucket", "mockKey")
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.wantedContent, gotContent)
+		})
+	}
+}
+
+func TestS3_ObjectKeys(t *testing.T) {
+	testCases := map[string]struct {
+		mockS3Client func(m *mocks.Mocks3API)
+
+		wantedKeys []string
+		wantErr    error
+	}{
+		"should return wrapped error if fail to list objects": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().ListObjectsV2(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("list objects with prefix mock/ for bucket mockBucket: some error"),
+		},
+		"should return the keys of every page": {
+			mockS3Client: func(m *mocks.Mocks3API) {
+				m.EXPECT().ListObjectsV2(&s3.ListObjectsV2Input{
+					Bucket: aws.String("mockBucket"),
+					Prefix: aws.String("mock/"),
+				}).Return(&s3.ListObjectsV2Output{
+					Contents: []*s3.Object{
+						{Key: aws.String("mock/1")},
+						{Key: aws.String("mock/2")},
+					},
+					NextContinuationToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().ListObjectsV2(&s3.ListObjectsV2Input{
+					Bucket:            aws.String("mockBucket"),
+					Prefix:            aws.String("mock/"),
+					ContinuationToken: aws.String("token"),
+				}).Return(&s3.ListObjectsV2Output{
+					Contents: []*s3.Object{
+						{Key: aws.String("mock/3")},
+					},
+				}, nil)
+			},
+			wantedKeys: []string{"mock/1", "mock/2", "mock/3"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockS3Client := mocks.NewMocks3API(ctrl)
+			tc.mockS3Client(mockS3Client)
+
+			service := S3{
+				s3Client: mockS3Client,
+			}
+
+			gotKeys, gotErr := service.ObjectKeys("mockBucket", "mock/")
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, tc.wantedKeys, gotKeys)
+		})
+	}
+}
+
+func TestS3_ObjectKeysModifiedAfter(t *testing.T) {
+	after := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockS3Client := mocks.NewMocks3API(ctrl)
+	mockS3Client.EXPECT().ListObjectsV2(&s3.ListObjectsV2Input{
+		Bucket: aws.String("mockBucket"),
+		Prefix: aws.String("mock/"),
+	}).Return(&s3.ListObjectsV2Output{
+		Contents: []*s3.Object{
+			{Key: aws.String("mock/1"), LastModified: aws.Time(after.Add(-time.Hour))},
+			{Key: aws.String("mock/2"), LastModified: aws.Time(after.Add(time.Hour))},
+			{Key: aws.String("mock/3"), LastModified: aws.Time(after)},
+		},
+	}, nil)
+	service := S3{
+		s3Client: mockS3Client,
+	}
+
+	gotKeys, gotErr := service.ObjectKeysModifiedAfter("mockBucket", "mock/", after)
+
+	require.NoError(t, gotErr)
+	require.Equal(t, []string{"mock/2"}, gotKeys)
+}
+
 func TestS3_ParseURL(t *testing.T) {
 	testCases := map[string]struct {
 		inURL string
@@ -0,0 +1,56 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package scheduler provides a client to make API requests to Amazon EventBridge Scheduler.
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/scheduler"
+)
+
+const (
+	fmtOneTimeExpression = "at(%s)"
+	oneTimeLayout        = "2006-01-02T15:04:05"
+	utcTimezone          = "UTC"
+)
+
+type api interface {
+	CreateSchedule(input *scheduler.CreateScheduleInput) (*scheduler.CreateScheduleOutput, error)
+}
+
+// Scheduler wraps an Amazon EventBridge Scheduler client.
+type Scheduler struct {
+	client api
+}
+
+// New returns a Scheduler client configured against the input session.
+func New(s *session.Session) *Scheduler {
+	return &Scheduler{
+		client: scheduler.New(s),
+	}
+}
+
+// ScheduleOnce creates a schedule that invokes the target once at the given time by assuming the role.
+func (s *Scheduler) ScheduleOnce(name string, at time.Time, targetARN, roleARN string) error {
+	_, err := s.client.CreateSchedule(&scheduler.CreateScheduleInput{
+		Name:                       aws.String(name),
+		ScheduleExpression:         aws.String(fmt.Sprintf(fmtOneTimeExpression, at.UTC().Format(oneTimeLayout))),
+		ScheduleExpressionTimezone: aws.String(utcTimezone),
+		FlexibleTimeWindow: &scheduler.FlexibleTimeWindow{
+			Mode: aws.String(scheduler.FlexibleTimeWindowModeOff),
+		},
+		Target: &scheduler.Target{
+			Arn:     aws.String(targetARN),
+			RoleArn: aws.String(roleARN),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("create schedule %s: %w", name, err)
+	}
+	return nil
+}
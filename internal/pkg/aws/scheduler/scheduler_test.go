@@ -0,0 +1,68 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/scheduler"
+	"github.com/aws/copilot-cli/internal/pkg/aws/scheduler/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler_ScheduleOnce(t *testing.T) {
+	mockAt := time.Date(2023, time.October, 15, 9, 30, 0, 0, time.FixedZone("PDT", -7*60*60))
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wantedError error
+	}{
+		"create a one-time schedule in UTC": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSchedule(&scheduler.CreateScheduleInput{
+					Name:                       aws.String("phonetool-test-report-1697387400"),
+					ScheduleExpression:         aws.String("at(2023-10-15T16:30:00)"),
+					ScheduleExpressionTimezone: aws.String("UTC"),
+					FlexibleTimeWindow: &scheduler.FlexibleTimeWindow{
+						Mode: aws.String("OFF"),
+					},
+					Target: &scheduler.Target{
+						Arn:     aws.String("arn:aws:states:us-west-2:123456789012:stateMachine:report"),
+						RoleArn: aws.String("arn:aws:iam::123456789012:role/phonetool-test-report-RuleRole"),
+					},
+				}).Return(&scheduler.CreateScheduleOutput{}, nil)
+			},
+		},
+		"wrap the error": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSchedule(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("create schedule phonetool-test-report-1697387400: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := Scheduler{client: m}
+
+			err := client.ScheduleOnce("phonetool-test-report-1697387400", mockAt,
+				"arn:aws:states:us-west-2:123456789012:stateMachine:report",
+				"arn:aws:iam::123456789012:role/phonetool-test-report-RuleRole")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package secretsmanager
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -23,6 +24,7 @@ type api interface {
 	DeleteSecret(*secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error)
 	DescribeSecret(input *secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error)
 	GetSecretValue(input *secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error)
+	ListSecrets(input *secretsmanager.ListSecretsInput) (*secretsmanager.ListSecretsOutput, error)
 }
 
 // SecretsManager wraps the AWS SecretManager client.
@@ -118,6 +120,82 @@ func (s *SecretsManager) DescribeSecret(secretName string) (*DescribeSecretOutpu
 	}, nil
 }
 
+// Secret holds the metadata of a secret, but not its value.
+type Secret struct {
+	Name         string
+	ARN          string
+	LastModified time.Time
+}
+
+// ListSecrets returns the metadata of all the secrets that have the tags.
+func (s *SecretsManager) ListSecrets(tags map[string]string) ([]Secret, error) {
+	// The "tag-key" and "tag-value" filters match secrets with any of the keys and any of the values,
+	// so the tags of each secret are checked again below.
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	values := make([]string, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, tags[k])
+	}
+	filters := []*secretsmanager.Filter{
+		{
+			Key:    aws.String(secretsmanager.FilterNameStringTypeTagKey),
+			Values: aws.StringSlice(keys),
+		},
+		{
+			Key:    aws.String(secretsmanager.FilterNameStringTypeTagValue),
+			Values: aws.StringSlice(values),
+		},
+	}
+
+	var secrets []Secret
+	var nextToken *string
+	for {
+		out, err := s.secretsManager.ListSecrets(&secretsmanager.ListSecretsInput{
+			Filters:   filters,
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list secrets: %w", err)
+		}
+		for _, entry := range out.SecretList {
+			if !hasTags(entry.Tags, tags) {
+				continue
+			}
+			lastModified := aws.TimeValue(entry.LastChangedDate)
+			if lastModified.IsZero() {
+				lastModified = aws.TimeValue(entry.CreatedDate)
+			}
+			secrets = append(secrets, Secret{
+				Name:         aws.StringValue(entry.Name),
+				ARN:          aws.StringValue(entry.ARN),
+				LastModified: lastModified,
+			})
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	return secrets, nil
+}
+
+func hasTags(tags []*secretsmanager.Tag, wanted map[string]string) bool {
+	got := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		got[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	for k, v := range wanted {
+		if got[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
 // GetSecretValue retrieves the value of a secret from AWS Secrets Manager.
 // It takes the name of the secret as input and returns the corresponding value as a string.
 func (s *SecretsManager) GetSecretValue(name string) (string, error) {
@@ -251,3 +251,115 @@ func TestSecretsManager_DescribeSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretsManager_ListSecrets(t *testing.T) {
+	mockTime := time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)
+	mockFilters := []*secretsmanager.Filter{
+		{
+			Key:    aws.String("tag-key"),
+			Values: aws.StringSlice([]string{"copilot-application", "copilot-environment"}),
+		},
+		{
+			Key:    aws.String("tag-value"),
+			Values: aws.StringSlice([]string{"phonetool", "test"}),
+		},
+	}
+	mockTags := func(app, env string) []*secretsmanager.Tag {
+		return []*secretsmanager.Tag{
+			{Key: aws.String("copilot-application"), Value: aws.String(app)},
+			{Key: aws.String("copilot-environment"), Value: aws.String(env)},
+		}
+	}
+
+	tests := map[string]struct {
+		callMock func(m *mocks.Mockapi)
+
+		expectedResp  []Secret
+		expectedError error
+	}{
+		"should wrap error returned by ListSecrets": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().ListSecrets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("list secrets: some error"),
+		},
+		"should return the secrets with all the tags from all pages": {
+			callMock: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().ListSecrets(&secretsmanager.ListSecretsInput{
+						Filters: mockFilters,
+					}).Return(&secretsmanager.ListSecretsOutput{
+						SecretList: []*secretsmanager.SecretListEntry{
+							{
+								Name:            aws.String("stripe-key"),
+								ARN:             aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:stripe-key"),
+								LastChangedDate: aws.Time(mockTime),
+								Tags:            mockTags("phonetool", "test"),
+							},
+							{
+								// Has a key and a value of the filters, but not as the same tag.
+								Name: aws.String("test"),
+								ARN:  aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:test"),
+								Tags: mockTags("test", "phonetool"),
+							},
+						},
+						NextToken: aws.String("next"),
+					}, nil),
+					m.EXPECT().ListSecrets(&secretsmanager.ListSecretsInput{
+						Filters:   mockFilters,
+						NextToken: aws.String("next"),
+					}).Return(&secretsmanager.ListSecretsOutput{
+						SecretList: []*secretsmanager.SecretListEntry{
+							{
+								Name:        aws.String("db-credentials"),
+								ARN:         aws.String("arn:aws:secretsmanager:us-west-2:123456789012:secret:db-credentials"),
+								CreatedDate: aws.Time(mockTime),
+								Tags:        mockTags("phonetool", "test"),
+							},
+						},
+					}, nil),
+				)
+			},
+			expectedResp: []Secret{
+				{
+					Name:         "stripe-key",
+					ARN:          "arn:aws:secretsmanager:us-west-2:123456789012:secret:stripe-key",
+					LastModified: mockTime,
+				},
+				{
+					Name:         "db-credentials",
+					ARN:          "arn:aws:secretsmanager:us-west-2:123456789012:secret:db-credentials",
+					LastModified: mockTime,
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+			tc.callMock(mockSecretsManager)
+
+			// WHEN
+			resp, err := sm.ListSecrets(map[string]string{
+				"copilot-environment": "test",
+				"copilot-application": "phonetool",
+			})
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedResp, resp)
+			}
+		})
+	}
+}
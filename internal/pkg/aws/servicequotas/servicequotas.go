@@ -0,0 +1,62 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package servicequotas provides a client to make API requests to Service Quotas.
+package servicequotas
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+)
+
+type api interface {
+	GetServiceQuota(input *servicequotas.GetServiceQuotaInput) (*servicequotas.GetServiceQuotaOutput, error)
+	RequestServiceQuotaIncrease(input *servicequotas.RequestServiceQuotaIncreaseInput) (*servicequotas.RequestServiceQuotaIncreaseOutput, error)
+}
+
+// ServiceQuotas wraps a Service Quotas client.
+type ServiceQuotas struct {
+	client api
+}
+
+// New returns a ServiceQuotas client configured against the input session.
+func New(s *session.Session) *ServiceQuotas {
+	return &ServiceQuotas{
+		client: servicequotas.New(s),
+	}
+}
+
+// Quota returns the value of a quota applied to the account in the region of the session,
+// such as quota "L-3032A538" of service "fargate".
+func (s *ServiceQuotas) Quota(serviceCode, quotaCode string) (float64, error) {
+	out, err := s.client.GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("get quota %s of service %s: %w", quotaCode, serviceCode, err)
+	}
+	if out.Quota == nil || out.Quota.Value == nil {
+		return 0, fmt.Errorf("quota %s of service %s has no value", quotaCode, serviceCode)
+	}
+	return aws.Float64Value(out.Quota.Value), nil
+}
+
+// RequestIncrease files a request to raise a quota of a service to the desired value, and returns the ID of the request.
+func (s *ServiceQuotas) RequestIncrease(serviceCode, quotaCode string, desired float64) (string, error) {
+	out, err := s.client.RequestServiceQuotaIncrease(&servicequotas.RequestServiceQuotaIncreaseInput{
+		ServiceCode:  aws.String(serviceCode),
+		QuotaCode:    aws.String(quotaCode),
+		DesiredValue: aws.Float64(desired),
+	})
+	if err != nil {
+		return "", fmt.Errorf("request an increase of quota %s of service %s to %g: %w", quotaCode, serviceCode, desired, err)
+	}
+	if out.RequestedQuota == nil {
+		return "", nil
+	}
+	return aws.StringValue(out.RequestedQuota.Id), nil
+}
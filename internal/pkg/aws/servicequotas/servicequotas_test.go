@@ -0,0 +1,128 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package servicequotas
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/servicequotas/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceQuotas_Quota(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wanted    float64
+		wantedErr error
+	}{
+		"wraps error on failure": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetServiceQuota(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get quota L-3032A538 of service fargate: some error"),
+		},
+		"errors if the quota has no value": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetServiceQuota(gomock.Any()).Return(&servicequotas.GetServiceQuotaOutput{
+					Quota: &servicequotas.ServiceQuota{},
+				}, nil)
+			},
+			wantedErr: errors.New("quota L-3032A538 of service fargate has no value"),
+		},
+		"returns the value of the quota": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+					ServiceCode: aws.String("fargate"),
+					QuotaCode:   aws.String("L-3032A538"),
+				}).Return(&servicequotas.GetServiceQuotaOutput{
+					Quota: &servicequotas.ServiceQuota{
+						Value: aws.Float64(6),
+					},
+				}, nil)
+			},
+			wanted: 6,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := &ServiceQuotas{
+				client: m,
+			}
+
+			// WHEN
+			got, err := client.Quota("fargate", "L-3032A538")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestServiceQuotas_RequestIncrease(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wanted    string
+		wantedErr error
+	}{
+		"wraps error on failure": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RequestServiceQuotaIncrease(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("request an increase of quota L-3032A538 of service fargate to 12: some error"),
+		},
+		"returns the ID of the request": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().RequestServiceQuotaIncrease(&servicequotas.RequestServiceQuotaIncreaseInput{
+					ServiceCode:  aws.String("fargate"),
+					QuotaCode:    aws.String("L-3032A538"),
+					DesiredValue: aws.Float64(12),
+				}).Return(&servicequotas.RequestServiceQuotaIncreaseOutput{
+					RequestedQuota: &servicequotas.RequestedServiceQuotaChange{
+						Id: aws.String("d1c5a2f3"),
+					},
+				}, nil)
+			},
+			wanted: "d1c5a2f3",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			client := &ServiceQuotas{
+				client: m,
+			}
+
+			// WHEN
+			got, err := client.RequestIncrease("fargate", "L-3032A538", 12)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
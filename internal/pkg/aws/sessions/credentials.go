@@ -0,0 +1,169 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessions
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// assumeRoleExpiryWindow is how long before they expire that the credentials of an assumed role are refreshed.
+// Requests that are retried or polled for a long time, such as while waiting for a stack update,
+// get fresh credentials instead of failing with an expired token.
+const assumeRoleExpiryWindow = 5 * time.Minute
+
+// RoleSource is where the credentials to assume a role come from, instead of the default credentials.
+type RoleSource struct {
+	Profile string // Named profile of the shared config files.
+	RoleARN string // Role to assume with the default credentials first.
+}
+
+var (
+	roleSourcesMu sync.RWMutex
+	roleSources   map[string]RoleSource // Keyed by the name of the role to assume.
+
+	ssoLoginMu sync.Mutex
+	ssoLogin   func(profile string) error
+)
+
+// UseRoleSources makes the sessions that assume a role by name, such as the manager role of an environment,
+// get their credentials from the source of the role instead of from the default credentials.
+func UseRoleSources(sources map[string]RoleSource) {
+	roleSourcesMu.Lock()
+	defer roleSourcesMu.Unlock()
+	roleSources = sources
+}
+
+// OnExpiredSSOToken calls login when the SSO token of a profile expired while retrieving credentials,
+// and retrieves the credentials again once it returns without an error.
+// The profile is empty if the credentials come from the default profile.
+func OnExpiredSSOToken(login func(profile string) error) {
+	ssoLoginMu.Lock()
+	defer ssoLoginMu.Unlock()
+	ssoLogin = login
+}
+
+func roleSource(roleARN string) (RoleSource, bool) {
+	parsed, err := arn.Parse(roleARN)
+	if err != nil {
+		return RoleSource{}, false
+	}
+	name := parsed.Resource[strings.LastIndex(parsed.Resource, "/")+1:]
+	roleSourcesMu.RLock()
+	defer roleSourcesMu.RUnlock()
+	src, ok := roleSources[name]
+	return src, ok
+}
+
+// sourceSession returns the session whose credentials assume the role.
+func (p *Provider) sourceSession(roleARN, region string) (*session.Session, error) {
+	src, ok := roleSource(roleARN)
+	switch {
+	case ok && src.Profile != "":
+		sess, err := session.NewSessionWithOptions(session.Options{
+			Config:                  *newConfig().WithRegion(region),
+			SharedConfigState:       session.SharedConfigEnable,
+			Profile:                 src.Profile,
+			AssumeRoleTokenProvider: stscreds.StdinTokenProvider,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create session from profile %s: %w", src.Profile, err)
+		}
+		refreshOnSSOLogin(sess, src.Profile)
+		if _, err := p.sessionValidator.ValidateCredentials(sess); err != nil {
+			if isCredRetrievalErr(err) {
+				return nil, &errCredRetrieval{profile: src.Profile, parentErr: err}
+			}
+			return nil, err
+		}
+		addRateLimitHandlers(sess)
+		return sess, nil
+	case ok && src.RoleARN != "":
+		defaultSession, err := p.defaultSession()
+		if err != nil {
+			return nil, fmt.Errorf("create default session: %w", err)
+		}
+		sess, err := session.NewSession(
+			newConfig().
+				WithCredentials(assumeRoleCredentials(defaultSession, src.RoleARN)).
+				WithRegion(region),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("create session from role %s: %w", src.RoleARN, err)
+		}
+		addRateLimitHandlers(sess)
+		return sess, nil
+	}
+	sess, err := p.defaultSession()
+	if err != nil {
+		return nil, fmt.Errorf("create default session: %w", err)
+	}
+	return sess, nil
+}
+
+func assumeRoleCredentials(sess *session.Session, roleARN string) *credentials.Credentials {
+	return stscreds.NewCredentials(sess, roleARN, func(p *stscreds.AssumeRoleProvider) {
+		p.ExpiryWindow = assumeRoleExpiryWindow
+	})
+}
+
+// refreshOnSSOLogin makes the credentials of the session retrieved again after logging in, if their SSO token expired.
+func refreshOnSSOLogin(sess *session.Session, profile string) {
+	ssoLoginMu.Lock()
+	defer ssoLoginMu.Unlock()
+	if ssoLogin == nil || sess.Config.Credentials == nil {
+		return
+	}
+	sess.Config.Credentials = credentials.NewCredentials(&ssoRefresher{
+		creds:   sess.Config.Credentials,
+		profile: profile,
+	})
+}
+
+// ssoRefresher is a credentials.Provider that logs in again when the SSO token of the credentials expired.
+type ssoRefresher struct {
+	creds   *credentials.Credentials
+	profile string
+}
+
+// Retrieve returns the credentials, and retrieves them again after logging in if the SSO token expired.
+func (r *ssoRefresher) Retrieve() (credentials.Value, error) {
+	v, err := r.creds.Get()
+	if err == nil || !isSSOTokenExpiredErr(err) {
+		return v, err
+	}
+	ssoLoginMu.Lock()
+	defer ssoLoginMu.Unlock()
+	// Another session may have logged in while waiting for the lock.
+	r.creds.Expire()
+	if v, err = r.creds.Get(); err == nil || !isSSOTokenExpiredErr(err) {
+		return v, err
+	}
+	if ssoLogin == nil {
+		return v, err
+	}
+	if loginErr := ssoLogin(r.profile); loginErr != nil {
+		return credentials.Value{}, &errSSOTokenExpired{profile: r.profile, parentErr: loginErr}
+	}
+	r.creds.Expire()
+	return r.creds.Get()
+}
+
+// IsExpired returns true if the wrapped credentials need to be refreshed.
+func (r *ssoRefresher) IsExpired() bool {
+	return r.creds.IsExpired()
+}
+
+func isSSOTokenExpiredErr(err error) bool {
+	return strings.Contains(err.Error(), ssocreds.ErrCodeSSOProviderInvalidToken) ||
+		strings.Contains(err.Error(), "cached SSO token is expired")
+}
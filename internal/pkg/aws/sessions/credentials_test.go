@@ -0,0 +1,98 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ssocreds"
+	"github.com/stretchr/testify/require"
+)
+
+// expiringProvider fails with an expired SSO token until loggedIn is set.
+type expiringProvider struct {
+	loggedIn *bool
+}
+
+func (p expiringProvider) Retrieve() (credentials.Value, error) {
+	if !*p.loggedIn {
+		return credentials.Value{}, awserr.New(ssocreds.ErrCodeSSOProviderInvalidToken, "the SSO session has expired or is invalid", nil)
+	}
+	return credentials.Value{AccessKeyID: "key", ProviderName: ssocreds.ProviderName}, nil
+}
+
+func (p expiringProvider) IsExpired() bool {
+	return !*p.loggedIn
+}
+
+func TestSSORefresher_Retrieve(t *testing.T) {
+	testCases := map[string]struct {
+		login func(loggedIn *bool) func(string) error
+
+		wantedKey   string
+		wantedError string
+	}{
+		"retrieve the credentials again once logged in": {
+			login: func(loggedIn *bool) func(string) error {
+				return func(profile string) error {
+					require.Equal(t, "prod-admin", profile)
+					*loggedIn = true
+					return nil
+				}
+			},
+			wantedKey: "key",
+		},
+		"error if the login fails": {
+			login: func(loggedIn *bool) func(string) error {
+				return func(string) error {
+					return errors.New("SSO login declined")
+				}
+			},
+			wantedError: "the SSO session of profile prod-admin has expired: SSO login declined",
+		},
+		"return the expired token error without a login": {
+			login: func(*bool) func(string) error {
+				return nil
+			},
+			wantedError: "SSOProviderInvalidToken: the SSO session has expired or is invalid",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			loggedIn := false
+			OnExpiredSSOToken(tc.login(&loggedIn))
+			defer OnExpiredSSOToken(nil)
+			r := &ssoRefresher{
+				creds:   credentials.NewCredentials(expiringProvider{loggedIn: &loggedIn}),
+				profile: "prod-admin",
+			}
+
+			v, err := r.Retrieve()
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedKey, v.AccessKeyID)
+		})
+	}
+}
+
+func TestRoleSource(t *testing.T) {
+	UseRoleSources(map[string]RoleSource{
+		"phonetool-prod-EnvManagerRole": {Profile: "prod-admin"},
+	})
+	defer UseRoleSources(nil)
+
+	src, ok := roleSource("arn:aws:iam::123456789012:role/phonetool-prod-EnvManagerRole")
+	require.True(t, ok)
+	require.Equal(t, RoleSource{Profile: "prod-admin"}, src)
+
+	_, ok = roleSource("arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole")
+	require.False(t, ok)
+}
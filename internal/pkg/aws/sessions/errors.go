@@ -50,3 +50,51 @@ More information: https://aws.github.io/copilot-cli/docs/credentials/`, notice)
 func isCredRetrievalErr(err error) bool {
 	return strings.Contains(err.Error(), "context deadline exceeded") || strings.Contains(err.Error(), "NoCredentialProviders")
 }
+
+// ErrOffline is returned by the requests of the sessions of an offline provider, which are never sent.
+type ErrOffline struct {
+	Service   string
+	Operation string
+}
+
+// Error implements the error interface.
+func (e *ErrOffline) Error() string {
+	return fmt.Sprintf("%s %s needs AWS credentials and can't be called offline", e.Service, e.Operation)
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+// Implements main.actionRecommender interface.
+func (e *ErrOffline) RecommendActions() string {
+	return `The command looks up a value in AWS that doesn't have an offline placeholder.
+Supply the value in the offline values file if there is a key for it, or run the command with AWS credentials.
+More information: https://aws.github.io/copilot-cli/docs/commands/svc-package/#offline`
+}
+
+type errSSOTokenExpired struct {
+	profile   string
+	parentErr error
+}
+
+// Implements error interface.
+func (e *errSSOTokenExpired) Error() string {
+	if e.profile == "" {
+		return fmt.Sprintf("the SSO session has expired: %v", e.parentErr)
+	}
+	return fmt.Sprintf("the SSO session of profile %s has expired: %v", e.profile, e.parentErr)
+}
+
+// Unwrap returns the error of the login.
+func (e *errSSOTokenExpired) Unwrap() error {
+	return e.parentErr
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+// Implements main.actionRecommender interface.
+func (e *errSSOTokenExpired) RecommendActions() string {
+	login := "aws sso login"
+	if e.profile != "" {
+		login = fmt.Sprintf("aws sso login --profile %s", e.profile)
+	}
+	return fmt.Sprintf(`Run %s to start a new SSO session, then run the command again.
+More information: https://aws.github.io/copilot-cli/docs/credentials/`, color.HighlightCode(login))
+}
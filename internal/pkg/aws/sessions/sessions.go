@@ -41,6 +41,7 @@ type Provider struct {
 	// Metadata associated with the provider.
 	userAgentExtras  []string
 	sessionValidator sessionValidator
+	offlineRegion    string // Region of the sessions of an offline provider, empty if the provider is online.
 }
 
 type sessionValidator interface {
@@ -70,6 +71,15 @@ func UserAgentExtras(extras ...string) func(*Provider) {
 	}
 }
 
+// Offline makes the provider return sessions without credentials whose requests fail with ErrOffline instead of being sent,
+// so that commands that only generate files can run without AWS credentials.
+// The sessions are in the region unless another region is requested.
+func Offline(region string) func(*Provider) {
+	return func(p *Provider) {
+		p.offlineRegion = region
+	}
+}
+
 // UserAgentExtras adds additional User-Agent extras to cached sessions and any new sessions.
 func (p *Provider) UserAgentExtras(extras ...string) {
 	p.userAgentExtras = append(p.userAgentExtras, extras...)
@@ -93,6 +103,9 @@ func (p *Provider) Default() (*session.Session, error) {
 
 // DefaultWithRegion returns a session configured against the "default" AWS profile and the input region.
 func (p *Provider) DefaultWithRegion(region string) (*session.Session, error) {
+	if p.offlineRegion != "" {
+		return p.offlineSession(region)
+	}
 	sess, err := session.NewSessionWithOptions(session.Options{
 		Config:                  *newConfig().WithRegion(region),
 		SharedConfigState:       session.SharedConfigEnable,
@@ -101,12 +114,18 @@ func (p *Provider) DefaultWithRegion(region string) (*session.Session, error) {
 	if err != nil {
 		return nil, err
 	}
+	refreshOnSSOLogin(sess, "")
 	sess.Handlers.Build.PushBackNamed(p.userAgentHandler())
+	addRateLimitHandlers(sess)
+	addTraceHandlers(sess)
 	return sess, nil
 }
 
 // FromProfile returns a session configured against the input profile name.
 func (p *Provider) FromProfile(name string) (*session.Session, error) {
+	if p.offlineRegion != "" {
+		return p.offlineSession(p.offlineRegion)
+	}
 	sess, err := session.NewSessionWithOptions(session.Options{
 		Config:                  *newConfig(),
 		SharedConfigState:       session.SharedConfigEnable,
@@ -119,6 +138,7 @@ func (p *Provider) FromProfile(name string) (*session.Session, error) {
 	if aws.StringValue(sess.Config.Region) == "" {
 		return nil, &errMissingRegion{}
 	}
+	refreshOnSSOLogin(sess, name)
 	if _, err := p.sessionValidator.ValidateCredentials(sess); err != nil {
 		if isCredRetrievalErr(err) {
 			return nil, &errCredRetrieval{profile: name, parentErr: err}
@@ -126,31 +146,41 @@ func (p *Provider) FromProfile(name string) (*session.Session, error) {
 		return nil, err
 	}
 	sess.Handlers.Build.PushBackNamed(p.userAgentHandler())
+	addRateLimitHandlers(sess)
+	addTraceHandlers(sess)
 	return sess, nil
 }
 
 // FromRole returns a session configured against the input role and region.
+// The role is assumed with the credentials of its source if one is used, and with the default credentials otherwise.
 func (p *Provider) FromRole(roleARN string, region string) (*session.Session, error) {
-	defaultSession, err := p.defaultSession()
+	if p.offlineRegion != "" {
+		return p.offlineSession(region)
+	}
+	sourceSession, err := p.sourceSession(roleARN, region)
 	if err != nil {
-		return nil, fmt.Errorf("create default session: %w", err)
+		return nil, err
 	}
 
-	creds := stscreds.NewCredentials(defaultSession, roleARN)
 	sess, err := session.NewSession(
 		newConfig().
-			WithCredentials(creds).
+			WithCredentials(assumeRoleCredentials(sourceSession, roleARN)).
 			WithRegion(region),
 	)
 	if err != nil {
 		return nil, err
 	}
 	sess.Handlers.Build.PushBackNamed(p.userAgentHandler())
+	addRateLimitHandlers(sess)
+	addTraceHandlers(sess)
 	return sess, nil
 }
 
 // FromStaticCreds returns a session from static credentials.
 func (p *Provider) FromStaticCreds(accessKeyID, secretAccessKey, sessionToken string) (*session.Session, error) {
+	if p.offlineRegion != "" {
+		return p.offlineSession(p.offlineRegion)
+	}
 	conf := newConfig()
 	conf.Credentials = credentials.NewStaticCredentials(accessKeyID, secretAccessKey, sessionToken)
 	sess, err := session.NewSessionWithOptions(session.Options{
@@ -160,6 +190,8 @@ func (p *Provider) FromStaticCreds(accessKeyID, secretAccessKey, sessionToken st
 		return nil, fmt.Errorf("create session from static credentials: %w", err)
 	}
 	sess.Handlers.Build.PushBackNamed(p.userAgentHandler())
+	addRateLimitHandlers(sess)
+	addTraceHandlers(sess)
 	return sess, nil
 }
 
@@ -167,6 +199,14 @@ func (p *Provider) defaultSession() (*session.Session, error) {
 	if p.defaultSess != nil {
 		return p.defaultSess, nil
 	}
+	if p.offlineRegion != "" {
+		sess, err := p.offlineSession(p.offlineRegion)
+		if err != nil {
+			return nil, err
+		}
+		p.defaultSess = sess
+		return sess, nil
+	}
 
 	sess, err := session.NewSessionWithOptions(session.Options{
 		Config:                  *newConfig(),
@@ -176,6 +216,7 @@ func (p *Provider) defaultSession() (*session.Session, error) {
 	if err != nil {
 		return nil, err
 	}
+	refreshOnSSOLogin(sess, "")
 	if _, err = p.sessionValidator.ValidateCredentials(sess); err != nil {
 		if isCredRetrievalErr(err) {
 			return nil, &errCredRetrieval{parentErr: err}
@@ -184,10 +225,35 @@ func (p *Provider) defaultSession() (*session.Session, error) {
 	}
 
 	sess.Handlers.Build.PushBackNamed(p.userAgentHandler())
+	addRateLimitHandlers(sess)
+	addTraceHandlers(sess)
 	p.defaultSess = sess
 	return sess, nil
 }
 
+// offlineSession returns a session that ignores the shared config and credentials, and fails every request with ErrOffline.
+func (p *Provider) offlineSession(region string) (*session.Session, error) {
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config:            *newConfig().WithCredentials(credentials.AnonymousCredentials).WithRegion(region),
+		SharedConfigState: session.SharedConfigDisable,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create offline session: %w", err)
+	}
+	sess.Handlers.Validate.PushFrontNamed(request.NamedHandler{
+		Name: "OfflineHandler",
+		Fn: func(r *request.Request) {
+			op := &ErrOffline{Service: r.ClientInfo.ServiceName}
+			if r.Operation != nil {
+				op.Operation = r.Operation.Name
+			}
+			r.Error = op
+		},
+	})
+	sess.Handlers.Build.PushBackNamed(p.userAgentHandler())
+	return sess, nil
+}
+
 // AreCredsFromEnvVars returns true if the session's credentials provider is environment variables, false otherwise.
 // An error is returned if the credentials are invalid or the request times out.
 func AreCredsFromEnvVars(sess *session.Session) (bool, error) {
@@ -210,15 +276,16 @@ func Creds(sess *session.Session) (credentials.Value, error) {
 	return v, nil
 }
 
-// newConfig returns a config with an end-to-end request timeout and verbose credentials errors.
+// newConfig returns a config with an end-to-end request timeout, verbose credentials errors,
+// and retries with jittered backoff.
 func newConfig() *aws.Config {
 	c := &http.Client{
 		Timeout: clientTimeout,
 	}
-	return aws.NewConfig().
+	conf := aws.NewConfig().
 		WithHTTPClient(c).
-		WithCredentialsChainVerboseErrors(true).
-		WithMaxRetries(maxRetriesOnRecoverableFailures)
+		WithCredentialsChainVerboseErrors(true)
+	return request.WithRetryer(conf, newRetryer())
 }
 
 // userAgentHandler returns a http request handler that sets the AWS Copilot custom user agent to all aws requests.
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/stretchr/testify/require"
 )
 
@@ -243,3 +244,29 @@ func restoreEnvVar(key string, originalValue string) error {
 	}
 	return os.Setenv(key, originalValue)
 }
+
+func TestProvider_Offline(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMocksessionValidator(ctrl) // No credentials are validated offline.
+
+	provider := &Provider{
+		sessionValidator: m,
+	}
+	Offline("us-west-2")(provider)
+
+	// WHEN
+	defaultSess, err := provider.Default()
+	require.NoError(t, err)
+	envSess, err := provider.FromRole("arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole", "eu-west-1")
+	require.NoError(t, err)
+	_, err = sts.New(envSess).GetCallerIdentity(&sts.GetCallerIdentityInput{})
+
+	// THEN
+	require.Equal(t, "us-west-2", aws.StringValue(defaultSess.Config.Region))
+	require.Equal(t, "eu-west-1", aws.StringValue(envSess.Config.Region))
+	var errOffline *ErrOffline
+	require.ErrorAs(t, err, &errOffline)
+	require.Equal(t, "sts GetCallerIdentity needs AWS credentials and can't be called offline", errOffline.Error())
+}
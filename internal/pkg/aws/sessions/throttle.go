@@ -0,0 +1,203 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessions
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// Backoff settings. The SDK adds jitter to the delays between retries.
+const (
+	minRetryDelay    = 50 * time.Millisecond
+	maxRetryDelay    = 5 * time.Second
+	minThrottleDelay = 500 * time.Millisecond
+	maxThrottleDelay = 20 * time.Second
+
+	maxAttemptsEnvVar = "AWS_MAX_ATTEMPTS"
+)
+
+// Client-side rate limiting settings.
+const (
+	minRequestsPerSecond = 0.5 // Lowest rate that a limiter slows down to after throttling errors.
+	throttleSlowdown     = 0.5 // Factor applied to the rate of a limiter after a throttling error.
+	recoverySteps        = 20  // Number of successful requests for a limiter to recover its maximum rate.
+)
+
+// requestsPerSecond is the maximum rate of the operations that large applications call many times in a row,
+// such as to show an application or to deploy a pipeline. The limits are shared by all the sessions of the process.
+var requestsPerSecond = map[string]float64{
+	"cloudformation/DescribeStacks":         10,
+	"cloudformation/DescribeStackEvents":    10,
+	"cloudformation/DescribeStackResources": 10,
+	"cloudformation/ListStackResources":     10,
+	"ssm/GetParameter":                      20,
+	"ssm/GetParameters":                     20,
+	"ssm/GetParametersByPath":               20,
+}
+
+var (
+	limitersMu sync.Mutex
+	limiters   = make(map[string]*limiter)
+
+	lookupEnv = os.LookupEnv
+)
+
+// newRetryer returns a retryer with jittered exponential backoff, that waits longer after throttling errors.
+// The number of attempts can be overridden with the AWS_MAX_ATTEMPTS environment variable.
+func newRetryer() request.Retryer {
+	retries := maxRetriesOnRecoverableFailures
+	if value, ok := lookupEnv(maxAttemptsEnvVar); ok {
+		if attempts, err := strconv.Atoi(value); err == nil && attempts > 0 {
+			retries = attempts - 1
+		}
+	}
+	return client.DefaultRetryer{
+		NumMaxRetries:    retries,
+		MinRetryDelay:    minRetryDelay,
+		MaxRetryDelay:    maxRetryDelay,
+		MinThrottleDelay: minThrottleDelay,
+		MaxThrottleDelay: maxThrottleDelay,
+	}
+}
+
+// addRateLimitHandlers makes each attempt of a rate limited operation wait for its limiter,
+// and adapts the rate of the limiter to the throttling errors of the operation.
+func addRateLimitHandlers(sess *session.Session) {
+	sess.Handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: "RateLimitHandler",
+		Fn: func(r *request.Request) {
+			l := limiterFor(r)
+			if l == nil {
+				return
+			}
+			if err := l.wait(r.Context()); err != nil {
+				r.Error = awserr.New(request.CanceledErrorCode, "request context canceled while rate limited", err)
+			}
+		},
+	})
+	sess.Handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: "AdaptiveRateHandler",
+		Fn: func(r *request.Request) {
+			l := limiterFor(r)
+			if l == nil {
+				return
+			}
+			switch {
+			case r.Error == nil:
+				l.succeeded()
+			case request.IsErrorThrottle(r.Error):
+				l.throttled()
+			}
+		},
+	})
+}
+
+// limiterFor returns the limiter of the operation of the request, or nil if the operation isn't rate limited.
+func limiterFor(r *request.Request) *limiter {
+	if r.Operation == nil {
+		return nil
+	}
+	key := r.ClientInfo.ServiceName + "/" + r.Operation.Name
+	rps, ok := requestsPerSecond[key]
+	if !ok {
+		return nil
+	}
+	limitersMu.Lock()
+	defer limitersMu.Unlock()
+	l, ok := limiters[key]
+	if !ok {
+		l = newLimiter(rps)
+		limiters[key] = l
+	}
+	return l
+}
+
+// limiter is a token bucket whose rate decreases after throttling errors, and increases back after successes.
+type limiter struct {
+	mu     sync.Mutex
+	max    float64 // Requests per second when the operation isn't throttled, and size of the bucket.
+	rate   float64 // Current requests per second.
+	tokens float64
+	last   time.Time
+
+	now func() time.Time
+}
+
+func newLimiter(rps float64) *limiter {
+	return &limiter{
+		max:    rps,
+		rate:   rps,
+		tokens: rps,
+		now:    time.Now,
+	}
+}
+
+// wait blocks until the request can be sent, or the context is done.
+func (l *limiter) wait(ctx context.Context) error {
+	for {
+		delay := l.reserve()
+		if delay == 0 {
+			return nil
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token if there is one and returns 0, otherwise it returns how long to wait for the next token.
+func (l *limiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens = minFloat(l.max, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+func (l *limiter) throttled() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rate = maxFloat(minRequestsPerSecond, l.rate*throttleSlowdown)
+	l.tokens = 0
+}
+
+func (l *limiter) succeeded() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rate = minFloat(l.max, l.rate+l.max/recoverySteps)
+}
+
+func minFloat(a, b float64) float64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxFloat(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
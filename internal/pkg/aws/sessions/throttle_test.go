@@ -0,0 +1,92 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRetryer(t *testing.T) {
+	defaultLookupEnv := lookupEnv
+	defer func() { lookupEnv = defaultLookupEnv }()
+
+	lookupEnv = func(string) (string, bool) { return "", false }
+	require.Equal(t, maxRetriesOnRecoverableFailures, newRetryer().MaxRetries())
+
+	lookupEnv = func(key string) (string, bool) {
+		require.Equal(t, "AWS_MAX_ATTEMPTS", key)
+		return "3", true
+	}
+	retryer := newRetryer()
+	require.Equal(t, 2, retryer.MaxRetries())
+	require.Equal(t, minThrottleDelay, retryer.(client.DefaultRetryer).MinThrottleDelay)
+}
+
+func TestLimiter(t *testing.T) {
+	now := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	l := newLimiter(2)
+	l.now = func() time.Time { return now }
+
+	// The bucket starts full.
+	require.Zero(t, l.reserve())
+	require.Zero(t, l.reserve())
+	require.Equal(t, 500*time.Millisecond, l.reserve())
+
+	// Tokens are added at the rate of the limiter.
+	now = now.Add(500 * time.Millisecond)
+	require.Zero(t, l.reserve())
+
+	// A throttling error empties the bucket and halves the rate.
+	l.throttled()
+	require.Equal(t, 1.0, l.rate)
+	require.Equal(t, time.Second, l.reserve())
+	l.throttled()
+	l.throttled()
+	require.Equal(t, minRequestsPerSecond, l.rate)
+
+	// Successes bring the rate back up to its maximum.
+	for i := 0; i < recoverySteps; i++ {
+		l.succeeded()
+	}
+	require.Equal(t, 2.0, l.rate)
+}
+
+func TestAddRateLimitHandlers(t *testing.T) {
+	defer func() { limiters = make(map[string]*limiter) }()
+	sess := &session.Session{
+		Config: aws.NewConfig(),
+	}
+	addRateLimitHandlers(sess)
+	newRequest := func(service, operation string) *request.Request {
+		return request.New(*sess.Config, metadata.ClientInfo{ServiceName: service}, sess.Handlers, nil,
+			&request.Operation{Name: operation}, nil, nil)
+	}
+
+	t.Run("throttling errors slow down the operation", func(t *testing.T) {
+		req := newRequest("ssm", "GetParameter")
+		req.Handlers.Sign.Run(req)
+		require.NoError(t, req.Error)
+
+		req.Error = awserr.New("ThrottlingException", "Rate exceeded", nil)
+		req.Handlers.CompleteAttempt.Run(req)
+
+		require.Equal(t, 10.0, limiters["ssm/GetParameter"].rate)
+	})
+	t.Run("operations without a limit are not rate limited", func(t *testing.T) {
+		req := newRequest("ssm", "PutParameter")
+		req.Handlers.Sign.Run(req)
+		req.Handlers.CompleteAttempt.Run(req)
+
+		require.NotContains(t, limiters, "ssm/PutParameter")
+	})
+}
@@ -0,0 +1,295 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessions
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+)
+
+// Call is an AWS API call, with all its attempts.
+// It holds no headers, query strings or bodies, so that traces never leak credentials or secrets.
+type Call struct {
+	Service    string        `json:"service"`
+	Operation  string        `json:"operation"`
+	Region     string        `json:"region,omitempty"`
+	Method     string        `json:"method,omitempty"`
+	URL        string        `json:"url,omitempty"` // Without the query string.
+	Start      time.Time     `json:"start"`
+	Duration   time.Duration `json:"durationNs"` // From the creation of the request to its last attempt, including retry delays.
+	Attempts   int           `json:"attempts"`
+	Throttles  int           `json:"throttles,omitempty"` // Number of attempts that were throttled.
+	RequestIDs []string      `json:"requestIds,omitempty"`
+	StatusCode int           `json:"statusCode,omitempty"`
+	ErrorCode  string        `json:"errorCode,omitempty"`
+}
+
+// Tracer records the AWS API calls of the sessions created after it's passed to TraceCalls.
+type Tracer struct {
+	mu      sync.Mutex
+	w       io.Writer // Every call is written as a line of JSON once it completes. Nothing is written if nil.
+	calls   []Call
+	pending map[*request.Request]*Call
+}
+
+var (
+	tracerMu sync.Mutex
+	tracer   *Tracer
+)
+
+// NewTracer returns a tracer that writes each call as a line of JSON to w.
+func NewTracer(w io.Writer) *Tracer {
+	return &Tracer{
+		w:       w,
+		pending: make(map[*request.Request]*Call),
+	}
+}
+
+// TraceCalls makes the sessions created from now on record their calls in t.
+func TraceCalls(t *Tracer) {
+	tracerMu.Lock()
+	defer tracerMu.Unlock()
+	tracer = t
+}
+
+// Calls returns the calls that completed, in the order they completed.
+func (t *Tracer) Calls() []Call {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	calls := make([]Call, len(t.calls))
+	copy(calls, t.calls)
+	return calls
+}
+
+// addTraceHandlers records the calls of the session in the tracer, if calls are traced.
+func addTraceHandlers(sess *session.Session) {
+	tracerMu.Lock()
+	t := tracer
+	tracerMu.Unlock()
+	if t == nil {
+		return
+	}
+	sess.Handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: "TraceAttemptHandler",
+		Fn:   t.attempted,
+	})
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "TraceHandler",
+		Fn:   t.completed,
+	})
+}
+
+func (t *Tracer) attempted(r *request.Request) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	call, ok := t.pending[r]
+	if !ok {
+		call = &Call{
+			Service: r.ClientInfo.ServiceName,
+			Region:  aws.StringValue(r.Config.Region),
+			Start:   r.Time,
+		}
+		if r.Operation != nil {
+			call.Operation = r.Operation.Name
+		}
+		if r.HTTPRequest != nil && r.HTTPRequest.URL != nil {
+			call.Method = r.HTTPRequest.Method
+			u := *r.HTTPRequest.URL
+			u.RawQuery, u.User = "", nil
+			call.URL = u.String()
+		}
+		t.pending[r] = call
+	}
+	call.Attempts++
+	if r.RequestID != "" {
+		call.RequestIDs = append(call.RequestIDs, r.RequestID)
+	}
+	if r.Error != nil && request.IsErrorThrottle(r.Error) {
+		call.Throttles++
+	}
+}
+
+func (t *Tracer) completed(r *request.Request) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	call, ok := t.pending[r]
+	if !ok {
+		return // The request failed before it was sent, such as when its parameters are invalid.
+	}
+	delete(t.pending, r)
+	call.Duration = time.Since(r.Time)
+	if r.HTTPResponse != nil {
+		call.StatusCode = r.HTTPResponse.StatusCode
+	}
+	if aerr, ok := r.Error.(awserr.Error); ok {
+		call.ErrorCode = aerr.Code()
+	}
+	t.calls = append(t.calls, *call)
+	if t.w == nil {
+		return
+	}
+	if line, err := json.Marshal(call); err == nil {
+		_, _ = t.w.Write(append(line, '\n'))
+	}
+}
+
+// OperationStats is the total of the calls to an operation.
+type OperationStats struct {
+	Service   string
+	Operation string
+	Calls     int
+	Retries   int
+	Throttles int
+	Errors    int
+	Duration  time.Duration // Sum of the duration of the calls.
+	Slowest   time.Duration
+}
+
+// Summarize returns the total of the calls of each operation, from the longest to the shortest total duration.
+func Summarize(calls []Call) []OperationStats {
+	idx := make(map[string]int)
+	var stats []OperationStats
+	for _, call := range calls {
+		key := call.Service + "/" + call.Operation
+		i, ok := idx[key]
+		if !ok {
+			i = len(stats)
+			idx[key] = i
+			stats = append(stats, OperationStats{Service: call.Service, Operation: call.Operation})
+		}
+		s := &stats[i]
+		s.Calls++
+		s.Retries += call.Attempts - 1
+		s.Throttles += call.Throttles
+		if call.ErrorCode != "" {
+			s.Errors++
+		}
+		s.Duration += call.Duration
+		if call.Duration > s.Slowest {
+			s.Slowest = call.Duration
+		}
+	}
+	sort.SliceStable(stats, func(i, j int) bool {
+		return stats[i].Duration > stats[j].Duration
+	})
+	return stats
+}
+
+// WriteHAR writes the calls as an HTTP Archive, which browsers and HAR viewers can open.
+// Only the method, URL, status, request IDs and timings of each call are included.
+func WriteHAR(w io.Writer, calls []Call) error {
+	type nameValue struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+	type content struct {
+		Size     int    `json:"size"`
+		MimeType string `json:"mimeType"`
+	}
+	type harRequest struct {
+		Method      string      `json:"method"`
+		URL         string      `json:"url"`
+		HTTPVersion string      `json:"httpVersion"`
+		Cookies     []nameValue `json:"cookies"`
+		Headers     []nameValue `json:"headers"`
+		QueryString []nameValue `json:"queryString"`
+		HeadersSize int         `json:"headersSize"`
+		BodySize    int         `json:"bodySize"`
+	}
+	type harResponse struct {
+		Status      int         `json:"status"`
+		StatusText  string      `json:"statusText"`
+		HTTPVersion string      `json:"httpVersion"`
+		Cookies     []nameValue `json:"cookies"`
+		Headers     []nameValue `json:"headers"`
+		Content     content     `json:"content"`
+		RedirectURL string      `json:"redirectURL"`
+		HeadersSize int         `json:"headersSize"`
+		BodySize    int         `json:"bodySize"`
+	}
+	type timings struct {
+		Send    float64 `json:"send"`
+		Wait    float64 `json:"wait"`
+		Receive float64 `json:"receive"`
+	}
+	type entry struct {
+		StartedDateTime string      `json:"startedDateTime"`
+		Time            float64     `json:"time"`
+		Request         harRequest  `json:"request"`
+		Response        harResponse `json:"response"`
+		Cache           struct{}    `json:"cache"`
+		Timings         timings     `json:"timings"`
+		Comment         string      `json:"comment,omitempty"`
+	}
+	type creator struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	}
+	type harLog struct {
+		Version string  `json:"version"`
+		Creator creator `json:"creator"`
+		Entries []entry `json:"entries"`
+	}
+
+	entries := make([]entry, len(calls))
+	for i, call := range calls {
+		ms := float64(call.Duration) / float64(time.Millisecond)
+		var headers []nameValue
+		for _, id := range call.RequestIDs {
+			headers = append(headers, nameValue{Name: "x-amzn-requestid", Value: id})
+		}
+		comment := fmt.Sprintf("%s %s, %d attempt(s)", call.Service, call.Operation, call.Attempts)
+		if call.ErrorCode != "" {
+			comment += ", error " + call.ErrorCode
+		}
+		entries[i] = entry{
+			StartedDateTime: call.Start.Format(time.RFC3339Nano),
+			Time:            ms,
+			Request: harRequest{
+				Method:      call.Method,
+				URL:         call.URL,
+				HTTPVersion: "HTTP/1.1",
+				Cookies:     []nameValue{},
+				Headers:     []nameValue{},
+				QueryString: []nameValue{},
+				HeadersSize: -1,
+				BodySize:    -1,
+			},
+			Response: harResponse{
+				Status:      call.StatusCode,
+				StatusText:  http.StatusText(call.StatusCode),
+				HTTPVersion: "HTTP/1.1",
+				Cookies:     []nameValue{},
+				Headers:     append([]nameValue{}, headers...),
+				Content:     content{MimeType: "application/octet-stream"},
+				HeadersSize: -1,
+				BodySize:    -1,
+			},
+			Timings: timings{Wait: ms},
+			Comment: comment,
+		}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Log harLog `json:"log"`
+	}{
+		Log: harLog{
+			Version: "1.2",
+			Creator: creator{Name: userAgentProductName, Version: version.Version},
+			Entries: entries,
+		},
+	})
+}
@@ -0,0 +1,155 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessions
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracer(t *testing.T) {
+	defer TraceCalls(nil)
+	buf := new(bytes.Buffer)
+	tracer := NewTracer(buf)
+	TraceCalls(tracer)
+	sess := &session.Session{
+		Config: aws.NewConfig().WithRegion("us-west-2"),
+	}
+	addTraceHandlers(sess)
+	newRequest := func(service, operation string) *request.Request {
+		return request.New(*sess.Config, metadata.ClientInfo{
+			ServiceName: service,
+			Endpoint:    "https://" + service + ".us-west-2.amazonaws.com",
+		}, sess.Handlers, nil, &request.Operation{Name: operation, HTTPMethod: "POST", HTTPPath: "/?Action=" + operation}, nil, nil)
+	}
+
+	// A throttled attempt followed by a successful one.
+	req := newRequest("ssm", "GetParameter")
+	req.Time = time.Now().Add(-time.Second)
+	req.RequestID = "1111"
+	req.Error = awserr.New("ThrottlingException", "Rate exceeded", nil)
+	req.Handlers.CompleteAttempt.Run(req)
+	req.RequestID, req.Error = "2222", nil
+	req.HTTPResponse = &http.Response{StatusCode: http.StatusOK}
+	req.Handlers.CompleteAttempt.Run(req)
+	req.Handlers.Complete.Run(req)
+
+	// A failed call.
+	failed := newRequest("ssm", "GetParameter")
+	failed.RequestID = "3333"
+	failed.Error = awserr.New("ParameterNotFound", "not found", nil)
+	failed.HTTPResponse = &http.Response{StatusCode: http.StatusBadRequest}
+	failed.Handlers.CompleteAttempt.Run(failed)
+	failed.Handlers.Complete.Run(failed)
+
+	// A request that was never sent isn't recorded.
+	invalid := newRequest("ecs", "DescribeServices")
+	invalid.Handlers.Complete.Run(invalid)
+
+	calls := tracer.Calls()
+	require.Len(t, calls, 2)
+	require.Equal(t, "ssm", calls[0].Service)
+	require.Equal(t, "GetParameter", calls[0].Operation)
+	require.Equal(t, "us-west-2", calls[0].Region)
+	require.Equal(t, "POST", calls[0].Method)
+	require.Equal(t, "https://ssm.us-west-2.amazonaws.com/", calls[0].URL, "the query string is left out")
+	require.Equal(t, 2, calls[0].Attempts)
+	require.Equal(t, 1, calls[0].Throttles)
+	require.Equal(t, []string{"1111", "2222"}, calls[0].RequestIDs)
+	require.Equal(t, http.StatusOK, calls[0].StatusCode)
+	require.GreaterOrEqual(t, calls[0].Duration, time.Second)
+	require.Equal(t, "ParameterNotFound", calls[1].ErrorCode)
+
+	// Each call is written as a line of JSON.
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	var written Call
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &written))
+	require.Equal(t, []string{"3333"}, written.RequestIDs)
+
+	// Calls are summarized by operation.
+	stats := Summarize(calls)
+	require.Len(t, stats, 1)
+	require.Equal(t, 2, stats[0].Calls)
+	require.Equal(t, 1, stats[0].Retries)
+	require.Equal(t, 1, stats[0].Throttles)
+	require.Equal(t, 1, stats[0].Errors)
+	require.Equal(t, calls[0].Duration, stats[0].Slowest)
+}
+
+func TestSummarize(t *testing.T) {
+	stats := Summarize([]Call{
+		{Service: "ssm", Operation: "GetParameter", Attempts: 1, Duration: 100 * time.Millisecond},
+		{Service: "cloudformation", Operation: "DescribeStacks", Attempts: 3, Throttles: 2, Duration: time.Second},
+		{Service: "ssm", Operation: "GetParameter", Attempts: 1, Duration: 300 * time.Millisecond, ErrorCode: "ParameterNotFound"},
+	})
+
+	require.Equal(t, []OperationStats{
+		{Service: "cloudformation", Operation: "DescribeStacks", Calls: 1, Retries: 2, Throttles: 2, Duration: time.Second, Slowest: time.Second},
+		{Service: "ssm", Operation: "GetParameter", Calls: 2, Errors: 1, Duration: 400 * time.Millisecond, Slowest: 300 * time.Millisecond},
+	}, stats)
+}
+
+func TestWriteHAR(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := WriteHAR(buf, []Call{
+		{
+			Service:    "ssm",
+			Operation:  "GetParameter",
+			Method:     "POST",
+			URL:        "https://ssm.us-west-2.amazonaws.com/",
+			Start:      time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC),
+			Duration:   1500 * time.Millisecond,
+			Attempts:   2,
+			RequestIDs: []string{"1111", "2222"},
+			StatusCode: http.StatusOK,
+		},
+	})
+	require.NoError(t, err)
+
+	var har struct {
+		Log struct {
+			Version string `json:"version"`
+			Entries []struct {
+				StartedDateTime string  `json:"startedDateTime"`
+				Time            float64 `json:"time"`
+				Request         struct {
+					Method string `json:"method"`
+					URL    string `json:"url"`
+				} `json:"request"`
+				Response struct {
+					Status  int `json:"status"`
+					Headers []struct {
+						Name  string `json:"name"`
+						Value string `json:"value"`
+					} `json:"headers"`
+				} `json:"response"`
+				Comment string `json:"comment"`
+			} `json:"entries"`
+		} `json:"log"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &har))
+	require.Equal(t, "1.2", har.Log.Version)
+	require.Len(t, har.Log.Entries, 1)
+	entry := har.Log.Entries[0]
+	require.Equal(t, "2023-08-01T00:00:00Z", entry.StartedDateTime)
+	require.Equal(t, 1500.0, entry.Time)
+	require.Equal(t, "POST", entry.Request.Method)
+	require.Equal(t, "https://ssm.us-west-2.amazonaws.com/", entry.Request.URL)
+	require.Equal(t, http.StatusOK, entry.Response.Status)
+	require.Len(t, entry.Response.Headers, 2)
+	require.Equal(t, "2222", entry.Response.Headers[1].Value)
+	require.Equal(t, "ssm GetParameter, 2 attempt(s)", entry.Comment)
+}
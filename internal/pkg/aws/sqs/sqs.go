@@ -0,0 +1,154 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sqs provides a client to make API requests to Amazon Simple Queue Service.
+package sqs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+const (
+	// maxReceivedMessages is the maximum number of messages that a ReceiveMessage call returns.
+	maxReceivedMessages = 10
+	// peekWaitSeconds is how long to wait for messages, so that the servers that hold the messages of small queues are polled.
+	peekWaitSeconds = 1
+)
+
+type api interface {
+	GetQueueAttributes(input *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error)
+	ReceiveMessage(input *sqs.ReceiveMessageInput) (*sqs.ReceiveMessageOutput, error)
+	StartMessageMoveTask(input *sqs.StartMessageMoveTaskInput) (*sqs.StartMessageMoveTaskOutput, error)
+}
+
+// SQS wraps an Amazon Simple Queue Service client.
+type SQS struct {
+	client api
+}
+
+// New returns a SQS client configured against the input session.
+func New(s *session.Session) *SQS {
+	return &SQS{
+		client: sqs.New(s),
+	}
+}
+
+// QueueAttributes holds the approximate number of messages in a queue and its redrive policy.
+type QueueAttributes struct {
+	ARN                 string
+	Messages            int64  // Messages available for retrieval.
+	MessagesInFlight    int64  // Messages received by a consumer but not yet deleted.
+	MessagesDelayed     int64  // Messages not yet available because of the delay of the queue.
+	DeadLetterTargetARN string // ARN of the dead-letter queue, empty if the queue has no redrive policy.
+	MaxReceiveCount     int    // Number of receives after which a message is moved to the dead-letter queue.
+}
+
+// QueueAttributes returns the attributes of the queue with the URL.
+func (s *SQS) QueueAttributes(url string) (*QueueAttributes, error) {
+	out, err := s.client.GetQueueAttributes(&sqs.GetQueueAttributesInput{
+		QueueUrl: aws.String(url),
+		AttributeNames: aws.StringSlice([]string{
+			sqs.QueueAttributeNameQueueArn,
+			sqs.QueueAttributeNameApproximateNumberOfMessages,
+			sqs.QueueAttributeNameApproximateNumberOfMessagesNotVisible,
+			sqs.QueueAttributeNameApproximateNumberOfMessagesDelayed,
+			sqs.QueueAttributeNameRedrivePolicy,
+		}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get attributes of queue %s: %w", url, err)
+	}
+	attrs := &QueueAttributes{
+		ARN: aws.StringValue(out.Attributes[sqs.QueueAttributeNameQueueArn]),
+	}
+	counts := map[string]*int64{
+		sqs.QueueAttributeNameApproximateNumberOfMessages:           &attrs.Messages,
+		sqs.QueueAttributeNameApproximateNumberOfMessagesNotVisible: &attrs.MessagesInFlight,
+		sqs.QueueAttributeNameApproximateNumberOfMessagesDelayed:    &attrs.MessagesDelayed,
+	}
+	for name, count := range counts {
+		value, ok := out.Attributes[name]
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseInt(aws.StringValue(value), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse attribute %s of queue %s: %w", name, url, err)
+		}
+		*count = n
+	}
+	if policy, ok := out.Attributes[sqs.QueueAttributeNameRedrivePolicy]; ok {
+		var redrive struct {
+			DeadLetterTargetARN string `json:"deadLetterTargetArn"`
+			MaxReceiveCount     int    `json:"maxReceiveCount"`
+		}
+		if err := json.Unmarshal([]byte(aws.StringValue(policy)), &redrive); err != nil {
+			return nil, fmt.Errorf("unmarshal redrive policy of queue %s: %w", url, err)
+		}
+		attrs.DeadLetterTargetARN, attrs.MaxReceiveCount = redrive.DeadLetterTargetARN, redrive.MaxReceiveCount
+	}
+	return attrs, nil
+}
+
+// Message is a message received from a queue.
+type Message struct {
+	ID           string    `json:"id"`
+	Body         string    `json:"body"`
+	SentAt       time.Time `json:"sentAt"`
+	ReceiveCount int       `json:"receiveCount"` // Number of times the message was received, including by Peek.
+}
+
+// Peek receives up to limit messages from the queue with the URL, without deleting them.
+// The messages can be received again by the consumers of the queue right away.
+func (s *SQS) Peek(url string, limit int) ([]Message, error) {
+	if limit > maxReceivedMessages {
+		limit = maxReceivedMessages
+	}
+	out, err := s.client.ReceiveMessage(&sqs.ReceiveMessageInput{
+		QueueUrl:            aws.String(url),
+		MaxNumberOfMessages: aws.Int64(int64(limit)),
+		VisibilityTimeout:   aws.Int64(0),
+		WaitTimeSeconds:     aws.Int64(peekWaitSeconds),
+		AttributeNames: aws.StringSlice([]string{
+			sqs.MessageSystemAttributeNameSentTimestamp,
+			sqs.MessageSystemAttributeNameApproximateReceiveCount,
+		}),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("receive messages from queue %s: %w", url, err)
+	}
+	messages := make([]Message, 0, len(out.Messages))
+	for _, msg := range out.Messages {
+		m := Message{
+			ID:   aws.StringValue(msg.MessageId),
+			Body: aws.StringValue(msg.Body),
+		}
+		if sent, err := strconv.ParseInt(aws.StringValue(msg.Attributes[sqs.MessageSystemAttributeNameSentTimestamp]), 10, 64); err == nil {
+			m.SentAt = time.UnixMilli(sent).UTC()
+		}
+		if count, err := strconv.Atoi(aws.StringValue(msg.Attributes[sqs.MessageSystemAttributeNameApproximateReceiveCount])); err == nil {
+			m.ReceiveCount = count
+		}
+		messages = append(messages, m)
+	}
+	return messages, nil
+}
+
+// Redrive starts moving the messages of the dead-letter queue with the ARN back to the queues they came from,
+// and returns the handle of the move task.
+func (s *SQS) Redrive(deadLetterQueueARN string) (string, error) {
+	out, err := s.client.StartMessageMoveTask(&sqs.StartMessageMoveTaskInput{
+		SourceArn: aws.String(deadLetterQueueARN),
+	})
+	if err != nil {
+		return "", fmt.Errorf("start moving the messages of queue %s: %w", deadLetterQueueARN, err)
+	}
+	return aws.StringValue(out.TaskHandle), nil
+}
@@ -0,0 +1,197 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package sqs
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQS_QueueAttributes(t *testing.T) {
+	const mockURL = "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-EventsQueue"
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      *QueueAttributes
+		wantedError error
+	}{
+		"return the message counts and the redrive policy": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetQueueAttributes(&sqs.GetQueueAttributesInput{
+					QueueUrl: aws.String(mockURL),
+					AttributeNames: aws.StringSlice([]string{
+						"QueueArn",
+						"ApproximateNumberOfMessages",
+						"ApproximateNumberOfMessagesNotVisible",
+						"ApproximateNumberOfMessagesDelayed",
+						"RedrivePolicy",
+					}),
+				}).Return(&sqs.GetQueueAttributesOutput{
+					Attributes: map[string]*string{
+						"QueueArn":                              aws.String("arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-EventsQueue"),
+						"ApproximateNumberOfMessages":           aws.String("42"),
+						"ApproximateNumberOfMessagesNotVisible": aws.String("3"),
+						"ApproximateNumberOfMessagesDelayed":    aws.String("0"),
+						"RedrivePolicy":                         aws.String(`{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-DeadLetterQueue","maxReceiveCount":5}`),
+					},
+				}, nil)
+			},
+			wanted: &QueueAttributes{
+				ARN:                 "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-EventsQueue",
+				Messages:            42,
+				MessagesInFlight:    3,
+				DeadLetterTargetARN: "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-DeadLetterQueue",
+				MaxReceiveCount:     5,
+			},
+		},
+		"return the message counts of a queue without redrive policy": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetQueueAttributes(gomock.Any()).Return(&sqs.GetQueueAttributesOutput{
+					Attributes: map[string]*string{
+						"QueueArn":                    aws.String("arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-DeadLetterQueue"),
+						"ApproximateNumberOfMessages": aws.String("7"),
+					},
+				}, nil)
+			},
+			wanted: &QueueAttributes{
+				ARN:      "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-DeadLetterQueue",
+				Messages: 7,
+			},
+		},
+		"error if a count is not a number": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetQueueAttributes(gomock.Any()).Return(&sqs.GetQueueAttributesOutput{
+					Attributes: map[string]*string{
+						"ApproximateNumberOfMessages": aws.String("many"),
+					},
+				}, nil)
+			},
+			wantedError: errors.New(`parse attribute ApproximateNumberOfMessages of queue ` + mockURL + `: strconv.ParseInt: parsing "many": invalid syntax`),
+		},
+		"wrap the error": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetQueueAttributes(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get attributes of queue " + mockURL + ": some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := SQS{client: m}
+
+			got, err := client.QueueAttributes(mockURL)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestSQS_Peek(t *testing.T) {
+	const mockURL = "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-DeadLetterQueue"
+	testCases := map[string]struct {
+		limit      int
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      []Message
+		wantedError error
+	}{
+		"return the messages without hiding them": {
+			limit: 20,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ReceiveMessage(&sqs.ReceiveMessageInput{
+					QueueUrl:            aws.String(mockURL),
+					MaxNumberOfMessages: aws.Int64(10),
+					VisibilityTimeout:   aws.Int64(0),
+					WaitTimeSeconds:     aws.Int64(1),
+					AttributeNames:      aws.StringSlice([]string{"SentTimestamp", "ApproximateReceiveCount"}),
+				}).Return(&sqs.ReceiveMessageOutput{
+					Messages: []*sqs.Message{
+						{
+							MessageId: aws.String("1"),
+							Body:      aws.String(`{"orderId":"42"}`),
+							Attributes: map[string]*string{
+								"SentTimestamp":           aws.String("1696500000000"),
+								"ApproximateReceiveCount": aws.String("6"),
+							},
+						},
+					},
+				}, nil)
+			},
+			wanted: []Message{
+				{ID: "1", Body: `{"orderId":"42"}`, SentAt: time.Date(2023, time.October, 5, 10, 0, 0, 0, time.UTC), ReceiveCount: 6},
+			},
+		},
+		"wrap the error": {
+			limit: 5,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ReceiveMessage(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("receive messages from queue " + mockURL + ": some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := SQS{client: m}
+
+			got, err := client.Peek(mockURL, tc.limit)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestSQS_Redrive(t *testing.T) {
+	const mockARN = "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-DeadLetterQueue"
+	t.Run("return the handle of the move task", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockapi(ctrl)
+		m.EXPECT().StartMessageMoveTask(&sqs.StartMessageMoveTaskInput{
+			SourceArn: aws.String(mockARN),
+		}).Return(&sqs.StartMessageMoveTaskOutput{TaskHandle: aws.String("handle")}, nil)
+		client := SQS{client: m}
+
+		got, err := client.Redrive(mockARN)
+
+		require.NoError(t, err)
+		require.Equal(t, "handle", got)
+	})
+	t.Run("wrap the error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := mocks.NewMockapi(ctrl)
+		m.EXPECT().StartMessageMoveTask(gomock.Any()).Return(nil, errors.New("some error"))
+		client := SQS{client: m}
+
+		_, err := client.Redrive(mockARN)
+
+		require.EqualError(t, err, "start moving the messages of queue "+mockARN+": some error")
+	})
+}
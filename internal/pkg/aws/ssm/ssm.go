@@ -8,32 +8,53 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
 )
 
 // Namespace represents the AWS Systems Manager(SSM) service namespace.
 const Namespace = "ssm"
 
+const (
+	portForwardingDocument         = "AWS-StartPortForwardingSession"
+	portForwardingToRemoteDocument = "AWS-StartPortForwardingSessionToRemoteHost"
+	portForwardingParamHost        = "host"
+	portForwardingParamPortNumber  = "portNumber"
+	portForwardingParamLocalPort   = "localPortNumber"
+)
+
 type api interface {
 	PutParameter(input *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
 	AddTagsToResource(input *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error)
 	GetParameter(input *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+	DescribeParameters(input *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error)
+	DeleteParameter(input *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+	StartSession(input *ssm.StartSessionInput) (*ssm.StartSessionOutput, error)
+}
+
+type portForwardingSessionStarter interface {
+	StartPortForwardingSession(ssmSess *ssm.StartSessionOutput, in *ssm.StartSessionInput) error
 }
 
 // SSM wraps an AWS SSM client.
 type SSM struct {
-	client api
+	client         api
+	newSessStarter func() portForwardingSessionStarter
 }
 
 // New returns a SSM service configured against the input session.
 func New(s *session.Session) *SSM {
 	return &SSM{
 		client: ssm.New(s),
+		newSessStarter: func() portForwardingSessionStarter {
+			return exec.NewSSMPluginCommand(s)
+		},
 	}
 }
 
@@ -78,6 +99,94 @@ func (s *SSM) GetSecretValue(name string) (string, error) {
 	return aws.StringValue(resp.Parameter.Value), nil
 }
 
+// Secret holds the metadata of a parameter, but not its value.
+type Secret struct {
+	Name         string
+	Type         string
+	Version      int64
+	LastModified time.Time
+}
+
+// ListSecrets returns the metadata of all the parameters that have the tags.
+func (s *SSM) ListSecrets(tags map[string]string) ([]Secret, error) {
+	var filters []*ssm.ParameterStringFilter
+	for _, tag := range convertTags(tags) {
+		filters = append(filters, &ssm.ParameterStringFilter{
+			Key:    aws.String(fmt.Sprintf("tag:%s", aws.StringValue(tag.Key))),
+			Option: aws.String("Equals"),
+			Values: []*string{tag.Value},
+		})
+	}
+
+	var secrets []Secret
+	var nextToken *string
+	for {
+		out, err := s.client.DescribeParameters(&ssm.DescribeParametersInput{
+			ParameterFilters: filters,
+			NextToken:        nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe parameters: %w", err)
+		}
+		for _, param := range out.Parameters {
+			secrets = append(secrets, Secret{
+				Name:         aws.StringValue(param.Name),
+				Type:         aws.StringValue(param.Type),
+				Version:      aws.Int64Value(param.Version),
+				LastModified: aws.TimeValue(param.LastModifiedDate),
+			})
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	return secrets, nil
+}
+
+// DeleteSecret deletes a parameter.
+func (s *SSM) DeleteSecret(name string) error {
+	if _, err := s.client.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(name),
+	}); err != nil {
+		return fmt.Errorf("delete parameter %s: %w", name, err)
+	}
+	return nil
+}
+
+// PortForwardInput holds the fields needed to forward a local port through a Session Manager target.
+type PortForwardInput struct {
+	Target     string // The Session Manager target, for example "ecs:<cluster>_<task id>_<container runtime id>".
+	LocalPort  string
+	RemotePort string
+	RemoteHost string // Optional. If set, traffic is forwarded to this host reachable from the target instead of the target itself.
+}
+
+// StartPortForwardingSession forwards traffic from a local port to a port on the target, or on a remote host reachable from the target.
+// The call blocks until the session is terminated.
+func (s *SSM) StartPortForwardingSession(in PortForwardInput) error {
+	req := &ssm.StartSessionInput{
+		DocumentName: aws.String(portForwardingDocument),
+		Parameters: map[string][]*string{
+			portForwardingParamPortNumber: aws.StringSlice([]string{in.RemotePort}),
+			portForwardingParamLocalPort:  aws.StringSlice([]string{in.LocalPort}),
+		},
+		Target: aws.String(in.Target),
+	}
+	if in.RemoteHost != "" {
+		req.DocumentName = aws.String(portForwardingToRemoteDocument)
+		req.Parameters[portForwardingParamHost] = aws.StringSlice([]string{in.RemoteHost})
+	}
+	resp, err := s.client.StartSession(req)
+	if err != nil {
+		return fmt.Errorf("start session to target %s: %w", in.Target, err)
+	}
+	if err := s.newSessStarter().StartPortForwardingSession(resp, req); err != nil {
+		return fmt.Errorf("start session %s using ssm plugin: %w", aws.StringValue(resp.SessionId), err)
+	}
+	return nil
+}
+
 func (s *SSM) createSecret(in PutSecretInput) (*PutSecretOutput, error) {
 	// Create a secret while adding the tags in a single call instead of separate calls to `PutParameter` and
 	// `AddTagsToResource` so that there won't be a case where the parameter is created while the tags are not added.
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
@@ -361,3 +362,231 @@ func TestSSM_PutSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSSM_ListSecrets(t *testing.T) {
+	mockTime := time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)
+	mockFilters := []*ssm.ParameterStringFilter{
+		{
+			Key:    aws.String("tag:copilot-application"),
+			Option: aws.String("Equals"),
+			Values: aws.StringSlice([]string{"myapp"}),
+		},
+		{
+			Key:    aws.String("tag:copilot-environment"),
+			Option: aws.String("Equals"),
+			Values: aws.StringSlice([]string{"myenv"}),
+		},
+	}
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wantedOut   []Secret
+		wantedError error
+	}{
+		"return the parameters of all pages": {
+			mockClient: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().DescribeParameters(&ssm.DescribeParametersInput{
+						ParameterFilters: mockFilters,
+					}).Return(&ssm.DescribeParametersOutput{
+						Parameters: []*ssm.ParameterMetadata{
+							{
+								Name:             aws.String("/copilot/myapp/myenv/secrets/db-password"),
+								Type:             aws.String("SecureString"),
+								Version:          aws.Int64(2),
+								LastModifiedDate: aws.Time(mockTime),
+							},
+						},
+						NextToken: aws.String("next"),
+					}, nil),
+					m.EXPECT().DescribeParameters(&ssm.DescribeParametersInput{
+						ParameterFilters: mockFilters,
+						NextToken:        aws.String("next"),
+					}).Return(&ssm.DescribeParametersOutput{
+						Parameters: []*ssm.ParameterMetadata{
+							{
+								Name:             aws.String("/copilot/myapp/myenv/secrets/api-key"),
+								Type:             aws.String("SecureString"),
+								Version:          aws.Int64(1),
+								LastModifiedDate: aws.Time(mockTime),
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedOut: []Secret{
+				{
+					Name:         "/copilot/myapp/myenv/secrets/db-password",
+					Type:         "SecureString",
+					Version:      2,
+					LastModified: mockTime,
+				},
+				{
+					Name:         "/copilot/myapp/myenv/secrets/api-key",
+					Type:         "SecureString",
+					Version:      1,
+					LastModified: mockTime,
+				},
+			},
+		},
+		"fail to describe parameters": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeParameters(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe parameters: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			client := SSM{
+				client: mockSSMClient,
+			}
+			tc.mockClient(mockSSMClient)
+
+			got, err := client.ListSecrets(map[string]string{
+				deploy.EnvTagKey: "myenv",
+				deploy.AppTagKey: "myapp",
+			})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOut, got)
+			}
+		})
+	}
+}
+
+func TestSSM_DeleteSecret(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wantedError error
+	}{
+		"delete the parameter": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(&ssm.DeleteParameterInput{
+					Name: aws.String("/copilot/myapp/myenv/secrets/db-password"),
+				}).Return(&ssm.DeleteParameterOutput{}, nil)
+			},
+		},
+		"fail to delete the parameter": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("delete parameter /copilot/myapp/myenv/secrets/db-password: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			client := SSM{
+				client: mockSSMClient,
+			}
+			tc.mockClient(mockSSMClient)
+
+			err := client.DeleteSecret("/copilot/myapp/myenv/secrets/db-password")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type mockPortForwardingSessionStarter struct {
+	err error
+}
+
+func (m *mockPortForwardingSessionStarter) StartPortForwardingSession(_ *ssm.StartSessionOutput, _ *ssm.StartSessionInput) error {
+	return m.err
+}
+
+func TestSSM_StartPortForwardingSession(t *testing.T) {
+	testCases := map[string]struct {
+		in          PortForwardInput
+		mockClient  func(*mocks.Mockapi)
+		starterErr  error
+		wantedError error
+	}{
+		"fail to start the session": {
+			in: PortForwardInput{Target: "ecs:cluster_task_runtime", LocalPort: "8080", RemotePort: "80"},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("start session to target ecs:cluster_task_runtime: some error"),
+		},
+		"fail to start the ssm plugin": {
+			in: PortForwardInput{Target: "ecs:cluster_task_runtime", LocalPort: "8080", RemotePort: "80"},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(gomock.Any()).Return(&ssm.StartSessionOutput{SessionId: aws.String("sess")}, nil)
+			},
+			starterErr:  errors.New("some error"),
+			wantedError: errors.New("start session sess using ssm plugin: some error"),
+		},
+		"forward to a port on the target": {
+			in: PortForwardInput{Target: "ecs:cluster_task_runtime", LocalPort: "8080", RemotePort: "80"},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(&ssm.StartSessionInput{
+					DocumentName: aws.String("AWS-StartPortForwardingSession"),
+					Parameters: map[string][]*string{
+						"portNumber":      aws.StringSlice([]string{"80"}),
+						"localPortNumber": aws.StringSlice([]string{"8080"}),
+					},
+					Target: aws.String("ecs:cluster_task_runtime"),
+				}).Return(&ssm.StartSessionOutput{SessionId: aws.String("sess")}, nil)
+			},
+		},
+		"forward to a remote host": {
+			in: PortForwardInput{Target: "ecs:cluster_task_runtime", LocalPort: "5432", RemotePort: "5432", RemoteHost: "db.cluster.us-west-2.rds.amazonaws.com"},
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(&ssm.StartSessionInput{
+					DocumentName: aws.String("AWS-StartPortForwardingSessionToRemoteHost"),
+					Parameters: map[string][]*string{
+						"host":            aws.StringSlice([]string{"db.cluster.us-west-2.rds.amazonaws.com"}),
+						"portNumber":      aws.StringSlice([]string{"5432"}),
+						"localPortNumber": aws.StringSlice([]string{"5432"}),
+					},
+					Target: aws.String("ecs:cluster_task_runtime"),
+				}).Return(&ssm.StartSessionOutput{SessionId: aws.String("sess")}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			tc.mockClient(mockSSMClient)
+			starter := &mockPortForwardingSessionStarter{err: tc.starterErr}
+			client := SSM{
+				client: mockSSMClient,
+				newSessStarter: func() portForwardingSessionStarter {
+					return starter
+				},
+			}
+
+			err := client.StartPortForwardingSession(tc.in)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package stepfunctions
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -15,6 +16,23 @@ import (
 type api interface {
 	DescribeStateMachine(input *sfn.DescribeStateMachineInput) (*sfn.DescribeStateMachineOutput, error)
 	StartExecution(input *sfn.StartExecutionInput) (*sfn.StartExecutionOutput, error)
+	ListExecutions(input *sfn.ListExecutionsInput) (*sfn.ListExecutionsOutput, error)
+	GetExecutionHistory(input *sfn.GetExecutionHistoryInput) (*sfn.GetExecutionHistoryOutput, error)
+}
+
+// Execution holds the description of a state machine execution.
+type Execution struct {
+	ARN       string
+	Name      string
+	Status    string
+	StartDate time.Time
+	StopDate  *time.Time // Nil if the execution is still running.
+}
+
+// TaskResult holds the result of an attempt to run a task state of an execution.
+type TaskResult struct {
+	Succeeded bool
+	Output    string // Output of a succeeded task, or the cause of a failed task.
 }
 
 // StepFunctions wraps an AWS StepFunctions client.
@@ -51,3 +69,94 @@ func (s *StepFunctions) Execute(arn string) error {
 	}
 	return nil
 }
+
+// Executions returns the most recent executions of a state machine, up to maxResults.
+func (s *StepFunctions) Executions(stateMachineARN string, maxResults int) ([]Execution, error) {
+	var executions []Execution
+	var nextToken *string
+	for {
+		out, err := s.client.ListExecutions(&sfn.ListExecutionsInput{
+			StateMachineArn: aws.String(stateMachineARN),
+			MaxResults:      aws.Int64(int64(maxResults - len(executions))),
+			NextToken:       nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list executions of state machine %s: %w", stateMachineARN, err)
+		}
+		for _, execution := range out.Executions {
+			executions = append(executions, Execution{
+				ARN:       aws.StringValue(execution.ExecutionArn),
+				Name:      aws.StringValue(execution.Name),
+				Status:    aws.StringValue(execution.Status),
+				StartDate: aws.TimeValue(execution.StartDate),
+				StopDate:  execution.StopDate,
+			})
+		}
+		if out.NextToken == nil || len(executions) >= maxResults {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	return executions, nil
+}
+
+// TaskResults returns the results of the task attempts of an execution in chronological order.
+func (s *StepFunctions) TaskResults(executionARN string) ([]TaskResult, error) {
+	var results []TaskResult
+	err := s.forEachHistoryEvent(executionARN, func(event *sfn.HistoryEvent) {
+		switch {
+		case event.TaskSucceededEventDetails != nil:
+			results = append(results, TaskResult{
+				Succeeded: true,
+				Output:    aws.StringValue(event.TaskSucceededEventDetails.Output),
+			})
+		case event.TaskFailedEventDetails != nil:
+			results = append(results, TaskResult{
+				Output: aws.StringValue(event.TaskFailedEventDetails.Cause),
+			})
+		case event.TaskTimedOutEventDetails != nil:
+			results = append(results, TaskResult{
+				Output: aws.StringValue(event.TaskTimedOutEventDetails.Cause),
+			})
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// SubmittedTasks returns the outputs of the task attempts of an execution that were started, in chronological order.
+// Unlike TaskResults, it includes the attempt that is still running.
+func (s *StepFunctions) SubmittedTasks(executionARN string) ([]string, error) {
+	var outputs []string
+	err := s.forEachHistoryEvent(executionARN, func(event *sfn.HistoryEvent) {
+		if event.TaskSubmittedEventDetails != nil {
+			outputs = append(outputs, aws.StringValue(event.TaskSubmittedEventDetails.Output))
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return outputs, nil
+}
+
+func (s *StepFunctions) forEachHistoryEvent(executionARN string, fn func(event *sfn.HistoryEvent)) error {
+	var nextToken *string
+	for {
+		out, err := s.client.GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+			ExecutionArn: aws.String(executionARN),
+			NextToken:    nextToken,
+		})
+		if err != nil {
+			return fmt.Errorf("get history of execution %s: %w", executionARN, err)
+		}
+		for _, event := range out.Events {
+			fn(event)
+		}
+		if out.NextToken == nil {
+			return nil
+		}
+		nextToken = out.NextToken
+	}
+}
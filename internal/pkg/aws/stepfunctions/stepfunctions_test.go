@@ -119,3 +119,229 @@ func TestStepFunctions_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestStepFunctions_Executions(t *testing.T) {
+	mockStart := time.Date(2023, time.October, 15, 9, 0, 0, 0, time.UTC)
+	mockStop := time.Date(2023, time.October, 15, 9, 5, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inMaxResults int
+		setupMocks   func(m *mocks.Mockapi)
+
+		wanted      []Execution
+		wantedError error
+	}{
+		"return the executions across pages up to the max results": {
+			inMaxResults: 2,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ListExecutions(&sfn.ListExecutionsInput{
+					StateMachineArn: aws.String("arn:aws:states:us-west-2:123456789012:stateMachine:report"),
+					MaxResults:      aws.Int64(2),
+				}).Return(&sfn.ListExecutionsOutput{
+					Executions: []*sfn.ExecutionListItem{
+						{
+							ExecutionArn: aws.String("arn:aws:states:us-west-2:123456789012:execution:report:running"),
+							Name:         aws.String("running"),
+							Status:       aws.String("RUNNING"),
+							StartDate:    aws.Time(mockStart),
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().ListExecutions(&sfn.ListExecutionsInput{
+					StateMachineArn: aws.String("arn:aws:states:us-west-2:123456789012:stateMachine:report"),
+					MaxResults:      aws.Int64(1),
+					NextToken:       aws.String("next"),
+				}).Return(&sfn.ListExecutionsOutput{
+					Executions: []*sfn.ExecutionListItem{
+						{
+							ExecutionArn: aws.String("arn:aws:states:us-west-2:123456789012:execution:report:failed"),
+							Name:         aws.String("failed"),
+							Status:       aws.String("FAILED"),
+							StartDate:    aws.Time(mockStart),
+							StopDate:     aws.Time(mockStop),
+						},
+					},
+					NextToken: aws.String("more"),
+				}, nil)
+			},
+			wanted: []Execution{
+				{
+					ARN:       "arn:aws:states:us-west-2:123456789012:execution:report:running",
+					Name:      "running",
+					Status:    "RUNNING",
+					StartDate: mockStart,
+				},
+				{
+					ARN:       "arn:aws:states:us-west-2:123456789012:execution:report:failed",
+					Name:      "failed",
+					Status:    "FAILED",
+					StartDate: mockStart,
+					StopDate:  aws.Time(mockStop),
+				},
+			},
+		},
+		"wrap the error": {
+			inMaxResults: 10,
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().ListExecutions(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list executions of state machine arn:aws:states:us-west-2:123456789012:stateMachine:report: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := StepFunctions{client: m}
+
+			got, err := client.Executions("arn:aws:states:us-west-2:123456789012:stateMachine:report", tc.inMaxResults)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestStepFunctions_TaskResults(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      []TaskResult
+		wantedError error
+	}{
+		"return the results of the task attempts across pages": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+					ExecutionArn: aws.String("arn:aws:states:us-west-2:123456789012:execution:report:1"),
+				}).Return(&sfn.GetExecutionHistoryOutput{
+					Events: []*sfn.HistoryEvent{
+						{Type: aws.String("TaskScheduled")},
+						{
+							Type:                   aws.String("TaskFailed"),
+							TaskFailedEventDetails: &sfn.TaskFailedEventDetails{Cause: aws.String(`{"TaskArn":"task-1"}`)},
+						},
+						{
+							Type:                     aws.String("TaskTimedOut"),
+							TaskTimedOutEventDetails: &sfn.TaskTimedOutEventDetails{Cause: aws.String("timed out")},
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+					ExecutionArn: aws.String("arn:aws:states:us-west-2:123456789012:execution:report:1"),
+					NextToken:    aws.String("next"),
+				}).Return(&sfn.GetExecutionHistoryOutput{
+					Events: []*sfn.HistoryEvent{
+						{
+							Type:                      aws.String("TaskSucceeded"),
+							TaskSucceededEventDetails: &sfn.TaskSucceededEventDetails{Output: aws.String(`{"TaskArn":"task-3"}`)},
+						},
+					},
+				}, nil)
+			},
+			wanted: []TaskResult{
+				{Output: `{"TaskArn":"task-1"}`},
+				{Output: "timed out"},
+				{Succeeded: true, Output: `{"TaskArn":"task-3"}`},
+			},
+		},
+		"wrap the error": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get history of execution arn:aws:states:us-west-2:123456789012:execution:report:1: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := StepFunctions{client: m}
+
+			got, err := client.TaskResults("arn:aws:states:us-west-2:123456789012:execution:report:1")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestStepFunctions_SubmittedTasks(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mockapi)
+
+		wanted      []string
+		wantedError error
+	}{
+		"return the outputs of the submitted tasks, including the running one": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+					ExecutionArn: aws.String("arn:aws:states:us-west-2:123456789012:execution:report:1"),
+				}).Return(&sfn.GetExecutionHistoryOutput{
+					Events: []*sfn.HistoryEvent{
+						{
+							Type:                      aws.String("TaskSubmitted"),
+							TaskSubmittedEventDetails: &sfn.TaskSubmittedEventDetails{Output: aws.String(`{"TaskArn":"task-1"}`)},
+						},
+						{
+							Type:                   aws.String("TaskFailed"),
+							TaskFailedEventDetails: &sfn.TaskFailedEventDetails{Cause: aws.String(`{"TaskArn":"task-1"}`)},
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+					ExecutionArn: aws.String("arn:aws:states:us-west-2:123456789012:execution:report:1"),
+					NextToken:    aws.String("next"),
+				}).Return(&sfn.GetExecutionHistoryOutput{
+					Events: []*sfn.HistoryEvent{
+						{
+							Type:                      aws.String("TaskSubmitted"),
+							TaskSubmittedEventDetails: &sfn.TaskSubmittedEventDetails{Output: aws.String(`{"TaskArn":"task-2"}`)},
+						},
+					},
+				}, nil)
+			},
+			wanted: []string{`{"TaskArn":"task-1"}`, `{"TaskArn":"task-2"}`},
+		},
+		"wrap the error": {
+			setupMocks: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get history of execution arn:aws:states:us-west-2:123456789012:execution:report:1: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.setupMocks(m)
+			client := StepFunctions{client: m}
+
+			got, err := client.SubmittedTasks("arn:aws:states:us-west-2:123456789012:execution:report:1")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
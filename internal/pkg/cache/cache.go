@@ -0,0 +1,181 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cache keeps the results of slow lookups in AWS in the user's cache directory until they expire,
+// so that commands run one after another, like prompts and their completions, don't repeat them.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/spf13/afero"
+)
+
+const dirName = "metadata"
+
+// Cache is a directory of entries that expire.
+// A nil Cache holds no entry, so that every lookup goes to AWS.
+type Cache struct {
+	fs  afero.Fs
+	dir string
+	now func() time.Time
+}
+
+// entry is the content of the file of a cached value.
+type entry struct {
+	Key     string          `json:"key"`
+	Expires time.Time       `json:"expires"`
+	Value   json.RawMessage `json:"value"`
+}
+
+// New returns the cache in the user's cache directory, such as $XDG_CACHE_HOME/copilot/metadata on Linux.
+// Returns nil if the user has no cache directory.
+func New() *Cache {
+	dir, err := Dir()
+	if err != nil {
+		return nil
+	}
+	return &Cache{
+		fs:  afero.NewOsFs(),
+		dir: dir,
+		now: time.Now,
+	}
+}
+
+// Dir returns the directory of the cache.
+func Dir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "copilot", dirName), nil
+}
+
+// Scope returns a key that separates the entries looked up with the credentials in a region from the ones of other
+// credentials, so that switching profiles never returns the resources of another account.
+// Returns an empty string if the credentials can't be retrieved, in which case nothing should be cached.
+func Scope(creds *credentials.Credentials, region string) string {
+	if creds == nil {
+		return ""
+	}
+	value, err := creds.Get()
+	if err != nil || value.AccessKeyID == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(value.AccessKeyID))
+	return hex.EncodeToString(sum[:8]) + "/" + region
+}
+
+// Get unmarshals the value of the key into v, and returns false if the key has no value or its value expired.
+func (c *Cache) Get(key string, v any) bool {
+	if c == nil {
+		return false
+	}
+	e, err := c.read(c.path(key))
+	if err != nil || e.Key != key || !c.now().Before(e.Expires) {
+		return false
+	}
+	return json.Unmarshal(e.Value, v) == nil
+}
+
+// Set stores the value of the key until the ttl elapses.
+func (c *Cache) Set(key string, v any, ttl time.Duration) error {
+	if c == nil {
+		return nil
+	}
+	value, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(entry{
+		Key:     key,
+		Expires: c.now().Add(ttl),
+		Value:   value,
+	})
+	if err != nil {
+		return err
+	}
+	if err := c.fs.MkdirAll(c.dir, 0700); err != nil {
+		return err
+	}
+	// Entries can hold credentials like registry passwords, so they're only readable by the user.
+	return afero.WriteFile(c.fs, c.path(key), data, 0600)
+}
+
+// Invalidate removes the entries whose key starts with the prefix, and the entries that expired.
+func (c *Cache) Invalidate(prefix string) error {
+	if c == nil {
+		return nil
+	}
+	files, err := afero.ReadDir(c.fs, c.dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	for _, file := range files {
+		path := filepath.Join(c.dir, file.Name())
+		e, err := c.read(path)
+		if err == nil && !strings.HasPrefix(e.Key, prefix) && c.now().Before(e.Expires) {
+			continue
+		}
+		if err := c.fs.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Clear removes every entry.
+func (c *Cache) Clear() error {
+	if c == nil {
+		return nil
+	}
+	return c.fs.RemoveAll(c.dir)
+}
+
+func (c *Cache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:16])+".json")
+}
+
+func (c *Cache) read(path string) (entry, error) {
+	var e entry
+	data, err := afero.ReadFile(c.fs, path)
+	if err != nil {
+		return e, err
+	}
+	err = json.Unmarshal(data, &e)
+	return e, err
+}
+
+// Fetch returns the cached value of the key, or calls fetch and caches its result for the ttl if the key has no value.
+// Errors are never cached, and failing to write the cache doesn't fail the lookup.
+func Fetch[T any](c *Cache, key string, ttl time.Duration, fetch func() (T, error)) (T, error) {
+	var v T
+	if c.Get(key, &v) {
+		return v, nil
+	}
+	v, err := fetch()
+	if err != nil {
+		return v, err
+	}
+	_ = c.Set(key, v, ttl)
+	return v, nil
+}
+
+// EnvPrefix returns the prefix of the keys of the entries about an environment,
+// which are invalidated when the environment is deployed.
+func EnvPrefix(accountID, region, app, env string) string {
+	return "env/" + accountID + "/" + region + "/" + app + "/" + env + "/"
+}
@@ -0,0 +1,138 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func newTestCache() (*Cache, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)}
+	return &Cache{fs: afero.NewMemMapFs(), dir: "/cache/copilot/metadata", now: clock.Now}, clock
+}
+
+func TestCache_GetSet(t *testing.T) {
+	t.Run("returns the value until it expires", func(t *testing.T) {
+		c, clock := newTestCache()
+		require.NoError(t, c.Set("store/scope/get/app", map[string]string{"name": "phonetool"}, time.Minute))
+
+		var got map[string]string
+		require.True(t, c.Get("store/scope/get/app", &got))
+		require.Equal(t, map[string]string{"name": "phonetool"}, got)
+
+		clock.now = clock.now.Add(time.Minute)
+		require.False(t, c.Get("store/scope/get/app", &got))
+	})
+	t.Run("returns nothing for a key that was never set", func(t *testing.T) {
+		c, _ := newTestCache()
+
+		var got string
+		require.False(t, c.Get("missing", &got))
+	})
+	t.Run("returns nothing if the entry is corrupted", func(t *testing.T) {
+		c, _ := newTestCache()
+		require.NoError(t, c.Set("key", "value", time.Minute))
+		require.NoError(t, afero.WriteFile(c.fs, c.path("key"), []byte("{"), 0600))
+
+		var got string
+		require.False(t, c.Get("key", &got))
+	})
+	t.Run("a nil cache holds nothing", func(t *testing.T) {
+		var c *Cache
+		require.NoError(t, c.Set("key", "value", time.Minute))
+
+		var got string
+		require.False(t, c.Get("key", &got))
+		require.NoError(t, c.Invalidate(""))
+		require.NoError(t, c.Clear())
+	})
+}
+
+func TestCache_Invalidate(t *testing.T) {
+	c, clock := newTestCache()
+	require.NoError(t, c.Set("store/a/get/app", "a", time.Hour))
+	require.NoError(t, c.Set("store/b/get/app", "b", time.Hour))
+	require.NoError(t, c.Set("route53/b/hostedzone/example.com", "Z1", time.Minute))
+	clock.now = clock.now.Add(time.Minute)
+
+	require.NoError(t, c.Invalidate("store/a/"))
+
+	files, err := afero.ReadDir(c.fs, c.dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1, "the entries of the prefix and the expired ones are removed")
+	var got string
+	require.True(t, c.Get("store/b/get/app", &got))
+	require.Equal(t, "b", got)
+}
+
+func TestCache_Clear(t *testing.T) {
+	c, _ := newTestCache()
+	require.NoError(t, c.Set("key", "value", time.Hour))
+
+	require.NoError(t, c.Clear())
+
+	exists, err := afero.DirExists(c.fs, c.dir)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
+
+func TestFetch(t *testing.T) {
+	t.Run("caches the fetched value", func(t *testing.T) {
+		c, _ := newTestCache()
+		calls := 0
+		fetch := func() ([]string, error) {
+			calls++
+			return []string{"test", "prod"}, nil
+		}
+
+		for i := 0; i < 2; i++ {
+			got, err := Fetch(c, "envs", time.Minute, fetch)
+			require.NoError(t, err)
+			require.Equal(t, []string{"test", "prod"}, got)
+		}
+		require.Equal(t, 1, calls)
+	})
+	t.Run("doesn't cache errors", func(t *testing.T) {
+		c, _ := newTestCache()
+		calls := 0
+		fetch := func() (string, error) {
+			calls++
+			return "", errors.New("some error")
+		}
+
+		for i := 0; i < 2; i++ {
+			_, err := Fetch(c, "key", time.Minute, fetch)
+			require.EqualError(t, err, "some error")
+		}
+		require.Equal(t, 2, calls)
+	})
+}
+
+func TestScope(t *testing.T) {
+	t.Run("separates credentials and regions", func(t *testing.T) {
+		a := Scope(credentials.NewStaticCredentials("AKIAA", "secret", ""), "us-west-2")
+		b := Scope(credentials.NewStaticCredentials("AKIAB", "secret", ""), "us-west-2")
+
+		require.NotEmpty(t, a)
+		require.NotEqual(t, a, b)
+		require.NotEqual(t, a, Scope(credentials.NewStaticCredentials("AKIAA", "secret", ""), "us-east-1"))
+		require.NotContains(t, a, "AKIAA")
+	})
+	t.Run("is empty without credentials", func(t *testing.T) {
+		require.Empty(t, Scope(credentials.AnonymousCredentials, "us-west-2"))
+		require.Empty(t, Scope(nil, "us-west-2"))
+	})
+}
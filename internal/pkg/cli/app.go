@@ -24,7 +24,15 @@ Applications are a collection of services and environments.`,
 	cmd.AddCommand(buildAppListCommand())
 	cmd.AddCommand(buildAppShowCmd())
 	cmd.AddCommand(buildAppDeleteCommand())
+	cmd.AddCommand(buildAppGCCmd())
 	cmd.AddCommand(buildAppUpgradeCmd())
+	cmd.AddCommand(buildAppUpdateTagsCmd())
+	cmd.AddCommand(buildAppMigrateConfigCmd())
+	cmd.AddCommand(buildAppLocksCmd())
+	cmd.AddCommand(buildAppDeployPolicyCmd())
+	cmd.AddCommand(buildAppResourceNamesCmd())
+	cmd.AddCommand(buildAppDriftCmd())
+	cmd.AddCommand(buildAppGraphCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
@@ -52,6 +53,8 @@ var (
 type deleteAppVars struct {
 	name             string
 	skipConfirmation bool
+	dryRun           bool
+	retain           []string
 }
 
 type deleteAppOpts struct {
@@ -63,6 +66,7 @@ type deleteAppOpts struct {
 	cfn                    deployer
 	prompt                 prompter
 	pipelineLister         deployedPipelineLister
+	locks                  stackLockManager
 	sel                    appSelector
 	s3                     func(session *session.Session) bucketEmptier
 	svcDeleteExecutor      func(svcName string) (executor, error)
@@ -71,6 +75,7 @@ type deleteAppOpts struct {
 	taskDeleteExecutor     func(envName, taskName string) (executor, error)
 	pipelineDeleteExecutor func(pipelineName string) (executor, error)
 	existingWorkSpace      func() (wsAppManagerDeleter, error)
+	planWriter             io.Writer
 }
 
 func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
@@ -92,12 +97,15 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 			return s3.New(session)
 		},
 		pipelineLister: deploy.NewPipelineStore(rg.New(defaultSession)),
+		locks:          newStackLocker(defaultSession, false, 0),
 		sel:            selector.NewAppEnvSelector(prompter, store),
 		svcDeleteExecutor: func(svcName string) (executor, error) {
 			opts, err := newDeleteSvcOpts(deleteSvcVars{
 				skipConfirmation: true, // always skip sub-confirmations
 				name:             svcName,
 				appName:          vars.name,
+				dryRun:           vars.dryRun,
+				retain:           vars.retain,
 			})
 			if err != nil {
 				return nil, err
@@ -120,6 +128,8 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 				skipConfirmation: true,
 				appName:          vars.name,
 				name:             envName,
+				dryRun:           vars.dryRun,
+				retain:           vars.retain,
 			})
 			if err != nil {
 				return nil, err
@@ -153,12 +163,13 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 		existingWorkSpace: func() (wsAppManagerDeleter, error) {
 			return workspace.Use(afero.NewOsFs())
 		},
+		planWriter: os.Stdout,
 	}, nil
 }
 
-// Validate is a no-op for this command.
+// Validate returns an error if the values passed by flags are invalid.
 func (o *deleteAppOpts) Validate() error {
-	return nil
+	return validateRetainedResourceClasses(o.retain)
 }
 
 // Ask prompts the user for any required flags that they didn't provide.
@@ -166,7 +177,7 @@ func (o *deleteAppOpts) Ask() error {
 	if err := o.validateOrAskAppName(); err != nil {
 		return err
 	}
-	if o.skipConfirmation {
+	if o.skipConfirmation || o.dryRun {
 		return nil
 	}
 
@@ -187,7 +198,12 @@ func (o *deleteAppOpts) Ask() error {
 // Execute deletes the application.
 // It removes all the services from each environment, the environments, the pipeline S3 buckets,
 // the pipeline, the application, removes the variables from the config store, and deletes the local workspace.
+// With a dry run, Execute only writes the plan of the deletion followed by the plans of each service and environment.
 func (o *deleteAppOpts) Execute() error {
+	if o.dryRun {
+		return o.showPlan()
+	}
+
 	if err := o.deleteSvcs(); err != nil {
 		return err
 	}
@@ -214,6 +230,10 @@ func (o *deleteAppOpts) Execute() error {
 		return err
 	}
 
+	if err := o.deleteLocks(); err != nil {
+		return err
+	}
+
 	if err := o.deleteAppConfigs(); err != nil {
 		return err
 	}
@@ -225,6 +245,88 @@ func (o *deleteAppOpts) Execute() error {
 	return nil
 }
 
+func (o *deleteAppOpts) showPlan() error {
+	plan := &deletePlan{
+		title: fmt.Sprintf("Plan to delete application %s:", o.name),
+	}
+	svcs, err := o.store.ListServices(o.name)
+	if err != nil {
+		return fmt.Errorf("list services for application %s: %w", o.name, err)
+	}
+	for _, svc := range svcs {
+		plan.add(fmt.Sprintf("Delete service %s.", svc.Name))
+	}
+	jobs, err := o.store.ListJobs(o.name)
+	if err != nil {
+		return fmt.Errorf("list jobs for application %s: %w", o.name, err)
+	}
+	for _, job := range jobs {
+		plan.add(fmt.Sprintf("Delete job %s from every environment.", job.Name))
+	}
+	envs, err := o.store.ListEnvironments(o.name)
+	if err != nil {
+		return fmt.Errorf("list environments for application %s: %w", o.name, err)
+	}
+	for _, env := range envs {
+		tasks, err := o.cfn.ListTaskStacks(o.name, env.Name)
+		if err != nil {
+			return err
+		}
+		for _, task := range tasks {
+			plan.add(fmt.Sprintf("Delete task %s in environment %s.", task.TaskName(), env.Name))
+		}
+		plan.add(fmt.Sprintf("Delete environment %s.", env.Name))
+	}
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	appResources, err := o.cfn.GetRegionalAppResources(app)
+	if err != nil {
+		return fmt.Errorf("get regional application resources for %s: %w", app.Name, err)
+	}
+	for _, resource := range appResources {
+		// Pipeline buckets are always emptied since the application stack set can't delete them otherwise.
+		plan.add(fmt.Sprintf("Empty S3 bucket %s in region %s.", resource.S3Bucket, resource.Region))
+	}
+	pipelines, err := o.pipelineLister.ListDeployedPipelines(o.name)
+	if err != nil {
+		return fmt.Errorf("list pipelines for application %s: %w", o.name, err)
+	}
+	for _, pipeline := range pipelines {
+		plan.add(fmt.Sprintf("Delete pipeline %s.", pipeline.Name))
+	}
+	plan.add("Delete the application stack set and roles.")
+	plan.add("Release the stack locks of the application.")
+	plan.add(fmt.Sprintf("Delete application %s from the config store.", o.name))
+	if err := plan.render(o.planWriter); err != nil {
+		return err
+	}
+
+	for _, svc := range svcs {
+		cmd, err := o.svcDeleteExecutor(svc.Name)
+		if err != nil {
+			return err
+		}
+		if err := cmd.Execute(); err != nil {
+			return fmt.Errorf("show plan of svc delete: %w", err)
+		}
+	}
+	for _, env := range envs {
+		cmd, err := o.envDeleteExecutor(env.Name)
+		if err != nil {
+			return err
+		}
+		if err := cmd.Ask(); err != nil {
+			return fmt.Errorf("ask env delete: %w", err)
+		}
+		if err := cmd.Execute(); err != nil {
+			return fmt.Errorf("show plan of env delete: %w", err)
+		}
+	}
+	return nil
+}
+
 func (o *deleteAppOpts) validateOrAskAppName() error {
 	if o.name != "" {
 		_, err := o.store.GetApplication(o.name)
@@ -362,6 +464,21 @@ func (o *deleteAppOpts) deleteAppResources() error {
 	return nil
 }
 
+// deleteLocks releases the locks left on the stacks of the application, so that they don't lock
+// the stacks of an application created later with the same name.
+func (o *deleteAppOpts) deleteLocks() error {
+	locks, err := o.locks.List(o.name)
+	if err != nil {
+		return err
+	}
+	for _, l := range locks {
+		if err := o.locks.Release(o.name, l.Stack); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (o *deleteAppOpts) deleteAppConfigs() error {
 	o.spinner.Start(deleteAppConfigStartMsg)
 	if err := o.store.DeleteApplication(o.name); err != nil {
@@ -399,7 +516,13 @@ func buildAppDeleteCommand() *cobra.Command {
 		Short: "Delete all resources associated with the application.",
 		Example: `
   Force delete the application with environments "test" and "prod".
-  /code $ copilot app delete --yes`,
+  /code $ copilot app delete --yes
+
+  Show the resources that would be deleted with the application.
+  /code $ copilot app delete --dry-run
+
+  Delete the application but keep the log groups and S3 buckets of its services and environments.
+  /code $ copilot app delete --retain logs,s3`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeleteAppOpts(vars)
 			if err != nil {
@@ -411,5 +534,7 @@ func buildAppDeleteCommand() *cobra.Command {
 
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, deleteDryRunFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.retain, retainFlag, nil, deleteRetainFlagDescription)
 	return cmd
 }
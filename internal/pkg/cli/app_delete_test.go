@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"testing"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/lock"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
@@ -36,6 +38,7 @@ type deleteAppMocks struct {
 	pipelineDeleter *mocks.Mockexecutor
 	prompt          *mocks.Mockprompter
 	sel             *mocks.MockappSelector
+	locks           *mocks.MockstackLockManager
 }
 
 func TestDeleteAppOpts_Ask(t *testing.T) {
@@ -154,11 +157,13 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 	mockPipelines := []deploy.Pipeline{
 		{
 			AppName:      "badgoose",
+			Name:         "pipeline1",
 			ResourceName: "pipeline1",
 			IsLegacy:     false,
 		},
 		{
 			AppName:      "badgoose",
+			Name:         "pipeline2",
 			ResourceName: "pipeline2",
 			IsLegacy:     false,
 		},
@@ -169,6 +174,12 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 			S3Bucket: "goose-bucket",
 		},
 	}
+	mockLocks := []lock.Lock{
+		{
+			Stack: "phonetool-test-api",
+			Owner: "alice@laptop",
+		},
+	}
 	mockTaskStacks := []deploy.TaskStackInfo{
 		{
 			StackName: "task-db-migrate",
@@ -178,8 +189,10 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 	}
 	tests := map[string]struct {
 		appName    string
+		inDryRun   bool
 		setupMocks func(mocks deleteAppMocks)
 
+		wantedPlan  string
 		wantedError error
 	}{
 		"success deleting all the resources along with workspace summary": {
@@ -219,6 +232,10 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 					// deleteAppResources
 					mocks.deployer.EXPECT().DeleteApp(mockAppName).Return(nil),
 
+					// deleteLocks
+					mocks.locks.EXPECT().List(mockAppName).Return(mockLocks, nil),
+					mocks.locks.EXPECT().Release(mockAppName, "phonetool-test-api").Return(nil),
+
 					// deleteAppConfigs
 					mocks.spinner.EXPECT().Start(deleteAppConfigStartMsg),
 					mocks.store.EXPECT().DeleteApplication(mockAppName).Return(nil),
@@ -268,6 +285,10 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 					// deleteAppResources
 					mocks.deployer.EXPECT().DeleteApp(mockAppName).Return(nil),
 
+					// deleteLocks
+					mocks.locks.EXPECT().List(mockAppName).Return(mockLocks, nil),
+					mocks.locks.EXPECT().Release(mockAppName, "phonetool-test-api").Return(nil),
+
 					// deleteAppConfigs
 					mocks.spinner.EXPECT().Start(deleteAppConfigStartMsg),
 					mocks.store.EXPECT().DeleteApplication(mockAppName).Return(nil),
@@ -278,6 +299,40 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 				)
 			},
 		},
+		"writes the plan followed by the plans of the services and environments on a dry run": {
+			appName:  mockAppName,
+			inDryRun: true,
+			setupMocks: func(mocks deleteAppMocks) {
+				gomock.InOrder(
+					mocks.store.EXPECT().ListServices(mockAppName).Return(mockServices, nil),
+					mocks.store.EXPECT().ListJobs(mockAppName).Return(mockJobs, nil),
+					mocks.store.EXPECT().ListEnvironments(mockAppName).Return(mockEnvs, nil),
+					mocks.deployer.EXPECT().ListTaskStacks(mockAppName, mockEnvs[0].Name).Return(mockTaskStacks, nil),
+					mocks.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil),
+					mocks.deployer.EXPECT().GetRegionalAppResources(mockApp).Return(mockResources, nil),
+					mocks.codepipeline.EXPECT().ListDeployedPipelines(mockAppName).Return(mockPipelines, nil),
+
+					mocks.svcDeleter.EXPECT().Execute().Return(nil).Times(2),
+					mocks.envDeleter.EXPECT().Ask().Return(nil),
+					mocks.envDeleter.EXPECT().Execute().Return(nil),
+				)
+			},
+			wantedPlan: `Plan to delete application phonetool:
+  1. Delete service webapp.
+  2. Delete service backend.
+  3. Delete job mailer from every environment.
+  4. Delete job bailer from every environment.
+  5. Delete task db-migrate in environment staging.
+  6. Delete environment staging.
+  7. Empty S3 bucket goose-bucket in region us-west-2.
+  8. Delete pipeline pipeline1.
+  9. Delete pipeline pipeline2.
+  10. Delete the application stack set and roles.
+  11. Release the stack locks of the application.
+  12. Delete application phonetool from the config store.
+
+`,
+		},
 	}
 
 	for name, test := range tests {
@@ -292,6 +347,7 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 			mockSession := sessions.ImmutableProvider()
 			mockDeployer := mocks.NewMockdeployer(ctrl)
 			mockPipelineLister := mocks.NewMockdeployedPipelineLister(ctrl)
+			mockLockManager := mocks.NewMockstackLockManager(ctrl)
 
 			mockBucketEmptier := mocks.NewMockbucketEmptier(ctrl)
 			mockGetBucketEmptier := func(session *session.Session) bucketEmptier {
@@ -337,12 +393,15 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 				taskDeleter:     mockTaskDeleteExecutor,
 				bucketEmptier:   mockBucketEmptier,
 				pipelineDeleter: mockPipelineDeleteExecutor,
+				locks:           mockLockManager,
 			}
 			test.setupMocks(mocks)
 
+			plan := &bytes.Buffer{}
 			opts := deleteAppOpts{
 				deleteAppVars: deleteAppVars{
-					name: mockAppName,
+					name:   mockAppName,
+					dryRun: test.inDryRun,
 				},
 				spinner: mockSpinner,
 				store:   mockStore,
@@ -350,6 +409,7 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 					return mockWorkspace, nil
 				},
 				pipelineLister:         mockPipelineLister,
+				locks:                  mockLockManager,
 				sessProvider:           mockSession,
 				cfn:                    mockDeployer,
 				s3:                     mockGetBucketEmptier,
@@ -358,6 +418,7 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 				envDeleteExecutor:      mockAskExecutorProvider,
 				taskDeleteExecutor:     mockTaskDeleteProvider,
 				pipelineDeleteExecutor: mockPipelineExecutorProvider,
+				planWriter:             plan,
 			}
 
 			// WHEN
@@ -365,6 +426,7 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 
 			// THEN
 			require.Equal(t, test.wantedError, err)
+			require.Equal(t, test.wantedPlan, plan.String())
 		})
 	}
 }
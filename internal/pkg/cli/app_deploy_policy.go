@@ -0,0 +1,220 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/template/policy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	appDeployPolicyNamePrompt     = "Which application's deploy policy would you like to manage?"
+	appDeployPolicyNameHelpPrompt = "A deploy policy restricts when the services and jobs of an application can be deployed, and which checks their templates must pass."
+)
+
+type deployPolicyAppVars struct {
+	name    string
+	envName string
+	file    string
+	remove  bool
+}
+
+type deployPolicyAppOpts struct {
+	deployPolicyAppVars
+
+	store store
+	sel   appSelector
+	fs    afero.Fs
+	w     io.Writer
+}
+
+func newDeployPolicyAppOpts(vars deployPolicyAppVars) (*deployPolicyAppOpts, error) {
+	defaultSess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("app deploy-policy")).Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	return &deployPolicyAppOpts{
+		deployPolicyAppVars: vars,
+		store:               store,
+		sel:                 selector.NewAppEnvSelector(prompt.New(), store),
+		fs:                  afero.NewOsFs(),
+		w:                   os.Stdout,
+	}, nil
+}
+
+// Validate returns an error if both a policy file and --remove are provided.
+func (o *deployPolicyAppOpts) Validate() error {
+	if o.file != "" && o.remove {
+		return fmt.Errorf("cannot specify both --%s and --%s", deployPolicyFileFlag, removeFlag)
+	}
+	return nil
+}
+
+// Ask validates the application and environment names if passed in, otherwise it prompts for the application.
+func (o *deployPolicyAppOpts) Ask() error {
+	if o.name != "" {
+		if _, err := o.store.GetApplication(o.name); err != nil {
+			return fmt.Errorf("validate application name %q: %w", o.name, err)
+		}
+	} else {
+		name, err := o.sel.Application(appDeployPolicyNamePrompt, appDeployPolicyNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.name = name
+	}
+	if o.envName == "" {
+		return nil
+	}
+	if _, err := o.store.GetEnvironment(o.name, o.envName); err != nil {
+		return fmt.Errorf("validate environment name %q: %w", o.envName, err)
+	}
+	return nil
+}
+
+// Execute writes the deploy policy of the application or environment, or replaces it with the one in the file.
+func (o *deployPolicyAppOpts) Execute() error {
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	if o.file == "" && !o.remove {
+		return o.writePolicy(o.policy(app))
+	}
+	var policy *config.DeployPolicy
+	if o.file != "" {
+		if policy, err = o.readPolicy(); err != nil {
+			return err
+		}
+	}
+	o.setPolicy(app, policy)
+	if err := o.store.UpdateApplication(app); err != nil {
+		return fmt.Errorf("update deploy policy of application %s: %w", o.name, err)
+	}
+	if policy.IsEmpty() {
+		log.Successf("Removed the deploy policy of %s.\n", o.target())
+		return nil
+	}
+	log.Successf("Updated the deploy policy of %s.\n", o.target())
+	return nil
+}
+
+func (o *deployPolicyAppOpts) readPolicy() (*config.DeployPolicy, error) {
+	raw, err := afero.ReadFile(o.fs, o.file)
+	if err != nil {
+		return nil, fmt.Errorf("read deploy policy file: %w", err)
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(raw))
+	dec.KnownFields(true)
+	deployPolicy := &config.DeployPolicy{}
+	if err := dec.Decode(deployPolicy); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("unmarshal deploy policy file %s: %w", o.file, err)
+	}
+	if err := deployPolicy.Validate(); err != nil {
+		return nil, fmt.Errorf("validate deploy policy file %s: %w", o.file, err)
+	}
+	if deployPolicy.Checks != nil {
+		if err := policy.Validate(deployPolicy.Checks.Rules); err != nil {
+			return nil, fmt.Errorf("validate deploy policy file %s: %w", o.file, err)
+		}
+	}
+	return deployPolicy, nil
+}
+
+func (o *deployPolicyAppOpts) policy(app *config.Application) *config.DeployPolicy {
+	if o.envName == "" {
+		return app.DeployPolicy
+	}
+	return app.EnvDeployPolicies[o.envName]
+}
+
+func (o *deployPolicyAppOpts) setPolicy(app *config.Application, policy *config.DeployPolicy) {
+	if policy.IsEmpty() {
+		policy = nil
+	}
+	if o.envName == "" {
+		app.DeployPolicy = policy
+		return
+	}
+	if policy == nil {
+		delete(app.EnvDeployPolicies, o.envName)
+		return
+	}
+	if app.EnvDeployPolicies == nil {
+		app.EnvDeployPolicies = make(map[string]*config.DeployPolicy)
+	}
+	app.EnvDeployPolicies[o.envName] = policy
+}
+
+func (o *deployPolicyAppOpts) writePolicy(policy *config.DeployPolicy) error {
+	if policy.IsEmpty() {
+		log.Infof("%s doesn't have a deploy policy.\n", o.target())
+		return nil
+	}
+	out, err := yaml.Marshal(policy)
+	if err != nil {
+		return fmt.Errorf("marshal deploy policy: %w", err)
+	}
+	_, err = o.w.Write(out)
+	return err
+}
+
+func (o *deployPolicyAppOpts) target() string {
+	if o.envName == "" {
+		return fmt.Sprintf("application %s", color.HighlightUserInput(o.name))
+	}
+	return fmt.Sprintf("environment %s", color.HighlightUserInput(o.envName))
+}
+
+// buildAppDeployPolicyCmd builds the command to manage the deploy policy of an application or environment.
+func buildAppDeployPolicyCmd() *cobra.Command {
+	vars := deployPolicyAppVars{}
+	cmd := &cobra.Command{
+		Use:   "deploy-policy",
+		Short: "Shows or sets the deploy windows, freezes and template checks of an application or environment.",
+		Long: `Shows or sets the deploy windows, freezes and template checks of an application or environment.
+Deploy commands fail while a freeze is in effect, or when none of the windows is open,
+unless --override-freeze is passed with a reason, which is recorded in the deployment history.
+They also fail if the generated templates don't pass the checks of the policy.
+A policy set on the application applies to all of its environments, in addition to the policy of the environment.`,
+
+		Example: `
+  Show the deploy policy of the "my-app" application.
+  /code $ copilot app deploy-policy -n my-app
+  Set the deploy policy of the "prod" environment.
+  /code $ copilot app deploy-policy -n my-app --env prod --file policy.yml
+  Remove the deploy policy of the "prod" environment.
+  /code $ copilot app deploy-policy -n my-app --env prod --remove`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDeployPolicyAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", deployPolicyEnvFlagDescription)
+	cmd.Flags().StringVar(&vars.file, deployPolicyFileFlag, "", deployPolicyFileFlagDescription)
+	cmd.Flags().BoolVar(&vars.remove, removeFlag, false, removeDeployPolicyFlagDescription)
+	return cmd
+}
@@ -0,0 +1,163 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployPolicyAppOpts_Validate(t *testing.T) {
+	opts := &deployPolicyAppOpts{
+		deployPolicyAppVars: deployPolicyAppVars{
+			file:   "policy.yml",
+			remove: true,
+		},
+	}
+
+	require.EqualError(t, opts.Validate(), "cannot specify both --file and --remove")
+}
+
+func TestDeployPolicyAppOpts_Execute(t *testing.T) {
+	const policyYAML = `windows:
+    - schedule: 0 9 * * MON-THU
+      duration: 8h
+      timezone: America/New_York
+`
+	businessHours := &config.DeployPolicy{
+		Windows: []config.Recurrence{
+			{
+				Schedule: "0 9 * * MON-THU",
+				Duration: "8h",
+				Timezone: "America/New_York",
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inEnv      string
+		inFile     string
+		inRemove   bool
+		inFiles    map[string]string
+		setupMocks func(store *mocks.Mockstore)
+
+		wanted      string
+		wantedError error
+	}{
+		"write the policy of the application": {
+			setupMocks: func(store *mocks.Mockstore) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:         "my-app",
+					DeployPolicy: businessHours,
+				}, nil)
+			},
+			wanted: policyYAML,
+		},
+		"no-op if the environment doesn't have a policy": {
+			inEnv: "prod",
+			setupMocks: func(store *mocks.Mockstore) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:         "my-app",
+					DeployPolicy: businessHours,
+				}, nil)
+			},
+		},
+		"set the policy of the environment from the file": {
+			inEnv:   "prod",
+			inFile:  "policy.yml",
+			inFiles: map[string]string{"policy.yml": policyYAML},
+			setupMocks: func(store *mocks.Mockstore) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				store.EXPECT().UpdateApplication(&config.Application{
+					Name: "my-app",
+					EnvDeployPolicies: map[string]*config.DeployPolicy{
+						"prod": businessHours,
+					},
+				}).Return(nil)
+			},
+		},
+		"remove the policy of the application": {
+			inRemove: true,
+			setupMocks: func(store *mocks.Mockstore) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:         "my-app",
+					DeployPolicy: businessHours,
+				}, nil)
+				store.EXPECT().UpdateApplication(&config.Application{Name: "my-app"}).Return(nil)
+			},
+		},
+		"reject unknown fields in the file": {
+			inFile:  "policy.yml",
+			inFiles: map[string]string{"policy.yml": "window:\n  - schedule: 0 9 * * *\n"},
+			setupMocks: func(store *mocks.Mockstore) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+			wantedError: errors.New("unmarshal deploy policy file policy.yml: yaml: unmarshal errors:\n  line 1: field window not found in type config.DeployPolicy"),
+		},
+		"reject an invalid policy": {
+			inFile:  "policy.yml",
+			inFiles: map[string]string{"policy.yml": "windows:\n  - schedule: 0 9 * * *\n    duration: -1h\n"},
+			setupMocks: func(store *mocks.Mockstore) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+			wantedError: errors.New(`validate deploy policy file policy.yml: validate window 1: duration "-1h" must be positive`),
+		},
+		"reject an unknown rule": {
+			inFile:  "policy.yml",
+			inFiles: map[string]string{"policy.yml": "checks:\n  rules: [no-public-buckets]\n"},
+			setupMocks: func(store *mocks.Mockstore) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+			wantedError: errors.New(`validate deploy policy file policy.yml: unknown rule "no-public-buckets": must be one of no-public-s3-buckets, container-memory-limits, no-wildcard-iam-actions, encrypted-storage`),
+		},
+		"wrap the error from updating the application": {
+			inRemove: true,
+			setupMocks: func(store *mocks.Mockstore) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				store.EXPECT().UpdateApplication(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("update deploy policy of application my-app: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			tc.setupMocks(store)
+			fs := afero.NewMemMapFs()
+			for path, content := range tc.inFiles {
+				require.NoError(t, afero.WriteFile(fs, path, []byte(content), 0644))
+			}
+			b := &strings.Builder{}
+			opts := &deployPolicyAppOpts{
+				deployPolicyAppVars: deployPolicyAppVars{
+					name:    "my-app",
+					envName: tc.inEnv,
+					file:    tc.inFile,
+					remove:  tc.inRemove,
+				},
+				store: store,
+				fs:    fs,
+				w:     b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, b.String())
+		})
+	}
+}
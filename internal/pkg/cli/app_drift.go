@@ -0,0 +1,116 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appDriftNamePrompt     = "Which application would you like to detect drift on?"
+	appDriftNameHelpPrompt = "The resources of the application stack are compared with their actual configuration."
+)
+
+type driftAppVars struct {
+	name             string
+	shouldOutputJSON bool
+}
+
+type driftAppOpts struct {
+	driftAppVars
+
+	w             io.Writer
+	store         store
+	sel           appSelector
+	prog          progress
+	driftDetector stackDriftDetector
+}
+
+func newDriftAppOpts(vars driftAppVars) (*driftAppOpts, error) {
+	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("app drift")).Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	store := config.NewSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region))
+	return &driftAppOpts{
+		driftAppVars:  vars,
+		w:             log.OutputWriter,
+		store:         store,
+		sel:           selector.NewAppEnvSelector(prompt.New(), store),
+		prog:          termprogress.NewSpinner(log.DiagnosticWriter),
+		driftDetector: cloudformation.New(sess),
+	}, nil
+}
+
+// Validate returns an error if any optional flags are invalid.
+func (o *driftAppOpts) Validate() error {
+	return nil
+}
+
+// Ask validates required fields that users passed in, otherwise it prompts for them.
+func (o *driftAppOpts) Ask() error {
+	if o.name != "" {
+		if _, err := o.store.GetApplication(o.name); err != nil {
+			return fmt.Errorf("validate application name %q: %w", o.name, err)
+		}
+		return nil
+	}
+	name, err := o.sel.Application(appDriftNamePrompt, appDriftNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute detects drift on the application stack and writes the drifted resources.
+func (o *driftAppOpts) Execute() error {
+	drift, err := detectStackDrift(o.driftDetector, o.prog, stack.NameForAppStack(o.name))
+	if err != nil {
+		return fmt.Errorf("detect drift of application %s: %w", o.name, err)
+	}
+	return drift.write(o.w, o.shouldOutputJSON)
+}
+
+// buildAppDriftCmd builds the command for detecting drift on an application stack.
+func buildAppDriftCmd() *cobra.Command {
+	vars := driftAppVars{}
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Detects resources of an application changed outside of Copilot.",
+		Long: `Detects resources of an application changed outside of Copilot.
+Runs CloudFormation drift detection on the application stack and shows the drifted properties.
+Exits with code 1 if any resource drifted.`,
+
+		Example: `
+  Detect drift on the "my-app" application.
+  /code $ copilot app drift -n my-app
+  Detect drift on the "my-app" application and print the drifted resources in JSON.
+  /code $ copilot app drift -n my-app --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDriftAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
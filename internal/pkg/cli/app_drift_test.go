@@ -0,0 +1,69 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriftAppOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(detector *mocks.MockstackDriftDetector)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"error if fail to detect drift": {
+			setupMocks: func(detector *mocks.MockstackDriftDetector) {
+				detector.EXPECT().DetectDrift(gomock.Any(), "phonetool-infrastructure-roles").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("detect drift of application phonetool: some error"),
+		},
+		"write that no drift is detected": {
+			setupMocks: func(detector *mocks.MockstackDriftDetector) {
+				detector.EXPECT().DetectDrift(gomock.Any(), "phonetool-infrastructure-roles").Return(nil, nil)
+			},
+			wantedOutput: "No drift detected in stack phonetool-infrastructure-roles.\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			prog := mocks.NewMockprogress(ctrl)
+			prog.EXPECT().Start(gomock.Any())
+			prog.EXPECT().Stop(gomock.Any())
+			detector := mocks.NewMockstackDriftDetector(ctrl)
+			tc.setupMocks(detector)
+			b := &bytes.Buffer{}
+			opts := &driftAppOpts{
+				driftAppVars: driftAppVars{
+					name: "phonetool",
+				},
+				w:             b,
+				prog:          prog,
+				driftDetector: detector,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
@@ -0,0 +1,501 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appGCNamePrompt       = "Which application would you like to clean up?"
+	fmtAppGCConfirmPrompt = "Are you sure you want to delete these %d unused resources?"
+
+	defaultAppGCOlderThan  = 30 * 24 * time.Hour
+	defaultAppGCKeepImages = 10
+
+	fmtAppGCImagesStart      = "Deleting %d images from ECR repository %s in region %s."
+	fmtAppGCImagesFailed     = "Failed to delete images from ECR repository %s in region %s.\n"
+	fmtAppGCImagesComplete   = "Deleted %d images from ECR repository %s in region %s.\n"
+	fmtAppGCTaskDefsStart    = "Deregistering %d task definitions in environment %s."
+	fmtAppGCTaskDefsFailed   = "Failed to deregister task definitions in environment %s.\n"
+	fmtAppGCTaskDefsComplete = "Deregistered %d task definitions in environment %s.\n"
+	fmtAppGCLogGroupsStart   = "Deleting %d log groups in environment %s."
+	fmtAppGCLogGroupsFailed  = "Failed to delete log groups in environment %s.\n"
+	fmtAppGCLogGroupsDone    = "Deleted %d log groups in environment %s.\n"
+)
+
+var (
+	errAppGCCancelled = errors.New("app gc cancelled - no changes made")
+)
+
+type gcAppVars struct {
+	name             string
+	dryRun           bool
+	olderThan        time.Duration
+	keepImages       int
+	skipConfirmation bool
+}
+
+type gcAppOpts struct {
+	gcAppVars
+
+	store       store
+	deployStore deployedEnvironmentLister
+	sel         appSelector
+	prompt      prompter
+	prog        progress
+	planWriter  io.Writer
+	now         func() time.Time
+
+	// unusedCount is the number of unused resources found by Execute.
+	unusedCount int
+
+	newImageCollector    func(region string) (imageCollector, error)
+	newEnvGarbageClients func(env *config.Environment) (taskDefinitionCollector, logGroupCollector, error)
+}
+
+// unusedImages are the images of an ECR repository in a region that can be deleted.
+type unusedImages struct {
+	region string
+	repo   string
+	client imageCollector
+	images []ecr.Image
+}
+
+// unusedEnvResources are the task definition revisions and log groups of an environment that can be deleted.
+type unusedEnvResources struct {
+	env       string
+	taskDefs  taskDefinitionCollector
+	logGroups logGroupCollector
+
+	taskDefARNs   []string
+	logGroupNames []string
+}
+
+func newGCAppOpts(vars gcAppVars) (*gcAppOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("app gc"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	return &gcAppOpts{
+		gcAppVars:   vars,
+		store:       store,
+		deployStore: deployStore,
+		sel:         selector.NewAppEnvSelector(prompter, store),
+		prompt:      prompter,
+		prog:        termprogress.NewSpinner(log.DiagnosticWriter),
+		planWriter:  os.Stdout,
+		now:         time.Now,
+		newImageCollector: func(region string) (imageCollector, error) {
+			sess, err := sessProvider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("default session with region %s: %w", region, err)
+			}
+			return ecr.New(sess), nil
+		},
+		newEnvGarbageClients: func(env *config.Environment) (taskDefinitionCollector, logGroupCollector, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return awsecs.New(sess), cloudwatchlogs.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values passed by flags are invalid.
+func (o *gcAppOpts) Validate() error {
+	if o.keepImages < 0 {
+		return fmt.Errorf("--%s must be greater than or equal to 0", keepImagesFlag)
+	}
+	if o.olderThan < 0 {
+		return fmt.Errorf("--%s must be a positive duration", olderThanFlag)
+	}
+	return nil
+}
+
+// Ask prompts for and validates the application name.
+func (o *gcAppOpts) Ask() error {
+	if o.name != "" {
+		_, err := o.store.GetApplication(o.name)
+		return err
+	}
+	name, err := o.sel.Application(appGCNamePrompt, "")
+	if err != nil {
+		return fmt.Errorf("select application name: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute finds the images, task definitions, and log groups of the application that are no longer used
+// by any deployed workload and are older than the age threshold, writes them, and then deletes them after confirmation.
+func (o *gcAppOpts) Execute() error {
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	envs, err := o.store.ListEnvironments(o.name)
+	if err != nil {
+		return fmt.Errorf("list environments of application %s: %w", o.name, err)
+	}
+	var envNames []string
+	for _, env := range envs {
+		envNames = append(envNames, env.Name)
+	}
+	var unusedEnvs []*unusedEnvResources
+	inUseImages := make(map[string][]string)
+	for _, env := range envs {
+		unused, err := o.unusedEnvResources(app, env, envNames, inUseImages)
+		if err != nil {
+			return err
+		}
+		unusedEnvs = append(unusedEnvs, unused)
+	}
+	images, err := o.unusedImages(app, envs, inUseImages)
+	if err != nil {
+		return err
+	}
+
+	plan := &deletePlan{
+		title: fmt.Sprintf("Unused resources of application %s older than %s:", o.name, o.olderThan),
+	}
+	var count int
+	for _, repo := range images {
+		var resources []deletePlanResource
+		for _, img := range repo.images {
+			resources = append(resources, deletePlanResource{description: imageDescription(img)})
+		}
+		plan.add(fmt.Sprintf("Delete %d images from ECR repository %s in region %s.", len(repo.images), repo.repo, repo.region), resources...)
+		count += len(repo.images)
+	}
+	for _, env := range unusedEnvs {
+		if len(env.taskDefARNs) > 0 {
+			plan.add(fmt.Sprintf("Deregister %d task definitions in environment %s.", len(env.taskDefARNs), env.env), namedPlanResources(env.taskDefARNs)...)
+		}
+		if len(env.logGroupNames) > 0 {
+			plan.add(fmt.Sprintf("Delete %d log groups in environment %s.", len(env.logGroupNames), env.env), namedPlanResources(env.logGroupNames)...)
+		}
+		count += len(env.taskDefARNs) + len(env.logGroupNames)
+	}
+	o.unusedCount = count
+	if count == 0 {
+		log.Infof("No unused resources found in application %s.\n", o.name)
+		return nil
+	}
+	if err := plan.render(o.planWriter); err != nil {
+		return err
+	}
+	if o.dryRun {
+		return nil
+	}
+	if !o.skipConfirmation {
+		confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtAppGCConfirmPrompt, count), "", prompt.WithConfirmFinalMessage())
+		if err != nil {
+			return fmt.Errorf("confirm to delete unused resources: %w", err)
+		}
+		if !confirmed {
+			return errAppGCCancelled
+		}
+	}
+	return o.collect(images, unusedEnvs)
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *gcAppOpts) RecommendActions() error {
+	if !o.dryRun || o.unusedCount == 0 {
+		return nil
+	}
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to delete these resources.", color.HighlightCode(fmt.Sprintf("copilot app gc -n %s", o.name))),
+	})
+	return nil
+}
+
+// unusedEnvResources returns the task definition revisions and log groups of an environment that don't belong to
+// a deployed workload, or are older revisions, and records the images of the task definitions in use.
+func (o *gcAppOpts) unusedEnvResources(app *config.Application, env *config.Environment, envNames []string, inUseImages map[string][]string) (*unusedEnvResources, error) {
+	deployed, err := o.deployedWorkloads(env.Name)
+	if err != nil {
+		return nil, err
+	}
+	taskDefs, logGroups, err := o.newEnvGarbageClients(env)
+	if err != nil {
+		return nil, err
+	}
+	unused := &unusedEnvResources{
+		env:       env.Name,
+		taskDefs:  taskDefs,
+		logGroups: logGroups,
+	}
+
+	prefix := fmt.Sprintf("%s-%s-", o.name, env.Name)
+	arns, err := taskDefs.ActiveTaskDefinitions(prefix)
+	if err != nil {
+		return nil, err
+	}
+	latest := make(map[string]int)
+	for _, arn := range arns {
+		family, revision := taskDefFamilyRevision(arn)
+		if revision > latest[family] {
+			latest[family] = revision
+		}
+	}
+	for _, arn := range arns {
+		family, revision := taskDefFamilyRevision(arn)
+		if ownerEnv(strings.TrimPrefix(family, o.name+"-"), envNames) != env.Name {
+			continue
+		}
+		taskDef, err := taskDefs.TaskDefinition(arn)
+		if err != nil {
+			return nil, err
+		}
+		if revision == latest[family] && contains(strings.TrimPrefix(family, prefix), deployed) {
+			for _, container := range taskDef.ContainerDefinitions {
+				repo, ref := imageReference(aws.StringValue(container.Image))
+				inUseImages[repo] = append(inUseImages[repo], ref)
+			}
+			continue
+		}
+		if o.isOld(aws.TimeValue(taskDef.RegisteredAt)) {
+			unused.taskDefARNs = append(unused.taskDefARNs, arn)
+		}
+	}
+
+	if app.ResourceNames != nil && app.ResourceNames.LogGroup != "" {
+		// Log groups with a custom name don't share a prefix that tells apart the ones Copilot created.
+		return unused, nil
+	}
+	groups, err := logGroups.LogGroups("/copilot/" + prefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		name := strings.TrimPrefix(group.Name, "/copilot/")
+		if ownerEnv(strings.TrimPrefix(name, o.name+"-"), envNames) != env.Name {
+			continue
+		}
+		if contains(strings.TrimPrefix(name, prefix), deployed) || !o.isOld(group.CreationTime) {
+			continue
+		}
+		unused.logGroupNames = append(unused.logGroupNames, group.Name)
+	}
+	return unused, nil
+}
+
+// unusedImages returns the images of each workload repository beyond the most recent ones to keep,
+// that are older than the age threshold and aren't used by the task definition of a deployed workload.
+func (o *gcAppOpts) unusedImages(app *config.Application, envs []*config.Environment, inUseImages map[string][]string) ([]*unusedImages, error) {
+	wklds, err := o.store.ListWorkloads(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list workloads of application %s: %w", o.name, err)
+	}
+	var unused []*unusedImages
+	for _, region := range uniqueRegions(envs) {
+		client, err := o.newImageCollector(region)
+		if err != nil {
+			return nil, err
+		}
+		for _, wkld := range wklds {
+			repo := app.RepositoryName(wkld.Name)
+			images, err := client.ListImages(repo)
+			if err != nil {
+				return nil, err
+			}
+			sort.SliceStable(images, func(i, j int) bool {
+				return images[i].PushedAt.After(images[j].PushedAt)
+			})
+			repoImages := &unusedImages{
+				region: region,
+				repo:   repo,
+				client: client,
+			}
+			for i, img := range images {
+				if i < o.keepImages || !o.isOld(img.PushedAt) || isImageInUse(img, inUseImages[repo]) {
+					continue
+				}
+				repoImages.images = append(repoImages.images, img)
+			}
+			if len(repoImages.images) > 0 {
+				unused = append(unused, repoImages)
+			}
+		}
+	}
+	return unused, nil
+}
+
+func (o *gcAppOpts) collect(images []*unusedImages, envs []*unusedEnvResources) error {
+	for _, repo := range images {
+		o.prog.Start(fmt.Sprintf(fmtAppGCImagesStart, len(repo.images), repo.repo, repo.region))
+		if err := repo.client.DeleteImages(repo.images, repo.repo); err != nil {
+			o.prog.Stop(log.Serrorf(fmtAppGCImagesFailed, repo.repo, repo.region))
+			return err
+		}
+		o.prog.Stop(log.Ssuccessf(fmtAppGCImagesComplete, len(repo.images), repo.repo, repo.region))
+	}
+	for _, env := range envs {
+		if len(env.taskDefARNs) > 0 {
+			o.prog.Start(fmt.Sprintf(fmtAppGCTaskDefsStart, len(env.taskDefARNs), env.env))
+			for _, arn := range env.taskDefARNs {
+				if err := env.taskDefs.DeregisterTaskDefinition(arn); err != nil {
+					o.prog.Stop(log.Serrorf(fmtAppGCTaskDefsFailed, env.env))
+					return err
+				}
+			}
+			o.prog.Stop(log.Ssuccessf(fmtAppGCTaskDefsComplete, len(env.taskDefARNs), env.env))
+		}
+		if len(env.logGroupNames) > 0 {
+			o.prog.Start(fmt.Sprintf(fmtAppGCLogGroupsStart, len(env.logGroupNames), env.env))
+			for _, name := range env.logGroupNames {
+				if err := env.logGroups.DeleteLogGroup(name); err != nil {
+					o.prog.Stop(log.Serrorf(fmtAppGCLogGroupsFailed, env.env))
+					return err
+				}
+			}
+			o.prog.Stop(log.Ssuccessf(fmtAppGCLogGroupsDone, len(env.logGroupNames), env.env))
+		}
+	}
+	return nil
+}
+
+func (o *gcAppOpts) deployedWorkloads(env string) ([]string, error) {
+	svcs, err := o.deployStore.ListDeployedServices(o.name, env)
+	if err != nil {
+		return nil, fmt.Errorf("list services deployed in environment %s: %w", env, err)
+	}
+	jobs, err := o.deployStore.ListDeployedJobs(o.name, env)
+	if err != nil {
+		return nil, fmt.Errorf("list jobs deployed in environment %s: %w", env, err)
+	}
+	return append(svcs, jobs...), nil
+}
+
+func (o *gcAppOpts) isOld(t time.Time) bool {
+	return o.now().Sub(t) > o.olderThan
+}
+
+// ownerEnv returns the environment whose name is the longest prefix of "<env>-<workload>",
+// so that the resources of environment "test-2" aren't mistaken for the resources of environment "test".
+func ownerEnv(envWorkload string, envNames []string) string {
+	var owner string
+	for _, env := range envNames {
+		if strings.HasPrefix(envWorkload, env+"-") && len(env) > len(owner) {
+			owner = env
+		}
+	}
+	return owner
+}
+
+// taskDefFamilyRevision returns the family and revision of a task definition ARN such as
+// arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:3.
+func taskDefFamilyRevision(arn string) (string, int) {
+	resource := arn[strings.LastIndex(arn, "/")+1:]
+	idx := strings.LastIndex(resource, ":")
+	if idx == -1 {
+		return resource, 0
+	}
+	revision, err := strconv.Atoi(resource[idx+1:])
+	if err != nil {
+		return resource, 0
+	}
+	return resource[:idx], revision
+}
+
+// imageReference returns the repository name and the tag or digest of an image URI such as
+// 123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:latest.
+func imageReference(uri string) (repo, ref string) {
+	path := uri[strings.Index(uri, "/")+1:]
+	if idx := strings.Index(path, "@"); idx != -1 {
+		return path[:idx], path[idx+1:]
+	}
+	if idx := strings.LastIndex(path, ":"); idx != -1 {
+		return path[:idx], path[idx+1:]
+	}
+	return path, "latest"
+}
+
+func isImageInUse(img ecr.Image, refs []string) bool {
+	for _, ref := range refs {
+		if ref == img.Digest || contains(ref, img.Tags) {
+			return true
+		}
+	}
+	return false
+}
+
+func imageDescription(img ecr.Image) string {
+	if len(img.Tags) == 0 {
+		return img.Digest
+	}
+	return fmt.Sprintf("%s (%s)", img.Digest, strings.Join(img.Tags, ", "))
+}
+
+func namedPlanResources(names []string) []deletePlanResource {
+	resources := make([]deletePlanResource, len(names))
+	for i, name := range names {
+		resources[i] = deletePlanResource{description: name}
+	}
+	return resources
+}
+
+// buildAppGCCmd builds the command to delete the unused resources of an application.
+func buildAppGCCmd() *cobra.Command {
+	vars := gcAppVars{}
+	cmd := &cobra.Command{
+		Use:   "gc",
+		Short: "Deletes the resources of an application that are no longer used.",
+		Long: `Deletes the resources of an application that are no longer used by any deployed workload:
+old images in the ECR repositories of the workloads, stale task definition revisions,
+and the log groups of workloads that were deleted.`,
+		Example: fmt.Sprintf(`
+  Show the unused resources of the application without deleting them.
+  /code $ copilot app gc --dry-run
+
+  Delete the unused resources older than a week, keeping the 5 most recent images of each workload.
+  /code $ copilot app gc --%s 168h --%s 5`, olderThanFlag, keepImagesFlag),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newGCAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, appGCDryRunFlagDescription)
+	cmd.Flags().DurationVar(&vars.olderThan, olderThanFlag, defaultAppGCOlderThan, olderThanFlagDescription)
+	cmd.Flags().IntVar(&vars.keepImages, keepImagesFlag, defaultAppGCKeepImages, keepImagesFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
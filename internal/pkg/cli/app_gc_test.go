@@ -0,0 +1,268 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type gcAppMocks struct {
+	store       *mocks.Mockstore
+	deployStore *mocks.MockdeployedEnvironmentLister
+	prompt      *mocks.Mockprompter
+	prog        *mocks.Mockprogress
+	images      *mocks.MockimageCollector
+	taskDefs    *mocks.MocktaskDefinitionCollector
+	logGroups   *mocks.MocklogGroupCollector
+}
+
+func TestGCAppOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inKeepImages int
+		inOlderThan  time.Duration
+
+		wantedError error
+	}{
+		"error if the number of images to keep is negative": {
+			inKeepImages: -1,
+			wantedError:  errors.New("--keep-images must be greater than or equal to 0"),
+		},
+		"error if the age threshold is negative": {
+			inOlderThan: -time.Hour,
+			wantedError: errors.New("--older-than must be a positive duration"),
+		},
+		"valid flags": {
+			inKeepImages: 10,
+			inOlderThan:  time.Hour,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &gcAppOpts{
+				gcAppVars: gcAppVars{
+					keepImages: tc.inKeepImages,
+					olderThan:  tc.inOlderThan,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGCAppOpts_Execute(t *testing.T) {
+	now := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	old := now.Add(-60 * 24 * time.Hour)
+	recent := now.Add(-time.Hour)
+	envs := []*config.Environment{
+		{Name: "test", Region: "us-west-2"},
+		{Name: "test-2", Region: "us-west-2"},
+	}
+	const (
+		apiRev1 = "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:1"
+		apiRev2 = "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:2"
+		oldRev1 = "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-old:1"
+		test2   = "arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-2-api:7"
+	)
+	mockUnusedResources := func(m gcAppMocks) {
+		m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+		m.store.EXPECT().ListEnvironments("phonetool").Return(envs, nil)
+
+		m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"api"}, nil)
+		m.deployStore.EXPECT().ListDeployedJobs("phonetool", "test").Return(nil, nil)
+		m.taskDefs.EXPECT().ActiveTaskDefinitions("phonetool-test-").Return([]string{apiRev1, apiRev2, oldRev1, test2}, nil)
+		m.taskDefs.EXPECT().TaskDefinition(apiRev1).Return(&awsecs.TaskDefinition{RegisteredAt: aws.Time(old)}, nil)
+		m.taskDefs.EXPECT().TaskDefinition(apiRev2).Return(&awsecs.TaskDefinition{
+			RegisteredAt: aws.Time(old),
+			ContainerDefinitions: []*sdkecs.ContainerDefinition{
+				{Image: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v2")},
+			},
+		}, nil)
+		m.taskDefs.EXPECT().TaskDefinition(oldRev1).Return(&awsecs.TaskDefinition{RegisteredAt: aws.Time(old)}, nil)
+		m.logGroups.EXPECT().LogGroups("/copilot/phonetool-test-").Return([]cloudwatchlogs.LogGroup{
+			{Name: "/copilot/phonetool-test-api", CreationTime: old},
+			{Name: "/copilot/phonetool-test-old", CreationTime: old},
+			{Name: "/copilot/phonetool-test-new", CreationTime: recent},
+			{Name: "/copilot/phonetool-test-2-api", CreationTime: old},
+		}, nil)
+
+		m.deployStore.EXPECT().ListDeployedServices("phonetool", "test-2").Return([]string{"api"}, nil)
+		m.deployStore.EXPECT().ListDeployedJobs("phonetool", "test-2").Return(nil, nil)
+		m.taskDefs.EXPECT().ActiveTaskDefinitions("phonetool-test-2-").Return(nil, nil)
+		m.logGroups.EXPECT().LogGroups("/copilot/phonetool-test-2-").Return(nil, nil)
+
+		m.store.EXPECT().ListWorkloads("phonetool").Return([]*config.Workload{{Name: "api"}}, nil)
+		m.images.EXPECT().ListImages("phonetool/api").Return([]ecr.Image{
+			{Digest: "sha256:1", Tags: []string{"v1"}, PushedAt: old.Add(-time.Hour)},
+			{Digest: "sha256:3", Tags: []string{"v3"}, PushedAt: recent},
+			{Digest: "sha256:2", Tags: []string{"v2"}, PushedAt: old},
+			{Digest: "sha256:0", PushedAt: old.Add(-2 * time.Hour)},
+		}, nil)
+	}
+	testCases := map[string]struct {
+		inDryRun   bool
+		setupMocks func(m gcAppMocks)
+
+		wantedPlan  string
+		wantedError error
+	}{
+		"error if the application can't be retrieved": {
+			setupMocks: func(m gcAppMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get application phonetool: some error"),
+		},
+		"error if the environments can't be listed": {
+			setupMocks: func(m gcAppMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list environments of application phonetool: some error"),
+		},
+		"writes the unused resources without deleting them on a dry run": {
+			inDryRun:   true,
+			setupMocks: mockUnusedResources,
+			wantedPlan: `Unused resources of application phonetool older than 720h0m0s:
+  1. Delete 2 images from ECR repository phonetool/api in region us-west-2.
+       - sha256:1 (v1)
+       - sha256:0
+  2. Deregister 2 task definitions in environment test.
+       - arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-api:1
+       - arn:aws:ecs:us-west-2:123456789012:task-definition/phonetool-test-old:1
+  3. Delete 1 log groups in environment test.
+       - /copilot/phonetool-test-old
+
+`,
+		},
+		"error if the user cancels": {
+			setupMocks: func(m gcAppMocks) {
+				mockUnusedResources(m)
+				m.prompt.EXPECT().Confirm("Are you sure you want to delete these 5 unused resources?", "", gomock.Any()).Return(false, nil)
+			},
+			wantedPlan:  "ignored",
+			wantedError: errAppGCCancelled,
+		},
+		"deletes the unused resources after confirmation": {
+			setupMocks: func(m gcAppMocks) {
+				mockUnusedResources(m)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+				m.prog.EXPECT().Start(gomock.Any()).Times(3)
+				m.images.EXPECT().DeleteImages([]ecr.Image{
+					{Digest: "sha256:1", Tags: []string{"v1"}, PushedAt: old.Add(-time.Hour)},
+					{Digest: "sha256:0", PushedAt: old.Add(-2 * time.Hour)},
+				}, "phonetool/api").Return(nil)
+				m.taskDefs.EXPECT().DeregisterTaskDefinition(apiRev1).Return(nil)
+				m.taskDefs.EXPECT().DeregisterTaskDefinition(oldRev1).Return(nil)
+				m.logGroups.EXPECT().DeleteLogGroup("/copilot/phonetool-test-old").Return(nil)
+				m.prog.EXPECT().Stop(gomock.Any()).Times(3)
+			},
+			wantedPlan: "ignored",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := gcAppMocks{
+				store:       mocks.NewMockstore(ctrl),
+				deployStore: mocks.NewMockdeployedEnvironmentLister(ctrl),
+				prompt:      mocks.NewMockprompter(ctrl),
+				prog:        mocks.NewMockprogress(ctrl),
+				images:      mocks.NewMockimageCollector(ctrl),
+				taskDefs:    mocks.NewMocktaskDefinitionCollector(ctrl),
+				logGroups:   mocks.NewMocklogGroupCollector(ctrl),
+			}
+			tc.setupMocks(m)
+			plan := &bytes.Buffer{}
+			opts := &gcAppOpts{
+				gcAppVars: gcAppVars{
+					name:       "phonetool",
+					dryRun:     tc.inDryRun,
+					olderThan:  defaultAppGCOlderThan,
+					keepImages: 1,
+				},
+				store:       m.store,
+				deployStore: m.deployStore,
+				prompt:      m.prompt,
+				prog:        m.prog,
+				planWriter:  plan,
+				now: func() time.Time {
+					return now
+				},
+				newImageCollector: func(region string) (imageCollector, error) {
+					return m.images, nil
+				},
+				newEnvGarbageClients: func(env *config.Environment) (taskDefinitionCollector, logGroupCollector, error) {
+					return m.taskDefs, m.logGroups, nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.wantedPlan != "ignored" {
+				require.Equal(t, tc.wantedPlan, plan.String())
+			}
+		})
+	}
+}
+
+func TestImageReference(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedRepo string
+		wantedRef  string
+	}{
+		"image with a tag": {
+			in:         "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v2",
+			wantedRepo: "phonetool/api",
+			wantedRef:  "v2",
+		},
+		"image with a digest": {
+			in:         "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:abc",
+			wantedRepo: "phonetool/api",
+			wantedRef:  "sha256:abc",
+		},
+		"image without a tag": {
+			in:         "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api",
+			wantedRepo: "phonetool/api",
+			wantedRef:  "latest",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			repo, ref := imageReference(tc.in)
+
+			require.Equal(t, tc.wantedRepo, repo)
+			require.Equal(t, tc.wantedRef, ref)
+		})
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appGraphNamePrompt     = "Which application would you like to graph?"
+	appGraphNameHelpPrompt = "An application is a collection of related services."
+)
+
+// Formats of the application graph.
+const (
+	graphFormatDOT     = "dot"
+	graphFormatMermaid = "mermaid"
+	graphFormatHTML    = "html"
+)
+
+var graphFormats = []string{graphFormatDOT, graphFormatMermaid, graphFormatHTML}
+
+type appGraphVars struct {
+	name             string
+	format           string
+	shouldOutputJSON bool
+}
+
+type appGraphOpts struct {
+	appGraphVars
+
+	w                 io.Writer
+	store             store
+	sel               appSelector
+	newGraphDescriber func(app string) appGraphDescriber
+}
+
+func newAppGraphOpts(vars appGraphVars) (*appGraphOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("app graph"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &appGraphOpts{
+		appGraphVars: vars,
+		w:            log.OutputWriter,
+		store:        configStore,
+		sel:          selector.NewAppEnvSelector(prompt.New(), configStore),
+		newGraphDescriber: func(app string) appGraphDescriber {
+			return describe.NewAppGraphDescriber(describe.NewAppGraphConfig{
+				App:         app,
+				ConfigStore: configStore,
+				DeployStore: deployStore,
+			})
+		},
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *appGraphOpts) Validate() error {
+	if o.shouldOutputJSON && o.format != "" {
+		return fmt.Errorf("--%s cannot be used with --%s", jsonFlag, graphFormatFlag)
+	}
+	if o.format != "" && !contains(o.format, graphFormats) {
+		return fmt.Errorf("invalid format %q: must be one of %s", o.format, english.WordSeries(applyAll(graphFormats, strconv.Quote), "or"))
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *appGraphOpts) Ask() error {
+	if o.name != "" {
+		if _, err := o.store.GetApplication(o.name); err != nil {
+			return fmt.Errorf("validate application name %q: %v", o.name, err)
+		}
+		return nil
+	}
+	name, err := o.sel.Application(appGraphNamePrompt, appGraphNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute writes the graph of the environments, workloads, topics and storage of the application.
+func (o *appGraphOpts) Execute() error {
+	graph, err := o.newGraphDescriber(o.name).Describe()
+	if err != nil {
+		return fmt.Errorf("describe graph of application %s: %w", o.name, err)
+	}
+	switch {
+	case o.shouldOutputJSON:
+		data, err := graph.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	case o.format == graphFormatDOT:
+		fmt.Fprint(o.w, graph.DOTString())
+	case o.format == graphFormatMermaid:
+		fmt.Fprint(o.w, graph.MermaidString())
+	case o.format == graphFormatHTML:
+		page, err := graph.HTMLString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, page)
+	default:
+		fmt.Fprint(o.w, graph.HumanString())
+	}
+	return nil
+}
+
+// buildAppGraphCmd builds the command for exporting the topology of an application.
+func buildAppGraphCmd() *cobra.Command {
+	vars := appGraphVars{}
+	cmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Shows the topology of an application.",
+		Long: `Shows the topology of an application: the services and jobs deployed in each environment,
+the SNS topics they publish to and subscribe to, and the storage of their addons.
+The graph is read from the deployed stacks, so it's what runs rather than what's in the workspace.`,
+
+		Example: `
+  Shows the topology of the "my-app" application.
+  /code $ copilot app graph -n my-app
+  Renders the topology as an image with Graphviz.
+  /code $ copilot app graph -n my-app --format dot | dot -Tsvg -o my-app.svg
+  Writes a web page that draws the topology, for an architecture review.
+  /code $ copilot app graph -n my-app --format html > my-app.html`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newAppGraphOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.format, graphFormatFlag, "", graphFormatFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
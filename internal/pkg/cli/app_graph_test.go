@@ -0,0 +1,186 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppGraphOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inFormat string
+		inJSON   bool
+
+		wantedError error
+	}{
+		"valid format": {
+			inFormat: "mermaid",
+		},
+		"both json and format": {
+			inFormat:    "dot",
+			inJSON:      true,
+			wantedError: errors.New("--json cannot be used with --format"),
+		},
+		"invalid format": {
+			inFormat:    "png",
+			wantedError: errors.New(`invalid format "png": must be one of "dot", "mermaid" or "html"`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &appGraphOpts{
+				appGraphVars: appGraphVars{
+					format:           tc.inFormat,
+					shouldOutputJSON: tc.inJSON,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAppGraphOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp      string
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockappSelector)
+
+		wantedApp   string
+		wantedError error
+	}{
+		"validate the application name": {
+			inApp: "phonetool",
+			setupMocks: func(store *mocks.Mockstore, _ *mocks.MockappSelector) {
+				store.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New(`validate application name "phonetool": some error`),
+		},
+		"prompt for the application name": {
+			setupMocks: func(_ *mocks.Mockstore, sel *mocks.MockappSelector) {
+				sel.EXPECT().Application(appGraphNamePrompt, appGraphNameHelpPrompt).Return("phonetool", nil)
+			},
+			wantedApp: "phonetool",
+		},
+		"wrap the error if fail to select the application": {
+			setupMocks: func(_ *mocks.Mockstore, sel *mocks.MockappSelector) {
+				sel.EXPECT().Application(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select application: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockappSelector(ctrl)
+			tc.setupMocks(store, sel)
+			opts := &appGraphOpts{
+				appGraphVars: appGraphVars{name: tc.inApp},
+				store:        store,
+				sel:          sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.name)
+		})
+	}
+}
+
+func TestAppGraphOpts_Execute(t *testing.T) {
+	graph := &describe.AppGraph{
+		App: "phonetool",
+		Environments: []*describe.GraphEnvironment{
+			{Name: "test", Region: "us-west-2"},
+		},
+	}
+	testCases := map[string]struct {
+		inFormat   string
+		inJSON     bool
+		setupMocks func(m *mocks.MockappGraphDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"wrap the error if fail to describe the graph": {
+			setupMocks: func(m *mocks.MockappGraphDescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe graph of application phonetool: some error"),
+		},
+		"human": {
+			setupMocks: func(m *mocks.MockappGraphDescriber) {
+				m.EXPECT().Describe().Return(graph, nil)
+			},
+			wantedContent: graph.HumanString(),
+		},
+		"json": {
+			inJSON: true,
+			setupMocks: func(m *mocks.MockappGraphDescriber) {
+				m.EXPECT().Describe().Return(graph, nil)
+			},
+			wantedContent: `{"application":"phonetool","environments":[{"name":"test","region":"us-west-2","nodes":null,"dependencies":null}]}` + "\n",
+		},
+		"dot": {
+			inFormat: graphFormatDOT,
+			setupMocks: func(m *mocks.MockappGraphDescriber) {
+				m.EXPECT().Describe().Return(graph, nil)
+			},
+			wantedContent: graph.DOTString(),
+		},
+		"mermaid": {
+			inFormat: graphFormatMermaid,
+			setupMocks: func(m *mocks.MockappGraphDescriber) {
+				m.EXPECT().Describe().Return(graph, nil)
+			},
+			wantedContent: graph.MermaidString(),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			describer := mocks.NewMockappGraphDescriber(ctrl)
+			tc.setupMocks(describer)
+			b := &strings.Builder{}
+			opts := &appGraphOpts{
+				appGraphVars: appGraphVars{
+					name:             "phonetool",
+					format:           tc.inFormat,
+					shouldOutputJSON: tc.inJSON,
+				},
+				w:                 b,
+				newGraphDescriber: func(string) appGraphDescriber { return describer },
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, b.String())
+		})
+	}
+}
@@ -44,6 +44,8 @@ type initAppVars struct {
 	permissionsBoundary string
 	domainName          string
 	resourceTags        map[string]string
+	configStore         string
+	imageRetentionVars
 }
 
 type initAppOpts struct {
@@ -103,6 +105,12 @@ func (o *initAppOpts) Validate() error {
 			return err
 		}
 	}
+	if err := o.imageRetentionVars.validate(); err != nil {
+		return err
+	}
+	if err := validateConfigStore(o.configStore); err != nil {
+		return err
+	}
 	if o.permissionsBoundary != "" {
 		// Best effort to get the permission boundary name if ARN
 		// (for example: arn:aws:iam::1234567890:policy/myPermissionsBoundaryPolicy).
@@ -237,6 +245,7 @@ func (o *initAppOpts) Execute() error {
 		PermissionsBoundary: o.permissionsBoundary,
 		AdditionalTags:      o.resourceTags,
 		Version:             version.LatestTemplateVersion(),
+		ImageRetention:      o.applyTo(nil),
 	})
 	if err != nil {
 		return err
@@ -249,6 +258,8 @@ func (o *initAppOpts) Execute() error {
 		DomainHostedZoneID:  hostedZoneID,
 		PermissionsBoundary: o.permissionsBoundary,
 		Tags:                o.resourceTags,
+		ImageRetention:      o.applyTo(nil),
+		ConfigStore:         o.configStore,
 	}); err != nil {
 		return err
 	}
@@ -411,7 +422,11 @@ An application is a collection of containerized services that operate together.`
   Create a new application with an existing IAM policy as the permissions boundary for roles.
   /code $ copilot app init --permissions-boundary myPermissionsBoundaryPolicy
   Create a new application with resource tags.
-  /code $ copilot app init --resource-tags department=MyDept,team=MyTeam`,
+  /code $ copilot app init --resource-tags department=MyDept,team=MyTeam
+  Create a new application whose ECR repositories keep the 50 most recent images.
+  /code $ copilot app init --keep-images 50 --untagged-image-expiry 7
+  Create a new application that stores the configuration of its environments and workloads in DynamoDB.
+  /code $ copilot app init --config-store dynamodb`,
 		Args: reservedArgs,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitAppOpts(vars)
@@ -427,5 +442,9 @@ An application is a collection of containerized services that operate together.`
 	cmd.Flags().StringVar(&vars.domainName, domainNameFlag, "", domainNameFlagDescription)
 	cmd.Flags().StringVar(&vars.permissionsBoundary, permissionsBoundaryFlag, "", permissionsBoundaryFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().IntVar(&vars.keepImages, keepImagesFlag, 0, appKeepImagesFlagDescription)
+	cmd.Flags().IntVar(&vars.untaggedExpiryDays, untaggedImageExpiryFlag, 0, appUntaggedImageExpiryFlagDescription)
+	cmd.Flags().BoolVar(&vars.immutableTags, immutableTagsFlag, false, appImmutableTagsFlagDescription)
+	cmd.Flags().StringVar(&vars.configStore, configStoreFlag, "", appConfigStoreFlagDescription)
 	return cmd
 }
@@ -32,6 +32,7 @@ func TestInitAppOpts_Validate(t *testing.T) {
 		inAppName      string
 		inDomainName   string
 		inPBPolicyName string
+		inConfigStore  string
 
 		mock func(m *initAppMocks)
 
@@ -40,6 +41,11 @@ func TestInitAppOpts_Validate(t *testing.T) {
 		"skip everything": {
 			mock: func(m *initAppMocks) {},
 		},
+		"invalid config store": {
+			inConfigStore: "s3",
+			mock:          func(m *initAppMocks) {},
+			wantedError:   errors.New(`invalid config store "s3": must be one of "ssm" or "dynamodb"`),
+		},
 		"valid app name without application in SSM and without IAM adminrole": {
 			inAppName: "metrics",
 			mock: func(m *initAppMocks) {
@@ -219,6 +225,7 @@ func TestInitAppOpts_Validate(t *testing.T) {
 					name:                tc.inAppName,
 					domainName:          tc.inDomainName,
 					permissionsBoundary: tc.inPBPolicyName,
+					configStore:         tc.inConfigStore,
 				},
 			}
 
@@ -4,9 +4,12 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -15,47 +18,158 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/output"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
+type listAppVars struct {
+	local            bool
+	shouldOutputJSON bool
+}
+
 type listAppOpts struct {
-	store applicationLister
-	w     io.Writer
+	listAppVars
+
+	store          applicationLister
+	listWorkspaces func() ([]workspace.Local, error)
+	getWd          func() (string, error)
+	w              io.Writer
 }
 
-// Execute writes the existing applications.
+// Execute writes the existing applications, or the workspaces on the local file system with --local.
 func (o *listAppOpts) Execute() error {
+	if o.local {
+		return o.writeLocal()
+	}
 	apps, err := o.store.ListApplications()
 	if err != nil {
 		return fmt.Errorf("list applications: %w", err)
 	}
+	return output.New(o.w, o.shouldOutputJSON).Write(appList(apps))
+}
 
-	for _, app := range apps {
-		fmt.Fprintln(o.w, app.Name)
+func (o *listAppOpts) writeLocal() error {
+	locals, err := o.listWorkspaces()
+	if err != nil {
+		return fmt.Errorf("list local workspaces: %w", err)
+	}
+	wd, err := o.getWd()
+	if err != nil {
+		return fmt.Errorf("get working directory: %w", err)
 	}
+	workspaces := make(localWorkspaceList, len(locals))
+	for i, local := range locals {
+		path, err := filepath.Rel(wd, local.Dir)
+		if err != nil {
+			path = local.Dir
+		}
+		workspaces[i] = localWorkspace{
+			Application: local.Application,
+			Path:        path,
+		}
+	}
+	return output.New(o.w, o.shouldOutputJSON).Write(workspaces)
+}
 
-	return nil
+// appList is the applications in the account and region.
+type appList []*config.Application
+
+// HumanString returns the names of the applications, one per line.
+func (l appList) HumanString() string {
+	b := &strings.Builder{}
+	for _, app := range l {
+		fmt.Fprintln(b, app.Name)
+	}
+	return b.String()
+}
+
+// JSONString returns the applications as a JSON object.
+func (l appList) JSONString() (string, error) {
+	apps := []*config.Application(l)
+	if apps == nil {
+		apps = []*config.Application{}
+	}
+	b, err := json.Marshal(struct {
+		Applications []*config.Application `json:"applications"`
+	}{Applications: apps})
+	if err != nil {
+		return "", fmt.Errorf("marshal applications: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// localWorkspace is a workspace on the local file system, with its path relative to the working directory.
+type localWorkspace struct {
+	Application string `json:"application"`
+	Path        string `json:"path"`
+}
+
+type localWorkspaceList []localWorkspace
+
+// HumanString returns a table of the workspaces, or nothing if there are none.
+func (l localWorkspaceList) HumanString() string {
+	if len(l) == 0 {
+		return ""
+	}
+	rows := make([][]string, len(l))
+	for i, ws := range l {
+		rows[i] = []string{ws.Application, ws.Path}
+	}
+	b := &strings.Builder{}
+	writeTable(b, []string{"Name", "Path"}, rows)
+	return b.String()
+}
+
+// JSONString returns the workspaces as a JSON object.
+func (l localWorkspaceList) JSONString() (string, error) {
+	b, err := json.Marshal(struct {
+		Workspaces localWorkspaceList `json:"workspaces"`
+	}{Workspaces: l})
+	if err != nil {
+		return "", fmt.Errorf("marshal workspaces: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
 }
 
 // buildAppListCommand builds the command to list existing applications.
 func buildAppListCommand() *cobra.Command {
+	vars := listAppVars{}
 	cmd := &cobra.Command{
 		Use:   "ls",
 		Short: "Lists all the applications in your account.",
+		Long: `Lists all the applications in your account.
+With --local, lists the workspaces on the local file system instead, either the ones listed in the
+` + workspace.IndexFileName + ` file at the root of a monorepo, or the current workspace.`,
 		Example: `
   List all the applications in your account and region.
-  /code $ copilot app ls`,
+  /code $ copilot app ls
+  List the workspaces of the monorepo.
+  /code $ copilot app ls --local
+  List the applications as JSON.
+  /code $ copilot app ls --json`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.shouldOutputJSON = outputsJSON(cmd)
 			opts := listAppOpts{
-				w: os.Stdout,
+				listAppVars: vars,
+				listWorkspaces: func() ([]workspace.Local, error) {
+					return workspace.List(afero.NewOsFs())
+				},
+				getWd: os.Getwd,
+				w:     os.Stdout,
+			}
+			if opts.local {
+				return opts.Execute()
 			}
 			sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("app ls")).Default()
 			if err != nil {
 				return fmt.Errorf("default session: %v", err)
 			}
-			opts.store = config.NewSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region))
+			opts.store = config.NewCachedSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region))
 			return opts.Execute()
 		}),
 	}
+	cmd.Flags().BoolVar(&vars.local, localFlag, false, localAppListFlagDescription)
 	return cmd
 }
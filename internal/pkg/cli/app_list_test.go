@@ -7,10 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -20,11 +22,14 @@ func TestListAppOpts_Execute(t *testing.T) {
 	mockstore := mocks.NewMockstore(ctrl)
 	defer ctrl.Finish()
 	testError := errors.New("error fetching apps")
+	jsonOut := &strings.Builder{}
 
 	testCases := map[string]struct {
 		listOpts listAppOpts
 		mocking  func()
 		want     error
+
+		wantedJSON string
 	}{
 		"with applications": {
 			listOpts: listAppOpts{
@@ -42,6 +47,25 @@ func TestListAppOpts_Execute(t *testing.T) {
 					Times(1)
 			},
 		},
+		"with applications as JSON": {
+			listOpts: listAppOpts{
+				listAppVars: listAppVars{
+					shouldOutputJSON: true,
+				},
+				store: mockstore,
+				w:     jsonOut,
+			},
+			mocking: func() {
+				mockstore.
+					EXPECT().
+					ListApplications().
+					Return([]*config.Application{
+						{Name: "app1", AccountID: "1234"},
+					}, nil).
+					Times(1)
+			},
+			wantedJSON: `{"applications":[{"name":"app1","account":"1234","domain":"","domainHostedZoneID":"","version":""}]}` + "\n",
+		},
 		"with an error": {
 			listOpts: listAppOpts{
 				store: mockstore,
@@ -65,6 +89,85 @@ func TestListAppOpts_Execute(t *testing.T) {
 			got := tc.listOpts.Execute()
 
 			require.Equal(t, tc.want, got)
+			if tc.wantedJSON != "" {
+				require.Equal(t, tc.wantedJSON, jsonOut.String())
+			}
+		})
+	}
+}
+
+func TestListAppOpts_Execute_Local(t *testing.T) {
+	testCases := map[string]struct {
+		listWorkspaces func() ([]workspace.Local, error)
+		inJSON         bool
+
+		wanted      string
+		wantedError error
+	}{
+		"writes the workspaces relative to the working directory": {
+			listWorkspaces: func() ([]workspace.Local, error) {
+				return []workspace.Local{
+					{Application: "payments", Dir: "/monorepo/payments"},
+					{Application: "identity", Dir: "/monorepo/platform/identity"},
+				}, nil
+			},
+			wanted: `Name                Path
+----                ----
+payments            payments
+identity            platform/identity
+`,
+		},
+		"writes the workspaces as JSON": {
+			listWorkspaces: func() ([]workspace.Local, error) {
+				return []workspace.Local{
+					{Application: "payments", Dir: "/monorepo/payments"},
+				}, nil
+			},
+			inJSON: true,
+			wanted: `{"workspaces":[{"application":"payments","path":"payments"}]}` + "\n",
+		},
+		"writes an empty list as JSON without workspaces": {
+			listWorkspaces: func() ([]workspace.Local, error) {
+				return nil, nil
+			},
+			inJSON: true,
+			wanted: `{"workspaces":[]}` + "\n",
+		},
+		"writes nothing without workspaces": {
+			listWorkspaces: func() ([]workspace.Local, error) {
+				return nil, nil
+			},
+		},
+		"wraps the error from listing the workspaces": {
+			listWorkspaces: func() ([]workspace.Local, error) {
+				return nil, errors.New("some error")
+			},
+			wantedError: errors.New("list local workspaces: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			b := &strings.Builder{}
+			opts := listAppOpts{
+				listAppVars: listAppVars{
+					local:            true,
+					shouldOutputJSON: tc.inJSON,
+				},
+				listWorkspaces: tc.listWorkspaces,
+				getWd: func() (string, error) {
+					return "/monorepo", nil
+				},
+				w: b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, b.String())
 		})
 	}
 }
@@ -0,0 +1,166 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/lock"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appLocksNamePrompt        = "Which application's stack locks would you like to see?"
+	appLocksNameHelpPrompt    = "Deployments and deletions lock the stacks of an application while they run."
+	fmtAppLocksReleasePrompt  = "Are you sure you want to release the lock on stack %s held by %s?"
+	appLocksReleaseHelpPrompt = "Release the lock only if the command that holds it isn't running anymore."
+)
+
+type locksAppVars struct {
+	name             string
+	release          string
+	skipConfirmation bool
+}
+
+type locksAppOpts struct {
+	locksAppVars
+
+	store  store
+	sel    appSelector
+	prompt prompter
+	locks  stackLockManager
+	w      io.Writer
+	now    func() time.Time
+}
+
+func newLocksAppOpts(vars locksAppVars) (*locksAppOpts, error) {
+	defaultSess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("app locks")).Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	return &locksAppOpts{
+		locksAppVars: vars,
+		store:        store,
+		sel:          selector.NewAppEnvSelector(prompter, store),
+		prompt:       prompter,
+		locks:        newStackLocker(defaultSess, false, 0),
+		w:            os.Stdout,
+		now:          time.Now,
+	}, nil
+}
+
+// Validate is a no-op for this command.
+func (o *locksAppOpts) Validate() error {
+	return nil
+}
+
+// Ask validates the application name if passed in, otherwise it prompts for it.
+func (o *locksAppOpts) Ask() error {
+	if o.name != "" {
+		if _, err := o.store.GetApplication(o.name); err != nil {
+			return fmt.Errorf("validate application name %q: %w", o.name, err)
+		}
+		return nil
+	}
+	name, err := o.sel.Application(appLocksNamePrompt, appLocksNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute writes the held locks on the stacks of the application, or force-releases the lock on a stack.
+func (o *locksAppOpts) Execute() error {
+	locks, err := o.locks.List(o.name)
+	if err != nil {
+		return err
+	}
+	if o.release != "" {
+		return o.releaseLock(locks)
+	}
+	if len(locks) == 0 {
+		log.Infof("No stacks of application %s are locked.\n", color.HighlightUserInput(o.name))
+		return nil
+	}
+	rows := make([][]string, len(locks))
+	for i, l := range locks {
+		rows[i] = []string{l.Stack, l.Owner, l.Command, humanize.RelTime(l.AcquiredAt, o.now(), "ago", "from now")}
+	}
+	writeTable(o.w, []string{"Stack", "Owner", "Command", "Acquired"}, rows)
+	return nil
+}
+
+func (o *locksAppOpts) releaseLock(locks []lock.Lock) error {
+	var held *lock.Lock
+	for i := range locks {
+		if locks[i].Stack == o.release {
+			held = &locks[i]
+			break
+		}
+	}
+	if held == nil {
+		log.Infof("Stack %s isn't locked.\n", color.HighlightResource(o.release))
+		return nil
+	}
+	if !o.skipConfirmation {
+		confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtAppLocksReleasePrompt, color.HighlightResource(held.Stack), held.Owner), appLocksReleaseHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("confirm releasing the lock on stack %s: %w", held.Stack, err)
+		}
+		if !confirmed {
+			return nil
+		}
+	}
+	if err := o.locks.Release(o.name, held.Stack); err != nil {
+		return err
+	}
+	log.Successf("Released the lock on stack %s.\n", color.HighlightResource(held.Stack))
+	return nil
+}
+
+// buildAppLocksCmd builds the command to inspect and force-release the locks on the stacks of an application.
+func buildAppLocksCmd() *cobra.Command {
+	vars := locksAppVars{}
+	cmd := &cobra.Command{
+		Use:   "locks",
+		Short: "Lists or releases the locks on the stacks of an application.",
+		Long: `Lists or releases the locks on the stacks of an application.
+Deployments and deletions of services, jobs and environments lock their stack while they run,
+so that two of them don't update the same stack at the same time.
+A lock held by a command that was interrupted can be released with --release.`,
+
+		Example: `
+  List the locked stacks of the "my-app" application.
+  /code $ copilot app locks -n my-app
+  Release the lock on the stack of the "api" service in the "test" environment.
+  /code $ copilot app locks -n my-app --release my-app-test-api`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newLocksAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.release, releaseLockFlag, "", releaseLockFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
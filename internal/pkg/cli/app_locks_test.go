@@ -0,0 +1,112 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/lock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocksAppOpts_Execute(t *testing.T) {
+	now := time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)
+	heldLocks := []lock.Lock{
+		{
+			Stack:      "my-app-test-api",
+			Owner:      "alice@laptop",
+			Command:    "svc deploy",
+			AcquiredAt: now.Add(-2 * time.Hour),
+		},
+	}
+	testCases := map[string]struct {
+		inRelease          string
+		inSkipConfirmation bool
+		setupMocks         func(locks *mocks.MockstackLockManager, prompt *mocks.Mockprompter)
+
+		wanted      string
+		wantedError error
+	}{
+		"write the held locks": {
+			setupMocks: func(locks *mocks.MockstackLockManager, prompt *mocks.Mockprompter) {
+				locks.EXPECT().List("my-app").Return(heldLocks, nil)
+			},
+			wanted: `Stack               Owner               Command             Acquired
+-----               -----               -------             --------
+my-app-test-api     alice@laptop        svc deploy          2 hours ago
+`,
+		},
+		"wrap the error from listing the locks": {
+			setupMocks: func(locks *mocks.MockstackLockManager, prompt *mocks.Mockprompter) {
+				locks.EXPECT().List("my-app").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"release the lock after confirmation": {
+			inRelease: "my-app-test-api",
+			setupMocks: func(locks *mocks.MockstackLockManager, prompt *mocks.Mockprompter) {
+				locks.EXPECT().List("my-app").Return(heldLocks, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(true, nil)
+				locks.EXPECT().Release("my-app", "my-app-test-api").Return(nil)
+			},
+		},
+		"don't release the lock if not confirmed": {
+			inRelease: "my-app-test-api",
+			setupMocks: func(locks *mocks.MockstackLockManager, prompt *mocks.Mockprompter) {
+				locks.EXPECT().List("my-app").Return(heldLocks, nil)
+				prompt.EXPECT().Confirm(gomock.Any(), gomock.Any()).Return(false, nil)
+				locks.EXPECT().Release(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"release the lock without confirmation": {
+			inRelease:          "my-app-test-api",
+			inSkipConfirmation: true,
+			setupMocks: func(locks *mocks.MockstackLockManager, prompt *mocks.Mockprompter) {
+				locks.EXPECT().List("my-app").Return(heldLocks, nil)
+				locks.EXPECT().Release("my-app", "my-app-test-api").Return(nil)
+			},
+		},
+		"no-op if the stack isn't locked": {
+			inRelease: "my-app-test-web",
+			setupMocks: func(locks *mocks.MockstackLockManager, prompt *mocks.Mockprompter) {
+				locks.EXPECT().List("my-app").Return(heldLocks, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			locks := mocks.NewMockstackLockManager(ctrl)
+			prompt := mocks.NewMockprompter(ctrl)
+			tc.setupMocks(locks, prompt)
+			buf := new(strings.Builder)
+			opts := &locksAppOpts{
+				locksAppVars: locksAppVars{
+					name:             "my-app",
+					release:          tc.inRelease,
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				locks:  locks,
+				prompt: prompt,
+				w:      buf,
+				now:    func() time.Time { return now },
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, buf.String())
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appMigrateConfigNamePrompt     = "Which application's configuration would you like to migrate?"
+	appMigrateConfigNameHelpPrompt = "The configuration of the environments and workloads of the application is moved to the new store."
+)
+
+type migrateConfigAppVars struct {
+	name        string
+	configStore string
+}
+
+type migrateConfigAppOpts struct {
+	migrateConfigAppVars
+
+	store    store
+	migrator configStoreMigrator
+	sel      appSelector
+	prog     progress
+}
+
+func newMigrateConfigAppOpts(vars migrateConfigAppVars) (*migrateConfigAppOpts, error) {
+	defaultSess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("app migrate-config")).Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	return &migrateConfigAppOpts{
+		migrateConfigAppVars: vars,
+		store:                store,
+		migrator:             store,
+		sel:                  selector.NewAppEnvSelector(prompt.New(), store),
+		prog:                 termprogress.NewSpinner(log.DiagnosticWriter),
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *migrateConfigAppOpts) Validate() error {
+	if o.configStore == "" {
+		return fmt.Errorf("must specify --%s", configStoreFlag)
+	}
+	return validateConfigStore(o.configStore)
+}
+
+// Ask validates the application name if passed in, otherwise it prompts for it.
+func (o *migrateConfigAppOpts) Ask() error {
+	if o.name != "" {
+		if _, err := o.store.GetApplication(o.name); err != nil {
+			return fmt.Errorf("validate application name %q: %w", o.name, err)
+		}
+		return nil
+	}
+	name, err := o.sel.Application(appMigrateConfigNamePrompt, appMigrateConfigNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute moves the configuration of the environments and workloads of the application to the new store.
+func (o *migrateConfigAppOpts) Execute() error {
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %q: %w", o.name, err)
+	}
+	from := app.ConfigStore
+	if from == "" {
+		from = config.SSMBackend
+	}
+	if from == o.configStore {
+		log.Infof("Application %s already stores its configuration in %s.\n", color.HighlightUserInput(o.name), o.configStore)
+		return nil
+	}
+	o.prog.Start(fmt.Sprintf("Migrating the configuration of application %s from %s to %s", color.HighlightUserInput(o.name), from, o.configStore))
+	if err := o.migrator.MigrateApplication(o.name, o.configStore); err != nil {
+		o.prog.Stop(log.Serrorf("Failed to migrate the configuration of application %s.\n", color.HighlightUserInput(o.name)))
+		return fmt.Errorf("migrate configuration of application %q to %s: %w", o.name, o.configStore, err)
+	}
+	o.prog.Stop(log.Ssuccessf("Migrated the configuration of application %s to %s.\n", color.HighlightUserInput(o.name), o.configStore))
+	return nil
+}
+
+func validateConfigStore(backend string) error {
+	if backend == "" || contains(backend, config.Backends()) {
+		return nil
+	}
+	return fmt.Errorf("invalid config store %q: must be one of %s", backend, english.WordSeries(applyAll(config.Backends(), strconv.Quote), "or"))
+}
+
+// buildAppMigrateConfigCmd builds the command to move the configuration of an application to another store.
+func buildAppMigrateConfigCmd() *cobra.Command {
+	vars := migrateConfigAppVars{}
+	cmd := &cobra.Command{
+		Use:   "migrate-config",
+		Short: "Moves the configuration of an application's environments and workloads to another store.",
+		Long: `Moves the configuration of an application's environments and workloads to another store.
+The configuration is copied to the new store before the application is switched to it,
+and only then deleted from the previous store. An interrupted migration can be run again.`,
+
+		Example: `
+  Store the configuration of the "my-app" application in DynamoDB.
+  /code $ copilot app migrate-config -n my-app --config-store dynamodb
+  Move the configuration of the "my-app" application back to SSM Parameter Store.
+  /code $ copilot app migrate-config -n my-app --config-store ssm`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newMigrateConfigAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.configStore, configStoreFlag, "", migrateConfigStoreFlagDescription)
+	return cmd
+}
@@ -0,0 +1,112 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateConfigAppOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inConfigStore string
+
+		wantedError error
+	}{
+		"error if the store is missing": {
+			wantedError: errors.New("must specify --config-store"),
+		},
+		"error if the store is unknown": {
+			inConfigStore: "s3",
+			wantedError:   errors.New(`invalid config store "s3": must be one of "ssm" or "dynamodb"`),
+		},
+		"valid store": {
+			inConfigStore: "dynamodb",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &migrateConfigAppOpts{
+				migrateConfigAppVars: migrateConfigAppVars{
+					configStore: tc.inConfigStore,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMigrateConfigAppOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inConfigStore string
+		setupMocks    func(store *mocks.Mockstore, migrator *mocks.MockconfigStoreMigrator, prog *mocks.Mockprogress)
+
+		wantedError error
+	}{
+		"no-op if the application already uses the store": {
+			inConfigStore: "ssm",
+			setupMocks: func(store *mocks.Mockstore, migrator *mocks.MockconfigStoreMigrator, prog *mocks.Mockprogress) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+		},
+		"migrate the configuration to the store": {
+			inConfigStore: "dynamodb",
+			setupMocks: func(store *mocks.Mockstore, migrator *mocks.MockconfigStoreMigrator, prog *mocks.Mockprogress) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				prog.EXPECT().Start(gomock.Any())
+				migrator.EXPECT().MigrateApplication("my-app", "dynamodb").Return(nil)
+				prog.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"wrap the error from the migration": {
+			inConfigStore: "ssm",
+			setupMocks: func(store *mocks.Mockstore, migrator *mocks.MockconfigStoreMigrator, prog *mocks.Mockprogress) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app", ConfigStore: "dynamodb"}, nil)
+				prog.EXPECT().Start(gomock.Any())
+				migrator.EXPECT().MigrateApplication("my-app", "ssm").Return(errors.New("some error"))
+				prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: errors.New(`migrate configuration of application "my-app" to ssm: some error`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			migrator := mocks.NewMockconfigStoreMigrator(ctrl)
+			prog := mocks.NewMockprogress(ctrl)
+			tc.setupMocks(store, migrator, prog)
+			opts := &migrateConfigAppOpts{
+				migrateConfigAppVars: migrateConfigAppVars{
+					name:        "my-app",
+					configStore: tc.inConfigStore,
+				},
+				store:    store,
+				migrator: migrator,
+				prog:     prog,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
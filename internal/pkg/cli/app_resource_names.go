@@ -0,0 +1,196 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	appResourceNamesNamePrompt     = "Which application's resource names would you like to manage?"
+	appResourceNamesNameHelpPrompt = "Resource names are the templates of the physical names of the clusters, load balancers, log groups and repositories of an application."
+)
+
+type resourceNamesAppVars struct {
+	name   string
+	file   string
+	remove bool
+}
+
+type resourceNamesAppOpts struct {
+	resourceNamesAppVars
+
+	store store
+	sel   appSelector
+	fs    afero.Fs
+	w     io.Writer
+}
+
+func newResourceNamesAppOpts(vars resourceNamesAppVars) (*resourceNamesAppOpts, error) {
+	defaultSess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("app resource-names")).Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	return &resourceNamesAppOpts{
+		resourceNamesAppVars: vars,
+		store:                store,
+		sel:                  selector.NewAppEnvSelector(prompt.New(), store),
+		fs:                   afero.NewOsFs(),
+		w:                    os.Stdout,
+	}, nil
+}
+
+// Validate returns an error if both a file and --remove are provided.
+func (o *resourceNamesAppOpts) Validate() error {
+	if o.file != "" && o.remove {
+		return fmt.Errorf("cannot specify both --%s and --%s", resourceNamesFileFlag, removeFlag)
+	}
+	return nil
+}
+
+// Ask validates the application name if passed in, otherwise it prompts for the application.
+func (o *resourceNamesAppOpts) Ask() error {
+	if o.name != "" {
+		if _, err := o.store.GetApplication(o.name); err != nil {
+			return fmt.Errorf("validate application name %q: %w", o.name, err)
+		}
+		return nil
+	}
+	name, err := o.sel.Application(appResourceNamesNamePrompt, appResourceNamesNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute writes the resource name templates of the application, or replaces them with the ones in the file.
+func (o *resourceNamesAppOpts) Execute() error {
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	if o.file == "" && !o.remove {
+		return o.writeNames(app.ResourceNames)
+	}
+	var names *config.ResourceNames
+	if o.file != "" {
+		if names, err = o.readNames(); err != nil {
+			return err
+		}
+	}
+	if err := o.validateUnused(); err != nil {
+		return err
+	}
+	if names.IsEmpty() {
+		names = nil
+	}
+	app.ResourceNames = names
+	if err := o.store.UpdateApplication(app); err != nil {
+		return fmt.Errorf("update resource names of application %s: %w", o.name, err)
+	}
+	if names == nil {
+		log.Successf("Removed the resource names of application %s.\n", color.HighlightUserInput(o.name))
+		return nil
+	}
+	log.Successf("Updated the resource names of application %s.\n", color.HighlightUserInput(o.name))
+	return nil
+}
+
+func (o *resourceNamesAppOpts) readNames() (*config.ResourceNames, error) {
+	raw, err := afero.ReadFile(o.fs, o.file)
+	if err != nil {
+		return nil, fmt.Errorf("read resource names file: %w", err)
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(raw))
+	dec.KnownFields(true)
+	names := &config.ResourceNames{}
+	if err := dec.Decode(names); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("unmarshal resource names file %s: %w", o.file, err)
+	}
+	if err := names.Validate(); err != nil {
+		return nil, fmt.Errorf("validate resource names file %s: %w", o.file, err)
+	}
+	return names, nil
+}
+
+// validateUnused returns an error if the application already has environments or workloads,
+// since renaming their resources would make CloudFormation replace them.
+func (o *resourceNamesAppOpts) validateUnused() error {
+	envs, err := o.store.ListEnvironments(o.name)
+	if err != nil {
+		return fmt.Errorf("list environments of application %s: %w", o.name, err)
+	}
+	wklds, err := o.store.ListWorkloads(o.name)
+	if err != nil {
+		return fmt.Errorf("list workloads of application %s: %w", o.name, err)
+	}
+	if len(envs) == 0 && len(wklds) == 0 {
+		return nil
+	}
+	return fmt.Errorf("cannot change the resource names of application %s because it has %d environments and %d workloads: renaming their resources would replace them", o.name, len(envs), len(wklds))
+}
+
+func (o *resourceNamesAppOpts) writeNames(names *config.ResourceNames) error {
+	if names.IsEmpty() {
+		log.Infof("Application %s uses the default resource names.\n", color.HighlightUserInput(o.name))
+		return nil
+	}
+	out, err := yaml.Marshal(names)
+	if err != nil {
+		return fmt.Errorf("marshal resource names: %w", err)
+	}
+	_, err = o.w.Write(out)
+	return err
+}
+
+// buildAppResourceNamesCmd builds the command to manage the resource name templates of an application.
+func buildAppResourceNamesCmd() *cobra.Command {
+	vars := resourceNamesAppVars{}
+	cmd := &cobra.Command{
+		Use:   "resource-names",
+		Short: "Shows or sets the templates of the physical names of the resources of an application.",
+		Long: `Shows or sets the templates of the physical names of the resources of an application.
+The templates name the clusters and load balancers of the environments, and the log groups and
+ECR repositories of the workloads, with the ${app}, ${env} and ${name} placeholders.
+They can only be changed before the application has any environment or workload.`,
+
+		Example: `
+  Show the resource names of the "my-app" application.
+  /code $ copilot app resource-names -n my-app
+  Set the resource names of the "my-app" application.
+  /code $ copilot app resource-names -n my-app --file names.yml
+  Go back to the default resource names.
+  /code $ copilot app resource-names -n my-app --remove`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newResourceNamesAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.file, resourceNamesFileFlag, "", resourceNamesFileFlagDescription)
+	cmd.Flags().BoolVar(&vars.remove, removeFlag, false, removeResourceNamesFlagDescription)
+	return cmd
+}
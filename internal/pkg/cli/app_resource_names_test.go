@@ -0,0 +1,123 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceNamesAppOpts_Execute(t *testing.T) {
+	const namesYAML = `cluster: ecs-${app}-${env}
+log_group: /org/${app}/${env}/${name}
+`
+	names := &config.ResourceNames{
+		Cluster:  "ecs-${app}-${env}",
+		LogGroup: "/org/${app}/${env}/${name}",
+	}
+	testCases := map[string]struct {
+		inFile     string
+		inRemove   bool
+		inFiles    map[string]string
+		setupMocks func(store *mocks.Mockstore)
+
+		wanted      string
+		wantedError error
+	}{
+		"write the resource names of the application": {
+			setupMocks: func(store *mocks.Mockstore) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:          "my-app",
+					ResourceNames: names,
+				}, nil)
+			},
+			wanted: namesYAML,
+		},
+		"set the resource names from the file": {
+			inFile:  "names.yml",
+			inFiles: map[string]string{"names.yml": namesYAML},
+			setupMocks: func(store *mocks.Mockstore) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+				store.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				store.EXPECT().ListWorkloads("my-app").Return(nil, nil)
+				store.EXPECT().UpdateApplication(&config.Application{
+					Name:          "my-app",
+					ResourceNames: names,
+				}).Return(nil)
+			},
+		},
+		"reject an invalid template": {
+			inFile:  "names.yml",
+			inFiles: map[string]string{"names.yml": "repository: ${app}\n"},
+			setupMocks: func(store *mocks.Mockstore) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+			wantedError: errors.New(`validate resource names file names.yml: validate "repository": "${app}" must contain ${name} so that names don't collide`),
+		},
+		"refuse to rename the resources of an application in use": {
+			inRemove: true,
+			setupMocks: func(store *mocks.Mockstore) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:          "my-app",
+					ResourceNames: names,
+				}, nil)
+				store.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{{Name: "test"}}, nil)
+				store.EXPECT().ListWorkloads("my-app").Return(nil, nil)
+			},
+			wantedError: errors.New("cannot change the resource names of application my-app because it has 1 environments and 0 workloads: renaming their resources would replace them"),
+		},
+		"remove the resource names of the application": {
+			inRemove: true,
+			setupMocks: func(store *mocks.Mockstore) {
+				store.EXPECT().GetApplication("my-app").Return(&config.Application{
+					Name:          "my-app",
+					ResourceNames: names,
+				}, nil)
+				store.EXPECT().ListEnvironments("my-app").Return(nil, nil)
+				store.EXPECT().ListWorkloads("my-app").Return(nil, nil)
+				store.EXPECT().UpdateApplication(&config.Application{Name: "my-app"}).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			tc.setupMocks(store)
+			fs := afero.NewMemMapFs()
+			for path, content := range tc.inFiles {
+				require.NoError(t, afero.WriteFile(fs, path, []byte(content), 0644))
+			}
+			b := &strings.Builder{}
+			opts := &resourceNamesAppOpts{
+				resourceNamesAppVars: resourceNamesAppVars{
+					name:   "my-app",
+					file:   tc.inFile,
+					remove: tc.inRemove,
+				},
+				store: store,
+				fs:    fs,
+				w:     b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, b.String())
+		})
+	}
+}
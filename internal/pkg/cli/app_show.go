@@ -21,6 +21,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/output"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"golang.org/x/sync/errgroup"
@@ -39,6 +40,7 @@ const (
 type showAppVars struct {
 	name             string
 	shouldOutputJSON bool
+	shouldOutputCost bool
 }
 
 type showAppOpts struct {
@@ -51,6 +53,7 @@ type showAppOpts struct {
 	codepipeline     pipelineGetter
 	pipelineLister   deployedPipelineLister
 	newVersionGetter func(string) (versionGetter, error)
+	newCostEstimator func(string) (costEstimator, error)
 }
 
 func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
@@ -59,7 +62,7 @@ func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
 	if err != nil {
 		return nil, fmt.Errorf("default session: %w", err)
 	}
-	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	store := config.NewCachedSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
 	deployStore, err := deploy.NewStore(sessProvider, store)
 	if err != nil {
 		return nil, fmt.Errorf("connect to deploy store: %w", err)
@@ -79,6 +82,13 @@ func newShowAppOpts(vars showAppVars) (*showAppOpts, error) {
 			}
 			return d, nil
 		},
+		newCostEstimator: func(s string) (costEstimator, error) {
+			return describe.NewCostEstimator(describe.NewCostEstimatorConfig{
+				App:         s,
+				ConfigStore: store,
+				DeployStore: deployStore,
+			})
+		},
 	}, nil
 }
 
@@ -105,21 +115,34 @@ func (o *showAppOpts) Ask() error {
 
 // Execute writes the application's description.
 func (o *showAppOpts) Execute() error {
+	if o.shouldOutputCost {
+		return o.writeCost()
+	}
 	description, err := o.description()
 	if err != nil {
 		return err
 	}
-	if !o.shouldOutputJSON {
-		fmt.Fprint(o.w, description.HumanString())
-		return nil
+	if err := output.New(o.w, o.shouldOutputJSON).Write(description); err != nil {
+		return fmt.Errorf("write description of application %s: %w", o.name, err)
 	}
-	data, err := description.JSONString()
+	return nil
+}
+
+func (o *showAppOpts) writeCost() error {
+	estimator, err := o.newCostEstimator(o.name)
 	if err != nil {
-		return fmt.Errorf("get JSON string: %w", err)
+		return err
+	}
+	estimate, err := estimator.Application()
+	if err != nil {
+		return fmt.Errorf("estimate cost of application %s: %w", o.name, err)
+	}
+	if err := output.New(o.w, o.shouldOutputJSON).Write(estimate); err != nil {
+		return fmt.Errorf("write cost estimate of application %s: %w", o.name, err)
 	}
-	fmt.Fprint(o.w, data)
 	return nil
 }
+
 func (o *showAppOpts) populateDeployedWorkloads(listWorkloads func(app, env string) ([]string, error), deployedEnvsFor map[string][]string, env string, lock sync.Locker) error {
 	deployedworkload, err := listWorkloads(o.name, env)
 	if err != nil {
@@ -250,7 +273,9 @@ func buildAppShowCmd() *cobra.Command {
 		Long:  "Shows configuration, environments and services for an application.",
 		Example: `
   Shows info about the application "my-app"
-  /code $ copilot app show -n my-app`,
+  /code $ copilot app show -n my-app
+  Shows the estimated monthly cost of each environment of "my-app"
+  /code $ copilot app show -n my-app --cost`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newShowAppOpts(vars)
 			if err != nil {
@@ -262,5 +287,6 @@ func buildAppShowCmd() *cobra.Command {
 	// The flags bound by viper are available to all sub-commands through viper.GetString({flagName})
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputCost, costFlag, false, appCostFlagDescription)
 	return cmd
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -24,6 +25,7 @@ type showAppMocks struct {
 	pipelineGetter *mocks.MockpipelineGetter
 	pipelineLister *mocks.MockdeployedPipelineLister
 	versionGetter  *mocks.MockversionGetter
+	costEstimator  *mocks.MockcostEstimator
 }
 
 func TestShowAppOpts_Validate(t *testing.T) {
@@ -178,6 +180,7 @@ func TestShowAppOpts_Execute(t *testing.T) {
 	testError := errors.New("some error")
 	testCases := map[string]struct {
 		shouldOutputJSON bool
+		shouldOutputCost bool
 
 		setupMocks func(mocks showAppMocks)
 
@@ -815,6 +818,42 @@ Pipelines
 			},
 			wantedError: fmt.Errorf("get version for application %s: %w", "my-app", testError),
 		},
+		"shows the estimated cost as json": {
+			shouldOutputJSON: true,
+			shouldOutputCost: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.costEstimator.EXPECT().Application().Return(&describe.CostEstimate{
+					Currency: "USD",
+					Environments: []*describe.EnvCost{
+						{
+							Environment: "test",
+							Region:      "us-west-2",
+							Services: []*describe.WorkloadCost{
+								{
+									Name: "my-svc",
+									Resources: []*describe.ResourceCost{
+										{Resource: "Fargate vCPU", Quantity: 0.25, Unit: "vCPU", MonthlyCost: 7.39},
+									},
+									Total: 7.39,
+								},
+							},
+							Total: 7.39,
+						},
+					},
+					Total: 7.39,
+				}, nil)
+			},
+			wantedContent: `{"currency":"USD","environments":[{"environment":"test","region":"us-west-2","services":[{"name":"my-svc","resources":[{"resource":"Fargate vCPU","quantity":0.25,"unit":"vCPU","monthlyCost":7.39}],"total":7.39}],"total":7.39}],"total":7.39}` + "\n",
+		},
+		"returns wrapped error if fail to estimate the cost": {
+			shouldOutputCost: true,
+
+			setupMocks: func(m showAppMocks) {
+				m.costEstimator.EXPECT().Application().Return(nil, testError)
+			},
+			wantedError: fmt.Errorf("estimate cost of application my-app: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -828,6 +867,7 @@ Pipelines
 			mockVersionGetter := mocks.NewMockversionGetter(ctrl)
 			mockPipelineLister := mocks.NewMockdeployedPipelineLister(ctrl)
 			mockDeployStore := mocks.NewMockdeployedEnvironmentLister(ctrl)
+			mockCostEstimator := mocks.NewMockcostEstimator(ctrl)
 
 			mocks := showAppMocks{
 				storeSvc:       mockStoreReader,
@@ -835,12 +875,14 @@ Pipelines
 				versionGetter:  mockVersionGetter,
 				pipelineLister: mockPipelineLister,
 				deployStore:    mockDeployStore,
+				costEstimator:  mockCostEstimator,
 			}
 			tc.setupMocks(mocks)
 
 			opts := &showAppOpts{
 				showAppVars: showAppVars{
 					shouldOutputJSON: tc.shouldOutputJSON,
+					shouldOutputCost: tc.shouldOutputCost,
 					name:             mockAppName,
 				},
 				store:          mockStoreReader,
@@ -851,6 +893,9 @@ Pipelines
 				newVersionGetter: func(s string) (versionGetter, error) {
 					return mockVersionGetter, nil
 				},
+				newCostEstimator: func(s string) (costEstimator, error) {
+					return mockCostEstimator, nil
+				},
 			}
 
 			// WHEN
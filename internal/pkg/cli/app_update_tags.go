@@ -0,0 +1,227 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appUpdateTagsNamePrompt     = "Which application would you like to update the tags of?"
+	appUpdateTagsNameHelpPrompt = "The tags are applied to the application, environment, service and job stacks and their resources."
+
+	reservedTagKeyPrefix = "copilot-"
+)
+
+type updateTagsAppVars struct {
+	name         string
+	resourceTags map[string]string
+	removeTags   []string
+}
+
+type updateTagsAppOpts struct {
+	updateTagsAppVars
+
+	store          store
+	sel            appSelector
+	appTagsUpdater appTagsUpdater
+
+	newVersionGetter    func(appName string) (versionGetter, error)
+	newEnvStacksUpdater func(env *config.Environment) (envStacksTagsUpdater, error)
+}
+
+func newUpdateTagsAppOpts(vars updateTagsAppVars) (*updateTagsAppOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("app update-tags"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	return &updateTagsAppOpts{
+		updateTagsAppVars: vars,
+		store:             store,
+		sel:               selector.NewAppEnvSelector(prompt.New(), store),
+		appTagsUpdater:    cloudformation.New(defaultSess, cloudformation.WithProgressTracker(os.Stderr)),
+		newVersionGetter: func(appName string) (versionGetter, error) {
+			d, err := describe.NewAppDescriber(appName)
+			if err != nil {
+				return nil, fmt.Errorf("new describer for application %q: %w", appName, err)
+			}
+			return d, nil
+		},
+		newEnvStacksUpdater: func(env *config.Environment) (envStacksTagsUpdater, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return cloudformation.New(sess, cloudformation.WithProgressTracker(os.Stderr)), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *updateTagsAppOpts) Validate() error {
+	if len(o.resourceTags) == 0 && len(o.removeTags) == 0 {
+		return fmt.Errorf("must specify at least one of --%s or --%s", resourceTagsFlag, removeTagsFlag)
+	}
+	for key := range o.resourceTags {
+		if err := validateAppTagKey(key); err != nil {
+			return err
+		}
+	}
+	for _, key := range o.removeTags {
+		if err := validateAppTagKey(key); err != nil {
+			return err
+		}
+		if _, ok := o.resourceTags[key]; ok {
+			return fmt.Errorf("tag %q cannot be both added and removed", key)
+		}
+	}
+	return nil
+}
+
+// Ask validates the application name if passed in, otherwise it prompts for it.
+func (o *updateTagsAppOpts) Ask() error {
+	if o.name != "" {
+		if _, err := o.store.GetApplication(o.name); err != nil {
+			return fmt.Errorf("validate application name %q: %w", o.name, err)
+		}
+		return nil
+	}
+	name, err := o.sel.Application(appUpdateTagsNamePrompt, appUpdateTagsNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute saves the new tags of the application, then updates the application stack and stack set
+// followed by every stack deployed in the environments of the application.
+func (o *updateTagsAppOpts) Execute() error {
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	tags := make(map[string]string)
+	for key, val := range app.Tags {
+		tags[key] = val
+	}
+	for _, key := range o.removeTags {
+		delete(tags, key)
+	}
+	for key, val := range o.resourceTags {
+		tags[key] = val
+	}
+
+	vg, err := o.newVersionGetter(o.name)
+	if err != nil {
+		return err
+	}
+	appVersion, err := vg.Version()
+	if err != nil {
+		return fmt.Errorf("get template version of application %s: %w", o.name, err)
+	}
+	if err := o.appTagsUpdater.UpdateApplicationTags(&deploy.CreateAppInput{
+		Name:                o.name,
+		AccountID:           app.AccountID,
+		DomainName:          app.Domain,
+		DomainHostedZoneID:  app.DomainHostedZoneID,
+		PermissionsBoundary: app.PermissionsBoundary,
+		AdditionalTags:      tags,
+		Version:             appVersion,
+	}); err != nil {
+		return fmt.Errorf("update tags of application %s: %w", o.name, err)
+	}
+	app.Tags = tags
+	if err := o.store.UpdateApplication(app); err != nil {
+		return fmt.Errorf("update application %s: %w", o.name, err)
+	}
+
+	envs, err := o.store.ListEnvironments(o.name)
+	if err != nil {
+		return fmt.Errorf("list environments of application %s: %w", o.name, err)
+	}
+	for _, env := range envs {
+		if err := o.updateEnvStacks(env, tags); err != nil {
+			return err
+		}
+	}
+	log.Successf("Updated the tags of application %s and its environments.\n", color.HighlightUserInput(o.name))
+	return nil
+}
+
+func (o *updateTagsAppOpts) updateEnvStacks(env *config.Environment, tags map[string]string) error {
+	updater, err := o.newEnvStacksUpdater(env)
+	if err != nil {
+		return err
+	}
+	stacks, err := updater.ListEnvironmentStacks(o.name, env.Name)
+	if err != nil {
+		return err
+	}
+	for _, stackName := range stacks {
+		if err := updater.UpdateStackTags(stackName, tags, o.removeTags); err != nil {
+			return fmt.Errorf("update tags of stack %s in environment %s: %w", stackName, env.Name, err)
+		}
+	}
+	return nil
+}
+
+func validateAppTagKey(key string) error {
+	if key == "" {
+		return errors.New("tag key cannot be empty")
+	}
+	if strings.HasPrefix(key, reservedTagKeyPrefix) {
+		return fmt.Errorf("tag key %q cannot start with %q since it is reserved by Copilot", key, reservedTagKeyPrefix)
+	}
+	return nil
+}
+
+// buildAppUpdateTagsCmd builds the command to update the tags of an application and its deployed stacks.
+func buildAppUpdateTagsCmd() *cobra.Command {
+	vars := updateTagsAppVars{}
+	cmd := &cobra.Command{
+		Use:   "update-tags",
+		Short: "Updates the resource tags of an application and everything deployed in it.",
+		Long: `Updates the resource tags of an application and everything deployed in it.
+The tags are applied to the application, environment, service, job and task stacks,
+and CloudFormation propagates them to the resources of each stack.
+Services and jobs deployed afterwards are tagged with the new tags as well.`,
+
+		Example: `
+  Add the "team" tag to the "my-app" application.
+  /code $ copilot app update-tags -n my-app --resource-tags team=payments
+  Remove the "cost-center" tag from the "my-app" application.
+  /code $ copilot app update-tags -n my-app --remove-tags cost-center`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newUpdateTagsAppOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, appUpdateTagsResourceTagsFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.removeTags, removeTagsFlag, nil, appUpdateTagsRemoveTagsFlagDescription)
+	return cmd
+}
@@ -0,0 +1,221 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateTagsAppOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inResourceTags map[string]string
+		inRemoveTags   []string
+
+		wantedError error
+	}{
+		"error if no tags are added or removed": {
+			wantedError: errors.New("must specify at least one of --resource-tags or --remove-tags"),
+		},
+		"error if a tag key is reserved": {
+			inResourceTags: map[string]string{"copilot-service": "api"},
+			wantedError:    errors.New(`tag key "copilot-service" cannot start with "copilot-" since it is reserved by Copilot`),
+		},
+		"error if a removed tag key is reserved": {
+			inRemoveTags: []string{"copilot-application"},
+			wantedError:  errors.New(`tag key "copilot-application" cannot start with "copilot-" since it is reserved by Copilot`),
+		},
+		"error if a tag is both added and removed": {
+			inResourceTags: map[string]string{"team": "payments"},
+			inRemoveTags:   []string{"team"},
+			wantedError:    errors.New(`tag "team" cannot be both added and removed`),
+		},
+		"valid tags": {
+			inResourceTags: map[string]string{"team": "payments"},
+			inRemoveTags:   []string{"cost-center"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &updateTagsAppOpts{
+				updateTagsAppVars: updateTagsAppVars{
+					resourceTags: tc.inResourceTags,
+					removeTags:   tc.inRemoveTags,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdateTagsAppOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockappSelector)
+
+		wantedAppName string
+		wantedError   error
+	}{
+		"validate the application name": {
+			inAppName: "phonetool",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockappSelector) {
+				store.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New(`validate application name "phonetool": some error`),
+		},
+		"prompt for the application name": {
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockappSelector) {
+				sel.EXPECT().Application(appUpdateTagsNamePrompt, appUpdateTagsNameHelpPrompt).Return("phonetool", nil)
+			},
+			wantedAppName: "phonetool",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockappSelector(ctrl)
+			tc.setupMocks(store, sel)
+			opts := &updateTagsAppOpts{
+				updateTagsAppVars: updateTagsAppVars{
+					name: tc.inAppName,
+				},
+				store: store,
+				sel:   sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedAppName, opts.name)
+			}
+		})
+	}
+}
+
+func TestUpdateTagsAppOpts_Execute(t *testing.T) {
+	mockApp := func() *config.Application {
+		return &config.Application{
+			Name:      "phonetool",
+			AccountID: "123456789012",
+			Tags: map[string]string{
+				"team":        "growth",
+				"cost-center": "1234",
+			},
+		}
+	}
+	wantedTags := map[string]string{
+		"team":  "payments",
+		"owner": "alice",
+	}
+	testEnv := &config.Environment{Name: "test"}
+	type updateTagsAppMocks struct {
+		store      *mocks.Mockstore
+		appUpdater *mocks.MockappTagsUpdater
+		envUpdater *mocks.MockenvStacksTagsUpdater
+	}
+	testCases := map[string]struct {
+		setupMocks func(m updateTagsAppMocks)
+
+		wantedError error
+	}{
+		"error if the application stack fails to update": {
+			setupMocks: func(m updateTagsAppMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp(), nil)
+				m.appUpdater.EXPECT().UpdateApplicationTags(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("update tags of application phonetool: some error"),
+		},
+		"error if a stack in an environment fails to update": {
+			setupMocks: func(m updateTagsAppMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp(), nil)
+				m.appUpdater.EXPECT().UpdateApplicationTags(gomock.Any()).Return(nil)
+				m.store.EXPECT().UpdateApplication(gomock.Any()).Return(nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv}, nil)
+				m.envUpdater.EXPECT().ListEnvironmentStacks("phonetool", "test").Return([]string{"phonetool-test"}, nil)
+				m.envUpdater.EXPECT().UpdateStackTags("phonetool-test", gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("update tags of stack phonetool-test in environment test: some error"),
+		},
+		"updates the tags of the application and of every stack in its environments": {
+			setupMocks: func(m updateTagsAppMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp(), nil)
+				m.appUpdater.EXPECT().UpdateApplicationTags(&deploy.CreateAppInput{
+					Name:           "phonetool",
+					AccountID:      "123456789012",
+					AdditionalTags: wantedTags,
+					Version:        "v1.2.0",
+				}).Return(nil)
+				m.store.EXPECT().UpdateApplication(&config.Application{
+					Name:      "phonetool",
+					AccountID: "123456789012",
+					Tags:      wantedTags,
+				}).Return(nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv}, nil)
+				m.envUpdater.EXPECT().ListEnvironmentStacks("phonetool", "test").Return([]string{"phonetool-test", "phonetool-test-api"}, nil)
+				m.envUpdater.EXPECT().UpdateStackTags("phonetool-test", wantedTags, []string{"cost-center"}).Return(nil)
+				m.envUpdater.EXPECT().UpdateStackTags("phonetool-test-api", wantedTags, []string{"cost-center"}).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := updateTagsAppMocks{
+				store:      mocks.NewMockstore(ctrl),
+				appUpdater: mocks.NewMockappTagsUpdater(ctrl),
+				envUpdater: mocks.NewMockenvStacksTagsUpdater(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &updateTagsAppOpts{
+				updateTagsAppVars: updateTagsAppVars{
+					name:         "phonetool",
+					resourceTags: map[string]string{"team": "payments", "owner": "alice"},
+					removeTags:   []string{"cost-center"},
+				},
+				store:          m.store,
+				appTagsUpdater: m.appUpdater,
+				newVersionGetter: func(string) (versionGetter, error) {
+					return &versionGetterDouble{
+						VersionFn: func() (string, error) {
+							return "v1.2.0", nil
+						},
+					}, nil
+				},
+				newEnvStacksUpdater: func(*config.Environment) (envStacksTagsUpdater, error) {
+					return m.envUpdater, nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -38,6 +38,7 @@ const (
 // appUpgradeVars holds flag values.
 type appUpgradeVars struct {
 	name string
+	imageRetentionVars
 }
 
 // appUpgradeOpts represents the app upgrade command and holds the necessary data
@@ -82,6 +83,9 @@ func newAppUpgradeOpts(vars appUpgradeVars) (*appUpgradeOpts, error) {
 
 // Validate returns an error if the values provided by the user are invalid.
 func (o *appUpgradeOpts) Validate() error {
+	if err := o.imageRetentionVars.validate(); err != nil {
+		return err
+	}
 	if o.name != "" {
 		_, err := o.store.GetApplication(o.name)
 		if err != nil {
@@ -111,7 +115,7 @@ func (o *appUpgradeOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("get template version of application %s: %v", o.name, err)
 	}
-	if !o.shouldUpgradeApp(appVersion) {
+	if !o.shouldUpgradeApp(appVersion) && !o.shouldUpdateImageRetention(appVersion) {
 		return nil
 	}
 	app, err := o.store.GetApplication(o.name)
@@ -164,11 +168,18 @@ Are you using the latest version of AWS Copilot?`, o.name, o.templateVersion, ap
 	return false
 }
 
+// shouldUpdateImageRetention returns true if the image retention settings should be applied to an application
+// that is already on the latest version.
+func (o *appUpgradeOpts) shouldUpdateImageRetention(appVersion string) bool {
+	return o.imageRetentionVars.isSet() && semver.Compare(appVersion, o.templateVersion) == 0
+}
+
 func (o *appUpgradeOpts) upgradeApplication(app *config.Application, fromVersion, toVersion string) error {
 	caller, err := o.identity.Get()
 	if err != nil {
 		return fmt.Errorf("get identity: %w", err)
 	}
+	app.ImageRetention = o.applyTo(app.ImageRetention)
 	// Upgrade SSM Parameter Store record.
 	if err := o.upgradeAppSSMStore(app); err != nil {
 		return err
@@ -180,6 +191,7 @@ func (o *appUpgradeOpts) upgradeApplication(app *config.Application, fromVersion
 		DomainName:         app.Domain,
 		DomainHostedZoneID: app.DomainHostedZoneID,
 		Version:            toVersion,
+		ImageRetention:     app.ImageRetention,
 	}); err != nil {
 		return fmt.Errorf("upgrade application %s from version %s to version %s: %v", app.Name, fromVersion, toVersion, err)
 	}
@@ -208,7 +220,9 @@ func buildAppUpgradeCmd() *cobra.Command {
 		Short: "Upgrades the template of an application to the latest version.",
 		Example: `
     Upgrade the application "my-app" to the latest version
-    /code $ copilot app upgrade -n my-app`,
+    /code $ copilot app upgrade -n my-app
+    Apply a lifecycle policy to the existing ECR repositories of the application "my-app"
+    /code $ copilot app upgrade -n my-app --keep-images 50`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newAppUpgradeOpts(vars)
 			if err != nil {
@@ -218,5 +232,8 @@ func buildAppUpgradeCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().IntVar(&vars.keepImages, keepImagesFlag, 0, appKeepImagesFlagDescription)
+	cmd.Flags().IntVar(&vars.untaggedExpiryDays, untaggedImageExpiryFlag, 0, appUntaggedImageExpiryFlagDescription)
+	cmd.Flags().BoolVar(&vars.immutableTags, immutableTagsFlag, false, appImmutableTagsFlagDescription)
 	return cmd
 }
@@ -332,6 +332,57 @@ func TestAppUpgradeOpts_Execute(t *testing.T) {
 				}
 			},
 		},
+		"apply the image retention settings to an up-to-date application": {
+			given: func(ctrl *gomock.Controller) *appUpgradeOpts {
+				mockIdentity := mocks.NewMockidentityService(ctrl)
+				mockIdentity.EXPECT().Get().Return(identity.Caller{Account: "1234"}, nil)
+
+				mockStore := mocks.NewMockstore(ctrl)
+				mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{
+					Name: "phonetool",
+					ImageRetention: &config.ImageRetention{
+						UntaggedExpiryDays: 7,
+					},
+				}, nil)
+				mockStore.EXPECT().UpdateApplication(&config.Application{
+					Name: "phonetool",
+					ImageRetention: &config.ImageRetention{
+						KeepImages:         50,
+						UntaggedExpiryDays: 7,
+					},
+				}).Return(nil)
+
+				mockUpgrader := mocks.NewMockappUpgrader(ctrl)
+				mockUpgrader.EXPECT().UpgradeApplication(&deploy.CreateAppInput{
+					Name:      "phonetool",
+					AccountID: "1234",
+					Version:   mockTemplateVersion,
+					ImageRetention: &config.ImageRetention{
+						KeepImages:         50,
+						UntaggedExpiryDays: 7,
+					},
+				}).Return(nil)
+
+				return &appUpgradeOpts{
+					appUpgradeVars: appUpgradeVars{
+						name: "phonetool",
+						imageRetentionVars: imageRetentionVars{
+							keepImages: 50,
+						},
+					},
+					newVersionGetter: func(string) (versionGetter, error) {
+						return &versionGetterDouble{
+							VersionFn: func() (string, error) {
+								return mockTemplateVersion, nil
+							},
+						}, nil
+					},
+					identity: mockIdentity,
+					store:    mockStore,
+					upgrader: mockUpgrader,
+				}
+			},
+		},
 	}
 
 	for name, tc := range testCases {
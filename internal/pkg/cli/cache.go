@@ -0,0 +1,27 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+)
+
+// BuildCacheCmd is the top level command for the local cache.
+func BuildCacheCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "cache",
+		Short: `Commands for the local cache.
+Copilot caches slow lookups in AWS, like the configuration of applications, for a few minutes.`,
+	}
+
+	cmd.AddCommand(buildCacheClearCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Settings,
+	}
+	return cmd
+}
@@ -0,0 +1,61 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/cache"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+type clearCacheOpts struct {
+	cache cacheClearer
+}
+
+func newClearCacheOpts() *clearCacheOpts {
+	return &clearCacheOpts{
+		cache: cache.New(),
+	}
+}
+
+// Validate is a no-op for this command.
+func (o *clearCacheOpts) Validate() error {
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *clearCacheOpts) Ask() error {
+	return nil
+}
+
+// Execute removes every entry of the cache.
+func (o *clearCacheOpts) Execute() error {
+	if err := o.cache.Clear(); err != nil {
+		return fmt.Errorf("clear cache: %w", err)
+	}
+	log.Successln("Cleared the cache. The next commands look up everything in AWS.")
+	return nil
+}
+
+// buildCacheClearCmd builds the command for clearing the local cache.
+func buildCacheClearCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear",
+		Short: "Clear the cache of lookups in AWS.",
+		Long: `Clear the cache of lookups in AWS.
+Copilot reuses the configuration of applications, environments and workloads for 5 minutes,
+the descriptions of environment stacks for 10 minutes, hosted zones for an hour,
+and registry credentials until they expire. Changes made from this machine clear the entries they affect,
+so the cache only needs to be cleared to see the changes made from another machine right away.`,
+		Example: `
+  Clear the cache.
+  /code $ copilot cache clear`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return run(newClearCacheOpts())
+		}),
+	}
+	return cmd
+}
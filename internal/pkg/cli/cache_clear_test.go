@@ -0,0 +1,43 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClearCacheOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		clearErr error
+		wantErr  string
+	}{
+		"clears the cache": {},
+		"wraps the error": {
+			clearErr: errors.New("permission denied"),
+			wantErr:  "clear cache: permission denied",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			clearer := mocks.NewMockcacheClearer(ctrl)
+			clearer.EXPECT().Clear().Return(tc.clearErr)
+			opts := &clearCacheOpts{cache: clearer}
+
+			err := opts.Execute()
+
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -73,6 +73,13 @@ func runCmdE(f func(cmd *cobra.Command, args []string) error) func(cmd *cobra.Co
 	}
 }
 
+// outputsJSON returns true if the results of the command are requested in JSON format,
+// either with the global --json flag or with the --json flag of the command.
+func outputsJSON(cmd *cobra.Command) bool {
+	json, _ := cmd.Flags().GetBool(jsonFlag)
+	return json
+}
+
 // returns true if error type is stack set not exist.
 func isStackSetNotExistsErr(err error) bool {
 	if err == nil {
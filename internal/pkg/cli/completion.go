@@ -7,27 +7,37 @@ import (
 	"errors"
 	"io"
 	"os"
+	"sort"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 )
 
 type shellCompleter interface {
 	GenBashCompletion(w io.Writer) error
 	GenZshCompletion(w io.Writer) error
 	GenFishCompletion(w io.Writer, includeDesc bool) error
+	GenPowerShellCompletionWithDesc(w io.Writer) error
 }
 
 type completionOpts struct {
-	Shell string // must be "bash" or "zsh" or "fish"
+	Shell string // must be "bash", "zsh", "fish" or "powershell"
 
 	w         io.Writer
 	completer shellCompleter
 }
 
-// Validate returns an error if the shell is not "bash" or "zsh" or "fish".
+// Validate returns an error if the shell is not "bash", "zsh", "fish" or "powershell".
 func (opts *completionOpts) Validate() error {
 	if opts.Shell == "bash" {
 		return nil
@@ -38,7 +48,10 @@ func (opts *completionOpts) Validate() error {
 	if opts.Shell == "fish" {
 		return nil
 	}
-	return errors.New("shell must be bash, zsh or fish")
+	if opts.Shell == "powershell" {
+		return nil
+	}
+	return errors.New("shell must be bash, zsh, fish or powershell")
 }
 
 // Execute writes the completion code to the writer.
@@ -50,17 +63,22 @@ func (opts *completionOpts) Execute() error {
 	if opts.Shell == "zsh" {
 		return opts.completer.GenZshCompletion(opts.w)
 	}
+	if opts.Shell == "powershell" {
+		return opts.completer.GenPowerShellCompletionWithDesc(opts.w)
+	}
 	return opts.completer.GenFishCompletion(opts.w, true)
 }
 
-// BuildCompletionCmd returns the command to output shell completion code for the specified shell (bash or zsh or fish).
+// BuildCompletionCmd returns the command to output shell completion code for the specified shell (bash, zsh, fish or powershell).
 func BuildCompletionCmd(rootCmd *cobra.Command) *cobra.Command {
 	opts := &completionOpts{}
 	cmd := &cobra.Command{
 		Use:   "completion [shell]",
 		Short: "Output shell completion code.",
-		Long: `Output shell completion code for bash, zsh or fish.
-The code must be evaluated to provide interactive completion of commands.`,
+		Long: `Output shell completion code for bash, zsh, fish or powershell.
+The code must be evaluated to provide interactive completion of commands.
+The values of the --app, --env, --name and --workload flags are completed with the
+applications, environments, services and jobs in your workspace and your AWS account.`,
 		Example: `
   Install zsh completion
   /code $ source <(copilot completion zsh)
@@ -80,10 +98,13 @@ The code must be evaluated to provide interactive completion of commands.`,
   /code$ copilot completion fish | source
 
   To load completions for each session, execute once:
-  /code$ copilot completion fish > ~/.config/fish/completions/copilot.fish`,
+  /code$ copilot completion fish > ~/.config/fish/completions/copilot.fish
+
+  Install powershell completion
+  /code$ copilot completion powershell | Out-String | Invoke-Expression`,
 		Args: func(cmd *cobra.Command, args []string) error {
 			if len(args) != 1 {
-				return errors.New("requires a single shell argument (bash, zsh or fish)")
+				return errors.New("requires a single shell argument (bash, zsh, fish or powershell)")
 			}
 			return nil
 		},
@@ -103,3 +124,158 @@ The code must be evaluated to provide interactive completion of commands.`,
 	}
 	return cmd
 }
+
+type workloadEnvLister interface {
+	ListServices() ([]string, error)
+	ListJobs() ([]string, error)
+	ListWorkloads() ([]string, error)
+	ListEnvironments() ([]string, error)
+}
+
+// flagCompleter completes the values of flags with the names of resources in the workspace and the AWS account.
+type flagCompleter struct {
+	newStore     func() (store, error)
+	newWorkspace func() (workloadEnvLister, error)
+	appName      func() string // Application of the workspace.
+}
+
+// RegisterFlagCompletions registers dynamic completions for the --app, --env, --name and --workload flags
+// of every command under root.
+func RegisterFlagCompletions(root *cobra.Command) {
+	c := &flagCompleter{
+		newStore: func() (store, error) {
+			sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("completion")).Default()
+			if err != nil {
+				return nil, err
+			}
+			return config.NewCachedSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region)), nil
+		},
+		newWorkspace: func() (workloadEnvLister, error) {
+			return workspace.Use(afero.NewOsFs())
+		},
+		appName: tryReadingAppName,
+	}
+	c.register(root)
+}
+
+func (c *flagCompleter) register(cmd *cobra.Command) {
+	for _, sub := range cmd.Commands() {
+		c.register(sub)
+	}
+	completions := map[string]func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective){
+		appFlag:      c.completeApps,
+		envFlag:      c.completeEnvs,
+		workloadFlag: c.completeWorkloads,
+	}
+	if complete := c.nameCompletion(cmd); complete != nil {
+		completions[nameFlag] = complete
+	}
+	for flag, complete := range completions {
+		if cmd.LocalNonPersistentFlags().Lookup(flag) == nil {
+			continue
+		}
+		// The only error is for flags that already have a completion, which are kept.
+		_ = cmd.RegisterFlagCompletionFunc(flag, complete)
+	}
+}
+
+// nameCompletion returns how to complete the --name flag based on the command group, or nil if it names a new resource.
+func (c *flagCompleter) nameCompletion(cmd *cobra.Command) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	if cmd.Name() == "init" || !cmd.HasParent() {
+		return nil
+	}
+	switch cmd.Parent().Name() {
+	case "svc":
+		return c.completeServices
+	case "job":
+		return c.completeJobs
+	case "env":
+		return c.completeEnvs
+	case "app":
+		return c.completeApps
+	case "run", "copilot":
+		return c.completeWorkloads
+	}
+	return nil
+}
+
+func (c *flagCompleter) completeApps(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	names := []string{c.appName()}
+	if s, err := c.newStore(); err == nil {
+		if apps, err := s.ListApplications(); err == nil {
+			for _, app := range apps {
+				names = append(names, app.Name)
+			}
+		}
+	}
+	return completions(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+func (c *flagCompleter) completeEnvs(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var names []string
+	if ws, err := c.newWorkspace(); err == nil {
+		names, _ = ws.ListEnvironments()
+	}
+	app := c.flagApp(cmd)
+	if s, err := c.newStore(); err == nil && app != "" {
+		if envs, err := s.ListEnvironments(app); err == nil {
+			for _, env := range envs {
+				names = append(names, env.Name)
+			}
+		}
+	}
+	return completions(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+func (c *flagCompleter) completeServices(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return c.completeWkldNames(cmd, toComplete, workloadEnvLister.ListServices, store.ListServices)
+}
+
+func (c *flagCompleter) completeJobs(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return c.completeWkldNames(cmd, toComplete, workloadEnvLister.ListJobs, store.ListJobs)
+}
+
+func (c *flagCompleter) completeWorkloads(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return c.completeWkldNames(cmd, toComplete, workloadEnvLister.ListWorkloads, store.ListWorkloads)
+}
+
+// completeWkldNames completes with the workloads in the workspace and the ones of the application in the store.
+func (c *flagCompleter) completeWkldNames(cmd *cobra.Command, toComplete string,
+	listLocal func(workloadEnvLister) ([]string, error), listStored func(store, string) ([]*config.Workload, error)) ([]string, cobra.ShellCompDirective) {
+	var names []string
+	if ws, err := c.newWorkspace(); err == nil {
+		names, _ = listLocal(ws)
+	}
+	app := c.flagApp(cmd)
+	if s, err := c.newStore(); err == nil && app != "" {
+		if wklds, err := listStored(s, app); err == nil {
+			for _, wkld := range wklds {
+				names = append(names, wkld.Name)
+			}
+		}
+	}
+	return completions(names, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// flagApp returns the value of the --app flag if it's set, otherwise the application of the workspace.
+func (c *flagCompleter) flagApp(cmd *cobra.Command) string {
+	if flag := cmd.Flags().Lookup(appFlag); flag != nil && flag.Value.String() != "" {
+		return flag.Value.String()
+	}
+	return c.appName()
+}
+
+// completions returns the sorted unique names that start with prefix.
+func completions(names []string, prefix string) []string {
+	seen := make(map[string]bool)
+	var out []string
+	for _, name := range names {
+		if name == "" || seen[name] || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		seen[name] = true
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
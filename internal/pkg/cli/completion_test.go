@@ -5,10 +5,13 @@ package cli
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 )
 
@@ -29,9 +32,13 @@ func TestCompletionOpts_Validate(t *testing.T) {
 			inputShell:  "fish",
 			wantedError: nil,
 		},
+		"powershell": {
+			inputShell:  "powershell",
+			wantedError: nil,
+		},
 		"invalid shell": {
 			inputShell:  "chicken",
-			wantedError: errors.New("shell must be bash, zsh or fish"),
+			wantedError: errors.New("shell must be bash, zsh, fish or powershell"),
 		},
 	}
 
@@ -86,6 +93,13 @@ func TestCompletionOpts_Execute(t *testing.T) {
 				mock.EXPECT().GenFishCompletion(gomock.Any(), gomock.Any()).Times(1)
 			},
 		},
+		"powershell": {
+			inputShell: "powershell",
+			mocking: func(mock *mocks.MockshellCompleter) {
+				mock.EXPECT().GenFishCompletion(gomock.Any(), gomock.Any()).Times(0)
+				mock.EXPECT().GenPowerShellCompletionWithDesc(gomock.Any()).Times(1)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -100,3 +114,98 @@ func TestCompletionOpts_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestFlagCompleter_Register(t *testing.T) {
+	testCases := map[string]struct {
+		inArgs func() []string
+
+		setupMocks func(s *mocks.Mockstore, ws *mocks.MockworkloadEnvLister)
+
+		wantedCompletions []string
+	}{
+		"completes service names from the workspace and the store": {
+			inArgs: func() []string { return []string{"svc", "deploy", "--app", "phonetool", "--name", "f"} },
+			setupMocks: func(s *mocks.Mockstore, ws *mocks.MockworkloadEnvLister) {
+				ws.EXPECT().ListServices().Return([]string{"frontend", "backend"}, nil)
+				s.EXPECT().ListServices("phonetool").Return([]*config.Workload{{Name: "frontend"}, {Name: "fe-admin"}}, nil)
+			},
+			wantedCompletions: []string{"fe-admin", "frontend"},
+		},
+		"completes job names with the application of the workspace": {
+			inArgs: func() []string { return []string{"job", "deploy", "--name", ""} },
+			setupMocks: func(s *mocks.Mockstore, ws *mocks.MockworkloadEnvLister) {
+				ws.EXPECT().ListJobs().Return(nil, errors.New("some error"))
+				s.EXPECT().ListJobs("my-app").Return([]*config.Workload{{Name: "report"}}, nil)
+			},
+			wantedCompletions: []string{"report"},
+		},
+		"completes environment names": {
+			inArgs: func() []string { return []string{"svc", "deploy", "--env", "t"} },
+			setupMocks: func(s *mocks.Mockstore, ws *mocks.MockworkloadEnvLister) {
+				ws.EXPECT().ListEnvironments().Return([]string{"test"}, nil)
+				s.EXPECT().ListEnvironments("my-app").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+			},
+			wantedCompletions: []string{"test"},
+		},
+		"completes application names": {
+			inArgs: func() []string { return []string{"svc", "deploy", "--app", ""} },
+			setupMocks: func(s *mocks.Mockstore, ws *mocks.MockworkloadEnvLister) {
+				s.EXPECT().ListApplications().Return([]*config.Application{{Name: "phonetool"}}, nil)
+			},
+			wantedCompletions: []string{"my-app", "phonetool"},
+		},
+		"does not complete the name of a new service": {
+			inArgs:            func() []string { return []string{"svc", "init", "--name", ""} },
+			setupMocks:        func(s *mocks.Mockstore, ws *mocks.MockworkloadEnvLister) {},
+			wantedCompletions: nil,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			s := mocks.NewMockstore(ctrl)
+			ws := mocks.NewMockworkloadEnvLister(ctrl)
+			tc.setupMocks(s, ws)
+			root := &cobra.Command{Use: "copilot"}
+			for _, group := range []string{"svc", "job"} {
+				parent := &cobra.Command{Use: group}
+				for _, sub := range []string{"init", "deploy"} {
+					cmd := &cobra.Command{Use: sub, Run: func(*cobra.Command, []string) {}}
+					cmd.Flags().String(appFlag, "", "")
+					cmd.Flags().String(envFlag, "", "")
+					cmd.Flags().String(nameFlag, "", "")
+					parent.AddCommand(cmd)
+				}
+				root.AddCommand(parent)
+			}
+			c := &flagCompleter{
+				newStore:     func() (store, error) { return s, nil },
+				newWorkspace: func() (workloadEnvLister, error) { return ws, nil },
+				appName:      func() string { return "my-app" },
+			}
+			c.register(root)
+			buf := new(strings.Builder)
+			root.SetOut(buf)
+			root.SetArgs(append([]string{cobra.ShellCompRequestCmd}, tc.inArgs()...))
+
+			// WHEN
+			require.NoError(t, root.Execute())
+
+			// THEN
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			require.Equal(t, tc.wantedCompletions, nonEmpty(lines[:len(lines)-1]))
+		})
+	}
+}
+
+func nonEmpty(lines []string) []string {
+	var out []string
+	for _, line := range lines {
+		if line != "" {
+			out = append(out, line)
+		}
+	}
+	return out
+}
@@ -0,0 +1,222 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Classes of resources that can be kept when deleting a workload, an environment, or an application.
+const (
+	retainS3Class   = "s3"
+	retainLogsClass = "logs"
+)
+
+const deletionPolicyRetain = "Retain"
+
+var (
+	retainableResourceClasses = []string{retainS3Class, retainLogsClass}
+
+	// retainableResourceTypes maps a class of resources to the CloudFormation resource type that belongs to it.
+	retainableResourceTypes = map[string]string{
+		retainS3Class:   "AWS::S3::Bucket",
+		retainLogsClass: "AWS::Logs::LogGroup",
+	}
+
+	// plannedResourceTypes are the resources of a stack that are listed under the step deleting the stack.
+	plannedResourceTypes = map[string]string{
+		"AWS::CloudFormation::Stack": "addons stack",
+		"AWS::Logs::LogGroup":        "log group",
+		"AWS::S3::Bucket":            "S3 bucket",
+	}
+)
+
+// deletePlanResource is a resource that is deleted, or retained, along with a stack.
+type deletePlanResource struct {
+	description string
+	retained    bool
+}
+
+type deletePlanStep struct {
+	description string
+	retained    bool
+	resources   []deletePlanResource
+}
+
+// deletePlan is the ordered list of steps that a delete command takes.
+type deletePlan struct {
+	title string
+	steps []deletePlanStep
+}
+
+func (p *deletePlan) add(description string, resources ...deletePlanResource) {
+	p.steps = append(p.steps, deletePlanStep{
+		description: description,
+		resources:   resources,
+	})
+}
+
+func (p *deletePlan) addRetained(description string) {
+	p.steps = append(p.steps, deletePlanStep{
+		description: description,
+		retained:    true,
+	})
+}
+
+func (p *deletePlan) render(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n", p.title)
+	for i, step := range p.steps {
+		fmt.Fprintf(&b, "  %d. %s%s\n", i+1, step.description, retainedSuffix(step.retained))
+		for _, r := range step.resources {
+			fmt.Fprintf(&b, "       - %s%s\n", r.description, retainedSuffix(r.retained))
+		}
+	}
+	fmt.Fprintln(&b)
+	_, err := w.Write([]byte(b.String()))
+	return err
+}
+
+func retainedSuffix(retained bool) string {
+	if !retained {
+		return ""
+	}
+	return " (retained)"
+}
+
+func validateRetainedResourceClasses(classes []string) error {
+	for _, class := range classes {
+		if !contains(class, retainableResourceClasses) {
+			return fmt.Errorf("invalid value %q for --%s: must be one of %s", class, retainFlag, strings.Join(retainableResourceClasses, ", "))
+		}
+	}
+	return nil
+}
+
+// plannedResources returns the addons stacks, log groups, and S3 buckets declared in a stack template.
+// A resource is marked as retained if it already has a "Retain" deletion policy or if its class is retained.
+func plannedResources(body string, retainedClasses []string) ([]deletePlanResource, error) {
+	resources, err := templateResources(body)
+	if err != nil {
+		return nil, err
+	}
+	var planned []deletePlanResource
+	for _, r := range resources {
+		typeDescription, ok := plannedResourceTypes[r.typ]
+		if !ok {
+			continue
+		}
+		planned = append(planned, deletePlanResource{
+			description: fmt.Sprintf("%s %s", typeDescription, r.logicalID),
+			retained:    r.deletionPolicy == deletionPolicyRetain || isRetainedType(r.typ, retainedClasses),
+		})
+	}
+	return planned, nil
+}
+
+// retainResourcesInTemplate sets a "Retain" deletion policy on the resources of the template whose class is retained.
+// The template body is returned unchanged if there is no such resource.
+func retainResourcesInTemplate(body string, retainedClasses []string) (string, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(body), &doc); err != nil {
+		return "", fmt.Errorf("unmarshal template: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return body, nil
+	}
+	resources := mappingValue(doc.Content[0], "Resources")
+	if resources == nil {
+		return body, nil
+	}
+	var updated bool
+	for i := 0; i+1 < len(resources.Content); i += 2 {
+		resource := resources.Content[i+1]
+		typ := mappingValue(resource, "Type")
+		if typ == nil || !isRetainedType(typ.Value, retainedClasses) {
+			continue
+		}
+		if policy := mappingValue(resource, "DeletionPolicy"); policy != nil {
+			if policy.Value == deletionPolicyRetain {
+				continue
+			}
+			policy.Value = deletionPolicyRetain
+		} else {
+			resource.Content = append(resource.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: "DeletionPolicy"},
+				&yaml.Node{Kind: yaml.ScalarNode, Value: deletionPolicyRetain})
+		}
+		updated = true
+	}
+	if !updated {
+		return body, nil
+	}
+	var out bytes.Buffer
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return "", fmt.Errorf("marshal template: %w", err)
+	}
+	return out.String(), nil
+}
+
+type templateResource struct {
+	logicalID      string
+	typ            string
+	deletionPolicy string
+}
+
+func templateResources(body string) ([]templateResource, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(body), &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal template: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	resources := mappingValue(doc.Content[0], "Resources")
+	if resources == nil {
+		return nil, nil
+	}
+	var out []templateResource
+	for i := 0; i+1 < len(resources.Content); i += 2 {
+		r := templateResource{
+			logicalID: resources.Content[i].Value,
+		}
+		if typ := mappingValue(resources.Content[i+1], "Type"); typ != nil {
+			r.typ = typ.Value
+		}
+		if policy := mappingValue(resources.Content[i+1], "DeletionPolicy"); policy != nil {
+			r.deletionPolicy = policy.Value
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func isRetainedType(typ string, retainedClasses []string) bool {
+	for _, class := range retainedClasses {
+		if retainableResourceTypes[class] == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// mappingValue returns the value node of key in a mapping node, or nil if the key doesn't exist.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
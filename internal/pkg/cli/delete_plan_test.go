@@ -0,0 +1,85 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetainResourcesInTemplate(t *testing.T) {
+	testCases := map[string]struct {
+		inTemplate string
+		inClasses  []string
+
+		wanted string
+	}{
+		"returns the template as is if no resource is retained": {
+			inTemplate: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+`,
+			inClasses: []string{"s3"},
+			wanted: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+`,
+		},
+		"returns the template as is if the resources are already retained": {
+			inTemplate: `Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    DeletionPolicy:   Retain
+`,
+			inClasses: []string{"s3"},
+			wanted: `Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    DeletionPolicy:   Retain
+`,
+		},
+		"sets the deletion policy of the retained resources": {
+			inTemplate: `Parameters:
+  AppName:
+    Type: String
+Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      LogGroupName: !Sub /copilot/${AppName}
+  Bucket:
+    Type: AWS::S3::Bucket
+    DeletionPolicy: Delete
+  Service:
+    Type: AWS::ECS::Service
+`,
+			inClasses: []string{"s3", "logs"},
+			wanted: `Parameters:
+  AppName:
+    Type: String
+Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      LogGroupName: !Sub /copilot/${AppName}
+    DeletionPolicy: Retain
+  Bucket:
+    Type: AWS::S3::Bucket
+    DeletionPolicy: Retain
+  Service:
+    Type: AWS::ECS::Service
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := retainResourcesInTemplate(tc.inTemplate, tc.inClasses)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -4,24 +4,38 @@
 package cli
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/graph"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/afero"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/hooks"
 
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/buildcache"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/output"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -34,22 +48,37 @@ const (
 	jobWkldType = "job"
 )
 
-type deployOpts struct {
+const defaultMaxParallelDeployments = 1
+
+type deployVars struct {
 	deployWkldVars
 
+	deployAll         bool
+	maxParallel       int
+	maxParallelBuilds int // Zero if each workload is packaged as part of its deployment.
+	envNames          []string
+	shouldOutputJSON  bool
+}
+
+type deployOpts struct {
+	deployVars
+
 	deployWkld     actionCommand
 	setupDeployCmd func(*deployOpts, string)
 
-	sel    wsSelector
-	store  store
-	ws     wsWlDirReader
-	prompt prompter
+	sel        wsSelector
+	store      store
+	ws         wsWlDirReader
+	pipelineWs wsPipelineGetter
+	prompt     prompter
+	buildCache *buildcache.Cache // Shared by the deployments of all the workloads.
+	w          io.Writer
 
 	// values for logging
 	wlType string
 }
 
-func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
+func newDeployOpts(vars deployVars) (*deployOpts, error) {
 	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("deploy"))
 	defaultSess, err := sessProvider.Default()
 	if err != nil {
@@ -62,11 +91,14 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 	}
 	prompter := prompt.New()
 	return &deployOpts{
-		deployWkldVars: vars,
-		store:          store,
-		sel:            selector.NewLocalWorkloadSelector(prompter, store, ws),
-		ws:             ws,
-		prompt:         prompter,
+		deployVars: vars,
+		store:      store,
+		sel:        selector.NewLocalWorkloadSelector(prompter, store, ws),
+		ws:         ws,
+		pipelineWs: ws,
+		prompt:     prompter,
+		buildCache: workspaceBuildCache(ws, vars.noBuildCache),
+		w:          os.Stdout,
 
 		setupDeployCmd: func(o *deployOpts, workloadType string) {
 			switch {
@@ -81,6 +113,9 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 					sel:             selector.NewLocalWorkloadSelector(o.prompt, o.store, ws),
 					cmd:             exec.NewCmd(),
 					sessProvider:    sessProvider,
+					locker:          newStackLocker(defaultSess, o.waitForLock, o.lockTimeout),
+					buildCache:      o.buildCache,
+					hooks:           hooks.New(ws),
 				}
 				opts.newJobDeployer = func() (workloadDeployer, error) {
 					return newJobDeployer(opts)
@@ -99,6 +134,9 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 					prompt:          o.prompt,
 					cmd:             exec.NewCmd(),
 					sessProvider:    sessProvider,
+					locker:          newStackLocker(defaultSess, o.waitForLock, o.lockTimeout),
+					buildCache:      o.buildCache,
+					hooks:           hooks.New(ws),
 					templateVersion: version.LatestTemplateVersion(),
 				}
 				opts.newSvcDeployer = func() (workloadDeployer, error) {
@@ -111,6 +149,12 @@ func newDeployOpts(vars deployWkldVars) (*deployOpts, error) {
 }
 
 func (o *deployOpts) Run() error {
+	if len(o.envNames) > 0 {
+		return o.runEnvs()
+	}
+	if o.deployAll {
+		return o.runAll()
+	}
 	if err := o.askName(); err != nil {
 		return err
 	}
@@ -123,7 +167,14 @@ func (o *deployOpts) Run() error {
 	if err := o.deployWkld.RecommendActions(); err != nil {
 		return err
 	}
-	return nil
+	envName := o.envName
+	if d, ok := o.deployWkld.(envTargeter); ok {
+		envName = d.targetEnvName()
+	}
+	return o.writeResult(envDeployResult{
+		Name:     envName,
+		Deployed: []string{o.name},
+	})
 }
 
 func (o *deployOpts) askName() error {
@@ -165,19 +216,607 @@ func (o *deployOpts) loadWkldCmd() error {
 	return nil
 }
 
+// workspaceBuildCache returns the build cache of the workspace, or nil if it is disabled.
+func workspaceBuildCache(ws *workspace.Workspace, disabled bool) *buildcache.Cache {
+	if disabled {
+		return nil
+	}
+	return buildcache.Open(afero.NewOsFs(), ws.CopilotDirAbs)
+}
+
+// artifactPackager is implemented by the deploy commands that can package a workload ahead of its deployment.
+type artifactPackager interface {
+	packageArtifacts() error
+}
+
+// imageReplicator is implemented by the deploy commands that can copy the images built for another environment
+// instead of building them again.
+type imageReplicator interface {
+	packagedImages() map[string]string
+	replicateImages(images map[string]string)
+}
+
+// envTargeter is implemented by the deploy commands that know the environment that they deploy to once they asked for it.
+type envTargeter interface {
+	targetEnvName() string
+}
+
+// pinnedImages returns the location pinned to a digest of each image in out, keyed by container name.
+func pinnedImages(out *clideploy.UploadArtifactsOutput) map[string]string {
+	if out == nil || out.ImageRepositoryURI == "" || len(out.ImageDigests) == 0 {
+		return nil
+	}
+	images := make(map[string]string, len(out.ImageDigests))
+	for container, img := range out.ImageDigests {
+		images[container] = fmt.Sprintf("%s@%s", out.ImageRepositoryURI, img.Digest)
+	}
+	return images
+}
+
+// envDeployment holds the deploy commands of the workloads to deploy to an environment.
+type envDeployment struct {
+	names        []string
+	cmds         map[string]actionCommand
+	wlTypes      map[string]string
+	stages       [][]string
+	dependencies map[string][]string
+}
+
+// runAll deploys all the workloads in the workspace to an environment. The workloads are deployed in stages, each
+// of which starts after the workloads that its workloads depend on are deployed. The workloads in the same stage are
+// deployed concurrently up to the maximum number of parallel deployments. If a workload fails to deploy, the workloads
+// that depend on it are skipped, while the others are still deployed.
+//
+// With a maximum number of parallel builds, the images and artifacts of all the workloads are packaged concurrently
+// before the first stage starts, since packaging doesn't depend on the deployment of other workloads.
+func (o *deployOpts) runAll() error {
+	if err := o.validateAll(); err != nil {
+		return err
+	}
+	if err := o.askEnvName(); err != nil {
+		return err
+	}
+	d, err := o.loadAll()
+	if err != nil {
+		return err
+	}
+	var packageErrs map[string]error
+	if o.maxParallelBuilds > 0 {
+		packageErrs = o.packageAll(d, o.maxParallelBuilds, nil)
+	}
+	tracker := o.deployStages(d, packageErrs)
+	tracker.summarize()
+
+	for _, name := range tracker.deployed {
+		if err := d.cmds[name].RecommendActions(); err != nil {
+			return err
+		}
+	}
+	if len(tracker.failed) > 0 {
+		return fmt.Errorf("%s failed to deploy to environment %s", english.WordSeries(tracker.failedNames(), "and"), o.envName)
+	}
+	return o.writeResult(tracker.result())
+}
+
+func (o *deployOpts) validateAll() error {
+	if o.name != "" {
+		return fmt.Errorf("only one of --%s or --%s may be used", allFlag, nameFlag)
+	}
+	if o.maxParallel < 1 {
+		return fmt.Errorf("--%s must be at least 1", maxParallelFlag)
+	}
+	if o.maxParallelBuilds < 0 {
+		return fmt.Errorf("--%s must not be negative", maxParallelBuildsFlag)
+	}
+	return nil
+}
+
+// loadAll loads the deploy commands of all the workloads in the workspace, and the stages to deploy them in.
+func (o *deployOpts) loadAll() (*envDeployment, error) {
+	names, err := o.ws.ListWorkloads()
+	if err != nil {
+		return nil, fmt.Errorf("list services and jobs in the workspace: %w", err)
+	}
+	if len(names) == 0 {
+		return nil, errors.New("no service or job found in the workspace")
+	}
+	stages, dependencies, err := o.deploymentStages(names)
+	if err != nil {
+		return nil, err
+	}
+	d := &envDeployment{
+		names:        names,
+		cmds:         make(map[string]actionCommand, len(names)),
+		wlTypes:      make(map[string]string, len(names)),
+		stages:       stages,
+		dependencies: dependencies,
+	}
+	for _, name := range names {
+		wkldOpts := *o
+		wkldOpts.name = name
+		if err := wkldOpts.loadWkld(); err != nil {
+			return nil, fmt.Errorf("load %s: %w", name, err)
+		}
+		d.cmds[name], d.wlTypes[name] = wkldOpts.deployWkld, wkldOpts.wlType
+	}
+	return d, nil
+}
+
+// loadOne loads the deploy command of the workload as a deployment with a single stage.
+func (o *deployOpts) loadOne() (*envDeployment, error) {
+	wkldOpts := *o
+	if err := wkldOpts.loadWkld(); err != nil {
+		return nil, err
+	}
+	return &envDeployment{
+		names:   []string{o.name},
+		cmds:    map[string]actionCommand{o.name: wkldOpts.deployWkld},
+		wlTypes: map[string]string{o.name: wkldOpts.wlType},
+		stages:  [][]string{{o.name}},
+	}, nil
+}
+
+// deployStages deploys the workloads stage after stage and returns the tracker of their outcome.
+// The workloads that failed to package are recorded as failed without being deployed.
+func (o *deployOpts) deployStages(d *envDeployment, packageErrs map[string]error) *deployAllTracker {
+	limit := o.maxParallel
+	if limit < 1 {
+		limit = 1
+	}
+	tracker := newDeployAllTracker(o.envName, d.stages)
+	for _, stage := range d.stages {
+		g := new(errgroup.Group)
+		g.SetLimit(limit)
+		for _, name := range stage {
+			if dependency, skipped := tracker.unavailableDependency(d.dependencies[name]); skipped {
+				tracker.skip(name, dependency)
+				continue
+			}
+			if err := packageErrs[name]; err != nil {
+				tracker.start(name, d.wlTypes[name])
+				tracker.finish(name, err)
+				continue
+			}
+			name := name
+			g.Go(func() error {
+				tracker.start(name, d.wlTypes[name])
+				tracker.finish(name, d.cmds[name].Execute())
+				return nil
+			})
+		}
+		_ = g.Wait() // Failures are recorded by the tracker, so that the other workloads are still deployed.
+	}
+	return tracker
+}
+
+// packageAll builds the images and uploads the artifacts of the workloads concurrently, up to limit at a time.
+// The workloads with images in sourceImages copy them instead of building them again.
+// It returns the error of each workload that failed to package, so that it isn't deployed.
+func (o *deployOpts) packageAll(d *envDeployment, limit int, sourceImages map[string]map[string]string) map[string]error {
+	errs := make(map[string]error)
+	log.Infof("Packaging %s for environment %s, up to %d at a time.\n",
+		english.Plural(len(d.names), "workload", "workloads"), color.HighlightUserInput(o.envName), limit)
+	var mu sync.Mutex
+	g := new(errgroup.Group)
+	g.SetLimit(limit)
+	for _, name := range d.names {
+		pkg, ok := d.cmds[name].(artifactPackager)
+		if !ok {
+			continue
+		}
+		if r, ok := d.cmds[name].(imageReplicator); ok && sourceImages[name] != nil {
+			r.replicateImages(sourceImages[name])
+		}
+		name := name
+		g.Go(func() error {
+			if err := pkg.packageArtifacts(); err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				errs[name] = err
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+	return errs
+}
+
+// runEnvs deploys the workload, or all the workloads with --all, to each environment. The images are built and
+// pushed once for the first environment, then copied by digest to the ECR repositories of the application in the
+// regions of the other environments. The environments are then deployed concurrently, and the deployments
+// are reported with their environment since the progress of each is printed line by line.
+// If the deployment to an environment fails, the deployments to the remaining environments still happen.
+func (o *deployOpts) runEnvs() error {
+	if o.deployAll {
+		if err := o.validateAll(); err != nil {
+			return err
+		}
+	}
+	envs := make([]*config.Environment, len(o.envNames))
+	for i, name := range o.envNames {
+		env, err := o.store.GetEnvironment(o.appName, name)
+		if err != nil {
+			return fmt.Errorf("get environment %s configuration: %w", name, err)
+		}
+		envs[i] = env
+	}
+	if !o.deployAll {
+		if err := o.askName(); err != nil {
+			return err
+		}
+	}
+	if len(envs) > 1 && termprogress.Mode() == termprogress.ModeTree {
+		// Progress updated in-place can't be shared by concurrent deployments.
+		_ = termprogress.SetMode(termprogress.ModePlain)
+		defer func() { _ = termprogress.SetMode(termprogress.ModeTree) }()
+	}
+	envOpts := make([]*deployOpts, len(envs))
+	deployments := make([]*envDeployment, len(envs))
+	for i, env := range envs {
+		opts := *o
+		opts.envName = env.Name
+		opts.envNames = nil
+		load := opts.loadOne
+		if o.deployAll {
+			load = opts.loadAll
+		}
+		d, err := load()
+		if err != nil {
+			return fmt.Errorf("load deployment to environment %s: %w", env.Name, err)
+		}
+		envOpts[i], deployments[i] = &opts, d
+	}
+
+	tracker := newDeployEnvsTracker(envs)
+	limit := o.maxParallelBuilds
+	if limit < 1 {
+		limit = 1
+	}
+	packageErrs := make([]map[string]error, len(envs))
+	tracker.update(envs[0].Name, "building images")
+	packageErrs[0] = envOpts[0].packageAll(deployments[0], limit, nil)
+	sourceImages := make(map[string]map[string]string)
+	for name, cmd := range deployments[0].cmds {
+		if r, ok := cmd.(imageReplicator); ok && packageErrs[0][name] == nil {
+			sourceImages[name] = r.packagedImages()
+		}
+	}
+	g := new(errgroup.Group)
+	for i := 1; i < len(envs); i++ {
+		i := i
+		tracker.update(envs[i].Name, "packaging with the copied images")
+		g.Go(func() error {
+			errs := make(map[string]error)
+			for name, err := range packageErrs[0] {
+				// The workloads that failed to build for the first environment aren't built again for the others.
+				errs[name] = fmt.Errorf("package for environment %s: %w", envs[0].Name, err)
+			}
+			seeded := &envDeployment{cmds: deployments[i].cmds}
+			for _, name := range deployments[i].names {
+				if errs[name] == nil {
+					seeded.names = append(seeded.names, name)
+				}
+			}
+			for name, err := range envOpts[i].packageAll(seeded, limit, sourceImages) {
+				errs[name] = err
+			}
+			packageErrs[i] = errs
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	trackers := make([]*deployAllTracker, len(envs))
+	g = new(errgroup.Group)
+	for i := range envs {
+		i := i
+		tracker.update(envs[i].Name, "deploying")
+		g.Go(func() error {
+			trackers[i] = envOpts[i].deployStages(deployments[i], packageErrs[i])
+			if o.deployAll {
+				trackers[i].summarize()
+			}
+			status := "deployed"
+			if len(trackers[i].failed) > 0 || len(trackers[i].skipped) > 0 {
+				status = "failed"
+			}
+			tracker.update(envs[i].Name, status)
+			return nil
+		})
+	}
+	_ = g.Wait() // Failures are recorded by the trackers, so that the other environments are still deployed.
+
+	var deployed, failed []string
+	for i, env := range envs {
+		for _, name := range trackers[i].deployed {
+			if err := deployments[i].cmds[name].RecommendActions(); err != nil {
+				return err
+			}
+		}
+		if len(trackers[i].failed) > 0 || len(trackers[i].skipped) > 0 {
+			failed = append(failed, env.Name)
+			continue
+		}
+		deployed = append(deployed, env.Name)
+	}
+	if len(failed) > 0 {
+		log.Infof("Deployed to %d of the %s.\n", len(deployed), english.Plural(len(envs), "environment", "environments"))
+		return fmt.Errorf("failed to deploy to %s %s", english.PluralWord(len(failed), "environment", "environments"), english.WordSeries(failed, "and"))
+	}
+	log.Successf("Deployed to %s %s.\n", english.PluralWord(len(envs), "environment", "environments"), english.WordSeries(deployed, "and"))
+	results := make([]envDeployResult, len(envs))
+	for i := range envs {
+		results[i] = trackers[i].result()
+	}
+	return o.writeResult(results...)
+}
+
+// writeResult writes the workloads deployed to each environment with --json.
+// Otherwise, the outcome of the deployments is already logged as they progress.
+func (o *deployOpts) writeResult(envs ...envDeployResult) error {
+	if !o.shouldOutputJSON {
+		return nil
+	}
+	return output.New(o.w, true).Write(deployResult{Environments: envs})
+}
+
+func (o *deployOpts) askEnvName() error {
+	if o.envName != "" {
+		return nil
+	}
+	name, err := o.sel.Environment("Select an environment to deploy all services and jobs to", "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = name
+	return nil
+}
+
+// deploymentStages groups the workloads into stages that are deployed one after another, according to the
+// "depends_on" of the deployments in the pipeline stages to the environment. It also returns the workloads that
+// each workload depends on.
+func (o *deployOpts) deploymentStages(names []string) ([][]string, map[string][]string, error) {
+	isWorkload := make(map[string]bool, len(names))
+	for _, name := range names {
+		isWorkload[name] = true
+	}
+	pipelines, err := o.pipelineWs.ListPipelines()
+	if err != nil {
+		return nil, nil, fmt.Errorf("list pipelines in the workspace: %w", err)
+	}
+	digraph := graph.New(names...)
+	dependencies := make(map[string][]string)
+	for _, pipeline := range pipelines {
+		mft, err := o.pipelineWs.ReadPipelineManifest(pipeline.Path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("read manifest for pipeline %s: %w", pipeline.Name, err)
+		}
+		for _, stage := range mft.Stages {
+			if stage.Name != o.envName {
+				continue
+			}
+			for name, conf := range stage.Deployments {
+				if conf == nil || !isWorkload[name] {
+					continue
+				}
+				for _, dependency := range conf.DependsOn {
+					if !isWorkload[dependency] {
+						continue
+					}
+					digraph.Add(graph.Edge[string]{
+						From: dependency, // Dependency must be deployed before name.
+						To:   name,
+					})
+					dependencies[name] = append(dependencies[name], dependency)
+				}
+			}
+		}
+	}
+	topo, err := graph.TopologicalOrder(digraph)
+	if err != nil {
+		return nil, nil, fmt.Errorf("find an ordering for deployments: %v", err)
+	}
+	var stages [][]string
+	for _, name := range names {
+		rank, _ := topo.Rank(name)
+		for len(stages) <= rank {
+			stages = append(stages, nil)
+		}
+		stages[rank] = append(stages[rank], name)
+	}
+	for _, stage := range stages {
+		sort.Strings(stage)
+	}
+	return stages, dependencies, nil
+}
+
+// deployAllTracker records the outcome of the deployment of each workload, and prints the combined progress of
+// the deployments.
+type deployAllTracker struct {
+	mu      sync.Mutex
+	envName string
+	total   int
+	started int
+
+	deployed []string
+	failed   map[string]error
+	skipped  map[string]string // Workload name to the dependency that is not deployed.
+}
+
+func newDeployAllTracker(envName string, stages [][]string) *deployAllTracker {
+	var total int
+	lines := make([]string, len(stages))
+	for i, stage := range stages {
+		total += len(stage)
+		lines[i] = fmt.Sprintf("  %d. %s", i+1, strings.Join(stage, ", "))
+	}
+	if total > 1 {
+		log.Infof("Deploying %s to environment %s in the following order:\n%s\n",
+			english.Plural(total, "workload", "workloads"), color.HighlightUserInput(envName), strings.Join(lines, "\n"))
+	}
+	return &deployAllTracker{
+		envName: envName,
+		total:   total,
+		failed:  make(map[string]error),
+		skipped: make(map[string]string),
+	}
+}
+
+func (t *deployAllTracker) start(name, wlType string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.started++
+	kind := "service"
+	if wlType == jobWkldType {
+		kind = "job"
+	}
+	log.Infof("[%d/%d] Deploying %s %s to environment %s.\n", t.started, t.total, kind, color.HighlightUserInput(name), t.envName)
+}
+
+func (t *deployAllTracker) finish(name string, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err != nil {
+		t.failed[name] = err
+		log.Errorf("Failed to deploy %s to environment %s: %v\n", name, t.envName, err)
+		return
+	}
+	t.deployed = append(t.deployed, name)
+}
+
+func (t *deployAllTracker) skip(name, dependency string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.started++
+	t.skipped[name] = dependency
+	log.Warningf("[%d/%d] Skipped %s because %s was not deployed.\n", t.started, t.total, color.HighlightUserInput(name), dependency)
+}
+
+// unavailableDependency returns a dependency that failed to deploy or was skipped, if any.
+func (t *deployAllTracker) unavailableDependency(dependencies []string) (string, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, dependency := range dependencies {
+		if _, ok := t.failed[dependency]; ok {
+			return dependency, true
+		}
+		if _, ok := t.skipped[dependency]; ok {
+			return dependency, true
+		}
+	}
+	return "", false
+}
+
+func (t *deployAllTracker) failedNames() []string {
+	names := make([]string, 0, len(t.failed))
+	for name := range t.failed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// result returns the outcome of the deployments to the environment.
+func (t *deployAllTracker) result() envDeployResult {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	deployed := append([]string{}, t.deployed...)
+	sort.Strings(deployed)
+	return envDeployResult{
+		Name:     t.envName,
+		Deployed: deployed,
+	}
+}
+
+func (t *deployAllTracker) summarize() {
+	if len(t.failed) == 0 && len(t.skipped) == 0 {
+		log.Successf("Deployed all %s to environment %s.\n", english.Plural(t.total, "workload", "workloads"), color.HighlightUserInput(t.envName))
+		return
+	}
+	log.Infof("Deployed %d, failed %d and skipped %d of the %s to environment %s.\n",
+		len(t.deployed), len(t.failed), len(t.skipped), english.Plural(t.total, "workload", "workloads"), color.HighlightUserInput(t.envName))
+}
+
+// deployEnvsTracker prints the combined status of the deployments to several environments every time one changes.
+type deployEnvsTracker struct {
+	mu     sync.Mutex
+	envs   []*config.Environment
+	status map[string]string
+}
+
+func newDeployEnvsTracker(envs []*config.Environment) *deployEnvsTracker {
+	status := make(map[string]string, len(envs))
+	for _, env := range envs {
+		status[env.Name] = "pending"
+	}
+	return &deployEnvsTracker{
+		envs:   envs,
+		status: status,
+	}
+}
+
+func (t *deployEnvsTracker) update(envName, status string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.status[envName] = status
+	lines := make([]string, len(t.envs))
+	for i, env := range t.envs {
+		lines[i] = fmt.Sprintf("  - %s (%s): %s", env.Name, env.Region, t.status[env.Name])
+	}
+	log.Infof("Environments:\n%s\n", strings.Join(lines, "\n"))
+}
+
+// deployResult is the outcome of the deployment of workloads to one or more environments.
+type deployResult struct {
+	Environments []envDeployResult `json:"environments"`
+}
+
+// envDeployResult is the workloads deployed to an environment.
+type envDeployResult struct {
+	Name     string   `json:"name"`
+	Deployed []string `json:"deployed"`
+}
+
+// HumanString returns a line per environment with the workloads deployed to it.
+func (r deployResult) HumanString() string {
+	b := &strings.Builder{}
+	for _, env := range r.Environments {
+		fmt.Fprintf(b, "Deployed %s to environment %s.\n", english.WordSeries(env.Deployed, "and"), env.Name)
+	}
+	return b.String()
+}
+
+// JSONString returns the deployed workloads as a JSON object.
+func (r deployResult) JSONString() (string, error) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return "", fmt.Errorf("marshal deploy result: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
 // BuildDeployCmd is the deploy command.
 func BuildDeployCmd() *cobra.Command {
-	vars := deployWkldVars{}
+	vars := deployVars{}
 	cmd := &cobra.Command{
 		Use:   "deploy",
 		Short: "Deploy a Copilot job or service.",
-		Long:  "Deploy a Copilot job or service.",
+		Long:  "Deploy a Copilot job or service, or all the jobs and services in the workspace with --all.",
 		Example: `
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot deploy --name frontend --env test
   Deploys a job named "mailer" with additional resource tags to a "prod" environment.
-  /code $ copilot deploy -n mailer -e prod --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot deploy -n mailer -e prod --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Deploys all the services and jobs in the workspace to a "test" environment, up to 3 at a time.
+  /code $ copilot deploy --all --env test --max-parallel 3
+  Builds the images of all the services and jobs 4 at a time, and then deploys them to a "test" environment.
+  /code $ copilot deploy --all --env test --max-parallel-builds 4
+  Deploys a service named "frontend" to a "prod-us" and then a "prod-eu" environment.
+  /code $ copilot deploy --name frontend --envs prod-us,prod-eu
+  Deploys all the services and jobs to a "test" environment, and writes the deployed ones as JSON.
+  /code $ copilot deploy --all --env test --json`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.shouldOutputJSON = outputsJSON(cmd)
 			opts, err := newDeployOpts(vars)
 			if err != nil {
 				return err
@@ -192,10 +831,24 @@ func BuildDeployCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", workloadFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
+	cmd.Flags().StringVar(&vars.builder, builderFlag, "", builderFlagDescription)
+	cmd.Flags().BoolVar(&vars.buildRemote, buildRemoteFlag, false, buildRemoteFlagDescription)
+	cmd.Flags().BoolVar(&vars.pinDigests, pinDigestsFlag, false, pinDigestsFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceFlagDescription)
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
+	cmd.Flags().BoolVar(&vars.waitForLock, waitForLockFlag, false, waitForLockFlagDescription)
+	cmd.Flags().DurationVar(&vars.lockTimeout, lockTimeoutFlag, defaultLockTimeout, lockTimeoutFlagDescription)
+	cmd.Flags().BoolVar(&vars.quotaIncrease, requestQuotaIncreaseFlag, false, requestQuotaIncreaseFlagDescription)
+	cmd.Flags().StringVar(&vars.overrideFreeze, overrideFreezeFlag, "", overrideFreezeFlagDescription)
+	cmd.Flags().BoolVar(&vars.deployAll, allFlag, false, deployAllFlagDescription)
+	cmd.Flags().IntVar(&vars.maxParallel, maxParallelFlag, defaultMaxParallelDeployments, maxParallelFlagDescription)
+	cmd.Flags().IntVar(&vars.maxParallelBuilds, maxParallelBuildsFlag, 0, maxParallelBuildsFlagDescription)
+	cmd.Flags().BoolVar(&vars.noBuildCache, noBuildCacheFlag, false, noBuildCacheFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.envNames, deployEnvsFlag, nil, deployEnvsFlagDescription)
+	cmd.MarkFlagsMutuallyExclusive(builderFlag, buildRemoteFlag)
+	cmd.MarkFlagsMutuallyExclusive(envFlag, deployEnvsFlag)
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
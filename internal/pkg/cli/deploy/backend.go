@@ -6,6 +6,7 @@ package deploy
 import (
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/acm"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
@@ -19,6 +20,9 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
 
+// maxConditionValuesPerRule is the maximum number of condition values, beyond the path pattern, of an ALB listener rule.
+const maxConditionValuesPerRule = 5
+
 type backendSvcDeployer struct {
 	*svcDeployer
 	backendMft *manifest.BackendService
@@ -39,10 +43,14 @@ func NewBackendDeployer(in *WorkloadDeployerInput) (*backendSvcDeployer, error)
 	if !ok {
 		return nil, fmt.Errorf("manifest is not of type %s", manifestinfo.BackendServiceType)
 	}
+	var certValidator aliasCertValidator = acm.New(svcDeployer.envSess)
+	if in.Offline != nil {
+		certValidator = newOfflineEnv(in.Offline, in.App, in.Env)
+	}
 	return &backendSvcDeployer{
 		svcDeployer:        svcDeployer,
 		backendMft:         bsMft,
-		aliasCertValidator: acm.New(svcDeployer.envSess),
+		aliasCertValidator: certValidator,
 	}, nil
 }
 
@@ -94,6 +102,9 @@ func (d *backendSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (
 	if err := d.validateALBRuntime(); err != nil {
 		return nil, err
 	}
+	if err := d.validateEC2Runtime(d.backendMft.TaskConfig); err != nil {
+		return nil, err
+	}
 
 	var conf cloudformation.StackConfiguration
 	switch {
@@ -105,6 +116,7 @@ func (d *backendSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (
 			EnvManifest:        d.envConfig,
 			Manifest:           d.backendMft,
 			RawManifest:        d.rawMft,
+			RenderedManifest:   d.renderedMft,
 			ArtifactBucketName: d.resources.S3Bucket,
 			RuntimeConfig:      *rc,
 			Addons:             d.addons,
@@ -119,6 +131,8 @@ func (d *backendSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (
 		svcUpdater: d.newSvcUpdater(func(s *session.Session) serviceForceUpdater {
 			return ecs.New(s)
 		}),
+		images: rc.PushedImages,
+		pinned: rc.PinnedImages,
 	}, nil
 }
 
@@ -142,6 +156,9 @@ func (d *backendSvcDeployer) validateRuntimeRoutingRule(rule manifest.RoutingRul
 		return nil
 	}
 	hasImportedCerts := len(d.envConfig.HTTPConfig.Private.Certificates) != 0
+	if rule.IsGRPC() && !hasImportedCerts {
+		return fmt.Errorf("cannot configure %s in an environment without imported certs", manifest.GRPCProtocol)
+	}
 	switch {
 	case rule.Alias.IsEmpty() && hasImportedCerts:
 		return &errSvcWithNoALBAliasDeployingToEnvWithImportedCerts{
@@ -150,14 +167,28 @@ func (d *backendSvcDeployer) validateRuntimeRoutingRule(rule manifest.RoutingRul
 		}
 	case rule.Alias.IsEmpty():
 		return nil
-	case !hasImportedCerts:
-		return fmt.Errorf(`cannot specify "alias" in an environment without imported certs`)
 	}
 
 	aliases, err := rule.Alias.ToStringSlice()
 	if err != nil {
 		return fmt.Errorf("convert aliases to string slice: %w", err)
 	}
+	if !hasImportedCerts {
+		privateZone := d.envConfig.HTTPConfig.Private.HostedZone
+		if privateZone.IsEmpty() {
+			return fmt.Errorf(`cannot specify "alias" in an environment without imported certs or a private hosted zone`)
+		}
+		for _, alias := range aliases {
+			if !privateZone.Contains(alias) {
+				return fmt.Errorf("alias %q is not in the private hosted zone %q of environment %q", alias, aws.StringValue(privateZone.Name), d.env.Name)
+			}
+		}
+		// Without certificates, the rule also matches the DNS names of the internal load balancer and of the service.
+		if len(rule.Hosts) == 0 && len(aliases)+len(rule.AllowedSourceIps)+2 >= maxConditionValuesPerRule {
+			return fmt.Errorf(`listener rule for path %q has more than five condition values: the rule matches up to %d values in "alias" and "allowed_source_ips"`, aws.StringValue(rule.Path), maxConditionValuesPerRule-3)
+		}
+		return nil
+	}
 
 	if err := d.aliasCertValidator.ValidateCertAliases(aliases, d.envConfig.HTTPConfig.Private.Certificates); err != nil {
 		return fmt.Errorf("validate aliases against the imported certificate for env %s: %w", d.env.Name, err)
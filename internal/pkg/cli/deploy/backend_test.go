@@ -114,7 +114,91 @@ func TestBackendSvcDeployer_stackConfiguration(t *testing.T) {
 				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return(mockAppName+".local", nil)
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 			},
-			expectedErr: `validate ALB runtime configuration for "http": cannot specify "alias" in an environment without imported certs`,
+			expectedErr: `validate ALB runtime configuration for "http": cannot specify "alias" in an environment without imported certs or a private hosted zone`,
+		},
+		"failure if alias is outside of the private hosted zone, no env certs": {
+			App: &config.Application{
+				Name: mockAppName,
+			},
+			Env: &config.Environment{
+				Name: mockEnvName,
+			},
+			inEnvironmentConfig: func() *manifest.Environment {
+				envConfig := &manifest.Environment{}
+				envConfig.HTTPConfig.Private.HostedZone.Name = aws.String("internal.example.com")
+				return envConfig
+			},
+			Manifest: &manifest.BackendService{
+				BackendServiceConfig: manifest.BackendServiceConfig{
+					HTTP: manifest.HTTP{
+						Main: manifest.RoutingRule{
+							Alias: manifest.Alias{
+								AdvancedAliases: []manifest.AdvancedAlias{
+									{Alias: aws.String("go.dev")},
+								},
+							},
+						},
+					},
+				},
+			},
+			setupMocks: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return(mockAppName+".local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+			expectedErr: `validate ALB runtime configuration for "http": alias "go.dev" is not in the private hosted zone "internal.example.com" of environment "mock-env"`,
+		},
+		"success if alias is in the private hosted zone, no env certs": {
+			App: &config.Application{
+				Name: mockAppName,
+			},
+			Env: &config.Environment{
+				Name: mockEnvName,
+			},
+			inEnvironmentConfig: func() *manifest.Environment {
+				envConfig := &manifest.Environment{}
+				envConfig.HTTPConfig.Private.HostedZone.Name = aws.String("internal.example.com")
+				return envConfig
+			},
+			Manifest: &manifest.BackendService{
+				BackendServiceConfig: manifest.BackendServiceConfig{
+					HTTP: manifest.HTTP{
+						Main: manifest.RoutingRule{
+							Alias: manifest.Alias{
+								AdvancedAliases: []manifest.AdvancedAlias{
+									{Alias: aws.String("api.internal.example.com")},
+								},
+							},
+						},
+					},
+				},
+			},
+			setupMocks: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return(mockAppName+".local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+		},
+		"failure if grpc configured, no env certs": {
+			App: &config.Application{
+				Name: mockAppName,
+			},
+			Env: &config.Environment{
+				Name: mockEnvName,
+			},
+			Manifest: &manifest.BackendService{
+				BackendServiceConfig: manifest.BackendServiceConfig{
+					HTTP: manifest.HTTP{
+						Main: manifest.RoutingRule{
+							Path:            aws.String("helloworld.Greeter"),
+							ProtocolVersion: aws.String("grpc"),
+						},
+					},
+				},
+			},
+			setupMocks: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return(mockAppName+".local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+			expectedErr: `validate ALB runtime configuration for "http": cannot configure gRPC in an environment without imported certs`,
 		},
 		"failure if cert validation fails": {
 			App: &config.Application{
@@ -254,6 +338,78 @@ func TestBackendSvcDeployer_stackConfiguration(t *testing.T) {
 			},
 			expectedErr: `validate ALB runtime configuration for "http.additional_rules[0]": cannot deploy service mock-svc without "alias" to environment mock-env with certificate imported`,
 		},
+		"failure if the service runs on EC2 in an environment without EC2 instances": {
+			App: &config.Application{
+				Name: mockAppName,
+			},
+			Env: &config.Environment{
+				Name: mockEnvName,
+			},
+			Manifest: &manifest.BackendService{
+				BackendServiceConfig: manifest.BackendServiceConfig{
+					TaskConfig: manifest.TaskConfig{
+						Compute: aws.String("ec2"),
+					},
+				},
+			},
+			setupMocks: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return(mockAppName+".local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+			expectedErr: `cannot deploy mock-svc with "compute: ec2" to environment mock-env without "compute.ec2"`,
+		},
+		"failure if the service reserves GPUs on EC2 instances without the GPU-optimized AMI": {
+			App: &config.Application{
+				Name: mockAppName,
+			},
+			Env: &config.Environment{
+				Name: mockEnvName,
+			},
+			Manifest: &manifest.BackendService{
+				BackendServiceConfig: manifest.BackendServiceConfig{
+					TaskConfig: manifest.TaskConfig{
+						Compute: aws.String("ec2"),
+						GPU:     aws.Int(1),
+					},
+				},
+			},
+			inEnvironmentConfig: func() *manifest.Environment {
+				envConfig := &manifest.Environment{}
+				envConfig.Compute.EC2.InstanceType = aws.String("m5.large")
+				return envConfig
+			},
+			setupMocks: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return(mockAppName+".local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+			expectedErr: `cannot reserve "gpu" for mock-svc since the EC2 instances in environment mock-env do not run the GPU-optimized AMI`,
+		},
+		"success if the service runs on the EC2 instances of the environment": {
+			App: &config.Application{
+				Name: mockAppName,
+			},
+			Env: &config.Environment{
+				Name: mockEnvName,
+			},
+			Manifest: &manifest.BackendService{
+				BackendServiceConfig: manifest.BackendServiceConfig{
+					TaskConfig: manifest.TaskConfig{
+						Compute: aws.String("ec2"),
+						GPU:     aws.Int(1),
+					},
+				},
+			},
+			inEnvironmentConfig: func() *manifest.Environment {
+				envConfig := &manifest.Environment{}
+				envConfig.Compute.EC2.InstanceType = aws.String("g4dn.xlarge")
+				envConfig.Compute.EC2.GPU = aws.Bool(true)
+				return envConfig
+			},
+			setupMocks: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return(mockAppName+".local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+		},
 		"success if env has imported certs but alb not configured": {
 			App: &config.Application{
 				Name: mockAppName,
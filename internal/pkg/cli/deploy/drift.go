@@ -0,0 +1,207 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dustin/go-humanize/english"
+	"gopkg.in/yaml.v3"
+
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/template/diff"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// DriftedParameter is a parameter of a deployed stack that was changed outside of Copilot.
+type DriftedParameter struct {
+	Key      string
+	Deployed string // Value of the deployed stack.
+	Manifest string // Value rendered from the manifest, which the deployment sets back.
+}
+
+// RevertedDrift returns the parameters of the service stack that were changed outside of Copilot since its last
+// recorded deployment, if deploying the template would only set them back to their values from the manifest.
+// It returns nil if the service is not deployed, has no recorded deployment, or the deployment changes anything else.
+//
+// The changes are found with the three-way diff of the parameters of the last recorded deployment, which is their
+// common base, against the parameters of the deployed stack and the parameters rendered from the manifest.
+// The image of the main container is compared with the deployed one only, since recorded deployments pin it to a digest.
+func (d *svcDeployer) RevertedDrift(out *GenerateCloudFormationTemplateOutput) ([]DriftedParameter, error) {
+	stackName := stack.NameForWorkload(d.app.Name, d.env.Name, d.name)
+	deployedTmpl, err := d.tmplGetter.Template(stackName)
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieve the deployed template for %q: %w", d.name, err)
+	}
+	tmplDiff, err := diff.From(deployedTmpl).ParseWithCFNOverriders([]byte(out.Template))
+	if err != nil {
+		return nil, fmt.Errorf("parse the diff against the deployed %q in environment %q: %w", d.name, d.env.Name, err)
+	}
+	if tmplDiff.HasChanges() {
+		return nil, nil
+	}
+	deployed, err := d.tmplGetter.StackParameters(stackName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve the deployed parameters for %q: %w", d.name, err)
+	}
+	var rendered struct {
+		Parameters map[string]string `json:"Parameters"`
+	}
+	if err := json.Unmarshal([]byte(out.Parameters), &rendered); err != nil {
+		return nil, fmt.Errorf("unmarshal the parameters of %q: %w", d.name, err)
+	}
+	if rendered.Parameters[stack.WorkloadContainerImageParamKey] != deployed[stack.WorkloadContainerImageParamKey] {
+		return nil, nil
+	}
+	ids, err := d.revisions.List(stackName)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	base, err := d.revisions.Get(stackName, ids[len(ids)-1])
+	if err != nil {
+		return nil, err
+	}
+	baseDoc, err := parametersDocument(base.ParameterValues)
+	if err != nil {
+		return nil, err
+	}
+	deployedDoc, err := parametersDocument(deployed)
+	if err != nil {
+		return nil, err
+	}
+	renderedDoc, err := parametersDocument(rendered.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := diff.From(baseDoc).ParseThreeWay(deployedDoc, renderedDoc)
+	if err != nil {
+		return nil, fmt.Errorf("parse the changes to the parameters of %q: %w", d.name, err)
+	}
+	if tree.Local().HasChanges() || !tree.Drift().HasChanges() {
+		return nil, nil
+	}
+	changes, err := tree.Drift().Changes()
+	if err != nil {
+		return nil, fmt.Errorf("list the changes to the parameters of %q: %w", d.name, err)
+	}
+	drifted := make([]DriftedParameter, 0, len(changes))
+	for _, change := range changes {
+		key := strings.TrimPrefix(change.Path, "/")
+		drifted = append(drifted, DriftedParameter{
+			Key:      key,
+			Deployed: deployed[key],
+			Manifest: rendered.Parameters[key],
+		})
+	}
+	sort.Slice(drifted, func(i, j int) bool { return drifted[i].Key < drifted[j].Key })
+	return drifted, nil
+}
+
+// parametersDocument returns the YAML document of the parameter values of a stack without the image of the main container.
+func parametersDocument(params map[string]string) ([]byte, error) {
+	values := make(map[string]string, len(params))
+	for key, val := range params {
+		if key == stack.WorkloadContainerImageParamKey {
+			continue
+		}
+		values[key] = val
+	}
+	doc, err := yaml.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("marshal stack parameters: %w", err)
+	}
+	return doc, nil
+}
+
+// lastRecordedTemplate returns the template of the last recorded deployment of the stack,
+// or an empty string if no deployment of the stack is recorded.
+func lastRecordedTemplate(revisions revisionRecorder, stackName string) (string, error) {
+	ids, err := revisions.List(stackName)
+	if err != nil {
+		return "", fmt.Errorf("list the recorded deployments of stack %s: %w", stackName, err)
+	}
+	if len(ids) == 0 {
+		return "", nil
+	}
+	rev, err := revisions.Get(stackName, ids[len(ids)-1])
+	if err != nil {
+		return "", fmt.Errorf("get the last recorded deployment of stack %s: %w", stackName, err)
+	}
+	return rev.TemplateBody, nil
+}
+
+var (
+	pinnedECRImage = regexp.MustCompile(`([0-9]{12}\.dkr\.ecr\.[a-z0-9-]+\.amazonaws\.com(?:\.cn)?/[a-z0-9._/-]+)@sha256:[0-9a-f]{64}`)
+	taggedECRImage = regexp.MustCompile(`([0-9]{12}\.dkr\.ecr\.[a-z0-9-]+\.amazonaws\.com(?:\.cn)?/[a-z0-9._/-]+):([\w][\w.-]{0,127})`)
+)
+
+// renderThreeWayDiff returns the changes made outside of Copilot to the deployed template since base, the template of
+// the last recorded deployment, followed by the changes of the new template against base.
+// If base is empty or the deployed template was not changed since, then it returns the diff against the deployed template.
+func renderThreeWayDiff(base, deployed, template string) (string, error) {
+	if base == "" {
+		return renderDiff(deployed, template)
+	}
+	// Recorded templates reference the images built by Copilot by digest, while the deployed ones reference them by tag.
+	base = unpinImages(base, taggedImages(deployed))
+	tree, err := diff.From(base).ParseThreeWay([]byte(deployed), []byte(template))
+	if err != nil {
+		return "", err
+	}
+	if !tree.Drift().HasChanges() {
+		return renderDiff(deployed, template)
+	}
+	deployDiff, err := diff.From(deployed).ParseWithCFNOverriders([]byte(template))
+	if err != nil {
+		return "", err
+	}
+	if !deployDiff.HasChanges() {
+		return "", nil
+	}
+	buf := strings.Builder{}
+	if summary := deployDiff.Summary(); !summary.Resources().IsEmpty() {
+		buf.WriteString(summary.String() + "\n")
+	}
+	if err := tree.Write(&buf, diff.WithColor(color.EnabledFor(os.Stdout))); err != nil {
+		return "", err
+	}
+	if drifted := tree.DriftedResources(); len(drifted) > 0 {
+		fmt.Fprintf(&buf, "Deploying reverts the changes made outside of Copilot to %s.\n", english.WordSeries(drifted, "and"))
+	}
+	return buf.String(), nil
+}
+
+// taggedImages returns the tagged references of the ECR images in the template by repository URI.
+func taggedImages(template string) map[string]string {
+	images := make(map[string]string)
+	for _, match := range taggedECRImage.FindAllStringSubmatch(template, -1) {
+		images[match[1]] = match[0]
+	}
+	return images
+}
+
+// unpinImages replaces the references by digest to the ECR images in the template with their tagged reference.
+// The references to the images that aren't tagged in images are kept.
+func unpinImages(template string, images map[string]string) string {
+	return pinnedECRImage.ReplaceAllStringFunc(template, func(pinned string) string {
+		if tagged, ok := images[pinned[:strings.LastIndex(pinned, "@")]]; ok {
+			return tagged
+		}
+		return pinned
+	})
+}
@@ -0,0 +1,245 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcDeployer_RevertedDrift(t *testing.T) {
+	const (
+		mockStack   = "phonetool-test-api"
+		mockTmpl    = "Resources:\n  Topic:\n    Type: AWS::SNS::Topic\n"
+		taggedImage = "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:bb133e7"
+		pinnedImage = "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:abc"
+	)
+	rendered := `{
+  "Parameters": {
+    "ContainerImage": "` + taggedImage + `",
+    "TaskCPU": "256",
+    "TaskCount": "1"
+  }
+}`
+	lastRevision := &stack.Revision{
+		ParameterValues: map[string]string{
+			"ContainerImage": pinnedImage,
+			"TaskCPU":        "256",
+			"TaskCount":      "1",
+		},
+	}
+	testCases := map[string]struct {
+		setupMocks func(tmpl *mocks.MockdeployedTemplateGetter, revs *mocks.MockrevisionRecorder)
+
+		wanted      []DriftedParameter
+		wantedError string
+	}{
+		"nothing to revert if the service is not deployed": {
+			setupMocks: func(tmpl *mocks.MockdeployedTemplateGetter, _ *mocks.MockrevisionRecorder) {
+				tmpl.EXPECT().Template(mockStack).Return("", &awscloudformation.ErrStackNotFound{})
+			},
+		},
+		"nothing to revert if the template changes": {
+			setupMocks: func(tmpl *mocks.MockdeployedTemplateGetter, _ *mocks.MockrevisionRecorder) {
+				tmpl.EXPECT().Template(mockStack).Return("Resources:\n  Queue:\n    Type: AWS::SQS::Queue\n", nil)
+			},
+		},
+		"nothing to revert if the image changes": {
+			setupMocks: func(tmpl *mocks.MockdeployedTemplateGetter, _ *mocks.MockrevisionRecorder) {
+				tmpl.EXPECT().Template(mockStack).Return(mockTmpl, nil)
+				tmpl.EXPECT().StackParameters(mockStack).Return(map[string]string{
+					"ContainerImage": "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:5dd1b2c",
+					"TaskCPU":        "256",
+					"TaskCount":      "3",
+				}, nil)
+			},
+		},
+		"nothing to revert without a recorded deployment": {
+			setupMocks: func(tmpl *mocks.MockdeployedTemplateGetter, revs *mocks.MockrevisionRecorder) {
+				tmpl.EXPECT().Template(mockStack).Return(mockTmpl, nil)
+				tmpl.EXPECT().StackParameters(mockStack).Return(map[string]string{
+					"ContainerImage": taggedImage,
+					"TaskCPU":        "256",
+					"TaskCount":      "3",
+				}, nil)
+				revs.EXPECT().List(mockStack).Return(nil, nil)
+			},
+		},
+		"nothing to revert if the manifest changed too": {
+			setupMocks: func(tmpl *mocks.MockdeployedTemplateGetter, revs *mocks.MockrevisionRecorder) {
+				tmpl.EXPECT().Template(mockStack).Return(mockTmpl, nil)
+				tmpl.EXPECT().StackParameters(mockStack).Return(map[string]string{
+					"ContainerImage": taggedImage,
+					"TaskCPU":        "256",
+					"TaskCount":      "3",
+				}, nil)
+				revs.EXPECT().List(mockStack).Return([]string{"20230102-150405"}, nil)
+				revs.EXPECT().Get(mockStack, "20230102-150405").Return(&stack.Revision{
+					ParameterValues: map[string]string{
+						"ContainerImage": pinnedImage,
+						"TaskCPU":        "512",
+						"TaskCount":      "1",
+					},
+				}, nil)
+			},
+		},
+		"return the parameters changed outside of Copilot since the last deployment": {
+			setupMocks: func(tmpl *mocks.MockdeployedTemplateGetter, revs *mocks.MockrevisionRecorder) {
+				tmpl.EXPECT().Template(mockStack).Return(mockTmpl, nil)
+				tmpl.EXPECT().StackParameters(mockStack).Return(map[string]string{
+					"ContainerImage": taggedImage,
+					"TaskCPU":        "256",
+					"TaskCount":      "3",
+				}, nil)
+				revs.EXPECT().List(mockStack).Return([]string{"20230101-000000", "20230102-150405"}, nil)
+				revs.EXPECT().Get(mockStack, "20230102-150405").Return(lastRevision, nil)
+			},
+			wanted: []DriftedParameter{
+				{Key: "TaskCount", Deployed: "3", Manifest: "1"},
+			},
+		},
+		"nothing to revert without a change outside of Copilot": {
+			setupMocks: func(tmpl *mocks.MockdeployedTemplateGetter, revs *mocks.MockrevisionRecorder) {
+				tmpl.EXPECT().Template(mockStack).Return(mockTmpl, nil)
+				tmpl.EXPECT().StackParameters(mockStack).Return(map[string]string{
+					"ContainerImage": taggedImage,
+					"TaskCPU":        "256",
+					"TaskCount":      "1",
+				}, nil)
+				revs.EXPECT().List(mockStack).Return([]string{"20230102-150405"}, nil)
+				revs.EXPECT().Get(mockStack, "20230102-150405").Return(lastRevision, nil)
+			},
+		},
+		"wrap the error if fail to get the deployed parameters": {
+			setupMocks: func(tmpl *mocks.MockdeployedTemplateGetter, _ *mocks.MockrevisionRecorder) {
+				tmpl.EXPECT().Template(mockStack).Return(mockTmpl, nil)
+				tmpl.EXPECT().StackParameters(mockStack).Return(nil, errors.New("some error"))
+			},
+			wantedError: `retrieve the deployed parameters for "api": some error`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			tmpl, revs := mocks.NewMockdeployedTemplateGetter(ctrl), mocks.NewMockrevisionRecorder(ctrl)
+			tc.setupMocks(tmpl, revs)
+			deployer := &svcDeployer{
+				workloadDeployer: &workloadDeployer{
+					name:       "api",
+					app:        &config.Application{Name: "phonetool"},
+					env:        &config.Environment{Name: "test"},
+					tmplGetter: tmpl,
+				},
+				revisions: revs,
+			}
+
+			got, err := deployer.RevertedDrift(&GenerateCloudFormationTemplateOutput{
+				Template:   mockTmpl,
+				Parameters: rendered,
+			})
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestRenderThreeWayDiff(t *testing.T) {
+	const (
+		pinnedImage = "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:5d41402abc4b2a76b9719d911017c5925d41402abc4b2a76b9719d911017c592"
+		taggedImage = "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:bb133e7"
+	)
+	template := func(delay, count int, image string) string {
+		return fmt.Sprintf(`Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+    Properties:
+      DelaySeconds: %d
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: %d
+      Image: %s
+`, delay, count, image)
+	}
+	testCases := map[string]struct {
+		inBase     string
+		inDeployed string
+		inTemplate string
+
+		wanted string
+	}{
+		"diff against the deployed template if no deployment is recorded": {
+			inDeployed: template(0, 1, taggedImage),
+			inTemplate: template(0, 2, taggedImage),
+			wanted: `0 resources added, 1 modified, 0 removed
+~ Resources/Service/Properties:
+    ~ DesiredCount: 1 -> 2
+`,
+		},
+		"diff against the deployed template if it wasn't changed outside of Copilot": {
+			inBase:     template(0, 1, pinnedImage),
+			inDeployed: template(0, 1, taggedImage),
+			inTemplate: template(0, 2, taggedImage),
+			wanted: `0 resources added, 1 modified, 0 removed
+~ Resources/Service/Properties:
+    ~ DesiredCount: 1 -> 2
+`,
+		},
+		"no changes if the template matches the deployed one": {
+			inBase:     template(0, 1, pinnedImage),
+			inDeployed: template(5, 1, taggedImage),
+			inTemplate: template(5, 1, taggedImage),
+		},
+		"show the changes made outside of Copilot apart from the changes from the manifest": {
+			inBase:     template(0, 1, pinnedImage),
+			inDeployed: template(5, 3, taggedImage),
+			inTemplate: template(0, 2, taggedImage),
+			wanted: `0 resources added, 2 modified, 0 removed
+Changes made outside of Copilot:
+~ Resources:
+    ~ Queue/Properties:
+        ~ DelaySeconds: 0 -> 5
+    ~ Service/Properties:
+        ~ DesiredCount: 1 -> 3
+Changes from your manifest:
+~ Resources/Service/Properties:
+    ~ DesiredCount: 1 -> 2
+Deploying reverts the changes made outside of Copilot to Queue and Service.
+`,
+		},
+		"only show the changes made outside of Copilot if the manifest is unchanged": {
+			inBase:     template(0, 1, pinnedImage),
+			inDeployed: template(5, 1, taggedImage),
+			inTemplate: template(0, 1, taggedImage),
+			wanted: `0 resources added, 1 modified, 0 removed
+Changes made outside of Copilot:
+~ Resources/Queue/Properties:
+    ~ DelaySeconds: 0 -> 5
+Deploying reverts the changes made outside of Copilot to Queue.
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := renderThreeWayDiff(tc.inBase, tc.inDeployed, tc.inTemplate)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
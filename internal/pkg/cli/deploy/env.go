@@ -12,7 +12,9 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
@@ -22,11 +24,13 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
 	awss3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cache"
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/patch"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/revision"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
@@ -34,7 +38,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/override"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
-	"github.com/aws/copilot-cli/internal/pkg/template/diff"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/spf13/afero"
@@ -68,10 +72,12 @@ type prefixListGetter interface {
 type envDescriber interface {
 	ValidateCFServiceDomainAliases() error
 	Params() (map[string]string, error)
+	Manifest() ([]byte, error)
 }
 
 type lbDescriber interface {
 	DescribeRule(context.Context, string) (elbv2.Rule, error)
+	LoadBalancer(arn string) (*elbv2.LoadBalancer, error)
 }
 
 type stackDescriber interface {
@@ -101,6 +107,9 @@ type envDeployer struct {
 	envDescriber             envDescriber
 	lbDescriber              lbDescriber
 	newServiceStackDescriber func(string) stackDescriber
+	cache                    *cache.Cache
+	newRevisions             func(bucket string) revisionRecorder // Nil unless the deployments of the environment are recorded.
+	now                      func() time.Time
 
 	// Dependencies for parsing addons.
 	ws              WorkspaceAddonsReaderPathGetter
@@ -120,6 +129,7 @@ type NewEnvDeployerInput struct {
 	ConfigStore     describe.ConfigStoreSvc
 	Workspace       WorkspaceAddonsReaderPathGetter
 	Overrider       Overrider
+	Offline         *Offline // Generate templates without looking up the environment and the application in AWS if not nil.
 }
 
 // NewEnvDeployer constructs an environment deployer.
@@ -136,12 +146,14 @@ func NewEnvDeployer(in *NewEnvDeployerInput) (*envDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get env session: %w", err)
 	}
-	envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+	var envDescriber envDescriber
+	if in.Offline != nil {
+		envDescriber = newOfflineEnv(in.Offline, in.App, in.Env)
+	} else if envDescriber, err = describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 		App:         in.App.Name,
 		Env:         in.Env.Name,
 		ConfigStore: in.ConfigStore,
-	})
-	if err != nil {
+	}); err != nil {
 		return nil, fmt.Errorf("initialize env describer: %w", err)
 	}
 	overrider := in.Overrider
@@ -177,21 +189,36 @@ func NewEnvDeployer(in *NewEnvDeployerInput) (*envDeployer, error) {
 		newServiceStackDescriber: func(svc string) stackDescriber {
 			return stack.NewStackDescriber(cfnstack.NameForWorkload(in.App.Name, in.Env.Name, svc), envManagerSession)
 		},
+		cache: cache.New(),
+		newRevisions: func(bucket string) revisionRecorder {
+			return revision.NewStore(awss3.New(envManagerSession), bucket)
+		},
+		now: time.Now,
 
 		ws: in.Workspace,
 	}
 	deployer.parseAddons = func() (stackBuilder, error) {
 		deployer.parseAddonsOnce.Do(func() {
-			deployer.addons.stack, deployer.addons.err = addon.ParseFromEnv(deployer.ws)
+			deployer.addons.stack, deployer.addons.err = addon.ParseFromEnv(deployer.env.Name, deployer.ws)
 		})
 		return deployer.addons.stack, deployer.addons.err
 	}
+	if in.Offline != nil {
+		offline := newOfflineEnv(in.Offline, in.App, in.Env)
+		deployer.prefixListGetter = offline
+		deployer.appCFN = offline
+		deployer.envDeployer = offlineEnvStack{cfnClient}
+		deployer.newRevisions = nil
+	}
 	return deployer, nil
 }
 
 // Validate returns an error if the environment manifest is incompatible with services and application configurations.
 func (d *envDeployer) Validate(mft *manifest.Environment) error {
-	return d.validateCDN(mft)
+	if err := d.validateCDN(mft); err != nil {
+		return err
+	}
+	return d.warnEgressChange(mft)
 }
 
 // UploadEnvArtifactsOutput holds URLs of artifacts pushed to S3 buckets.
@@ -223,25 +250,80 @@ func (d *envDeployer) UploadArtifacts() (*UploadEnvArtifactsOutput, error) {
 	}, nil
 }
 
-// DeployDiff returns the stringified diff of the template against the deployed template of the environment.
+// DeployDiff returns the stringified diff of the template against the deployed template of the environment,
+// followed by the diff of the addons template against the deployed addons stack.
+// The changes made outside of Copilot since the last recorded deployment of the environment are shown apart.
 func (d *envDeployer) DeployDiff(template string) (string, error) {
-	tmpl, err := d.tmplGetter.Template(cfnstack.NameForEnv(d.app.Name, d.env.Name))
+	stackName := cfnstack.NameForEnv(d.app.Name, d.env.Name)
+	tmpl, err := d.tmplGetter.Template(stackName)
+	isDeployed := true
 	if err != nil {
 		var errNotFound *awscloudformation.ErrStackNotFound
 		if !errors.As(err, &errNotFound) {
 			return "", fmt.Errorf("retrieve the deployed template for %q: %w", d.env.Name, err)
 		}
 		tmpl = ""
+		isDeployed = false
 	}
-	diffTree, err := diff.From(tmpl).ParseWithCFNOverriders([]byte(template))
+	var base string
+	if isDeployed {
+		if base, err = d.lastRecordedTemplate(stackName); err != nil {
+			return "", err
+		}
+	}
+	out, err := renderThreeWayDiff(base, tmpl, template)
 	if err != nil {
 		return "", fmt.Errorf("parse the diff against the deployed env stack %q: %w", d.env.Name, err)
 	}
-	buf := strings.Builder{}
-	if err := diffTree.Write(&buf); err != nil {
+	addonsOut, err := d.addonsDeployDiff(stackName, isDeployed)
+	if err != nil {
 		return "", err
 	}
-	return buf.String(), nil
+	if addonsOut == "" {
+		return out, nil
+	}
+	if out != "" {
+		out += "\n"
+	}
+	return out + fmt.Sprintf("Addons stack %q:\n", addon.StackName) + addonsOut, nil
+}
+
+// lastRecordedTemplate returns the template of the last recorded deployment of the environment stack,
+// or an empty string if its deployments aren't recorded.
+func (d *envDeployer) lastRecordedTemplate(stackName string) (string, error) {
+	if d.newRevisions == nil {
+		return "", nil
+	}
+	resources, err := d.getAppRegionalResources()
+	if err != nil {
+		return "", err
+	}
+	return lastRecordedTemplate(d.newRevisions(resources.S3Bucket), stackName)
+}
+
+// addonsDeployDiff returns the stringified diff of the environment addons template against the deployed addons stack.
+// If the environment has no addons or there are no differences, then returns an empty string.
+func (d *envDeployer) addonsDeployDiff(stackName string, isDeployed bool) (string, error) {
+	template, err := d.AddonsTemplate()
+	if err != nil || template == "" {
+		return "", err
+	}
+	var deployed string
+	if isDeployed {
+		deployed, err = d.tmplGetter.NestedStackTemplate(stackName, addon.StackName)
+		if err != nil {
+			var errNotFound *awscloudformation.ErrStackNotFound
+			if !errors.As(err, &errNotFound) {
+				return "", fmt.Errorf("retrieve the deployed addons template for %q: %w", d.env.Name, err)
+			}
+			deployed = ""
+		}
+	}
+	out, err := renderDiff(deployed, template)
+	if err != nil {
+		return "", fmt.Errorf("parse the diff against the deployed addons of environment %q: %w", d.env.Name, err)
+	}
+	return out, nil
 }
 
 // AddonsTemplate returns the environment addons template.
@@ -306,6 +388,43 @@ func (d *envDeployer) GenerateCloudFormationTemplate(in *DeployEnvironmentInput)
 	}, nil
 }
 
+// GenerateBootstrapTemplate returns the template and parameter configuration of the stack
+// that creates the roles of the environment before its first deployment.
+func (d *envDeployer) GenerateBootstrapTemplate(in *DeployEnvironmentInput) (*GenerateCloudFormationTemplateOutput, error) {
+	resources, err := d.getAppRegionalResources()
+	if err != nil {
+		return nil, err
+	}
+	partition, err := partitions.Region(d.env.Region).Partition()
+	if err != nil {
+		return nil, err
+	}
+	stack := cfnstack.NewBootstrapEnvStackConfig(&cfnstack.EnvConfig{
+		Name: d.env.Name,
+		App: deploy.AppInformation{
+			Name:                d.app.Name,
+			Domain:              d.app.Domain,
+			AccountPrincipalARN: in.RootUserARN,
+		},
+		AdditionalTags:       d.app.Tags,
+		ArtifactBucketARN:    awss3.FormatARN(partition.ID(), resources.S3Bucket),
+		ArtifactBucketKeyARN: resources.KMSKeyARN,
+		PermissionsBoundary:  in.PermissionsBoundary,
+	})
+	tpl, err := stack.Template()
+	if err != nil {
+		return nil, fmt.Errorf("generate bootstrap stack template: %w", err)
+	}
+	params, err := stack.SerializedParameters()
+	if err != nil {
+		return nil, fmt.Errorf("generate bootstrap stack template parameters: %w", err)
+	}
+	return &GenerateCloudFormationTemplateOutput{
+		Template:   tpl,
+		Parameters: params,
+	}, nil
+}
+
 // DeployEnvironment deploys an environment using CloudFormation.
 func (d *envDeployer) DeployEnvironment(in *DeployEnvironmentInput) error {
 	stackInput, err := d.buildStackInput(in)
@@ -330,7 +449,33 @@ func (d *envDeployer) DeployEnvironment(in *DeployEnvironmentInput) error {
 	if err != nil {
 		return err
 	}
-	return d.envDeployer.UpdateAndRenderEnvironment(stack, stackInput.ArtifactBucketARN, opts...)
+	err = d.envDeployer.UpdateAndRenderEnvironment(stack, stackInput.ArtifactBucketARN, opts...)
+	// The stack may have changed even if the deployment failed, so its description is read again by the next commands.
+	_ = d.cache.Invalidate(cache.EnvPrefix(d.env.AccountID, d.env.Region, d.app.Name, d.env.Name))
+	if err != nil {
+		return err
+	}
+	d.recordRevision(stack)
+	return nil
+}
+
+// recordRevision stores the deployed environment stack, so that the next deployments can tell apart
+// the changes made to the stack outside of Copilot. Failing to record it only results in a warning.
+func (d *envDeployer) recordRevision(conf deploycfn.StackConfiguration) {
+	if d.newRevisions == nil {
+		return
+	}
+	deployedAt := d.now()
+	resources, err := d.getAppRegionalResources()
+	if err == nil {
+		var rev *cfnstack.Revision
+		if rev, err = cfnstack.NewRevision(conf, deployedAt, nil); err == nil {
+			err = d.newRevisions(resources.S3Bucket).Record(rev)
+		}
+	}
+	if err != nil {
+		log.Warningf("Failed to record the deployment of environment %s: %v\n", d.env.Name, err)
+	}
 }
 
 func (d *envDeployer) getAppRegionalResources() (*cfnstack.AppRegionalResources, error) {
@@ -392,6 +537,9 @@ func (d *envDeployer) uploadAddons(bucket string) (string, error) {
 }
 
 func (d *envDeployer) buildStackInput(in *DeployEnvironmentInput) (*cfnstack.EnvConfig, error) {
+	if err := d.app.ResourceNames.ValidateEnvironment(d.app.Name, d.env.Name); err != nil {
+		return nil, fmt.Errorf("validate resource names of environment %s: %w", d.env.Name, err)
+	}
 	resources, err := d.getAppRegionalResources()
 	if err != nil {
 		return nil, err
@@ -408,6 +556,10 @@ func (d *envDeployer) buildStackInput(in *DeployEnvironmentInput) (*cfnstack.Env
 	if err != nil {
 		return nil, err
 	}
+	importedPublicALB, err := d.importedPublicALB(in)
+	if err != nil {
+		return nil, err
+	}
 	return &cfnstack.EnvConfig{
 		Name: d.env.Name,
 		App: deploy.AppInformation{
@@ -422,11 +574,13 @@ func (d *envDeployer) buildStackInput(in *DeployEnvironmentInput) (*cfnstack.Env
 		ArtifactBucketKeyARN: resources.KMSKeyARN,
 		CIDRPrefixListIDs:    cidrPrefixListIDs,
 		PublicALBSourceIPs:   d.publicALBSourceIPs(in),
+		ImportedPublicALB:    importedPublicALB,
 		Mft:                  in.Manifest,
 		ForceUpdate:          in.ForceNewUpdate,
 		RawMft:               in.RawManifest,
 		PermissionsBoundary:  in.PermissionsBoundary,
 		Version:              in.Version,
+		ResourceNames:        d.app.ResourceNames,
 	}, nil
 }
 
@@ -510,6 +664,59 @@ func (d *envDeployer) validateCDN(mft *manifest.Environment) error {
 	return nil
 }
 
+// warnEgressChange warns if the manifest changes how the private subnets reach the internet,
+// since their routes are replaced while the environment is updated.
+func (d *envDeployer) warnEgressChange(mft *manifest.Environment) error {
+	vpc := mft.Network.VPC
+	if vpc.NAT.IsEmpty() && vpc.IPv6 == nil {
+		return nil
+	}
+	raw, err := d.envDescriber.Manifest()
+	if err != nil {
+		return fmt.Errorf("get manifest of the deployed environment: %w", err)
+	}
+	deployed, err := manifest.UnmarshalEnvironment(raw)
+	if err != nil {
+		return fmt.Errorf("unmarshal manifest of the deployed environment: %w", err)
+	}
+	prev := deployed.Network.VPC
+	from, to := natTopology(prev.NAT), natTopology(vpc.NAT)
+	if from == to && aws.BoolValue(prev.IPv6) == aws.BoolValue(vpc.IPv6) {
+		return nil
+	}
+	var changes []string
+	if from != to {
+		changes = append(changes, fmt.Sprintf("The NAT of the private subnets changes from %s to %s.", from, to))
+	}
+	switch {
+	case aws.BoolValue(vpc.IPv6) && !aws.BoolValue(prev.IPv6):
+		changes = append(changes, "IPv6 is enabled for the subnets of the VPC.")
+	case !aws.BoolValue(vpc.IPv6) && aws.BoolValue(prev.IPv6):
+		changes = append(changes, "IPv6 is disabled for the subnets of the VPC.")
+	}
+	log.Warningf(`%s
+Workloads in private subnets can briefly lose internet access while their routes are replaced.
+Run %s to review the changes before deploying them.
+`, strings.Join(changes, "\n"), color.HighlightCode("copilot env deploy --diff"))
+	return nil
+}
+
+// natTopology returns a description of the NAT devices created by a NAT configuration.
+func natTopology(nat manifest.NATConfig) string {
+	device := "a NAT gateway"
+	if nat.InstanceType != nil {
+		device = fmt.Sprintf("a %s NAT instance", aws.StringValue(nat.InstanceType))
+	}
+	switch aws.StringValue(nat.Strategy) {
+	case manifest.NATStrategySingle:
+		return device + " shared by all availability zones"
+	case manifest.NATStrategyNone:
+		return "no NAT"
+	default:
+		return device + " per availability zone"
+	}
+}
+
 // validateALBWorkloadsDontRedirect verifies that none of the public ALB Workloads
 // in this environment have a redirect in their HTTPWithDomain listener.
 // If any services redirect, an error is returned.
@@ -584,6 +791,22 @@ func (d *envDeployer) publicALBSourceIPs(in *DeployEnvironmentInput) []string {
 	return ips
 }
 
+// importedPublicALB returns the description of the public load balancer imported in the manifest, if any.
+func (d *envDeployer) importedPublicALB(in *DeployEnvironmentInput) (*elbv2.LoadBalancer, error) {
+	if in.Manifest == nil || !in.Manifest.ImportsPublicALB() {
+		return nil, nil
+	}
+	arn := aws.StringValue(in.Manifest.Imports.PublicALB.ARN)
+	lb, err := d.lbDescriber.LoadBalancer(arn)
+	if err != nil {
+		return nil, fmt.Errorf("describe imported public load balancer: %w", err)
+	}
+	if lb.Scheme != elbv2.SchemeInternetFacing {
+		return nil, fmt.Errorf("imported public load balancer %s must be internet-facing instead of %s", arn, lb.Scheme)
+	}
+	return lb, nil
+}
+
 func (d *envDeployer) cfManagedPrefixListID() (string, error) {
 	id, err := d.prefixListGetter.CloudFrontManagedPrefixListID()
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"io"
 	"strings"
 	"testing"
+	"time"
 
 	cfnclient "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
@@ -43,6 +44,7 @@ type envDeployerMocks struct {
 	lbDescriber      *mocks.MocklbDescriber
 	stackDescribers  map[string]*mocks.MockstackDescriber
 	ws               *mocks.MockWorkspaceAddonsReaderPathGetter
+	revisions        *mocks.MockrevisionRecorder
 
 	parseAddons func() (stackBuilder, error)
 	addons      *mocks.MockstackBuilder
@@ -252,10 +254,12 @@ func TestEnvDeployer_UploadArtifacts(t *testing.T) {
 
 func TestEnvDeployer_DeployDiff(t *testing.T) {
 	testCases := map[string]struct {
-		inTemplate string
-		setUpMocks func(m *deployDiffMocks)
-		wanted     string
-		checkErr   func(t *testing.T, gotErr error)
+		inTemplate       string
+		hasAddons        bool
+		recordsRevisions bool
+		setUpMocks       func(m *deployDiffMocks)
+		wanted           string
+		checkErr         func(t *testing.T, gotErr error)
 	}{
 		"error getting the deployed template": {
 			setUpMocks: func(m *deployDiffMocks) {
@@ -296,6 +300,87 @@ func TestEnvDeployer_DeployDiff(t *testing.T) {
 					Return("", &cfnclient.ErrStackNotFound{})
 			},
 			wanted: `+ peace: and love
+`,
+		},
+		"error getting the deployed addons template": {
+			inTemplate: `peace: and love`,
+			hasAddons:  true,
+			setUpMocks: func(m *deployDiffMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(cfnstack.NameForEnv("mockApp", "mockEnv")).Return("peace: and love", nil)
+				m.mockAddons.EXPECT().Template().Return("Resources: {}", nil)
+				m.mockDeployedTmplGetter.EXPECT().NestedStackTemplate(cfnstack.NameForEnv("mockApp", "mockEnv"), "AddonsStack").Return("", errors.New("some error"))
+			},
+			checkErr: func(t *testing.T, gotErr error) {
+				require.EqualError(t, gotErr, `retrieve the deployed addons template for "mockEnv": some error`)
+			},
+		},
+		"get the diff of the addons": {
+			inTemplate: `peace: and love`,
+			hasAddons:  true,
+			setUpMocks: func(m *deployDiffMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(cfnstack.NameForEnv("mockApp", "mockEnv")).Return("peace: und Liebe", nil)
+				m.mockAddons.EXPECT().Template().Return("table: orders", nil)
+				m.mockDeployedTmplGetter.EXPECT().NestedStackTemplate(cfnstack.NameForEnv("mockApp", "mockEnv"), "AddonsStack").Return("table: items", nil)
+			},
+			wanted: `~ peace: und Liebe -> and love
+
+Addons stack "AddonsStack":
+~ table: items -> orders
+`,
+		},
+		"get the diff of the addons of an environment that isn't deployed": {
+			inTemplate: `peace: and love`,
+			hasAddons:  true,
+			setUpMocks: func(m *deployDiffMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(cfnstack.NameForEnv("mockApp", "mockEnv")).Return("", &cfnclient.ErrStackNotFound{})
+				m.mockAddons.EXPECT().Template().Return("table: orders", nil)
+			},
+			wanted: `+ peace: and love
+
+Addons stack "AddonsStack":
++ table: orders
+`,
+		},
+		"error getting the last recorded deployment": {
+			inTemplate:       `peace: and love`,
+			recordsRevisions: true,
+			setUpMocks: func(m *deployDiffMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(cfnstack.NameForEnv("mockApp", "mockEnv")).Return("peace: und Liebe", nil)
+				m.mockAppCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&cfnstack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.mockRevisions.EXPECT().List(cfnstack.NameForEnv("mockApp", "mockEnv")).Return(nil, errors.New("some error"))
+			},
+			checkErr: func(t *testing.T, gotErr error) {
+				require.EqualError(t, gotErr, `list the recorded deployments of stack mockApp-mockEnv: some error`)
+			},
+		},
+		"show the changes made outside of Copilot since the last recorded deployment": {
+			inTemplate:       "peace: and love\nwar: never\n",
+			recordsRevisions: true,
+			setUpMocks: func(m *deployDiffMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(cfnstack.NameForEnv("mockApp", "mockEnv")).Return("peace: und Liebe\nwar: none\n", nil)
+				m.mockAppCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&cfnstack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.mockRevisions.EXPECT().List(cfnstack.NameForEnv("mockApp", "mockEnv")).Return([]string{"1"}, nil)
+				m.mockRevisions.EXPECT().Get(cfnstack.NameForEnv("mockApp", "mockEnv"), "1").Return(&cfnstack.Revision{
+					TemplateBody: "peace: und Liebe\nwar: never\n",
+				}, nil)
+			},
+			wanted: `Changes made outside of Copilot:
+~ war: never -> none
+Changes from your manifest:
+~ peace: und Liebe -> and love
+`,
+		},
+		"do not look up recorded deployments of an environment that isn't deployed": {
+			inTemplate:       `peace: and love`,
+			recordsRevisions: true,
+			setUpMocks: func(m *deployDiffMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(cfnstack.NameForEnv("mockApp", "mockEnv")).Return("", &cfnclient.ErrStackNotFound{})
+			},
+			wanted: `+ peace: and love
 `,
 		},
 	}
@@ -306,6 +391,9 @@ func TestEnvDeployer_DeployDiff(t *testing.T) {
 
 			m := &deployDiffMocks{
 				mockDeployedTmplGetter: mocks.NewMockdeployedTemplateGetter(ctrl),
+				mockAddons:             mocks.NewMockstackBuilder(ctrl),
+				mockRevisions:          mocks.NewMockrevisionRecorder(ctrl),
+				mockAppCFN:             mocks.NewMockappResourcesGetter(ctrl),
 			}
 			tc.setUpMocks(m)
 			deployer := envDeployer{
@@ -313,9 +401,23 @@ func TestEnvDeployer_DeployDiff(t *testing.T) {
 					Name: "mockApp",
 				},
 				env: &config.Environment{
-					Name: "mockEnv",
+					Name:   "mockEnv",
+					Region: "us-west-2",
 				},
+				appCFN:     m.mockAppCFN,
 				tmplGetter: m.mockDeployedTmplGetter,
+				parseAddons: func() (stackBuilder, error) {
+					if !tc.hasAddons {
+						return nil, &addon.ErrAddonsNotFound{}
+					}
+					return m.mockAddons, nil
+				},
+			}
+			if tc.recordsRevisions {
+				deployer.newRevisions = func(bucket string) revisionRecorder {
+					require.Equal(t, "mockS3Bucket", bucket)
+					return m.mockRevisions
+				}
 			}
 			got, gotErr := deployer.DeployDiff(tc.inTemplate)
 			if tc.checkErr != nil {
@@ -562,6 +664,72 @@ func TestEnvDeployer_GenerateCloudFormationTemplate(t *testing.T) {
 	}
 }
 
+func TestEnvDeployer_GenerateBootstrapTemplate(t *testing.T) {
+	testCases := map[string]struct {
+		setUpMocks func(m *envDeployerMocks)
+
+		wantedParams string
+		wantedError  error
+	}{
+		"fail to get app resources by region": {
+			setUpMocks: func(m *envDeployerMocks) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get app resources in region us-west-2: some error"),
+		},
+		"generate the bootstrap stack template and parameters": {
+			setUpMocks: func(m *envDeployerMocks) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), "us-west-2").Return(&cfnstack.AppRegionalResources{
+					S3Bucket:  "mockS3Bucket",
+					KMSKeyARN: "mockKMSKeyARN",
+				}, nil)
+			},
+			wantedParams: `{
+  "Parameters": {
+    "AppName": "mockApp",
+    "EnvironmentName": "mockEnv",
+    "ToolsAccountPrincipalARN": "arn:aws:iam::000000000000:root"
+  },
+  "Tags": {
+    "copilot-application": "mockApp",
+    "copilot-environment": "mockEnv"
+  }
+}`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &envDeployerMocks{
+				appCFN: mocks.NewMockappResourcesGetter(ctrl),
+			}
+			tc.setUpMocks(m)
+			d := envDeployer{
+				app: &config.Application{Name: "mockApp"},
+				env: &config.Environment{
+					Name:   "mockEnv",
+					Region: "us-west-2",
+				},
+				appCFN: m.appCFN,
+			}
+
+			actual, err := d.GenerateBootstrapTemplate(&DeployEnvironmentInput{
+				RootUserARN: "arn:aws:iam::000000000000:root",
+			})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Contains(t, actual.Template, "arn:aws:s3:::mockS3Bucket")
+			require.JSONEq(t, tc.wantedParams, actual.Parameters)
+		})
+	}
+}
+
 func TestEnvDeployer_DeployEnvironment(t *testing.T) {
 	const (
 		mockManagerRoleARN = "mockManagerRoleARN"
@@ -576,6 +744,7 @@ func TestEnvDeployer_DeployEnvironment(t *testing.T) {
 		setUpMocks        func(m *envDeployerMocks)
 		inManifest        *manifest.Environment
 		inDisableRollback bool
+		recordsRevisions  bool
 		wantedError       error
 	}{
 		"fail to get app resources by region": {
@@ -622,6 +791,39 @@ func TestEnvDeployer_DeployEnvironment(t *testing.T) {
 			},
 			inManifest: nil,
 		},
+		"fail to describe the imported public load balancer": {
+			setUpMocks: func(m *envDeployerMocks) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&cfnstack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.parseAddons = func() (stackBuilder, error) { return nil, &addon.ErrAddonsNotFound{} }
+				m.lbDescriber.EXPECT().LoadBalancer("mockALBARN").Return(nil, errors.New("some error"))
+			},
+			inManifest: func() *manifest.Environment {
+				mft := &manifest.Environment{}
+				mft.Imports.PublicALB.ARN = aws.String("mockALBARN")
+				return mft
+			}(),
+			wantedError: errors.New("describe imported public load balancer: some error"),
+		},
+		"error if the imported public load balancer is internal": {
+			setUpMocks: func(m *envDeployerMocks) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&cfnstack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil)
+				m.parseAddons = func() (stackBuilder, error) { return nil, &addon.ErrAddonsNotFound{} }
+				m.lbDescriber.EXPECT().LoadBalancer("mockALBARN").Return(&elbv2.LoadBalancer{
+					ARN:    "mockALBARN",
+					Scheme: "internal",
+				}, nil)
+			},
+			inManifest: func() *manifest.Environment {
+				mft := &manifest.Environment{}
+				mft.Imports.PublicALB.ARN = aws.String("mockALBARN")
+				return mft
+			}(),
+			wantedError: errors.New("imported public load balancer mockALBARN must be internet-facing instead of internal"),
+		},
 		"fail to get existing parameters": {
 			setUpMocks: func(m *envDeployerMocks) {
 				m.appCFN.EXPECT().GetAppResourcesByRegion(gomock.Any(), gomock.Any()).Return(&cfnstack.AppRegionalResources{
@@ -668,6 +870,44 @@ func TestEnvDeployer_DeployEnvironment(t *testing.T) {
 				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
+		"record the successful environment deployment": {
+			recordsRevisions: true,
+			setUpMocks: func(m *envDeployerMocks) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&cfnstack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil).Times(2)
+				m.parseAddons = func() (stackBuilder, error) { return nil, &addon.ErrAddonsNotFound{} }
+				m.envDeployer.EXPECT().DeployedEnvironmentParameters(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.envDeployer.EXPECT().ForceUpdateOutputID(gomock.Any(), gomock.Any()).Return("", nil)
+				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.stackSerializer.EXPECT().StackName().Return("mockApp-mockEnv").AnyTimes()
+				m.stackSerializer.EXPECT().Template().Return("Resources: {}", nil)
+				m.stackSerializer.EXPECT().Parameters().Return(nil, nil)
+				m.stackSerializer.EXPECT().Tags().Return(nil)
+				m.revisions.EXPECT().Record(gomock.Any()).DoAndReturn(func(rev *cfnstack.Revision) error {
+					require.Equal(t, "mockApp-mockEnv", rev.Name)
+					require.Equal(t, "Resources: {}", rev.TemplateBody)
+					return nil
+				})
+			},
+		},
+		"do not fail the environment deployment if it can't be recorded": {
+			recordsRevisions: true,
+			setUpMocks: func(m *envDeployerMocks) {
+				m.appCFN.EXPECT().GetAppResourcesByRegion(mockApp, mockEnvRegion).Return(&cfnstack.AppRegionalResources{
+					S3Bucket: "mockS3Bucket",
+				}, nil).Times(2)
+				m.parseAddons = func() (stackBuilder, error) { return nil, &addon.ErrAddonsNotFound{} }
+				m.envDeployer.EXPECT().DeployedEnvironmentParameters(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.envDeployer.EXPECT().ForceUpdateOutputID(gomock.Any(), gomock.Any()).Return("", nil)
+				m.envDeployer.EXPECT().UpdateAndRenderEnvironment(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.stackSerializer.EXPECT().StackName().Return("mockApp-mockEnv").AnyTimes()
+				m.stackSerializer.EXPECT().Template().Return("Resources: {}", nil)
+				m.stackSerializer.EXPECT().Parameters().Return(nil, nil)
+				m.stackSerializer.EXPECT().Tags().Return(nil)
+				m.revisions.EXPECT().Record(gomock.Any()).Return(errors.New("some error"))
+			},
+		},
 		"successful environment deployment, no rollback": {
 			inDisableRollback: true,
 			setUpMocks: func(m *envDeployerMocks) {
@@ -692,6 +932,8 @@ func TestEnvDeployer_DeployEnvironment(t *testing.T) {
 				envDeployer:      mocks.NewMockenvironmentDeployer(ctrl),
 				prefixListGetter: mocks.NewMockprefixListGetter(ctrl),
 				stackSerializer:  cfnmocks.NewMockStackConfiguration(ctrl),
+				lbDescriber:      mocks.NewMocklbDescriber(ctrl),
+				revisions:        mocks.NewMockrevisionRecorder(ctrl),
 			}
 			tc.setUpMocks(m)
 			d := envDeployer{
@@ -704,11 +946,19 @@ func TestEnvDeployer_DeployEnvironment(t *testing.T) {
 				appCFN:           m.appCFN,
 				envDeployer:      m.envDeployer,
 				prefixListGetter: m.prefixListGetter,
+				lbDescriber:      m.lbDescriber,
 				parseAddons:      m.parseAddons,
 				newStack: func(_ *cfnstack.EnvConfig, _ string, _ []*awscfn.Parameter) (cloudformation.StackConfiguration, error) {
 					return m.stackSerializer, nil
 				},
 			}
+			if tc.recordsRevisions {
+				d.newRevisions = func(bucket string) revisionRecorder {
+					require.Equal(t, "mockS3Bucket", bucket)
+					return m.revisions
+				}
+				d.now = func() time.Time { return time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC) }
+			}
 			mockIn := &DeployEnvironmentInput{
 				RootUserARN: "mockRootUserARN",
 				CustomResourcesURLs: map[string]string{
@@ -766,6 +1016,16 @@ func TestEnvDeployer_Validate(t *testing.T) {
 			},
 		},
 	}
+	mftSingleNAT, err := manifest.UnmarshalEnvironment([]byte(`
+name: test
+type: Environment
+network:
+  vpc:
+    nat:
+      strategy: single
+      instance_type: t4g.nano
+`))
+	require.NoError(t, err)
 	tests := map[string]struct {
 		app            *config.Application
 		mft            *manifest.Environment
@@ -1018,6 +1278,43 @@ If you'd like to use these services without a CDN, ensure each service's A recor
 				m.lbDescriber.EXPECT().DescribeRule(gomock.Any(), "svc1RuleARN").Return(listenerRuleNoRedirect, nil)
 			},
 		},
+		"nat configured, fail to get deployed manifest": {
+			app: &config.Application{},
+			mft: mftSingleNAT,
+			setUpMocks: func(m *envDeployerMocks, ctrl *gomock.Controller) {
+				m.envDescriber.EXPECT().Manifest().Return(nil, errors.New("some error"))
+			},
+			expected: "get manifest of the deployed environment: some error",
+		},
+		"nat unchanged": {
+			app: &config.Application{},
+			mft: mftSingleNAT,
+			setUpMocks: func(m *envDeployerMocks, ctrl *gomock.Controller) {
+				m.envDescriber.EXPECT().Manifest().Return([]byte(`
+name: test
+type: Environment
+network:
+  vpc:
+    nat:
+      strategy: single
+      instance_type: t4g.nano
+`), nil)
+			},
+		},
+		"warn when nat changes": {
+			app: &config.Application{},
+			mft: mftSingleNAT,
+			setUpMocks: func(m *envDeployerMocks, ctrl *gomock.Controller) {
+				m.envDescriber.EXPECT().Manifest().Return([]byte(`
+name: test
+type: Environment
+`), nil)
+			},
+			expectedStdErr: `Note: The NAT of the private subnets changes from a NAT gateway per availability zone to a t4g.nano NAT instance shared by all availability zones.
+Workloads in private subnets can briefly lose internet access while their routes are replaced.
+Run ` + "`copilot env deploy --diff`" + ` to review the changes before deploying them.
+`,
+		},
 	}
 
 	for name, tc := range tests {
@@ -84,3 +84,27 @@ If you'd like to use %s without a CDN, ensure %s A record is pointed to the ALB.
 		english.PluralWord(len(e.services), "its", "each service's"),
 	)
 }
+
+type errVulnerableImages struct {
+	containers []string
+	blockOn    string
+}
+
+func (e *errVulnerableImages) Error() string {
+	quoted := make([]string, len(e.containers))
+	for i := range e.containers {
+		quoted[i] = strconv.Quote(e.containers[i])
+	}
+	return fmt.Sprintf("found vulnerabilities of severity %s or higher in the %s of %s",
+		e.blockOn,
+		english.PluralWord(len(e.containers), "image", "images"),
+		english.OxfordWordSeries(quoted, "and"),
+	)
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+// Implements main.actionRecommender interface.
+func (e *errVulnerableImages) RecommendActions() string {
+	return fmt.Sprintf(`Update the vulnerable packages and redeploy, or raise the threshold with the %s field in the manifest.`,
+		color.HighlightCode(`"image.scan.block_on"`))
+}
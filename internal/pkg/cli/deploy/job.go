@@ -97,6 +97,9 @@ func (d *jobDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*jobSta
 	if err != nil {
 		return nil, err
 	}
+	if err := d.validateEC2Runtime(d.jobMft.TaskConfig); err != nil {
+		return nil, err
+	}
 
 	var conf cloudformation.StackConfiguration
 	switch {
@@ -108,6 +111,7 @@ func (d *jobDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*jobSta
 			Env:                d.env.Name,
 			Manifest:           d.jobMft,
 			RawManifest:        d.rawMft,
+			RenderedManifest:   d.renderedMft,
 			ArtifactBucketName: d.resources.S3Bucket,
 			RuntimeConfig:      *rc,
 			Addons:             d.addons,
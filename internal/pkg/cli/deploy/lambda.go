@@ -0,0 +1,114 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/lambda"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+)
+
+type lambdaSvcDeployer struct {
+	*svcDeployer
+	lambdaMft *manifest.LambdaService
+
+	// Overriden in tests.
+	newStack func(*stack.LambdaServiceConfig) (cloudformation.StackConfiguration, error)
+}
+
+// NewLambdaDeployer is the constructor for lambdaSvcDeployer.
+func NewLambdaDeployer(in *WorkloadDeployerInput) (*lambdaSvcDeployer, error) {
+	in.customResources = lambdaCustomResources
+	svcDeployer, err := newSvcDeployer(in)
+	if err != nil {
+		return nil, err
+	}
+	mft, ok := in.Mft.(*manifest.LambdaService)
+	if !ok {
+		return nil, fmt.Errorf("manifest is not of type %s", manifestinfo.LambdaServiceType)
+	}
+	return &lambdaSvcDeployer{
+		svcDeployer: svcDeployer,
+		lambdaMft:   mft,
+		newStack: func(config *stack.LambdaServiceConfig) (cloudformation.StackConfiguration, error) {
+			return stack.NewLambdaService(config)
+		},
+	}, nil
+}
+
+func lambdaCustomResources(fs template.Reader) ([]*customresource.CustomResource, error) {
+	crs, err := customresource.LambdaService(fs)
+	if err != nil {
+		return nil, fmt.Errorf("read custom resources for a %q: %w", manifestinfo.LambdaServiceType, err)
+	}
+	return crs, nil
+}
+
+// IsServiceAvailableInRegion checks if service type exist in the given region.
+func (*lambdaSvcDeployer) IsServiceAvailableInRegion(region string) (bool, error) {
+	return partitions.IsAvailableInRegion(lambda.EndpointsID, region)
+}
+
+// UploadArtifacts uploads the deployment artifacts such as the container image, custom resources and addons.
+func (d *lambdaSvcDeployer) UploadArtifacts() (*UploadArtifactsOutput, error) {
+	return d.uploadArtifacts(d.uploadContainerImages, d.uploadArtifactsToS3, d.uploadCustomResources)
+}
+
+// GenerateCloudFormationTemplate generates a CloudFormation template and parameters for a workload.
+func (d *lambdaSvcDeployer) GenerateCloudFormationTemplate(in *GenerateCloudFormationTemplateInput) (
+	*GenerateCloudFormationTemplateOutput, error) {
+	conf, err := d.stackConfiguration(&in.StackRuntimeConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	return d.generateCloudFormationTemplate(conf)
+}
+
+// DeployWorkload deploys a Lambda service using CloudFormation.
+func (d *lambdaSvcDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecommender, error) {
+	conf, err := d.stackConfiguration(&in.StackRuntimeConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	opts := []awscloudformation.StackOption{
+		awscloudformation.WithRoleARN(d.env.ExecutionRoleARN),
+	}
+	if in.Options.DisableRollback {
+		opts = append(opts, awscloudformation.WithDisableRollback())
+	}
+	// There are no tasks to restart with --force: a new version of the function is published whenever it changes.
+	if err := d.deployer.DeployService(conf, d.resources.S3Bucket, opts...); err != nil {
+		return nil, fmt.Errorf("deploy service: %w", err)
+	}
+	return noopActionRecommender{}, nil
+}
+
+func (d *lambdaSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (cloudformation.StackConfiguration, error) {
+	rc, err := d.runtimeConfig(in)
+	if err != nil {
+		return nil, err
+	}
+	conf, err := d.newStack(&stack.LambdaServiceConfig{
+		App:                d.app,
+		EnvManifest:        d.envConfig,
+		Manifest:           d.lambdaMft,
+		RawManifest:        d.rawMft,
+		RenderedManifest:   d.renderedMft,
+		ArtifactBucketName: d.resources.S3Bucket,
+		RuntimeConfig:      *rc,
+		Addons:             d.addons,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create stack configuration: %w", err)
+	}
+	return cloudformation.WrapWithTemplateOverrider(conf, d.overrider), nil
+}
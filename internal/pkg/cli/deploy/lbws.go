@@ -5,6 +5,7 @@ package deploy
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -64,6 +65,22 @@ func NewLBWSDeployer(in *WorkloadDeployerInput) (*lbWebSvcDeployer, error) {
 	if err != nil {
 		return nil, err
 	}
+	lbMft, ok := in.Mft.(*manifest.LoadBalancedWebService)
+	if !ok {
+		return nil, fmt.Errorf("manifest is not of type %s", manifestinfo.LoadBalancedWebServiceType)
+	}
+	if in.Offline != nil {
+		offline := newOfflineEnv(in.Offline, in.App, in.Env)
+		return &lbWebSvcDeployer{
+			svcDeployer:            svcDeployer,
+			appVersionGetter:       offline,
+			publicCIDRBlocksGetter: offline,
+			lbMft:                  lbMft,
+			newAliasCertValidator: func(optionalRegion *string) aliasCertValidator {
+				return offline
+			},
+		}, nil
+	}
 	versionGetter, err := describe.NewAppDescriber(in.App.Name)
 	if err != nil {
 		return nil, fmt.Errorf("new app describer for application %s: %w", in.App.Name, err)
@@ -81,10 +98,6 @@ func NewLBWSDeployer(in *WorkloadDeployerInput) (*lbWebSvcDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("create describer for environment %s in application %s: %w", in.Env.Name, in.App.Name, err)
 	}
-	lbMft, ok := in.Mft.(*manifest.LoadBalancedWebService)
-	if !ok {
-		return nil, fmt.Errorf("manifest is not of type %s", manifestinfo.LoadBalancedWebServiceType)
-	}
 	return &lbWebSvcDeployer{
 		svcDeployer:            svcDeployer,
 		appVersionGetter:       versionGetter,
@@ -150,6 +163,9 @@ func (d *lbWebSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*s
 	if err := d.validateNLBRuntime(); err != nil {
 		return nil, err
 	}
+	if err := d.validateEC2Runtime(d.lbMft.TaskConfig); err != nil {
+		return nil, err
+	}
 	var opts []stack.LoadBalancedWebServiceOption
 	if !d.lbMft.NLBConfig.IsEmpty() {
 		cidrBlocks, err := d.publicCIDRBlocksGetter.PublicCIDRBlocks()
@@ -169,6 +185,7 @@ func (d *lbWebSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*s
 			EnvManifest:        d.envConfig,
 			Manifest:           d.lbMft,
 			RawManifest:        d.rawMft,
+			RenderedManifest:   d.renderedMft,
 			ArtifactBucketName: d.resources.S3Bucket,
 			RuntimeConfig:      *rc,
 			RootUserARN:        in.RootUserARN,
@@ -184,6 +201,8 @@ func (d *lbWebSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*s
 		svcUpdater: d.newSvcUpdater(func(s *session.Session) serviceForceUpdater {
 			return ecs.New(s)
 		}),
+		images: rc.PushedImages,
+		pinned: rc.PinnedImages,
 	}, nil
 }
 
@@ -198,16 +217,42 @@ func (d *lbWebSvcDeployer) validateALBRuntime() error {
 			return fmt.Errorf(`validate ALB runtime configuration for "http.additional_rule[%d]": %w`, idx, err)
 		}
 	}
+	if err := d.validateRuntimeClientAuth(d.lbMft.HTTPOrBool.TLS); err != nil {
+		return fmt.Errorf(`validate ALB runtime configuration for "http.tls": %w`, err)
+	}
+	return nil
+}
+
+func (d *lbWebSvcDeployer) validateRuntimeClientAuth(tls manifest.HTTPTLSConfig) error {
+	if tls.ClientAuth == nil {
+		return nil
+	}
+	if d.app.Domain == "" && !d.envConfig.HTTPConfig.Public.HasCertificates() {
+		return fmt.Errorf("cannot configure mutual TLS without having a domain associated with the app %q or importing any certificates in env %q", d.app.Name, d.env.Name)
+	}
+	if d.envConfig.CDNEnabled() {
+		// CloudFront terminates TLS, so the client certificates never reach the load balancer.
+		return fmt.Errorf("cannot configure mutual TLS when CloudFront is enabled in env %q", d.env.Name)
+	}
+	if aws.StringValue(tls.ClientAuth) == manifest.ClientAuthVerify && d.envConfig.HTTPConfig.Public.TrustStore.IsEmpty() {
+		return fmt.Errorf(`cannot verify client certificates without a "http.public.trust_store" in env %q`, d.env.Name)
+	}
 	return nil
 }
 
 func (d *lbWebSvcDeployer) validateRuntimeRoutingRule(rule manifest.RoutingRule) error {
-	hasALBCerts := len(d.envConfig.HTTPConfig.Public.Certificates) != 0
+	hasALBCerts := d.envConfig.HTTPConfig.Public.HasCertificates()
 	hasCDNCerts := d.envConfig.CDNConfig.Config.Certificate != nil
 	hasImportedCerts := hasALBCerts || hasCDNCerts
 	if rule.RedirectToHTTPS != nil && d.app.Domain == "" && !hasImportedCerts {
 		return fmt.Errorf("cannot configure http to https redirect without having a domain associated with the app %q or importing any certificates in env %q", d.app.Name, d.env.Name)
 	}
+	if rule.IsGRPC() && d.app.Domain == "" && !hasImportedCerts {
+		return fmt.Errorf("cannot configure %s without having a domain associated with the app %q or importing any certificates in env %q", manifest.GRPCProtocol, d.app.Name, d.env.Name)
+	}
+	if !rule.Auth.IsEmpty() && d.app.Domain == "" && !hasImportedCerts {
+		return fmt.Errorf("cannot configure authentication without having a domain associated with the app %q or importing any certificates in env %q", d.app.Name, d.env.Name)
+	}
 	if rule.Alias.IsEmpty() {
 		if hasImportedCerts {
 			return &errSvcWithNoALBAliasDeployingToEnvWithImportedCerts{
@@ -235,9 +280,8 @@ func (d *lbWebSvcDeployer) validateRuntimeRoutingRule(rule manifest.RoutingRule)
 		}
 
 		if hasALBCerts {
-			albCertValidator := d.newAliasCertValidator(nil)
-			if err := albCertValidator.ValidateCertAliases(aliases, d.envConfig.HTTPConfig.Public.Certificates); err != nil {
-				return fmt.Errorf("validate aliases against the imported public ALB certificate for env %s: %w", d.env.Name, err)
+			if err := d.validateALBCertAliases(aliases); err != nil {
+				return err
 			}
 		}
 		if hasCDNCerts {
@@ -262,6 +306,46 @@ func (d *lbWebSvcDeployer) validateRuntimeRoutingRule(rule manifest.RoutingRule)
 	return fmt.Errorf(`cannot specify "alias" when application is not associated with a domain and env %s doesn't import one or more certificates`, d.env.Name)
 }
 
+// validateALBCertAliases returns nil if each alias is covered by a certificate that the environment manages,
+// or else by one of the certificates it imports.
+func (d *lbWebSvcDeployer) validateALBCertAliases(aliases []string) error {
+	var uncovered []string
+	for _, alias := range aliases {
+		if !managedCertificatesCover(d.envConfig.HTTPConfig.Public.ManagedCertificates, alias) {
+			uncovered = append(uncovered, alias)
+		}
+	}
+	if len(uncovered) == 0 {
+		return nil
+	}
+	imported := d.envConfig.HTTPConfig.Public.Certificates
+	if len(imported) == 0 {
+		return fmt.Errorf("alias %q is not covered by the managed certificates of env %s", uncovered[0], d.env.Name)
+	}
+	if err := d.newAliasCertValidator(nil).ValidateCertAliases(uncovered, imported); err != nil {
+		return fmt.Errorf("validate aliases against the imported public ALB certificate for env %s: %w", d.env.Name, err)
+	}
+	return nil
+}
+
+// managedCertificatesCover returns true if the domain or one of the alternative names of a certificate matches the alias.
+// A wildcard name matches a single label, such that "*.example.com" matches "api.example.com".
+func managedCertificatesCover(certs []manifest.ManagedCertificate, alias string) bool {
+	for _, cert := range certs {
+		for _, domain := range cert.Domains() {
+			if domain == alias {
+				return true
+			}
+			if suffix, ok := strings.CutPrefix(domain, "*."); ok {
+				if label, rest, found := strings.Cut(alias, "."); found && label != "" && rest == suffix {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 func (d *lbWebSvcDeployer) validateNLBRuntime() error {
 	if d.lbMft.NLBConfig.Aliases.IsEmpty() {
 		return nil
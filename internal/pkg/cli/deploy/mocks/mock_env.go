@@ -349,6 +349,21 @@ func (m *MockenvDescriber) EXPECT() *MockenvDescriberMockRecorder {
 	return m.recorder
 }
 
+// Manifest mocks base method.
+func (m *MockenvDescriber) Manifest() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Manifest")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Manifest indicates an expected call of Manifest.
+func (mr *MockenvDescriberMockRecorder) Manifest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Manifest", reflect.TypeOf((*MockenvDescriber)(nil).Manifest))
+}
+
 // Params mocks base method.
 func (m *MockenvDescriber) Params() (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -416,6 +431,21 @@ func (mr *MocklbDescriberMockRecorder) DescribeRule(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeRule", reflect.TypeOf((*MocklbDescriber)(nil).DescribeRule), arg0, arg1)
 }
 
+// LoadBalancer mocks base method.
+func (m *MocklbDescriber) LoadBalancer(arn string) (*elbv2.LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadBalancer", arn)
+	ret0, _ := ret[0].(*elbv2.LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoadBalancer indicates an expected call of LoadBalancer.
+func (mr *MocklbDescriberMockRecorder) LoadBalancer(arn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadBalancer", reflect.TypeOf((*MocklbDescriber)(nil).LoadBalancer), arn)
+}
+
 // MockstackDescriber is a mock of stackDescriber interface.
 type MockstackDescriber struct {
 	ctrl     *gomock.Controller
@@ -6,7 +6,10 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
+	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	cloudfront "github.com/aws/copilot-cli/internal/pkg/aws/cloudfront"
 	manifest "github.com/aws/copilot-cli/internal/pkg/manifest"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -48,3 +51,147 @@ func (mr *MockfileUploaderMockRecorder) UploadFiles(files interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadFiles", reflect.TypeOf((*MockfileUploader)(nil).UploadFiles), files)
 }
+
+// MockuploadedAssetsGetter is a mock of uploadedAssetsGetter interface.
+type MockuploadedAssetsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockuploadedAssetsGetterMockRecorder
+}
+
+// MockuploadedAssetsGetterMockRecorder is the mock recorder for MockuploadedAssetsGetter.
+type MockuploadedAssetsGetterMockRecorder struct {
+	mock *MockuploadedAssetsGetter
+}
+
+// NewMockuploadedAssetsGetter creates a new mock instance.
+func NewMockuploadedAssetsGetter(ctrl *gomock.Controller) *MockuploadedAssetsGetter {
+	mock := &MockuploadedAssetsGetter{ctrl: ctrl}
+	mock.recorder = &MockuploadedAssetsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuploadedAssetsGetter) EXPECT() *MockuploadedAssetsGetterMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method.
+func (m *MockuploadedAssetsGetter) Download(bucket, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", bucket, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockuploadedAssetsGetterMockRecorder) Download(bucket, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockuploadedAssetsGetter)(nil).Download), bucket, key)
+}
+
+// ObjectKeysModifiedAfter mocks base method.
+func (m *MockuploadedAssetsGetter) ObjectKeysModifiedAfter(bucket, prefix string, after time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectKeysModifiedAfter", bucket, prefix, after)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ObjectKeysModifiedAfter indicates an expected call of ObjectKeysModifiedAfter.
+func (mr *MockuploadedAssetsGetterMockRecorder) ObjectKeysModifiedAfter(bucket, prefix, after interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectKeysModifiedAfter", reflect.TypeOf((*MockuploadedAssetsGetter)(nil).ObjectKeysModifiedAfter), bucket, prefix, after)
+}
+
+// MockstackResourcesGetter is a mock of stackResourcesGetter interface.
+type MockstackResourcesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackResourcesGetterMockRecorder
+}
+
+// MockstackResourcesGetterMockRecorder is the mock recorder for MockstackResourcesGetter.
+type MockstackResourcesGetterMockRecorder struct {
+	mock *MockstackResourcesGetter
+}
+
+// NewMockstackResourcesGetter creates a new mock instance.
+func NewMockstackResourcesGetter(ctrl *gomock.Controller) *MockstackResourcesGetter {
+	mock := &MockstackResourcesGetter{ctrl: ctrl}
+	mock.recorder = &MockstackResourcesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackResourcesGetter) EXPECT() *MockstackResourcesGetterMockRecorder {
+	return m.recorder
+}
+
+// StackResources mocks base method.
+func (m *MockstackResourcesGetter) StackResources(name string) ([]*cloudformation.StackResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackResources", name)
+	ret0, _ := ret[0].([]*cloudformation.StackResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackResources indicates an expected call of StackResources.
+func (mr *MockstackResourcesGetterMockRecorder) StackResources(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResources", reflect.TypeOf((*MockstackResourcesGetter)(nil).StackResources), name)
+}
+
+// MockinvalidationGetter is a mock of invalidationGetter interface.
+type MockinvalidationGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockinvalidationGetterMockRecorder
+}
+
+// MockinvalidationGetterMockRecorder is the mock recorder for MockinvalidationGetter.
+type MockinvalidationGetterMockRecorder struct {
+	mock *MockinvalidationGetter
+}
+
+// NewMockinvalidationGetter creates a new mock instance.
+func NewMockinvalidationGetter(ctrl *gomock.Controller) *MockinvalidationGetter {
+	mock := &MockinvalidationGetter{ctrl: ctrl}
+	mock.recorder = &MockinvalidationGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockinvalidationGetter) EXPECT() *MockinvalidationGetterMockRecorder {
+	return m.recorder
+}
+
+// InvalidationStatus mocks base method.
+func (m *MockinvalidationGetter) InvalidationStatus(distributionID, invalidationID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidationStatus", distributionID, invalidationID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvalidationStatus indicates an expected call of InvalidationStatus.
+func (mr *MockinvalidationGetterMockRecorder) InvalidationStatus(distributionID, invalidationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidationStatus", reflect.TypeOf((*MockinvalidationGetter)(nil).InvalidationStatus), distributionID, invalidationID)
+}
+
+// LatestInvalidation mocks base method.
+func (m *MockinvalidationGetter) LatestInvalidation(distributionID string) (*cloudfront.Invalidation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestInvalidation", distributionID)
+	ret0, _ := ret[0].(*cloudfront.Invalidation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestInvalidation indicates an expected call of LatestInvalidation.
+func (mr *MockinvalidationGetterMockRecorder) LatestInvalidation(distributionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestInvalidation", reflect.TypeOf((*MockinvalidationGetter)(nil).LatestInvalidation), distributionID)
+}
@@ -9,6 +9,8 @@ import (
 	reflect "reflect"
 	time "time"
 
+	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	stack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -140,6 +142,43 @@ func (mr *MockserviceForceUpdaterMockRecorder) LastUpdatedAt(app, env, svc inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastUpdatedAt", reflect.TypeOf((*MockserviceForceUpdater)(nil).LastUpdatedAt), app, env, svc)
 }
 
+// MockserviceImageUpdater is a mock of serviceImageUpdater interface.
+type MockserviceImageUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceImageUpdaterMockRecorder
+}
+
+// MockserviceImageUpdaterMockRecorder is the mock recorder for MockserviceImageUpdater.
+type MockserviceImageUpdaterMockRecorder struct {
+	mock *MockserviceImageUpdater
+}
+
+// NewMockserviceImageUpdater creates a new mock instance.
+func NewMockserviceImageUpdater(ctrl *gomock.Controller) *MockserviceImageUpdater {
+	mock := &MockserviceImageUpdater{ctrl: ctrl}
+	mock.recorder = &MockserviceImageUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceImageUpdater) EXPECT() *MockserviceImageUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateServiceImages mocks base method.
+func (m *MockserviceImageUpdater) UpdateServiceImages(app, env, svc string, images map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceImages", app, env, svc, images)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateServiceImages indicates an expected call of UpdateServiceImages.
+func (mr *MockserviceImageUpdaterMockRecorder) UpdateServiceImages(app, env, svc, images interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceImages", reflect.TypeOf((*MockserviceImageUpdater)(nil).UpdateServiceImages), app, env, svc, images)
+}
+
 // MockaliasCertValidator is a mock of aliasCertValidator interface.
 type MockaliasCertValidator struct {
 	ctrl     *gomock.Controller
@@ -176,3 +215,122 @@ func (mr *MockaliasCertValidatorMockRecorder) ValidateCertAliases(aliases, certs
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCertAliases", reflect.TypeOf((*MockaliasCertValidator)(nil).ValidateCertAliases), aliases, certs)
 }
+
+// MockrevisionRecorder is a mock of revisionRecorder interface.
+type MockrevisionRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockrevisionRecorderMockRecorder
+}
+
+// MockrevisionRecorderMockRecorder is the mock recorder for MockrevisionRecorder.
+type MockrevisionRecorderMockRecorder struct {
+	mock *MockrevisionRecorder
+}
+
+// NewMockrevisionRecorder creates a new mock instance.
+func NewMockrevisionRecorder(ctrl *gomock.Controller) *MockrevisionRecorder {
+	mock := &MockrevisionRecorder{ctrl: ctrl}
+	mock.recorder = &MockrevisionRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrevisionRecorder) EXPECT() *MockrevisionRecorderMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockrevisionRecorder) Get(stackName, id string) (*stack.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", stackName, id)
+	ret0, _ := ret[0].(*stack.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockrevisionRecorderMockRecorder) Get(stackName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockrevisionRecorder)(nil).Get), stackName, id)
+}
+
+// List mocks base method.
+func (m *MockrevisionRecorder) List(stackName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", stackName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockrevisionRecorderMockRecorder) List(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockrevisionRecorder)(nil).List), stackName)
+}
+
+// Record mocks base method.
+func (m *MockrevisionRecorder) Record(rev *stack.Revision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", rev)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockrevisionRecorderMockRecorder) Record(rev interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockrevisionRecorder)(nil).Record), rev)
+}
+
+// RecordLog mocks base method.
+func (m *MockrevisionRecorder) RecordLog(stackName, id string, content []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordLog", stackName, id, content)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordLog indicates an expected call of RecordLog.
+func (mr *MockrevisionRecorderMockRecorder) RecordLog(stackName, id, content interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordLog", reflect.TypeOf((*MockrevisionRecorder)(nil).RecordLog), stackName, id, content)
+}
+
+// MockstackEventsGetter is a mock of stackEventsGetter interface.
+type MockstackEventsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackEventsGetterMockRecorder
+}
+
+// MockstackEventsGetterMockRecorder is the mock recorder for MockstackEventsGetter.
+type MockstackEventsGetterMockRecorder struct {
+	mock *MockstackEventsGetter
+}
+
+// NewMockstackEventsGetter creates a new mock instance.
+func NewMockstackEventsGetter(ctrl *gomock.Controller) *MockstackEventsGetter {
+	mock := &MockstackEventsGetter{ctrl: ctrl}
+	mock.recorder = &MockstackEventsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackEventsGetter) EXPECT() *MockstackEventsGetterMockRecorder {
+	return m.recorder
+}
+
+// StackEvents mocks base method.
+func (m *MockstackEventsGetter) StackEvents(stackName string, since time.Time) ([]cloudformation.StackEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackEvents", stackName, since)
+	ret0, _ := ret[0].([]cloudformation.StackEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackEvents indicates an expected call of StackEvents.
+func (mr *MockstackEventsGetterMockRecorder) StackEvents(stackName, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackEvents", reflect.TypeOf((*MockstackEventsGetter)(nil).StackEvents), stackName, since)
+}
@@ -11,6 +11,8 @@ import (
 
 	addon "github.com/aws/copilot-cli/internal/pkg/addon"
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	ecr "github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	ssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	dockerengine "github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	gomock "github.com/golang/mock/gomock"
@@ -344,6 +346,20 @@ func (mr *MockserviceDeployerMockRecorder) DeployService(conf, bucketName interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployService", reflect.TypeOf((*MockserviceDeployer)(nil).DeployService), varargs...)
 }
 
+// UpdateStackParameters mocks base method.
+func (m *MockserviceDeployer) UpdateStackParameters(stackName string, params map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStackParameters", stackName, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStackParameters indicates an expected call of UpdateStackParameters.
+func (mr *MockserviceDeployerMockRecorder) UpdateStackParameters(stackName, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStackParameters", reflect.TypeOf((*MockserviceDeployer)(nil).UpdateStackParameters), stackName, params)
+}
+
 // MockdeployedTemplateGetter is a mock of deployedTemplateGetter interface.
 type MockdeployedTemplateGetter struct {
 	ctrl     *gomock.Controller
@@ -367,6 +383,36 @@ func (m *MockdeployedTemplateGetter) EXPECT() *MockdeployedTemplateGetterMockRec
 	return m.recorder
 }
 
+// NestedStackTemplate mocks base method.
+func (m *MockdeployedTemplateGetter) NestedStackTemplate(stackName, logicalID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NestedStackTemplate", stackName, logicalID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NestedStackTemplate indicates an expected call of NestedStackTemplate.
+func (mr *MockdeployedTemplateGetterMockRecorder) NestedStackTemplate(stackName, logicalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NestedStackTemplate", reflect.TypeOf((*MockdeployedTemplateGetter)(nil).NestedStackTemplate), stackName, logicalID)
+}
+
+// StackParameters mocks base method.
+func (m *MockdeployedTemplateGetter) StackParameters(stackName string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackParameters", stackName)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackParameters indicates an expected call of StackParameters.
+func (mr *MockdeployedTemplateGetterMockRecorder) StackParameters(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackParameters", reflect.TypeOf((*MockdeployedTemplateGetter)(nil).StackParameters), stackName)
+}
+
 // Template mocks base method.
 func (m *MockdeployedTemplateGetter) Template(stackName string) (string, error) {
 	m.ctrl.T.Helper()
@@ -478,6 +524,198 @@ func (mr *MocklabeledTermPrinterMockRecorder) Print() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Print", reflect.TypeOf((*MocklabeledTermPrinter)(nil).Print))
 }
 
+// MockimageScanner is a mock of imageScanner interface.
+type MockimageScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageScannerMockRecorder
+}
+
+// MockimageScannerMockRecorder is the mock recorder for MockimageScanner.
+type MockimageScannerMockRecorder struct {
+	mock *MockimageScanner
+}
+
+// NewMockimageScanner creates a new mock instance.
+func NewMockimageScanner(ctrl *gomock.Controller) *MockimageScanner {
+	mock := &MockimageScanner{ctrl: ctrl}
+	mock.recorder = &MockimageScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageScanner) EXPECT() *MockimageScannerMockRecorder {
+	return m.recorder
+}
+
+// ImageScanFindings mocks base method.
+func (m *MockimageScanner) ImageScanFindings(ctx context.Context, repoName, digest string) ([]ecr.ScanFinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageScanFindings", ctx, repoName, digest)
+	ret0, _ := ret[0].([]ecr.ScanFinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageScanFindings indicates an expected call of ImageScanFindings.
+func (mr *MockimageScannerMockRecorder) ImageScanFindings(ctx, repoName, digest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageScanFindings", reflect.TypeOf((*MockimageScanner)(nil).ImageScanFindings), ctx, repoName, digest)
+}
+
+// MockimageTagger is a mock of imageTagger interface.
+type MockimageTagger struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageTaggerMockRecorder
+}
+
+// MockimageTaggerMockRecorder is the mock recorder for MockimageTagger.
+type MockimageTaggerMockRecorder struct {
+	mock *MockimageTagger
+}
+
+// NewMockimageTagger creates a new mock instance.
+func NewMockimageTagger(ctrl *gomock.Controller) *MockimageTagger {
+	mock := &MockimageTagger{ctrl: ctrl}
+	mock.recorder = &MockimageTaggerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageTagger) EXPECT() *MockimageTaggerMockRecorder {
+	return m.recorder
+}
+
+// TagImage mocks base method.
+func (m *MockimageTagger) TagImage(repoName, digest string, tags ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{repoName, digest}
+	for _, a := range tags {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TagImage", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TagImage indicates an expected call of TagImage.
+func (mr *MockimageTaggerMockRecorder) TagImage(repoName, digest interface{}, tags ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{repoName, digest}, tags...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagImage", reflect.TypeOf((*MockimageTagger)(nil).TagImage), varargs...)
+}
+
+// MocksbomGenerator is a mock of sbomGenerator interface.
+type MocksbomGenerator struct {
+	ctrl     *gomock.Controller
+	recorder *MocksbomGeneratorMockRecorder
+}
+
+// MocksbomGeneratorMockRecorder is the mock recorder for MocksbomGenerator.
+type MocksbomGeneratorMockRecorder struct {
+	mock *MocksbomGenerator
+}
+
+// NewMocksbomGenerator creates a new mock instance.
+func NewMocksbomGenerator(ctrl *gomock.Controller) *MocksbomGenerator {
+	mock := &MocksbomGenerator{ctrl: ctrl}
+	mock.recorder = &MocksbomGeneratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksbomGenerator) EXPECT() *MocksbomGeneratorMockRecorder {
+	return m.recorder
+}
+
+// Generate mocks base method.
+func (m *MocksbomGenerator) Generate(image, format string, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generate", image, format, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Generate indicates an expected call of Generate.
+func (mr *MocksbomGeneratorMockRecorder) Generate(image, format, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MocksbomGenerator)(nil).Generate), image, format, w)
+}
+
+// MockimageSigner is a mock of imageSigner interface.
+type MockimageSigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageSignerMockRecorder
+}
+
+// MockimageSignerMockRecorder is the mock recorder for MockimageSigner.
+type MockimageSignerMockRecorder struct {
+	mock *MockimageSigner
+}
+
+// NewMockimageSigner creates a new mock instance.
+func NewMockimageSigner(ctrl *gomock.Controller) *MockimageSigner {
+	mock := &MockimageSigner{ctrl: ctrl}
+	mock.recorder = &MockimageSignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageSigner) EXPECT() *MockimageSignerMockRecorder {
+	return m.recorder
+}
+
+// Sign mocks base method.
+func (m *MockimageSigner) Sign(image, kmsKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", image, kmsKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockimageSignerMockRecorder) Sign(image, kmsKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockimageSigner)(nil).Sign), image, kmsKey)
+}
+
+// MockprovenanceRecorder is a mock of provenanceRecorder interface.
+type MockprovenanceRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockprovenanceRecorderMockRecorder
+}
+
+// MockprovenanceRecorderMockRecorder is the mock recorder for MockprovenanceRecorder.
+type MockprovenanceRecorderMockRecorder struct {
+	mock *MockprovenanceRecorder
+}
+
+// NewMockprovenanceRecorder creates a new mock instance.
+func NewMockprovenanceRecorder(ctrl *gomock.Controller) *MockprovenanceRecorder {
+	mock := &MockprovenanceRecorder{ctrl: ctrl}
+	mock.recorder = &MockprovenanceRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockprovenanceRecorder) EXPECT() *MockprovenanceRecorderMockRecorder {
+	return m.recorder
+}
+
+// PutSecret mocks base method.
+func (m *MockprovenanceRecorder) PutSecret(in ssm.PutSecretInput) (*ssm.PutSecretOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutSecret", in)
+	ret0, _ := ret[0].(*ssm.PutSecretOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutSecret indicates an expected call of PutSecret.
+func (mr *MockprovenanceRecorderMockRecorder) PutSecret(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MockprovenanceRecorder)(nil).PutSecret), in)
+}
+
 // MockdockerEngineRunChecker is a mock of dockerEngineRunChecker interface.
 type MockdockerEngineRunChecker struct {
 	ctrl     *gomock.Controller
@@ -515,6 +753,124 @@ func (mr *MockdockerEngineRunCheckerMockRecorder) CheckDockerEngineRunning() *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDockerEngineRunning", reflect.TypeOf((*MockdockerEngineRunChecker)(nil).CheckDockerEngineRunning))
 }
 
+// MockimageDigestResolver is a mock of imageDigestResolver interface.
+type MockimageDigestResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageDigestResolverMockRecorder
+}
+
+// MockimageDigestResolverMockRecorder is the mock recorder for MockimageDigestResolver.
+type MockimageDigestResolverMockRecorder struct {
+	mock *MockimageDigestResolver
+}
+
+// NewMockimageDigestResolver creates a new mock instance.
+func NewMockimageDigestResolver(ctrl *gomock.Controller) *MockimageDigestResolver {
+	mock := &MockimageDigestResolver{ctrl: ctrl}
+	mock.recorder = &MockimageDigestResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageDigestResolver) EXPECT() *MockimageDigestResolverMockRecorder {
+	return m.recorder
+}
+
+// ImageDigest mocks base method.
+func (m *MockimageDigestResolver) ImageDigest(ctx context.Context, image string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageDigest", ctx, image)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageDigest indicates an expected call of ImageDigest.
+func (mr *MockimageDigestResolverMockRecorder) ImageDigest(ctx, image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageDigest", reflect.TypeOf((*MockimageDigestResolver)(nil).ImageDigest), ctx, image)
+}
+
+// MockimageCopier is a mock of imageCopier interface.
+type MockimageCopier struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageCopierMockRecorder
+}
+
+// MockimageCopierMockRecorder is the mock recorder for MockimageCopier.
+type MockimageCopierMockRecorder struct {
+	mock *MockimageCopier
+}
+
+// NewMockimageCopier creates a new mock instance.
+func NewMockimageCopier(ctrl *gomock.Controller) *MockimageCopier {
+	mock := &MockimageCopier{ctrl: ctrl}
+	mock.recorder = &MockimageCopierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageCopier) EXPECT() *MockimageCopierMockRecorder {
+	return m.recorder
+}
+
+// CopyImage mocks base method.
+func (m *MockimageCopier) CopyImage(ctx context.Context, src, dst string, tags ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, src, dst}
+	for _, a := range tags {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CopyImage", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyImage indicates an expected call of CopyImage.
+func (mr *MockimageCopierMockRecorder) CopyImage(ctx, src, dst interface{}, tags ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, src, dst}, tags...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyImage", reflect.TypeOf((*MockimageCopier)(nil).CopyImage), varargs...)
+}
+
+// MockimagePlatformsGetter is a mock of imagePlatformsGetter interface.
+type MockimagePlatformsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockimagePlatformsGetterMockRecorder
+}
+
+// MockimagePlatformsGetterMockRecorder is the mock recorder for MockimagePlatformsGetter.
+type MockimagePlatformsGetterMockRecorder struct {
+	mock *MockimagePlatformsGetter
+}
+
+// NewMockimagePlatformsGetter creates a new mock instance.
+func NewMockimagePlatformsGetter(ctrl *gomock.Controller) *MockimagePlatformsGetter {
+	mock := &MockimagePlatformsGetter{ctrl: ctrl}
+	mock.recorder = &MockimagePlatformsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimagePlatformsGetter) EXPECT() *MockimagePlatformsGetterMockRecorder {
+	return m.recorder
+}
+
+// ImagePlatforms mocks base method.
+func (m *MockimagePlatformsGetter) ImagePlatforms(img ecr.ImageURI) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImagePlatforms", img)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImagePlatforms indicates an expected call of ImagePlatforms.
+func (mr *MockimagePlatformsGetterMockRecorder) ImagePlatforms(img interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImagePlatforms", reflect.TypeOf((*MockimagePlatformsGetter)(nil).ImagePlatforms), img)
+}
+
 // MocktimeoutError is a mock of timeoutError interface.
 type MocktimeoutError struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,236 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"fmt"
+
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+)
+
+// Placeholders written in the templates generated offline in place of the values that aren't supplied.
+// They must be replaced before the templates are deployed.
+const (
+	PlaceholderAccountID              = "{{account_id}}"
+	PlaceholderArtifactBucket         = "{{artifact_bucket}}"
+	PlaceholderArtifactKMSKeyARN      = "{{artifact_kms_key_arn}}"
+	PlaceholderPublicCIDRBlock        = "{{public_cidr_block}}"
+	PlaceholderCloudFrontPrefixListID = "{{cloudfront_prefix_list_id}}"
+)
+
+const (
+	fmtEnvManagerRoleARN   = "arn:%s:iam::%s:role/%s-EnvManagerRole"
+	fmtEnvExecutionRoleARN = "arn:%s:iam::%s:role/%s-CFNExecutionRole"
+	fmtRepositoryARN       = "arn:%s:ecr:%s:%s:repository/%s"
+	fmtTopicARN            = "arn:%s:sns:%s:%s:%s"
+	fmtSvcDiscoveryDomain  = "%s.%s.local"
+)
+
+// OfflineValues are the values that commands look up in AWS to generate templates.
+// They're supplied to generate the templates offline, and the values that are missing are replaced by placeholders.
+type OfflineValues struct {
+	AccountID              string            `yaml:"account_id"`
+	Region                 string            `yaml:"region"`
+	Domain                 string            `yaml:"domain"`
+	PermissionsBoundary    string            `yaml:"permissions_boundary"`
+	Tags                   map[string]string `yaml:"tags"`
+	ArtifactBucket         string            `yaml:"artifact_bucket"`
+	ArtifactKMSKeyARN      string            `yaml:"artifact_kms_key_arn"`
+	Repositories           map[string]string `yaml:"repositories"` // Workload name to the URI of its image repository.
+	PublicCIDRBlocks       []string          `yaml:"public_cidr_blocks"`
+	CloudFrontPrefixListID string            `yaml:"cloudfront_prefix_list_id"`
+}
+
+// Application returns the configuration of the application, assumed to be upgraded to the latest version.
+func (v *OfflineValues) Application(name string) *config.Application {
+	return &config.Application{
+		Name:                name,
+		AccountID:           v.Account(),
+		Domain:              v.Domain,
+		PermissionsBoundary: v.PermissionsBoundary,
+		Tags:                v.Tags,
+	}
+}
+
+// Environment returns the configuration of an environment of the application in the region of the values,
+// with the roles created by Copilot.
+func (v *OfflineValues) Environment(app, env string) (*config.Environment, error) {
+	partition, err := partitions.Region(v.Region).Partition()
+	if err != nil {
+		return nil, err
+	}
+	stackName := cfnstack.NameForEnv(app, env)
+	return &config.Environment{
+		App:              app,
+		Name:             env,
+		Region:           v.Region,
+		AccountID:        v.Account(),
+		ManagerRoleARN:   fmt.Sprintf(fmtEnvManagerRoleARN, partition.ID(), v.Account(), stackName),
+		ExecutionRoleARN: fmt.Sprintf(fmtEnvExecutionRoleARN, partition.ID(), v.Account(), stackName),
+	}, nil
+}
+
+// Account returns the supplied account ID, or a placeholder.
+func (v *OfflineValues) Account() string {
+	if v.AccountID == "" {
+		return PlaceholderAccountID
+	}
+	return v.AccountID
+}
+
+// Offline holds what deployers use in place of the resources they look up in AWS, to generate templates without credentials.
+type Offline struct {
+	Values *OfflineValues
+	// Manifest of the environment in the workspace with its variables substituted. It's assumed to be deployed already.
+	EnvManifest []byte
+}
+
+// offlineEnv answers the lookups of deployers about an environment and the regional resources of its application.
+type offlineEnv struct {
+	values *OfflineValues
+	app    *config.Application
+	env    *config.Environment
+	mft    []byte
+
+	workloads []string // Workloads whose repositories are looked up.
+}
+
+func newOfflineEnv(in *Offline, app *config.Application, env *config.Environment, workloads ...string) *offlineEnv {
+	return &offlineEnv{
+		values:    in.Values,
+		app:       app,
+		env:       env,
+		mft:       in.EnvManifest,
+		workloads: workloads,
+	}
+}
+
+// GetAppResourcesByRegion returns the supplied artifact bucket and repositories, or placeholders.
+// The repositories of the workloads that aren't supplied are named like the ones Copilot creates.
+func (e *offlineEnv) GetAppResourcesByRegion(app *config.Application, region string) (*cfnstack.AppRegionalResources, error) {
+	partition, err := partitions.Region(region).Partition()
+	if err != nil {
+		return nil, err
+	}
+	resources := &cfnstack.AppRegionalResources{
+		Region:         region,
+		S3Bucket:       e.values.ArtifactBucket,
+		KMSKeyARN:      e.values.ArtifactKMSKeyARN,
+		RepositoryURLs: make(map[string]string),
+	}
+	if resources.S3Bucket == "" {
+		resources.S3Bucket = PlaceholderArtifactBucket
+	}
+	if resources.KMSKeyARN == "" {
+		resources.KMSKeyARN = PlaceholderArtifactKMSKeyARN
+	}
+	for _, wkld := range e.workloads {
+		uri, ok := e.values.Repositories[wkld]
+		if !ok {
+			if uri, err = ecr.URIFromARN(fmt.Sprintf(fmtRepositoryARN, partition.ID(), region, e.values.Account(), app.RepositoryName(wkld))); err != nil {
+				return nil, err
+			}
+		}
+		resources.RepositoryURLs[wkld] = uri
+	}
+	return resources, nil
+}
+
+// Manifest returns the manifest of the environment in the workspace.
+func (e *offlineEnv) Manifest() ([]byte, error) {
+	return e.mft, nil
+}
+
+// Params returns no parameters, as if the environment had no workloads.
+func (e *offlineEnv) Params() (map[string]string, error) {
+	return map[string]string{}, nil
+}
+
+// ValidateCFServiceDomainAliases doesn't validate the aliases of the deployed services, which are checked when the environment is deployed.
+func (e *offlineEnv) ValidateCFServiceDomainAliases() error {
+	return nil
+}
+
+// ServiceDiscoveryEndpoint returns the endpoint of environments created after v1.5.0.
+func (e *offlineEnv) ServiceDiscoveryEndpoint() (string, error) {
+	return fmt.Sprintf(fmtSvcDiscoveryDomain, e.env.Name, e.app.Name), nil
+}
+
+// PublicCIDRBlocks returns the supplied public CIDR blocks of the environment VPC, or a placeholder.
+func (e *offlineEnv) PublicCIDRBlocks() ([]string, error) {
+	if len(e.values.PublicCIDRBlocks) == 0 {
+		return []string{PlaceholderPublicCIDRBlock}, nil
+	}
+	return e.values.PublicCIDRBlocks, nil
+}
+
+// CloudFrontManagedPrefixListID returns the supplied prefix list ID, or a placeholder.
+func (e *offlineEnv) CloudFrontManagedPrefixListID() (string, error) {
+	if e.values.CloudFrontPrefixListID == "" {
+		return PlaceholderCloudFrontPrefixListID, nil
+	}
+	return e.values.CloudFrontPrefixListID, nil
+}
+
+// ValidateCertAliases doesn't validate the aliases against the certificates, which is done when the workload is deployed.
+func (e *offlineEnv) ValidateCertAliases(aliases []string, certs []string) error {
+	return nil
+}
+
+// Version returns the latest template version, as the application is assumed to be upgraded.
+func (e *offlineEnv) Version() (string, error) {
+	return version.LatestTemplateVersion(), nil
+}
+
+// offlineEnvStack generates environment templates as if the environment stack were deployed with its default parameters.
+// Deployments fail with the offline error of the underlying deployer.
+type offlineEnvStack struct {
+	environmentDeployer
+}
+
+// DeployedEnvironmentParameters returns no parameters, so that the template uses its default values.
+func (offlineEnvStack) DeployedEnvironmentParameters(app, env string) ([]*awscfn.Parameter, error) {
+	return nil, nil
+}
+
+// ForceUpdateOutputID returns an empty ID, as for an environment that was never forced to update.
+func (offlineEnvStack) ForceUpdateOutputID(app, env string) (string, error) {
+	return "", nil
+}
+
+// offlineTopicLister lists the topics that the subscriptions of a worker service expect,
+// since whether they exist is only known once the services that publish them are deployed.
+type offlineTopicLister struct {
+	env           *config.Environment
+	subscriptions []manifest.TopicSubscription
+}
+
+// ListSNSTopics returns the topics of the subscriptions.
+func (l offlineTopicLister) ListSNSTopics(app, env string) ([]deploy.Topic, error) {
+	partition, err := partitions.Region(l.env.Region).Partition()
+	if err != nil {
+		return nil, err
+	}
+	var topics []deploy.Topic
+	for _, sub := range l.subscriptions {
+		wkld, name := sub.Service, sub.Name
+		if wkld == nil || name == nil {
+			continue
+		}
+		topicName := fmt.Sprintf(resourceNameFormat, app, env, *wkld, *name)
+		topic, err := deploy.NewTopic(fmt.Sprintf(fmtTopicARN, partition.ID(), l.env.Region, l.env.AccountID, topicName), app, env, *wkld)
+		if err != nil {
+			return nil, fmt.Errorf("topic %s of service %s: %w", *name, *wkld, err)
+		}
+		topics = append(topics, *topic)
+	}
+	return topics, nil
+}
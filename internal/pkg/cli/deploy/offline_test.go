@@ -0,0 +1,83 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOfflineEnv_GetAppResourcesByRegion(t *testing.T) {
+	testCases := map[string]struct {
+		values *OfflineValues
+
+		wantBucket string
+		wantKMSKey string
+		wantRepos  map[string]string
+	}{
+		"placeholders": {
+			values:     &OfflineValues{Region: "us-west-2"},
+			wantBucket: PlaceholderArtifactBucket,
+			wantKMSKey: PlaceholderArtifactKMSKeyARN,
+			wantRepos: map[string]string{
+				"api":      "{{account_id}}.dkr.ecr.us-west-2.amazonaws.com/demo/api",
+				"frontend": "{{account_id}}.dkr.ecr.us-west-2.amazonaws.com/demo/frontend",
+			},
+		},
+		"supplied values": {
+			values: &OfflineValues{
+				AccountID:         "123456789012",
+				Region:            "us-west-2",
+				ArtifactBucket:    "bucket",
+				ArtifactKMSKeyARN: "arn:aws:kms:us-west-2:123456789012:key/key",
+				Repositories: map[string]string{
+					"frontend": "123456789012.dkr.ecr.us-west-2.amazonaws.com/shared/frontend",
+				},
+			},
+			wantBucket: "bucket",
+			wantKMSKey: "arn:aws:kms:us-west-2:123456789012:key/key",
+			wantRepos: map[string]string{
+				"api":      "123456789012.dkr.ecr.us-west-2.amazonaws.com/demo/api",
+				"frontend": "123456789012.dkr.ecr.us-west-2.amazonaws.com/shared/frontend",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			app := tc.values.Application("demo")
+			env, err := tc.values.Environment("demo", "test")
+			require.NoError(t, err)
+			e := newOfflineEnv(&Offline{Values: tc.values}, app, env, "api", "frontend")
+
+			resources, err := e.GetAppResourcesByRegion(app, "us-west-2")
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantBucket, resources.S3Bucket)
+			require.Equal(t, tc.wantKMSKey, resources.KMSKeyARN)
+			require.Equal(t, tc.wantRepos, resources.RepositoryURLs)
+		})
+	}
+}
+
+func TestOfflineTopicLister_ListSNSTopics(t *testing.T) {
+	l := offlineTopicLister{
+		env: &config.Environment{Region: "us-west-2", AccountID: "123456789012"},
+		subscriptions: []manifest.TopicSubscription{
+			{Name: aws.String("orders"), Service: aws.String("api")},
+			{Name: aws.String("events")},
+		},
+	}
+
+	topics, err := l.ListSNSTopics("demo", "test")
+
+	require.NoError(t, err)
+	require.Len(t, topics, 1)
+	require.Equal(t, "arn:aws:sns:us-west-2:123456789012:demo-test-api-orders", topics[0].ARN())
+	require.Equal(t, "orders", topics[0].Name())
+	require.Equal(t, "api", topics[0].Workload())
+}
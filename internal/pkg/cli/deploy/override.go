@@ -22,7 +22,7 @@ type UserAgentAdder interface {
 	UserAgentExtras(extras ...string)
 }
 
-// NewOverrider looks up if a CDK or YAMLPatch Overrider exists at pathsToOverriderDir and initializes the respective Overrider.
+// NewOverrider looks up if a CDK, YAMLPatch, or strategic merge Overrider exists at pathsToOverriderDir and initializes the respective Overrider.
 // If the directory is empty, then returns a noop Overrider.
 func NewOverrider(pathToOverridesDir, app, env string, fs afero.Fs, sess UserAgentAdder) (Overrider, error) {
 	info, err := override.Lookup(pathToOverridesDir, fs)
@@ -55,6 +55,11 @@ func NewOverrider(pathToOverridesDir, app, env string, fs afero.Fs, sess UserAge
 		return override.WithPatch(info.Path(), override.PatchOpts{
 			FS: fs,
 		}), nil
+	case info.IsStrategicMerge():
+		sess.UserAgentExtras("override merge")
+		return override.WithMerge(info.Path(), override.MergeOpts{
+			FS: fs,
+		}), nil
 	default:
 		return new(override.Noop), nil
 	}
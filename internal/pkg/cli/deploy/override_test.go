@@ -63,4 +63,20 @@ func TestNewOverrider(t *testing.T) {
 		require.True(t, ok)
 		require.Contains(t, sess.UserAgent, "override cdk")
 	})
+	t.Run("should initialize a strategic merge overrider", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		_ = fs.MkdirAll("overrides", 0755)
+		_ = afero.WriteFile(fs, filepath.Join("overrides", "cfn.merge.yml"), []byte(""), 0755)
+		sess := new(mockSessProvider)
+
+		// WHEN
+		ovrdr, err := NewOverrider("overrides", "demo", "test", fs, sess)
+
+		// THEN
+		require.NoError(t, err)
+		_, ok := ovrdr.(*override.Merge)
+		require.True(t, ok)
+		require.Contains(t, sess.UserAgent, "override merge")
+	})
 }
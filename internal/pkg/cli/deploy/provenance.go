@@ -0,0 +1,95 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+)
+
+const fmtProvenanceParameterName = "/copilot/%s/%s/provenance/%s/%s"
+
+// ImageProvenance describes how the image of a container was built, pushed, and signed during a deployment.
+type ImageProvenance struct {
+	Image     string            `json:"image"`
+	Digest    string            `json:"digest"`
+	GitCommit string            `json:"gitCommit,omitempty"`
+	BuildArgs map[string]string `json:"buildArgs,omitempty"`
+	Builder   string            `json:"builder"`
+	Version   string            `json:"version,omitempty"`
+	Signature ImageSignature    `json:"signature"`
+}
+
+// ImageSignature is the method that an image was signed with.
+// The image is signed keyless if KMSKey is empty.
+type ImageSignature struct {
+	KMSKey string `json:"kmsKey,omitempty"`
+}
+
+// ProvenanceParameterName returns the name of the SSM parameter that holds the provenance of a container image.
+func ProvenanceParameterName(app, env, workload, container string) string {
+	return fmt.Sprintf(fmtProvenanceParameterName, app, env, workload, container)
+}
+
+// signImages signs the pushed images with cosign and records their provenance in SSM
+// if the manifest of the workload enables image signing.
+func (d *workloadDeployer) signImages(uri string, images map[string]ContainerImageIdentifier, buildArgs map[string]*dockerengine.BuildArguments) error {
+	mft, ok := d.mft.(interface{ ImageSigning() manifest.ImageSigning })
+	if !ok || !mft.ImageSigning().Enabled() {
+		return nil
+	}
+	kmsKey := aws.StringValue(mft.ImageSigning().KMSKey)
+	for _, container := range sortedKeys(images) {
+		image := fmt.Sprintf("%s@%s", uri, images[container].Digest)
+		log.Infof("Signing image %s\n", image)
+		if err := d.signer.Sign(image, kmsKey); err != nil {
+			return fmt.Errorf("sign the image %q: %w", container, err)
+		}
+		provenance, err := json.Marshal(ImageProvenance{
+			Image:     uri,
+			Digest:    images[container].Digest,
+			GitCommit: images[container].GitShortCommitTag,
+			BuildArgs: buildArgs[container].Args,
+			Builder:   "copilot-cli",
+			Version:   version.Version,
+			Signature: ImageSignature{
+				KMSKey: kmsKey,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("marshal provenance of the image %q: %w", container, err)
+		}
+		if _, err := d.provenance.PutSecret(awsssm.PutSecretInput{
+			Name:      ProvenanceParameterName(d.app.Name, d.env.Name, d.name, container),
+			Value:     string(provenance),
+			Overwrite: true,
+			Tags: map[string]string{
+				deploy.AppTagKey:     d.app.Name,
+				deploy.EnvTagKey:     d.env.Name,
+				deploy.ServiceTagKey: d.name,
+			},
+		}); err != nil {
+			return fmt.Errorf("record provenance of the image %q: %w", container, err)
+		}
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -49,8 +49,10 @@ func NewRDWSDeployer(in *WorkloadDeployerInput) (*rdwsDeployer, error) {
 	if err != nil {
 		return nil, err
 	}
-	versionGetter, err := describe.NewAppDescriber(in.App.Name)
-	if err != nil {
+	var appVersionGetter versionGetter
+	if in.Offline != nil {
+		appVersionGetter = newOfflineEnv(in.Offline, in.App, in.Env)
+	} else if appVersionGetter, err = describe.NewAppDescriber(in.App.Name); err != nil {
 		return nil, fmt.Errorf("new app describer for application %s: %w", in.App.Name, err)
 	}
 	rdwsMft, ok := in.Mft.(*manifest.RequestDrivenWebService)
@@ -60,7 +62,7 @@ func NewRDWSDeployer(in *WorkloadDeployerInput) (*rdwsDeployer, error) {
 	return &rdwsDeployer{
 		svcDeployer:            svcDeployer,
 		customResourceS3Client: s3.New(svcDeployer.defaultSessWithEnvRegion),
-		appVersionGetter:       versionGetter,
+		appVersionGetter:       appVersionGetter,
 		rdwsMft:                rdwsMft,
 	}, nil
 }
@@ -151,6 +153,7 @@ func (d *rdwsDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*rdwsS
 			Env:                d.env.Name,
 			Manifest:           d.rdwsMft,
 			RawManifest:        d.rawMft,
+			RenderedManifest:   d.renderedMft,
 			ArtifactBucketName: d.resources.S3Bucket,
 			RuntimeConfig:      *rc,
 			Addons:             d.addons,
@@ -167,6 +170,8 @@ func (d *rdwsDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*rdwsS
 				svcUpdater: d.newSvcUpdater(func(s *session.Session) serviceForceUpdater {
 					return apprunner.New(s)
 				}),
+				images: rc.PushedImages,
+				pinned: rc.PinnedImages,
 			},
 		}, nil
 	}
@@ -181,6 +186,8 @@ func (d *rdwsDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*rdwsS
 			svcUpdater: d.newSvcUpdater(func(s *session.Session) serviceForceUpdater {
 				return apprunner.New(s)
 			}),
+			images: rc.PushedImages,
+			pinned: rc.PinnedImages,
 		},
 		rdSvcAlias: aws.StringValue(d.rdwsMft.Alias),
 	}, nil
@@ -194,14 +201,19 @@ func validateRDSvcAliasAndAppVersion(svcName, alias, envName string, app *config
 		return fmt.Errorf("alias not supported: %w", err)
 	}
 	// Alias should be within root hosted zone.
-	aliasInvalidLog := fmt.Sprintf(`%s of %s field should match the pattern <subdomain>.%s 
+	aliasInvalidLog := fmt.Sprintf(`%s of %s field should be %s or match the pattern <subdomain>.%s 
 Where <subdomain> cannot be the application name.
-`, color.HighlightUserInput(alias), color.HighlightCode("http.alias"), app.Domain)
+`, color.HighlightUserInput(alias), color.HighlightCode("http.alias"), app.Domain, app.Domain)
 	if err := checkUnsupportedRDSvcAlias(alias, envName, app); err != nil {
 		log.Errorf(aliasInvalidLog)
 		return err
 	}
 
+	// The apex domain is mapped to the service with an alias record.
+	if alias == app.Domain {
+		return nil
+	}
+
 	// Example: subdomain.domain
 	regRootHostedZone, err := regexp.Compile(fmt.Sprintf(`^([^\.]+\.)%s`, app.Domain))
 	if err != nil {
@@ -237,9 +249,5 @@ func checkUnsupportedRDSvcAlias(alias, envName string, app *config.Application)
 		return fmt.Errorf("%s is an application-level alias, which is not supported yet", alias)
 	}
 
-	if alias == app.Domain {
-		return fmt.Errorf("%s is a root domain alias, which is not supported yet", alias)
-	}
-
 	return nil
 }
@@ -151,7 +151,7 @@ func TestSvcDeployOpts_rdWebServiceStackConfiguration(t *testing.T) {
 
 			wantErr: fmt.Errorf("someSub.mockApp.mockDomain is an application-level alias, which is not supported yet"),
 		},
-		"invalid root level alias": {
+		"success with the apex domain": {
 			inAlias: "mockDomain",
 			inEnvironment: &config.Environment{
 				Name:   mockEnvName,
@@ -167,7 +167,7 @@ func TestSvcDeployOpts_rdWebServiceStackConfiguration(t *testing.T) {
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 			},
 
-			wantErr: fmt.Errorf("mockDomain is a root domain alias, which is not supported yet"),
+			wantAlias: "mockDomain",
 		},
 		"success": {
 			inAlias: "v1.mockDomain",
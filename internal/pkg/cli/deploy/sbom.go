@@ -0,0 +1,48 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+const (
+	fmtSBOMStart    = "Generating the software bill of materials of image %s"
+	fmtSBOMFailed   = "Failed to generate the software bill of materials of image %s.\n"
+	fmtSBOMComplete = "Uploaded the software bill of materials of image %s.\n"
+)
+
+// uploadSBOMs generates the software bills of materials of the pushed images and uploads them to the artifact bucket
+// if the manifest of the workload enables SBOM generation.
+// The SBOM of a container replaces the one uploaded by the previous deployment to the environment.
+func (d *workloadDeployer) uploadSBOMs(uri string, images map[string]ContainerImageIdentifier) error {
+	mft, ok := d.mft.(interface{ ImageSBOM() manifest.ImageSBOM })
+	if !ok || mft.ImageSBOM().IsEmpty() {
+		return nil
+	}
+	format := aws.StringValue(mft.ImageSBOM().Format)
+	stackName := stack.NameForWorkload(d.app.Name, d.env.Name, d.name)
+	for _, container := range sortedKeys(images) {
+		d.spinner.Start(fmt.Sprintf(fmtSBOMStart, color.HighlightUserInput(container)))
+		var sbom bytes.Buffer
+		if err := d.sbomGenerator.Generate(fmt.Sprintf("%s@%s", uri, images[container].Digest), format, &sbom); err != nil {
+			d.spinner.Stop(log.Serrorf(fmtSBOMFailed, color.HighlightUserInput(container)))
+			return err
+		}
+		if _, err := d.s3Client.Upload(d.resources.S3Bucket, artifactpath.SBOM(stackName, container), &sbom); err != nil {
+			d.spinner.Stop(log.Serrorf(fmtSBOMFailed, color.HighlightUserInput(container)))
+			return fmt.Errorf("put sbom of image %q to bucket %s: %w", container, d.resources.S3Bucket, err)
+		}
+		d.spinner.Stop(log.Ssuccessf(fmtSBOMComplete, color.HighlightUserInput(container)))
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+// imageScanTimeout is how long to wait for the scans of the pushed images to complete.
+const imageScanTimeout = 30 * time.Minute
+
+const (
+	fmtImageScanStart    = "Waiting for the vulnerability scan of image %s"
+	fmtImageScanFailed   = "Failed to scan image %s.\n"
+	fmtImageScanComplete = "Scanned image %s: %s.\n"
+)
+
+// scanImages waits for ECR to scan the pushed images and writes their findings if the manifest of the workload enables scanning.
+// Findings at or above the "block_on" severity fail the deployment, other findings are written as warnings.
+func (d *workloadDeployer) scanImages(images map[string]ContainerImageIdentifier) error {
+	mft, ok := d.mft.(interface{ ImageScan() manifest.ImageScan })
+	if !ok || mft.ImageScan().IsEmpty() {
+		return nil
+	}
+	blockOn := aws.StringValue(mft.ImageScan().BlockOn)
+	ctx, cancel := context.WithTimeout(context.Background(), imageScanTimeout)
+	defer cancel()
+	var vulnerable []string
+	for _, container := range sortedKeys(images) {
+		d.spinner.Start(fmt.Sprintf(fmtImageScanStart, color.HighlightUserInput(container)))
+		findings, err := d.scanner.ImageScanFindings(ctx, d.app.RepositoryName(d.name), images[container].Digest)
+		if err != nil {
+			d.spinner.Stop(log.Serrorf(fmtImageScanFailed, color.HighlightUserInput(container)))
+			return fmt.Errorf("scan image %q: %w", container, err)
+		}
+		d.spinner.Stop(log.Ssuccessf(fmtImageScanComplete, color.HighlightUserInput(container), scanSummary(findings)))
+		if blocked := logScanFindings(findings, blockOn); blocked > 0 {
+			vulnerable = append(vulnerable, container)
+		}
+	}
+	if len(vulnerable) > 0 {
+		return &errVulnerableImages{
+			containers: vulnerable,
+			blockOn:    blockOn,
+		}
+	}
+	return nil
+}
+
+// logScanFindings writes the findings from the most to the least severe and returns how many are at or above the blockOn severity.
+func logScanFindings(findings []ecr.ScanFinding, blockOn string) int {
+	sort.SliceStable(findings, func(i, j int) bool {
+		return severityRank(findings[i].Severity) < severityRank(findings[j].Severity)
+	})
+	var blocked int
+	for _, f := range findings {
+		msg := fmt.Sprintf("%s %s", f.Severity, f.Name)
+		if f.Package != "" {
+			msg += fmt.Sprintf(" in package %s", f.Package)
+		}
+		if f.URI != "" {
+			msg += fmt.Sprintf(" (%s)", f.URI)
+		}
+		if severityRank(f.Severity) <= severityRank(blockOn) {
+			blocked++
+			log.Errorln(msg)
+			continue
+		}
+		log.Warningln(msg)
+	}
+	return blocked
+}
+
+// scanSummary returns the number of findings per severity, such as "1 critical, 3 low".
+func scanSummary(findings []ecr.ScanFinding) string {
+	if len(findings) == 0 {
+		return "no vulnerabilities found"
+	}
+	counts := make(map[string]int)
+	for _, f := range findings {
+		counts[strings.ToLower(f.Severity)]++
+	}
+	var parts []string
+	for _, severity := range manifest.ImageScanSeverities {
+		if counts[severity] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[severity], severity))
+			delete(counts, severity)
+		}
+	}
+	for _, severity := range sortedKeys(counts) {
+		parts = append(parts, fmt.Sprintf("%d %s", counts[severity], severity))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// severityRank returns the position of the severity from the most severe, severities that are unknown, such as "undefined", rank last.
+func severityRank(severity string) int {
+	for i, s := range manifest.ImageScanSeverities {
+		if strings.EqualFold(s, severity) {
+			return i
+		}
+	}
+	return len(manifest.ImageScanSeverities)
+}
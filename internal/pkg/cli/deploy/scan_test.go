@@ -0,0 +1,36 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScanSummary(t *testing.T) {
+	testCases := map[string]struct {
+		in     []ecr.ScanFinding
+		wanted string
+	}{
+		"no findings": {
+			wanted: "no vulnerabilities found",
+		},
+		"findings are counted from the most to the least severe": {
+			in: []ecr.ScanFinding{
+				{Severity: "LOW"},
+				{Severity: "UNDEFINED"},
+				{Severity: "CRITICAL"},
+				{Severity: "LOW"},
+			},
+			wanted: "1 critical, 2 low, 1 undefined",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, scanSummary(tc.in))
+		})
+	}
+}
@@ -4,11 +4,17 @@
 package deploy
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"path/filepath"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awss3 "github.com/aws/aws-sdk-go/service/s3"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	awscloudfront "github.com/aws/copilot-cli/internal/pkg/aws/cloudfront"
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
@@ -19,27 +25,76 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/version"
-	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize"
 	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
 )
 
-const artifactBucketAssetsDir = "local-assets"
+const (
+	artifactBucketAssetsDir = "local-assets"
+
+	// The artifact bucket expires local assets 30 days after they're uploaded, so older assets are uploaded
+	// again instead of being reused, in case they expire before the deployment copies them.
+	reusableAssetMaxAge = 25 * 24 * time.Hour
+
+	// uploadProgressInterval is the minimum duration between two updates of the progress of the upload of static files.
+	uploadProgressInterval = time.Second
+
+	// Invalidations usually complete within minutes, past which the deployment stops waiting for them.
+	invalidationPollInterval = 5 * time.Second
+	invalidationTimeout      = 15 * time.Minute
+)
+
+const (
+	fmtUploadStaticFilesStart    = "Uploading the static files of %s to S3"
+	fmtUploadStaticFilesProgress = "Uploading the static files of %s to S3: %d/%d files, %s/%s (%s/s)"
+	fmtUploadStaticFilesFailed   = "Failed to upload the static files of %s to S3.\n"
+	fmtUploadStaticFilesComplete = "Uploaded the static files of %s to S3: %d files, %d unchanged.\n"
+
+	fmtInvalidateCacheProgress = "Invalidating the CloudFront cache of %s: %s (%s)"
+	fmtInvalidateCacheFailed   = "Failed to follow the invalidation of the CloudFront cache of %s.\n"
+	fmtInvalidateCacheTimeout  = "The invalidation %s of the CloudFront cache of %s is still in progress after %s.\n"
+	fmtInvalidateCacheComplete = "Invalidated the CloudFront cache of %s in %s.\n"
+)
 
 type fileUploader interface {
 	UploadFiles(files []manifest.FileUpload) (string, error)
 }
 
+type uploadedAssetsGetter interface {
+	ObjectKeysModifiedAfter(bucket, prefix string, after time.Time) ([]string, error)
+	Download(bucket, key string) ([]byte, error)
+}
+
+type stackResourcesGetter interface {
+	StackResources(name string) ([]*awscloudformation.StackResource, error)
+}
+
+type invalidationGetter interface {
+	LatestInvalidation(distributionID string) (*awscloudfront.Invalidation, error)
+	InvalidationStatus(distributionID, invalidationID string) (string, error)
+}
+
 type staticSiteDeployer struct {
 	*svcDeployer
 	appVersionGetter versionGetter
 	staticSiteMft    *manifest.StaticSite
 	fs               afero.Fs
 	uploader         fileUploader
+	assets           uploadedAssetsGetter
+	stackResources   stackResourcesGetter
+	invalidations    invalidationGetter
 	newStack         func(*stack.StaticSiteConfig) (cloudformation.StackConfiguration, error)
+	pollInterval     time.Duration
 
 	// cached.
-	wsRoot string
+	wsRoot         string
+	uploadStart    time.Time
+	lastUpload     time.Time
+	uploadProgress asset.UploadProgress
 }
 
 // NewStaticSiteDeployer is the constructor for staticSiteDeployer.
@@ -49,37 +104,47 @@ func NewStaticSiteDeployer(in *WorkloadDeployerInput) (*staticSiteDeployer, erro
 	if err != nil {
 		return nil, err
 	}
-	versionGetter, err := describe.NewAppDescriber(in.App.Name)
-	if err != nil {
+	var appVersionGetter versionGetter
+	if in.Offline != nil {
+		appVersionGetter = newOfflineEnv(in.Offline, in.App, in.Env)
+	} else if appVersionGetter, err = describe.NewAppDescriber(in.App.Name); err != nil {
 		return nil, fmt.Errorf("new app describer for application %s: %w", in.App.Name, err)
 	}
 	mft, ok := in.Mft.(*manifest.StaticSite)
 	if !ok {
 		return nil, fmt.Errorf("manifest is not of type %s", manifestinfo.StaticSiteType)
 	}
-	ws, err := workspace.Use(svcDeployer.fs)
+	ws, err := in.workspace()
 	if err != nil {
 		return nil, err
 	}
-	return &staticSiteDeployer{
+	d := &staticSiteDeployer{
 		svcDeployer:      svcDeployer,
-		appVersionGetter: versionGetter,
+		appVersionGetter: appVersionGetter,
 		staticSiteMft:    mft,
 		fs:               svcDeployer.fs,
-		uploader: &asset.ArtifactBucketUploader{
-			FS:                  svcDeployer.fs,
-			AssetDir:            artifactBucketAssetsDir,
-			AssetMappingFileDir: fmt.Sprintf("%s/environments/%s/workloads/%s/mapping", artifactBucketAssetsDir, svcDeployer.env.Name, svcDeployer.name),
-			Upload: func(path string, data io.Reader) error {
-				_, err := svcDeployer.s3Client.Upload(svcDeployer.resources.S3Bucket, path, data)
-				return err
-			},
-		},
-		wsRoot: ws.ProjectRoot(),
+		assets:           s3.New(svcDeployer.envSess),
+		stackResources:   awscloudformation.New(svcDeployer.envSess),
+		invalidations:    awscloudfront.New(svcDeployer.envSess),
+		pollInterval:     invalidationPollInterval,
+		wsRoot:           ws.ProjectRoot(),
 		newStack: func(config *stack.StaticSiteConfig) (cloudformation.StackConfiguration, error) {
 			return stack.NewStaticSite(config)
 		},
-	}, nil
+	}
+	d.uploader = &asset.ArtifactBucketUploader{
+		FS:                  svcDeployer.fs,
+		AssetDir:            artifactBucketAssetsDir,
+		AssetMappingFileDir: fmt.Sprintf("%s/environments/%s/workloads/%s/mapping", artifactBucketAssetsDir, svcDeployer.env.Name, svcDeployer.name),
+		Upload: func(path string, data io.Reader) error {
+			_, err := svcDeployer.s3Client.Upload(svcDeployer.resources.S3Bucket, path, data)
+			return err
+		},
+		UploadedAssets:           d.uploadedAssets,
+		DeployedAssetMappingFile: d.deployedAssetMappingFile,
+		Progress:                 d.reportUploadProgress,
+	}
+	return d, nil
 }
 
 func staticSiteCustomResources(fs template.Reader) ([]*customresource.CustomResource, error) {
@@ -111,12 +176,65 @@ func (d *staticSiteDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionReco
 	if err != nil {
 		return nil, err
 	}
+	start := d.now()
 	if err := d.deploy(in.Options, svcStackConfigurationOutput{conf: conf}); err != nil {
 		return nil, err
 	}
+	if err := d.waitForInvalidation(start); err != nil {
+		// The site is deployed, so failing to follow the invalidation doesn't fail the deployment.
+		log.Warningf("Couldn't follow the invalidation of the CloudFront cache of %s: %v\n", d.name, err)
+	}
 	return noopActionRecommender{}, nil
 }
 
+// waitForInvalidation reports the progress of the invalidation of the CloudFront cache that the deployment
+// started since the time, until it completes or invalidationTimeout elapses.
+// The deployment doesn't start an invalidation if no file was copied, or if invalidations are disabled.
+func (d *staticSiteDeployer) waitForInvalidation(since time.Time) error {
+	if aws.StringValue(d.staticSiteMft.HTTP.Cache.Invalidation.String) == manifest.StaticSiteInvalidateNone {
+		return nil
+	}
+	stackName := stack.NameForWorkload(d.app.Name, d.env.Name, d.name)
+	resources, err := d.stackResources.StackResources(stackName)
+	if err != nil {
+		return fmt.Errorf("list the resources of stack %s: %w", stackName, err)
+	}
+	var distributionID string
+	for _, resource := range resources {
+		if aws.StringValue(resource.LogicalResourceId) == "CloudFrontDistribution" {
+			distributionID = aws.StringValue(resource.PhysicalResourceId)
+		}
+	}
+	if distributionID == "" {
+		return fmt.Errorf("no CloudFront distribution in stack %s", stackName)
+	}
+	invalidation, err := d.invalidations.LatestInvalidation(distributionID)
+	if err != nil {
+		return err
+	}
+	if invalidation == nil || invalidation.CreateTime.Before(since) {
+		return nil
+	}
+
+	name := color.HighlightUserInput(d.name)
+	status := invalidation.Status
+	for status != awscloudfront.InvalidationStatusCompleted {
+		elapsed := d.now().Sub(invalidation.CreateTime).Round(time.Second)
+		if elapsed > invalidationTimeout {
+			d.spinner.Stop(log.Swarningf(fmtInvalidateCacheTimeout, invalidation.ID, name, elapsed))
+			return nil
+		}
+		d.spinner.Start(fmt.Sprintf(fmtInvalidateCacheProgress, name, status, elapsed))
+		time.Sleep(d.pollInterval)
+		if status, err = d.invalidations.InvalidationStatus(distributionID, invalidation.ID); err != nil {
+			d.spinner.Stop(log.Serrorf(fmtInvalidateCacheFailed, name))
+			return err
+		}
+	}
+	d.spinner.Stop(log.Ssuccessf(fmtInvalidateCacheComplete, name, d.now().Sub(invalidation.CreateTime).Round(time.Second)))
+	return nil
+}
+
 func (d *staticSiteDeployer) deploy(deployOptions Options, stackConfigOutput svcStackConfigurationOutput) error {
 	opts := []awscloudformation.StackOption{
 		awscloudformation.WithRoleARN(d.env.ExecutionRoleARN),
@@ -143,15 +261,83 @@ func (d *staticSiteDeployer) uploadStaticFiles(out *UploadArtifactsOutput) error
 	if err != nil {
 		return err
 	}
+	d.uploadStart, d.lastUpload = d.now(), time.Time{}
+	d.spinner.Start(fmt.Sprintf(fmtUploadStaticFilesStart, color.HighlightUserInput(d.name)))
 	path, err := d.uploader.UploadFiles(fullPathSources)
 	if err != nil {
+		d.spinner.Stop(log.Serrorf(fmtUploadStaticFilesFailed, color.HighlightUserInput(d.name)))
 		return fmt.Errorf("upload static files: %w", err)
 	}
+	d.spinner.Stop(log.Ssuccessf(fmtUploadStaticFilesComplete, color.HighlightUserInput(d.name),
+		d.uploadProgress.TotalFiles, d.uploadProgress.Skipped))
 
 	out.StaticSiteAssetMappingLocation = s3.Location(d.resources.S3Bucket, path)
 	return nil
 }
 
+// reportUploadProgress updates the spinner with the number of files and bytes uploaded, and the throughput,
+// at most once per uploadProgressInterval so that sites with many files don't flood the output.
+func (d *staticSiteDeployer) reportUploadProgress(progress asset.UploadProgress) {
+	d.uploadProgress = progress
+	now := d.now()
+	if progress.Files != progress.TotalFiles && now.Sub(d.lastUpload) < uploadProgressInterval {
+		return
+	}
+	d.lastUpload = now
+	var throughput uint64
+	if elapsed := now.Sub(d.uploadStart).Seconds(); elapsed > 0 {
+		throughput = uint64(float64(progress.Bytes) / elapsed)
+	}
+	d.spinner.Start(fmt.Sprintf(fmtUploadStaticFilesProgress, color.HighlightUserInput(d.name),
+		progress.Files, progress.TotalFiles, humanize.Bytes(uint64(progress.Bytes)), humanize.Bytes(uint64(progress.TotalBytes)),
+		humanize.Bytes(throughput)))
+}
+
+// uploadedAssets returns the keys of the assets in the artifact bucket that are recent enough to be reused.
+func (d *staticSiteDeployer) uploadedAssets() ([]string, error) {
+	return d.assets.ObjectKeysModifiedAfter(d.resources.S3Bucket, artifactBucketAssetsDir+"/", d.now().Add(-reusableAssetMaxAge))
+}
+
+// deployedAssetMappingFile returns the content of the asset mapping file of the deployed site,
+// or nil if the site isn't deployed or the file expired.
+func (d *staticSiteDeployer) deployedAssetMappingFile() ([]byte, error) {
+	stackName := stack.NameForWorkload(d.app.Name, d.env.Name, d.name)
+	tmpl, err := d.tmplGetter.Template(stackName)
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieve the deployed template for %q: %w", d.name, err)
+	}
+	var deployed struct {
+		Resources struct {
+			TriggerStateMachineAction struct {
+				Properties struct {
+					AssetMappingFilePath string `yaml:"AssetMappingFilePath"`
+				} `yaml:"Properties"`
+			} `yaml:"TriggerStateMachineAction"`
+		} `yaml:"Resources"`
+	}
+	if err := yaml.Unmarshal([]byte(tmpl), &deployed); err != nil {
+		return nil, fmt.Errorf("unmarshal the deployed template for %q: %w", d.name, err)
+	}
+	path := deployed.Resources.TriggerStateMachineAction.Properties.AssetMappingFilePath
+	if path == "" {
+		return nil, nil
+	}
+	data, err := d.assets.Download(d.resources.S3Bucket, path)
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == awss3.ErrCodeNoSuchKey {
+			log.Warningf("The asset mapping file of the deployed %s expired, so the files removed since then are kept in its bucket.\n", d.name)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("download the deployed asset mapping file of %q: %w", d.name, err)
+	}
+	return data, nil
+}
+
 func (d *staticSiteDeployer) stackConfiguration(in *StackRuntimeConfiguration) (cloudformation.StackConfiguration, error) {
 	rc, err := d.runtimeConfig(in)
 	if err != nil {
@@ -168,6 +354,7 @@ func (d *staticSiteDeployer) stackConfiguration(in *StackRuntimeConfiguration) (
 		EnvManifest:        d.envConfig,
 		Manifest:           d.staticSiteMft,
 		RawManifest:        d.rawMft,
+		RenderedManifest:   d.renderedMft,
 		ArtifactBucketName: d.resources.S3Bucket,
 		RuntimeConfig:      *rc,
 		RootUserARN:        in.RootUserARN,
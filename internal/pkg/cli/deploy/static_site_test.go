@@ -7,16 +7,23 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/s3"
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	awscloudfront "github.com/aws/copilot-cli/internal/pkg/aws/cloudfront"
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	deployCFN "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/asset"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/golang/mock/gomock"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
@@ -25,6 +32,7 @@ import (
 func TestStaticSiteDeployer_UploadArtifacts(t *testing.T) {
 	type mockDeps struct {
 		uploader     *mocks.MockfileUploader
+		spinner      *mocks.Mockspinner
 		fs           func() afero.Fs
 		cachedWSRoot string
 	}
@@ -42,7 +50,9 @@ func TestStaticSiteDeployer_UploadArtifacts(t *testing.T) {
 					_ = fs.Mkdir("mockRoot/assets/", 0755)
 					return fs
 				}
+				m.spinner.EXPECT().Start(fmt.Sprintf(fmtUploadStaticFilesStart, "mockSite"))
 				m.uploader.EXPECT().UploadFiles(gomock.Any()).Return("", errors.New("some error"))
+				m.spinner.EXPECT().Stop(log.Serrorf(fmtUploadStaticFilesFailed, "mockSite"))
 			},
 			wantErr: fmt.Errorf("upload static files: some error"),
 		},
@@ -65,6 +75,8 @@ func TestStaticSiteDeployer_UploadArtifacts(t *testing.T) {
 					_ = fs.Mkdir("mockRoot/assets/", 0755)
 					return fs
 				}
+				m.spinner.EXPECT().Start(fmt.Sprintf(fmtUploadStaticFilesStart, "mockSite"))
+				m.spinner.EXPECT().Stop(log.Ssuccessf(fmtUploadStaticFilesComplete, "mockSite", 0, 0))
 				m.uploader.EXPECT().UploadFiles([]manifest.FileUpload{
 					{
 						Source:      "mockRoot/assets",
@@ -90,6 +102,7 @@ func TestStaticSiteDeployer_UploadArtifacts(t *testing.T) {
 
 			m := &mockDeps{
 				uploader: mocks.NewMockfileUploader(ctrl),
+				spinner:  mocks.NewMockspinner(ctrl),
 			}
 			if tc.mock != nil {
 				tc.mock(m)
@@ -98,6 +111,8 @@ func TestStaticSiteDeployer_UploadArtifacts(t *testing.T) {
 			deployer := &staticSiteDeployer{
 				svcDeployer: &svcDeployer{
 					workloadDeployer: &workloadDeployer{
+						name:    "mockSite",
+						spinner: m.spinner,
 						customResources: func(fs template.Reader) ([]*customresource.CustomResource, error) {
 							return nil, nil
 						},
@@ -106,6 +121,7 @@ func TestStaticSiteDeployer_UploadArtifacts(t *testing.T) {
 							S3Bucket: "mockArtifactBucket",
 						},
 					},
+					now: time.Now,
 				},
 				staticSiteMft: &manifest.StaticSite{
 					StaticSiteConfig: manifest.StaticSiteConfig{
@@ -137,6 +153,225 @@ func TestStaticSiteDeployer_UploadArtifacts(t *testing.T) {
 	}
 }
 
+func TestStaticSiteDeployer_reportUploadProgress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	start := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	spinner := mocks.NewMockspinner(ctrl)
+	d := &staticSiteDeployer{
+		svcDeployer: &svcDeployer{
+			workloadDeployer: &workloadDeployer{
+				name:    "mockSite",
+				spinner: spinner,
+			},
+			now: func() time.Time { return now },
+		},
+		uploadStart: start,
+	}
+	gomock.InOrder(
+		spinner.EXPECT().Start(fmt.Sprintf(fmtUploadStaticFilesProgress, "mockSite", 1, 3, "0 B", "3.0 MB", "0 B")),
+		spinner.EXPECT().Start(fmt.Sprintf(fmtUploadStaticFilesProgress, "mockSite", 3, 3, "3.0 MB", "3.0 MB", "1.5 MB")),
+	)
+
+	d.reportUploadProgress(asset.UploadProgress{Files: 1, TotalFiles: 3, Skipped: 1, TotalBytes: 3_000_000})
+	now = start.Add(500 * time.Millisecond)
+	d.reportUploadProgress(asset.UploadProgress{Files: 2, TotalFiles: 3, Skipped: 1, Bytes: 1_000_000, TotalBytes: 3_000_000})
+	now = start.Add(2 * time.Second)
+	d.reportUploadProgress(asset.UploadProgress{Files: 3, TotalFiles: 3, Skipped: 1, Bytes: 3_000_000, TotalBytes: 3_000_000})
+
+	require.Equal(t, asset.UploadProgress{Files: 3, TotalFiles: 3, Skipped: 1, Bytes: 3_000_000, TotalBytes: 3_000_000}, d.uploadProgress)
+}
+
+func TestStaticSiteDeployer_deployedAssetMappingFile(t *testing.T) {
+	const deployedTemplate = `Resources:
+  TriggerStateMachineAction:
+    Type: Custom::TriggerStateMachine
+    Properties:
+      ServiceToken: !GetAtt TriggerStateMachineFunction.Arn
+      AssetMappingFilePath: local-assets/environments/test/workloads/mockSite/mapping/abc
+`
+	testCases := map[string]struct {
+		mock func(tmpl *mocks.MockdeployedTemplateGetter, assets *mocks.MockuploadedAssetsGetter)
+
+		wanted  []byte
+		wantErr string
+	}{
+		"nothing if the site isn't deployed": {
+			mock: func(tmpl *mocks.MockdeployedTemplateGetter, _ *mocks.MockuploadedAssetsGetter) {
+				tmpl.EXPECT().Template("app-test-mockSite").Return("", &awscfn.ErrStackNotFound{})
+			},
+		},
+		"error if the deployed template can't be retrieved": {
+			mock: func(tmpl *mocks.MockdeployedTemplateGetter, _ *mocks.MockuploadedAssetsGetter) {
+				tmpl.EXPECT().Template("app-test-mockSite").Return("", errors.New("some error"))
+			},
+			wantErr: `retrieve the deployed template for "mockSite": some error`,
+		},
+		"nothing if the mapping file expired": {
+			mock: func(tmpl *mocks.MockdeployedTemplateGetter, assets *mocks.MockuploadedAssetsGetter) {
+				tmpl.EXPECT().Template("app-test-mockSite").Return(deployedTemplate, nil)
+				assets.EXPECT().Download("mockArtifactBucket", "local-assets/environments/test/workloads/mockSite/mapping/abc").
+					Return(nil, fmt.Errorf("get object: %w", awserr.New(s3.ErrCodeNoSuchKey, "gone", nil)))
+			},
+		},
+		"error if the mapping file can't be downloaded": {
+			mock: func(tmpl *mocks.MockdeployedTemplateGetter, assets *mocks.MockuploadedAssetsGetter) {
+				tmpl.EXPECT().Template("app-test-mockSite").Return(deployedTemplate, nil)
+				assets.EXPECT().Download(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantErr: `download the deployed asset mapping file of "mockSite": some error`,
+		},
+		"content of the deployed mapping file": {
+			mock: func(tmpl *mocks.MockdeployedTemplateGetter, assets *mocks.MockuploadedAssetsGetter) {
+				tmpl.EXPECT().Template("app-test-mockSite").Return(deployedTemplate, nil)
+				assets.EXPECT().Download("mockArtifactBucket", "local-assets/environments/test/workloads/mockSite/mapping/abc").
+					Return([]byte(`[]`), nil)
+			},
+			wanted: []byte(`[]`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			tmpl := mocks.NewMockdeployedTemplateGetter(ctrl)
+			assets := mocks.NewMockuploadedAssetsGetter(ctrl)
+			tc.mock(tmpl, assets)
+			d := &staticSiteDeployer{
+				svcDeployer: &svcDeployer{
+					workloadDeployer: &workloadDeployer{
+						name:       "mockSite",
+						app:        &config.Application{Name: "app"},
+						env:        &config.Environment{Name: "test"},
+						resources:  &stack.AppRegionalResources{S3Bucket: "mockArtifactBucket"},
+						tmplGetter: tmpl,
+					},
+				},
+				assets: assets,
+			}
+
+			got, err := d.deployedAssetMappingFile()
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestStaticSiteDeployer_waitForInvalidation(t *testing.T) {
+	start := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	distribution := []*awscfn.StackResource{
+		{LogicalResourceId: aws.String("Bucket"), PhysicalResourceId: aws.String("bucket")},
+		{LogicalResourceId: aws.String("CloudFrontDistribution"), PhysicalResourceId: aws.String("E123")},
+	}
+	type invalidationMocks struct {
+		resources     *mocks.MockstackResourcesGetter
+		invalidations *mocks.MockinvalidationGetter
+		spinner       *mocks.Mockspinner
+	}
+	testCases := map[string]struct {
+		invalidation manifest.StringSliceOrString
+		mock         func(m *invalidationMocks)
+
+		wantErr string
+	}{
+		"nothing to wait for if invalidations are disabled": {
+			invalidation: manifest.StringSliceOrString{String: aws.String("none")},
+			mock:         func(m *invalidationMocks) {},
+		},
+		"error if the stack resources can't be listed": {
+			mock: func(m *invalidationMocks) {
+				m.resources.EXPECT().StackResources("app-test-mockSite").Return(nil, errors.New("some error"))
+			},
+			wantErr: "list the resources of stack app-test-mockSite: some error",
+		},
+		"nothing to wait for if the deployment didn't invalidate the cache": {
+			mock: func(m *invalidationMocks) {
+				m.resources.EXPECT().StackResources("app-test-mockSite").Return(distribution, nil)
+				m.invalidations.EXPECT().LatestInvalidation("E123").Return(&awscloudfront.Invalidation{
+					ID:         "I1",
+					Status:     "Completed",
+					CreateTime: start.Add(-time.Hour),
+				}, nil)
+			},
+		},
+		"error if the status of the invalidation can't be retrieved": {
+			mock: func(m *invalidationMocks) {
+				m.resources.EXPECT().StackResources("app-test-mockSite").Return(distribution, nil)
+				m.invalidations.EXPECT().LatestInvalidation("E123").Return(&awscloudfront.Invalidation{
+					ID:         "I2",
+					Status:     "InProgress",
+					CreateTime: start.Add(time.Minute),
+				}, nil)
+				m.spinner.EXPECT().Start(fmt.Sprintf(fmtInvalidateCacheProgress, "mockSite", "InProgress", "1m0s"))
+				m.invalidations.EXPECT().InvalidationStatus("E123", "I2").Return("", errors.New("some error"))
+				m.spinner.EXPECT().Stop(log.Serrorf(fmtInvalidateCacheFailed, "mockSite"))
+			},
+			wantErr: "some error",
+		},
+		"report progress until the invalidation completes": {
+			mock: func(m *invalidationMocks) {
+				m.resources.EXPECT().StackResources("app-test-mockSite").Return(distribution, nil)
+				m.invalidations.EXPECT().LatestInvalidation("E123").Return(&awscloudfront.Invalidation{
+					ID:         "I2",
+					Status:     "InProgress",
+					CreateTime: start.Add(time.Minute),
+				}, nil)
+				gomock.InOrder(
+					m.spinner.EXPECT().Start(fmt.Sprintf(fmtInvalidateCacheProgress, "mockSite", "InProgress", "1m0s")),
+					m.invalidations.EXPECT().InvalidationStatus("E123", "I2").Return("InProgress", nil),
+					m.spinner.EXPECT().Start(fmt.Sprintf(fmtInvalidateCacheProgress, "mockSite", "InProgress", "1m0s")),
+					m.invalidations.EXPECT().InvalidationStatus("E123", "I2").Return("Completed", nil),
+					m.spinner.EXPECT().Stop(log.Ssuccessf(fmtInvalidateCacheComplete, "mockSite", "1m0s")),
+				)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &invalidationMocks{
+				resources:     mocks.NewMockstackResourcesGetter(ctrl),
+				invalidations: mocks.NewMockinvalidationGetter(ctrl),
+				spinner:       mocks.NewMockspinner(ctrl),
+			}
+			tc.mock(m)
+			d := &staticSiteDeployer{
+				svcDeployer: &svcDeployer{
+					workloadDeployer: &workloadDeployer{
+						name:    "mockSite",
+						app:     &config.Application{Name: "app"},
+						env:     &config.Environment{Name: "test"},
+						spinner: m.spinner,
+					},
+					now: func() time.Time { return start.Add(2 * time.Minute) },
+				},
+				staticSiteMft: &manifest.StaticSite{
+					StaticSiteConfig: manifest.StaticSiteConfig{
+						HTTP: manifest.StaticSiteHTTP{
+							Cache: manifest.StaticSiteCache{Invalidation: tc.invalidation},
+						},
+					},
+				},
+				stackResources: m.resources,
+				invalidations:  m.invalidations,
+			}
+
+			err := d.waitForInvalidation(start)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestStaticSiteDeployer_stackConfiguration(t *testing.T) {
 	tests := map[string]struct {
 		deployer     *staticSiteDeployer
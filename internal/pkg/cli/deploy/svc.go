@@ -5,18 +5,29 @@
 package deploy
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"regexp"
+	"strings"
+	"text/tabwriter"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"golang.org/x/mod/semver"
 
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/revision"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template/diff"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 )
@@ -34,13 +45,31 @@ type serviceForceUpdater interface {
 	LastUpdatedAt(app, env, svc string) (time.Time, error)
 }
 
+type serviceImageUpdater interface {
+	UpdateServiceImages(app, env, svc string, images map[string]string) error
+}
+
 type aliasCertValidator interface {
 	ValidateCertAliases(aliases []string, certs []string) error
 }
 
+type revisionRecorder interface {
+	Record(rev *stack.Revision) error
+	RecordLog(stackName, id string, content []byte) error
+	List(stackName string) ([]string, error)
+	Get(stackName, id string) (*stack.Revision, error)
+}
+
+type stackEventsGetter interface {
+	StackEvents(stackName string, since time.Time) ([]awscloudformation.StackEvent, error)
+}
+
 type svcDeployer struct {
 	*workloadDeployer
 	newSvcUpdater func(func(*session.Session) serviceForceUpdater) serviceForceUpdater
+	imageUpdater  serviceImageUpdater
+	revisions     revisionRecorder
+	stackEvents   stackEventsGetter
 	now           func() time.Time
 }
 
@@ -49,16 +78,30 @@ func newSvcDeployer(in *WorkloadDeployerInput) (*svcDeployer, error) {
 	if err != nil {
 		return nil, err
 	}
+	revisions := revision.NewStore(s3.New(wkldDeployer.envSess), wkldDeployer.resources.S3Bucket)
+	wkldDeployer.history = revisions
 	return &svcDeployer{
 		workloadDeployer: wkldDeployer,
 		newSvcUpdater: func(f func(*session.Session) serviceForceUpdater) serviceForceUpdater {
 			return f(wkldDeployer.envSess)
 		},
-		now: time.Now,
+		imageUpdater: ecs.New(wkldDeployer.envSess),
+		revisions:    revisions,
+		stackEvents:  cloudformation.New(wkldDeployer.envSess),
+		now:          time.Now,
 	}, nil
 }
 
 func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackConfigurationOutput) error {
+	if deployOptions.HotSwap {
+		swapped, err := d.hotSwap(deployOptions, stackConfigOutput)
+		if err != nil {
+			return err
+		}
+		if swapped {
+			return nil
+		}
+	}
 	opts := []awscloudformation.StackOption{
 		awscloudformation.WithRoleARN(d.env.ExecutionRoleARN),
 	}
@@ -66,15 +109,20 @@ func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackCo
 		opts = append(opts, awscloudformation.WithDisableRollback())
 	}
 	cmdRunAt := d.now()
-	if err := d.deployer.DeployService(stackConfigOutput.conf, d.resources.S3Bucket, opts...); err != nil {
+	err := d.deployer.DeployService(stackConfigOutput.conf, d.resources.S3Bucket, opts...)
+	if err != nil {
 		var errEmptyCS *awscloudformation.ErrChangeSetEmpty
 		if !errors.As(err, &errEmptyCS) {
+			d.recordLog(cmdRunAt, deployOptions, err)
 			return fmt.Errorf("deploy service: %w", err)
 		}
 		if !deployOptions.ForceNewUpdate {
 			log.Warningln("Set --force to force an update for the service.")
 			return fmt.Errorf("deploy service: %w", err)
 		}
+	} else {
+		d.recordRevision(stackConfigOutput, cmdRunAt, deployOptions)
+		d.recordLog(cmdRunAt, deployOptions, nil)
 	}
 	// Force update the service if --force is set and the service is not updated by the CFN.
 	if deployOptions.ForceNewUpdate {
@@ -94,9 +142,239 @@ func (d *svcDeployer) deploy(deployOptions Options, stackConfigOutput svcStackCo
 	return nil
 }
 
+// hotSwap updates the ECS service to the new image of the main container without a stack update,
+// if the image is the only change against the deployed stack.
+// If the addons are the only change instead, it updates the addons template URL of the stack alone.
+// Returns false if the service needs a full deployment instead.
+func (d *svcDeployer) hotSwap(deployOptions Options, stackConfigOutput svcStackConfigurationOutput) (bool, error) {
+	// ECS rejects UpdateService calls on services controlled by CodeDeploy,
+	// and the deployment hooks only run as part of a stack update.
+	if mft, ok := d.mft.(interface{ IsBlueGreen() bool }); ok && mft.IsBlueGreen() {
+		log.Infof("Service %s is deployed with a blue/green deployment, falling back to a full deployment.\n", d.name)
+		return false, nil
+	}
+	if mft, ok := d.mft.(interface {
+		DeploymentHooks() manifest.DeploymentHooks
+	}); ok && !mft.DeploymentHooks().IsEmpty() {
+		log.Infof("Service %s has deployment hooks, falling back to a full deployment.\n", d.name)
+		return false, nil
+	}
+	stackName := stack.NameForWorkload(d.app.Name, d.env.Name, d.name)
+	deployed, err := d.tmplGetter.Template(stackName)
+	if err != nil {
+		var errNotFound *awscloudformation.ErrStackNotFound
+		if errors.As(err, &errNotFound) {
+			log.Infof("Service %s is not deployed yet, falling back to a full deployment.\n", d.name)
+			return false, nil
+		}
+		return false, fmt.Errorf("retrieve the deployed template for %q: %w", d.name, err)
+	}
+	tmpl, err := stackConfigOutput.conf.Template()
+	if err != nil {
+		return false, fmt.Errorf("generate stack template for %q: %w", d.name, err)
+	}
+	diffTree, err := diff.From(deployed).ParseWithCFNOverriders([]byte(tmpl))
+	if err != nil {
+		return false, fmt.Errorf("parse the diff against the deployed %q in environment %q: %w", d.name, d.env.Name, err)
+	}
+	if diffTree.HasChanges() {
+		log.Infof("The template of service %s changed, falling back to a full deployment.\n", d.name)
+		return false, nil
+	}
+	deployedParams, err := d.tmplGetter.StackParameters(stackName)
+	if err != nil {
+		return false, fmt.Errorf("retrieve the deployed parameters for %q: %w", d.name, err)
+	}
+	params, err := stackConfigOutput.conf.Parameters()
+	if err != nil {
+		return false, fmt.Errorf("generate stack parameters for %q: %w", d.name, err)
+	}
+	var image, addonsURL string
+	for _, param := range params {
+		key, val := aws.StringValue(param.ParameterKey), aws.StringValue(param.ParameterValue)
+		switch key {
+		case stack.WorkloadContainerImageParamKey:
+			image = val
+			continue
+		case stack.WorkloadAddonsTemplateURLParamKey:
+			addonsURL = val
+			continue
+		}
+		if deployedParams[key] != val {
+			log.Infof("Parameter %s of service %s changed, falling back to a full deployment.\n", key, d.name)
+			return false, nil
+		}
+	}
+	addonsChanged, err := d.addonsChanged(stackName, addonsURL, deployedParams[stack.WorkloadAddonsTemplateURLParamKey])
+	if err != nil {
+		return false, err
+	}
+	imageChanged := image != "" && image != deployedParams[stack.WorkloadContainerImageParamKey]
+	switch {
+	case imageChanged && addonsChanged:
+		log.Infof("Both the image and the addons of service %s changed, falling back to a full deployment.\n", d.name)
+		return false, nil
+	case addonsChanged:
+		return true, d.deployAddonsStack(deployOptions, stackConfigOutput, stackName, addonsURL)
+	case !imageChanged:
+		log.Infof("The image of service %s did not change, falling back to a full deployment.\n", d.name)
+		return false, nil
+	}
+	cmdRunAt := d.now()
+	d.spinner.Start(fmt.Sprintf(fmtHotSwapSvcStart, color.HighlightUserInput(d.name), color.HighlightUserInput(d.env.Name)))
+	// UpdateServiceImages waits until the service is stable, so that a failed rollout fails the command.
+	if err := d.imageUpdater.UpdateServiceImages(d.app.Name, d.env.Name, d.name, map[string]string{d.name: image}); err != nil {
+		d.spinner.Stop(log.Serrorf(fmtHotSwapSvcFailed, color.HighlightUserInput(d.name), color.HighlightUserInput(d.env.Name), err))
+		d.recordLog(cmdRunAt, deployOptions, err)
+		return false, fmt.Errorf("hot swap the image of service %s: %w", d.name, err)
+	}
+	d.spinner.Stop(log.Ssuccessf(fmtHotSwapSvcComplete, color.HighlightUserInput(d.name), color.HighlightUserInput(d.env.Name)))
+	d.recordRevision(stackConfigOutput, cmdRunAt, deployOptions)
+	d.recordLog(cmdRunAt, deployOptions, nil)
+	log.Warningf(`Stack %s still references the previous image of service %s.
+The drift is reconciled by the next deployment without %s.
+`, stackName, d.name, color.HighlightCode("--fast"))
+	return true, nil
+}
+
+// addonsChanged returns true if the addons template differs from the deployed addons stack of the service.
+// Returns false if the service adds or removes its addons, since the service stack has to create or delete the nested stack.
+func (d *svcDeployer) addonsChanged(stackName, newURL, deployedURL string) (bool, error) {
+	if newURL == deployedURL || newURL == "" || deployedURL == "" {
+		return false, nil
+	}
+	tmpl, err := d.addons.Template()
+	if err != nil {
+		return false, fmt.Errorf("render addons template for %q: %w", d.name, err)
+	}
+	deployed, err := d.tmplGetter.NestedStackTemplate(stackName, addon.StackName)
+	if err != nil {
+		return false, fmt.Errorf("retrieve the deployed addons template for %q: %w", d.name, err)
+	}
+	diffTree, err := diff.From(deployed).ParseWithCFNOverriders([]byte(tmpl))
+	if err != nil {
+		return false, fmt.Errorf("parse the diff against the deployed addons of %q in environment %q: %w", d.name, d.env.Name, err)
+	}
+	if !diffTree.HasChanges() {
+		log.Infof("Skipping unchanged nested stack %s of service %s.\n", addon.StackName, d.name)
+		return false, nil
+	}
+	return true, nil
+}
+
+// deployAddonsStack points the addons template URL parameter of the service stack to templateURL while keeping
+// the template and other parameters of the stack, so that CloudFormation updates only the nested addons stack.
+func (d *svcDeployer) deployAddonsStack(deployOptions Options, stackConfigOutput svcStackConfigurationOutput, stackName, templateURL string) error {
+	log.Infof("Skipping unchanged stack %s, updating only its nested stack %s.\n", stackName, addon.StackName)
+	cmdRunAt := d.now()
+	if err := d.deployer.UpdateStackParameters(stackName, map[string]string{
+		stack.WorkloadAddonsTemplateURLParamKey: templateURL,
+	}); err != nil {
+		d.recordLog(cmdRunAt, deployOptions, err)
+		return fmt.Errorf("deploy the addons of service %s: %w", d.name, err)
+	}
+	d.recordRevision(stackConfigOutput, cmdRunAt, deployOptions)
+	d.recordLog(cmdRunAt, deployOptions, nil)
+	return nil
+}
+
+// recordRevision stores the deployed stack in the deployment history of the service, so that it can be audited and rolled back to.
+// The deployment already succeeded, so failing to record it only results in a warning.
+func (d *svcDeployer) recordRevision(stackConfigOutput svcStackConfigurationOutput, deployedAt time.Time, deployOptions Options) {
+	images := make([]stack.ECRImage, 0, len(stackConfigOutput.images))
+	for _, img := range stackConfigOutput.images {
+		images = append(images, img)
+	}
+	rev, err := stack.NewRevision(stackConfigOutput.conf, deployedAt, images)
+	if err == nil {
+		for container, location := range stackConfigOutput.pinned {
+			if rev.ImageDigests == nil {
+				rev.ImageDigests = make(map[string]string)
+			}
+			rev.ImageDigests[container] = location[strings.LastIndex(location, "@")+1:]
+		}
+		rev.DeployedBy = deployOptions.DeployedBy
+		rev.FreezeOverride = deployOptions.FreezeOverride
+		rev.GitCommit = d.image.GitShortCommitTag
+		err = d.revisions.Record(rev)
+	}
+	if err != nil {
+		log.Warningf("Failed to record the deployment of service %s, it won't be listed by %s nor available to %s: %v\n",
+			d.name, color.HighlightCode("copilot svc deployments"), color.HighlightCode("copilot svc rollback"), err)
+	}
+}
+
+// recordLog stores the stack events and the result of a deployment, so that a failed deployment can be debugged
+// after the terminal session is gone. Failing to record the log only results in a warning.
+func (d *svcDeployer) recordLog(deployedAt time.Time, deployOptions Options, deployErr error) {
+	stackName := stack.NameForWorkload(d.app.Name, d.env.Name, d.name)
+	id := stack.RevisionID(deployedAt)
+	events, err := d.stackEvents.StackEvents(stackName, deployedAt)
+	if err == nil {
+		err = d.revisions.RecordLog(stackName, id, deploymentLog(deploymentLogInput{
+			id:         id,
+			svc:        d.name,
+			env:        d.env.Name,
+			deployedBy: deployOptions.DeployedBy,
+			gitCommit:  d.image.GitShortCommitTag,
+			events:     events,
+			err:        deployErr,
+		}))
+	}
+	if err != nil {
+		log.Warningf("Failed to record the log of deployment %s of service %s: %v\n", id, d.name, err)
+		return
+	}
+	if deployErr != nil {
+		log.Infof("Run %s to see the stack events of the failed deployment later.\n",
+			color.HighlightCode(fmt.Sprintf("copilot svc deployments logs %s -n %s -e %s", id, d.name, d.env.Name)))
+	}
+}
+
+type deploymentLogInput struct {
+	id         string
+	svc        string
+	env        string
+	deployedBy string
+	gitCommit  string
+	events     []awscloudformation.StackEvent
+	err        error
+}
+
+// deploymentLog returns the plain text log of a deployment with its result and stack events.
+func deploymentLog(in deploymentLogInput) []byte {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "Deployment %s of service %s in environment %s\n", in.id, in.svc, in.env)
+	if in.deployedBy != "" {
+		fmt.Fprintf(buf, "Deployed by: %s\n", in.deployedBy)
+	}
+	if in.gitCommit != "" {
+		fmt.Fprintf(buf, "Git commit: %s\n", in.gitCommit)
+	}
+	if in.err != nil {
+		fmt.Fprintf(buf, "Result: failed: %v\n", in.err)
+	} else {
+		fmt.Fprintln(buf, "Result: succeeded")
+	}
+	fmt.Fprintln(buf, "\nStack events")
+	w := tabwriter.NewWriter(buf, 0, 4, 2, ' ', 0)
+	for _, e := range in.events {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			aws.TimeValue(e.Timestamp).UTC().Format(time.RFC3339),
+			aws.StringValue(e.ResourceStatus),
+			aws.StringValue(e.LogicalResourceId),
+			aws.StringValue(e.ResourceType),
+			aws.StringValue(e.ResourceStatusReason))
+	}
+	w.Flush()
+	return buf.Bytes()
+}
+
 type svcStackConfigurationOutput struct {
 	conf       cloudformation.StackConfiguration
 	svcUpdater serviceForceUpdater
+	images     map[string]stack.ECRImage // Container name to the image pushed for the deployment.
+	pinned     map[string]string         // Container name to the location of its image pinned to a digest.
 }
 
 type errAppOutOfDate struct {
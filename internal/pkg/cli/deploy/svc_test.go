@@ -3,6 +3,24 @@
 
 package deploy
 
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
 type versionGetterDouble struct {
 	VersionFn func() (string, error)
 }
@@ -10,3 +28,384 @@ type versionGetterDouble struct {
 func (d *versionGetterDouble) Version() (string, error) {
 	return d.VersionFn()
 }
+
+type stackParamsDouble struct {
+	stubCloudFormationStack
+	params map[string]string
+}
+
+func (s *stackParamsDouble) Parameters() ([]*sdkcfn.Parameter, error) {
+	var params []*sdkcfn.Parameter
+	for k, v := range s.params {
+		params = append(params, &sdkcfn.Parameter{
+			ParameterKey:   aws.String(k),
+			ParameterValue: aws.String(v),
+		})
+	}
+	return params, nil
+}
+
+func TestSvcDeployer_deployHotSwap(t *testing.T) {
+	const (
+		mockApp   = "phonetool"
+		mockEnv   = "test"
+		mockSvc   = "api"
+		mockStack = "phonetool-test-api"
+		oldImage  = "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:old"
+		newImage  = "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:new"
+
+		oldAddonsURL  = "https://mockBucket.s3.us-west-2.amazonaws.com/manual/addons/api/old.yml"
+		newAddonsURL  = "https://mockBucket.s3.us-west-2.amazonaws.com/manual/addons/api/new.yml"
+		oldAddonsTmpl = `
+Resources:
+  Table:
+    Type: AWS::DynamoDB::Table`
+		newAddonsTmpl = `
+Resources:
+  Topic:
+    Type: AWS::SNS::Topic`
+	)
+	deployedTmpl, _ := new(stubCloudFormationStack).Template()
+	testCases := map[string]struct {
+		inMft      interface{}
+		inParams   map[string]string
+		setupMocks func(m *deployMocks)
+		wantErr    string
+	}{
+		"fall back to a full deployment if the service is deployed with blue/green": {
+			inMft: &manifest.LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+					DeployConfig: manifest.DeploymentConfig{
+						Type: aws.String(manifest.ECSBlueGreenDeploymentType),
+					},
+				},
+			},
+			setupMocks: func(m *deployMocks) {
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Return(nil)
+			},
+		},
+		"fall back to a full deployment if the service has deployment hooks": {
+			inMft: &manifest.WorkerService{
+				WorkerServiceConfig: manifest.WorkerServiceConfig{
+					DeployConfig: manifest.WorkerDeploymentConfig{
+						DeploymentHooks: manifest.DeploymentHooks{
+							PreDeploy: manifest.DeploymentHook{
+								Command: manifest.CommandOverride{
+									StringSlice: []string{"./migrate"},
+								},
+							},
+						},
+					},
+				},
+			},
+			setupMocks: func(m *deployMocks) {
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Return(nil)
+			},
+		},
+		"fall back to a full deployment if the stack is not deployed": {
+			setupMocks: func(m *deployMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(mockStack).Return("", &awscloudformation.ErrStackNotFound{})
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Return(nil)
+			},
+		},
+		"fall back to a full deployment if the template changed": {
+			setupMocks: func(m *deployMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(mockStack).Return(`
+Resources:
+  Topic:
+    Type: AWS::SNS::Topic`, nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Return(nil)
+			},
+		},
+		"fall back to a full deployment if another parameter changed": {
+			inParams: map[string]string{
+				stack.WorkloadContainerImageParamKey: newImage,
+				"TaskCount":                          "2",
+			},
+			setupMocks: func(m *deployMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(mockStack).Return(deployedTmpl, nil)
+				m.mockDeployedTmplGetter.EXPECT().StackParameters(mockStack).Return(map[string]string{
+					stack.WorkloadContainerImageParamKey: oldImage,
+					"TaskCount":                          "1",
+				}, nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Return(nil)
+			},
+		},
+		"fall back to a full deployment if the image did not change": {
+			inParams: map[string]string{
+				stack.WorkloadContainerImageParamKey: oldImage,
+			},
+			setupMocks: func(m *deployMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(mockStack).Return(deployedTmpl, nil)
+				m.mockDeployedTmplGetter.EXPECT().StackParameters(mockStack).Return(map[string]string{
+					stack.WorkloadContainerImageParamKey: oldImage,
+				}, nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Return(nil)
+			},
+		},
+		"error if fail to get the deployed template": {
+			setupMocks: func(m *deployMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(mockStack).Return("", errors.New("some error"))
+			},
+			wantErr: `retrieve the deployed template for "api": some error`,
+		},
+		"error if fail to get the deployed parameters": {
+			setupMocks: func(m *deployMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(mockStack).Return(deployedTmpl, nil)
+				m.mockDeployedTmplGetter.EXPECT().StackParameters(mockStack).Return(nil, errors.New("some error"))
+			},
+			wantErr: `retrieve the deployed parameters for "api": some error`,
+		},
+		"error if fail to update the service images": {
+			inParams: map[string]string{
+				stack.WorkloadContainerImageParamKey: newImage,
+			},
+			setupMocks: func(m *deployMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(mockStack).Return(deployedTmpl, nil)
+				m.mockDeployedTmplGetter.EXPECT().StackParameters(mockStack).Return(map[string]string{
+					stack.WorkloadContainerImageParamKey: oldImage,
+				}, nil)
+				m.mockSpinner.EXPECT().Start(fmt.Sprintf(fmtHotSwapSvcStart, mockSvc, mockEnv))
+				m.mockImageUpdater.EXPECT().UpdateServiceImages(mockApp, mockEnv, mockSvc, map[string]string{mockSvc: newImage}).
+					Return(errors.New("some error"))
+				m.mockSpinner.EXPECT().Stop(gomock.Any())
+			},
+			wantErr: "hot swap the image of service api: some error",
+		},
+		"update the service images without a stack update if only the image changed": {
+			inParams: map[string]string{
+				stack.WorkloadContainerImageParamKey: newImage,
+				"TaskCount":                          "1",
+			},
+			setupMocks: func(m *deployMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(mockStack).Return(deployedTmpl, nil)
+				m.mockDeployedTmplGetter.EXPECT().StackParameters(mockStack).Return(map[string]string{
+					stack.WorkloadContainerImageParamKey: oldImage,
+					"TaskCount":                          "1",
+				}, nil)
+				m.mockSpinner.EXPECT().Start(fmt.Sprintf(fmtHotSwapSvcStart, mockSvc, mockEnv))
+				m.mockImageUpdater.EXPECT().UpdateServiceImages(mockApp, mockEnv, mockSvc, map[string]string{mockSvc: newImage}).
+					Return(nil)
+				m.mockSpinner.EXPECT().Stop(log.Ssuccessf(fmtHotSwapSvcComplete, mockSvc, mockEnv))
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Return(nil)
+			},
+		},
+		"fall back to a full deployment if both the image and the addons changed": {
+			inParams: map[string]string{
+				stack.WorkloadContainerImageParamKey:    newImage,
+				stack.WorkloadAddonsTemplateURLParamKey: newAddonsURL,
+			},
+			setupMocks: func(m *deployMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(mockStack).Return(deployedTmpl, nil)
+				m.mockDeployedTmplGetter.EXPECT().StackParameters(mockStack).Return(map[string]string{
+					stack.WorkloadContainerImageParamKey:    oldImage,
+					stack.WorkloadAddonsTemplateURLParamKey: oldAddonsURL,
+				}, nil)
+				m.mockAddons.EXPECT().Template().Return(newAddonsTmpl, nil)
+				m.mockDeployedTmplGetter.EXPECT().NestedStackTemplate(mockStack, "AddonsStack").Return(oldAddonsTmpl, nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Return(nil)
+			},
+		},
+		"fall back to a full deployment if the addons are added": {
+			inParams: map[string]string{
+				stack.WorkloadContainerImageParamKey:    oldImage,
+				stack.WorkloadAddonsTemplateURLParamKey: newAddonsURL,
+			},
+			setupMocks: func(m *deployMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(mockStack).Return(deployedTmpl, nil)
+				m.mockDeployedTmplGetter.EXPECT().StackParameters(mockStack).Return(map[string]string{
+					stack.WorkloadContainerImageParamKey:    oldImage,
+					stack.WorkloadAddonsTemplateURLParamKey: "",
+				}, nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Return(nil)
+			},
+		},
+		"error if fail to get the deployed addons template": {
+			inParams: map[string]string{
+				stack.WorkloadAddonsTemplateURLParamKey: newAddonsURL,
+			},
+			setupMocks: func(m *deployMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(mockStack).Return(deployedTmpl, nil)
+				m.mockDeployedTmplGetter.EXPECT().StackParameters(mockStack).Return(map[string]string{
+					stack.WorkloadAddonsTemplateURLParamKey: oldAddonsURL,
+				}, nil)
+				m.mockAddons.EXPECT().Template().Return(newAddonsTmpl, nil)
+				m.mockDeployedTmplGetter.EXPECT().NestedStackTemplate(mockStack, "AddonsStack").Return("", errors.New("some error"))
+			},
+			wantErr: `retrieve the deployed addons template for "api": some error`,
+		},
+		"error if fail to deploy the addons stack": {
+			inParams: map[string]string{
+				stack.WorkloadAddonsTemplateURLParamKey: newAddonsURL,
+			},
+			setupMocks: func(m *deployMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(mockStack).Return(deployedTmpl, nil)
+				m.mockDeployedTmplGetter.EXPECT().StackParameters(mockStack).Return(map[string]string{
+					stack.WorkloadAddonsTemplateURLParamKey: oldAddonsURL,
+				}, nil)
+				m.mockAddons.EXPECT().Template().Return(newAddonsTmpl, nil)
+				m.mockDeployedTmplGetter.EXPECT().NestedStackTemplate(mockStack, "AddonsStack").Return(oldAddonsTmpl, nil)
+				m.mockServiceDeployer.EXPECT().UpdateStackParameters(mockStack, map[string]string{
+					stack.WorkloadAddonsTemplateURLParamKey: newAddonsURL,
+				}).Return(errors.New("some error"))
+			},
+			wantErr: "deploy the addons of service api: some error",
+		},
+		"update only the addons stack if only the addons changed": {
+			inParams: map[string]string{
+				stack.WorkloadContainerImageParamKey:    oldImage,
+				stack.WorkloadAddonsTemplateURLParamKey: newAddonsURL,
+			},
+			setupMocks: func(m *deployMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(mockStack).Return(deployedTmpl, nil)
+				m.mockDeployedTmplGetter.EXPECT().StackParameters(mockStack).Return(map[string]string{
+					stack.WorkloadContainerImageParamKey:    oldImage,
+					stack.WorkloadAddonsTemplateURLParamKey: oldAddonsURL,
+				}, nil)
+				m.mockAddons.EXPECT().Template().Return(newAddonsTmpl, nil)
+				m.mockDeployedTmplGetter.EXPECT().NestedStackTemplate(mockStack, "AddonsStack").Return(oldAddonsTmpl, nil)
+				m.mockServiceDeployer.EXPECT().UpdateStackParameters(mockStack, map[string]string{
+					stack.WorkloadAddonsTemplateURLParamKey: newAddonsURL,
+				}).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Return(nil)
+			},
+		},
+		"skip the unchanged addons stack and update the service images": {
+			inParams: map[string]string{
+				stack.WorkloadContainerImageParamKey:    newImage,
+				stack.WorkloadAddonsTemplateURLParamKey: newAddonsURL,
+			},
+			setupMocks: func(m *deployMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(mockStack).Return(deployedTmpl, nil)
+				m.mockDeployedTmplGetter.EXPECT().StackParameters(mockStack).Return(map[string]string{
+					stack.WorkloadContainerImageParamKey:    oldImage,
+					stack.WorkloadAddonsTemplateURLParamKey: oldAddonsURL,
+				}, nil)
+				m.mockAddons.EXPECT().Template().Return(newAddonsTmpl, nil)
+				m.mockDeployedTmplGetter.EXPECT().NestedStackTemplate(mockStack, "AddonsStack").Return(newAddonsTmpl, nil)
+				m.mockSpinner.EXPECT().Start(fmt.Sprintf(fmtHotSwapSvcStart, mockSvc, mockEnv))
+				m.mockImageUpdater.EXPECT().UpdateServiceImages(mockApp, mockEnv, mockSvc, map[string]string{mockSvc: newImage}).
+					Return(nil)
+				m.mockSpinner.EXPECT().Stop(log.Ssuccessf(fmtHotSwapSvcComplete, mockSvc, mockEnv))
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &deployMocks{
+				mockServiceDeployer:    mocks.NewMockserviceDeployer(ctrl),
+				mockAddons:             mocks.NewMockstackBuilder(ctrl),
+				mockDeployedTmplGetter: mocks.NewMockdeployedTemplateGetter(ctrl),
+				mockImageUpdater:       mocks.NewMockserviceImageUpdater(ctrl),
+				mockRevisionRecorder:   mocks.NewMockrevisionRecorder(ctrl),
+				mockStackEventsGetter:  mocks.NewMockstackEventsGetter(ctrl),
+				mockSpinner:            mocks.NewMockspinner(ctrl),
+			}
+			tc.setupMocks(m)
+			m.mockStackEventsGetter.EXPECT().StackEvents(gomock.Any(), gomock.Any()).AnyTimes()
+			m.mockRevisionRecorder.EXPECT().RecordLog(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			deployer := &svcDeployer{
+				workloadDeployer: &workloadDeployer{
+					name:       mockSvc,
+					app:        &config.Application{Name: mockApp},
+					env:        &config.Environment{Name: mockEnv},
+					resources:  &stack.AppRegionalResources{S3Bucket: "mockBucket"},
+					deployer:   m.mockServiceDeployer,
+					tmplGetter: m.mockDeployedTmplGetter,
+					spinner:    m.mockSpinner,
+					addons:     m.mockAddons,
+					mft:        tc.inMft,
+				},
+				imageUpdater: m.mockImageUpdater,
+				revisions:    m.mockRevisionRecorder,
+				stackEvents:  m.mockStackEventsGetter,
+				now:          time.Now,
+			}
+
+			err := deployer.deploy(Options{HotSwap: true}, svcStackConfigurationOutput{
+				conf: &stackParamsDouble{params: tc.inParams},
+			})
+
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcDeployer_recordLog(t *testing.T) {
+	const (
+		mockApp = "phonetool"
+		mockEnv = "test"
+		mockSvc = "frontend"
+	)
+	deployedAt := time.Date(2023, time.January, 2, 15, 4, 5, 0, time.UTC)
+	events := []awscloudformation.StackEvent{
+		{
+			Timestamp:            aws.Time(deployedAt.Add(time.Minute)),
+			ResourceStatus:       aws.String("UPDATE_FAILED"),
+			LogicalResourceId:    aws.String("Service"),
+			ResourceType:         aws.String("AWS::ECS::Service"),
+			ResourceStatusReason: aws.String("Circuit breaker triggered"),
+		},
+	}
+	testCases := map[string]struct {
+		deployErr  error
+		setupMocks func(r *mocks.MockrevisionRecorder, e *mocks.MockstackEventsGetter)
+	}{
+		"record the events and the error of a failed deployment": {
+			deployErr: errors.New("stack update failed"),
+			setupMocks: func(r *mocks.MockrevisionRecorder, e *mocks.MockstackEventsGetter) {
+				e.EXPECT().StackEvents("phonetool-test-frontend", deployedAt).Return(events, nil)
+				r.EXPECT().RecordLog("phonetool-test-frontend", "20230102-150405", gomock.Any()).DoAndReturn(func(_, _ string, content []byte) error {
+					require.Equal(t, `Deployment 20230102-150405 of service frontend in environment test
+Deployed by: arn:aws:iam::1234:user/alice
+Result: failed: stack update failed
+
+Stack events
+2023-01-02T15:05:05Z  UPDATE_FAILED  Service  AWS::ECS::Service  Circuit breaker triggered
+`, string(content))
+					return nil
+				})
+			},
+		},
+		"only warn if the events can't be retrieved": {
+			setupMocks: func(r *mocks.MockrevisionRecorder, e *mocks.MockstackEventsGetter) {
+				e.EXPECT().StackEvents(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+				r.EXPECT().RecordLog(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			r, e := mocks.NewMockrevisionRecorder(ctrl), mocks.NewMockstackEventsGetter(ctrl)
+			tc.setupMocks(r, e)
+			deployer := &svcDeployer{
+				workloadDeployer: &workloadDeployer{
+					name: mockSvc,
+					app:  &config.Application{Name: mockApp},
+					env:  &config.Environment{Name: mockEnv},
+				},
+				revisions:   r,
+				stackEvents: e,
+			}
+
+			deployer.recordLog(deployedAt, Options{DeployedBy: "arn:aws:iam::1234:user/alice"}, tc.deployErr)
+		})
+	}
+}
@@ -55,14 +55,24 @@ func NewWorkerSvcDeployer(in *WorkloadDeployerInput) (*workerSvcDeployer, error)
 	if err != nil {
 		return nil, err
 	}
-	deployStore, err := deploy.NewStore(in.SessionProvider, svcDeployer.store)
-	if err != nil {
-		return nil, fmt.Errorf("new deploy store: %w", err)
-	}
 	wsMft, ok := in.Mft.(*manifest.WorkerService)
 	if !ok {
 		return nil, fmt.Errorf("manifest is not of type %s", manifestinfo.WorkerServiceType)
 	}
+	if in.Offline != nil {
+		return &workerSvcDeployer{
+			svcDeployer: svcDeployer,
+			topicLister: offlineTopicLister{
+				env:           in.Env,
+				subscriptions: wsMft.Subscriptions(),
+			},
+			wsMft: wsMft,
+		}, nil
+	}
+	deployStore, err := deploy.NewStore(in.SessionProvider, svcDeployer.store)
+	if err != nil {
+		return nil, fmt.Errorf("new deploy store: %w", err)
+	}
 	return &workerSvcDeployer{
 		svcDeployer: svcDeployer,
 		topicLister: deployStore,
@@ -160,6 +170,9 @@ func (d *workerSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*
 	if err != nil {
 		return nil, err
 	}
+	if err := d.validateEC2Runtime(d.wsMft.TaskConfig); err != nil {
+		return nil, err
+	}
 	var topics []deploy.Topic
 	topics, err = d.topicLister.ListSNSTopics(d.app.Name, d.env.Name)
 	if err != nil {
@@ -184,6 +197,7 @@ func (d *workerSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*
 			Env:                d.env.Name,
 			Manifest:           d.wsMft,
 			RawManifest:        d.rawMft,
+			RenderedManifest:   d.renderedMft,
 			ArtifactBucketName: d.resources.S3Bucket,
 			RuntimeConfig:      *rc,
 			Addons:             d.addons,
@@ -199,6 +213,8 @@ func (d *workerSvcDeployer) stackConfiguration(in *StackRuntimeConfiguration) (*
 			svcUpdater: d.newSvcUpdater(func(s *session.Session) serviceForceUpdater {
 				return ecs.New(s)
 			}),
+			images: rc.PushedImages,
+			pinned: rc.PinnedImages,
 		},
 		subscriptions: subs,
 	}, nil
@@ -0,0 +1,113 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package deploy
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/sfn"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+)
+
+type workflowJobDeployer struct {
+	*workloadDeployer
+	workflowMft *manifest.WorkflowJob
+
+	// Overriden in tests.
+	newStack func(stack.WorkflowJobConfig) (cloudformation.StackConfiguration, error)
+}
+
+// NewWorkflowJobDeployer is the constructor for workflowJobDeployer.
+func NewWorkflowJobDeployer(in *WorkloadDeployerInput) (*workflowJobDeployer, error) {
+	in.customResources = workflowJobCustomResources
+	wkldDeployer, err := newWorkloadDeployer(in)
+	if err != nil {
+		return nil, err
+	}
+	mft, ok := in.Mft.(*manifest.WorkflowJob)
+	if !ok {
+		return nil, fmt.Errorf("manifest is not of type %s", manifestinfo.WorkflowJobType)
+	}
+	return &workflowJobDeployer{
+		workloadDeployer: wkldDeployer,
+		workflowMft:      mft,
+		newStack: func(config stack.WorkflowJobConfig) (cloudformation.StackConfiguration, error) {
+			return stack.NewWorkflowJob(config)
+		},
+	}, nil
+}
+
+func workflowJobCustomResources(fs template.Reader) ([]*customresource.CustomResource, error) {
+	crs, err := customresource.WorkflowJob(fs)
+	if err != nil {
+		return nil, fmt.Errorf("read custom resources for a %q: %w", manifestinfo.WorkflowJobType, err)
+	}
+	return crs, nil
+}
+
+// IsServiceAvailableInRegion checks if Step Functions is available in the given region.
+func (*workflowJobDeployer) IsServiceAvailableInRegion(region string) (bool, error) {
+	return partitions.IsAvailableInRegion(sfn.EndpointsID, region)
+}
+
+// UploadArtifacts uploads the deployment artifacts such as the container images of the steps, custom resources and addons.
+func (d *workflowJobDeployer) UploadArtifacts() (*UploadArtifactsOutput, error) {
+	return d.uploadArtifacts(d.uploadContainerImages, d.uploadArtifactsToS3, d.uploadCustomResources)
+}
+
+// GenerateCloudFormationTemplate generates a CloudFormation template and parameters for a workload.
+func (d *workflowJobDeployer) GenerateCloudFormationTemplate(in *GenerateCloudFormationTemplateInput) (
+	*GenerateCloudFormationTemplateOutput, error) {
+	conf, err := d.stackConfiguration(&in.StackRuntimeConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	return d.generateCloudFormationTemplate(conf)
+}
+
+// DeployWorkload deploys a workflow job using CloudFormation.
+func (d *workflowJobDeployer) DeployWorkload(in *DeployWorkloadInput) (ActionRecommender, error) {
+	conf, err := d.stackConfiguration(&in.StackRuntimeConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	opts := []awscloudformation.StackOption{
+		awscloudformation.WithRoleARN(d.env.ExecutionRoleARN),
+	}
+	if in.DisableRollback {
+		opts = append(opts, awscloudformation.WithDisableRollback())
+	}
+	if err := d.deployer.DeployService(conf, d.resources.S3Bucket, opts...); err != nil {
+		return nil, fmt.Errorf("deploy job: %w", err)
+	}
+	return noopActionRecommender{}, nil
+}
+
+func (d *workflowJobDeployer) stackConfiguration(in *StackRuntimeConfiguration) (cloudformation.StackConfiguration, error) {
+	rc, err := d.runtimeConfig(in)
+	if err != nil {
+		return nil, err
+	}
+	conf, err := d.newStack(stack.WorkflowJobConfig{
+		App:                d.app,
+		Env:                d.env.Name,
+		Manifest:           d.workflowMft,
+		RawManifest:        d.rawMft,
+		RenderedManifest:   d.renderedMft,
+		ArtifactBucketName: d.resources.S3Bucket,
+		RuntimeConfig:      *rc,
+		Addons:             d.addons,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create stack configuration: %w", err)
+	}
+	return cloudformation.WrapWithTemplateOverrider(conf, d.overrider), nil
+}
@@ -6,6 +6,7 @@ package deploy
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -25,12 +26,15 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	awsssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/buildcache"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/docker/remotebuild"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/repository"
@@ -44,6 +48,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/syncbuffer"
 	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/afero"
 	"golang.org/x/sync/errgroup"
 )
@@ -52,6 +57,14 @@ const (
 	fmtForceUpdateSvcStart    = "Forcing an update for service %s from environment %s"
 	fmtForceUpdateSvcFailed   = "Failed to force an update for service %s from environment %s: %v.\n"
 	fmtForceUpdateSvcComplete = "Forced an update for service %s from environment %s.\n"
+	fmtHotSwapSvcStart        = "Updating the image of service %s in environment %s without a stack update"
+	fmtHotSwapSvcFailed       = "Failed to update the image of service %s in environment %s: %v.\n"
+	fmtHotSwapSvcComplete     = "Updated the image of service %s in environment %s.\n"
+
+	fmtUploadS3ArtifactsStart    = "Uploading the environment files and addons of %s to S3"
+	fmtUploadS3ArtifactsFailed   = "Failed to upload the environment files and addons of %s to S3.\n"
+	fmtUploadS3ArtifactsComplete = "Uploaded the environment files and addons of %s to S3.\n"
+	fmtDeployDiffStart           = "Comparing %s to its deployed stack in environment %s"
 )
 const (
 	imageTagLatest = "latest"
@@ -61,6 +74,8 @@ const (
 	labelForBuilder       = "com.aws.copilot.image.builder"
 	labelForVersion       = "com.aws.copilot.image.version"
 	labelForContainerName = "com.aws.copilot.image.container.name"
+	labelForGitCommit     = "com.aws.copilot.image.git.commit"
+	labelForBuildArgs     = "com.aws.copilot.image.build.args"
 )
 const (
 	paddingInSpacesForBuildAndPush = 5
@@ -105,10 +120,13 @@ type endpointGetter interface {
 
 type serviceDeployer interface {
 	DeployService(conf cloudformation.StackConfiguration, bucketName string, opts ...awscloudformation.StackOption) error
+	UpdateStackParameters(stackName string, params map[string]string) error
 }
 
 type deployedTemplateGetter interface {
 	Template(stackName string) (string, error)
+	NestedStackTemplate(stackName, logicalID string) (string, error)
+	StackParameters(stackName string) (map[string]string, error)
 }
 
 type spinner interface {
@@ -121,13 +139,46 @@ type labeledTermPrinter interface {
 	Print()
 }
 
+type imageScanner interface {
+	ImageScanFindings(ctx context.Context, repoName, digest string) ([]ecr.ScanFinding, error)
+}
+
+type imageTagger interface {
+	TagImage(repoName, digest string, tags ...string) error
+}
+
+type sbomGenerator interface {
+	Generate(image, format string, w io.Writer) error
+}
+
+type imageSigner interface {
+	Sign(image, kmsKey string) error
+}
+
+type provenanceRecorder interface {
+	PutSecret(in awsssm.PutSecretInput) (*awsssm.PutSecretOutput, error)
+}
+
 type dockerEngineRunChecker interface {
 	CheckDockerEngineRunning() error
 }
 
+type imageDigestResolver interface {
+	ImageDigest(ctx context.Context, image string) (string, error)
+}
+
+type imageCopier interface {
+	CopyImage(ctx context.Context, src, dst string, tags ...string) error
+}
+
+type imagePlatformsGetter interface {
+	ImagePlatforms(img ecr.ImageURI) ([]string, error)
+}
+
 // StackRuntimeConfiguration contains runtime configuration for a workload CloudFormation stack.
 type StackRuntimeConfiguration struct {
 	ImageDigests              map[string]ContainerImageIdentifier // Container name to image.
+	PinnedImages              map[string]string                   // Container name to the location of its image pinned to a digest.
 	EnvFileARNs               map[string]string
 	AddonsURL                 string
 	RootUserARN               string
@@ -147,6 +198,9 @@ type DeployWorkloadInput struct {
 type Options struct {
 	ForceNewUpdate  bool
 	DisableRollback bool
+	HotSwap         bool   // Update the ECS service or the addons stack directly if the image or the addons are the only change.
+	DeployedBy      string // Identity recorded in the deployment history of the workload.
+	FreezeOverride  string // Reason for deploying in spite of the deploy policy, recorded in the deployment history.
 }
 
 // GenerateCloudFormationTemplateInput is the input of GenerateCloudFormationTemplate.
@@ -160,11 +214,6 @@ type GenerateCloudFormationTemplateOutput struct {
 	Parameters string
 }
 
-// RepoName returns the name of a Copilot-managed ECR repository given an application and workload.
-func RepoName(app, workload string) string {
-	return fmt.Sprintf("%s/%s", app, workload)
-}
-
 type workloadDeployer struct {
 	name          string
 	app           *config.Application
@@ -173,7 +222,13 @@ type workloadDeployer struct {
 	resources     *stack.AppRegionalResources
 	mft           interface{}
 	rawMft        []byte
+	renderedMft   []byte
 	workspacePath string
+	builder       string            // Tool to build container images with.
+	buildCache    *buildcache.Cache // Nil if images are always built.
+	pinDigests    bool              // Reference images by digest instead of tag in the task definition.
+	prebuiltImage string            // Existing image of the main container, deployed instead of building one.
+	sourceImages  map[string]string // Images built for another environment, copied instead of building them.
 
 	// Dependencies.
 	fs                 afero.Fs
@@ -188,7 +243,17 @@ type workloadDeployer struct {
 	envVersionGetter   versionGetter
 	overrider          Overrider
 	docker             dockerEngineRunChecker
+	digestResolver     imageDigestResolver
+	imageCopier        imageCopier
+	prebuiltImages     func(region string) (imagePlatformsGetter, error)
 	customResources    customResourcesFunc
+	remoteBuilder      func() (repositoryService, error) // Nil unless images are built with AWS CodeBuild.
+	history            revisionRecorder                  // Nil unless the deployments of the workload are recorded.
+	scanner            imageScanner
+	tagger             imageTagger
+	signer             imageSigner
+	sbomGenerator      sbomGenerator
+	provenance         provenanceRecorder
 	labeledTermPrinter func(fw syncbuffer.FileWriter, bufs []*syncbuffer.LabeledSyncBuffer, opts ...syncbuffer.LabeledTermPrinterOption) labeledTermPrinter
 
 	// Cached variables.
@@ -208,13 +273,38 @@ type WorkloadDeployerInput struct {
 	Image            ContainerImageIdentifier
 	Mft              interface{} // Interpolated, applied, and unmarshaled manifest.
 	RawMft           []byte      // Content of the manifest file without any transformations.
+	RenderedMft      []byte      // Content of the manifest file with its environment variables substituted, if it has any.
 	EnvVersionGetter versionGetter
 	Overrider        Overrider
+	Builder          string // Tool to build container images with. Overrides "image.builder" in the manifest if not empty.
+	BuildRemote      bool   // Build container images with AWS CodeBuild instead of a local tool.
+	PinDigests       bool   // Resolve the tags of the images to digests, so that the tasks run the exact images of the deployment.
+	PrebuiltImage    string // ECR URI with the digest of an existing image to deploy for the main container instead of building one.
+
+	// Container name to the ECR URI with the digest of its image built for another environment.
+	// These images are copied to the repository of the environment instead of being built again.
+	SourceImages map[string]string
+
+	// Images and artifacts that are unchanged since they were last pushed from the workspace are reused if not nil.
+	BuildCache *buildcache.Cache
+
+	// Generate templates without looking up the environment and the application in AWS if not nil.
+	Offline *Offline
+
+	// Workspace of the workload. Defaults to the workspace of the current working directory if nil.
+	Workspace *workspace.Workspace
 
 	// Workload specific configuration.
 	customResources customResourcesFunc
 }
 
+func (in *WorkloadDeployerInput) workspace() (*workspace.Workspace, error) {
+	if in.Workspace != nil {
+		return in.Workspace, nil
+	}
+	return workspace.Use(afero.NewOsFs())
+}
+
 // ContainerImageIdentifier is the configuration of the image digest and tags of an ECR image.
 type ContainerImageIdentifier struct {
 	Digest            string
@@ -224,7 +314,7 @@ type ContainerImageIdentifier struct {
 
 // newWorkloadDeployer is the constructor for workloadDeployer.
 func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
-	ws, err := workspace.Use(afero.NewOsFs())
+	ws, err := in.workspace()
 	if err != nil {
 		return nil, err
 	}
@@ -240,7 +330,28 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("create default session with region %s: %w", in.Env.Region, err)
 	}
-	resources, err := cloudformation.New(defaultSession, cloudformation.WithProgressTracker(os.Stderr)).GetAppResourcesByRegion(in.App, in.Env.Region)
+	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	var (
+		appResources appResourcesGetter = cloudformation.New(defaultSession, cloudformation.WithProgressTracker(os.Stderr))
+		envDescriber interface {
+			endpointGetter
+			Manifest() ([]byte, error)
+		}
+	)
+	if in.Offline != nil {
+		offline := newOfflineEnv(in.Offline, in.App, in.Env, in.Name)
+		appResources, envDescriber = offline, offline
+	} else {
+		envDescriber, err = describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+			App:         in.App.Name,
+			Env:         in.Env.Name,
+			ConfigStore: store,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	resources, err := appResources.GetAppResourcesByRegion(in.App, in.Env.Region)
 	if err != nil {
 		return nil, fmt.Errorf("get application %s resources from region %s: %w", in.App.Name, in.Env.Region, err)
 	}
@@ -255,19 +366,22 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 		addons = nil // so that we can check for no addons with nil comparison
 	}
 
-	repoName := RepoName(in.App.Name, in.Name)
-	repository := repository.NewWithURI(
-		ecr.New(defaultSessEnvRegion), repoName, resources.RepositoryURLs[in.Name])
-	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
-	envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
-		App:         in.App.Name,
-		Env:         in.Env.Name,
-		ConfigStore: store,
-	})
+	builder := in.Builder
+	if mft, ok := in.Mft.(interface{ ImageBuilder() string }); ok && builder == "" {
+		builder = mft.ImageBuilder()
+	}
+	if in.BuildRemote {
+		// Remote builds always run docker in the build container.
+		builder = dockerengine.BuilderDocker
+	}
+	docker, err := dockerengine.NewWithBuilder(exec.NewCmd(), builder)
 	if err != nil {
 		return nil, err
 	}
 
+	repoName := in.App.RepositoryName(in.Name)
+	repository := repository.NewWithURI(
+		ecr.New(defaultSessEnvRegion), repoName, resources.RepositoryURLs[in.Name], repository.WithDocker(docker))
 	mft, err := envDescriber.Manifest()
 	if err != nil {
 		return nil, fmt.Errorf("read the manifest used to deploy environment %s: %w", in.Env.Name, err)
@@ -279,30 +393,74 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 
 	cfn := cloudformation.New(envSession, cloudformation.WithProgressTracker(os.Stderr))
 
+	var remoteBuilder func() (repositoryService, error)
+	if in.BuildRemote {
+		remoteBuilder = func() (repositoryService, error) {
+			// The builder lives next to the regional resources of the application, in the application account.
+			builderCfn := cloudformation.New(defaultSessEnvRegion, cloudformation.WithProgressTracker(os.Stderr))
+			if err := builderCfn.DeployRemoteBuilder(&stack.RemoteBuilderConfig{
+				App:                 in.App.Name,
+				PermissionsBoundary: in.App.PermissionsBoundary,
+				ArtifactBucket:      resources.S3Bucket,
+				AdditionalTags:      in.App.Tags,
+			}); err != nil {
+				return nil, fmt.Errorf("deploy remote builder for application %s: %w", in.App.Name, err)
+			}
+			return remotebuild.New(defaultSessEnvRegion, s3.New(defaultSessEnvRegion), remotebuild.Input{
+				ProjectName:   stack.NameForRemoteBuilder(in.App.Name),
+				Bucket:        resources.S3Bucket,
+				RepositoryURI: resources.RepositoryURLs[in.Name],
+			}), nil
+		}
+	}
+
+	var s3Client uploader = s3.New(envSession)
+	if in.BuildCache != nil {
+		s3Client = in.BuildCache.Uploader(s3Client)
+	}
 	labeledTermPrinter := func(fw syncbuffer.FileWriter, bufs []*syncbuffer.LabeledSyncBuffer, opts ...syncbuffer.LabeledTermPrinterOption) labeledTermPrinter {
 		return syncbuffer.NewLabeledTermPrinter(fw, bufs, opts...)
 	}
-	docker := dockerengine.New(exec.NewCmd())
 	return &workloadDeployer{
-		name:                     in.Name,
-		app:                      in.App,
-		env:                      in.Env,
-		image:                    in.Image,
-		resources:                resources,
-		workspacePath:            ws.Path(),
-		fs:                       afero.NewOsFs(),
-		s3Client:                 s3.New(envSession),
-		addons:                   addons,
-		repository:               repository,
-		deployer:                 cfn,
-		tmplGetter:               cfn,
-		endpointGetter:           envDescriber,
-		spinner:                  termprogress.NewSpinner(log.DiagnosticWriter),
-		templateFS:               template.New(),
-		envVersionGetter:         in.EnvVersionGetter,
-		overrider:                in.Overrider,
-		docker:                   docker,
+		name:             in.Name,
+		app:              in.App,
+		env:              in.Env,
+		image:            in.Image,
+		resources:        resources,
+		workspacePath:    ws.Path(),
+		builder:          docker.Builder(),
+		buildCache:       in.BuildCache,
+		pinDigests:       in.PinDigests,
+		prebuiltImage:    in.PrebuiltImage,
+		sourceImages:     in.SourceImages,
+		fs:               afero.NewOsFs(),
+		s3Client:         s3Client,
+		addons:           addons,
+		repository:       repository,
+		deployer:         cfn,
+		tmplGetter:       cfn,
+		endpointGetter:   envDescriber,
+		spinner:          termprogress.NewSpinner(log.DiagnosticWriter),
+		templateFS:       template.New(),
+		envVersionGetter: in.EnvVersionGetter,
+		overrider:        in.Overrider,
+		docker:           docker,
+		digestResolver:   docker,
+		imageCopier:      docker,
+		prebuiltImages: func(region string) (imagePlatformsGetter, error) {
+			sess, err := in.SessionProvider.DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create default session with region %s: %w", region, err)
+			}
+			return ecr.New(sess), nil
+		},
 		customResources:          in.customResources,
+		remoteBuilder:            remoteBuilder,
+		scanner:                  ecr.New(defaultSessEnvRegion),
+		tagger:                   ecr.New(defaultSessEnvRegion),
+		signer:                   exec.NewCosignCommand(in.Env.Region),
+		sbomGenerator:            exec.NewSBOMCommand(),
+		provenance:               awsssm.New(defaultSessEnvRegion),
 		defaultSess:              defaultSession,
 		defaultSessWithEnvRegion: defaultSessEnvRegion,
 		envSess:                  envSession,
@@ -310,27 +468,170 @@ func newWorkloadDeployer(in *WorkloadDeployerInput) (*workloadDeployer, error) {
 		envConfig:                envConfig,
 		labeledTermPrinter:       labeledTermPrinter,
 
-		mft:    in.Mft,
-		rawMft: in.RawMft,
+		mft:         in.Mft,
+		rawMft:      in.RawMft,
+		renderedMft: in.RenderedMft,
 	}, nil
 }
 
 // DeployDiff returns the stringified diff of the template against the deployed template of the workload.
+// If the deployed stack was changed outside of Copilot since its last recorded deployment, then these changes
+// are shown apart from the changes from the manifest.
 func (d *workloadDeployer) DeployDiff(template string) (string, error) {
-	tmpl, err := d.tmplGetter.Template(stack.NameForWorkload(d.app.Name, d.env.Name, d.name))
+	d.spinner.Start(fmt.Sprintf(fmtDeployDiffStart, color.HighlightUserInput(d.name), color.HighlightUserInput(d.env.Name)))
+	defer d.spinner.Stop("")
+	stackName := stack.NameForWorkload(d.app.Name, d.env.Name, d.name)
+	deployed, isDeployed, err := d.deployedTemplate(stackName)
+	if err != nil {
+		return "", err
+	}
+	var base string
+	if isDeployed && d.history != nil {
+		if base, err = lastRecordedTemplate(d.history, stackName); err != nil {
+			return "", err
+		}
+	}
+	out, err := renderThreeWayDiff(base, deployed, template)
+	if err != nil {
+		return "", fmt.Errorf("parse the diff against the deployed %q in environment %q: %w", d.name, d.env.Name, err)
+	}
+	addonsTree, err := d.addonsDiffTree(stackName, isDeployed)
+	if err != nil {
+		return "", err
+	}
+	if addonsTree == nil {
+		return out, nil
+	}
+	addonsOut, err := renderDiffTree(*addonsTree)
+	if err != nil {
+		return "", err
+	}
+	if addonsOut == "" {
+		return out, nil
+	}
+	if out != "" {
+		out += "\n"
+	}
+	return out + fmt.Sprintf("Addons stack %q:\n", addon.StackName) + addonsOut, nil
+}
+
+// deployDiffJSON is the JSON representation of the diff of a workload stack and its addons stack.
+type deployDiffJSON struct {
+	Stack  json.RawMessage `json:"stack"`
+	Addons json.RawMessage `json:"addons,omitempty"`
+}
+
+// DeployDiffJSON returns the changes of the template against the deployed template of the workload as a JSON object.
+// The changes of the workload stack are under "stack", and the changes of its addons stack, if any, under "addons".
+// Sensitive values are redacted.
+func (d *workloadDeployer) DeployDiffJSON(template string) (string, error) {
+	d.spinner.Start(fmt.Sprintf(fmtDeployDiffStart, color.HighlightUserInput(d.name), color.HighlightUserInput(d.env.Name)))
+	defer d.spinner.Stop("")
+	stackTree, addonsTree, err := d.deployDiffTrees(template)
+	if err != nil {
+		return "", err
+	}
+	var out deployDiffJSON
+	buf := new(bytes.Buffer)
+	if err := stackTree.WriteJSON(buf); err != nil {
+		return "", fmt.Errorf("write the diff of %q as JSON: %w", d.name, err)
+	}
+	out.Stack = buf.Bytes()
+	if addonsTree != nil {
+		buf := new(bytes.Buffer)
+		if err := addonsTree.WriteJSON(buf); err != nil {
+			return "", fmt.Errorf("write the diff of the addons of %q as JSON: %w", d.name, err)
+		}
+		out.Addons = buf.Bytes()
+	}
+	data, err := json.Marshal(out)
+	if err != nil {
+		return "", fmt.Errorf("marshal the diff of %q: %w", d.name, err)
+	}
+	return string(data) + "\n", nil
+}
+
+// deployDiffTrees returns the diff of the template against the deployed template of the workload,
+// and the diff of its addons template against the deployed addons stack, which is nil if the workload has no addons.
+func (d *workloadDeployer) deployDiffTrees(template string) (diff.Tree, *diff.Tree, error) {
+	stackName := stack.NameForWorkload(d.app.Name, d.env.Name, d.name)
+	tmpl, isDeployed, err := d.deployedTemplate(stackName)
+	if err != nil {
+		return diff.Tree{}, nil, err
+	}
+	stackTree, err := diff.From(tmpl).ParseWithCFNOverriders([]byte(template))
+	if err != nil {
+		return diff.Tree{}, nil, fmt.Errorf("parse the diff against the deployed %q in environment %q: %w", d.name, d.env.Name, err)
+	}
+	addonsTree, err := d.addonsDiffTree(stackName, isDeployed)
+	if err != nil {
+		return diff.Tree{}, nil, err
+	}
+	return stackTree, addonsTree, nil
+}
+
+// deployedTemplate returns the template of the deployed workload stack, and whether the stack is deployed.
+func (d *workloadDeployer) deployedTemplate(stackName string) (string, bool, error) {
+	tmpl, err := d.tmplGetter.Template(stackName)
 	if err != nil {
 		var errNotFound *awscloudformation.ErrStackNotFound
 		if !errors.As(err, &errNotFound) {
-			return "", fmt.Errorf("retrieve the deployed template for %q: %w", d.name, err)
+			return "", false, fmt.Errorf("retrieve the deployed template for %q: %w", d.name, err)
+		}
+		return "", false, nil
+	}
+	return tmpl, true, nil
+}
+
+// addonsDiffTree returns the diff of the addons template against the deployed addons stack.
+// If the workload has no addons, then returns nil.
+func (d *workloadDeployer) addonsDiffTree(stackName string, isDeployed bool) (*diff.Tree, error) {
+	if d.addons == nil {
+		return nil, nil
+	}
+	template, err := d.addons.Template()
+	if err != nil {
+		return nil, fmt.Errorf("render addons template for %q: %w", d.name, err)
+	}
+	var deployed string
+	if isDeployed {
+		deployed, err = d.tmplGetter.NestedStackTemplate(stackName, addon.StackName)
+		if err != nil {
+			var errNotFound *awscloudformation.ErrStackNotFound
+			if !errors.As(err, &errNotFound) {
+				return nil, fmt.Errorf("retrieve the deployed addons template for %q: %w", d.name, err)
+			}
+			deployed = ""
 		}
-		tmpl = ""
 	}
-	diffTree, err := diff.From(tmpl).ParseWithCFNOverriders([]byte(template))
+	tree, err := diff.From(deployed).ParseWithCFNOverriders([]byte(template))
 	if err != nil {
-		return "", fmt.Errorf("parse the diff against the deployed %q in environment %q: %w", d.name, d.env.Name, err)
+		return nil, fmt.Errorf("parse the diff against the deployed addons of %q in environment %q: %w", d.name, d.env.Name, err)
+	}
+	return &tree, nil
+}
+
+// renderDiff returns the changes from the deployed template to the new one with a summary of the changed resources.
+// If there are no changes, then returns an empty string.
+func renderDiff(deployed, template string) (string, error) {
+	diffTree, err := diff.From(deployed).ParseWithCFNOverriders([]byte(template))
+	if err != nil {
+		return "", err
+	}
+	return renderDiffTree(diffTree)
+}
+
+// renderDiffTree returns the changes of the diff tree with a summary of the changed resources.
+// If there are no changes, then returns an empty string.
+func renderDiffTree(diffTree diff.Tree) (string, error) {
+	if !diffTree.HasChanges() {
+		return "", nil
 	}
 	buf := strings.Builder{}
-	if err := diffTree.Write(&buf); err != nil {
+	if summary := diffTree.Summary(); !summary.Resources().IsEmpty() {
+		buf.WriteString(summary.String() + "\n")
+	}
+	if err := diffTree.Write(&buf, diff.WithColor(color.EnabledFor(os.Stdout))); err != nil {
 		return "", err
 	}
 	return buf.String(), nil
@@ -393,47 +694,102 @@ func (img ContainerImageIdentifier) Tag() string {
 }
 
 func (d *workloadDeployer) uploadContainerImages(out *UploadArtifactsOutput) error {
+	if err := d.pinImageLocations(out); err != nil {
+		return err
+	}
+	if err := d.checkPrebuiltImage(); err != nil {
+		return err
+	}
 	// If it is built from local Dockerfile, build and push to the ECR repo.
 	buildArgsPerContainer, err := buildArgsPerContainer(d.name, d.workspacePath, d.image, d.mft)
 	if err != nil {
 		return err
 	}
+	if d.prebuiltImage != "" {
+		delete(buildArgsPerContainer, d.name)
+		if len(buildArgsPerContainer) != 0 {
+			return fmt.Errorf("deploy the existing image %s: sidecars %s build their image from a Dockerfile: set their \"image.location\" instead",
+				d.prebuiltImage, english.WordSeries(sortedKeys(buildArgsPerContainer), "and"))
+		}
+	}
 	if len(buildArgsPerContainer) == 0 {
 		return nil
 	}
-	if err := d.docker.CheckDockerEngineRunning(); err != nil {
+	repo, buildLocation := d.repository, ""
+	if d.remoteBuilder != nil {
+		if repo, err = d.remoteBuilder(); err != nil {
+			return err
+		}
+		buildLocation = " with AWS CodeBuild"
+	} else if err := d.docker.CheckDockerEngineRunning(); err != nil {
 		return fmt.Errorf("check if docker engine is running: %w", err)
 	}
-	uri, err := d.repository.Login()
+	uri, err := repo.Login()
 	if err != nil {
 		return fmt.Errorf("login to image repository: %w", err)
 	}
 
 	var digestsMu sync.Mutex
 	out.ImageDigests = make(map[string]ContainerImageIdentifier, len(buildArgsPerContainer))
+	buildClient, err := dockerengine.NewWithBuilder(exec.NewCmd(), d.builder)
+	if err != nil {
+		return err
+	}
 	var labeledBuffers []*syncbuffer.LabeledSyncBuffer
 	g, ctx := errgroup.WithContext(context.Background())
-	cursor := cursor.New()
-	cursor.Hide()
+	if !color.Accessible() {
+		cursor.New().Hide()
+	}
 	for name, buildArgs := range buildArgsPerContainer {
 		// create a copy of loop variables to avoid data race.
 		name := name
 		buildArgs := buildArgs
 
 		buildArgs.URI = uri
-		buildArgsList, err := buildArgs.GenerateDockerBuildArgs(dockerengine.New(exec.NewCmd()))
+		if src, ok := d.sourceImages[name]; ok {
+			digest, err := d.copySourceImage(ctx, name, src, buildArgs)
+			if err != nil {
+				return err
+			}
+			digestsMu.Lock()
+			out.ImageDigests[name] = ContainerImageIdentifier{
+				Digest:            digest,
+				CustomTag:         d.image.CustomTag,
+				GitShortCommitTag: d.image.GitShortCommitTag,
+			}
+			digestsMu.Unlock()
+			continue
+		}
+		fingerprint, digest := d.cachedImage(uri, buildArgs)
+		if digest != "" {
+			log.Successf("Reused image %q as its sources are unchanged since it was pushed with digest %s.\n", name, digest)
+			digestsMu.Lock()
+			out.ImageDigests[name] = ContainerImageIdentifier{
+				Digest:            digest,
+				CustomTag:         d.image.CustomTag,
+				GitShortCommitTag: d.image.GitShortCommitTag,
+			}
+			digestsMu.Unlock()
+			continue
+		}
+		buildArgsList, err := buildArgs.GenerateDockerBuildArgs(buildClient)
 		if err != nil {
 			return fmt.Errorf("generate docker build args for %q: %w", name, err)
 		}
 		buf := syncbuffer.New()
-		labeledBuffers = append(labeledBuffers, buf.WithLabel(fmt.Sprintf("Building your container image %q: docker %s", name, strings.Join(buildArgsList, " "))))
+		labeledBuffers = append(labeledBuffers, buf.WithLabel(fmt.Sprintf("Building your container image %q%s: %s %s", name, buildLocation, buildClient.Binary(), strings.Join(buildArgsList, " "))))
 		pr, pw := io.Pipe()
 		g.Go(func() error {
 			defer pw.Close()
-			digest, err := d.repository.BuildAndPush(ctx, buildArgs, pw)
+			digest, err := repo.BuildAndPush(ctx, buildArgs, pw)
 			if err != nil {
 				return fmt.Errorf("build and push the image %q: %w", name, err)
 			}
+			if fingerprint != "" {
+				if err := d.buildCache.SetImage(uri, fingerprint, digest); err != nil {
+					log.Warningf("Unable to record image %q in the build cache: %v\n", name, err)
+				}
+			}
 			digestsMu.Lock()
 			defer digestsMu.Unlock()
 			out.ImageDigests[name] = ContainerImageIdentifier{
@@ -451,7 +807,8 @@ func (d *workloadDeployer) uploadContainerImages(out *UploadArtifactsOutput) err
 		})
 	}
 	opts := []syncbuffer.LabeledTermPrinterOption{syncbuffer.WithPadding(paddingInSpacesForBuildAndPush)}
-	if os.Getenv("CI") != "true" {
+	// In CI and in the accessibility mode, the logs of each build are written once it's done instead of being updated in-place.
+	if os.Getenv("CI") != "true" && !color.Accessible() {
 		opts = append(opts, syncbuffer.WithNumLines(defaultNumLinesForBuildAndPush))
 	}
 	ltp := d.labeledTermPrinter(os.Stderr, labeledBuffers, opts...)
@@ -471,9 +828,154 @@ func (d *workloadDeployer) uploadContainerImages(out *UploadArtifactsOutput) err
 	if err := g.Wait(); err != nil {
 		return err
 	}
+	out.ImageRepositoryURI = uri
+	if err := d.scanImages(out.ImageDigests); err != nil {
+		return err
+	}
+	if err := d.signImages(uri, out.ImageDigests, buildArgsPerContainer); err != nil {
+		return err
+	}
+	return d.uploadSBOMs(uri, out.ImageDigests)
+}
+
+// copySourceImage copies the image of a container built for another environment to the repository in args.URI
+// under the tags of args, and returns its digest. Images in the same repository are reused as is.
+func (d *workloadDeployer) copySourceImage(ctx context.Context, name, src string, args *dockerengine.BuildArguments) (string, error) {
+	srcRepo, digest, ok := strings.Cut(src, "@")
+	if !ok {
+		return "", fmt.Errorf("image %s of container %q built for another environment is not pinned to a digest", src, name)
+	}
+	if srcRepo == args.URI {
+		log.Successf("Reused image %q with digest %s built for another environment in the same region.\n", name, digest)
+		return digest, nil
+	}
+	if err := d.imageCopier.CopyImage(ctx, src, args.URI, args.Tags...); err != nil {
+		return "", fmt.Errorf("copy the image %q built for another environment: %w", name, err)
+	}
+	log.Successf("Copied image %q with digest %s to %s instead of building it again.\n", name, digest, args.URI)
+	return digest, nil
+}
+
+// pinImageLocations resolves the digest of the images of the containers that don't build one, if digests are pinned.
+// Locations that already reference a digest are kept as is.
+func (d *workloadDeployer) pinImageLocations(out *UploadArtifactsOutput) error {
+	if !d.pinDigests {
+		return nil
+	}
+	mft, ok := d.mft.(interface{ ImageLocations() map[string]string })
+	if !ok {
+		return nil
+	}
+	locations := mft.ImageLocations()
+	if len(locations) == 0 {
+		return nil
+	}
+	out.PinnedImages = make(map[string]string, len(locations))
+	for _, container := range sortedKeys(locations) {
+		location := locations[container]
+		if strings.Contains(location, "@") {
+			out.PinnedImages[container] = location
+			continue
+		}
+		digest, err := d.digestResolver.ImageDigest(context.Background(), location)
+		if err != nil {
+			return fmt.Errorf("resolve the digest of the image %q of container %q: %w", location, container, err)
+		}
+		out.PinnedImages[container] = fmt.Sprintf("%s@%s", imageRepository(location), digest)
+		log.Infof("Pinned the image %q of container %q to digest %s.\n", location, container, digest)
+	}
 	return nil
 }
 
+// checkPrebuiltImage returns an error if the existing image to deploy for the main container doesn't exist,
+// or can't run on the platform of the workload.
+func (d *workloadDeployer) checkPrebuiltImage() error {
+	if d.prebuiltImage == "" {
+		return nil
+	}
+	img, err := ecr.ParseImageURI(d.prebuiltImage)
+	if err != nil {
+		return err
+	}
+	registry, err := d.prebuiltImages(img.Region)
+	if err != nil {
+		return err
+	}
+	platforms, err := registry.ImagePlatforms(img)
+	if err != nil {
+		return fmt.Errorf("describe the existing image %s: %w", d.prebuiltImage, err)
+	}
+	var wanted string
+	if mft, ok := d.mft.(interface{ ContainerPlatform() string }); ok {
+		wanted = mft.ContainerPlatform()
+	}
+	if wanted == "" {
+		wanted = fmt.Sprintf("%s/%s", dockerengine.OSLinux, dockerengine.ArchAMD64)
+	}
+	wantedOS, wantedArch, _ := strings.Cut(wanted, "/")
+	wantedArch = normalizedArch(wantedArch)
+	for _, platform := range platforms {
+		if imgOS, imgArch, _ := strings.Cut(platform, "/"); imgOS == wantedOS && normalizedArch(imgArch) == wantedArch {
+			log.Infof("Deploying the existing image %s instead of building one for %q.\n", d.prebuiltImage, d.name)
+			return nil
+		}
+	}
+	return fmt.Errorf("the existing image %s is built for %s, but %q runs on %s/%s",
+		d.prebuiltImage, english.WordSeries(platforms, "and"), d.name, wantedOS, wantedArch)
+}
+
+// normalizedArch returns the name of the architecture in image manifests.
+func normalizedArch(arch string) string {
+	switch strings.ToLower(arch) {
+	case dockerengine.ArchX86:
+		return dockerengine.ArchAMD64
+	case dockerengine.ArchARM:
+		return dockerengine.ArchARM64
+	}
+	return strings.ToLower(arch)
+}
+
+// imageRepository returns the location of the image without its tag.
+func imageRepository(location string) string {
+	if i := strings.LastIndex(location, ":"); i > strings.LastIndex(location, "/") {
+		return location[:i]
+	}
+	return location
+}
+
+// cachedImage returns the digest of an image that was already pushed to the repository from the same sources,
+// after tagging it with the tags of this deployment. It returns an empty digest if the image must be built, along
+// with the fingerprint of its sources to record the image once it's pushed.
+func (d *workloadDeployer) cachedImage(uri string, args *dockerengine.BuildArguments) (fingerprint, digest string) {
+	if d.buildCache == nil {
+		return "", ""
+	}
+	fingerprint, err := buildcache.ImageFingerprint(d.fs, buildcache.ImageInput{
+		Dockerfile: args.Dockerfile,
+		Context:    args.Context,
+		Args:       args.Args,
+		Target:     args.Target,
+		Platform:   args.Platform,
+	})
+	if err != nil {
+		log.Warningf("Unable to look up image %s in the build cache: %v\n", args.Dockerfile, err)
+		return "", ""
+	}
+	digest, ok := d.buildCache.Image(uri, fingerprint)
+	if !ok {
+		return fingerprint, ""
+	}
+	if err := d.tagger.TagImage(d.app.RepositoryName(d.name), digest, args.Tags...); err != nil {
+		var errNotFound *ecr.ErrImageNotFound
+		if !errors.As(err, &errNotFound) {
+			log.Warningf("Unable to reuse image %s, building it again: %v\n", digest, err)
+		}
+		_ = d.buildCache.ForgetImage(uri, fingerprint)
+		return fingerprint, ""
+	}
+	return fingerprint, digest
+}
+
 func buildArgsPerContainer(name, workspacePath string, img ContainerImageIdentifier, unmarshaledManifest interface{}) (map[string]*dockerengine.BuildArguments, error) {
 	type dfArgs interface {
 		BuildArgs(rootDirectory string) (map[string]*manifest.DockerBuildArgs, error)
@@ -487,6 +989,10 @@ func buildArgsPerContainer(name, workspacePath string, img ContainerImageIdentif
 	if err != nil {
 		return nil, fmt.Errorf("check if manifest requires building from local Dockerfile: %w", err)
 	}
+	var imagePlatforms []string
+	if mft, ok := mf.(interface{ ImagePlatforms() []string }); ok {
+		imagePlatforms = mft.ImagePlatforms()
+	}
 	dArgs := make(map[string]*dockerengine.BuildArguments, len(argsPerContainer))
 	for container, buildArgs := range argsPerContainer {
 		tags := []string{imageTagLatest}
@@ -505,13 +1011,25 @@ func buildArgsPerContainer(name, workspacePath string, img ContainerImageIdentif
 			labels[labelForVersion] = version.Version
 		}
 		labels[labelForContainerName] = container
+		if img.GitShortCommitTag != "" {
+			labels[labelForGitCommit] = img.GitShortCommitTag
+		}
+		if len(buildArgs.Args) != 0 {
+			// Only the names of the build arguments are labeled as their values can be sensitive.
+			labels[labelForBuildArgs] = strings.Join(sortedKeys(buildArgs.Args), ",")
+		}
+		platform := mf.ContainerPlatform()
+		if container == name && len(imagePlatforms) != 0 {
+			// The main container is built for every platform in a single manifest list.
+			platform = strings.Join(imagePlatforms, ",")
+		}
 		dArgs[container] = &dockerengine.BuildArguments{
 			Dockerfile: aws.StringValue(buildArgs.Dockerfile),
 			Context:    aws.StringValue(buildArgs.Context),
 			Args:       buildArgs.Args,
 			CacheFrom:  buildArgs.CacheFrom,
 			Target:     aws.StringValue(buildArgs.Target),
-			Platform:   mf.ContainerPlatform(),
+			Platform:   platform,
 			Tags:       tags,
 			Labels:     labels,
 		}
@@ -520,18 +1038,25 @@ func buildArgsPerContainer(name, workspacePath string, img ContainerImageIdentif
 }
 
 func (d *workloadDeployer) uploadArtifactsToS3(out *UploadArtifactsOutput) error {
+	if d.addons == nil && !hasEnvFiles(d.mft) {
+		return nil
+	}
+	d.spinner.Start(fmt.Sprintf(fmtUploadS3ArtifactsStart, color.HighlightUserInput(d.name)))
 	var err error
 	out.EnvFileARNs, err = d.pushEnvFilesToS3Bucket(&pushEnvFilesToS3BucketInput{
 		fs:       d.fs,
 		uploader: d.s3Client,
 	})
 	if err != nil {
+		d.spinner.Stop(log.Serrorf(fmtUploadS3ArtifactsFailed, color.HighlightUserInput(d.name)))
 		return err
 	}
 	out.AddonsURL, err = d.pushAddonsTemplateToS3Bucket()
 	if err != nil {
+		d.spinner.Stop(log.Serrorf(fmtUploadS3ArtifactsFailed, color.HighlightUserInput(d.name)))
 		return err
 	}
+	d.spinner.Stop(log.Ssuccessf(fmtUploadS3ArtifactsComplete, color.HighlightUserInput(d.name)))
 	return nil
 }
 
@@ -540,6 +1065,8 @@ type customResourcesFunc func(fs template.Reader) ([]*customresource.CustomResou
 // UploadArtifactsOutput is the output of UploadArtifacts.
 type UploadArtifactsOutput struct {
 	ImageDigests                   map[string]ContainerImageIdentifier // Container name to image.
+	ImageRepositoryURI             string                              // ECR repository the images in ImageDigests were pushed to.
+	PinnedImages                   map[string]string                   // Container name to the location of its image pinned to a digest.
 	EnvFileARNs                    map[string]string                   // map[container name]envFileARN
 	AddonsURL                      string
 	CustomResourceURLs             map[string]string
@@ -654,6 +1181,42 @@ func envFiles(unmarshaledManifest interface{}) map[string]string {
 	return nil
 }
 
+// hasEnvFiles returns true if any container of the workload reads its environment variables from a file.
+func hasEnvFiles(mft interface{}) bool {
+	for _, path := range envFiles(mft) {
+		if path != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// variablesFromEnvFiles reads the environment variables of each container from its
+// "variables_from_env_file" in the manifest, if any. The keys are container names.
+func (d *workloadDeployer) variablesFromEnvFiles() (map[string]map[string]string, error) {
+	mft, ok := d.mft.(interface{ VariablesEnvFiles() map[string]string })
+	if !ok {
+		return nil, nil
+	}
+	paths := mft.VariablesEnvFiles()
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	vars := make(map[string]map[string]string, len(paths))
+	for container, path := range paths {
+		content, err := afero.ReadFile(d.fs, filepath.Join(d.workspacePath, path))
+		if err != nil {
+			return nil, fmt.Errorf("read env file %s: %w", path, err)
+		}
+		containerVars, err := manifest.UnmarshalEnvFile(content, manifest.NewInterpolator(d.app.Name, d.env.Name))
+		if err != nil {
+			return nil, fmt.Errorf("parse env file %s: %w", path, err)
+		}
+		vars[container] = containerVars
+	}
+	return vars, nil
+}
+
 func (d *workloadDeployer) pushAddonsTemplateToS3Bucket() (string, error) {
 	if d.addons == nil {
 		return "", nil
@@ -683,6 +1246,25 @@ func (d *workloadDeployer) pushAddonsTemplateToS3Bucket() (string, error) {
 	return url, nil
 }
 
+// validateEC2Runtime returns an error if the tasks of the workload run on EC2 instances
+// that the environment doesn't provision or that can't run them.
+func (d *workloadDeployer) validateEC2Runtime(tc manifest.TaskConfig) error {
+	if !tc.OnEC2() {
+		return nil
+	}
+	ec2 := d.envConfig.Compute.EC2
+	if ec2.IsEmpty() {
+		return fmt.Errorf(`cannot deploy %s with "compute: %s" to environment %s without "compute.ec2"`, d.name, manifest.ComputeEC2, d.env.Name)
+	}
+	if tc.IsWindows() != ec2.IsWindows() {
+		return fmt.Errorf(`the platform of %s does not match the "os" of the EC2 instances in environment %s`, d.name, d.env.Name)
+	}
+	if tc.GPU != nil && ec2.AMI == nil && !aws.BoolValue(ec2.GPU) {
+		return fmt.Errorf(`cannot reserve "gpu" for %s since the EC2 instances in environment %s do not run the GPU-optimized AMI`, d.name, d.env.Name)
+	}
+	return nil
+}
+
 func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.RuntimeConfig, error) {
 	endpoint, err := d.endpointGetter.ServiceDiscoveryEndpoint()
 	if err != nil {
@@ -692,11 +1274,25 @@ func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.
 	if err != nil {
 		return nil, fmt.Errorf("get version of environment %q: %w", d.env.Name, err)
 	}
+	envFileVars, err := d.variablesFromEnvFiles()
+	if err != nil {
+		return nil, err
+	}
+	pinnedImages := in.PinnedImages
+	if d.prebuiltImage != "" {
+		pinnedImages = make(map[string]string, len(in.PinnedImages)+1)
+		for container, location := range in.PinnedImages {
+			pinnedImages[container] = location
+		}
+		pinnedImages[d.name] = d.prebuiltImage
+	}
 	if len(in.ImageDigests) == 0 {
 		return &stack.RuntimeConfig{
 			AddonsTemplateURL:        in.AddonsURL,
 			EnvFileARNs:              in.EnvFileARNs,
+			EnvFileVariables:         envFileVars,
 			AdditionalTags:           in.Tags,
+			PinnedImages:             pinnedImages,
 			ServiceDiscoveryEndpoint: endpoint,
 			AccountID:                d.env.AccountID,
 			Region:                   d.env.Region,
@@ -714,6 +1310,9 @@ func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.
 		if container != d.name {
 			imageTag = img.GitShortCommitTag
 		}
+		if d.pinDigests {
+			imageTag = "" // Refer to the image by its digest.
+		}
 		images[container] = stack.ECRImage{
 			RepoURL:           d.resources.RepositoryURLs[d.name],
 			ImageTag:          imageTag,
@@ -725,8 +1324,10 @@ func (d *workloadDeployer) runtimeConfig(in *StackRuntimeConfiguration) (*stack.
 	return &stack.RuntimeConfig{
 		AddonsTemplateURL:        in.AddonsURL,
 		EnvFileARNs:              in.EnvFileARNs,
+		EnvFileVariables:         envFileVars,
 		AdditionalTags:           in.Tags,
 		PushedImages:             images,
+		PinnedImages:             pinnedImages,
 		ServiceDiscoveryEndpoint: endpoint,
 		AccountID:                d.env.AccountID,
 		Region:                   d.env.Region,
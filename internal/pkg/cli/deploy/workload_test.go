@@ -5,6 +5,7 @@ package deploy
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -21,10 +22,13 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	sdkcfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	awsssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/buildcache"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
@@ -54,6 +58,10 @@ type deployMocks struct {
 	mockSNSTopicsLister        *mocks.MocksnsTopicsLister
 	mockServiceDeployer        *mocks.MockserviceDeployer
 	mockServiceForceUpdater    *mocks.MockserviceForceUpdater
+	mockImageUpdater           *mocks.MockserviceImageUpdater
+	mockDeployedTmplGetter     *mocks.MockdeployedTemplateGetter
+	mockRevisionRecorder       *mocks.MockrevisionRecorder
+	mockStackEventsGetter      *mocks.MockstackEventsGetter
 	mockAddons                 *mocks.MockstackBuilder
 	mockUploader               *mocks.Mockuploader
 	mockAppVersionGetter       *mocks.MockversionGetter
@@ -62,6 +70,10 @@ type deployMocks struct {
 	mockValidator              *mocks.MockaliasCertValidator
 	mockLabeledTermPrinter     *mocks.MocklabeledTermPrinter
 	mockdockerEngineRunChecker *mocks.MockdockerEngineRunChecker
+	mockImageScanner           *mocks.MockimageScanner
+	mockImageSigner            *mocks.MockimageSigner
+	mockSBOMGenerator          *mocks.MocksbomGenerator
+	mockProvenanceRecorder     *mocks.MockprovenanceRecorder
 }
 
 type mockTemplateFS struct {
@@ -118,6 +130,10 @@ type mockWorkloadMft struct {
 	dockerBuildArgs map[string]*manifest.DockerBuildArgs
 	workloadName    string
 	customEnvFiles  map[string]string
+	imagePlatforms  []string
+	imageSigning    manifest.ImageSigning
+	imageScan       manifest.ImageScan
+	imageSBOM       manifest.ImageSBOM
 }
 
 func (m *mockWorkloadMft) EnvFiles() map[string]string {
@@ -137,6 +153,22 @@ func (m *mockWorkloadMft) ContainerPlatform() string {
 	return "mockContainerPlatform"
 }
 
+func (m *mockWorkloadMft) ImagePlatforms() []string {
+	return m.imagePlatforms
+}
+
+func (m *mockWorkloadMft) ImageSigning() manifest.ImageSigning {
+	return m.imageSigning
+}
+
+func (m *mockWorkloadMft) ImageScan() manifest.ImageScan {
+	return m.imageScan
+}
+
+func (m *mockWorkloadMft) ImageSBOM() manifest.ImageSBOM {
+	return m.imageSBOM
+}
+
 // stubCloudFormationStack implements the cloudformation.StackConfiguration interface.
 type stubCloudFormationStack struct{}
 
@@ -195,9 +227,15 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 		inMockUserTag     string
 		inMockGitTag      string
 		inDockerBuildArgs map[string]*manifest.DockerBuildArgs
+		inBuilder         string
+		inImagePlatforms  []string
+		inImageSigning    manifest.ImageSigning
+		inImageScan       manifest.ImageScan
+		inImageSBOM       manifest.ImageSBOM
 
 		mock                func(t *testing.T, m *deployMocks)
 		mockServiceDeployer func(deployer *workloadDeployer) artifactsUploader
+		mockRemoteBuilder   func(m *deployMocks) (repositoryService, error)
 		customResourcesFunc customResourcesFunc
 
 		wantAddonsURL     string
@@ -219,6 +257,90 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("check if docker engine is running: some error"),
 		},
+		"error if fail to set up the remote builder": {
+			inMockUserTag: "v1.0",
+			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"mockWkld": {
+					Dockerfile: aws.String("mockDockerfile"),
+					Context:    aws.String("mockContext"),
+				},
+			},
+			mock: func(t *testing.T, m *deployMocks) {},
+			mockRemoteBuilder: func(m *deployMocks) (repositoryService, error) {
+				return nil, errors.New("some error")
+			},
+			wantErr: errors.New("some error"),
+		},
+		"build and push image remotely without a docker engine": {
+			inMockUserTag: "v1.0",
+			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"mockWkld": {
+					Dockerfile: aws.String("mockDockerfile"),
+					Context:    aws.String("mockContext"),
+				},
+			},
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockRepositoryService.EXPECT().Login().Return(mockURI, nil)
+				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+					URI:        mockURI,
+					Dockerfile: "mockDockerfile",
+					Context:    "mockContext",
+					Platform:   "mockContainerPlatform",
+					Tags:       []string{"latest", "v1.0"},
+					Labels: map[string]string{
+						"com.aws.copilot.image.builder":        "copilot-cli",
+						"com.aws.copilot.image.container.name": "mockWkld",
+					},
+				}, gomock.Any()).Return("mockDigest", nil)
+				m.mockLabeledTermPrinter.EXPECT().IsDone().Return(true).AnyTimes()
+				m.mockLabeledTermPrinter.EXPECT().Print().AnyTimes()
+				m.mockAddons = nil
+			},
+			mockRemoteBuilder: func(m *deployMocks) (repositoryService, error) {
+				return m.mockRepositoryService, nil
+			},
+			wantImages: map[string]ContainerImageIdentifier{
+				mockName: {
+					Digest:    "mockDigest",
+					CustomTag: "v1.0",
+				},
+			},
+		},
+		"build and push a multi-platform image of the main container": {
+			inMockUserTag: "v1.0",
+			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"mockWkld": {
+					Dockerfile: aws.String("mockDockerfile"),
+					Context:    aws.String("mockContext"),
+				},
+			},
+			inBuilder:        "buildx",
+			inImagePlatforms: []string{"linux/amd64", "linux/arm64"},
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockdockerEngineRunChecker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.mockRepositoryService.EXPECT().Login().Return(mockURI, nil)
+				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+					URI:        mockURI,
+					Dockerfile: "mockDockerfile",
+					Context:    "mockContext",
+					Platform:   "linux/amd64,linux/arm64",
+					Tags:       []string{"latest", "v1.0"},
+					Labels: map[string]string{
+						"com.aws.copilot.image.builder":        "copilot-cli",
+						"com.aws.copilot.image.container.name": "mockWkld",
+					},
+				}, gomock.Any()).Return("mockDigest", nil)
+				m.mockLabeledTermPrinter.EXPECT().IsDone().Return(true).AnyTimes()
+				m.mockLabeledTermPrinter.EXPECT().Print().AnyTimes()
+				m.mockAddons = nil
+			},
+			wantImages: map[string]ContainerImageIdentifier{
+				mockName: {
+					Digest:    "mockDigest",
+					CustomTag: "v1.0",
+				},
+			},
+		},
 		"error if failed to build and push image": {
 			inMockUserTag: "v1.0",
 			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
@@ -267,6 +389,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 					Labels: map[string]string{
 						"com.aws.copilot.image.builder":        "copilot-cli",
 						"com.aws.copilot.image.container.name": "mockWkld",
+						"com.aws.copilot.image.git.commit":     "gitTag",
 					},
 				}, gomock.Any()).Return("mockDigest", nil)
 				m.mockLabeledTermPrinter.EXPECT().IsDone().Return(true).AnyTimes()
@@ -301,6 +424,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 					Labels: map[string]string{
 						"com.aws.copilot.image.builder":        "copilot-cli",
 						"com.aws.copilot.image.container.name": "mockWkld",
+						"com.aws.copilot.image.git.commit":     "gitTag",
 					},
 				}, gomock.Any()).Return("mockDigest", nil)
 				m.mockLabeledTermPrinter.EXPECT().IsDone().Return(true).AnyTimes()
@@ -338,6 +462,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 					Labels: map[string]string{
 						"com.aws.copilot.image.builder":        "copilot-cli",
 						"com.aws.copilot.image.container.name": "nginx",
+						"com.aws.copilot.image.git.commit":     "gitTag",
 					},
 				}, gomock.Any()).Return("sidecarMockDigest1", nil)
 				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
@@ -349,6 +474,7 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 					Labels: map[string]string{
 						"com.aws.copilot.image.builder":        "copilot-cli",
 						"com.aws.copilot.image.container.name": "logging",
+						"com.aws.copilot.image.git.commit":     "gitTag",
 					},
 				}, gomock.Any()).Return("sidecarMockDigest2", nil)
 				m.mockLabeledTermPrinter.EXPECT().IsDone().Return(true).AnyTimes()
@@ -366,6 +492,178 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				},
 			},
 		},
+		"sign the pushed image and record its provenance": {
+			inMockGitTag: "gitTag",
+			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"mockWkld": {
+					Dockerfile: aws.String("mockDockerfile"),
+					Context:    aws.String("mockContext"),
+					Args: map[string]string{
+						"TOKEN":   "secret",
+						"VERSION": "1.0",
+					},
+				},
+			},
+			inImageSigning: manifest.ImageSigning{
+				KMSKey: aws.String("alias/signing"),
+			},
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockdockerEngineRunChecker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.mockRepositoryService.EXPECT().Login().Return(mockURI, nil)
+				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+					URI:        mockURI,
+					Dockerfile: "mockDockerfile",
+					Context:    "mockContext",
+					Args: map[string]string{
+						"TOKEN":   "secret",
+						"VERSION": "1.0",
+					},
+					Platform: "mockContainerPlatform",
+					Tags:     []string{"latest", "gitTag"},
+					Labels: map[string]string{
+						"com.aws.copilot.image.builder":        "copilot-cli",
+						"com.aws.copilot.image.container.name": "mockWkld",
+						"com.aws.copilot.image.git.commit":     "gitTag",
+						"com.aws.copilot.image.build.args":     "TOKEN,VERSION",
+					},
+				}, gomock.Any()).Return("mockDigest", nil)
+				m.mockLabeledTermPrinter.EXPECT().IsDone().Return(true).AnyTimes()
+				m.mockLabeledTermPrinter.EXPECT().Print().AnyTimes()
+				m.mockImageSigner.EXPECT().Sign("mockRepoURI@mockDigest", "alias/signing").Return(nil)
+				m.mockProvenanceRecorder.EXPECT().PutSecret(awsssm.PutSecretInput{
+					Name:      "/copilot/press/test/provenance/mockWkld/mockWkld",
+					Value:     `{"image":"mockRepoURI","digest":"mockDigest","gitCommit":"gitTag","buildArgs":{"TOKEN":"secret","VERSION":"1.0"},"builder":"copilot-cli","signature":{"kmsKey":"alias/signing"}}`,
+					Overwrite: true,
+					Tags: map[string]string{
+						"copilot-application": "press",
+						"copilot-environment": "test",
+						"copilot-service":     "mockWkld",
+					},
+				}).Return(nil, nil)
+				m.mockAddons = nil
+			},
+			wantImages: map[string]ContainerImageIdentifier{
+				mockName: {
+					Digest:            "mockDigest",
+					GitShortCommitTag: "gitTag",
+				},
+			},
+		},
+		"error if the pushed image can't be signed": {
+			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"mockWkld": {
+					Dockerfile: aws.String("mockDockerfile"),
+					Context:    aws.String("mockContext"),
+				},
+			},
+			inImageSigning: manifest.ImageSigning{
+				Keyless: aws.Bool(true),
+			},
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockdockerEngineRunChecker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.mockRepositoryService.EXPECT().Login().Return(mockURI, nil)
+				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), gomock.Any(), gomock.Any()).Return("mockDigest", nil)
+				m.mockLabeledTermPrinter.EXPECT().IsDone().Return(true).AnyTimes()
+				m.mockLabeledTermPrinter.EXPECT().Print().AnyTimes()
+				m.mockImageSigner.EXPECT().Sign("mockRepoURI@mockDigest", "").Return(mockError)
+			},
+			wantErr: errors.New(`sign the image "mockWkld": some error`),
+		},
+		"error if the pushed image has vulnerabilities at or above the threshold": {
+			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"mockWkld": {
+					Dockerfile: aws.String("mockDockerfile"),
+					Context:    aws.String("mockContext"),
+				},
+			},
+			inImageScan: manifest.ImageScan{
+				BlockOn: aws.String("high"),
+			},
+			inImageSigning: manifest.ImageSigning{
+				Keyless: aws.Bool(true),
+			},
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockdockerEngineRunChecker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.mockRepositoryService.EXPECT().Login().Return(mockURI, nil)
+				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), gomock.Any(), gomock.Any()).Return("mockDigest", nil)
+				m.mockLabeledTermPrinter.EXPECT().IsDone().Return(true).AnyTimes()
+				m.mockLabeledTermPrinter.EXPECT().Print().AnyTimes()
+				m.mockSpinner.EXPECT().Start(gomock.Any())
+				m.mockImageScanner.EXPECT().ImageScanFindings(gomock.Any(), "press/mockWkld", "mockDigest").Return([]ecr.ScanFinding{
+					{Name: "CVE-2023-0002", Severity: "MEDIUM"},
+					{Name: "CVE-2023-0001", Severity: "CRITICAL"},
+				}, nil)
+				m.mockSpinner.EXPECT().Stop(gomock.Any())
+			},
+			wantErr: errors.New(`found vulnerabilities of severity high or higher in the image of "mockWkld"`),
+		},
+		"deploy the pushed image if its vulnerabilities are below the threshold": {
+			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"mockWkld": {
+					Dockerfile: aws.String("mockDockerfile"),
+					Context:    aws.String("mockContext"),
+				},
+			},
+			inImageScan: manifest.ImageScan{
+				BlockOn: aws.String("critical"),
+			},
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockdockerEngineRunChecker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.mockRepositoryService.EXPECT().Login().Return(mockURI, nil)
+				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), gomock.Any(), gomock.Any()).Return("mockDigest", nil)
+				m.mockLabeledTermPrinter.EXPECT().IsDone().Return(true).AnyTimes()
+				m.mockLabeledTermPrinter.EXPECT().Print().AnyTimes()
+				m.mockSpinner.EXPECT().Start(gomock.Any())
+				m.mockImageScanner.EXPECT().ImageScanFindings(gomock.Any(), "press/mockWkld", "mockDigest").Return([]ecr.ScanFinding{
+					{Name: "CVE-2023-0002", Severity: "HIGH"},
+				}, nil)
+				m.mockSpinner.EXPECT().Stop(gomock.Any())
+				m.mockAddons = nil
+			},
+			wantImages: map[string]ContainerImageIdentifier{
+				mockName: {
+					Digest: "mockDigest",
+				},
+			},
+		},
+		"generate and upload the sbom of the pushed image": {
+			inDockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+				"mockWkld": {
+					Dockerfile: aws.String("mockDockerfile"),
+					Context:    aws.String("mockContext"),
+				},
+			},
+			inImageSBOM: manifest.ImageSBOM{
+				Format: aws.String("cyclonedx"),
+			},
+			mock: func(t *testing.T, m *deployMocks) {
+				m.mockdockerEngineRunChecker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.mockRepositoryService.EXPECT().Login().Return(mockURI, nil)
+				m.mockRepositoryService.EXPECT().BuildAndPush(gomock.Any(), gomock.Any(), gomock.Any()).Return("mockDigest", nil)
+				m.mockLabeledTermPrinter.EXPECT().IsDone().Return(true).AnyTimes()
+				m.mockLabeledTermPrinter.EXPECT().Print().AnyTimes()
+				m.mockSpinner.EXPECT().Start(gomock.Any())
+				m.mockSBOMGenerator.EXPECT().Generate("mockRepoURI@mockDigest", "cyclonedx", gomock.Any()).
+					DoAndReturn(func(_, _ string, w io.Writer) error {
+						_, err := w.Write([]byte(`{"bomFormat":"CycloneDX"}`))
+						return err
+					})
+				m.mockUploader.EXPECT().Upload(mockS3Bucket, "manual/sbom/press-test-mockWkld/mockWkld.json", gomock.Any()).
+					DoAndReturn(func(_, _ string, data io.Reader) (string, error) {
+						content, err := io.ReadAll(data)
+						require.NoError(t, err)
+						require.Equal(t, `{"bomFormat":"CycloneDX"}`, string(content))
+						return "", nil
+					})
+				m.mockSpinner.EXPECT().Stop(gomock.Any())
+				m.mockAddons = nil
+			},
+			wantImages: map[string]ContainerImageIdentifier{
+				mockName: {
+					Digest: "mockDigest",
+				},
+			},
+		},
 		"should retrieve Load Balanced Web Service custom resource URLs": {
 			mock: func(t *testing.T, m *deployMocks) {
 				// Ignore addon uploads.
@@ -636,8 +934,16 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 				mockFileSystem:             afero.NewMemMapFs(),
 				mockLabeledTermPrinter:     mocks.NewMocklabeledTermPrinter(ctrl),
 				mockdockerEngineRunChecker: mocks.NewMockdockerEngineRunChecker(ctrl),
+				mockImageScanner:           mocks.NewMockimageScanner(ctrl),
+				mockImageSigner:            mocks.NewMockimageSigner(ctrl),
+				mockSBOMGenerator:          mocks.NewMocksbomGenerator(ctrl),
+				mockProvenanceRecorder:     mocks.NewMockprovenanceRecorder(ctrl),
+				mockSpinner:                mocks.NewMockspinner(ctrl),
 			}
 			tc.mock(t, m)
+			// Uploads to S3 report their progress in every case that reaches them.
+			m.mockSpinner.EXPECT().Start(gomock.Any()).AnyTimes()
+			m.mockSpinner.EXPECT().Stop(gomock.Any()).AnyTimes()
 
 			crFn := tc.customResourcesFunc
 			if crFn == nil {
@@ -660,16 +966,26 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 					GitShortCommitTag: tc.inMockGitTag,
 				},
 				workspacePath: mockWorkspacePath,
+				builder:       tc.inBuilder,
 				mft: &mockWorkloadMft{
 					workloadName:    mockName,
 					fileName:        tc.inEnvFile,
 					customEnvFiles:  tc.customEnvFiles,
 					dockerBuildArgs: tc.inDockerBuildArgs,
+					imagePlatforms:  tc.inImagePlatforms,
+					imageSigning:    tc.inImageSigning,
+					imageScan:       tc.inImageScan,
+					imageSBOM:       tc.inImageSBOM,
 				},
 				fs:              m.mockFileSystem,
 				s3Client:        m.mockUploader,
 				docker:          m.mockdockerEngineRunChecker,
 				repository:      m.mockRepositoryService,
+				scanner:         m.mockImageScanner,
+				signer:          m.mockImageSigner,
+				sbomGenerator:   m.mockSBOMGenerator,
+				spinner:         m.mockSpinner,
+				provenance:      m.mockProvenanceRecorder,
 				templateFS:      fakeTemplateFS(),
 				overrider:       new(override.Noop),
 				customResources: crFn,
@@ -680,6 +996,11 @@ func TestWorkloadDeployer_UploadArtifacts(t *testing.T) {
 			if m.mockAddons != nil {
 				wkldDeployer.addons = m.mockAddons
 			}
+			if tc.mockRemoteBuilder != nil {
+				wkldDeployer.remoteBuilder = func() (repositoryService, error) {
+					return tc.mockRemoteBuilder(m)
+				}
+			}
 			var deployer artifactsUploader
 			deployer = &lbWebSvcDeployer{
 				svcDeployer: &svcDeployer{
@@ -740,7 +1061,12 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 		inEnvironment     *config.Environment
 		inForceDeploy     bool
 		inDisableRollback bool
+		inDeployedBy      string
+		inFreezeOverride  string
 		inRedirectToHTTPS *bool
+		inHTTPVersion     *string
+		inClientAuth      *string
+		inAuth            manifest.HTTPAuth
 
 		// Cached variables.
 		inEnvironmentConfig func() *manifest.Environment
@@ -784,6 +1110,24 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 			},
 			wantErr: fmt.Errorf(`validate ALB runtime configuration for "http": cannot deploy service mockWkld without "alias" to environment mockEnv with certificate imported`),
 		},
+		"fail if grpc configured without custom domain": {
+			inHTTPVersion: aws.String("gRPC"),
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inEnvironmentConfig: func() *manifest.Environment {
+				return &manifest.Environment{}
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+			wantErr: fmt.Errorf(`validate ALB runtime configuration for "http": cannot configure gRPC without having a domain associated with the app "mockApp" or importing any certificates in env "mockEnv"`),
+		},
 		"fail if http redirect to https configured without custom domain": {
 			inRedirectToHTTPS: aws.Bool(true),
 			inEnvironment: &config.Environment{
@@ -802,6 +1146,67 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 			},
 			wantErr: fmt.Errorf(`validate ALB runtime configuration for "http": cannot configure http to https redirect without having a domain associated with the app "mockApp" or importing any certificates in env "mockEnv"`),
 		},
+		"fail if authentication configured without custom domain": {
+			inAuth: manifest.HTTPAuth{
+				Cognito: manifest.CognitoAuth{
+					UserPool: aws.String("arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_abc"),
+					ClientID: aws.String("client"),
+					Domain:   aws.String("login"),
+				},
+			},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inEnvironmentConfig: func() *manifest.Environment {
+				return &manifest.Environment{}
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+			wantErr: fmt.Errorf(`validate ALB runtime configuration for "http": cannot configure authentication without having a domain associated with the app "mockApp" or importing any certificates in env "mockEnv"`),
+		},
+		"fail if mutual tls configured without custom domain": {
+			inClientAuth: aws.String(manifest.ClientAuthPassthrough),
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inEnvironmentConfig: func() *manifest.Environment {
+				return &manifest.Environment{}
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+			wantErr: fmt.Errorf(`validate ALB runtime configuration for "http.tls": cannot configure mutual TLS without having a domain associated with the app "mockApp" or importing any certificates in env "mockEnv"`),
+		},
+		"fail if client certificates are verified without a trust store in the env": {
+			inClientAuth: aws.String(manifest.ClientAuthVerify),
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inEnvironmentConfig: func() *manifest.Environment {
+				return &manifest.Environment{}
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "example.com",
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+			},
+			wantErr: fmt.Errorf(`validate ALB runtime configuration for "http.tls": cannot verify client certificates without a "http.public.trust_store" in env "mockEnv"`),
+		},
 		"cannot specify alias hosted zone when no certificates are imported in the env": {
 			inEnvironment: &config.Environment{
 				Name:   mockEnvName,
@@ -887,15 +1292,16 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("validate ALB runtime configuration for \"http\": validate aliases against the imported public ALB certificate for env mockEnv: some error"),
 		},
-		"fail to validate cdn certificate aliases": {
+		"fail if an alias is not covered by the managed certificates": {
 			inEnvironment: &config.Environment{
 				Name:   mockEnvName,
-				Region: "us-east-1",
+				Region: "us-west-2",
 			},
 			inEnvironmentConfig: func() *manifest.Environment {
 				envConfig := &manifest.Environment{}
-				envConfig.HTTPConfig.Public.Certificates = mockCertARNs
-				envConfig.CDNConfig.Config.Certificate = aws.String(mockCDNCertARN)
+				envConfig.HTTPConfig.Public.ManagedCertificates = []manifest.ManagedCertificate{
+					{Domain: aws.String("example.com"), HostedZone: aws.String("Z0123")},
+				}
 				return envConfig
 			},
 			inAliases: manifest.Alias{
@@ -907,17 +1313,66 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 			mock: func(m *deployMocks) {
 				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
-				m.mockValidator.EXPECT().ValidateCertAliases([]string{"example.com", "foobar.com"}, mockCertARNs).Return(nil)
-				m.mockValidator.EXPECT().ValidateCertAliases([]string{"example.com", "foobar.com"}, []string{mockCDNCertARN}).Return(mockError)
 			},
-			wantErr: fmt.Errorf("validate ALB runtime configuration for \"http\": validate aliases against the imported CDN certificate for env mockEnv: some error"),
+			wantErr: fmt.Errorf("validate ALB runtime configuration for \"http\": alias \"foobar.com\" is not covered by the managed certificates of env mockEnv"),
 		},
-		"fail to get public CIDR blocks": {
-			inNLB: manifest.NetworkLoadBalancerConfiguration{
-				Listener: manifest.NetworkLoadBalancerListener{
-					Port: aws.String("443/tcp"),
-				},
-			},
+		"validate only the aliases that are not covered by the managed certificates against the imported certificates": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inEnvironmentConfig: func() *manifest.Environment {
+				envConfig := &manifest.Environment{}
+				envConfig.HTTPConfig.Public.Certificates = mockCertARNs
+				envConfig.HTTPConfig.Public.ManagedCertificates = []manifest.ManagedCertificate{
+					{Domain: aws.String("*.com"), HostedZone: aws.String("Z0123")},
+				}
+				return envConfig
+			},
+			inAliases: manifest.Alias{
+				AdvancedAliases: append(mockMultiAliases, manifest.AdvancedAlias{Alias: aws.String("api.example.org")}),
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+				m.mockValidator.EXPECT().ValidateCertAliases([]string{"api.example.org"}, mockCertARNs).Return(mockError)
+			},
+			wantErr: fmt.Errorf("validate ALB runtime configuration for \"http\": validate aliases against the imported public ALB certificate for env mockEnv: some error"),
+		},
+		"fail to validate cdn certificate aliases": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-east-1",
+			},
+			inEnvironmentConfig: func() *manifest.Environment {
+				envConfig := &manifest.Environment{}
+				envConfig.HTTPConfig.Public.Certificates = mockCertARNs
+				envConfig.CDNConfig.Config.Certificate = aws.String(mockCDNCertARN)
+				return envConfig
+			},
+			inAliases: manifest.Alias{
+				AdvancedAliases: mockMultiAliases,
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+				m.mockValidator.EXPECT().ValidateCertAliases([]string{"example.com", "foobar.com"}, mockCertARNs).Return(nil)
+				m.mockValidator.EXPECT().ValidateCertAliases([]string{"example.com", "foobar.com"}, []string{mockCDNCertARN}).Return(mockError)
+			},
+			wantErr: fmt.Errorf("validate ALB runtime configuration for \"http\": validate aliases against the imported CDN certificate for env mockEnv: some error"),
+		},
+		"fail to get public CIDR blocks": {
+			inNLB: manifest.NetworkLoadBalancerConfiguration{
+				Listener: manifest.NetworkLoadBalancerListener{
+					Port: aws.String("443/tcp"),
+				},
+			},
 			inEnvironment: &config.Environment{
 				Name:   mockEnvName,
 				Region: "us-west-2",
@@ -1123,6 +1578,27 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("deploy service: change set with name mockChangeSet for stack mockStack has no changes"),
 		},
+		"record who deployed the service in its deployment history": {
+			inDeployedBy:     "arn:aws:iam::1234:user/alice",
+			inFreezeOverride: "hotfix for incident 42",
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).DoAndReturn(func(rev *stack.Revision) error {
+					require.Equal(t, "arn:aws:iam::1234:user/alice", rev.DeployedBy)
+					require.Equal(t, "hotfix for incident 42", rev.FreezeOverride)
+					return nil
+				})
+			},
+		},
 		"error if fail to get last update time when force an update": {
 			inForceDeploy: true,
 			inEnvironment: &config.Environment{
@@ -1137,6 +1613,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).
 					Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Return(nil)
 				m.mockServiceForceUpdater.EXPECT().LastUpdatedAt(mockAppName, mockEnvName, mockName).
 					Return(time.Time{}, mockError)
 			},
@@ -1156,6 +1633,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).
 					Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Return(nil)
 				m.mockServiceForceUpdater.EXPECT().LastUpdatedAt(mockAppName, mockEnvName, mockName).
 					Return(mockAfterTime, nil)
 			},
@@ -1221,6 +1699,41 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Return(nil)
+			},
+		},
+		"success even if the deployment cannot be recorded": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Return(errors.New("some error"))
+			},
+		},
+		"do not record the deployment if the change set is empty": {
+			inForceDeploy: true,
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deployMocks) {
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).
+					Return(cloudformation.NewMockErrChangeSetEmpty())
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Times(0)
+				m.mockServiceForceUpdater.EXPECT().LastUpdatedAt(mockAppName, mockEnvName, mockName).
+					Return(mockAfterTime, nil)
 			},
 		},
 		"success": {
@@ -1245,6 +1758,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 				m.mockValidator.EXPECT().ValidateCertAliases([]string{"example.com", "foobar.com"}, mockCertARNs).Return(nil).Times(2)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Return(nil)
 			},
 		},
 		"success with http redirect disabled and alb certs imported": {
@@ -1269,6 +1783,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 				m.mockValidator.EXPECT().ValidateCertAliases([]string{"example.com", "foobar.com"}, mockCertARNs).Return(nil).Times(2)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Return(nil)
 			},
 		},
 		"success with only cdn certs imported": {
@@ -1292,6 +1807,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 				m.mockValidator.EXPECT().ValidateCertAliases([]string{"example.com", "foobar.com"}, []string{mockCDNCertARN}).Return(nil).Times(2)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Return(nil)
 			},
 		},
 		"success with http redirect disabled and domain imported": {
@@ -1320,6 +1836,7 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				m.mockEnvVersionGetter.EXPECT().Version().Return("v1.42.0", nil)
 				m.mockAppVersionGetter.EXPECT().Version().Return("v1.0.0", nil).Times(2)
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), "mockBucket", gomock.Any()).Return(nil)
+				m.mockRevisionRecorder.EXPECT().Record(gomock.Any()).Return(nil)
 			},
 		},
 		"success with force update": {
@@ -1356,11 +1873,15 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				mockEndpointGetter:         mocks.NewMockendpointGetter(ctrl),
 				mockServiceDeployer:        mocks.NewMockserviceDeployer(ctrl),
 				mockServiceForceUpdater:    mocks.NewMockserviceForceUpdater(ctrl),
+				mockRevisionRecorder:       mocks.NewMockrevisionRecorder(ctrl),
+				mockStackEventsGetter:      mocks.NewMockstackEventsGetter(ctrl),
 				mockSpinner:                mocks.NewMockspinner(ctrl),
 				mockPublicCIDRBlocksGetter: mocks.NewMockpublicCIDRBlocksGetter(ctrl),
 				mockValidator:              mocks.NewMockaliasCertValidator(ctrl),
 			}
 			tc.mock(m)
+			m.mockStackEventsGetter.EXPECT().StackEvents(gomock.Any(), gomock.Any()).AnyTimes()
+			m.mockRevisionRecorder.EXPECT().RecordLog(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 
 			if tc.inEnvironmentConfig == nil {
 				tc.inEnvironmentConfig = func() *manifest.Environment {
@@ -1384,6 +1905,8 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 					newSvcUpdater: func(f func(*session.Session) serviceForceUpdater) serviceForceUpdater {
 						return m.mockServiceForceUpdater
 					},
+					revisions:   m.mockRevisionRecorder,
+					stackEvents: m.mockStackEventsGetter,
 					now: func() time.Time {
 						return mockNowTime
 					},
@@ -1412,8 +1935,10 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 							HTTP: manifest.HTTP{
 								Main: manifest.RoutingRule{
 									Path:            aws.String("/"),
+									ProtocolVersion: tc.inHTTPVersion,
 									Alias:           tc.inAliases,
 									RedirectToHTTPS: tc.inRedirectToHTTPS,
+									Auth:            tc.inAuth,
 								},
 								AdditionalRoutingRules: []manifest.RoutingRule{
 									{
@@ -1422,6 +1947,9 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 										RedirectToHTTPS: tc.inRedirectToHTTPS,
 									},
 								},
+								TLS: manifest.HTTPTLSConfig{
+									ClientAuth: tc.inClientAuth,
+								},
 							},
 						},
 						NLBConfig: tc.inNLB,
@@ -1436,6 +1964,8 @@ func TestWorkloadDeployer_DeployWorkload(t *testing.T) {
 				Options: Options{
 					ForceNewUpdate:  tc.inForceDeploy,
 					DisableRollback: tc.inDisableRollback,
+					DeployedBy:      tc.inDeployedBy,
+					FreezeOverride:  tc.inFreezeOverride,
 				},
 			})
 
@@ -1472,16 +2002,121 @@ func TestUploadArtifacts(t *testing.T) {
 
 }
 
+func TestWorkloadDeployer_variablesFromEnvFiles(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest interface{}
+		inContent  string
+
+		wanted      map[string]map[string]string
+		wantedError error
+	}{
+		"no variables if the manifest does not support env files": {
+			inManifest: &manifest.RequestDrivenWebService{},
+		},
+		"no variables if the env file is not specified": {
+			inManifest: &manifest.BackendService{},
+		},
+		"error if the env file does not exist": {
+			inManifest: &manifest.BackendService{
+				BackendServiceConfig: manifest.BackendServiceConfig{
+					TaskConfig: manifest.TaskConfig{
+						VariablesFromEnvFile: aws.String("config/missing.env"),
+					},
+				},
+			},
+			wantedError: errors.New("read env file config/missing.env: open /ws/config/missing.env: file does not exist"),
+		},
+		"error if the env file is malformed": {
+			inManifest: &manifest.BackendService{
+				BackendServiceConfig: manifest.BackendServiceConfig{
+					TaskConfig: manifest.TaskConfig{
+						VariablesFromEnvFile: aws.String("config/prod.env"),
+					},
+				},
+			},
+			inContent:   "LOG_LEVEL",
+			wantedError: errors.New("parse env file config/prod.env: line 1: expected the format <key>=<value>"),
+		},
+		"interpolate the variables of the env file": {
+			inManifest: &manifest.BackendService{
+				Workload: manifest.Workload{
+					Name: aws.String("api"),
+				},
+				BackendServiceConfig: manifest.BackendServiceConfig{
+					TaskConfig: manifest.TaskConfig{
+						VariablesFromEnvFile: aws.String("config/prod.env"),
+					},
+				},
+			},
+			inContent: "LOG_LEVEL=info\nTOPIC=${COPILOT_APPLICATION_NAME}-${COPILOT_ENVIRONMENT_NAME}-events\n",
+			wanted: map[string]map[string]string{
+				"api": {
+					"LOG_LEVEL": "info",
+					"TOPIC":     "phonetool-prod-events",
+				},
+			},
+		},
+		"read the env files of the sidecars": {
+			inManifest: &manifest.BackendService{
+				Workload: manifest.Workload{
+					Name: aws.String("api"),
+				},
+				BackendServiceConfig: manifest.BackendServiceConfig{
+					Sidecars: map[string]*manifest.SidecarConfig{
+						"nginx": {
+							VariablesFromEnvFile: aws.String("config/prod.env"),
+						},
+						"xray": {},
+					},
+				},
+			},
+			inContent: "LOG_LEVEL=info\n",
+			wanted: map[string]map[string]string{
+				"nginx": {
+					"LOG_LEVEL": "info",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "/ws/config/prod.env", []byte(tc.inContent), 0644))
+			d := &workloadDeployer{
+				app:           &config.Application{Name: "phonetool"},
+				env:           &config.Environment{Name: "prod"},
+				mft:           tc.inManifest,
+				fs:            fs,
+				workspacePath: "/ws",
+			}
+
+			got, err := d.variablesFromEnvFiles()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
 type deployDiffMocks struct {
 	mockDeployedTmplGetter *mocks.MockdeployedTemplateGetter
+	mockAddons             *mocks.MockstackBuilder
+	mockRevisions          *mocks.MockrevisionRecorder
+	mockAppCFN             *mocks.MockappResourcesGetter
 }
 
 func TestWorkloadDeployer_DeployDiff(t *testing.T) {
 	testCases := map[string]struct {
-		inTemplate string
-		setUpMocks func(m *deployDiffMocks)
-		wanted     string
-		checkErr   func(t *testing.T, gotErr error)
+		inTemplate       string
+		hasAddons        bool
+		recordsRevisions bool
+		setUpMocks       func(m *deployDiffMocks)
+		wanted           string
+		checkErr         func(t *testing.T, gotErr error)
 	}{
 		"error getting the deployed template": {
 			setUpMocks: func(m *deployDiffMocks) {
@@ -1522,6 +2157,138 @@ func TestWorkloadDeployer_DeployDiff(t *testing.T) {
 					Return("", &cloudformation.ErrStackNotFound{})
 			},
 			wanted: `+ peace: and love
+`,
+		},
+		"write a summary of the changed resources before the diff": {
+			inTemplate: `
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+  Queue:
+    Type: AWS::SQS::Queue`,
+			setUpMocks: func(m *deployDiffMocks) {
+				m.mockDeployedTmplGetter.EXPECT().
+					Template(gomock.Eq(stack.NameForWorkload("mockApp", "mockEnv", "mockSvc"))).
+					Return(`
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket`, nil)
+			},
+			wanted: `1 resource added, 0 modified, 0 removed
+~ Resources:
+    + Queue:
+    +     Type: AWS::SQS::Queue
+`,
+		},
+		"error getting the deployed addons template": {
+			inTemplate: `peace: and love`,
+			hasAddons:  true,
+			setUpMocks: func(m *deployDiffMocks) {
+				m.mockDeployedTmplGetter.EXPECT().
+					Template(stack.NameForWorkload("mockApp", "mockEnv", "mockSvc")).
+					Return("peace: and love", nil)
+				m.mockAddons.EXPECT().Template().Return("Resources: {}", nil)
+				m.mockDeployedTmplGetter.EXPECT().
+					NestedStackTemplate(stack.NameForWorkload("mockApp", "mockEnv", "mockSvc"), "AddonsStack").
+					Return("", errors.New("some error"))
+			},
+			checkErr: func(t *testing.T, gotErr error) {
+				require.EqualError(t, gotErr, `retrieve the deployed addons template for "mockSvc": some error`)
+			},
+		},
+		"write a section for the diff of the addons stack": {
+			inTemplate: `peace: and love`,
+			hasAddons:  true,
+			setUpMocks: func(m *deployDiffMocks) {
+				m.mockDeployedTmplGetter.EXPECT().
+					Template(stack.NameForWorkload("mockApp", "mockEnv", "mockSvc")).
+					Return("peace: und Liebe", nil)
+				m.mockAddons.EXPECT().Template().Return(`
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+  Queue:
+    Type: AWS::SQS::Queue`, nil)
+				m.mockDeployedTmplGetter.EXPECT().
+					NestedStackTemplate(stack.NameForWorkload("mockApp", "mockEnv", "mockSvc"), "AddonsStack").
+					Return(`
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket`, nil)
+			},
+			wanted: `~ peace: und Liebe -> and love
+
+Addons stack "AddonsStack":
+1 resource added, 0 modified, 0 removed
+~ Resources:
+    + Queue:
+    +     Type: AWS::SQS::Queue
+`,
+		},
+		"write only the addons section if the workload stack has no diff": {
+			inTemplate: `peace: and love`,
+			hasAddons:  true,
+			setUpMocks: func(m *deployDiffMocks) {
+				m.mockDeployedTmplGetter.EXPECT().
+					Template(stack.NameForWorkload("mockApp", "mockEnv", "mockSvc")).
+					Return("peace: and love", nil)
+				m.mockAddons.EXPECT().Template().Return("Parameters: {}", nil)
+				m.mockDeployedTmplGetter.EXPECT().
+					NestedStackTemplate(stack.NameForWorkload("mockApp", "mockEnv", "mockSvc"), "AddonsStack").
+					Return("", nil)
+			},
+			wanted: `Addons stack "AddonsStack":
++ Parameters: {}
+`,
+		},
+		"do not retrieve the deployed addons template if the workload is not deployed": {
+			inTemplate: `peace: and love`,
+			hasAddons:  true,
+			setUpMocks: func(m *deployDiffMocks) {
+				m.mockDeployedTmplGetter.EXPECT().
+					Template(stack.NameForWorkload("mockApp", "mockEnv", "mockSvc")).
+					Return("", &cloudformation.ErrStackNotFound{})
+				m.mockAddons.EXPECT().Template().Return("Parameters: {}", nil)
+			},
+			wanted: `+ peace: and love
+
+Addons stack "AddonsStack":
++ Parameters: {}
+`,
+		},
+		"return empty string if there is no diff": {
+			inTemplate: `peace: and love`,
+			setUpMocks: func(m *deployDiffMocks) {
+				m.mockDeployedTmplGetter.EXPECT().
+					Template(gomock.Eq(stack.NameForWorkload("mockApp", "mockEnv", "mockSvc"))).
+					Return("peace: and love", nil)
+			},
+		},
+		"error getting the last recorded deployment": {
+			inTemplate:       `peace: and love`,
+			recordsRevisions: true,
+			setUpMocks: func(m *deployDiffMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(stack.NameForWorkload("mockApp", "mockEnv", "mockSvc")).Return("peace: und Liebe", nil)
+				m.mockRevisions.EXPECT().List(stack.NameForWorkload("mockApp", "mockEnv", "mockSvc")).Return(nil, errors.New("some error"))
+			},
+			checkErr: func(t *testing.T, gotErr error) {
+				require.EqualError(t, gotErr, `list the recorded deployments of stack mockApp-mockEnv-mockSvc: some error`)
+			},
+		},
+		"show the changes made outside of Copilot since the last recorded deployment": {
+			inTemplate:       "peace: and love\nwar: never\n",
+			recordsRevisions: true,
+			setUpMocks: func(m *deployDiffMocks) {
+				m.mockDeployedTmplGetter.EXPECT().Template(stack.NameForWorkload("mockApp", "mockEnv", "mockSvc")).Return("peace: und Liebe\nwar: none\n", nil)
+				m.mockRevisions.EXPECT().List(stack.NameForWorkload("mockApp", "mockEnv", "mockSvc")).Return([]string{"1", "2"}, nil)
+				m.mockRevisions.EXPECT().Get(stack.NameForWorkload("mockApp", "mockEnv", "mockSvc"), "2").Return(&stack.Revision{
+					TemplateBody: "peace: und Liebe\nwar: never\n",
+				}, nil)
+			},
+			wanted: `Changes made outside of Copilot:
+~ war: never -> none
+Changes from your manifest:
+~ peace: und Liebe -> and love
 `,
 		},
 	}
@@ -1532,8 +2299,13 @@ func TestWorkloadDeployer_DeployDiff(t *testing.T) {
 
 			m := &deployDiffMocks{
 				mockDeployedTmplGetter: mocks.NewMockdeployedTemplateGetter(ctrl),
+				mockAddons:             mocks.NewMockstackBuilder(ctrl),
+				mockRevisions:          mocks.NewMockrevisionRecorder(ctrl),
 			}
 			tc.setUpMocks(m)
+			spinner := mocks.NewMockspinner(ctrl)
+			spinner.EXPECT().Start(`Comparing mockSvc to its deployed stack in environment mockEnv`)
+			spinner.EXPECT().Stop("")
 			deployer := workloadDeployer{
 				name: "mockSvc",
 				app: &config.Application{
@@ -1543,6 +2315,13 @@ func TestWorkloadDeployer_DeployDiff(t *testing.T) {
 					Name: "mockEnv",
 				},
 				tmplGetter: m.mockDeployedTmplGetter,
+				spinner:    spinner,
+			}
+			if tc.hasAddons {
+				deployer.addons = m.mockAddons
+			}
+			if tc.recordsRevisions {
+				deployer.history = m.mockRevisions
 			}
 			got, gotErr := deployer.DeployDiff(tc.inTemplate)
 			if tc.checkErr != nil {
@@ -1554,3 +2333,415 @@ func TestWorkloadDeployer_DeployDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkloadDeployer_DeployDiffJSON(t *testing.T) {
+	testCases := map[string]struct {
+		inTemplate string
+		hasAddons  bool
+		setUpMocks func(m *deployDiffMocks)
+		wanted     string
+		wantedErr  string
+	}{
+		"wraps the error of the diff": {
+			setUpMocks: func(m *deployDiffMocks) {
+				m.mockDeployedTmplGetter.EXPECT().
+					Template(stack.NameForWorkload("mockApp", "mockEnv", "mockSvc")).
+					Return("", errors.New("some error"))
+			},
+			wantedErr: `retrieve the deployed template for "mockSvc": some error`,
+		},
+		"writes the changes of the stack with the sensitive values redacted": {
+			inTemplate: `
+Parameters:
+  DBPassword: hunter3
+peace: and love`,
+			setUpMocks: func(m *deployDiffMocks) {
+				m.mockDeployedTmplGetter.EXPECT().
+					Template(stack.NameForWorkload("mockApp", "mockEnv", "mockSvc")).
+					Return(`
+Parameters:
+  DBPassword: hunter2
+peace: und Liebe`, nil)
+			},
+			wanted: `{"stack":[
+{"action":"modify","path":"/Parameters/DBPassword","old":"(sensitive value)","new":"(sensitive value)"},
+{"action":"modify","path":"/peace","old":"und Liebe","new":"and love"}]}`,
+		},
+		"writes the changes of the addons stack": {
+			inTemplate: `peace: and love`,
+			hasAddons:  true,
+			setUpMocks: func(m *deployDiffMocks) {
+				m.mockDeployedTmplGetter.EXPECT().
+					Template(stack.NameForWorkload("mockApp", "mockEnv", "mockSvc")).
+					Return("peace: and love", nil)
+				m.mockAddons.EXPECT().Template().Return("Parameters: {}", nil)
+				m.mockDeployedTmplGetter.EXPECT().
+					NestedStackTemplate(stack.NameForWorkload("mockApp", "mockEnv", "mockSvc"), "AddonsStack").
+					Return("", nil)
+			},
+			wanted: `{"stack":[],"addons":[{"action":"add","path":"","new":{"Parameters":{}}}]}`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &deployDiffMocks{
+				mockDeployedTmplGetter: mocks.NewMockdeployedTemplateGetter(ctrl),
+				mockAddons:             mocks.NewMockstackBuilder(ctrl),
+			}
+			tc.setUpMocks(m)
+			spinner := mocks.NewMockspinner(ctrl)
+			spinner.EXPECT().Start(`Comparing mockSvc to its deployed stack in environment mockEnv`)
+			spinner.EXPECT().Stop("")
+			deployer := workloadDeployer{
+				name:       "mockSvc",
+				app:        &config.Application{Name: "mockApp"},
+				env:        &config.Environment{Name: "mockEnv"},
+				tmplGetter: m.mockDeployedTmplGetter,
+				spinner:    spinner,
+			}
+			if tc.hasAddons {
+				deployer.addons = m.mockAddons
+			}
+
+			got, err := deployer.DeployDiffJSON(tc.inTemplate)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.JSONEq(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestWorkloadDeployer_uploadContainerImagesWithBuildCache(t *testing.T) {
+	const (
+		mockURI    = "1111.dkr.ecr.us-west-2.amazonaws.com/press/fe"
+		mockDigest = "sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7"
+	)
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/ws/fe/Dockerfile", []byte("FROM nginx"), 0644))
+	cache := buildcache.Open(fs, "/ws/copilot")
+	mft := &mockWorkloadMft{
+		workloadName: "fe",
+		dockerBuildArgs: map[string]*manifest.DockerBuildArgs{
+			"fe": {
+				Dockerfile: aws.String("/ws/fe/Dockerfile"),
+				Context:    aws.String("/ws/fe"),
+			},
+		},
+	}
+	upload := func(t *testing.T, mock func(repo *mocks.MockrepositoryService, tagger *mocks.MockimageTagger)) map[string]ContainerImageIdentifier {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		repo := mocks.NewMockrepositoryService(ctrl)
+		tagger := mocks.NewMockimageTagger(ctrl)
+		docker := mocks.NewMockdockerEngineRunChecker(ctrl)
+		printer := mocks.NewMocklabeledTermPrinter(ctrl)
+		docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+		repo.EXPECT().Login().Return(mockURI, nil)
+		printer.EXPECT().IsDone().Return(true).AnyTimes()
+		printer.EXPECT().Print().AnyTimes()
+		mock(repo, tagger)
+		deployer := &workloadDeployer{
+			name:       "fe",
+			app:        &config.Application{Name: "press"},
+			env:        &config.Environment{Name: "test"},
+			image:      ContainerImageIdentifier{GitShortCommitTag: "8fa2b1c"},
+			mft:        mft,
+			buildCache: cache,
+			fs:         fs,
+			docker:     docker,
+			repository: repo,
+			tagger:     tagger,
+			labeledTermPrinter: func(fw syncbuffer.FileWriter, bufs []*syncbuffer.LabeledSyncBuffer, opts ...syncbuffer.LabeledTermPrinterOption) labeledTermPrinter {
+				return printer
+			},
+		}
+		out := &UploadArtifactsOutput{}
+		require.NoError(t, deployer.uploadContainerImages(out))
+		return out.ImageDigests
+	}
+	wanted := map[string]ContainerImageIdentifier{
+		"fe": {
+			Digest:            mockDigest,
+			GitShortCommitTag: "8fa2b1c",
+		},
+	}
+
+	t.Run("builds the image on the first deployment", func(t *testing.T) {
+		got := upload(t, func(repo *mocks.MockrepositoryService, tagger *mocks.MockimageTagger) {
+			repo.EXPECT().BuildAndPush(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockDigest, nil)
+		})
+		require.Equal(t, wanted, got)
+	})
+	t.Run("tags the pushed image if the sources are unchanged", func(t *testing.T) {
+		got := upload(t, func(repo *mocks.MockrepositoryService, tagger *mocks.MockimageTagger) {
+			repo.EXPECT().BuildAndPush(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			tagger.EXPECT().TagImage("press/fe", mockDigest, "latest", "8fa2b1c").Return(nil)
+		})
+		require.Equal(t, wanted, got)
+	})
+	t.Run("builds the image again if it was deleted from the repository", func(t *testing.T) {
+		got := upload(t, func(repo *mocks.MockrepositoryService, tagger *mocks.MockimageTagger) {
+			tagger.EXPECT().TagImage("press/fe", mockDigest, "latest", "8fa2b1c").Return(&ecr.ErrImageNotFound{})
+			repo.EXPECT().BuildAndPush(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockDigest, nil)
+		})
+		require.Equal(t, wanted, got)
+	})
+	t.Run("builds the image again if its sources change", func(t *testing.T) {
+		require.NoError(t, afero.WriteFile(fs, "/ws/fe/index.html", []byte("<h1>hello</h1>"), 0644))
+		got := upload(t, func(repo *mocks.MockrepositoryService, tagger *mocks.MockimageTagger) {
+			repo.EXPECT().BuildAndPush(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockDigest, nil)
+		})
+		require.Equal(t, wanted, got)
+	})
+}
+
+type mockImageLocationsMft struct {
+	mockWorkloadMft
+	locations map[string]string
+}
+
+func (m *mockImageLocationsMft) ImageLocations() map[string]string {
+	return m.locations
+}
+
+func TestWorkloadDeployer_pinImageLocations(t *testing.T) {
+	const mockDigest = "sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7"
+	mft := &mockImageLocationsMft{
+		locations: map[string]string{
+			"fe":    "public.ecr.aws/nginx/nginx:1.25",
+			"envoy": "localhost:5000/envoy",
+			"otel":  "public.ecr.aws/aws-observability/aws-otel-collector@" + mockDigest,
+		},
+	}
+	testCases := map[string]struct {
+		pinDigests bool
+		setupMock  func(m *mocks.MockimageDigestResolver)
+
+		wanted      map[string]string
+		wantedError string
+	}{
+		"does nothing unless digests are pinned": {
+			setupMock: func(m *mocks.MockimageDigestResolver) {},
+		},
+		"pins each image location to its digest": {
+			pinDigests: true,
+			setupMock: func(m *mocks.MockimageDigestResolver) {
+				m.EXPECT().ImageDigest(gomock.Any(), "public.ecr.aws/nginx/nginx:1.25").Return(mockDigest, nil)
+				m.EXPECT().ImageDigest(gomock.Any(), "localhost:5000/envoy").Return(mockDigest, nil)
+			},
+			wanted: map[string]string{
+				"fe":    "public.ecr.aws/nginx/nginx@" + mockDigest,
+				"envoy": "localhost:5000/envoy@" + mockDigest,
+				"otel":  "public.ecr.aws/aws-observability/aws-otel-collector@" + mockDigest,
+			},
+		},
+		"wraps the error": {
+			pinDigests: true,
+			setupMock: func(m *mocks.MockimageDigestResolver) {
+				m.EXPECT().ImageDigest(gomock.Any(), "localhost:5000/envoy").Return("", errors.New("some error"))
+			},
+			wantedError: `resolve the digest of the image "localhost:5000/envoy" of container "envoy": some error`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			resolver := mocks.NewMockimageDigestResolver(ctrl)
+			tc.setupMock(resolver)
+			deployer := &workloadDeployer{
+				name:           "fe",
+				mft:            mft,
+				pinDigests:     tc.pinDigests,
+				digestResolver: resolver,
+			}
+			out := &UploadArtifactsOutput{}
+
+			err := deployer.pinImageLocations(out)
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, out.PinnedImages)
+		})
+	}
+}
+
+type mockPlatformMft struct {
+	mockWorkloadMft
+	platform string
+}
+
+func (m *mockPlatformMft) ContainerPlatform() string {
+	return m.platform
+}
+
+func TestWorkloadDeployer_checkPrebuiltImage(t *testing.T) {
+	const image = "123456789012.dkr.ecr.us-east-1.amazonaws.com/phonetool/fe@sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7"
+	wantedImage := ecr.ImageURI{
+		RegistryID: "123456789012",
+		Region:     "us-east-1",
+		RepoName:   "phonetool/fe",
+		Digest:     "sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7",
+	}
+	testCases := map[string]struct {
+		platform   string
+		setupMocks func(m *mocks.MockimagePlatformsGetter)
+
+		wantedError string
+	}{
+		"image for the default platform": {
+			setupMocks: func(m *mocks.MockimagePlatformsGetter) {
+				m.EXPECT().ImagePlatforms(wantedImage).Return([]string{"linux/amd64", "linux/arm64"}, nil)
+			},
+		},
+		"image for the ARM platform of the manifest": {
+			platform: "linux/arm",
+			setupMocks: func(m *mocks.MockimagePlatformsGetter) {
+				m.EXPECT().ImagePlatforms(wantedImage).Return([]string{"linux/arm64"}, nil)
+			},
+		},
+		"error if the image is built for another architecture": {
+			platform: "linux/x86_64",
+			setupMocks: func(m *mocks.MockimagePlatformsGetter) {
+				m.EXPECT().ImagePlatforms(wantedImage).Return([]string{"linux/arm64"}, nil)
+			},
+			wantedError: `the existing image ` + image + ` is built for linux/arm64, but "fe" runs on linux/amd64`,
+		},
+		"wraps the error": {
+			setupMocks: func(m *mocks.MockimagePlatformsGetter) {
+				m.EXPECT().ImagePlatforms(wantedImage).Return(nil, errors.New("some error"))
+			},
+			wantedError: "describe the existing image " + image + ": some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			registry := mocks.NewMockimagePlatformsGetter(ctrl)
+			tc.setupMocks(registry)
+			deployer := &workloadDeployer{
+				name:          "fe",
+				mft:           &mockPlatformMft{platform: tc.platform},
+				prebuiltImage: image,
+				prebuiltImages: func(region string) (imagePlatformsGetter, error) {
+					require.Equal(t, "us-east-1", region)
+					return registry, nil
+				},
+			}
+
+			err := deployer.checkPrebuiltImage()
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestWorkloadDeployer_uploadContainerImagesWithPrebuiltImage(t *testing.T) {
+	const image = "123456789012.dkr.ecr.us-east-1.amazonaws.com/phonetool/fe@sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7"
+	upload := func(t *testing.T, buildArgs map[string]*manifest.DockerBuildArgs) error {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		registry := mocks.NewMockimagePlatformsGetter(ctrl)
+		registry.EXPECT().ImagePlatforms(gomock.Any()).Return([]string{"linux/amd64"}, nil)
+		deployer := &workloadDeployer{
+			name: "fe",
+			mft: &mockPlatformMft{
+				mockWorkloadMft: mockWorkloadMft{dockerBuildArgs: buildArgs},
+			},
+			prebuiltImage: image,
+			prebuiltImages: func(region string) (imagePlatformsGetter, error) {
+				return registry, nil
+			},
+		}
+		return deployer.uploadContainerImages(&UploadArtifactsOutput{})
+	}
+
+	t.Run("skips building the image of the main container", func(t *testing.T) {
+		require.NoError(t, upload(t, map[string]*manifest.DockerBuildArgs{
+			"fe": {Dockerfile: aws.String("fe/Dockerfile")},
+		}))
+	})
+	t.Run("error if a sidecar builds its image", func(t *testing.T) {
+		err := upload(t, map[string]*manifest.DockerBuildArgs{
+			"fe":    {Dockerfile: aws.String("fe/Dockerfile")},
+			"nginx": {Dockerfile: aws.String("nginx/Dockerfile")},
+		})
+		require.EqualError(t, err, `deploy the existing image `+image+`: sidecars nginx build their image from a Dockerfile: set their "image.location" instead`)
+	})
+}
+
+func TestWorkloadDeployer_copySourceImage(t *testing.T) {
+	const (
+		digest = "sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7"
+		uri    = "123456789012.dkr.ecr.us-east-1.amazonaws.com/phonetool/fe"
+		other  = "123456789012.dkr.ecr.eu-west-1.amazonaws.com/phonetool/fe"
+	)
+	testCases := map[string]struct {
+		src       string
+		setupMock func(m *mocks.MockimageCopier)
+
+		wantedError string
+	}{
+		"reuses the image of the same repository": {
+			src:       uri + "@" + digest,
+			setupMock: func(m *mocks.MockimageCopier) {},
+		},
+		"copies the image from the repository of another region": {
+			src: other + "@" + digest,
+			setupMock: func(m *mocks.MockimageCopier) {
+				m.EXPECT().CopyImage(gomock.Any(), other+"@"+digest, uri, "latest", "v1.0").Return(nil)
+			},
+		},
+		"error if the image is not pinned to a digest": {
+			src:         other + ":latest",
+			setupMock:   func(m *mocks.MockimageCopier) {},
+			wantedError: `image ` + other + `:latest of container "fe" built for another environment is not pinned to a digest`,
+		},
+		"wraps the error": {
+			src: other + "@" + digest,
+			setupMock: func(m *mocks.MockimageCopier) {
+				m.EXPECT().CopyImage(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: `copy the image "fe" built for another environment: some error`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			copier := mocks.NewMockimageCopier(ctrl)
+			tc.setupMock(copier)
+			deployer := &workloadDeployer{
+				name:        "fe",
+				imageCopier: copier,
+			}
+
+			got, err := deployer.copySourceImage(context.Background(), "fe", tc.src, &dockerengine.BuildArguments{
+				URI:  uri,
+				Tags: []string{"latest", "v1.0"},
+			})
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, digest, got)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+// checkDeployPolicy returns an error if the deploy windows or freezes of the application block deployments
+// to the environment at the given time, unless a reason to override them is provided.
+// The reason is returned if it was used to override the policy, so that it can be recorded with the deployment.
+func checkDeployPolicy(app *config.Application, env string, at time.Time, overrideReason string) (string, error) {
+	err := app.CheckDeployPolicies(env, at)
+	if err == nil {
+		return "", nil
+	}
+	var errFrozen *config.ErrDeployFrozen
+	var errOutsideWindow *config.ErrOutsideDeployWindow
+	if !errors.As(err, &errFrozen) && !errors.As(err, &errOutsideWindow) {
+		return "", fmt.Errorf("check deploy policy of environment %s: %w", env, err)
+	}
+	reason := strings.TrimSpace(overrideReason)
+	if reason == "" {
+		return "", &errDeployBlocked{err: err}
+	}
+	log.Warningf("Overriding the deploy policy of environment %s: %v.\nReason: %s\n", env, err, reason)
+	return reason, nil
+}
+
+type errDeployBlocked struct {
+	err error
+}
+
+func (e *errDeployBlocked) Error() string {
+	return e.err.Error()
+}
+
+func (e *errDeployBlocked) Unwrap() error {
+	return e.err
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+// Implements main.actionRecommender interface.
+func (e *errDeployBlocked) RecommendActions() string {
+	return fmt.Sprintf("Deploy once the policy allows it, or run the command again with %s to deploy anyway.\nThe reason is recorded in the deployment history of services.",
+		color.HighlightCode(fmt.Sprintf(`--%s "<reason>"`, overrideFreezeFlag)))
+}
@@ -0,0 +1,81 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDeployPolicy(t *testing.T) {
+	start := time.Date(2026, time.December, 20, 0, 0, 0, 0, time.UTC)
+	app := &config.Application{
+		EnvDeployPolicies: map[string]*config.DeployPolicy{
+			"prod": {
+				Freezes: []config.DeployFreeze{
+					{
+						Name:   "holidays",
+						Reason: "End of year code freeze.",
+						Start:  &start,
+						End:    aws.Time(start.Add(14 * 24 * time.Hour)),
+					},
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inEnv    string
+		inAt     time.Time
+		inReason string
+
+		wantedOverride string
+		wantedError    string
+	}{
+		"allowed without a policy": {
+			inEnv: "test",
+			inAt:  start.Add(time.Hour),
+		},
+		"allowed outside of the freeze": {
+			inEnv:    "prod",
+			inAt:     start.Add(-time.Hour),
+			inReason: "Hotfix for the checkout page.",
+		},
+		"blocked during the freeze": {
+			inEnv:       "prod",
+			inAt:        start.Add(time.Hour),
+			wantedError: `deployments are frozen by "holidays": End of year code freeze.`,
+		},
+		"blocked during the freeze if the reason is blank": {
+			inEnv:       "prod",
+			inAt:        start.Add(time.Hour),
+			inReason:    "  ",
+			wantedError: `deployments are frozen by "holidays": End of year code freeze.`,
+		},
+		"overridden with a reason": {
+			inEnv:          "prod",
+			inAt:           start.Add(time.Hour),
+			inReason:       " Hotfix for the checkout page. ",
+			wantedOverride: "Hotfix for the checkout page.",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			override, err := checkDeployPolicy(app, tc.inEnv, tc.inAt, tc.inReason)
+
+			if tc.wantedError != "" {
+				var errBlocked *errDeployBlocked
+				require.ErrorAs(t, err, &errBlocked)
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOverride, override)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/quota"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+// cpuUnitsPerVCPU is the number of CPU units of a task size that make up one vCPU.
+const cpuUnitsPerVCPU = 1024
+
+// workloadQuotaNeeds returns the resources that deploying a workload adds to the account.
+//
+// Tasks are counted up to the maximum the service scales to, as a rolling deployment starts the new tasks before
+// stopping the old ones. Listener rules are only counted on the first deployment, since updates replace the existing rules.
+func workloadQuotaNeeds(mft manifest.DynamicWorkload, envOutputs map[string]string, firstDeployment bool) (quota.Needs, error) {
+	var needs quota.Needs
+	var task *manifest.TaskConfig
+	switch t := mft.Manifest().(type) {
+	case *manifest.LoadBalancedWebService:
+		task = &t.TaskConfig
+		if albARN := envOutputs[stack.EnvOutputPublicALBARN]; firstDeployment && albARN != "" && !t.HTTPOrBool.Disabled() {
+			rules := len(t.HTTPOrBool.RoutingRules()) + len(t.HTTPOrBool.StaticRules)
+			if envOutputs[stack.EnvOutputHTTPSListenerARN] != "" {
+				rules *= 2 // The HTTP listener gets a rule that redirects to HTTPS for each rule of the HTTPS listener.
+			}
+			needs.LoadBalancerARN = albARN
+			needs.ListenerRules = rules
+		}
+	case *manifest.BackendService:
+		task = &t.TaskConfig
+	case *manifest.WorkerService:
+		task = &t.TaskConfig
+	}
+	if task != nil {
+		count, err := task.Count.MaxOnDemand()
+		if err != nil {
+			return quota.Needs{}, err
+		}
+		needs.FargateVCPUs = float64(aws.IntValue(task.CPU)) / cpuUnitsPerVCPU * float64(count)
+	}
+	// Managed VPCs get a NAT gateway, with its Elastic IP, for each private subnet when the first workload needs them.
+	createsNATGateways := envOutputs[stack.EnvOutputInternetGatewayID] != "" && envOutputs[stack.EnvOutputPrivateRouteTables] == ""
+	if createsNATGateways && contains(template.NATFeatureName, mft.RequiredEnvironmentFeatures()) {
+		if subnets := envOutputs[stack.EnvOutputPrivateSubnets]; subnets != "" {
+			needs.ElasticIPs = len(strings.Split(subnets, ","))
+		}
+	}
+	return needs, nil
+}
+
+// checkQuotas stops a deployment before any resource is created if it would exceed a quota of the account,
+// and warns about the quotas that the deployment brings close to their limit.
+// If requestIncrease is true, an increase is requested for each exceeded quota.
+func checkQuotas(checker quotaChecker, needs quota.Needs, requestIncrease bool) error {
+	usages, err := checker.Check(needs)
+	var errQuota *quota.ErrInsufficientQuota
+	if err != nil && !errors.As(err, &errQuota) {
+		// Checking quotas requires permissions that not every deployer has, so it never blocks a deployment.
+		log.Warningf("Unable to check the service quotas needed by the deployment: %v\n", err)
+		return nil
+	}
+	for _, usage := range usages {
+		if !usage.Exceeded() && usage.NearLimit() {
+			log.Warningf("The deployment brings quota %q close to its limit: %s.\n", usage.Name, usage)
+		}
+	}
+	if errQuota == nil {
+		return nil
+	}
+	if !requestIncrease {
+		return &errInsufficientQuota{err: errQuota}
+	}
+	for _, usage := range errQuota.Exceeded {
+		id, err := checker.RequestIncrease(usage)
+		if err != nil {
+			return err
+		}
+		log.Infof("Requested an increase of quota %q to %g with request %s.\n", usage.Name, usage.Used+usage.Needed, id)
+	}
+	return fmt.Errorf("%w: deploy again once the requested increases are approved", errQuota)
+}
+
+type errInsufficientQuota struct {
+	err *quota.ErrInsufficientQuota
+}
+
+func (e *errInsufficientQuota) Error() string {
+	return e.err.Error()
+}
+
+func (e *errInsufficientQuota) Unwrap() error {
+	return e.err
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+// Implements main.actionRecommender interface.
+func (e *errInsufficientQuota) RecommendActions() string {
+	return fmt.Sprintf("Run the command again with %s to request an increase of the exceeded quotas,\nor request them from the Service Quotas console.",
+		color.HighlightCode("--"+requestQuotaIncreaseFlag))
+}
@@ -0,0 +1,195 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/quota"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloadQuotaNeeds(t *testing.T) {
+	const albARN = "arn:aws:elasticloadbalancing:us-west-2:1111:loadbalancer/app/demo-Publi-1A2B3C/50dc6c495c0c9188"
+	managedVPCOutputs := map[string]string{
+		"InternetGatewayID":     "igw-1",
+		"PrivateSubnets":        "subnet-1,subnet-2",
+		"PublicLoadBalancerArn": albARN,
+		"HTTPSListenerArn":      "arn:aws:elasticloadbalancing:us-west-2:1111:listener/app/demo-Publi-1A2B3C/50dc6c495c0c9188/1",
+	}
+	testCases := map[string]struct {
+		inManifest        string
+		inEnvOutputs      map[string]string
+		inFirstDeployment bool
+
+		wanted    quota.Needs
+		wantedErr error
+	}{
+		"counts the vCPUs of the largest number of tasks on demand": {
+			inManifest: `name: api
+type: Backend Service
+image:
+  location: nginx
+cpu: 512
+count:
+  range:
+    min: 1
+    max: 10
+    spot_from: 7
+`,
+			wanted: quota.Needs{FargateVCPUs: 3},
+		},
+		"counts the listener rules of the first deployment on both listeners": {
+			inManifest: `name: frontend
+type: Load Balanced Web Service
+image:
+  location: nginx
+  port: 80
+http:
+  path: /
+  additional_rules:
+    - path: admin
+`,
+			inEnvOutputs:      managedVPCOutputs,
+			inFirstDeployment: true,
+			wanted: quota.Needs{
+				FargateVCPUs:    0.25,
+				LoadBalancerARN: albARN,
+				ListenerRules:   4,
+			},
+		},
+		"ignores the listener rules of an update": {
+			inManifest: `name: frontend
+type: Load Balanced Web Service
+image:
+  location: nginx
+  port: 80
+http:
+  path: /
+count: 2
+`,
+			inEnvOutputs: managedVPCOutputs,
+			wanted:       quota.Needs{FargateVCPUs: 0.5},
+		},
+		"counts an Elastic IP per private subnet if the environment needs NAT gateways": {
+			inManifest: `name: worker
+type: Worker Service
+image:
+  location: nginx
+network:
+  vpc:
+    placement: private
+`,
+			inEnvOutputs: managedVPCOutputs,
+			wanted: quota.Needs{
+				FargateVCPUs: 0.25,
+				ElasticIPs:   2,
+			},
+		},
+		"doesn't count Elastic IPs if the NAT gateways exist": {
+			inManifest: `name: worker
+type: Worker Service
+image:
+  location: nginx
+network:
+  vpc:
+    placement: private
+`,
+			inEnvOutputs: map[string]string{
+				"InternetGatewayID":    "igw-1",
+				"PrivateSubnets":       "subnet-1,subnet-2",
+				"PrivateRouteTableIDs": "rtb-1,rtb-2",
+			},
+			wanted: quota.Needs{FargateVCPUs: 0.25},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mft, err := manifest.UnmarshalWorkload([]byte(tc.inManifest))
+			require.NoError(t, err)
+
+			// WHEN
+			got, err := workloadQuotaNeeds(mft, tc.inEnvOutputs, tc.inFirstDeployment)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestCheckQuotas(t *testing.T) {
+	needs := quota.Needs{FargateVCPUs: 4}
+	exceeded := quota.Usage{Quota: quota.FargateOnDemandVCPUs, Used: 6, Needed: 4, Limit: 8}
+	testCases := map[string]struct {
+		inRequestIncrease bool
+		setupMocks        func(m *mocks.MockquotaChecker)
+
+		wantedErr error
+	}{
+		"doesn't block the deployment if the quotas can't be checked": {
+			setupMocks: func(m *mocks.MockquotaChecker) {
+				m.EXPECT().Check(needs).Return(nil, errors.New("access denied"))
+			},
+		},
+		"passes if the quotas leave room for the deployment": {
+			setupMocks: func(m *mocks.MockquotaChecker) {
+				m.EXPECT().Check(needs).Return([]quota.Usage{{Quota: quota.FargateOnDemandVCPUs, Used: 6, Needed: 1, Limit: 8}}, nil)
+			},
+		},
+		"fails with the exceeded quotas": {
+			setupMocks: func(m *mocks.MockquotaChecker) {
+				m.EXPECT().Check(needs).Return([]quota.Usage{exceeded}, &quota.ErrInsufficientQuota{Exceeded: []quota.Usage{exceeded}})
+				m.EXPECT().RequestIncrease(gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("deployment would exceed quota Fargate On-Demand vCPU resource count: 6 used + 4 needed > 8"),
+		},
+		"requests an increase of the exceeded quotas": {
+			inRequestIncrease: true,
+			setupMocks: func(m *mocks.MockquotaChecker) {
+				m.EXPECT().Check(needs).Return([]quota.Usage{exceeded}, &quota.ErrInsufficientQuota{Exceeded: []quota.Usage{exceeded}})
+				m.EXPECT().RequestIncrease(exceeded).Return("d1c5a2f3", nil)
+			},
+			wantedErr: errors.New("deployment would exceed quota Fargate On-Demand vCPU resource count: 6 used + 4 needed > 8: deploy again once the requested increases are approved"),
+		},
+		"errors if an increase can't be requested": {
+			inRequestIncrease: true,
+			setupMocks: func(m *mocks.MockquotaChecker) {
+				m.EXPECT().Check(needs).Return([]quota.Usage{exceeded}, &quota.ErrInsufficientQuota{Exceeded: []quota.Usage{exceeded}})
+				m.EXPECT().RequestIncrease(exceeded).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockquotaChecker(ctrl)
+			tc.setupMocks(m)
+
+			// WHEN
+			err := checkQuotas(m, needs, tc.inRequestIncrease)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
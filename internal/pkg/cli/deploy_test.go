@@ -5,10 +5,15 @@ package cli
 
 import (
 	"errors"
+	"strings"
+	"sync"
 	"testing"
 
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -27,8 +32,10 @@ func TestDeployOpts_Run(t *testing.T) {
 	testCases := map[string]struct {
 		inAppName string
 		inName    string
+		inJSON    bool
 
-		wantedErr string
+		wantedErr  string
+		wantedJSON string
 
 		mockSel           func(m *mocks.MockwsSelector)
 		mockActionCommand func(m *mocks.MockactionCommand)
@@ -49,6 +56,22 @@ func TestDeployOpts_Run(t *testing.T) {
 				m.EXPECT().GetWorkload("app", "fe").Return(&mockWl, nil)
 			},
 		},
+		"writes the deployed workload as JSON": {
+			inAppName: "app",
+			inName:    "fe",
+			inJSON:    true,
+			mockSel:   func(m *mocks.MockwsSelector) {},
+			mockActionCommand: func(m *mocks.MockactionCommand) {
+				m.EXPECT().Ask()
+				m.EXPECT().Validate()
+				m.EXPECT().Execute()
+				m.EXPECT().RecommendActions()
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetWorkload("app", "fe").Return(&mockWl, nil)
+			},
+			wantedJSON: `{"environments":[{"name":"test","deployed":["fe"]}]}` + "\n",
+		},
 		"errors correctly if job returned": {
 			inAppName: "app",
 			wantedErr: "ask job deploy: some error",
@@ -141,15 +164,20 @@ func TestDeployOpts_Run(t *testing.T) {
 			tc.mockStore(mockStore)
 			tc.mockSel(mockSel)
 			tc.mockActionCommand(mockCmd)
+			b := &strings.Builder{}
 			opts := &deployOpts{
-				deployWkldVars: deployWkldVars{
-					appName: tc.inAppName,
-					name:    tc.inName,
-					envName: "test",
+				deployVars: deployVars{
+					deployWkldVars: deployWkldVars{
+						appName: tc.inAppName,
+						name:    tc.inName,
+						envName: "test",
+					},
+					shouldOutputJSON: tc.inJSON,
 				},
 				deployWkld: mockCmd,
 				sel:        mockSel,
 				store:      mockStore,
+				w:          b,
 
 				setupDeployCmd: func(o *deployOpts, wlType string) {},
 			}
@@ -161,6 +189,396 @@ func TestDeployOpts_Run(t *testing.T) {
 			if tc.wantedErr != "" {
 				require.EqualError(t, err, tc.wantedErr)
 			}
+			require.Equal(t, tc.wantedJSON, b.String())
 		})
 	}
 }
+
+type packagingActionCommand struct {
+	*mocks.MockactionCommand
+	packageArtifactsFn func() error
+}
+
+func (c *packagingActionCommand) packageArtifacts() error {
+	return c.packageArtifactsFn()
+}
+
+func TestDeployOpts_RunAll(t *testing.T) {
+	mockPipelines := []workspace.PipelineManifest{{Name: "release", Path: "/copilot/pipelines/release/manifest.yml"}}
+	mockPipelineMft := &manifest.Pipeline{
+		Stages: []manifest.PipelineStage{
+			{
+				Name: "test",
+				Deployments: manifest.Deployments{
+					"fe":     {DependsOn: []string{"api"}},
+					"api":    {DependsOn: []string{"db"}},
+					"db":     nil,
+					"mailer": {DependsOn: []string{"db", "orders-stack"}},
+				},
+			},
+			{
+				Name: "prod",
+				Deployments: manifest.Deployments{
+					"db": {DependsOn: []string{"mailer"}},
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inName              string
+		inEnvName           string
+		inMaxParallel       int
+		inMaxParallelBuilds int
+		inJSON              bool
+		packageErrs         map[string]error
+		executeErrs         map[string]error
+
+		mockSel func(m *mocks.MockwsSelector)
+
+		wantedOrder       [][]string
+		wantedPackaged    []string
+		wantedDeployed    []string
+		wantedRecommended []string
+		wantedJSON        string
+		wantedErr         string
+	}{
+		"errors if both --all and --name are set": {
+			inName:        "fe",
+			inEnvName:     "test",
+			inMaxParallel: 1,
+			mockSel:       func(m *mocks.MockwsSelector) {},
+			wantedErr:     "only one of --all or --name may be used",
+		},
+		"errors if max parallel is not positive": {
+			inEnvName: "test",
+			mockSel:   func(m *mocks.MockwsSelector) {},
+			wantedErr: "--max-parallel must be at least 1",
+		},
+		"errors if max parallel builds is negative": {
+			inEnvName:           "test",
+			inMaxParallel:       1,
+			inMaxParallelBuilds: -1,
+			mockSel:             func(m *mocks.MockwsSelector) {},
+			wantedErr:           "--max-parallel-builds must not be negative",
+		},
+		"packages all the workloads before deploying them": {
+			inEnvName:           "test",
+			inMaxParallel:       1,
+			inMaxParallelBuilds: 4,
+			packageErrs: map[string]error{
+				"db": errors.New("some error"),
+			},
+			mockSel:        func(m *mocks.MockwsSelector) {},
+			wantedPackaged: []string{"api", "db", "fe", "mailer"},
+			wantedErr:      "db failed to deploy to environment test",
+		},
+		"deploys the workloads after their dependencies": {
+			inMaxParallel: 1,
+			mockSel: func(m *mocks.MockwsSelector) {
+				m.EXPECT().Environment("Select an environment to deploy all services and jobs to", "", "app").Return("test", nil)
+			},
+			wantedOrder:       [][]string{{"db"}, {"api", "mailer"}, {"fe"}},
+			wantedDeployed:    []string{"api", "db", "fe", "mailer"},
+			wantedRecommended: []string{"api", "db", "fe", "mailer"},
+		},
+		"writes the deployed workloads as JSON": {
+			inEnvName:         "test",
+			inMaxParallel:     1,
+			inJSON:            true,
+			mockSel:           func(m *mocks.MockwsSelector) {},
+			wantedOrder:       [][]string{{"db"}, {"api", "mailer"}, {"fe"}},
+			wantedDeployed:    []string{"api", "db", "fe", "mailer"},
+			wantedRecommended: []string{"api", "db", "fe", "mailer"},
+			wantedJSON:        `{"environments":[{"name":"test","deployed":["api","db","fe","mailer"]}]}` + "\n",
+		},
+		"skips the workloads that depend on a failed deployment": {
+			inEnvName:     "test",
+			inMaxParallel: 2,
+			executeErrs: map[string]error{
+				"api": errors.New("some error"),
+			},
+			mockSel:           func(m *mocks.MockwsSelector) {},
+			wantedOrder:       [][]string{{"db"}, {"api", "mailer"}},
+			wantedDeployed:    []string{"api", "db", "mailer"},
+			wantedRecommended: []string{"db", "mailer"},
+			wantedErr:         "api failed to deploy to environment test",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockwsSelector(ctrl)
+			mockStore := mocks.NewMockstore(ctrl)
+			mockWs := mocks.NewMockwsWlDirReader(ctrl)
+			mockPipelineWs := mocks.NewMockwsPipelineGetter(ctrl)
+			tc.mockSel(mockSel)
+			names := []string{"api", "db", "fe", "mailer"}
+			mockWs.EXPECT().ListWorkloads().Return(names, nil).AnyTimes()
+			mockPipelineWs.EXPECT().ListPipelines().Return(mockPipelines, nil).AnyTimes()
+			mockPipelineWs.EXPECT().ReadPipelineManifest(mockPipelines[0].Path).Return(mockPipelineMft, nil).AnyTimes()
+
+			var mu sync.Mutex
+			var packaged, executed, recommended []string
+			rank := make(map[string]int)
+			for i, stage := range tc.wantedOrder {
+				for _, name := range stage {
+					rank[name] = i
+				}
+			}
+			b := &strings.Builder{}
+			cmds := make(map[string]actionCommand)
+			for _, name := range names {
+				name := name
+				mockStore.EXPECT().GetWorkload("app", name).Return(&config.Workload{Name: name, Type: "Backend Service"}, nil).AnyTimes()
+				cmd := mocks.NewMockactionCommand(ctrl)
+				cmd.EXPECT().Ask().AnyTimes()
+				cmd.EXPECT().Validate().AnyTimes()
+				cmd.EXPECT().Execute().DoAndReturn(func() error {
+					mu.Lock()
+					defer mu.Unlock()
+					for _, prev := range executed {
+						require.LessOrEqual(t, rank[prev], rank[name], "%s is deployed before %s", name, prev)
+					}
+					executed = append(executed, name)
+					return tc.executeErrs[name]
+				}).AnyTimes()
+				cmd.EXPECT().RecommendActions().DoAndReturn(func() error {
+					recommended = append(recommended, name)
+					return nil
+				}).AnyTimes()
+				cmds[name] = cmd
+				if tc.inMaxParallelBuilds > 0 {
+					cmds[name] = &packagingActionCommand{
+						MockactionCommand: cmd,
+						packageArtifactsFn: func() error {
+							mu.Lock()
+							defer mu.Unlock()
+							require.Empty(t, executed, "%s is packaged after a deployment started", name)
+							packaged = append(packaged, name)
+							return tc.packageErrs[name]
+						},
+					}
+				}
+			}
+			opts := &deployOpts{
+				deployVars: deployVars{
+					deployWkldVars: deployWkldVars{
+						appName: "app",
+						envName: tc.inEnvName,
+						name:    tc.inName,
+					},
+					deployAll:         true,
+					maxParallel:       tc.inMaxParallel,
+					maxParallelBuilds: tc.inMaxParallelBuilds,
+					shouldOutputJSON:  tc.inJSON,
+				},
+				sel:        mockSel,
+				store:      mockStore,
+				ws:         mockWs,
+				pipelineWs: mockPipelineWs,
+				w:          b,
+
+				setupDeployCmd: func(o *deployOpts, wlType string) {
+					o.deployWkld = cmds[o.name]
+				},
+			}
+
+			// WHEN
+			err := opts.Run()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.ElementsMatch(t, tc.wantedPackaged, packaged)
+			require.ElementsMatch(t, tc.wantedDeployed, executed)
+			require.ElementsMatch(t, tc.wantedRecommended, recommended)
+			require.Equal(t, tc.wantedJSON, b.String())
+		})
+	}
+}
+
+type replicatingActionCommand struct {
+	packagingActionCommand
+	images     map[string]string
+	replicated map[string]string
+}
+
+func (c *replicatingActionCommand) packagedImages() map[string]string {
+	return c.images
+}
+
+func (c *replicatingActionCommand) replicateImages(images map[string]string) {
+	c.replicated = images
+}
+
+func TestDeployOpts_RunEnvs(t *testing.T) {
+	const mockImage = "123456789012.dkr.ecr.us-east-1.amazonaws.com/app/fe@sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7"
+	testCases := map[string]struct {
+		inName      string
+		packageErrs map[string]error
+		executeErrs map[string]error
+
+		mockSel   func(m *mocks.MockwsSelector)
+		mockStore func(m *mocks.Mockstore)
+
+		wantedPackaged   []string
+		wantedReplicated map[string]map[string]string
+		wantedExecuted   []string
+		wantedErr        string
+	}{
+		"errors if an environment does not exist": {
+			inName:  "fe",
+			mockSel: func(m *mocks.MockwsSelector) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("app", "prod-us").Return(&config.Environment{Name: "prod-us", Region: "us-east-1"}, nil)
+				m.EXPECT().GetEnvironment("app", "prod-eu").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "get environment prod-eu configuration: some error",
+		},
+		"prompts for the workload once, builds its images once and deploys it to each environment": {
+			mockSel: func(m *mocks.MockwsSelector) {
+				m.EXPECT().Workload("Select a service or job in your workspace", "").Return("fe", nil).Times(1)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("app", "prod-us").Return(&config.Environment{Name: "prod-us", Region: "us-east-1"}, nil)
+				m.EXPECT().GetEnvironment("app", "prod-eu").Return(&config.Environment{Name: "prod-eu", Region: "eu-west-1"}, nil)
+				m.EXPECT().GetWorkload("app", "fe").Return(&config.Workload{Name: "fe", Type: "Load Balanced Web Service"}, nil).Times(2)
+			},
+			wantedPackaged: []string{"prod-us", "prod-eu"},
+			wantedReplicated: map[string]map[string]string{
+				"prod-eu": {"fe": mockImage},
+			},
+			wantedExecuted: []string{"prod-us", "prod-eu"},
+		},
+		"deploys to the remaining environments after a failure": {
+			inName: "fe",
+			executeErrs: map[string]error{
+				"prod-us": errors.New("some error"),
+			},
+			mockSel: func(m *mocks.MockwsSelector) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("app", "prod-us").Return(&config.Environment{Name: "prod-us", Region: "us-east-1"}, nil)
+				m.EXPECT().GetEnvironment("app", "prod-eu").Return(&config.Environment{Name: "prod-eu", Region: "eu-west-1"}, nil)
+				m.EXPECT().GetWorkload("app", "fe").Return(&config.Workload{Name: "fe", Type: "Load Balanced Web Service"}, nil).Times(2)
+			},
+			wantedPackaged: []string{"prod-us", "prod-eu"},
+			wantedReplicated: map[string]map[string]string{
+				"prod-eu": {"fe": mockImage},
+			},
+			wantedExecuted: []string{"prod-us", "prod-eu"},
+			wantedErr:      "failed to deploy to environment prod-us",
+		},
+		"does not build the images again for the other environments if the first build fails": {
+			inName: "fe",
+			packageErrs: map[string]error{
+				"prod-us": errors.New("some error"),
+			},
+			mockSel: func(m *mocks.MockwsSelector) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetEnvironment("app", "prod-us").Return(&config.Environment{Name: "prod-us", Region: "us-east-1"}, nil)
+				m.EXPECT().GetEnvironment("app", "prod-eu").Return(&config.Environment{Name: "prod-eu", Region: "eu-west-1"}, nil)
+				m.EXPECT().GetWorkload("app", "fe").Return(&config.Workload{Name: "fe", Type: "Load Balanced Web Service"}, nil).Times(2)
+			},
+			wantedPackaged: []string{"prod-us"},
+			wantedErr:      "failed to deploy to environments prod-us and prod-eu",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockwsSelector(ctrl)
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.mockSel(mockSel)
+			tc.mockStore(mockStore)
+			var mu sync.Mutex
+			var packaged, executed []string
+			replicated := make(map[string]map[string]string)
+			cmds := make(map[string]*replicatingActionCommand)
+			for _, env := range []string{"prod-us", "prod-eu"} {
+				env := env
+				cmd := mocks.NewMockactionCommand(ctrl)
+				cmd.EXPECT().Ask().AnyTimes()
+				cmd.EXPECT().Validate().AnyTimes()
+				cmd.EXPECT().Execute().DoAndReturn(func() error {
+					mu.Lock()
+					defer mu.Unlock()
+					executed = append(executed, env)
+					return tc.executeErrs[env]
+				}).AnyTimes()
+				cmd.EXPECT().RecommendActions().AnyTimes()
+				rcmd := &replicatingActionCommand{
+					images: map[string]string{"fe": mockImage},
+				}
+				rcmd.packagingActionCommand = packagingActionCommand{
+					MockactionCommand: cmd,
+					packageArtifactsFn: func() error {
+						mu.Lock()
+						defer mu.Unlock()
+						require.Empty(t, executed, "%s is packaged after a deployment started", env)
+						packaged = append(packaged, env)
+						if rcmd.replicated != nil {
+							replicated[env] = rcmd.replicated
+						}
+						return tc.packageErrs[env]
+					},
+				}
+				cmds[env] = rcmd
+			}
+			opts := &deployOpts{
+				deployVars: deployVars{
+					deployWkldVars: deployWkldVars{
+						appName: "app",
+						name:    tc.inName,
+					},
+					envNames: []string{"prod-us", "prod-eu"},
+				},
+				sel:   mockSel,
+				store: mockStore,
+
+				setupDeployCmd: func(o *deployOpts, wlType string) {
+					o.deployWkld = cmds[o.envName]
+				},
+			}
+
+			// WHEN
+			err := opts.Run()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedPackaged, packaged)
+			if tc.wantedReplicated == nil {
+				tc.wantedReplicated = make(map[string]map[string]string)
+			}
+			require.Equal(t, tc.wantedReplicated, replicated)
+			require.ElementsMatch(t, tc.wantedExecuted, executed)
+		})
+	}
+}
+
+func TestPinnedImages(t *testing.T) {
+	require.Nil(t, pinnedImages(nil))
+	require.Nil(t, pinnedImages(&clideploy.UploadArtifactsOutput{}))
+	require.Equal(t, map[string]string{
+		"fe":    "123456789012.dkr.ecr.us-east-1.amazonaws.com/app/fe@sha256:abc",
+		"nginx": "123456789012.dkr.ecr.us-east-1.amazonaws.com/app/fe@sha256:def",
+	}, pinnedImages(&clideploy.UploadArtifactsOutput{
+		ImageRepositoryURI: "123456789012.dkr.ecr.us-east-1.amazonaws.com/app/fe",
+		ImageDigests: map[string]clideploy.ContainerImageIdentifier{
+			"fe":    {Digest: "sha256:abc"},
+			"nginx": {Digest: "sha256:def"},
+		},
+	}))
+}
@@ -0,0 +1,582 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/profile"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/servicequotas"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+)
+
+const (
+	// Fargate On-Demand vCPUs used by the running tasks of the account in the region.
+	fargateServiceCode   = "fargate"
+	fargateVCPUQuotaCode = "L-3032A538"
+
+	albRulesAccountLimit = "rules-per-application-load-balancer"
+
+	// Usage above which a quota is reported as close to its limit.
+	quotaWarningThreshold = 0.8
+	quotaUsagePeriod      = 15 * time.Minute
+)
+
+// doctorRequiredActions are the actions that the credentials of the user must be allowed to call to manage applications.
+// The resources of the stacks are managed by the CloudFormation execution roles of the applications and environments.
+var doctorRequiredActions = []string{
+	"cloudformation:CreateChangeSet",
+	"cloudformation:DescribeChangeSet",
+	"cloudformation:ExecuteChangeSet",
+	"cloudformation:DescribeStacks",
+	"cloudformation:DescribeStackEvents",
+	"cloudformation:DeleteStack",
+	"cloudformation:UpdateStackSet",
+	"ssm:GetParameter",
+	"ssm:GetParametersByPath",
+	"ssm:PutParameter",
+	"iam:PassRole",
+	"sts:AssumeRole",
+	"ecr:GetAuthorizationToken",
+	"s3:PutObject",
+	"tag:GetResources",
+}
+
+type doctorCheckStatus int
+
+const (
+	doctorCheckPassed doctorCheckStatus = iota
+	doctorCheckWarning
+	doctorCheckFailed
+)
+
+// doctorCheck is the outcome of a diagnostic, with the action that fixes it if it didn't pass.
+type doctorCheck struct {
+	status  doctorCheckStatus
+	summary string
+	fix     string
+}
+
+type doctorVars struct {
+	appName string
+	builder string
+}
+
+type doctorOpts struct {
+	doctorVars
+
+	w       io.Writer
+	now     func() time.Time
+	profile string // Name of the AWS profile of the default session.
+
+	docker      imageBuilderChecker
+	credsSource func() (string, error)
+	roleChain   func(name string) []profile.AssumedRole
+	identity    identityService
+	policies    policySimulator
+	quotas      serviceQuotaGetter
+	metrics     metricMaximumGetter
+	lbRules     loadBalancerRuleCounter
+	rg          resourcesByTagsGetter
+	store       store
+	ws          wsDoctorReader // Nil if the command isn't run from a workspace.
+}
+
+func newDoctorOpts(vars doctorVars) (*doctorOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("doctor"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	docker, err := dockerengine.NewWithBuilder(exec.NewCmd(), vars.builder)
+	if err != nil {
+		return nil, err
+	}
+	opts := &doctorOpts{
+		doctorVars: vars,
+		w:          log.OutputWriter,
+		now:        time.Now,
+		profile:    profileName(),
+		docker:     docker,
+		credsSource: func() (string, error) {
+			creds, err := sessions.Creds(defaultSess)
+			if err != nil {
+				return "", err
+			}
+			return creds.ProviderName, nil
+		},
+		roleChain: func(name string) []profile.AssumedRole {
+			cfg, err := profile.NewConfig()
+			if err != nil {
+				return nil
+			}
+			return cfg.RoleChain(name)
+		},
+		identity: identity.New(defaultSess),
+		policies: iam.New(defaultSess),
+		quotas:   servicequotas.New(defaultSess),
+		metrics:  cloudwatch.New(defaultSess),
+		lbRules:  elbv2.New(defaultSess),
+		rg:       resourcegroups.New(defaultSess),
+		store:    config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region)),
+	}
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		var errNoWorkspace *workspace.ErrWorkspaceNotFound
+		if !errors.As(err, &errNoWorkspace) {
+			return nil, err
+		}
+	} else {
+		opts.ws = ws
+	}
+	return opts, nil
+}
+
+// profileName returns the name of the AWS profile that the default session is created from.
+func profileName() string {
+	for _, env := range []string{"AWS_PROFILE", "AWS_DEFAULT_PROFILE"} {
+		if name := os.Getenv(env); name != "" {
+			return name
+		}
+	}
+	return session.DefaultSharedConfigProfile
+}
+
+// Validate is a no-op for this command.
+func (o *doctorOpts) Validate() error {
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *doctorOpts) Ask() error {
+	return nil
+}
+
+// Execute runs the diagnostics and prints how to fix the ones that didn't pass.
+func (o *doctorOpts) Execute() error {
+	var failed int
+	report := func(title string, checks []doctorCheck) {
+		fmt.Fprintln(o.w, color.Emphasize(title))
+		for _, check := range checks {
+			switch check.status {
+			case doctorCheckPassed:
+				fmt.Fprintf(o.w, "  %s", log.Ssuccessf("%s\n", check.summary))
+			case doctorCheckWarning:
+				fmt.Fprintf(o.w, "  %s", log.Swarningf("%s\n", check.summary))
+			case doctorCheckFailed:
+				failed++
+				fmt.Fprintf(o.w, "  %s", log.Serrorf("%s\n", check.summary))
+			}
+			if check.fix != "" {
+				fmt.Fprintf(o.w, "    Fix: %s\n", check.fix)
+			}
+		}
+		fmt.Fprintln(o.w)
+	}
+
+	report("Container builder", o.checkBuilder())
+	credChecks, caller := o.checkCredentials()
+	report("AWS credentials", credChecks)
+	if caller != nil {
+		report("IAM permissions", o.checkPermissions(caller))
+		report("Service quotas", o.checkQuotas())
+	}
+	report("Workspace", o.checkWorkspace())
+	if failed > 0 {
+		return fmt.Errorf("%s failed", english.Plural(failed, "check", "checks"))
+	}
+	return nil
+}
+
+func (o *doctorOpts) checkBuilder() []doctorCheck {
+	builder := o.docker.Builder()
+	if err := o.docker.CheckDockerEngineRunning(); err != nil {
+		return []doctorCheck{{
+			status:  doctorCheckFailed,
+			summary: fmt.Sprintf("%s isn't available: %v", builder, err),
+			fix:     fmt.Sprintf("Install and start %s, choose another tool with --builder, or build images remotely with `copilot svc deploy --build-remote`.", builder),
+		}}
+	}
+	return []doctorCheck{{
+		status:  doctorCheckPassed,
+		summary: fmt.Sprintf("%s is running.", builder),
+	}}
+}
+
+// checkCredentials returns the checks of the credentials of the default session, and the identity of the caller if the credentials are valid.
+func (o *doctorOpts) checkCredentials() ([]doctorCheck, *identity.Caller) {
+	source, err := o.credsSource()
+	if err != nil {
+		return []doctorCheck{{
+			status:  doctorCheckFailed,
+			summary: fmt.Sprintf("Credentials of profile %q can't be retrieved: %v", o.profile, err),
+			fix:     "Run `aws configure`, or `aws sso login` if the profile uses IAM Identity Center.",
+		}}, nil
+	}
+	checks := []doctorCheck{{
+		status:  doctorCheckPassed,
+		summary: fmt.Sprintf("Credentials of profile %q are retrieved from %s.", o.profile, source),
+	}}
+	if source != session.EnvProviderName {
+		if chain := o.roleChain(o.profile); len(chain) > 0 {
+			roles := make([]string, len(chain))
+			for i, role := range chain {
+				roles[i] = fmt.Sprintf("%s (profile %s)", role.RoleARN, role.Profile)
+			}
+			checks = append(checks, doctorCheck{
+				status:  doctorCheckPassed,
+				summary: fmt.Sprintf("Assumed roles: %s.", strings.Join(roles, " -> ")),
+			})
+		}
+	}
+	caller, err := o.identity.Get()
+	if err != nil {
+		return append(checks, doctorCheck{
+			status:  doctorCheckFailed,
+			summary: fmt.Sprintf("Credentials are rejected by AWS: %v", err),
+			fix:     "Refresh expired credentials, and check that every role in the chain trusts the previous one.",
+		}), nil
+	}
+	return append(checks, doctorCheck{
+		status:  doctorCheckPassed,
+		summary: fmt.Sprintf("Signed in as %s in account %s.", caller.ARN, caller.Account),
+	}), &caller
+}
+
+func (o *doctorOpts) checkPermissions(caller *identity.Caller) []doctorCheck {
+	if caller.ARN == caller.RootUserARN {
+		return []doctorCheck{{
+			status:  doctorCheckWarning,
+			summary: "Signed in as the root user, which is allowed to call every action.",
+			fix:     "Use an IAM role or user instead of the root user.",
+		}}
+	}
+	denied, err := o.policies.DeniedActions(caller.ARN, doctorRequiredActions)
+	if err != nil {
+		return []doctorCheck{{
+			status:  doctorCheckWarning,
+			summary: fmt.Sprintf("Permissions can't be simulated: %v", err),
+			fix:     "Allow iam:SimulatePrincipalPolicy to check the permissions of your credentials.",
+		}}
+	}
+	if len(denied) > 0 {
+		return []doctorCheck{{
+			status:  doctorCheckFailed,
+			summary: fmt.Sprintf("%s isn't allowed to call %s.", caller.ARN, english.WordSeries(denied, "and")),
+			fix:     "Attach a policy that allows these actions to your IAM role or user.",
+		}}
+	}
+	return []doctorCheck{{
+		status:  doctorCheckPassed,
+		summary: fmt.Sprintf("Allowed to call the %d actions used to manage applications.", len(doctorRequiredActions)),
+	}}
+}
+
+func (o *doctorOpts) checkQuotas() []doctorCheck {
+	checks := []doctorCheck{o.checkFargateQuota()}
+	if o.appName != "" {
+		checks = append(checks, o.checkALBRulesQuota()...)
+	}
+	return checks
+}
+
+func (o *doctorOpts) checkFargateQuota() doctorCheck {
+	quota, err := o.quotas.Quota(fargateServiceCode, fargateVCPUQuotaCode)
+	if err != nil {
+		return doctorCheck{
+			status:  doctorCheckWarning,
+			summary: fmt.Sprintf("The quota of Fargate vCPUs can't be retrieved: %v", err),
+		}
+	}
+	end := o.now().Truncate(time.Minute)
+	used, err := o.metrics.MetricMaximum(cloudwatch.MetricQuery{
+		Namespace: "AWS/Usage",
+		Name:      "ResourceCount",
+		Dimensions: map[string]string{
+			"Service":  "Fargate",
+			"Type":     "Resource",
+			"Resource": "vCPU",
+			"Class":    "Standard/OnDemand",
+		},
+		StartTime: end.Add(-quotaUsagePeriod),
+		EndTime:   end,
+	})
+	if err != nil {
+		return doctorCheck{
+			status:  doctorCheckWarning,
+			summary: fmt.Sprintf("The usage of Fargate vCPUs can't be retrieved: %v", err),
+		}
+	}
+	return quotaCheck("Fargate On-Demand vCPUs", aws.Float64Value(used), quota,
+		"Request an increase of the quota \"Fargate On-Demand vCPU resource count\" in the Service Quotas console.")
+}
+
+func (o *doctorOpts) checkALBRulesQuota() []doctorCheck {
+	resources, err := o.rg.GetResourcesByTags(resourcegroups.ResourceTypeLoadBalancer, map[string]string{
+		deploy.AppTagKey: o.appName,
+	})
+	if err != nil {
+		return []doctorCheck{{
+			status:  doctorCheckWarning,
+			summary: fmt.Sprintf("The load balancers of application %s can't be listed: %v", o.appName, err),
+		}}
+	}
+	var albs []string
+	for _, resource := range resources {
+		if strings.Contains(resource.ARN, ":loadbalancer/app/") {
+			albs = append(albs, resource.ARN)
+		}
+	}
+	if len(albs) == 0 {
+		return nil
+	}
+	limit, err := o.lbRules.AccountLimit(albRulesAccountLimit)
+	if err != nil {
+		return []doctorCheck{{
+			status:  doctorCheckWarning,
+			summary: fmt.Sprintf("The quota of listener rules per load balancer can't be retrieved: %v", err),
+		}}
+	}
+	var checks []doctorCheck
+	for _, alb := range albs {
+		count, err := o.lbRules.ListenerRuleCount(alb)
+		if err != nil {
+			checks = append(checks, doctorCheck{
+				status:  doctorCheckWarning,
+				summary: fmt.Sprintf("The listener rules of load balancer %s can't be counted: %v", alb, err),
+			})
+			continue
+		}
+		checks = append(checks, quotaCheck(fmt.Sprintf("Listener rules of load balancer %s", loadBalancerName(alb)), float64(count), float64(limit),
+			"Request an increase of the quota \"Rules per Application Load Balancer\", or spread services across environments."))
+	}
+	return checks
+}
+
+// quotaCheck reports the headroom of a quota.
+func quotaCheck(name string, used, quota float64, fix string) doctorCheck {
+	summary := fmt.Sprintf("%s: %g of %g used.", name, used, quota)
+	switch {
+	case used >= quota:
+		return doctorCheck{status: doctorCheckFailed, summary: summary, fix: fix}
+	case used >= quota*quotaWarningThreshold:
+		return doctorCheck{status: doctorCheckWarning, summary: summary, fix: fix}
+	default:
+		return doctorCheck{status: doctorCheckPassed, summary: summary}
+	}
+}
+
+// loadBalancerName returns the name of a load balancer from its ARN,
+// such as "demo-Publi-1A2B3C" for "arn:aws:elasticloadbalancing:us-west-2:1111:loadbalancer/app/demo-Publi-1A2B3C/50dc6c495c0c9188".
+func loadBalancerName(lbARN string) string {
+	parts := strings.Split(lbARN, "/")
+	if len(parts) < 3 {
+		return lbARN
+	}
+	return parts[len(parts)-2]
+}
+
+func (o *doctorOpts) checkWorkspace() []doctorCheck {
+	if o.ws == nil {
+		return []doctorCheck{{
+			status:  doctorCheckWarning,
+			summary: "Not in a workspace, so no manifest is checked.",
+			fix:     "Run `copilot doctor` from the root of your workspace.",
+		}}
+	}
+	summary, err := o.ws.Summary()
+	if err != nil {
+		return []doctorCheck{{
+			status:  doctorCheckFailed,
+			summary: fmt.Sprintf("The workspace isn't associated with an application: %v", err),
+			fix:     "Run `copilot app init` to create an application for the workspace.",
+		}}
+	}
+	appName := summary.Application
+	if _, err := o.store.GetApplication(appName); err != nil {
+		return []doctorCheck{{
+			status:  doctorCheckFailed,
+			summary: fmt.Sprintf("Application %s of the workspace can't be found in the account: %v", appName, err),
+			fix:     "Check that your credentials and region are those of the application, or run `copilot app init` to create it.",
+		}}
+	}
+	checks := []doctorCheck{{
+		status:  doctorCheckPassed,
+		summary: fmt.Sprintf("Application %s of the workspace exists.", appName),
+	}}
+	checks = append(checks, o.checkWorkloadManifests(appName)...)
+	return append(checks, o.checkEnvironmentManifests(appName)...)
+}
+
+func (o *doctorOpts) checkWorkloadManifests(appName string) []doctorCheck {
+	var checks []doctorCheck
+	registered := make(map[string]bool)
+	workloads, err := o.store.ListWorkloads(appName)
+	if err != nil {
+		return []doctorCheck{{
+			status:  doctorCheckWarning,
+			summary: fmt.Sprintf("The workloads of application %s can't be listed: %v", appName, err),
+		}}
+	}
+	for _, wkld := range workloads {
+		registered[wkld.Name] = true
+	}
+	for _, kind := range []struct {
+		name  string
+		title string
+		cmd   string
+		list  func() ([]string, error)
+	}{
+		{name: "service", title: "Service", cmd: "svc", list: o.ws.ListServices},
+		{name: "job", title: "Job", cmd: "job", list: o.ws.ListJobs},
+	} {
+		names, err := kind.list()
+		if err != nil {
+			checks = append(checks, doctorCheck{
+				status:  doctorCheckWarning,
+				summary: fmt.Sprintf("The %ss of the workspace can't be listed: %v", kind.name, err),
+			})
+			continue
+		}
+		for _, name := range names {
+			raw, err := o.ws.ReadWorkloadManifest(name)
+			if err != nil {
+				checks = append(checks, doctorCheck{
+					status:  doctorCheckFailed,
+					summary: fmt.Sprintf("The manifest of %s %s can't be read: %v", kind.name, name, err),
+				})
+				continue
+			}
+			checks = append(checks, manifestCheck(fmt.Sprintf("%s %s", kind.name, name), manifest.CheckWorkload(raw, appName, ""),
+				fmt.Sprintf("Run `copilot %s validate -n %s` to list every problem.", kind.cmd, name)))
+			if !registered[name] {
+				checks = append(checks, doctorCheck{
+					status:  doctorCheckWarning,
+					summary: fmt.Sprintf("%s %s has a manifest but isn't part of application %s.", kind.title, name, appName),
+					fix:     fmt.Sprintf("Run `copilot %s init -n %s` to add it to the application.", kind.cmd, name),
+				})
+			}
+		}
+	}
+	return checks
+}
+
+func (o *doctorOpts) checkEnvironmentManifests(appName string) []doctorCheck {
+	names, err := o.ws.ListEnvironments()
+	if err != nil {
+		return []doctorCheck{{
+			status:  doctorCheckWarning,
+			summary: fmt.Sprintf("The environments of the workspace can't be listed: %v", err),
+		}}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	envs, err := o.store.ListEnvironments(appName)
+	if err != nil {
+		return []doctorCheck{{
+			status:  doctorCheckWarning,
+			summary: fmt.Sprintf("The environments of application %s can't be listed: %v", appName, err),
+		}}
+	}
+	registered := make(map[string]bool)
+	for _, env := range envs {
+		registered[env.Name] = true
+	}
+	var checks []doctorCheck
+	for _, name := range names {
+		raw, err := o.ws.ReadEnvironmentManifest(name)
+		if err != nil {
+			checks = append(checks, doctorCheck{
+				status:  doctorCheckFailed,
+				summary: fmt.Sprintf("The manifest of environment %s can't be read: %v", name, err),
+			})
+			continue
+		}
+		checks = append(checks, manifestCheck(fmt.Sprintf("environment %s", name), manifest.CheckEnvironment(raw, appName, name),
+			fmt.Sprintf("Run `copilot env validate -n %s` to list every problem.", name)))
+		if !registered[name] {
+			checks = append(checks, doctorCheck{
+				status:  doctorCheckWarning,
+				summary: fmt.Sprintf("Environment %s has a manifest but isn't part of application %s.", name, appName),
+				fix:     fmt.Sprintf("Run `copilot env init -n %s` to add it to the application.", name),
+			})
+		}
+	}
+	return checks
+}
+
+// manifestCheck reports the first problem found in the manifest of a workload or an environment.
+func manifestCheck(subject string, problems []*manifest.ValidationError, fix string) doctorCheck {
+	if len(problems) == 0 {
+		return doctorCheck{
+			status:  doctorCheckPassed,
+			summary: fmt.Sprintf("The manifest of %s is valid.", subject),
+		}
+	}
+	return doctorCheck{
+		status:  doctorCheckFailed,
+		summary: fmt.Sprintf("The manifest of %s has %s, starting with %s", subject, english.Plural(len(problems), "problem", "problems"), problems[0].Error()),
+		fix:     fix,
+	}
+}
+
+// BuildDoctorCmd builds the command for diagnosing the local setup and the AWS account before deploying.
+func BuildDoctorCmd() *cobra.Command {
+	vars := doctorVars{}
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Diagnose your local setup and AWS account before deploying.",
+		Long: `Diagnose your local setup and AWS account before deploying.
+Checks the container builder, your AWS credentials and assumed roles, the permissions of your credentials,
+the headroom of the Fargate vCPU and load balancer rule quotas, and the manifests of the workspace.
+Each problem is printed with the action that fixes it.`,
+		Example: `
+  Diagnose the setup of the application of the workspace.
+  /code $ copilot doctor
+  Diagnose the setup of application "phonetool" with podman as the image builder.
+  /code $ copilot doctor -a phonetool --builder podman`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDoctorOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+		Annotations: map[string]string{
+			"group": group.Settings,
+		},
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.builder, builderFlag, "", doctorBuilderFlagDescription)
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,222 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/profile"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+)
+
+type doctorMocks struct {
+	docker   *mocks.MockimageBuilderChecker
+	identity *mocks.MockidentityService
+	policies *mocks.MockpolicySimulator
+	quotas   *mocks.MockserviceQuotaGetter
+	metrics  *mocks.MockmetricMaximumGetter
+	lbRules  *mocks.MockloadBalancerRuleCounter
+	rg       *mocks.MockresourcesByTagsGetter
+	store    *mocks.Mockstore
+	ws       *mocks.MockwsDoctorReader
+}
+
+func TestDoctorOpts_Execute(t *testing.T) {
+	const (
+		callerARN = "arn:aws:sts::123456789012:assumed-role/Deployer/bob"
+		albARN    = "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/phonet-Publi-1A2B3C/50dc6c495c0c9188"
+	)
+	caller := identity.Caller{
+		ARN:         callerARN,
+		RootUserARN: "arn:aws:iam::123456789012:root",
+		Account:     "123456789012",
+	}
+	validSvcManifest := []byte(`name: frontend
+type: Backend Service
+image:
+  location: nginx
+`)
+	testCases := map[string]struct {
+		inApp         string
+		inCredsErr    error
+		inRoleChain   []profile.AssumedRole
+		notWorkspace  bool
+		setupMocks    func(m doctorMocks)
+		wantedOutput  string
+		wantedContent []string
+		wantedError   error
+	}{
+		"every check passes": {
+			inApp: "phonetool",
+			inRoleChain: []profile.AssumedRole{
+				{Profile: "deployer", RoleARN: "arn:aws:iam::123456789012:role/Deployer"},
+			},
+			setupMocks: func(m doctorMocks) {
+				m.rg.EXPECT().GetResourcesByTags(resourcegroups.ResourceTypeLoadBalancer, map[string]string{"copilot-application": "phonetool"}).
+					Return([]*resourcegroups.Resource{
+						{ARN: albARN},
+						{ARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/net/phonet-Netwo-1A2B3C/50dc6c495c0c9188"},
+					}, nil)
+				m.lbRules.EXPECT().AccountLimit("rules-per-application-load-balancer").Return(100, nil)
+				m.lbRules.EXPECT().ListenerRuleCount(albARN).Return(12, nil)
+				m.ws.EXPECT().ListServices().Return([]string{"frontend"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return(workspace.WorkloadManifest(validSvcManifest), nil)
+			},
+			wantedOutput: `Container builder
+  ✔ docker is running.
+
+AWS credentials
+  ✔ Credentials of profile "deployer" are retrieved from SharedConfigCredentials.
+  ✔ Assumed roles: arn:aws:iam::123456789012:role/Deployer (profile deployer).
+  ✔ Signed in as arn:aws:sts::123456789012:assumed-role/Deployer/bob in account 123456789012.
+
+IAM permissions
+  ✔ Allowed to call the 15 actions used to manage applications.
+
+Service quotas
+  ✔ Fargate On-Demand vCPUs: 8 of 4000 used.
+  ✔ Listener rules of load balancer phonet-Publi-1A2B3C: 12 of 100 used.
+
+Workspace
+  ✔ Application phonetool of the workspace exists.
+  ✔ The manifest of service frontend is valid.
+
+`,
+		},
+		"skips the account checks if the credentials can't be retrieved": {
+			inCredsErr:   errors.New("no credentials"),
+			notWorkspace: true,
+			setupMocks: func(m doctorMocks) {
+				m.identity.EXPECT().Get().Times(0)
+				m.policies.EXPECT().DeniedActions(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedContent: []string{
+				`✘ Credentials of profile "deployer" can't be retrieved: no credentials`,
+				"Fix: Run `aws configure`",
+				"Note: Not in a workspace, so no manifest is checked.",
+			},
+			wantedError: errors.New("1 check failed"),
+		},
+		"reports the problems with their fix": {
+			setupMocks: func(m doctorMocks) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(errors.New("docker daemon is not responsive"))
+				m.policies.EXPECT().DeniedActions(callerARN, doctorRequiredActions).Return([]string{"iam:PassRole", "s3:PutObject"}, nil)
+				m.quotas.EXPECT().Quota("fargate", "L-3032A538").Return(float64(10), nil)
+				m.ws.EXPECT().ListServices().Return([]string{"frontend", "api"}, nil)
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return(workspace.WorkloadManifest(validSvcManifest), nil)
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return(workspace.WorkloadManifest(`name: api
+type: Backend Service
+image:
+  location: nginx
+cpu: many
+`), nil)
+				m.ws.EXPECT().ListEnvironments().Return([]string{"test"}, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return(workspace.EnvironmentManifest(`name: test
+type: Environment
+`), nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+			},
+			wantedContent: []string{
+				"✘ docker isn't available: docker daemon is not responsive",
+				"✘ arn:aws:sts::123456789012:assumed-role/Deployer/bob isn't allowed to call iam:PassRole and s3:PutObject.",
+				"Note: Fargate On-Demand vCPUs: 8 of 10 used.",
+				"Fix: Request an increase of the quota \"Fargate On-Demand vCPU resource count\"",
+				"✘ The manifest of service api has 1 problem, starting with line 5:",
+				"Fix: Run `copilot svc validate -n api` to list every problem.",
+				"Note: Service api has a manifest but isn't part of application phonetool.",
+				"✔ The manifest of environment test is valid.",
+				"Fix: Run `copilot env init -n test` to add it to the application.",
+			},
+			wantedError: errors.New("3 checks failed"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := doctorMocks{
+				docker:   mocks.NewMockimageBuilderChecker(ctrl),
+				identity: mocks.NewMockidentityService(ctrl),
+				policies: mocks.NewMockpolicySimulator(ctrl),
+				quotas:   mocks.NewMockserviceQuotaGetter(ctrl),
+				metrics:  mocks.NewMockmetricMaximumGetter(ctrl),
+				lbRules:  mocks.NewMockloadBalancerRuleCounter(ctrl),
+				rg:       mocks.NewMockresourcesByTagsGetter(ctrl),
+				store:    mocks.NewMockstore(ctrl),
+				ws:       mocks.NewMockwsDoctorReader(ctrl),
+			}
+			tc.setupMocks(m)
+			m.docker.EXPECT().Builder().Return("docker").AnyTimes()
+			m.docker.EXPECT().CheckDockerEngineRunning().Return(nil).AnyTimes()
+			m.identity.EXPECT().Get().Return(caller, nil).AnyTimes()
+			m.policies.EXPECT().DeniedActions(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			m.quotas.EXPECT().Quota(gomock.Any(), gomock.Any()).Return(float64(4000), nil).AnyTimes()
+			m.metrics.EXPECT().MetricMaximum(gomock.Any()).Return(aws.Float64(8), nil).AnyTimes()
+			m.ws.EXPECT().Summary().Return(&workspace.Summary{Application: "phonetool"}, nil).AnyTimes()
+			m.ws.EXPECT().ListJobs().Return(nil, nil).AnyTimes()
+			m.ws.EXPECT().ListEnvironments().Return(nil, nil).AnyTimes()
+			m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil).AnyTimes()
+			m.store.EXPECT().ListWorkloads("phonetool").Return([]*config.Workload{{Name: "frontend"}}, nil).AnyTimes()
+
+			buf := new(bytes.Buffer)
+			opts := &doctorOpts{
+				doctorVars: doctorVars{
+					appName: tc.inApp,
+				},
+				w:       buf,
+				now:     func() time.Time { return time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC) },
+				profile: "deployer",
+				docker:  m.docker,
+				credsSource: func() (string, error) {
+					if tc.inCredsErr != nil {
+						return "", tc.inCredsErr
+					}
+					return "SharedConfigCredentials", nil
+				},
+				roleChain: func(name string) []profile.AssumedRole {
+					return tc.inRoleChain
+				},
+				identity: m.identity,
+				policies: m.policies,
+				quotas:   m.quotas,
+				metrics:  m.metrics,
+				lbRules:  m.lbRules,
+				rg:       m.rg,
+				store:    m.store,
+			}
+			if !tc.notWorkspace {
+				opts.ws = m.ws
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.wantedOutput != "" {
+				require.Equal(t, tc.wantedOutput, buf.String())
+			}
+			for _, content := range tc.wantedContent {
+				require.Contains(t, buf.String(), content)
+			}
+		})
+	}
+}
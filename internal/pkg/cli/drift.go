@@ -0,0 +1,130 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	templatediff "github.com/aws/copilot-cli/internal/pkg/template/diff"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+const (
+	fmtDriftDetectionStart    = "Detecting drift of stack %s."
+	fmtDriftDetectionFailed   = "Failed to detect drift of stack %s.\n"
+	fmtDriftDetectionComplete = "Detected drift of stack %s.\n"
+)
+
+// stackDrift holds the resources of a stack whose configuration drifted from the stack's template.
+type stackDrift struct {
+	Stack     string           `json:"stack"`
+	Resources []*resourceDrift `json:"resources"`
+}
+
+// resourceDrift holds the differences between the expected and the actual properties of a drifted resource.
+type resourceDrift struct {
+	LogicalID  string                `json:"logicalID"`
+	Type       string                `json:"type"`
+	PhysicalID string                `json:"physicalID"`
+	Status     string                `json:"status"`
+	Changes    []templatediff.Change `json:"changes,omitempty"`
+
+	tree templatediff.Tree
+}
+
+// errHasDrift is returned when a stack drifted so that the command exits with a non-zero code.
+type errHasDrift struct {
+	stack string
+}
+
+func (e *errHasDrift) Error() string {
+	return fmt.Sprintf("Drift detected in stack %s.", e.stack)
+}
+
+// ExitCode returns 1 for a stack with drifted resources.
+func (e *errHasDrift) ExitCode() int {
+	return 1
+}
+
+// detectStackDrift detects drift on the stack and parses the expected and actual properties of the drifted resources.
+func detectStackDrift(detector stackDriftDetector, prog progress, stackName string) (*stackDrift, error) {
+	prog.Start(fmt.Sprintf(fmtDriftDetectionStart, stackName))
+	drifts, err := detector.DetectDrift(context.Background(), stackName)
+	if err != nil {
+		prog.Stop(log.Serrorf(fmtDriftDetectionFailed, stackName))
+		return nil, err
+	}
+	prog.Stop(log.Ssuccessf(fmtDriftDetectionComplete, stackName))
+	out := &stackDrift{
+		Stack:     stackName,
+		Resources: []*resourceDrift{},
+	}
+	for _, drift := range drifts {
+		resource := &resourceDrift{
+			LogicalID:  aws.StringValue(drift.LogicalResourceId),
+			Type:       aws.StringValue(drift.ResourceType),
+			PhysicalID: aws.StringValue(drift.PhysicalResourceId),
+			Status:     aws.StringValue(drift.StackResourceDriftStatus),
+		}
+		if resource.Status == awscfn.StackResourceDriftStatusModified {
+			tree, err := templatediff.From(aws.StringValue(drift.ExpectedProperties)).Parse([]byte(aws.StringValue(drift.ActualProperties)))
+			if err != nil {
+				return nil, fmt.Errorf("parse properties of drifted resource %s: %w", resource.LogicalID, err)
+			}
+			changes, err := tree.Changes()
+			if err != nil {
+				return nil, fmt.Errorf("list drifted properties of resource %s: %w", resource.LogicalID, err)
+			}
+			resource.tree, resource.Changes = tree, changes
+		}
+		out.Resources = append(out.Resources, resource)
+	}
+	return out, nil
+}
+
+// write writes the drifted resources in JSON format if asJSON is true, otherwise with the diff tree of their properties.
+// It returns errHasDrift if any resource drifted.
+func (d *stackDrift) write(w io.Writer, asJSON bool) error {
+	if asJSON {
+		if err := json.NewEncoder(w).Encode(d); err != nil {
+			return fmt.Errorf("write drift of stack %s in JSON: %w", d.Stack, err)
+		}
+	} else if err := d.writeHuman(w); err != nil {
+		return err
+	}
+	if len(d.Resources) != 0 {
+		return &errHasDrift{stack: d.Stack}
+	}
+	return nil
+}
+
+func (d *stackDrift) writeHuman(w io.Writer) error {
+	if len(d.Resources) == 0 {
+		_, err := fmt.Fprintf(w, "No drift detected in stack %s.\n", d.Stack)
+		return err
+	}
+	fmt.Fprintf(w, "%s\n\n", color.Bold.Sprintf("Drifted resources of stack %s", d.Stack))
+	for _, resource := range d.Resources {
+		fmt.Fprintf(w, "%s (%s) %s\n", resource.LogicalID, resource.Type, strings.ToLower(resource.Status))
+		if resource.Status != awscfn.StackResourceDriftStatusModified {
+			continue
+		}
+		var buf bytes.Buffer
+		if err := resource.tree.Write(&buf, templatediff.WithColor(color.EnabledFor(w))); err != nil {
+			return fmt.Errorf("write drifted properties of resource %s: %w", resource.LogicalID, err)
+		}
+		for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+			fmt.Fprintf(w, "    %s\n", line)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStackDrift_write(t *testing.T) {
+	mockDrifts := []cloudformation.StackResourceDrift{
+		{
+			LogicalResourceId:        aws.String("PublicHTTPLoadBalancerSecurityGroup"),
+			PhysicalResourceId:       aws.String("sg-1234"),
+			ResourceType:             aws.String("AWS::EC2::SecurityGroup"),
+			StackResourceDriftStatus: aws.String("MODIFIED"),
+			ExpectedProperties:       aws.String(`{"GroupDescription":"HTTP access to the public facing load balancer","SecurityGroupIngress":[{"CidrIp":"0.0.0.0/0","FromPort":80,"IpProtocol":"tcp","ToPort":80}]}`),
+			ActualProperties:         aws.String(`{"GroupDescription":"HTTP access to the public facing load balancer","SecurityGroupIngress":[{"CidrIp":"10.0.0.0/8","FromPort":80,"IpProtocol":"tcp","ToPort":80}]}`),
+		},
+		{
+			LogicalResourceId:        aws.String("LogGroup"),
+			PhysicalResourceId:       aws.String("/copilot/phonetool-test-api"),
+			ResourceType:             aws.String("AWS::Logs::LogGroup"),
+			StackResourceDriftStatus: aws.String("DELETED"),
+			ExpectedProperties:       aws.String(`{"LogGroupName":"/copilot/phonetool-test-api"}`),
+		},
+	}
+	testCases := map[string]struct {
+		inDrifts []cloudformation.StackResourceDrift
+		inJSON   bool
+
+		wantedOutput string
+		wantedErr    error
+	}{
+		"write that no drift is detected": {
+			wantedOutput: "No drift detected in stack phonetool-test-api.\n",
+		},
+		"write an empty list of resources in JSON": {
+			inJSON:       true,
+			wantedOutput: `{"stack":"phonetool-test-api","resources":[]}` + "\n",
+		},
+		"write the drifted properties of the resources": {
+			inDrifts: mockDrifts,
+			wantedOutput: `Drifted resources of stack phonetool-test-api
+
+PublicHTTPLoadBalancerSecurityGroup (AWS::EC2::SecurityGroup) modified
+    ~ SecurityGroupIngress:
+        ~ - (changed item)
+          ~ CidrIp: 0.0.0.0/0 -> 10.0.0.0/8
+LogGroup (AWS::Logs::LogGroup) deleted
+`,
+			wantedErr: errors.New("Drift detected in stack phonetool-test-api."),
+		},
+		"write the drifted properties of the resources in JSON": {
+			inDrifts:     mockDrifts,
+			inJSON:       true,
+			wantedOutput: `{"stack":"phonetool-test-api","resources":[{"logicalID":"PublicHTTPLoadBalancerSecurityGroup","type":"AWS::EC2::SecurityGroup","physicalID":"sg-1234","status":"MODIFIED","changes":[{"action":"modify","path":"/SecurityGroupIngress/0/CidrIp","old":"0.0.0.0/0","new":"10.0.0.0/8"}]},{"logicalID":"LogGroup","type":"AWS::Logs::LogGroup","physicalID":"/copilot/phonetool-test-api","status":"DELETED"}]}` + "\n",
+			wantedErr:    errors.New("Drift detected in stack phonetool-test-api."),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			detector := mocks.NewMockstackDriftDetector(ctrl)
+			prog := mocks.NewMockprogress(ctrl)
+			prog.EXPECT().Start(gomock.Any())
+			prog.EXPECT().Stop(gomock.Any())
+			detector.EXPECT().DetectDrift(gomock.Any(), "phonetool-test-api").Return(tc.inDrifts, nil)
+			drift, err := detectStackDrift(detector, prog, "phonetool-test-api")
+			require.NoError(t, err)
+			var buf bytes.Buffer
+
+			// WHEN
+			err = drift.write(&buf, tc.inJSON)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedOutput, buf.String())
+		})
+	}
+}
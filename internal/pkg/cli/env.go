@@ -46,6 +46,10 @@ Environments are deployment stages shared between services.`,
 	cmd.AddCommand(buildEnvOverrideCmd())
 	cmd.AddCommand(buildEnvDeployCmd())
 	cmd.AddCommand(buildEnvDeleteCmd())
+	cmd.AddCommand(buildEnvStopCmd())
+	cmd.AddCommand(buildEnvValidateCmd())
+	cmd.AddCommand(buildEnvDriftCmd())
+	cmd.AddCommand(buildEnvDashboardCmd())
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
 		"group": group.Develop,
@@ -0,0 +1,242 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envDashboardAppNameHelpPrompt = "A CloudWatch dashboard will be generated for an environment in the selected application."
+	envDashboardNamePrompt        = "Which environment would you like to generate a dashboard for?"
+
+	fmtEnvDashboardStart    = "Generating dashboard %s for environment %s."
+	fmtEnvDashboardFailed   = "Failed to generate dashboard %s for environment %s.\n"
+	fmtEnvDashboardComplete = "Generated dashboard %s for environment %s.\n"
+
+	fmtEnvDashboardURL = "https://%[1]s.console.aws.amazon.com/cloudwatch/home?region=%[1]s#dashboards:name=%[2]s"
+)
+
+var envDashboardAppNamePrompt = fmt.Sprintf("In which %s would you like to generate a dashboard?", color.Emphasize("application"))
+
+type dashboardEnvVars struct {
+	appName string
+	name    string
+}
+
+type dashboardEnvOpts struct {
+	dashboardEnvVars
+
+	store       store
+	deployStore deployedEnvironmentLister
+	sel         configSelector
+	prog        progress
+
+	// Clients initialized with the environment manager role.
+	region    string
+	ecs       serviceDescriber
+	dashboard dashboardPutter
+
+	// initRuntimeClients is overridden in tests.
+	initRuntimeClients func(*dashboardEnvOpts) error
+}
+
+func newDashboardEnvOpts(vars dashboardEnvVars) (*dashboardEnvOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env dashboard"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &dashboardEnvOpts{
+		dashboardEnvVars: vars,
+		store:            configStore,
+		deployStore:      deployStore,
+		sel:              selector.NewConfigSelector(prompt.New(), configStore),
+		prog:             termprogress.NewSpinner(log.DiagnosticWriter),
+		initRuntimeClients: func(o *dashboardEnvOpts) error {
+			env, err := o.store.GetEnvironment(o.appName, o.name)
+			if err != nil {
+				return fmt.Errorf("get environment %s configuration: %w", o.name, err)
+			}
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			o.region = env.Region
+			o.ecs = ecs.New(sess)
+			o.dashboard = cloudwatch.New(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the individual user inputs are invalid.
+func (o *dashboardEnvOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates the application and environment names.
+func (o *dashboardEnvOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateOrAskEnv()
+}
+
+// Execute creates or updates the CloudWatch dashboard of the environment with the
+// alarms and the utilization of every service deployed in the environment.
+func (o *dashboardEnvOpts) Execute() error {
+	if err := o.initRuntimeClients(o); err != nil {
+		return err
+	}
+	dashboard, err := o.generate()
+	if err != nil {
+		return err
+	}
+	name := o.dashboardName()
+	o.prog.Start(fmt.Sprintf(fmtEnvDashboardStart, name, o.name))
+	if err := o.dashboard.PutDashboard(name, dashboard); err != nil {
+		o.prog.Stop(log.Serrorf(fmtEnvDashboardFailed, name, o.name))
+		return err
+	}
+	o.prog.Stop(log.Ssuccessf(fmtEnvDashboardComplete, name, o.name))
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *dashboardEnvOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Open the dashboard at %s.", color.HighlightResource(fmt.Sprintf(fmtEnvDashboardURL, o.region, o.dashboardName()))),
+		fmt.Sprintf("Run %s again after deploying new services to add them to the dashboard.",
+			color.HighlightCode(fmt.Sprintf("copilot env dashboard -n %s", o.name))),
+	})
+	return nil
+}
+
+func (o *dashboardEnvOpts) dashboardName() string {
+	return fmt.Sprintf("%s-%s", o.appName, o.name)
+}
+
+// generate returns a dashboard with a row for the alarms of the environment, followed by
+// a row per ECS service graphing its CPU and memory utilization.
+func (o *dashboardEnvOpts) generate() (*cloudwatch.Dashboard, error) {
+	alarms, err := o.dashboard.AlarmStatuses(cloudwatch.WithPrefix(o.dashboardName() + "-"))
+	if err != nil {
+		return nil, fmt.Errorf("get alarms of environment %s: %w", o.name, err)
+	}
+	var rows [][]*cloudwatch.DashboardWidget
+	if len(alarms) > 0 {
+		arns := make([]string, len(alarms))
+		for i, alarm := range alarms {
+			arns[i] = alarm.Arn
+		}
+		rows = append(rows, []*cloudwatch.DashboardWidget{cloudwatch.NewAlarmWidget("Alarms", arns)})
+	}
+	svcs, err := o.deployStore.ListDeployedServices(o.appName, o.name)
+	if err != nil {
+		return nil, fmt.Errorf("list services deployed in environment %s: %w", o.name, err)
+	}
+	for _, name := range svcs {
+		svc, err := o.store.GetService(o.appName, name)
+		if err != nil {
+			return nil, fmt.Errorf("get service %s configuration: %w", name, err)
+		}
+		if !contains(svc.Type, ecsServiceTypes) {
+			log.Infof("Skipping service %s since services with type %s don't report ECS metrics.\n", name, svc.Type)
+			continue
+		}
+		desc, err := o.ecs.DescribeService(o.appName, o.name, name)
+		if err != nil {
+			return nil, fmt.Errorf("describe ECS service for %s in environment %s: %w", name, o.name, err)
+		}
+		dimensions := map[string]string{
+			"ClusterName": desc.ClusterName,
+			"ServiceName": desc.Name,
+		}
+		rows = append(rows, []*cloudwatch.DashboardWidget{
+			cloudwatch.NewMetricWidget(fmt.Sprintf("%s CPU utilization", name), o.region, "Average", cloudwatch.DashboardMetric{
+				Namespace:  "AWS/ECS",
+				Name:       "CPUUtilization",
+				Dimensions: dimensions,
+			}),
+			cloudwatch.NewMetricWidget(fmt.Sprintf("%s memory utilization", name), o.region, "Average", cloudwatch.DashboardMetric{
+				Namespace:  "AWS/ECS",
+				Name:       "MemoryUtilization",
+				Dimensions: dimensions,
+			}),
+		})
+	}
+	return cloudwatch.NewDashboard(rows...), nil
+}
+
+func (o *dashboardEnvOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(envDashboardAppNamePrompt, envDashboardAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *dashboardEnvOpts) validateOrAskEnv() error {
+	if o.name != "" {
+		_, err := o.store.GetEnvironment(o.appName, o.name)
+		return err
+	}
+	env, err := o.sel.Environment(envDashboardNamePrompt, "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.name = env
+	return nil
+}
+
+// buildEnvDashboardCmd builds the command to generate the CloudWatch dashboard of an environment.
+func buildEnvDashboardCmd() *cobra.Command {
+	vars := dashboardEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "dashboard",
+		Short: "Generates a CloudWatch dashboard for the services of an environment.",
+		Long: `Generates a CloudWatch dashboard for the services of an environment.
+The dashboard shows the alarms of the environment and the CPU and memory utilization of its services.
+Running the command again replaces the dashboard with the services that are currently deployed.`,
+		Example: `
+  Generate the dashboard of the "prod" environment.
+  /code $ copilot env dashboard --name prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDashboardEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	return cmd
+}
@@ -0,0 +1,204 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type dashboardEnvMocks struct {
+	store       *mocks.Mockstore
+	deployStore *mocks.MockdeployedEnvironmentLister
+	sel         *mocks.MockconfigSelector
+	prog        *mocks.Mockprogress
+	ecs         *mocks.MockserviceDescriber
+	dashboard   *mocks.MockdashboardPutter
+}
+
+func TestDashboardEnvOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		inEnvName  string
+		setupMocks func(m dashboardEnvMocks)
+
+		wantedAppName string
+		wantedEnvName string
+		wantedError   error
+	}{
+		"prompt for the application and the environment": {
+			setupMocks: func(m dashboardEnvMocks) {
+				m.sel.EXPECT().Application(envDashboardAppNamePrompt, envDashboardAppNameHelpPrompt).Return("phonetool", nil)
+				m.sel.EXPECT().Environment(envDashboardNamePrompt, "", "phonetool").Return("test", nil)
+			},
+			wantedAppName: "phonetool",
+			wantedEnvName: "test",
+		},
+		"validate the environment name": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			setupMocks: func(m dashboardEnvMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := dashboardEnvMocks{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockconfigSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &dashboardEnvOpts{
+				dashboardEnvVars: dashboardEnvVars{
+					appName: tc.inAppName,
+					name:    tc.inEnvName,
+				},
+				store: m.store,
+				sel:   m.sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedAppName, opts.appName)
+				require.Equal(t, tc.wantedEnvName, opts.name)
+			}
+		})
+	}
+}
+
+func TestDashboardEnvOpts_Execute(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(m dashboardEnvMocks)
+
+		wantedError error
+	}{
+		"error if the alarms can't be retrieved": {
+			setupMocks: func(m dashboardEnvMocks) {
+				m.dashboard.EXPECT().AlarmStatuses(gomock.Any()).Return(nil, mockError)
+			},
+			wantedError: errors.New("get alarms of environment test: some error"),
+		},
+		"error if the deployed services can't be listed": {
+			setupMocks: func(m dashboardEnvMocks) {
+				m.dashboard.EXPECT().AlarmStatuses(gomock.Any()).Return(nil, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return(nil, mockError)
+			},
+			wantedError: errors.New("list services deployed in environment test: some error"),
+		},
+		"error if a service can't be described": {
+			setupMocks: func(m dashboardEnvMocks) {
+				m.dashboard.EXPECT().AlarmStatuses(gomock.Any()).Return(nil, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"api"}, nil)
+				m.store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{Type: manifestinfo.BackendServiceType}, nil)
+				m.ecs.EXPECT().DescribeService("phonetool", "test", "api").Return(nil, mockError)
+			},
+			wantedError: errors.New("describe ECS service for api in environment test: some error"),
+		},
+		"error if the dashboard fails to be put": {
+			setupMocks: func(m dashboardEnvMocks) {
+				m.dashboard.EXPECT().AlarmStatuses(gomock.Any()).Return(nil, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return(nil, nil)
+				m.prog.EXPECT().Start("Generating dashboard phonetool-test for environment test.")
+				m.dashboard.EXPECT().PutDashboard("phonetool-test", gomock.Any()).Return(mockError)
+				m.prog.EXPECT().Stop(log.Serrorf("Failed to generate dashboard phonetool-test for environment test.\n"))
+			},
+			wantedError: mockError,
+		},
+		"generate a dashboard with the alarms and the ECS services": {
+			setupMocks: func(m dashboardEnvMocks) {
+				m.dashboard.EXPECT().AlarmStatuses(gomock.Any()).Return([]cloudwatch.AlarmStatus{
+					{Arn: "arn:aws:cloudwatch:us-west-2:1234567890:alarm:phonetool-test-api-CopilotCPUAlarm"},
+				}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"api", "frontend"}, nil)
+				m.store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{Type: manifestinfo.LoadBalancedWebServiceType}, nil)
+				m.store.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{Type: manifestinfo.RequestDrivenWebServiceType}, nil)
+				m.ecs.EXPECT().DescribeService("phonetool", "test", "api").Return(&ecs.ServiceDesc{
+					Name:        "phonetool-test-api-Service",
+					ClusterName: "phonetool-test-Cluster",
+				}, nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				dimensions := map[string]string{
+					"ClusterName": "phonetool-test-Cluster",
+					"ServiceName": "phonetool-test-api-Service",
+				}
+				m.dashboard.EXPECT().PutDashboard("phonetool-test", cloudwatch.NewDashboard(
+					[]*cloudwatch.DashboardWidget{
+						cloudwatch.NewAlarmWidget("Alarms", []string{"arn:aws:cloudwatch:us-west-2:1234567890:alarm:phonetool-test-api-CopilotCPUAlarm"}),
+					},
+					[]*cloudwatch.DashboardWidget{
+						cloudwatch.NewMetricWidget("api CPU utilization", "us-west-2", "Average", cloudwatch.DashboardMetric{
+							Namespace:  "AWS/ECS",
+							Name:       "CPUUtilization",
+							Dimensions: dimensions,
+						}),
+						cloudwatch.NewMetricWidget("api memory utilization", "us-west-2", "Average", cloudwatch.DashboardMetric{
+							Namespace:  "AWS/ECS",
+							Name:       "MemoryUtilization",
+							Dimensions: dimensions,
+						}),
+					},
+				)).Return(nil)
+				m.prog.EXPECT().Stop(log.Ssuccessf("Generated dashboard phonetool-test for environment test.\n"))
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := dashboardEnvMocks{
+				store:       mocks.NewMockstore(ctrl),
+				deployStore: mocks.NewMockdeployedEnvironmentLister(ctrl),
+				prog:        mocks.NewMockprogress(ctrl),
+				ecs:         mocks.NewMockserviceDescriber(ctrl),
+				dashboard:   mocks.NewMockdashboardPutter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &dashboardEnvOpts{
+				dashboardEnvVars: dashboardEnvVars{
+					appName: "phonetool",
+					name:    "test",
+				},
+				store:       m.store,
+				deployStore: m.deployStore,
+				prog:        m.prog,
+				initRuntimeClients: func(o *dashboardEnvOpts) error {
+					o.region = "us-west-2"
+					o.ecs = m.ecs
+					o.dashboard = m.dashboard
+					return nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
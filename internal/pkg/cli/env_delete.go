@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -41,6 +42,10 @@ const (
 	fmtRetainEnvRolesFailed   = "Failed to retain IAM roles for the %q environment\n"
 	fmtRetainEnvRolesComplete = "Retained IAM roles for the %q environment\n"
 
+	fmtRetainEnvResourcesStart    = "Retaining %s resources of the %q environment"
+	fmtRetainEnvResourcesFailed   = "Failed to retain %s resources of the %q environment\n"
+	fmtRetainEnvResourcesComplete = "Retained %s resources of the %q environment\n"
+
 	fmtDeleteEnvStart     = "Deleting IAM roles and deregistering environment %q from application %q."
 	fmtDeleteEnvIAMFailed = "Failed to delete IAM roles of environment %q from application %q.\n"
 	fmtDeleteEnvSSMFailed = "Failed to deregister environment %q from application %q.\n"
@@ -63,6 +68,8 @@ type deleteEnvVars struct {
 	appName          string
 	name             string
 	skipConfirmation bool
+	dryRun           bool
+	retain           []string
 }
 
 type deleteEnvOpts struct {
@@ -77,6 +84,7 @@ type deleteEnvOpts struct {
 	prog              progress
 	prompt            prompter
 	sel               configSelector
+	planWriter        io.Writer
 
 	// cached data to avoid fetching the same information multiple times.
 	envConfig *config.Environment
@@ -98,10 +106,11 @@ func newDeleteEnvOpts(vars deleteEnvVars) (*deleteEnvOpts, error) {
 	return &deleteEnvOpts{
 		deleteEnvVars: vars,
 
-		store:  store,
-		prog:   termprogress.NewSpinner(log.DiagnosticWriter),
-		sel:    selector.NewConfigSelector(prompter, store),
-		prompt: prompter,
+		store:      store,
+		prog:       termprogress.NewSpinner(log.DiagnosticWriter),
+		sel:        selector.NewConfigSelector(prompter, store),
+		prompt:     prompter,
+		planWriter: os.Stdout,
 
 		initRuntimeClients: func(o *deleteEnvOpts) error {
 			env, err := o.getEnvConfig()
@@ -128,7 +137,7 @@ func (o *deleteEnvOpts) Validate() error {
 			return err
 		}
 	}
-	return nil
+	return validateRetainedResourceClasses(o.retain)
 }
 
 // Ask prompts for fields that are required but not passed in.
@@ -139,7 +148,7 @@ func (o *deleteEnvOpts) Ask() error {
 	if err := o.askEnvName(); err != nil {
 		return err
 	}
-	if o.skipConfirmation {
+	if o.skipConfirmation || o.dryRun {
 		return nil
 	}
 	deleteConfirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtDeleteEnvPrompt, o.name, o.appName), "", prompt.WithConfirmFinalMessage())
@@ -157,6 +166,7 @@ func (o *deleteEnvOpts) Ask() error {
 // 2. Deleting the EnvManagerRole and CFNExecutionRole.
 // 3. Deleting the parameter from the SSM store.
 // The environment is removed from the store only if other delete operations succeed.
+// With a dry run, Execute only writes the plan of the deletion.
 // Execute assumes that Validate is invoked first.
 func (o *deleteEnvOpts) Execute() error {
 	if err := o.initRuntimeClients(o); err != nil {
@@ -165,6 +175,9 @@ func (o *deleteEnvOpts) Execute() error {
 	if err := o.validateNoRunningServices(); err != nil {
 		return err
 	}
+	if o.dryRun {
+		return o.showPlan()
+	}
 
 	o.prog.Start(fmt.Sprintf(fmtRetainEnvRolesStart, o.name))
 	if err := o.ensureRolesAreRetained(); err != nil {
@@ -173,6 +186,16 @@ func (o *deleteEnvOpts) Execute() error {
 	}
 	o.prog.Stop(log.Ssuccessf(fmtRetainEnvRolesComplete, o.name))
 
+	if len(o.retain) > 0 {
+		classes := strings.Join(o.retain, ", ")
+		o.prog.Start(fmt.Sprintf(fmtRetainEnvResourcesStart, classes, o.name))
+		if err := o.retainResources(); err != nil {
+			o.prog.Stop(log.Serrorf(fmtRetainEnvResourcesFailed, classes, o.name))
+			return err
+		}
+		o.prog.Stop(log.Ssuccessf(fmtRetainEnvResourcesComplete, classes, o.name))
+	}
+
 	o.prog.Start(fmt.Sprintf("Deleting resources for the %q environment\n", o.name))
 	if err := o.deleteStack(); err != nil {
 		o.prog.Stop(log.Serrorf("Failed to delete resources for the %q environment\n", o.name))
@@ -285,13 +308,9 @@ func (o *deleteEnvOpts) validateNoRunningServices() error {
 // In case we encounter a legacy stack, we need to first update the stack to make sure these roles are retained and then
 // proceed with the regular flow.
 func (o *deleteEnvOpts) ensureRolesAreRetained() error {
-	body, err := o.deployer.Template(stack.NameForEnv(o.appName, o.name))
-	if err != nil {
-		var stackDoesNotExist *awscfn.ErrStackNotFound
-		if errors.As(err, &stackDoesNotExist) {
-			return nil
-		}
-		return fmt.Errorf("get template body for environment %s in application %s: %v", o.name, o.appName, err)
+	body, err := o.stackTemplate()
+	if err != nil || body == "" {
+		return err
 	}
 
 	// Check if the execution role and the manager role are retained by the stack.
@@ -348,6 +367,64 @@ func (o *deleteEnvOpts) ensureRolesAreRetained() error {
 	return nil
 }
 
+// retainResources updates the environment stack so that the resources of the retained classes, such as the
+// S3 bucket for the access logs of the load balancer, are kept after the stack is deleted.
+func (o *deleteEnvOpts) retainResources() error {
+	body, err := o.stackTemplate()
+	if err != nil || body == "" {
+		return err
+	}
+	newBody, err := retainResourcesInTemplate(body, o.retain)
+	if err != nil {
+		return fmt.Errorf("retain resources of environment %s: %w", o.name, err)
+	}
+	if newBody == body {
+		return nil
+	}
+	env, err := o.getEnvConfig()
+	if err != nil {
+		return err
+	}
+	if err := o.deployer.UpdateEnvironmentTemplate(o.appName, o.name, newBody, env.ExecutionRoleARN); err != nil {
+		return fmt.Errorf("update environment stack to retain resources: %w", err)
+	}
+	return nil
+}
+
+// stackTemplate returns the template of the environment stack, or an empty string if the stack doesn't exist.
+func (o *deleteEnvOpts) stackTemplate() (string, error) {
+	body, err := o.deployer.Template(stack.NameForEnv(o.appName, o.name))
+	if err != nil {
+		var stackDoesNotExist *awscfn.ErrStackNotFound
+		if errors.As(err, &stackDoesNotExist) {
+			return "", nil
+		}
+		return "", fmt.Errorf("get template body for environment %s in application %s: %v", o.name, o.appName, err)
+	}
+	return body, nil
+}
+
+func (o *deleteEnvOpts) showPlan() error {
+	plan := &deletePlan{
+		title: fmt.Sprintf("Plan to delete environment %s from application %s:", o.name, o.appName),
+	}
+	body, err := o.stackTemplate()
+	if err != nil {
+		return err
+	}
+	if body != "" {
+		resources, err := plannedResources(body, o.retain)
+		if err != nil {
+			return fmt.Errorf("list resources of environment %s: %w", o.name, err)
+		}
+		plan.add(fmt.Sprintf("Delete stack %s.", stack.NameForEnv(o.appName, o.name)), resources...)
+	}
+	plan.add(fmt.Sprintf("Remove environment %s from the application stack set and hosted zone delegation.", o.name))
+	plan.add(fmt.Sprintf("Delete the EnvironmentManagerRole and CloudformationExecutionRole of environment %s.", o.name))
+	plan.add(fmt.Sprintf("Delete environment %s from the config store.", o.name))
+	return plan.render(o.planWriter)
+}
+
 // deleteStack returns nil if the stack was deleted successfully. Otherwise, returns the error.
 func (o *deleteEnvOpts) deleteStack() error {
 	env, err := o.getEnvConfig()
@@ -447,7 +524,13 @@ func buildEnvDeleteCmd() *cobra.Command {
   /code $ copilot env delete --name test
 
   Delete the "test" environment without prompting.
-  /code $ copilot env delete --name test --yes`,
+  /code $ copilot env delete --name test --yes
+
+  Show the resources that would be deleted with the "test" environment.
+  /code $ copilot env delete --name test --dry-run
+
+  Delete the "test" environment but keep its S3 buckets.
+  /code $ copilot env delete --name test --retain s3`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeleteEnvOpts(vars)
 			if err != nil {
@@ -459,5 +542,7 @@ func buildEnvDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, deleteDryRunFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.retain, retainFlag, nil, deleteRetainFlagDescription)
 	return cmd
 }
@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"testing"
@@ -282,6 +283,98 @@ Resources:
 			},
 			wantedError: errors.New("update environment stack to retain environment roles: some error"),
 		},
+		"writes the plan without deleting anything on a dry run": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				rg := mocks.NewMockresourceGetter(ctrl)
+				rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{}}, nil)
+
+				deployer := mocks.NewMockenvironmentDeployer(ctrl)
+				deployer.EXPECT().Template("phonetool-test").Return(`
+Resources:
+  ELBAccessLogsBucket:
+    Type: AWS::S3::Bucket
+  CloudformationExecutionRole:
+    DeletionPolicy: Retain
+    Type: AWS::IAM::Role
+`, nil)
+
+				plan := &bytes.Buffer{}
+				t.Cleanup(func() {
+					require.Equal(t, `Plan to delete environment test from application phonetool:
+  1. Delete stack phonetool-test.
+       - S3 bucket ELBAccessLogsBucket (retained)
+  2. Remove environment test from the application stack set and hosted zone delegation.
+  3. Delete the EnvironmentManagerRole and CloudformationExecutionRole of environment test.
+  4. Delete environment test from the config store.
+
+`, plan.String())
+				})
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName: "phonetool",
+						name:    "test",
+						dryRun:  true,
+						retain:  []string{"s3"},
+					},
+					rg:                 rg,
+					deployer:           deployer,
+					planWriter:         plan,
+					initRuntimeClients: noopInitRuntimeClients,
+				}
+			},
+		},
+		"returns wrapped error when environment stack cannot be updated to retain resources": {
+			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
+				rg := mocks.NewMockresourceGetter(ctrl)
+				rg.EXPECT().GetResources(gomock.Any()).Return(&resourcegroupstaggingapi.GetResourcesOutput{
+					ResourceTagMappingList: []*resourcegroupstaggingapi.ResourceTagMapping{}}, nil)
+
+				prog := mocks.NewMockprogress(ctrl)
+				tpl := `Resources:
+  CloudformationExecutionRole:
+    DeletionPolicy: Retain
+  EnvironmentManagerRole:
+    DeletionPolicy: Retain
+  ELBAccessLogsBucket:
+    Type: AWS::S3::Bucket
+`
+				deployer := mocks.NewMockenvironmentDeployer(ctrl)
+				gomock.InOrder(
+					prog.EXPECT().Start(gomock.Any()),
+					deployer.EXPECT().Template("phonetool-test").Return(tpl, nil),
+					prog.EXPECT().Stop(gomock.Any()),
+					prog.EXPECT().Start(`Retaining s3 resources of the "test" environment`),
+					deployer.EXPECT().Template("phonetool-test").Return(tpl, nil),
+					deployer.EXPECT().UpdateEnvironmentTemplate("phonetool", "test", `Resources:
+  CloudformationExecutionRole:
+    DeletionPolicy: Retain
+  EnvironmentManagerRole:
+    DeletionPolicy: Retain
+  ELBAccessLogsBucket:
+    Type: AWS::S3::Bucket
+    DeletionPolicy: Retain
+`, "arn").Return(errors.New("some error")),
+					prog.EXPECT().Stop(log.Serror("Failed to retain s3 resources of the \"test\" environment\n")),
+				)
+
+				return &deleteEnvOpts{
+					deleteEnvVars: deleteEnvVars{
+						appName: "phonetool",
+						name:    "test",
+						retain:  []string{"s3"},
+					},
+					rg:       rg,
+					deployer: deployer,
+					prog:     prog,
+					envConfig: &config.Environment{
+						ExecutionRoleARN: "arn",
+					},
+					initRuntimeClients: noopInitRuntimeClients,
+				}
+			},
+			wantedError: errors.New("update environment stack to retain resources: some error"),
+		},
 		"returns wrapped error when stack cannot be deleted": {
 			given: func(t *testing.T, ctrl *gomock.Controller) *deleteEnvOpts {
 				rg := mocks.NewMockresourceGetter(ctrl)
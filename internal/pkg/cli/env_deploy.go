@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -18,6 +19,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
@@ -40,6 +42,8 @@ type deployEnvVars struct {
 	showDiff          bool
 	skipDiffPrompt    bool
 	allowEnvDowngrade bool
+	waitForLock       bool
+	lockTimeout       time.Duration
 }
 
 type deployEnvOpts struct {
@@ -60,6 +64,8 @@ type deployEnvOpts struct {
 	newInterpolator     func(app, env string) interpolator
 	newEnvVersionGetter func(appName, envName string) (versionGetter, error)
 	newEnvDeployer      func() (envDeployer, error)
+	locker              stackLocker
+	cmd                 execRunner
 
 	// Cached variables.
 	targetApp *config.Application
@@ -102,6 +108,8 @@ func newEnvDeployOpts(vars deployEnvVars) (*deployEnvOpts, error) {
 		identity:        identity.New(defaultSess),
 		templateVersion: version.LatestTemplateVersion(),
 		newInterpolator: newManifestInterpolator,
+		locker:          newStackLocker(defaultSess, vars.waitForLock, vars.lockTimeout),
+		cmd:             exec.NewCmd(),
 	}
 	opts.newEnvDeployer = func() (envDeployer, error) {
 		return newEnvDeployer(opts, ws)
@@ -198,6 +206,11 @@ func (o *deployEnvOpts) Execute() error {
 	if err := deployer.Validate(mft); err != nil {
 		return err
 	}
+	release, err := lockStack(o.locker, o.appName, stack.NameForEnv(o.appName, o.name), "env deploy")
+	if err != nil {
+		return err
+	}
+	defer release()
 	artifacts, err := deployer.UploadArtifacts()
 	if err != nil {
 		return fmt.Errorf("upload artifacts for environment %s: %w", o.name, err)
@@ -213,6 +226,15 @@ func (o *deployEnvOpts) Execute() error {
 		DisableRollback:     o.disableRollback,
 		Version:             o.templateVersion,
 	}
+	if checker := newTemplateChecker(o.targetApp, o.name, o.cmd, log.DiagnosticWriter); checker.configured() {
+		output, err := deployer.GenerateCloudFormationTemplate(deployInput)
+		if err != nil {
+			return fmt.Errorf("generate the template for environment %q: %w", o.name, err)
+		}
+		if err := checkStackTemplate(checker, deployer, stack.NameForEnv(o.appName, o.name), output.Template); err != nil {
+			return err
+		}
+	}
 	if o.showDiff {
 		contd, err := o.showDiffAndConfirmDeployment(deployer, deployInput)
 		if err != nil {
@@ -267,13 +289,15 @@ func (o *deployEnvOpts) showDiffAndConfirmDeployment(deployer envDeployer, input
 	if err != nil {
 		return false, fmt.Errorf("generate the template for environment %q: %w", o.name, err)
 	}
+	var hasDiff bool
 	if err := diff(deployer, output.Template, os.Stdout); err != nil {
 		var errHasDiff *errHasDiff
 		if !errors.As(err, &errHasDiff) {
 			return false, fmt.Errorf("generate diff for environment %q: %w", o.name, err)
 		}
+		hasDiff = true
 	}
-	if o.skipDiffPrompt {
+	if !hasDiff || o.skipDiffPrompt {
 		return true, nil
 	}
 	contd, err := o.prompt.Confirm(continueDeploymentPrompt, "")
@@ -381,5 +405,7 @@ Deploy an environment named "test".
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipDiffPrompt, diffAutoApproveFlag, false, diffAutoApproveFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowEnvDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
+	cmd.Flags().BoolVar(&vars.waitForLock, waitForLockFlag, false, waitForLockFlagDescription)
+	cmd.Flags().DurationVar(&vars.lockTimeout, lockTimeoutFlag, defaultLockTimeout, lockTimeoutFlagDescription)
 	return cmd
 }
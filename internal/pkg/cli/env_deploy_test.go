@@ -146,6 +146,7 @@ type deployEnvExecuteMocks struct {
 	interpolator     *mocks.Mockinterpolator
 	prompter         *mocks.Mockprompter
 	envVersionGetter *mocks.MockversionGetter
+	locker           *mocks.MockstackLocker
 }
 
 func TestDeployEnvOpts_Execute(t *testing.T) {
@@ -264,7 +265,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 			},
 			wantedErr: errors.New(`generate diff for environment "mockEnv": some error`),
 		},
-		"write 'no changes' if there is no diff": {
+		"write 'no changes' and deploy without confirmation if there is no diff": {
 			inShowDiff: true,
 			setUpMocks: func(m *deployEnvExecuteMocks) {
 				m.envVersionGetter.EXPECT().Version().Return(mockEnvVersion, nil)
@@ -277,7 +278,8 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
 				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
-				m.prompter.EXPECT().Confirm(gomock.Eq(continueDeploymentPrompt), gomock.Any(), gomock.Any()).Return(false, nil)
+				m.prompter.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Times(1)
 			},
 			wantedDiff: "No changes.\n",
 		},
@@ -293,7 +295,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
+				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("mock diff", nil)
 				m.prompter.EXPECT().Confirm(gomock.Eq(continueDeploymentPrompt), gomock.Any(), gomock.Any()).Return(false, nil)
 			},
 			wantedDiff: "mock diff",
@@ -310,7 +312,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
+				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("mock diff", nil)
 				m.prompter.EXPECT().Confirm(gomock.Eq(continueDeploymentPrompt), gomock.Any(), gomock.Any()).Return(false, errors.New("some error"))
 			},
 			wantedErr: errors.New("ask whether to continue with the deployment: some error"),
@@ -327,7 +329,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
+				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("mock diff", nil)
 				m.prompter.EXPECT().Confirm(gomock.Eq(continueDeploymentPrompt), gomock.Any(), gomock.Any()).Return(false, nil)
 				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Times(0)
 			},
@@ -344,7 +346,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				m.deployer.EXPECT().Validate(gomock.Any()).Return(nil)
 				m.deployer.EXPECT().UploadArtifacts().Return(&deploy.UploadEnvArtifactsOutput{}, nil)
 				m.deployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
-				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
+				m.deployer.EXPECT().DeployDiff(gomock.Any()).Return("mock diff", nil)
 				m.prompter.EXPECT().Confirm(gomock.Eq(continueDeploymentPrompt), gomock.Any(), gomock.Any()).Return(true, nil)
 				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Times(1)
 			},
@@ -427,8 +429,10 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 				interpolator:     mocks.NewMockinterpolator(ctrl),
 				prompter:         mocks.NewMockprompter(ctrl),
 				envVersionGetter: mocks.NewMockversionGetter(ctrl),
+				locker:           mocks.NewMockstackLocker(ctrl),
 			}
 			tc.setUpMocks(m)
+			m.locker.EXPECT().Lock(gomock.Any(), gomock.Any(), gomock.Any()).Return(func() error { return nil }, nil).AnyTimes()
 			opts := deployEnvOpts{
 				deployEnvVars: deployEnvVars{
 					name:              "mockEnv",
@@ -449,6 +453,7 @@ func TestDeployEnvOpts_Execute(t *testing.T) {
 					return m.interpolator
 				},
 				prompt: m.prompter,
+				locker: m.locker,
 				targetApp: &config.Application{
 					Name:   "mockApp",
 					Domain: "mockDomain",
@@ -0,0 +1,158 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envDriftAppNamePrompt     = "Which application is the environment in?"
+	envDriftAppNameHelpPrompt = "An application is a collection of related services."
+	envDriftNamePrompt        = "Which environment of %s would you like to detect drift on?"
+	envDriftNameHelpPrompt    = "The resources of the environment stack are compared with their actual configuration."
+)
+
+type driftEnvVars struct {
+	appName          string
+	name             string
+	shouldOutputJSON bool
+}
+
+type driftEnvOpts struct {
+	driftEnvVars
+
+	w                io.Writer
+	store            store
+	sel              appEnvSelector
+	prog             progress
+	newDriftDetector func(env *config.Environment) (stackDriftDetector, error)
+}
+
+func newDriftEnvOpts(vars driftEnvVars) (*driftEnvOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env drift"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	return &driftEnvOpts{
+		driftEnvVars: vars,
+		w:            log.OutputWriter,
+		store:        store,
+		sel:          selector.NewAppEnvSelector(prompt.New(), store),
+		prog:         termprogress.NewSpinner(log.DiagnosticWriter),
+		newDriftDetector: func(env *config.Environment) (stackDriftDetector, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return cloudformation.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if any optional flags are invalid.
+func (o *driftEnvOpts) Validate() error {
+	return nil
+}
+
+// Ask validates required fields that users passed in, otherwise it prompts for them.
+func (o *driftEnvOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateOrAskEnv()
+}
+
+// Execute detects drift on the environment stack and writes the drifted resources.
+func (o *driftEnvOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.name, err)
+	}
+	detector, err := o.newDriftDetector(env)
+	if err != nil {
+		return err
+	}
+	drift, err := detectStackDrift(detector, o.prog, stack.NameForEnv(o.appName, o.name))
+	if err != nil {
+		return fmt.Errorf("detect drift of environment %s: %w", o.name, err)
+	}
+	return drift.write(o.w, o.shouldOutputJSON)
+}
+
+func (o *driftEnvOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name %q: %v", o.appName, err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(envDriftAppNamePrompt, envDriftAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *driftEnvOpts) validateOrAskEnv() error {
+	if o.name != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.name); err != nil {
+			return fmt.Errorf("validate environment name %q in application %q: %v", o.name, o.appName, err)
+		}
+		return nil
+	}
+	env, err := o.sel.Environment(fmt.Sprintf(envDriftNamePrompt, color.HighlightUserInput(o.appName)), envDriftNameHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment for application %s: %w", o.appName, err)
+	}
+	o.name = env
+	return nil
+}
+
+// buildEnvDriftCmd builds the command for detecting drift on an environment stack.
+func buildEnvDriftCmd() *cobra.Command {
+	vars := driftEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Detects resources of an environment changed outside of Copilot.",
+		Long: `Detects resources of an environment changed outside of Copilot.
+Runs CloudFormation drift detection on the environment stack and shows the drifted properties.
+Exits with code 1 if any resource drifted.`,
+
+		Example: `
+  Detect drift on the "test" environment.
+  /code $ copilot env drift -n test
+  Detect drift on the "prod" environment and print the drifted resources in JSON.
+  /code $ copilot env drift -n prod --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDriftEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
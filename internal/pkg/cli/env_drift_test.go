@@ -0,0 +1,203 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type envDriftMocks struct {
+	store    *mocks.Mockstore
+	sel      *mocks.MockappEnvSelector
+	prog     *mocks.Mockprogress
+	detector *mocks.MockstackDriftDetector
+}
+
+func TestDriftEnvOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp      string
+		inEnv      string
+		setupMocks func(m envDriftMocks)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"validate the application and environment names": {
+			inApp: "phonetool",
+			inEnv: "test",
+			setupMocks: func(m envDriftMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+		},
+		"error if the environment does not exist": {
+			inApp: "phonetool",
+			inEnv: "test",
+			setupMocks: func(m envDriftMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New(`validate environment name "test" in application "phonetool": some error`),
+		},
+		"prompt for the application and the environment": {
+			setupMocks: func(m envDriftMocks) {
+				m.sel.EXPECT().Application(envDriftAppNamePrompt, envDriftAppNameHelpPrompt).Return("phonetool", nil)
+				m.sel.EXPECT().Environment(gomock.Any(), envDriftNameHelpPrompt, "phonetool").Return("test", nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+		},
+		"error if fail to select an environment": {
+			inApp: "phonetool",
+			setupMocks: func(m envDriftMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.sel.EXPECT().Environment(gomock.Any(), gomock.Any(), "phonetool").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select environment for application phonetool: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := envDriftMocks{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockappEnvSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &driftEnvOpts{
+				driftEnvVars: driftEnvVars{
+					appName: tc.inApp,
+					name:    tc.inEnv,
+				},
+				store: m.store,
+				sel:   m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.appName)
+				require.Equal(t, tc.wantedEnv, opts.name)
+			}
+		})
+	}
+}
+
+func TestDriftEnvOpts_Execute(t *testing.T) {
+	mockEnv := &config.Environment{
+		App:            "phonetool",
+		Name:           "test",
+		Region:         "us-west-2",
+		ManagerRoleARN: "arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole",
+	}
+	testCases := map[string]struct {
+		setupMocks func(m envDriftMocks)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"error if fail to get the environment": {
+			setupMocks: func(m envDriftMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get environment test configuration: some error"),
+		},
+		"error if fail to detect drift": {
+			setupMocks: func(m envDriftMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.prog.EXPECT().Start("Detecting drift of stack phonetool-test.")
+				m.detector.EXPECT().DetectDrift(gomock.Any(), "phonetool-test").Return(nil, errors.New("some error"))
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: errors.New("detect drift of environment test: some error"),
+		},
+		"write that no drift is detected": {
+			setupMocks: func(m envDriftMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.detector.EXPECT().DetectDrift(gomock.Any(), "phonetool-test").Return(nil, nil)
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedOutput: "No drift detected in stack phonetool-test.\n",
+		},
+		"exit with an error if the environment drifted": {
+			setupMocks: func(m envDriftMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.detector.EXPECT().DetectDrift(gomock.Any(), "phonetool-test").Return([]cloudformation.StackResourceDrift{
+					{
+						LogicalResourceId:        aws.String("Cluster"),
+						ResourceType:             aws.String("AWS::ECS::Cluster"),
+						StackResourceDriftStatus: aws.String("DELETED"),
+					},
+				}, nil)
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedOutput: `Drifted resources of stack phonetool-test
+
+Cluster (AWS::ECS::Cluster) deleted
+`,
+			wantedError: &errHasDrift{stack: "phonetool-test"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := envDriftMocks{
+				store:    mocks.NewMockstore(ctrl),
+				prog:     mocks.NewMockprogress(ctrl),
+				detector: mocks.NewMockstackDriftDetector(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &driftEnvOpts{
+				driftEnvVars: driftEnvVars{
+					appName: "phonetool",
+					name:    "test",
+				},
+				w:     b,
+				store: m.store,
+				prog:  m.prog,
+				newDriftDetector: func(env *config.Environment) (stackDriftDetector, error) {
+					require.Equal(t, mockEnv, env)
+					return m.detector, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
@@ -4,6 +4,8 @@
 package cli
 
 import (
+	"bytes"
+	"encoding"
 	"errors"
 	"fmt"
 	"net"
@@ -14,11 +16,13 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/profile"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/afero"
 	"golang.org/x/mod/semver"
+	"gopkg.in/yaml.v3"
 
 	"github.com/aws/aws-sdk-go/service/ssm"
 
@@ -150,6 +154,7 @@ type initEnvVars struct {
 	importCerts        []string      // Additional existing ACM certificates to use.
 	internalALBSubnets []string      // Subnets to be used for internal ALB placement.
 	allowVPCIngress    bool          // True means the env stack will create ingress to the internal ALB from ports 80/443.
+	fromEnv            string        // Name of an existing environment to copy the manifest from.
 
 	tempCreds tempCredsVars // Temporary credentials to initialize the environment. Mutually exclusive with the profile.
 	region    string        // The region to create the environment in.
@@ -176,12 +181,14 @@ type initEnvOpts struct {
 	selApp              appSelector
 	appCFN              appResourcesGetter
 	manifestWriter      environmentManifestWriter
+	manifestReader      environmentManifestReader
 
 	sess *session.Session // Session pointing to environment's AWS account and region.
 
 	// Cached variables.
 	wsAppName        string
 	mftDisplayedPath string
+	srcEnv           *config.Environment // Environment to copy the manifest from.
 
 	// Overridden in tests.
 	templateVersion string
@@ -224,6 +231,7 @@ func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
 		selApp:         selector.NewAppEnvSelector(prompt.New(), store),
 		appCFN:         deploycfn.New(defaultSession, deploycfn.WithProgressTracker(os.Stderr)),
 		manifestWriter: ws,
+		manifestReader: ws,
 
 		wsAppName:       tryReadingAppName(),
 		templateVersion: version.LatestTemplateVersion(),
@@ -246,6 +254,9 @@ func (o *initEnvOpts) Validate() error {
 		}
 	}
 
+	if err := o.validateFromEnv(); err != nil {
+		return err
+	}
 	if err := o.validateCustomizedResources(); err != nil {
 		return err
 	}
@@ -263,6 +274,10 @@ func (o *initEnvOpts) Ask() error {
 	if err := o.askEnvRegion(); err != nil {
 		return err
 	}
+	if o.srcEnv != nil {
+		// The resources are configured by the manifest of the source environment.
+		return nil
+	}
 	return o.askCustomizedResources()
 }
 
@@ -364,6 +379,27 @@ func (o *initEnvOpts) initRuntimeClients() error {
 	return nil
 }
 
+func (o *initEnvOpts) validateFromEnv() error {
+	if o.fromEnv == "" {
+		return nil
+	}
+	if o.importVPC.isSet() || o.adjustVPC.isSet() || o.importCerts != nil || o.internalALBSubnets != nil || o.allowVPCIngress {
+		return fmt.Errorf("cannot import or configure resources if --%s is set", fromEnvFlag)
+	}
+	if o.defaultConfig {
+		return fmt.Errorf("cannot specify both --%s and --%s", fromEnvFlag, defaultConfigFlag)
+	}
+	if o.telemetry.EnableContainerInsights {
+		return fmt.Errorf("cannot specify both --%s and --%s", fromEnvFlag, enableContainerInsightsFlag)
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.fromEnv)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.fromEnv, err)
+	}
+	o.srcEnv = env
+	return nil
+}
+
 func (o *initEnvOpts) validateCustomizedResources() error {
 	if o.importVPC.isSet() && o.adjustVPC.isSet() {
 		return errors.New("cannot specify both import vpc flags and configure vpc flags")
@@ -441,6 +477,9 @@ func (o *initEnvOpts) askEnvSession() error {
 
 func (o *initEnvOpts) askEnvRegion() error {
 	region := aws.StringValue(o.sess.Config.Region)
+	if o.srcEnv != nil {
+		region = o.srcEnv.Region
+	}
 	if o.region != "" {
 		region = o.region
 	}
@@ -847,24 +886,33 @@ func (o *initEnvOpts) tryDeletingEnvRoles(app, env string) {
 }
 
 func (o *initEnvOpts) writeManifest() (string, error) {
-	customizedEnv := &config.CustomizeEnv{
-		ImportVPC:                   o.importVPCConfig(),
-		VPCConfig:                   o.adjustVPCConfig(),
-		ImportCertARNs:              o.importCerts,
-		InternalALBSubnets:          o.internalALBSubnets,
-		EnableInternalALBVPCIngress: o.allowVPCIngress,
-	}
-	if customizedEnv.IsEmpty() {
-		customizedEnv = nil
-	}
-	props := manifest.EnvironmentProps{
-		Name:         o.name,
-		CustomConfig: customizedEnv,
-		Telemetry:    o.telemetry.toConfig(),
+	var mft encoding.BinaryMarshaler
+	if o.srcEnv != nil {
+		copied, err := o.copiedManifest()
+		if err != nil {
+			return "", err
+		}
+		mft = copied
+	} else {
+		customizedEnv := &config.CustomizeEnv{
+			ImportVPC:                   o.importVPCConfig(),
+			VPCConfig:                   o.adjustVPCConfig(),
+			ImportCertARNs:              o.importCerts,
+			InternalALBSubnets:          o.internalALBSubnets,
+			EnableInternalALBVPCIngress: o.allowVPCIngress,
+		}
+		if customizedEnv.IsEmpty() {
+			customizedEnv = nil
+		}
+		mft = manifest.NewEnvironment(&manifest.EnvironmentProps{
+			Name:         o.name,
+			CustomConfig: customizedEnv,
+			Telemetry:    o.telemetry.toConfig(),
+		})
 	}
 
 	var manifestExists bool
-	manifestPath, err := o.manifestWriter.WriteEnvironmentManifest(manifest.NewEnvironment(&props), props.Name)
+	manifestPath, err := o.manifestWriter.WriteEnvironmentManifest(mft, o.name)
 	if err != nil {
 		e, ok := err.(*workspace.ErrFileExists)
 		if !ok {
@@ -878,10 +926,92 @@ func (o *initEnvOpts) writeManifest() (string, error) {
 	if manifestExists {
 		manifestMsgFmt = "Manifest file for environment %s already exists at %s, skipping writing it.\n"
 	}
-	log.Successf(manifestMsgFmt, color.HighlightUserInput(props.Name), color.HighlightResource(manifestPath))
+	log.Successf(manifestMsgFmt, color.HighlightUserInput(o.name), color.HighlightResource(manifestPath))
 	return manifestPath, nil
 }
 
+// copiedManifest returns the manifest of the source environment renamed after the new environment.
+// The manifest is read from the workspace, and generated from the stored configuration of the source environment if
+// the workspace doesn't have it.
+func (o *initEnvOpts) copiedManifest() (encoding.BinaryMarshaler, error) {
+	var (
+		mft         *manifest.Environment
+		copied      encoding.BinaryMarshaler
+		errNotExist *workspace.ErrFileNotExists
+	)
+	raw, err := o.manifestReader.ReadEnvironmentManifest(o.srcEnv.Name)
+	switch {
+	case err == nil:
+		if mft, err = manifest.UnmarshalEnvironment(raw); err != nil {
+			return nil, fmt.Errorf("unmarshal manifest of environment %s: %w", o.srcEnv.Name, err)
+		}
+		renamed, err := renameEnvManifest(raw, o.name)
+		if err != nil {
+			return nil, fmt.Errorf("rename manifest of environment %s: %w", o.srcEnv.Name, err)
+		}
+		copied = renamed
+	case errors.As(err, &errNotExist):
+		log.Infof("Manifest of environment %s is not in the workspace, generating it from the environment's configuration.\n",
+			color.HighlightUserInput(o.srcEnv.Name))
+		mft = manifest.FromEnvConfig(o.srcEnv, template.New())
+		mft.Name = aws.String(o.name)
+		copied = mft
+	default:
+		return nil, fmt.Errorf("read manifest of environment %s: %w", o.srcEnv.Name, err)
+	}
+	if region := aws.StringValue(o.sess.Config.Region); region != o.srcEnv.Region && importsRegionalResources(mft) {
+		log.Warningf("Environment %s imports existing resources such as a VPC or certificates from region %s.\nReplace them in the manifest with resources from region %s before deploying environment %s.\n",
+			o.srcEnv.Name, o.srcEnv.Region, region, o.name)
+	}
+	return copied, nil
+}
+
+// renamedEnvManifest is the content of an environment manifest ready to be written to the workspace.
+type renamedEnvManifest []byte
+
+// MarshalBinary returns the content of the manifest.
+func (m renamedEnvManifest) MarshalBinary() ([]byte, error) {
+	return m, nil
+}
+
+// renameEnvManifest replaces the name of an environment manifest while preserving its other fields and comments.
+func renameEnvManifest(raw []byte, name string) (renamedEnvManifest, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, errors.New("manifest is not a map")
+	}
+	root := doc.Content[0]
+	var renamed bool
+	for i := 0; i < len(root.Content)-1; i += 2 {
+		if root.Content[i].Value == "name" {
+			root.Content[i+1].Value = name
+			renamed = true
+		}
+	}
+	if !renamed {
+		return nil, errors.New(`manifest does not have a "name" field`)
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// importsRegionalResources returns true if the environment manifest refers to existing resources that only exist in its region.
+func importsRegionalResources(mft *manifest.Environment) bool {
+	return mft.Network.VPC.ID != nil || len(mft.HTTPConfig.Public.Certificates) != 0 ||
+		len(mft.HTTPConfig.Private.Certificates) != 0 || !mft.Imports.IsEmpty()
+}
+
 func validateAppVersion(vg versionGetter, name, templateVersion string) error {
 	appVersion, err := vg.Version()
 	if err != nil {
@@ -921,7 +1051,10 @@ func buildEnvInitCmd() *cobra.Command {
   /code $ copilot env init --override-vpc-cidr 10.1.0.0/16 \
   /code --override-az-names us-west-2b,us-west-2c \
   /code --override-public-cidrs 10.1.0.0/24,10.1.1.0/24 \
-  /code --override-private-cidrs 10.1.2.0/24,10.1.3.0/24`,
+  /code --override-private-cidrs 10.1.2.0/24,10.1.3.0/24
+
+  Creates a prod-pdx environment in us-west-2 with the same configuration as the prod-iad environment.
+  /code $ copilot env init --name prod-pdx --from prod-iad --profile prod-admin --region us-west-2`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitEnvOpts(vars)
 			if err != nil {
@@ -954,6 +1087,7 @@ func buildEnvInitCmd() *cobra.Command {
 	cmd.Flags().StringSliceVar(&vars.internalALBSubnets, internalALBSubnetsFlag, nil, internalALBSubnetsFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowVPCIngress, allowVPCIngressFlag, false, allowVPCIngressFlagDescription)
 	cmd.Flags().BoolVar(&vars.defaultConfig, defaultConfigFlag, false, defaultConfigFlagDescription)
+	cmd.Flags().StringVar(&vars.fromEnv, fromEnvFlag, "", fromEnvFlagDescription)
 
 	flags := pflag.NewFlagSet("Common", pflag.ContinueOnError)
 	flags.AddFlag(cmd.Flags().Lookup(appFlag))
@@ -964,6 +1098,7 @@ func buildEnvInitCmd() *cobra.Command {
 	flags.AddFlag(cmd.Flags().Lookup(sessionTokenFlag))
 	flags.AddFlag(cmd.Flags().Lookup(regionFlag))
 	flags.AddFlag(cmd.Flags().Lookup(defaultConfigFlag))
+	flags.AddFlag(cmd.Flags().Lookup(fromEnvFlag))
 	flags.AddFlag(cmd.Flags().Lookup(allowDowngradeFlag))
 
 	resourcesImportFlags := pflag.NewFlagSet("Import Existing Resources", pflag.ContinueOnError)
@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
 	"net"
@@ -23,6 +24,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -56,6 +58,8 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 		inAZs         []string
 		inPublicCIDRs []string
 
+		inFromEnv string
+
 		inProfileName     string
 		inAccessKeyID     string
 		inSecretAccessKey string
@@ -77,6 +81,52 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 		"fail if command not run under a workspace": {
 			wantedErrMsg: "could not find an application attached to this workspace, please run `app init` first",
 		},
+		"valid environment creation from an existing environment": {
+			inEnvName: "prod-pdx",
+			inAppName: "phonetool",
+			inFromEnv: "prod-iad",
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "prod-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+				m.store.EXPECT().GetEnvironment("phonetool", "prod-iad").Return(&config.Environment{Name: "prod-iad"}, nil)
+			},
+		},
+		"fail if the environment to copy from does not exist": {
+			inEnvName: "prod-pdx",
+			inAppName: "phonetool",
+			inFromEnv: "prod-iad",
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "prod-pdx").Return(nil, &config.ErrNoSuchEnvironment{})
+				m.store.EXPECT().GetEnvironment("phonetool", "prod-iad").Return(nil, &config.ErrNoSuchEnvironment{
+					ApplicationName: "phonetool",
+					EnvironmentName: "prod-iad",
+				})
+			},
+			wantedErrMsg: "get environment prod-iad configuration: couldn't find environment prod-iad in the application phonetool",
+		},
+		"fail if copying from an existing environment and importing resources": {
+			inAppName: "phonetool",
+			inFromEnv: "prod-iad",
+			inVPCID:   "vpc-1234",
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+			},
+			wantedErrMsg: "cannot import or configure resources if --from is set",
+		},
+		"fail if copying from an existing environment with default config": {
+			inAppName: "phonetool",
+			inFromEnv: "prod-iad",
+			inDefault: true,
+			setupMocks: func(m *initEnvMocks) {
+				m.wsAppName = "phonetool"
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, nil)
+			},
+			wantedErrMsg: "cannot specify both --from and --default-config",
+		},
 		"fail if using different app name from the workspace": {
 			inAppName: "demo",
 			setupMocks: func(m *initEnvMocks) {
@@ -293,6 +343,7 @@ func TestInitEnvOpts_Validate(t *testing.T) {
 						ID:               tc.inVPCID,
 					},
 					appName: tc.inAppName,
+					fromEnv: tc.inFromEnv,
 					profile: tc.inProfileName,
 					tempCreds: tempCredsVars{
 						AccessKeyID:     tc.inAccessKeyID,
@@ -355,11 +406,44 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 		inImportVPCVars      importVPCVars
 		inAdjustVPCVars      adjustVPCVars
 		inInternalALBSubnets []string
+		inSrcEnv             *config.Environment
 
 		setupMocks func(mocks initEnvMocks)
 
-		wantedError error
+		wantedRegion string
+		wantedError  error
 	}{
+		"default to the region of the environment to copy from and skip configuring resources": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inSrcEnv:  &config.Environment{Name: "prod", Region: "eu-west-1"},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(mockProfile).Return(&session.Session{
+					Config: &aws.Config{
+						Region: aws.String(mockRegion),
+					},
+				}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedRegion: "eu-west-1",
+		},
+		"override the region of the environment to copy from with the region flag": {
+			inAppName: mockApp,
+			inEnv:     mockEnv,
+			inProfile: mockProfile,
+			inRegion:  "us-east-1",
+			inSrcEnv:  &config.Environment{Name: "prod", Region: "eu-west-1"},
+			setupMocks: func(m initEnvMocks) {
+				m.sessProvider.EXPECT().FromProfile(mockProfile).Return(&session.Session{
+					Config: &aws.Config{
+						Region: aws.String(mockRegion),
+					},
+				}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedRegion: "us-east-1",
+		},
 		"fail to get env name": {
 			inAppName: mockApp,
 			setupMocks: func(m initEnvMocks) {
@@ -929,6 +1013,7 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 				prompt:    mocks.prompt,
 				selApp:    mocks.selApp,
 				store:     mocks.store,
+				srcEnv:    tc.inSrcEnv,
 			}
 
 			// WHEN
@@ -938,6 +1023,9 @@ func TestInitEnvOpts_Ask(t *testing.T) {
 			if tc.wantedError == nil {
 				require.NoError(t, err)
 				require.Equal(t, mockEnv, addEnv.name, "expected environment names to match")
+				if tc.wantedRegion != "" {
+					require.Equal(t, tc.wantedRegion, aws.StringValue(addEnv.sess.Config.Region))
+				}
 			} else {
 				require.EqualError(t, err, tc.wantedError.Error())
 			}
@@ -1399,3 +1487,111 @@ func TestInitEnvOpts_delegateDNSFromApp(t *testing.T) {
 		})
 	}
 }
+
+func TestInitEnvOpts_writeManifest(t *testing.T) {
+	testCases := map[string]struct {
+		inSrcEnv   *config.Environment
+		setupMocks func(reader *mocks.MockenvironmentManifestReader, writer *mocks.MockenvironmentManifestWriter)
+
+		wantedErr string
+	}{
+		"copy the manifest of the source environment from the workspace": {
+			inSrcEnv: &config.Environment{Name: "prod-iad", Region: "us-east-1"},
+			setupMocks: func(reader *mocks.MockenvironmentManifestReader, writer *mocks.MockenvironmentManifestWriter) {
+				reader.EXPECT().ReadEnvironmentManifest("prod-iad").Return([]byte(`# The manifest for the "prod-iad" environment.
+name: prod-iad
+type: Environment
+
+network:
+  vpc:
+    cidr: 10.1.0.0/16 # Overridden CIDR.
+observability:
+  container_insights: true
+`), nil)
+				writer.EXPECT().WriteEnvironmentManifest(gomock.Any(), "prod-pdx").DoAndReturn(func(mft encoding.BinaryMarshaler, _ string) (string, error) {
+					out, err := mft.MarshalBinary()
+					require.NoError(t, err)
+					require.Equal(t, `# The manifest for the "prod-iad" environment.
+name: prod-pdx
+type: Environment
+network:
+  vpc:
+    cidr: 10.1.0.0/16 # Overridden CIDR.
+observability:
+  container_insights: true
+`, string(out))
+					return "/copilot/environments/prod-pdx/manifest.yml", nil
+				})
+			},
+		},
+		"generate the manifest from the configuration of the source environment if it's not in the workspace": {
+			inSrcEnv: &config.Environment{
+				Name:   "prod-iad",
+				Region: "us-east-1",
+				CustomConfig: &config.CustomizeEnv{
+					VPCConfig: &config.AdjustVPC{
+						CIDR:               "10.1.0.0/16",
+						PublicSubnetCIDRs:  []string{"10.1.0.0/24", "10.1.1.0/24"},
+						PrivateSubnetCIDRs: []string{"10.1.2.0/24", "10.1.3.0/24"},
+					},
+				},
+				Telemetry: &config.Telemetry{EnableContainerInsights: true},
+			},
+			setupMocks: func(reader *mocks.MockenvironmentManifestReader, writer *mocks.MockenvironmentManifestWriter) {
+				reader.EXPECT().ReadEnvironmentManifest("prod-iad").Return(nil, &workspace.ErrFileNotExists{FileName: "manifest.yml"})
+				writer.EXPECT().WriteEnvironmentManifest(gomock.Any(), "prod-pdx").DoAndReturn(func(mft encoding.BinaryMarshaler, _ string) (string, error) {
+					out, err := mft.MarshalBinary()
+					require.NoError(t, err)
+					copied, err := manifest.UnmarshalEnvironment(out)
+					require.NoError(t, err)
+					require.Equal(t, "prod-pdx", aws.StringValue(copied.Name))
+					require.Equal(t, "10.1.0.0/16", string(*copied.Network.VPC.CIDR))
+					require.True(t, aws.BoolValue(copied.Observability.ContainerInsights))
+					return "/copilot/environments/prod-pdx/manifest.yml", nil
+				})
+			},
+		},
+		"error if the manifest of the source environment cannot be read": {
+			inSrcEnv: &config.Environment{Name: "prod-iad", Region: "us-east-1"},
+			setupMocks: func(reader *mocks.MockenvironmentManifestReader, writer *mocks.MockenvironmentManifestWriter) {
+				reader.EXPECT().ReadEnvironmentManifest("prod-iad").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "read manifest of environment prod-iad: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			reader := mocks.NewMockenvironmentManifestReader(ctrl)
+			writer := mocks.NewMockenvironmentManifestWriter(ctrl)
+			tc.setupMocks(reader, writer)
+			opts := &initEnvOpts{
+				initEnvVars: initEnvVars{
+					appName: "phonetool",
+					name:    "prod-pdx",
+					fromEnv: "prod-iad",
+				},
+				sess: &session.Session{
+					Config: &aws.Config{
+						Region: aws.String("us-west-2"),
+					},
+				},
+				manifestReader: reader,
+				manifestWriter: writer,
+				srcEnv:         tc.inSrcEnv,
+			}
+
+			// WHEN
+			_, err := opts.writeManifest()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/output"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/spf13/cobra"
@@ -46,7 +47,7 @@ func newListEnvOpts(vars listEnvVars) (*listEnvOpts, error) {
 	if err != nil {
 		return nil, err
 	}
-	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	store := config.NewCachedSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
 	prompter := prompt.New()
 	return &listEnvOpts{
 		listEnvVars: vars,
@@ -82,34 +83,27 @@ func (o *listEnvOpts) Execute() error {
 		return err
 	}
 
-	var out string
-	if o.shouldOutputJSON {
-		data, err := o.jsonOutput(envs)
-		if err != nil {
-			return err
-		}
-		out = data
-	} else {
-		out = o.humanOutput(envs)
-	}
-	fmt.Fprint(o.w, out)
-
-	return nil
+	return output.New(o.w, o.shouldOutputJSON).Write(envList(envs))
 }
 
-func (o *listEnvOpts) humanOutput(envs []*config.Environment) string {
+// envList is the environments of an application.
+type envList []*config.Environment
+
+// HumanString returns the names of the environments, one per line.
+func (l envList) HumanString() string {
 	b := &strings.Builder{}
-	for _, env := range envs {
+	for _, env := range l {
 		fmt.Fprintln(b, env.Name)
 	}
 	return b.String()
 }
 
-func (o *listEnvOpts) jsonOutput(envs []*config.Environment) (string, error) {
+// JSONString returns the environments as a JSON object.
+func (l envList) JSONString() (string, error) {
 	type serializedEnvs struct {
 		Environments []*config.Environment `json:"environments"`
 	}
-	b, err := json.Marshal(serializedEnvs{Environments: envs})
+	b, err := json.Marshal(serializedEnvs{Environments: l})
 	if err != nil {
 		return "", fmt.Errorf("marshal environments: %w", err)
 	}
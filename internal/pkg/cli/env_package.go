@@ -11,7 +11,11 @@ import (
 	"path/filepath"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cdk"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/terraform"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/spf13/afero"
 
@@ -35,17 +39,25 @@ import (
 const (
 	envCFNTemplateNameFmt              = "%s.env.yml"
 	envCFNTemplateConfigurationNameFmt = "%s.env.params.json"
+	envTerraformConfigurationNameFmt   = "%s.env.tf"
 	envAddonsCFNTemplateName           = "env.addons.yml"
+
+	envBootstrapCFNTemplateNameFmt              = "%s.bootstrap.yml"
+	envBootstrapCFNTemplateConfigurationNameFmt = "%s.bootstrap.params.json"
 )
 
 type packageEnvVars struct {
 	name              string
 	appName           string
 	outputDir         string
+	format            string
 	uploadAssets      bool
 	forceNewUpdate    bool
 	showDiff          bool
+	diffFile          string
 	allowEnvDowngrade bool
+	checkTemplate     bool
+	offlineVars
 }
 
 type discardFile struct{}
@@ -71,6 +83,7 @@ type packageEnvOpts struct {
 	paramsWriter io.WriteCloser
 	addonsWriter io.WriteCloser
 	diffWriter   io.Writer
+	runner       execRunner
 
 	newInterpolator     func(appName, name string) interpolator
 	newEnvVersionGetter func(appName, name string) (versionGetter, error)
@@ -85,18 +98,31 @@ type packageEnvOpts struct {
 }
 
 func newPackageEnvOpts(vars packageEnvVars) (*packageEnvOpts, error) {
-	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env package"))
+	fs := afero.NewOsFs()
+	ws, err := workspace.Use(fs)
+	if err != nil {
+		return nil, err
+	}
+	sessOpts := []func(*sessions.Provider){sessions.UserAgentExtras("env package")}
+	var offlineValues *deploy.OfflineValues
+	if vars.offline {
+		if offlineValues, err = vars.values(fs); err != nil {
+			return nil, err
+		}
+		sessOpts = append(sessOpts, sessions.Offline(offlineValues.Region))
+	}
+	sessProvider := sessions.ImmutableProvider(sessOpts...)
 	defaultSess, err := sessProvider.Default()
 	if err != nil {
 		return nil, fmt.Errorf("default session: %v", err)
 	}
 
-	fs := afero.NewOsFs()
-	ws, err := workspace.Use(fs)
-	if err != nil {
-		return nil, err
+	var cfgStore store = config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	var caller identityService = identity.New(defaultSess)
+	if offlineValues != nil {
+		cfgStore = newOfflineStore(cfgStore, offlineValues, ws)
+		caller = offlineCaller{values: offlineValues}
 	}
-	cfgStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
 
 	opts := &packageEnvOpts{
 		packageEnvVars: vars,
@@ -104,15 +130,19 @@ func newPackageEnvOpts(vars packageEnvVars) (*packageEnvOpts, error) {
 		cfgStore:        cfgStore,
 		ws:              ws,
 		sel:             selector.NewLocalEnvironmentSelector(prompt.New(), cfgStore, ws),
-		caller:          identity.New(defaultSess),
+		caller:          caller,
 		fs:              fs,
 		tplWriter:       os.Stdout,
 		paramsWriter:    discardFile{},
 		addonsWriter:    discardFile{},
 		diffWriter:      os.Stdout,
+		runner:          exec.NewCmd(),
 		templateVersion: version.LatestTemplateVersion(),
 
 		newEnvVersionGetter: func(appName, name string) (versionGetter, error) {
+			if offlineValues != nil {
+				return offlineEnvFeatures{}, nil
+			}
 			return describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 				App:         appName,
 				Env:         name,
@@ -136,6 +166,17 @@ func newPackageEnvOpts(vars packageEnvVars) (*packageEnvOpts, error) {
 		if err != nil {
 			return nil, err
 		}
+		var offline *deploy.Offline
+		if offlineValues != nil {
+			envMft, err := offlineEnvManifest(ws, opts.newInterpolator(envCfg.App, envCfg.Name), envCfg.Name)
+			if err != nil {
+				return nil, err
+			}
+			offline = &deploy.Offline{
+				Values:      offlineValues,
+				EnvManifest: envMft,
+			}
+		}
 		return deploy.NewEnvDeployer(&deploy.NewEnvDeployerInput{
 			App:             appCfg,
 			Env:             envCfg,
@@ -143,6 +184,7 @@ func newPackageEnvOpts(vars packageEnvVars) (*packageEnvOpts, error) {
 			ConfigStore:     opts.cfgStore,
 			Workspace:       ws,
 			Overrider:       ovrdr,
+			Offline:         offline,
 		})
 	}
 	return opts, nil
@@ -150,7 +192,10 @@ func newPackageEnvOpts(vars packageEnvVars) (*packageEnvOpts, error) {
 
 // Validate returns an error for any invalid optional flags.
 func (o *packageEnvOpts) Validate() error {
-	return nil
+	if err := o.offlineVars.validate(); err != nil {
+		return err
+	}
+	return validateStackFormat(o.format, o.outputDir)
 }
 
 // Ask prompts for and validates any required flags.
@@ -217,6 +262,11 @@ func (o *packageEnvOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("generate CloudFormation template from environment %q manifest: %v", o.name, err)
 	}
+	if o.checkTemplate {
+		if err := o.checkStackTemplate(packager, res.Template); err != nil {
+			return err
+		}
+	}
 	if o.showDiff {
 		if err := diff(packager, res.Template, o.diffWriter); err != nil {
 			var errHasDiff *errHasDiff
@@ -228,6 +278,11 @@ func (o *packageEnvOpts) Execute() error {
 			}
 		}
 	}
+	if o.diffFile != "" {
+		if err := writeDiffFile(o.fs, o.diffFile, packager, res.Template); err != nil {
+			return err
+		}
+	}
 	addonsTemplate, err := packager.AddonsTemplate()
 	if err != nil {
 		return fmt.Errorf("retrieve environment addons template: %w", err)
@@ -235,12 +290,33 @@ func (o *packageEnvOpts) Execute() error {
 	if err := o.setWriters(); err != nil {
 		return err
 	}
-	if err := o.writeAndClose(o.tplWriter, res.Template); err != nil {
+	template, params := res.Template, res.Parameters
+	if o.format == stackFormatTerraform {
+		if template, params, err = o.terraformConfig(res); err != nil {
+			return err
+		}
+	}
+	if err := o.writeAndClose(o.tplWriter, template); err != nil {
 		return err
 	}
-	if err := o.writeAndClose(o.paramsWriter, res.Parameters); err != nil {
+	if err := o.writeAndClose(o.paramsWriter, params); err != nil {
 		return err
 	}
+	if o.outputDir != "" && o.format != stackFormatTerraform && o.format != stackFormatCDK {
+		if err := o.writeBootstrapStack(packager, principal.RootUserARN); err != nil {
+			return err
+		}
+	}
+	if o.format == stackFormatCDK {
+		app := cdk.App{
+			StackName:     stack.NameForEnv(o.appName, o.name),
+			TemplateFile:  fmt.Sprintf(envCFNTemplateNameFmt, o.name),
+			Configuration: res.Parameters,
+		}
+		if err := app.Write(o.fs, o.outputDir); err != nil {
+			return fmt.Errorf("write CDK application for environment %q: %w", o.name, err)
+		}
+	}
 	if addonsTemplate == "" {
 		return nil
 	}
@@ -250,6 +326,45 @@ func (o *packageEnvOpts) Execute() error {
 	return o.writeAndClose(o.addonsWriter, addonsTemplate)
 }
 
+// terraformConfig returns the template to write and a Terraform configuration that deploys it in place of the template configuration.
+// If the stack isn't written to a directory, the template is inlined in the Terraform configuration, which is returned as the template.
+func (o *packageEnvOpts) terraformConfig(res *deploy.GenerateCloudFormationTemplateOutput) (tpl, params string, err error) {
+	envCfg, err := o.getEnvCfg()
+	if err != nil {
+		return "", "", err
+	}
+	tf := terraform.Stack{
+		Name:             stack.NameForEnv(o.appName, o.name),
+		Template:         res.Template,
+		Configuration:    res.Parameters,
+		ExecutionRoleARN: envCfg.ExecutionRoleARN,
+	}
+	if o.outputDir != "" {
+		tf.TemplatePath = fmt.Sprintf(envCFNTemplateNameFmt, o.name)
+	}
+	hcl, err := tf.HCL()
+	if err != nil {
+		return "", "", fmt.Errorf("generate Terraform configuration for environment %q: %w", o.name, err)
+	}
+	if o.outputDir == "" {
+		return string(hcl), "", nil
+	}
+	return res.Template, string(hcl), nil
+}
+
+func (o *packageEnvOpts) checkStackTemplate(packager envPackager, template string) error {
+	app, err := o.getAppCfg()
+	if err != nil {
+		return err
+	}
+	checker := newTemplateChecker(app, o.name, o.runner, log.DiagnosticWriter)
+	if !checker.configured() {
+		log.Warningf("The deploy policies of application %s and environment %s don't configure any checks.\n", o.appName, o.name)
+		return nil
+	}
+	return checkStackTemplate(checker, packager, stack.NameForEnv(o.appName, o.name), template)
+}
+
 func (o *packageEnvOpts) getAppCfg() (*config.Application, error) {
 	if o.appCfg != nil {
 		return o.appCfg, nil
@@ -305,17 +420,52 @@ func (o *packageEnvOpts) setWriters() error {
 	if err != nil {
 		return fmt.Errorf("create file at %q: %w", path, err)
 	}
-	path = filepath.Join(o.outputDir, fmt.Sprintf(envCFNTemplateConfigurationNameFmt, o.name))
+	o.tplWriter = tplFile
+
+	paramsNameFmt := envCFNTemplateConfigurationNameFmt
+	switch o.format {
+	case stackFormatTerraform:
+		paramsNameFmt = envTerraformConfigurationNameFmt
+	case stackFormatCDK:
+		// The parameters are props of the CDK application instead.
+		o.paramsWriter = discardFile{}
+		return nil
+	}
+	path = filepath.Join(o.outputDir, fmt.Sprintf(paramsNameFmt, o.name))
 	paramsFile, err := o.fs.Create(path)
 	if err != nil {
 		return fmt.Errorf("create file at %q: %w", path, err)
 	}
-
-	o.tplWriter = tplFile
 	o.paramsWriter = paramsFile
 	return nil
 }
 
+// writeBootstrapStack writes the template and configuration of the stack that creates the roles of the environment,
+// which needs to be deployed before the environment stack by tools that create the environment outside of Copilot.
+func (o *packageEnvOpts) writeBootstrapStack(packager envPackager, rootUserARN string) error {
+	res, err := packager.GenerateBootstrapTemplate(&deploy.DeployEnvironmentInput{
+		RootUserARN:         rootUserARN,
+		PermissionsBoundary: o.appCfg.PermissionsBoundary,
+	})
+	if err != nil {
+		return fmt.Errorf("generate bootstrap CloudFormation template of environment %q: %v", o.name, err)
+	}
+	files := []struct {
+		nameFmt string
+		content string
+	}{
+		{envBootstrapCFNTemplateNameFmt, res.Template},
+		{envBootstrapCFNTemplateConfigurationNameFmt, res.Parameters},
+	}
+	for _, file := range files {
+		path := filepath.Join(o.outputDir, fmt.Sprintf(file.nameFmt, o.name))
+		if err := afero.WriteFile(o.fs, path, []byte(file.content), 0644); err != nil {
+			return fmt.Errorf("write file at %q: %w", path, err)
+		}
+	}
+	return nil
+}
+
 func (o *packageEnvOpts) setAddonsWriter() error {
 	if o.outputDir == "" {
 		return nil
@@ -342,7 +492,9 @@ func buildEnvPkgCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "package",
 		Short: "Print the AWS CloudFormation template of an environment.",
-		Long:  `Print the CloudFormation stack template and configuration used to deploy an environment.`,
+		Long: `Print the CloudFormation stack template and configuration used to deploy an environment.
+With --output-dir, also writes the addons template and the template and configuration of the stack
+that creates the environment's roles before its first deployment.`,
 		Example: `
   Print the CloudFormation template for the "prod" environment.
   /code $ copilot env package -n prod --upload-assets
@@ -351,8 +503,18 @@ func buildEnvPkgCmd() *cobra.Command {
   /startcodeblock
   $ copilot env package -n test --output-dir ./infrastructure --upload-assets
   $ ls ./infrastructure
-  test.env.yml      test.env.params.json
-  /endcodeblock`,
+  test.bootstrap.yml    test.bootstrap.params.json    test.env.yml      test.env.params.json
+  /endcodeblock
+
+  Write the CloudFormation template and a Terraform configuration that deploys it instead of the template configuration.
+  /startcodeblock
+  $ copilot env package -n test --output-dir ./infrastructure --upload-assets --format terraform
+  $ ls ./infrastructure
+  test.env.yml      test.env.tf
+  /endcodeblock
+
+  Print the CloudFormation template of the "test" environment without AWS credentials.
+  /code $ copilot env package -n test --offline --values ./copilot-values.yml`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newPackageEnvOpts(vars)
 			if err != nil {
@@ -364,12 +526,21 @@ func buildEnvPkgCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, "", stackOutputDirFlagDescription)
+	cmd.Flags().StringVar(&vars.format, stackFormatFlag, stackFormatCloudFormation, stackFormatFlagDescription)
 	cmd.Flags().BoolVar(&vars.uploadAssets, uploadAssetsFlag, false, uploadAssetsFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceEnvDeployFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().StringVar(&vars.diffFile, diffFileFlag, "", diffFileFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowEnvDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
+	cmd.Flags().BoolVar(&vars.checkTemplate, checkFlag, false, checkTemplateFlagDescription)
+	cmd.Flags().BoolVar(&vars.offline, offlineFlag, false, offlineFlagDescription)
+	cmd.Flags().StringVar(&vars.accountID, accountIDFlag, "", accountIDFlagDescription)
+	cmd.Flags().StringVar(&vars.valuesFile, valuesFlag, "", offlineValuesFlagDescription)
 
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, stackOutputDirFlag)
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, uploadAssetsFlag)
+	cmd.MarkFlagsMutuallyExclusive(offlineFlag, uploadAssetsFlag)
+	cmd.MarkFlagsMutuallyExclusive(offlineFlag, diffFlag)
+	cmd.MarkFlagsMutuallyExclusive(offlineFlag, diffFileFlag)
 	return cmd
 }
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"strings"
 	"testing"
 
@@ -465,6 +466,12 @@ func TestPackageEnvOpts_Execute(t *testing.T) {
 					Parameters: "parameters",
 				}, nil)
 				deployer.EXPECT().AddonsTemplate().Return("", nil)
+				deployer.EXPECT().GenerateBootstrapTemplate(&deploy.DeployEnvironmentInput{
+					PermissionsBoundary: "mockPermissionsBoundaryPolicy",
+				}).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template:   "bootstrap template",
+					Parameters: "bootstrap parameters",
+				}, nil)
 				fs := afero.NewMemMapFs()
 
 				return &packageEnvOpts{
@@ -500,6 +507,14 @@ func TestPackageEnvOpts_Execute(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, []byte("parameters"), actual)
 
+				actual, err = afero.ReadFile(fs, "infrastructure/test.bootstrap.yml")
+				require.NoError(t, err)
+				require.Equal(t, []byte("bootstrap template"), actual)
+
+				actual, err = afero.ReadFile(fs, "infrastructure/test.bootstrap.params.json")
+				require.NoError(t, err)
+				require.Equal(t, []byte("bootstrap parameters"), actual)
+
 				_, err = fs.Open(fmt.Sprintf("infrastructure/%s", envAddonsCFNTemplateName))
 				require.EqualError(t, err, fmt.Errorf("open infrastructure/%s: file does not exist", envAddonsCFNTemplateName).Error())
 			},
@@ -532,6 +547,7 @@ func TestPackageEnvOpts_Execute(t *testing.T) {
 					Parameters: "parameters",
 				}, nil)
 				deployer.EXPECT().AddonsTemplate().Return("addons", nil)
+				deployer.EXPECT().GenerateBootstrapTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
 				fs := afero.NewMemMapFs()
 
 				return &packageEnvOpts{
@@ -574,6 +590,175 @@ func TestPackageEnvOpts_Execute(t *testing.T) {
 				require.Equal(t, []byte("addons"), actual)
 			},
 		},
+		"should write a Terraform configuration that deploys the template": {
+			mockedCmd: func(ctrl *gomock.Controller) *packageEnvOpts {
+				ws := mocks.NewMockwsEnvironmentReader(ctrl)
+				ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte("name: test\ntype: Environment\n"), nil)
+				interop := mocks.NewMockinterpolator(ctrl)
+				interop.EXPECT().Interpolate("name: test\ntype: Environment\n").Return("name: test\ntype: Environment\n", nil)
+				caller := mocks.NewMockidentityService(ctrl)
+				caller.EXPECT().Get().Return(identity.Caller{}, nil)
+				deployer := mocks.NewMockenvPackager(ctrl)
+				deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				deployer.EXPECT().GenerateCloudFormationTemplate(&deploy.DeployEnvironmentInput{
+					RootUserARN:         "",
+					CustomResourcesURLs: nil,
+					Manifest: &manifest.Environment{
+						Workload: manifest.Workload{
+							Name: aws.String("test"),
+							Type: aws.String("Environment"),
+						},
+						EnvironmentConfig: manifest.EnvironmentConfig{},
+					},
+					ForceNewUpdate:      false,
+					RawManifest:         []byte("name: test\ntype: Environment\n"),
+					PermissionsBoundary: "mockPermissionsBoundaryPolicy",
+				}).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template:   "template",
+					Parameters: `{"Parameters":{"AppName":"demo"}}`,
+				}, nil)
+				deployer.EXPECT().AddonsTemplate().Return("", nil)
+				fs := afero.NewMemMapFs()
+
+				return &packageEnvOpts{
+					packageEnvVars: packageEnvVars{
+						name:      "test",
+						appName:   "demo",
+						outputDir: "infrastructure",
+						format:    "terraform",
+					},
+					ws:     ws,
+					caller: caller,
+					newInterpolator: func(_, _ string) interpolator {
+						return interop
+					},
+					newEnvPackager: func() (envPackager, error) {
+						return deployer, nil
+					},
+					fs: fs,
+					envCfg: &config.Environment{
+						Name:             "test",
+						ExecutionRoleARN: "arn:aws:iam::123456789012:role/demo-test-CFNExecutionRole",
+					},
+					appCfg: &config.Application{
+						PermissionsBoundary: "mockPermissionsBoundaryPolicy",
+					},
+				}
+			},
+			wantedFS: func(t *testing.T, fs afero.Fs) {
+				f, err := fs.Open("infrastructure/test.env.yml")
+				require.NoError(t, err)
+				actual, err := io.ReadAll(f)
+				require.NoError(t, err)
+				require.Equal(t, []byte("template"), actual)
+
+				f, err = fs.Open("infrastructure/test.env.tf")
+				require.NoError(t, err)
+				actual, err = io.ReadAll(f)
+				require.NoError(t, err)
+				require.Equal(t, `# Generated by AWS Copilot from the CloudFormation stack "demo-test".
+# The variables default to the parameters of the stack when it was packaged.
+
+variable "app_name" {
+  type    = string
+  default = "demo"
+}
+
+resource "aws_cloudformation_stack" "demo_test" {
+  name          = "demo-test"
+  template_body = file("${path.module}/test.env.yml")
+  capabilities  = ["CAPABILITY_IAM", "CAPABILITY_NAMED_IAM", "CAPABILITY_AUTO_EXPAND"]
+  iam_role_arn  = "arn:aws:iam::123456789012:role/demo-test-CFNExecutionRole"
+
+  parameters = {
+    AppName = var.app_name
+  }
+}
+`, string(actual))
+
+				_, err = fs.Stat("infrastructure/test.env.params.json")
+				require.True(t, os.IsNotExist(err))
+			},
+		},
+		"should write a CDK application that includes the template": {
+			mockedCmd: func(ctrl *gomock.Controller) *packageEnvOpts {
+				ws := mocks.NewMockwsEnvironmentReader(ctrl)
+				ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte("name: test\ntype: Environment\n"), nil)
+				interop := mocks.NewMockinterpolator(ctrl)
+				interop.EXPECT().Interpolate("name: test\ntype: Environment\n").Return("name: test\ntype: Environment\n", nil)
+				caller := mocks.NewMockidentityService(ctrl)
+				caller.EXPECT().Get().Return(identity.Caller{}, nil)
+				deployer := mocks.NewMockenvPackager(ctrl)
+				deployer.EXPECT().Validate(gomock.Any()).Return(nil)
+				deployer.EXPECT().GenerateCloudFormationTemplate(&deploy.DeployEnvironmentInput{
+					RootUserARN:         "",
+					CustomResourcesURLs: nil,
+					Manifest: &manifest.Environment{
+						Workload: manifest.Workload{
+							Name: aws.String("test"),
+							Type: aws.String("Environment"),
+						},
+						EnvironmentConfig: manifest.EnvironmentConfig{},
+					},
+					ForceNewUpdate:      false,
+					RawManifest:         []byte("name: test\ntype: Environment\n"),
+					PermissionsBoundary: "mockPermissionsBoundaryPolicy",
+				}).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template:   "template",
+					Parameters: `{"Parameters":{"AppName":"demo"}}`,
+				}, nil)
+				deployer.EXPECT().AddonsTemplate().Return("", nil)
+				fs := afero.NewMemMapFs()
+
+				return &packageEnvOpts{
+					packageEnvVars: packageEnvVars{
+						name:      "test",
+						appName:   "demo",
+						outputDir: "infrastructure",
+						format:    "cdk",
+					},
+					ws:     ws,
+					caller: caller,
+					newInterpolator: func(_, _ string) interpolator {
+						return interop
+					},
+					newEnvPackager: func() (envPackager, error) {
+						return deployer, nil
+					},
+					fs: fs,
+					envCfg: &config.Environment{
+						Name:             "test",
+						ExecutionRoleARN: "arn:aws:iam::123456789012:role/demo-test-CFNExecutionRole",
+					},
+					appCfg: &config.Application{
+						PermissionsBoundary: "mockPermissionsBoundaryPolicy",
+					},
+				}
+			},
+			wantedFS: func(t *testing.T, fs afero.Fs) {
+				f, err := fs.Open("infrastructure/test.env.yml")
+				require.NoError(t, err)
+				actual, err := io.ReadAll(f)
+				require.NoError(t, err)
+				require.Equal(t, []byte("template"), actual)
+
+				f, err = fs.Open("infrastructure/stack.ts")
+				require.NoError(t, err)
+				actual, err = io.ReadAll(f)
+				require.NoError(t, err)
+				require.Contains(t, string(actual), `templateFile: path.join(__dirname, "test.env.yml"),`)
+				require.Contains(t, string(actual), `"AppName": props.appName,`)
+
+				f, err = fs.Open("infrastructure/bin/app.ts")
+				require.NoError(t, err)
+				actual, err = io.ReadAll(f)
+				require.NoError(t, err)
+				require.Contains(t, string(actual), `stackName: "demo-test",`)
+
+				_, err = fs.Stat("infrastructure/test.env.params.json")
+				require.True(t, os.IsNotExist(err))
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -17,6 +17,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/output"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/spf13/cobra"
@@ -35,6 +36,8 @@ type showEnvVars struct {
 	shouldOutputJSON      bool
 	shouldOutputResources bool
 	shouldOutputManifest  bool
+	shouldOutputCost      bool
+	shouldOutputFeatures  bool
 }
 
 type showEnvOpts struct {
@@ -45,6 +48,7 @@ type showEnvOpts struct {
 	describer        envDescriber
 	sel              configSelector
 	initEnvDescriber func() error
+	newCostEstimator func() (costEstimator, error)
 }
 
 func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
@@ -53,7 +57,7 @@ func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
 	if err != nil {
 		return nil, err
 	}
-	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	store := config.NewCachedSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
 
 	deployStore, err := deploy.NewStore(sessProvider, store)
 	if err != nil {
@@ -66,6 +70,13 @@ func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
 		w:           log.OutputWriter,
 		sel:         selector.NewConfigSelector(prompt.New(), store),
 	}
+	opts.newCostEstimator = func() (costEstimator, error) {
+		return describe.NewCostEstimator(describe.NewCostEstimatorConfig{
+			App:         opts.appName,
+			ConfigStore: store,
+			DeployStore: deployStore,
+		})
+	}
 	opts.initEnvDescriber = func() error {
 		d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 			App:             opts.appName,
@@ -73,6 +84,7 @@ func newShowEnvOpts(vars showEnvVars) (*showEnvOpts, error) {
 			ConfigStore:     store,
 			DeployStore:     deployStore,
 			EnableResources: opts.shouldOutputResources,
+			Cached:          true,
 		})
 		if err != nil {
 			return fmt.Errorf("creating describer for environment %s in application %s: %w", opts.name, opts.appName, err)
@@ -98,27 +110,24 @@ func (o *showEnvOpts) Ask() error {
 
 // Execute shows the environments through the prompt.
 func (o *showEnvOpts) Execute() error {
+	if o.shouldOutputCost {
+		return o.writeCost()
+	}
 	if err := o.initEnvDescriber(); err != nil {
 		return err
 	}
 	if o.shouldOutputManifest {
 		return o.writeManifest()
 	}
+	if o.shouldOutputFeatures {
+		return o.writeFeatures()
+	}
 
 	env, err := o.describer.Describe()
 	if err != nil {
 		return fmt.Errorf("describe environment %s: %w", o.name, err)
 	}
-	content := env.HumanString()
-	if o.shouldOutputJSON {
-		data, err := env.JSONString()
-		if err != nil {
-			return err
-		}
-		content = data
-	}
-	fmt.Fprint(o.w, content)
-	return nil
+	return output.New(o.w, o.shouldOutputJSON).Write(env)
 }
 
 func (o *showEnvOpts) validateOrAskApp() error {
@@ -168,6 +177,34 @@ func (o *showEnvOpts) writeManifest() error {
 	return nil
 }
 
+func (o *showEnvOpts) writeFeatures() error {
+	features, err := o.describer.Features()
+	if err != nil {
+		return fmt.Errorf("describe features of environment %s: %w", o.name, err)
+	}
+	out := output.New(o.w, o.shouldOutputJSON)
+	if err := out.Write(features); err != nil {
+		return err
+	}
+	if !out.JSON() && len(features.RequiresUpgrade()) > 0 {
+		log.Infof("\nRun %s to preview the changes of upgrading the environment to %s.\n",
+			color.HighlightCode(fmt.Sprintf("copilot env upgrade -n %s --plan", o.name)), features.LatestVersion)
+	}
+	return nil
+}
+
+func (o *showEnvOpts) writeCost() error {
+	estimator, err := o.newCostEstimator()
+	if err != nil {
+		return err
+	}
+	estimate, err := estimator.Environment(o.name)
+	if err != nil {
+		return fmt.Errorf("estimate cost of environment %s: %w", o.name, err)
+	}
+	return output.New(o.w, o.shouldOutputJSON).Write(estimate)
+}
+
 // buildEnvShowCmd builds the command for showing environments in an application.
 func buildEnvShowCmd() *cobra.Command {
 	vars := showEnvVars{}
@@ -180,7 +217,11 @@ func buildEnvShowCmd() *cobra.Command {
   Print configuration for the "test" environment.
   /code $ copilot env show -n test
   Print manifest file for deploying the "prod" environment.
-  /code $ copilot env show -n prod --manifest`,
+  /code $ copilot env show -n prod --manifest
+  Print the estimated monthly cost of the "prod" environment and its services.
+  /code $ copilot env show -n prod --cost
+  Print which optional features are enabled in the "prod" environment.
+  /code $ copilot env show -n prod --features`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newShowEnvOpts(vars)
 			if err != nil {
@@ -194,8 +235,15 @@ func buildEnvShowCmd() *cobra.Command {
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, envResourcesFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputManifest, manifestFlag, false, manifestFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputCost, costFlag, false, envCostFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputFeatures, featuresFlag, false, envFeaturesFlagDescription)
 
 	cmd.MarkFlagsMutuallyExclusive(jsonFlag, manifestFlag)
 	cmd.MarkFlagsMutuallyExclusive(resourcesFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(costFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(costFlag, resourcesFlag)
+	cmd.MarkFlagsMutuallyExclusive(featuresFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(featuresFlag, costFlag)
+	cmd.MarkFlagsMutuallyExclusive(featuresFlag, resourcesFlag)
 	return cmd
 }
@@ -21,9 +21,10 @@ import (
 )
 
 type showEnvMocks struct {
-	storeSvc  *mocks.Mockstore
-	describer *mocks.MockenvDescriber
-	sel       *mocks.MockconfigSelector
+	storeSvc      *mocks.Mockstore
+	describer     *mocks.MockenvDescriber
+	sel           *mocks.MockconfigSelector
+	costEstimator *mocks.MockcostEstimator
 }
 
 func TestEnvShow_Ask(t *testing.T) {
@@ -194,6 +195,8 @@ func TestEnvShow_Execute(t *testing.T) {
 		inputEnv             string
 		shouldOutputJSON     bool
 		shouldOutputManifest bool
+		shouldOutputCost     bool
+		shouldOutputFeatures bool
 
 		setupMocks func(mocks showEnvMocks)
 
@@ -272,6 +275,76 @@ Resources
 
 			wantedContent: "hello\n",
 		},
+		"should print the estimated cost": {
+			inputEnv:         "testEnv",
+			shouldOutputCost: true,
+			setupMocks: func(m showEnvMocks) {
+				m.costEstimator.EXPECT().Environment("testEnv").Return(&describe.CostEstimate{
+					Currency: "USD",
+					Environments: []*describe.EnvCost{
+						{
+							Environment: "testEnv",
+							Region:      "us-west-2",
+							Resources: []*describe.ResourceCost{
+								{Resource: "NAT gateway", Quantity: 1, Unit: "count", MonthlyCost: 32.85},
+							},
+							Total: 32.85,
+						},
+					},
+					Total: 32.85,
+				}, nil)
+			},
+
+			wantedContent: `Estimated Monthly Cost
+
+  Environment  Service   Resource     Quantity  Cost
+  -----------  -------   --------     --------  ----
+  testEnv      -         NAT gateway  1         $32.85
+
+Total
+
+  testEnv (us-west-2)  $32.85
+  All environments     $32.85
+
+Estimates assume that the resources run all month at their desired count or minimum capacity with on-demand prices.
+They exclude usage-based charges such as data processing, requests and storage.
+`,
+		},
+		"return error if fail to estimate the cost": {
+			inputEnv:         "testEnv",
+			shouldOutputCost: true,
+			setupMocks: func(m showEnvMocks) {
+				m.costEstimator.EXPECT().Environment("testEnv").Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("estimate cost of environment testEnv: some error"),
+		},
+		"should print the features in JSON format": {
+			inputEnv:             "testEnv",
+			shouldOutputJSON:     true,
+			shouldOutputFeatures: true,
+			setupMocks: func(m showEnvMocks) {
+				m.describer.EXPECT().Features().Return(&describe.EnvFeatures{
+					Environment:   "testEnv",
+					Version:       "v1.30.0",
+					LatestVersion: "v1.43.0",
+					Features: []*describe.EnvFeature{
+						{Name: "Internal ALB", Status: describe.EnvFeatureEnabled, Workloads: []string{"api"}, LeastVersion: "v1.10.0"},
+					},
+				}, nil)
+			},
+
+			wantedContent: `{"environment":"testEnv","version":"v1.30.0","latestVersion":"v1.43.0","features":[{"name":"Internal ALB","status":"enabled","workloads":["api"],"leastVersion":"v1.10.0"}]}` + "\n",
+		},
+		"return error if fail to describe the features": {
+			inputEnv:             "testEnv",
+			shouldOutputFeatures: true,
+			setupMocks: func(m showEnvMocks) {
+				m.describer.EXPECT().Features().Return(nil, mockError)
+			},
+
+			wantedError: fmt.Errorf("describe features of environment testEnv: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -283,8 +356,10 @@ Resources
 			mockStoreReader := mocks.NewMockstore(ctrl)
 			mockEnvDescriber := mocks.NewMockenvDescriber(ctrl)
 
+			mockCostEstimator := mocks.NewMockcostEstimator(ctrl)
 			mocks := showEnvMocks{
-				describer: mockEnvDescriber,
+				describer:     mockEnvDescriber,
+				costEstimator: mockCostEstimator,
 			}
 
 			tc.setupMocks(mocks)
@@ -294,11 +369,16 @@ Resources
 					name:                 tc.inputEnv,
 					shouldOutputJSON:     tc.shouldOutputJSON,
 					shouldOutputManifest: tc.shouldOutputManifest,
+					shouldOutputCost:     tc.shouldOutputCost,
+					shouldOutputFeatures: tc.shouldOutputFeatures,
 				},
 				store:            mockStoreReader,
 				describer:        mockEnvDescriber,
 				initEnvDescriber: func() error { return nil },
-				w:                b,
+				newCostEstimator: func() (costEstimator, error) {
+					return mockCostEstimator, nil
+				},
+				w: b,
 			}
 
 			// WHEN
@@ -0,0 +1,285 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/rds"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envStopAppNameHelpPrompt = "The services of an environment in the selected application will be paused."
+	envStopNamePrompt        = "Which environment would you like to stop?"
+	fmtEnvStopConfirmPrompt  = "Are you sure you want to pause all services in environment %q of application %q?"
+
+	fmtEnvStopClusterStart    = "Stopping Aurora cluster %s in environment %s."
+	fmtEnvStopClusterFailed   = "Failed to stop Aurora cluster %s in environment %s.\n"
+	fmtEnvStopClusterComplete = "Stopped Aurora cluster %s in environment %s.\n"
+
+	auroraClusterStatusAvailable = "available"
+	auroraServerlessV1EngineMode = "serverless"
+)
+
+var (
+	envStopAppNamePrompt = fmt.Sprintf("In which %s would you like to stop an environment?", color.Emphasize("application"))
+
+	errEnvStopCancelled = errors.New("env stop cancelled - no changes made")
+)
+
+type stopEnvVars struct {
+	appName          string
+	name             string
+	stopDatabases    bool
+	skipConfirmation bool
+}
+
+type stopEnvOpts struct {
+	stopEnvVars
+
+	store       store
+	deployStore deployedEnvironmentLister
+	sel         configSelector
+	prompt      prompter
+	prog        progress
+
+	// Clients initialized with the environment manager role.
+	ecsPauser      ecsServicePauser
+	rdwsPauser     servicePauser
+	rdwsServiceARN func(svc string) (string, error)
+	rds            rdsClusterStopper
+
+	// initRuntimeClients is overridden in tests.
+	initRuntimeClients func(*stopEnvOpts) error
+}
+
+func newStopEnvOpts(vars stopEnvVars) (*stopEnvOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env stop"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	return &stopEnvOpts{
+		stopEnvVars: vars,
+		store:       configStore,
+		deployStore: deployStore,
+		sel:         selector.NewConfigSelector(prompter, configStore),
+		prompt:      prompter,
+		prog:        termprogress.NewSpinner(log.DiagnosticWriter),
+		initRuntimeClients: func(o *stopEnvOpts) error {
+			env, err := o.store.GetEnvironment(o.appName, o.name)
+			if err != nil {
+				return fmt.Errorf("get environment %s configuration: %w", o.name, err)
+			}
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			o.ecsPauser = ecs.New(sess)
+			o.rdwsPauser = apprunner.New(sess)
+			o.rdwsServiceARN = func(svc string) (string, error) {
+				d, err := describe.NewRDWebServiceDescriber(describe.NewServiceConfig{
+					App:         o.appName,
+					Svc:         svc,
+					ConfigStore: configStore,
+				})
+				if err != nil {
+					return "", err
+				}
+				return d.ServiceARN(o.name)
+			}
+			o.rds = rds.New(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the individual user inputs are invalid.
+func (o *stopEnvOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates the application and environment names, then confirms the operation.
+func (o *stopEnvOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	if err := o.validateOrAskEnv(); err != nil {
+		return err
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtEnvStopConfirmPrompt, o.name, o.appName), "", prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("confirm to stop environment %s: %w", o.name, err)
+	}
+	if !confirmed {
+		return errEnvStopCancelled
+	}
+	return nil
+}
+
+// Execute pauses every service deployed in the environment, and optionally stops its Aurora clusters.
+func (o *stopEnvOpts) Execute() error {
+	if err := o.initRuntimeClients(o); err != nil {
+		return err
+	}
+	svcs, err := o.deployStore.ListDeployedServices(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("list services deployed in environment %s: %w", o.name, err)
+	}
+	for _, name := range svcs {
+		if err := o.pauseService(name); err != nil {
+			return err
+		}
+	}
+	if !o.stopDatabases {
+		return nil
+	}
+	return o.stopClusters()
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *stopEnvOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to start processing requests again for each of the services.",
+			color.HighlightCode(fmt.Sprintf("copilot svc resume -n <name> -e %s", o.name))),
+	})
+	return nil
+}
+
+func (o *stopEnvOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(envStopAppNamePrompt, envStopAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *stopEnvOpts) validateOrAskEnv() error {
+	if o.name != "" {
+		_, err := o.store.GetEnvironment(o.appName, o.name)
+		return err
+	}
+	env, err := o.sel.Environment(envStopNamePrompt, "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.name = env
+	return nil
+}
+
+func (o *stopEnvOpts) pauseService(name string) error {
+	svc, err := o.store.GetService(o.appName, name)
+	if err != nil {
+		return fmt.Errorf("get service %s configuration: %w", name, err)
+	}
+	if !contains(svc.Type, pausableServiceTypes) {
+		log.Infof("Skipping service %s since services with type %s can't be paused.\n", name, svc.Type)
+		return nil
+	}
+	o.prog.Start(fmt.Sprintf(fmtSvcPauseStart, name, o.name))
+	if contains(svc.Type, ecsServiceTypes) {
+		err = o.ecsPauser.PauseService(o.appName, o.name, name)
+	} else {
+		err = o.pauseRDWS(name)
+	}
+	if err != nil {
+		o.prog.Stop(log.Serrorf(fmtsvcPauseFailed, name, o.name))
+		return err
+	}
+	o.prog.Stop(log.Ssuccessf(fmtSvcPauseSucceed, name, o.name))
+	return nil
+}
+
+func (o *stopEnvOpts) pauseRDWS(name string) error {
+	arn, err := o.rdwsServiceARN(name)
+	if err != nil {
+		return fmt.Errorf("retrieve ServiceARN for %s: %w", name, err)
+	}
+	return o.rdwsPauser.PauseService(arn)
+}
+
+func (o *stopEnvOpts) stopClusters() error {
+	clusters, err := o.rds.ListClusters(map[string]string{
+		deploy.AppTagKey: o.appName,
+		deploy.EnvTagKey: o.name,
+	})
+	if err != nil {
+		return fmt.Errorf("list Aurora clusters of environment %s: %w", o.name, err)
+	}
+	for _, cluster := range clusters {
+		if cluster.EngineMode == auroraServerlessV1EngineMode {
+			// Aurora Serverless v1 clusters can't be stopped, they pause on their own when idle instead.
+			continue
+		}
+		if cluster.Status != auroraClusterStatusAvailable {
+			continue
+		}
+		o.prog.Start(fmt.Sprintf(fmtEnvStopClusterStart, cluster.ID, o.name))
+		if err := o.rds.StopCluster(cluster.ID); err != nil {
+			o.prog.Stop(log.Serrorf(fmtEnvStopClusterFailed, cluster.ID, o.name))
+			return err
+		}
+		o.prog.Stop(log.Ssuccessf(fmtEnvStopClusterComplete, cluster.ID, o.name))
+	}
+	return nil
+}
+
+// buildEnvStopCmd builds the command to pause the services of an environment.
+func buildEnvStopCmd() *cobra.Command {
+	vars := stopEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "stop",
+		Short: "Pauses the services of an environment to save costs.",
+		Long: `Pauses the services of an environment to save costs.
+Services running on Amazon ECS are scaled down to zero tasks and App Runner services are paused.
+Jobs keep running on their schedule. Resume each service with "copilot svc resume".`,
+		Example: `
+  Pause all the services in the "test" environment.
+  /code $ copilot env stop --name test
+  Pause all the services and stop the Aurora clusters in the "test" environment.
+  /code $ copilot env stop --name test --stop-databases`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newStopEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.stopDatabases, stopDatabasesFlag, false, envStopDatabasesFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,202 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/rds"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type stopEnvMocks struct {
+	store       *mocks.Mockstore
+	deployStore *mocks.MockdeployedEnvironmentLister
+	sel         *mocks.MockconfigSelector
+	prompt      *mocks.Mockprompter
+	prog        *mocks.Mockprogress
+	ecsPauser   *mocks.MockecsServicePauser
+	rdwsPauser  *mocks.MockservicePauser
+	rds         *mocks.MockrdsClusterStopper
+}
+
+func TestStopEnvOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName          string
+		inEnvName          string
+		inSkipConfirmation bool
+		setupMocks         func(m stopEnvMocks)
+
+		wantedAppName string
+		wantedEnvName string
+		wantedError   error
+	}{
+		"prompt for the application and the environment": {
+			inSkipConfirmation: true,
+			setupMocks: func(m stopEnvMocks) {
+				m.sel.EXPECT().Application(envStopAppNamePrompt, envStopAppNameHelpPrompt).Return("phonetool", nil)
+				m.sel.EXPECT().Environment(envStopNamePrompt, "", "phonetool").Return("test", nil)
+			},
+			wantedAppName: "phonetool",
+			wantedEnvName: "test",
+		},
+		"validate the environment name": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			setupMocks: func(m stopEnvMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"error if the user cancels": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			setupMocks: func(m stopEnvMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+				m.prompt.EXPECT().Confirm(`Are you sure you want to pause all services in environment "test" of application "phonetool"?`, "", gomock.Any()).Return(false, nil)
+			},
+			wantedError: errEnvStopCancelled,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := stopEnvMocks{
+				store:  mocks.NewMockstore(ctrl),
+				sel:    mocks.NewMockconfigSelector(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &stopEnvOpts{
+				stopEnvVars: stopEnvVars{
+					appName:          tc.inAppName,
+					name:             tc.inEnvName,
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				store:  m.store,
+				sel:    m.sel,
+				prompt: m.prompt,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedAppName, opts.appName)
+				require.Equal(t, tc.wantedEnvName, opts.name)
+			}
+		})
+	}
+}
+
+func TestStopEnvOpts_Execute(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inStopDatabases bool
+		setupMocks      func(m stopEnvMocks)
+
+		wantedError error
+	}{
+		"error if the deployed services can't be listed": {
+			setupMocks: func(m stopEnvMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return(nil, mockError)
+			},
+			wantedError: errors.New("list services deployed in environment test: some error"),
+		},
+		"error if a service fails to pause": {
+			setupMocks: func(m stopEnvMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"api"}, nil)
+				m.store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{Type: manifestinfo.LoadBalancedWebServiceType}, nil)
+				m.prog.EXPECT().Start("Pausing service api in environment test.")
+				m.ecsPauser.EXPECT().PauseService("phonetool", "test", "api").Return(mockError)
+				m.prog.EXPECT().Stop(log.Serrorf("Failed to pause service api in environment test.\n"))
+			},
+			wantedError: mockError,
+		},
+		"pause every service that can be paused": {
+			setupMocks: func(m stopEnvMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"api", "frontend", "site"}, nil)
+				m.store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{Type: manifestinfo.BackendServiceType}, nil)
+				m.store.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{Type: manifestinfo.RequestDrivenWebServiceType}, nil)
+				m.store.EXPECT().GetService("phonetool", "site").Return(&config.Workload{Type: manifestinfo.StaticSiteType}, nil)
+				m.prog.EXPECT().Start(gomock.Any()).Times(2)
+				m.ecsPauser.EXPECT().PauseService("phonetool", "test", "api").Return(nil)
+				m.rdwsPauser.EXPECT().PauseService("frontend-arn").Return(nil)
+				m.prog.EXPECT().Stop(gomock.Any()).Times(2)
+			},
+		},
+		"stop the available Aurora clusters": {
+			inStopDatabases: true,
+			setupMocks: func(m stopEnvMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return(nil, nil)
+				m.rds.EXPECT().ListClusters(map[string]string{
+					"copilot-application": "phonetool",
+					"copilot-environment": "test",
+				}).Return([]rds.Cluster{
+					{ID: "db", Status: "available", EngineMode: "provisioned"},
+					{ID: "stopped-db", Status: "stopped", EngineMode: "provisioned"},
+					{ID: "v1-db", Status: "available", EngineMode: "serverless"},
+				}, nil)
+				m.prog.EXPECT().Start("Stopping Aurora cluster db in environment test.")
+				m.rds.EXPECT().StopCluster("db").Return(nil)
+				m.prog.EXPECT().Stop(log.Ssuccessf("Stopped Aurora cluster db in environment test.\n"))
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := stopEnvMocks{
+				store:       mocks.NewMockstore(ctrl),
+				deployStore: mocks.NewMockdeployedEnvironmentLister(ctrl),
+				prog:        mocks.NewMockprogress(ctrl),
+				ecsPauser:   mocks.NewMockecsServicePauser(ctrl),
+				rdwsPauser:  mocks.NewMockservicePauser(ctrl),
+				rds:         mocks.NewMockrdsClusterStopper(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &stopEnvOpts{
+				stopEnvVars: stopEnvVars{
+					appName:       "phonetool",
+					name:          "test",
+					stopDatabases: tc.inStopDatabases,
+				},
+				store:       m.store,
+				deployStore: m.deployStore,
+				prog:        m.prog,
+				initRuntimeClients: func(o *stopEnvOpts) error {
+					o.ecsPauser = m.ecsPauser
+					o.rdwsPauser = m.rdwsPauser
+					o.rdwsServiceARN = func(svc string) (string, error) {
+						return svc + "-arn", nil
+					}
+					o.rds = m.rds
+					return nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
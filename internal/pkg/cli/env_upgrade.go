@@ -4,28 +4,378 @@
 package cli
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	"golang.org/x/mod/semver"
 )
 
-// Deprecated. Clients should use "env deploy" instead after copilot v1.20.0.
+type upgradeEnvVars struct {
+	appName string
+	name    string
+	all     bool
+	plan    bool
+}
+
+type upgradeEnvOpts struct {
+	upgradeEnvVars
+
+	store         store
+	sel           wsEnvironmentSelector
+	ws            wsEnvironmentWorkloadLister
+	deployStore   deployedWorkloadsLister
+	w             io.Writer
+	latestVersion string
+
+	newFeaturesDescriber func(appName, envName string) (envFeaturesDescriber, error)
+	newPlanner           func(vars packageEnvVars) (cmd, error)
+
+	// Used with --all to detect the version of each stack and to upgrade it.
+	newEnvVersionGetter  func(appName, envName string) (versionGetter, error)
+	newWkldVersionGetter func(appName, envName, name string) (versionGetter, error)
+	newEnvDeployer       func(vars deployEnvVars) (cmd, error)
+	newWkldDeployer      func(vars deployWkldVars) (cmd, error)
+}
+
+func newUpgradeEnvOpts(vars upgradeEnvVars) (*upgradeEnvOpts, error) {
+	if !vars.plan && !vars.all {
+		return &upgradeEnvOpts{upgradeEnvVars: vars}, nil
+	}
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("env upgrade"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &upgradeEnvOpts{
+		upgradeEnvVars: vars,
+		store:          store,
+		sel:            selector.NewLocalEnvironmentSelector(prompt.New(), store, ws),
+		ws:             ws,
+		deployStore:    deployStore,
+		w:              log.OutputWriter,
+		latestVersion:  version.LatestTemplateVersion(),
+		newFeaturesDescriber: func(appName, envName string) (envFeaturesDescriber, error) {
+			return describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+				App:         appName,
+				Env:         envName,
+				ConfigStore: store,
+			})
+		},
+		newPlanner: func(vars packageEnvVars) (cmd, error) {
+			opts, err := newPackageEnvOpts(vars)
+			if err != nil {
+				return nil, err
+			}
+			// Only the diff is printed, not the template.
+			opts.tplWriter = discardFile{}
+			return opts, nil
+		},
+		newEnvVersionGetter: func(appName, envName string) (versionGetter, error) {
+			return describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+				App:         appName,
+				Env:         envName,
+				ConfigStore: store,
+			})
+		},
+		newWkldVersionGetter: func(appName, envName, name string) (versionGetter, error) {
+			return describe.NewWorkloadStackDescriber(describe.NewWorkloadConfig{
+				App:         appName,
+				Env:         envName,
+				Name:        name,
+				ConfigStore: store,
+			})
+		},
+		newEnvDeployer: func(vars deployEnvVars) (cmd, error) {
+			return newEnvDeployOpts(vars)
+		},
+		newWkldDeployer: func(vars deployWkldVars) (cmd, error) {
+			wkld, err := store.GetWorkload(vars.appName, vars.name)
+			if err != nil {
+				return nil, fmt.Errorf("get workload %s: %w", vars.name, err)
+			}
+			if contains(wkld.Type, manifestinfo.JobTypes()) {
+				return newJobDeployOpts(vars)
+			}
+			return newSvcDeployOpts(vars)
+		},
+	}, nil
+}
+
+// Validate is a no-op for this command.
+func (o *upgradeEnvOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for the environment to plan the upgrade of.
+// Without --plan or --all, the command is deprecated and there is nothing to ask.
+func (o *upgradeEnvOpts) Ask() error {
+	if !o.plan && !o.all {
+		return nil
+	}
+	if o.appName == "" {
+		// NOTE: This command is required to be executed under a workspace. We don't prompt for it.
+		return errNoAppInWorkspace
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %q configuration: %w", o.appName, err)
+	}
+	if o.all {
+		return nil
+	}
+	if o.name != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.name); err != nil {
+			return fmt.Errorf("get environment %q in application %q: %w", o.name, o.appName, err)
+		}
+		return nil
+	}
+	name, err := o.sel.LocalEnvironment("Select an environment manifest from your workspace", "")
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute prints the features that upgrading the environment makes available, followed by
+// the diff between the deployed template and the template generated at the latest version.
+// With --all, it upgrades every outdated stack of the application instead.
+func (o *upgradeEnvOpts) Execute() error {
+	if o.all {
+		return o.upgradeAll()
+	}
+	if !o.plan {
+		return nil
+	}
+	describer, err := o.newFeaturesDescriber(o.appName, o.name)
+	if err != nil {
+		return err
+	}
+	features, err := describer.Features()
+	if err != nil {
+		return fmt.Errorf("describe features of environment %s: %w", o.name, err)
+	}
+	if features.Version == features.LatestVersion {
+		log.Infof("Environment %s is already on the latest version %s.\n", o.name, features.Version)
+	} else {
+		log.Infof("Upgrading environment %s from version %s to %s.\n", o.name, features.Version, features.LatestVersion)
+	}
+	if newFeatures := features.RequiresUpgrade(); len(newFeatures) > 0 {
+		names := make([]string, len(newFeatures))
+		for i, feature := range newFeatures {
+			names[i] = feature.Name
+		}
+		log.Infof("The upgrade makes the following features available: %s.\n", strings.Join(names, ", "))
+	}
+	planner, err := o.newPlanner(packageEnvVars{
+		name:     o.name,
+		appName:  o.appName,
+		showDiff: true,
+	})
+	if err != nil {
+		return err
+	}
+	if err := planner.Ask(); err != nil {
+		return err
+	}
+	if err := planner.Execute(); err != nil {
+		var errHasDiff *errHasDiff
+		if errors.As(err, &errHasDiff) {
+			log.Infof("\nRun %s to apply the upgrade.\n", color.HighlightCode(fmt.Sprintf("copilot env deploy -n %s", o.name)))
+			return nil
+		}
+		return fmt.Errorf("plan the upgrade of environment %s: %w", o.name, err)
+	}
+	return nil
+}
+
+// outdatedStack is an environment or workload stack generated by an older version of Copilot.
+type outdatedStack struct {
+	env     string
+	name    string // Name of the workload, empty for the environment stack.
+	version string
+}
+
+func (s outdatedStack) String() string {
+	if s.name == "" {
+		return fmt.Sprintf("environment %s", s.env)
+	}
+	return fmt.Sprintf("%s in environment %s", s.name, s.env)
+}
+
+// upgradeAll lists the stacks of the application that are on an older version, then deploys them one after the other
+// with their template diff, so that each one is confirmed. An environment is upgraded before its workloads.
+// With --plan, the stacks are only listed.
+func (o *upgradeEnvOpts) upgradeAll() error {
+	stacks, err := o.outdatedStacks()
+	if err != nil {
+		return err
+	}
+	if len(stacks) == 0 {
+		log.Successf("All the environments and workloads of application %s are on the latest version %s.\n", o.appName, o.latestVersion)
+		return nil
+	}
+	rows := make([][]string, len(stacks))
+	for i, s := range stacks {
+		rows[i] = []string{s.env, orDash(s.name), s.version}
+	}
+	log.Infof("%s of application %s are on an older version than %s:\n\n", english.Plural(len(stacks), "stack", "stacks"), o.appName, o.latestVersion)
+	writeTable(o.w, []string{"Environment", "Workload", "Version"}, rows)
+	if o.plan {
+		log.Infof("\nRun %s to upgrade them.\n", color.HighlightCode("copilot env upgrade --all"))
+		return nil
+	}
+	for i, s := range stacks {
+		log.Infof("\n[%d/%d] Upgrading %s from version %s to %s.\n", i+1, len(stacks), s, s.version, o.latestVersion)
+		if err := o.upgradeStack(s); err != nil {
+			return fmt.Errorf("upgrade %s: %w", s, err)
+		}
+	}
+	return nil
+}
+
+// outdatedStacks returns the environments with a manifest in the workspace that are on an older version,
+// and the workloads of the workspace deployed to them that are on an older version.
+func (o *upgradeEnvOpts) outdatedStacks() ([]outdatedStack, error) {
+	envs, err := o.store.ListEnvironments(o.appName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments of application %s: %w", o.appName, err)
+	}
+	localEnvs, err := o.ws.ListEnvironments()
+	if err != nil {
+		return nil, fmt.Errorf("list environments in the workspace: %w", err)
+	}
+	localWklds, err := o.ws.ListWorkloads()
+	if err != nil {
+		return nil, fmt.Errorf("list services and jobs in the workspace: %w", err)
+	}
+	var stacks []outdatedStack
+	for _, env := range envs {
+		if !contains(env.Name, localEnvs) {
+			continue // Environments are upgraded by deploying their manifest.
+		}
+		getter, err := o.newEnvVersionGetter(o.appName, env.Name)
+		if err != nil {
+			return nil, err
+		}
+		v, err := getter.Version()
+		if err != nil {
+			return nil, fmt.Errorf("get version of environment %s: %w", env.Name, err)
+		}
+		if semver.Compare(v, o.latestVersion) < 0 {
+			stacks = append(stacks, outdatedStack{env: env.Name, version: v})
+		}
+		deployed, err := o.deployStore.ListDeployedWorkloads(o.appName, env.Name)
+		if err != nil {
+			return nil, fmt.Errorf("list workloads deployed to environment %s: %w", env.Name, err)
+		}
+		for _, name := range localWklds {
+			if !contains(name, deployed) {
+				continue
+			}
+			getter, err := o.newWkldVersionGetter(o.appName, env.Name, name)
+			if err != nil {
+				return nil, err
+			}
+			v, err := getter.Version()
+			if err != nil {
+				return nil, fmt.Errorf("get version of %s in environment %s: %w", name, env.Name, err)
+			}
+			if semver.Compare(v, o.latestVersion) < 0 {
+				stacks = append(stacks, outdatedStack{env: env.Name, name: name, version: v})
+			}
+		}
+	}
+	return stacks, nil
+}
+
+// upgradeStack deploys the stack with its diff, which asks for confirmation before the deployment.
+func (o *upgradeEnvOpts) upgradeStack(s outdatedStack) error {
+	var deployer cmd
+	var err error
+	if s.name == "" {
+		deployer, err = o.newEnvDeployer(deployEnvVars{
+			appName:  o.appName,
+			name:     s.env,
+			showDiff: true,
+		})
+	} else {
+		deployer, err = o.newWkldDeployer(deployWkldVars{
+			appName:  o.appName,
+			name:     s.name,
+			envName:  s.env,
+			showDiff: true,
+		})
+	}
+	if err != nil {
+		return err
+	}
+	if err := deployer.Ask(); err != nil {
+		return err
+	}
+	if err := deployer.Validate(); err != nil {
+		return err
+	}
+	return deployer.Execute()
+}
+
 // buildEnvUpgradeCmd builds the command to update environment(s) to the latest version of
 // the environment template.
 func buildEnvUpgradeCmd() *cobra.Command {
-	var envName, appName string
-	var all bool
+	vars := upgradeEnvVars{}
 	cmd := &cobra.Command{
 		Use:   "upgrade",
-		Short: "Deprecated. Upgrades the template of an environment to the latest version.",
-		Long: `Deprecated. Use "copilot env deploy" instead.
-This command is now a no op. Upgrades the template of an environment stack to the latest version.
-`,
-		Hidden: true,
+		Short: "Previews the upgrade of an environment, or upgrades all the stacks of an application, to the latest version.",
+		Long: `Previews the upgrade of an environment to the latest version of its template.
+Upgrades are deployed with "copilot env deploy", so without --plan or --all this command is a no op.
+With --all, lists the environments and workloads of the workspace that are on an older version,
+and deploys them one after the other, each environment before its workloads, once their diff is confirmed.`,
+		Example: `
+  Show the features and the template changes of upgrading the "prod" environment.
+  /code $ copilot env upgrade -n prod --plan
+  List the environments and workloads that are on an older version.
+  /code $ copilot env upgrade --all --plan
+  Upgrade them, confirming the diff of each one.
+  /code $ copilot env upgrade --all`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
-			return nil
+			opts, err := newUpgradeEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
 		}),
 	}
-	cmd.Flags().StringVarP(&envName, nameFlag, nameFlagShort, "", envFlagDescription)
-	cmd.Flags().StringVarP(&appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
-	cmd.Flags().BoolVar(&all, allFlag, false, upgradeAllEnvsDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.all, allFlag, false, upgradeAllEnvsDescription)
+	cmd.Flags().BoolVar(&vars.plan, planFlag, false, upgradePlanEnvDescription)
+	cmd.MarkFlagsMutuallyExclusive(allFlag, nameFlag)
 	return cmd
 }
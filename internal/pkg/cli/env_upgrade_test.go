@@ -0,0 +1,294 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type upgradeEnvMocks struct {
+	store     *mocks.Mockstore
+	sel       *mocks.MockwsEnvironmentSelector
+	describer *mocks.MockenvFeaturesDescriber
+	planner   *mocks.Mockcmd
+}
+
+func TestUpgradeEnvOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inVars     upgradeEnvVars
+		setupMocks func(m *upgradeEnvMocks)
+
+		wantedName  string
+		wantedError error
+	}{
+		"no-op without --plan": {
+			setupMocks: func(m *upgradeEnvMocks) {},
+		},
+		"error if not in a workspace": {
+			inVars:      upgradeEnvVars{plan: true},
+			setupMocks:  func(m *upgradeEnvMocks) {},
+			wantedError: errNoAppInWorkspace,
+		},
+		"error if the environment does not exist": {
+			inVars: upgradeEnvVars{appName: "phonetool", name: "test", plan: true},
+			setupMocks: func(m *upgradeEnvMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New(`get environment "test" in application "phonetool": some error`),
+		},
+		"nothing to ask with --all": {
+			inVars: upgradeEnvVars{appName: "phonetool", all: true},
+			setupMocks: func(m *upgradeEnvMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+			},
+		},
+		"select a local environment": {
+			inVars: upgradeEnvVars{appName: "phonetool", plan: true},
+			setupMocks: func(m *upgradeEnvMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.sel.EXPECT().LocalEnvironment(gomock.Any(), gomock.Any()).Return("prod", nil)
+			},
+			wantedName: "prod",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &upgradeEnvMocks{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockwsEnvironmentSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &upgradeEnvOpts{
+				upgradeEnvVars: tc.inVars,
+				store:          m.store,
+				sel:            m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantedName != "" {
+				require.Equal(t, tc.wantedName, opts.name)
+			}
+		})
+	}
+}
+
+func TestUpgradeEnvOpts_Execute(t *testing.T) {
+	features := &describe.EnvFeatures{
+		Environment:   "test",
+		Version:       "v1.30.0",
+		LatestVersion: "v1.43.0",
+		Features: []*describe.EnvFeature{
+			{Name: "Mutual TLS (verify)", Status: describe.EnvFeatureUpgradeRequired},
+		},
+	}
+	testCases := map[string]struct {
+		inPlan     bool
+		setupMocks func(m *upgradeEnvMocks)
+
+		wantedError error
+	}{
+		"no-op without --plan": {
+			setupMocks: func(m *upgradeEnvMocks) {},
+		},
+		"error if fail to describe the features": {
+			inPlan: true,
+			setupMocks: func(m *upgradeEnvMocks) {
+				m.describer.EXPECT().Features().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe features of environment test: some error"),
+		},
+		"error if fail to generate the diff": {
+			inPlan: true,
+			setupMocks: func(m *upgradeEnvMocks) {
+				m.describer.EXPECT().Features().Return(features, nil)
+				m.planner.EXPECT().Ask().Return(nil)
+				m.planner.EXPECT().Execute().Return(errors.New("some error"))
+			},
+			wantedError: errors.New("plan the upgrade of environment test: some error"),
+		},
+		"a diff is not an error": {
+			inPlan: true,
+			setupMocks: func(m *upgradeEnvMocks) {
+				m.describer.EXPECT().Features().Return(features, nil)
+				m.planner.EXPECT().Ask().Return(nil)
+				m.planner.EXPECT().Execute().Return(&errHasDiff{})
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &upgradeEnvMocks{
+				describer: mocks.NewMockenvFeaturesDescriber(ctrl),
+				planner:   mocks.NewMockcmd(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &upgradeEnvOpts{
+				upgradeEnvVars: upgradeEnvVars{
+					appName: "phonetool",
+					name:    "test",
+					plan:    tc.inPlan,
+				},
+				newFeaturesDescriber: func(appName, envName string) (envFeaturesDescriber, error) {
+					return m.describer, nil
+				},
+				newPlanner: func(vars packageEnvVars) (cmd, error) {
+					require.Equal(t, packageEnvVars{appName: "phonetool", name: "test", showDiff: true}, vars)
+					return m.planner, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpgradeEnvOpts_UpgradeAll(t *testing.T) {
+	const latest = "v1.43.0"
+	versions := map[string]string{
+		"test":          "v1.30.0",
+		"prod":          latest,
+		"test/frontend": latest,
+		"test/api":      "v1.29.0",
+		"prod/frontend": "bootstrap",
+	}
+	testCases := map[string]struct {
+		inPlan     bool
+		setupMocks func(store *mocks.Mockstore, ws *mocks.MockwsEnvironmentWorkloadLister, deployStore *mocks.MockdeployedWorkloadsLister)
+		deployErr  error
+
+		wantedUpgrades []string
+		wantedOutput   string
+		wantedError    error
+	}{
+		"only list the outdated stacks with --plan": {
+			inPlan: true,
+			wantedOutput: "Environment         Workload            Version\n" +
+				"-----------         --------            -------\n" +
+				"test                -                   v1.30.0\n" +
+				"test                api                 v1.29.0\n" +
+				"prod                frontend            bootstrap\n",
+		},
+		"upgrade each environment before its workloads": {
+			wantedUpgrades: []string{"test", "test/api", "prod/frontend"},
+			wantedOutput: "Environment         Workload            Version\n" +
+				"-----------         --------            -------\n" +
+				"test                -                   v1.30.0\n" +
+				"test                api                 v1.29.0\n" +
+				"prod                frontend            bootstrap\n",
+		},
+		"stop at the first stack that fails to upgrade": {
+			deployErr:      errors.New("some error"),
+			wantedUpgrades: []string{"test"},
+			wantedError:    errors.New("upgrade environment test: some error"),
+		},
+		"error if fail to list deployed workloads": {
+			setupMocks: func(store *mocks.Mockstore, ws *mocks.MockwsEnvironmentWorkloadLister, deployStore *mocks.MockdeployedWorkloadsLister) {
+				store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				ws.EXPECT().ListEnvironments().Return([]string{"test"}, nil)
+				ws.EXPECT().ListWorkloads().Return([]string{"frontend"}, nil)
+				deployStore.EXPECT().ListDeployedWorkloads("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list workloads deployed to environment test: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			ws := mocks.NewMockwsEnvironmentWorkloadLister(ctrl)
+			deployStore := mocks.NewMockdeployedWorkloadsLister(ctrl)
+			if tc.setupMocks != nil {
+				tc.setupMocks(store, ws, deployStore)
+			} else {
+				// "dev" has no manifest in the workspace, and "worker" is not deployed to "test".
+				store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}, {Name: "dev"}, {Name: "prod"}}, nil)
+				ws.EXPECT().ListEnvironments().Return([]string{"prod", "test"}, nil)
+				ws.EXPECT().ListWorkloads().Return([]string{"frontend", "api", "worker"}, nil)
+				deployStore.EXPECT().ListDeployedWorkloads("phonetool", "test").Return([]string{"api", "frontend"}, nil)
+				deployStore.EXPECT().ListDeployedWorkloads("phonetool", "prod").Return([]string{"frontend"}, nil)
+			}
+			var upgrades []string
+			deployer := func(stack string) cmd {
+				m := mocks.NewMockcmd(ctrl)
+				m.EXPECT().Ask().Return(nil)
+				m.EXPECT().Validate().Return(nil)
+				m.EXPECT().Execute().DoAndReturn(func() error {
+					upgrades = append(upgrades, stack)
+					return tc.deployErr
+				})
+				return m
+			}
+			buf := new(bytes.Buffer)
+			opts := &upgradeEnvOpts{
+				upgradeEnvVars: upgradeEnvVars{appName: "phonetool", all: true, plan: tc.inPlan},
+				store:          store,
+				ws:             ws,
+				deployStore:    deployStore,
+				w:              buf,
+				latestVersion:  latest,
+				newEnvVersionGetter: func(_, envName string) (versionGetter, error) {
+					return &versionGetterDouble{VersionFn: func() (string, error) { return versions[envName], nil }}, nil
+				},
+				newWkldVersionGetter: func(_, envName, name string) (versionGetter, error) {
+					return &versionGetterDouble{VersionFn: func() (string, error) { return versions[envName+"/"+name], nil }}, nil
+				},
+				newEnvDeployer: func(vars deployEnvVars) (cmd, error) {
+					require.True(t, vars.showDiff)
+					return deployer(vars.name), nil
+				},
+				newWkldDeployer: func(vars deployWkldVars) (cmd, error) {
+					require.True(t, vars.showDiff)
+					return deployer(vars.envName + "/" + vars.name), nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedUpgrades, upgrades)
+			if tc.wantedOutput != "" {
+				require.Equal(t, tc.wantedOutput, buf.String())
+			}
+		})
+	}
+}
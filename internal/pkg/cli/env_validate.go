@@ -0,0 +1,155 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const envValidateNamePrompt = "Which environment's manifest would you like to validate?"
+
+type validateEnvVars struct {
+	appName    string
+	name       string
+	showSchema bool
+}
+
+type validateEnvOpts struct {
+	validateEnvVars
+
+	ws             wsEnvironmentReader
+	sel            workspaceSelector
+	w              io.Writer
+	addonsTemplate func(env string) (string, error)
+}
+
+func newValidateEnvOpts(vars validateEnvVars) (*validateEnvOpts, error) {
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	return &validateEnvOpts{
+		validateEnvVars: vars,
+		ws:              ws,
+		sel:             selector.NewWorkspaceSelector(prompt.New(), ws),
+		w:               os.Stdout,
+		addonsTemplate: func(env string) (string, error) {
+			stack, err := addon.ParseFromEnv(env, ws)
+			if err != nil {
+				return "", err
+			}
+			return stack.Template()
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *validateEnvOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.showSchema || o.name == "" {
+		return nil
+	}
+	names, err := o.ws.ListEnvironments()
+	if err != nil {
+		return fmt.Errorf("list environments in the workspace: %w", err)
+	}
+	if !contains(o.name, names) {
+		return fmt.Errorf("environment %q does not exist in the workspace", o.name)
+	}
+	return nil
+}
+
+// Ask prompts the user for any missing required fields.
+func (o *validateEnvOpts) Ask() error {
+	if o.showSchema || o.name != "" {
+		return nil
+	}
+	name, err := o.sel.Environment(envValidateNamePrompt, "")
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute validates the manifest and the addons of the environment, or prints the JSON Schema of environment manifests.
+func (o *validateEnvOpts) Execute() error {
+	if o.showSchema {
+		schema, err := manifest.EnvironmentJSONSchema()
+		if err != nil {
+			return fmt.Errorf("generate JSON Schema for environments: %w", err)
+		}
+		fmt.Fprintln(o.w, string(schema))
+		return nil
+	}
+	raw, err := o.ws.ReadEnvironmentManifest(o.name)
+	if err != nil {
+		return fmt.Errorf("read manifest for environment %q: %w", o.name, err)
+	}
+	problems := manifest.CheckEnvironment(raw, o.appName, o.name)
+	if err := reportManifestProblems(problems, fmt.Sprintf("environment %s", o.name)); err != nil {
+		return err
+	}
+	return o.validateAddons()
+}
+
+// validateAddons parses the templates and the parameters files of the environment addons, if any.
+func (o *validateEnvOpts) validateAddons() error {
+	tpl, err := o.addonsTemplate(o.name)
+	if err != nil {
+		var errNotFound *addon.ErrAddonsNotFound
+		if errors.As(err, &errNotFound) {
+			return nil
+		}
+		return fmt.Errorf("parse addons for environment %s: %w", o.name, err)
+	}
+	if _, err := addon.Outputs(tpl); err != nil {
+		return fmt.Errorf("parse outputs of addons for environment %s: %w", o.name, err)
+	}
+	log.Successf("Addons for %s are valid.\n", color.HighlightUserInput(fmt.Sprintf("environment %s", o.name)))
+	return nil
+}
+
+// buildEnvValidateCmd builds the command for validating the manifest of an environment.
+func buildEnvValidateCmd() *cobra.Command {
+	vars := validateEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the manifest of an environment without deploying it.",
+		Long: `Validate the manifest of an environment without deploying it.
+Reports every unknown field, type mismatch and invalid configuration with its line, without calling any AWS APIs.
+Also checks that the addons templates merge and that the parameters files of the environment match them.`,
+		Example: `
+  Validate the manifest of the "prod" environment.
+  /code $ copilot env validate -n prod
+  Print the JSON Schema of environment manifests.
+  /code $ copilot env validate --schema`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newValidateEnvOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.showSchema, schemaFlag, false, schemaFlagDescription)
+	return cmd
+}
@@ -0,0 +1,183 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateEnvOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName       string
+		inShowSchema bool
+		setupMocks   func(m *mocks.MockworkspaceSelector)
+
+		wantedName string
+		wantedErr  error
+	}{
+		"should not prompt when printing the JSON Schema": {
+			inShowSchema: true,
+			setupMocks:   func(m *mocks.MockworkspaceSelector) {},
+		},
+		"should prompt for an environment in the workspace": {
+			setupMocks: func(m *mocks.MockworkspaceSelector) {
+				m.EXPECT().Environment(envValidateNamePrompt, "").Return("test", nil)
+			},
+			wantedName: "test",
+		},
+		"should return a wrapped error if the environment cannot be selected": {
+			setupMocks: func(m *mocks.MockworkspaceSelector) {
+				m.EXPECT().Environment(envValidateNamePrompt, "").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select environment: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockworkspaceSelector(ctrl)
+			tc.setupMocks(m)
+			opts := &validateEnvOpts{
+				validateEnvVars: validateEnvVars{
+					name:       tc.inName,
+					showSchema: tc.inShowSchema,
+				},
+				sel: m,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.name)
+		})
+	}
+}
+
+func TestValidateEnvOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inShowSchema     bool
+		inAddonsTemplate string
+		inAddonsErr      error
+		setupMocks       func(m *mocks.MockwsEnvironmentReader)
+
+		wantedOut string
+		wantedErr error
+	}{
+		"should return a wrapped error if the manifest cannot be read": {
+			setupMocks: func(m *mocks.MockwsEnvironmentReader) {
+				m.EXPECT().ReadEnvironmentManifest("test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New(`read manifest for environment "test": some error`),
+		},
+		"should return the number of problems found in the manifest": {
+			setupMocks: func(m *mocks.MockwsEnvironmentReader) {
+				m.EXPECT().ReadEnvironmentManifest("test").Return([]byte(`name: test
+type: Environment
+observability:
+  container_insight: true
+`), nil)
+			},
+			wantedErr: errors.New("found 1 problem in the manifest for environment test"),
+		},
+		"should succeed if the manifest is valid": {
+			setupMocks: func(m *mocks.MockwsEnvironmentReader) {
+				m.EXPECT().ReadEnvironmentManifest("test").Return([]byte(`name: test
+type: Environment
+observability:
+  container_insights: true
+`), nil)
+			},
+		},
+		"should return a wrapped error if the addons cannot be parsed": {
+			inAddonsErr: errors.New(`required parameter "Env" is missing from the template`),
+			setupMocks: func(m *mocks.MockwsEnvironmentReader) {
+				m.EXPECT().ReadEnvironmentManifest("test").Return([]byte(`name: test
+type: Environment
+`), nil)
+			},
+			wantedErr: errors.New(`parse addons for environment test: required parameter "Env" is missing from the template`),
+		},
+		"should return a wrapped error if the outputs of the addons cannot be parsed": {
+			inAddonsTemplate: `Resources: []`,
+			setupMocks: func(m *mocks.MockwsEnvironmentReader) {
+				m.EXPECT().ReadEnvironmentManifest("test").Return([]byte(`name: test
+type: Environment
+`), nil)
+			},
+			wantedErr: errors.New(`parse outputs of addons for environment test: "Resources" field in cloudformation template is not a map`),
+		},
+		"should succeed if the addons are valid": {
+			inAddonsTemplate: `Resources:
+  Table:
+    Type: AWS::DynamoDB::Table
+Outputs:
+  TableName:
+    Value: !Ref Table
+`,
+			setupMocks: func(m *mocks.MockwsEnvironmentReader) {
+				m.EXPECT().ReadEnvironmentManifest("test").Return([]byte(`name: test
+type: Environment
+`), nil)
+			},
+		},
+		"should print the JSON Schema of environment manifests": {
+			inShowSchema: true,
+			setupMocks:   func(m *mocks.MockwsEnvironmentReader) {},
+			wantedOut:    `"title": "Environment manifest"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockwsEnvironmentReader(ctrl)
+			tc.setupMocks(m)
+			buf := new(bytes.Buffer)
+			opts := &validateEnvOpts{
+				validateEnvVars: validateEnvVars{
+					appName:    "phonetool",
+					name:       "test",
+					showSchema: tc.inShowSchema,
+				},
+				ws: m,
+				w:  buf,
+				addonsTemplate: func(env string) (string, error) {
+					if tc.inAddonsErr == nil && tc.inAddonsTemplate == "" {
+						return "", &addon.ErrAddonsNotFound{}
+					}
+					return tc.inAddonsTemplate, tc.inAddonsErr
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Contains(t, buf.String(), tc.wantedOut)
+		})
+	}
+}
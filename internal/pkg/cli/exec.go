@@ -3,6 +3,14 @@
 
 package cli
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+)
+
 const (
 	defaultCommand = "/bin/sh"
 )
@@ -16,3 +24,16 @@ type execVars struct {
 	containerName    string
 	skipConfirmation *bool // If nil, we will prompt to upgrade the ssm plugin.
 }
+
+// validateExecContainer returns an error listing the containers of the task if none of them is named container.
+func validateExecContainer(task *awsecs.Task, container string) error {
+	names := make([]string, len(task.Containers))
+	for i, c := range task.Containers {
+		if aws.StringValue(c.Name) == container {
+			return nil
+		}
+		names[i] = aws.StringValue(c.Name)
+	}
+	taskID, _ := awsecs.TaskID(aws.StringValue(task.TaskArn))
+	return fmt.Errorf("container %s not found in task %s, the task runs containers: %s", container, taskID, strings.Join(names, ", "))
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/dustin/go-humanize/english"
@@ -26,23 +27,54 @@ const (
 	profileFlag        = "profile"
 	yesFlag            = "yes"
 	jsonFlag           = "json"
+	dotFlag            = "dot"
+	graphFormatFlag    = "format"
 	allFlag            = "all"
 	forceFlag          = "force"
 	allowDowngradeFlag = "allow-downgrade"
 	noRollbackFlag     = "no-rollback"
+	fastFlag           = "fast"
 	manifestFlag       = "manifest"
 	resourceTagsFlag   = "resource-tags"
+	maxParallelFlag    = "max-parallel"
+	deployEnvsFlag     = "envs"
+	waitForLockFlag    = "wait-for-lock"
+	lockTimeoutFlag    = "lock-timeout"
+	releaseLockFlag    = "release"
+
+	// Quota flags.
+	requestQuotaIncreaseFlag = "request-quota-increase"
+
+	// Deploy policy flags.
+	overrideFreezeFlag   = "override-freeze"
+	deployPolicyFileFlag = "file"
+	removeFlag           = "remove"
+
+	// Resource names flags.
+	resourceNamesFileFlag = "file"
+
+	// Build cache flags.
+	noBuildCacheFlag      = "no-build-cache"
+	maxParallelBuildsFlag = "max-parallel-builds"
 
 	// Build flags.
 	dockerFileFlag        = "dockerfile"
 	dockerFileContextFlag = "build-context"
 	imageTagFlag          = "tag"
+	builderFlag           = "builder"
+	buildRemoteFlag       = "build-remote"
+	pinDigestsFlag        = "pin-digests"
 	stackOutputDirFlag    = "output-dir"
+	stackFormatFlag       = "format"
 	uploadAssetsFlag      = "upload-assets"
 	deployFlag            = "deploy"
 	diffFlag              = "diff"
 	diffAutoApproveFlag   = "diff-yes"
+	diffExitCodeFlag      = "exit-code"
+	diffFileFlag          = "diff-file"
+	preferFlag            = "prefer"
 	sourcesFlag           = "sources"
+	saveFlag              = "save"
 
 	// Flags for operational commands.
 	limitFlag                   = "limit"
@@ -52,13 +84,40 @@ const (
 	sinceFlag                   = "since"
 	startTimeFlag               = "start-time"
 	endTimeFlag                 = "end-time"
+	atFlag                      = "at"
 	tasksFlag                   = "tasks"
 	logGroupFlag                = "log-group"
 	containerLogFlag            = "container"
+	grepFlag                    = "grep"
 	includeStateMachineLogsFlag = "include-state-machine"
 	resourcesFlag               = "resources"
+	costFlag                    = "cost"
+	featuresFlag                = "features"
+	planFlag                    = "plan"
+	iamFlag                     = "iam"
+	sbomFlag                    = "sbom"
+	checkFlag                   = "check"
+	offlineFlag                 = "offline"
+	accountIDFlag               = "account-id"
+	removeTagsFlag              = "remove-tags"
 	taskIDFlag                  = "task-id"
 	containerFlag               = "container"
+	remoteHostFlag              = "host"
+	watchFlag                   = "watch"
+	watchIntervalFlag           = "interval"
+	rollbackToFlag              = "to"
+	showDeploymentFlag          = "show"
+	waitFlag                    = "wait"
+	schemaFlag                  = "schema"
+	proxyFlag                   = "proxy"
+	stopDatabasesFlag           = "stop-databases"
+	dryRunFlag                  = "dry-run"
+	retainFlag                  = "retain"
+	olderThanFlag               = "older-than"
+	keepImagesFlag              = "keep-images"
+	untaggedImageExpiryFlag     = "untagged-image-expiry"
+	immutableTagsFlag           = "immutable-tags"
+	configStoreFlag             = "config-store"
 
 	// Flags for CI/CD.
 	githubURLFlag         = "github-url"
@@ -67,6 +126,8 @@ const (
 	gitBranchFlag         = "git-branch"
 	envsFlag              = "environments"
 	pipelineTypeFlag      = "pipeline-type"
+	pipelineProviderFlag  = "provider"
+	pipelineStageFlag     = "stage"
 
 	// Flags for ls.
 	localFlag = "local"
@@ -80,10 +141,19 @@ const (
 	storageNoSortFlag                  = "no-sort"
 	storageLSIConfigFlag               = "lsi"
 	storageNoLSIFlag                   = "no-lsi"
+	storageGSIConfigFlag               = "gsi"
+	storageNoGSIFlag                   = "no-gsi"
+	storageDDBTTLFlag                  = "ttl"
+	storageDDBStreamFlag               = "stream"
 	storageAuroraServerlessVersionFlag = "serverless-version"
 	storageRDSEngineFlag               = "engine"
 	storageRDSInitialDBFlag            = "initial-db"
 	storageRDSParameterGroupFlag       = "parameter-group"
+	storageRDSMinCapacityFlag          = "min-capacity"
+	storageRDSMaxCapacityFlag          = "max-capacity"
+	storageEFSThroughputModeFlag       = "throughput-mode"
+	storageEFSTransitionToIAFlag       = "transition-to-ia"
+	storageEFSNoBackupFlag             = "no-backup"
 
 	// Flags for one-off tasks.
 	taskGroupNameFlag            = "task-group-name"
@@ -121,6 +191,7 @@ const (
 
 	enableContainerInsightsFlag = "container-insights"
 	defaultConfigFlag           = "default-config"
+	fromEnvFlag                 = "from"
 
 	accessKeyIDFlag     = "aws-access-key-id"
 	secretAccessKeyFlag = "aws-secret-access-key"
@@ -131,11 +202,20 @@ const (
 	valuesFlag        = "values"
 	overwriteFlag     = "overwrite"
 	inputFilePathFlag = "cli-input-yaml"
+	fromFileFlag      = "from-file"
+	revealFlag        = "reveal"
 
 	// Flags for overriding templates.
 	iacToolFlag       = "tool"
 	cdkLanguageFlag   = "cdk-language"
 	skipResourcesFlag = "skip-resources"
+	showTemplateFlag  = "show-template"
+
+	// Flags for dead-letter queues.
+	queueFlag = "queue"
+
+	// Flags for telemetry.
+	endpointFlag = "endpoint"
 
 	// Other.
 	svcPortFlag             = "port"
@@ -151,6 +231,13 @@ const (
 	deleteSecretFlag        = "delete-secret"
 )
 
+// Manifest flags.
+const (
+	renderedFlag    = "rendered"
+	fromComposeFlag = "from-compose"
+	fromK8sFlag     = "from-k8s"
+)
+
 // Short flag names.
 // A short flag only exists if the flag or flag set is mandatory by the command.
 const (
@@ -236,12 +323,32 @@ Must be one of: %s.`, strings.Join(applyAll(validIaCTools, strconv.Quote), ", ")
 	overrideEnvFlagDescription = `Optional. Name of the environment to use when retrieving resources in a template.
 Defaults to a random environment.`
 	skipResourcesFlagDescription = `Optional. Skip asking for which resources to override and generate empty IaC extension files.`
+	showTemplateFlagDescription  = `Optional. Print the overridden template instead of its difference from the generated template.`
 
 	repoURLFlagDescription = fmt.Sprintf(`The repository URL to trigger your pipeline.
 Supported providers are: %s.`, strings.Join(manifest.PipelineProviders, ", "))
 
 	ingressTypeFlagDescription = fmt.Sprintf(`Required for a Request-Driven Web Service. Allowed source of traffic to your service.
 Must be one of %s.`, english.OxfordWordSeries(rdwsIngressOptions, "or"))
+
+	stackFormatFlagDescription = fmt.Sprintf(`Optional. The format of the packaged stack. Must be one of:
+%s.
+With %q, writes a Terraform configuration that deploys the template
+instead of the template configuration.
+With %q, writes a CDK application that includes the template
+instead of the template configuration. Must be used with --%s.`,
+		strings.Join(applyAll(stackFormats, strconv.Quote), ", "), stackFormatTerraform, stackFormatCDK, stackOutputDirFlag)
+
+	graphFormatFlagDescription = fmt.Sprintf(`Optional. Output the graph in a format to render. Must be one of:
+%s.
+With %q, writes a web page that draws the graph in a browser.`,
+		strings.Join(applyAll(graphFormats, strconv.Quote), ", "), graphFormatHTML)
+
+	appConfigStoreFlagDescription = fmt.Sprintf(`Optional. Where to store the configuration of the environments and workloads
+of the application. Must be one of %s. Defaults to %q.`,
+		english.OxfordWordSeries(applyAll(config.Backends(), strconv.Quote), "or"), config.SSMBackend)
+	migrateConfigStoreFlagDescription = fmt.Sprintf(`Where to move the configuration of the environments and workloads
+of the application. Must be one of %s.`, english.OxfordWordSeries(applyAll(config.Backends(), strconv.Quote), "or"))
 )
 
 const (
@@ -257,21 +364,82 @@ const (
 Allows you to categorize resources.`
 	diffFlagDescription            = "Compares the generated CloudFormation template to the deployed stack."
 	diffAutoApproveFlagDescription = "Skip interactive approval of diff before deploying."
+	diffExitCodeFlagDescription    = `Optional. Exit with 0 if there are no changes, 1 if there are changes,
+or 2 if there is an error. Must be used with --diff.`
+	diffFileFlagDescription = `Optional. Write the comparison of the generated CloudFormation template
+to the deployed stack to a file, and still package the stack.`
+	diffJSONFlagDescription = `Optional. Write the comparison as JSON changes, with sensitive values
+redacted. Must be used with --diff.`
+	preferFlagDescription = `Optional. If the deployment would only revert changes made outside of Copilot
+since the last deployment, keep the "deployed" values by writing them to the manifest,
+or keep the "manifest" values by deploying them, instead of prompting.`
+	scaleCountFlagDescription = "Number of tasks for the service to run."
+	saveCountFlagDescription  = `Optional. Write the count to the environment overrides of the manifest,
+so that the next deployments keep it.`
 
 	// Deployment.
-	deployTestFlagDescription     = `Deploy your service or job to a "test" environment.`
+	deployTestFlagDescription  = `Deploy your service or job to a "test" environment.`
+	fromComposeFlagDescription = `Path to a Docker Compose file to create a service for each of its services.
+Mutually exclusive with the flags of a single service or job.`
+	fromK8sFlagDescription = `Path to a Kubernetes manifest file, or to a directory of manifest files,
+to create a service for each Deployment. Mutually exclusive with the flags of a single service or job.`
 	allowDowngradeFlagDescription = `Optional. Allow using an older version of Copilot to update Copilot components
 updated by a newer version of Copilot.`
+	waitForLockFlagDescription = `Optional. If the stack is locked by another deployment or deletion,
+wait for the lock to be released instead of failing.`
+	lockTimeoutFlagDescription = `Optional. The maximum duration to wait for the lock with --wait-for-lock,
+like 30m or 2h. Use 0 to wait indefinitely.`
+	requestQuotaIncreaseFlagDescription = `Optional. If the deployment would exceed a service quota of the account,
+request an increase of the quota before stopping.`
+	overrideFreezeFlagDescription = `Optional. Reason to deploy even though the deploy windows
+or freezes of the application or environment don't allow it.
+The reason is recorded in the deployment history of services.`
+	checkTemplateFlagDescription = `Optional. Check the generated templates against the policy checks
+of the application and environment, and fail if any check doesn't pass.`
+	offlineFlagDescription = `Optional. Generate the templates without AWS credentials.
+The values that are looked up in AWS are read from the --values file,
+or replaced by placeholders like {{account_id}} to substitute before deploying.`
+	accountIDFlagDescription     = `Optional. ID of the AWS account of the application. Must be used with --offline.`
+	offlineValuesFlagDescription = `Optional. Path to a YAML file with the values to use with --offline,
+such as the account ID, region and artifact bucket of the application.`
+	deployPolicyFileFlagDescription = `Optional. Path to a YAML file with the deploy windows, freezes and template checks to set.`
+	deployPolicyEnvFlagDescription  = `Optional. Name of the environment to manage the deploy policy of,
+instead of the application.`
+	removeDeployPolicyFlagDescription  = `Optional. Remove the deploy policy.`
+	resourceNamesFileFlagDescription   = `Optional. Path to a YAML file with the templates of the resource names to set.`
+	removeResourceNamesFlagDescription = `Optional. Remove the templates and go back to the default resource names.`
+	releaseLockFlagDescription         = `Optional. Name of a stack to force-release the lock of,
+for example after a deployment was interrupted.`
 	forceFlagDescription = `Optional. Force a new service deployment using the existing image.
 Not available with the "Static Site" service type.`
+	fastFlagDescription = `Optional. If the container image is the only change,
+register a new task definition and update the ECS service
+directly instead of updating the stack. The stack drifts
+until the next deployment without --fast. If the addons are
+the only change, update only the addons nested stack.
+Not available with the "Request-Driven Web Service" and "Static Site" service types.`
 	noRollbackFlagDescription = `Optional. Disable automatic stack 
 rollback in case of deployment failure.
 We do not recommend using this flag for a
 production environment.`
 	forceEnvDeployFlagDescription = "Optional. Force update the environment stack template."
+	deployAllFlagDescription      = `Optional. Deploy all the services and jobs in the workspace to an environment.
+Workloads are deployed after the workloads that they depend on in the
+"depends_on" of the pipeline deployments to the environment.`
+	maxParallelFlagDescription = `Optional. The maximum number of workloads deployed at the same time
+with --all.`
+	deployEnvsFlagDescription = `Optional. Names of the environments to deploy to at the same time,
+such as environments in different regions. Cannot be used with --env.`
+	maxParallelBuildsFlagDescription = `Optional. With --all, build the images and upload the artifacts
+of up to this number of workloads at the same time before starting
+the deployments. Defaults to 0, which packages each workload as part
+of its deployment.`
+	noBuildCacheFlagDescription = `Optional. Build every image and upload every artifact, even if its
+sources are unchanged since it was last pushed from the workspace.`
 
 	// Operational.
 	jsonFlagDescription = "Optional. Output in JSON format."
+	dotFlagDescription  = "Optional. Output the graph in the DOT language of Graphviz."
 
 	limitFlagDescription = `Optional. The maximum number of log events returned. Default is 10
 unless any time filtering flags are set.`
@@ -285,10 +453,27 @@ Defaults to all logs. Only one of start-time / since may be used.`
 Defaults to all logs. Only one of start-time / since may be used.`
 	endTimeFlagDescription = `Optional. Only return logs before a specific date (RFC3339).
 Defaults to all logs. Only one of end-time / follow may be used.`
+	atFlagDescription = `Optional. Invoke the job once at a later time instead of now.
+Accepts a date (RFC3339) like 2023-10-15T09:00:00Z or a relative duration like 30m or 2h.`
 	tasksLogsFlagDescription               = "Optional. Only return logs from specific task IDs."
 	includeStateMachineLogsFlagDescription = "Optional. Include logs from the state machine executions."
+	jobHistoryLastFlagDescription          = "Optional. The number of most recent executions of the job to show."
 	logGroupFlagDescription                = "Optional. Only return logs from specific log group."
-	containerLogFlagDescription            = "Optional. Return only logs from a specific container."
+	containerLogFlagDescription            = "Optional. Return only logs from specific containers."
+	grepFlagDescription                    = "Optional. Only return logs whose message matches a regular expression."
+	watchFlagDescription                   = `Optional. Keep refreshing the status in place until interrupted
+with Ctrl+C, to monitor a rollout.`
+	watchIntervalFlagDescription     = "Optional. The duration between refreshes with --watch, like 5s or 1m."
+	svcStatusPreviousFlagDescription = `Optional. Show the stopped tasks of the latest failed or replaced deployment,
+with their stop reasons, container exit codes and last log lines.`
+	svcStatusLimitFlagDescription = "Optional. The number of log lines to show for each stopped task with --previous, or below the status with --watch."
+	rollbackToFlagDescription     = `Optional. ID of the deployment to roll back to.
+Defaults to the deployment before the latest one.`
+	showDeploymentFlagDescription = `Optional. ID of a deployment to show the details and
+the recorded template diff of.`
+	schemaFlagDescription      = "Optional. Print the JSON Schema of the manifest type instead of validating the manifest."
+	validateEnvFlagDescription = `Optional. Name of the environment to validate the overrides of.
+Defaults to validating the overrides of every environment in the manifest.`
 
 	envResourcesFlagDescription      = "Optional. Show the resources in your environment."
 	svcResourcesFlagDescription      = "Optional. Show the resources in your service."
@@ -296,18 +481,78 @@ Defaults to all logs. Only one of end-time / follow may be used.`
 	localSvcFlagDescription          = "Only show services in the workspace."
 	localJobFlagDescription          = "Only show jobs in the workspace."
 	localPipelineFlagDescription     = "Only show pipelines in the workspace."
+	localAppListFlagDescription      = "List the workspaces on the local file system instead of the applications in your account."
+
+	appCostFlagDescription = `Optional. Show the estimated monthly cost of the resources
+of each environment and its services.`
+	envCostFlagDescription = `Optional. Show the estimated monthly cost of the resources
+of your environment and its services.`
+	envFeaturesFlagDescription = `Optional. Show which optional features are enabled in your environment,
+and which ones require to upgrade it first.`
+	svcCostFlagDescription = `Optional. Show the estimated monthly cost of the resources
+of your service in each environment.`
+
+	svcIAMFlagDescription = `Optional. Name of the environment in which the service was deployed;
+output the IAM policies of the task and execution roles, including the policies from addons.`
+	svcCheckIAMFlagDescription = `Optional. Used with --iam. Compare the policies with the policies
+attached to the deployed roles, and return an error if they differ.`
+	svcSBOMFlagDescription = `Optional. Name of the environment in which the service was deployed;
+output the software bills of materials of its images as a JSON object keyed by container name.`
+
+	appUpdateTagsResourceTagsFlagDescription = `Optional. Labels with a key and value separated by commas.
+Adds the tags to the application, or overwrites the values of existing keys.`
+	appUpdateTagsRemoveTagsFlagDescription = "Optional. Keys of the application tags to remove, separated by commas."
+
+	envStopDatabasesFlagDescription = `Optional. Stop the Aurora clusters of the environment as well.
+Stopped clusters are started again automatically after seven days.`
+	deleteDryRunFlagDescription  = "Optional. Show the resources that would be deleted, in order, without deleting them."
+	appGCDryRunFlagDescription   = "Optional. Show the unused resources without deleting them."
+	olderThanFlagDescription     = "Optional. Only delete resources older than a duration like 72h."
+	keepImagesFlagDescription    = "Optional. Number of most recent images to keep in the ECR repository of each workload."
+	appKeepImagesFlagDescription = `Optional. Number of most recent images to keep in the ECR repository of each workload.
+Older images are expired by a lifecycle policy.`
+	appUntaggedImageExpiryFlagDescription = "Optional. Number of days after which untagged images expire in the ECR repository of each workload."
+	appImmutableTagsFlagDescription       = `Optional. Reject pushes that overwrite an existing image tag, other than "latest",
+in the ECR repository of each workload.`
+	wkldKeepImagesFlagDescription = `Optional. Number of most recent images to keep in the ECR repository of the workload.
+Overrides the image retention settings of the application.`
+	wkldUntaggedImageExpiryFlagDescription = "Optional. Number of days after which untagged images expire in the ECR repository of the workload."
+	wkldImmutableTagsFlagDescription       = `Optional. Reject pushes that overwrite an existing image tag, other than "latest",
+in the ECR repository of the workload.`
+	deleteRetainFlagDescription = `Optional. Classes of resources to keep instead of deleting.
+Must be one of "s3" or "logs". For example, --retain s3,logs.`
 
 	svcManifestFlagDescription = `Optional. Name of the environment in which the service was deployed;
-output the manifest file used for that deployment.`
+output the manifest file used for that deployment. The environment can also be given with --env.`
+	svcRenderedManifestFlagDescription = `Optional. Used with --manifest. Output the manifest with its environment
+variables substituted by their values at the time of the deployment.`
+	svcManifestDiffFlagDescription = `Optional. Used with --manifest. Compare the deployed manifest
+to the manifest file in the workspace, and return an error if they differ.`
 	manifestFlagDescription = "Optional. Output the manifest file used for the deployment."
 
-	execYesFlagDescription     = "Optional. Whether to update the Session Manager Plugin."
-	taskIDFlagDescription      = "Optional. ID of the task you want to exec in."
-	execCommandFlagDescription = `Optional. The command that is passed to a running container.`
-	containerFlagDescription   = "Optional. The specific container you want to exec in. By default the first essential container will be used."
+	execYesFlagDescription        = "Optional. Whether to update the Session Manager Plugin."
+	taskIDFlagDescription         = "Optional. ID of the task you want to exec in."
+	taskExecTaskIDFlagDescription = `Optional. ID of the task you want to exec in.
+Any running task in the cluster can be targeted by ID, including the tasks of services.`
+	execCommandFlagDescription     = `Optional. The command that is passed to a running container.`
+	containerFlagDescription       = "Optional. The specific container you want to exec in. By default the first essential container will be used."
+	portForwardPortFlagDescription = `Port mapping in the format "<local port>:<remote port>", or a single port used on both ends.`
+	portForwardHostFlagDescription = `Optional. A remote host reachable from the task to forward traffic to, such as an RDS endpoint.
+By default traffic is forwarded to the container itself.`
+	runLocalWatchFlagDescription = "Optional. Rebuild and restart the containers when files in the workspace change."
+	runLocalProxyFlagDescription = `Optional. Reach the other services deployed in the environment through
+Session Manager sessions to a running task of the workload.`
 
 	// Build.
-	imageTagFlagDescription     = `Optional. The tag for the container images Copilot builds from Dockerfiles.`
+	imageTagFlagDescription      = `Optional. The tag for the container images Copilot builds from Dockerfiles.`
+	builderFlagDescription       = `Optional. The tool to build container images with: docker, podman, nerdctl or buildx. Overrides "image.builder" in the manifest.`
+	doctorBuilderFlagDescription = `Optional. The tool to build container images with: docker, podman, nerdctl or buildx.
+Defaults to the first of docker, podman and nerdctl that is installed.`
+	buildRemoteFlagDescription = `Optional. Build container images with AWS CodeBuild instead of a local tool, without Docker installed.`
+	pinDigestsFlagDescription  = `Optional. Reference every image by its digest in the task definition,
+including the images of "image.location", so that new tasks run the exact images of the deployment.`
+	deployImageFlagDescription = `Optional. URI of an existing image in Amazon ECR, referenced by its digest,
+to deploy for the main container instead of building one from the manifest.`
 	uploadAssetsFlagDescription = `Optional. Whether to upload assets (container images, Lambda functions, etc.).
 Uploaded asset locations are filled in the template configuration.`
 	stackOutputDirFlagDescription = "Optional. Writes the stack template and template configuration to a directory."
@@ -319,6 +564,11 @@ Uploaded asset locations are filled in the template configuration.`
 	gitBranchFlagDescription         = "Branch used to trigger your pipeline."
 	pipelineEnvsFlagDescription      = "Environments to add to the pipeline."
 	pipelineTypeFlagDescription      = `The type of pipeline. Must be either "Workloads" or "Environments".`
+	pipelineProviderFlagDescription  = `Optional. The CI/CD system that runs the pipeline.
+Must be either "codepipeline" or "github-actions".`
+	pipelineStageFlagDescription       = "Name of the failed stage to retry."
+	pipelineStatusWatchFlagDescription = `Optional. Stream the status changes of the actions
+until no action of the pipeline is in progress.`
 
 	// Storage.
 	storageFlagDescription             = "Name of the storage resource to create."
@@ -331,12 +581,33 @@ Must be of the format '<keyName>:<dataType>'.`
 	storageNoLSIFlagDescription     = `Optional. Don't ask about configuring alternate sort keys.`
 	storageLSIConfigFlagDescription = `Optional. Attribute to use as an alternate sort key. May be specified up to 5 times.
 Must be of the format '<keyName>:<dataType>'.`
+	storageNoGSIFlagDescription     = `Optional. Don't ask about configuring global secondary indexes.`
+	storageGSIConfigFlagDescription = `Optional. Keys of a global secondary index. May be specified up to 20 times.
+Must be of the format '<keyName>:<dataType>[,<keyName>:<dataType>]'.`
+	storageDDBTTLFlagDescription    = "Optional. Attribute that holds the expiration time of the items in the DDB table."
+	storageDDBStreamFlagDescription = `Optional. Information written to the stream of the DDB table when items are modified.
+Must be one of "NEW_AND_OLD_IMAGES", "NEW_IMAGE", "OLD_IMAGE" or "KEYS_ONLY".`
 	storageAuroraServerlessVersionFlagDescription = `Optional. Aurora Serverless version.
 Must be either "v1" or "v2".`
 	storageRDSEngineFlagDescription = `The database engine used in the cluster.
 Must be either "MySQL" or "PostgreSQL".`
 	storageRDSInitialDBFlagDescription      = "The initial database to create in the cluster."
 	storageRDSParameterGroupFlagDescription = "Optional. The name of the parameter group to associate with the cluster."
+	storageRDSMinCapacityFlagDescription    = `Optional. The minimum capacity of an Aurora Serverless v2 cluster in ACUs.
+Must be between 0.5 and 128 in increments of 0.5. (default 0.5)`
+	storageRDSMaxCapacityFlagDescription = `Optional. The maximum capacity of an Aurora Serverless v2 cluster in ACUs.
+Must be between 0.5 and 128 in increments of 0.5. (default 8)`
+	storageEFSThroughputModeFlagDescription = `Optional. The throughput mode of the file system.
+Must be either "bursting" or "elastic".`
+	storageEFSTransitionToIAFlagDescription = `Optional. Number of days since the last access after which
+files are moved to the Infrequent Access storage class.
+Must be one of 1, 7, 14, 30, 60, 90, 180, 270 or 365.`
+	storageEFSNoBackupFlagDescription = "Optional. Disable the automatic backups of the file system."
+	storageNameFlagDescription        = "Name of the storage resource."
+	storageEnvFlagDescription         = `Optional. Name of the environment.
+Defaults to all the environments of the application.`
+	storageDeleteWorkloadFlagDescription = `Optional. Name of the workload of the storage resource.
+Defaults to the storage resources of the environments.`
 
 	// One-off tasks.
 	countFlagDescription         = "Optional. The number of tasks to set up."
@@ -359,6 +630,9 @@ Tasks with the same group name share the same set of resources.
 To use it for an ECS service, specify --generate-cmd <cluster name>/<service name>.
 Alternatively, if the service or job is created with Copilot, specify --generate-cmd <application>/<environment>/<service or job name>.
 Cannot be specified with any other flags.`
+	taskWaitFlagDescription    = `Optional. Wait for the tasks to stop, and exit with the exit code of the first essential container that failed.`
+	taskTimeoutFlagDescription = `Optional. The maximum time to wait for the tasks to stop with --wait or --follow.
+The tasks are stopped if they are still running after the timeout. For example: "10m", "1h30m".`
 
 	// Environment configurations.
 	vpcIDFlagDescription              = "Optional. Use an existing VPC ID."
@@ -380,6 +654,8 @@ Cannot be specified with --default-config or any of the --override flags.`
 
 	enableContainerInsightsFlagDescription = "Optional. Enable CloudWatch Container Insights."
 	defaultConfigFlagDescription           = "Optional. Skip prompting and use default environment configuration."
+	fromEnvFlagDescription                 = `Optional. Name of an existing environment to copy the manifest from.
+Cannot be specified with --default-config or any of the import, override or telemetry flags.`
 
 	profileFlagDescription         = "Name of the profile."
 	accessKeyIDFlagDescription     = "Optional. An AWS access key."
@@ -402,9 +678,25 @@ Accepts cron expressions of the format (M H DoM M DoW) and schedule definition s
 For example: "0 * * * *", "@daily", "@weekly", "@every 1h30m".
 AWS Schedule Expressions of the form "rate(10 minutes)" or "cron(0 12 L * ? 2021)"
 are also accepted.`
-	upgradeAllEnvsDescription          = "Optional. Upgrade all environments."
-	secretOverwriteFlagDescription     = "Optional. Whether to overwrite an existing secret."
+	upgradeAllEnvsDescription = `Optional. Upgrade all the environments and workloads of the application
+that are on an older version, confirming the diff of each one.`
+	upgradePlanEnvDescription = `Optional. Show the changes to the environment template
+from upgrading it to the latest version, without deploying them.`
+	secretOverwriteFlagDescription = "Optional. Whether to overwrite an existing secret."
+	secretFlagDescription          = "Name of the secret."
+	secretEnvFlagDescription       = `Optional. Name of the environment.
+Defaults to all the environments of the application.`
+	secretRevealFlagDescription        = "Optional. Show the values of the secret."
+	secretFromFileFlagDescription      = `Path to a .env file with a secret per line, formatted as <name>=<value>.`
 	permissionsBoundaryFlagDescription = `Optional. The name or ARN of an existing IAM policy with which to set a
 permissions boundary for all roles generated within the application.`
 	prodEnvFlagDescription = "If the environment contains production services."
+
+	dlqQueueFlagDescription = `Optional. Name of the dead-letter queue, such as "DeadLetterQueue".
+Defaults to all the dead-letter queues of the service.`
+	dlqPeekLimitFlagDescription = "Optional. The maximum number of messages to show from each queue, up to 10."
+
+	statsSinceFlagDescription    = `Optional. Only include the commands run within a relative duration like 24h or 168h.`
+	statsEndpointFlagDescription = `Optional. URL of an HTTP endpoint to POST each event to as JSON, in addition to the local file.
+Defaults to keeping the events on this machine only.`
 )
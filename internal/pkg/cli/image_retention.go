@@ -0,0 +1,53 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+)
+
+// imageRetentionVars holds the flag values that configure the lifecycle of the ECR repositories created by Copilot.
+type imageRetentionVars struct {
+	keepImages         int
+	untaggedExpiryDays int
+	immutableTags      bool
+}
+
+func (v imageRetentionVars) validate() error {
+	if v.keepImages < 0 {
+		return fmt.Errorf("--%s must be greater than or equal to 0", keepImagesFlag)
+	}
+	if v.untaggedExpiryDays < 0 {
+		return fmt.Errorf("--%s must be greater than or equal to 0", untaggedImageExpiryFlag)
+	}
+	return nil
+}
+
+func (v imageRetentionVars) isSet() bool {
+	return v.keepImages > 0 || v.untaggedExpiryDays > 0 || v.immutableTags
+}
+
+// applyTo returns the retention settings in base overridden by the flags that are set.
+// It returns base as is if no flag is set.
+func (v imageRetentionVars) applyTo(base *config.ImageRetention) *config.ImageRetention {
+	if !v.isSet() {
+		return base
+	}
+	var retention config.ImageRetention
+	if base != nil {
+		retention = *base
+	}
+	if v.keepImages > 0 {
+		retention.KeepImages = v.keepImages
+	}
+	if v.untaggedExpiryDays > 0 {
+		retention.UntaggedExpiryDays = v.untaggedExpiryDays
+	}
+	if v.immutableTags {
+		retention.ImmutableTags = true
+	}
+	return &retention
+}
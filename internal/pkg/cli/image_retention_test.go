@@ -0,0 +1,47 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImageRetentionVars_ApplyTo(t *testing.T) {
+	testCases := map[string]struct {
+		inVars imageRetentionVars
+		inBase *config.ImageRetention
+
+		wanted *config.ImageRetention
+	}{
+		"returns nil if no flag is set and there are no settings": {},
+		"keeps the settings if no flag is set": {
+			inBase: &config.ImageRetention{KeepImages: 10},
+			wanted: &config.ImageRetention{KeepImages: 10},
+		},
+		"overrides the settings with the flags that are set": {
+			inVars: imageRetentionVars{
+				untaggedExpiryDays: 3,
+				immutableTags:      true,
+			},
+			inBase: &config.ImageRetention{
+				KeepImages:         10,
+				UntaggedExpiryDays: 7,
+			},
+			wanted: &config.ImageRetention{
+				KeepImages:         10,
+				UntaggedExpiryDays: 3,
+				ImmutableTags:      true,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.inVars.applyTo(tc.inBase))
+		})
+	}
+}
@@ -58,6 +58,8 @@ type initVars struct {
 	dockerfilePath string
 	image          string
 	imageTag       string
+	composeFile    string
+	k8sPath        string
 
 	// Service specific flags
 	port uint16
@@ -75,12 +77,13 @@ type initOpts struct {
 	promptForShouldDeploy bool // true means that the user set the ShouldDeploy flag explicitly.
 
 	// Sub-commands to execute.
-	initAppCmd   actionCommand
-	initWlCmd    actionCommand
-	initEnvCmd   actionCommand
-	deployEnvCmd cmd
-	deploySvcCmd actionCommand
-	deployJobCmd actionCommand
+	initAppCmd    actionCommand
+	initWlCmd     actionCommand
+	initImportCmd actionCommand
+	initEnvCmd    actionCommand
+	deployEnvCmd  cmd
+	deploySvcCmd  actionCommand
+	deployJobCmd  actionCommand
 
 	// Pointers to flag values part of sub-commands.
 	// Since the sub-commands implement the actionCommand interface, without pointers to their internal fields
@@ -89,6 +92,7 @@ type initOpts struct {
 	port         *uint16
 	schedule     *string
 	initWkldVars *initWkldVars
+	importedSvcs *[]string
 
 	prompt prompter
 
@@ -161,6 +165,7 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		identity:        id,
 		fs:              fs,
 		newInterpolator: newManifestInterpolator,
+		locker:          newStackLocker(defaultSess, false, 0),
 		newEnvVersionGetter: func(appName, envName string) (versionGetter, error) {
 			return describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 				App:         appName,
@@ -183,6 +188,7 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		spinner:         spin,
 		cmd:             exec.NewCmd(),
 		sessProvider:    sessProvider,
+		locker:          newStackLocker(defaultSess, false, 0),
 		templateVersion: version.LatestTemplateVersion(),
 	}
 	deploySvcCmd.newSvcDeployer = func() (workloadDeployer, error) {
@@ -198,12 +204,29 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		unmarshal:       manifest.UnmarshalWorkload,
 		cmd:             exec.NewCmd(),
 		sessProvider:    sessProvider,
+		locker:          newStackLocker(defaultSess, false, 0),
 		templateVersion: version.LatestTemplateVersion(),
 	}
 	deployJobCmd.newJobDeployer = func() (workloadDeployer, error) {
 		return newJobDeployer(deployJobCmd)
 	}
 
+	var initImportCmd actionCommand
+	var importedSvcs *[]string
+	if vars.k8sPath != "" {
+		initK8sCmd := &initK8sOpts{
+			path: vars.k8sPath,
+			fs:   fs,
+		}
+		initImportCmd, importedSvcs = initK8sCmd, &initK8sCmd.services
+	} else {
+		initComposeCmd := &initComposeOpts{
+			path: vars.composeFile,
+			fs:   fs,
+		}
+		initImportCmd, importedSvcs = initComposeCmd, &initComposeCmd.services
+	}
+
 	cmd := exec.NewCmd()
 
 	useExistingWorkspaceClient := func(o *initOpts) error {
@@ -227,19 +250,28 @@ func newInitOpts(vars initVars) (*initOpts, error) {
 		if initWkCmd, ok := o.initWlCmd.(*initJobOpts); ok {
 			initWkCmd.init = &initialize.WorkloadInitializer{Store: configStore, Ws: ws, Prog: spin, Deployer: deployer}
 		}
+		switch initImportCmd := o.initImportCmd.(type) {
+		case *initComposeOpts:
+			initImportCmd.ws = ws
+			initImportCmd.init = &initialize.WorkloadInitializer{Store: configStore, Ws: ws, Prog: spin, Deployer: deployer}
+		case *initK8sOpts:
+			initImportCmd.init = &initialize.WorkloadInitializer{Store: configStore, Ws: ws, Prog: spin, Deployer: deployer}
+		}
 		return nil
 	}
 	return &initOpts{
 		initVars:     vars,
 		ShouldDeploy: vars.shouldDeploy,
 
-		initAppCmd:   initAppCmd,
-		initEnvCmd:   initEnvCmd,
-		deployEnvCmd: deployEnvCmd,
-		deploySvcCmd: deploySvcCmd,
-		deployJobCmd: deployJobCmd,
+		initAppCmd:    initAppCmd,
+		initImportCmd: initImportCmd,
+		initEnvCmd:    initEnvCmd,
+		deployEnvCmd:  deployEnvCmd,
+		deploySvcCmd:  deploySvcCmd,
+		deployJobCmd:  deployJobCmd,
 
-		appName: &initAppCmd.name,
+		appName:      &initAppCmd.name,
+		importedSvcs: importedSvcs,
 
 		prompt: prompt,
 
@@ -360,6 +392,9 @@ containerized services that operate together.`))
 	if err := o.loadApp(); err != nil {
 		return err
 	}
+	if o.composeFile != "" || o.k8sPath != "" {
+		return o.runImport()
+	}
 
 	if err := o.loadWkld(); err != nil {
 		return err
@@ -384,6 +419,47 @@ containerized services that operate together.`))
 	return o.deploy()
 }
 
+// runImport executes "app init", creates a service for each service of the Docker Compose file or
+// of the Kubernetes manifests, and optionally deploys them to a test environment.
+func (o *initOpts) runImport() error {
+	path := o.composeFile
+	if o.k8sPath != "" {
+		path = o.k8sPath
+	}
+	if err := o.initImportCmd.Validate(); err != nil {
+		return fmt.Errorf("validate %s: %w", path, err)
+	}
+	log.Infof("Ok great, we'll set up the services of %s in application %s.\n", color.HighlightResource(path), color.HighlightUserInput(*o.appName))
+
+	log.Infoln()
+	if err := o.initAppCmd.Execute(); err != nil {
+		return fmt.Errorf("execute app init: %w", err)
+	}
+	if err := o.useExistingWorkspaceForCMDs(o); err != nil {
+		return fmt.Errorf("set up workspace client for commands: %w", err)
+	}
+	// Set the application name from app init to the import command.
+	switch initImportCmd := o.initImportCmd.(type) {
+	case *initComposeOpts:
+		initImportCmd.appName = *o.appName
+	case *initK8sOpts:
+		initImportCmd.appName = *o.appName
+	}
+	if err := o.initImportCmd.Execute(); err != nil {
+		return fmt.Errorf("execute init from %s: %w", path, err)
+	}
+
+	if err := o.deployEnv(); err != nil {
+		return err
+	}
+	for _, name := range *o.importedSvcs {
+		if err := o.deploySvc(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (o *initOpts) logWorkloadTypeAck() {
 	if manifestinfo.IsTypeAJob(o.initWkldVars.wkldType) {
 		log.Infof("Ok great, we'll set up a %s named %s in application %s running on the schedule %s.\n",
@@ -397,7 +473,7 @@ func (o *initOpts) deploy() error {
 	if manifestinfo.IsTypeAJob(o.initWkldVars.wkldType) {
 		return o.deployJob()
 	}
-	return o.deploySvc()
+	return o.deploySvc(o.initWkldVars.name)
 }
 func (o *initOpts) loadApp() error {
 	if err := o.initAppCmd.Ask(); err != nil {
@@ -487,13 +563,13 @@ func (o *initOpts) deployEnv() error {
 	return nil
 }
 
-func (o *initOpts) deploySvc() error {
+func (o *initOpts) deploySvc(name string) error {
 	if !o.ShouldDeploy {
 		return nil
 	}
 	if deployOpts, ok := o.deploySvcCmd.(*deploySvcOpts); ok {
 		// Set the service's name and app name to the deploy sub-command.
-		deployOpts.name = o.initWkldVars.name
+		deployOpts.name = name
 		deployOpts.appName = *o.appName
 	}
 
@@ -578,6 +654,13 @@ func BuildInitCmd() *cobra.Command {
 	cmd.Flags().StringVar(&vars.schedule, scheduleFlag, "", scheduleFlagDescription)
 	cmd.Flags().StringVar(&vars.timeout, timeoutFlag, "", timeoutFlagDescription)
 	cmd.Flags().IntVar(&vars.retries, retriesFlag, 0, retriesFlagDescription)
+	cmd.Flags().StringVar(&vars.composeFile, fromComposeFlag, "", fromComposeFlagDescription)
+	cmd.Flags().StringVar(&vars.k8sPath, fromK8sFlag, "", fromK8sFlagDescription)
+	cmd.MarkFlagsMutuallyExclusive(fromComposeFlag, fromK8sFlag)
+	for _, flag := range []string{nameFlag, typeFlag, dockerFileFlag, imageFlag, svcPortFlag, scheduleFlag, timeoutFlag, retriesFlag} {
+		cmd.MarkFlagsMutuallyExclusive(fromComposeFlag, flag)
+		cmd.MarkFlagsMutuallyExclusive(fromK8sFlag, flag)
+	}
 	cmd.SetUsageTemplate(cmdtemplate.Usage)
 	cmd.Annotations = map[string]string{
 		"group": group.GettingStarted,
@@ -0,0 +1,222 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockercompose"
+	"github.com/aws/copilot-cli/internal/pkg/initialize"
+	"github.com/aws/copilot-cli/internal/pkg/kubernetes"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/afero"
+)
+
+// initComposeOpts creates a service for each service of a Docker Compose file.
+type initComposeOpts struct {
+	path    string
+	appName string
+
+	fs   afero.Fs
+	ws   relPath
+	init svcInitializer
+
+	// Cached variables.
+	project  *dockercompose.Project
+	services []string // Names of the initialized services, in the order of the Compose file.
+}
+
+// Validate returns an error if the Compose file can't be read or converted.
+func (o *initComposeOpts) Validate() error {
+	content, err := afero.ReadFile(o.fs, o.path)
+	if err != nil {
+		return fmt.Errorf("read Docker Compose file %s: %w", o.path, err)
+	}
+	project, err := dockercompose.Parse(content)
+	if err != nil {
+		return fmt.Errorf("convert Docker Compose file %s: %w", o.path, err)
+	}
+	o.project = project
+	return nil
+}
+
+// Ask is a no-op, as all the information comes from the Compose file.
+func (o *initComposeOpts) Ask() error {
+	return nil
+}
+
+// Execute writes the manifest of each service of the Compose file, and adds the services to the application.
+func (o *initComposeOpts) Execute() error {
+	dir := filepath.Dir(o.path)
+	var props []*initialize.ServiceProps
+	for _, svc := range o.project.Services {
+		overrides, err := svc.ManifestOverrides(func(path string) (string, error) {
+			return o.ws.Rel(filepath.Join(dir, path))
+		})
+		if err != nil {
+			return fmt.Errorf("convert the fields of service %s: %w", svc.Name, err)
+		}
+		p := &initialize.ServiceProps{
+			WorkloadProps: initialize.WorkloadProps{
+				App:   o.appName,
+				Name:  svc.Name,
+				Type:  svc.Type,
+				Image: svc.Image,
+			},
+			Port:              svc.Port,
+			HealthCheck:       svc.HealthCheck,
+			ManifestOverrides: overrides,
+		}
+		if svc.Dockerfile != "" {
+			p.DockerfilePath = filepath.Join(dir, svc.Dockerfile)
+		}
+		if svc.Platform != "" {
+			platform := manifest.PlatformString(svc.Platform)
+			p.Platform = manifest.PlatformArgsOrString{PlatformString: &platform}
+		}
+		props = append(props, p)
+	}
+	services, err := initImportedServices(o.init, props)
+	o.services = services
+	if err != nil {
+		return err
+	}
+	logImportWarnings(o.path, o.project.Warnings)
+	return nil
+}
+
+// RecommendActions is a no-op, as "init" recommends the follow-up actions.
+func (o *initComposeOpts) RecommendActions() error {
+	return nil
+}
+
+// initK8sOpts creates a service for each Deployment of Kubernetes manifests.
+type initK8sOpts struct {
+	path    string // Path to a manifest file, or to a directory of manifest files.
+	appName string
+
+	fs   afero.Fs
+	init svcInitializer
+
+	// Cached variables.
+	project  *kubernetes.Project
+	services []string // Names of the initialized services, in the order of the manifests.
+}
+
+// Validate returns an error if the Kubernetes manifests can't be read or converted.
+func (o *initK8sOpts) Validate() error {
+	files, err := o.manifestFiles()
+	if err != nil {
+		return err
+	}
+	var contents [][]byte
+	for _, file := range files {
+		content, err := afero.ReadFile(o.fs, file)
+		if err != nil {
+			return fmt.Errorf("read Kubernetes manifest %s: %w", file, err)
+		}
+		contents = append(contents, content)
+	}
+	project, err := kubernetes.Parse(contents...)
+	if err != nil {
+		return fmt.Errorf("convert Kubernetes manifests in %s: %w", o.path, err)
+	}
+	o.project = project
+	return nil
+}
+
+// manifestFiles returns the path, or the YAML files under the path if it is a directory, in lexical order.
+func (o *initK8sOpts) manifestFiles() ([]string, error) {
+	isDir, err := afero.IsDir(o.fs, o.path)
+	if err != nil {
+		return nil, fmt.Errorf("check if %s is a directory: %w", o.path, err)
+	}
+	if !isDir {
+		return []string{o.path}, nil
+	}
+	var files []string
+	err = afero.Walk(o.fs, o.path, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if ext := strings.ToLower(filepath.Ext(path)); !info.IsDir() && (ext == ".yml" || ext == ".yaml") {
+			files = append(files, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list Kubernetes manifests in %s: %w", o.path, err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no YAML files in directory %s", o.path)
+	}
+	return files, nil
+}
+
+// Ask is a no-op, as all the information comes from the Kubernetes manifests.
+func (o *initK8sOpts) Ask() error {
+	return nil
+}
+
+// Execute writes the manifest of each Deployment, and adds the services to the application.
+func (o *initK8sOpts) Execute() error {
+	var props []*initialize.ServiceProps
+	for _, svc := range o.project.Services {
+		overrides, err := svc.ManifestOverrides()
+		if err != nil {
+			return fmt.Errorf("convert the fields of service %s: %w", svc.Name, err)
+		}
+		props = append(props, &initialize.ServiceProps{
+			WorkloadProps: initialize.WorkloadProps{
+				App:   o.appName,
+				Name:  svc.Name,
+				Type:  svc.Type,
+				Image: svc.Image,
+			},
+			Port:              svc.Port,
+			HealthCheck:       svc.HealthCheck,
+			ManifestOverrides: overrides,
+		})
+	}
+	services, err := initImportedServices(o.init, props)
+	o.services = services
+	if err != nil {
+		return err
+	}
+	logImportWarnings(o.path, o.project.Warnings)
+	return nil
+}
+
+// RecommendActions is a no-op, as "init" recommends the follow-up actions.
+func (o *initK8sOpts) RecommendActions() error {
+	return nil
+}
+
+// initImportedServices initializes the services, and returns the names of the services that were initialized.
+func initImportedServices(init svcInitializer, props []*initialize.ServiceProps) ([]string, error) {
+	var names []string
+	for _, p := range props {
+		if _, err := init.Service(p); err != nil {
+			return names, fmt.Errorf("initialize service %s: %w", p.Name, err)
+		}
+		names = append(names, p.Name)
+	}
+	return names, nil
+}
+
+func logImportWarnings(path string, warnings []string) {
+	if len(warnings) == 0 {
+		return
+	}
+	log.Warningf("Some settings of %s are not converted to the manifests:\n", color.HighlightResource(path))
+	for _, warning := range warnings {
+		log.Warningf("- %s\n", warning)
+	}
+	log.Infoln()
+}
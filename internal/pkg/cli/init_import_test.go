@@ -0,0 +1,264 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/initialize"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitComposeOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedErr string
+	}{
+		"returns an error if the file does not exist": {
+			wantedErr: "read Docker Compose file app/docker-compose.yml: open app/docker-compose.yml: file does not exist",
+		},
+		"returns an error if the file can't be converted": {
+			inContent: "services: {}",
+			wantedErr: `convert Docker Compose file app/docker-compose.yml: Compose file has no "services"`,
+		},
+		"success": {
+			inContent: `
+services:
+  web:
+    image: nginx`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			if tc.inContent != "" {
+				require.NoError(t, afero.WriteFile(fs, "app/docker-compose.yml", []byte(tc.inContent), 0644))
+			}
+			opts := &initComposeOpts{
+				path: "app/docker-compose.yml",
+				fs:   fs,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, opts.project.Services, 1)
+		})
+	}
+}
+
+func TestInitComposeOpts_Execute(t *testing.T) {
+	const compose = `
+services:
+  web:
+    build: ./web
+    ports: ["8080:80"]
+    environment:
+      API_URL: http://api:3000
+  api:
+    image: api:latest
+    expose: [3000]
+    platform: linux/arm64`
+	testCases := map[string]struct {
+		setupMocks func(ws *mocks.MockrelPath, init *mocks.MocksvcInitializer)
+
+		wantedServices []string
+		wantedErr      string
+	}{
+		"initializes every service": {
+			setupMocks: func(ws *mocks.MockrelPath, init *mocks.MocksvcInitializer) {
+				init.EXPECT().Service(gomock.Any()).DoAndReturn(func(props *initialize.ServiceProps) (string, error) {
+					require.Equal(t, "demo", props.App)
+					require.Equal(t, "web", props.Name)
+					require.Equal(t, manifestinfo.LoadBalancedWebServiceType, props.Type)
+					require.Equal(t, filepath.Join("app", "web", "Dockerfile"), props.DockerfilePath)
+					require.Equal(t, uint16(80), props.Port)
+					require.Equal(t, "variables:\n  API_URL: \"http://api:3000\"\n", string(props.ManifestOverrides))
+					return "copilot/web/manifest.yml", nil
+				})
+				init.EXPECT().Service(gomock.Any()).DoAndReturn(func(props *initialize.ServiceProps) (string, error) {
+					require.Equal(t, "api", props.Name)
+					require.Equal(t, manifestinfo.BackendServiceType, props.Type)
+					require.Equal(t, "api:latest", props.Image)
+					require.Equal(t, uint16(3000), props.Port)
+					require.Equal(t, "linux/arm64", string(*props.Platform.PlatformString))
+					require.Nil(t, props.ManifestOverrides)
+					return "copilot/api/manifest.yml", nil
+				})
+			},
+			wantedServices: []string{"web", "api"},
+		},
+		"returns an error if a service can't be initialized": {
+			setupMocks: func(ws *mocks.MockrelPath, init *mocks.MocksvcInitializer) {
+				init.EXPECT().Service(gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: "initialize service web: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockrelPath(ctrl)
+			init := mocks.NewMocksvcInitializer(ctrl)
+			tc.setupMocks(ws, init)
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "app/docker-compose.yml", []byte(compose), 0644))
+			opts := &initComposeOpts{
+				path:    "app/docker-compose.yml",
+				appName: "demo",
+				fs:      fs,
+				ws:      ws,
+				init:    init,
+			}
+			require.NoError(t, opts.Validate())
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedServices, opts.services)
+		})
+	}
+}
+
+func TestInitK8sOpts_Validate(t *testing.T) {
+	const deployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: api
+spec:
+  template:
+    spec:
+      containers:
+        - name: api
+          image: api:latest`
+	const service = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: api`
+	testCases := map[string]struct {
+		inPath  string
+		inFiles map[string]string
+
+		wantedServices int
+		wantedErr      string
+	}{
+		"reads a manifest file": {
+			inPath:         "k8s/api.yaml",
+			inFiles:        map[string]string{"k8s/api.yaml": deployment},
+			wantedServices: 1,
+		},
+		"reads the YAML files of a directory": {
+			inPath: "k8s",
+			inFiles: map[string]string{
+				"k8s/api/deployment.yaml": deployment,
+				"k8s/api/service.yml":     service,
+				"k8s/README.md":           "# Manifests",
+			},
+			wantedServices: 1,
+		},
+		"returns an error if the directory has no YAML files": {
+			inPath:    "k8s",
+			inFiles:   map[string]string{"k8s/README.md": "# Manifests"},
+			wantedErr: "no YAML files in directory k8s",
+		},
+		"returns an error if the manifests can't be converted": {
+			inPath:    "k8s",
+			inFiles:   map[string]string{"k8s/service.yml": service},
+			wantedErr: "convert Kubernetes manifests in k8s: Kubernetes manifests have no Deployment",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			for path, content := range tc.inFiles {
+				require.NoError(t, afero.WriteFile(fs, path, []byte(content), 0644))
+			}
+			opts := &initK8sOpts{
+				path: tc.inPath,
+				fs:   fs,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, opts.project.Services, tc.wantedServices)
+		})
+	}
+}
+
+func TestInitK8sOpts_Execute(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	init := mocks.NewMocksvcInitializer(ctrl)
+	init.EXPECT().Service(gomock.Any()).DoAndReturn(func(props *initialize.ServiceProps) (string, error) {
+		require.Equal(t, "demo", props.App)
+		require.Equal(t, "api", props.Name)
+		require.Equal(t, manifestinfo.BackendServiceType, props.Type)
+		require.Equal(t, "api:latest", props.Image)
+		require.Equal(t, uint16(3000), props.Port)
+		require.Equal(t, "count: 3\n", string(props.ManifestOverrides))
+		return "copilot/api/manifest.yml", nil
+	})
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "k8s.yaml", []byte(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: api
+spec:
+  replicas: 3
+  template:
+    spec:
+      containers:
+        - name: api
+          image: api:latest
+          ports:
+            - containerPort: 3000`), 0644))
+	opts := &initK8sOpts{
+		path:    "k8s.yaml",
+		appName: "demo",
+		fs:      fs,
+		init:    init,
+	}
+	require.NoError(t, opts.Validate())
+
+	// WHEN
+	err := opts.Execute()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []string{"api"}, opts.services)
+}
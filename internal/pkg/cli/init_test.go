@@ -172,10 +172,18 @@ func TestInitOpts_Run(t *testing.T) {
 						Value: manifestinfo.StaticSiteType,
 						Hint:  "Internet to CDN to S3 bucket",
 					},
+					{
+						Value: manifestinfo.LambdaServiceType,
+						Hint:  "Internet to Lambda",
+					},
 					{
 						Value: manifestinfo.ScheduledJobType,
 						Hint:  "Scheduled event to State Machine to Fargate",
 					},
+					{
+						Value: manifestinfo.WorkflowJobType,
+						Hint:  "Scheduled event to State Machine to a sequence of Fargate tasks",
+					},
 				}, gomock.Any())
 				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Ask().Return(nil)
 				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Validate().Return(nil)
@@ -257,3 +265,85 @@ func TestInitOpts_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestInitOpts_RunImport(t *testing.T) {
+	mockAppName := "demo"
+	testCases := map[string]struct {
+		inShouldDeploy bool
+
+		expect      func(opts *initOpts)
+		wantedError string
+	}{
+		"returns validation error for the Compose file": {
+			expect: func(opts *initOpts) {
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Ask().Return(nil)
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Validate().Return(nil)
+				opts.initImportCmd.(*climocks.MockactionCommand).EXPECT().Validate().Return(errors.New("some error"))
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Execute().Times(0)
+			},
+			wantedError: "validate docker-compose.yml: some error",
+		},
+		"returns execute error for the Compose file": {
+			expect: func(opts *initOpts) {
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Ask().Return(nil)
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Validate().Return(nil)
+				opts.initImportCmd.(*climocks.MockactionCommand).EXPECT().Validate().Return(nil)
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Execute().Return(nil)
+				opts.initImportCmd.(*climocks.MockactionCommand).EXPECT().Execute().Return(errors.New("some error"))
+			},
+			wantedError: "execute init from docker-compose.yml: some error",
+		},
+		"deploys every service of the Compose file": {
+			inShouldDeploy: true,
+			expect: func(opts *initOpts) {
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Ask().Return(nil)
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Validate().Return(nil)
+				opts.initImportCmd.(*climocks.MockactionCommand).EXPECT().Validate().Return(nil)
+				opts.initAppCmd.(*climocks.MockactionCommand).EXPECT().Execute().Return(nil)
+				opts.initImportCmd.(*climocks.MockactionCommand).EXPECT().Execute().Return(nil)
+				opts.initEnvCmd.(*climocks.MockactionCommand).EXPECT().Execute().Return(nil)
+				opts.deployEnvCmd.(*climocks.Mockcmd).EXPECT().Execute().Return(nil)
+				opts.deploySvcCmd.(*climocks.MockactionCommand).EXPECT().Ask().Return(nil).Times(2)
+				opts.deploySvcCmd.(*climocks.MockactionCommand).EXPECT().Execute().Return(nil).Times(2)
+				opts.deploySvcCmd.(*climocks.MockactionCommand).EXPECT().RecommendActions().Return(nil).Times(2)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			opts := &initOpts{
+				initVars: initVars{
+					composeFile: "docker-compose.yml",
+				},
+				ShouldDeploy: tc.inShouldDeploy,
+
+				initAppCmd:    climocks.NewMockactionCommand(ctrl),
+				initImportCmd: climocks.NewMockactionCommand(ctrl),
+				initEnvCmd:    climocks.NewMockactionCommand(ctrl),
+				deployEnvCmd:  climocks.NewMockcmd(ctrl),
+				deploySvcCmd:  climocks.NewMockactionCommand(ctrl),
+
+				appName:      &mockAppName,
+				importedSvcs: &[]string{"web", "api"},
+				useExistingWorkspaceForCMDs: func(opts *initOpts) error {
+					return nil
+				},
+			}
+			tc.expect(opts)
+
+			// WHEN
+			err := opts.Run()
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
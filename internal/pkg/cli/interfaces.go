@@ -7,21 +7,33 @@ import (
 	"context"
 	"encoding"
 	"io"
+	"time"
 
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/dynamodb"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/efs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/rds"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/lock"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/quota"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
@@ -31,6 +43,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/logging"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/aws/copilot-cli/internal/pkg/telemetry"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -190,12 +203,33 @@ type logEventsWriter interface {
 	WriteLogEvents(opts logging.WriteLogEventsOpts) error
 }
 
+type jobTasksLister interface {
+	TaskIDs() ([]string, error)
+}
+
+type deadLetterQueueLister interface {
+	DeadLetterQueues() ([]describe.DeadLetterQueue, error)
+}
+
+type queueMessagePeeker interface {
+	Peek(url string, limit int) ([]sqs.Message, error)
+}
+
+type queueRedriver interface {
+	Redrive(deadLetterQueueARN string) (string, error)
+}
+
 type execRunner interface {
 	Run(name string, args []string, options ...exec.CmdOption) error
 }
 
 type eventsWriter interface {
 	WriteEventsUntilStopped() error
+	WaitUntilStopped() error
+}
+
+type ecsTaskStopper interface {
+	StopTasks(tasks []string, opts ...awsecs.StopTasksOpts) error
 }
 
 type defaultSessionProvider interface {
@@ -233,6 +267,7 @@ type describer interface {
 type workloadDescriber interface {
 	describer
 	Manifest(string) ([]byte, error)
+	RenderedManifest(string) ([]byte, error)
 }
 
 type wsFileDeleter interface {
@@ -247,6 +282,10 @@ type environmentManifestWriter interface {
 	WriteEnvironmentManifest(encoding.BinaryMarshaler, string) (string, error)
 }
 
+type environmentManifestReader interface {
+	ReadEnvironmentManifest(mftDirName string) (workspace.EnvironmentManifest, error)
+}
+
 type workspacePathGetter interface {
 	Path() string
 }
@@ -267,6 +306,7 @@ type wsPipelineIniter interface {
 	relPath
 	WritePipelineBuildspec(marshaler encoding.BinaryMarshaler, name string) (string, error)
 	WritePipelineManifest(marshaler encoding.BinaryMarshaler, name string) (string, error)
+	WriteGitHubWorkflow(marshaler encoding.BinaryMarshaler, name string) (string, error)
 	ListPipelines() ([]workspace.PipelineManifest, error)
 }
 
@@ -288,10 +328,25 @@ type wsJobReader interface {
 	jobLister
 }
 
+type wsWorkloadManifestReader interface {
+	manifestReader
+	serviceLister
+	jobLister
+}
+
 type wlLister interface {
 	ListWorkloads() ([]string, error)
 }
 
+type wsEnvironmentWorkloadLister interface {
+	wsEnvironmentsLister
+	wlLister
+}
+
+type deployedWorkloadsLister interface {
+	ListDeployedWorkloads(appName, envName string) ([]string, error)
+}
+
 type wsWorkloadReader interface {
 	manifestReader
 	ReadFile(path string) ([]byte, error)
@@ -321,6 +376,7 @@ type wsWlDirReader interface {
 	workspacePathGetter
 	wlLister
 	wsEnvironmentsLister
+	environmentManifestReader
 	WorkloadOverridesPath(string) string
 	Summary() (*workspace.Summary, error)
 }
@@ -379,6 +435,8 @@ type environmentDeployer interface {
 
 type wlDeleter interface {
 	DeleteWorkload(in deploy.DeleteWorkloadInput) error
+	Template(stackName string) (string, error)
+	UpdateWorkloadTemplate(appName, envName, name, templateBody, cfnExecRoleARN string) error
 }
 
 type svcRemoverFromApp interface {
@@ -393,6 +451,22 @@ type imageRemover interface {
 	ClearRepository(repoName string) error // implemented by ECR Service
 }
 
+type imageCollector interface {
+	ListImages(repoName string) ([]ecr.Image, error)
+	DeleteImages(images []ecr.Image, repoName string) error
+}
+
+type taskDefinitionCollector interface {
+	ActiveTaskDefinitions(familyPrefix string) ([]string, error)
+	TaskDefinition(taskDefName string) (*awsecs.TaskDefinition, error)
+	DeregisterTaskDefinition(taskDefARN string) error
+}
+
+type logGroupCollector interface {
+	LogGroups(prefix string) ([]cloudwatchlogs.LogGroup, error)
+	DeleteLogGroup(name string) error
+}
+
 type pipelineDeployer interface {
 	CreatePipeline(bucketName string, stackConfig cloudformation.StackConfiguration) error
 	UpdatePipeline(bucketName string, stackConfig cloudformation.StackConfiguration) error
@@ -418,6 +492,31 @@ type appResourcesGetter interface {
 	GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error)
 }
 
+type githubActionsRoleDeployer interface {
+	DeployGitHubActionsRole(conf *stack.GitHubActionsRoleConfig) (string, error)
+}
+
+type oidcProviderGetter interface {
+	OIDCProviderARN(url string) (string, error)
+}
+
+type svcStackDeployer interface {
+	DeployService(conf cloudformation.StackConfiguration, bucketName string, opts ...awscloudformation.StackOption) error
+}
+
+type deploymentRevisionStore interface {
+	List(stackName string) ([]string, error)
+	Get(stackName, id string) (*stack.Revision, error)
+	Record(rev *stack.Revision) error
+}
+
+type deploymentHistory interface {
+	History(stackName string) ([]*stack.Revision, error)
+	Get(stackName, id string) (*stack.Revision, error)
+	LogIDs(stackName string) ([]string, error)
+	Log(stackName, id string) ([]byte, error)
+}
+
 type envDeleterFromApp interface {
 	appResourcesGetter
 	RemoveEnvFromApp(opts *cloudformation.RemoveEnvFromAppOpts) error
@@ -463,11 +562,31 @@ type statusDescriber interface {
 	Describe() (describe.HumanJSONStringer, error)
 }
 
+type topologyDescriber interface {
+	Describe() (describe.GraphStringer, error)
+}
+
+type costEstimator interface {
+	Application() (*describe.CostEstimate, error)
+	Environment(name string) (*describe.CostEstimate, error)
+	Service(name string) (*describe.CostEstimate, error)
+}
+
+type iamPolicyDescriber interface {
+	Describe() (*describe.IAMPolicies, error)
+	Check() (*describe.IAMPolicies, error)
+}
+
 type envDescriber interface {
 	Describe() (*describe.EnvDescription, error)
 	PublicCIDRBlocks() ([]string, error)
 	Manifest() ([]byte, error)
 	ValidateCFServiceDomainAliases() error
+	Features() (*describe.EnvFeatures, error)
+}
+
+type envFeaturesDescriber interface {
+	Features() (*describe.EnvFeatures, error)
 }
 
 type versionCompatibilityChecker interface {
@@ -483,6 +602,37 @@ type appUpgrader interface {
 	UpgradeApplication(in *deploy.CreateAppInput) error
 }
 
+type quotaChecker interface {
+	Check(needs quota.Needs) ([]quota.Usage, error)
+	RequestIncrease(usage quota.Usage) (string, error)
+}
+
+type envOutputsGetter interface {
+	Outputs() (map[string]string, error)
+}
+
+type stackLocker interface {
+	Lock(app, stack, command string) (release func() error, err error)
+}
+
+type stackLockManager interface {
+	List(app string) ([]lock.Lock, error)
+	Release(app, stack string) error
+}
+
+type configStoreMigrator interface {
+	MigrateApplication(appName, backend string) error
+}
+
+type appTagsUpdater interface {
+	UpdateApplicationTags(in *deploy.CreateAppInput) error
+}
+
+type envStacksTagsUpdater interface {
+	ListEnvironmentStacks(appName, envName string) ([]string, error)
+	UpdateStackTags(stackName string, added map[string]string, removed []string) error
+}
+
 type pipelineGetter interface {
 	GetPipeline(pipelineName string) (*codepipeline.Pipeline, error)
 }
@@ -491,6 +641,15 @@ type deployedPipelineLister interface {
 	ListDeployedPipelines(appName string) ([]deploy.Pipeline, error)
 }
 
+type pipelineStatusWatcher interface {
+	Watch(write func(describe.HumanJSONStringer) error) error
+}
+
+type pipelineStageRetrier interface {
+	GetPipelineState(pipelineName string) (*codepipeline.PipelineState, error)
+	RetryStageExecution(pipelineName, stageName string) error
+}
+
 type executor interface {
 	Execute() error
 }
@@ -539,6 +698,12 @@ type wsEnvironmentSelector interface {
 	LocalEnvironment(msg, help string) (wl string, err error)
 }
 
+type workspaceSelector interface {
+	Service(msg, help string) (string, error)
+	Job(msg, help string) (string, error)
+	Environment(msg, help string) (string, error)
+}
+
 type codePipelineSelector interface {
 	appSelector
 	DeployedPipeline(prompt, help, app string) (deploy.Pipeline, error)
@@ -617,6 +782,10 @@ type ecsCommandExecutor interface {
 	ExecuteCommand(in awsecs.ExecuteCommandInput) error
 }
 
+type portForwarder interface {
+	StartPortForwardingSession(in ssm.PortForwardInput) error
+}
+
 type ssmPluginManager interface {
 	ValidateBinary() error
 	InstallLatestBinary() error
@@ -642,6 +811,10 @@ type roleManager interface {
 	serviceLinkedRoleCreator
 }
 
+type stackDriftDetector interface {
+	DetectDrift(ctx context.Context, stackName string) ([]awscloudformation.StackResourceDrift, error)
+}
+
 type stackExistChecker interface {
 	Exists(string) (bool, error)
 }
@@ -650,11 +823,27 @@ type runningTaskSelector interface {
 	RunningTask(prompt, help string, opts ...selector.TaskOpts) (*awsecs.Task, error)
 }
 
+type activeTaskLister interface {
+	ListActiveAppEnvTasks(opts ecs.ListActiveAppEnvTasksOpts) ([]*awsecs.Task, error)
+}
+
 type dockerEngine interface {
 	CheckDockerEngineRunning() error
 	GetPlatform() (string, string, error)
 }
 
+type containerRunner interface {
+	CheckDockerEngineRunning() error
+	Build(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) error
+	Login(uri, username, password string) error
+	Run(ctx context.Context, in *dockerengine.RunOptions, w io.Writer) error
+	Stop(ctx context.Context, containerName string) error
+}
+
+type registryAuthenticator interface {
+	Auth() (username string, password string, err error)
+}
+
 type codestar interface {
 	GetConnectionARN(string) (string, error)
 }
@@ -667,14 +856,92 @@ type cliStringer interface {
 	CLIString() (string, error)
 }
 
+type artifactReader interface {
+	ObjectKeys(bucket, prefix string) ([]string, error)
+	Download(bucket, key string) ([]byte, error)
+}
+
+type provenanceGetter interface {
+	GetSecretValue(name string) (string, error)
+}
+
+type imageVerifier interface {
+	Verify(image, kmsKey string) error
+}
+
 type secretPutter interface {
 	PutSecret(in ssm.PutSecretInput) (*ssm.PutSecretOutput, error)
 }
 
+type parameterSecretsClient interface {
+	secretPutter
+	ListSecrets(tags map[string]string) ([]ssm.Secret, error)
+	GetSecretValue(name string) (string, error)
+	DeleteSecret(name string) error
+}
+
+type secretsManagerSecretsClient interface {
+	ListSecrets(tags map[string]string) ([]secretsmanager.Secret, error)
+	GetSecretValue(name string) (string, error)
+}
+
+type efsDescriber interface {
+	ListFileSystems(tags map[string]string) ([]efs.FileSystem, error)
+	MountTargets(fsID string) ([]efs.MountTarget, error)
+	AccessPoints(fsID string) ([]efs.AccessPoint, error)
+	TransitionToIA(fsID string) (string, error)
+	BackupStatus(fsID string) (string, error)
+}
+
+type resourcesByTagsGetter interface {
+	GetResourcesByTags(resourceType string, tags map[string]string) ([]*resourcegroups.Resource, error)
+}
+
+type dynamoDBTableDescriber interface {
+	DescribeTable(name string) (*dynamodb.Table, error)
+	TTLAttribute(name string) (string, error)
+}
+
+type rdsClusterLister interface {
+	ListClusters(tags map[string]string) ([]rds.Cluster, error)
+}
+
+type rdsClusterStopper interface {
+	rdsClusterLister
+	StopCluster(id string) error
+}
+
+type wsAddonDeleter interface {
+	EnvAddonsAbsPath() string
+	WorkloadAddonsAbsPath(name string) string
+	ListWorkloads() ([]string, error)
+	ListFiles(dirPath string) ([]string, error)
+	DeleteEnvAddonFile(fName string) error
+	DeleteWorkloadAddonFile(wkldName, fName string) error
+}
+
 type servicePauser interface {
 	PauseService(svcARN string) error
 }
 
+type dashboardPutter interface {
+	AlarmStatuses(opts ...cloudwatch.DescribeAlarmOpts) ([]cloudwatch.AlarmStatus, error)
+	PutDashboard(name string, dashboard *cloudwatch.Dashboard) error
+}
+
+type ecsServicePauser interface {
+	PauseService(app, env, svc string) error
+}
+
+type ecsServiceScaler interface {
+	ScaleService(app, env, svc string, count int64) error
+	AutoscalingCapacity(app, env, svc string) (*aas.Capacity, error)
+}
+
+type ecsServiceResumer interface {
+	ResumeService(app, env, svc string) error
+}
+
 type interpolator interface {
 	Interpolate(s string) (string, error)
 }
@@ -685,13 +952,32 @@ type workloadDeployer interface {
 		*clideploy.GenerateCloudFormationTemplateOutput, error)
 	DeployWorkload(in *clideploy.DeployWorkloadInput) (clideploy.ActionRecommender, error)
 	IsServiceAvailableInRegion(region string) (bool, error)
+	AddonsTemplate() (string, error)
 	templateDiffer
+	jsonTemplateDiffer
+}
+
+type addonsTemplateGetter interface {
+	AddonsTemplate() (string, error)
+}
+
+type driftReverter interface {
+	RevertedDrift(out *clideploy.GenerateCloudFormationTemplateOutput) ([]clideploy.DriftedParameter, error)
+}
+
+type workloadManifestEditor interface {
+	EditWorkloadManifest(name string, edit func(raw []byte) ([]byte, error)) (string, error)
+	EditWorkloadEnvOverrides(name, env string, edit func(raw []byte) ([]byte, error)) (string, error)
 }
 
 type templateDiffer interface {
 	DeployDiff(inTmpl string) (string, error)
 }
 
+type jsonTemplateDiffer interface {
+	DeployDiffJSON(inTmpl string) (string, error)
+}
+
 type workloadStackGenerator interface {
 	UploadArtifacts() (*clideploy.UploadArtifactsOutput, error)
 	GenerateCloudFormationTemplate(in *clideploy.GenerateCloudFormationTemplateInput) (
@@ -704,17 +990,23 @@ type runner interface {
 	Run() error
 }
 
+type delayedRunner interface {
+	RunAt(at time.Time) error
+}
+
 type envDeployer interface {
 	DeployEnvironment(in *clideploy.DeployEnvironmentInput) error
 	Validate(*manifest.Environment) error
 	UploadArtifacts() (*clideploy.UploadEnvArtifactsOutput, error)
 	GenerateCloudFormationTemplate(in *clideploy.DeployEnvironmentInput) (
 		*clideploy.GenerateCloudFormationTemplateOutput, error)
+	AddonsTemplate() (string, error)
 	templateDiffer
 }
 
 type envPackager interface {
 	GenerateCloudFormationTemplate(in *clideploy.DeployEnvironmentInput) (*clideploy.GenerateCloudFormationTemplateOutput, error)
+	GenerateBootstrapTemplate(in *clideploy.DeployEnvironmentInput) (*clideploy.GenerateCloudFormationTemplateOutput, error)
 	Validate(*manifest.Environment) error
 	UploadArtifacts() (*clideploy.UploadEnvArtifactsOutput, error)
 	AddonsTemplate() (string, error)
@@ -728,3 +1020,49 @@ type stackConfiguration interface {
 	Tags() []*sdkcloudformation.Tag
 	SerializedParameters() (string, error)
 }
+
+type imageBuilderChecker interface {
+	Builder() string
+	CheckDockerEngineRunning() error
+}
+
+type policySimulator interface {
+	DeniedActions(principalARN string, actions []string) ([]string, error)
+}
+
+type serviceQuotaGetter interface {
+	Quota(serviceCode, quotaCode string) (float64, error)
+}
+
+type metricMaximumGetter interface {
+	MetricMaximum(q cloudwatch.MetricQuery) (*float64, error)
+}
+
+type loadBalancerRuleCounter interface {
+	ListenerRuleCount(lbARN string) (int, error)
+	AccountLimit(name string) (int, error)
+}
+
+type wsDoctorReader interface {
+	Summary() (*workspace.Summary, error)
+	ListServices() ([]string, error)
+	ListJobs() ([]string, error)
+	ListEnvironments() ([]string, error)
+	ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error)
+	ReadEnvironmentManifest(name string) (workspace.EnvironmentManifest, error)
+}
+
+type cacheClearer interface {
+	Clear() error
+}
+
+type telemetryStore interface {
+	Settings() (telemetry.Settings, error)
+	SetSettings(settings telemetry.Settings) error
+	Events() ([]telemetry.Event, error)
+	Clear() error
+}
+
+type appGraphDescriber interface {
+	Describe() (*describe.AppGraph, error)
+}
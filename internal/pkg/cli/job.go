@@ -27,6 +27,8 @@ Jobs are tasks that are triggered by events.`,
 	cmd.AddCommand(buildJobDeleteCmd())
 	cmd.AddCommand(buildJobLogsCmd())
 	cmd.AddCommand(buildJobRunCmd())
+	cmd.AddCommand(buildJobHistoryCmd())
+	cmd.AddCommand(buildJobValidateCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
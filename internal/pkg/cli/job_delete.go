@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -21,9 +22,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
-	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -55,6 +56,8 @@ type deleteJobVars struct {
 	skipConfirmation bool
 	name             string
 	envName          string
+	waitForLock      bool
+	lockTimeout      time.Duration
 }
 
 type deleteJobOpts struct {
@@ -70,6 +73,7 @@ type deleteJobOpts struct {
 	newWlDeleter    func(sess *session.Session) wlDeleter
 	newImageRemover func(sess *session.Session) imageRemover
 	newTaskStopper  func(sess *session.Session) taskStopper
+	locker          stackLocker
 }
 
 func newDeleteJobOpts(vars deleteJobVars) (*deleteJobOpts, error) {
@@ -98,6 +102,7 @@ func newDeleteJobOpts(vars deleteJobVars) (*deleteJobOpts, error) {
 		newTaskStopper: func(session *session.Session) taskStopper {
 			return ecs.New(session)
 		},
+		locker: newStackLocker(defaultSession, vars.waitForLock, vars.lockTimeout),
 	}, nil
 }
 
@@ -265,6 +270,11 @@ func (o *deleteJobOpts) deleteJobs(envs []*config.Environment) error {
 }
 
 func (o *deleteJobOpts) deleteStack(sess *session.Session, env *config.Environment) error {
+	release, err := lockStack(o.locker, o.appName, stack.NameForWorkload(o.appName, env.Name, o.name), "job delete")
+	if err != nil {
+		return err
+	}
+	defer release()
 	cfClient := o.newWlDeleter(sess)
 	if err := cfClient.DeleteWorkload(deploy.DeleteWorkloadInput{
 		Name:             o.name,
@@ -304,7 +314,11 @@ func (o *deleteJobOpts) emptyECRRepos(envs []*config.Environment) error {
 		}
 	}
 
-	repoName := clideploy.RepoName(o.appName, o.name)
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	repoName := app.RepositoryName(o.name)
 	for _, region := range uniqueRegions {
 		sess, err := o.sess.DefaultWithRegion(region)
 		if err != nil {
@@ -380,5 +394,7 @@ func buildJobDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.waitForLock, waitForLockFlag, false, waitForLockFlagDescription)
+	cmd.Flags().DurationVar(&vars.lockTimeout, lockTimeoutFlag, defaultLockTimeout, lockTimeoutFlagDescription)
 	return cmd
 }
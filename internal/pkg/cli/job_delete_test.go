@@ -299,6 +299,7 @@ type deleteJobMocks struct {
 	jobCFN         *mocks.MockwlDeleter
 	ecr            *mocks.MockimageRemover
 	ecs            *mocks.MocktaskStopper
+	locker         *mocks.MockstackLocker
 }
 
 func TestDeleteJobOpts_Execute(t *testing.T) {
@@ -344,6 +345,7 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 					mocks.ecs.EXPECT().StopWorkloadTasks(mockAppName, mockEnvName, mockJobName).Return(nil),
 					mocks.spinner.EXPECT().Stop(log.Ssuccessf(fmtJobTasksStopComplete, mockJobName, mockEnvName)),
 
+					mocks.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil),
 					mocks.sessProvider.EXPECT().DefaultWithRegion(gomock.Any()).Return(&session.Session{}, nil),
 					// emptyECRRepos
 					mocks.ecr.EXPECT().ClearRepository(mockRepo).Return(nil),
@@ -465,9 +467,11 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 				jobCFN:         mockJobCFN,
 				ecr:            mockImageRemover,
 				ecs:            mockTaskStopper,
+				locker:         mocks.NewMockstackLocker(ctrl),
 			}
 
 			test.setupMocks(mocks)
+			mocks.locker.EXPECT().Lock(gomock.Any(), gomock.Any(), gomock.Any()).Return(func() error { return nil }, nil).AnyTimes()
 
 			opts := deleteJobOpts{
 				deleteJobVars: deleteJobVars{
@@ -482,6 +486,7 @@ func TestDeleteJobOpts_Execute(t *testing.T) {
 				newWlDeleter:    mockGetJobCFN,
 				newImageRemover: mockGetImageRemover,
 				newTaskStopper:  mockNewTaskStopper,
+				locker:          mocks.locker,
 			}
 
 			// WHEN
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -19,6 +20,7 @@ import (
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/hooks"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 
 	"github.com/spf13/cobra"
@@ -28,6 +30,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/buildcache"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -51,6 +54,9 @@ type deployJobOpts struct {
 	prompt               prompter
 	gitShortCommit       string
 	diffWriter           io.Writer
+	locker               stackLocker
+	buildCache           *buildcache.Cache // Nil with --no-build-cache.
+	hooks                *hooks.Runner     // Nil in tests.
 
 	// cached variables
 	targetApp         *config.Application
@@ -58,6 +64,9 @@ type deployJobOpts struct {
 	envSess           *session.Session
 	appliedDynamicMft manifest.DynamicWorkload
 	rootUserARN       string
+	deployer          workloadDeployer
+	uploadOut         *deploy.UploadArtifactsOutput // Set if the artifacts are packaged ahead of the deployment.
+	sourceImages      map[string]string             // Images built for another environment by deploy --envs, copied instead of built.
 
 	// Overridden in tests.
 	templateVersion string
@@ -88,6 +97,9 @@ func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
 		cmd:             exec.NewCmd(),
 		templateVersion: version.LatestTemplateVersion(),
 		diffWriter:      os.Stdout,
+		locker:          newStackLocker(defaultSess, vars.waitForLock, vars.lockTimeout),
+		buildCache:      workspaceBuildCache(ws, vars.noBuildCache),
+		hooks:           hooks.New(ws),
 	}
 	opts.newJobDeployer = func() (workloadDeployer, error) {
 		// NOTE: Defined as a struct member to facilitate unit testing.
@@ -101,6 +113,10 @@ func newJobDeployer(o *deployJobOpts) (workloadDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("read manifest file for %s: %w", o.name, err)
 	}
+	rendered, err := renderedManifest(raw, o.newInterpolator(o.appName, o.envName))
+	if err != nil {
+		return nil, fmt.Errorf("interpolate environment variables for %s manifest: %w", o.name, err)
+	}
 	ovrdr, err := deploy.NewOverrider(o.ws.WorkloadOverridesPath(o.name), o.appName, o.envName, afero.NewOsFs(), o.sessProvider)
 	if err != nil {
 		return nil, err
@@ -118,13 +134,21 @@ func newJobDeployer(o *deployJobOpts) (workloadDeployer, error) {
 		},
 		Mft:              content,
 		RawMft:           raw,
+		RenderedMft:      rendered,
 		EnvVersionGetter: o.envFeaturesDescriber,
 		Overrider:        ovrdr,
+		Builder:          o.builder,
+		BuildRemote:      o.buildRemote,
+		PinDigests:       o.pinDigests,
+		BuildCache:       o.buildCache,
+		SourceImages:     o.sourceImages,
 	}
 	var deployer workloadDeployer
 	switch t := content.(type) {
 	case *manifest.ScheduledJob:
 		deployer, err = deploy.NewJobDeployer(&in)
+	case *manifest.WorkflowJob:
+		deployer, err = deploy.NewWorkflowJobDeployer(&in)
 	default:
 		return nil, fmt.Errorf("unknown manifest type %T while creating the CloudFormation stack", t)
 	}
@@ -165,56 +189,39 @@ func (o *deployJobOpts) Ask() error {
 
 // Execute builds and pushes the container image for the job.
 func (o *deployJobOpts) Execute() error {
-	if !o.clientConfigured {
-		if err := o.configureClients(); err != nil {
-			return err
-		}
-	}
-	if !o.allowWkldDowngrade {
-		if err := validateWkldVersion(o.jobVersionGetter, o.name, o.templateVersion); err != nil {
-			return err
-		}
-	}
-	mft, err := workloadManifest(&workloadManifestInput{
-		name:         o.name,
-		appName:      o.appName,
-		envName:      o.envName,
-		interpolator: o.newInterpolator(o.appName, o.envName),
-		ws:           o.ws,
-		unmarshal:    o.unmarshal,
-		sess:         o.envSess,
-	})
-	if err != nil {
+	hookIn := hooks.Input{App: o.appName, Environment: o.envName, Workload: o.name}
+	if err := o.hooks.Run(hooks.PreDeploy, hookIn); err != nil {
 		return err
 	}
-	o.appliedDynamicMft = mft
-	if err := validateWorkloadManifestCompatibilityWithEnv(o.ws, o.envFeaturesDescriber, mft, o.envName); err != nil {
+	deployer, err := o.prepareDeployer()
+	if err != nil {
 		return err
 	}
-	deployer, err := o.newJobDeployer()
+	freezeOverride, err := checkDeployPolicy(o.targetApp, o.envName, time.Now(), o.overrideFreeze)
 	if err != nil {
 		return err
 	}
-	serviceInRegion, err := deployer.IsServiceAvailableInRegion(o.targetEnv.Region)
+	release, err := lockStack(o.locker, o.appName, stack.NameForWorkload(o.appName, o.envName, o.name), "job deploy")
 	if err != nil {
-		return fmt.Errorf("check if Scheduled Job(s) is available in region %s: %w", o.targetEnv.Region, err)
-	}
-
-	if !serviceInRegion {
-		log.Warningf(`Scheduled Job might not be available in region %s; proceed with caution.
-`, o.targetEnv.Region)
+		return err
 	}
-	uploadOut, err := deployer.UploadArtifacts()
-	if err != nil {
-		return fmt.Errorf("upload deploy resources for job %s: %w", o.name, err)
+	defer release()
+	uploadOut := o.uploadOut
+	if uploadOut == nil {
+		if uploadOut, err = deployer.UploadArtifacts(); err != nil {
+			return fmt.Errorf("upload deploy resources for job %s: %w", o.name, err)
+		}
 	}
-	if o.showDiff {
+	var template string
+	checker := newTemplateChecker(o.targetApp, o.envName, o.cmd, log.DiagnosticWriter)
+	if o.showDiff || checker.configured() {
 		output, err := deployer.GenerateCloudFormationTemplate(&deploy.GenerateCloudFormationTemplateInput{
 			StackRuntimeConfiguration: deploy.StackRuntimeConfiguration{
 				RootUserARN:        o.rootUserARN,
 				Tags:               o.targetApp.Tags,
 				EnvFileARNs:        uploadOut.EnvFileARNs,
 				ImageDigests:       uploadOut.ImageDigests,
+				PinnedImages:       uploadOut.PinnedImages,
 				AddonsURL:          uploadOut.AddonsURL,
 				Version:            o.templateVersion,
 				CustomResourceURLs: uploadOut.CustomResourceURLs,
@@ -223,18 +230,30 @@ func (o *deployJobOpts) Execute() error {
 		if err != nil {
 			return fmt.Errorf("generate the template for job %q against environment %q: %w", o.name, o.envName, err)
 		}
-		if err := diff(deployer, output.Template, o.diffWriter); err != nil {
+		template = output.Template
+		if checker.configured() {
+			if err := checkStackTemplate(checker, deployer, stack.NameForWorkload(o.appName, o.envName, o.name), template); err != nil {
+				return err
+			}
+		}
+	}
+	if o.showDiff {
+		var hasDiff bool
+		if err := diff(deployer, template, o.diffWriter); err != nil {
 			var errHasDiff *errHasDiff
 			if !errors.As(err, &errHasDiff) {
 				return err
 			}
+			hasDiff = true
 		}
-		contd, err := o.prompt.Confirm(continueDeploymentPrompt, "")
-		if err != nil {
-			return fmt.Errorf("ask whether to continue with the deployment: %w", err)
-		}
-		if !contd {
-			return nil
+		if hasDiff {
+			contd, err := o.prompt.Confirm(continueDeploymentPrompt, "")
+			if err != nil {
+				return fmt.Errorf("ask whether to continue with the deployment: %w", err)
+			}
+			if !contd {
+				return nil
+			}
 		}
 	}
 	var errStackDeletedOnInterrupt *deploycfn.ErrStackDeletedOnInterrupt
@@ -242,6 +261,7 @@ func (o *deployJobOpts) Execute() error {
 	if _, err = deployer.DeployWorkload(&deploy.DeployWorkloadInput{
 		StackRuntimeConfiguration: deploy.StackRuntimeConfiguration{
 			ImageDigests:       uploadOut.ImageDigests,
+			PinnedImages:       uploadOut.PinnedImages,
 			EnvFileARNs:        uploadOut.EnvFileARNs,
 			AddonsURL:          uploadOut.AddonsURL,
 			RootUserARN:        o.rootUserARN,
@@ -251,6 +271,7 @@ func (o *deployJobOpts) Execute() error {
 		},
 		Options: deploy.Options{
 			DisableRollback: o.disableRollback,
+			FreezeOverride:  freezeOverride,
 		},
 	}); err != nil {
 		if errors.As(err, &errStackDeletedOnInterrupt) {
@@ -272,9 +293,82 @@ After fixing the deployment, you can:
 		return fmt.Errorf("deploy job %s to environment %s: %w", o.name, o.envName, err)
 	}
 	log.Successf("Deployed %s.\n", color.HighlightUserInput(o.name))
+	return o.hooks.Run(hooks.PostDeploy, hookIn)
+}
+
+// prepareDeployer reads the manifest of the job and returns the deployer for it.
+func (o *deployJobOpts) prepareDeployer() (workloadDeployer, error) {
+	if o.deployer != nil {
+		return o.deployer, nil
+	}
+	if !o.clientConfigured {
+		if err := o.configureClients(); err != nil {
+			return nil, err
+		}
+	}
+	if !o.allowWkldDowngrade {
+		if err := validateWkldVersion(o.jobVersionGetter, o.name, o.templateVersion); err != nil {
+			return nil, err
+		}
+	}
+	mft, err := workloadManifest(&workloadManifestInput{
+		name:         o.name,
+		appName:      o.appName,
+		envName:      o.envName,
+		interpolator: o.newInterpolator(o.appName, o.envName),
+		ws:           o.ws,
+		unmarshal:    o.unmarshal,
+		sess:         o.envSess,
+		fs:           afero.NewOsFs(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	o.appliedDynamicMft = mft
+	if err := validateWorkloadManifestCompatibilityWithEnv(o.ws, o.envFeaturesDescriber, mft, o.envName); err != nil {
+		return nil, err
+	}
+	deployer, err := o.newJobDeployer()
+	if err != nil {
+		return nil, err
+	}
+	serviceInRegion, err := deployer.IsServiceAvailableInRegion(o.targetEnv.Region)
+	if err != nil {
+		return nil, fmt.Errorf("check if Scheduled Job(s) is available in region %s: %w", o.targetEnv.Region, err)
+	}
+
+	if !serviceInRegion {
+		log.Warningf(`Scheduled Job might not be available in region %s; proceed with caution.
+`, o.targetEnv.Region)
+	}
+	o.deployer = deployer
+	return deployer, nil
+}
+
+// packageArtifacts builds the images of the job and uploads its artifacts ahead of the deployment.
+func (o *deployJobOpts) packageArtifacts() error {
+	deployer, err := o.prepareDeployer()
+	if err != nil {
+		return err
+	}
+	out, err := deployer.UploadArtifacts()
+	if err != nil {
+		return fmt.Errorf("upload deploy resources for job %s: %w", o.name, err)
+	}
+	o.uploadOut = out
 	return nil
 }
 
+// packagedImages returns the images pushed by packageArtifacts, pinned to their digest.
+func (o *deployJobOpts) packagedImages() map[string]string {
+	return pinnedImages(o.uploadOut)
+}
+
+// replicateImages copies the images built for another environment instead of building them again.
+func (o *deployJobOpts) replicateImages(images map[string]string) {
+	o.sourceImages = images
+}
+
 func (o *deployJobOpts) configureClients() error {
 	o.gitShortCommit = imageTagFromGit(o.cmd) // Best effort assign git tag.
 	env, err := o.store.GetEnvironment(o.appName, o.envName)
@@ -404,9 +498,17 @@ func buildJobDeployCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
+	cmd.Flags().StringVar(&vars.builder, builderFlag, "", builderFlagDescription)
+	cmd.Flags().BoolVar(&vars.buildRemote, buildRemoteFlag, false, buildRemoteFlagDescription)
+	cmd.Flags().BoolVar(&vars.pinDigests, pinDigestsFlag, false, pinDigestsFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
+	cmd.Flags().BoolVar(&vars.waitForLock, waitForLockFlag, false, waitForLockFlagDescription)
+	cmd.Flags().DurationVar(&vars.lockTimeout, lockTimeoutFlag, defaultLockTimeout, lockTimeoutFlagDescription)
+	cmd.Flags().BoolVar(&vars.noBuildCache, noBuildCacheFlag, false, noBuildCacheFlagDescription)
+	cmd.Flags().StringVar(&vars.overrideFreeze, overrideFreezeFlag, "", overrideFreezeFlagDescription)
+	cmd.MarkFlagsMutuallyExclusive(builderFlag, buildRemoteFlag)
 	return cmd
 }
@@ -312,7 +312,7 @@ func TestJobDeployOpts_Execute(t *testing.T) {
 			},
 			wantedError: errors.New("some error"),
 		},
-		"write 'no changes' if there is no diff": {
+		"write 'no changes' and deploy without confirmation if there is no diff": {
 			inShowDiff: true,
 			mock: func(m *deployMocks) {
 				m.mockVersionGetter.EXPECT().Version().Return(mockTemplateVersion, nil)
@@ -330,7 +330,8 @@ func TestJobDeployOpts_Execute(t *testing.T) {
 				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{}, nil)
 				m.mockDeployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
 				m.mockDiffWriter = &strings.Builder{}
-				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Return(false, nil)
+				m.mockPrompter.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(1)
 			},
 			wantedDiff: "No changes.\n",
 		},
@@ -456,8 +457,10 @@ func TestJobDeployOpts_Execute(t *testing.T) {
 				mockEnvFeaturesDescriber: mocks.NewMockversionCompatibilityChecker(ctrl),
 				mockPrompter:             mocks.NewMockprompter(ctrl),
 				mockVersionGetter:        mocks.NewMockversionGetter(ctrl),
+				mockLocker:               mocks.NewMockstackLocker(ctrl),
 			}
 			tc.mock(m)
+			m.mockLocker.EXPECT().Lock(gomock.Any(), gomock.Any(), gomock.Any()).Return(func() error { return nil }, nil).AnyTimes()
 
 			opts := deployJobOpts{
 				deployWkldVars: deployWkldVars{
@@ -484,6 +487,7 @@ func TestJobDeployOpts_Execute(t *testing.T) {
 				prompt:               m.mockPrompter,
 				diffWriter:           m.mockDiffWriter,
 				templateVersion:      mockTemplateVersion,
+				locker:               m.mockLocker,
 
 				targetApp: &config.Application{},
 				targetEnv: &config.Environment{},
@@ -0,0 +1,179 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/output"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	jobHistoryNamePrompt     = "Which job's executions would you like to show?"
+	jobHistoryNameHelpPrompt = "Displays the status, duration, retries, exit code and task of the recent executions of the job."
+
+	defaultJobHistoryLast = 10
+	maxJobHistoryLast     = 1000
+)
+
+type jobHistoryVars struct {
+	shouldOutputJSON bool
+	name             string
+	envName          string
+	appName          string
+	last             int
+}
+
+type jobHistoryOpts struct {
+	jobHistoryVars
+
+	w                    io.Writer
+	store                store
+	historyDescriber     statusDescriber
+	sel                  deploySelector
+	initHistoryDescriber func(*jobHistoryOpts) error
+}
+
+func newJobHistoryOpts(vars jobHistoryVars) (*jobHistoryOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("job history"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &jobHistoryOpts{
+		jobHistoryVars: vars,
+		store:          configStore,
+		w:              log.OutputWriter,
+		sel:            selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		initHistoryDescriber: func(o *jobHistoryOpts) error {
+			app, err := configStore.GetApplication(o.appName)
+			if err != nil {
+				return fmt.Errorf("get application %s: %w", o.appName, err)
+			}
+			d, err := describe.NewJobHistoryDescriber(&describe.NewJobHistoryConfig{
+				App:         o.appName,
+				Env:         o.envName,
+				Job:         o.name,
+				Limit:       o.last,
+				LogGroup:    app.LogGroupName(o.envName, o.name),
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("create history describer for job %s in application %s: %w", o.name, o.appName, err)
+			}
+			o.historyDescriber = d
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the flags are invalid.
+func (o *jobHistoryOpts) Validate() error {
+	if o.last < 1 || o.last > maxJobHistoryLast {
+		return fmt.Errorf("flag --%s must be between 1 and %d", lastFlag, maxJobHistoryLast)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *jobHistoryOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskJobEnvName()
+}
+
+// Execute displays the recent executions of the job.
+func (o *jobHistoryOpts) Execute() error {
+	if err := o.initHistoryDescriber(o); err != nil {
+		return err
+	}
+	history, err := o.historyDescriber.Describe()
+	if err != nil {
+		return fmt.Errorf("describe executions of job %s: %w", o.name, err)
+	}
+	return output.New(o.w, o.shouldOutputJSON).Write(history)
+}
+
+func (o *jobHistoryOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(jobAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *jobHistoryOpts) validateAndAskJobEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetJob(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	deployedJob, err := o.sel.DeployedJob(jobHistoryNamePrompt, jobHistoryNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithName(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed jobs for application %s: %w", o.appName, err)
+	}
+	o.name = deployedJob.Name
+	o.envName = deployedJob.Env
+	return nil
+}
+
+// buildJobHistoryCmd builds the command for showing the recent executions of a deployed job.
+func buildJobHistoryCmd() *cobra.Command {
+	vars := jobHistoryVars{}
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Shows the recent executions of a deployed job.",
+		Long: `Shows the recent executions of a deployed job.
+For each execution, the status, start time, duration, number of retries, exit code
+and ID of the last task are shown.`,
+
+		Example: `
+  Shows the last 10 executions of the job "report" in the "test" environment.
+  /code $ copilot job history -n report -e test
+  Shows the last 50 executions in JSON format.
+  /code $ copilot job history -n report -e test --last 50 --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newJobHistoryOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().IntVar(&vars.last, lastFlag, defaultJobHistoryLast, jobHistoryLastFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
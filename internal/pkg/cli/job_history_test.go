@@ -0,0 +1,214 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type jobHistoryAskMock struct {
+	store *mocks.Mockstore
+	sel   *mocks.MockdeploySelector
+}
+
+func TestJobHistory_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputLast int
+
+		wantedError error
+	}{
+		"valid number of executions": {
+			inputLast: 10,
+		},
+		"error if the number of executions is not positive": {
+			inputLast:   0,
+			wantedError: errors.New("flag --last must be between 1 and 1000"),
+		},
+		"error if the number of executions is too large": {
+			inputLast:   1001,
+			wantedError: errors.New("flag --last must be between 1 and 1000"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &jobHistoryOpts{
+				jobHistoryVars: jobHistoryVars{
+					last: tc.inputLast,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJobHistory_Ask(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inputApp   string
+		inputJob   string
+		inputEnv   string
+		setupMocks func(m jobHistoryAskMock)
+
+		wantedApp   string
+		wantedJob   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"select a deployed job": {
+			setupMocks: func(m jobHistoryAskMock) {
+				m.sel.EXPECT().Application(jobAppNamePrompt, wkldAppNameHelpPrompt).Return("phonetool", nil)
+				m.sel.EXPECT().DeployedJob(jobHistoryNamePrompt, jobHistoryNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedJob{
+						Env:  "test",
+						Name: "report",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedJob: "report",
+			wantedEnv: "test",
+		},
+		"validate the flags before selecting": {
+			inputApp: "phonetool",
+			inputJob: "report",
+			inputEnv: "test",
+			setupMocks: func(m jobHistoryAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.store.EXPECT().GetJob("phonetool", "report").Return(&config.Workload{Name: "report"}, nil)
+				m.sel.EXPECT().DeployedJob(jobHistoryNamePrompt, jobHistoryNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedJob{
+						Env:  "test",
+						Name: "report",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedJob: "report",
+			wantedEnv: "test",
+		},
+		"error if the job does not exist": {
+			inputApp: "phonetool",
+			inputJob: "report",
+			setupMocks: func(m jobHistoryAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.store.EXPECT().GetJob("phonetool", "report").Return(nil, mockError)
+			},
+			wantedError: mockError,
+		},
+		"error if fail to select a deployed job": {
+			inputApp: "phonetool",
+			setupMocks: func(m jobHistoryAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.sel.EXPECT().DeployedJob(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("select deployed jobs for application phonetool: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := jobHistoryAskMock{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockdeploySelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &jobHistoryOpts{
+				jobHistoryVars: jobHistoryVars{
+					appName: tc.inputApp,
+					name:    tc.inputJob,
+					envName: tc.inputEnv,
+				},
+				store: m.store,
+				sel:   m.sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.appName)
+				require.Equal(t, tc.wantedJob, opts.name)
+				require.Equal(t, tc.wantedEnv, opts.envName)
+			}
+		})
+	}
+}
+
+func TestJobHistory_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		shouldOutputJSON     bool
+		mockHistoryDescriber func(m *mocks.MockstatusDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"write the executions": {
+			mockHistoryDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(&mockDescribeData{data: "Executions"}, nil)
+			},
+			wantedContent: "Executions",
+		},
+		"write the executions in JSON": {
+			shouldOutputJSON: true,
+			mockHistoryDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(&mockDescribeData{data: `{"executions":[]}`}, nil)
+			},
+			wantedContent: `{"executions":[]}`,
+		},
+		"error if fail to describe the executions": {
+			mockHistoryDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe executions of job report: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			b := &bytes.Buffer{}
+			mockHistoryDescriber := mocks.NewMockstatusDescriber(ctrl)
+			tc.mockHistoryDescriber(mockHistoryDescriber)
+			opts := &jobHistoryOpts{
+				jobHistoryVars: jobHistoryVars{
+					appName:          "phonetool",
+					name:             "report",
+					envName:          "test",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				historyDescriber:     mockHistoryDescriber,
+				initHistoryDescriber: func(*jobHistoryOpts) error { return nil },
+				w:                    b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Contains(t, b.String(), tc.wantedContent)
+			}
+		})
+	}
+}
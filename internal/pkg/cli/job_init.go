@@ -43,12 +43,17 @@ executions and is good for jobs which need to run frequently. "Fixed Schedule"
 lets you use a predefined or custom cron schedule and is good for less-frequent 
 jobs or those which require specific execution schedules.`
 
-	jobInitTypeHelp = fmt.Sprintf(`A %s is a task which is invoked on a set schedule, with optional retry logic.
-To learn more see: https://git.io/JEEU4`, manifestinfo.ScheduledJobType)
+	fmtJobInitJobTypePrompt = "Which %s best represents your job's architecture?"
+	jobInitTypeHelp         = fmt.Sprintf(`A %s is a task which is invoked on a set schedule, with optional retry logic.
+To learn more see: https://git.io/JEEU4
+
+A %s is a sequence of tasks, each with its own container image, which is invoked
+on a set schedule with per-step retries and fallback steps.`, manifestinfo.ScheduledJobType, manifestinfo.WorkflowJobType)
 )
 
 var jobTypeHints = map[string]string{
 	manifestinfo.ScheduledJobType: "Scheduled event to State Machine to Fargate",
+	manifestinfo.WorkflowJobType:  "Scheduled event to State Machine to a sequence of Fargate tasks",
 }
 
 type initJobVars struct {
@@ -159,6 +164,9 @@ func (o *initJobOpts) Validate() error {
 	if o.dockerfilePath != "" && o.image != "" {
 		return fmt.Errorf("--%s and --%s cannot be specified together", dockerFileFlag, imageFlag)
 	}
+	if err := o.imageRetentionVars.validate(); err != nil {
+		return err
+	}
 	if o.dockerfilePath != "" {
 		if _, err := o.fs.Stat(o.dockerfilePath); err != nil {
 			return err
@@ -186,6 +194,10 @@ func (o *initJobOpts) Ask() error {
 			return err
 		}
 	}
+	if o.wkldType == manifestinfo.WorkflowJobType && (o.timeout != "" || o.retries != 0) {
+		return fmt.Errorf(`--%s and --%s are not supported for a %s, configure "timeout" and "retries" per step in its manifest instead`,
+			timeoutFlag, retriesFlag, manifestinfo.WorkflowJobType)
+	}
 	if o.name == "" {
 		if err := o.askJobName(); err != nil {
 			return err
@@ -310,6 +322,7 @@ func (o *initJobOpts) Execute() error {
 				PlatformString: o.platform,
 			},
 			PrivateOnlyEnvironments: envs,
+			ImageRetention:          o.applyTo(nil),
 		},
 
 		Schedule:    o.schedule,
@@ -360,8 +373,12 @@ func (o *initJobOpts) askJobType() error {
 	if o.wkldType != "" {
 		return nil
 	}
-	// short circuit since there's only one valid job type.
-	o.wkldType = manifestinfo.ScheduledJobType
+	msg := fmt.Sprintf(fmtJobInitJobTypePrompt, color.Emphasize("job type"))
+	t, err := o.prompt.SelectOption(msg, jobInitTypeHelp, jobTypePromptOpts(), prompt.WithFinalMessage("Job type:"))
+	if err != nil {
+		return fmt.Errorf("select job type: %w", err)
+	}
+	o.wkldType = t
 	return nil
 }
 
@@ -489,6 +506,9 @@ func buildJobInitCmd() *cobra.Command {
 	cmd.Flags().IntVar(&vars.retries, retriesFlag, 0, retriesFlagDescription)
 	cmd.Flags().StringVarP(&vars.image, imageFlag, imageFlagShort, "", imageFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowAppDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
+	cmd.Flags().IntVar(&vars.keepImages, keepImagesFlag, 0, wkldKeepImagesFlagDescription)
+	cmd.Flags().IntVar(&vars.untaggedExpiryDays, untaggedImageExpiryFlag, 0, wkldUntaggedImageExpiryFlagDescription)
+	cmd.Flags().BoolVar(&vars.immutableTags, immutableTagsFlag, false, wkldImmutableTagsFlagDescription)
 
 	cmd.Annotations = map[string]string{
 		"group": group.Develop,
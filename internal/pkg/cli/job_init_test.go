@@ -15,6 +15,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
@@ -194,7 +195,40 @@ func TestJobInitOpts_Ask(t *testing.T) {
 	}{
 		"invalid job type": {
 			inJobType: "TestJobType",
-			wantedErr: errors.New(`invalid job type TestJobType: must be one of "Scheduled Job"`),
+			wantedErr: errors.New(`invalid job type TestJobType: must be one of "Scheduled Job", "Workflow Job"`),
+		},
+		"error if fail to select job type": {
+			inJobName:        wantedJobName,
+			inDockerfilePath: wantedDockerfilePath,
+			inJobSchedule:    wantedCronSchedule,
+
+			setupMocks: func(m initJobMocks) {
+				m.mockPrompt.EXPECT().SelectOption(gomock.Eq("Which job type best represents your job's architecture?"), gomock.Any(), []prompt.Option{
+					{
+						Value: manifestinfo.ScheduledJobType,
+						Hint:  "Scheduled event to State Machine to Fargate",
+					},
+					{
+						Value: manifestinfo.WorkflowJobType,
+						Hint:  "Scheduled event to State Machine to a sequence of Fargate tasks",
+					},
+				}, gomock.Any()).Return("", mockError)
+			},
+
+			wantedErr: fmt.Errorf("select job type: mock error"),
+		},
+		"prompt for job type": {
+			inJobName:        wantedJobName,
+			inDockerfilePath: wantedDockerfilePath,
+			inJobSchedule:    wantedCronSchedule,
+
+			setupMocks: func(m initJobMocks) {
+				m.mockPrompt.EXPECT().SelectOption(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(wantedJobType, nil)
+				m.mockStore.EXPECT().GetJob(mockAppName, wantedJobName).Return(nil, &config.ErrNoSuchJob{})
+				m.mockMftReader.EXPECT().ReadWorkloadManifest(wantedJobName).Return(nil, &workspace.ErrFileNotExists{FileName: wantedJobName})
+			},
+
+			wantedSchedule: wantedCronSchedule,
 		},
 		"invalid job name": {
 			inJobType: wantedJobType,
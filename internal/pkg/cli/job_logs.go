@@ -14,6 +14,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/logging"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -41,6 +42,8 @@ type jobLogsOpts struct {
 	jobLogsVars
 	wkldLogOpts
 
+	executionTasks jobTasksLister // Lists the tasks of the last executions of the job.
+
 	// Cached variables.
 	targetEnv *config.Environment
 }
@@ -75,12 +78,28 @@ func newJobLogOpts(vars jobLogsVars) (*jobLogsOpts, error) {
 		if err != nil {
 			return err
 		}
+		app, err := opts.configStore.GetApplication(opts.appName)
+		if err != nil {
+			return fmt.Errorf("get application %s: %w", opts.appName, err)
+		}
 		opts.logsSvc = logging.NewJobLogger(&logging.NewWorkloadLoggerOpts{
-			Sess: sess,
-			App:  opts.appName,
-			Env:  opts.envName,
-			Name: opts.name,
+			Sess:     sess,
+			App:      opts.appName,
+			Env:      opts.envName,
+			Name:     opts.name,
+			LogGroup: app.LogGroupName(opts.envName, opts.name),
 		})
+		tasks, err := describe.NewJobHistoryDescriber(&describe.NewJobHistoryConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			Job:         opts.name,
+			Limit:       opts.last,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("create history describer for job %s: %w", opts.name, err)
+		}
+		opts.executionTasks = tasks
 		return nil
 	}
 	return opts, nil
@@ -170,13 +189,25 @@ func (o *jobLogsOpts) Execute() error {
 	if o.last != 0 {
 		logStreamLimit = o.last
 	}
+	taskIDs := o.taskIDs
+	if o.shouldFindExecutionTasks() {
+		ids, err := o.executionTasks.TaskIDs()
+		switch {
+		case err != nil:
+			// Environments deployed before the manager role could list executions can still show the latest log streams.
+			log.Warningf("Couldn't find the tasks of the last %d executions of job %s, showing the %d most recent log streams instead: %v\n",
+				o.last, o.name, logStreamLimit, err)
+		case len(ids) > 0:
+			taskIDs, logStreamLimit = ids, len(ids)
+		}
+	}
 
 	err := o.logsSvc.WriteLogEvents(logging.WriteLogEventsOpts{
 		Follow:                  o.follow,
 		Limit:                   limit,
 		EndTime:                 o.endTime,
 		StartTime:               o.startTime,
-		TaskIDs:                 o.taskIDs,
+		TaskIDs:                 taskIDs,
 		OnEvents:                eventsWriter,
 		LogStreamLimit:          logStreamLimit,
 		IncludeStateMachineLogs: o.includeStateMachineLogs,
@@ -187,6 +218,13 @@ func (o *jobLogsOpts) Execute() error {
 	return nil
 }
 
+// shouldFindExecutionTasks returns true if the logs should be filtered to the tasks of the last executions,
+// including the tasks of retried attempts, rather than to the most recent log streams.
+// The state machine's own log streams can't be associated with executions, and following logs needs the new tasks too.
+func (o *jobLogsOpts) shouldFindExecutionTasks() bool {
+	return o.last != 0 && len(o.taskIDs) == 0 && !o.follow && !o.includeStateMachineLogs
+}
+
 func (o *jobLogsOpts) getTargetEnv() (*config.Environment, error) {
 	if o.targetEnv != nil {
 		return o.targetEnv, nil
@@ -326,6 +326,7 @@ func TestJobLogs_Execute(t *testing.T) {
 		taskIDs   []string
 
 		mocklogsSvc func(ctrl *gomock.Controller) logEventsWriter
+		mockTasks   func(ctrl *gomock.Controller) jobTasksLister
 
 		last                int
 		includeStateMachine bool
@@ -393,6 +394,40 @@ func TestJobLogs_Execute(t *testing.T) {
 
 			wantedError: nil,
 		},
+		"success with the tasks of the last executions": {
+			inputJob: "mockJob",
+			last:     2,
+			mockTasks: func(ctrl *gomock.Controller) jobTasksLister {
+				m := mocks.NewMockjobTasksLister(ctrl)
+				m.EXPECT().TaskIDs().Return([]string{"task3", "task1", "task2"}, nil)
+				return m
+			},
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+					require.Equal(t, []string{"task3", "task1", "task2"}, param.TaskIDs)
+					require.Equal(t, 3, param.LogStreamLimit)
+				}).Return(nil)
+				return m
+			},
+		},
+		"success with the most recent log streams if the tasks of the executions can't be found": {
+			inputJob: "mockJob",
+			last:     2,
+			mockTasks: func(ctrl *gomock.Controller) jobTasksLister {
+				m := mocks.NewMockjobTasksLister(ctrl)
+				m.EXPECT().TaskIDs().Return(nil, errors.New("access denied"))
+				return m
+			},
+			mocklogsSvc: func(ctrl *gomock.Controller) logEventsWriter {
+				m := mocks.NewMocklogEventsWriter(ctrl)
+				m.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+					require.Empty(t, param.TaskIDs)
+					require.Equal(t, 2, param.LogStreamLimit)
+				}).Return(nil)
+				return m
+			},
+		},
 		"returns error if fail to get event logs": {
 			inputJob: "mockJob",
 
@@ -412,6 +447,10 @@ func TestJobLogs_Execute(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
+			var tasks jobTasksLister
+			if tc.mockTasks != nil {
+				tasks = tc.mockTasks(ctrl)
+			}
 
 			svcLogs := &jobLogsOpts{
 				jobLogsVars: jobLogsVars{
@@ -430,6 +469,7 @@ func TestJobLogs_Execute(t *testing.T) {
 					initRuntimeClients: func() error { return nil },
 					logsSvc:            tc.mocklogsSvc(ctrl),
 				},
+				executionTasks: tasks,
 			}
 
 			// WHEN
@@ -13,8 +13,10 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/version"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/hooks"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -33,10 +35,17 @@ type packageJobVars struct {
 	envName            string
 	appName            string
 	tag                string
+	builder            string
+	buildRemote        bool
 	outputDir          string
+	format             string
 	uploadAssets       bool
 	showDiff           bool
+	diffFile           string
 	allowWkldDowngrade bool
+	noBuildCache       bool
+	checkTemplate      bool
+	offlineVars
 }
 
 type packageJobOpts struct {
@@ -55,17 +64,28 @@ type packageJobOpts struct {
 }
 
 func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
-	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("job package"))
-	defaultSess, err := sessProvider.Default()
+	fs := afero.NewOsFs()
+	ws, err := workspace.Use(fs)
 	if err != nil {
 		return nil, err
 	}
-	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
-	fs := afero.NewOsFs()
-	ws, err := workspace.Use(fs)
+	sessOpts := []func(*sessions.Provider){sessions.UserAgentExtras("job package")}
+	var offlineValues *clideploy.OfflineValues
+	if vars.offline {
+		if offlineValues, err = vars.values(fs); err != nil {
+			return nil, err
+		}
+		sessOpts = append(sessOpts, sessions.Offline(offlineValues.Region))
+	}
+	sessProvider := sessions.ImmutableProvider(sessOpts...)
+	defaultSess, err := sessProvider.Default()
 	if err != nil {
 		return nil, err
 	}
+	var store store = config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	if offlineValues != nil {
+		store = newOfflineStore(store, offlineValues, ws)
+	}
 	prompter := prompt.New()
 	opts := &packageJobOpts{
 		packageJobVars: vars,
@@ -83,9 +103,16 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 				envName:            o.envName,
 				appName:            o.appName,
 				tag:                o.tag,
+				builder:            o.builder,
+				buildRemote:        o.buildRemote,
 				outputDir:          o.outputDir,
+				format:             o.format,
 				uploadAssets:       o.uploadAssets,
+				diffFile:           o.diffFile,
 				allowWkldDowngrade: o.allowWkldDowngrade,
+				noBuildCache:       o.noBuildCache,
+				checkTemplate:      o.checkTemplate,
+				offlineVars:        o.offlineVars,
 			},
 			runner:            o.runner,
 			ws:                ws,
@@ -98,8 +125,12 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 			fs:                fs,
 			sessProvider:      sessProvider,
 			newStackGenerator: newWorkloadStackGenerator,
+			newOverrider:      newWorkloadOverrider,
 			gitShortCommit:    imageTagFromGit(o.runner),
 			templateVersion:   version.LatestTemplateVersion(),
+			buildCache:        workspaceBuildCache(ws, o.noBuildCache),
+			hooks:             hooks.New(ws),
+			offlineValues:     offlineValues,
 		}
 	}
 	return opts, nil
@@ -124,7 +155,10 @@ func (o *packageJobOpts) Validate() error {
 			return err
 		}
 	}
-	return nil
+	if err := o.offlineVars.validate(); err != nil {
+		return err
+	}
+	return validateStackFormat(o.format, o.outputDir)
 }
 
 // Ask prompts the user for any missing required fields.
@@ -191,7 +225,13 @@ func buildJobPackageCmd() *cobra.Command {
   $ copilot job package -n report-generator -e test --output-dir ./infrastructure
   $ ls ./infrastructure
   report-generator-test.stack.yml      report-generator-test.params.yml
-  /endcodeblock`,
+  /endcodeblock
+
+  Print a Terraform configuration that deploys the CloudFormation stack of the job.
+  /code $ copilot job package -n report-generator -e test --format terraform
+
+  Print the CloudFormation template without AWS credentials, for the account "123456789012".
+  /code $ copilot job package -n report-generator -e test --offline --account-id 123456789012`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newPackageJobOpts(vars)
 			if err != nil {
@@ -204,12 +244,26 @@ func buildJobPackageCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVar(&vars.tag, imageTagFlag, "", imageTagFlagDescription)
+	cmd.Flags().StringVar(&vars.builder, builderFlag, "", builderFlagDescription)
+	cmd.Flags().BoolVar(&vars.buildRemote, buildRemoteFlag, false, buildRemoteFlagDescription)
 	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, "", stackOutputDirFlagDescription)
+	cmd.Flags().StringVar(&vars.format, stackFormatFlag, stackFormatCloudFormation, stackFormatFlagDescription)
 	cmd.Flags().BoolVar(&vars.uploadAssets, uploadAssetsFlag, false, uploadAssetsFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().StringVar(&vars.diffFile, diffFileFlag, "", diffFileFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
+	cmd.Flags().BoolVar(&vars.noBuildCache, noBuildCacheFlag, false, noBuildCacheFlagDescription)
+	cmd.Flags().BoolVar(&vars.checkTemplate, checkFlag, false, checkTemplateFlagDescription)
+	cmd.Flags().BoolVar(&vars.offline, offlineFlag, false, offlineFlagDescription)
+	cmd.Flags().StringVar(&vars.accountID, accountIDFlag, "", accountIDFlagDescription)
+	cmd.Flags().StringVar(&vars.valuesFile, valuesFlag, "", offlineValuesFlagDescription)
+	cmd.MarkFlagsMutuallyExclusive(builderFlag, buildRemoteFlag)
 
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, stackOutputDirFlag)
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, uploadAssetsFlag)
+	cmd.MarkFlagsMutuallyExclusive(offlineFlag, uploadAssetsFlag)
+	cmd.MarkFlagsMutuallyExclusive(offlineFlag, diffFlag)
+	cmd.MarkFlagsMutuallyExclusive(offlineFlag, diffFileFlag)
+	cmd.MarkFlagsMutuallyExclusive(offlineFlag, buildRemoteFlag)
 	return cmd
 }
@@ -5,6 +5,7 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/afero"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/scheduler"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -29,6 +31,7 @@ type jobRunVars struct {
 	appName string
 	envName string
 	jobName string
+	at      string
 }
 
 type jobRunOpts struct {
@@ -41,8 +44,11 @@ type jobRunOpts struct {
 	// cached variables.
 	targetEnv    *config.Environment
 	sessProvider *sessions.Provider
+	runAt        time.Time
 
+	now                        func() time.Time
 	newRunner                  func() (runner, error)
+	newDelayedRunner           func() (delayedRunner, error)
 	newEnvCompatibilityChecker func() (versionCompatibilityChecker, error)
 }
 
@@ -68,21 +74,13 @@ func newJobRunOpts(vars jobRunVars) (*jobRunOpts, error) {
 		ws:          ws,
 
 		sessProvider: sessProvider,
+		now:          time.Now,
 	}
 	opts.newRunner = func() (runner, error) {
-		sess, err := opts.envSession()
-		if err != nil {
-			return nil, err
-		}
-
-		return jobrunner.New(&jobrunner.Config{
-			App: opts.appName,
-			Env: opts.envName,
-			Job: opts.jobName,
-
-			CFN:          cloudformation.New(sess),
-			StateMachine: stepfunctions.New(sess),
-		}), nil
+		return opts.jobRunner()
+	}
+	opts.newDelayedRunner = func() (delayedRunner, error) {
+		return opts.jobRunner()
 	}
 	opts.newEnvCompatibilityChecker = func() (versionCompatibilityChecker, error) {
 		envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
@@ -98,9 +96,22 @@ func newJobRunOpts(vars jobRunVars) (*jobRunOpts, error) {
 	return opts, nil
 }
 
-// Validate is a no-op for this command.
-// it's a no-op because all 3 flags are required, and `Validate` only validate optional flags.
+// Validate returns an error if the optional flag values are invalid.
 func (o *jobRunOpts) Validate() error {
+	if o.at == "" {
+		return nil
+	}
+	now := o.now()
+	if d, err := time.ParseDuration(o.at); err == nil {
+		o.runAt = now.Add(d)
+	} else if t, err := time.Parse(time.RFC3339, o.at); err == nil {
+		o.runAt = t
+	} else {
+		return fmt.Errorf(`invalid value %q for flag --%s: must be a date (RFC3339) or a duration`, o.at, atFlag)
+	}
+	if !o.runAt.After(now) {
+		return fmt.Errorf("flag --%s must be a time in the future", atFlag)
+	}
 	return nil
 }
 
@@ -123,6 +134,9 @@ func (o *jobRunOpts) Execute() error {
 	if err := o.validateEnvCompatible(); err != nil {
 		return err
 	}
+	if !o.runAt.IsZero() {
+		return o.runLater()
+	}
 	runner, err := o.newRunner()
 	if err != nil {
 		return err
@@ -134,6 +148,34 @@ func (o *jobRunOpts) Execute() error {
 	return nil
 }
 
+func (o *jobRunOpts) runLater() error {
+	runner, err := o.newDelayedRunner()
+	if err != nil {
+		return err
+	}
+	if err := runner.RunAt(o.runAt); err != nil {
+		return fmt.Errorf("schedule job %q: %w", o.jobName, err)
+	}
+	log.Successf("Scheduled job %q to run at %s\n", o.jobName, o.runAt.Format(time.RFC3339))
+	return nil
+}
+
+func (o *jobRunOpts) jobRunner() (*jobrunner.JobRunner, error) {
+	sess, err := o.envSession()
+	if err != nil {
+		return nil, err
+	}
+	return jobrunner.New(&jobrunner.Config{
+		App: o.appName,
+		Env: o.envName,
+		Job: o.jobName,
+
+		CFN:          cloudformation.New(sess),
+		StateMachine: stepfunctions.New(sess),
+		Scheduler:    scheduler.New(sess),
+	}), nil
+}
+
 func (o *jobRunOpts) validateOrAskApp() error {
 	if o.appName != "" {
 		_, err := o.configStore.GetApplication(o.appName)
@@ -216,7 +258,9 @@ func buildJobRunCmd() *cobra.Command {
 		Long:  "Invoke a job in an environment.",
 		Example: `
   Run a job named "report-gen" in an application named "report" within a "test" environment
-  /code $ copilot job run -a report -n report-gen -e test`,
+  /code $ copilot job run -a report -n report-gen -e test
+  Run the job once in two hours
+  /code $ copilot job run -n report-gen -e test --at 2h`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newJobRunOpts(vars)
 			if err != nil {
@@ -228,5 +272,6 @@ func buildJobRunCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVarP(&vars.jobName, nameFlag, nameFlagShort, "", jobFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.at, atFlag, "", atFlagDescription)
 	return cmd
 }
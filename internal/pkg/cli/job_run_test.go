@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -157,12 +158,62 @@ func TestJobRun_Ask(t *testing.T) {
 	}
 }
 
+func TestJobRun_Validate(t *testing.T) {
+	mockNow := time.Date(2023, time.October, 15, 9, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inputAt string
+
+		wantedRunAt time.Time
+		wantedError error
+	}{
+		"skip validation if the job runs now": {},
+		"run the job after a duration": {
+			inputAt:     "2h30m",
+			wantedRunAt: time.Date(2023, time.October, 15, 11, 30, 0, 0, time.UTC),
+		},
+		"run the job at a date": {
+			inputAt:     "2023-10-16T08:00:00-07:00",
+			wantedRunAt: time.Date(2023, time.October, 16, 8, 0, 0, 0, time.FixedZone("", -7*60*60)),
+		},
+		"error if the value is neither a date nor a duration": {
+			inputAt:     "tomorrow",
+			wantedError: errors.New(`invalid value "tomorrow" for flag --at: must be a date (RFC3339) or a duration`),
+		},
+		"error if the time is in the past": {
+			inputAt:     "2023-10-14T09:00:00Z",
+			wantedError: errors.New("flag --at must be a time in the future"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &jobRunOpts{
+				jobRunVars: jobRunVars{
+					at: tc.inputAt,
+				},
+				now: func() time.Time { return mockNow },
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.True(t, tc.wantedRunAt.Equal(opts.runAt))
+			}
+		})
+	}
+}
+
 func TestJobRun_Execute(t *testing.T) {
 	testCases := map[string]struct {
 		appName        string
 		envName        string
 		jobName        string
+		runAt          time.Time
 		mockjobRunner  func(ctrl *gomock.Controller) runner
+		mockDelayed    func(ctrl *gomock.Controller) delayedRunner
 		mockEnvChecker func(ctrl *gomock.Controller) versionCompatibilityChecker
 		wantedError    error
 	}{
@@ -193,6 +244,35 @@ func TestJobRun_Execute(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`execute job "mockJob": some error`),
 		},
+		"successfully schedule the job": {
+			jobName: "mockJob",
+			runAt:   time.Date(2023, time.October, 15, 9, 0, 0, 0, time.UTC),
+			mockDelayed: func(ctrl *gomock.Controller) delayedRunner {
+				m := mocks.NewMockdelayedRunner(ctrl)
+				m.EXPECT().RunAt(time.Date(2023, time.October, 15, 9, 0, 0, 0, time.UTC)).Return(nil)
+				return m
+			},
+			mockEnvChecker: func(ctrl *gomock.Controller) versionCompatibilityChecker {
+				m := mocks.NewMockversionCompatibilityChecker(ctrl)
+				m.EXPECT().Version().Return("v1.12.1", nil)
+				return m
+			},
+		},
+		"should return a wrapped error when the job cannot be scheduled": {
+			jobName: "mockJob",
+			runAt:   time.Date(2023, time.October, 15, 9, 0, 0, 0, time.UTC),
+			mockDelayed: func(ctrl *gomock.Controller) delayedRunner {
+				m := mocks.NewMockdelayedRunner(ctrl)
+				m.EXPECT().RunAt(gomock.Any()).Return(errors.New("some error"))
+				return m
+			},
+			mockEnvChecker: func(ctrl *gomock.Controller) versionCompatibilityChecker {
+				m := mocks.NewMockversionCompatibilityChecker(ctrl)
+				m.EXPECT().Version().Return("v1.12.1", nil)
+				return m
+			},
+			wantedError: errors.New(`schedule job "mockJob": some error`),
+		},
 		"should return a wrapped error when environment version cannot be retrieved": {
 			appName: "finance",
 			envName: "test",
@@ -234,9 +314,13 @@ func TestJobRun_Execute(t *testing.T) {
 					envName: tc.envName,
 					jobName: tc.jobName,
 				},
+				runAt: tc.runAt,
 				newRunner: func() (runner, error) {
 					return tc.mockjobRunner(ctrl), nil
 				},
+				newDelayedRunner: func() (delayedRunner, error) {
+					return tc.mockDelayed(ctrl), nil
+				},
 				newEnvCompatibilityChecker: func() (versionCompatibilityChecker, error) {
 					return tc.mockEnvChecker(ctrl), nil
 				},
@@ -0,0 +1,35 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// buildJobValidateCmd builds the command for validating the manifest of a job.
+func buildJobValidateCmd() *cobra.Command {
+	vars := validateWkldVars{}
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the manifest of a job without deploying it.",
+		Long: `Validate the manifest of a job without deploying it.
+Reports every unknown field, type mismatch and invalid configuration with its line, without calling any AWS APIs.`,
+		Example: `
+  Validate the manifest of job "report-generator", including the overrides of every environment.
+  /code $ copilot job validate -n report-generator
+  Validate the manifest of job "report-generator" as it would be deployed to the "test" environment.
+  /code $ copilot job validate -n report-generator -e test
+  Print the JSON Schema of the manifest of job "report-generator".
+  /code $ copilot job validate -n report-generator --schema`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newValidateWkldOpts(vars, "job")
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	addValidateWkldFlags(cmd, &vars, jobFlagDescription)
+	return cmd
+}
@@ -0,0 +1,59 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+)
+
+// DeployServiceInput holds the configuration of a service deployment run from a Go program.
+type DeployServiceInput struct {
+	App             string            // Name of the application. Defaults to the application of the workspace.
+	Name            string            // Name of the service.
+	Env             string            // Name of the environment to deploy to.
+	ImageTag        string            // Tag of the container images pushed for the service.
+	ResourceTags    map[string]string // Additional tags applied to the stack.
+	Force           bool              // Force a new deployment even if there are no changes.
+	DisableRollback bool              // Leave failed stacks in place instead of rolling them back.
+	WaitForLock     bool              // Wait for another deployment of the service to finish instead of failing.
+	LockTimeout     time.Duration     // Maximum duration to wait with WaitForLock. Zero waits indefinitely.
+	OverrideFreeze  string            // Reason to deploy in spite of the deploy windows and freezes of the environment.
+}
+
+// DeployService deploys a service of the workspace like "copilot svc deploy" does, without prompting.
+func DeployService(ws *workspace.Workspace, in DeployServiceInput) error {
+	if in.Name == "" {
+		return errors.New("name of the service is required")
+	}
+	if in.Env == "" {
+		return errors.New("name of the environment is required")
+	}
+	if in.App == "" {
+		summary, err := ws.Summary()
+		if err != nil {
+			return fmt.Errorf("read application of the workspace: %w", err)
+		}
+		in.App = summary.Application
+	}
+	opts, err := newSvcDeployOptsInWorkspace(deployWkldVars{
+		appName:         in.App,
+		name:            in.Name,
+		envName:         in.Env,
+		imageTag:        in.ImageTag,
+		resourceTags:    in.ResourceTags,
+		forceNewUpdate:  in.Force,
+		disableRollback: in.DisableRollback,
+		waitForLock:     in.WaitForLock,
+		lockTimeout:     in.LockTimeout,
+		overrideFreeze:  in.OverrideFreeze,
+	}, ws)
+	if err != nil {
+		return err
+	}
+	return run(opts)
+}
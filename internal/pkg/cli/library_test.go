@@ -0,0 +1,31 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployService_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in        DeployServiceInput
+		wantedErr string
+	}{
+		"no service": {
+			in:        DeployServiceInput{Env: "test"},
+			wantedErr: "name of the service is required",
+		},
+		"no environment": {
+			in:        DeployServiceInput{Name: "api"},
+			wantedErr: "name of the environment is required",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.EqualError(t, DeployService(nil, tc.in), tc.wantedErr)
+		})
+	}
+}
@@ -62,6 +62,20 @@ func (mr *MockshellCompleterMockRecorder) GenFishCompletion(w, includeDesc inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenFishCompletion", reflect.TypeOf((*MockshellCompleter)(nil).GenFishCompletion), w, includeDesc)
 }
 
+// GenPowerShellCompletionWithDesc mocks base method.
+func (m *MockshellCompleter) GenPowerShellCompletionWithDesc(w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenPowerShellCompletionWithDesc", w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenPowerShellCompletionWithDesc indicates an expected call of GenPowerShellCompletionWithDesc.
+func (mr *MockshellCompleterMockRecorder) GenPowerShellCompletionWithDesc(w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenPowerShellCompletionWithDesc", reflect.TypeOf((*MockshellCompleter)(nil).GenPowerShellCompletionWithDesc), w)
+}
+
 // GenZshCompletion mocks base method.
 func (m *MockshellCompleter) GenZshCompletion(w io.Writer) error {
 	m.ctrl.T.Helper()
@@ -75,3 +89,86 @@ func (mr *MockshellCompleterMockRecorder) GenZshCompletion(w interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenZshCompletion", reflect.TypeOf((*MockshellCompleter)(nil).GenZshCompletion), w)
 }
+
+// MockworkloadEnvLister is a mock of workloadEnvLister interface.
+type MockworkloadEnvLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkloadEnvListerMockRecorder
+}
+
+// MockworkloadEnvListerMockRecorder is the mock recorder for MockworkloadEnvLister.
+type MockworkloadEnvListerMockRecorder struct {
+	mock *MockworkloadEnvLister
+}
+
+// NewMockworkloadEnvLister creates a new mock instance.
+func NewMockworkloadEnvLister(ctrl *gomock.Controller) *MockworkloadEnvLister {
+	mock := &MockworkloadEnvLister{ctrl: ctrl}
+	mock.recorder = &MockworkloadEnvListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkloadEnvLister) EXPECT() *MockworkloadEnvListerMockRecorder {
+	return m.recorder
+}
+
+// ListEnvironments mocks base method.
+func (m *MockworkloadEnvLister) ListEnvironments() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnvironments")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockworkloadEnvListerMockRecorder) ListEnvironments() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockworkloadEnvLister)(nil).ListEnvironments))
+}
+
+// ListJobs mocks base method.
+func (m *MockworkloadEnvLister) ListJobs() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobs")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobs indicates an expected call of ListJobs.
+func (mr *MockworkloadEnvListerMockRecorder) ListJobs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockworkloadEnvLister)(nil).ListJobs))
+}
+
+// ListServices mocks base method.
+func (m *MockworkloadEnvLister) ListServices() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServices")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServices indicates an expected call of ListServices.
+func (mr *MockworkloadEnvListerMockRecorder) ListServices() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockworkloadEnvLister)(nil).ListServices))
+}
+
+// ListWorkloads mocks base method.
+func (m *MockworkloadEnvLister) ListWorkloads() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockworkloadEnvListerMockRecorder) ListWorkloads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockworkloadEnvLister)(nil).ListWorkloads))
+}
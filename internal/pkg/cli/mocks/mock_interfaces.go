@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ./internal/pkg/cli/interfaces.go
+// Source: internal/pkg/cli/interfaces.go
 
 // Package mocks is a generated GoMock package.
 package mocks
@@ -9,20 +9,32 @@ import (
 	encoding "encoding"
 	io "io"
 	reflect "reflect"
+	time "time"
 
 	session "github.com/aws/aws-sdk-go/aws/session"
 	cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	aas "github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	cloudwatch "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	cloudwatchlogs "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	dynamodb "github.com/aws/copilot-cli/internal/pkg/aws/dynamodb"
 	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	ecr "github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	efs "github.com/aws/copilot-cli/internal/pkg/aws/efs"
+	rds "github.com/aws/copilot-cli/internal/pkg/aws/rds"
+	resourcegroups "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	secretsmanager "github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	sqs "github.com/aws/copilot-cli/internal/pkg/aws/sqs"
 	ssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	deploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
 	deploy0 "github.com/aws/copilot-cli/internal/pkg/deploy"
 	cloudformation1 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	stack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	lock "github.com/aws/copilot-cli/internal/pkg/deploy/lock"
+	quota "github.com/aws/copilot-cli/internal/pkg/deploy/quota"
 	describe "github.com/aws/copilot-cli/internal/pkg/describe"
 	dockerengine "github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	dockerfile "github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
@@ -32,6 +44,7 @@ import (
 	logging "github.com/aws/copilot-cli/internal/pkg/logging"
 	manifest "github.com/aws/copilot-cli/internal/pkg/manifest"
 	task "github.com/aws/copilot-cli/internal/pkg/task"
+	telemetry "github.com/aws/copilot-cli/internal/pkg/telemetry"
 	template "github.com/aws/copilot-cli/internal/pkg/template"
 	prompt "github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	selector "github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -1774,6 +1787,158 @@ func (mr *MocklogEventsWriterMockRecorder) WriteLogEvents(opts interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteLogEvents", reflect.TypeOf((*MocklogEventsWriter)(nil).WriteLogEvents), opts)
 }
 
+// MockjobTasksLister is a mock of jobTasksLister interface.
+type MockjobTasksLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobTasksListerMockRecorder
+}
+
+// MockjobTasksListerMockRecorder is the mock recorder for MockjobTasksLister.
+type MockjobTasksListerMockRecorder struct {
+	mock *MockjobTasksLister
+}
+
+// NewMockjobTasksLister creates a new mock instance.
+func NewMockjobTasksLister(ctrl *gomock.Controller) *MockjobTasksLister {
+	mock := &MockjobTasksLister{ctrl: ctrl}
+	mock.recorder = &MockjobTasksListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobTasksLister) EXPECT() *MockjobTasksListerMockRecorder {
+	return m.recorder
+}
+
+// TaskIDs mocks base method.
+func (m *MockjobTasksLister) TaskIDs() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskIDs")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskIDs indicates an expected call of TaskIDs.
+func (mr *MockjobTasksListerMockRecorder) TaskIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskIDs", reflect.TypeOf((*MockjobTasksLister)(nil).TaskIDs))
+}
+
+// MockdeadLetterQueueLister is a mock of deadLetterQueueLister interface.
+type MockdeadLetterQueueLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeadLetterQueueListerMockRecorder
+}
+
+// MockdeadLetterQueueListerMockRecorder is the mock recorder for MockdeadLetterQueueLister.
+type MockdeadLetterQueueListerMockRecorder struct {
+	mock *MockdeadLetterQueueLister
+}
+
+// NewMockdeadLetterQueueLister creates a new mock instance.
+func NewMockdeadLetterQueueLister(ctrl *gomock.Controller) *MockdeadLetterQueueLister {
+	mock := &MockdeadLetterQueueLister{ctrl: ctrl}
+	mock.recorder = &MockdeadLetterQueueListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeadLetterQueueLister) EXPECT() *MockdeadLetterQueueListerMockRecorder {
+	return m.recorder
+}
+
+// DeadLetterQueues mocks base method.
+func (m *MockdeadLetterQueueLister) DeadLetterQueues() ([]describe.DeadLetterQueue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadLetterQueues")
+	ret0, _ := ret[0].([]describe.DeadLetterQueue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeadLetterQueues indicates an expected call of DeadLetterQueues.
+func (mr *MockdeadLetterQueueListerMockRecorder) DeadLetterQueues() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetterQueues", reflect.TypeOf((*MockdeadLetterQueueLister)(nil).DeadLetterQueues))
+}
+
+// MockqueueMessagePeeker is a mock of queueMessagePeeker interface.
+type MockqueueMessagePeeker struct {
+	ctrl     *gomock.Controller
+	recorder *MockqueueMessagePeekerMockRecorder
+}
+
+// MockqueueMessagePeekerMockRecorder is the mock recorder for MockqueueMessagePeeker.
+type MockqueueMessagePeekerMockRecorder struct {
+	mock *MockqueueMessagePeeker
+}
+
+// NewMockqueueMessagePeeker creates a new mock instance.
+func NewMockqueueMessagePeeker(ctrl *gomock.Controller) *MockqueueMessagePeeker {
+	mock := &MockqueueMessagePeeker{ctrl: ctrl}
+	mock.recorder = &MockqueueMessagePeekerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockqueueMessagePeeker) EXPECT() *MockqueueMessagePeekerMockRecorder {
+	return m.recorder
+}
+
+// Peek mocks base method.
+func (m *MockqueueMessagePeeker) Peek(url string, limit int) ([]sqs.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Peek", url, limit)
+	ret0, _ := ret[0].([]sqs.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Peek indicates an expected call of Peek.
+func (mr *MockqueueMessagePeekerMockRecorder) Peek(url, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Peek", reflect.TypeOf((*MockqueueMessagePeeker)(nil).Peek), url, limit)
+}
+
+// MockqueueRedriver is a mock of queueRedriver interface.
+type MockqueueRedriver struct {
+	ctrl     *gomock.Controller
+	recorder *MockqueueRedriverMockRecorder
+}
+
+// MockqueueRedriverMockRecorder is the mock recorder for MockqueueRedriver.
+type MockqueueRedriverMockRecorder struct {
+	mock *MockqueueRedriver
+}
+
+// NewMockqueueRedriver creates a new mock instance.
+func NewMockqueueRedriver(ctrl *gomock.Controller) *MockqueueRedriver {
+	mock := &MockqueueRedriver{ctrl: ctrl}
+	mock.recorder = &MockqueueRedriverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockqueueRedriver) EXPECT() *MockqueueRedriverMockRecorder {
+	return m.recorder
+}
+
+// Redrive mocks base method.
+func (m *MockqueueRedriver) Redrive(deadLetterQueueARN string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redrive", deadLetterQueueARN)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redrive indicates an expected call of Redrive.
+func (mr *MockqueueRedriverMockRecorder) Redrive(deadLetterQueueARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redrive", reflect.TypeOf((*MockqueueRedriver)(nil).Redrive), deadLetterQueueARN)
+}
+
 // MockexecRunner is a mock of execRunner interface.
 type MockexecRunner struct {
 	ctrl     *gomock.Controller
@@ -1839,6 +2004,20 @@ func (m *MockeventsWriter) EXPECT() *MockeventsWriterMockRecorder {
 	return m.recorder
 }
 
+// WaitUntilStopped mocks base method.
+func (m *MockeventsWriter) WaitUntilStopped() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitUntilStopped")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitUntilStopped indicates an expected call of WaitUntilStopped.
+func (mr *MockeventsWriterMockRecorder) WaitUntilStopped() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitUntilStopped", reflect.TypeOf((*MockeventsWriter)(nil).WaitUntilStopped))
+}
+
 // WriteEventsUntilStopped mocks base method.
 func (m *MockeventsWriter) WriteEventsUntilStopped() error {
 	m.ctrl.T.Helper()
@@ -1853,6 +2032,48 @@ func (mr *MockeventsWriterMockRecorder) WriteEventsUntilStopped() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteEventsUntilStopped", reflect.TypeOf((*MockeventsWriter)(nil).WriteEventsUntilStopped))
 }
 
+// MockecsTaskStopper is a mock of ecsTaskStopper interface.
+type MockecsTaskStopper struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsTaskStopperMockRecorder
+}
+
+// MockecsTaskStopperMockRecorder is the mock recorder for MockecsTaskStopper.
+type MockecsTaskStopperMockRecorder struct {
+	mock *MockecsTaskStopper
+}
+
+// NewMockecsTaskStopper creates a new mock instance.
+func NewMockecsTaskStopper(ctrl *gomock.Controller) *MockecsTaskStopper {
+	mock := &MockecsTaskStopper{ctrl: ctrl}
+	mock.recorder = &MockecsTaskStopperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockecsTaskStopper) EXPECT() *MockecsTaskStopperMockRecorder {
+	return m.recorder
+}
+
+// StopTasks mocks base method.
+func (m *MockecsTaskStopper) StopTasks(tasks []string, opts ...ecs.StopTasksOpts) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{tasks}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StopTasks", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopTasks indicates an expected call of StopTasks.
+func (mr *MockecsTaskStopperMockRecorder) StopTasks(tasks interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{tasks}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTasks", reflect.TypeOf((*MockecsTaskStopper)(nil).StopTasks), varargs...)
+}
+
 // MockdefaultSessionProvider is a mock of defaultSessionProvider interface.
 type MockdefaultSessionProvider struct {
 	ctrl     *gomock.Controller
@@ -2232,6 +2453,21 @@ func (mr *MockworkloadDescriberMockRecorder) Manifest(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Manifest", reflect.TypeOf((*MockworkloadDescriber)(nil).Manifest), arg0)
 }
 
+// RenderedManifest mocks base method.
+func (m *MockworkloadDescriber) RenderedManifest(arg0 string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderedManifest", arg0)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderedManifest indicates an expected call of RenderedManifest.
+func (mr *MockworkloadDescriberMockRecorder) RenderedManifest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderedManifest", reflect.TypeOf((*MockworkloadDescriber)(nil).RenderedManifest), arg0)
+}
+
 // MockwsFileDeleter is a mock of wsFileDeleter interface.
 type MockwsFileDeleter struct {
 	ctrl     *gomock.Controller
@@ -2345,6 +2581,44 @@ func (mr *MockenvironmentManifestWriterMockRecorder) WriteEnvironmentManifest(ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteEnvironmentManifest", reflect.TypeOf((*MockenvironmentManifestWriter)(nil).WriteEnvironmentManifest), arg0, arg1)
 }
 
+// MockenvironmentManifestReader is a mock of environmentManifestReader interface.
+type MockenvironmentManifestReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvironmentManifestReaderMockRecorder
+}
+
+// MockenvironmentManifestReaderMockRecorder is the mock recorder for MockenvironmentManifestReader.
+type MockenvironmentManifestReaderMockRecorder struct {
+	mock *MockenvironmentManifestReader
+}
+
+// NewMockenvironmentManifestReader creates a new mock instance.
+func NewMockenvironmentManifestReader(ctrl *gomock.Controller) *MockenvironmentManifestReader {
+	mock := &MockenvironmentManifestReader{ctrl: ctrl}
+	mock.recorder = &MockenvironmentManifestReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvironmentManifestReader) EXPECT() *MockenvironmentManifestReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadEnvironmentManifest mocks base method.
+func (m *MockenvironmentManifestReader) ReadEnvironmentManifest(mftDirName string) (workspace.EnvironmentManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEnvironmentManifest", mftDirName)
+	ret0, _ := ret[0].(workspace.EnvironmentManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEnvironmentManifest indicates an expected call of ReadEnvironmentManifest.
+func (mr *MockenvironmentManifestReaderMockRecorder) ReadEnvironmentManifest(mftDirName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockenvironmentManifestReader)(nil).ReadEnvironmentManifest), mftDirName)
+}
+
 // MockworkspacePathGetter is a mock of workspacePathGetter interface.
 type MockworkspacePathGetter struct {
 	ctrl     *gomock.Controller
@@ -2511,6 +2785,21 @@ func (mr *MockwsPipelineIniterMockRecorder) Rel(path interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rel", reflect.TypeOf((*MockwsPipelineIniter)(nil).Rel), path)
 }
 
+// WriteGitHubWorkflow mocks base method.
+func (m *MockwsPipelineIniter) WriteGitHubWorkflow(marshaler encoding.BinaryMarshaler, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteGitHubWorkflow", marshaler, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteGitHubWorkflow indicates an expected call of WriteGitHubWorkflow.
+func (mr *MockwsPipelineIniterMockRecorder) WriteGitHubWorkflow(marshaler, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteGitHubWorkflow", reflect.TypeOf((*MockwsPipelineIniter)(nil).WriteGitHubWorkflow), marshaler, name)
+}
+
 // WritePipelineBuildspec mocks base method.
 func (m *MockwsPipelineIniter) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler, name string) (string, error) {
 	m.ctrl.T.Helper()
@@ -2723,6 +3012,74 @@ func (mr *MockwsJobReaderMockRecorder) ReadWorkloadManifest(name interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsJobReader)(nil).ReadWorkloadManifest), name)
 }
 
+// MockwsWorkloadManifestReader is a mock of wsWorkloadManifestReader interface.
+type MockwsWorkloadManifestReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsWorkloadManifestReaderMockRecorder
+}
+
+// MockwsWorkloadManifestReaderMockRecorder is the mock recorder for MockwsWorkloadManifestReader.
+type MockwsWorkloadManifestReaderMockRecorder struct {
+	mock *MockwsWorkloadManifestReader
+}
+
+// NewMockwsWorkloadManifestReader creates a new mock instance.
+func NewMockwsWorkloadManifestReader(ctrl *gomock.Controller) *MockwsWorkloadManifestReader {
+	mock := &MockwsWorkloadManifestReader{ctrl: ctrl}
+	mock.recorder = &MockwsWorkloadManifestReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsWorkloadManifestReader) EXPECT() *MockwsWorkloadManifestReaderMockRecorder {
+	return m.recorder
+}
+
+// ListJobs mocks base method.
+func (m *MockwsWorkloadManifestReader) ListJobs() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJobs")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJobs indicates an expected call of ListJobs.
+func (mr *MockwsWorkloadManifestReaderMockRecorder) ListJobs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockwsWorkloadManifestReader)(nil).ListJobs))
+}
+
+// ListServices mocks base method.
+func (m *MockwsWorkloadManifestReader) ListServices() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServices")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServices indicates an expected call of ListServices.
+func (mr *MockwsWorkloadManifestReaderMockRecorder) ListServices() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockwsWorkloadManifestReader)(nil).ListServices))
+}
+
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsWorkloadManifestReader) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsWorkloadManifestReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsWorkloadManifestReader)(nil).ReadWorkloadManifest), name)
+}
+
 // MockwlLister is a mock of wlLister interface.
 type MockwlLister struct {
 	ctrl     *gomock.Controller
@@ -2761,83 +3118,174 @@ func (mr *MockwlListerMockRecorder) ListWorkloads() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwlLister)(nil).ListWorkloads))
 }
 
-// MockwsWorkloadReader is a mock of wsWorkloadReader interface.
-type MockwsWorkloadReader struct {
+// MockwsEnvironmentWorkloadLister is a mock of wsEnvironmentWorkloadLister interface.
+type MockwsEnvironmentWorkloadLister struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsWorkloadReaderMockRecorder
+	recorder *MockwsEnvironmentWorkloadListerMockRecorder
 }
 
-// MockwsWorkloadReaderMockRecorder is the mock recorder for MockwsWorkloadReader.
-type MockwsWorkloadReaderMockRecorder struct {
-	mock *MockwsWorkloadReader
+// MockwsEnvironmentWorkloadListerMockRecorder is the mock recorder for MockwsEnvironmentWorkloadLister.
+type MockwsEnvironmentWorkloadListerMockRecorder struct {
+	mock *MockwsEnvironmentWorkloadLister
 }
 
-// NewMockwsWorkloadReader creates a new mock instance.
-func NewMockwsWorkloadReader(ctrl *gomock.Controller) *MockwsWorkloadReader {
-	mock := &MockwsWorkloadReader{ctrl: ctrl}
-	mock.recorder = &MockwsWorkloadReaderMockRecorder{mock}
+// NewMockwsEnvironmentWorkloadLister creates a new mock instance.
+func NewMockwsEnvironmentWorkloadLister(ctrl *gomock.Controller) *MockwsEnvironmentWorkloadLister {
+	mock := &MockwsEnvironmentWorkloadLister{ctrl: ctrl}
+	mock.recorder = &MockwsEnvironmentWorkloadListerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockwsWorkloadReader) EXPECT() *MockwsWorkloadReaderMockRecorder {
+func (m *MockwsEnvironmentWorkloadLister) EXPECT() *MockwsEnvironmentWorkloadListerMockRecorder {
 	return m.recorder
 }
 
-// ReadFile mocks base method.
-func (m *MockwsWorkloadReader) ReadFile(path string) ([]byte, error) {
+// ListEnvironments mocks base method.
+func (m *MockwsEnvironmentWorkloadLister) ListEnvironments() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadFile", path)
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "ListEnvironments")
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadFile indicates an expected call of ReadFile.
-func (mr *MockwsWorkloadReaderMockRecorder) ReadFile(path interface{}) *gomock.Call {
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockwsEnvironmentWorkloadListerMockRecorder) ListEnvironments() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFile", reflect.TypeOf((*MockwsWorkloadReader)(nil).ReadFile), path)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockwsEnvironmentWorkloadLister)(nil).ListEnvironments))
 }
 
-// ReadWorkloadManifest mocks base method.
-func (m *MockwsWorkloadReader) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+// ListWorkloads mocks base method.
+func (m *MockwsEnvironmentWorkloadLister) ListWorkloads() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
-	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
-func (mr *MockwsWorkloadReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsEnvironmentWorkloadListerMockRecorder) ListWorkloads() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsWorkloadReader)(nil).ReadWorkloadManifest), name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsEnvironmentWorkloadLister)(nil).ListWorkloads))
 }
 
-// WorkloadAddonFileAbsPath mocks base method.
-func (m *MockwsWorkloadReader) WorkloadAddonFileAbsPath(wkldName, fName string) string {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WorkloadAddonFileAbsPath", wkldName, fName)
-	ret0, _ := ret[0].(string)
-	return ret0
+// MockdeployedWorkloadsLister is a mock of deployedWorkloadsLister interface.
+type MockdeployedWorkloadsLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployedWorkloadsListerMockRecorder
 }
 
-// WorkloadAddonFileAbsPath indicates an expected call of WorkloadAddonFileAbsPath.
-func (mr *MockwsWorkloadReaderMockRecorder) WorkloadAddonFileAbsPath(wkldName, fName interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadAddonFileAbsPath", reflect.TypeOf((*MockwsWorkloadReader)(nil).WorkloadAddonFileAbsPath), wkldName, fName)
+// MockdeployedWorkloadsListerMockRecorder is the mock recorder for MockdeployedWorkloadsLister.
+type MockdeployedWorkloadsListerMockRecorder struct {
+	mock *MockdeployedWorkloadsLister
 }
 
-// WorkloadAddonFilePath mocks base method.
-func (m *MockwsWorkloadReader) WorkloadAddonFilePath(wkldName, fName string) string {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WorkloadAddonFilePath", wkldName, fName)
-	ret0, _ := ret[0].(string)
-	return ret0
+// NewMockdeployedWorkloadsLister creates a new mock instance.
+func NewMockdeployedWorkloadsLister(ctrl *gomock.Controller) *MockdeployedWorkloadsLister {
+	mock := &MockdeployedWorkloadsLister{ctrl: ctrl}
+	mock.recorder = &MockdeployedWorkloadsListerMockRecorder{mock}
+	return mock
 }
 
-// WorkloadAddonFilePath indicates an expected call of WorkloadAddonFilePath.
-func (mr *MockwsWorkloadReaderMockRecorder) WorkloadAddonFilePath(wkldName, fName interface{}) *gomock.Call {
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeployedWorkloadsLister) EXPECT() *MockdeployedWorkloadsListerMockRecorder {
+	return m.recorder
+}
+
+// ListDeployedWorkloads mocks base method.
+func (m *MockdeployedWorkloadsLister) ListDeployedWorkloads(appName, envName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeployedWorkloads", appName, envName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeployedWorkloads indicates an expected call of ListDeployedWorkloads.
+func (mr *MockdeployedWorkloadsListerMockRecorder) ListDeployedWorkloads(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedWorkloads", reflect.TypeOf((*MockdeployedWorkloadsLister)(nil).ListDeployedWorkloads), appName, envName)
+}
+
+// MockwsWorkloadReader is a mock of wsWorkloadReader interface.
+type MockwsWorkloadReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsWorkloadReaderMockRecorder
+}
+
+// MockwsWorkloadReaderMockRecorder is the mock recorder for MockwsWorkloadReader.
+type MockwsWorkloadReaderMockRecorder struct {
+	mock *MockwsWorkloadReader
+}
+
+// NewMockwsWorkloadReader creates a new mock instance.
+func NewMockwsWorkloadReader(ctrl *gomock.Controller) *MockwsWorkloadReader {
+	mock := &MockwsWorkloadReader{ctrl: ctrl}
+	mock.recorder = &MockwsWorkloadReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsWorkloadReader) EXPECT() *MockwsWorkloadReaderMockRecorder {
+	return m.recorder
+}
+
+// ReadFile mocks base method.
+func (m *MockwsWorkloadReader) ReadFile(path string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadFile", path)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadFile indicates an expected call of ReadFile.
+func (mr *MockwsWorkloadReaderMockRecorder) ReadFile(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFile", reflect.TypeOf((*MockwsWorkloadReader)(nil).ReadFile), path)
+}
+
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsWorkloadReader) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsWorkloadReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsWorkloadReader)(nil).ReadWorkloadManifest), name)
+}
+
+// WorkloadAddonFileAbsPath mocks base method.
+func (m *MockwsWorkloadReader) WorkloadAddonFileAbsPath(wkldName, fName string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadAddonFileAbsPath", wkldName, fName)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// WorkloadAddonFileAbsPath indicates an expected call of WorkloadAddonFileAbsPath.
+func (mr *MockwsWorkloadReaderMockRecorder) WorkloadAddonFileAbsPath(wkldName, fName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadAddonFileAbsPath", reflect.TypeOf((*MockwsWorkloadReader)(nil).WorkloadAddonFileAbsPath), wkldName, fName)
+}
+
+// WorkloadAddonFilePath mocks base method.
+func (m *MockwsWorkloadReader) WorkloadAddonFilePath(wkldName, fName string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadAddonFilePath", wkldName, fName)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// WorkloadAddonFilePath indicates an expected call of WorkloadAddonFilePath.
+func (mr *MockwsWorkloadReaderMockRecorder) WorkloadAddonFilePath(wkldName, fName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadAddonFilePath", reflect.TypeOf((*MockwsWorkloadReader)(nil).WorkloadAddonFilePath), wkldName, fName)
 }
@@ -3330,6 +3778,21 @@ func (mr *MockwsWlDirReaderMockRecorder) Path() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Path", reflect.TypeOf((*MockwsWlDirReader)(nil).Path))
 }
 
+// ReadEnvironmentManifest mocks base method.
+func (m *MockwsWlDirReader) ReadEnvironmentManifest(mftDirName string) (workspace.EnvironmentManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEnvironmentManifest", mftDirName)
+	ret0, _ := ret[0].(workspace.EnvironmentManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEnvironmentManifest indicates an expected call of ReadEnvironmentManifest.
+func (mr *MockwsWlDirReaderMockRecorder) ReadEnvironmentManifest(mftDirName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockwsWlDirReader)(nil).ReadEnvironmentManifest), mftDirName)
+}
+
 // ReadWorkloadManifest mocks base method.
 func (m *MockwsWlDirReader) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
 	m.ctrl.T.Helper()
@@ -3984,6 +4447,35 @@ func (mr *MockwlDeleterMockRecorder) DeleteWorkload(in interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkload", reflect.TypeOf((*MockwlDeleter)(nil).DeleteWorkload), in)
 }
 
+// Template mocks base method.
+func (m *MockwlDeleter) Template(stackName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Template", stackName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Template indicates an expected call of Template.
+func (mr *MockwlDeleterMockRecorder) Template(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockwlDeleter)(nil).Template), stackName)
+}
+
+// UpdateWorkloadTemplate mocks base method.
+func (m *MockwlDeleter) UpdateWorkloadTemplate(appName, envName, name, templateBody, cfnExecRoleARN string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkloadTemplate", appName, envName, name, templateBody, cfnExecRoleARN)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkloadTemplate indicates an expected call of UpdateWorkloadTemplate.
+func (mr *MockwlDeleterMockRecorder) UpdateWorkloadTemplate(appName, envName, name, templateBody, cfnExecRoleARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkloadTemplate", reflect.TypeOf((*MockwlDeleter)(nil).UpdateWorkloadTemplate), appName, envName, name, templateBody, cfnExecRoleARN)
+}
+
 // MocksvcRemoverFromApp is a mock of svcRemoverFromApp interface.
 type MocksvcRemoverFromApp struct {
 	ctrl     *gomock.Controller
@@ -4095,6 +4587,177 @@ func (mr *MockimageRemoverMockRecorder) ClearRepository(repoName interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearRepository", reflect.TypeOf((*MockimageRemover)(nil).ClearRepository), repoName)
 }
 
+// MockimageCollector is a mock of imageCollector interface.
+type MockimageCollector struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageCollectorMockRecorder
+}
+
+// MockimageCollectorMockRecorder is the mock recorder for MockimageCollector.
+type MockimageCollectorMockRecorder struct {
+	mock *MockimageCollector
+}
+
+// NewMockimageCollector creates a new mock instance.
+func NewMockimageCollector(ctrl *gomock.Controller) *MockimageCollector {
+	mock := &MockimageCollector{ctrl: ctrl}
+	mock.recorder = &MockimageCollectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageCollector) EXPECT() *MockimageCollectorMockRecorder {
+	return m.recorder
+}
+
+// DeleteImages mocks base method.
+func (m *MockimageCollector) DeleteImages(images []ecr.Image, repoName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImages", images, repoName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteImages indicates an expected call of DeleteImages.
+func (mr *MockimageCollectorMockRecorder) DeleteImages(images, repoName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImages", reflect.TypeOf((*MockimageCollector)(nil).DeleteImages), images, repoName)
+}
+
+// ListImages mocks base method.
+func (m *MockimageCollector) ListImages(repoName string) ([]ecr.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImages", repoName)
+	ret0, _ := ret[0].([]ecr.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImages indicates an expected call of ListImages.
+func (mr *MockimageCollectorMockRecorder) ListImages(repoName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockimageCollector)(nil).ListImages), repoName)
+}
+
+// MocktaskDefinitionCollector is a mock of taskDefinitionCollector interface.
+type MocktaskDefinitionCollector struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskDefinitionCollectorMockRecorder
+}
+
+// MocktaskDefinitionCollectorMockRecorder is the mock recorder for MocktaskDefinitionCollector.
+type MocktaskDefinitionCollectorMockRecorder struct {
+	mock *MocktaskDefinitionCollector
+}
+
+// NewMocktaskDefinitionCollector creates a new mock instance.
+func NewMocktaskDefinitionCollector(ctrl *gomock.Controller) *MocktaskDefinitionCollector {
+	mock := &MocktaskDefinitionCollector{ctrl: ctrl}
+	mock.recorder = &MocktaskDefinitionCollectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaskDefinitionCollector) EXPECT() *MocktaskDefinitionCollectorMockRecorder {
+	return m.recorder
+}
+
+// ActiveTaskDefinitions mocks base method.
+func (m *MocktaskDefinitionCollector) ActiveTaskDefinitions(familyPrefix string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveTaskDefinitions", familyPrefix)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveTaskDefinitions indicates an expected call of ActiveTaskDefinitions.
+func (mr *MocktaskDefinitionCollectorMockRecorder) ActiveTaskDefinitions(familyPrefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveTaskDefinitions", reflect.TypeOf((*MocktaskDefinitionCollector)(nil).ActiveTaskDefinitions), familyPrefix)
+}
+
+// DeregisterTaskDefinition mocks base method.
+func (m *MocktaskDefinitionCollector) DeregisterTaskDefinition(taskDefARN string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeregisterTaskDefinition", taskDefARN)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeregisterTaskDefinition indicates an expected call of DeregisterTaskDefinition.
+func (mr *MocktaskDefinitionCollectorMockRecorder) DeregisterTaskDefinition(taskDefARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeregisterTaskDefinition", reflect.TypeOf((*MocktaskDefinitionCollector)(nil).DeregisterTaskDefinition), taskDefARN)
+}
+
+// TaskDefinition mocks base method.
+func (m *MocktaskDefinitionCollector) TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskDefinition", taskDefName)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskDefinition indicates an expected call of TaskDefinition.
+func (mr *MocktaskDefinitionCollectorMockRecorder) TaskDefinition(taskDefName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MocktaskDefinitionCollector)(nil).TaskDefinition), taskDefName)
+}
+
+// MocklogGroupCollector is a mock of logGroupCollector interface.
+type MocklogGroupCollector struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogGroupCollectorMockRecorder
+}
+
+// MocklogGroupCollectorMockRecorder is the mock recorder for MocklogGroupCollector.
+type MocklogGroupCollectorMockRecorder struct {
+	mock *MocklogGroupCollector
+}
+
+// NewMocklogGroupCollector creates a new mock instance.
+func NewMocklogGroupCollector(ctrl *gomock.Controller) *MocklogGroupCollector {
+	mock := &MocklogGroupCollector{ctrl: ctrl}
+	mock.recorder = &MocklogGroupCollectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklogGroupCollector) EXPECT() *MocklogGroupCollectorMockRecorder {
+	return m.recorder
+}
+
+// DeleteLogGroup mocks base method.
+func (m *MocklogGroupCollector) DeleteLogGroup(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLogGroup", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLogGroup indicates an expected call of DeleteLogGroup.
+func (mr *MocklogGroupCollectorMockRecorder) DeleteLogGroup(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogGroup", reflect.TypeOf((*MocklogGroupCollector)(nil).DeleteLogGroup), name)
+}
+
+// LogGroups mocks base method.
+func (m *MocklogGroupCollector) LogGroups(prefix string) ([]cloudwatchlogs.LogGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogGroups", prefix)
+	ret0, _ := ret[0].([]cloudwatchlogs.LogGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogGroups indicates an expected call of LogGroups.
+func (mr *MocklogGroupCollectorMockRecorder) LogGroups(prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogGroups", reflect.TypeOf((*MocklogGroupCollector)(nil).LogGroups), prefix)
+}
+
 // MockpipelineDeployer is a mock of pipelineDeployer interface.
 type MockpipelineDeployer struct {
 	ctrl     *gomock.Controller
@@ -4404,65 +5067,333 @@ func (mr *MockappResourcesGetterMockRecorder) GetRegionalAppResources(app interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockappResourcesGetter)(nil).GetRegionalAppResources), app)
 }
 
-// MockenvDeleterFromApp is a mock of envDeleterFromApp interface.
-type MockenvDeleterFromApp struct {
+// MockgithubActionsRoleDeployer is a mock of githubActionsRoleDeployer interface.
+type MockgithubActionsRoleDeployer struct {
 	ctrl     *gomock.Controller
-	recorder *MockenvDeleterFromAppMockRecorder
+	recorder *MockgithubActionsRoleDeployerMockRecorder
 }
 
-// MockenvDeleterFromAppMockRecorder is the mock recorder for MockenvDeleterFromApp.
-type MockenvDeleterFromAppMockRecorder struct {
-	mock *MockenvDeleterFromApp
+// MockgithubActionsRoleDeployerMockRecorder is the mock recorder for MockgithubActionsRoleDeployer.
+type MockgithubActionsRoleDeployerMockRecorder struct {
+	mock *MockgithubActionsRoleDeployer
 }
 
-// NewMockenvDeleterFromApp creates a new mock instance.
-func NewMockenvDeleterFromApp(ctrl *gomock.Controller) *MockenvDeleterFromApp {
-	mock := &MockenvDeleterFromApp{ctrl: ctrl}
-	mock.recorder = &MockenvDeleterFromAppMockRecorder{mock}
+// NewMockgithubActionsRoleDeployer creates a new mock instance.
+func NewMockgithubActionsRoleDeployer(ctrl *gomock.Controller) *MockgithubActionsRoleDeployer {
+	mock := &MockgithubActionsRoleDeployer{ctrl: ctrl}
+	mock.recorder = &MockgithubActionsRoleDeployerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockenvDeleterFromApp) EXPECT() *MockenvDeleterFromAppMockRecorder {
+func (m *MockgithubActionsRoleDeployer) EXPECT() *MockgithubActionsRoleDeployerMockRecorder {
 	return m.recorder
 }
 
-// GetAppResourcesByRegion mocks base method.
-func (m *MockenvDeleterFromApp) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
+// DeployGitHubActionsRole mocks base method.
+func (m *MockgithubActionsRoleDeployer) DeployGitHubActionsRole(conf *stack.GitHubActionsRoleConfig) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
-	ret0, _ := ret[0].(*stack.AppRegionalResources)
+	ret := m.ctrl.Call(m, "DeployGitHubActionsRole", conf)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion.
-func (mr *MockenvDeleterFromAppMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
+// DeployGitHubActionsRole indicates an expected call of DeployGitHubActionsRole.
+func (mr *MockgithubActionsRoleDeployerMockRecorder) DeployGitHubActionsRole(conf interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*MockenvDeleterFromApp)(nil).GetAppResourcesByRegion), app, region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployGitHubActionsRole", reflect.TypeOf((*MockgithubActionsRoleDeployer)(nil).DeployGitHubActionsRole), conf)
 }
 
-// GetRegionalAppResources mocks base method.
-func (m *MockenvDeleterFromApp) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
-	ret0, _ := ret[0].([]*stack.AppRegionalResources)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// MockoidcProviderGetter is a mock of oidcProviderGetter interface.
+type MockoidcProviderGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockoidcProviderGetterMockRecorder
 }
 
-// GetRegionalAppResources indicates an expected call of GetRegionalAppResources.
-func (mr *MockenvDeleterFromAppMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockenvDeleterFromApp)(nil).GetRegionalAppResources), app)
+// MockoidcProviderGetterMockRecorder is the mock recorder for MockoidcProviderGetter.
+type MockoidcProviderGetterMockRecorder struct {
+	mock *MockoidcProviderGetter
 }
 
-// RemoveEnvFromApp mocks base method.
-func (m *MockenvDeleterFromApp) RemoveEnvFromApp(opts *cloudformation1.RemoveEnvFromAppOpts) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveEnvFromApp", opts)
-	ret0, _ := ret[0].(error)
-	return ret0
+// NewMockoidcProviderGetter creates a new mock instance.
+func NewMockoidcProviderGetter(ctrl *gomock.Controller) *MockoidcProviderGetter {
+	mock := &MockoidcProviderGetter{ctrl: ctrl}
+	mock.recorder = &MockoidcProviderGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoidcProviderGetter) EXPECT() *MockoidcProviderGetterMockRecorder {
+	return m.recorder
+}
+
+// OIDCProviderARN mocks base method.
+func (m *MockoidcProviderGetter) OIDCProviderARN(url string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OIDCProviderARN", url)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OIDCProviderARN indicates an expected call of OIDCProviderARN.
+func (mr *MockoidcProviderGetterMockRecorder) OIDCProviderARN(url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OIDCProviderARN", reflect.TypeOf((*MockoidcProviderGetter)(nil).OIDCProviderARN), url)
+}
+
+// MocksvcStackDeployer is a mock of svcStackDeployer interface.
+type MocksvcStackDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcStackDeployerMockRecorder
+}
+
+// MocksvcStackDeployerMockRecorder is the mock recorder for MocksvcStackDeployer.
+type MocksvcStackDeployerMockRecorder struct {
+	mock *MocksvcStackDeployer
+}
+
+// NewMocksvcStackDeployer creates a new mock instance.
+func NewMocksvcStackDeployer(ctrl *gomock.Controller) *MocksvcStackDeployer {
+	mock := &MocksvcStackDeployer{ctrl: ctrl}
+	mock.recorder = &MocksvcStackDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksvcStackDeployer) EXPECT() *MocksvcStackDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployService mocks base method.
+func (m *MocksvcStackDeployer) DeployService(conf cloudformation1.StackConfiguration, bucketName string, opts ...cloudformation0.StackOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{conf, bucketName}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployService", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployService indicates an expected call of DeployService.
+func (mr *MocksvcStackDeployerMockRecorder) DeployService(conf, bucketName interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{conf, bucketName}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployService", reflect.TypeOf((*MocksvcStackDeployer)(nil).DeployService), varargs...)
+}
+
+// MockdeploymentRevisionStore is a mock of deploymentRevisionStore interface.
+type MockdeploymentRevisionStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeploymentRevisionStoreMockRecorder
+}
+
+// MockdeploymentRevisionStoreMockRecorder is the mock recorder for MockdeploymentRevisionStore.
+type MockdeploymentRevisionStoreMockRecorder struct {
+	mock *MockdeploymentRevisionStore
+}
+
+// NewMockdeploymentRevisionStore creates a new mock instance.
+func NewMockdeploymentRevisionStore(ctrl *gomock.Controller) *MockdeploymentRevisionStore {
+	mock := &MockdeploymentRevisionStore{ctrl: ctrl}
+	mock.recorder = &MockdeploymentRevisionStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeploymentRevisionStore) EXPECT() *MockdeploymentRevisionStoreMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockdeploymentRevisionStore) Get(stackName, id string) (*stack.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", stackName, id)
+	ret0, _ := ret[0].(*stack.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockdeploymentRevisionStoreMockRecorder) Get(stackName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockdeploymentRevisionStore)(nil).Get), stackName, id)
+}
+
+// List mocks base method.
+func (m *MockdeploymentRevisionStore) List(stackName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", stackName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockdeploymentRevisionStoreMockRecorder) List(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockdeploymentRevisionStore)(nil).List), stackName)
+}
+
+// Record mocks base method.
+func (m *MockdeploymentRevisionStore) Record(rev *stack.Revision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", rev)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockdeploymentRevisionStoreMockRecorder) Record(rev interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockdeploymentRevisionStore)(nil).Record), rev)
+}
+
+// MockdeploymentHistory is a mock of deploymentHistory interface.
+type MockdeploymentHistory struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeploymentHistoryMockRecorder
+}
+
+// MockdeploymentHistoryMockRecorder is the mock recorder for MockdeploymentHistory.
+type MockdeploymentHistoryMockRecorder struct {
+	mock *MockdeploymentHistory
+}
+
+// NewMockdeploymentHistory creates a new mock instance.
+func NewMockdeploymentHistory(ctrl *gomock.Controller) *MockdeploymentHistory {
+	mock := &MockdeploymentHistory{ctrl: ctrl}
+	mock.recorder = &MockdeploymentHistoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeploymentHistory) EXPECT() *MockdeploymentHistoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockdeploymentHistory) Get(stackName, id string) (*stack.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", stackName, id)
+	ret0, _ := ret[0].(*stack.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockdeploymentHistoryMockRecorder) Get(stackName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockdeploymentHistory)(nil).Get), stackName, id)
+}
+
+// History mocks base method.
+func (m *MockdeploymentHistory) History(stackName string) ([]*stack.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", stackName)
+	ret0, _ := ret[0].([]*stack.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockdeploymentHistoryMockRecorder) History(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockdeploymentHistory)(nil).History), stackName)
+}
+
+// Log mocks base method.
+func (m *MockdeploymentHistory) Log(stackName, id string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Log", stackName, id)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Log indicates an expected call of Log.
+func (mr *MockdeploymentHistoryMockRecorder) Log(stackName, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Log", reflect.TypeOf((*MockdeploymentHistory)(nil).Log), stackName, id)
+}
+
+// LogIDs mocks base method.
+func (m *MockdeploymentHistory) LogIDs(stackName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogIDs", stackName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogIDs indicates an expected call of LogIDs.
+func (mr *MockdeploymentHistoryMockRecorder) LogIDs(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogIDs", reflect.TypeOf((*MockdeploymentHistory)(nil).LogIDs), stackName)
+}
+
+// MockenvDeleterFromApp is a mock of envDeleterFromApp interface.
+type MockenvDeleterFromApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvDeleterFromAppMockRecorder
+}
+
+// MockenvDeleterFromAppMockRecorder is the mock recorder for MockenvDeleterFromApp.
+type MockenvDeleterFromAppMockRecorder struct {
+	mock *MockenvDeleterFromApp
+}
+
+// NewMockenvDeleterFromApp creates a new mock instance.
+func NewMockenvDeleterFromApp(ctrl *gomock.Controller) *MockenvDeleterFromApp {
+	mock := &MockenvDeleterFromApp{ctrl: ctrl}
+	mock.recorder = &MockenvDeleterFromAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvDeleterFromApp) EXPECT() *MockenvDeleterFromAppMockRecorder {
+	return m.recorder
+}
+
+// GetAppResourcesByRegion mocks base method.
+func (m *MockenvDeleterFromApp) GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppResourcesByRegion", app, region)
+	ret0, _ := ret[0].(*stack.AppRegionalResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppResourcesByRegion indicates an expected call of GetAppResourcesByRegion.
+func (mr *MockenvDeleterFromAppMockRecorder) GetAppResourcesByRegion(app, region interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppResourcesByRegion", reflect.TypeOf((*MockenvDeleterFromApp)(nil).GetAppResourcesByRegion), app, region)
+}
+
+// GetRegionalAppResources mocks base method.
+func (m *MockenvDeleterFromApp) GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegionalAppResources", app)
+	ret0, _ := ret[0].([]*stack.AppRegionalResources)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegionalAppResources indicates an expected call of GetRegionalAppResources.
+func (mr *MockenvDeleterFromAppMockRecorder) GetRegionalAppResources(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegionalAppResources", reflect.TypeOf((*MockenvDeleterFromApp)(nil).GetRegionalAppResources), app)
+}
+
+// RemoveEnvFromApp mocks base method.
+func (m *MockenvDeleterFromApp) RemoveEnvFromApp(opts *cloudformation1.RemoveEnvFromAppOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveEnvFromApp", opts)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // RemoveEnvFromApp indicates an expected call of RemoveEnvFromApp.
@@ -5118,127 +6049,339 @@ func (mr *MockstatusDescriberMockRecorder) Describe() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstatusDescriber)(nil).Describe))
 }
 
-// MockenvDescriber is a mock of envDescriber interface.
-type MockenvDescriber struct {
+// MocktopologyDescriber is a mock of topologyDescriber interface.
+type MocktopologyDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *MockenvDescriberMockRecorder
+	recorder *MocktopologyDescriberMockRecorder
 }
 
-// MockenvDescriberMockRecorder is the mock recorder for MockenvDescriber.
-type MockenvDescriberMockRecorder struct {
-	mock *MockenvDescriber
+// MocktopologyDescriberMockRecorder is the mock recorder for MocktopologyDescriber.
+type MocktopologyDescriberMockRecorder struct {
+	mock *MocktopologyDescriber
 }
 
-// NewMockenvDescriber creates a new mock instance.
-func NewMockenvDescriber(ctrl *gomock.Controller) *MockenvDescriber {
-	mock := &MockenvDescriber{ctrl: ctrl}
-	mock.recorder = &MockenvDescriberMockRecorder{mock}
+// NewMocktopologyDescriber creates a new mock instance.
+func NewMocktopologyDescriber(ctrl *gomock.Controller) *MocktopologyDescriber {
+	mock := &MocktopologyDescriber{ctrl: ctrl}
+	mock.recorder = &MocktopologyDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockenvDescriber) EXPECT() *MockenvDescriberMockRecorder {
+func (m *MocktopologyDescriber) EXPECT() *MocktopologyDescriberMockRecorder {
 	return m.recorder
 }
 
 // Describe mocks base method.
-func (m *MockenvDescriber) Describe() (*describe.EnvDescription, error) {
+func (m *MocktopologyDescriber) Describe() (describe.GraphStringer, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Describe")
-	ret0, _ := ret[0].(*describe.EnvDescription)
+	ret0, _ := ret[0].(describe.GraphStringer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Describe indicates an expected call of Describe.
-func (mr *MockenvDescriberMockRecorder) Describe() *gomock.Call {
+func (mr *MocktopologyDescriberMockRecorder) Describe() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockenvDescriber)(nil).Describe))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MocktopologyDescriber)(nil).Describe))
 }
 
-// Manifest mocks base method.
-func (m *MockenvDescriber) Manifest() ([]byte, error) {
+// MockcostEstimator is a mock of costEstimator interface.
+type MockcostEstimator struct {
+	ctrl     *gomock.Controller
+	recorder *MockcostEstimatorMockRecorder
+}
+
+// MockcostEstimatorMockRecorder is the mock recorder for MockcostEstimator.
+type MockcostEstimatorMockRecorder struct {
+	mock *MockcostEstimator
+}
+
+// NewMockcostEstimator creates a new mock instance.
+func NewMockcostEstimator(ctrl *gomock.Controller) *MockcostEstimator {
+	mock := &MockcostEstimator{ctrl: ctrl}
+	mock.recorder = &MockcostEstimatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcostEstimator) EXPECT() *MockcostEstimatorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method.
+func (m *MockcostEstimator) Application() (*describe.CostEstimate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Manifest")
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "Application")
+	ret0, _ := ret[0].(*describe.CostEstimate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Manifest indicates an expected call of Manifest.
-func (mr *MockenvDescriberMockRecorder) Manifest() *gomock.Call {
+// Application indicates an expected call of Application.
+func (mr *MockcostEstimatorMockRecorder) Application() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Manifest", reflect.TypeOf((*MockenvDescriber)(nil).Manifest))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockcostEstimator)(nil).Application))
 }
 
-// PublicCIDRBlocks mocks base method.
-func (m *MockenvDescriber) PublicCIDRBlocks() ([]string, error) {
+// Environment mocks base method.
+func (m *MockcostEstimator) Environment(name string) (*describe.CostEstimate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PublicCIDRBlocks")
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "Environment", name)
+	ret0, _ := ret[0].(*describe.CostEstimate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PublicCIDRBlocks indicates an expected call of PublicCIDRBlocks.
-func (mr *MockenvDescriberMockRecorder) PublicCIDRBlocks() *gomock.Call {
+// Environment indicates an expected call of Environment.
+func (mr *MockcostEstimatorMockRecorder) Environment(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicCIDRBlocks", reflect.TypeOf((*MockenvDescriber)(nil).PublicCIDRBlocks))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockcostEstimator)(nil).Environment), name)
 }
 
-// ValidateCFServiceDomainAliases mocks base method.
-func (m *MockenvDescriber) ValidateCFServiceDomainAliases() error {
+// Service mocks base method.
+func (m *MockcostEstimator) Service(name string) (*describe.CostEstimate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateCFServiceDomainAliases")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Service", name)
+	ret0, _ := ret[0].(*describe.CostEstimate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ValidateCFServiceDomainAliases indicates an expected call of ValidateCFServiceDomainAliases.
-func (mr *MockenvDescriberMockRecorder) ValidateCFServiceDomainAliases() *gomock.Call {
+// Service indicates an expected call of Service.
+func (mr *MockcostEstimatorMockRecorder) Service(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCFServiceDomainAliases", reflect.TypeOf((*MockenvDescriber)(nil).ValidateCFServiceDomainAliases))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockcostEstimator)(nil).Service), name)
 }
 
-// MockversionCompatibilityChecker is a mock of versionCompatibilityChecker interface.
-type MockversionCompatibilityChecker struct {
+// MockiamPolicyDescriber is a mock of iamPolicyDescriber interface.
+type MockiamPolicyDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *MockversionCompatibilityCheckerMockRecorder
+	recorder *MockiamPolicyDescriberMockRecorder
 }
 
-// MockversionCompatibilityCheckerMockRecorder is the mock recorder for MockversionCompatibilityChecker.
-type MockversionCompatibilityCheckerMockRecorder struct {
-	mock *MockversionCompatibilityChecker
+// MockiamPolicyDescriberMockRecorder is the mock recorder for MockiamPolicyDescriber.
+type MockiamPolicyDescriberMockRecorder struct {
+	mock *MockiamPolicyDescriber
 }
 
-// NewMockversionCompatibilityChecker creates a new mock instance.
-func NewMockversionCompatibilityChecker(ctrl *gomock.Controller) *MockversionCompatibilityChecker {
-	mock := &MockversionCompatibilityChecker{ctrl: ctrl}
-	mock.recorder = &MockversionCompatibilityCheckerMockRecorder{mock}
+// NewMockiamPolicyDescriber creates a new mock instance.
+func NewMockiamPolicyDescriber(ctrl *gomock.Controller) *MockiamPolicyDescriber {
+	mock := &MockiamPolicyDescriber{ctrl: ctrl}
+	mock.recorder = &MockiamPolicyDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockversionCompatibilityChecker) EXPECT() *MockversionCompatibilityCheckerMockRecorder {
+func (m *MockiamPolicyDescriber) EXPECT() *MockiamPolicyDescriberMockRecorder {
 	return m.recorder
 }
 
-// AvailableFeatures mocks base method.
-func (m *MockversionCompatibilityChecker) AvailableFeatures() ([]string, error) {
+// Check mocks base method.
+func (m *MockiamPolicyDescriber) Check() (*describe.IAMPolicies, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AvailableFeatures")
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "Check")
+	ret0, _ := ret[0].(*describe.IAMPolicies)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AvailableFeatures indicates an expected call of AvailableFeatures.
-func (mr *MockversionCompatibilityCheckerMockRecorder) AvailableFeatures() *gomock.Call {
+// Check indicates an expected call of Check.
+func (mr *MockiamPolicyDescriberMockRecorder) Check() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailableFeatures", reflect.TypeOf((*MockversionCompatibilityChecker)(nil).AvailableFeatures))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockiamPolicyDescriber)(nil).Check))
 }
 
-// Version mocks base method.
+// Describe mocks base method.
+func (m *MockiamPolicyDescriber) Describe() (*describe.IAMPolicies, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.IAMPolicies)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockiamPolicyDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockiamPolicyDescriber)(nil).Describe))
+}
+
+// MockenvDescriber is a mock of envDescriber interface.
+type MockenvDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvDescriberMockRecorder
+}
+
+// MockenvDescriberMockRecorder is the mock recorder for MockenvDescriber.
+type MockenvDescriberMockRecorder struct {
+	mock *MockenvDescriber
+}
+
+// NewMockenvDescriber creates a new mock instance.
+func NewMockenvDescriber(ctrl *gomock.Controller) *MockenvDescriber {
+	mock := &MockenvDescriber{ctrl: ctrl}
+	mock.recorder = &MockenvDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvDescriber) EXPECT() *MockenvDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockenvDescriber) Describe() (*describe.EnvDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.EnvDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockenvDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockenvDescriber)(nil).Describe))
+}
+
+// Features mocks base method.
+func (m *MockenvDescriber) Features() (*describe.EnvFeatures, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Features")
+	ret0, _ := ret[0].(*describe.EnvFeatures)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Features indicates an expected call of Features.
+func (mr *MockenvDescriberMockRecorder) Features() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Features", reflect.TypeOf((*MockenvDescriber)(nil).Features))
+}
+
+// Manifest mocks base method.
+func (m *MockenvDescriber) Manifest() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Manifest")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Manifest indicates an expected call of Manifest.
+func (mr *MockenvDescriberMockRecorder) Manifest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Manifest", reflect.TypeOf((*MockenvDescriber)(nil).Manifest))
+}
+
+// PublicCIDRBlocks mocks base method.
+func (m *MockenvDescriber) PublicCIDRBlocks() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicCIDRBlocks")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicCIDRBlocks indicates an expected call of PublicCIDRBlocks.
+func (mr *MockenvDescriberMockRecorder) PublicCIDRBlocks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicCIDRBlocks", reflect.TypeOf((*MockenvDescriber)(nil).PublicCIDRBlocks))
+}
+
+// ValidateCFServiceDomainAliases mocks base method.
+func (m *MockenvDescriber) ValidateCFServiceDomainAliases() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateCFServiceDomainAliases")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateCFServiceDomainAliases indicates an expected call of ValidateCFServiceDomainAliases.
+func (mr *MockenvDescriberMockRecorder) ValidateCFServiceDomainAliases() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateCFServiceDomainAliases", reflect.TypeOf((*MockenvDescriber)(nil).ValidateCFServiceDomainAliases))
+}
+
+// MockenvFeaturesDescriber is a mock of envFeaturesDescriber interface.
+type MockenvFeaturesDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvFeaturesDescriberMockRecorder
+}
+
+// MockenvFeaturesDescriberMockRecorder is the mock recorder for MockenvFeaturesDescriber.
+type MockenvFeaturesDescriberMockRecorder struct {
+	mock *MockenvFeaturesDescriber
+}
+
+// NewMockenvFeaturesDescriber creates a new mock instance.
+func NewMockenvFeaturesDescriber(ctrl *gomock.Controller) *MockenvFeaturesDescriber {
+	mock := &MockenvFeaturesDescriber{ctrl: ctrl}
+	mock.recorder = &MockenvFeaturesDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvFeaturesDescriber) EXPECT() *MockenvFeaturesDescriberMockRecorder {
+	return m.recorder
+}
+
+// Features mocks base method.
+func (m *MockenvFeaturesDescriber) Features() (*describe.EnvFeatures, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Features")
+	ret0, _ := ret[0].(*describe.EnvFeatures)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Features indicates an expected call of Features.
+func (mr *MockenvFeaturesDescriberMockRecorder) Features() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Features", reflect.TypeOf((*MockenvFeaturesDescriber)(nil).Features))
+}
+
+// MockversionCompatibilityChecker is a mock of versionCompatibilityChecker interface.
+type MockversionCompatibilityChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockversionCompatibilityCheckerMockRecorder
+}
+
+// MockversionCompatibilityCheckerMockRecorder is the mock recorder for MockversionCompatibilityChecker.
+type MockversionCompatibilityCheckerMockRecorder struct {
+	mock *MockversionCompatibilityChecker
+}
+
+// NewMockversionCompatibilityChecker creates a new mock instance.
+func NewMockversionCompatibilityChecker(ctrl *gomock.Controller) *MockversionCompatibilityChecker {
+	mock := &MockversionCompatibilityChecker{ctrl: ctrl}
+	mock.recorder = &MockversionCompatibilityCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockversionCompatibilityChecker) EXPECT() *MockversionCompatibilityCheckerMockRecorder {
+	return m.recorder
+}
+
+// AvailableFeatures mocks base method.
+func (m *MockversionCompatibilityChecker) AvailableFeatures() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AvailableFeatures")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AvailableFeatures indicates an expected call of AvailableFeatures.
+func (mr *MockversionCompatibilityCheckerMockRecorder) AvailableFeatures() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailableFeatures", reflect.TypeOf((*MockversionCompatibilityChecker)(nil).AvailableFeatures))
+}
+
+// Version mocks base method.
 func (m *MockversionCompatibilityChecker) Version() (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Version")
@@ -5328,664 +6471,591 @@ func (mr *MockappUpgraderMockRecorder) UpgradeApplication(in interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeApplication", reflect.TypeOf((*MockappUpgrader)(nil).UpgradeApplication), in)
 }
 
-// MockpipelineGetter is a mock of pipelineGetter interface.
-type MockpipelineGetter struct {
+// MockquotaChecker is a mock of quotaChecker interface.
+type MockquotaChecker struct {
 	ctrl     *gomock.Controller
-	recorder *MockpipelineGetterMockRecorder
+	recorder *MockquotaCheckerMockRecorder
 }
 
-// MockpipelineGetterMockRecorder is the mock recorder for MockpipelineGetter.
-type MockpipelineGetterMockRecorder struct {
-	mock *MockpipelineGetter
+// MockquotaCheckerMockRecorder is the mock recorder for MockquotaChecker.
+type MockquotaCheckerMockRecorder struct {
+	mock *MockquotaChecker
 }
 
-// NewMockpipelineGetter creates a new mock instance.
-func NewMockpipelineGetter(ctrl *gomock.Controller) *MockpipelineGetter {
-	mock := &MockpipelineGetter{ctrl: ctrl}
-	mock.recorder = &MockpipelineGetterMockRecorder{mock}
+// NewMockquotaChecker creates a new mock instance.
+func NewMockquotaChecker(ctrl *gomock.Controller) *MockquotaChecker {
+	mock := &MockquotaChecker{ctrl: ctrl}
+	mock.recorder = &MockquotaCheckerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockpipelineGetter) EXPECT() *MockpipelineGetterMockRecorder {
+func (m *MockquotaChecker) EXPECT() *MockquotaCheckerMockRecorder {
 	return m.recorder
 }
 
-// GetPipeline mocks base method.
-func (m *MockpipelineGetter) GetPipeline(pipelineName string) (*codepipeline.Pipeline, error) {
+// Check mocks base method.
+func (m *MockquotaChecker) Check(needs quota.Needs) ([]quota.Usage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPipeline", pipelineName)
-	ret0, _ := ret[0].(*codepipeline.Pipeline)
+	ret := m.ctrl.Call(m, "Check", needs)
+	ret0, _ := ret[0].([]quota.Usage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPipeline indicates an expected call of GetPipeline.
-func (mr *MockpipelineGetterMockRecorder) GetPipeline(pipelineName interface{}) *gomock.Call {
+// Check indicates an expected call of Check.
+func (mr *MockquotaCheckerMockRecorder) Check(needs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipeline), pipelineName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockquotaChecker)(nil).Check), needs)
 }
 
-// MockdeployedPipelineLister is a mock of deployedPipelineLister interface.
-type MockdeployedPipelineLister struct {
+// RequestIncrease mocks base method.
+func (m *MockquotaChecker) RequestIncrease(usage quota.Usage) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestIncrease", usage)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestIncrease indicates an expected call of RequestIncrease.
+func (mr *MockquotaCheckerMockRecorder) RequestIncrease(usage interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestIncrease", reflect.TypeOf((*MockquotaChecker)(nil).RequestIncrease), usage)
+}
+
+// MockenvOutputsGetter is a mock of envOutputsGetter interface.
+type MockenvOutputsGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockdeployedPipelineListerMockRecorder
+	recorder *MockenvOutputsGetterMockRecorder
 }
 
-// MockdeployedPipelineListerMockRecorder is the mock recorder for MockdeployedPipelineLister.
-type MockdeployedPipelineListerMockRecorder struct {
-	mock *MockdeployedPipelineLister
+// MockenvOutputsGetterMockRecorder is the mock recorder for MockenvOutputsGetter.
+type MockenvOutputsGetterMockRecorder struct {
+	mock *MockenvOutputsGetter
 }
 
-// NewMockdeployedPipelineLister creates a new mock instance.
-func NewMockdeployedPipelineLister(ctrl *gomock.Controller) *MockdeployedPipelineLister {
-	mock := &MockdeployedPipelineLister{ctrl: ctrl}
-	mock.recorder = &MockdeployedPipelineListerMockRecorder{mock}
+// NewMockenvOutputsGetter creates a new mock instance.
+func NewMockenvOutputsGetter(ctrl *gomock.Controller) *MockenvOutputsGetter {
+	mock := &MockenvOutputsGetter{ctrl: ctrl}
+	mock.recorder = &MockenvOutputsGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockdeployedPipelineLister) EXPECT() *MockdeployedPipelineListerMockRecorder {
+func (m *MockenvOutputsGetter) EXPECT() *MockenvOutputsGetterMockRecorder {
 	return m.recorder
 }
 
-// ListDeployedPipelines mocks base method.
-func (m *MockdeployedPipelineLister) ListDeployedPipelines(appName string) ([]deploy0.Pipeline, error) {
+// Outputs mocks base method.
+func (m *MockenvOutputsGetter) Outputs() (map[string]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDeployedPipelines", appName)
-	ret0, _ := ret[0].([]deploy0.Pipeline)
+	ret := m.ctrl.Call(m, "Outputs")
+	ret0, _ := ret[0].(map[string]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListDeployedPipelines indicates an expected call of ListDeployedPipelines.
-func (mr *MockdeployedPipelineListerMockRecorder) ListDeployedPipelines(appName interface{}) *gomock.Call {
+// Outputs indicates an expected call of Outputs.
+func (mr *MockenvOutputsGetterMockRecorder) Outputs() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedPipelines", reflect.TypeOf((*MockdeployedPipelineLister)(nil).ListDeployedPipelines), appName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MockenvOutputsGetter)(nil).Outputs))
 }
 
-// Mockexecutor is a mock of executor interface.
-type Mockexecutor struct {
+// MockstackLocker is a mock of stackLocker interface.
+type MockstackLocker struct {
 	ctrl     *gomock.Controller
-	recorder *MockexecutorMockRecorder
+	recorder *MockstackLockerMockRecorder
 }
 
-// MockexecutorMockRecorder is the mock recorder for Mockexecutor.
-type MockexecutorMockRecorder struct {
-	mock *Mockexecutor
+// MockstackLockerMockRecorder is the mock recorder for MockstackLocker.
+type MockstackLockerMockRecorder struct {
+	mock *MockstackLocker
 }
 
-// NewMockexecutor creates a new mock instance.
-func NewMockexecutor(ctrl *gomock.Controller) *Mockexecutor {
-	mock := &Mockexecutor{ctrl: ctrl}
-	mock.recorder = &MockexecutorMockRecorder{mock}
+// NewMockstackLocker creates a new mock instance.
+func NewMockstackLocker(ctrl *gomock.Controller) *MockstackLocker {
+	mock := &MockstackLocker{ctrl: ctrl}
+	mock.recorder = &MockstackLockerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *Mockexecutor) EXPECT() *MockexecutorMockRecorder {
+func (m *MockstackLocker) EXPECT() *MockstackLockerMockRecorder {
 	return m.recorder
 }
 
-// Execute mocks base method.
-func (m *Mockexecutor) Execute() error {
+// Lock mocks base method.
+func (m *MockstackLocker) Lock(app, stack, command string) (func() error, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Lock", app, stack, command)
+	ret0, _ := ret[0].(func() error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Execute indicates an expected call of Execute.
-func (mr *MockexecutorMockRecorder) Execute() *gomock.Call {
+// Lock indicates an expected call of Lock.
+func (mr *MockstackLockerMockRecorder) Lock(app, stack, command interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*Mockexecutor)(nil).Execute))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockstackLocker)(nil).Lock), app, stack, command)
 }
 
-// MockexecuteAsker is a mock of executeAsker interface.
-type MockexecuteAsker struct {
+// MockstackLockManager is a mock of stackLockManager interface.
+type MockstackLockManager struct {
 	ctrl     *gomock.Controller
-	recorder *MockexecuteAskerMockRecorder
+	recorder *MockstackLockManagerMockRecorder
 }
 
-// MockexecuteAskerMockRecorder is the mock recorder for MockexecuteAsker.
-type MockexecuteAskerMockRecorder struct {
-	mock *MockexecuteAsker
+// MockstackLockManagerMockRecorder is the mock recorder for MockstackLockManager.
+type MockstackLockManagerMockRecorder struct {
+	mock *MockstackLockManager
 }
 
-// NewMockexecuteAsker creates a new mock instance.
-func NewMockexecuteAsker(ctrl *gomock.Controller) *MockexecuteAsker {
-	mock := &MockexecuteAsker{ctrl: ctrl}
-	mock.recorder = &MockexecuteAskerMockRecorder{mock}
+// NewMockstackLockManager creates a new mock instance.
+func NewMockstackLockManager(ctrl *gomock.Controller) *MockstackLockManager {
+	mock := &MockstackLockManager{ctrl: ctrl}
+	mock.recorder = &MockstackLockManagerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockexecuteAsker) EXPECT() *MockexecuteAskerMockRecorder {
+func (m *MockstackLockManager) EXPECT() *MockstackLockManagerMockRecorder {
 	return m.recorder
 }
 
-// Ask mocks base method.
-func (m *MockexecuteAsker) Ask() error {
+// List mocks base method.
+func (m *MockstackLockManager) List(app string) ([]lock.Lock, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Ask")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "List", app)
+	ret0, _ := ret[0].([]lock.Lock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Ask indicates an expected call of Ask.
-func (mr *MockexecuteAskerMockRecorder) Ask() *gomock.Call {
+// List indicates an expected call of List.
+func (mr *MockstackLockManagerMockRecorder) List(app interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ask", reflect.TypeOf((*MockexecuteAsker)(nil).Ask))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockstackLockManager)(nil).List), app)
 }
 
-// Execute mocks base method.
-func (m *MockexecuteAsker) Execute() error {
+// Release mocks base method.
+func (m *MockstackLockManager) Release(app, stack string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Execute")
+	ret := m.ctrl.Call(m, "Release", app, stack)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Execute indicates an expected call of Execute.
-func (mr *MockexecuteAskerMockRecorder) Execute() *gomock.Call {
+// Release indicates an expected call of Release.
+func (mr *MockstackLockManagerMockRecorder) Release(app, stack interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockexecuteAsker)(nil).Execute))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockstackLockManager)(nil).Release), app, stack)
 }
 
-// MockappSelector is a mock of appSelector interface.
-type MockappSelector struct {
+// MockconfigStoreMigrator is a mock of configStoreMigrator interface.
+type MockconfigStoreMigrator struct {
 	ctrl     *gomock.Controller
-	recorder *MockappSelectorMockRecorder
+	recorder *MockconfigStoreMigratorMockRecorder
 }
 
-// MockappSelectorMockRecorder is the mock recorder for MockappSelector.
-type MockappSelectorMockRecorder struct {
-	mock *MockappSelector
+// MockconfigStoreMigratorMockRecorder is the mock recorder for MockconfigStoreMigrator.
+type MockconfigStoreMigratorMockRecorder struct {
+	mock *MockconfigStoreMigrator
 }
 
-// NewMockappSelector creates a new mock instance.
-func NewMockappSelector(ctrl *gomock.Controller) *MockappSelector {
-	mock := &MockappSelector{ctrl: ctrl}
-	mock.recorder = &MockappSelectorMockRecorder{mock}
+// NewMockconfigStoreMigrator creates a new mock instance.
+func NewMockconfigStoreMigrator(ctrl *gomock.Controller) *MockconfigStoreMigrator {
+	mock := &MockconfigStoreMigrator{ctrl: ctrl}
+	mock.recorder = &MockconfigStoreMigratorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockappSelector) EXPECT() *MockappSelectorMockRecorder {
+func (m *MockconfigStoreMigrator) EXPECT() *MockconfigStoreMigratorMockRecorder {
 	return m.recorder
 }
 
-// Application mocks base method.
-func (m *MockappSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+// MigrateApplication mocks base method.
+func (m *MockconfigStoreMigrator) MigrateApplication(appName, backend string) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Application", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "MigrateApplication", appName, backend)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Application indicates an expected call of Application.
-func (mr *MockappSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+// MigrateApplication indicates an expected call of MigrateApplication.
+func (mr *MockconfigStoreMigratorMockRecorder) MigrateApplication(appName, backend interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockappSelector)(nil).Application), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateApplication", reflect.TypeOf((*MockconfigStoreMigrator)(nil).MigrateApplication), appName, backend)
 }
 
-// MockappEnvSelector is a mock of appEnvSelector interface.
-type MockappEnvSelector struct {
+// MockappTagsUpdater is a mock of appTagsUpdater interface.
+type MockappTagsUpdater struct {
 	ctrl     *gomock.Controller
-	recorder *MockappEnvSelectorMockRecorder
+	recorder *MockappTagsUpdaterMockRecorder
 }
 
-// MockappEnvSelectorMockRecorder is the mock recorder for MockappEnvSelector.
-type MockappEnvSelectorMockRecorder struct {
-	mock *MockappEnvSelector
+// MockappTagsUpdaterMockRecorder is the mock recorder for MockappTagsUpdater.
+type MockappTagsUpdaterMockRecorder struct {
+	mock *MockappTagsUpdater
 }
 
-// NewMockappEnvSelector creates a new mock instance.
-func NewMockappEnvSelector(ctrl *gomock.Controller) *MockappEnvSelector {
-	mock := &MockappEnvSelector{ctrl: ctrl}
-	mock.recorder = &MockappEnvSelectorMockRecorder{mock}
+// NewMockappTagsUpdater creates a new mock instance.
+func NewMockappTagsUpdater(ctrl *gomock.Controller) *MockappTagsUpdater {
+	mock := &MockappTagsUpdater{ctrl: ctrl}
+	mock.recorder = &MockappTagsUpdaterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockappEnvSelector) EXPECT() *MockappEnvSelectorMockRecorder {
+func (m *MockappTagsUpdater) EXPECT() *MockappTagsUpdaterMockRecorder {
 	return m.recorder
 }
 
-// Application mocks base method.
-func (m *MockappEnvSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
-	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Application", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Application indicates an expected call of Application.
-func (mr *MockappEnvSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockappEnvSelector)(nil).Application), varargs...)
-}
-
-// Environment mocks base method.
-func (m *MockappEnvSelector) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
+// UpdateApplicationTags mocks base method.
+func (m *MockappTagsUpdater) UpdateApplicationTags(in *deploy0.CreateAppInput) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help, app}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Environment", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "UpdateApplicationTags", in)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Environment indicates an expected call of Environment.
-func (mr *MockappEnvSelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
+// UpdateApplicationTags indicates an expected call of UpdateApplicationTags.
+func (mr *MockappTagsUpdaterMockRecorder) UpdateApplicationTags(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help, app}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockappEnvSelector)(nil).Environment), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplicationTags", reflect.TypeOf((*MockappTagsUpdater)(nil).UpdateApplicationTags), in)
 }
 
-// MockcfnSelector is a mock of cfnSelector interface.
-type MockcfnSelector struct {
+// MockenvStacksTagsUpdater is a mock of envStacksTagsUpdater interface.
+type MockenvStacksTagsUpdater struct {
 	ctrl     *gomock.Controller
-	recorder *MockcfnSelectorMockRecorder
+	recorder *MockenvStacksTagsUpdaterMockRecorder
 }
 
-// MockcfnSelectorMockRecorder is the mock recorder for MockcfnSelector.
-type MockcfnSelectorMockRecorder struct {
-	mock *MockcfnSelector
+// MockenvStacksTagsUpdaterMockRecorder is the mock recorder for MockenvStacksTagsUpdater.
+type MockenvStacksTagsUpdaterMockRecorder struct {
+	mock *MockenvStacksTagsUpdater
 }
 
-// NewMockcfnSelector creates a new mock instance.
-func NewMockcfnSelector(ctrl *gomock.Controller) *MockcfnSelector {
-	mock := &MockcfnSelector{ctrl: ctrl}
-	mock.recorder = &MockcfnSelectorMockRecorder{mock}
+// NewMockenvStacksTagsUpdater creates a new mock instance.
+func NewMockenvStacksTagsUpdater(ctrl *gomock.Controller) *MockenvStacksTagsUpdater {
+	mock := &MockenvStacksTagsUpdater{ctrl: ctrl}
+	mock.recorder = &MockenvStacksTagsUpdaterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockcfnSelector) EXPECT() *MockcfnSelectorMockRecorder {
+func (m *MockenvStacksTagsUpdater) EXPECT() *MockenvStacksTagsUpdaterMockRecorder {
 	return m.recorder
 }
 
-// Resources mocks base method.
-func (m *MockcfnSelector) Resources(msg, finalMsg, help, body string) ([]template.CFNResource, error) {
+// ListEnvironmentStacks mocks base method.
+func (m *MockenvStacksTagsUpdater) ListEnvironmentStacks(appName, envName string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Resources", msg, finalMsg, help, body)
-	ret0, _ := ret[0].([]template.CFNResource)
+	ret := m.ctrl.Call(m, "ListEnvironmentStacks", appName, envName)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Resources indicates an expected call of Resources.
-func (mr *MockcfnSelectorMockRecorder) Resources(msg, finalMsg, help, body interface{}) *gomock.Call {
+// ListEnvironmentStacks indicates an expected call of ListEnvironmentStacks.
+func (mr *MockenvStacksTagsUpdaterMockRecorder) ListEnvironmentStacks(appName, envName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockcfnSelector)(nil).Resources), msg, finalMsg, help, body)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironmentStacks", reflect.TypeOf((*MockenvStacksTagsUpdater)(nil).ListEnvironmentStacks), appName, envName)
 }
 
-// MockconfigSelector is a mock of configSelector interface.
-type MockconfigSelector struct {
+// UpdateStackTags mocks base method.
+func (m *MockenvStacksTagsUpdater) UpdateStackTags(stackName string, added map[string]string, removed []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStackTags", stackName, added, removed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStackTags indicates an expected call of UpdateStackTags.
+func (mr *MockenvStacksTagsUpdaterMockRecorder) UpdateStackTags(stackName, added, removed interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStackTags", reflect.TypeOf((*MockenvStacksTagsUpdater)(nil).UpdateStackTags), stackName, added, removed)
+}
+
+// MockpipelineGetter is a mock of pipelineGetter interface.
+type MockpipelineGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockconfigSelectorMockRecorder
+	recorder *MockpipelineGetterMockRecorder
 }
 
-// MockconfigSelectorMockRecorder is the mock recorder for MockconfigSelector.
-type MockconfigSelectorMockRecorder struct {
-	mock *MockconfigSelector
+// MockpipelineGetterMockRecorder is the mock recorder for MockpipelineGetter.
+type MockpipelineGetterMockRecorder struct {
+	mock *MockpipelineGetter
 }
 
-// NewMockconfigSelector creates a new mock instance.
-func NewMockconfigSelector(ctrl *gomock.Controller) *MockconfigSelector {
-	mock := &MockconfigSelector{ctrl: ctrl}
-	mock.recorder = &MockconfigSelectorMockRecorder{mock}
+// NewMockpipelineGetter creates a new mock instance.
+func NewMockpipelineGetter(ctrl *gomock.Controller) *MockpipelineGetter {
+	mock := &MockpipelineGetter{ctrl: ctrl}
+	mock.recorder = &MockpipelineGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockconfigSelector) EXPECT() *MockconfigSelectorMockRecorder {
+func (m *MockpipelineGetter) EXPECT() *MockpipelineGetterMockRecorder {
 	return m.recorder
 }
 
-// Application mocks base method.
-func (m *MockconfigSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+// GetPipeline mocks base method.
+func (m *MockpipelineGetter) GetPipeline(pipelineName string) (*codepipeline.Pipeline, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Application", varargs...)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetPipeline", pipelineName)
+	ret0, _ := ret[0].(*codepipeline.Pipeline)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Application indicates an expected call of Application.
-func (mr *MockconfigSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+// GetPipeline indicates an expected call of GetPipeline.
+func (mr *MockpipelineGetterMockRecorder) GetPipeline(pipelineName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockconfigSelector)(nil).Application), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipeline", reflect.TypeOf((*MockpipelineGetter)(nil).GetPipeline), pipelineName)
 }
 
-// Environment mocks base method.
-func (m *MockconfigSelector) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
-	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help, app}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Environment", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// MockdeployedPipelineLister is a mock of deployedPipelineLister interface.
+type MockdeployedPipelineLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployedPipelineListerMockRecorder
 }
 
-// Environment indicates an expected call of Environment.
-func (mr *MockconfigSelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help, app}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockconfigSelector)(nil).Environment), varargs...)
+// MockdeployedPipelineListerMockRecorder is the mock recorder for MockdeployedPipelineLister.
+type MockdeployedPipelineListerMockRecorder struct {
+	mock *MockdeployedPipelineLister
 }
 
-// Job mocks base method.
-func (m *MockconfigSelector) Job(prompt, help, app string) (string, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Job", prompt, help, app)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// NewMockdeployedPipelineLister creates a new mock instance.
+func NewMockdeployedPipelineLister(ctrl *gomock.Controller) *MockdeployedPipelineLister {
+	mock := &MockdeployedPipelineLister{ctrl: ctrl}
+	mock.recorder = &MockdeployedPipelineListerMockRecorder{mock}
+	return mock
 }
 
-// Job indicates an expected call of Job.
-func (mr *MockconfigSelectorMockRecorder) Job(prompt, help, app interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockconfigSelector)(nil).Job), prompt, help, app)
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeployedPipelineLister) EXPECT() *MockdeployedPipelineListerMockRecorder {
+	return m.recorder
 }
 
-// Service mocks base method.
-func (m *MockconfigSelector) Service(prompt, help, app string) (string, error) {
+// ListDeployedPipelines mocks base method.
+func (m *MockdeployedPipelineLister) ListDeployedPipelines(appName string) ([]deploy0.Pipeline, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Service", prompt, help, app)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ListDeployedPipelines", appName)
+	ret0, _ := ret[0].([]deploy0.Pipeline)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Service indicates an expected call of Service.
-func (mr *MockconfigSelectorMockRecorder) Service(prompt, help, app interface{}) *gomock.Call {
+// ListDeployedPipelines indicates an expected call of ListDeployedPipelines.
+func (mr *MockdeployedPipelineListerMockRecorder) ListDeployedPipelines(appName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockconfigSelector)(nil).Service), prompt, help, app)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployedPipelines", reflect.TypeOf((*MockdeployedPipelineLister)(nil).ListDeployedPipelines), appName)
 }
 
-// Workload mocks base method.
-func (m *MockconfigSelector) Workload(prompt, help, app string) (string, error) {
+// MockpipelineStatusWatcher is a mock of pipelineStatusWatcher interface.
+type MockpipelineStatusWatcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineStatusWatcherMockRecorder
+}
+
+// MockpipelineStatusWatcherMockRecorder is the mock recorder for MockpipelineStatusWatcher.
+type MockpipelineStatusWatcherMockRecorder struct {
+	mock *MockpipelineStatusWatcher
+}
+
+// NewMockpipelineStatusWatcher creates a new mock instance.
+func NewMockpipelineStatusWatcher(ctrl *gomock.Controller) *MockpipelineStatusWatcher {
+	mock := &MockpipelineStatusWatcher{ctrl: ctrl}
+	mock.recorder = &MockpipelineStatusWatcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineStatusWatcher) EXPECT() *MockpipelineStatusWatcherMockRecorder {
+	return m.recorder
+}
+
+// Watch mocks base method.
+func (m *MockpipelineStatusWatcher) Watch(write func(describe.HumanJSONStringer) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Workload", prompt, help, app)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Watch", write)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Workload indicates an expected call of Workload.
-func (mr *MockconfigSelectorMockRecorder) Workload(prompt, help, app interface{}) *gomock.Call {
+// Watch indicates an expected call of Watch.
+func (mr *MockpipelineStatusWatcherMockRecorder) Watch(write interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workload", reflect.TypeOf((*MockconfigSelector)(nil).Workload), prompt, help, app)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockpipelineStatusWatcher)(nil).Watch), write)
 }
 
-// MockdeploySelector is a mock of deploySelector interface.
-type MockdeploySelector struct {
+// MockpipelineStageRetrier is a mock of pipelineStageRetrier interface.
+type MockpipelineStageRetrier struct {
 	ctrl     *gomock.Controller
-	recorder *MockdeploySelectorMockRecorder
+	recorder *MockpipelineStageRetrierMockRecorder
 }
 
-// MockdeploySelectorMockRecorder is the mock recorder for MockdeploySelector.
-type MockdeploySelectorMockRecorder struct {
-	mock *MockdeploySelector
+// MockpipelineStageRetrierMockRecorder is the mock recorder for MockpipelineStageRetrier.
+type MockpipelineStageRetrierMockRecorder struct {
+	mock *MockpipelineStageRetrier
 }
 
-// NewMockdeploySelector creates a new mock instance.
-func NewMockdeploySelector(ctrl *gomock.Controller) *MockdeploySelector {
-	mock := &MockdeploySelector{ctrl: ctrl}
-	mock.recorder = &MockdeploySelectorMockRecorder{mock}
+// NewMockpipelineStageRetrier creates a new mock instance.
+func NewMockpipelineStageRetrier(ctrl *gomock.Controller) *MockpipelineStageRetrier {
+	mock := &MockpipelineStageRetrier{ctrl: ctrl}
+	mock.recorder = &MockpipelineStageRetrierMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockdeploySelector) EXPECT() *MockdeploySelectorMockRecorder {
+func (m *MockpipelineStageRetrier) EXPECT() *MockpipelineStageRetrierMockRecorder {
 	return m.recorder
 }
 
-// Application mocks base method.
-func (m *MockdeploySelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+// GetPipelineState mocks base method.
+func (m *MockpipelineStageRetrier) GetPipelineState(pipelineName string) (*codepipeline.PipelineState, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range additionalOpts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Application", varargs...)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetPipelineState", pipelineName)
+	ret0, _ := ret[0].(*codepipeline.PipelineState)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Application indicates an expected call of Application.
-func (mr *MockdeploySelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+// GetPipelineState indicates an expected call of GetPipelineState.
+func (mr *MockpipelineStageRetrierMockRecorder) GetPipelineState(pipelineName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockdeploySelector)(nil).Application), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineState", reflect.TypeOf((*MockpipelineStageRetrier)(nil).GetPipelineState), pipelineName)
 }
 
-// DeployedJob mocks base method.
-func (m *MockdeploySelector) DeployedJob(prompt, help, app string, opts ...selector.GetDeployedWorkloadOpts) (*selector.DeployedJob, error) {
+// RetryStageExecution mocks base method.
+func (m *MockpipelineStageRetrier) RetryStageExecution(pipelineName, stageName string) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help, app}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "DeployedJob", varargs...)
-	ret0, _ := ret[0].(*selector.DeployedJob)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RetryStageExecution", pipelineName, stageName)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// DeployedJob indicates an expected call of DeployedJob.
-func (mr *MockdeploySelectorMockRecorder) DeployedJob(prompt, help, app interface{}, opts ...interface{}) *gomock.Call {
+// RetryStageExecution indicates an expected call of RetryStageExecution.
+func (mr *MockpipelineStageRetrierMockRecorder) RetryStageExecution(pipelineName, stageName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help, app}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedJob", reflect.TypeOf((*MockdeploySelector)(nil).DeployedJob), varargs...)
-}
-
-// DeployedService mocks base method.
-func (m *MockdeploySelector) DeployedService(prompt, help, app string, opts ...selector.GetDeployedWorkloadOpts) (*selector.DeployedService, error) {
-	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help, app}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "DeployedService", varargs...)
-	ret0, _ := ret[0].(*selector.DeployedService)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// DeployedService indicates an expected call of DeployedService.
-func (mr *MockdeploySelectorMockRecorder) DeployedService(prompt, help, app interface{}, opts ...interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help, app}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedService", reflect.TypeOf((*MockdeploySelector)(nil).DeployedService), varargs...)
-}
-
-// DeployedWorkload mocks base method.
-func (m *MockdeploySelector) DeployedWorkload(prompt, help, app string, opts ...selector.GetDeployedWorkloadOpts) (*selector.DeployedWorkload, error) {
-	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help, app}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "DeployedWorkload", varargs...)
-	ret0, _ := ret[0].(*selector.DeployedWorkload)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// DeployedWorkload indicates an expected call of DeployedWorkload.
-func (mr *MockdeploySelectorMockRecorder) DeployedWorkload(prompt, help, app interface{}, opts ...interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help, app}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedWorkload", reflect.TypeOf((*MockdeploySelector)(nil).DeployedWorkload), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryStageExecution", reflect.TypeOf((*MockpipelineStageRetrier)(nil).RetryStageExecution), pipelineName, stageName)
 }
 
-// MockpipelineEnvSelector is a mock of pipelineEnvSelector interface.
-type MockpipelineEnvSelector struct {
+// Mockexecutor is a mock of executor interface.
+type Mockexecutor struct {
 	ctrl     *gomock.Controller
-	recorder *MockpipelineEnvSelectorMockRecorder
+	recorder *MockexecutorMockRecorder
 }
 
-// MockpipelineEnvSelectorMockRecorder is the mock recorder for MockpipelineEnvSelector.
-type MockpipelineEnvSelectorMockRecorder struct {
-	mock *MockpipelineEnvSelector
+// MockexecutorMockRecorder is the mock recorder for Mockexecutor.
+type MockexecutorMockRecorder struct {
+	mock *Mockexecutor
 }
 
-// NewMockpipelineEnvSelector creates a new mock instance.
-func NewMockpipelineEnvSelector(ctrl *gomock.Controller) *MockpipelineEnvSelector {
-	mock := &MockpipelineEnvSelector{ctrl: ctrl}
-	mock.recorder = &MockpipelineEnvSelectorMockRecorder{mock}
+// NewMockexecutor creates a new mock instance.
+func NewMockexecutor(ctrl *gomock.Controller) *Mockexecutor {
+	mock := &Mockexecutor{ctrl: ctrl}
+	mock.recorder = &MockexecutorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockpipelineEnvSelector) EXPECT() *MockpipelineEnvSelectorMockRecorder {
+func (m *Mockexecutor) EXPECT() *MockexecutorMockRecorder {
 	return m.recorder
 }
 
-// Environments mocks base method.
-func (m *MockpipelineEnvSelector) Environments(prompt, help, app string, finalMsgFunc func(int) prompt.PromptConfig) ([]string, error) {
+// Execute mocks base method.
+func (m *Mockexecutor) Execute() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Environments", prompt, help, app, finalMsgFunc)
-	ret0, _ := ret[0].([]string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Execute")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Environments indicates an expected call of Environments.
-func (mr *MockpipelineEnvSelectorMockRecorder) Environments(prompt, help, app, finalMsgFunc interface{}) *gomock.Call {
+// Execute indicates an expected call of Execute.
+func (mr *MockexecutorMockRecorder) Execute() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environments", reflect.TypeOf((*MockpipelineEnvSelector)(nil).Environments), prompt, help, app, finalMsgFunc)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*Mockexecutor)(nil).Execute))
 }
 
-// MockwsPipelineSelector is a mock of wsPipelineSelector interface.
-type MockwsPipelineSelector struct {
+// MockexecuteAsker is a mock of executeAsker interface.
+type MockexecuteAsker struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsPipelineSelectorMockRecorder
+	recorder *MockexecuteAskerMockRecorder
 }
 
-// MockwsPipelineSelectorMockRecorder is the mock recorder for MockwsPipelineSelector.
-type MockwsPipelineSelectorMockRecorder struct {
-	mock *MockwsPipelineSelector
+// MockexecuteAskerMockRecorder is the mock recorder for MockexecuteAsker.
+type MockexecuteAskerMockRecorder struct {
+	mock *MockexecuteAsker
 }
 
-// NewMockwsPipelineSelector creates a new mock instance.
-func NewMockwsPipelineSelector(ctrl *gomock.Controller) *MockwsPipelineSelector {
-	mock := &MockwsPipelineSelector{ctrl: ctrl}
-	mock.recorder = &MockwsPipelineSelectorMockRecorder{mock}
+// NewMockexecuteAsker creates a new mock instance.
+func NewMockexecuteAsker(ctrl *gomock.Controller) *MockexecuteAsker {
+	mock := &MockexecuteAsker{ctrl: ctrl}
+	mock.recorder = &MockexecuteAskerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockwsPipelineSelector) EXPECT() *MockwsPipelineSelectorMockRecorder {
+func (m *MockexecuteAsker) EXPECT() *MockexecuteAskerMockRecorder {
 	return m.recorder
 }
 
-// WsPipeline mocks base method.
-func (m *MockwsPipelineSelector) WsPipeline(prompt, help string) (*workspace.PipelineManifest, error) {
+// Ask mocks base method.
+func (m *MockexecuteAsker) Ask() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WsPipeline", prompt, help)
-	ret0, _ := ret[0].(*workspace.PipelineManifest)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Ask")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// WsPipeline indicates an expected call of WsPipeline.
-func (mr *MockwsPipelineSelectorMockRecorder) WsPipeline(prompt, help interface{}) *gomock.Call {
+// Ask indicates an expected call of Ask.
+func (mr *MockexecuteAskerMockRecorder) Ask() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WsPipeline", reflect.TypeOf((*MockwsPipelineSelector)(nil).WsPipeline), prompt, help)
-}
-
-// MockwsEnvironmentSelector is a mock of wsEnvironmentSelector interface.
-type MockwsEnvironmentSelector struct {
-	ctrl     *gomock.Controller
-	recorder *MockwsEnvironmentSelectorMockRecorder
-}
-
-// MockwsEnvironmentSelectorMockRecorder is the mock recorder for MockwsEnvironmentSelector.
-type MockwsEnvironmentSelectorMockRecorder struct {
-	mock *MockwsEnvironmentSelector
-}
-
-// NewMockwsEnvironmentSelector creates a new mock instance.
-func NewMockwsEnvironmentSelector(ctrl *gomock.Controller) *MockwsEnvironmentSelector {
-	mock := &MockwsEnvironmentSelector{ctrl: ctrl}
-	mock.recorder = &MockwsEnvironmentSelectorMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockwsEnvironmentSelector) EXPECT() *MockwsEnvironmentSelectorMockRecorder {
-	return m.recorder
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ask", reflect.TypeOf((*MockexecuteAsker)(nil).Ask))
 }
 
-// LocalEnvironment mocks base method.
-func (m *MockwsEnvironmentSelector) LocalEnvironment(msg, help string) (string, error) {
+// Execute mocks base method.
+func (m *MockexecuteAsker) Execute() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LocalEnvironment", msg, help)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Execute")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// LocalEnvironment indicates an expected call of LocalEnvironment.
-func (mr *MockwsEnvironmentSelectorMockRecorder) LocalEnvironment(msg, help interface{}) *gomock.Call {
+// Execute indicates an expected call of Execute.
+func (mr *MockexecuteAskerMockRecorder) Execute() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LocalEnvironment", reflect.TypeOf((*MockwsEnvironmentSelector)(nil).LocalEnvironment), msg, help)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockexecuteAsker)(nil).Execute))
 }
 
-// MockcodePipelineSelector is a mock of codePipelineSelector interface.
-type MockcodePipelineSelector struct {
+// MockappSelector is a mock of appSelector interface.
+type MockappSelector struct {
 	ctrl     *gomock.Controller
-	recorder *MockcodePipelineSelectorMockRecorder
+	recorder *MockappSelectorMockRecorder
 }
 
-// MockcodePipelineSelectorMockRecorder is the mock recorder for MockcodePipelineSelector.
-type MockcodePipelineSelectorMockRecorder struct {
-	mock *MockcodePipelineSelector
+// MockappSelectorMockRecorder is the mock recorder for MockappSelector.
+type MockappSelectorMockRecorder struct {
+	mock *MockappSelector
 }
 
-// NewMockcodePipelineSelector creates a new mock instance.
-func NewMockcodePipelineSelector(ctrl *gomock.Controller) *MockcodePipelineSelector {
-	mock := &MockcodePipelineSelector{ctrl: ctrl}
-	mock.recorder = &MockcodePipelineSelectorMockRecorder{mock}
+// NewMockappSelector creates a new mock instance.
+func NewMockappSelector(ctrl *gomock.Controller) *MockappSelector {
+	mock := &MockappSelector{ctrl: ctrl}
+	mock.recorder = &MockappSelectorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockcodePipelineSelector) EXPECT() *MockcodePipelineSelectorMockRecorder {
+func (m *MockappSelector) EXPECT() *MockappSelectorMockRecorder {
 	return m.recorder
 }
 
 // Application mocks base method.
-func (m *MockcodePipelineSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+func (m *MockappSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{prompt, help}
 	for _, a := range additionalOpts {
@@ -5998,52 +7068,37 @@ func (m *MockcodePipelineSelector) Application(prompt, help string, additionalOp
 }
 
 // Application indicates an expected call of Application.
-func (mr *MockcodePipelineSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+func (mr *MockappSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{prompt, help}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockcodePipelineSelector)(nil).Application), varargs...)
-}
-
-// DeployedPipeline mocks base method.
-func (m *MockcodePipelineSelector) DeployedPipeline(prompt, help, app string) (deploy0.Pipeline, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployedPipeline", prompt, help, app)
-	ret0, _ := ret[0].(deploy0.Pipeline)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// DeployedPipeline indicates an expected call of DeployedPipeline.
-func (mr *MockcodePipelineSelectorMockRecorder) DeployedPipeline(prompt, help, app interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedPipeline", reflect.TypeOf((*MockcodePipelineSelector)(nil).DeployedPipeline), prompt, help, app)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockappSelector)(nil).Application), varargs...)
 }
 
-// MockwsSelector is a mock of wsSelector interface.
-type MockwsSelector struct {
+// MockappEnvSelector is a mock of appEnvSelector interface.
+type MockappEnvSelector struct {
 	ctrl     *gomock.Controller
-	recorder *MockwsSelectorMockRecorder
+	recorder *MockappEnvSelectorMockRecorder
 }
 
-// MockwsSelectorMockRecorder is the mock recorder for MockwsSelector.
-type MockwsSelectorMockRecorder struct {
-	mock *MockwsSelector
+// MockappEnvSelectorMockRecorder is the mock recorder for MockappEnvSelector.
+type MockappEnvSelectorMockRecorder struct {
+	mock *MockappEnvSelector
 }
 
-// NewMockwsSelector creates a new mock instance.
-func NewMockwsSelector(ctrl *gomock.Controller) *MockwsSelector {
-	mock := &MockwsSelector{ctrl: ctrl}
-	mock.recorder = &MockwsSelectorMockRecorder{mock}
+// NewMockappEnvSelector creates a new mock instance.
+func NewMockappEnvSelector(ctrl *gomock.Controller) *MockappEnvSelector {
+	mock := &MockappEnvSelector{ctrl: ctrl}
+	mock.recorder = &MockappEnvSelectorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockwsSelector) EXPECT() *MockwsSelectorMockRecorder {
+func (m *MockappEnvSelector) EXPECT() *MockappEnvSelectorMockRecorder {
 	return m.recorder
 }
 
 // Application mocks base method.
-func (m *MockwsSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+func (m *MockappEnvSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{prompt, help}
 	for _, a := range additionalOpts {
@@ -6056,14 +7111,14 @@ func (m *MockwsSelector) Application(prompt, help string, additionalOpts ...stri
 }
 
 // Application indicates an expected call of Application.
-func (mr *MockwsSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+func (mr *MockappEnvSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{prompt, help}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockwsSelector)(nil).Application), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockappEnvSelector)(nil).Application), varargs...)
 }
 
 // Environment mocks base method.
-func (m *MockwsSelector) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
+func (m *MockappEnvSelector) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{prompt, help, app}
 	for _, a := range additionalOpts {
@@ -6076,1340 +7131,3586 @@ func (m *MockwsSelector) Environment(prompt, help, app string, additionalOpts ..
 }
 
 // Environment indicates an expected call of Environment.
-func (mr *MockwsSelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
+func (mr *MockappEnvSelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{prompt, help, app}, additionalOpts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockwsSelector)(nil).Environment), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockappEnvSelector)(nil).Environment), varargs...)
 }
 
-// Job mocks base method.
-func (m *MockwsSelector) Job(prompt, help string) (string, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Job", prompt, help)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// MockcfnSelector is a mock of cfnSelector interface.
+type MockcfnSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockcfnSelectorMockRecorder
 }
 
-// Job indicates an expected call of Job.
-func (mr *MockwsSelectorMockRecorder) Job(prompt, help interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockwsSelector)(nil).Job), prompt, help)
+// MockcfnSelectorMockRecorder is the mock recorder for MockcfnSelector.
+type MockcfnSelectorMockRecorder struct {
+	mock *MockcfnSelector
 }
 
-// Service mocks base method.
-func (m *MockwsSelector) Service(prompt, help string) (string, error) {
+// NewMockcfnSelector creates a new mock instance.
+func NewMockcfnSelector(ctrl *gomock.Controller) *MockcfnSelector {
+	mock := &MockcfnSelector{ctrl: ctrl}
+	mock.recorder = &MockcfnSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcfnSelector) EXPECT() *MockcfnSelectorMockRecorder {
+	return m.recorder
+}
+
+// Resources mocks base method.
+func (m *MockcfnSelector) Resources(msg, finalMsg, help, body string) ([]template.CFNResource, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Service", prompt, help)
+	ret := m.ctrl.Call(m, "Resources", msg, finalMsg, help, body)
+	ret0, _ := ret[0].([]template.CFNResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resources indicates an expected call of Resources.
+func (mr *MockcfnSelectorMockRecorder) Resources(msg, finalMsg, help, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockcfnSelector)(nil).Resources), msg, finalMsg, help, body)
+}
+
+// MockconfigSelector is a mock of configSelector interface.
+type MockconfigSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockconfigSelectorMockRecorder
+}
+
+// MockconfigSelectorMockRecorder is the mock recorder for MockconfigSelector.
+type MockconfigSelectorMockRecorder struct {
+	mock *MockconfigSelector
+}
+
+// NewMockconfigSelector creates a new mock instance.
+func NewMockconfigSelector(ctrl *gomock.Controller) *MockconfigSelector {
+	mock := &MockconfigSelector{ctrl: ctrl}
+	mock.recorder = &MockconfigSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockconfigSelector) EXPECT() *MockconfigSelectorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method.
+func (m *MockconfigSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Application indicates an expected call of Application.
+func (mr *MockconfigSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockconfigSelector)(nil).Application), varargs...)
+}
+
+// Environment mocks base method.
+func (m *MockconfigSelector) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Environment", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Environment indicates an expected call of Environment.
+func (mr *MockconfigSelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockconfigSelector)(nil).Environment), varargs...)
+}
+
+// Job mocks base method.
+func (m *MockconfigSelector) Job(prompt, help, app string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Job", prompt, help, app)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Job indicates an expected call of Job.
+func (mr *MockconfigSelectorMockRecorder) Job(prompt, help, app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockconfigSelector)(nil).Job), prompt, help, app)
+}
+
+// Service mocks base method.
+func (m *MockconfigSelector) Service(prompt, help, app string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", prompt, help, app)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Service indicates an expected call of Service.
-func (mr *MockwsSelectorMockRecorder) Service(prompt, help interface{}) *gomock.Call {
+func (mr *MockconfigSelectorMockRecorder) Service(prompt, help, app interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockwsSelector)(nil).Service), prompt, help)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockconfigSelector)(nil).Service), prompt, help, app)
 }
 
 // Workload mocks base method.
-func (m *MockwsSelector) Workload(msg, help string) (string, error) {
+func (m *MockconfigSelector) Workload(prompt, help, app string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Workload", msg, help)
+	ret := m.ctrl.Call(m, "Workload", prompt, help, app)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Workload indicates an expected call of Workload.
-func (mr *MockwsSelectorMockRecorder) Workload(msg, help interface{}) *gomock.Call {
+func (mr *MockconfigSelectorMockRecorder) Workload(prompt, help, app interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workload", reflect.TypeOf((*MockwsSelector)(nil).Workload), msg, help)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workload", reflect.TypeOf((*MockconfigSelector)(nil).Workload), prompt, help, app)
 }
 
-// MockstaticSourceSelector is a mock of staticSourceSelector interface.
-type MockstaticSourceSelector struct {
+// MockdeploySelector is a mock of deploySelector interface.
+type MockdeploySelector struct {
 	ctrl     *gomock.Controller
-	recorder *MockstaticSourceSelectorMockRecorder
+	recorder *MockdeploySelectorMockRecorder
+}
+
+// MockdeploySelectorMockRecorder is the mock recorder for MockdeploySelector.
+type MockdeploySelectorMockRecorder struct {
+	mock *MockdeploySelector
+}
+
+// NewMockdeploySelector creates a new mock instance.
+func NewMockdeploySelector(ctrl *gomock.Controller) *MockdeploySelector {
+	mock := &MockdeploySelector{ctrl: ctrl}
+	mock.recorder = &MockdeploySelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeploySelector) EXPECT() *MockdeploySelectorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method.
+func (m *MockdeploySelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Application indicates an expected call of Application.
+func (mr *MockdeploySelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockdeploySelector)(nil).Application), varargs...)
+}
+
+// DeployedJob mocks base method.
+func (m *MockdeploySelector) DeployedJob(prompt, help, app string, opts ...selector.GetDeployedWorkloadOpts) (*selector.DeployedJob, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployedJob", varargs...)
+	ret0, _ := ret[0].(*selector.DeployedJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployedJob indicates an expected call of DeployedJob.
+func (mr *MockdeploySelectorMockRecorder) DeployedJob(prompt, help, app interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedJob", reflect.TypeOf((*MockdeploySelector)(nil).DeployedJob), varargs...)
+}
+
+// DeployedService mocks base method.
+func (m *MockdeploySelector) DeployedService(prompt, help, app string, opts ...selector.GetDeployedWorkloadOpts) (*selector.DeployedService, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployedService", varargs...)
+	ret0, _ := ret[0].(*selector.DeployedService)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployedService indicates an expected call of DeployedService.
+func (mr *MockdeploySelectorMockRecorder) DeployedService(prompt, help, app interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedService", reflect.TypeOf((*MockdeploySelector)(nil).DeployedService), varargs...)
+}
+
+// DeployedWorkload mocks base method.
+func (m *MockdeploySelector) DeployedWorkload(prompt, help, app string, opts ...selector.GetDeployedWorkloadOpts) (*selector.DeployedWorkload, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployedWorkload", varargs...)
+	ret0, _ := ret[0].(*selector.DeployedWorkload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployedWorkload indicates an expected call of DeployedWorkload.
+func (mr *MockdeploySelectorMockRecorder) DeployedWorkload(prompt, help, app interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedWorkload", reflect.TypeOf((*MockdeploySelector)(nil).DeployedWorkload), varargs...)
+}
+
+// MockpipelineEnvSelector is a mock of pipelineEnvSelector interface.
+type MockpipelineEnvSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockpipelineEnvSelectorMockRecorder
+}
+
+// MockpipelineEnvSelectorMockRecorder is the mock recorder for MockpipelineEnvSelector.
+type MockpipelineEnvSelectorMockRecorder struct {
+	mock *MockpipelineEnvSelector
+}
+
+// NewMockpipelineEnvSelector creates a new mock instance.
+func NewMockpipelineEnvSelector(ctrl *gomock.Controller) *MockpipelineEnvSelector {
+	mock := &MockpipelineEnvSelector{ctrl: ctrl}
+	mock.recorder = &MockpipelineEnvSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpipelineEnvSelector) EXPECT() *MockpipelineEnvSelectorMockRecorder {
+	return m.recorder
+}
+
+// Environments mocks base method.
+func (m *MockpipelineEnvSelector) Environments(prompt, help, app string, finalMsgFunc func(int) prompt.PromptConfig) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Environments", prompt, help, app, finalMsgFunc)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Environments indicates an expected call of Environments.
+func (mr *MockpipelineEnvSelectorMockRecorder) Environments(prompt, help, app, finalMsgFunc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environments", reflect.TypeOf((*MockpipelineEnvSelector)(nil).Environments), prompt, help, app, finalMsgFunc)
+}
+
+// MockwsPipelineSelector is a mock of wsPipelineSelector interface.
+type MockwsPipelineSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsPipelineSelectorMockRecorder
+}
+
+// MockwsPipelineSelectorMockRecorder is the mock recorder for MockwsPipelineSelector.
+type MockwsPipelineSelectorMockRecorder struct {
+	mock *MockwsPipelineSelector
+}
+
+// NewMockwsPipelineSelector creates a new mock instance.
+func NewMockwsPipelineSelector(ctrl *gomock.Controller) *MockwsPipelineSelector {
+	mock := &MockwsPipelineSelector{ctrl: ctrl}
+	mock.recorder = &MockwsPipelineSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsPipelineSelector) EXPECT() *MockwsPipelineSelectorMockRecorder {
+	return m.recorder
+}
+
+// WsPipeline mocks base method.
+func (m *MockwsPipelineSelector) WsPipeline(prompt, help string) (*workspace.PipelineManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WsPipeline", prompt, help)
+	ret0, _ := ret[0].(*workspace.PipelineManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WsPipeline indicates an expected call of WsPipeline.
+func (mr *MockwsPipelineSelectorMockRecorder) WsPipeline(prompt, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WsPipeline", reflect.TypeOf((*MockwsPipelineSelector)(nil).WsPipeline), prompt, help)
+}
+
+// MockwsEnvironmentSelector is a mock of wsEnvironmentSelector interface.
+type MockwsEnvironmentSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsEnvironmentSelectorMockRecorder
+}
+
+// MockwsEnvironmentSelectorMockRecorder is the mock recorder for MockwsEnvironmentSelector.
+type MockwsEnvironmentSelectorMockRecorder struct {
+	mock *MockwsEnvironmentSelector
+}
+
+// NewMockwsEnvironmentSelector creates a new mock instance.
+func NewMockwsEnvironmentSelector(ctrl *gomock.Controller) *MockwsEnvironmentSelector {
+	mock := &MockwsEnvironmentSelector{ctrl: ctrl}
+	mock.recorder = &MockwsEnvironmentSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsEnvironmentSelector) EXPECT() *MockwsEnvironmentSelectorMockRecorder {
+	return m.recorder
+}
+
+// LocalEnvironment mocks base method.
+func (m *MockwsEnvironmentSelector) LocalEnvironment(msg, help string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LocalEnvironment", msg, help)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LocalEnvironment indicates an expected call of LocalEnvironment.
+func (mr *MockwsEnvironmentSelectorMockRecorder) LocalEnvironment(msg, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LocalEnvironment", reflect.TypeOf((*MockwsEnvironmentSelector)(nil).LocalEnvironment), msg, help)
+}
+
+// MockworkspaceSelector is a mock of workspaceSelector interface.
+type MockworkspaceSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkspaceSelectorMockRecorder
+}
+
+// MockworkspaceSelectorMockRecorder is the mock recorder for MockworkspaceSelector.
+type MockworkspaceSelectorMockRecorder struct {
+	mock *MockworkspaceSelector
+}
+
+// NewMockworkspaceSelector creates a new mock instance.
+func NewMockworkspaceSelector(ctrl *gomock.Controller) *MockworkspaceSelector {
+	mock := &MockworkspaceSelector{ctrl: ctrl}
+	mock.recorder = &MockworkspaceSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkspaceSelector) EXPECT() *MockworkspaceSelectorMockRecorder {
+	return m.recorder
+}
+
+// Environment mocks base method.
+func (m *MockworkspaceSelector) Environment(msg, help string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Environment", msg, help)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Environment indicates an expected call of Environment.
+func (mr *MockworkspaceSelectorMockRecorder) Environment(msg, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockworkspaceSelector)(nil).Environment), msg, help)
+}
+
+// Job mocks base method.
+func (m *MockworkspaceSelector) Job(msg, help string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Job", msg, help)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Job indicates an expected call of Job.
+func (mr *MockworkspaceSelectorMockRecorder) Job(msg, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockworkspaceSelector)(nil).Job), msg, help)
+}
+
+// Service mocks base method.
+func (m *MockworkspaceSelector) Service(msg, help string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", msg, help)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockworkspaceSelectorMockRecorder) Service(msg, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockworkspaceSelector)(nil).Service), msg, help)
+}
+
+// MockcodePipelineSelector is a mock of codePipelineSelector interface.
+type MockcodePipelineSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockcodePipelineSelectorMockRecorder
+}
+
+// MockcodePipelineSelectorMockRecorder is the mock recorder for MockcodePipelineSelector.
+type MockcodePipelineSelectorMockRecorder struct {
+	mock *MockcodePipelineSelector
+}
+
+// NewMockcodePipelineSelector creates a new mock instance.
+func NewMockcodePipelineSelector(ctrl *gomock.Controller) *MockcodePipelineSelector {
+	mock := &MockcodePipelineSelector{ctrl: ctrl}
+	mock.recorder = &MockcodePipelineSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcodePipelineSelector) EXPECT() *MockcodePipelineSelectorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method.
+func (m *MockcodePipelineSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Application indicates an expected call of Application.
+func (mr *MockcodePipelineSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockcodePipelineSelector)(nil).Application), varargs...)
+}
+
+// DeployedPipeline mocks base method.
+func (m *MockcodePipelineSelector) DeployedPipeline(prompt, help, app string) (deploy0.Pipeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployedPipeline", prompt, help, app)
+	ret0, _ := ret[0].(deploy0.Pipeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployedPipeline indicates an expected call of DeployedPipeline.
+func (mr *MockcodePipelineSelectorMockRecorder) DeployedPipeline(prompt, help, app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedPipeline", reflect.TypeOf((*MockcodePipelineSelector)(nil).DeployedPipeline), prompt, help, app)
+}
+
+// MockwsSelector is a mock of wsSelector interface.
+type MockwsSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsSelectorMockRecorder
+}
+
+// MockwsSelectorMockRecorder is the mock recorder for MockwsSelector.
+type MockwsSelectorMockRecorder struct {
+	mock *MockwsSelector
+}
+
+// NewMockwsSelector creates a new mock instance.
+func NewMockwsSelector(ctrl *gomock.Controller) *MockwsSelector {
+	mock := &MockwsSelector{ctrl: ctrl}
+	mock.recorder = &MockwsSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsSelector) EXPECT() *MockwsSelectorMockRecorder {
+	return m.recorder
+}
+
+// Application mocks base method.
+func (m *MockwsSelector) Application(prompt, help string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Application", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Application indicates an expected call of Application.
+func (mr *MockwsSelectorMockRecorder) Application(prompt, help interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockwsSelector)(nil).Application), varargs...)
+}
+
+// Environment mocks base method.
+func (m *MockwsSelector) Environment(prompt, help, app string, additionalOpts ...string) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range additionalOpts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Environment", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Environment indicates an expected call of Environment.
+func (mr *MockwsSelectorMockRecorder) Environment(prompt, help, app interface{}, additionalOpts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, additionalOpts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Environment", reflect.TypeOf((*MockwsSelector)(nil).Environment), varargs...)
+}
+
+// Job mocks base method.
+func (m *MockwsSelector) Job(prompt, help string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Job", prompt, help)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Job indicates an expected call of Job.
+func (mr *MockwsSelectorMockRecorder) Job(prompt, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockwsSelector)(nil).Job), prompt, help)
+}
+
+// Service mocks base method.
+func (m *MockwsSelector) Service(prompt, help string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", prompt, help)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockwsSelectorMockRecorder) Service(prompt, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockwsSelector)(nil).Service), prompt, help)
+}
+
+// Workload mocks base method.
+func (m *MockwsSelector) Workload(msg, help string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Workload", msg, help)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Workload indicates an expected call of Workload.
+func (mr *MockwsSelectorMockRecorder) Workload(msg, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workload", reflect.TypeOf((*MockwsSelector)(nil).Workload), msg, help)
+}
+
+// MockstaticSourceSelector is a mock of staticSourceSelector interface.
+type MockstaticSourceSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockstaticSourceSelectorMockRecorder
+}
+
+// MockstaticSourceSelectorMockRecorder is the mock recorder for MockstaticSourceSelector.
+type MockstaticSourceSelectorMockRecorder struct {
+	mock *MockstaticSourceSelector
+}
+
+// NewMockstaticSourceSelector creates a new mock instance.
+func NewMockstaticSourceSelector(ctrl *gomock.Controller) *MockstaticSourceSelector {
+	mock := &MockstaticSourceSelector{ctrl: ctrl}
+	mock.recorder = &MockstaticSourceSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstaticSourceSelector) EXPECT() *MockstaticSourceSelectorMockRecorder {
+	return m.recorder
+}
+
+// StaticSources mocks base method.
+func (m *MockstaticSourceSelector) StaticSources(selPrompt, selHelp, anotherPathPrompt, anotherPathHelp string, pathValidator prompt.ValidatorFunc) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StaticSources", selPrompt, selHelp, anotherPathPrompt, anotherPathHelp, pathValidator)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StaticSources indicates an expected call of StaticSources.
+func (mr *MockstaticSourceSelectorMockRecorder) StaticSources(selPrompt, selHelp, anotherPathPrompt, anotherPathHelp, pathValidator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StaticSources", reflect.TypeOf((*MockstaticSourceSelector)(nil).StaticSources), selPrompt, selHelp, anotherPathPrompt, anotherPathHelp, pathValidator)
+}
+
+// MockscheduleSelector is a mock of scheduleSelector interface.
+type MockscheduleSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockscheduleSelectorMockRecorder
+}
+
+// MockscheduleSelectorMockRecorder is the mock recorder for MockscheduleSelector.
+type MockscheduleSelectorMockRecorder struct {
+	mock *MockscheduleSelector
+}
+
+// NewMockscheduleSelector creates a new mock instance.
+func NewMockscheduleSelector(ctrl *gomock.Controller) *MockscheduleSelector {
+	mock := &MockscheduleSelector{ctrl: ctrl}
+	mock.recorder = &MockscheduleSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockscheduleSelector) EXPECT() *MockscheduleSelectorMockRecorder {
+	return m.recorder
+}
+
+// Schedule mocks base method.
+func (m *MockscheduleSelector) Schedule(scheduleTypePrompt, scheduleTypeHelp string, scheduleValidator, rateValidator prompt.ValidatorFunc) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Schedule", scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockscheduleSelectorMockRecorder) Schedule(scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockscheduleSelector)(nil).Schedule), scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator)
+}
+
+// MockcfTaskSelector is a mock of cfTaskSelector interface.
+type MockcfTaskSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockcfTaskSelectorMockRecorder
+}
+
+// MockcfTaskSelectorMockRecorder is the mock recorder for MockcfTaskSelector.
+type MockcfTaskSelectorMockRecorder struct {
+	mock *MockcfTaskSelector
+}
+
+// NewMockcfTaskSelector creates a new mock instance.
+func NewMockcfTaskSelector(ctrl *gomock.Controller) *MockcfTaskSelector {
+	mock := &MockcfTaskSelector{ctrl: ctrl}
+	mock.recorder = &MockcfTaskSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcfTaskSelector) EXPECT() *MockcfTaskSelectorMockRecorder {
+	return m.recorder
+}
+
+// Task mocks base method.
+func (m *MockcfTaskSelector) Task(prompt, help string, opts ...selector.GetDeployedTaskOpts) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Task", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Task indicates an expected call of Task.
+func (mr *MockcfTaskSelectorMockRecorder) Task(prompt, help interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Task", reflect.TypeOf((*MockcfTaskSelector)(nil).Task), varargs...)
+}
+
+// MockdockerfileSelector is a mock of dockerfileSelector interface.
+type MockdockerfileSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockdockerfileSelectorMockRecorder
+}
+
+// MockdockerfileSelectorMockRecorder is the mock recorder for MockdockerfileSelector.
+type MockdockerfileSelectorMockRecorder struct {
+	mock *MockdockerfileSelector
+}
+
+// NewMockdockerfileSelector creates a new mock instance.
+func NewMockdockerfileSelector(ctrl *gomock.Controller) *MockdockerfileSelector {
+	mock := &MockdockerfileSelector{ctrl: ctrl}
+	mock.recorder = &MockdockerfileSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdockerfileSelector) EXPECT() *MockdockerfileSelectorMockRecorder {
+	return m.recorder
+}
+
+// Dockerfile mocks base method.
+func (m *MockdockerfileSelector) Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoundHelp string, pv prompt.ValidatorFunc) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dockerfile", selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Dockerfile indicates an expected call of Dockerfile.
+func (mr *MockdockerfileSelectorMockRecorder) Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dockerfile", reflect.TypeOf((*MockdockerfileSelector)(nil).Dockerfile), selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv)
+}
+
+// MocktopicSelector is a mock of topicSelector interface.
+type MocktopicSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MocktopicSelectorMockRecorder
+}
+
+// MocktopicSelectorMockRecorder is the mock recorder for MocktopicSelector.
+type MocktopicSelectorMockRecorder struct {
+	mock *MocktopicSelector
+}
+
+// NewMocktopicSelector creates a new mock instance.
+func NewMocktopicSelector(ctrl *gomock.Controller) *MocktopicSelector {
+	mock := &MocktopicSelector{ctrl: ctrl}
+	mock.recorder = &MocktopicSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktopicSelector) EXPECT() *MocktopicSelectorMockRecorder {
+	return m.recorder
+}
+
+// Topics mocks base method.
+func (m *MocktopicSelector) Topics(prompt, help, app string) ([]deploy0.Topic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Topics", prompt, help, app)
+	ret0, _ := ret[0].([]deploy0.Topic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Topics indicates an expected call of Topics.
+func (mr *MocktopicSelectorMockRecorder) Topics(prompt, help, app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Topics", reflect.TypeOf((*MocktopicSelector)(nil).Topics), prompt, help, app)
+}
+
+// Mockec2Selector is a mock of ec2Selector interface.
+type Mockec2Selector struct {
+	ctrl     *gomock.Controller
+	recorder *Mockec2SelectorMockRecorder
+}
+
+// Mockec2SelectorMockRecorder is the mock recorder for Mockec2Selector.
+type Mockec2SelectorMockRecorder struct {
+	mock *Mockec2Selector
+}
+
+// NewMockec2Selector creates a new mock instance.
+func NewMockec2Selector(ctrl *gomock.Controller) *Mockec2Selector {
+	mock := &Mockec2Selector{ctrl: ctrl}
+	mock.recorder = &Mockec2SelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockec2Selector) EXPECT() *Mockec2SelectorMockRecorder {
+	return m.recorder
+}
+
+// Subnets mocks base method.
+func (m *Mockec2Selector) Subnets(input selector.SubnetsInput) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subnets", input)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subnets indicates an expected call of Subnets.
+func (mr *Mockec2SelectorMockRecorder) Subnets(input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subnets", reflect.TypeOf((*Mockec2Selector)(nil).Subnets), input)
+}
+
+// VPC mocks base method.
+func (m *Mockec2Selector) VPC(prompt, help string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VPC", prompt, help)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VPC indicates an expected call of VPC.
+func (mr *Mockec2SelectorMockRecorder) VPC(prompt, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPC", reflect.TypeOf((*Mockec2Selector)(nil).VPC), prompt, help)
+}
+
+// MockcredsSelector is a mock of credsSelector interface.
+type MockcredsSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockcredsSelectorMockRecorder
+}
+
+// MockcredsSelectorMockRecorder is the mock recorder for MockcredsSelector.
+type MockcredsSelectorMockRecorder struct {
+	mock *MockcredsSelector
+}
+
+// NewMockcredsSelector creates a new mock instance.
+func NewMockcredsSelector(ctrl *gomock.Controller) *MockcredsSelector {
+	mock := &MockcredsSelector{ctrl: ctrl}
+	mock.recorder = &MockcredsSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcredsSelector) EXPECT() *MockcredsSelectorMockRecorder {
+	return m.recorder
+}
+
+// Creds mocks base method.
+func (m *MockcredsSelector) Creds(prompt, help string) (*session.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Creds", prompt, help)
+	ret0, _ := ret[0].(*session.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Creds indicates an expected call of Creds.
+func (mr *MockcredsSelectorMockRecorder) Creds(prompt, help interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Creds", reflect.TypeOf((*MockcredsSelector)(nil).Creds), prompt, help)
+}
+
+// Mockec2Client is a mock of ec2Client interface.
+type Mockec2Client struct {
+	ctrl     *gomock.Controller
+	recorder *Mockec2ClientMockRecorder
+}
+
+// Mockec2ClientMockRecorder is the mock recorder for Mockec2Client.
+type Mockec2ClientMockRecorder struct {
+	mock *Mockec2Client
+}
+
+// NewMockec2Client creates a new mock instance.
+func NewMockec2Client(ctrl *gomock.Controller) *Mockec2Client {
+	mock := &Mockec2Client{ctrl: ctrl}
+	mock.recorder = &Mockec2ClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockec2Client) EXPECT() *Mockec2ClientMockRecorder {
+	return m.recorder
+}
+
+// HasDNSSupport mocks base method.
+func (m *Mockec2Client) HasDNSSupport(vpcID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasDNSSupport", vpcID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasDNSSupport indicates an expected call of HasDNSSupport.
+func (mr *Mockec2ClientMockRecorder) HasDNSSupport(vpcID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDNSSupport", reflect.TypeOf((*Mockec2Client)(nil).HasDNSSupport), vpcID)
+}
+
+// ListAZs mocks base method.
+func (m *Mockec2Client) ListAZs() ([]ec2.AZ, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAZs")
+	ret0, _ := ret[0].([]ec2.AZ)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAZs indicates an expected call of ListAZs.
+func (mr *Mockec2ClientMockRecorder) ListAZs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAZs", reflect.TypeOf((*Mockec2Client)(nil).ListAZs))
+}
+
+// MockserviceResumer is a mock of serviceResumer interface.
+type MockserviceResumer struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceResumerMockRecorder
+}
+
+// MockserviceResumerMockRecorder is the mock recorder for MockserviceResumer.
+type MockserviceResumerMockRecorder struct {
+	mock *MockserviceResumer
+}
+
+// NewMockserviceResumer creates a new mock instance.
+func NewMockserviceResumer(ctrl *gomock.Controller) *MockserviceResumer {
+	mock := &MockserviceResumer{ctrl: ctrl}
+	mock.recorder = &MockserviceResumerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceResumer) EXPECT() *MockserviceResumerMockRecorder {
+	return m.recorder
+}
+
+// ResumeService mocks base method.
+func (m *MockserviceResumer) ResumeService(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeService", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeService indicates an expected call of ResumeService.
+func (mr *MockserviceResumerMockRecorder) ResumeService(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeService", reflect.TypeOf((*MockserviceResumer)(nil).ResumeService), arg0)
+}
+
+// MockjobInitializer is a mock of jobInitializer interface.
+type MockjobInitializer struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobInitializerMockRecorder
+}
+
+// MockjobInitializerMockRecorder is the mock recorder for MockjobInitializer.
+type MockjobInitializerMockRecorder struct {
+	mock *MockjobInitializer
+}
+
+// NewMockjobInitializer creates a new mock instance.
+func NewMockjobInitializer(ctrl *gomock.Controller) *MockjobInitializer {
+	mock := &MockjobInitializer{ctrl: ctrl}
+	mock.recorder = &MockjobInitializerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobInitializer) EXPECT() *MockjobInitializerMockRecorder {
+	return m.recorder
+}
+
+// Job mocks base method.
+func (m *MockjobInitializer) Job(props *initialize.JobProps) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Job", props)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Job indicates an expected call of Job.
+func (mr *MockjobInitializerMockRecorder) Job(props interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockjobInitializer)(nil).Job), props)
+}
+
+// MocksvcInitializer is a mock of svcInitializer interface.
+type MocksvcInitializer struct {
+	ctrl     *gomock.Controller
+	recorder *MocksvcInitializerMockRecorder
+}
+
+// MocksvcInitializerMockRecorder is the mock recorder for MocksvcInitializer.
+type MocksvcInitializerMockRecorder struct {
+	mock *MocksvcInitializer
+}
+
+// NewMocksvcInitializer creates a new mock instance.
+func NewMocksvcInitializer(ctrl *gomock.Controller) *MocksvcInitializer {
+	mock := &MocksvcInitializer{ctrl: ctrl}
+	mock.recorder = &MocksvcInitializerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksvcInitializer) EXPECT() *MocksvcInitializerMockRecorder {
+	return m.recorder
+}
+
+// Service mocks base method.
+func (m *MocksvcInitializer) Service(props *initialize.ServiceProps) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", props)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MocksvcInitializerMockRecorder) Service(props interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MocksvcInitializer)(nil).Service), props)
+}
+
+// MockroleDeleter is a mock of roleDeleter interface.
+type MockroleDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockroleDeleterMockRecorder
+}
+
+// MockroleDeleterMockRecorder is the mock recorder for MockroleDeleter.
+type MockroleDeleterMockRecorder struct {
+	mock *MockroleDeleter
+}
+
+// NewMockroleDeleter creates a new mock instance.
+func NewMockroleDeleter(ctrl *gomock.Controller) *MockroleDeleter {
+	mock := &MockroleDeleter{ctrl: ctrl}
+	mock.recorder = &MockroleDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockroleDeleter) EXPECT() *MockroleDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteRole mocks base method.
+func (m *MockroleDeleter) DeleteRole(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockroleDeleterMockRecorder) DeleteRole(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockroleDeleter)(nil).DeleteRole), arg0)
+}
+
+// MockpolicyLister is a mock of policyLister interface.
+type MockpolicyLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockpolicyListerMockRecorder
+}
+
+// MockpolicyListerMockRecorder is the mock recorder for MockpolicyLister.
+type MockpolicyListerMockRecorder struct {
+	mock *MockpolicyLister
+}
+
+// NewMockpolicyLister creates a new mock instance.
+func NewMockpolicyLister(ctrl *gomock.Controller) *MockpolicyLister {
+	mock := &MockpolicyLister{ctrl: ctrl}
+	mock.recorder = &MockpolicyListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpolicyLister) EXPECT() *MockpolicyListerMockRecorder {
+	return m.recorder
+}
+
+// ListPolicyNames mocks base method.
+func (m *MockpolicyLister) ListPolicyNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPolicyNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPolicyNames indicates an expected call of ListPolicyNames.
+func (mr *MockpolicyListerMockRecorder) ListPolicyNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicyNames", reflect.TypeOf((*MockpolicyLister)(nil).ListPolicyNames))
+}
+
+// MockserviceDescriber is a mock of serviceDescriber interface.
+type MockserviceDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceDescriberMockRecorder
+}
+
+// MockserviceDescriberMockRecorder is the mock recorder for MockserviceDescriber.
+type MockserviceDescriberMockRecorder struct {
+	mock *MockserviceDescriber
+}
+
+// NewMockserviceDescriber creates a new mock instance.
+func NewMockserviceDescriber(ctrl *gomock.Controller) *MockserviceDescriber {
+	mock := &MockserviceDescriber{ctrl: ctrl}
+	mock.recorder = &MockserviceDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceDescriber) EXPECT() *MockserviceDescriberMockRecorder {
+	return m.recorder
+}
+
+// DescribeService mocks base method.
+func (m *MockserviceDescriber) DescribeService(app, env, svc string) (*ecs0.ServiceDesc, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeService", app, env, svc)
+	ret0, _ := ret[0].(*ecs0.ServiceDesc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeService indicates an expected call of DescribeService.
+func (mr *MockserviceDescriberMockRecorder) DescribeService(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockserviceDescriber)(nil).DescribeService), app, env, svc)
+}
+
+// MockapprunnerServiceDescriber is a mock of apprunnerServiceDescriber interface.
+type MockapprunnerServiceDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockapprunnerServiceDescriberMockRecorder
+}
+
+// MockapprunnerServiceDescriberMockRecorder is the mock recorder for MockapprunnerServiceDescriber.
+type MockapprunnerServiceDescriberMockRecorder struct {
+	mock *MockapprunnerServiceDescriber
+}
+
+// NewMockapprunnerServiceDescriber creates a new mock instance.
+func NewMockapprunnerServiceDescriber(ctrl *gomock.Controller) *MockapprunnerServiceDescriber {
+	mock := &MockapprunnerServiceDescriber{ctrl: ctrl}
+	mock.recorder = &MockapprunnerServiceDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockapprunnerServiceDescriber) EXPECT() *MockapprunnerServiceDescriberMockRecorder {
+	return m.recorder
+}
+
+// ServiceARN mocks base method.
+func (m *MockapprunnerServiceDescriber) ServiceARN(env string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceARN", env)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceARN indicates an expected call of ServiceARN.
+func (mr *MockapprunnerServiceDescriberMockRecorder) ServiceARN(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceARN", reflect.TypeOf((*MockapprunnerServiceDescriber)(nil).ServiceARN), env)
+}
+
+// MockecsCommandExecutor is a mock of ecsCommandExecutor interface.
+type MockecsCommandExecutor struct {
+	ctrl     *gomock.Controller
+	recorder *MockecsCommandExecutorMockRecorder
+}
+
+// MockecsCommandExecutorMockRecorder is the mock recorder for MockecsCommandExecutor.
+type MockecsCommandExecutorMockRecorder struct {
+	mock *MockecsCommandExecutor
+}
+
+// NewMockecsCommandExecutor creates a new mock instance.
+func NewMockecsCommandExecutor(ctrl *gomock.Controller) *MockecsCommandExecutor {
+	mock := &MockecsCommandExecutor{ctrl: ctrl}
+	mock.recorder = &MockecsCommandExecutorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockecsCommandExecutor) EXPECT() *MockecsCommandExecutorMockRecorder {
+	return m.recorder
+}
+
+// ExecuteCommand mocks base method.
+func (m *MockecsCommandExecutor) ExecuteCommand(in ecs.ExecuteCommandInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteCommand", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteCommand indicates an expected call of ExecuteCommand.
+func (mr *MockecsCommandExecutorMockRecorder) ExecuteCommand(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockecsCommandExecutor)(nil).ExecuteCommand), in)
+}
+
+// MockportForwarder is a mock of portForwarder interface.
+type MockportForwarder struct {
+	ctrl     *gomock.Controller
+	recorder *MockportForwarderMockRecorder
+}
+
+// MockportForwarderMockRecorder is the mock recorder for MockportForwarder.
+type MockportForwarderMockRecorder struct {
+	mock *MockportForwarder
+}
+
+// NewMockportForwarder creates a new mock instance.
+func NewMockportForwarder(ctrl *gomock.Controller) *MockportForwarder {
+	mock := &MockportForwarder{ctrl: ctrl}
+	mock.recorder = &MockportForwarderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockportForwarder) EXPECT() *MockportForwarderMockRecorder {
+	return m.recorder
+}
+
+// StartPortForwardingSession mocks base method.
+func (m *MockportForwarder) StartPortForwardingSession(in ssm.PortForwardInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPortForwardingSession", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartPortForwardingSession indicates an expected call of StartPortForwardingSession.
+func (mr *MockportForwarderMockRecorder) StartPortForwardingSession(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPortForwardingSession", reflect.TypeOf((*MockportForwarder)(nil).StartPortForwardingSession), in)
+}
+
+// MockssmPluginManager is a mock of ssmPluginManager interface.
+type MockssmPluginManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockssmPluginManagerMockRecorder
+}
+
+// MockssmPluginManagerMockRecorder is the mock recorder for MockssmPluginManager.
+type MockssmPluginManagerMockRecorder struct {
+	mock *MockssmPluginManager
+}
+
+// NewMockssmPluginManager creates a new mock instance.
+func NewMockssmPluginManager(ctrl *gomock.Controller) *MockssmPluginManager {
+	mock := &MockssmPluginManager{ctrl: ctrl}
+	mock.recorder = &MockssmPluginManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockssmPluginManager) EXPECT() *MockssmPluginManagerMockRecorder {
+	return m.recorder
+}
+
+// InstallLatestBinary mocks base method.
+func (m *MockssmPluginManager) InstallLatestBinary() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallLatestBinary")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallLatestBinary indicates an expected call of InstallLatestBinary.
+func (mr *MockssmPluginManagerMockRecorder) InstallLatestBinary() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallLatestBinary", reflect.TypeOf((*MockssmPluginManager)(nil).InstallLatestBinary))
+}
+
+// ValidateBinary mocks base method.
+func (m *MockssmPluginManager) ValidateBinary() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateBinary")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateBinary indicates an expected call of ValidateBinary.
+func (mr *MockssmPluginManagerMockRecorder) ValidateBinary() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBinary", reflect.TypeOf((*MockssmPluginManager)(nil).ValidateBinary))
+}
+
+// MocktaskStopper is a mock of taskStopper interface.
+type MocktaskStopper struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskStopperMockRecorder
+}
+
+// MocktaskStopperMockRecorder is the mock recorder for MocktaskStopper.
+type MocktaskStopperMockRecorder struct {
+	mock *MocktaskStopper
+}
+
+// NewMocktaskStopper creates a new mock instance.
+func NewMocktaskStopper(ctrl *gomock.Controller) *MocktaskStopper {
+	mock := &MocktaskStopper{ctrl: ctrl}
+	mock.recorder = &MocktaskStopperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaskStopper) EXPECT() *MocktaskStopperMockRecorder {
+	return m.recorder
+}
+
+// StopDefaultClusterTasks mocks base method.
+func (m *MocktaskStopper) StopDefaultClusterTasks(familyName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopDefaultClusterTasks", familyName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopDefaultClusterTasks indicates an expected call of StopDefaultClusterTasks.
+func (mr *MocktaskStopperMockRecorder) StopDefaultClusterTasks(familyName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopDefaultClusterTasks", reflect.TypeOf((*MocktaskStopper)(nil).StopDefaultClusterTasks), familyName)
+}
+
+// StopOneOffTasks mocks base method.
+func (m *MocktaskStopper) StopOneOffTasks(app, env, family string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopOneOffTasks", app, env, family)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopOneOffTasks indicates an expected call of StopOneOffTasks.
+func (mr *MocktaskStopperMockRecorder) StopOneOffTasks(app, env, family interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopOneOffTasks", reflect.TypeOf((*MocktaskStopper)(nil).StopOneOffTasks), app, env, family)
+}
+
+// StopWorkloadTasks mocks base method.
+func (m *MocktaskStopper) StopWorkloadTasks(app, env, workload string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopWorkloadTasks", app, env, workload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopWorkloadTasks indicates an expected call of StopWorkloadTasks.
+func (mr *MocktaskStopperMockRecorder) StopWorkloadTasks(app, env, workload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopWorkloadTasks", reflect.TypeOf((*MocktaskStopper)(nil).StopWorkloadTasks), app, env, workload)
+}
+
+// MockserviceLinkedRoleCreator is a mock of serviceLinkedRoleCreator interface.
+type MockserviceLinkedRoleCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceLinkedRoleCreatorMockRecorder
+}
+
+// MockserviceLinkedRoleCreatorMockRecorder is the mock recorder for MockserviceLinkedRoleCreator.
+type MockserviceLinkedRoleCreatorMockRecorder struct {
+	mock *MockserviceLinkedRoleCreator
+}
+
+// NewMockserviceLinkedRoleCreator creates a new mock instance.
+func NewMockserviceLinkedRoleCreator(ctrl *gomock.Controller) *MockserviceLinkedRoleCreator {
+	mock := &MockserviceLinkedRoleCreator{ctrl: ctrl}
+	mock.recorder = &MockserviceLinkedRoleCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceLinkedRoleCreator) EXPECT() *MockserviceLinkedRoleCreatorMockRecorder {
+	return m.recorder
+}
+
+// CreateECSServiceLinkedRole mocks base method.
+func (m *MockserviceLinkedRoleCreator) CreateECSServiceLinkedRole() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateECSServiceLinkedRole")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateECSServiceLinkedRole indicates an expected call of CreateECSServiceLinkedRole.
+func (mr *MockserviceLinkedRoleCreatorMockRecorder) CreateECSServiceLinkedRole() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateECSServiceLinkedRole", reflect.TypeOf((*MockserviceLinkedRoleCreator)(nil).CreateECSServiceLinkedRole))
+}
+
+// MockroleTagsLister is a mock of roleTagsLister interface.
+type MockroleTagsLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockroleTagsListerMockRecorder
+}
+
+// MockroleTagsListerMockRecorder is the mock recorder for MockroleTagsLister.
+type MockroleTagsListerMockRecorder struct {
+	mock *MockroleTagsLister
+}
+
+// NewMockroleTagsLister creates a new mock instance.
+func NewMockroleTagsLister(ctrl *gomock.Controller) *MockroleTagsLister {
+	mock := &MockroleTagsLister{ctrl: ctrl}
+	mock.recorder = &MockroleTagsListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockroleTagsLister) EXPECT() *MockroleTagsListerMockRecorder {
+	return m.recorder
+}
+
+// ListRoleTags mocks base method.
+func (m *MockroleTagsLister) ListRoleTags(arg0 string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoleTags", arg0)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoleTags indicates an expected call of ListRoleTags.
+func (mr *MockroleTagsListerMockRecorder) ListRoleTags(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleTags", reflect.TypeOf((*MockroleTagsLister)(nil).ListRoleTags), arg0)
+}
+
+// MockroleManager is a mock of roleManager interface.
+type MockroleManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockroleManagerMockRecorder
+}
+
+// MockroleManagerMockRecorder is the mock recorder for MockroleManager.
+type MockroleManagerMockRecorder struct {
+	mock *MockroleManager
+}
+
+// NewMockroleManager creates a new mock instance.
+func NewMockroleManager(ctrl *gomock.Controller) *MockroleManager {
+	mock := &MockroleManager{ctrl: ctrl}
+	mock.recorder = &MockroleManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockroleManager) EXPECT() *MockroleManagerMockRecorder {
+	return m.recorder
+}
+
+// CreateECSServiceLinkedRole mocks base method.
+func (m *MockroleManager) CreateECSServiceLinkedRole() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateECSServiceLinkedRole")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateECSServiceLinkedRole indicates an expected call of CreateECSServiceLinkedRole.
+func (mr *MockroleManagerMockRecorder) CreateECSServiceLinkedRole() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateECSServiceLinkedRole", reflect.TypeOf((*MockroleManager)(nil).CreateECSServiceLinkedRole))
+}
+
+// DeleteRole mocks base method.
+func (m *MockroleManager) DeleteRole(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRole indicates an expected call of DeleteRole.
+func (mr *MockroleManagerMockRecorder) DeleteRole(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockroleManager)(nil).DeleteRole), arg0)
+}
+
+// ListRoleTags mocks base method.
+func (m *MockroleManager) ListRoleTags(arg0 string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoleTags", arg0)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoleTags indicates an expected call of ListRoleTags.
+func (mr *MockroleManagerMockRecorder) ListRoleTags(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleTags", reflect.TypeOf((*MockroleManager)(nil).ListRoleTags), arg0)
+}
+
+// MockstackDriftDetector is a mock of stackDriftDetector interface.
+type MockstackDriftDetector struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackDriftDetectorMockRecorder
+}
+
+// MockstackDriftDetectorMockRecorder is the mock recorder for MockstackDriftDetector.
+type MockstackDriftDetectorMockRecorder struct {
+	mock *MockstackDriftDetector
+}
+
+// NewMockstackDriftDetector creates a new mock instance.
+func NewMockstackDriftDetector(ctrl *gomock.Controller) *MockstackDriftDetector {
+	mock := &MockstackDriftDetector{ctrl: ctrl}
+	mock.recorder = &MockstackDriftDetectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackDriftDetector) EXPECT() *MockstackDriftDetectorMockRecorder {
+	return m.recorder
+}
+
+// DetectDrift mocks base method.
+func (m *MockstackDriftDetector) DetectDrift(ctx context.Context, stackName string) ([]cloudformation0.StackResourceDrift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectDrift", ctx, stackName)
+	ret0, _ := ret[0].([]cloudformation0.StackResourceDrift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectDrift indicates an expected call of DetectDrift.
+func (mr *MockstackDriftDetectorMockRecorder) DetectDrift(ctx, stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectDrift", reflect.TypeOf((*MockstackDriftDetector)(nil).DetectDrift), ctx, stackName)
+}
+
+// MockstackExistChecker is a mock of stackExistChecker interface.
+type MockstackExistChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackExistCheckerMockRecorder
+}
+
+// MockstackExistCheckerMockRecorder is the mock recorder for MockstackExistChecker.
+type MockstackExistCheckerMockRecorder struct {
+	mock *MockstackExistChecker
+}
+
+// NewMockstackExistChecker creates a new mock instance.
+func NewMockstackExistChecker(ctrl *gomock.Controller) *MockstackExistChecker {
+	mock := &MockstackExistChecker{ctrl: ctrl}
+	mock.recorder = &MockstackExistCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackExistChecker) EXPECT() *MockstackExistCheckerMockRecorder {
+	return m.recorder
+}
+
+// Exists mocks base method.
+func (m *MockstackExistChecker) Exists(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockstackExistCheckerMockRecorder) Exists(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockstackExistChecker)(nil).Exists), arg0)
+}
+
+// MockrunningTaskSelector is a mock of runningTaskSelector interface.
+type MockrunningTaskSelector struct {
+	ctrl     *gomock.Controller
+	recorder *MockrunningTaskSelectorMockRecorder
+}
+
+// MockrunningTaskSelectorMockRecorder is the mock recorder for MockrunningTaskSelector.
+type MockrunningTaskSelectorMockRecorder struct {
+	mock *MockrunningTaskSelector
+}
+
+// NewMockrunningTaskSelector creates a new mock instance.
+func NewMockrunningTaskSelector(ctrl *gomock.Controller) *MockrunningTaskSelector {
+	mock := &MockrunningTaskSelector{ctrl: ctrl}
+	mock.recorder = &MockrunningTaskSelectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrunningTaskSelector) EXPECT() *MockrunningTaskSelectorMockRecorder {
+	return m.recorder
+}
+
+// RunningTask mocks base method.
+func (m *MockrunningTaskSelector) RunningTask(prompt, help string, opts ...selector.TaskOpts) (*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunningTask", varargs...)
+	ret0, _ := ret[0].(*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunningTask indicates an expected call of RunningTask.
+func (mr *MockrunningTaskSelectorMockRecorder) RunningTask(prompt, help interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTask", reflect.TypeOf((*MockrunningTaskSelector)(nil).RunningTask), varargs...)
+}
+
+// MockactiveTaskLister is a mock of activeTaskLister interface.
+type MockactiveTaskLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockactiveTaskListerMockRecorder
+}
+
+// MockactiveTaskListerMockRecorder is the mock recorder for MockactiveTaskLister.
+type MockactiveTaskListerMockRecorder struct {
+	mock *MockactiveTaskLister
+}
+
+// NewMockactiveTaskLister creates a new mock instance.
+func NewMockactiveTaskLister(ctrl *gomock.Controller) *MockactiveTaskLister {
+	mock := &MockactiveTaskLister{ctrl: ctrl}
+	mock.recorder = &MockactiveTaskListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockactiveTaskLister) EXPECT() *MockactiveTaskListerMockRecorder {
+	return m.recorder
+}
+
+// ListActiveAppEnvTasks mocks base method.
+func (m *MockactiveTaskLister) ListActiveAppEnvTasks(opts ecs0.ListActiveAppEnvTasksOpts) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveAppEnvTasks", opts)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveAppEnvTasks indicates an expected call of ListActiveAppEnvTasks.
+func (mr *MockactiveTaskListerMockRecorder) ListActiveAppEnvTasks(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveAppEnvTasks", reflect.TypeOf((*MockactiveTaskLister)(nil).ListActiveAppEnvTasks), opts)
+}
+
+// MockdockerEngine is a mock of dockerEngine interface.
+type MockdockerEngine struct {
+	ctrl     *gomock.Controller
+	recorder *MockdockerEngineMockRecorder
+}
+
+// MockdockerEngineMockRecorder is the mock recorder for MockdockerEngine.
+type MockdockerEngineMockRecorder struct {
+	mock *MockdockerEngine
+}
+
+// NewMockdockerEngine creates a new mock instance.
+func NewMockdockerEngine(ctrl *gomock.Controller) *MockdockerEngine {
+	mock := &MockdockerEngine{ctrl: ctrl}
+	mock.recorder = &MockdockerEngineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdockerEngine) EXPECT() *MockdockerEngineMockRecorder {
+	return m.recorder
+}
+
+// CheckDockerEngineRunning mocks base method.
+func (m *MockdockerEngine) CheckDockerEngineRunning() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDockerEngineRunning")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckDockerEngineRunning indicates an expected call of CheckDockerEngineRunning.
+func (mr *MockdockerEngineMockRecorder) CheckDockerEngineRunning() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDockerEngineRunning", reflect.TypeOf((*MockdockerEngine)(nil).CheckDockerEngineRunning))
+}
+
+// GetPlatform mocks base method.
+func (m *MockdockerEngine) GetPlatform() (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlatform")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPlatform indicates an expected call of GetPlatform.
+func (mr *MockdockerEngineMockRecorder) GetPlatform() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlatform", reflect.TypeOf((*MockdockerEngine)(nil).GetPlatform))
+}
+
+// MockcontainerRunner is a mock of containerRunner interface.
+type MockcontainerRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockcontainerRunnerMockRecorder
+}
+
+// MockcontainerRunnerMockRecorder is the mock recorder for MockcontainerRunner.
+type MockcontainerRunnerMockRecorder struct {
+	mock *MockcontainerRunner
+}
+
+// NewMockcontainerRunner creates a new mock instance.
+func NewMockcontainerRunner(ctrl *gomock.Controller) *MockcontainerRunner {
+	mock := &MockcontainerRunner{ctrl: ctrl}
+	mock.recorder = &MockcontainerRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcontainerRunner) EXPECT() *MockcontainerRunnerMockRecorder {
+	return m.recorder
+}
+
+// Build mocks base method.
+func (m *MockcontainerRunner) Build(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Build", ctx, args, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Build indicates an expected call of Build.
+func (mr *MockcontainerRunnerMockRecorder) Build(ctx, args, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockcontainerRunner)(nil).Build), ctx, args, w)
+}
+
+// CheckDockerEngineRunning mocks base method.
+func (m *MockcontainerRunner) CheckDockerEngineRunning() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDockerEngineRunning")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckDockerEngineRunning indicates an expected call of CheckDockerEngineRunning.
+func (mr *MockcontainerRunnerMockRecorder) CheckDockerEngineRunning() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDockerEngineRunning", reflect.TypeOf((*MockcontainerRunner)(nil).CheckDockerEngineRunning))
+}
+
+// Login mocks base method.
+func (m *MockcontainerRunner) Login(uri, username, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", uri, username, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockcontainerRunnerMockRecorder) Login(uri, username, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockcontainerRunner)(nil).Login), uri, username, password)
+}
+
+// Run mocks base method.
+func (m *MockcontainerRunner) Run(ctx context.Context, in *dockerengine.RunOptions, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, in, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockcontainerRunnerMockRecorder) Run(ctx, in, w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockcontainerRunner)(nil).Run), ctx, in, w)
+}
+
+// Stop mocks base method.
+func (m *MockcontainerRunner) Stop(ctx context.Context, containerName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", ctx, containerName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockcontainerRunnerMockRecorder) Stop(ctx, containerName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockcontainerRunner)(nil).Stop), ctx, containerName)
+}
+
+// MockregistryAuthenticator is a mock of registryAuthenticator interface.
+type MockregistryAuthenticator struct {
+	ctrl     *gomock.Controller
+	recorder *MockregistryAuthenticatorMockRecorder
+}
+
+// MockregistryAuthenticatorMockRecorder is the mock recorder for MockregistryAuthenticator.
+type MockregistryAuthenticatorMockRecorder struct {
+	mock *MockregistryAuthenticator
+}
+
+// NewMockregistryAuthenticator creates a new mock instance.
+func NewMockregistryAuthenticator(ctrl *gomock.Controller) *MockregistryAuthenticator {
+	mock := &MockregistryAuthenticator{ctrl: ctrl}
+	mock.recorder = &MockregistryAuthenticatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockregistryAuthenticator) EXPECT() *MockregistryAuthenticatorMockRecorder {
+	return m.recorder
+}
+
+// Auth mocks base method.
+func (m *MockregistryAuthenticator) Auth() (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Auth")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Auth indicates an expected call of Auth.
+func (mr *MockregistryAuthenticatorMockRecorder) Auth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*MockregistryAuthenticator)(nil).Auth))
+}
+
+// Mockcodestar is a mock of codestar interface.
+type Mockcodestar struct {
+	ctrl     *gomock.Controller
+	recorder *MockcodestarMockRecorder
+}
+
+// MockcodestarMockRecorder is the mock recorder for Mockcodestar.
+type MockcodestarMockRecorder struct {
+	mock *Mockcodestar
+}
+
+// NewMockcodestar creates a new mock instance.
+func NewMockcodestar(ctrl *gomock.Controller) *Mockcodestar {
+	mock := &Mockcodestar{ctrl: ctrl}
+	mock.recorder = &MockcodestarMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockcodestar) EXPECT() *MockcodestarMockRecorder {
+	return m.recorder
+}
+
+// GetConnectionARN mocks base method.
+func (m *Mockcodestar) GetConnectionARN(arg0 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnectionARN", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnectionARN indicates an expected call of GetConnectionARN.
+func (mr *MockcodestarMockRecorder) GetConnectionARN(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectionARN", reflect.TypeOf((*Mockcodestar)(nil).GetConnectionARN), arg0)
+}
+
+// MockpublicIPGetter is a mock of publicIPGetter interface.
+type MockpublicIPGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpublicIPGetterMockRecorder
+}
+
+// MockpublicIPGetterMockRecorder is the mock recorder for MockpublicIPGetter.
+type MockpublicIPGetterMockRecorder struct {
+	mock *MockpublicIPGetter
+}
+
+// NewMockpublicIPGetter creates a new mock instance.
+func NewMockpublicIPGetter(ctrl *gomock.Controller) *MockpublicIPGetter {
+	mock := &MockpublicIPGetter{ctrl: ctrl}
+	mock.recorder = &MockpublicIPGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpublicIPGetter) EXPECT() *MockpublicIPGetterMockRecorder {
+	return m.recorder
+}
+
+// PublicIP mocks base method.
+func (m *MockpublicIPGetter) PublicIP(ENI string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicIP", ENI)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicIP indicates an expected call of PublicIP.
+func (mr *MockpublicIPGetterMockRecorder) PublicIP(ENI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicIP", reflect.TypeOf((*MockpublicIPGetter)(nil).PublicIP), ENI)
+}
+
+// MockcliStringer is a mock of cliStringer interface.
+type MockcliStringer struct {
+	ctrl     *gomock.Controller
+	recorder *MockcliStringerMockRecorder
+}
+
+// MockcliStringerMockRecorder is the mock recorder for MockcliStringer.
+type MockcliStringerMockRecorder struct {
+	mock *MockcliStringer
+}
+
+// NewMockcliStringer creates a new mock instance.
+func NewMockcliStringer(ctrl *gomock.Controller) *MockcliStringer {
+	mock := &MockcliStringer{ctrl: ctrl}
+	mock.recorder = &MockcliStringerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcliStringer) EXPECT() *MockcliStringerMockRecorder {
+	return m.recorder
+}
+
+// CLIString mocks base method.
+func (m *MockcliStringer) CLIString() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CLIString")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CLIString indicates an expected call of CLIString.
+func (mr *MockcliStringerMockRecorder) CLIString() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CLIString", reflect.TypeOf((*MockcliStringer)(nil).CLIString))
+}
+
+// MockartifactReader is a mock of artifactReader interface.
+type MockartifactReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockartifactReaderMockRecorder
+}
+
+// MockartifactReaderMockRecorder is the mock recorder for MockartifactReader.
+type MockartifactReaderMockRecorder struct {
+	mock *MockartifactReader
+}
+
+// NewMockartifactReader creates a new mock instance.
+func NewMockartifactReader(ctrl *gomock.Controller) *MockartifactReader {
+	mock := &MockartifactReader{ctrl: ctrl}
+	mock.recorder = &MockartifactReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockartifactReader) EXPECT() *MockartifactReaderMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method.
+func (m *MockartifactReader) Download(bucket, key string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", bucket, key)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockartifactReaderMockRecorder) Download(bucket, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockartifactReader)(nil).Download), bucket, key)
+}
+
+// ObjectKeys mocks base method.
+func (m *MockartifactReader) ObjectKeys(bucket, prefix string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ObjectKeys", bucket, prefix)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ObjectKeys indicates an expected call of ObjectKeys.
+func (mr *MockartifactReaderMockRecorder) ObjectKeys(bucket, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObjectKeys", reflect.TypeOf((*MockartifactReader)(nil).ObjectKeys), bucket, prefix)
+}
+
+// MockprovenanceGetter is a mock of provenanceGetter interface.
+type MockprovenanceGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockprovenanceGetterMockRecorder
+}
+
+// MockprovenanceGetterMockRecorder is the mock recorder for MockprovenanceGetter.
+type MockprovenanceGetterMockRecorder struct {
+	mock *MockprovenanceGetter
+}
+
+// NewMockprovenanceGetter creates a new mock instance.
+func NewMockprovenanceGetter(ctrl *gomock.Controller) *MockprovenanceGetter {
+	mock := &MockprovenanceGetter{ctrl: ctrl}
+	mock.recorder = &MockprovenanceGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockprovenanceGetter) EXPECT() *MockprovenanceGetterMockRecorder {
+	return m.recorder
+}
+
+// GetSecretValue mocks base method.
+func (m *MockprovenanceGetter) GetSecretValue(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretValue", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretValue indicates an expected call of GetSecretValue.
+func (mr *MockprovenanceGetterMockRecorder) GetSecretValue(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretValue", reflect.TypeOf((*MockprovenanceGetter)(nil).GetSecretValue), name)
+}
+
+// MockimageVerifier is a mock of imageVerifier interface.
+type MockimageVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageVerifierMockRecorder
+}
+
+// MockimageVerifierMockRecorder is the mock recorder for MockimageVerifier.
+type MockimageVerifierMockRecorder struct {
+	mock *MockimageVerifier
+}
+
+// NewMockimageVerifier creates a new mock instance.
+func NewMockimageVerifier(ctrl *gomock.Controller) *MockimageVerifier {
+	mock := &MockimageVerifier{ctrl: ctrl}
+	mock.recorder = &MockimageVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageVerifier) EXPECT() *MockimageVerifierMockRecorder {
+	return m.recorder
+}
+
+// Verify mocks base method.
+func (m *MockimageVerifier) Verify(image, kmsKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", image, kmsKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockimageVerifierMockRecorder) Verify(image, kmsKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockimageVerifier)(nil).Verify), image, kmsKey)
+}
+
+// MocksecretPutter is a mock of secretPutter interface.
+type MocksecretPutter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretPutterMockRecorder
+}
+
+// MocksecretPutterMockRecorder is the mock recorder for MocksecretPutter.
+type MocksecretPutterMockRecorder struct {
+	mock *MocksecretPutter
+}
+
+// NewMocksecretPutter creates a new mock instance.
+func NewMocksecretPutter(ctrl *gomock.Controller) *MocksecretPutter {
+	mock := &MocksecretPutter{ctrl: ctrl}
+	mock.recorder = &MocksecretPutterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretPutter) EXPECT() *MocksecretPutterMockRecorder {
+	return m.recorder
+}
+
+// PutSecret mocks base method.
+func (m *MocksecretPutter) PutSecret(in ssm.PutSecretInput) (*ssm.PutSecretOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutSecret", in)
+	ret0, _ := ret[0].(*ssm.PutSecretOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutSecret indicates an expected call of PutSecret.
+func (mr *MocksecretPutterMockRecorder) PutSecret(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretPutter)(nil).PutSecret), in)
+}
+
+// MockparameterSecretsClient is a mock of parameterSecretsClient interface.
+type MockparameterSecretsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockparameterSecretsClientMockRecorder
+}
+
+// MockparameterSecretsClientMockRecorder is the mock recorder for MockparameterSecretsClient.
+type MockparameterSecretsClientMockRecorder struct {
+	mock *MockparameterSecretsClient
+}
+
+// NewMockparameterSecretsClient creates a new mock instance.
+func NewMockparameterSecretsClient(ctrl *gomock.Controller) *MockparameterSecretsClient {
+	mock := &MockparameterSecretsClient{ctrl: ctrl}
+	mock.recorder = &MockparameterSecretsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockparameterSecretsClient) EXPECT() *MockparameterSecretsClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteSecret mocks base method.
+func (m *MockparameterSecretsClient) DeleteSecret(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MockparameterSecretsClientMockRecorder) DeleteSecret(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockparameterSecretsClient)(nil).DeleteSecret), name)
+}
+
+// GetSecretValue mocks base method.
+func (m *MockparameterSecretsClient) GetSecretValue(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretValue", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockstaticSourceSelectorMockRecorder is the mock recorder for MockstaticSourceSelector.
-type MockstaticSourceSelectorMockRecorder struct {
-	mock *MockstaticSourceSelector
+// GetSecretValue indicates an expected call of GetSecretValue.
+func (mr *MockparameterSecretsClientMockRecorder) GetSecretValue(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretValue", reflect.TypeOf((*MockparameterSecretsClient)(nil).GetSecretValue), name)
 }
 
-// NewMockstaticSourceSelector creates a new mock instance.
-func NewMockstaticSourceSelector(ctrl *gomock.Controller) *MockstaticSourceSelector {
-	mock := &MockstaticSourceSelector{ctrl: ctrl}
-	mock.recorder = &MockstaticSourceSelectorMockRecorder{mock}
-	return mock
+// ListSecrets mocks base method.
+func (m *MockparameterSecretsClient) ListSecrets(tags map[string]string) ([]ssm.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", tags)
+	ret0, _ := ret[0].([]ssm.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockstaticSourceSelector) EXPECT() *MockstaticSourceSelectorMockRecorder {
-	return m.recorder
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MockparameterSecretsClientMockRecorder) ListSecrets(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockparameterSecretsClient)(nil).ListSecrets), tags)
 }
 
-// StaticSources mocks base method.
-func (m *MockstaticSourceSelector) StaticSources(selPrompt, selHelp, anotherPathPrompt, anotherPathHelp string, pathValidator prompt.ValidatorFunc) ([]string, error) {
+// PutSecret mocks base method.
+func (m *MockparameterSecretsClient) PutSecret(in ssm.PutSecretInput) (*ssm.PutSecretOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StaticSources", selPrompt, selHelp, anotherPathPrompt, anotherPathHelp, pathValidator)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "PutSecret", in)
+	ret0, _ := ret[0].(*ssm.PutSecretOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// StaticSources indicates an expected call of StaticSources.
-func (mr *MockstaticSourceSelectorMockRecorder) StaticSources(selPrompt, selHelp, anotherPathPrompt, anotherPathHelp, pathValidator interface{}) *gomock.Call {
+// PutSecret indicates an expected call of PutSecret.
+func (mr *MockparameterSecretsClientMockRecorder) PutSecret(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StaticSources", reflect.TypeOf((*MockstaticSourceSelector)(nil).StaticSources), selPrompt, selHelp, anotherPathPrompt, anotherPathHelp, pathValidator)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MockparameterSecretsClient)(nil).PutSecret), in)
 }
 
-// MockscheduleSelector is a mock of scheduleSelector interface.
-type MockscheduleSelector struct {
+// MocksecretsManagerSecretsClient is a mock of secretsManagerSecretsClient interface.
+type MocksecretsManagerSecretsClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockscheduleSelectorMockRecorder
+	recorder *MocksecretsManagerSecretsClientMockRecorder
 }
 
-// MockscheduleSelectorMockRecorder is the mock recorder for MockscheduleSelector.
-type MockscheduleSelectorMockRecorder struct {
-	mock *MockscheduleSelector
+// MocksecretsManagerSecretsClientMockRecorder is the mock recorder for MocksecretsManagerSecretsClient.
+type MocksecretsManagerSecretsClientMockRecorder struct {
+	mock *MocksecretsManagerSecretsClient
 }
 
-// NewMockscheduleSelector creates a new mock instance.
-func NewMockscheduleSelector(ctrl *gomock.Controller) *MockscheduleSelector {
-	mock := &MockscheduleSelector{ctrl: ctrl}
-	mock.recorder = &MockscheduleSelectorMockRecorder{mock}
+// NewMocksecretsManagerSecretsClient creates a new mock instance.
+func NewMocksecretsManagerSecretsClient(ctrl *gomock.Controller) *MocksecretsManagerSecretsClient {
+	mock := &MocksecretsManagerSecretsClient{ctrl: ctrl}
+	mock.recorder = &MocksecretsManagerSecretsClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockscheduleSelector) EXPECT() *MockscheduleSelectorMockRecorder {
+func (m *MocksecretsManagerSecretsClient) EXPECT() *MocksecretsManagerSecretsClientMockRecorder {
 	return m.recorder
 }
 
-// Schedule mocks base method.
-func (m *MockscheduleSelector) Schedule(scheduleTypePrompt, scheduleTypeHelp string, scheduleValidator, rateValidator prompt.ValidatorFunc) (string, error) {
+// GetSecretValue mocks base method.
+func (m *MocksecretsManagerSecretsClient) GetSecretValue(name string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Schedule", scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator)
+	ret := m.ctrl.Call(m, "GetSecretValue", name)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Schedule indicates an expected call of Schedule.
-func (mr *MockscheduleSelectorMockRecorder) Schedule(scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator interface{}) *gomock.Call {
+// GetSecretValue indicates an expected call of GetSecretValue.
+func (mr *MocksecretsManagerSecretsClientMockRecorder) GetSecretValue(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockscheduleSelector)(nil).Schedule), scheduleTypePrompt, scheduleTypeHelp, scheduleValidator, rateValidator)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretValue", reflect.TypeOf((*MocksecretsManagerSecretsClient)(nil).GetSecretValue), name)
 }
 
-// MockcfTaskSelector is a mock of cfTaskSelector interface.
-type MockcfTaskSelector struct {
+// ListSecrets mocks base method.
+func (m *MocksecretsManagerSecretsClient) ListSecrets(tags map[string]string) ([]secretsmanager.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", tags)
+	ret0, _ := ret[0].([]secretsmanager.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MocksecretsManagerSecretsClientMockRecorder) ListSecrets(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MocksecretsManagerSecretsClient)(nil).ListSecrets), tags)
+}
+
+// MockefsDescriber is a mock of efsDescriber interface.
+type MockefsDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *MockcfTaskSelectorMockRecorder
+	recorder *MockefsDescriberMockRecorder
 }
 
-// MockcfTaskSelectorMockRecorder is the mock recorder for MockcfTaskSelector.
-type MockcfTaskSelectorMockRecorder struct {
-	mock *MockcfTaskSelector
+// MockefsDescriberMockRecorder is the mock recorder for MockefsDescriber.
+type MockefsDescriberMockRecorder struct {
+	mock *MockefsDescriber
 }
 
-// NewMockcfTaskSelector creates a new mock instance.
-func NewMockcfTaskSelector(ctrl *gomock.Controller) *MockcfTaskSelector {
-	mock := &MockcfTaskSelector{ctrl: ctrl}
-	mock.recorder = &MockcfTaskSelectorMockRecorder{mock}
+// NewMockefsDescriber creates a new mock instance.
+func NewMockefsDescriber(ctrl *gomock.Controller) *MockefsDescriber {
+	mock := &MockefsDescriber{ctrl: ctrl}
+	mock.recorder = &MockefsDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockcfTaskSelector) EXPECT() *MockcfTaskSelectorMockRecorder {
+func (m *MockefsDescriber) EXPECT() *MockefsDescriberMockRecorder {
 	return m.recorder
 }
 
-// Task mocks base method.
-func (m *MockcfTaskSelector) Task(prompt, help string, opts ...selector.GetDeployedTaskOpts) (string, error) {
+// AccessPoints mocks base method.
+func (m *MockefsDescriber) AccessPoints(fsID string) ([]efs.AccessPoint, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Task", varargs...)
+	ret := m.ctrl.Call(m, "AccessPoints", fsID)
+	ret0, _ := ret[0].([]efs.AccessPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccessPoints indicates an expected call of AccessPoints.
+func (mr *MockefsDescriberMockRecorder) AccessPoints(fsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccessPoints", reflect.TypeOf((*MockefsDescriber)(nil).AccessPoints), fsID)
+}
+
+// BackupStatus mocks base method.
+func (m *MockefsDescriber) BackupStatus(fsID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupStatus", fsID)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Task indicates an expected call of Task.
-func (mr *MockcfTaskSelectorMockRecorder) Task(prompt, help interface{}, opts ...interface{}) *gomock.Call {
+// BackupStatus indicates an expected call of BackupStatus.
+func (mr *MockefsDescriberMockRecorder) BackupStatus(fsID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Task", reflect.TypeOf((*MockcfTaskSelector)(nil).Task), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupStatus", reflect.TypeOf((*MockefsDescriber)(nil).BackupStatus), fsID)
 }
 
-// MockdockerfileSelector is a mock of dockerfileSelector interface.
-type MockdockerfileSelector struct {
-	ctrl     *gomock.Controller
-	recorder *MockdockerfileSelectorMockRecorder
+// ListFileSystems mocks base method.
+func (m *MockefsDescriber) ListFileSystems(tags map[string]string) ([]efs.FileSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFileSystems", tags)
+	ret0, _ := ret[0].([]efs.FileSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockdockerfileSelectorMockRecorder is the mock recorder for MockdockerfileSelector.
-type MockdockerfileSelectorMockRecorder struct {
-	mock *MockdockerfileSelector
+// ListFileSystems indicates an expected call of ListFileSystems.
+func (mr *MockefsDescriberMockRecorder) ListFileSystems(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFileSystems", reflect.TypeOf((*MockefsDescriber)(nil).ListFileSystems), tags)
 }
 
-// NewMockdockerfileSelector creates a new mock instance.
-func NewMockdockerfileSelector(ctrl *gomock.Controller) *MockdockerfileSelector {
-	mock := &MockdockerfileSelector{ctrl: ctrl}
-	mock.recorder = &MockdockerfileSelectorMockRecorder{mock}
-	return mock
+// MountTargets mocks base method.
+func (m *MockefsDescriber) MountTargets(fsID string) ([]efs.MountTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MountTargets", fsID)
+	ret0, _ := ret[0].([]efs.MountTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockdockerfileSelector) EXPECT() *MockdockerfileSelectorMockRecorder {
-	return m.recorder
+// MountTargets indicates an expected call of MountTargets.
+func (mr *MockefsDescriberMockRecorder) MountTargets(fsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MountTargets", reflect.TypeOf((*MockefsDescriber)(nil).MountTargets), fsID)
 }
 
-// Dockerfile mocks base method.
-func (m *MockdockerfileSelector) Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoundHelp string, pv prompt.ValidatorFunc) (string, error) {
+// TransitionToIA mocks base method.
+func (m *MockefsDescriber) TransitionToIA(fsID string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Dockerfile", selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv)
+	ret := m.ctrl.Call(m, "TransitionToIA", fsID)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Dockerfile indicates an expected call of Dockerfile.
-func (mr *MockdockerfileSelectorMockRecorder) Dockerfile(selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv interface{}) *gomock.Call {
+// TransitionToIA indicates an expected call of TransitionToIA.
+func (mr *MockefsDescriberMockRecorder) TransitionToIA(fsID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dockerfile", reflect.TypeOf((*MockdockerfileSelector)(nil).Dockerfile), selPrompt, notFoundPrompt, selHelp, notFoundHelp, pv)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionToIA", reflect.TypeOf((*MockefsDescriber)(nil).TransitionToIA), fsID)
 }
 
-// MocktopicSelector is a mock of topicSelector interface.
-type MocktopicSelector struct {
+// MockresourcesByTagsGetter is a mock of resourcesByTagsGetter interface.
+type MockresourcesByTagsGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MocktopicSelectorMockRecorder
+	recorder *MockresourcesByTagsGetterMockRecorder
 }
 
-// MocktopicSelectorMockRecorder is the mock recorder for MocktopicSelector.
-type MocktopicSelectorMockRecorder struct {
-	mock *MocktopicSelector
+// MockresourcesByTagsGetterMockRecorder is the mock recorder for MockresourcesByTagsGetter.
+type MockresourcesByTagsGetterMockRecorder struct {
+	mock *MockresourcesByTagsGetter
 }
 
-// NewMocktopicSelector creates a new mock instance.
-func NewMocktopicSelector(ctrl *gomock.Controller) *MocktopicSelector {
-	mock := &MocktopicSelector{ctrl: ctrl}
-	mock.recorder = &MocktopicSelectorMockRecorder{mock}
+// NewMockresourcesByTagsGetter creates a new mock instance.
+func NewMockresourcesByTagsGetter(ctrl *gomock.Controller) *MockresourcesByTagsGetter {
+	mock := &MockresourcesByTagsGetter{ctrl: ctrl}
+	mock.recorder = &MockresourcesByTagsGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocktopicSelector) EXPECT() *MocktopicSelectorMockRecorder {
+func (m *MockresourcesByTagsGetter) EXPECT() *MockresourcesByTagsGetterMockRecorder {
 	return m.recorder
 }
 
-// Topics mocks base method.
-func (m *MocktopicSelector) Topics(prompt, help, app string) ([]deploy0.Topic, error) {
+// GetResourcesByTags mocks base method.
+func (m *MockresourcesByTagsGetter) GetResourcesByTags(resourceType string, tags map[string]string) ([]*resourcegroups.Resource, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Topics", prompt, help, app)
-	ret0, _ := ret[0].([]deploy0.Topic)
+	ret := m.ctrl.Call(m, "GetResourcesByTags", resourceType, tags)
+	ret0, _ := ret[0].([]*resourcegroups.Resource)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Topics indicates an expected call of Topics.
-func (mr *MocktopicSelectorMockRecorder) Topics(prompt, help, app interface{}) *gomock.Call {
+// GetResourcesByTags indicates an expected call of GetResourcesByTags.
+func (mr *MockresourcesByTagsGetterMockRecorder) GetResourcesByTags(resourceType, tags interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Topics", reflect.TypeOf((*MocktopicSelector)(nil).Topics), prompt, help, app)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourcesByTags", reflect.TypeOf((*MockresourcesByTagsGetter)(nil).GetResourcesByTags), resourceType, tags)
 }
 
-// Mockec2Selector is a mock of ec2Selector interface.
-type Mockec2Selector struct {
+// MockdynamoDBTableDescriber is a mock of dynamoDBTableDescriber interface.
+type MockdynamoDBTableDescriber struct {
 	ctrl     *gomock.Controller
-	recorder *Mockec2SelectorMockRecorder
+	recorder *MockdynamoDBTableDescriberMockRecorder
 }
 
-// Mockec2SelectorMockRecorder is the mock recorder for Mockec2Selector.
-type Mockec2SelectorMockRecorder struct {
-	mock *Mockec2Selector
+// MockdynamoDBTableDescriberMockRecorder is the mock recorder for MockdynamoDBTableDescriber.
+type MockdynamoDBTableDescriberMockRecorder struct {
+	mock *MockdynamoDBTableDescriber
 }
 
-// NewMockec2Selector creates a new mock instance.
-func NewMockec2Selector(ctrl *gomock.Controller) *Mockec2Selector {
-	mock := &Mockec2Selector{ctrl: ctrl}
-	mock.recorder = &Mockec2SelectorMockRecorder{mock}
+// NewMockdynamoDBTableDescriber creates a new mock instance.
+func NewMockdynamoDBTableDescriber(ctrl *gomock.Controller) *MockdynamoDBTableDescriber {
+	mock := &MockdynamoDBTableDescriber{ctrl: ctrl}
+	mock.recorder = &MockdynamoDBTableDescriberMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *Mockec2Selector) EXPECT() *Mockec2SelectorMockRecorder {
+func (m *MockdynamoDBTableDescriber) EXPECT() *MockdynamoDBTableDescriberMockRecorder {
 	return m.recorder
 }
 
-// Subnets mocks base method.
-func (m *Mockec2Selector) Subnets(input selector.SubnetsInput) ([]string, error) {
+// DescribeTable mocks base method.
+func (m *MockdynamoDBTableDescriber) DescribeTable(name string) (*dynamodb.Table, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Subnets", input)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "DescribeTable", name)
+	ret0, _ := ret[0].(*dynamodb.Table)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Subnets indicates an expected call of Subnets.
-func (mr *Mockec2SelectorMockRecorder) Subnets(input interface{}) *gomock.Call {
+// DescribeTable indicates an expected call of DescribeTable.
+func (mr *MockdynamoDBTableDescriberMockRecorder) DescribeTable(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subnets", reflect.TypeOf((*Mockec2Selector)(nil).Subnets), input)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTable", reflect.TypeOf((*MockdynamoDBTableDescriber)(nil).DescribeTable), name)
 }
 
-// VPC mocks base method.
-func (m *Mockec2Selector) VPC(prompt, help string) (string, error) {
+// TTLAttribute mocks base method.
+func (m *MockdynamoDBTableDescriber) TTLAttribute(name string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VPC", prompt, help)
+	ret := m.ctrl.Call(m, "TTLAttribute", name)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// VPC indicates an expected call of VPC.
-func (mr *Mockec2SelectorMockRecorder) VPC(prompt, help interface{}) *gomock.Call {
+// TTLAttribute indicates an expected call of TTLAttribute.
+func (mr *MockdynamoDBTableDescriberMockRecorder) TTLAttribute(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VPC", reflect.TypeOf((*Mockec2Selector)(nil).VPC), prompt, help)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TTLAttribute", reflect.TypeOf((*MockdynamoDBTableDescriber)(nil).TTLAttribute), name)
 }
 
-// MockcredsSelector is a mock of credsSelector interface.
-type MockcredsSelector struct {
+// MockrdsClusterLister is a mock of rdsClusterLister interface.
+type MockrdsClusterLister struct {
 	ctrl     *gomock.Controller
-	recorder *MockcredsSelectorMockRecorder
+	recorder *MockrdsClusterListerMockRecorder
 }
 
-// MockcredsSelectorMockRecorder is the mock recorder for MockcredsSelector.
-type MockcredsSelectorMockRecorder struct {
-	mock *MockcredsSelector
+// MockrdsClusterListerMockRecorder is the mock recorder for MockrdsClusterLister.
+type MockrdsClusterListerMockRecorder struct {
+	mock *MockrdsClusterLister
 }
 
-// NewMockcredsSelector creates a new mock instance.
-func NewMockcredsSelector(ctrl *gomock.Controller) *MockcredsSelector {
-	mock := &MockcredsSelector{ctrl: ctrl}
-	mock.recorder = &MockcredsSelectorMockRecorder{mock}
+// NewMockrdsClusterLister creates a new mock instance.
+func NewMockrdsClusterLister(ctrl *gomock.Controller) *MockrdsClusterLister {
+	mock := &MockrdsClusterLister{ctrl: ctrl}
+	mock.recorder = &MockrdsClusterListerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockcredsSelector) EXPECT() *MockcredsSelectorMockRecorder {
+func (m *MockrdsClusterLister) EXPECT() *MockrdsClusterListerMockRecorder {
 	return m.recorder
 }
 
-// Creds mocks base method.
-func (m *MockcredsSelector) Creds(prompt, help string) (*session.Session, error) {
+// ListClusters mocks base method.
+func (m *MockrdsClusterLister) ListClusters(tags map[string]string) ([]rds.Cluster, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Creds", prompt, help)
-	ret0, _ := ret[0].(*session.Session)
+	ret := m.ctrl.Call(m, "ListClusters", tags)
+	ret0, _ := ret[0].([]rds.Cluster)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Creds indicates an expected call of Creds.
-func (mr *MockcredsSelectorMockRecorder) Creds(prompt, help interface{}) *gomock.Call {
+// ListClusters indicates an expected call of ListClusters.
+func (mr *MockrdsClusterListerMockRecorder) ListClusters(tags interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Creds", reflect.TypeOf((*MockcredsSelector)(nil).Creds), prompt, help)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockrdsClusterLister)(nil).ListClusters), tags)
 }
 
-// Mockec2Client is a mock of ec2Client interface.
-type Mockec2Client struct {
+// MockrdsClusterStopper is a mock of rdsClusterStopper interface.
+type MockrdsClusterStopper struct {
 	ctrl     *gomock.Controller
-	recorder *Mockec2ClientMockRecorder
+	recorder *MockrdsClusterStopperMockRecorder
 }
 
-// Mockec2ClientMockRecorder is the mock recorder for Mockec2Client.
-type Mockec2ClientMockRecorder struct {
-	mock *Mockec2Client
+// MockrdsClusterStopperMockRecorder is the mock recorder for MockrdsClusterStopper.
+type MockrdsClusterStopperMockRecorder struct {
+	mock *MockrdsClusterStopper
 }
 
-// NewMockec2Client creates a new mock instance.
-func NewMockec2Client(ctrl *gomock.Controller) *Mockec2Client {
-	mock := &Mockec2Client{ctrl: ctrl}
-	mock.recorder = &Mockec2ClientMockRecorder{mock}
+// NewMockrdsClusterStopper creates a new mock instance.
+func NewMockrdsClusterStopper(ctrl *gomock.Controller) *MockrdsClusterStopper {
+	mock := &MockrdsClusterStopper{ctrl: ctrl}
+	mock.recorder = &MockrdsClusterStopperMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *Mockec2Client) EXPECT() *Mockec2ClientMockRecorder {
+func (m *MockrdsClusterStopper) EXPECT() *MockrdsClusterStopperMockRecorder {
 	return m.recorder
 }
 
-// HasDNSSupport mocks base method.
-func (m *Mockec2Client) HasDNSSupport(vpcID string) (bool, error) {
+// ListClusters mocks base method.
+func (m *MockrdsClusterStopper) ListClusters(tags map[string]string) ([]rds.Cluster, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "HasDNSSupport", vpcID)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "ListClusters", tags)
+	ret0, _ := ret[0].([]rds.Cluster)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// HasDNSSupport indicates an expected call of HasDNSSupport.
-func (mr *Mockec2ClientMockRecorder) HasDNSSupport(vpcID interface{}) *gomock.Call {
+// ListClusters indicates an expected call of ListClusters.
+func (mr *MockrdsClusterStopperMockRecorder) ListClusters(tags interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDNSSupport", reflect.TypeOf((*Mockec2Client)(nil).HasDNSSupport), vpcID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockrdsClusterStopper)(nil).ListClusters), tags)
 }
 
-// ListAZs mocks base method.
-func (m *Mockec2Client) ListAZs() ([]ec2.AZ, error) {
+// StopCluster mocks base method.
+func (m *MockrdsClusterStopper) StopCluster(id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAZs")
-	ret0, _ := ret[0].([]ec2.AZ)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "StopCluster", id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListAZs indicates an expected call of ListAZs.
-func (mr *Mockec2ClientMockRecorder) ListAZs() *gomock.Call {
+// StopCluster indicates an expected call of StopCluster.
+func (mr *MockrdsClusterStopperMockRecorder) StopCluster(id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAZs", reflect.TypeOf((*Mockec2Client)(nil).ListAZs))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopCluster", reflect.TypeOf((*MockrdsClusterStopper)(nil).StopCluster), id)
 }
 
-// MockserviceResumer is a mock of serviceResumer interface.
-type MockserviceResumer struct {
+// MockwsAddonDeleter is a mock of wsAddonDeleter interface.
+type MockwsAddonDeleter struct {
 	ctrl     *gomock.Controller
-	recorder *MockserviceResumerMockRecorder
+	recorder *MockwsAddonDeleterMockRecorder
 }
 
-// MockserviceResumerMockRecorder is the mock recorder for MockserviceResumer.
-type MockserviceResumerMockRecorder struct {
-	mock *MockserviceResumer
+// MockwsAddonDeleterMockRecorder is the mock recorder for MockwsAddonDeleter.
+type MockwsAddonDeleterMockRecorder struct {
+	mock *MockwsAddonDeleter
 }
 
-// NewMockserviceResumer creates a new mock instance.
-func NewMockserviceResumer(ctrl *gomock.Controller) *MockserviceResumer {
-	mock := &MockserviceResumer{ctrl: ctrl}
-	mock.recorder = &MockserviceResumerMockRecorder{mock}
+// NewMockwsAddonDeleter creates a new mock instance.
+func NewMockwsAddonDeleter(ctrl *gomock.Controller) *MockwsAddonDeleter {
+	mock := &MockwsAddonDeleter{ctrl: ctrl}
+	mock.recorder = &MockwsAddonDeleterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockserviceResumer) EXPECT() *MockserviceResumerMockRecorder {
+func (m *MockwsAddonDeleter) EXPECT() *MockwsAddonDeleterMockRecorder {
 	return m.recorder
 }
 
-// ResumeService mocks base method.
-func (m *MockserviceResumer) ResumeService(arg0 string) error {
+// DeleteEnvAddonFile mocks base method.
+func (m *MockwsAddonDeleter) DeleteEnvAddonFile(fName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEnvAddonFile", fName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEnvAddonFile indicates an expected call of DeleteEnvAddonFile.
+func (mr *MockwsAddonDeleterMockRecorder) DeleteEnvAddonFile(fName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnvAddonFile", reflect.TypeOf((*MockwsAddonDeleter)(nil).DeleteEnvAddonFile), fName)
+}
+
+// DeleteWorkloadAddonFile mocks base method.
+func (m *MockwsAddonDeleter) DeleteWorkloadAddonFile(wkldName, fName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkloadAddonFile", wkldName, fName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkloadAddonFile indicates an expected call of DeleteWorkloadAddonFile.
+func (mr *MockwsAddonDeleterMockRecorder) DeleteWorkloadAddonFile(wkldName, fName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkloadAddonFile", reflect.TypeOf((*MockwsAddonDeleter)(nil).DeleteWorkloadAddonFile), wkldName, fName)
+}
+
+// EnvAddonsAbsPath mocks base method.
+func (m *MockwsAddonDeleter) EnvAddonsAbsPath() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvAddonsAbsPath")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// EnvAddonsAbsPath indicates an expected call of EnvAddonsAbsPath.
+func (mr *MockwsAddonDeleterMockRecorder) EnvAddonsAbsPath() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvAddonsAbsPath", reflect.TypeOf((*MockwsAddonDeleter)(nil).EnvAddonsAbsPath))
+}
+
+// ListFiles mocks base method.
+func (m *MockwsAddonDeleter) ListFiles(dirPath string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFiles", dirPath)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFiles indicates an expected call of ListFiles.
+func (mr *MockwsAddonDeleterMockRecorder) ListFiles(dirPath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiles", reflect.TypeOf((*MockwsAddonDeleter)(nil).ListFiles), dirPath)
+}
+
+// ListWorkloads mocks base method.
+func (m *MockwsAddonDeleter) ListWorkloads() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkloads")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkloads indicates an expected call of ListWorkloads.
+func (mr *MockwsAddonDeleterMockRecorder) ListWorkloads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkloads", reflect.TypeOf((*MockwsAddonDeleter)(nil).ListWorkloads))
+}
+
+// WorkloadAddonsAbsPath mocks base method.
+func (m *MockwsAddonDeleter) WorkloadAddonsAbsPath(name string) string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResumeService", arg0)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "WorkloadAddonsAbsPath", name)
+	ret0, _ := ret[0].(string)
 	return ret0
 }
 
-// ResumeService indicates an expected call of ResumeService.
-func (mr *MockserviceResumerMockRecorder) ResumeService(arg0 interface{}) *gomock.Call {
+// WorkloadAddonsAbsPath indicates an expected call of WorkloadAddonsAbsPath.
+func (mr *MockwsAddonDeleterMockRecorder) WorkloadAddonsAbsPath(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeService", reflect.TypeOf((*MockserviceResumer)(nil).ResumeService), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadAddonsAbsPath", reflect.TypeOf((*MockwsAddonDeleter)(nil).WorkloadAddonsAbsPath), name)
 }
 
-// MockjobInitializer is a mock of jobInitializer interface.
-type MockjobInitializer struct {
+// MockservicePauser is a mock of servicePauser interface.
+type MockservicePauser struct {
 	ctrl     *gomock.Controller
-	recorder *MockjobInitializerMockRecorder
+	recorder *MockservicePauserMockRecorder
 }
 
-// MockjobInitializerMockRecorder is the mock recorder for MockjobInitializer.
-type MockjobInitializerMockRecorder struct {
-	mock *MockjobInitializer
+// MockservicePauserMockRecorder is the mock recorder for MockservicePauser.
+type MockservicePauserMockRecorder struct {
+	mock *MockservicePauser
 }
 
-// NewMockjobInitializer creates a new mock instance.
-func NewMockjobInitializer(ctrl *gomock.Controller) *MockjobInitializer {
-	mock := &MockjobInitializer{ctrl: ctrl}
-	mock.recorder = &MockjobInitializerMockRecorder{mock}
+// NewMockservicePauser creates a new mock instance.
+func NewMockservicePauser(ctrl *gomock.Controller) *MockservicePauser {
+	mock := &MockservicePauser{ctrl: ctrl}
+	mock.recorder = &MockservicePauserMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockjobInitializer) EXPECT() *MockjobInitializerMockRecorder {
+func (m *MockservicePauser) EXPECT() *MockservicePauserMockRecorder {
 	return m.recorder
 }
 
-// Job mocks base method.
-func (m *MockjobInitializer) Job(props *initialize.JobProps) (string, error) {
+// PauseService mocks base method.
+func (m *MockservicePauser) PauseService(svcARN string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Job", props)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "PauseService", svcARN)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Job indicates an expected call of Job.
-func (mr *MockjobInitializerMockRecorder) Job(props interface{}) *gomock.Call {
+// PauseService indicates an expected call of PauseService.
+func (mr *MockservicePauserMockRecorder) PauseService(svcARN interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Job", reflect.TypeOf((*MockjobInitializer)(nil).Job), props)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseService", reflect.TypeOf((*MockservicePauser)(nil).PauseService), svcARN)
 }
 
-// MocksvcInitializer is a mock of svcInitializer interface.
-type MocksvcInitializer struct {
+// MockdashboardPutter is a mock of dashboardPutter interface.
+type MockdashboardPutter struct {
 	ctrl     *gomock.Controller
-	recorder *MocksvcInitializerMockRecorder
+	recorder *MockdashboardPutterMockRecorder
 }
 
-// MocksvcInitializerMockRecorder is the mock recorder for MocksvcInitializer.
-type MocksvcInitializerMockRecorder struct {
-	mock *MocksvcInitializer
+// MockdashboardPutterMockRecorder is the mock recorder for MockdashboardPutter.
+type MockdashboardPutterMockRecorder struct {
+	mock *MockdashboardPutter
 }
 
-// NewMocksvcInitializer creates a new mock instance.
-func NewMocksvcInitializer(ctrl *gomock.Controller) *MocksvcInitializer {
-	mock := &MocksvcInitializer{ctrl: ctrl}
-	mock.recorder = &MocksvcInitializerMockRecorder{mock}
+// NewMockdashboardPutter creates a new mock instance.
+func NewMockdashboardPutter(ctrl *gomock.Controller) *MockdashboardPutter {
+	mock := &MockdashboardPutter{ctrl: ctrl}
+	mock.recorder = &MockdashboardPutterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocksvcInitializer) EXPECT() *MocksvcInitializerMockRecorder {
+func (m *MockdashboardPutter) EXPECT() *MockdashboardPutterMockRecorder {
 	return m.recorder
 }
 
-// Service mocks base method.
-func (m *MocksvcInitializer) Service(props *initialize.ServiceProps) (string, error) {
+// AlarmStatuses mocks base method.
+func (m *MockdashboardPutter) AlarmStatuses(opts ...cloudwatch.DescribeAlarmOpts) ([]cloudwatch.AlarmStatus, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Service", props)
-	ret0, _ := ret[0].(string)
+	varargs := []interface{}{}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AlarmStatuses", varargs...)
+	ret0, _ := ret[0].([]cloudwatch.AlarmStatus)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Service indicates an expected call of Service.
-func (mr *MocksvcInitializerMockRecorder) Service(props interface{}) *gomock.Call {
+// AlarmStatuses indicates an expected call of AlarmStatuses.
+func (mr *MockdashboardPutterMockRecorder) AlarmStatuses(opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MocksvcInitializer)(nil).Service), props)
-}
-
-// MockroleDeleter is a mock of roleDeleter interface.
-type MockroleDeleter struct {
-	ctrl     *gomock.Controller
-	recorder *MockroleDeleterMockRecorder
-}
-
-// MockroleDeleterMockRecorder is the mock recorder for MockroleDeleter.
-type MockroleDeleterMockRecorder struct {
-	mock *MockroleDeleter
-}
-
-// NewMockroleDeleter creates a new mock instance.
-func NewMockroleDeleter(ctrl *gomock.Controller) *MockroleDeleter {
-	mock := &MockroleDeleter{ctrl: ctrl}
-	mock.recorder = &MockroleDeleterMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockroleDeleter) EXPECT() *MockroleDeleterMockRecorder {
-	return m.recorder
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlarmStatuses", reflect.TypeOf((*MockdashboardPutter)(nil).AlarmStatuses), opts...)
 }
 
-// DeleteRole mocks base method.
-func (m *MockroleDeleter) DeleteRole(arg0 string) error {
+// PutDashboard mocks base method.
+func (m *MockdashboardPutter) PutDashboard(name string, dashboard *cloudwatch.Dashboard) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRole", arg0)
+	ret := m.ctrl.Call(m, "PutDashboard", name, dashboard)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteRole indicates an expected call of DeleteRole.
-func (mr *MockroleDeleterMockRecorder) DeleteRole(arg0 interface{}) *gomock.Call {
+// PutDashboard indicates an expected call of PutDashboard.
+func (mr *MockdashboardPutterMockRecorder) PutDashboard(name, dashboard interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockroleDeleter)(nil).DeleteRole), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDashboard", reflect.TypeOf((*MockdashboardPutter)(nil).PutDashboard), name, dashboard)
 }
 
-// MockpolicyLister is a mock of policyLister interface.
-type MockpolicyLister struct {
+// MockecsServicePauser is a mock of ecsServicePauser interface.
+type MockecsServicePauser struct {
 	ctrl     *gomock.Controller
-	recorder *MockpolicyListerMockRecorder
+	recorder *MockecsServicePauserMockRecorder
 }
 
-// MockpolicyListerMockRecorder is the mock recorder for MockpolicyLister.
-type MockpolicyListerMockRecorder struct {
-	mock *MockpolicyLister
+// MockecsServicePauserMockRecorder is the mock recorder for MockecsServicePauser.
+type MockecsServicePauserMockRecorder struct {
+	mock *MockecsServicePauser
 }
 
-// NewMockpolicyLister creates a new mock instance.
-func NewMockpolicyLister(ctrl *gomock.Controller) *MockpolicyLister {
-	mock := &MockpolicyLister{ctrl: ctrl}
-	mock.recorder = &MockpolicyListerMockRecorder{mock}
+// NewMockecsServicePauser creates a new mock instance.
+func NewMockecsServicePauser(ctrl *gomock.Controller) *MockecsServicePauser {
+	mock := &MockecsServicePauser{ctrl: ctrl}
+	mock.recorder = &MockecsServicePauserMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockpolicyLister) EXPECT() *MockpolicyListerMockRecorder {
+func (m *MockecsServicePauser) EXPECT() *MockecsServicePauserMockRecorder {
 	return m.recorder
 }
 
-// ListPolicyNames mocks base method.
-func (m *MockpolicyLister) ListPolicyNames() ([]string, error) {
+// PauseService mocks base method.
+func (m *MockecsServicePauser) PauseService(app, env, svc string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPolicyNames")
-	ret0, _ := ret[0].([]string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "PauseService", app, env, svc)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListPolicyNames indicates an expected call of ListPolicyNames.
-func (mr *MockpolicyListerMockRecorder) ListPolicyNames() *gomock.Call {
+// PauseService indicates an expected call of PauseService.
+func (mr *MockecsServicePauserMockRecorder) PauseService(app, env, svc interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicyNames", reflect.TypeOf((*MockpolicyLister)(nil).ListPolicyNames))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseService", reflect.TypeOf((*MockecsServicePauser)(nil).PauseService), app, env, svc)
 }
 
-// MockserviceDescriber is a mock of serviceDescriber interface.
-type MockserviceDescriber struct {
+// MockecsServiceScaler is a mock of ecsServiceScaler interface.
+type MockecsServiceScaler struct {
 	ctrl     *gomock.Controller
-	recorder *MockserviceDescriberMockRecorder
+	recorder *MockecsServiceScalerMockRecorder
 }
 
-// MockserviceDescriberMockRecorder is the mock recorder for MockserviceDescriber.
-type MockserviceDescriberMockRecorder struct {
-	mock *MockserviceDescriber
+// MockecsServiceScalerMockRecorder is the mock recorder for MockecsServiceScaler.
+type MockecsServiceScalerMockRecorder struct {
+	mock *MockecsServiceScaler
 }
 
-// NewMockserviceDescriber creates a new mock instance.
-func NewMockserviceDescriber(ctrl *gomock.Controller) *MockserviceDescriber {
-	mock := &MockserviceDescriber{ctrl: ctrl}
-	mock.recorder = &MockserviceDescriberMockRecorder{mock}
+// NewMockecsServiceScaler creates a new mock instance.
+func NewMockecsServiceScaler(ctrl *gomock.Controller) *MockecsServiceScaler {
+	mock := &MockecsServiceScaler{ctrl: ctrl}
+	mock.recorder = &MockecsServiceScalerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockserviceDescriber) EXPECT() *MockserviceDescriberMockRecorder {
+func (m *MockecsServiceScaler) EXPECT() *MockecsServiceScalerMockRecorder {
 	return m.recorder
 }
 
-// DescribeService mocks base method.
-func (m *MockserviceDescriber) DescribeService(app, env, svc string) (*ecs0.ServiceDesc, error) {
+// AutoscalingCapacity mocks base method.
+func (m *MockecsServiceScaler) AutoscalingCapacity(app, env, svc string) (*aas.Capacity, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DescribeService", app, env, svc)
-	ret0, _ := ret[0].(*ecs0.ServiceDesc)
+	ret := m.ctrl.Call(m, "AutoscalingCapacity", app, env, svc)
+	ret0, _ := ret[0].(*aas.Capacity)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DescribeService indicates an expected call of DescribeService.
-func (mr *MockserviceDescriberMockRecorder) DescribeService(app, env, svc interface{}) *gomock.Call {
+// AutoscalingCapacity indicates an expected call of AutoscalingCapacity.
+func (mr *MockecsServiceScalerMockRecorder) AutoscalingCapacity(app, env, svc interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeService", reflect.TypeOf((*MockserviceDescriber)(nil).DescribeService), app, env, svc)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoscalingCapacity", reflect.TypeOf((*MockecsServiceScaler)(nil).AutoscalingCapacity), app, env, svc)
 }
 
-// MockapprunnerServiceDescriber is a mock of apprunnerServiceDescriber interface.
-type MockapprunnerServiceDescriber struct {
+// ScaleService mocks base method.
+func (m *MockecsServiceScaler) ScaleService(app, env, svc string, count int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleService", app, env, svc, count)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScaleService indicates an expected call of ScaleService.
+func (mr *MockecsServiceScalerMockRecorder) ScaleService(app, env, svc, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleService", reflect.TypeOf((*MockecsServiceScaler)(nil).ScaleService), app, env, svc, count)
+}
+
+// MockecsServiceResumer is a mock of ecsServiceResumer interface.
+type MockecsServiceResumer struct {
 	ctrl     *gomock.Controller
-	recorder *MockapprunnerServiceDescriberMockRecorder
+	recorder *MockecsServiceResumerMockRecorder
 }
 
-// MockapprunnerServiceDescriberMockRecorder is the mock recorder for MockapprunnerServiceDescriber.
-type MockapprunnerServiceDescriberMockRecorder struct {
-	mock *MockapprunnerServiceDescriber
+// MockecsServiceResumerMockRecorder is the mock recorder for MockecsServiceResumer.
+type MockecsServiceResumerMockRecorder struct {
+	mock *MockecsServiceResumer
 }
 
-// NewMockapprunnerServiceDescriber creates a new mock instance.
-func NewMockapprunnerServiceDescriber(ctrl *gomock.Controller) *MockapprunnerServiceDescriber {
-	mock := &MockapprunnerServiceDescriber{ctrl: ctrl}
-	mock.recorder = &MockapprunnerServiceDescriberMockRecorder{mock}
+// NewMockecsServiceResumer creates a new mock instance.
+func NewMockecsServiceResumer(ctrl *gomock.Controller) *MockecsServiceResumer {
+	mock := &MockecsServiceResumer{ctrl: ctrl}
+	mock.recorder = &MockecsServiceResumerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockapprunnerServiceDescriber) EXPECT() *MockapprunnerServiceDescriberMockRecorder {
+func (m *MockecsServiceResumer) EXPECT() *MockecsServiceResumerMockRecorder {
 	return m.recorder
 }
 
-// ServiceARN mocks base method.
-func (m *MockapprunnerServiceDescriber) ServiceARN(env string) (string, error) {
+// ResumeService mocks base method.
+func (m *MockecsServiceResumer) ResumeService(app, env, svc string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ServiceARN", env)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "ResumeService", app, env, svc)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ServiceARN indicates an expected call of ServiceARN.
-func (mr *MockapprunnerServiceDescriberMockRecorder) ServiceARN(env interface{}) *gomock.Call {
+// ResumeService indicates an expected call of ResumeService.
+func (mr *MockecsServiceResumerMockRecorder) ResumeService(app, env, svc interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceARN", reflect.TypeOf((*MockapprunnerServiceDescriber)(nil).ServiceARN), env)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeService", reflect.TypeOf((*MockecsServiceResumer)(nil).ResumeService), app, env, svc)
 }
 
-// MockecsCommandExecutor is a mock of ecsCommandExecutor interface.
-type MockecsCommandExecutor struct {
+// Mockinterpolator is a mock of interpolator interface.
+type Mockinterpolator struct {
 	ctrl     *gomock.Controller
-	recorder *MockecsCommandExecutorMockRecorder
+	recorder *MockinterpolatorMockRecorder
 }
 
-// MockecsCommandExecutorMockRecorder is the mock recorder for MockecsCommandExecutor.
-type MockecsCommandExecutorMockRecorder struct {
-	mock *MockecsCommandExecutor
+// MockinterpolatorMockRecorder is the mock recorder for Mockinterpolator.
+type MockinterpolatorMockRecorder struct {
+	mock *Mockinterpolator
 }
 
-// NewMockecsCommandExecutor creates a new mock instance.
-func NewMockecsCommandExecutor(ctrl *gomock.Controller) *MockecsCommandExecutor {
-	mock := &MockecsCommandExecutor{ctrl: ctrl}
-	mock.recorder = &MockecsCommandExecutorMockRecorder{mock}
+// NewMockinterpolator creates a new mock instance.
+func NewMockinterpolator(ctrl *gomock.Controller) *Mockinterpolator {
+	mock := &Mockinterpolator{ctrl: ctrl}
+	mock.recorder = &MockinterpolatorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockecsCommandExecutor) EXPECT() *MockecsCommandExecutorMockRecorder {
+func (m *Mockinterpolator) EXPECT() *MockinterpolatorMockRecorder {
 	return m.recorder
 }
 
-// ExecuteCommand mocks base method.
-func (m *MockecsCommandExecutor) ExecuteCommand(in ecs.ExecuteCommandInput) error {
+// Interpolate mocks base method.
+func (m *Mockinterpolator) Interpolate(s string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExecuteCommand", in)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Interpolate", s)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ExecuteCommand indicates an expected call of ExecuteCommand.
-func (mr *MockecsCommandExecutorMockRecorder) ExecuteCommand(in interface{}) *gomock.Call {
+// Interpolate indicates an expected call of Interpolate.
+func (mr *MockinterpolatorMockRecorder) Interpolate(s interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockecsCommandExecutor)(nil).ExecuteCommand), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interpolate", reflect.TypeOf((*Mockinterpolator)(nil).Interpolate), s)
 }
 
-// MockssmPluginManager is a mock of ssmPluginManager interface.
-type MockssmPluginManager struct {
+// MockworkloadDeployer is a mock of workloadDeployer interface.
+type MockworkloadDeployer struct {
 	ctrl     *gomock.Controller
-	recorder *MockssmPluginManagerMockRecorder
+	recorder *MockworkloadDeployerMockRecorder
 }
 
-// MockssmPluginManagerMockRecorder is the mock recorder for MockssmPluginManager.
-type MockssmPluginManagerMockRecorder struct {
-	mock *MockssmPluginManager
+// MockworkloadDeployerMockRecorder is the mock recorder for MockworkloadDeployer.
+type MockworkloadDeployerMockRecorder struct {
+	mock *MockworkloadDeployer
 }
 
-// NewMockssmPluginManager creates a new mock instance.
-func NewMockssmPluginManager(ctrl *gomock.Controller) *MockssmPluginManager {
-	mock := &MockssmPluginManager{ctrl: ctrl}
-	mock.recorder = &MockssmPluginManagerMockRecorder{mock}
+// NewMockworkloadDeployer creates a new mock instance.
+func NewMockworkloadDeployer(ctrl *gomock.Controller) *MockworkloadDeployer {
+	mock := &MockworkloadDeployer{ctrl: ctrl}
+	mock.recorder = &MockworkloadDeployerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockssmPluginManager) EXPECT() *MockssmPluginManagerMockRecorder {
+func (m *MockworkloadDeployer) EXPECT() *MockworkloadDeployerMockRecorder {
 	return m.recorder
 }
 
-// InstallLatestBinary mocks base method.
-func (m *MockssmPluginManager) InstallLatestBinary() error {
+// AddonsTemplate mocks base method.
+func (m *MockworkloadDeployer) AddonsTemplate() (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InstallLatestBinary")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "AddonsTemplate")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// InstallLatestBinary indicates an expected call of InstallLatestBinary.
-func (mr *MockssmPluginManagerMockRecorder) InstallLatestBinary() *gomock.Call {
+// AddonsTemplate indicates an expected call of AddonsTemplate.
+func (mr *MockworkloadDeployerMockRecorder) AddonsTemplate() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallLatestBinary", reflect.TypeOf((*MockssmPluginManager)(nil).InstallLatestBinary))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsTemplate", reflect.TypeOf((*MockworkloadDeployer)(nil).AddonsTemplate))
 }
 
-// ValidateBinary mocks base method.
-func (m *MockssmPluginManager) ValidateBinary() error {
+// DeployDiff mocks base method.
+func (m *MockworkloadDeployer) DeployDiff(inTmpl string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateBinary")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ValidateBinary indicates an expected call of ValidateBinary.
-func (mr *MockssmPluginManagerMockRecorder) ValidateBinary() *gomock.Call {
+// DeployDiff indicates an expected call of DeployDiff.
+func (mr *MockworkloadDeployerMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBinary", reflect.TypeOf((*MockssmPluginManager)(nil).ValidateBinary))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockworkloadDeployer)(nil).DeployDiff), inTmpl)
 }
 
-// MocktaskStopper is a mock of taskStopper interface.
-type MocktaskStopper struct {
-	ctrl     *gomock.Controller
-	recorder *MocktaskStopperMockRecorder
+// DeployDiffJSON mocks base method.
+func (m *MockworkloadDeployer) DeployDiffJSON(inTmpl string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployDiffJSON", inTmpl)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MocktaskStopperMockRecorder is the mock recorder for MocktaskStopper.
-type MocktaskStopperMockRecorder struct {
-	mock *MocktaskStopper
+// DeployDiffJSON indicates an expected call of DeployDiffJSON.
+func (mr *MockworkloadDeployerMockRecorder) DeployDiffJSON(inTmpl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiffJSON", reflect.TypeOf((*MockworkloadDeployer)(nil).DeployDiffJSON), inTmpl)
 }
 
-// NewMocktaskStopper creates a new mock instance.
-func NewMocktaskStopper(ctrl *gomock.Controller) *MocktaskStopper {
-	mock := &MocktaskStopper{ctrl: ctrl}
-	mock.recorder = &MocktaskStopperMockRecorder{mock}
-	return mock
+// DeployWorkload mocks base method.
+func (m *MockworkloadDeployer) DeployWorkload(in *deploy.DeployWorkloadInput) (deploy.ActionRecommender, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployWorkload", in)
+	ret0, _ := ret[0].(deploy.ActionRecommender)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocktaskStopper) EXPECT() *MocktaskStopperMockRecorder {
-	return m.recorder
+// DeployWorkload indicates an expected call of DeployWorkload.
+func (mr *MockworkloadDeployerMockRecorder) DeployWorkload(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployWorkload", reflect.TypeOf((*MockworkloadDeployer)(nil).DeployWorkload), in)
 }
 
-// StopDefaultClusterTasks mocks base method.
-func (m *MocktaskStopper) StopDefaultClusterTasks(familyName string) error {
+// GenerateCloudFormationTemplate mocks base method.
+func (m *MockworkloadDeployer) GenerateCloudFormationTemplate(in *deploy.GenerateCloudFormationTemplateInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StopDefaultClusterTasks", familyName)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GenerateCloudFormationTemplate", in)
+	ret0, _ := ret[0].(*deploy.GenerateCloudFormationTemplateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// StopDefaultClusterTasks indicates an expected call of StopDefaultClusterTasks.
-func (mr *MocktaskStopperMockRecorder) StopDefaultClusterTasks(familyName interface{}) *gomock.Call {
+// GenerateCloudFormationTemplate indicates an expected call of GenerateCloudFormationTemplate.
+func (mr *MockworkloadDeployerMockRecorder) GenerateCloudFormationTemplate(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopDefaultClusterTasks", reflect.TypeOf((*MocktaskStopper)(nil).StopDefaultClusterTasks), familyName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCloudFormationTemplate", reflect.TypeOf((*MockworkloadDeployer)(nil).GenerateCloudFormationTemplate), in)
 }
 
-// StopOneOffTasks mocks base method.
-func (m *MocktaskStopper) StopOneOffTasks(app, env, family string) error {
+// IsServiceAvailableInRegion mocks base method.
+func (m *MockworkloadDeployer) IsServiceAvailableInRegion(region string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StopOneOffTasks", app, env, family)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "IsServiceAvailableInRegion", region)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// StopOneOffTasks indicates an expected call of StopOneOffTasks.
-func (mr *MocktaskStopperMockRecorder) StopOneOffTasks(app, env, family interface{}) *gomock.Call {
+// IsServiceAvailableInRegion indicates an expected call of IsServiceAvailableInRegion.
+func (mr *MockworkloadDeployerMockRecorder) IsServiceAvailableInRegion(region interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopOneOffTasks", reflect.TypeOf((*MocktaskStopper)(nil).StopOneOffTasks), app, env, family)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsServiceAvailableInRegion", reflect.TypeOf((*MockworkloadDeployer)(nil).IsServiceAvailableInRegion), region)
 }
 
-// StopWorkloadTasks mocks base method.
-func (m *MocktaskStopper) StopWorkloadTasks(app, env, workload string) error {
+// UploadArtifacts mocks base method.
+func (m *MockworkloadDeployer) UploadArtifacts() (*deploy.UploadArtifactsOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StopWorkloadTasks", app, env, workload)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UploadArtifacts")
+	ret0, _ := ret[0].(*deploy.UploadArtifactsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// StopWorkloadTasks indicates an expected call of StopWorkloadTasks.
-func (mr *MocktaskStopperMockRecorder) StopWorkloadTasks(app, env, workload interface{}) *gomock.Call {
+// UploadArtifacts indicates an expected call of UploadArtifacts.
+func (mr *MockworkloadDeployerMockRecorder) UploadArtifacts() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopWorkloadTasks", reflect.TypeOf((*MocktaskStopper)(nil).StopWorkloadTasks), app, env, workload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadArtifacts", reflect.TypeOf((*MockworkloadDeployer)(nil).UploadArtifacts))
 }
 
-// MockserviceLinkedRoleCreator is a mock of serviceLinkedRoleCreator interface.
-type MockserviceLinkedRoleCreator struct {
+// MockaddonsTemplateGetter is a mock of addonsTemplateGetter interface.
+type MockaddonsTemplateGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockserviceLinkedRoleCreatorMockRecorder
+	recorder *MockaddonsTemplateGetterMockRecorder
 }
 
-// MockserviceLinkedRoleCreatorMockRecorder is the mock recorder for MockserviceLinkedRoleCreator.
-type MockserviceLinkedRoleCreatorMockRecorder struct {
-	mock *MockserviceLinkedRoleCreator
+// MockaddonsTemplateGetterMockRecorder is the mock recorder for MockaddonsTemplateGetter.
+type MockaddonsTemplateGetterMockRecorder struct {
+	mock *MockaddonsTemplateGetter
 }
 
-// NewMockserviceLinkedRoleCreator creates a new mock instance.
-func NewMockserviceLinkedRoleCreator(ctrl *gomock.Controller) *MockserviceLinkedRoleCreator {
-	mock := &MockserviceLinkedRoleCreator{ctrl: ctrl}
-	mock.recorder = &MockserviceLinkedRoleCreatorMockRecorder{mock}
+// NewMockaddonsTemplateGetter creates a new mock instance.
+func NewMockaddonsTemplateGetter(ctrl *gomock.Controller) *MockaddonsTemplateGetter {
+	mock := &MockaddonsTemplateGetter{ctrl: ctrl}
+	mock.recorder = &MockaddonsTemplateGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockserviceLinkedRoleCreator) EXPECT() *MockserviceLinkedRoleCreatorMockRecorder {
+func (m *MockaddonsTemplateGetter) EXPECT() *MockaddonsTemplateGetterMockRecorder {
 	return m.recorder
 }
 
-// CreateECSServiceLinkedRole mocks base method.
-func (m *MockserviceLinkedRoleCreator) CreateECSServiceLinkedRole() error {
+// AddonsTemplate mocks base method.
+func (m *MockaddonsTemplateGetter) AddonsTemplate() (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateECSServiceLinkedRole")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "AddonsTemplate")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateECSServiceLinkedRole indicates an expected call of CreateECSServiceLinkedRole.
-func (mr *MockserviceLinkedRoleCreatorMockRecorder) CreateECSServiceLinkedRole() *gomock.Call {
+// AddonsTemplate indicates an expected call of AddonsTemplate.
+func (mr *MockaddonsTemplateGetterMockRecorder) AddonsTemplate() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateECSServiceLinkedRole", reflect.TypeOf((*MockserviceLinkedRoleCreator)(nil).CreateECSServiceLinkedRole))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsTemplate", reflect.TypeOf((*MockaddonsTemplateGetter)(nil).AddonsTemplate))
 }
 
-// MockroleTagsLister is a mock of roleTagsLister interface.
-type MockroleTagsLister struct {
+// MockdriftReverter is a mock of driftReverter interface.
+type MockdriftReverter struct {
 	ctrl     *gomock.Controller
-	recorder *MockroleTagsListerMockRecorder
+	recorder *MockdriftReverterMockRecorder
 }
 
-// MockroleTagsListerMockRecorder is the mock recorder for MockroleTagsLister.
-type MockroleTagsListerMockRecorder struct {
-	mock *MockroleTagsLister
+// MockdriftReverterMockRecorder is the mock recorder for MockdriftReverter.
+type MockdriftReverterMockRecorder struct {
+	mock *MockdriftReverter
 }
 
-// NewMockroleTagsLister creates a new mock instance.
-func NewMockroleTagsLister(ctrl *gomock.Controller) *MockroleTagsLister {
-	mock := &MockroleTagsLister{ctrl: ctrl}
-	mock.recorder = &MockroleTagsListerMockRecorder{mock}
+// NewMockdriftReverter creates a new mock instance.
+func NewMockdriftReverter(ctrl *gomock.Controller) *MockdriftReverter {
+	mock := &MockdriftReverter{ctrl: ctrl}
+	mock.recorder = &MockdriftReverterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockroleTagsLister) EXPECT() *MockroleTagsListerMockRecorder {
+func (m *MockdriftReverter) EXPECT() *MockdriftReverterMockRecorder {
 	return m.recorder
 }
 
-// ListRoleTags mocks base method.
-func (m *MockroleTagsLister) ListRoleTags(arg0 string) (map[string]string, error) {
+// RevertedDrift mocks base method.
+func (m *MockdriftReverter) RevertedDrift(out *deploy.GenerateCloudFormationTemplateOutput) ([]deploy.DriftedParameter, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRoleTags", arg0)
-	ret0, _ := ret[0].(map[string]string)
+	ret := m.ctrl.Call(m, "RevertedDrift", out)
+	ret0, _ := ret[0].([]deploy.DriftedParameter)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListRoleTags indicates an expected call of ListRoleTags.
-func (mr *MockroleTagsListerMockRecorder) ListRoleTags(arg0 interface{}) *gomock.Call {
+// RevertedDrift indicates an expected call of RevertedDrift.
+func (mr *MockdriftReverterMockRecorder) RevertedDrift(out interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleTags", reflect.TypeOf((*MockroleTagsLister)(nil).ListRoleTags), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertedDrift", reflect.TypeOf((*MockdriftReverter)(nil).RevertedDrift), out)
 }
 
-// MockroleManager is a mock of roleManager interface.
-type MockroleManager struct {
+// MockworkloadManifestEditor is a mock of workloadManifestEditor interface.
+type MockworkloadManifestEditor struct {
 	ctrl     *gomock.Controller
-	recorder *MockroleManagerMockRecorder
+	recorder *MockworkloadManifestEditorMockRecorder
 }
 
-// MockroleManagerMockRecorder is the mock recorder for MockroleManager.
-type MockroleManagerMockRecorder struct {
-	mock *MockroleManager
+// MockworkloadManifestEditorMockRecorder is the mock recorder for MockworkloadManifestEditor.
+type MockworkloadManifestEditorMockRecorder struct {
+	mock *MockworkloadManifestEditor
 }
 
-// NewMockroleManager creates a new mock instance.
-func NewMockroleManager(ctrl *gomock.Controller) *MockroleManager {
-	mock := &MockroleManager{ctrl: ctrl}
-	mock.recorder = &MockroleManagerMockRecorder{mock}
+// NewMockworkloadManifestEditor creates a new mock instance.
+func NewMockworkloadManifestEditor(ctrl *gomock.Controller) *MockworkloadManifestEditor {
+	mock := &MockworkloadManifestEditor{ctrl: ctrl}
+	mock.recorder = &MockworkloadManifestEditorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockroleManager) EXPECT() *MockroleManagerMockRecorder {
+func (m *MockworkloadManifestEditor) EXPECT() *MockworkloadManifestEditorMockRecorder {
 	return m.recorder
 }
 
-// CreateECSServiceLinkedRole mocks base method.
-func (m *MockroleManager) CreateECSServiceLinkedRole() error {
+// EditWorkloadEnvOverrides mocks base method.
+func (m *MockworkloadManifestEditor) EditWorkloadEnvOverrides(name, env string, edit func([]byte) ([]byte, error)) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateECSServiceLinkedRole")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "EditWorkloadEnvOverrides", name, env, edit)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateECSServiceLinkedRole indicates an expected call of CreateECSServiceLinkedRole.
-func (mr *MockroleManagerMockRecorder) CreateECSServiceLinkedRole() *gomock.Call {
+// EditWorkloadEnvOverrides indicates an expected call of EditWorkloadEnvOverrides.
+func (mr *MockworkloadManifestEditorMockRecorder) EditWorkloadEnvOverrides(name, env, edit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateECSServiceLinkedRole", reflect.TypeOf((*MockroleManager)(nil).CreateECSServiceLinkedRole))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditWorkloadEnvOverrides", reflect.TypeOf((*MockworkloadManifestEditor)(nil).EditWorkloadEnvOverrides), name, env, edit)
 }
 
-// DeleteRole mocks base method.
-func (m *MockroleManager) DeleteRole(arg0 string) error {
+// EditWorkloadManifest mocks base method.
+func (m *MockworkloadManifestEditor) EditWorkloadManifest(name string, edit func([]byte) ([]byte, error)) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRole", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "EditWorkloadManifest", name, edit)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteRole indicates an expected call of DeleteRole.
-func (mr *MockroleManagerMockRecorder) DeleteRole(arg0 interface{}) *gomock.Call {
+// EditWorkloadManifest indicates an expected call of EditWorkloadManifest.
+func (mr *MockworkloadManifestEditorMockRecorder) EditWorkloadManifest(name, edit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockroleManager)(nil).DeleteRole), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditWorkloadManifest", reflect.TypeOf((*MockworkloadManifestEditor)(nil).EditWorkloadManifest), name, edit)
 }
 
-// ListRoleTags mocks base method.
-func (m *MockroleManager) ListRoleTags(arg0 string) (map[string]string, error) {
+// MocktemplateDiffer is a mock of templateDiffer interface.
+type MocktemplateDiffer struct {
+	ctrl     *gomock.Controller
+	recorder *MocktemplateDifferMockRecorder
+}
+
+// MocktemplateDifferMockRecorder is the mock recorder for MocktemplateDiffer.
+type MocktemplateDifferMockRecorder struct {
+	mock *MocktemplateDiffer
+}
+
+// NewMocktemplateDiffer creates a new mock instance.
+func NewMocktemplateDiffer(ctrl *gomock.Controller) *MocktemplateDiffer {
+	mock := &MocktemplateDiffer{ctrl: ctrl}
+	mock.recorder = &MocktemplateDifferMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktemplateDiffer) EXPECT() *MocktemplateDifferMockRecorder {
+	return m.recorder
+}
+
+// DeployDiff mocks base method.
+func (m *MocktemplateDiffer) DeployDiff(inTmpl string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRoleTags", arg0)
-	ret0, _ := ret[0].(map[string]string)
+	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListRoleTags indicates an expected call of ListRoleTags.
-func (mr *MockroleManagerMockRecorder) ListRoleTags(arg0 interface{}) *gomock.Call {
+// DeployDiff indicates an expected call of DeployDiff.
+func (mr *MocktemplateDifferMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleTags", reflect.TypeOf((*MockroleManager)(nil).ListRoleTags), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MocktemplateDiffer)(nil).DeployDiff), inTmpl)
 }
 
-// MockstackExistChecker is a mock of stackExistChecker interface.
-type MockstackExistChecker struct {
+// MockjsonTemplateDiffer is a mock of jsonTemplateDiffer interface.
+type MockjsonTemplateDiffer struct {
 	ctrl     *gomock.Controller
-	recorder *MockstackExistCheckerMockRecorder
+	recorder *MockjsonTemplateDifferMockRecorder
 }
 
-// MockstackExistCheckerMockRecorder is the mock recorder for MockstackExistChecker.
-type MockstackExistCheckerMockRecorder struct {
-	mock *MockstackExistChecker
+// MockjsonTemplateDifferMockRecorder is the mock recorder for MockjsonTemplateDiffer.
+type MockjsonTemplateDifferMockRecorder struct {
+	mock *MockjsonTemplateDiffer
 }
 
-// NewMockstackExistChecker creates a new mock instance.
-func NewMockstackExistChecker(ctrl *gomock.Controller) *MockstackExistChecker {
-	mock := &MockstackExistChecker{ctrl: ctrl}
-	mock.recorder = &MockstackExistCheckerMockRecorder{mock}
+// NewMockjsonTemplateDiffer creates a new mock instance.
+func NewMockjsonTemplateDiffer(ctrl *gomock.Controller) *MockjsonTemplateDiffer {
+	mock := &MockjsonTemplateDiffer{ctrl: ctrl}
+	mock.recorder = &MockjsonTemplateDifferMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockstackExistChecker) EXPECT() *MockstackExistCheckerMockRecorder {
+func (m *MockjsonTemplateDiffer) EXPECT() *MockjsonTemplateDifferMockRecorder {
 	return m.recorder
 }
 
-// Exists mocks base method.
-func (m *MockstackExistChecker) Exists(arg0 string) (bool, error) {
+// DeployDiffJSON mocks base method.
+func (m *MockjsonTemplateDiffer) DeployDiffJSON(inTmpl string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Exists", arg0)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "DeployDiffJSON", inTmpl)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Exists indicates an expected call of Exists.
-func (mr *MockstackExistCheckerMockRecorder) Exists(arg0 interface{}) *gomock.Call {
+// DeployDiffJSON indicates an expected call of DeployDiffJSON.
+func (mr *MockjsonTemplateDifferMockRecorder) DeployDiffJSON(inTmpl interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockstackExistChecker)(nil).Exists), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiffJSON", reflect.TypeOf((*MockjsonTemplateDiffer)(nil).DeployDiffJSON), inTmpl)
 }
 
-// MockrunningTaskSelector is a mock of runningTaskSelector interface.
-type MockrunningTaskSelector struct {
+// MockworkloadStackGenerator is a mock of workloadStackGenerator interface.
+type MockworkloadStackGenerator struct {
 	ctrl     *gomock.Controller
-	recorder *MockrunningTaskSelectorMockRecorder
+	recorder *MockworkloadStackGeneratorMockRecorder
 }
 
-// MockrunningTaskSelectorMockRecorder is the mock recorder for MockrunningTaskSelector.
-type MockrunningTaskSelectorMockRecorder struct {
-	mock *MockrunningTaskSelector
+// MockworkloadStackGeneratorMockRecorder is the mock recorder for MockworkloadStackGenerator.
+type MockworkloadStackGeneratorMockRecorder struct {
+	mock *MockworkloadStackGenerator
 }
 
-// NewMockrunningTaskSelector creates a new mock instance.
-func NewMockrunningTaskSelector(ctrl *gomock.Controller) *MockrunningTaskSelector {
-	mock := &MockrunningTaskSelector{ctrl: ctrl}
-	mock.recorder = &MockrunningTaskSelectorMockRecorder{mock}
+// NewMockworkloadStackGenerator creates a new mock instance.
+func NewMockworkloadStackGenerator(ctrl *gomock.Controller) *MockworkloadStackGenerator {
+	mock := &MockworkloadStackGenerator{ctrl: ctrl}
+	mock.recorder = &MockworkloadStackGeneratorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockrunningTaskSelector) EXPECT() *MockrunningTaskSelectorMockRecorder {
+func (m *MockworkloadStackGenerator) EXPECT() *MockworkloadStackGeneratorMockRecorder {
 	return m.recorder
 }
-
-// RunningTask mocks base method.
-func (m *MockrunningTaskSelector) RunningTask(prompt, help string, opts ...selector.TaskOpts) (*ecs.Task, error) {
-	m.ctrl.T.Helper()
-	varargs := []interface{}{prompt, help}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "RunningTask", varargs...)
-	ret0, _ := ret[0].(*ecs.Task)
+
+// AddonsTemplate mocks base method.
+func (m *MockworkloadStackGenerator) AddonsTemplate() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddonsTemplate")
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RunningTask indicates an expected call of RunningTask.
-func (mr *MockrunningTaskSelectorMockRecorder) RunningTask(prompt, help interface{}, opts ...interface{}) *gomock.Call {
+// AddonsTemplate indicates an expected call of AddonsTemplate.
+func (mr *MockworkloadStackGeneratorMockRecorder) AddonsTemplate() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{prompt, help}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTask", reflect.TypeOf((*MockrunningTaskSelector)(nil).RunningTask), varargs...)
-}
-
-// MockdockerEngine is a mock of dockerEngine interface.
-type MockdockerEngine struct {
-	ctrl     *gomock.Controller
-	recorder *MockdockerEngineMockRecorder
-}
-
-// MockdockerEngineMockRecorder is the mock recorder for MockdockerEngine.
-type MockdockerEngineMockRecorder struct {
-	mock *MockdockerEngine
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsTemplate", reflect.TypeOf((*MockworkloadStackGenerator)(nil).AddonsTemplate))
 }
 
-// NewMockdockerEngine creates a new mock instance.
-func NewMockdockerEngine(ctrl *gomock.Controller) *MockdockerEngine {
-	mock := &MockdockerEngine{ctrl: ctrl}
-	mock.recorder = &MockdockerEngineMockRecorder{mock}
-	return mock
+// DeployDiff mocks base method.
+func (m *MockworkloadStackGenerator) DeployDiff(inTmpl string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockdockerEngine) EXPECT() *MockdockerEngineMockRecorder {
-	return m.recorder
+// DeployDiff indicates an expected call of DeployDiff.
+func (mr *MockworkloadStackGeneratorMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockworkloadStackGenerator)(nil).DeployDiff), inTmpl)
 }
 
-// CheckDockerEngineRunning mocks base method.
-func (m *MockdockerEngine) CheckDockerEngineRunning() error {
+// GenerateCloudFormationTemplate mocks base method.
+func (m *MockworkloadStackGenerator) GenerateCloudFormationTemplate(in *deploy.GenerateCloudFormationTemplateInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckDockerEngineRunning")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GenerateCloudFormationTemplate", in)
+	ret0, _ := ret[0].(*deploy.GenerateCloudFormationTemplateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CheckDockerEngineRunning indicates an expected call of CheckDockerEngineRunning.
-func (mr *MockdockerEngineMockRecorder) CheckDockerEngineRunning() *gomock.Call {
+// GenerateCloudFormationTemplate indicates an expected call of GenerateCloudFormationTemplate.
+func (mr *MockworkloadStackGeneratorMockRecorder) GenerateCloudFormationTemplate(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDockerEngineRunning", reflect.TypeOf((*MockdockerEngine)(nil).CheckDockerEngineRunning))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCloudFormationTemplate", reflect.TypeOf((*MockworkloadStackGenerator)(nil).GenerateCloudFormationTemplate), in)
 }
 
-// GetPlatform mocks base method.
-func (m *MockdockerEngine) GetPlatform() (string, string, error) {
+// UploadArtifacts mocks base method.
+func (m *MockworkloadStackGenerator) UploadArtifacts() (*deploy.UploadArtifactsOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPlatform")
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(string)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "UploadArtifacts")
+	ret0, _ := ret[0].(*deploy.UploadArtifactsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetPlatform indicates an expected call of GetPlatform.
-func (mr *MockdockerEngineMockRecorder) GetPlatform() *gomock.Call {
+// UploadArtifacts indicates an expected call of UploadArtifacts.
+func (mr *MockworkloadStackGeneratorMockRecorder) UploadArtifacts() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlatform", reflect.TypeOf((*MockdockerEngine)(nil).GetPlatform))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadArtifacts", reflect.TypeOf((*MockworkloadStackGenerator)(nil).UploadArtifacts))
 }
 
-// Mockcodestar is a mock of codestar interface.
-type Mockcodestar struct {
+// Mockrunner is a mock of runner interface.
+type Mockrunner struct {
 	ctrl     *gomock.Controller
-	recorder *MockcodestarMockRecorder
+	recorder *MockrunnerMockRecorder
 }
 
-// MockcodestarMockRecorder is the mock recorder for Mockcodestar.
-type MockcodestarMockRecorder struct {
-	mock *Mockcodestar
+// MockrunnerMockRecorder is the mock recorder for Mockrunner.
+type MockrunnerMockRecorder struct {
+	mock *Mockrunner
 }
 
-// NewMockcodestar creates a new mock instance.
-func NewMockcodestar(ctrl *gomock.Controller) *Mockcodestar {
-	mock := &Mockcodestar{ctrl: ctrl}
-	mock.recorder = &MockcodestarMockRecorder{mock}
+// NewMockrunner creates a new mock instance.
+func NewMockrunner(ctrl *gomock.Controller) *Mockrunner {
+	mock := &Mockrunner{ctrl: ctrl}
+	mock.recorder = &MockrunnerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *Mockcodestar) EXPECT() *MockcodestarMockRecorder {
+func (m *Mockrunner) EXPECT() *MockrunnerMockRecorder {
 	return m.recorder
 }
 
-// GetConnectionARN mocks base method.
-func (m *Mockcodestar) GetConnectionARN(arg0 string) (string, error) {
+// Run mocks base method.
+func (m *Mockrunner) Run() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetConnectionARN", arg0)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Run")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetConnectionARN indicates an expected call of GetConnectionARN.
-func (mr *MockcodestarMockRecorder) GetConnectionARN(arg0 interface{}) *gomock.Call {
+// Run indicates an expected call of Run.
+func (mr *MockrunnerMockRecorder) Run() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectionARN", reflect.TypeOf((*Mockcodestar)(nil).GetConnectionARN), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*Mockrunner)(nil).Run))
 }
 
-// MockpublicIPGetter is a mock of publicIPGetter interface.
-type MockpublicIPGetter struct {
+// MockdelayedRunner is a mock of delayedRunner interface.
+type MockdelayedRunner struct {
 	ctrl     *gomock.Controller
-	recorder *MockpublicIPGetterMockRecorder
+	recorder *MockdelayedRunnerMockRecorder
 }
 
-// MockpublicIPGetterMockRecorder is the mock recorder for MockpublicIPGetter.
-type MockpublicIPGetterMockRecorder struct {
-	mock *MockpublicIPGetter
+// MockdelayedRunnerMockRecorder is the mock recorder for MockdelayedRunner.
+type MockdelayedRunnerMockRecorder struct {
+	mock *MockdelayedRunner
 }
 
-// NewMockpublicIPGetter creates a new mock instance.
-func NewMockpublicIPGetter(ctrl *gomock.Controller) *MockpublicIPGetter {
-	mock := &MockpublicIPGetter{ctrl: ctrl}
-	mock.recorder = &MockpublicIPGetterMockRecorder{mock}
+// NewMockdelayedRunner creates a new mock instance.
+func NewMockdelayedRunner(ctrl *gomock.Controller) *MockdelayedRunner {
+	mock := &MockdelayedRunner{ctrl: ctrl}
+	mock.recorder = &MockdelayedRunnerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockpublicIPGetter) EXPECT() *MockpublicIPGetterMockRecorder {
+func (m *MockdelayedRunner) EXPECT() *MockdelayedRunnerMockRecorder {
 	return m.recorder
 }
 
-// PublicIP mocks base method.
-func (m *MockpublicIPGetter) PublicIP(ENI string) (string, error) {
+// RunAt mocks base method.
+func (m *MockdelayedRunner) RunAt(at time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PublicIP", ENI)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RunAt", at)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// PublicIP indicates an expected call of PublicIP.
-func (mr *MockpublicIPGetterMockRecorder) PublicIP(ENI interface{}) *gomock.Call {
+// RunAt indicates an expected call of RunAt.
+func (mr *MockdelayedRunnerMockRecorder) RunAt(at interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicIP", reflect.TypeOf((*MockpublicIPGetter)(nil).PublicIP), ENI)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunAt", reflect.TypeOf((*MockdelayedRunner)(nil).RunAt), at)
 }
 
-// MockcliStringer is a mock of cliStringer interface.
-type MockcliStringer struct {
+// MockenvDeployer is a mock of envDeployer interface.
+type MockenvDeployer struct {
 	ctrl     *gomock.Controller
-	recorder *MockcliStringerMockRecorder
+	recorder *MockenvDeployerMockRecorder
 }
 
-// MockcliStringerMockRecorder is the mock recorder for MockcliStringer.
-type MockcliStringerMockRecorder struct {
-	mock *MockcliStringer
+// MockenvDeployerMockRecorder is the mock recorder for MockenvDeployer.
+type MockenvDeployerMockRecorder struct {
+	mock *MockenvDeployer
 }
 
-// NewMockcliStringer creates a new mock instance.
-func NewMockcliStringer(ctrl *gomock.Controller) *MockcliStringer {
-	mock := &MockcliStringer{ctrl: ctrl}
-	mock.recorder = &MockcliStringerMockRecorder{mock}
+// NewMockenvDeployer creates a new mock instance.
+func NewMockenvDeployer(ctrl *gomock.Controller) *MockenvDeployer {
+	mock := &MockenvDeployer{ctrl: ctrl}
+	mock.recorder = &MockenvDeployerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockcliStringer) EXPECT() *MockcliStringerMockRecorder {
+func (m *MockenvDeployer) EXPECT() *MockenvDeployerMockRecorder {
 	return m.recorder
 }
 
-// CLIString mocks base method.
-func (m *MockcliStringer) CLIString() (string, error) {
+// AddonsTemplate mocks base method.
+func (m *MockenvDeployer) AddonsTemplate() (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CLIString")
+	ret := m.ctrl.Call(m, "AddonsTemplate")
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CLIString indicates an expected call of CLIString.
-func (mr *MockcliStringerMockRecorder) CLIString() *gomock.Call {
+// AddonsTemplate indicates an expected call of AddonsTemplate.
+func (mr *MockenvDeployerMockRecorder) AddonsTemplate() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CLIString", reflect.TypeOf((*MockcliStringer)(nil).CLIString))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsTemplate", reflect.TypeOf((*MockenvDeployer)(nil).AddonsTemplate))
 }
 
-// MocksecretPutter is a mock of secretPutter interface.
-type MocksecretPutter struct {
-	ctrl     *gomock.Controller
-	recorder *MocksecretPutterMockRecorder
+// DeployDiff mocks base method.
+func (m *MockenvDeployer) DeployDiff(inTmpl string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MocksecretPutterMockRecorder is the mock recorder for MocksecretPutter.
-type MocksecretPutterMockRecorder struct {
-	mock *MocksecretPutter
+// DeployDiff indicates an expected call of DeployDiff.
+func (mr *MockenvDeployerMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockenvDeployer)(nil).DeployDiff), inTmpl)
 }
 
-// NewMocksecretPutter creates a new mock instance.
-func NewMocksecretPutter(ctrl *gomock.Controller) *MocksecretPutter {
-	mock := &MocksecretPutter{ctrl: ctrl}
-	mock.recorder = &MocksecretPutterMockRecorder{mock}
-	return mock
+// DeployEnvironment mocks base method.
+func (m *MockenvDeployer) DeployEnvironment(in *deploy.DeployEnvironmentInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployEnvironment", in)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocksecretPutter) EXPECT() *MocksecretPutterMockRecorder {
-	return m.recorder
+// DeployEnvironment indicates an expected call of DeployEnvironment.
+func (mr *MockenvDeployerMockRecorder) DeployEnvironment(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironment", reflect.TypeOf((*MockenvDeployer)(nil).DeployEnvironment), in)
 }
 
-// PutSecret mocks base method.
-func (m *MocksecretPutter) PutSecret(in ssm.PutSecretInput) (*ssm.PutSecretOutput, error) {
+// GenerateCloudFormationTemplate mocks base method.
+func (m *MockenvDeployer) GenerateCloudFormationTemplate(in *deploy.DeployEnvironmentInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PutSecret", in)
-	ret0, _ := ret[0].(*ssm.PutSecretOutput)
+	ret := m.ctrl.Call(m, "GenerateCloudFormationTemplate", in)
+	ret0, _ := ret[0].(*deploy.GenerateCloudFormationTemplateOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PutSecret indicates an expected call of PutSecret.
-func (mr *MocksecretPutterMockRecorder) PutSecret(in interface{}) *gomock.Call {
+// GenerateCloudFormationTemplate indicates an expected call of GenerateCloudFormationTemplate.
+func (mr *MockenvDeployerMockRecorder) GenerateCloudFormationTemplate(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretPutter)(nil).PutSecret), in)
-}
-
-// MockservicePauser is a mock of servicePauser interface.
-type MockservicePauser struct {
-	ctrl     *gomock.Controller
-	recorder *MockservicePauserMockRecorder
-}
-
-// MockservicePauserMockRecorder is the mock recorder for MockservicePauser.
-type MockservicePauserMockRecorder struct {
-	mock *MockservicePauser
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCloudFormationTemplate", reflect.TypeOf((*MockenvDeployer)(nil).GenerateCloudFormationTemplate), in)
 }
 
-// NewMockservicePauser creates a new mock instance.
-func NewMockservicePauser(ctrl *gomock.Controller) *MockservicePauser {
-	mock := &MockservicePauser{ctrl: ctrl}
-	mock.recorder = &MockservicePauserMockRecorder{mock}
-	return mock
+// UploadArtifacts mocks base method.
+func (m *MockenvDeployer) UploadArtifacts() (*deploy.UploadEnvArtifactsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadArtifacts")
+	ret0, _ := ret[0].(*deploy.UploadEnvArtifactsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockservicePauser) EXPECT() *MockservicePauserMockRecorder {
-	return m.recorder
+// UploadArtifacts indicates an expected call of UploadArtifacts.
+func (mr *MockenvDeployerMockRecorder) UploadArtifacts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadArtifacts", reflect.TypeOf((*MockenvDeployer)(nil).UploadArtifacts))
 }
 
-// PauseService mocks base method.
-func (m *MockservicePauser) PauseService(svcARN string) error {
+// Validate mocks base method.
+func (m *MockenvDeployer) Validate(arg0 *manifest.Environment) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PauseService", svcARN)
+	ret := m.ctrl.Call(m, "Validate", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// PauseService indicates an expected call of PauseService.
-func (mr *MockservicePauserMockRecorder) PauseService(svcARN interface{}) *gomock.Call {
+// Validate indicates an expected call of Validate.
+func (mr *MockenvDeployerMockRecorder) Validate(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseService", reflect.TypeOf((*MockservicePauser)(nil).PauseService), svcARN)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockenvDeployer)(nil).Validate), arg0)
 }
 
-// Mockinterpolator is a mock of interpolator interface.
-type Mockinterpolator struct {
+// MockenvPackager is a mock of envPackager interface.
+type MockenvPackager struct {
 	ctrl     *gomock.Controller
-	recorder *MockinterpolatorMockRecorder
+	recorder *MockenvPackagerMockRecorder
 }
 
-// MockinterpolatorMockRecorder is the mock recorder for Mockinterpolator.
-type MockinterpolatorMockRecorder struct {
-	mock *Mockinterpolator
+// MockenvPackagerMockRecorder is the mock recorder for MockenvPackager.
+type MockenvPackagerMockRecorder struct {
+	mock *MockenvPackager
 }
 
-// NewMockinterpolator creates a new mock instance.
-func NewMockinterpolator(ctrl *gomock.Controller) *Mockinterpolator {
-	mock := &Mockinterpolator{ctrl: ctrl}
-	mock.recorder = &MockinterpolatorMockRecorder{mock}
+// NewMockenvPackager creates a new mock instance.
+func NewMockenvPackager(ctrl *gomock.Controller) *MockenvPackager {
+	mock := &MockenvPackager{ctrl: ctrl}
+	mock.recorder = &MockenvPackagerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *Mockinterpolator) EXPECT() *MockinterpolatorMockRecorder {
+func (m *MockenvPackager) EXPECT() *MockenvPackagerMockRecorder {
 	return m.recorder
 }
 
-// Interpolate mocks base method.
-func (m *Mockinterpolator) Interpolate(s string) (string, error) {
+// AddonsTemplate mocks base method.
+func (m *MockenvPackager) AddonsTemplate() (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Interpolate", s)
+	ret := m.ctrl.Call(m, "AddonsTemplate")
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Interpolate indicates an expected call of Interpolate.
-func (mr *MockinterpolatorMockRecorder) Interpolate(s interface{}) *gomock.Call {
+// AddonsTemplate indicates an expected call of AddonsTemplate.
+func (mr *MockenvPackagerMockRecorder) AddonsTemplate() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Interpolate", reflect.TypeOf((*Mockinterpolator)(nil).Interpolate), s)
-}
-
-// MockworkloadDeployer is a mock of workloadDeployer interface.
-type MockworkloadDeployer struct {
-	ctrl     *gomock.Controller
-	recorder *MockworkloadDeployerMockRecorder
-}
-
-// MockworkloadDeployerMockRecorder is the mock recorder for MockworkloadDeployer.
-type MockworkloadDeployerMockRecorder struct {
-	mock *MockworkloadDeployer
-}
-
-// NewMockworkloadDeployer creates a new mock instance.
-func NewMockworkloadDeployer(ctrl *gomock.Controller) *MockworkloadDeployer {
-	mock := &MockworkloadDeployer{ctrl: ctrl}
-	mock.recorder = &MockworkloadDeployerMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockworkloadDeployer) EXPECT() *MockworkloadDeployerMockRecorder {
-	return m.recorder
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsTemplate", reflect.TypeOf((*MockenvPackager)(nil).AddonsTemplate))
 }
 
 // DeployDiff mocks base method.
-func (m *MockworkloadDeployer) DeployDiff(inTmpl string) (string, error) {
+func (m *MockenvPackager) DeployDiff(inTmpl string) (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
 	ret0, _ := ret[0].(string)
@@ -7418,28 +10719,28 @@ func (m *MockworkloadDeployer) DeployDiff(inTmpl string) (string, error) {
 }
 
 // DeployDiff indicates an expected call of DeployDiff.
-func (mr *MockworkloadDeployerMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+func (mr *MockenvPackagerMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockworkloadDeployer)(nil).DeployDiff), inTmpl)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockenvPackager)(nil).DeployDiff), inTmpl)
 }
 
-// DeployWorkload mocks base method.
-func (m *MockworkloadDeployer) DeployWorkload(in *deploy.DeployWorkloadInput) (deploy.ActionRecommender, error) {
+// GenerateBootstrapTemplate mocks base method.
+func (m *MockenvPackager) GenerateBootstrapTemplate(in *deploy.DeployEnvironmentInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployWorkload", in)
-	ret0, _ := ret[0].(deploy.ActionRecommender)
+	ret := m.ctrl.Call(m, "GenerateBootstrapTemplate", in)
+	ret0, _ := ret[0].(*deploy.GenerateCloudFormationTemplateOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeployWorkload indicates an expected call of DeployWorkload.
-func (mr *MockworkloadDeployerMockRecorder) DeployWorkload(in interface{}) *gomock.Call {
+// GenerateBootstrapTemplate indicates an expected call of GenerateBootstrapTemplate.
+func (mr *MockenvPackagerMockRecorder) GenerateBootstrapTemplate(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployWorkload", reflect.TypeOf((*MockworkloadDeployer)(nil).DeployWorkload), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateBootstrapTemplate", reflect.TypeOf((*MockenvPackager)(nil).GenerateBootstrapTemplate), in)
 }
 
 // GenerateCloudFormationTemplate mocks base method.
-func (m *MockworkloadDeployer) GenerateCloudFormationTemplate(in *deploy.GenerateCloudFormationTemplateInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
+func (m *MockenvPackager) GenerateCloudFormationTemplate(in *deploy.DeployEnvironmentInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GenerateCloudFormationTemplate", in)
 	ret0, _ := ret[0].(*deploy.GenerateCloudFormationTemplateOutput)
@@ -7448,484 +10749,619 @@ func (m *MockworkloadDeployer) GenerateCloudFormationTemplate(in *deploy.Generat
 }
 
 // GenerateCloudFormationTemplate indicates an expected call of GenerateCloudFormationTemplate.
-func (mr *MockworkloadDeployerMockRecorder) GenerateCloudFormationTemplate(in interface{}) *gomock.Call {
+func (mr *MockenvPackagerMockRecorder) GenerateCloudFormationTemplate(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCloudFormationTemplate", reflect.TypeOf((*MockworkloadDeployer)(nil).GenerateCloudFormationTemplate), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCloudFormationTemplate", reflect.TypeOf((*MockenvPackager)(nil).GenerateCloudFormationTemplate), in)
 }
 
-// IsServiceAvailableInRegion mocks base method.
-func (m *MockworkloadDeployer) IsServiceAvailableInRegion(region string) (bool, error) {
+// UploadArtifacts mocks base method.
+func (m *MockenvPackager) UploadArtifacts() (*deploy.UploadEnvArtifactsOutput, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsServiceAvailableInRegion", region)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "UploadArtifacts")
+	ret0, _ := ret[0].(*deploy.UploadEnvArtifactsOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsServiceAvailableInRegion indicates an expected call of IsServiceAvailableInRegion.
-func (mr *MockworkloadDeployerMockRecorder) IsServiceAvailableInRegion(region interface{}) *gomock.Call {
+// UploadArtifacts indicates an expected call of UploadArtifacts.
+func (mr *MockenvPackagerMockRecorder) UploadArtifacts() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsServiceAvailableInRegion", reflect.TypeOf((*MockworkloadDeployer)(nil).IsServiceAvailableInRegion), region)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadArtifacts", reflect.TypeOf((*MockenvPackager)(nil).UploadArtifacts))
 }
 
-// UploadArtifacts mocks base method.
-func (m *MockworkloadDeployer) UploadArtifacts() (*deploy.UploadArtifactsOutput, error) {
+// Validate mocks base method.
+func (m *MockenvPackager) Validate(arg0 *manifest.Environment) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadArtifacts")
-	ret0, _ := ret[0].(*deploy.UploadArtifactsOutput)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Validate", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// UploadArtifacts indicates an expected call of UploadArtifacts.
-func (mr *MockworkloadDeployerMockRecorder) UploadArtifacts() *gomock.Call {
+// Validate indicates an expected call of Validate.
+func (mr *MockenvPackagerMockRecorder) Validate(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadArtifacts", reflect.TypeOf((*MockworkloadDeployer)(nil).UploadArtifacts))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockenvPackager)(nil).Validate), arg0)
 }
 
-// MocktemplateDiffer is a mock of templateDiffer interface.
-type MocktemplateDiffer struct {
+// MockstackConfiguration is a mock of stackConfiguration interface.
+type MockstackConfiguration struct {
 	ctrl     *gomock.Controller
-	recorder *MocktemplateDifferMockRecorder
+	recorder *MockstackConfigurationMockRecorder
 }
 
-// MocktemplateDifferMockRecorder is the mock recorder for MocktemplateDiffer.
-type MocktemplateDifferMockRecorder struct {
-	mock *MocktemplateDiffer
+// MockstackConfigurationMockRecorder is the mock recorder for MockstackConfiguration.
+type MockstackConfigurationMockRecorder struct {
+	mock *MockstackConfiguration
 }
 
-// NewMocktemplateDiffer creates a new mock instance.
-func NewMocktemplateDiffer(ctrl *gomock.Controller) *MocktemplateDiffer {
-	mock := &MocktemplateDiffer{ctrl: ctrl}
-	mock.recorder = &MocktemplateDifferMockRecorder{mock}
+// NewMockstackConfiguration creates a new mock instance.
+func NewMockstackConfiguration(ctrl *gomock.Controller) *MockstackConfiguration {
+	mock := &MockstackConfiguration{ctrl: ctrl}
+	mock.recorder = &MockstackConfigurationMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocktemplateDiffer) EXPECT() *MocktemplateDifferMockRecorder {
+func (m *MockstackConfiguration) EXPECT() *MockstackConfigurationMockRecorder {
 	return m.recorder
 }
 
-// DeployDiff mocks base method.
-func (m *MocktemplateDiffer) DeployDiff(inTmpl string) (string, error) {
+// Parameters mocks base method.
+func (m *MockstackConfiguration) Parameters() ([]*cloudformation.Parameter, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
+	ret := m.ctrl.Call(m, "Parameters")
+	ret0, _ := ret[0].([]*cloudformation.Parameter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Parameters indicates an expected call of Parameters.
+func (mr *MockstackConfigurationMockRecorder) Parameters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parameters", reflect.TypeOf((*MockstackConfiguration)(nil).Parameters))
+}
+
+// SerializedParameters mocks base method.
+func (m *MockstackConfiguration) SerializedParameters() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SerializedParameters")
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeployDiff indicates an expected call of DeployDiff.
-func (mr *MocktemplateDifferMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+// SerializedParameters indicates an expected call of SerializedParameters.
+func (mr *MockstackConfigurationMockRecorder) SerializedParameters() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MocktemplateDiffer)(nil).DeployDiff), inTmpl)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerializedParameters", reflect.TypeOf((*MockstackConfiguration)(nil).SerializedParameters))
 }
 
-// MockworkloadStackGenerator is a mock of workloadStackGenerator interface.
-type MockworkloadStackGenerator struct {
-	ctrl     *gomock.Controller
-	recorder *MockworkloadStackGeneratorMockRecorder
+// StackName mocks base method.
+func (m *MockstackConfiguration) StackName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackName")
+	ret0, _ := ret[0].(string)
+	return ret0
 }
 
-// MockworkloadStackGeneratorMockRecorder is the mock recorder for MockworkloadStackGenerator.
-type MockworkloadStackGeneratorMockRecorder struct {
-	mock *MockworkloadStackGenerator
+// StackName indicates an expected call of StackName.
+func (mr *MockstackConfigurationMockRecorder) StackName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackName", reflect.TypeOf((*MockstackConfiguration)(nil).StackName))
 }
 
-// NewMockworkloadStackGenerator creates a new mock instance.
-func NewMockworkloadStackGenerator(ctrl *gomock.Controller) *MockworkloadStackGenerator {
-	mock := &MockworkloadStackGenerator{ctrl: ctrl}
-	mock.recorder = &MockworkloadStackGeneratorMockRecorder{mock}
-	return mock
+// Tags mocks base method.
+func (m *MockstackConfiguration) Tags() []*cloudformation.Tag {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tags")
+	ret0, _ := ret[0].([]*cloudformation.Tag)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockworkloadStackGenerator) EXPECT() *MockworkloadStackGeneratorMockRecorder {
-	return m.recorder
+// Tags indicates an expected call of Tags.
+func (mr *MockstackConfigurationMockRecorder) Tags() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tags", reflect.TypeOf((*MockstackConfiguration)(nil).Tags))
 }
 
-// AddonsTemplate mocks base method.
-func (m *MockworkloadStackGenerator) AddonsTemplate() (string, error) {
+// Template mocks base method.
+func (m *MockstackConfiguration) Template() (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddonsTemplate")
+	ret := m.ctrl.Call(m, "Template")
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddonsTemplate indicates an expected call of AddonsTemplate.
-func (mr *MockworkloadStackGeneratorMockRecorder) AddonsTemplate() *gomock.Call {
+// Template indicates an expected call of Template.
+func (mr *MockstackConfigurationMockRecorder) Template() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsTemplate", reflect.TypeOf((*MockworkloadStackGenerator)(nil).AddonsTemplate))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockstackConfiguration)(nil).Template))
 }
 
-// DeployDiff mocks base method.
-func (m *MockworkloadStackGenerator) DeployDiff(inTmpl string) (string, error) {
+// MockimageBuilderChecker is a mock of imageBuilderChecker interface.
+type MockimageBuilderChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageBuilderCheckerMockRecorder
+}
+
+// MockimageBuilderCheckerMockRecorder is the mock recorder for MockimageBuilderChecker.
+type MockimageBuilderCheckerMockRecorder struct {
+	mock *MockimageBuilderChecker
+}
+
+// NewMockimageBuilderChecker creates a new mock instance.
+func NewMockimageBuilderChecker(ctrl *gomock.Controller) *MockimageBuilderChecker {
+	mock := &MockimageBuilderChecker{ctrl: ctrl}
+	mock.recorder = &MockimageBuilderCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageBuilderChecker) EXPECT() *MockimageBuilderCheckerMockRecorder {
+	return m.recorder
+}
+
+// Builder mocks base method.
+func (m *MockimageBuilderChecker) Builder() string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
+	ret := m.ctrl.Call(m, "Builder")
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	return ret0
 }
 
-// DeployDiff indicates an expected call of DeployDiff.
-func (mr *MockworkloadStackGeneratorMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+// Builder indicates an expected call of Builder.
+func (mr *MockimageBuilderCheckerMockRecorder) Builder() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockworkloadStackGenerator)(nil).DeployDiff), inTmpl)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Builder", reflect.TypeOf((*MockimageBuilderChecker)(nil).Builder))
 }
 
-// GenerateCloudFormationTemplate mocks base method.
-func (m *MockworkloadStackGenerator) GenerateCloudFormationTemplate(in *deploy.GenerateCloudFormationTemplateInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
+// CheckDockerEngineRunning mocks base method.
+func (m *MockimageBuilderChecker) CheckDockerEngineRunning() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateCloudFormationTemplate", in)
-	ret0, _ := ret[0].(*deploy.GenerateCloudFormationTemplateOutput)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CheckDockerEngineRunning")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GenerateCloudFormationTemplate indicates an expected call of GenerateCloudFormationTemplate.
-func (mr *MockworkloadStackGeneratorMockRecorder) GenerateCloudFormationTemplate(in interface{}) *gomock.Call {
+// CheckDockerEngineRunning indicates an expected call of CheckDockerEngineRunning.
+func (mr *MockimageBuilderCheckerMockRecorder) CheckDockerEngineRunning() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCloudFormationTemplate", reflect.TypeOf((*MockworkloadStackGenerator)(nil).GenerateCloudFormationTemplate), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDockerEngineRunning", reflect.TypeOf((*MockimageBuilderChecker)(nil).CheckDockerEngineRunning))
+}
+
+// MockpolicySimulator is a mock of policySimulator interface.
+type MockpolicySimulator struct {
+	ctrl     *gomock.Controller
+	recorder *MockpolicySimulatorMockRecorder
+}
+
+// MockpolicySimulatorMockRecorder is the mock recorder for MockpolicySimulator.
+type MockpolicySimulatorMockRecorder struct {
+	mock *MockpolicySimulator
+}
+
+// NewMockpolicySimulator creates a new mock instance.
+func NewMockpolicySimulator(ctrl *gomock.Controller) *MockpolicySimulator {
+	mock := &MockpolicySimulator{ctrl: ctrl}
+	mock.recorder = &MockpolicySimulatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpolicySimulator) EXPECT() *MockpolicySimulatorMockRecorder {
+	return m.recorder
 }
 
-// UploadArtifacts mocks base method.
-func (m *MockworkloadStackGenerator) UploadArtifacts() (*deploy.UploadArtifactsOutput, error) {
+// DeniedActions mocks base method.
+func (m *MockpolicySimulator) DeniedActions(principalARN string, actions []string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadArtifacts")
-	ret0, _ := ret[0].(*deploy.UploadArtifactsOutput)
+	ret := m.ctrl.Call(m, "DeniedActions", principalARN, actions)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UploadArtifacts indicates an expected call of UploadArtifacts.
-func (mr *MockworkloadStackGeneratorMockRecorder) UploadArtifacts() *gomock.Call {
+// DeniedActions indicates an expected call of DeniedActions.
+func (mr *MockpolicySimulatorMockRecorder) DeniedActions(principalARN, actions interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadArtifacts", reflect.TypeOf((*MockworkloadStackGenerator)(nil).UploadArtifacts))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeniedActions", reflect.TypeOf((*MockpolicySimulator)(nil).DeniedActions), principalARN, actions)
 }
 
-// Mockrunner is a mock of runner interface.
-type Mockrunner struct {
+// MockserviceQuotaGetter is a mock of serviceQuotaGetter interface.
+type MockserviceQuotaGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockrunnerMockRecorder
+	recorder *MockserviceQuotaGetterMockRecorder
 }
 
-// MockrunnerMockRecorder is the mock recorder for Mockrunner.
-type MockrunnerMockRecorder struct {
-	mock *Mockrunner
+// MockserviceQuotaGetterMockRecorder is the mock recorder for MockserviceQuotaGetter.
+type MockserviceQuotaGetterMockRecorder struct {
+	mock *MockserviceQuotaGetter
 }
 
-// NewMockrunner creates a new mock instance.
-func NewMockrunner(ctrl *gomock.Controller) *Mockrunner {
-	mock := &Mockrunner{ctrl: ctrl}
-	mock.recorder = &MockrunnerMockRecorder{mock}
+// NewMockserviceQuotaGetter creates a new mock instance.
+func NewMockserviceQuotaGetter(ctrl *gomock.Controller) *MockserviceQuotaGetter {
+	mock := &MockserviceQuotaGetter{ctrl: ctrl}
+	mock.recorder = &MockserviceQuotaGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *Mockrunner) EXPECT() *MockrunnerMockRecorder {
+func (m *MockserviceQuotaGetter) EXPECT() *MockserviceQuotaGetterMockRecorder {
 	return m.recorder
 }
 
-// Run mocks base method.
-func (m *Mockrunner) Run() error {
+// Quota mocks base method.
+func (m *MockserviceQuotaGetter) Quota(serviceCode, quotaCode string) (float64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Run")
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Quota", serviceCode, quotaCode)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Run indicates an expected call of Run.
-func (mr *MockrunnerMockRecorder) Run() *gomock.Call {
+// Quota indicates an expected call of Quota.
+func (mr *MockserviceQuotaGetterMockRecorder) Quota(serviceCode, quotaCode interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*Mockrunner)(nil).Run))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quota", reflect.TypeOf((*MockserviceQuotaGetter)(nil).Quota), serviceCode, quotaCode)
 }
 
-// MockenvDeployer is a mock of envDeployer interface.
-type MockenvDeployer struct {
+// MockmetricMaximumGetter is a mock of metricMaximumGetter interface.
+type MockmetricMaximumGetter struct {
 	ctrl     *gomock.Controller
-	recorder *MockenvDeployerMockRecorder
+	recorder *MockmetricMaximumGetterMockRecorder
 }
 
-// MockenvDeployerMockRecorder is the mock recorder for MockenvDeployer.
-type MockenvDeployerMockRecorder struct {
-	mock *MockenvDeployer
+// MockmetricMaximumGetterMockRecorder is the mock recorder for MockmetricMaximumGetter.
+type MockmetricMaximumGetterMockRecorder struct {
+	mock *MockmetricMaximumGetter
 }
 
-// NewMockenvDeployer creates a new mock instance.
-func NewMockenvDeployer(ctrl *gomock.Controller) *MockenvDeployer {
-	mock := &MockenvDeployer{ctrl: ctrl}
-	mock.recorder = &MockenvDeployerMockRecorder{mock}
+// NewMockmetricMaximumGetter creates a new mock instance.
+func NewMockmetricMaximumGetter(ctrl *gomock.Controller) *MockmetricMaximumGetter {
+	mock := &MockmetricMaximumGetter{ctrl: ctrl}
+	mock.recorder = &MockmetricMaximumGetterMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockenvDeployer) EXPECT() *MockenvDeployerMockRecorder {
+func (m *MockmetricMaximumGetter) EXPECT() *MockmetricMaximumGetterMockRecorder {
 	return m.recorder
 }
 
-// DeployDiff mocks base method.
-func (m *MockenvDeployer) DeployDiff(inTmpl string) (string, error) {
+// MetricMaximum mocks base method.
+func (m *MockmetricMaximumGetter) MetricMaximum(q cloudwatch.MetricQuery) (*float64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "MetricMaximum", q)
+	ret0, _ := ret[0].(*float64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeployDiff indicates an expected call of DeployDiff.
-func (mr *MockenvDeployerMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+// MetricMaximum indicates an expected call of MetricMaximum.
+func (mr *MockmetricMaximumGetterMockRecorder) MetricMaximum(q interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockenvDeployer)(nil).DeployDiff), inTmpl)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MetricMaximum", reflect.TypeOf((*MockmetricMaximumGetter)(nil).MetricMaximum), q)
 }
 
-// DeployEnvironment mocks base method.
-func (m *MockenvDeployer) DeployEnvironment(in *deploy.DeployEnvironmentInput) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployEnvironment", in)
-	ret0, _ := ret[0].(error)
-	return ret0
+// MockloadBalancerRuleCounter is a mock of loadBalancerRuleCounter interface.
+type MockloadBalancerRuleCounter struct {
+	ctrl     *gomock.Controller
+	recorder *MockloadBalancerRuleCounterMockRecorder
 }
 
-// DeployEnvironment indicates an expected call of DeployEnvironment.
-func (mr *MockenvDeployerMockRecorder) DeployEnvironment(in interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironment", reflect.TypeOf((*MockenvDeployer)(nil).DeployEnvironment), in)
+// MockloadBalancerRuleCounterMockRecorder is the mock recorder for MockloadBalancerRuleCounter.
+type MockloadBalancerRuleCounterMockRecorder struct {
+	mock *MockloadBalancerRuleCounter
 }
 
-// GenerateCloudFormationTemplate mocks base method.
-func (m *MockenvDeployer) GenerateCloudFormationTemplate(in *deploy.DeployEnvironmentInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateCloudFormationTemplate", in)
-	ret0, _ := ret[0].(*deploy.GenerateCloudFormationTemplateOutput)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+// NewMockloadBalancerRuleCounter creates a new mock instance.
+func NewMockloadBalancerRuleCounter(ctrl *gomock.Controller) *MockloadBalancerRuleCounter {
+	mock := &MockloadBalancerRuleCounter{ctrl: ctrl}
+	mock.recorder = &MockloadBalancerRuleCounterMockRecorder{mock}
+	return mock
 }
 
-// GenerateCloudFormationTemplate indicates an expected call of GenerateCloudFormationTemplate.
-func (mr *MockenvDeployerMockRecorder) GenerateCloudFormationTemplate(in interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCloudFormationTemplate", reflect.TypeOf((*MockenvDeployer)(nil).GenerateCloudFormationTemplate), in)
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockloadBalancerRuleCounter) EXPECT() *MockloadBalancerRuleCounterMockRecorder {
+	return m.recorder
 }
 
-// UploadArtifacts mocks base method.
-func (m *MockenvDeployer) UploadArtifacts() (*deploy.UploadEnvArtifactsOutput, error) {
+// AccountLimit mocks base method.
+func (m *MockloadBalancerRuleCounter) AccountLimit(name string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadArtifacts")
-	ret0, _ := ret[0].(*deploy.UploadEnvArtifactsOutput)
+	ret := m.ctrl.Call(m, "AccountLimit", name)
+	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UploadArtifacts indicates an expected call of UploadArtifacts.
-func (mr *MockenvDeployerMockRecorder) UploadArtifacts() *gomock.Call {
+// AccountLimit indicates an expected call of AccountLimit.
+func (mr *MockloadBalancerRuleCounterMockRecorder) AccountLimit(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadArtifacts", reflect.TypeOf((*MockenvDeployer)(nil).UploadArtifacts))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountLimit", reflect.TypeOf((*MockloadBalancerRuleCounter)(nil).AccountLimit), name)
 }
 
-// Validate mocks base method.
-func (m *MockenvDeployer) Validate(arg0 *manifest.Environment) error {
+// ListenerRuleCount mocks base method.
+func (m *MockloadBalancerRuleCounter) ListenerRuleCount(lbARN string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Validate", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListenerRuleCount", lbARN)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Validate indicates an expected call of Validate.
-func (mr *MockenvDeployerMockRecorder) Validate(arg0 interface{}) *gomock.Call {
+// ListenerRuleCount indicates an expected call of ListenerRuleCount.
+func (mr *MockloadBalancerRuleCounterMockRecorder) ListenerRuleCount(lbARN interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockenvDeployer)(nil).Validate), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenerRuleCount", reflect.TypeOf((*MockloadBalancerRuleCounter)(nil).ListenerRuleCount), lbARN)
 }
 
-// MockenvPackager is a mock of envPackager interface.
-type MockenvPackager struct {
+// MockwsDoctorReader is a mock of wsDoctorReader interface.
+type MockwsDoctorReader struct {
 	ctrl     *gomock.Controller
-	recorder *MockenvPackagerMockRecorder
+	recorder *MockwsDoctorReaderMockRecorder
 }
 
-// MockenvPackagerMockRecorder is the mock recorder for MockenvPackager.
-type MockenvPackagerMockRecorder struct {
-	mock *MockenvPackager
+// MockwsDoctorReaderMockRecorder is the mock recorder for MockwsDoctorReader.
+type MockwsDoctorReaderMockRecorder struct {
+	mock *MockwsDoctorReader
 }
 
-// NewMockenvPackager creates a new mock instance.
-func NewMockenvPackager(ctrl *gomock.Controller) *MockenvPackager {
-	mock := &MockenvPackager{ctrl: ctrl}
-	mock.recorder = &MockenvPackagerMockRecorder{mock}
+// NewMockwsDoctorReader creates a new mock instance.
+func NewMockwsDoctorReader(ctrl *gomock.Controller) *MockwsDoctorReader {
+	mock := &MockwsDoctorReader{ctrl: ctrl}
+	mock.recorder = &MockwsDoctorReaderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockenvPackager) EXPECT() *MockenvPackagerMockRecorder {
+func (m *MockwsDoctorReader) EXPECT() *MockwsDoctorReaderMockRecorder {
 	return m.recorder
 }
 
-// AddonsTemplate mocks base method.
-func (m *MockenvPackager) AddonsTemplate() (string, error) {
+// ListEnvironments mocks base method.
+func (m *MockwsDoctorReader) ListEnvironments() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddonsTemplate")
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ListEnvironments")
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// AddonsTemplate indicates an expected call of AddonsTemplate.
-func (mr *MockenvPackagerMockRecorder) AddonsTemplate() *gomock.Call {
+// ListEnvironments indicates an expected call of ListEnvironments.
+func (mr *MockwsDoctorReaderMockRecorder) ListEnvironments() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddonsTemplate", reflect.TypeOf((*MockenvPackager)(nil).AddonsTemplate))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockwsDoctorReader)(nil).ListEnvironments))
 }
 
-// DeployDiff mocks base method.
-func (m *MockenvPackager) DeployDiff(inTmpl string) (string, error) {
+// ListJobs mocks base method.
+func (m *MockwsDoctorReader) ListJobs() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeployDiff", inTmpl)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ListJobs")
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeployDiff indicates an expected call of DeployDiff.
-func (mr *MockenvPackagerMockRecorder) DeployDiff(inTmpl interface{}) *gomock.Call {
+// ListJobs indicates an expected call of ListJobs.
+func (mr *MockwsDoctorReaderMockRecorder) ListJobs() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployDiff", reflect.TypeOf((*MockenvPackager)(nil).DeployDiff), inTmpl)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJobs", reflect.TypeOf((*MockwsDoctorReader)(nil).ListJobs))
 }
 
-// GenerateCloudFormationTemplate mocks base method.
-func (m *MockenvPackager) GenerateCloudFormationTemplate(in *deploy.DeployEnvironmentInput) (*deploy.GenerateCloudFormationTemplateOutput, error) {
+// ListServices mocks base method.
+func (m *MockwsDoctorReader) ListServices() ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenerateCloudFormationTemplate", in)
-	ret0, _ := ret[0].(*deploy.GenerateCloudFormationTemplateOutput)
+	ret := m.ctrl.Call(m, "ListServices")
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GenerateCloudFormationTemplate indicates an expected call of GenerateCloudFormationTemplate.
-func (mr *MockenvPackagerMockRecorder) GenerateCloudFormationTemplate(in interface{}) *gomock.Call {
+// ListServices indicates an expected call of ListServices.
+func (mr *MockwsDoctorReaderMockRecorder) ListServices() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCloudFormationTemplate", reflect.TypeOf((*MockenvPackager)(nil).GenerateCloudFormationTemplate), in)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServices", reflect.TypeOf((*MockwsDoctorReader)(nil).ListServices))
 }
 
-// UploadArtifacts mocks base method.
-func (m *MockenvPackager) UploadArtifacts() (*deploy.UploadEnvArtifactsOutput, error) {
+// ReadEnvironmentManifest mocks base method.
+func (m *MockwsDoctorReader) ReadEnvironmentManifest(name string) (workspace.EnvironmentManifest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadArtifacts")
-	ret0, _ := ret[0].(*deploy.UploadEnvArtifactsOutput)
+	ret := m.ctrl.Call(m, "ReadEnvironmentManifest", name)
+	ret0, _ := ret[0].(workspace.EnvironmentManifest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UploadArtifacts indicates an expected call of UploadArtifacts.
-func (mr *MockenvPackagerMockRecorder) UploadArtifacts() *gomock.Call {
+// ReadEnvironmentManifest indicates an expected call of ReadEnvironmentManifest.
+func (mr *MockwsDoctorReaderMockRecorder) ReadEnvironmentManifest(name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadArtifacts", reflect.TypeOf((*MockenvPackager)(nil).UploadArtifacts))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockwsDoctorReader)(nil).ReadEnvironmentManifest), name)
 }
 
-// Validate mocks base method.
-func (m *MockenvPackager) Validate(arg0 *manifest.Environment) error {
+// ReadWorkloadManifest mocks base method.
+func (m *MockwsDoctorReader) ReadWorkloadManifest(name string) (workspace.WorkloadManifest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Validate", arg0)
+	ret := m.ctrl.Call(m, "ReadWorkloadManifest", name)
+	ret0, _ := ret[0].(workspace.WorkloadManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkloadManifest indicates an expected call of ReadWorkloadManifest.
+func (mr *MockwsDoctorReaderMockRecorder) ReadWorkloadManifest(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkloadManifest", reflect.TypeOf((*MockwsDoctorReader)(nil).ReadWorkloadManifest), name)
+}
+
+// Summary mocks base method.
+func (m *MockwsDoctorReader) Summary() (*workspace.Summary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summary")
+	ret0, _ := ret[0].(*workspace.Summary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Summary indicates an expected call of Summary.
+func (mr *MockwsDoctorReaderMockRecorder) Summary() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockwsDoctorReader)(nil).Summary))
+}
+
+// MockcacheClearer is a mock of cacheClearer interface.
+type MockcacheClearer struct {
+	ctrl     *gomock.Controller
+	recorder *MockcacheClearerMockRecorder
+}
+
+// MockcacheClearerMockRecorder is the mock recorder for MockcacheClearer.
+type MockcacheClearerMockRecorder struct {
+	mock *MockcacheClearer
+}
+
+// NewMockcacheClearer creates a new mock instance.
+func NewMockcacheClearer(ctrl *gomock.Controller) *MockcacheClearer {
+	mock := &MockcacheClearer{ctrl: ctrl}
+	mock.recorder = &MockcacheClearerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcacheClearer) EXPECT() *MockcacheClearerMockRecorder {
+	return m.recorder
+}
+
+// Clear mocks base method.
+func (m *MockcacheClearer) Clear() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clear")
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Validate indicates an expected call of Validate.
-func (mr *MockenvPackagerMockRecorder) Validate(arg0 interface{}) *gomock.Call {
+// Clear indicates an expected call of Clear.
+func (mr *MockcacheClearerMockRecorder) Clear() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockenvPackager)(nil).Validate), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockcacheClearer)(nil).Clear))
 }
 
-// MockstackConfiguration is a mock of stackConfiguration interface.
-type MockstackConfiguration struct {
+// MocktelemetryStore is a mock of telemetryStore interface.
+type MocktelemetryStore struct {
 	ctrl     *gomock.Controller
-	recorder *MockstackConfigurationMockRecorder
+	recorder *MocktelemetryStoreMockRecorder
 }
 
-// MockstackConfigurationMockRecorder is the mock recorder for MockstackConfiguration.
-type MockstackConfigurationMockRecorder struct {
-	mock *MockstackConfiguration
+// MocktelemetryStoreMockRecorder is the mock recorder for MocktelemetryStore.
+type MocktelemetryStoreMockRecorder struct {
+	mock *MocktelemetryStore
 }
 
-// NewMockstackConfiguration creates a new mock instance.
-func NewMockstackConfiguration(ctrl *gomock.Controller) *MockstackConfiguration {
-	mock := &MockstackConfiguration{ctrl: ctrl}
-	mock.recorder = &MockstackConfigurationMockRecorder{mock}
+// NewMocktelemetryStore creates a new mock instance.
+func NewMocktelemetryStore(ctrl *gomock.Controller) *MocktelemetryStore {
+	mock := &MocktelemetryStore{ctrl: ctrl}
+	mock.recorder = &MocktelemetryStoreMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockstackConfiguration) EXPECT() *MockstackConfigurationMockRecorder {
+func (m *MocktelemetryStore) EXPECT() *MocktelemetryStoreMockRecorder {
 	return m.recorder
 }
 
-// Parameters mocks base method.
-func (m *MockstackConfiguration) Parameters() ([]*cloudformation.Parameter, error) {
+// Clear mocks base method.
+func (m *MocktelemetryStore) Clear() error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Parameters")
-	ret0, _ := ret[0].([]*cloudformation.Parameter)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Clear")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Parameters indicates an expected call of Parameters.
-func (mr *MockstackConfigurationMockRecorder) Parameters() *gomock.Call {
+// Clear indicates an expected call of Clear.
+func (mr *MocktelemetryStoreMockRecorder) Clear() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parameters", reflect.TypeOf((*MockstackConfiguration)(nil).Parameters))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MocktelemetryStore)(nil).Clear))
 }
 
-// SerializedParameters mocks base method.
-func (m *MockstackConfiguration) SerializedParameters() (string, error) {
+// Events mocks base method.
+func (m *MocktelemetryStore) Events() ([]telemetry.Event, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SerializedParameters")
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Events")
+	ret0, _ := ret[0].([]telemetry.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SerializedParameters indicates an expected call of SerializedParameters.
-func (mr *MockstackConfigurationMockRecorder) SerializedParameters() *gomock.Call {
+// Events indicates an expected call of Events.
+func (mr *MocktelemetryStoreMockRecorder) Events() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerializedParameters", reflect.TypeOf((*MockstackConfiguration)(nil).SerializedParameters))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Events", reflect.TypeOf((*MocktelemetryStore)(nil).Events))
 }
 
-// StackName mocks base method.
-func (m *MockstackConfiguration) StackName() string {
+// SetSettings mocks base method.
+func (m *MocktelemetryStore) SetSettings(settings telemetry.Settings) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StackName")
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "SetSettings", settings)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// StackName indicates an expected call of StackName.
-func (mr *MockstackConfigurationMockRecorder) StackName() *gomock.Call {
+// SetSettings indicates an expected call of SetSettings.
+func (mr *MocktelemetryStoreMockRecorder) SetSettings(settings interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackName", reflect.TypeOf((*MockstackConfiguration)(nil).StackName))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSettings", reflect.TypeOf((*MocktelemetryStore)(nil).SetSettings), settings)
 }
 
-// Tags mocks base method.
-func (m *MockstackConfiguration) Tags() []*cloudformation.Tag {
+// Settings mocks base method.
+func (m *MocktelemetryStore) Settings() (telemetry.Settings, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Tags")
-	ret0, _ := ret[0].([]*cloudformation.Tag)
-	return ret0
+	ret := m.ctrl.Call(m, "Settings")
+	ret0, _ := ret[0].(telemetry.Settings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Tags indicates an expected call of Tags.
-func (mr *MockstackConfigurationMockRecorder) Tags() *gomock.Call {
+// Settings indicates an expected call of Settings.
+func (mr *MocktelemetryStoreMockRecorder) Settings() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tags", reflect.TypeOf((*MockstackConfiguration)(nil).Tags))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Settings", reflect.TypeOf((*MocktelemetryStore)(nil).Settings))
 }
 
-// Template mocks base method.
-func (m *MockstackConfiguration) Template() (string, error) {
+// MockappGraphDescriber is a mock of appGraphDescriber interface.
+type MockappGraphDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockappGraphDescriberMockRecorder
+}
+
+// MockappGraphDescriberMockRecorder is the mock recorder for MockappGraphDescriber.
+type MockappGraphDescriberMockRecorder struct {
+	mock *MockappGraphDescriber
+}
+
+// NewMockappGraphDescriber creates a new mock instance.
+func NewMockappGraphDescriber(ctrl *gomock.Controller) *MockappGraphDescriber {
+	mock := &MockappGraphDescriber{ctrl: ctrl}
+	mock.recorder = &MockappGraphDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockappGraphDescriber) EXPECT() *MockappGraphDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockappGraphDescriber) Describe() (*describe.AppGraph, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Template")
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*describe.AppGraph)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Template indicates an expected call of Template.
-func (mr *MockstackConfigurationMockRecorder) Template() *gomock.Call {
+// Describe indicates an expected call of Describe.
+func (mr *MockappGraphDescriberMockRecorder) Describe() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockstackConfiguration)(nil).Template))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockappGraphDescriber)(nil).Describe))
 }
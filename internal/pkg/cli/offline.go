@@ -0,0 +1,177 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+var accountIDRegexp = regexp.MustCompile(`^\d{12}$`)
+
+// offlineVars are the flags of the package commands that generate templates without AWS credentials.
+type offlineVars struct {
+	offline    bool
+	accountID  string
+	valuesFile string
+}
+
+func (v offlineVars) validate() error {
+	if !v.offline {
+		if v.accountID != "" {
+			return fmt.Errorf("--%s must be used with --%s", accountIDFlag, offlineFlag)
+		}
+		if v.valuesFile != "" {
+			return fmt.Errorf("--%s must be used with --%s", valuesFlag, offlineFlag)
+		}
+		return nil
+	}
+	if v.accountID != "" && !accountIDRegexp.MatchString(v.accountID) {
+		return fmt.Errorf("account ID %q must be 12 digits", v.accountID)
+	}
+	return nil
+}
+
+// values returns the values in the file of the --values flag, overridden by the --account-id flag.
+// The region defaults to the AWS_REGION or AWS_DEFAULT_REGION environment variable.
+func (v offlineVars) values(fs afero.Fs) (*clideploy.OfflineValues, error) {
+	values := &clideploy.OfflineValues{}
+	if v.valuesFile != "" {
+		raw, err := afero.ReadFile(fs, v.valuesFile)
+		if err != nil {
+			return nil, fmt.Errorf("read offline values file: %w", err)
+		}
+		dec := yaml.NewDecoder(bytes.NewReader(raw))
+		dec.KnownFields(true)
+		if err := dec.Decode(values); err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("unmarshal offline values file %s: %w", v.valuesFile, err)
+		}
+	}
+	if v.accountID != "" {
+		values.AccountID = v.accountID
+	}
+	for _, envVar := range []string{"AWS_REGION", "AWS_DEFAULT_REGION"} {
+		if values.Region != "" {
+			break
+		}
+		values.Region = os.Getenv(envVar)
+	}
+	if values.Region == "" {
+		return nil, fmt.Errorf(`the region of the environment is unknown offline: set "region" in the file of --%s or the AWS_REGION environment variable`, valuesFlag)
+	}
+	if _, err := partitions.Region(values.Region).Partition(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// offlineStore reads the application and the environments of the workspace from offline values instead of SSM.
+// The calls to the other methods of the store fail, since it's backed by an offline session.
+type offlineStore struct {
+	store
+	values *clideploy.OfflineValues
+	ws     wsEnvironmentsLister
+}
+
+func newOfflineStore(s store, values *clideploy.OfflineValues, ws wsEnvironmentsLister) *offlineStore {
+	return &offlineStore{
+		store:  s,
+		values: values,
+		ws:     ws,
+	}
+}
+
+// GetApplication returns the application with the offline values.
+func (s *offlineStore) GetApplication(name string) (*config.Application, error) {
+	return s.values.Application(name), nil
+}
+
+// GetEnvironment returns the environment if it has a manifest in the workspace.
+func (s *offlineStore) GetEnvironment(app, name string) (*config.Environment, error) {
+	envs, err := s.ws.ListEnvironments()
+	if err != nil {
+		return nil, fmt.Errorf("list environments in the workspace: %w", err)
+	}
+	if !contains(name, envs) {
+		return nil, &config.ErrNoSuchEnvironment{
+			ApplicationName: app,
+			EnvironmentName: name,
+		}
+	}
+	return s.values.Environment(app, name)
+}
+
+// ListEnvironments returns the environments that have a manifest in the workspace.
+func (s *offlineStore) ListEnvironments(app string) ([]*config.Environment, error) {
+	names, err := s.ws.ListEnvironments()
+	if err != nil {
+		return nil, fmt.Errorf("list environments in the workspace: %w", err)
+	}
+	envs := make([]*config.Environment, len(names))
+	for i, name := range names {
+		if envs[i], err = s.values.Environment(app, name); err != nil {
+			return nil, err
+		}
+	}
+	return envs, nil
+}
+
+// offlineCaller is the root user of the account of the offline values.
+type offlineCaller struct {
+	values *clideploy.OfflineValues
+}
+
+// Get returns the root user of the account.
+func (c offlineCaller) Get() (identity.Caller, error) {
+	partition, err := partitions.Region(c.values.Region).Partition()
+	if err != nil {
+		return identity.Caller{}, err
+	}
+	root := fmt.Sprintf("arn:%s:iam::%s:root", partition.ID(), c.values.Account())
+	return identity.Caller{
+		ARN:         root,
+		RootUserARN: root,
+		Account:     c.values.Account(),
+	}, nil
+}
+
+// offlineEnvFeatures is an environment upgraded to the latest version, which has all the features.
+type offlineEnvFeatures struct{}
+
+// Version returns the latest template version.
+func (offlineEnvFeatures) Version() (string, error) {
+	return version.LatestTemplateVersion(), nil
+}
+
+// AvailableFeatures returns all the features of environments.
+func (offlineEnvFeatures) AvailableFeatures() ([]string, error) {
+	return template.AvailableEnvFeatures(), nil
+}
+
+// offlineEnvManifest returns the manifest of the environment in the workspace with its variables substituted,
+// which stands for the manifest of the deployed environment offline.
+func offlineEnvManifest(ws environmentManifestReader, interpolator interpolator, env string) ([]byte, error) {
+	raw, err := ws.ReadEnvironmentManifest(env)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest for environment %q: %w", env, err)
+	}
+	interpolated, err := interpolator.Interpolate(string(raw))
+	if err != nil {
+		return nil, fmt.Errorf("interpolate environment variables for environment %q manifest: %w", env, err)
+	}
+	return []byte(interpolated), nil
+}
@@ -0,0 +1,169 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOfflineVars_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in      offlineVars
+		wantErr string
+	}{
+		"online": {},
+		"account ID without --offline": {
+			in:      offlineVars{accountID: "123456789012"},
+			wantErr: "--account-id must be used with --offline",
+		},
+		"values without --offline": {
+			in:      offlineVars{valuesFile: "values.yml"},
+			wantErr: "--values must be used with --offline",
+		},
+		"malformed account ID": {
+			in:      offlineVars{offline: true, accountID: "1234"},
+			wantErr: `account ID "1234" must be 12 digits`,
+		},
+		"offline": {
+			in: offlineVars{offline: true, accountID: "123456789012", valuesFile: "values.yml"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.validate()
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestOfflineVars_values(t *testing.T) {
+	testCases := map[string]struct {
+		in         offlineVars
+		file       string
+		envRegion  string
+		wantValues *deploy.OfflineValues
+		wantErr    string
+	}{
+		"region from the environment variable": {
+			in:         offlineVars{offline: true, accountID: "123456789012"},
+			envRegion:  "us-west-2",
+			wantValues: &deploy.OfflineValues{AccountID: "123456789012", Region: "us-west-2"},
+		},
+		"values from the file, with the account ID overridden": {
+			in: offlineVars{offline: true, accountID: "210987654321", valuesFile: "values.yml"},
+			file: `account_id: "123456789012"
+region: eu-west-1
+artifact_bucket: bucket
+repositories:
+  frontend: 123456789012.dkr.ecr.eu-west-1.amazonaws.com/demo/frontend`,
+			envRegion: "us-west-2",
+			wantValues: &deploy.OfflineValues{
+				AccountID:      "210987654321",
+				Region:         "eu-west-1",
+				ArtifactBucket: "bucket",
+				Repositories: map[string]string{
+					"frontend": "123456789012.dkr.ecr.eu-west-1.amazonaws.com/demo/frontend",
+				},
+			},
+		},
+		"unknown field": {
+			in:      offlineVars{offline: true, valuesFile: "values.yml"},
+			file:    `acount_id: "123456789012"`,
+			wantErr: "unmarshal offline values file values.yml",
+		},
+		"no region": {
+			in:      offlineVars{offline: true},
+			wantErr: "the region of the environment is unknown offline",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("AWS_REGION", tc.envRegion)
+			t.Setenv("AWS_DEFAULT_REGION", "")
+			fs := afero.NewMemMapFs()
+			if tc.file != "" {
+				require.NoError(t, afero.WriteFile(fs, "values.yml", []byte(tc.file), 0644))
+			}
+
+			values, err := tc.in.values(fs)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantValues, values)
+		})
+	}
+}
+
+func TestOfflineStore_GetEnvironment(t *testing.T) {
+	testCases := map[string]struct {
+		mockWs  func(m *mocks.MockwsEnvironmentsLister)
+		wantEnv *config.Environment
+		wantErr error
+	}{
+		"environment in the workspace": {
+			mockWs: func(m *mocks.MockwsEnvironmentsLister) {
+				m.EXPECT().ListEnvironments().Return([]string{"test", "prod"}, nil)
+			},
+			wantEnv: &config.Environment{
+				App:              "demo",
+				Name:             "test",
+				Region:           "us-west-2",
+				AccountID:        "123456789012",
+				ManagerRoleARN:   "arn:aws:iam::123456789012:role/demo-test-EnvManagerRole",
+				ExecutionRoleARN: "arn:aws:iam::123456789012:role/demo-test-CFNExecutionRole",
+			},
+		},
+		"environment not in the workspace": {
+			mockWs: func(m *mocks.MockwsEnvironmentsLister) {
+				m.EXPECT().ListEnvironments().Return([]string{"prod"}, nil)
+			},
+			wantErr: &config.ErrNoSuchEnvironment{ApplicationName: "demo", EnvironmentName: "test"},
+		},
+		"error listing environments": {
+			mockWs: func(m *mocks.MockwsEnvironmentsLister) {
+				m.EXPECT().ListEnvironments().Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("list environments in the workspace: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsEnvironmentsLister(ctrl)
+			tc.mockWs(ws)
+			s := newOfflineStore(nil, &deploy.OfflineValues{AccountID: "123456789012", Region: "us-west-2"}, ws)
+
+			env, err := s.GetEnvironment("demo", "test")
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantEnv, env)
+		})
+	}
+}
+
+func TestOfflineCaller_Get(t *testing.T) {
+	caller, err := offlineCaller{values: &deploy.OfflineValues{Region: "us-gov-west-1"}}.Get()
+
+	require.NoError(t, err)
+	require.Equal(t, "arn:aws-us-gov:iam::{{account_id}}:root", caller.RootUserARN)
+	require.Equal(t, "{{account_id}}", caller.Account)
+}
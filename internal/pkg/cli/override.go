@@ -10,17 +10,21 @@ import (
 	"strconv"
 	"strings"
 
+	cmdtemplate "github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
 	"github.com/aws/copilot-cli/internal/pkg/override"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
 )
 
 const (
 	// IaC options for overrides.
-	cdkIaCTool = "cdk"
-	yamlPatch  = "yamlpatch"
+	cdkIaCTool     = "cdk"
+	yamlPatch      = "yamlpatch"
+	strategicMerge = "merge"
 
 	// IaC toolkit configuration.
 	typescriptCDKLang = "typescript"
@@ -29,6 +33,7 @@ const (
 var validIaCTools = []string{
 	cdkIaCTool,
 	yamlPatch,
+	strategicMerge,
 }
 
 var validCDKLangs = []string{
@@ -109,6 +114,11 @@ func (o *overrideOpts) Execute() error {
 			return fmt.Errorf("scaffold CFN YAML patches under %q: %v", dir, err)
 		}
 		log.Successf("Created a YAML patch file under %q to override resources\n", displayPath(dir))
+	case strategicMerge:
+		if err := override.ScaffoldWithMerge(o.fs, dir); err != nil {
+			return fmt.Errorf("scaffold CFN strategic merge patch under %q: %v", dir, err)
+		}
+		log.Successf("Created a strategic merge patch file under %q to override resources\n", displayPath(dir))
 	}
 	return nil
 }
@@ -160,7 +170,11 @@ To learn more about the CDK: https://aws.github.io/copilot-cli/docs/developing/o
 
 CloudFormation YAML patches is recommended for users that need to override
 a handful resources or do not want to depend on any other tool.
-To learn more about CFN yaml patches: https://aws.github.io/copilot-cli/docs/developing/overrides/yamlpatch/`
+To learn more about CFN yaml patches: https://aws.github.io/copilot-cli/docs/developing/overrides/yamlpatch/
+
+A CloudFormation strategic merge patch merges the properties you write
+into resources by logical ID, and validates the overridden template.
+To learn more about strategic merge patches: https://aws.github.io/copilot-cli/docs/developing/overrides/merge/`
 	tool, err := o.prompt.SelectOne(msg, help, validIaCTools, prompt.WithFinalMessage("IaC tool:"))
 	if err != nil {
 		return fmt.Errorf("select IaC tool: %v", err)
@@ -181,7 +195,7 @@ func (o *overrideOpts) validateIaCTool() error {
 }
 
 func (o *overrideOpts) askResourcesToOverride() error {
-	if o.skipResources || o.iacTool == yamlPatch {
+	if o.skipResources || o.iacTool == yamlPatch || o.iacTool == strategicMerge {
 		return nil
 	}
 
@@ -207,3 +221,20 @@ func (o *overrideOpts) askResourcesToOverride() error {
 	o.resources = resources
 	return nil
 }
+
+// BuildOverrideCmd is the top level command for overrides.
+func BuildOverrideCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "override",
+		Short: `Commands for overrides.
+Overrides extend and change the AWS CloudFormation templates generated by Copilot.`,
+	}
+
+	cmd.AddCommand(buildOverridePreviewCmd())
+
+	cmd.SetUsageTemplate(cmdtemplate.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Extend,
+	}
+	return cmd
+}
@@ -0,0 +1,242 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/override"
+	templatediff "github.com/aws/copilot-cli/internal/pkg/template/diff"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	overridePreviewWkldNamePrompt = "Which workload's overrides would you like to preview?"
+	overridePreviewEnvNamePrompt  = "Which environment would you like to generate the template for?"
+)
+
+type previewOverrideVars struct {
+	name         string
+	envName      string
+	appName      string
+	showTemplate bool
+}
+
+type previewOverrideOpts struct {
+	previewOverrideVars
+
+	// Interfaces to interact with dependencies.
+	w          io.Writer
+	fs         afero.Fs
+	ws         wsWlDirReader
+	store      store
+	sel        wsSelector
+	packageCmd func(rec *templateRecorder) (executor, error)
+}
+
+func newPreviewOverrideOpts(vars previewOverrideVars) (*previewOverrideOpts, error) {
+	fs := afero.NewOsFs()
+	ws, err := workspace.Use(fs)
+	if err != nil {
+		return nil, err
+	}
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("override preview"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	opts := &previewOverrideOpts{
+		previewOverrideVars: vars,
+		w:                   log.OutputWriter,
+		fs:                  fs,
+		ws:                  ws,
+		store:               store,
+		sel:                 selector.NewLocalWorkloadSelector(prompt.New(), store, ws),
+	}
+	opts.packageCmd = opts.newPackageCmd
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *previewOverrideOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %q configuration: %v", o.appName, err)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *previewOverrideOpts) Ask() error {
+	if err := o.validateOrAskName(); err != nil {
+		return err
+	}
+	return o.validateOrAskEnvName()
+}
+
+// Execute renders the template of the workload with its overrides applied, validates it,
+// and writes the difference from the template without overrides.
+func (o *previewOverrideOpts) Execute() error {
+	dir := o.ws.WorkloadOverridesPath(o.name)
+	if _, err := override.Lookup(dir, o.fs); err != nil {
+		var errNotExist *override.ErrNotExist
+		if errors.As(err, &errNotExist) {
+			return fmt.Errorf("no overrides found for %q under %q", o.name, displayPath(dir))
+		}
+		return fmt.Errorf("look up overrides for %q: %w", o.name, err)
+	}
+
+	rec := &templateRecorder{}
+	cmd, err := o.packageCmd(rec)
+	if err != nil {
+		return err
+	}
+	if err := cmd.Execute(); err != nil {
+		return fmt.Errorf("generate CloudFormation template for %q: %w", o.name, err)
+	}
+	if err := override.ValidateTemplate(rec.overridden); err != nil {
+		return fmt.Errorf("validate overridden template for %q: %w", o.name, err)
+	}
+	if o.showTemplate {
+		_, err := o.w.Write(rec.overridden)
+		return err
+	}
+
+	tree, err := templatediff.From(rec.original).ParseWithCFNOverriders(rec.overridden)
+	if err != nil {
+		return fmt.Errorf("compare templates: %w", err)
+	}
+	buf := new(strings.Builder)
+	if err := tree.Write(buf, templatediff.WithColor(color.EnabledFor(o.w))); err != nil {
+		return err
+	}
+	if buf.Len() == 0 {
+		log.Infof("The overrides under %q don't change the template of %q.\n", displayPath(dir), o.name)
+		return nil
+	}
+	_, err = io.WriteString(o.w, buf.String())
+	return err
+}
+
+func (o *previewOverrideOpts) validateOrAskName() error {
+	if o.name == "" {
+		name, err := o.sel.Workload(overridePreviewWkldNamePrompt, "")
+		if err != nil {
+			return fmt.Errorf("select workload name from workspace: %v", err)
+		}
+		o.name = name
+		return nil
+	}
+	names, err := o.ws.ListWorkloads()
+	if err != nil {
+		return fmt.Errorf("list workloads in the workspace: %v", err)
+	}
+	if !contains(o.name, names) {
+		return fmt.Errorf("workload %q does not exist in the workspace", o.name)
+	}
+	return nil
+}
+
+func (o *previewOverrideOpts) validateOrAskEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("get environment %q configuration: %v", o.envName, err)
+		}
+		return nil
+	}
+	name, err := o.sel.Environment(overridePreviewEnvNamePrompt, "", o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %v", err)
+	}
+	o.envName = name
+	return nil
+}
+
+// newPackageCmd returns the "svc package" command that generates the template of the workload,
+// with its overrider wrapped by rec.
+func (o *previewOverrideOpts) newPackageCmd(rec *templateRecorder) (executor, error) {
+	cmd, err := newPackageSvcOpts(packageSvcVars{
+		name:    o.name,
+		envName: o.envName,
+		appName: o.appName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	cmd.templateWriter = discardFile{}
+	cmd.newOverrider = func(pkg *packageSvcOpts) (clideploy.Overrider, error) {
+		ovrdr, err := newWorkloadOverrider(pkg)
+		if err != nil {
+			return nil, err
+		}
+		rec.Overrider = ovrdr
+		return rec, nil
+	}
+	return cmd, nil
+}
+
+// templateRecorder is an Overrider that records the template before and after the overrides are applied.
+type templateRecorder struct {
+	clideploy.Overrider
+
+	original   []byte
+	overridden []byte
+}
+
+// Override applies the overrides of the underlying Overrider and records the templates.
+func (r *templateRecorder) Override(body []byte) ([]byte, error) {
+	out, err := r.Overrider.Override(body)
+	if err != nil {
+		return nil, err
+	}
+	r.original, r.overridden = body, out
+	return out, nil
+}
+
+// buildOverridePreviewCmd builds the command to preview the overrides of a workload.
+func buildOverridePreviewCmd() *cobra.Command {
+	vars := previewOverrideVars{}
+	cmd := &cobra.Command{
+		Use:   "preview",
+		Short: "Preview the effect of the overrides of a service or job.",
+		Long: `Preview the effect of the overrides of a service or job.
+Renders the template of the workload with its overrides applied, validates it,
+and shows the difference from the template that Copilot generates without overrides.`,
+		Example: `
+  Show how the overrides change the template of the "frontend" service in the "test" environment.
+  /code $ copilot override preview -n frontend -e test
+  Print the overridden template.
+  /code $ copilot override preview -n frontend -e test --show-template`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPreviewOverrideOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", workloadFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.showTemplate, showTemplateFlag, false, showTemplateFlagDescription)
+	return cmd
+}
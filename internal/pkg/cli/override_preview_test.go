@@ -0,0 +1,194 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+type previewOverrideMocks struct {
+	ws    *mocks.MockwsWlDirReader
+	store *mocks.Mockstore
+	sel   *mocks.MockwsSelector
+}
+
+func TestPreviewOverrideOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		inEnvName  string
+		setupMocks func(m previewOverrideMocks)
+
+		wantedName    string
+		wantedEnvName string
+		wantedErr     error
+	}{
+		"prompt for the workload and the environment": {
+			setupMocks: func(m previewOverrideMocks) {
+				m.sel.EXPECT().Workload(overridePreviewWkldNamePrompt, "").Return("frontend", nil)
+				m.sel.EXPECT().Environment(overridePreviewEnvNamePrompt, "", "demo").Return("test", nil)
+			},
+			wantedName:    "frontend",
+			wantedEnvName: "test",
+		},
+		"validate the workload and the environment from flags": {
+			inName:    "frontend",
+			inEnvName: "test",
+			setupMocks: func(m previewOverrideMocks) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"frontend", "report"}, nil)
+				m.store.EXPECT().GetEnvironment("demo", "test").Return(&config.Environment{}, nil)
+			},
+			wantedName:    "frontend",
+			wantedEnvName: "test",
+		},
+		"return an error if the workload isn't in the workspace": {
+			inName: "backend",
+			setupMocks: func(m previewOverrideMocks) {
+				m.ws.EXPECT().ListWorkloads().Return([]string{"frontend"}, nil)
+			},
+			wantedErr: errors.New(`workload "backend" does not exist in the workspace`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := previewOverrideMocks{
+				ws:    mocks.NewMockwsWlDirReader(ctrl),
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockwsSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &previewOverrideOpts{
+				previewOverrideVars: previewOverrideVars{
+					name:    tc.inName,
+					envName: tc.inEnvName,
+					appName: "demo",
+				},
+				ws:    m.ws,
+				store: m.store,
+				sel:   m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.name)
+			require.Equal(t, tc.wantedEnvName, opts.envName)
+		})
+	}
+}
+
+func TestPreviewOverrideOpts_Execute(t *testing.T) {
+	const (
+		original = `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 30
+`
+		overridden = `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    DeletionPolicy: Retain
+    Properties:
+      RetentionInDays: 30
+`
+	)
+	overridesDir := filepath.Join("copilot", "frontend", "overrides")
+	testCases := map[string]struct {
+		showTemplate bool
+		overridden   string
+		noOverrides  bool
+
+		wanted    string
+		wantedErr string
+	}{
+		"return an error if the workload has no overrides": {
+			noOverrides: true,
+			wantedErr:   `no overrides found for "frontend" under "copilot/frontend/overrides"`,
+		},
+		"return an error if the overridden template is invalid": {
+			overridden: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    DependsOn: Bucket
+`,
+			wantedErr: `validate overridden template for "frontend": line 4: resource "LogGroup" depends on the resource "Bucket" that doesn't exist`,
+		},
+		"write the difference between the templates": {
+			overridden: overridden,
+			wanted: `~ Resources/LogGroup:
+    + DeletionPolicy: Retain
+`,
+		},
+		"write the overridden template": {
+			showTemplate: true,
+			overridden:   overridden,
+			wanted:       overridden,
+		},
+		"write nothing if the overrides don't change the template": {
+			overridden: original,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsWlDirReader(ctrl)
+			ws.EXPECT().WorkloadOverridesPath("frontend").Return(overridesDir)
+			fs := afero.NewMemMapFs()
+			if !tc.noOverrides {
+				_ = afero.WriteFile(fs, filepath.Join(overridesDir, "cfn.merge.yml"), []byte(""), 0644)
+			}
+			pkg := mocks.NewMockexecutor(ctrl)
+			buf := new(strings.Builder)
+			opts := &previewOverrideOpts{
+				previewOverrideVars: previewOverrideVars{
+					name:         "frontend",
+					envName:      "test",
+					appName:      "demo",
+					showTemplate: tc.showTemplate,
+				},
+				w:  buf,
+				fs: fs,
+				ws: ws,
+				packageCmd: func(rec *templateRecorder) (executor, error) {
+					pkg.EXPECT().Execute().DoAndReturn(func() error {
+						rec.original, rec.overridden = []byte(original), []byte(tc.overridden)
+						return nil
+					}).AnyTimes()
+					return pkg, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, buf.String())
+		})
+	}
+}
@@ -25,6 +25,7 @@ Continuous delivery pipelines to release services.`,
 	cmd.AddCommand(buildPipelineDeleteCmd())
 	cmd.AddCommand(buildPipelineShowCmd())
 	cmd.AddCommand(buildPipelineStatusCmd())
+	cmd.AddCommand(buildPipelineRetryCmd())
 	cmd.AddCommand(buildPipelineListCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
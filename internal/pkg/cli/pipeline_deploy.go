@@ -339,13 +339,15 @@ func (o *deployPipelineOpts) Execute() error {
 		if err != nil {
 			return fmt.Errorf("generate the new template for diff: %w", err)
 		}
+		var hasDiff bool
 		if err = diff(o, tpl, o.diffWriter); err != nil {
 			var errHasDiff *errHasDiff
 			if !errors.As(err, &errHasDiff) {
 				return err
 			}
+			hasDiff = true
 		}
-		if !o.skipConfirmation {
+		if hasDiff && !o.skipConfirmation {
 			contd, err := o.prompt.Confirm(continueDeploymentPrompt, "")
 			if err != nil {
 				return fmt.Errorf("ask whether to continue with the deployment: %w", err)
@@ -390,8 +392,14 @@ func (o *deployPipelineOpts) DeployDiff(template string) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("parse the diff against the deployed pipeline stack %q: %w", o.pipeline.Name, err)
 	}
+	if !diffTree.HasChanges() {
+		return "", nil
+	}
 	buf := strings.Builder{}
-	if err := diffTree.Write(&buf); err != nil {
+	if summary := diffTree.Summary(); !summary.Resources().IsEmpty() {
+		buf.WriteString(summary.String() + "\n")
+	}
+	if err := diffTree.Write(&buf, templatediff.WithColor(color.EnabledFor(os.Stdout))); err != nil {
 		return "", err
 	}
 	return buf.String(), nil
@@ -818,7 +818,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 
 				m.pipelineStackConfig.EXPECT().Template().Return("name: mockEnv\ntype: Environment", nil)
 
-				m.deployer.EXPECT().Template(gomock.Any()).Return("name: mockEnv\ntype: Environment", nil)
+				m.deployer.EXPECT().Template(gomock.Any()).Return("name: mockEnv\ntype: Pipeline", nil)
 
 				m.prompt.EXPECT().Confirm(continueDeploymentPrompt, "").Return(false, errors.New("some error"))
 
@@ -850,7 +850,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 
 				m.pipelineStackConfig.EXPECT().Template().Return("name: mockEnv\ntype: Environment", nil)
 
-				m.deployer.EXPECT().Template(gomock.Any()).Return("name: mockEnv\ntype: Environment", nil)
+				m.deployer.EXPECT().Template(gomock.Any()).Return("name: mockEnv\ntype: Pipeline", nil)
 
 				m.prompt.EXPECT().Confirm(continueDeploymentPrompt, "").Return(true, nil)
 
@@ -887,7 +887,7 @@ func TestDeployPipelineOpts_Execute(t *testing.T) {
 				m.ws.EXPECT().PipelineOverridesPath(pipelineName).Return("path")
 
 				m.pipelineStackConfig.EXPECT().Template().Return("name: mockEnv\ntype: Environment", nil)
-				m.deployer.EXPECT().Template(gomock.Any()).Return("name: mockEnv\ntype: Environment", nil)
+				m.deployer.EXPECT().Template(gomock.Any()).Return("name: mockEnv\ntype: Pipeline", nil)
 
 				m.prompt.EXPECT().Confirm(continueDeploymentPrompt, "").Return(true, nil)
 
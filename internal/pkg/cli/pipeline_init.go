@@ -17,6 +17,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/dustin/go-humanize/english"
@@ -63,6 +64,8 @@ Please enter full repository URL, e.g., "https://github.com/myCompany/myRepo", o
 const (
 	workloadsPipelineBuildspecTemplatePath    = "cicd/buildspec.yml"
 	environmentsPipelineBuildspecTemplatePath = "cicd/env/buildspec.yml"
+	githubActionsWorkflowTemplatePath         = "cicd/github-actions/workflow.yml"
+	githubActionsOIDCProviderURL              = "token.actions.githubusercontent.com"
 
 	fmtPipelineStackName = "pipeline-%s-%s" // Ex: "pipeline-appName-repoName"
 	defaultBranch        = deploy.DefaultPipelineBranch
@@ -86,6 +89,13 @@ const (
 
 var pipelineTypes = []string{pipelineTypeWorkloads, pipelineTypeEnvironments}
 
+const (
+	pipelineProviderCodePipeline  = "codepipeline"
+	pipelineProviderGitHubActions = "github-actions"
+)
+
+var pipelineProviders = []string{pipelineProviderCodePipeline, pipelineProviderGitHubActions}
+
 var buildspecTemplateFunctions = map[string]interface{}{
 	"URLSafeVersion": template.URLSafeVersion,
 }
@@ -181,6 +191,7 @@ type initPipelineVars struct {
 	repoBranch        string
 	githubAccessToken string
 	pipelineType      string
+	ciProvider        string // The CI/CD system that runs the pipeline, either CodePipeline or GitHub Actions.
 }
 
 type initPipelineOpts struct {
@@ -196,6 +207,8 @@ type initPipelineOpts struct {
 	prompt         prompter
 	sel            pipelineEnvSelector
 	pipelineLister deployedPipelineLister
+	roleDeployer   githubActionsRoleDeployer
+	oidc           oidcProviderGetter
 
 	// Outputs stored on successful actions.
 	secret    string
@@ -217,6 +230,12 @@ type artifactBucket struct {
 	Environments []string
 }
 
+// githubWorkflowStage is a job of the GitHub Actions workflow that deploys to an environment.
+type githubWorkflowStage struct {
+	Name     string
+	Previous string // Name of the environment deployed by the job that this job depends on.
+}
+
 func newInitPipelineOpts(vars initPipelineVars) (*initPipelineOpts, error) {
 	ws, err := workspace.Use(afero.NewOsFs())
 	if err != nil {
@@ -231,6 +250,7 @@ func newInitPipelineOpts(vars initPipelineVars) (*initPipelineOpts, error) {
 
 	ssmStore := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
 	prompter := prompt.New()
+	cfnClient := cloudformation.New(defaultSession, cloudformation.WithProgressTracker(os.Stderr))
 
 	wsAppName := tryReadingAppName()
 	if vars.appName == "" {
@@ -243,19 +263,29 @@ func newInitPipelineOpts(vars initPipelineVars) (*initPipelineOpts, error) {
 		secretsmanager:   secretsmanager.New(defaultSession),
 		parser:           template.New(),
 		sessProvider:     p,
-		cfnClient:        cloudformation.New(defaultSession, cloudformation.WithProgressTracker(os.Stderr)),
+		cfnClient:        cfnClient,
 		store:            ssmStore,
 		prompt:           prompter,
 		sel:              selector.NewAppEnvSelector(prompter, ssmStore),
 		runner:           exec.NewCmd(),
 		wsAppName:        wsAppName,
 		pipelineLister:   deploy.NewPipelineStore(rg.New(defaultSession)),
+		roleDeployer:     cfnClient,
+		oidc:             iam.New(defaultSession),
 	}, nil
 }
 
 // Validate returns an error if the optional flag values passed by the user are invalid.
 func (o *initPipelineOpts) Validate() error {
-	return nil
+	if o.ciProvider == "" {
+		return nil
+	}
+	for _, provider := range pipelineProviders {
+		if o.ciProvider == provider {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid provider %q; must be one of %s", o.ciProvider, english.WordSeries(applyAll(pipelineProviders, strconv.Quote), "or"))
 }
 
 // Ask prompts for required fields that are not passed in and validates them.
@@ -273,6 +303,9 @@ func (o *initPipelineOpts) Ask() error {
 	if err := o.parseRepoDetails(); err != nil {
 		return err
 	}
+	if o.ciProvider == pipelineProviderGitHubActions && o.provider != manifest.GithubProviderName && o.provider != manifest.GithubV1ProviderName {
+		return fmt.Errorf("repository %s must be from GitHub to run the pipeline with GitHub Actions", o.repoURL)
+	}
 
 	if o.repoBranch == "" {
 		o.getBranch()
@@ -303,7 +336,12 @@ func (o *initPipelineOpts) Ask() error {
 }
 
 // Execute writes the pipeline manifest file.
+// If the pipeline runs with GitHub Actions, it deploys the IAM role that the workflow assumes and writes the workflow instead.
 func (o *initPipelineOpts) Execute() error {
+	if o.ciProvider == pipelineProviderGitHubActions {
+		log.Infoln()
+		return o.initGitHubActions()
+	}
 	if o.provider == manifest.GithubV1ProviderName {
 		if err := o.storeGitHubAccessToken(); err != nil {
 			return err
@@ -322,6 +360,12 @@ func (o *initPipelineOpts) Execute() error {
 
 // RequiredActions returns follow-up actions the user must take after successfully executing the command.
 func (o *initPipelineOpts) RequiredActions() []string {
+	if o.ciProvider == pipelineProviderGitHubActions {
+		return []string{
+			fmt.Sprintf("Commit and push the %s and %s directories to your repository to run the workflow.", color.HighlightResource("copilot/"), color.HighlightResource(".github/workflows/")),
+			fmt.Sprintf("Add protection rules, such as required reviewers, to the GitHub environments %s to approve their deployments.", english.WordSeries(o.environments, "and")),
+		}
+	}
 	return []string{
 		fmt.Sprintf("Commit and push the %s directory to your repository.", color.HighlightResource("copilot/")),
 		fmt.Sprintf("Run %s to create your pipeline.", color.HighlightCode("copilot pipeline deploy")),
@@ -774,16 +818,22 @@ func (o *initPipelineOpts) createBuildspec(buildSpecTemplatePath string) error {
 	if err != nil {
 		return err
 	}
+	var diffVariables []string
+	for _, env := range o.environments {
+		diffVariables = append(diffVariables, deploy.ApprovalDiffSummaryVariable(env), deploy.ApprovalDiffURLVariable(env))
+	}
 	content, err := o.parser.Parse(buildSpecTemplatePath, struct {
 		BinaryS3BucketPath string
 		Version            string
 		ManifestPath       string
 		ArtifactBuckets    []artifactBucket
+		DiffVariables      []string // Variables exported for the approval actions that show a diff.
 	}{
 		BinaryS3BucketPath: binaryS3BucketPath,
 		Version:            version.Version,
 		ManifestPath:       filepath.ToSlash(o.manifestPath), // The manifest path must be rendered in the buildspec with '/' instead of os-specific separator.
 		ArtifactBuckets:    artifactBuckets,
+		DiffVariables:      diffVariables,
 	}, template.WithFuncs(buildspecTemplateFunctions))
 	if err != nil {
 		return err
@@ -809,6 +859,102 @@ Previously set config will remain.
 	return nil
 }
 
+func (o *initPipelineOpts) initGitHubActions() error {
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	providerARN, err := o.oidc.OIDCProviderARN(githubActionsOIDCProviderURL)
+	if err != nil {
+		return err
+	}
+	regionalResources, err := o.cfnClient.GetRegionalAppResources(app)
+	if err != nil {
+		return fmt.Errorf("get regional application resources: %w", err)
+	}
+	var buckets []deploy.ArtifactBucket
+	for _, resource := range regionalResources {
+		buckets = append(buckets, deploy.ArtifactBucket{
+			BucketName: resource.S3Bucket,
+			KeyArn:     resource.KMSKeyARN,
+		})
+	}
+	var envManagerRoleARNs []string
+	var stages []githubWorkflowStage
+	for i, env := range o.envConfigs {
+		envManagerRoleARNs = append(envManagerRoleARNs, env.ManagerRoleARN)
+		stage := githubWorkflowStage{
+			Name: env.Name,
+		}
+		if i > 0 {
+			stage.Previous = o.envConfigs[i-1].Name
+		}
+		stages = append(stages, stage)
+	}
+	roleARN, err := o.roleDeployer.DeployGitHubActionsRole(&stack.GitHubActionsRoleConfig{
+		App:                 o.appName,
+		Pipeline:            o.name,
+		Repository:          fmt.Sprintf("%s/%s", o.repoOwner, o.repoName),
+		OIDCProviderARN:     providerARN,
+		EnvManagerRoleARNs:  envManagerRoleARNs,
+		ArtifactBuckets:     buckets,
+		PermissionsBoundary: app.PermissionsBoundary,
+		AdditionalTags:      app.Tags,
+	})
+	if err != nil {
+		return fmt.Errorf("deploy IAM role for GitHub Actions: %w", err)
+	}
+	log.Successf("Deployed the IAM role %s for GitHub Actions to assume.\n", color.HighlightResource(roleARN))
+
+	sess, err := o.sessProvider.Default()
+	if err != nil {
+		return fmt.Errorf("retrieve default session: %w", err)
+	}
+	content, err := o.parser.Parse(githubActionsWorkflowTemplatePath, struct {
+		Name               string
+		Branch             string
+		RoleARN            string
+		Region             string
+		BinaryS3BucketPath string
+		Version            string
+		DeployEnvironments bool
+		Stages             []githubWorkflowStage
+	}{
+		Name:               o.name,
+		Branch:             o.repoBranch,
+		RoleARN:            roleARN,
+		Region:             aws.StringValue(sess.Config.Region),
+		BinaryS3BucketPath: binaryS3BucketPath,
+		Version:            version.Version,
+		DeployEnvironments: o.pipelineType == pipelineTypeEnvironments,
+		Stages:             stages,
+	}, template.WithFuncs(buildspecTemplateFunctions))
+	if err != nil {
+		return err
+	}
+	workflowPath, err := o.workspace.WriteGitHubWorkflow(content, o.name)
+	var workflowExists bool
+	if err != nil {
+		e, ok := err.(*workspace.ErrFileExists)
+		if !ok {
+			return fmt.Errorf("write GitHub Actions workflow to workspace: %w", err)
+		}
+		workflowExists = true
+		workflowPath = e.FileName
+	}
+	workflowPath = displayPath(workflowPath)
+	if workflowExists {
+		log.Infof(`GitHub Actions workflow for pipeline already exists at %s, skipping writing it.
+Previously set config will remain.
+`, color.HighlightResource(workflowPath))
+		return nil
+	}
+	log.Successf("Wrote the GitHub Actions workflow for %s at '%s'\n", color.HighlightUserInput(o.repoName), color.HighlightResource(workflowPath))
+	log.Debugln(`The workflow deploys to each environment after the previous one succeeds.
+Each job runs in the GitHub environment of the same name, whose protection rules can require approvals.`)
+	return nil
+}
+
 func (o *initPipelineOpts) secretName() string {
 	return fmt.Sprintf(fmtSecretName, o.appName, o.repoName)
 }
@@ -884,6 +1030,10 @@ func buildPipelineInitCmd() *cobra.Command {
   /code  --name frontend-main \
   /code  --url https://github.com/gitHubUserName/frontend.git \
   /code  --git-branch main \
+  /code  --environments "stage,prod"
+  Create a GitHub Actions workflow, instead of CodePipeline, that deploys the services in your workspace.
+  /code $ copilot pipeline init --provider github-actions \
+  /code  --url https://github.com/gitHubUserName/frontend.git \
   /code  --environments "stage,prod"`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitPipelineOpts(vars)
@@ -917,5 +1067,6 @@ func buildPipelineInitCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.repoBranch, gitBranchFlag, gitBranchFlagShort, "", gitBranchFlagDescription)
 	cmd.Flags().StringSliceVarP(&vars.environments, envsFlag, envsFlagShort, []string{}, pipelineEnvsFlagDescription)
 	cmd.Flags().StringVarP(&vars.pipelineType, pipelineTypeFlag, pipelineTypeShort, "", pipelineTypeFlagDescription)
+	cmd.Flags().StringVar(&vars.ciProvider, pipelineProviderFlag, pipelineProviderCodePipeline, pipelineProviderFlagDescription)
 	return cmd
 }
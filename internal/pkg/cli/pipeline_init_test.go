@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -34,6 +35,46 @@ type pipelineInitMocks struct {
 	prompt         *mocks.Mockprompter
 	sel            *mocks.MockpipelineEnvSelector
 	pipelineLister *mocks.MockdeployedPipelineLister
+	roleDeployer   *mocks.MockgithubActionsRoleDeployer
+	oidc           *mocks.MockoidcProviderGetter
+}
+
+func TestInitPipelineOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inProvider string
+
+		wantedError error
+	}{
+		"valid when the provider is not set": {},
+		"valid with the GitHub Actions provider": {
+			inProvider: pipelineProviderGitHubActions,
+		},
+		"invalid provider": {
+			inProvider:  "jenkins",
+			wantedError: errors.New(`invalid provider "jenkins"; must be one of "codepipeline" or "github-actions"`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			opts := &initPipelineOpts{
+				initPipelineVars: initPipelineVars{
+					ciProvider: tc.inProvider,
+				},
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
 }
 
 func TestInitPipelineOpts_Ask(t *testing.T) {
@@ -57,6 +98,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 		inGitHubAccessToken string
 		inGitBranch         string
 		inType              string
+		inProvider          string
 
 		setupMocks func(m pipelineInitMocks)
 		buffer     bytes.Buffer
@@ -128,6 +170,15 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 			},
 			expectedError: errors.New("repository repo-man is in us-west-2, but app my-app is in us-east-1; they must be in the same region"),
 		},
+		"returns error when the repository is not from GitHub for GitHub Actions": {
+			inWsAppName: mockAppName,
+			inRepoURL:   "https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service",
+			inProvider:  pipelineProviderGitHubActions,
+			setupMocks: func(m pipelineInitMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil)
+			},
+			expectedError: errors.New("repository https://huanjani@bitbucket.org/huanjani/aws-copilot-sample-service must be from GitHub to run the pipeline with GitHub Actions"),
+		},
 		"returns error when Bitbucket repository URL is of unknown format": {
 			inWsAppName: mockAppName,
 			inRepoURL:   "bitbucket.org",
@@ -440,6 +491,7 @@ func TestInitPipelineOpts_Ask(t *testing.T) {
 					githubAccessToken: tc.inGitHubAccessToken,
 					repoBranch:        tc.inGitBranch,
 					pipelineType:      tc.inType,
+					ciProvider:        tc.inProvider,
 				},
 				wsAppName:      tc.inWsAppName,
 				prompt:         mocks.prompt,
@@ -488,6 +540,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 		inBranch       string
 		inAppName      string
 		inType         string
+		inProvider     string
 
 		setupMocks func(m pipelineInitMocks)
 		buffer     bytes.Buffer
@@ -847,6 +900,162 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			},
 			expectedError: fmt.Errorf("write buildspec to workspace: some error"),
 		},
+		"deploys the IAM role and writes the workflow for GitHub Actions": {
+			inName:     wantedName,
+			inType:     pipelineTypeWorkloads,
+			inProvider: pipelineProviderGitHubActions,
+			inEnvConfigs: []*config.Environment{
+				{
+					Name:           "test",
+					ManagerRoleARN: "arn:aws:iam::123456789012:role/badgoose-test-EnvManagerRole",
+				},
+				{
+					Name:           "prod",
+					ManagerRoleARN: "arn:aws:iam::210987654321:role/badgoose-prod-EnvManagerRole",
+				},
+			},
+			inRepoURL: "git@github.com:badgoose/goose.git",
+			inBranch:  "main",
+			inAppName: "badgoose",
+			setupMocks: func(m pipelineInitMocks) {
+				m.store.EXPECT().GetApplication("badgoose").Return(&config.Application{
+					Name:                "badgoose",
+					PermissionsBoundary: "mockBoundary",
+				}, nil)
+				m.oidc.EXPECT().OIDCProviderARN("token.actions.githubusercontent.com").Return("", nil)
+				m.cfnClient.EXPECT().GetRegionalAppResources(gomock.Any()).Return([]*stack.AppRegionalResources{
+					{
+						Region:    "us-west-2",
+						S3Bucket:  "gooseBucket",
+						KMSKeyARN: "arn:aws:kms:us-west-2:123456789012:key/abcd",
+					},
+				}, nil)
+				m.roleDeployer.EXPECT().DeployGitHubActionsRole(&stack.GitHubActionsRoleConfig{
+					App:        "badgoose",
+					Pipeline:   wantedName,
+					Repository: "badgoose/goose",
+					EnvManagerRoleARNs: []string{
+						"arn:aws:iam::123456789012:role/badgoose-test-EnvManagerRole",
+						"arn:aws:iam::210987654321:role/badgoose-prod-EnvManagerRole",
+					},
+					ArtifactBuckets: []deploy.ArtifactBucket{
+						{
+							BucketName: "gooseBucket",
+							KeyArn:     "arn:aws:kms:us-west-2:123456789012:key/abcd",
+						},
+					},
+					PermissionsBoundary: "mockBoundary",
+				}).Return("arn:aws:iam::123456789012:role/github-actions", nil)
+				m.sessProvider.EXPECT().Default().Return(&session.Session{
+					Config: &aws.Config{
+						Region: aws.String("us-west-2"),
+					},
+				}, nil)
+				m.parser.EXPECT().Parse(githubActionsWorkflowTemplatePath, gomock.Any(), gomock.Any()).DoAndReturn(func(_ string, data any, _ ...template.ParseOption) (*template.Content, error) {
+					require.Equal(t, "arn:aws:iam::123456789012:role/github-actions", reflect.ValueOf(data).FieldByName("RoleARN").String())
+					require.Equal(t, "us-west-2", reflect.ValueOf(data).FieldByName("Region").String())
+					require.Equal(t, []githubWorkflowStage{
+						{Name: "test"},
+						{Name: "prod", Previous: "test"},
+					}, reflect.ValueOf(data).FieldByName("Stages").Interface())
+					return &template.Content{Buffer: bytes.NewBufferString("hello")}, nil
+				})
+				m.workspace.EXPECT().WriteGitHubWorkflow(gomock.Any(), wantedName).Return("/.github/workflows/copilot-mypipe.yml", nil)
+				m.workspace.EXPECT().WritePipelineManifest(gomock.Any(), gomock.Any()).Times(0)
+				m.workspace.EXPECT().WritePipelineBuildspec(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"does not return an error if the GitHub Actions workflow already exists": {
+			inName:     wantedName,
+			inType:     pipelineTypeWorkloads,
+			inProvider: pipelineProviderGitHubActions,
+			inEnvConfigs: []*config.Environment{
+				{
+					Name: "test",
+				},
+			},
+			inRepoURL: "git@github.com:badgoose/goose.git",
+			inAppName: "badgoose",
+			setupMocks: func(m pipelineInitMocks) {
+				m.store.EXPECT().GetApplication("badgoose").Return(&config.Application{Name: "badgoose"}, nil)
+				m.oidc.EXPECT().OIDCProviderARN(gomock.Any()).Return("arn:aws:iam::123456789012:oidc-provider/token.actions.githubusercontent.com", nil)
+				m.cfnClient.EXPECT().GetRegionalAppResources(gomock.Any()).Return(nil, nil)
+				m.roleDeployer.EXPECT().DeployGitHubActionsRole(gomock.Any()).Return("arn:aws:iam::123456789012:role/github-actions", nil)
+				m.sessProvider.EXPECT().Default().Return(&session.Session{
+					Config: &aws.Config{
+						Region: aws.String("us-west-2"),
+					},
+				}, nil)
+				m.parser.EXPECT().Parse(githubActionsWorkflowTemplatePath, gomock.Any(), gomock.Any()).Return(&template.Content{
+					Buffer: bytes.NewBufferString("hello"),
+				}, nil)
+				m.workspace.EXPECT().WriteGitHubWorkflow(gomock.Any(), wantedName).Return("", &workspace.ErrFileExists{FileName: "/.github/workflows/copilot-mypipe.yml"})
+			},
+		},
+		"returns an error if the OIDC provider cannot be retrieved for GitHub Actions": {
+			inName:     wantedName,
+			inType:     pipelineTypeWorkloads,
+			inProvider: pipelineProviderGitHubActions,
+			inEnvConfigs: []*config.Environment{
+				{
+					Name: "test",
+				},
+			},
+			inRepoURL: "git@github.com:badgoose/goose.git",
+			inAppName: "badgoose",
+			setupMocks: func(m pipelineInitMocks) {
+				m.store.EXPECT().GetApplication("badgoose").Return(&config.Application{Name: "badgoose"}, nil)
+				m.oidc.EXPECT().OIDCProviderARN(gomock.Any()).Return("", errors.New("some error"))
+			},
+			expectedError: errors.New("some error"),
+		},
+		"returns an error if the IAM role for GitHub Actions cannot be deployed": {
+			inName:     wantedName,
+			inType:     pipelineTypeWorkloads,
+			inProvider: pipelineProviderGitHubActions,
+			inEnvConfigs: []*config.Environment{
+				{
+					Name: "test",
+				},
+			},
+			inRepoURL: "git@github.com:badgoose/goose.git",
+			inAppName: "badgoose",
+			setupMocks: func(m pipelineInitMocks) {
+				m.store.EXPECT().GetApplication("badgoose").Return(&config.Application{Name: "badgoose"}, nil)
+				m.oidc.EXPECT().OIDCProviderARN(gomock.Any()).Return("", nil)
+				m.cfnClient.EXPECT().GetRegionalAppResources(gomock.Any()).Return(nil, nil)
+				m.roleDeployer.EXPECT().DeployGitHubActionsRole(gomock.Any()).Return("", errors.New("some error"))
+			},
+			expectedError: errors.New("deploy IAM role for GitHub Actions: some error"),
+		},
+		"returns an error if the GitHub Actions workflow cannot be written": {
+			inName:     wantedName,
+			inType:     pipelineTypeWorkloads,
+			inProvider: pipelineProviderGitHubActions,
+			inEnvConfigs: []*config.Environment{
+				{
+					Name: "test",
+				},
+			},
+			inRepoURL: "git@github.com:badgoose/goose.git",
+			inAppName: "badgoose",
+			setupMocks: func(m pipelineInitMocks) {
+				m.store.EXPECT().GetApplication("badgoose").Return(&config.Application{Name: "badgoose"}, nil)
+				m.oidc.EXPECT().OIDCProviderARN(gomock.Any()).Return("", nil)
+				m.cfnClient.EXPECT().GetRegionalAppResources(gomock.Any()).Return(nil, nil)
+				m.roleDeployer.EXPECT().DeployGitHubActionsRole(gomock.Any()).Return("arn:aws:iam::123456789012:role/github-actions", nil)
+				m.sessProvider.EXPECT().Default().Return(&session.Session{
+					Config: &aws.Config{
+						Region: aws.String("us-west-2"),
+					},
+				}, nil)
+				m.parser.EXPECT().Parse(githubActionsWorkflowTemplatePath, gomock.Any(), gomock.Any()).Return(&template.Content{
+					Buffer: bytes.NewBufferString("hello"),
+				}, nil)
+				m.workspace.EXPECT().WriteGitHubWorkflow(gomock.Any(), wantedName).Return("", errors.New("some error"))
+			},
+			expectedError: errors.New("write GitHub Actions workflow to workspace: some error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -862,6 +1071,8 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				sessProvider:   mocks.NewMocksessionProvider(ctrl),
 				cfnClient:      mocks.NewMockappResourcesGetter(ctrl),
 				store:          mocks.NewMockstore(ctrl),
+				roleDeployer:   mocks.NewMockgithubActionsRoleDeployer(ctrl),
+				oidc:           mocks.NewMockoidcProviderGetter(ctrl),
 			}
 			if tc.setupMocks != nil {
 				tc.setupMocks(mocks)
@@ -874,6 +1085,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 					repoBranch:        tc.inBranch,
 					repoURL:           tc.inRepoURL,
 					pipelineType:      tc.inType,
+					ciProvider:        tc.inProvider,
 				},
 				workspace:      mocks.workspace,
 				secretsmanager: mocks.secretsmanager,
@@ -881,6 +1093,8 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				sessProvider:   mocks.sessProvider,
 				store:          mocks.store,
 				cfnClient:      mocks.cfnClient,
+				roleDeployer:   mocks.roleDeployer,
+				oidc:           mocks.oidc,
 				buffer:         tc.buffer,
 				envConfigs:     tc.inEnvConfigs,
 			}
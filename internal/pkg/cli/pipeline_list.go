@@ -75,7 +75,7 @@ func newListPipelinesOpts(vars listPipelineVars) (*listPipelineOpts, error) {
 		wsAppName = tryReadingAppName()
 	}
 
-	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	store := config.NewCachedSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
 	prompter := prompt.New()
 	return &listPipelineOpts{
 		listPipelineVars: vars,
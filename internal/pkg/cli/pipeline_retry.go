@@ -0,0 +1,196 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	rg "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	pipelineRetryAppNamePrompt     = "Which application's pipeline would you like to retry?"
+	pipelineRetryAppNameHelpPrompt = "An application is a collection of related services."
+	pipelineRetryStagePrompt       = "Which failed stage would you like to retry?"
+	pipelineRetryStageHelpPrompt   = "Only the failed actions of the stage are run again, with the same source revision."
+
+	fmtPipelineRetryPrompt = "Which pipeline of %s would you like to retry?"
+)
+
+type retryPipelineVars struct {
+	appName string
+	name    string
+	stage   string
+}
+
+type retryPipelineOpts struct {
+	retryPipelineVars
+
+	store                  store
+	sel                    codePipelineSelector
+	prompt                 prompter
+	deployedPipelineLister deployedPipelineLister
+	codepipeline           pipelineStageRetrier
+
+	// Cached variables.
+	targetPipeline *deploy.Pipeline
+}
+
+func newRetryPipelineOpts(vars retryPipelineVars) (*retryPipelineOpts, error) {
+	sess, err := sessions.ImmutableProvider(sessions.UserAgentExtras("pipeline retry")).Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	pipelineLister := deploy.NewPipelineStore(rg.New(sess))
+	store := config.NewSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region))
+	prompter := prompt.New()
+	return &retryPipelineOpts{
+		retryPipelineVars:      vars,
+		store:                  store,
+		sel:                    selector.NewAppPipelineSelector(prompter, store, pipelineLister),
+		prompt:                 prompter,
+		deployedPipelineLister: pipelineLister,
+		codepipeline:           codepipeline.New(sess),
+	}, nil
+}
+
+// Validate returns an error if the optional flag values provided by the user are invalid.
+func (o *retryPipelineOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for fields that are required but not passed in, and validates those that are.
+func (o *retryPipelineOpts) Ask() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name: %w", err)
+		}
+	} else {
+		if err := o.askAppName(); err != nil {
+			return err
+		}
+	}
+	if err := o.askPipelineName(); err != nil {
+		return err
+	}
+	return o.askStage()
+}
+
+// Execute retries the failed actions of the stage of the pipeline.
+func (o *retryPipelineOpts) Execute() error {
+	if err := o.codepipeline.RetryStageExecution(o.targetPipeline.ResourceName, o.stage); err != nil {
+		return fmt.Errorf("retry stage %s of pipeline %s: %w", o.stage, o.name, err)
+	}
+	log.Successf("Retrying the failed actions of stage %s of pipeline %s.\n", color.HighlightUserInput(o.stage), color.HighlightUserInput(o.name))
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *retryPipelineOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to follow the retry of the stage.", color.HighlightCode(fmt.Sprintf("copilot pipeline status -n %s --watch", o.name))),
+	})
+	return nil
+}
+
+func (o *retryPipelineOpts) askAppName() error {
+	name, err := o.sel.Application(pipelineRetryAppNamePrompt, pipelineRetryAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = name
+	return nil
+}
+
+func (o *retryPipelineOpts) askPipelineName() error {
+	if o.name != "" {
+		pipeline, err := getDeployedPipelineInfo(o.deployedPipelineLister, o.appName, o.name)
+		if err != nil {
+			return fmt.Errorf("validate pipeline name %s: %w", o.name, err)
+		}
+		o.targetPipeline = &pipeline
+		return nil
+	}
+	pipeline, err := askDeployedPipelineName(o.sel, fmt.Sprintf(fmtPipelineRetryPrompt, color.HighlightUserInput(o.appName)), o.appName)
+	if err != nil {
+		return err
+	}
+	o.name = pipeline.Name
+	o.targetPipeline = &pipeline
+	return nil
+}
+
+func (o *retryPipelineOpts) askStage() error {
+	state, err := o.codepipeline.GetPipelineState(o.targetPipeline.ResourceName)
+	if err != nil {
+		return fmt.Errorf("get state of pipeline %s: %w", o.name, err)
+	}
+	var failedStages []string
+	for _, stage := range state.StageStates {
+		if o.stage != "" && stage.StageName == o.stage {
+			if stage.AggregateStatus() != codepipeline.ActionStatusFailed {
+				return fmt.Errorf("stage %s of pipeline %s has no failed actions to retry", o.stage, o.name)
+			}
+			return nil
+		}
+		if stage.AggregateStatus() == codepipeline.ActionStatusFailed {
+			failedStages = append(failedStages, stage.StageName)
+		}
+	}
+	if o.stage != "" {
+		return fmt.Errorf("stage %s does not exist in pipeline %s", o.stage, o.name)
+	}
+	switch len(failedStages) {
+	case 0:
+		return fmt.Errorf("no failed stages to retry in pipeline %s", o.name)
+	case 1:
+		log.Infof("Only found one failed stage, defaulting to: %s\n", color.HighlightUserInput(failedStages[0]))
+		o.stage = failedStages[0]
+		return nil
+	}
+	stage, err := o.prompt.SelectOne(pipelineRetryStagePrompt, pipelineRetryStageHelpPrompt, failedStages, prompt.WithFinalMessage("Stage:"))
+	if err != nil {
+		return fmt.Errorf("select stage: %w", err)
+	}
+	o.stage = stage
+	return nil
+}
+
+// buildPipelineRetryCmd builds the command for retrying a failed stage of a deployed pipeline.
+func buildPipelineRetryCmd() *cobra.Command {
+	vars := retryPipelineVars{}
+	cmd := &cobra.Command{
+		Use:   "retry",
+		Short: "Retries a failed stage of a pipeline.",
+		Long: `Retries a failed stage of a pipeline.
+Only the failed actions of the stage are run again, with the source revision of the failed release.`,
+
+		Example: `
+Retries the failed actions of the "DeployTo-test" stage of the pipeline "my-repo-my-branch".
+/code $ copilot pipeline retry -n my-repo-my-branch --stage DeployTo-test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRetryPipelineOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.stage, pipelineStageFlag, "", pipelineStageFlagDescription)
+	return cmd
+}
@@ -0,0 +1,269 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type pipelineRetryMocks struct {
+	store                  *mocks.Mockstore
+	sel                    *mocks.MockcodePipelineSelector
+	prompt                 *mocks.Mockprompter
+	deployedPipelineLister *mocks.MockdeployedPipelineLister
+	codepipeline           *mocks.MockpipelineStageRetrier
+}
+
+func TestPipelineRetry_Ask(t *testing.T) {
+	const (
+		mockAppName              = "dinder"
+		mockPipelineName         = "pipeline-dinder-badgoose-repo"
+		mockPipelineResourceName = "pipeline-dinder-badgoose-repo-RANDOMSTRING"
+	)
+	mockPipeline := deploy.Pipeline{
+		AppName:      mockAppName,
+		Name:         mockPipelineName,
+		ResourceName: mockPipelineResourceName,
+	}
+	mockState := &codepipeline.PipelineState{
+		PipelineName: mockPipelineResourceName,
+		StageStates: []*codepipeline.StageState{
+			{
+				StageName: "Source",
+				Actions:   []codepipeline.StageAction{{Name: "SourceCodeFor-dinder", Status: "Succeeded"}},
+			},
+			{
+				StageName: "DeployTo-test",
+				Actions:   []codepipeline.StageAction{{Name: "CreateOrUpdate-api-test", Status: "Failed"}},
+			},
+			{
+				StageName: "DeployTo-prod",
+				Actions:   []codepipeline.StageAction{{Name: "CreateOrUpdate-api-prod", Status: "Failed"}},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inApp      string
+		inPipeline string
+		inStage    string
+		setupMocks func(m pipelineRetryMocks)
+
+		wantedApp      string
+		wantedPipeline string
+		wantedStage    string
+		wantedError    error
+	}{
+		"error if the application does not exist": {
+			inApp: mockAppName,
+			setupMocks: func(m pipelineRetryMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("validate application name: some error"),
+		},
+		"error if the pipeline does not exist": {
+			inApp:      mockAppName,
+			inPipeline: "badgoose",
+			setupMocks: func(m pipelineRetryMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{mockPipeline}, nil)
+			},
+			wantedError: errors.New("validate pipeline name badgoose: cannot find pipeline named badgoose"),
+		},
+		"error if the stage does not exist": {
+			inApp:      mockAppName,
+			inPipeline: mockPipelineName,
+			inStage:    "DeployTo-staging",
+			setupMocks: func(m pipelineRetryMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{mockPipeline}, nil)
+				m.codepipeline.EXPECT().GetPipelineState(mockPipelineResourceName).Return(mockState, nil)
+			},
+			wantedError: errors.New("stage DeployTo-staging does not exist in pipeline pipeline-dinder-badgoose-repo"),
+		},
+		"error if the stage has not failed": {
+			inApp:      mockAppName,
+			inPipeline: mockPipelineName,
+			inStage:    "Source",
+			setupMocks: func(m pipelineRetryMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{mockPipeline}, nil)
+				m.codepipeline.EXPECT().GetPipelineState(mockPipelineResourceName).Return(mockState, nil)
+			},
+			wantedError: errors.New("stage Source of pipeline pipeline-dinder-badgoose-repo has no failed actions to retry"),
+		},
+		"validates the flags": {
+			inApp:      mockAppName,
+			inPipeline: mockPipelineName,
+			inStage:    "DeployTo-test",
+			setupMocks: func(m pipelineRetryMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{mockPipeline}, nil)
+				m.codepipeline.EXPECT().GetPipelineState(mockPipelineResourceName).Return(mockState, nil)
+			},
+			wantedApp:      mockAppName,
+			wantedPipeline: mockPipelineName,
+			wantedStage:    "DeployTo-test",
+		},
+		"error if the state of the pipeline cannot be retrieved": {
+			inApp:      mockAppName,
+			inPipeline: mockPipelineName,
+			setupMocks: func(m pipelineRetryMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{mockPipeline}, nil)
+				m.codepipeline.EXPECT().GetPipelineState(mockPipelineResourceName).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get state of pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+		"error if no stage has failed": {
+			inApp:      mockAppName,
+			inPipeline: mockPipelineName,
+			setupMocks: func(m pipelineRetryMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{mockPipeline}, nil)
+				m.codepipeline.EXPECT().GetPipelineState(mockPipelineResourceName).Return(&codepipeline.PipelineState{
+					StageStates: mockState.StageStates[:1],
+				}, nil)
+			},
+			wantedError: errors.New("no failed stages to retry in pipeline pipeline-dinder-badgoose-repo"),
+		},
+		"defaults to the only failed stage": {
+			inApp:      mockAppName,
+			inPipeline: mockPipelineName,
+			setupMocks: func(m pipelineRetryMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{mockPipeline}, nil)
+				m.codepipeline.EXPECT().GetPipelineState(mockPipelineResourceName).Return(&codepipeline.PipelineState{
+					StageStates: mockState.StageStates[:2],
+				}, nil)
+			},
+			wantedApp:      mockAppName,
+			wantedPipeline: mockPipelineName,
+			wantedStage:    "DeployTo-test",
+		},
+		"prompts for the application, the pipeline and the failed stage": {
+			setupMocks: func(m pipelineRetryMocks) {
+				m.sel.EXPECT().Application(pipelineRetryAppNamePrompt, pipelineRetryAppNameHelpPrompt).Return(mockAppName, nil)
+				m.sel.EXPECT().DeployedPipeline(gomock.Any(), gomock.Any(), mockAppName).Return(mockPipeline, nil)
+				m.codepipeline.EXPECT().GetPipelineState(mockPipelineResourceName).Return(mockState, nil)
+				m.prompt.EXPECT().SelectOne(pipelineRetryStagePrompt, pipelineRetryStageHelpPrompt, []string{"DeployTo-test", "DeployTo-prod"}, gomock.Any()).
+					Return("DeployTo-prod", nil)
+			},
+			wantedApp:      mockAppName,
+			wantedPipeline: mockPipelineName,
+			wantedStage:    "DeployTo-prod",
+		},
+		"error if fail to select a stage": {
+			inApp:      mockAppName,
+			inPipeline: mockPipelineName,
+			setupMocks: func(m pipelineRetryMocks) {
+				m.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{Name: mockAppName}, nil)
+				m.deployedPipelineLister.EXPECT().ListDeployedPipelines(mockAppName).Return([]deploy.Pipeline{mockPipeline}, nil)
+				m.codepipeline.EXPECT().GetPipelineState(mockPipelineResourceName).Return(mockState, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select stage: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := pipelineRetryMocks{
+				store:                  mocks.NewMockstore(ctrl),
+				sel:                    mocks.NewMockcodePipelineSelector(ctrl),
+				prompt:                 mocks.NewMockprompter(ctrl),
+				deployedPipelineLister: mocks.NewMockdeployedPipelineLister(ctrl),
+				codepipeline:           mocks.NewMockpipelineStageRetrier(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &retryPipelineOpts{
+				retryPipelineVars: retryPipelineVars{
+					appName: tc.inApp,
+					name:    tc.inPipeline,
+					stage:   tc.inStage,
+				},
+				store:                  m.store,
+				sel:                    m.sel,
+				prompt:                 m.prompt,
+				deployedPipelineLister: m.deployedPipelineLister,
+				codepipeline:           m.codepipeline,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.appName)
+				require.Equal(t, tc.wantedPipeline, opts.name)
+				require.Equal(t, tc.wantedStage, opts.stage)
+			}
+		})
+	}
+}
+
+func TestPipelineRetry_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockpipelineStageRetrier)
+
+		wantedError error
+	}{
+		"retries the stage": {
+			setupMocks: func(m *mocks.MockpipelineStageRetrier) {
+				m.EXPECT().RetryStageExecution("pipeline-dinder-badgoose-repo-RANDOMSTRING", "DeployTo-test").Return(nil)
+			},
+		},
+		"wraps the error": {
+			setupMocks: func(m *mocks.MockpipelineStageRetrier) {
+				m.EXPECT().RetryStageExecution(gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("retry stage DeployTo-test of pipeline pipeline-dinder-badgoose-repo: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockpipelineStageRetrier(ctrl)
+			tc.setupMocks(m)
+			opts := &retryPipelineOpts{
+				retryPipelineVars: retryPipelineVars{
+					appName: "dinder",
+					name:    "pipeline-dinder-badgoose-repo",
+					stage:   "DeployTo-test",
+				},
+				codepipeline: m,
+				targetPipeline: &deploy.Pipeline{
+					Name:         "pipeline-dinder-badgoose-repo",
+					ResourceName: "pipeline-dinder-badgoose-repo-RANDOMSTRING",
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
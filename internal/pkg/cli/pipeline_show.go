@@ -21,6 +21,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/output"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -71,7 +72,7 @@ func newShowPipelineOpts(vars showPipelineVars) (*showPipelineOpts, error) {
 	}
 	codepipeline := codepipeline.New(defaultSession)
 	pipelineLister := deploy.NewPipelineStore(rg.New(defaultSession))
-	store := config.NewSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
+	store := config.NewCachedSSMStore(identity.New(defaultSession), ssm.New(defaultSession), aws.StringValue(defaultSession.Config.Region))
 	prompter := prompt.New()
 	opts := &showPipelineOpts{
 		showPipelineVars:       vars,
@@ -142,17 +143,7 @@ func (o *showPipelineOpts) Execute() error {
 		return fmt.Errorf("describe pipeline %s: %w", o.name, err)
 	}
 
-	if o.shouldOutputJSON {
-		data, err := pipeline.JSONString()
-		if err != nil {
-			return err
-		}
-		fmt.Fprint(o.w, data)
-	} else {
-		fmt.Fprint(o.w, pipeline.HumanString())
-	}
-
-	return nil
+	return output.New(o.w, o.shouldOutputJSON).Write(pipeline)
 }
 
 func (o *showPipelineOpts) getTargetPipeline() (deploy.Pipeline, error) {
@@ -21,6 +21,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/output"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -39,6 +40,7 @@ type pipelineStatusVars struct {
 	appName          string
 	shouldOutputJSON bool
 	name             string
+	shouldWatch      bool
 }
 
 type pipelineStatusOpts struct {
@@ -49,6 +51,7 @@ type pipelineStatusOpts struct {
 	store                  store
 	codepipeline           pipelineGetter
 	describer              describer
+	watcher                pipelineStatusWatcher
 	sel                    codePipelineSelector
 	prompt                 prompter
 	initDescriber          func(opts *pipelineStatusOpts) error
@@ -91,6 +94,7 @@ func newPipelineStatusOpts(vars pipelineStatusVars) (*pipelineStatusOpts, error)
 				return fmt.Errorf("new pipeline status describer: %w", err)
 			}
 			o.describer = d
+			o.watcher = d
 			return nil
 		},
 	}, nil
@@ -133,22 +137,21 @@ func (o *pipelineStatusOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("describe status of pipeline: %w", err)
 	}
+	if o.shouldWatch {
+		out := output.New(o.w, o.shouldOutputJSON)
+		if err := o.watcher.Watch(func(status describe.HumanJSONStringer) error {
+			return out.Write(status)
+		}); err != nil {
+			return fmt.Errorf("watch status of pipeline: %w", err)
+		}
+		return nil
+	}
 	pipelineStatus, err := o.describer.Describe()
 	if err != nil {
 		return fmt.Errorf("describe status of pipeline: %w", err)
 	}
 
-	if o.shouldOutputJSON {
-		data, err := pipelineStatus.JSONString()
-		if err != nil {
-			return err
-		}
-		fmt.Fprint(o.w, data)
-	} else {
-		fmt.Fprint(o.w, pipelineStatus.HumanString())
-	}
-
-	return nil
+	return output.New(o.w, o.shouldOutputJSON).Write(pipelineStatus)
 }
 
 func (o *pipelineStatusOpts) getTargetPipeline() (deploy.Pipeline, error) {
@@ -178,11 +181,14 @@ func buildPipelineStatusCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "status",
 		Short: "Shows the status of a pipeline.",
-		Long:  "Shows the status of each stage of your pipeline.",
+		Long: `Shows the status of each stage of your pipeline.
+Shows the source revisions, and the errors and last log lines of failed actions.`,
 
 		Example: `
 Shows status of the pipeline "my-repo-my-branch".
-/code $ copilot pipeline status -n my-repo-my-branch`,
+/code $ copilot pipeline status -n my-repo-my-branch
+Streams the status changes of the actions of the pipeline until the release is done.
+/code $ copilot pipeline status -n my-repo-my-branch --watch`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newPipelineStatusOpts(vars)
 			if err != nil {
@@ -194,6 +200,7 @@ Shows status of the pipeline "my-repo-my-branch".
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldWatch, watchFlag, false, pipelineStatusWatchFlagDescription)
 
 	return cmd
 }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/golang/mock/gomock"
@@ -23,6 +24,7 @@ type pipelineStatusMocks struct {
 	prompt                 *mocks.Mockprompter
 	codepipeline           *mocks.MockpipelineGetter
 	describer              *mocks.Mockdescriber
+	watcher                *mocks.MockpipelineStatusWatcher
 	sel                    *mocks.MockcodePipelineSelector
 	deployedPipelineLister *mocks.MockdeployedPipelineLister
 }
@@ -192,6 +194,7 @@ func TestPipelineStatus_Execute(t *testing.T) {
 	}
 	testCases := map[string]struct {
 		shouldOutputJSON bool
+		shouldWatch      bool
 		pipelineName     string
 		setupMocks       func(m pipelineStatusMocks)
 
@@ -214,6 +217,27 @@ func TestPipelineStatus_Execute(t *testing.T) {
 			expectedContent: "mockData",
 			expectedError:   nil,
 		},
+		"writes every status change while watching": {
+			pipelineName: mockPipelineName,
+			shouldWatch:  true,
+			setupMocks: func(m pipelineStatusMocks) {
+				m.watcher.EXPECT().Watch(gomock.Any()).DoAndReturn(func(write func(describe.HumanJSONStringer) error) error {
+					if err := write(&mockDescribeData{data: "status\n"}); err != nil {
+						return err
+					}
+					return write(&mockDescribeData{data: "Build/Build: InProgress -> Succeeded\n"})
+				})
+			},
+			expectedContent: "status\nBuild/Build: InProgress -> Succeeded\n",
+		},
+		"wraps the error while watching": {
+			pipelineName: mockPipelineName,
+			shouldWatch:  true,
+			setupMocks: func(m pipelineStatusMocks) {
+				m.watcher.EXPECT().Watch(gomock.Any()).Return(mockError)
+			},
+			expectedError: errors.New("watch status of pipeline: mock error"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -225,8 +249,10 @@ func TestPipelineStatus_Execute(t *testing.T) {
 			b := &bytes.Buffer{}
 			mockDescriber := mocks.NewMockdescriber(ctrl)
 
+			mockWatcher := mocks.NewMockpipelineStatusWatcher(ctrl)
 			mocks := pipelineStatusMocks{
 				describer: mockDescriber,
+				watcher:   mockWatcher,
 			}
 
 			tc.setupMocks(mocks)
@@ -234,9 +260,11 @@ func TestPipelineStatus_Execute(t *testing.T) {
 			opts := &pipelineStatusOpts{
 				pipelineStatusVars: pipelineStatusVars{
 					shouldOutputJSON: tc.shouldOutputJSON,
+					shouldWatch:      tc.shouldWatch,
 					name:             tc.pipelineName,
 				},
 				describer:     mockDescriber,
+				watcher:       mockWatcher,
 				initDescriber: func(o *pipelineStatusOpts) error { return nil },
 				w:             b,
 			}
@@ -0,0 +1,27 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/spf13/cobra"
+)
+
+// BuildQueueCmd is the top level command for queue.
+func BuildQueueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "queue",
+		Short: `Commands for queues.
+Queues are the SQS queues that buffer the messages of a Worker Service.`,
+	}
+
+	cmd.AddCommand(buildQueueStatusCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Develop,
+	}
+	return cmd
+}
@@ -0,0 +1,168 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/output"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	queueStatusNamePrompt     = "Which worker service's queues would you like to show?"
+	queueStatusNameHelpPrompt = "Displays the number of messages, the age of the oldest message and the dead-letter queue of each queue."
+)
+
+type queueStatusVars struct {
+	shouldOutputJSON bool
+	svcName          string
+	envName          string
+	appName          string
+}
+
+type queueStatusOpts struct {
+	queueStatusVars
+
+	w                   io.Writer
+	store               store
+	statusDescriber     statusDescriber
+	sel                 deploySelector
+	initStatusDescriber func(*queueStatusOpts) error
+}
+
+func newQueueStatusOpts(vars queueStatusVars) (*queueStatusOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("queue status"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &queueStatusOpts{
+		queueStatusVars: vars,
+		store:           configStore,
+		w:               log.OutputWriter,
+		sel:             selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		initStatusDescriber: func(o *queueStatusOpts) error {
+			d, err := describe.NewQueueStatusDescriber(&describe.NewServiceStatusConfig{
+				App:         o.appName,
+				Env:         o.envName,
+				Svc:         o.svcName,
+				ConfigStore: configStore,
+			})
+			if err != nil {
+				return fmt.Errorf("create queue status describer for service %s in application %s: %w", o.svcName, o.appName, err)
+			}
+			o.statusDescriber = d
+			return nil
+		},
+	}, nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *queueStatusOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskSvcEnvName()
+}
+
+// Execute displays the status of the queues of the worker service.
+func (o *queueStatusOpts) Execute() error {
+	if err := o.initStatusDescriber(o); err != nil {
+		return err
+	}
+	status, err := o.statusDescriber.Describe()
+	if err != nil {
+		return fmt.Errorf("describe status of the queues of service %s: %w", o.svcName, err)
+	}
+	return output.New(o.w, o.shouldOutputJSON).Write(status)
+}
+
+func (o *queueStatusOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *queueStatusOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		svc, err := o.store.GetService(o.appName, o.svcName)
+		if err != nil {
+			return err
+		}
+		if svc.Type != manifestinfo.WorkerServiceType {
+			return fmt.Errorf("service %s is a %s: only a %s has queues", o.svcName, svc.Type, manifestinfo.WorkerServiceType)
+		}
+	}
+	deployedService, err := o.sel.DeployedService(queueStatusNamePrompt, queueStatusNameHelpPrompt, o.appName,
+		selector.WithEnv(o.envName), selector.WithName(o.svcName),
+		selector.WithServiceTypesFilter([]string{manifestinfo.WorkerServiceType}))
+	if err != nil {
+		return fmt.Errorf("select deployed worker services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildQueueStatusCmd builds the command for showing the status of the queues of a deployed worker service.
+func buildQueueStatusCmd() *cobra.Command {
+	vars := queueStatusVars{}
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Shows the status of the queues of a deployed worker service.",
+		Long: `Shows the status of the queues of a deployed worker service.
+For each queue, the number of available, in flight and delayed messages, the age of the oldest message
+and the dead-letter queue are shown.`,
+
+		Example: `
+  Shows the status of the queues of the worker service "orders" in the "test" environment.
+  /code $ copilot queue status -n orders -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newQueueStatusOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
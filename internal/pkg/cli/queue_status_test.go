@@ -0,0 +1,184 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type queueStatusAskMock struct {
+	store *mocks.Mockstore
+	sel   *mocks.MockdeploySelector
+}
+
+func TestQueueStatus_Ask(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inputApp   string
+		inputSvc   string
+		inputEnv   string
+		setupMocks func(m queueStatusAskMock)
+
+		wantedApp   string
+		wantedSvc   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"select a deployed worker service": {
+			setupMocks: func(m queueStatusAskMock) {
+				m.sel.EXPECT().Application(svcAppNamePrompt, wkldAppNameHelpPrompt).Return("phonetool", nil)
+				m.sel.EXPECT().DeployedService(queueStatusNamePrompt, queueStatusNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "test",
+						Name: "orders",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedSvc: "orders",
+			wantedEnv: "test",
+		},
+		"validate the flags before selecting": {
+			inputApp: "phonetool",
+			inputSvc: "orders",
+			inputEnv: "test",
+			setupMocks: func(m queueStatusAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.store.EXPECT().GetService("phonetool", "orders").Return(&config.Workload{
+					Name: "orders",
+					Type: manifestinfo.WorkerServiceType,
+				}, nil)
+				m.sel.EXPECT().DeployedService(queueStatusNamePrompt, queueStatusNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "test",
+						Name: "orders",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedSvc: "orders",
+			wantedEnv: "test",
+		},
+		"error if the service is not a worker service": {
+			inputApp: "phonetool",
+			inputSvc: "api",
+			setupMocks: func(m queueStatusAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{
+					Name: "api",
+					Type: manifestinfo.LoadBalancedWebServiceType,
+				}, nil)
+			},
+			wantedError: errors.New("service api is a Load Balanced Web Service: only a Worker Service has queues"),
+		},
+		"error if fail to select a deployed worker service": {
+			inputApp: "phonetool",
+			setupMocks: func(m queueStatusAskMock) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, mockError)
+			},
+			wantedError: fmt.Errorf("select deployed worker services for application phonetool: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := queueStatusAskMock{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockdeploySelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &queueStatusOpts{
+				queueStatusVars: queueStatusVars{
+					appName: tc.inputApp,
+					svcName: tc.inputSvc,
+					envName: tc.inputEnv,
+				},
+				store: m.store,
+				sel:   m.sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.appName)
+				require.Equal(t, tc.wantedSvc, opts.svcName)
+				require.Equal(t, tc.wantedEnv, opts.envName)
+			}
+		})
+	}
+}
+
+func TestQueueStatus_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		shouldOutputJSON    bool
+		mockStatusDescriber func(m *mocks.MockstatusDescriber)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"write the status": {
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(&mockDescribeData{data: "Queues"}, nil)
+			},
+			wantedContent: "Queues",
+		},
+		"write the status in JSON": {
+			shouldOutputJSON: true,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(&mockDescribeData{data: `{"queues":[]}`}, nil)
+			},
+			wantedContent: `{"queues":[]}`,
+		},
+		"error if fail to describe the status of the queues": {
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("describe status of the queues of service orders: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			b := &bytes.Buffer{}
+			mockStatusDescriber := mocks.NewMockstatusDescriber(ctrl)
+			tc.mockStatusDescriber(mockStatusDescriber)
+			opts := &queueStatusOpts{
+				queueStatusVars: queueStatusVars{
+					appName:          "phonetool",
+					svcName:          "orders",
+					envName:          "test",
+					shouldOutputJSON: tc.shouldOutputJSON,
+				},
+				statusDescriber:     mockStatusDescriber,
+				initStatusDescriber: func(*queueStatusOpts) error { return nil },
+				w:                   b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Contains(t, b.String(), tc.wantedContent)
+			}
+		})
+	}
+}
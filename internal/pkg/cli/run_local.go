@@ -0,0 +1,683 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const workloadAskPrompt = "Which workload would you like to run locally?"
+
+const (
+	// localImageTag is the tag of the images built for running a workload locally.
+	localImageTag = "local"
+	// pauseContainerImage is the image of the container that owns the network namespace shared by the workload's containers.
+	pauseContainerImage = "public.ecr.aws/amazonlinux/amazonlinux:2023"
+	// proxyHostGateway resolves to the host machine from inside a container.
+	proxyHostGateway = "host-gateway"
+
+	defaultRunLocalWatchInterval = time.Second
+)
+
+type runLocalVars struct {
+	wkldName string
+	wkldType string
+	appName  string
+	envName  string
+	watch    bool
+	proxy    bool
+}
+
+type runLocalOpts struct {
+	runLocalVars
+
+	sel                      deploySelector
+	ecsLocalClient           ecsLocalClient
+	sess                     *session.Session
+	sessProvider             sessionProvider
+	store                    store
+	deployStore              deployedEnvironmentLister
+	ws                       wsWlDirReader
+	newInterpolator          func(app, env string) interpolator
+	unmarshal                func([]byte) (manifest.DynamicWorkload, error)
+	containerRunner          containerRunner
+	newRegistryAuthenticator func(*session.Session) registryAuthenticator
+	newSvcDescriber          func(*session.Session) serviceDescriber
+	newPortForwarder         func(*session.Session) portForwarder
+	newContext               func() (context.Context, context.CancelFunc)
+	fs                       afero.Fs
+	out                      io.Writer
+	outMu                    sync.Mutex // Guards out, which is shared by the containers' output.
+	watchInterval            time.Duration
+}
+
+func newRunLocalOpts(vars runLocalVars) (*runLocalOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("run local"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+
+	store := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, err
+	}
+	fs := afero.NewOsFs()
+	ws, err := workspace.Use(fs)
+	if err != nil {
+		return nil, err
+	}
+	opts := &runLocalOpts{
+		runLocalVars:    vars,
+		sel:             selector.NewDeploySelect(prompt.New(), store, deployStore),
+		store:           store,
+		deployStore:     deployStore,
+		ecsLocalClient:  ecs.New(defaultSess),
+		sess:            defaultSess,
+		sessProvider:    sessProvider,
+		ws:              ws,
+		newInterpolator: newManifestInterpolator,
+		unmarshal:       manifest.UnmarshalWorkload,
+		containerRunner: dockerengine.New(exec.NewCmd()),
+		newRegistryAuthenticator: func(s *session.Session) registryAuthenticator {
+			return ecr.New(s)
+		},
+		newSvcDescriber: func(s *session.Session) serviceDescriber {
+			return ecs.New(s)
+		},
+		newPortForwarder: func(s *session.Session) portForwarder {
+			return ssm.New(s)
+		},
+		newContext: func() (context.Context, context.CancelFunc) {
+			return signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		},
+		fs:            fs,
+		out:           os.Stdout,
+		watchInterval: defaultRunLocalWatchInterval,
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *runLocalOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	// Ensure that the application name provided exists in the workspace
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	return nil
+}
+
+// Ask prompts the user for any unprovided required fields and validates them.
+func (o *runLocalOpts) Ask() error {
+	return o.validateAndAskWkldEnvName()
+}
+
+func (o *runLocalOpts) validateAndAskWkldEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.wkldName != "" {
+		if _, err := o.store.GetWorkload(o.appName, o.wkldName); err != nil {
+			return err
+		}
+	}
+
+	deployedWorkload, err := o.sel.DeployedWorkload(workloadAskPrompt, "", o.appName, selector.WithEnv(o.envName), selector.WithName(o.wkldName))
+	if err != nil {
+		return fmt.Errorf("select a deployed workload from application %s: %w", o.appName, err)
+	}
+	o.wkldName = deployedWorkload.Name
+	o.envName = deployedWorkload.Env
+	o.wkldType = deployedWorkload.Type
+	return nil
+}
+
+// localContainer is a container of the workload's task definition run with docker.
+type localContainer struct {
+	*dockerengine.RunOptions
+	name      string
+	essential bool
+	build     *dockerengine.BuildArguments // Nil if the image is pulled instead of built locally.
+}
+
+type containerExit struct {
+	name string
+	err  error
+}
+
+// Execute builds and runs the workload images locally.
+func (o *runLocalOpts) Execute() error {
+	if o.wkldType == manifestinfo.RequestDrivenWebServiceType || o.wkldType == manifestinfo.StaticSiteType || o.wkldType == manifestinfo.LambdaServiceType {
+		return fmt.Errorf("running locally is not supported for workloads with type: '%s'", o.wkldType)
+	}
+	if o.proxy && manifestinfo.IsTypeAJob(o.wkldType) {
+		return fmt.Errorf("--%s is not supported for jobs", proxyFlag)
+	}
+	if err := o.containerRunner.CheckDockerEngineRunning(); err != nil {
+		return fmt.Errorf("check if docker engine is running: %w", err)
+	}
+	taskDef, err := o.ecsLocalClient.TaskDefinition(o.appName, o.envName, o.wkldName)
+	if err != nil {
+		return fmt.Errorf("get task definition: %w", err)
+	}
+	secrets, err := o.ecsLocalClient.DecryptedSecrets(taskDef.Secrets())
+	if err != nil {
+		return fmt.Errorf("get secret values: %w", err)
+	}
+	envSess, err := o.envSession()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := o.newContext()
+	defer cancel()
+	containers, err := o.localContainers(ctx, taskDef, secrets, envSess)
+	if err != nil {
+		return err
+	}
+	if err := o.buildImages(ctx, containers); err != nil {
+		return err
+	}
+	if err := o.loginToRegistries(containers); err != nil {
+		return err
+	}
+
+	// Containers in a task share a network namespace, so they all join the network of a pause container
+	// that publishes their ports and can reach each other on localhost.
+	pause := &dockerengine.RunOptions{
+		ImageURI:       pauseContainerImage,
+		ContainerName:  o.containerName("pause"),
+		Detach:         true,
+		ContainerPorts: make(map[string]string),
+		Command:        []string{"sleep", "infinity"},
+	}
+	usedPorts := make(map[string]bool)
+	for _, def := range taskDef.ContainerDefinitions {
+		for _, mapping := range def.PortMappings {
+			port := strconv.FormatInt(aws.Int64Value(mapping.ContainerPort), 10)
+			pause.ContainerPorts[port] = port
+			usedPorts[port] = true
+		}
+	}
+	if o.proxy {
+		hosts, err := o.startProxy(taskDef, envSess, usedPorts)
+		if err != nil {
+			return err
+		}
+		pause.AddHosts = hosts
+	}
+	if err := o.containerRunner.Run(ctx, pause, io.Discard); err != nil {
+		return fmt.Errorf("start pause container: %w", err)
+	}
+	defer o.stopContainer(pause.ContainerName)
+
+	log.Infof("Running %s locally. Press %s to stop.\n", color.HighlightUserInput(o.wkldName), color.HighlightCode("Ctrl+C"))
+	return o.runContainers(ctx, containers)
+}
+
+func (o *runLocalOpts) envSession() (*session.Session, error) {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	sess, err := o.sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("get session for environment %s: %w", o.envName, err)
+	}
+	return sess, nil
+}
+
+// localContainers returns the containers of the task definition with the same environment variables and secrets
+// they have in the environment, along with temporary credentials of the environment.
+func (o *runLocalOpts) localContainers(ctx context.Context, taskDef *awsecs.TaskDefinition, secrets []ecs.EnvVar, envSess *session.Session) ([]*localContainer, error) {
+	creds, err := envSess.Config.Credentials.GetWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get temporary credentials for environment %s: %w", o.envName, err)
+	}
+	buildArgs, err := o.buildArgs()
+	if err != nil {
+		return nil, err
+	}
+	region := aws.StringValue(envSess.Config.Region)
+
+	var containers []*localContainer
+	for _, def := range taskDef.ContainerDefinitions {
+		name := aws.StringValue(def.Name)
+		if name == manifest.FirelensContainerName {
+			// Logs are streamed to the terminal instead of being routed by FireLens.
+			continue
+		}
+		c := &localContainer{
+			RunOptions: &dockerengine.RunOptions{
+				ImageURI:         aws.StringValue(def.Image),
+				ContainerName:    o.containerName(name),
+				ContainerNetwork: o.containerName("pause"),
+				EnvVars: map[string]string{
+					"AWS_REGION":         region,
+					"AWS_DEFAULT_REGION": region,
+				},
+				Secrets: map[string]string{
+					"AWS_ACCESS_KEY_ID":     creds.AccessKeyID,
+					"AWS_SECRET_ACCESS_KEY": creds.SecretAccessKey,
+				},
+				EntryPoint: aws.StringValueSlice(def.EntryPoint),
+				Command:    aws.StringValueSlice(def.Command),
+			},
+			name:      name,
+			essential: def.Essential == nil || aws.BoolValue(def.Essential),
+		}
+		if creds.SessionToken != "" {
+			c.Secrets["AWS_SESSION_TOKEN"] = creds.SessionToken
+		}
+		if args, ok := buildArgs[name]; ok {
+			c.build = args
+			c.ImageURI = fmt.Sprintf("%s:%s", args.URI, localImageTag)
+		}
+		containers = append(containers, c)
+	}
+	byName := make(map[string]*localContainer, len(containers))
+	for _, c := range containers {
+		byName[c.name] = c
+	}
+	for _, env := range taskDef.EnvironmentVariables() {
+		if c, ok := byName[env.Container]; ok {
+			c.EnvVars[env.Name] = env.Value
+		}
+	}
+	for _, secret := range secrets {
+		if c, ok := byName[secret.Container]; ok {
+			c.Secrets[secret.Name] = secret.Value
+		}
+	}
+	return containers, nil
+}
+
+// buildArgs returns the arguments to build the images of the containers that are built from a Dockerfile, keyed by container name.
+func (o *runLocalOpts) buildArgs() (map[string]*dockerengine.BuildArguments, error) {
+	mft, err := workloadManifest(&workloadManifestInput{
+		name:         o.wkldName,
+		appName:      o.appName,
+		envName:      o.envName,
+		ws:           o.ws,
+		interpolator: o.newInterpolator(o.appName, o.envName),
+		sess:         o.sess,
+		unmarshal:    o.unmarshal,
+		fs:           o.fs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	mf, ok := mft.Manifest().(interface {
+		BuildArgs(contextDir string) (map[string]*manifest.DockerBuildArgs, error)
+	})
+	if !ok {
+		return nil, nil
+	}
+	argsPerContainer, err := mf.BuildArgs(o.ws.Path())
+	if err != nil {
+		return nil, fmt.Errorf("check if manifest requires building from local Dockerfile: %w", err)
+	}
+	out := make(map[string]*dockerengine.BuildArguments, len(argsPerContainer))
+	for container, args := range argsPerContainer {
+		uri := fmt.Sprintf("%s/%s", o.appName, o.wkldName)
+		if container != o.wkldName {
+			uri = fmt.Sprintf("%s/%s", uri, container)
+		}
+		out[container] = &dockerengine.BuildArguments{
+			URI:        uri,
+			Tags:       []string{localImageTag},
+			Dockerfile: aws.StringValue(args.Dockerfile),
+			Context:    aws.StringValue(args.Context),
+			Target:     aws.StringValue(args.Target),
+			CacheFrom:  args.CacheFrom,
+			Args:       args.Args,
+		}
+	}
+	return out, nil
+}
+
+func (o *runLocalOpts) buildImages(ctx context.Context, containers []*localContainer) error {
+	for _, c := range containers {
+		if c.build == nil {
+			continue
+		}
+		log.Infof("Building the image of container %s.\n", color.HighlightUserInput(c.name))
+		if err := o.containerRunner.Build(ctx, c.build, o.prefixedWriter(c.name)); err != nil {
+			return fmt.Errorf("build image of container %s: %w", c.name, err)
+		}
+	}
+	return nil
+}
+
+// loginToRegistries logs in to the ECR registries of the images that aren't built locally.
+func (o *runLocalOpts) loginToRegistries(containers []*localContainer) error {
+	registries := make(map[string]bool)
+	for _, c := range containers {
+		if c.build != nil || !strings.Contains(c.ImageURI, ".dkr.ecr.") {
+			continue
+		}
+		registries[strings.Split(c.ImageURI, "/")[0]] = true
+	}
+	if len(registries) == 0 {
+		return nil
+	}
+	username, password, err := o.newRegistryAuthenticator(o.sess).Auth()
+	if err != nil {
+		return fmt.Errorf("get ECR auth data: %w", err)
+	}
+	for _, registry := range sortedBoolKeys(registries) {
+		if err := o.containerRunner.Login(registry, username, password); err != nil {
+			return fmt.Errorf("login to registry %s: %w", registry, err)
+		}
+	}
+	return nil
+}
+
+// startProxy forwards the port of every other service deployed in the environment to the host through
+// a running task of the workload, and returns the hosts entries that point the services' names to the host.
+func (o *runLocalOpts) startProxy(taskDef *awsecs.TaskDefinition, envSess *session.Session, usedPorts map[string]bool) (map[string]string, error) {
+	var sdEndpoint string
+	for _, env := range taskDef.EnvironmentVariables() {
+		if env.Name == "COPILOT_SERVICE_DISCOVERY_ENDPOINT" {
+			sdEndpoint = env.Value
+		}
+	}
+	svcDesc, err := o.newSvcDescriber(envSess).DescribeService(o.appName, o.envName, o.wkldName)
+	if err != nil {
+		return nil, fmt.Errorf("describe ECS service for %s in environment %s: %w", o.wkldName, o.envName, err)
+	}
+	tasks := awsecs.FilterRunningTasks(svcDesc.Tasks)
+	if len(tasks) == 0 {
+		return nil, fmt.Errorf("no running tasks of %s in environment %s to proxy traffic through", o.wkldName, o.envName)
+	}
+	target, err := tasks[0].SessionTarget(o.wkldName)
+	if err != nil {
+		return nil, fmt.Errorf("get session target: %w", err)
+	}
+	peers, err := o.deployStore.ListDeployedServices(o.appName, o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("list services deployed in environment %s: %w", o.envName, err)
+	}
+	hosts := make(map[string]string)
+	forwarder := o.newPortForwarder(envSess)
+	for _, peer := range peers {
+		if peer == o.wkldName {
+			continue
+		}
+		peerTaskDef, err := o.ecsLocalClient.TaskDefinition(o.appName, o.envName, peer)
+		if err != nil {
+			return nil, fmt.Errorf("get task definition of service %s: %w", peer, err)
+		}
+		port := mainContainerPort(peerTaskDef, peer)
+		if port == "" {
+			continue
+		}
+		if usedPorts[port] {
+			log.Warningf("Skip proxying traffic to %s: port %s is already in use.\n", peer, port)
+			continue
+		}
+		usedPorts[port] = true
+		hosts[peer] = proxyHostGateway
+		remoteHost := peer
+		if sdEndpoint != "" {
+			remoteHost = fmt.Sprintf("%s.%s", peer, sdEndpoint)
+			hosts[remoteHost] = proxyHostGateway
+		}
+		log.Infof("Proxy traffic to %s through task %s.\n", color.HighlightUserInput(fmt.Sprintf("%s:%s", peer, port)), color.HighlightResource(aws.StringValue(tasks[0].TaskArn)))
+		go func(peer string, in ssm.PortForwardInput) {
+			if err := forwarder.StartPortForwardingSession(in); err != nil {
+				log.Warningf("Stopped proxying traffic to %s: %v\n", peer, err)
+			}
+		}(peer, ssm.PortForwardInput{
+			Target:     target,
+			LocalPort:  port,
+			RemotePort: port,
+			RemoteHost: remoteHost,
+		})
+	}
+	return hosts, nil
+}
+
+// mainContainerPort returns the first port exposed by the main container of a task definition, or empty if there is none.
+func mainContainerPort(taskDef *awsecs.TaskDefinition, container string) string {
+	for _, def := range taskDef.ContainerDefinitions {
+		if aws.StringValue(def.Name) != container || len(def.PortMappings) == 0 {
+			continue
+		}
+		return strconv.FormatInt(aws.Int64Value(def.PortMappings[0].ContainerPort), 10)
+	}
+	return ""
+}
+
+// runContainers runs the containers until an essential container exits or the context is canceled.
+// With --watch, the images are rebuilt and the containers restarted whenever files in the workspace change.
+func (o *runLocalOpts) runContainers(ctx context.Context, containers []*localContainer) error {
+	for {
+		runCtx, cancelRun := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		exits := make(chan containerExit, len(containers))
+		for _, c := range containers {
+			wg.Add(1)
+			go func(c *localContainer) {
+				defer wg.Done()
+				exits <- containerExit{
+					name: c.name,
+					err:  o.containerRunner.Run(runCtx, c.RunOptions, o.prefixedWriter(c.name)),
+				}
+			}(c)
+		}
+		restart, err := o.waitForContainers(ctx, containers, exits)
+		for _, c := range containers {
+			o.stopContainer(c.ContainerName)
+		}
+		cancelRun()
+		wg.Wait()
+		if !restart {
+			return err
+		}
+		log.Infoln("Restarting the containers.")
+	}
+}
+
+// waitForContainers blocks until the containers should be stopped, and returns true if they should be restarted.
+func (o *runLocalOpts) waitForContainers(ctx context.Context, containers []*localContainer, exits <-chan containerExit) (bool, error) {
+	essential := make(map[string]bool, len(containers))
+	for _, c := range containers {
+		essential[c.name] = c.essential
+	}
+	var tick <-chan time.Time
+	var lastModTime time.Time
+	if o.watch {
+		modTime, err := latestModTime(o.fs, o.ws.Path())
+		if err != nil {
+			return false, fmt.Errorf("check for file changes: %w", err)
+		}
+		lastModTime = modTime
+		ticker := time.NewTicker(o.watchInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return false, nil
+		case exit := <-exits:
+			if ctx.Err() != nil {
+				return false, nil
+			}
+			if !essential[exit.name] {
+				log.Warningf("Container %s exited: %v\n", exit.name, exit.err)
+				continue
+			}
+			if exit.err != nil {
+				return false, fmt.Errorf("essential container %s exited: %w", exit.name, exit.err)
+			}
+			log.Infof("Essential container %s exited.\n", exit.name)
+			return false, nil
+		case <-tick:
+			modTime, err := latestModTime(o.fs, o.ws.Path())
+			if err != nil {
+				log.Warningf("Failed to check for file changes: %v\n", err)
+				continue
+			}
+			if !modTime.After(lastModTime) {
+				continue
+			}
+			lastModTime = modTime
+			log.Infoln("Detected file changes, rebuilding the images.")
+			if err := o.buildImages(ctx, containers); err != nil {
+				log.Errorf("Failed to rebuild the images, the previous containers keep running: %v\n", err)
+				continue
+			}
+			return true, nil
+		}
+	}
+}
+
+func (o *runLocalOpts) stopContainer(name string) {
+	if err := o.containerRunner.Stop(context.Background(), name); err != nil {
+		log.Warningf("Failed to stop container: %v\n", err)
+	}
+}
+
+func (o *runLocalOpts) containerName(container string) string {
+	return fmt.Sprintf("%s-%s-%s-%s", o.appName, o.envName, o.wkldName, container)
+}
+
+func (o *runLocalOpts) prefixedWriter(container string) io.Writer {
+	return &prefixedWriter{
+		mu:     &o.outMu,
+		w:      o.out,
+		prefix: fmt.Sprintf("[%s] ", container),
+	}
+}
+
+// prefixedWriter writes each line of output prefixed with the name of the container that produced it.
+// Writers sharing a mutex never interleave their lines.
+type prefixedWriter struct {
+	mu      *sync.Mutex
+	w       io.Writer
+	prefix  string
+	partial []byte
+}
+
+// Write buffers incomplete lines until their newline is written.
+func (p *prefixedWriter) Write(b []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.partial = append(p.partial, b...)
+	for {
+		i := bytes.IndexByte(p.partial, '\n')
+		if i < 0 {
+			return len(b), nil
+		}
+		if _, err := fmt.Fprintf(p.w, "%s%s", p.prefix, p.partial[:i+1]); err != nil {
+			return 0, err
+		}
+		p.partial = p.partial[i+1:]
+	}
+}
+
+// latestModTime returns the most recent modification time of the files under root, skipping hidden directories.
+func latestModTime(fs afero.Fs, root string) (time.Time, error) {
+	var latest time.Time
+	err := afero.Walk(fs, root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && path != root && strings.HasPrefix(info.Name(), ".") {
+			return filepath.SkipDir
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+		return nil
+	})
+	return latest, err
+}
+
+func sortedBoolKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// buildRunLocalCmd builds the command for running a workload locally.
+func buildRunLocalCmd() *cobra.Command {
+	vars := runLocalVars{}
+	cmd := &cobra.Command{
+		Use:   "local",
+		Short: "Run a workload's containers locally.",
+		Long: `Run a workload's containers locally.
+The containers get the same environment variables and secrets as in the environment,
+along with temporary credentials of the environment.`,
+		Example: `
+  Run the "api" service with the configuration of the "test" environment.
+  /code $ copilot run local -n api -e test
+  Rebuild and restart the containers whenever a file changes.
+  /code $ copilot run local -n api -e test --watch
+  Reach the other services of the environment by their service discovery names.
+  /code $ copilot run local -n api -e test --proxy`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRunLocalOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.wkldName, nameFlag, nameFlagShort, "", workloadFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.watch, watchFlag, false, runLocalWatchFlagDescription)
+	cmd.Flags().BoolVar(&vars.proxy, proxyFlag, false, runLocalProxyFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,634 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+var testError = errors.New("some error")
+
+type runLocalAskMocks struct {
+	store *mocks.Mockstore
+	sel   *mocks.MockdeploySelector
+}
+
+func TestRunLocalOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputAppName  string
+		setupMocks    func(m *runLocalAskMocks)
+		wantedAppName string
+		wantedError   error
+	}{
+		"no app in workspace": {
+			wantedError: errNoAppInWorkspace,
+		},
+		"fail to read the application from SSM store": {
+			inputAppName: "testApp",
+			setupMocks: func(m *runLocalAskMocks) {
+				m.store.EXPECT().GetApplication("testApp").Return(nil, testError)
+			},
+			wantedError: fmt.Errorf("get application testApp: %w", testError),
+		},
+		"successful validation": {
+			inputAppName: "testApp",
+			setupMocks: func(m *runLocalAskMocks) {
+				m.store.EXPECT().GetApplication("testApp").Return(&config.Application{Name: "testApp"}, nil)
+			},
+			wantedAppName: "testApp",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &runLocalAskMocks{
+				store: mocks.NewMockstore(ctrl),
+			}
+			if tc.setupMocks != nil {
+				tc.setupMocks(m)
+			}
+			opts := runLocalOpts{
+				runLocalVars: runLocalVars{
+					appName: tc.inputAppName,
+				},
+				store: m.store,
+			}
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRunLocalOpts_Ask(t *testing.T) {
+	const (
+		testAppName  = "testApp"
+		testEnvName  = "testEnv"
+		testWkldName = "testWkld"
+		testWkldType = "testWkldType"
+	)
+	testCases := map[string]struct {
+		inputAppName  string
+		inputEnvName  string
+		inputWkldName string
+
+		setupMocks     func(m *runLocalAskMocks)
+		wantedWkldName string
+		wantedEnvName  string
+		wantedWkldType string
+		wantedError    error
+	}{
+		"error if provided environment is not present in the workspace": {
+			inputAppName: testAppName,
+			inputEnvName: testEnvName,
+			setupMocks: func(m *runLocalAskMocks) {
+				m.store.EXPECT().GetEnvironment(testAppName, testEnvName).Return(nil, testError)
+			},
+			wantedError: testError,
+		},
+		"error if provided workload is not present in the workspace": {
+			inputAppName:  testAppName,
+			inputWkldName: testWkldName,
+			setupMocks: func(m *runLocalAskMocks) {
+				m.store.EXPECT().GetEnvironment(gomock.Any(), gomock.Any()).Times(0)
+				m.store.EXPECT().GetWorkload(testAppName, testWkldName).Return(nil, testError)
+			},
+			wantedError: testError,
+		},
+		"successfully validate env and svc with flags passed in": {
+			inputAppName:  testAppName,
+			inputWkldName: testWkldName,
+			inputEnvName:  testEnvName,
+			setupMocks: func(m *runLocalAskMocks) {
+				m.store.EXPECT().GetEnvironment(testAppName, testEnvName).Return(&config.Environment{Name: "testEnv"}, nil)
+				m.store.EXPECT().GetWorkload(testAppName, testWkldName).Return(&config.Workload{Name: "testWkld"}, nil)
+				m.sel.EXPECT().DeployedWorkload(workloadAskPrompt, "", testAppName, gomock.Any()).Return(&selector.DeployedWorkload{
+					Env:  "testEnv",
+					Name: "testWkld",
+					Type: "testWkldType",
+				}, nil)
+			},
+			wantedEnvName:  testEnvName,
+			wantedWkldName: testWkldName,
+			wantedWkldType: testWkldType,
+		},
+		"prompt for workload and environment": {
+			inputAppName: testAppName,
+			setupMocks: func(m *runLocalAskMocks) {
+				m.store.EXPECT().GetEnvironment(gomock.Any(), gomock.Any()).Times(0)
+				m.store.EXPECT().GetWorkload(gomock.Any(), gomock.Any()).Times(0)
+				m.sel.EXPECT().DeployedWorkload(workloadAskPrompt, "", testAppName, gomock.Any()).Return(&selector.DeployedWorkload{
+					Env:  "testEnv",
+					Name: "testWkld",
+					Type: "testWkldType",
+				}, nil)
+			},
+			wantedEnvName:  testEnvName,
+			wantedWkldName: testWkldName,
+			wantedWkldType: testWkldType,
+		},
+		"return error while failed to select workload": {
+			inputAppName: testAppName,
+			setupMocks: func(m *runLocalAskMocks) {
+				m.sel.EXPECT().DeployedWorkload(workloadAskPrompt, "", testAppName, gomock.Any()).
+					Return(nil, testError)
+			},
+			wantedError: fmt.Errorf("select a deployed workload from application %s: %w", testAppName, testError),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &runLocalAskMocks{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockdeploySelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := runLocalOpts{
+				runLocalVars: runLocalVars{
+					appName:  tc.inputAppName,
+					wkldName: tc.inputWkldName,
+					envName:  tc.inputEnvName,
+				},
+				store: m.store,
+				sel:   m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedWkldName, opts.wkldName)
+				require.Equal(t, tc.wantedEnvName, opts.envName)
+			} else {
+				require.EqualError(t, err, tc.wantedError.Error())
+			}
+		})
+	}
+}
+
+type runLocalExecuteMocks struct {
+	ecsLocalClient  *mocks.MockecsLocalClient
+	containerRunner *mocks.MockcontainerRunner
+	store           *mocks.Mockstore
+	sessProvider    *mocks.MocksessionProvider
+	ws              *mocks.MockwsWlDirReader
+	interpolator    *mocks.Mockinterpolator
+	registryAuth    *mocks.MockregistryAuthenticator
+	svcDescriber    *mocks.MockserviceDescriber
+	deployStore     *mocks.MockdeployedEnvironmentLister
+	portForwarder   *mocks.MockportForwarder
+}
+
+type mockRunLocalMft struct {
+	mockWorkloadMft
+	buildArgs map[string]*manifest.DockerBuildArgs
+}
+
+func (m *mockRunLocalMft) ApplyEnv(envName string) (manifest.DynamicWorkload, error) {
+	return m, nil
+}
+
+func (m *mockRunLocalMft) Manifest() interface{} {
+	return m
+}
+
+func (m *mockRunLocalMft) BuildArgs(contextDir string) (map[string]*manifest.DockerBuildArgs, error) {
+	return m.buildArgs, nil
+}
+
+func TestRunLocalOpts_Execute(t *testing.T) {
+	const (
+		testAppName  = "testApp"
+		testEnvName  = "testEnv"
+		testWkldName = "testWkld"
+		testRegistry = "123456789012.dkr.ecr.us-west-2.amazonaws.com"
+	)
+	var taskDefinition = &awsecs.TaskDefinition{
+		ContainerDefinitions: []*ecsapi.ContainerDefinition{
+			{
+				Name:  aws.String("testWkld"),
+				Image: aws.String(testRegistry + "/testapp/testwkld:latest"),
+				Environment: []*ecsapi.KeyValuePair{
+					{
+						Name:  aws.String("COPILOT_SERVICE_NAME"),
+						Value: aws.String("testWkld"),
+					},
+					{
+						Name:  aws.String("COPILOT_SERVICE_DISCOVERY_ENDPOINT"),
+						Value: aws.String("testEnv.testApp.local"),
+					},
+				},
+				PortMappings: []*ecsapi.PortMapping{
+					{
+						ContainerPort: aws.Int64(8080),
+					},
+				},
+				Command: aws.StringSlice([]string{"serve"}),
+			},
+			{
+				Name:      aws.String("nginx"),
+				Image:     aws.String(testRegistry + "/nginx:latest"),
+				Essential: aws.Bool(false),
+				PortMappings: []*ecsapi.PortMapping{
+					{
+						ContainerPort: aws.Int64(80),
+					},
+				},
+			},
+			{
+				Name:  aws.String("firelens_log_router"),
+				Image: aws.String("public.ecr.aws/aws-observability/aws-for-fluent-bit:stable"),
+			},
+		},
+	}
+	var peerTaskDefinition = &awsecs.TaskDefinition{
+		ContainerDefinitions: []*ecsapi.ContainerDefinition{
+			{
+				Name: aws.String("db"),
+				PortMappings: []*ecsapi.PortMapping{
+					{
+						ContainerPort: aws.Int64(5432),
+					},
+				},
+			},
+		},
+	}
+	envSess := &session.Session{
+		Config: &aws.Config{
+			Region:      aws.String("us-west-2"),
+			Credentials: credentials.NewStaticCredentials("ACCESSKEY", "SECRETKEY", "TOKEN"),
+		},
+	}
+	wantedPause := func(hosts map[string]string) *dockerengine.RunOptions {
+		return &dockerengine.RunOptions{
+			ImageURI:      pauseContainerImage,
+			ContainerName: "testApp-testEnv-testWkld-pause",
+			Detach:        true,
+			ContainerPorts: map[string]string{
+				"8080": "8080",
+				"80":   "80",
+			},
+			AddHosts: hosts,
+			Command:  []string{"sleep", "infinity"},
+		}
+	}
+	wantedMain := &dockerengine.RunOptions{
+		ImageURI:         "testApp/testWkld:local",
+		ContainerName:    "testApp-testEnv-testWkld-testWkld",
+		ContainerNetwork: "testApp-testEnv-testWkld-pause",
+		EnvVars: map[string]string{
+			"AWS_REGION":                         "us-west-2",
+			"AWS_DEFAULT_REGION":                 "us-west-2",
+			"COPILOT_SERVICE_NAME":               "testWkld",
+			"COPILOT_SERVICE_DISCOVERY_ENDPOINT": "testEnv.testApp.local",
+		},
+		Secrets: map[string]string{
+			"AWS_ACCESS_KEY_ID":     "ACCESSKEY",
+			"AWS_SECRET_ACCESS_KEY": "SECRETKEY",
+			"AWS_SESSION_TOKEN":     "TOKEN",
+			"my-secret":             "Password123",
+		},
+		EntryPoint: []string{},
+		Command:    []string{"serve"},
+	}
+	wantedSidecar := &dockerengine.RunOptions{
+		ImageURI:         testRegistry + "/nginx:latest",
+		ContainerName:    "testApp-testEnv-testWkld-nginx",
+		ContainerNetwork: "testApp-testEnv-testWkld-pause",
+		EnvVars: map[string]string{
+			"AWS_REGION":         "us-west-2",
+			"AWS_DEFAULT_REGION": "us-west-2",
+		},
+		Secrets: map[string]string{
+			"AWS_ACCESS_KEY_ID":     "ACCESSKEY",
+			"AWS_SECRET_ACCESS_KEY": "SECRETKEY",
+			"AWS_SESSION_TOKEN":     "TOKEN",
+		},
+		EntryPoint: []string{},
+		Command:    []string{},
+	}
+	wantedBuild := &dockerengine.BuildArguments{
+		URI:        "testApp/testWkld",
+		Tags:       []string{"local"},
+		Dockerfile: "/ws/testWkld/Dockerfile",
+		Context:    "/ws/testWkld",
+	}
+	setupManifest := func(m *runLocalExecuteMocks) {
+		m.store.EXPECT().GetEnvironment(testAppName, testEnvName).Return(&config.Environment{
+			Name:           testEnvName,
+			Region:         "us-west-2",
+			ManagerRoleARN: "arn:aws:iam::123456789012:role/manager",
+		}, nil)
+		m.sessProvider.EXPECT().FromRole("arn:aws:iam::123456789012:role/manager", "us-west-2").Return(envSess, nil)
+		m.ws.EXPECT().ReadWorkloadManifest(testWkldName).Return([]byte("name: testWkld"), nil)
+		m.interpolator.EXPECT().Interpolate("name: testWkld").Return("name: testWkld", nil)
+		m.ws.EXPECT().Path().Return("/ws").AnyTimes()
+	}
+	setupRun := func(m *runLocalExecuteMocks) {
+		m.ecsLocalClient.EXPECT().TaskDefinition(testAppName, testEnvName, testWkldName).Return(taskDefinition, nil)
+		m.ecsLocalClient.EXPECT().DecryptedSecrets(gomock.Any()).Return([]ecs.EnvVar{{
+			Name:      "my-secret",
+			Container: "testWkld",
+			Value:     "Password123",
+		}}, nil)
+		setupManifest(m)
+		m.containerRunner.EXPECT().Build(gomock.Any(), wantedBuild, gomock.Any()).Return(nil)
+		m.registryAuth.EXPECT().Auth().Return("AWS", "password", nil)
+		m.containerRunner.EXPECT().Login(testRegistry, "AWS", "password").Return(nil)
+	}
+	testCases := map[string]struct {
+		inputWkldType string
+		inputProxy    bool
+
+		setupMocks  func(m *runLocalExecuteMocks)
+		wantedError error
+	}{
+		"error if the workload type is not supported": {
+			inputWkldType: manifestinfo.RequestDrivenWebServiceType,
+			setupMocks:    func(m *runLocalExecuteMocks) {},
+			wantedError:   errors.New("running locally is not supported for workloads with type: 'Request-Driven Web Service'"),
+		},
+		"error if proxying for a job": {
+			inputWkldType: manifestinfo.ScheduledJobType,
+			inputProxy:    true,
+			setupMocks:    func(m *runLocalExecuteMocks) {},
+			wantedError:   errors.New("--proxy is not supported for jobs"),
+		},
+		"error if docker engine is not running": {
+			inputWkldType: manifestinfo.BackendServiceType,
+			setupMocks: func(m *runLocalExecuteMocks) {
+				m.containerRunner.EXPECT().CheckDockerEngineRunning().Return(testError)
+			},
+			wantedError: fmt.Errorf("check if docker engine is running: %w", testError),
+		},
+		"error getting the task Definition": {
+			inputWkldType: manifestinfo.BackendServiceType,
+			setupMocks: func(m *runLocalExecuteMocks) {
+				m.containerRunner.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ecsLocalClient.EXPECT().TaskDefinition(testAppName, testEnvName, testWkldName).Return(nil, testError)
+			},
+			wantedError: fmt.Errorf("get task definition: %w", testError),
+		},
+		"error decryting secrets from task definition": {
+			inputWkldType: manifestinfo.BackendServiceType,
+			setupMocks: func(m *runLocalExecuteMocks) {
+				m.containerRunner.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ecsLocalClient.EXPECT().TaskDefinition(testAppName, testEnvName, testWkldName).Return(taskDefinition, nil)
+				m.ecsLocalClient.EXPECT().DecryptedSecrets(gomock.Any()).Return(nil, testError)
+			},
+			wantedError: fmt.Errorf("get secret values: %w", testError),
+		},
+		"error getting the environment": {
+			inputWkldType: manifestinfo.BackendServiceType,
+			setupMocks: func(m *runLocalExecuteMocks) {
+				m.containerRunner.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ecsLocalClient.EXPECT().TaskDefinition(testAppName, testEnvName, testWkldName).Return(taskDefinition, nil)
+				m.ecsLocalClient.EXPECT().DecryptedSecrets(gomock.Any()).Return(nil, nil)
+				m.store.EXPECT().GetEnvironment(testAppName, testEnvName).Return(nil, testError)
+			},
+			wantedError: fmt.Errorf("get environment testEnv: %w", testError),
+		},
+		"error building an image": {
+			inputWkldType: manifestinfo.BackendServiceType,
+			setupMocks: func(m *runLocalExecuteMocks) {
+				m.containerRunner.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ecsLocalClient.EXPECT().TaskDefinition(testAppName, testEnvName, testWkldName).Return(taskDefinition, nil)
+				m.ecsLocalClient.EXPECT().DecryptedSecrets(gomock.Any()).Return(nil, nil)
+				setupManifest(m)
+				m.containerRunner.EXPECT().Build(gomock.Any(), wantedBuild, gomock.Any()).Return(testError)
+			},
+			wantedError: fmt.Errorf("build image of container testWkld: %w", testError),
+		},
+		"error if the essential container fails": {
+			inputWkldType: manifestinfo.BackendServiceType,
+			setupMocks: func(m *runLocalExecuteMocks) {
+				m.containerRunner.EXPECT().CheckDockerEngineRunning().Return(nil)
+				setupRun(m)
+				m.containerRunner.EXPECT().Run(gomock.Any(), wantedPause(nil), gomock.Any()).Return(nil)
+				m.containerRunner.EXPECT().Run(gomock.Any(), wantedMain, gomock.Any()).Return(testError)
+				m.containerRunner.EXPECT().Run(gomock.Any(), wantedSidecar, gomock.Any()).Return(nil)
+				m.containerRunner.EXPECT().Stop(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+			},
+			wantedError: fmt.Errorf("essential container testWkld exited: %w", testError),
+		},
+		"run the containers until the essential container exits": {
+			inputWkldType: manifestinfo.BackendServiceType,
+			setupMocks: func(m *runLocalExecuteMocks) {
+				m.containerRunner.EXPECT().CheckDockerEngineRunning().Return(nil)
+				setupRun(m)
+				m.containerRunner.EXPECT().Run(gomock.Any(), wantedPause(nil), gomock.Any()).Return(nil)
+				m.containerRunner.EXPECT().Run(gomock.Any(), wantedMain, gomock.Any()).Return(nil)
+				m.containerRunner.EXPECT().Run(gomock.Any(), wantedSidecar, gomock.Any()).Return(testError)
+				m.containerRunner.EXPECT().Stop(gomock.Any(), "testApp-testEnv-testWkld-testWkld").Return(nil)
+				m.containerRunner.EXPECT().Stop(gomock.Any(), "testApp-testEnv-testWkld-nginx").Return(nil)
+				m.containerRunner.EXPECT().Stop(gomock.Any(), "testApp-testEnv-testWkld-pause").Return(nil)
+			},
+		},
+		"error if there are no running tasks to proxy through": {
+			inputWkldType: manifestinfo.BackendServiceType,
+			inputProxy:    true,
+			setupMocks: func(m *runLocalExecuteMocks) {
+				m.containerRunner.EXPECT().CheckDockerEngineRunning().Return(nil)
+				setupRun(m)
+				m.svcDescriber.EXPECT().DescribeService(testAppName, testEnvName, testWkldName).Return(&ecs.ServiceDesc{}, nil)
+			},
+			wantedError: errors.New("no running tasks of testWkld in environment testEnv to proxy traffic through"),
+		},
+		"proxy traffic to the other services in the environment": {
+			inputWkldType: manifestinfo.BackendServiceType,
+			inputProxy:    true,
+			setupMocks: func(m *runLocalExecuteMocks) {
+				m.containerRunner.EXPECT().CheckDockerEngineRunning().Return(nil)
+				setupRun(m)
+				m.svcDescriber.EXPECT().DescribeService(testAppName, testEnvName, testWkldName).Return(&ecs.ServiceDesc{
+					ClusterName: "cluster",
+					Tasks: []*awsecs.Task{
+						{
+							TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789012:task/cluster/4082490ee6c245e09d2145010aa1ba8d"),
+							ClusterArn: aws.String("arn:aws:ecs:us-west-2:123456789012:cluster/cluster"),
+							LastStatus: aws.String("RUNNING"),
+							Containers: []*ecsapi.Container{
+								{
+									Name:      aws.String("testWkld"),
+									RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-2179454625"),
+								},
+							},
+						},
+					},
+				}, nil)
+				m.deployStore.EXPECT().ListDeployedServices(testAppName, testEnvName).Return([]string{"db", "testWkld", "web"}, nil)
+				m.ecsLocalClient.EXPECT().TaskDefinition(testAppName, testEnvName, "db").Return(peerTaskDefinition, nil)
+				m.ecsLocalClient.EXPECT().TaskDefinition(testAppName, testEnvName, "web").Return(taskDefinition, nil)
+				m.portForwarder.EXPECT().StartPortForwardingSession(ssm.PortForwardInput{
+					Target:     "ecs:cluster_4082490ee6c245e09d2145010aa1ba8d_4082490ee6c245e09d2145010aa1ba8d-2179454625",
+					LocalPort:  "5432",
+					RemotePort: "5432",
+					RemoteHost: "db.testEnv.testApp.local",
+				}).Return(nil).MaxTimes(1)
+				m.containerRunner.EXPECT().Run(gomock.Any(), wantedPause(map[string]string{
+					"db":                       "host-gateway",
+					"db.testEnv.testApp.local": "host-gateway",
+				}), gomock.Any()).Return(nil)
+				m.containerRunner.EXPECT().Run(gomock.Any(), wantedMain, gomock.Any()).Return(nil)
+				m.containerRunner.EXPECT().Run(gomock.Any(), wantedSidecar, gomock.Any()).Return(nil)
+				m.containerRunner.EXPECT().Stop(gomock.Any(), gomock.Any()).Return(nil).Times(3)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &runLocalExecuteMocks{
+				ecsLocalClient:  mocks.NewMockecsLocalClient(ctrl),
+				containerRunner: mocks.NewMockcontainerRunner(ctrl),
+				store:           mocks.NewMockstore(ctrl),
+				sessProvider:    mocks.NewMocksessionProvider(ctrl),
+				ws:              mocks.NewMockwsWlDirReader(ctrl),
+				interpolator:    mocks.NewMockinterpolator(ctrl),
+				registryAuth:    mocks.NewMockregistryAuthenticator(ctrl),
+				svcDescriber:    mocks.NewMockserviceDescriber(ctrl),
+				deployStore:     mocks.NewMockdeployedEnvironmentLister(ctrl),
+				portForwarder:   mocks.NewMockportForwarder(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := runLocalOpts{
+				runLocalVars: runLocalVars{
+					appName:  testAppName,
+					wkldName: testWkldName,
+					envName:  testEnvName,
+					wkldType: tc.inputWkldType,
+					proxy:    tc.inputProxy,
+				},
+				ecsLocalClient:  m.ecsLocalClient,
+				containerRunner: m.containerRunner,
+				store:           m.store,
+				sessProvider:    m.sessProvider,
+				deployStore:     m.deployStore,
+				ws:              m.ws,
+				newInterpolator: func(app, env string) interpolator {
+					return m.interpolator
+				},
+				unmarshal: func(b []byte) (manifest.DynamicWorkload, error) {
+					return &mockRunLocalMft{
+						buildArgs: map[string]*manifest.DockerBuildArgs{
+							"testWkld": {
+								Dockerfile: aws.String("/ws/testWkld/Dockerfile"),
+								Context:    aws.String("/ws/testWkld"),
+							},
+						},
+					}, nil
+				},
+				newRegistryAuthenticator: func(*session.Session) registryAuthenticator {
+					return m.registryAuth
+				},
+				newSvcDescriber: func(*session.Session) serviceDescriber {
+					return m.svcDescriber
+				},
+				newPortForwarder: func(*session.Session) portForwarder {
+					return m.portForwarder
+				},
+				newContext: func() (context.Context, context.CancelFunc) {
+					return context.WithCancel(context.Background())
+				},
+				out: io.Discard,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.wantedError.Error())
+			}
+		})
+	}
+}
+
+func TestPrefixedWriter_Write(t *testing.T) {
+	// GIVEN
+	buf := &bytes.Buffer{}
+	mu := &sync.Mutex{}
+	api := &prefixedWriter{mu: mu, w: buf, prefix: "[api] "}
+	nginx := &prefixedWriter{mu: mu, w: buf, prefix: "[nginx] "}
+
+	// WHEN
+	_, err := api.Write([]byte("listening on"))
+	require.NoError(t, err)
+	_, err = nginx.Write([]byte("started\nready\n"))
+	require.NoError(t, err)
+	_, err = api.Write([]byte(" :8080\n"))
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, "[nginx] started\n[nginx] ready\n[api] listening on :8080\n", buf.String())
+}
+
+func TestLatestModTime(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	older := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	hidden := newer.Add(time.Hour)
+	require.NoError(t, afero.WriteFile(fs, "/ws/api/main.go", []byte("package main"), 0644))
+	require.NoError(t, afero.WriteFile(fs, "/ws/api/Dockerfile", []byte("FROM scratch"), 0644))
+	require.NoError(t, afero.WriteFile(fs, "/ws/.git/index", []byte(""), 0644))
+	for path, modTime := range map[string]time.Time{
+		"/ws":                older,
+		"/ws/api":            older,
+		"/ws/.git":           older,
+		"/ws/api/Dockerfile": older,
+		"/ws/api/main.go":    newer,
+		"/ws/.git/index":     hidden,
+	} {
+		require.NoError(t, fs.Chtimes(path, modTime, modTime))
+	}
+
+	// WHEN
+	got, err := latestModTime(fs, "/ws")
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, newer, got)
+}
@@ -4,11 +4,34 @@
 package cli
 
 import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
 	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/spf13/cobra"
 )
 
+const (
+	secretSourceParameterStore = "Parameter Store"
+	secretSourceSecretsManager = "Secrets Manager"
+)
+
+const (
+	secretTableMinCellWidth     = 20  // minimum number of characters in a table's cell.
+	secretTableTabWidth         = 4   // number of characters in between columns.
+	secretTableCellPaddingWidth = 2   // number of padding characters added by default to a cell.
+	secretTablePaddingChar      = ' ' // character in between columns.
+)
+
 // BuildSecretCmd is the top level command for secret.
 func BuildSecretCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -18,6 +41,10 @@ Secrets are sensitive information that you need in your application.`,
 	}
 
 	cmd.AddCommand(buildSecretInitCmd())
+	cmd.AddCommand(buildSecretListCmd())
+	cmd.AddCommand(buildSecretShowCmd())
+	cmd.AddCommand(buildSecretPutCmd())
+	cmd.AddCommand(buildSecretDeleteCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 	cmd.Annotations = map[string]string{
@@ -25,3 +52,139 @@ Secrets are sensitive information that you need in your application.`,
 	}
 	return cmd
 }
+
+// envSecret is a secret tagged for an environment of an application.
+type envSecret struct {
+	Name         string    `json:"name"`
+	Environment  string    `json:"environment"`
+	Source       string    `json:"source"`
+	ID           string    `json:"id"` // Name of the parameter or ARN of the secret.
+	Version      int64     `json:"version,omitempty"`
+	LastModified time.Time `json:"lastModified"`
+	Value        *string   `json:"value,omitempty"`
+}
+
+// envSecretClients holds the clients to manage the secrets of an environment.
+type envSecretClients struct {
+	params  parameterSecretsClient
+	secrets secretsManagerSecretsClient
+}
+
+type newEnvSecretClientsFunc func(env *config.Environment) (*envSecretClients, error)
+
+// newEnvSecretClients returns a function that creates the clients with the environment manager role.
+func newEnvSecretClients(sessProvider sessionFromRoleProvider) newEnvSecretClientsFunc {
+	return func(env *config.Environment) (*envSecretClients, error) {
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		return &envSecretClients{
+			params:  ssm.New(sess),
+			secrets: secretsmanager.New(sess),
+		}, nil
+	}
+}
+
+// envsInApp returns the environment named envName, or all the environments of the application if envName is empty.
+func envsInApp(store store, appName, envName string) ([]*config.Environment, error) {
+	if envName != "" {
+		env, err := store.GetEnvironment(appName, envName)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s in application %s: %w", envName, appName, err)
+		}
+		return []*config.Environment{env}, nil
+	}
+	envs, err := store.ListEnvironments(appName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", appName, err)
+	}
+	return envs, nil
+}
+
+// listSecretsInEnvs returns the secrets tagged for each of the environments sorted by name,
+// along with the clients of each environment.
+func listSecretsInEnvs(appName string, envs []*config.Environment, newClients newEnvSecretClientsFunc) ([]*envSecret, map[string]*envSecretClients, error) {
+	var secrets []*envSecret
+	clients := make(map[string]*envSecretClients, len(envs))
+	for _, env := range envs {
+		c, err := newClients(env)
+		if err != nil {
+			return nil, nil, err
+		}
+		clients[env.Name] = c
+		envSecrets, err := listEnvSecrets(appName, env.Name, c)
+		if err != nil {
+			return nil, nil, err
+		}
+		secrets = append(secrets, envSecrets...)
+	}
+	sort.SliceStable(secrets, func(i, j int) bool {
+		return secrets[i].Name < secrets[j].Name
+	})
+	return secrets, clients, nil
+}
+
+func listEnvSecrets(appName, envName string, clients *envSecretClients) ([]*envSecret, error) {
+	tags := map[string]string{
+		deploy.AppTagKey: appName,
+		deploy.EnvTagKey: envName,
+	}
+	params, err := clients.params.ListSecrets(tags)
+	if err != nil {
+		return nil, fmt.Errorf("list secrets of environment %s in SSM Parameter Store: %w", envName, err)
+	}
+	secrets, err := clients.secrets.ListSecrets(tags)
+	if err != nil {
+		return nil, fmt.Errorf("list secrets of environment %s in Secrets Manager: %w", envName, err)
+	}
+
+	prefix := fmt.Sprintf(fmtSecretParameterName, appName, envName, "")
+	out := make([]*envSecret, 0, len(params)+len(secrets))
+	for _, param := range params {
+		out = append(out, &envSecret{
+			Name:         strings.TrimPrefix(param.Name, prefix),
+			Environment:  envName,
+			Source:       secretSourceParameterStore,
+			ID:           param.Name,
+			Version:      param.Version,
+			LastModified: param.LastModified,
+		})
+	}
+	for _, secret := range secrets {
+		out = append(out, &envSecret{
+			Name:         secret.Name,
+			Environment:  envName,
+			Source:       secretSourceSecretsManager,
+			ID:           secret.ARN,
+			LastModified: secret.LastModified,
+		})
+	}
+	return out, nil
+}
+
+// filterSecretsByName returns the secrets with the name, or with the full name of the parameter or ARN.
+func filterSecretsByName(secrets []*envSecret, name string) []*envSecret {
+	var out []*envSecret
+	for _, secret := range secrets {
+		if secret.Name == name || secret.ID == name {
+			out = append(out, secret)
+		}
+	}
+	return out
+}
+
+// writeTable writes the rows as a table with underlined headers.
+func writeTable(w io.Writer, headers []string, rows [][]string) {
+	writer := tabwriter.NewWriter(w, secretTableMinCellWidth, secretTableTabWidth, secretTableCellPaddingWidth, secretTablePaddingChar, 0)
+	underlines := make([]string, len(headers))
+	for i, header := range headers {
+		underlines[i] = strings.Repeat("-", len(header))
+	}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "%s\n", strings.Join(underlines, "\t"))
+	for _, row := range rows {
+		fmt.Fprintf(writer, "%s\n", strings.Join(row, "\t"))
+	}
+	writer.Flush()
+}
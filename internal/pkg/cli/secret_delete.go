@@ -0,0 +1,203 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+)
+
+const (
+	secretDeleteAppNamePrompt = "Which application is the secret in?"
+	secretDeleteAppNameHelper = "An application is a collection of related services."
+	secretDeleteNamePrompt    = "Which secret would you like to delete?"
+	secretDeleteNameHelper    = "The secret will be deleted from SSM Parameter Store."
+
+	fmtSecretDeleteFromEnvConfirmPrompt  = "Are you sure you want to delete secret %s from environment %s?"
+	fmtSecretDeleteFromEnvsConfirmPrompt = "Are you sure you want to delete secret %s from all the environments of application %s?"
+	secretDeleteConfirmHelp              = "Services that refer to the secret will fail to start new tasks."
+)
+
+var (
+	errSecretDeleteCancelled = errors.New("secret delete cancelled - no changes made")
+)
+
+type deleteSecretVars struct {
+	appName          string
+	envName          string
+	name             string
+	skipConfirmation bool
+}
+
+type deleteSecretOpts struct {
+	deleteSecretVars
+
+	store            store
+	sel              appSelector
+	prompt           prompter
+	newSecretClients newEnvSecretClientsFunc
+
+	// Cached secrets and clients of the environments.
+	secrets []*envSecret
+	clients map[string]*envSecretClients
+}
+
+func newDeleteSecretOpts(vars deleteSecretVars) (*deleteSecretOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("secret delete"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	return &deleteSecretOpts{
+		deleteSecretVars: vars,
+		store:            store,
+		sel:              selector.NewAppEnvSelector(prompter, store),
+		prompt:           prompter,
+		newSecretClients: newEnvSecretClients(sessProvider),
+	}, nil
+}
+
+// Ask prompts for and validates the application and the name of the secret, and confirms the deletion.
+func (o *deleteSecretOpts) Ask() error {
+	if err := o.askAppName(); err != nil {
+		return err
+	}
+	if err := o.askSecretName(); err != nil {
+		return err
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+	msg := fmt.Sprintf(fmtSecretDeleteFromEnvsConfirmPrompt, color.HighlightUserInput(o.name), color.HighlightUserInput(o.appName))
+	if o.envName != "" {
+		msg = fmt.Sprintf(fmtSecretDeleteFromEnvConfirmPrompt, color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName))
+	}
+	confirmed, err := o.prompt.Confirm(msg, secretDeleteConfirmHelp, prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("confirm to delete secret %s: %w", o.name, err)
+	}
+	if !confirmed {
+		return errSecretDeleteCancelled
+	}
+	return nil
+}
+
+// Execute deletes the parameters of the secret from SSM Parameter Store.
+func (o *deleteSecretOpts) Execute() error {
+	if err := o.loadSecrets(); err != nil {
+		return err
+	}
+	secrets := filterSecretsByName(o.secrets, o.name)
+	if len(secrets) == 0 {
+		return fmt.Errorf("secret %s not found in SSM Parameter Store for application %s", o.name, o.appName)
+	}
+	for _, secret := range secrets {
+		if err := o.clients[secret.Environment].params.DeleteSecret(secret.ID); err != nil {
+			return fmt.Errorf("delete secret %s from environment %s: %w", o.name, secret.Environment, err)
+		}
+		log.Successf("Deleted secret %s from environment %s.\n", color.HighlightResource(secret.ID), color.HighlightUserInput(secret.Environment))
+	}
+	return nil
+}
+
+func (o *deleteSecretOpts) askAppName() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application: %w", err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(secretDeleteAppNamePrompt, secretDeleteAppNameHelper)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *deleteSecretOpts) askSecretName() error {
+	if o.name != "" {
+		return nil
+	}
+	if err := o.loadSecrets(); err != nil {
+		return err
+	}
+	names := uniqueSecretNames(o.secrets)
+	if len(names) == 0 {
+		return fmt.Errorf("no secrets found in SSM Parameter Store for application %s", o.appName)
+	}
+	name, err := o.prompt.SelectOne(secretDeleteNamePrompt, secretDeleteNameHelper, names, prompt.WithFinalMessage("Secret:"))
+	if err != nil {
+		return fmt.Errorf("select secret: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// loadSecrets retrieves the secrets in SSM Parameter Store, since Copilot only deletes the secrets that it can create.
+func (o *deleteSecretOpts) loadSecrets() error {
+	if o.clients != nil {
+		return nil
+	}
+	envs, err := envsInApp(o.store, o.appName, o.envName)
+	if err != nil {
+		return err
+	}
+	secrets, clients, err := listSecretsInEnvs(o.appName, envs, o.newSecretClients)
+	if err != nil {
+		return err
+	}
+	for _, secret := range secrets {
+		if secret.Source == secretSourceParameterStore {
+			o.secrets = append(o.secrets, secret)
+		}
+	}
+	o.clients = clients
+	return nil
+}
+
+// buildSecretDeleteCmd builds the command for deleting a secret.
+func buildSecretDeleteCmd() *cobra.Command {
+	vars := deleteSecretVars{}
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Deletes a secret from SSM Parameter Store.",
+		Long: `Deletes a secret from SSM Parameter Store.
+Only the secrets tagged with the copilot-application and copilot-environment tags are deleted.`,
+		Example: `
+  Deletes the db_password secret from all the environments.
+  /code $ copilot secret delete -n db_password
+  Deletes the db_password secret from the test environment without confirmation.
+  /code $ copilot secret delete -n db_password -e test --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDeleteSecretOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", secretEnvFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", secretFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,206 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type secretDeleteMocks struct {
+	store   *mocks.Mockstore
+	prompt  *mocks.Mockprompter
+	params  *mocks.MockparameterSecretsClient
+	secrets *mocks.MocksecretsManagerSecretsClient
+}
+
+func TestDeleteSecretOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inEnv              string
+		inName             string
+		inSkipConfirmation bool
+		setupMocks         func(m secretDeleteMocks)
+
+		wantedName  string
+		wantedError error
+	}{
+		"skip confirmation": {
+			inName:             "db_password",
+			inSkipConfirmation: true,
+			setupMocks: func(m secretDeleteMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+			},
+			wantedName: "db_password",
+		},
+		"only select the secrets in SSM Parameter Store": {
+			inSkipConfirmation: true,
+			setupMocks: func(m secretDeleteMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.params.EXPECT().ListSecrets(gomock.Any()).Return([]ssm.Secret{
+					{Name: "/copilot/phonetool/test/secrets/db_password"},
+				}, nil)
+				m.secrets.EXPECT().ListSecrets(gomock.Any()).Return([]secretsmanager.Secret{
+					{Name: "api_key"},
+				}, nil)
+				m.prompt.EXPECT().SelectOne(secretDeleteNamePrompt, secretDeleteNameHelper, []string{"db_password"}, gomock.Any()).
+					Return("db_password", nil)
+			},
+			wantedName: "db_password",
+		},
+		"confirm to delete from an environment": {
+			inEnv:  "test",
+			inName: "db_password",
+			setupMocks: func(m secretDeleteMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.prompt.EXPECT().Confirm(fmt.Sprintf(fmtSecretDeleteFromEnvConfirmPrompt, "db_password", "test"), secretDeleteConfirmHelp, gomock.Any()).
+					Return(true, nil)
+			},
+			wantedName: "db_password",
+		},
+		"error if the deletion is cancelled": {
+			inName: "db_password",
+			setupMocks: func(m secretDeleteMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.prompt.EXPECT().Confirm(fmt.Sprintf(fmtSecretDeleteFromEnvsConfirmPrompt, "db_password", "phonetool"), secretDeleteConfirmHelp, gomock.Any()).
+					Return(false, nil)
+			},
+			wantedError: errSecretDeleteCancelled,
+		},
+		"error if fail to confirm": {
+			inName: "db_password",
+			setupMocks: func(m secretDeleteMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, errors.New("some error"))
+			},
+			wantedError: errors.New("confirm to delete secret db_password: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretDeleteMocks{
+				store:   mocks.NewMockstore(ctrl),
+				prompt:  mocks.NewMockprompter(ctrl),
+				params:  mocks.NewMockparameterSecretsClient(ctrl),
+				secrets: mocks.NewMocksecretsManagerSecretsClient(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &deleteSecretOpts{
+				deleteSecretVars: deleteSecretVars{
+					appName:          "phonetool",
+					envName:          tc.inEnv,
+					name:             tc.inName,
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				store:  m.store,
+				prompt: m.prompt,
+				newSecretClients: func(env *config.Environment) (*envSecretClients, error) {
+					return &envSecretClients{
+						params:  m.params,
+						secrets: m.secrets,
+					}, nil
+				},
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedName, opts.name)
+			}
+		})
+	}
+}
+
+func TestDeleteSecretOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m secretDeleteMocks)
+
+		wantedError error
+	}{
+		"delete the secret from every environment": {
+			setupMocks: func(m secretDeleteMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				m.params.EXPECT().ListSecrets(gomock.Any()).Return([]ssm.Secret{
+					{Name: "/copilot/phonetool/test/secrets/db_password"},
+				}, nil)
+				m.secrets.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+				m.params.EXPECT().ListSecrets(gomock.Any()).Return([]ssm.Secret{
+					{Name: "/copilot/phonetool/prod/secrets/db_password"},
+				}, nil)
+				m.secrets.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+				m.params.EXPECT().DeleteSecret("/copilot/phonetool/test/secrets/db_password").Return(nil)
+				m.params.EXPECT().DeleteSecret("/copilot/phonetool/prod/secrets/db_password").Return(nil)
+			},
+		},
+		"error if the secret is not in SSM Parameter Store": {
+			setupMocks: func(m secretDeleteMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.params.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+				m.secrets.EXPECT().ListSecrets(gomock.Any()).Return([]secretsmanager.Secret{
+					{Name: "db_password"},
+				}, nil)
+			},
+			wantedError: errors.New("secret db_password not found in SSM Parameter Store for application phonetool"),
+		},
+		"error if fail to delete the secret": {
+			setupMocks: func(m secretDeleteMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.params.EXPECT().ListSecrets(gomock.Any()).Return([]ssm.Secret{
+					{Name: "/copilot/phonetool/test/secrets/db_password"},
+				}, nil)
+				m.secrets.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+				m.params.EXPECT().DeleteSecret(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("delete secret db_password from environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretDeleteMocks{
+				store:   mocks.NewMockstore(ctrl),
+				params:  mocks.NewMockparameterSecretsClient(ctrl),
+				secrets: mocks.NewMocksecretsManagerSecretsClient(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &deleteSecretOpts{
+				deleteSecretVars: deleteSecretVars{
+					appName: "phonetool",
+					name:    "db_password",
+				},
+				store: m.store,
+				newSecretClients: func(env *config.Environment) (*envSecretClients, error) {
+					return &envSecretClients{
+						params:  m.params,
+						secrets: m.secrets,
+					}, nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+)
+
+const (
+	secretListAppNamePrompt = "Which application are the secrets in?"
+	secretListAppNameHelper = "An application is a collection of related services."
+)
+
+type listSecretVars struct {
+	appName          string
+	envName          string
+	shouldOutputJSON bool
+}
+
+type listSecretOpts struct {
+	listSecretVars
+
+	store            store
+	sel              appSelector
+	newSecretClients newEnvSecretClientsFunc
+	now              func() time.Time
+	w                io.Writer
+}
+
+func newListSecretOpts(vars listSecretVars) (*listSecretOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("secret ls"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewCachedSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	return &listSecretOpts{
+		listSecretVars:   vars,
+		store:            store,
+		sel:              selector.NewAppEnvSelector(prompt.New(), store),
+		newSecretClients: newEnvSecretClients(sessProvider),
+		now:              time.Now,
+		w:                os.Stdout,
+	}, nil
+}
+
+// Ask prompts for and validates the application name.
+func (o *listSecretOpts) Ask() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application: %w", err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(secretListAppNamePrompt, secretListAppNameHelper)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+// Execute lists the secrets tagged for the environments of the application.
+func (o *listSecretOpts) Execute() error {
+	envs, err := envsInApp(o.store, o.appName, o.envName)
+	if err != nil {
+		return err
+	}
+	secrets, _, err := listSecretsInEnvs(o.appName, envs, o.newSecretClients)
+	if err != nil {
+		return err
+	}
+
+	if o.shouldOutputJSON {
+		data, err := o.jsonOutput(secrets)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	o.humanOutput(secrets)
+	return nil
+}
+
+func (o *listSecretOpts) humanOutput(secrets []*envSecret) {
+	rows := make([][]string, 0, len(secrets))
+	for _, secret := range secrets {
+		rows = append(rows, []string{secret.Name, secret.Environment, secret.Source, humanize.RelTime(secret.LastModified, o.now(), "ago", "from now")})
+	}
+	writeTable(o.w, []string{"Name", "Environment", "Source", "Last Modified"}, rows)
+}
+
+func (o *listSecretOpts) jsonOutput(secrets []*envSecret) (string, error) {
+	type serializedSecrets struct {
+		Secrets []*envSecret `json:"secrets"`
+	}
+	b, err := json.Marshal(serializedSecrets{Secrets: secrets})
+	if err != nil {
+		return "", fmt.Errorf("marshal secrets: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// buildSecretListCmd builds the command for listing the secrets of an application.
+func buildSecretListCmd() *cobra.Command {
+	vars := listSecretVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the secrets of an application in SSM Parameter Store and Secrets Manager.",
+		Long: `Lists the secrets of an application in SSM Parameter Store and Secrets Manager.
+Secrets are listed if they are tagged with the copilot-application and copilot-environment tags.`,
+		Example: `
+  Lists the secrets of all the environments of the frontend application.
+  /code $ copilot secret ls -a frontend
+  Lists the secrets of the test environment in JSON format.
+  /code $ copilot secret ls -e test --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newListSecretOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", secretEnvFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
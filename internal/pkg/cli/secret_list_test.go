@@ -0,0 +1,233 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type secretListMocks struct {
+	store   *mocks.Mockstore
+	sel     *mocks.MockappSelector
+	params  *mocks.MockparameterSecretsClient
+	secrets *mocks.MocksecretsManagerSecretsClient
+}
+
+func TestListSecretOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp      string
+		setupMocks func(m secretListMocks)
+
+		wantedApp   string
+		wantedError error
+	}{
+		"validate the application from the flag": {
+			inApp: "phonetool",
+			setupMocks: func(m secretListMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+			},
+			wantedApp: "phonetool",
+		},
+		"error if the application does not exist": {
+			inApp: "phonetool",
+			setupMocks: func(m secretListMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("validate application: some error"),
+		},
+		"select an application": {
+			setupMocks: func(m secretListMocks) {
+				m.sel.EXPECT().Application(secretListAppNamePrompt, secretListAppNameHelper).Return("phonetool", nil)
+			},
+			wantedApp: "phonetool",
+		},
+		"error if fail to select an application": {
+			setupMocks: func(m secretListMocks) {
+				m.sel.EXPECT().Application(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select application: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretListMocks{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockappSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &listSecretOpts{
+				listSecretVars: listSecretVars{
+					appName: tc.inApp,
+				},
+				store: m.store,
+				sel:   m.sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.appName)
+			}
+		})
+	}
+}
+
+func TestListSecretOpts_Execute(t *testing.T) {
+	mockNow := time.Date(2023, time.March, 3, 12, 0, 0, 0, time.UTC)
+	mockLastModified := time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)
+	mockTags := func(env string) map[string]string {
+		return map[string]string{
+			deploy.AppTagKey: "phonetool",
+			deploy.EnvTagKey: env,
+		}
+	}
+	testCases := map[string]struct {
+		inEnv      string
+		inJSON     bool
+		setupMocks func(m secretListMocks)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"list the secrets of all the environments": {
+			setupMocks: func(m secretListMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test"},
+					{Name: "prod"},
+				}, nil)
+				m.params.EXPECT().ListSecrets(mockTags("test")).Return([]ssm.Secret{
+					{
+						Name:         "/copilot/phonetool/test/secrets/db_password",
+						Version:      2,
+						LastModified: mockLastModified,
+					},
+				}, nil)
+				m.secrets.EXPECT().ListSecrets(mockTags("test")).Return([]secretsmanager.Secret{
+					{
+						Name:         "stripe_key",
+						ARN:          "arn:aws:secretsmanager:us-west-2:123456789012:secret:stripe_key",
+						LastModified: mockLastModified,
+					},
+				}, nil)
+				m.params.EXPECT().ListSecrets(mockTags("prod")).Return([]ssm.Secret{
+					{
+						Name:         "/copilot/phonetool/prod/secrets/db_password",
+						Version:      1,
+						LastModified: mockLastModified,
+					},
+				}, nil)
+				m.secrets.EXPECT().ListSecrets(mockTags("prod")).Return(nil, nil)
+			},
+			wantedContent: `Name                Environment         Source              Last Modified
+----                -----------         ------              -------------
+db_password         test                Parameter Store     2 days ago
+db_password         prod                Parameter Store     2 days ago
+stripe_key          test                Secrets Manager     2 days ago
+`,
+		},
+		"list the secrets of an environment in JSON": {
+			inEnv:  "test",
+			inJSON: true,
+			setupMocks: func(m secretListMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.params.EXPECT().ListSecrets(mockTags("test")).Return([]ssm.Secret{
+					{
+						Name:         "/copilot/phonetool/test/secrets/db_password",
+						Version:      2,
+						LastModified: mockLastModified,
+					},
+				}, nil)
+				m.secrets.EXPECT().ListSecrets(mockTags("test")).Return(nil, nil)
+			},
+			wantedContent: `{"secrets":[{"name":"db_password","environment":"test","source":"Parameter Store","id":"/copilot/phonetool/test/secrets/db_password","version":2,"lastModified":"2023-03-01T12:00:00Z"}]}
+`,
+		},
+		"error if fail to get the environment": {
+			inEnv: "test",
+			setupMocks: func(m secretListMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get environment test in application phonetool: some error"),
+		},
+		"error if fail to list the environments": {
+			setupMocks: func(m secretListMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list environments in application phonetool: some error"),
+		},
+		"error if fail to list the parameters": {
+			inEnv: "test",
+			setupMocks: func(m secretListMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.params.EXPECT().ListSecrets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list secrets of environment test in SSM Parameter Store: some error"),
+		},
+		"error if fail to list the secrets in Secrets Manager": {
+			inEnv: "test",
+			setupMocks: func(m secretListMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.params.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+				m.secrets.EXPECT().ListSecrets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list secrets of environment test in Secrets Manager: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretListMocks{
+				store:   mocks.NewMockstore(ctrl),
+				params:  mocks.NewMockparameterSecretsClient(ctrl),
+				secrets: mocks.NewMocksecretsManagerSecretsClient(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &listSecretOpts{
+				listSecretVars: listSecretVars{
+					appName:          "phonetool",
+					envName:          tc.inEnv,
+					shouldOutputJSON: tc.inJSON,
+				},
+				store: m.store,
+				newSecretClients: func(env *config.Environment) (*envSecretClients, error) {
+					return &envSecretClients{
+						params:  m.params,
+						secrets: m.secrets,
+					}, nil
+				},
+				now: func() time.Time { return mockNow },
+				w:   b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+)
+
+const (
+	secretPutAppNamePrompt = "Which application do you want to add the secrets to?"
+	secretPutAppNameHelper = "An application is a collection of related services."
+	secretPutEnvNamePrompt = "Which environment do you want to add the secrets to?"
+	secretPutEnvNameHelper = "Secrets are environment-level resources."
+)
+
+type putSecretVars struct {
+	appName       string
+	envName       string
+	inputFilePath string
+	overwrite     bool
+}
+
+type putSecretOpts struct {
+	putSecretVars
+	shouldShowOverwriteHint bool
+	secretNames             []string
+
+	store            store
+	fs               afero.Fs
+	sel              appEnvSelector
+	newSecretClients newEnvSecretClientsFunc
+}
+
+func newPutSecretOpts(vars putSecretVars) (*putSecretOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("secret put"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	return &putSecretOpts{
+		putSecretVars:    vars,
+		store:            store,
+		fs:               afero.NewOsFs(),
+		sel:              selector.NewAppEnvSelector(prompt.New(), store),
+		newSecretClients: newEnvSecretClients(sessProvider),
+	}, nil
+}
+
+// Validate returns an error if the flag values passed by the user are invalid.
+func (o *putSecretOpts) Validate() error {
+	if o.inputFilePath == "" {
+		return fmt.Errorf("`--%s` must be specified", fromFileFlag)
+	}
+	if _, err := o.fs.Stat(o.inputFilePath); err != nil {
+		return err
+	}
+	if o.appName == "" {
+		return nil
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+		}
+	}
+	return nil
+}
+
+// Ask prompts for the application and the environment if they are not provided.
+func (o *putSecretOpts) Ask() error {
+	if o.overwrite {
+		log.Warningf("You have specified %s flag. Please note that overwriting an existing secret may break your deployed service.\n", color.HighlightCode(fmt.Sprintf("--%s", overwriteFlag)))
+	}
+	if o.appName == "" {
+		app, err := o.sel.Application(secretPutAppNamePrompt, secretPutAppNameHelper)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.envName == "" {
+		env, err := o.sel.Environment(secretPutEnvNamePrompt, secretPutEnvNameHelper, o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.envName = env
+	}
+	return nil
+}
+
+// Execute puts each secret of the .env file in the environment.
+func (o *putSecretOpts) Execute() error {
+	raw, err := afero.ReadFile(o.fs, o.inputFilePath)
+	if err != nil {
+		return fmt.Errorf("read input file %s: %w", o.inputFilePath, err)
+	}
+	names, values, err := parseDotEnv(raw)
+	if err != nil {
+		return fmt.Errorf("parse input file %s: %w", o.inputFilePath, err)
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s in application %s: %w", o.envName, o.appName, err)
+	}
+	clients, err := o.newSecretClients(env)
+	if err != nil {
+		return err
+	}
+
+	var errs []*errSecretFailedInSomeEnvironments
+	for _, name := range names {
+		if err := o.putSecret(clients.params, name, values[name]); err != nil {
+			log.Errorf("Failed to put secret %s in environment %s.\n", color.HighlightUserInput(name), color.HighlightUserInput(o.envName))
+			errs = append(errs, &errSecretFailedInSomeEnvironments{
+				secretName:            name,
+				errorsForEnvironments: map[string]error{o.envName: err},
+			})
+			continue
+		}
+		o.secretNames = append(o.secretNames, name)
+	}
+	if len(errs) != 0 {
+		return &errBatchPutSecretsFailed{
+			errors: errs,
+		}
+	}
+	return nil
+}
+
+func (o *putSecretOpts) putSecret(client secretPutter, secretName, value string) error {
+	name := fmt.Sprintf(fmtSecretParameterName, o.appName, o.envName, secretName)
+	out, err := client.PutSecret(ssm.PutSecretInput{
+		Name:      name,
+		Value:     value,
+		Overwrite: o.overwrite,
+		Tags: map[string]string{
+			deploy.AppTagKey: o.appName,
+			deploy.EnvTagKey: o.envName,
+		},
+	})
+	if err != nil {
+		var targetErr *ssm.ErrParameterAlreadyExists
+		if errors.As(err, &targetErr) {
+			o.shouldShowOverwriteHint = true
+			log.Successf("Secret %s already exists in environment %s as %s. Did not overwrite.\n", color.HighlightUserInput(secretName), color.HighlightUserInput(o.envName), color.HighlightResource(name))
+			return nil
+		}
+		return err
+	}
+	if aws.Int64Value(out.Version) != 1 {
+		log.Successf("Secret %s already exists in environment %s. Overwritten.\n", color.HighlightResource(name), color.HighlightUserInput(o.envName))
+		return nil
+	}
+	log.Successf("Successfully put secret %s in environment %s as %s.\n", color.HighlightUserInput(secretName), color.HighlightUserInput(o.envName), color.HighlightResource(name))
+	return nil
+}
+
+// RecommendActions shows how to refer to the secrets from a manifest.
+func (o *putSecretOpts) RecommendActions() error {
+	if len(o.secretNames) == 0 {
+		return nil
+	}
+	example := "secrets:"
+	for _, name := range o.secretNames {
+		example = fmt.Sprintf("%s\n    %s: %s", example, name, fmt.Sprintf(fmtSecretParameterNameMftExample, name))
+	}
+	log.Infoln("You can refer to these secrets from your manifest file by editing the `secrets` section.")
+	log.Infoln(color.HighlightCodeBlock(example))
+	return nil
+}
+
+// parseDotEnv parses the lines of a .env file formatted as <name>=<value>, and returns the names in order of appearance.
+// Empty lines, comments starting with "#" and an "export" prefix are ignored, and a value can be surrounded by quotes.
+func parseDotEnv(content []byte) ([]string, map[string]string, error) {
+	var names []string
+	values := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		name, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, nil, fmt.Errorf("line %d: expected the format <name>=<value>", lineNum)
+		}
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if err := validateSecretName(name); err != nil {
+			return nil, nil, fmt.Errorf("line %d: invalid secret name %q: %w", lineNum, name, err)
+		}
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		if _, ok := values[name]; !ok {
+			names = append(names, name)
+		}
+		values[name] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+	return names, values, nil
+}
+
+// buildSecretPutCmd builds the command for putting the secrets of a .env file in an environment.
+func buildSecretPutCmd() *cobra.Command {
+	vars := putSecretVars{}
+	cmd := &cobra.Command{
+		Use:   "put",
+		Short: "Create or update secrets in SSM Parameter Store from a .env file.",
+		Long: `Create or update secrets in SSM Parameter Store from a .env file.
+Each line of the file is a secret formatted as <name>=<value>.
+Use the --overwrite flag to update, or rotate, the values of existing secrets.`,
+		Example: `
+  Create the secrets of .env in the test environment.
+  /code $ copilot secret put --from-file .env -e test
+  Update the values of existing secrets.
+  /code $ copilot secret put --from-file .env -e test --overwrite`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPutSecretOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			err = opts.Execute()
+			if opts.shouldShowOverwriteHint {
+				log.Warningf("If you want to overwrite an existing secret, use the %s flag.\n", color.HighlightCode(fmt.Sprintf("--%s", overwriteFlag)))
+			}
+			if err != nil {
+				return err
+			}
+			return opts.RecommendActions()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.inputFilePath, fromFileFlag, "", secretFromFileFlagDescription)
+	cmd.Flags().BoolVar(&vars.overwrite, overwriteFlag, false, secretOverwriteFlagDescription)
+	return cmd
+}
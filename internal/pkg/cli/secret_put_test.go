@@ -0,0 +1,251 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPutSecretOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inApp      string
+		inEnv      string
+		inFilePath string
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"error if the input file is not specified": {
+			wantedError: errors.New("`--from-file` must be specified"),
+		},
+		"error if the input file does not exist": {
+			inFilePath:  "missing.env",
+			wantedError: errors.New("open missing.env: file does not exist"),
+		},
+		"error if the application does not exist": {
+			inApp:      "phonetool",
+			inFilePath: ".env",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get application phonetool: some error"),
+		},
+		"error if the environment does not exist": {
+			inApp:      "phonetool",
+			inEnv:      "test",
+			inFilePath: ".env",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get environment test in application phonetool: some error"),
+		},
+		"valid flags": {
+			inApp:      "phonetool",
+			inEnv:      "test",
+			inFilePath: ".env",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockStore)
+			}
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, ".env", []byte("db_password=hunter2"), 0644))
+			opts := &putSecretOpts{
+				putSecretVars: putSecretVars{
+					appName:       tc.inApp,
+					envName:       tc.inEnv,
+					inputFilePath: tc.inFilePath,
+				},
+				store: mockStore,
+				fs:    fs,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPutSecretOpts_Execute(t *testing.T) {
+	mockTags := map[string]string{
+		deploy.AppTagKey: "phonetool",
+		deploy.EnvTagKey: "test",
+	}
+	testCases := map[string]struct {
+		inContent   string
+		inOverwrite bool
+		setupMocks  func(m *mocks.MockparameterSecretsClient)
+
+		wantedSecretNames   []string
+		wantedOverwriteHint bool
+		wantedError         error
+	}{
+		"error if the input file is malformed": {
+			inContent:   "db_password",
+			wantedError: errors.New("parse input file .env: line 1: expected the format <name>=<value>"),
+		},
+		"put each secret of the file": {
+			inContent: "db_password=hunter2\napi_key=\"abc 123\"\n",
+			setupMocks: func(m *mocks.MockparameterSecretsClient) {
+				m.EXPECT().PutSecret(ssm.PutSecretInput{
+					Name:  "/copilot/phonetool/test/secrets/db_password",
+					Value: "hunter2",
+					Tags:  mockTags,
+				}).Return(&ssm.PutSecretOutput{Version: aws.Int64(1)}, nil)
+				m.EXPECT().PutSecret(ssm.PutSecretInput{
+					Name:  "/copilot/phonetool/test/secrets/api_key",
+					Value: "abc 123",
+					Tags:  mockTags,
+				}).Return(&ssm.PutSecretOutput{Version: aws.Int64(1)}, nil)
+			},
+			wantedSecretNames: []string{"db_password", "api_key"},
+		},
+		"overwrite the existing secrets": {
+			inContent:   "db_password=hunter3",
+			inOverwrite: true,
+			setupMocks: func(m *mocks.MockparameterSecretsClient) {
+				m.EXPECT().PutSecret(ssm.PutSecretInput{
+					Name:      "/copilot/phonetool/test/secrets/db_password",
+					Value:     "hunter3",
+					Overwrite: true,
+					Tags:      mockTags,
+				}).Return(&ssm.PutSecretOutput{Version: aws.Int64(2)}, nil)
+			},
+			wantedSecretNames: []string{"db_password"},
+		},
+		"show the overwrite hint if a secret already exists": {
+			inContent: "db_password=hunter2",
+			setupMocks: func(m *mocks.MockparameterSecretsClient) {
+				m.EXPECT().PutSecret(gomock.Any()).Return(nil, &ssm.ErrParameterAlreadyExists{})
+			},
+			wantedSecretNames:   []string{"db_password"},
+			wantedOverwriteHint: true,
+		},
+		"error if fail to put some of the secrets": {
+			inContent: "db_password=hunter2\napi_key=abc",
+			setupMocks: func(m *mocks.MockparameterSecretsClient) {
+				m.EXPECT().PutSecret(gomock.Any()).Return(&ssm.PutSecretOutput{Version: aws.Int64(1)}, nil)
+				m.EXPECT().PutSecret(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedSecretNames: []string{"db_password"},
+			wantedError:       errors.New("batch put secrets:\nput secret api_key in environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStore := mocks.NewMockstore(ctrl)
+			mockParams := mocks.NewMockparameterSecretsClient(ctrl)
+			mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil).AnyTimes()
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockParams)
+			}
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, ".env", []byte(tc.inContent), 0644))
+			opts := &putSecretOpts{
+				putSecretVars: putSecretVars{
+					appName:       "phonetool",
+					envName:       "test",
+					inputFilePath: ".env",
+					overwrite:     tc.inOverwrite,
+				},
+				store: mockStore,
+				fs:    fs,
+				newSecretClients: func(env *config.Environment) (*envSecretClients, error) {
+					return &envSecretClients{
+						params: mockParams,
+					}, nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedSecretNames, opts.secretNames)
+			require.Equal(t, tc.wantedOverwriteHint, opts.shouldShowOverwriteHint)
+		})
+	}
+}
+
+func TestParseDotEnv(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedNames  []string
+		wantedValues map[string]string
+		wantedError  error
+	}{
+		"parse names and values": {
+			inContent: `# Database credentials.
+db_user=admin
+
+export db_password = 'hunter2'
+api_key="abc=123"
+db_user=root
+empty=
+`,
+			wantedNames: []string{"db_user", "db_password", "api_key", "empty"},
+			wantedValues: map[string]string{
+				"db_user":     "root",
+				"db_password": "hunter2",
+				"api_key":     "abc=123",
+				"empty":       "",
+			},
+		},
+		"error if a line is missing the separator": {
+			inContent:   "db_user=admin\ndb_password",
+			wantedError: errors.New("line 2: expected the format <name>=<value>"),
+		},
+		"error if a name is invalid": {
+			inContent:   "db password=hunter2",
+			wantedError: errors.New(`line 1: invalid secret name "db password": value must contain only letters, numbers, periods, hyphens and underscores`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			names, values, err := parseDotEnv([]byte(tc.inContent))
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedNames, names)
+				require.Equal(t, tc.wantedValues, values)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+)
+
+const (
+	secretShowAppNamePrompt = "Which application is the secret in?"
+	secretShowAppNameHelper = "An application is a collection of related services."
+	secretShowNamePrompt    = "Which secret would you like to show?"
+	secretShowNameHelper    = "The metadata of the secret in each environment will be shown."
+)
+
+type showSecretVars struct {
+	appName          string
+	envName          string
+	name             string
+	shouldReveal     bool
+	shouldOutputJSON bool
+}
+
+type showSecretOpts struct {
+	showSecretVars
+
+	store            store
+	sel              appSelector
+	prompt           prompter
+	newSecretClients newEnvSecretClientsFunc
+	now              func() time.Time
+	w                io.Writer
+
+	// Cached secrets and clients of the environments.
+	secrets []*envSecret
+	clients map[string]*envSecretClients
+}
+
+func newShowSecretOpts(vars showSecretVars) (*showSecretOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("secret show"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewCachedSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	return &showSecretOpts{
+		showSecretVars:   vars,
+		store:            store,
+		sel:              selector.NewAppEnvSelector(prompter, store),
+		prompt:           prompter,
+		newSecretClients: newEnvSecretClients(sessProvider),
+		now:              time.Now,
+		w:                os.Stdout,
+	}, nil
+}
+
+// Ask prompts for and validates the application and the name of the secret.
+func (o *showSecretOpts) Ask() error {
+	if err := o.askAppName(); err != nil {
+		return err
+	}
+	return o.askSecretName()
+}
+
+// Execute shows the metadata of the secret in each environment, and its values if requested.
+func (o *showSecretOpts) Execute() error {
+	if err := o.loadSecrets(); err != nil {
+		return err
+	}
+	secrets := filterSecretsByName(o.secrets, o.name)
+	if len(secrets) == 0 {
+		return fmt.Errorf("secret %s not found in application %s", o.name, o.appName)
+	}
+	if o.shouldReveal {
+		for _, secret := range secrets {
+			value, err := o.secretValue(secret)
+			if err != nil {
+				return err
+			}
+			secret.Value = aws.String(value)
+		}
+	}
+
+	if o.shouldOutputJSON {
+		data, err := o.jsonOutput(secrets)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	o.humanOutput(secrets)
+	return nil
+}
+
+func (o *showSecretOpts) askAppName() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application: %w", err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(secretShowAppNamePrompt, secretShowAppNameHelper)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *showSecretOpts) askSecretName() error {
+	if o.name != "" {
+		return nil
+	}
+	if err := o.loadSecrets(); err != nil {
+		return err
+	}
+	names := uniqueSecretNames(o.secrets)
+	if len(names) == 0 {
+		return fmt.Errorf("no secrets found in application %s", o.appName)
+	}
+	name, err := o.prompt.SelectOne(secretShowNamePrompt, secretShowNameHelper, names, prompt.WithFinalMessage("Secret:"))
+	if err != nil {
+		return fmt.Errorf("select secret: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+func (o *showSecretOpts) loadSecrets() error {
+	if o.clients != nil {
+		return nil
+	}
+	envs, err := envsInApp(o.store, o.appName, o.envName)
+	if err != nil {
+		return err
+	}
+	secrets, clients, err := listSecretsInEnvs(o.appName, envs, o.newSecretClients)
+	if err != nil {
+		return err
+	}
+	o.secrets, o.clients = secrets, clients
+	return nil
+}
+
+func (o *showSecretOpts) secretValue(secret *envSecret) (string, error) {
+	clients := o.clients[secret.Environment]
+	if secret.Source == secretSourceSecretsManager {
+		return clients.secrets.GetSecretValue(secret.ID)
+	}
+	return clients.params.GetSecretValue(secret.ID)
+}
+
+func (o *showSecretOpts) humanOutput(secrets []*envSecret) {
+	writer := tabwriter.NewWriter(o.w, secretTableMinCellWidth, secretTableTabWidth, secretTableCellPaddingWidth, secretTablePaddingChar, 0)
+	fmt.Fprint(writer, color.Bold.Sprint("About\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Name", o.name)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Application", o.appName)
+	fmt.Fprint(writer, color.Bold.Sprint("\nEnvironments\n\n"))
+	writer.Flush()
+	headers := []string{"Environment", "Source", "ID", "Version", "Last Modified"}
+	if o.shouldReveal {
+		headers = append(headers, "Value")
+	}
+	underlines := make([]string, len(headers))
+	for i, header := range headers {
+		underlines[i] = strings.Repeat("-", len(header))
+	}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underlines, "\t"))
+	for _, secret := range secrets {
+		version := "-"
+		if secret.Version != 0 {
+			version = strconv.FormatInt(secret.Version, 10)
+		}
+		row := []string{secret.Environment, secret.Source, secret.ID, version, humanize.RelTime(secret.LastModified, o.now(), "ago", "from now")}
+		if o.shouldReveal {
+			row = append(row, aws.StringValue(secret.Value))
+		}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(row, "\t"))
+	}
+	writer.Flush()
+}
+
+func (o *showSecretOpts) jsonOutput(secrets []*envSecret) (string, error) {
+	type serializedSecret struct {
+		Name        string       `json:"name"`
+		Application string       `json:"application"`
+		Secrets     []*envSecret `json:"secrets"`
+	}
+	b, err := json.Marshal(serializedSecret{
+		Name:        o.name,
+		Application: o.appName,
+		Secrets:     secrets,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal secret: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+func uniqueSecretNames(secrets []*envSecret) []string {
+	seen := make(map[string]struct{})
+	var names []string
+	for _, secret := range secrets {
+		if _, ok := seen[secret.Name]; ok {
+			continue
+		}
+		seen[secret.Name] = struct{}{}
+		names = append(names, secret.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// buildSecretShowCmd builds the command for showing the metadata of a secret.
+func buildSecretShowCmd() *cobra.Command {
+	vars := showSecretVars{}
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Shows the metadata of a secret in each environment.",
+		Long: `Shows the metadata of a secret in each environment.
+The values of the secret are only shown with the --reveal flag.`,
+		Example: `
+  Shows the metadata of the db_password secret.
+  /code $ copilot secret show -n db_password
+  Shows the value of the db_password secret in the test environment.
+  /code $ copilot secret show -n db_password -e test --reveal`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newShowSecretOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", secretEnvFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", secretFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldReveal, revealFlag, false, secretRevealFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
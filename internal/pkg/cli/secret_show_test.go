@@ -0,0 +1,249 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type secretShowMocks struct {
+	store   *mocks.Mockstore
+	sel     *mocks.MockappSelector
+	prompt  *mocks.Mockprompter
+	params  *mocks.MockparameterSecretsClient
+	secrets *mocks.MocksecretsManagerSecretsClient
+}
+
+func TestShowSecretOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(m secretShowMocks)
+
+		wantedName  string
+		wantedError error
+	}{
+		"skip selecting the secret if the name is provided": {
+			inName: "db_password",
+			setupMocks: func(m secretShowMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+			},
+			wantedName: "db_password",
+		},
+		"select a secret of the environments": {
+			setupMocks: func(m secretShowMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				m.params.EXPECT().ListSecrets(gomock.Any()).Return([]ssm.Secret{
+					{Name: "/copilot/phonetool/test/secrets/db_password"},
+				}, nil)
+				m.secrets.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+				m.params.EXPECT().ListSecrets(gomock.Any()).Return([]ssm.Secret{
+					{Name: "/copilot/phonetool/prod/secrets/db_password"},
+				}, nil)
+				m.secrets.EXPECT().ListSecrets(gomock.Any()).Return([]secretsmanager.Secret{
+					{Name: "api_key"},
+				}, nil)
+				m.prompt.EXPECT().SelectOne(secretShowNamePrompt, secretShowNameHelper, []string{"api_key", "db_password"}, gomock.Any()).
+					Return("db_password", nil)
+			},
+			wantedName: "db_password",
+		},
+		"error if there are no secrets": {
+			setupMocks: func(m secretShowMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.params.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+				m.secrets.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+			},
+			wantedError: errors.New("no secrets found in application phonetool"),
+		},
+		"error if fail to select a secret": {
+			setupMocks: func(m secretShowMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.params.EXPECT().ListSecrets(gomock.Any()).Return([]ssm.Secret{
+					{Name: "/copilot/phonetool/test/secrets/db_password"},
+				}, nil)
+				m.secrets.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select secret: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretShowMocks{
+				store:   mocks.NewMockstore(ctrl),
+				prompt:  mocks.NewMockprompter(ctrl),
+				params:  mocks.NewMockparameterSecretsClient(ctrl),
+				secrets: mocks.NewMocksecretsManagerSecretsClient(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showSecretOpts{
+				showSecretVars: showSecretVars{
+					appName: "phonetool",
+					name:    tc.inName,
+				},
+				store:  m.store,
+				prompt: m.prompt,
+				newSecretClients: func(env *config.Environment) (*envSecretClients, error) {
+					return &envSecretClients{
+						params:  m.params,
+						secrets: m.secrets,
+					}, nil
+				},
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedName, opts.name)
+			}
+		})
+	}
+}
+
+func TestShowSecretOpts_Execute(t *testing.T) {
+	mockNow := time.Date(2023, time.March, 3, 12, 0, 0, 0, time.UTC)
+	mockLastModified := time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inName     string
+		inReveal   bool
+		inJSON     bool
+		setupMocks func(m secretShowMocks)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"error if the secret does not exist": {
+			inName: "api_key",
+			setupMocks: func(m secretShowMocks) {
+				m.params.EXPECT().ListSecrets(gomock.Any()).Return([]ssm.Secret{
+					{Name: "/copilot/phonetool/test/secrets/db_password"},
+				}, nil)
+				m.secrets.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+			},
+			wantedError: errors.New("secret api_key not found in application phonetool"),
+		},
+		"show the metadata without the values": {
+			inName: "db_password",
+			setupMocks: func(m secretShowMocks) {
+				m.params.EXPECT().ListSecrets(gomock.Any()).Return([]ssm.Secret{
+					{
+						Name:         "/copilot/phonetool/test/secrets/db_password",
+						Version:      3,
+						LastModified: mockLastModified,
+					},
+				}, nil)
+				m.secrets.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+			},
+			wantedContent: `About
+
+  Name              db_password
+  Application       phonetool
+
+Environments
+
+  Environment       Source              ID                                           Version             Last Modified
+  -----------       ------              --                                           -------             -------------
+  test              Parameter Store     /copilot/phonetool/test/secrets/db_password  3                   2 days ago
+`,
+		},
+		"reveal the values in JSON": {
+			inName:   "db_password",
+			inReveal: true,
+			inJSON:   true,
+			setupMocks: func(m secretShowMocks) {
+				m.params.EXPECT().ListSecrets(gomock.Any()).Return([]ssm.Secret{
+					{
+						Name:         "/copilot/phonetool/test/secrets/db_password",
+						Version:      3,
+						LastModified: mockLastModified,
+					},
+				}, nil)
+				m.secrets.EXPECT().ListSecrets(gomock.Any()).Return([]secretsmanager.Secret{
+					{
+						Name:         "db_password",
+						ARN:          "arn:aws:secretsmanager:us-west-2:123456789012:secret:db_password",
+						LastModified: mockLastModified,
+					},
+				}, nil)
+				m.params.EXPECT().GetSecretValue("/copilot/phonetool/test/secrets/db_password").Return("hunter2", nil)
+				m.secrets.EXPECT().GetSecretValue("arn:aws:secretsmanager:us-west-2:123456789012:secret:db_password").Return("hunter3", nil)
+			},
+			wantedContent: `{"name":"db_password","application":"phonetool","secrets":[{"name":"db_password","environment":"test","source":"Parameter Store","id":"/copilot/phonetool/test/secrets/db_password","version":3,"lastModified":"2023-03-01T12:00:00Z","value":"hunter2"},{"name":"db_password","environment":"test","source":"Secrets Manager","id":"arn:aws:secretsmanager:us-west-2:123456789012:secret:db_password","lastModified":"2023-03-01T12:00:00Z","value":"hunter3"}]}
+`,
+		},
+		"error if fail to get the value": {
+			inName:   "db_password",
+			inReveal: true,
+			setupMocks: func(m secretShowMocks) {
+				m.params.EXPECT().ListSecrets(gomock.Any()).Return([]ssm.Secret{
+					{Name: "/copilot/phonetool/test/secrets/db_password"},
+				}, nil)
+				m.secrets.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+				m.params.EXPECT().GetSecretValue(gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretShowMocks{
+				store:   mocks.NewMockstore(ctrl),
+				params:  mocks.NewMockparameterSecretsClient(ctrl),
+				secrets: mocks.NewMocksecretsManagerSecretsClient(ctrl),
+			}
+			m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &showSecretOpts{
+				showSecretVars: showSecretVars{
+					appName:          "phonetool",
+					envName:          "test",
+					name:             tc.inName,
+					shouldReveal:     tc.inReveal,
+					shouldOutputJSON: tc.inJSON,
+				},
+				store: m.store,
+				newSecretClients: func(env *config.Environment) (*envSecretClients, error) {
+					return &envSecretClients{
+						params:  m.params,
+						secrets: m.secrets,
+					}, nil
+				},
+				now: func() time.Time { return mockNow },
+				w:   b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/lock"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+)
+
+// defaultLockTimeout is how long the commands wait for a lock with --wait-for-lock by default.
+const defaultLockTimeout = time.Hour
+
+// newStackLocker returns a locker whose locks are stored in the region of sess.
+// If wait is true, the locker waits for locks held by others to be released, for at most timeout if it's positive.
+func newStackLocker(sess *session.Session, wait bool, timeout time.Duration) *lock.Locker {
+	var opts []lock.Option
+	if wait {
+		opts = append(opts, lock.WithWait(func(held *lock.Lock) {
+			log.Infof("Waiting for %s to finish running %s on stack %s.\n",
+				held.Owner, color.HighlightCode(fmt.Sprintf("copilot %s", held.Command)), color.HighlightResource(held.Stack))
+		}), lock.WithTimeout(timeout))
+	}
+	return lock.New(ssm.New(sess), lockOwner(), opts...)
+}
+
+// lockStack acquires the lock on the stack of the application for the command.
+// The returned function releases the lock, and only warns if it fails to so that it can be deferred.
+func lockStack(locker stackLocker, app, stack, command string) (release func(), err error) {
+	releaseLock, err := locker.Lock(app, stack, command)
+	if err != nil {
+		var errLocked *lock.ErrLocked
+		var errTimeout *lock.ErrWaitTimeout
+		switch {
+		case errors.As(err, &errLocked):
+			log.Infof("Run the command again with --%s to wait for the lock, or %s if the lock is stale.\n",
+				waitForLockFlag, color.HighlightCode(fmt.Sprintf("copilot app locks -n %s --%s %s", app, releaseLockFlag, stack)))
+		case errors.As(err, &errTimeout):
+			log.Infof("Run the command again with a longer --%s, or %s if the lock is stale.\n",
+				lockTimeoutFlag, color.HighlightCode(fmt.Sprintf("copilot app locks -n %s --%s %s", app, releaseLockFlag, stack)))
+		}
+		return nil, err
+	}
+	return func() {
+		if err := releaseLock(); err != nil {
+			log.Warningf("Failed to release the lock on stack %s: %v\n", stack, err)
+		}
+	}, nil
+}
+
+// lockOwner returns who the locks acquired by this process are attributed to, such as "alice@laptop".
+func lockOwner() string {
+	if id := os.Getenv("CODEBUILD_BUILD_ID"); id != "" {
+		return id
+	}
+	name := "unknown"
+	if u, err := user.Current(); err == nil {
+		name = u.Username
+	}
+	host, err := os.Hostname()
+	if err != nil {
+		return name
+	}
+	return fmt.Sprintf("%s@%s", name, host)
+}
@@ -0,0 +1,187 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/telemetry"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultStatsSince = 30 * 24 * time.Hour
+
+	statsTableMinCellWidth     = 10  // minimum number of characters in a table's cell.
+	statsTableTabWidth         = 4   // number of characters in between columns.
+	statsTableCellPaddingWidth = 2   // number of padding characters added by default to a cell.
+	statsTablePaddingChar      = ' ' // character in between columns.
+)
+
+type showStatsVars struct {
+	since            time.Duration
+	shouldOutputJSON bool
+}
+
+type showStatsOpts struct {
+	showStatsVars
+
+	telemetry telemetryStore
+	now       func() time.Time
+	w         io.Writer
+}
+
+func newShowStatsOpts(vars showStatsVars) *showStatsOpts {
+	return &showStatsOpts{
+		showStatsVars: vars,
+		telemetry:     telemetry.New(),
+		now:           time.Now,
+		w:             os.Stdout,
+	}
+}
+
+// Validate returns an error if the duration isn't positive.
+func (o *showStatsOpts) Validate() error {
+	if o.since <= 0 {
+		return fmt.Errorf("--%s must be a positive duration like 24h", sinceFlag)
+	}
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *showStatsOpts) Ask() error {
+	return nil
+}
+
+// Execute shows the number of runs, failures and durations of each command recorded since the duration.
+func (o *showStatsOpts) Execute() error {
+	settings, err := o.telemetry.Settings()
+	if err != nil {
+		return err
+	}
+	if !settings.Enabled {
+		log.Infoln("Telemetry is disabled. Run `copilot stats enable` to record how long your commands take.")
+	}
+	events, err := o.telemetry.Events()
+	if err != nil {
+		return err
+	}
+	stats := telemetry.Summarize(events, o.now().Add(-o.since))
+	if o.shouldOutputJSON {
+		data, err := json.Marshal(struct {
+			Commands []telemetry.CommandStats `json:"commands"`
+		}{
+			Commands: stats,
+		})
+		if err != nil {
+			return fmt.Errorf("marshal stats: %w", err)
+		}
+		fmt.Fprintf(o.w, "%s\n", data)
+		return nil
+	}
+	if len(stats) == 0 {
+		log.Infof("No commands were recorded in the last %s.\n", o.since)
+		return nil
+	}
+	o.humanOutput(stats)
+	return nil
+}
+
+func (o *showStatsOpts) humanOutput(stats []telemetry.CommandStats) {
+	writer := tabwriter.NewWriter(o.w, statsTableMinCellWidth, statsTableTabWidth, statsTableCellPaddingWidth, statsTablePaddingChar, 0)
+	fmt.Fprint(writer, color.Bold.Sprint("Commands\n\n"))
+	writer.Flush()
+	headers := []string{"Command", "Runs", "Failures", "Median", "P90", "Max", "Top Failure"}
+	underlines := make([]string, len(headers))
+	for i, header := range headers {
+		underlines[i] = strings.Repeat("-", len(header))
+	}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underlines, "\t"))
+	for _, s := range stats {
+		row := []string{
+			s.Command,
+			strconv.Itoa(s.Runs),
+			strconv.Itoa(s.FailureCount()),
+			formatMS(s.MedianMS),
+			formatMS(s.P90MS),
+			formatMS(s.MaxMS),
+			topFailure(s.Failures),
+		}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(row, "\t"))
+	}
+	writer.Flush()
+}
+
+// formatMS formats milliseconds as a duration, rounded to a tenth of a second.
+func formatMS(ms int64) string {
+	d := time.Duration(ms) * time.Millisecond
+	if d < time.Second {
+		return d.String()
+	}
+	return d.Round(100 * time.Millisecond).String()
+}
+
+// topFailure returns the most frequent error code, or "-" if there are no failures.
+func topFailure(failures map[string]int) string {
+	codes := make([]string, 0, len(failures))
+	for code := range failures {
+		codes = append(codes, code)
+	}
+	if len(codes) == 0 {
+		return "-"
+	}
+	sort.Slice(codes, func(i, j int) bool {
+		if failures[codes[i]] != failures[codes[j]] {
+			return failures[codes[i]] > failures[codes[j]]
+		}
+		return codes[i] < codes[j]
+	})
+	return codes[0]
+}
+
+// BuildStatsCmd builds the top level command to show the telemetry recorded on this machine.
+func BuildStatsCmd() *cobra.Command {
+	vars := showStatsVars{}
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Shows how long your commands take and how they fail.",
+		Long: `Shows how long your commands take and how they fail, from the telemetry recorded on this machine.
+Telemetry is opt-in: enable it with "copilot stats enable". Copilot then records the command, the names of the flags
+that were set, the duration, and the error code of each run in your config directory, and sends them to the endpoint
+you configure, if any. Names of resources and values of flags are never recorded, and nothing is sent to AWS.`,
+		Example: `
+  Shows the stats of the commands run in the last 30 days.
+  /code $ copilot stats
+  Shows the stats of the commands run in the last week, in JSON.
+  /code $ copilot stats --since 168h --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return run(newShowStatsOpts(vars))
+		}),
+	}
+	cmd.Flags().DurationVar(&vars.since, sinceFlag, defaultStatsSince, statsSinceFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+
+	cmd.AddCommand(buildStatsEnableCmd())
+	cmd.AddCommand(buildStatsDisableCmd())
+	cmd.AddCommand(buildStatsClearCmd())
+
+	cmd.SetUsageTemplate(template.Usage)
+	cmd.Annotations = map[string]string{
+		"group": group.Settings,
+	}
+	return cmd
+}
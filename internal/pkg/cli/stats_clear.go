@@ -0,0 +1,52 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/telemetry"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+type clearStatsOpts struct {
+	telemetry telemetryStore
+}
+
+// Validate is a no-op for this command.
+func (o *clearStatsOpts) Validate() error {
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *clearStatsOpts) Ask() error {
+	return nil
+}
+
+// Execute removes the events recorded on this machine.
+func (o *clearStatsOpts) Execute() error {
+	if err := o.telemetry.Clear(); err != nil {
+		return fmt.Errorf("clear telemetry events: %w", err)
+	}
+	log.Successln("Removed the events recorded on this machine.")
+	return nil
+}
+
+// buildStatsClearCmd builds the command to remove the recorded events.
+func buildStatsClearCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear",
+		Short: "Remove the events recorded on this machine.",
+		Long: `Remove the events recorded on this machine.
+Events that were already sent to an endpoint are not affected.`,
+		Example: `
+  Removes the recorded events.
+  /code $ copilot stats clear`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return run(&clearStatsOpts{telemetry: telemetry.New()})
+		}),
+	}
+	return cmd
+}
@@ -0,0 +1,117 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/aws/copilot-cli/internal/pkg/telemetry"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+type enableStatsOpts struct {
+	endpoint  string
+	telemetry telemetryStore
+}
+
+// Validate returns an error if the endpoint isn't an HTTP or HTTPS URL.
+func (o *enableStatsOpts) Validate() error {
+	if o.endpoint == "" {
+		return nil
+	}
+	u, err := url.Parse(o.endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("--%s must be an http or https URL", endpointFlag)
+	}
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *enableStatsOpts) Ask() error {
+	return nil
+}
+
+// Execute turns on telemetry, keeping the identifier of the installation if it was enabled before.
+func (o *enableStatsOpts) Execute() error {
+	settings, err := o.telemetry.Settings()
+	if err != nil {
+		return err
+	}
+	settings.Enabled = true
+	settings.Endpoint = o.endpoint
+	if err := o.telemetry.SetSettings(settings); err != nil {
+		return fmt.Errorf("enable telemetry: %w", err)
+	}
+	if o.endpoint != "" {
+		log.Successf("Enabled telemetry. Events are recorded on this machine and sent to %s.\n", o.endpoint)
+		return nil
+	}
+	log.Successln("Enabled telemetry. Events are recorded on this machine only.")
+	return nil
+}
+
+type disableStatsOpts struct {
+	telemetry telemetryStore
+}
+
+// Validate is a no-op for this command.
+func (o *disableStatsOpts) Validate() error {
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *disableStatsOpts) Ask() error {
+	return nil
+}
+
+// Execute turns off telemetry. The events that were recorded are kept until they're cleared.
+func (o *disableStatsOpts) Execute() error {
+	settings, err := o.telemetry.Settings()
+	if err != nil {
+		return err
+	}
+	settings.Enabled = false
+	if err := o.telemetry.SetSettings(settings); err != nil {
+		return fmt.Errorf("disable telemetry: %w", err)
+	}
+	log.Successln("Disabled telemetry. Run `copilot stats clear` to remove the events recorded so far.")
+	return nil
+}
+
+// buildStatsEnableCmd builds the command to opt in to telemetry.
+func buildStatsEnableCmd() *cobra.Command {
+	opts := &enableStatsOpts{}
+	cmd := &cobra.Command{
+		Use:   "enable",
+		Short: "Record how long your commands take and how they fail.",
+		Example: `
+  Records the commands on this machine.
+  /code $ copilot stats enable
+  Also sends each event to your own collector.
+  /code $ copilot stats enable --endpoint https://metrics.example.com/copilot`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts.telemetry = telemetry.New()
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVar(&opts.endpoint, endpointFlag, "", statsEndpointFlagDescription)
+	return cmd
+}
+
+// buildStatsDisableCmd builds the command to opt out of telemetry.
+func buildStatsDisableCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable",
+		Short: "Stop recording your commands.",
+		Example: `
+  Stops recording the commands.
+  /code $ copilot stats disable`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			return run(&disableStatsOpts{telemetry: telemetry.New()})
+		}),
+	}
+	return cmd
+}
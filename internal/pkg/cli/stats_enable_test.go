@@ -0,0 +1,98 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/telemetry"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnableStatsOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		endpoint  string
+		wantedErr string
+	}{
+		"no endpoint":    {},
+		"https endpoint": {endpoint: "https://metrics.example.com/copilot"},
+		"not a URL": {
+			endpoint:  "metrics.example.com",
+			wantedErr: "--endpoint must be an http or https URL",
+		},
+		"other scheme": {
+			endpoint:  "ftp://metrics.example.com",
+			wantedErr: "--endpoint must be an http or https URL",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := (&enableStatsOpts{endpoint: tc.endpoint}).Validate()
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestEnableStatsOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		endpoint string
+		current  telemetry.Settings
+		setErr   error
+
+		wanted    telemetry.Settings
+		wantedErr string
+	}{
+		"keeps the identifier of the installation": {
+			endpoint: "https://metrics.example.com/copilot",
+			current:  telemetry.Settings{ID: "abc"},
+			wanted:   telemetry.Settings{Enabled: true, Endpoint: "https://metrics.example.com/copilot", ID: "abc"},
+		},
+		"removes the endpoint if none is given": {
+			current: telemetry.Settings{Endpoint: "https://metrics.example.com/copilot", ID: "abc"},
+			wanted:  telemetry.Settings{Enabled: true, ID: "abc"},
+		},
+		"wraps the error": {
+			setErr:    errors.New("read-only file system"),
+			wanted:    telemetry.Settings{Enabled: true},
+			wantedErr: "enable telemetry: read-only file system",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMocktelemetryStore(ctrl)
+			store.EXPECT().Settings().Return(tc.current, nil)
+			store.EXPECT().SetSettings(tc.wanted).Return(tc.setErr)
+			opts := &enableStatsOpts{endpoint: tc.endpoint, telemetry: store}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDisableStatsOpts_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mocks.NewMocktelemetryStore(ctrl)
+	store.EXPECT().Settings().Return(telemetry.Settings{Enabled: true, Endpoint: "https://metrics.example.com/copilot", ID: "abc"}, nil)
+	store.EXPECT().SetSettings(telemetry.Settings{Endpoint: "https://metrics.example.com/copilot", ID: "abc"}).Return(nil)
+
+	err := (&disableStatsOpts{telemetry: store}).Execute()
+
+	require.NoError(t, err)
+}
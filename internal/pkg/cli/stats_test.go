@@ -0,0 +1,86 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/telemetry"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShowStatsOpts_Validate(t *testing.T) {
+	require.NoError(t, (&showStatsOpts{showStatsVars: showStatsVars{since: time.Hour}}).Validate())
+	require.EqualError(t, (&showStatsOpts{}).Validate(), "--since must be a positive duration like 24h")
+}
+
+func TestShowStatsOpts_Execute(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	events := []telemetry.Event{
+		{Time: now.Add(-time.Hour), Command: "svc deploy", DurationMS: 95000, Result: telemetry.ResultSuccess},
+		{Time: now.Add(-time.Hour), Command: "svc deploy", DurationMS: 182340, Result: telemetry.ResultFailure, Failure: "stack-failed"},
+		{Time: now.Add(-2 * time.Hour), Command: "svc ls", DurationMS: 850, Result: telemetry.ResultSuccess},
+		{Time: now.Add(-72 * time.Hour), Command: "env deploy", DurationMS: 300000, Result: telemetry.ResultSuccess},
+	}
+	testCases := map[string]struct {
+		json      bool
+		eventsErr error
+
+		wanted    string
+		wantedErr string
+	}{
+		"table": {
+			wanted: "Commands\n\n" +
+				"  Command     Runs      Failures  Median    P90       Max       Top Failure\n" +
+				"  -------     ----      --------  ------    ---       ---       -----------\n" +
+				"  svc deploy  2         1         1m35s     3m2.3s    3m2.3s    stack-failed\n" +
+				"  svc ls      1         0         850ms     850ms     850ms     -\n",
+		},
+		"json": {
+			json: true,
+			wanted: `{"commands":[{"command":"svc deploy","runs":2,"failures":{"stack-failed":1},"medianMs":95000,"p90Ms":182340,"maxMs":182340},` +
+				`{"command":"svc ls","runs":1,"medianMs":850,"p90Ms":850,"maxMs":850}]}` + "\n",
+		},
+		"error reading the events": {
+			eventsErr: errors.New("permission denied"),
+			wantedErr: "permission denied",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMocktelemetryStore(ctrl)
+			store.EXPECT().Settings().Return(telemetry.Settings{Enabled: true}, nil)
+			store.EXPECT().Events().Return(events, tc.eventsErr)
+			b := &strings.Builder{}
+			opts := &showStatsOpts{
+				showStatsVars: showStatsVars{since: 24 * time.Hour, shouldOutputJSON: tc.json},
+				telemetry:     store,
+				now:           func() time.Time { return now },
+				w:             b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, b.String())
+		})
+	}
+}
+
+func TestTopFailure(t *testing.T) {
+	require.Equal(t, "-", topFailure(nil))
+	require.Equal(t, "stack-failed", topFailure(map[string]int{"unknown": 1, "stack-failed": 3}))
+	require.Equal(t, "stack-failed", topFailure(map[string]int{"unknown": 2, "stack-failed": 2}))
+}
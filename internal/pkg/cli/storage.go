@@ -4,21 +4,43 @@
 package cli
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/dynamodb"
+	"github.com/aws/copilot-cli/internal/pkg/aws/efs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/rds"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/spf13/cobra"
 )
 
+const (
+	// fmtEFSFileSystemName is the name tag of the file systems created by "storage init".
+	fmtEFSFileSystemName = "copilot-%s-%s-%s"
+
+	// Aurora clusters created by "storage init" have the logical ID <name>DBCluster, where the name is stripped of non-alphanumeric characters.
+	cfnLogicalIDTagKey           = "aws:cloudformation:logical-id"
+	auroraClusterLogicalIDSuffix = "DBCluster"
+)
+
 // BuildStorageCmd is the top level command for storage
 func BuildStorageCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "storage",
 		Short: "Commands for working with storage and databases.",
 		Long: `Commands for working with storage and databases.
-Augment your services with S3 buckets, NoSQL and SQL databases.`,
+Augment your services with S3 buckets, NoSQL and SQL databases, and EFS file systems.`,
 	}
 
 	cmd.AddCommand(buildStorageInitCmd())
+	cmd.AddCommand(buildStorageListCmd())
+	cmd.AddCommand(buildStorageShowCmd())
+	cmd.AddCommand(buildStorageDeleteCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
@@ -27,3 +49,187 @@ Augment your services with S3 buckets, NoSQL and SQL databases.`,
 	}
 	return cmd
 }
+
+// envFileSystem is an EFS file system created by "storage init" in an environment.
+type envFileSystem struct {
+	Name                 string `json:"name"`
+	Environment          string `json:"environment"`
+	ID                   string `json:"id"`
+	LifeCycleState       string `json:"lifeCycleState"`
+	ThroughputMode       string `json:"throughputMode"`
+	SizeInBytes          int64  `json:"sizeInBytes"`
+	NumberOfMountTargets int64  `json:"numberOfMountTargets"`
+}
+
+// envTable is a DynamoDB table created by "storage init" for an environment or for a workload in an environment.
+type envTable struct {
+	Name        string `json:"name"`
+	Environment string `json:"environment"`
+	Workload    string `json:"workload,omitempty"`
+	TableName   string `json:"tableName"`
+}
+
+// envCluster is an Aurora Serverless cluster created by "storage init" for an environment or for a workload in an environment.
+type envCluster struct {
+	Name           string  `json:"name"`
+	Environment    string  `json:"environment"`
+	Workload       string  `json:"workload,omitempty"`
+	ID             string  `json:"id"`
+	Status         string  `json:"status"`
+	Engine         string  `json:"engine"`
+	EngineVersion  string  `json:"engineVersion"`
+	Endpoint       string  `json:"endpoint"`
+	ReaderEndpoint string  `json:"readerEndpoint"`
+	Port           int64   `json:"port"`
+	MinCapacity    float64 `json:"minCapacity"`
+	MaxCapacity    float64 `json:"maxCapacity"`
+}
+
+// envStorageClients holds the clients to describe the storage resources of an environment.
+type envStorageClients struct {
+	efs       efsDescriber
+	resources resourcesByTagsGetter
+	dynamoDB  dynamoDBTableDescriber
+	rds       rdsClusterLister
+}
+
+type newEnvStorageClientsFunc func(env *config.Environment) (*envStorageClients, error)
+
+// newEnvStorageClients returns a function that creates the clients with the environment manager role.
+func newEnvStorageClients(sessProvider sessionFromRoleProvider) newEnvStorageClientsFunc {
+	return func(env *config.Environment) (*envStorageClients, error) {
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		return &envStorageClients{
+			efs:       efs.New(sess),
+			resources: resourcegroups.New(sess),
+			dynamoDB:  dynamodb.New(sess),
+			rds:       rds.New(sess),
+		}, nil
+	}
+}
+
+// storageClientsOfEnvs returns the clients of each of the environments keyed by the name of the environment.
+func storageClientsOfEnvs(envs []*config.Environment, newClients newEnvStorageClientsFunc) (map[string]*envStorageClients, error) {
+	clients := make(map[string]*envStorageClients, len(envs))
+	for _, env := range envs {
+		c, err := newClients(env)
+		if err != nil {
+			return nil, err
+		}
+		clients[env.Name] = c
+	}
+	return clients, nil
+}
+
+// listFileSystemsInEnvs returns the file systems created by "storage init" in each of the environments sorted by name.
+func listFileSystemsInEnvs(appName string, envs []*config.Environment, clients map[string]*envStorageClients) ([]*envFileSystem, error) {
+	var fileSystems []*envFileSystem
+	for _, env := range envs {
+		out, err := clients[env.Name].efs.ListFileSystems(map[string]string{
+			deploy.AppTagKey: appName,
+			deploy.EnvTagKey: env.Name,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list file systems of environment %s: %w", env.Name, err)
+		}
+		prefix := fmt.Sprintf(fmtEFSFileSystemName, appName, env.Name, "")
+		for _, fs := range out {
+			if !strings.HasPrefix(fs.Name, prefix) {
+				continue // Managed by a service manifest instead of "storage init".
+			}
+			fileSystems = append(fileSystems, &envFileSystem{
+				Name:                 strings.TrimPrefix(fs.Name, prefix),
+				Environment:          env.Name,
+				ID:                   fs.ID,
+				LifeCycleState:       fs.LifeCycleState,
+				ThroughputMode:       fs.ThroughputMode,
+				SizeInBytes:          fs.SizeInBytes,
+				NumberOfMountTargets: fs.NumberOfMountTargets,
+			})
+		}
+	}
+	sort.SliceStable(fileSystems, func(i, j int) bool {
+		return fileSystems[i].Name < fileSystems[j].Name
+	})
+	return fileSystems, nil
+}
+
+// listTablesInEnvs returns the DynamoDB tables created by "storage init" in each of the environments sorted by name.
+func listTablesInEnvs(appName string, envs []*config.Environment, clients map[string]*envStorageClients) ([]*envTable, error) {
+	var tables []*envTable
+	for _, env := range envs {
+		out, err := clients[env.Name].resources.GetResourcesByTags(dynamodb.ResourceTypeTable, map[string]string{
+			deploy.AppTagKey: appName,
+			deploy.EnvTagKey: env.Name,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list DynamoDB tables of environment %s: %w", env.Name, err)
+		}
+		for _, resource := range out {
+			// The ARN of a table is of the form arn:aws:dynamodb:us-west-2:123456789012:table/<app>-<env>[-<workload>]-<name>.
+			_, tableName, found := strings.Cut(resource.ARN, ":table/")
+			if !found {
+				continue
+			}
+			wkld := resource.Tags[deploy.ServiceTagKey]
+			prefix := fmt.Sprintf("%s-%s-", appName, env.Name)
+			if wkld != "" {
+				prefix = fmt.Sprintf("%s%s-", prefix, wkld)
+			}
+			if !strings.HasPrefix(tableName, prefix) {
+				continue // Not created by "storage init".
+			}
+			tables = append(tables, &envTable{
+				Name:        strings.TrimPrefix(tableName, prefix),
+				Environment: env.Name,
+				Workload:    wkld,
+				TableName:   tableName,
+			})
+		}
+	}
+	sort.SliceStable(tables, func(i, j int) bool {
+		return tables[i].Name < tables[j].Name
+	})
+	return tables, nil
+}
+
+// listClustersInEnvs returns the Aurora Serverless clusters created by "storage init" in each of the environments sorted by name.
+func listClustersInEnvs(appName string, envs []*config.Environment, clients map[string]*envStorageClients) ([]*envCluster, error) {
+	var clusters []*envCluster
+	for _, env := range envs {
+		out, err := clients[env.Name].rds.ListClusters(map[string]string{
+			deploy.AppTagKey: appName,
+			deploy.EnvTagKey: env.Name,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list Aurora clusters of environment %s: %w", env.Name, err)
+		}
+		for _, c := range out {
+			logicalID := c.Tags[cfnLogicalIDTagKey]
+			if !strings.HasSuffix(logicalID, auroraClusterLogicalIDSuffix) {
+				continue // Not created by "storage init".
+			}
+			clusters = append(clusters, &envCluster{
+				Name:           strings.TrimSuffix(logicalID, auroraClusterLogicalIDSuffix),
+				Environment:    env.Name,
+				Workload:       c.Tags[deploy.ServiceTagKey],
+				ID:             c.ID,
+				Status:         c.Status,
+				Engine:         c.Engine,
+				EngineVersion:  c.EngineVersion,
+				Endpoint:       c.Endpoint,
+				ReaderEndpoint: c.ReaderEndpoint,
+				Port:           c.Port,
+				MinCapacity:    c.MinCapacity,
+				MaxCapacity:    c.MaxCapacity,
+			})
+		}
+	}
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return clusters[i].Name < clusters[j].Name
+	})
+	return clusters, nil
+}
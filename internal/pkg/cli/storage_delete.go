@@ -0,0 +1,295 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+)
+
+const (
+	storageDeleteNamePrompt = "Which storage resource would you like to delete?"
+	storageDeleteNameHelper = "The addon files of the storage resource will be removed from your workspace."
+
+	fmtStorageDeleteConfirmPrompt         = "Are you sure you want to delete storage %s from your environments?"
+	storageDeleteConfirmHelp              = "The data of the storage resource is deleted the next time your environments are deployed."
+	fmtStorageDeleteWorkloadConfirmPrompt = "Are you sure you want to delete storage %s from workload %s?"
+	storageDeleteWorkloadConfirmHelp      = "The data of the storage resource is deleted the next time your workload is deployed."
+
+	// The addon files of a workload that grant it access to the DynamoDB table or the Aurora cluster of the environments.
+	fmtStorageAccessPolicyFileName = "%s-access-policy.yml"
+	fmtStorageIngressFileName      = "%s-ingress.yml"
+)
+
+var (
+	errStorageDeleteCancelled = errors.New("storage delete cancelled - no changes made")
+)
+
+type deleteStorageVars struct {
+	name             string
+	workloadName     string
+	skipConfirmation bool
+}
+
+type deleteStorageOpts struct {
+	deleteStorageVars
+
+	ws     wsAddonDeleter
+	prompt prompter
+
+	// Cached files of the environment addons, or of the workload addons if a workload is provided.
+	addonFiles []string
+
+	// Workloads whose access to the storage resource of the environments was removed.
+	updatedWorkloads []string
+}
+
+func newDeleteStorageOpts(vars deleteStorageVars) (*deleteStorageOpts, error) {
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	return &deleteStorageOpts{
+		deleteStorageVars: vars,
+		ws:                ws,
+		prompt:            prompt.New(),
+	}, nil
+}
+
+// Ask prompts for the name of the storage resource and confirms the deletion.
+func (o *deleteStorageOpts) Ask() error {
+	if err := o.askStorageName(); err != nil {
+		return err
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+	msg, help := fmt.Sprintf(fmtStorageDeleteConfirmPrompt, color.HighlightUserInput(o.name)), storageDeleteConfirmHelp
+	if o.workloadName != "" {
+		msg, help = fmt.Sprintf(fmtStorageDeleteWorkloadConfirmPrompt, color.HighlightUserInput(o.name), color.HighlightUserInput(o.workloadName)), storageDeleteWorkloadConfirmHelp
+	}
+	confirmed, err := o.prompt.Confirm(msg, help, prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("confirm to delete storage %s: %w", o.name, err)
+	}
+	if !confirmed {
+		return errStorageDeleteCancelled
+	}
+	return nil
+}
+
+// Execute removes the template of the storage resource from the addons of the environments or of the workload.
+// The access points of a file system and the access of the workloads to a storage resource of the environments are removed too.
+func (o *deleteStorageOpts) Execute() error {
+	if err := o.loadAddonFiles(); err != nil {
+		return err
+	}
+	if o.workloadName != "" {
+		return o.deleteWorkloadStorage()
+	}
+	fileNames := storageAddonFiles(o.addonFiles, o.name)
+	if len(fileNames) == 0 {
+		return fmt.Errorf("storage %s not found in %s", o.name, o.ws.EnvAddonsAbsPath())
+	}
+	for _, fName := range fileNames {
+		if err := o.ws.DeleteEnvAddonFile(fName); err != nil {
+			return fmt.Errorf("delete addon file %s: %w", fName, err)
+		}
+		log.Successf("Deleted %s.\n", color.HighlightResource(filepath.Join(o.ws.EnvAddonsAbsPath(), fName)))
+	}
+	return o.deleteWorkloadAccess()
+}
+
+// RecommendActions logs the follow-up actions to delete the storage resource from the environments or the workload.
+func (o *deleteStorageOpts) RecommendActions() error {
+	if o.workloadName != "" {
+		logRecommendedActions([]string{
+			fmt.Sprintf("Run %s to delete %s from your workload.", color.HighlightCode(fmt.Sprintf("copilot deploy --name %s", o.workloadName)), color.HighlightUserInput(o.name)),
+		})
+		return nil
+	}
+	actions := []string{
+		fmt.Sprintf("Remove the references to %s from the manifests of your workloads, such as under %s.", color.HighlightUserInput(o.name), color.HighlightCode("storage.volumes")),
+	}
+	for _, wkld := range o.updatedWorkloads {
+		actions = append(actions, fmt.Sprintf("Run %s to remove the access of %s to %s.", color.HighlightCode(fmt.Sprintf("copilot deploy --name %s", wkld)), wkld, color.HighlightUserInput(o.name)))
+	}
+	actions = append(actions, fmt.Sprintf("Run %s to delete %s from your environments.", color.HighlightCode("copilot env deploy"), color.HighlightUserInput(o.name)))
+	logRecommendedActions(actions)
+	return nil
+}
+
+func (o *deleteStorageOpts) deleteWorkloadStorage() error {
+	fName := fmt.Sprintf("%s.yml", o.name)
+	var found bool
+	for _, f := range o.addonFiles {
+		if f == fName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("storage %s not found in %s", o.name, o.ws.WorkloadAddonsAbsPath(o.workloadName))
+	}
+	if err := o.ws.DeleteWorkloadAddonFile(o.workloadName, fName); err != nil {
+		return fmt.Errorf("delete addon file %s: %w", fName, err)
+	}
+	log.Successf("Deleted %s.\n", color.HighlightResource(filepath.Join(o.ws.WorkloadAddonsAbsPath(o.workloadName), fName)))
+	return nil
+}
+
+// deleteWorkloadAccess removes the access policies and the ingress rules of the workloads to the storage resource of the environments.
+func (o *deleteStorageOpts) deleteWorkloadAccess() error {
+	wklds, err := o.ws.ListWorkloads()
+	if err != nil {
+		return fmt.Errorf("list workloads: %w", err)
+	}
+	accessFiles := map[string]bool{
+		fmt.Sprintf(fmtStorageAccessPolicyFileName, o.name): true,
+		fmt.Sprintf(fmtStorageIngressFileName, o.name):      true,
+	}
+	for _, wkld := range wklds {
+		files, err := o.ws.ListFiles(o.ws.WorkloadAddonsAbsPath(wkld))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue // The workload has no addons.
+			}
+			return fmt.Errorf("list addon files of workload %s: %w", wkld, err)
+		}
+		var updated bool
+		for _, fName := range files {
+			if !accessFiles[fName] {
+				continue
+			}
+			if err := o.ws.DeleteWorkloadAddonFile(wkld, fName); err != nil {
+				return fmt.Errorf("delete addon file %s of workload %s: %w", fName, wkld, err)
+			}
+			log.Successf("Deleted %s.\n", color.HighlightResource(filepath.Join(o.ws.WorkloadAddonsAbsPath(wkld), fName)))
+			updated = true
+		}
+		if updated {
+			o.updatedWorkloads = append(o.updatedWorkloads, wkld)
+		}
+	}
+	return nil
+}
+
+func (o *deleteStorageOpts) askStorageName() error {
+	if o.name != "" {
+		return nil
+	}
+	if err := o.loadAddonFiles(); err != nil {
+		return err
+	}
+	var names []string
+	for _, fName := range o.addonFiles {
+		if fName == workspace.AddonsParametersFileName || isEFSAccessPointFile(fName) || isWorkloadAccessFile(fName) || filepath.Ext(fName) != ".yml" {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(fName, ".yml"))
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("no storage resources found in %s", o.addonsDir())
+	}
+	name, err := o.prompt.SelectOne(storageDeleteNamePrompt, storageDeleteNameHelper, names, prompt.WithFinalMessage("Storage:"))
+	if err != nil {
+		return fmt.Errorf("select storage: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+func (o *deleteStorageOpts) loadAddonFiles() error {
+	if o.addonFiles != nil {
+		return nil
+	}
+	files, err := o.ws.ListFiles(o.addonsDir())
+	if err != nil {
+		if o.workloadName != "" {
+			return fmt.Errorf("list addon files of workload %s: %w", o.workloadName, err)
+		}
+		return fmt.Errorf("list addon files of environments: %w", err)
+	}
+	o.addonFiles = files
+	return nil
+}
+
+func (o *deleteStorageOpts) addonsDir() string {
+	if o.workloadName != "" {
+		return o.ws.WorkloadAddonsAbsPath(o.workloadName)
+	}
+	return o.ws.EnvAddonsAbsPath()
+}
+
+// storageAddonFiles returns the template of the storage resource followed by the templates of its access points.
+func storageAddonFiles(files []string, name string) []string {
+	var template string
+	var accessPoints []string
+	for _, fName := range files {
+		switch {
+		case fName == fmt.Sprintf("%s.yml", name):
+			template = fName
+		case strings.HasPrefix(fName, name+"-") && isEFSAccessPointFile(fName):
+			accessPoints = append(accessPoints, fName)
+		}
+	}
+	if template == "" {
+		return nil
+	}
+	return append([]string{template}, accessPoints...)
+}
+
+func isEFSAccessPointFile(fName string) bool {
+	return strings.HasSuffix(fName, efsAccessPointFileSuffix)
+}
+
+func isWorkloadAccessFile(fName string) bool {
+	return strings.HasSuffix(fName, fmt.Sprintf(fmtStorageAccessPolicyFileName, "")) || strings.HasSuffix(fName, fmt.Sprintf(fmtStorageIngressFileName, ""))
+}
+
+// buildStorageDeleteCmd builds the command for deleting a storage resource of the environments or of a workload.
+func buildStorageDeleteCmd() *cobra.Command {
+	vars := deleteStorageVars{}
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Deletes a storage resource of your environments or of a workload from the workspace.",
+		Long: `Deletes a storage resource of your environments or of a workload from the workspace.
+The addon files of the storage resource, such as the access points of an EFS file system
+or the access policies of your workloads to a DynamoDB table, are removed.
+The storage resource is deleted the next time you run "copilot env deploy", or "copilot deploy" for a workload.`,
+		Example: `
+  Deletes the uploads file system and its access points.
+  /code $ copilot storage delete -n uploads
+  Deletes the orders table of the api service without confirmation.
+  /code $ copilot storage delete -n orders --workload api --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDeleteStorageOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			if err := opts.Execute(); err != nil {
+				return err
+			}
+			return opts.RecommendActions()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", storageNameFlagDescription)
+	cmd.Flags().StringVar(&vars.workloadName, workloadFlag, "", storageDeleteWorkloadFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,258 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type storageDeleteMocks struct {
+	ws     *mocks.MockwsAddonDeleter
+	prompt *mocks.Mockprompter
+}
+
+func TestDeleteStorageOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName             string
+		inWorkload         string
+		inSkipConfirmation bool
+		setupMocks         func(m storageDeleteMocks)
+
+		wantedName  string
+		wantedError error
+	}{
+		"skip prompting if the name is provided without confirmation": {
+			inName:             "uploads",
+			inSkipConfirmation: true,
+			setupMocks:         func(m storageDeleteMocks) {},
+			wantedName:         "uploads",
+		},
+		"select a storage resource of the environment addons": {
+			inSkipConfirmation: true,
+			setupMocks: func(m storageDeleteMocks) {
+				m.ws.EXPECT().EnvAddonsAbsPath().Return("/copilot/environments/addons")
+				m.ws.EXPECT().ListFiles("/copilot/environments/addons").Return([]string{
+					"addons.parameters.yml",
+					"uploads.yml",
+					"uploads-api-access-point.yml",
+					"bucket.yml",
+					"README.md",
+				}, nil)
+				m.prompt.EXPECT().SelectOne(storageDeleteNamePrompt, storageDeleteNameHelper, []string{"uploads", "bucket"}, gomock.Any()).
+					Return("uploads", nil)
+			},
+			wantedName: "uploads",
+		},
+		"select a storage resource of the workload addons": {
+			inWorkload:         "api",
+			inSkipConfirmation: true,
+			setupMocks: func(m storageDeleteMocks) {
+				m.ws.EXPECT().WorkloadAddonsAbsPath("api").Return("/copilot/api/addons")
+				m.ws.EXPECT().ListFiles("/copilot/api/addons").Return([]string{
+					"orders.yml",
+					"users-access-policy.yml",
+					"db-ingress.yml",
+				}, nil)
+				m.prompt.EXPECT().SelectOne(storageDeleteNamePrompt, storageDeleteNameHelper, []string{"orders"}, gomock.Any()).
+					Return("orders", nil)
+			},
+			wantedName: "orders",
+		},
+		"error if there are no storage resources": {
+			setupMocks: func(m storageDeleteMocks) {
+				m.ws.EXPECT().EnvAddonsAbsPath().Return("/copilot/environments/addons").AnyTimes()
+				m.ws.EXPECT().ListFiles("/copilot/environments/addons").Return([]string{"addons.parameters.yml"}, nil)
+			},
+			wantedError: errors.New("no storage resources found in /copilot/environments/addons"),
+		},
+		"error if fail to list the addon files": {
+			setupMocks: func(m storageDeleteMocks) {
+				m.ws.EXPECT().EnvAddonsAbsPath().Return("/copilot/environments/addons")
+				m.ws.EXPECT().ListFiles("/copilot/environments/addons").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list addon files of environments: some error"),
+		},
+		"error if the deletion is cancelled": {
+			inName: "uploads",
+			setupMocks: func(m storageDeleteMocks) {
+				m.prompt.EXPECT().Confirm(gomock.Any(), storageDeleteConfirmHelp, gomock.Any()).Return(false, nil)
+			},
+			wantedError: errStorageDeleteCancelled,
+		},
+		"confirm the deletion from the workload": {
+			inName:     "orders",
+			inWorkload: "api",
+			setupMocks: func(m storageDeleteMocks) {
+				m.prompt.EXPECT().Confirm(gomock.Any(), storageDeleteWorkloadConfirmHelp, gomock.Any()).Return(true, nil)
+			},
+			wantedName: "orders",
+		},
+		"error if fail to confirm": {
+			inName: "uploads",
+			setupMocks: func(m storageDeleteMocks) {
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, errors.New("some error"))
+			},
+			wantedError: errors.New("confirm to delete storage uploads: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := storageDeleteMocks{
+				ws:     mocks.NewMockwsAddonDeleter(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &deleteStorageOpts{
+				deleteStorageVars: deleteStorageVars{
+					name:             tc.inName,
+					workloadName:     tc.inWorkload,
+					skipConfirmation: tc.inSkipConfirmation,
+				},
+				ws:     m.ws,
+				prompt: m.prompt,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedName, opts.name)
+			}
+		})
+	}
+}
+
+func TestDeleteStorageOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		inWorkload string
+		setupMocks func(m storageDeleteMocks)
+
+		wantedUpdatedWorkloads []string
+		wantedError            error
+	}{
+		"delete the template of the storage resource and its access points": {
+			inName: "uploads",
+			setupMocks: func(m storageDeleteMocks) {
+				m.ws.EXPECT().ListFiles("/copilot/environments/addons").Return([]string{
+					"addons.parameters.yml",
+					"uploads-api-access-point.yml",
+					"uploads.yml",
+					"uploads-worker-access-point.yml",
+					"uploads-archive.yml",
+					"data-api-access-point.yml",
+				}, nil)
+				gomock.InOrder(
+					m.ws.EXPECT().DeleteEnvAddonFile("uploads.yml").Return(nil),
+					m.ws.EXPECT().DeleteEnvAddonFile("uploads-api-access-point.yml").Return(nil),
+					m.ws.EXPECT().DeleteEnvAddonFile("uploads-worker-access-point.yml").Return(nil),
+				)
+				m.ws.EXPECT().ListWorkloads().Return(nil, nil)
+			},
+		},
+		"delete the access policies and ingress rules of the workloads": {
+			inName: "orders",
+			setupMocks: func(m storageDeleteMocks) {
+				m.ws.EXPECT().ListFiles("/copilot/environments/addons").Return([]string{"orders.yml"}, nil)
+				m.ws.EXPECT().DeleteEnvAddonFile("orders.yml").Return(nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api", "worker", "frontend"}, nil)
+				m.ws.EXPECT().WorkloadAddonsAbsPath(gomock.Any()).DoAndReturn(func(name string) string {
+					return "/copilot/" + name + "/addons"
+				}).AnyTimes()
+				m.ws.EXPECT().ListFiles("/copilot/api/addons").Return([]string{"orders-access-policy.yml", "users-access-policy.yml"}, nil)
+				m.ws.EXPECT().ListFiles("/copilot/worker/addons").Return(nil, os.ErrNotExist)
+				m.ws.EXPECT().ListFiles("/copilot/frontend/addons").Return([]string{"orders-ingress.yml"}, nil)
+				m.ws.EXPECT().DeleteWorkloadAddonFile("api", "orders-access-policy.yml").Return(nil)
+				m.ws.EXPECT().DeleteWorkloadAddonFile("frontend", "orders-ingress.yml").Return(nil)
+			},
+			wantedUpdatedWorkloads: []string{"api", "frontend"},
+		},
+		"error if fail to list the addon files of a workload": {
+			inName: "orders",
+			setupMocks: func(m storageDeleteMocks) {
+				m.ws.EXPECT().ListFiles("/copilot/environments/addons").Return([]string{"orders.yml"}, nil)
+				m.ws.EXPECT().DeleteEnvAddonFile("orders.yml").Return(nil)
+				m.ws.EXPECT().ListWorkloads().Return([]string{"api"}, nil)
+				m.ws.EXPECT().WorkloadAddonsAbsPath("api").Return("/copilot/api/addons")
+				m.ws.EXPECT().ListFiles("/copilot/api/addons").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list addon files of workload api: some error"),
+		},
+		"delete the template of the storage resource of the workload": {
+			inName:     "orders",
+			inWorkload: "api",
+			setupMocks: func(m storageDeleteMocks) {
+				m.ws.EXPECT().WorkloadAddonsAbsPath("api").Return("/copilot/api/addons").AnyTimes()
+				m.ws.EXPECT().ListFiles("/copilot/api/addons").Return([]string{"orders.yml", "users-access-policy.yml"}, nil)
+				m.ws.EXPECT().DeleteWorkloadAddonFile("api", "orders.yml").Return(nil)
+			},
+		},
+		"error if the storage resource is not found in the workload": {
+			inName:     "users",
+			inWorkload: "api",
+			setupMocks: func(m storageDeleteMocks) {
+				m.ws.EXPECT().WorkloadAddonsAbsPath("api").Return("/copilot/api/addons").AnyTimes()
+				m.ws.EXPECT().ListFiles("/copilot/api/addons").Return([]string{"orders.yml", "users-access-policy.yml"}, nil)
+			},
+			wantedError: errors.New("storage users not found in /copilot/api/addons"),
+		},
+		"error if the storage resource is not found": {
+			inName: "uploads",
+			setupMocks: func(m storageDeleteMocks) {
+				m.ws.EXPECT().ListFiles("/copilot/environments/addons").Return([]string{
+					"addons.parameters.yml",
+					"uploads-api-access-point.yml",
+				}, nil)
+			},
+			wantedError: errors.New("storage uploads not found in /copilot/environments/addons"),
+		},
+		"error if fail to delete a file": {
+			inName: "uploads",
+			setupMocks: func(m storageDeleteMocks) {
+				m.ws.EXPECT().ListFiles("/copilot/environments/addons").Return([]string{"uploads.yml"}, nil)
+				m.ws.EXPECT().DeleteEnvAddonFile("uploads.yml").Return(errors.New("some error"))
+			},
+			wantedError: errors.New("delete addon file uploads.yml: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := storageDeleteMocks{
+				ws: mocks.NewMockwsAddonDeleter(ctrl),
+			}
+			m.ws.EXPECT().EnvAddonsAbsPath().Return("/copilot/environments/addons").AnyTimes()
+			tc.setupMocks(m)
+			opts := &deleteStorageOpts{
+				deleteStorageVars: deleteStorageVars{
+					name:         tc.inName,
+					workloadName: tc.inWorkload,
+				},
+				ws: m.ws,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedUpdatedWorkloads, opts.updatedWorkloads)
+			}
+		})
+	}
+}
@@ -33,12 +33,16 @@ const (
 	dynamoDBStorageType = "DynamoDB"
 	s3StorageType       = "S3"
 	rdsStorageType      = "Aurora"
+	efsStorageType      = "EFS"
+	redisStorageType    = "Redis"
 )
 
 var storageTypes = []string{
 	dynamoDBStorageType,
 	s3StorageType,
 	rdsStorageType,
+	efsStorageType,
+	redisStorageType,
 }
 
 // Displayed options for storage types
@@ -46,12 +50,16 @@ const (
 	dynamoDBStorageTypeOption = "DynamoDB"
 	s3StorageTypeOption       = "S3"
 	rdsStorageTypeOption      = "Aurora Serverless"
+	efsStorageTypeOption      = "EFS"
+	redisStorageTypeOption    = "ElastiCache (Redis)"
 )
 
 const (
 	s3BucketFriendlyText      = "S3 Bucket"
 	dynamoDBTableFriendlyText = "DynamoDB Table"
 	rdsFriendlyText           = "Database Cluster"
+	efsFriendlyText           = "File System"
+	redisFriendlyText         = "Redis Cluster"
 )
 
 const (
@@ -70,6 +78,8 @@ var (
 DynamoDB is a key-value and document database that delivers single-digit millisecond performance at any scale.
 S3 is a web object store built to store and retrieve any amount of data from anywhere on the Internet.
 Aurora Serverless is an on-demand autoscaling configuration for Amazon Aurora, a MySQL and PostgreSQL-compatible relational database.
+EFS is a serverless, elastic file system that the containers of your services and jobs can share.
+ElastiCache for Redis is a managed, Redis-compatible in-memory data store for caching and real-time workloads.
 `
 
 	fmtStorageInitNamePrompt = "What would you like to " + color.Emphasize("name") + " this %s?"
@@ -100,6 +110,23 @@ partition key but a different sort key. You may specify up to 5 alternate sort k
 
 	storageInitDDBLSINamePrompt = "What would you like to name this " + color.Emphasize("alternate sort key") + "?"
 	storageInitDDBLSINameHelp   = "You can use the characters [a-zA-Z0-9.-_]"
+
+	storageInitDDBGSIPrompt = "Would you like to add any global secondary indexes to this table?"
+	storageInitDDBGSIHelp   = `Global secondary indexes allow you to query the table using a different partition key
+and an optional sort key. You may specify up to 20 global secondary indexes.`
+
+	storageInitDDBMoreGSIPrompt = "Would you like to add more global secondary indexes to this table?"
+
+	storageInitDDBGSISortKeyConfirm = "Would you like to add a sort key to this global secondary index?"
+
+	storageInitDDBTTLConfirm = "Would you like items of this table to expire?"
+	storageInitDDBTTLHelp    = `DynamoDB deletes the items whose time to live attribute, a timestamp in Unix epoch time
+format in seconds, is in the past.`
+	storageInitDDBTTLPrompt = "What is the name of the " + color.Emphasize("time to live attribute") + "?"
+
+	storageInitDDBStreamPrompt = "Would you like to capture the changes to the items of this table in a stream?"
+	storageInitDDBStreamHelp   = `A DynamoDB stream records the modifications of the items of the table for 24 hours,
+so that other services can react to them.`
 )
 
 // DynamoDB specific constants and variables.
@@ -116,10 +143,32 @@ var attributeTypes = []string{
 	ddbBinaryType,
 }
 
+const (
+	maxDDBGSIs = 20
+
+	ddbStreamViewTypeNewAndOldImages = "NEW_AND_OLD_IMAGES"
+	ddbStreamViewTypeNewImage        = "NEW_IMAGE"
+	ddbStreamViewTypeOldImage        = "OLD_IMAGE"
+	ddbStreamViewTypeKeysOnly        = "KEYS_ONLY"
+	ddbStreamNone                    = "None"
+)
+
+var ddbStreamViewTypes = []string{
+	ddbStreamViewTypeNewAndOldImages,
+	ddbStreamViewTypeNewImage,
+	ddbStreamViewTypeOldImage,
+	ddbStreamViewTypeKeysOnly,
+}
+
 // RDS Aurora Serverless specific questions and help prompts.
 var (
 	storageInitRDSInitialDBNamePrompt = "What would you like to name the initial database in your cluster?"
 	storageInitRDSDBEnginePrompt      = "Which database engine would you like to use?"
+	storageInitRDSMinCapacityPrompt   = "What is the " + color.Emphasize("minimum capacity") + " of your cluster in ACUs?"
+	storageInitRDSMaxCapacityPrompt   = "What is the " + color.Emphasize("maximum capacity") + " of your cluster in ACUs?"
+	storageInitRDSCapacityHelp        = `Aurora Serverless v2 scales the capacity of the cluster between the minimum and maximum
+Aurora capacity units (ACUs). Each ACU provides about 2 GiB of memory. The capacity must be between 0.5 and 128
+in increments of 0.5.`
 )
 
 // RDS Aurora Serverless specific constants and variables.
@@ -132,6 +181,12 @@ const (
 
 	engineTypeMySQL      = addon.RDSEngineTypeMySQL
 	engineTypePostgreSQL = addon.RDSEngineTypePostgreSQL
+
+	// Capacity of an Aurora Serverless v2 cluster in ACUs.
+	minAuroraCapacity        = 0.5
+	maxAuroraCapacity        = 128
+	defaultAuroraMinCapacity = 0.5
+	defaultAuroraMaxCapacity = 8
 )
 
 var auroraServerlessVersions = []string{
@@ -144,6 +199,26 @@ var engineTypes = []string{
 	engineTypePostgreSQL,
 }
 
+// EFS specific constants and variables.
+const (
+	efsThroughputModeBursting    = "bursting"
+	efsThroughputModeElastic     = "elastic"
+	defaultEFSThroughputMode     = efsThroughputModeBursting
+	defaultEFSTransitionToIADays = 30
+
+	// The POSIX user and group of the files created through the access point of a workload.
+	defaultEFSAccessPointPosixID = 1000
+)
+
+var efsThroughputModes = []string{
+	efsThroughputModeBursting,
+	efsThroughputModeElastic,
+}
+
+// efsTransitionToIADays are the numbers of days since the last access after which EFS can move files to
+// the Infrequent Access storage class.
+var efsTransitionToIADays = []int{1, 7, 14, 30, 60, 90, 180, 270, 365}
+
 const workloadTypeNonLocal = "Non Local"
 
 const (
@@ -151,6 +226,12 @@ const (
 	blobDescriptionTemplate   = "template"
 )
 
+const (
+	// fmtEFSAccessPointFileName is the name of the environment addon file for the access point of a workload to a file system.
+	fmtEFSAccessPointFileName = "%s-%s" + efsAccessPointFileSuffix
+	efsAccessPointFileSuffix  = "-access-point.yml"
+)
+
 type initStorageVars struct {
 	storageType    string
 	storageName    string
@@ -159,17 +240,28 @@ type initStorageVars struct {
 	addIngressFrom string
 
 	// Dynamo DB specific values collected via flags or prompts
-	partitionKey string
-	sortKey      string
-	lsiSorts     []string // lsi sort keys collected as "name:T" where T is one of [SNB]
-	noLSI        bool
-	noSort       bool
+	partitionKey   string
+	sortKey        string
+	lsiSorts       []string // lsi sort keys collected as "name:T" where T is one of [SNB]
+	noLSI          bool
+	noSort         bool
+	gsis           []string // gsi keys collected as "name:T" or "name:T,name:T" where T is one of [SNB]
+	noGSI          bool
+	ttlAttribute   string
+	streamViewType string
 
 	// RDS Aurora Serverless specific values collected via flags or prompts
 	auroraServerlessVersion string
 	rdsEngine               string
 	rdsParameterGroup       string
 	rdsInitialDBName        string
+	rdsMinCapacity          float64
+	rdsMaxCapacity          float64
+
+	// EFS specific values collected via flags.
+	efsThroughputMode     string
+	efsTransitionToIADays int
+	efsNoBackup           bool
 }
 
 type initStorageOpts struct {
@@ -235,11 +327,43 @@ func (o *initStorageOpts) Validate() error {
 	if o.noSort && len(o.lsiSorts) != 0 {
 		return fmt.Errorf("validate LSI configuration: cannot specify --no-sort and --lsi options at once")
 	}
+	// --no-gsi and --gsi are mutually exclusive.
+	if o.noGSI && len(o.gsis) != 0 {
+		return fmt.Errorf("validate GSI configuration: cannot specify --no-gsi and --gsi options at once")
+	}
+	if len(o.gsis) != 0 {
+		if err := validateGSIs(o.gsis); err != nil {
+			return fmt.Errorf("validate GSI configuration: %w", err)
+		}
+	}
+	if o.ttlAttribute != "" {
+		if err := dynamoAttributeNameValidation(o.ttlAttribute); err != nil {
+			return fmt.Errorf("validate TTL attribute: %w", err)
+		}
+	}
+	if o.streamViewType != "" {
+		if err := validateDDBStreamViewType(o.streamViewType); err != nil {
+			return err
+		}
+	}
 	if o.auroraServerlessVersion != "" {
 		if err := o.validateServerlessVersion(); err != nil {
 			return err
 		}
 	}
+	if o.rdsMinCapacity != 0 || o.rdsMaxCapacity != 0 {
+		if err := o.validateAuroraCapacityRange(); err != nil {
+			return err
+		}
+	}
+	if o.efsThroughputMode != "" && !contains(o.efsThroughputMode, efsThroughputModes) {
+		return fmt.Errorf("invalid EFS throughput mode %s: must be one of %s", o.efsThroughputMode, prettify(efsThroughputModes))
+	}
+	if o.efsTransitionToIADays != 0 {
+		if err := o.validateEFSTransitionToIA(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -256,6 +380,10 @@ func (o *initStorageOpts) validateAddIngressFrom() error {
 	if o.storageType == "" {
 		return fmt.Errorf("--%s is required when --%s is used", storageTypeFlag, storageAddIngressFromFlag)
 	}
+	if o.storageType == efsStorageType {
+		return fmt.Errorf("--%s cannot be used with storage type %s: run %s with --%s in the workspace where environments are managed instead",
+			storageAddIngressFromFlag, efsStorageType, color.HighlightCode("copilot storage init"), workloadFlag)
+	}
 	exist, err := o.ws.WorkloadExists(o.addIngressFrom)
 	if err != nil {
 		return fmt.Errorf("check if %s exists in the workspace: %w", o.addIngressFrom, err)
@@ -277,6 +405,49 @@ func (o *initStorageOpts) validateServerlessVersion() error {
 	return fmt.Errorf(fmtErrInvalidServerlessVersion, o.auroraServerlessVersion, prettify(auroraServerlessVersions))
 }
 
+func (o *initStorageOpts) validateAuroraCapacityRange() error {
+	if o.auroraServerlessVersion == auroraServerlessVersionV1 {
+		return fmt.Errorf("--%s and --%s can only be used with Aurora Serverless %s",
+			storageRDSMinCapacityFlag, storageRDSMaxCapacityFlag, auroraServerlessVersionV2)
+	}
+	for _, capacity := range []float64{o.rdsMinCapacity, o.rdsMaxCapacity} {
+		if capacity == 0 {
+			continue
+		}
+		if err := validateAuroraCapacity(capacity); err != nil {
+			return fmt.Errorf("validate capacity range: %w", err)
+		}
+	}
+	return validateAuroraMinMaxCapacity(o.rdsMinCapacity, o.rdsMaxCapacity)
+}
+
+// validateAuroraMinMaxCapacity returns an error if the minimum capacity is greater than the maximum capacity.
+// A zero capacity stands for the default value.
+func validateAuroraMinMaxCapacity(min, max float64) error {
+	if min == 0 {
+		min = defaultAuroraMinCapacity
+	}
+	if max == 0 {
+		max = defaultAuroraMaxCapacity
+	}
+	if min > max {
+		return fmt.Errorf("validate capacity range: minimum capacity %v must be less than or equal to maximum capacity %v", min, max)
+	}
+	return nil
+}
+
+func (o *initStorageOpts) validateEFSTransitionToIA() error {
+	valid := make([]string, len(efsTransitionToIADays))
+	for i, days := range efsTransitionToIADays {
+		if o.efsTransitionToIADays == days {
+			return nil
+		}
+		valid[i] = strconv.Itoa(days)
+	}
+	return fmt.Errorf("invalid number of days %d to transition files to EFS Infrequent Access: must be one of %s",
+		o.efsTransitionToIADays, english.OxfordWordSeries(valid, "or"))
+}
+
 // Ask asks for fields that are required but not passed in.
 func (o *initStorageOpts) Ask() error {
 	if o.addIngressFrom != "" {
@@ -300,6 +471,7 @@ func (o *initStorageOpts) Ask() error {
 	}
 	switch o.storageType {
 	case dynamoDBStorageType:
+		askedPartitionKey := o.partitionKey == ""
 		if err := o.validateOrAskDynamoPartitionKey(); err != nil {
 			return err
 		}
@@ -309,7 +481,21 @@ func (o *initStorageOpts) Ask() error {
 		if err := o.validateOrAskDynamoLSIConfig(); err != nil {
 			return err
 		}
+		// The optional table settings are only prompted for when the table is configured interactively,
+		// so that existing commands passing the keys as flags don't start prompting.
+		if askedPartitionKey {
+			if err := o.askDynamoGSIConfig(); err != nil {
+				return err
+			}
+			if err := o.askDynamoTTL(); err != nil {
+				return err
+			}
+			if err := o.askDynamoStream(); err != nil {
+				return err
+			}
+		}
 	case rdsStorageType:
+		askedEngine := o.rdsEngine == ""
 		if err := o.validateOrAskAuroraEngineType(); err != nil {
 			return err
 		}
@@ -317,6 +503,12 @@ func (o *initStorageOpts) Ask() error {
 		if err := o.validateOrAskAuroraInitialDBName(); err != nil {
 			return err
 		}
+		// Similar to DynamoDB, the capacity range is only prompted for when the cluster is configured interactively.
+		if askedEngine {
+			if err := o.askAuroraCapacityRange(); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -341,6 +533,16 @@ func (o *initStorageOpts) validateOrAskStorageType() error {
 			FriendlyText: rdsStorageTypeOption,
 			Hint:         "SQL",
 		},
+		{
+			Value:        efsStorageType,
+			FriendlyText: efsStorageTypeOption,
+			Hint:         "Files",
+		},
+		{
+			Value:        redisStorageType,
+			FriendlyText: redisStorageTypeOption,
+			Hint:         "Cache",
+		},
 	}
 	result, err := o.prompt.SelectOption(o.storageTypePrompt(),
 		storageInitTypeHelp,
@@ -396,6 +598,12 @@ func (o *initStorageOpts) validateOrAskStorageName() error {
 		friendlyText = dynamoDBTableFriendlyText
 	case rdsStorageType:
 		return o.askStorageNameWithDefault(rdsFriendlyText, fmt.Sprintf(fmtRDSStorageNameDefault, o.workloadName), rdsNameValidation)
+	case efsStorageType:
+		validator = dynamoTableNameValidation
+		friendlyText = efsFriendlyText
+	case redisStorageType:
+		validator = dynamoTableNameValidation
+		friendlyText = redisFriendlyText
 	}
 
 	name, err := o.prompt.Get(fmt.Sprintf(fmtStorageInitNamePrompt,
@@ -464,6 +672,14 @@ func (o *initStorageOpts) askLocalWorkload() error {
 }
 
 func (o *initStorageOpts) validateOrAskLifecycle() error {
+	if o.storageType == efsStorageType {
+		// A file system is shared by the workloads of an environment through their own access points.
+		if o.lifecycle == lifecycleWorkloadLevel {
+			return fmt.Errorf("invalid lifecycle; storage type %s must be %q", efsStorageType, lifecycleEnvironmentLevel)
+		}
+		o.lifecycle = lifecycleEnvironmentLevel
+		return nil
+	}
 	if o.lifecycle != "" {
 		return o.validateStorageLifecycle()
 	}
@@ -698,6 +914,125 @@ func (o *initStorageOpts) validateOrAskDynamoLSIConfig() error {
 	}
 }
 
+func (o *initStorageOpts) askDynamoGSIConfig() error {
+	if len(o.gsis) > 0 || o.noGSI {
+		return nil
+	}
+	moreGSI, err := o.prompt.Confirm(storageInitDDBGSIPrompt, storageInitDDBGSIHelp, prompt.WithFinalMessage("Global secondary indexes?"))
+	if err != nil {
+		return fmt.Errorf("confirm add global secondary index: %w", err)
+	}
+	for moreGSI {
+		if len(o.gsis) >= maxDDBGSIs {
+			log.Infof("You may not specify more than %d global secondary indexes. Continuing...\n", maxDDBGSIs)
+			break
+		}
+		gsi, err := o.askDynamoAttribute("partition key of this global secondary index", "GSI partition key")
+		if err != nil {
+			return err
+		}
+		hasSortKey, err := o.prompt.Confirm(storageInitDDBGSISortKeyConfirm, "", prompt.WithFinalMessage("GSI sort key?"))
+		if err != nil {
+			return fmt.Errorf("confirm add sort key to global secondary index: %w", err)
+		}
+		if hasSortKey {
+			sortKey, err := o.askDynamoAttribute("sort key of this global secondary index", "GSI sort key")
+			if err != nil {
+				return err
+			}
+			gsi = gsi + "," + sortKey
+		}
+		o.gsis = append(o.gsis, gsi)
+
+		moreGSI, err = o.prompt.Confirm(storageInitDDBMoreGSIPrompt, storageInitDDBGSIHelp, prompt.WithFinalMessage("Additional global secondary indexes?"))
+		if err != nil {
+			return fmt.Errorf("confirm add global secondary index: %w", err)
+		}
+	}
+	o.noGSI = len(o.gsis) == 0
+	return nil
+}
+
+// askDynamoAttribute asks for the name and datatype of an attribute and returns it as "name:T".
+func (o *initStorageOpts) askDynamoAttribute(attribute, finalMsg string) (string, error) {
+	name, err := o.prompt.Get(fmt.Sprintf(fmtStorageInitDDBKeyPrompt, color.HighlightUserInput(attribute), color.HighlightUserInput(dynamoDBStorageType)),
+		"",
+		dynamoAttributeNameValidation,
+		prompt.WithFinalMessage(finalMsg+":"),
+	)
+	if err != nil {
+		return "", fmt.Errorf("get DDB %s: %w", attribute, err)
+	}
+	dataType, err := o.prompt.SelectOne(fmt.Sprintf(fmtStorageInitDDBKeyTypePrompt, ddbKeyString),
+		fmt.Sprintf(fmtStorageInitDDBKeyTypeHelp, ddbKeyString),
+		attributeTypes,
+		prompt.WithFinalMessage(finalMsg+" datatype:"),
+	)
+	if err != nil {
+		return "", fmt.Errorf("get DDB %s datatype: %w", attribute, err)
+	}
+	return name + ":" + dataType, nil
+}
+
+func (o *initStorageOpts) askDynamoTTL() error {
+	if o.ttlAttribute != "" {
+		return nil
+	}
+	hasTTL, err := o.prompt.Confirm(storageInitDDBTTLConfirm, storageInitDDBTTLHelp, prompt.WithFinalMessage("Time to live?"))
+	if err != nil {
+		return fmt.Errorf("confirm DDB time to live: %w", err)
+	}
+	if !hasTTL {
+		return nil
+	}
+	attr, err := o.prompt.Get(storageInitDDBTTLPrompt,
+		storageInitDDBTTLHelp,
+		dynamoAttributeNameValidation,
+		prompt.WithFinalMessage("Time to live attribute:"),
+	)
+	if err != nil {
+		return fmt.Errorf("get DDB time to live attribute: %w", err)
+	}
+	o.ttlAttribute = attr
+	return nil
+}
+
+func (o *initStorageOpts) askDynamoStream() error {
+	if o.streamViewType != "" {
+		return nil
+	}
+	options := []prompt.Option{
+		{
+			Value: ddbStreamNone,
+			Hint:  "No stream",
+		},
+		{
+			Value: ddbStreamViewTypeNewAndOldImages,
+			Hint:  "The items before and after they are modified",
+		},
+		{
+			Value: ddbStreamViewTypeNewImage,
+			Hint:  "The items after they are modified",
+		},
+		{
+			Value: ddbStreamViewTypeOldImage,
+			Hint:  "The items before they are modified",
+		},
+		{
+			Value: ddbStreamViewTypeKeysOnly,
+			Hint:  "Only the keys of the modified items",
+		},
+	}
+	viewType, err := o.prompt.SelectOption(storageInitDDBStreamPrompt, storageInitDDBStreamHelp, options, prompt.WithFinalMessage("Stream:"))
+	if err != nil {
+		return fmt.Errorf("select DDB stream view type: %w", err)
+	}
+	if viewType != ddbStreamNone {
+		o.streamViewType = viewType
+	}
+	return nil
+}
+
 func (o *initStorageOpts) validateOrAskAuroraEngineType() error {
 	if o.rdsEngine != "" {
 		return validateEngine(o.rdsEngine)
@@ -740,6 +1075,45 @@ func (o *initStorageOpts) validateOrAskAuroraInitialDBName() error {
 	return nil
 }
 
+func (o *initStorageOpts) askAuroraCapacityRange() error {
+	if o.auroraServerlessVersion != auroraServerlessVersionV2 {
+		return nil
+	}
+	if o.rdsMinCapacity == 0 {
+		capacity, err := o.askAuroraCapacity(storageInitRDSMinCapacityPrompt, defaultAuroraMinCapacity, validateAuroraCapacity, "Minimum capacity:")
+		if err != nil {
+			return fmt.Errorf("input minimum capacity: %w", err)
+		}
+		o.rdsMinCapacity = capacity
+	}
+	if o.rdsMaxCapacity == 0 {
+		capacity, err := o.askAuroraCapacity(storageInitRDSMaxCapacityPrompt, defaultAuroraMaxCapacity, func(val interface{}) error {
+			if err := validateAuroraCapacity(val); err != nil {
+				return err
+			}
+			max, _ := strconv.ParseFloat(val.(string), 64)
+			return validateAuroraMinMaxCapacity(o.rdsMinCapacity, max)
+		}, "Maximum capacity:")
+		if err != nil {
+			return fmt.Errorf("input maximum capacity: %w", err)
+		}
+		o.rdsMaxCapacity = capacity
+	}
+	return nil
+}
+
+func (o *initStorageOpts) askAuroraCapacity(msg string, defaultCapacity float64, validator prompt.ValidatorFunc, finalMsg string) (float64, error) {
+	in, err := o.prompt.Get(msg,
+		storageInitRDSCapacityHelp,
+		validator,
+		prompt.WithDefaultInput(strconv.FormatFloat(defaultCapacity, 'f', -1, 64)),
+		prompt.WithFinalMessage(finalMsg))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(in, 64)
+}
+
 // Execute deploys a new environment with CloudFormation and adds it to SSM.
 func (o *initStorageOpts) Execute() error {
 	o.consumeFlags()
@@ -847,6 +1221,12 @@ func (o *initStorageOpts) addonBlobs() ([]addonBlob, error) {
 		return o.envDDBAddonBlobs()
 	case option{lifecycleEnvironmentLevel, rdsStorageType}:
 		return o.envRDSAddonBlobs()
+	case option{lifecycleEnvironmentLevel, efsStorageType}:
+		return o.envEFSAddonBlobs()
+	case option{lifecycleWorkloadLevel, redisStorageType}:
+		return o.wkldRedisAddonBlobs()
+	case option{lifecycleEnvironmentLevel, redisStorageType}:
+		return o.envRedisAddonBlobs()
 	}
 	return nil, fmt.Errorf("storage type %s is not supported yet", o.storageType)
 }
@@ -913,6 +1293,11 @@ func (o *initStorageOpts) ddbProps() (*addon.DynamoDBProps, error) {
 			return nil, err
 		}
 	}
+	if _, err := props.BuildGlobalSecondaryIndex(o.noGSI, o.gsis); err != nil {
+		return nil, err
+	}
+	props.TTLAttribute = o.ttlAttribute
+	props.StreamViewType = o.streamViewType
 	return &props, nil
 }
 
@@ -1061,6 +1446,94 @@ func (o *initStorageOpts) rdsProps() (addon.RDSProps, error) {
 		InitialDBName:  o.rdsInitialDBName,
 		ParameterGroup: o.rdsParameterGroup,
 		Envs:           envs,
+		MinCapacity:    o.rdsMinCapacity,
+		MaxCapacity:    o.rdsMaxCapacity,
+	}, nil
+}
+
+// envEFSAddonBlobs returns the file system of the environments and the access point of the workload to it.
+// The access point is an environment addon so that its ID is known before the workload is deployed.
+func (o *initStorageOpts) envEFSAddonBlobs() ([]addonBlob, error) {
+	transitionToIA := fmt.Sprintf("AFTER_%d_DAYS", o.efsTransitionToIADays)
+	if o.efsTransitionToIADays == 1 {
+		transitionToIA = "AFTER_1_DAY"
+	}
+	return []addonBlob{
+		{
+			path:        o.ws.EnvAddonFilePath(fmt.Sprintf("%s.yml", o.storageName)),
+			description: blobDescriptionTemplate,
+			blob: addon.EnvEFSTemplate(&addon.EFSProps{
+				StorageProps: &addon.StorageProps{
+					Name: o.storageName,
+				},
+				ThroughputMode: o.efsThroughputMode,
+				TransitionToIA: transitionToIA,
+				EnableBackup:   !o.efsNoBackup,
+			}),
+		},
+		{
+			path:        o.ws.EnvAddonFilePath(workspace.AddonsParametersFileName),
+			description: blobDescriptionParameters,
+			blob:        addon.EnvParamsForEFS(),
+		},
+		{
+			path:        o.ws.EnvAddonFilePath(fmt.Sprintf(fmtEFSAccessPointFileName, o.storageName, o.workloadName)),
+			description: blobDescriptionTemplate,
+			blob: addon.EnvEFSAccessPointTemplate(addon.EFSAccessPointProps{
+				Name:         o.storageName,
+				WorkloadName: o.workloadName,
+				UID:          defaultEFSAccessPointPosixID,
+				GID:          defaultEFSAccessPointPosixID,
+			}),
+		},
+	}, nil
+}
+
+func (o *initStorageOpts) wkldRedisAddonBlobs() ([]addonBlob, error) {
+	props, err := o.redisProps()
+	if err != nil {
+		return nil, err
+	}
+	return []addonBlob{
+		{
+			path:        o.ws.WorkloadAddonFilePath(o.workloadName, fmt.Sprintf("%s.yml", o.storageName)),
+			description: blobDescriptionTemplate,
+			blob:        addon.WorkloadRedisTemplate(props),
+		},
+	}, nil
+}
+
+func (o *initStorageOpts) envRedisAddonBlobs() ([]addonBlob, error) {
+	if o.addIngressFrom != "" {
+		return nil, nil
+	}
+	props, err := o.redisProps()
+	if err != nil {
+		return nil, err
+	}
+	tmplBlob := addonBlob{
+		path:        o.ws.EnvAddonFilePath(fmt.Sprintf("%s.yml", o.storageName)),
+		description: blobDescriptionTemplate,
+		blob:        addon.EnvRedisTemplate(props),
+	}
+	paramBlob := addonBlob{
+		path:        o.ws.EnvAddonFilePath(workspace.AddonsParametersFileName),
+		description: blobDescriptionParameters,
+		blob:        addon.EnvParamsForRedis(),
+	}
+	return []addonBlob{tmplBlob, paramBlob}, nil
+}
+
+func (o *initStorageOpts) redisProps() (*addon.RedisProps, error) {
+	envs, err := o.environmentNames()
+	if err != nil {
+		return nil, err
+	}
+	return &addon.RedisProps{
+		StorageProps: &addon.StorageProps{
+			Name: o.storageName,
+		},
+		Envs: envs,
 	}, nil
 }
 
@@ -1108,6 +1581,10 @@ const client = new AWS.SecretsManager({
 const dbSecret = await client.getSecretValue({SecretId: process.env.%s}).promise();
 const {username, host, dbname, password, port} = JSON.parse(dbSecret.SecretString);`, newVar)
 		}
+	case redisStorageType:
+		newVar = template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName) + "Endpoint")
+		portVar := template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName) + "Port")
+		retrieveEnvVarCode = fmt.Sprintf("const client = createClient({url: `rediss://${process.env.%s}:${process.env.%s}`});", newVar, portVar)
 	}
 
 	actionRetrieveEnvVar := fmt.Sprintf(
@@ -1129,6 +1606,16 @@ For example, in JavaScript you can write:
 func (o *initStorageOpts) actionsForEnvStorage() []string {
 	envDeployAction := fmt.Sprintf("Run %s to deploy your environment storage resources.", color.HighlightCode("copilot env deploy"))
 	svcMftAction := fmt.Sprintf("Update the manifest for your %q workload:\n%s", o.workloadName, color.HighlightCodeBlock(o.manifestSuggestion()))
+	if o.storageType == efsStorageType {
+		showAction := fmt.Sprintf("Run %s to find the IDs of the file system and of the access point of %s.",
+			color.HighlightCode(fmt.Sprintf("copilot storage show -n %s", o.storageName)),
+			color.HighlightUserInput(o.workloadName))
+		svcDeployAction := fmt.Sprintf("Run %s to deploy the workload so that %s mounts the %s file system.",
+			color.HighlightCode(fmt.Sprintf("copilot deploy --name %s", o.workloadName)),
+			color.HighlightUserInput(o.workloadName),
+			color.HighlightUserInput(o.storageName))
+		return []string{envDeployAction, showAction, svcMftAction, svcDeployAction}
+	}
 	svcDeployAction := fmt.Sprintf("Run %s to deploy the workload so that %s has access to %s storage.",
 		color.HighlightCode(fmt.Sprintf("copilot svc deploy --name %s", o.workloadName)),
 		color.HighlightUserInput(o.workloadName),
@@ -1163,6 +1650,27 @@ func (o *initStorageOpts) manifestSuggestion() string {
 		return fmt.Sprintf(`secrets:
   DB_SECRET:
     from_cfn: ${COPILOT_APPLICATION_NAME}-${COPILOT_ENVIRONMENT_NAME}-%sAuroraSecret`, logicalIDSafeStorageName)
+	case o.storageType == efsStorageType:
+		return fmt.Sprintf(`storage:
+  volumes:
+    %s:
+      path: /mnt/%s
+      read_only: false
+      efs:
+        id: <file system ID>
+        auth:
+          iam: true
+          access_point_id: <access point ID>`, o.storageName, o.storageName)
+	case o.storageType == redisStorageType:
+		return fmt.Sprintf(`network:
+  vpc:
+    security_groups:
+      - from_cfn: ${COPILOT_APPLICATION_NAME}-${COPILOT_ENVIRONMENT_NAME}-%[1]sSecurityGroup
+variables:
+  REDIS_ENDPOINT:
+    from_cfn: ${COPILOT_APPLICATION_NAME}-${COPILOT_ENVIRONMENT_NAME}-%[1]sEndpoint
+  REDIS_PORT:
+    from_cfn: ${COPILOT_APPLICATION_NAME}-${COPILOT_ENVIRONMENT_NAME}-%[1]sPort`, logicalIDSafeStorageName)
 	case o.storageType == rdsStorageType && o.workloadType != manifestinfo.RequestDrivenWebServiceType:
 		return fmt.Sprintf(`network:
   vpc:
@@ -1221,8 +1729,17 @@ Storage resources are addons, either for a workload or the environments.`,
   /code $ copilot storage init -n my-bucket -t S3 -w api -l environment
   Create a DynamoDB table with a sort key.
   /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Email:S --sort-key UserId:N --no-lsi
+  Create a DynamoDB table with a global secondary index, a time to live attribute and a stream.
+  /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Email:S --no-sort \
+    --gsi Status:S,CreatedAt:N --ttl ExpiresAt --stream NEW_AND_OLD_IMAGES
   Create an RDS Aurora Serverless v2 cluster using PostgreSQL.
-  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL --initial-db testdb`,
+  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL --initial-db testdb
+  Create an RDS Aurora Serverless v2 cluster that scales between 2 and 16 ACUs.
+  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine MySQL --initial-db testdb --min-capacity 2 --max-capacity 16
+  Create an environment EFS file system with elastic throughput and an access point for the "api" service.
+  /code $ copilot storage init -n my-fs -t EFS -w api --throughput-mode elastic --transition-to-ia 60
+  Create an ElastiCache for Redis replication group attached to the "api" service.
+  /code $ copilot storage init -n my-cache -t Redis -w api -l workload`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newStorageInitOpts(vars)
 			if err != nil {
@@ -1242,15 +1759,30 @@ Storage resources are addons, either for a workload or the environments.`,
 	cmd.Flags().StringArrayVar(&vars.lsiSorts, storageLSIConfigFlag, []string{}, storageLSIConfigFlagDescription)
 	cmd.Flags().BoolVar(&vars.noLSI, storageNoLSIFlag, false, storageNoLSIFlagDescription)
 	cmd.Flags().BoolVar(&vars.noSort, storageNoSortFlag, false, storageNoSortFlagDescription)
+	cmd.Flags().StringArrayVar(&vars.gsis, storageGSIConfigFlag, []string{}, storageGSIConfigFlagDescription)
+	cmd.Flags().BoolVar(&vars.noGSI, storageNoGSIFlag, false, storageNoGSIFlagDescription)
+	cmd.Flags().StringVar(&vars.ttlAttribute, storageDDBTTLFlag, "", storageDDBTTLFlagDescription)
+	cmd.Flags().StringVar(&vars.streamViewType, storageDDBStreamFlag, "", storageDDBStreamFlagDescription)
 
 	cmd.Flags().StringVar(&vars.auroraServerlessVersion, storageAuroraServerlessVersionFlag, defaultAuroraServerlessVersion, storageAuroraServerlessVersionFlagDescription)
 	cmd.Flags().StringVar(&vars.rdsEngine, storageRDSEngineFlag, "", storageRDSEngineFlagDescription)
 	cmd.Flags().StringVar(&vars.rdsInitialDBName, storageRDSInitialDBFlag, "", storageRDSInitialDBFlagDescription)
 	cmd.Flags().StringVar(&vars.rdsParameterGroup, storageRDSParameterGroupFlag, "", storageRDSParameterGroupFlagDescription)
-
-	ddbFlags := []string{storagePartitionKeyFlag, storageSortKeyFlag, storageNoSortFlag, storageLSIConfigFlag, storageNoLSIFlag}
-	rdsFlags := []string{storageAuroraServerlessVersionFlag, storageRDSEngineFlag, storageRDSInitialDBFlag, storageRDSParameterGroupFlag}
-	for _, f := range append(ddbFlags, storageAuroraServerlessVersionFlag, storageRDSInitialDBFlag, storageRDSParameterGroupFlag) {
+	cmd.Flags().Float64Var(&vars.rdsMinCapacity, storageRDSMinCapacityFlag, 0, storageRDSMinCapacityFlagDescription)
+	cmd.Flags().Float64Var(&vars.rdsMaxCapacity, storageRDSMaxCapacityFlag, 0, storageRDSMaxCapacityFlagDescription)
+
+	cmd.Flags().StringVar(&vars.efsThroughputMode, storageEFSThroughputModeFlag, defaultEFSThroughputMode, storageEFSThroughputModeFlagDescription)
+	cmd.Flags().IntVar(&vars.efsTransitionToIADays, storageEFSTransitionToIAFlag, defaultEFSTransitionToIADays, storageEFSTransitionToIAFlagDescription)
+	cmd.Flags().BoolVar(&vars.efsNoBackup, storageEFSNoBackupFlag, false, storageEFSNoBackupFlagDescription)
+
+	ddbFlags := []string{storagePartitionKeyFlag, storageSortKeyFlag, storageNoSortFlag, storageLSIConfigFlag, storageNoLSIFlag,
+		storageGSIConfigFlag, storageNoGSIFlag, storageDDBTTLFlag, storageDDBStreamFlag}
+	rdsFlags := []string{storageAuroraServerlessVersionFlag, storageRDSEngineFlag, storageRDSInitialDBFlag, storageRDSParameterGroupFlag,
+		storageRDSMinCapacityFlag, storageRDSMaxCapacityFlag}
+	efsFlags := []string{storageEFSThroughputModeFlag, storageEFSTransitionToIAFlag, storageEFSNoBackupFlag}
+	mutuallyExclusiveWithIngress := append(ddbFlags, storageAuroraServerlessVersionFlag, storageRDSInitialDBFlag, storageRDSParameterGroupFlag,
+		storageRDSMinCapacityFlag, storageRDSMaxCapacityFlag)
+	for _, f := range append(mutuallyExclusiveWithIngress, efsFlags...) {
 		cmd.MarkFlagsMutuallyExclusive(storageAddIngressFromFlag, f)
 	}
 	requiredFlags := pflag.NewFlagSet("Required", pflag.ContinueOnError)
@@ -1267,16 +1799,21 @@ Storage resources are addons, either for a workload or the environments.`,
 	for _, f := range rdsFlags {
 		auroraFlagSet.AddFlag(cmd.Flags().Lookup(f))
 	}
+	efsFlagSet := pflag.NewFlagSet("EFS", pflag.ContinueOnError)
+	for _, f := range efsFlags {
+		efsFlagSet.AddFlag(cmd.Flags().Lookup(f))
+	}
 
 	optionalFlagSet := pflag.NewFlagSet("Optional", pflag.ContinueOnError)
 	optionalFlagSet.AddFlag(cmd.Flags().Lookup(storageAddIngressFromFlag))
 
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
-		"sections":          `Required,DynamoDB,Aurora Serverless,Optional`,
+		"sections":          `Required,DynamoDB,Aurora Serverless,EFS,Optional`,
 		"Required":          requiredFlags.FlagUsages(),
 		"DynamoDB":          ddbFlagSet.FlagUsages(),
 		"Aurora Serverless": auroraFlagSet.FlagUsages(),
+		"EFS":               efsFlagSet.FlagUsages(),
 		"Optional":          optionalFlagSet.FlagUsages(),
 	}
 	cmd.SetUsageTemplate(`{{h1 "Usage"}}{{if .Runnable}}
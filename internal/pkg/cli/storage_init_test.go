@@ -36,6 +36,13 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 		inNoLSI             bool
 		inServerlessVersion string
 		inEngine            string
+		inThroughputMode    string
+		inTransitionToIA    int
+		inGSIs              []string
+		inNoGSI             bool
+		inStreamViewType    string
+		inMinCapacity       float64
+		inMaxCapacity       float64
 
 		mock      func(m *mockStorageInitValidate)
 		wantedErr error
@@ -126,6 +133,80 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 			mock:                func(m *mockStorageInitValidate) {},
 			wantedErr:           errors.New("invalid Aurora Serverless version weird-serverless-version: must be one of \"v1\", \"v2\""),
 		},
+		"fails when --add-ingress-from is used for an EFS file system": {
+			inAppName:        "bowie",
+			inAddIngressFrom: "api",
+			inStorageName:    "myfs",
+			inStorageType:    efsStorageType,
+			mock:             func(m *mockStorageInitValidate) {},
+			wantedErr:        errors.New("--add-ingress-from cannot be used with storage type EFS: run `copilot storage init` with --workload in the workspace where environments are managed instead"),
+		},
+		"successfully validates EFS configuration": {
+			inAppName:        "bowie",
+			inStorageType:    efsStorageType,
+			inThroughputMode: efsThroughputModeElastic,
+			inTransitionToIA: 90,
+			mock:             func(m *mockStorageInitValidate) {},
+		},
+		"invalid EFS throughput mode": {
+			inAppName:        "bowie",
+			inStorageType:    efsStorageType,
+			inThroughputMode: "provisioned",
+			mock:             func(m *mockStorageInitValidate) {},
+			wantedErr:        errors.New(`invalid EFS throughput mode provisioned: must be one of "bursting", "elastic"`),
+		},
+		"invalid number of days to transition files to EFS Infrequent Access": {
+			inAppName:        "bowie",
+			inStorageType:    efsStorageType,
+			inTransitionToIA: 45,
+			mock:             func(m *mockStorageInitValidate) {},
+			wantedErr:        errors.New("invalid number of days 45 to transition files to EFS Infrequent Access: must be one of 1, 7, 14, 30, 60, 90, 180, 270, or 365"),
+		},
+		"fails when --no-gsi and --gsi are both specified": {
+			inAppName: "bowie",
+			inGSIs:    []string{"status:S"},
+			inNoGSI:   true,
+			mock:      func(m *mockStorageInitValidate) {},
+			wantedErr: errors.New("validate GSI configuration: cannot specify --no-gsi and --gsi options at once"),
+		},
+		"invalid global secondary index": {
+			inAppName: "bowie",
+			inGSIs:    []string{"status:S,createdAt:N,owner:S"},
+			mock:      func(m *mockStorageInitValidate) {},
+			wantedErr: errors.New("validate GSI configuration: value must be of the form <name>:<T>[,<name>:<T>] where T is one of S, N, or B"),
+		},
+		"invalid stream view type": {
+			inAppName:        "bowie",
+			inStreamViewType: "ALL",
+			mock:             func(m *mockStorageInitValidate) {},
+			wantedErr:        errors.New(`invalid stream view type ALL: must be one of "NEW_AND_OLD_IMAGES", "NEW_IMAGE", "OLD_IMAGE", "KEYS_ONLY"`),
+		},
+		"fails when the capacity range is specified with Aurora Serverless v1": {
+			inAppName:           "bowie",
+			inServerlessVersion: auroraServerlessVersionV1,
+			inMaxCapacity:       16,
+			mock:                func(m *mockStorageInitValidate) {},
+			wantedErr:           errors.New("--min-capacity and --max-capacity can only be used with Aurora Serverless v2"),
+		},
+		"invalid capacity": {
+			inAppName:     "bowie",
+			inMinCapacity: 0.7,
+			mock:          func(m *mockStorageInitValidate) {},
+			wantedErr:     errors.New("validate capacity range: capacity 0.7 must be between 0.5 and 128 ACUs in increments of 0.5"),
+		},
+		"fails when the minimum capacity is greater than the default maximum capacity": {
+			inAppName:     "bowie",
+			inMinCapacity: 16,
+			mock:          func(m *mockStorageInitValidate) {},
+			wantedErr:     errors.New("validate capacity range: minimum capacity 16 must be less than or equal to maximum capacity 8"),
+		},
+		"valid capacity range": {
+			inAppName:           "bowie",
+			inServerlessVersion: auroraServerlessVersionV2,
+			inMinCapacity:       2,
+			inMaxCapacity:       16.5,
+			mock:                func(m *mockStorageInitValidate) {},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -151,6 +232,13 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 					noSort:                  tc.inNoSort,
 					auroraServerlessVersion: tc.inServerlessVersion,
 					rdsEngine:               tc.inEngine,
+					efsThroughputMode:       tc.inThroughputMode,
+					efsTransitionToIADays:   tc.inTransitionToIA,
+					gsis:                    tc.inGSIs,
+					noGSI:                   tc.inNoGSI,
+					streamViewType:          tc.inStreamViewType,
+					rdsMinCapacity:          tc.inMinCapacity,
+					rdsMaxCapacity:          tc.inMaxCapacity,
 				},
 				appName: tc.inAppName,
 				ws:      m.ws,
@@ -203,7 +291,7 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 			inStorageType: "box",
 			inSvcName:     "frontend",
 			mock:          func(m *mockStorageInitAsk) {},
-			wantedErr:     errors.New(`invalid storage type box: must be one of "DynamoDB", "S3", "Aurora"`),
+			wantedErr:     errors.New(`invalid storage type box: must be one of "DynamoDB", "S3", "Aurora", "EFS", "Redis"`),
 		},
 		"asks for storage type": {
 			inSvcName:     wantedSvcName,
@@ -471,6 +559,31 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 				m.ws.EXPECT().WorkloadExists(gomock.Eq("frontend")).Times(0)
 			},
 		},
+		"lifecycle is env level for an EFS file system without asking": {
+			inStorageType: efsStorageType,
+			inSvcName:     "frontend",
+			inStorageName: "my-fs",
+			mock: func(m *mockStorageInitAsk) {
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return(nil, &workspace.ErrFileNotExists{FileName: "frontend"})
+				m.ws.EXPECT().HasEnvironments().Return(true, nil)
+			},
+			wantedVars: &initStorageVars{
+				storageType:  efsStorageType,
+				storageName:  "my-fs",
+				workloadName: "frontend",
+				lifecycle:    lifecycleEnvironmentLevel,
+			},
+		},
+		"error if an EFS file system has a workload-level lifecycle": {
+			inStorageType: efsStorageType,
+			inSvcName:     "frontend",
+			inStorageName: "my-fs",
+			inLifecycle:   lifecycleWorkloadLevel,
+			mock: func(m *mockStorageInitAsk) {
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return(nil, &workspace.ErrFileNotExists{FileName: "frontend"})
+			},
+			wantedErr: errors.New(`invalid lifecycle; storage type EFS must be "environment"`),
+		},
 		"no error or asks when fully specified": {
 			inSvcName:     wantedSvcName,
 			inStorageType: s3StorageType,
@@ -578,7 +691,75 @@ func TestStorageInitOpts_AskDDB(t *testing.T) {
 					attributeTypes,
 					gomock.Any(),
 				).Return(ddbStringType, nil)
+				m.prompt.EXPECT().Confirm(storageInitDDBGSIPrompt, gomock.Any(), gomock.Any()).Return(false, nil)
+				m.prompt.EXPECT().Confirm(storageInitDDBTTLConfirm, gomock.Any(), gomock.Any()).Return(false, nil)
+				m.prompt.EXPECT().SelectOption(storageInitDDBStreamPrompt, gomock.Any(), gomock.Any(), gomock.Any()).Return(ddbStreamNone, nil)
+			},
+		},
+		"ask for global secondary indexes, time to live and stream if the table is configured interactively": {
+			inStorageName: wantedTableName,
+			inNoSort:      true,
+			mock: func(m *mockStorageInitAsk) {
+				m.ws.EXPECT().WorkloadExists(gomock.Any()).Return(true, nil)
+				gsiPartitionKeyPrompt := fmt.Sprintf(fmtStorageInitDDBKeyPrompt,
+					color.HighlightUserInput("partition key of this global secondary index"),
+					color.HighlightUserInput(dynamoDBStorageType),
+				)
+				gsiSortKeyPrompt := fmt.Sprintf(fmtStorageInitDDBKeyPrompt,
+					color.HighlightUserInput("sort key of this global secondary index"),
+					color.HighlightUserInput(dynamoDBStorageType),
+				)
+				gomock.InOrder(
+					m.prompt.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("DogName", nil),
+					m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), attributeTypes, gomock.Any()).Return(ddbStringType, nil),
+					m.prompt.EXPECT().Confirm(storageInitDDBGSIPrompt, gomock.Any(), gomock.Any()).Return(true, nil),
+					m.prompt.EXPECT().Get(gsiPartitionKeyPrompt, gomock.Any(), gomock.Any(), gomock.Any()).Return("Breed", nil),
+					m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), attributeTypes, gomock.Any()).Return(ddbStringType, nil),
+					m.prompt.EXPECT().Confirm(storageInitDDBGSISortKeyConfirm, gomock.Any(), gomock.Any()).Return(true, nil),
+					m.prompt.EXPECT().Get(gsiSortKeyPrompt, gomock.Any(), gomock.Any(), gomock.Any()).Return("Age", nil),
+					m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), attributeTypes, gomock.Any()).Return(ddbIntType, nil),
+					m.prompt.EXPECT().Confirm(storageInitDDBMoreGSIPrompt, gomock.Any(), gomock.Any()).Return(false, nil),
+					m.prompt.EXPECT().Confirm(storageInitDDBTTLConfirm, gomock.Any(), gomock.Any()).Return(true, nil),
+					m.prompt.EXPECT().Get(storageInitDDBTTLPrompt, gomock.Any(), gomock.Any(), gomock.Any()).Return("ExpiresAt", nil),
+					m.prompt.EXPECT().SelectOption(storageInitDDBStreamPrompt, gomock.Any(), gomock.Any(), gomock.Any()).Return(ddbStreamViewTypeNewImage, nil),
+				)
+			},
+			wantedVars: &initStorageVars{
+				storageType:    dynamoDBStorageType,
+				storageName:    wantedTableName,
+				workloadName:   wantedSvcName,
+				lifecycle:      lifecycleWorkloadLevel,
+				partitionKey:   "DogName:String",
+				noSort:         true,
+				noLSI:          true,
+				gsis:           []string{"Breed:String,Age:Number"},
+				ttlAttribute:   "ExpiresAt",
+				streamViewType: ddbStreamViewTypeNewImage,
+			},
+		},
+		"error if fail to confirm global secondary indexes": {
+			inStorageName: wantedTableName,
+			inNoSort:      true,
+			mock: func(m *mockStorageInitAsk) {
+				m.ws.EXPECT().WorkloadExists(gomock.Any()).Return(true, nil)
+				m.prompt.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("DogName", nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), attributeTypes, gomock.Any()).Return(ddbStringType, nil)
+				m.prompt.EXPECT().Confirm(storageInitDDBGSIPrompt, gomock.Any(), gomock.Any()).Return(false, errors.New("some error"))
 			},
+			wantedErr: errors.New("confirm add global secondary index: some error"),
+		},
+		"error if fail to select the stream view type": {
+			inStorageName: wantedTableName,
+			inNoSort:      true,
+			mock: func(m *mockStorageInitAsk) {
+				m.ws.EXPECT().WorkloadExists(gomock.Any()).Return(true, nil)
+				m.prompt.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("DogName", nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), attributeTypes, gomock.Any()).Return(ddbStringType, nil)
+				m.prompt.EXPECT().Confirm(storageInitDDBGSIPrompt, gomock.Any(), gomock.Any()).Return(false, nil)
+				m.prompt.EXPECT().Confirm(storageInitDDBTTLConfirm, gomock.Any(), gomock.Any()).Return(false, nil)
+				m.prompt.EXPECT().SelectOption(storageInitDDBStreamPrompt, gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select DDB stream view type: some error"),
 		},
 		"error if fail to return partition key": {
 			inStorageName: wantedTableName,
@@ -1033,6 +1214,10 @@ func TestStorageInitOpts_AskRDS(t *testing.T) {
 				m.ws.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
 				m.prompt.EXPECT().SelectOne(gomock.Eq(storageInitRDSDBEnginePrompt), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(wantedDBEngine, nil)
+				m.prompt.EXPECT().Get(storageInitRDSMinCapacityPrompt, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("0.5", nil)
+				m.prompt.EXPECT().Get(storageInitRDSMaxCapacityPrompt, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("8", nil)
 
 			},
 			wantedVars: &initStorageVars{
@@ -1044,8 +1229,24 @@ func TestStorageInitOpts_AskRDS(t *testing.T) {
 				auroraServerlessVersion: wantedServerlessVersion,
 				rdsInitialDBName:        wantedInitialDBName,
 				rdsEngine:               wantedDBEngine,
+				rdsMinCapacity:          0.5,
+				rdsMaxCapacity:          8,
 			},
 		},
+		"error if fail to get the minimum capacity": {
+			inStorageName:   wantedClusterName,
+			inInitialDBName: wantedInitialDBName,
+			mock: func(m *mockStorageInitAsk) {
+				m.ws.EXPECT().HasEnvironments().Return(true, nil).AnyTimes()
+				m.ws.EXPECT().WorkloadExists(gomock.Any()).Return(true, nil).AnyTimes()
+				m.ws.EXPECT().ReadWorkloadManifest(wantedSvcName).Return(workspace.WorkloadManifest("type: Load Balanced Web Service"), nil)
+				m.prompt.EXPECT().SelectOne(gomock.Eq(storageInitRDSDBEnginePrompt), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(wantedDBEngine, nil)
+				m.prompt.EXPECT().Get(storageInitRDSMinCapacityPrompt, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("input minimum capacity: some error"),
+		},
 		"error if engine not gotten": {
 			inStorageName:   wantedClusterName,
 			inInitialDBName: wantedInitialDBName,
@@ -1184,6 +1385,9 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 		inInitialDBName     string
 		inParameterGroup    string
 
+		inThroughputMode string
+		inTransitionToIA int
+
 		inLifecycle string
 
 		mockWS         func(m *mocks.MockwsReadWriter)
@@ -1434,6 +1638,57 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 				m.EXPECT().ListEnvironments(gomock.Any()).Times(1)
 			},
 		},
+		"happy calls for env EFS": {
+			inSvcName:        wantedSvcName,
+			inStorageType:    efsStorageType,
+			inStorageName:    "my-fs",
+			inThroughputMode: efsThroughputModeElastic,
+			inTransitionToIA: 1,
+			inLifecycle:      lifecycleEnvironmentLevel,
+
+			mockWS: func(m *mocks.MockwsReadWriter) {
+				m.EXPECT().WorkloadExists(wantedSvcName).Return(false, nil)
+				m.EXPECT().EnvAddonFilePath(gomock.Eq("my-fs.yml")).Return("mockEnvTemplatePath")
+				m.EXPECT().EnvAddonFilePath(gomock.Eq("addons.parameters.yml")).Return("mockEnvParametersPath")
+				m.EXPECT().EnvAddonFilePath(gomock.Eq("my-fs-frontend-access-point.yml")).Return("mockEnvAccessPointPath")
+				m.EXPECT().Write(gomock.Any(), "mockEnvTemplatePath").Return("mockEnvTemplatePath", nil)
+				m.EXPECT().Write(gomock.Any(), "mockEnvParametersPath").Return("mockEnvParametersPath", nil)
+				m.EXPECT().Write(gomock.Any(), "mockEnvAccessPointPath").Return("mockEnvAccessPointPath", nil)
+			},
+		},
+		"happy calls for wkld Redis": {
+			inSvcName:     wantedSvcName,
+			inStorageType: redisStorageType,
+			inStorageName: "my-cache",
+			inLifecycle:   lifecycleWorkloadLevel,
+
+			mockWS: func(m *mocks.MockwsReadWriter) {
+				m.EXPECT().WorkloadExists(wantedSvcName).Return(true, nil)
+				m.EXPECT().ReadWorkloadManifest(wantedSvcName).Return([]byte("type: Load Balanced Web Service"), nil)
+				m.EXPECT().WorkloadAddonFilePath(gomock.Eq(wantedSvcName), gomock.Eq("my-cache.yml")).Return("mockPath")
+				m.EXPECT().Write(gomock.Any(), "mockPath").Return("mockPath", nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments(gomock.Any()).Times(1)
+			},
+		},
+		"happy calls for env Redis": {
+			inSvcName:     wantedSvcName,
+			inStorageType: redisStorageType,
+			inStorageName: "my-cache",
+			inLifecycle:   lifecycleEnvironmentLevel,
+
+			mockWS: func(m *mocks.MockwsReadWriter) {
+				m.EXPECT().WorkloadExists(wantedSvcName).Return(false, nil)
+				m.EXPECT().EnvAddonFilePath(gomock.Eq("my-cache.yml")).Return("mockEnvTemplatePath")
+				m.EXPECT().EnvAddonFilePath(gomock.Eq("addons.parameters.yml")).Return("mockEnvParametersPath")
+				m.EXPECT().Write(gomock.Any(), "mockEnvTemplatePath").Return("mockEnvTemplatePath", nil)
+				m.EXPECT().Write(gomock.Any(), "mockEnvParametersPath").Return("mockEnvParametersPath", nil)
+			},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().ListEnvironments(gomock.Any()).Times(1)
+			},
+		},
 		"do not error out if addon exists": {
 			inStorageType: s3StorageType,
 			inSvcName:     wantedSvcName,
@@ -1501,6 +1756,9 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 					auroraServerlessVersion: tc.inServerlessVersion,
 					rdsEngine:               tc.inEngine,
 					rdsParameterGroup:       tc.inParameterGroup,
+
+					efsThroughputMode:     tc.inThroughputMode,
+					efsTransitionToIADays: tc.inTransitionToIA,
 				},
 				appName:        wantedAppName,
 				ws:             mockWS,
@@ -0,0 +1,151 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+)
+
+const (
+	storageListAppNamePrompt = "Which application are the file systems in?"
+	storageListAppNameHelper = "An application is a collection of related services."
+)
+
+type listStorageVars struct {
+	appName          string
+	envName          string
+	shouldOutputJSON bool
+}
+
+type listStorageOpts struct {
+	listStorageVars
+
+	store             store
+	sel               appSelector
+	newStorageClients newEnvStorageClientsFunc
+	w                 io.Writer
+}
+
+func newListStorageOpts(vars listStorageVars) (*listStorageOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("storage ls"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewCachedSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	return &listStorageOpts{
+		listStorageVars:   vars,
+		store:             store,
+		sel:               selector.NewAppEnvSelector(prompt.New(), store),
+		newStorageClients: newEnvStorageClients(sessProvider),
+		w:                 os.Stdout,
+	}, nil
+}
+
+// Ask prompts for and validates the application name.
+func (o *listStorageOpts) Ask() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application: %w", err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(storageListAppNamePrompt, storageListAppNameHelper)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+// Execute lists the file systems created by "storage init" in the environments of the application.
+func (o *listStorageOpts) Execute() error {
+	envs, err := envsInApp(o.store, o.appName, o.envName)
+	if err != nil {
+		return err
+	}
+	clients, err := storageClientsOfEnvs(envs, o.newStorageClients)
+	if err != nil {
+		return err
+	}
+	fileSystems, err := listFileSystemsInEnvs(o.appName, envs, clients)
+	if err != nil {
+		return err
+	}
+
+	if o.shouldOutputJSON {
+		data, err := o.jsonOutput(fileSystems)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	o.humanOutput(fileSystems)
+	return nil
+}
+
+func (o *listStorageOpts) humanOutput(fileSystems []*envFileSystem) {
+	rows := make([][]string, 0, len(fileSystems))
+	for _, fs := range fileSystems {
+		rows = append(rows, []string{fs.Name, fs.Environment, fs.ID, fs.ThroughputMode, strconv.FormatInt(fs.NumberOfMountTargets, 10), humanize.IBytes(uint64(fs.SizeInBytes))})
+	}
+	writeTable(o.w, []string{"Name", "Environment", "ID", "Throughput Mode", "Mount Targets", "Size"}, rows)
+}
+
+func (o *listStorageOpts) jsonOutput(fileSystems []*envFileSystem) (string, error) {
+	type serializedFileSystems struct {
+		FileSystems []*envFileSystem `json:"fileSystems"`
+	}
+	b, err := json.Marshal(serializedFileSystems{FileSystems: fileSystems})
+	if err != nil {
+		return "", fmt.Errorf("marshal file systems: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// buildStorageListCmd builds the command for listing the file systems of an application.
+func buildStorageListCmd() *cobra.Command {
+	vars := listStorageVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the EFS file systems created with storage init in the environments of an application.",
+		Long: `Lists the EFS file systems created with storage init in the environments of an application.
+The throughput mode and the number of mount targets of each file system are listed.`,
+		Example: `
+  Lists the file systems of all the environments of the frontend application.
+  /code $ copilot storage ls -a frontend
+  Lists the file systems of the test environment in JSON format.
+  /code $ copilot storage ls -e test --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newListStorageOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", storageEnvFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
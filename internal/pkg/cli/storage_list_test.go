@@ -0,0 +1,209 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/efs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type storageListMocks struct {
+	store *mocks.Mockstore
+	sel   *mocks.MockappSelector
+	efs   *mocks.MockefsDescriber
+}
+
+func TestListStorageOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp      string
+		setupMocks func(m storageListMocks)
+
+		wantedApp   string
+		wantedError error
+	}{
+		"validate the application from the flag": {
+			inApp: "phonetool",
+			setupMocks: func(m storageListMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+			},
+			wantedApp: "phonetool",
+		},
+		"error if the application does not exist": {
+			inApp: "phonetool",
+			setupMocks: func(m storageListMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("validate application: some error"),
+		},
+		"select an application": {
+			setupMocks: func(m storageListMocks) {
+				m.sel.EXPECT().Application(storageListAppNamePrompt, storageListAppNameHelper).Return("phonetool", nil)
+			},
+			wantedApp: "phonetool",
+		},
+		"error if fail to select an application": {
+			setupMocks: func(m storageListMocks) {
+				m.sel.EXPECT().Application(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select application: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := storageListMocks{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockappSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &listStorageOpts{
+				listStorageVars: listStorageVars{
+					appName: tc.inApp,
+				},
+				store: m.store,
+				sel:   m.sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.appName)
+			}
+		})
+	}
+}
+
+func TestListStorageOpts_Execute(t *testing.T) {
+	mockTags := func(env string) map[string]string {
+		return map[string]string{
+			deploy.AppTagKey: "phonetool",
+			deploy.EnvTagKey: env,
+		}
+	}
+	testCases := map[string]struct {
+		inEnv      string
+		inJSON     bool
+		setupMocks func(m storageListMocks)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"list the file systems of all the environments": {
+			setupMocks: func(m storageListMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{Name: "test"},
+					{Name: "prod"},
+				}, nil)
+				m.efs.EXPECT().ListFileSystems(mockTags("test")).Return([]efs.FileSystem{
+					{
+						ID:                   "fs-1234",
+						Name:                 "copilot-phonetool-test-uploads",
+						ThroughputMode:       "bursting",
+						SizeInBytes:          6144,
+						NumberOfMountTargets: 2,
+					},
+					{
+						ID:   "fs-5678",
+						Name: "managed-by-a-service",
+					},
+				}, nil)
+				m.efs.EXPECT().ListFileSystems(mockTags("prod")).Return([]efs.FileSystem{
+					{
+						ID:                   "fs-abcd",
+						Name:                 "copilot-phonetool-prod-data",
+						ThroughputMode:       "elastic",
+						SizeInBytes:          2048,
+						NumberOfMountTargets: 2,
+					},
+				}, nil)
+			},
+			wantedContent: `Name                Environment         ID                  Throughput Mode     Mount Targets       Size
+----                -----------         --                  ---------------     -------------       ----
+data                prod                fs-abcd             elastic             2                   2.0 KiB
+uploads             test                fs-1234             bursting            2                   6.0 KiB
+`,
+		},
+		"list the file systems of an environment in JSON": {
+			inEnv:  "test",
+			inJSON: true,
+			setupMocks: func(m storageListMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.efs.EXPECT().ListFileSystems(mockTags("test")).Return([]efs.FileSystem{
+					{
+						ID:                   "fs-1234",
+						Name:                 "copilot-phonetool-test-uploads",
+						LifeCycleState:       "available",
+						ThroughputMode:       "bursting",
+						SizeInBytes:          6144,
+						NumberOfMountTargets: 2,
+					},
+				}, nil)
+			},
+			wantedContent: `{"fileSystems":[{"name":"uploads","environment":"test","id":"fs-1234","lifeCycleState":"available","throughputMode":"bursting","sizeInBytes":6144,"numberOfMountTargets":2}]}
+`,
+		},
+		"error if fail to get the environment": {
+			inEnv: "test",
+			setupMocks: func(m storageListMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get environment test in application phonetool: some error"),
+		},
+		"error if fail to list the file systems": {
+			inEnv: "test",
+			setupMocks: func(m storageListMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.efs.EXPECT().ListFileSystems(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list file systems of environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := storageListMocks{
+				store: mocks.NewMockstore(ctrl),
+				efs:   mocks.NewMockefsDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &listStorageOpts{
+				listStorageVars: listStorageVars{
+					appName:          "phonetool",
+					envName:          tc.inEnv,
+					shouldOutputJSON: tc.inJSON,
+				},
+				store: m.store,
+				newStorageClients: func(env *config.Environment) (*envStorageClients, error) {
+					return &envStorageClients{efs: m.efs}, nil
+				},
+				w: b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,471 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsssm "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+)
+
+const (
+	storageShowAppNamePrompt = "Which application is the storage in?"
+	storageShowAppNameHelper = "An application is a collection of related services."
+	storageShowNamePrompt    = "Which storage would you like to show?"
+	storageShowNameHelper    = "The configuration of the file system, DynamoDB table or Aurora cluster in each environment will be shown."
+)
+
+type showStorageVars struct {
+	appName          string
+	envName          string
+	name             string
+	shouldOutputJSON bool
+}
+
+type showStorageOpts struct {
+	showStorageVars
+
+	store             store
+	sel               appSelector
+	prompt            prompter
+	newStorageClients newEnvStorageClientsFunc
+	w                 io.Writer
+
+	// Cached storage resources and clients of the environments.
+	fileSystems []*envFileSystem
+	tables      []*envTable
+	clusters    []*envCluster
+	clients     map[string]*envStorageClients
+}
+
+// efsMountTarget is a mount target of a file system in a subnet of an environment.
+type efsMountTarget struct {
+	Environment      string `json:"environment"`
+	ID               string `json:"id"`
+	AvailabilityZone string `json:"availabilityZone"`
+	SubnetID         string `json:"subnetID"`
+	IPAddress        string `json:"ipAddress"`
+	LifeCycleState   string `json:"lifeCycleState"`
+}
+
+// efsAccessPoint is an access point of a file system, such as the one created for each workload by "storage init".
+type efsAccessPoint struct {
+	Environment   string `json:"environment"`
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	RootDirectory string `json:"rootDirectory"`
+}
+
+// efsFileSystemConfig holds the configuration of a file system in an environment.
+type efsFileSystemConfig struct {
+	*envFileSystem
+	TransitionToIA string           `json:"transitionToIA,omitempty"`
+	BackupStatus   string           `json:"backupStatus"`
+	MountTargets   []efsMountTarget `json:"mountTargets"`
+	AccessPoints   []efsAccessPoint `json:"accessPoints"`
+}
+
+// ddbTableConfig holds the configuration of a DynamoDB table in an environment.
+type ddbTableConfig struct {
+	*envTable
+	Status                 string   `json:"status"`
+	BillingMode            string   `json:"billingMode"`
+	ItemCount              int64    `json:"itemCount"`
+	SizeInBytes            int64    `json:"sizeInBytes"`
+	GlobalSecondaryIndexes []string `json:"globalSecondaryIndexes,omitempty"`
+	TTLAttribute           string   `json:"ttlAttribute,omitempty"`
+	StreamViewType         string   `json:"streamViewType,omitempty"`
+	StreamARN              string   `json:"streamARN,omitempty"`
+}
+
+// storageConfigs holds the configuration of the storage resources with the same name across environments.
+type storageConfigs struct {
+	fileSystems []*efsFileSystemConfig
+	tables      []*ddbTableConfig
+	clusters    []*envCluster
+}
+
+func (c *storageConfigs) isEmpty() bool {
+	return len(c.fileSystems) == 0 && len(c.tables) == 0 && len(c.clusters) == 0
+}
+
+func newShowStorageOpts(vars showStorageVars) (*showStorageOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("storage show"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	store := config.NewCachedSSMStore(identity.New(defaultSess), awsssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	prompter := prompt.New()
+	return &showStorageOpts{
+		showStorageVars:   vars,
+		store:             store,
+		sel:               selector.NewAppEnvSelector(prompter, store),
+		prompt:            prompter,
+		newStorageClients: newEnvStorageClients(sessProvider),
+		w:                 os.Stdout,
+	}, nil
+}
+
+// Ask prompts for and validates the application and the name of the storage resource.
+func (o *showStorageOpts) Ask() error {
+	if err := o.askAppName(); err != nil {
+		return err
+	}
+	return o.askStorageName()
+}
+
+// Execute shows the configuration of the file system, the DynamoDB table or the Aurora cluster in each environment.
+func (o *showStorageOpts) Execute() error {
+	if err := o.loadStorage(); err != nil {
+		return err
+	}
+	configs := &storageConfigs{}
+	for _, fs := range o.fileSystems {
+		if fs.Name != o.name {
+			continue
+		}
+		cfg, err := o.fileSystemConfig(fs)
+		if err != nil {
+			return err
+		}
+		configs.fileSystems = append(configs.fileSystems, cfg)
+	}
+	for _, table := range o.tables {
+		if table.Name != o.name {
+			continue
+		}
+		cfg, err := o.tableConfig(table)
+		if err != nil {
+			return err
+		}
+		configs.tables = append(configs.tables, cfg)
+	}
+	for _, cluster := range o.clusters {
+		// The name of a cluster is derived from its logical ID, which is stripped of non-alphanumeric characters.
+		if cluster.Name != template.StripNonAlphaNumFunc(o.name) {
+			continue
+		}
+		configs.clusters = append(configs.clusters, cluster)
+	}
+	if configs.isEmpty() {
+		return fmt.Errorf("storage %s not found in application %s", o.name, o.appName)
+	}
+
+	if o.shouldOutputJSON {
+		data, err := o.jsonOutput(configs)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	o.humanOutput(configs)
+	return nil
+}
+
+func (o *showStorageOpts) askAppName() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application: %w", err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(storageShowAppNamePrompt, storageShowAppNameHelper)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *showStorageOpts) askStorageName() error {
+	if o.name != "" {
+		return nil
+	}
+	if err := o.loadStorage(); err != nil {
+		return err
+	}
+	names := o.uniqueStorageNames()
+	if len(names) == 0 {
+		return fmt.Errorf("no storage found in application %s", o.appName)
+	}
+	name, err := o.prompt.SelectOne(storageShowNamePrompt, storageShowNameHelper, names, prompt.WithFinalMessage("Storage:"))
+	if err != nil {
+		return fmt.Errorf("select storage: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+func (o *showStorageOpts) loadStorage() error {
+	if o.clients != nil {
+		return nil
+	}
+	envs, err := envsInApp(o.store, o.appName, o.envName)
+	if err != nil {
+		return err
+	}
+	clients, err := storageClientsOfEnvs(envs, o.newStorageClients)
+	if err != nil {
+		return err
+	}
+	fileSystems, err := listFileSystemsInEnvs(o.appName, envs, clients)
+	if err != nil {
+		return err
+	}
+	tables, err := listTablesInEnvs(o.appName, envs, clients)
+	if err != nil {
+		return err
+	}
+	clusters, err := listClustersInEnvs(o.appName, envs, clients)
+	if err != nil {
+		return err
+	}
+	o.fileSystems, o.tables, o.clusters, o.clients = fileSystems, tables, clusters, clients
+	return nil
+}
+
+func (o *showStorageOpts) tableConfig(table *envTable) (*ddbTableConfig, error) {
+	client := o.clients[table.Environment].dynamoDB
+	desc, err := client.DescribeTable(table.TableName)
+	if err != nil {
+		return nil, err
+	}
+	ttl, err := client.TTLAttribute(table.TableName)
+	if err != nil {
+		return nil, err
+	}
+	return &ddbTableConfig{
+		envTable:               table,
+		Status:                 desc.Status,
+		BillingMode:            desc.BillingMode,
+		ItemCount:              desc.ItemCount,
+		SizeInBytes:            desc.SizeInBytes,
+		GlobalSecondaryIndexes: desc.GlobalSecondaryIndexes,
+		TTLAttribute:           ttl,
+		StreamViewType:         desc.StreamViewType,
+		StreamARN:              desc.StreamARN,
+	}, nil
+}
+
+func (o *showStorageOpts) fileSystemConfig(fs *envFileSystem) (*efsFileSystemConfig, error) {
+	client := o.clients[fs.Environment].efs
+	transitionToIA, err := client.TransitionToIA(fs.ID)
+	if err != nil {
+		return nil, err
+	}
+	backupStatus, err := client.BackupStatus(fs.ID)
+	if err != nil {
+		return nil, err
+	}
+	mountTargets, err := client.MountTargets(fs.ID)
+	if err != nil {
+		return nil, err
+	}
+	accessPoints, err := client.AccessPoints(fs.ID)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &efsFileSystemConfig{
+		envFileSystem:  fs,
+		TransitionToIA: transitionToIA,
+		BackupStatus:   backupStatus,
+		MountTargets:   make([]efsMountTarget, 0, len(mountTargets)),
+		AccessPoints:   make([]efsAccessPoint, 0, len(accessPoints)),
+	}
+	for _, mt := range mountTargets {
+		cfg.MountTargets = append(cfg.MountTargets, efsMountTarget{
+			Environment:      fs.Environment,
+			ID:               mt.ID,
+			AvailabilityZone: mt.AvailabilityZone,
+			SubnetID:         mt.SubnetID,
+			IPAddress:        mt.IPAddress,
+			LifeCycleState:   mt.LifeCycleState,
+		})
+	}
+	for _, ap := range accessPoints {
+		cfg.AccessPoints = append(cfg.AccessPoints, efsAccessPoint{
+			Environment:   fs.Environment,
+			ID:            ap.ID,
+			Name:          ap.Name,
+			RootDirectory: ap.RootDirectory,
+		})
+	}
+	return cfg, nil
+}
+
+func (o *showStorageOpts) humanOutput(configs *storageConfigs) {
+	writer := tabwriter.NewWriter(o.w, secretTableMinCellWidth, secretTableTabWidth, secretTableCellPaddingWidth, secretTablePaddingChar, 0)
+	fmt.Fprint(writer, color.Bold.Sprint("About\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Name", o.name)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Application", o.appName)
+	if len(configs.fileSystems) > 0 {
+		o.writeFileSystems(writer, configs.fileSystems)
+	}
+	if len(configs.tables) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nDynamoDB Tables\n\n"))
+		writer.Flush()
+		var rows [][]string
+		for _, cfg := range configs.tables {
+			rows = append(rows, []string{cfg.Environment, valueOrDash(cfg.Workload), cfg.TableName, cfg.Status, cfg.BillingMode,
+				strconv.FormatInt(cfg.ItemCount, 10), valueOrDash(cfg.TTLAttribute), valueOrDash(cfg.StreamViewType)})
+		}
+		writeIndentedTable(writer, []string{"Environment", "Workload", "Table", "Status", "Billing Mode", "Items", "TTL", "Stream"}, rows)
+	}
+	if len(configs.clusters) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nAurora Clusters\n\n"))
+		writer.Flush()
+		var rows [][]string
+		for _, c := range configs.clusters {
+			capacity := "-"
+			if c.MaxCapacity > 0 {
+				capacity = fmt.Sprintf("%v-%v ACUs", c.MinCapacity, c.MaxCapacity)
+			}
+			rows = append(rows, []string{c.Environment, valueOrDash(c.Workload), c.ID, c.Status, fmt.Sprintf("%s %s", c.Engine, c.EngineVersion), capacity,
+				fmt.Sprintf("%s:%d", c.Endpoint, c.Port), valueOrDash(c.ReaderEndpoint)})
+		}
+		writeIndentedTable(writer, []string{"Environment", "Workload", "ID", "Status", "Engine", "Capacity", "Endpoint", "Reader Endpoint"}, rows)
+	}
+	writer.Flush()
+}
+
+func (o *showStorageOpts) writeFileSystems(writer *tabwriter.Writer, configs []*efsFileSystemConfig) {
+	fmt.Fprint(writer, color.Bold.Sprint("\nFile Systems\n\n"))
+	writer.Flush()
+	var envRows, mountTargetRows, accessPointRows [][]string
+	for _, cfg := range configs {
+		envRows = append(envRows, []string{cfg.Environment, cfg.ID, cfg.ThroughputMode, valueOrDash(cfg.TransitionToIA), cfg.BackupStatus})
+		for _, mt := range cfg.MountTargets {
+			mountTargetRows = append(mountTargetRows, []string{mt.Environment, mt.ID, mt.AvailabilityZone, mt.SubnetID, mt.IPAddress, mt.LifeCycleState})
+		}
+		for _, ap := range cfg.AccessPoints {
+			accessPointRows = append(accessPointRows, []string{ap.Environment, ap.ID, ap.RootDirectory})
+		}
+	}
+	writeIndentedTable(writer, []string{"Environment", "ID", "Throughput Mode", "Transition to IA", "Backups"}, envRows)
+
+	fmt.Fprint(writer, color.Bold.Sprint("\nMount Targets\n\n"))
+	writer.Flush()
+	writeIndentedTable(writer, []string{"Environment", "ID", "Availability Zone", "Subnet", "IP Address", "State"}, mountTargetRows)
+
+	fmt.Fprint(writer, color.Bold.Sprint("\nAccess Points\n\n"))
+	writer.Flush()
+	writeIndentedTable(writer, []string{"Environment", "ID", "Root Directory"}, accessPointRows)
+}
+
+func (o *showStorageOpts) jsonOutput(configs *storageConfigs) (string, error) {
+	type serializedStorage struct {
+		Name        string                 `json:"name"`
+		Application string                 `json:"application"`
+		FileSystems []*efsFileSystemConfig `json:"fileSystems,omitempty"`
+		Tables      []*ddbTableConfig      `json:"tables,omitempty"`
+		Clusters    []*envCluster          `json:"clusters,omitempty"`
+	}
+	b, err := json.Marshal(serializedStorage{
+		Name:        o.name,
+		Application: o.appName,
+		FileSystems: configs.fileSystems,
+		Tables:      configs.tables,
+		Clusters:    configs.clusters,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal storage: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// writeIndentedTable writes the rows as an indented table with underlined headers without flushing the writer.
+func writeIndentedTable(w io.Writer, headers []string, rows [][]string) {
+	underlines := make([]string, len(headers))
+	for i, header := range headers {
+		underlines[i] = strings.Repeat("-", len(header))
+	}
+	fmt.Fprintf(w, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(w, "  %s\n", strings.Join(underlines, "\t"))
+	for _, row := range rows {
+		fmt.Fprintf(w, "  %s\n", strings.Join(row, "\t"))
+	}
+}
+
+func (o *showStorageOpts) uniqueStorageNames() []string {
+	seen := make(map[string]struct{})
+	var names []string
+	add := func(name string) {
+		if _, ok := seen[name]; ok {
+			return
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+	for _, fs := range o.fileSystems {
+		add(fs.Name)
+	}
+	for _, table := range o.tables {
+		add(table.Name)
+	}
+	for _, cluster := range o.clusters {
+		add(cluster.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// buildStorageShowCmd builds the command for showing a storage resource created with storage init.
+func buildStorageShowCmd() *cobra.Command {
+	vars := showStorageVars{}
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Shows the configuration of an EFS file system, a DynamoDB table or an Aurora cluster in each environment.",
+		Long: `Shows the configuration of an EFS file system, a DynamoDB table or an Aurora cluster in each environment.
+The mount targets and access points of a file system, the status, billing mode, TTL and stream of a table,
+and the status, capacity range and endpoints of a cluster are shown.
+Use the IDs of the file system and of the access point of your service to mount it under storage.volumes in the manifest.`,
+		Example: `
+  Shows the uploads file system in all the environments.
+  /code $ copilot storage show -n uploads
+  Shows the orders table in the test environment in JSON format.
+  /code $ copilot storage show -n orders -e test --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newShowStorageOpts(vars)
+			if err != nil {
+				return err
+			}
+			if err := opts.Ask(); err != nil {
+				return err
+			}
+			return opts.Execute()
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", storageEnvFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", storageNameFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
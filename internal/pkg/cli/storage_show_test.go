@@ -0,0 +1,396 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/dynamodb"
+	"github.com/aws/copilot-cli/internal/pkg/aws/efs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/rds"
+	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type storageShowMocks struct {
+	store     *mocks.Mockstore
+	prompt    *mocks.Mockprompter
+	efs       *mocks.MockefsDescriber
+	resources *mocks.MockresourcesByTagsGetter
+	dynamoDB  *mocks.MockdynamoDBTableDescriber
+	rds       *mocks.MockrdsClusterLister
+}
+
+func TestShowStorageOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(m storageShowMocks)
+
+		wantedName  string
+		wantedError error
+	}{
+		"skip selecting the storage if the name is provided": {
+			inName: "uploads",
+			setupMocks: func(m storageShowMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+			},
+			wantedName: "uploads",
+		},
+		"select a storage resource of the environments": {
+			setupMocks: func(m storageShowMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}, {Name: "prod"}}, nil)
+				m.efs.EXPECT().ListFileSystems(gomock.Any()).Return([]efs.FileSystem{
+					{ID: "fs-1234", Name: "copilot-phonetool-test-uploads"},
+				}, nil)
+				m.efs.EXPECT().ListFileSystems(gomock.Any()).Return([]efs.FileSystem{
+					{ID: "fs-5678", Name: "copilot-phonetool-prod-uploads"},
+					{ID: "fs-abcd", Name: "copilot-phonetool-prod-data"},
+				}, nil)
+				m.resources.EXPECT().GetResourcesByTags(dynamodb.ResourceTypeTable, map[string]string{
+					deploy.AppTagKey: "phonetool",
+					deploy.EnvTagKey: "test",
+				}).Return([]*resourcegroups.Resource{
+					{ARN: "arn:aws:dynamodb:us-west-2:123456789012:table/phonetool-test-orders"},
+					{
+						ARN:  "arn:aws:dynamodb:us-west-2:123456789012:table/phonetool-test-api-users",
+						Tags: map[string]string{deploy.ServiceTagKey: "api"},
+					},
+					{ARN: "arn:aws:dynamodb:us-west-2:123456789012:table/unmanaged"},
+				}, nil)
+				m.resources.EXPECT().GetResourcesByTags(dynamodb.ResourceTypeTable, gomock.Any()).Return(nil, nil)
+				m.rds.EXPECT().ListClusters(gomock.Any()).Return(nil, nil)
+				m.rds.EXPECT().ListClusters(gomock.Any()).Return([]rds.Cluster{
+					{ID: "cluster-1", Tags: map[string]string{"aws:cloudformation:logical-id": "inventoryDBCluster"}},
+					{ID: "cluster-2", Tags: map[string]string{"aws:cloudformation:logical-id": "OtherCluster"}},
+				}, nil)
+				m.prompt.EXPECT().SelectOne(storageShowNamePrompt, storageShowNameHelper, []string{"data", "inventory", "orders", "uploads", "users"}, gomock.Any()).
+					Return("uploads", nil)
+			},
+			wantedName: "uploads",
+		},
+		"error if there are no storage resources": {
+			setupMocks: func(m storageShowMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.efs.EXPECT().ListFileSystems(gomock.Any()).Return(nil, nil)
+				m.resources.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.rds.EXPECT().ListClusters(gomock.Any()).Return(nil, nil)
+			},
+			wantedError: errors.New("no storage found in application phonetool"),
+		},
+		"error if fail to list the tables": {
+			setupMocks: func(m storageShowMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.efs.EXPECT().ListFileSystems(gomock.Any()).Return(nil, nil)
+				m.resources.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list DynamoDB tables of environment test: some error"),
+		},
+		"error if fail to select a storage resource": {
+			setupMocks: func(m storageShowMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{{Name: "test"}}, nil)
+				m.efs.EXPECT().ListFileSystems(gomock.Any()).Return([]efs.FileSystem{
+					{ID: "fs-1234", Name: "copilot-phonetool-test-uploads"},
+				}, nil)
+				m.resources.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return(nil, nil)
+				m.rds.EXPECT().ListClusters(gomock.Any()).Return(nil, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select storage: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := storageShowMocks{
+				store:     mocks.NewMockstore(ctrl),
+				prompt:    mocks.NewMockprompter(ctrl),
+				efs:       mocks.NewMockefsDescriber(ctrl),
+				resources: mocks.NewMockresourcesByTagsGetter(ctrl),
+				rds:       mocks.NewMockrdsClusterLister(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &showStorageOpts{
+				showStorageVars: showStorageVars{
+					appName: "phonetool",
+					name:    tc.inName,
+				},
+				store:  m.store,
+				prompt: m.prompt,
+				newStorageClients: func(env *config.Environment) (*envStorageClients, error) {
+					return &envStorageClients{
+						efs:       m.efs,
+						resources: m.resources,
+						dynamoDB:  m.dynamoDB,
+						rds:       m.rds,
+					}, nil
+				},
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedName, opts.name)
+			}
+		})
+	}
+}
+
+func TestShowStorageOpts_Execute(t *testing.T) {
+	mockFileSystems := []efs.FileSystem{
+		{
+			ID:                   "fs-1234",
+			Name:                 "copilot-phonetool-test-uploads",
+			LifeCycleState:       "available",
+			ThroughputMode:       "bursting",
+			SizeInBytes:          6144,
+			NumberOfMountTargets: 2,
+		},
+	}
+	mockTables := []*resourcegroups.Resource{
+		{
+			ARN:  "arn:aws:dynamodb:us-west-2:123456789012:table/phonetool-test-api-orders",
+			Tags: map[string]string{deploy.ServiceTagKey: "api"},
+		},
+	}
+	mockClusters := []rds.Cluster{
+		{
+			ID:             "phonetool-test-inventorydb",
+			Status:         "available",
+			Engine:         "aurora-postgresql",
+			EngineVersion:  "14.4",
+			Endpoint:       "inventory.cluster-abc.us-west-2.rds.amazonaws.com",
+			ReaderEndpoint: "inventory.cluster-ro-abc.us-west-2.rds.amazonaws.com",
+			Port:           5432,
+			MinCapacity:    0.5,
+			MaxCapacity:    8,
+			Tags:           map[string]string{"aws:cloudformation:logical-id": "inventorydbDBCluster"},
+		},
+	}
+	testCases := map[string]struct {
+		inName     string
+		inJSON     bool
+		setupMocks func(m storageShowMocks)
+
+		wantedContent string
+		wantedError   error
+	}{
+		"error if the storage does not exist": {
+			inName: "data",
+			setupMocks: func(m storageShowMocks) {
+				m.efs.EXPECT().ListFileSystems(gomock.Any()).Return(mockFileSystems, nil)
+				m.resources.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return(mockTables, nil)
+				m.rds.EXPECT().ListClusters(gomock.Any()).Return(mockClusters, nil)
+			},
+			wantedError: errors.New("storage data not found in application phonetool"),
+		},
+		"show the configuration, mount targets and access points": {
+			inName: "uploads",
+			setupMocks: func(m storageShowMocks) {
+				m.efs.EXPECT().ListFileSystems(gomock.Any()).Return(mockFileSystems, nil)
+				m.resources.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return(mockTables, nil)
+				m.rds.EXPECT().ListClusters(gomock.Any()).Return(mockClusters, nil)
+				m.efs.EXPECT().TransitionToIA("fs-1234").Return("AFTER_30_DAYS", nil)
+				m.efs.EXPECT().BackupStatus("fs-1234").Return("ENABLED", nil)
+				m.efs.EXPECT().MountTargets("fs-1234").Return([]efs.MountTarget{
+					{
+						ID:               "fsmt-1",
+						SubnetID:         "subnet-1",
+						AvailabilityZone: "us-west-2a",
+						IPAddress:        "10.0.1.10",
+						LifeCycleState:   "available",
+					},
+				}, nil)
+				m.efs.EXPECT().AccessPoints("fs-1234").Return([]efs.AccessPoint{
+					{
+						ID:            "fsap-1",
+						Name:          "copilot-phonetool-test-api",
+						RootDirectory: "/api",
+					},
+				}, nil)
+			},
+			wantedContent: `About
+
+  Name              uploads
+  Application       phonetool
+
+File Systems
+
+  Environment       ID                  Throughput Mode     Transition to IA    Backups
+  -----------       --                  ---------------     ----------------    -------
+  test              fs-1234             bursting            AFTER_30_DAYS       ENABLED
+
+Mount Targets
+
+  Environment       ID                  Availability Zone   Subnet              IP Address          State
+  -----------       --                  -----------------   ------              ----------          -----
+  test              fsmt-1              us-west-2a          subnet-1            10.0.1.10           available
+
+Access Points
+
+  Environment       ID                  Root Directory
+  -----------       --                  --------------
+  test              fsap-1              /api
+`,
+		},
+		"show the file system in JSON": {
+			inName: "uploads",
+			inJSON: true,
+			setupMocks: func(m storageShowMocks) {
+				m.efs.EXPECT().ListFileSystems(gomock.Any()).Return(mockFileSystems, nil)
+				m.resources.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return(mockTables, nil)
+				m.rds.EXPECT().ListClusters(gomock.Any()).Return(mockClusters, nil)
+				m.efs.EXPECT().TransitionToIA("fs-1234").Return("", nil)
+				m.efs.EXPECT().BackupStatus("fs-1234").Return("DISABLED", nil)
+				m.efs.EXPECT().MountTargets("fs-1234").Return(nil, nil)
+				m.efs.EXPECT().AccessPoints("fs-1234").Return([]efs.AccessPoint{
+					{
+						ID:            "fsap-1",
+						Name:          "copilot-phonetool-test-api",
+						RootDirectory: "/api",
+					},
+				}, nil)
+			},
+			wantedContent: `{"name":"uploads","application":"phonetool","fileSystems":[{"name":"uploads","environment":"test","id":"fs-1234","lifeCycleState":"available","throughputMode":"bursting","sizeInBytes":6144,"numberOfMountTargets":2,"backupStatus":"DISABLED","mountTargets":[],"accessPoints":[{"environment":"test","id":"fsap-1","name":"copilot-phonetool-test-api","rootDirectory":"/api"}]}]}
+`,
+		},
+		"show the table with its TTL and stream": {
+			inName: "orders",
+			setupMocks: func(m storageShowMocks) {
+				m.efs.EXPECT().ListFileSystems(gomock.Any()).Return(mockFileSystems, nil)
+				m.resources.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return(mockTables, nil)
+				m.rds.EXPECT().ListClusters(gomock.Any()).Return(mockClusters, nil)
+				m.dynamoDB.EXPECT().DescribeTable("phonetool-test-api-orders").Return(&dynamodb.Table{
+					Name:           "phonetool-test-api-orders",
+					Status:         "ACTIVE",
+					BillingMode:    "PAY_PER_REQUEST",
+					ItemCount:      42,
+					StreamViewType: "NEW_IMAGE",
+				}, nil)
+				m.dynamoDB.EXPECT().TTLAttribute("phonetool-test-api-orders").Return("expiresAt", nil)
+			},
+			wantedContent: `About
+
+  Name              orders
+  Application       phonetool
+
+DynamoDB Tables
+
+  Environment       Workload            Table                      Status              Billing Mode        Items               TTL                 Stream
+  -----------       --------            -----                      ------              ------------        -----               ---                 ------
+  test              api                 phonetool-test-api-orders  ACTIVE              PAY_PER_REQUEST     42                  expiresAt           NEW_IMAGE
+`,
+		},
+		"show the cluster in JSON": {
+			inName: "inventory-db",
+			inJSON: true,
+			setupMocks: func(m storageShowMocks) {
+				m.efs.EXPECT().ListFileSystems(gomock.Any()).Return(mockFileSystems, nil)
+				m.resources.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return(mockTables, nil)
+				m.rds.EXPECT().ListClusters(gomock.Any()).Return(mockClusters, nil)
+			},
+			wantedContent: `{"name":"inventory-db","application":"phonetool","clusters":[{"name":"inventorydb","environment":"test","id":"phonetool-test-inventorydb","status":"available","engine":"aurora-postgresql","engineVersion":"14.4","endpoint":"inventory.cluster-abc.us-west-2.rds.amazonaws.com","readerEndpoint":"inventory.cluster-ro-abc.us-west-2.rds.amazonaws.com","port":5432,"minCapacity":0.5,"maxCapacity":8}]}
+`,
+		},
+		"show the cluster": {
+			inName: "inventorydb",
+			setupMocks: func(m storageShowMocks) {
+				m.efs.EXPECT().ListFileSystems(gomock.Any()).Return(mockFileSystems, nil)
+				m.resources.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return(mockTables, nil)
+				m.rds.EXPECT().ListClusters(gomock.Any()).Return(mockClusters, nil)
+			},
+			wantedContent: `About
+
+  Name              inventorydb
+  Application       phonetool
+
+Aurora Clusters
+
+  Environment       Workload            ID                          Status              Engine                  Capacity            Endpoint                                                Reader Endpoint
+  -----------       --------            --                          ------              ------                  --------            --------                                                ---------------
+  test              -                   phonetool-test-inventorydb  available           aurora-postgresql 14.4  0.5-8 ACUs          inventory.cluster-abc.us-west-2.rds.amazonaws.com:5432  inventory.cluster-ro-abc.us-west-2.rds.amazonaws.com
+`,
+		},
+		"error if fail to describe the table": {
+			inName: "orders",
+			setupMocks: func(m storageShowMocks) {
+				m.efs.EXPECT().ListFileSystems(gomock.Any()).Return(mockFileSystems, nil)
+				m.resources.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return(mockTables, nil)
+				m.rds.EXPECT().ListClusters(gomock.Any()).Return(mockClusters, nil)
+				m.dynamoDB.EXPECT().DescribeTable(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"error if fail to describe the mount targets": {
+			inName: "uploads",
+			setupMocks: func(m storageShowMocks) {
+				m.efs.EXPECT().ListFileSystems(gomock.Any()).Return(mockFileSystems, nil)
+				m.resources.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return(mockTables, nil)
+				m.rds.EXPECT().ListClusters(gomock.Any()).Return(mockClusters, nil)
+				m.efs.EXPECT().TransitionToIA("fs-1234").Return("AFTER_30_DAYS", nil)
+				m.efs.EXPECT().BackupStatus("fs-1234").Return("ENABLED", nil)
+				m.efs.EXPECT().MountTargets("fs-1234").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := storageShowMocks{
+				store:     mocks.NewMockstore(ctrl),
+				efs:       mocks.NewMockefsDescriber(ctrl),
+				resources: mocks.NewMockresourcesByTagsGetter(ctrl),
+				dynamoDB:  mocks.NewMockdynamoDBTableDescriber(ctrl),
+				rds:       mocks.NewMockrdsClusterLister(ctrl),
+			}
+			m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &showStorageOpts{
+				showStorageVars: showStorageVars{
+					appName:          "phonetool",
+					envName:          "test",
+					name:             tc.inName,
+					shouldOutputJSON: tc.inJSON,
+				},
+				store: m.store,
+				newStorageClients: func(env *config.Environment) (*envStorageClients, error) {
+					return &envStorageClients{
+						efs:       m.efs,
+						resources: m.resources,
+						dynamoDB:  m.dynamoDB,
+						rds:       m.rds,
+					}, nil
+				},
+				w: b,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, b.String())
+			}
+		})
+	}
+}
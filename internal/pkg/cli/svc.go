@@ -33,8 +33,19 @@ Services are long-running ECS or App Runner services.`,
 	cmd.AddCommand(buildSvcStatusCmd())
 	cmd.AddCommand(buildSvcLogsCmd())
 	cmd.AddCommand(buildSvcExecCmd())
+	cmd.AddCommand(buildSvcCpCmd())
+	cmd.AddCommand(buildSvcPortForwardCmd())
 	cmd.AddCommand(buildSvcPauseCmd())
 	cmd.AddCommand(buildSvcResumeCmd())
+	cmd.AddCommand(buildSvcScaleCmd())
+	cmd.AddCommand(buildSvcRollbackCmd())
+	cmd.AddCommand(buildSvcDeploymentsCmd())
+	cmd.AddCommand(buildSvcValidateCmd())
+	cmd.AddCommand(buildSvcManifestCmd())
+	cmd.AddCommand(buildSvcDriftCmd())
+	cmd.AddCommand(buildSvcVerifyCmd())
+	cmd.AddCommand(buildSvcTopologyCmd())
+	cmd.AddCommand(buildSvcDLQCmd())
 
 	cmd.SetUsageTemplate(template.Usage)
 
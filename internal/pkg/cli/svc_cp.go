@@ -0,0 +1,189 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// svcCpMaxFileSize is the largest file that can be copied, since every chunk opens a new exec session.
+	svcCpMaxFileSize = 512 * 1024
+	// svcCpChunkSize is the number of base64 characters sent to the container in a single command.
+	svcCpChunkSize = 3072
+)
+
+type svcCpVars struct {
+	execVars
+	src string
+	dst string
+}
+
+type svcCpOpts struct {
+	*svcExecOpts
+	src string
+	dst string
+
+	fs afero.Fs
+}
+
+func newSvcCpOpts(vars svcCpVars) (*svcCpOpts, error) {
+	execOpts, err := newSvcExecOpts(vars.execVars)
+	if err != nil {
+		return nil, err
+	}
+	return &svcCpOpts{
+		svcExecOpts: execOpts,
+		src:         vars.src,
+		dst:         vars.dst,
+		fs:          afero.NewOsFs(),
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcCpOpts) Validate() error {
+	info, err := o.fs.Stat(o.src)
+	if err != nil {
+		return fmt.Errorf("get info of local file %s: %w", o.src, err)
+	}
+	if info.IsDir() {
+		return fmt.Errorf("local path %s is a directory, only files can be copied", o.src)
+	}
+	if info.Size() > svcCpMaxFileSize {
+		return fmt.Errorf("local file %s is %d bytes, only files up to %d bytes can be copied", o.src, info.Size(), svcCpMaxFileSize)
+	}
+	if o.dst == "" {
+		return errors.New("remote path must not be empty")
+	}
+	if strings.ContainsAny(o.dst, `'"`) {
+		return fmt.Errorf("remote path %s must not contain quotes", o.dst)
+	}
+	return o.svcExecOpts.Validate()
+}
+
+// Execute copies a local file into a running container by streaming it through exec sessions.
+func (o *svcCpOpts) Execute() error {
+	content, err := afero.ReadFile(o.fs, o.src)
+	if err != nil {
+		return fmt.Errorf("read local file %s: %w", o.src, err)
+	}
+	wkld, err := o.store.GetWorkload(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get workload: %w", err)
+	}
+	if wkld.Type == manifestinfo.RequestDrivenWebServiceType || wkld.Type == manifestinfo.LambdaServiceType {
+		return fmt.Errorf("copying files to a running container is not supported for services with type: '%s'", wkld.Type)
+	}
+	sess, err := o.envSession()
+	if err != nil {
+		return err
+	}
+	svcDesc, err := o.newSvcDescriber(sess).DescribeService(o.appName, o.envName, o.name)
+	if err != nil {
+		return fmt.Errorf("describe ECS service for %s in environment %s: %w", o.name, o.envName, err)
+	}
+	task, err := o.selectTask(awsecs.FilterRunningTasks(svcDesc.Tasks))
+	if err != nil {
+		return err
+	}
+	taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+	if err != nil {
+		return err
+	}
+	container := o.selectContainer()
+	log.Infof("Copy %s to %s in container %s in task %s.\n", color.HighlightUserInput(o.src),
+		color.HighlightUserInput(o.dst), color.HighlightUserInput(container), color.HighlightResource(taskID))
+	executor := o.newCommandExecutor(sess)
+	for _, cmd := range copyFileCommands(content, o.dst) {
+		if err := executor.ExecuteCommand(awsecs.ExecuteCommandInput{
+			Cluster:   svcDesc.ClusterName,
+			Command:   cmd,
+			Container: container,
+			Task:      taskID,
+		}); err != nil {
+			var errExecCmd *awsecs.ErrExecuteCommand
+			if errors.As(err, &errExecCmd) {
+				log.Errorf("Failed to copy %s. Is %s set in your manifest?\n", o.src, color.HighlightCode("exec: true"))
+			}
+			return fmt.Errorf("copy %s to %s in container %s: %w", o.src, o.dst, container, err)
+		}
+	}
+	log.Successf("Copied %s to %s in container %s.\n", color.HighlightUserInput(o.src),
+		color.HighlightUserInput(o.dst), color.HighlightUserInput(container))
+	return nil
+}
+
+// copyFileCommands returns the commands that recreate content at path dst inside a container.
+// The content is base64 encoded and split into chunks so that each command stays within the exec command limit.
+func copyFileCommands(content []byte, dst string) []string {
+	encoded := base64.StdEncoding.EncodeToString(content)
+	if encoded == "" {
+		return []string{fmt.Sprintf(`/bin/sh -c ": > '%s'"`, dst)}
+	}
+	var cmds []string
+	redirect := ">"
+	for start := 0; start < len(encoded); start += svcCpChunkSize {
+		end := start + svcCpChunkSize
+		if end > len(encoded) {
+			end = len(encoded)
+		}
+		// Chunks are multiples of 4 characters so each one decodes on its own.
+		cmds = append(cmds, fmt.Sprintf(`/bin/sh -c "echo %s | base64 -d %s '%s'"`, encoded[start:end], redirect, dst))
+		redirect = ">>"
+	}
+	return cmds
+}
+
+// buildSvcCpCmd builds the command for copying a local file into a running container in a service.
+func buildSvcCpCmd() *cobra.Command {
+	vars := svcCpVars{}
+	var skipPrompt bool
+	cmd := &cobra.Command{
+		Use:   "cp <local path> <remote path>",
+		Short: "Copy a local file into a running container part of a service.",
+		Long: `Copy a local file into a running container part of a service.
+The file is transferred through ECS Exec sessions, so the container needs a shell and the "base64" utility.`,
+		Example: `
+  Copy a seed file into a task part of the "api" service.
+  /code $ copilot svc cp ./seed.sql /tmp/seed.sql -a my-app -e test -n api
+  Copy a config file into the "nginx" sidecar of the task prefixed with ID "8c38184".
+  /code $ copilot svc cp ./nginx.conf /etc/nginx/conf.d/default.conf -n frontend --task-id 8c38184 --container nginx`,
+		Args: cobra.ExactArgs(2),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.src, vars.dst = args[0], args[1]
+			opts, err := newSvcCpOpts(vars)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(yesFlag) {
+				opts.skipConfirmation = aws.Bool(false)
+				if skipPrompt {
+					opts.skipConfirmation = aws.Bool(true)
+				}
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", nameFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", containerFlagDescription)
+	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,199 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcCp_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inSrc   string
+		inDst   string
+		setupFS func(fs afero.Fs)
+
+		wantedError error
+	}{
+		"error if local file does not exist": {
+			inSrc:       "seed.sql",
+			inDst:       "/tmp/seed.sql",
+			setupFS:     func(fs afero.Fs) {},
+			wantedError: errors.New("get info of local file seed.sql: open seed.sql: file does not exist"),
+		},
+		"error if local path is a directory": {
+			inSrc: "data",
+			inDst: "/tmp/data",
+			setupFS: func(fs afero.Fs) {
+				_ = fs.Mkdir("data", 0755)
+			},
+			wantedError: errors.New("local path data is a directory, only files can be copied"),
+		},
+		"error if local file is too large": {
+			inSrc: "seed.sql",
+			inDst: "/tmp/seed.sql",
+			setupFS: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "seed.sql", make([]byte, svcCpMaxFileSize+1), 0644)
+			},
+			wantedError: fmt.Errorf("local file seed.sql is %d bytes, only files up to %d bytes can be copied", svcCpMaxFileSize+1, svcCpMaxFileSize),
+		},
+		"error if remote path contains quotes": {
+			inSrc: "seed.sql",
+			inDst: "/tmp/it's.sql",
+			setupFS: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "seed.sql", []byte("select 1;"), 0644)
+			},
+			wantedError: errors.New("remote path /tmp/it's.sql must not contain quotes"),
+		},
+		"success": {
+			inSrc: "seed.sql",
+			inDst: "/tmp/seed.sql",
+			setupFS: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "seed.sql", []byte("select 1;"), 0644)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			tc.setupFS(fs)
+			opts := &svcCpOpts{
+				svcExecOpts: &svcExecOpts{
+					execVars: execVars{
+						skipConfirmation: aws.Bool(false),
+					},
+				},
+				src: tc.inSrc,
+				dst: tc.inDst,
+				fs:  fs,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcCp_Execute(t *testing.T) {
+	const mockTaskARN = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockTaskID"
+	mockWl := config.Workload{
+		App:  "mockApp",
+		Name: "mockSvc",
+		Type: "Backend Service",
+	}
+	mockSvcDesc := &ecs.ServiceDesc{
+		ClusterName: "mockCluster",
+		Tasks: []*awsecs.Task{
+			{
+				TaskArn:    aws.String(mockTaskARN),
+				LastStatus: aws.String("RUNNING"),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		content    []byte
+		setupMocks func(executor *mocks.MockecsCommandExecutor)
+
+		wantedError error
+	}{
+		"return error if fail to execute command": {
+			content: []byte("hello"),
+			setupMocks: func(executor *mocks.MockecsCommandExecutor) {
+				executor.EXPECT().ExecuteCommand(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("copy seed.sql to /tmp/seed.sql in container mockSvc: some error"),
+		},
+		"write a small file in a single command": {
+			content: []byte("hello"),
+			setupMocks: func(executor *mocks.MockecsCommandExecutor) {
+				executor.EXPECT().ExecuteCommand(awsecs.ExecuteCommandInput{
+					Cluster:   "mockCluster",
+					Command:   `/bin/sh -c "echo aGVsbG8= | base64 -d > '/tmp/seed.sql'"`,
+					Container: "mockSvc",
+					Task:      "mockTaskID",
+				}).Return(nil)
+			},
+		},
+		"append the remaining chunks of a larger file": {
+			content: []byte(strings.Repeat("a", svcCpChunkSize)),
+			setupMocks: func(executor *mocks.MockecsCommandExecutor) {
+				gomock.InOrder(
+					executor.EXPECT().ExecuteCommand(gomock.Any()).DoAndReturn(func(in awsecs.ExecuteCommandInput) error {
+						require.True(t, strings.HasSuffix(in.Command, `| base64 -d > '/tmp/seed.sql'"`))
+						return nil
+					}),
+					executor.EXPECT().ExecuteCommand(gomock.Any()).DoAndReturn(func(in awsecs.ExecuteCommandInput) error {
+						require.True(t, strings.HasSuffix(in.Command, `| base64 -d >> '/tmp/seed.sql'"`))
+						return nil
+					}),
+				)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockStore.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+			mockStore.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{Name: "mockEnv"}, nil)
+			mockSessionProvider := mocks.NewMocksessionProvider(ctrl)
+			mockSessionProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil)
+			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
+			mockSvcDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockSvcDesc, nil)
+			mockExecutor := mocks.NewMockecsCommandExecutor(ctrl)
+			tc.setupMocks(mockExecutor)
+			fs := afero.NewMemMapFs()
+			_ = afero.WriteFile(fs, "seed.sql", tc.content, 0644)
+
+			opts := &svcCpOpts{
+				svcExecOpts: &svcExecOpts{
+					execVars: execVars{
+						name:    "mockSvc",
+						envName: "mockEnv",
+						appName: "mockApp",
+					},
+					store: mockStore,
+					newSvcDescriber: func(_ *session.Session) serviceDescriber {
+						return mockSvcDescriber
+					},
+					newCommandExecutor: func(_ *session.Session) ecsCommandExecutor {
+						return mockExecutor
+					},
+					randInt:      func(i int) int { return 0 },
+					sessProvider: mockSessionProvider,
+				},
+				src: "seed.sql",
+				dst: "/tmp/seed.sql",
+				fs:  fs,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
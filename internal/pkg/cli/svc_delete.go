@@ -8,11 +8,16 @@ package cli
 import (
 	"errors"
 	"fmt"
-	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"io"
 	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	awscfn "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	awss3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/cli/clean"
@@ -40,6 +45,10 @@ const (
 	fmtSvcDeleteFromEnvConfirmPrompt = "Are you sure you want to delete %s from environment %s?"
 	svcDeleteConfirmHelp             = "This will remove the service from all environments and delete it from your app."
 	svcDeleteFromEnvConfirmHelp      = "This will remove the service from just the %s environment."
+
+	fmtSvcRetainResourcesStart    = "Retaining %s resources of service %s in environment %s."
+	fmtSvcRetainResourcesFailed   = "Failed to retain %s resources of service %s in environment %s.\n"
+	fmtSvcRetainResourcesComplete = "Retained %s resources of service %s in environment %s.\n"
 )
 
 var (
@@ -55,6 +64,10 @@ type deleteSvcVars struct {
 	skipConfirmation bool
 	name             string
 	envName          string
+	dryRun           bool
+	retain           []string
+	waitForLock      bool
+	lockTimeout      time.Duration
 }
 
 type deleteSvcOpts struct {
@@ -70,6 +83,8 @@ type deleteSvcOpts struct {
 	getSvcCFN     func(sess *awssession.Session) wlDeleter
 	getECR        func(sess *awssession.Session) imageRemover
 	newSvcCleaner func(sess *awssession.Session, manifestType string) cleaner
+	planWriter    io.Writer
+	locker        stackLocker
 }
 
 func newDeleteSvcOpts(vars deleteSvcVars) (*deleteSvcOpts, error) {
@@ -96,6 +111,8 @@ func newDeleteSvcOpts(vars deleteSvcVars) (*deleteSvcOpts, error) {
 		getECR: func(sess *awssession.Session) imageRemover {
 			return ecr.New(sess)
 		},
+		planWriter: os.Stdout,
+		locker:     newStackLocker(defaultSession, vars.waitForLock, vars.lockTimeout),
 	}
 	opts.newSvcCleaner = func(sess *awssession.Session, manifestType string) cleaner {
 		if manifestType == manifestinfo.StaticSiteType {
@@ -108,7 +125,7 @@ func newDeleteSvcOpts(vars deleteSvcVars) (*deleteSvcOpts, error) {
 
 // Validate returns an error for any invalid optional flags.
 func (o *deleteSvcOpts) Validate() error {
-	return nil
+	return validateRetainedResourceClasses(o.retain)
 }
 
 // Ask prompts for and validates any required flags.
@@ -138,7 +155,7 @@ func (o *deleteSvcOpts) Ask() error {
 			return err
 		}
 	}
-	if o.skipConfirmation {
+	if o.skipConfirmation || o.dryRun {
 		return nil
 	}
 
@@ -171,6 +188,7 @@ func (o *deleteSvcOpts) Ask() error {
 // Execute deletes the service's CloudFormation stack.
 // If the service is being removed from the application, Execute will
 // also delete the ECR repository and the SSM parameter.
+// With a dry run, Execute only writes the plan of the deletion.
 func (o *deleteSvcOpts) Execute() error {
 	wkld, err := o.store.GetWorkload(o.appName, o.name)
 	if err != nil {
@@ -181,6 +199,9 @@ func (o *deleteSvcOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	if o.dryRun {
+		return o.showPlan(wkld.Type, envs)
+	}
 
 	if err := o.deleteStacks(wkld.Type, envs); err != nil {
 		return err
@@ -269,40 +290,150 @@ func (o *deleteSvcOpts) appEnvironments() ([]*config.Environment, error) {
 
 func (o *deleteSvcOpts) deleteStacks(wkldType string, envs []*config.Environment) error {
 	for _, env := range envs {
-		sess, err := o.sess.FromRole(env.ManagerRoleARN, env.Region)
-		if err != nil {
+		if err := o.deleteStack(wkldType, env); err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
+func (o *deleteSvcOpts) deleteStack(wkldType string, env *config.Environment) error {
+	sess, err := o.sess.FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return err
+	}
+	release, err := lockStack(o.locker, o.appName, stack.NameForWorkload(o.appName, env.Name, o.name), "svc delete")
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	// The objects of a retained bucket are kept as well.
+	if !contains(retainS3Class, o.retain) {
 		if err := o.newSvcCleaner(sess, wkldType).Clean(); err != nil {
 			return fmt.Errorf("clean resources: %w", err)
 		}
+	}
 
-		cfClient := o.getSvcCFN(sess)
-		if err := cfClient.DeleteWorkload(deploy.DeleteWorkloadInput{
-			Name:             o.name,
-			EnvName:          env.Name,
-			AppName:          o.appName,
-			ExecutionRoleARN: env.ExecutionRoleARN,
-		}); err != nil {
-			return fmt.Errorf("delete service: %w", err)
-		}
+	cfClient := o.getSvcCFN(sess)
+	if err := o.retainResources(cfClient, env); err != nil {
+		return err
+	}
+	if err := cfClient.DeleteWorkload(deploy.DeleteWorkloadInput{
+		Name:             o.name,
+		EnvName:          env.Name,
+		AppName:          o.appName,
+		ExecutionRoleARN: env.ExecutionRoleARN,
+	}); err != nil {
+		return fmt.Errorf("delete service: %w", err)
 	}
 	return nil
 }
 
-// This is to make mocking easier in unit tests
-func (o *deleteSvcOpts) emptyECRRepos(envs []*config.Environment) error {
-	var uniqueRegions []string
+// retainResources updates the service stack so that the resources of the retained classes are kept after the stack is deleted.
+func (o *deleteSvcOpts) retainResources(cfn wlDeleter, env *config.Environment) error {
+	if len(o.retain) == 0 {
+		return nil
+	}
+	body, err := o.stackTemplate(cfn, env.Name)
+	if err != nil || body == "" {
+		return err
+	}
+	newBody, err := retainResourcesInTemplate(body, o.retain)
+	if err != nil {
+		return fmt.Errorf("retain resources of service %s in environment %s: %w", o.name, env.Name, err)
+	}
+	if newBody == body {
+		return nil
+	}
+	classes := strings.Join(o.retain, ", ")
+	o.spinner.Start(fmt.Sprintf(fmtSvcRetainResourcesStart, classes, o.name, env.Name))
+	if err := cfn.UpdateWorkloadTemplate(o.appName, env.Name, o.name, newBody, env.ExecutionRoleARN); err != nil {
+		o.spinner.Stop(log.Serrorf(fmtSvcRetainResourcesFailed, classes, o.name, env.Name))
+		return fmt.Errorf("update stack of service %s in environment %s to retain resources: %w", o.name, env.Name, err)
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtSvcRetainResourcesComplete, classes, o.name, env.Name))
+	return nil
+}
+
+// stackTemplate returns the template of the service stack in an environment, or an empty string if the service isn't deployed there.
+func (o *deleteSvcOpts) stackTemplate(cfn wlDeleter, envName string) (string, error) {
+	stackName := stack.NameForWorkload(o.appName, envName, o.name)
+	body, err := cfn.Template(stackName)
+	if err != nil {
+		var errStackNotFound *awscfn.ErrStackNotFound
+		if errors.As(err, &errStackNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("get template of stack %s: %w", stackName, err)
+	}
+	return body, nil
+}
+
+func (o *deleteSvcOpts) showPlan(wkldType string, envs []*config.Environment) error {
+	plan := &deletePlan{
+		title: fmt.Sprintf("Plan to delete service %s from application %s:", o.name, o.appName),
+	}
+	if o.envName != "" {
+		plan.title = fmt.Sprintf("Plan to delete service %s from environment %s:", o.name, o.envName)
+	}
 	for _, env := range envs {
-		if !contains(env.Region, uniqueRegions) {
-			uniqueRegions = append(uniqueRegions, env.Region)
+		sess, err := o.sess.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return err
+		}
+		body, err := o.stackTemplate(o.getSvcCFN(sess), env.Name)
+		if err != nil {
+			return err
 		}
+		if body == "" {
+			continue
+		}
+		resources, err := plannedResources(body, o.retain)
+		if err != nil {
+			return fmt.Errorf("list resources of service %s in environment %s: %w", o.name, env.Name, err)
+		}
+		if wkldType == manifestinfo.StaticSiteType {
+			description := fmt.Sprintf("Empty the S3 bucket of the static site in environment %s.", env.Name)
+			if contains(retainS3Class, o.retain) {
+				plan.addRetained(description)
+			} else {
+				plan.add(description)
+			}
+		}
+		plan.add(fmt.Sprintf("Delete stack %s in environment %s.", stack.NameForWorkload(o.appName, env.Name, o.name), env.Name), resources...)
 	}
+	if o.needsAppCleanup() {
+		repoName, err := o.repoName()
+		if err != nil {
+			return err
+		}
+		for _, region := range uniqueRegions(envs) {
+			plan.add(fmt.Sprintf("Empty ECR repository %s in region %s.", repoName, region))
+		}
+		plan.add(fmt.Sprintf("Remove service %s from the application stack set, which deletes its ECR repositories.", o.name))
+		plan.add(fmt.Sprintf("Delete service %s from the config store.", o.name))
+	}
+	return plan.render(o.planWriter)
+}
 
-	// TODO: centralized ECR repo name
-	repoName := clideploy.RepoName(o.appName, o.name)
-	for _, region := range uniqueRegions {
+func uniqueRegions(envs []*config.Environment) []string {
+	var regions []string
+	for _, env := range envs {
+		if !contains(env.Region, regions) {
+			regions = append(regions, env.Region)
+		}
+	}
+	return regions
+}
+
+// This is to make mocking easier in unit tests
+func (o *deleteSvcOpts) emptyECRRepos(envs []*config.Environment) error {
+	repoName, err := o.repoName()
+	if err != nil {
+		return err
+	}
+	for _, region := range uniqueRegions(envs) {
 		sess, err := o.sess.DefaultWithRegion(region)
 		if err != nil {
 			return err
@@ -315,6 +446,14 @@ func (o *deleteSvcOpts) emptyECRRepos(envs []*config.Environment) error {
 	return nil
 }
 
+func (o *deleteSvcOpts) repoName() (string, error) {
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return "", fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	return app.RepositoryName(o.name), nil
+}
+
 func (o *deleteSvcOpts) removeSvcFromApp() error {
 	proj, err := o.store.GetApplication(o.appName)
 	if err != nil {
@@ -363,7 +502,13 @@ func buildSvcDeleteCmd() *cobra.Command {
   /code $ copilot svc delete --name test --app my-app
 
   Delete the "test" service without confirmation prompt.
-  /code $ copilot svc delete --name test --yes`,
+  /code $ copilot svc delete --name test --yes
+
+  Show the resources that would be deleted with the "test" service.
+  /code $ copilot svc delete --name test --dry-run
+
+  Delete the "test" service but keep its log groups and S3 buckets.
+  /code $ copilot svc delete --name test --retain logs,s3`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeleteSvcOpts(vars)
 			if err != nil {
@@ -377,5 +522,9 @@ func buildSvcDeleteCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, deleteDryRunFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.retain, retainFlag, nil, deleteRetainFlagDescription)
+	cmd.Flags().BoolVar(&vars.waitForLock, waitForLockFlag, false, waitForLockFlagDescription)
+	cmd.Flags().DurationVar(&vars.lockTimeout, lockTimeoutFlag, defaultLockTimeout, lockTimeoutFlagDescription)
 	return cmd
 }
@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"testing"
@@ -22,6 +23,7 @@ func TestDeleteSvcOpts_Validate(t *testing.T) {
 		inAppName  string
 		inEnvName  string
 		inName     string
+		inRetain   []string
 		setupMocks func(m *mocks.Mockstore)
 
 		want error
@@ -50,6 +52,12 @@ func TestDeleteSvcOpts_Validate(t *testing.T) {
 			setupMocks: func(m *mocks.Mockstore) {},
 			want:       nil,
 		},
+		"with an invalid class of resources to retain": {
+			inAppName:  "phonetool",
+			inRetain:   []string{"logs", "ecr"},
+			setupMocks: func(m *mocks.Mockstore) {},
+			want:       errors.New(`invalid value "ecr" for --retain: must be one of s3, logs`),
+		},
 	}
 
 	for name, test := range tests {
@@ -65,6 +73,7 @@ func TestDeleteSvcOpts_Validate(t *testing.T) {
 					appName: test.inAppName,
 					name:    test.inName,
 					envName: test.inEnvName,
+					retain:  test.inRetain,
 				},
 				store: mockstore,
 			}
@@ -312,6 +321,7 @@ type deleteSvcMocks struct {
 	spinner        *mocks.Mockprogress
 	svcCFN         *mocks.MockwlDeleter
 	ecr            *mocks.MockimageRemover
+	locker         *mocks.MockstackLocker
 }
 
 func TestDeleteSvcOpts_Execute(t *testing.T) {
@@ -331,6 +341,14 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 
 	mockRepo := fmt.Sprintf("%s/%s", mockAppName, mockSvcName)
 	testError := errors.New("some error")
+	mockTemplate := `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+  Service:
+    Type: AWS::ECS::Service
+  AddonsStack:
+    Type: AWS::CloudFormation::Stack
+`
 
 	tests := map[string]struct {
 		inAppName string
@@ -341,6 +359,7 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 		wkldCleaner cleaner
 		setupMocks  func(mocks deleteSvcMocks)
 
+		wantedPlan  string
 		wantedError error
 	}{
 		"happy path with no environment passed in as flag": {
@@ -366,6 +385,7 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 					// deleteStacks
 					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
 
+					mocks.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil),
 					mocks.sessProvider.EXPECT().DefaultWithRegion(gomock.Any()).Return(&session.Session{}, nil),
 
 					// emptyECRRepos
@@ -483,6 +503,71 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("delete service: %w", testError),
 		},
+		"retains the log groups of the service before deleting the stack": {
+			opts: &deleteSvcOpts{
+				deleteSvcVars: deleteSvcVars{
+					appName: mockAppName,
+					envName: mockEnvName,
+					name:    mockSvcName,
+					retain:  []string{"logs"},
+				},
+				newSvcCleaner: func(*session.Session, string) cleaner {
+					return &cleantest.Succeeds{}
+				},
+			},
+			setupMocks: func(mocks deleteSvcMocks) {
+				gomock.InOrder(
+					mocks.store.EXPECT().GetWorkload(mockAppName, mockSvcName).Return(&config.Workload{
+						Type: manifestinfo.LoadBalancedWebServiceType,
+					}, nil),
+					mocks.store.EXPECT().GetEnvironment(mockAppName, mockEnvName).Return(mockEnv, nil),
+					mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil),
+					mocks.svcCFN.EXPECT().Template("badgoose-test-backend").Return(mockTemplate, nil),
+					mocks.spinner.EXPECT().Start("Retaining logs resources of service backend in environment test."),
+					mocks.svcCFN.EXPECT().UpdateWorkloadTemplate(mockAppName, mockEnvName, mockSvcName, `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    DeletionPolicy: Retain
+  Service:
+    Type: AWS::ECS::Service
+  AddonsStack:
+    Type: AWS::CloudFormation::Stack
+`, "").Return(nil),
+					mocks.spinner.EXPECT().Stop(gomock.Any()),
+					mocks.svcCFN.EXPECT().DeleteWorkload(gomock.Any()).Return(nil),
+				)
+			},
+		},
+		"writes the plan without deleting anything on a dry run": {
+			opts: &deleteSvcOpts{
+				deleteSvcVars: deleteSvcVars{
+					appName: mockAppName,
+					name:    mockSvcName,
+					dryRun:  true,
+					retain:  []string{"logs"},
+				},
+			},
+			setupMocks: func(mocks deleteSvcMocks) {
+				gomock.InOrder(
+					mocks.store.EXPECT().GetWorkload(mockAppName, mockSvcName).Return(&config.Workload{
+						Type: manifestinfo.LoadBalancedWebServiceType,
+					}, nil),
+					mocks.store.EXPECT().ListEnvironments(mockAppName).Return(mockEnvs, nil),
+					mocks.sessProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil),
+					mocks.svcCFN.EXPECT().Template("badgoose-test-backend").Return(mockTemplate, nil),
+					mocks.store.EXPECT().GetApplication(mockAppName).Return(mockApp, nil),
+				)
+			},
+			wantedPlan: `Plan to delete service backend from application badgoose:
+  1. Delete stack badgoose-test-backend in environment test.
+       - log group LogGroup (retained)
+       - addons stack AddonsStack
+  2. Empty ECR repository badgoose/backend in region us-west-2.
+  3. Remove service backend from the application stack set, which deletes its ECR repositories.
+  4. Delete service backend from the config store.
+
+`,
+		},
 	}
 
 	for name, tc := range tests {
@@ -498,20 +583,25 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 				spinner:        mocks.NewMockprogress(ctrl),
 				svcCFN:         mocks.NewMockwlDeleter(ctrl),
 				ecr:            mocks.NewMockimageRemover(ctrl),
+				locker:         mocks.NewMockstackLocker(ctrl),
 			}
 
 			tc.setupMocks(mocks)
+			mocks.locker.EXPECT().Lock(gomock.Any(), gomock.Any(), gomock.Any()).Return(func() error { return nil }, nil).AnyTimes()
 
 			tc.opts.store = mocks.store
 			tc.opts.sess = mocks.sessProvider
 			tc.opts.spinner = mocks.spinner
 			tc.opts.appCFN = mocks.appCFN
+			tc.opts.locker = mocks.locker
 			tc.opts.getSvcCFN = func(_ *session.Session) wlDeleter {
 				return mocks.svcCFN
 			}
 			tc.opts.getECR = func(_ *session.Session) imageRemover {
 				return mocks.ecr
 			}
+			plan := &bytes.Buffer{}
+			tc.opts.planWriter = plan
 
 			// WHEN
 			err := tc.opts.Execute()
@@ -521,6 +611,7 @@ func TestDeleteSvcOpts_Execute(t *testing.T) {
 				require.EqualError(t, err, tc.wantedError.Error())
 			} else {
 				require.NoError(t, err)
+				require.Equal(t, tc.wantedPlan, plan.String())
 			}
 		})
 	}
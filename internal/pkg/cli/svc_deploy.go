@@ -4,24 +4,30 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/quota"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/afero"
 	"golang.org/x/mod/semver"
 
@@ -30,11 +36,14 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/buildcache"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/hooks"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/output"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -46,22 +55,40 @@ type deployWkldVars struct {
 	name               string
 	envName            string
 	imageTag           string
+	builder            string
+	buildRemote        bool
+	pinDigests         bool   // Resolve image tags to digests and render the digests in the task definition.
+	prebuiltImage      string // Existing image to deploy for the main container instead of building one.
 	resourceTags       map[string]string
 	forceNewUpdate     bool // NOTE: this variable is not applicable for a job workload currently.
 	disableRollback    bool
+	hotSwap            bool
 	showDiff           bool
 	skipDiffPrompt     bool
+	diffJSON           bool   // Write the diff as JSON instead of text.
+	preferDrift        string // "deployed" or "manifest" to resolve the reverted changes made outside of Copilot without prompting.
 	allowWkldDowngrade bool
+	waitForLock        bool
+	lockTimeout        time.Duration
+	quotaIncrease      bool   // Request an increase of the quotas that the deployment exceeds.
+	overrideFreeze     string // Reason to deploy in spite of the deploy windows and freezes.
+	noBuildCache       bool
 
 	// To facilitate unit tests.
 	clientConfigured bool
 }
 
+// targetEnvName returns the name of the environment to deploy to, once it is known.
+func (v deployWkldVars) targetEnvName() string {
+	return v.envName
+}
+
 type deploySvcOpts struct {
 	deployWkldVars
 
 	store                store
 	ws                   wsWlDirReader
+	mftEditor            workloadManifestEditor
 	unmarshal            func([]byte) (manifest.DynamicWorkload, error)
 	newInterpolator      func(app, env string) interpolator
 	cmd                  execRunner
@@ -70,6 +97,11 @@ type deploySvcOpts struct {
 	svcVersionGetter     versionGetter
 	envFeaturesDescriber versionCompatibilityChecker
 	diffWriter           io.Writer
+	locker               stackLocker
+	quotas               quotaChecker // Nil if the environment is in another account than the caller.
+	envOutputs           envOutputsGetter
+	buildCache           *buildcache.Cache // Nil with --no-build-cache.
+	hooks                *hooks.Runner     // Nil in tests.
 
 	spinner        progress
 	sel            wsSelector
@@ -83,8 +115,12 @@ type deploySvcOpts struct {
 	svcType           string
 	appliedDynamicMft manifest.DynamicWorkload
 	rootUserARN       string
+	callerARN         string
 	deployRecs        clideploy.ActionRecommender
 	noDeploy          bool
+	deployer          workloadDeployer
+	uploadOut         *clideploy.UploadArtifactsOutput // Set if the artifacts are packaged ahead of the deployment.
+	sourceImages      map[string]string                // Images built for another environment by deploy --envs, copied instead of built.
 
 	// Overridden in tests.
 	templateVersion string
@@ -95,7 +131,10 @@ func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
 	if err != nil {
 		return nil, err
 	}
+	return newSvcDeployOptsInWorkspace(vars, ws)
+}
 
+func newSvcDeployOptsInWorkspace(vars deployWkldVars, ws *workspace.Workspace) (*deploySvcOpts, error) {
 	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc deploy"))
 	defaultSession, err := sessProvider.Default()
 	if err != nil {
@@ -110,6 +149,7 @@ func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
 
 		store:           store,
 		ws:              ws,
+		mftEditor:       ws,
 		unmarshal:       manifest.UnmarshalWorkload,
 		spinner:         termprogress.NewSpinner(log.DiagnosticWriter),
 		sel:             selector.NewLocalWorkloadSelector(prompter, store, ws),
@@ -118,6 +158,9 @@ func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
 		cmd:             exec.NewCmd(),
 		sessProvider:    sessProvider,
 		diffWriter:      os.Stdout,
+		locker:          newStackLocker(defaultSession, vars.waitForLock, vars.lockTimeout),
+		buildCache:      workspaceBuildCache(ws, vars.noBuildCache),
+		hooks:           hooks.New(ws),
 		templateVersion: version.LatestTemplateVersion(),
 	}
 	opts.newSvcDeployer = func() (workloadDeployer, error) {
@@ -136,6 +179,10 @@ func newSvcDeployer(o *deploySvcOpts) (workloadDeployer, error) {
 	if err != nil {
 		return nil, fmt.Errorf("read manifest file for %s: %w", o.name, err)
 	}
+	rendered, err := renderedManifest(raw, o.newInterpolator(o.appName, o.envName))
+	if err != nil {
+		return nil, fmt.Errorf("interpolate environment variables for %s manifest: %w", o.name, err)
+	}
 	ovrdr, err := clideploy.NewOverrider(o.ws.WorkloadOverridesPath(o.name), o.appName, o.envName, afero.NewOsFs(), o.sessProvider)
 	if err != nil {
 		return nil, err
@@ -154,8 +201,18 @@ func newSvcDeployer(o *deploySvcOpts) (workloadDeployer, error) {
 		},
 		Mft:              content,
 		RawMft:           raw,
+		RenderedMft:      rendered,
 		EnvVersionGetter: o.envFeaturesDescriber,
 		Overrider:        ovrdr,
+		Builder:          o.builder,
+		BuildRemote:      o.buildRemote,
+		PinDigests:       o.pinDigests,
+		PrebuiltImage:    o.prebuiltImage,
+		BuildCache:       o.buildCache,
+		SourceImages:     o.sourceImages,
+	}
+	if ws, ok := o.ws.(*workspace.Workspace); ok {
+		in.Workspace = ws
 	}
 	switch t := content.(type) {
 	case *manifest.LoadBalancedWebService:
@@ -168,6 +225,8 @@ func newSvcDeployer(o *deploySvcOpts) (workloadDeployer, error) {
 		deployer, err = clideploy.NewWorkerSvcDeployer(&in)
 	case *manifest.StaticSite:
 		deployer, err = clideploy.NewStaticSiteDeployer(&in)
+	case *manifest.LambdaService:
+		deployer, err = clideploy.NewLambdaDeployer(&in)
 	default:
 		return nil, fmt.Errorf("unknown manifest type %T while creating the CloudFormation stack", t)
 	}
@@ -183,6 +242,17 @@ func newManifestInterpolator(app, env string) interpolator {
 
 // Validate returns an error for any invalid optional flags.
 func (o *deploySvcOpts) Validate() error {
+	if o.prebuiltImage != "" {
+		if _, err := ecr.ParseImageURI(o.prebuiltImage); err != nil {
+			return fmt.Errorf("validate --%s: %w", imageFlag, err)
+		}
+	}
+	if o.preferDrift != "" && !contains(o.preferDrift, driftPreferences) {
+		return fmt.Errorf("invalid value %q for --%s: must be one of %s", o.preferDrift, preferFlag, english.WordSeries(applyAll(driftPreferences, strconv.Quote), "or"))
+	}
+	if o.diffJSON && !o.showDiff {
+		return fmt.Errorf("--%s must be used with --%s", jsonFlag, diffFlag)
+	}
 	return nil
 }
 
@@ -209,62 +279,48 @@ func (o *deploySvcOpts) Ask() error {
 
 // Execute builds and pushes the container image for the service,
 func (o *deploySvcOpts) Execute() error {
-	if !o.clientConfigured {
-		if err := o.configureClients(); err != nil {
-			return err
-		}
-	}
-	if !o.allowWkldDowngrade {
-		if err := validateWkldVersion(o.svcVersionGetter, o.name, o.templateVersion); err != nil {
-			return err
-		}
-	}
-	mft, err := workloadManifest(&workloadManifestInput{
-		name:         o.name,
-		appName:      o.appName,
-		envName:      o.envName,
-		interpolator: o.newInterpolator(o.appName, o.envName),
-		ws:           o.ws,
-		unmarshal:    o.unmarshal,
-		sess:         o.envSess,
-	})
-	if err != nil {
+	hookIn := hooks.Input{App: o.appName, Environment: o.envName, Workload: o.name}
+	if err := o.hooks.Run(hooks.PreDeploy, hookIn); err != nil {
 		return err
 	}
-	if o.forceNewUpdate && o.svcType == manifestinfo.StaticSiteType {
-		return fmt.Errorf("--%s is not supported for service type %q", forceFlag, manifestinfo.StaticSiteType)
-	}
-	o.appliedDynamicMft = mft
-	if err := validateWorkloadManifestCompatibilityWithEnv(o.ws, o.envFeaturesDescriber, mft, o.envName); err != nil {
+	deployer, err := o.prepareDeployer()
+	if err != nil {
 		return err
 	}
-	deployer, err := o.newSvcDeployer()
+	targetApp, err := o.getTargetApp()
 	if err != nil {
 		return err
 	}
-	serviceInRegion, err := deployer.IsServiceAvailableInRegion(o.targetEnv.Region)
+	freezeOverride, err := checkDeployPolicy(targetApp, o.envName, time.Now(), o.overrideFreeze)
 	if err != nil {
-		return fmt.Errorf("check if %s is available in region %s: %w", o.svcType, o.targetEnv.Region, err)
-	}
-	if !serviceInRegion {
-		log.Warningf(`%s might not be available in region %s; proceed with caution.
-`, o.svcType, o.targetEnv.Region)
+		return err
 	}
-	uploadOut, err := deployer.UploadArtifacts()
-	if err != nil {
-		return fmt.Errorf("upload deploy resources for service %s: %w", o.name, err)
+	if o.quotas != nil {
+		if err := o.checkQuotas(o.appliedDynamicMft); err != nil {
+			return err
+		}
 	}
-	targetApp, err := o.getTargetApp()
+	release, err := lockStack(o.locker, o.appName, stack.NameForWorkload(o.appName, o.envName, o.name), "svc deploy")
 	if err != nil {
 		return err
 	}
-	if o.showDiff {
+	defer release()
+	uploadOut := o.uploadOut
+	if uploadOut == nil {
+		if uploadOut, err = deployer.UploadArtifacts(); err != nil {
+			return fmt.Errorf("upload deploy resources for service %s: %w", o.name, err)
+		}
+	}
+	checker := newTemplateChecker(targetApp, o.envName, o.cmd, log.DiagnosticWriter)
+	resolveDrift := o.showDiff || o.preferDrift != ""
+	if resolveDrift || checker.configured() {
 		output, err := deployer.GenerateCloudFormationTemplate(&clideploy.GenerateCloudFormationTemplateInput{
 			StackRuntimeConfiguration: clideploy.StackRuntimeConfiguration{
 				RootUserARN:               o.rootUserARN,
 				Tags:                      targetApp.Tags,
 				EnvFileARNs:               uploadOut.EnvFileARNs,
 				ImageDigests:              uploadOut.ImageDigests,
+				PinnedImages:              uploadOut.PinnedImages,
 				AddonsURL:                 uploadOut.AddonsURL,
 				CustomResourceURLs:        uploadOut.CustomResourceURLs,
 				StaticSiteAssetMappingURL: uploadOut.StaticSiteAssetMappingLocation,
@@ -274,22 +330,30 @@ func (o *deploySvcOpts) Execute() error {
 		if err != nil {
 			return fmt.Errorf("generate the template for workload %q against environment %q: %w", o.name, o.envName, err)
 		}
-		if err := diff(deployer, output.Template, o.diffWriter); err != nil {
-			var errHasDiff *errHasDiff
-			if !errors.As(err, &errHasDiff) {
+		if checker.configured() {
+			if err := checkStackTemplate(checker, deployer, stack.NameForWorkload(o.appName, o.envName, o.name), output.Template); err != nil {
 				return err
 			}
 		}
-		contd, err := o.skipDiffPrompt, nil
-		if !o.skipDiffPrompt {
-			contd, err = o.prompt.Confirm(continueDeploymentPrompt, "")
-		}
-		if err != nil {
-			return fmt.Errorf("ask whether to continue with the deployment: %w", err)
+		if resolveDrift {
+			contd, err := o.resolveRevertedDrift(deployer, output)
+			if err != nil {
+				return err
+			}
+			if !contd {
+				o.noDeploy = true
+				return nil
+			}
 		}
-		if !contd {
-			o.noDeploy = true
-			return nil
+		if o.showDiff {
+			contd, err := o.showDiffAndConfirm(deployer, output.Template)
+			if err != nil {
+				return err
+			}
+			if !contd {
+				o.noDeploy = true
+				return nil
+			}
 		}
 	}
 	var errStackDeletedOnInterrupt *deploycfn.ErrStackDeletedOnInterrupt
@@ -297,6 +361,7 @@ func (o *deploySvcOpts) Execute() error {
 	deployRecs, err := deployer.DeployWorkload(&clideploy.DeployWorkloadInput{
 		StackRuntimeConfiguration: clideploy.StackRuntimeConfiguration{
 			ImageDigests:              uploadOut.ImageDigests,
+			PinnedImages:              uploadOut.PinnedImages,
 			EnvFileARNs:               uploadOut.EnvFileARNs,
 			AddonsURL:                 uploadOut.AddonsURL,
 			RootUserARN:               o.rootUserARN,
@@ -308,6 +373,9 @@ func (o *deploySvcOpts) Execute() error {
 		Options: clideploy.Options{
 			ForceNewUpdate:  o.forceNewUpdate,
 			DisableRollback: o.disableRollback,
+			HotSwap:         o.hotSwap,
+			DeployedBy:      o.callerARN,
+			FreezeOverride:  freezeOverride,
 		},
 	})
 	if err != nil {
@@ -335,9 +403,115 @@ After fixing the deployment, you can:
 	}
 	log.Successf("Deployed service %s.\n", color.HighlightUserInput(o.name))
 	o.deployRecs = deployRecs
+	return o.hooks.Run(hooks.PostDeploy, hookIn)
+}
+
+func (o *deploySvcOpts) showDiffAndConfirm(deployer workloadDeployer, template string) (bool, error) {
+	var hasDiff bool
+	if o.diffJSON {
+		var err error
+		if hasDiff, err = writeJSONDiff(deployer, template, o.diffWriter); err != nil {
+			return false, err
+		}
+	} else if err := diff(deployer, template, o.diffWriter); err != nil {
+		var errHasDiff *errHasDiff
+		if !errors.As(err, &errHasDiff) {
+			return false, err
+		}
+		hasDiff = true
+	}
+	if !hasDiff || o.skipDiffPrompt {
+		return true, nil
+	}
+	contd, err := o.prompt.Confirm(continueDeploymentPrompt, "")
+	if err != nil {
+		return false, fmt.Errorf("ask whether to continue with the deployment: %w", err)
+	}
+	return contd, nil
+}
+
+// prepareDeployer reads the manifest of the service and returns the deployer for it.
+func (o *deploySvcOpts) prepareDeployer() (workloadDeployer, error) {
+	if o.deployer != nil {
+		return o.deployer, nil
+	}
+	if !o.clientConfigured {
+		if err := o.configureClients(); err != nil {
+			return nil, err
+		}
+	}
+	if !o.allowWkldDowngrade {
+		if err := validateWkldVersion(o.svcVersionGetter, o.name, o.templateVersion); err != nil {
+			return nil, err
+		}
+	}
+	mft, err := workloadManifest(&workloadManifestInput{
+		name:         o.name,
+		appName:      o.appName,
+		envName:      o.envName,
+		interpolator: o.newInterpolator(o.appName, o.envName),
+		ws:           o.ws,
+		unmarshal:    o.unmarshal,
+		sess:         o.envSess,
+		fs:           afero.NewOsFs(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if o.forceNewUpdate && (o.svcType == manifestinfo.StaticSiteType || o.svcType == manifestinfo.LambdaServiceType) {
+		return nil, fmt.Errorf("--%s is not supported for service type %q", forceFlag, o.svcType)
+	}
+	if o.hotSwap && (o.svcType == manifestinfo.StaticSiteType || o.svcType == manifestinfo.RequestDrivenWebServiceType || o.svcType == manifestinfo.LambdaServiceType) {
+		return nil, fmt.Errorf("--%s is not supported for service type %q", fastFlag, o.svcType)
+	}
+	if o.prebuiltImage != "" && (o.svcType == manifestinfo.StaticSiteType || o.svcType == manifestinfo.LambdaServiceType) {
+		return nil, fmt.Errorf("--%s is not supported for service type %q", imageFlag, o.svcType)
+	}
+	o.appliedDynamicMft = mft
+	if err := validateWorkloadManifestCompatibilityWithEnv(o.ws, o.envFeaturesDescriber, mft, o.envName); err != nil {
+		return nil, err
+	}
+	deployer, err := o.newSvcDeployer()
+	if err != nil {
+		return nil, err
+	}
+	serviceInRegion, err := deployer.IsServiceAvailableInRegion(o.targetEnv.Region)
+	if err != nil {
+		return nil, fmt.Errorf("check if %s is available in region %s: %w", o.svcType, o.targetEnv.Region, err)
+	}
+	if !serviceInRegion {
+		log.Warningf(`%s might not be available in region %s; proceed with caution.
+`, o.svcType, o.targetEnv.Region)
+	}
+	o.deployer = deployer
+	return deployer, nil
+}
+
+// packageArtifacts builds the images of the service and uploads its artifacts ahead of the deployment,
+// so that deploy --all can package several workloads at the same time before deploying them.
+func (o *deploySvcOpts) packageArtifacts() error {
+	deployer, err := o.prepareDeployer()
+	if err != nil {
+		return err
+	}
+	out, err := deployer.UploadArtifacts()
+	if err != nil {
+		return fmt.Errorf("upload deploy resources for service %s: %w", o.name, err)
+	}
+	o.uploadOut = out
 	return nil
 }
 
+// packagedImages returns the images pushed by packageArtifacts, pinned to their digest.
+func (o *deploySvcOpts) packagedImages() map[string]string {
+	return pinnedImages(o.uploadOut)
+}
+
+// replicateImages copies the images built for another environment instead of building them again.
+func (o *deploySvcOpts) replicateImages(images map[string]string) {
+	o.sourceImages = images
+}
+
 // RecommendActions returns follow-up actions the user can take after successfully executing the command.
 func (o *deploySvcOpts) RecommendActions() error {
 	if o.noDeploy {
@@ -433,6 +607,7 @@ func (o *deploySvcOpts) configureClients() error {
 		return fmt.Errorf("get identity: %w", err)
 	}
 	o.rootUserARN = caller.RootUserARN
+	o.callerARN = caller.ARN
 
 	envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 		App:         o.appName,
@@ -443,6 +618,16 @@ func (o *deploySvcOpts) configureClients() error {
 		return err
 	}
 	o.envFeaturesDescriber = envDescriber
+	o.envOutputs = envDescriber
+
+	// Quotas are checked with the credentials of the caller, which can only read the quotas of their own account.
+	if env.AccountID == caller.Account {
+		quotaSess, err := o.sessProvider.DefaultWithRegion(env.Region)
+		if err != nil {
+			return err
+		}
+		o.quotas = quota.New(quotaSess)
+	}
 
 	wkldDescriber, err := describe.NewWorkloadStackDescriber(describe.NewWorkloadConfig{
 		App:         o.appName,
@@ -457,6 +642,21 @@ func (o *deploySvcOpts) configureClients() error {
 	return nil
 }
 
+// checkQuotas stops the deployment if the resources of the service would exceed a quota of the account.
+func (o *deploySvcOpts) checkQuotas(mft manifest.DynamicWorkload) error {
+	outputs, err := o.envOutputs.Outputs()
+	if err != nil {
+		return fmt.Errorf("get outputs of environment %s: %w", o.envName, err)
+	}
+	var errStackNotExist *cloudformation.ErrStackNotFound
+	_, err = o.svcVersionGetter.Version()
+	needs, err := workloadQuotaNeeds(mft, outputs, errors.As(err, &errStackNotExist))
+	if err != nil {
+		return fmt.Errorf("compute the resources needed by service %s: %w", o.name, err)
+	}
+	return checkQuotas(o.quotas, needs, o.quotaIncrease)
+}
+
 type workloadManifestInput struct {
 	name         string
 	appName      string
@@ -465,6 +665,7 @@ type workloadManifestInput struct {
 	interpolator interpolator
 	sess         *session.Session
 	unmarshal    func([]byte) (manifest.DynamicWorkload, error)
+	fs           afero.Fs // File system to read the env files of the manifest from. Env files aren't checked if nil.
 }
 
 func workloadManifest(in *workloadManifestInput) (manifest.DynamicWorkload, error) {
@@ -484,6 +685,9 @@ func workloadManifest(in *workloadManifestInput) (manifest.DynamicWorkload, erro
 	if err != nil {
 		return nil, fmt.Errorf("apply environment %s override: %w", in.envName, err)
 	}
+	if err := checkWorkloadConflicts(in, raw); err != nil {
+		return nil, err
+	}
 	if err := envMft.Validate(); err != nil {
 		return nil, fmt.Errorf("validate manifest against environment %q: %w", in.envName, err)
 	}
@@ -493,6 +697,85 @@ func workloadManifest(in *workloadManifestInput) (manifest.DynamicWorkload, erro
 	return envMft, nil
 }
 
+// checkWorkloadConflicts logs every conflict of the manifest in the environment, and returns an error if there is any.
+// Env files are read only if the manifest has some, and the other workloads only if the manifest uses Service Connect.
+func checkWorkloadConflicts(in *workloadManifestInput, raw []byte) error {
+	conflictsIn := manifest.ConflictsInput{
+		Manifest: raw,
+		App:      in.appName,
+		Env:      in.envName,
+	}
+	if in.fs != nil {
+		conflictsIn.EnvFileVariables = func(path string) ([]string, error) {
+			content, err := afero.ReadFile(in.fs, filepath.Join(in.ws.Path(), path))
+			if err != nil {
+				return nil, err
+			}
+			vars, err := manifest.UnmarshalEnvFile(content, manifest.NewInterpolator(in.appName, in.envName))
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(vars))
+			for name := range vars {
+				names = append(names, name)
+			}
+			return names, nil
+		}
+	}
+	if len(manifest.ServiceConnectAliases(raw, in.appName, in.envName)) > 0 {
+		aliases, err := serviceConnectAliases(in)
+		if err != nil {
+			return err
+		}
+		conflictsIn.Aliases = aliases
+	}
+	conflicts := manifest.CheckConflicts(conflictsIn)
+	if len(conflicts) == 0 {
+		return nil
+	}
+	for _, conflict := range conflicts {
+		log.Errorln(conflict.Error())
+	}
+	return fmt.Errorf("found %s in the manifest for %s in environment %s",
+		english.Plural(len(conflicts), "conflict", "conflicts"), in.name, in.envName)
+}
+
+// serviceConnectAliases returns the Service Connect aliases of the other workloads of the workspace in the environment,
+// mapped to the names of the workloads.
+func serviceConnectAliases(in *workloadManifestInput) (map[string]string, error) {
+	names, err := in.ws.ListWorkloads()
+	if err != nil {
+		return nil, fmt.Errorf("list workloads: %w", err)
+	}
+	aliases := make(map[string]string)
+	for _, name := range names {
+		if name == in.name {
+			continue
+		}
+		raw, err := in.ws.ReadWorkloadManifest(name)
+		if err != nil {
+			return nil, fmt.Errorf("read manifest file for %s: %w", name, err)
+		}
+		for _, alias := range manifest.ServiceConnectAliases(raw, in.appName, in.envName) {
+			aliases[alias] = name
+		}
+	}
+	return aliases, nil
+}
+
+// renderedManifest returns the manifest with its environment variables substituted,
+// or nil if it has none so that the stack doesn't store the same manifest twice.
+func renderedManifest(raw []byte, interpolator interpolator) ([]byte, error) {
+	rendered, err := interpolator.Interpolate(string(raw))
+	if err != nil {
+		return nil, err
+	}
+	if rendered == string(raw) {
+		return nil, nil
+	}
+	return []byte(rendered), nil
+}
+
 func validateWorkloadManifestCompatibilityWithEnv(ws wsEnvironmentsLister, env versionCompatibilityChecker, mft manifest.DynamicWorkload, envName string) error {
 	currVersion, err := env.Version()
 	if err != nil {
@@ -673,6 +956,58 @@ func diff(differ templateDiffer, tmpl string, writer io.Writer) error {
 	return nil
 }
 
+// jsonDiff is a diff of templates written as JSON.
+type jsonDiff string
+
+// HumanString returns the JSON as is, since the diff is only requested as JSON.
+func (d jsonDiff) HumanString() string {
+	return string(d)
+}
+
+// JSONString returns the JSON of the diff.
+func (d jsonDiff) JSONString() (string, error) {
+	return string(d), nil
+}
+
+// writeJSONDiff writes the changes of the template against the deployed stacks as JSON, and returns true if there are any.
+func writeJSONDiff(differ jsonTemplateDiffer, tmpl string, writer io.Writer) (bool, error) {
+	out, err := differ.DeployDiffJSON(tmpl)
+	if err != nil {
+		return false, err
+	}
+	if err := output.New(writer, true).Write(jsonDiff(out)); err != nil {
+		return false, fmt.Errorf("write diff: %w", err)
+	}
+	var changes struct {
+		Stack  []json.RawMessage `json:"stack"`
+		Addons []json.RawMessage `json:"addons"`
+	}
+	if err := json.Unmarshal([]byte(out), &changes); err != nil {
+		return false, fmt.Errorf("unmarshal diff: %w", err)
+	}
+	return len(changes.Stack)+len(changes.Addons) > 0, nil
+}
+
+// writeDiffFile writes the diff of the template against the deployed stack to the file at path,
+// creating its parent directories if they don't exist.
+func writeDiffFile(fs afero.Fs, path string, differ templateDiffer, tmpl string) error {
+	if err := fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("create directory for %s: %w", path, err)
+	}
+	f, err := fs.Create(path)
+	if err != nil {
+		return fmt.Errorf("create file %s: %w", path, err)
+	}
+	defer f.Close()
+	if err := diff(differ, tmpl, f); err != nil {
+		var errHasDiff *errHasDiff
+		if !errors.As(err, &errHasDiff) {
+			return fmt.Errorf("write diff to %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
 // buildSvcDeployCmd builds the `svc deploy` subcommand.
 func buildSvcDeployCmd() *cobra.Command {
 	vars := deployWkldVars{}
@@ -684,7 +1019,11 @@ func buildSvcDeployCmd() *cobra.Command {
   Deploys a service named "frontend" to a "test" environment.
   /code $ copilot svc deploy --name frontend --env test
   Deploys a service with additional resource tags.
-  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual`,
+  /code $ copilot svc deploy --resource-tags source/revision=bb133e7,deployment/initiator=manual
+  Updates the image of a service without a stack update, if the image is the only change.
+  /code $ copilot svc deploy --name frontend --env test --fast
+  Keeps the task count set outside of Copilot by writing it to the manifest, instead of reverting it.
+  /code $ copilot svc deploy --name frontend --env test --prefer deployed`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcDeployOpts(vars)
 			if err != nil {
@@ -697,11 +1036,29 @@ func buildSvcDeployCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVar(&vars.imageTag, imageTagFlag, "", imageTagFlagDescription)
+	cmd.Flags().StringVar(&vars.builder, builderFlag, "", builderFlagDescription)
+	cmd.Flags().BoolVar(&vars.buildRemote, buildRemoteFlag, false, buildRemoteFlagDescription)
+	cmd.Flags().BoolVar(&vars.pinDigests, pinDigestsFlag, false, pinDigestsFlagDescription)
+	cmd.Flags().StringVar(&vars.prebuiltImage, imageFlag, "", deployImageFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 	cmd.Flags().BoolVar(&vars.forceNewUpdate, forceFlag, false, forceFlagDescription)
 	cmd.Flags().BoolVar(&vars.disableRollback, noRollbackFlag, false, noRollbackFlagDescription)
+	cmd.Flags().BoolVar(&vars.hotSwap, fastFlag, false, fastFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipDiffPrompt, diffAutoApproveFlag, false, diffAutoApproveFlagDescription)
+	cmd.Flags().BoolVar(&vars.diffJSON, jsonFlag, false, diffJSONFlagDescription)
+	cmd.Flags().StringVar(&vars.preferDrift, preferFlag, "", preferFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
+	cmd.Flags().BoolVar(&vars.waitForLock, waitForLockFlag, false, waitForLockFlagDescription)
+	cmd.Flags().DurationVar(&vars.lockTimeout, lockTimeoutFlag, defaultLockTimeout, lockTimeoutFlagDescription)
+	cmd.Flags().BoolVar(&vars.quotaIncrease, requestQuotaIncreaseFlag, false, requestQuotaIncreaseFlagDescription)
+	cmd.Flags().StringVar(&vars.overrideFreeze, overrideFreezeFlag, "", overrideFreezeFlagDescription)
+	cmd.Flags().BoolVar(&vars.noBuildCache, noBuildCacheFlag, false, noBuildCacheFlagDescription)
+	cmd.MarkFlagsMutuallyExclusive(builderFlag, buildRemoteFlag)
+	cmd.MarkFlagsMutuallyExclusive(forceFlag, fastFlag)
+	cmd.MarkFlagsMutuallyExclusive(preferFlag, fastFlag)
+	cmd.MarkFlagsMutuallyExclusive(imageFlag, imageTagFlag)
+	cmd.MarkFlagsMutuallyExclusive(imageFlag, builderFlag)
+	cmd.MarkFlagsMutuallyExclusive(imageFlag, buildRemoteFlag)
 	return cmd
 }
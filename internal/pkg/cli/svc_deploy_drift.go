@@ -0,0 +1,228 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/dustin/go-humanize/english"
+	"gopkg.in/yaml.v3"
+
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+)
+
+const (
+	driftPreferDeployed = "deployed"
+	driftPreferManifest = "manifest"
+)
+
+var driftPreferences = []string{driftPreferDeployed, driftPreferManifest}
+
+const (
+	fmtResolveDriftPrompt = "How do you want to resolve the changes to service %s?"
+	resolveDriftHelp      = `Deploying the manifest sets the values changed outside of Copilot back to the values of the manifest.
+Keeping the deployed values writes them to the environment overrides of the manifest, so that the next deployments keep them.`
+	resolveDriftKeepDeployed = "Keep the deployed values and write them to the manifest"
+	resolveDriftKeepManifest = "Keep the manifest values and deploy them"
+	resolveDriftAbort        = "Abort the deployment"
+)
+
+// driftManifestFields are the manifest fields that render the stack parameters, so that the deployed values of
+// the parameters can be written back to the manifest.
+var driftManifestFields = map[string]string{
+	stack.WorkloadTaskCountParamKey:    "count",
+	stack.WorkloadTaskCPUParamKey:      "cpu",
+	stack.WorkloadTaskMemoryParamKey:   "memory",
+	stack.RDWkldInstanceCPUParamKey:    "cpu",
+	stack.RDWkldInstanceMemoryParamKey: "memory",
+}
+
+// resolveRevertedDrift detects whether the deployment would only revert changes made outside of Copilot to the service,
+// and resolves them either by writing the deployed values to the manifest or by deploying the manifest.
+// It returns false if the deployment should not continue.
+func (o *deploySvcOpts) resolveRevertedDrift(deployer workloadDeployer, output *clideploy.GenerateCloudFormationTemplateOutput) (bool, error) {
+	reverter, ok := deployer.(driftReverter)
+	if !ok {
+		return true, nil
+	}
+	drifted, err := reverter.RevertedDrift(output)
+	if err != nil {
+		return false, fmt.Errorf("detect changes made outside of Copilot to service %s: %w", o.name, err)
+	}
+	if len(drifted) == 0 {
+		return true, nil
+	}
+	mft, err := o.ws.ReadWorkloadManifest(o.name)
+	if err != nil {
+		return false, fmt.Errorf("read manifest file for %s: %w", o.name, err)
+	}
+	fields, unwritable, err := driftedManifestFields(mft, o.envName, drifted)
+	if err != nil {
+		return false, fmt.Errorf("read manifest fields of %s: %w", o.name, err)
+	}
+	log.Warningf("Deploying service %s to environment %s only reverts changes made outside of Copilot since its last deployment:\n\n", o.name, o.envName)
+	writeDriftedParameters(o.diffWriter, drifted)
+
+	choice, err := o.resolveDriftChoice(unwritable)
+	if err != nil {
+		return false, err
+	}
+	switch choice {
+	case driftPreferManifest:
+		return true, nil
+	case driftPreferDeployed:
+		if len(unwritable) != 0 {
+			return false, fmt.Errorf("cannot write %s back to the manifest of service %s", english.WordSeries(unwritable, "and"), o.name)
+		}
+		path, err := o.mftEditor.EditWorkloadManifest(o.name, func(raw []byte) ([]byte, error) {
+			rawFields, rawUnwritable, err := driftedManifestFields(raw, o.envName, drifted)
+			if err != nil {
+				return nil, err
+			}
+			if len(rawUnwritable) != 0 {
+				return nil, fmt.Errorf("cannot write %s back to the manifest", english.WordSeries(rawUnwritable, "and"))
+			}
+			return setEnvironmentOverrides(raw, o.envName, rawFields)
+		})
+		if err != nil {
+			return false, err
+		}
+		log.Successf("Wrote the deployed values of %s to %s.\n", english.WordSeries(sortedKeys(fields), "and"), color.HighlightResource(path))
+		log.Infof("Service %s already runs with them in environment %s, so there is nothing to deploy.\n", o.name, o.envName)
+		return false, nil
+	}
+	return false, nil
+}
+
+// resolveDriftChoice returns the preference of --prefer, or prompts for how to resolve the drift.
+// Without --prefer, approving the diff with --diff-yes deploys the manifest.
+func (o *deploySvcOpts) resolveDriftChoice(unwritable []string) (string, error) {
+	if o.preferDrift != "" {
+		return o.preferDrift, nil
+	}
+	if o.skipDiffPrompt {
+		return driftPreferManifest, nil
+	}
+	opts := []string{resolveDriftKeepDeployed, resolveDriftKeepManifest, resolveDriftAbort}
+	if len(unwritable) != 0 {
+		log.Infof("The deployed values of %s can't be written back to the manifest.\n", english.WordSeries(unwritable, "and"))
+		opts = opts[1:]
+	}
+	choice, err := o.prompt.SelectOne(fmt.Sprintf(fmtResolveDriftPrompt, color.HighlightUserInput(o.name)), resolveDriftHelp, opts, prompt.WithFinalMessage("Resolution:"))
+	if err != nil {
+		return "", fmt.Errorf("select how to resolve the changes to service %s: %w", o.name, err)
+	}
+	switch choice {
+	case resolveDriftKeepDeployed:
+		return driftPreferDeployed, nil
+	case resolveDriftKeepManifest:
+		return driftPreferManifest, nil
+	}
+	return "", nil
+}
+
+func writeDriftedParameters(w io.Writer, drifted []clideploy.DriftedParameter) {
+	tw := tabwriter.NewWriter(w, 4, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "  %s\n", strings.Join([]string{"Parameter", "Manifest field", "Deployed", "Manifest"}, "\t"))
+	for _, param := range drifted {
+		fmt.Fprintf(tw, "  %s\n", strings.Join([]string{param.Key, orDash(driftManifestFields[param.Key]), param.Deployed, param.Manifest}, "\t"))
+	}
+	tw.Flush()
+	fmt.Fprintln(w)
+}
+
+// driftedManifestFields returns the manifest fields to set to the deployed values of the drifted parameters,
+// and the parameters that can't be written back to the manifest. A parameter can't be written back if no manifest
+// field renders it, or if the field is not a single value in the environment, such as a count with autoscaling.
+func driftedManifestFields(mft []byte, env string, drifted []clideploy.DriftedParameter) (fields map[string]string, unwritable []string, err error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(mft, &doc); err != nil {
+		return nil, nil, err
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, nil, errors.New("manifest is not a map")
+	}
+	root := doc.Content[0]
+	envOverrides := mappingValue(mappingValue(root, "environments"), env)
+	fields = make(map[string]string)
+	for _, param := range drifted {
+		field, ok := driftManifestFields[param.Key]
+		if !ok {
+			unwritable = append(unwritable, param.Key)
+			continue
+		}
+		node := mappingValue(envOverrides, field)
+		if node == nil {
+			node = mappingValue(root, field)
+		}
+		if node != nil && node.Kind != yaml.ScalarNode {
+			unwritable = append(unwritable, param.Key)
+			continue
+		}
+		fields[field] = param.Deployed
+	}
+	return fields, unwritable, nil
+}
+
+// setEnvironmentOverrides sets the fields under "environments.{env}" of a workload manifest while preserving its other fields and comments.
+func setEnvironmentOverrides(raw []byte, env string, fields map[string]string) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, errors.New("manifest is not a map")
+	}
+	overrides := mappingChild(mappingChild(doc.Content[0], "environments"), env)
+	for _, field := range sortedKeys(fields) {
+		value := &yaml.Node{Kind: yaml.ScalarNode, Value: fields[field]}
+		if node := mappingValue(overrides, field); node != nil {
+			*node = *value
+			continue
+		}
+		overrides.Content = append(overrides.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: field}, value)
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// mappingChild returns the mapping under key in a mapping node, adding it if the key doesn't exist or has no value.
+func mappingChild(node *yaml.Node, key string) *yaml.Node {
+	child := mappingValue(node, key)
+	if child == nil {
+		child = &yaml.Node{}
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, child)
+	}
+	if child.Kind != yaml.MappingNode {
+		*child = yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	}
+	return child
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,166 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// driftReverterDouble is a workload deployer that detects the changes made outside of Copilot that the deployment reverts.
+type driftReverterDouble struct {
+	workloadDeployer
+	drifted []clideploy.DriftedParameter
+	err     error
+}
+
+func (d *driftReverterDouble) RevertedDrift(_ *clideploy.GenerateCloudFormationTemplateOutput) ([]clideploy.DriftedParameter, error) {
+	return d.drifted, d.err
+}
+
+func TestDeploySvcOpts_resolveRevertedDrift(t *testing.T) {
+	const mft = `# The manifest of the api service.
+name: api
+type: Backend Service
+count: 1 # Number of tasks.
+environments:
+  prod:
+    count:
+      range: 1-10
+`
+	countDrift := []clideploy.DriftedParameter{{Key: "TaskCount", Deployed: "3", Manifest: "1"}}
+	testCases := map[string]struct {
+		inPrefer   string
+		inDiffYes  bool
+		inEnv      string
+		inDrifted  []clideploy.DriftedParameter
+		inErr      error
+		setupMocks func(p *mocks.Mockprompter)
+
+		wantedContinue bool
+		wantedManifest string
+		wantedError    string
+	}{
+		"continue if nothing is reverted": {
+			inEnv:          "test",
+			wantedContinue: true,
+		},
+		"wrap the error of the drift detection": {
+			inEnv:       "test",
+			inErr:       errors.New("some error"),
+			wantedError: "detect changes made outside of Copilot to service api: some error",
+		},
+		"deploy the manifest with --prefer manifest": {
+			inPrefer:       driftPreferManifest,
+			inEnv:          "test",
+			inDrifted:      countDrift,
+			wantedContinue: true,
+		},
+		"deploy the manifest with --diff-yes": {
+			inDiffYes:      true,
+			inEnv:          "test",
+			inDrifted:      countDrift,
+			wantedContinue: true,
+		},
+		"write the deployed values to the environment overrides with --prefer deployed": {
+			inPrefer:  driftPreferDeployed,
+			inEnv:     "test",
+			inDrifted: append(countDrift, clideploy.DriftedParameter{Key: "TaskCPU", Deployed: "512", Manifest: "256"}),
+			wantedManifest: `# The manifest of the api service.
+name: api
+type: Backend Service
+count: 1 # Number of tasks.
+environments:
+  prod:
+    count:
+      range: 1-10
+  test:
+    count: 3
+    cpu: 512
+`,
+		},
+		"error if a deployed value can't be written back with --prefer deployed": {
+			inPrefer:    driftPreferDeployed,
+			inEnv:       "prod",
+			inDrifted:   countDrift,
+			wantedError: "cannot write TaskCount back to the manifest of service api",
+		},
+		"prompt for the resolution": {
+			inEnv:     "test",
+			inDrifted: countDrift,
+			setupMocks: func(p *mocks.Mockprompter) {
+				p.EXPECT().SelectOne(gomock.Any(), gomock.Any(), []string{resolveDriftKeepDeployed, resolveDriftKeepManifest, resolveDriftAbort}, gomock.Any()).
+					Return(resolveDriftKeepManifest, nil)
+			},
+			wantedContinue: true,
+		},
+		"do not offer to keep the deployed values that can't be written back": {
+			inEnv:     "prod",
+			inDrifted: countDrift,
+			setupMocks: func(p *mocks.Mockprompter) {
+				p.EXPECT().SelectOne(gomock.Any(), gomock.Any(), []string{resolveDriftKeepManifest, resolveDriftAbort}, gomock.Any()).
+					Return(resolveDriftAbort, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			p := mocks.NewMockprompter(ctrl)
+			if tc.setupMocks != nil {
+				tc.setupMocks(p)
+			}
+			ws := mocks.NewMockwsWlDirReader(ctrl)
+			ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(mft), nil).AnyTimes()
+			editor := mocks.NewMockworkloadManifestEditor(ctrl)
+			var written string
+			editor.EXPECT().EditWorkloadManifest("api", gomock.Any()).DoAndReturn(func(_ string, edit func([]byte) ([]byte, error)) (string, error) {
+				out, err := edit([]byte(mft))
+				if err != nil {
+					return "", err
+				}
+				written = string(out)
+				return "copilot/api/manifest.yml", nil
+			}).AnyTimes()
+			opts := &deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					name:           "api",
+					envName:        tc.inEnv,
+					preferDrift:    tc.inPrefer,
+					skipDiffPrompt: tc.inDiffYes,
+				},
+				ws:         ws,
+				mftEditor:  editor,
+				prompt:     p,
+				diffWriter: new(bytes.Buffer),
+			}
+
+			// WHEN
+			contd, err := opts.resolveRevertedDrift(&driftReverterDouble{drifted: tc.inDrifted, err: tc.inErr}, &clideploy.GenerateCloudFormationTemplateOutput{})
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContinue, contd)
+			require.Equal(t, tc.wantedManifest, written)
+		})
+	}
+}
+
+func TestDeploySvcOpts_ValidatePrefer(t *testing.T) {
+	require.NoError(t, (&deploySvcOpts{deployWkldVars: deployWkldVars{preferDrift: "deployed"}}).Validate())
+	require.EqualError(t, (&deploySvcOpts{deployWkldVars: deployWkldVars{preferDrift: "local"}}).Validate(),
+		`invalid value "local" for --prefer: must be one of "deployed" or "manifest"`)
+}
@@ -8,14 +8,17 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/lock"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/version"
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
@@ -24,7 +27,49 @@ import (
 )
 
 func TestSvcDeployOpts_Validate(t *testing.T) {
-	// There is no validation for svc deploy.
+	testCases := map[string]struct {
+		inImage    string
+		inShowDiff bool
+		inDiffJSON bool
+
+		wantedErr string
+	}{
+		"valid without flags": {},
+		"valid with an ECR image referenced by digest": {
+			inImage: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend@sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7",
+		},
+		"error if the image is referenced by tag": {
+			inImage:   "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:v1",
+			wantedErr: `validate --image: image "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:v1" must be an ECR repository URI followed by an image digest, such as 123456789012.dkr.ecr.us-west-2.amazonaws.com/repo@sha256:<digest>`,
+		},
+		"valid with the diff as JSON": {
+			inShowDiff: true,
+			inDiffJSON: true,
+		},
+		"error if the diff as JSON is requested without the diff": {
+			inDiffJSON: true,
+			wantedErr:  "--json must be used with --diff",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					prebuiltImage: tc.inImage,
+					showDiff:      tc.inShowDiff,
+					diffJSON:      tc.inDiffJSON,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
 }
 
 type svcDeployAskMocks struct {
@@ -139,6 +184,7 @@ type deployMocks struct {
 	mockDiffWriter           *strings.Builder
 	mockPrompter             *mocks.Mockprompter
 	mockVersionGetter        *mocks.MockversionGetter
+	mockLocker               *mocks.MockstackLocker
 }
 
 func TestSvcDeployOpts_Execute(t *testing.T) {
@@ -154,7 +200,9 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 	testCases := map[string]struct {
 		inShowDiff       bool
 		inSkipDiffPrompt bool
+		inDiffJSON       bool
 		inForceFlag      bool
+		inFastFlag       bool
 		inAllowDowngrade bool
 		inSvcType        string
 		mock             func(m *deployMocks)
@@ -214,6 +262,17 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 
 			wantedError: fmt.Errorf(`--force is not supported for service type "Static Site"`),
 		},
+		"error out if fast deploy for request-driven web service": {
+			inFastFlag: true,
+			inSvcType:  manifestinfo.RequestDrivenWebServiceType,
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return(mockVersion, nil)
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+			},
+
+			wantedError: fmt.Errorf(`--fast is not supported for service type "Request-Driven Web Service"`),
+		},
 		"error if some required features are not available in the environment": {
 			mock: func(m *deployMocks) {
 				m.mockVersionGetter.EXPECT().Version().Return(mockVersion, nil)
@@ -287,7 +346,7 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 			},
 			wantedError: errors.New("some error"),
 		},
-		"write 'no changes' if there is no diff": {
+		"write 'no changes' and deploy without confirmation if there is no diff": {
 			inShowDiff: true,
 			mock: func(m *deployMocks) {
 				m.mockVersionGetter.EXPECT().Version().Return(mockVersion, nil)
@@ -305,7 +364,8 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{}, nil)
 				m.mockDeployer.EXPECT().DeployDiff(gomock.Any()).Return("", nil)
 				m.mockDiffWriter = &strings.Builder{}
-				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Return(false, nil)
+				m.mockPrompter.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(1)
 			},
 			wantedDiff: "No changes.\n",
 		},
@@ -420,6 +480,53 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(1)
 			},
 		},
+		"write the diff as JSON before asking whether to continue": {
+			inShowDiff: true,
+			inDiffJSON: true,
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return(mockVersion, nil)
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{"mockFeature1"}
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{"mockFeature1", "mockFeature2"}, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{}, nil)
+				m.mockDeployer.EXPECT().DeployDiffJSON(gomock.Any()).Return(`{"stack":[{"action":"add","path":"/peace","new":"love"}]}`+"\n", nil)
+				m.mockDiffWriter = &strings.Builder{}
+				m.mockPrompter.EXPECT().Confirm(gomock.Eq("Continue with the deployment?"), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			wantedDiff: `{"stack":[{"action":"add","path":"/peace","new":"love"}]}` + "\n",
+		},
+		"deploy without asking if the diff as JSON has no changes": {
+			inShowDiff: true,
+			inDiffJSON: true,
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return(mockVersion, nil)
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{"mockFeature1"}
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{"mockFeature1", "mockFeature2"}, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(false, nil)
+				m.mockDeployer.EXPECT().UploadArtifacts().Return(&clideploy.UploadArtifactsOutput{}, nil)
+				m.mockDeployer.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&clideploy.GenerateCloudFormationTemplateOutput{}, nil)
+				m.mockDeployer.EXPECT().DeployDiffJSON(gomock.Any()).Return(`{"stack":[]}`+"\n", nil)
+				m.mockDiffWriter = &strings.Builder{}
+				m.mockPrompter.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.mockDeployer.EXPECT().DeployWorkload(gomock.Any()).Times(1)
+			},
+			wantedDiff: `{"stack":[]}` + "\n",
+		},
 		"error if failed to deploy service": {
 			mock: func(m *deployMocks) {
 				m.mockVersionGetter.EXPECT().Version().Return(mockVersion, nil)
@@ -439,6 +546,32 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 
 			wantedError: fmt.Errorf("deploy service frontend to environment prod-iad: some error"),
 		},
+		"error if the stack is locked": {
+			mock: func(m *deployMocks) {
+				m.mockVersionGetter.EXPECT().Version().Return(mockVersion, nil)
+				m.mockWsReader.EXPECT().ReadWorkloadManifest(mockSvcName).Return([]byte(""), nil)
+				m.mockInterpolator.EXPECT().Interpolate("").Return("", nil)
+				m.mockMft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{"mockFeature1"}
+					},
+				}
+				m.mockEnvFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.mockEnvFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{"mockFeature1", "mockFeature2"}, nil)
+				m.mockDeployer.EXPECT().IsServiceAvailableInRegion("").Return(true, nil)
+				m.mockLocker.EXPECT().Lock(mockAppName, "phonetool-prod-iad-frontend", "svc deploy").Return(nil, &lock.ErrLocked{
+					Lock: &lock.Lock{
+						Stack:      "phonetool-prod-iad-frontend",
+						Owner:      "alice@laptop",
+						Command:    "svc deploy",
+						AcquiredAt: time.Date(2023, time.March, 1, 10, 0, 0, 0, time.UTC),
+					},
+				})
+				m.mockDeployer.EXPECT().UploadArtifacts().Times(0)
+			},
+
+			wantedError: errors.New(`stack phonetool-prod-iad-frontend is locked by alice@laptop running "svc deploy" since 2023-03-01T10:00:00Z`),
+		},
 		"success with no recommendations and allow downgrade": {
 			inAllowDowngrade: true,
 			mock: func(m *deployMocks) {
@@ -489,8 +622,10 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				mockEnvFeaturesDescriber: mocks.NewMockversionCompatibilityChecker(ctrl),
 				mockPrompter:             mocks.NewMockprompter(ctrl),
 				mockVersionGetter:        mocks.NewMockversionGetter(ctrl),
+				mockLocker:               mocks.NewMockstackLocker(ctrl),
 			}
 			tc.mock(m)
+			m.mockLocker.EXPECT().Lock(gomock.Any(), gomock.Any(), gomock.Any()).Return(func() error { return nil }, nil).AnyTimes()
 
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
@@ -499,7 +634,9 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 					envName:            mockEnvName,
 					showDiff:           tc.inShowDiff,
 					skipDiffPrompt:     tc.inSkipDiffPrompt,
+					diffJSON:           tc.inDiffJSON,
 					forceNewUpdate:     tc.inForceFlag,
+					hotSwap:            tc.inFastFlag,
 					allowWkldDowngrade: tc.inAllowDowngrade,
 					clientConfigured:   true,
 				},
@@ -518,6 +655,7 @@ func TestSvcDeployOpts_Execute(t *testing.T) {
 				prompt:               m.mockPrompter,
 				diffWriter:           m.mockDiffWriter,
 				svcVersionGetter:     m.mockVersionGetter,
+				locker:               m.mockLocker,
 				targetApp:            &config.Application{},
 				targetEnv:            &config.Environment{},
 				templateVersion:      mockVersion,
@@ -645,3 +783,90 @@ func (m *mockWorkloadMft) Manifest() interface{} {
 func (m *mockWorkloadMft) RequiredEnvironmentFeatures() []string {
 	return m.mockRequiredEnvironmentFeatures()
 }
+
+func TestCheckWorkloadConflicts(t *testing.T) {
+	const api = `name: api
+type: Backend Service
+image:
+  location: nginx
+  port: 8080
+env_file: api.env
+variables:
+  LOG_LEVEL: info
+network:
+  connect:
+    alias: orders
+`
+	testCases := map[string]struct {
+		setupMocks func(ws *mocks.MockwsWlDirReader)
+		envFile    string
+
+		wantedErr string
+	}{
+		"no conflicts": {
+			setupMocks: func(ws *mocks.MockwsWlDirReader) {
+				ws.EXPECT().Path().Return("/ws")
+				ws.EXPECT().ListWorkloads().Return([]string{"api", "frontend"}, nil)
+				ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(`name: frontend
+type: Backend Service
+image:
+  location: nginx
+network:
+  connect: true
+`), nil)
+			},
+			envFile: "DB_NAME=orders\n",
+		},
+		"reports every conflict at once": {
+			setupMocks: func(ws *mocks.MockwsWlDirReader) {
+				ws.EXPECT().Path().Return("/ws")
+				ws.EXPECT().ListWorkloads().Return([]string{"api", "orders"}, nil)
+				ws.EXPECT().ReadWorkloadManifest("orders").Return([]byte(`name: orders
+type: Backend Service
+image:
+  location: nginx
+network:
+  connect: true
+`), nil)
+			},
+			envFile:   "LOG_LEVEL=debug\n",
+			wantedErr: "found 2 conflicts in the manifest for api in environment test",
+		},
+		"wraps the error of reading the other manifests": {
+			setupMocks: func(ws *mocks.MockwsWlDirReader) {
+				ws.EXPECT().ListWorkloads().Return([]string{"api", "orders"}, nil)
+				ws.EXPECT().ReadWorkloadManifest("orders").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "read manifest file for orders: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsWlDirReader(ctrl)
+			tc.setupMocks(ws)
+			fs := afero.NewMemMapFs()
+			if tc.envFile != "" {
+				require.NoError(t, afero.WriteFile(fs, "/ws/api.env", []byte(tc.envFile), 0644))
+			}
+
+			// WHEN
+			err := checkWorkloadConflicts(&workloadManifestInput{
+				name:    "api",
+				appName: "phonetool",
+				envName: "test",
+				ws:      ws,
+				fs:      fs,
+			}, []byte(api))
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
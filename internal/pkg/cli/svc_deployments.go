@@ -0,0 +1,351 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/revision"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+)
+
+const (
+	svcDeploymentsNamePrompt     = "Which service's deployments would you like to show?"
+	svcDeploymentsNameHelpPrompt = "Displays who deployed the service, when, and what changed with each deployment."
+
+	shortDigestLength       = 12 // Number of hexadecimal characters of an image digest shown in the list of deployments.
+	shortTemplateHashLength = 8
+)
+
+type svcDeploymentsVars struct {
+	appName          string
+	envName          string
+	svcName          string
+	deploymentID     string
+	shouldOutputJSON bool
+}
+
+type svcDeploymentsOpts struct {
+	svcDeploymentsVars
+
+	w     io.Writer
+	store store
+	sel   deploySelector
+	now   func() time.Time
+
+	// Initialized in Execute once the environment is known.
+	initHistory func() error
+	history     deploymentHistory
+}
+
+func newSvcDeploymentsOpts(vars svcDeploymentsVars) (*svcDeploymentsOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc deployments"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	opts := &svcDeploymentsOpts{
+		svcDeploymentsVars: vars,
+		w:                  log.OutputWriter,
+		store:              configStore,
+		sel:                selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		now:                time.Now,
+	}
+	opts.initHistory = func() error {
+		env, err := opts.store.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s: %w", opts.envName, err)
+		}
+		app, err := opts.store.GetApplication(opts.appName)
+		if err != nil {
+			return fmt.Errorf("get application %s: %w", opts.appName, err)
+		}
+		resources, err := cloudformation.New(defaultSess).GetAppResourcesByRegion(app, env.Region)
+		if err != nil {
+			return fmt.Errorf("get application %s resources from region %s: %w", app.Name, env.Region, err)
+		}
+		envSess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		opts.history = revision.NewStore(s3.New(envSess), resources.S3Bucket)
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcDeploymentsOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcDeploymentsOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskSvcEnvName()
+}
+
+func (o *svcDeploymentsOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcDeploymentsOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+	deployedService, err := o.sel.DeployedService(svcDeploymentsNamePrompt, svcDeploymentsNameHelpPrompt, o.appName,
+		selector.WithEnv(o.envName), selector.WithName(o.svcName), selector.WithServiceTypesFilter(rollbackSvcTypes))
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute lists the recorded deployments of the service, or shows one of them.
+func (o *svcDeploymentsOpts) Execute() error {
+	if err := o.initHistory(); err != nil {
+		return err
+	}
+	stackName := stack.NameForWorkload(o.appName, o.envName, o.svcName)
+	if o.deploymentID != "" {
+		rev, err := o.history.Get(stackName, o.deploymentID)
+		if err != nil {
+			return fmt.Errorf("get deployment %s of service %s: %w", o.deploymentID, o.svcName, err)
+		}
+		if o.shouldOutputJSON {
+			return o.writeJSON(newSvcDeployment(rev))
+		}
+		o.writeDeployment(rev)
+		return nil
+	}
+
+	revs, err := o.history.History(stackName)
+	if err != nil {
+		return fmt.Errorf("list deployments of service %s: %w", o.svcName, err)
+	}
+	// Show the most recent deployments first.
+	sort.SliceStable(revs, func(i, j int) bool {
+		return revs[i].ID > revs[j].ID
+	})
+	if o.shouldOutputJSON {
+		deployments := make([]*svcDeployment, len(revs))
+		for i, rev := range revs {
+			deployments[i] = newSvcDeployment(rev)
+			deployments[i].Diff = "" // Only the summary of the changes is listed.
+		}
+		return o.writeJSON(struct {
+			Deployments []*svcDeployment `json:"deployments"`
+		}{deployments})
+	}
+	if len(revs) == 0 {
+		log.Infof("No deployments of service %s in environment %s are recorded.\n", o.svcName, o.envName)
+		return nil
+	}
+	rows := make([][]string, len(revs))
+	for i, rev := range revs {
+		rows[i] = []string{
+			rev.ID,
+			humanize.RelTime(rev.DeployedAt, o.now(), "ago", "from now"),
+			orDash(shortDeployer(rev.DeployedBy)),
+			orDash(rev.GitCommit),
+			orDash(shortImageDigests(rev.ImageDigests)),
+			orDash(truncate(rev.TemplateHash, shortTemplateHashLength)),
+			orDash(rev.DiffSummary),
+		}
+	}
+	writeTable(o.w, []string{"ID", "Deployed", "By", "Commit", "Images", "Template", "Changes"}, rows)
+	return nil
+}
+
+func (o *svcDeploymentsOpts) writeDeployment(rev *stack.Revision) {
+	writer := tabwriter.NewWriter(o.w, secretTableMinCellWidth, secretTableTabWidth, secretTableCellPaddingWidth, secretTablePaddingChar, 0)
+	fmt.Fprint(writer, color.Bold.Sprintf("Deployment %s\n\n", rev.ID))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Service", o.svcName)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Environment", o.envName)
+	fmt.Fprintf(writer, "  %s\t%s (%s)\n", "Deployed at", rev.DeployedAt.Format(time.RFC3339), humanize.RelTime(rev.DeployedAt, o.now(), "ago", "from now"))
+	fmt.Fprintf(writer, "  %s\t%s\n", "Deployed by", orDash(rev.DeployedBy))
+	fmt.Fprintf(writer, "  %s\t%s\n", "Git commit", orDash(rev.GitCommit))
+	fmt.Fprintf(writer, "  %s\t%s\n", "Template hash", orDash(rev.TemplateHash))
+	if rev.FreezeOverride != "" {
+		fmt.Fprintf(writer, "  %s\t%s\n", "Freeze override", rev.FreezeOverride)
+	}
+	if len(rev.ImageDigests) > 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nImages\n\n"))
+		writer.Flush()
+		for _, container := range sortedStringKeys(rev.ImageDigests) {
+			fmt.Fprintf(writer, "  %s\t%s\n", container, rev.ImageDigests[container])
+		}
+	}
+	fmt.Fprint(writer, color.Bold.Sprint("\nChanges\n\n"))
+	writer.Flush()
+	if rev.Diff == "" {
+		fmt.Fprintln(o.w, "  No changes to the template are recorded.")
+		return
+	}
+	fmt.Fprintf(o.w, "  %s\n\n", orDash(rev.DiffSummary))
+	fmt.Fprint(o.w, rev.Diff)
+}
+
+func (o *svcDeploymentsOpts) writeJSON(v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshal deployments: %w", err)
+	}
+	_, err = fmt.Fprintf(o.w, "%s\n", b)
+	return err
+}
+
+// svcDeployment is the audit information of a recorded deployment of a service.
+type svcDeployment struct {
+	ID             string            `json:"id"`
+	DeployedAt     time.Time         `json:"deployedAt"`
+	DeployedBy     string            `json:"deployedBy,omitempty"`
+	GitCommit      string            `json:"gitCommit,omitempty"`
+	ImageDigests   map[string]string `json:"imageDigests,omitempty"`
+	TemplateHash   string            `json:"templateHash,omitempty"`
+	FreezeOverride string            `json:"freezeOverride,omitempty"`
+	DiffSummary    string            `json:"diffSummary,omitempty"`
+	Diff           string            `json:"diff,omitempty"`
+}
+
+func newSvcDeployment(rev *stack.Revision) *svcDeployment {
+	return &svcDeployment{
+		ID:             rev.ID,
+		DeployedAt:     rev.DeployedAt,
+		DeployedBy:     rev.DeployedBy,
+		GitCommit:      rev.GitCommit,
+		ImageDigests:   rev.ImageDigests,
+		TemplateHash:   rev.TemplateHash,
+		FreezeOverride: rev.FreezeOverride,
+		DiffSummary:    rev.DiffSummary,
+		Diff:           rev.Diff,
+	}
+}
+
+// shortDeployer returns the resource of an identity ARN, like "assumed-role/Admin/alice".
+func shortDeployer(deployedBy string) string {
+	parsed, err := arn.Parse(deployedBy)
+	if err != nil {
+		return deployedBy
+	}
+	return parsed.Resource
+}
+
+// shortImageDigests returns the truncated digest of the image of each container, like "frontend@sha256:0123456789ab".
+func shortImageDigests(digests map[string]string) string {
+	images := make([]string, 0, len(digests))
+	for _, container := range sortedStringKeys(digests) {
+		algorithm, hex, found := strings.Cut(digests[container], ":")
+		if !found {
+			images = append(images, fmt.Sprintf("%s@%s", container, truncate(digests[container], shortDigestLength)))
+			continue
+		}
+		images = append(images, fmt.Sprintf("%s@%s:%s", container, algorithm, truncate(hex, shortDigestLength)))
+	}
+	return strings.Join(images, ", ")
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func sortedStringKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// buildSvcDeploymentsCmd builds the command for showing the deployment history of a service.
+func buildSvcDeploymentsCmd() *cobra.Command {
+	vars := svcDeploymentsVars{}
+	cmd := &cobra.Command{
+		Use:   "deployments",
+		Short: "Shows the deployment history of a service.",
+		Long: `Shows the deployment history of a service.
+For each deployment recorded by "copilot svc deploy", the identity that deployed it, the git commit,
+the image digests, the hash of the template and a summary of the template changes are shown.`,
+
+		Example: `
+  Lists the deployments of service "frontend" in the "prod" environment.
+  /code $ copilot svc deployments -n frontend -e prod
+  Shows the template diff of a deployment.
+  /code $ copilot svc deployments -n frontend -e prod --show 20230102-150405`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcDeploymentsOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.deploymentID, showDeploymentFlag, "", showDeploymentFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.AddCommand(buildSvcDeploymentsLogsCmd())
+	return cmd
+}
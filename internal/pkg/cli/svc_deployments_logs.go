@@ -0,0 +1,77 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+type svcDeploymentsLogsOpts struct {
+	*svcDeploymentsOpts
+}
+
+// Execute writes the log of a deployment of the service, or of its most recent deployment if no ID is given.
+func (o *svcDeploymentsLogsOpts) Execute() error {
+	if err := o.initHistory(); err != nil {
+		return err
+	}
+	stackName := stack.NameForWorkload(o.appName, o.envName, o.svcName)
+	id := o.deploymentID
+	if id == "" {
+		ids, err := o.history.LogIDs(stackName)
+		if err != nil {
+			return fmt.Errorf("list deployment logs of service %s: %w", o.svcName, err)
+		}
+		if len(ids) == 0 {
+			log.Infof("No deployment logs of service %s in environment %s are recorded.\n", o.svcName, o.envName)
+			return nil
+		}
+		id = ids[len(ids)-1]
+	}
+	content, err := o.history.Log(stackName, id)
+	if err != nil {
+		return fmt.Errorf("get log of deployment %s of service %s: %w", id, o.svcName, err)
+	}
+	_, err = o.w.Write(content)
+	return err
+}
+
+// buildSvcDeploymentsLogsCmd builds the command for showing the log of a deployment of a service.
+func buildSvcDeploymentsLogsCmd() *cobra.Command {
+	vars := svcDeploymentsVars{}
+	cmd := &cobra.Command{
+		Use:   "logs [<id>]",
+		Short: "Shows the stack events and the result of a deployment of a service.",
+		Long: `Shows the stack events and the result of a deployment of a service.
+The log of every deployment by "copilot svc deploy" is stored in the artifact bucket, including failed deployments,
+so that they can be debugged after the terminal session that ran them is gone.
+Shows the most recent deployment if no ID is given.`,
+		Example: `
+  Shows the log of the most recent deployment of service "frontend" in the "prod" environment.
+  /code $ copilot svc deployments logs -n frontend -e prod
+  Shows the log of a deployment that failed in a pipeline.
+  /code $ copilot svc deployments logs 20230102-150405 -n frontend -e prod`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				vars.deploymentID = args[0]
+			}
+			opts, err := newSvcDeploymentsOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(&svcDeploymentsLogsOpts{
+				svcDeploymentsOpts: opts,
+			})
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	return cmd
+}
@@ -0,0 +1,88 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+)
+
+func TestSvcDeploymentsLogsOpts_Execute(t *testing.T) {
+	const stackName = "phonetool-prod-frontend"
+	testCases := map[string]struct {
+		inDeploymentID string
+		setupMocks     func(m *mocks.MockdeploymentHistory)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"write the log of the deployment": {
+			inDeploymentID: "20230102-150405",
+			setupMocks: func(m *mocks.MockdeploymentHistory) {
+				m.EXPECT().Log(stackName, "20230102-150405").Return([]byte("Result: failed\n"), nil)
+			},
+			wantedOutput: "Result: failed\n",
+		},
+		"write the log of the most recent deployment without an ID": {
+			setupMocks: func(m *mocks.MockdeploymentHistory) {
+				m.EXPECT().LogIDs(stackName).Return([]string{"20230101-000000", "20230102-150405"}, nil)
+				m.EXPECT().Log(stackName, "20230102-150405").Return([]byte("Result: succeeded\n"), nil)
+			},
+			wantedOutput: "Result: succeeded\n",
+		},
+		"write nothing if no logs are recorded": {
+			setupMocks: func(m *mocks.MockdeploymentHistory) {
+				m.EXPECT().LogIDs(stackName).Return(nil, nil)
+			},
+		},
+		"wrap the error": {
+			inDeploymentID: "20230102-150405",
+			setupMocks: func(m *mocks.MockdeploymentHistory) {
+				m.EXPECT().Log(stackName, "20230102-150405").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get log of deployment 20230102-150405 of service frontend: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockdeploymentHistory(ctrl)
+			tc.setupMocks(m)
+			buf := new(bytes.Buffer)
+			opts := &svcDeploymentsLogsOpts{
+				svcDeploymentsOpts: &svcDeploymentsOpts{
+					svcDeploymentsVars: svcDeploymentsVars{
+						appName:      "phonetool",
+						envName:      "prod",
+						svcName:      "frontend",
+						deploymentID: tc.inDeploymentID,
+					},
+					w:           buf,
+					initHistory: func() error { return nil },
+					history:     m,
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, buf.String())
+		})
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+)
+
+func TestSvcDeploymentsOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp string
+		inEnv string
+		inSvc string
+
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockdeploySelector)
+
+		wantedEnv   string
+		wantedSvc   string
+		wantedError error
+	}{
+		"errors if the environment does not exist": {
+			inApp: "phonetool",
+			inEnv: "test",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"selects the deployed service": {
+			inApp: "phonetool",
+			inSvc: "frontend",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				store.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{}, nil)
+				sel.EXPECT().DeployedService(svcDeploymentsNamePrompt, svcDeploymentsNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "prod",
+						Name: "frontend",
+					}, nil)
+			},
+			wantedEnv: "prod",
+			wantedSvc: "frontend",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockdeploySelector(ctrl)
+			tc.setupMocks(store, sel)
+			opts := &svcDeploymentsOpts{
+				svcDeploymentsVars: svcDeploymentsVars{
+					appName: tc.inApp,
+					envName: tc.inEnv,
+					svcName: tc.inSvc,
+				},
+				store: store,
+				sel:   sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+		})
+	}
+}
+
+func TestSvcDeploymentsOpts_Execute(t *testing.T) {
+	const stackName = "phonetool-prod-frontend"
+	now := time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC)
+	first := &stack.Revision{
+		ID:           "20230101-000000",
+		DeployedAt:   time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Name:         stackName,
+		DeployedBy:   "arn:aws:iam::1234:user/alice",
+		ImageDigests: map[string]string{"frontend": "sha256:0123456789abcdef"},
+		TemplateHash: "49cbd1e9008e8b67",
+		DiffSummary:  "1 resource added, 0 modified, 0 removed",
+		Diff:         "+ Resources:\n+     Service: {}\n",
+	}
+	second := &stack.Revision{
+		ID:             "20230102-000000",
+		DeployedAt:     time.Date(2023, time.January, 2, 0, 0, 0, 0, time.UTC),
+		Name:           stackName,
+		DeployedBy:     "arn:aws:sts::1234:assumed-role/Admin/bob",
+		GitCommit:      "a1b2c3d",
+		TemplateHash:   "e3b0c44298fc1c14",
+		FreezeOverride: "Hotfix for the checkout page.",
+	}
+	testCases := map[string]struct {
+		inDeploymentID string
+		inJSON         bool
+		setupMocks     func(m *mocks.MockdeploymentHistory)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"errors if the deployments cannot be listed": {
+			setupMocks: func(m *mocks.MockdeploymentHistory) {
+				m.EXPECT().History(stackName).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list deployments of service frontend: some error"),
+		},
+		"lists the most recent deployments first": {
+			setupMocks: func(m *mocks.MockdeploymentHistory) {
+				m.EXPECT().History(stackName).Return([]*stack.Revision{first, second}, nil)
+			},
+			wantedOutput: `ID                  Deployed            By                      Commit              Images                        Template            Changes
+--                  --------            --                      ------              ------                        --------            -------
+20230102-000000     1 day ago           assumed-role/Admin/bob  a1b2c3d             -                             e3b0c442            -
+20230101-000000     2 days ago          user/alice              -                   frontend@sha256:0123456789ab  49cbd1e9            1 resource added, 0 modified, 0 removed
+`,
+		},
+		"lists the deployments without their diff in JSON": {
+			inJSON: true,
+			setupMocks: func(m *mocks.MockdeploymentHistory) {
+				m.EXPECT().History(stackName).Return([]*stack.Revision{first}, nil)
+			},
+			wantedOutput: `{"deployments":[{"id":"20230101-000000","deployedAt":"2023-01-01T00:00:00Z","deployedBy":"arn:aws:iam::1234:user/alice","imageDigests":{"frontend":"sha256:0123456789abcdef"},"templateHash":"49cbd1e9008e8b67","diffSummary":"1 resource added, 0 modified, 0 removed"}]}
+`,
+		},
+		"errors if the deployment cannot be retrieved": {
+			inDeploymentID: "20230101-000000",
+			setupMocks: func(m *mocks.MockdeploymentHistory) {
+				m.EXPECT().Get(stackName, "20230101-000000").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get deployment 20230101-000000 of service frontend: some error"),
+		},
+		"shows the recorded diff of a deployment": {
+			inDeploymentID: "20230101-000000",
+			setupMocks: func(m *mocks.MockdeploymentHistory) {
+				m.EXPECT().Get(stackName, "20230101-000000").Return(first, nil)
+			},
+			wantedOutput: `Deployment 20230101-000000
+
+  Service           frontend
+  Environment       prod
+  Deployed at       2023-01-01T00:00:00Z (2 days ago)
+  Deployed by       arn:aws:iam::1234:user/alice
+  Git commit        -
+  Template hash     49cbd1e9008e8b67
+
+Images
+
+  frontend          sha256:0123456789abcdef
+
+Changes
+
+  1 resource added, 0 modified, 0 removed
+
++ Resources:
++     Service: {}
+`,
+		},
+		"shows the reason a deploy freeze was overridden": {
+			inDeploymentID: "20230102-000000",
+			setupMocks: func(m *mocks.MockdeploymentHistory) {
+				m.EXPECT().Get(stackName, "20230102-000000").Return(second, nil)
+			},
+			wantedOutput: `Deployment 20230102-000000
+
+  Service           frontend
+  Environment       prod
+  Deployed at       2023-01-02T00:00:00Z (1 day ago)
+  Deployed by       arn:aws:sts::1234:assumed-role/Admin/bob
+  Git commit        a1b2c3d
+  Template hash     e3b0c44298fc1c14
+  Freeze override   Hotfix for the checkout page.
+
+Changes
+
+  No changes to the template are recorded.
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockdeploymentHistory(ctrl)
+			tc.setupMocks(m)
+			buf := new(bytes.Buffer)
+			opts := &svcDeploymentsOpts{
+				svcDeploymentsVars: svcDeploymentsVars{
+					appName:          "phonetool",
+					envName:          "prod",
+					svcName:          "frontend",
+					deploymentID:     tc.inDeploymentID,
+					shouldOutputJSON: tc.inJSON,
+				},
+				w:           buf,
+				now:         func() time.Time { return now },
+				initHistory: func() error { return nil },
+				history:     m,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, buf.String())
+		})
+	}
+}
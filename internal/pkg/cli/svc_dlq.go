@@ -0,0 +1,178 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcDLQNamePrompt     = "Which worker service's dead-letter queues would you like to use?"
+	svcDLQNameHelpPrompt = "Messages that a worker service fails to process are moved to its dead-letter queues."
+)
+
+type svcDLQVars struct {
+	appName   string
+	envName   string
+	svcName   string
+	queueName string
+}
+
+// svcDLQOpts holds the dependencies shared by the commands for the dead-letter queues of a worker service.
+type svcDLQOpts struct {
+	svcDLQVars
+
+	w           io.Writer
+	store       store
+	sel         deploySelector
+	prompt      prompter
+	queues      deadLetterQueueLister
+	messages    queueMessagePeeker
+	redriver    queueRedriver
+	initClients func() error // Overridden in tests.
+}
+
+func newSvcDLQOpts(vars svcDLQVars, cmdName string) (*svcDLQOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras(cmdName))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	opts := &svcDLQOpts{
+		svcDLQVars: vars,
+		w:          log.OutputWriter,
+		store:      configStore,
+		sel:        selector.NewDeploySelect(prompter, configStore, deployStore),
+		prompt:     prompter,
+	}
+	opts.initClients = func() error {
+		env, err := configStore.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s: %w", opts.envName, err)
+		}
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return err
+		}
+		d, err := describe.NewQueueStatusDescriber(&describe.NewServiceStatusConfig{
+			App:         opts.appName,
+			Env:         opts.envName,
+			Svc:         opts.svcName,
+			ConfigStore: configStore,
+		})
+		if err != nil {
+			return fmt.Errorf("create queue describer for service %s in application %s: %w", opts.svcName, opts.appName, err)
+		}
+		client := sqs.New(sess)
+		opts.queues, opts.messages, opts.redriver = d, client, client
+		return nil
+	}
+	return opts, nil
+}
+
+// Ask prompts for and validates the application, the worker service and the environment.
+func (o *svcDLQOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateAndAskSvcEnvName()
+}
+
+// deadLetterQueues returns the dead-letter queues of the service, or only the one of the --queue flag.
+func (o *svcDLQOpts) deadLetterQueues() ([]describe.DeadLetterQueue, error) {
+	queues, err := o.queues.DeadLetterQueues()
+	if err != nil {
+		return nil, fmt.Errorf("list dead-letter queues of service %s: %w", o.svcName, err)
+	}
+	if len(queues) == 0 {
+		return nil, fmt.Errorf("service %s has no dead-letter queues in environment %s: set `subscribe.queue.dead_letter.tries` in its manifest", o.svcName, o.envName)
+	}
+	if o.queueName == "" {
+		return queues, nil
+	}
+	var names []string
+	for _, q := range queues {
+		if q.Name == o.queueName {
+			return []describe.DeadLetterQueue{q}, nil
+		}
+		names = append(names, q.Name)
+	}
+	return nil, fmt.Errorf("dead-letter queue %s not found for service %s: valid queues are %s", o.queueName, o.svcName, english.WordSeries(names, "and"))
+}
+
+func (o *svcDLQOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcDLQOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		svc, err := o.store.GetService(o.appName, o.svcName)
+		if err != nil {
+			return err
+		}
+		if svc.Type != manifestinfo.WorkerServiceType {
+			return fmt.Errorf("service %s is a %s: only a %s has dead-letter queues", o.svcName, svc.Type, manifestinfo.WorkerServiceType)
+		}
+	}
+	deployedService, err := o.sel.DeployedService(svcDLQNamePrompt, svcDLQNameHelpPrompt, o.appName,
+		selector.WithEnv(o.envName), selector.WithName(o.svcName),
+		selector.WithServiceTypesFilter([]string{manifestinfo.WorkerServiceType}))
+	if err != nil {
+		return fmt.Errorf("select deployed worker services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildSvcDLQCmd builds the command for the dead-letter queues of worker services.
+func buildSvcDLQCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dlq",
+		Short: "Commands for the dead-letter queues of worker services.",
+		Long: `Commands for the dead-letter queues of worker services.
+Messages that a worker service fails to process more times than the "tries" of its manifest are moved to a dead-letter queue.`,
+	}
+	cmd.AddCommand(buildSvcDLQPeekCmd())
+	cmd.AddCommand(buildSvcDLQRedriveCmd())
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,167 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultDLQPeekLimit = 5
+	maxDLQPeekLimit     = 10
+
+	dlqPeekBodyMaxLen = 80 // Bodies that are longer are cut in the table, but not in JSON.
+
+	dlqTableMinCellWidth     = 10  // minimum number of characters in a table's cell.
+	dlqTableTabWidth         = 4   // number of characters in between columns.
+	dlqTableCellPaddingWidth = 2   // number of padding characters added by default to a cell.
+	dlqTablePaddingChar      = ' ' // character in between columns.
+)
+
+type peekSvcDLQVars struct {
+	svcDLQVars
+	limit            int
+	shouldOutputJSON bool
+}
+
+type peekSvcDLQOpts struct {
+	*svcDLQOpts
+	limit            int
+	shouldOutputJSON bool
+}
+
+// dlqMessages holds the sample messages of a dead-letter queue.
+type dlqMessages struct {
+	Queue    string        `json:"queue"`
+	Sources  []string      `json:"sources"`
+	Messages int64         `json:"approximateMessages"`
+	Samples  []sqs.Message `json:"samples"`
+}
+
+// Validate returns an error if the number of messages is out of bounds.
+func (o *peekSvcDLQOpts) Validate() error {
+	if o.limit < 1 || o.limit > maxDLQPeekLimit {
+		return fmt.Errorf("flag --%s must be between 1 and %d", limitFlag, maxDLQPeekLimit)
+	}
+	return nil
+}
+
+// Execute shows sample messages of the dead-letter queues, without removing them from the queues.
+func (o *peekSvcDLQOpts) Execute() error {
+	if err := o.initClients(); err != nil {
+		return err
+	}
+	queues, err := o.deadLetterQueues()
+	if err != nil {
+		return err
+	}
+	var out []dlqMessages
+	for _, q := range queues {
+		samples, err := o.messages.Peek(q.URL, o.limit)
+		if err != nil {
+			return fmt.Errorf("peek messages of dead-letter queue %s: %w", q.Name, err)
+		}
+		out = append(out, dlqMessages{
+			Queue:    q.Name,
+			Sources:  q.Sources,
+			Messages: q.Messages,
+			Samples:  samples,
+		})
+	}
+	if o.shouldOutputJSON {
+		data, err := json.Marshal(struct {
+			Queues []dlqMessages `json:"queues"`
+		}{
+			Queues: out,
+		})
+		if err != nil {
+			return fmt.Errorf("marshal messages: %w", err)
+		}
+		fmt.Fprintf(o.w, "%s\n", data)
+		return nil
+	}
+	o.humanOutput(out)
+	return nil
+}
+
+func (o *peekSvcDLQOpts) humanOutput(queues []dlqMessages) {
+	writer := tabwriter.NewWriter(o.w, dlqTableMinCellWidth, dlqTableTabWidth, dlqTableCellPaddingWidth, dlqTablePaddingChar, 0)
+	for i, q := range queues {
+		if i > 0 {
+			fmt.Fprintln(writer)
+		}
+		fmt.Fprint(writer, color.Bold.Sprintf("%s\n\n", q.Queue))
+		writer.Flush()
+		fmt.Fprintf(writer, "  About %d messages from %s.\n", q.Messages, strings.Join(q.Sources, ", "))
+		if len(q.Samples) == 0 {
+			writer.Flush()
+			continue
+		}
+		fmt.Fprintln(writer)
+		headers := []string{"ID", "Sent", "Receives", "Body"}
+		underlines := make([]string, len(headers))
+		for i, header := range headers {
+			underlines[i] = strings.Repeat("-", len(header))
+		}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underlines, "\t"))
+		for _, m := range q.Samples {
+			row := []string{m.ID, m.SentAt.Format(time.RFC3339), strconv.Itoa(m.ReceiveCount), shortBody(m.Body)}
+			fmt.Fprintf(writer, "  %s\n", strings.Join(row, "\t"))
+		}
+		writer.Flush()
+	}
+}
+
+// shortBody returns the body on a single line, cut to dlqPeekBodyMaxLen characters.
+func shortBody(body string) string {
+	body = strings.Join(strings.Fields(body), " ")
+	if r := []rune(body); len(r) > dlqPeekBodyMaxLen {
+		return string(r[:dlqPeekBodyMaxLen-3]) + "..."
+	}
+	return body
+}
+
+// buildSvcDLQPeekCmd builds the command for showing sample messages of the dead-letter queues of a worker service.
+func buildSvcDLQPeekCmd() *cobra.Command {
+	vars := peekSvcDLQVars{}
+	cmd := &cobra.Command{
+		Use:   "peek",
+		Short: "Shows sample messages of the dead-letter queues of a deployed worker service.",
+		Long: `Shows sample messages of the dead-letter queues of a deployed worker service.
+The messages stay in the queues: peeking only increases the number of times they were received.`,
+		Example: `
+  Shows up to 5 messages of each dead-letter queue of the worker service "orders" in the "test" environment.
+  /code $ copilot svc dlq peek -n orders -e test
+  Shows the full bodies of up to 10 messages of a dead-letter queue.
+  /code $ copilot svc dlq peek -n orders -e test --queue DeadLetterQueue --limit 10 --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			base, err := newSvcDLQOpts(vars.svcDLQVars, "svc dlq peek")
+			if err != nil {
+				return err
+			}
+			return run(&peekSvcDLQOpts{
+				svcDLQOpts:       base,
+				limit:            vars.limit,
+				shouldOutputJSON: vars.shouldOutputJSON,
+			})
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.queueName, queueFlag, "", dlqQueueFlagDescription)
+	cmd.Flags().IntVar(&vars.limit, limitFlag, defaultDLQPeekLimit, dlqPeekLimitFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
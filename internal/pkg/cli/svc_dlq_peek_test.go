@@ -0,0 +1,89 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeekSvcDLQOpts_Validate(t *testing.T) {
+	require.NoError(t, (&peekSvcDLQOpts{limit: 5}).Validate())
+	require.EqualError(t, (&peekSvcDLQOpts{limit: 11}).Validate(), "flag --limit must be between 1 and 10")
+}
+
+func TestPeekSvcDLQOpts_Execute(t *testing.T) {
+	const dlqURL = "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-orders-DeadLetterQueue"
+	sent := time.Date(2023, time.October, 5, 10, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		json    bool
+		peekErr error
+
+		wanted      string
+		wantedError string
+	}{
+		"table": {
+			wanted: "DeadLetterQueue\n\n" +
+				"  About 7 messages from EventsQueue.\n\n" +
+				"  ID      Sent                  Receives  Body\n" +
+				"  --      ----                  --------  ----\n" +
+				"  1       2023-10-05T10:00:00Z  6         {\"orderId\": \"42\"}\n" +
+				"  2       2023-10-05T10:00:00Z  6         " + strings.Repeat("x", 77) + "...\n",
+		},
+		"json": {
+			json: true,
+			wanted: `{"queues":[{"queue":"DeadLetterQueue","sources":["EventsQueue"],"approximateMessages":7,"samples":[` +
+				`{"id":"1","body":"{\"orderId\":\n \"42\"}","sentAt":"2023-10-05T10:00:00Z","receiveCount":6},` +
+				`{"id":"2","body":"` + strings.Repeat("x", 100) + `","sentAt":"2023-10-05T10:00:00Z","receiveCount":6}]}]}` + "\n",
+		},
+		"wrap the error": {
+			peekErr:     errors.New("some error"),
+			wantedError: "peek messages of dead-letter queue DeadLetterQueue: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			lister := mocks.NewMockdeadLetterQueueLister(ctrl)
+			lister.EXPECT().DeadLetterQueues().Return([]describe.DeadLetterQueue{
+				{Name: "DeadLetterQueue", URL: dlqURL, Messages: 7, Sources: []string{"EventsQueue"}},
+			}, nil)
+			peeker := mocks.NewMockqueueMessagePeeker(ctrl)
+			peeker.EXPECT().Peek(dlqURL, 5).Return([]sqs.Message{
+				{ID: "1", Body: "{\"orderId\":\n \"42\"}", SentAt: sent, ReceiveCount: 6},
+				{ID: "2", Body: strings.Repeat("x", 100), SentAt: sent, ReceiveCount: 6},
+			}, tc.peekErr)
+			b := &strings.Builder{}
+			opts := &peekSvcDLQOpts{
+				svcDLQOpts: &svcDLQOpts{
+					svcDLQVars:  svcDLQVars{appName: "phonetool", envName: "test", svcName: "orders"},
+					w:           b,
+					queues:      lister,
+					messages:    peeker,
+					initClients: func() error { return nil },
+				},
+				limit:            5,
+				shouldOutputJSON: tc.json,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, b.String())
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/spf13/cobra"
+)
+
+const (
+	fmtSvcDLQRedriveConfirmPrompt = "Move about %d messages from %s back to %s?"
+	svcDLQRedriveConfirmHelp      = "The worker service processes the messages again. Redrive them once a fix for their failures is deployed."
+)
+
+var errSvcDLQRedriveCancelled = errors.New("redrive cancelled - no messages moved")
+
+type redriveSvcDLQVars struct {
+	svcDLQVars
+	skipConfirmation bool
+}
+
+type redriveSvcDLQOpts struct {
+	*svcDLQOpts
+	skipConfirmation bool
+}
+
+// Validate is a no-op for this command.
+func (o *redriveSvcDLQOpts) Validate() error {
+	return nil
+}
+
+// Execute moves the messages of the dead-letter queues back to the queues they came from.
+func (o *redriveSvcDLQOpts) Execute() error {
+	if err := o.initClients(); err != nil {
+		return err
+	}
+	queues, err := o.deadLetterQueues()
+	if err != nil {
+		return err
+	}
+	for _, q := range queues {
+		if q.Messages == 0 {
+			log.Infof("Dead-letter queue %s has no messages to redrive.\n", q.Name)
+			continue
+		}
+		sources := strings.Join(q.Sources, ", ")
+		if !o.skipConfirmation {
+			confirmed, err := o.prompt.Confirm(
+				fmt.Sprintf(fmtSvcDLQRedriveConfirmPrompt, q.Messages, q.Name, sources),
+				svcDLQRedriveConfirmHelp,
+				prompt.WithConfirmFinalMessage())
+			if err != nil {
+				return fmt.Errorf("svc dlq redrive confirmation prompt: %w", err)
+			}
+			if !confirmed {
+				return errSvcDLQRedriveCancelled
+			}
+		}
+		if _, err := o.redriver.Redrive(q.ARN); err != nil {
+			return fmt.Errorf("redrive dead-letter queue %s: %w", q.Name, err)
+		}
+		log.Successf("Started moving about %d messages from %s back to %s.\n", q.Messages, q.Name, sources)
+	}
+	return nil
+}
+
+// buildSvcDLQRedriveCmd builds the command for moving the messages of the dead-letter queues of a worker service back to its queues.
+func buildSvcDLQRedriveCmd() *cobra.Command {
+	vars := redriveSvcDLQVars{}
+	cmd := &cobra.Command{
+		Use:   "redrive",
+		Short: "Moves the messages of the dead-letter queues of a deployed worker service back to its queues.",
+		Long: `Moves the messages of the dead-letter queues of a deployed worker service back to its queues.
+The messages are moved in the background by Amazon SQS, and processed again by the service.
+Deploy a fix for the failures first: messages that fail again are moved back to the dead-letter queue.`,
+		Example: `
+  Redrives the messages of the worker service "orders" in the "prod" environment after a fix is deployed.
+  /code $ copilot svc deploy -n orders -e prod
+  /code $ copilot svc dlq redrive -n orders -e prod
+  Redrives the messages of a single dead-letter queue without confirmation.
+  /code $ copilot svc dlq redrive -n orders -e prod --queue DeadLetterQueue --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			base, err := newSvcDLQOpts(vars.svcDLQVars, "svc dlq redrive")
+			if err != nil {
+				return err
+			}
+			return run(&redriveSvcDLQOpts{
+				svcDLQOpts:       base,
+				skipConfirmation: vars.skipConfirmation,
+			})
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVar(&vars.queueName, queueFlag, "", dlqQueueFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
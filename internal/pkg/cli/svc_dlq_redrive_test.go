@@ -0,0 +1,82 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedriveSvcDLQOpts_Execute(t *testing.T) {
+	const dlqARN = "arn:aws:sqs:us-west-2:123456789012:phonetool-test-orders-DeadLetterQueue"
+	queues := []describe.DeadLetterQueue{
+		{Name: "DeadLetterQueue", ARN: dlqARN, Messages: 7, Sources: []string{"EventsQueue"}},
+		{Name: "ordersDeadLetterQueue", Messages: 0, Sources: []string{"ordersEventsQueue"}},
+	}
+	testCases := map[string]struct {
+		skipConfirmation bool
+		setupMocks       func(p *mocks.Mockprompter, r *mocks.MockqueueRedriver)
+
+		wantedError string
+	}{
+		"redrive the queues with messages once confirmed": {
+			setupMocks: func(p *mocks.Mockprompter, r *mocks.MockqueueRedriver) {
+				p.EXPECT().Confirm("Move about 7 messages from DeadLetterQueue back to EventsQueue?", svcDLQRedriveConfirmHelp, gomock.Any()).Return(true, nil)
+				r.EXPECT().Redrive(dlqARN).Return("handle", nil)
+			},
+		},
+		"redrive without confirmation": {
+			skipConfirmation: true,
+			setupMocks: func(p *mocks.Mockprompter, r *mocks.MockqueueRedriver) {
+				r.EXPECT().Redrive(dlqARN).Return("handle", nil)
+			},
+		},
+		"cancelled": {
+			setupMocks: func(p *mocks.Mockprompter, r *mocks.MockqueueRedriver) {
+				p.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			wantedError: "redrive cancelled - no messages moved",
+		},
+		"wrap the error": {
+			skipConfirmation: true,
+			setupMocks: func(p *mocks.Mockprompter, r *mocks.MockqueueRedriver) {
+				r.EXPECT().Redrive(dlqARN).Return("", errors.New("some error"))
+			},
+			wantedError: "redrive dead-letter queue DeadLetterQueue: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			lister := mocks.NewMockdeadLetterQueueLister(ctrl)
+			lister.EXPECT().DeadLetterQueues().Return(queues, nil)
+			p, r := mocks.NewMockprompter(ctrl), mocks.NewMockqueueRedriver(ctrl)
+			tc.setupMocks(p, r)
+			opts := &redriveSvcDLQOpts{
+				svcDLQOpts: &svcDLQOpts{
+					svcDLQVars:  svcDLQVars{appName: "phonetool", envName: "test", svcName: "orders"},
+					prompt:      p,
+					queues:      lister,
+					redriver:    r,
+					initClients: func() error { return nil },
+				},
+				skipConfirmation: tc.skipConfirmation,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
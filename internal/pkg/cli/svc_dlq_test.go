@@ -0,0 +1,133 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcDLQOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp   string
+		inputSvc   string
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockdeploySelector)
+
+		wantedSvc   string
+		wantedEnv   string
+		wantedError string
+	}{
+		"select a deployed worker service": {
+			inputApp: "phonetool",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				sel.EXPECT().DeployedService(svcDLQNamePrompt, svcDLQNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Env: "test", Name: "orders"}, nil)
+			},
+			wantedSvc: "orders",
+			wantedEnv: "test",
+		},
+		"error if the service is not a worker service": {
+			inputApp: "phonetool",
+			inputSvc: "api",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{
+					Name: "api",
+					Type: manifestinfo.LoadBalancedWebServiceType,
+				}, nil)
+			},
+			wantedError: "service api is a Load Balanced Web Service: only a Worker Service has dead-letter queues",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store, sel := mocks.NewMockstore(ctrl), mocks.NewMockdeploySelector(ctrl)
+			tc.setupMocks(store, sel)
+			opts := &svcDLQOpts{
+				svcDLQVars: svcDLQVars{appName: tc.inputApp, svcName: tc.inputSvc},
+				store:      store,
+				sel:        sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+		})
+	}
+}
+
+func TestSvcDLQOpts_deadLetterQueues(t *testing.T) {
+	queues := []describe.DeadLetterQueue{
+		{Name: "DeadLetterQueue", Sources: []string{"EventsQueue"}},
+		{Name: "ordersDeadLetterQueue", Sources: []string{"ordersEventsQueue"}},
+	}
+	testCases := map[string]struct {
+		queueName string
+		queues    []describe.DeadLetterQueue
+		err       error
+
+		wanted      []describe.DeadLetterQueue
+		wantedError string
+	}{
+		"all the dead-letter queues": {
+			queues: queues,
+			wanted: queues,
+		},
+		"the dead-letter queue of the flag": {
+			queueName: "ordersDeadLetterQueue",
+			queues:    queues,
+			wanted:    queues[1:],
+		},
+		"error if the queue of the flag doesn't exist": {
+			queueName:   "DLQ",
+			queues:      queues,
+			wantedError: "dead-letter queue DLQ not found for service orders: valid queues are DeadLetterQueue and ordersDeadLetterQueue",
+		},
+		"error if the service has no dead-letter queues": {
+			wantedError: "service orders has no dead-letter queues in environment test: set `subscribe.queue.dead_letter.tries` in its manifest",
+		},
+		"wrap the error": {
+			err:         errors.New("some error"),
+			wantedError: "list dead-letter queues of service orders: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			lister := mocks.NewMockdeadLetterQueueLister(ctrl)
+			lister.EXPECT().DeadLetterQueues().Return(tc.queues, tc.err)
+			opts := &svcDLQOpts{
+				svcDLQVars: svcDLQVars{appName: "phonetool", envName: "test", svcName: "orders", queueName: tc.queueName},
+				queues:     lister,
+			}
+
+			got, err := opts.deadLetterQueues()
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
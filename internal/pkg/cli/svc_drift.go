@@ -0,0 +1,173 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcDriftAppNamePrompt  = "Which application is the service in?"
+	svcDriftNamePrompt     = "Which service of %s would you like to detect drift on?"
+	svcDriftNameHelpPrompt = "The resources of the service stack are compared with their actual configuration."
+)
+
+type driftSvcVars struct {
+	appName          string
+	name             string
+	envName          string
+	shouldOutputJSON bool
+}
+
+type driftSvcOpts struct {
+	driftSvcVars
+
+	w                io.Writer
+	store            store
+	sel              deploySelector
+	prog             progress
+	newDriftDetector func(env *config.Environment) (stackDriftDetector, error)
+}
+
+func newDriftSvcOpts(vars driftSvcVars) (*driftSvcOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc drift"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &driftSvcOpts{
+		driftSvcVars: vars,
+		w:            log.OutputWriter,
+		store:        store,
+		sel:          selector.NewDeploySelect(prompt.New(), store, deployStore),
+		prog:         termprogress.NewSpinner(log.DiagnosticWriter),
+		newDriftDetector: func(env *config.Environment) (stackDriftDetector, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return cloudformation.New(sess), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if any optional flags are invalid.
+func (o *driftSvcOpts) Validate() error {
+	return nil
+}
+
+// Ask validates required fields that users passed in, otherwise it prompts for them.
+func (o *driftSvcOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateOrAskSvcEnvName()
+}
+
+// Execute detects drift on the service stack in the environment and writes the drifted resources.
+func (o *driftSvcOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+	}
+	detector, err := o.newDriftDetector(env)
+	if err != nil {
+		return err
+	}
+	drift, err := detectStackDrift(detector, o.prog, stack.NameForWorkload(o.appName, o.envName, o.name))
+	if err != nil {
+		return fmt.Errorf("detect drift of service %s in environment %s: %w", o.name, o.envName, err)
+	}
+	return drift.write(o.w, o.shouldOutputJSON)
+}
+
+func (o *driftSvcOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcDriftAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *driftSvcOpts) validateOrAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetService(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	deployedService, err := o.sel.DeployedService(
+		fmt.Sprintf(svcDriftNamePrompt, color.HighlightUserInput(o.appName)),
+		svcDriftNameHelpPrompt,
+		o.appName,
+		selector.WithEnv(o.envName),
+		selector.WithName(o.name),
+	)
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.name = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildSvcDriftCmd builds the command for detecting drift on a deployed service.
+func buildSvcDriftCmd() *cobra.Command {
+	vars := driftSvcVars{}
+	cmd := &cobra.Command{
+		Use:   "drift",
+		Short: "Detects resources of a deployed service changed outside of Copilot.",
+		Long: `Detects resources of a deployed service changed outside of Copilot.
+Runs CloudFormation drift detection on the service stack and shows the drifted properties.
+Exits with code 1 if any resource drifted.`,
+
+		Example: `
+  Detect drift on the service "my-svc" in the "test" environment.
+  /code $ copilot svc drift -n my-svc -e test
+  Detect drift on the service "my-svc" in the "prod" environment and print the drifted resources in JSON.
+  /code $ copilot svc drift -n my-svc -e prod --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDriftSvcOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
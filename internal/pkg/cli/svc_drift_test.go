@@ -0,0 +1,155 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriftSvcOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp      string
+		inSvc      string
+		inEnv      string
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockdeploySelector)
+
+		wantedSvc   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"error if the service does not exist": {
+			inApp: "phonetool",
+			inSvc: "api",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				store.EXPECT().GetService("phonetool", "api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"select a deployed service": {
+			inApp: "phonetool",
+			inSvc: "api",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{}, nil)
+				sel.EXPECT().DeployedService(gomock.Any(), svcDriftNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{Name: "api", Env: "test"}, nil)
+			},
+			wantedSvc: "api",
+			wantedEnv: "test",
+		},
+		"error if fail to select a deployed service": {
+			inApp: "phonetool",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), "phonetool", gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("select deployed services for application phonetool: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockdeploySelector(ctrl)
+			tc.setupMocks(store, sel)
+			opts := &driftSvcOpts{
+				driftSvcVars: driftSvcVars{
+					appName: tc.inApp,
+					name:    tc.inSvc,
+					envName: tc.inEnv,
+				},
+				store: store,
+				sel:   sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSvc, opts.name)
+				require.Equal(t, tc.wantedEnv, opts.envName)
+			}
+		})
+	}
+}
+
+func TestDriftSvcOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(detector *mocks.MockstackDriftDetector)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"error if fail to detect drift": {
+			setupMocks: func(detector *mocks.MockstackDriftDetector) {
+				detector.EXPECT().DetectDrift(gomock.Any(), "phonetool-test-api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("detect drift of service api in environment test: some error"),
+		},
+		"write the drift of the service stack in JSON": {
+			setupMocks: func(detector *mocks.MockstackDriftDetector) {
+				detector.EXPECT().DetectDrift(gomock.Any(), "phonetool-test-api").Return(nil, nil)
+			},
+			wantedOutput: `{"stack":"phonetool-test-api","resources":[]}` + "\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+			prog := mocks.NewMockprogress(ctrl)
+			prog.EXPECT().Start(gomock.Any())
+			prog.EXPECT().Stop(gomock.Any())
+			detector := mocks.NewMockstackDriftDetector(ctrl)
+			tc.setupMocks(detector)
+			b := &bytes.Buffer{}
+			opts := &driftSvcOpts{
+				driftSvcVars: driftSvcVars{
+					appName:          "phonetool",
+					name:             "api",
+					envName:          "test",
+					shouldOutputJSON: true,
+				},
+				w:     b,
+				store: store,
+				prog:  prog,
+				newDriftDetector: func(env *config.Environment) (stackDriftDetector, error) {
+					return detector, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedOutput, b.String())
+		})
+	}
+}
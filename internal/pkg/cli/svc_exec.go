@@ -111,8 +111,8 @@ func (o *svcExecOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("get workload: %w", err)
 	}
-	if wkld.Type == manifestinfo.RequestDrivenWebServiceType {
-		return fmt.Errorf("executing a command in a running container part of a service is not supported for services with type: '%s'", manifestinfo.RequestDrivenWebServiceType)
+	if wkld.Type == manifestinfo.RequestDrivenWebServiceType || wkld.Type == manifestinfo.LambdaServiceType {
+		return fmt.Errorf("executing a command in a running container part of a service is not supported for services with type: '%s'", wkld.Type)
 	}
 	sess, err := o.envSession()
 	if err != nil {
@@ -122,11 +122,20 @@ func (o *svcExecOpts) Execute() error {
 	if err != nil {
 		return fmt.Errorf("describe ECS service for %s in environment %s: %w", o.name, o.envName, err)
 	}
-	taskID, err := o.selectTask(awsecs.FilterRunningTasks(svcDesc.Tasks))
+	task, err := o.selectTask(awsecs.FilterRunningTasks(svcDesc.Tasks))
+	if err != nil {
+		return err
+	}
+	taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
 	if err != nil {
 		return err
 	}
 	container := o.selectContainer()
+	if o.containerName != "" {
+		if err := validateExecContainer(task, container); err != nil {
+			return err
+		}
+	}
 	log.Infof("Execute %s in container %s in task %s.\n", color.HighlightCode(o.command),
 		color.HighlightUserInput(container), color.HighlightResource(taskID))
 	if err = o.newCommandExecutor(sess).ExecuteCommand(awsecs.ExecuteCommandInput{
@@ -189,27 +198,23 @@ func (o *svcExecOpts) envSession() (*session.Session, error) {
 	return o.sessProvider.FromRole(env.ManagerRoleARN, env.Region)
 }
 
-func (o *svcExecOpts) selectTask(tasks []*awsecs.Task) (string, error) {
+func (o *svcExecOpts) selectTask(tasks []*awsecs.Task) (*awsecs.Task, error) {
 	if len(tasks) == 0 {
-		return "", fmt.Errorf("found no running task for service %s in environment %s", o.name, o.envName)
+		return nil, fmt.Errorf("found no running task for service %s in environment %s", o.name, o.envName)
 	}
 	if o.taskID != "" {
 		for _, task := range tasks {
 			taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
 			if err != nil {
-				return "", err
+				return nil, err
 			}
 			if strings.HasPrefix(taskID, o.taskID) {
-				return taskID, nil
+				return task, nil
 			}
 		}
-		return "", fmt.Errorf("found no running task whose ID is prefixed with %s", o.taskID)
-	}
-	taskID, err := awsecs.TaskID(aws.StringValue(tasks[o.randInt(len(tasks))].TaskArn))
-	if err != nil {
-		return "", err
+		return nil, fmt.Errorf("found no running task whose ID is prefixed with %s", o.taskID)
 	}
-	return taskID, nil
+	return tasks[o.randInt(len(tasks))], nil
 }
 
 func (o *svcExecOpts) selectContainer() string {
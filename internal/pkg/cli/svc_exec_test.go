@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
+	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -524,6 +525,14 @@ func TestSvcExec_Execute(t *testing.T) {
 							{
 								TaskArn:    aws.String(mockTaskARN),
 								LastStatus: aws.String("RUNNING"),
+								Containers: []*ecsapi.Container{
+									{
+										Name: aws.String("mockSvc"),
+									},
+									{
+										Name: aws.String("hello"),
+									},
+								},
 							},
 						},
 					}, nil),
@@ -64,11 +64,15 @@ A %s is a private, non internet-facing service accessible from other services in
 To learn more see: https://git.io/JEEJt
 
 A %s is a private service that can consume messages published to topics in your application.
-To learn more see: https://git.io/JEEJY`,
+To learn more see: https://git.io/JEEJY
+
+A %s runs your container image on AWS Lambda behind your environment's load balancer or an API Gateway HTTP API.
+It suits services with little or irregular traffic, as you only pay for the requests.`,
 		manifestinfo.RequestDrivenWebServiceType,
 		manifestinfo.LoadBalancedWebServiceType,
 		manifestinfo.BackendServiceType,
 		manifestinfo.WorkerServiceType,
+		manifestinfo.LambdaServiceType,
 	)
 
 	fmtWkldInitNamePrompt     = "What do you want to %s this %s?"
@@ -116,6 +120,7 @@ var serviceTypeHints = map[string]string{
 	manifestinfo.BackendServiceType:          "ECS on Fargate",
 	manifestinfo.WorkerServiceType:           "Events to SQS to ECS on Fargate",
 	manifestinfo.StaticSiteType:              "Internet to CDN to S3 bucket",
+	manifestinfo.LambdaServiceType:           "Internet to Lambda",
 }
 
 type initWkldVars struct {
@@ -128,6 +133,7 @@ type initWkldVars struct {
 	noSubscribe       bool
 	sourcePaths       []string
 	allowAppDowngrade bool
+	imageRetentionVars
 }
 
 type initSvcVars struct {
@@ -259,6 +265,9 @@ func (o *initSvcOpts) Validate() error {
 	if o.dockerfilePath != "" && o.image != "" {
 		return fmt.Errorf("--%s and --%s cannot be specified together", dockerFileFlag, imageFlag)
 	}
+	if err := o.imageRetentionVars.validate(); err != nil {
+		return err
+	}
 	if o.dockerfilePath != "" {
 		if _, err := o.fs.Stat(o.dockerfilePath); err != nil {
 			return err
@@ -408,6 +417,7 @@ func (o *initSvcOpts) Execute() error {
 			},
 			Topics:                  o.topics,
 			PrivateOnlyEnvironments: envs,
+			ImageRetention:          o.applyTo(nil),
 		},
 		Port:        o.port,
 		HealthCheck: hc,
@@ -456,6 +466,9 @@ func (o *initSvcOpts) askSvcDetails() error {
 			return err
 		}
 	}
+	if o.wkldType == manifestinfo.LambdaServiceType {
+		return nil // Lambda functions are invoked with events rather than listening on a port.
+	}
 	if err := o.askSvcPort(); err != nil {
 		return err
 	}
@@ -945,6 +958,9 @@ This command is also run as part of "copilot init".`,
 	cmd.Flags().StringVar(&vars.ingressType, ingressTypeFlag, "", ingressTypeFlagDescription)
 	cmd.Flags().StringArrayVar(&vars.sourcePaths, sourcesFlag, nil, sourcesFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowAppDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
+	cmd.Flags().IntVar(&vars.keepImages, keepImagesFlag, 0, wkldKeepImagesFlagDescription)
+	cmd.Flags().IntVar(&vars.untaggedExpiryDays, untaggedImageExpiryFlag, 0, wkldUntaggedImageExpiryFlagDescription)
+	cmd.Flags().BoolVar(&vars.immutableTags, immutableTagsFlag, false, wkldImmutableTagsFlagDescription)
 
 	return cmd
 }
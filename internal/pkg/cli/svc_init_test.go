@@ -304,7 +304,7 @@ func TestSvcInitOpts_Ask(t *testing.T) {
 	}{
 		"invalid service type": {
 			inSvcType: "TestSvcType",
-			wantedErr: errors.New(`invalid service type TestSvcType: must be one of "Request-Driven Web Service", "Load Balanced Web Service", "Backend Service", "Worker Service", "Static Site"`),
+			wantedErr: errors.New(`invalid service type TestSvcType: must be one of "Request-Driven Web Service", "Load Balanced Web Service", "Backend Service", "Worker Service", "Static Site", "Lambda Service"`),
 		},
 		"invalid service name": {
 			inSvcType: wantedSvcType,
@@ -450,6 +450,10 @@ type: Request-Driven Web Service`), nil)
 						Value: manifestinfo.StaticSiteType,
 						Hint:  "Internet to CDN to S3 bucket",
 					},
+					{
+						Value: manifestinfo.LambdaServiceType,
+						Hint:  "Internet to Lambda",
+					},
 				}), gomock.Any()).
 					Return(wantedSvcType, nil)
 				m.mockStore.EXPECT().GetService(mockAppName, wantedSvcName).Return(nil, &config.ErrNoSuchService{}).Times(2)
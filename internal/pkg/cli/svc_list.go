@@ -46,7 +46,7 @@ func newListSvcOpts(vars listWkldVars) (*listSvcOpts, error) {
 		return nil, fmt.Errorf("default session: %v", err)
 	}
 
-	store := config.NewSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region))
+	store := config.NewCachedSSMStore(identity.New(sess), ssm.New(sess), aws.StringValue(sess.Config.Region))
 	svcLister := &list.SvcListWriter{
 		Ws:    ws,
 		Store: store,
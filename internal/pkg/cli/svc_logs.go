@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"regexp"
 	"sort"
 	"time"
 
@@ -58,9 +59,10 @@ type wkldLogsVars struct {
 type svcLogsVars struct {
 	wkldLogsVars
 
-	logGroup      string
-	containerName string
-	previous      bool
+	logGroup       string
+	containerNames []string
+	grep           string
+	previous       bool
 }
 
 type svcLogsOpts struct {
@@ -68,8 +70,9 @@ type svcLogsOpts struct {
 	wkldLogOpts
 
 	// Cached variables.
-	targetEnv     *config.Environment
-	targetSvcType string
+	targetEnv      *config.Environment
+	targetSvcType  string
+	messagePattern *regexp.Regexp
 }
 
 type wkldLogOpts struct {
@@ -119,12 +122,17 @@ func newSvcLogOpts(vars svcLogsVars) (*svcLogsOpts, error) {
 			return err
 		}
 		opts.ecs = ecs.New(sess)
+		app, err := opts.configStore.GetApplication(opts.appName)
+		if err != nil {
+			return fmt.Errorf("get application %s: %w", opts.appName, err)
+		}
 
 		newWorkloadLoggerOpts := &logging.NewWorkloadLoggerOpts{
-			App:  opts.appName,
-			Env:  opts.envName,
-			Name: opts.name,
-			Sess: sess,
+			App:      opts.appName,
+			Env:      opts.envName,
+			Name:     opts.name,
+			LogGroup: app.LogGroupName(opts.envName, opts.name),
+			Sess:     sess,
 		}
 		if opts.targetSvcType != manifestinfo.RequestDrivenWebServiceType {
 			opts.logsSvc = logging.NewECSServiceClient(newWorkloadLoggerOpts)
@@ -180,6 +188,14 @@ func (o *svcLogsOpts) Validate() error {
 		return fmt.Errorf("--limit %d is out-of-bounds, value must be between %d and %d", o.limit, cwGetLogEventsLimitMin, cwGetLogEventsLimitMax)
 	}
 
+	if o.grep != "" {
+		pattern, err := regexp.Compile(o.grep)
+		if err != nil {
+			return fmt.Errorf(`invalid argument %s for "--grep" flag: %w`, o.grep, err)
+		}
+		o.messagePattern = pattern
+	}
+
 	if o.previous {
 		if err := o.validatePrevious(); err != nil {
 			return err
@@ -222,14 +238,15 @@ func (o *svcLogsOpts) Execute() error {
 		log.Infoln("previously stopped task:", taskID)
 	}
 	err := o.logsSvc.WriteLogEvents(logging.WriteLogEventsOpts{
-		Follow:        o.follow,
-		Limit:         limit,
-		EndTime:       o.endTime,
-		StartTime:     o.startTime,
-		TaskIDs:       o.taskIDs,
-		OnEvents:      eventsWriter,
-		ContainerName: o.containerName,
-		LogGroup:      o.logGroup,
+		Follow:         o.follow,
+		Limit:          limit,
+		EndTime:        o.endTime,
+		StartTime:      o.startTime,
+		TaskIDs:        o.taskIDs,
+		OnEvents:       eventsWriter,
+		ContainerNames: o.containerNames,
+		MessagePattern: o.messagePattern,
+		LogGroup:       o.logGroup,
 	})
 	if err != nil {
 		return fmt.Errorf("write log events for service %s: %w", o.name, err)
@@ -289,6 +306,9 @@ func (o *svcLogsOpts) validateAndAskSvcEnvName() error {
 	if deployedService.SvcType == manifestinfo.RequestDrivenWebServiceType && len(o.taskIDs) != 0 {
 		return fmt.Errorf("cannot use `--tasks` for App Runner service logs")
 	}
+	if deployedService.SvcType == manifestinfo.LambdaServiceType && len(o.taskIDs) != 0 {
+		return fmt.Errorf("cannot use `--tasks` for Lambda service logs")
+	}
 	if deployedService.SvcType == manifestinfo.StaticSiteType {
 		return fmt.Errorf("`svc logs` unavailable for Static Site services")
 	}
@@ -349,6 +369,8 @@ func buildSvcLogsCmd() *cobra.Command {
   /code $ copilot svc logs --tasks 709c7eae05f947f6861b150372ddc443,1de57fd63c6a4920ac416d02add891b9
   Displays logs in real time.
   /code $ copilot svc logs --follow
+  Displays logs of the "web" and "nginx" containers that contain "ERROR", in real time.
+  /code $ copilot svc logs --follow --container web,nginx --grep ERROR
   Display logs from specific log group.
   /code $ copilot svc logs --log-group system`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
@@ -371,6 +393,7 @@ func buildSvcLogsCmd() *cobra.Command {
 	cmd.Flags().StringSliceVar(&vars.taskIDs, tasksFlag, nil, tasksLogsFlagDescription)
 	cmd.Flags().StringVar(&vars.logGroup, logGroupFlag, "", logGroupFlagDescription)
 	cmd.Flags().BoolVarP(&vars.previous, previousFlag, previousFlagShort, false, previousFlagDescription)
-	cmd.Flags().StringVar(&vars.containerName, containerLogFlag, "", containerLogFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.containerNames, containerLogFlag, nil, containerLogFlagDescription)
+	cmd.Flags().StringVar(&vars.grep, grepFlag, "", grepFlagDescription)
 	return cmd
 }
@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -50,6 +51,7 @@ func TestSvcLogs_Validate(t *testing.T) {
 		inputSince     time.Duration
 		inputPrevious  bool
 		inputTaskIDs   []string
+		inputGrep      string
 
 		mockstore func(m *mocks.Mockstore)
 
@@ -117,6 +119,18 @@ func TestSvcLogs_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("cannot specify both --previous and --tasks"),
 		},
+		"returns error if invalid grep flag value": {
+			inputGrep: "ERROR(",
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("invalid argument ERROR( for \"--grep\" flag: error parsing regexp: missing closing ): `ERROR(`"),
+		},
+		"with a valid grep flag value": {
+			inputGrep: "ERROR|WARN",
+
+			mockstore: func(m *mocks.Mockstore) {},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -141,6 +155,7 @@ func TestSvcLogs_Validate(t *testing.T) {
 						taskIDs:        tc.inputTaskIDs,
 					},
 					previous: tc.inputPrevious,
+					grep:     tc.inputGrep,
 				},
 				wkldLogOpts: wkldLogOpts{
 					configStore: mockstore,
@@ -364,7 +379,8 @@ func TestSvcLogs_Execute(t *testing.T) {
 		startTime         int64
 		taskIDs           []string
 		inputPreviousTask bool
-		container         string
+		containers        []string
+		messagePattern    *regexp.Regexp
 		logGroup          string
 
 		setupMocks func(mocks wkldLogsMock)
@@ -372,13 +388,14 @@ func TestSvcLogs_Execute(t *testing.T) {
 		wantedError error
 	}{
 		"success": {
-			inputSvc:  "mockSvc",
-			endTime:   mockEndTime,
-			startTime: mockStartTime,
-			follow:    true,
-			limit:     10,
-			taskIDs:   []string{"mockTaskID"},
-			container: "datadog",
+			inputSvc:       "mockSvc",
+			endTime:        mockEndTime,
+			startTime:      mockStartTime,
+			follow:         true,
+			limit:          10,
+			taskIDs:        []string{"mockTaskID"},
+			containers:     []string{"datadog", "nginx"},
+			messagePattern: regexp.MustCompile("ERROR"),
 			setupMocks: func(m wkldLogsMock) {
 				gomock.InOrder(
 					m.logSvcWriter.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
@@ -387,7 +404,8 @@ func TestSvcLogs_Execute(t *testing.T) {
 						require.Equal(t, param.StartTime, &mockStartTime)
 						require.Equal(t, param.Follow, true)
 						require.Equal(t, param.Limit, &mockLimit)
-						require.Equal(t, param.ContainerName, "datadog")
+						require.Equal(t, param.ContainerNames, []string{"datadog", "nginx"})
+						require.Equal(t, param.MessagePattern.String(), "ERROR")
 					}).Return(nil),
 				)
 			},
@@ -426,7 +444,8 @@ func TestSvcLogs_Execute(t *testing.T) {
 						require.Equal(t, param.StartTime, &mockStartTime)
 						require.Equal(t, param.Follow, false)
 						require.Equal(t, param.Limit, (*int64)(nil))
-						require.Equal(t, param.ContainerName, "")
+						require.Nil(t, param.ContainerNames)
+						require.Nil(t, param.MessagePattern)
 						require.Equal(t, param.LogGroup, "system")
 					}).Return(nil),
 				)
@@ -545,9 +564,9 @@ func TestSvcLogs_Execute(t *testing.T) {
 						limit:   tc.limit,
 						taskIDs: tc.taskIDs,
 					},
-					previous:      tc.inputPreviousTask,
-					containerName: tc.container,
-					logGroup:      tc.logGroup,
+					previous:       tc.inputPreviousTask,
+					containerNames: tc.containers,
+					logGroup:       tc.logGroup,
 				},
 
 				wkldLogOpts: wkldLogOpts{
@@ -560,6 +579,7 @@ func TestSvcLogs_Execute(t *testing.T) {
 					sessProvider:       mockSessionProvider,
 					ecs:                mockSvcDescriber,
 				},
+				messagePattern: tc.messagePattern,
 			}
 
 			// WHEN
@@ -0,0 +1,150 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcManifestRenderNamePrompt = "Which service's manifest would you like to render?"
+	svcManifestRenderEnvPrompt  = "Which environment would you like to render the manifest for?"
+)
+
+type renderSvcManifestVars struct {
+	appName string
+	name    string
+	envName string
+}
+
+// renderSvcManifestOpts prints the manifest of a service in the workspace as it is deployed to an environment.
+type renderSvcManifestOpts struct {
+	renderSvcManifestVars
+
+	ws              wsWorkloadManifestReader
+	sel             workspaceSelector
+	newInterpolator func(app, env string) interpolator
+	w               io.Writer
+}
+
+func newRenderSvcManifestOpts(vars renderSvcManifestVars) (*renderSvcManifestOpts, error) {
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	return &renderSvcManifestOpts{
+		renderSvcManifestVars: vars,
+		ws:                    ws,
+		sel:                   selector.NewWorkspaceSelector(prompt.New(), ws),
+		newInterpolator:       newManifestInterpolator,
+		w:                     os.Stdout,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *renderSvcManifestOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.name == "" {
+		return nil
+	}
+	names, err := o.ws.ListServices()
+	if err != nil {
+		return fmt.Errorf("list services in the workspace: %w", err)
+	}
+	if !contains(o.name, names) {
+		return fmt.Errorf("service %q does not exist in the workspace", o.name)
+	}
+	return nil
+}
+
+// Ask prompts the user for any missing required fields.
+func (o *renderSvcManifestOpts) Ask() error {
+	if o.name == "" {
+		name, err := o.sel.Service(svcManifestRenderNamePrompt, "")
+		if err != nil {
+			return fmt.Errorf("select service: %w", err)
+		}
+		o.name = name
+	}
+	if o.envName == "" {
+		env, err := o.sel.Environment(svcManifestRenderEnvPrompt, "")
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.envName = env
+	}
+	return nil
+}
+
+// Execute prints the effective manifest of the service in the environment:
+// the manifest composed from the manifests that it extends and its override files, with its environment
+// variables substituted and the overrides of the environment applied.
+func (o *renderSvcManifestOpts) Execute() error {
+	raw, err := o.ws.ReadWorkloadManifest(o.name)
+	if err != nil {
+		return fmt.Errorf("read manifest file for service %s: %w", o.name, err)
+	}
+	if problems := manifest.CheckWorkload(raw, o.appName, o.envName); len(problems) > 0 {
+		return reportManifestProblems(problems, fmt.Sprintf("service %s", o.name))
+	}
+	interpolated, err := o.newInterpolator(o.appName, o.envName).Interpolate(string(raw))
+	if err != nil {
+		return fmt.Errorf("interpolate environment variables for %s manifest: %w", o.name, err)
+	}
+	rendered, err := manifest.RenderWorkloadForEnv([]byte(interpolated), o.envName)
+	if err != nil {
+		return fmt.Errorf("render manifest of service %s for environment %s: %w", o.name, o.envName, err)
+	}
+	fmt.Fprint(o.w, string(rendered))
+	return nil
+}
+
+// buildSvcManifestCmd builds the command group for the manifests of services.
+func buildSvcManifestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "manifest",
+		Short: "Commands for the manifests of services.",
+	}
+	cmd.AddCommand(buildSvcManifestRenderCmd())
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
+
+// buildSvcManifestRenderCmd builds the command for rendering the effective manifest of a service.
+func buildSvcManifestRenderCmd() *cobra.Command {
+	vars := renderSvcManifestVars{}
+	cmd := &cobra.Command{
+		Use:   "render",
+		Short: "Print the effective manifest of a service in an environment.",
+		Long: `Print the effective manifest of a service in an environment.
+Merges the manifests that the manifest extends, the override files of the environment
+and the overrides under "environments", and substitutes environment variables, without calling any AWS APIs.`,
+		Example: `
+  Print the manifest that is deployed for service "frontend" to the "prod" environment.
+  /code $ copilot svc manifest render -n frontend -e prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newRenderSvcManifestOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	return cmd
+}
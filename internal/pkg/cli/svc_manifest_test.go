@@ -0,0 +1,152 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderSvcManifestOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		inEnv      string
+		setupMocks func(m *mocks.MockworkspaceSelector)
+
+		wantedName string
+		wantedEnv  string
+		wantedErr  error
+	}{
+		"should not prompt if the service and environment are provided": {
+			inName:     "frontend",
+			inEnv:      "prod",
+			setupMocks: func(m *mocks.MockworkspaceSelector) {},
+			wantedName: "frontend",
+			wantedEnv:  "prod",
+		},
+		"should prompt for the service and the environment": {
+			setupMocks: func(m *mocks.MockworkspaceSelector) {
+				m.EXPECT().Service(svcManifestRenderNamePrompt, "").Return("frontend", nil)
+				m.EXPECT().Environment(svcManifestRenderEnvPrompt, "").Return("test", nil)
+			},
+			wantedName: "frontend",
+			wantedEnv:  "test",
+		},
+		"should return a wrapped error if the environment cannot be selected": {
+			inName: "frontend",
+			setupMocks: func(m *mocks.MockworkspaceSelector) {
+				m.EXPECT().Environment(svcManifestRenderEnvPrompt, "").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select environment: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			sel := mocks.NewMockworkspaceSelector(ctrl)
+			tc.setupMocks(sel)
+			opts := &renderSvcManifestOpts{
+				renderSvcManifestVars: renderSvcManifestVars{
+					name:    tc.inName,
+					envName: tc.inEnv,
+				},
+				sel: sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedName, opts.name)
+				require.Equal(t, tc.wantedEnv, opts.envName)
+			}
+		})
+	}
+}
+
+func TestRenderSvcManifestOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest string
+
+		wanted    string
+		wantedErr error
+	}{
+		"should print the manifest with the overrides of the environment applied": {
+			inManifest: `name: frontend
+type: Backend Service
+image:
+  location: nginx
+  port: 80
+variables:
+  ENV: ${COPILOT_ENVIRONMENT_NAME}
+environments:
+  prod:
+    count: 3
+`,
+			wanted: `name: frontend
+type: Backend Service
+image:
+  location: nginx
+  port: 80
+variables:
+  ENV: prod
+count: 3
+`,
+		},
+		"should return an error if the manifest is invalid": {
+			inManifest: `name: frontend
+type: Backend Service
+image:
+  location: nginx
+  port: 80
+cpus: 256
+`,
+			wantedErr: errors.New("found 1 problem in the manifest for service frontend"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsWorkloadManifestReader(ctrl)
+			ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(tc.inManifest), nil)
+			buf := &bytes.Buffer{}
+			opts := &renderSvcManifestOpts{
+				renderSvcManifestVars: renderSvcManifestVars{
+					appName: "phonetool",
+					name:    "frontend",
+					envName: "prod",
+				},
+				ws:              ws,
+				newInterpolator: newManifestInterpolator,
+				w:               buf,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, buf.String())
+			}
+		})
+	}
+}
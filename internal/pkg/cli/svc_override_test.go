@@ -256,12 +256,12 @@ func TestOverrideSvc_Ask(t *testing.T) {
 			},
 			"return an error if IaC tool flag value is invalid": {
 				iacTool: "terraform",
-				wanted:  errors.New(`"terraform" is not a valid IaC tool: must be one of: "cdk", "yamlpatch"`),
+				wanted:  errors.New(`"terraform" is not a valid IaC tool: must be one of: "cdk", "yamlpatch", "merge"`),
 			},
 			"should ask for IaC tool name if flag is not provided": {
 				initMocks: func(ctrl *gomock.Controller, cmd *overrideWorkloadOpts) {
 					mockPrompt := mocks.NewMockprompter(ctrl)
-					mockPrompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), []string{"cdk", "yamlpatch"}, gomock.Any())
+					mockPrompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), []string{"cdk", "yamlpatch", "merge"}, gomock.Any())
 					cmd.prompt = mockPrompt
 				},
 			},
@@ -9,22 +9,31 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/buildcache"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cdk"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/terraform"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/hooks"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -35,15 +44,33 @@ const (
 	svcPackageEnvNamePrompt = "Which environment would you like to package this stack for?"
 )
 
+// Formats of the packaged stack.
+const (
+	stackFormatCloudFormation = "cloudformation"
+	stackFormatTerraform      = "terraform"
+	stackFormatCDK            = "cdk"
+)
+
+var stackFormats = []string{stackFormatCloudFormation, stackFormatTerraform, stackFormatCDK}
+
 type packageSvcVars struct {
 	name               string
 	envName            string
 	appName            string
 	tag                string
+	builder            string
+	buildRemote        bool
+	prebuiltImage      string // Existing image to deploy for the main container instead of building one.
 	outputDir          string
+	format             string
 	uploadAssets       bool
 	showDiff           bool
+	diffExitCode       bool
+	diffFile           string
 	allowWkldDowngrade bool
+	noBuildCache       bool
+	checkTemplate      bool
+	offlineVars
 
 	// To facilitate unit tests.
 	clientConfigured bool
@@ -67,8 +94,12 @@ type packageSvcOpts struct {
 	unmarshal            func([]byte) (manifest.DynamicWorkload, error)
 	newInterpolator      func(app, env string) interpolator
 	newStackGenerator    func(*packageSvcOpts) (workloadStackGenerator, error)
+	newOverrider         func(*packageSvcOpts) (clideploy.Overrider, error)
 	envFeaturesDescriber versionCompatibilityChecker
 	gitShortCommit       string
+	buildCache           *buildcache.Cache        // Nil with --no-build-cache.
+	offlineValues        *clideploy.OfflineValues // Nil unless --offline.
+	hooks                *hooks.Runner            // Nil unless run by the package commands.
 
 	// cached variables
 	targetApp         *config.Application
@@ -88,13 +119,24 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 		return nil, err
 	}
 
-	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc package"))
+	sessOpts := []func(*sessions.Provider){sessions.UserAgentExtras("svc package")}
+	var offlineValues *clideploy.OfflineValues
+	if vars.offline {
+		if offlineValues, err = vars.values(fs); err != nil {
+			return nil, err
+		}
+		sessOpts = append(sessOpts, sessions.Offline(offlineValues.Region))
+	}
+	sessProvider := sessions.ImmutableProvider(sessOpts...)
 	defaultSess, err := sessProvider.Default()
 	if err != nil {
 		return nil, fmt.Errorf("default session: %v", err)
 	}
 
-	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	var store store = config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	if offlineValues != nil {
+		store = newOfflineStore(store, offlineValues, ws)
+	}
 	prompter := prompt.New()
 	opts := &packageSvcOpts{
 		packageSvcVars:    vars,
@@ -112,10 +154,17 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 		newInterpolator:   newManifestInterpolator,
 		sessProvider:      sessProvider,
 		newStackGenerator: newWorkloadStackGenerator,
+		newOverrider:      newWorkloadOverrider,
+		buildCache:        workspaceBuildCache(ws, vars.noBuildCache),
+		offlineValues:     offlineValues,
 	}
 	return opts, nil
 }
 
+func newWorkloadOverrider(o *packageSvcOpts) (clideploy.Overrider, error) {
+	return clideploy.NewOverrider(o.ws.WorkloadOverridesPath(o.name), o.appName, o.envName, o.fs, o.sessProvider)
+}
+
 func newWorkloadStackGenerator(o *packageSvcOpts) (workloadStackGenerator, error) {
 	targetApp, err := o.getTargetApp()
 	if err != nil {
@@ -129,10 +178,25 @@ func newWorkloadStackGenerator(o *packageSvcOpts) (workloadStackGenerator, error
 	if err != nil {
 		return nil, fmt.Errorf("read manifest file for %s: %w", o.name, err)
 	}
-	ovrdr, err := clideploy.NewOverrider(o.ws.WorkloadOverridesPath(o.name), o.appName, o.envName, o.fs, o.sessProvider)
+	rendered, err := renderedManifest(raw, o.newInterpolator(o.appName, o.envName))
+	if err != nil {
+		return nil, fmt.Errorf("interpolate environment variables for %s manifest: %w", o.name, err)
+	}
+	ovrdr, err := o.newOverrider(o)
 	if err != nil {
 		return nil, err
 	}
+	var offline *clideploy.Offline
+	if o.offlineValues != nil {
+		envMft, err := offlineEnvManifest(o.ws, o.newInterpolator(o.appName, o.envName), o.envName)
+		if err != nil {
+			return nil, err
+		}
+		offline = &clideploy.Offline{
+			Values:      o.offlineValues,
+			EnvManifest: envMft,
+		}
+	}
 
 	content := o.appliedDynamicMft.Manifest()
 	var deployer workloadStackGenerator
@@ -147,8 +211,14 @@ func newWorkloadStackGenerator(o *packageSvcOpts) (workloadStackGenerator, error
 		},
 		Mft:              content,
 		RawMft:           raw,
+		RenderedMft:      rendered,
 		EnvVersionGetter: o.envFeaturesDescriber,
 		Overrider:        ovrdr,
+		Builder:          o.builder,
+		BuildRemote:      o.buildRemote,
+		PrebuiltImage:    o.prebuiltImage,
+		BuildCache:       o.buildCache,
+		Offline:          offline,
 	}
 	switch t := content.(type) {
 	case *manifest.LoadBalancedWebService:
@@ -161,8 +231,12 @@ func newWorkloadStackGenerator(o *packageSvcOpts) (workloadStackGenerator, error
 		deployer, err = clideploy.NewWorkerSvcDeployer(&in)
 	case *manifest.ScheduledJob:
 		deployer, err = clideploy.NewJobDeployer(&in)
+	case *manifest.WorkflowJob:
+		deployer, err = clideploy.NewWorkflowJobDeployer(&in)
 	case *manifest.StaticSite:
 		deployer, err = clideploy.NewStaticSiteDeployer(&in)
+	case *manifest.LambdaService:
+		deployer, err = clideploy.NewLambdaDeployer(&in)
 	default:
 		return nil, fmt.Errorf("unknown manifest type %T while creating the CloudFormation stack", t)
 	}
@@ -174,7 +248,18 @@ func newWorkloadStackGenerator(o *packageSvcOpts) (workloadStackGenerator, error
 
 // Validate returns an error for any invalid optional flags.
 func (o *packageSvcOpts) Validate() error {
-	return nil
+	if o.diffExitCode && !o.showDiff {
+		return fmt.Errorf("--%s must be used with --%s", diffExitCodeFlag, diffFlag)
+	}
+	if err := o.offlineVars.validate(); err != nil {
+		return err
+	}
+	if o.prebuiltImage != "" {
+		if _, err := ecr.ParseImageURI(o.prebuiltImage); err != nil {
+			return fmt.Errorf("validate --%s: %w", imageFlag, err)
+		}
+	}
+	return validateStackFormat(o.format, o.outputDir)
 }
 
 // Ask prompts for and validates any required flags.
@@ -198,12 +283,35 @@ func (o *packageSvcOpts) Ask() error {
 
 // Execute prints the CloudFormation template of the application for the environment.
 func (o *packageSvcOpts) Execute() error {
+	hookIn := hooks.Input{App: o.appName, Environment: o.envName, Workload: o.name}
+	if err := o.hooks.Run(hooks.PrePackage, hookIn); err != nil {
+		return err
+	}
+	err := o.execute()
+	if err == nil {
+		return o.hooks.Run(hooks.PostPackage, hookIn)
+	}
+	if !o.diffExitCode {
+		return err
+	}
+	var errHasDiff *errHasDiff
+	var errNoDiff *errDiffNotAvailable
+	var errCheckFailed *errTemplateCheckFailed
+	if errors.As(err, &errHasDiff) || errors.As(err, &errNoDiff) || errors.As(err, &errCheckFailed) {
+		return err
+	}
+	return &errDiffNotAvailable{
+		parentErr: err,
+	}
+}
+
+func (o *packageSvcOpts) execute() error {
 	if !o.clientConfigured {
 		if err := o.configureClients(); err != nil {
 			return err
 		}
 	}
-	if !o.allowWkldDowngrade {
+	if !o.allowWkldDowngrade && o.offlineValues == nil {
 		if err := validateWkldVersion(o.svcVersionGetter, o.name, o.templateVersion); err != nil {
 			return err
 		}
@@ -225,6 +333,11 @@ func (o *packageSvcOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	if o.checkTemplate {
+		if err := o.checkStackTemplate(gen, stack.template); err != nil {
+			return err
+		}
+	}
 	if o.showDiff {
 		if err := diff(gen, stack.template, o.diffWriter); err != nil {
 			var errHasDiff *errHasDiff
@@ -236,12 +349,27 @@ func (o *packageSvcOpts) Execute() error {
 			}
 		}
 	}
+	if o.diffFile != "" {
+		if err := writeDiffFile(o.fs, o.diffFile, gen, stack.template); err != nil {
+			return err
+		}
+	}
+	if o.format == stackFormatTerraform {
+		if stack, err = o.terraformStack(stack, targetEnv); err != nil {
+			return err
+		}
+	}
 	if err := o.writeAndClose(o.templateWriter, stack.template); err != nil {
 		return err
 	}
 	if err := o.writeAndClose(o.paramsWriter, stack.parameters); err != nil {
 		return err
 	}
+	if o.format == stackFormatCDK {
+		if err := o.writeCDKApp(stack); err != nil {
+			return err
+		}
+	}
 	addonsTemplate, err := gen.AddonsTemplate()
 	switch {
 	case err != nil:
@@ -258,6 +386,19 @@ func (o *packageSvcOpts) Execute() error {
 	return o.writeAndClose(o.addonsWriter, addonsTemplate)
 }
 
+func (o *packageSvcOpts) checkStackTemplate(gen workloadStackGenerator, template string) error {
+	app, err := o.getTargetApp()
+	if err != nil {
+		return err
+	}
+	checker := newTemplateChecker(app, o.envName, o.runner, log.DiagnosticWriter)
+	if !checker.configured() {
+		log.Warningf("The deploy policies of application %s and environment %s don't configure any checks.\n", o.appName, o.envName)
+		return nil
+	}
+	return checkStackTemplate(checker, gen, stack.NameForWorkload(o.appName, o.envName, o.name), template)
+}
+
 func (o *packageSvcOpts) validateOrAskSvcName() error {
 	if o.name != "" {
 		names, err := o.ws.ListServices()
@@ -308,6 +449,15 @@ func (o *packageSvcOpts) configureClients() error {
 		return err
 	}
 	o.envSess = envSess
+	if o.offlineValues != nil {
+		caller, err := offlineCaller{values: o.offlineValues}.Get()
+		if err != nil {
+			return err
+		}
+		o.rootUserARN = caller.RootUserARN
+		o.envFeaturesDescriber = offlineEnvFeatures{}
+		return nil
+	}
 	// client to retrieve caller identity.
 	caller, err := identity.New(defaultSess).Get()
 	if err != nil {
@@ -352,6 +502,7 @@ func (o *packageSvcOpts) getStackGenerator(env *config.Environment) (workloadSta
 		ws:           o.ws,
 		unmarshal:    o.unmarshal,
 		sess:         o.envSess,
+		fs:           o.fs,
 	})
 	if err != nil {
 		return nil, err
@@ -383,6 +534,7 @@ func (o *packageSvcOpts) getWorkloadStack(generator workloadStackGenerator) (*cf
 			Tags:                      targetApp.Tags,
 			EnvFileARNs:               uploadOut.EnvFileARNs,
 			ImageDigests:              uploadOut.ImageDigests,
+			PinnedImages:              uploadOut.PinnedImages,
 			AddonsURL:                 uploadOut.AddonsURL,
 			Version:                   o.templateVersion,
 			CustomResourceURLs:        uploadOut.CustomResourceURLs,
@@ -397,6 +549,41 @@ func (o *packageSvcOpts) getWorkloadStack(generator workloadStackGenerator) (*cf
 		parameters: output.Parameters}, nil
 }
 
+// terraformStack replaces the template configuration of the stack with a Terraform configuration that deploys it.
+// If the stack isn't written to a directory, the template is inlined in the Terraform configuration instead.
+func (o *packageSvcOpts) terraformStack(cfn *cfnStackConfig, env *config.Environment) (*cfnStackConfig, error) {
+	tf := terraform.Stack{
+		Name:             stack.NameForWorkload(o.appName, o.envName, o.name),
+		Template:         cfn.template,
+		Configuration:    cfn.parameters,
+		ExecutionRoleARN: env.ExecutionRoleARN,
+	}
+	if o.outputDir != "" {
+		tf.TemplatePath = fmt.Sprintf(deploy.WorkloadCfnTemplateNameFormat, o.name, o.envName)
+	}
+	hcl, err := tf.HCL()
+	if err != nil {
+		return nil, fmt.Errorf("generate Terraform configuration for %s: %w", o.name, err)
+	}
+	if o.outputDir == "" {
+		return &cfnStackConfig{template: string(hcl)}, nil
+	}
+	return &cfnStackConfig{template: cfn.template, parameters: string(hcl)}, nil
+}
+
+// writeCDKApp writes a CDK application that includes the template of the stack to the output directory.
+func (o *packageSvcOpts) writeCDKApp(cfn *cfnStackConfig) error {
+	app := cdk.App{
+		StackName:     stack.NameForWorkload(o.appName, o.envName, o.name),
+		TemplateFile:  fmt.Sprintf(deploy.WorkloadCfnTemplateNameFormat, o.name, o.envName),
+		Configuration: cfn.parameters,
+	}
+	if err := app.Write(o.fs, o.outputDir); err != nil {
+		return fmt.Errorf("write CDK application for %s: %w", o.name, err)
+	}
+	return nil
+}
+
 // setOutputFileWriters creates the output directory, and updates the template and param writers to file writers in the directory.
 func (o *packageSvcOpts) setOutputFileWriters() error {
 	if err := o.fs.MkdirAll(o.outputDir, 0755); err != nil {
@@ -411,8 +598,16 @@ func (o *packageSvcOpts) setOutputFileWriters() error {
 	}
 	o.templateWriter = templateFile
 
-	paramsPath := filepath.Join(o.outputDir,
-		fmt.Sprintf(deploy.WorkloadCfnTemplateConfigurationNameFormat, o.name, o.envName))
+	paramsNameFormat := deploy.WorkloadCfnTemplateConfigurationNameFormat
+	switch o.format {
+	case stackFormatTerraform:
+		paramsNameFormat = deploy.WorkloadTerraformConfigurationNameFormat
+	case stackFormatCDK:
+		// The parameters are props of the CDK application instead.
+		o.paramsWriter = discardFile{}
+		return nil
+	}
+	paramsPath := filepath.Join(o.outputDir, fmt.Sprintf(paramsNameFormat, o.name, o.envName))
 	paramsFile, err := o.fs.Create(paramsPath)
 	if err != nil {
 		return fmt.Errorf("create file %s: %w", paramsPath, err)
@@ -488,6 +683,16 @@ func (e *errDiffNotAvailable) ExitCode() int {
 	return 2
 }
 
+func validateStackFormat(format, outputDir string) error {
+	if format == stackFormatCDK && outputDir == "" {
+		return fmt.Errorf("--%s %s must be used with --%s", stackFormatFlag, stackFormatCDK, stackOutputDirFlag)
+	}
+	if format == "" || contains(format, stackFormats) {
+		return nil
+	}
+	return fmt.Errorf("invalid format %q: must be one of %s", format, english.WordSeries(applyAll(stackFormats, strconv.Quote), "or"))
+}
+
 func contains(s string, items []string) bool {
 	for _, item := range items {
 		if s == item {
@@ -513,12 +718,26 @@ func buildSvcPackageCmd() *cobra.Command {
   $ copilot svc package -n frontend -e test --output-dir ./infrastructure
   $ ls ./infrastructure
   frontend-test.stack.yml      frontend-test.params.json
-  /endcodeblock`,
+  /endcodeblock
+
+  Write the CloudFormation stack and a Terraform configuration that deploys it to a "infrastructure/" sub-directory.
+  /startcodeblock
+  $ copilot svc package -n frontend -e test --output-dir ./infrastructure --format terraform
+  $ ls ./infrastructure
+  frontend-test.stack.yml      frontend-test.tf
+  /endcodeblock
+
+  Write the CloudFormation stack and a CDK application that includes it to a "infrastructure/" sub-directory.
+  /code $ copilot svc package -n frontend -e test --output-dir ./infrastructure --format cdk
+
+  Print the CloudFormation template without AWS credentials, with the values of the application read from a file.
+  /code $ copilot svc package -n frontend -e test --offline --values ./copilot-values.yml`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newPackageSvcOpts(vars)
 			if err != nil {
 				return err
 			}
+			opts.hooks = hooks.New(opts.ws)
 			return run(opts)
 		}),
 	}
@@ -526,12 +745,31 @@ func buildSvcPackageCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVar(&vars.tag, imageTagFlag, "", imageTagFlagDescription)
+	cmd.Flags().StringVar(&vars.builder, builderFlag, "", builderFlagDescription)
+	cmd.Flags().BoolVar(&vars.buildRemote, buildRemoteFlag, false, buildRemoteFlagDescription)
+	cmd.Flags().StringVar(&vars.prebuiltImage, imageFlag, "", deployImageFlagDescription)
 	cmd.Flags().StringVar(&vars.outputDir, stackOutputDirFlag, "", stackOutputDirFlagDescription)
+	cmd.Flags().StringVar(&vars.format, stackFormatFlag, stackFormatCloudFormation, stackFormatFlagDescription)
 	cmd.Flags().BoolVar(&vars.uploadAssets, uploadAssetsFlag, false, uploadAssetsFlagDescription)
 	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	cmd.Flags().BoolVar(&vars.diffExitCode, diffExitCodeFlag, false, diffExitCodeFlagDescription)
+	cmd.Flags().StringVar(&vars.diffFile, diffFileFlag, "", diffFileFlagDescription)
 	cmd.Flags().BoolVar(&vars.allowWkldDowngrade, allowDowngradeFlag, false, allowDowngradeFlagDescription)
+	cmd.Flags().BoolVar(&vars.noBuildCache, noBuildCacheFlag, false, noBuildCacheFlagDescription)
+	cmd.Flags().BoolVar(&vars.checkTemplate, checkFlag, false, checkTemplateFlagDescription)
+	cmd.Flags().BoolVar(&vars.offline, offlineFlag, false, offlineFlagDescription)
+	cmd.Flags().StringVar(&vars.accountID, accountIDFlag, "", accountIDFlagDescription)
+	cmd.Flags().StringVar(&vars.valuesFile, valuesFlag, "", offlineValuesFlagDescription)
+	cmd.MarkFlagsMutuallyExclusive(builderFlag, buildRemoteFlag)
+	cmd.MarkFlagsMutuallyExclusive(imageFlag, imageTagFlag)
+	cmd.MarkFlagsMutuallyExclusive(imageFlag, builderFlag)
+	cmd.MarkFlagsMutuallyExclusive(imageFlag, buildRemoteFlag)
 
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, stackOutputDirFlag)
 	cmd.MarkFlagsMutuallyExclusive(diffFlag, uploadAssetsFlag)
+	cmd.MarkFlagsMutuallyExclusive(offlineFlag, uploadAssetsFlag)
+	cmd.MarkFlagsMutuallyExclusive(offlineFlag, diffFlag)
+	cmd.MarkFlagsMutuallyExclusive(offlineFlag, diffFileFlag)
+	cmd.MarkFlagsMutuallyExclusive(offlineFlag, buildRemoteFlag)
 	return cmd
 }
@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/require"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/deploy"
@@ -150,6 +151,70 @@ func (wc mockWriteCloser) Close() error {
 	return nil
 }
 
+func TestPackageSvcOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inVars packageSvcVars
+
+		wantedErr error
+	}{
+		"valid without flags": {},
+		"valid with --diff and --exit-code": {
+			inVars: packageSvcVars{
+				showDiff:     true,
+				diffExitCode: true,
+			},
+		},
+		"error if --exit-code is used without --diff": {
+			inVars: packageSvcVars{
+				diffExitCode: true,
+			},
+			wantedErr: errors.New("--exit-code must be used with --diff"),
+		},
+		"valid with --format terraform": {
+			inVars: packageSvcVars{
+				format: "terraform",
+			},
+		},
+		"error if --format is unknown": {
+			inVars: packageSvcVars{
+				format: "pulumi",
+			},
+			wantedErr: errors.New(`invalid format "pulumi": must be one of "cloudformation", "terraform" or "cdk"`),
+		},
+		"valid with --format cdk and --output-dir": {
+			inVars: packageSvcVars{
+				format:    "cdk",
+				outputDir: "infrastructure",
+			},
+		},
+		"error if --image is not referenced by digest": {
+			inVars: packageSvcVars{
+				prebuiltImage: "123456789012.dkr.ecr.us-west-2.amazonaws.com/frontend",
+			},
+			wantedErr: errors.New(`validate --image: image "123456789012.dkr.ecr.us-west-2.amazonaws.com/frontend" must be an ECR repository URI followed by an image digest, such as 123456789012.dkr.ecr.us-west-2.amazonaws.com/repo@sha256:<digest>`),
+		},
+		"error if --format cdk is used without --output-dir": {
+			inVars: packageSvcVars{
+				format: "cdk",
+			},
+			wantedErr: errors.New("--format cdk must be used with --output-dir"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &packageSvcOpts{
+				packageSvcVars: tc.inVars,
+			}
+			err := opts.Validate()
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestPackageSvcOpts_Execute(t *testing.T) {
 	const (
 		mockARN    = "mockARN"
@@ -185,6 +250,9 @@ count: 1`
 		wantedAddons string
 		wantedDiff   string
 		wantedErr    error
+
+		wantedExitCode int
+		wantedDiffFile string
 	}{
 		"error out if fail to get version": {
 			inVars: packageSvcVars{
@@ -197,6 +265,19 @@ count: 1`
 			},
 			wantedErr: fmt.Errorf("get template version of workload api: some error"),
 		},
+		"exit with 2 if fail to get version with --exit-code": {
+			inVars: packageSvcVars{
+				name:             "api",
+				clientConfigured: true,
+				showDiff:         true,
+				diffExitCode:     true,
+			},
+			setupMocks: func(m *svcPackageExecuteMock) {
+				m.mockVersionGetter.EXPECT().Version().Return("", errors.New("some error"))
+			},
+			wantedErr:      fmt.Errorf("get template version of workload api: some error"),
+			wantedExitCode: 2,
+		},
 		"fail to get the diff": {
 			inVars: packageSvcVars{
 				name:               "api",
@@ -220,7 +301,8 @@ count: 1`
 				}, nil)
 				m.generator.EXPECT().DeployDiff(gomock.Eq("mystack")).Return("", errors.New("some error"))
 			},
-			wantedErr: &errDiffNotAvailable{parentErr: errors.New("some error")},
+			wantedErr:      &errDiffNotAvailable{parentErr: errors.New("some error")},
+			wantedExitCode: 2,
 		},
 		"writes the diff": {
 			inVars: packageSvcVars{
@@ -245,8 +327,37 @@ count: 1`
 				}, nil)
 				m.generator.EXPECT().DeployDiff(gomock.Eq("mystack")).Return("mock diff", nil)
 			},
-			wantedDiff: "mock diff",
-			wantedErr:  &errHasDiff{},
+			wantedDiff:     "mock diff",
+			wantedErr:      &errHasDiff{},
+			wantedExitCode: 1,
+		},
+		"writes the diff to a file and still packages the stack": {
+			inVars: packageSvcVars{
+				name:               "api",
+				clientConfigured:   true,
+				diffFile:           "diffs/test/api.diff",
+				allowWkldDowngrade: true,
+			},
+			setupMocks: func(m *svcPackageExecuteMock) {
+				m.ws.EXPECT().ReadWorkloadManifest("api").Return([]byte(lbwsMft), nil)
+				m.interpolator.EXPECT().Interpolate(lbwsMft).Return(lbwsMft, nil)
+				m.mft = &mockWorkloadMft{
+					mockRequiredEnvironmentFeatures: func() []string {
+						return []string{}
+					},
+				}
+				m.envFeaturesDescriber.EXPECT().Version().Return("v1.mock", nil)
+				m.envFeaturesDescriber.EXPECT().AvailableFeatures().Return([]string{}, nil)
+				m.generator.EXPECT().GenerateCloudFormationTemplate(gomock.Any()).Return(&deploy.GenerateCloudFormationTemplateOutput{
+					Template:   "mystack",
+					Parameters: "myparams",
+				}, nil)
+				m.generator.EXPECT().DeployDiff(gomock.Eq("mystack")).Return("mock diff", nil)
+				m.generator.EXPECT().AddonsTemplate().Return("", nil)
+			},
+			wantedStack:    "mystack",
+			wantedParams:   "myparams",
+			wantedDiffFile: "mock diff",
 		},
 		"writes service template without addons": {
 			inVars: packageSvcVars{
@@ -347,9 +458,11 @@ count: 1`
 				mockVersionGetter:    mocks.NewMockversionGetter(ctrl),
 			}
 			tc.setupMocks(m)
+			fs := afero.NewMemMapFs()
 			opts := &packageSvcOpts{
 				packageSvcVars: tc.inVars,
 
+				fs:               fs,
 				templateWriter:   mockWriteCloser{w: stackBuf},
 				paramsWriter:     mockWriteCloser{w: paramsBuf},
 				addonsWriter:     mockWriteCloser{w: addonsBuf},
@@ -381,10 +494,20 @@ count: 1`
 			} else {
 				require.NoError(t, err)
 			}
+			if tc.wantedExitCode != 0 {
+				var exitCodeErr interface{ ExitCode() int }
+				require.ErrorAs(t, err, &exitCodeErr)
+				require.Equal(t, tc.wantedExitCode, exitCodeErr.ExitCode())
+			}
 			require.Equal(t, stackBuf.String(), tc.wantedStack)
 			require.Equal(t, paramsBuf.String(), tc.wantedParams)
 			require.Equal(t, addonsBuf.String(), tc.wantedAddons)
 			require.Equal(t, diffBuff.String(), tc.wantedDiff)
+			if tc.wantedDiffFile != "" {
+				content, err := afero.ReadFile(fs, tc.inVars.diffFile)
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDiffFile, string(content))
+			}
 		})
 	}
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -36,6 +37,16 @@ const (
 	fmtSvcPauseConfirmPrompt = "Are you sure you want to stop processing requests for service %s?"
 )
 
+// ecsServiceTypes are the types of services that run on Amazon ECS and are paused by scaling them to zero tasks.
+var ecsServiceTypes = []string{
+	manifestinfo.LoadBalancedWebServiceType,
+	manifestinfo.BackendServiceType,
+	manifestinfo.WorkerServiceType,
+}
+
+// pausableServiceTypes are the types of services that can be paused.
+var pausableServiceTypes = append([]string{manifestinfo.RequestDrivenWebServiceType}, ecsServiceTypes...)
+
 type svcPauseVars struct {
 	svcName          string
 	envName          string
@@ -49,6 +60,7 @@ type svcPauseOpts struct {
 	prompt       prompter
 	sel          deploySelector
 	client       servicePauser
+	ecsPauser    ecsServicePauser
 	initSvcPause func() error
 	svcARN       string
 	prog         progress
@@ -86,13 +98,17 @@ func newSvcPauseOpts(vars svcPauseVars) (*svcPauseOpts, error) {
 		if err != nil {
 			return fmt.Errorf("get workload: %w", err)
 		}
-		if wl.Type != manifestinfo.RequestDrivenWebServiceType {
-			return fmt.Errorf("pausing a service is only supported for services with type: %s", manifestinfo.RequestDrivenWebServiceType)
+		if !contains(wl.Type, pausableServiceTypes) {
+			return fmt.Errorf("pausing a service is not supported for services with type: %s", wl.Type)
 		}
 		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
 		if err != nil {
 			return err
 		}
+		if contains(wl.Type, ecsServiceTypes) {
+			opts.ecsPauser = ecs.New(sess)
+			return nil
+		}
 		opts.client = apprunner.New(sess)
 		d, err := describe.NewRDWebServiceDescriber(describe.NewServiceConfig{
 			App:         opts.appName,
@@ -173,7 +189,7 @@ func (o *svcPauseOpts) validateAndAskSvcEnvName() error {
 		o.appName,
 		selector.WithEnv(o.envName),
 		selector.WithName(o.svcName),
-		selector.WithServiceTypesFilter([]string{manifestinfo.RequestDrivenWebServiceType}),
+		selector.WithServiceTypesFilter(pausableServiceTypes),
 	)
 	if err != nil {
 		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
@@ -183,7 +199,7 @@ func (o *svcPauseOpts) validateAndAskSvcEnvName() error {
 	return nil
 }
 
-// Execute pause the running App Runner service.
+// Execute pauses the running App Runner service, or scales the ECS service down to zero tasks.
 func (o *svcPauseOpts) Execute() error {
 	if err := o.initSvcPause(); err != nil {
 		return err
@@ -192,7 +208,12 @@ func (o *svcPauseOpts) Execute() error {
 	log.Warningln("Your service will be unavailable while paused. You can resume the service once the pause operation is complete.")
 	o.prog.Start(fmt.Sprintf(fmtSvcPauseStart, o.svcName, o.envName))
 
-	err := o.client.PauseService(o.svcARN)
+	var err error
+	if o.ecsPauser != nil {
+		err = o.ecsPauser.PauseService(o.appName, o.envName, o.svcName)
+	} else {
+		err = o.client.PauseService(o.svcARN)
+	}
 	if err != nil {
 		o.prog.Stop(log.Serrorf(fmtsvcPauseFailed, o.svcName, o.envName))
 		return err
@@ -226,11 +247,13 @@ func buildSvcPauseCmd() *cobra.Command {
 	vars := svcPauseVars{}
 	cmd := &cobra.Command{
 		Use:   "pause",
-		Short: "Pause running App Runner service.",
-		Long:  "Pause running App Runner service.",
+		Short: "Pause a running service.",
+		Long: `Pause a running service.
+App Runner services are paused, while services running on Amazon ECS are scaled down to zero tasks
+until they are resumed with "copilot svc resume" or deployed again.`,
 
 		Example: `
-  Pause running App Runner service "my-svc".
+  Pause running service "my-svc".
   /code $ copilot svc pause -n my-svc`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcPauseOpts(vars)
@@ -286,3 +286,59 @@ func TestSvcPause_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestSvcPause_Execute_ECS(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		mocking     func(mockPauser *mocks.MockecsServicePauser, mockProgress *mocks.Mockprogress)
+		wantedError error
+	}{
+		"errors if failed to scale the service down": {
+			mocking: func(mockPauser *mocks.MockecsServicePauser, mockProgress *mocks.Mockprogress) {
+				mockProgress.EXPECT().Start("Pausing service mock-svc in environment mock-env.")
+				mockPauser.EXPECT().PauseService("mock-app", "mock-env", "mock-svc").Return(mockError)
+				mockProgress.EXPECT().Stop(log.Serrorf("Failed to pause service mock-svc in environment mock-env.\n"))
+			},
+			wantedError: fmt.Errorf("some error"),
+		},
+		"success": {
+			mocking: func(mockPauser *mocks.MockecsServicePauser, mockProgress *mocks.Mockprogress) {
+				mockProgress.EXPECT().Start("Pausing service mock-svc in environment mock-env.")
+				mockPauser.EXPECT().PauseService("mock-app", "mock-env", "mock-svc").Return(nil)
+				mockProgress.EXPECT().Stop(log.Ssuccessf("Paused service mock-svc in environment mock-env.\n"))
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPauser := mocks.NewMockecsServicePauser(ctrl)
+			mockProgress := mocks.NewMockprogress(ctrl)
+
+			tc.mocking(mockPauser, mockProgress)
+
+			svcPause := &svcPauseOpts{
+				svcPauseVars: svcPauseVars{
+					svcName: "mock-svc",
+					envName: "mock-env",
+					appName: "mock-app",
+				},
+				ecsPauser:    mockPauser,
+				prog:         mockProgress,
+				initSvcPause: func() error { return nil },
+			}
+
+			// WHEN
+			err := svcPause.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
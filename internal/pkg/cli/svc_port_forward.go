@@ -0,0 +1,175 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/spf13/cobra"
+)
+
+type svcPortForwardVars struct {
+	execVars
+	port       string
+	remoteHost string
+}
+
+type svcPortForwardOpts struct {
+	*svcExecOpts
+	port       string
+	remoteHost string
+
+	newPortForwarder func(*session.Session) portForwarder
+
+	// Cached variables.
+	localPort  string
+	remotePort string
+}
+
+func newSvcPortForwardOpts(vars svcPortForwardVars) (*svcPortForwardOpts, error) {
+	execOpts, err := newSvcExecOpts(vars.execVars)
+	if err != nil {
+		return nil, err
+	}
+	return &svcPortForwardOpts{
+		svcExecOpts: execOpts,
+		port:        vars.port,
+		remoteHost:  vars.remoteHost,
+		newPortForwarder: func(s *session.Session) portForwarder {
+			return ssm.New(s)
+		},
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcPortForwardOpts) Validate() error {
+	local, remote, err := parsePortMapping(o.port)
+	if err != nil {
+		return err
+	}
+	o.localPort, o.remotePort = local, remote
+	return o.svcExecOpts.Validate()
+}
+
+// Execute forwards a local port to a port on a running task of the service, or to a remote host reachable from it.
+func (o *svcPortForwardOpts) Execute() error {
+	wkld, err := o.store.GetWorkload(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get workload: %w", err)
+	}
+	if wkld.Type == manifestinfo.RequestDrivenWebServiceType || wkld.Type == manifestinfo.LambdaServiceType {
+		return fmt.Errorf("port forwarding is not supported for services with type: '%s'", wkld.Type)
+	}
+	sess, err := o.envSession()
+	if err != nil {
+		return err
+	}
+	svcDesc, err := o.newSvcDescriber(sess).DescribeService(o.appName, o.envName, o.name)
+	if err != nil {
+		return fmt.Errorf("describe ECS service for %s in environment %s: %w", o.name, o.envName, err)
+	}
+	task, err := o.selectTask(awsecs.FilterRunningTasks(svcDesc.Tasks))
+	if err != nil {
+		return err
+	}
+	container := o.selectContainer()
+	target, err := task.SessionTarget(container)
+	if err != nil {
+		return fmt.Errorf("get session target: %w", err)
+	}
+	taskID, err := awsecs.TaskID(aws.StringValue(task.TaskArn))
+	if err != nil {
+		return err
+	}
+	if o.remoteHost != "" {
+		log.Infof("Forward %s to %s through container %s in task %s. Press %s to stop.\n",
+			color.HighlightUserInput("localhost:"+o.localPort), color.HighlightUserInput(o.remoteHost+":"+o.remotePort),
+			color.HighlightUserInput(container), color.HighlightResource(taskID), color.HighlightCode("Ctrl+C"))
+	} else {
+		log.Infof("Forward %s to port %s of container %s in task %s. Press %s to stop.\n",
+			color.HighlightUserInput("localhost:"+o.localPort), color.HighlightUserInput(o.remotePort),
+			color.HighlightUserInput(container), color.HighlightResource(taskID), color.HighlightCode("Ctrl+C"))
+	}
+	if err := o.newPortForwarder(sess).StartPortForwardingSession(ssm.PortForwardInput{
+		Target:     target,
+		LocalPort:  o.localPort,
+		RemotePort: o.remotePort,
+		RemoteHost: o.remoteHost,
+	}); err != nil {
+		log.Errorf("Failed to forward port %s. Is %s set in your manifest?\n", o.port, color.HighlightCode("exec: true"))
+		return fmt.Errorf("forward port %s through container %s: %w", o.port, container, err)
+	}
+	return nil
+}
+
+// parsePortMapping parses a port mapping in the format "<local>:<remote>" or "<port>".
+func parsePortMapping(mapping string) (local, remote string, err error) {
+	if mapping == "" {
+		return "", "", fmt.Errorf("port mapping is required, for example %s", color.HighlightCode("--port 5432:5432"))
+	}
+	parts := strings.Split(mapping, ":")
+	if len(parts) > 2 {
+		return "", "", fmt.Errorf(`port mapping %s must be in the format "<local port>:<remote port>" or "<port>"`, mapping)
+	}
+	for _, part := range parts {
+		if port, err := strconv.ParseUint(part, 10, 16); err != nil || port == 0 {
+			return "", "", fmt.Errorf("port %q in mapping %s must be a number between 1 and 65535", part, mapping)
+		}
+	}
+	if len(parts) == 1 {
+		return parts[0], parts[0], nil
+	}
+	return parts[0], parts[1], nil
+}
+
+// buildSvcPortForwardCmd builds the command for forwarding a local port through a running container in a service.
+func buildSvcPortForwardCmd() *cobra.Command {
+	vars := svcPortForwardVars{}
+	var skipPrompt bool
+	cmd := &cobra.Command{
+		Use:   "port-forward",
+		Short: "Forward a local port through a running container part of a service.",
+		Long: `Forward a local port through a running container part of a service.
+Traffic is tunneled through a Session Manager session, so no bastion host is needed.`,
+		Example: `
+  Forward local port 8080 to port 80 of a task in the "frontend" service.
+  /code $ copilot svc port-forward -a my-app -e test -n frontend --port 8080:80
+  Reach a database from your machine through a task in the "api" service.
+  /code $ copilot svc port-forward -n api -e test --port 5432:5432 --host mydb.cluster-abc.us-west-2.rds.amazonaws.com`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcPortForwardOpts(vars)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(yesFlag) {
+				opts.skipConfirmation = aws.Bool(false)
+				if skipPrompt {
+					opts.skipConfirmation = aws.Bool(true)
+				}
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", nameFlagDescription)
+	cmd.Flags().StringVar(&vars.port, svcPortFlag, "", portForwardPortFlagDescription)
+	cmd.Flags().StringVar(&vars.remoteHost, remoteHostFlag, "", portForwardHostFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", containerFlagDescription)
+	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
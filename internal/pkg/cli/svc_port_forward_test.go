@@ -0,0 +1,202 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSvcPortForward_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inPort string
+
+		wantedLocalPort  string
+		wantedRemotePort string
+		wantedError      error
+	}{
+		"error if port is missing": {
+			wantedError: errors.New("port mapping is required, for example `--port 5432:5432`"),
+		},
+		"error if mapping has too many parts": {
+			inPort:      "1:2:3",
+			wantedError: errors.New(`port mapping 1:2:3 must be in the format "<local port>:<remote port>" or "<port>"`),
+		},
+		"error if port is not a number": {
+			inPort:      "8080:http",
+			wantedError: errors.New(`port "http" in mapping 8080:http must be a number between 1 and 65535`),
+		},
+		"error if port is out of range": {
+			inPort:      "70000:80",
+			wantedError: errors.New(`port "70000" in mapping 70000:80 must be a number between 1 and 65535`),
+		},
+		"single port is used on both ends": {
+			inPort:           "5432",
+			wantedLocalPort:  "5432",
+			wantedRemotePort: "5432",
+		},
+		"local and remote ports": {
+			inPort:           "8080:80",
+			wantedLocalPort:  "8080",
+			wantedRemotePort: "80",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcPortForwardOpts{
+				svcExecOpts: &svcExecOpts{
+					execVars: execVars{
+						skipConfirmation: aws.Bool(false),
+					},
+				},
+				port: tc.inPort,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedLocalPort, opts.localPort)
+				require.Equal(t, tc.wantedRemotePort, opts.remotePort)
+			}
+		})
+	}
+}
+
+func TestSvcPortForward_Execute(t *testing.T) {
+	const (
+		mockClusterARN = "arn:aws:ecs:us-west-2:123456789:cluster/mockCluster"
+		mockTaskARN    = "arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockTaskID"
+	)
+	mockWl := config.Workload{
+		App:  "mockApp",
+		Name: "mockSvc",
+		Type: "Backend Service",
+	}
+	mockRDWSWl := config.Workload{
+		App:  "mockApp",
+		Name: "mockSvc",
+		Type: "Request-Driven Web Service",
+	}
+	mockTask := &awsecs.Task{
+		ClusterArn: aws.String(mockClusterARN),
+		TaskArn:    aws.String(mockTaskARN),
+		LastStatus: aws.String("RUNNING"),
+		Containers: []*sdkecs.Container{
+			{Name: aws.String("mockSvc"), RuntimeId: aws.String("mockTaskID-1234")},
+		},
+	}
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		containerName string
+		remoteHost    string
+		setupMocks    func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, forwarder *mocks.MockportForwarder)
+
+		wantedError error
+	}{
+		"return error if service type is Request-Driven Web Service": {
+			setupMocks: func(store *mocks.Mockstore, _ *mocks.MockserviceDescriber, _ *mocks.MockportForwarder) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockRDWSWl, nil)
+			},
+			wantedError: errors.New("port forwarding is not supported for services with type: 'Request-Driven Web Service'"),
+		},
+		"return error if the container is not in the task": {
+			containerName: "nginx",
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, _ *mocks.MockportForwarder) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+				store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{Name: "mockEnv"}, nil)
+				describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{mockTask},
+				}, nil)
+			},
+			wantedError: errors.New("get session target: container nginx not found in task mockTaskID"),
+		},
+		"return error if fail to forward the port": {
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, forwarder *mocks.MockportForwarder) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+				store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{Name: "mockEnv"}, nil)
+				describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{mockTask},
+				}, nil)
+				forwarder.EXPECT().StartPortForwardingSession(gomock.Any()).Return(mockError)
+			},
+			wantedError: fmt.Errorf("forward port 5432:5432 through container mockSvc: some error"),
+		},
+		"forward to a remote host through the task": {
+			remoteHost: "mydb.cluster-abc.us-west-2.rds.amazonaws.com",
+			setupMocks: func(store *mocks.Mockstore, describer *mocks.MockserviceDescriber, forwarder *mocks.MockportForwarder) {
+				store.EXPECT().GetWorkload("mockApp", "mockSvc").Return(&mockWl, nil)
+				store.EXPECT().GetEnvironment("mockApp", "mockEnv").Return(&config.Environment{Name: "mockEnv"}, nil)
+				describer.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{mockTask},
+				}, nil)
+				forwarder.EXPECT().StartPortForwardingSession(ssm.PortForwardInput{
+					Target:     "ecs:mockCluster_mockTaskID_mockTaskID-1234",
+					LocalPort:  "5432",
+					RemotePort: "5432",
+					RemoteHost: "mydb.cluster-abc.us-west-2.rds.amazonaws.com",
+				}).Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
+			mockForwarder := mocks.NewMockportForwarder(ctrl)
+			mockSessionProvider := mocks.NewMocksessionProvider(ctrl)
+			mockSessionProvider.EXPECT().FromRole(gomock.Any(), gomock.Any()).Return(&session.Session{}, nil).AnyTimes()
+			tc.setupMocks(mockStore, mockSvcDescriber, mockForwarder)
+
+			opts := &svcPortForwardOpts{
+				svcExecOpts: &svcExecOpts{
+					execVars: execVars{
+						name:          "mockSvc",
+						envName:       "mockEnv",
+						appName:       "mockApp",
+						containerName: tc.containerName,
+					},
+					store: mockStore,
+					newSvcDescriber: func(_ *session.Session) serviceDescriber {
+						return mockSvcDescriber
+					},
+					randInt:      func(i int) int { return 0 },
+					sessProvider: mockSessionProvider,
+				},
+				port:       "5432:5432",
+				remoteHost: tc.remoteHost,
+				localPort:  "5432",
+				remotePort: "5432",
+				newPortForwarder: func(_ *session.Session) portForwarder {
+					return mockForwarder
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
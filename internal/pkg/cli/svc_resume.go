@@ -16,6 +16,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -46,6 +47,7 @@ type resumeSvcOpts struct {
 	store              store
 	serviceResumer     serviceResumer
 	apprunnerDescriber apprunnerServiceDescriber
+	ecsResumer         ecsServiceResumer
 	spinner            progress
 	sel                deploySelector
 	initClients        resumeSvcInitClients
@@ -72,6 +74,15 @@ func (o *resumeSvcOpts) Execute() error {
 	if err := o.initClients(); err != nil {
 		return err
 	}
+	if o.ecsResumer != nil {
+		o.spinner.Start(fmt.Sprintf(fmtSvcResumeStarted, o.svcName, o.envName))
+		if err := o.ecsResumer.ResumeService(o.appName, o.envName, o.svcName); err != nil {
+			o.spinner.Stop(log.Serrorf(fmtSvcResumeFailed, o.svcName, o.envName, err))
+			return err
+		}
+		o.spinner.Stop(log.Ssuccessf(fmtSvcResumeSuccess, o.svcName, o.envName))
+		return nil
+	}
 	svcARN, err := o.apprunnerDescriber.ServiceARN(o.envName)
 	if err != nil {
 		return err
@@ -120,7 +131,7 @@ func (o *resumeSvcOpts) validateAndAskSvcEnvName() error {
 		o.appName,
 		selector.WithEnv(o.envName),
 		selector.WithName(o.svcName),
-		selector.WithServiceTypesFilter([]string{manifestinfo.RequestDrivenWebServiceType}),
+		selector.WithServiceTypesFilter(pausableServiceTypes),
 	)
 	if err != nil {
 		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
@@ -161,6 +172,13 @@ func newResumeSvcOpts(vars resumeSvcVars) (*resumeSvcOpts, error) {
 			return err
 		}
 		switch svc.Type {
+		case manifestinfo.LoadBalancedWebServiceType, manifestinfo.BackendServiceType, manifestinfo.WorkerServiceType:
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return err
+			}
+			opts.ecsResumer = ecs.New(sess)
+			return nil
 		case manifestinfo.RequestDrivenWebServiceType:
 			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
 			if err != nil {
@@ -195,7 +213,8 @@ func buildSvcResumeCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "resume",
 		Short: "Resumes a paused service.",
-		Long:  "Resumes a paused service.",
+		Long: `Resumes a paused service.
+Services running on Amazon ECS are scaled back to the number of tasks they ran before they were paused.`,
 		Example: `
   Resumes the service named "my-svc" in the "test" environment.
   /code $ copilot svc resume --name my-svc --env test`,
@@ -171,6 +171,7 @@ type resumeSvcMocks struct {
 	spinner            *mocks.Mockprogress
 	serviceResumer     *mocks.MockserviceResumer
 	apprunnerDescriber *mocks.MockapprunnerServiceDescriber
+	ecsResumer         *mocks.MockecsServiceResumer
 }
 
 func TestResumeSvcOpts_Execute(t *testing.T) {
@@ -186,6 +187,7 @@ func TestResumeSvcOpts_Execute(t *testing.T) {
 		appName string
 		envName string
 		svcName string
+		isECS   bool
 
 		setupMocks func(mocks *resumeSvcMocks)
 
@@ -228,6 +230,33 @@ func TestResumeSvcOpts_Execute(t *testing.T) {
 			},
 			wantedError: mockError,
 		},
+		"resume an ECS service": {
+			appName: testAppName,
+			envName: testEnvName,
+			svcName: testSvcName,
+			isECS:   true,
+			setupMocks: func(m *resumeSvcMocks) {
+				gomock.InOrder(
+					m.spinner.EXPECT().Start("Resuming service phonetool in environment test."),
+					m.ecsResumer.EXPECT().ResumeService(testAppName, testEnvName, testSvcName).Return(nil),
+					m.spinner.EXPECT().Stop(log.Ssuccessf("Resumed service phonetool in environment test.\n")),
+				)
+			},
+		},
+		"should display failure spinner and return error if the ECS service fails to resume": {
+			appName: testAppName,
+			envName: testEnvName,
+			svcName: testSvcName,
+			isECS:   true,
+			setupMocks: func(m *resumeSvcMocks) {
+				gomock.InOrder(
+					m.spinner.EXPECT().Start("Resuming service phonetool in environment test."),
+					m.ecsResumer.EXPECT().ResumeService(testAppName, testEnvName, testSvcName).Return(mockError),
+					m.spinner.EXPECT().Stop(log.Serrorf("Failed to resume service phonetool in environment test: mockError\n")),
+				)
+			},
+			wantedError: mockError,
+		},
 	}
 
 	for name, test := range tests {
@@ -240,12 +269,14 @@ func TestResumeSvcOpts_Execute(t *testing.T) {
 			mockSpinner := mocks.NewMockprogress(ctrl)
 			mockserviceResumer := mocks.NewMockserviceResumer(ctrl)
 			mockapprunnerDescriber := mocks.NewMockapprunnerServiceDescriber(ctrl)
+			mockECSResumer := mocks.NewMockecsServiceResumer(ctrl)
 
 			mocks := &resumeSvcMocks{
 				store:              mockstore,
 				spinner:            mockSpinner,
 				serviceResumer:     mockserviceResumer,
 				apprunnerDescriber: mockapprunnerDescriber,
+				ecsResumer:         mockECSResumer,
 			}
 
 			test.setupMocks(mocks)
@@ -264,6 +295,9 @@ func TestResumeSvcOpts_Execute(t *testing.T) {
 					return nil
 				},
 			}
+			if test.isECS {
+				opts.ecsResumer = mockECSResumer
+			}
 
 			// WHEN
 			err := opts.Execute()
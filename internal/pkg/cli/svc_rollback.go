@@ -0,0 +1,328 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/spf13/cobra"
+
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/revision"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+)
+
+const (
+	svcRollbackAppNamePrompt     = "Which application is the service in?"
+	svcRollbackNamePrompt        = "Which service of %s would you like to roll back?"
+	svcRollbackSvcNameHelpPrompt = "The selected service will be deployed again with one of its previous deployments."
+
+	fmtSvcRollbackConfirmPrompt             = "Are you sure you want to roll back service %s in environment %s to its previous deployment?"
+	fmtSvcRollbackToDeploymentConfirmPrompt = "Are you sure you want to roll back service %s in environment %s to deployment %s?"
+
+	fmtSvcRollbackStart   = "Rolling back service %s in environment %s to deployment %s.\n"
+	fmtSvcRollbackSucceed = "Rolled back service %s in environment %s to deployment %s.\n"
+)
+
+// rollbackSvcTypes are the types of services whose deployments are recorded by "svc deploy".
+var rollbackSvcTypes = []string{
+	manifestinfo.LoadBalancedWebServiceType,
+	manifestinfo.BackendServiceType,
+	manifestinfo.WorkerServiceType,
+	manifestinfo.RequestDrivenWebServiceType,
+}
+
+type svcRollbackVars struct {
+	appName          string
+	envName          string
+	svcName          string
+	deploymentID     string
+	skipConfirmation bool
+}
+
+type svcRollbackOpts struct {
+	svcRollbackVars
+
+	store  store
+	prompt prompter
+	sel    deploySelector
+	now    func() time.Time
+
+	// Initialized in Execute once the environment is known.
+	initRollbackClients func() error
+	deployer            svcStackDeployer
+	revisions           deploymentRevisionStore
+	bucket              string
+	callerARN           string
+
+	// Cached variables.
+	targetEnv *config.Environment
+}
+
+func newSvcRollbackOpts(vars svcRollbackVars) (*svcRollbackOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc rollback"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	opts := &svcRollbackOpts{
+		svcRollbackVars: vars,
+		store:           configStore,
+		prompt:          prompt.New(),
+		sel:             selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		now:             time.Now,
+	}
+	opts.initRollbackClients = func() error {
+		env, err := opts.getTargetEnv()
+		if err != nil {
+			return err
+		}
+		app, err := opts.store.GetApplication(opts.appName)
+		if err != nil {
+			return fmt.Errorf("get application %s: %w", opts.appName, err)
+		}
+		resources, err := cloudformation.New(defaultSess).GetAppResourcesByRegion(app, env.Region)
+		if err != nil {
+			return fmt.Errorf("get application %s resources from region %s: %w", app.Name, env.Region, err)
+		}
+		caller, err := identity.New(defaultSess).Get()
+		if err != nil {
+			return fmt.Errorf("get identity: %w", err)
+		}
+		envSess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		opts.deployer = cloudformation.New(envSess, cloudformation.WithProgressTracker(os.Stderr))
+		opts.revisions = revision.NewStore(s3.New(envSess), resources.S3Bucket)
+		opts.bucket = resources.S3Bucket
+		opts.callerARN = caller.ARN
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcRollbackOpts) Validate() error {
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcRollbackOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	if err := o.validateAndAskSvcEnvName(); err != nil {
+		return err
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+
+	msg := fmt.Sprintf(fmtSvcRollbackConfirmPrompt, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName))
+	if o.deploymentID != "" {
+		msg = fmt.Sprintf(fmtSvcRollbackToDeploymentConfirmPrompt, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName), color.HighlightUserInput(o.deploymentID))
+	}
+	confirmed, err := o.prompt.Confirm(msg, "", prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("svc rollback confirmation prompt: %w", err)
+	}
+	if !confirmed {
+		return errors.New("svc rollback cancelled - no changes made")
+	}
+	return nil
+}
+
+func (o *svcRollbackOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcRollbackAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcRollbackOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.getTargetEnv(); err != nil {
+			return err
+		}
+	}
+	if o.svcName != "" {
+		if _, err := o.store.GetService(o.appName, o.svcName); err != nil {
+			return err
+		}
+	}
+
+	// Note: we let prompter handle the case when there is only option for user to choose from.
+	// This is naturally the case when `o.envName != "" && o.svcName != ""`.
+	deployedService, err := o.sel.DeployedService(
+		fmt.Sprintf(svcRollbackNamePrompt, color.HighlightUserInput(o.appName)),
+		svcRollbackSvcNameHelpPrompt,
+		o.appName,
+		selector.WithEnv(o.envName),
+		selector.WithName(o.svcName),
+		selector.WithServiceTypesFilter(rollbackSvcTypes),
+	)
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// Execute deploys a previous revision of the service's stack.
+func (o *svcRollbackOpts) Execute() error {
+	if err := o.initRollbackClients(); err != nil {
+		return err
+	}
+	env, err := o.getTargetEnv()
+	if err != nil {
+		return err
+	}
+	stackName := stack.NameForWorkload(o.appName, o.envName, o.svcName)
+	id, err := o.targetDeploymentID(stackName)
+	if err != nil {
+		return err
+	}
+	rev, err := o.revisions.Get(stackName, id)
+	if err != nil {
+		return fmt.Errorf("get deployment %s of service %s: %w", id, o.svcName, err)
+	}
+
+	log.Infof(fmtSvcRollbackStart, o.svcName, o.envName, id)
+	rolledBackAt := o.now()
+	if err := o.deployer.DeployService(rev, o.bucket, awscloudformation.WithRoleARN(env.ExecutionRoleARN)); err != nil {
+		var errEmptyCS *awscloudformation.ErrChangeSetEmpty
+		if errors.As(err, &errEmptyCS) {
+			return fmt.Errorf("service %s in environment %s is already deployed with deployment %s", o.svcName, o.envName, id)
+		}
+		return fmt.Errorf("roll back service %s to deployment %s: %w", o.svcName, id, err)
+	}
+	log.Successf(fmtSvcRollbackSucceed, o.svcName, o.envName, id)
+
+	// Record the rollback as the latest deployment, so that rolling back again returns to the deployment we rolled back from.
+	latest, err := stack.NewRevision(rev, rolledBackAt, nil)
+	if err == nil {
+		latest.DeployedBy = o.callerARN
+		latest.GitCommit = rev.GitCommit
+		latest.ImageDigests = rev.ImageDigests
+		err = o.revisions.Record(latest)
+	}
+	if err != nil {
+		log.Warningf("Failed to record the rollback of service %s: %v\n", o.svcName, err)
+	}
+	return nil
+}
+
+// targetDeploymentID returns the ID of the deployment to roll back to.
+// If the ID isn't provided with a flag, it's the deployment that was recorded before the latest one.
+func (o *svcRollbackOpts) targetDeploymentID(stackName string) (string, error) {
+	if o.deploymentID != "" {
+		return o.deploymentID, nil
+	}
+	ids, err := o.revisions.List(stackName)
+	if err != nil {
+		return "", fmt.Errorf("list deployments of service %s: %w", o.svcName, err)
+	}
+	if len(ids) < 2 {
+		return "", &errNoPreviousDeployment{
+			svc: o.svcName,
+			env: o.envName,
+		}
+	}
+	return ids[len(ids)-2], nil
+}
+
+func (o *svcRollbackOpts) getTargetEnv() (*config.Environment, error) {
+	if o.targetEnv != nil {
+		return o.targetEnv, nil
+	}
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("get environment: %w", err)
+	}
+	o.targetEnv = env
+	return o.targetEnv, nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *svcRollbackOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to check the status of the service.", color.HighlightCode(fmt.Sprintf("copilot svc status -n %s -e %s", o.svcName, o.envName))),
+		fmt.Sprintf("Run %s to deploy the service from your workspace again.", color.HighlightCode(fmt.Sprintf("copilot svc deploy -n %s -e %s", o.svcName, o.envName))),
+	})
+	return nil
+}
+
+type errNoPreviousDeployment struct {
+	svc string
+	env string
+}
+
+func (e *errNoPreviousDeployment) Error() string {
+	return fmt.Sprintf("no previous deployment of service %s in environment %s is recorded", e.svc, e.env)
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+func (e *errNoPreviousDeployment) RecommendActions() string {
+	return fmt.Sprintf("Copilot records the deployments of %s, so the service can only be rolled back after it was deployed twice.", color.HighlightCode("copilot svc deploy"))
+}
+
+// buildSvcRollbackCmd builds the command for rolling back a service to a previous deployment.
+func buildSvcRollbackCmd() *cobra.Command {
+	vars := svcRollbackVars{}
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Roll back a service to a previous deployment.",
+		Long: `Roll back a service to a previous deployment.
+The CloudFormation template, parameters and container image digests recorded by "copilot svc deploy" are deployed again.`,
+
+		Example: `
+  Roll back service "frontend" in environment "test" to the deployment before the latest one.
+  /code $ copilot svc rollback -n frontend -e test
+  Roll back service "frontend" in environment "test" to a specific deployment.
+  /code $ copilot svc rollback -n frontend -e test --to 20230102-150405`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcRollbackOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.deploymentID, rollbackToFlag, "", rollbackToFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
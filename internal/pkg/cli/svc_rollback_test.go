@@ -0,0 +1,289 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+)
+
+type svcRollbackAskMocks struct {
+	store  *mocks.Mockstore
+	sel    *mocks.MockdeploySelector
+	prompt *mocks.Mockprompter
+}
+
+func TestSvcRollbackOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp            string
+		inEnv            string
+		inSvc            string
+		inDeploymentID   string
+		skipConfirmation bool
+
+		setupMocks func(m svcRollbackAskMocks)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedSvc   string
+		wantedError error
+	}{
+		"validate app, env and svc with all flags passed in": {
+			inApp:            "phonetool",
+			inEnv:            "test",
+			inSvc:            "frontend",
+			skipConfirmation: true,
+			setupMocks: func(m svcRollbackAskMocks) {
+				gomock.InOrder(
+					m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil),
+					m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil),
+					m.store.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{}, nil),
+				)
+				m.sel.EXPECT().DeployedService(fmt.Sprintf(svcRollbackNamePrompt, "phonetool"), svcRollbackSvcNameHelpPrompt, "phonetool", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "test",
+						Name: "frontend",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+			wantedSvc: "frontend",
+		},
+		"prompt for app, env and svc": {
+			skipConfirmation: true,
+			setupMocks: func(m svcRollbackAskMocks) {
+				m.sel.EXPECT().Application(svcRollbackAppNamePrompt, wkldAppNameHelpPrompt).Return("phonetool", nil)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), "phonetool", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "test",
+						Name: "frontend",
+					}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+			wantedSvc: "frontend",
+		},
+		"errors if failed to select deployed service": {
+			inApp:            "phonetool",
+			skipConfirmation: true,
+			setupMocks: func(m svcRollbackAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), "phonetool", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("select deployed services for application phonetool: some error"),
+		},
+		"errors if the rollback is not confirmed": {
+			inApp:          "phonetool",
+			inDeploymentID: "20230102-150405",
+			setupMocks: func(m svcRollbackAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), "phonetool", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "test",
+						Name: "frontend",
+					}, nil)
+				m.prompt.EXPECT().Confirm(fmt.Sprintf(fmtSvcRollbackToDeploymentConfirmPrompt, "frontend", "test", "20230102-150405"), "", gomock.Any()).
+					Return(false, nil)
+			},
+			wantedError: errors.New("svc rollback cancelled - no changes made"),
+		},
+		"should wrap error returned from prompter confirmation": {
+			inApp: "phonetool",
+			setupMocks: func(m svcRollbackAskMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), "phonetool", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env:  "test",
+						Name: "frontend",
+					}, nil)
+				m.prompt.EXPECT().Confirm(fmt.Sprintf(fmtSvcRollbackConfirmPrompt, "frontend", "test"), "", gomock.Any()).
+					Return(false, errors.New("some error"))
+			},
+			wantedError: errors.New("svc rollback confirmation prompt: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcRollbackAskMocks{
+				store:  mocks.NewMockstore(ctrl),
+				sel:    mocks.NewMockdeploySelector(ctrl),
+				prompt: mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcRollbackOpts{
+				svcRollbackVars: svcRollbackVars{
+					appName:          tc.inApp,
+					envName:          tc.inEnv,
+					svcName:          tc.inSvc,
+					deploymentID:     tc.inDeploymentID,
+					skipConfirmation: tc.skipConfirmation,
+				},
+				store:  m.store,
+				sel:    m.sel,
+				prompt: m.prompt,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedApp, opts.appName)
+			require.Equal(t, tc.wantedEnv, opts.envName)
+			require.Equal(t, tc.wantedSvc, opts.svcName)
+		})
+	}
+}
+
+type svcRollbackExecuteMocks struct {
+	deployer  *mocks.MocksvcStackDeployer
+	revisions *mocks.MockdeploymentRevisionStore
+}
+
+func TestSvcRollbackOpts_Execute(t *testing.T) {
+	const stackName = "phonetool-test-frontend"
+	rolledBackAt := time.Date(2023, time.January, 3, 0, 0, 0, 0, time.UTC)
+	rev := &stack.Revision{
+		ID:           "20230102-150405",
+		Name:         stackName,
+		TemplateBody: "Resources: {}\n",
+		ParameterValues: map[string]string{
+			stack.WorkloadContainerImageParamKey: "1234.dkr.ecr.us-west-2.amazonaws.com/frontend@sha256:main",
+		},
+		DeployedBy:   "arn:aws:iam::1234:user/alice",
+		GitCommit:    "a1b2c3d",
+		ImageDigests: map[string]string{"frontend": "sha256:main"},
+	}
+	testCases := map[string]struct {
+		inDeploymentID string
+		setupMocks     func(m svcRollbackExecuteMocks)
+
+		wantedError error
+	}{
+		"errors if the deployments cannot be listed": {
+			setupMocks: func(m svcRollbackExecuteMocks) {
+				m.revisions.EXPECT().List(stackName).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list deployments of service frontend: some error"),
+		},
+		"errors if there is no previous deployment": {
+			setupMocks: func(m svcRollbackExecuteMocks) {
+				m.revisions.EXPECT().List(stackName).Return([]string{"20230102-150405"}, nil)
+			},
+			wantedError: errors.New("no previous deployment of service frontend in environment test is recorded"),
+		},
+		"errors if the deployment cannot be retrieved": {
+			inDeploymentID: "20230101-000000",
+			setupMocks: func(m svcRollbackExecuteMocks) {
+				m.revisions.EXPECT().Get(stackName, "20230101-000000").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get deployment 20230101-000000 of service frontend: some error"),
+		},
+		"errors if the service already runs the deployment": {
+			inDeploymentID: "20230102-150405",
+			setupMocks: func(m svcRollbackExecuteMocks) {
+				m.revisions.EXPECT().Get(stackName, "20230102-150405").Return(rev, nil)
+				m.deployer.EXPECT().DeployService(rev, "mockBucket", gomock.Any()).Return(&cloudformation.ErrChangeSetEmpty{})
+			},
+			wantedError: errors.New("service frontend in environment test is already deployed with deployment 20230102-150405"),
+		},
+		"errors if the deployment fails": {
+			inDeploymentID: "20230102-150405",
+			setupMocks: func(m svcRollbackExecuteMocks) {
+				m.revisions.EXPECT().Get(stackName, "20230102-150405").Return(rev, nil)
+				m.deployer.EXPECT().DeployService(rev, "mockBucket", gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("roll back service frontend to deployment 20230102-150405: some error"),
+		},
+		"rolls back to the deployment before the latest and records the rollback": {
+			setupMocks: func(m svcRollbackExecuteMocks) {
+				m.revisions.EXPECT().List(stackName).Return([]string{"20230101-000000", "20230102-150405", "20230102-160000"}, nil)
+				m.revisions.EXPECT().Get(stackName, "20230102-150405").Return(rev, nil)
+				m.deployer.EXPECT().DeployService(rev, "mockBucket", gomock.Any()).Return(nil)
+				m.revisions.EXPECT().Record(&stack.Revision{
+					ID:              "20230103-000000",
+					DeployedAt:      rolledBackAt,
+					Name:            stackName,
+					TemplateBody:    rev.TemplateBody,
+					ParameterValues: rev.ParameterValues,
+					DeployedBy:      "arn:aws:iam::1234:user/bob",
+					GitCommit:       "a1b2c3d",
+					ImageDigests:    rev.ImageDigests,
+					TemplateHash:    "49cbd1e9008e8b67dec76613bb4d6468c822c99701820526928d43b382f35fba",
+				}).Return(nil)
+			},
+		},
+		"succeeds even if the rollback cannot be recorded": {
+			inDeploymentID: "20230102-150405",
+			setupMocks: func(m svcRollbackExecuteMocks) {
+				m.revisions.EXPECT().Get(stackName, "20230102-150405").Return(rev, nil)
+				m.deployer.EXPECT().DeployService(rev, "mockBucket", gomock.Any()).Return(nil)
+				m.revisions.EXPECT().Record(gomock.Any()).Return(errors.New("some error"))
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcRollbackExecuteMocks{
+				deployer:  mocks.NewMocksvcStackDeployer(ctrl),
+				revisions: mocks.NewMockdeploymentRevisionStore(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcRollbackOpts{
+				svcRollbackVars: svcRollbackVars{
+					appName:      "phonetool",
+					envName:      "test",
+					svcName:      "frontend",
+					deploymentID: tc.inDeploymentID,
+				},
+				now: func() time.Time {
+					return rolledBackAt
+				},
+				initRollbackClients: func() error { return nil },
+				deployer:            m.deployer,
+				revisions:           m.revisions,
+				bucket:              "mockBucket",
+				callerARN:           "arn:aws:iam::1234:user/bob",
+				targetEnv: &config.Environment{
+					Name:             "test",
+					ExecutionRoleARN: "mockExecutionRoleARN",
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,364 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+)
+
+const (
+	svcScaleAppNamePrompt     = "Which application is the service in?"
+	svcScaleNamePrompt        = "Which service of %s would you like to scale?"
+	svcScaleSvcNameHelpPrompt = "The selected service will run the number of tasks that you choose."
+	fmtSvcScaleCountPrompt    = "How many tasks should service %s run in environment %s?"
+
+	fmtSvcScaleConfirmPrompt = "Are you sure you want to scale service %s in environment %s to %d tasks?"
+	fmtSvcScaleStart         = "Scaling service %s in environment %s to %d tasks."
+	fmtSvcScaleFailed        = "Failed to scale service %s in environment %s.\n"
+	fmtSvcScaleSucceed       = "Scaled service %s in environment %s to %d tasks.\n"
+)
+
+type svcScaleVars struct {
+	appName          string
+	envName          string
+	svcName          string
+	count            *int
+	save             bool
+	skipConfirmation bool
+}
+
+type svcScaleOpts struct {
+	svcScaleVars
+
+	store     store
+	prompt    prompter
+	sel       deploySelector
+	mftEditor workloadManifestEditor
+	prog      progress
+
+	// Initialized in Execute once the environment is known.
+	initScaler func() error
+	scaler     ecsServiceScaler
+
+	autoscales bool // Whether autoscaling manages the number of tasks of the service.
+}
+
+func newSvcScaleOpts(vars svcScaleVars) (*svcScaleOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc scale"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	opts := &svcScaleOpts{
+		svcScaleVars: vars,
+		store:        configStore,
+		prompt:       prompt.New(),
+		sel:          selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		prog:         termprogress.NewSpinner(log.DiagnosticWriter),
+	}
+	if vars.save {
+		ws, err := workspace.Use(afero.NewOsFs())
+		if err != nil {
+			return nil, err
+		}
+		opts.mftEditor = ws
+	}
+	opts.initScaler = func() error {
+		env, err := opts.store.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment: %w", err)
+		}
+		sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		opts.scaler = ecs.New(sess)
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcScaleOpts) Validate() error {
+	if o.count != nil && *o.count < 0 {
+		return fmt.Errorf("--%s must be greater than or equal to 0", countFlag)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcScaleOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	if err := o.validateAndAskSvcEnvName(); err != nil {
+		return err
+	}
+	if err := o.askCount(); err != nil {
+		return err
+	}
+	if o.skipConfirmation {
+		return nil
+	}
+	confirmed, err := o.prompt.Confirm(fmt.Sprintf(fmtSvcScaleConfirmPrompt, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName), *o.count), "", prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("svc scale confirmation prompt: %w", err)
+	}
+	if !confirmed {
+		return errors.New("svc scale cancelled - no changes made")
+	}
+	return nil
+}
+
+func (o *svcScaleOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcScaleAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcScaleOpts) validateAndAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("get environment: %w", err)
+		}
+	}
+	if o.svcName != "" {
+		svc, err := o.store.GetService(o.appName, o.svcName)
+		if err != nil {
+			return err
+		}
+		if !contains(svc.Type, ecsServiceTypes) {
+			return fmt.Errorf("scaling a service is not supported for services with type: %s", svc.Type)
+		}
+	}
+
+	// Note: we let prompter handle the case when there is only option for user to choose from.
+	// This is naturally the case when `o.envName != "" && o.svcName != ""`.
+	deployedService, err := o.sel.DeployedService(
+		fmt.Sprintf(svcScaleNamePrompt, color.HighlightUserInput(o.appName)),
+		svcScaleSvcNameHelpPrompt,
+		o.appName,
+		selector.WithEnv(o.envName),
+		selector.WithName(o.svcName),
+		selector.WithServiceTypesFilter(ecsServiceTypes),
+	)
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.svcName = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+func (o *svcScaleOpts) askCount() error {
+	if o.count != nil {
+		return nil
+	}
+	raw, err := o.prompt.Get(fmt.Sprintf(fmtSvcScaleCountPrompt, color.HighlightUserInput(o.svcName), color.HighlightUserInput(o.envName)), "",
+		validateTaskCount, prompt.WithFinalMessage("Number of tasks:"))
+	if err != nil {
+		return fmt.Errorf("get number of tasks: %w", err)
+	}
+	count, err := strconv.Atoi(raw)
+	if err != nil {
+		return fmt.Errorf("parse number of tasks %q: %w", raw, err)
+	}
+	o.count = aws.Int(count)
+	return nil
+}
+
+// Execute sets the desired count of the ECS service, after checking that autoscaling won't override it,
+// and writes the count to the manifest with --save.
+func (o *svcScaleOpts) Execute() error {
+	if err := o.initScaler(); err != nil {
+		return err
+	}
+	count := *o.count
+	capacity, err := o.scaler.AutoscalingCapacity(o.appName, o.envName, o.svcName)
+	if err != nil {
+		return fmt.Errorf("get autoscaling capacity of service %s: %w", o.svcName, err)
+	}
+	if capacity != nil {
+		if int64(count) < capacity.Min || int64(count) > capacity.Max {
+			return fmt.Errorf(`service %s autoscales between %d and %d tasks in environment %s: choose a count in the range, or change "count.range" in the manifest and deploy the service`,
+				o.svcName, capacity.Min, capacity.Max, o.envName)
+		}
+		if o.save {
+			return fmt.Errorf(`cannot save a count for service %s: autoscaling manages its number of tasks in environment %s`, o.svcName, o.envName)
+		}
+		o.autoscales = true
+		log.Warningf("Autoscaling manages the number of tasks of service %s, so it may change it again.\n", o.svcName)
+	}
+
+	o.prog.Start(fmt.Sprintf(fmtSvcScaleStart, o.svcName, o.envName, count))
+	if err := o.scaler.ScaleService(o.appName, o.envName, o.svcName, int64(count)); err != nil {
+		o.prog.Stop(log.Serrorf(fmtSvcScaleFailed, o.svcName, o.envName))
+		var errPaused *ecs.ErrServicePaused
+		if errors.As(err, &errPaused) {
+			return fmt.Errorf("%w: resume it with %s before scaling it", err, color.HighlightCode(fmt.Sprintf("copilot svc resume -n %s -e %s", o.svcName, o.envName)))
+		}
+		return fmt.Errorf("scale service %s: %w", o.svcName, err)
+	}
+	o.prog.Stop(log.Ssuccessf(fmtSvcScaleSucceed, o.svcName, o.envName, count))
+
+	if !o.save {
+		return nil
+	}
+	path, err := o.saveCount(count)
+	if err != nil {
+		return fmt.Errorf("write count to the manifest of service %s: %w", o.svcName, err)
+	}
+	log.Successf("Wrote count %d for environment %s to %s.\n", count, o.envName, color.HighlightResource(path))
+	return nil
+}
+
+// saveCount writes the count to the environment overrides of the manifest of the service.
+// The count is written to the override file of the environment if there is one, since it takes precedence over the manifest.
+func (o *svcScaleOpts) saveCount(count int) (string, error) {
+	path, err := o.mftEditor.EditWorkloadEnvOverrides(o.svcName, o.envName, func(raw []byte) ([]byte, error) {
+		return setOverridesCount(raw, o.envName, count)
+	})
+	var errNotExist *workspace.ErrFileNotExists
+	if !errors.As(err, &errNotExist) {
+		return path, err
+	}
+	return o.mftEditor.EditWorkloadManifest(o.svcName, func(raw []byte) ([]byte, error) {
+		fields, unwritable, err := driftedManifestFields(raw, o.envName, []clideploy.DriftedParameter{
+			{Key: stack.WorkloadTaskCountParamKey, Deployed: strconv.Itoa(count)},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(unwritable) != 0 {
+			return nil, fmt.Errorf(`"count" of environment %s is not a number of tasks`, o.envName)
+		}
+		return setEnvironmentOverrides(raw, o.envName, fields)
+	})
+}
+
+// setOverridesCount sets the "count" field of an environment override file while preserving its other fields and comments.
+func setOverridesCount(raw []byte, env string, count int) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, errors.New("overrides are not a map")
+	}
+	value := &yaml.Node{Kind: yaml.ScalarNode, Value: strconv.Itoa(count)}
+	switch node := mappingValue(root, "count"); {
+	case node == nil:
+		root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: "count"}, value)
+	case node.Kind != yaml.ScalarNode:
+		return nil, fmt.Errorf(`"count" of environment %s is not a number of tasks`, env)
+	default:
+		*node = *value
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *svcScaleOpts) RecommendActions() error {
+	if o.save || o.autoscales {
+		return nil
+	}
+	logRecommendedActions([]string{
+		fmt.Sprintf("The next deployment of service %s sets its number of tasks back to the %s of the manifest.", o.svcName, color.HighlightCode("count")),
+		fmt.Sprintf("Run %s to keep the count in the next deployments.",
+			color.HighlightCode(fmt.Sprintf("copilot svc scale -n %s -e %s --count %d --save", o.svcName, o.envName, *o.count))),
+	})
+	return nil
+}
+
+func validateTaskCount(val interface{}) error {
+	count, err := strconv.Atoi(val.(string))
+	if err != nil || count < 0 {
+		return errors.New("number of tasks must be an integer greater than or equal to 0")
+	}
+	return nil
+}
+
+// buildSvcScaleCmd builds the command for changing the number of tasks of a service.
+func buildSvcScaleCmd() *cobra.Command {
+	vars := svcScaleVars{}
+	var count int
+	cmd := &cobra.Command{
+		Use:   "scale",
+		Short: "Change the number of tasks of a deployed service.",
+		Long: `Change the number of tasks of a deployed service without deploying it.
+Supported for Load Balanced Web Services, Backend Services and Worker Services.`,
+
+		Example: `
+  Scale service "api" to 8 tasks in the "prod" environment.
+  /code $ copilot svc scale -n api --env prod --count 8
+  Scale service "api" and keep the count in the next deployments.
+  /code $ copilot svc scale -n api --env prod --count 8 --save`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed(countFlag) {
+				vars.count = aws.Int(count)
+			}
+			opts, err := newSvcScaleOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().IntVar(&count, countFlag, 0, scaleCountFlagDescription)
+	cmd.Flags().BoolVar(&vars.save, saveFlag, false, saveCountFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,265 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+)
+
+func TestSvcScaleOpts_Validate(t *testing.T) {
+	require.NoError(t, (&svcScaleOpts{svcScaleVars: svcScaleVars{count: aws.Int(0)}}).Validate())
+	require.EqualError(t, (&svcScaleOpts{svcScaleVars: svcScaleVars{count: aws.Int(-1)}}).Validate(),
+		"--count must be greater than or equal to 0")
+}
+
+func TestSvcScaleOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inVars     svcScaleVars
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockdeploySelector, p *mocks.Mockprompter)
+
+		wantedCount int
+		wantedError string
+	}{
+		"error if the service doesn't run on ECS": {
+			inVars: svcScaleVars{appName: "phonetool", svcName: "frontend"},
+			setupMocks: func(store *mocks.Mockstore, _ *mocks.MockdeploySelector, _ *mocks.Mockprompter) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				store.EXPECT().GetService("phonetool", "frontend").Return(&config.Workload{Type: manifestinfo.RequestDrivenWebServiceType}, nil)
+			},
+			wantedError: "scaling a service is not supported for services with type: Request-Driven Web Service",
+		},
+		"prompt for the count and the confirmation": {
+			inVars: svcScaleVars{appName: "phonetool", envName: "prod", svcName: "api"},
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector, p *mocks.Mockprompter) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{}, nil)
+				store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{Type: manifestinfo.BackendServiceType}, nil)
+				sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), "phonetool", gomock.Any()).
+					Return(&selector.DeployedService{Name: "api", Env: "prod"}, nil)
+				p.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("8", nil)
+				p.EXPECT().Confirm("Are you sure you want to scale service api in environment prod to 8 tasks?", gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+			wantedCount: 8,
+		},
+		"error if the confirmation is declined": {
+			inVars: svcScaleVars{appName: "phonetool", envName: "prod", svcName: "api", count: aws.Int(0)},
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockdeploySelector, p *mocks.Mockprompter) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{}, nil)
+				store.EXPECT().GetService("phonetool", "api").Return(&config.Workload{Type: manifestinfo.BackendServiceType}, nil)
+				sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), "phonetool", gomock.Any()).
+					Return(&selector.DeployedService{Name: "api", Env: "prod"}, nil)
+				p.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			wantedError: "svc scale cancelled - no changes made",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store, sel, p := mocks.NewMockstore(ctrl), mocks.NewMockdeploySelector(ctrl), mocks.NewMockprompter(ctrl)
+			tc.setupMocks(store, sel, p)
+			opts := &svcScaleOpts{
+				svcScaleVars: tc.inVars,
+				store:        store,
+				sel:          sel,
+				prompt:       p,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedCount, aws.IntValue(opts.count))
+		})
+	}
+}
+
+func TestSvcScaleOpts_Execute(t *testing.T) {
+	const mft = `name: api
+type: Backend Service
+count: 1
+`
+	testCases := map[string]struct {
+		inCount     int
+		inSave      bool
+		inOverrides string
+		setupMocks  func(scaler *mocks.MockecsServiceScaler, prog *mocks.Mockprogress)
+
+		wantedManifest  string
+		wantedOverrides string
+		wantedError     string
+	}{
+		"error if the count is outside of the autoscaling range": {
+			inCount: 20,
+			setupMocks: func(scaler *mocks.MockecsServiceScaler, _ *mocks.Mockprogress) {
+				scaler.EXPECT().AutoscalingCapacity("phonetool", "prod", "api").Return(&aas.Capacity{Min: 2, Max: 10}, nil)
+			},
+			wantedError: `service api autoscales between 2 and 10 tasks in environment prod: choose a count in the range, or change "count.range" in the manifest and deploy the service`,
+		},
+		"error if saving the count of an autoscaling service": {
+			inCount: 4,
+			inSave:  true,
+			setupMocks: func(scaler *mocks.MockecsServiceScaler, _ *mocks.Mockprogress) {
+				scaler.EXPECT().AutoscalingCapacity("phonetool", "prod", "api").Return(&aas.Capacity{Min: 2, Max: 10}, nil)
+			},
+			wantedError: "cannot save a count for service api: autoscaling manages its number of tasks in environment prod",
+		},
+		"scale an autoscaling service within its range": {
+			inCount: 4,
+			setupMocks: func(scaler *mocks.MockecsServiceScaler, prog *mocks.Mockprogress) {
+				scaler.EXPECT().AutoscalingCapacity("phonetool", "prod", "api").Return(&aas.Capacity{Min: 2, Max: 10}, nil)
+				prog.EXPECT().Start("Scaling service api in environment prod to 4 tasks.")
+				scaler.EXPECT().ScaleService("phonetool", "prod", "api", int64(4)).Return(nil)
+				prog.EXPECT().Stop(log.Ssuccessf("Scaled service api in environment prod to 4 tasks.\n"))
+			},
+		},
+		"recommend resuming a paused service": {
+			inCount: 4,
+			setupMocks: func(scaler *mocks.MockecsServiceScaler, prog *mocks.Mockprogress) {
+				scaler.EXPECT().AutoscalingCapacity("phonetool", "prod", "api").Return(nil, nil)
+				prog.EXPECT().Start(gomock.Any())
+				scaler.EXPECT().ScaleService("phonetool", "prod", "api", int64(4)).Return(&ecs.ErrServicePaused{})
+				prog.EXPECT().Stop(log.Serrorf("Failed to scale service api in environment prod.\n"))
+			},
+			wantedError: "service  is paused in environment : resume it with `copilot svc resume -n api -e prod` before scaling it",
+		},
+		"wrap the error of the scaling": {
+			inCount: 4,
+			setupMocks: func(scaler *mocks.MockecsServiceScaler, prog *mocks.Mockprogress) {
+				scaler.EXPECT().AutoscalingCapacity("phonetool", "prod", "api").Return(nil, nil)
+				prog.EXPECT().Start(gomock.Any())
+				scaler.EXPECT().ScaleService("phonetool", "prod", "api", int64(4)).Return(errors.New("some error"))
+				prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: "scale service api: some error",
+		},
+		"write the count to the manifest with --save": {
+			inCount: 8,
+			inSave:  true,
+			setupMocks: func(scaler *mocks.MockecsServiceScaler, prog *mocks.Mockprogress) {
+				scaler.EXPECT().AutoscalingCapacity("phonetool", "prod", "api").Return(nil, nil)
+				prog.EXPECT().Start(gomock.Any())
+				scaler.EXPECT().ScaleService("phonetool", "prod", "api", int64(8)).Return(nil)
+				prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedManifest: `name: api
+type: Backend Service
+count: 1
+environments:
+  prod:
+    count: 8
+`,
+		},
+		"write the count to the override file of the environment with --save": {
+			inCount: 8,
+			inSave:  true,
+			inOverrides: `# Scale out in prod.
+count: 2
+cpu: 1024
+`,
+			setupMocks: func(scaler *mocks.MockecsServiceScaler, prog *mocks.Mockprogress) {
+				scaler.EXPECT().AutoscalingCapacity("phonetool", "prod", "api").Return(nil, nil)
+				prog.EXPECT().Start(gomock.Any())
+				scaler.EXPECT().ScaleService("phonetool", "prod", "api", int64(8)).Return(nil)
+				prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedOverrides: `# Scale out in prod.
+count: 8
+cpu: 1024
+`,
+		},
+		"error if the count of the override file is not a number of tasks": {
+			inCount: 8,
+			inSave:  true,
+			inOverrides: `count:
+  range: 1-10
+`,
+			setupMocks: func(scaler *mocks.MockecsServiceScaler, prog *mocks.Mockprogress) {
+				scaler.EXPECT().AutoscalingCapacity("phonetool", "prod", "api").Return(nil, nil)
+				prog.EXPECT().Start(gomock.Any())
+				scaler.EXPECT().ScaleService("phonetool", "prod", "api", int64(8)).Return(nil)
+				prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedError: `write count to the manifest of service api: "count" of environment prod is not a number of tasks`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			scaler, prog := mocks.NewMockecsServiceScaler(ctrl), mocks.NewMockprogress(ctrl)
+			tc.setupMocks(scaler, prog)
+			editor := mocks.NewMockworkloadManifestEditor(ctrl)
+			var written string
+			editor.EXPECT().EditWorkloadManifest("api", gomock.Any()).DoAndReturn(func(_ string, edit func([]byte) ([]byte, error)) (string, error) {
+				out, err := edit([]byte(mft))
+				if err != nil {
+					return "", err
+				}
+				written = string(out)
+				return "copilot/api/manifest.yml", nil
+			}).AnyTimes()
+			var writtenOverrides string
+			editor.EXPECT().EditWorkloadEnvOverrides("api", "prod", gomock.Any()).DoAndReturn(func(_, _ string, edit func([]byte) ([]byte, error)) (string, error) {
+				if tc.inOverrides == "" {
+					return "", &workspace.ErrFileNotExists{FileName: "copilot/api/environments/prod/overrides.yml"}
+				}
+				out, err := edit([]byte(tc.inOverrides))
+				if err != nil {
+					return "", err
+				}
+				writtenOverrides = string(out)
+				return "copilot/api/environments/prod/overrides.yml", nil
+			}).AnyTimes()
+			opts := &svcScaleOpts{
+				svcScaleVars: svcScaleVars{
+					appName: "phonetool",
+					envName: "prod",
+					svcName: "api",
+					count:   aws.Int(tc.inCount),
+					save:    tc.inSave,
+				},
+				mftEditor:  editor,
+				prog:       prog,
+				initScaler: func() error { return nil },
+				scaler:     scaler,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedManifest, written)
+			require.Equal(t, tc.wantedOverrides, writtenOverrides)
+		})
+	}
+}
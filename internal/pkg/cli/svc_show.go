@@ -4,39 +4,63 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"path"
 	"strings"
 	"unicode"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
+	templatediff "github.com/aws/copilot-cli/internal/pkg/template/diff"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/output"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 )
 
 const (
 	svcShowSvcNamePrompt     = "Which service of %s would you like to show?"
 	svcShowSvcNameHelpPrompt = "The details of a service will be shown (e.g., endpoint URL, CPU, Memory)."
+
+	svcShowManifestEnvPrompt     = "Which environment's manifest of %s would you like to show?"
+	svcShowManifestEnvHelpPrompt = "The manifest file used for the latest deployment of the service to the environment will be shown."
 )
 
+// manifestForSelectedEnv is the value of the --manifest flag when it's given without an environment name,
+// in which case the environment comes from the --env flag or is prompted for.
+const manifestForSelectedEnv = "selected"
+
 type showSvcVars struct {
 	appName               string
 	svcName               string
 	shouldOutputJSON      bool
 	shouldOutputResources bool
+	shouldOutputCost      bool
 	outputManifestForEnv  string
+	envName               string
+	shouldRenderManifest  bool
+	shouldDiffManifest    bool
+	outputIAMForEnv       string
+	shouldCheckIAM        bool
+	outputSBOMForEnv      string
 }
 
 type showSvcOpts struct {
@@ -48,6 +72,11 @@ type showSvcOpts struct {
 	sel           configSelector
 	initDescriber func() error // Overridden in tests.
 
+	newCostEstimator      func() (costEstimator, error)
+	newIAMPolicyDescriber func(env string) (iamPolicyDescriber, error)
+	newArtifactReader     func(env string) (reader artifactReader, bucket string, err error)
+	newManifestReader     func() (manifestReader, error)
+
 	// Cached variables.
 	targetSvc *config.Workload
 }
@@ -59,7 +88,7 @@ func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
 		return nil, fmt.Errorf("default session: %v", err)
 	}
 
-	ssmStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	ssmStore := config.NewCachedSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
 	deployStore, err := deploy.NewStore(sessProvider, ssmStore)
 	if err != nil {
 		return nil, fmt.Errorf("connect to deploy store: %w", err)
@@ -71,6 +100,43 @@ func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
 		w:           log.OutputWriter,
 		sel:         selector.NewConfigSelector(prompt.New(), ssmStore),
 	}
+	opts.newCostEstimator = func() (costEstimator, error) {
+		return describe.NewCostEstimator(describe.NewCostEstimatorConfig{
+			App:         opts.appName,
+			ConfigStore: ssmStore,
+			DeployStore: deployStore,
+		})
+	}
+	opts.newIAMPolicyDescriber = func(env string) (iamPolicyDescriber, error) {
+		return describe.NewIAMPolicyDescriber(describe.NewIAMPolicyDescriberConfig{
+			App:         opts.appName,
+			Env:         env,
+			Svc:         opts.svcName,
+			ConfigStore: ssmStore,
+		})
+	}
+	opts.newArtifactReader = func(envName string) (artifactReader, string, error) {
+		env, err := ssmStore.GetEnvironment(opts.appName, envName)
+		if err != nil {
+			return nil, "", fmt.Errorf("get environment %s: %w", envName, err)
+		}
+		app, err := ssmStore.GetApplication(opts.appName)
+		if err != nil {
+			return nil, "", fmt.Errorf("get application %s: %w", opts.appName, err)
+		}
+		resources, err := cloudformation.New(defaultSess).GetAppResourcesByRegion(app, env.Region)
+		if err != nil {
+			return nil, "", fmt.Errorf("get application %s resources from region %s: %w", app.Name, env.Region, err)
+		}
+		envSess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return nil, "", fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+		}
+		return s3.New(envSess), resources.S3Bucket, nil
+	}
+	opts.newManifestReader = func() (manifestReader, error) {
+		return workspace.Use(afero.NewOsFs())
+	}
 	opts.initDescriber = func() error {
 		var d workloadDescriber
 		svc, err := opts.getTargetSvc()
@@ -110,6 +176,23 @@ func newShowSvcOpts(vars showSvcVars) (*showSvcOpts, error) {
 
 // Validate returns an error for any invalid optional flags.
 func (o *showSvcOpts) Validate() error {
+	if o.shouldCheckIAM && o.outputIAMForEnv == "" {
+		return fmt.Errorf("--%s must be specified with --%s", iamFlag, checkFlag)
+	}
+	if o.outputManifestForEnv == "" {
+		if o.envName != "" {
+			return fmt.Errorf("--%s must be specified with --%s", manifestFlag, envFlag)
+		}
+		if o.shouldRenderManifest {
+			return fmt.Errorf("--%s must be specified with --%s", manifestFlag, renderedFlag)
+		}
+		if o.shouldDiffManifest {
+			return fmt.Errorf("--%s must be specified with --%s", manifestFlag, diffFlag)
+		}
+	}
+	if o.outputManifestForEnv != manifestForSelectedEnv && o.envName != "" && o.envName != o.outputManifestForEnv {
+		return fmt.Errorf("--%s %s and --%s %s refer to different environments", manifestFlag, o.outputManifestForEnv, envFlag, o.envName)
+	}
 	return nil
 }
 
@@ -118,7 +201,10 @@ func (o *showSvcOpts) Ask() error {
 	if err := o.validateOrAskApp(); err != nil {
 		return err
 	}
-	return o.validateOrAskSvcName()
+	if err := o.validateOrAskSvcName(); err != nil {
+		return err
+	}
+	return o.validateOrAskManifestEnv()
 }
 
 // Execute shows the services through the prompt.
@@ -126,6 +212,15 @@ func (o *showSvcOpts) Execute() error {
 	if o.svcName == "" {
 		return nil
 	}
+	if o.shouldOutputCost {
+		return o.writeCost()
+	}
+	if o.outputIAMForEnv != "" {
+		return o.writeIAMPolicies()
+	}
+	if o.outputSBOMForEnv != "" {
+		return o.writeSBOMs()
+	}
 	if err := o.initDescriber(); err != nil {
 		return err
 	}
@@ -138,17 +233,7 @@ func (o *showSvcOpts) Execute() error {
 		return fmt.Errorf("describe service %s: %w", o.svcName, err)
 	}
 
-	if o.shouldOutputJSON {
-		data, err := svc.JSONString()
-		if err != nil {
-			return err
-		}
-		fmt.Fprint(o.w, data)
-	} else {
-		fmt.Fprint(o.w, svc.HumanString())
-	}
-
-	return nil
+	return output.New(o.w, o.shouldOutputJSON).Write(svc)
 }
 
 func (o *showSvcOpts) validateOrAskApp() error {
@@ -179,6 +264,23 @@ func (o *showSvcOpts) validateOrAskSvcName() error {
 	return nil
 }
 
+func (o *showSvcOpts) validateOrAskManifestEnv() error {
+	if o.outputManifestForEnv != manifestForSelectedEnv {
+		return nil
+	}
+	if o.envName != "" {
+		o.outputManifestForEnv = o.envName
+		return nil
+	}
+	env, err := o.sel.Environment(fmt.Sprintf(svcShowManifestEnvPrompt, color.HighlightUserInput(o.svcName)),
+		svcShowManifestEnvHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.outputManifestForEnv = env
+	return nil
+}
+
 func (o *showSvcOpts) getTargetSvc() (*config.Workload, error) {
 	if o.targetSvc != nil {
 		return o.targetSvc, nil
@@ -192,7 +294,11 @@ func (o *showSvcOpts) getTargetSvc() (*config.Workload, error) {
 }
 
 func (o *showSvcOpts) writeManifest() error {
-	out, err := o.describer.Manifest(o.outputManifestForEnv)
+	getManifest := o.describer.Manifest
+	if o.shouldRenderManifest {
+		getManifest = o.describer.RenderedManifest
+	}
+	out, err := getManifest(o.outputManifestForEnv)
 	if err != nil {
 		var errNotFound *describe.ErrManifestNotFoundInTemplate
 		if errors.As(err, &errNotFound) {
@@ -201,10 +307,102 @@ func (o *showSvcOpts) writeManifest() error {
 		}
 		return fmt.Errorf("fetch manifest for service %q in environment %q: %v", o.svcName, o.outputManifestForEnv, err)
 	}
+	if o.shouldDiffManifest {
+		return o.writeManifestDiff(out)
+	}
 	fmt.Fprintln(o.w, strings.TrimRightFunc(string(out), unicode.IsSpace))
 	return nil
 }
 
+// writeManifestDiff writes the changes from the deployed manifest to the manifest file in the workspace.
+// Environment variables are compared as written in the manifests, so they never show up as changes.
+func (o *showSvcOpts) writeManifestDiff(deployed []byte) error {
+	ws, err := o.newManifestReader()
+	if err != nil {
+		return err
+	}
+	local, err := ws.ReadWorkloadManifest(o.svcName)
+	if err != nil {
+		return fmt.Errorf("read manifest file for %s: %w", o.svcName, err)
+	}
+	tree, err := templatediff.From(string(deployed)).Parse(local)
+	if err != nil {
+		return fmt.Errorf("compare deployed manifest of service %q in environment %q with manifest file: %w", o.svcName, o.outputManifestForEnv, err)
+	}
+	if !tree.HasChanges() {
+		fmt.Fprintln(o.w, "No changes.")
+		return nil
+	}
+	if err := tree.Write(o.w, templatediff.WithColor(color.EnabledFor(o.w))); err != nil {
+		return fmt.Errorf("write manifest diff: %w", err)
+	}
+	return &errHasDiff{}
+}
+
+func (o *showSvcOpts) writeCost() error {
+	estimator, err := o.newCostEstimator()
+	if err != nil {
+		return err
+	}
+	estimate, err := estimator.Service(o.svcName)
+	if err != nil {
+		return fmt.Errorf("estimate cost of service %s: %w", o.svcName, err)
+	}
+	return output.New(o.w, o.shouldOutputJSON).Write(estimate)
+}
+
+func (o *showSvcOpts) writeIAMPolicies() error {
+	d, err := o.newIAMPolicyDescriber(o.outputIAMForEnv)
+	if err != nil {
+		return err
+	}
+	describePolicies := d.Describe
+	if o.shouldCheckIAM {
+		describePolicies = d.Check
+	}
+	policies, err := describePolicies()
+	if err != nil {
+		return fmt.Errorf("describe IAM policies of service %s in environment %s: %w", o.svcName, o.outputIAMForEnv, err)
+	}
+	if err := output.New(o.w, o.shouldOutputJSON).Write(policies); err != nil {
+		return err
+	}
+	if o.shouldCheckIAM && policies.Drifted() {
+		return fmt.Errorf("IAM policies of the roles of service %s in environment %s differ from its stack", o.svcName, o.outputIAMForEnv)
+	}
+	return nil
+}
+
+func (o *showSvcOpts) writeSBOMs() error {
+	reader, bucket, err := o.newArtifactReader(o.outputSBOMForEnv)
+	if err != nil {
+		return err
+	}
+	keys, err := reader.ObjectKeys(bucket, artifactpath.SBOMs(stack.NameForWorkload(o.appName, o.outputSBOMForEnv, o.svcName)))
+	if err != nil {
+		return fmt.Errorf("list software bills of materials of service %s in environment %s: %w", o.svcName, o.outputSBOMForEnv, err)
+	}
+	if len(keys) == 0 {
+		log.Infof("Set %s in the manifest and redeploy the service to generate the software bills of materials of its images.\n",
+			color.HighlightCode("image.sbom.format"))
+		return fmt.Errorf("no software bill of materials found for service %s in environment %s", o.svcName, o.outputSBOMForEnv)
+	}
+	sboms := make(map[string]json.RawMessage, len(keys))
+	for _, key := range keys {
+		content, err := reader.Download(bucket, key)
+		if err != nil {
+			return fmt.Errorf("download software bill of materials %s: %w", key, err)
+		}
+		sboms[strings.TrimSuffix(path.Base(key), ".json")] = content
+	}
+	data, err := json.MarshalIndent(sboms, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal software bills of materials: %w", err)
+	}
+	fmt.Fprintln(o.w, string(data))
+	return nil
+}
+
 // buildSvcShowCmd builds the command for showing services in an application.
 func buildSvcShowCmd() *cobra.Command {
 	vars := showSvcVars{}
@@ -217,8 +415,24 @@ func buildSvcShowCmd() *cobra.Command {
   Print service configuration in deployed environments.
   /code $ copilot svc show -n api
   Print manifest file used for deploying service "api" in the "prod" environment.
-  /code $ copilot svc show -n api --manifest prod`,
+  /code $ copilot svc show -n api --manifest prod
+  Print the manifest of service "api" in the "prod" environment with its environment variables substituted.
+  /code $ copilot svc show -n api --manifest --env prod --rendered
+  Compare the manifest deployed to the "prod" environment with the manifest file in the workspace.
+  /code $ copilot svc show -n api --manifest --env prod --diff
+  Print the estimated monthly cost of service "api" in each environment as JSON.
+  /code $ copilot svc show -n api --cost --json
+  Print the IAM policies of the task and execution roles of service "api" in the "prod" environment.
+  /code $ copilot svc show -n api --iam prod
+  Check that the policies of the deployed roles haven't drifted from the policies in the stack.
+  /code $ copilot svc show -n api --iam prod --check
+  Print the software bills of materials of the images of service "api" in the "prod" environment.
+  /code $ copilot svc show -n api --sbom prod`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if vars.outputManifestForEnv == manifestForSelectedEnv && len(args) == 1 {
+				// "--manifest prod" leaves the environment name as an argument since the flag's value is optional.
+				vars.outputManifestForEnv = args[0]
+			}
 			opts, err := newShowSvcOpts(vars)
 			if err != nil {
 				return err
@@ -230,9 +444,28 @@ func buildSvcShowCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.svcName, nameFlag, nameFlagShort, "", svcFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputResources, resourcesFlag, false, svcResourcesFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputCost, costFlag, false, svcCostFlagDescription)
 	cmd.Flags().StringVar(&vars.outputManifestForEnv, manifestFlag, "", svcManifestFlagDescription)
+	cmd.Flags().Lookup(manifestFlag).NoOptDefVal = manifestForSelectedEnv
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldRenderManifest, renderedFlag, false, svcRenderedManifestFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldDiffManifest, diffFlag, false, svcManifestDiffFlagDescription)
+	cmd.Flags().StringVar(&vars.outputIAMForEnv, iamFlag, "", svcIAMFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldCheckIAM, checkFlag, false, svcCheckIAMFlagDescription)
+	cmd.Flags().StringVar(&vars.outputSBOMForEnv, sbomFlag, "", svcSBOMFlagDescription)
 
 	cmd.MarkFlagsMutuallyExclusive(jsonFlag, manifestFlag)
 	cmd.MarkFlagsMutuallyExclusive(resourcesFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(costFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(renderedFlag, diffFlag)
+	cmd.MarkFlagsMutuallyExclusive(costFlag, resourcesFlag)
+	cmd.MarkFlagsMutuallyExclusive(iamFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(iamFlag, resourcesFlag)
+	cmd.MarkFlagsMutuallyExclusive(iamFlag, costFlag)
+	cmd.MarkFlagsMutuallyExclusive(sbomFlag, jsonFlag)
+	cmd.MarkFlagsMutuallyExclusive(sbomFlag, manifestFlag)
+	cmd.MarkFlagsMutuallyExclusive(sbomFlag, resourcesFlag)
+	cmd.MarkFlagsMutuallyExclusive(sbomFlag, costFlag)
+	cmd.MarkFlagsMutuallyExclusive(sbomFlag, iamFlag)
 	return cmd
 }
@@ -14,13 +14,18 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
 )
 
 type showSvcMocks struct {
-	storeSvc  *mocks.Mockstore
-	describer *mocks.MockworkloadDescriber
-	ws        *mocks.MockwsSvcReader
-	sel       *mocks.MockconfigSelector
+	storeSvc      *mocks.Mockstore
+	describer     *mocks.MockworkloadDescriber
+	ws            *mocks.MockwsSvcReader
+	sel           *mocks.MockconfigSelector
+	costEstimator *mocks.MockcostEstimator
+	iamDescriber  *mocks.MockiamPolicyDescriber
+	artifacts     *mocks.MockartifactReader
+	manifests     *mocks.MockmanifestReader
 }
 
 type mockDescribeData struct {
@@ -37,19 +42,74 @@ func (m *mockDescribeData) JSONString() (string, error) {
 }
 
 func TestSvcShow_Validate(t *testing.T) {
-	// NOTE: no optional flag needs to be validated for this command.
+	testCases := map[string]struct {
+		inputIAMForEnv      string
+		inputCheckIAM       bool
+		inputManifestForEnv string
+		inputEnv            string
+		inputDiffManifest   bool
+
+		wantedError error
+	}{
+		"error if --check is used without --iam": {
+			inputCheckIAM: true,
+			wantedError:   errors.New("--iam must be specified with --check"),
+		},
+		"valid with --iam and --check": {
+			inputIAMForEnv: "test",
+			inputCheckIAM:  true,
+		},
+		"error if --diff is used without --manifest": {
+			inputDiffManifest: true,
+			wantedError:       errors.New("--manifest must be specified with --diff"),
+		},
+		"error if --manifest and --env name different environments": {
+			inputManifestForEnv: "test",
+			inputEnv:            "prod",
+			wantedError:         errors.New("--manifest test and --env prod refer to different environments"),
+		},
+		"valid with --manifest and --env": {
+			inputManifestForEnv: manifestForSelectedEnv,
+			inputEnv:            "prod",
+			inputDiffManifest:   true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &showSvcOpts{
+				showSvcVars: showSvcVars{
+					outputIAMForEnv:      tc.inputIAMForEnv,
+					shouldCheckIAM:       tc.inputCheckIAM,
+					outputManifestForEnv: tc.inputManifestForEnv,
+					envName:              tc.inputEnv,
+					shouldDiffManifest:   tc.inputDiffManifest,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
 }
 
 func TestSvcShow_Ask(t *testing.T) {
 	testCases := map[string]struct {
-		inputApp string
-		inputSvc string
+		inputApp            string
+		inputSvc            string
+		inputManifestForEnv string
+		inputEnv            string
 
 		setupMocks func(mocks showSvcMocks)
 
-		wantedApp   string
-		wantedSvc   string
-		wantedError error
+		wantedApp         string
+		wantedSvc         string
+		wantedManifestEnv string
+		wantedError       error
 	}{
 		"validate instead of prompting application name and svc name": {
 			inputApp: "my-app",
@@ -98,6 +158,33 @@ func TestSvcShow_Ask(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("select service for application my-app: some error"),
 		},
+		"use --env as the environment of --manifest": {
+			inputApp:            "my-app",
+			inputSvc:            "my-svc",
+			inputManifestForEnv: manifestForSelectedEnv,
+			inputEnv:            "prod",
+			setupMocks: func(m showSvcMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.storeSvc.EXPECT().GetService("my-app", "my-svc").Return(&config.Workload{}, nil)
+				m.sel.EXPECT().Environment(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedApp:         "my-app",
+			wantedSvc:         "my-svc",
+			wantedManifestEnv: "prod",
+		},
+		"prompt for the environment of --manifest": {
+			inputApp:            "my-app",
+			inputSvc:            "my-svc",
+			inputManifestForEnv: manifestForSelectedEnv,
+			setupMocks: func(m showSvcMocks) {
+				m.storeSvc.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.storeSvc.EXPECT().GetService("my-app", "my-svc").Return(&config.Workload{}, nil)
+				m.sel.EXPECT().Environment(fmt.Sprintf(svcShowManifestEnvPrompt, "my-svc"), svcShowManifestEnvHelpPrompt, "my-app").Return("test", nil)
+			},
+			wantedApp:         "my-app",
+			wantedSvc:         "my-svc",
+			wantedManifestEnv: "test",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -119,8 +206,10 @@ func TestSvcShow_Ask(t *testing.T) {
 
 			showSvcs := &showSvcOpts{
 				showSvcVars: showSvcVars{
-					svcName: tc.inputSvc,
-					appName: tc.inputApp,
+					svcName:              tc.inputSvc,
+					appName:              tc.inputApp,
+					outputManifestForEnv: tc.inputManifestForEnv,
+					envName:              tc.inputEnv,
 				},
 				store: mockStoreReader,
 				sel:   mockSelector,
@@ -136,6 +225,7 @@ func TestSvcShow_Ask(t *testing.T) {
 				require.NoError(t, err)
 				require.Equal(t, tc.wantedApp, showSvcs.appName, "expected app name to match")
 				require.Equal(t, tc.wantedSvc, showSvcs.svcName, "expected service name to match")
+				require.Equal(t, tc.wantedManifestEnv, showSvcs.outputManifestForEnv, "expected environment of the manifest to match")
 			}
 		})
 	}
@@ -150,7 +240,13 @@ func TestSvcShow_Execute(t *testing.T) {
 	testCases := map[string]struct {
 		inputSvc             string
 		shouldOutputJSON     bool
+		shouldOutputCost     bool
 		outputManifestForEnv string
+		shouldRenderManifest bool
+		shouldDiffManifest   bool
+		outputIAMForEnv      string
+		shouldCheckIAM       bool
+		outputSBOMForEnv     string
 
 		setupMocks func(mocks showSvcMocks)
 
@@ -182,6 +278,50 @@ func TestSvcShow_Execute(t *testing.T) {
 
 			wantedContent: "name: my-svc\n",
 		},
+		"print the rendered manifest if --rendered is provided": {
+			inputSvc:             "my-svc",
+			outputManifestForEnv: "test",
+			shouldRenderManifest: true,
+			setupMocks: func(m showSvcMocks) {
+				m.describer.EXPECT().Manifest(gomock.Any()).Times(0)
+				m.describer.EXPECT().RenderedManifest("test").Return([]byte("name: my-svc\nimage:\n  location: nginx:1.25\n"), nil)
+			},
+
+			wantedContent: "name: my-svc\nimage:\n  location: nginx:1.25\n",
+		},
+		"print no changes if the deployed manifest matches the manifest file": {
+			inputSvc:             "my-svc",
+			outputManifestForEnv: "test",
+			shouldDiffManifest:   true,
+			setupMocks: func(m showSvcMocks) {
+				m.describer.EXPECT().Manifest("test").Return([]byte("name: my-svc\ncount: 1\n"), nil)
+				m.manifests.EXPECT().ReadWorkloadManifest("my-svc").Return([]byte("name: my-svc\ncount: 1 # Tasks.\n"), nil)
+			},
+
+			wantedContent: "No changes.\n",
+		},
+		"return errHasDiff if the deployed manifest differs from the manifest file": {
+			inputSvc:             "my-svc",
+			outputManifestForEnv: "test",
+			shouldDiffManifest:   true,
+			setupMocks: func(m showSvcMocks) {
+				m.describer.EXPECT().Manifest("test").Return([]byte("name: my-svc\ncount: 1\n"), nil)
+				m.manifests.EXPECT().ReadWorkloadManifest("my-svc").Return([]byte("name: my-svc\ncount: 2\n"), nil)
+			},
+
+			wantedError: &errHasDiff{},
+		},
+		"return wrapped error if the manifest file cannot be read": {
+			inputSvc:             "my-svc",
+			outputManifestForEnv: "test",
+			shouldDiffManifest:   true,
+			setupMocks: func(m showSvcMocks) {
+				m.describer.EXPECT().Manifest("test").Return([]byte("name: my-svc\n"), nil)
+				m.manifests.EXPECT().ReadWorkloadManifest("my-svc").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("read manifest file for my-svc: some error"),
+		},
 		"return error if fail to generate JSON output": {
 			inputSvc:         "my-svc",
 			shouldOutputJSON: true,
@@ -214,6 +354,93 @@ func TestSvcShow_Execute(t *testing.T) {
 
 			wantedError: errors.New(`fetch manifest for service "my-svc" in environment "test": some error`),
 		},
+		"print the estimated cost as JSON if --cost is provided": {
+			inputSvc:         "my-svc",
+			shouldOutputJSON: true,
+			shouldOutputCost: true,
+			setupMocks: func(m showSvcMocks) {
+				m.describer.EXPECT().Describe().Times(0)
+				m.costEstimator.EXPECT().Service("my-svc").Return(&describe.CostEstimate{Currency: "USD", Total: 12.5}, nil)
+			},
+
+			wantedContent: `{"currency":"USD","environments":null,"total":12.5}` + "\n",
+		},
+		"return wrapped error if the cost cannot be estimated": {
+			inputSvc:         "my-svc",
+			shouldOutputCost: true,
+			setupMocks: func(m showSvcMocks) {
+				m.costEstimator.EXPECT().Service("my-svc").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("estimate cost of service my-svc: some error"),
+		},
+		"print the IAM policies as JSON if --iam is provided": {
+			inputSvc:         "my-svc",
+			shouldOutputJSON: true,
+			outputIAMForEnv:  "test",
+			setupMocks: func(m showSvcMocks) {
+				m.describer.EXPECT().Describe().Times(0)
+				m.iamDescriber.EXPECT().Describe().Return(&describe.IAMPolicies{Service: "my-svc", Environment: "test"}, nil)
+			},
+
+			wantedContent: `{"service":"my-svc","environment":"test","roles":null}` + "\n",
+		},
+		"return wrapped error if the IAM policies cannot be described": {
+			inputSvc:        "my-svc",
+			outputIAMForEnv: "test",
+			setupMocks: func(m showSvcMocks) {
+				m.iamDescriber.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("describe IAM policies of service my-svc in environment test: some error"),
+		},
+		"return error if the policies of the deployed roles differ from the stack with --check": {
+			inputSvc:         "my-svc",
+			shouldOutputJSON: true,
+			outputIAMForEnv:  "test",
+			shouldCheckIAM:   true,
+			setupMocks: func(m showSvcMocks) {
+				m.iamDescriber.EXPECT().Check().Return(&describe.IAMPolicies{
+					Roles: []*describe.IAMRole{
+						{
+							Policies: []*describe.IAMPolicy{{Status: describe.IAMPolicyStatusMissing}},
+						},
+					},
+				}, nil)
+			},
+
+			wantedError: errors.New("IAM policies of the roles of service my-svc in environment test differ from its stack"),
+		},
+		"print the software bills of materials of the images if --sbom is provided": {
+			inputSvc:         "my-svc",
+			outputSBOMForEnv: "test",
+			setupMocks: func(m showSvcMocks) {
+				m.describer.EXPECT().Describe().Times(0)
+				m.artifacts.EXPECT().ObjectKeys("mockBucket", "manual/sbom/my-app-test-my-svc/").
+					Return([]string{"manual/sbom/my-app-test-my-svc/my-svc.json", "manual/sbom/my-app-test-my-svc/nginx.json"}, nil)
+				m.artifacts.EXPECT().Download("mockBucket", "manual/sbom/my-app-test-my-svc/my-svc.json").Return([]byte(`{"spdxVersion":"SPDX-2.3"}`), nil)
+				m.artifacts.EXPECT().Download("mockBucket", "manual/sbom/my-app-test-my-svc/nginx.json").Return([]byte(`{"spdxVersion":"SPDX-2.3"}`), nil)
+			},
+
+			wantedContent: `{
+  "my-svc": {
+    "spdxVersion": "SPDX-2.3"
+  },
+  "nginx": {
+    "spdxVersion": "SPDX-2.3"
+  }
+}
+`,
+		},
+		"return error if no software bill of materials was uploaded": {
+			inputSvc:         "my-svc",
+			outputSBOMForEnv: "test",
+			setupMocks: func(m showSvcMocks) {
+				m.artifacts.EXPECT().ObjectKeys("mockBucket", "manual/sbom/my-app-test-my-svc/").Return(nil, nil)
+			},
+
+			wantedError: errors.New("no software bill of materials found for service my-svc in environment test"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -224,8 +451,16 @@ func TestSvcShow_Execute(t *testing.T) {
 			b := &bytes.Buffer{}
 			mockSvcDescriber := mocks.NewMockworkloadDescriber(ctrl)
 
+			mockCostEstimator := mocks.NewMockcostEstimator(ctrl)
+			mockIAMDescriber := mocks.NewMockiamPolicyDescriber(ctrl)
+			mockArtifacts := mocks.NewMockartifactReader(ctrl)
+			mockWorkspace := mocks.NewMockmanifestReader(ctrl)
 			mocks := showSvcMocks{
-				describer: mockSvcDescriber,
+				describer:     mockSvcDescriber,
+				costEstimator: mockCostEstimator,
+				iamDescriber:  mockIAMDescriber,
+				artifacts:     mockArtifacts,
+				manifests:     mockWorkspace,
 			}
 
 			tc.setupMocks(mocks)
@@ -235,11 +470,29 @@ func TestSvcShow_Execute(t *testing.T) {
 					appName:              appName,
 					svcName:              tc.inputSvc,
 					shouldOutputJSON:     tc.shouldOutputJSON,
+					shouldOutputCost:     tc.shouldOutputCost,
 					outputManifestForEnv: tc.outputManifestForEnv,
+					shouldRenderManifest: tc.shouldRenderManifest,
+					shouldDiffManifest:   tc.shouldDiffManifest,
+					outputIAMForEnv:      tc.outputIAMForEnv,
+					shouldCheckIAM:       tc.shouldCheckIAM,
+					outputSBOMForEnv:     tc.outputSBOMForEnv,
 				},
 				describer:     mockSvcDescriber,
 				initDescriber: func() error { return nil },
-				w:             b,
+				newCostEstimator: func() (costEstimator, error) {
+					return mockCostEstimator, nil
+				},
+				newIAMPolicyDescriber: func(string) (iamPolicyDescriber, error) {
+					return mockIAMDescriber, nil
+				},
+				newArtifactReader: func(string) (artifactReader, string, error) {
+					return mockArtifacts, "mockBucket", nil
+				},
+				newManifestReader: func() (manifestReader, error) {
+					return mockWorkspace, nil
+				},
+				w: b,
 			}
 
 			// WHEN
@@ -4,8 +4,15 @@
 package cli
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -16,7 +23,12 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/cursor"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/output"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/spf13/cobra"
@@ -25,21 +37,37 @@ import (
 const (
 	svcStatusNamePrompt     = "Which service's status would you like to show?"
 	svcStatusNameHelpPrompt = "Displays the service's task status, most recent deployment and alarm statuses."
+
+	defaultSvcStatusWatchInterval = 5 * time.Second
+	minSvcStatusWatchInterval     = time.Second
 )
 
+// alarmStater is implemented by the statuses of services that have CloudWatch alarms.
+type alarmStater interface {
+	AlarmStates() map[string]string
+}
+
 type svcStatusVars struct {
 	shouldOutputJSON bool
 	svcName          string
 	envName          string
 	appName          string
+	watch            bool
+	watchInterval    time.Duration
+	previous         bool
+	logLines         int
 }
 
 type svcStatusOpts struct {
 	svcStatusVars
 
 	w                   io.Writer
+	watchOut            termprogress.FileWriter // Where the status is refreshed in place with --watch.
+	now                 func() time.Time
+	interrupted         func() (context.Context, context.CancelFunc)
 	store               store
 	statusDescriber     statusDescriber
+	logs                logEventsWriter // Writes the recent log lines of the service with --watch, nil if they aren't shown.
 	sel                 deploySelector
 	initStatusDescriber func(*svcStatusOpts) error
 }
@@ -60,12 +88,41 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 		svcStatusVars: vars,
 		store:         configStore,
 		w:             log.OutputWriter,
-		sel:           selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		watchOut:      os.Stdout,
+		now:           time.Now,
+		interrupted: func() (context.Context, context.CancelFunc) {
+			return signal.NotifyContext(context.Background(), os.Interrupt)
+		},
+		sel: selector.NewDeploySelect(prompt.New(), configStore, deployStore),
 		initStatusDescriber: func(o *svcStatusOpts) error {
 			wkld, err := configStore.GetWorkload(o.appName, o.svcName)
 			if err != nil {
 				return fmt.Errorf("retrieve %s from application %s: %w", o.appName, o.svcName, err)
 			}
+			if o.previous {
+				if !contains(wkld.Type, ecsServiceTypes) {
+					return fmt.Errorf("--%s is only supported for services running on Amazon ECS, not %q", previousFlag, wkld.Type)
+				}
+				app, err := configStore.GetApplication(o.appName)
+				if err != nil {
+					return fmt.Errorf("get application %s: %w", o.appName, err)
+				}
+				d, err := describe.NewECSPreviousDeploymentDescriber(&describe.NewPreviousDeploymentDescriberConfig{
+					NewServiceStatusConfig: describe.NewServiceStatusConfig{
+						App:         o.appName,
+						Env:         o.envName,
+						Svc:         o.svcName,
+						ConfigStore: configStore,
+					},
+					LogLines: o.logLines,
+					LogGroup: app.LogGroupName(o.envName, o.svcName),
+				})
+				if err != nil {
+					return fmt.Errorf("create previous deployment describer for service %s in application %s: %w", o.svcName, o.appName, err)
+				}
+				o.statusDescriber = d
+				return nil
+			}
 			switch wkld.Type {
 			case manifestinfo.RequestDrivenWebServiceType:
 				d, err := describe.NewAppRunnerStatusDescriber(&describe.NewServiceStatusConfig{
@@ -89,6 +146,8 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 					return fmt.Errorf("create status describer for Static Site service %s in application %s: %w", o.svcName, o.appName, err)
 				}
 				o.statusDescriber = d
+			case manifestinfo.LambdaServiceType:
+				return fmt.Errorf(`service type %q is not supported for %s`, wkld.Type, color.HighlightCode("svc status"))
 			default:
 				d, err := describe.NewECSStatusDescriber(&describe.NewServiceStatusConfig{
 					App:         o.appName,
@@ -100,6 +159,28 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 					return fmt.Errorf("create status describer for service %s in application %s: %w", o.svcName, o.appName, err)
 				}
 				o.statusDescriber = d
+				if !o.watch || o.logLines == 0 {
+					return nil
+				}
+				env, err := configStore.GetEnvironment(o.appName, o.envName)
+				if err != nil {
+					return fmt.Errorf("get environment %s: %w", o.envName, err)
+				}
+				sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+				if err != nil {
+					return err
+				}
+				app, err := configStore.GetApplication(o.appName)
+				if err != nil {
+					return fmt.Errorf("get application %s: %w", o.appName, err)
+				}
+				o.logs = logging.NewECSServiceClient(&logging.NewWorkloadLoggerOpts{
+					App:      o.appName,
+					Env:      o.envName,
+					Name:     o.svcName,
+					LogGroup: app.LogGroupName(o.envName, o.svcName),
+					Sess:     sess,
+				})
 			}
 			return nil
 		},
@@ -108,6 +189,18 @@ func newSvcStatusOpts(vars svcStatusVars) (*svcStatusOpts, error) {
 
 // Validate returns an error for any invalid optional flags.
 func (o *svcStatusOpts) Validate() error {
+	if o.watch && o.shouldOutputJSON {
+		return fmt.Errorf("--%s cannot be used with --%s", watchFlag, jsonFlag)
+	}
+	if o.watch && o.watchInterval < minSvcStatusWatchInterval {
+		return fmt.Errorf("--%s must be at least %s", watchIntervalFlag, minSvcStatusWatchInterval)
+	}
+	if o.watch && o.previous {
+		return fmt.Errorf("--%s cannot be used with --%s", watchFlag, previousFlag)
+	}
+	if o.logLines < 0 {
+		return fmt.Errorf("--%s cannot be negative", limitFlag)
+	}
 	return nil
 }
 
@@ -125,21 +218,120 @@ func (o *svcStatusOpts) Execute() error {
 	if err != nil {
 		return err
 	}
+	if o.watch {
+		return o.watchStatus()
+	}
 	svcStatus, err := o.statusDescriber.Describe()
 	if err != nil {
 		return fmt.Errorf("describe status of service %s: %w", o.svcName, err)
 	}
-	if o.shouldOutputJSON {
-		data, err := svcStatus.JSONString()
+	return output.New(o.w, o.shouldOutputJSON).Write(svcStatus)
+}
+
+// watchStatus describes the status of the service periodically, and replaces the previous status on the terminal
+// with the latest one until interrupted. In the accessibility mode, the latest status is appended instead.
+// The status is followed by the states of the alarms of the service and its recent log lines.
+func (o *svcStatusOpts) watchStatus() error {
+	ctx, stop := o.interrupted()
+	defer stop()
+
+	inPlace := !color.Accessible()
+	if inPlace {
+		c := cursor.NewWithWriter(o.watchOut)
+		c.Hide()
+		defer c.Show()
+	}
+
+	var (
+		writtenLines int
+		alarmStates  map[string]string
+	)
+	for {
+		svcStatus, err := o.statusDescriber.Describe()
 		if err != nil {
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return nil
+			}
+			return fmt.Errorf("describe status of service %s: %w", o.svcName, err)
+		}
+		panels := []string{svcStatus.HumanString()}
+		if stater, ok := svcStatus.(alarmStater); ok {
+			states := stater.AlarmStates()
+			if len(states) > 0 {
+				panels = append(panels, alarmStatesPanel(alarmStates, states))
+			}
+			alarmStates = states
+		}
+		if o.logs != nil {
+			logs, err := o.recentLogsPanel()
+			if err != nil {
+				if errors.Is(ctx.Err(), context.Canceled) {
+					return nil
+				}
+				return err
+			}
+			panels = append(panels, logs)
+		}
+		content := fmt.Sprintf("%s\n\n%s", color.Help(fmt.Sprintf("Refreshed at %s, every %s. Press Ctrl+C to exit.",
+			o.now().Format(time.Kitchen), o.watchInterval)), strings.Join(panels, ""))
+		if inPlace {
+			cursor.EraseLinesAbove(o.watchOut, writtenLines)
+		} else if writtenLines > 0 {
+			content = "\n" + content
+		}
+		if _, err := fmt.Fprint(o.watchOut, content); err != nil {
 			return err
 		}
-		fmt.Fprint(o.w, data)
-	} else {
-		fmt.Fprint(o.w, svcStatus.HumanString())
+		writtenLines = cursor.RenderedLines(content, cursor.TerminalWidth(o.watchOut))
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(o.watchInterval):
+		}
 	}
+}
 
-	return nil
+// alarmStatesPanel returns the number of alarms of the service in each state, followed by the alarms
+// whose state changed since the previous refresh.
+func alarmStatesPanel(prev, curr map[string]string) string {
+	counts := make(map[string]int)
+	var changed []string
+	for name, state := range curr {
+		counts[state]++
+		if prevState, ok := prev[name]; ok && prevState != state {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	var b strings.Builder
+	b.WriteString(color.Bold.Sprint("\nAlarm States\n\n"))
+	fmt.Fprintf(&b, "  %d in alarm, %d OK, %d with insufficient data\n",
+		counts["ALARM"], counts["OK"], counts["INSUFFICIENT_DATA"])
+	for _, name := range changed {
+		fmt.Fprintf(&b, "  %s changed from %s to %s since the last refresh\n", name, prev[name], curr[name])
+	}
+	return b.String()
+}
+
+// recentLogsPanel returns the last log lines of the service.
+func (o *svcStatusOpts) recentLogsPanel() (string, error) {
+	var b strings.Builder
+	b.WriteString(color.Bold.Sprint("\nRecent Logs\n\n"))
+	var count int
+	err := o.logs.WriteLogEvents(logging.WriteLogEventsOpts{
+		Limit: aws.Int64(int64(o.logLines)),
+		OnEvents: func(_ io.Writer, logs []logging.HumanJSONStringer) error {
+			count += len(logs)
+			return logging.WriteHumanLogs(&b, logs)
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("get the recent logs of service %s: %w", o.svcName, err)
+	}
+	if count == 0 {
+		b.WriteString("  No log lines yet.\n")
+	}
+	return b.String(), nil
 }
 
 func (o *svcStatusOpts) validateOrAskApp() error {
@@ -188,7 +380,11 @@ func buildSvcStatusCmd() *cobra.Command {
 
 		Example: `
   Shows status of the deployed service "my-svc"
-  /code $ copilot svc status -n my-svc`,
+  /code $ copilot svc status -n my-svc
+  Keeps refreshing the status of "my-svc" every 10 seconds while it rolls out
+  /code $ copilot svc status -n my-svc --watch --interval 10s
+  Shows why the tasks of the last failed deployment of "my-svc" stopped, with their last 50 log lines
+  /code $ copilot svc status -n my-svc --previous --limit 50`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSvcStatusOpts(vars)
 			if err != nil {
@@ -201,5 +397,9 @@ func buildSvcStatusCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.watch, watchFlag, false, watchFlagDescription)
+	cmd.Flags().DurationVar(&vars.watchInterval, watchIntervalFlag, defaultSvcStatusWatchInterval, watchIntervalFlagDescription)
+	cmd.Flags().BoolVarP(&vars.previous, previousFlag, previousFlagShort, false, svcStatusPreviousFlagDescription)
+	cmd.Flags().IntVar(&vars.logLines, limitFlag, describe.DefaultPreviousLogLines, svcStatusLimitFlagDescription)
 	return cmd
 }
@@ -5,20 +5,87 @@ package cli
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 )
 
 func TestSvcStatus_Validate(t *testing.T) {
-	// NOTE: No optional flag to `copilot svc pause` needs to be validated.
+	testCases := map[string]struct {
+		vars        svcStatusVars
+		wantedError string
+	}{
+		"valid without --watch": {
+			vars: svcStatusVars{shouldOutputJSON: true},
+		},
+		"valid with --watch": {
+			vars: svcStatusVars{watch: true, watchInterval: 5 * time.Second},
+		},
+		"error if --watch is used with --json": {
+			vars:        svcStatusVars{watch: true, watchInterval: 5 * time.Second, shouldOutputJSON: true},
+			wantedError: "--watch cannot be used with --json",
+		},
+		"error if the interval is too short": {
+			vars:        svcStatusVars{watch: true, watchInterval: 10 * time.Millisecond},
+			wantedError: "--interval must be at least 1s",
+		},
+		"valid with --previous": {
+			vars: svcStatusVars{previous: true, logLines: 20, shouldOutputJSON: true},
+		},
+		"error if --watch is used with --previous": {
+			vars:        svcStatusVars{watch: true, watchInterval: 5 * time.Second, previous: true},
+			wantedError: "--watch cannot be used with --previous",
+		},
+		"error if the number of log lines is negative": {
+			vars:        svcStatusVars{previous: true, logLines: -1},
+			wantedError: "--limit cannot be negative",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcStatusOpts{svcStatusVars: tc.vars}
+
+			err := opts.Validate()
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// fakeFileWriter is a bytes.Buffer with a dummy file descriptor.
+type mockAlarmStatus struct {
+	mockDescribeData
+	states map[string]string
+}
+
+func (m *mockAlarmStatus) AlarmStates() map[string]string {
+	return m.states
+}
+
+type fakeFileWriter struct {
+	bytes.Buffer
+}
+
+func (w *fakeFileWriter) Fd() uintptr {
+	return 0
 }
 
 type svcStatusAskMock struct {
@@ -202,3 +269,187 @@ func TestSvcStatus_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestSvcStatus_Execute_Watch(t *testing.T) {
+	testCases := map[string]struct {
+		inAccessible        bool
+		inLogLines          int
+		mockStatusDescriber func(m *mocks.MockstatusDescriber, cancel context.CancelFunc)
+		mockLogs            func(m *mocks.MocklogEventsWriter)
+		wantedError         string
+		wantedStatuses      []string
+		wantedOutput        string
+	}{
+		"refresh the status until interrupted": {
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber, cancel context.CancelFunc) {
+				gomock.InOrder(
+					m.EXPECT().Describe().Return(&mockDescribeData{data: "rolling out\n"}, nil),
+					m.EXPECT().Describe().DoAndReturn(func() (*mockDescribeData, error) {
+						cancel()
+						return &mockDescribeData{data: "completed\n"}, nil
+					}),
+				)
+			},
+			wantedStatuses: []string{"rolling out\n", "completed\n"},
+		},
+		"append the statuses instead of replacing them in the accessibility mode": {
+			inAccessible: true,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber, cancel context.CancelFunc) {
+				gomock.InOrder(
+					m.EXPECT().Describe().Return(&mockDescribeData{data: "rolling out\n"}, nil),
+					m.EXPECT().Describe().DoAndReturn(func() (*mockDescribeData, error) {
+						cancel()
+						return &mockDescribeData{data: "completed\n"}, nil
+					}),
+				)
+			},
+			wantedStatuses: []string{"rolling out\n", "completed\n"},
+			wantedOutput: "Refreshed at 3:04PM, every 1ms. Press Ctrl+C to exit.\n\nrolling out\n" +
+				"\nRefreshed at 3:04PM, every 1ms. Press Ctrl+C to exit.\n\ncompleted\n",
+		},
+		"show the alarm states and the alarms that changed since the last refresh": {
+			inAccessible: true,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber, cancel context.CancelFunc) {
+				gomock.InOrder(
+					m.EXPECT().Describe().Return(&mockAlarmStatus{
+						mockDescribeData: mockDescribeData{data: "rolling out\n"},
+						states:           map[string]string{"high-cpu": "OK", "rollback": "OK"},
+					}, nil),
+					m.EXPECT().Describe().DoAndReturn(func() (*mockAlarmStatus, error) {
+						cancel()
+						return &mockAlarmStatus{
+							mockDescribeData: mockDescribeData{data: "completed\n"},
+							states:           map[string]string{"high-cpu": "ALARM", "rollback": "OK"},
+						}, nil
+					}),
+				)
+			},
+			wantedStatuses: []string{"rolling out\n", "completed\n"},
+			wantedOutput: "Refreshed at 3:04PM, every 1ms. Press Ctrl+C to exit.\n\nrolling out\n" +
+				"\nAlarm States\n\n  0 in alarm, 2 OK, 0 with insufficient data\n" +
+				"\nRefreshed at 3:04PM, every 1ms. Press Ctrl+C to exit.\n\ncompleted\n" +
+				"\nAlarm States\n\n  1 in alarm, 1 OK, 0 with insufficient data\n" +
+				"  high-cpu changed from OK to ALARM since the last refresh\n",
+		},
+		"show the recent log lines of the service": {
+			inAccessible: true,
+			inLogLines:   2,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber, cancel context.CancelFunc) {
+				m.EXPECT().Describe().DoAndReturn(func() (*mockDescribeData, error) {
+					cancel()
+					return &mockDescribeData{data: "completed\n"}, nil
+				})
+			},
+			mockLogs: func(m *mocks.MocklogEventsWriter) {
+				m.EXPECT().WriteLogEvents(gomock.Any()).DoAndReturn(func(opts logging.WriteLogEventsOpts) error {
+					require.Equal(t, aws.Int64(2), opts.Limit)
+					return opts.OnEvents(io.Discard, []logging.HumanJSONStringer{
+						&mockDescribeData{data: "GET /healthcheck 200\n"},
+						&mockDescribeData{data: "GET /orders 500\n"},
+					})
+				})
+			},
+			wantedStatuses: []string{"completed\n"},
+			wantedOutput: "Refreshed at 3:04PM, every 1ms. Press Ctrl+C to exit.\n\ncompleted\n" +
+				"\nRecent Logs\n\nGET /healthcheck 200\nGET /orders 500\n",
+		},
+		"show that the service has no log lines yet": {
+			inAccessible: true,
+			inLogLines:   2,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber, cancel context.CancelFunc) {
+				m.EXPECT().Describe().DoAndReturn(func() (*mockDescribeData, error) {
+					cancel()
+					return &mockDescribeData{data: "completed\n"}, nil
+				})
+			},
+			mockLogs: func(m *mocks.MocklogEventsWriter) {
+				m.EXPECT().WriteLogEvents(gomock.Any()).DoAndReturn(func(opts logging.WriteLogEventsOpts) error {
+					return opts.OnEvents(io.Discard, nil)
+				})
+			},
+			wantedStatuses: []string{"completed\n"},
+			wantedOutput: "Refreshed at 3:04PM, every 1ms. Press Ctrl+C to exit.\n\ncompleted\n" +
+				"\nRecent Logs\n\n  No log lines yet.\n",
+		},
+		"error if failed to get the recent log lines": {
+			inLogLines: 2,
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber, cancel context.CancelFunc) {
+				m.EXPECT().Describe().Return(&mockDescribeData{data: "completed\n"}, nil)
+			},
+			mockLogs: func(m *mocks.MocklogEventsWriter) {
+				m.EXPECT().WriteLogEvents(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: "get the recent logs of service mockSvc: some error",
+		},
+		"stop silently if interrupted while describing": {
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber, cancel context.CancelFunc) {
+				m.EXPECT().Describe().DoAndReturn(func() (*mockDescribeData, error) {
+					cancel()
+					return nil, context.Canceled
+				})
+			},
+		},
+		"error if failed to describe the status": {
+			mockStatusDescriber: func(m *mocks.MockstatusDescriber, cancel context.CancelFunc) {
+				m.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedError: "describe status of service mockSvc: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			color.SetAccessible(tc.inAccessible)
+			defer color.SetAccessible(false)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			mockStatusDescriber := mocks.NewMockstatusDescriber(ctrl)
+			tc.mockStatusDescriber(mockStatusDescriber, cancel)
+			out := &fakeFileWriter{}
+			var logs logEventsWriter
+			if tc.mockLogs != nil {
+				mockLogs := mocks.NewMocklogEventsWriter(ctrl)
+				tc.mockLogs(mockLogs)
+				logs = mockLogs
+			}
+
+			opts := &svcStatusOpts{
+				svcStatusVars: svcStatusVars{
+					svcName:       "mockSvc",
+					envName:       "mockEnv",
+					appName:       "mockApp",
+					watch:         true,
+					watchInterval: time.Millisecond,
+					logLines:      tc.inLogLines,
+				},
+				statusDescriber:     mockStatusDescriber,
+				logs:                logs,
+				initStatusDescriber: func(*svcStatusOpts) error { return nil },
+				watchOut:            out,
+				now: func() time.Time {
+					return time.Date(2023, 6, 1, 15, 4, 0, 0, time.UTC)
+				},
+				interrupted: func() (context.Context, context.CancelFunc) {
+					return ctx, cancel
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			for _, status := range tc.wantedStatuses {
+				require.Contains(t, out.String(), "Refreshed at 3:04PM, every 1ms. Press Ctrl+C to exit.\n\n"+status)
+			}
+			require.Equal(t, len(tc.wantedStatuses), strings.Count(out.String(), "Refreshed at"))
+			if tc.wantedOutput != "" {
+				require.Equal(t, tc.wantedOutput, out.String())
+			}
+		})
+	}
+}
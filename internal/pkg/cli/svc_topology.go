@@ -0,0 +1,172 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcTopologyAppNamePrompt     = "Which application's services would you like to graph?"
+	svcTopologyAppNameHelpPrompt = "An application is a collection of related services."
+	svcTopologyEnvNamePrompt     = "Which environment of %s would you like to graph the services of?"
+	svcTopologyEnvNameHelpPrompt = "The dependencies between services are the Service Connect traffic between them in the environment."
+)
+
+type svcTopologyVars struct {
+	appName          string
+	envName          string
+	shouldOutputJSON bool
+	shouldOutputDOT  bool
+}
+
+type svcTopologyOpts struct {
+	svcTopologyVars
+
+	w                    io.Writer
+	store                store
+	sel                  appEnvSelector
+	newTopologyDescriber func(app, env string) (topologyDescriber, error)
+}
+
+func newSvcTopologyOpts(vars svcTopologyVars) (*svcTopologyOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc topology"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	configStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &svcTopologyOpts{
+		svcTopologyVars: vars,
+		w:               log.OutputWriter,
+		store:           configStore,
+		sel:             selector.NewAppEnvSelector(prompt.New(), configStore),
+		newTopologyDescriber: func(app, env string) (topologyDescriber, error) {
+			return describe.NewServiceTopologyDescriber(&describe.NewServiceTopologyConfig{
+				App:         app,
+				Env:         env,
+				ConfigStore: configStore,
+				DeployStore: deployStore,
+			})
+		},
+	}, nil
+}
+
+// Validate returns an error for any invalid optional flags.
+func (o *svcTopologyOpts) Validate() error {
+	if o.shouldOutputJSON && o.shouldOutputDOT {
+		return fmt.Errorf("--%s cannot be used with --%s", jsonFlag, dotFlag)
+	}
+	return nil
+}
+
+// Ask prompts for and validates any required flags.
+func (o *svcTopologyOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateOrAskEnv()
+}
+
+// Execute writes the graph of the Service Connect dependencies between the services of the environment.
+func (o *svcTopologyOpts) Execute() error {
+	d, err := o.newTopologyDescriber(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("create topology describer for environment %s: %w", o.envName, err)
+	}
+	topology, err := d.Describe()
+	if err != nil {
+		return fmt.Errorf("describe topology of environment %s: %w", o.envName, err)
+	}
+	switch {
+	case o.shouldOutputJSON:
+		data, err := topology.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+	case o.shouldOutputDOT:
+		fmt.Fprint(o.w, topology.DOTString())
+	default:
+		fmt.Fprint(o.w, topology.HumanString())
+	}
+	return nil
+}
+
+func (o *svcTopologyOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return fmt.Errorf("validate application name %q: %v", o.appName, err)
+		}
+		return nil
+	}
+	app, err := o.sel.Application(svcTopologyAppNamePrompt, svcTopologyAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcTopologyOpts) validateOrAskEnv() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return fmt.Errorf("validate environment name %q in application %q: %v", o.envName, o.appName, err)
+		}
+		return nil
+	}
+	env, err := o.sel.Environment(fmt.Sprintf(svcTopologyEnvNamePrompt, color.HighlightUserInput(o.appName)), svcTopologyEnvNameHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment for application %s: %w", o.appName, err)
+	}
+	o.envName = env
+	return nil
+}
+
+// buildSvcTopologyCmd builds the command for showing the dependencies between the services of an environment.
+func buildSvcTopologyCmd() *cobra.Command {
+	vars := svcTopologyVars{}
+	cmd := &cobra.Command{
+		Use:   "topology",
+		Short: "Shows the dependency graph of the services in an environment.",
+		Long: `Shows the dependency graph of the services in an environment.
+The dependencies are the endpoints that each service sent traffic to with Service Connect in the last hours.`,
+
+		Example: `
+  Shows the dependencies between the services of the "test" environment.
+  /code $ copilot svc topology -e test
+  Renders the dependency graph of the "prod" environment as an image with Graphviz.
+  /code $ copilot svc topology -e prod --dot | dot -Tpng -o topology.png`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcTopologyOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputDOT, dotFlag, false, dotFlagDescription)
+	return cmd
+}
@@ -0,0 +1,226 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockGraphData struct {
+	mockDescribeData
+	dot string
+}
+
+func (m *mockGraphData) DOTString() string {
+	return m.dot
+}
+
+type svcTopologyMocks struct {
+	store     *mocks.Mockstore
+	sel       *mocks.MockappEnvSelector
+	describer *mocks.MocktopologyDescriber
+}
+
+func TestSvcTopologyOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inJSON bool
+		inDOT  bool
+
+		wantedError error
+	}{
+		"valid with a single output format": {
+			inDOT: true,
+		},
+		"error if both --json and --dot are set": {
+			inJSON:      true,
+			inDOT:       true,
+			wantedError: errors.New("--json cannot be used with --dot"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcTopologyOpts{
+				svcTopologyVars: svcTopologyVars{
+					shouldOutputJSON: tc.inJSON,
+					shouldOutputDOT:  tc.inDOT,
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcTopologyOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inApp      string
+		inEnv      string
+		setupMocks func(m svcTopologyMocks)
+
+		wantedApp   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"validate the application and environment names": {
+			inApp: "phonetool",
+			inEnv: "test",
+			setupMocks: func(m svcTopologyMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+		},
+		"error if the application does not exist": {
+			inApp: "phonetool",
+			setupMocks: func(m svcTopologyMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New(`validate application name "phonetool": some error`),
+		},
+		"prompt for the application and the environment": {
+			setupMocks: func(m svcTopologyMocks) {
+				m.sel.EXPECT().Application(svcTopologyAppNamePrompt, svcTopologyAppNameHelpPrompt).Return("phonetool", nil)
+				m.sel.EXPECT().Environment(gomock.Any(), svcTopologyEnvNameHelpPrompt, "phonetool").Return("test", nil)
+			},
+			wantedApp: "phonetool",
+			wantedEnv: "test",
+		},
+		"error if fail to select an environment": {
+			inApp: "phonetool",
+			setupMocks: func(m svcTopologyMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.sel.EXPECT().Environment(gomock.Any(), gomock.Any(), "phonetool").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select environment for application phonetool: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcTopologyMocks{
+				store: mocks.NewMockstore(ctrl),
+				sel:   mocks.NewMockappEnvSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcTopologyOpts{
+				svcTopologyVars: svcTopologyVars{
+					appName: tc.inApp,
+					envName: tc.inEnv,
+				},
+				store: m.store,
+				sel:   m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.appName)
+				require.Equal(t, tc.wantedEnv, opts.envName)
+			}
+		})
+	}
+}
+
+func TestSvcTopologyOpts_Execute(t *testing.T) {
+	mockGraph := &mockGraphData{
+		mockDescribeData: mockDescribeData{data: "graph"},
+		dot:              "digraph {}\n",
+	}
+	testCases := map[string]struct {
+		inJSON     bool
+		inDOT      bool
+		setupMocks func(m svcTopologyMocks)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"wrap the error if fail to describe the topology": {
+			setupMocks: func(m svcTopologyMocks) {
+				m.describer.EXPECT().Describe().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe topology of environment test: some error"),
+		},
+		"write the topology in human format": {
+			setupMocks: func(m svcTopologyMocks) {
+				m.describer.EXPECT().Describe().Return(mockGraph, nil)
+			},
+			wantedOutput: "graph",
+		},
+		"write the topology in JSON": {
+			inJSON: true,
+			setupMocks: func(m svcTopologyMocks) {
+				m.describer.EXPECT().Describe().Return(mockGraph, nil)
+			},
+			wantedOutput: "graph",
+		},
+		"write the topology in DOT": {
+			inDOT: true,
+			setupMocks: func(m svcTopologyMocks) {
+				m.describer.EXPECT().Describe().Return(mockGraph, nil)
+			},
+			wantedOutput: "digraph {}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcTopologyMocks{
+				describer: mocks.NewMocktopologyDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+			b := &bytes.Buffer{}
+			opts := &svcTopologyOpts{
+				svcTopologyVars: svcTopologyVars{
+					appName:          "phonetool",
+					envName:          "test",
+					shouldOutputJSON: tc.inJSON,
+					shouldOutputDOT:  tc.inDOT,
+				},
+				w: b,
+				newTopologyDescriber: func(app, env string) (topologyDescriber, error) {
+					require.Equal(t, "phonetool", app)
+					require.Equal(t, "test", env)
+					return m.describer, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOutput, b.String())
+			}
+		})
+	}
+}
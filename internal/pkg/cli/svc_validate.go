@@ -0,0 +1,177 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	svcValidateNamePrompt = "Which service's manifest would you like to validate?"
+	jobValidateNamePrompt = "Which job's manifest would you like to validate?"
+)
+
+type validateWkldVars struct {
+	appName    string
+	name       string
+	envName    string
+	showSchema bool
+}
+
+// validateWkldOpts validates the manifest of a service or a job in the workspace.
+type validateWkldOpts struct {
+	validateWkldVars
+
+	// Type of the workload to validate, either "service" or "job".
+	wkldKind string
+
+	ws  wsWorkloadManifestReader
+	sel workspaceSelector
+	w   io.Writer
+}
+
+func newValidateWkldOpts(vars validateWkldVars, wkldKind string) (*validateWkldOpts, error) {
+	ws, err := workspace.Use(afero.NewOsFs())
+	if err != nil {
+		return nil, err
+	}
+	return &validateWkldOpts{
+		validateWkldVars: vars,
+		wkldKind:         wkldKind,
+		ws:               ws,
+		sel:              selector.NewWorkspaceSelector(prompt.New(), ws),
+		w:                os.Stdout,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *validateWkldOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.name == "" {
+		return nil
+	}
+	names, err := o.listWorkloads()
+	if err != nil {
+		return fmt.Errorf("list %ss in the workspace: %w", o.wkldKind, err)
+	}
+	if !contains(o.name, names) {
+		return fmt.Errorf("%s %q does not exist in the workspace", o.wkldKind, o.name)
+	}
+	return nil
+}
+
+// Ask prompts the user for any missing required fields.
+func (o *validateWkldOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	var name string
+	var err error
+	if o.wkldKind == "job" {
+		name, err = o.sel.Job(jobValidateNamePrompt, "")
+	} else {
+		name, err = o.sel.Service(svcValidateNamePrompt, "")
+	}
+	if err != nil {
+		return fmt.Errorf("select %s: %w", o.wkldKind, err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute validates the manifest of the workload, or prints its JSON Schema.
+func (o *validateWkldOpts) Execute() error {
+	raw, err := o.ws.ReadWorkloadManifest(o.name)
+	if err != nil {
+		return fmt.Errorf("read manifest file for %s %s: %w", o.wkldKind, o.name, err)
+	}
+	if o.showSchema {
+		return o.printSchema(raw)
+	}
+	problems := manifest.CheckWorkload(raw, o.appName, o.envName)
+	return reportManifestProblems(problems, fmt.Sprintf("%s %s", o.wkldKind, o.name))
+}
+
+func (o *validateWkldOpts) listWorkloads() ([]string, error) {
+	if o.wkldKind == "job" {
+		return o.ws.ListJobs()
+	}
+	return o.ws.ListServices()
+}
+
+func (o *validateWkldOpts) printSchema(raw []byte) error {
+	var mft struct {
+		Type string `yaml:"type"`
+	}
+	if err := yaml.Unmarshal(raw, &mft); err != nil {
+		return fmt.Errorf("unmarshal manifest for %s %s: %w", o.wkldKind, o.name, err)
+	}
+	schema, err := manifest.WorkloadJSONSchema(mft.Type)
+	if err != nil {
+		return fmt.Errorf("generate JSON Schema for %s %s: %w", o.wkldKind, o.name, err)
+	}
+	fmt.Fprintln(o.w, string(schema))
+	return nil
+}
+
+// reportManifestProblems logs each problem found in a manifest, and returns an error if there is any.
+func reportManifestProblems(problems []*manifest.ValidationError, subject string) error {
+	if len(problems) == 0 {
+		log.Successf("Manifest for %s is valid.\n", color.HighlightUserInput(subject))
+		return nil
+	}
+	for _, problem := range problems {
+		log.Errorln(problem.Error())
+	}
+	return fmt.Errorf("found %s in the manifest for %s", english.Plural(len(problems), "problem", "problems"), subject)
+}
+
+// buildSvcValidateCmd builds the command for validating the manifest of a service.
+func buildSvcValidateCmd() *cobra.Command {
+	vars := validateWkldVars{}
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the manifest of a service without deploying it.",
+		Long: `Validate the manifest of a service without deploying it.
+Reports every unknown field, type mismatch and invalid configuration with its line, without calling any AWS APIs.`,
+		Example: `
+  Validate the manifest of service "frontend", including the overrides of every environment.
+  /code $ copilot svc validate -n frontend
+  Validate the manifest of service "frontend" as it would be deployed to the "test" environment.
+  /code $ copilot svc validate -n frontend -e test
+  Save the JSON Schema of the manifest of service "frontend" for editor autocompletion.
+  /code $ copilot svc validate -n frontend --schema > frontend.schema.json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newValidateWkldOpts(vars, "service")
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	addValidateWkldFlags(cmd, &vars, svcFlagDescription)
+	return cmd
+}
+
+func addValidateWkldFlags(cmd *cobra.Command, vars *validateWkldVars, nameDescription string) {
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", nameDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", validateEnvFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.showSchema, schemaFlag, false, schemaFlagDescription)
+}
@@ -0,0 +1,249 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type validateWkldMocks struct {
+	ws  *mocks.MockwsWorkloadManifestReader
+	sel *mocks.MockworkspaceSelector
+}
+
+func TestValidateWkldOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		inName     string
+		inKind     string
+		setupMocks func(m validateWkldMocks)
+
+		wantedErr error
+	}{
+		"should return errNoAppInWorkspace if there is no application": {
+			inKind:     "service",
+			setupMocks: func(m validateWkldMocks) {},
+			wantedErr:  errNoAppInWorkspace,
+		},
+		"should return a wrapped error if the services cannot be listed": {
+			inAppName: "phonetool",
+			inName:    "frontend",
+			inKind:    "service",
+			setupMocks: func(m validateWkldMocks) {
+				m.ws.EXPECT().ListServices().Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list services in the workspace: some error"),
+		},
+		"should return an error if the job does not exist in the workspace": {
+			inAppName: "phonetool",
+			inName:    "frontend",
+			inKind:    "job",
+			setupMocks: func(m validateWkldMocks) {
+				m.ws.EXPECT().ListJobs().Return([]string{"report"}, nil)
+			},
+			wantedErr: errors.New(`job "frontend" does not exist in the workspace`),
+		},
+		"should succeed if the service exists in the workspace": {
+			inAppName: "phonetool",
+			inName:    "frontend",
+			inKind:    "service",
+			setupMocks: func(m validateWkldMocks) {
+				m.ws.EXPECT().ListServices().Return([]string{"frontend"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := validateWkldMocks{
+				ws: mocks.NewMockwsWorkloadManifestReader(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &validateWkldOpts{
+				validateWkldVars: validateWkldVars{
+					appName: tc.inAppName,
+					name:    tc.inName,
+				},
+				wkldKind: tc.inKind,
+				ws:       m.ws,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateWkldOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		inKind     string
+		setupMocks func(m validateWkldMocks)
+
+		wantedName string
+		wantedErr  error
+	}{
+		"should not prompt if the name is provided": {
+			inName:     "frontend",
+			inKind:     "service",
+			setupMocks: func(m validateWkldMocks) {},
+			wantedName: "frontend",
+		},
+		"should prompt for a job in the workspace": {
+			inKind: "job",
+			setupMocks: func(m validateWkldMocks) {
+				m.sel.EXPECT().Job(jobValidateNamePrompt, "").Return("report", nil)
+			},
+			wantedName: "report",
+		},
+		"should return a wrapped error if the service cannot be selected": {
+			inKind: "service",
+			setupMocks: func(m validateWkldMocks) {
+				m.sel.EXPECT().Service(svcValidateNamePrompt, "").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("select service: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := validateWkldMocks{
+				sel: mocks.NewMockworkspaceSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &validateWkldOpts{
+				validateWkldVars: validateWkldVars{
+					name: tc.inName,
+				},
+				wkldKind: tc.inKind,
+				sel:      m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedName, opts.name)
+		})
+	}
+}
+
+func TestValidateWkldOpts_Execute(t *testing.T) {
+	const validMft = `name: frontend
+type: Backend Service
+image:
+  location: nginx
+  port: 80
+`
+	testCases := map[string]struct {
+		inEnvName    string
+		inShowSchema bool
+		setupMocks   func(m validateWkldMocks)
+
+		wantedSchema bool
+		wantedErr    error
+	}{
+		"should return a wrapped error if the manifest cannot be read": {
+			setupMocks: func(m validateWkldMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("read manifest file for service frontend: some error"),
+		},
+		"should return the number of problems found in the manifest": {
+			setupMocks: func(m validateWkldMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(`name: frontend
+type: Backend Service
+image:
+  location: nginx
+  prot: 80
+cpu: many
+`), nil)
+			},
+			wantedErr: errors.New("found 2 problems in the manifest for service frontend"),
+		},
+		"should succeed if the manifest is valid for the environment": {
+			inEnvName: "test",
+			setupMocks: func(m validateWkldMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(validMft), nil)
+			},
+		},
+		"should print the JSON Schema of the manifest type": {
+			inShowSchema: true,
+			setupMocks: func(m validateWkldMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte(validMft), nil)
+			},
+			wantedSchema: true,
+		},
+		"should return a wrapped error if the manifest type is invalid": {
+			inShowSchema: true,
+			setupMocks: func(m validateWkldMocks) {
+				m.ws.EXPECT().ReadWorkloadManifest("frontend").Return([]byte("name: frontend\ntype: Frontend Service\n"), nil)
+			},
+			wantedErr: errors.New("generate JSON Schema for service frontend: invalid manifest type: Frontend Service"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := validateWkldMocks{
+				ws: mocks.NewMockwsWorkloadManifestReader(ctrl),
+			}
+			tc.setupMocks(m)
+			buf := new(bytes.Buffer)
+			opts := &validateWkldOpts{
+				validateWkldVars: validateWkldVars{
+					appName:    "phonetool",
+					name:       "frontend",
+					envName:    tc.inEnvName,
+					showSchema: tc.inShowSchema,
+				},
+				wkldKind: "service",
+				ws:       m.ws,
+				w:        buf,
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantedSchema {
+				require.Contains(t, buf.String(), `"title": "Backend Service manifest"`)
+			} else {
+				require.Empty(t, buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	awsssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcVerifyAppNamePrompt  = "Which application is the service in?"
+	svcVerifyNamePrompt     = "Which service of %s would you like to verify?"
+	svcVerifyNameHelpPrompt = "The signatures of the images run by the tasks of the service are verified."
+)
+
+type verifySvcVars struct {
+	appName string
+	name    string
+	envName string
+}
+
+type verifySvcOpts struct {
+	verifySvcVars
+
+	w                      io.Writer
+	store                  store
+	sel                    deploySelector
+	newSvcDescriber        func(env *config.Environment) (serviceDescriber, error)
+	newProvenanceGetter    func(env *config.Environment) (provenanceGetter, error)
+	newImageVerifier       func(env *config.Environment) imageVerifier
+	provenancePerContainer map[string]*clideploy.ImageProvenance // Cached provenance of the images.
+}
+
+func newVerifySvcOpts(vars verifySvcVars) (*verifySvcOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("svc verify"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, store)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	return &verifySvcOpts{
+		verifySvcVars: vars,
+		w:             log.OutputWriter,
+		store:         store,
+		sel:           selector.NewDeploySelect(prompt.New(), store, deployStore),
+		newSvcDescriber: func(env *config.Environment) (serviceDescriber, error) {
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return ecs.New(sess), nil
+		},
+		newProvenanceGetter: func(env *config.Environment) (provenanceGetter, error) {
+			// Provenance is recorded next to the images, in the application account.
+			sess, err := sessProvider.DefaultWithRegion(env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create default session with region %s: %w", env.Region, err)
+			}
+			return awsssm.New(sess), nil
+		},
+		newImageVerifier: func(env *config.Environment) imageVerifier {
+			return exec.NewCosignCommand(env.Region)
+		},
+		provenancePerContainer: make(map[string]*clideploy.ImageProvenance),
+	}, nil
+}
+
+// Validate returns an error if any optional flags are invalid.
+func (o *verifySvcOpts) Validate() error {
+	return nil
+}
+
+// Ask validates required fields that users passed in, otherwise it prompts for them.
+func (o *verifySvcOpts) Ask() error {
+	if err := o.validateOrAskApp(); err != nil {
+		return err
+	}
+	return o.validateOrAskSvcEnvName()
+}
+
+// Execute verifies the signature of every image run by the tasks of the service.
+func (o *verifySvcOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return fmt.Errorf("get environment %s configuration: %w", o.envName, err)
+	}
+	describer, err := o.newSvcDescriber(env)
+	if err != nil {
+		return err
+	}
+	provenance, err := o.newProvenanceGetter(env)
+	if err != nil {
+		return err
+	}
+	verifier := o.newImageVerifier(env)
+
+	svcDesc, err := describer.DescribeService(o.appName, o.envName, o.name)
+	if err != nil {
+		return fmt.Errorf("describe ECS service for %s in environment %s: %w", o.name, o.envName, err)
+	}
+	tasks := awsecs.FilterRunningTasks(svcDesc.Tasks)
+	if len(tasks) == 0 {
+		return fmt.Errorf("found no running task for service %s in environment %s", o.name, o.envName)
+	}
+	var failed, verified int
+	for _, task := range tasks {
+		for _, container := range task.Containers {
+			digest := aws.StringValue(container.ImageDigest)
+			if digest == "" {
+				continue
+			}
+			name := aws.StringValue(container.Name)
+			if err := o.verifyImage(provenance, verifier, name, digest); err != nil {
+				failed++
+				fmt.Fprintf(o.w, "%s Task %s, container %s: %v\n", color.Red.Sprint("✘"), task.String(), color.HighlightUserInput(name), err)
+				continue
+			}
+			verified++
+			fmt.Fprintf(o.w, "%s Task %s, container %s: verified image %s\n", color.Green.Sprint("✔"), task.String(), color.HighlightUserInput(name), digest)
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d images of service %s in environment %s failed verification", failed, failed+verified, o.name, o.envName)
+	}
+	return nil
+}
+
+// verifyImage checks that the running image is the one recorded during the last deployment and that its signature is valid.
+func (o *verifySvcOpts) verifyImage(getter provenanceGetter, verifier imageVerifier, container, digest string) error {
+	provenance, err := o.provenance(getter, container)
+	if err != nil {
+		return err
+	}
+	if provenance.Digest != digest {
+		return fmt.Errorf("running image %s does not match the image %s signed during the last deployment", digest, provenance.Digest)
+	}
+	return verifier.Verify(fmt.Sprintf("%s@%s", provenance.Image, digest), provenance.Signature.KMSKey)
+}
+
+func (o *verifySvcOpts) provenance(getter provenanceGetter, container string) (*clideploy.ImageProvenance, error) {
+	if provenance, ok := o.provenancePerContainer[container]; ok {
+		return provenance, nil
+	}
+	raw, err := getter.GetSecretValue(clideploy.ProvenanceParameterName(o.appName, o.envName, o.name, container))
+	if err != nil {
+		return nil, fmt.Errorf("get provenance recorded when the image was signed: %w", err)
+	}
+	var provenance clideploy.ImageProvenance
+	if err := json.Unmarshal([]byte(raw), &provenance); err != nil {
+		return nil, fmt.Errorf("unmarshal provenance: %w", err)
+	}
+	o.provenancePerContainer[container] = &provenance
+	return &provenance, nil
+}
+
+func (o *verifySvcOpts) validateOrAskApp() error {
+	if o.appName != "" {
+		_, err := o.store.GetApplication(o.appName)
+		return err
+	}
+	app, err := o.sel.Application(svcVerifyAppNamePrompt, wkldAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *verifySvcOpts) validateOrAskSvcEnvName() error {
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	if o.name != "" {
+		if _, err := o.store.GetService(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	deployedService, err := o.sel.DeployedService(
+		fmt.Sprintf(svcVerifyNamePrompt, color.HighlightUserInput(o.appName)),
+		svcVerifyNameHelpPrompt,
+		o.appName,
+		selector.WithEnv(o.envName),
+		selector.WithName(o.name),
+	)
+	if err != nil {
+		return fmt.Errorf("select deployed services for application %s: %w", o.appName, err)
+	}
+	o.name = deployedService.Name
+	o.envName = deployedService.Env
+	return nil
+}
+
+// buildSvcVerifyCmd builds the command for verifying the images of a deployed service.
+func buildSvcVerifyCmd() *cobra.Command {
+	vars := verifySvcVars{}
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verifies the signatures of the images run by a deployed service.",
+		Long: `Verifies the signatures of the images run by a deployed service.
+Checks that every running task uses the image signed during the last deployment and verifies its signature with cosign.
+Exits with code 1 if any image fails verification.`,
+
+		Example: `
+  Verify the images of the service "my-svc" in the "prod" environment.
+  /code $ copilot svc verify -n my-svc -e prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newVerifySvcOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	return cmd
+}
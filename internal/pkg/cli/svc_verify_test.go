@@ -0,0 +1,150 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	clideploy "github.com/aws/copilot-cli/internal/pkg/cli/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type verifySvcMocks struct {
+	store      *mocks.Mockstore
+	describer  *mocks.MockserviceDescriber
+	provenance *mocks.MockprovenanceGetter
+	verifier   *mocks.MockimageVerifier
+}
+
+func TestVerifySvcOpts_Execute(t *testing.T) {
+	const (
+		mockRepo       = "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api"
+		mockParamName  = "/copilot/phonetool/test/provenance/api/api"
+		mockProvenance = `{"image":"123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api","digest":"sha256:abc","signature":{"kmsKey":"alias/signing"}}`
+	)
+	runningTask := func(digest string) *awsecs.Task {
+		return &awsecs.Task{
+			TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789012:task/phonetool-test-Cluster/4082490ee6c245e09d2145010aa1ba8d"),
+			LastStatus: aws.String("RUNNING"),
+			Containers: []*sdkecs.Container{
+				{
+					Name:        aws.String("api"),
+					Image:       aws.String(mockRepo + ":latest"),
+					ImageDigest: aws.String(digest),
+				},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		setupMocks func(m verifySvcMocks)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"error if there is no running task": {
+			setupMocks: func(m verifySvcMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.describer.EXPECT().DescribeService("phonetool", "test", "api").Return(&ecs.ServiceDesc{}, nil)
+			},
+			wantedError: errors.New("found no running task for service api in environment test"),
+		},
+		"verify the signature of the running image with the recorded key": {
+			setupMocks: func(m verifySvcMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.describer.EXPECT().DescribeService("phonetool", "test", "api").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{runningTask("sha256:abc"), runningTask("sha256:abc")},
+				}, nil)
+				m.provenance.EXPECT().GetSecretValue(mockParamName).Return(mockProvenance, nil).Times(1)
+				m.verifier.EXPECT().Verify(mockRepo+"@sha256:abc", "alias/signing").Return(nil).Times(2)
+			},
+			wantedOutput: "container api: verified image sha256:abc",
+		},
+		"error if the running image is not the signed image": {
+			setupMocks: func(m verifySvcMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.describer.EXPECT().DescribeService("phonetool", "test", "api").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{runningTask("sha256:def")},
+				}, nil)
+				m.provenance.EXPECT().GetSecretValue(mockParamName).Return(mockProvenance, nil)
+			},
+			wantedOutput: "running image sha256:def does not match the image sha256:abc signed during the last deployment",
+			wantedError:  errors.New("1 of 1 images of service api in environment test failed verification"),
+		},
+		"error if the signature is invalid": {
+			setupMocks: func(m verifySvcMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.describer.EXPECT().DescribeService("phonetool", "test", "api").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{runningTask("sha256:abc")},
+				}, nil)
+				m.provenance.EXPECT().GetSecretValue(mockParamName).Return(mockProvenance, nil)
+				m.verifier.EXPECT().Verify(gomock.Any(), gomock.Any()).Return(errors.New("no matching signatures"))
+			},
+			wantedOutput: "no matching signatures",
+			wantedError:  errors.New("1 of 1 images of service api in environment test failed verification"),
+		},
+		"error if no provenance was recorded": {
+			setupMocks: func(m verifySvcMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+				m.describer.EXPECT().DescribeService("phonetool", "test", "api").Return(&ecs.ServiceDesc{
+					Tasks: []*awsecs.Task{runningTask("sha256:abc")},
+				}, nil)
+				m.provenance.EXPECT().GetSecretValue(mockParamName).Return("", errors.New("some error"))
+			},
+			wantedOutput: "get provenance recorded when the image was signed: some error",
+			wantedError:  errors.New("1 of 1 images of service api in environment test failed verification"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := verifySvcMocks{
+				store:      mocks.NewMockstore(ctrl),
+				describer:  mocks.NewMockserviceDescriber(ctrl),
+				provenance: mocks.NewMockprovenanceGetter(ctrl),
+				verifier:   mocks.NewMockimageVerifier(ctrl),
+			}
+			tc.setupMocks(m)
+			out := &bytes.Buffer{}
+			opts := &verifySvcOpts{
+				verifySvcVars: verifySvcVars{
+					appName: "phonetool",
+					envName: "test",
+					name:    "api",
+				},
+				w:     out,
+				store: m.store,
+				newSvcDescriber: func(env *config.Environment) (serviceDescriber, error) {
+					return m.describer, nil
+				},
+				newProvenanceGetter: func(env *config.Environment) (provenanceGetter, error) {
+					return m.provenance, nil
+				},
+				newImageVerifier: func(env *config.Environment) imageVerifier {
+					return m.verifier
+				},
+				provenancePerContainer: make(map[string]*clideploy.ImageProvenance),
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Contains(t, out.String(), tc.wantedOutput)
+		})
+	}
+}
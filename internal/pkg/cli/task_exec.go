@@ -137,6 +137,12 @@ func (o *taskExecOpts) Execute() error {
 		return err
 	}
 	cluster, container := aws.StringValue(o.task.ClusterArn), aws.StringValue(o.task.Containers[0].Name)
+	if o.containerName != "" {
+		if err := validateExecContainer(o.task, o.containerName); err != nil {
+			return err
+		}
+		container = o.containerName
+	}
 	taskID, err := awsecs.TaskID(aws.StringValue(o.task.TaskArn))
 	if err != nil {
 		return fmt.Errorf("parse task ARN %s: %w", aws.StringValue(o.task.TaskArn), err)
@@ -159,8 +165,7 @@ func (o *taskExecOpts) selectTaskInDefaultCluster() error {
 	if err != nil {
 		return fmt.Errorf("create default session: %w", err)
 	}
-	task, err := o.newTaskSel(sess).RunningTask(taskExecTaskPrompt, taskExecTaskHelpPrompt,
-		selector.WithDefault(), selector.WithTaskGroup(o.name), selector.WithTaskID(o.taskID))
+	task, err := o.newTaskSel(sess).RunningTask(taskExecTaskPrompt, taskExecTaskHelpPrompt, o.taskSelectorOpts(selector.WithDefault())...)
 	if err != nil {
 		return fmt.Errorf("select running task in default cluster: %w", err)
 	}
@@ -177,8 +182,7 @@ func (o *taskExecOpts) selectTaskInAppEnvCluster() error {
 	if err != nil {
 		return fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
 	}
-	task, err := o.newTaskSel(sess).RunningTask(taskExecTaskPrompt, taskExecTaskHelpPrompt,
-		selector.WithAppEnv(o.appName, o.envName), selector.WithTaskGroup(o.name), selector.WithTaskID(o.taskID))
+	task, err := o.newTaskSel(sess).RunningTask(taskExecTaskPrompt, taskExecTaskHelpPrompt, o.taskSelectorOpts(selector.WithAppEnv(o.appName, o.envName))...)
 	if err != nil {
 		return fmt.Errorf("select running task in environment %s: %w", o.envName, err)
 	}
@@ -186,6 +190,16 @@ func (o *taskExecOpts) selectTaskInAppEnvCluster() error {
 	return nil
 }
 
+// taskSelectorOpts returns the options to select a running task in the cluster.
+// A task ID can target any running task, such as a task of a service, while otherwise only one-off tasks can be selected.
+func (o *taskExecOpts) taskSelectorOpts(cluster selector.TaskOpts) []selector.TaskOpts {
+	opts := []selector.TaskOpts{cluster, selector.WithTaskGroup(o.name), selector.WithTaskID(o.taskID)}
+	if o.taskID != "" {
+		opts = append(opts, selector.WithAllTasks())
+	}
+	return opts
+}
+
 func (o *taskExecOpts) configSession() (*session.Session, error) {
 	if o.useDefault {
 		return o.provider.Default()
@@ -210,7 +224,9 @@ func buildTaskExecCmd() *cobra.Command {
   Runs the 'cat progress.csv' command in the task prefixed with ID "1848c38" part of the "db-migrate" task group.
   /code $ copilot task exec --name db-migrate --task-id 1848c38 --command "cat progress.csv"
   Start an interactive bash session with a task prefixed with ID "38c3818" in the default cluster.
-  /code $ copilot task exec --default --task-id 38c3818`,
+  /code $ copilot task exec --default --task-id 38c3818
+  Start a shell in the "envoy" sidecar of any running task in the "test" environment, such as a task listed by "copilot task ls".
+  /code $ copilot task exec -e test --task-id 8ab5c3e1 --container envoy`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newTaskExecOpts(vars)
 			if err != nil {
@@ -229,7 +245,8 @@ func buildTaskExecCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", nameFlagDescription)
 	cmd.Flags().StringVarP(&vars.command, commandFlag, commandFlagShort, defaultCommand, execCommandFlagDescription)
-	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", taskExecTaskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", containerFlagDescription)
 	cmd.Flags().BoolVar(&vars.useDefault, taskDefaultFlag, false, taskExecDefaultFlagDescription)
 	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
 
@@ -310,13 +310,17 @@ func TestTaskExec_Execute(t *testing.T) {
 			{
 				Name: aws.String(mockContainerName),
 			},
+			{
+				Name: aws.String("envoy"),
+			},
 		},
 	}
 	mockErr := errors.New("some error")
 	testCases := map[string]struct {
-		inTask       *ecs.Task
-		inUseDefault bool
-		setupMocks   func(mocks execTaskMocks)
+		inTask          *ecs.Task
+		inUseDefault    bool
+		inContainerName string
+		setupMocks      func(mocks execTaskMocks)
 
 		wantedError error
 	}{
@@ -359,6 +363,30 @@ func TestTaskExec_Execute(t *testing.T) {
 
 			wantedError: fmt.Errorf("execute command mockCommand in container mockContainerName: some error"),
 		},
+		"should error if the container is not in the task": {
+			inTask:          mockTask,
+			inUseDefault:    true,
+			inContainerName: "nginx",
+			setupMocks: func(m execTaskMocks) {
+				m.provider.EXPECT().Default()
+			},
+
+			wantedError: fmt.Errorf("container nginx not found in task 4082490ee6c245e09d2145010aa1ba8d, the task runs containers: mockContainerName, envoy"),
+		},
+		"execute the command in the requested container": {
+			inTask:          mockTask,
+			inUseDefault:    true,
+			inContainerName: "envoy",
+			setupMocks: func(m execTaskMocks) {
+				m.provider.EXPECT().Default()
+				m.commandExec.EXPECT().ExecuteCommand(ecs.ExecuteCommandInput{
+					Cluster:   mockClusterARN,
+					Command:   mockCommand,
+					Container: "envoy",
+					Task:      mockTaskID,
+				}).Return(nil)
+			},
+		},
 		"success": {
 			inTask: mockTask,
 			setupMocks: func(m execTaskMocks) {
@@ -395,9 +423,10 @@ func TestTaskExec_Execute(t *testing.T) {
 			execTasks := &taskExecOpts{
 				taskExecVars: taskExecVars{
 					execVars: execVars{
-						appName: mockApp,
-						envName: mockEnv,
-						command: mockCommand,
+						appName:       mockApp,
+						envName:       mockEnv,
+						command:       mockCommand,
+						containerName: tc.inContainerName,
 					},
 					useDefault: tc.inUseDefault,
 				},
@@ -0,0 +1,235 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+)
+
+const (
+	taskListAppNamePrompt     = "Which application's running tasks would you like to list?"
+	taskListAppNameHelpPrompt = "An application groups the environments that your services and tasks run in."
+	taskListEnvNamePrompt     = "Which environment's running tasks would you like to list?"
+	taskListEnvNameHelpPrompt = "Tasks are listed from the cluster of the environment, including the tasks of services and jobs."
+
+	fmtTaskListOneOffGroup = "task/%s"
+)
+
+type listTaskVars struct {
+	appName          string
+	envName          string
+	shouldOutputJSON bool
+}
+
+type listTaskOpts struct {
+	listTaskVars
+
+	store store
+	sel   appEnvSelector
+	w     io.Writer
+	now   func() time.Time
+
+	// lister is initialized with the environment manager role.
+	lister activeTaskLister
+	// initTaskLister is overridden in tests.
+	initTaskLister func(*listTaskOpts) error
+}
+
+func newListTaskOpts(vars listTaskVars) (*listTaskOpts, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("task ls"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %v", err)
+	}
+	store := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	return &listTaskOpts{
+		listTaskVars: vars,
+		store:        store,
+		sel:          selector.NewAppEnvSelector(prompt.New(), store),
+		w:            os.Stdout,
+		now:          time.Now,
+		initTaskLister: func(o *listTaskOpts) error {
+			env, err := o.store.GetEnvironment(o.appName, o.envName)
+			if err != nil {
+				return fmt.Errorf("get environment %s: %w", o.envName, err)
+			}
+			sess, err := sessProvider.FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			o.lister = ecs.New(sess)
+			return nil
+		},
+	}, nil
+}
+
+// Validate is a no-op for this command.
+func (o *listTaskOpts) Validate() error {
+	return nil
+}
+
+// Ask validates the application and environment names if passed in, otherwise it prompts for them.
+func (o *listTaskOpts) Ask() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+	} else {
+		app, err := o.sel.Application(taskListAppNamePrompt, taskListAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.envName != "" {
+		_, err := o.store.GetEnvironment(o.appName, o.envName)
+		return err
+	}
+	env, err := o.sel.Environment(taskListEnvNamePrompt, taskListEnvNameHelpPrompt, o.appName)
+	if err != nil {
+		return fmt.Errorf("select environment: %w", err)
+	}
+	o.envName = env
+	return nil
+}
+
+// Execute writes the running tasks of the environment, whether they belong to a service, a job or a one-off task.
+func (o *listTaskOpts) Execute() error {
+	if err := o.initTaskLister(o); err != nil {
+		return err
+	}
+	tasks, err := o.lister.ListActiveAppEnvTasks(ecs.ListActiveAppEnvTasksOpts{
+		App: o.appName,
+		Env: o.envName,
+	})
+	if err != nil {
+		return fmt.Errorf("list running tasks in environment %s: %w", o.envName, err)
+	}
+	running := make([]runningTask, len(tasks))
+	for i, task := range tasks {
+		running[i] = newRunningTask(task)
+	}
+	if o.shouldOutputJSON {
+		b, err := json.Marshal(struct {
+			Tasks []runningTask `json:"tasks"`
+		}{Tasks: running})
+		if err != nil {
+			return fmt.Errorf("marshal running tasks: %w", err)
+		}
+		fmt.Fprintf(o.w, "%s\n", b)
+		return nil
+	}
+	if len(running) == 0 {
+		log.Infof("No tasks are running in environment %s.\n", color.HighlightUserInput(o.envName))
+		return nil
+	}
+	rows := make([][]string, len(running))
+	for i, task := range running {
+		started := "-"
+		if !task.StartedAt.IsZero() {
+			started = humanize.RelTime(task.StartedAt, o.now(), "ago", "from now")
+		}
+		rows[i] = []string{task.ID, task.Group, task.LastStatus, dashIfEmpty(task.Health), dashIfEmpty(task.PrivateIP), dashIfEmpty(task.AvailabilityZone), started}
+	}
+	writeTable(o.w, []string{"Task ID", "Group", "Status", "Health", "Private IP", "Availability Zone", "Started"}, rows)
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *listTaskOpts) RecommendActions() error {
+	if o.shouldOutputJSON {
+		return nil
+	}
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to start a shell in one of the tasks.",
+			color.HighlightCode(fmt.Sprintf("copilot task exec -e %s --task-id <task ID>", o.envName))),
+	})
+	return nil
+}
+
+// runningTask is a task running in the cluster of an environment.
+type runningTask struct {
+	ID               string    `json:"id"`
+	Group            string    `json:"group"` // The name of the service or job, or "task/<name>" for one-off tasks.
+	LastStatus       string    `json:"lastStatus"`
+	Health           string    `json:"health"`
+	PrivateIP        string    `json:"privateIP"`
+	AvailabilityZone string    `json:"availabilityZone"`
+	StartedAt        time.Time `json:"startedAt"`
+}
+
+func newRunningTask(task *awsecs.Task) runningTask {
+	id, _ := awsecs.TaskID(aws.StringValue(task.TaskArn))
+	ip, _ := task.PrivateIP() // Tasks that are still provisioning don't have an IP address yet.
+	running := runningTask{
+		ID:               id,
+		Group:            aws.StringValue(task.Group),
+		LastStatus:       aws.StringValue(task.LastStatus),
+		Health:           aws.StringValue(task.HealthStatus),
+		PrivateIP:        ip,
+		AvailabilityZone: aws.StringValue(task.AvailabilityZone),
+		StartedAt:        aws.TimeValue(task.StartedAt),
+	}
+	for _, tag := range task.Tags {
+		switch aws.StringValue(tag.Key) {
+		case deploy.ServiceTagKey:
+			running.Group = aws.StringValue(tag.Value)
+		case deploy.TaskTagKey:
+			running.Group = fmt.Sprintf(fmtTaskListOneOffGroup, aws.StringValue(tag.Value))
+		}
+	}
+	return running
+}
+
+func dashIfEmpty(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// buildTaskListCmd builds the command to list the running tasks of an environment.
+func buildTaskListCmd() *cobra.Command {
+	vars := listTaskVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the running tasks of an environment.",
+		Long: `Lists the running tasks of an environment.
+The tasks of services and jobs are listed along with one-off tasks, with their private IP address,
+availability zone and health status.`,
+		Example: `
+  Lists the running tasks in the "test" environment.
+  /code $ copilot task ls -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newListTaskOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,197 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTaskOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		inEnvName  string
+		setupMocks func(store *mocks.Mockstore, sel *mocks.MockappEnvSelector)
+
+		wantedAppName string
+		wantedEnvName string
+		wantedError   error
+	}{
+		"prompt for the application and the environment": {
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockappEnvSelector) {
+				sel.EXPECT().Application(taskListAppNamePrompt, taskListAppNameHelpPrompt).Return("phonetool", nil)
+				sel.EXPECT().Environment(taskListEnvNamePrompt, taskListEnvNameHelpPrompt, "phonetool").Return("test", nil)
+			},
+			wantedAppName: "phonetool",
+			wantedEnvName: "test",
+		},
+		"validate the application and the environment": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockappEnvSelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+			},
+			wantedAppName: "phonetool",
+			wantedEnvName: "test",
+		},
+		"wrap the error from selecting the environment": {
+			inAppName: "phonetool",
+			setupMocks: func(store *mocks.Mockstore, sel *mocks.MockappEnvSelector) {
+				store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				sel.EXPECT().Environment(gomock.Any(), gomock.Any(), "phonetool").Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("select environment: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := mocks.NewMockstore(ctrl)
+			sel := mocks.NewMockappEnvSelector(ctrl)
+			tc.setupMocks(store, sel)
+			opts := &listTaskOpts{
+				listTaskVars: listTaskVars{
+					appName: tc.inAppName,
+					envName: tc.inEnvName,
+				},
+				store: store,
+				sel:   sel,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedAppName, opts.appName)
+			require.Equal(t, tc.wantedEnvName, opts.envName)
+		})
+	}
+}
+
+func TestListTaskOpts_Execute(t *testing.T) {
+	now := time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)
+	startedAt := now.Add(-2 * time.Hour)
+	tasks := []*awsecs.Task{
+		{
+			TaskArn:          aws.String("arn:aws:ecs:us-west-2:123456789:task/phonetool-test-Cluster/4082490ee6c245e09d2145010aa1ba8d"),
+			Group:            aws.String("service:phonetool-test-api-Service-1A2B3C"),
+			LastStatus:       aws.String("RUNNING"),
+			HealthStatus:     aws.String("HEALTHY"),
+			AvailabilityZone: aws.String("us-west-2a"),
+			StartedAt:        &startedAt,
+			Attachments: []*ecsapi.Attachment{
+				{
+					Type: aws.String("ElasticNetworkInterface"),
+					Details: []*ecsapi.KeyValuePair{
+						{
+							Name:  aws.String("privateIPv4Address"),
+							Value: aws.String("10.0.0.12"),
+						},
+					},
+				},
+			},
+			Tags: []*ecsapi.Tag{
+				{
+					Key:   aws.String("copilot-service"),
+					Value: aws.String("api"),
+				},
+			},
+		},
+		{
+			TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789:task/phonetool-test-Cluster/1848c38a0f5c4c47a5e7d6e1e3c8b2a1"),
+			Group:      aws.String("family:copilot-db-migrate"),
+			LastStatus: aws.String("PROVISIONING"),
+			Tags: []*ecsapi.Tag{
+				{
+					Key:   aws.String("copilot-task"),
+					Value: aws.String("db-migrate"),
+				},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		inJSON     bool
+		setupMocks func(lister *mocks.MockactiveTaskLister)
+
+		wanted      string
+		wantedError error
+	}{
+		"write the tasks of services and one-off tasks": {
+			setupMocks: func(lister *mocks.MockactiveTaskLister) {
+				lister.EXPECT().ListActiveAppEnvTasks(ecs.ListActiveAppEnvTasksOpts{
+					App: "phonetool",
+					Env: "test",
+				}).Return(tasks, nil)
+			},
+			wanted: `Task ID                           Group               Status              Health              Private IP          Availability Zone   Started
+-------                           -----               ------              ------              ----------          -----------------   -------
+4082490ee6c245e09d2145010aa1ba8d  api                 RUNNING             HEALTHY             10.0.0.12           us-west-2a          2 hours ago
+1848c38a0f5c4c47a5e7d6e1e3c8b2a1  task/db-migrate     PROVISIONING        -                   -                   -                   -
+`,
+		},
+		"write the tasks in JSON": {
+			inJSON: true,
+			setupMocks: func(lister *mocks.MockactiveTaskLister) {
+				lister.EXPECT().ListActiveAppEnvTasks(gomock.Any()).Return(tasks[:1], nil)
+			},
+			wanted: `{"tasks":[{"id":"4082490ee6c245e09d2145010aa1ba8d","group":"api","lastStatus":"RUNNING","health":"HEALTHY","privateIP":"10.0.0.12","availabilityZone":"us-west-2a","startedAt":"2023-03-01T10:00:00Z"}]}
+`,
+		},
+		"wrap the error from listing the tasks": {
+			setupMocks: func(lister *mocks.MockactiveTaskLister) {
+				lister.EXPECT().ListActiveAppEnvTasks(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list running tasks in environment test: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			lister := mocks.NewMockactiveTaskLister(ctrl)
+			tc.setupMocks(lister)
+			b := &strings.Builder{}
+			opts := &listTaskOpts{
+				listTaskVars: listTaskVars{
+					appName:          "phonetool",
+					envName:          "test",
+					shouldOutputJSON: tc.inJSON,
+				},
+				w:   b,
+				now: func() time.Time { return now },
+				initTaskLister: func(o *listTaskOpts) error {
+					o.lister = lister
+					return nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, b.String())
+		})
+	}
+}
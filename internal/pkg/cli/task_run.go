@@ -11,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
@@ -135,6 +136,8 @@ type runTaskVars struct {
 	resourceTags             map[string]string
 
 	follow                bool
+	wait                  bool
+	timeout               time.Duration
 	generateCommandTarget string
 
 	os   string
@@ -158,6 +161,7 @@ type runTaskOpts struct {
 	repository           repositoryService
 	runner               taskRunner
 	eventsWriter         eventsWriter
+	taskStopper          ecsTaskStopper
 	defaultClusterGetter defaultClusterGetter
 	publicIPGetter       publicIPGetter
 
@@ -168,7 +172,7 @@ type runTaskOpts struct {
 	// Configurer functions.
 	configureRuntimeOpts func() error
 	configureRepository  func() error
-	// NOTE: configureEventsWriter is only called when tailing logs (i.e. --follow is specified)
+	// NOTE: configureEventsWriter is only called when waiting for the tasks (i.e. --follow or --wait is specified)
 	configureEventsWriter func(tasks []*task.Task)
 
 	configureECSServiceDescriber func(session *session.Session) ecs.ECSServiceDescriber
@@ -216,7 +220,9 @@ func newTaskRunOpts(vars runTaskVars) (*runTaskOpts, error) {
 			return fmt.Errorf("configure task runner: %w", err)
 		}
 		opts.deployer = cloudformation.New(opts.sess, cloudformation.WithProgressTracker(os.Stderr))
-		opts.defaultClusterGetter = awsecs.New(opts.sess)
+		ecsClient := awsecs.New(opts.sess)
+		opts.defaultClusterGetter = ecsClient
+		opts.taskStopper = ecsClient
 		opts.publicIPGetter = ec2.New(opts.sess)
 		return nil
 	}
@@ -439,6 +445,13 @@ func (o *runTaskOpts) Validate() error {
 		}
 	}
 
+	if o.timeout < 0 {
+		return fmt.Errorf("`--%s` must be a positive duration", timeoutFlag)
+	}
+	if o.timeout != 0 && !o.wait && !o.follow {
+		return fmt.Errorf("cannot specify `--%s` without `--%s` or `--%s`", timeoutFlag, waitFlag, followFlag)
+	}
+
 	return nil
 }
 
@@ -757,9 +770,9 @@ Did you tag your secrets with the "copilot-application" and "copilot-environment
 
 	o.showPublicIPs(tasks)
 
-	if o.follow {
+	if o.follow || o.wait {
 		o.configureEventsWriter(tasks)
-		if err := o.displayLogStream(); err != nil {
+		if err := o.waitForTasks(tasks); err != nil {
 			return err
 		}
 		if err := o.runner.CheckNonZeroExitCode(tasks); err != nil {
@@ -897,9 +910,20 @@ func (o *runTaskOpts) workloadType(appName, workloadName string) (string, error)
 	return workloadTypeInvalid, fmt.Errorf("workload %s is neither a service nor a job", workloadName)
 }
 
-func (o *runTaskOpts) displayLogStream() error {
-	if err := o.eventsWriter.WriteEventsUntilStopped(); err != nil {
-		return fmt.Errorf("write events: %w", err)
+// waitForTasks blocks until the tasks stop, and streams their logs if --follow is specified.
+// If the tasks are still running after --timeout, they are stopped.
+func (o *runTaskOpts) waitForTasks(tasks []*task.Task) error {
+	if o.follow {
+		if err := o.waitUntilStoppedOrTimeout(tasks, o.eventsWriter.WriteEventsUntilStopped); err != nil {
+			return fmt.Errorf("write events: %w", err)
+		}
+	} else {
+		o.spinner.Start(fmt.Sprintf("Waiting for %s to stop.", english.Plural(o.count, "task", "")))
+		if err := o.waitUntilStoppedOrTimeout(tasks, o.eventsWriter.WaitUntilStopped); err != nil {
+			o.spinner.Stop(log.Serrorf("Failed to wait for %s to stop.\n", english.PluralWord(o.count, "task", "")))
+			return fmt.Errorf("wait for tasks to stop: %w", err)
+		}
+		o.spinner.Stop("")
 	}
 
 	log.Infof("%s %s stopped.\n",
@@ -908,6 +932,43 @@ func (o *runTaskOpts) displayLogStream() error {
 	return nil
 }
 
+func (o *runTaskOpts) waitUntilStoppedOrTimeout(tasks []*task.Task, wait func() error) error {
+	if o.timeout == 0 {
+		return wait()
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- wait()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(o.timeout):
+	}
+	taskARNs := make([]string, len(tasks))
+	for i, t := range tasks {
+		taskARNs[i] = t.TaskARN
+	}
+	reason := fmt.Sprintf("Task stopped after the timeout of %s", o.timeout)
+	if err := o.taskStopper.StopTasks(taskARNs, awsecs.WithStopTaskCluster(tasks[0].ClusterARN), awsecs.WithStopTaskReason(reason)); err != nil {
+		return fmt.Errorf("stop tasks after timeout of %s: %w", o.timeout, err)
+	}
+	return &errTaskTimeout{timeout: o.timeout}
+}
+
+type errTaskTimeout struct {
+	timeout time.Duration
+}
+
+func (e *errTaskTimeout) Error() string {
+	return fmt.Sprintf("tasks did not stop within the timeout of %s", e.timeout)
+}
+
+// ExitCode returns 124 for tasks that timed out, like the timeout(1) command.
+func (e *errTaskTimeout) ExitCode() int {
+	return 124
+}
+
 func (o *runTaskOpts) runTask() ([]*task.Task, error) {
 	o.spinner.Start(fmt.Sprintf("Waiting for %s to be running for %s.", english.Plural(o.count, "task", ""), o.groupName))
 	tasks, err := o.runner.Run()
@@ -1175,7 +1236,9 @@ func BuildTaskRunCmd() *cobra.Command {
   Run a task using the current workspace with specific subnets and security groups.
   /code $ copilot task run --subnets subnet-123,subnet-456 --security-groups sg-123,sg-456
   Run a task with a command.
-  /code $ copilot task run --command "python migrate-script.py"`,
+  /code $ copilot task run --command "python migrate-script.py"
+  Run a task in a CI pipeline, and exit with the exit code of the task or stop it after 30 minutes.
+  /code $ copilot task run -n db-migrate --env test --follow --timeout 30m`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newTaskRunOpts(vars)
 			if err != nil {
@@ -1220,6 +1283,8 @@ func BuildTaskRunCmd() *cobra.Command {
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
 
 	cmd.Flags().BoolVar(&vars.follow, followFlag, false, followFlagDescription)
+	cmd.Flags().BoolVar(&vars.wait, waitFlag, false, taskWaitFlagDescription)
+	cmd.Flags().DurationVar(&vars.timeout, timeoutFlag, 0, taskTimeoutFlagDescription)
 	cmd.Flags().StringVar(&vars.generateCommandTarget, generateCommandFlag, "", generateCommandFlagDescription)
 
 	// group flags.
@@ -1257,6 +1322,8 @@ func BuildTaskRunCmd() *cobra.Command {
 
 	utilityFlags := pflag.NewFlagSet("Utility", pflag.ContinueOnError)
 	utilityFlags.AddFlag(cmd.Flags().Lookup(followFlag))
+	utilityFlags.AddFlag(cmd.Flags().Lookup(waitFlag))
+	utilityFlags.AddFlag(cmd.Flags().Lookup(timeoutFlag))
 	utilityFlags.AddFlag(cmd.Flags().Lookup(generateCommandFlag))
 	utilityFlags.AddFlag(cmd.Flags().Lookup(acknowledgeSecretsAccessFlag))
 
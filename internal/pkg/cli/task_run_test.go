@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -86,6 +87,9 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 		inDefault               bool
 		inGenerateCommandTarget string
+		inFollow                bool
+		inWait                  bool
+		inTimeout               time.Duration
 
 		appName         string
 		isDockerfileSet bool
@@ -393,6 +397,27 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 
 			wantedError: nil,
 		},
+		"valid timeout with wait": {
+			basicOpts: defaultOpts,
+			inWait:    true,
+			inTimeout: 10 * time.Minute,
+		},
+		"valid timeout with follow": {
+			basicOpts: defaultOpts,
+			inFollow:  true,
+			inTimeout: 10 * time.Minute,
+		},
+		"invalid negative timeout": {
+			basicOpts:   defaultOpts,
+			inWait:      true,
+			inTimeout:   -time.Minute,
+			wantedError: errors.New("`--timeout` must be a positive duration"),
+		},
+		"invalid timeout without wait or follow": {
+			basicOpts:   defaultOpts,
+			inTimeout:   10 * time.Minute,
+			wantedError: errors.New("cannot specify `--timeout` without `--wait` or `--follow`"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -424,6 +449,9 @@ func TestTaskRunOpts_Validate(t *testing.T) {
 					entrypoint:                  tc.inEntryPoint,
 					useDefaultSubnetsAndCluster: tc.inDefault,
 					generateCommandTarget:       tc.inGenerateCommandTarget,
+					follow:                      tc.inFollow,
+					wait:                        tc.inWait,
+					timeout:                     tc.inTimeout,
 					os:                          tc.inOS,
 					arch:                        tc.inArch,
 				},
@@ -750,6 +778,7 @@ type runTaskMocks struct {
 	runner               *mocks.MocktaskRunner
 	store                *mocks.Mockstore
 	eventsWriter         *mocks.MockeventsWriter
+	taskStopper          *mocks.MockecsTaskStopper
 	defaultClusterGetter *mocks.MockdefaultClusterGetter
 	publicIPGetter       *mocks.MockpublicIPGetter
 	provider             *mocks.MocksessionProvider
@@ -784,6 +813,8 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 		inTag        string
 		inDockerCtx  string
 		inFollow     bool
+		inWait       bool
+		inTimeout    time.Duration
 		inCommand    string
 		inEntryPoint string
 		inEnvFile    string
@@ -1023,6 +1054,87 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 			},
 			wantedError: errors.New("write events: error writing events"),
 		},
+		"fail to wait for tasks to stop": {
+			inWait:  true,
+			inImage: "image",
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN: "task-1",
+					},
+				}, nil)
+				m.eventsWriter.EXPECT().WaitUntilStopped().Return(errors.New("some error"))
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().Times(0)
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("wait for tasks to stop: some error"),
+		},
+		"propagate the exit code of the task after waiting": {
+			inWait:  true,
+			inImage: "image",
+			setupMocks: func(m runTaskMocks) {
+				tasks := []*task.Task{
+					{
+						TaskARN: "task-1",
+					},
+				}
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return(tasks, nil)
+				m.eventsWriter.EXPECT().WaitUntilStopped().Return(nil)
+				m.runner.EXPECT().CheckNonZeroExitCode(tasks).Return(errors.New("container main in task task-1 exited with status code 3"))
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("container main in task task-1 exited with status code 3"),
+		},
+		"stop the tasks after the timeout": {
+			inFollow:  true,
+			inTimeout: time.Millisecond,
+			inImage:   "image",
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN:    "task-1",
+						ClusterARN: "cluster-1",
+					},
+				}, nil)
+				block := make(chan struct{})
+				m.eventsWriter.EXPECT().WriteEventsUntilStopped().DoAndReturn(func() error {
+					<-block
+					return nil
+				}).AnyTimes()
+				m.taskStopper.EXPECT().StopTasks([]string{"task-1"}, gomock.Any(), gomock.Any()).Return(nil)
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("write events: tasks did not stop within the timeout of 1ms"),
+		},
+		"fail to stop the tasks after the timeout": {
+			inWait:    true,
+			inTimeout: time.Millisecond,
+			inImage:   "image",
+			setupMocks: func(m runTaskMocks) {
+				m.provider.EXPECT().Default().Return(&session.Session{}, nil)
+				m.deployer.EXPECT().DeployTask(gomock.Any()).AnyTimes()
+				m.runner.EXPECT().Run().Return([]*task.Task{
+					{
+						TaskARN:    "task-1",
+						ClusterARN: "cluster-1",
+					},
+				}, nil)
+				block := make(chan struct{})
+				m.eventsWriter.EXPECT().WaitUntilStopped().DoAndReturn(func() error {
+					<-block
+					return nil
+				}).AnyTimes()
+				m.taskStopper.EXPECT().StopTasks([]string{"task-1"}, gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+				mockHasDefaultCluster(m)
+			},
+			wantedError: errors.New("wait for tasks to stop: stop tasks after timeout of 1ms: some error"),
+		},
 		"error getting app config (to look for permissions boundary policy)": {
 			inApp: "my-app",
 			inEnv: "test",
@@ -1161,6 +1273,7 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				runner:               mocks.NewMocktaskRunner(ctrl),
 				store:                mocks.NewMockstore(ctrl),
 				eventsWriter:         mocks.NewMockeventsWriter(ctrl),
+				taskStopper:          mocks.NewMockecsTaskStopper(ctrl),
 				defaultClusterGetter: mocks.NewMockdefaultClusterGetter(ctrl),
 				publicIPGetter:       mocks.NewMockpublicIPGetter(ctrl),
 				provider:             mocks.NewMocksessionProvider(ctrl),
@@ -1179,6 +1292,8 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 					appName:    tc.inApp,
 					env:        tc.inEnv,
 					follow:     tc.inFollow,
+					wait:       tc.inWait,
+					timeout:    tc.inTimeout,
 					secrets:    tc.inSecrets,
 					command:    tc.inCommand,
 					entrypoint: tc.inEntryPoint,
@@ -1194,6 +1309,7 @@ func TestTaskRunOpts_Execute(t *testing.T) {
 				opts.deployer = mocks.deployer
 				opts.defaultClusterGetter = mocks.defaultClusterGetter
 				opts.publicIPGetter = mocks.publicIPGetter
+				opts.taskStopper = mocks.taskStopper
 				return nil
 			}
 			opts.configureRepository = func() error {
@@ -0,0 +1,160 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	osexec "os/exec"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	templatediff "github.com/aws/copilot-cli/internal/pkg/template/diff"
+	"github.com/aws/copilot-cli/internal/pkg/template/policy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/dustin/go-humanize/english"
+)
+
+const (
+	cfnGuardBin     = "cfn-guard"
+	opaBin          = "opa"
+	defaultOPAQuery = "data.copilot.deny[msg]"
+)
+
+// templateChecker runs the checks of the deploy policies of an application and environment against generated templates.
+type templateChecker struct {
+	checks []*config.TemplateChecks
+	runner execRunner
+	out    io.Writer // Output of the external policy engines.
+}
+
+func newTemplateChecker(app *config.Application, env string, runner execRunner, out io.Writer) *templateChecker {
+	return &templateChecker{
+		checks: app.TemplateChecks(env),
+		runner: runner,
+		out:    out,
+	}
+}
+
+// configured returns true if the deploy policies have any checks.
+func (c *templateChecker) configured() bool {
+	return len(c.checks) > 0
+}
+
+// Check returns an errTemplateCheckFailed error if the template of the stack, or the template of its addons, fails any of the checks.
+func (c *templateChecker) Check(stackName, template, addonsTemplate string) error {
+	failures, err := c.failures(template)
+	if err != nil {
+		return fmt.Errorf("check template of stack %s: %w", stackName, err)
+	}
+	if addonsTemplate != "" {
+		addonsFailures, err := c.failures(addonsTemplate)
+		if err != nil {
+			return fmt.Errorf("check addons template of stack %s: %w", stackName, err)
+		}
+		for _, failure := range addonsFailures {
+			failures = append(failures, "addons: "+failure)
+		}
+	}
+	if len(failures) > 0 {
+		return &errTemplateCheckFailed{
+			stack:    stackName,
+			failures: failures,
+		}
+	}
+	log.Successf("The template of stack %s passed the policy checks.\n", color.HighlightResource(stackName))
+	return nil
+}
+
+// checkStackTemplate checks the template of a stack along with the template of its addons.
+func checkStackTemplate(checker *templateChecker, gen addonsTemplateGetter, stackName, template string) error {
+	addons, err := gen.AddonsTemplate()
+	if err != nil {
+		return fmt.Errorf("retrieve addons template: %w", err)
+	}
+	return checker.Check(stackName, template, addons)
+}
+
+func (c *templateChecker) failures(template string) ([]string, error) {
+	var failures []string
+	for _, checks := range c.checks {
+		violations, err := policy.Check(template, checks.Rules)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range violations {
+			failures = append(failures, v.String())
+		}
+		if checks.GuardRules != "" {
+			failed, err := c.runEngine(cfnGuardBin, []string{"validate", "--rules", checks.GuardRules, "--show-summary", "fail"}, template)
+			if err != nil {
+				return nil, err
+			}
+			if failed {
+				failures = append(failures, fmt.Sprintf("cfn-guard rules %s failed", checks.GuardRules))
+			}
+		}
+		if checks.OPA != nil {
+			failed, err := c.runOPA(checks.OPA, template)
+			if err != nil {
+				return nil, err
+			}
+			if failed {
+				failures = append(failures, fmt.Sprintf("OPA bundle %s denied the template", checks.OPA.Bundle))
+			}
+		}
+	}
+	return failures, nil
+}
+
+func (c *templateChecker) runOPA(check *config.OPACheck, template string) (bool, error) {
+	doc, err := templatediff.JSONValue([]byte(template))
+	if err != nil {
+		return false, fmt.Errorf("parse template: %w", err)
+	}
+	input, err := json.Marshal(doc)
+	if err != nil {
+		return false, fmt.Errorf("marshal template to JSON: %w", err)
+	}
+	query := check.Query
+	if query == "" {
+		query = defaultOPAQuery
+	}
+	return c.runEngine(opaBin, []string{"eval", "--fail-defined", "--format", "pretty", "--bundle", check.Bundle, "--stdin-input", query}, string(input))
+}
+
+// runEngine runs a policy engine with the template as its standard input.
+// It returns true if the engine exited with a non-zero status, and an error if it couldn't run at all.
+func (c *templateChecker) runEngine(bin string, args []string, input string) (failed bool, err error) {
+	err = c.runner.Run(bin, args, exec.Stdin(strings.NewReader(input)), exec.Stdout(c.out), exec.Stderr(c.out))
+	if err == nil {
+		return false, nil
+	}
+	var errExit *osexec.ExitError
+	if errors.As(err, &errExit) {
+		return true, nil
+	}
+	return false, fmt.Errorf("run %s: %w", bin, err)
+}
+
+type errTemplateCheckFailed struct {
+	stack    string
+	failures []string
+}
+
+func (e *errTemplateCheckFailed) Error() string {
+	return fmt.Sprintf("template of stack %s failed %s:\n  %s",
+		e.stack, english.Plural(len(e.failures), "policy check", "policy checks"), strings.Join(e.failures, "\n  "))
+}
+
+// RecommendActions returns recommended actions to be taken after the error.
+// Implements main.actionRecommender interface.
+func (e *errTemplateCheckFailed) RecommendActions() string {
+	return fmt.Sprintf("Update the manifest or the addons so that the template complies with the policy, or ask the owners of the application to update it with %s.",
+		color.HighlightCode("copilot app deploy-policy"))
+}
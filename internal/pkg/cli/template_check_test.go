@@ -0,0 +1,114 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"io"
+	osexec "os/exec"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateChecker_Check(t *testing.T) {
+	const (
+		template = `
+Resources:
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Memory: 512
+      ContainerDefinitions:
+        - Name: api
+`
+		addonsTemplate = `
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      BucketName: !Sub '${App}-${Env}-uploads'
+`
+	)
+	testCases := map[string]struct {
+		inChecks         []*config.TemplateChecks
+		inAddonsTemplate string
+		setupMocks       func(m *mocks.MockexecRunner)
+
+		wantedError error
+	}{
+		"pass the built-in rules": {
+			inChecks: []*config.TemplateChecks{
+				{Rules: []string{"container-memory-limits", "no-public-s3-buckets"}},
+			},
+			setupMocks: func(m *mocks.MockexecRunner) {},
+		},
+		"fail the built-in rules in the addons template": {
+			inChecks: []*config.TemplateChecks{
+				{Rules: []string{"no-public-s3-buckets"}},
+			},
+			inAddonsTemplate: addonsTemplate,
+			setupMocks:       func(m *mocks.MockexecRunner) {},
+			wantedError: errors.New(`template of stack my-app-test-api failed 1 policy check:
+  addons: no-public-s3-buckets: Bucket must set BlockPublicAcls, BlockPublicPolicy, IgnorePublicAcls, RestrictPublicBuckets to true in PublicAccessBlockConfiguration`),
+		},
+		"fail the cfn-guard rules": {
+			inChecks: []*config.TemplateChecks{
+				{GuardRules: "policies/prod.guard"},
+			},
+			setupMocks: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run("cfn-guard", []string{"validate", "--rules", "policies/prod.guard", "--show-summary", "fail"}, gomock.Any()).
+					Return(&osexec.ExitError{})
+			},
+			wantedError: errors.New(`template of stack my-app-test-api failed 1 policy check:
+  cfn-guard rules policies/prod.guard failed`),
+		},
+		"pass the OPA bundle of the application and the environment": {
+			inChecks: []*config.TemplateChecks{
+				{OPA: &config.OPACheck{Bundle: "policies/app"}},
+				{OPA: &config.OPACheck{Bundle: "policies/prod", Query: "data.prod.violations[v]"}},
+			},
+			setupMocks: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run("opa", []string{"eval", "--fail-defined", "--format", "pretty", "--bundle", "policies/app", "--stdin-input", "data.copilot.deny[msg]"}, gomock.Any()).
+					Return(nil)
+				m.EXPECT().Run("opa", []string{"eval", "--fail-defined", "--format", "pretty", "--bundle", "policies/prod", "--stdin-input", "data.prod.violations[v]"}, gomock.Any()).
+					Return(nil)
+			},
+		},
+		"wrap the error if the policy engine can't run": {
+			inChecks: []*config.TemplateChecks{
+				{GuardRules: "policies/prod.guard"},
+			},
+			setupMocks: func(m *mocks.MockexecRunner) {
+				m.EXPECT().Run("cfn-guard", gomock.Any(), gomock.Any()).Return(errors.New("executable file not found in $PATH"))
+			},
+			wantedError: errors.New("check template of stack my-app-test-api: run cfn-guard: executable file not found in $PATH"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			runner := mocks.NewMockexecRunner(ctrl)
+			tc.setupMocks(runner)
+			checker := &templateChecker{
+				checks: tc.inChecks,
+				runner: runner,
+				out:    io.Discard,
+			}
+
+			err := checker.Check("my-app-test-api", template, tc.inAddonsTemplate)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"math"
 	"net"
 	"regexp"
 	"strconv"
@@ -23,6 +24,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 )
 
 const basicNameRegex = `^[a-z][a-z0-9\-]+$`
@@ -61,6 +63,8 @@ var (
 	errValueBadFormatWithPeriodUnderscore = errors.New("value must contain only alphanumeric characters and ._-")
 	errDDBAttributeBadFormat              = errors.New("value must be of the form <name>:<T> where T is one of S, N, or B")
 	errTooManyLSIKeys                     = errors.New("number of specified LSI sort keys must be 5 or less")
+	errDDBGSIBadFormat                    = errors.New("value must be of the form <name>:<T>[,<name>:<T>] where T is one of S, N, or B")
+	errTooManyGSIs                        = errors.New("number of specified global secondary indexes must be 20 or less")
 
 	// Aurora-Serverless-specific errors.
 	errInvalidRDSNameCharacters    = errors.New("value must start with a letter and followed by alphanumeric letters only")
@@ -360,8 +364,59 @@ func validateStorageType(val interface{}, opts validateStorageTypeOpts) error {
 		return fmt.Errorf(fmtErrInvalidStorageType, storageType, prettify(storageTypes))
 	}
 
-	if storageType == rdsStorageType {
+	switch storageType {
+	case rdsStorageType:
 		return validateAuroraStorageType(opts.ws, opts.workloadName)
+	case efsStorageType:
+		return validateEFSStorageType(opts.ws, opts.workloadName)
+	case redisStorageType:
+		return validateRedisStorageType(opts.ws, opts.workloadName)
+	}
+	return nil
+}
+
+// validateRedisStorageType returns an error if the workload is in the workspace but cannot run in the VPC of the Redis cluster.
+func validateRedisStorageType(ws manifestReader, workloadName string) error {
+	if workloadName == "" {
+		return nil // Workload not yet selected while validating storage type flag.
+	}
+	mft, err := ws.ReadWorkloadManifest(workloadName)
+	if err != nil {
+		var errNotExist *workspace.ErrFileNotExists
+		if errors.As(err, &errNotExist) {
+			return nil // The Redis cluster of an environment can be accessed by a workload in another workspace.
+		}
+		return fmt.Errorf("invalid storage type %s: read manifest file for %s: %w", redisStorageType, workloadName, err)
+	}
+	mftType, err := mft.WorkloadType()
+	if err != nil {
+		return fmt.Errorf("invalid storage type %s: read type of workload from manifest file for %s: %w", redisStorageType, workloadName, err)
+	}
+	if mftType == manifestinfo.RequestDrivenWebServiceType || mftType == manifestinfo.StaticSiteType || mftType == manifestinfo.LambdaServiceType {
+		return fmt.Errorf("invalid storage type %s: a %s cannot attach the security group of a Redis cluster", redisStorageType, mftType)
+	}
+	return nil
+}
+
+// validateEFSStorageType returns an error if the workload is in the workspace but cannot mount an EFS file system.
+func validateEFSStorageType(ws manifestReader, workloadName string) error {
+	if workloadName == "" {
+		return nil // Workload not yet selected while validating storage type flag.
+	}
+	mft, err := ws.ReadWorkloadManifest(workloadName)
+	if err != nil {
+		var errNotExist *workspace.ErrFileNotExists
+		if errors.As(err, &errNotExist) {
+			return nil // The file system of an environment can be accessed by a workload in another workspace.
+		}
+		return fmt.Errorf("invalid storage type %s: read manifest file for %s: %w", efsStorageType, workloadName, err)
+	}
+	mftType, err := mft.WorkloadType()
+	if err != nil {
+		return fmt.Errorf("invalid storage type %s: read type of workload from manifest file for %s: %w", efsStorageType, workloadName, err)
+	}
+	if mftType == manifestinfo.RequestDrivenWebServiceType || mftType == manifestinfo.StaticSiteType || mftType == manifestinfo.LambdaServiceType {
+		return fmt.Errorf("invalid storage type %s: a %s cannot mount an EFS file system", efsStorageType, mftType)
 	}
 	return nil
 }
@@ -745,6 +800,61 @@ func validateLSIs(val interface{}) error {
 	return nil
 }
 
+func validateGSIs(val interface{}) error {
+	s, ok := val.([]string)
+	if !ok {
+		return errValueNotAStringSlice
+	}
+	if len(s) > maxDDBGSIs {
+		return errTooManyGSIs
+	}
+	for _, gsi := range s {
+		keys := strings.Split(gsi, ",")
+		if len(keys) > 2 {
+			return errDDBGSIBadFormat
+		}
+		for _, key := range keys {
+			if err := validateKey(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateDDBStreamViewType(val interface{}) error {
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if !contains(s, ddbStreamViewTypes) {
+		return fmt.Errorf("invalid stream view type %s: must be one of %s", s, prettify(ddbStreamViewTypes))
+	}
+	return nil
+}
+
+// validateAuroraCapacity validates the capacity of an Aurora Serverless v2 cluster,
+// either passed as a float64 with a flag or as a string with a prompt.
+func validateAuroraCapacity(val interface{}) error {
+	var capacity float64
+	switch v := val.(type) {
+	case float64:
+		capacity = v
+	case string:
+		parsed, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("capacity %s must be a number", v)
+		}
+		capacity = parsed
+	default:
+		return errValueNotAString
+	}
+	if capacity < minAuroraCapacity || capacity > maxAuroraCapacity || math.Mod(capacity, minAuroraCapacity) != 0 {
+		return fmt.Errorf("capacity %v must be between %v and %v ACUs in increments of %v", capacity, minAuroraCapacity, maxAuroraCapacity, minAuroraCapacity)
+	}
+	return nil
+}
+
 func validateSubscribe(noSubscription bool, subscribeTags []string) error {
 	// --no-subscriptions and --subscribe are mutually exclusive.
 	if noSubscription && len(subscribeTags) != 0 {
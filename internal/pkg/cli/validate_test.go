@@ -448,6 +448,62 @@ type: Request-Driven Web Service
 network:
   vpc:
     placement: private
+`),
+				},
+				workloadName: "api",
+			},
+		},
+		"should allow EFS if the workload is not in the workspace": {
+			input: "EFS",
+			optionals: validateStorageTypeOpts{
+				ws: mockManifestReader{
+					err: &workspace.ErrFileNotExists{FileName: "api"},
+				},
+				workloadName: "api",
+			},
+		},
+		"should return an error if EFS is selected for a RDWS": {
+			input: "EFS",
+			optionals: validateStorageTypeOpts{
+				ws: mockManifestReader{
+					out: []byte(`
+name: api
+type: Request-Driven Web Service
+`),
+				},
+				workloadName: "api",
+			},
+			want: errors.New("invalid storage type EFS: a Request-Driven Web Service cannot mount an EFS file system"),
+		},
+		"should return an error if Redis is selected for a Static Site": {
+			input: "Redis",
+			optionals: validateStorageTypeOpts{
+				ws: mockManifestReader{
+					out: []byte(`
+name: www
+type: Static Site
+`),
+				},
+				workloadName: "www",
+			},
+			want: errors.New("invalid storage type Redis: a Static Site cannot attach the security group of a Redis cluster"),
+		},
+		"should allow Redis if the workload is not in the workspace": {
+			input: "Redis",
+			optionals: validateStorageTypeOpts{
+				ws: mockManifestReader{
+					err: &workspace.ErrFileNotExists{FileName: "api"},
+				},
+				workloadName: "api",
+			},
+		},
+		"should allow EFS for a Load Balanced Web Service": {
+			input: "EFS",
+			optionals: validateStorageTypeOpts{
+				ws: mockManifestReader{
+					out: []byte(`
+name: api
+type: Load Balanced Web Service
 `),
 				},
 				workloadName: "api",
@@ -524,6 +580,75 @@ func TestValidateLSIs(t *testing.T) {
 	}
 }
 
+func TestValidateGSIs(t *testing.T) {
+	testCases := map[string]struct {
+		inputGSIs []string
+		wantError error
+	}{
+		"good case": {
+			inputGSIs: []string{"status:S", "status:S,createdAt:N"},
+		},
+		"bad gsi structure": {
+			inputGSIs: []string{"status:S,createdAt"},
+			wantError: errDDBAttributeBadFormat,
+		},
+		"too many keys in a gsi": {
+			inputGSIs: []string{"status:S,createdAt:N,owner:S"},
+			wantError: errDDBGSIBadFormat,
+		},
+		"too many gsis": {
+			inputGSIs: make([]string, 21),
+			wantError: errTooManyGSIs,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateGSIs(tc.inputGSIs)
+			if tc.wantError != nil {
+				require.EqualError(t, got, tc.wantError.Error())
+			} else {
+				require.Nil(t, got)
+			}
+		})
+	}
+}
+
+func TestValidateAuroraCapacity(t *testing.T) {
+	testCases := map[string]struct {
+		input     interface{}
+		wantError error
+	}{
+		"good flag value": {
+			input: 16.5,
+		},
+		"good prompt input": {
+			input: "0.5",
+		},
+		"not a number": {
+			input:     "many",
+			wantError: errors.New("capacity many must be a number"),
+		},
+		"too large": {
+			input:     256.0,
+			wantError: errors.New("capacity 256 must be between 0.5 and 128 ACUs in increments of 0.5"),
+		},
+		"not an increment of 0.5": {
+			input:     "1.25",
+			wantError: errors.New("capacity 1.25 must be between 0.5 and 128 ACUs in increments of 0.5"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validateAuroraCapacity(tc.input)
+			if tc.wantError != nil {
+				require.EqualError(t, got, tc.wantError.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
 func TestValidateCIDR(t *testing.T) {
 	testCases := map[string]struct {
 		inputCIDR string
@@ -5,7 +5,9 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -21,13 +23,69 @@ type Application struct {
 	DomainHostedZoneID  string            `json:"domainHostedZoneID"`            // Existing domain hosted zone in Route53. An empty domain name means the user does not have one.
 	Version             string            `json:"version"`                       // The version of the app layout in the underlying datastore (e.g. SSM).
 	Tags                map[string]string `json:"tags,omitempty"`                // Labels to apply to resources created within the app.
+	ImageRetention      *ImageRetention   `json:"imageRetention,omitempty"`      // Lifecycle settings of the ECR repositories created for the workloads of the app.
+	ConfigStore         string            `json:"configStore,omitempty"`         // Backend of the configuration of the environments and workloads of the app. Defaults to SSM.
+	ResourceNames       *ResourceNames    `json:"resourceNames,omitempty"`       // Templates of the physical names of the resources of the app.
+
+	DeployPolicy      *DeployPolicy            `json:"deployPolicy,omitempty"`      // Deploy windows and freezes of every environment.
+	EnvDeployPolicies map[string]*DeployPolicy `json:"envDeployPolicies,omitempty"` // Environment name to its own deploy windows and freezes.
+}
+
+// CheckDeployPolicies returns an error if the policy of the application, or the policy of the environment,
+// blocks deploying to the environment at the given time.
+func (a *Application) CheckDeployPolicies(env string, at time.Time) error {
+	if err := a.DeployPolicy.Check(at); err != nil {
+		return err
+	}
+	return a.EnvDeployPolicies[env].Check(at)
+}
+
+// TemplateChecks returns the template checks of the application policy and of the environment policy that are configured.
+func (a *Application) TemplateChecks(env string) []*TemplateChecks {
+	var checks []*TemplateChecks
+	for _, p := range []*DeployPolicy{a.DeployPolicy, a.EnvDeployPolicies[env]} {
+		if p != nil && !p.Checks.IsEmpty() {
+			checks = append(checks, p.Checks)
+		}
+	}
+	return checks
+}
+
+// LogGroupName returns the name of the log group of a workload in an environment.
+func (a *Application) LogGroupName(env, wkld string) string {
+	if name := a.ResourceNames.LogGroupName(a.Name, env, wkld); name != "" {
+		return name
+	}
+	return fmt.Sprintf("/copilot/%s-%s-%s", a.Name, env, wkld)
+}
+
+// RepositoryName returns the name of the ECR repository of a workload.
+func (a *Application) RepositoryName(wkld string) string {
+	if name := a.ResourceNames.RepositoryName(a.Name, wkld); name != "" {
+		return name
+	}
+	return fmt.Sprintf("%s/%s", a.Name, wkld)
+}
+
+// ImageRetention holds the lifecycle policy and tag mutability settings of an ECR repository.
+type ImageRetention struct {
+	KeepImages         int  `json:"keepImages,omitempty" yaml:"KeepImages,omitempty"`                 // Number of most recent images to keep. Zero keeps every image.
+	UntaggedExpiryDays int  `json:"untaggedExpiryDays,omitempty" yaml:"UntaggedExpiryDays,omitempty"` // Days after which untagged images expire. Zero never expires them.
+	ImmutableTags      bool `json:"immutableTags,omitempty" yaml:"ImmutableTags,omitempty"`           // Whether pushing an existing image tag, other than "latest", is rejected.
 }
 
 // CreateApplication instantiates a new application, validates its uniqueness and stores it in SSM.
+// If the application stores its configuration in DynamoDB, the table of the application is created first.
 func (s *Store) CreateApplication(application *Application) error {
 	applicationPath := fmt.Sprintf(fmtApplicationPath, application.Name)
 	application.Version = schemaVersion
 
+	if application.ConfigStore == DynamoDBBackend {
+		if err := s.createConfigTable(application.Name); err != nil {
+			return fmt.Errorf("create application %s: %w", application.Name, err)
+		}
+	}
+
 	data, err := marshal(application)
 	if err != nil {
 		return fmt.Errorf("serializing application %s: %w", application.Name, err)
@@ -55,6 +113,7 @@ func (s *Store) CreateApplication(application *Application) error {
 		}
 		return fmt.Errorf("create application %s: %w", application.Name, err)
 	}
+	s.invalidateCache()
 	return nil
 }
 
@@ -77,33 +136,28 @@ func (s *Store) UpdateApplication(application *Application) error {
 	}); err != nil {
 		return fmt.Errorf("update application %s: %w", application.Name, err)
 	}
+	s.invalidateCache()
 	return nil
 }
 
 // GetApplication fetches an application by name. If it can't be found, return a ErrNoSuchApplication
 func (s *Store) GetApplication(applicationName string) (*Application, error) {
 	applicationPath := fmt.Sprintf(fmtApplicationPath, applicationName)
-	applicationParam, err := s.ssm.GetParameter(&ssm.GetParameterInput{
-		Name: aws.String(applicationPath),
-	})
-
+	value, err := s.get(&ssmParams{client: s.ssm}, applicationPath)
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterNotFound:
-				account, region := s.getCallerAccountAndRegion()
-				return nil, &ErrNoSuchApplication{
-					ApplicationName: applicationName,
-					AccountID:       account,
-					Region:          region,
-				}
+		if errors.Is(err, errParamNotFound) {
+			account, region := s.getCallerAccountAndRegion()
+			return nil, &ErrNoSuchApplication{
+				ApplicationName: applicationName,
+				AccountID:       account,
+				Region:          region,
 			}
 		}
 		return nil, fmt.Errorf("get application %s: %w", applicationName, err)
 	}
 
 	var application Application
-	if err := json.Unmarshal([]byte(*applicationParam.Parameter.Value), &application); err != nil {
+	if err := json.Unmarshal([]byte(value), &application); err != nil {
 		return nil, fmt.Errorf("read configuration for application %s: %w", applicationName, err)
 	}
 	return &application, nil
@@ -112,13 +166,13 @@ func (s *Store) GetApplication(applicationName string) (*Application, error) {
 // ListApplications returns the list of existing applications in the customer's account and region.
 func (s *Store) ListApplications() ([]*Application, error) {
 	var applications []*Application
-	serializedApplications, err := s.listParams(rootApplicationPath)
+	serializedApplications, err := s.list(&ssmParams{client: s.ssm}, rootApplicationPath)
 	if err != nil {
 		return nil, fmt.Errorf("list applications: %w", err)
 	}
 	for _, serializedApplication := range serializedApplications {
 		var application Application
-		if err := json.Unmarshal([]byte(*serializedApplication), &application); err != nil {
+		if err := json.Unmarshal([]byte(serializedApplication.value), &application); err != nil {
 			return nil, fmt.Errorf("read application configuration: %w", err)
 		}
 
@@ -127,14 +181,18 @@ func (s *Store) ListApplications() ([]*Application, error) {
 	return applications, nil
 }
 
-// DeleteApplication deletes the SSM parameter related to the application.
+// DeleteApplication deletes the SSM parameter related to the application, and its DynamoDB table if it has one.
 func (s *Store) DeleteApplication(name string) error {
 	paramName := fmt.Sprintf(fmtApplicationPath, name)
 
+	if err := s.deleteConfigTable(name); err != nil {
+		return err
+	}
+
 	_, err := s.ssm.DeleteParameter(&ssm.DeleteParameterInput{
 		Name: aws.String(paramName),
 	})
-
+	s.invalidateCache()
 	if err != nil {
 		awserr, ok := err.(awserr.Error)
 		if !ok {
@@ -150,3 +208,24 @@ func (s *Store) DeleteApplication(name string) error {
 
 	return nil
 }
+
+// createConfigTable creates the DynamoDB table that holds the configuration of the application.
+func (s *Store) createConfigTable(appName string) error {
+	params, err := s.paramsIn(appName, DynamoDBBackend)
+	if err != nil {
+		return err
+	}
+	return params.(*dynamoDBParams).createTable(appName)
+}
+
+// deleteConfigTable deletes the DynamoDB table of the application if the application stores its configuration in DynamoDB.
+func (s *Store) deleteConfigTable(appName string) error {
+	params, err := s.paramsFor(appName)
+	if err != nil {
+		return err
+	}
+	if ddb, ok := params.(*dynamoDBParams); ok {
+		return ddb.deleteTable()
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+// Backends that can hold the configuration of the environments and workloads of an application.
+// The configuration of the application itself is always stored in SSM, so that applications can be listed
+// and their backend discovered.
+const (
+	SSMBackend      = "ssm"
+	DynamoDBBackend = "dynamodb"
+)
+
+// Backends returns the list of supported configuration backends.
+func Backends() []string {
+	return []string{SSMBackend, DynamoDBBackend}
+}
+
+var (
+	errParamNotFound      = errors.New("parameter not found")
+	errParamAlreadyExists = errors.New("parameter already exists")
+)
+
+// tag is a key-value pair attached to a parameter.
+type tag struct {
+	key   string
+	value string
+}
+
+// param is the serialized configuration of a resource stored under a path, such as "/copilot/applications/demo/environments/test".
+type param struct {
+	path        string
+	value       string
+	description string
+	tags        []tag
+}
+
+// paramStore reads and writes the parameters of an application.
+type paramStore interface {
+	// create stores a new parameter, and returns errParamAlreadyExists if a parameter exists at the path.
+	create(p param) error
+	// get returns the value of the parameter at path, and errParamNotFound if there is none.
+	get(path string) (string, error)
+	// list returns the parameters directly under the root path.
+	list(root string) ([]param, error)
+	// delete removes the parameter at path, and returns errParamNotFound if there is none.
+	delete(path string) error
+}
+
+// ssmParams stores parameters in SSM Parameter Store.
+type ssmParams struct {
+	client SSM
+}
+
+func (s *ssmParams) create(p param) error {
+	tags := make([]*ssm.Tag, len(p.tags))
+	for i, t := range p.tags {
+		tags[i] = &ssm.Tag{
+			Key:   aws.String(t.key),
+			Value: aws.String(t.value),
+		}
+	}
+	_, err := s.client.PutParameter(&ssm.PutParameterInput{
+		Name:        aws.String(p.path),
+		Description: aws.String(p.description),
+		Type:        aws.String(ssm.ParameterTypeString),
+		Value:       aws.String(p.value),
+		Tags:        tags,
+	})
+	if isAWSErrCode(err, ssm.ErrCodeParameterAlreadyExists) {
+		return errParamAlreadyExists
+	}
+	return err
+}
+
+func (s *ssmParams) get(path string) (string, error) {
+	out, err := s.client.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(path),
+	})
+	if isAWSErrCode(err, ssm.ErrCodeParameterNotFound) {
+		return "", errParamNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	return aws.StringValue(out.Parameter.Value), nil
+}
+
+func (s *ssmParams) list(root string) ([]param, error) {
+	var params []param
+	var nextToken *string
+	for {
+		out, err := s.client.GetParametersByPath(&ssm.GetParametersByPathInput{
+			Path:      aws.String(root),
+			Recursive: aws.Bool(false),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range out.Parameters {
+			params = append(params, param{
+				path:  aws.StringValue(p.Name),
+				value: aws.StringValue(p.Value),
+			})
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	return params, nil
+}
+
+func (s *ssmParams) delete(path string) error {
+	_, err := s.client.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(path),
+	})
+	if isAWSErrCode(err, ssm.ErrCodeParameterNotFound) {
+		return errParamNotFound
+	}
+	return err
+}
+
+func isAWSErrCode(err error, code string) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		return aerr.Code() == code
+	}
+	return false
+}
+
+// paramsFor returns the store that holds the parameters of the environments and workloads of the application.
+func (s *Store) paramsFor(appName string) (paramStore, error) {
+	if s.newDynamoDB == nil {
+		return &ssmParams{client: s.ssm}, nil
+	}
+	backend, ok := s.appBackends[appName]
+	if !ok {
+		app, err := s.GetApplication(appName)
+		if err != nil {
+			var errNoApp *ErrNoSuchApplication
+			if errors.As(err, &errNoApp) {
+				// Let the parameters be looked up in SSM so that callers get the "not found" error of the resource.
+				return &ssmParams{client: s.ssm}, nil
+			}
+			return nil, err
+		}
+		backend = app.ConfigStore
+		s.cacheBackend(appName, backend)
+	}
+	return s.paramsIn(appName, backend)
+}
+
+func (s *Store) cacheBackend(appName, backend string) {
+	if s.appBackends == nil {
+		s.appBackends = make(map[string]string)
+	}
+	s.appBackends[appName] = backend
+}
+
+// paramsIn returns the store of the parameters of an application in a backend.
+func (s *Store) paramsIn(appName, backend string) (paramStore, error) {
+	switch backend {
+	case "", SSMBackend:
+		return &ssmParams{client: s.ssm}, nil
+	case DynamoDBBackend:
+		if s.newDynamoDB == nil {
+			return nil, fmt.Errorf("application %s stores its configuration in DynamoDB, which isn't configured", appName)
+		}
+		client, err := s.newDynamoDB()
+		if err != nil {
+			return nil, fmt.Errorf("create DynamoDB client: %w", err)
+		}
+		return &dynamoDBParams{client: client, table: configTableName(appName)}, nil
+	default:
+		return nil, fmt.Errorf("unknown configuration backend %q of application %s", backend, appName)
+	}
+}
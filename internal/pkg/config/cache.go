@@ -0,0 +1,61 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cache"
+)
+
+// storeCacheTTL is how long the configuration read by a command with a cached store is reused by the next commands.
+// Changes made through the store clear the cache, so only the changes made from other machines take this long to show up.
+const storeCacheTTL = 5 * time.Minute
+
+// cachedParam is the serialized form of a listed parameter in the cache.
+type cachedParam struct {
+	Path  string `json:"path"`
+	Value string `json:"value"`
+}
+
+// get returns the value of the parameter at path, reusing the value read by a recent command.
+func (s *Store) get(params paramStore, path string) (string, error) {
+	return cache.Fetch(s.cache, s.cacheKey("get", path), storeCacheTTL, func() (string, error) {
+		return params.get(path)
+	})
+}
+
+// list returns the parameters under the root path, reusing the parameters listed by a recent command.
+func (s *Store) list(params paramStore, root string) ([]param, error) {
+	cached, err := cache.Fetch(s.cache, s.cacheKey("list", root), storeCacheTTL, func() ([]cachedParam, error) {
+		listed, err := params.list(root)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]cachedParam, len(listed))
+		for i, p := range listed {
+			out[i] = cachedParam{Path: p.path, Value: p.value}
+		}
+		return out, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]param, len(cached))
+	for i, p := range cached {
+		out[i] = param{path: p.Path, value: p.Value}
+	}
+	return out, nil
+}
+
+// invalidateCache forgets the configuration read with the credentials of the store after it's changed.
+func (s *Store) invalidateCache() {
+	// A failure only delays the change from showing up until the entries expire.
+	_ = s.cache.Invalidate(s.cacheKey())
+}
+
+func (s *Store) cacheKey(parts ...string) string {
+	return "store/" + s.cacheScope + "/" + strings.Join(parts, "/")
+}
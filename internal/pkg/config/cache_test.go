@@ -0,0 +1,74 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cache"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_Cache(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", dir)
+	t.Setenv("HOME", dir)
+	t.Setenv("LocalAppData", dir)
+
+	testEnv := Environment{Name: "test", App: "phonetool", Region: "us-west-2"}
+	testEnvString, err := marshal(testEnv)
+	require.NoError(t, err)
+	var gets int
+	client := &mockSSM{
+		t: t,
+		mockGetParameter: func(t *testing.T, param *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+			gets++
+			if aws.StringValue(param.Name) == fmt.Sprintf(fmtApplicationPath, "phonetool") {
+				return &ssm.GetParameterOutput{Parameter: &ssm.Parameter{Value: aws.String(`{"name":"phonetool"}`)}}, nil
+			}
+			return &ssm.GetParameterOutput{Parameter: &ssm.Parameter{Value: aws.String(testEnvString)}}, nil
+		},
+		mockPutParameter: func(t *testing.T, param *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+			return &ssm.PutParameterOutput{}, nil
+		},
+	}
+	newStore := func() *Store {
+		return &Store{ssm: client, cache: cache.New(), cacheScope: "scope"}
+	}
+
+	env, err := newStore().GetEnvironment("phonetool", "test")
+	require.NoError(t, err)
+	require.Equal(t, &testEnv, env)
+	env, err = newStore().GetEnvironment("phonetool", "test")
+	require.NoError(t, err)
+	require.Equal(t, &testEnv, env)
+	require.Equal(t, 1, gets, "the next store reads the environment from the cache")
+
+	require.NoError(t, newStore().CreateEnvironment(&Environment{Name: "prod", App: "phonetool"}))
+	gets = 0
+	_, err = newStore().GetEnvironment("phonetool", "test")
+	require.NoError(t, err)
+	require.Equal(t, 1, gets, "writes clear the cache")
+}
+
+func TestNewCachedSSMStore(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CACHE_HOME", dir)
+	t.Setenv("HOME", dir)
+	t.Setenv("LocalAppData", dir)
+
+	sess := session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKIAEXAMPLE", "secret", ""),
+		Region:      aws.String("us-west-2"),
+	}))
+	client := ssm.New(sess)
+
+	require.Nil(t, NewSSMStore(nil, client, "us-west-2").cache, "stores read everything from AWS by default")
+	require.NotNil(t, NewCachedSSMStore(nil, client, "us-west-2").cache)
+}
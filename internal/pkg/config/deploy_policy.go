@@ -0,0 +1,232 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// DeployPolicy restricts when the workloads of an application, or of one of its environments, can be deployed,
+// and which checks their templates must pass.
+type DeployPolicy struct {
+	Windows []Recurrence    `json:"windows,omitempty" yaml:"windows,omitempty"` // If any, deployments are only allowed while one of the windows is open.
+	Freezes []DeployFreeze  `json:"freezes,omitempty" yaml:"freezes,omitempty"` // Deployments are blocked while any of the freezes is in effect.
+	Checks  *TemplateChecks `json:"checks,omitempty" yaml:"checks,omitempty"`   // Checks that the generated templates must pass to be deployed.
+}
+
+// TemplateChecks are the policy checks run against the CloudFormation templates of workloads and environments.
+type TemplateChecks struct {
+	Rules      []string  `json:"rules,omitempty" yaml:"rules,omitempty"`            // Names of the built-in rules to enforce.
+	GuardRules string    `json:"guardRules,omitempty" yaml:"guard_rules,omitempty"` // Path to a cfn-guard rules file or directory.
+	OPA        *OPACheck `json:"opa,omitempty" yaml:"opa,omitempty"`
+}
+
+// OPACheck evaluates the templates with an Open Policy Agent bundle.
+type OPACheck struct {
+	Bundle string `json:"bundle" yaml:"bundle"`                   // Path to the bundle directory or archive.
+	Query  string `json:"query,omitempty" yaml:"query,omitempty"` // Query whose results are the violations. Defaults to "data.copilot.deny[msg]".
+}
+
+// IsEmpty returns true if no checks are configured.
+func (c *TemplateChecks) IsEmpty() bool {
+	return c == nil || (len(c.Rules) == 0 && c.GuardRules == "" && c.OPA == nil)
+}
+
+// Recurrence is a period of time that starts on a cron schedule and lasts for a duration.
+type Recurrence struct {
+	Schedule string `json:"schedule" yaml:"schedule"`                     // Cron expression such as "0 9 * * MON-THU".
+	Duration string `json:"duration" yaml:"duration"`                     // Duration such as "8h".
+	Timezone string `json:"timezone,omitempty" yaml:"timezone,omitempty"` // IANA timezone of the schedule. Defaults to UTC.
+}
+
+// DeployFreeze is a named period of time during which deployments are blocked.
+// A freeze either spans from Start to End, or recurs.
+type DeployFreeze struct {
+	Name      string      `json:"name" yaml:"name"`
+	Reason    string      `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Start     *time.Time  `json:"start,omitempty" yaml:"start,omitempty"`
+	End       *time.Time  `json:"end,omitempty" yaml:"end,omitempty"`
+	Recurring *Recurrence `json:"recurring,omitempty" yaml:"recurring,omitempty"`
+}
+
+// IsEmpty returns true if the policy has neither windows, freezes nor checks.
+func (p *DeployPolicy) IsEmpty() bool {
+	return p == nil || (len(p.Windows) == 0 && len(p.Freezes) == 0 && p.Checks.IsEmpty())
+}
+
+// Validate returns an error if a window or a freeze of the policy is misconfigured.
+func (p *DeployPolicy) Validate() error {
+	if p == nil {
+		return nil
+	}
+	for i, w := range p.Windows {
+		if err := w.validate(); err != nil {
+			return fmt.Errorf("validate window %d: %w", i+1, err)
+		}
+	}
+	names := make(map[string]bool)
+	for _, f := range p.Freezes {
+		if err := f.validate(); err != nil {
+			return fmt.Errorf("validate freeze %q: %w", f.Name, err)
+		}
+		if names[f.Name] {
+			return fmt.Errorf("freeze names must be unique, but %q is used more than once", f.Name)
+		}
+		names[f.Name] = true
+	}
+	if p.Checks != nil && p.Checks.OPA != nil && p.Checks.OPA.Bundle == "" {
+		return errors.New(`"checks.opa.bundle" must be specified`)
+	}
+	return nil
+}
+
+// Check returns an ErrDeployFrozen error if a freeze is in effect at the given time,
+// or an ErrOutsideDeployWindow error if the policy has windows and none of them is open.
+func (p *DeployPolicy) Check(at time.Time) error {
+	if p.IsEmpty() {
+		return nil
+	}
+	for _, f := range p.Freezes {
+		active, err := f.activeAt(at)
+		if err != nil {
+			return fmt.Errorf("check freeze %q: %w", f.Name, err)
+		}
+		if active {
+			return &ErrDeployFrozen{Freeze: f}
+		}
+	}
+	if len(p.Windows) == 0 {
+		return nil
+	}
+	for _, w := range p.Windows {
+		open, err := w.activeAt(at)
+		if err != nil {
+			return fmt.Errorf("check window %q: %w", w.Schedule, err)
+		}
+		if open {
+			return nil
+		}
+	}
+	return &ErrOutsideDeployWindow{Windows: p.Windows}
+}
+
+// String returns a human readable description of the recurrence, such as "0 9 * * MON-THU for 8h (America/New_York)".
+func (r Recurrence) String() string {
+	tz := r.Timezone
+	if tz == "" {
+		tz = "UTC"
+	}
+	return fmt.Sprintf("%s for %s (%s)", r.Schedule, r.Duration, tz)
+}
+
+func (r Recurrence) validate() error {
+	if _, err := cron.ParseStandard(r.Schedule); err != nil {
+		return fmt.Errorf("parse schedule %q: %w", r.Schedule, err)
+	}
+	d, err := time.ParseDuration(r.Duration)
+	if err != nil {
+		return fmt.Errorf("parse duration %q: %w", r.Duration, err)
+	}
+	if d <= 0 {
+		return fmt.Errorf("duration %q must be positive", r.Duration)
+	}
+	if _, err := time.LoadLocation(r.Timezone); err != nil {
+		return fmt.Errorf("load timezone %q: %w", r.Timezone, err)
+	}
+	return nil
+}
+
+// activeAt returns true if the recurrence started at most its duration before the given time.
+func (r Recurrence) activeAt(at time.Time) (bool, error) {
+	sched, err := cron.ParseStandard(r.Schedule)
+	if err != nil {
+		return false, fmt.Errorf("parse schedule %q: %w", r.Schedule, err)
+	}
+	d, err := time.ParseDuration(r.Duration)
+	if err != nil {
+		return false, fmt.Errorf("parse duration %q: %w", r.Duration, err)
+	}
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return false, fmt.Errorf("load timezone %q: %w", r.Timezone, err)
+	}
+	start := sched.Next(at.In(loc).Add(-d))
+	return !start.After(at), nil
+}
+
+func (f DeployFreeze) validate() error {
+	if f.Name == "" {
+		return errors.New(`"name" must be specified`)
+	}
+	hasPeriod := f.Start != nil || f.End != nil
+	if hasPeriod && f.Recurring != nil {
+		return errors.New(`must specify one, not both, of "start/end" and "recurring"`)
+	}
+	if f.Recurring != nil {
+		return f.Recurring.validate()
+	}
+	if f.Start == nil || f.End == nil {
+		return errors.New(`"start" and "end" must be specified if "recurring" is not`)
+	}
+	if !f.End.After(*f.Start) {
+		return fmt.Errorf("end %s must be after start %s", f.End.Format(time.RFC3339), f.Start.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func (f DeployFreeze) activeAt(at time.Time) (bool, error) {
+	if f.Recurring != nil {
+		return f.Recurring.activeAt(at)
+	}
+	if f.Start == nil || f.End == nil {
+		return false, nil
+	}
+	return !at.Before(*f.Start) && at.Before(*f.End), nil
+}
+
+// String returns a human readable description of when the freeze is in effect.
+func (f DeployFreeze) String() string {
+	if f.Recurring != nil {
+		return fmt.Sprintf("%s, every %s", f.Name, f.Recurring)
+	}
+	var start, end string
+	if f.Start != nil {
+		start = f.Start.Format(time.RFC3339)
+	}
+	if f.End != nil {
+		end = f.End.Format(time.RFC3339)
+	}
+	return fmt.Sprintf("%s, from %s to %s", f.Name, start, end)
+}
+
+// ErrDeployFrozen means that a deployment was attempted while a freeze is in effect.
+type ErrDeployFrozen struct {
+	Freeze DeployFreeze
+}
+
+func (e *ErrDeployFrozen) Error() string {
+	msg := fmt.Sprintf("deployments are frozen by %q", e.Freeze.Name)
+	if e.Freeze.Reason != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Freeze.Reason)
+	}
+	return msg
+}
+
+// ErrOutsideDeployWindow means that a deployment was attempted while none of the deploy windows is open.
+type ErrOutsideDeployWindow struct {
+	Windows []Recurrence
+}
+
+func (e *ErrOutsideDeployWindow) Error() string {
+	windows := make([]string, len(e.Windows))
+	for i, w := range e.Windows {
+		windows[i] = w.String()
+	}
+	return fmt.Sprintf("deployments are only allowed during the deploy windows: %s", strings.Join(windows, "; "))
+}
@@ -0,0 +1,254 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployPolicy_Validate(t *testing.T) {
+	start := time.Date(2026, time.December, 20, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		in          *DeployPolicy
+		wantedError error
+	}{
+		"nil policy is valid": {},
+		"valid windows and freezes": {
+			in: &DeployPolicy{
+				Windows: []Recurrence{
+					{
+						Schedule: "0 9 * * MON-THU",
+						Duration: "8h",
+						Timezone: "America/New_York",
+					},
+				},
+				Freezes: []DeployFreeze{
+					{
+						Name:  "holidays",
+						Start: &start,
+						End:   aws.Time(start.Add(14 * 24 * time.Hour)),
+					},
+					{
+						Name: "friday-afternoons",
+						Recurring: &Recurrence{
+							Schedule: "0 15 * * FRI",
+							Duration: "9h",
+						},
+					},
+				},
+			},
+		},
+		"invalid window schedule": {
+			in: &DeployPolicy{
+				Windows: []Recurrence{
+					{
+						Schedule: "every morning",
+						Duration: "8h",
+					},
+				},
+			},
+			wantedError: errors.New(`validate window 1: parse schedule "every morning": expected exactly 5 fields, found 2: [every morning]`),
+		},
+		"non-positive window duration": {
+			in: &DeployPolicy{
+				Windows: []Recurrence{
+					{
+						Schedule: "0 9 * * *",
+						Duration: "0s",
+					},
+				},
+			},
+			wantedError: errors.New(`validate window 1: duration "0s" must be positive`),
+		},
+		"freeze without a name": {
+			in: &DeployPolicy{
+				Freezes: []DeployFreeze{
+					{
+						Start: &start,
+						End:   aws.Time(start.Add(time.Hour)),
+					},
+				},
+			},
+			wantedError: errors.New(`validate freeze "": "name" must be specified`),
+		},
+		"freeze with both a period and a recurrence": {
+			in: &DeployPolicy{
+				Freezes: []DeployFreeze{
+					{
+						Name:  "holidays",
+						Start: &start,
+						Recurring: &Recurrence{
+							Schedule: "0 15 * * FRI",
+							Duration: "9h",
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate freeze "holidays": must specify one, not both, of "start/end" and "recurring"`),
+		},
+		"freeze that ends before it starts": {
+			in: &DeployPolicy{
+				Freezes: []DeployFreeze{
+					{
+						Name:  "holidays",
+						Start: &start,
+						End:   aws.Time(start.Add(-time.Hour)),
+					},
+				},
+			},
+			wantedError: errors.New(`validate freeze "holidays": end 2026-12-19T23:00:00Z must be after start 2026-12-20T00:00:00Z`),
+		},
+		"freezes with the same name": {
+			in: &DeployPolicy{
+				Freezes: []DeployFreeze{
+					{
+						Name:  "holidays",
+						Start: &start,
+						End:   aws.Time(start.Add(time.Hour)),
+					},
+					{
+						Name:  "holidays",
+						Start: &start,
+						End:   aws.Time(start.Add(2 * time.Hour)),
+					},
+				},
+			},
+			wantedError: errors.New(`freeze names must be unique, but "holidays" is used more than once`),
+		},
+		"opa check without a bundle": {
+			in: &DeployPolicy{
+				Checks: &TemplateChecks{
+					OPA: &OPACheck{Query: "data.copilot.deny[msg]"},
+				},
+			},
+			wantedError: errors.New(`"checks.opa.bundle" must be specified`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestApplication_CheckDeployPolicies(t *testing.T) {
+	start := time.Date(2026, time.December, 20, 0, 0, 0, 0, time.UTC)
+	holidays := DeployFreeze{
+		Name:   "holidays",
+		Reason: "End of year code freeze.",
+		Start:  &start,
+		End:    aws.Time(start.Add(14 * 24 * time.Hour)),
+	}
+	businessHours := Recurrence{
+		Schedule: "0 9 * * MON-THU",
+		Duration: "8h",
+		Timezone: "America/New_York",
+	}
+	app := &Application{
+		DeployPolicy: &DeployPolicy{
+			Freezes: []DeployFreeze{holidays},
+		},
+		EnvDeployPolicies: map[string]*DeployPolicy{
+			"prod": {
+				Windows: []Recurrence{businessHours},
+			},
+		},
+	}
+	testCases := map[string]struct {
+		env string
+		at  time.Time
+
+		wantedError error
+	}{
+		"allowed in an environment without windows": {
+			env: "test",
+			at:  time.Date(2026, time.October, 17, 3, 0, 0, 0, time.UTC), // Saturday.
+		},
+		"blocked in every environment during an app freeze": {
+			env:         "test",
+			at:          start.Add(time.Hour),
+			wantedError: &ErrDeployFrozen{Freeze: holidays},
+		},
+		"allowed when the freeze is over": {
+			env: "test",
+			at:  start.Add(14 * 24 * time.Hour),
+		},
+		"allowed during an environment window": {
+			env: "prod",
+			at:  time.Date(2026, time.October, 15, 14, 0, 0, 0, time.UTC), // Thursday 10am in New York.
+		},
+		"blocked outside of the environment windows": {
+			env:         "prod",
+			at:          time.Date(2026, time.October, 15, 21, 30, 0, 0, time.UTC), // Thursday 5:30pm in New York.
+			wantedError: &ErrOutsideDeployWindow{Windows: []Recurrence{businessHours}},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := app.CheckDeployPolicies(tc.env, tc.at)
+
+			if tc.wantedError != nil {
+				require.Equal(t, tc.wantedError, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestApplication_TemplateChecks(t *testing.T) {
+	appChecks := &TemplateChecks{Rules: []string{"no-public-s3-buckets"}}
+	prodChecks := &TemplateChecks{GuardRules: "policies/prod.guard"}
+	app := &Application{
+		DeployPolicy: &DeployPolicy{
+			Checks: appChecks,
+		},
+		EnvDeployPolicies: map[string]*DeployPolicy{
+			"test": {
+				Windows: []Recurrence{
+					{
+						Schedule: "0 9 * * *",
+						Duration: "8h",
+					},
+				},
+			},
+			"prod": {
+				Checks: prodChecks,
+			},
+		},
+	}
+
+	require.Equal(t, []*TemplateChecks{appChecks}, app.TemplateChecks("test"))
+	require.Equal(t, []*TemplateChecks{appChecks, prodChecks}, app.TemplateChecks("prod"))
+	require.Nil(t, (&Application{}).TemplateChecks("prod"))
+}
+
+func TestDeployPolicyErrors(t *testing.T) {
+	require.EqualError(t, &ErrDeployFrozen{Freeze: DeployFreeze{Name: "holidays", Reason: "End of year code freeze."}},
+		`deployments are frozen by "holidays": End of year code freeze.`)
+	require.EqualError(t, &ErrOutsideDeployWindow{Windows: []Recurrence{
+		{
+			Schedule: "0 9 * * MON-THU",
+			Duration: "8h",
+			Timezone: "America/New_York",
+		},
+		{
+			Schedule: "0 10 * * FRI",
+			Duration: "2h",
+		},
+	}}, "deployments are only allowed during the deploy windows: 0 9 * * MON-THU for 8h (America/New_York); 0 10 * * FRI for 2h (UTC)")
+}
@@ -0,0 +1,195 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+const (
+	fmtConfigTableName = "copilot-%s-config" // Name of the DynamoDB table that holds the configuration of an application.
+
+	// Attributes of the items of the table.
+	tableParentAttr = "parent" // Partition key: the root path of the parameter, such as "/copilot/applications/demo/environments/".
+	tableNameAttr   = "name"   // Sort key: the last element of the path of the parameter, such as "test".
+	tableValueAttr  = "value"  // The serialized configuration.
+)
+
+// DynamoDB is the interface for the AWS DynamoDB client.
+type DynamoDB interface {
+	CreateTable(in *dynamodb.CreateTableInput) (*dynamodb.CreateTableOutput, error)
+	WaitUntilTableExists(in *dynamodb.DescribeTableInput) error
+	DeleteTable(in *dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error)
+	PutItem(in *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error)
+	GetItem(in *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error)
+	Query(in *dynamodb.QueryInput) (*dynamodb.QueryOutput, error)
+	DeleteItem(in *dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error)
+}
+
+func configTableName(appName string) string {
+	return fmt.Sprintf(fmtConfigTableName, appName)
+}
+
+// dynamoDBParams stores parameters as items of a DynamoDB table.
+// Unlike SSM, reads are strongly consistent and aren't subject to the account-wide SSM throughput limits.
+type dynamoDBParams struct {
+	client DynamoDB
+	table  string
+}
+
+// createTable creates the table of the parameters if it doesn't exist yet, and waits until it's active.
+func (d *dynamoDBParams) createTable(appName string) error {
+	_, err := d.client.CreateTable(&dynamodb.CreateTableInput{
+		TableName:   aws.String(d.table),
+		BillingMode: aws.String(dynamodb.BillingModePayPerRequest),
+		AttributeDefinitions: []*dynamodb.AttributeDefinition{
+			{
+				AttributeName: aws.String(tableParentAttr),
+				AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+			},
+			{
+				AttributeName: aws.String(tableNameAttr),
+				AttributeType: aws.String(dynamodb.ScalarAttributeTypeS),
+			},
+		},
+		KeySchema: []*dynamodb.KeySchemaElement{
+			{
+				AttributeName: aws.String(tableParentAttr),
+				KeyType:       aws.String(dynamodb.KeyTypeHash),
+			},
+			{
+				AttributeName: aws.String(tableNameAttr),
+				KeyType:       aws.String(dynamodb.KeyTypeRange),
+			},
+		},
+		Tags: []*dynamodb.Tag{
+			{
+				Key:   aws.String("copilot-application"),
+				Value: aws.String(appName),
+			},
+		},
+	})
+	if err != nil && !isAWSErrCode(err, dynamodb.ErrCodeResourceInUseException) {
+		return fmt.Errorf("create table %s: %w", d.table, err)
+	}
+	if err := d.client.WaitUntilTableExists(&dynamodb.DescribeTableInput{
+		TableName: aws.String(d.table),
+	}); err != nil {
+		return fmt.Errorf("wait for table %s to be active: %w", d.table, err)
+	}
+	return nil
+}
+
+// deleteTable deletes the table of the parameters. It's a no-op if the table doesn't exist.
+func (d *dynamoDBParams) deleteTable() error {
+	_, err := d.client.DeleteTable(&dynamodb.DeleteTableInput{
+		TableName: aws.String(d.table),
+	})
+	if err != nil && !isAWSErrCode(err, dynamodb.ErrCodeResourceNotFoundException) {
+		return fmt.Errorf("delete table %s: %w", d.table, err)
+	}
+	return nil
+}
+
+func (d *dynamoDBParams) create(p param) error {
+	parent, name := splitParamPath(p.path)
+	_, err := d.client.PutItem(&dynamodb.PutItemInput{
+		TableName: aws.String(d.table),
+		Item: map[string]*dynamodb.AttributeValue{
+			tableParentAttr: {S: aws.String(parent)},
+			tableNameAttr:   {S: aws.String(name)},
+			tableValueAttr:  {S: aws.String(p.value)},
+		},
+		ConditionExpression: aws.String("attribute_not_exists(#name)"),
+		ExpressionAttributeNames: map[string]*string{
+			"#name": aws.String(tableNameAttr),
+		},
+	})
+	if isAWSErrCode(err, dynamodb.ErrCodeConditionalCheckFailedException) {
+		return errParamAlreadyExists
+	}
+	return err
+}
+
+func (d *dynamoDBParams) get(path string) (string, error) {
+	out, err := d.client.GetItem(&dynamodb.GetItemInput{
+		TableName:      aws.String(d.table),
+		Key:            itemKey(path),
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return "", err
+	}
+	value, ok := out.Item[tableValueAttr]
+	if !ok {
+		return "", errParamNotFound
+	}
+	return aws.StringValue(value.S), nil
+}
+
+func (d *dynamoDBParams) list(root string) ([]param, error) {
+	var params []param
+	var startKey map[string]*dynamodb.AttributeValue
+	for {
+		out, err := d.client.Query(&dynamodb.QueryInput{
+			TableName:              aws.String(d.table),
+			KeyConditionExpression: aws.String("#parent = :parent"),
+			ExpressionAttributeNames: map[string]*string{
+				"#parent": aws.String(tableParentAttr),
+			},
+			ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+				":parent": {S: aws.String(root)},
+			},
+			ConsistentRead:    aws.Bool(true),
+			ExclusiveStartKey: startKey,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range out.Items {
+			params = append(params, param{
+				path:  root + aws.StringValue(item[tableNameAttr].S),
+				value: aws.StringValue(item[tableValueAttr].S),
+			})
+		}
+		startKey = out.LastEvaluatedKey
+		if len(startKey) == 0 {
+			break
+		}
+	}
+	return params, nil
+}
+
+func (d *dynamoDBParams) delete(path string) error {
+	out, err := d.client.DeleteItem(&dynamodb.DeleteItemInput{
+		TableName:    aws.String(d.table),
+		Key:          itemKey(path),
+		ReturnValues: aws.String(dynamodb.ReturnValueAllOld),
+	})
+	if err != nil {
+		return err
+	}
+	if len(out.Attributes) == 0 {
+		return errParamNotFound
+	}
+	return nil
+}
+
+// splitParamPath splits a path like "/copilot/applications/demo/environments/test"
+// into its root "/copilot/applications/demo/environments/" and name "test".
+func splitParamPath(p string) (parent, name string) {
+	return path.Split(p)
+}
+
+func itemKey(path string) map[string]*dynamodb.AttributeValue {
+	parent, name := splitParamPath(path)
+	return map[string]*dynamodb.AttributeValue{
+		tableParentAttr: {S: aws.String(parent)},
+		tableNameAttr:   {S: aws.String(name)},
+	}
+}
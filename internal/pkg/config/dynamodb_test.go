@@ -0,0 +1,135 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDynamoDB is an in-memory DynamoDB table keyed by parent and name.
+type fakeDynamoDB struct {
+	tables map[string]map[string]string // Items by "parent|name" of each table.
+}
+
+func newFakeDynamoDB() *fakeDynamoDB {
+	return &fakeDynamoDB{tables: make(map[string]map[string]string)}
+}
+
+func (f *fakeDynamoDB) CreateTable(in *dynamodb.CreateTableInput) (*dynamodb.CreateTableOutput, error) {
+	if _, ok := f.tables[aws.StringValue(in.TableName)]; ok {
+		return nil, awserr.New(dynamodb.ErrCodeResourceInUseException, "table exists", nil)
+	}
+	f.tables[aws.StringValue(in.TableName)] = make(map[string]string)
+	return &dynamodb.CreateTableOutput{}, nil
+}
+
+func (f *fakeDynamoDB) WaitUntilTableExists(in *dynamodb.DescribeTableInput) error {
+	return nil
+}
+
+func (f *fakeDynamoDB) DeleteTable(in *dynamodb.DeleteTableInput) (*dynamodb.DeleteTableOutput, error) {
+	if _, ok := f.tables[aws.StringValue(in.TableName)]; !ok {
+		return nil, awserr.New(dynamodb.ErrCodeResourceNotFoundException, "no table", nil)
+	}
+	delete(f.tables, aws.StringValue(in.TableName))
+	return &dynamodb.DeleteTableOutput{}, nil
+}
+
+func (f *fakeDynamoDB) PutItem(in *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+	table := f.tables[aws.StringValue(in.TableName)]
+	key := aws.StringValue(in.Item[tableParentAttr].S) + "|" + aws.StringValue(in.Item[tableNameAttr].S)
+	if _, ok := table[key]; ok && in.ConditionExpression != nil {
+		return nil, awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "exists", nil)
+	}
+	table[key] = aws.StringValue(in.Item[tableValueAttr].S)
+	return &dynamodb.PutItemOutput{}, nil
+}
+
+func (f *fakeDynamoDB) GetItem(in *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+	value, ok := f.tables[aws.StringValue(in.TableName)][aws.StringValue(in.Key[tableParentAttr].S)+"|"+aws.StringValue(in.Key[tableNameAttr].S)]
+	if !ok {
+		return &dynamodb.GetItemOutput{}, nil
+	}
+	return &dynamodb.GetItemOutput{
+		Item: map[string]*dynamodb.AttributeValue{
+			tableValueAttr: {S: aws.String(value)},
+		},
+	}, nil
+}
+
+func (f *fakeDynamoDB) Query(in *dynamodb.QueryInput) (*dynamodb.QueryOutput, error) {
+	parent := aws.StringValue(in.ExpressionAttributeValues[":parent"].S)
+	var names []string
+	for key := range f.tables[aws.StringValue(in.TableName)] {
+		if len(key) > len(parent) && key[:len(parent)+1] == parent+"|" {
+			names = append(names, key[len(parent)+1:])
+		}
+	}
+	sort.Strings(names)
+	out := &dynamodb.QueryOutput{}
+	for _, name := range names {
+		out.Items = append(out.Items, map[string]*dynamodb.AttributeValue{
+			tableParentAttr: {S: aws.String(parent)},
+			tableNameAttr:   {S: aws.String(name)},
+			tableValueAttr:  {S: aws.String(f.tables[aws.StringValue(in.TableName)][parent+"|"+name])},
+		})
+	}
+	return out, nil
+}
+
+func (f *fakeDynamoDB) DeleteItem(in *dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error) {
+	table := f.tables[aws.StringValue(in.TableName)]
+	key := aws.StringValue(in.Key[tableParentAttr].S) + "|" + aws.StringValue(in.Key[tableNameAttr].S)
+	value, ok := table[key]
+	if !ok {
+		return &dynamodb.DeleteItemOutput{}, nil
+	}
+	delete(table, key)
+	return &dynamodb.DeleteItemOutput{
+		Attributes: map[string]*dynamodb.AttributeValue{
+			tableValueAttr: {S: aws.String(value)},
+		},
+	}, nil
+}
+
+func TestDynamoDBParams(t *testing.T) {
+	// GIVEN
+	client := newFakeDynamoDB()
+	params := &dynamoDBParams{client: client, table: configTableName("phonetool")}
+	require.NoError(t, params.createTable("phonetool"))
+	require.NoError(t, params.createTable("phonetool"), "creating an existing table is a no-op")
+
+	// WHEN
+	require.NoError(t, params.create(param{path: "/copilot/applications/phonetool/environments/test", value: `{"name":"test"}`}))
+	require.NoError(t, params.create(param{path: "/copilot/applications/phonetool/environments/prod", value: `{"name":"prod"}`}))
+	require.NoError(t, params.create(param{path: "/copilot/applications/phonetool/components/api", value: `{"name":"api"}`}))
+
+	// THEN
+	require.ErrorIs(t, params.create(param{path: "/copilot/applications/phonetool/environments/test"}), errParamAlreadyExists)
+
+	value, err := params.get("/copilot/applications/phonetool/environments/test")
+	require.NoError(t, err)
+	require.Equal(t, `{"name":"test"}`, value)
+	_, err = params.get("/copilot/applications/phonetool/environments/staging")
+	require.ErrorIs(t, err, errParamNotFound)
+
+	envs, err := params.list("/copilot/applications/phonetool/environments/")
+	require.NoError(t, err)
+	require.Equal(t, []param{
+		{path: "/copilot/applications/phonetool/environments/prod", value: `{"name":"prod"}`},
+		{path: "/copilot/applications/phonetool/environments/test", value: `{"name":"test"}`},
+	}, envs)
+
+	require.NoError(t, params.delete("/copilot/applications/phonetool/environments/test"))
+	require.ErrorIs(t, params.delete("/copilot/applications/phonetool/environments/test"), errParamNotFound)
+
+	require.NoError(t, params.deleteTable())
+	require.NoError(t, params.deleteTable(), "deleting a missing table is a no-op")
+}
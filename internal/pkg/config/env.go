@@ -5,12 +5,9 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sort"
-
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
 // Environment represents a deployment environment in an application.
@@ -68,67 +65,61 @@ type Telemetry struct {
 // CreateEnvironment instantiates a new environment within an existing App. Skip if
 // the environment already exists in the App.
 func (s *Store) CreateEnvironment(environment *Environment) error {
-	if _, err := s.GetApplication(environment.App); err != nil {
+	app, err := s.GetApplication(environment.App)
+	if err != nil {
 		return err
 	}
-
-	environmentPath := fmt.Sprintf(fmtEnvParamPath, environment.App, environment.Name)
-	data, err := marshal(environment)
+	params, err := s.paramsIn(app.Name, app.ConfigStore)
 	if err != nil {
-		return fmt.Errorf("serializing environment %s: %w", environment.Name, err)
+		return err
 	}
-
-	_, err = s.ssm.PutParameter(&ssm.PutParameterInput{
-		Name:        aws.String(environmentPath),
-		Description: aws.String(fmt.Sprintf("The %s deployment stage", environment.Name)),
-		Type:        aws.String(ssm.ParameterTypeString),
-		Value:       aws.String(data),
-		Tags: []*ssm.Tag{
-			{
-				Key:   aws.String("copilot-application"),
-				Value: aws.String(environment.App),
-			},
-			{
-				Key:   aws.String("copilot-environment"),
-				Value: aws.String(environment.Name),
-			},
-		},
-	})
+	p, err := envParam(environment)
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterAlreadyExists:
-				return nil
-			}
-		}
+		return err
+	}
+	if err := params.create(p); err != nil && !errors.Is(err, errParamAlreadyExists) {
 		return fmt.Errorf("create environment %s in application %s: %w", environment.Name, environment.App, err)
 	}
+	s.invalidateCache()
 	return nil
 }
 
+func envParam(environment *Environment) (param, error) {
+	data, err := marshal(environment)
+	if err != nil {
+		return param{}, fmt.Errorf("serializing environment %s: %w", environment.Name, err)
+	}
+	return param{
+		path:        fmt.Sprintf(fmtEnvParamPath, environment.App, environment.Name),
+		value:       data,
+		description: fmt.Sprintf("The %s deployment stage", environment.Name),
+		tags: []tag{
+			{key: "copilot-application", value: environment.App},
+			{key: "copilot-environment", value: environment.Name},
+		},
+	}, nil
+}
+
 // GetEnvironment gets an environment belonging to a particular application by name. If no environment is found
 // it returns ErrNoSuchEnvironment.
 func (s *Store) GetEnvironment(appName string, environmentName string) (*Environment, error) {
-	environmentPath := fmt.Sprintf(fmtEnvParamPath, appName, environmentName)
-	environmentParam, err := s.ssm.GetParameter(&ssm.GetParameterInput{
-		Name: aws.String(environmentPath),
-	})
-
+	params, err := s.paramsFor(appName)
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterNotFound:
-				return nil, &ErrNoSuchEnvironment{
-					ApplicationName: appName,
-					EnvironmentName: environmentName,
-				}
+		return nil, err
+	}
+	value, err := s.get(params, fmt.Sprintf(fmtEnvParamPath, appName, environmentName))
+	if err != nil {
+		if errors.Is(err, errParamNotFound) {
+			return nil, &ErrNoSuchEnvironment{
+				ApplicationName: appName,
+				EnvironmentName: environmentName,
 			}
 		}
 		return nil, fmt.Errorf("get environment %s in application %s: %w", environmentName, appName, err)
 	}
 
 	var env Environment
-	err = json.Unmarshal([]byte(*environmentParam.Parameter.Value), &env)
+	err = json.Unmarshal([]byte(value), &env)
 	if err != nil {
 		return nil, fmt.Errorf("read configuration for environment %s in application %s: %w", environmentName, appName, err)
 	}
@@ -139,14 +130,18 @@ func (s *Store) GetEnvironment(appName string, environmentName string) (*Environ
 func (s *Store) ListEnvironments(appName string) ([]*Environment, error) {
 	var environments []*Environment
 
+	params, err := s.paramsFor(appName)
+	if err != nil {
+		return nil, err
+	}
 	environmentsPath := fmt.Sprintf(rootEnvParamPath, appName)
-	serializedEnvs, err := s.listParams(environmentsPath)
+	serializedEnvs, err := s.list(params, environmentsPath)
 	if err != nil {
 		return nil, fmt.Errorf("list environments for application %s: %w", appName, err)
 	}
 	for _, serializedEnv := range serializedEnvs {
 		var env Environment
-		if err := json.Unmarshal([]byte(*serializedEnv), &env); err != nil {
+		if err := json.Unmarshal([]byte(serializedEnv.value), &env); err != nil {
 			return nil, fmt.Errorf("read environment configuration for application %s: %w", appName, err)
 		}
 
@@ -156,21 +151,16 @@ func (s *Store) ListEnvironments(appName string) ([]*Environment, error) {
 	return environments, nil
 }
 
-// DeleteEnvironment removes an environment from SSM.
+// DeleteEnvironment removes an environment from the store.
 // If the environment does not exist in the store or is successfully deleted then returns nil. Otherwise, returns an error.
 func (s *Store) DeleteEnvironment(appName, environmentName string) error {
-	paramName := fmt.Sprintf(fmtEnvParamPath, appName, environmentName)
-	_, err := s.ssm.DeleteParameter(&ssm.DeleteParameterInput{
-		Name: aws.String(paramName),
-	})
-
+	params, err := s.paramsFor(appName)
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterNotFound:
-				return nil
-			}
-		}
+		return err
+	}
+	err = params.delete(fmt.Sprintf(fmtEnvParamPath, appName, environmentName))
+	s.invalidateCache()
+	if err != nil && !errors.Is(err, errParamNotFound) {
 		return fmt.Errorf("delete environment %s from application %s: %w", environmentName, appName, err)
 	}
 	return nil
@@ -0,0 +1,101 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// MigrateApplication moves the configuration of the environments and workloads of an application to another backend.
+// The configuration is copied to the new backend before the application is switched to it, and only then removed
+// from the old backend, so that an interrupted migration can safely be run again.
+func (s *Store) MigrateApplication(appName, backend string) error {
+	// Copy the configuration as it is in AWS, and let the next commands read it from the new backend.
+	s.invalidateCache()
+	defer s.invalidateCache()
+	app, err := s.GetApplication(appName)
+	if err != nil {
+		return err
+	}
+	from := app.ConfigStore
+	if from == "" {
+		from = SSMBackend
+	}
+	if from == backend {
+		return nil
+	}
+	if backend == DynamoDBBackend {
+		if err := s.createConfigTable(appName); err != nil {
+			return err
+		}
+	}
+	src, err := s.paramsIn(appName, from)
+	if err != nil {
+		return err
+	}
+	dst, err := s.paramsIn(appName, backend)
+	if err != nil {
+		return err
+	}
+
+	envs, err := src.list(fmt.Sprintf(rootEnvParamPath, appName))
+	if err != nil {
+		return fmt.Errorf("list environments of application %s in %s: %w", appName, from, err)
+	}
+	wklds, err := src.list(fmt.Sprintf(rootWkldParamPath, appName))
+	if err != nil {
+		return fmt.Errorf("list workloads of application %s in %s: %w", appName, from, err)
+	}
+	for _, p := range envs {
+		var env Environment
+		if err := json.Unmarshal([]byte(p.value), &env); err != nil {
+			return fmt.Errorf("read configuration at %s: %w", p.path, err)
+		}
+		p, err := envParam(&env)
+		if err != nil {
+			return err
+		}
+		if err := copyParam(dst, p); err != nil {
+			return fmt.Errorf("copy environment %s to %s: %w", env.Name, backend, err)
+		}
+	}
+	for _, p := range wklds {
+		var wkld Workload
+		if err := json.Unmarshal([]byte(p.value), &wkld); err != nil {
+			return fmt.Errorf("read configuration at %s: %w", p.path, err)
+		}
+		p, err := workloadParam(&wkld)
+		if err != nil {
+			return err
+		}
+		if err := copyParam(dst, p); err != nil {
+			return fmt.Errorf("copy workload %s to %s: %w", wkld.Name, backend, err)
+		}
+	}
+
+	app.ConfigStore = backend
+	if err := s.UpdateApplication(app); err != nil {
+		return err
+	}
+	s.cacheBackend(appName, backend)
+
+	if ddb, ok := src.(*dynamoDBParams); ok {
+		return ddb.deleteTable()
+	}
+	for _, p := range append(envs, wklds...) {
+		if err := src.delete(p.path); err != nil && !errors.Is(err, errParamNotFound) {
+			return fmt.Errorf("delete %s from %s: %w", p.path, from, err)
+		}
+	}
+	return nil
+}
+
+func copyParam(dst paramStore, p param) error {
+	if err := dst.create(p); err != nil && !errors.Is(err, errParamAlreadyExists) {
+		return err
+	}
+	return nil
+}
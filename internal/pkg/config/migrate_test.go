@@ -0,0 +1,98 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeSSM returns an SSM client backed by the params map.
+func newFakeSSM(t *testing.T, params map[string]string) *mockSSM {
+	return &mockSSM{
+		t: t,
+		mockPutParameter: func(t *testing.T, in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+			if _, ok := params[*in.Name]; ok && !aws.BoolValue(in.Overwrite) {
+				return nil, awserr.New(ssm.ErrCodeParameterAlreadyExists, "exists", nil)
+			}
+			params[*in.Name] = *in.Value
+			return &ssm.PutParameterOutput{}, nil
+		},
+		mockGetParameter: func(t *testing.T, in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+			value, ok := params[*in.Name]
+			if !ok {
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil)
+			}
+			return &ssm.GetParameterOutput{
+				Parameter: &ssm.Parameter{Name: in.Name, Value: aws.String(value)},
+			}, nil
+		},
+		mockGetParametersByPath: func(t *testing.T, in *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+			out := &ssm.GetParametersByPathOutput{}
+			for name, value := range params {
+				if strings.HasPrefix(name, *in.Path) && !strings.Contains(strings.TrimPrefix(name, *in.Path), "/") {
+					out.Parameters = append(out.Parameters, &ssm.Parameter{Name: aws.String(name), Value: aws.String(value)})
+				}
+			}
+			return out, nil
+		},
+		mockDeleteParameter: func(t *testing.T, in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+			if _, ok := params[*in.Name]; !ok {
+				return nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil)
+			}
+			delete(params, *in.Name)
+			return &ssm.DeleteParameterOutput{}, nil
+		},
+	}
+}
+
+func TestStore_MigrateApplication(t *testing.T) {
+	// GIVEN
+	ssmParams := map[string]string{
+		"/copilot/applications/phonetool":                       `{"name":"phonetool","account":"1234","domain":"","domainHostedZoneID":"","version":"1.0"}`,
+		"/copilot/applications/phonetool/environments/test":     `{"app":"phonetool","name":"test","region":"us-west-2","accountID":"1234","registryURL":"","executionRoleARN":"","managerRoleARN":""}`,
+		"/copilot/applications/phonetool/components/api":        `{"app":"phonetool","name":"api","type":"Load Balanced Web Service"}`,
+		"/copilot/applications/phonetool/components/api/extras": `unrelated`,
+	}
+	ddb := newFakeDynamoDB()
+	store := &Store{
+		ssm:         newFakeSSM(t, ssmParams),
+		newDynamoDB: func() (DynamoDB, error) { return ddb, nil },
+	}
+
+	// WHEN
+	require.NoError(t, store.MigrateApplication("phonetool", DynamoDBBackend))
+
+	// THEN
+	app, err := store.GetApplication("phonetool")
+	require.NoError(t, err)
+	require.Equal(t, DynamoDBBackend, app.ConfigStore)
+	require.NotContains(t, ssmParams, "/copilot/applications/phonetool/environments/test")
+	require.NotContains(t, ssmParams, "/copilot/applications/phonetool/components/api")
+	require.Len(t, ddb.tables[configTableName("phonetool")], 2)
+
+	env, err := store.GetEnvironment("phonetool", "test")
+	require.NoError(t, err)
+	require.Equal(t, "us-west-2", env.Region)
+	wklds, err := store.ListWorkloads("phonetool")
+	require.NoError(t, err)
+	require.Len(t, wklds, 1)
+	require.Equal(t, "api", wklds[0].Name)
+
+	// WHEN migrating back
+	require.NoError(t, store.MigrateApplication("phonetool", SSMBackend))
+
+	// THEN
+	require.NotContains(t, ddb.tables, configTableName("phonetool"))
+	require.Contains(t, ssmParams, "/copilot/applications/phonetool/environments/test")
+	require.Contains(t, ssmParams, "/copilot/applications/phonetool/components/api")
+	env, err = store.GetEnvironment("phonetool", "test")
+	require.NoError(t, err)
+	require.Equal(t, "us-west-2", env.Region)
+}
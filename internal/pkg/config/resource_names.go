@@ -0,0 +1,162 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Placeholders of the resource name templates.
+const (
+	AppNamePlaceholder      = "${app}"
+	EnvNamePlaceholder      = "${env}"
+	WorkloadNamePlaceholder = "${name}"
+)
+
+const (
+	maxLoadBalancerNameLength = 32
+	maxClusterNameLength      = 255
+)
+
+var (
+	placeholderRegexp      = regexp.MustCompile(`\$\{[^}]*\}`)
+	clusterNameRegexp      = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	loadBalancerNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?$`)
+	logGroupNameRegexp     = regexp.MustCompile(`^[a-zA-Z0-9_/.#-]+$`)
+	repositoryNameRegexp   = regexp.MustCompile(`^[a-z0-9]+([._-][a-z0-9]+)*(/[a-z0-9]+([._-][a-z0-9]+)*)*$`)
+)
+
+// ResourceNames holds the templates of the physical names of the resources that Copilot creates for an application.
+// The templates can reference the "${app}", "${env}" and "${name}" placeholders. An empty template keeps the default name.
+type ResourceNames struct {
+	Cluster              string `json:"cluster,omitempty" yaml:"cluster,omitempty"`                             // ECS cluster of each environment.
+	PublicLoadBalancer   string `json:"publicLoadBalancer,omitempty" yaml:"public_load_balancer,omitempty"`     // Public Application Load Balancer of each environment.
+	InternalLoadBalancer string `json:"internalLoadBalancer,omitempty" yaml:"internal_load_balancer,omitempty"` // Internal Application Load Balancer of each environment.
+	LogGroup             string `json:"logGroup,omitempty" yaml:"log_group,omitempty"`                          // Log group of each workload in each environment.
+	Repository           string `json:"repository,omitempty" yaml:"repository,omitempty"`                       // ECR repository of each workload.
+}
+
+// IsEmpty returns true if no name is customized.
+func (n *ResourceNames) IsEmpty() bool {
+	return n == nil || *n == ResourceNames{}
+}
+
+// Validate returns an error if a template references an unknown placeholder, misses a placeholder
+// that keeps the names unique, or can't produce a valid name.
+func (n *ResourceNames) Validate() error {
+	if n == nil {
+		return nil
+	}
+	templates := []struct {
+		field    string
+		template string
+		required []string
+		valid    *regexp.Regexp
+	}{
+		{"cluster", n.Cluster, []string{AppNamePlaceholder, EnvNamePlaceholder}, clusterNameRegexp},
+		{"public_load_balancer", n.PublicLoadBalancer, []string{AppNamePlaceholder, EnvNamePlaceholder}, loadBalancerNameRegexp},
+		{"internal_load_balancer", n.InternalLoadBalancer, []string{AppNamePlaceholder, EnvNamePlaceholder}, loadBalancerNameRegexp},
+		{"log_group", n.LogGroup, []string{AppNamePlaceholder, EnvNamePlaceholder, WorkloadNamePlaceholder}, logGroupNameRegexp},
+		{"repository", n.Repository, []string{AppNamePlaceholder, WorkloadNamePlaceholder}, repositoryNameRegexp},
+	}
+	for _, t := range templates {
+		if t.template == "" {
+			continue
+		}
+		if err := validateNameTemplate(t.template, t.required, t.valid); err != nil {
+			return fmt.Errorf(`validate "%s": %w`, t.field, err)
+		}
+	}
+	if n.PublicLoadBalancer != "" && n.PublicLoadBalancer == n.InternalLoadBalancer {
+		return fmt.Errorf(`"public_load_balancer" and "internal_load_balancer" must be different to not collide`)
+	}
+	if strings.HasPrefix(n.PublicLoadBalancer, "internal-") || strings.HasPrefix(n.InternalLoadBalancer, "internal-") {
+		return fmt.Errorf(`load balancer names cannot start with "internal-"`)
+	}
+	return nil
+}
+
+func validateNameTemplate(template string, required []string, valid *regexp.Regexp) error {
+	for _, placeholder := range placeholderRegexp.FindAllString(template, -1) {
+		switch placeholder {
+		case AppNamePlaceholder, EnvNamePlaceholder, WorkloadNamePlaceholder:
+		default:
+			return fmt.Errorf("unknown placeholder %s", placeholder)
+		}
+	}
+	for _, placeholder := range required {
+		if !strings.Contains(template, placeholder) {
+			return fmt.Errorf("%q must contain %s so that names don't collide", template, placeholder)
+		}
+	}
+	// Application, environment and workload names only contain lowercase letters, numbers and hyphens.
+	if sample := placeholderRegexp.ReplaceAllString(template, "a"); !valid.MatchString(sample) {
+		return fmt.Errorf("%q must match %s once its placeholders are replaced", template, valid.String())
+	}
+	return nil
+}
+
+// ValidateEnvironment returns an error if the names of the resources of the environment exceed their maximum length.
+func (n *ResourceNames) ValidateEnvironment(app, env string) error {
+	if name := n.ClusterName(app, env); len(name) > maxClusterNameLength {
+		return fmt.Errorf("cluster name %q must not exceed %d characters", name, maxClusterNameLength)
+	}
+	for _, name := range []string{n.PublicLoadBalancerName(app, env), n.InternalLoadBalancerName(app, env)} {
+		if len(name) > maxLoadBalancerNameLength {
+			return fmt.Errorf("load balancer name %q must not exceed %d characters", name, maxLoadBalancerNameLength)
+		}
+	}
+	return nil
+}
+
+// ClusterName returns the name of the cluster of the environment, or an empty string to let CloudFormation generate it.
+func (n *ResourceNames) ClusterName(app, env string) string {
+	if n == nil {
+		return ""
+	}
+	return renderName(n.Cluster, app, env, "")
+}
+
+// PublicLoadBalancerName returns the name of the public load balancer of the environment,
+// or an empty string to let CloudFormation generate it.
+func (n *ResourceNames) PublicLoadBalancerName(app, env string) string {
+	if n == nil {
+		return ""
+	}
+	return renderName(n.PublicLoadBalancer, app, env, "")
+}
+
+// InternalLoadBalancerName returns the name of the internal load balancer of the environment,
+// or an empty string to let CloudFormation generate it.
+func (n *ResourceNames) InternalLoadBalancerName(app, env string) string {
+	if n == nil {
+		return ""
+	}
+	return renderName(n.InternalLoadBalancer, app, env, "")
+}
+
+// LogGroupName returns the name of the log group of the workload in the environment, or an empty string if it isn't customized.
+func (n *ResourceNames) LogGroupName(app, env, wkld string) string {
+	if n == nil {
+		return ""
+	}
+	return renderName(n.LogGroup, app, env, wkld)
+}
+
+// RepositoryName returns the name of the ECR repository of the workload, or an empty string if it isn't customized.
+func (n *ResourceNames) RepositoryName(app, wkld string) string {
+	if n == nil {
+		return ""
+	}
+	return renderName(n.Repository, app, "", wkld)
+}
+
+func renderName(template, app, env, wkld string) string {
+	if template == "" {
+		return ""
+	}
+	return strings.NewReplacer(AppNamePlaceholder, app, EnvNamePlaceholder, env, WorkloadNamePlaceholder, wkld).Replace(template)
+}
@@ -0,0 +1,91 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceNames_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in          *ResourceNames
+		wantedError error
+	}{
+		"valid templates": {
+			in: &ResourceNames{
+				Cluster:              "ecs-${app}-${env}",
+				PublicLoadBalancer:   "${app}-${env}-pub",
+				InternalLoadBalancer: "${app}-${env}-int",
+				LogGroup:             "/org/${app}/${env}/${name}",
+				Repository:           "org/${app}/${name}",
+			},
+		},
+		"unknown placeholder": {
+			in:          &ResourceNames{Cluster: "${app}-${env}-${region}"},
+			wantedError: errors.New(`validate "cluster": unknown placeholder ${region}`),
+		},
+		"missing placeholder": {
+			in:          &ResourceNames{LogGroup: "/org/${app}/${name}"},
+			wantedError: errors.New(`validate "log_group": "/org/${app}/${name}" must contain ${env} so that names don't collide`),
+		},
+		"invalid characters": {
+			in:          &ResourceNames{Repository: "Org/${app}/${name}"},
+			wantedError: errors.New(`validate "repository": "Org/${app}/${name}" must match ^[a-z0-9]+([._-][a-z0-9]+)*(/[a-z0-9]+([._-][a-z0-9]+)*)*$ once its placeholders are replaced`),
+		},
+		"load balancers collide": {
+			in: &ResourceNames{
+				PublicLoadBalancer:   "${app}-${env}",
+				InternalLoadBalancer: "${app}-${env}",
+			},
+			wantedError: errors.New(`"public_load_balancer" and "internal_load_balancer" must be different to not collide`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestResourceNames_ValidateEnvironment(t *testing.T) {
+	names := &ResourceNames{PublicLoadBalancer: "${app}-${env}-public"}
+
+	require.NoError(t, names.ValidateEnvironment("shop", "prod"))
+	require.EqualError(t, names.ValidateEnvironment("shopping-cart", "production-eu"),
+		`load balancer name "shopping-cart-production-eu-public" must not exceed 32 characters`)
+}
+
+func TestApplication_ResourceNames(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		app := &Application{Name: "shop"}
+
+		require.Equal(t, "/copilot/shop-prod-api", app.LogGroupName("prod", "api"))
+		require.Equal(t, "shop/api", app.RepositoryName("api"))
+		require.Empty(t, app.ResourceNames.ClusterName("shop", "prod"))
+	})
+	t.Run("custom templates", func(t *testing.T) {
+		app := &Application{
+			Name: "shop",
+			ResourceNames: &ResourceNames{
+				Cluster:    "ecs-${app}-${env}",
+				LogGroup:   "/org/${app}/${env}/${name}",
+				Repository: "org/${app}/${name}",
+			},
+		}
+
+		require.Equal(t, "/org/shop/prod/api", app.LogGroupName("prod", "api"))
+		require.Equal(t, "org/shop/api", app.RepositoryName("api"))
+		require.Equal(t, "ecs-shop-prod", app.ResourceNames.ClusterName("shop", "prod"))
+		require.Empty(t, app.ResourceNames.PublicLoadBalancerName("shop", "prod"))
+	})
+}
@@ -14,8 +14,11 @@ import (
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cache"
 )
 
 // Parameter name formats for resources in an application. Applications are laid out in SSM
@@ -50,47 +53,52 @@ type SSM interface {
 	DeleteParameter(in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
 }
 
-// Store is in charge of fetching and creating applications, environment, services and other workloads, and pipeline configuration in SSM.
+// Store is in charge of fetching and creating applications, environment, services and other workloads, and pipeline configuration.
+// Applications are stored in SSM, and the environments and workloads of an application in the backend selected for the application.
 type Store struct {
 	sts       IAMIdentityGetter
 	ssm       SSM
 	appRegion string
+
+	// newDynamoDB returns a client in the application region for the applications that store their configuration in DynamoDB.
+	// If nil, the configuration of all applications is read from SSM without looking up their backend.
+	newDynamoDB func() (DynamoDB, error)
+	appBackends map[string]string // Cached backend of each application.
+
+	// cache keeps the configuration read by recent commands. If nil, the configuration is always read from AWS.
+	cache      *cache.Cache
+	cacheScope string // Credentials and region of the configuration in the cache.
 }
 
 // NewSSMStore returns a new store, allowing you to query or create Applications, Environments, Services, and other workloads.
+// The DynamoDB client of the applications that store their configuration in DynamoDB is created from the default session.
 func NewSSMStore(sts IAMIdentityGetter, ssm SSM, appRegion string) *Store {
 	return &Store{
 		sts:       sts,
 		ssm:       ssm,
 		appRegion: appRegion,
+		newDynamoDB: func() (DynamoDB, error) {
+			sess, err := sessions.ImmutableProvider().Default()
+			if err != nil {
+				return nil, err
+			}
+			return dynamodb.New(sess, aws.NewConfig().WithRegion(appRegion)), nil
+		},
 	}
 }
 
-func (s *Store) listParams(path string) ([]*string, error) {
-	var serializedParams []*string
-
-	var nextToken *string
-	for {
-		params, err := s.ssm.GetParametersByPath(&ssm.GetParametersByPathInput{
-			Path:      aws.String(path),
-			Recursive: aws.Bool(false),
-			NextToken: nextToken,
-		})
-
-		if err != nil {
-			return nil, err
-		}
-
-		for _, param := range params.Parameters {
-			serializedParams = append(serializedParams, param.Value)
-		}
-
-		nextToken = params.NextToken
-		if nextToken == nil {
-			break
+// NewCachedSSMStore returns a new store like NewSSMStore, except that the configuration read with the credentials
+// of an SSM client is reused by the next commands for a few minutes.
+// Only use it in commands that don't change any resource, like completions and descriptions, since changes made
+// from other machines take a few minutes to show up.
+func NewCachedSSMStore(sts IAMIdentityGetter, ssmClient SSM, appRegion string) *Store {
+	s := NewSSMStore(sts, ssmClient, appRegion)
+	if client, ok := ssmClient.(*ssm.SSM); ok {
+		if scope := cache.Scope(client.Config.Credentials, appRegion); scope != "" {
+			s.cache, s.cacheScope = cache.New(), scope
 		}
 	}
-	return serializedParams, nil
+	return s
 }
 
 // Retrieves the caller's Account ID with a best effort. If it fails to fetch the Account ID,
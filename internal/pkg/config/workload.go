@@ -8,9 +8,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 )
 
@@ -40,44 +37,41 @@ func (s *Store) CreateJob(job *Workload) error {
 }
 
 func (s *Store) createWorkload(wkld *Workload) error {
-	if _, err := s.GetApplication(wkld.App); err != nil {
+	app, err := s.GetApplication(wkld.App)
+	if err != nil {
 		return err
 	}
-
-	wkldPath := fmt.Sprintf(fmtWkldParamPath, wkld.App, wkld.Name)
-	data, err := marshal(wkld)
+	params, err := s.paramsIn(app.Name, app.ConfigStore)
 	if err != nil {
-		return fmt.Errorf("serialize data: %w", err)
+		return err
 	}
-
-	_, err = s.ssm.PutParameter(&ssm.PutParameterInput{
-		Name:        aws.String(wkldPath),
-		Description: aws.String(fmt.Sprintf("Copilot %s %s", wkld.Type, wkld.Name)),
-		Type:        aws.String(ssm.ParameterTypeString),
-		Value:       aws.String(data),
-		Tags: []*ssm.Tag{
-			{
-				Key:   aws.String("copilot-application"),
-				Value: aws.String(wkld.App),
-			},
-			{
-				Key:   aws.String("copilot-service"),
-				Value: aws.String(wkld.Name),
-			},
-		},
-	})
+	p, err := workloadParam(wkld)
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterAlreadyExists:
-				return nil
-			}
-		}
 		return err
 	}
+	if err := params.create(p); err != nil && !errors.Is(err, errParamAlreadyExists) {
+		return err
+	}
+	s.invalidateCache()
 	return nil
 }
 
+func workloadParam(wkld *Workload) (param, error) {
+	data, err := marshal(wkld)
+	if err != nil {
+		return param{}, fmt.Errorf("serialize data: %w", err)
+	}
+	return param{
+		path:        fmt.Sprintf(fmtWkldParamPath, wkld.App, wkld.Name),
+		value:       data,
+		description: fmt.Sprintf("Copilot %s %s", wkld.Type, wkld.Name),
+		tags: []tag{
+			{key: "copilot-application", value: wkld.App},
+			{key: "copilot-service", value: wkld.Name},
+		},
+	}, nil
+}
+
 // GetService gets a service belonging to a particular application by name. If no job or svc is found
 // it returns ErrNoSuchService.
 func (s *Store) GetService(appName, svcName string) (*Workload, error) {
@@ -151,23 +145,21 @@ func (s *Store) GetWorkload(appName, name string) (*Workload, error) {
 }
 
 func (s *Store) getWorkloadParam(appName, name string) ([]byte, error) {
-	wlPath := fmt.Sprintf(fmtWkldParamPath, appName, name)
-	wlParam, err := s.ssm.GetParameter(&ssm.GetParameterInput{
-		Name: aws.String(wlPath),
-	})
+	params, err := s.paramsFor(appName)
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterNotFound:
-				return nil, &errNoSuchWorkload{
-					App:  appName,
-					Name: name,
-				}
+		return nil, err
+	}
+	value, err := s.get(params, fmt.Sprintf(fmtWkldParamPath, appName, name))
+	if err != nil {
+		if errors.Is(err, errParamNotFound) {
+			return nil, &errNoSuchWorkload{
+				App:  appName,
+				Name: name,
 			}
 		}
 		return nil, err
 	}
-	return []byte(*wlParam.Parameter.Value), nil
+	return []byte(value), nil
 }
 
 // ListServices returns all services belonging to a particular application.
@@ -217,14 +209,18 @@ func (s *Store) ListWorkloads(appName string) ([]*Workload, error) {
 func (s *Store) listWorkloads(appName string) ([]*Workload, error) {
 	var workloads []*Workload
 
+	params, err := s.paramsFor(appName)
+	if err != nil {
+		return nil, err
+	}
 	workloadsPath := fmt.Sprintf(rootWkldParamPath, appName)
-	serializedWklds, err := s.listParams(workloadsPath)
+	serializedWklds, err := s.list(params, workloadsPath)
 	if err != nil {
 		return nil, err
 	}
 	for _, serializedWkld := range serializedWklds {
 		var wkld Workload
-		if err := json.Unmarshal([]byte(*serializedWkld), &wkld); err != nil {
+		if err := json.Unmarshal([]byte(serializedWkld.value), &wkld); err != nil {
 			return nil, err
 		}
 
@@ -233,7 +229,7 @@ func (s *Store) listWorkloads(appName string) ([]*Workload, error) {
 	return workloads, nil
 }
 
-// DeleteService removes a service from SSM.
+// DeleteService removes a service from the store.
 // If the service does not exist in the store or is successfully deleted then returns nil. Otherwise, returns an error.
 func (s *Store) DeleteService(appName, svcName string) error {
 	if err := s.deleteWorkload(appName, svcName); err != nil {
@@ -242,7 +238,7 @@ func (s *Store) DeleteService(appName, svcName string) error {
 	return nil
 }
 
-// DeleteJob removes a job from SSM.
+// DeleteJob removes a job from the store.
 // If the job does not exist in the store or is successfully deleted then returns nil. Otherwise, returns an error.
 func (s *Store) DeleteJob(appName, jobName string) error {
 	if err := s.deleteWorkload(appName, jobName); err != nil {
@@ -252,18 +248,13 @@ func (s *Store) DeleteJob(appName, jobName string) error {
 }
 
 func (s *Store) deleteWorkload(appName, wkldName string) error {
-	paramName := fmt.Sprintf(fmtWkldParamPath, appName, wkldName)
-	_, err := s.ssm.DeleteParameter(&ssm.DeleteParameterInput{
-		Name: aws.String(paramName),
-	})
-
+	params, err := s.paramsFor(appName)
 	if err != nil {
-		if aerr, ok := err.(awserr.Error); ok {
-			switch aerr.Code() {
-			case ssm.ErrCodeParameterNotFound:
-				return nil
-			}
-		}
+		return err
+	}
+	err = params.delete(fmt.Sprintf(fmtWkldParamPath, appName, wkldName))
+	s.invalidateCache()
+	if err != nil && !errors.Is(err, errParamNotFound) {
 		return err
 	}
 	return nil
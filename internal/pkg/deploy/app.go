@@ -9,20 +9,22 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 )
 
 const appDNSDelegationRoleName = "DNSDelegationRole"
 
 // CreateAppInput holds the fields required to create an application stack set.
 type CreateAppInput struct {
-	Name                  string            // Name of the application that needs to be created.
-	AccountID             string            // AWS account ID to administrate the application.
-	DNSDelegationAccounts []string          // Accounts to grant DNS access to for this application.
-	DomainName            string            // DNS Name used for this application.
-	DomainHostedZoneID    string            // Hosted Zone ID for the domain.
-	PermissionsBoundary   string            // Name of the IAM Managed Policy to set a permissions boundary.
-	AdditionalTags        map[string]string // AdditionalTags are labels applied to resources under the application.
-	Version               string            // The version of the application template to create the stack/stackset. If empty, creates the legacy stack/stackset.
+	Name                  string                 // Name of the application that needs to be created.
+	AccountID             string                 // AWS account ID to administrate the application.
+	DNSDelegationAccounts []string               // Accounts to grant DNS access to for this application.
+	DomainName            string                 // DNS Name used for this application.
+	DomainHostedZoneID    string                 // Hosted Zone ID for the domain.
+	PermissionsBoundary   string                 // Name of the IAM Managed Policy to set a permissions boundary.
+	AdditionalTags        map[string]string      // AdditionalTags are labels applied to resources under the application.
+	Version               string                 // The version of the application template to create the stack/stackset. If empty, creates the legacy stack/stackset.
+	ImageRetention        *config.ImageRetention // Lifecycle settings of the ECR repositories. If nil, the settings of the deployed stack set are kept.
 }
 
 // AppInformation holds information about the application that need to be propagated to the env stacks and workload stacks.
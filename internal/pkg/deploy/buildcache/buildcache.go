@@ -0,0 +1,243 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package buildcache records the images and artifacts that the deployments from a workspace already pushed,
+// so that later deployments of unchanged workloads skip building and uploading them again.
+//
+// Images are identified by a fingerprint of everything that goes into their build: the Dockerfile, the files of the
+// build context, the build arguments, the target stage and the platform. Artifacts are identified by their content.
+// The cache is a JSON file in the "copilot/.cache" directory of the workspace, which is ignored by git.
+package buildcache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	// DirName is the name of the directory of the cache under the copilot directory of the workspace.
+	DirName   = ".cache"
+	fileName  = "build.json"
+	gitignore = ".gitignore"
+
+	// fingerprintVersion changes whenever the inputs of a fingerprint change, so that stale entries are never matched.
+	fingerprintVersion = "v1"
+)
+
+// Cache is a build cache that is safe for concurrent use by the deployments of several workloads.
+type Cache struct {
+	fs  afero.Fs
+	dir string
+
+	mu      sync.Mutex
+	content content
+}
+
+type content struct {
+	Images  map[string]string `json:"images"`  // Repository URI and image fingerprint to the digest of the pushed image.
+	Uploads map[string]string `json:"uploads"` // Bucket, key and content hash to the URL of the uploaded object.
+}
+
+// Open reads the build cache under the copilot directory of a workspace.
+// A missing or unreadable cache file is treated as an empty cache.
+func Open(fs afero.Fs, copilotDirPath string) *Cache {
+	c := &Cache{
+		fs:  fs,
+		dir: filepath.Join(copilotDirPath, DirName),
+		content: content{
+			Images:  make(map[string]string),
+			Uploads: make(map[string]string),
+		},
+	}
+	data, err := afero.ReadFile(fs, filepath.Join(c.dir, fileName))
+	if err != nil {
+		return c
+	}
+	var stored content
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return c
+	}
+	for k, v := range stored.Images {
+		c.content.Images[k] = v
+	}
+	for k, v := range stored.Uploads {
+		c.content.Uploads[k] = v
+	}
+	return c
+}
+
+// Image returns the digest of the image with the fingerprint that was pushed to the repository, if any.
+func (c *Cache) Image(repoURI, fingerprint string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	digest, ok := c.content.Images[imageKey(repoURI, fingerprint)]
+	return digest, ok
+}
+
+// SetImage records that the image with the fingerprint was pushed to the repository with the digest.
+func (c *Cache) SetImage(repoURI, fingerprint, digest string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.content.Images[imageKey(repoURI, fingerprint)] = digest
+	return c.save()
+}
+
+// ForgetImage removes an image from the cache, for example once it is deleted from the repository.
+func (c *Cache) ForgetImage(repoURI, fingerprint string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.content.Images, imageKey(repoURI, fingerprint))
+	return c.save()
+}
+
+func (c *Cache) upload(bucket, key, hash string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	url, ok := c.content.Uploads[uploadKey(bucket, key, hash)]
+	return url, ok
+}
+
+func (c *Cache) setUpload(bucket, key, hash, url string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.content.Uploads[uploadKey(bucket, key, hash)] = url
+	return c.save()
+}
+
+// save writes the cache to disk. It must be called while holding the lock.
+func (c *Cache) save() error {
+	if err := c.fs.MkdirAll(c.dir, 0755); err != nil {
+		return fmt.Errorf("create build cache directory %s: %w", c.dir, err)
+	}
+	// Ignore the whole directory, as the cache depends on the machine that built the images.
+	if err := afero.WriteFile(c.fs, filepath.Join(c.dir, gitignore), []byte("*\n"), 0644); err != nil {
+		return fmt.Errorf("write %s of build cache: %w", gitignore, err)
+	}
+	data, err := json.MarshalIndent(c.content, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal build cache: %w", err)
+	}
+	if err := afero.WriteFile(c.fs, filepath.Join(c.dir, fileName), data, 0644); err != nil {
+		return fmt.Errorf("write build cache: %w", err)
+	}
+	return nil
+}
+
+func imageKey(repoURI, fingerprint string) string {
+	return repoURI + "@" + fingerprint
+}
+
+func uploadKey(bucket, key, hash string) string {
+	return fmt.Sprintf("%s/%s@%s", bucket, key, hash)
+}
+
+// ImageInput holds the inputs of an image build that determine its content.
+type ImageInput struct {
+	Dockerfile string // Path to the Dockerfile.
+	Context    string // Path to the build context directory.
+	Args       map[string]string
+	Target     string
+	Platform   string
+}
+
+// ImageFingerprint returns a hash of the Dockerfile, of the files in the build context, and of the build parameters.
+// Files ignored by the .dockerignore file are hashed too, so a change to one of them rebuilds the image
+// even though it wouldn't be part of it.
+func ImageFingerprint(fs afero.Fs, in ImageInput) (string, error) {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00target=%s\x00platform=%s\x00", fingerprintVersion, in.Target, in.Platform)
+	args := make([]string, 0, len(in.Args))
+	for name := range in.Args {
+		args = append(args, name)
+	}
+	sort.Strings(args)
+	for _, name := range args {
+		fmt.Fprintf(h, "arg:%s=%s\x00", name, in.Args[name])
+	}
+	dockerfile, err := afero.ReadFile(fs, in.Dockerfile)
+	if err != nil {
+		return "", fmt.Errorf("read Dockerfile %s: %w", in.Dockerfile, err)
+	}
+	fmt.Fprintf(h, "dockerfile:%d\x00", len(dockerfile))
+	h.Write(dockerfile)
+	err = afero.Walk(fs, in.Context, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(in.Context, path)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == ".git" || info.Name() == DirName {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			fmt.Fprintf(h, "other:%s:%s\x00", filepath.ToSlash(rel), info.Mode())
+			return nil
+		}
+		f, err := fs.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		fmt.Fprintf(h, "file:%s:%s:%d\x00", filepath.ToSlash(rel), info.Mode().Perm(), info.Size())
+		_, err = io.Copy(h, f)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("hash build context %s: %w", in.Context, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+type uploader interface {
+	Upload(bucket, key string, data io.Reader) (string, error)
+}
+
+// Uploader skips the uploads of objects that were already uploaded with the same content.
+type Uploader struct {
+	cache    *Cache
+	uploader uploader
+}
+
+// Uploader wraps an uploader so that it records its uploads in the cache.
+func (c *Cache) Uploader(u uploader) *Uploader {
+	return &Uploader{
+		cache:    c,
+		uploader: u,
+	}
+}
+
+// Upload uploads the data to the key of the bucket, unless the same data was already uploaded there,
+// and returns the URL of the object.
+func (u *Uploader) Upload(bucket, key string, data io.Reader) (string, error) {
+	content, err := io.ReadAll(data)
+	if err != nil {
+		return "", fmt.Errorf("read content of %s: %w", key, err)
+	}
+	sum := sha256.Sum256(content)
+	hash := hex.EncodeToString(sum[:])
+	if url, ok := u.cache.upload(bucket, key, hash); ok {
+		return url, nil
+	}
+	url, err := u.uploader.Upload(bucket, key, bytes.NewReader(content))
+	if err != nil {
+		return "", err
+	}
+	// The object is uploaded, so failing to record it only costs another upload next time.
+	_ = u.cache.setUpload(bucket, key, hash, url)
+	return url, nil
+}
@@ -0,0 +1,187 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package buildcache
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_Image(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	cache := Open(fs, "/ws/copilot")
+
+	// WHEN
+	require.NoError(t, cache.SetImage("1111.dkr.ecr.us-west-2.amazonaws.com/demo/fe", "abc", "sha256:1"))
+	reopened := Open(fs, "/ws/copilot")
+
+	// THEN
+	digest, ok := reopened.Image("1111.dkr.ecr.us-west-2.amazonaws.com/demo/fe", "abc")
+	require.True(t, ok)
+	require.Equal(t, "sha256:1", digest)
+	_, ok = reopened.Image("1111.dkr.ecr.eu-west-1.amazonaws.com/demo/fe", "abc")
+	require.False(t, ok, "images are cached per repository")
+	ignored, err := afero.ReadFile(fs, "/ws/copilot/.cache/.gitignore")
+	require.NoError(t, err)
+	require.Equal(t, "*\n", string(ignored))
+
+	require.NoError(t, reopened.ForgetImage("1111.dkr.ecr.us-west-2.amazonaws.com/demo/fe", "abc"))
+	_, ok = Open(fs, "/ws/copilot").Image("1111.dkr.ecr.us-west-2.amazonaws.com/demo/fe", "abc")
+	require.False(t, ok)
+}
+
+func TestOpen_CorruptedFile(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/ws/copilot/.cache/build.json", []byte("{not json"), 0644))
+
+	// WHEN
+	cache := Open(fs, "/ws/copilot")
+
+	// THEN
+	_, ok := cache.Image("repo", "abc")
+	require.False(t, ok)
+	require.NoError(t, cache.SetImage("repo", "abc", "sha256:1"))
+}
+
+func TestImageFingerprint(t *testing.T) {
+	newFS := func() afero.Fs {
+		fs := afero.NewMemMapFs()
+		_ = afero.WriteFile(fs, "/ws/fe/Dockerfile", []byte("FROM nginx\nCOPY . /app"), 0644)
+		_ = afero.WriteFile(fs, "/ws/fe/index.html", []byte("<h1>hello</h1>"), 0644)
+		_ = afero.WriteFile(fs, "/ws/fe/.git/HEAD", []byte("ref: refs/heads/main"), 0644)
+		return fs
+	}
+	in := ImageInput{
+		Dockerfile: "/ws/fe/Dockerfile",
+		Context:    "/ws/fe",
+		Args:       map[string]string{"GO_VERSION": "1.20", "ENV": "test"},
+		Platform:   "linux/amd64",
+	}
+	base, err := ImageFingerprint(newFS(), in)
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		change func(fs afero.Fs, in *ImageInput)
+
+		wantedSame bool
+	}{
+		"is stable": {
+			change:     func(fs afero.Fs, in *ImageInput) {},
+			wantedSame: true,
+		},
+		"ignores the git directory": {
+			change: func(fs afero.Fs, in *ImageInput) {
+				_ = afero.WriteFile(fs, "/ws/fe/.git/HEAD", []byte("ref: refs/heads/feature"), 0644)
+			},
+			wantedSame: true,
+		},
+		"changes with a file of the context": {
+			change: func(fs afero.Fs, in *ImageInput) {
+				_ = afero.WriteFile(fs, "/ws/fe/index.html", []byte("<h1>bye</h1>"), 0644)
+			},
+		},
+		"changes with a new file in the context": {
+			change: func(fs afero.Fs, in *ImageInput) {
+				_ = afero.WriteFile(fs, "/ws/fe/static/app.js", []byte(""), 0644)
+			},
+		},
+		"changes with the Dockerfile": {
+			change: func(fs afero.Fs, in *ImageInput) {
+				_ = afero.WriteFile(fs, "/ws/fe/Dockerfile", []byte("FROM nginx:alpine\nCOPY . /app"), 0644)
+			},
+		},
+		"changes with a build argument": {
+			change: func(fs afero.Fs, in *ImageInput) {
+				in.Args = map[string]string{"GO_VERSION": "1.21", "ENV": "test"}
+			},
+		},
+		"changes with the platform": {
+			change: func(fs afero.Fs, in *ImageInput) {
+				in.Platform = "linux/arm64"
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := newFS()
+			changed := in
+			tc.change(fs, &changed)
+
+			// WHEN
+			got, err := ImageFingerprint(fs, changed)
+
+			// THEN
+			require.NoError(t, err)
+			if tc.wantedSame {
+				require.Equal(t, base, got)
+			} else {
+				require.NotEqual(t, base, got)
+			}
+		})
+	}
+}
+
+func TestImageFingerprint_MissingDockerfile(t *testing.T) {
+	_, err := ImageFingerprint(afero.NewMemMapFs(), ImageInput{Dockerfile: "/ws/fe/Dockerfile", Context: "/ws/fe"})
+	require.ErrorContains(t, err, "read Dockerfile /ws/fe/Dockerfile")
+}
+
+type fakeUploader struct {
+	uploads []string
+	err     error
+}
+
+func (f *fakeUploader) Upload(bucket, key string, data io.Reader) (string, error) {
+	if f.err != nil {
+		return "", f.err
+	}
+	content, _ := io.ReadAll(data)
+	f.uploads = append(f.uploads, key+"="+string(content))
+	return "https://" + bucket + ".s3.amazonaws.com/" + key, nil
+}
+
+func TestUploader_Upload(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	s3 := &fakeUploader{}
+	up := Open(fs, "/ws/copilot").Uploader(s3)
+
+	// WHEN
+	url, err := up.Upload("bucket", "manual/addons/fe/template.yml", strings.NewReader("Resources: {}"))
+	require.NoError(t, err)
+	cachedURL, err := Open(fs, "/ws/copilot").Uploader(s3).Upload("bucket", "manual/addons/fe/template.yml", strings.NewReader("Resources: {}"))
+	require.NoError(t, err)
+	_, err = up.Upload("bucket", "manual/addons/fe/template.yml", strings.NewReader("Resources: {Queue: {}}"))
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, "https://bucket.s3.amazonaws.com/manual/addons/fe/template.yml", url)
+	require.Equal(t, url, cachedURL)
+	require.Equal(t, []string{
+		"manual/addons/fe/template.yml=Resources: {}",
+		"manual/addons/fe/template.yml=Resources: {Queue: {}}",
+	}, s3.uploads)
+}
+
+func TestUploader_UploadError(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	up := Open(fs, "/ws/copilot").Uploader(&fakeUploader{err: errors.New("some error")})
+
+	// WHEN
+	_, err := up.Upload("bucket", "key", strings.NewReader("data"))
+
+	// THEN
+	require.EqualError(t, err, "some error")
+	exists, _ := afero.Exists(fs, "/ws/copilot/.cache/build.json")
+	require.False(t, exists, "failed uploads are not cached")
+}
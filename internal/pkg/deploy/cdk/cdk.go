@@ -0,0 +1,110 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cdk exports the CloudFormation stacks generated by Copilot as AWS CDK applications,
+// so that teams can keep evolving the infrastructure with the CDK.
+package cdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/spf13/afero"
+)
+
+// stackPropNames are the props of "cdk.StackProps" that parameters can't be named after.
+var stackPropNames = []string{
+	"analyticsReporting", "crossRegionReferences", "description", "env", "permissionsBoundary",
+	"stackName", "suppressTemplateIndentation", "synthesizer", "tags", "terminationProtection",
+}
+
+var templates = template.New()
+
+// App is a CDK application that includes a CloudFormation template with the "cloudformation-include" module.
+type App struct {
+	StackName     string // Name of the CloudFormation stack.
+	TemplateFile  string // Path of the template relative to the directory of the application.
+	Configuration string // Template configuration with the "Parameters" and "Tags" of the stack, as serialized by "package".
+}
+
+type templateConfiguration struct {
+	Parameters map[string]*string `json:"Parameters"`
+	Tags       map[string]*string `json:"Tags"`
+}
+
+// Write writes the files of the CDK application under dir.
+// Each parameter of the template becomes a prop of the CDK stack that defaults to its current value.
+func (a App) Write(fs afero.Fs, dir string) error {
+	var cfg templateConfiguration
+	if err := json.Unmarshal([]byte(a.Configuration), &cfg); err != nil {
+		return fmt.Errorf("unmarshal template configuration of stack %s: %w", a.StackName, err)
+	}
+	opts := template.CDKExportOpts{
+		StackName:    a.StackName,
+		TemplateFile: filepath.ToSlash(a.TemplateFile),
+	}
+	for _, name := range sortedKeys(cfg.Parameters) {
+		opts.Parameters = append(opts.Parameters, template.CDKExportParameter{
+			Name:     name,
+			PropName: propName(name),
+			Value:    value(cfg.Parameters[name]),
+		})
+	}
+	for _, key := range sortedKeys(cfg.Tags) {
+		opts.Tags = append(opts.Tags, template.CDKExportTag{
+			Key:   key,
+			Value: value(cfg.Tags[key]),
+		})
+	}
+	return templates.WalkExportCDKDir(opts, func(name string, content *template.Content) error {
+		path := filepath.Join(dir, name)
+		if err := fs.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("make directories along %q: %w", filepath.Dir(path), err)
+		}
+		if err := afero.WriteFile(fs, path, content.Bytes(), 0644); err != nil {
+			return fmt.Errorf("write file at %q: %w", path, err)
+		}
+		return nil
+	})
+}
+
+// propName converts a parameter name like "ContainerImage" or "VPCId" to a prop name like "containerImage" or "vpcId".
+func propName(param string) string {
+	runes := []rune(param)
+	upper := 0
+	for upper < len(runes) && unicode.IsUpper(runes[upper]) {
+		upper++
+	}
+	// Keep the last upper case letter of an acronym if it starts the next word, as in "VPCId".
+	if upper > 1 && upper < len(runes) && unicode.IsLower(runes[upper]) {
+		upper--
+	}
+	name := strings.ToLower(string(runes[:upper])) + string(runes[upper:])
+	for _, reserved := range stackPropNames {
+		if name == reserved {
+			return name + "Parameter"
+		}
+	}
+	return name
+}
+
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func sortedKeys(m map[string]*string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
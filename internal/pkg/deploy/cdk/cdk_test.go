@@ -0,0 +1,92 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cdk
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApp_Write(t *testing.T) {
+	t.Run("writes the CDK application with the parameters as props", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		app := App{
+			StackName:    "demo-test-api",
+			TemplateFile: "api-test.stack.yml",
+			Configuration: `{
+  "Parameters": {
+    "ContainerImage": "nginx",
+    "VPCId": "vpc-1234",
+    "Description": "say \"hi\""
+  },
+  "Tags": {
+    "copilot-application": "demo"
+  }
+}`,
+		}
+
+		// WHEN
+		err := app.Write(fs, "infrastructure")
+
+		// THEN
+		require.NoError(t, err)
+		for _, name := range []string{"README.md", "package.json", "cdk.json", "tsconfig.json", ".gitignore"} {
+			ok, _ := afero.Exists(fs, filepath.Join("infrastructure", name))
+			require.True(t, ok, "%s should exist", name)
+		}
+		stack, err := afero.ReadFile(fs, filepath.Join("infrastructure", "stack.ts"))
+		require.NoError(t, err)
+		require.Equal(t, `import * as cdk from 'aws-cdk-lib';
+import { Construct } from 'constructs';
+import * as path from 'path';
+
+export interface CopilotStackProps extends cdk.StackProps {
+    readonly containerImage: string;
+    readonly descriptionParameter: string;
+    readonly vpcId: string;
+}
+
+export class CopilotStack extends cdk.Stack {
+    public readonly template: cdk.cloudformation_include.CfnInclude;
+
+    constructor (scope: Construct, id: string, props: CopilotStackProps) {
+        super(scope, id, props);
+        this.template = new cdk.cloudformation_include.CfnInclude(this, 'Template', {
+            templateFile: path.join(__dirname, "api-test.stack.yml"),
+            parameters: {
+                "ContainerImage": props.containerImage,
+                "Description": props.descriptionParameter,
+                "VPCId": props.vpcId,
+            },
+        });
+    }
+}
+`, string(stack))
+		bin, err := afero.ReadFile(fs, filepath.Join("infrastructure", "bin", "app.ts"))
+		require.NoError(t, err)
+		require.Equal(t, `#!/usr/bin/env node
+import * as cdk from 'aws-cdk-lib';
+import { CopilotStack } from '../stack';
+
+const app = new cdk.App();
+new CopilotStack(app, 'CopilotStack', {
+    stackName: "demo-test-api",
+    containerImage: "nginx",
+    descriptionParameter: "say \"hi\"",
+    vpcId: "vpc-1234",
+    tags: {
+        "copilot-application": "demo",
+    },
+});
+`, string(bin))
+	})
+	t.Run("returns an error if the configuration is invalid", func(t *testing.T) {
+		err := App{StackName: "demo-test-api", Configuration: "Parameters:"}.Write(afero.NewMemMapFs(), "infrastructure")
+		require.EqualError(t, err, "unmarshal template configuration of stack demo-test-api: invalid character 'P' looking for beginning of value")
+	})
+}
@@ -55,7 +55,8 @@ func (cf CloudFormation) DeployApp(in *deploy.CreateAppInput) error {
 	}
 
 	blankAppTemplate, err := appConfig.ResourceTemplate(&stack.AppResourcesConfig{
-		App: appConfig.Name,
+		App:            appConfig.Name,
+		ImageRetention: in.ImageRetention,
 	})
 	if err != nil {
 		return err
@@ -73,13 +74,27 @@ func (cf CloudFormation) DeployApp(in *deploy.CreateAppInput) error {
 
 // UpgradeApplication upgrades the application stack to the latest version.
 func (cf CloudFormation) UpgradeApplication(in *deploy.CreateAppInput) error {
+	return cf.redeployApplication(in, true)
+}
+
+// UpdateApplicationTags redeploys the application stack and stack set with the tags in in.AdditionalTags.
+// The stack set instances pass the tags on to the regional resources of the application, such as the ECR repositories.
+func (cf CloudFormation) UpdateApplicationTags(in *deploy.CreateAppInput) error {
+	return cf.redeployApplication(in, false)
+}
+
+// redeployApplication updates the application stack and stack set.
+// If keepTags is true, the tags of the deployed application stack are kept instead of in.AdditionalTags.
+func (cf CloudFormation) redeployApplication(in *deploy.CreateAppInput, keepTags bool) error {
 	appConfig := stack.NewAppStackConfig(in)
 	appStack, err := cf.cfnClient.Describe(appConfig.StackName())
 	if err != nil {
 		return fmt.Errorf("get existing application infrastructure stack: %w", err)
 	}
 	in.DNSDelegationAccounts = stack.DNSDelegatedAccountsForStack(appStack.SDK())
-	in.AdditionalTags = toMap(appStack.Tags)
+	if keepTags {
+		in.AdditionalTags = toMap(appStack.Tags)
+	}
 	appConfig = stack.NewAppStackConfig(in)
 	if err := cf.upgradeAppStack(appConfig); err != nil {
 		var empty *cloudformation.ErrChangeSetEmpty
@@ -101,6 +116,9 @@ func (cf CloudFormation) upgradeAppStackSet(config *stack.AppStackConfig) error
 			return err
 		}
 		previouslyDeployedConfig.Version += 1
+		if config.ImageRetention != nil {
+			previouslyDeployedConfig.ImageRetention = config.ImageRetention
+		}
 		err = cf.deployAppConfig(config, previouslyDeployedConfig, true /* updating template resources should update all instances*/)
 		if err == nil {
 			return nil
@@ -193,10 +211,11 @@ func (cf CloudFormation) removeDNSDelegationAndCrossAccountAccess(appStack *stac
 		return err
 	}
 	newDeploymentConfig := &stack.AppResourcesConfig{
-		Version:   appResourcesConfig.Version + 1,
-		Workloads: appResourcesConfig.Workloads,
-		Accounts:  newAccountList,
-		App:       appResourcesConfig.App,
+		Version:        appResourcesConfig.Version + 1,
+		Workloads:      appResourcesConfig.Workloads,
+		Accounts:       newAccountList,
+		App:            appResourcesConfig.App,
+		ImageRetention: appResourcesConfig.ImageRetention,
 	}
 	if err := cf.deployAppConfig(newCfg, newDeploymentConfig, true); err != nil {
 		return err
@@ -304,6 +323,13 @@ func AddWorkloadToAppOptWithoutECR(s *stack.AppResourcesWorkload) {
 	s.WithECR = false
 }
 
+// AddWorkloadToAppOptWithImageRetention adds a workload to app with its own lifecycle settings for the ECR repo.
+func AddWorkloadToAppOptWithImageRetention(retention *config.ImageRetention) AddWorkloadToAppOpt {
+	return func(s *stack.AppResourcesWorkload) {
+		s.ImageRetention = retention
+	}
+}
+
 // AddServiceToApp attempts to add new service specific resources to the application resource stack.
 // Currently, this means that we'll set up an ECR repo with a policy for all envs to be able
 // to pull from it.
@@ -351,8 +377,9 @@ func (cf CloudFormation) addWorkloadToApp(app *config.Application, wlName string
 		return nil
 	}
 	newAppResourcesService := &stack.AppResourcesWorkload{
-		Name:    wlName,
-		WithECR: true,
+		Name:           wlName,
+		WithECR:        true,
+		RepositoryName: app.ResourceNames.RepositoryName(app.Name, wlName),
 	}
 	for _, opt := range opts {
 		opt(newAppResourcesService)
@@ -360,10 +387,11 @@ func (cf CloudFormation) addWorkloadToApp(app *config.Application, wlName string
 	wlList = append(wlList, *newAppResourcesService)
 
 	newDeploymentConfig := stack.AppResourcesConfig{
-		Version:   previouslyDeployedConfig.Version + 1,
-		Workloads: wlList,
-		Accounts:  previouslyDeployedConfig.Accounts,
-		App:       appConfig.Name,
+		Version:        previouslyDeployedConfig.Version + 1,
+		Workloads:      wlList,
+		Accounts:       previouslyDeployedConfig.Accounts,
+		App:            appConfig.Name,
+		ImageRetention: previouslyDeployedConfig.ImageRetention,
 	}
 	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig, shouldAddNewWl); err != nil {
 		return err
@@ -494,10 +522,11 @@ func (cf CloudFormation) removeWorkloadFromApp(app *config.Application, wlName s
 	}
 
 	newDeploymentConfig := stack.AppResourcesConfig{
-		Version:   previouslyDeployedConfig.Version + 1,
-		Workloads: wlList,
-		Accounts:  previouslyDeployedConfig.Accounts,
-		App:       appConfig.Name,
+		Version:        previouslyDeployedConfig.Version + 1,
+		Workloads:      wlList,
+		Accounts:       previouslyDeployedConfig.Accounts,
+		App:            appConfig.Name,
+		ImageRetention: previouslyDeployedConfig.ImageRetention,
 	}
 	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig, shouldRemoveWl); err != nil {
 		return err
@@ -546,10 +575,11 @@ func (cf CloudFormation) AddEnvToApp(opts *AddEnvToAppOpts) error {
 	}
 
 	newDeploymentConfig := stack.AppResourcesConfig{
-		Version:   previouslyDeployedConfig.Version + 1,
-		Workloads: previouslyDeployedConfig.Workloads,
-		Accounts:  accountList,
-		App:       appConfig.Name,
+		Version:        previouslyDeployedConfig.Version + 1,
+		Workloads:      previouslyDeployedConfig.Workloads,
+		Accounts:       accountList,
+		App:            appConfig.Name,
+		ImageRetention: previouslyDeployedConfig.ImageRetention,
 	}
 
 	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig, shouldAddNewAccountID); err != nil {
@@ -349,6 +349,39 @@ func TestCloudFormation_UpgradeApplication(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_UpdateApplicationTags(t *testing.T) {
+	t.Run("deploys the application stack with the new tags instead of the existing ones", func(t *testing.T) {
+		// GIVEN
+		var gotTags map[string]string
+		cf := &CloudFormation{
+			cfnClient: &cloudformationtest.Double{
+				DescribeFn: func(string) (*cloudformation.StackDescription, error) {
+					return &cloudformation.StackDescription{
+						Tags: []*awscfn.Tag{
+							{Key: aws.String("team"), Value: aws.String("growth")},
+						},
+					}, nil
+				},
+				UpdateFn: func(s *cloudformation.Stack) (string, error) {
+					gotTags = toMap(s.Tags)
+					return "", errors.New("some error")
+				},
+			},
+			console: mockFileWriter{Writer: &strings.Builder{}},
+		}
+
+		// WHEN
+		err := cf.UpdateApplicationTags(&deploy.CreateAppInput{
+			Name:           "phonetool",
+			AdditionalTags: map[string]string{"team": "payments"},
+		})
+
+		// THEN
+		require.EqualError(t, err, `upgrade stack "phonetool-infrastructure-roles": some error`)
+		require.Equal(t, "payments", gotTags["team"])
+	})
+}
+
 func TestCloudFormation_AddEnvToApp(t *testing.T) {
 	mockApp := config.Application{
 		Name:      "testapp",
@@ -24,13 +24,14 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codestar"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/stream"
-	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/template/lint"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"golang.org/x/sync/errgroup"
@@ -42,7 +43,9 @@ const (
 
 	// CloudFormation resource types.
 	ecsServiceResourceType    = "AWS::ECS::Service"
+	certificateResourceType   = "AWS::CertificateManager::Certificate"
 	envControllerResourceType = "Custom::EnvControllerFunction"
+	blueGreenResourceType     = "Custom::BlueGreenDeploymentFunction"
 )
 
 // CloudFormation's error types to compare against.
@@ -99,6 +102,10 @@ type cwClient interface {
 	stream.CloudWatchDescriber
 }
 
+type codeDeployClient interface {
+	stream.CodeDeployDescriber
+}
+
 type cfnClient interface {
 	// Methods augmented by the aws wrapper struct.
 	Create(*cloudformation.Stack) (string, error)
@@ -106,6 +113,8 @@ type cfnClient interface {
 	WaitForCreate(ctx context.Context, stackName string) error
 	Update(*cloudformation.Stack) (string, error)
 	UpdateAndWait(*cloudformation.Stack) error
+	UpdateTags(stackName string, tags map[string]string) (string, error)
+	UpdateParameters(stackName string, params map[string]string) (string, error)
 	WaitForUpdate(ctx context.Context, stackName string) error
 	Delete(stackName string) error
 	DeleteAndWait(stackName string) error
@@ -188,6 +197,7 @@ type CloudFormation struct {
 	cpClient          codePipelineClient
 	ecsClient         ecsClient
 	cwClient          cwClient
+	codeDeployClient  codeDeployClient
 	regionalClient    func(region string) cfnClient
 	appStackSet       stackSetClient
 	s3Client          s3Client
@@ -195,6 +205,7 @@ type CloudFormation struct {
 	regionalECRClient func(region string) imageRemover
 	region            string
 	console           progress.FileWriter
+	durations         *deployDurations
 
 	// cached variables.
 	cachedDeployedStack *cloudformation.StackDescription
@@ -208,11 +219,12 @@ type CloudFormation struct {
 // New returns a configured CloudFormation client.
 func New(sess *session.Session, opts ...OptFn) CloudFormation {
 	client := CloudFormation{
-		cfnClient:      cloudformation.New(sess),
-		codeStarClient: codestar.New(sess),
-		cpClient:       codepipeline.New(sess),
-		ecsClient:      ecs.New(sess),
-		cwClient:       cloudwatch.New(sess),
+		cfnClient:        cloudformation.New(sess),
+		codeStarClient:   codestar.New(sess),
+		cpClient:         codepipeline.New(sess),
+		ecsClient:        ecs.New(sess),
+		cwClient:         cloudwatch.New(sess),
+		codeDeployClient: codedeploy.New(sess),
 		regionalClient: func(region string) cfnClient {
 			return cloudformation.New(sess.Copy(&aws.Config{
 				Region: aws.String(region),
@@ -230,8 +242,9 @@ func New(sess *session.Session, opts ...OptFn) CloudFormation {
 				Region: aws.String(region),
 			}))
 		},
-		region:  aws.StringValue(sess.Config.Region),
-		console: new(discardFile),
+		region:    aws.StringValue(sess.Config.Region),
+		console:   new(discardFile),
+		durations: newDeployDurations(),
 	}
 	for _, opt := range opts {
 		opt(&client)
@@ -247,6 +260,173 @@ func (cf CloudFormation) Template(stackName string) (string, error) {
 	return cf.cfnClient.TemplateBody(stackName)
 }
 
+// StackParameters returns the values of a deployed stack's parameters keyed by parameter name.
+func (cf CloudFormation) StackParameters(stackName string) (map[string]string, error) {
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		return nil, err
+	}
+	params := make(map[string]string, len(descr.Parameters))
+	for _, param := range descr.Parameters {
+		params[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+	return params, nil
+}
+
+// StackEvents returns the events of a stack since the given time in chronological order,
+// such as the events of a deployment that started at that time.
+func (cf CloudFormation) StackEvents(stackName string, since time.Time) ([]cloudformation.StackEvent, error) {
+	var events []cloudformation.StackEvent
+	var nextToken *string
+	for {
+		out, err := cf.cfnClient.DescribeStackEvents(&sdkcloudformation.DescribeStackEventsInput{
+			NextToken: nextToken,
+			StackName: aws.String(stackName),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe stack events for stack %s: %w", stackName, err)
+		}
+		done := out.NextToken == nil
+		// Events are returned from the most recent to the oldest.
+		for _, event := range out.StackEvents {
+			if aws.TimeValue(event.Timestamp).Before(since) {
+				done = true
+				break
+			}
+			events = append(events, cloudformation.StackEvent(*event))
+		}
+		if done {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+	return events, nil
+}
+
+// ListEnvironmentStacks returns the names of the stacks deployed in an environment, such as the environment, workload and task stacks.
+// Nested stacks are not included since they inherit the tags of their parent stack.
+func (cf CloudFormation) ListEnvironmentStacks(appName, envName string) ([]string, error) {
+	stacks, err := cf.cfnClient.ListStacksWithTags(map[string]string{
+		deploy.AppTagKey: appName,
+		deploy.EnvTagKey: envName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list stacks of environment %s: %w", envName, err)
+	}
+	var names []string
+	for _, s := range stacks {
+		if s.ParentId != nil {
+			continue
+		}
+		names = append(names, aws.StringValue(s.StackName))
+	}
+	return names, nil
+}
+
+// UpdateStackTags removes the tags with the keys in removed from a deployed stack, then adds or overwrites the tags in added.
+// The template and parameters of the stack stay the same, and CloudFormation propagates the new tags to the stack's resources.
+func (cf CloudFormation) UpdateStackTags(stackName string, added map[string]string, removed []string) error {
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		return fmt.Errorf("describe stack %s: %w", stackName, err)
+	}
+	updated := toMap(descr.Tags)
+	for _, key := range removed {
+		delete(updated, key)
+	}
+	for key, val := range added {
+		updated[key] = val
+	}
+
+	in := &executeAndRenderChangeSetInput{
+		stackName:        stackName,
+		stackDescription: fmt.Sprintf("Updating the tags of stack %s", stackName),
+	}
+	in.createChangeSet = func() (changeSetID string, err error) {
+		spinner := progress.NewSpinner(cf.console)
+		label := fmt.Sprintf("Proposing tag changes for stack %s", stackName)
+		spinner.Start(label)
+		changeSetID, err = cf.cfnClient.UpdateTags(stackName, updated)
+		if err != nil {
+			msg := log.Serrorf("%s\n", label)
+			var errChangeSetEmpty *cloudformation.ErrChangeSetEmpty
+			if errors.As(err, &errChangeSetEmpty) {
+				msg = fmt.Sprintf("- No tag changes for stack %s\n", stackName)
+			}
+			spinner.Stop(msg)
+			return "", err
+		}
+		spinner.Stop(log.Ssuccessf("%s\n", label))
+		return changeSetID, nil
+	}
+	if err := cf.executeAndRenderChangeSet(in); err != nil {
+		var errChangeSetEmpty *cloudformation.ErrChangeSetEmpty
+		if errors.As(err, &errChangeSetEmpty) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// UpdateStackParameters updates the values of the parameters in params of a deployed stack, and renders progress updates
+// until the update is done. The template and the values of the other parameters stay the same.
+func (cf CloudFormation) UpdateStackParameters(stackName string, params map[string]string) error {
+	in := &executeAndRenderChangeSetInput{
+		stackName:        stackName,
+		stackDescription: fmt.Sprintf("Updating the parameters of stack %s", stackName),
+	}
+	in.createChangeSet = func() (changeSetID string, err error) {
+		spinner := progress.NewSpinner(cf.console)
+		label := fmt.Sprintf("Proposing infrastructure changes for stack %s", stackName)
+		spinner.Start(label)
+		changeSetID, err = cf.cfnClient.UpdateParameters(stackName, params)
+		if err != nil {
+			msg := log.Serrorf("%s\n", label)
+			var errChangeSetEmpty *cloudformation.ErrChangeSetEmpty
+			if errors.As(err, &errChangeSetEmpty) {
+				msg = fmt.Sprintf("- No new infrastructure changes for stack %s\n", stackName)
+			}
+			spinner.Stop(msg)
+			return "", cf.handleStackError(stackName, err)
+		}
+		spinner.Stop(log.Ssuccessf("%s\n", label))
+		return changeSetID, nil
+	}
+	return cf.executeAndRenderChangeSet(in)
+}
+
+// NestedStackTemplate returns the template of the stack nested under the logical ID in a deployed stack.
+// If the nested stack is not deployed yet, returns an empty template.
+func (cf CloudFormation) NestedStackTemplate(stackName, logicalID string) (string, error) {
+	id, err := cf.nestedStackID(stackName, logicalID)
+	if err != nil {
+		return "", err
+	}
+	if id == "" {
+		return "", nil
+	}
+	return cf.cfnClient.TemplateBody(id)
+}
+
+// nestedStackID returns the ID of the stack nested under the logical ID in a deployed stack.
+// If the nested stack is not deployed yet, returns an empty string.
+func (cf CloudFormation) nestedStackID(stackName, logicalID string) (string, error) {
+	resources, err := cf.cfnClient.StackResources(stackName)
+	if err != nil {
+		return "", err
+	}
+	for _, resource := range resources {
+		if aws.StringValue(resource.LogicalResourceId) == logicalID {
+			return aws.StringValue(resource.PhysicalResourceId), nil
+		}
+	}
+	return "", nil
+}
+
 // IsEmptyErr returns true if the error occurred because the cloudformation resource does not exist or does not contain any sub-resources.
 func IsEmptyErr(err error) bool {
 	type isEmpty interface {
@@ -257,20 +437,6 @@ func IsEmptyErr(err error) bool {
 	return errors.As(err, &emptyErr)
 }
 
-// errorEvents returns the list of status reasons of failed resource events
-func (cf CloudFormation) errorEvents(stackName string) ([]string, error) {
-	events, err := cf.cfnClient.ErrorEvents(stackName)
-	if err != nil {
-		return nil, err
-	}
-	var reasons []string
-	for _, event := range events {
-		// CFN error messages end with a '. (Service' and only the first sentence is useful, the rest is error codes.
-		reasons = append(reasons, strings.Split(aws.StringValue(event.ResourceStatusReason), ". (Service")[0])
-	}
-	return reasons, nil
-}
-
 type executeAndRenderChangeSetInput struct {
 	stackName        string
 	stackDescription string
@@ -393,6 +559,11 @@ func (cf CloudFormation) renderChangeSet(ctx context.Context, changeSetID string
 	if err != nil {
 		return err
 	}
+	durationKey := fmt.Sprintf("%s/%s", cf.region, in.stackName)
+	if estimate, ok := cf.durations.estimate(durationKey); ok {
+		renderer = progress.ETARenderer(renderer, estimate, progress.RenderOptions{})
+	}
+	startTime := time.Now()
 	g.Go(func() error {
 		_, err := progress.Render(ctx, progress.NewTabbedFileWriter(cf.console), renderer)
 		return err
@@ -403,6 +574,8 @@ func (cf CloudFormation) renderChangeSet(ctx context.Context, changeSetID string
 	if err := cf.errOnFailedStack(in.stackName); err != nil {
 		return err
 	}
+	// The duration is only used for estimates, so failing to record it isn't an error.
+	_ = cf.durations.record(durationKey, time.Since(startTime))
 	return nil
 }
 
@@ -610,12 +783,29 @@ func (cf CloudFormation) changeRenderers(in changeRenderersInput) ([]progress.Re
 					Ctx:        in.ctx,
 					RenderOpts: in.opts,
 				})
+		case aws.StringValue(change.ResourceChange.ResourceType) == certificateResourceType:
+			renderer = progress.ListeningCertificateResourceRenderer(in.stackStreamer, logicalID, description, progress.ResourceRendererOpts{
+				RenderOpts: in.opts,
+			})
+		case aws.StringValue(change.ResourceChange.ResourceType) == blueGreenResourceType:
+			renderer = cf.createBlueGreenDeploymentRenderer(&blueGreenDeploymentRendererInput{
+				g:                 in.g,
+				ctx:               in.ctx,
+				workloadStackName: in.stackName,
+				workloadTimestamp: in.changeSetTimestamp,
+				change:            change,
+				description:       description,
+				serviceStack:      in.stackStreamer,
+				renderOpts:        in.opts,
+			})
 		case change.ResourceChange.ChangeSetId != nil:
 			// The resource change is a nested stack.
 			changeSetID := aws.StringValue(change.ResourceChange.ChangeSetId)
 			stackName := parseStackNameFromARN(aws.StringValue(change.ResourceChange.PhysicalResourceId))
+			opts := in.opts
+			opts.Collapse = true // Keep the output short once the nested stack is updated.
 
-			r, err := cf.createChangeSetRenderer(in.g, in.ctx, changeSetID, stackName, description, in.opts)
+			r, err := cf.createChangeSetRenderer(in.g, in.ctx, changeSetID, stackName, description, opts)
 			if err != nil {
 				return nil, err
 			}
@@ -680,6 +870,42 @@ func (cf CloudFormation) createEnvControllerRenderer(in *envControllerRendererIn
 	}), nil
 }
 
+type blueGreenDeploymentRendererInput struct {
+	g                 *errgroup.Group
+	ctx               context.Context
+	workloadStackName string
+	workloadTimestamp time.Time
+	change            *sdkcloudformation.Change
+	description       string
+	serviceStack      progress.StackSubscriber
+	renderOpts        progress.RenderOptions
+}
+
+func (cf CloudFormation) createBlueGreenDeploymentRenderer(in *blueGreenDeploymentRendererInput) progress.DynamicRenderer {
+	// The CodeDeploy application and deployment group of a service are both named after its stack.
+	deploymentStreamer := stream.NewBlueGreenDeploymentStreamer(cf.codeDeployClient, in.workloadStackName, in.workloadStackName, in.workloadTimestamp)
+	ctx, cancel := context.WithCancel(in.ctx)
+	in.g.Go(func() error {
+		if err := stream.Stream(ctx, deploymentStreamer); err != nil {
+			if errors.Is(err, context.Canceled) {
+				// The deployment streamer was canceled on purpose, do not return an error.
+				// This occurs once the blue/green deployment action is done, for example when no new deployment was needed.
+				return nil
+			}
+			return err
+		}
+		return nil
+	})
+	return progress.ListeningBlueGreenDeploymentRenderer(progress.BlueGreenDeploymentConfig{
+		Description:            in.description,
+		RenderOpts:             in.renderOpts,
+		ActionStreamer:         in.serviceStack,
+		ActionLogicalID:        aws.StringValue(in.change.ResourceChange.LogicalResourceId),
+		DeploymentStreamer:     deploymentStreamer,
+		CancelDeploymentStream: cancel,
+	})
+}
+
 type renderStackInput struct {
 	group *errgroup.Group // Group of go routines.
 
@@ -754,23 +980,6 @@ func (cf CloudFormation) deleteAndRenderStack(name, description string, deleteFn
 	return nil
 }
 
-type errFailedService struct {
-	stackName    string
-	resourceType string
-	status       string
-}
-
-func (e *errFailedService) RecommendActions() string {
-	if e.resourceType == "AWS::AppRunner::Service" {
-		return fmt.Sprintf("You may fix the error by updating the service code or the manifest configuration.\n"+
-			"You can then retry deploying your service by running %s.", color.HighlightCode("copilot svc deploy"))
-	}
-	return ""
-}
-func (e *errFailedService) Error() string {
-	return fmt.Sprintf("stack %s did not complete successfully and exited with status %s", e.stackName, e.status)
-}
-
 func (cf CloudFormation) errOnFailedStack(stackName string) error {
 	stack, err := cf.cfnClient.Describe(stackName)
 	if err != nil {
@@ -779,15 +988,9 @@ func (cf CloudFormation) errOnFailedStack(stackName string) error {
 	status := aws.StringValue(stack.StackStatus)
 	if cloudformation.StackStatus(status).IsFailure() {
 		events, _ := cf.cfnClient.ErrorEvents(stackName)
-		var failedResourceType string
-		if len(events) > 0 {
-			failedResourceType = aws.StringValue(events[0].ResourceType)
-		}
-		return &errFailedService{
-			stackName:    stackName,
-			resourceType: failedResourceType,
-			status:       status,
-		}
+		err := failedStackError(stackName, events)
+		err.Message = fmt.Sprintf("stack %s did not complete successfully and exited with status %s", stackName, status)
+		return err
 	}
 	return nil
 }
@@ -797,6 +1000,9 @@ func toStack(config StackConfiguration) (*cloudformation.Stack, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err := lint.Validate(config.StackName(), template, lint.MaxTemplateBodySize); err != nil {
+		return nil, err
+	}
 	stack := cloudformation.NewStack(config.StackName(), template)
 	stack.Parameters, err = config.Parameters()
 	if err != nil {
@@ -468,7 +468,7 @@ func testDeployWorkload_RenderNewlyCreatedStackWithAddons(t *testing.T, stackNam
 	defer ctrl.Finish()
 	m := mocks.NewMockcfnClient(ctrl)
 	mS3Client := mocks.NewMocks3Client(ctrl)
-	mS3Client.EXPECT().Upload("mockBucket", "manual/templates/myapp-myenv-mysvc/5cde0f1298f41f7d1c8b907a36992a7a513225a2615bd6e307bf1a9149b06b40.yml", gomock.Any()).Return("mockURL", nil)
+	mS3Client.EXPECT().Upload("mockBucket", "manual/templates/myapp-myenv-mysvc/ab82a52a9ab64d76782ca3847cb0fb35e7eb2c2aab4582a17646dbe8ccf6dc7f.yml", gomock.Any()).Return("mockURL", nil)
 
 	// Mocks for the parent stack.
 	m.EXPECT().Create(gomock.Any()).Return("1234", nil)
@@ -588,7 +588,7 @@ Resources:
 	require.NoError(t, err)
 	require.Contains(t, buf.String(), "An ECS cluster")
 	require.Contains(t, buf.String(), "An Addons CloudFormation Stack for your additional AWS resources")
-	require.Contains(t, buf.String(), "A DynamoDB table to store data")
+	require.NotContains(t, buf.String(), "A DynamoDB table to store data", "the addons stack should be collapsed once it's done")
 }
 
 func testDeployTask_OnCreateChangeSetFailure(t *testing.T, when func(cf CloudFormation) error) {
@@ -862,7 +862,7 @@ Resources:
 	require.NoError(t, err)
 	require.Contains(t, buf.String(), "An ECS cluster")
 	require.Contains(t, buf.String(), "An Addons CloudFormation Stack for your additional AWS resources")
-	require.Contains(t, buf.String(), "A DynamoDB table to store data")
+	require.NotContains(t, buf.String(), "A DynamoDB table to store data", "the addons stack should be collapsed once it's done")
 }
 
 func TestCloudFormation_Template(t *testing.T) {
@@ -910,3 +910,380 @@ func TestCloudFormation_Template(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudFormation_StackParameters(t *testing.T) {
+	const inStackName = "phonetool-test-frontend"
+	testCases := map[string]struct {
+		inClient     func(ctrl *gomock.Controller) *mocks.MockcfnClient
+		wantedParams map[string]string
+		wantedError  error
+	}{
+		"error describing the stack": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe(inStackName).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns the parameters of the stack": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe(inStackName).Return(&cloudformation.StackDescription{
+					Parameters: []*sdkcloudformation.Parameter{
+						{
+							ParameterKey:   aws.String("ContainerImage"),
+							ParameterValue: aws.String("repo@sha256:abc"),
+						},
+						{
+							ParameterKey:   aws.String("TaskCount"),
+							ParameterValue: aws.String("1"),
+						},
+					},
+				}, nil)
+				return m
+			},
+			wantedParams: map[string]string{
+				"ContainerImage": "repo@sha256:abc",
+				"TaskCount":      "1",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := &CloudFormation{
+				cfnClient: tc.inClient(ctrl),
+			}
+
+			// WHEN
+			got, gotErr := cf.StackParameters(inStackName)
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantedParams, got)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_StackEvents(t *testing.T) {
+	const inStackName = "phonetool-test-frontend"
+	since := time.Date(2023, time.January, 2, 15, 4, 5, 0, time.UTC)
+	event := func(id string, at time.Time) *sdkcloudformation.StackEvent {
+		return &sdkcloudformation.StackEvent{EventId: aws.String(id), Timestamp: aws.Time(at)}
+	}
+	testCases := map[string]struct {
+		inClient     func(ctrl *gomock.Controller) *mocks.MockcfnClient
+		wantedEvents []string
+		wantedError  error
+	}{
+		"wrap the error": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().DescribeStackEvents(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("describe stack events for stack phonetool-test-frontend: some error"),
+		},
+		"returns the events since the time in chronological order without reading older pages": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().DescribeStackEvents(&sdkcloudformation.DescribeStackEventsInput{
+					StackName: aws.String(inStackName),
+				}).Return(&sdkcloudformation.DescribeStackEventsOutput{
+					StackEvents: []*sdkcloudformation.StackEvent{
+						event("3", since.Add(2*time.Minute)),
+						event("2", since.Add(time.Minute)),
+					},
+					NextToken: aws.String("page2"),
+				}, nil)
+				m.EXPECT().DescribeStackEvents(&sdkcloudformation.DescribeStackEventsInput{
+					StackName: aws.String(inStackName),
+					NextToken: aws.String("page2"),
+				}).Return(&sdkcloudformation.DescribeStackEventsOutput{
+					StackEvents: []*sdkcloudformation.StackEvent{
+						event("1", since),
+						event("0", since.Add(-time.Hour)),
+					},
+					NextToken: aws.String("page3"),
+				}, nil)
+				return m
+			},
+			wantedEvents: []string{"1", "2", "3"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := &CloudFormation{
+				cfnClient: tc.inClient(ctrl),
+			}
+
+			// WHEN
+			got, gotErr := cf.StackEvents(inStackName, since)
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+			var ids []string
+			for _, e := range got {
+				ids = append(ids, aws.StringValue(e.EventId))
+			}
+			require.Equal(t, tc.wantedEvents, ids)
+		})
+	}
+}
+
+func TestCloudFormation_ListEnvironmentStacks(t *testing.T) {
+	testCases := map[string]struct {
+		inClient    func(ctrl *gomock.Controller) *mocks.MockcfnClient
+		wanted      []string
+		wantedError error
+	}{
+		"error listing the stacks": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().ListStacksWithTags(gomock.Any()).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("list stacks of environment test: some error"),
+		},
+		"returns the top-level stacks of the environment": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().ListStacksWithTags(map[string]string{
+					"copilot-application": "phonetool",
+					"copilot-environment": "test",
+				}).Return([]cloudformation.StackDescription{
+					{StackName: aws.String("phonetool-test")},
+					{StackName: aws.String("phonetool-test-frontend")},
+					{
+						StackName: aws.String("phonetool-test-frontend-AddonsStack-1234"),
+						ParentId:  aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-frontend/abcd"),
+					},
+				}, nil)
+				return m
+			},
+			wanted: []string{"phonetool-test", "phonetool-test-frontend"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := &CloudFormation{
+				cfnClient: tc.inClient(ctrl),
+			}
+
+			// WHEN
+			got, gotErr := cf.ListEnvironmentStacks("phonetool", "test")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_UpdateStackTags(t *testing.T) {
+	const inStackName = "phonetool-test-frontend"
+	testCases := map[string]struct {
+		inClient    func(ctrl *gomock.Controller) *mocks.MockcfnClient
+		wantedError error
+	}{
+		"error describing the stack": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe(inStackName).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("describe stack phonetool-test-frontend: some error"),
+		},
+		"error updating the tags": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe(inStackName).Return(&cloudformation.StackDescription{}, nil)
+				m.EXPECT().UpdateTags(inStackName, gomock.Any()).Return("", errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("some error"),
+		},
+		"no-op if the stack already has the tags": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe(inStackName).Return(&cloudformation.StackDescription{}, nil)
+				m.EXPECT().UpdateTags(inStackName, gomock.Any()).Return("", &cloudformation.ErrChangeSetEmpty{})
+				return m
+			},
+		},
+		"removes then overwrites the tags of the stack": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe(inStackName).Return(&cloudformation.StackDescription{
+					Tags: []*sdkcloudformation.Tag{
+						{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+						{Key: aws.String("team"), Value: aws.String("growth")},
+						{Key: aws.String("cost-center"), Value: aws.String("1234")},
+					},
+				}, nil)
+				m.EXPECT().UpdateTags(inStackName, map[string]string{
+					"copilot-application": "phonetool",
+					"team":                "payments",
+				}).Return("1234", nil)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := &CloudFormation{
+				cfnClient: tc.inClient(ctrl),
+				console:   new(discardFile),
+			}
+
+			// WHEN
+			gotErr := cf.UpdateStackTags(inStackName, map[string]string{"team": "payments"}, []string{"cost-center"})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_UpdateStackParameters(t *testing.T) {
+	const inStackName = "phonetool-test-frontend"
+	testCases := map[string]struct {
+		inClient    func(ctrl *gomock.Controller) *mocks.MockcfnClient
+		wantedError error
+	}{
+		"error updating the parameters": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().UpdateParameters(inStackName, gomock.Any()).Return("", errors.New("some error"))
+				m.EXPECT().ErrorEvents(inStackName).Return(nil, nil)
+				return m
+			},
+			wantedError: errors.New("some error"),
+		},
+		"updates the parameters of the stack": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().UpdateParameters(inStackName, map[string]string{
+					"AddonsTemplateURL": "https://bucket.s3.us-west-2.amazonaws.com/addons.yml",
+				}).Return("1234", nil)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := &CloudFormation{
+				cfnClient: tc.inClient(ctrl),
+				console:   new(discardFile),
+			}
+
+			// WHEN
+			gotErr := cf.UpdateStackParameters(inStackName, map[string]string{
+				"AddonsTemplateURL": "https://bucket.s3.us-west-2.amazonaws.com/addons.yml",
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_NestedStackTemplate(t *testing.T) {
+	const inStackName = "phonetool-test-frontend"
+	testCases := map[string]struct {
+		inClient       func(ctrl *gomock.Controller) *mocks.MockcfnClient
+		wantedTemplate string
+		wantedError    error
+	}{
+		"error listing the resources of the stack": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().StackResources(inStackName).Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns an empty template if the nested stack is not deployed": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().StackResources(inStackName).Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("Service"),
+						PhysicalResourceId: aws.String("frontend"),
+					},
+				}, nil)
+				return m
+			},
+		},
+		"returns the template body of the nested stack": {
+			inClient: func(ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().StackResources(inStackName).Return([]*cloudformation.StackResource{
+					{
+						LogicalResourceId:  aws.String("AddonsStack"),
+						PhysicalResourceId: aws.String("arn:aws:cloudformation:us-west-2:1234:stack/phonetool-test-frontend-AddonsStack/abc"),
+					},
+				}, nil)
+				m.EXPECT().TemplateBody("arn:aws:cloudformation:us-west-2:1234:stack/phonetool-test-frontend-AddonsStack/abc").Return("mockTemplate", nil)
+				return m
+			},
+			wantedTemplate: "mockTemplate",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := &CloudFormation{
+				cfnClient: tc.inClient(ctrl),
+			}
+
+			// WHEN
+			got, gotErr := cf.NestedStackTemplate(inStackName, "AddonsStack")
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantedTemplate, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	durationsFileName = "deployments.json"
+	maxDurations      = 5 // Number of previous deployments per stack used to estimate the next one.
+)
+
+// deployDurations keeps how long the previous deployments of stacks took in a local file,
+// so that the time remaining of the next deployment can be estimated.
+type deployDurations struct {
+	fs   afero.Fs
+	path string
+}
+
+func newDeployDurations() *deployDurations {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return nil
+	}
+	return &deployDurations{
+		fs:   afero.NewOsFs(),
+		path: filepath.Join(dir, "copilot", durationsFileName),
+	}
+}
+
+// estimate returns the average duration of the previous deployments of the stack.
+// Returns false if the stack was never deployed from this machine.
+func (d *deployDurations) estimate(key string) (time.Duration, bool) {
+	if d == nil {
+		return 0, false
+	}
+	durations, err := d.read()
+	if err != nil || len(durations[key]) == 0 {
+		return 0, false
+	}
+	var sum time.Duration
+	for _, seconds := range durations[key] {
+		sum += time.Duration(seconds * float64(time.Second))
+	}
+	return sum / time.Duration(len(durations[key])), true
+}
+
+// record stores the duration of a deployment of the stack, and forgets the oldest deployments beyond maxDurations.
+func (d *deployDurations) record(key string, duration time.Duration) error {
+	if d == nil {
+		return nil
+	}
+	durations, err := d.read()
+	if err != nil {
+		return err
+	}
+	durations[key] = append(durations[key], duration.Seconds())
+	if len(durations[key]) > maxDurations {
+		durations[key] = durations[key][len(durations[key])-maxDurations:]
+	}
+	data, err := json.Marshal(durations)
+	if err != nil {
+		return err
+	}
+	if err := d.fs.MkdirAll(filepath.Dir(d.path), 0755); err != nil {
+		return err
+	}
+	return afero.WriteFile(d.fs, d.path, data, 0644)
+}
+
+// read returns the durations in seconds of the previous deployments keyed by stack.
+func (d *deployDurations) read() (map[string][]float64, error) {
+	durations := make(map[string][]float64)
+	data, err := afero.ReadFile(d.fs, d.path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return durations, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &durations); err != nil {
+		// A corrupted file only means that the estimates start over.
+		return make(map[string][]float64), nil
+	}
+	return durations, nil
+}
@@ -0,0 +1,51 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployDurations(t *testing.T) {
+	t.Run("returns no estimate for a stack that was never deployed", func(t *testing.T) {
+		d := &deployDurations{fs: afero.NewMemMapFs(), path: "/cache/copilot/deployments.json"}
+
+		_, ok := d.estimate("us-west-2/phonetool-test-api")
+
+		require.False(t, ok)
+	})
+	t.Run("returns no estimate if the file is corrupted", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(fs, "/cache/copilot/deployments.json", []byte("{"), 0644))
+		d := &deployDurations{fs: fs, path: "/cache/copilot/deployments.json"}
+
+		_, ok := d.estimate("us-west-2/phonetool-test-api")
+
+		require.False(t, ok)
+	})
+	t.Run("estimates the average of the last deployments", func(t *testing.T) {
+		d := &deployDurations{fs: afero.NewMemMapFs(), path: "/cache/copilot/deployments.json"}
+		for _, duration := range []time.Duration{time.Hour, 10 * time.Second, 20 * time.Second, 30 * time.Second, 40 * time.Second, 50 * time.Second} {
+			require.NoError(t, d.record("us-west-2/phonetool-test-api", duration))
+		}
+		require.NoError(t, d.record("us-west-2/phonetool-test-web", time.Minute))
+
+		got, ok := d.estimate("us-west-2/phonetool-test-api")
+
+		require.True(t, ok)
+		require.Equal(t, 30*time.Second, got, "expected the oldest deployment to be forgotten")
+	})
+	t.Run("does nothing without a cache directory", func(t *testing.T) {
+		var d *deployDurations
+
+		_, ok := d.estimate("us-west-2/phonetool-test-api")
+
+		require.False(t, ok)
+		require.NoError(t, d.record("us-west-2/phonetool-test-api", time.Minute))
+	})
+}
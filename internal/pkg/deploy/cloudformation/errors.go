@@ -0,0 +1,92 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/errs"
+)
+
+// failedStackError returns the error of a stack that failed to deploy with its most likely cause among the events of
+// its failed resources, and a remediation based on the reason of the failure.
+func failedStackError(stackName string, events []cloudformation.StackEvent) *errs.Error {
+	err := &errs.Error{
+		Code: errs.CodeStackFailed,
+		Remediation: errs.Remediation{
+			Command: fmt.Sprintf("aws cloudformation describe-stack-events --stack-name %s", stackName),
+			DocsURL: errs.Docs(errs.CodeStackFailed),
+		},
+	}
+	event, ok := rootCauseEvent(stackName, events)
+	if !ok {
+		return err
+	}
+	err.Resource = &errs.Resource{
+		Stack:     stackName,
+		LogicalID: aws.StringValue(event.LogicalResourceId),
+		Type:      aws.StringValue(event.ResourceType),
+		Status:    aws.StringValue(event.ResourceStatus),
+		// CFN error messages end with a '. (Service' and only the first sentence is useful, the rest is error codes.
+		Reason: strings.Split(aws.StringValue(event.ResourceStatusReason), ". (Service")[0],
+	}
+	reason := strings.ToLower(err.Resource.Reason)
+	switch {
+	case strings.Contains(reason, "already exists"):
+		err.Code = errs.CodeResourceExists
+		err.Remediation = errs.Remediation{
+			Hint: fmt.Sprintf("A resource named like %s already exists outside of stack %s.\n"+
+				"Delete it or rename it, then retry the deployment.", err.Resource.LogicalID, stackName),
+		}
+	case strings.Contains(reason, "not authorized to perform") || strings.Contains(reason, "accessdenied") || strings.Contains(reason, "access denied"):
+		err.Code = errs.CodeAccessDenied
+		err.Remediation = errs.Remediation{
+			Hint: "The role that deploys the stack is missing a permission, or a policy such as an SCP denies it.\n" +
+				"Grant the permission in the reason of the failure, then retry the deployment.",
+		}
+	case strings.Contains(reason, "limit exceeded") || strings.Contains(reason, "limitexceeded") || strings.Contains(reason, "quota"):
+		err.Code = errs.CodeLimitExceeded
+		err.Remediation = errs.Remediation{
+			Hint: "The account reached a quota of the resource. Delete the resources you don't use or request a quota increase.",
+		}
+	case strings.Contains(reason, "circuit breaker"):
+		err.Code = errs.CodeCircuitBreakerTriggered
+		err.Remediation = errs.Remediation{
+			Hint:    "The tasks of the service failed to start or to pass their health checks, so the deployment was rolled back.",
+			Command: "copilot svc logs --previous",
+		}
+	case err.Resource.Type == "AWS::AppRunner::Service":
+		err.Remediation = errs.Remediation{
+			Hint:    "You may fix the error by updating the service code or the manifest configuration, then retry deploying your service.",
+			Command: "copilot svc deploy",
+		}
+	}
+	err.Remediation.DocsURL = errs.Docs(err.Code)
+	return err
+}
+
+// rootCauseEvent returns the earliest failure of a resource of the stack, since the failures that follow it are often
+// cancellations caused by it.
+func rootCauseEvent(stackName string, events []cloudformation.StackEvent) (cloudformation.StackEvent, bool) {
+	var fallback *cloudformation.StackEvent
+	// The events are sorted from the most recent to the oldest.
+	for i := len(events) - 1; i >= 0; i-- {
+		event := events[i]
+		reason := strings.ToLower(aws.StringValue(event.ResourceStatusReason))
+		if aws.StringValue(event.LogicalResourceId) == stackName || strings.Contains(reason, "cancelled") {
+			if fallback == nil {
+				fallback = &events[i]
+			}
+			continue
+		}
+		return event, true
+	}
+	if fallback == nil {
+		return cloudformation.StackEvent{}, false
+	}
+	return *fallback, true
+}
@@ -0,0 +1,89 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/errs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailedStackError(t *testing.T) {
+	const stackName = "shop-test-api"
+	event := func(logicalID, resourceType, reason string) cloudformation.StackEvent {
+		return cloudformation.StackEvent{
+			LogicalResourceId:    aws.String(logicalID),
+			ResourceType:         aws.String(resourceType),
+			ResourceStatus:       aws.String("CREATE_FAILED"),
+			ResourceStatusReason: aws.String(reason),
+		}
+	}
+	testCases := map[string]struct {
+		inEvents []cloudformation.StackEvent
+
+		wantedCode     errs.Code
+		wantedResource *errs.Resource
+		wantedCommand  string
+	}{
+		"no failed resource": {
+			wantedCode:    errs.CodeStackFailed,
+			wantedCommand: "aws cloudformation describe-stack-events --stack-name shop-test-api",
+		},
+		"picks the earliest failure that isn't a cancellation": {
+			inEvents: []cloudformation.StackEvent{
+				event(stackName, "AWS::CloudFormation::Stack", "The following resource(s) failed to create: [Service, TaskRole]."),
+				event("TaskRole", "AWS::IAM::Role", "Resource creation cancelled"),
+				event("Service", "AWS::ECS::Service", "ECS Deployment Circuit Breaker was triggered. (Service: Ecs, Status Code: 400)"),
+			},
+			wantedCode: errs.CodeCircuitBreakerTriggered,
+			wantedResource: &errs.Resource{
+				Stack:     stackName,
+				LogicalID: "Service",
+				Type:      "AWS::ECS::Service",
+				Status:    "CREATE_FAILED",
+				Reason:    "ECS Deployment Circuit Breaker was triggered",
+			},
+			wantedCommand: "copilot svc logs --previous",
+		},
+		"resource already exists": {
+			inEvents: []cloudformation.StackEvent{
+				event("LogGroup", "AWS::Logs::LogGroup", "Resource of type 'AWS::Logs::LogGroup' with identifier '/copilot/shop-test-api' already exists."),
+			},
+			wantedCode: errs.CodeResourceExists,
+			wantedResource: &errs.Resource{
+				Stack:     stackName,
+				LogicalID: "LogGroup",
+				Type:      "AWS::Logs::LogGroup",
+				Status:    "CREATE_FAILED",
+				Reason:    "Resource of type 'AWS::Logs::LogGroup' with identifier '/copilot/shop-test-api' already exists.",
+			},
+		},
+		"access denied": {
+			inEvents: []cloudformation.StackEvent{
+				event("Bucket", "AWS::S3::Bucket", "User: arn:aws:sts::123456789012:assumed-role/exec is not authorized to perform: s3:CreateBucket"),
+			},
+			wantedCode: errs.CodeAccessDenied,
+			wantedResource: &errs.Resource{
+				Stack:     stackName,
+				LogicalID: "Bucket",
+				Type:      "AWS::S3::Bucket",
+				Status:    "CREATE_FAILED",
+				Reason:    "User: arn:aws:sts::123456789012:assumed-role/exec is not authorized to perform: s3:CreateBucket",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := failedStackError(stackName, tc.inEvents)
+
+			require.Equal(t, tc.wantedCode, err.Code)
+			require.Equal(t, tc.wantedResource, err.Resource)
+			require.Equal(t, tc.wantedCommand, err.Remediation.Command)
+			require.Equal(t, errs.Docs(tc.wantedCode), err.Remediation.DocsURL)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+)
+
+// DeployGitHubActionsRole deploys the stack of the IAM role that the GitHub Actions workflow of a pipeline assumes,
+// renders the deployment to the console until it is done, and returns the ARN of the role.
+func (cf CloudFormation) DeployGitHubActionsRole(conf *stack.GitHubActionsRoleConfig) (string, error) {
+	s, err := toStack(stack.NewGitHubActionsRoleStackConfig(conf))
+	if err != nil {
+		return "", err
+	}
+	if err := cf.executeAndRenderChangeSet(cf.newUpsertChangeSetInput(cf.console, s)); err != nil {
+		var errChangeSetEmpty *cloudformation.ErrChangeSetEmpty
+		if !errors.As(err, &errChangeSetEmpty) {
+			return "", err
+		}
+	}
+	outputs, err := cf.cfnClient.Outputs(s)
+	if err != nil {
+		return "", fmt.Errorf("get outputs of stack %s: %w", s.Name, err)
+	}
+	return outputs[stack.GitHubActionsRoleOutputARN], nil
+}
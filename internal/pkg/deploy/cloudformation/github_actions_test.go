@@ -0,0 +1,84 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloudFormation_DeployGitHubActionsRole(t *testing.T) {
+	when := func(cf CloudFormation) error {
+		_, err := cf.DeployGitHubActionsRole(&stack.GitHubActionsRoleConfig{
+			App:        "phonetool",
+			Pipeline:   "release",
+			Repository: "aws/phonetool",
+		})
+		return err
+	}
+
+	t.Run("returns a wrapped error if creating a change set fails", func(t *testing.T) {
+		testDeployTask_OnCreateChangeSetFailure(t, when)
+	})
+	t.Run("returns an error if stack creation fails", func(t *testing.T) {
+		testDeployTask_StreamUntilStackCreationFails(t, "pipeline-phonetool-release-github-actions", when)
+	})
+
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.MockcfnClient)
+
+		wantedARN   string
+		wantedError error
+	}{
+		"returns the role ARN if the change set is empty": {
+			setupMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Outputs(gomock.Any()).Return(map[string]string{
+					"RoleARN": "arn:aws:iam::123456789012:role/github-actions",
+				}, nil)
+			},
+			wantedARN: "arn:aws:iam::123456789012:role/github-actions",
+		},
+		"returns a wrapped error if the outputs cannot be retrieved": {
+			setupMocks: func(m *mocks.MockcfnClient) {
+				m.EXPECT().Outputs(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get outputs of stack pipeline-phonetool-release-github-actions: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfnClient(ctrl)
+			m.EXPECT().Create(gomock.Any()).Return("", &cloudformation.ErrStackAlreadyExists{})
+			m.EXPECT().Update(gomock.Any()).Return("", &cloudformation.ErrChangeSetEmpty{})
+			m.EXPECT().ErrorEvents(gomock.Any()).Return(nil, nil)
+			tc.setupMocks(m)
+			client := CloudFormation{cfnClient: m, console: mockFileWriter{Writer: new(strings.Builder)}}
+
+			// WHEN
+			got, err := client.DeployGitHubActionsRole(&stack.GitHubActionsRoleConfig{
+				App:        "phonetool",
+				Pipeline:   "release",
+				Repository: "aws/phonetool",
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedARN, got)
+		})
+	}
+}
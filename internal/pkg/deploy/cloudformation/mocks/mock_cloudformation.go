@@ -8,11 +8,13 @@ import (
 	context "context"
 	io "io"
 	reflect "reflect"
+	time "time"
 
 	cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	stackset "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
 	cloudwatch "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	codedeploy "github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -189,6 +191,21 @@ func (mr *MockecsClientMockRecorder) Service(clusterName, serviceName interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsClient)(nil).Service), clusterName, serviceName)
 }
 
+// StoppedServiceTasks mocks base method.
+func (m *MockecsClient) StoppedServiceTasks(cluster, service string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoppedServiceTasks", cluster, service)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StoppedServiceTasks indicates an expected call of StoppedServiceTasks.
+func (mr *MockecsClientMockRecorder) StoppedServiceTasks(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoppedServiceTasks", reflect.TypeOf((*MockecsClient)(nil).StoppedServiceTasks), cluster, service)
+}
+
 // MockcwClient is a mock of cwClient interface.
 type MockcwClient struct {
 	ctrl     *gomock.Controller
@@ -231,6 +248,44 @@ func (mr *MockcwClientMockRecorder) AlarmStatuses(opts ...interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AlarmStatuses", reflect.TypeOf((*MockcwClient)(nil).AlarmStatuses), opts...)
 }
 
+// MockcodeDeployClient is a mock of codeDeployClient interface.
+type MockcodeDeployClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockcodeDeployClientMockRecorder
+}
+
+// MockcodeDeployClientMockRecorder is the mock recorder for MockcodeDeployClient.
+type MockcodeDeployClientMockRecorder struct {
+	mock *MockcodeDeployClient
+}
+
+// NewMockcodeDeployClient creates a new mock instance.
+func NewMockcodeDeployClient(ctrl *gomock.Controller) *MockcodeDeployClient {
+	mock := &MockcodeDeployClient{ctrl: ctrl}
+	mock.recorder = &MockcodeDeployClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcodeDeployClient) EXPECT() *MockcodeDeployClientMockRecorder {
+	return m.recorder
+}
+
+// LatestDeployment mocks base method.
+func (m *MockcodeDeployClient) LatestDeployment(app, deploymentGroup string, since time.Time) (*codedeploy.Deployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestDeployment", app, deploymentGroup, since)
+	ret0, _ := ret[0].(*codedeploy.Deployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestDeployment indicates an expected call of LatestDeployment.
+func (mr *MockcodeDeployClientMockRecorder) LatestDeployment(app, deploymentGroup, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestDeployment", reflect.TypeOf((*MockcodeDeployClient)(nil).LatestDeployment), app, deploymentGroup, since)
+}
+
 // MockcfnClient is a mock of cfnClient interface.
 type MockcfnClient struct {
 	ctrl     *gomock.Controller
@@ -533,6 +588,36 @@ func (mr *MockcfnClientMockRecorder) UpdateAndWait(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAndWait", reflect.TypeOf((*MockcfnClient)(nil).UpdateAndWait), arg0)
 }
 
+// UpdateParameters mocks base method.
+func (m *MockcfnClient) UpdateParameters(stackName string, params map[string]string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateParameters", stackName, params)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateParameters indicates an expected call of UpdateParameters.
+func (mr *MockcfnClientMockRecorder) UpdateParameters(stackName, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateParameters", reflect.TypeOf((*MockcfnClient)(nil).UpdateParameters), stackName, params)
+}
+
+// UpdateTags mocks base method.
+func (m *MockcfnClient) UpdateTags(stackName string, tags map[string]string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTags", stackName, tags)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTags indicates an expected call of UpdateTags.
+func (mr *MockcfnClientMockRecorder) UpdateTags(stackName, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTags", reflect.TypeOf((*MockcfnClient)(nil).UpdateTags), stackName, tags)
+}
+
 // WaitForCreate mocks base method.
 func (m *MockcfnClient) WaitForCreate(ctx context.Context, stackName string) error {
 	m.ctrl.T.Helper()
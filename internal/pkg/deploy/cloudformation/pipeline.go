@@ -17,6 +17,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/template/lint"
 )
 
 const (
@@ -122,8 +123,12 @@ func (cf CloudFormation) pushTemplateToS3Bucket(bucket string, config StackConfi
 	if err != nil {
 		return "", fmt.Errorf("generate template: %w", err)
 	}
+	stackName := config.StackName()
+	if err := lint.Validate(stackName, template, lint.MaxTemplateURLSize); err != nil {
+		return "", err
+	}
 	reader := strings.NewReader(template)
-	url, err := cf.s3Client.Upload(bucket, fmt.Sprintf(fmtPipelineCfnTemplateName, config.StackName()), reader)
+	url, err := cf.s3Client.Upload(bucket, fmt.Sprintf(fmtPipelineCfnTemplateName, stackName), reader)
 	if err != nil {
 		return "", fmt.Errorf("upload pipeline template to S3 bucket %s: %w", bucket, err)
 	}
@@ -120,7 +120,7 @@ func TestCloudFormation_CreatePipeline(t *testing.T) {
 			},
 			stackConfigMock: func(ctrl *gomock.Controller) StackConfiguration {
 				m := mocks.NewMockStackConfiguration(ctrl)
-				m.EXPECT().Template().Return("mockTemplate", nil)
+				m.EXPECT().Template().Return(mockTemplate, nil)
 				m.EXPECT().Parameters().Return([]*sdkcloudformation.Parameter{}, nil)
 				m.EXPECT().Tags().Return([]*sdkcloudformation.Tag{})
 				m.EXPECT().StackName().Return("mockStackName").Times(2)
@@ -162,7 +162,7 @@ func TestCloudFormation_CreatePipeline(t *testing.T) {
 			},
 			stackConfigMock: func(ctrl *gomock.Controller) StackConfiguration {
 				m := mocks.NewMockStackConfiguration(ctrl)
-				m.EXPECT().Template().Return("mockTemplate", nil)
+				m.EXPECT().Template().Return(mockTemplate, nil)
 				m.EXPECT().Parameters().Return([]*sdkcloudformation.Parameter{}, nil)
 				m.EXPECT().Tags().Return([]*sdkcloudformation.Tag{})
 				m.EXPECT().StackName().Return("mockStackName").Times(3)
@@ -194,7 +194,7 @@ func TestCloudFormation_CreatePipeline(t *testing.T) {
 			},
 			stackConfigMock: func(ctrl *gomock.Controller) StackConfiguration {
 				m := mocks.NewMockStackConfiguration(ctrl)
-				m.EXPECT().Template().Return("mockTemplate", nil)
+				m.EXPECT().Template().Return(mockTemplate, nil)
 				m.EXPECT().Parameters().Return([]*sdkcloudformation.Parameter{}, nil)
 				m.EXPECT().Tags().Return([]*sdkcloudformation.Tag{})
 				m.EXPECT().StackName().Return("mockStackName").Times(2)
@@ -226,7 +226,7 @@ func TestCloudFormation_CreatePipeline(t *testing.T) {
 			},
 			stackConfigMock: func(ctrl *gomock.Controller) StackConfiguration {
 				m := mocks.NewMockStackConfiguration(ctrl)
-				m.EXPECT().Template().Return("mockTemplate", nil)
+				m.EXPECT().Template().Return(mockTemplate, nil)
 				m.EXPECT().StackName().Return("mockStackName")
 				return m
 			},
@@ -256,7 +256,7 @@ func TestCloudFormation_CreatePipeline(t *testing.T) {
 			},
 			stackConfigMock: func(ctrl *gomock.Controller) StackConfiguration {
 				m := mocks.NewMockStackConfiguration(ctrl)
-				m.EXPECT().Template().Return("mockTemplate", nil)
+				m.EXPECT().Template().Return(mockTemplate, nil)
 				m.EXPECT().Parameters().Return([]*sdkcloudformation.Parameter{}, nil)
 				m.EXPECT().Tags().Return([]*sdkcloudformation.Tag{})
 				m.EXPECT().StackName().Return("mockStackName").Times(2)
@@ -290,7 +290,7 @@ func TestCloudFormation_CreatePipeline(t *testing.T) {
 			},
 			stackConfigMock: func(ctrl *gomock.Controller) StackConfiguration {
 				m := mocks.NewMockStackConfiguration(ctrl)
-				m.EXPECT().Template().Return("mockTemplate", nil)
+				m.EXPECT().Template().Return(mockTemplate, nil)
 				m.EXPECT().Parameters().Return([]*sdkcloudformation.Parameter{}, nil)
 				m.EXPECT().Tags().Return([]*sdkcloudformation.Tag{})
 				m.EXPECT().StackName().Return("mockStackName").Times(2)
@@ -325,7 +325,7 @@ func TestCloudFormation_CreatePipeline(t *testing.T) {
 			},
 			stackConfigMock: func(ctrl *gomock.Controller) StackConfiguration {
 				m := mocks.NewMockStackConfiguration(ctrl)
-				m.EXPECT().Template().Return("mockTemplate", nil)
+				m.EXPECT().Template().Return(mockTemplate, nil)
 				m.EXPECT().Parameters().Return([]*sdkcloudformation.Parameter{}, nil)
 				m.EXPECT().Tags().Return([]*sdkcloudformation.Tag{})
 				m.EXPECT().StackName().Return("mockStackName").Times(3)
@@ -360,7 +360,7 @@ func TestCloudFormation_CreatePipeline(t *testing.T) {
 			},
 			stackConfigMock: func(ctrl *gomock.Controller) StackConfiguration {
 				m := mocks.NewMockStackConfiguration(ctrl)
-				m.EXPECT().Template().Return("mockTemplate", nil)
+				m.EXPECT().Template().Return(mockTemplate, nil)
 				m.EXPECT().Parameters().Return([]*sdkcloudformation.Parameter{}, nil)
 				m.EXPECT().Tags().Return([]*sdkcloudformation.Tag{})
 				m.EXPECT().StackName().Return("mockStackName").Times(3)
@@ -401,7 +401,7 @@ func TestCloudFormation_CreatePipeline(t *testing.T) {
 			},
 			stackConfigMock: func(ctrl *gomock.Controller) StackConfiguration {
 				m := mocks.NewMockStackConfiguration(ctrl)
-				m.EXPECT().Template().Return("mockTemplate", nil)
+				m.EXPECT().Template().Return(mockTemplate, nil)
 				m.EXPECT().Parameters().Return([]*sdkcloudformation.Parameter{}, nil)
 				m.EXPECT().Tags().Return([]*sdkcloudformation.Tag{})
 				m.EXPECT().StackName().Return("mockStackName").Times(3)
@@ -458,7 +458,7 @@ func TestCloudFormation_UpdatePipeline(t *testing.T) {
 			},
 			stackConfigMock: func(ctrl *gomock.Controller) StackConfiguration {
 				m := mocks.NewMockStackConfiguration(ctrl)
-				m.EXPECT().Template().Return("mockTemplate", nil)
+				m.EXPECT().Template().Return(mockTemplate, nil)
 				m.EXPECT().Parameters().Return([]*sdkcloudformation.Parameter{}, nil)
 				m.EXPECT().Tags().Return([]*sdkcloudformation.Tag{})
 				m.EXPECT().StackName().Return("mockStackName").Times(2)
@@ -479,7 +479,7 @@ func TestCloudFormation_UpdatePipeline(t *testing.T) {
 			},
 			stackConfigMock: func(ctrl *gomock.Controller) StackConfiguration {
 				m := mocks.NewMockStackConfiguration(ctrl)
-				m.EXPECT().Template().Return("mockTemplate", nil)
+				m.EXPECT().Template().Return(mockTemplate, nil)
 				m.EXPECT().StackName().Return("mockStackName")
 				return m
 			},
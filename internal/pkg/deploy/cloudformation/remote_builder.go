@@ -0,0 +1,27 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import (
+	"errors"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+)
+
+// DeployRemoteBuilder deploys the stack of the CodeBuild project that builds the images of an application,
+// and renders the deployment to the console until it is done. It returns nil if the stack doesn't have any changes.
+func (cf CloudFormation) DeployRemoteBuilder(conf *stack.RemoteBuilderConfig) error {
+	s, err := toStack(stack.NewRemoteBuilderStackConfig(conf))
+	if err != nil {
+		return err
+	}
+	if err := cf.executeAndRenderChangeSet(cf.newUpsertChangeSetInput(cf.console, s)); err != nil {
+		var errChangeSetEmpty *cloudformation.ErrChangeSetEmpty
+		if !errors.As(err, &errChangeSetEmpty) {
+			return err
+		}
+	}
+	return nil
+}
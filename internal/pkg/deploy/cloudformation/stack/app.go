@@ -4,6 +4,7 @@
 package stack
 
 import (
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/partitions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 )
@@ -31,16 +33,19 @@ type AppResources struct {
 
 // AppResourcesConfig is a configuration for a deployed Application StackSet.
 type AppResourcesConfig struct {
-	Accounts  []string               `yaml:"Accounts"`
-	Workloads []AppResourcesWorkload `yaml:"Workloads"`
-	App       string                 `yaml:"App"`
-	Version   int                    `yaml:"Version"`
+	Accounts       []string               `yaml:"Accounts"`
+	Workloads      []AppResourcesWorkload `yaml:"Workloads"`
+	App            string                 `yaml:"App"`
+	Version        int                    `yaml:"Version"`
+	ImageRetention *config.ImageRetention `yaml:"ImageRetention,omitempty"` // Lifecycle settings of the ECR repositories of workloads without their own.
 }
 
 // AppResourcesWorkload is a workload configuration for a deployed Application StackSet
 type AppResourcesWorkload struct {
-	Name    string `yaml:"Name"`
-	WithECR bool   `yaml:"WithECR"`
+	Name           string                 `yaml:"Name"`
+	WithECR        bool                   `yaml:"WithECR"`
+	ImageRetention *config.ImageRetention `yaml:"ImageRetention,omitempty"` // Overrides the lifecycle settings of the application for the workload's ECR repository.
+	RepositoryName string                 `yaml:"RepositoryName,omitempty"` // Name of the workload's ECR repository if the application customizes it.
 }
 
 // UnmarshalYAML overrides the default YAML unmarshaling logic for the Image
@@ -101,7 +106,71 @@ const (
 )
 
 var cfTemplateFunctions = map[string]interface{}{
-	"logicalIDSafe": template.ReplaceDashesFunc,
+	"logicalIDSafe":   template.ReplaceDashesFunc,
+	"lifecyclePolicy": ecrLifecyclePolicy,
+}
+
+type ecrLifecycleRule struct {
+	RulePriority int                   `json:"rulePriority"`
+	Description  string                `json:"description"`
+	Selection    ecrLifecycleSelection `json:"selection"`
+	Action       ecrLifecycleAction    `json:"action"`
+}
+
+type ecrLifecycleSelection struct {
+	TagStatus   string `json:"tagStatus"`
+	CountType   string `json:"countType"`
+	CountUnit   string `json:"countUnit,omitempty"`
+	CountNumber int    `json:"countNumber"`
+}
+
+type ecrLifecycleAction struct {
+	Type string `json:"type"`
+}
+
+// ecrLifecyclePolicy returns the text of the ECR lifecycle policy that expires images according to the retention settings.
+// An empty string is returned if no image expires.
+func ecrLifecyclePolicy(retention *config.ImageRetention) (string, error) {
+	if retention == nil {
+		return "", nil
+	}
+	var rules []ecrLifecycleRule
+	if retention.UntaggedExpiryDays > 0 {
+		rules = append(rules, ecrLifecycleRule{
+			Description: fmt.Sprintf("Expire untagged images after %d days", retention.UntaggedExpiryDays),
+			Selection: ecrLifecycleSelection{
+				TagStatus:   "untagged",
+				CountType:   "sinceImagePushed",
+				CountUnit:   "days",
+				CountNumber: retention.UntaggedExpiryDays,
+			},
+		})
+	}
+	// A rule selecting "any" tag status must have the lowest priority, which is why it comes last.
+	if retention.KeepImages > 0 {
+		rules = append(rules, ecrLifecycleRule{
+			Description: fmt.Sprintf("Keep the %d most recent images", retention.KeepImages),
+			Selection: ecrLifecycleSelection{
+				TagStatus:   "any",
+				CountType:   "imageCountMoreThan",
+				CountNumber: retention.KeepImages,
+			},
+		})
+	}
+	if len(rules) == 0 {
+		return "", nil
+	}
+	for i := range rules {
+		rules[i].RulePriority = i + 1
+		rules[i].Action.Type = "expire"
+	}
+	policy, err := json.Marshal(struct {
+		Rules []ecrLifecycleRule `json:"rules"`
+	}{rules})
+	if err != nil {
+		return "", fmt.Errorf("marshal ECR lifecycle policy: %w", err)
+	}
+	return string(policy), nil
 }
 
 // AppConfigFrom takes a template file and extracts the metadata block,
@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
@@ -500,3 +501,77 @@ Accounts:
 		})
 	}
 }
+
+func TestAppResourceTemplate_ImageRetention(t *testing.T) {
+	appStack := NewAppStackConfig(&deploy.CreateAppInput{Name: "testapp", AccountID: "1234"})
+	given := &AppResourcesConfig{
+		App:     "testapp",
+		Version: 2,
+		Workloads: []AppResourcesWorkload{
+			{Name: "api", WithECR: true},
+			{
+				Name:    "worker",
+				WithECR: true,
+				ImageRetention: &config.ImageRetention{
+					UntaggedExpiryDays: 3,
+				},
+			},
+		},
+		ImageRetention: &config.ImageRetention{
+			KeepImages:    20,
+			ImmutableTags: true,
+		},
+	}
+
+	tpl, err := appStack.ResourceTemplate(given)
+	require.NoError(t, err)
+
+	var parsed struct {
+		Resources map[string]struct {
+			Properties map[string]any `yaml:"Properties"`
+		} `yaml:"Resources"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(tpl), &parsed))
+	api := parsed.Resources["ECRRepoapi"].Properties
+	require.Equal(t, "IMMUTABLE_WITH_EXCLUSION", api["ImageTagMutability"])
+	require.Equal(t, map[string]any{
+		"LifecyclePolicyText": `{"rules":[{"rulePriority":1,"description":"Keep the 20 most recent images","selection":{"tagStatus":"any","countType":"imageCountMoreThan","countNumber":20},"action":{"type":"expire"}}]}`,
+	}, api["LifecyclePolicy"])
+	worker := parsed.Resources["ECRRepoworker"].Properties
+	require.NotContains(t, worker, "ImageTagMutability")
+	require.Equal(t, map[string]any{
+		"LifecyclePolicyText": `{"rules":[{"rulePriority":1,"description":"Expire untagged images after 3 days","selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countUnit":"days","countNumber":3},"action":{"type":"expire"}}]}`,
+	}, worker["LifecyclePolicy"])
+
+	deployed, err := AppConfigFrom(&tpl)
+	require.NoError(t, err)
+	require.Equal(t, given.ImageRetention, deployed.ImageRetention)
+	require.Equal(t, given.Workloads, deployed.Workloads)
+}
+
+func TestECRLifecyclePolicy(t *testing.T) {
+	testCases := map[string]struct {
+		in     *config.ImageRetention
+		wanted string
+	}{
+		"no policy without retention settings": {},
+		"no policy if images never expire": {
+			in: &config.ImageRetention{ImmutableTags: true},
+		},
+		"untagged images expire before the image count is applied": {
+			in: &config.ImageRetention{
+				KeepImages:         10,
+				UntaggedExpiryDays: 7,
+			},
+			wanted: `{"rules":[{"rulePriority":1,"description":"Expire untagged images after 7 days","selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countUnit":"days","countNumber":7},"action":{"type":"expire"}},{"rulePriority":2,"description":"Keep the 10 most recent images","selection":{"tagStatus":"any","countType":"imageCountMoreThan","countNumber":10},"action":{"type":"expire"}}]}`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ecrLifecyclePolicy(tc.in)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -6,7 +6,6 @@ package stack
 import (
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -25,6 +24,9 @@ type BackendService struct {
 	httpsEnabled bool
 	albEnabled   bool
 
+	// privateHostedZone is the private hosted zone of the environment in which aliases are resolved, if any.
+	privateHostedZone manifest.PrivateHostedZoneConfig
+
 	parser backendSvcReadParser
 }
 
@@ -35,6 +37,7 @@ type BackendServiceConfig struct {
 	Manifest           *manifest.BackendService
 	ArtifactBucketName string
 	RawManifest        []byte // Content of the manifest file without any transformations.
+	RenderedManifest   []byte // Content of the manifest file after the substitution of its environment variables.
 	RuntimeConfig      RuntimeConfig
 	Addons             NestedStackConfigurer
 }
@@ -58,6 +61,8 @@ func NewBackendService(conf BackendServiceConfig) (*BackendService, error) {
 				rc:                 conf.RuntimeConfig,
 				image:              conf.Manifest.ImageConfig.Image,
 				rawManifest:        conf.RawManifest,
+				renderedManifest:   conf.RenderedManifest,
+				resourceNames:      conf.App.ResourceNames,
 				parser:             fs,
 				addons:             conf.Addons,
 			},
@@ -66,9 +71,10 @@ func NewBackendService(conf BackendServiceConfig) (*BackendService, error) {
 			tc:                  conf.Manifest.TaskConfig,
 			taskDefOverrideFunc: override.CloudFormationTemplate,
 		},
-		manifest:   conf.Manifest,
-		parser:     fs,
-		albEnabled: !conf.Manifest.HTTP.IsEmpty(),
+		manifest:          conf.Manifest,
+		parser:            fs,
+		albEnabled:        !conf.Manifest.HTTP.IsEmpty(),
+		privateHostedZone: conf.EnvManifest.HTTPConfig.Private.HostedZone,
 	}
 
 	if len(conf.EnvManifest.HTTPConfig.Private.Certificates) != 0 {
@@ -96,7 +102,11 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("parse exposed ports in service manifest %s: %w", s.name, err)
 	}
-	sidecars, err := convertSidecars(s.manifest.Sidecars, exposedPorts.PortsForContainer, s.rc)
+	var portHealthChecks map[string]string
+	if s.manifest.Network.Connect.Enabled() {
+		portHealthChecks = serviceConnectHealthChecks(s.manifest.Network.Connect.ServiceConnectArgs, exposedPorts)
+	}
+	sidecars, err := convertSidecars(s.sidecarsWithHealthChecks(portHealthChecks), exposedPorts.PortsForContainer, s.rc)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
@@ -127,9 +137,14 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	network := convertNetworkConfig(s.manifest.Network)
+	if network.Ingress, err = convertIngressRules(s.manifest.Network.VPC.SecurityGroups.GetIngress()); err != nil {
+		return "", fmt.Errorf(`convert "network.vpc.security_groups.ingress" field for service %s: %w`, s.name, err)
+	}
 	var scConfig *template.ServiceConnect
 	if s.manifest.Network.Connect.Enabled() {
 		scConfig = convertServiceConnect(s.manifest.Network.Connect)
+		scConfig.Ports = convertServiceConnectPorts(s.manifest.Network.Connect.ServiceConnectArgs, exposedPorts)
 	}
 	targetContainer, targetContainerPort, err := s.manifest.HTTP.Main.Target(exposedPorts)
 	if err != nil {
@@ -148,16 +163,18 @@ func (s *BackendService) Template() (string, error) {
 		EnvVersion:         s.rc.EnvVersion,
 		Version:            s.rc.Version,
 		SerializedManifest: string(s.rawManifest),
+		RenderedManifest:   string(s.renderedManifest),
 		WorkloadType:       manifestinfo.BackendServiceType,
 		WorkloadName:       s.name,
+		LogGroupName:       s.logGroupName(),
 
 		// Configuration for the main container.
 		EntryPoint:   entrypoint,
 		Command:      command,
-		HealthCheck:  convertContainerHealthCheck(s.manifest.BackendServiceConfig.ImageConfig.HealthCheck),
+		HealthCheck:  convertContainerHealthCheck(withHealthCheckCommand(s.manifest.BackendServiceConfig.ImageConfig.HealthCheck, portHealthChecks[s.name])),
 		PortMappings: convertPortMappings(exposedPorts.PortsForContainer[s.name]),
 		Secrets:      convertSecrets(s.manifest.BackendServiceConfig.Secrets),
-		Variables:    convertEnvVars(s.manifest.BackendServiceConfig.Variables),
+		Variables:    convertEnvVarsWithEnvFile(s.manifest.BackendServiceConfig.Variables, s.rc.EnvFileVariables[s.name]),
 
 		// Additional options that are common between **all** workload templates.
 		AddonsExtraParams:       addonsParams,
@@ -165,17 +182,23 @@ func (s *BackendService) Template() (string, error) {
 		CapacityProviders:       capacityProviders,
 		CredentialsParameter:    aws.StringValue(s.manifest.ImageConfig.Image.Credentials),
 		DeploymentConfiguration: convertDeploymentConfig(s.manifest.DeployConfig),
+		Alarms:                  convertHTTPAlarms(s.manifest.Alarms),
 		DesiredCountOnSpot:      desiredCountOnSpot,
 		DependsOn:               convertDependsOn(s.manifest.ImageConfig.Image.DependsOn),
 		DockerLabels:            s.manifest.ImageConfig.Image.DockerLabels,
 		ExecuteCommand:          convertExecuteCommand(&s.manifest.ExecuteCommand),
-		LogConfig:               convertLogging(s.manifest.Logging),
+		LogConfig:               convertLogging(s.manifest.Logging, s.rc.Region),
 		NestedStack:             addonsOutputs,
-		Network:                 convertNetworkConfig(s.manifest.Network),
+		Network:                 network,
 		Publish:                 publishers,
-		PermissionsBoundary:     s.permBound,
+		PermissionsBoundary:     s.permissionsBoundary(),
+		TaskRoleARN:             aws.StringValue(s.tc.TaskRole),
+		ExecutionRoleARN:        aws.StringValue(s.tc.ExecutionRole),
+		EC2CapacityProvider:     s.tc.OnEC2(),
+		GPU:                     s.tc.GPU,
 		Platform:                convertPlatform(s.manifest.Platform),
 		Storage:                 convertStorageOpts(s.manifest.Name, s.manifest.Storage),
+		SecretFiles:             convertSecretFiles(s.manifest.BackendServiceConfig.Secrets, s.manifest.Sidecars),
 
 		// ALB configs.
 		ALBEnabled: s.albEnabled,
@@ -186,6 +209,9 @@ func (s *BackendService) Template() (string, error) {
 		GracePeriod: s.convertGracePeriod(),
 		ALBListener: albListenerConfig,
 
+		// API Gateway configs.
+		APIGateway: convertAPIGateway(s.manifest.APIGateway),
+
 		// Custom Resource Config.
 		CustomResources: crs,
 
@@ -197,9 +223,7 @@ func (s *BackendService) Template() (string, error) {
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
 
 		// Additional options for request driven web service templates.
-		Observability: template.ObservabilityOpts{
-			Tracing: strings.ToUpper(aws.StringValue(s.manifest.Observability.Tracing)),
-		},
+		Observability: convertObservability(s.manifest.Observability),
 	})
 	if err != nil {
 		return "", fmt.Errorf("parse backend service template: %w", err)
@@ -211,6 +235,23 @@ func (s *BackendService) Template() (string, error) {
 	return string(overriddenTpl), nil
 }
 
+// sidecarsWithHealthChecks returns the sidecars of the manifest, with the health check commands of their Service Connect ports.
+func (s *BackendService) sidecarsWithHealthChecks(cmds map[string]string) map[string]*manifest.SidecarConfig {
+	if len(cmds) == 0 {
+		return s.manifest.Sidecars
+	}
+	sidecars := make(map[string]*manifest.SidecarConfig, len(s.manifest.Sidecars))
+	for name, sidecar := range s.manifest.Sidecars {
+		if cmd, ok := cmds[name]; ok && sidecar != nil {
+			withCheck := *sidecar
+			withCheck.HealthCheck = withHealthCheckCommand(sidecar.HealthCheck, cmd)
+			sidecar = &withCheck
+		}
+		sidecars[name] = sidecar
+	}
+	return sidecars
+}
+
 // Parameters returns the list of CloudFormation parameters used by the template.
 func (s *BackendService) Parameters() ([]*cloudformation.Parameter, error) {
 	params, err := s.ecsWkld.Parameters()
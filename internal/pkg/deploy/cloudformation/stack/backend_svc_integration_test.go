@@ -65,6 +65,16 @@ func TestBackendService_TemplateAndParamsGeneration(t *testing.T) {
 			TemplatePath: filepath.Join(testDir, "http-autoscaling-template.yml"),
 			ParamsPath:   filepath.Join(testDir, "http-autoscaling-params.json"),
 		},
+		"http api gateway configured": {
+			ManifestPath: filepath.Join(testDir, "api-gateway-http-manifest.yml"),
+			TemplatePath: filepath.Join(testDir, "api-gateway-http-template.yml"),
+			ParamsPath:   filepath.Join(testDir, "api-gateway-http-params.json"),
+		},
+		"websocket api gateway with autoscaling by connections configured": {
+			ManifestPath: filepath.Join(testDir, "api-gateway-websocket-manifest.yml"),
+			TemplatePath: filepath.Join(testDir, "api-gateway-websocket-template.yml"),
+			ParamsPath:   filepath.Join(testDir, "api-gateway-websocket-params.json"),
+		},
 	}
 
 	// run tests
@@ -532,6 +532,10 @@ Outputs:
 					Bucket: "my-bucket",
 					Key:    "manual/scripts/custom-resources/rulepriorityfunction/8932747ba5dbff619d89b92d0033ef1d04f7dd1b055e073254907d4e38e3976d.zip",
 				},
+				"DeploymentHookFunction": {
+					Bucket: "my-bucket",
+					Key:    "manual/scripts/custom-resources/deploymenthookfunction/8932747ba5dbff619d89b92d0033ef1d04f7dd1b055e073254907d4e38e3976d.zip",
+				},
 			},
 			ExecuteCommand: &template.ExecuteCommandOpts{},
 			NestedStack: &template.WorkloadNestedStackOpts{
@@ -54,16 +54,44 @@ func TestDD_Pipeline_Template(t *testing.T) {
 		TestCommands: []string{`echo "test"`},
 	}, []string{"api"})
 
+	var prodStage deploy.PipelineStage
+	prodStage.Init(&config.Environment{
+		App:              "phonetool",
+		Name:             "prod",
+		Region:           "us-east-1",
+		AccountID:        "2222",
+		ExecutionRoleARN: "arn:aws:iam::2222:role/phonetool-prod-CFNExecutionRole",
+		ManagerRoleARN:   "arn:aws:iam::2222:role/phonetool-prod-EnvManagerRole",
+	}, &manifest.PipelineStage{
+		Name:             "prod",
+		RequiresApproval: true,
+		Approval: manifest.ApprovalConfig{
+			Emails:  []string{"dev@example.com", "ops@example.com"},
+			Message: "Check the test environment before promoting.",
+		},
+		Test: manifest.StageTest{
+			Buildspec:   "copilot/pipelines/release/smoke.yml",
+			ComputeType: "BUILD_GENERAL1_MEDIUM",
+			Variables: map[string]string{
+				"ENDPOINT": "https://prod.example.com",
+			},
+		},
+	}, []string{"api"})
+
 	serializer := stack.NewPipelineStackConfig(&deploy.CreatePipelineInput{
 		AppName: "phonetool",
 		Build:   &build,
 		Source:  source,
-		Stages:  []deploy.PipelineStage{stage},
+		Stages:  []deploy.PipelineStage{stage, prodStage},
 		ArtifactBuckets: []deploy.ArtifactBucket{
 			{
 				BucketName: "fancy-bucket",
 				KeyArn:     "arn:aws:kms:us-west-2:1111:key/abcd",
 			},
+			{
+				BucketName: "fancy-bucket-us-east-1",
+				KeyArn:     "arn:aws:kms:us-east-1:1111:key/efgh",
+			},
 		},
 		AdditionalTags: nil,
 		Version:        "v1.28.0",
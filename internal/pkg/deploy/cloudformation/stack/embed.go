@@ -30,6 +30,16 @@ type staticSiteReadParser interface {
 	ParseStaticSite(template.WorkloadOpts) (*template.Content, error)
 }
 
+type lambdaSvcReadParser interface {
+	template.ReadParser
+	ParseLambdaService(template.WorkloadOpts) (*template.Content, error)
+}
+
+type workflowJobReadParser interface {
+	template.ReadParser
+	ParseWorkflowJob(template.WorkloadOpts) (*template.Content, error)
+}
+
 type scheduledJobReadParser interface {
 	template.ReadParser
 	ParseScheduledJob(template.WorkloadOpts) (*template.Content, error)
@@ -51,7 +61,9 @@ type embedFS interface {
 	loadBalancedWebSvcReadParser
 	requestDrivenWebSvcReadParser
 	staticSiteReadParser
+	lambdaSvcReadParser
 	scheduledJobReadParser
+	workflowJobReadParser
 	workerSvcReadParser
 	envReadParser
 }
@@ -4,8 +4,13 @@
 package stack
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
@@ -13,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
@@ -22,20 +28,23 @@ import (
 
 // Parameter keys.
 const (
-	EnvParamAliasesKey                     = "Aliases"
-	EnvParamALBWorkloadsKey                = "ALBWorkloads"
-	EnvParamServiceDiscoveryEndpoint       = "ServiceDiscoveryEndpoint"
-	envParamAppNameKey                     = "AppName"
-	envParamEnvNameKey                     = "EnvironmentName"
-	envParamToolsAccountPrincipalKey       = "ToolsAccountPrincipalARN"
-	envParamAppDNSKey                      = "AppDNSName"
-	envParamAppDNSDelegationRoleKey        = "AppDNSDelegationRole"
-	envParamInternalALBWorkloadsKey        = "InternalALBWorkloads"
-	envParamEFSWorkloadsKey                = "EFSWorkloads"
-	envParamNATWorkloadsKey                = "NATWorkloads"
-	envParamAppRunnerPrivateWorkloadsKey   = "AppRunnerPrivateWorkloads"
-	envParamCreateHTTPSListenerKey         = "CreateHTTPSListener"
-	envParamCreateInternalHTTPSListenerKey = "CreateInternalHTTPSListener"
+	EnvParamAliasesKey                       = "Aliases"
+	EnvParamALBWorkloadsKey                  = "ALBWorkloads"
+	EnvParamServiceDiscoveryEndpoint         = "ServiceDiscoveryEndpoint"
+	envParamAppNameKey                       = "AppName"
+	envParamEnvNameKey                       = "EnvironmentName"
+	envParamToolsAccountPrincipalKey         = "ToolsAccountPrincipalARN"
+	envParamAppDNSKey                        = "AppDNSName"
+	envParamAppDNSDelegationRoleKey          = "AppDNSDelegationRole"
+	envParamInternalALBWorkloadsKey          = "InternalALBWorkloads"
+	envParamEFSWorkloadsKey                  = "EFSWorkloads"
+	envParamNATWorkloadsKey                  = "NATWorkloads"
+	envParamAppRunnerPrivateWorkloadsKey     = "AppRunnerPrivateWorkloads"
+	envParamServiceConnectTLSWorkloadsKey    = "ServiceConnectTLSWorkloads"
+	envParamMutualTLSVerifyWorkloadsKey      = "MutualTLSVerifyWorkloads"
+	envParamMutualTLSPassthroughWorkloadsKey = "MutualTLSPassthroughWorkloads"
+	envParamCreateHTTPSListenerKey           = "CreateHTTPSListener"
+	envParamCreateInternalHTTPSListenerKey   = "CreateInternalHTTPSListener"
 )
 
 // Output keys.
@@ -43,6 +52,10 @@ const (
 	EnvOutputVPCID               = "VpcId"
 	EnvOutputPublicSubnets       = "PublicSubnets"
 	EnvOutputPrivateSubnets      = "PrivateSubnets"
+	EnvOutputInternetGatewayID   = "InternetGatewayID"
+	EnvOutputPrivateRouteTables  = "PrivateRouteTableIDs" // Only exported once the NAT gateways are created.
+	EnvOutputPublicALBARN        = "PublicLoadBalancerArn"
+	EnvOutputHTTPSListenerARN    = "HTTPSListenerArn"
 	envOutputCFNExecutionRoleARN = "CFNExecutionRoleARN"
 	envOutputManagerRoleKey      = "EnvironmentManagerRoleARN"
 )
@@ -95,6 +108,8 @@ type EnvConfig struct {
 	Telemetry           *config.Telemetry     // Optional observability and monitoring configuration.
 	Mft                 *manifest.Environment // Unmarshaled and interpolated manifest object.
 	RawMft              []byte                // Content of the environment manifest without any modifications.
+	ImportedPublicALB   *elbv2.LoadBalancer   // Optional description of the public load balancer imported in the manifest.
+	ResourceNames       *config.ResourceNames // Optional templates of the physical names of the environment's resources.
 	ForceUpdate         bool
 }
 
@@ -166,15 +181,27 @@ func (e *Env) Template() (string, error) {
 		if err != nil {
 			return "", fmt.Errorf("parse extra parameters for environment addons: %w", err)
 		}
+		outputs, err := e.addonsOutputs()
+		if err != nil {
+			return "", err
+		}
 		addons = &template.Addons{
 			URL:         e.in.Addons.S3ObjectURL,
 			ExtraParams: extraParams,
+			Outputs:     outputs,
 		}
 	}
 	vpcConfig, err := e.vpcConfig()
 	if err != nil {
 		return "", err
 	}
+	if e.in.Mft != nil && e.in.Mft.ImportsPublicALB() && e.in.ImportedPublicALB == nil {
+		return "", fmt.Errorf("load balancer %s is imported but not described", aws.StringValue(e.in.Mft.Imports.PublicALB.ARN))
+	}
+	publicHTTPConfig, err := e.publicHTTPConfig()
+	if err != nil {
+		return "", err
+	}
 	forceUpdateID := e.lastForceUpdateID
 	if e.in.ForceUpdate {
 		id, err := uuid.NewRandom()
@@ -191,11 +218,17 @@ func (e *Env) Template() (string, error) {
 		ArtifactBucketARN:    e.in.ArtifactBucketARN,
 		ArtifactBucketKeyARN: e.in.ArtifactBucketKeyARN,
 		PermissionsBoundary:  e.in.PermissionsBoundary,
-		PublicHTTPConfig:     e.publicHTTPConfig(),
+		PublicHTTPConfig:     publicHTTPConfig,
 		VPCConfig:            vpcConfig,
 		PrivateHTTPConfig:    e.privateHTTPConfig(),
 		Telemetry:            e.telemetryConfig(),
 		CDNConfig:            e.cdnConfig(),
+		ImportedCluster:      e.importedCluster(),
+		ClusterName:          e.in.ResourceNames.ClusterName(e.in.App.Name, e.in.Name),
+		ImportedHostedZone:   e.importedHostedZone(),
+		EC2CapacityProvider:  e.ec2CapacityProvider(),
+		Notifications:        e.notifications(),
+		StaticSiteLogs:       convertStaticSiteLogsConfig(e.in.Mft),
 
 		LatestVersion:      e.in.Version,
 		SerializedManifest: string(e.in.RawMft),
@@ -208,12 +241,36 @@ func (e *Env) Template() (string, error) {
 	return content.String(), nil
 }
 
+// addonsOutputs returns the names of the outputs of the environment addons template.
+func (e *Env) addonsOutputs() ([]string, error) {
+	tmpl, err := e.in.Addons.Stack.Template()
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("generate environment addons template: %w", err)
+	case tmpl == "":
+		return nil, nil
+	}
+	outputs, err := addon.Outputs(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("get environment addons outputs: %w", err)
+	}
+	var names []string
+	for _, out := range outputs {
+		names = append(names, out.Name)
+	}
+	return names, nil
+}
+
 // Parameters returns the parameters to be passed into an environment CloudFormation template.
 func (e *Env) Parameters() ([]*cloudformation.Parameter, error) {
 	httpsListener := "false"
-	if len(e.importPublicCertARNs()) != 0 || e.in.App.Domain != "" {
+	if len(e.importPublicCertARNs()) != 0 || e.in.App.Domain != "" || (e.in.Mft != nil && len(e.in.Mft.HTTPConfig.Public.ManagedCertificates) != 0) {
 		httpsListener = "true"
 	}
+	if alb := e.importedPublicALB(); alb != nil && alb.HTTPSListenerARN == "" {
+		// An imported load balancer can only serve HTTPS traffic through its own listener.
+		httpsListener = "false"
+	}
 	internalHTTPSListener := "false"
 	if len(e.importPrivateCertARNs()) != 0 {
 		internalHTTPSListener = "true"
@@ -275,6 +332,18 @@ func (e *Env) Parameters() ([]*cloudformation.Parameter, error) {
 			ParameterKey:   aws.String(envParamAppRunnerPrivateWorkloadsKey),
 			ParameterValue: aws.String(""),
 		},
+		{
+			ParameterKey:   aws.String(envParamServiceConnectTLSWorkloadsKey),
+			ParameterValue: aws.String(""),
+		},
+		{
+			ParameterKey:   aws.String(envParamMutualTLSVerifyWorkloadsKey),
+			ParameterValue: aws.String(""),
+		},
+		{
+			ParameterKey:   aws.String(envParamMutualTLSPassthroughWorkloadsKey),
+			ParameterValue: aws.String(""),
+		},
 	}
 	if e.prevParams == nil {
 		return currParams, nil
@@ -426,8 +495,7 @@ func (e *BootstrapEnv) Parameters() ([]*cloudformation.Parameter, error) {
 // SerializedParameters returns the CloudFormation stack's parameters serialized
 // to a YAML document annotated with comments for readability to users.
 func (e *BootstrapEnv) SerializedParameters() (string, error) {
-	// No-op for now.
-	return "", nil
+	return serializeTemplateConfig(e.parser, e)
 }
 
 // Tags returns the tags that should be applied to the bootstrap CloudFormation stack.
@@ -482,16 +550,209 @@ func (e *Env) cdnConfig() *template.CDNConfig {
 	return config
 }
 
-func (e *Env) publicHTTPConfig() template.PublicHTTPConfig {
+func (e *Env) publicHTTPConfig() (template.PublicHTTPConfig, error) {
+	trustStore, err := convertTrustStore(e.in.Mft)
+	if err != nil {
+		return template.PublicHTTPConfig{}, err
+	}
 	return template.PublicHTTPConfig{
 		HTTPConfig: template.HTTPConfig{
 			ImportedCertARNs: e.importPublicCertARNs(),
 			SSLPolicy:        e.getPublicSSLPolicy(),
 		},
-		PublicALBSourceIPs: e.in.PublicALBSourceIPs,
-		CIDRPrefixListIDs:  e.in.CIDRPrefixListIDs,
-		ELBAccessLogs:      convertELBAccessLogsConfig(e.in.Mft),
+		PublicALBSourceIPs:  e.in.PublicALBSourceIPs,
+		CIDRPrefixListIDs:   e.in.CIDRPrefixListIDs,
+		ELBAccessLogs:       convertELBAccessLogsConfig(e.in.Mft),
+		ImportedALB:         e.importedPublicALB(),
+		TrustStore:          trustStore,
+		WAF:                 convertWAF(e.in.Mft.HTTPConfig.Public.WAF),
+		ManagedCertificates: convertManagedCertificates(e.in.Mft),
+		LoadBalancerName:    e.in.ResourceNames.PublicLoadBalancerName(e.in.App.Name, e.in.Name),
+	}, nil
+}
+
+func (e *Env) importedPublicALB() *template.ImportedALB {
+	if e.in.Mft == nil || !e.in.Mft.ImportsPublicALB() || e.in.ImportedPublicALB == nil {
+		return nil
+	}
+	listeners := e.in.Mft.Imports.PublicALB.Listeners
+	lb := e.in.ImportedPublicALB
+	var fullName string
+	if parsed, err := arn.Parse(lb.ARN); err == nil {
+		// The full name of a load balancer is the resource of its ARN without the "loadbalancer/" prefix, such as "app/my-alb/1234567890abcdef".
+		fullName = strings.TrimPrefix(parsed.Resource, "loadbalancer/")
+	}
+	return &template.ImportedALB{
+		ARN:              lb.ARN,
+		FullName:         fullName,
+		DNSName:          lb.DNSName,
+		HostedZoneID:     lb.CanonicalHostedZoneID,
+		SecurityGroups:   lb.SecurityGroups,
+		HTTPListenerARN:  aws.StringValue(listeners.HTTP.ARN),
+		HTTPSListenerARN: aws.StringValue(listeners.HTTPS.ARN),
+	}
+}
+
+func (e *Env) importedCluster() string {
+	if e.in.Mft == nil {
+		return ""
+	}
+	return aws.StringValue(e.in.Mft.Imports.Cluster)
+}
+
+// ecsOptimizedAMIParameters maps the operating systems of EC2 instances to the public SSM parameters
+// that hold the ID of the latest ECS-optimized AMI in the region.
+var ecsOptimizedAMIParameters = map[string]string{
+	manifest.OSLinux:                 "/aws/service/ecs/optimized-ami/amazon-linux-2/recommended/image_id",
+	manifest.OSWindowsServer2019Core: "/aws/service/ami-windows-latest/Windows_Server-2019-English-Core-ECS_Optimized/image_id",
+	manifest.OSWindowsServer2019Full: "/aws/service/ami-windows-latest/Windows_Server-2019-English-Full-ECS_Optimized/image_id",
+	manifest.OSWindowsServer2022Core: "/aws/service/ami-windows-latest/Windows_Server-2022-English-Core-ECS_Optimized/image_id",
+	manifest.OSWindowsServer2022Full: "/aws/service/ami-windows-latest/Windows_Server-2022-English-Full-ECS_Optimized/image_id",
+}
+
+const ecsOptimizedGPUAMIParameter = "/aws/service/ecs/optimized-ami/amazon-linux-2/gpu/recommended/image_id"
+
+// natInstanceAMIParameters maps the CPU architectures of NAT instances to the public SSM parameters
+// that hold the ID of the latest Amazon Linux 2023 AMI in the region.
+var natInstanceAMIParameters = map[string]string{
+	manifest.ArchX86:   "/aws/service/ami-amazon-linux-latest/al2023-ami-kernel-default-x86_64",
+	manifest.ArchARM64: "/aws/service/ami-amazon-linux-latest/al2023-ami-kernel-default-arm64",
+}
+
+// gravitonInstanceType matches the instance types with AWS Graviton processors, such as "t4g.nano" or "c7gn.large".
+var gravitonInstanceType = regexp.MustCompile(`^[a-z]+[0-9]+[a-z]*g[a-z]*\.`)
+
+func (e *Env) ec2CapacityProvider() *template.EC2CapacityProvider {
+	if e.in.Mft == nil || e.in.Mft.Compute.EC2.IsEmpty() {
+		return nil
 	}
+	ec2 := e.in.Mft.Compute.EC2
+	imageID := aws.StringValue(ec2.AMI)
+	if imageID == "" {
+		param := ecsOptimizedAMIParameters[manifest.OSLinux]
+		if ec2.OS != nil {
+			param = ecsOptimizedAMIParameters[aws.StringValue(ec2.OS)]
+		}
+		if aws.BoolValue(ec2.GPU) {
+			param = ecsOptimizedGPUAMIParameter
+		}
+		imageID = fmt.Sprintf("{{resolve:ssm:%s}}", param)
+	}
+	return &template.EC2CapacityProvider{
+		InstanceType: aws.StringValue(ec2.InstanceType),
+		ImageID:      imageID,
+		MinSize:      ec2.Min(),
+		MaxSize:      ec2.Max(),
+		Windows:      ec2.IsWindows(),
+	}
+}
+
+// notificationStatuses maps deployment events to the CloudFormation stack statuses that signal them.
+var notificationStatuses = map[string][]string{
+	manifest.DeploymentEventStarted:    {"CREATE_IN_PROGRESS", "UPDATE_IN_PROGRESS"},
+	manifest.DeploymentEventSucceeded:  {"CREATE_COMPLETE", "UPDATE_COMPLETE"},
+	manifest.DeploymentEventFailed:     {"CREATE_FAILED", "UPDATE_FAILED", "ROLLBACK_IN_PROGRESS", "UPDATE_ROLLBACK_IN_PROGRESS", "ROLLBACK_FAILED", "UPDATE_ROLLBACK_FAILED"},
+	manifest.DeploymentEventRolledBack: {"ROLLBACK_COMPLETE", "UPDATE_ROLLBACK_COMPLETE"},
+}
+
+// notificationRuleNames maps deployment events to the names used in the logical IDs of their rules.
+var notificationRuleNames = map[string]string{
+	manifest.DeploymentEventStarted:    "Started",
+	manifest.DeploymentEventSucceeded:  "Succeeded",
+	manifest.DeploymentEventFailed:     "Failed",
+	manifest.DeploymentEventRolledBack: "RolledBack",
+}
+
+const (
+	defaultNotificationText    = "Deployment ${event} in environment ${env} of application ${app}: stack ${stack_id} is ${status}. ${reason}"
+	defaultNotificationWebhook = `{"app": "${app}", "env": "${env}", "event": "${event}", "stack_id": "${stack_id}", "status": "${status}", "reason": "${reason}", "time": "${time}"}`
+)
+
+func (e *Env) notifications() *template.Notifications {
+	if e.in.Mft == nil || len(e.in.Mft.Notifications) == 0 {
+		return nil
+	}
+	notifications := &template.Notifications{}
+	targets := make(map[string][]template.NotificationTarget)
+	for idx, n := range e.in.Mft.Notifications {
+		id := fmt.Sprintf("Notification%d", idx+1)
+		if n.SNS == nil {
+			notifications.Destinations = append(notifications.Destinations, template.NotificationDestination{
+				ID:  id,
+				URL: aws.StringValue(n.Slack) + aws.StringValue(n.Webhook),
+			})
+		}
+		for _, event := range n.DeploymentEvents() {
+			target := template.NotificationTarget{
+				ID:            id,
+				InputTemplate: e.notificationPayload(n, event),
+			}
+			if n.SNS != nil {
+				target.TopicARN = aws.StringValue(n.SNS)
+			} else {
+				target.DestinationID = id
+			}
+			targets[event] = append(targets[event], target)
+		}
+	}
+	for _, event := range manifest.DeploymentEvents {
+		if len(targets[event]) == 0 {
+			continue
+		}
+		notifications.Rules = append(notifications.Rules, template.NotificationRule{
+			Event:    notificationRuleNames[event],
+			Statuses: notificationStatuses[event],
+			Targets:  targets[event],
+		})
+	}
+	return notifications
+}
+
+// notificationPayload returns the input template of a notification for an event.
+// The placeholders known at deploy time are replaced with their value, and the others with the
+// placeholders of the EventBridge input transformer.
+func (e *Env) notificationPayload(n manifest.Notification, event string) string {
+	msg := aws.StringValue(n.Message)
+	if msg == "" {
+		msg = defaultNotificationText
+		if n.Webhook != nil {
+			msg = defaultNotificationWebhook
+		}
+	}
+	msg = strings.NewReplacer(
+		"${app}", e.in.App.Name,
+		"${env}", e.in.Name,
+		"${event}", event,
+		"${stack_id}", "<stack_id>",
+		"${status}", "<status>",
+		"${reason}", "<reason>",
+		"${time}", "<time>",
+	).Replace(msg)
+	switch {
+	case n.Slack != nil:
+		return jsonEncode(map[string]string{"text": msg})
+	case n.SNS != nil:
+		return jsonEncode(msg)
+	default:
+		// Webhook messages are the JSON payload itself.
+		return msg
+	}
+}
+
+// jsonEncode encodes v without escaping "<" and ">", so that the input transformer placeholders are preserved.
+func jsonEncode(v interface{}) string {
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(v) // Strings and maps of strings are always encodable.
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+func (e *Env) importedHostedZone() string {
+	if e.in.Mft == nil {
+		return ""
+	}
+	return aws.StringValue(e.in.Mft.Imports.HostedZone)
 }
 
 func (e *Env) privateHTTPConfig() template.PrivateHTTPConfig {
@@ -501,6 +762,19 @@ func (e *Env) privateHTTPConfig() template.PrivateHTTPConfig {
 			SSLPolicy:        e.getPrivateSSLPolicy(),
 		},
 		CustomALBSubnets: e.internalALBSubnets(),
+		HostedZone:       e.privateHostedZone(),
+		LoadBalancerName: e.in.ResourceNames.InternalLoadBalancerName(e.in.App.Name, e.in.Name),
+	}
+}
+
+func (e *Env) privateHostedZone() *template.PrivateHostedZone {
+	if e.in.Mft == nil || e.in.Mft.HTTPConfig.Private.HostedZone.IsEmpty() {
+		return nil
+	}
+	zone := e.in.Mft.HTTPConfig.Private.HostedZone
+	return &template.PrivateHostedZone{
+		Name:           aws.StringValue(zone.Name),
+		AssociatedVPCs: zone.AssociatedVPCs,
 	}
 }
 
@@ -519,6 +793,7 @@ func (e *Env) vpcConfig() (template.VPCConfig, error) {
 		AllowVPCIngress:     e.in.Mft.HTTPConfig.Private.HasVPCIngress(),
 		SecurityGroupConfig: securityGroupConfig,
 		FlowLogs:            flowLogs,
+		DualStack:           e.in.Mft.DualStack(),
 	}, nil
 }
 
@@ -547,10 +822,14 @@ func (e *Env) managedVPC() template.ManagedVPC {
 	}
 	// If a manifest is present, it is the only place we look at.
 	if e.in.Mft != nil {
+		vpc := defaultManagedVPC
 		if v := e.in.Mft.Network.VPC.ManagedVPC(); v != nil {
-			return *v
+			vpc = *v
 		}
-		return defaultManagedVPC
+		vpc.NAT = convertNATConfig(e.in.Mft.Network.VPC.NAT)
+		vpc.IPv6 = aws.BoolValue(e.in.Mft.Network.VPC.IPv6) || e.in.Mft.DualStack()
+		vpc.AssignIPv6 = e.in.Mft.DualStack()
+		return vpc
 	}
 
 	// Fallthrough to SSM config.
@@ -588,6 +867,9 @@ func (e *Env) telemetryConfig() *template.Telemetry {
 func (e *Env) importPublicCertARNs() []string {
 	// If a manifest is present, it is the only place we look at.
 	if e.in.Mft != nil {
+		if e.in.Mft.ImportsPublicALB() {
+			return e.in.Mft.Imports.PublicALB.Listeners.HTTPS.Certificates
+		}
 		return e.in.Mft.HTTPConfig.Public.Certificates
 	}
 	// Fallthrough to SSM config.
@@ -13,6 +13,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 
 	"gopkg.in/yaml.v3"
@@ -263,6 +264,59 @@ network:
 			}(),
 			wantedFileName: "template-with-importedvpc-flowlogs.yml",
 		},
+		"generate template with imported cluster, hosted zone and public load balancer": {
+			input: func() *stack.EnvConfig {
+				rawMft := `name: test
+type: Environment
+network:
+  vpc:
+    id: 'vpc-12345'
+    subnets:
+      public:
+        - id: 'subnet-11111'
+        - id: 'subnet-22222'
+      private:
+        - id: 'subnet-33333'
+        - id: 'subnet-44444'
+imports:
+  cluster: shared-cluster
+  hosted_zone: Z0123456789ABCDEFGHIJ
+  public_alb:
+    arn: arn:aws:elasticloadbalancing:us-west-2:000000000:loadbalancer/app/shared/1234567890abcdef
+    listeners:
+      http:
+        arn: arn:aws:elasticloadbalancing:us-west-2:000000000:listener/app/shared/1234567890abcdef/1111111111111111
+      https:
+        arn: arn:aws:elasticloadbalancing:us-west-2:000000000:listener/app/shared/1234567890abcdef/2222222222222222
+        certificates:
+          - cert-1
+          - cert-2`
+				var mft manifest.Environment
+				err := yaml.Unmarshal([]byte(rawMft), &mft)
+				require.NoError(t, err)
+				return &stack.EnvConfig{
+					Version: "1.x",
+					App: deploy.AppInformation{
+						AccountPrincipalARN: "arn:aws:iam::000000000:root",
+						Name:                "demo",
+					},
+					Name:                 "test",
+					ArtifactBucketARN:    "arn:aws:s3:::mockbucket",
+					ArtifactBucketKeyARN: "arn:aws:kms:us-west-2:000000000:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+					Mft:                  &mft,
+					RawMft:               []byte(rawMft),
+					ImportedPublicALB: &elbv2.LoadBalancer{
+						ARN:                   "arn:aws:elasticloadbalancing:us-west-2:000000000:loadbalancer/app/shared/1234567890abcdef",
+						Name:                  "shared",
+						DNSName:               "shared-1234567890.us-west-2.elb.amazonaws.com",
+						Scheme:                "internet-facing",
+						CanonicalHostedZoneID: "Z1H1FL5HABSF5",
+						SecurityGroups:        []string{"sg-11111", "sg-22222"},
+					},
+				}
+			}(),
+			wantedFileName: "template-with-imported-resources.yml",
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -445,6 +499,7 @@ func resetCustomResourceLocations(template map[any]any) {
 		"RulePriorityFunction", "NLBCustomDomainFunction", "NLBCertValidatorFunction",
 		"CustomDomainFunction", "CertificateValidationFunction", "DNSDelegationFunction",
 		"CertificateReplicatorFunction", "UniqueJSONValuesFunction", "TriggerStateMachineFunction",
+		"BlueGreenDeploymentFunction", "DeploymentHookFunction",
 	}
 	for _, fnName := range functions {
 		resource, ok := resources[fnName]
@@ -13,6 +13,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack/mocks"
@@ -72,6 +73,31 @@ func TestEnv_Template(t *testing.T) {
 		// THEN
 		require.EqualError(t, errors.New("parse extra parameters for environment addons: some error"), err.Error())
 	})
+	t.Run("error generating addons template", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// GIVEN
+		inEnvConfig := mockDeployEnvironmentInput()
+		mockAddonsConfig := mocks.NewMockNestedStackConfigurer(ctrl)
+		inEnvConfig.Addons = &Addons{
+			S3ObjectURL: "mockAddonsURL",
+			Stack:       mockAddonsConfig,
+		}
+		fs = templatetest.Stub{}
+
+		// EXPECT
+		mockAddonsConfig.EXPECT().Parameters().Return("", nil)
+		mockAddonsConfig.EXPECT().Template().Return("", errors.New("some error"))
+
+		// WHEN
+		envStack, err := NewEnvConfigFromExistingStack(inEnvConfig, "mockPreviousForceUpdateID", nil)
+		require.NoError(t, err)
+		_, err = envStack.Template()
+
+		// THEN
+		require.EqualError(t, err, "generate environment addons template: some error")
+	})
 	t.Run("should contain addons information when addons are present", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -80,6 +106,14 @@ func TestEnv_Template(t *testing.T) {
 		inEnvConfig := mockDeployEnvironmentInput()
 		mockAddonsConfig := mocks.NewMockNestedStackConfigurer(ctrl)
 		mockAddonsConfig.EXPECT().Parameters().Return("mockAddonsExtraParameters", nil)
+		mockAddonsConfig.EXPECT().Template().Return(`Resources:
+  Table:
+    Type: AWS::DynamoDB::Table
+Outputs:
+  TableName:
+    Value: !Ref Table
+  TableArn:
+    Value: !GetAtt Table.Arn`, nil)
 		inEnvConfig.Addons = &Addons{
 			S3ObjectURL: "mockAddonsURL",
 			Stack:       mockAddonsConfig,
@@ -90,6 +124,7 @@ func TestEnv_Template(t *testing.T) {
 			require.Equal(t, &template.Addons{
 				URL:         "mockAddonsURL",
 				ExtraParams: "mockAddonsExtraParameters",
+				Outputs:     []string{"TableName", "TableArn"},
 			}, data.Addons)
 			return &template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil
 		})
@@ -184,6 +219,170 @@ func TestEnv_Template(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, mockTemplate, got)
 	})
+	t.Run("error if the imported load balancer is not described", func(t *testing.T) {
+		// GIVEN
+		inEnvConfig := mockDeployEnvironmentInput()
+		inEnvConfig.Mft.Imports.PublicALB.ARN = aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/1234567890abcdef")
+		fs = templatetest.Stub{}
+
+		// WHEN
+		envStack, err := NewEnvConfigFromExistingStack(inEnvConfig, "mockPreviousForceUpdateID", nil)
+		require.NoError(t, err)
+		_, err = envStack.Template()
+
+		// THEN
+		require.EqualError(t, err, "load balancer arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/1234567890abcdef is imported but not described")
+	})
+	t.Run("should pass the imported resources to the template", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// GIVEN
+		mockALBARN := "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/1234567890abcdef"
+		inEnvConfig := mockDeployEnvironmentInput()
+		inEnvConfig.Mft.Imports.Cluster = aws.String("shared-cluster")
+		inEnvConfig.Mft.Imports.HostedZone = aws.String("Z0123456789")
+		inEnvConfig.Mft.Imports.PublicALB.ARN = aws.String(mockALBARN)
+		inEnvConfig.Mft.Imports.PublicALB.Listeners.HTTP.ARN = aws.String("mockHTTPListenerARN")
+		inEnvConfig.Mft.Imports.PublicALB.Listeners.HTTPS.ARN = aws.String("mockHTTPSListenerARN")
+		inEnvConfig.Mft.Imports.PublicALB.Listeners.HTTPS.Certificates = []string{"mockCertARN"}
+		inEnvConfig.ImportedPublicALB = &elbv2.LoadBalancer{
+			ARN:                   mockALBARN,
+			DNSName:               "shared-1234.us-west-2.elb.amazonaws.com",
+			CanonicalHostedZoneID: "Z1H1FL5HABSF5",
+			SecurityGroups:        []string{"sg-1", "sg-2"},
+		}
+		mockParser := mocks.NewMockembedFS(ctrl)
+		mockParser.EXPECT().Read(gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("data")}, nil).AnyTimes()
+		mockParser.EXPECT().ParseEnv(gomock.Any()).DoAndReturn(func(data *template.EnvOpts) (*template.Content, error) {
+			require.Equal(t, "shared-cluster", data.ImportedCluster)
+			require.Equal(t, "Z0123456789", data.ImportedHostedZone)
+			require.Equal(t, []string{"mockCertARN"}, data.PublicHTTPConfig.ImportedCertARNs)
+			require.Equal(t, &template.ImportedALB{
+				ARN:              mockALBARN,
+				FullName:         "app/shared/1234567890abcdef",
+				DNSName:          "shared-1234.us-west-2.elb.amazonaws.com",
+				HostedZoneID:     "Z1H1FL5HABSF5",
+				SecurityGroups:   []string{"sg-1", "sg-2"},
+				HTTPListenerARN:  "mockHTTPListenerARN",
+				HTTPSListenerARN: "mockHTTPSListenerARN",
+			}, data.PublicHTTPConfig.ImportedALB)
+			return &template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil
+		})
+		fs = mockParser
+
+		// WHEN
+		envStack, err := NewEnvConfigFromExistingStack(inEnvConfig, "mockPreviousForceUpdateID", nil)
+		require.NoError(t, err)
+		got, err := envStack.Template()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, mockTemplate, got)
+	})
+	t.Run("should pass the EC2 capacity provider to the template", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// GIVEN
+		inEnvConfig := mockDeployEnvironmentInput()
+		inEnvConfig.Mft.Compute.EC2 = manifest.EC2CapacityProvider{
+			InstanceType: aws.String("g4dn.xlarge"),
+			GPU:          aws.Bool(true),
+			MaxSize:      aws.Int(4),
+		}
+		mockParser := mocks.NewMockembedFS(ctrl)
+		mockParser.EXPECT().Read(gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("data")}, nil).AnyTimes()
+		mockParser.EXPECT().ParseEnv(gomock.Any()).DoAndReturn(func(data *template.EnvOpts) (*template.Content, error) {
+			require.Equal(t, &template.EC2CapacityProvider{
+				InstanceType: "g4dn.xlarge",
+				ImageID:      "{{resolve:ssm:/aws/service/ecs/optimized-ami/amazon-linux-2/gpu/recommended/image_id}}",
+				MinSize:      0,
+				MaxSize:      4,
+			}, data.EC2CapacityProvider)
+			return &template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil
+		})
+		fs = mockParser
+
+		// WHEN
+		envStack, err := NewEnvConfigFromExistingStack(inEnvConfig, "mockPreviousForceUpdateID", nil)
+		require.NoError(t, err)
+		got, err := envStack.Template()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, "mockTemplate", got)
+	})
+	t.Run("should pass the deployment notifications to the template", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// GIVEN
+		inEnvConfig := mockDeployEnvironmentInput()
+		inEnvConfig.Mft.Notifications = []manifest.Notification{
+			{
+				Events: []string{"failed", "rolled_back"},
+				Slack:  aws.String("https://hooks.slack.com/services/T0/B0/x"),
+			},
+			{
+				Events:  []string{"failed"},
+				SNS:     aws.String("arn:aws:sns:us-west-2:123456789012:deployments"),
+				Message: aws.String("${app}/${env} ${event}: ${stack_id} is ${status}"),
+			},
+		}
+		mockParser := mocks.NewMockembedFS(ctrl)
+		mockParser.EXPECT().Read(gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("data")}, nil).AnyTimes()
+		mockParser.EXPECT().ParseEnv(gomock.Any()).DoAndReturn(func(data *template.EnvOpts) (*template.Content, error) {
+			require.Equal(t, &template.Notifications{
+				Rules: []template.NotificationRule{
+					{
+						Event:    "Failed",
+						Statuses: []string{"CREATE_FAILED", "UPDATE_FAILED", "ROLLBACK_IN_PROGRESS", "UPDATE_ROLLBACK_IN_PROGRESS", "ROLLBACK_FAILED", "UPDATE_ROLLBACK_FAILED"},
+						Targets: []template.NotificationTarget{
+							{
+								ID:            "Notification1",
+								DestinationID: "Notification1",
+								InputTemplate: `{"text":"Deployment failed in environment env of application project: stack <stack_id> is <status>. <reason>"}`,
+							},
+							{
+								ID:            "Notification2",
+								TopicARN:      "arn:aws:sns:us-west-2:123456789012:deployments",
+								InputTemplate: `"project/env failed: <stack_id> is <status>"`,
+							},
+						},
+					},
+					{
+						Event:    "RolledBack",
+						Statuses: []string{"ROLLBACK_COMPLETE", "UPDATE_ROLLBACK_COMPLETE"},
+						Targets: []template.NotificationTarget{
+							{
+								ID:            "Notification1",
+								DestinationID: "Notification1",
+								InputTemplate: `{"text":"Deployment rolled_back in environment env of application project: stack <stack_id> is <status>. <reason>"}`,
+							},
+						},
+					},
+				},
+				Destinations: []template.NotificationDestination{
+					{
+						ID:  "Notification1",
+						URL: "https://hooks.slack.com/services/T0/B0/x",
+					},
+				},
+			}, data.Notifications)
+			return &template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil
+		})
+		fs = mockParser
+
+		// WHEN
+		envStack, err := NewEnvConfigFromExistingStack(inEnvConfig, "mockPreviousForceUpdateID", nil)
+		require.NoError(t, err)
+		got, err := envStack.Template()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, "mockTemplate", got)
+	})
 	t.Run("should return template body with local custom resources when not uploaded", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -306,6 +505,18 @@ func TestEnv_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(envParamAppRunnerPrivateWorkloadsKey),
 					ParameterValue: aws.String(""),
 				},
+				{
+					ParameterKey:   aws.String(envParamServiceConnectTLSWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamMutualTLSVerifyWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamMutualTLSPassthroughWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
 			},
 		},
 		"with DNS": {
@@ -367,6 +578,18 @@ func TestEnv_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(envParamAppRunnerPrivateWorkloadsKey),
 					ParameterValue: aws.String(""),
 				},
+				{
+					ParameterKey:   aws.String(envParamServiceConnectTLSWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamMutualTLSVerifyWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamMutualTLSPassthroughWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
 			},
 		},
 		"with private DNS only": {
@@ -428,6 +651,18 @@ func TestEnv_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(envParamAppRunnerPrivateWorkloadsKey),
 					ParameterValue: aws.String(""),
 				},
+				{
+					ParameterKey:   aws.String(envParamServiceConnectTLSWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamMutualTLSVerifyWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamMutualTLSPassthroughWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
 			},
 		},
 		"should use default value for new EnvControllerParameters": {
@@ -544,6 +779,18 @@ func TestEnv_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(envParamAppRunnerPrivateWorkloadsKey),
 					ParameterValue: aws.String("rdws-backend"),
 				},
+				{
+					ParameterKey:   aws.String(envParamServiceConnectTLSWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamMutualTLSVerifyWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamMutualTLSPassthroughWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
 			},
 		},
 		"should retain the values from EnvControllerParameters": {
@@ -664,6 +911,18 @@ func TestEnv_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(envParamAppRunnerPrivateWorkloadsKey),
 					ParameterValue: aws.String("rdws-backend"),
 				},
+				{
+					ParameterKey:   aws.String(envParamServiceConnectTLSWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamMutualTLSVerifyWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamMutualTLSPassthroughWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
 			},
 		},
 		"should not include old parameters that are deleted": {
@@ -787,6 +1046,18 @@ func TestEnv_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(envParamAppRunnerPrivateWorkloadsKey),
 					ParameterValue: aws.String(""),
 				},
+				{
+					ParameterKey:   aws.String(envParamServiceConnectTLSWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamMutualTLSVerifyWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamMutualTLSPassthroughWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
 			},
 		},
 		"should reuse old service discovery endpoint value": {
@@ -907,6 +1178,18 @@ func TestEnv_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(envParamAppRunnerPrivateWorkloadsKey),
 					ParameterValue: aws.String(""),
 				},
+				{
+					ParameterKey:   aws.String(envParamServiceConnectTLSWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamMutualTLSVerifyWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamMutualTLSPassthroughWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
 			},
 		},
 		"should use app.local endpoint service discovery endpoint if it is a new parameter": {
@@ -999,6 +1282,18 @@ func TestEnv_Parameters(t *testing.T) {
 					ParameterKey:   aws.String(envParamAppRunnerPrivateWorkloadsKey),
 					ParameterValue: aws.String(""),
 				},
+				{
+					ParameterKey:   aws.String(envParamServiceConnectTLSWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamMutualTLSVerifyWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
+				{
+					ParameterKey:   aws.String(envParamMutualTLSPassthroughWorkloadsKey),
+					ParameterValue: aws.String(""),
+				},
 			},
 		},
 	}
@@ -1153,6 +1448,33 @@ func TestBootstrapEnv_Parameters(t *testing.T) {
 	}
 }
 
+func TestBootstrapEnv_SerializedParameters(t *testing.T) {
+	bootstrap := &BootstrapEnv{
+		in: &EnvConfig{
+			Name: "test",
+			App: deploy.AppInformation{
+				Name:                "phonetool",
+				AccountPrincipalARN: "arn:aws:iam::000000000000:root",
+			},
+		},
+	}
+
+	params, err := bootstrap.SerializedParameters()
+
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+  "Parameters": {
+    "AppName": "phonetool",
+    "EnvironmentName": "test",
+    "ToolsAccountPrincipalARN": "arn:aws:iam::000000000000:root"
+  },
+  "Tags": {
+    "copilot-application": "phonetool",
+    "copilot-environment": "test"
+  }
+}`, params)
+}
+
 func TestBootstrapEnv_Tags(t *testing.T) {
 	bootstrap := &BootstrapEnv{
 		in: &EnvConfig{
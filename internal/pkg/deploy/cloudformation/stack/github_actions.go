@@ -0,0 +1,102 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+)
+
+const (
+	githubActionsRoleTemplatePath = "cicd/github-actions/role.yml"
+
+	githubActionsRoleAppNameParamKey    = "AppName"
+	githubActionsRoleRepositoryParamKey = "Repository"
+
+	// GitHubActionsRoleOutputARN is the CFN stack output logical ID for the ARN of the role assumed by a GitHub Actions workflow.
+	GitHubActionsRoleOutputARN = "RoleARN"
+)
+
+// GitHubActionsRoleConfig holds the configuration of the IAM role that a GitHub Actions workflow assumes
+// through OpenID Connect to deploy an application with Copilot.
+type GitHubActionsRoleConfig struct {
+	App                 string
+	Pipeline            string
+	Repository          string // Full name of the GitHub repository, such as "owner/repo".
+	OIDCProviderARN     string // ARN of the existing GitHub OpenID Connect provider of the account. A provider is created if empty.
+	EnvManagerRoleARNs  []string
+	ArtifactBuckets     []deploy.ArtifactBucket
+	PermissionsBoundary string
+	AdditionalTags      map[string]string
+}
+
+type githubActionsRoleStackConfig struct {
+	*GitHubActionsRoleConfig
+	parser template.Parser
+}
+
+// NewGitHubActionsRoleStackConfig sets up a struct that provides stack configurations for CloudFormation
+// to deploy the IAM role of a GitHub Actions workflow.
+func NewGitHubActionsRoleStackConfig(conf *GitHubActionsRoleConfig) *githubActionsRoleStackConfig {
+	return &githubActionsRoleStackConfig{
+		GitHubActionsRoleConfig: conf,
+		parser:                  template.New(),
+	}
+}
+
+// StackName returns the name of the CloudFormation stack for the GitHub Actions role.
+func (s *githubActionsRoleStackConfig) StackName() string {
+	return NameForGitHubActionsRole(s.App, s.Pipeline)
+}
+
+// Template returns the GitHub Actions role CloudFormation template.
+func (s *githubActionsRoleStackConfig) Template() (string, error) {
+	content, err := s.parser.Parse(githubActionsRoleTemplatePath, struct {
+		OIDCProviderARN     string
+		EnvManagerRoleARNs  []string
+		ArtifactBuckets     []deploy.ArtifactBucket
+		PermissionsBoundary string
+	}{
+		OIDCProviderARN:     s.OIDCProviderARN,
+		EnvManagerRoleARNs:  s.EnvManagerRoleARNs,
+		ArtifactBuckets:     s.ArtifactBuckets,
+		PermissionsBoundary: s.PermissionsBoundary,
+	})
+	if err != nil {
+		return "", fmt.Errorf("read template for GitHub Actions role stack: %w", err)
+	}
+	return content.String(), nil
+}
+
+// Parameters returns the parameter values to be passed to the GitHub Actions role CloudFormation template.
+func (s *githubActionsRoleStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
+	return []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(githubActionsRoleAppNameParamKey),
+			ParameterValue: aws.String(s.App),
+		},
+		{
+			ParameterKey:   aws.String(githubActionsRoleRepositoryParamKey),
+			ParameterValue: aws.String(s.Repository),
+		},
+	}, nil
+}
+
+// SerializedParameters returns the CloudFormation stack's parameters serialized
+// to a YAML document annotated with comments for readability to users.
+func (s *githubActionsRoleStackConfig) SerializedParameters() (string, error) {
+	// No-op for now.
+	return "", nil
+}
+
+// Tags returns the tags that should be applied to the GitHub Actions role CloudFormation stack.
+func (s *githubActionsRoleStackConfig) Tags() []*cloudformation.Tag {
+	return mergeAndFlattenTags(s.AdditionalTags, map[string]string{
+		deploy.AppTagKey: s.App,
+	})
+}
@@ -0,0 +1,200 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestGitHubActionsRoleStackConfig_Template(t *testing.T) {
+	testCases := map[string]struct {
+		mockParser func(m *mocks.MockParser)
+
+		wantedTemplate string
+		wantedError    error
+	}{
+		"should return error if unable to parse": {
+			mockParser: func(m *mocks.MockParser) {
+				m.EXPECT().Parse(githubActionsRoleTemplatePath, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("read template for GitHub Actions role stack: some error"),
+		},
+		"should return template body when present": {
+			mockParser: func(m *mocks.MockParser) {
+				m.EXPECT().Parse(githubActionsRoleTemplatePath, struct {
+					OIDCProviderARN     string
+					EnvManagerRoleARNs  []string
+					ArtifactBuckets     []deploy.ArtifactBucket
+					PermissionsBoundary string
+				}{
+					OIDCProviderARN:    "arn:aws:iam::123456789012:oidc-provider/token.actions.githubusercontent.com",
+					EnvManagerRoleARNs: []string{"arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole"},
+					ArtifactBuckets: []deploy.ArtifactBucket{
+						{
+							BucketName: "mockBucket",
+							KeyArn:     "arn:aws:kms:us-west-2:123456789012:key/abcd",
+						},
+					},
+					PermissionsBoundary: "mockBoundary",
+				}).Return(&template.Content{
+					Buffer: bytes.NewBufferString("This is the GitHub Actions role template"),
+				}, nil)
+			},
+			wantedTemplate: "This is the GitHub Actions role template",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockParser(ctrl)
+			tc.mockParser(m)
+			conf := &githubActionsRoleStackConfig{
+				GitHubActionsRoleConfig: &GitHubActionsRoleConfig{
+					App:                "phonetool",
+					Pipeline:           "release",
+					Repository:         "aws/phonetool",
+					OIDCProviderARN:    "arn:aws:iam::123456789012:oidc-provider/token.actions.githubusercontent.com",
+					EnvManagerRoleARNs: []string{"arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole"},
+					ArtifactBuckets: []deploy.ArtifactBucket{
+						{
+							BucketName: "mockBucket",
+							KeyArn:     "arn:aws:kms:us-west-2:123456789012:key/abcd",
+						},
+					},
+					PermissionsBoundary: "mockBoundary",
+				},
+				parser: m,
+			}
+
+			// WHEN
+			got, err := conf.Template()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTemplate, got)
+		})
+	}
+}
+
+func TestGitHubActionsRoleStackConfig_TemplateIsValidYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inOIDCProviderARN string
+
+		wantedProvider bool
+	}{
+		"creates an OIDC provider if there is none in the account": {
+			wantedProvider: true,
+		},
+		"reuses the existing OIDC provider of the account": {
+			inOIDCProviderARN: "arn:aws:iam::123456789012:oidc-provider/token.actions.githubusercontent.com",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			conf := NewGitHubActionsRoleStackConfig(&GitHubActionsRoleConfig{
+				App:                "phonetool",
+				Pipeline:           "release",
+				Repository:         "aws/phonetool",
+				OIDCProviderARN:    tc.inOIDCProviderARN,
+				EnvManagerRoleARNs: []string{"arn:aws:iam::123456789012:role/phonetool-test-EnvManagerRole"},
+				ArtifactBuckets: []deploy.ArtifactBucket{
+					{
+						BucketName: "mockBucket",
+						KeyArn:     "arn:aws:kms:us-west-2:123456789012:key/abcd",
+					},
+				},
+				PermissionsBoundary: "mockBoundary",
+			})
+
+			// WHEN
+			got, err := conf.Template()
+
+			// THEN
+			require.NoError(t, err)
+			var tpl struct {
+				Resources map[string]struct {
+					Type       string         `yaml:"Type"`
+					Properties map[string]any `yaml:"Properties"`
+				} `yaml:"Resources"`
+			}
+			require.NoError(t, yaml.Unmarshal([]byte(got), &tpl))
+			require.Equal(t, "AWS::IAM::Role", tpl.Resources["GitHubActionsRole"].Type)
+			require.Contains(t, tpl.Resources["GitHubActionsRole"].Properties, "PermissionsBoundary")
+			_, ok := tpl.Resources["GitHubOIDCProvider"]
+			require.Equal(t, tc.wantedProvider, ok)
+		})
+	}
+}
+
+func TestGitHubActionsRoleStackConfig_Parameters(t *testing.T) {
+	// GIVEN
+	conf := NewGitHubActionsRoleStackConfig(&GitHubActionsRoleConfig{
+		App:        "phonetool",
+		Pipeline:   "release",
+		Repository: "aws/phonetool",
+	})
+
+	// WHEN
+	params, err := conf.Parameters()
+
+	// THEN
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String("AppName"),
+			ParameterValue: aws.String("phonetool"),
+		},
+		{
+			ParameterKey:   aws.String("Repository"),
+			ParameterValue: aws.String("aws/phonetool"),
+		},
+	}, params)
+}
+
+func TestGitHubActionsRoleStackConfig_Tags(t *testing.T) {
+	// GIVEN
+	conf := NewGitHubActionsRoleStackConfig(&GitHubActionsRoleConfig{
+		App:      "phonetool",
+		Pipeline: "release",
+		AdditionalTags: map[string]string{
+			"owner": "boss",
+		},
+	})
+
+	// WHEN
+	tags := conf.Tags()
+
+	// THEN
+	require.Equal(t, "pipeline-phonetool-release-github-actions", conf.StackName())
+	require.ElementsMatch(t, []*cloudformation.Tag{
+		{
+			Key:   aws.String("copilot-application"),
+			Value: aws.String("phonetool"),
+		},
+		{
+			Key:   aws.String("owner"),
+			Value: aws.String("boss"),
+		},
+	}, tags)
+}
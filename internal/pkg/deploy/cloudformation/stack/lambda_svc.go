@@ -0,0 +1,170 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+)
+
+// Architectures of Lambda functions.
+const (
+	lambdaArchX86   = "x86_64"
+	lambdaArchARM64 = "arm64"
+)
+
+// LambdaService represents the configuration needed to create a CloudFormation stack from a Lambda service manifest.
+type LambdaService struct {
+	*wkld
+	manifest     *manifest.LambdaService
+	httpsEnabled bool
+
+	parser lambdaSvcReadParser
+}
+
+// LambdaServiceConfig contains fields to configure LambdaService.
+type LambdaServiceConfig struct {
+	App                *config.Application
+	EnvManifest        *manifest.Environment
+	Manifest           *manifest.LambdaService
+	RawManifest        []byte // Content of the manifest file without any transformations.
+	RenderedManifest   []byte // Content of the manifest file after the substitution of its environment variables.
+	RuntimeConfig      RuntimeConfig
+	ArtifactBucketName string
+	Addons             NestedStackConfigurer
+}
+
+// NewLambdaService creates a new CFN stack from a manifest file, given the options.
+func NewLambdaService(cfg *LambdaServiceConfig) (*LambdaService, error) {
+	crs, err := customresource.LambdaService(fs)
+	if err != nil {
+		return nil, fmt.Errorf("lambda service custom resources: %w", err)
+	}
+	cfg.RuntimeConfig.loadCustomResourceURLs(cfg.ArtifactBucketName, uploadableCRs(crs).convert())
+
+	return &LambdaService{
+		wkld: &wkld{
+			name:               aws.StringValue(cfg.Manifest.Name),
+			env:                aws.StringValue(cfg.EnvManifest.Name),
+			app:                cfg.App.Name,
+			permBound:          cfg.App.PermissionsBoundary,
+			artifactBucketName: cfg.ArtifactBucketName,
+			rc:                 cfg.RuntimeConfig,
+			image:              cfg.Manifest.ImageConfig.Image,
+			rawManifest:        cfg.RawManifest,
+			renderedManifest:   cfg.RenderedManifest,
+			resourceNames:      cfg.App.ResourceNames,
+			parser:             fs,
+			addons:             cfg.Addons,
+		},
+		manifest:     cfg.Manifest,
+		httpsEnabled: cfg.App.Domain != "" || cfg.EnvManifest.HTTPConfig.Public.HasCertificates(),
+
+		parser: fs,
+	}, nil
+}
+
+// Template returns the CloudFormation template for the service parametrized for the environment.
+func (s *LambdaService) Template() (string, error) {
+	crs, err := convertCustomResources(s.rc.CustomResourcesURL)
+	if err != nil {
+		return "", err
+	}
+	addonsParams, err := s.addonsParameters()
+	if err != nil {
+		return "", err
+	}
+	addonsOutputs, err := s.addonsOutputs()
+	if err != nil {
+		return "", err
+	}
+	entrypoint, err := convertEntryPoint(s.manifest.ImageConfig.EntryPoint)
+	if err != nil {
+		return "", err
+	}
+	command, err := convertCommand(s.manifest.ImageConfig.Command)
+	if err != nil {
+		return "", err
+	}
+	params, err := s.Parameters()
+	if err != nil {
+		return "", err
+	}
+	content, err := s.parser.ParseLambdaService(template.WorkloadOpts{
+		// Workload parameters.
+		AppName:            s.app,
+		EnvName:            s.env,
+		EnvVersion:         s.rc.EnvVersion,
+		Version:            s.rc.Version,
+		SerializedManifest: string(s.rawManifest),
+		RenderedManifest:   string(s.renderedManifest),
+		WorkloadName:       s.name,
+		LogGroupName:       s.logGroupName(),
+		WorkloadType:       manifestinfo.LambdaServiceType,
+
+		// Configuration for the function.
+		Variables:  convertEnvVars(s.manifest.Variables),
+		EntryPoint: entrypoint,
+		Command:    command,
+		Tags:       s.manifest.Tags,
+		Lambda:     s.convertLambdaOpts(params),
+		APIGateway: convertAPIGateway(s.manifest.APIGateway),
+		ALBEnabled: !s.manifest.HTTP.IsEmpty(),
+
+		// Additional options that are common between **all** workload templates.
+		AddonsExtraParams:   addonsParams,
+		NestedStack:         addonsOutputs,
+		PermissionsBoundary: s.permBound,
+
+		// Custom Resource Config.
+		CustomResources: crs,
+	})
+	if err != nil {
+		return "", err
+	}
+	return content.String(), nil
+}
+
+// convertLambdaOpts returns the configuration of the function.
+// The configuration hash covers the parameters of the stack, such as the image, and the rendered manifest,
+// so that any change to the function publishes a new version.
+func (s *LambdaService) convertLambdaOpts(params []*cloudformation.Parameter) *template.LambdaOpts {
+	config := string(s.renderedManifest)
+	for _, param := range params {
+		config += fmt.Sprintf("\n%s=%s", aws.StringValue(param.ParameterKey), aws.StringValue(param.ParameterValue))
+	}
+	out := &template.LambdaOpts{
+		MemorySize:   aws.IntValue(s.manifest.Memory),
+		Architecture: lambdaArchX86,
+		ConfigHash:   hash(config),
+		HTTPS:        s.httpsEnabled,
+	}
+	if s.manifest.Timeout != nil {
+		out.Timeout = int(*s.manifest.Timeout / time.Second)
+	}
+	if arch := s.manifest.Platform.Arch(); !s.manifest.Platform.IsEmpty() && manifest.IsArmArch(arch) {
+		out.Architecture = lambdaArchARM64
+	}
+	if !s.manifest.HTTP.IsEmpty() {
+		out.RulePath = convertPath(aws.StringValue(s.manifest.HTTP.Path))
+	}
+	if deployment := s.manifest.Deployment; deployment.IsTrafficShifting() {
+		out.TrafficRouting = convertTrafficShiftingConfig(aws.StringValue(deployment.Rolling), deployment.TrafficShifting)
+		out.RollbackAlarms = deployment.RollbackAlarms
+	}
+	return out
+}
+
+// SerializedParameters returns the CloudFormation stack's parameters serialized to a JSON document.
+func (s *LambdaService) SerializedParameters() (string, error) {
+	return serializeTemplateConfig(s.wkld.parser, s)
+}
@@ -0,0 +1,245 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+var testLambdaServiceManifest = &manifest.LambdaService{
+	Workload: manifest.Workload{
+		Name: aws.String(testServiceName),
+		Type: aws.String(manifestinfo.LambdaServiceType),
+	},
+	LambdaServiceConfig: manifest.LambdaServiceConfig{
+		Memory:  aws.Int(1024),
+		Timeout: (*time.Duration)(aws.Int64(int64(time.Minute))),
+	},
+}
+
+func TestLambdaService_Template(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest       func(mft manifest.LambdaService) manifest.LambdaService
+		inHTTPSEnabled   bool
+		mockDependencies func(t *testing.T, ctrl *gomock.Controller, svc *LambdaService)
+
+		wantedTemplate string
+		wantedError    error
+	}{
+		"returns the error when parsing the service template fails": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *LambdaService) {
+				parser := mocks.NewMocklambdaSvcReadParser(ctrl)
+				parser.EXPECT().ParseLambdaService(gomock.Any()).Return(nil, errors.New("some error"))
+				svc.parser = parser
+			},
+			wantedError: errors.New("some error"),
+		},
+		"converts the manifest of a function behind the environment load balancer": {
+			inManifest: func(mft manifest.LambdaService) manifest.LambdaService {
+				mft.HTTP.Path = aws.String("api")
+				mft.Platform = manifest.PlatformArgsOrString{PlatformString: (*manifest.PlatformString)(aws.String("linux/arm64"))}
+				mft.Deployment = manifest.LambdaDeploymentConfig{
+					DeploymentControllerConfig: manifest.DeploymentControllerConfig{Rolling: aws.String(manifest.ECSCanaryRollingUpdateStrategy)},
+					TrafficShifting: manifest.TrafficShiftingConfig{
+						Percent:  aws.Int(20),
+						Interval: (*time.Duration)(aws.Int64(int64(10 * time.Minute))),
+					},
+					RollbackAlarms: []string{"api-errors"},
+				}
+				return mft
+			},
+			inHTTPSEnabled: true,
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *LambdaService) {
+				parser := mocks.NewMocklambdaSvcReadParser(ctrl)
+				parser.EXPECT().ParseLambdaService(gomock.Any()).DoAndReturn(func(actual template.WorkloadOpts) (*template.Content, error) {
+					require.True(t, actual.ALBEnabled)
+					require.Equal(t, &template.TrafficRoutingOpts{
+						Type:            "TimeBasedCanary",
+						Percent:         20,
+						IntervalMinutes: 10,
+					}, actual.Lambda.TrafficRouting)
+					actual.Lambda.TrafficRouting = nil
+					require.NotEmpty(t, actual.Lambda.ConfigHash)
+					actual.Lambda.ConfigHash = ""
+					require.Equal(t, &template.LambdaOpts{
+						MemorySize:     1024,
+						Timeout:        60,
+						Architecture:   "arm64",
+						RulePath:       "/api",
+						HTTPS:          true,
+						RollbackAlarms: []string{"api-errors"},
+					}, actual.Lambda)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				svc.parser = parser
+			},
+			wantedTemplate: "template",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mft := *testLambdaServiceManifest
+			if tc.inManifest != nil {
+				mft = tc.inManifest(mft)
+			}
+			svc := &LambdaService{
+				wkld: &wkld{
+					name:             testServiceName,
+					env:              testEnvName,
+					app:              testAppName,
+					renderedManifest: []byte("name: frontend"),
+					rc: RuntimeConfig{
+						PushedImages: map[string]ECRImage{
+							testServiceName: {
+								RepoURL:  testImageRepoURL,
+								ImageTag: testImageTag,
+							},
+						},
+						AccountID: "123456789012",
+						Region:    "us-west-2",
+					},
+					addons: mockAddons{},
+				},
+				manifest:     &mft,
+				httpsEnabled: tc.inHTTPSEnabled,
+			}
+			tc.mockDependencies(t, ctrl, svc)
+
+			// WHEN
+			tpl, err := svc.Template()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTemplate, tpl)
+			}
+		})
+	}
+}
+
+func TestLambdaService_TemplateResources(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest func(mft manifest.LambdaService) manifest.LambdaService
+
+		wantedResources    []string
+		notWantedResources []string
+	}{
+		"function behind the environment load balancer with a canary deployment": {
+			inManifest: func(mft manifest.LambdaService) manifest.LambdaService {
+				mft.HTTP.Path = aws.String("/")
+				mft.Deployment.Rolling = aws.String(manifest.ECSCanaryRollingUpdateStrategy)
+				return mft
+			},
+			wantedResources:    []string{"Function", "FunctionAlias", "TargetGroup", "ListenerRule", "CodeDeployDeploymentGroup"},
+			notWantedResources: []string{"APIGatewayAPI"},
+		},
+		"function behind an HTTP API": {
+			inManifest: func(mft manifest.LambdaService) manifest.LambdaService {
+				mft.APIGateway.Protocol = aws.String(manifest.APIGatewayProtocolHTTP)
+				return mft
+			},
+			wantedResources:    []string{"Function", "FunctionAlias", "APIGatewayAPI", "APIGatewayIntegration"},
+			notWantedResources: []string{"TargetGroup", "CodeDeployDeploymentGroup"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mft := tc.inManifest(*testLambdaServiceManifest)
+			svc := &LambdaService{
+				wkld: &wkld{
+					name:             testServiceName,
+					env:              testEnvName,
+					app:              testAppName,
+					renderedManifest: []byte("name: frontend"),
+					rc: RuntimeConfig{
+						PushedImages: map[string]ECRImage{
+							testServiceName: {
+								RepoURL:  testImageRepoURL,
+								ImageTag: testImageTag,
+							},
+						},
+						AccountID: "123456789012",
+						Region:    "us-west-2",
+					},
+					parser: fs,
+					addons: mockAddons{},
+				},
+				manifest: &mft,
+				parser:   fs,
+			}
+
+			// WHEN
+			tpl, err := svc.Template()
+
+			// THEN
+			require.NoError(t, err)
+			var parsed struct {
+				Resources map[string]any `yaml:"Resources"`
+			}
+			require.NoError(t, yaml.Unmarshal([]byte(tpl), &parsed))
+			for _, resource := range tc.wantedResources {
+				require.Contains(t, parsed.Resources, resource)
+			}
+			for _, resource := range tc.notWantedResources {
+				require.NotContains(t, parsed.Resources, resource)
+			}
+		})
+	}
+}
+
+func TestLambdaService_ConfigHash(t *testing.T) {
+	// GIVEN
+	mft := *testLambdaServiceManifest
+	svc := &LambdaService{
+		wkld: &wkld{
+			name: testServiceName,
+			env:  testEnvName,
+			app:  testAppName,
+			rc: RuntimeConfig{
+				PushedImages: map[string]ECRImage{
+					testServiceName: {
+						RepoURL:  testImageRepoURL,
+						ImageTag: testImageTag,
+					},
+				},
+				AccountID: "123456789012",
+				Region:    "us-west-2",
+			},
+		},
+		manifest: &mft,
+	}
+	params, err := svc.Parameters()
+	require.NoError(t, err)
+	hash := svc.convertLambdaOpts(params).ConfigHash
+
+	// WHEN
+	svc.rc.PushedImages[testServiceName] = ECRImage{
+		RepoURL:  testImageRepoURL,
+		ImageTag: "v2",
+	}
+	params, err = svc.Parameters()
+	require.NoError(t, err)
+
+	// THEN
+	require.NotEqual(t, hash, svc.convertLambdaOpts(params).ConfigHash, "a new image publishes a new version of the function")
+}
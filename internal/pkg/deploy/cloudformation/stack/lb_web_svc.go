@@ -6,7 +6,6 @@ package stack
 import (
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -34,6 +33,7 @@ type LoadBalancedWebService struct {
 	dnsDelegationEnabled   bool
 	publicSubnetCIDRBlocks []string
 	appInfo                deploy.AppInformation
+	dualStack              bool
 
 	parser loadBalancedWebSvcReadParser
 }
@@ -54,6 +54,7 @@ type LoadBalancedWebServiceConfig struct {
 	EnvManifest        *manifest.Environment
 	Manifest           *manifest.LoadBalancedWebService
 	RawManifest        []byte // Content of the manifest file without any transformations.
+	RenderedManifest   []byte // Content of the manifest file after the substitution of its environment variables.
 	RuntimeConfig      RuntimeConfig
 	RootUserARN        string
 	ArtifactBucketName string
@@ -80,8 +81,7 @@ func NewLoadBalancedWebService(conf LoadBalancedWebServiceConfig,
 		}
 		httpsEnabled = true
 	}
-	certImported := len(conf.EnvManifest.HTTPConfig.Public.Certificates) != 0
-	if certImported {
+	if conf.EnvManifest.HTTPConfig.Public.HasCertificates() {
 		httpsEnabled = true
 		dnsDelegationEnabled = false
 	}
@@ -99,6 +99,8 @@ func NewLoadBalancedWebService(conf LoadBalancedWebServiceConfig,
 				rc:                 conf.RuntimeConfig,
 				image:              conf.Manifest.ImageConfig.Image,
 				rawManifest:        conf.RawManifest,
+				renderedManifest:   conf.RenderedManifest,
+				resourceNames:      conf.App.ResourceNames,
 				parser:             fs,
 				addons:             conf.Addons,
 			},
@@ -111,6 +113,7 @@ func NewLoadBalancedWebService(conf LoadBalancedWebServiceConfig,
 		httpsEnabled:         httpsEnabled,
 		appInfo:              appInfo,
 		dnsDelegationEnabled: dnsDelegationEnabled,
+		dualStack:            conf.EnvManifest.DualStack(),
 
 		parser: fs,
 	}
@@ -175,6 +178,13 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	network := convertNetworkConfig(s.manifest.Network)
+	if network.Ingress, err = convertIngressRules(s.manifest.Network.VPC.SecurityGroups.GetIngress()); err != nil {
+		return "", fmt.Errorf(`convert "network.vpc.security_groups.ingress" field for service %s: %w`, s.name, err)
+	}
+	if err := validateIngressWithNLB(network.Ingress, nlbConfig.settings); err != nil {
+		return "", fmt.Errorf(`validate "network.vpc.security_groups.ingress" field for service %s: %w`, s.name, err)
+	}
 	albListenerConfig, err := s.convertALBListener()
 	if err != nil {
 		return "", err
@@ -190,7 +200,7 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	}
 
 	// Set container-level feature flag.
-	logConfig := convertLogging(s.manifest.Logging)
+	logConfig := convertLogging(s.manifest.Logging, s.rc.Region)
 	content, err := s.parser.ParseLoadBalancedWebService(template.WorkloadOpts{
 		// Workload parameters.
 		AppName:            s.app,
@@ -198,7 +208,9 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		EnvVersion:         s.rc.EnvVersion,
 		Version:            s.rc.Version,
 		SerializedManifest: string(s.rawManifest),
+		RenderedManifest:   string(s.renderedManifest),
 		WorkloadName:       s.name,
+		LogGroupName:       s.logGroupName(),
 		WorkloadType:       manifestinfo.LoadBalancedWebServiceType,
 
 		// Configuration for the main container.
@@ -207,7 +219,7 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		HealthCheck:  convertContainerHealthCheck(s.manifest.ImageConfig.HealthCheck),
 		PortMappings: convertPortMappings(exposedPorts.PortsForContainer[s.name]),
 		Secrets:      convertSecrets(s.manifest.TaskConfig.Secrets),
-		Variables:    convertEnvVars(s.manifest.TaskConfig.Variables),
+		Variables:    convertEnvVarsWithEnvFile(s.manifest.TaskConfig.Variables, s.rc.EnvFileVariables[s.name]),
 
 		// Additional options that are common between **all** workload templates.
 		AddonsExtraParams:       addonsParams,
@@ -216,16 +228,22 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		CredentialsParameter:    aws.StringValue(s.manifest.ImageConfig.Image.Credentials),
 		DesiredCountOnSpot:      desiredCountOnSpot,
 		DeploymentConfiguration: convertDeploymentConfig(s.manifest.DeployConfig),
+		Alarms:                  convertHTTPAlarms(s.manifest.Alarms),
 		DependsOn:               convertDependsOn(s.manifest.ImageConfig.Image.DependsOn),
 		DockerLabels:            s.manifest.ImageConfig.Image.DockerLabels,
 		ExecuteCommand:          convertExecuteCommand(&s.manifest.ExecuteCommand),
 		LogConfig:               logConfig,
 		NestedStack:             addonsOutputs,
-		Network:                 convertNetworkConfig(s.manifest.Network),
+		Network:                 network,
 		Publish:                 publishers,
-		PermissionsBoundary:     s.permBound,
+		PermissionsBoundary:     s.permissionsBoundary(),
+		TaskRoleARN:             aws.StringValue(s.tc.TaskRole),
+		ExecutionRoleARN:        aws.StringValue(s.tc.ExecutionRole),
+		EC2CapacityProvider:     s.tc.OnEC2(),
+		GPU:                     s.tc.GPU,
 		Platform:                convertPlatform(s.manifest.Platform),
 		Storage:                 convertStorageOpts(s.manifest.Name, s.manifest.Storage),
+		SecretFiles:             convertSecretFiles(s.manifest.TaskConfig.Secrets, s.manifest.Sidecars),
 
 		// ALB configs.
 		ALBEnabled: !s.manifest.HTTPOrBool.Disabled(),
@@ -240,15 +258,14 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 		AppDNSName:           nlbConfig.appDNSName,
 		AppDNSDelegationRole: nlbConfig.appDNSDelegationRole,
 		NLB:                  nlbConfig.settings,
+		DualStack:            s.dualStack,
 
 		// service connect and service discovery options.
 		ServiceConnect:           scConfig,
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
 
 		// Additional options for request driven web service templates.
-		Observability: template.ObservabilityOpts{
-			Tracing: strings.ToUpper(aws.StringValue(s.manifest.Observability.Tracing)),
-		},
+		Observability: convertObservability(s.manifest.Observability),
 
 		// Sidecar configs.
 		Sidecars: sidecars,
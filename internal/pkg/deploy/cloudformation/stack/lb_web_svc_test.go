@@ -284,6 +284,14 @@ Outputs:
 				Retries:     aws.Int64(5),
 			},
 			CustomResources: map[string]template.S3ObjectLocation{
+				"BlueGreenDeploymentFunction": {
+					Bucket: "bucket",
+					Key:    "manual/scripts/custom-resources/bluegreendeploymentfunction/8932747ba5dbff619d89b92d0033ef1d04f7dd1b055e073254907d4e38e3976d.zip",
+				},
+				"DeploymentHookFunction": {
+					Bucket: "bucket",
+					Key:    "manual/scripts/custom-resources/deploymenthookfunction/8932747ba5dbff619d89b92d0033ef1d04f7dd1b055e073254907d4e38e3976d.zip",
+				},
 				"DynamicDesiredCountFunction": {
 					Bucket: "bucket",
 					Key:    "manual/scripts/custom-resources/dynamicdesiredcountfunction/8932747ba5dbff619d89b92d0033ef1d04f7dd1b055e073254907d4e38e3976d.zip",
@@ -376,6 +376,152 @@ func (mr *MockstaticSiteReadParserMockRecorder) Read(path interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockstaticSiteReadParser)(nil).Read), path)
 }
 
+// MocklambdaSvcReadParser is a mock of lambdaSvcReadParser interface.
+type MocklambdaSvcReadParser struct {
+	ctrl     *gomock.Controller
+	recorder *MocklambdaSvcReadParserMockRecorder
+}
+
+// MocklambdaSvcReadParserMockRecorder is the mock recorder for MocklambdaSvcReadParser.
+type MocklambdaSvcReadParserMockRecorder struct {
+	mock *MocklambdaSvcReadParser
+}
+
+// NewMocklambdaSvcReadParser creates a new mock instance.
+func NewMocklambdaSvcReadParser(ctrl *gomock.Controller) *MocklambdaSvcReadParser {
+	mock := &MocklambdaSvcReadParser{ctrl: ctrl}
+	mock.recorder = &MocklambdaSvcReadParserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklambdaSvcReadParser) EXPECT() *MocklambdaSvcReadParserMockRecorder {
+	return m.recorder
+}
+
+// Parse mocks base method.
+func (m *MocklambdaSvcReadParser) Parse(path string, data interface{}, options ...template.ParseOption) (*template.Content, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{path, data}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Parse", varargs...)
+	ret0, _ := ret[0].(*template.Content)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Parse indicates an expected call of Parse.
+func (mr *MocklambdaSvcReadParserMockRecorder) Parse(path, data interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{path, data}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MocklambdaSvcReadParser)(nil).Parse), varargs...)
+}
+
+// ParseLambdaService mocks base method.
+func (m *MocklambdaSvcReadParser) ParseLambdaService(arg0 template.WorkloadOpts) (*template.Content, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseLambdaService", arg0)
+	ret0, _ := ret[0].(*template.Content)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseLambdaService indicates an expected call of ParseLambdaService.
+func (mr *MocklambdaSvcReadParserMockRecorder) ParseLambdaService(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseLambdaService", reflect.TypeOf((*MocklambdaSvcReadParser)(nil).ParseLambdaService), arg0)
+}
+
+// Read mocks base method.
+func (m *MocklambdaSvcReadParser) Read(path string) (*template.Content, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", path)
+	ret0, _ := ret[0].(*template.Content)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MocklambdaSvcReadParserMockRecorder) Read(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MocklambdaSvcReadParser)(nil).Read), path)
+}
+
+// MockworkflowJobReadParser is a mock of workflowJobReadParser interface.
+type MockworkflowJobReadParser struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkflowJobReadParserMockRecorder
+}
+
+// MockworkflowJobReadParserMockRecorder is the mock recorder for MockworkflowJobReadParser.
+type MockworkflowJobReadParserMockRecorder struct {
+	mock *MockworkflowJobReadParser
+}
+
+// NewMockworkflowJobReadParser creates a new mock instance.
+func NewMockworkflowJobReadParser(ctrl *gomock.Controller) *MockworkflowJobReadParser {
+	mock := &MockworkflowJobReadParser{ctrl: ctrl}
+	mock.recorder = &MockworkflowJobReadParserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkflowJobReadParser) EXPECT() *MockworkflowJobReadParserMockRecorder {
+	return m.recorder
+}
+
+// Parse mocks base method.
+func (m *MockworkflowJobReadParser) Parse(path string, data interface{}, options ...template.ParseOption) (*template.Content, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{path, data}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Parse", varargs...)
+	ret0, _ := ret[0].(*template.Content)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Parse indicates an expected call of Parse.
+func (mr *MockworkflowJobReadParserMockRecorder) Parse(path, data interface{}, options ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{path, data}, options...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockworkflowJobReadParser)(nil).Parse), varargs...)
+}
+
+// ParseWorkflowJob mocks base method.
+func (m *MockworkflowJobReadParser) ParseWorkflowJob(arg0 template.WorkloadOpts) (*template.Content, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseWorkflowJob", arg0)
+	ret0, _ := ret[0].(*template.Content)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseWorkflowJob indicates an expected call of ParseWorkflowJob.
+func (mr *MockworkflowJobReadParserMockRecorder) ParseWorkflowJob(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseWorkflowJob", reflect.TypeOf((*MockworkflowJobReadParser)(nil).ParseWorkflowJob), arg0)
+}
+
+// Read mocks base method.
+func (m *MockworkflowJobReadParser) Read(path string) (*template.Content, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", path)
+	ret0, _ := ret[0].(*template.Content)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockworkflowJobReadParserMockRecorder) Read(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockworkflowJobReadParser)(nil).Read), path)
+}
+
 // MockscheduledJobReadParser is a mock of scheduledJobReadParser interface.
 type MockscheduledJobReadParser struct {
 	ctrl     *gomock.Controller
@@ -673,6 +819,21 @@ func (mr *MockembedFSMockRecorder) ParseEnvBootstrap(data interface{}, options .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseEnvBootstrap", reflect.TypeOf((*MockembedFS)(nil).ParseEnvBootstrap), varargs...)
 }
 
+// ParseLambdaService mocks base method.
+func (m *MockembedFS) ParseLambdaService(arg0 template.WorkloadOpts) (*template.Content, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseLambdaService", arg0)
+	ret0, _ := ret[0].(*template.Content)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseLambdaService indicates an expected call of ParseLambdaService.
+func (mr *MockembedFSMockRecorder) ParseLambdaService(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseLambdaService", reflect.TypeOf((*MockembedFS)(nil).ParseLambdaService), arg0)
+}
+
 // ParseLoadBalancedWebService mocks base method.
 func (m *MockembedFS) ParseLoadBalancedWebService(arg0 template.WorkloadOpts) (*template.Content, error) {
 	m.ctrl.T.Helper()
@@ -748,6 +909,21 @@ func (mr *MockembedFSMockRecorder) ParseWorkerService(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseWorkerService", reflect.TypeOf((*MockembedFS)(nil).ParseWorkerService), arg0)
 }
 
+// ParseWorkflowJob mocks base method.
+func (m *MockembedFS) ParseWorkflowJob(arg0 template.WorkloadOpts) (*template.Content, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseWorkflowJob", arg0)
+	ret0, _ := ret[0].(*template.Content)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseWorkflowJob indicates an expected call of ParseWorkflowJob.
+func (mr *MockembedFSMockRecorder) ParseWorkflowJob(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseWorkflowJob", reflect.TypeOf((*MockembedFS)(nil).ParseWorkflowJob), arg0)
+}
+
 // Read mocks base method.
 func (m *MockembedFS) Read(path string) (*template.Content, error) {
 	m.ctrl.T.Helper()
@@ -51,11 +51,21 @@ func NameForAppStack(app string) string {
 	return fmt.Sprintf("%s-infrastructure-roles", app)
 }
 
+// NameForRemoteBuilder returns the name of the stack, and of the CodeBuild project in it, that builds the images of an app.
+func NameForRemoteBuilder(app string) string {
+	return fmt.Sprintf("%s-remote-builder", app)
+}
+
 // NameForAppStackSet returns the stackset name for an app.
 func NameForAppStackSet(app string) string {
 	return fmt.Sprintf("%s-infrastructure", app)
 }
 
+// NameForGitHubActionsRole returns the name of the stack of the IAM role assumed by the GitHub Actions workflow of a pipeline.
+func NameForGitHubActionsRole(app, pipeline string) string {
+	return fmt.Sprintf(fmtPipelineNamespaced+"-github-actions", app, pipeline)
+}
+
 // NameForPipeline returns the stack name for a pipeline, depending on whether it has been deployed using the legacy scheme.
 // Note that it doesn't cut name to length of 128 like service stack name. It expects CloudFormation to error out
 // when the name is to long.
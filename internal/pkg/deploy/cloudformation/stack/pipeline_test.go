@@ -11,7 +11,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -162,6 +164,47 @@ func TestPipelineStackConfig_Template(t *testing.T) {
 	}
 }
 
+func TestPipelineStackConfig_Template_ApprovalDiff(t *testing.T) {
+	var stage deploy.PipelineStage
+	stage.Init(&config.Environment{
+		Name:   "prod",
+		App:    projectName,
+		Region: "us-west-2",
+	}, &manifest.PipelineStage{
+		Name:             "prod",
+		RequiresApproval: true,
+		Approval: manifest.ApprovalConfig{
+			Emails: []string{"dev@example.com"},
+			Diff:   true,
+		},
+	}, []string{"api"})
+	in := mockCreatePipelineInput()
+	in.Stages = []deploy.PipelineStage{stage}
+	in.Build = &deploy.Build{
+		Image:           "aws/codebuild/amazonlinux2-x86_64-standard:4.0",
+		EnvironmentType: "LINUX_CONTAINER",
+		BuildspecPath:   "copilot/pipelines/wingspipeline/buildspec.yml",
+	}
+
+	tpl, err := NewPipelineStackConfig(in).Template()
+
+	require.NoError(t, err)
+	require.Contains(t, tpl, `
+Mappings:
+  ArtifactBuckets:
+    us-east-1:
+      Name: chicken-us-east-1
+    us-west-2:
+      Name: chicken-us-west-2
+    us-west-1:
+      Name: chicken-us-west-1
+`)
+	require.Contains(t, tpl, "Value: !FindInMap [ArtifactBuckets, !Ref AWS::Region, Name]")
+	require.Contains(t, tpl, "Namespace: BuildVariables")
+	require.Contains(t, tpl, `CustomData: "#{BuildVariables.COPILOT_DIFF_SUMMARY_PROD}"`)
+	require.Contains(t, tpl, `ExternalEntityLink: "#{BuildVariables.COPILOT_DIFF_URL_PROD}"`)
+}
+
 func mockCreatePipelineInput() *deploy.CreatePipelineInput {
 	return &deploy.CreatePipelineInput{
 		AppName: projectName,
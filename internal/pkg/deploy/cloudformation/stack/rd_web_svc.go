@@ -54,6 +54,7 @@ type RequestDrivenWebServiceConfig struct {
 	Env                string
 	Manifest           *manifest.RequestDrivenWebService
 	RawManifest        []byte
+	RenderedManifest   []byte
 	ArtifactBucketName string
 	RuntimeConfig      RuntimeConfig
 	Addons             NestedStackConfigurer
@@ -78,6 +79,7 @@ func NewRequestDrivenWebService(cfg RequestDrivenWebServiceConfig) (*RequestDriv
 				rc:                 cfg.RuntimeConfig,
 				image:              cfg.Manifest.ImageConfig.Image,
 				rawManifest:        cfg.RawManifest,
+				renderedManifest:   cfg.RenderedManifest,
 				addons:             cfg.Addons,
 				parser:             fs,
 			},
@@ -120,6 +122,7 @@ func (s *RequestDrivenWebService) Template() (string, error) {
 		EnvName:            s.env,
 		WorkloadName:       s.name,
 		SerializedManifest: string(s.rawManifest),
+		RenderedManifest:   string(s.renderedManifest),
 		EnvVersion:         s.rc.EnvVersion,
 		Version:            s.rc.Version,
 
@@ -146,7 +149,9 @@ func (s *RequestDrivenWebService) Template() (string, error) {
 		PermissionsBoundary:  s.permBound,
 		Private:              aws.BoolValue(s.manifest.Private.Basic) || s.manifest.Private.Advanced.Endpoint != nil,
 		AppRunnerVPCEndpoint: s.manifest.Private.Advanced.Endpoint,
-		Count:                s.manifest.Count,
+		Count:                s.manifest.Count.Basic,
+		AutoScaling:          convertAppRunnerAutoScaling(s.manifest.Count),
+		WAF:                  convertWAF(s.manifest.WAF),
 		Secrets:              convertSecrets(s.manifest.RequestDrivenWebServiceConfig.Secrets),
 	})
 	if err != nil {
@@ -196,7 +196,7 @@ func TestRequestDrivenWebService_Template(t *testing.T) {
 						},
 						Secrets:           map[string]template.Secret{"foo": template.SecretFromPlainSSMOrARN("")},
 						Tags:              c.manifest.Tags,
-						Count:             c.manifest.Count,
+						Count:             c.manifest.Count.Basic,
 						EnableHealthCheck: true,
 						Alias:             aws.String("convex.domain.com"),
 						CustomResources: map[string]template.S3ObjectLocation{
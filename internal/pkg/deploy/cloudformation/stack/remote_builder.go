@@ -0,0 +1,88 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+)
+
+const (
+	remoteBuilderTemplatePath = "builder/cf.yml"
+
+	remoteBuilderAppNameParamKey        = "AppName"
+	remoteBuilderArtifactBucketParamKey = "ArtifactBucket"
+)
+
+// RemoteBuilderConfig holds the configuration of the CodeBuild project that builds the container images of an application.
+type RemoteBuilderConfig struct {
+	App                 string
+	PermissionsBoundary string
+	ArtifactBucket      string // Name of the bucket that the build contexts are uploaded to.
+	AdditionalTags      map[string]string
+}
+
+type remoteBuilderStackConfig struct {
+	*RemoteBuilderConfig
+	parser template.Parser
+}
+
+// NewRemoteBuilderStackConfig sets up a struct that provides stack configurations for CloudFormation
+// to deploy the remote builder stack of an application.
+func NewRemoteBuilderStackConfig(conf *RemoteBuilderConfig) *remoteBuilderStackConfig {
+	return &remoteBuilderStackConfig{
+		RemoteBuilderConfig: conf,
+		parser:              template.New(),
+	}
+}
+
+// StackName returns the name of the CloudFormation stack for the remote builder.
+func (s *remoteBuilderStackConfig) StackName() string {
+	return NameForRemoteBuilder(s.App)
+}
+
+// Template returns the remote builder CloudFormation template.
+func (s *remoteBuilderStackConfig) Template() (string, error) {
+	content, err := s.parser.Parse(remoteBuilderTemplatePath, struct {
+		PermissionsBoundary string
+	}{
+		PermissionsBoundary: s.PermissionsBoundary,
+	})
+	if err != nil {
+		return "", fmt.Errorf("read template for remote builder stack: %w", err)
+	}
+	return content.String(), nil
+}
+
+// Parameters returns the parameter values to be passed to the remote builder CloudFormation template.
+func (s *remoteBuilderStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
+	return []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(remoteBuilderAppNameParamKey),
+			ParameterValue: aws.String(s.App),
+		},
+		{
+			ParameterKey:   aws.String(remoteBuilderArtifactBucketParamKey),
+			ParameterValue: aws.String(s.ArtifactBucket),
+		},
+	}, nil
+}
+
+// SerializedParameters returns the CloudFormation stack's parameters serialized
+// to a YAML document annotated with comments for readability to users.
+func (s *remoteBuilderStackConfig) SerializedParameters() (string, error) {
+	// No-op for now.
+	return "", nil
+}
+
+// Tags returns the tags that should be applied to the remote builder CloudFormation stack.
+func (s *remoteBuilderStackConfig) Tags() []*cloudformation.Tag {
+	return mergeAndFlattenTags(s.AdditionalTags, map[string]string{
+		deploy.AppTagKey: s.App,
+	})
+}
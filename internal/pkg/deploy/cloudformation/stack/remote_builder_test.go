@@ -0,0 +1,147 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestRemoteBuilderStackConfig_Template(t *testing.T) {
+	testCases := map[string]struct {
+		mockParser func(m *mocks.MockParser)
+
+		wantedTemplate string
+		wantedError    error
+	}{
+		"should return error if unable to parse": {
+			mockParser: func(m *mocks.MockParser) {
+				m.EXPECT().Parse(remoteBuilderTemplatePath, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("read template for remote builder stack: some error"),
+		},
+		"should return template body when present": {
+			mockParser: func(m *mocks.MockParser) {
+				m.EXPECT().Parse(remoteBuilderTemplatePath, struct {
+					PermissionsBoundary string
+				}{
+					PermissionsBoundary: "mockBoundary",
+				}).Return(&template.Content{
+					Buffer: bytes.NewBufferString("This is the remote builder template"),
+				}, nil)
+			},
+			wantedTemplate: "This is the remote builder template",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockParser(ctrl)
+			tc.mockParser(m)
+			conf := &remoteBuilderStackConfig{
+				RemoteBuilderConfig: &RemoteBuilderConfig{
+					App:                 "phonetool",
+					PermissionsBoundary: "mockBoundary",
+				},
+				parser: m,
+			}
+
+			// WHEN
+			got, err := conf.Template()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTemplate, got)
+		})
+	}
+}
+
+func TestRemoteBuilderStackConfig_TemplateIsValidYAML(t *testing.T) {
+	// GIVEN
+	conf := NewRemoteBuilderStackConfig(&RemoteBuilderConfig{
+		App:                 "phonetool",
+		PermissionsBoundary: "mockBoundary",
+	})
+
+	// WHEN
+	got, err := conf.Template()
+
+	// THEN
+	require.NoError(t, err)
+	var tpl struct {
+		Resources map[string]struct {
+			Type       string         `yaml:"Type"`
+			Properties map[string]any `yaml:"Properties"`
+		} `yaml:"Resources"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(got), &tpl))
+	require.Equal(t, "AWS::CodeBuild::Project", tpl.Resources["Project"].Type)
+	require.Contains(t, tpl.Resources["BuildRole"].Properties, "PermissionsBoundary")
+}
+
+func TestRemoteBuilderStackConfig_Parameters(t *testing.T) {
+	// GIVEN
+	conf := NewRemoteBuilderStackConfig(&RemoteBuilderConfig{
+		App:            "phonetool",
+		ArtifactBucket: "mockBucket",
+	})
+
+	// WHEN
+	params, err := conf.Parameters()
+
+	// THEN
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String("AppName"),
+			ParameterValue: aws.String("phonetool"),
+		},
+		{
+			ParameterKey:   aws.String("ArtifactBucket"),
+			ParameterValue: aws.String("mockBucket"),
+		},
+	}, params)
+}
+
+func TestRemoteBuilderStackConfig_Tags(t *testing.T) {
+	// GIVEN
+	conf := NewRemoteBuilderStackConfig(&RemoteBuilderConfig{
+		App: "phonetool",
+		AdditionalTags: map[string]string{
+			"owner": "boss",
+		},
+	})
+
+	// WHEN
+	tags := conf.Tags()
+
+	// THEN
+	require.Equal(t, "phonetool-remote-builder", conf.StackName())
+	require.ElementsMatch(t, []*cloudformation.Tag{
+		{
+			Key:   aws.String("copilot-application"),
+			Value: aws.String("phonetool"),
+		},
+		{
+			Key:   aws.String("owner"),
+			Value: aws.String("boss"),
+		},
+	}, tags)
+}
@@ -0,0 +1,170 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+)
+
+// revisionIDFormat is the layout of the ID of a deployment revision, so that IDs sort in chronological order.
+const revisionIDFormat = "20060102-150405"
+
+// Revision is a deployment of a workload stack recorded by Copilot, that can be deployed again to roll back the workload.
+// Revision implements the cloudformation.StackConfiguration interface.
+type Revision struct {
+	ID              string            `json:"id"`
+	DeployedAt      time.Time         `json:"deployedAt"`
+	Name            string            `json:"stackName"`
+	TemplateBody    string            `json:"template"`
+	ParameterValues map[string]string `json:"parameters"`
+	TagValues       map[string]string `json:"tags,omitempty"`
+
+	// Audit information about the deployment.
+	DeployedBy     string            `json:"deployedBy,omitempty"`     // ARN of the identity that deployed the revision.
+	FreezeOverride string            `json:"freezeOverride,omitempty"` // Reason given to deploy during a freeze or outside of the deploy windows.
+	GitCommit      string            `json:"gitCommit,omitempty"`      // Short commit of the workspace when the revision was deployed.
+	ImageDigests   map[string]string `json:"imageDigests,omitempty"`   // Container name to the digest of the image it runs.
+	TemplateHash   string            `json:"templateHash,omitempty"`   // SHA-256 of the template.
+	DiffSummary    string            `json:"diffSummary,omitempty"`    // One-line summary of the changes from the previous revision.
+	Diff           string            `json:"diff,omitempty"`           // Changes of the template from the previous revision.
+}
+
+// RevisionID returns the ID of the deployment revision of a stack deployed at the given time.
+func RevisionID(deployedAt time.Time) string {
+	return deployedAt.UTC().Format(revisionIDFormat)
+}
+
+type revisionStackConfigurer interface {
+	StackName() string
+	Template() (string, error)
+	Parameters() ([]*cloudformation.Parameter, error)
+	Tags() []*cloudformation.Tag
+}
+
+// NewRevision records the configuration of a stack deployed at the given time.
+// The container images pushed for the deployment are pinned to their digest, so that deploying the revision again
+// runs the same images even if their tags were moved to other images since.
+func NewRevision(conf revisionStackConfigurer, deployedAt time.Time, images []ECRImage) (*Revision, error) {
+	tpl, err := conf.Template()
+	if err != nil {
+		return nil, fmt.Errorf("generate template of stack %s: %w", conf.StackName(), err)
+	}
+	params, err := conf.Parameters()
+	if err != nil {
+		return nil, fmt.Errorf("generate parameters of stack %s: %w", conf.StackName(), err)
+	}
+	var pairs []string
+	var digests map[string]string
+	for _, img := range images {
+		if img.Digest == "" {
+			continue
+		}
+		pairs = append(pairs, img.URI(), fmt.Sprintf("%s@%s", img.RepoURL, img.Digest))
+		if digests == nil {
+			digests = make(map[string]string)
+		}
+		digests[img.ContainerName] = img.Digest
+	}
+	pin := strings.NewReplacer(pairs...)
+	rev := &Revision{
+		ID:              RevisionID(deployedAt),
+		DeployedAt:      deployedAt.UTC(),
+		Name:            conf.StackName(),
+		TemplateBody:    pin.Replace(tpl),
+		ParameterValues: make(map[string]string, len(params)),
+		ImageDigests:    digests,
+	}
+	rev.TemplateHash = hash(rev.TemplateBody)
+	for _, param := range params {
+		rev.ParameterValues[aws.StringValue(param.ParameterKey)] = pin.Replace(aws.StringValue(param.ParameterValue))
+	}
+	tags := conf.Tags()
+	if len(tags) > 0 {
+		rev.TagValues = make(map[string]string, len(tags))
+	}
+	for _, tag := range tags {
+		rev.TagValues[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return rev, nil
+}
+
+// ParseRevision unmarshals a revision from its JSON representation.
+func ParseRevision(content []byte) (*Revision, error) {
+	var rev Revision
+	if err := json.Unmarshal(content, &rev); err != nil {
+		return nil, fmt.Errorf("unmarshal deployment revision: %w", err)
+	}
+	return &rev, nil
+}
+
+// Marshal returns the JSON representation of the revision.
+func (r *Revision) Marshal() ([]byte, error) {
+	content, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal deployment revision %s: %w", r.ID, err)
+	}
+	return content, nil
+}
+
+// StackName returns the name of the CloudFormation stack.
+func (r *Revision) StackName() string {
+	return r.Name
+}
+
+// Template returns the CloudFormation template of the revision.
+func (r *Revision) Template() (string, error) {
+	return r.TemplateBody, nil
+}
+
+// Parameters returns the parameter values of the revision, sorted by key.
+func (r *Revision) Parameters() ([]*cloudformation.Parameter, error) {
+	params := make([]*cloudformation.Parameter, 0, len(r.ParameterValues))
+	for _, key := range sortedKeys(r.ParameterValues) {
+		params = append(params, &cloudformation.Parameter{
+			ParameterKey:   aws.String(key),
+			ParameterValue: aws.String(r.ParameterValues[key]),
+		})
+	}
+	return params, nil
+}
+
+// Tags returns the tags of the revision, sorted by key.
+func (r *Revision) Tags() []*cloudformation.Tag {
+	tags := make([]*cloudformation.Tag, 0, len(r.TagValues))
+	for _, key := range sortedKeys(r.TagValues) {
+		tags = append(tags, &cloudformation.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(r.TagValues[key]),
+		})
+	}
+	return tags
+}
+
+// SerializedParameters returns the CloudFormation stack's parameters serialized to a JSON document.
+func (r *Revision) SerializedParameters() (string, error) {
+	return serializeTemplateConfig(nil, r)
+}
+
+func hash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
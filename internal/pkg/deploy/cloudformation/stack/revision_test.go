@@ -0,0 +1,189 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRevisionStackConfigurer struct {
+	tpl    string
+	tplErr error
+	params []*cloudformation.Parameter
+	tags   []*cloudformation.Tag
+}
+
+func (m mockRevisionStackConfigurer) StackName() string {
+	return "phonetool-test-frontend"
+}
+
+func (m mockRevisionStackConfigurer) Template() (string, error) {
+	return m.tpl, m.tplErr
+}
+
+func (m mockRevisionStackConfigurer) Parameters() ([]*cloudformation.Parameter, error) {
+	return m.params, nil
+}
+
+func (m mockRevisionStackConfigurer) Tags() []*cloudformation.Tag {
+	return m.tags
+}
+
+func TestNewRevision(t *testing.T) {
+	deployedAt := time.Date(2023, time.January, 2, 15, 4, 5, 0, time.UTC)
+	testCases := map[string]struct {
+		inConf   mockRevisionStackConfigurer
+		inImages []ECRImage
+
+		wanted    *Revision
+		wantedErr error
+	}{
+		"should return a wrapped error if the template cannot be generated": {
+			inConf: mockRevisionStackConfigurer{
+				tplErr: errors.New("some error"),
+			},
+			wantedErr: errors.New("generate template of stack phonetool-test-frontend: some error"),
+		},
+		"should pin the pushed images to their digest": {
+			inConf: mockRevisionStackConfigurer{
+				tpl: "Image: 1234.dkr.ecr.us-west-2.amazonaws.com/frontend:logging-v1\n",
+				params: []*cloudformation.Parameter{
+					{
+						ParameterKey:   aws.String(WorkloadContainerImageParamKey),
+						ParameterValue: aws.String("1234.dkr.ecr.us-west-2.amazonaws.com/frontend:v1"),
+					},
+				},
+				tags: []*cloudformation.Tag{
+					{
+						Key:   aws.String("copilot-application"),
+						Value: aws.String("phonetool"),
+					},
+				},
+			},
+			inImages: []ECRImage{
+				{
+					RepoURL:           "1234.dkr.ecr.us-west-2.amazonaws.com/frontend",
+					ImageTag:          "v1",
+					Digest:            "sha256:main",
+					ContainerName:     "frontend",
+					MainContainerName: "frontend",
+				},
+				{
+					RepoURL:           "1234.dkr.ecr.us-west-2.amazonaws.com/frontend",
+					ImageTag:          "v1",
+					Digest:            "sha256:logging",
+					ContainerName:     "logging",
+					MainContainerName: "frontend",
+				},
+			},
+			wanted: &Revision{
+				ID:           "20230102-150405",
+				DeployedAt:   deployedAt,
+				Name:         "phonetool-test-frontend",
+				TemplateBody: "Image: 1234.dkr.ecr.us-west-2.amazonaws.com/frontend@sha256:logging\n",
+				ParameterValues: map[string]string{
+					WorkloadContainerImageParamKey: "1234.dkr.ecr.us-west-2.amazonaws.com/frontend@sha256:main",
+				},
+				TagValues: map[string]string{
+					"copilot-application": "phonetool",
+				},
+				ImageDigests: map[string]string{
+					"frontend": "sha256:main",
+					"logging":  "sha256:logging",
+				},
+				TemplateHash: "214e45accf9b5963d7a0d371b383a2b9147148bc8d818c3ab3299002118cef2c",
+			},
+		},
+		"should keep image references without a digest": {
+			inConf: mockRevisionStackConfigurer{
+				tpl: "Image: nginx\n",
+			},
+			inImages: []ECRImage{
+				{
+					RepoURL:  "1234.dkr.ecr.us-west-2.amazonaws.com/frontend",
+					ImageTag: "v1",
+				},
+			},
+			wanted: &Revision{
+				ID:              "20230102-150405",
+				DeployedAt:      deployedAt,
+				Name:            "phonetool-test-frontend",
+				TemplateBody:    "Image: nginx\n",
+				ParameterValues: map[string]string{},
+				TemplateHash:    "05b47354e81061d6363ee7a407c6b6b6284922717880c5b99a8eb97fc77d2324",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := NewRevision(tc.inConf, deployedAt, tc.inImages)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestRevision_Marshal(t *testing.T) {
+	// GIVEN
+	rev := &Revision{
+		ID:           "20230102-150405",
+		DeployedAt:   time.Date(2023, time.January, 2, 15, 4, 5, 0, time.UTC),
+		Name:         "phonetool-test-frontend",
+		TemplateBody: "Resources: {}\n",
+		ParameterValues: map[string]string{
+			"EnvName": "test",
+			"AppName": "phonetool",
+		},
+		TagValues: map[string]string{
+			"copilot-application": "phonetool",
+		},
+		DeployedBy:   "arn:aws:iam::1234:user/alice",
+		GitCommit:    "a1b2c3d",
+		ImageDigests: map[string]string{"frontend": "sha256:main"},
+		TemplateHash: "8d4a1b0c",
+		DiffSummary:  "0 resources added, 1 modified, 0 removed",
+		Diff:         "~ Resources:\n",
+	}
+
+	// WHEN
+	content, err := rev.Marshal()
+	require.NoError(t, err)
+	got, err := ParseRevision(content)
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, rev, got)
+	params, err := got.Parameters()
+	require.NoError(t, err)
+	require.Equal(t, []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String("AppName"),
+			ParameterValue: aws.String("phonetool"),
+		},
+		{
+			ParameterKey:   aws.String("EnvName"),
+			ParameterValue: aws.String("test"),
+		},
+	}, params)
+	require.Equal(t, []*cloudformation.Tag{
+		{
+			Key:   aws.String("copilot-application"),
+			Value: aws.String("phonetool"),
+		},
+	}, got.Tags())
+}
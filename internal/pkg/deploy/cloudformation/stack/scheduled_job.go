@@ -93,6 +93,7 @@ type ScheduledJobConfig struct {
 	Manifest           *manifest.ScheduledJob
 	ArtifactBucketName string
 	RawManifest        []byte
+	RenderedManifest   []byte
 	RuntimeConfig      RuntimeConfig
 	Addons             NestedStackConfigurer
 }
@@ -116,6 +117,8 @@ func NewScheduledJob(cfg ScheduledJobConfig) (*ScheduledJob, error) {
 				rc:                 cfg.RuntimeConfig,
 				image:              cfg.Manifest.ImageConfig.Image,
 				rawManifest:        cfg.RawManifest,
+				renderedManifest:   cfg.RenderedManifest,
+				resourceNames:      cfg.App.ResourceNames,
 				parser:             fs,
 				addons:             cfg.Addons,
 			},
@@ -156,6 +159,14 @@ func (j *ScheduledJob) Template() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("convert schedule for job %s: %w", j.name, err)
 	}
+	schedules, err := j.additionalSchedules()
+	if err != nil {
+		return "", fmt.Errorf(`convert "on.schedules" field for job %s: %w`, j.name, err)
+	}
+	eventRules, err := convertEventRules(j.manifest.On.Events)
+	if err != nil {
+		return "", fmt.Errorf(`convert "on.events" field for job %s: %w`, j.name, err)
+	}
 	stateMachine, err := j.stateMachineOpts()
 	if err != nil {
 		return "", fmt.Errorf("convert retry/timeout config for job %s: %w", j.name, err)
@@ -175,18 +186,23 @@ func (j *ScheduledJob) Template() (string, error) {
 
 	content, err := j.parser.ParseScheduledJob(template.WorkloadOpts{
 		SerializedManifest:       string(j.rawManifest),
-		Variables:                convertEnvVars(j.manifest.Variables),
+		RenderedManifest:         string(j.renderedManifest),
+		LogGroupName:             j.logGroupName(),
+		Variables:                convertEnvVarsWithEnvFile(j.manifest.Variables, j.rc.EnvFileVariables[j.name]),
 		Secrets:                  convertSecrets(j.manifest.Secrets),
 		WorkloadType:             manifestinfo.ScheduledJobType,
 		NestedStack:              addonsOutputs,
 		AddonsExtraParams:        addonsParams,
 		Sidecars:                 sidecars,
 		ScheduleExpression:       schedule,
+		Schedules:                schedules,
+		EventRules:               eventRules,
 		StateMachine:             stateMachine,
 		HealthCheck:              convertContainerHealthCheck(j.manifest.ImageConfig.HealthCheck),
-		LogConfig:                convertLogging(j.manifest.Logging),
+		LogConfig:                convertLogging(j.manifest.Logging, j.rc.Region),
 		DockerLabels:             j.manifest.ImageConfig.Image.DockerLabels,
 		Storage:                  convertStorageOpts(j.manifest.Name, j.manifest.Storage),
+		SecretFiles:              convertSecretFiles(j.manifest.Secrets, j.manifest.Sidecars),
 		Network:                  convertNetworkConfig(j.manifest.Network),
 		EntryPoint:               entrypoint,
 		Command:                  command,
@@ -199,7 +215,11 @@ func (j *ScheduledJob) Template() (string, error) {
 		Version:                  j.rc.Version,
 
 		CustomResources:     crs,
-		PermissionsBoundary: j.permBound,
+		PermissionsBoundary: j.permissionsBoundary(),
+		TaskRoleARN:         aws.StringValue(j.tc.TaskRole),
+		ExecutionRoleARN:    aws.StringValue(j.tc.ExecutionRole),
+		EC2CapacityProvider: j.tc.OnEC2(),
+		GPU:                 j.tc.GPU,
 	})
 	if err != nil {
 		return "", fmt.Errorf("parse scheduled job template: %w", err)
@@ -244,13 +264,51 @@ func (j *ScheduledJob) SerializedParameters() (string, error) {
 // Exception is made for strings of the form "rate( )" or "cron( )". These are accepted as-is and
 // validated server-side by CloudFormation.
 func (j *ScheduledJob) awsSchedule() (string, error) {
-	schedule := aws.StringValue(j.manifest.On.Schedule)
+	return convertJobTrigger(j.name, j.manifest.On)
+}
+
+// convertJobTrigger converts the "on.schedule" field of a job to the expression of its schedule rule.
+func convertJobTrigger(name string, on manifest.JobTriggerConfig) (string, error) {
+	schedule := aws.StringValue(on.Schedule)
+	if schedule == "" && (len(on.Schedules) != 0 || len(on.Events) != 0) {
+		return "none", nil // The job is only triggered by the additional schedules or events, so the schedule rule is disabled.
+	}
 	if schedule == "" {
-		return "", fmt.Errorf(`missing required field "schedule" in manifest for job %s`, j.name)
+		return "", fmt.Errorf(`missing required field "schedule" in manifest for job %s`, name)
 	}
+	return toAWSSchedule(schedule)
+}
+
+// additionalSchedules converts the "on.schedules" field to the EventBridge Scheduler schedules of the job.
+func (j *ScheduledJob) additionalSchedules() ([]*template.JobSchedule, error) {
+	return convertJobSchedules(j.manifest.On.Schedules)
+}
+
+func convertJobSchedules(in []manifest.JobSchedule) ([]*template.JobSchedule, error) {
+	var schedules []*template.JobSchedule
+	for idx, schedule := range in {
+		expression, err := toAWSSchedule(aws.StringValue(schedule.Schedule))
+		if err != nil {
+			return nil, fmt.Errorf("convert schedule %d: %w", idx, err)
+		}
+		var window *int64
+		if schedule.FlexibleWindow != nil {
+			window = aws.Int64(int64(schedule.FlexibleWindow.Minutes()))
+		}
+		schedules = append(schedules, &template.JobSchedule{
+			Expression:            expression,
+			Timezone:              schedule.Timezone,
+			FlexibleWindowMinutes: window,
+		})
+	}
+	return schedules, nil
+}
+
+// toAWSSchedule converts a schedule of the manifest to an expression as described in awsSchedule.
+func toAWSSchedule(schedule string) (string, error) {
 	// If the schedule uses default CloudWatch Events syntax, pass it through for server-side validation.
 	if match := awsScheduleRegexp.FindStringSubmatch(schedule); match != nil {
-		return aws.StringValue(j.manifest.On.Schedule), nil
+		return schedule, nil
 	}
 	// Try parsing the string as a cron expression to validate it.
 	if _, err := cron.ParseStandard(schedule); err != nil {
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -151,6 +152,59 @@ DiscoveryServiceArn: !GetAtt DiscoveryService.Arn`,
 			},
 			wantedTemplate: "template",
 		},
+		"render template with existing roles and a permissions boundary": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, j *ScheduledJob) {
+				m := mocks.NewMockscheduledJobReadParser(ctrl)
+				m.EXPECT().ParseScheduledJob(gomock.Any()).DoAndReturn(func(actual template.WorkloadOpts) (*template.Content, error) {
+					require.Equal(t, "central/my-boundary", actual.PermissionsBoundary)
+					require.Equal(t, "arn:aws:iam::123456789012:role/my-task-role", actual.TaskRoleARN)
+					require.Equal(t, "arn:aws:iam::123456789012:role/my-execution-role", actual.ExecutionRoleARN)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				j.parser = m
+				j.wkld.addons = mockAddons{}
+				j.permBound = "app-boundary"
+				j.tc.TaskRole = aws.String("arn:aws:iam::123456789012:role/my-task-role")
+				j.tc.ExecutionRole = aws.String("arn:aws:iam::123456789012:role/my-execution-role")
+				j.tc.PermissionsBoundary = aws.String("arn:aws:iam::123456789012:policy/central/my-boundary")
+			},
+			wantedTemplate: "template",
+		},
+		"render template with the tasks on the EC2 capacity provider": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, j *ScheduledJob) {
+				m := mocks.NewMockscheduledJobReadParser(ctrl)
+				m.EXPECT().ParseScheduledJob(gomock.Any()).DoAndReturn(func(actual template.WorkloadOpts) (*template.Content, error) {
+					require.True(t, actual.EC2CapacityProvider)
+					require.Equal(t, aws.Int(1), actual.GPU)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				j.parser = m
+				j.wkld.addons = mockAddons{}
+				j.tc.Compute = aws.String("ec2")
+				j.tc.GPU = aws.Int(1)
+			},
+			wantedTemplate: "template",
+		},
+		"error if addons output managed policies for an existing task role": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, j *ScheduledJob) {
+				j.wkld.addons = mockAddons{
+					tpl: `Resources:
+  AdditionalResourcesPolicy:
+    Type: AWS::IAM::ManagedPolicy
+    Properties:
+      PolicyDocument:
+        Statement:
+        - Effect: Allow
+          Action: '*'
+          Resource: '*'
+Outputs:
+  AdditionalResourcesPolicyArn:
+    Value: !Ref AdditionalResourcesPolicy`,
+				}
+				j.tc.TaskRole = aws.String("arn:aws:iam::123456789012:role/my-task-role")
+			},
+			wantedError: errors.New(`addons of mailer output the managed policies AdditionalResourcesPolicyArn that cannot be attached to the existing task role arn:aws:iam::123456789012:role/my-task-role: grant the permissions to the role instead, or remove "task_role" from the manifest`),
+		},
 		"error parsing addons": {
 			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, j *ScheduledJob) {
 				addons := mockAddons{tplErr: errors.New("some error")}
@@ -213,6 +267,8 @@ DiscoveryServiceArn: !GetAtt DiscoveryService.Arn`,
 func TestScheduledJob_awsSchedule(t *testing.T) {
 	testCases := map[string]struct {
 		inputSchedule   string
+		inputSchedules  []manifest.JobSchedule
+		inputEvents     []manifest.EventRule
 		wantedSchedule  string
 		wantedError     error
 		wantedErrorType interface{}
@@ -225,6 +281,24 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 			inputSchedule: "",
 			wantedError:   errors.New(`missing required field "schedule" in manifest for job mailer`),
 		},
+		"disable the schedule if the job is only triggered by events": {
+			inputEvents: []manifest.EventRule{
+				{
+					Name:    aws.String("orders"),
+					Pattern: map[string]interface{}{"source": []interface{}{"com.example.orders"}},
+				},
+			},
+			wantedSchedule: "none",
+		},
+		"disable the schedule if the job is only triggered by additional schedules": {
+			inputSchedules: []manifest.JobSchedule{
+				{
+					Schedule: aws.String("@daily"),
+					Timezone: aws.String("Europe/London"),
+				},
+			},
+			wantedSchedule: "none",
+		},
 		"one minute rate": {
 			inputSchedule:  "@every 1m",
 			wantedSchedule: "rate(1 minute)",
@@ -338,7 +412,9 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 				manifest: &manifest.ScheduledJob{
 					ScheduledJobConfig: manifest.ScheduledJobConfig{
 						On: manifest.JobTriggerConfig{
-							Schedule: aws.String(tc.inputSchedule),
+							Schedule:  aws.String(tc.inputSchedule),
+							Schedules: tc.inputSchedules,
+							Events:    tc.inputEvents,
 						},
 					},
 				},
@@ -361,6 +437,76 @@ func TestScheduledJob_awsSchedule(t *testing.T) {
 	}
 }
 
+func TestScheduledJob_additionalSchedules(t *testing.T) {
+	window := 15 * time.Minute
+	testCases := map[string]struct {
+		inSchedules []manifest.JobSchedule
+
+		wanted      []*template.JobSchedule
+		wantedError error
+	}{
+		"no additional schedules": {},
+		"converts the expressions and the flexible windows": {
+			inSchedules: []manifest.JobSchedule{
+				{
+					Schedule:       aws.String("0 9 * * MON-FRI"),
+					Timezone:       aws.String("America/New_York"),
+					FlexibleWindow: &window,
+				},
+				{
+					Schedule: aws.String("rate(1 hour)"),
+				},
+			},
+			wanted: []*template.JobSchedule{
+				{
+					Expression:            "cron(0 9 ? * MON-FRI *)",
+					Timezone:              aws.String("America/New_York"),
+					FlexibleWindowMinutes: aws.Int64(15),
+				},
+				{
+					Expression: "rate(1 hour)",
+				},
+			},
+		},
+		"wraps the error of an invalid schedule": {
+			inSchedules: []manifest.JobSchedule{
+				{
+					Schedule: aws.String("@daily"),
+				},
+				{
+					Schedule: aws.String("* * 1 * SUN"),
+				},
+			},
+			wantedError: errors.New("convert schedule 1: parse cron schedule: cannot specify both DOW and DOM in cron expression"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			job := &ScheduledJob{
+				manifest: &manifest.ScheduledJob{
+					ScheduledJobConfig: manifest.ScheduledJobConfig{
+						On: manifest.JobTriggerConfig{
+							Schedules: tc.inSchedules,
+						},
+					},
+				},
+			}
+
+			// WHEN
+			got, err := job.additionalSchedules()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
 func TestScheduledJob_stateMachine(t *testing.T) {
 	testCases := map[string]struct {
 		inputTimeout    string
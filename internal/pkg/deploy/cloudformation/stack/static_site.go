@@ -23,6 +23,7 @@ type StaticSite struct {
 	manifest             *manifest.StaticSite
 	dnsDelegationEnabled bool
 	appInfo              deploy.AppInformation
+	accessLogs           bool // True if the environment has a bucket for the access logs of static sites.
 
 	parser          staticSiteReadParser
 	assetMappingURL string
@@ -34,6 +35,7 @@ type StaticSiteConfig struct {
 	EnvManifest        *manifest.Environment
 	Manifest           *manifest.StaticSite
 	RawManifest        []byte // Content of the manifest file without any transformations.
+	RenderedManifest   []byte // Content of the manifest file after the substitution of its environment variables.
 	RuntimeConfig      RuntimeConfig
 	RootUserARN        string
 	ArtifactBucketName string
@@ -68,12 +70,14 @@ func NewStaticSite(cfg *StaticSiteConfig) (*StaticSite, error) {
 			artifactBucketName: cfg.ArtifactBucketName,
 			rc:                 cfg.RuntimeConfig,
 			rawManifest:        cfg.RawManifest,
+			renderedManifest:   cfg.RenderedManifest,
 			parser:             fs,
 			addons:             cfg.Addons,
 		},
 		manifest:             cfg.Manifest,
 		dnsDelegationEnabled: dnsDelegationEnabled,
 		appInfo:              appInfo,
+		accessLogs:           cfg.EnvManifest.StaticSiteLogsEnabled(),
 
 		parser:          fs,
 		assetMappingURL: cfg.AssetMappingURL,
@@ -101,6 +105,10 @@ func (s *StaticSite) Template() (string, error) {
 			return "", err
 		}
 	}
+	responseHeaders, err := convertStaticSiteResponseHeaders(s.manifest.HTTP.CustomHeaders)
+	if err != nil {
+		return "", fmt.Errorf(`convert "custom_headers": %w`, err)
+	}
 	dnsDelegationRole, dnsName := convertAppInformation(s.appInfo)
 	content, err := s.parser.ParseStaticSite(template.WorkloadOpts{
 		// Workload parameters.
@@ -109,6 +117,7 @@ func (s *StaticSite) Template() (string, error) {
 		EnvVersion:         s.rc.EnvVersion,
 		Version:            s.rc.Version,
 		SerializedManifest: string(s.rawManifest),
+		RenderedManifest:   string(s.renderedManifest),
 		WorkloadName:       s.name,
 		WorkloadType:       manifestinfo.StaticSiteType,
 
@@ -125,6 +134,13 @@ func (s *StaticSite) Template() (string, error) {
 		AssetMappingFileBucket: bucket,
 		AssetMappingFilePath:   path,
 		StaticSiteAlias:        s.manifest.HTTP.Alias,
+
+		StaticSiteErrorDocument:   s.manifest.HTTP.ErrorDocument,
+		StaticSiteRedirects:       convertStaticSiteRedirects(s.manifest.HTTP.Redirects),
+		StaticSiteResponseHeaders: responseHeaders,
+		StaticSiteWebACLARN:       aws.StringValue(s.manifest.HTTP.WAF),
+		StaticSiteLogs:            s.accessLogs,
+		StaticSiteCache:           convertStaticSiteCache(s.manifest.HTTP.Cache),
 	})
 	if err != nil {
 		return "", err
@@ -5,6 +5,7 @@ package stack
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"hash/crc32"
 	"sort"
@@ -60,6 +61,16 @@ const (
 	maxPercentDefault         = 200
 )
 
+// Default wait before CodeDeploy terminates the original tasks of a blue/green deployment.
+const defaultBlueGreenTerminationWaitMinutes = 5
+
+// Default values of the FireLens log destinations.
+const (
+	defaultDatadogSite            = "datadoghq.com"
+	defaultSplunkHECPort          = 8088
+	fluentBitJSONParserConfigFile = "/fluent-bit/configs/parse-json.conf"
+)
+
 var (
 	taskDefOverrideRulePrefixes = []string{"Resources", "TaskDefinition", "Properties"}
 	subnetPlacementForTemplate  = map[manifest.PlacementString]string{
@@ -99,6 +110,9 @@ func convertSidecars(s map[string]*manifest.SidecarConfig, exposedPorts map[stri
 		if uri, hasLocation := config.ImageURI(); hasLocation {
 			imageURI = uri
 		}
+		if pinned, ok := rc.PinnedImages[name]; ok {
+			imageURI = pinned
+		}
 		entrypoint, err := convertEntryPoint(config.EntryPoint)
 		if err != nil {
 			return nil, err
@@ -114,7 +128,7 @@ func convertSidecars(s map[string]*manifest.SidecarConfig, exposedPorts map[stri
 			Essential:  config.Essential,
 			CredsParam: config.CredsParam,
 			Secrets:    convertSecrets(config.Secrets),
-			Variables:  convertEnvVars(config.Variables),
+			Variables:  convertEnvVarsWithEnvFile(config.Variables, rc.EnvFileVariables[name]),
 			Storage: template.SidecarStorageOpts{
 				MountPoints: mp,
 			},
@@ -124,6 +138,9 @@ func convertSidecars(s map[string]*manifest.SidecarConfig, exposedPorts map[stri
 			HealthCheck:  convertContainerHealthCheck(config.HealthCheck),
 			Command:      command,
 			PortMappings: convertPortMappings(exposedPorts[name]),
+
+			CPU:               config.CPU,
+			MemoryReservation: config.MemoryReservation,
 		})
 	}
 	return sidecars, nil
@@ -221,6 +238,9 @@ func convertAdvancedCount(a manifest.AdvancedCount) (*template.AdvancedCount, er
 // convertCapacityProviders transforms the manifest fields into a format
 // parsable by the templates pkg.
 func convertCapacityProviders(a manifest.AdvancedCount) []*template.CapacityProviderStrategy {
+	if !a.Capacity.IsEmpty() {
+		return convertCapacityStrategy(a.Capacity)
+	}
 	if a.Spot == nil && a.Range.RangeConfig.SpotFrom == nil {
 		return nil
 	}
@@ -256,6 +276,29 @@ func convertCapacityProviders(a manifest.AdvancedCount) []*template.CapacityProv
 	return cps
 }
 
+// convertCapacityStrategy returns the weighted mix of Fargate and Fargate Spot capacity providers,
+// where the on-demand base is always placed on the Fargate capacity provider.
+func convertCapacityStrategy(c manifest.CapacityStrategy) []*template.CapacityProviderStrategy {
+	spotWeight, onDemandWeight := c.SpotWeight, c.OnDemandWeight
+	if spotWeight == nil && onDemandWeight == nil {
+		spotWeight = aws.Int(1)
+	}
+	cps := []*template.CapacityProviderStrategy{
+		{
+			Weight:           aws.Int(aws.IntValue(spotWeight)),
+			CapacityProvider: capacityProviderFargateSpot,
+		},
+	}
+	if c.OnDemandBase == nil && aws.IntValue(onDemandWeight) == 0 {
+		return cps
+	}
+	return append(cps, &template.CapacityProviderStrategy{
+		Base:             c.OnDemandBase,
+		Weight:           aws.Int(aws.IntValue(onDemandWeight)),
+		CapacityProvider: capacityProviderFargate,
+	})
+}
+
 // convertCooldown converts a service manifest cooldown struct into a format parsable
 // by the templates pkg.
 func convertCooldown(c manifest.Cooldown) template.Cooldown {
@@ -338,11 +381,18 @@ func convertAutoscaling(a manifest.AdvancedCount) (*template.AutoscalingOpts, er
 		responseTime := float64(*a.ResponseTime.ScalingConfig.Value) / float64(time.Second)
 		autoscalingOpts.ResponseTime = aws.Float64(responseTime)
 	}
+	if a.Connections.Value != nil {
+		autoscalingOpts.Connections = aws.Float64(float64(*a.Connections.Value))
+	}
+	if a.Connections.ScalingConfig.Value != nil {
+		autoscalingOpts.Connections = aws.Float64(float64(*a.Connections.ScalingConfig.Value))
+	}
 
 	autoscalingOpts.CPUCooldown = convertScalingCooldown(a.CPU.ScalingConfig.Cooldown, a.Cooldown)
 	autoscalingOpts.MemCooldown = convertScalingCooldown(a.Memory.ScalingConfig.Cooldown, a.Cooldown)
 	autoscalingOpts.ReqCooldown = convertScalingCooldown(a.Requests.ScalingConfig.Cooldown, a.Cooldown)
 	autoscalingOpts.RespTimeCooldown = convertScalingCooldown(a.ResponseTime.ScalingConfig.Cooldown, a.Cooldown)
+	autoscalingOpts.ConnCooldown = convertScalingCooldown(a.Connections.ScalingConfig.Cooldown, a.Cooldown)
 	autoscalingOpts.QueueDelayCooldown = convertScalingCooldown(a.QueueScaling.Cooldown, a.Cooldown)
 
 	if !a.QueueScaling.IsEmpty() {
@@ -354,9 +404,124 @@ func convertAutoscaling(a manifest.AdvancedCount) (*template.AutoscalingOpts, er
 			AcceptableBacklogPerTask: acceptableBacklog,
 		}
 	}
+
+	for _, metric := range a.Metrics {
+		autoscalingOpts.CustomMetrics = append(autoscalingOpts.CustomMetrics, template.CustomMetricScalingOpts{
+			Metric:   convertCloudWatchMetric(metric.CloudWatchMetric),
+			Target:   aws.Float64Value(metric.Target),
+			Cooldown: convertScalingCooldown(metric.Cooldown, a.Cooldown),
+		})
+	}
+	for _, policy := range a.StepScaling {
+		autoscalingOpts.StepScaling = append(autoscalingOpts.StepScaling, convertStepScaling(policy, a.Cooldown))
+	}
+	for idx, schedule := range a.Schedules {
+		// One-time "at(...)" expressions are only supported by Application Auto Scaling, so they are passed through as-is.
+		expression := aws.StringValue(schedule.Schedule)
+		if !strings.HasPrefix(expression, "at(") {
+			if expression, err = toAWSSchedule(expression); err != nil {
+				return nil, fmt.Errorf("convert schedule %d: %w", idx, err)
+			}
+		}
+		name := aws.StringValue(schedule.Name)
+		if name == "" {
+			name = fmt.Sprintf("schedule-%d", idx+1)
+		}
+		autoscalingOpts.Schedules = append(autoscalingOpts.Schedules, template.ScheduledScalingOpts{
+			Name:        name,
+			Expression:  expression,
+			Timezone:    schedule.Timezone,
+			MinCapacity: schedule.Min,
+			MaxCapacity: schedule.Max,
+		})
+	}
+	autoscalingOpts.ScaleToZero = a.CanScaleToZero()
 	return &autoscalingOpts, nil
 }
 
+func convertCloudWatchMetric(m manifest.CloudWatchMetric) template.CloudWatchMetricOpts {
+	statistic := aws.StringValue(m.Statistic)
+	if statistic == "" {
+		statistic = "Average"
+	}
+	return template.CloudWatchMetricOpts{
+		Namespace:  aws.StringValue(m.Namespace),
+		Name:       aws.StringValue(m.Name),
+		Dimensions: m.Dimensions,
+		Statistic:  statistic,
+	}
+}
+
+// convertStepScaling converts a step scaling policy of the manifest into a scale-out and a scale-in policy.
+// The alarm of each policy breaches at the innermost bound of its steps, and the bounds of the steps
+// are converted to be relative to that threshold as expected by Application Auto Scaling.
+func convertStepScaling(s manifest.StepScaling, genCooldown manifest.Cooldown) template.StepScalingOpts {
+	period, evaluationPeriods := 60, 1
+	if s.Period != nil {
+		period = int(s.Period.Seconds())
+	}
+	if s.EvaluationPeriods != nil {
+		evaluationPeriods = aws.IntValue(s.EvaluationPeriods)
+	}
+	opts := template.StepScalingOpts{
+		Metric:            convertCloudWatchMetric(s.CloudWatchMetric),
+		PeriodSeconds:     period,
+		EvaluationPeriods: evaluationPeriods,
+		Cooldown:          convertScalingCooldown(s.Cooldown, genCooldown),
+	}
+	relativeTo := func(bound *float64, threshold float64) *float64 {
+		if bound == nil {
+			return nil
+		}
+		return aws.Float64(aws.Float64Value(bound) - threshold)
+	}
+	if out := s.ScaleOutSteps(); len(out) > 0 {
+		threshold := aws.Float64Value(out[0].Above)
+		policy := &template.StepScalingPolicyOpts{Threshold: threshold}
+		for _, step := range out {
+			policy.Steps = append(policy.Steps, template.StepAdjustmentOpts{
+				LowerBound: relativeTo(step.Above, threshold),
+				UpperBound: relativeTo(step.Below, threshold),
+				Change:     aws.IntValue(step.Change),
+			})
+		}
+		opts.ScaleOut = policy
+	}
+	if in := s.ScaleInSteps(); len(in) > 0 {
+		threshold := aws.Float64Value(in[0].Below)
+		policy := &template.StepScalingPolicyOpts{Threshold: threshold}
+		for _, step := range in {
+			policy.Steps = append(policy.Steps, template.StepAdjustmentOpts{
+				LowerBound: relativeTo(step.Above, threshold),
+				UpperBound: relativeTo(step.Below, threshold),
+				Change:     aws.IntValue(step.Change),
+			})
+		}
+		opts.ScaleIn = policy
+	}
+	return opts
+}
+
+// convertAPIGateway converts the API Gateway configuration of a Backend Service into a format parsable by the templates pkg.
+func convertAPIGateway(a manifest.APIGateway) *template.APIGatewayOpts {
+	if a.IsEmpty() {
+		return nil
+	}
+	opts := &template.APIGatewayOpts{
+		IsWebSocket:     a.IsWebSocket(),
+		Routes:          a.Routes,
+		ThrottlingRate:  a.Throttling.Rate,
+		ThrottlingBurst: a.Throttling.Burst,
+	}
+	if !a.Authorizer.JWT.IsEmpty() {
+		opts.JWTAuthorizer = &template.JWTAuthorizerOpts{
+			Issuer:   aws.StringValue(a.Authorizer.JWT.Issuer),
+			Audience: a.Authorizer.JWT.Audience,
+		}
+	}
+	return opts
+}
+
 // convertHTTPHealthCheck converts the ALB health check configuration into a format parsable by the templates pkg.
 func convertHTTPHealthCheck(hc *manifest.HealthCheckArgsOrString) template.HTTPHealthCheckOpts {
 	opts := template.HTTPHealthCheckOpts{
@@ -393,6 +558,17 @@ func convertHTTPHealthCheck(hc *manifest.HealthCheckArgsOrString) template.HTTPH
 	return opts
 }
 
+// convertGRPCHealthCheck replaces the HTTP defaults of the ALB health check, which always fail against a gRPC server, with the gRPC defaults.
+func convertGRPCHealthCheck(opts template.HTTPHealthCheckOpts) template.HTTPHealthCheckOpts {
+	if opts.HealthCheckPath == manifest.DefaultHealthCheckPath {
+		opts.HealthCheckPath = manifest.DefaultGRPCHealthCheckPath
+	}
+	if opts.SuccessCodes == "" {
+		opts.SuccessCodes = manifest.DefaultGRPCHealthCheckSuccessCodes
+	}
+	return opts
+}
+
 // convertNLBHealthCheck converts the NLB health check configuration into a format parsable by the templates pkg.
 func convertNLBHealthCheck(nlbHC *manifest.NLBHealthCheckArgs) template.NLBHealthCheck {
 	hc := template.NLBHealthCheck{
@@ -436,6 +612,67 @@ func convertELBAccessLogsConfig(mft *manifest.Environment) *template.ELBAccessLo
 	return &template.ELBAccessLogs{
 		BucketName: aws.StringValue(elbAccessLogsArgs.BucketName),
 		Prefix:     aws.StringValue(elbAccessLogsArgs.Prefix),
+		Retention:  elbAccessLogsArgs.Retention,
+	}
+}
+
+// convertTrustStore converts the trust store of the public load balancer into a format parsable by the templates pkg.
+func convertTrustStore(mft *manifest.Environment) (*template.TrustStore, error) {
+	trustStore := mft.HTTPConfig.Public.TrustStore
+	if trustStore.IsEmpty() {
+		return nil, nil
+	}
+	bucket, key, err := s3.ParseURL(aws.StringValue(trustStore.CABundle))
+	if err != nil {
+		return nil, fmt.Errorf("parse CA bundle of the trust store: %w", err)
+	}
+	return &template.TrustStore{
+		CABundleBucket:                bucket,
+		CABundleKey:                   key,
+		IgnoreClientCertificateExpiry: aws.BoolValue(trustStore.IgnoreClientCertificateExpiry),
+	}, nil
+}
+
+// convertManagedCertificates converts the certificates that the environment requests for its public load balancer.
+func convertManagedCertificates(mft *manifest.Environment) []template.ManagedCertificate {
+	if mft == nil {
+		return nil
+	}
+	var certs []template.ManagedCertificate
+	for _, cert := range mft.HTTPConfig.Public.ManagedCertificates {
+		certs = append(certs, template.ManagedCertificate{
+			Domain:           aws.StringValue(cert.Domain),
+			HostedZoneID:     aws.StringValue(cert.HostedZone),
+			AlternativeNames: cert.AlternativeNames,
+		})
+	}
+	return certs
+}
+
+// convertWAF converts the web ACL of a load balancer or App Runner service into a format parsable by the templates pkg.
+func convertWAF(waf manifest.WAF) *template.WAF {
+	if waf.IsZero() {
+		return nil
+	}
+	if arn := waf.WebACLARN(); arn != "" {
+		return &template.WAF{
+			WebACLARN: arn,
+		}
+	}
+	rules := make([]template.WAFManagedRule, len(waf.Advanced.ManagedRules))
+	for i, rule := range waf.Advanced.ManagedRules {
+		vendor := "AWS"
+		if rule.Vendor != nil {
+			vendor = aws.StringValue(rule.Vendor)
+		}
+		rules[i] = template.WAFManagedRule{
+			Vendor:         vendor,
+			Name:           aws.StringValue(rule.Name),
+			ScopeDownPaths: rule.Paths,
+		}
+	}
+	return &template.WAF{
+		ManagedRules: rules,
 	}
 }
 
@@ -451,10 +688,46 @@ func convertFlowLogsConfig(mft *manifest.Environment) (*template.VPCFlowLogs, er
 	}
 	return &template.VPCFlowLogs{
 		Retention: retentionInDays,
+		S3:        aws.StringValue(vpcFlowLogs.Advanced.Destination) == manifest.FlowLogsDestinationS3,
 	}, nil
 
 }
 
+// convertNATConfig converts the NAT topology of a managed VPC into a format parsable by the templates pkg.
+func convertNATConfig(nat manifest.NATConfig) template.NAT {
+	var out template.NAT
+	switch aws.StringValue(nat.Strategy) {
+	case manifest.NATStrategySingle:
+		out.Single = true
+	case manifest.NATStrategyNone:
+		out.None = true
+		return out
+	}
+	if nat.InstanceType == nil {
+		return out
+	}
+	instanceType := aws.StringValue(nat.InstanceType)
+	arch := manifest.ArchX86
+	if gravitonInstanceType.MatchString(instanceType) {
+		arch = manifest.ArchARM64
+	}
+	out.Instance = &template.NATInstance{
+		InstanceType: instanceType,
+		ImageID:      fmt.Sprintf("{{resolve:ssm:%s}}", natInstanceAMIParameters[arch]),
+	}
+	return out
+}
+
+// convertStaticSiteLogsConfig converts the CloudFront access logs configuration of static sites into a format parsable by the templates pkg.
+func convertStaticSiteLogsConfig(mft *manifest.Environment) *template.StaticSiteLogs {
+	if !mft.StaticSiteLogsEnabled() {
+		return nil
+	}
+	return &template.StaticSiteLogs{
+		Retention: mft.Observability.StaticSiteLogs.Advanced.Retention,
+	}
+}
+
 func convertEnvSecurityGroupCfg(mft *manifest.Environment) (*template.SecurityGroupConfig, error) {
 	securityGroupConfig, isSecurityConfigSet := mft.EnvSecurityGroup()
 	if !isSecurityConfigSet {
@@ -517,8 +790,10 @@ func (s *LoadBalancedWebService) convertALBListener() (*template.ALBListener, er
 	}
 	return &template.ALBListener{
 		Rules:             rules,
+		StaticRules:       convertStaticRules(rrConfig.StaticRules, rules),
 		IsHTTPS:           s.httpsEnabled,
 		HostedZoneAliases: aliasesFor,
+		ClientAuth:        aws.StringValue(rrConfig.TLS.ClientAuth),
 	}, nil
 }
 
@@ -539,21 +814,67 @@ func (s *BackendService) convertALBListener() (*template.ALBListener, error) {
 		if err != nil {
 			return nil, err
 		}
+		if !s.httpsEnabled && !s.privateHostedZone.IsEmpty() {
+			// The aliases resolved in the private hosted zone are matched by the rules of the HTTP listener.
+			if rule.Aliases, err = convertAlias(routingRule.Alias); err != nil {
+				return nil, err
+			}
+		}
 		rules = append(rules, *rule)
 		hostedZoneAliases, err = convertHostedZone(rrConfig.Main.Alias, rrConfig.Main.HostedZone)
 		if err != nil {
 			return nil, err
 		}
 	}
+	privateAliases, err := convertPrivateHostedZoneAliases(rrConfig.RoutingRules(), s.privateHostedZone)
+	if err != nil {
+		return nil, err
+	}
 
 	return &template.ALBListener{
-		Rules:             rules,
-		IsHTTPS:           s.httpsEnabled,
-		MainContainerPort: s.manifest.MainContainerPort(),
-		HostedZoneAliases: hostedZoneAliases,
+		Rules:                    rules,
+		StaticRules:              convertStaticRules(rrConfig.StaticRules, rules),
+		IsHTTPS:                  s.httpsEnabled,
+		MainContainerPort:        s.manifest.MainContainerPort(),
+		HostedZoneAliases:        hostedZoneAliases,
+		PrivateHostedZoneAliases: privateAliases,
 	}, nil
 }
 
+// convertPrivateHostedZoneAliases returns the aliases of the routing rules that are resolved in the private hosted zone
+// of the environment. Aliases with a hosted zone of their own, or of their rule, are left out.
+func convertPrivateHostedZoneAliases(rules []manifest.RoutingRule, zone manifest.PrivateHostedZoneConfig) ([]string, error) {
+	if zone.IsEmpty() {
+		return nil, nil
+	}
+	var candidates []string
+	for _, rule := range rules {
+		if rule.HostedZone != nil {
+			continue
+		}
+		if len(rule.Alias.AdvancedAliases) != 0 {
+			for _, alias := range rule.Alias.AdvancedAliases {
+				if alias.HostedZone == nil {
+					candidates = append(candidates, aws.StringValue(alias.Alias))
+				}
+			}
+			continue
+		}
+		aliases, err := rule.Alias.ToStringSlice()
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, aliases...)
+	}
+	var out []string
+	for _, alias := range candidates {
+		if zone.Contains(alias) && !isDuplicateAliasEntry(out, alias) {
+			out = append(out, alias)
+		}
+	}
+	return out, nil
+}
+
 func (s *BackendService) convertGracePeriod() *int64 {
 	if s.manifest.HTTP.Main.HealthCheck.Advanced.GracePeriod != nil {
 		return aws.Int64(int64(s.manifest.HTTP.Main.HealthCheck.Advanced.GracePeriod.Seconds()))
@@ -610,21 +931,141 @@ func (conv routingRuleConfigConverter) convert() (*template.ALBListenerRule, err
 		}
 	}
 
+	healthCheck := convertHTTPHealthCheck(&conv.rule.HealthCheck)
+	if conv.rule.IsGRPC() {
+		healthCheck = convertGRPCHealthCheck(healthCheck)
+	}
+
 	config := &template.ALBListenerRule{
 		Path:                convertPath(aws.StringValue(conv.rule.Path)),
 		TargetContainer:     targetContainer,
 		TargetPort:          targetPort,
 		Aliases:             aliases,
-		HTTPHealthCheck:     convertHTTPHealthCheck(&conv.rule.HealthCheck),
+		HTTPHealthCheck:     healthCheck,
 		AllowedSourceIps:    convertAllowedSourceIPs(conv.rule.AllowedSourceIps),
 		Stickiness:          strconv.FormatBool(aws.BoolValue(conv.rule.Stickiness)),
 		HTTPVersion:         aws.StringValue(convertHTTPVersion(conv.rule.ProtocolVersion)),
 		RedirectToHTTPS:     conv.redirectToHTTPS,
 		DeregistrationDelay: convertDeregistrationDelay(conv.rule.DeregistrationDelay),
+		Hosts:               conv.rule.Hosts,
+		Weight:              aws.IntValue(conv.rule.Weight),
+	}
+	for _, target := range conv.rule.WeightedTargets {
+		rule := target.RoutingRule()
+		container, port, err := rule.Target(exposedPorts)
+		if err != nil {
+			return nil, err
+		}
+		config.WeightedTargets = append(config.WeightedTargets, template.ALBTarget{
+			TargetContainer: container,
+			TargetPort:      port,
+			Weight:          aws.IntValue(target.Weight),
+		})
+	}
+	if !conv.rule.Auth.IsEmpty() {
+		if !conv.httpsEnabled {
+			return nil, fmt.Errorf(`"auth" for path %q requires an HTTPS listener: specify a domain for the application or import certificates in the environment`, config.Path)
+		}
+		config.Auth = convertHTTPAuth(conv.rule.Auth)
 	}
 	return config, nil
 }
 
+// convertHTTPAuth converts the authentication settings of a routing rule.
+// The client secret of an OIDC provider is read from Secrets Manager with a dynamic reference.
+func convertHTTPAuth(in manifest.HTTPAuth) *template.ALBAuth {
+	auth := &template.ALBAuth{
+		Scope:             aws.StringValue(in.Scope),
+		SessionCookieName: aws.StringValue(in.SessionCookie),
+	}
+	if in.SessionTimeout != nil {
+		auth.SessionTimeout = strconv.FormatInt(int64(in.SessionTimeout.Seconds()), 10)
+	}
+	if !in.OIDC.IsEmpty() {
+		auth.OIDC = &template.ALBOIDCAuth{
+			Issuer:                aws.StringValue(in.OIDC.Issuer),
+			AuthorizationEndpoint: aws.StringValue(in.OIDC.AuthorizationEndpoint),
+			TokenEndpoint:         aws.StringValue(in.OIDC.TokenEndpoint),
+			UserInfoEndpoint:      aws.StringValue(in.OIDC.UserInfoEndpoint),
+			ClientID:              aws.StringValue(in.OIDC.ClientID),
+			ClientSecret:          fmt.Sprintf("{{resolve:secretsmanager:%s:SecretString}}", aws.StringValue(in.OIDC.ClientSecret)),
+		}
+		return auth
+	}
+	auth.Cognito = &template.ALBCognitoAuth{
+		UserPoolARN: aws.StringValue(in.Cognito.UserPool),
+		ClientID:    aws.StringValue(in.Cognito.ClientID),
+		Domain:      aws.StringValue(in.Cognito.Domain),
+	}
+	return auth
+}
+
+// convertStaticRules converts the static rules of the manifest.
+// Static rules without hosts match the aliases of the main routing rule.
+func convertStaticRules(in []manifest.StaticRule, routingRules []template.ALBListenerRule) []template.ALBStaticRule {
+	var defaultHosts []string
+	if len(routingRules) != 0 {
+		defaultHosts = routingRules[0].Aliases
+	}
+	var out []template.ALBStaticRule
+	for _, rule := range in {
+		static := template.ALBStaticRule{
+			Path:  convertPath(aws.StringValue(rule.Path)),
+			Hosts: rule.Hosts,
+		}
+		if len(static.Hosts) == 0 {
+			static.Hosts = defaultHosts
+		}
+		if !rule.Redirect.IsEmpty() {
+			static.Redirect = convertHTTPRedirect(rule.Redirect)
+		} else {
+			static.FixedResponse = &template.ALBFixedResponse{
+				StatusCode:  strconv.Itoa(aws.IntValue(rule.FixedResponse.StatusCode)),
+				ContentType: aws.StringValue(rule.FixedResponse.ContentType),
+				Body:        aws.StringValue(rule.FixedResponse.Body),
+			}
+		}
+		out = append(out, static)
+	}
+	return out
+}
+
+// convertHTTPRedirect keeps the parts of the original URL that are not overridden with their "#{...}" placeholder.
+// The port follows the protocol if only the protocol is changed.
+func convertHTTPRedirect(in manifest.HTTPRedirect) *template.ALBRedirect {
+	out := &template.ALBRedirect{
+		Protocol:   "#{protocol}",
+		Host:       "#{host}",
+		Port:       "#{port}",
+		Path:       "/#{path}",
+		Query:      "#{query}",
+		StatusCode: "HTTP_301",
+	}
+	if in.Protocol != nil {
+		out.Protocol = strings.ToUpper(aws.StringValue(in.Protocol))
+		out.Port = "80"
+		if out.Protocol == "HTTPS" {
+			out.Port = "443"
+		}
+	}
+	if in.Port != nil {
+		out.Port = strconv.Itoa(int(aws.Uint16Value(in.Port)))
+	}
+	if in.Host != nil {
+		out.Host = aws.StringValue(in.Host)
+	}
+	if in.Path != nil {
+		out.Path = aws.StringValue(in.Path)
+	}
+	if in.Query != nil {
+		out.Query = aws.StringValue(in.Query)
+	}
+	if in.StatusCode != nil {
+		out.StatusCode = fmt.Sprintf("HTTP_%d", aws.IntValue(in.StatusCode))
+	}
+	return out
+}
+
 func convertDeregistrationDelay(delay *time.Duration) *int64 {
 	if delay == nil {
 		return aws.Int64(int64(manifest.DefaultDeregistrationDelay))
@@ -734,22 +1175,190 @@ func convertAllowedSourceIPs(allowedSourceIPs []manifest.IPNet) []string {
 func convertServiceConnect(s manifest.ServiceConnectBoolOrArgs) *template.ServiceConnect {
 	return &template.ServiceConnect{
 		Alias: s.ServiceConnectArgs.Alias,
+		TLS:   aws.BoolValue(s.ServiceConnectArgs.TLS),
+	}
+}
+
+// convertServiceConnectPorts converts the additional Service Connect endpoints of a service into a format parsable by the templates pkg.
+func convertServiceConnectPorts(s manifest.ServiceConnectArgs, exposedPorts manifest.ExposedPortsIndex) []template.ServiceConnectPort {
+	var ports []template.ServiceConnectPort
+	for _, port := range s.Ports {
+		alias := aws.StringValue(s.Alias)
+		if port.Alias != nil {
+			alias = aws.StringValue(port.Alias)
+		}
+		ports = append(ports, template.ServiceConnectPort{
+			Name:          fmt.Sprintf("port-%d", aws.Uint16Value(port.Port)),
+			ContainerName: exposedPorts.ContainerForPort[aws.Uint16Value(port.Port)],
+			Port:          aws.Uint16Value(port.Port),
+			Alias:         alias,
+			AppProtocol:   aws.StringValue(port.AppProtocol),
+		})
+	}
+	return ports
+}
+
+// serviceConnectHealthChecks returns the health check command of each container that listens on
+// additional Service Connect ports with a health check. The ports of a container are all checked by its command.
+func serviceConnectHealthChecks(s manifest.ServiceConnectArgs, exposedPorts manifest.ExposedPortsIndex) map[string]string {
+	checks := make(map[string][]string)
+	for _, port := range s.Ports {
+		cmd := port.HealthCheckCommand()
+		if cmd == "" {
+			continue
+		}
+		container := exposedPorts.ContainerForPort[aws.Uint16Value(port.Port)]
+		checks[container] = append(checks[container], cmd)
+	}
+	if len(checks) == 0 {
+		return nil
+	}
+	cmds := make(map[string]string, len(checks))
+	for container, checks := range checks {
+		cmds[container] = strings.Join(checks, " && ") + " || exit 1"
+	}
+	return cmds
+}
+
+// withHealthCheckCommand returns the health check of a container with its command replaced by cmd, if cmd is set.
+func withHealthCheckCommand(hc manifest.ContainerHealthCheck, cmd string) manifest.ContainerHealthCheck {
+	if cmd == "" {
+		return hc
+	}
+	hc.Command = []string{"CMD-SHELL", cmd}
+	return hc
+}
+
+func convertObservability(o manifest.Observability) template.ObservabilityOpts {
+	return template.ObservabilityOpts{
+		Tracing:   strings.ToUpper(aws.StringValue(o.Tracing)),
+		Collector: convertOTelCollector(o.Collector),
 	}
 }
 
-func convertLogging(lc manifest.Logging) *template.LogConfigOpts {
+func convertOTelCollector(c manifest.OTelCollectorConfig) *template.OTelCollectorOpts {
+	if c.IsEmpty() {
+		return nil
+	}
+	opts := &template.OTelCollectorOpts{
+		Image:        aws.StringValue(c.Image),
+		Traces:       strings.ToUpper(aws.StringValue(c.Traces)),
+		Metrics:      strings.ToUpper(aws.StringValue(c.Metrics)),
+		OTLPEndpoint: aws.StringValue(c.OTLPEndpoint),
+		Config:       aws.StringValue(c.Config),
+	}
+	if opts.Config == "" && opts.Traces == "" && opts.Metrics == "" {
+		// Export both signals to AWS by default.
+		opts.Traces = "AWSXRAY"
+		opts.Metrics = "CLOUDWATCH"
+	}
+	return opts
+}
+
+func convertLogging(lc manifest.Logging, region string) *template.LogConfigOpts {
 	if lc.IsEmpty() {
 		return nil
 	}
-	return &template.LogConfigOpts{
+	opts := &template.LogConfigOpts{
 		Image:          lc.LogImage(),
 		ConfigFile:     lc.ConfigFile,
 		EnableMetadata: lc.GetEnableMetadata(),
-		Destination:    lc.Destination,
+		Destination:    lc.Destination.Basic,
 		SecretOptions:  convertSecrets(lc.SecretOptions),
 		Variables:      convertEnvVars(lc.Variables),
 		Secrets:        convertSecrets(lc.Secrets),
 	}
+	if aws.StringValue(lc.Parser) == manifest.LogParserJSON {
+		opts.ConfigFile = aws.String(fluentBitJSONParserConfigFile)
+	}
+	if lc.Destination.IsAdvanced() {
+		convertLogDestination(opts, lc.Destination.Advanced, region)
+	}
+	return opts
+}
+
+// convertLogDestination fills the FireLens output options of the destination preset in opts.
+func convertLogDestination(opts *template.LogConfigOpts, dst manifest.LogDestinationConfig, region string) {
+	secretOpts := make(map[string]manifest.Secret)
+	switch {
+	case !dst.Datadog.IsEmpty():
+		dd := dst.Datadog
+		site := defaultDatadogSite
+		if dd.Site != nil {
+			site = aws.StringValue(dd.Site)
+		}
+		opts.Destination = map[string]string{
+			"Name":     "datadog",
+			"Host":     fmt.Sprintf("http-intake.logs.%s", site),
+			"TLS":      "on",
+			"provider": "ecs",
+		}
+		setLogOption(opts.Destination, "dd_service", dd.Service)
+		setLogOption(opts.Destination, "dd_source", dd.Source)
+		setLogOption(opts.Destination, "dd_tags", dd.Tags)
+		secretOpts["apikey"] = *dd.APIKey
+	case !dst.Splunk.IsEmpty():
+		port := uint16(defaultSplunkHECPort)
+		if dst.Splunk.Port != nil {
+			port = aws.Uint16Value(dst.Splunk.Port)
+		}
+		opts.Destination = map[string]string{
+			"Name": "splunk",
+			"Host": aws.StringValue(dst.Splunk.Host),
+			"Port": strconv.Itoa(int(port)),
+			"TLS":  "On",
+		}
+		secretOpts["Splunk_Token"] = *dst.Splunk.Token
+	case !dst.OpenSearch.IsEmpty():
+		domainRegion := region
+		if dst.OpenSearch.Region != nil {
+			domainRegion = aws.StringValue(dst.OpenSearch.Region)
+		}
+		opts.Destination = map[string]string{
+			"Name":               "opensearch",
+			"Host":               aws.StringValue(dst.OpenSearch.Host),
+			"Port":               "443",
+			"Index":              aws.StringValue(dst.OpenSearch.Index),
+			"tls":                "On",
+			"AWS_Auth":           "On",
+			"AWS_Region":         domainRegion,
+			"Suppress_Type_Name": "On",
+		}
+		// The domain name can't be derived from the endpoint, so we grant access to all the domains of the region.
+		opts.OpenSearchDomain = &template.LogDestinationResource{
+			Name:   "*",
+			Region: domainRegion,
+		}
+	case !dst.Firehose.IsEmpty():
+		streamRegion := region
+		if dst.Firehose.Region != nil {
+			streamRegion = aws.StringValue(dst.Firehose.Region)
+		}
+		opts.Destination = map[string]string{
+			"Name":            "kinesis_firehose",
+			"region":          streamRegion,
+			"delivery_stream": aws.StringValue(dst.Firehose.DeliveryStream),
+		}
+		opts.FirehoseDeliveryStream = &template.LogDestinationResource{
+			Name:   aws.StringValue(dst.Firehose.DeliveryStream),
+			Region: streamRegion,
+		}
+	}
+	if len(secretOpts) == 0 {
+		return
+	}
+	generated := convertSecrets(secretOpts)
+	// Secret options in the manifest take precedence over the generated ones.
+	for name, secret := range opts.SecretOptions {
+		generated[name] = secret
+	}
+	opts.SecretOptions = generated
+}
+
+func setLogOption(options map[string]string, key string, value *string) {
+	if value != nil {
+		options[key] = aws.StringValue(value)
+	}
 }
 
 func convertTaskDefOverrideRules(inRules []manifest.OverrideRule) []override.Rule {
@@ -990,6 +1599,64 @@ func convertNetworkConfig(network manifest.NetworkConfig) template.NetworkOpts {
 	return opts
 }
 
+// convertIngressRules converts the inbound rules of the security group that is created for the tasks of a service.
+func convertIngressRules(rules []manifest.SecurityGroupIngressRule) ([]template.IngressRule, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	out := make([]template.IngressRule, len(rules))
+	for idx, rule := range rules {
+		from, to, err := rule.GetPorts()
+		if err != nil {
+			return nil, fmt.Errorf(`parse "ports" of "ingress[%d]": %w`, idx, err)
+		}
+		out[idx] = template.IngressRule{
+			CIDR:       aws.StringValue(rule.CIDR),
+			IPProtocol: rule.Protocol(),
+			FromPort:   from,
+			ToPort:     to,
+		}
+		switch src := rule.SourceSecurityGroup; {
+		case src.Plain != nil:
+			out[idx].SourceSecurityGroup = template.PlainSecurityGroup(aws.StringValue(src.Plain))
+		case src.FromCFN.Name != nil:
+			out[idx].SourceSecurityGroup = template.ImportedSecurityGroup(aws.StringValue(src.FromCFN.Name))
+		}
+	}
+	return out, nil
+}
+
+// validateIngressWithNLB returns an error if an ingress rule allows traffic that Copilot already allows
+// from the public subnets to the target ports of the network load balancer.
+func validateIngressWithNLB(rules []template.IngressRule, nlb *template.NetworkLoadBalancer) error {
+	if nlb == nil {
+		return nil
+	}
+	publicSubnetCIDRs := make(map[string]bool, len(nlb.PublicSubnetCIDRs))
+	for _, cidr := range nlb.PublicSubnetCIDRs {
+		publicSubnetCIDRs[cidr] = true
+	}
+	for idx, rule := range rules {
+		if !publicSubnetCIDRs[rule.CIDR] {
+			continue
+		}
+		for _, listener := range nlb.Listener {
+			protocol := listener.Protocol
+			if protocol == "TLS" {
+				protocol = "TCP"
+			}
+			port, err := strconv.Atoi(listener.TargetPort)
+			if err != nil {
+				return fmt.Errorf("parse target port %q of the network load balancer: %w", listener.TargetPort, err)
+			}
+			if strings.EqualFold(protocol, rule.IPProtocol) && rule.FromPort <= port && port <= rule.ToPort {
+				return fmt.Errorf(`"ingress[%d]" overlaps with the rule that allows traffic from the network load balancer on port %d`, idx, port)
+			}
+		}
+	}
+	return nil
+}
+
 func convertRDWSNetworkConfig(network manifest.RequestDrivenWebServiceNetworkConfig) template.NetworkOpts {
 	opts := template.NetworkOpts{}
 	if network.IsEmpty() {
@@ -1007,6 +1674,17 @@ func convertRDWSNetworkConfig(network manifest.RequestDrivenWebServiceNetworkCon
 	return opts
 }
 
+func convertAppRunnerAutoScaling(count manifest.Union[*string, manifest.AppRunnerAutoScaling]) *template.AppRunnerAutoScalingOpts {
+	if !count.IsAdvanced() {
+		return nil
+	}
+	return &template.AppRunnerAutoScalingOpts{
+		MaxConcurrency: count.Advanced.MaxConcurrency,
+		MinSize:        count.Advanced.MinInstances,
+		MaxSize:        count.Advanced.MaxInstances,
+	}
+}
+
 func convertAlias(alias manifest.Alias) ([]string, error) {
 	out, err := alias.ToStringSlice()
 	if err != nil {
@@ -1037,25 +1715,140 @@ func convertDeploymentControllerConfig(in manifest.DeploymentControllerConfig) t
 
 func convertDeploymentConfig(in manifest.DeploymentConfig) template.DeploymentConfigurationOpts {
 	out := convertDeploymentControllerConfig(in.DeploymentControllerConfig)
-	out.Rollback = template.RollingUpdateRollbackConfig{
-		AlarmNames:        in.RollbackAlarms.Basic,
-		CPUUtilization:    in.RollbackAlarms.Advanced.CPUUtilization,
-		MemoryUtilization: in.RollbackAlarms.Advanced.MemoryUtilization,
+	out.Rollback = convertRollbackAlarmNames(in.RollbackAlarms.Basic)
+	out.Rollback.CPUUtilization = in.RollbackAlarms.Advanced.CPUUtilization
+	out.Rollback.MemoryUtilization = in.RollbackAlarms.Advanced.MemoryUtilization
+	if in.IsBlueGreen() {
+		out.BlueGreen = convertBlueGreenDeploymentConfig(in.BlueGreen)
+	}
+	if in.IsTrafficShifting() {
+		out.BlueGreen.TrafficRouting = convertTrafficShiftingConfig(aws.StringValue(in.Rolling), in.TrafficShifting)
+	}
+	out.PreDeploy = convertDeploymentHook(in.PreDeploy)
+	out.PostDeploy = convertDeploymentHook(in.PostDeploy)
+	return out
+}
+
+func convertDeploymentHook(in manifest.DeploymentHook) *template.DeploymentHookOpts {
+	if in.IsEmpty() {
+		return nil
+	}
+	// The command is already converted to a string slice successfully when the manifest is validated.
+	command, _ := in.Command.ToStringSlice()
+	return &template.DeploymentHookOpts{
+		Command: command,
+	}
+}
+
+func convertTrafficShiftingConfig(strategy string, in manifest.TrafficShiftingConfig) *template.TrafficRoutingOpts {
+	percent, interval := in.PercentAndInterval(strategy)
+	out := &template.TrafficRoutingOpts{
+		Type:            template.TimeBasedLinearTrafficRouting,
+		Percent:         percent,
+		IntervalMinutes: int(interval.Minutes()),
+	}
+	if strings.EqualFold(strategy, manifest.ECSCanaryRollingUpdateStrategy) {
+		out.Type = template.TimeBasedCanaryTrafficRouting
+	}
+	return out
+}
+
+func convertBlueGreenDeploymentConfig(in manifest.BlueGreenDeploymentConfig) *template.BlueGreenDeploymentOpts {
+	out := &template.BlueGreenDeploymentOpts{
+		TestListenerPort:       in.TestListenerPort,
+		TerminationWaitMinutes: defaultBlueGreenTerminationWaitMinutes,
+	}
+	if in.TerminationWait != nil {
+		out.TerminationWaitMinutes = int(in.TerminationWait.Minutes())
+	}
+	hooks := []struct {
+		event    string
+		function *string
+	}{
+		{"BeforeInstall", in.Hooks.BeforeInstall},
+		{"AfterInstall", in.Hooks.AfterInstall},
+		{"AfterAllowTestTraffic", in.Hooks.AfterAllowTestTraffic},
+		{"BeforeAllowTraffic", in.Hooks.BeforeAllowTraffic},
+		{"AfterAllowTraffic", in.Hooks.AfterAllowTraffic},
+	}
+	for _, hook := range hooks {
+		if hook.function == nil {
+			continue
+		}
+		out.Hooks = append(out.Hooks, template.BlueGreenHook{
+			Event:    hook.event,
+			Function: aws.StringValue(hook.function),
+		})
 	}
 	return out
 }
 
 func convertWorkerDeploymentConfig(in manifest.WorkerDeploymentConfig) template.DeploymentConfigurationOpts {
 	out := convertDeploymentControllerConfig(in.DeploymentControllerConfig)
-	out.Rollback = template.RollingUpdateRollbackConfig{
-		AlarmNames:        in.WorkerRollbackAlarms.Basic,
-		CPUUtilization:    in.WorkerRollbackAlarms.Advanced.CPUUtilization,
-		MemoryUtilization: in.WorkerRollbackAlarms.Advanced.MemoryUtilization,
-		MessagesDelayed:   in.WorkerRollbackAlarms.Advanced.MessagesDelayed,
+	out.Rollback = convertRollbackAlarmNames(in.WorkerRollbackAlarms.Basic)
+	out.Rollback.CPUUtilization = in.WorkerRollbackAlarms.Advanced.CPUUtilization
+	out.Rollback.MemoryUtilization = in.WorkerRollbackAlarms.Advanced.MemoryUtilization
+	out.Rollback.MessagesDelayed = in.WorkerRollbackAlarms.Advanced.MessagesDelayed
+	out.PreDeploy = convertDeploymentHook(in.PreDeploy)
+	out.PostDeploy = convertDeploymentHook(in.PostDeploy)
+	return out
+}
+
+// generatedAlarmLogicalIDs maps the rollback alarm shorthands to the alarms rendered from the "alarms" section.
+var generatedAlarmLogicalIDs = map[string]string{
+	manifest.RollbackAlarmCPU:          template.CPUUtilizationAlarmLogicalID,
+	manifest.RollbackAlarmMemory:       template.MemoryUtilizationAlarmLogicalID,
+	manifest.RollbackAlarmHTTP5xx:      template.HTTP5xxRateAlarmLogicalID,
+	manifest.RollbackAlarmResponseTime: template.ResponseTimeAlarmLogicalID,
+	manifest.RollbackAlarmQueueDepth:   template.QueueDepthAlarmLogicalID,
+}
+
+// convertRollbackAlarmNames splits the rollback alarms into existing alarms and alarms generated from the "alarms" section.
+func convertRollbackAlarmNames(names []string) template.RollingUpdateRollbackConfig {
+	var out template.RollingUpdateRollbackConfig
+	for _, name := range names {
+		if !manifest.IsGeneratedRollbackAlarm(name) {
+			out.AlarmNames = append(out.AlarmNames, name)
+			continue
+		}
+		// Unknown shorthands are rejected when the manifest is validated.
+		out.GeneratedAlarms = append(out.GeneratedAlarms, generatedAlarmLogicalIDs[name])
+	}
+	return out
+}
+
+// convertHTTPAlarms converts the alarms of a service behind a load balancer.
+func convertHTTPAlarms(in manifest.HTTPAlarmsConfig) *template.AlarmsOpts {
+	if in.IsEmpty() {
+		return nil
+	}
+	out := convertAlarms(in.AlarmsConfig)
+	out.HTTP5xxRate = in.HTTP5xxRate
+	if in.ResponseTime != nil {
+		out.ResponseTime = aws.Float64(in.ResponseTime.Seconds())
 	}
 	return out
 }
 
+// convertWorkerAlarms converts the alarms of a Worker Service.
+func convertWorkerAlarms(in manifest.WorkerAlarmsConfig) *template.AlarmsOpts {
+	if in.IsEmpty() {
+		return nil
+	}
+	out := convertAlarms(in.AlarmsConfig)
+	out.QueueDepth = in.QueueDepth
+	return out
+}
+
+func convertAlarms(in manifest.AlarmsConfig) *template.AlarmsOpts {
+	return &template.AlarmsOpts{
+		CPUUtilization:    in.CPUUtilization,
+		MemoryUtilization: in.MemoryUtilization,
+		Emails:            in.Notify.Emails,
+		Topics:            in.Notify.Topics,
+	}
+}
+
 func convertCommand(command manifest.CommandOverride) ([]string, error) {
 	out, err := command.ToStringSlice()
 	if err != nil {
@@ -1087,6 +1880,7 @@ func convertPublish(topics []manifest.Topic, accountID, region, app, env, svc st
 		publishers.Topics = append(publishers.Topics, &template.Topic{
 			Name:            topic.Name,
 			FIFOTopicConfig: fifoConfig,
+			KMSKey:          topic.KMSKey,
 			AccountID:       accountID,
 			Partition:       partition.ID(),
 			Region:          region,
@@ -1100,7 +1894,7 @@ func convertPublish(topics []manifest.Topic, accountID, region, app, env, svc st
 }
 
 func convertSubscribe(s *manifest.WorkerService) (*template.SubscribeOpts, error) {
-	if s.Subscribe.Topics == nil {
+	if s.Subscribe.Topics == nil && s.Subscribe.Events == nil {
 		return nil, nil
 	}
 	var subscriptions template.SubscribeOpts
@@ -1111,10 +1905,31 @@ func convertSubscribe(s *manifest.WorkerService) (*template.SubscribeOpts, error
 		}
 		subscriptions.Topics = append(subscriptions.Topics, ts)
 	}
+	events, err := convertEventRules(s.Subscribe.Events)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions.Events = events
 	subscriptions.Queue = convertQueue(s.Subscribe.Queue)
 	return &subscriptions, nil
 }
 
+func convertEventRules(rules []manifest.EventRule) ([]*template.EventRule, error) {
+	var out []*template.EventRule
+	for _, rule := range rules {
+		pattern, err := json.Marshal(rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf(`convert "pattern" of event rule %s to a JSON string: %w`, aws.StringValue(rule.Name), err)
+		}
+		out = append(out, &template.EventRule{
+			Name:    aws.StringValue(rule.Name),
+			Bus:     rule.Bus,
+			Pattern: string(pattern),
+		})
+	}
+	return out, nil
+}
+
 func convertTopicSubscription(t manifest.TopicSubscription) (
 	*template.TopicSubscription, error) {
 	filterPolicy, err := convertFilterPolicy(t.FilterPolicy)
@@ -1123,17 +1938,19 @@ func convertTopicSubscription(t manifest.TopicSubscription) (
 	}
 	if aws.BoolValue(t.Queue.Enabled) {
 		return &template.TopicSubscription{
-			Name:         t.Name,
-			Service:      t.Service,
-			Queue:        &template.SQSQueue{},
-			FilterPolicy: filterPolicy,
+			Name:              t.Name,
+			Service:           t.Service,
+			Queue:             &template.SQSQueue{},
+			FilterPolicy:      filterPolicy,
+			FilterPolicyScope: t.FilterPolicyScope,
 		}, nil
 	}
 	return &template.TopicSubscription{
-		Name:         t.Name,
-		Service:      t.Service,
-		Queue:        convertQueue(t.Queue.Advanced),
-		FilterPolicy: filterPolicy,
+		Name:              t.Name,
+		Service:           t.Service,
+		Queue:             convertQueue(t.Queue.Advanced),
+		FilterPolicy:      filterPolicy,
+		FilterPolicyScope: t.FilterPolicyScope,
 	}, nil
 }
 
@@ -1158,6 +1975,7 @@ func convertQueue(in manifest.SQSQueue) *template.SQSQueue {
 		Delay:      convertDelay(in.Delay),
 		Timeout:    convertTimeout(in.Timeout),
 		DeadLetter: convertDeadLetter(in.DeadLetter),
+		KMSKey:     in.KMSKey,
 	}
 
 	if !in.FIFO.IsEnabled() {
@@ -1207,7 +2025,8 @@ func convertDeadLetter(d manifest.DeadLetterQueue) *template.DeadLetterQueue {
 		return nil
 	}
 	return &template.DeadLetterQueue{
-		Tries: d.Tries,
+		Tries:     d.Tries,
+		Retention: convertRetention(d.Retention),
 	}
 }
 
@@ -1272,27 +2091,97 @@ func convertEnvVars(variables map[string]manifest.Variable) map[string]template.
 	return m
 }
 
+// convertEnvVarsWithEnvFile converts the manifest Variables along with the variables read from an env file
+// into a format parsable by the templates pkg. The manifest Variables take precedence over the ones from the env file.
+func convertEnvVarsWithEnvFile(variables map[string]manifest.Variable, fromEnvFile map[string]string) map[string]template.Variable {
+	m := convertEnvVars(variables)
+	if len(fromEnvFile) == 0 {
+		return m
+	}
+	if m == nil {
+		m = make(map[string]template.Variable, len(fromEnvFile))
+	}
+	for name, value := range fromEnvFile {
+		if _, ok := m[name]; ok {
+			continue
+		}
+		m[name] = template.PlainVariable(value)
+	}
+	return m
+}
+
 // convertSecrets converts the manifest Secrets into a format parsable by the templates pkg.
+// Secrets written to files are injected by the secret files container instead, see convertSecretFiles.
 func convertSecrets(secrets map[string]manifest.Secret) map[string]template.Secret {
-	if len(secrets) == 0 {
-		return nil
-	}
 	m := make(map[string]template.Secret, len(secrets))
-	var tplSecret template.Secret
 	for name, mftSecret := range secrets {
-		switch {
-		case mftSecret.IsSecretsManagerName():
-			tplSecret = template.SecretFromSecretsManager(mftSecret.Value())
-		case mftSecret.RequiresImport():
-			tplSecret = template.SecretFromImportedSSMOrARN(mftSecret.Value())
-		default:
-			tplSecret = template.SecretFromPlainSSMOrARN(mftSecret.Value())
+		if mftSecret.IsFile() {
+			continue
 		}
-		m[name] = tplSecret
+		m[name] = convertSecret(mftSecret)
+	}
+	if len(m) == 0 {
+		return nil
 	}
 	return m
 }
 
+// convertSecretFiles converts the manifest Secrets with "as_file" of the main container and of the sidecars
+// into the options of the container that writes them.
+func convertSecretFiles(secrets map[string]manifest.Secret, sidecars map[string]*manifest.SidecarConfig) *template.SecretFilesOpts {
+	files := secretFiles("", secrets)
+	for name, sidecar := range sidecars {
+		if sidecar != nil {
+			files = append(files, secretFiles(name, sidecar.Secrets)...)
+		}
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].Container != files[j].Container {
+			return files[i].Container < files[j].Container
+		}
+		return files[i].Name < files[j].Name
+	})
+	return &template.SecretFilesOpts{
+		Files: files,
+	}
+}
+
+// secretFiles returns the secrets with "as_file" of the container, where the empty name is the main container.
+// The secrets of a sidecar are suffixed with the name of the sidecar so that they don't clash with the main container's
+// in the secret files container.
+func secretFiles(container string, secrets map[string]manifest.Secret) []template.SecretFile {
+	var files []template.SecretFile
+	for name, mftSecret := range secrets {
+		if !mftSecret.IsFile() {
+			continue
+		}
+		if container != "" {
+			name = fmt.Sprintf("%s_FOR_%s", name, strings.ToUpper(strings.ReplaceAll(container, "-", "_")))
+		}
+		files = append(files, template.SecretFile{
+			Name:      name,
+			Container: container,
+			Path:      mftSecret.FilePath(),
+			Secret:    convertSecret(mftSecret),
+		})
+	}
+	return files
+}
+
+func convertSecret(mftSecret manifest.Secret) template.Secret {
+	switch {
+	case mftSecret.IsSecretsManagerName():
+		return template.SecretFromSecretsManager(mftSecret.Value())
+	case mftSecret.RequiresImport():
+		return template.SecretFromImportedSSMOrARN(mftSecret.Value())
+	default:
+		return template.SecretFromPlainSSMOrARN(mftSecret.Value())
+	}
+}
+
 func convertCustomResources(urlForFunc map[string]string) (map[string]template.S3ObjectLocation, error) {
 	out := make(map[string]template.S3ObjectLocation)
 	for fn, url := range urlForFunc {
@@ -1308,6 +2197,215 @@ func convertCustomResources(urlForFunc map[string]string) (map[string]template.S
 	return out, nil
 }
 
+// Status descriptions of the redirects of a static site.
+var staticSiteRedirectStatusDescriptions = map[int]string{
+	301: "Moved Permanently",
+	302: "Found",
+	307: "Temporary Redirect",
+	308: "Permanent Redirect",
+}
+
+const defaultStaticSiteRedirectStatusCode = 301
+
+func convertStaticSiteRedirects(in []manifest.StaticSiteRedirect) []template.StaticSiteRedirect {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]template.StaticSiteRedirect, len(in))
+	for i, r := range in {
+		code := defaultStaticSiteRedirectStatusCode
+		if r.StatusCode != nil {
+			code = aws.IntValue(r.StatusCode)
+		}
+		hasWildcard := strings.HasSuffix(r.From, "*")
+		out[i] = template.StaticSiteRedirect{
+			From:              strings.TrimSuffix(r.From, "*"),
+			To:                strings.TrimSuffix(r.To, "*"),
+			HasWildcard:       hasWildcard,
+			KeepSuffix:        hasWildcard && strings.HasSuffix(r.To, "*"),
+			StatusCode:        code,
+			StatusDescription: staticSiteRedirectStatusDescriptions[code],
+		}
+	}
+	return out
+}
+
+func convertStaticSiteCache(in manifest.StaticSiteCache) template.StaticSiteCache {
+	out := template.StaticSiteCache{
+		Default: convertStaticSiteCacheBehavior("", in.StaticSiteCacheBehavior),
+	}
+	for _, path := range in.Paths {
+		out.Paths = append(out.Paths, convertStaticSiteCacheBehavior(path.Path, path.StaticSiteCacheBehavior))
+	}
+	switch {
+	case in.Invalidation.String != nil:
+		switch strategy := aws.StringValue(in.Invalidation.String); strategy {
+		case manifest.StaticSiteInvalidateAll:
+		case manifest.StaticSiteInvalidateNone:
+			out.DisableInvalidation = true
+		default:
+			out.InvalidationPaths = []string{strategy}
+		}
+	case len(in.Invalidation.StringSlice) != 0:
+		out.InvalidationPaths = in.Invalidation.StringSlice
+	}
+	return out
+}
+
+func convertStaticSiteCacheBehavior(pathPattern string, in manifest.StaticSiteCacheBehavior) template.StaticSiteCacheBehavior {
+	out := template.StaticSiteCacheBehavior{
+		PathPattern:           pathPattern,
+		CachePolicyID:         aws.StringValue(in.Policy),
+		OriginRequestPolicyID: aws.StringValue(in.OriginRequestPolicy),
+	}
+	if !in.TTL.IsEmpty() {
+		min, def, max := in.TTL.Seconds()
+		out.TTL = &template.StaticSiteCacheTTL{
+			Min:     min,
+			Default: def,
+			Max:     max,
+		}
+	}
+	return out
+}
+
+// Referrer-Policy values supported by CloudFront response headers policies.
+var staticSiteReferrerPolicies = []string{
+	"no-referrer",
+	"no-referrer-when-downgrade",
+	"origin",
+	"origin-when-cross-origin",
+	"same-origin",
+	"strict-origin",
+	"strict-origin-when-cross-origin",
+	"unsafe-url",
+}
+
+func isStaticSiteReferrerPolicy(policy string) bool {
+	for _, p := range staticSiteReferrerPolicies {
+		if p == policy {
+			return true
+		}
+	}
+	return false
+}
+
+// convertStaticSiteResponseHeaders converts the custom headers of a static site into the headers of a CloudFront
+// response headers policy. Security headers can't be set as custom headers, so they are converted into their
+// dedicated settings.
+func convertStaticSiteResponseHeaders(in map[string]string) (*template.StaticSiteResponseHeaders, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	out := &template.StaticSiteResponseHeaders{}
+	for name, value := range in {
+		value = strings.TrimSpace(value)
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "content-security-policy":
+			out.ContentSecurityPolicy = value
+		case "strict-transport-security":
+			hsts, err := convertStrictTransportSecurity(value)
+			if err != nil {
+				return nil, fmt.Errorf("convert header %s: %w", name, err)
+			}
+			out.StrictTransportSecurity = hsts
+		case "x-content-type-options":
+			if !strings.EqualFold(value, "nosniff") {
+				return nil, fmt.Errorf(`convert header %s: value %q must be "nosniff"`, name, value)
+			}
+			out.ContentTypeOptions = true
+		case "x-frame-options":
+			option := strings.ToUpper(value)
+			if option != "DENY" && option != "SAMEORIGIN" {
+				return nil, fmt.Errorf(`convert header %s: value %q must be "DENY" or "SAMEORIGIN"`, name, value)
+			}
+			out.FrameOption = option
+		case "referrer-policy":
+			policy := strings.ToLower(value)
+			if !isStaticSiteReferrerPolicy(policy) {
+				return nil, fmt.Errorf("convert header %s: value %q must be one of %s", name, value, strings.Join(staticSiteReferrerPolicies, ", "))
+			}
+			out.ReferrerPolicy = policy
+		case "x-xss-protection":
+			xss, err := convertXSSProtection(value)
+			if err != nil {
+				return nil, fmt.Errorf("convert header %s: %w", name, err)
+			}
+			out.XSSProtection = xss
+		default:
+			if out.Custom == nil {
+				out.Custom = make(map[string]string)
+			}
+			out.Custom[name] = value
+		}
+	}
+	return out, nil
+}
+
+// convertStrictTransportSecurity parses a Strict-Transport-Security header such as "max-age=63072000; includeSubDomains; preload".
+func convertStrictTransportSecurity(value string) (*template.StrictTransportSecurityOpts, error) {
+	out := &template.StrictTransportSecurityOpts{}
+	var hasMaxAge bool
+	for _, directive := range strings.Split(value, ";") {
+		directive = strings.TrimSpace(directive)
+		if directive == "" {
+			continue
+		}
+		key, val, _ := strings.Cut(directive, "=")
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "max-age":
+			maxAge, err := strconv.ParseInt(strings.TrimSpace(val), 10, 64)
+			if err != nil || maxAge < 0 {
+				return nil, fmt.Errorf(`directive %q must be a non-negative number of seconds`, directive)
+			}
+			out.MaxAgeSec, hasMaxAge = maxAge, true
+		case "includesubdomains":
+			out.IncludeSubdomains = true
+		case "preload":
+			out.Preload = true
+		default:
+			return nil, fmt.Errorf("unsupported directive %q", directive)
+		}
+	}
+	if !hasMaxAge {
+		return nil, errors.New(`directive "max-age" must be specified`)
+	}
+	return out, nil
+}
+
+// convertXSSProtection parses a X-XSS-Protection header such as "1; mode=block".
+func convertXSSProtection(value string) (*template.XSSProtectionOpts, error) {
+	directives := strings.Split(value, ";")
+	out := &template.XSSProtectionOpts{}
+	switch strings.TrimSpace(directives[0]) {
+	case "0":
+		if len(directives) > 1 {
+			return nil, fmt.Errorf(`value %q must not have directives when the protection is disabled`, value)
+		}
+		return out, nil
+	case "1":
+		out.Protection = true
+	default:
+		return nil, fmt.Errorf(`value %q must start with "0" or "1"`, value)
+	}
+	for _, directive := range directives[1:] {
+		directive = strings.TrimSpace(directive)
+		key, val, _ := strings.Cut(directive, "=")
+		switch {
+		case strings.EqualFold(key, "mode") && strings.EqualFold(val, "block"):
+			out.ModeBlock = true
+		case strings.EqualFold(key, "report") && val != "":
+			out.ReportURI = val
+		default:
+			return nil, fmt.Errorf("unsupported directive %q", directive)
+		}
+	}
+	if out.ModeBlock && out.ReportURI != "" {
+		return nil, fmt.Errorf(`value %q must not specify both "mode=block" and "report"`, value)
+	}
+	return out, nil
+}
+
 type uploadableCRs []*customresource.CustomResource
 
 func (in uploadableCRs) convert() []uploadable {
@@ -48,6 +48,9 @@ func Test_convertSidecar(t *testing.T) {
 		inDependsOn       map[string]string
 		inImageOverride   manifest.ImageOverride
 		inHealthCheck     manifest.ContainerHealthCheck
+		inCPU             *int
+		inMemory          *int
+		inEnvFileVars     map[string]string
 		circDepContainers []string
 
 		wanted    *template.SidecarOpts
@@ -91,6 +94,55 @@ func Test_convertSidecar(t *testing.T) {
 				},
 			},
 		},
+		"reserve cpu and memory": {
+			inEssential: true,
+			inCPU:       aws.Int(256),
+			inMemory:    aws.Int(512),
+
+			wanted: &template.SidecarOpts{
+				Name:       "foo",
+				CredsParam: mockCredsParam,
+				Image:      mockImage,
+				Secrets:    mockSecrets,
+				Variables:  mockMap,
+				Essential:  aws.Bool(true),
+				PortMappings: []*template.PortMapping{
+					{
+						Protocol:      "tcp",
+						ContainerName: "foo",
+						ContainerPort: uint16(2000),
+					},
+				},
+				CPU:               aws.Int(256),
+				MemoryReservation: aws.Int(512),
+			},
+		},
+		"render the variables of the env file": {
+			inEssential: true,
+			inEnvFileVars: map[string]string{
+				"foo":       "shadowed",
+				"LOG_LEVEL": "info",
+			},
+
+			wanted: &template.SidecarOpts{
+				Name:       "foo",
+				CredsParam: mockCredsParam,
+				Image:      mockImage,
+				Secrets:    mockSecrets,
+				Variables: map[string]template.Variable{
+					"foo":       template.PlainVariable(""),
+					"LOG_LEVEL": template.PlainVariable("info"),
+				},
+				Essential: aws.Bool(true),
+				PortMappings: []*template.PortMapping{
+					{
+						Protocol:      "tcp",
+						ContainerName: "foo",
+						ContainerPort: uint16(2000),
+					},
+				},
+			},
+		},
 		"good container dependencies": {
 			inEssential: true,
 			inDependsOn: map[string]string{
@@ -293,16 +345,20 @@ func Test_convertSidecar(t *testing.T) {
 							},
 						},
 					},
-					Secrets:       map[string]manifest.Secret{"foo": {}},
-					Variables:     map[string]manifest.Variable{"foo": {}},
-					Essential:     aws.Bool(tc.inEssential),
-					DockerLabels:  tc.inLabels,
-					DependsOn:     tc.inDependsOn,
-					ImageOverride: tc.inImageOverride,
-					HealthCheck:   tc.inHealthCheck,
+					Secrets:           map[string]manifest.Secret{"foo": {}},
+					Variables:         map[string]manifest.Variable{"foo": {}},
+					Essential:         aws.Bool(tc.inEssential),
+					DockerLabels:      tc.inLabels,
+					DependsOn:         tc.inDependsOn,
+					ImageOverride:     tc.inImageOverride,
+					HealthCheck:       tc.inHealthCheck,
+					CPU:               tc.inCPU,
+					MemoryReservation: tc.inMemory,
 				},
 			}
-			got, err := convertSidecars(sidecar, mockExposedPorts, mockRunTimeConfig)
+			rc := mockRunTimeConfig
+			rc.EnvFileVariables = map[string]map[string]string{"foo": tc.inEnvFileVars}
+			got, err := convertSidecars(sidecar, mockExposedPorts, rc)
 
 			if tc.wantedErr != nil {
 				require.EqualError(t, err, tc.wantedErr.Error())
@@ -361,6 +417,10 @@ func Test_convertAdvancedCount(t *testing.T) {
 						ScaleInCooldown:  aws.Float64(60),
 						ScaleOutCooldown: aws.Float64(60),
 					},
+					ConnCooldown: template.Cooldown{
+						ScaleInCooldown:  aws.Float64(60),
+						ScaleOutCooldown: aws.Float64(60),
+					},
 					QueueDelayCooldown: template.Cooldown{
 						ScaleInCooldown:  aws.Float64(60),
 						ScaleOutCooldown: aws.Float64(60),
@@ -491,6 +551,69 @@ func Test_convertCapacityProviders(t *testing.T) {
 				},
 			},
 		},
+		"with a weighted capacity strategy and an on-demand base": {
+			input: manifest.AdvancedCount{
+				Range: manifest.Range{
+					Value: &mockRange,
+				},
+				Capacity: manifest.CapacityStrategy{
+					OnDemandBase:   aws.Int(2),
+					OnDemandWeight: aws.Int(1),
+					SpotWeight:     aws.Int(3),
+				},
+			},
+
+			expected: []*template.CapacityProviderStrategy{
+				{
+					Weight:           aws.Int(3),
+					CapacityProvider: capacityProviderFargateSpot,
+				},
+				{
+					Base:             aws.Int(2),
+					Weight:           aws.Int(1),
+					CapacityProvider: capacityProviderFargate,
+				},
+			},
+		},
+		"with only an on-demand base in the capacity strategy": {
+			input: manifest.AdvancedCount{
+				Range: manifest.Range{
+					Value: &mockRange,
+				},
+				Capacity: manifest.CapacityStrategy{
+					OnDemandBase: aws.Int(1),
+				},
+			},
+
+			expected: []*template.CapacityProviderStrategy{
+				{
+					Weight:           aws.Int(1),
+					CapacityProvider: capacityProviderFargateSpot,
+				},
+				{
+					Base:             aws.Int(1),
+					Weight:           aws.Int(0),
+					CapacityProvider: capacityProviderFargate,
+				},
+			},
+		},
+		"with only a spot weight in the capacity strategy": {
+			input: manifest.AdvancedCount{
+				Range: manifest.Range{
+					Value: &mockRange,
+				},
+				Capacity: manifest.CapacityStrategy{
+					SpotWeight: aws.Int(2),
+				},
+			},
+
+			expected: []*template.CapacityProviderStrategy{
+				{
+					Weight:           aws.Int(2),
+					CapacityProvider: capacityProviderFargateSpot,
+				},
+			},
+		},
 		"with scaling into spot": {
 			input: manifest.AdvancedCount{
 				Range: manifest.Range{
@@ -690,6 +813,188 @@ func Test_convertAutoscaling(t *testing.T) {
 				},
 			},
 		},
+		"success with queue autoscaling that scales to zero": {
+			input: manifest.AdvancedCount{
+				Range: manifest.Range{
+					RangeConfig: manifest.RangeConfig{
+						Min: aws.Int(0),
+						Max: aws.Int(10),
+					},
+				},
+				QueueScaling: manifest.QueueScaling{
+					AcceptableLatency: &testAcceptableLatency,
+					AvgProcessingTime: &testAvgProcessingTime,
+				},
+			},
+			wanted: &template.AutoscalingOpts{
+				MaxCapacity: aws.Int(10),
+				MinCapacity: aws.Int(0),
+				QueueDelay: &template.AutoscalingQueueDelayOpts{
+					AcceptableBacklogPerTask: 2400,
+				},
+				ScaleToZero: true,
+			},
+		},
+		"success with websocket connections": {
+			input: manifest.AdvancedCount{
+				Range: manifest.Range{
+					Value: &mockRange,
+				},
+				Connections: manifest.ScalingConfigOrT[int]{
+					ScalingConfig: manifest.AdvancedScalingConfig[int]{
+						Value: aws.Int(100),
+						Cooldown: manifest.Cooldown{
+							ScaleOutCooldown: &timeMinute,
+						},
+					},
+				},
+			},
+			wanted: &template.AutoscalingOpts{
+				MaxCapacity: aws.Int(100),
+				MinCapacity: aws.Int(1),
+				Connections: aws.Float64(100),
+				ConnCooldown: template.Cooldown{
+					ScaleOutCooldown: aws.Float64(60),
+				},
+			},
+		},
+		"success with custom metric, step and scheduled scaling": {
+			input: manifest.AdvancedCount{
+				Range: manifest.Range{
+					Value: &mockRange,
+				},
+				Cooldown: manifest.Cooldown{
+					ScaleOutCooldown: &timeMinute,
+				},
+				Metrics: []manifest.CustomMetricScaling{
+					{
+						CloudWatchMetric: manifest.CloudWatchMetric{
+							Namespace:  aws.String("MyApp"),
+							Name:       aws.String("ActiveSessions"),
+							Dimensions: map[string]string{"Service": "api"},
+						},
+						Target: aws.Float64(100),
+					},
+				},
+				StepScaling: []manifest.StepScaling{
+					{
+						CloudWatchMetric: manifest.CloudWatchMetric{
+							Namespace: aws.String("AWS/SQS"),
+							Name:      aws.String("ApproximateNumberOfMessagesVisible"),
+							Statistic: aws.String("Sum"),
+						},
+						Period:            &timeMinute,
+						EvaluationPeriods: aws.Int(2),
+						Steps: []manifest.ScalingStep{
+							{Above: aws.Float64(500), Change: aws.Int(5)},
+							{Above: aws.Float64(100), Below: aws.Float64(500), Change: aws.Int(2)},
+							{Above: aws.Float64(5), Below: aws.Float64(10), Change: aws.Int(-1)},
+							{Below: aws.Float64(5), Change: aws.Int(-2)},
+						},
+					},
+				},
+				Schedules: []manifest.ScheduledScaling{
+					{
+						Schedule: aws.String("0 9 * * 1-5"),
+						Timezone: aws.String("America/New_York"),
+						Min:      aws.Int(10),
+					},
+					{
+						Name:     aws.String("nights"),
+						Schedule: aws.String("cron(0 18 ? * MON-FRI *)"),
+						Min:      aws.Int(1),
+						Max:      aws.Int(2),
+					},
+					{
+						Schedule: aws.String("at(2024-11-29T00:00:00)"),
+						Max:      aws.Int(50),
+					},
+				},
+			},
+			wanted: &template.AutoscalingOpts{
+				MaxCapacity: aws.Int(100),
+				MinCapacity: aws.Int(1),
+				CPUCooldown: template.Cooldown{
+					ScaleOutCooldown: aws.Float64(60),
+				},
+				MemCooldown: template.Cooldown{
+					ScaleOutCooldown: aws.Float64(60),
+				},
+				ReqCooldown: template.Cooldown{
+					ScaleOutCooldown: aws.Float64(60),
+				},
+				RespTimeCooldown: template.Cooldown{
+					ScaleOutCooldown: aws.Float64(60),
+				},
+				ConnCooldown: template.Cooldown{
+					ScaleOutCooldown: aws.Float64(60),
+				},
+				QueueDelayCooldown: template.Cooldown{
+					ScaleOutCooldown: aws.Float64(60),
+				},
+				CustomMetrics: []template.CustomMetricScalingOpts{
+					{
+						Metric: template.CloudWatchMetricOpts{
+							Namespace:  "MyApp",
+							Name:       "ActiveSessions",
+							Dimensions: map[string]string{"Service": "api"},
+							Statistic:  "Average",
+						},
+						Target: 100,
+						Cooldown: template.Cooldown{
+							ScaleOutCooldown: aws.Float64(60),
+						},
+					},
+				},
+				StepScaling: []template.StepScalingOpts{
+					{
+						Metric: template.CloudWatchMetricOpts{
+							Namespace: "AWS/SQS",
+							Name:      "ApproximateNumberOfMessagesVisible",
+							Statistic: "Sum",
+						},
+						PeriodSeconds:     60,
+						EvaluationPeriods: 2,
+						Cooldown: template.Cooldown{
+							ScaleOutCooldown: aws.Float64(60),
+						},
+						ScaleOut: &template.StepScalingPolicyOpts{
+							Threshold: 100,
+							Steps: []template.StepAdjustmentOpts{
+								{LowerBound: aws.Float64(0), UpperBound: aws.Float64(400), Change: 2},
+								{LowerBound: aws.Float64(400), Change: 5},
+							},
+						},
+						ScaleIn: &template.StepScalingPolicyOpts{
+							Threshold: 10,
+							Steps: []template.StepAdjustmentOpts{
+								{LowerBound: aws.Float64(-5), UpperBound: aws.Float64(0), Change: -1},
+								{UpperBound: aws.Float64(-5), Change: -2},
+							},
+						},
+					},
+				},
+				Schedules: []template.ScheduledScalingOpts{
+					{
+						Name:        "schedule-1",
+						Expression:  "cron(0 9 ? * 2-6 *)",
+						Timezone:    aws.String("America/New_York"),
+						MinCapacity: aws.Int(10),
+					},
+					{
+						Name:        "nights",
+						Expression:  "cron(0 18 ? * MON-FRI *)",
+						MinCapacity: aws.Int(1),
+						MaxCapacity: aws.Int(2),
+					},
+					{
+						Name:        "schedule-3",
+						Expression:  "at(2024-11-29T00:00:00)",
+						MaxCapacity: aws.Int(50),
+					},
+				},
+			},
+		},
 		"returns nil if spot specified": {
 			input: manifest.AdvancedCount{
 				Spot: aws.Int(5),
@@ -711,6 +1016,53 @@ func Test_convertAutoscaling(t *testing.T) {
 	}
 }
 
+func Test_convertAPIGateway(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.APIGateway
+		wanted *template.APIGatewayOpts
+	}{
+		"returns nil if the api gateway is not configured": {},
+		"transforms an http api with a jwt authorizer": {
+			in: manifest.APIGateway{
+				Authorizer: manifest.APIGatewayAuthorizer{
+					JWT: manifest.JWTAuthorizer{
+						Issuer:   aws.String("https://example.com"),
+						Audience: []string{"client"},
+					},
+				},
+				Throttling: manifest.APIGatewayThrottling{
+					Rate:  aws.Float64(100),
+					Burst: aws.Int(50),
+				},
+			},
+			wanted: &template.APIGatewayOpts{
+				JWTAuthorizer: &template.JWTAuthorizerOpts{
+					Issuer:   "https://example.com",
+					Audience: []string{"client"},
+				},
+				ThrottlingRate:  aws.Float64(100),
+				ThrottlingBurst: aws.Int(50),
+			},
+		},
+		"transforms a websocket api": {
+			in: manifest.APIGateway{
+				Protocol: aws.String("websocket"),
+				Routes:   []string{"sendmessage"},
+			},
+			wanted: &template.APIGatewayOpts{
+				IsWebSocket: true,
+				Routes:      []string{"sendmessage"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertAPIGateway(tc.in))
+		})
+	}
+}
+
 func Test_convertPath(t *testing.T) {
 	testCases := map[string]struct {
 		inPath string
@@ -741,53 +1093,369 @@ func Test_convertPath(t *testing.T) {
 	}
 }
 
-func Test_convertTaskDefOverrideRules(t *testing.T) {
+func Test_convertStaticRules(t *testing.T) {
 	testCases := map[string]struct {
-		inRule []manifest.OverrideRule
+		in           []manifest.StaticRule
+		routingRules []template.ALBListenerRule
 
-		wanted []override.Rule
+		wanted []template.ALBStaticRule
 	}{
-		"should have proper prefix": {
-			inRule: []manifest.OverrideRule{
+		"no static rules": {},
+		"redirect to the apex domain over https": {
+			in: []manifest.StaticRule{
 				{
-					Path:  "ContainerDefinitions[0].Ulimits[-].HardLimit",
-					Value: yaml.Node{},
+					Path:  aws.String("/"),
+					Hosts: []string{"www.example.com"},
+					Redirect: manifest.HTTPRedirect{
+						Protocol: aws.String("https"),
+						Host:     aws.String("example.com"),
+					},
 				},
 			},
-			wanted: []override.Rule{
+			wanted: []template.ALBStaticRule{
 				{
-					Path:  "Resources.TaskDefinition.Properties.ContainerDefinitions[0].Ulimits[-].HardLimit",
-					Value: yaml.Node{},
+					Path:  "/",
+					Hosts: []string{"www.example.com"},
+					Redirect: &template.ALBRedirect{
+						Protocol:   "HTTPS",
+						Host:       "example.com",
+						Port:       "443",
+						Path:       "/#{path}",
+						Query:      "#{query}",
+						StatusCode: "HTTP_301",
+					},
+				},
+			},
+		},
+		"redirect with every part of the url": {
+			in: []manifest.StaticRule{
+				{
+					Path: aws.String("old"),
+					Redirect: manifest.HTTPRedirect{
+						Protocol:   aws.String("HTTP"),
+						Host:       aws.String("example.com"),
+						Port:       aws.Uint16(8080),
+						Path:       aws.String("/new"),
+						Query:      aws.String("from=old"),
+						StatusCode: aws.Int(302),
+					},
+				},
+			},
+			wanted: []template.ALBStaticRule{
+				{
+					Path: "/old",
+					Redirect: &template.ALBRedirect{
+						Protocol:   "HTTP",
+						Host:       "example.com",
+						Port:       "8080",
+						Path:       "/new",
+						Query:      "from=old",
+						StatusCode: "HTTP_302",
+					},
+				},
+			},
+		},
+		"fixed response defaults to the aliases of the main rule": {
+			in: []manifest.StaticRule{
+				{
+					Path: aws.String("/maintenance"),
+					FixedResponse: manifest.HTTPFixedResponse{
+						StatusCode:  aws.Int(503),
+						ContentType: aws.String("text/plain"),
+						Body:        aws.String("Down for maintenance"),
+					},
+				},
+			},
+			routingRules: []template.ALBListenerRule{
+				{
+					Path:    "/",
+					Aliases: []string{"example.com"},
+				},
+			},
+			wanted: []template.ALBStaticRule{
+				{
+					Path:  "/maintenance",
+					Hosts: []string{"example.com"},
+					FixedResponse: &template.ALBFixedResponse{
+						StatusCode:  "503",
+						ContentType: "text/plain",
+						Body:        "Down for maintenance",
+					},
 				},
 			},
 		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			got := convertTaskDefOverrideRules(tc.inRule)
-
-			require.Equal(t, tc.wanted, got)
+			require.Equal(t, tc.wanted, convertStaticRules(tc.in, tc.routingRules))
 		})
 	}
 }
 
-func Test_convertHTTPHealthCheck(t *testing.T) {
-	// These are used by reference to represent the output of the manifest.durationp function.
-	duration15Seconds := 15 * time.Second
-	duration60Seconds := 60 * time.Second
+func Test_convertPrivateHostedZoneAliases(t *testing.T) {
+	zone := manifest.PrivateHostedZoneConfig{
+		Name: aws.String("internal.example.com"),
+	}
 	testCases := map[string]struct {
-		input      manifest.HealthCheckArgsOrString
-		wantedOpts template.HTTPHealthCheckOpts
+		inRules []manifest.RoutingRule
+		inZone  manifest.PrivateHostedZoneConfig
+
+		wanted []string
 	}{
-		"no fields indicated in manifest": {
-			wantedOpts: template.HTTPHealthCheckOpts{
-				HealthCheckPath: "/",
-				GracePeriod:     60,
+		"no private hosted zone": {
+			inRules: []manifest.RoutingRule{
+				{Alias: manifest.Alias{StringSliceOrString: manifest.StringSliceOrString{String: aws.String("api.internal.example.com")}}},
 			},
 		},
-		"just Path": {
-			input: manifest.HealthCheckArgsOrString{
-				Union: manifest.BasicToUnion[string, manifest.HTTPHealthCheckArgs]("path"),
+		"keeps the aliases in the zone without a hosted zone of their own": {
+			inRules: []manifest.RoutingRule{
+				{
+					Alias: manifest.Alias{
+						AdvancedAliases: []manifest.AdvancedAlias{
+							{Alias: aws.String("api.internal.example.com")},
+							{Alias: aws.String("admin.internal.example.com"), HostedZone: aws.String("Z123")},
+							{Alias: aws.String("api.example.com")},
+						},
+					},
+				},
+				{
+					Alias: manifest.Alias{StringSliceOrString: manifest.StringSliceOrString{StringSlice: []string{"internal.example.com", "api.internal.example.com"}}},
+				},
+				{
+					Alias:      manifest.Alias{StringSliceOrString: manifest.StringSliceOrString{String: aws.String("v2.internal.example.com")}},
+					HostedZone: aws.String("Z123"),
+				},
+			},
+			inZone: zone,
+			wanted: []string{"api.internal.example.com", "internal.example.com"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := convertPrivateHostedZoneAliases(tc.inRules, tc.inZone)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertHTTPAuth(t *testing.T) {
+	sessionTimeout := 8 * time.Hour
+	testCases := map[string]struct {
+		in manifest.HTTPAuth
+
+		wanted *template.ALBAuth
+	}{
+		"oidc with a client secret from secrets manager": {
+			in: manifest.HTTPAuth{
+				OIDC: manifest.OIDCAuth{
+					Issuer:                aws.String("https://idp.example.com"),
+					AuthorizationEndpoint: aws.String("https://idp.example.com/authorize"),
+					TokenEndpoint:         aws.String("https://idp.example.com/token"),
+					UserInfoEndpoint:      aws.String("https://idp.example.com/userinfo"),
+					ClientID:              aws.String("client"),
+					ClientSecret:          aws.String("idp-client-secret"),
+				},
+				Scope:          aws.String("openid email"),
+				SessionTimeout: &sessionTimeout,
+			},
+			wanted: &template.ALBAuth{
+				OIDC: &template.ALBOIDCAuth{
+					Issuer:                "https://idp.example.com",
+					AuthorizationEndpoint: "https://idp.example.com/authorize",
+					TokenEndpoint:         "https://idp.example.com/token",
+					UserInfoEndpoint:      "https://idp.example.com/userinfo",
+					ClientID:              "client",
+					ClientSecret:          "{{resolve:secretsmanager:idp-client-secret:SecretString}}",
+				},
+				Scope:          "openid email",
+				SessionTimeout: "28800",
+			},
+		},
+		"cognito": {
+			in: manifest.HTTPAuth{
+				Cognito: manifest.CognitoAuth{
+					UserPool: aws.String("arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_abc"),
+					ClientID: aws.String("client"),
+					Domain:   aws.String("login"),
+				},
+				SessionCookie: aws.String("session"),
+			},
+			wanted: &template.ALBAuth{
+				Cognito: &template.ALBCognitoAuth{
+					UserPoolARN: "arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_abc",
+					ClientID:    "client",
+					Domain:      "login",
+				},
+				SessionCookieName: "session",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertHTTPAuth(tc.in))
+		})
+	}
+}
+
+func Test_convertManagedCertificates(t *testing.T) {
+	testCases := map[string]struct {
+		in []manifest.ManagedCertificate
+
+		wanted []template.ManagedCertificate
+	}{
+		"no managed certificates": {},
+		"managed certificates with alternative names": {
+			in: []manifest.ManagedCertificate{
+				{Domain: aws.String("example.com"), HostedZone: aws.String("Z0123"), AlternativeNames: []string{"*.example.com"}},
+				{Domain: aws.String("example.org"), HostedZone: aws.String("Z0456")},
+			},
+			wanted: []template.ManagedCertificate{
+				{Domain: "example.com", HostedZoneID: "Z0123", AlternativeNames: []string{"*.example.com"}},
+				{Domain: "example.org", HostedZoneID: "Z0456"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mft := &manifest.Environment{}
+			mft.HTTPConfig.Public.ManagedCertificates = tc.in
+
+			require.Equal(t, tc.wanted, convertManagedCertificates(mft))
+		})
+	}
+}
+
+func Test_convertWAF(t *testing.T) {
+	testCases := map[string]struct {
+		in manifest.WAF
+
+		wanted *template.WAF
+	}{
+		"no waf": {},
+		"existing web ACL": {
+			in: manifest.WAF{
+				Union: manifest.BasicToUnion[string, manifest.WAFManagedRules]("arn:aws:wafv2:us-west-2:123456789012:regional/webacl/api/a1b2"),
+			},
+			wanted: &template.WAF{
+				WebACLARN: "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/api/a1b2",
+			},
+		},
+		"managed rules default to the AWS vendor": {
+			in: manifest.WAF{
+				Union: manifest.AdvancedToUnion[string](manifest.WAFManagedRules{
+					ManagedRules: []manifest.WAFManagedRule{
+						{Name: aws.String("AWSManagedRulesCommonRuleSet")},
+						{Name: aws.String("BotControl"), Vendor: aws.String("Example"), Paths: []string{"/api"}},
+					},
+				}),
+			},
+			wanted: &template.WAF{
+				ManagedRules: []template.WAFManagedRule{
+					{Vendor: "AWS", Name: "AWSManagedRulesCommonRuleSet"},
+					{Vendor: "Example", Name: "BotControl", ScopeDownPaths: []string{"/api"}},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertWAF(tc.in))
+		})
+	}
+}
+
+func Test_convertTrustStore(t *testing.T) {
+	testCases := map[string]struct {
+		in manifest.TrustStoreConfig
+
+		wanted      *template.TrustStore
+		wantedError error
+	}{
+		"no trust store": {},
+		"error if the CA bundle is not an S3 URI": {
+			in: manifest.TrustStoreConfig{
+				CABundle: aws.String("s3:///ca.pem"),
+			},
+			wantedError: errors.New("parse CA bundle of the trust store: cannot parse S3 URI s3:///ca.pem into bucket name and key"),
+		},
+		"trust store with a CA bundle": {
+			in: manifest.TrustStoreConfig{
+				CABundle:                      aws.String("s3://my-bucket/certs/ca.pem"),
+				IgnoreClientCertificateExpiry: aws.Bool(true),
+			},
+			wanted: &template.TrustStore{
+				CABundleBucket:                "my-bucket",
+				CABundleKey:                   "certs/ca.pem",
+				IgnoreClientCertificateExpiry: true,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mft := &manifest.Environment{}
+			mft.HTTPConfig.Public.TrustStore = tc.in
+
+			got, err := convertTrustStore(mft)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertTaskDefOverrideRules(t *testing.T) {
+	testCases := map[string]struct {
+		inRule []manifest.OverrideRule
+
+		wanted []override.Rule
+	}{
+		"should have proper prefix": {
+			inRule: []manifest.OverrideRule{
+				{
+					Path:  "ContainerDefinitions[0].Ulimits[-].HardLimit",
+					Value: yaml.Node{},
+				},
+			},
+			wanted: []override.Rule{
+				{
+					Path:  "Resources.TaskDefinition.Properties.ContainerDefinitions[0].Ulimits[-].HardLimit",
+					Value: yaml.Node{},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := convertTaskDefOverrideRules(tc.inRule)
+
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertHTTPHealthCheck(t *testing.T) {
+	// These are used by reference to represent the output of the manifest.durationp function.
+	duration15Seconds := 15 * time.Second
+	duration60Seconds := 60 * time.Second
+	testCases := map[string]struct {
+		input      manifest.HealthCheckArgsOrString
+		wantedOpts template.HTTPHealthCheckOpts
+	}{
+		"no fields indicated in manifest": {
+			wantedOpts: template.HTTPHealthCheckOpts{
+				HealthCheckPath: "/",
+				GracePeriod:     60,
+			},
+		},
+		"just Path": {
+			input: manifest.HealthCheckArgsOrString{
+				Union: manifest.BasicToUnion[string, manifest.HTTPHealthCheckArgs]("path"),
 			},
 			wantedOpts: template.HTTPHealthCheckOpts{
 				HealthCheckPath: "/path",
@@ -907,6 +1575,42 @@ func Test_convertHTTPHealthCheck(t *testing.T) {
 	}
 }
 
+func Test_convertGRPCHealthCheck(t *testing.T) {
+	testCases := map[string]struct {
+		in     template.HTTPHealthCheckOpts
+		wanted template.HTTPHealthCheckOpts
+	}{
+		"replaces the http defaults": {
+			in: template.HTTPHealthCheckOpts{
+				HealthCheckPath: "/",
+				GracePeriod:     60,
+			},
+			wanted: template.HTTPHealthCheckOpts{
+				HealthCheckPath: "/AWS.ALB/healthcheck",
+				SuccessCodes:    "12",
+				GracePeriod:     60,
+			},
+		},
+		"keeps a custom path and success codes": {
+			in: template.HTTPHealthCheckOpts{
+				HealthCheckPath: "/grpc.health.v1.Health/Check",
+				SuccessCodes:    "0",
+				GracePeriod:     60,
+			},
+			wanted: template.HTTPHealthCheckOpts{
+				HealthCheckPath: "/grpc.health.v1.Health/Check",
+				SuccessCodes:    "0",
+				GracePeriod:     60,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertGRPCHealthCheck(tc.in))
+		})
+	}
+}
+
 func Test_convertManagedFSInfo(t *testing.T) {
 	testCases := map[string]struct {
 		inVolumes         map[string]*manifest.Volume
@@ -1384,6 +2088,33 @@ func Test_convertExecuteCommand(t *testing.T) {
 	}
 }
 
+func Test_convertSidecars_pinnedImages(t *testing.T) {
+	sidecars := map[string]*manifest.SidecarConfig{
+		"envoy": {
+			Image: manifest.Union[*string, manifest.ImageLocationOrBuild]{
+				Basic: aws.String("public.ecr.aws/appmesh/aws-appmesh-envoy:v1.25"),
+			},
+		},
+		"nginx": {
+			Image: manifest.Union[*string, manifest.ImageLocationOrBuild]{
+				Basic: aws.String("public.ecr.aws/nginx/nginx:1.25"),
+			},
+		},
+	}
+	rc := RuntimeConfig{
+		PinnedImages: map[string]string{
+			"envoy": "public.ecr.aws/appmesh/aws-appmesh-envoy@sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7",
+		},
+	}
+
+	got, err := convertSidecars(sidecars, nil, rc)
+
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, "public.ecr.aws/appmesh/aws-appmesh-envoy@sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7", aws.StringValue(got[0].Image))
+	require.Equal(t, "public.ecr.aws/nginx/nginx:1.25", aws.StringValue(got[1].Image))
+}
+
 func Test_convertSidecarMountPoints(t *testing.T) {
 	testCases := map[string]struct {
 		inMountPoints  []manifest.SidecarMountPoint
@@ -1515,6 +2246,28 @@ func Test_convertPublish(t *testing.T) {
 				},
 			},
 		},
+		"valid publish with a kms key": {
+			inTopics: []manifest.Topic{
+				{
+					Name:   aws.String("topic1"),
+					KMSKey: aws.String("arn:aws:kms:us-west-2:123456789012:key/topic"),
+				},
+			},
+			wanted: &template.PublishOpts{
+				Topics: []*template.Topic{
+					{
+						Name:      aws.String("topic1"),
+						KMSKey:    aws.String("arn:aws:kms:us-west-2:123456789012:key/topic"),
+						AccountID: accountId,
+						Partition: partition,
+						Region:    region,
+						App:       app,
+						Env:       env,
+						Svc:       svc,
+					},
+				},
+			},
+		},
 		"valid publish with fifo enabled and standard topics": {
 			inTopics: []manifest.Topic{
 				{
@@ -1657,6 +2410,78 @@ func Test_convertSubscribe(t *testing.T) {
 				},
 			},
 		},
+		"valid subscribe with kms keys, dead letter retention and filter policy scope": {
+			inSubscribe: &manifest.WorkerService{
+				WorkerServiceConfig: manifest.WorkerServiceConfig{
+					Subscribe: manifest.SubscribeConfig{
+						Topics: []manifest.TopicSubscription{
+							{
+								Name:              aws.String("name"),
+								Service:           aws.String("svc"),
+								FilterPolicy:      mockStruct,
+								FilterPolicyScope: aws.String("MessageBody"),
+								Queue: manifest.SQSQueueOrBool{
+									Advanced: manifest.SQSQueue{
+										KMSKey: aws.String("arn:aws:kms:us-west-2:123456789012:key/topic"),
+									},
+								},
+							},
+						},
+						Queue: manifest.SQSQueue{
+							KMSKey: aws.String("arn:aws:kms:us-west-2:123456789012:key/queue"),
+							DeadLetter: manifest.DeadLetterQueue{
+								Tries:     aws.Uint16(35),
+								Retention: &duration111Seconds,
+							},
+						},
+					},
+				},
+			},
+			wanted: &template.SubscribeOpts{
+				Topics: []*template.TopicSubscription{
+					{
+						Name:              aws.String("name"),
+						Service:           aws.String("svc"),
+						FilterPolicy:      aws.String(`{"store":["example_corp"]}`),
+						FilterPolicyScope: aws.String("MessageBody"),
+						Queue: &template.SQSQueue{
+							KMSKey: aws.String("arn:aws:kms:us-west-2:123456789012:key/topic"),
+						},
+					},
+				},
+				Queue: &template.SQSQueue{
+					KMSKey: aws.String("arn:aws:kms:us-west-2:123456789012:key/queue"),
+					DeadLetter: &template.DeadLetterQueue{
+						Tries:     aws.Uint16(35),
+						Retention: aws.Int64(111),
+					},
+				},
+			},
+		},
+		"valid subscribe with only event rules": {
+			inSubscribe: &manifest.WorkerService{
+				WorkerServiceConfig: manifest.WorkerServiceConfig{
+					Subscribe: manifest.SubscribeConfig{
+						Events: []manifest.EventRule{
+							{
+								Name:    aws.String("orders"),
+								Bus:     aws.String("custom"),
+								Pattern: map[string]interface{}{"source": []string{"com.example.orders"}},
+							},
+						},
+					},
+				},
+			},
+			wanted: &template.SubscribeOpts{
+				Events: []*template.EventRule{
+					{
+						Name:    "orders",
+						Bus:     aws.String("custom"),
+						Pattern: `{"source":["com.example.orders"]}`,
+					},
+				},
+			},
+		},
 		"valid subscribe with default queue configs": { // 3
 			inSubscribe: &manifest.WorkerService{
 				WorkerServiceConfig: manifest.WorkerServiceConfig{
@@ -2217,6 +3042,20 @@ func Test_convertDeploymentConfig(t *testing.T) {
 				},
 			},
 		},
+		"if alarm shorthands entered, refer to the generated alarms": {
+			in: manifest.DeploymentConfig{
+				RollbackAlarms: manifest.BasicToUnion[[]string, manifest.AlarmArgs](
+					[]string{"alarmName1", ".cpu", ".5xx"}),
+			},
+			out: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: minHealthyPercentDefault,
+				MaxPercent:        maxPercentDefault,
+				Rollback: template.RollingUpdateRollbackConfig{
+					AlarmNames:      []string{"alarmName1"},
+					GeneratedAlarms: []string{"CPUUtilizationAlarm", "HTTP5xxRateAlarm"},
+				},
+			},
+		},
 		"if alarm args entered, transform": {
 			in: manifest.DeploymentConfig{
 				RollbackAlarms: manifest.AdvancedToUnion[[]string, manifest.AlarmArgs](
@@ -2234,17 +3073,141 @@ func Test_convertDeploymentConfig(t *testing.T) {
 				},
 			},
 		},
-	}
-	for name, tc := range testCases {
-		t.Run(name, func(t *testing.T) {
-			require.Equal(t, tc.out, convertDeploymentConfig(tc.in))
-		})
-	}
-}
-
-func Test_convertWorkerDeploymentConfig(t *testing.T) {
-	testCases := map[string]struct {
-		in  manifest.WorkerDeploymentConfig
+		"ignore blue/green configuration if the deployment type is rolling": {
+			in: manifest.DeploymentConfig{
+				Type: aws.String("rolling"),
+				BlueGreen: manifest.BlueGreenDeploymentConfig{
+					TestListenerPort: aws.Uint16(8080),
+				},
+			},
+			out: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: minHealthyPercentDefault,
+				MaxPercent:        maxPercentDefault,
+			},
+		},
+		"if blue/green deployment type indicated, populate with blue/green defaults": {
+			in: manifest.DeploymentConfig{
+				Type: aws.String("blue/green"),
+			},
+			out: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: minHealthyPercentDefault,
+				MaxPercent:        maxPercentDefault,
+				BlueGreen: &template.BlueGreenDeploymentOpts{
+					TerminationWaitMinutes: 5,
+				},
+			},
+		},
+		"if blue/green configuration entered, transform with hooks in lifecycle order": {
+			in: manifest.DeploymentConfig{
+				Type: aws.String("blue/green"),
+				BlueGreen: manifest.BlueGreenDeploymentConfig{
+					TestListenerPort: aws.Uint16(8080),
+					TerminationWait:  (*time.Duration)(aws.Int64(int64(90 * time.Minute))),
+					Hooks: manifest.BlueGreenHooks{
+						AfterAllowTraffic:     aws.String("arn:aws:lambda:us-west-2:123456789012:function:smoke"),
+						AfterAllowTestTraffic: aws.String("validate"),
+					},
+				},
+			},
+			out: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: minHealthyPercentDefault,
+				MaxPercent:        maxPercentDefault,
+				BlueGreen: &template.BlueGreenDeploymentOpts{
+					TestListenerPort:       aws.Uint16(8080),
+					TerminationWaitMinutes: 90,
+					Hooks: []template.BlueGreenHook{
+						{Event: "AfterAllowTestTraffic", Function: "validate"},
+						{Event: "AfterAllowTraffic", Function: "arn:aws:lambda:us-west-2:123456789012:function:smoke"},
+					},
+				},
+			},
+		},
+		"if canary rolling update indicated, shift the traffic with a canary deployment configuration": {
+			in: manifest.DeploymentConfig{
+				DeploymentControllerConfig: manifest.DeploymentControllerConfig{
+					Rolling: aws.String("canary"),
+				},
+				TrafficShifting: manifest.TrafficShiftingConfig{
+					Percent: aws.Int(20),
+				},
+				RollbackAlarms: manifest.BasicToUnion[[]string, manifest.AlarmArgs]([]string{"alarmName"}),
+			},
+			out: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: minHealthyPercentDefault,
+				MaxPercent:        maxPercentDefault,
+				Rollback: template.RollingUpdateRollbackConfig{
+					AlarmNames: []string{"alarmName"},
+				},
+				BlueGreen: &template.BlueGreenDeploymentOpts{
+					TerminationWaitMinutes: 5,
+					TrafficRouting: &template.TrafficRoutingOpts{
+						Type:            "TimeBasedCanary",
+						Percent:         20,
+						IntervalMinutes: 5,
+					},
+				},
+			},
+		},
+		"if linear rolling update indicated, shift the traffic with a linear deployment configuration": {
+			in: manifest.DeploymentConfig{
+				Type: aws.String("blue/green"),
+				DeploymentControllerConfig: manifest.DeploymentControllerConfig{
+					Rolling: aws.String("linear"),
+				},
+				TrafficShifting: manifest.TrafficShiftingConfig{
+					Interval: (*time.Duration)(aws.Int64(int64(2 * time.Minute))),
+				},
+			},
+			out: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: minHealthyPercentDefault,
+				MaxPercent:        maxPercentDefault,
+				BlueGreen: &template.BlueGreenDeploymentOpts{
+					TerminationWaitMinutes: 5,
+					TrafficRouting: &template.TrafficRoutingOpts{
+						Type:            "TimeBasedLinear",
+						Percent:         10,
+						IntervalMinutes: 2,
+					},
+				},
+			},
+		},
+		"if deployment hooks indicated, convert their commands": {
+			in: manifest.DeploymentConfig{
+				DeploymentHooks: manifest.DeploymentHooks{
+					PreDeploy: manifest.DeploymentHook{
+						Command: manifest.CommandOverride{
+							StringSlice: []string{"rake", "db:migrate"},
+						},
+					},
+					PostDeploy: manifest.DeploymentHook{
+						Command: manifest.CommandOverride{
+							String: aws.String("./notify.sh 'deployment done'"),
+						},
+					},
+				},
+			},
+			out: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: minHealthyPercentDefault,
+				MaxPercent:        maxPercentDefault,
+				PreDeploy: &template.DeploymentHookOpts{
+					Command: []string{"rake", "db:migrate"},
+				},
+				PostDeploy: &template.DeploymentHookOpts{
+					Command: []string{"./notify.sh", "deployment done"},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.out, convertDeploymentConfig(tc.in))
+		})
+	}
+}
+
+func Test_convertWorkerDeploymentConfig(t *testing.T) {
+	testCases := map[string]struct {
+		in  manifest.WorkerDeploymentConfig
 		out template.DeploymentConfigurationOpts
 	}{
 		"if alarm names entered, format and populate": {
@@ -2260,6 +3223,19 @@ func Test_convertWorkerDeploymentConfig(t *testing.T) {
 				},
 			},
 		},
+		"if alarm shorthands entered, refer to the generated alarms": {
+			in: manifest.WorkerDeploymentConfig{
+				WorkerRollbackAlarms: manifest.BasicToUnion[[]string, manifest.WorkerAlarmArgs](
+					[]string{".queue_depth"}),
+			},
+			out: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: minHealthyPercentDefault,
+				MaxPercent:        maxPercentDefault,
+				Rollback: template.RollingUpdateRollbackConfig{
+					GeneratedAlarms: []string{"QueueDepthAlarm"},
+				},
+			},
+		},
 		"if alarm args entered, transform": {
 			in: manifest.WorkerDeploymentConfig{
 				WorkerRollbackAlarms: manifest.AdvancedToUnion[[]string, manifest.WorkerAlarmArgs](
@@ -2281,6 +3257,24 @@ func Test_convertWorkerDeploymentConfig(t *testing.T) {
 				},
 			},
 		},
+		"if a pre-deployment hook entered, convert its command": {
+			in: manifest.WorkerDeploymentConfig{
+				DeploymentHooks: manifest.DeploymentHooks{
+					PreDeploy: manifest.DeploymentHook{
+						Command: manifest.CommandOverride{
+							String: aws.String("python manage.py migrate"),
+						},
+					},
+				},
+			},
+			out: template.DeploymentConfigurationOpts{
+				MinHealthyPercent: minHealthyPercentDefault,
+				MaxPercent:        maxPercentDefault,
+				PreDeploy: &template.DeploymentHookOpts{
+					Command: []string{"python", "manage.py", "migrate"},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2397,6 +3391,43 @@ func Test_convertHTTPVersion(t *testing.T) {
 	}
 }
 
+func Test_convertEnvVarsWithEnvFile(t *testing.T) {
+	testCases := map[string]struct {
+		inVariables   map[string]manifest.Variable
+		inFromEnvFile map[string]string
+
+		wanted map[string]template.Variable
+	}{
+		"should return nil if there are no variables": {},
+		"should return the variables from the env file": {
+			inFromEnvFile: map[string]string{
+				"LOG_LEVEL": "info",
+			},
+			wanted: map[string]template.Variable{
+				"LOG_LEVEL": template.PlainVariable("info"),
+			},
+		},
+		"should prefer the manifest variables over the env file": {
+			inVariables: map[string]manifest.Variable{
+				"LOG_LEVEL": {},
+			},
+			inFromEnvFile: map[string]string{
+				"LOG_LEVEL": "info",
+				"API_URL":   "https://example.com",
+			},
+			wanted: map[string]template.Variable{
+				"LOG_LEVEL": template.PlainVariable(""),
+				"API_URL":   template.PlainVariable("https://example.com"),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertEnvVarsWithEnvFile(tc.inVariables, tc.inFromEnvFile))
+		})
+	}
+}
+
 func Test_convertCustomResources(t *testing.T) {
 	testCases := map[string]struct {
 		in        map[string]string
@@ -2435,3 +3466,851 @@ func Test_convertCustomResources(t *testing.T) {
 		})
 	}
 }
+
+func Test_convertStaticSiteRedirects(t *testing.T) {
+	testCases := map[string]struct {
+		in     []manifest.StaticSiteRedirect
+		wanted []template.StaticSiteRedirect
+	}{
+		"returns nil if there are no redirects": {},
+		"transforms exact and wildcard redirects": {
+			in: []manifest.StaticSiteRedirect{
+				{From: "/old.html", To: "/new.html"},
+				{From: "/docs/*", To: "https://docs.example.com/*", StatusCode: aws.Int(308)},
+				{From: "/blog/*", To: "/news", StatusCode: aws.Int(302)},
+			},
+			wanted: []template.StaticSiteRedirect{
+				{
+					From:              "/old.html",
+					To:                "/new.html",
+					StatusCode:        301,
+					StatusDescription: "Moved Permanently",
+				},
+				{
+					From:              "/docs/",
+					To:                "https://docs.example.com/",
+					HasWildcard:       true,
+					KeepSuffix:        true,
+					StatusCode:        308,
+					StatusDescription: "Permanent Redirect",
+				},
+				{
+					From:              "/blog/",
+					To:                "/news",
+					HasWildcard:       true,
+					StatusCode:        302,
+					StatusDescription: "Found",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertStaticSiteRedirects(tc.in))
+		})
+	}
+}
+
+func Test_convertStaticSiteCache(t *testing.T) {
+	zero, minute, hour := time.Duration(0), time.Minute, time.Hour
+	testCases := map[string]struct {
+		in     manifest.StaticSiteCache
+		wanted template.StaticSiteCache
+	}{
+		"defaults": {},
+		"policies, TTLs and invalidation paths": {
+			in: manifest.StaticSiteCache{
+				StaticSiteCacheBehavior: manifest.StaticSiteCacheBehavior{
+					TTL: manifest.StaticSiteCacheTTL{Max: &hour},
+				},
+				Paths: []manifest.StaticSiteCachePath{
+					{
+						Path: "/assets/*",
+						StaticSiteCacheBehavior: manifest.StaticSiteCacheBehavior{
+							Policy:              aws.String("cache-policy"),
+							OriginRequestPolicy: aws.String("origin-request-policy"),
+						},
+					},
+					{
+						Path: "/index.html",
+						StaticSiteCacheBehavior: manifest.StaticSiteCacheBehavior{
+							TTL: manifest.StaticSiteCacheTTL{Default: &minute, Min: &zero},
+						},
+					},
+				},
+				Invalidation: manifest.StringSliceOrString{StringSlice: []string{"/index.html", "/css/*"}},
+			},
+			wanted: template.StaticSiteCache{
+				Default: template.StaticSiteCacheBehavior{
+					TTL: &template.StaticSiteCacheTTL{Min: 0, Default: 3600, Max: 3600},
+				},
+				Paths: []template.StaticSiteCacheBehavior{
+					{
+						PathPattern:           "/assets/*",
+						CachePolicyID:         "cache-policy",
+						OriginRequestPolicyID: "origin-request-policy",
+					},
+					{
+						PathPattern: "/index.html",
+						TTL:         &template.StaticSiteCacheTTL{Min: 0, Default: 60, Max: 31536000},
+					},
+				},
+				InvalidationPaths: []string{"/index.html", "/css/*"},
+			},
+		},
+		"no invalidation": {
+			in: manifest.StaticSiteCache{
+				Invalidation: manifest.StringSliceOrString{String: aws.String("none")},
+			},
+			wanted: template.StaticSiteCache{
+				DisableInvalidation: true,
+			},
+		},
+		"single invalidation path": {
+			in: manifest.StaticSiteCache{
+				Invalidation: manifest.StringSliceOrString{String: aws.String("/index.html")},
+			},
+			wanted: template.StaticSiteCache{
+				InvalidationPaths: []string{"/index.html"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertStaticSiteCache(tc.in))
+		})
+	}
+}
+
+func Test_convertStaticSiteResponseHeaders(t *testing.T) {
+	testCases := map[string]struct {
+		in        map[string]string
+		wanted    *template.StaticSiteResponseHeaders
+		wantedErr error
+	}{
+		"returns nil if there are no headers": {},
+		"transforms security and custom headers": {
+			in: map[string]string{
+				"Content-Security-Policy":   "default-src 'self'",
+				"Strict-Transport-Security": "max-age=63072000; includeSubDomains; preload",
+				"X-Content-Type-Options":    "nosniff",
+				"X-Frame-Options":           "sameorigin",
+				"Referrer-Policy":           "strict-origin-when-cross-origin",
+				"X-XSS-Protection":          "1; mode=block",
+				"Cache-Control":             "max-age=3600",
+			},
+			wanted: &template.StaticSiteResponseHeaders{
+				ContentSecurityPolicy: "default-src 'self'",
+				StrictTransportSecurity: &template.StrictTransportSecurityOpts{
+					MaxAgeSec:         63072000,
+					IncludeSubdomains: true,
+					Preload:           true,
+				},
+				ContentTypeOptions: true,
+				FrameOption:        "SAMEORIGIN",
+				ReferrerPolicy:     "strict-origin-when-cross-origin",
+				XSSProtection: &template.XSSProtectionOpts{
+					Protection: true,
+					ModeBlock:  true,
+				},
+				Custom: map[string]string{
+					"Cache-Control": "max-age=3600",
+				},
+			},
+		},
+		"transforms an XSS protection with a report uri": {
+			in: map[string]string{
+				"x-xss-protection": "1; report=https://example.com/report",
+			},
+			wanted: &template.StaticSiteResponseHeaders{
+				XSSProtection: &template.XSSProtectionOpts{
+					Protection: true,
+					ReportURI:  "https://example.com/report",
+				},
+			},
+		},
+		"error if strict-transport-security does not have a max-age": {
+			in: map[string]string{
+				"Strict-Transport-Security": "includeSubDomains",
+			},
+			wantedErr: errors.New(`convert header Strict-Transport-Security: directive "max-age" must be specified`),
+		},
+		"error if strict-transport-security has an unsupported directive": {
+			in: map[string]string{
+				"Strict-Transport-Security": "max-age=60; foo",
+			},
+			wantedErr: errors.New(`convert header Strict-Transport-Security: unsupported directive "foo"`),
+		},
+		"error if x-content-type-options is not nosniff": {
+			in: map[string]string{
+				"X-Content-Type-Options": "sniff",
+			},
+			wantedErr: errors.New(`convert header X-Content-Type-Options: value "sniff" must be "nosniff"`),
+		},
+		"error if x-frame-options is invalid": {
+			in: map[string]string{
+				"X-Frame-Options": "ALLOW-FROM https://example.com",
+			},
+			wantedErr: errors.New(`convert header X-Frame-Options: value "ALLOW-FROM https://example.com" must be "DENY" or "SAMEORIGIN"`),
+		},
+		"error if referrer-policy is invalid": {
+			in: map[string]string{
+				"Referrer-Policy": "everywhere",
+			},
+			wantedErr: errors.New(`convert header Referrer-Policy: value "everywhere" must be one of no-referrer, no-referrer-when-downgrade, origin, origin-when-cross-origin, same-origin, strict-origin, strict-origin-when-cross-origin, unsafe-url`),
+		},
+		"error if x-xss-protection is invalid": {
+			in: map[string]string{
+				"X-XSS-Protection": "2",
+			},
+			wantedErr: errors.New(`convert header X-XSS-Protection: value "2" must start with "0" or "1"`),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			out, err := convertStaticSiteResponseHeaders(tc.in)
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, out)
+			}
+		})
+	}
+}
+
+func Test_convertAppRunnerAutoScaling(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.Union[*string, manifest.AppRunnerAutoScaling]
+		wanted *template.AppRunnerAutoScalingOpts
+	}{
+		"no auto scaling configuration": {},
+		"name of an existing auto scaling configuration": {
+			in: manifest.BasicToUnion[*string, manifest.AppRunnerAutoScaling](aws.String("high-availability/3")),
+		},
+		"auto scaling configuration": {
+			in: manifest.AdvancedToUnion[*string](manifest.AppRunnerAutoScaling{
+				MaxConcurrency: aws.Int(50),
+				MaxInstances:   aws.Int(10),
+			}),
+			wanted: &template.AppRunnerAutoScalingOpts{
+				MaxConcurrency: aws.Int(50),
+				MaxSize:        aws.Int(10),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertAppRunnerAutoScaling(tc.in))
+		})
+	}
+}
+
+func Test_convertObservability(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.Observability
+		wanted template.ObservabilityOpts
+	}{
+		"tracing only": {
+			in: manifest.Observability{
+				Tracing: aws.String("awsxray"),
+			},
+			wanted: template.ObservabilityOpts{
+				Tracing: "AWSXRAY",
+			},
+		},
+		"collector exports traces and metrics to AWS by default": {
+			in: manifest.Observability{
+				Collector: manifest.OTelCollectorConfig{
+					Image: aws.String("public.ecr.aws/aws-observability/aws-otel-collector:latest"),
+				},
+			},
+			wanted: template.ObservabilityOpts{
+				Collector: &template.OTelCollectorOpts{
+					Image:   "public.ecr.aws/aws-observability/aws-otel-collector:latest",
+					Traces:  "AWSXRAY",
+					Metrics: "CLOUDWATCH",
+				},
+			},
+		},
+		"collector exports only the configured signals": {
+			in: manifest.Observability{
+				Collector: manifest.OTelCollectorConfig{
+					Traces:       aws.String("otlp"),
+					OTLPEndpoint: aws.String("https://otlp.example.com:4317"),
+				},
+			},
+			wanted: template.ObservabilityOpts{
+				Collector: &template.OTelCollectorOpts{
+					Traces:       "OTLP",
+					OTLPEndpoint: "https://otlp.example.com:4317",
+				},
+			},
+		},
+		"collector with a custom config": {
+			in: manifest.Observability{
+				Collector: manifest.OTelCollectorConfig{
+					Config: aws.String("receivers: {}"),
+				},
+			},
+			wanted: template.ObservabilityOpts{
+				Collector: &template.OTelCollectorOpts{
+					Config: "receivers: {}",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertObservability(tc.in))
+		})
+	}
+}
+
+func Test_convertLogging(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted *template.LogConfigOpts
+	}{
+		"no logging configuration": {
+			in: `{}`,
+		},
+		"passes through the FireLens output options": {
+			in: `
+destination:
+  Name: cloudwatch
+  region: us-east-1`,
+			wanted: &template.LogConfigOpts{
+				Image:          aws.String("public.ecr.aws/aws-observability/aws-for-fluent-bit:stable"),
+				EnableMetadata: aws.String("true"),
+				Destination: map[string]string{
+					"Name":   "cloudwatch",
+					"region": "us-east-1",
+				},
+			},
+		},
+		"datadog with the api key and a json parser": {
+			in: `
+parser: json
+destination:
+  datadog:
+    api_key: /copilot/datadog/key
+    service: api
+    tags: team:payments`,
+			wanted: &template.LogConfigOpts{
+				Image:          aws.String("public.ecr.aws/aws-observability/aws-for-fluent-bit:stable"),
+				EnableMetadata: aws.String("true"),
+				ConfigFile:     aws.String("/fluent-bit/configs/parse-json.conf"),
+				Destination: map[string]string{
+					"Name":       "datadog",
+					"Host":       "http-intake.logs.datadoghq.com",
+					"TLS":        "on",
+					"provider":   "ecs",
+					"dd_service": "api",
+					"dd_tags":    "team:payments",
+				},
+				SecretOptions: map[string]template.Secret{
+					"apikey": template.SecretFromPlainSSMOrARN("/copilot/datadog/key"),
+				},
+			},
+		},
+		"splunk with the default port": {
+			in: `
+destination:
+  splunk:
+    host: splunk.example.com
+    token: /copilot/splunk/token`,
+			wanted: &template.LogConfigOpts{
+				Image:          aws.String("public.ecr.aws/aws-observability/aws-for-fluent-bit:stable"),
+				EnableMetadata: aws.String("true"),
+				Destination: map[string]string{
+					"Name": "splunk",
+					"Host": "splunk.example.com",
+					"Port": "8088",
+					"TLS":  "On",
+				},
+				SecretOptions: map[string]template.Secret{
+					"Splunk_Token": template.SecretFromPlainSSMOrARN("/copilot/splunk/token"),
+				},
+			},
+		},
+		"opensearch in the region of the environment": {
+			in: `
+destination:
+  opensearch:
+    host: search-logs.us-west-2.es.amazonaws.com
+    index: api`,
+			wanted: &template.LogConfigOpts{
+				Image:          aws.String("public.ecr.aws/aws-observability/aws-for-fluent-bit:stable"),
+				EnableMetadata: aws.String("true"),
+				Destination: map[string]string{
+					"Name":               "opensearch",
+					"Host":               "search-logs.us-west-2.es.amazonaws.com",
+					"Port":               "443",
+					"Index":              "api",
+					"tls":                "On",
+					"AWS_Auth":           "On",
+					"AWS_Region":         "us-west-2",
+					"Suppress_Type_Name": "On",
+				},
+				OpenSearchDomain: &template.LogDestinationResource{
+					Name:   "*",
+					Region: "us-west-2",
+				},
+			},
+		},
+		"firehose in another region": {
+			in: `
+destination:
+  firehose:
+    delivery_stream: logs
+    region: us-east-1`,
+			wanted: &template.LogConfigOpts{
+				Image:          aws.String("public.ecr.aws/aws-observability/aws-for-fluent-bit:stable"),
+				EnableMetadata: aws.String("true"),
+				Destination: map[string]string{
+					"Name":            "kinesis_firehose",
+					"region":          "us-east-1",
+					"delivery_stream": "logs",
+				},
+				FirehoseDeliveryStream: &template.LogDestinationResource{
+					Name:   "logs",
+					Region: "us-east-1",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var lc manifest.Logging
+			require.NoError(t, yaml.Unmarshal([]byte(tc.in), &lc))
+
+			require.Equal(t, tc.wanted, convertLogging(lc, "us-west-2"))
+		})
+	}
+}
+
+func Test_convertFlowLogsConfig(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.Union[*bool, manifest.VPCFlowLogsArgs]
+		wanted *template.VPCFlowLogs
+	}{
+		"flow logs disabled": {},
+		"flow logs enabled with the default retention": {
+			in: manifest.BasicToUnion[*bool, manifest.VPCFlowLogsArgs](aws.Bool(true)),
+			wanted: &template.VPCFlowLogs{
+				Retention: aws.Int(14),
+			},
+		},
+		"flow logs published to s3": {
+			in: manifest.AdvancedToUnion[*bool](manifest.VPCFlowLogsArgs{
+				Retention:   aws.Int(90),
+				Destination: aws.String(manifest.FlowLogsDestinationS3),
+			}),
+			wanted: &template.VPCFlowLogs{
+				Retention: aws.Int(90),
+				S3:        true,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mft := &manifest.Environment{}
+			mft.Network.VPC.FlowLogs = tc.in
+
+			got, err := convertFlowLogsConfig(mft)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertStaticSiteLogsConfig(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.Union[*bool, manifest.StaticSiteLogsArgs]
+		wanted *template.StaticSiteLogs
+	}{
+		"static site logs disabled": {
+			in: manifest.BasicToUnion[*bool, manifest.StaticSiteLogsArgs](aws.Bool(false)),
+		},
+		"static site logs enabled": {
+			in:     manifest.BasicToUnion[*bool, manifest.StaticSiteLogsArgs](aws.Bool(true)),
+			wanted: &template.StaticSiteLogs{},
+		},
+		"static site logs with a retention": {
+			in: manifest.AdvancedToUnion[*bool](manifest.StaticSiteLogsArgs{
+				Retention: aws.Int(365),
+			}),
+			wanted: &template.StaticSiteLogs{
+				Retention: aws.Int(365),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mft := &manifest.Environment{}
+			mft.Observability.StaticSiteLogs = tc.in
+
+			require.Equal(t, tc.wanted, convertStaticSiteLogsConfig(mft))
+		})
+	}
+}
+
+func Test_convertNATConfig(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.NATConfig
+		wanted template.NAT
+	}{
+		"nat gateway per availability zone by default": {},
+		"single nat gateway": {
+			in: manifest.NATConfig{
+				Strategy: aws.String(manifest.NATStrategySingle),
+			},
+			wanted: template.NAT{
+				Single: true,
+			},
+		},
+		"no nat": {
+			in: manifest.NATConfig{
+				Strategy: aws.String(manifest.NATStrategyNone),
+			},
+			wanted: template.NAT{
+				None: true,
+			},
+		},
+		"graviton nat instances": {
+			in: manifest.NATConfig{
+				InstanceType: aws.String("t4g.nano"),
+			},
+			wanted: template.NAT{
+				Instance: &template.NATInstance{
+					InstanceType: "t4g.nano",
+					ImageID:      "{{resolve:ssm:/aws/service/ami-amazon-linux-latest/al2023-ami-kernel-default-arm64}}",
+				},
+			},
+		},
+		"single x86 nat instance": {
+			in: manifest.NATConfig{
+				Strategy:     aws.String(manifest.NATStrategySingle),
+				InstanceType: aws.String("t3.micro"),
+			},
+			wanted: template.NAT{
+				Single: true,
+				Instance: &template.NATInstance{
+					InstanceType: "t3.micro",
+					ImageID:      "{{resolve:ssm:/aws/service/ami-amazon-linux-latest/al2023-ami-kernel-default-x86_64}}",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertNATConfig(tc.in))
+		})
+	}
+}
+
+func Test_convertIngressRules(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted []template.IngressRule
+	}{
+		"no rules": {},
+		"rules from security groups and cidr blocks": {
+			in: `
+- source_security_group: sg-1234
+  ports: 8080
+- source_security_group:
+    from_cfn: demo-test-DBSecurityGroup
+  ports: 5432
+- cidr: 10.0.0.0/16
+  ports: 9000-9010
+  ip_protocol: udp`,
+			wanted: []template.IngressRule{
+				{
+					SourceSecurityGroup: template.PlainSecurityGroup("sg-1234"),
+					IPProtocol:          "tcp",
+					FromPort:            8080,
+					ToPort:              8080,
+				},
+				{
+					SourceSecurityGroup: template.ImportedSecurityGroup("demo-test-DBSecurityGroup"),
+					IPProtocol:          "tcp",
+					FromPort:            5432,
+					ToPort:              5432,
+				},
+				{
+					CIDR:       "10.0.0.0/16",
+					IPProtocol: "udp",
+					FromPort:   9000,
+					ToPort:     9010,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var rules []manifest.SecurityGroupIngressRule
+			require.NoError(t, yaml.Unmarshal([]byte(tc.in), &rules))
+
+			got, err := convertIngressRules(rules)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_validateIngressWithNLB(t *testing.T) {
+	nlb := &template.NetworkLoadBalancer{
+		PublicSubnetCIDRs: []string{"10.0.0.0/24", "10.0.1.0/24"},
+		Listener: []template.NetworkLoadBalancerListener{
+			{
+				Protocol:   "TLS",
+				TargetPort: "8080",
+			},
+		},
+	}
+	testCases := map[string]struct {
+		rules []template.IngressRule
+		nlb   *template.NetworkLoadBalancer
+
+		wantedErr string
+	}{
+		"no network load balancer": {
+			rules: []template.IngressRule{
+				{CIDR: "10.0.0.0/24", IPProtocol: "tcp", FromPort: 8080, ToPort: 8080},
+			},
+		},
+		"rule from a public subnet on the target port": {
+			rules: []template.IngressRule{
+				{CIDR: "10.0.2.0/24", IPProtocol: "tcp", FromPort: 8080, ToPort: 8080},
+				{CIDR: "10.0.1.0/24", IPProtocol: "tcp", FromPort: 8000, ToPort: 8100},
+			},
+			nlb:       nlb,
+			wantedErr: `"ingress[1]" overlaps with the rule that allows traffic from the network load balancer on port 8080`,
+		},
+		"rules that don't overlap": {
+			rules: []template.IngressRule{
+				{CIDR: "10.0.0.0/24", IPProtocol: "udp", FromPort: 8080, ToPort: 8080},
+				{CIDR: "10.0.1.0/24", IPProtocol: "tcp", FromPort: 9000, ToPort: 9000},
+				{SourceSecurityGroup: template.PlainSecurityGroup("sg-1234"), IPProtocol: "tcp", FromPort: 8080, ToPort: 8080},
+			},
+			nlb: nlb,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateIngressWithNLB(tc.rules, tc.nlb)
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_convertHTTPAlarms(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.HTTPAlarmsConfig
+		wanted *template.AlarmsOpts
+	}{
+		"no alarms": {},
+		"all alarms": {
+			in: manifest.HTTPAlarmsConfig{
+				AlarmsConfig: manifest.AlarmsConfig{
+					CPUUtilization:    aws.Float64(80),
+					MemoryUtilization: aws.Float64(90),
+					Notify: manifest.AlarmNotifyConfig{
+						Emails: []string{"oncall@example.com"},
+						Topics: []string{"arn:aws:sns:us-west-2:123456789012:alarms"},
+					},
+				},
+				HTTP5xxRate:  aws.Float64(5),
+				ResponseTime: (*time.Duration)(aws.Int64(int64(1500 * time.Millisecond))),
+			},
+			wanted: &template.AlarmsOpts{
+				CPUUtilization:    aws.Float64(80),
+				MemoryUtilization: aws.Float64(90),
+				HTTP5xxRate:       aws.Float64(5),
+				ResponseTime:      aws.Float64(1.5),
+				Emails:            []string{"oncall@example.com"},
+				Topics:            []string{"arn:aws:sns:us-west-2:123456789012:alarms"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertHTTPAlarms(tc.in))
+		})
+	}
+}
+
+func Test_convertWorkerAlarms(t *testing.T) {
+	require.Nil(t, convertWorkerAlarms(manifest.WorkerAlarmsConfig{}))
+	require.Equal(t, &template.AlarmsOpts{
+		QueueDepth: aws.Int(100),
+	}, convertWorkerAlarms(manifest.WorkerAlarmsConfig{
+		QueueDepth: aws.Int(100),
+	}))
+}
+
+func Test_convertServiceConnectPorts(t *testing.T) {
+	exposedPorts := manifest.ExposedPortsIndex{
+		WorkloadName: "api",
+		ContainerForPort: map[uint16]string{
+			50051: "api",
+			8080:  "api",
+			9901:  "envoy",
+		},
+	}
+	testCases := map[string]struct {
+		in     manifest.ServiceConnectArgs
+		wanted []template.ServiceConnectPort
+	}{
+		"no additional ports": {
+			in: manifest.ServiceConnectArgs{
+				Alias: aws.String("api"),
+			},
+		},
+		"ports default to the alias of the service": {
+			in: manifest.ServiceConnectArgs{
+				Alias: aws.String("grpc"),
+				Ports: []manifest.ServiceConnectPort{
+					{
+						Port:        aws.Uint16(8080),
+						Alias:       aws.String("admin"),
+						AppProtocol: aws.String("http"),
+					},
+					{
+						Port:            aws.Uint16(9901),
+						TargetContainer: aws.String("envoy"),
+					},
+				},
+			},
+			wanted: []template.ServiceConnectPort{
+				{
+					Name:          "port-8080",
+					ContainerName: "api",
+					Port:          8080,
+					Alias:         "admin",
+					AppProtocol:   "http",
+				},
+				{
+					Name:          "port-9901",
+					ContainerName: "envoy",
+					Port:          9901,
+					Alias:         "grpc",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertServiceConnectPorts(tc.in, exposedPorts))
+		})
+	}
+}
+
+func Test_convertSecretFiles(t *testing.T) {
+	var secrets, sidecarSecrets map[string]manifest.Secret
+	require.NoError(t, yaml.Unmarshal([]byte(`
+GITHUB_TOKEN: /github/token
+TLS_KEY:
+  secretsmanager: demo/tls
+  as_file: /etc/ssl/private/key.pem
+KUBECONFIG:
+  from: /demo/kubeconfig
+  as_file: /root/.kube/config
+`), &secrets))
+	require.NoError(t, yaml.Unmarshal([]byte(`
+TLS_KEY:
+  secretsmanager: demo/proxy-tls
+  as_file: /etc/ssl/private/key.pem
+`), &sidecarSecrets))
+	sidecars := map[string]*manifest.SidecarConfig{
+		"envoy-proxy": {
+			Secrets: sidecarSecrets,
+		},
+		"xray": {},
+	}
+
+	require.Equal(t, map[string]template.Secret{
+		"GITHUB_TOKEN": template.SecretFromPlainSSMOrARN("/github/token"),
+	}, convertSecrets(secrets))
+	require.Equal(t, &template.SecretFilesOpts{
+		Files: []template.SecretFile{
+			{Name: "KUBECONFIG", Path: "/root/.kube/config", Secret: template.SecretFromPlainSSMOrARN("/demo/kubeconfig")},
+			{Name: "TLS_KEY", Path: "/etc/ssl/private/key.pem", Secret: template.SecretFromSecretsManager("demo/tls")},
+			{Name: "TLS_KEY_FOR_ENVOY_PROXY", Container: "envoy-proxy", Path: "/etc/ssl/private/key.pem", Secret: template.SecretFromSecretsManager("demo/proxy-tls")},
+		},
+	}, convertSecretFiles(secrets, sidecars))
+	require.Nil(t, convertSecretFiles(map[string]manifest.Secret{"GITHUB_TOKEN": secrets["GITHUB_TOKEN"]}, map[string]*manifest.SidecarConfig{"xray": {}}))
+}
+
+func Test_serviceConnectHealthChecks(t *testing.T) {
+	exposedPorts := manifest.ExposedPortsIndex{
+		WorkloadName: "api",
+		ContainerForPort: map[uint16]string{
+			50051: "api",
+			8080:  "api",
+			9901:  "envoy",
+		},
+	}
+	testCases := map[string]struct {
+		in     manifest.ServiceConnectArgs
+		wanted map[string]string
+	}{
+		"no health checks": {
+			in: manifest.ServiceConnectArgs{
+				Ports: []manifest.ServiceConnectPort{
+					{Port: aws.Uint16(8080), AppProtocol: aws.String("http")},
+				},
+			},
+		},
+		"the ports of a container are checked by one command": {
+			in: manifest.ServiceConnectArgs{
+				Ports: []manifest.ServiceConnectPort{
+					{
+						Port:        aws.Uint16(8080),
+						AppProtocol: aws.String("http"),
+						HealthCheck: manifest.AdvancedToUnion[*bool](manifest.ServiceConnectPortHealthCheck{
+							Path: aws.String("/admin/health"),
+						}),
+					},
+					{
+						Port:        aws.Uint16(50051),
+						AppProtocol: aws.String("grpc"),
+						HealthCheck: manifest.BasicToUnion[*bool, manifest.ServiceConnectPortHealthCheck](aws.Bool(true)),
+					},
+					{
+						Port:            aws.Uint16(9901),
+						TargetContainer: aws.String("envoy"),
+						AppProtocol:     aws.String("http2"),
+						HealthCheck:     manifest.BasicToUnion[*bool, manifest.ServiceConnectPortHealthCheck](aws.Bool(true)),
+					},
+				},
+			},
+			wanted: map[string]string{
+				"api":   "curl -fs http://localhost:8080/admin/health && grpc_health_probe -addr=localhost:50051 || exit 1",
+				"envoy": "curl -fs --http2-prior-knowledge http://localhost:9901/ || exit 1",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, serviceConnectHealthChecks(tc.in, exposedPorts))
+		})
+	}
+}
@@ -5,7 +5,6 @@ package stack
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
 
@@ -33,6 +32,7 @@ type WorkerServiceConfig struct {
 	Manifest           *manifest.WorkerService
 	ArtifactBucketName string
 	RawManifest        []byte
+	RenderedManifest   []byte
 	RuntimeConfig      RuntimeConfig
 	Addons             NestedStackConfigurer
 }
@@ -56,6 +56,8 @@ func NewWorkerService(cfg WorkerServiceConfig) (*WorkerService, error) {
 				rc:                 cfg.RuntimeConfig,
 				image:              cfg.Manifest.ImageConfig.Image,
 				rawManifest:        cfg.RawManifest,
+				renderedManifest:   cfg.RenderedManifest,
+				resourceNames:      cfg.App.ResourceNames,
 				parser:             fs,
 				addons:             cfg.Addons,
 			},
@@ -114,6 +116,10 @@ func (s *WorkerService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	network := convertNetworkConfig(s.manifest.Network)
+	if network.Ingress, err = convertIngressRules(s.manifest.Network.VPC.SecurityGroups.GetIngress()); err != nil {
+		return "", fmt.Errorf(`convert "network.vpc.security_groups.ingress" field for service %s: %w`, s.name, err)
+	}
 	subscribe, err := convertSubscribe(s.manifest)
 	if err != nil {
 		return "", err
@@ -130,10 +136,12 @@ func (s *WorkerService) Template() (string, error) {
 		AppName:                  s.app,
 		EnvName:                  s.env,
 		WorkloadName:             s.name,
+		LogGroupName:             s.logGroupName(),
 		SerializedManifest:       string(s.rawManifest),
+		RenderedManifest:         string(s.renderedManifest),
 		EnvVersion:               s.rc.EnvVersion,
 		Version:                  s.rc.Version,
-		Variables:                convertEnvVars(s.manifest.WorkerServiceConfig.Variables),
+		Variables:                convertEnvVarsWithEnvFile(s.manifest.WorkerServiceConfig.Variables, s.rc.EnvFileVariables[s.name]),
 		Secrets:                  convertSecrets(s.manifest.WorkerServiceConfig.Secrets),
 		NestedStack:              addonsOutputs,
 		AddonsExtraParams:        addonsParams,
@@ -144,12 +152,14 @@ func (s *WorkerService) Template() (string, error) {
 		ExecuteCommand:           convertExecuteCommand(&s.manifest.ExecuteCommand),
 		WorkloadType:             manifestinfo.WorkerServiceType,
 		HealthCheck:              convertContainerHealthCheck(s.manifest.WorkerServiceConfig.ImageConfig.HealthCheck),
-		LogConfig:                convertLogging(s.manifest.Logging),
+		LogConfig:                convertLogging(s.manifest.Logging, s.rc.Region),
 		DockerLabels:             s.manifest.ImageConfig.Image.DockerLabels,
 		CustomResources:          crs,
 		Storage:                  convertStorageOpts(s.manifest.Name, s.manifest.Storage),
-		Network:                  convertNetworkConfig(s.manifest.Network),
+		SecretFiles:              convertSecretFiles(s.manifest.WorkerServiceConfig.Secrets, s.manifest.Sidecars),
+		Network:                  network,
 		DeploymentConfiguration:  convertWorkerDeploymentConfig(s.manifest.WorkerServiceConfig.DeployConfig),
+		Alarms:                   convertWorkerAlarms(s.manifest.Alarms),
 		EntryPoint:               entrypoint,
 		ServiceConnect:           scConfig,
 		Command:                  command,
@@ -159,10 +169,12 @@ func (s *WorkerService) Template() (string, error) {
 		Subscribe:                subscribe,
 		Publish:                  publishers,
 		Platform:                 convertPlatform(s.manifest.Platform),
-		Observability: template.ObservabilityOpts{
-			Tracing: strings.ToUpper(aws.StringValue(s.manifest.Observability.Tracing)),
-		},
-		PermissionsBoundary: s.permBound,
+		Observability:            convertObservability(s.manifest.Observability),
+		PermissionsBoundary:      s.permissionsBoundary(),
+		TaskRoleARN:              aws.StringValue(s.tc.TaskRole),
+		ExecutionRoleARN:         aws.StringValue(s.tc.ExecutionRole),
+		EC2CapacityProvider:      s.tc.OnEC2(),
+		GPU:                      s.tc.GPU,
 	})
 	if err != nil {
 		return "", fmt.Errorf("parse worker service template: %w", err)
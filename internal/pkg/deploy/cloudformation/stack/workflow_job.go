@@ -0,0 +1,234 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/upload/customresource"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+)
+
+// Default resources of the task of a workflow step.
+const (
+	workflowStepDefaultCPU    = 256
+	workflowStepDefaultMemory = 512
+)
+
+// workflowAllErrors matches every error of a Step Functions task.
+const workflowAllErrors = "States.ALL"
+
+// WorkflowJob represents the configuration needed to create a CloudFormation stack from a workflow job manifest.
+type WorkflowJob struct {
+	*wkld
+	manifest *manifest.WorkflowJob
+
+	parser workflowJobReadParser
+}
+
+// WorkflowJobConfig contains data required to initialize a workflow job stack.
+type WorkflowJobConfig struct {
+	App                *config.Application
+	Env                string
+	Manifest           *manifest.WorkflowJob
+	ArtifactBucketName string
+	RawManifest        []byte
+	RenderedManifest   []byte
+	RuntimeConfig      RuntimeConfig
+	Addons             NestedStackConfigurer
+}
+
+// NewWorkflowJob creates a new WorkflowJob stack from a manifest file.
+func NewWorkflowJob(cfg WorkflowJobConfig) (*WorkflowJob, error) {
+	crs, err := customresource.WorkflowJob(fs)
+	if err != nil {
+		return nil, fmt.Errorf("workflow job custom resources: %w", err)
+	}
+	cfg.RuntimeConfig.loadCustomResourceURLs(cfg.ArtifactBucketName, uploadableCRs(crs).convert())
+
+	return &WorkflowJob{
+		wkld: &wkld{
+			name:               aws.StringValue(cfg.Manifest.Name),
+			env:                cfg.Env,
+			app:                cfg.App.Name,
+			permBound:          cfg.App.PermissionsBoundary,
+			artifactBucketName: cfg.ArtifactBucketName,
+			rc:                 cfg.RuntimeConfig,
+			rawManifest:        cfg.RawManifest,
+			renderedManifest:   cfg.RenderedManifest,
+			resourceNames:      cfg.App.ResourceNames,
+			parser:             fs,
+			addons:             cfg.Addons,
+		},
+		manifest: cfg.Manifest,
+
+		parser: fs,
+	}, nil
+}
+
+// Template returns the CloudFormation template for the workflow job.
+func (j *WorkflowJob) Template() (string, error) {
+	addonsParams, err := j.addonsParameters()
+	if err != nil {
+		return "", err
+	}
+	addonsOutputs, err := j.addonsOutputs()
+	if err != nil {
+		return "", err
+	}
+	schedule, err := j.awsSchedule()
+	if err != nil {
+		return "", fmt.Errorf("convert schedule for job %s: %w", j.name, err)
+	}
+	schedules, err := convertJobSchedules(j.manifest.On.Schedules)
+	if err != nil {
+		return "", fmt.Errorf(`convert "on.schedules" field for job %s: %w`, j.name, err)
+	}
+	eventRules, err := convertEventRules(j.manifest.On.Events)
+	if err != nil {
+		return "", fmt.Errorf(`convert "on.events" field for job %s: %w`, j.name, err)
+	}
+	workflow, err := j.workflowOpts()
+	if err != nil {
+		return "", fmt.Errorf(`convert "steps" field for job %s: %w`, j.name, err)
+	}
+	crs, err := convertCustomResources(j.rc.CustomResourcesURL)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := j.parser.ParseWorkflowJob(template.WorkloadOpts{
+		SerializedManifest:       string(j.rawManifest),
+		RenderedManifest:         string(j.renderedManifest),
+		LogGroupName:             j.logGroupName(),
+		WorkloadType:             manifestinfo.WorkflowJobType,
+		NestedStack:              addonsOutputs,
+		AddonsExtraParams:        addonsParams,
+		ScheduleExpression:       schedule,
+		Schedules:                schedules,
+		EventRules:               eventRules,
+		Workflow:                 workflow,
+		Network:                  convertNetworkConfig(j.manifest.Network),
+		ServiceDiscoveryEndpoint: j.rc.ServiceDiscoveryEndpoint,
+		Platform:                 convertPlatform(j.manifest.Platform),
+		EnvVersion:               j.rc.EnvVersion,
+		Version:                  j.rc.Version,
+
+		CustomResources:     crs,
+		PermissionsBoundary: j.permBound,
+	})
+	if err != nil {
+		return "", fmt.Errorf("parse workflow job template: %w", err)
+	}
+	return content.String(), nil
+}
+
+// Parameters returns the list of CloudFormation parameters used by the template.
+func (j *WorkflowJob) Parameters() ([]*cloudformation.Parameter, error) {
+	wkldParams, err := j.wkld.Parameters()
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := j.awsSchedule()
+	if err != nil {
+		return nil, err
+	}
+	return append(wkldParams, []*cloudformation.Parameter{
+		{
+			ParameterKey:   aws.String(ScheduledJobScheduleParamKey),
+			ParameterValue: aws.String(schedule),
+		},
+		{
+			ParameterKey:   aws.String(WorkloadLogRetentionParamKey),
+			ParameterValue: aws.String(strconv.Itoa(ecsWkldLogRetentionDefault)),
+		},
+	}...), nil
+}
+
+// SerializedParameters returns the CloudFormation stack's parameters serialized to a JSON document.
+func (j *WorkflowJob) SerializedParameters() (string, error) {
+	return serializeTemplateConfig(j.wkld.parser, j)
+}
+
+// awsSchedule converts the schedule of the workflow to the expression of its schedule rule.
+func (j *WorkflowJob) awsSchedule() (string, error) {
+	return convertJobTrigger(j.name, j.manifest.On)
+}
+
+// workflowOpts converts the steps of the manifest to the states of the state machine.
+func (j *WorkflowJob) workflowOpts() (*template.WorkflowOpts, error) {
+	out := &template.WorkflowOpts{
+		StartAt: aws.StringValue(j.manifest.Steps[0].Name),
+	}
+	if j.manifest.Timeout != nil {
+		out.Timeout = aws.Int(int(*j.manifest.Timeout / time.Second))
+	}
+	for idx, step := range j.manifest.Steps {
+		opts, err := j.convertStep(step)
+		if err != nil {
+			return nil, fmt.Errorf("convert step %s: %w", aws.StringValue(step.Name), err)
+		}
+		opts.Next = j.manifest.NextStep(idx)
+		out.Steps = append(out.Steps, opts)
+	}
+	return out, nil
+}
+
+func (j *WorkflowJob) convertStep(step manifest.WorkflowStep) (*template.WorkflowStepOpts, error) {
+	name := aws.StringValue(step.Name)
+	entrypoint, err := convertEntryPoint(step.ImageConfig.EntryPoint)
+	if err != nil {
+		return nil, err
+	}
+	command, err := convertCommand(step.ImageConfig.Command)
+	if err != nil {
+		return nil, err
+	}
+	image := j.rc.PushedImages[name].URI()
+	if step.ImageConfig.Image.Location != nil {
+		image = step.ImageConfig.Image.GetLocation()
+	}
+	out := &template.WorkflowStepOpts{
+		Name:       name,
+		Image:      image,
+		CPU:        workflowStepDefaultCPU,
+		Memory:     workflowStepDefaultMemory,
+		Variables:  convertEnvVars(step.Variables),
+		Secrets:    convertSecrets(step.Secrets),
+		EntryPoint: entrypoint,
+		Command:    command,
+		InputPath:  step.InputPath,
+		ResultPath: step.ResultPath,
+		OutputPath: step.OutputPath,
+		Retries:    step.Retries,
+	}
+	if step.CPU != nil {
+		out.CPU = aws.IntValue(step.CPU)
+	}
+	if step.Memory != nil {
+		out.Memory = aws.IntValue(step.Memory)
+	}
+	if step.Timeout != nil {
+		out.Timeout = aws.Int(int(*step.Timeout / time.Second))
+	}
+	for _, catch := range step.Catch {
+		errs := catch.Errors
+		if len(errs) == 0 {
+			errs = []string{workflowAllErrors}
+		}
+		out.Catch = append(out.Catch, &template.WorkflowCatchOpts{
+			Errors:     errs,
+			Next:       aws.StringValue(catch.Next),
+			ResultPath: catch.ResultPath,
+		})
+	}
+	return out, nil
+}
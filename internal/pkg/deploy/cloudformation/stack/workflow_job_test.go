@@ -0,0 +1,272 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stack
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+var testWorkflowJobManifest = &manifest.WorkflowJob{
+	Workload: manifest.Workload{
+		Name: aws.String(testServiceName),
+		Type: aws.String(manifestinfo.WorkflowJobType),
+	},
+	WorkflowJobConfig: manifest.WorkflowJobConfig{
+		On: manifest.JobTriggerConfig{
+			Schedule: aws.String("@daily"),
+		},
+		Timeout: (*time.Duration)(aws.Int64(int64(2 * time.Hour))),
+		Steps: []manifest.WorkflowStep{
+			{
+				Name: aws.String("extract"),
+				ImageConfig: manifest.ImageWithEntryPointAndCommand{
+					Image: manifest.Image{
+						ImageLocationOrBuild: manifest.ImageLocationOrBuild{
+							Build: manifest.BuildArgsOrString{BuildString: aws.String("./extract/Dockerfile")},
+						},
+					},
+				},
+				CPU:        aws.Int(1024),
+				Memory:     aws.Int(2048),
+				ResultPath: aws.String("$.extract"),
+				Retries:    aws.Int(2),
+			},
+			{
+				Name: aws.String("load-all"),
+				ImageConfig: manifest.ImageWithEntryPointAndCommand{
+					Image: manifest.Image{
+						ImageLocationOrBuild: manifest.ImageLocationOrBuild{
+							Location: aws.String("public.ecr.aws/example/load:latest"),
+						},
+					},
+				},
+				Timeout: (*time.Duration)(aws.Int64(int64(30 * time.Minute))),
+				Catch: []manifest.WorkflowCatch{
+					{Next: aws.String("notify")},
+				},
+				End: aws.Bool(true),
+			},
+			{
+				Name: aws.String("notify"),
+				ImageConfig: manifest.ImageWithEntryPointAndCommand{
+					Image: manifest.Image{
+						ImageLocationOrBuild: manifest.ImageLocationOrBuild{
+							Location: aws.String("public.ecr.aws/example/notify:latest"),
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func TestWorkflowJob_Template(t *testing.T) {
+	testCases := map[string]struct {
+		mockDependencies func(t *testing.T, ctrl *gomock.Controller, job *WorkflowJob)
+
+		wantedTemplate string
+		wantedError    error
+	}{
+		"returns the error when parsing the job template fails": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, job *WorkflowJob) {
+				parser := mocks.NewMockworkflowJobReadParser(ctrl)
+				parser.EXPECT().ParseWorkflowJob(gomock.Any()).Return(nil, errors.New("some error"))
+				job.parser = parser
+			},
+			wantedError: errors.New("parse workflow job template: some error"),
+		},
+		"converts the steps of the manifest": {
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, job *WorkflowJob) {
+				parser := mocks.NewMockworkflowJobReadParser(ctrl)
+				parser.EXPECT().ParseWorkflowJob(gomock.Any()).DoAndReturn(func(actual template.WorkloadOpts) (*template.Content, error) {
+					require.Equal(t, "cron(0 0 * * ? *)", actual.ScheduleExpression)
+					require.Equal(t, &template.WorkflowOpts{
+						Timeout: aws.Int(7200),
+						StartAt: "extract",
+						Steps: []*template.WorkflowStepOpts{
+							{
+								Name:       "extract",
+								Image:      testImageRepoURL + ":" + testImageTag,
+								CPU:        1024,
+								Memory:     2048,
+								ResultPath: aws.String("$.extract"),
+								Retries:    aws.Int(2),
+								Next:       "load-all",
+							},
+							{
+								Name:    "load-all",
+								Image:   "public.ecr.aws/example/load:latest",
+								CPU:     256,
+								Memory:  512,
+								Timeout: aws.Int(1800),
+								Catch: []*template.WorkflowCatchOpts{
+									{
+										Errors: []string{"States.ALL"},
+										Next:   "notify",
+									},
+								},
+							},
+							{
+								Name:   "notify",
+								Image:  "public.ecr.aws/example/notify:latest",
+								CPU:    256,
+								Memory: 512,
+							},
+						},
+					}, actual.Workflow)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				job.parser = parser
+			},
+			wantedTemplate: "template",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			job := &WorkflowJob{
+				wkld: &wkld{
+					name:             testServiceName,
+					env:              testEnvName,
+					app:              testAppName,
+					renderedManifest: []byte("name: frontend"),
+					rc: RuntimeConfig{
+						PushedImages: map[string]ECRImage{
+							"extract": {
+								RepoURL:  testImageRepoURL,
+								ImageTag: testImageTag,
+							},
+						},
+						AccountID: "123456789012",
+						Region:    "us-west-2",
+					},
+					addons: mockAddons{},
+				},
+				manifest: testWorkflowJobManifest,
+			}
+			tc.mockDependencies(t, ctrl, job)
+
+			// WHEN
+			tpl, err := job.Template()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTemplate, tpl)
+			}
+		})
+	}
+}
+
+func TestWorkflowJob_TemplateResources(t *testing.T) {
+	// GIVEN
+	job := &WorkflowJob{
+		wkld: &wkld{
+			name:             testServiceName,
+			env:              testEnvName,
+			app:              testAppName,
+			renderedManifest: []byte("name: frontend"),
+			rc: RuntimeConfig{
+				PushedImages: map[string]ECRImage{
+					"extract": {
+						RepoURL:  testImageRepoURL,
+						ImageTag: testImageTag,
+					},
+				},
+				AccountID: "123456789012",
+				Region:    "us-west-2",
+			},
+			parser: fs,
+			addons: mockAddons{},
+		},
+		manifest: testWorkflowJobManifest,
+		parser:   fs,
+	}
+
+	// WHEN
+	tpl, err := job.Template()
+
+	// THEN
+	require.NoError(t, err)
+	var parsed struct {
+		Resources map[string]struct {
+			Properties map[string]any `yaml:"Properties"`
+		} `yaml:"Resources"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(tpl), &parsed))
+	for _, resource := range []string{"extractTaskDefinition", "loadallTaskDefinition", "notifyTaskDefinition", "StateMachine", "StateMachineRole", "Rule"} {
+		require.Contains(t, parsed.Resources, resource)
+	}
+	var definition struct {
+		StartAt string                    `json:"StartAt"`
+		States  map[string]map[string]any `json:"States"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(parsed.Resources["StateMachine"].Properties["DefinitionString"].(string)), &definition))
+	require.Equal(t, "extract", definition.StartAt)
+	require.Equal(t, "load-all", definition.States["extract"]["Next"])
+	require.Equal(t, true, definition.States["load-all"]["End"])
+	require.Equal(t, true, definition.States["notify"]["End"])
+}
+
+func TestWorkflowJob_Parameters(t *testing.T) {
+	testCases := map[string]struct {
+		wantedParam *cloudformation.Parameter
+	}{
+		"schedule": {
+			wantedParam: &cloudformation.Parameter{
+				ParameterKey:   aws.String(ScheduledJobScheduleParamKey),
+				ParameterValue: aws.String("cron(0 0 * * ? *)"),
+			},
+		},
+		"log retention": {
+			wantedParam: &cloudformation.Parameter{
+				ParameterKey:   aws.String(WorkloadLogRetentionParamKey),
+				ParameterValue: aws.String("30"),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			job := &WorkflowJob{
+				wkld: &wkld{
+					name: testServiceName,
+					env:  testEnvName,
+					app:  testAppName,
+					rc: RuntimeConfig{
+						AccountID: "123456789012",
+						Region:    "us-west-2",
+					},
+				},
+				manifest: testWorkflowJobManifest,
+			}
+
+			// WHEN
+			params, err := job.Parameters()
+
+			// THEN
+			require.NoError(t, err)
+			require.Contains(t, params, tc.wantedParam)
+		})
+	}
+}
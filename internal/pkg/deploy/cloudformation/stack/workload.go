@@ -7,13 +7,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -71,11 +74,13 @@ const (
 // RuntimeConfig represents configuration that's defined outside of the manifest file
 // that is needed to create a CloudFormation stack.
 type RuntimeConfig struct {
-	PushedImages       map[string]ECRImage // Optional. Image location in an ECR repository.
-	AddonsTemplateURL  string              // Optional. S3 object URL for the addons template.
-	EnvFileARNs        map[string]string   // Optional. S3 object ARNs for any env files. Map keys are container names.
-	AdditionalTags     map[string]string   // AdditionalTags are labels applied to resources in the workload stack.
-	CustomResourcesURL map[string]string   // Mapping of Custom Resource Function Name to the S3 URL where the function zip file is stored.
+	PushedImages       map[string]ECRImage          // Optional. Image location in an ECR repository.
+	PinnedImages       map[string]string            // Optional. Image location pinned to its digest for containers that don't build an image.
+	AddonsTemplateURL  string                       // Optional. S3 object URL for the addons template.
+	EnvFileARNs        map[string]string            // Optional. S3 object ARNs for any env files. Map keys are container names.
+	EnvFileVariables   map[string]map[string]string // Optional. Environment variables read from env files. Map keys are container names.
+	AdditionalTags     map[string]string            // AdditionalTags are labels applied to resources in the workload stack.
+	CustomResourcesURL map[string]string            // Mapping of Custom Resource Function Name to the S3 URL where the function zip file is stored.
 
 	// The target environment metadata.
 	ServiceDiscoveryEndpoint string // Endpoint for the service discovery namespace in the environment.
@@ -155,6 +160,8 @@ type wkld struct {
 	rc                 RuntimeConfig
 	image              location
 	rawManifest        []byte // Content of the manifest file without any transformations.
+	renderedManifest   []byte // Content of the manifest file after the substitution of its environment variables.
+	resourceNames      *config.ResourceNames
 
 	parser template.Parser
 	addons NestedStackConfigurer
@@ -165,6 +172,11 @@ func (w *wkld) StackName() string {
 	return NameForWorkload(w.app, w.env, w.name)
 }
 
+// logGroupName returns the name of the workload's log group if the application customizes it, or an empty string.
+func (w *wkld) logGroupName() string {
+	return w.resourceNames.LogGroupName(w.app, w.env, w.name)
+}
+
 // Parameters returns the list of CloudFormation parameters used by the template.
 func (w *wkld) Parameters() ([]*cloudformation.Parameter, error) {
 	var img string
@@ -174,6 +186,9 @@ func (w *wkld) Parameters() ([]*cloudformation.Parameter, error) {
 	if w.rc.PushedImages != nil {
 		img = w.rc.PushedImages[w.name].URI()
 	}
+	if pinned, ok := w.rc.PinnedImages[w.name]; ok {
+		img = pinned
+	}
 	return []*cloudformation.Parameter{
 		{
 			ParameterKey:   aws.String(WorkloadAppNameParamKey),
@@ -393,6 +408,34 @@ func (w *ecsWkld) envFileParams() []*cloudformation.Parameter {
 	return params
 }
 
+// addonsOutputs returns the outputs of the addons stack.
+// It returns an error if the addons grant permissions through managed policies while the manifest brings an existing task role,
+// since Copilot doesn't attach policies to roles it does not manage.
+func (w *ecsWkld) addonsOutputs() (*template.WorkloadNestedStackOpts, error) {
+	out, err := w.wkld.addonsOutputs()
+	if err != nil {
+		return nil, err
+	}
+	if w.tc.TaskRole == nil || out == nil || len(out.PolicyOutputs) == 0 {
+		return out, nil
+	}
+	return nil, fmt.Errorf(`addons of %s output the managed policies %s that cannot be attached to the existing task role %s: grant the permissions to the role instead, or remove "task_role" from the manifest`,
+		w.name, strings.Join(out.PolicyOutputs, ", "), aws.StringValue(w.tc.TaskRole))
+}
+
+// permissionsBoundary returns the name of the IAM managed policy that bounds the permissions of the roles generated for the workload.
+// A boundary in the manifest takes precedence over the one of the application.
+func (w *ecsWkld) permissionsBoundary() string {
+	boundary := aws.StringValue(w.tc.PermissionsBoundary)
+	if boundary == "" {
+		return w.permBound
+	}
+	if parsed, err := arn.Parse(boundary); err == nil {
+		return strings.TrimPrefix(parsed.Resource, "policy/")
+	}
+	return boundary
+}
+
 type appRunnerWkld struct {
 	*wkld
 	instanceConfig    manifest.AppRunnerInstanceConfig
@@ -413,6 +456,9 @@ func (w *appRunnerWkld) Parameters() ([]*cloudformation.Parameter, error) {
 	if w.rc.PushedImages != nil {
 		img = w.rc.PushedImages[w.name].URI()
 	}
+	if pinned, ok := w.rc.PinnedImages[w.name]; ok {
+		img = pinned
+	}
 
 	imageRepositoryType, err := apprunner.DetermineImageRepositoryType(img)
 	if err != nil {
@@ -6,6 +6,9 @@ package stack
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/stretchr/testify/require"
 )
 
@@ -44,3 +47,26 @@ func TestECRImage_GetLocation(t *testing.T) {
 		})
 	}
 }
+
+func TestWkld_Parameters_pinnedImage(t *testing.T) {
+	const pinned = "public.ecr.aws/nginx/nginx@sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807"
+	w := &wkld{
+		name: "frontend",
+		image: manifest.Image{
+			ImageLocationOrBuild: manifest.ImageLocationOrBuild{
+				Location: aws.String("public.ecr.aws/nginx/nginx:1.25"),
+			},
+		},
+		rc: RuntimeConfig{
+			PinnedImages: map[string]string{"frontend": pinned},
+		},
+	}
+
+	params, err := w.Parameters()
+
+	require.NoError(t, err)
+	require.Contains(t, params, &cloudformation.Parameter{
+		ParameterKey:   aws.String(WorkloadContainerImageParamKey),
+		ParameterValue: aws.String(pinned),
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/stretchr/testify/require"
@@ -52,6 +53,25 @@ func TestCloudFormation_DeployTask(t *testing.T) {
 	})
 }
 
+func TestCloudFormation_DeployRemoteBuilder(t *testing.T) {
+	when := func(cf CloudFormation) error {
+		return cf.DeployRemoteBuilder(&stack.RemoteBuilderConfig{
+			App:            "phonetool",
+			ArtifactBucket: "mockBucket",
+		})
+	}
+
+	t.Run("returns a wrapped error if creating a change set fails", func(t *testing.T) {
+		testDeployTask_OnCreateChangeSetFailure(t, when)
+	})
+	t.Run("returns nil if the change set is empty when calling Update", func(t *testing.T) {
+		testDeployTask_ReturnNilOnEmptyChangeSetWhileUpdatingStack(t, when)
+	})
+	t.Run("returns an error if stack creation fails", func(t *testing.T) {
+		testDeployTask_StreamUntilStackCreationFails(t, "phonetool-remote-builder", when)
+	})
+}
+
 var mockDescription1 = &cloudformation.StackDescription{
 	Tags: []*awscfn.Tag{
 		{
@@ -7,9 +7,12 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/errs"
 	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
+	"github.com/aws/copilot-cli/internal/pkg/template/lint"
 )
 
 // DeployService deploys a service stack and renders progress updates to out until the deployment is done.
@@ -40,7 +43,11 @@ func (cf CloudFormation) uploadStackTemplateToS3(bucket string, stack uploadable
 	if err != nil {
 		return "", fmt.Errorf("generate template: %w", err)
 	}
-	url, err := cf.s3Client.Upload(bucket, artifactpath.CFNTemplate(stack.StackName(), []byte(tmpl)), strings.NewReader(tmpl))
+	stackName := stack.StackName()
+	if err := lint.Validate(stackName, tmpl, lint.MaxTemplateURLSize); err != nil {
+		return "", err
+	}
+	url, err := cf.s3Client.Upload(bucket, artifactpath.CFNTemplate(stackName, []byte(tmpl)), strings.NewReader(tmpl))
 	if err != nil {
 		return "", err
 	}
@@ -51,14 +58,20 @@ func (cf CloudFormation) handleStackError(stackName string, err error) error {
 	if err == nil {
 		return nil
 	}
-	reasons, describeErr := cf.errorEvents(stackName)
+	events, describeErr := cf.cfnClient.ErrorEvents(stackName)
 	if describeErr != nil {
 		return fmt.Errorf("%w: describe stack: %v", err, describeErr)
 	}
-	if len(reasons) == 0 {
+	if len(events) == 0 {
 		return err
 	}
-	return fmt.Errorf("%w: %s", err, reasons[0])
+	stackErr := failedStackError(stackName, events)
+	if stackErr.Code == errs.CodeStackFailed {
+		stackErr.Code = errs.CodeChangeSetFailed
+		stackErr.Remediation.DocsURL = errs.Docs(errs.CodeChangeSetFailed)
+	}
+	stackErr.Err = err
+	return stackErr
 }
 
 // DeleteWorkload removes the CloudFormation stack of a deployed workload.
@@ -69,3 +82,17 @@ func (cf CloudFormation) DeleteWorkload(in deploy.DeleteWorkloadInput) error {
 		return cf.cfnClient.DeleteAndWaitWithRoleARN(stackName, in.ExecutionRoleARN)
 	})
 }
+
+// UpdateWorkloadTemplate updates the template body of a deployed workload's stack while maintaining its parameters and tags.
+func (cf CloudFormation) UpdateWorkloadTemplate(appName, envName, name, templateBody, cfnExecRoleARN string) error {
+	stackName := fmt.Sprintf("%s-%s-%s", appName, envName, name)
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		return fmt.Errorf("describe stack %s: %w", stackName, err)
+	}
+	s := cloudformation.NewStack(stackName, templateBody)
+	s.Parameters = descr.Parameters
+	s.Tags = descr.Tags
+	s.RoleARN = aws.String(cfnExecRoleARN)
+	return cf.cfnClient.UpdateAndWait(s)
+}
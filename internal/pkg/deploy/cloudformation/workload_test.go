@@ -17,6 +17,12 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// mockTemplate is the smallest template that passes the linter.
+const mockTemplate = `Resources:
+  Topic:
+    Type: AWS::SNS::Topic
+`
+
 type mockStackConfig struct {
 	name       string
 	template   string
@@ -61,7 +67,7 @@ func (m *mockStackConfig) Tags() []*sdkcloudformation.Tag {
 func TestCloudFormation_DeployService(t *testing.T) {
 	serviceConfig := &mockStackConfig{
 		name:     "myapp-myenv-mysvc",
-		template: "template",
+		template: mockTemplate,
 		parameters: map[string]string{
 			"port": "80",
 		},
@@ -104,6 +110,24 @@ func TestCloudFormation_DeployService(t *testing.T) {
 	t.Run("renders a stack with addons template if stack creation is successful", func(t *testing.T) {
 		testDeployWorkload_RenderNewlyCreatedStackWithAddons(t, "myapp-myenv-mysvc", when)
 	})
+	t.Run("returns an error without uploading an invalid template", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		client := CloudFormation{
+			s3Client: mocks.NewMocks3Client(ctrl),
+		}
+		invalid := &mockStackConfig{
+			name:     "myapp-myenv-mysvc",
+			template: mockTemplate + "    DependsOn: Queue\n",
+		}
+
+		// WHEN
+		err := client.DeployService(invalid, "mockBucket")
+
+		// THEN
+		require.EqualError(t, err, "template of stack myapp-myenv-mysvc is invalid: Resources.Topic.DependsOn: resource Queue does not exist")
+	})
 }
 
 func TestCloudFormation_DeleteWorkload(t *testing.T) {
@@ -211,3 +235,68 @@ func TestCloudFormation_DeleteWorkload(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudFormation_UpdateWorkloadTemplate(t *testing.T) {
+	testCases := map[string]struct {
+		inClient func(t *testing.T, ctrl *gomock.Controller) *mocks.MockcfnClient
+
+		wantedError error
+	}{
+		"wraps error if describe fails": {
+			inClient: func(t *testing.T, ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test-api").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("describe stack phonetool-test-api: some error"),
+		},
+		"uses existing parameters, tags, and passed in new template and role arn on success": {
+			inClient: func(t *testing.T, ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				params := []*sdkcloudformation.Parameter{
+					{
+						ParameterKey:   aws.String("WorkloadName"),
+						ParameterValue: aws.String("api"),
+					},
+				}
+				tags := []*sdkcloudformation.Tag{
+					{
+						Key:   aws.String("copilot-application"),
+						Value: aws.String("phonetool"),
+					},
+				}
+				m.EXPECT().Describe("phonetool-test-api").Return(&cloudformation.StackDescription{
+					Parameters: params,
+					Tags:       tags,
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any()).Return(nil).
+					Do(func(s *cloudformation.Stack) {
+						require.Equal(t, "phonetool-test-api", s.Name)
+						require.Equal(t, params, s.Parameters)
+						require.Equal(t, tags, s.Tags)
+						require.Equal(t, "hello", s.TemplateBody)
+						require.Equal(t, aws.String("arn"), s.RoleARN)
+					})
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := CloudFormation{
+				cfnClient: tc.inClient(t, ctrl),
+			}
+
+			err := cf.UpdateWorkloadTemplate("phonetool", "test", "api", "hello", "arn")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
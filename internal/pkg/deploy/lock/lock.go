@@ -0,0 +1,279 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package lock provides locks on the CloudFormation stacks of an application, so that
+// concurrent deployments or deletions of the same stack are rejected or queued instead of racing.
+//
+// A lock is an SSM parameter that is created without overwriting, which fails if the parameter already exists.
+package lock
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+)
+
+const (
+	rootLockPath = "/copilot/applications/%s/locks/"
+	fmtLockPath  = "/copilot/applications/%s/locks/%s"
+
+	defaultPollInterval = 5 * time.Second
+
+	// maxPutAttempts is the number of times a lock is created again after it was released between
+	// the failed attempt to create it and the attempt to read who holds it.
+	maxPutAttempts = 3
+)
+
+// SSM is the interface for the AWS SSM client.
+type SSM interface {
+	PutParameter(in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+	GetParameter(in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+	GetParametersByPath(in *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error)
+	DeleteParameter(in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+}
+
+// Lock is a held lock on a stack.
+type Lock struct {
+	Stack      string    `json:"stack"`
+	Owner      string    `json:"owner"`   // Who holds the lock, such as "alice@laptop".
+	Command    string    `json:"command"` // Command that holds the lock, such as "svc deploy".
+	AcquiredAt time.Time `json:"acquiredAt"`
+}
+
+// ErrLocked occurs when a stack is locked by someone else.
+type ErrLocked struct {
+	Lock *Lock
+}
+
+func (e *ErrLocked) Error() string {
+	return fmt.Sprintf("stack %s is locked by %s running %q since %s",
+		e.Lock.Stack, e.Lock.Owner, e.Lock.Command, e.Lock.AcquiredAt.Format(time.RFC3339))
+}
+
+// ErrWaitTimeout occurs when a stack is still locked by someone else after waiting for the lock for the timeout.
+type ErrWaitTimeout struct {
+	Timeout time.Duration
+	Lock    *Lock
+}
+
+func (e *ErrWaitTimeout) Error() string {
+	return fmt.Sprintf("timed out after %s waiting for the lock: %s", e.Timeout, (&ErrLocked{Lock: e.Lock}).Error())
+}
+
+// Locker acquires and releases the locks on the stacks of applications.
+type Locker struct {
+	client  SSM
+	owner   string
+	wait    bool
+	timeout time.Duration // Zero if the Locker waits indefinitely.
+	onWait  func(held *Lock)
+
+	pollInterval time.Duration
+	now          func() time.Time
+	sleep        func(time.Duration)
+}
+
+// Option configures a Locker.
+type Option func(*Locker)
+
+// WithWait makes the Locker wait for a held lock to be released instead of failing.
+// onWait is called with the held lock when the Locker starts waiting for it.
+func WithWait(onWait func(held *Lock)) Option {
+	return func(l *Locker) {
+		l.wait = true
+		l.onWait = onWait
+	}
+}
+
+// WithTimeout makes a waiting Locker give up with an *ErrWaitTimeout if the stack is still locked after timeout.
+// A timeout of zero or less waits indefinitely.
+func WithTimeout(timeout time.Duration) Option {
+	return func(l *Locker) {
+		if timeout > 0 {
+			l.timeout = timeout
+		}
+	}
+}
+
+// New returns a Locker whose locks are attributed to owner.
+func New(client SSM, owner string, opts ...Option) *Locker {
+	l := &Locker{
+		client:       client,
+		owner:        owner,
+		pollInterval: defaultPollInterval,
+		now:          time.Now,
+		sleep:        time.Sleep,
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Lock acquires the lock on the stack of the application for the command, and returns a function to release it.
+// If the stack is already locked, Lock returns an *ErrLocked, unless the Locker waits.
+// A Locker with a timeout returns an *ErrWaitTimeout if the stack is still locked once the timeout is over.
+func (l *Locker) Lock(app, stack, command string) (release func() error, err error) {
+	held := &Lock{
+		Stack:   stack,
+		Owner:   l.owner,
+		Command: command,
+	}
+	var deadline time.Time
+	if l.timeout > 0 {
+		deadline = l.now().Add(l.timeout)
+	}
+	var waitingFor *Lock
+	for {
+		held.AcquiredAt = l.now().UTC()
+		err := l.put(app, held)
+		if err == nil {
+			return func() error {
+				return l.release(app, held)
+			}, nil
+		}
+		errLocked, ok := err.(*ErrLocked)
+		if !ok || !l.wait {
+			return nil, err
+		}
+		if waitingFor == nil || *waitingFor != *errLocked.Lock {
+			waitingFor = errLocked.Lock
+			if l.onWait != nil {
+				l.onWait(waitingFor)
+			}
+		}
+		interval := l.pollInterval
+		if !deadline.IsZero() {
+			remaining := deadline.Sub(l.now())
+			if remaining <= 0 {
+				return nil, &ErrWaitTimeout{
+					Timeout: l.timeout,
+					Lock:    waitingFor,
+				}
+			}
+			if remaining < interval {
+				interval = remaining
+			}
+		}
+		l.sleep(interval)
+	}
+}
+
+// List returns the held locks on the stacks of the application, sorted by stack name.
+func (l *Locker) List(app string) ([]Lock, error) {
+	var locks []Lock
+	var nextToken *string
+	for {
+		out, err := l.client.GetParametersByPath(&ssm.GetParametersByPathInput{
+			Path:      aws.String(fmt.Sprintf(rootLockPath, app)),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list locks of application %s: %w", app, err)
+		}
+		for _, param := range out.Parameters {
+			var lock Lock
+			if err := json.Unmarshal([]byte(aws.StringValue(param.Value)), &lock); err != nil {
+				return nil, fmt.Errorf("read lock %s: %w", aws.StringValue(param.Name), err)
+			}
+			locks = append(locks, lock)
+		}
+		nextToken = out.NextToken
+		if nextToken == nil {
+			break
+		}
+	}
+	sort.Slice(locks, func(i, j int) bool {
+		return locks[i].Stack < locks[j].Stack
+	})
+	return locks, nil
+}
+
+// Release force-releases the lock on the stack of the application, whoever holds it.
+// It's a no-op if the stack isn't locked.
+func (l *Locker) Release(app, stack string) error {
+	_, err := l.client.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(lockPath(app, stack)),
+	})
+	if err != nil && !isErrCode(err, ssm.ErrCodeParameterNotFound) {
+		return fmt.Errorf("release lock on stack %s: %w", stack, err)
+	}
+	return nil
+}
+
+// put creates the lock, or returns an *ErrLocked with the lock that's already held.
+func (l *Locker) put(app string, lock *Lock) error {
+	data, err := json.Marshal(lock)
+	if err != nil {
+		return fmt.Errorf("marshal lock on stack %s: %w", lock.Stack, err)
+	}
+	for attempt := 0; attempt < maxPutAttempts; attempt++ {
+		_, err = l.client.PutParameter(&ssm.PutParameterInput{
+			Name:        aws.String(lockPath(app, lock.Stack)),
+			Description: aws.String("Copilot stack lock"),
+			Type:        aws.String(ssm.ParameterTypeString),
+			Value:       aws.String(string(data)),
+			Overwrite:   aws.Bool(false),
+		})
+		if err == nil {
+			return nil
+		}
+		if !isErrCode(err, ssm.ErrCodeParameterAlreadyExists) {
+			return fmt.Errorf("acquire lock on stack %s: %w", lock.Stack, err)
+		}
+		held, err := l.get(app, lock.Stack)
+		if err != nil {
+			return err
+		}
+		if held != nil {
+			return &ErrLocked{Lock: held}
+		}
+		// The lock was released in the meantime, so try to create it again.
+	}
+	return fmt.Errorf("acquire lock on stack %s: the lock was released and acquired again by someone else %d times in a row", lock.Stack, maxPutAttempts)
+}
+
+// get returns the held lock on the stack, or nil if the stack isn't locked.
+func (l *Locker) get(app, stack string) (*Lock, error) {
+	out, err := l.client.GetParameter(&ssm.GetParameterInput{
+		Name: aws.String(lockPath(app, stack)),
+	})
+	if isErrCode(err, ssm.ErrCodeParameterNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get lock on stack %s: %w", stack, err)
+	}
+	var lock Lock
+	if err := json.Unmarshal([]byte(aws.StringValue(out.Parameter.Value)), &lock); err != nil {
+		return nil, fmt.Errorf("read lock on stack %s: %w", stack, err)
+	}
+	return &lock, nil
+}
+
+// release releases the lock if it's still held, so that a lock that was force-released
+// and then acquired by someone else isn't released by mistake.
+func (l *Locker) release(app string, lock *Lock) error {
+	held, err := l.get(app, lock.Stack)
+	if err != nil {
+		return err
+	}
+	if held == nil || !held.AcquiredAt.Equal(lock.AcquiredAt) || held.Owner != lock.Owner || held.Command != lock.Command {
+		return nil
+	}
+	return l.Release(app, lock.Stack)
+}
+
+func lockPath(app, stack string) string {
+	return fmt.Sprintf(fmtLockPath, app, stack)
+}
+
+func isErrCode(err error, code string) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == code
+}
@@ -0,0 +1,211 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package lock
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSSM is an in-memory SSM Parameter Store.
+type fakeSSM struct {
+	params map[string]string
+}
+
+func (f *fakeSSM) PutParameter(in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+	if _, ok := f.params[*in.Name]; ok && !aws.BoolValue(in.Overwrite) {
+		return nil, awserr.New(ssm.ErrCodeParameterAlreadyExists, "exists", nil)
+	}
+	f.params[*in.Name] = *in.Value
+	return &ssm.PutParameterOutput{}, nil
+}
+
+func (f *fakeSSM) GetParameter(in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+	value, ok := f.params[*in.Name]
+	if !ok {
+		return nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil)
+	}
+	return &ssm.GetParameterOutput{Parameter: &ssm.Parameter{Name: in.Name, Value: aws.String(value)}}, nil
+}
+
+func (f *fakeSSM) GetParametersByPath(in *ssm.GetParametersByPathInput) (*ssm.GetParametersByPathOutput, error) {
+	out := &ssm.GetParametersByPathOutput{}
+	for name, value := range f.params {
+		if strings.HasPrefix(name, *in.Path) {
+			out.Parameters = append(out.Parameters, &ssm.Parameter{Name: aws.String(name), Value: aws.String(value)})
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeSSM) DeleteParameter(in *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error) {
+	if _, ok := f.params[*in.Name]; !ok {
+		return nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil)
+	}
+	delete(f.params, *in.Name)
+	return &ssm.DeleteParameterOutput{}, nil
+}
+
+// churningSSM is a fakeSSM whose locks are always released right before they're read and acquired again right after,
+// as if other deployments kept taking turns on the stacks.
+type churningSSM struct {
+	*fakeSSM
+	gets int
+}
+
+func (f *churningSSM) GetParameter(in *ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+	f.gets++
+	return nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil)
+}
+
+func TestLocker_Lock(t *testing.T) {
+	acquiredAt := time.Date(2023, time.March, 1, 10, 0, 0, 0, time.UTC)
+	fixedTime := func() time.Time { return acquiredAt }
+
+	t.Run("rejects a locked stack", func(t *testing.T) {
+		// GIVEN
+		client := &fakeSSM{params: make(map[string]string)}
+		alice := New(client, "alice@laptop")
+		alice.now = fixedTime
+		bob := New(client, "bob@desktop")
+
+		// WHEN
+		release, err := alice.Lock("phonetool", "phonetool-test-api", "svc deploy")
+		require.NoError(t, err)
+		_, err = bob.Lock("phonetool", "phonetool-test-api", "svc delete")
+
+		// THEN
+		var errLocked *ErrLocked
+		require.True(t, errors.As(err, &errLocked))
+		require.EqualError(t, err, `stack phonetool-test-api is locked by alice@laptop running "svc deploy" since 2023-03-01T10:00:00Z`)
+
+		require.NoError(t, release())
+		release, err = bob.Lock("phonetool", "phonetool-test-api", "svc delete")
+		require.NoError(t, err)
+		require.NoError(t, release())
+		require.Empty(t, client.params)
+	})
+	t.Run("waits for a locked stack", func(t *testing.T) {
+		// GIVEN
+		client := &fakeSSM{params: make(map[string]string)}
+		alice := New(client, "alice@laptop")
+		releaseAlice, err := alice.Lock("phonetool", "phonetool-test-api", "svc deploy")
+		require.NoError(t, err)
+
+		var waitedFor []*Lock
+		bob := New(client, "bob@desktop", WithWait(func(held *Lock) {
+			waitedFor = append(waitedFor, held)
+		}))
+		var polls int
+		bob.sleep = func(time.Duration) {
+			polls++
+			if polls == 3 {
+				require.NoError(t, releaseAlice())
+			}
+		}
+
+		// WHEN
+		release, err := bob.Lock("phonetool", "phonetool-test-api", "svc deploy")
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, 3, polls)
+		require.Len(t, waitedFor, 1)
+		require.Equal(t, "alice@laptop", waitedFor[0].Owner)
+		locks, err := bob.List("phonetool")
+		require.NoError(t, err)
+		require.Len(t, locks, 1)
+		require.Equal(t, "bob@desktop", locks[0].Owner)
+		require.NoError(t, release())
+	})
+	t.Run("gives up waiting for a locked stack after the timeout", func(t *testing.T) {
+		// GIVEN
+		client := &fakeSSM{params: make(map[string]string)}
+		alice := New(client, "alice@laptop")
+		alice.now = fixedTime
+		_, err := alice.Lock("phonetool", "phonetool-test-api", "svc deploy")
+		require.NoError(t, err)
+
+		now := acquiredAt
+		var slept []time.Duration
+		bob := New(client, "bob@desktop", WithWait(nil), WithTimeout(12*time.Second))
+		bob.now = func() time.Time { return now }
+		bob.sleep = func(d time.Duration) {
+			slept = append(slept, d)
+			now = now.Add(d)
+		}
+
+		// WHEN
+		_, err = bob.Lock("phonetool", "phonetool-test-api", "svc deploy")
+
+		// THEN
+		var errTimeout *ErrWaitTimeout
+		require.True(t, errors.As(err, &errTimeout))
+		require.EqualError(t, err, `timed out after 12s waiting for the lock: stack phonetool-test-api is locked by alice@laptop running "svc deploy" since 2023-03-01T10:00:00Z`)
+		require.Equal(t, []time.Duration{5 * time.Second, 5 * time.Second, 2 * time.Second}, slept)
+	})
+	t.Run("stops trying to acquire a lock that keeps changing hands", func(t *testing.T) {
+		// GIVEN
+		client := &churningSSM{fakeSSM: &fakeSSM{params: map[string]string{
+			"/copilot/applications/phonetool/locks/phonetool-test-api": "{}",
+		}}}
+		bob := New(client, "bob@desktop")
+
+		// WHEN
+		_, err := bob.Lock("phonetool", "phonetool-test-api", "svc deploy")
+
+		// THEN
+		require.EqualError(t, err, "acquire lock on stack phonetool-test-api: the lock was released and acquired again by someone else 3 times in a row")
+		require.Equal(t, 3, client.gets)
+	})
+	t.Run("doesn't release a lock that was force-released and acquired by someone else", func(t *testing.T) {
+		// GIVEN
+		client := &fakeSSM{params: make(map[string]string)}
+		alice := New(client, "alice@laptop")
+		release, err := alice.Lock("phonetool", "phonetool-test", "env deploy")
+		require.NoError(t, err)
+		bob := New(client, "bob@desktop")
+		require.NoError(t, bob.Release("phonetool", "phonetool-test"))
+		_, err = bob.Lock("phonetool", "phonetool-test", "env deploy")
+		require.NoError(t, err)
+
+		// WHEN
+		require.NoError(t, release())
+
+		// THEN
+		locks, err := alice.List("phonetool")
+		require.NoError(t, err)
+		require.Len(t, locks, 1)
+		require.Equal(t, "bob@desktop", locks[0].Owner)
+	})
+}
+
+func TestLocker_List(t *testing.T) {
+	// GIVEN
+	client := &fakeSSM{params: make(map[string]string)}
+	locker := New(client, "alice@laptop")
+	_, err := locker.Lock("phonetool", "phonetool-test-web", "svc deploy")
+	require.NoError(t, err)
+	_, err = locker.Lock("phonetool", "phonetool-test-api", "job deploy")
+	require.NoError(t, err)
+	_, err = New(client, "alice@laptop").Lock("other", "other-test-api", "svc deploy")
+	require.NoError(t, err)
+
+	// WHEN
+	locks, err := locker.List("phonetool")
+
+	// THEN
+	require.NoError(t, err)
+	require.Len(t, locks, 2)
+	require.Equal(t, "phonetool-test-api", locks[0].Stack)
+	require.Equal(t, "phonetool-test-web", locks[1].Stack)
+	require.NoError(t, locker.Release("phonetool", "phonetool-test-nothing"), "releasing an unlocked stack is a no-op")
+}
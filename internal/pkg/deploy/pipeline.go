@@ -35,9 +35,16 @@ const (
 
 	defaultPipelineBuildImage      = "aws/codebuild/amazonlinux2-x86_64-standard:4.0"
 	defaultPipelineEnvironmentType = "LINUX_CONTAINER"
+	defaultPipelineTestComputeType = "BUILD_GENERAL1_SMALL"
 
 	// DefaultPipelineArtifactsDir is the default folder to output Copilot-generated templates.
 	DefaultPipelineArtifactsDir = "infrastructure"
+
+	// BuildVariablesNamespace is the namespace of the variables exported by the build action of a pipeline.
+	BuildVariablesNamespace = "BuildVariables"
+
+	approvalDiffSummaryVariablePrefix = "COPILOT_DIFF_SUMMARY_"
+	approvalDiffURLVariablePrefix     = "COPILOT_DIFF_URL_"
 )
 
 var (
@@ -85,6 +92,33 @@ type CreatePipelineInput struct {
 	Version string
 }
 
+// HasApprovalDiffs returns true if any approval action of the pipeline shows the diff of the changes to approve.
+func (in *CreatePipelineInput) HasApprovalDiffs() bool {
+	for _, stage := range in.Stages {
+		if approval := stage.Approval(); approval != nil && approval.diff {
+			return true
+		}
+	}
+	return false
+}
+
+// ApprovalDiffSummaryVariable returns the name of the variable that the build action exports
+// with the summary of the changes to deploy to the environment.
+func ApprovalDiffSummaryVariable(env string) string {
+	return approvalDiffSummaryVariablePrefix + envVariableSuffix(env)
+}
+
+// ApprovalDiffURLVariable returns the name of the variable that the build action exports
+// with the link to the diff of the changes to deploy to the environment.
+func ApprovalDiffURLVariable(env string) string {
+	return approvalDiffURLVariablePrefix + envVariableSuffix(env)
+}
+
+// envVariableSuffix converts an environment name, such as "prod-eu", to "PROD_EU".
+func envVariableSuffix(env string) string {
+	return strings.ToUpper(strings.ReplaceAll(env, "-", "_"))
+}
+
 // Build represents CodeBuild project used in the CodePipeline
 // to build and test Docker image.
 type Build struct {
@@ -489,7 +523,9 @@ type associatedEnvironment struct {
 type PipelineStage struct {
 	*associatedEnvironment
 	requiresApproval  bool
+	approval          manifest.ApprovalConfig
 	testCommands      []string
+	test              manifest.StageTest
 	execRoleARN       string
 	envManagerRoleARN string
 	deployments       manifest.Deployments
@@ -515,7 +551,9 @@ func (stg *PipelineStage) Init(env *config.Environment, mftStage *manifest.Pipel
 
 	stg.deployments = deployments
 	stg.requiresApproval = mftStage.RequiresApproval
+	stg.approval = mftStage.Approval
 	stg.testCommands = mftStage.TestCommands
+	stg.test = mftStage.Test
 	stg.execRoleARN = env.ExecutionRoleARN
 	stg.envManagerRoleARN = env.ManagerRoleARN
 }
@@ -532,7 +570,11 @@ func (stg *PipelineStage) Approval() *ManualApprovalAction {
 		return nil
 	}
 	return &ManualApprovalAction{
-		name: stg.associatedEnvironment.Name,
+		name:     stg.associatedEnvironment.Name,
+		topicARN: stg.approval.Topic,
+		emails:   stg.approval.Emails,
+		message:  stg.approval.Message,
+		diff:     stg.approval.Diff,
 	}
 }
 
@@ -552,9 +594,9 @@ func (stg *PipelineStage) EnvManagerRoleARN() string {
 }
 
 // Test returns a test for the stage.
-// If the stage does not have any test commands, then returns nil.
+// If the stage does not have any test commands or buildspec, then returns nil.
 func (stg *PipelineStage) Test() (*TestCommandsAction, error) {
-	if len(stg.testCommands) == 0 {
+	if len(stg.testCommands) == 0 && stg.test.Buildspec == "" {
 		return nil, nil
 	}
 
@@ -571,7 +613,13 @@ func (stg *PipelineStage) Test() (*TestCommandsAction, error) {
 		action: action{
 			prevActions: prevActions,
 		},
-		commands: stg.testCommands,
+		commands:  stg.testCommands,
+		buildspec: filepath.ToSlash(stg.test.Buildspec),
+		image:     stg.test.Image,
+		compute:   stg.test.ComputeType,
+		variables: stg.test.Variables,
+		appName:   stg.AppName,
+		envName:   stg.associatedEnvironment.Name,
 	}, nil
 }
 
@@ -652,6 +700,11 @@ func (a *action) RunOrder() int {
 type ManualApprovalAction struct {
 	action
 	name string // Name of the stage to approve.
+
+	topicARN string   // Existing SNS topic notified when the action is waiting for approval.
+	emails   []string // Email addresses subscribed to the topic created for the action.
+	message  string
+	diff     bool // Whether to include the summary and link to the diff exported by the build action.
 }
 
 // Name returns the name of the CodePipeline approval action for the stage.
@@ -659,6 +712,41 @@ func (a *ManualApprovalAction) Name() string {
 	return fmt.Sprintf("ApprovePromotionTo-%s", a.name)
 }
 
+// TopicARN returns the ARN of the existing SNS topic to notify when the action is waiting for approval.
+func (a *ManualApprovalAction) TopicARN() string {
+	return a.topicARN
+}
+
+// Emails returns the email addresses to subscribe to a new SNS topic that is notified for the approval.
+func (a *ManualApprovalAction) Emails() []string {
+	return a.emails
+}
+
+// Message returns the additional information sent to the approvers.
+func (a *ManualApprovalAction) Message() string {
+	return a.message
+}
+
+// CustomData returns the message sent to the approvers, followed by the summary of the changes to approve if enabled.
+func (a *ManualApprovalAction) CustomData() string {
+	if !a.diff {
+		return a.message
+	}
+	summary := fmt.Sprintf("#{%s.%s}", BuildVariablesNamespace, ApprovalDiffSummaryVariable(a.name))
+	if a.message == "" {
+		return summary
+	}
+	return a.message + " " + summary
+}
+
+// DiffURL returns the link to the diff of the changes to approve, or an empty string if it isn't enabled.
+func (a *ManualApprovalAction) DiffURL() string {
+	if !a.diff {
+		return ""
+	}
+	return fmt.Sprintf("#{%s.%s}", BuildVariablesNamespace, ApprovalDiffURLVariable(a.name))
+}
+
 type ranker interface {
 	Rank(name string) (int, bool)
 }
@@ -717,7 +805,13 @@ func (a *DeployAction) RunOrder() int {
 // TestCommandsAction represents a CodePipeline action of category "Test" to validate deployments.
 type TestCommandsAction struct {
 	action
-	commands []string
+	commands  []string
+	buildspec string
+	image     string
+	compute   string
+	variables map[string]string // User defined environment variables.
+	appName   string
+	envName   string
 }
 
 // Name returns the name of the test action.
@@ -729,3 +823,44 @@ func (a *TestCommandsAction) Name() string {
 func (a *TestCommandsAction) Commands() []string {
 	return a.commands
 }
+
+// BuildspecPath returns the path of the buildspec in the source repository to run instead of the commands.
+func (a *TestCommandsAction) BuildspecPath() string {
+	return a.buildspec
+}
+
+// Image returns the URI of the Docker image used to run the test action.
+func (a *TestCommandsAction) Image() string {
+	if a.image == "" {
+		return defaultPipelineBuildImage
+	}
+	return a.image
+}
+
+// EnvironmentType returns the type of the CodeBuild environment that runs the test action.
+func (a *TestCommandsAction) EnvironmentType() string {
+	if strings.Contains(a.Image(), "aarch64") {
+		return "ARM_CONTAINER"
+	}
+	return defaultPipelineEnvironmentType
+}
+
+// ComputeType returns the compute resources used to run the test action.
+func (a *TestCommandsAction) ComputeType() string {
+	if a.compute == "" {
+		return defaultPipelineTestComputeType
+	}
+	return a.compute
+}
+
+// EnvironmentVariables returns the environment variables of the test action.
+// The names of the application and the environment of the stage are always injected.
+func (a *TestCommandsAction) EnvironmentVariables() map[string]string {
+	vars := make(map[string]string, len(a.variables)+2)
+	for k, v := range a.variables {
+		vars[k] = v
+	}
+	vars["COPILOT_APPLICATION_NAME"] = a.appName
+	vars["COPILOT_ENVIRONMENT_NAME"] = a.envName
+	return vars
+}
@@ -365,7 +365,11 @@ func TestPipelineStage_Init(t *testing.T) {
 	}, &manifest.PipelineStage{
 		Name:             "test",
 		RequiresApproval: true,
-		TestCommands:     []string{"make test", "echo \"made test\""},
+		Approval: manifest.ApprovalConfig{
+			Emails:  []string{"dev@example.com"},
+			Message: "Check the test environment.",
+		},
+		TestCommands: []string{"make test", "echo \"made test\""},
 	}, []string{"frontend", "backend"})
 
 	t.Run("stage name matches the environment's name", func(t *testing.T) {
@@ -387,6 +391,9 @@ func TestPipelineStage_Init(t *testing.T) {
 	})
 	t.Run("manual approval button", func(t *testing.T) {
 		require.NotNil(t, stg.Approval(), "should require approval action for stages when the manifest requires it")
+		require.Equal(t, []string{"dev@example.com"}, stg.Approval().Emails())
+		require.Equal(t, "Check the test environment.", stg.Approval().Message())
+		require.Empty(t, stg.Approval().TopicARN())
 
 		stg := PipelineStage{}
 		require.Nil(t, stg.Approval(), "should return nil by default")
@@ -535,6 +542,28 @@ func TestManualApprovalAction_Name(t *testing.T) {
 	require.Equal(t, "ApprovePromotionTo-test", action.Name())
 }
 
+func TestManualApprovalAction_Diff(t *testing.T) {
+	t.Run("without diff", func(t *testing.T) {
+		action := ManualApprovalAction{
+			name:    "prod-eu",
+			message: "Check the dashboards.",
+		}
+
+		require.Equal(t, "Check the dashboards.", action.CustomData())
+		require.Empty(t, action.DiffURL())
+	})
+	t.Run("with diff", func(t *testing.T) {
+		action := ManualApprovalAction{
+			name:    "prod-eu",
+			message: "Check the dashboards.",
+			diff:    true,
+		}
+
+		require.Equal(t, "Check the dashboards. #{BuildVariables.COPILOT_DIFF_SUMMARY_PROD_EU}", action.CustomData())
+		require.Equal(t, "#{BuildVariables.COPILOT_DIFF_URL_PROD_EU}", action.DiffURL())
+	})
+}
+
 func TestDeployAction_Name(t *testing.T) {
 	action := DeployAction{
 		name:    "frontend",
@@ -660,6 +689,77 @@ func TestTestCommandsAction_Name(t *testing.T) {
 	require.Equal(t, "TestCommands", (&TestCommandsAction{}).Name())
 }
 
+func TestPipelineStage_Test(t *testing.T) {
+	env := &config.Environment{
+		Name:   "test",
+		App:    "badgoose",
+		Region: "us-west-2",
+	}
+	t.Run("returns nil if there are no test commands or buildspec", func(t *testing.T) {
+		var stg PipelineStage
+		stg.Init(env, &manifest.PipelineStage{Name: "test"}, []string{"api"})
+
+		got, err := stg.Test()
+
+		require.NoError(t, err)
+		require.Nil(t, got)
+	})
+	t.Run("runs a buildspec after the deployments with the defaults", func(t *testing.T) {
+		var stg PipelineStage
+		stg.Init(env, &manifest.PipelineStage{
+			Name: "test",
+			Test: manifest.StageTest{
+				Buildspec: "copilot/pipelines/release/smoke.yml",
+			},
+		}, []string{"api"})
+
+		got, err := stg.Test()
+
+		require.NoError(t, err)
+		require.Equal(t, "copilot/pipelines/release/smoke.yml", got.BuildspecPath())
+		require.Equal(t, "aws/codebuild/amazonlinux2-x86_64-standard:4.0", got.Image())
+		require.Equal(t, "LINUX_CONTAINER", got.EnvironmentType())
+		require.Equal(t, "BUILD_GENERAL1_SMALL", got.ComputeType())
+		require.Equal(t, 2, got.RunOrder(), "should run after the deployments")
+	})
+	t.Run("uses the configured image and compute type", func(t *testing.T) {
+		var stg PipelineStage
+		stg.Init(env, &manifest.PipelineStage{
+			Name:         "test",
+			TestCommands: []string{"make smoke-test"},
+			Test: manifest.StageTest{
+				Image:       "aws/codebuild/amazonlinux2-aarch64-standard:3.0",
+				ComputeType: "BUILD_GENERAL1_LARGE",
+			},
+		}, []string{"api"})
+
+		got, err := stg.Test()
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"make smoke-test"}, got.Commands())
+		require.Equal(t, "aws/codebuild/amazonlinux2-aarch64-standard:3.0", got.Image())
+		require.Equal(t, "ARM_CONTAINER", got.EnvironmentType())
+		require.Equal(t, "BUILD_GENERAL1_LARGE", got.ComputeType())
+	})
+}
+
+func TestTestCommandsAction_EnvironmentVariables(t *testing.T) {
+	action := TestCommandsAction{
+		appName: "badgoose",
+		envName: "test",
+		variables: map[string]string{
+			"ENDPOINT":                 "https://test.example.com",
+			"COPILOT_ENVIRONMENT_NAME": "prod",
+		},
+	}
+
+	require.Equal(t, map[string]string{
+		"ENDPOINT":                 "https://test.example.com",
+		"COPILOT_APPLICATION_NAME": "badgoose",
+		"COPILOT_ENVIRONMENT_NAME": "test",
+	}, action.EnvironmentVariables(), "should inject the names of the application and environment of the stage")
+}
+
 func TestParseRepo(t *testing.T) {
 	testCases := map[string]struct {
 		src           *CodeCommitSource
@@ -0,0 +1,222 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package quota compares the resources that a deployment adds to an account with the service quotas of the account,
+// so that a deployment that would exceed a quota is stopped before CloudFormation creates any resource.
+package quota
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
+	"github.com/aws/copilot-cli/internal/pkg/aws/servicequotas"
+)
+
+const (
+	// Usage from which a quota is reported as close to its limit.
+	nearLimitThreshold = 0.8
+	// Period over which the usage of Fargate vCPUs is measured.
+	fargateUsagePeriod = 15 * time.Minute
+)
+
+// Quota identifies a quota in Service Quotas.
+type Quota struct {
+	Name        string
+	ServiceCode string
+	Code        string
+}
+
+// Quotas checked before deployments.
+var (
+	FargateOnDemandVCPUs = Quota{
+		Name:        "Fargate On-Demand vCPU resource count",
+		ServiceCode: "fargate",
+		Code:        "L-3032A538",
+	}
+	RulesPerALB = Quota{
+		Name:        "Rules per Application Load Balancer",
+		ServiceCode: "elasticloadbalancing",
+		Code:        "L-93826ACB",
+	}
+	VPCElasticIPs = Quota{
+		Name:        "EC2-VPC Elastic IPs",
+		ServiceCode: "ec2",
+		Code:        "L-0263D0A3",
+	}
+)
+
+type quotaService interface {
+	Quota(serviceCode, quotaCode string) (float64, error)
+	RequestIncrease(serviceCode, quotaCode string, desired float64) (string, error)
+}
+
+type metricMaximumGetter interface {
+	MetricMaximum(query cloudwatch.MetricQuery) (*float64, error)
+}
+
+type listenerRuleCounter interface {
+	ListenerRuleCount(lbARN string) (int, error)
+}
+
+type elasticIPCounter interface {
+	ElasticIPCount() (int, error)
+}
+
+// Needs are the resources that a deployment adds to the account.
+type Needs struct {
+	FargateVCPUs    float64 // vCPUs of the tasks placed on Fargate On-Demand capacity.
+	LoadBalancerARN string  // Application Load Balancer that the listener rules are added to.
+	ListenerRules   int
+	ElasticIPs      int
+}
+
+// Usage is the usage of a quota once a deployment is done.
+type Usage struct {
+	Quota
+	Used   float64 // Used before the deployment.
+	Needed float64 // Added by the deployment.
+	Limit  float64
+}
+
+// Exceeded returns true if the deployment needs more than what is left of the quota.
+func (u Usage) Exceeded() bool {
+	return u.Used+u.Needed > u.Limit
+}
+
+// NearLimit returns true if the deployment leaves less than a fifth of the quota.
+func (u Usage) NearLimit() bool {
+	return u.Used+u.Needed >= u.Limit*nearLimitThreshold
+}
+
+// String returns a summary of the usage such as "Fargate On-Demand vCPU resource count: 6 used + 4 needed > 8".
+func (u Usage) String() string {
+	op := "<="
+	if u.Exceeded() {
+		op = ">"
+	}
+	return fmt.Sprintf("%s: %g used + %g needed %s %g", u.Name, u.Used, u.Needed, op, u.Limit)
+}
+
+// ErrInsufficientQuota occurs when a deployment would exceed a quota of the account.
+type ErrInsufficientQuota struct {
+	Exceeded []Usage
+}
+
+func (e *ErrInsufficientQuota) Error() string {
+	if len(e.Exceeded) == 1 {
+		return fmt.Sprintf("deployment would exceed quota %s", e.Exceeded[0])
+	}
+	return fmt.Sprintf("deployment would exceed %d quotas, starting with %s", len(e.Exceeded), e.Exceeded[0])
+}
+
+// Checker compares the needs of deployments with the quotas of an account in a region.
+type Checker struct {
+	quotas    quotaService
+	metrics   metricMaximumGetter
+	rules     listenerRuleCounter
+	addresses elasticIPCounter
+	now       func() time.Time
+}
+
+// New returns a Checker of the quotas in the account and region of the session.
+func New(sess *session.Session) *Checker {
+	return &Checker{
+		quotas:    servicequotas.New(sess),
+		metrics:   cloudwatch.New(sess),
+		rules:     elbv2.New(sess),
+		addresses: ec2.New(sess),
+		now:       time.Now,
+	}
+}
+
+// Check returns the usage of each quota that the deployment needs.
+// It returns an ErrInsufficientQuota along with the usages if any quota would be exceeded.
+func (c *Checker) Check(needs Needs) ([]Usage, error) {
+	var usages []Usage
+	if needs.FargateVCPUs > 0 {
+		usage, err := c.usage(FargateOnDemandVCPUs, needs.FargateVCPUs, c.fargateVCPUsUsed)
+		if err != nil {
+			return nil, err
+		}
+		usages = append(usages, usage)
+	}
+	if needs.ListenerRules > 0 && needs.LoadBalancerARN != "" {
+		usage, err := c.usage(RulesPerALB, float64(needs.ListenerRules), func() (float64, error) {
+			count, err := c.rules.ListenerRuleCount(needs.LoadBalancerARN)
+			return float64(count), err
+		})
+		if err != nil {
+			return nil, err
+		}
+		usages = append(usages, usage)
+	}
+	if needs.ElasticIPs > 0 {
+		usage, err := c.usage(VPCElasticIPs, float64(needs.ElasticIPs), func() (float64, error) {
+			count, err := c.addresses.ElasticIPCount()
+			return float64(count), err
+		})
+		if err != nil {
+			return nil, err
+		}
+		usages = append(usages, usage)
+	}
+	var exceeded []Usage
+	for _, usage := range usages {
+		if usage.Exceeded() {
+			exceeded = append(exceeded, usage)
+		}
+	}
+	if len(exceeded) > 0 {
+		return usages, &ErrInsufficientQuota{Exceeded: exceeded}
+	}
+	return usages, nil
+}
+
+func (c *Checker) usage(quota Quota, needed float64, used func() (float64, error)) (Usage, error) {
+	limit, err := c.quotas.Quota(quota.ServiceCode, quota.Code)
+	if err != nil {
+		return Usage{}, err
+	}
+	current, err := used()
+	if err != nil {
+		return Usage{}, fmt.Errorf("get usage of quota %q: %w", quota.Name, err)
+	}
+	return Usage{
+		Quota:  quota,
+		Used:   current,
+		Needed: needed,
+		Limit:  limit,
+	}, nil
+}
+
+// fargateVCPUsUsed returns the peak of vCPUs used by the Fargate On-Demand tasks of the account over the last minutes.
+func (c *Checker) fargateVCPUsUsed() (float64, error) {
+	end := c.now().Truncate(time.Minute)
+	used, err := c.metrics.MetricMaximum(cloudwatch.MetricQuery{
+		Namespace: "AWS/Usage",
+		Name:      "ResourceCount",
+		Dimensions: map[string]string{
+			"Service":  "Fargate",
+			"Type":     "Resource",
+			"Resource": "vCPU",
+			"Class":    "Standard/OnDemand",
+		},
+		StartTime: end.Add(-fargateUsagePeriod),
+		EndTime:   end,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return aws.Float64Value(used), nil
+}
+
+// RequestIncrease files a request to raise a quota to what the deployment needs, and returns the ID of the request.
+func (c *Checker) RequestIncrease(usage Usage) (string, error) {
+	return c.quotas.RequestIncrease(usage.ServiceCode, usage.Code, math.Ceil(usage.Used+usage.Needed))
+}
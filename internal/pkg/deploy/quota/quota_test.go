@@ -0,0 +1,172 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package quota
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeQuotas struct {
+	limits    map[string]float64
+	err       error
+	requested map[string]float64
+}
+
+func (f *fakeQuotas) Quota(_, quotaCode string) (float64, error) {
+	return f.limits[quotaCode], f.err
+}
+
+func (f *fakeQuotas) RequestIncrease(_, quotaCode string, desired float64) (string, error) {
+	f.requested[quotaCode] = desired
+	return "request-" + quotaCode, nil
+}
+
+type fakeUsage struct {
+	vCPUs *float64
+	rules map[string]int
+	eips  int
+}
+
+func (f *fakeUsage) MetricMaximum(query cloudwatch.MetricQuery) (*float64, error) {
+	if query.Dimensions["Resource"] != "vCPU" {
+		return nil, errors.New("unexpected metric")
+	}
+	return f.vCPUs, nil
+}
+
+func (f *fakeUsage) ListenerRuleCount(lbARN string) (int, error) {
+	count, ok := f.rules[lbARN]
+	if !ok {
+		return 0, errors.New("load balancer not found")
+	}
+	return count, nil
+}
+
+func (f *fakeUsage) ElasticIPCount() (int, error) {
+	return f.eips, nil
+}
+
+func TestChecker_Check(t *testing.T) {
+	const albARN = "arn:aws:elasticloadbalancing:us-west-2:1111:loadbalancer/app/demo-Publi-1A2B3C/50dc6c495c0c9188"
+	limits := map[string]float64{
+		FargateOnDemandVCPUs.Code: 8,
+		RulesPerALB.Code:          100,
+		VPCElasticIPs.Code:        5,
+	}
+	testCases := map[string]struct {
+		needs     Needs
+		usage     *fakeUsage
+		quotasErr error
+
+		wanted    []Usage
+		wantedErr error
+	}{
+		"checks nothing if the deployment adds no resource": {
+			needs: Needs{},
+			usage: &fakeUsage{},
+		},
+		"errors if a quota can't be retrieved": {
+			needs:     Needs{FargateVCPUs: 1},
+			usage:     &fakeUsage{},
+			quotasErr: errors.New("access denied"),
+			wantedErr: errors.New("access denied"),
+		},
+		"errors if a usage can't be retrieved": {
+			needs: Needs{
+				LoadBalancerARN: "arn:aws:elasticloadbalancing:us-west-2:1111:loadbalancer/app/other/1",
+				ListenerRules:   1,
+			},
+			usage:     &fakeUsage{},
+			wantedErr: errors.New(`get usage of quota "Rules per Application Load Balancer": load balancer not found`),
+		},
+		"returns the usages within the quotas": {
+			needs: Needs{
+				FargateVCPUs:    2,
+				LoadBalancerARN: albARN,
+				ListenerRules:   2,
+				ElasticIPs:      3,
+			},
+			usage: &fakeUsage{
+				vCPUs: aws.Float64(4),
+				rules: map[string]int{albARN: 10},
+				eips:  2,
+			},
+			wanted: []Usage{
+				{Quota: FargateOnDemandVCPUs, Used: 4, Needed: 2, Limit: 8},
+				{Quota: RulesPerALB, Used: 10, Needed: 2, Limit: 100},
+				{Quota: VPCElasticIPs, Used: 2, Needed: 3, Limit: 5},
+			},
+		},
+		"treats a missing usage metric as no usage": {
+			needs:  Needs{FargateVCPUs: 0.25},
+			usage:  &fakeUsage{},
+			wanted: []Usage{{Quota: FargateOnDemandVCPUs, Used: 0, Needed: 0.25, Limit: 8}},
+		},
+		"returns the exceeded quotas": {
+			needs: Needs{
+				FargateVCPUs: 4,
+				ElasticIPs:   3,
+			},
+			usage: &fakeUsage{
+				vCPUs: aws.Float64(6),
+				eips:  3,
+			},
+			wanted: []Usage{
+				{Quota: FargateOnDemandVCPUs, Used: 6, Needed: 4, Limit: 8},
+				{Quota: VPCElasticIPs, Used: 3, Needed: 3, Limit: 5},
+			},
+			wantedErr: errors.New("deployment would exceed 2 quotas, starting with Fargate On-Demand vCPU resource count: 6 used + 4 needed > 8"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			checker := &Checker{
+				quotas:    &fakeQuotas{limits: limits, err: tc.quotasErr},
+				metrics:   tc.usage,
+				rules:     tc.usage,
+				addresses: tc.usage,
+				now:       func() time.Time { return time.Date(2023, time.March, 1, 10, 0, 30, 0, time.UTC) },
+			}
+
+			// WHEN
+			got, err := checker.Check(tc.needs)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestUsage_NearLimit(t *testing.T) {
+	require.False(t, Usage{Used: 5, Needed: 2, Limit: 10}.NearLimit())
+	require.True(t, Usage{Used: 6, Needed: 2, Limit: 10}.NearLimit())
+	require.False(t, Usage{Used: 6, Needed: 2, Limit: 10}.Exceeded())
+}
+
+func TestChecker_RequestIncrease(t *testing.T) {
+	// GIVEN
+	quotas := &fakeQuotas{requested: make(map[string]float64)}
+	checker := &Checker{quotas: quotas}
+
+	// WHEN
+	id, err := checker.RequestIncrease(Usage{Quota: FargateOnDemandVCPUs, Used: 6, Needed: 2.5, Limit: 8})
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "request-L-3032A538", id)
+	require.Equal(t, map[string]float64{"L-3032A538": 9}, quotas.requested)
+}
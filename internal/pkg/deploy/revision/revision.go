@@ -0,0 +1,219 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package revision records the deployments of workload stacks in the artifact bucket,
+// so that they can be audited and rolled back to.
+package revision
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
+	"github.com/aws/copilot-cli/internal/pkg/template/diff"
+)
+
+type s3Client interface {
+	Upload(bucket, key string, data io.Reader) (string, error)
+	ObjectKeys(bucket, prefix string) ([]string, error)
+	Download(bucket, key string) ([]byte, error)
+}
+
+// Store reads and writes the deployment revisions of workload stacks in an S3 bucket.
+type Store struct {
+	s3     s3Client
+	bucket string
+}
+
+// NewStore returns a Store of revisions in the bucket.
+func NewStore(s3 s3Client, bucket string) *Store {
+	return &Store{
+		s3:     s3,
+		bucket: bucket,
+	}
+}
+
+// ErrNotFound is returned when a revision of a stack does not exist.
+type ErrNotFound struct {
+	StackName string
+	ID        string
+	Available []string
+}
+
+// Error implements the error interface.
+func (e *ErrNotFound) Error() string {
+	if len(e.Available) == 0 {
+		return fmt.Sprintf("deployment %s of stack %s not found: no deployments are recorded", e.ID, e.StackName)
+	}
+	return fmt.Sprintf("deployment %s of stack %s not found: recorded deployments are %s", e.ID, e.StackName, strings.Join(e.Available, ", "))
+}
+
+// Record stores the revision, along with the changes of its template from the revision recorded before it.
+func (s *Store) Record(rev *stack.Revision) error {
+	if err := s.diffFromPrevious(rev); err != nil {
+		return err
+	}
+	content, err := rev.Marshal()
+	if err != nil {
+		return err
+	}
+	if _, err := s.s3.Upload(s.bucket, artifactpath.Deployment(rev.StackName(), rev.ID), bytes.NewReader(content)); err != nil {
+		return fmt.Errorf("upload deployment revision %s of stack %s: %w", rev.ID, rev.StackName(), err)
+	}
+	return nil
+}
+
+// List returns the IDs of the revisions of a stack, from the oldest to the most recent.
+func (s *Store) List(stackName string) ([]string, error) {
+	ids, err := s.ids(stackName, ".json")
+	if err != nil {
+		return nil, fmt.Errorf("list deployment revisions of stack %s: %w", stackName, err)
+	}
+	return ids, nil
+}
+
+// History returns the revisions of a stack, from the oldest to the most recent.
+func (s *Store) History(stackName string) ([]*stack.Revision, error) {
+	ids, err := s.List(stackName)
+	if err != nil {
+		return nil, err
+	}
+	revs := make([]*stack.Revision, len(ids))
+	for i, id := range ids {
+		rev, err := s.download(stackName, id)
+		if err != nil {
+			return nil, err
+		}
+		revs[i] = rev
+	}
+	return revs, nil
+}
+
+// Get returns the revision of a stack with the ID.
+func (s *Store) Get(stackName, id string) (*stack.Revision, error) {
+	ids, err := s.List(stackName)
+	if err != nil {
+		return nil, err
+	}
+	if !contains(ids, id) {
+		return nil, &ErrNotFound{
+			StackName: stackName,
+			ID:        id,
+			Available: ids,
+		}
+	}
+	return s.download(stackName, id)
+}
+
+// RecordLog stores the log of a deployment of a stack.
+// Logs are recorded for failed deployments too, which have no revision.
+func (s *Store) RecordLog(stackName, id string, content []byte) error {
+	if _, err := s.s3.Upload(s.bucket, artifactpath.DeploymentLog(stackName, id), bytes.NewReader(content)); err != nil {
+		return fmt.Errorf("upload log of deployment %s of stack %s: %w", id, stackName, err)
+	}
+	return nil
+}
+
+// LogIDs returns the IDs of the deployments of a stack that have a log, from the oldest to the most recent.
+func (s *Store) LogIDs(stackName string) ([]string, error) {
+	ids, err := s.ids(stackName, ".log")
+	if err != nil {
+		return nil, fmt.Errorf("list deployment logs of stack %s: %w", stackName, err)
+	}
+	return ids, nil
+}
+
+// Log returns the log of the deployment of a stack with the ID.
+func (s *Store) Log(stackName, id string) ([]byte, error) {
+	ids, err := s.LogIDs(stackName)
+	if err != nil {
+		return nil, err
+	}
+	if !contains(ids, id) {
+		return nil, &ErrNotFound{
+			StackName: stackName,
+			ID:        id,
+			Available: ids,
+		}
+	}
+	content, err := s.s3.Download(s.bucket, artifactpath.DeploymentLog(stackName, id))
+	if err != nil {
+		return nil, fmt.Errorf("download log of deployment %s of stack %s: %w", id, stackName, err)
+	}
+	return content, nil
+}
+
+// ids returns the sorted IDs of the objects of a stack's deployments with the file extension.
+func (s *Store) ids(stackName, ext string) ([]string, error) {
+	keys, err := s.s3.ObjectKeys(s.bucket, artifactpath.Deployments(stackName))
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if path.Ext(key) != ext {
+			continue
+		}
+		ids = append(ids, strings.TrimSuffix(path.Base(key), ext))
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func contains(ids []string, id string) bool {
+	for _, recorded := range ids {
+		if recorded == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Store) download(stackName, id string) (*stack.Revision, error) {
+	content, err := s.s3.Download(s.bucket, artifactpath.Deployment(stackName, id))
+	if err != nil {
+		return nil, fmt.Errorf("download deployment revision %s of stack %s: %w", id, stackName, err)
+	}
+	return stack.ParseRevision(content)
+}
+
+// diffFromPrevious sets the diff of the revision against the latest revision recorded before it.
+// The first revision of a stack is diffed against an empty template.
+func (s *Store) diffFromPrevious(rev *stack.Revision) error {
+	ids, err := s.List(rev.StackName())
+	if err != nil {
+		return err
+	}
+	var from string
+	for i := len(ids) - 1; i >= 0; i-- {
+		if ids[i] >= rev.ID {
+			continue
+		}
+		prev, err := s.download(rev.StackName(), ids[i])
+		if err != nil {
+			return err
+		}
+		from = prev.TemplateBody
+		break
+	}
+	tree, err := diff.From(from).ParseWithCFNOverriders([]byte(rev.TemplateBody))
+	if err != nil {
+		return fmt.Errorf("diff the template of deployment revision %s of stack %s: %w", rev.ID, rev.StackName(), err)
+	}
+	if !tree.HasChanges() {
+		rev.DiffSummary, rev.Diff = "", ""
+		return nil
+	}
+	buf := new(strings.Builder)
+	if err := tree.Write(buf, diff.WithColor(false)); err != nil {
+		return fmt.Errorf("write the diff of deployment revision %s of stack %s: %w", rev.ID, rev.StackName(), err)
+	}
+	rev.DiffSummary = tree.Summary().String()
+	rev.Diff = buf.String()
+	return nil
+}
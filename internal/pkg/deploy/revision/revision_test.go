@@ -0,0 +1,421 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package revision
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/revision/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mockBucket    = "mockBucket"
+	mockStackName = "phonetool-test-frontend"
+)
+
+func TestStore_Record(t *testing.T) {
+	const template = `Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 2
+`
+	newRev := func() *stack.Revision {
+		return &stack.Revision{
+			ID:              "20230102-150405",
+			DeployedAt:      time.Date(2023, time.January, 2, 15, 4, 5, 0, time.UTC),
+			Name:            mockStackName,
+			TemplateBody:    template,
+			ParameterValues: map[string]string{},
+		}
+	}
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mocks3Client)
+
+		wantedDiffSummary string
+		wantedDiff        string
+		wantedErr         error
+	}{
+		"should return a wrapped error if the previous revisions cannot be listed": {
+			setupMocks: func(m *mocks.Mocks3Client) {
+				m.EXPECT().ObjectKeys(mockBucket, "manual/deployments/phonetool-test-frontend/").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list deployment revisions of stack phonetool-test-frontend: some error"),
+		},
+		"should return a wrapped error if the previous revision cannot be downloaded": {
+			setupMocks: func(m *mocks.Mocks3Client) {
+				m.EXPECT().ObjectKeys(mockBucket, gomock.Any()).Return([]string{
+					"manual/deployments/phonetool-test-frontend/20230101-000000.json",
+				}, nil)
+				m.EXPECT().Download(mockBucket, "manual/deployments/phonetool-test-frontend/20230101-000000.json").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("download deployment revision 20230101-000000 of stack phonetool-test-frontend: some error"),
+		},
+		"should return a wrapped error if the upload fails": {
+			setupMocks: func(m *mocks.Mocks3Client) {
+				m.EXPECT().ObjectKeys(mockBucket, gomock.Any()).Return(nil, nil)
+				m.EXPECT().Upload(mockBucket, "manual/deployments/phonetool-test-frontend/20230102-150405.json", gomock.Any()).
+					Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("upload deployment revision 20230102-150405 of stack phonetool-test-frontend: some error"),
+		},
+		"should upload the revision": {
+			setupMocks: func(m *mocks.Mocks3Client) {
+				m.EXPECT().ObjectKeys(mockBucket, gomock.Any()).Return(nil, nil)
+				m.EXPECT().Upload(mockBucket, "manual/deployments/phonetool-test-frontend/20230102-150405.json", gomock.Any()).
+					DoAndReturn(func(_, _ string, data io.Reader) (string, error) {
+						content, err := io.ReadAll(data)
+						require.NoError(t, err)
+						got, err := stack.ParseRevision(content)
+						require.NoError(t, err)
+						wanted := newRev()
+						wanted.DiffSummary = "1 resource added, 0 modified, 0 removed"
+						wanted.Diff = `+ Resources:
++     Service:
++         Type: AWS::ECS::Service
++         Properties:
++             DesiredCount: 2
+`
+						require.Equal(t, wanted, got)
+						return "", nil
+					})
+			},
+			wantedDiffSummary: "1 resource added, 0 modified, 0 removed",
+			wantedDiff: `+ Resources:
++     Service:
++         Type: AWS::ECS::Service
++         Properties:
++             DesiredCount: 2
+`,
+		},
+		"should diff the first revision of a stack against an empty template": {
+			setupMocks: func(m *mocks.Mocks3Client) {
+				m.EXPECT().ObjectKeys(mockBucket, gomock.Any()).Return(nil, nil)
+				m.EXPECT().Upload(mockBucket, "manual/deployments/phonetool-test-frontend/20230102-150405.json", gomock.Any()).Return("", nil)
+			},
+			wantedDiffSummary: "1 resource added, 0 modified, 0 removed",
+			wantedDiff: `+ Resources:
++     Service:
++         Type: AWS::ECS::Service
++         Properties:
++             DesiredCount: 2
+`,
+		},
+		"should diff the revision against the latest revision recorded before it": {
+			setupMocks: func(m *mocks.Mocks3Client) {
+				m.EXPECT().ObjectKeys(mockBucket, gomock.Any()).Return([]string{
+					"manual/deployments/phonetool-test-frontend/20230101-000000.json",
+					"manual/deployments/phonetool-test-frontend/20230101-120000.json",
+				}, nil)
+				m.EXPECT().Download(mockBucket, "manual/deployments/phonetool-test-frontend/20230101-120000.json").Return([]byte(`{
+  "id": "20230101-120000",
+  "stackName": "phonetool-test-frontend",
+  "template": "Resources:\n  Service:\n    Type: AWS::ECS::Service\n    Properties:\n      DesiredCount: 1\n"
+}`), nil)
+				m.EXPECT().Upload(mockBucket, "manual/deployments/phonetool-test-frontend/20230102-150405.json", gomock.Any()).
+					DoAndReturn(func(_, _ string, data io.Reader) (string, error) {
+						content, err := io.ReadAll(data)
+						require.NoError(t, err)
+						got, err := stack.ParseRevision(content)
+						require.NoError(t, err)
+						require.Equal(t, "0 resources added, 1 modified, 0 removed", got.DiffSummary)
+						require.Equal(t, `~ Resources/Service/Properties:
+    ~ DesiredCount: 1 -> 2
+`, got.Diff)
+						return "", nil
+					})
+			},
+			wantedDiffSummary: "0 resources added, 1 modified, 0 removed",
+			wantedDiff: `~ Resources/Service/Properties:
+    ~ DesiredCount: 1 -> 2
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocks3Client(ctrl)
+			tc.setupMocks(m)
+			store := NewStore(m, mockBucket)
+			rev := newRev()
+
+			// WHEN
+			err := store.Record(rev)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDiffSummary, rev.DiffSummary)
+			require.Equal(t, tc.wantedDiff, rev.Diff)
+		})
+	}
+}
+
+func TestStore_List(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mocks3Client)
+
+		wanted    []string
+		wantedErr error
+	}{
+		"should return a wrapped error if the objects cannot be listed": {
+			setupMocks: func(m *mocks.Mocks3Client) {
+				m.EXPECT().ObjectKeys(mockBucket, "manual/deployments/phonetool-test-frontend/").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list deployment revisions of stack phonetool-test-frontend: some error"),
+		},
+		"should return the sorted IDs of the revisions": {
+			setupMocks: func(m *mocks.Mocks3Client) {
+				m.EXPECT().ObjectKeys(mockBucket, "manual/deployments/phonetool-test-frontend/").Return([]string{
+					"manual/deployments/phonetool-test-frontend/20230103-000000.json",
+					"manual/deployments/phonetool-test-frontend/20230102-150405.json",
+					"manual/deployments/phonetool-test-frontend/20230102-150405.log",
+					"manual/deployments/phonetool-test-frontend/README",
+				}, nil)
+			},
+			wanted: []string{"20230102-150405", "20230103-000000"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocks3Client(ctrl)
+			tc.setupMocks(m)
+			store := NewStore(m, mockBucket)
+
+			// WHEN
+			got, err := store.List(mockStackName)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestStore_Get(t *testing.T) {
+	testCases := map[string]struct {
+		inID       string
+		setupMocks func(m *mocks.Mocks3Client)
+
+		wanted    *stack.Revision
+		wantedErr error
+	}{
+		"should return ErrNotFound with the recorded IDs if the revision does not exist": {
+			inID: "20230101-000000",
+			setupMocks: func(m *mocks.Mocks3Client) {
+				m.EXPECT().ObjectKeys(mockBucket, gomock.Any()).Return([]string{
+					"manual/deployments/phonetool-test-frontend/20230102-150405.json",
+					"manual/deployments/phonetool-test-frontend/20230103-000000.json",
+				}, nil)
+			},
+			wantedErr: errors.New("deployment 20230101-000000 of stack phonetool-test-frontend not found: recorded deployments are 20230102-150405, 20230103-000000"),
+		},
+		"should return a wrapped error if the revision cannot be downloaded": {
+			inID: "20230102-150405",
+			setupMocks: func(m *mocks.Mocks3Client) {
+				m.EXPECT().ObjectKeys(mockBucket, gomock.Any()).Return([]string{
+					"manual/deployments/phonetool-test-frontend/20230102-150405.json",
+				}, nil)
+				m.EXPECT().Download(mockBucket, "manual/deployments/phonetool-test-frontend/20230102-150405.json").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("download deployment revision 20230102-150405 of stack phonetool-test-frontend: some error"),
+		},
+		"should return the revision": {
+			inID: "20230102-150405",
+			setupMocks: func(m *mocks.Mocks3Client) {
+				m.EXPECT().ObjectKeys(mockBucket, gomock.Any()).Return([]string{
+					"manual/deployments/phonetool-test-frontend/20230102-150405.json",
+				}, nil)
+				m.EXPECT().Download(mockBucket, "manual/deployments/phonetool-test-frontend/20230102-150405.json").Return([]byte(`{
+  "id": "20230102-150405",
+  "deployedAt": "2023-01-02T15:04:05Z",
+  "stackName": "phonetool-test-frontend",
+  "template": "Resources: {}\n",
+  "parameters": {
+    "ContainerImage": "1234.dkr.ecr.us-west-2.amazonaws.com/frontend@sha256:main"
+  }
+}`), nil)
+			},
+			wanted: &stack.Revision{
+				ID:           "20230102-150405",
+				DeployedAt:   time.Date(2023, time.January, 2, 15, 4, 5, 0, time.UTC),
+				Name:         mockStackName,
+				TemplateBody: "Resources: {}\n",
+				ParameterValues: map[string]string{
+					"ContainerImage": "1234.dkr.ecr.us-west-2.amazonaws.com/frontend@sha256:main",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocks3Client(ctrl)
+			tc.setupMocks(m)
+			store := NewStore(m, mockBucket)
+
+			// WHEN
+			got, err := store.Get(mockStackName, tc.inID)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestStore_History(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m *mocks.Mocks3Client)
+
+		wanted    []*stack.Revision
+		wantedErr error
+	}{
+		"should return a wrapped error if a revision cannot be downloaded": {
+			setupMocks: func(m *mocks.Mocks3Client) {
+				m.EXPECT().ObjectKeys(mockBucket, gomock.Any()).Return([]string{
+					"manual/deployments/phonetool-test-frontend/20230102-150405.json",
+				}, nil)
+				m.EXPECT().Download(mockBucket, gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("download deployment revision 20230102-150405 of stack phonetool-test-frontend: some error"),
+		},
+		"should return the revisions from the oldest to the most recent": {
+			setupMocks: func(m *mocks.Mocks3Client) {
+				m.EXPECT().ObjectKeys(mockBucket, gomock.Any()).Return([]string{
+					"manual/deployments/phonetool-test-frontend/20230103-000000.json",
+					"manual/deployments/phonetool-test-frontend/20230102-150405.json",
+				}, nil)
+				m.EXPECT().Download(mockBucket, "manual/deployments/phonetool-test-frontend/20230102-150405.json").
+					Return([]byte(`{"id": "20230102-150405", "stackName": "phonetool-test-frontend", "gitCommit": "a1b2c3d"}`), nil)
+				m.EXPECT().Download(mockBucket, "manual/deployments/phonetool-test-frontend/20230103-000000.json").
+					Return([]byte(`{"id": "20230103-000000", "stackName": "phonetool-test-frontend", "gitCommit": "e4f5a6b"}`), nil)
+			},
+			wanted: []*stack.Revision{
+				{
+					ID:        "20230102-150405",
+					Name:      mockStackName,
+					GitCommit: "a1b2c3d",
+				},
+				{
+					ID:        "20230103-000000",
+					Name:      mockStackName,
+					GitCommit: "e4f5a6b",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocks3Client(ctrl)
+			tc.setupMocks(m)
+			store := NewStore(m, mockBucket)
+
+			// WHEN
+			got, err := store.History(mockStackName)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestStore_Log(t *testing.T) {
+	testCases := map[string]struct {
+		id         string
+		setupMocks func(m *mocks.Mocks3Client)
+
+		wanted    string
+		wantedErr error
+	}{
+		"should return an ErrNotFound if the deployment has no log": {
+			id: "20230101-000000",
+			setupMocks: func(m *mocks.Mocks3Client) {
+				m.EXPECT().ObjectKeys(mockBucket, "manual/deployments/phonetool-test-frontend/").Return([]string{
+					"manual/deployments/phonetool-test-frontend/20230101-000000.json",
+					"manual/deployments/phonetool-test-frontend/20230102-150405.log",
+				}, nil)
+			},
+			wantedErr: errors.New("deployment 20230101-000000 of stack phonetool-test-frontend not found: recorded deployments are 20230102-150405"),
+		},
+		"should return a wrapped error if the log cannot be downloaded": {
+			id: "20230102-150405",
+			setupMocks: func(m *mocks.Mocks3Client) {
+				m.EXPECT().ObjectKeys(mockBucket, gomock.Any()).Return([]string{
+					"manual/deployments/phonetool-test-frontend/20230102-150405.log",
+				}, nil)
+				m.EXPECT().Download(mockBucket, "manual/deployments/phonetool-test-frontend/20230102-150405.log").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("download log of deployment 20230102-150405 of stack phonetool-test-frontend: some error"),
+		},
+		"should return the log of a failed deployment": {
+			id: "20230102-150405",
+			setupMocks: func(m *mocks.Mocks3Client) {
+				m.EXPECT().ObjectKeys(mockBucket, gomock.Any()).Return([]string{
+					"manual/deployments/phonetool-test-frontend/20230102-150405.log",
+				}, nil)
+				m.EXPECT().Download(mockBucket, "manual/deployments/phonetool-test-frontend/20230102-150405.log").Return([]byte("UPDATE_FAILED"), nil)
+			},
+			wanted: "UPDATE_FAILED",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMocks3Client(ctrl)
+			tc.setupMocks(m)
+			store := NewStore(m, mockBucket)
+
+			// WHEN
+			got, err := store.Log(mockStackName, tc.id)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(got))
+		})
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package terraform exports the CloudFormation stacks generated by Copilot as Terraform configuration,
+// so that they can be deployed by Terraform alongside the rest of an infrastructure.
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// maxTemplateBodySize is the maximum size of a template passed inline to CloudFormation.
+// Larger templates must be uploaded to S3 first.
+// See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/cloudformation-limits.html.
+const maxTemplateBodySize = 51200
+
+// capabilities are the capabilities that Copilot acknowledges when it deploys a stack.
+var capabilities = []string{"CAPABILITY_IAM", "CAPABILITY_NAMED_IAM", "CAPABILITY_AUTO_EXPAND"}
+
+// Stack is a CloudFormation stack to export as an "aws_cloudformation_stack" resource.
+type Stack struct {
+	Name             string // Name of the CloudFormation stack.
+	Template         string // Body of the template.
+	Configuration    string // Template configuration with the "Parameters" and "Tags" of the stack, as serialized by "package".
+	ExecutionRoleARN string // Role that CloudFormation assumes to deploy the stack.
+
+	// TemplatePath is the path of the template file relative to the Terraform module.
+	// If empty, the template is written inline in the configuration.
+	TemplatePath string
+}
+
+type templateConfiguration struct {
+	Parameters map[string]*string `json:"Parameters"`
+	Tags       map[string]*string `json:"Tags"`
+}
+
+// HCL returns the Terraform configuration that deploys the stack.
+// Each parameter of the stack is extracted to a variable that defaults to its current value.
+// If the template is too large to be passed inline, it's uploaded to a bucket given by the "template_bucket" variable.
+func (s Stack) HCL() ([]byte, error) {
+	var cfg templateConfiguration
+	if err := json.Unmarshal([]byte(s.Configuration), &cfg); err != nil {
+		return nil, fmt.Errorf("unmarshal template configuration of stack %s: %w", s.Name, err)
+	}
+	paramKeys := sortedKeys(cfg.Parameters)
+	resourceName := identifier(s.Name)
+
+	b := new(strings.Builder)
+	fmt.Fprintf(b, "# Generated by AWS Copilot from the CloudFormation stack %q.\n", s.Name)
+	b.WriteString("# The variables default to the parameters of the stack when it was packaged.\n")
+	for _, key := range paramKeys {
+		fmt.Fprintf(b, "\nvariable %q {\n", identifier(key))
+		b.WriteString("  type    = string\n")
+		fmt.Fprintf(b, "  default = %s\n", quote(value(cfg.Parameters[key])))
+		b.WriteString("}\n")
+	}
+
+	large := len(s.Template) > maxTemplateBodySize
+	if large {
+		b.WriteString(`
+variable "template_bucket" {
+  type        = string
+  description = "Name of the S3 bucket to upload the template to, because the template is too large to be passed inline."
+}
+`)
+		fmt.Fprintf(b, "\nresource \"aws_s3_object\" %q {\n", resourceName+"_template")
+		b.WriteString("  bucket  = var.template_bucket\n")
+		fmt.Fprintf(b, "  key     = %s\n", quote(fmt.Sprintf("%s.stack.yml", s.Name)))
+		fmt.Fprintf(b, "  content = %s\n", s.templateBody())
+		b.WriteString("}\n")
+	}
+
+	fmt.Fprintf(b, "\nresource \"aws_cloudformation_stack\" %q {\n", resourceName)
+	fmt.Fprintf(b, "  name          = %s\n", quote(s.Name))
+	if large {
+		fmt.Fprintf(b, "  template_url  = \"https://${aws_s3_object.%[1]s_template.bucket}.s3.amazonaws.com/${aws_s3_object.%[1]s_template.key}\"\n", resourceName)
+	} else {
+		fmt.Fprintf(b, "  template_body = %s\n", s.templateBody())
+	}
+	fmt.Fprintf(b, "  capabilities  = [%s]\n", strings.Join(applyAll(capabilities, quote), ", "))
+	if s.ExecutionRoleARN != "" {
+		fmt.Fprintf(b, "  iam_role_arn  = %s\n", quote(s.ExecutionRoleARN))
+	}
+	if len(paramKeys) > 0 {
+		b.WriteString("\n  parameters = {\n")
+		for _, key := range paramKeys {
+			fmt.Fprintf(b, "    %s = var.%s\n", key, identifier(key))
+		}
+		b.WriteString("  }\n")
+	}
+	if len(cfg.Tags) > 0 {
+		b.WriteString("\n  tags = {\n")
+		for _, key := range sortedKeys(cfg.Tags) {
+			fmt.Fprintf(b, "    %s = %s\n", quote(key), quote(value(cfg.Tags[key])))
+		}
+		b.WriteString("  }\n")
+	}
+	b.WriteString("}\n")
+	return []byte(b.String()), nil
+}
+
+// templateBody returns the expression of the template: either a reference to the template file, or the template itself.
+func (s Stack) templateBody() string {
+	if s.TemplatePath != "" {
+		return fmt.Sprintf("file(\"${path.module}/%s\")", escape(s.TemplatePath))
+	}
+	body := strings.TrimSuffix(s.Template, "\n")
+	return fmt.Sprintf("<<EOT\n%s\nEOT", escapeTemplate(body))
+}
+
+// identifier converts a name like "ContainerImage" or "demo-test-api" to a Terraform identifier like "container_image" or "demo_test_api".
+func identifier(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		switch {
+		case unicode.IsUpper(r):
+			// Start a new word at an upper case letter that follows a lower case letter or precedes one, as in "VPCId".
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))) {
+				b.WriteRune('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	id := b.String()
+	if id == "" || unicode.IsDigit([]rune(id)[0]) {
+		id = "_" + id
+	}
+	return id
+}
+
+// quote returns s as a quoted HCL string.
+func quote(s string) string {
+	return fmt.Sprintf(`"%s"`, escape(s))
+}
+
+// escape escapes the characters of s that have a special meaning in a quoted HCL string.
+func escape(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`).Replace(s)
+	return escapeTemplate(s)
+}
+
+// escapeTemplate escapes the template sequences of HCL, so that CloudFormation's "${AWS::Region}" is kept as is.
+func escapeTemplate(s string) string {
+	return strings.NewReplacer("${", "$${", "%{", "%%{").Replace(s)
+}
+
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func sortedKeys(m map[string]*string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func applyAll(in []string, fn func(string) string) []string {
+	out := make([]string, len(in))
+	for i, s := range in {
+		out[i] = fn(s)
+	}
+	return out
+}
@@ -0,0 +1,175 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package terraform
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStack_HCL(t *testing.T) {
+	const configuration = `{
+  "Parameters": {
+    "AppName": "demo",
+    "ContainerImage": "123456789012.dkr.ecr.us-west-2.amazonaws.com/demo/api:latest",
+    "TargetPort": "8080"
+  },
+  "Tags": {
+    "copilot-application": "demo",
+    "copilot-environment": "test"
+  }
+}`
+	testCases := map[string]struct {
+		stack Stack
+
+		wanted    string
+		wantedErr string
+	}{
+		"inline the template and escape template sequences": {
+			stack: Stack{
+				Name: "demo-test-api",
+				Template: `Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      LogGroupName: !Sub /copilot/${AppName}
+`,
+				Configuration:    configuration,
+				ExecutionRoleARN: "arn:aws:iam::123456789012:role/demo-test-CFNExecutionRole",
+			},
+			wanted: `# Generated by AWS Copilot from the CloudFormation stack "demo-test-api".
+# The variables default to the parameters of the stack when it was packaged.
+
+variable "app_name" {
+  type    = string
+  default = "demo"
+}
+
+variable "container_image" {
+  type    = string
+  default = "123456789012.dkr.ecr.us-west-2.amazonaws.com/demo/api:latest"
+}
+
+variable "target_port" {
+  type    = string
+  default = "8080"
+}
+
+resource "aws_cloudformation_stack" "demo_test_api" {
+  name          = "demo-test-api"
+  template_body = <<EOT
+Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      LogGroupName: !Sub /copilot/$${AppName}
+EOT
+  capabilities  = ["CAPABILITY_IAM", "CAPABILITY_NAMED_IAM", "CAPABILITY_AUTO_EXPAND"]
+  iam_role_arn  = "arn:aws:iam::123456789012:role/demo-test-CFNExecutionRole"
+
+  parameters = {
+    AppName = var.app_name
+    ContainerImage = var.container_image
+    TargetPort = var.target_port
+  }
+
+  tags = {
+    "copilot-application" = "demo"
+    "copilot-environment" = "test"
+  }
+}
+`,
+		},
+		"reference the template file": {
+			stack: Stack{
+				Name:          "demo-test",
+				Template:      "Resources: {}",
+				Configuration: `{"Parameters": {"VPCId": "vpc-1234"}}`,
+				TemplatePath:  "test.env.yml",
+			},
+			wanted: `# Generated by AWS Copilot from the CloudFormation stack "demo-test".
+# The variables default to the parameters of the stack when it was packaged.
+
+variable "vpc_id" {
+  type    = string
+  default = "vpc-1234"
+}
+
+resource "aws_cloudformation_stack" "demo_test" {
+  name          = "demo-test"
+  template_body = file("${path.module}/test.env.yml")
+  capabilities  = ["CAPABILITY_IAM", "CAPABILITY_NAMED_IAM", "CAPABILITY_AUTO_EXPAND"]
+
+  parameters = {
+    VPCId = var.vpc_id
+  }
+}
+`,
+		},
+		"upload templates that are too large to be passed inline": {
+			stack: Stack{
+				Name:          "demo-test",
+				Template:      strings.Repeat("#", maxTemplateBodySize+1),
+				Configuration: `{}`,
+				TemplatePath:  "test.env.yml",
+			},
+			wanted: `# Generated by AWS Copilot from the CloudFormation stack "demo-test".
+# The variables default to the parameters of the stack when it was packaged.
+
+variable "template_bucket" {
+  type        = string
+  description = "Name of the S3 bucket to upload the template to, because the template is too large to be passed inline."
+}
+
+resource "aws_s3_object" "demo_test_template" {
+  bucket  = var.template_bucket
+  key     = "demo-test.stack.yml"
+  content = file("${path.module}/test.env.yml")
+}
+
+resource "aws_cloudformation_stack" "demo_test" {
+  name          = "demo-test"
+  template_url  = "https://${aws_s3_object.demo_test_template.bucket}.s3.amazonaws.com/${aws_s3_object.demo_test_template.key}"
+  capabilities  = ["CAPABILITY_IAM", "CAPABILITY_NAMED_IAM", "CAPABILITY_AUTO_EXPAND"]
+}
+`,
+		},
+		"return an error if the configuration is invalid": {
+			stack: Stack{
+				Name:          "demo-test",
+				Configuration: "Parameters:",
+			},
+			wantedErr: "unmarshal template configuration of stack demo-test: invalid character 'P' looking for beginning of value",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			out, err := tc.stack.HCL()
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(out))
+		})
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	testCases := map[string]string{
+		"ContainerImage": "container_image",
+		"VPCId":          "vpc_id",
+		"demo-test-api":  "demo_test_api",
+		"EnvFileARN":     "env_file_arn",
+		"Sidecar1Image":  "sidecar1_image",
+		"2048-game":      "_2048_game",
+	}
+	for in, wanted := range testCases {
+		t.Run(in, func(t *testing.T) {
+			require.Equal(t, wanted, identifier(in))
+		})
+	}
+}
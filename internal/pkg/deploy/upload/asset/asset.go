@@ -17,12 +17,16 @@ import (
 	"path"
 	"path/filepath"
 	"sort"
+	"sync"
 
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/spf13/afero"
 	"golang.org/x/sync/errgroup"
 )
 
+// defaultConcurrency is the number of files uploaded at the same time if ArtifactBucketUploader.Concurrency is unset.
+const defaultConcurrency = 16
+
 // ArtifactBucketUploader uploads local asset files.
 type ArtifactBucketUploader struct {
 	// FS is the file system to use.
@@ -36,21 +40,49 @@ type ArtifactBucketUploader struct {
 
 	// AssetMappingFileDir is the directory to upload the asset mapping file to.
 	AssetMappingFileDir string
+
+	// UploadedAssets returns the paths of the files already in AssetDir, which aren't uploaded again.
+	// Every file is uploaded if it's nil.
+	UploadedAssets func() ([]string, error)
+
+	// DeployedAssetMappingFile returns the content of the asset mapping file that is deployed, or nil if there is none.
+	// The destination paths that it has and the new mapping doesn't are marked to be deleted in the new mapping.
+	// Nothing is deleted if it's nil.
+	DeployedAssetMappingFile func() ([]byte, error)
+
+	// Concurrency is the maximum number of files uploaded at the same time.
+	// Defaults to 16.
+	Concurrency int
+
+	// Progress is called after each file is uploaded or skipped, if it's not nil.
+	Progress func(UploadProgress)
+}
+
+// UploadProgress is the progress of the upload of the assets.
+type UploadProgress struct {
+	Files      int   // Number of files done, either uploaded or skipped.
+	TotalFiles int   // Number of files to upload.
+	Skipped    int   // Number of files not uploaded because the same content is already uploaded.
+	Bytes      int64 // Number of bytes uploaded.
+	TotalBytes int64 // Number of bytes to upload, excluding the skipped files.
 }
 
 type asset struct {
 	localPath string
-	content   io.Reader
+	size      int64
 
-	ArtifactBucketPath string `json:"path"`
+	ArtifactBucketPath string `json:"path,omitempty"`
 	ServiceBucketPath  string `json:"destPath"`
-	ContentType        string `json:"contentType"`
+	ContentType        string `json:"contentType,omitempty"`
+	Delete             bool   `json:"delete,omitempty"`
 }
 
 // UploadFiles hashes each of the files specified in files and uploads
-// them to the path "{AssetDir}/{hash}". After, it uploads a JSON file
+// them to the path "{AssetDir}/{hash}", unless a file with the same hash
+// is already there. After, it uploads a JSON file
 // to AssetDir that maps the location of every file in the artifact bucket to its
-// intended destination path in the service bucket. The path to the mapping file
+// intended destination path in the service bucket, along with the destination paths
+// of the deployed files that were removed. The path to the mapping file
 // is returned along with an error, if any.
 func (u *ArtifactBucketUploader) UploadFiles(files []manifest.FileUpload) (string, error) {
 	var assets []asset
@@ -66,7 +98,12 @@ func (u *ArtifactBucketUploader) UploadFiles(files []manifest.FileUpload) (strin
 		return "", fmt.Errorf("upload assets: %s", err)
 	}
 
-	path, err := u.uploadAssetMappingFile(assets)
+	removed, err := u.removedAssets(assets)
+	if err != nil {
+		return "", err
+	}
+
+	path, err := u.uploadAssetMappingFile(assets, removed)
 	if err != nil {
 		return "", fmt.Errorf("upload asset mapping file: %s", err)
 	}
@@ -94,15 +131,15 @@ func (u *ArtifactBucketUploader) walkFn(sourcePath, destPath string, recursive b
 			return nil
 		}
 
+		// The file is only hashed here and read again when it's uploaded, so that large sites aren't held in memory.
 		hash := sha256.New()
-		buf := &bytes.Buffer{}
 		file, err := u.FS.Open(fpath)
 		if err != nil {
 			return fmt.Errorf("open %q: %w", fpath, err)
 		}
 		defer file.Close()
 
-		_, err = io.Copy(io.MultiWriter(buf, hash), file)
+		size, err := io.Copy(hash, file)
 		if err != nil {
 			return fmt.Errorf("copy %q: %w", fpath, err)
 		}
@@ -120,7 +157,7 @@ func (u *ArtifactBucketUploader) walkFn(sourcePath, destPath string, recursive b
 
 		*assets = append(*assets, asset{
 			localPath:          fpath,
-			content:            buf,
+			size:               size,
 			ArtifactBucketPath: path.Join(u.AssetDir, hex.EncodeToString(hash.Sum(nil))),
 			ServiceBucketPath:  filepath.ToSlash(dest),
 			ContentType:        mime.TypeByExtension(filepath.Ext(fpath)),
@@ -130,14 +167,54 @@ func (u *ArtifactBucketUploader) walkFn(sourcePath, destPath string, recursive b
 }
 
 func (u *ArtifactBucketUploader) uploadAssets(assets []asset) error {
-	g, _ := errgroup.WithContext(context.Background())
+	uploaded := make(map[string]bool)
+	if u.UploadedAssets != nil {
+		paths, err := u.UploadedAssets()
+		if err != nil {
+			return fmt.Errorf("list uploaded assets: %w", err)
+		}
+		for _, path := range paths {
+			uploaded[path] = true
+		}
+	}
 
+	// Files with the same content are uploaded once.
+	var todo []asset
+	var progress UploadProgress
 	for i := range assets {
-		asset := assets[i]
+		if uploaded[assets[i].ArtifactBucketPath] {
+			progress.Skipped++
+			continue
+		}
+		uploaded[assets[i].ArtifactBucketPath] = true
+		todo = append(todo, assets[i])
+		progress.TotalBytes += assets[i].size
+	}
+	progress.TotalFiles = len(todo) + progress.Skipped
+	progress.Files = progress.Skipped
+	u.reportProgress(progress)
+
+	concurrency := u.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultConcurrency
+	}
+	g, ctx := errgroup.WithContext(context.Background())
+	g.SetLimit(concurrency)
+	var mu sync.Mutex
+	for i := range todo {
+		asset := todo[i]
 		g.Go(func() error {
-			if err := u.Upload(asset.ArtifactBucketPath, asset.content); err != nil {
-				return fmt.Errorf("upload %q: %w", asset.localPath, err)
+			if ctx.Err() != nil {
+				return nil // Another upload failed, so there's no need to start this one.
+			}
+			if err := u.uploadAsset(asset); err != nil {
+				return err
 			}
+			mu.Lock()
+			defer mu.Unlock()
+			progress.Files++
+			progress.Bytes += asset.size
+			u.reportProgress(progress)
 			return nil
 		})
 	}
@@ -145,6 +222,60 @@ func (u *ArtifactBucketUploader) uploadAssets(assets []asset) error {
 	return g.Wait()
 }
 
+func (u *ArtifactBucketUploader) uploadAsset(asset asset) error {
+	file, err := u.FS.Open(asset.localPath)
+	if err != nil {
+		return fmt.Errorf("open %q: %w", asset.localPath, err)
+	}
+	defer file.Close()
+	if err := u.Upload(asset.ArtifactBucketPath, file); err != nil {
+		return fmt.Errorf("upload %q: %w", asset.localPath, err)
+	}
+	return nil
+}
+
+func (u *ArtifactBucketUploader) reportProgress(progress UploadProgress) {
+	if u.Progress != nil {
+		u.Progress(progress)
+	}
+}
+
+// removedAssets returns the assets of the deployed asset mapping file whose destination path isn't in assets.
+func (u *ArtifactBucketUploader) removedAssets(assets []asset) ([]asset, error) {
+	if u.DeployedAssetMappingFile == nil {
+		return nil, nil
+	}
+	data, err := u.DeployedAssetMappingFile()
+	if err != nil {
+		return nil, fmt.Errorf("get deployed asset mapping file: %w", err)
+	}
+	if data == nil {
+		return nil, nil
+	}
+	var deployed []asset
+	if err := json.Unmarshal(data, &deployed); err != nil {
+		return nil, fmt.Errorf("decode deployed asset mapping file: %w", err)
+	}
+
+	dests := make(map[string]bool, len(assets))
+	for i := range assets {
+		dests[assets[i].ServiceBucketPath] = true
+	}
+	var removed []asset
+	for i := range deployed {
+		// Files removed by the deployed mapping are already gone.
+		if deployed[i].Delete || dests[deployed[i].ServiceBucketPath] {
+			continue
+		}
+		dests[deployed[i].ServiceBucketPath] = true
+		removed = append(removed, asset{
+			ServiceBucketPath: deployed[i].ServiceBucketPath,
+			Delete:            true,
+		})
+	}
+	return removed, nil
+}
+
 // uploadAssetMappingFile uploads a JSON file containing the location
 // of each file in the artifact bucket and the desired location
 // of the file in the destination bucket. It has the format:
@@ -153,12 +284,15 @@ func (u *ArtifactBucketUploader) uploadAssets(assets []asset) error {
 //	  "path": "local-assets/12345asdf",
 //	  "destPath": "index.html",
 //	  "contentType": "text/html"
+//	}, {
+//	  "destPath": "removed.html",
+//	  "delete": true
 //	}]
 //
 // The path returned is u.AssetMappingDir/a hash of the mapping file's content.
 // This makes it so the file path is constant as long as the
 // content and destination of the uploaded assets do not change.
-func (u *ArtifactBucketUploader) uploadAssetMappingFile(assets []asset) (string, error) {
+func (u *ArtifactBucketUploader) uploadAssetMappingFile(assets, removed []asset) (string, error) {
 	assets = dedupe(assets)
 	sort.Slice(assets, func(i, j int) bool {
 		if assets[i].ArtifactBucketPath != assets[j].ArtifactBucketPath {
@@ -166,6 +300,10 @@ func (u *ArtifactBucketUploader) uploadAssetMappingFile(assets []asset) (string,
 		}
 		return assets[i].ServiceBucketPath < assets[j].ServiceBucketPath
 	})
+	sort.Slice(removed, func(i, j int) bool {
+		return removed[i].ServiceBucketPath < removed[j].ServiceBucketPath
+	})
+	assets = append(assets, removed...)
 
 	data, err := json.Marshal(assets)
 	if err != nil {
@@ -4,7 +4,6 @@
 package asset
 
 import (
-	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
@@ -13,6 +12,7 @@ import (
 	"io"
 	"mime"
 	"path"
+	"sort"
 	"sync"
 	"testing"
 
@@ -56,22 +56,27 @@ func Test_UploadFiles(t *testing.T) {
 		return hex.EncodeToString(hash.Sum(nil))
 	}
 
+	contents := make(map[string]string)
 	newAsset := func(dstPath string, content string, contentType string) asset {
+		contents[path.Join(mockPrefix, hash(content))] = content
 		return asset{
 			ArtifactBucketPath: path.Join(mockPrefix, hash(content)),
-			content:            bytes.NewBufferString(content),
 			ServiceBucketPath:  dstPath,
 			ContentType:        contentType,
 		}
 	}
 
 	testCases := map[string]struct {
-		files          []manifest.FileUpload
-		mockS3Error    error
-		mockFileSystem func(fs afero.Fs)
+		files               []manifest.FileUpload
+		mockS3Error         error
+		mockFileSystem      func(fs afero.Fs)
+		uploadedAssets      []string
+		uploadedAssetsError error
+		deployedMapping     string
 
-		expected      []asset
-		expectedError error
+		expected        []asset
+		expectedRemoved []asset
+		expectedError   error
 	}{
 		"error if failed to upload": {
 			files: []manifest.FileUpload{
@@ -87,6 +92,69 @@ func Test_UploadFiles(t *testing.T) {
 			mockS3Error:   errors.New("mock error"),
 			expectedError: fmt.Errorf(`upload assets: upload "test/copilot/.workspace": mock error`),
 		},
+		"error if failed to list uploaded assets": {
+			files: []manifest.FileUpload{
+				{
+					Source: "test",
+				},
+			},
+			mockFileSystem: func(fs afero.Fs) {
+				afero.WriteFile(fs, "test/index.html", []byte(mockContent1), 0644)
+			},
+			uploadedAssetsError: errors.New("mock error"),
+			expectedError:       fmt.Errorf(`upload assets: list uploaded assets: mock error`),
+		},
+		"error if the deployed mapping file is malformed": {
+			files: []manifest.FileUpload{
+				{
+					Source: "test",
+				},
+			},
+			mockFileSystem: func(fs afero.Fs) {
+				afero.WriteFile(fs, "test/index.html", []byte(mockContent1), 0644)
+			},
+			deployedMapping: "{",
+			expectedError:   fmt.Errorf(`decode deployed asset mapping file: unexpected end of JSON input`),
+		},
+		"skip files that are already uploaded": {
+			files: []manifest.FileUpload{
+				{
+					Source: "test",
+				},
+			},
+			mockFileSystem: func(fs afero.Fs) {
+				afero.WriteFile(fs, "test/index.html", []byte(mockContent1), 0644)
+				afero.WriteFile(fs, "test/about.html", []byte(mockContent2), 0644)
+			},
+			uploadedAssets: []string{path.Join(mockPrefix, hash(mockContent1))},
+			expected: []asset{
+				newAsset("index.html", mockContent1, mime.TypeByExtension(".html")),
+				newAsset("about.html", mockContent2, mime.TypeByExtension(".html")),
+			},
+		},
+		"mark the files removed since the deployment for deletion": {
+			files: []manifest.FileUpload{
+				{
+					Source: "test",
+				},
+			},
+			mockFileSystem: func(fs afero.Fs) {
+				afero.WriteFile(fs, "test/index.html", []byte(mockContent1), 0644)
+			},
+			deployedMapping: `[
+	{"path": "mockPrefix/old", "destPath": "index.html", "contentType": "text/html; charset=utf-8"},
+	{"path": "mockPrefix/old", "destPath": "old.html", "contentType": "text/html; charset=utf-8"},
+	{"path": "mockPrefix/old", "destPath": "css/old.css"},
+	{"destPath": "deleted.html", "delete": true}
+]`,
+			expected: []asset{
+				newAsset("index.html", mockContent1, mime.TypeByExtension(".html")),
+			},
+			expectedRemoved: []asset{
+				{ServiceBucketPath: "css/old.css", Delete: true},
+				{ServiceBucketPath: "old.html", Delete: true},
+			},
+		},
 		"success without include and exclude": {
 			// source=directory, dest unset
 			files: []manifest.FileUpload{
@@ -258,13 +326,20 @@ func Test_UploadFiles(t *testing.T) {
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			// build the expected s3 bucket
+			uploaded := make(map[string]bool)
+			for _, path := range tc.uploadedAssets {
+				uploaded[path] = true
+			}
 			expected := make(map[string][]byte)
 			for _, asset := range tc.expected {
-				expected[asset.ArtifactBucketPath] = asset.content.(*bytes.Buffer).Bytes()
+				if !uploaded[asset.ArtifactBucketPath] {
+					expected[asset.ArtifactBucketPath] = []byte(contents[asset.ArtifactBucketPath])
+				}
 			}
 
 			// add in the mapping file
-			b, err := json.Marshal(tc.expected)
+			mapping := append(sortedAssets(tc.expected), tc.expectedRemoved...)
+			b, err := json.Marshal(mapping)
 			require.NoError(t, err)
 
 			hash := sha256.New()
@@ -287,6 +362,15 @@ func Test_UploadFiles(t *testing.T) {
 				Upload:              mockS3.Upload,
 				AssetDir:            mockPrefix,
 				AssetMappingFileDir: mockMappingDir,
+				UploadedAssets: func() ([]string, error) {
+					return tc.uploadedAssets, tc.uploadedAssetsError
+				},
+				DeployedAssetMappingFile: func() ([]byte, error) {
+					if tc.deployedMapping == "" {
+						return nil, nil
+					}
+					return []byte(tc.deployedMapping), nil
+				},
 			}
 
 			mappingFilePath, err := u.UploadFiles(tc.files)
@@ -302,3 +386,48 @@ func Test_UploadFiles(t *testing.T) {
 		})
 	}
 }
+
+func sortedAssets(assets []asset) []asset {
+	sorted := make([]asset, len(assets))
+	copy(sorted, assets)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].ArtifactBucketPath != sorted[j].ArtifactBucketPath {
+			return sorted[i].ArtifactBucketPath < sorted[j].ArtifactBucketPath
+		}
+		return sorted[i].ServiceBucketPath < sorted[j].ServiceBucketPath
+	})
+	return sorted
+}
+
+func Test_UploadFilesProgress(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "site/index.html", []byte("index"), 0644))
+	require.NoError(t, afero.WriteFile(fs, "site/copy.html", []byte("index"), 0644))
+	require.NoError(t, afero.WriteFile(fs, "site/about.html", []byte("about us"), 0644))
+	require.NoError(t, afero.WriteFile(fs, "site/logo.svg", []byte("<svg/>"), 0644))
+	uploaded := sha256.Sum256([]byte("<svg/>"))
+
+	var progress []UploadProgress
+	u := ArtifactBucketUploader{
+		FS:                  fs,
+		Upload:              (&fakeS3{}).Upload,
+		AssetDir:            "assets",
+		AssetMappingFileDir: "mapping",
+		UploadedAssets: func() ([]string, error) {
+			return []string{path.Join("assets", hex.EncodeToString(uploaded[:]))}, nil
+		},
+		Concurrency: 1,
+		Progress: func(p UploadProgress) {
+			progress = append(progress, p)
+		},
+	}
+
+	_, err := u.UploadFiles([]manifest.FileUpload{{Source: "site"}})
+
+	require.NoError(t, err)
+	require.Equal(t, []UploadProgress{
+		{Files: 2, TotalFiles: 4, Skipped: 2, TotalBytes: 13},
+		{Files: 3, TotalFiles: 4, Skipped: 2, Bytes: 8, TotalBytes: 13},
+		{Files: 4, TotalFiles: 4, Skipped: 2, Bytes: 13, TotalBytes: 13},
+	}, progress)
+}
@@ -37,6 +37,8 @@ const (
 	certReplicatorFnName      = "CertificateReplicatorFunction"
 	uniqueJsonValuesFnName    = "UniqueJSONValuesFunction"
 	triggerStateMachineFnName = "TriggerStateMachineFunction"
+	blueGreenDeploymentFnName = "BlueGreenDeploymentFunction"
+	deploymentHookFnName      = "DeploymentHookFunction"
 )
 
 // Function source file locations.
@@ -54,6 +56,8 @@ var (
 	wkldCustomDomainFilePath         = path.Join(customResourcesDir, "wkld-custom-domain.js")
 	uniqueJSONValuesFilePath         = path.Join(customResourcesDir, "unique-json-values.js")
 	triggerStateMachineFilePath      = path.Join(customResourcesDir, "trigger-state-machine.js")
+	blueGreenDeploymentFilePath      = path.Join(customResourcesDir, "blue-green-deployment.js")
+	deploymentHookFilePath           = path.Join(customResourcesDir, "deployment-hook.js")
 )
 
 // CustomResource represents a CloudFormation custom resource backed by a Lambda function.
@@ -121,6 +125,8 @@ func LBWS(fs template.Reader) ([]*CustomResource, error) {
 		rulePriorityFnName:        albRulePriorityGeneratorFilePath,
 		nlbCustomDomainFnName:     wkldCustomDomainFilePath,
 		nlbCertValidatorFnName:    wkldCertValidatorFilePath,
+		blueGreenDeploymentFnName: blueGreenDeploymentFilePath,
+		deploymentHookFnName:      deploymentHookFilePath,
 	})
 }
 
@@ -130,6 +136,7 @@ func Worker(fs template.Reader) ([]*CustomResource, error) {
 		dynamicDesiredCountFnName: desiredCountDelegationFilePath,
 		backlogPerTaskFnName:      backlogPerTaskCalculatorFilePath,
 		envControllerFnName:       envControllerFilePath,
+		deploymentHookFnName:      deploymentHookFilePath,
 	})
 }
 
@@ -139,6 +146,7 @@ func Backend(fs template.Reader) ([]*CustomResource, error) {
 		dynamicDesiredCountFnName: desiredCountDelegationFilePath,
 		rulePriorityFnName:        albRulePriorityGeneratorFilePath,
 		envControllerFnName:       envControllerFilePath,
+		deploymentHookFnName:      deploymentHookFilePath,
 	})
 }
 
@@ -151,6 +159,14 @@ func StaticSite(fs template.Reader) ([]*CustomResource, error) {
 	})
 }
 
+// LambdaService returns the custom resources for a Lambda service.
+func LambdaService(fs template.Reader) ([]*CustomResource, error) {
+	return buildCustomResources(fs, map[string]string{
+		envControllerFnName: envControllerFilePath,
+		rulePriorityFnName:  albRulePriorityGeneratorFilePath,
+	})
+}
+
 // ScheduledJob returns the custom resources for a scheduled job.
 func ScheduledJob(fs template.Reader) ([]*CustomResource, error) {
 	return buildCustomResources(fs, map[string]string{
@@ -158,6 +174,13 @@ func ScheduledJob(fs template.Reader) ([]*CustomResource, error) {
 	})
 }
 
+// WorkflowJob returns the custom resources for a workflow job.
+func WorkflowJob(fs template.Reader) ([]*CustomResource, error) {
+	return buildCustomResources(fs, map[string]string{
+		envControllerFnName: envControllerFilePath,
+	})
+}
+
 // Env returns the custom resources for an environment.
 func Env(fs template.Reader) ([]*CustomResource, error) {
 	return buildCustomResources(fs, map[string]string{
@@ -99,6 +99,12 @@ func TestLBWS(t *testing.T) {
 			"custom-resources/wkld-cert-validator.js": {
 				Buffer: bytes.NewBufferString("service-level cert"),
 			},
+			"custom-resources/blue-green-deployment.js": {
+				Buffer: bytes.NewBufferString("blue/green deployment"),
+			},
+			"custom-resources/deployment-hook.js": {
+				Buffer: bytes.NewBufferString("deployment hook"),
+			},
 		},
 	}
 	fakePaths := map[string]string{
@@ -107,6 +113,8 @@ func TestLBWS(t *testing.T) {
 		"RulePriorityFunction":        "manual/scripts/custom-resources/rulepriorityfunction/1385d258950a50faf4b5cd7deeecbc4bcc79a0d41d631e3977cffa0332e6f0c6.zip",
 		"NLBCustomDomainFunction":     "manual/scripts/custom-resources/nlbcustomdomainfunction/ac1c96e7f0823f3167b4e74c8b286ffe8f9d43279dc232d9478837327e57905e.zip",
 		"NLBCertValidatorFunction":    "manual/scripts/custom-resources/nlbcertvalidatorfunction/41aeafc64f18f82c452432a214ae83d8c8de4aba2d5df6a752b7e9a2c86833f1.zip",
+		"BlueGreenDeploymentFunction": "manual/scripts/custom-resources/bluegreendeploymentfunction/588255ca8f2fd17090601b28c349a20d473a58ff57fb73038cc7eb4d548caa9e.zip",
+		"DeploymentHookFunction":      "manual/scripts/custom-resources/deploymenthookfunction/3da4f02e3b02ce46b03f140ad36d0aead9ba5dbf03d88bb2d9ea78c2139af10a.zip",
 	}
 
 	// WHEN
@@ -114,14 +122,14 @@ func TestLBWS(t *testing.T) {
 
 	// THEN
 	require.NoError(t, err)
-	require.Equal(t, fakeFS.matchCount, 5, "expected path calls do not match")
+	require.Equal(t, fakeFS.matchCount, 7, "expected path calls do not match")
 
 	actualFnNames := make([]string, len(crs))
 	for i, cr := range crs {
 		actualFnNames[i] = cr.Name()
 	}
 	require.ElementsMatch(t,
-		[]string{"DynamicDesiredCountFunction", "EnvControllerFunction", "RulePriorityFunction", "NLBCustomDomainFunction", "NLBCertValidatorFunction"},
+		[]string{"DynamicDesiredCountFunction", "EnvControllerFunction", "RulePriorityFunction", "NLBCustomDomainFunction", "NLBCertValidatorFunction", "BlueGreenDeploymentFunction", "DeploymentHookFunction"},
 		actualFnNames, "function names must match")
 
 	// ensure the zip files contain an index.js file.
@@ -155,12 +163,16 @@ func TestWorker(t *testing.T) {
 			"custom-resources/env-controller.js": {
 				Buffer: bytes.NewBufferString("env controller"),
 			},
+			"custom-resources/deployment-hook.js": {
+				Buffer: bytes.NewBufferString("deployment hook"),
+			},
 		},
 	}
 	fakePaths := map[string]string{
 		"DynamicDesiredCountFunction":      "manual/scripts/custom-resources/dynamicdesiredcountfunction/2611784f21e91e499306dac066aae5fd8f2ba664b38073bdd3198d2e041c076e.zip",
 		"BacklogPerTaskCalculatorFunction": "manual/scripts/custom-resources/backlogpertaskcalculatorfunction/bc925d682cb47de9c65ed9cc5438ee51d9e2b9b39ca6b57bb9adda81b0091b30.zip",
 		"EnvControllerFunction":            "manual/scripts/custom-resources/envcontrollerfunction/72297cacaeab3a267e371c17ea3f0235905b0da51410eb31c10f7c66ba944044.zip",
+		"DeploymentHookFunction":           "manual/scripts/custom-resources/deploymenthookfunction/3da4f02e3b02ce46b03f140ad36d0aead9ba5dbf03d88bb2d9ea78c2139af10a.zip",
 	}
 
 	// WHEN
@@ -168,14 +180,14 @@ func TestWorker(t *testing.T) {
 
 	// THEN
 	require.NoError(t, err)
-	require.Equal(t, fakeFS.matchCount, 3, "expected path calls do not match")
+	require.Equal(t, fakeFS.matchCount, 4, "expected path calls do not match")
 
 	actualFnNames := make([]string, len(crs))
 	for i, cr := range crs {
 		actualFnNames[i] = cr.Name()
 	}
 	require.ElementsMatch(t,
-		[]string{"DynamicDesiredCountFunction", "BacklogPerTaskCalculatorFunction", "EnvControllerFunction"},
+		[]string{"DynamicDesiredCountFunction", "BacklogPerTaskCalculatorFunction", "EnvControllerFunction", "DeploymentHookFunction"},
 		actualFnNames, "function names must match")
 
 	// ensure the zip files contain an index.js file.
@@ -209,12 +221,16 @@ func TestBackend(t *testing.T) {
 			"custom-resources/env-controller.js": {
 				Buffer: bytes.NewBufferString("env controller"),
 			},
+			"custom-resources/deployment-hook.js": {
+				Buffer: bytes.NewBufferString("deployment hook"),
+			},
 		},
 	}
 	fakePaths := map[string]string{
 		"DynamicDesiredCountFunction": "manual/scripts/custom-resources/dynamicdesiredcountfunction/2611784f21e91e499306dac066aae5fd8f2ba664b38073bdd3198d2e041c076e.zip",
 		"EnvControllerFunction":       "manual/scripts/custom-resources/envcontrollerfunction/72297cacaeab3a267e371c17ea3f0235905b0da51410eb31c10f7c66ba944044.zip",
 		"RulePriorityFunction":        "manual/scripts/custom-resources/rulepriorityfunction/1385d258950a50faf4b5cd7deeecbc4bcc79a0d41d631e3977cffa0332e6f0c6.zip",
+		"DeploymentHookFunction":      "manual/scripts/custom-resources/deploymenthookfunction/3da4f02e3b02ce46b03f140ad36d0aead9ba5dbf03d88bb2d9ea78c2139af10a.zip",
 	}
 
 	// WHEN
@@ -222,14 +238,14 @@ func TestBackend(t *testing.T) {
 
 	// THEN
 	require.NoError(t, err)
-	require.Equal(t, fakeFS.matchCount, 3, "expected path calls do not match")
+	require.Equal(t, fakeFS.matchCount, 4, "expected path calls do not match")
 
 	actualFnNames := make([]string, len(crs))
 	for i, cr := range crs {
 		actualFnNames[i] = cr.Name()
 	}
 	require.ElementsMatch(t,
-		[]string{"DynamicDesiredCountFunction", "RulePriorityFunction", "EnvControllerFunction"},
+		[]string{"DynamicDesiredCountFunction", "RulePriorityFunction", "EnvControllerFunction", "DeploymentHookFunction"},
 		actualFnNames, "function names must match")
 
 	// ensure the zip files contain an index.js file.
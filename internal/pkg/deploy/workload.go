@@ -13,6 +13,9 @@ const (
 	// file name when `service package` or `job package is called. It's also used to
 	// render the pipeline CFN template.
 	WorkloadCfnTemplateConfigurationNameFormat = "%s-%s.params.json"
+	// WorkloadTerraformConfigurationNameFormat is the output Terraform configuration
+	// file name when `service package` or `job package` is called with `--format terraform`.
+	WorkloadTerraformConfigurationNameFormat = "%s-%s.tf"
 	// AddonsCfnTemplateNameFormat is the addons output file name when `service package`
 	// is called.
 	AddonsCfnTemplateNameFormat = "%s.addons.stack.yml"
@@ -0,0 +1,465 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	cptemplate "github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// Kinds of the nodes of an application graph.
+const (
+	graphKindService = "service"
+	graphKindJob     = "job"
+	graphKindTopic   = "topic"
+	graphKindStorage = "storage"
+)
+
+// graphStorageTypes are the resource types of addons drawn as storage, and how they're labeled.
+var graphStorageTypes = map[string]string{
+	"AWS::DynamoDB::Table": "DynamoDB table",
+	"AWS::S3::Bucket":      "S3 bucket",
+	"AWS::RDS::DBCluster":  "Aurora cluster",
+	"AWS::RDS::DBInstance": "RDS instance",
+	"AWS::EFS::FileSystem": "EFS file system",
+}
+
+// graphNode is a workload, topic or storage resource deployed in an environment.
+type graphNode struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+	Type string `json:"type"`
+}
+
+// graphEdge is a dependency of a node on another.
+type graphEdge struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Label string `json:"label"`
+}
+
+// GraphEnvironment is the graph of the nodes deployed in an environment.
+type GraphEnvironment struct {
+	Name         string      `json:"name"`
+	Region       string      `json:"region"`
+	Nodes        []graphNode `json:"nodes"`
+	Dependencies []graphEdge `json:"dependencies"`
+}
+
+// AppGraph is the topology of the workloads, topics and storage deployed in the environments of an application.
+type AppGraph struct {
+	App          string              `json:"application"`
+	Environments []*GraphEnvironment `json:"environments"`
+}
+
+// NewAppGraphConfig contains fields that initiates an appGraphDescriber struct.
+type NewAppGraphConfig struct {
+	App         string
+	ConfigStore ConfigStoreSvc
+	DeployStore DeployedEnvServicesLister
+}
+
+type appGraphDescriber struct {
+	app string
+
+	configStore       ConfigStoreSvc
+	deployStore       DeployedEnvServicesLister
+	newStackDescriber func(env *config.Environment, stackName string) (stackDescriber, error)
+}
+
+// NewAppGraphDescriber instantiates a describer of the topology of an application from the metadata of its deployed stacks.
+func NewAppGraphDescriber(opt NewAppGraphConfig) *appGraphDescriber {
+	envSessions := make(map[string]*session.Session)
+	return &appGraphDescriber{
+		app:         opt.App,
+		configStore: opt.ConfigStore,
+		deployStore: opt.DeployStore,
+		newStackDescriber: func(env *config.Environment, stackName string) (stackDescriber, error) {
+			sess, ok := envSessions[env.Name]
+			if !ok {
+				var err error
+				if sess, err = newEnvManagerSession(env); err != nil {
+					return nil, err
+				}
+				envSessions[env.Name] = sess
+			}
+			return stack.NewStackDescriber(stackName, sess), nil
+		},
+	}
+}
+
+func newEnvManagerSession(env *config.Environment) (*session.Session, error) {
+	sess, err := sessions.ImmutableProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return sess, nil
+}
+
+// Describe returns the graph of every environment of the application: the deployed services and jobs,
+// the topics they publish to and subscribe to, and the storage of their addons and of the environment addons.
+func (d *appGraphDescriber) Describe() (*AppGraph, error) {
+	envs, err := d.configStore.ListEnvironments(d.app)
+	if err != nil {
+		return nil, fmt.Errorf("list environments of application %s: %w", d.app, err)
+	}
+	graph := &AppGraph{
+		App:          d.app,
+		Environments: []*GraphEnvironment{},
+	}
+	for _, env := range envs {
+		g, err := d.describeEnv(env)
+		if err != nil {
+			return nil, err
+		}
+		graph.Environments = append(graph.Environments, g)
+	}
+	return graph, nil
+}
+
+func (d *appGraphDescriber) describeEnv(env *config.Environment) (*GraphEnvironment, error) {
+	g := &GraphEnvironment{
+		Name:         env.Name,
+		Region:       env.Region,
+		Nodes:        []graphNode{},
+		Dependencies: []graphEdge{},
+	}
+	envStack, err := d.newStackDescriber(env, cfnstack.NameForEnv(d.app, env.Name))
+	if err != nil {
+		return nil, err
+	}
+	storage, err := d.addonsStorage(env, envStack, env.Name)
+	if err != nil {
+		return nil, fmt.Errorf("describe addons of environment %s: %w", env.Name, err)
+	}
+	g.Nodes = append(g.Nodes, storage...)
+
+	svcs, err := d.deployStore.ListDeployedServices(d.app, env.Name)
+	if err != nil {
+		return nil, fmt.Errorf("list deployed services in environment %s: %w", env.Name, err)
+	}
+	jobs, err := d.deployStore.ListDeployedJobs(d.app, env.Name)
+	if err != nil {
+		return nil, fmt.Errorf("list deployed jobs in environment %s: %w", env.Name, err)
+	}
+	names := append(append([]string{}, svcs...), jobs...)
+	sort.Strings(names)
+	var subscriptions []graphEdge
+	for _, name := range names {
+		nodes, edges, subs, err := d.describeWorkload(env, name)
+		if err != nil {
+			return nil, err
+		}
+		g.Nodes = append(g.Nodes, nodes...)
+		g.Dependencies = append(g.Dependencies, edges...)
+		subscriptions = append(subscriptions, subs...)
+	}
+	// Topics are only known once every publisher is described.
+	for _, sub := range subscriptions {
+		if !g.hasNode(sub.From) {
+			publisher, topic, _ := strings.Cut(strings.TrimPrefix(sub.From, env.Name+"."), ".")
+			g.Nodes = append(g.Nodes, graphNode{
+				ID:   sub.From,
+				Name: fmt.Sprintf("%s/%s", publisher, topic),
+				Kind: graphKindTopic,
+				Type: "SNS topic (not deployed)",
+			})
+		}
+		g.Dependencies = append(g.Dependencies, sub)
+	}
+	return g, nil
+}
+
+// describeWorkload returns the nodes of a workload, its topics and storage, the edges to its topics and storage,
+// and the edges from the topics it subscribes to.
+func (d *appGraphDescriber) describeWorkload(env *config.Environment, name string) (nodes []graphNode, edges, subs []graphEdge, err error) {
+	wkld, err := d.configStore.GetWorkload(d.app, name)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("retrieve workload %s: %w", name, err)
+	}
+	id := graphID(env.Name, name)
+	kind := graphKindJob
+	if manifestinfo.IsTypeAService(wkld.Type) {
+		kind = graphKindService
+	}
+	nodes = append(nodes, graphNode{ID: id, Name: name, Kind: kind, Type: wkld.Type})
+
+	cfn, err := d.newStackDescriber(env, cfnstack.NameForWorkload(d.app, env.Name, name))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	storage, err := d.addonsStorage(env, cfn, id)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("describe addons of %s in environment %s: %w", name, env.Name, err)
+	}
+	for _, node := range storage {
+		nodes = append(nodes, node)
+		edges = append(edges, graphEdge{From: id, To: node.ID, Label: "uses"})
+	}
+
+	mft, err := deployedManifest(&WorkloadStackDescriber{app: d.app, env: env.Name, name: name, cfn: cfn}, env.Name)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if publisher, ok := mft.(interface{ Publish() []manifest.Topic }); ok {
+		for _, topic := range publisher.Publish() {
+			topicID := graphID(env.Name, name, aws.StringValue(topic.Name))
+			nodes = append(nodes, graphNode{ID: topicID, Name: aws.StringValue(topic.Name), Kind: graphKindTopic, Type: "SNS topic"})
+			edges = append(edges, graphEdge{From: id, To: topicID, Label: "publishes"})
+		}
+	}
+	if subscriber, ok := mft.(interface {
+		Subscriptions() []manifest.TopicSubscription
+	}); ok {
+		for _, sub := range subscriber.Subscriptions() {
+			subs = append(subs, graphEdge{
+				From:  graphID(env.Name, aws.StringValue(sub.Service), aws.StringValue(sub.Name)),
+				To:    id,
+				Label: "subscribes",
+			})
+		}
+	}
+	return nodes, edges, subs, nil
+}
+
+// deployedManifest returns the manifest that the workload stack was deployed with, with the overrides of the environment.
+// Returns nil if the stack predates the manifest being stored in its metadata.
+func deployedManifest(d *WorkloadStackDescriber, env string) (any, error) {
+	raw, err := d.RenderedManifest()
+	if err != nil {
+		var errNotFound *ErrManifestNotFoundInTemplate
+		if errors.As(err, &errNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	mft, err := manifest.UnmarshalWorkload(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal deployed manifest of %s: %w", d.name, err)
+	}
+	mft, err = mft.ApplyEnv(env)
+	if err != nil {
+		return nil, fmt.Errorf("apply environment %s to deployed manifest of %s: %w", env, d.name, err)
+	}
+	return mft.Manifest(), nil
+}
+
+// addonsStorage returns the storage resources of the addons nested stack of a stack, identified under the owner.
+func (d *appGraphDescriber) addonsStorage(env *config.Environment, cfn stackDescriber, owner string) ([]graphNode, error) {
+	resources, err := cfn.Resources()
+	if err != nil {
+		return nil, err
+	}
+	var nodes []graphNode
+	for _, resource := range resources {
+		if resource.LogicalID != cptemplate.AddonsStackLogicalID || resource.PhysicalID == "" {
+			continue
+		}
+		addons, err := d.newStackDescriber(env, resource.PhysicalID)
+		if err != nil {
+			return nil, err
+		}
+		addonResources, err := addons.Resources()
+		if err != nil {
+			return nil, err
+		}
+		for _, addon := range addonResources {
+			label, ok := graphStorageTypes[addon.Type]
+			if !ok {
+				continue
+			}
+			nodes = append(nodes, graphNode{
+				ID:   graphID(owner, addon.LogicalID),
+				Name: addon.LogicalID,
+				Kind: graphKindStorage,
+				Type: label,
+			})
+		}
+	}
+	return nodes, nil
+}
+
+func graphID(parts ...string) string {
+	return strings.Join(parts, ".")
+}
+
+func (g *GraphEnvironment) hasNode(id string) bool {
+	for _, node := range g.Nodes {
+		if node.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// JSONString returns the stringified AppGraph struct with json format.
+func (g *AppGraph) JSONString() (string, error) {
+	b, err := json.Marshal(g)
+	if err != nil {
+		return "", fmt.Errorf("marshal application graph: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified AppGraph struct with human readable format.
+func (g *AppGraph) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	if len(g.Environments) == 0 {
+		fmt.Fprintf(writer, "No environments found in application %s.\n", g.App)
+		writer.Flush()
+		return b.String()
+	}
+	for i, env := range g.Environments {
+		if i > 0 {
+			fmt.Fprintln(writer)
+		}
+		fmt.Fprint(writer, color.Bold.Sprintf("Environment %s (%s)\n\n", env.Name, env.Region))
+		writer.Flush()
+		if len(env.Nodes) == 0 {
+			fmt.Fprintln(writer, "  Nothing is deployed in the environment.")
+			writer.Flush()
+			continue
+		}
+		headers := []string{"Name", "Kind", "Type"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		names := env.nodeNames()
+		for _, node := range env.Nodes {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", names[node.ID], node.Kind, node.Type)
+		}
+		writer.Flush()
+		if len(env.Dependencies) == 0 {
+			continue
+		}
+		fmt.Fprint(writer, color.Bold.Sprint("\n  Dependencies\n\n"))
+		writer.Flush()
+		for _, edge := range env.Dependencies {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", names[edge.From], edge.Label, names[edge.To])
+		}
+		writer.Flush()
+	}
+	return b.String()
+}
+
+// nodeNames returns the name of each node qualified by its owner, such as "api/orders" for a topic of the "api" service.
+func (g *GraphEnvironment) nodeNames() map[string]string {
+	names := make(map[string]string)
+	for _, node := range g.Nodes {
+		names[node.ID] = strings.ReplaceAll(strings.TrimPrefix(node.ID, g.Name+"."), ".", "/")
+	}
+	return names
+}
+
+// DOTString returns the AppGraph as a directed graph in the DOT language of Graphviz, with a cluster per environment.
+func (g *AppGraph) DOTString() string {
+	shapes := map[string]string{
+		graphKindService: "box",
+		graphKindJob:     "box, style=rounded",
+		graphKindTopic:   "cds",
+		graphKindStorage: "cylinder",
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", strconv.Quote(g.App))
+	b.WriteString("  rankdir=LR;\n")
+	for _, env := range g.Environments {
+		fmt.Fprintf(&b, "  subgraph %s {\n", strconv.Quote("cluster_"+env.Name))
+		fmt.Fprintf(&b, "    label=%s;\n", strconv.Quote(fmt.Sprintf("%s (%s)", env.Name, env.Region)))
+		for _, node := range env.Nodes {
+			fmt.Fprintf(&b, "    %s [label=%s, shape=%s];\n", strconv.Quote(node.ID), strconv.Quote(node.Name), shapes[node.Kind])
+		}
+		b.WriteString("  }\n")
+		for _, edge := range env.Dependencies {
+			fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", strconv.Quote(edge.From), strconv.Quote(edge.To), strconv.Quote(edge.Label))
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// MermaidString returns the AppGraph as a Mermaid flowchart, with a subgraph per environment.
+func (g *AppGraph) MermaidString() string {
+	shapes := map[string]string{
+		graphKindService: "[%s]",
+		graphKindJob:     "([%s])",
+		graphKindTopic:   ">%s]",
+		graphKindStorage: "[(%s)]",
+	}
+	// Mermaid IDs can't contain dots, so nodes are numbered.
+	ids := make(map[string]string)
+	for _, env := range g.Environments {
+		for _, node := range env.Nodes {
+			ids[node.ID] = fmt.Sprintf("n%d", len(ids)+1)
+		}
+	}
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	for i, env := range g.Environments {
+		fmt.Fprintf(&b, "  subgraph env%d [%s]\n", i+1, mermaidLabel(fmt.Sprintf("%s (%s)", env.Name, env.Region)))
+		for _, node := range env.Nodes {
+			fmt.Fprintf(&b, "    %s"+shapes[node.Kind]+"\n", ids[node.ID], mermaidLabel(node.Name))
+		}
+		b.WriteString("  end\n")
+		for _, edge := range env.Dependencies {
+			fmt.Fprintf(&b, "  %s -->|%s| %s\n", ids[edge.From], edge.Label, ids[edge.To])
+		}
+	}
+	return b.String()
+}
+
+func mermaidLabel(s string) string {
+	return strconv.Quote(strings.ReplaceAll(s, `"`, "#quot;"))
+}
+
+var appGraphHTML = template.Must(template.New("graph").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>{{.App}}</title>
+  <script type="module">
+    import mermaid from "https://cdn.jsdelivr.net/npm/mermaid@10/dist/mermaid.esm.min.mjs";
+    mermaid.initialize({ startOnLoad: true });
+  </script>
+</head>
+<body>
+  <h1>{{.App}}</h1>
+  <pre class="mermaid">
+{{.Mermaid}}</pre>
+</body>
+</html>
+`))
+
+// HTMLString returns a web page that renders the AppGraph with Mermaid.
+func (g *AppGraph) HTMLString() (string, error) {
+	var b strings.Builder
+	if err := appGraphHTML.Execute(&b, struct {
+		App     string
+		Mermaid string
+	}{
+		App:     g.App,
+		Mermaid: g.MermaidString(),
+	}); err != nil {
+		return "", fmt.Errorf("render application graph page: %w", err)
+	}
+	return b.String(), nil
+}
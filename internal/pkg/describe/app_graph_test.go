@@ -0,0 +1,241 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type appGraphMocks struct {
+	configStore *mocks.MockConfigStoreSvc
+	deployStore *mocks.MockDeployedEnvServicesLister
+	stacks      map[string]*mocks.MockstackDescriber
+}
+
+// manifestStackMetadata returns the metadata of a workload stack deployed with the manifest.
+func manifestStackMetadata(mft string) string {
+	return "Manifest: |\n  " + strings.ReplaceAll(strings.TrimSpace(mft), "\n", "\n  ") + "\n"
+}
+
+func TestAppGraphDescriber_Describe(t *testing.T) {
+	testEnv := &config.Environment{Name: "test", Region: "us-west-2"}
+	testCases := map[string]struct {
+		setupMocks func(m appGraphMocks)
+
+		wanted      *AppGraph
+		wantedError error
+	}{
+		"wrap the error if fail to list the environments": {
+			setupMocks: func(m appGraphMocks) {
+				m.configStore.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list environments of application phonetool: some error"),
+		},
+		"wrap the error if fail to describe the environment addons": {
+			setupMocks: func(m appGraphMocks) {
+				m.configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv}, nil)
+				m.stacks["phonetool-test"].EXPECT().Resources().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe addons of environment test: some error"),
+		},
+		"graph the workloads, topics and storage of every environment": {
+			setupMocks: func(m appGraphMocks) {
+				m.configStore.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{testEnv}, nil)
+				m.stacks["phonetool-test"].EXPECT().Resources().Return([]*stack.Resource{
+					{LogicalID: "AddonsStack", PhysicalID: "phonetool-test-AddonsStack"},
+				}, nil)
+				m.stacks["phonetool-test-AddonsStack"].EXPECT().Resources().Return([]*stack.Resource{
+					{LogicalID: "Assets", Type: "AWS::S3::Bucket"},
+					{LogicalID: "AssetsAccessPolicy", Type: "AWS::IAM::ManagedPolicy"},
+				}, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"worker", "api"}, nil)
+				m.deployStore.EXPECT().ListDeployedJobs("phonetool", "test").Return([]string{"report"}, nil)
+
+				m.configStore.EXPECT().GetWorkload("phonetool", "api").Return(&config.Workload{Type: manifestinfo.LoadBalancedWebServiceType}, nil)
+				m.stacks["phonetool-test-api"].EXPECT().Resources().Return([]*stack.Resource{}, nil)
+				m.stacks["phonetool-test-api"].EXPECT().StackMetadata().Return(manifestStackMetadata(`
+name: api
+type: Load Balanced Web Service
+image:
+  location: nginx
+http:
+  path: '/'
+publish:
+  topics:
+    - name: orders`), nil)
+
+				m.configStore.EXPECT().GetWorkload("phonetool", "report").Return(&config.Workload{Type: manifestinfo.ScheduledJobType}, nil)
+				m.stacks["phonetool-test-report"].EXPECT().Resources().Return([]*stack.Resource{
+					{LogicalID: "AddonsStack", PhysicalID: "phonetool-test-report-AddonsStack"},
+				}, nil)
+				m.stacks["phonetool-test-report-AddonsStack"].EXPECT().Resources().Return([]*stack.Resource{
+					{LogicalID: "reports", Type: "AWS::DynamoDB::Table"},
+				}, nil)
+				m.stacks["phonetool-test-report"].EXPECT().StackMetadata().Return("", nil)
+
+				m.configStore.EXPECT().GetWorkload("phonetool", "worker").Return(&config.Workload{Type: manifestinfo.WorkerServiceType}, nil)
+				m.stacks["phonetool-test-worker"].EXPECT().Resources().Return([]*stack.Resource{}, nil)
+				m.stacks["phonetool-test-worker"].EXPECT().StackMetadata().Return(manifestStackMetadata(`
+name: worker
+type: Worker Service
+image:
+  location: nginx
+subscribe:
+  topics:
+    - name: orders
+      service: api
+    - name: refunds
+      service: billing`), nil)
+			},
+			wanted: &AppGraph{
+				App: "phonetool",
+				Environments: []*GraphEnvironment{
+					{
+						Name:   "test",
+						Region: "us-west-2",
+						Nodes: []graphNode{
+							{ID: "test.Assets", Name: "Assets", Kind: graphKindStorage, Type: "S3 bucket"},
+							{ID: "test.api", Name: "api", Kind: graphKindService, Type: manifestinfo.LoadBalancedWebServiceType},
+							{ID: "test.api.orders", Name: "orders", Kind: graphKindTopic, Type: "SNS topic"},
+							{ID: "test.report", Name: "report", Kind: graphKindJob, Type: manifestinfo.ScheduledJobType},
+							{ID: "test.report.reports", Name: "reports", Kind: graphKindStorage, Type: "DynamoDB table"},
+							{ID: "test.worker", Name: "worker", Kind: graphKindService, Type: manifestinfo.WorkerServiceType},
+							{ID: "test.billing.refunds", Name: "billing/refunds", Kind: graphKindTopic, Type: "SNS topic (not deployed)"},
+						},
+						Dependencies: []graphEdge{
+							{From: "test.api", To: "test.api.orders", Label: "publishes"},
+							{From: "test.report", To: "test.report.reports", Label: "uses"},
+							{From: "test.api.orders", To: "test.worker", Label: "subscribes"},
+							{From: "test.billing.refunds", To: "test.worker", Label: "subscribes"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := appGraphMocks{
+				configStore: mocks.NewMockConfigStoreSvc(ctrl),
+				deployStore: mocks.NewMockDeployedEnvServicesLister(ctrl),
+				stacks:      make(map[string]*mocks.MockstackDescriber),
+			}
+			for _, name := range []string{"phonetool-test", "phonetool-test-AddonsStack", "phonetool-test-api", "phonetool-test-report",
+				"phonetool-test-report-AddonsStack", "phonetool-test-worker"} {
+				m.stacks[name] = mocks.NewMockstackDescriber(ctrl)
+			}
+			tc.setupMocks(m)
+			d := &appGraphDescriber{
+				app:         "phonetool",
+				configStore: m.configStore,
+				deployStore: m.deployStore,
+				newStackDescriber: func(_ *config.Environment, stackName string) (stackDescriber, error) {
+					s, ok := m.stacks[stackName]
+					if !ok {
+						return nil, fmt.Errorf("unexpected stack %s", stackName)
+					}
+					return s, nil
+				},
+			}
+
+			// WHEN
+			graph, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, graph)
+		})
+	}
+}
+
+func TestAppGraph_String(t *testing.T) {
+	graph := &AppGraph{
+		App: "phonetool",
+		Environments: []*GraphEnvironment{
+			{
+				Name:   "test",
+				Region: "us-west-2",
+				Nodes: []graphNode{
+					{ID: "test.api", Name: "api", Kind: graphKindService, Type: manifestinfo.LoadBalancedWebServiceType},
+					{ID: "test.api.orders", Name: "orders", Kind: graphKindTopic, Type: "SNS topic"},
+					{ID: "test.api.table", Name: "table", Kind: graphKindStorage, Type: "DynamoDB table"},
+				},
+				Dependencies: []graphEdge{
+					{From: "test.api", To: "test.api.orders", Label: "publishes"},
+					{From: "test.api", To: "test.api.table", Label: "uses"},
+				},
+			},
+		},
+	}
+
+	t.Run("dot", func(t *testing.T) {
+		require.Equal(t, `digraph "phonetool" {
+  rankdir=LR;
+  subgraph "cluster_test" {
+    label="test (us-west-2)";
+    "test.api" [label="api", shape=box];
+    "test.api.orders" [label="orders", shape=cds];
+    "test.api.table" [label="table", shape=cylinder];
+  }
+  "test.api" -> "test.api.orders" [label="publishes"];
+  "test.api" -> "test.api.table" [label="uses"];
+}
+`, graph.DOTString())
+	})
+	t.Run("mermaid", func(t *testing.T) {
+		require.Equal(t, `flowchart LR
+  subgraph env1 ["test (us-west-2)"]
+    n1["api"]
+    n2>"orders"]
+    n3[("table")]
+  end
+  n1 -->|publishes| n2
+  n1 -->|uses| n3
+`, graph.MermaidString())
+	})
+	t.Run("html", func(t *testing.T) {
+		page, err := graph.HTMLString()
+		require.NoError(t, err)
+		require.Contains(t, page, "<title>phonetool</title>")
+		require.Contains(t, page, `n2&gt;&#34;orders&#34;]`)
+	})
+	t.Run("json", func(t *testing.T) {
+		data, err := graph.JSONString()
+		require.NoError(t, err)
+		require.Contains(t, data, `{"application":"phonetool","environments":[{"name":"test","region":"us-west-2","nodes":[{"id":"test.api"`)
+	})
+	t.Run("human", func(t *testing.T) {
+		require.Equal(t, `Environment test (us-west-2)
+
+  Name        Kind      Type
+  ----        ----      ----
+  api         service   Load Balanced Web Service
+  api/orders  topic     SNS topic
+  api/table   storage   DynamoDB table
+
+  Dependencies
+
+  api     publishes  api/orders
+  api     uses       api/table
+`, graph.HumanString())
+	})
+}
@@ -249,6 +249,15 @@ func (d *BackendServiceDescriber) Manifest(env string) ([]byte, error) {
 	return cfn.Manifest()
 }
 
+// RenderedManifest returns the manifest used to deploy a backend service stack with its environment variables substituted.
+func (d *BackendServiceDescriber) RenderedManifest(env string) ([]byte, error) {
+	cfn, err := d.initECSServiceDescribers(env)
+	if err != nil {
+		return nil, err
+	}
+	return cfn.RenderedManifest()
+}
+
 // backendSvcDesc contains serialized parameters for a backend service.
 type backendSvcDesc struct {
 	ecsSvcDesc
@@ -0,0 +1,555 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/pricing"
+	"github.com/aws/copilot-cli/internal/pkg/aws/rds"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	hoursPerMonth = 730
+	costCurrency  = "USD"
+
+	natGatewayResourceType   = "AWS::EC2::NatGateway"
+	loadBalancerResourceType = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+
+	auroraServerlessV1EngineMode = "serverless"
+)
+
+// Units of the quantity of a priced resource.
+const (
+	costUnitVCPU  = "vCPU"
+	costUnitGB    = "GB"
+	costUnitACU   = "ACU"
+	costUnitCount = "count"
+)
+
+// priceQuery identifies the hourly on-demand price of a resource in the AWS Price List Service.
+type priceQuery struct {
+	resource    string
+	serviceCode string
+	attributes  map[string]string
+	usageType   string // Usage type without the region prefix, such as "Fargate-vCPU-Hours:perCPU".
+}
+
+var (
+	fargateVCPUPrice = priceQuery{
+		resource:    "Fargate vCPU",
+		serviceCode: "AmazonECS",
+		attributes:  map[string]string{"productFamily": "Compute"},
+		usageType:   "Fargate-vCPU-Hours:perCPU",
+	}
+	fargateMemoryPrice = priceQuery{
+		resource:    "Fargate memory",
+		serviceCode: "AmazonECS",
+		attributes:  map[string]string{"productFamily": "Compute"},
+		usageType:   "Fargate-GB-Hours",
+	}
+	fargateARMVCPUPrice = priceQuery{
+		resource:    "Fargate vCPU (ARM)",
+		serviceCode: "AmazonECS",
+		attributes:  map[string]string{"productFamily": "Compute"},
+		usageType:   "Fargate-ARM-vCPU-Hours:perCPU",
+	}
+	fargateARMMemoryPrice = priceQuery{
+		resource:    "Fargate memory (ARM)",
+		serviceCode: "AmazonECS",
+		attributes:  map[string]string{"productFamily": "Compute"},
+		usageType:   "Fargate-ARM-GB-Hours",
+	}
+	natGatewayPrice = priceQuery{
+		resource:    "NAT gateway",
+		serviceCode: "AmazonEC2",
+		attributes:  map[string]string{"productFamily": "NAT Gateway"},
+		usageType:   "NatGateway-Hours",
+	}
+	albPrice = priceQuery{
+		resource:    "Application Load Balancer",
+		serviceCode: "AWSELB",
+		attributes:  map[string]string{"productFamily": "Load Balancer-Application"},
+		usageType:   "LoadBalancerUsage",
+	}
+	auroraServerlessV2Price = priceQuery{
+		resource:    "Aurora Serverless v2",
+		serviceCode: "AmazonRDS",
+		attributes:  map[string]string{"productFamily": "ServerlessV2"},
+		usageType:   "Aurora:ServerlessV2Usage",
+	}
+	auroraServerlessV1Price = priceQuery{
+		resource:    "Aurora Serverless v1",
+		serviceCode: "AmazonRDS",
+		attributes:  map[string]string{"productFamily": "Serverless"},
+		usageType:   "Aurora:ServerlessUsage",
+	}
+)
+
+type pricer interface {
+	Prices(serviceCode, region string, attributes map[string]string) ([]pricing.Price, error)
+}
+
+type clusterLister interface {
+	ListClusters(tags map[string]string) ([]rds.Cluster, error)
+}
+
+type fargateDescriber interface {
+	Params() (map[string]string, error)
+	Platform() (*awsecs.ContainerPlatform, error)
+}
+
+type envResourcesDescriber interface {
+	Resources() ([]*stack.Resource, error)
+}
+
+// CostEstimator estimates the monthly cost of the resources provisioned for an application.
+type CostEstimator struct {
+	app string
+
+	configStore          ConfigStoreSvc
+	deployStore          DeployedEnvServicesLister
+	pricer               pricer
+	initEnvDescriber     func(env *config.Environment) (envResourcesDescriber, error)
+	initClusterLister    func(env *config.Environment) (clusterLister, error)
+	initFargateDescriber func(env, svc string) (fargateDescriber, error)
+
+	// Cached prices keyed by region, service code and attributes.
+	prices map[string][]pricing.Price
+}
+
+// NewCostEstimatorConfig contains fields that initiates a CostEstimator struct.
+type NewCostEstimatorConfig struct {
+	App         string
+	ConfigStore ConfigStoreSvc
+	DeployStore DeployedEnvServicesLister
+}
+
+// NewCostEstimator instantiates an estimator of the monthly cost of an application.
+func NewCostEstimator(opt NewCostEstimatorConfig) (*CostEstimator, error) {
+	defaultSess, err := sessions.ImmutableProvider().Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	return &CostEstimator{
+		app:         opt.App,
+		configStore: opt.ConfigStore,
+		deployStore: opt.DeployStore,
+		pricer:      pricing.New(defaultSess),
+		initEnvDescriber: func(env *config.Environment) (envResourcesDescriber, error) {
+			sess, err := sessions.ImmutableProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("assume role for environment %s: %w", env.ManagerRoleARN, err)
+			}
+			return stack.NewStackDescriber(cfnstack.NameForEnv(opt.App, env.Name), sess), nil
+		},
+		initClusterLister: func(env *config.Environment) (clusterLister, error) {
+			sess, err := sessions.ImmutableProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("assume role for environment %s: %w", env.ManagerRoleARN, err)
+			}
+			return rds.New(sess), nil
+		},
+		initFargateDescriber: func(env, svc string) (fargateDescriber, error) {
+			return newECSServiceDescriber(NewServiceConfig{
+				App:         opt.App,
+				Env:         env,
+				Svc:         svc,
+				ConfigStore: opt.ConfigStore,
+			})
+		},
+		prices: make(map[string][]pricing.Price),
+	}, nil
+}
+
+// Application estimates the monthly cost of all the environments of the application and the services deployed to them.
+func (e *CostEstimator) Application() (*CostEstimate, error) {
+	envs, err := e.configStore.ListEnvironments(e.app)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", e.app, err)
+	}
+	estimate := &CostEstimate{Currency: costCurrency}
+	for _, env := range envs {
+		svcs, err := e.deployStore.ListDeployedServices(e.app, env.Name)
+		if err != nil {
+			return nil, fmt.Errorf("list deployed services in environment %s: %w", env.Name, err)
+		}
+		envCost, err := e.estimateEnv(env, true, svcs)
+		if err != nil {
+			return nil, err
+		}
+		estimate.add(envCost)
+	}
+	return estimate, nil
+}
+
+// Environment estimates the monthly cost of an environment and the services deployed to it.
+func (e *CostEstimator) Environment(envName string) (*CostEstimate, error) {
+	env, err := e.configStore.GetEnvironment(e.app, envName)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", envName, err)
+	}
+	svcs, err := e.deployStore.ListDeployedServices(e.app, envName)
+	if err != nil {
+		return nil, fmt.Errorf("list deployed services in environment %s: %w", envName, err)
+	}
+	envCost, err := e.estimateEnv(env, true, svcs)
+	if err != nil {
+		return nil, err
+	}
+	estimate := &CostEstimate{Currency: costCurrency}
+	estimate.add(envCost)
+	return estimate, nil
+}
+
+// Service estimates the monthly cost of a service in each environment that it's deployed to.
+func (e *CostEstimator) Service(svc string) (*CostEstimate, error) {
+	envNames, err := e.deployStore.ListEnvironmentsDeployedTo(e.app, svc)
+	if err != nil {
+		return nil, fmt.Errorf("list deployed environments for service %s: %w", svc, err)
+	}
+	estimate := &CostEstimate{Currency: costCurrency}
+	for _, envName := range envNames {
+		env, err := e.configStore.GetEnvironment(e.app, envName)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s: %w", envName, err)
+		}
+		envCost, err := e.estimateEnv(env, false, []string{svc})
+		if err != nil {
+			return nil, err
+		}
+		estimate.add(envCost)
+	}
+	return estimate, nil
+}
+
+// estimateEnv estimates the cost of the services in the environment, and of the resources shared by them if withShared is true.
+func (e *CostEstimator) estimateEnv(env *config.Environment, withShared bool, svcs []string) (*EnvCost, error) {
+	envCost := &EnvCost{
+		Environment: env.Name,
+		Region:      env.Region,
+	}
+	clusters, err := e.listClusters(env)
+	if err != nil {
+		return nil, err
+	}
+	if withShared {
+		resources, err := e.estimateSharedResources(env, clusters[""])
+		if err != nil {
+			return nil, err
+		}
+		envCost.Resources = resources
+	}
+	for _, svc := range svcs {
+		resources, err := e.estimateService(env, svc, clusters[svc])
+		if err != nil {
+			return nil, err
+		}
+		envCost.Services = append(envCost.Services, &WorkloadCost{
+			Name:      svc,
+			Resources: resources,
+		})
+	}
+	envCost.total()
+	return envCost, nil
+}
+
+// listClusters returns the Aurora clusters of the environment keyed by the service they belong to.
+// The clusters that belong to the environment are keyed by an empty string.
+func (e *CostEstimator) listClusters(env *config.Environment) (map[string][]rds.Cluster, error) {
+	lister, err := e.initClusterLister(env)
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := lister.ListClusters(map[string]string{
+		deploy.AppTagKey: e.app,
+		deploy.EnvTagKey: env.Name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list Aurora clusters in environment %s: %w", env.Name, err)
+	}
+	bySvc := make(map[string][]rds.Cluster)
+	for _, cluster := range clusters {
+		svc := cluster.Tags[deploy.ServiceTagKey]
+		bySvc[svc] = append(bySvc[svc], cluster)
+	}
+	return bySvc, nil
+}
+
+func (e *CostEstimator) estimateSharedResources(env *config.Environment, clusters []rds.Cluster) ([]*ResourceCost, error) {
+	describer, err := e.initEnvDescriber(env)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := describer.Resources()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve environment resources: %w", err)
+	}
+	var natGateways, loadBalancers int
+	for _, resource := range resources {
+		switch resource.Type {
+		case natGatewayResourceType:
+			natGateways++
+		case loadBalancerResourceType:
+			// The load balancers of an environment stack are always Application Load Balancers.
+			loadBalancers++
+		}
+	}
+	var costs []*ResourceCost
+	if natGateways > 0 {
+		cost, err := e.cost(env.Region, natGatewayPrice, float64(natGateways), costUnitCount)
+		if err != nil {
+			return nil, err
+		}
+		costs = append(costs, cost)
+	}
+	if loadBalancers > 0 {
+		cost, err := e.cost(env.Region, albPrice, float64(loadBalancers), costUnitCount)
+		if err != nil {
+			return nil, err
+		}
+		costs = append(costs, cost)
+	}
+	auroraCosts, err := e.estimateClusters(env.Region, clusters)
+	if err != nil {
+		return nil, err
+	}
+	return append(costs, auroraCosts...), nil
+}
+
+func (e *CostEstimator) estimateService(env *config.Environment, svc string, clusters []rds.Cluster) ([]*ResourceCost, error) {
+	describer, err := e.initFargateDescriber(env.Name, svc)
+	if err != nil {
+		return nil, err
+	}
+	params, err := describer.Params()
+	if err != nil {
+		return nil, fmt.Errorf("get stack parameters for service %s: %w", svc, err)
+	}
+	var costs []*ResourceCost
+	// Only the services that run on Fargate have task sizes, unlike Request-Driven Web Services and Static Sites.
+	if _, ok := params[cfnstack.WorkloadTaskCPUParamKey]; ok {
+		costs, err = e.estimateFargate(env.Region, describer, params)
+		if err != nil {
+			return nil, fmt.Errorf("estimate Fargate cost of service %s: %w", svc, err)
+		}
+	}
+	auroraCosts, err := e.estimateClusters(env.Region, clusters)
+	if err != nil {
+		return nil, err
+	}
+	return append(costs, auroraCosts...), nil
+}
+
+// estimateFargate estimates the cost of the desired number of tasks of a service.
+func (e *CostEstimator) estimateFargate(region string, describer fargateDescriber, params map[string]string) ([]*ResourceCost, error) {
+	cpu, err := strconv.ParseFloat(params[cfnstack.WorkloadTaskCPUParamKey], 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse task CPU %q: %w", params[cfnstack.WorkloadTaskCPUParamKey], err)
+	}
+	memory, err := strconv.ParseFloat(params[cfnstack.WorkloadTaskMemoryParamKey], 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse task memory %q: %w", params[cfnstack.WorkloadTaskMemoryParamKey], err)
+	}
+	tasks, err := strconv.ParseFloat(params[cfnstack.WorkloadTaskCountParamKey], 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse task count %q: %w", params[cfnstack.WorkloadTaskCountParamKey], err)
+	}
+	platform, err := describer.Platform()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve platform: %w", err)
+	}
+	vCPUPrice, memoryPrice := fargateVCPUPrice, fargateMemoryPrice
+	if platform.Architecture == template.ArchARM64 {
+		vCPUPrice, memoryPrice = fargateARMVCPUPrice, fargateARMMemoryPrice
+	}
+	vCPUCost, err := e.cost(region, vCPUPrice, cpu/1024*tasks, costUnitVCPU)
+	if err != nil {
+		return nil, err
+	}
+	memoryCost, err := e.cost(region, memoryPrice, memory/1024*tasks, costUnitGB)
+	if err != nil {
+		return nil, err
+	}
+	return []*ResourceCost{vCPUCost, memoryCost}, nil
+}
+
+// estimateClusters estimates the cost of Aurora Serverless clusters running at their minimum capacity.
+// Provisioned clusters are not estimated since their cost depends on the classes of their instances.
+func (e *CostEstimator) estimateClusters(region string, clusters []rds.Cluster) ([]*ResourceCost, error) {
+	var costs []*ResourceCost
+	for _, cluster := range clusters {
+		if cluster.MinCapacity == 0 {
+			continue
+		}
+		query := auroraServerlessV2Price
+		if cluster.EngineMode == auroraServerlessV1EngineMode {
+			query = auroraServerlessV1Price
+		}
+		cost, err := e.cost(region, query, cluster.MinCapacity, costUnitACU)
+		if err != nil {
+			return nil, err
+		}
+		cost.Resource = fmt.Sprintf("%s (%s)", cost.Resource, cluster.ID)
+		costs = append(costs, cost)
+	}
+	return costs, nil
+}
+
+// cost returns the monthly cost of running a quantity of a resource for every hour of the month.
+func (e *CostEstimator) cost(region string, query priceQuery, quantity float64, unit string) (*ResourceCost, error) {
+	hourly, err := e.hourlyPrice(region, query)
+	if err != nil {
+		return nil, err
+	}
+	return &ResourceCost{
+		Resource:    query.resource,
+		Quantity:    quantity,
+		Unit:        unit,
+		MonthlyCost: roundCents(hourly * quantity * hoursPerMonth),
+	}, nil
+}
+
+func (e *CostEstimator) hourlyPrice(region string, query priceQuery) (float64, error) {
+	key := fmt.Sprintf("%s/%s/%v", region, query.serviceCode, query.attributes)
+	prices, ok := e.prices[key]
+	if !ok {
+		var err error
+		prices, err = e.pricer.Prices(query.serviceCode, region, query.attributes)
+		if err != nil {
+			return 0, fmt.Errorf("get prices of %s: %w", query.resource, err)
+		}
+		e.prices[key] = prices
+	}
+	for _, price := range prices {
+		// Usage types are prefixed by an abbreviation of the region, except in some regions such as us-east-1.
+		if price.UsageType == query.usageType || strings.HasSuffix(price.UsageType, "-"+query.usageType) {
+			return price.USD, nil
+		}
+	}
+	return 0, fmt.Errorf("no price found for %s in region %s", query.resource, region)
+}
+
+func roundCents(usd float64) float64 {
+	return math.Round(usd*100) / 100
+}
+
+// CostEstimate is the estimated monthly cost of environments and the services deployed to them.
+type CostEstimate struct {
+	Currency     string     `json:"currency"`
+	Environments []*EnvCost `json:"environments"`
+	Total        float64    `json:"total"`
+}
+
+// EnvCost is the estimated monthly cost of an environment.
+type EnvCost struct {
+	Environment string          `json:"environment"`
+	Region      string          `json:"region"`
+	Resources   []*ResourceCost `json:"resources,omitempty"` // Resources shared by the services of the environment.
+	Services    []*WorkloadCost `json:"services"`
+	Total       float64         `json:"total"`
+}
+
+// WorkloadCost is the estimated monthly cost of a service in an environment.
+type WorkloadCost struct {
+	Name      string          `json:"name"`
+	Resources []*ResourceCost `json:"resources"`
+	Total     float64         `json:"total"`
+}
+
+// ResourceCost is the estimated monthly cost of a quantity of a resource running for the whole month.
+type ResourceCost struct {
+	Resource    string  `json:"resource"`
+	Quantity    float64 `json:"quantity"`
+	Unit        string  `json:"unit"`
+	MonthlyCost float64 `json:"monthlyCost"`
+}
+
+func (c *EnvCost) total() {
+	var total float64
+	for _, resource := range c.Resources {
+		total += resource.MonthlyCost
+	}
+	for _, svc := range c.Services {
+		svc.Total = 0
+		for _, resource := range svc.Resources {
+			svc.Total += resource.MonthlyCost
+		}
+		svc.Total = roundCents(svc.Total)
+		total += svc.Total
+	}
+	sort.SliceStable(c.Services, func(i, j int) bool { return c.Services[i].Name < c.Services[j].Name })
+	c.Total = roundCents(total)
+}
+
+func (e *CostEstimate) add(env *EnvCost) {
+	e.Environments = append(e.Environments, env)
+	e.Total = roundCents(e.Total + env.Total)
+}
+
+// JSONString returns the stringified CostEstimate struct in json format.
+func (e *CostEstimate) JSONString() (string, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return "", fmt.Errorf("marshal cost estimate: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified CostEstimate struct in human readable format.
+func (e *CostEstimate) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Estimated Monthly Cost\n\n"))
+	writer.Flush()
+	headers := []string{"Environment", "Service", "Resource", "Quantity", "Cost"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, env := range e.Environments {
+		for _, resource := range env.Resources {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", env.Environment, "-", resource.humanString())
+		}
+		for _, svc := range env.Services {
+			for _, resource := range svc.Resources {
+				fmt.Fprintf(writer, "  %s\t%s\t%s\n", env.Environment, svc.Name, resource.humanString())
+			}
+		}
+	}
+	fmt.Fprint(writer, color.Bold.Sprint("\nTotal\n\n"))
+	writer.Flush()
+	for _, env := range e.Environments {
+		fmt.Fprintf(writer, "  %s (%s)\t%s\n", env.Environment, env.Region, formatUSD(env.Total))
+	}
+	fmt.Fprintf(writer, "  %s\t%s\n", "All environments", formatUSD(e.Total))
+	writer.Flush()
+	fmt.Fprintf(&b, "\nEstimates assume that the resources run all month at their desired count or minimum capacity with on-demand prices.\nThey exclude usage-based charges such as data processing, requests and storage.\n")
+	return b.String()
+}
+
+func (c *ResourceCost) humanString() string {
+	quantity := strconv.FormatFloat(c.Quantity, 'f', -1, 64)
+	if c.Unit != costUnitCount {
+		quantity = fmt.Sprintf("%s %s", quantity, c.Unit)
+	}
+	return fmt.Sprintf("%s\t%s\t%s", c.Resource, quantity, formatUSD(c.MonthlyCost))
+}
+
+func formatUSD(usd float64) string {
+	return fmt.Sprintf("$%.2f", usd)
+}
@@ -0,0 +1,356 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/pricing"
+	"github.com/aws/copilot-cli/internal/pkg/aws/rds"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type costEstimatorMocks struct {
+	configStore *mocks.MockConfigStoreSvc
+	deployStore *mocks.MockDeployedEnvServicesLister
+	pricer      *mocks.Mockpricer
+	envStack    *mocks.MockenvResourcesDescriber
+	clusters    *mocks.MockclusterLister
+	svcs        map[string]*mocks.MockfargateDescriber
+}
+
+func newCostEstimatorMocks(ctrl *gomock.Controller) costEstimatorMocks {
+	return costEstimatorMocks{
+		configStore: mocks.NewMockConfigStoreSvc(ctrl),
+		deployStore: mocks.NewMockDeployedEnvServicesLister(ctrl),
+		pricer:      mocks.NewMockpricer(ctrl),
+		envStack:    mocks.NewMockenvResourcesDescriber(ctrl),
+		clusters:    mocks.NewMockclusterLister(ctrl),
+		svcs: map[string]*mocks.MockfargateDescriber{
+			"api":  mocks.NewMockfargateDescriber(ctrl),
+			"site": mocks.NewMockfargateDescriber(ctrl),
+		},
+	}
+}
+
+func (m costEstimatorMocks) estimator() *CostEstimator {
+	return &CostEstimator{
+		app:         "phonetool",
+		configStore: m.configStore,
+		deployStore: m.deployStore,
+		pricer:      m.pricer,
+		initEnvDescriber: func(env *config.Environment) (envResourcesDescriber, error) {
+			return m.envStack, nil
+		},
+		initClusterLister: func(env *config.Environment) (clusterLister, error) {
+			return m.clusters, nil
+		},
+		initFargateDescriber: func(env, svc string) (fargateDescriber, error) {
+			d, ok := m.svcs[svc]
+			if !ok {
+				return nil, fmt.Errorf("unexpected service %s", svc)
+			}
+			return d, nil
+		},
+		prices: make(map[string][]pricing.Price),
+	}
+}
+
+func (m costEstimatorMocks) expectPrices() {
+	m.pricer.EXPECT().Prices("AmazonECS", "us-west-2", map[string]string{"productFamily": "Compute"}).Return([]pricing.Price{
+		{UsageType: "USW2-Fargate-ARM-vCPU-Hours:perCPU", USD: 0.03238},
+		{UsageType: "USW2-Fargate-vCPU-Hours:perCPU", USD: 0.04048},
+		{UsageType: "USW2-Fargate-GB-Hours", USD: 0.004445},
+	}, nil)
+	m.pricer.EXPECT().Prices("AmazonEC2", "us-west-2", map[string]string{"productFamily": "NAT Gateway"}).Return([]pricing.Price{
+		{UsageType: "USW2-NatGateway-Bytes", USD: 0.045},
+		{UsageType: "USW2-NatGateway-Hours", USD: 0.045},
+	}, nil)
+	m.pricer.EXPECT().Prices("AWSELB", "us-west-2", map[string]string{"productFamily": "Load Balancer-Application"}).Return([]pricing.Price{
+		{UsageType: "USW2-LoadBalancerUsage", USD: 0.0225},
+	}, nil)
+	m.pricer.EXPECT().Prices("AmazonRDS", "us-west-2", map[string]string{"productFamily": "ServerlessV2"}).Return([]pricing.Price{
+		{UsageType: "USW2-Aurora:ServerlessV2Usage", USD: 0.12},
+	}, nil)
+}
+
+func TestCostEstimator_Environment(t *testing.T) {
+	testEnv := &config.Environment{Name: "test", Region: "us-west-2"}
+	testCases := map[string]struct {
+		setupMocks func(m costEstimatorMocks)
+
+		wanted      *CostEstimate
+		wantedError error
+	}{
+		"wrap the error if fail to list the Aurora clusters": {
+			setupMocks: func(m costEstimatorMocks) {
+				m.configStore.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return(nil, nil)
+				m.clusters.EXPECT().ListClusters(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list Aurora clusters in environment test: some error"),
+		},
+		"return an error if no price matches the usage type": {
+			setupMocks: func(m costEstimatorMocks) {
+				m.configStore.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return(nil, nil)
+				m.clusters.EXPECT().ListClusters(gomock.Any()).Return(nil, nil)
+				m.envStack.EXPECT().Resources().Return([]*stack.Resource{
+					{Type: "AWS::EC2::NatGateway", PhysicalID: "nat-1"},
+				}, nil)
+				m.pricer.EXPECT().Prices("AmazonEC2", "us-west-2", gomock.Any()).Return([]pricing.Price{
+					{UsageType: "USW2-NatGateway-Bytes", USD: 0.045},
+				}, nil)
+			},
+			wantedError: errors.New("no price found for NAT gateway in region us-west-2"),
+		},
+		"estimate the shared resources and the services of the environment": {
+			setupMocks: func(m costEstimatorMocks) {
+				m.configStore.EXPECT().GetEnvironment("phonetool", "test").Return(testEnv, nil)
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"site", "api"}, nil)
+				m.clusters.EXPECT().ListClusters(map[string]string{
+					"copilot-application": "phonetool",
+					"copilot-environment": "test",
+				}).Return([]rds.Cluster{
+					{ID: "provisioned", Tags: map[string]string{}},
+					{ID: "usersdb", MinCapacity: 0.5, Tags: map[string]string{"copilot-service": "api"}},
+				}, nil)
+				m.envStack.EXPECT().Resources().Return([]*stack.Resource{
+					{Type: "AWS::EC2::NatGateway", PhysicalID: "nat-1"},
+					{Type: "AWS::EC2::NatGateway", PhysicalID: "nat-2"},
+					{Type: "AWS::ElasticLoadBalancingV2::LoadBalancer", PhysicalID: "alb"},
+					{Type: "AWS::EC2::VPC", PhysicalID: "vpc"},
+				}, nil)
+				m.svcs["api"].EXPECT().Params().Return(map[string]string{
+					"TaskCPU":    "512",
+					"TaskMemory": "1024",
+					"TaskCount":  "2",
+				}, nil)
+				m.svcs["api"].EXPECT().Platform().Return(&awsecs.ContainerPlatform{OperatingSystem: "LINUX", Architecture: "X86_64"}, nil)
+				m.svcs["site"].EXPECT().Params().Return(map[string]string{}, nil)
+				m.expectPrices()
+			},
+			wanted: &CostEstimate{
+				Currency: "USD",
+				Environments: []*EnvCost{
+					{
+						Environment: "test",
+						Region:      "us-west-2",
+						Resources: []*ResourceCost{
+							{Resource: "NAT gateway", Quantity: 2, Unit: "count", MonthlyCost: 65.7},
+							{Resource: "Application Load Balancer", Quantity: 1, Unit: "count", MonthlyCost: 16.43},
+						},
+						Services: []*WorkloadCost{
+							{
+								Name: "api",
+								Resources: []*ResourceCost{
+									{Resource: "Fargate vCPU", Quantity: 1, Unit: "vCPU", MonthlyCost: 29.55},
+									{Resource: "Fargate memory", Quantity: 2, Unit: "GB", MonthlyCost: 6.49},
+									{Resource: "Aurora Serverless v2 (usersdb)", Quantity: 0.5, Unit: "ACU", MonthlyCost: 43.8},
+								},
+								Total: 79.84,
+							},
+							{
+								Name: "site",
+							},
+						},
+						Total: 161.97,
+					},
+				},
+				Total: 161.97,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newCostEstimatorMocks(ctrl)
+			tc.setupMocks(m)
+
+			got, err := m.estimator().Environment("test")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestCostEstimator_Service(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m costEstimatorMocks)
+
+		wanted      *CostEstimate
+		wantedError error
+	}{
+		"wrap the error if fail to get the stack parameters": {
+			setupMocks: func(m costEstimatorMocks) {
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "api").Return([]string{"test"}, nil)
+				m.configStore.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test", Region: "us-west-2"}, nil)
+				m.clusters.EXPECT().ListClusters(gomock.Any()).Return(nil, nil)
+				m.svcs["api"].EXPECT().Params().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get stack parameters for service api: some error"),
+		},
+		"estimate only the service in each environment with ARM prices": {
+			setupMocks: func(m costEstimatorMocks) {
+				m.deployStore.EXPECT().ListEnvironmentsDeployedTo("phonetool", "api").Return([]string{"test", "prod"}, nil)
+				m.configStore.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test", Region: "us-west-2"}, nil)
+				m.configStore.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{Name: "prod", Region: "us-west-2"}, nil)
+				m.clusters.EXPECT().ListClusters(gomock.Any()).Return([]rds.Cluster{
+					{ID: "shared", MinCapacity: 1, Tags: map[string]string{}},
+				}, nil).Times(2)
+				m.svcs["api"].EXPECT().Params().Return(map[string]string{
+					"TaskCPU":    "1024",
+					"TaskMemory": "2048",
+					"TaskCount":  "1",
+				}, nil).Times(2)
+				m.svcs["api"].EXPECT().Platform().Return(&awsecs.ContainerPlatform{OperatingSystem: "LINUX", Architecture: "ARM64"}, nil).Times(2)
+				m.pricer.EXPECT().Prices("AmazonECS", "us-west-2", gomock.Any()).Return([]pricing.Price{
+					{UsageType: "USW2-Fargate-ARM-vCPU-Hours:perCPU", USD: 0.03238},
+					{UsageType: "USW2-Fargate-ARM-GB-Hours", USD: 0.00356},
+				}, nil)
+			},
+			wanted: &CostEstimate{
+				Currency: "USD",
+				Environments: []*EnvCost{
+					{
+						Environment: "test",
+						Region:      "us-west-2",
+						Services: []*WorkloadCost{
+							{
+								Name: "api",
+								Resources: []*ResourceCost{
+									{Resource: "Fargate vCPU (ARM)", Quantity: 1, Unit: "vCPU", MonthlyCost: 23.64},
+									{Resource: "Fargate memory (ARM)", Quantity: 2, Unit: "GB", MonthlyCost: 5.2},
+								},
+								Total: 28.84,
+							},
+						},
+						Total: 28.84,
+					},
+					{
+						Environment: "prod",
+						Region:      "us-west-2",
+						Services: []*WorkloadCost{
+							{
+								Name: "api",
+								Resources: []*ResourceCost{
+									{Resource: "Fargate vCPU (ARM)", Quantity: 1, Unit: "vCPU", MonthlyCost: 23.64},
+									{Resource: "Fargate memory (ARM)", Quantity: 2, Unit: "GB", MonthlyCost: 5.2},
+								},
+								Total: 28.84,
+							},
+						},
+						Total: 28.84,
+					},
+				},
+				Total: 57.68,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := newCostEstimatorMocks(ctrl)
+			tc.setupMocks(m)
+
+			got, err := m.estimator().Service("api")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestCostEstimate_HumanString(t *testing.T) {
+	estimate := &CostEstimate{
+		Currency: "USD",
+		Environments: []*EnvCost{
+			{
+				Environment: "test",
+				Region:      "us-west-2",
+				Resources: []*ResourceCost{
+					{Resource: "NAT gateway", Quantity: 2, Unit: "count", MonthlyCost: 65.7},
+				},
+				Services: []*WorkloadCost{
+					{
+						Name: "api",
+						Resources: []*ResourceCost{
+							{Resource: "Fargate vCPU", Quantity: 0.5, Unit: "vCPU", MonthlyCost: 14.78},
+						},
+						Total: 14.78,
+					},
+				},
+				Total: 80.48,
+			},
+		},
+		Total: 80.48,
+	}
+
+	wanted := `Estimated Monthly Cost
+
+  Environment  Service   Resource      Quantity  Cost
+  -----------  -------   --------      --------  ----
+  test         -         NAT gateway   2         $65.70
+  test         api       Fargate vCPU  0.5 vCPU  $14.78
+
+Total
+
+  test (us-west-2)  $80.48
+  All environments  $80.48
+
+Estimates assume that the resources run all month at their desired count or minimum capacity with on-demand prices.
+They exclude usage-based charges such as data processing, requests and storage.
+`
+
+	require.Equal(t, wanted, estimate.HumanString())
+}
+
+func TestCostEstimate_JSONString(t *testing.T) {
+	estimate := &CostEstimate{
+		Currency: "USD",
+		Environments: []*EnvCost{
+			{
+				Environment: "test",
+				Region:      "us-west-2",
+				Services: []*WorkloadCost{
+					{
+						Name: "api",
+						Resources: []*ResourceCost{
+							{Resource: "Fargate vCPU", Quantity: 0.5, Unit: "vCPU", MonthlyCost: 14.78},
+						},
+						Total: 14.78,
+					},
+				},
+				Total: 14.78,
+			},
+		},
+		Total: 14.78,
+	}
+
+	got, err := estimate.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `{"currency":"USD","environments":[{"environment":"test","region":"us-west-2","services":[{"name":"api","resources":[{"resource":"Fargate vCPU","quantity":0.5,"unit":"vCPU","monthlyCost":14.78}],"total":14.78}],"total":14.78}],"total":14.78}
+`, got)
+}
@@ -36,6 +36,12 @@ type HumanJSONStringer interface {
 	JSONString() (string, error)
 }
 
+// GraphStringer is a HumanJSONStringer that can also be rendered as a graph in the DOT language.
+type GraphStringer interface {
+	HumanJSONStringer
+	DOTString() string
+}
+
 type stackDescriber interface {
 	Describe() (stack.StackDescription, error)
 	Resources() ([]*stack.Resource, error)
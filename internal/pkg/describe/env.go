@@ -10,6 +10,7 @@ import (
 	"sort"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/version"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cache"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
@@ -28,6 +30,10 @@ var (
 	fmtLegacySvcDiscoveryEndpoint = "%s.local"
 )
 
+// envCacheTTL is how long the description of the environment stack is reused by the next commands.
+// Deploying the environment from this machine clears it earlier.
+const envCacheTTL = 10 * time.Minute
+
 type vpcSubnetLister interface {
 	ListVPCSubnets(vpcID string) (*ec2.VPCSubnets, error)
 }
@@ -59,6 +65,7 @@ type EnvDescriber struct {
 	deployStore  DeployedEnvServicesLister
 	cfn          stackDescriber
 	subnetLister vpcSubnetLister
+	cache        *cache.Cache
 
 	// Cached values for reuse.
 	description *EnvDescription
@@ -71,6 +78,10 @@ type NewEnvDescriberConfig struct {
 	EnableResources bool
 	ConfigStore     ConfigStoreSvc
 	DeployStore     DeployedEnvServicesLister
+
+	// Cached reuses the description of the environment stack read by recent commands.
+	// Only set it in commands that don't change the environment.
+	Cached bool
 }
 
 // NewEnvDescriber instantiates an environment describer.
@@ -83,7 +94,7 @@ func NewEnvDescriber(opt NewEnvDescriberConfig) (*EnvDescriber, error) {
 	if err != nil {
 		return nil, fmt.Errorf("assume role for environment %s: %w", env.ManagerRoleARN, err)
 	}
-	return &EnvDescriber{
+	d := &EnvDescriber{
 		app:             opt.App,
 		env:             env,
 		enableResources: opt.EnableResources,
@@ -92,7 +103,11 @@ func NewEnvDescriber(opt NewEnvDescriberConfig) (*EnvDescriber, error) {
 		deployStore:  opt.DeployStore,
 		cfn:          stack.NewStackDescriber(cfnstack.NameForEnv(opt.App, opt.Env), sess),
 		subnetLister: ec2.New(sess),
-	}, nil
+	}
+	if opt.Cached {
+		d.cache = cache.New()
+	}
+	return d, nil
 }
 
 // Describe returns info about an application's environment.
@@ -117,7 +132,7 @@ func (d *EnvDescriber) Describe() (*EnvDescription, error) {
 
 	var stackResources []*stack.Resource
 	if d.enableResources {
-		stackResources, err = d.cfn.Resources()
+		stackResources, err = cache.Fetch(d.cache, d.cacheKey("resources"), envCacheTTL, d.cfn.Resources)
 		if err != nil {
 			return nil, fmt.Errorf("retrieve environment resources: %w", err)
 		}
@@ -240,12 +255,26 @@ func (d *EnvDescriber) PublicCIDRBlocks() ([]string, error) {
 	return cidrBlocks, nil
 }
 
+// envStackInfo is the part of the description of an environment that only changes when the environment is deployed.
+type envStackInfo struct {
+	Tags map[string]string `json:"tags"`
+	VPC  EnvironmentVPC    `json:"vpc"`
+}
+
 func (d *EnvDescriber) loadStackInfo() (map[string]string, EnvironmentVPC, error) {
+	info, err := cache.Fetch(d.cache, d.cacheKey("stack"), envCacheTTL, d.describeStack)
+	if err != nil {
+		return nil, EnvironmentVPC{}, err
+	}
+	return info.Tags, info.VPC, nil
+}
+
+func (d *EnvDescriber) describeStack() (envStackInfo, error) {
 	var environmentVPC EnvironmentVPC
 
 	envStack, err := d.cfn.Describe()
 	if err != nil {
-		return nil, environmentVPC, fmt.Errorf("retrieve environment stack: %w", err)
+		return envStackInfo{}, fmt.Errorf("retrieve environment stack: %w", err)
 	}
 
 	for k, v := range envStack.Outputs {
@@ -259,7 +288,11 @@ func (d *EnvDescriber) loadStackInfo() (map[string]string, EnvironmentVPC, error
 		}
 	}
 
-	return envStack.Tags, environmentVPC, nil
+	return envStackInfo{Tags: envStack.Tags, VPC: environmentVPC}, nil
+}
+
+func (d *EnvDescriber) cacheKey(name string) string {
+	return cache.EnvPrefix(d.env.AccountID, d.env.Region, d.app, d.env.Name) + name
 }
 
 func (d *EnvDescriber) filterDeployedSvcs() ([]*config.Workload, error) {
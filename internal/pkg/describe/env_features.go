@@ -0,0 +1,142 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+)
+
+// Statuses of an optional environment feature.
+const (
+	EnvFeatureEnabled         = "enabled"
+	EnvFeatureDisabled        = "disabled"
+	EnvFeatureUpgradeRequired = "upgrade required"
+)
+
+const envFeatureFlowLogs = "VPC flow logs"
+
+// EnvFeature holds the status of an optional feature of an environment.
+type EnvFeature struct {
+	Name         string   `json:"name"`
+	Status       string   `json:"status"`
+	Workloads    []string `json:"workloads,omitempty"`
+	LeastVersion string   `json:"leastVersion,omitempty"`
+}
+
+// EnvFeatures holds the optional features of an environment, and the template versions they are compared against.
+type EnvFeatures struct {
+	Environment   string        `json:"environment"`
+	Version       string        `json:"version"`
+	LatestVersion string        `json:"latestVersion"`
+	Features      []*EnvFeature `json:"features"`
+}
+
+// Features returns which optional features are enabled in the environment, and which ones
+// require to upgrade the environment to the latest template version first.
+//
+// The features that the env controller manages are enabled as soon as a workload requires them,
+// while the other features are enabled in the manifest of the environment.
+func (d *EnvDescriber) Features() (*EnvFeatures, error) {
+	params, err := d.Params()
+	if err != nil {
+		return nil, fmt.Errorf("get parameters of environment %s: %w", d.env.Name, err)
+	}
+	envVersion, err := d.Version()
+	if err != nil {
+		return nil, fmt.Errorf("get template version of environment %s: %w", d.env.Name, err)
+	}
+	rawMft, err := d.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf("get manifest of environment %s: %w", d.env.Name, err)
+	}
+	mft, err := manifest.UnmarshalEnvironment(rawMft)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest of environment %s: %w", d.env.Name, err)
+	}
+
+	out := &EnvFeatures{
+		Environment:   d.env.Name,
+		Version:       envVersion,
+		LatestVersion: version.LatestTemplateVersion(),
+	}
+	for _, name := range template.AvailableEnvFeatures() {
+		feature := &EnvFeature{
+			Name:         template.FriendlyEnvFeatureName(name),
+			Status:       EnvFeatureDisabled,
+			LeastVersion: template.LeastVersionForFeature(name),
+		}
+		workloads, ok := params[name]
+		switch {
+		case !ok:
+			feature.Status = EnvFeatureUpgradeRequired
+		case workloads != "":
+			feature.Status = EnvFeatureEnabled
+			feature.Workloads = strings.Split(workloads, ",")
+		}
+		out.Features = append(out.Features, feature)
+	}
+	flowLogs := &EnvFeature{
+		Name:   envFeatureFlowLogs,
+		Status: EnvFeatureDisabled,
+	}
+	if !mft.Network.VPC.FlowLogs.IsZero() {
+		flowLogs.Status = EnvFeatureEnabled
+	}
+	out.Features = append(out.Features, flowLogs)
+	return out, nil
+}
+
+// RequiresUpgrade returns the features that are only available after upgrading the environment.
+func (f *EnvFeatures) RequiresUpgrade() []*EnvFeature {
+	var out []*EnvFeature
+	for _, feature := range f.Features {
+		if feature.Status == EnvFeatureUpgradeRequired {
+			out = append(out, feature)
+		}
+	}
+	return out
+}
+
+// JSONString returns the stringified EnvFeatures struct with json format.
+func (f *EnvFeatures) JSONString() (string, error) {
+	b, err := json.Marshal(f)
+	if err != nil {
+		return "", fmt.Errorf("marshal environment features: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified EnvFeatures struct with human readable format.
+func (f *EnvFeatures) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("About\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Name", f.Environment)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Version", f.Version)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Latest Version", f.LatestVersion)
+	fmt.Fprint(writer, color.Bold.Sprint("\nFeatures\n\n"))
+	writer.Flush()
+	headers := []string{"Name", "Status", "Since", "Workloads"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, feature := range f.Features {
+		since := feature.LeastVersion
+		if since == "" {
+			since = "-"
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", feature.Name, feature.Status, since, strings.Join(feature.Workloads, ", "))
+	}
+	writer.Flush()
+	return b.String()
+}
@@ -0,0 +1,129 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/version"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvDescriber_FeatureStatuses(t *testing.T) {
+	testCases := map[string]struct {
+		setupMock func(m *mocks.MockstackDescriber)
+
+		wanted    *EnvFeatures
+		wantedErr error
+	}{
+		"error describing stack": {
+			setupMock: func(m *mocks.MockstackDescriber) {
+				m.EXPECT().Describe().Return(stack.StackDescription{}, errors.New("some error"))
+			},
+			wantedErr: errors.New("get parameters of environment test: some error"),
+		},
+		"reports enabled, disabled and missing features": {
+			setupMock: func(m *mocks.MockstackDescriber) {
+				params := map[string]string{
+					"AppName":         "phonetool",
+					"EnvironmentName": "test",
+				}
+				for _, f := range template.AvailableEnvFeatures() {
+					params[f] = ""
+				}
+				params[template.ALBFeatureName] = "frontend,api"
+				delete(params, template.MutualTLSVerifyFeatureName)
+				delete(params, template.MutualTLSPassthroughFeatureName)
+				m.EXPECT().Describe().Return(stack.StackDescription{Parameters: params}, nil)
+				m.EXPECT().StackMetadata().Return(`{"Version":"v1.30.0","Manifest":"\nname: test\ntype: Environment\nnetwork:\n  vpc:\n    flow_logs: on"}`, nil).Times(2)
+			},
+			wanted: &EnvFeatures{
+				Environment:   "test",
+				Version:       "v1.30.0",
+				LatestVersion: version.LatestTemplateVersion(),
+				Features: []*EnvFeature{
+					{Name: "ALB", Status: EnvFeatureEnabled, Workloads: []string{"frontend", "api"}, LeastVersion: "v1.0.0"},
+					{Name: "EFS", Status: EnvFeatureDisabled, LeastVersion: "v1.3.0"},
+					{Name: "NAT Gateway", Status: EnvFeatureDisabled, LeastVersion: "v1.3.0"},
+					{Name: "Internal ALB", Status: EnvFeatureDisabled, LeastVersion: "v1.10.0"},
+					{Name: "Aliases", Status: EnvFeatureDisabled, LeastVersion: "v1.4.0"},
+					{Name: "App Runner Private Services", Status: EnvFeatureDisabled, LeastVersion: "v1.23.0"},
+					{Name: "Service Connect TLS", Status: EnvFeatureDisabled, LeastVersion: "v1.30.0"},
+					{Name: "Mutual TLS (verify)", Status: EnvFeatureUpgradeRequired, LeastVersion: "v1.43.0"},
+					{Name: "Mutual TLS (passthrough)", Status: EnvFeatureUpgradeRequired, LeastVersion: "v1.43.0"},
+					{Name: "VPC flow logs", Status: EnvFeatureEnabled},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockstackDescriber(ctrl)
+			tc.setupMock(m)
+			d := &EnvDescriber{
+				app: "phonetool",
+				env: &config.Environment{Name: "test"},
+				cfn: m,
+			}
+
+			// WHEN
+			got, err := d.Features()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestEnvFeatures_HumanString(t *testing.T) {
+	// GIVEN
+	features := &EnvFeatures{
+		Environment:   "test",
+		Version:       "v1.30.0",
+		LatestVersion: "v1.43.0",
+		Features: []*EnvFeature{
+			{Name: "ALB", Status: EnvFeatureEnabled, Workloads: []string{"frontend", "api"}, LeastVersion: "v1.0.0"},
+			{Name: "Mutual TLS (verify)", Status: EnvFeatureUpgradeRequired, LeastVersion: "v1.43.0"},
+			{Name: "VPC flow logs", Status: EnvFeatureDisabled},
+		},
+	}
+
+	// WHEN
+	human := features.HumanString()
+	data, err := features.JSONString()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `About
+
+  Name            test
+  Version         v1.30.0
+  Latest Version  v1.43.0
+
+Features
+
+  Name                 Status            Since     Workloads
+  ----                 ------            -----     ---------
+  ALB                  enabled           v1.0.0    frontend, api
+  Mutual TLS (verify)  upgrade required  v1.43.0   
+  VPC flow logs        disabled          -         
+`, human)
+	require.Equal(t, fmt.Sprintf("%s\n", `{"environment":"test","version":"v1.30.0","latestVersion":"v1.43.0","features":[{"name":"ALB","status":"enabled","workloads":["frontend","api"],"leastVersion":"v1.0.0"},{"name":"Mutual TLS (verify)","status":"upgrade required","leastVersion":"v1.43.0"},{"name":"VPC flow logs","status":"disabled"}]}`), data)
+	require.Len(t, features.RequiresUpgrade(), 1)
+}
@@ -0,0 +1,712 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/iam"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	cfnstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"gopkg.in/yaml.v3"
+)
+
+// Types of IAM policies attached to a role.
+const (
+	IAMPolicyTypeInline  = "inline"
+	IAMPolicyTypeManaged = "managed"
+)
+
+// Sources of the IAM policies attached to a role.
+const (
+	IAMPolicySourceCopilot = "copilot"
+	IAMPolicySourceAddons  = "addons"
+)
+
+// Statuses of an IAM policy compared to the deployed role.
+const (
+	IAMPolicyStatusDeployed   = "deployed"
+	IAMPolicyStatusMissing    = "missing"
+	IAMPolicyStatusUnexpected = "unexpected"
+)
+
+var iamPolicyRoleLogicalIDs = []string{"TaskRole", "ExecutionRole"}
+
+// subVarPattern matches the variables such as ${AWS::Region} or ${AddonsStack.Outputs.Policy} in a Fn::Sub string.
+var subVarPattern = regexp.MustCompile(`\$\{([^!}][^}]*)\}`)
+
+type stackTemplateDescriber interface {
+	Describe(name string) (*cloudformation.StackDescription, error)
+	StackResources(name string) ([]*cloudformation.StackResource, error)
+	TemplateBody(name string) (string, error)
+}
+
+type rolePoliciesLister interface {
+	ListRolePolicyNames(roleName string) ([]string, error)
+	ListAttachedRolePolicyARNs(roleName string) ([]string, error)
+}
+
+// IAMPolicyDescriber retrieves the IAM policies attached to the task and execution roles of a deployed service.
+type IAMPolicyDescriber struct {
+	app string
+	env string
+	svc string
+
+	cfn stackTemplateDescriber
+	iam rolePoliciesLister
+}
+
+// NewIAMPolicyDescriberConfig contains fields that initiates an IAMPolicyDescriber struct.
+type NewIAMPolicyDescriberConfig struct {
+	App         string
+	Env         string
+	Svc         string
+	ConfigStore ConfigStoreSvc
+}
+
+// NewIAMPolicyDescriber instantiates a describer of the IAM policies of a service deployed in an environment.
+func NewIAMPolicyDescriber(opt NewIAMPolicyDescriberConfig) (*IAMPolicyDescriber, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.ImmutableProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("assume role for environment %s: %w", env.ManagerRoleARN, err)
+	}
+	return &IAMPolicyDescriber{
+		app: opt.App,
+		env: opt.Env,
+		svc: opt.Svc,
+		cfn: cloudformation.New(sess),
+		iam: iam.New(sess),
+	}, nil
+}
+
+// Describe returns the IAM policies that the deployed stack of the service attaches to its task and execution roles,
+// including the managed policies created by addons.
+func (d *IAMPolicyDescriber) Describe() (*IAMPolicies, error) {
+	return d.describe()
+}
+
+// Check returns the same IAM policies as Describe, compared against the policies currently attached to the deployed roles.
+// Policies that are attached to a role but are not part of the stack are reported with the "unexpected" status.
+func (d *IAMPolicyDescriber) Check() (*IAMPolicies, error) {
+	policies, err := d.describe()
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range policies.Roles {
+		if err := d.check(role); err != nil {
+			return nil, err
+		}
+	}
+	policies.checked = true
+	return policies, nil
+}
+
+func (d *IAMPolicyDescriber) describe() (*IAMPolicies, error) {
+	stackName := cfnstack.NameForWorkload(d.app, d.env, d.svc)
+	tpl, err := d.template(stackName)
+	if err != nil {
+		return nil, err
+	}
+	descr, err := d.cfn.Describe(stackName)
+	if err != nil {
+		return nil, fmt.Errorf("describe stack %s: %w", stackName, err)
+	}
+	resources, err := d.cfn.StackResources(stackName)
+	if err != nil {
+		return nil, fmt.Errorf("list resources of stack %s: %w", stackName, err)
+	}
+	physicalIDs := make(map[string]string)
+	for _, resource := range resources {
+		physicalIDs[aws.StringValue(resource.LogicalResourceId)] = aws.StringValue(resource.PhysicalResourceId)
+	}
+
+	r := &cfnResolver{
+		params:     pseudoParameters(descr),
+		conditions: tpl.Conditions,
+	}
+	for _, param := range descr.Parameters {
+		r.params[aws.StringValue(param.ParameterKey)] = aws.StringValue(param.ParameterValue)
+	}
+	var addons *cfnTemplate
+	if addonsStackID := physicalIDs[template.AddonsStackLogicalID]; addonsStackID != "" {
+		if addons, err = d.template(addonsStackID); err != nil {
+			return nil, err
+		}
+		addonsDescr, err := d.cfn.Describe(addonsStackID)
+		if err != nil {
+			return nil, fmt.Errorf("describe stack %s: %w", addonsStackID, err)
+		}
+		r.addonsOutputs = make(map[string]string)
+		for _, out := range addonsDescr.Outputs {
+			r.addonsOutputs[aws.StringValue(out.OutputKey)] = aws.StringValue(out.OutputValue)
+		}
+	}
+
+	policies := &IAMPolicies{
+		Service:     d.svc,
+		Environment: d.env,
+	}
+	for _, logicalID := range iamPolicyRoleLogicalIDs {
+		resource, ok := tpl.Resources[logicalID]
+		if !ok {
+			// The role is not created by Copilot, for example when the manifest references an existing role.
+			continue
+		}
+		role, err := r.role(logicalID, resource.Properties, addons)
+		if err != nil {
+			return nil, fmt.Errorf("read policies of %s in stack %s: %w", logicalID, stackName, err)
+		}
+		role.Name = physicalIDs[logicalID]
+		policies.Roles = append(policies.Roles, role)
+	}
+	return policies, nil
+}
+
+func (d *IAMPolicyDescriber) template(stackName string) (*cfnTemplate, error) {
+	body, err := d.cfn.TemplateBody(stackName)
+	if err != nil {
+		return nil, fmt.Errorf("get template of stack %s: %w", stackName, err)
+	}
+	var tpl cfnTemplate
+	if err := yaml.Unmarshal([]byte(body), &tpl); err != nil {
+		return nil, fmt.Errorf("unmarshal template of stack %s: %w", stackName, err)
+	}
+	return &tpl, nil
+}
+
+func (d *IAMPolicyDescriber) check(role *IAMRole) error {
+	roleName := role.Name
+	if roleName == "" {
+		return nil
+	}
+	inline, err := d.iam.ListRolePolicyNames(roleName)
+	if err != nil {
+		return fmt.Errorf("list inline policies of role %s: %w", roleName, err)
+	}
+	managed, err := d.iam.ListAttachedRolePolicyARNs(roleName)
+	if err != nil {
+		return fmt.Errorf("list managed policies of role %s: %w", roleName, err)
+	}
+	deployed := map[string]map[string]bool{
+		IAMPolicyTypeInline:  make(map[string]bool),
+		IAMPolicyTypeManaged: make(map[string]bool),
+	}
+	for _, name := range inline {
+		deployed[IAMPolicyTypeInline][name] = true
+	}
+	for _, policyARN := range managed {
+		deployed[IAMPolicyTypeManaged][policyARN] = true
+	}
+	for _, policy := range role.Policies {
+		policy.Status = IAMPolicyStatusMissing
+		if deployed[policy.Type][policy.Name] {
+			policy.Status = IAMPolicyStatusDeployed
+			delete(deployed[policy.Type], policy.Name)
+		}
+	}
+	for _, typ := range []string{IAMPolicyTypeInline, IAMPolicyTypeManaged} {
+		var names []string
+		for name := range deployed[typ] {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			role.Policies = append(role.Policies, &IAMPolicy{
+				Type:   typ,
+				Name:   name,
+				Status: IAMPolicyStatusUnexpected,
+			})
+		}
+	}
+	return nil
+}
+
+// IAMPolicies contains the IAM policies attached to the roles of a service in an environment.
+type IAMPolicies struct {
+	Service     string     `json:"service"`
+	Environment string     `json:"environment"`
+	Roles       []*IAMRole `json:"roles"`
+
+	checked bool
+}
+
+// IAMRole is a role created for a service along with its policies.
+type IAMRole struct {
+	LogicalID string       `json:"logicalID"`
+	Name      string       `json:"name,omitempty"`
+	Policies  []*IAMPolicy `json:"policies"`
+}
+
+// IAMPolicy is an inline or managed policy attached to a role.
+// The name of a managed policy is its ARN.
+type IAMPolicy struct {
+	Type      string `json:"type"`
+	Name      string `json:"name"`
+	Source    string `json:"source,omitempty"`
+	Condition string `json:"condition,omitempty"` // Set if the policy is only attached when a condition that could not be evaluated is true.
+	Document  string `json:"document,omitempty"`
+	Status    string `json:"status,omitempty"`
+}
+
+// Drifted returns true if a policy is missing from a deployed role, or if a deployed role has a policy that isn't part of the stack.
+func (p *IAMPolicies) Drifted() bool {
+	for _, role := range p.Roles {
+		for _, policy := range role.Policies {
+			if policy.Status == IAMPolicyStatusMissing || policy.Status == IAMPolicyStatusUnexpected {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// JSONString returns the stringified IAMPolicies struct in json format.
+func (p *IAMPolicies) JSONString() (string, error) {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return "", fmt.Errorf("marshal IAM policies: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified IAMPolicies struct in human readable format.
+func (p *IAMPolicies) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("IAM Roles\n\n"))
+	writer.Flush()
+	if len(p.Roles) == 0 {
+		fmt.Fprintf(writer, "  Service %s does not create any task or execution role in environment %s.\n", p.Service, p.Environment)
+		writer.Flush()
+		return b.String()
+	}
+	for _, role := range p.Roles {
+		fmt.Fprintf(writer, "  %s\t%s\n", role.LogicalID, valueOrDash(role.Name))
+	}
+	fmt.Fprint(writer, color.Bold.Sprint("\nPolicies\n\n"))
+	writer.Flush()
+	headers := []string{"Role", "Type", "Name", "Source"}
+	if p.checked {
+		headers = append(headers, "Status")
+	}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, role := range p.Roles {
+		for _, policy := range role.Policies {
+			name := policy.Name
+			if policy.Condition != "" {
+				name = fmt.Sprintf("%s (if %s)", name, policy.Condition)
+			}
+			cells := []string{role.LogicalID, policy.Type, name, valueOrDash(policy.Source)}
+			if p.checked {
+				cells = append(cells, policy.Status)
+			}
+			fmt.Fprintf(writer, "  %s\n", strings.Join(cells, "\t"))
+		}
+	}
+	writer.Flush()
+	fmt.Fprint(&b, color.Bold.Sprint("\nPolicy Documents\n"))
+	for _, role := range p.Roles {
+		for _, policy := range role.Policies {
+			if policy.Document == "" {
+				continue
+			}
+			fmt.Fprintf(&b, "\n  %s: %s\n", role.LogicalID, policy.Name)
+			for _, line := range strings.Split(policy.Document, "\n") {
+				fmt.Fprintf(&b, "    %s\n", line)
+			}
+		}
+	}
+	if p.checked {
+		if p.Drifted() {
+			fmt.Fprint(&b, "\nThe policies attached to the deployed roles differ from the policies in the stack of the service.\n")
+		} else {
+			fmt.Fprint(&b, "\nThe policies attached to the deployed roles match the policies in the stack of the service.\n")
+		}
+	}
+	return b.String()
+}
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+type cfnTemplate struct {
+	Conditions map[string]yaml.Node `yaml:"Conditions"`
+	Resources  map[string]struct {
+		Type       string    `yaml:"Type"`
+		Properties yaml.Node `yaml:"Properties"`
+	} `yaml:"Resources"`
+	Outputs map[string]struct {
+		Value yaml.Node `yaml:"Value"`
+	} `yaml:"Outputs"`
+}
+
+// cfnResolver resolves the intrinsic functions of a deployed CloudFormation template that are needed to name policies.
+type cfnResolver struct {
+	params        map[string]string
+	conditions    map[string]yaml.Node
+	addonsOutputs map[string]string
+}
+
+func pseudoParameters(descr *cloudformation.StackDescription) map[string]string {
+	params := map[string]string{
+		"AWS::StackName": aws.StringValue(descr.StackName),
+		"AWS::StackId":   aws.StringValue(descr.StackId),
+	}
+	if parsed, err := arn.Parse(aws.StringValue(descr.StackId)); err == nil {
+		params["AWS::Partition"] = parsed.Partition
+		params["AWS::Region"] = parsed.Region
+		params["AWS::AccountId"] = parsed.AccountID
+	}
+	return params
+}
+
+func (r *cfnResolver) role(logicalID string, properties yaml.Node, addons *cfnTemplate) (*IAMRole, error) {
+	var props struct {
+		Policies          []yaml.Node `yaml:"Policies"`
+		ManagedPolicyArns []yaml.Node `yaml:"ManagedPolicyArns"`
+	}
+	if err := properties.Decode(&props); err != nil {
+		return nil, err
+	}
+	role := &IAMRole{
+		LogicalID: logicalID,
+	}
+	for i := range props.Policies {
+		node, cond := r.unwrapIf(&props.Policies[i])
+		if node == nil {
+			continue
+		}
+		var inline struct {
+			PolicyName     yaml.Node `yaml:"PolicyName"`
+			PolicyDocument yaml.Node `yaml:"PolicyDocument"`
+		}
+		if err := node.Decode(&inline); err != nil {
+			return nil, fmt.Errorf("decode inline policy: %w", err)
+		}
+		doc, err := documentString(&inline.PolicyDocument)
+		if err != nil {
+			return nil, err
+		}
+		role.Policies = append(role.Policies, &IAMPolicy{
+			Type:      IAMPolicyTypeInline,
+			Name:      r.stringOrExpr(&inline.PolicyName),
+			Source:    IAMPolicySourceCopilot,
+			Condition: cond,
+			Document:  doc,
+		})
+	}
+	for i := range props.ManagedPolicyArns {
+		node, cond := r.unwrapIf(&props.ManagedPolicyArns[i])
+		if node == nil {
+			continue
+		}
+		policy := &IAMPolicy{
+			Type:      IAMPolicyTypeManaged,
+			Name:      r.stringOrExpr(node),
+			Source:    IAMPolicySourceCopilot,
+			Condition: cond,
+		}
+		if output, ok := addonsOutput(node); ok {
+			policy.Source = IAMPolicySourceAddons
+			doc, err := addonsPolicyDocument(addons, output)
+			if err != nil {
+				return nil, err
+			}
+			policy.Document = doc
+		}
+		role.Policies = append(role.Policies, policy)
+	}
+	return role, nil
+}
+
+// addonsPolicyDocument returns the document of the AWS::IAM::ManagedPolicy resource referenced by an output of the addons stack.
+func addonsPolicyDocument(addons *cfnTemplate, output string) (string, error) {
+	if addons == nil {
+		return "", nil
+	}
+	out, ok := addons.Outputs[output]
+	if !ok {
+		return "", nil
+	}
+	name, arg := intrinsic(&out.Value)
+	if name != "Ref" {
+		return "", nil
+	}
+	resource, ok := addons.Resources[arg.Value]
+	if !ok || resource.Type != "AWS::IAM::ManagedPolicy" {
+		return "", nil
+	}
+	var props struct {
+		PolicyDocument yaml.Node `yaml:"PolicyDocument"`
+	}
+	if err := resource.Properties.Decode(&props); err != nil {
+		return "", fmt.Errorf("decode managed policy %s in addons: %w", arg.Value, err)
+	}
+	return documentString(&props.PolicyDocument)
+}
+
+// addonsOutput returns the name of the addons stack output if the node is a Fn::GetAtt on the output.
+func addonsOutput(node *yaml.Node) (string, bool) {
+	name, arg := intrinsic(node)
+	if name != "Fn::GetAtt" {
+		return "", false
+	}
+	logicalID, attr, ok := getAttArgs(arg)
+	if !ok || logicalID != template.AddonsStackLogicalID || !strings.HasPrefix(attr, "Outputs.") {
+		return "", false
+	}
+	return strings.TrimPrefix(attr, "Outputs."), true
+}
+
+// unwrapIf returns the node itself, or the branch of a Fn::If that applies.
+// If the condition can't be evaluated, the "true" branch is returned along with the name of the condition.
+// A nil node is returned if the branch that applies is AWS::NoValue.
+func (r *cfnResolver) unwrapIf(node *yaml.Node) (*yaml.Node, string) {
+	name, arg := intrinsic(node)
+	if name != "Fn::If" || arg.Kind != yaml.SequenceNode || len(arg.Content) != 3 {
+		return node, ""
+	}
+	cond := arg.Content[0].Value
+	val, ok := r.condition(cond)
+	branch := arg.Content[1]
+	if ok && !val {
+		branch = arg.Content[2]
+	}
+	if ref, refArg := intrinsic(branch); ref == "Ref" && refArg.Value == "AWS::NoValue" {
+		return nil, ""
+	}
+	if ok {
+		return branch, ""
+	}
+	return branch, cond
+}
+
+func (r *cfnResolver) condition(name string) (val bool, ok bool) {
+	node, exists := r.conditions[name]
+	if !exists {
+		return false, false
+	}
+	return r.evaluate(&node)
+}
+
+func (r *cfnResolver) evaluate(node *yaml.Node) (val bool, ok bool) {
+	name, arg := intrinsic(node)
+	switch name {
+	case "Condition":
+		return r.condition(arg.Value)
+	case "Fn::Equals":
+		if arg.Kind != yaml.SequenceNode || len(arg.Content) != 2 {
+			return false, false
+		}
+		left, lok := r.resolve(arg.Content[0])
+		right, rok := r.resolve(arg.Content[1])
+		return left == right, lok && rok
+	case "Fn::Not":
+		if arg.Kind != yaml.SequenceNode || len(arg.Content) != 1 {
+			return false, false
+		}
+		val, ok := r.evaluate(arg.Content[0])
+		return !val, ok
+	case "Fn::And", "Fn::Or":
+		if arg.Kind != yaml.SequenceNode {
+			return false, false
+		}
+		isAnd := name == "Fn::And"
+		for _, c := range arg.Content {
+			val, ok := r.evaluate(c)
+			if !ok {
+				return false, false
+			}
+			if val != isAnd {
+				return val, true
+			}
+		}
+		return isAnd, true
+	}
+	return false, false
+}
+
+// stringOrExpr returns the resolved value of the node, or the node in flow style if it can't be resolved.
+func (r *cfnResolver) stringOrExpr(node *yaml.Node) string {
+	if s, ok := r.resolve(node); ok {
+		return s
+	}
+	out, err := yaml.Marshal(flowStyle(node))
+	if err != nil {
+		return node.Value
+	}
+	return strings.TrimSpace(string(out))
+}
+
+func (r *cfnResolver) resolve(node *yaml.Node) (string, bool) {
+	name, arg := intrinsic(node)
+	switch name {
+	case "":
+		if node.Kind != yaml.ScalarNode {
+			return "", false
+		}
+		return node.Value, true
+	case "Ref":
+		val, ok := r.params[arg.Value]
+		return val, ok
+	case "Fn::GetAtt":
+		logicalID, attr, ok := getAttArgs(arg)
+		if !ok {
+			return "", false
+		}
+		return r.getAtt(logicalID, attr)
+	case "Fn::Join":
+		if arg.Kind != yaml.SequenceNode || len(arg.Content) != 2 || arg.Content[1].Kind != yaml.SequenceNode {
+			return "", false
+		}
+		var elems []string
+		for _, c := range arg.Content[1].Content {
+			elem, ok := r.resolve(c)
+			if !ok {
+				return "", false
+			}
+			elems = append(elems, elem)
+		}
+		return strings.Join(elems, arg.Content[0].Value), true
+	case "Fn::Sub":
+		return r.sub(arg)
+	case "Fn::If":
+		branch, cond := r.unwrapIf(node)
+		if branch == nil || cond != "" {
+			return "", false
+		}
+		return r.resolve(branch)
+	}
+	return "", false
+}
+
+func (r *cfnResolver) sub(arg *yaml.Node) (string, bool) {
+	str := arg
+	vars := make(map[string]string)
+	if arg.Kind == yaml.SequenceNode {
+		if len(arg.Content) != 2 || arg.Content[1].Kind != yaml.MappingNode {
+			return "", false
+		}
+		str = arg.Content[0]
+		for i := 0; i+1 < len(arg.Content[1].Content); i += 2 {
+			val, ok := r.resolve(arg.Content[1].Content[i+1])
+			if !ok {
+				return "", false
+			}
+			vars[arg.Content[1].Content[i].Value] = val
+		}
+	}
+	if str.Kind != yaml.ScalarNode {
+		return "", false
+	}
+	resolved := true
+	out := subVarPattern.ReplaceAllStringFunc(str.Value, func(match string) string {
+		name := subVarPattern.FindStringSubmatch(match)[1]
+		if val, ok := vars[name]; ok {
+			return val
+		}
+		if val, ok := r.params[name]; ok {
+			return val
+		}
+		if logicalID, attr, found := strings.Cut(name, "."); found {
+			if val, ok := r.getAtt(logicalID, attr); ok {
+				return val
+			}
+		}
+		resolved = false
+		return match
+	})
+	return out, resolved
+}
+
+func (r *cfnResolver) getAtt(logicalID, attr string) (string, bool) {
+	if logicalID != template.AddonsStackLogicalID || !strings.HasPrefix(attr, "Outputs.") {
+		return "", false
+	}
+	val, ok := r.addonsOutputs[strings.TrimPrefix(attr, "Outputs.")]
+	return val, ok
+}
+
+// intrinsic returns the name and the argument of the intrinsic function in the node, in either its short or full form.
+// An empty name is returned if the node isn't an intrinsic function.
+func intrinsic(node *yaml.Node) (string, *yaml.Node) {
+	if strings.HasPrefix(node.Tag, "!") && !strings.HasPrefix(node.Tag, "!!") {
+		name := strings.TrimPrefix(node.Tag, "!")
+		if name != "Ref" && name != "Condition" {
+			name = "Fn::" + name
+		}
+		arg := *node
+		arg.Tag = ""
+		return name, &arg
+	}
+	if node.Kind == yaml.MappingNode && len(node.Content) == 2 {
+		key := node.Content[0].Value
+		if key == "Ref" || key == "Condition" || strings.HasPrefix(key, "Fn::") {
+			return key, node.Content[1]
+		}
+	}
+	return "", node
+}
+
+func getAttArgs(arg *yaml.Node) (logicalID, attr string, ok bool) {
+	switch arg.Kind {
+	case yaml.ScalarNode:
+		return strings.Cut(arg.Value, ".")
+	case yaml.SequenceNode:
+		if len(arg.Content) != 2 {
+			return "", "", false
+		}
+		return arg.Content[0].Value, arg.Content[1].Value, true
+	}
+	return "", "", false
+}
+
+func flowStyle(node *yaml.Node) *yaml.Node {
+	cp := *node
+	cp.HeadComment, cp.LineComment, cp.FootComment = "", "", ""
+	if cp.Kind == yaml.MappingNode || cp.Kind == yaml.SequenceNode {
+		cp.Style |= yaml.FlowStyle
+	}
+	cp.Content = make([]*yaml.Node, len(node.Content))
+	for i, c := range node.Content {
+		cp.Content[i] = flowStyle(c)
+	}
+	return &cp
+}
+
+func documentString(doc *yaml.Node) (string, error) {
+	if doc.Kind == 0 {
+		return "", nil
+	}
+	var b bytes.Buffer
+	enc := yaml.NewEncoder(&b)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return "", fmt.Errorf("marshal policy document: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", fmt.Errorf("marshal policy document: %w", err)
+	}
+	return strings.TrimSpace(b.String()), nil
+}
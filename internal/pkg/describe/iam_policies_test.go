@@ -0,0 +1,305 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIAMPoliciesStackID  = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api/1"
+	testIAMPoliciesAddonsID = "arn:aws:cloudformation:us-west-2:123456789012:stack/phonetool-test-api-AddonsStack-1A2B/2"
+	testIAMPoliciesTemplate = `Parameters:
+  AppName:
+    Type: String
+  EnvName:
+    Type: String
+  WorkloadName:
+    Type: String
+  EnvFileARN:
+    Type: String
+Conditions:
+  HasEnvFile:
+    !Not [!Equals [!Ref EnvFileARN, ""]]
+  HasAddons:
+    !Equals [!Ref AddonsTemplateURL, ""]
+Resources:
+  ExecutionRole:
+    Type: AWS::IAM::Role
+    Properties:
+      Policies:
+        - PolicyName: !Join ['', [!Ref AppName, '-', !Ref EnvName, '-', !Ref WorkloadName, SecretsPolicy]]
+          PolicyDocument:
+            Version: '2012-10-17'
+            Statement:
+              - Effect: 'Allow'
+                Action:
+                  - 'ssm:GetParameters'
+                Resource:
+                  - !Sub 'arn:${AWS::Partition}:ssm:${AWS::Region}:${AWS::AccountId}:parameter/*'
+        - !If
+          - HasEnvFile
+          - PolicyName: !Join ['', [!Ref AppName, '-', !Ref EnvName, '-', !Ref WorkloadName, GetEnvFilePolicy]]
+            PolicyDocument:
+              Version: '2012-10-17'
+          - !Ref AWS::NoValue
+      ManagedPolicyArns:
+        - !Sub 'arn:${AWS::Partition}:iam::aws:policy/service-role/AmazonECSTaskExecutionRolePolicy'
+  TaskRole:
+    Type: AWS::IAM::Role
+    Properties:
+      ManagedPolicyArns:
+        - Fn::GetAtt: [AddonsStack, Outputs.TableAccessPolicy]
+      Policies:
+        - PolicyName: 'DenyIAMExceptTaggedRoles'
+          PolicyDocument:
+            Version: '2012-10-17'
+            Statement:
+              - Effect: 'Deny'
+                Action: 'iam:*'
+                Resource: '*'
+        - !If
+          - HasAddons
+          - PolicyName: 'Conditional'
+            PolicyDocument:
+              Version: '2012-10-17'
+          - !Ref AWS::NoValue
+  AddonsStack:
+    Type: AWS::CloudFormation::Stack
+`
+	testIAMPoliciesAddonsTemplate = `Resources:
+  TableAccessPolicy:
+    Type: AWS::IAM::ManagedPolicy
+    Properties:
+      PolicyDocument:
+        Version: '2012-10-17'
+        Statement:
+          - Effect: Allow
+            Action: dynamodb:GetItem
+            Resource: !GetAtt Table.Arn
+Outputs:
+  TableAccessPolicy:
+    Value: !Ref TableAccessPolicy
+`
+)
+
+func TestIAMPolicyDescriber_Check(t *testing.T) {
+	const (
+		stackName         = "phonetool-test-api"
+		taskRoleName      = "phonetool-test-api-TaskRole-1A2B"
+		executionRoleName = "phonetool-test-api-ExecutionRole-3C4D"
+		addonsPolicyARN   = "arn:aws:iam::123456789012:policy/phonetool-test-api-AddonsStack-1A2B-TableAccessPolicy-5E6F"
+	)
+	mockDescribedStacks := func(m *mocks.MockstackTemplateDescriber) {
+		m.EXPECT().TemplateBody(stackName).Return(testIAMPoliciesTemplate, nil)
+		m.EXPECT().Describe(stackName).Return(&cloudformation.StackDescription{
+			StackId:   aws.String(testIAMPoliciesStackID),
+			StackName: aws.String(stackName),
+			Parameters: []*awscfn.Parameter{
+				{ParameterKey: aws.String("AppName"), ParameterValue: aws.String("phonetool")},
+				{ParameterKey: aws.String("EnvName"), ParameterValue: aws.String("test")},
+				{ParameterKey: aws.String("WorkloadName"), ParameterValue: aws.String("api")},
+				{ParameterKey: aws.String("EnvFileARN"), ParameterValue: aws.String("")},
+			},
+		}, nil)
+		m.EXPECT().StackResources(stackName).Return([]*cloudformation.StackResource{
+			{LogicalResourceId: aws.String("TaskRole"), PhysicalResourceId: aws.String(taskRoleName)},
+			{LogicalResourceId: aws.String("ExecutionRole"), PhysicalResourceId: aws.String(executionRoleName)},
+			{LogicalResourceId: aws.String("AddonsStack"), PhysicalResourceId: aws.String(testIAMPoliciesAddonsID)},
+		}, nil)
+		m.EXPECT().TemplateBody(testIAMPoliciesAddonsID).Return(testIAMPoliciesAddonsTemplate, nil)
+		m.EXPECT().Describe(testIAMPoliciesAddonsID).Return(&cloudformation.StackDescription{
+			Outputs: []*awscfn.Output{
+				{OutputKey: aws.String("TableAccessPolicy"), OutputValue: aws.String(addonsPolicyARN)},
+			},
+		}, nil)
+	}
+	testCases := map[string]struct {
+		setupMocks func(cfn *mocks.MockstackTemplateDescriber, iam *mocks.MockrolePoliciesLister)
+
+		wanted      *IAMPolicies
+		wantedError error
+	}{
+		"error if the template of the stack can't be retrieved": {
+			setupMocks: func(cfn *mocks.MockstackTemplateDescriber, _ *mocks.MockrolePoliciesLister) {
+				cfn.EXPECT().TemplateBody(stackName).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("get template of stack phonetool-test-api: some error"),
+		},
+		"error if the policies of a deployed role can't be listed": {
+			setupMocks: func(cfn *mocks.MockstackTemplateDescriber, iam *mocks.MockrolePoliciesLister) {
+				mockDescribedStacks(cfn)
+				iam.EXPECT().ListRolePolicyNames(taskRoleName).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list inline policies of role phonetool-test-api-TaskRole-1A2B: some error"),
+		},
+		"compares the policies in the stack with the policies of the deployed roles": {
+			setupMocks: func(cfn *mocks.MockstackTemplateDescriber, iam *mocks.MockrolePoliciesLister) {
+				mockDescribedStacks(cfn)
+				iam.EXPECT().ListRolePolicyNames(executionRoleName).Return([]string{"phonetool-test-apiSecretsPolicy"}, nil)
+				iam.EXPECT().ListAttachedRolePolicyARNs(executionRoleName).Return([]string{
+					"arn:aws:iam::aws:policy/service-role/AmazonECSTaskExecutionRolePolicy",
+				}, nil)
+				iam.EXPECT().ListRolePolicyNames(taskRoleName).Return([]string{"DenyIAMExceptTaggedRoles", "AddedByHand"}, nil)
+				iam.EXPECT().ListAttachedRolePolicyARNs(taskRoleName).Return(nil, nil)
+			},
+			wanted: &IAMPolicies{
+				Service:     "api",
+				Environment: "test",
+				Roles: []*IAMRole{
+					{
+						LogicalID: "TaskRole",
+						Name:      taskRoleName,
+						Policies: []*IAMPolicy{
+							{
+								Type:   IAMPolicyTypeInline,
+								Name:   "DenyIAMExceptTaggedRoles",
+								Source: IAMPolicySourceCopilot,
+								Document: `Version: '2012-10-17'
+Statement:
+  - Effect: 'Deny'
+    Action: 'iam:*'
+    Resource: '*'`,
+								Status: IAMPolicyStatusDeployed,
+							},
+							{
+								Type:      IAMPolicyTypeInline,
+								Name:      "Conditional",
+								Source:    IAMPolicySourceCopilot,
+								Condition: "HasAddons",
+								Document:  `Version: '2012-10-17'`,
+								Status:    IAMPolicyStatusMissing,
+							},
+							{
+								Type:   IAMPolicyTypeManaged,
+								Name:   addonsPolicyARN,
+								Source: IAMPolicySourceAddons,
+								Document: `Version: '2012-10-17'
+Statement:
+  - Effect: Allow
+    Action: dynamodb:GetItem
+    Resource: !GetAtt Table.Arn`,
+								Status: IAMPolicyStatusMissing,
+							},
+							{
+								Type:   IAMPolicyTypeInline,
+								Name:   "AddedByHand",
+								Status: IAMPolicyStatusUnexpected,
+							},
+						},
+					},
+					{
+						LogicalID: "ExecutionRole",
+						Name:      executionRoleName,
+						Policies: []*IAMPolicy{
+							{
+								Type:   IAMPolicyTypeInline,
+								Name:   "phonetool-test-apiSecretsPolicy",
+								Source: IAMPolicySourceCopilot,
+								Document: `Version: '2012-10-17'
+Statement:
+  - Effect: 'Allow'
+    Action:
+      - 'ssm:GetParameters'
+    Resource:
+      - !Sub 'arn:${AWS::Partition}:ssm:${AWS::Region}:${AWS::AccountId}:parameter/*'`,
+								Status: IAMPolicyStatusDeployed,
+							},
+							{
+								Type:   IAMPolicyTypeManaged,
+								Name:   "arn:aws:iam::aws:policy/service-role/AmazonECSTaskExecutionRolePolicy",
+								Source: IAMPolicySourceCopilot,
+								Status: IAMPolicyStatusDeployed,
+							},
+						},
+					},
+				},
+				checked: true,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cfn := mocks.NewMockstackTemplateDescriber(ctrl)
+			iam := mocks.NewMockrolePoliciesLister(ctrl)
+			tc.setupMocks(cfn, iam)
+			d := &IAMPolicyDescriber{
+				app: "phonetool",
+				env: "test",
+				svc: "api",
+				cfn: cfn,
+				iam: iam,
+			}
+
+			got, err := d.Check()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+				require.True(t, got.Drifted())
+			}
+		})
+	}
+}
+
+func TestIAMPolicies_HumanString(t *testing.T) {
+	policies := &IAMPolicies{
+		Service:     "api",
+		Environment: "test",
+		Roles: []*IAMRole{
+			{
+				LogicalID: "TaskRole",
+				Name:      "phonetool-test-api-TaskRole-1A2B",
+				Policies: []*IAMPolicy{
+					{
+						Type:     IAMPolicyTypeInline,
+						Name:     "DenyIAMExceptTaggedRoles",
+						Source:   IAMPolicySourceCopilot,
+						Document: "Version: '2012-10-17'",
+						Status:   IAMPolicyStatusDeployed,
+					},
+					{
+						Type:   IAMPolicyTypeInline,
+						Name:   "AddedByHand",
+						Status: IAMPolicyStatusUnexpected,
+					},
+				},
+			},
+		},
+		checked: true,
+	}
+	wanted := `IAM Roles
+
+  TaskRole  phonetool-test-api-TaskRole-1A2B
+
+Policies
+
+  Role      Type      Name                      Source    Status
+  ----      ----      ----                      ------    ------
+  TaskRole  inline    DenyIAMExceptTaggedRoles  copilot   deployed
+  TaskRole  inline    AddedByHand               -         unexpected
+
+Policy Documents
+
+  TaskRole: DenyIAMExceptTaggedRoles
+    Version: '2012-10-17'
+
+The policies attached to the deployed roles differ from the policies in the stack of the service.
+`
+
+	require.Equal(t, wanted, policies.HumanString())
+}
@@ -0,0 +1,259 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	stateMachineResourceType = "AWS::StepFunctions::StateMachine"
+
+	fmtJobLogGroupName  = "/copilot/%s-%s-%s"
+	fmtJobLogStreamName = "copilot/%s/%s" // copilot/{container name}/{task ID}
+)
+
+type executionsLister interface {
+	Executions(stateMachineARN string, maxResults int) ([]stepfunctions.Execution, error)
+	TaskResults(executionARN string) ([]stepfunctions.TaskResult, error)
+	SubmittedTasks(executionARN string) ([]string, error)
+}
+
+type jobHistoryDescriber struct {
+	app      string
+	env      string
+	job      string
+	limit    int
+	logGroup string
+
+	stackDescriber   stackResourcesGetter
+	executionsLister executionsLister
+}
+
+// NewJobHistoryConfig contains fields that initiates a jobHistoryDescriber struct.
+type NewJobHistoryConfig struct {
+	App         string
+	Env         string
+	Job         string
+	Limit       int    // Maximum number of executions to describe.
+	LogGroup    string // Optional. Name of the log group of the job if it isn't the default one.
+	ConfigStore ConfigStoreSvc
+}
+
+// NewJobHistoryDescriber instantiates a describer of the recent executions of a Scheduled Job.
+func NewJobHistoryDescriber(opt *NewJobHistoryConfig) (*jobHistoryDescriber, error) {
+	stackDescriber, err := NewWorkloadStackDescriber(NewWorkloadConfig{
+		App:         opt.App,
+		Env:         opt.Env,
+		Name:        opt.Job,
+		ConfigStore: opt.ConfigStore,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &jobHistoryDescriber{
+		app:              opt.App,
+		env:              opt.Env,
+		job:              opt.Job,
+		limit:            opt.Limit,
+		logGroup:         opt.LogGroup,
+		stackDescriber:   stackDescriber,
+		executionsLister: stepfunctions.New(stackDescriber.sess),
+	}, nil
+}
+
+func (d *jobHistoryDescriber) logGroupName() string {
+	if d.logGroup != "" {
+		return d.logGroup
+	}
+	return fmt.Sprintf(fmtJobLogGroupName, d.app, d.env, d.job)
+}
+
+// jobExecution contains the result of an execution of a Scheduled Job.
+type jobExecution struct {
+	Name      string     `json:"name"`
+	Status    string     `json:"status"`
+	StartedAt time.Time  `json:"startedAt"`
+	StoppedAt *time.Time `json:"stoppedAt,omitempty"`
+	Retries   int        `json:"retries"`
+	ExitCode  *int64     `json:"exitCode,omitempty"`
+	TaskID    string     `json:"taskID,omitempty"`
+	LogStream string     `json:"logStream,omitempty"`
+}
+
+// jobHistory contains the recent executions of a Scheduled Job.
+type jobHistory struct {
+	Job         string          `json:"job"`
+	Environment string          `json:"environment"`
+	LogGroup    string          `json:"logGroup"`
+	Executions  []*jobExecution `json:"executions"`
+}
+
+// ecsTask holds the fields of the ECS task that the state machine returns as the output or cause of a task state.
+type ecsTask struct {
+	TaskArn    string `json:"TaskArn"`
+	Containers []struct {
+		Name     string `json:"Name"`
+		ExitCode *int64 `json:"ExitCode"`
+	} `json:"Containers"`
+}
+
+// Describe returns the most recent executions of the job's state machine, along with the number of retries,
+// the exit code of the main container and the log stream of the last task of each execution.
+func (d *jobHistoryDescriber) Describe() (HumanJSONStringer, error) {
+	executions, err := d.executions()
+	if err != nil {
+		return nil, err
+	}
+	history := &jobHistory{
+		Job:         d.job,
+		Environment: d.env,
+		LogGroup:    d.logGroupName(),
+	}
+	for _, execution := range executions {
+		results, err := d.executionsLister.TaskResults(execution.ARN)
+		if err != nil {
+			return nil, err
+		}
+		out := &jobExecution{
+			Name:      execution.Name,
+			Status:    execution.Status,
+			StartedAt: execution.StartDate,
+			StoppedAt: execution.StopDate,
+		}
+		if len(results) > 1 {
+			out.Retries = len(results) - 1
+		}
+		if len(results) > 0 {
+			d.addTaskResult(out, results[len(results)-1])
+		}
+		history.Executions = append(history.Executions, out)
+	}
+	return history, nil
+}
+
+// TaskIDs returns the IDs of the tasks started by the most recent executions of the job's state machine,
+// including the tasks of retried attempts and of the executions that are still running.
+func (d *jobHistoryDescriber) TaskIDs() ([]string, error) {
+	executions, err := d.executions()
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, execution := range executions {
+		outputs, err := d.executionsLister.SubmittedTasks(execution.ARN)
+		if err != nil {
+			return nil, err
+		}
+		for _, output := range outputs {
+			var task ecsTask
+			if err := json.Unmarshal([]byte(output), &task); err != nil || task.TaskArn == "" {
+				continue
+			}
+			ids = append(ids, task.TaskArn[strings.LastIndex(task.TaskArn, "/")+1:])
+		}
+	}
+	return ids, nil
+}
+
+// executions returns the most recent executions of the job's state machine, up to the limit.
+func (d *jobHistoryDescriber) executions() ([]stepfunctions.Execution, error) {
+	resources, err := d.stackDescriber.StackResources()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve resources of job %s: %w", d.job, err)
+	}
+	var stateMachineARN string
+	for _, resource := range resources {
+		if resource.Type == stateMachineResourceType {
+			stateMachineARN = resource.PhysicalID
+			break
+		}
+	}
+	if stateMachineARN == "" {
+		return nil, fmt.Errorf("state machine for job %s is not found in environment %s", d.job, d.env)
+	}
+	return d.executionsLister.Executions(stateMachineARN, d.limit)
+}
+
+func (d *jobHistoryDescriber) addTaskResult(execution *jobExecution, result stepfunctions.TaskResult) {
+	var task ecsTask
+	if err := json.Unmarshal([]byte(result.Output), &task); err != nil {
+		return // The cause of a failed task isn't an ECS task if the task couldn't be started.
+	}
+	if task.TaskArn != "" {
+		execution.TaskID = task.TaskArn[strings.LastIndex(task.TaskArn, "/")+1:]
+		execution.LogStream = fmt.Sprintf(fmtJobLogStreamName, d.job, execution.TaskID)
+	}
+	for _, container := range task.Containers {
+		if container.Name == d.job {
+			execution.ExitCode = container.ExitCode
+		}
+	}
+}
+
+// JSONString returns the stringified jobHistory struct with json format.
+func (h *jobHistory) JSONString() (string, error) {
+	b, err := json.Marshal(h)
+	if err != nil {
+		return "", fmt.Errorf("marshal job history: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified jobHistory struct with human readable format.
+func (h *jobHistory) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, statusMinCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Executions\n\n"))
+	writer.Flush()
+	if len(h.Executions) == 0 {
+		fmt.Fprintf(writer, "  No executions found for job %s in environment %s.\n", h.Job, h.Environment)
+		writer.Flush()
+		return b.String()
+	}
+	headers := []string{"Name", "Status", "Started", "Duration", "Retries", "Exit Code", "Task ID"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, e := range h.Executions {
+		duration := "-"
+		if e.StoppedAt != nil {
+			duration = e.StoppedAt.Sub(e.StartedAt).String()
+		}
+		exitCode := "-"
+		if e.ExitCode != nil {
+			exitCode = strconv.FormatInt(aws.Int64Value(e.ExitCode), 10)
+		}
+		taskID := "-"
+		if e.TaskID != "" {
+			taskID = e.TaskID
+		}
+		row := []string{
+			e.Name,
+			e.Status,
+			humanizeTime(e.StartedAt),
+			duration,
+			strconv.Itoa(e.Retries),
+			exitCode,
+			taskID,
+		}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(row, "\t"))
+	}
+	writer.Flush()
+	fmt.Fprint(writer, color.Bold.Sprint("\nLogs\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  The logs of a task are in the stream %s of the log group %s.\n", fmt.Sprintf(fmtJobLogStreamName, h.Job, "<task ID>"), h.LogGroup)
+	fmt.Fprintf(writer, "  Run `copilot job logs -n %s -e %s --tasks <task ID>` to view them.\n", h.Job, h.Environment)
+	writer.Flush()
+	return b.String()
+}
@@ -0,0 +1,232 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type jobHistoryDescriberMocks struct {
+	stackDescriber   *mocks.MockstackResourcesGetter
+	executionsLister *mocks.MockexecutionsLister
+}
+
+func TestJobHistoryDescriber_Describe(t *testing.T) {
+	const stateMachineARN = "arn:aws:states:us-west-2:123456789012:stateMachine:report"
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now := time.Date(2023, time.October, 15, 12, 0, 0, 0, time.UTC)
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	mockStart := time.Date(2023, time.October, 15, 9, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		setupMocks func(m jobHistoryDescriberMocks)
+
+		wantedHuman string
+		wantedJSON  string
+		wantedError error
+	}{
+		"error if fail to retrieve the stack resources": {
+			setupMocks: func(m jobHistoryDescriberMocks) {
+				m.stackDescriber.EXPECT().StackResources().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("retrieve resources of job report: some error"),
+		},
+		"error if the job has no state machine": {
+			setupMocks: func(m jobHistoryDescriberMocks) {
+				m.stackDescriber.EXPECT().StackResources().Return([]*stack.Resource{
+					{Type: "AWS::IAM::Role", LogicalID: "RuleRole", PhysicalID: "phonetool-test-report-RuleRole"},
+				}, nil)
+			},
+			wantedError: errors.New("state machine for job report is not found in environment test"),
+		},
+		"error if fail to get the task results of an execution": {
+			setupMocks: func(m jobHistoryDescriberMocks) {
+				m.stackDescriber.EXPECT().StackResources().Return([]*stack.Resource{
+					{Type: "AWS::StepFunctions::StateMachine", LogicalID: "StateMachine", PhysicalID: stateMachineARN},
+				}, nil)
+				m.executionsLister.EXPECT().Executions(stateMachineARN, 10).Return([]stepfunctions.Execution{
+					{ARN: "arn:aws:states:us-west-2:123456789012:execution:report:1"},
+				}, nil)
+				m.executionsLister.EXPECT().TaskResults(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"return the executions with their retries, exit codes and tasks": {
+			setupMocks: func(m jobHistoryDescriberMocks) {
+				m.stackDescriber.EXPECT().StackResources().Return([]*stack.Resource{
+					{Type: "AWS::StepFunctions::StateMachine", LogicalID: "StateMachine", PhysicalID: stateMachineARN},
+				}, nil)
+				m.executionsLister.EXPECT().Executions(stateMachineARN, 10).Return([]stepfunctions.Execution{
+					{
+						ARN:       "arn:aws:states:us-west-2:123456789012:execution:report:running",
+						Name:      "running",
+						Status:    "RUNNING",
+						StartDate: mockStart.Add(2 * time.Hour),
+					},
+					{
+						ARN:       "arn:aws:states:us-west-2:123456789012:execution:report:failed",
+						Name:      "failed",
+						Status:    "FAILED",
+						StartDate: mockStart,
+						StopDate:  aws.Time(mockStart.Add(90 * time.Second)),
+					},
+				}, nil)
+				m.executionsLister.EXPECT().TaskResults("arn:aws:states:us-west-2:123456789012:execution:report:running").Return(nil, nil)
+				m.executionsLister.EXPECT().TaskResults("arn:aws:states:us-west-2:123456789012:execution:report:failed").Return([]stepfunctions.TaskResult{
+					{Output: "ECS.AmazonECSException: capacity is unavailable"},
+					{Output: `{"TaskArn":"arn:aws:ecs:us-west-2:123456789012:task/phonetool-test-Cluster/abc123","Containers":[{"Name":"nginx","ExitCode":0},{"Name":"report","ExitCode":2}]}`},
+				}, nil)
+			},
+			wantedHuman: `Executions
+
+  Name      Status      Started      Duration    Retries     Exit Code   Task ID
+  ----      ------      -------      --------    -------     ---------   -------
+  running   RUNNING     1 hour ago   -           0           -           -
+  failed    FAILED      3 hours ago  1m30s       1           2           abc123
+
+Logs
+
+  The logs of a task are in the stream copilot/report/<task ID> of the log group /copilot/phonetool-test-report.
+  Run ` + "`copilot job logs -n report -e test --tasks <task ID>`" + ` to view them.
+`,
+			wantedJSON: `{"job":"report","environment":"test","logGroup":"/copilot/phonetool-test-report","executions":[{"name":"running","status":"RUNNING","startedAt":"2023-10-15T11:00:00Z","retries":0},{"name":"failed","status":"FAILED","startedAt":"2023-10-15T09:00:00Z","stoppedAt":"2023-10-15T09:01:30Z","retries":1,"exitCode":2,"taskID":"abc123","logStream":"copilot/report/abc123"}]}
+`,
+		},
+		"show a message if the job has no executions": {
+			setupMocks: func(m jobHistoryDescriberMocks) {
+				m.stackDescriber.EXPECT().StackResources().Return([]*stack.Resource{
+					{Type: "AWS::StepFunctions::StateMachine", LogicalID: "StateMachine", PhysicalID: stateMachineARN},
+				}, nil)
+				m.executionsLister.EXPECT().Executions(stateMachineARN, 10).Return(nil, nil)
+			},
+			wantedHuman: `Executions
+
+  No executions found for job report in environment test.
+`,
+			wantedJSON: `{"job":"report","environment":"test","logGroup":"/copilot/phonetool-test-report","executions":null}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := jobHistoryDescriberMocks{
+				stackDescriber:   mocks.NewMockstackResourcesGetter(ctrl),
+				executionsLister: mocks.NewMockexecutionsLister(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &jobHistoryDescriber{
+				app:              "phonetool",
+				env:              "test",
+				job:              "report",
+				limit:            10,
+				stackDescriber:   m.stackDescriber,
+				executionsLister: m.executionsLister,
+			}
+
+			// WHEN
+			got, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHuman, got.HumanString())
+			json, err := got.JSONString()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJSON, json)
+		})
+	}
+}
+
+func TestJobHistoryDescriber_TaskIDs(t *testing.T) {
+	const stateMachineARN = "arn:aws:states:us-west-2:123456789012:stateMachine:report"
+	testCases := map[string]struct {
+		setupMocks func(m jobHistoryDescriberMocks)
+
+		wanted      []string
+		wantedError error
+	}{
+		"error if fail to get the submitted tasks of an execution": {
+			setupMocks: func(m jobHistoryDescriberMocks) {
+				m.stackDescriber.EXPECT().StackResources().Return([]*stack.Resource{
+					{Type: "AWS::StepFunctions::StateMachine", LogicalID: "StateMachine", PhysicalID: stateMachineARN},
+				}, nil)
+				m.executionsLister.EXPECT().Executions(stateMachineARN, 2).Return([]stepfunctions.Execution{
+					{ARN: "arn:aws:states:us-west-2:123456789012:execution:report:1"},
+				}, nil)
+				m.executionsLister.EXPECT().SubmittedTasks(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"return the tasks of every attempt of the executions": {
+			setupMocks: func(m jobHistoryDescriberMocks) {
+				m.stackDescriber.EXPECT().StackResources().Return([]*stack.Resource{
+					{Type: "AWS::StepFunctions::StateMachine", LogicalID: "StateMachine", PhysicalID: stateMachineARN},
+				}, nil)
+				m.executionsLister.EXPECT().Executions(stateMachineARN, 2).Return([]stepfunctions.Execution{
+					{ARN: "arn:aws:states:us-west-2:123456789012:execution:report:running"},
+					{ARN: "arn:aws:states:us-west-2:123456789012:execution:report:failed"},
+				}, nil)
+				m.executionsLister.EXPECT().SubmittedTasks("arn:aws:states:us-west-2:123456789012:execution:report:running").Return([]string{
+					`{"TaskArn":"arn:aws:ecs:us-west-2:123456789012:task/phonetool-test-Cluster/def456"}`,
+				}, nil)
+				m.executionsLister.EXPECT().SubmittedTasks("arn:aws:states:us-west-2:123456789012:execution:report:failed").Return([]string{
+					`{"TaskArn":"arn:aws:ecs:us-west-2:123456789012:task/phonetool-test-Cluster/abc123"}`,
+					"not an ECS task",
+					`{"TaskArn":"arn:aws:ecs:us-west-2:123456789012:task/phonetool-test-Cluster/abc456"}`,
+				}, nil)
+			},
+			wanted: []string{"def456", "abc123", "abc456"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := jobHistoryDescriberMocks{
+				stackDescriber:   mocks.NewMockstackResourcesGetter(ctrl),
+				executionsLister: mocks.NewMockexecutionsLister(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &jobHistoryDescriber{
+				app:              "phonetool",
+				env:              "test",
+				job:              "report",
+				limit:            2,
+				stackDescriber:   m.stackDescriber,
+				executionsLister: m.executionsLister,
+			}
+
+			got, err := d.TaskIDs()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -264,6 +264,15 @@ func (d *LBWebServiceDescriber) Manifest(env string) ([]byte, error) {
 	return cfn.Manifest()
 }
 
+// RenderedManifest returns the manifest used to deploy a load balanced web service stack with its environment variables substituted.
+func (d *LBWebServiceDescriber) RenderedManifest(env string) ([]byte, error) {
+	cfn, err := d.initECSServiceDescribers(env)
+	if err != nil {
+		return nil, err
+	}
+	return cfn.RenderedManifest()
+}
+
 // WebServiceRoute contains serialized route parameters for a web service.
 type WebServiceRoute struct {
 	Environment string `json:"environment"`
@@ -0,0 +1,182 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/cost.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	pricing "github.com/aws/copilot-cli/internal/pkg/aws/pricing"
+	rds "github.com/aws/copilot-cli/internal/pkg/aws/rds"
+	stack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// Mockpricer is a mock of pricer interface.
+type Mockpricer struct {
+	ctrl     *gomock.Controller
+	recorder *MockpricerMockRecorder
+}
+
+// MockpricerMockRecorder is the mock recorder for Mockpricer.
+type MockpricerMockRecorder struct {
+	mock *Mockpricer
+}
+
+// NewMockpricer creates a new mock instance.
+func NewMockpricer(ctrl *gomock.Controller) *Mockpricer {
+	mock := &Mockpricer{ctrl: ctrl}
+	mock.recorder = &MockpricerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockpricer) EXPECT() *MockpricerMockRecorder {
+	return m.recorder
+}
+
+// Prices mocks base method.
+func (m *Mockpricer) Prices(serviceCode, region string, attributes map[string]string) ([]pricing.Price, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Prices", serviceCode, region, attributes)
+	ret0, _ := ret[0].([]pricing.Price)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Prices indicates an expected call of Prices.
+func (mr *MockpricerMockRecorder) Prices(serviceCode, region, attributes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prices", reflect.TypeOf((*Mockpricer)(nil).Prices), serviceCode, region, attributes)
+}
+
+// MockclusterLister is a mock of clusterLister interface.
+type MockclusterLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockclusterListerMockRecorder
+}
+
+// MockclusterListerMockRecorder is the mock recorder for MockclusterLister.
+type MockclusterListerMockRecorder struct {
+	mock *MockclusterLister
+}
+
+// NewMockclusterLister creates a new mock instance.
+func NewMockclusterLister(ctrl *gomock.Controller) *MockclusterLister {
+	mock := &MockclusterLister{ctrl: ctrl}
+	mock.recorder = &MockclusterListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockclusterLister) EXPECT() *MockclusterListerMockRecorder {
+	return m.recorder
+}
+
+// ListClusters mocks base method.
+func (m *MockclusterLister) ListClusters(tags map[string]string) ([]rds.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusters", tags)
+	ret0, _ := ret[0].([]rds.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusters indicates an expected call of ListClusters.
+func (mr *MockclusterListerMockRecorder) ListClusters(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockclusterLister)(nil).ListClusters), tags)
+}
+
+// MockfargateDescriber is a mock of fargateDescriber interface.
+type MockfargateDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockfargateDescriberMockRecorder
+}
+
+// MockfargateDescriberMockRecorder is the mock recorder for MockfargateDescriber.
+type MockfargateDescriberMockRecorder struct {
+	mock *MockfargateDescriber
+}
+
+// NewMockfargateDescriber creates a new mock instance.
+func NewMockfargateDescriber(ctrl *gomock.Controller) *MockfargateDescriber {
+	mock := &MockfargateDescriber{ctrl: ctrl}
+	mock.recorder = &MockfargateDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockfargateDescriber) EXPECT() *MockfargateDescriberMockRecorder {
+	return m.recorder
+}
+
+// Params mocks base method.
+func (m *MockfargateDescriber) Params() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Params")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Params indicates an expected call of Params.
+func (mr *MockfargateDescriberMockRecorder) Params() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockfargateDescriber)(nil).Params))
+}
+
+// Platform mocks base method.
+func (m *MockfargateDescriber) Platform() (*ecs.ContainerPlatform, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Platform")
+	ret0, _ := ret[0].(*ecs.ContainerPlatform)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Platform indicates an expected call of Platform.
+func (mr *MockfargateDescriberMockRecorder) Platform() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Platform", reflect.TypeOf((*MockfargateDescriber)(nil).Platform))
+}
+
+// MockenvResourcesDescriber is a mock of envResourcesDescriber interface.
+type MockenvResourcesDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvResourcesDescriberMockRecorder
+}
+
+// MockenvResourcesDescriberMockRecorder is the mock recorder for MockenvResourcesDescriber.
+type MockenvResourcesDescriberMockRecorder struct {
+	mock *MockenvResourcesDescriber
+}
+
+// NewMockenvResourcesDescriber creates a new mock instance.
+func NewMockenvResourcesDescriber(ctrl *gomock.Controller) *MockenvResourcesDescriber {
+	mock := &MockenvResourcesDescriber{ctrl: ctrl}
+	mock.recorder = &MockenvResourcesDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvResourcesDescriber) EXPECT() *MockenvResourcesDescriberMockRecorder {
+	return m.recorder
+}
+
+// Resources mocks base method.
+func (m *MockenvResourcesDescriber) Resources() ([]*stack.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resources")
+	ret0, _ := ret[0].([]*stack.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resources indicates an expected call of Resources.
+func (mr *MockenvResourcesDescriberMockRecorder) Resources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resources", reflect.TypeOf((*MockenvResourcesDescriber)(nil).Resources))
+}
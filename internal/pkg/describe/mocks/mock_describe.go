@@ -63,6 +63,72 @@ func (mr *MockHumanJSONStringerMockRecorder) JSONString() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JSONString", reflect.TypeOf((*MockHumanJSONStringer)(nil).JSONString))
 }
 
+// MockGraphStringer is a mock of GraphStringer interface.
+type MockGraphStringer struct {
+	ctrl     *gomock.Controller
+	recorder *MockGraphStringerMockRecorder
+}
+
+// MockGraphStringerMockRecorder is the mock recorder for MockGraphStringer.
+type MockGraphStringerMockRecorder struct {
+	mock *MockGraphStringer
+}
+
+// NewMockGraphStringer creates a new mock instance.
+func NewMockGraphStringer(ctrl *gomock.Controller) *MockGraphStringer {
+	mock := &MockGraphStringer{ctrl: ctrl}
+	mock.recorder = &MockGraphStringerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGraphStringer) EXPECT() *MockGraphStringerMockRecorder {
+	return m.recorder
+}
+
+// DOTString mocks base method.
+func (m *MockGraphStringer) DOTString() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DOTString")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// DOTString indicates an expected call of DOTString.
+func (mr *MockGraphStringerMockRecorder) DOTString() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DOTString", reflect.TypeOf((*MockGraphStringer)(nil).DOTString))
+}
+
+// HumanString mocks base method.
+func (m *MockGraphStringer) HumanString() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HumanString")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// HumanString indicates an expected call of HumanString.
+func (mr *MockGraphStringerMockRecorder) HumanString() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HumanString", reflect.TypeOf((*MockGraphStringer)(nil).HumanString))
+}
+
+// JSONString mocks base method.
+func (m *MockGraphStringer) JSONString() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JSONString")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JSONString indicates an expected call of JSONString.
+func (mr *MockGraphStringerMockRecorder) JSONString() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JSONString", reflect.TypeOf((*MockGraphStringer)(nil).JSONString))
+}
+
 // MockstackDescriber is a mock of stackDescriber interface.
 type MockstackDescriber struct {
 	ctrl     *gomock.Controller
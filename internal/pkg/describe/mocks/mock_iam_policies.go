@@ -0,0 +1,133 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/iam_policies.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockstackTemplateDescriber is a mock of stackTemplateDescriber interface.
+type MockstackTemplateDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackTemplateDescriberMockRecorder
+}
+
+// MockstackTemplateDescriberMockRecorder is the mock recorder for MockstackTemplateDescriber.
+type MockstackTemplateDescriberMockRecorder struct {
+	mock *MockstackTemplateDescriber
+}
+
+// NewMockstackTemplateDescriber creates a new mock instance.
+func NewMockstackTemplateDescriber(ctrl *gomock.Controller) *MockstackTemplateDescriber {
+	mock := &MockstackTemplateDescriber{ctrl: ctrl}
+	mock.recorder = &MockstackTemplateDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackTemplateDescriber) EXPECT() *MockstackTemplateDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockstackTemplateDescriber) Describe(name string) (*cloudformation.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe", name)
+	ret0, _ := ret[0].(*cloudformation.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockstackTemplateDescriberMockRecorder) Describe(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockstackTemplateDescriber)(nil).Describe), name)
+}
+
+// StackResources mocks base method.
+func (m *MockstackTemplateDescriber) StackResources(name string) ([]*cloudformation.StackResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackResources", name)
+	ret0, _ := ret[0].([]*cloudformation.StackResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackResources indicates an expected call of StackResources.
+func (mr *MockstackTemplateDescriberMockRecorder) StackResources(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResources", reflect.TypeOf((*MockstackTemplateDescriber)(nil).StackResources), name)
+}
+
+// TemplateBody mocks base method.
+func (m *MockstackTemplateDescriber) TemplateBody(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TemplateBody", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TemplateBody indicates an expected call of TemplateBody.
+func (mr *MockstackTemplateDescriberMockRecorder) TemplateBody(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TemplateBody", reflect.TypeOf((*MockstackTemplateDescriber)(nil).TemplateBody), name)
+}
+
+// MockrolePoliciesLister is a mock of rolePoliciesLister interface.
+type MockrolePoliciesLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockrolePoliciesListerMockRecorder
+}
+
+// MockrolePoliciesListerMockRecorder is the mock recorder for MockrolePoliciesLister.
+type MockrolePoliciesListerMockRecorder struct {
+	mock *MockrolePoliciesLister
+}
+
+// NewMockrolePoliciesLister creates a new mock instance.
+func NewMockrolePoliciesLister(ctrl *gomock.Controller) *MockrolePoliciesLister {
+	mock := &MockrolePoliciesLister{ctrl: ctrl}
+	mock.recorder = &MockrolePoliciesListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrolePoliciesLister) EXPECT() *MockrolePoliciesListerMockRecorder {
+	return m.recorder
+}
+
+// ListAttachedRolePolicyARNs mocks base method.
+func (m *MockrolePoliciesLister) ListAttachedRolePolicyARNs(roleName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttachedRolePolicyARNs", roleName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttachedRolePolicyARNs indicates an expected call of ListAttachedRolePolicyARNs.
+func (mr *MockrolePoliciesListerMockRecorder) ListAttachedRolePolicyARNs(roleName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachedRolePolicyARNs", reflect.TypeOf((*MockrolePoliciesLister)(nil).ListAttachedRolePolicyARNs), roleName)
+}
+
+// ListRolePolicyNames mocks base method.
+func (m *MockrolePoliciesLister) ListRolePolicyNames(roleName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRolePolicyNames", roleName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRolePolicyNames indicates an expected call of ListRolePolicyNames.
+func (mr *MockrolePoliciesListerMockRecorder) ListRolePolicyNames(roleName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRolePolicyNames", reflect.TypeOf((*MockrolePoliciesLister)(nil).ListRolePolicyNames), roleName)
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/job_history.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	stepfunctions "github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockexecutionsLister is a mock of executionsLister interface.
+type MockexecutionsLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockexecutionsListerMockRecorder
+}
+
+// MockexecutionsListerMockRecorder is the mock recorder for MockexecutionsLister.
+type MockexecutionsListerMockRecorder struct {
+	mock *MockexecutionsLister
+}
+
+// NewMockexecutionsLister creates a new mock instance.
+func NewMockexecutionsLister(ctrl *gomock.Controller) *MockexecutionsLister {
+	mock := &MockexecutionsLister{ctrl: ctrl}
+	mock.recorder = &MockexecutionsListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockexecutionsLister) EXPECT() *MockexecutionsListerMockRecorder {
+	return m.recorder
+}
+
+// Executions mocks base method.
+func (m *MockexecutionsLister) Executions(stateMachineARN string, maxResults int) ([]stepfunctions.Execution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Executions", stateMachineARN, maxResults)
+	ret0, _ := ret[0].([]stepfunctions.Execution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Executions indicates an expected call of Executions.
+func (mr *MockexecutionsListerMockRecorder) Executions(stateMachineARN, maxResults interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Executions", reflect.TypeOf((*MockexecutionsLister)(nil).Executions), stateMachineARN, maxResults)
+}
+
+// SubmittedTasks mocks base method.
+func (m *MockexecutionsLister) SubmittedTasks(executionARN string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmittedTasks", executionARN)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmittedTasks indicates an expected call of SubmittedTasks.
+func (mr *MockexecutionsListerMockRecorder) SubmittedTasks(executionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmittedTasks", reflect.TypeOf((*MockexecutionsLister)(nil).SubmittedTasks), executionARN)
+}
+
+// TaskResults mocks base method.
+func (m *MockexecutionsLister) TaskResults(executionARN string) ([]stepfunctions.TaskResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskResults", executionARN)
+	ret0, _ := ret[0].([]stepfunctions.TaskResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskResults indicates an expected call of TaskResults.
+func (mr *MockexecutionsListerMockRecorder) TaskResults(executionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskResults", reflect.TypeOf((*MockexecutionsLister)(nil).TaskResults), executionARN)
+}
@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/queue_status.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	cloudwatch "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	sqs "github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	stack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockstackResourcesGetter is a mock of stackResourcesGetter interface.
+type MockstackResourcesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockstackResourcesGetterMockRecorder
+}
+
+// MockstackResourcesGetterMockRecorder is the mock recorder for MockstackResourcesGetter.
+type MockstackResourcesGetterMockRecorder struct {
+	mock *MockstackResourcesGetter
+}
+
+// NewMockstackResourcesGetter creates a new mock instance.
+func NewMockstackResourcesGetter(ctrl *gomock.Controller) *MockstackResourcesGetter {
+	mock := &MockstackResourcesGetter{ctrl: ctrl}
+	mock.recorder = &MockstackResourcesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstackResourcesGetter) EXPECT() *MockstackResourcesGetterMockRecorder {
+	return m.recorder
+}
+
+// StackResources mocks base method.
+func (m *MockstackResourcesGetter) StackResources() ([]*stack.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackResources")
+	ret0, _ := ret[0].([]*stack.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StackResources indicates an expected call of StackResources.
+func (mr *MockstackResourcesGetterMockRecorder) StackResources() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackResources", reflect.TypeOf((*MockstackResourcesGetter)(nil).StackResources))
+}
+
+// MockqueueAttributesGetter is a mock of queueAttributesGetter interface.
+type MockqueueAttributesGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockqueueAttributesGetterMockRecorder
+}
+
+// MockqueueAttributesGetterMockRecorder is the mock recorder for MockqueueAttributesGetter.
+type MockqueueAttributesGetterMockRecorder struct {
+	mock *MockqueueAttributesGetter
+}
+
+// NewMockqueueAttributesGetter creates a new mock instance.
+func NewMockqueueAttributesGetter(ctrl *gomock.Controller) *MockqueueAttributesGetter {
+	mock := &MockqueueAttributesGetter{ctrl: ctrl}
+	mock.recorder = &MockqueueAttributesGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockqueueAttributesGetter) EXPECT() *MockqueueAttributesGetterMockRecorder {
+	return m.recorder
+}
+
+// QueueAttributes mocks base method.
+func (m *MockqueueAttributesGetter) QueueAttributes(url string) (*sqs.QueueAttributes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueAttributes", url)
+	ret0, _ := ret[0].(*sqs.QueueAttributes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueueAttributes indicates an expected call of QueueAttributes.
+func (mr *MockqueueAttributesGetterMockRecorder) QueueAttributes(url interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueAttributes", reflect.TypeOf((*MockqueueAttributesGetter)(nil).QueueAttributes), url)
+}
+
+// MockmetricMaximumGetter is a mock of metricMaximumGetter interface.
+type MockmetricMaximumGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockmetricMaximumGetterMockRecorder
+}
+
+// MockmetricMaximumGetterMockRecorder is the mock recorder for MockmetricMaximumGetter.
+type MockmetricMaximumGetterMockRecorder struct {
+	mock *MockmetricMaximumGetter
+}
+
+// NewMockmetricMaximumGetter creates a new mock instance.
+func NewMockmetricMaximumGetter(ctrl *gomock.Controller) *MockmetricMaximumGetter {
+	mock := &MockmetricMaximumGetter{ctrl: ctrl}
+	mock.recorder = &MockmetricMaximumGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmetricMaximumGetter) EXPECT() *MockmetricMaximumGetterMockRecorder {
+	return m.recorder
+}
+
+// MetricMaximum mocks base method.
+func (m *MockmetricMaximumGetter) MetricMaximum(q cloudwatch.MetricQuery) (*float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MetricMaximum", q)
+	ret0, _ := ret[0].(*float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MetricMaximum indicates an expected call of MetricMaximum.
+func (mr *MockmetricMaximumGetterMockRecorder) MetricMaximum(q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MetricMaximum", reflect.TypeOf((*MockmetricMaximumGetter)(nil).MetricMaximum), q)
+}
@@ -355,6 +355,21 @@ func (mr *MockworkloadDescriberMockRecorder) Params() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockworkloadDescriber)(nil).Params))
 }
 
+// RenderedManifest mocks base method.
+func (m *MockworkloadDescriber) RenderedManifest() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderedManifest")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderedManifest indicates an expected call of RenderedManifest.
+func (mr *MockworkloadDescriberMockRecorder) RenderedManifest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderedManifest", reflect.TypeOf((*MockworkloadDescriber)(nil).RenderedManifest))
+}
+
 // StackResources mocks base method.
 func (m *MockworkloadDescriber) StackResources() ([]*stack.Resource, error) {
 	m.ctrl.T.Helper()
@@ -468,6 +483,21 @@ func (mr *MockecsDescriberMockRecorder) Platform() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Platform", reflect.TypeOf((*MockecsDescriber)(nil).Platform))
 }
 
+// RenderedManifest mocks base method.
+func (m *MockecsDescriber) RenderedManifest() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderedManifest")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderedManifest indicates an expected call of RenderedManifest.
+func (mr *MockecsDescriberMockRecorder) RenderedManifest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderedManifest", reflect.TypeOf((*MockecsDescriber)(nil).RenderedManifest))
+}
+
 // RollbackAlarmNames mocks base method.
 func (m *MockecsDescriber) RollbackAlarmNames() ([]string, error) {
 	m.ctrl.T.Helper()
@@ -611,6 +641,21 @@ func (mr *MockapprunnerDescriberMockRecorder) Params() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Params", reflect.TypeOf((*MockapprunnerDescriber)(nil).Params))
 }
 
+// RenderedManifest mocks base method.
+func (m *MockapprunnerDescriber) RenderedManifest() ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderedManifest")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderedManifest indicates an expected call of RenderedManifest.
+func (mr *MockapprunnerDescriberMockRecorder) RenderedManifest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderedManifest", reflect.TypeOf((*MockapprunnerDescriber)(nil).RenderedManifest))
+}
+
 // Service mocks base method.
 func (m *MockapprunnerDescriber) Service() (*apprunner.Service, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +201,74 @@ func (mr *MockecsServiceGetterMockRecorder) ServiceRunningTasks(clusterName, ser
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceRunningTasks", reflect.TypeOf((*MockecsServiceGetter)(nil).ServiceRunningTasks), clusterName, serviceName)
 }
 
+// MockserviceConnectMetricsGetter is a mock of serviceConnectMetricsGetter interface.
+type MockserviceConnectMetricsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceConnectMetricsGetterMockRecorder
+}
+
+// MockserviceConnectMetricsGetterMockRecorder is the mock recorder for MockserviceConnectMetricsGetter.
+type MockserviceConnectMetricsGetterMockRecorder struct {
+	mock *MockserviceConnectMetricsGetter
+}
+
+// NewMockserviceConnectMetricsGetter creates a new mock instance.
+func NewMockserviceConnectMetricsGetter(ctrl *gomock.Controller) *MockserviceConnectMetricsGetter {
+	mock := &MockserviceConnectMetricsGetter{ctrl: ctrl}
+	mock.recorder = &MockserviceConnectMetricsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceConnectMetricsGetter) EXPECT() *MockserviceConnectMetricsGetterMockRecorder {
+	return m.recorder
+}
+
+// MetricAverage mocks base method.
+func (m *MockserviceConnectMetricsGetter) MetricAverage(q cloudwatch.MetricQuery) (*float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MetricAverage", q)
+	ret0, _ := ret[0].(*float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MetricAverage indicates an expected call of MetricAverage.
+func (mr *MockserviceConnectMetricsGetterMockRecorder) MetricAverage(q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MetricAverage", reflect.TypeOf((*MockserviceConnectMetricsGetter)(nil).MetricAverage), q)
+}
+
+// MetricDimensions mocks base method.
+func (m *MockserviceConnectMetricsGetter) MetricDimensions(namespace, name string, filters map[string]string) ([]map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MetricDimensions", namespace, name, filters)
+	ret0, _ := ret[0].([]map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MetricDimensions indicates an expected call of MetricDimensions.
+func (mr *MockserviceConnectMetricsGetterMockRecorder) MetricDimensions(namespace, name, filters interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MetricDimensions", reflect.TypeOf((*MockserviceConnectMetricsGetter)(nil).MetricDimensions), namespace, name, filters)
+}
+
+// MetricSum mocks base method.
+func (m *MockserviceConnectMetricsGetter) MetricSum(q cloudwatch.MetricQuery) (*float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MetricSum", q)
+	ret0, _ := ret[0].(*float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MetricSum indicates an expected call of MetricSum.
+func (mr *MockserviceConnectMetricsGetterMockRecorder) MetricSum(q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MetricSum", reflect.TypeOf((*MockserviceConnectMetricsGetter)(nil).MetricSum), q)
+}
+
 // MockserviceDescriber is a mock of serviceDescriber interface.
 type MockserviceDescriber struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/describe/topology.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockworkloadECSServiceGetter is a mock of workloadECSServiceGetter interface.
+type MockworkloadECSServiceGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkloadECSServiceGetterMockRecorder
+}
+
+// MockworkloadECSServiceGetterMockRecorder is the mock recorder for MockworkloadECSServiceGetter.
+type MockworkloadECSServiceGetterMockRecorder struct {
+	mock *MockworkloadECSServiceGetter
+}
+
+// NewMockworkloadECSServiceGetter creates a new mock instance.
+func NewMockworkloadECSServiceGetter(ctrl *gomock.Controller) *MockworkloadECSServiceGetter {
+	mock := &MockworkloadECSServiceGetter{ctrl: ctrl}
+	mock.recorder = &MockworkloadECSServiceGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkloadECSServiceGetter) EXPECT() *MockworkloadECSServiceGetterMockRecorder {
+	return m.recorder
+}
+
+// Service mocks base method.
+func (m *MockworkloadECSServiceGetter) Service(app, env, svc string) (*ecs.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", app, env, svc)
+	ret0, _ := ret[0].(*ecs.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockworkloadECSServiceGetterMockRecorder) Service(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockworkloadECSServiceGetter)(nil).Service), app, env, svc)
+}
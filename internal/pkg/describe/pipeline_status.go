@@ -9,13 +9,22 @@ import (
 	"fmt"
 	"strings"
 	"text/tabwriter"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
 
+const (
+	fmtCodeBuildLogGroupName   = "/aws/codebuild/%s"
+	pipelineActionLogsLimit    = 20
+	pipelineStatusPollInterval = 5 * time.Second
+)
+
 type pipelineStateGetter interface {
 	GetPipelineState(pipelineName string) (*codepipeline.PipelineState, error)
 }
@@ -24,6 +33,8 @@ type pipelineStateGetter interface {
 type PipelineStatusDescriber struct {
 	pipeline    deploy.Pipeline
 	pipelineSvc pipelineStateGetter
+	logs        logGetter
+	sleep       func(time.Duration)
 }
 
 // PipelineStatus contains the status for a pipeline.
@@ -32,6 +43,17 @@ type PipelineStatus struct {
 	codepipeline.PipelineState
 }
 
+// PipelineActionTransition is a change of the status of an action in a pipeline.
+type PipelineActionTransition struct {
+	Stage        string   `json:"stage"`
+	Action       string   `json:"action"`
+	From         string   `json:"from"`
+	To           string   `json:"to"`
+	Revision     string   `json:"revision,omitempty"`
+	ErrorMessage string   `json:"errorMessage,omitempty"`
+	Logs         []string `json:"logs,omitempty"`
+}
+
 // NewPipelineStatusDescriber instantiates a new PipelineStatus struct.
 func NewPipelineStatusDescriber(pipeline deploy.Pipeline) (*PipelineStatusDescriber, error) {
 	sess, err := sessions.ImmutableProvider().Default()
@@ -43,22 +65,143 @@ func NewPipelineStatusDescriber(pipeline deploy.Pipeline) (*PipelineStatusDescri
 	return &PipelineStatusDescriber{
 		pipeline:    pipeline,
 		pipelineSvc: pipelineSvc,
+		logs:        cloudwatchlogs.New(sess),
+		sleep:       time.Sleep,
 	}, nil
 }
 
-// Describe returns status of a pipeline.
+// Describe returns status of a pipeline, along with the last lines of the logs of its failed actions.
 func (d *PipelineStatusDescriber) Describe() (HumanJSONStringer, error) {
+	return d.statusWithLogs()
+}
+
+// Watch writes the current status of the pipeline, then polls the pipeline and writes every change of the status
+// of its actions until none of its actions is in progress.
+func (d *PipelineStatusDescriber) Watch(write func(HumanJSONStringer) error) error {
+	prevStatus, err := d.statusWithLogs()
+	if err != nil {
+		return err
+	}
+	if err := write(prevStatus); err != nil {
+		return err
+	}
+	for prevStatus.inProgress() {
+		d.sleep(pipelineStatusPollInterval)
+		curr, err := d.status()
+		if err != nil {
+			return err
+		}
+		for _, transition := range prevStatus.transitions(curr) {
+			if transition.To == codepipeline.ActionStatusFailed {
+				transition.Logs = d.actionLogs(curr.action(transition.Stage, transition.Action))
+			}
+			if err := write(transition); err != nil {
+				return err
+			}
+		}
+		prevStatus = curr
+	}
+	return nil
+}
+
+func (d *PipelineStatusDescriber) status() (*PipelineStatus, error) {
 	ps, err := d.pipelineSvc.GetPipelineState(d.pipeline.ResourceName)
 	if err != nil {
 		return nil, fmt.Errorf("get pipeline status: %w", err)
 	}
-	pipelineStatus := &PipelineStatus{
+	return &PipelineStatus{
 		Name:          d.pipeline.Name,
 		PipelineState: *ps,
+	}, nil
+}
+
+func (d *PipelineStatusDescriber) statusWithLogs() (*PipelineStatus, error) {
+	pipelineStatus, err := d.status()
+	if err != nil {
+		return nil, err
+	}
+	for _, stage := range pipelineStatus.StageStates {
+		for i := range stage.Actions {
+			if stage.Actions[i].Status == codepipeline.ActionStatusFailed {
+				stage.Actions[i].Logs = d.actionLogs(stage.Actions[i])
+			}
+		}
 	}
 	return pipelineStatus, nil
 }
 
+// actionLogs returns the last lines of the logs of an action if it ran a CodeBuild build.
+func (d *PipelineStatusDescriber) actionLogs(action codepipeline.StageAction) []string {
+	// The ID of a CodeBuild build is "<project name>:<build ID>", whereas other actions have ARNs or no external execution.
+	project, buildID, ok := strings.Cut(action.ExternalExecutionID, ":")
+	if !ok || project == "arn" {
+		return nil
+	}
+	out, err := d.logs.LogEvents(cloudwatchlogs.LogEventsOpts{
+		LogGroup:               fmt.Sprintf(fmtCodeBuildLogGroupName, project),
+		LogStreamPrefixFilters: []string{buildID},
+		Limit:                  aws.Int64(pipelineActionLogsLimit),
+		LogStreamLimit:         1,
+	})
+	if err != nil {
+		// The logs are a best effort to help debugging, the log stream might have expired.
+		return nil
+	}
+	var lines []string
+	for _, event := range out.Events {
+		lines = append(lines, strings.TrimRight(event.Message, "\n"))
+	}
+	return lines
+}
+
+func (p *PipelineStatus) inProgress() bool {
+	for _, stage := range p.StageStates {
+		if stage.AggregateStatus() == codepipeline.ActionStatusInProgress {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *PipelineStatus) action(stageName, actionName string) codepipeline.StageAction {
+	for _, stage := range p.StageStates {
+		if stage.StageName != stageName {
+			continue
+		}
+		for _, action := range stage.Actions {
+			if action.Name == actionName {
+				return action
+			}
+		}
+	}
+	return codepipeline.StageAction{}
+}
+
+// transitions returns the changes of the status of the actions from p to curr.
+func (p *PipelineStatus) transitions(curr *PipelineStatus) []*PipelineActionTransition {
+	var transitions []*PipelineActionTransition
+	for _, stage := range curr.StageStates {
+		for _, action := range stage.Actions {
+			from := p.action(stage.StageName, action.Name).Status
+			if from == action.Status {
+				continue
+			}
+			transition := &PipelineActionTransition{
+				Stage:    stage.StageName,
+				Action:   action.Name,
+				From:     from,
+				To:       action.Status,
+				Revision: action.Revision,
+			}
+			if action.Status == codepipeline.ActionStatusFailed {
+				transition.ErrorMessage = action.ErrorMessage
+			}
+			transitions = append(transitions, transition)
+		}
+	}
+	return transitions
+}
+
 // JSONString returns stringified PipelineStatus struct with json format.
 func (p PipelineStatus) JSONString() (string, error) {
 	b, err := json.Marshal(p)
@@ -81,8 +224,70 @@ func (p PipelineStatus) HumanString() string {
 		fmt.Fprint(writer, stage.HumanString())
 	}
 	writer.Flush()
+	p.writeActionDetails(writer)
 	fmt.Fprint(writer, color.Bold.Sprint("\nLast Deployment\n\n"))
 	fmt.Fprintf(writer, "  %s\t%s\n", "Updated At", humanizeTime(p.UpdatedAt))
 	writer.Flush()
 	return b.String()
 }
+
+func (p PipelineStatus) writeActionDetails(writer *tabwriter.Writer) {
+	var hasDetails bool
+	for _, stage := range p.StageStates {
+		for _, action := range stage.Actions {
+			details := action.Details()
+			if len(details) == 0 {
+				continue
+			}
+			if !hasDetails {
+				fmt.Fprint(writer, color.Bold.Sprint("\nAction Details\n\n"))
+				hasDetails = true
+			}
+			fmt.Fprintf(writer, "  %s/%s\n", stage.StageName, action.Name)
+			for _, detail := range details {
+				fmt.Fprintf(writer, "    %s\n", detail)
+			}
+		}
+	}
+	writer.Flush()
+}
+
+// JSONString returns the stringified PipelineActionTransition struct with json format.
+func (t *PipelineActionTransition) JSONString() (string, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return "", fmt.Errorf("marshal pipeline action transition: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified PipelineActionTransition struct with human readable format.
+func (t *PipelineActionTransition) HumanString() string {
+	from := t.From
+	if from == "" {
+		from = "-"
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s/%s: %s -> %s\n", t.Stage, t.Action, from, fmtPipelineActionStatus(t.To))
+	if t.Revision != "" {
+		fmt.Fprintf(&b, "  Revision: %s\n", t.Revision)
+	}
+	if t.ErrorMessage != "" {
+		fmt.Fprintf(&b, "  Error: %s\n", t.ErrorMessage)
+	}
+	for _, line := range t.Logs {
+		fmt.Fprintf(&b, "  %s\n", color.Faint.Sprint(line))
+	}
+	return b.String()
+}
+
+func fmtPipelineActionStatus(status string) string {
+	switch status {
+	case codepipeline.ActionStatusInProgress:
+		return color.Emphasize(status)
+	case codepipeline.ActionStatusFailed:
+		return color.Red.Sprint(status)
+	default:
+		return status
+	}
+}
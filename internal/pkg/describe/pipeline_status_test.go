@@ -11,6 +11,8 @@ import (
 
 	"github.com/dustin/go-humanize"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
@@ -20,6 +22,7 @@ import (
 
 type pipelineStatusDescriberMocks struct {
 	pipelineStateGetter *mocks.MockpipelineStateGetter
+	logs                *mocks.MocklogGetter
 }
 
 var mockParsedTime = func() time.Time {
@@ -103,6 +106,76 @@ func TestPipelineStatusDescriber_Describe(t *testing.T) {
 				PipelineState: *mockPipelineState,
 			},
 		},
+		"retrieves the logs of failed CodeBuild actions": {
+			setupMocks: func(m pipelineStatusDescriberMocks) {
+				m.pipelineStateGetter.EXPECT().GetPipelineState(pipelineResourceName).Return(&codepipeline.PipelineState{
+					PipelineName: pipelineResourceName,
+					StageStates: []*codepipeline.StageState{
+						{
+							StageName: "Build",
+							Actions: []codepipeline.StageAction{
+								{
+									Name:                "Build",
+									Status:              "Failed",
+									ExternalExecutionID: "pipeline-dinder-BuildProject:1a2b3c4d",
+								},
+							},
+						},
+						{
+							StageName: "DeployTo-test",
+							Actions: []codepipeline.StageAction{
+								{
+									Name:                "CreateOrUpdate-api-test",
+									Status:              "Failed",
+									ExternalExecutionID: "arn:aws:cloudformation:us-west-2:123456789012:stack/dinder-test-api/abcd",
+								},
+							},
+						},
+					},
+					UpdatedAt: mockParsedTime(),
+				}, nil)
+				m.logs.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{
+					LogGroup:               "/aws/codebuild/pipeline-dinder-BuildProject",
+					LogStreamPrefixFilters: []string{"1a2b3c4d"},
+					Limit:                  aws.Int64(20),
+					LogStreamLimit:         1,
+				}).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{
+						{Message: "make: *** [test] Error 2\n"},
+					},
+				}, nil)
+			},
+			expectedOutput: &PipelineStatus{
+				Name: pipelineName,
+				PipelineState: codepipeline.PipelineState{
+					PipelineName: pipelineResourceName,
+					StageStates: []*codepipeline.StageState{
+						{
+							StageName: "Build",
+							Actions: []codepipeline.StageAction{
+								{
+									Name:                "Build",
+									Status:              "Failed",
+									ExternalExecutionID: "pipeline-dinder-BuildProject:1a2b3c4d",
+									Logs:                []string{"make: *** [test] Error 2"},
+								},
+							},
+						},
+						{
+							StageName: "DeployTo-test",
+							Actions: []codepipeline.StageAction{
+								{
+									Name:                "CreateOrUpdate-api-test",
+									Status:              "Failed",
+									ExternalExecutionID: "arn:aws:cloudformation:us-west-2:123456789012:stack/dinder-test-api/abcd",
+								},
+							},
+						},
+					},
+					UpdatedAt: mockParsedTime(),
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -110,9 +183,11 @@ func TestPipelineStatusDescriber_Describe(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockPipelineStateGetter := mocks.NewMockpipelineStateGetter(ctrl)
+			mockLogs := mocks.NewMocklogGetter(ctrl)
 
 			mocks := pipelineStatusDescriberMocks{
 				pipelineStateGetter: mockPipelineStateGetter,
+				logs:                mockLogs,
 			}
 			tc.setupMocks(mocks)
 
@@ -126,6 +201,7 @@ func TestPipelineStatusDescriber_Describe(t *testing.T) {
 			describer := &PipelineStatusDescriber{
 				pipeline:    mockDeployedPipeline,
 				pipelineSvc: mockPipelineStateGetter,
+				logs:        mockLogs,
 			}
 
 			// WHEN
@@ -181,6 +257,62 @@ Last Deployment
 `,
 			expectedJSONString: "{\"name\":\"pipeline-dinder-badgoose-repo\",\"pipelineName\":\"pipeline-dinder-badgoose-repo-RANDOMSTRING\",\"stageStates\":[{\"stageName\":\"Source\",\"transition\":\"\"},{\"stageName\":\"Build\",\"actions\":[{\"name\":\"action1\",\"status\":\"Failed\"},{\"name\":\"action2\",\"status\":\"InProgress\"},{\"name\":\"action3\",\"status\":\"Succeeded\"}],\"transition\":\"ENABLED\"},{\"stageName\":\"DeployTo-test\",\"actions\":[{\"name\":\"action1\",\"status\":\"Succeeded\"}],\"transition\":\"DISABLED\"},{\"stageName\":\"DeployTo-prod\",\"actions\":[{\"name\":\"action1\",\"status\":\"Succeeded\"},{\"name\":\"TestCommands\",\"status\":\"Failed\"}],\"transition\":\"\"}],\"updatedAt\":\"2020-02-02T15:04:05Z\"}\n",
 		},
+		"shows the source revisions and the errors and logs of failed actions": {
+			testPipelineStatus: &PipelineStatus{
+				Name: pipelineName,
+				PipelineState: codepipeline.PipelineState{
+					PipelineName: pipelineResourceName,
+					StageStates: []*codepipeline.StageState{
+						{
+							StageName: "Source",
+							Actions: []codepipeline.StageAction{
+								{
+									Name:     "SourceCodeFor-dinder",
+									Status:   "Succeeded",
+									Revision: "8cb4a5e3f2c1d0b9a8f7e6d5c4b3a2918f7e6d5c",
+									Summary:  "Fix the login page\n\nThe button was hidden.",
+								},
+							},
+						},
+						{
+							StageName: "Build",
+							Actions: []codepipeline.StageAction{
+								{
+									Name:         "Build",
+									Status:       "Failed",
+									ErrorMessage: "Error while executing command: make test. Reason: exit status 2",
+									Logs:         []string{"make: *** [test] Error 2"},
+								},
+							},
+							Transition: "ENABLED",
+						},
+					},
+					UpdatedAt: mockParsedTime(),
+				},
+			},
+			expectedHumanString: `Pipeline Status
+
+Stage                     Transition  Status
+-----                     ----------  ------
+Source                      -         Succeeded
+└── SourceCodeFor-dinder              Succeeded
+Build                     ENABLED     Failed
+└── Build                             Failed
+
+Action Details
+
+  Source/SourceCodeFor-dinder
+    Revision: 8cb4a5e Fix the login page
+  Build/Build
+    Error: Error while executing command: make test. Reason: exit status 2
+    make: *** [test] Error 2
+
+Last Deployment
+
+  Updated At  4 months ago
+`,
+			expectedJSONString: "{\"name\":\"pipeline-dinder-badgoose-repo\",\"pipelineName\":\"pipeline-dinder-badgoose-repo-RANDOMSTRING\",\"stageStates\":[{\"stageName\":\"Source\",\"actions\":[{\"name\":\"SourceCodeFor-dinder\",\"status\":\"Succeeded\",\"revision\":\"8cb4a5e3f2c1d0b9a8f7e6d5c4b3a2918f7e6d5c\",\"summary\":\"Fix the login page\\n\\nThe button was hidden.\"}],\"transition\":\"\"},{\"stageName\":\"Build\",\"actions\":[{\"name\":\"Build\",\"status\":\"Failed\",\"errorMessage\":\"Error while executing command: make test. Reason: exit status 2\",\"logs\":[\"make: *** [test] Error 2\"]}],\"transition\":\"ENABLED\"}],\"updatedAt\":\"2020-02-02T15:04:05Z\"}\n",
+		},
 	}
 	for _, tc := range testCases {
 		human := tc.testPipelineStatus.HumanString()
@@ -190,3 +322,164 @@ Last Deployment
 		require.Equal(t, tc.expectedJSONString, json, "expected JSON output to match")
 	}
 }
+
+func TestPipelineStatusDescriber_Watch(t *testing.T) {
+	stateWith := func(buildStatus, deployStatus string) *codepipeline.PipelineState {
+		state := &codepipeline.PipelineState{
+			PipelineName: pipelineResourceName,
+			StageStates: []*codepipeline.StageState{
+				{
+					StageName: "Build",
+					Actions: []codepipeline.StageAction{
+						{
+							Name:                "Build",
+							Status:              buildStatus,
+							ExternalExecutionID: "pipeline-dinder-BuildProject:1a2b3c4d",
+						},
+					},
+				},
+				{
+					StageName: "DeployTo-test",
+				},
+			},
+			UpdatedAt: mockParsedTime(),
+		}
+		if deployStatus != "" {
+			state.StageStates[1].Actions = []codepipeline.StageAction{
+				{
+					Name:         "CreateOrUpdate-api-test",
+					Status:       deployStatus,
+					ErrorMessage: "Resource creation cancelled",
+				},
+			}
+		}
+		return state
+	}
+	testCases := map[string]struct {
+		setupMocks func(m pipelineStatusDescriberMocks)
+
+		wantedWrites []HumanJSONStringer
+		wantedError  error
+	}{
+		"writes the status once if no action is in progress": {
+			setupMocks: func(m pipelineStatusDescriberMocks) {
+				m.pipelineStateGetter.EXPECT().GetPipelineState(pipelineResourceName).Return(stateWith("Succeeded", "Succeeded"), nil)
+			},
+			wantedWrites: []HumanJSONStringer{
+				&PipelineStatus{
+					Name:          pipelineName,
+					PipelineState: *stateWith("Succeeded", "Succeeded"),
+				},
+			},
+		},
+		"writes the transitions of the actions until none is in progress": {
+			setupMocks: func(m pipelineStatusDescriberMocks) {
+				gomock.InOrder(
+					m.pipelineStateGetter.EXPECT().GetPipelineState(pipelineResourceName).Return(stateWith("InProgress", ""), nil),
+					m.pipelineStateGetter.EXPECT().GetPipelineState(pipelineResourceName).Return(stateWith("InProgress", ""), nil),
+					m.pipelineStateGetter.EXPECT().GetPipelineState(pipelineResourceName).Return(stateWith("Succeeded", "InProgress"), nil),
+					m.pipelineStateGetter.EXPECT().GetPipelineState(pipelineResourceName).Return(stateWith("Succeeded", "Failed"), nil),
+				)
+			},
+			wantedWrites: []HumanJSONStringer{
+				&PipelineStatus{
+					Name:          pipelineName,
+					PipelineState: *stateWith("InProgress", ""),
+				},
+				&PipelineActionTransition{
+					Stage:  "Build",
+					Action: "Build",
+					From:   "InProgress",
+					To:     "Succeeded",
+				},
+				&PipelineActionTransition{
+					Stage:  "DeployTo-test",
+					Action: "CreateOrUpdate-api-test",
+					To:     "InProgress",
+				},
+				&PipelineActionTransition{
+					Stage:        "DeployTo-test",
+					Action:       "CreateOrUpdate-api-test",
+					From:         "InProgress",
+					To:           "Failed",
+					ErrorMessage: "Resource creation cancelled",
+				},
+			},
+		},
+		"returns the error if the status cannot be retrieved while polling": {
+			setupMocks: func(m pipelineStatusDescriberMocks) {
+				gomock.InOrder(
+					m.pipelineStateGetter.EXPECT().GetPipelineState(pipelineResourceName).Return(stateWith("InProgress", ""), nil),
+					m.pipelineStateGetter.EXPECT().GetPipelineState(pipelineResourceName).Return(nil, errors.New("some error")),
+				)
+			},
+			wantedWrites: []HumanJSONStringer{
+				&PipelineStatus{
+					Name:          pipelineName,
+					PipelineState: *stateWith("InProgress", ""),
+				},
+			},
+			wantedError: errors.New("get pipeline status: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := pipelineStatusDescriberMocks{
+				pipelineStateGetter: mocks.NewMockpipelineStateGetter(ctrl),
+				logs:                mocks.NewMocklogGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			var slept int
+			describer := &PipelineStatusDescriber{
+				pipeline: deploy.Pipeline{
+					Name:         pipelineName,
+					ResourceName: pipelineResourceName,
+				},
+				pipelineSvc: m.pipelineStateGetter,
+				logs:        m.logs,
+				sleep: func(time.Duration) {
+					slept++
+				},
+			}
+
+			// WHEN
+			var writes []HumanJSONStringer
+			err := describer.Watch(func(out HumanJSONStringer) error {
+				writes = append(writes, out)
+				return nil
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedWrites, writes)
+		})
+	}
+}
+
+func TestPipelineActionTransition_String(t *testing.T) {
+	transition := &PipelineActionTransition{
+		Stage:        "Build",
+		Action:       "Build",
+		From:         "InProgress",
+		To:           "Failed",
+		ErrorMessage: "Error while executing command: make test. Reason: exit status 2",
+		Logs:         []string{"make: *** [test] Error 2"},
+	}
+
+	json, err := transition.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `Build/Build: InProgress -> Failed
+  Error: Error while executing command: make test. Reason: exit status 2
+  make: *** [test] Error 2
+`, transition.HumanString())
+	require.Equal(t, `{"stage":"Build","action":"Build","from":"InProgress","to":"Failed","errorMessage":"Error while executing command: make test. Reason: exit status 2","logs":["make: *** [test] Error 2"]}
+`, json)
+}
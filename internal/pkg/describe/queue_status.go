@@ -0,0 +1,263 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	sqsQueueResourceType        = "AWS::SQS::Queue"
+	sqsMetricNamespace          = "AWS/SQS"
+	sqsOldestMessageAgeMetric   = "ApproximateAgeOfOldestMessage"
+	sqsQueueNameDimension       = "QueueName"
+	sqsOldestMessageAgeLookback = 5 * time.Minute
+
+	queueTypeEvents     = "Events"
+	queueTypeDeadLetter = "Dead-letter"
+)
+
+type stackResourcesGetter interface {
+	StackResources() ([]*stack.Resource, error)
+}
+
+type queueAttributesGetter interface {
+	QueueAttributes(url string) (*sqs.QueueAttributes, error)
+}
+
+type metricMaximumGetter interface {
+	MetricMaximum(q cloudwatch.MetricQuery) (*float64, error)
+}
+
+type queueStatusDescriber struct {
+	svc string
+	env string
+
+	stackDescriber stackResourcesGetter
+	queueGetter    queueAttributesGetter
+	metricGetter   metricMaximumGetter
+	now            func() time.Time
+}
+
+// NewQueueStatusDescriber instantiates a describer of the SQS queues of a Worker Service.
+func NewQueueStatusDescriber(opt *NewServiceStatusConfig) (*queueStatusDescriber, error) {
+	stackDescriber, err := NewWorkloadStackDescriber(NewWorkloadConfig{
+		App:         opt.App,
+		Env:         opt.Env,
+		Name:        opt.Svc,
+		ConfigStore: opt.ConfigStore,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &queueStatusDescriber{
+		svc:            opt.Svc,
+		env:            opt.Env,
+		stackDescriber: stackDescriber,
+		queueGetter:    sqs.New(stackDescriber.sess),
+		metricGetter:   cloudwatch.New(stackDescriber.sess),
+		now:            time.Now,
+	}, nil
+}
+
+// queueStatus contains the status of a SQS queue of a Worker Service.
+type queueStatus struct {
+	Name                    string   `json:"name"`
+	Type                    string   `json:"type"`
+	URL                     string   `json:"url"`
+	Messages                int64    `json:"messages"`
+	MessagesInFlight        int64    `json:"messagesInFlight"`
+	MessagesDelayed         int64    `json:"messagesDelayed"`
+	OldestMessageAgeSeconds *float64 `json:"oldestMessageAgeSeconds,omitempty"`
+	DeadLetterQueue         string   `json:"deadLetterQueue,omitempty"` // Name of the queue where failed messages are moved to.
+	MaxReceiveCount         int      `json:"maxReceiveCount,omitempty"`
+
+	arn string
+}
+
+// workerQueuesStatus contains the status of the SQS queues of a Worker Service.
+type workerQueuesStatus struct {
+	Service     string         `json:"service"`
+	Environment string         `json:"environment"`
+	Queues      []*queueStatus `json:"queues"`
+}
+
+// Describe returns the number of messages in each SQS queue of the Worker Service,
+// and the age of the oldest message from the last minutes.
+func (d *queueStatusDescriber) Describe() (HumanJSONStringer, error) {
+	queues, err := d.queues()
+	if err != nil {
+		return nil, err
+	}
+	end := d.now().Truncate(time.Minute)
+	for _, q := range queues {
+		age, err := d.metricGetter.MetricMaximum(cloudwatch.MetricQuery{
+			Namespace: sqsMetricNamespace,
+			Name:      sqsOldestMessageAgeMetric,
+			Dimensions: map[string]string{
+				sqsQueueNameDimension: queueNameFromURL(q.URL),
+			},
+			StartTime: end.Add(-sqsOldestMessageAgeLookback),
+			EndTime:   end,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get age of oldest message in queue %s: %w", q.Name, err)
+		}
+		q.OldestMessageAgeSeconds = age
+	}
+	return &workerQueuesStatus{
+		Service:     d.svc,
+		Environment: d.env,
+		Queues:      queues,
+	}, nil
+}
+
+// DeadLetterQueue is a queue where the messages that a Worker Service failed to process are moved to.
+type DeadLetterQueue struct {
+	Name     string   `json:"name"`
+	URL      string   `json:"url"`
+	ARN      string   `json:"arn"`
+	Messages int64    `json:"messages"`
+	Sources  []string `json:"sources"` // Names of the queues whose failed messages are moved to this queue.
+}
+
+// DeadLetterQueues returns the dead-letter queues of the Worker Service.
+func (d *queueStatusDescriber) DeadLetterQueues() ([]DeadLetterQueue, error) {
+	queues, err := d.queues()
+	if err != nil {
+		return nil, err
+	}
+	var dlqs []DeadLetterQueue
+	for _, q := range queues {
+		if q.Type != queueTypeDeadLetter {
+			continue
+		}
+		dlq := DeadLetterQueue{
+			Name:     q.Name,
+			URL:      q.URL,
+			ARN:      q.arn,
+			Messages: q.Messages,
+		}
+		for _, source := range queues {
+			if source.DeadLetterQueue == q.Name {
+				dlq.Sources = append(dlq.Sources, source.Name)
+			}
+		}
+		dlqs = append(dlqs, dlq)
+	}
+	return dlqs, nil
+}
+
+// queues returns the attributes of the SQS queues of the Worker Service, with the names of their dead-letter queues.
+func (d *queueStatusDescriber) queues() ([]*queueStatus, error) {
+	resources, err := d.stackDescriber.StackResources()
+	if err != nil {
+		return nil, fmt.Errorf("retrieve resources of service %s: %w", d.svc, err)
+	}
+	var queues []*queueStatus
+	for _, resource := range resources {
+		if resource.Type != sqsQueueResourceType {
+			continue
+		}
+		attrs, err := d.queueGetter.QueueAttributes(resource.PhysicalID)
+		if err != nil {
+			return nil, err
+		}
+		queues = append(queues, &queueStatus{
+			Name:             resource.LogicalID,
+			Type:             queueTypeEvents,
+			URL:              resource.PhysicalID,
+			Messages:         attrs.Messages,
+			MessagesInFlight: attrs.MessagesInFlight,
+			MessagesDelayed:  attrs.MessagesDelayed,
+			DeadLetterQueue:  attrs.DeadLetterTargetARN, // Replaced by the name of the queue below.
+			MaxReceiveCount:  attrs.MaxReceiveCount,
+			arn:              attrs.ARN,
+		})
+	}
+	nameByARN := make(map[string]string, len(queues))
+	for _, q := range queues {
+		nameByARN[q.arn] = q.Name
+	}
+	deadLetterQueues := make(map[string]struct{})
+	for _, q := range queues {
+		if q.DeadLetterQueue == "" {
+			continue
+		}
+		if name, ok := nameByARN[q.DeadLetterQueue]; ok {
+			q.DeadLetterQueue = name
+			deadLetterQueues[name] = struct{}{}
+		}
+	}
+	for _, q := range queues {
+		if _, ok := deadLetterQueues[q.Name]; ok {
+			q.Type = queueTypeDeadLetter
+		}
+	}
+	return queues, nil
+}
+
+// JSONString returns the stringified workerQueuesStatus struct with json format.
+func (s *workerQueuesStatus) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal queues status: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified workerQueuesStatus struct with human readable format.
+func (s *workerQueuesStatus) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, statusMinCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Queues\n\n"))
+	writer.Flush()
+	if len(s.Queues) == 0 {
+		fmt.Fprintf(writer, "  No queues found for service %s in environment %s.\n", s.Service, s.Environment)
+		writer.Flush()
+		return b.String()
+	}
+	headers := []string{"Name", "Type", "Available", "In Flight", "Delayed", "Oldest Message", "Dead-letter Queue"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, q := range s.Queues {
+		age := "-"
+		if q.OldestMessageAgeSeconds != nil {
+			age = (time.Duration(aws.Float64Value(q.OldestMessageAgeSeconds)) * time.Second).String()
+		}
+		dlq := "-"
+		if q.DeadLetterQueue != "" {
+			dlq = fmt.Sprintf("%s (after %d receives)", q.DeadLetterQueue, q.MaxReceiveCount)
+		}
+		row := []string{
+			q.Name,
+			q.Type,
+			strconv.FormatInt(q.Messages, 10),
+			strconv.FormatInt(q.MessagesInFlight, 10),
+			strconv.FormatInt(q.MessagesDelayed, 10),
+			age,
+			dlq,
+		}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(row, "\t"))
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// queueNameFromURL returns the name of a queue from its URL, such as "https://sqs.us-west-2.amazonaws.com/123456789012/name".
+func queueNameFromURL(url string) string {
+	return url[strings.LastIndex(url, "/")+1:]
+}
@@ -0,0 +1,236 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sqs"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type queueStatusDescriberMocks struct {
+	stackDescriber *mocks.MockstackResourcesGetter
+	queueGetter    *mocks.MockqueueAttributesGetter
+	metricGetter   *mocks.MockmetricMaximumGetter
+}
+
+func TestQueueStatusDescriber_Describe(t *testing.T) {
+	const (
+		eventsQueueURL = "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-EventsQueue"
+		dlqURL         = "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-DeadLetterQueue"
+		eventsQueueARN = "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-EventsQueue"
+		dlqARN         = "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-DeadLetterQueue"
+	)
+	mockNow := time.Date(2023, time.March, 1, 12, 5, 30, 0, time.UTC)
+	testCases := map[string]struct {
+		setupMocks func(m queueStatusDescriberMocks)
+
+		wantedHuman string
+		wantedJSON  string
+		wantedError error
+	}{
+		"error if fail to retrieve the stack resources": {
+			setupMocks: func(m queueStatusDescriberMocks) {
+				m.stackDescriber.EXPECT().StackResources().Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("retrieve resources of service worker: some error"),
+		},
+		"error if fail to get the age of the oldest message": {
+			setupMocks: func(m queueStatusDescriberMocks) {
+				m.stackDescriber.EXPECT().StackResources().Return([]*stack.Resource{
+					{Type: "AWS::SQS::Queue", LogicalID: "EventsQueue", PhysicalID: eventsQueueURL},
+				}, nil)
+				m.queueGetter.EXPECT().QueueAttributes(eventsQueueURL).Return(&sqs.QueueAttributes{}, nil)
+				m.metricGetter.EXPECT().MetricMaximum(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get age of oldest message in queue EventsQueue: some error"),
+		},
+		"return the status of the events and dead-letter queues": {
+			setupMocks: func(m queueStatusDescriberMocks) {
+				m.stackDescriber.EXPECT().StackResources().Return([]*stack.Resource{
+					{Type: "AWS::IAM::Role", LogicalID: "TaskRole", PhysicalID: "phonetool-test-worker-TaskRole"},
+					{Type: "AWS::SQS::Queue", LogicalID: "EventsQueue", PhysicalID: eventsQueueURL},
+					{Type: "AWS::SQS::Queue", LogicalID: "DeadLetterQueue", PhysicalID: dlqURL},
+				}, nil)
+				m.queueGetter.EXPECT().QueueAttributes(eventsQueueURL).Return(&sqs.QueueAttributes{
+					ARN:                 eventsQueueARN,
+					Messages:            42,
+					MessagesInFlight:    3,
+					DeadLetterTargetARN: dlqARN,
+					MaxReceiveCount:     5,
+				}, nil)
+				m.metricGetter.EXPECT().MetricMaximum(cloudwatch.MetricQuery{
+					Namespace: "AWS/SQS",
+					Name:      "ApproximateAgeOfOldestMessage",
+					Dimensions: map[string]string{
+						"QueueName": "phonetool-test-worker-EventsQueue",
+					},
+					StartTime: time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC),
+					EndTime:   time.Date(2023, time.March, 1, 12, 5, 0, 0, time.UTC),
+				}).Return(aws.Float64(125), nil)
+				m.queueGetter.EXPECT().QueueAttributes(dlqURL).Return(&sqs.QueueAttributes{
+					ARN:      dlqARN,
+					Messages: 7,
+				}, nil)
+				m.metricGetter.EXPECT().MetricMaximum(gomock.Any()).Return(nil, nil)
+			},
+			wantedHuman: `Queues
+
+  Name             Type         Available   In Flight   Delayed     Oldest Message  Dead-letter Queue
+  ----             ----         ---------   ---------   -------     --------------  -----------------
+  EventsQueue      Events       42          3           0           2m5s            DeadLetterQueue (after 5 receives)
+  DeadLetterQueue  Dead-letter  7           0           0           -               -
+`,
+			wantedJSON: `{"service":"worker","environment":"test","queues":[{"name":"EventsQueue","type":"Events","url":"https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-EventsQueue","messages":42,"messagesInFlight":3,"messagesDelayed":0,"oldestMessageAgeSeconds":125,"deadLetterQueue":"DeadLetterQueue","maxReceiveCount":5},{"name":"DeadLetterQueue","type":"Dead-letter","url":"https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-DeadLetterQueue","messages":7,"messagesInFlight":0,"messagesDelayed":0}]}
+`,
+		},
+		"show a message if the service has no queues": {
+			setupMocks: func(m queueStatusDescriberMocks) {
+				m.stackDescriber.EXPECT().StackResources().Return(nil, nil)
+			},
+			wantedHuman: `Queues
+
+  No queues found for service worker in environment test.
+`,
+			wantedJSON: `{"service":"worker","environment":"test","queues":null}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := queueStatusDescriberMocks{
+				stackDescriber: mocks.NewMockstackResourcesGetter(ctrl),
+				queueGetter:    mocks.NewMockqueueAttributesGetter(ctrl),
+				metricGetter:   mocks.NewMockmetricMaximumGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &queueStatusDescriber{
+				svc:            "worker",
+				env:            "test",
+				stackDescriber: m.stackDescriber,
+				queueGetter:    m.queueGetter,
+				metricGetter:   m.metricGetter,
+				now:            func() time.Time { return mockNow },
+			}
+
+			// WHEN
+			got, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHuman, got.HumanString())
+			json, err := got.JSONString()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJSON, json)
+		})
+	}
+}
+
+func TestQueueStatusDescriber_DeadLetterQueues(t *testing.T) {
+	const (
+		eventsQueueURL = "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-EventsQueue"
+		topicQueueURL  = "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-ordersEventsQueue"
+		dlqURL         = "https://sqs.us-west-2.amazonaws.com/123456789012/phonetool-test-worker-DeadLetterQueue"
+		eventsQueueARN = "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-EventsQueue"
+		topicQueueARN  = "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-ordersEventsQueue"
+		dlqARN         = "arn:aws:sqs:us-west-2:123456789012:phonetool-test-worker-DeadLetterQueue"
+	)
+	testCases := map[string]struct {
+		setupMocks func(m queueStatusDescriberMocks)
+
+		wanted      []DeadLetterQueue
+		wantedError error
+	}{
+		"error if fail to get the attributes of a queue": {
+			setupMocks: func(m queueStatusDescriberMocks) {
+				m.stackDescriber.EXPECT().StackResources().Return([]*stack.Resource{
+					{Type: "AWS::SQS::Queue", LogicalID: "EventsQueue", PhysicalID: eventsQueueURL},
+				}, nil)
+				m.queueGetter.EXPECT().QueueAttributes(eventsQueueURL).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"return the dead-letter queues with their source queues": {
+			setupMocks: func(m queueStatusDescriberMocks) {
+				m.stackDescriber.EXPECT().StackResources().Return([]*stack.Resource{
+					{Type: "AWS::SQS::Queue", LogicalID: "EventsQueue", PhysicalID: eventsQueueURL},
+					{Type: "AWS::SQS::Queue", LogicalID: "ordersEventsQueue", PhysicalID: topicQueueURL},
+					{Type: "AWS::SQS::Queue", LogicalID: "DeadLetterQueue", PhysicalID: dlqURL},
+				}, nil)
+				m.queueGetter.EXPECT().QueueAttributes(eventsQueueURL).Return(&sqs.QueueAttributes{
+					ARN:                 eventsQueueARN,
+					DeadLetterTargetARN: dlqARN,
+				}, nil)
+				m.queueGetter.EXPECT().QueueAttributes(topicQueueURL).Return(&sqs.QueueAttributes{
+					ARN:                 topicQueueARN,
+					DeadLetterTargetARN: dlqARN,
+				}, nil)
+				m.queueGetter.EXPECT().QueueAttributes(dlqURL).Return(&sqs.QueueAttributes{
+					ARN:      dlqARN,
+					Messages: 7,
+				}, nil)
+			},
+			wanted: []DeadLetterQueue{
+				{
+					Name:     "DeadLetterQueue",
+					URL:      dlqURL,
+					ARN:      dlqARN,
+					Messages: 7,
+					Sources:  []string{"EventsQueue", "ordersEventsQueue"},
+				},
+			},
+		},
+		"no dead-letter queues": {
+			setupMocks: func(m queueStatusDescriberMocks) {
+				m.stackDescriber.EXPECT().StackResources().Return([]*stack.Resource{
+					{Type: "AWS::SQS::Queue", LogicalID: "EventsQueue", PhysicalID: eventsQueueURL},
+				}, nil)
+				m.queueGetter.EXPECT().QueueAttributes(eventsQueueURL).Return(&sqs.QueueAttributes{ARN: eventsQueueARN}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := queueStatusDescriberMocks{
+				stackDescriber: mocks.NewMockstackResourcesGetter(ctrl),
+				queueGetter:    mocks.NewMockqueueAttributesGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &queueStatusDescriber{
+				svc:            "worker",
+				env:            "test",
+				stackDescriber: m.stackDescriber,
+				queueGetter:    m.queueGetter,
+			}
+
+			got, err := d.DeadLetterQueues()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
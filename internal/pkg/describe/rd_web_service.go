@@ -169,6 +169,15 @@ func (d *RDWebServiceDescriber) Manifest(env string) ([]byte, error) {
 	return cfn.Manifest()
 }
 
+// RenderedManifest returns the manifest used to deploy a request-driven web service stack with its environment variables substituted.
+func (d *RDWebServiceDescriber) RenderedManifest(env string) ([]byte, error) {
+	cfn, err := d.initAppRunnerDescriber(env)
+	if err != nil {
+		return nil, err
+	}
+	return cfn.RenderedManifest()
+}
+
 func formatTracingConfiguration(configuration *apprunner.TraceConfiguration) *tracing {
 	if configuration == nil {
 		return nil
@@ -67,6 +67,7 @@ type workloadDescriber interface {
 	Outputs() (map[string]string, error)
 	StackResources() ([]*stack.Resource, error)
 	Manifest() ([]byte, error)
+	RenderedManifest() ([]byte, error)
 }
 
 type ecsDescriber interface {
@@ -174,6 +174,15 @@ func (d *StaticSiteDescriber) Manifest(env string) ([]byte, error) {
 	return cfn.Manifest()
 }
 
+// RenderedManifest returns the manifest used to deploy a static site stack with its environment variables substituted.
+func (d *StaticSiteDescriber) RenderedManifest(env string) ([]byte, error) {
+	cfn, err := d.initWkldStackDescriber(env)
+	if err != nil {
+		return nil, err
+	}
+	return cfn.RenderedManifest()
+}
+
 // S3ObjectTree contains serialized parameters for an S3 object tree.
 type S3ObjectTree struct {
 	Environment string
@@ -46,6 +46,24 @@ type ecsServiceStatus struct {
 	Alarms                   []cloudwatch.AlarmStatus `json:"alarms"`
 	StoppedTasks             []awsecs.TaskStatus      `json:"stoppedTasks"`
 	TargetHealthDescriptions []taskTargetHealth       `json:"targetHealthDescriptions"`
+	ServiceConnect           *serviceConnectStatus    `json:"serviceConnect,omitempty"`
+}
+
+// serviceConnectStatus contains the Service Connect endpoints of an ECS service and the endpoints it calls.
+type serviceConnectStatus struct {
+	Namespace string                          `json:"namespace"`
+	Endpoints []*serviceConnectEndpointStatus `json:"endpoints"`
+	Upstreams []string                        `json:"upstreams"` // Discovery names of the endpoints that the service sends traffic to.
+}
+
+// serviceConnectEndpointStatus contains the callers of a Service Connect endpoint and the traffic it received in the last hour.
+type serviceConnectEndpointStatus struct {
+	DiscoveryName     string   `json:"discoveryName"`
+	Aliases           []string `json:"aliases"`
+	Downstreams       []string `json:"downstreams"` // Services that send traffic to the endpoint.
+	Requests          *float64 `json:"requests,omitempty"`
+	Target5XXCount    *float64 `json:"target5XXCount,omitempty"`
+	AvgResponseTimeMs *float64 `json:"averageResponseTimeMs,omitempty"`
 }
 
 // appRunnerServiceStatus contains the status for an App Runner service.
@@ -113,6 +131,15 @@ func (s *staticSiteServiceStatus) JSONString() (string, error) {
 	return fmt.Sprintf("%s\n", b), nil
 }
 
+// AlarmStates returns the state of each CloudWatch alarm of the service by alarm name.
+func (s *ecsServiceStatus) AlarmStates() map[string]string {
+	states := make(map[string]string, len(s.Alarms))
+	for _, alarm := range s.Alarms {
+		states[alarm.Name] = alarm.Status
+	}
+	return states
+}
+
 // HumanString returns the stringified ecsServiceStatus struct in human-readable format.
 func (s *ecsServiceStatus) HumanString() string {
 	var b bytes.Buffer
@@ -143,6 +170,13 @@ func (s *ecsServiceStatus) HumanString() string {
 		s.writeAlarms(writer)
 		writer.Flush()
 	}
+
+	if s.ServiceConnect != nil {
+		fmt.Fprint(writer, color.Bold.Sprint("\nService Connect\n\n"))
+		writer.Flush()
+		s.writeServiceConnect(writer)
+		writer.Flush()
+	}
 	return b.String()
 }
 
@@ -397,6 +431,20 @@ func (s *ecsServiceStatus) writeStoppedTasks(writer io.Writer) {
 		}
 		printWithMaxWidth(writer, "  %s\t%s\t%s\n", 30, reason, strconv.Itoa(len(ids)), strings.Join(sampleIDs, ","))
 	}
+	if interrupted := s.spotInterruptions(); interrupted > 0 {
+		fmt.Fprintf(writer, "\n  %s\n", color.Faint.Sprintf("%d of the stopped tasks were interrupted because Fargate Spot capacity was reclaimed.", interrupted))
+	}
+}
+
+// spotInterruptions returns the number of stopped tasks that were interrupted by Fargate Spot.
+func (s *ecsServiceStatus) spotInterruptions() int {
+	var count int
+	for _, task := range s.StoppedTasks {
+		if task.StopCode == awsecs.TaskStopCodeSpotInterruption {
+			count++
+		}
+	}
+	return count
 }
 
 func (s *ecsServiceStatus) writeRunningTasks(writer io.Writer) {
@@ -452,6 +500,33 @@ func (s *ecsServiceStatus) writeAlarms(writer io.Writer) {
 	}
 }
 
+func (s *ecsServiceStatus) writeServiceConnect(writer io.Writer) {
+	fmt.Fprintf(writer, "  %s\t%s\n", "Namespace", s.ServiceConnect.Namespace)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Upstreams", joinOrDash(s.ServiceConnect.Upstreams))
+	if len(s.ServiceConnect.Endpoints) == 0 {
+		return
+	}
+	fmt.Fprintln(writer)
+	headers := []string{"Endpoint", "Aliases", "Downstreams", "Requests (1h)", "5XX (1h)", "Avg. Response Time"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, endpoint := range s.ServiceConnect.Endpoints {
+		responseTime := "-"
+		if endpoint.AvgResponseTimeMs != nil {
+			responseTime = fmt.Sprintf("%.0fms", *endpoint.AvgResponseTimeMs)
+		}
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\t%s\n", endpoint.DiscoveryName, strings.Join(endpoint.Aliases, ", "), joinOrDash(endpoint.Downstreams),
+			formatMetricCount(endpoint.Requests), formatMetricCount(endpoint.Target5XXCount), responseTime)
+	}
+}
+
+func formatMetricCount(count *float64) string {
+	if count == nil {
+		return "-"
+	}
+	return strconv.FormatFloat(*count, 'f', 0, 64)
+}
+
 type ecsTaskStatus awsecs.TaskStatus
 
 // Example output:
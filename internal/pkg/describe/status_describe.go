@@ -6,6 +6,8 @@ package describe
 import (
 	"fmt"
 	"sort"
+	"strings"
+	"time"
 
 	awsS3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/s3"
@@ -27,6 +29,21 @@ const (
 	rollbackAlarmType           = "Rollback"
 )
 
+// Service Connect proxies publish their metrics to the ECS namespace. A metric with a TargetDiscoveryName dimension
+// is emitted by the proxy of a client for the traffic it sends to the endpoint with that discovery name.
+const (
+	ecsMetricNamespace                         = "AWS/ECS"
+	ecsClusterNameDimension                    = "ClusterName"
+	ecsServiceNameDimension                    = "ServiceName"
+	serviceConnectDiscoveryNameDimension       = "DiscoveryName"
+	serviceConnectTargetDiscoveryNameDimension = "TargetDiscoveryName"
+	serviceConnectConnectionCountMetric        = "NewConnectionCount"
+	serviceConnectRequestCountMetric           = "RequestCount"
+	serviceConnect5XXCountMetric               = "HTTPCode_Target_5XX_Count"
+	serviceConnectResponseTimeMetric           = "TargetResponseTime"
+	serviceConnectMetricsLookback              = time.Hour
+)
+
 type targetHealthGetter interface {
 	TargetsHealth(targetGroupARN string) ([]*elbv2.TargetHealth, error)
 }
@@ -45,6 +62,12 @@ type ecsServiceGetter interface {
 	Service(clusterName, serviceName string) (*awsecs.Service, error)
 }
 
+type serviceConnectMetricsGetter interface {
+	MetricDimensions(namespace, name string, filters map[string]string) ([]map[string]string, error)
+	MetricSum(q cloudwatch.MetricQuery) (*float64, error)
+	MetricAverage(q cloudwatch.MetricQuery) (*float64, error)
+}
+
 type serviceDescriber interface {
 	DescribeService(app, env, svc string) (*ecs.ServiceDesc, error)
 }
@@ -63,6 +86,8 @@ type ecsStatusDescriber struct {
 	cwSvcGetter        alarmStatusGetter
 	aasSvcGetter       autoscalingAlarmNamesGetter
 	targetHealthGetter targetHealthGetter
+	scMetricsGetter    serviceConnectMetricsGetter
+	now                func() time.Time
 }
 
 type appRunnerStatusDescriber struct {
@@ -109,6 +134,8 @@ func NewECSStatusDescriber(opt *NewServiceStatusConfig) (*ecsStatusDescriber, er
 		ecsSvcGetter:       awsecs.New(sess),
 		aasSvcGetter:       aas.New(sess),
 		targetHealthGetter: elbv2.New(sess),
+		scMetricsGetter:    cloudwatch.New(sess),
+		now:                time.Now,
 	}, nil
 }
 
@@ -233,6 +260,10 @@ func (s *ecsStatusDescriber) Describe() (HumanJSONStringer, error) {
 		}
 		return tasksTargetHealth[i].TargetGroupARN < tasksTargetHealth[j].TargetGroupARN
 	})
+	serviceConnect, err := s.serviceConnectStatus(svcDesc.ClusterName, svcDesc.Name, service)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ecsServiceStatus{
 		Service:                  service.ServiceStatus(),
@@ -240,9 +271,132 @@ func (s *ecsStatusDescriber) Describe() (HumanJSONStringer, error) {
 		Alarms:                   alarmList,
 		StoppedTasks:             stoppedTaskStatus,
 		TargetHealthDescriptions: tasksTargetHealth,
+		ServiceConnect:           serviceConnect,
 	}, nil
 }
 
+// serviceConnectStatus returns the endpoints that the service exposes with Service Connect, the services that called them
+// and the traffic they received in the last hour, along with the endpoints that the service sent traffic to.
+// It returns nil if Service Connect is not enabled for the service.
+func (s *ecsStatusDescriber) serviceConnectStatus(cluster, ecsSvcName string, service *awsecs.Service) (*serviceConnectStatus, error) {
+	namespace, endpoints := service.ServiceConnectEndpoints()
+	if namespace == "" {
+		return nil, nil
+	}
+	upstreams, err := serviceConnectTargets(s.scMetricsGetter, cluster, ecsSvcName)
+	if err != nil {
+		return nil, fmt.Errorf("get upstream endpoints of service %s: %w", s.svc, err)
+	}
+	status := &serviceConnectStatus{
+		Namespace: namespace,
+		Upstreams: upstreams,
+	}
+	end := s.now().Truncate(time.Minute)
+	for _, endpoint := range endpoints {
+		clients, err := serviceConnectClients(s.scMetricsGetter, cluster, endpoint.DiscoveryName)
+		if err != nil {
+			return nil, fmt.Errorf("get downstream services of endpoint %s: %w", endpoint.DiscoveryName, err)
+		}
+		downstreams := make([]string, len(clients))
+		for i, client := range clients {
+			downstreams[i] = workloadNameOfECSService(s.app, s.env, client)
+		}
+		sort.Strings(downstreams)
+		q := cloudwatch.MetricQuery{
+			Namespace: ecsMetricNamespace,
+			Dimensions: map[string]string{
+				ecsClusterNameDimension:              cluster,
+				ecsServiceNameDimension:              ecsSvcName,
+				serviceConnectDiscoveryNameDimension: endpoint.DiscoveryName,
+			},
+			StartTime: end.Add(-serviceConnectMetricsLookback),
+			EndTime:   end,
+		}
+		q.Name = serviceConnectRequestCountMetric
+		requests, err := s.scMetricsGetter.MetricSum(q)
+		if err != nil {
+			return nil, fmt.Errorf("get requests to endpoint %s: %w", endpoint.DiscoveryName, err)
+		}
+		q.Name = serviceConnect5XXCountMetric
+		errs, err := s.scMetricsGetter.MetricSum(q)
+		if err != nil {
+			return nil, fmt.Errorf("get 5XX responses of endpoint %s: %w", endpoint.DiscoveryName, err)
+		}
+		q.Name = serviceConnectResponseTimeMetric
+		responseTime, err := s.scMetricsGetter.MetricAverage(q)
+		if err != nil {
+			return nil, fmt.Errorf("get response time of endpoint %s: %w", endpoint.DiscoveryName, err)
+		}
+		status.Endpoints = append(status.Endpoints, &serviceConnectEndpointStatus{
+			DiscoveryName:     endpoint.DiscoveryName,
+			Aliases:           endpoint.Aliases,
+			Downstreams:       downstreams,
+			Requests:          requests,
+			Target5XXCount:    errs,
+			AvgResponseTimeMs: responseTime,
+		})
+	}
+	return status, nil
+}
+
+// serviceConnectTargets returns the sorted discovery names of the endpoints that the
+// ECS service sent traffic to through its Service Connect proxy in the last hours.
+func serviceConnectTargets(getter serviceConnectMetricsGetter, cluster, ecsSvcName string) ([]string, error) {
+	dimensions, err := getter.MetricDimensions(ecsMetricNamespace, serviceConnectConnectionCountMetric, map[string]string{
+		ecsClusterNameDimension: cluster,
+		ecsServiceNameDimension: ecsSvcName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uniqueDimensionValues(dimensions, serviceConnectTargetDiscoveryNameDimension), nil
+}
+
+// serviceConnectClients returns the sorted names of the ECS services that sent traffic
+// to the endpoint with the discovery name through Service Connect in the last hours.
+func serviceConnectClients(getter serviceConnectMetricsGetter, cluster, discoveryName string) ([]string, error) {
+	dimensions, err := getter.MetricDimensions(ecsMetricNamespace, serviceConnectConnectionCountMetric, map[string]string{
+		ecsClusterNameDimension:                    cluster,
+		serviceConnectTargetDiscoveryNameDimension: discoveryName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uniqueDimensionValues(dimensions, ecsServiceNameDimension), nil
+}
+
+func uniqueDimensionValues(dimensions []map[string]string, name string) []string {
+	seen := make(map[string]struct{})
+	values := []string{}
+	for _, dimension := range dimensions {
+		value, ok := dimension[name]
+		if !ok || value == "" {
+			continue
+		}
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		values = append(values, value)
+	}
+	sort.Strings(values)
+	return values
+}
+
+// workloadNameOfECSService returns the name of the Copilot service from the name that CloudFormation generated
+// for its ECS service, such as "phonetool-test-api-Service-ah1P0eRCrpZI" for the service "api".
+// It returns the name of the ECS service as is if the service was not deployed by Copilot in the environment.
+func workloadNameOfECSService(app, env, ecsSvcName string) string {
+	name := strings.TrimPrefix(ecsSvcName, fmt.Sprintf("%s-%s-", app, env))
+	if name == ecsSvcName {
+		return ecsSvcName
+	}
+	if i := strings.LastIndex(name, "-Service-"); i > 0 {
+		return name[:i]
+	}
+	return ecsSvcName
+}
+
 // Describe returns the status of an AppRunner service.
 func (a *appRunnerStatusDescriber) Describe() (HumanJSONStringer, error) {
 	svc, err := a.svcDescriber.Service()
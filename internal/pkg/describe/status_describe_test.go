@@ -686,6 +686,137 @@ func TestServiceStatus_Describe(t *testing.T) {
 	}
 }
 
+func TestECSStatusDescriber_serviceConnectStatus(t *testing.T) {
+	mockNow := time.Date(2023, time.March, 1, 12, 30, 15, 0, time.UTC)
+	mockEnd := time.Date(2023, time.March, 1, 12, 30, 0, 0, time.UTC)
+	mockService := &awsecs.Service{
+		Deployments: []*ecsapi.Deployment{
+			{
+				ServiceConnectConfiguration: &ecsapi.ServiceConnectConfiguration{
+					Enabled:   aws.Bool(true),
+					Namespace: aws.String("mockApp.local"),
+					Services: []*ecsapi.ServiceConnectService{
+						{
+							PortName: aws.String("target"),
+							ClientAliases: []*ecsapi.ServiceConnectClientAlias{
+								{
+									DnsName: aws.String("api"),
+									Port:    aws.Int64(80),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	endpointQuery := func(name string) cloudwatch.MetricQuery {
+		return cloudwatch.MetricQuery{
+			Namespace: "AWS/ECS",
+			Name:      name,
+			Dimensions: map[string]string{
+				"ClusterName":   "mockCluster",
+				"ServiceName":   "mockApp-mockEnv-mockSvc-Service-abc",
+				"DiscoveryName": "target",
+			},
+			StartTime: mockEnd.Add(-time.Hour),
+			EndTime:   mockEnd,
+		}
+	}
+	testCases := map[string]struct {
+		inService  *awsecs.Service
+		setupMocks func(m *mocks.MockserviceConnectMetricsGetter)
+
+		wanted      *serviceConnectStatus
+		wantedError error
+	}{
+		"return nil if Service Connect is not enabled": {
+			inService:  &awsecs.Service{Deployments: []*ecsapi.Deployment{{}}},
+			setupMocks: func(m *mocks.MockserviceConnectMetricsGetter) {},
+		},
+		"wrap the error if fail to list the upstream endpoints": {
+			inService: mockService,
+			setupMocks: func(m *mocks.MockserviceConnectMetricsGetter) {
+				m.EXPECT().MetricDimensions(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get upstream endpoints of service mockSvc: some error"),
+		},
+		"wrap the error if fail to get the metrics of an endpoint": {
+			inService: mockService,
+			setupMocks: func(m *mocks.MockserviceConnectMetricsGetter) {
+				m.EXPECT().MetricDimensions(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+				m.EXPECT().MetricSum(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get requests to endpoint target: some error"),
+		},
+		"return the endpoints, their callers and their traffic": {
+			inService: mockService,
+			setupMocks: func(m *mocks.MockserviceConnectMetricsGetter) {
+				m.EXPECT().MetricDimensions("AWS/ECS", "NewConnectionCount", map[string]string{
+					"ClusterName": "mockCluster",
+					"ServiceName": "mockApp-mockEnv-mockSvc-Service-abc",
+				}).Return([]map[string]string{
+					{"ClusterName": "mockCluster", "ServiceName": "mockApp-mockEnv-mockSvc-Service-abc", "TargetDiscoveryName": "payments"},
+					{"ClusterName": "mockCluster", "ServiceName": "mockApp-mockEnv-mockSvc-Service-abc", "TargetDiscoveryName": "db"},
+					{"ClusterName": "mockCluster", "ServiceName": "mockApp-mockEnv-mockSvc-Service-abc", "TargetDiscoveryName": "payments"},
+					{"ClusterName": "mockCluster", "ServiceName": "mockApp-mockEnv-mockSvc-Service-abc", "DiscoveryName": "target"},
+				}, nil)
+				m.EXPECT().MetricDimensions("AWS/ECS", "NewConnectionCount", map[string]string{
+					"ClusterName":         "mockCluster",
+					"TargetDiscoveryName": "target",
+				}).Return([]map[string]string{
+					{"ClusterName": "mockCluster", "ServiceName": "mockApp-mockEnv-frontend-Service-xyz", "TargetDiscoveryName": "target"},
+					{"ClusterName": "mockCluster", "ServiceName": "legacy", "TargetDiscoveryName": "target"},
+				}, nil)
+				m.EXPECT().MetricSum(endpointQuery("RequestCount")).Return(aws.Float64(100), nil)
+				m.EXPECT().MetricSum(endpointQuery("HTTPCode_Target_5XX_Count")).Return(nil, nil)
+				m.EXPECT().MetricAverage(endpointQuery("TargetResponseTime")).Return(aws.Float64(25), nil)
+			},
+			wanted: &serviceConnectStatus{
+				Namespace: "mockApp.local",
+				Upstreams: []string{"db", "payments"},
+				Endpoints: []*serviceConnectEndpointStatus{
+					{
+						DiscoveryName:     "target",
+						Aliases:           []string{"api:80"},
+						Downstreams:       []string{"frontend", "legacy"},
+						Requests:          aws.Float64(100),
+						AvgResponseTimeMs: aws.Float64(25),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockserviceConnectMetricsGetter(ctrl)
+			tc.setupMocks(m)
+			d := &ecsStatusDescriber{
+				app:             "mockApp",
+				env:             "mockEnv",
+				svc:             "mockSvc",
+				scMetricsGetter: m,
+				now:             func() time.Time { return mockNow },
+			}
+
+			// WHEN
+			got, err := d.serviceConnectStatus("mockCluster", "mockApp-mockEnv-mockSvc-Service-abc", tc.inService)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
 func TestAppRunnerStatusDescriber_Describe(t *testing.T) {
 	appName := "testapp"
 	envName := "test"
@@ -0,0 +1,230 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const (
+	fmtECSSvcLogGroupName = "/copilot/%s-%s-%s"
+	fmtECSLogStreamPrefix = "copilot/%s/%s" // Streams are named after the container and the task ID.
+
+	// DefaultPreviousLogLines is the number of log lines shown for each stopped task by default.
+	DefaultPreviousLogLines = 20
+)
+
+// NewPreviousDeploymentDescriberConfig contains fields that initiate an ecsPreviousDeploymentDescriber.
+type NewPreviousDeploymentDescriberConfig struct {
+	NewServiceStatusConfig
+	LogLines int    // Number of log lines to show for each stopped task.
+	LogGroup string // Optional. Name of the log group of the service if it isn't the default one.
+}
+
+// ecsPreviousDeploymentDescriber describes the tasks that ECS stopped for the latest task definition revision
+// that has stopped tasks, which is the revision of the deployment that failed when the service rolled back.
+type ecsPreviousDeploymentDescriber struct {
+	app      string
+	env      string
+	svc      string
+	logLines int
+	logGroup string
+
+	svcDescriber serviceDescriber
+	logGetter    logGetter
+}
+
+// NewECSPreviousDeploymentDescriber instantiates a new ecsPreviousDeploymentDescriber struct.
+func NewECSPreviousDeploymentDescriber(opt *NewPreviousDeploymentDescriberConfig) (*ecsPreviousDeploymentDescriber, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.ImmutableProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return &ecsPreviousDeploymentDescriber{
+		app:          opt.App,
+		env:          opt.Env,
+		svc:          opt.Svc,
+		logLines:     opt.LogLines,
+		logGroup:     opt.LogGroup,
+		svcDescriber: ecs.New(sess),
+		logGetter:    cloudwatchlogs.New(sess),
+	}, nil
+}
+
+// Describe returns the stopped tasks of the previous deployment of an ECS service, with the exit codes
+// of their containers and their last log lines.
+func (d *ecsPreviousDeploymentDescriber) Describe() (HumanJSONStringer, error) {
+	svcDesc, err := d.svcDescriber.DescribeService(d.app, d.env, d.svc)
+	if err != nil {
+		return nil, fmt.Errorf("get ECS service description for %s: %w", d.svc, err)
+	}
+	revision := 0
+	for _, task := range svcDesc.StoppedTasks {
+		if taskRevision, err := awsecs.TaskDefinitionVersion(aws.StringValue(task.TaskDefinitionArn)); err == nil && taskRevision > revision {
+			revision = taskRevision
+		}
+	}
+	status := &ecsPreviousDeploymentStatus{
+		TaskDefinitionRevision: revision,
+	}
+	for _, task := range svcDesc.StoppedTasks {
+		if taskRevision, err := awsecs.TaskDefinitionVersion(aws.StringValue(task.TaskDefinitionArn)); err != nil || taskRevision != revision {
+			continue
+		}
+		stopped, err := d.stoppedTask(task)
+		if err != nil {
+			return nil, err
+		}
+		status.Tasks = append(status.Tasks, stopped)
+	}
+	return status, nil
+}
+
+func (d *ecsPreviousDeploymentDescriber) logGroupName() string {
+	if d.logGroup != "" {
+		return d.logGroup
+	}
+	return fmt.Sprintf(fmtECSSvcLogGroupName, d.app, d.env, d.svc)
+}
+
+func (d *ecsPreviousDeploymentDescriber) stoppedTask(task *awsecs.Task) (*stoppedTask, error) {
+	taskStatus, err := task.TaskStatus()
+	if err != nil {
+		return nil, fmt.Errorf("get status for stopped task %s: %w", aws.StringValue(task.TaskArn), err)
+	}
+	out := &stoppedTask{
+		ID:            taskStatus.ID,
+		StartedAt:     taskStatus.StartedAt,
+		StoppedAt:     taskStatus.StoppedAt,
+		StopCode:      aws.StringValue(task.StopCode),
+		StoppedReason: taskStatus.StoppedReason,
+	}
+	var prefixes []string
+	for _, container := range task.Containers {
+		name := aws.StringValue(container.Name)
+		out.Containers = append(out.Containers, stoppedContainer{
+			Name:     name,
+			ExitCode: container.ExitCode,
+			Reason:   aws.StringValue(container.Reason),
+		})
+		prefixes = append(prefixes, fmt.Sprintf(fmtECSLogStreamPrefix, name, taskStatus.ID))
+	}
+	if d.logLines <= 0 || len(prefixes) == 0 {
+		return out, nil
+	}
+	logs, err := d.logGetter.LogEvents(cloudwatchlogs.LogEventsOpts{
+		LogGroup:               d.logGroupName(),
+		LogStreamPrefixFilters: prefixes,
+		Limit:                  aws.Int64(int64(d.logLines)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get logs of stopped task %s: %w", taskStatus.ID, err)
+	}
+	out.LogEvents = logs.Events
+	return out, nil
+}
+
+// ecsPreviousDeploymentStatus contains the stopped tasks of the previous deployment of an ECS service.
+type ecsPreviousDeploymentStatus struct {
+	TaskDefinitionRevision int            `json:"taskDefinitionRevision"`
+	Tasks                  []*stoppedTask `json:"tasks"`
+}
+
+type stoppedTask struct {
+	ID            string                  `json:"id"`
+	StartedAt     time.Time               `json:"startedAt"`
+	StoppedAt     time.Time               `json:"stoppedAt"`
+	StopCode      string                  `json:"stopCode"`
+	StoppedReason string                  `json:"stoppedReason"`
+	Containers    []stoppedContainer      `json:"containers"`
+	LogEvents     []*cloudwatchlogs.Event `json:"logEvents"`
+}
+
+type stoppedContainer struct {
+	Name     string `json:"name"`
+	ExitCode *int64 `json:"exitCode,omitempty"` // Nil if the container never ran, for example when its image couldn't be pulled.
+	Reason   string `json:"reason,omitempty"`
+}
+
+// JSONString returns the stringified ecsPreviousDeploymentStatus struct with json format.
+func (s *ecsPreviousDeploymentStatus) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal previous deployment: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified ecsPreviousDeploymentStatus struct in human-readable format.
+func (s *ecsPreviousDeploymentStatus) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, statusMinCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
+	if len(s.Tasks) == 0 {
+		fmt.Fprint(writer, "No stopped tasks found. ECS only keeps stopped tasks for about an hour.\n")
+		writer.Flush()
+		return b.String()
+	}
+	fmt.Fprint(writer, color.Bold.Sprint("Previous Deployment\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%d\n", "Revision", s.TaskDefinitionRevision)
+	fmt.Fprintf(writer, "  %s\t%d\n", "Stopped Tasks", len(s.Tasks))
+	writer.Flush()
+	for _, task := range s.Tasks {
+		fmt.Fprint(writer, color.Bold.Sprintf("\nTask %s\n\n", shortTaskID(task.ID)))
+		writer.Flush()
+		startedAt := "-" // Tasks that fail to pull their images never start.
+		if !task.StartedAt.IsZero() {
+			startedAt = humanizeTime(task.StartedAt)
+		}
+		fmt.Fprintf(writer, "  %s\t%s\n", "Started At", startedAt)
+		fmt.Fprintf(writer, "  %s\t%s\n", "Stopped At", humanizeTime(task.StoppedAt))
+		fmt.Fprintf(writer, "  %s\t%s\n", "Stop Code", task.StopCode)
+		fmt.Fprintf(writer, "  %s\t%s\n", "Stopped Reason", task.StoppedReason)
+		writer.Flush()
+
+		headers := []string{"Container", "Exit Code", "Reason"}
+		fmt.Fprintf(writer, "\n  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, container := range task.Containers {
+			exitCode, reason := "-", "-"
+			if container.ExitCode != nil {
+				exitCode = strconv.FormatInt(aws.Int64Value(container.ExitCode), 10)
+			}
+			if container.Reason != "" {
+				reason = container.Reason
+			}
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", container.Name, exitCode, reason)
+		}
+		writer.Flush()
+
+		if len(task.LogEvents) == 0 {
+			continue
+		}
+		fmt.Fprint(writer, color.Bold.Sprint("\n  Last Log Lines\n\n"))
+		writer.Flush()
+		for _, event := range task.LogEvents {
+			timestamp := time.UnixMilli(event.Timestamp).UTC()
+			fmt.Fprintf(writer, "  %v\t%s\n", timestamp.Format(time.RFC3339), event.Message)
+		}
+		writer.Flush()
+	}
+	return b.String()
+}
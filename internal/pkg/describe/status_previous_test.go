@@ -0,0 +1,274 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/dustin/go-humanize"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestECSPreviousDeploymentDescriber_Describe(t *testing.T) {
+	const (
+		fmtTaskARN    = "arn:aws:ecs:us-west-2:1234567890:task/mockCluster/%s"
+		fmtTaskDefARN = "arn:aws:ecs:us-west-2:1234567890:task-definition/phonetool-test-api:%d"
+	)
+	startTime := time.Unix(1594044000, 0)
+	stopTime := time.Unix(1594044060, 0)
+	newStoppedTask := func(id string, revision int) *awsecs.Task {
+		return &awsecs.Task{
+			TaskArn:           aws.String(fmt.Sprintf(fmtTaskARN, id)),
+			TaskDefinitionArn: aws.String(fmt.Sprintf(fmtTaskDefARN, revision)),
+			StartedAt:         &startTime,
+			StoppedAt:         &stopTime,
+			StopCode:          aws.String("EssentialContainerExited"),
+			StoppedReason:     aws.String("Essential container in task exited"),
+			Containers: []*ecsapi.Container{
+				{
+					Name:     aws.String("api"),
+					ExitCode: aws.Int64(1),
+				},
+				{
+					Name:   aws.String("firelens_log_router"),
+					Reason: aws.String("Task stopped"),
+				},
+			},
+		}
+	}
+	logEvents := []*cloudwatchlogs.Event{
+		{
+			LogStreamName: "copilot/api/1234567890abcdef",
+			Message:       "panic: missing environment variable DB_HOST",
+			Timestamp:     1594044059000,
+		},
+	}
+	testCases := map[string]struct {
+		logLines   int
+		setupMocks func(m serviceStatusDescriberMocks)
+
+		wantedContent *ecsPreviousDeploymentStatus
+		wantedError   error
+	}{
+		"errors if failed to describe the service": {
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				m.serviceDescriber.EXPECT().DescribeService("phonetool", "test", "api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get ECS service description for api: some error"),
+		},
+		"errors if failed to get the logs of a stopped task": {
+			logLines: 10,
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				m.serviceDescriber.EXPECT().DescribeService("phonetool", "test", "api").Return(&ecs.ServiceDesc{
+					StoppedTasks: []*awsecs.Task{newStoppedTask("1234567890abcdef", 5)},
+				}, nil)
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get logs of stopped task 1234567890abcdef: some error"),
+		},
+		"returns an empty status if no task was stopped": {
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				m.serviceDescriber.EXPECT().DescribeService("phonetool", "test", "api").Return(&ecs.ServiceDesc{}, nil)
+			},
+			wantedContent: &ecsPreviousDeploymentStatus{},
+		},
+		"only describes the stopped tasks of the latest revision": {
+			logLines: 10,
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				m.serviceDescriber.EXPECT().DescribeService("phonetool", "test", "api").Return(&ecs.ServiceDesc{
+					StoppedTasks: []*awsecs.Task{
+						newStoppedTask("abcdef1234567890", 4),
+						newStoppedTask("1234567890abcdef", 5),
+					},
+				}, nil)
+				m.logGetter.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{
+					LogGroup:               "/copilot/phonetool-test-api",
+					LogStreamPrefixFilters: []string{"copilot/api/1234567890abcdef", "copilot/firelens_log_router/1234567890abcdef"},
+					Limit:                  aws.Int64(10),
+				}).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: logEvents,
+				}, nil)
+			},
+			wantedContent: &ecsPreviousDeploymentStatus{
+				TaskDefinitionRevision: 5,
+				Tasks: []*stoppedTask{
+					{
+						ID:            "1234567890abcdef",
+						StartedAt:     startTime,
+						StoppedAt:     stopTime,
+						StopCode:      "EssentialContainerExited",
+						StoppedReason: "Essential container in task exited",
+						Containers: []stoppedContainer{
+							{
+								Name:     "api",
+								ExitCode: aws.Int64(1),
+							},
+							{
+								Name:   "firelens_log_router",
+								Reason: "Task stopped",
+							},
+						},
+						LogEvents: logEvents,
+					},
+				},
+			},
+		},
+		"does not fetch logs if no log lines are requested": {
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				m.serviceDescriber.EXPECT().DescribeService("phonetool", "test", "api").Return(&ecs.ServiceDesc{
+					StoppedTasks: []*awsecs.Task{newStoppedTask("1234567890abcdef", 5)},
+				}, nil)
+			},
+			wantedContent: &ecsPreviousDeploymentStatus{
+				TaskDefinitionRevision: 5,
+				Tasks: []*stoppedTask{
+					{
+						ID:            "1234567890abcdef",
+						StartedAt:     startTime,
+						StoppedAt:     stopTime,
+						StopCode:      "EssentialContainerExited",
+						StoppedReason: "Essential container in task exited",
+						Containers: []stoppedContainer{
+							{
+								Name:     "api",
+								ExitCode: aws.Int64(1),
+							},
+							{
+								Name:   "firelens_log_router",
+								Reason: "Task stopped",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := serviceStatusDescriberMocks{
+				serviceDescriber: mocks.NewMockserviceDescriber(ctrl),
+				logGetter:        mocks.NewMocklogGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			describer := &ecsPreviousDeploymentDescriber{
+				app:          "phonetool",
+				env:          "test",
+				svc:          "api",
+				logLines:     tc.logLines,
+				svcDescriber: m.serviceDescriber,
+				logGetter:    m.logGetter,
+			}
+
+			// WHEN
+			got, err := describer.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, got)
+			}
+		})
+	}
+}
+
+func TestECSPreviousDeploymentStatus_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2020-07-06T14:10:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	stopTime, _ := time.Parse(time.RFC3339, "2020-07-06T14:01:00+00:00")
+
+	testCases := map[string]struct {
+		status *ecsPreviousDeploymentStatus
+
+		wantedHuman string
+		wantedJSON  string
+	}{
+		"no stopped tasks": {
+			status:      &ecsPreviousDeploymentStatus{},
+			wantedHuman: "No stopped tasks found. ECS only keeps stopped tasks for about an hour.\n",
+			wantedJSON:  "{\"taskDefinitionRevision\":0,\"tasks\":null}\n",
+		},
+		"a task that failed to start": {
+			status: &ecsPreviousDeploymentStatus{
+				TaskDefinitionRevision: 5,
+				Tasks: []*stoppedTask{
+					{
+						ID:            "1234567890abcdef",
+						StoppedAt:     stopTime,
+						StopCode:      "TaskFailedToStart",
+						StoppedReason: "CannotPullContainerError: pull image manifest has been retried 5 time(s)",
+						Containers: []stoppedContainer{
+							{
+								Name:   "api",
+								Reason: "CannotPullContainerError",
+							},
+							{
+								Name:     "sidecar",
+								ExitCode: aws.Int64(137),
+							},
+						},
+						LogEvents: []*cloudwatchlogs.Event{
+							{
+								Message:   "starting sidecar",
+								Timestamp: 1594044059000,
+							},
+						},
+					},
+				},
+			},
+			wantedHuman: `Previous Deployment
+
+  Revision       5
+  Stopped Tasks  1
+
+Task 12345678
+
+  Started At      -
+  Stopped At      9 minutes ago
+  Stop Code       TaskFailedToStart
+  Stopped Reason  CannotPullContainerError: pull image manifest has been retried 5 time(s)
+
+  Container  Exit Code   Reason
+  ---------  ---------   ------
+  api        -           CannotPullContainerError
+  sidecar    137         -
+
+  Last Log Lines
+
+  2020-07-06T14:00:59Z  starting sidecar
+`,
+			wantedJSON: "{\"taskDefinitionRevision\":5,\"tasks\":[{\"id\":\"1234567890abcdef\",\"startedAt\":\"0001-01-01T00:00:00Z\",\"stoppedAt\":\"2020-07-06T14:01:00Z\",\"stopCode\":\"TaskFailedToStart\",\"stoppedReason\":\"CannotPullContainerError: pull image manifest has been retried 5 time(s)\",\"containers\":[{\"name\":\"api\",\"reason\":\"CannotPullContainerError\"},{\"name\":\"sidecar\",\"exitCode\":137}],\"logEvents\":[{\"logStreamName\":\"\",\"ingestionTime\":0,\"message\":\"starting sidecar\",\"timestamp\":1594044059000}]}]}\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			json, err := tc.status.JSONString()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJSON, json)
+			require.Equal(t, tc.wantedHuman, tc.status.HumanString())
+		})
+	}
+}
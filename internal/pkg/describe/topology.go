@@ -0,0 +1,222 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+type workloadECSServiceGetter interface {
+	Service(app, env, svc string) (*awsecs.Service, error)
+}
+
+type serviceTopologyDescriber struct {
+	app string
+	env string
+
+	configStore     ConfigStoreSvc
+	deployStore     DeployedEnvServicesLister
+	ecsSvcGetter    workloadECSServiceGetter
+	scMetricsGetter serviceConnectMetricsGetter
+}
+
+// NewServiceTopologyConfig contains fields that initiates a serviceTopologyDescriber struct.
+type NewServiceTopologyConfig struct {
+	App         string
+	Env         string
+	ConfigStore ConfigStoreSvc
+	DeployStore DeployedEnvServicesLister
+}
+
+// NewServiceTopologyDescriber instantiates a describer of the Service Connect dependencies between the services of an environment.
+func NewServiceTopologyDescriber(opt *NewServiceTopologyConfig) (*serviceTopologyDescriber, error) {
+	env, err := opt.ConfigStore.GetEnvironment(opt.App, opt.Env)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", opt.Env, err)
+	}
+	sess, err := sessions.ImmutableProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("session for role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return &serviceTopologyDescriber{
+		app:             opt.App,
+		env:             opt.Env,
+		configStore:     opt.ConfigStore,
+		deployStore:     opt.DeployStore,
+		ecsSvcGetter:    ecs.New(sess),
+		scMetricsGetter: cloudwatch.New(sess),
+	}, nil
+}
+
+// topologyNode is a service of the environment that is part of a Service Connect namespace.
+type topologyNode struct {
+	Name      string   `json:"name"`
+	Endpoints []string `json:"endpoints"` // Discovery names of the endpoints exposed by the service.
+}
+
+// topologyEdge is the traffic sent by a service to the endpoint of another.
+// The target is the discovery name of the endpoint if no service of the environment exposes it.
+type topologyEdge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Endpoint string `json:"endpoint"`
+}
+
+// serviceTopology is the graph of the Service Connect dependencies between the services of an environment.
+type serviceTopology struct {
+	App          string          `json:"application"`
+	Env          string          `json:"environment"`
+	Services     []*topologyNode `json:"services"`
+	Dependencies []topologyEdge  `json:"dependencies"`
+}
+
+// Describe returns the services of the environment with Service Connect enabled, and the endpoints
+// that each of them sent traffic to in the last hours according to their Service Connect metrics.
+func (d *serviceTopologyDescriber) Describe() (GraphStringer, error) {
+	svcs, err := d.deployStore.ListDeployedServices(d.app, d.env)
+	if err != nil {
+		return nil, fmt.Errorf("list deployed services in environment %s: %w", d.env, err)
+	}
+	topology := &serviceTopology{
+		App:          d.app,
+		Env:          d.env,
+		Services:     []*topologyNode{},
+		Dependencies: []topologyEdge{},
+	}
+	exposedBy := make(map[string]string) // Discovery name to the service that exposes the endpoint.
+	upstreams := make(map[string][]string)
+	for _, name := range svcs {
+		wkld, err := d.configStore.GetWorkload(d.app, name)
+		if err != nil {
+			return nil, fmt.Errorf("retrieve service %s: %w", name, err)
+		}
+		if wkld.Type == manifestinfo.RequestDrivenWebServiceType || wkld.Type == manifestinfo.StaticSiteType {
+			continue // Only ECS services can use Service Connect.
+		}
+		service, err := d.ecsSvcGetter.Service(d.app, d.env, name)
+		if err != nil {
+			return nil, fmt.Errorf("get ECS service of %s: %w", name, err)
+		}
+		namespace, endpoints := service.ServiceConnectEndpoints()
+		if namespace == "" {
+			continue
+		}
+		svcARN, err := awsecs.ParseServiceArn(aws.StringValue(service.ServiceArn))
+		if err != nil {
+			return nil, err
+		}
+		targets, err := serviceConnectTargets(d.scMetricsGetter, svcARN.ClusterName(), svcARN.ServiceName())
+		if err != nil {
+			return nil, fmt.Errorf("get upstream endpoints of service %s: %w", name, err)
+		}
+		node := &topologyNode{
+			Name:      name,
+			Endpoints: []string{},
+		}
+		for _, endpoint := range endpoints {
+			node.Endpoints = append(node.Endpoints, endpoint.DiscoveryName)
+			exposedBy[endpoint.DiscoveryName] = name
+		}
+		topology.Services = append(topology.Services, node)
+		upstreams[name] = targets
+	}
+	for _, node := range topology.Services {
+		for _, endpoint := range upstreams[node.Name] {
+			to := endpoint
+			if svc, ok := exposedBy[endpoint]; ok {
+				to = svc
+			}
+			topology.Dependencies = append(topology.Dependencies, topologyEdge{
+				From:     node.Name,
+				To:       to,
+				Endpoint: endpoint,
+			})
+		}
+	}
+	return topology, nil
+}
+
+// JSONString returns the stringified serviceTopology struct with json format.
+func (t *serviceTopology) JSONString() (string, error) {
+	b, err := json.Marshal(t)
+	if err != nil {
+		return "", fmt.Errorf("marshal service topology: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns the stringified serviceTopology struct with human readable format.
+func (t *serviceTopology) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, statusMinCellWidth, tabWidth, statusCellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("Services\n\n"))
+	writer.Flush()
+	if len(t.Services) == 0 {
+		fmt.Fprintf(writer, "  No services with Service Connect found in environment %s.\n", t.Env)
+		writer.Flush()
+		return b.String()
+	}
+	upstreams := make(map[string][]string)
+	downstreams := make(map[string][]string)
+	for _, edge := range t.Dependencies {
+		upstreams[edge.From] = append(upstreams[edge.From], edge.To)
+		downstreams[edge.To] = append(downstreams[edge.To], edge.From)
+	}
+	headers := []string{"Name", "Endpoints", "Upstreams", "Downstreams"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, node := range t.Services {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", node.Name, joinOrDash(node.Endpoints), joinOrDash(upstreams[node.Name]), joinOrDash(downstreams[node.Name]))
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// DOTString returns the serviceTopology as a directed graph in the DOT language of Graphviz.
+func (t *serviceTopology) DOTString() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", strconv.Quote(fmt.Sprintf("%s-%s", t.App, t.Env)))
+	nodes := make(map[string]struct{})
+	for _, node := range t.Services {
+		nodes[node.Name] = struct{}{}
+		fmt.Fprintf(&b, "  %s;\n", strconv.Quote(node.Name))
+	}
+	var external []string
+	for _, edge := range t.Dependencies {
+		if _, ok := nodes[edge.To]; !ok {
+			nodes[edge.To] = struct{}{}
+			external = append(external, edge.To)
+		}
+	}
+	sort.Strings(external)
+	for _, name := range external {
+		fmt.Fprintf(&b, "  %s [shape=box, style=dashed];\n", strconv.Quote(name))
+	}
+	for _, edge := range t.Dependencies {
+		fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", strconv.Quote(edge.From), strconv.Quote(edge.To), strconv.Quote(edge.Endpoint))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func joinOrDash(values []string) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,220 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type serviceTopologyMocks struct {
+	configStore *mocks.MockConfigStoreSvc
+	deployStore *mocks.MockDeployedEnvServicesLister
+	ecs         *mocks.MockworkloadECSServiceGetter
+	metrics     *mocks.MockserviceConnectMetricsGetter
+}
+
+func mockServiceConnectService(name string, discoveryNames ...string) *awsecs.Service {
+	var services []*ecsapi.ServiceConnectService
+	for _, discoveryName := range discoveryNames {
+		services = append(services, &ecsapi.ServiceConnectService{
+			PortName: aws.String(discoveryName),
+		})
+	}
+	return &awsecs.Service{
+		ServiceArn: aws.String("arn:aws:ecs:us-west-2:123456789012:service/phonetool-test-Cluster/phonetool-test-" + name + "-Service-abc"),
+		Deployments: []*ecsapi.Deployment{
+			{
+				ServiceConnectConfiguration: &ecsapi.ServiceConnectConfiguration{
+					Enabled:   aws.Bool(true),
+					Namespace: aws.String("phonetool.local"),
+					Services:  services,
+				},
+			},
+		},
+	}
+}
+
+func TestServiceTopologyDescriber_Describe(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m serviceTopologyMocks)
+
+		wanted      GraphStringer
+		wantedError error
+	}{
+		"wrap the error if fail to list the deployed services": {
+			setupMocks: func(m serviceTopologyMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list deployed services in environment test: some error"),
+		},
+		"wrap the error if fail to get the ECS service": {
+			setupMocks: func(m serviceTopologyMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"api"}, nil)
+				m.configStore.EXPECT().GetWorkload("phonetool", "api").Return(&config.Workload{Type: manifestinfo.BackendServiceType}, nil)
+				m.ecs.EXPECT().Service("phonetool", "test", "api").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get ECS service of api: some error"),
+		},
+		"wrap the error if fail to list the upstream endpoints": {
+			setupMocks: func(m serviceTopologyMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"api"}, nil)
+				m.configStore.EXPECT().GetWorkload("phonetool", "api").Return(&config.Workload{Type: manifestinfo.BackendServiceType}, nil)
+				m.ecs.EXPECT().Service("phonetool", "test", "api").Return(mockServiceConnectService("api", "api"), nil)
+				m.metrics.EXPECT().MetricDimensions(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get upstream endpoints of service api: some error"),
+		},
+		"build the graph of the services with Service Connect": {
+			setupMocks: func(m serviceTopologyMocks) {
+				m.deployStore.EXPECT().ListDeployedServices("phonetool", "test").Return([]string{"api", "frontend", "site", "worker"}, nil)
+				m.configStore.EXPECT().GetWorkload("phonetool", "api").Return(&config.Workload{Type: manifestinfo.BackendServiceType}, nil)
+				m.configStore.EXPECT().GetWorkload("phonetool", "frontend").Return(&config.Workload{Type: manifestinfo.LoadBalancedWebServiceType}, nil)
+				m.configStore.EXPECT().GetWorkload("phonetool", "site").Return(&config.Workload{Type: manifestinfo.StaticSiteType}, nil)
+				m.configStore.EXPECT().GetWorkload("phonetool", "worker").Return(&config.Workload{Type: manifestinfo.WorkerServiceType}, nil)
+				m.ecs.EXPECT().Service("phonetool", "test", "api").Return(mockServiceConnectService("api", "api"), nil)
+				m.ecs.EXPECT().Service("phonetool", "test", "frontend").Return(mockServiceConnectService("frontend"), nil)
+				m.ecs.EXPECT().Service("phonetool", "test", "worker").Return(&awsecs.Service{
+					Deployments: []*ecsapi.Deployment{{}},
+				}, nil)
+				m.metrics.EXPECT().MetricDimensions("AWS/ECS", "NewConnectionCount", map[string]string{
+					"ClusterName": "phonetool-test-Cluster",
+					"ServiceName": "phonetool-test-api-Service-abc",
+				}).Return([]map[string]string{
+					{"TargetDiscoveryName": "db"},
+				}, nil)
+				m.metrics.EXPECT().MetricDimensions("AWS/ECS", "NewConnectionCount", map[string]string{
+					"ClusterName": "phonetool-test-Cluster",
+					"ServiceName": "phonetool-test-frontend-Service-abc",
+				}).Return([]map[string]string{
+					{"TargetDiscoveryName": "api"},
+				}, nil)
+			},
+			wanted: &serviceTopology{
+				App: "phonetool",
+				Env: "test",
+				Services: []*topologyNode{
+					{Name: "api", Endpoints: []string{"api"}},
+					{Name: "frontend", Endpoints: []string{}},
+				},
+				Dependencies: []topologyEdge{
+					{From: "api", To: "db", Endpoint: "db"},
+					{From: "frontend", To: "api", Endpoint: "api"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := serviceTopologyMocks{
+				configStore: mocks.NewMockConfigStoreSvc(ctrl),
+				deployStore: mocks.NewMockDeployedEnvServicesLister(ctrl),
+				ecs:         mocks.NewMockworkloadECSServiceGetter(ctrl),
+				metrics:     mocks.NewMockserviceConnectMetricsGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			d := &serviceTopologyDescriber{
+				app:             "phonetool",
+				env:             "test",
+				configStore:     m.configStore,
+				deployStore:     m.deployStore,
+				ecsSvcGetter:    m.ecs,
+				scMetricsGetter: m.metrics,
+			}
+
+			// WHEN
+			got, err := d.Describe()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestServiceTopology_String(t *testing.T) {
+	testCases := map[string]struct {
+		in *serviceTopology
+
+		wantedHuman string
+		wantedJSON  string
+		wantedDOT   string
+	}{
+		"no services": {
+			in: &serviceTopology{
+				App:          "phonetool",
+				Env:          "test",
+				Services:     []*topologyNode{},
+				Dependencies: []topologyEdge{},
+			},
+			wantedHuman: `Services
+
+  No services with Service Connect found in environment test.
+`,
+			wantedJSON: `{"application":"phonetool","environment":"test","services":[],"dependencies":[]}
+`,
+			wantedDOT: `digraph "phonetool-test" {
+}
+`,
+		},
+		"services with dependencies": {
+			in: &serviceTopology{
+				App: "phonetool",
+				Env: "test",
+				Services: []*topologyNode{
+					{Name: "api", Endpoints: []string{"api"}},
+					{Name: "frontend", Endpoints: []string{}},
+				},
+				Dependencies: []topologyEdge{
+					{From: "api", To: "db", Endpoint: "db"},
+					{From: "frontend", To: "api", Endpoint: "api"},
+				},
+			},
+			wantedHuman: `Services
+
+  Name      Endpoints   Upstreams   Downstreams
+  ----      ---------   ---------   -----------
+  api       api         db          frontend
+  frontend  -           api         -
+`,
+			wantedJSON: `{"application":"phonetool","environment":"test","services":[{"name":"api","endpoints":["api"]},{"name":"frontend","endpoints":[]}],"dependencies":[{"from":"api","to":"db","endpoint":"db"},{"from":"frontend","to":"api","endpoint":"api"}]}
+`,
+			wantedDOT: `digraph "phonetool-test" {
+  "api";
+  "frontend";
+  "db" [shape=box, style=dashed];
+  "api" -> "db" [label="db"];
+  "frontend" -> "api" [label="api"];
+}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			json, err := tc.in.JSONString()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJSON, json)
+			require.Equal(t, tc.wantedHuman, tc.in.HumanString())
+			require.Equal(t, tc.wantedDOT, tc.in.DOTString())
+		})
+	}
+}
@@ -178,6 +178,15 @@ func (d *WorkerServiceDescriber) Manifest(env string) ([]byte, error) {
 	return cfn.Manifest()
 }
 
+// RenderedManifest returns the manifest used to deploy a worker service stack with its environment variables substituted.
+func (d *WorkerServiceDescriber) RenderedManifest(env string) ([]byte, error) {
+	cfn, err := d.initECSDescriber(env)
+	if err != nil {
+		return nil, err
+	}
+	return cfn.RenderedManifest()
+}
+
 // workerSvcDesc contains serialized parameters for a worker service.
 type workerSvcDesc struct {
 	Service           string                         `json:"service"`
@@ -137,14 +137,38 @@ func (d *WorkloadStackDescriber) StackResources() ([]*stack.Resource, error) {
 // Manifest returns the contents of the manifest used to deploy a workload stack.
 // If the Manifest metadata doesn't exist in the stack template, then returns ErrManifestNotFoundInTemplate.
 func (d *WorkloadStackDescriber) Manifest() ([]byte, error) {
+	metadata, err := d.manifestMetadata()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(metadata.Manifest), nil
+}
+
+// RenderedManifest returns the manifest used to deploy a workload stack with its environment variables substituted
+// by their values at the time of the deployment.
+// If the manifest had no environment variables, then returns the same content as Manifest.
+func (d *WorkloadStackDescriber) RenderedManifest() ([]byte, error) {
+	metadata, err := d.manifestMetadata()
+	if err != nil {
+		return nil, err
+	}
+	if metadata.RenderedManifest == "" {
+		return []byte(metadata.Manifest), nil
+	}
+	return []byte(metadata.RenderedManifest), nil
+}
+
+type manifestMetadata struct {
+	Manifest         string `yaml:"Manifest"`
+	RenderedManifest string `yaml:"RenderedManifest"`
+}
+
+func (d *WorkloadStackDescriber) manifestMetadata() (*manifestMetadata, error) {
 	tpl, err := d.cfn.StackMetadata()
 	if err != nil {
 		return nil, fmt.Errorf("retrieve stack metadata for %s-%s-%s: %w", d.app, d.env, d.name, err)
 	}
-
-	metadata := struct {
-		Manifest string `yaml:"Manifest"`
-	}{}
+	var metadata manifestMetadata
 	if err := yaml.Unmarshal([]byte(tpl), &metadata); err != nil {
 		return nil, fmt.Errorf("unmarshal Metadata.Manifest in stack %s-%s-%s: %v", d.app, d.env, d.name, err)
 	}
@@ -155,5 +179,5 @@ func (d *WorkloadStackDescriber) Manifest() ([]byte, error) {
 			name: d.name,
 		}
 	}
-	return []byte(metadata.Manifest), nil
+	return &metadata, nil
 }
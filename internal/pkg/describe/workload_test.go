@@ -76,6 +76,60 @@ Manifest: |
 	}
 }
 
+func TestServiceStackDescriber_RenderedManifest(t *testing.T) {
+	testCases := map[string]struct {
+		inMetadata string
+
+		wantedMft []byte
+		wantedErr error
+	}{
+		"should return content of Metadata.RenderedManifest if it exists": {
+			inMetadata: `
+Manifest: |
+  image: ${REPO}
+RenderedManifest: |
+  image: nginx`,
+			wantedMft: []byte("image: nginx"),
+		},
+		"should fall back to Metadata.Manifest if the manifest has no environment variables": {
+			inMetadata: `
+Manifest: |
+  image: nginx`,
+			wantedMft: []byte("image: nginx"),
+		},
+		"should return ErrManifestNotFoundInTemplate if Metadata.Manifest is empty": {
+			wantedErr: &ErrManifestNotFoundInTemplate{app: "phonetool", env: "test", name: "api"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cfn := mocks.NewMockstackDescriber(ctrl)
+			cfn.EXPECT().StackMetadata().Return(tc.inMetadata, nil)
+			describer := WorkloadStackDescriber{
+				app:  "phonetool",
+				env:  "test",
+				name: "api",
+				cfn:  cfn,
+			}
+
+			// WHEN
+			actualMft, actualErr := describer.RenderedManifest()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, actualErr, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, actualErr)
+				require.Equal(t, tc.wantedMft, actualMft)
+			}
+		})
+	}
+}
+
 func Test_WorkloadManifest(t *testing.T) {
 	testApp, testService := "phonetool", "api"
 
@@ -0,0 +1,612 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package dockercompose converts the services of a Docker Compose file into Copilot services.
+//
+// Only the keys that have an equivalent in a Copilot manifest are converted. Every other key is reported
+// as a warning, so that users know which parts of their Compose file they need to port by hand.
+package dockercompose
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"gopkg.in/yaml.v3"
+)
+
+// Keys of a service of a Compose file that are converted to manifest fields.
+var supportedServiceKeys = map[string]bool{
+	"build":       true,
+	"image":       true,
+	"ports":       true,
+	"expose":      true,
+	"environment": true,
+	"env_file":    true,
+	"volumes":     true,
+	"command":     true,
+	"entrypoint":  true,
+	"healthcheck": true,
+	"platform":    true,
+	"depends_on":  true,
+}
+
+var (
+	// Copilot workload names are lowercase alphanumeric words separated by hyphens.
+	invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+	// Volume names are used in the logical IDs of the CloudFormation resources, so they are alphanumeric only.
+	invalidVolumeChars = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+)
+
+// Project holds the services of a Compose file converted to Copilot services.
+type Project struct {
+	Services []*Service
+	Warnings []string // Keys of the Compose file that could not be converted.
+}
+
+// Service is a service of a Compose file converted to a Copilot service.
+// Paths are relative to the directory of the Compose file.
+type Service struct {
+	Name        string
+	Type        string // Load Balanced Web Service if the service publishes a port, Backend Service otherwise.
+	Dockerfile  string
+	Image       string
+	Port        uint16
+	Platform    string
+	HealthCheck manifest.ContainerHealthCheck
+
+	buildContext string // Only set if it isn't the directory of the Dockerfile.
+	buildArgs    map[string]string
+	buildTarget  string
+	variables    map[string]string
+	envFile      string
+	volumes      []volume
+	command      *yaml.Node
+	entrypoint   *yaml.Node
+}
+
+type volume struct {
+	name     string
+	path     string
+	readOnly bool
+	efs      bool
+}
+
+type composeFile struct {
+	Services yaml.Node            `yaml:"services"`
+	Volumes  map[string]yaml.Node `yaml:"volumes"`
+	Networks yaml.Node            `yaml:"networks"`
+	Secrets  yaml.Node            `yaml:"secrets"`
+	Configs  yaml.Node            `yaml:"configs"`
+}
+
+type composeService struct {
+	Build       yaml.Node           `yaml:"build"`
+	Image       string              `yaml:"image"`
+	Ports       []yaml.Node         `yaml:"ports"`
+	Expose      []string            `yaml:"expose"`
+	Environment yaml.Node           `yaml:"environment"`
+	EnvFile     yaml.Node           `yaml:"env_file"`
+	Volumes     []yaml.Node         `yaml:"volumes"`
+	Command     yaml.Node           `yaml:"command"`
+	Entrypoint  yaml.Node           `yaml:"entrypoint"`
+	HealthCheck *composeHealthCheck `yaml:"healthcheck"`
+	Platform    string              `yaml:"platform"`
+	DependsOn   yaml.Node           `yaml:"depends_on"`
+}
+
+type composeBuild struct {
+	Context    string         `yaml:"context"`
+	Dockerfile string         `yaml:"dockerfile"`
+	Args       yaml.Node      `yaml:"args"`
+	Target     string         `yaml:"target"`
+	Extra      map[string]any `yaml:",inline"`
+}
+
+type composePort struct {
+	Target    uint16 `yaml:"target"`
+	Published string `yaml:"published"`
+	Protocol  string `yaml:"protocol"`
+}
+
+type composeVolume struct {
+	Type     string `yaml:"type"`
+	Source   string `yaml:"source"`
+	Target   string `yaml:"target"`
+	ReadOnly bool   `yaml:"read_only"`
+}
+
+type composeHealthCheck struct {
+	Test        yaml.Node `yaml:"test"`
+	Interval    string    `yaml:"interval"`
+	Timeout     string    `yaml:"timeout"`
+	Retries     *int      `yaml:"retries"`
+	StartPeriod string    `yaml:"start_period"`
+	Disable     bool      `yaml:"disable"`
+}
+
+// Parse converts the services of the content of a Compose file.
+func Parse(content []byte) (*Project, error) {
+	var file composeFile
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("unmarshal Compose file: %w", err)
+	}
+	if file.Services.Kind != yaml.MappingNode || len(file.Services.Content) == 0 {
+		return nil, errors.New(`Compose file has no "services"`)
+	}
+	p := &Project{}
+	if !file.Networks.IsZero() {
+		p.warnf(`"networks" are not supported: services in a Copilot environment share its network and reach each other with Service Connect`)
+	}
+	if !file.Secrets.IsZero() {
+		p.warnf(`"secrets" are not supported: store them in SSM Parameter Store with "copilot secret init" and add them to the "secrets" field of the manifests`)
+	}
+	if !file.Configs.IsZero() {
+		p.warnf(`"configs" are not supported: build the files into the images or mount them from a volume`)
+	}
+	for _, name := range sortedKeys(file.Volumes) {
+		node := file.Volumes[name]
+		var def map[string]any
+		if err := node.Decode(&def); err == nil && len(def) > 0 {
+			p.warnf(`the options of volume %q are not supported: the volume is created as an EFS file system`, name)
+		}
+	}
+	names := make(map[string]string)
+	for i := 0; i+1 < len(file.Services.Content); i += 2 {
+		composeName := file.Services.Content[i].Value
+		svc, err := p.convertService(composeName, file.Services.Content[i+1])
+		if err != nil {
+			return nil, err
+		}
+		if other, ok := names[svc.Name]; ok {
+			return nil, fmt.Errorf("services %q and %q both convert to the name %q", other, composeName, svc.Name)
+		}
+		names[svc.Name] = composeName
+		p.Services = append(p.Services, svc)
+	}
+	return p, nil
+}
+
+func (p *Project) convertService(composeName string, node *yaml.Node) (*Service, error) {
+	var in composeService
+	if err := node.Decode(&in); err != nil {
+		return nil, fmt.Errorf("unmarshal service %q: %w", composeName, err)
+	}
+	svc := &Service{
+		Name:     workloadName(composeName),
+		Type:     manifestinfo.BackendServiceType,
+		Image:    in.Image,
+		Platform: in.Platform,
+	}
+	if svc.Name != composeName {
+		p.warnf("service %q is renamed to %q, as Copilot names contain only lowercase letters, digits and hyphens", composeName, svc.Name)
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if key := node.Content[i].Value; !supportedServiceKeys[key] {
+			p.warnf("service %q: %q is not supported and is ignored", composeName, key)
+		}
+	}
+	if err := p.convertBuild(svc, composeName, &in.Build); err != nil {
+		return nil, err
+	}
+	if svc.Dockerfile == "" && svc.Image == "" {
+		return nil, fmt.Errorf(`service %q must have either "build" or "image"`, composeName)
+	}
+	if svc.Dockerfile != "" && svc.Image != "" {
+		p.warnf(`service %q: "image" is ignored as the image is built from "build"`, composeName)
+		svc.Image = ""
+	}
+	if err := p.convertPorts(svc, composeName, in.Ports, in.Expose); err != nil {
+		return nil, err
+	}
+	if err := p.convertEnvironment(svc, composeName, &in.Environment, &in.EnvFile); err != nil {
+		return nil, err
+	}
+	if err := p.convertVolumes(svc, composeName, in.Volumes); err != nil {
+		return nil, err
+	}
+	if err := p.convertHealthCheck(svc, composeName, in.HealthCheck); err != nil {
+		return nil, err
+	}
+	if !in.Command.IsZero() {
+		svc.command = &in.Command
+	}
+	if !in.Entrypoint.IsZero() {
+		svc.entrypoint = &in.Entrypoint
+	}
+	if deps := dependencies(&in.DependsOn); len(deps) > 0 {
+		p.warnf(`service %q: "depends_on" is not supported, as services are deployed independently: reach %s with Service Connect at "http://<name>:<port>" instead`,
+			composeName, strings.Join(deps, ", "))
+	}
+	return svc, nil
+}
+
+func (p *Project) convertBuild(svc *Service, composeName string, node *yaml.Node) error {
+	if node.IsZero() {
+		return nil
+	}
+	build := composeBuild{Dockerfile: "Dockerfile"}
+	switch node.Kind {
+	case yaml.ScalarNode:
+		build.Context = node.Value
+	case yaml.MappingNode:
+		if err := node.Decode(&build); err != nil {
+			return fmt.Errorf(`unmarshal "build" of service %q: %w`, composeName, err)
+		}
+	default:
+		return fmt.Errorf(`"build" of service %q must be a path or a mapping`, composeName)
+	}
+	for _, key := range sortedKeys(build.Extra) {
+		p.warnf("service %q: %q of \"build\" is not supported and is ignored", composeName, key)
+	}
+	if build.Context == "" {
+		build.Context = "."
+	}
+	svc.Dockerfile = filepath.Join(build.Context, build.Dockerfile)
+	if filepath.Dir(svc.Dockerfile) != filepath.Clean(build.Context) {
+		svc.buildContext = filepath.Clean(build.Context)
+	}
+	args, err := p.keyValues(composeName, "build.args", &build.Args)
+	if err != nil {
+		return err
+	}
+	svc.buildArgs = args
+	svc.buildTarget = build.Target
+	return nil
+}
+
+func (p *Project) convertPorts(svc *Service, composeName string, ports []yaml.Node, expose []string) error {
+	var published, unpublished []uint16
+	for i := range ports {
+		port, err := parsePort(&ports[i])
+		if err != nil {
+			return fmt.Errorf("parse port of service %q: %w", composeName, err)
+		}
+		if port.Protocol != "" && port.Protocol != "tcp" {
+			p.warnf("service %q: %s port %d is not supported and is ignored", composeName, port.Protocol, port.Target)
+			continue
+		}
+		if port.Published != "" {
+			published = append(published, port.Target)
+		} else {
+			unpublished = append(unpublished, port.Target)
+		}
+	}
+	for _, e := range expose {
+		port, err := strconv.ParseUint(strings.TrimSuffix(e, "/tcp"), 10, 16)
+		if err != nil {
+			p.warnf("service %q: exposed port %q is not supported and is ignored", composeName, e)
+			continue
+		}
+		unpublished = append(unpublished, uint16(port))
+	}
+	switch {
+	case len(published) > 0:
+		svc.Type = manifestinfo.LoadBalancedWebServiceType
+		svc.Port = published[0]
+	case len(unpublished) > 0:
+		svc.Port = unpublished[0]
+	default:
+		return nil
+	}
+	if extra := len(published) + len(unpublished) - 1; extra > 0 {
+		p.warnf("service %q: only port %d is exposed, add the other ports to the manifest by hand", composeName, svc.Port)
+	}
+	return nil
+}
+
+// parsePort parses the short syntax "[host_ip:][published:]target[/protocol]" and the long syntax of a port.
+func parsePort(node *yaml.Node) (composePort, error) {
+	if node.Kind == yaml.MappingNode {
+		var port composePort
+		if err := node.Decode(&port); err != nil {
+			return composePort{}, err
+		}
+		return port, nil
+	}
+	port := composePort{}
+	spec := node.Value
+	if idx := strings.LastIndex(spec, "/"); idx != -1 {
+		spec, port.Protocol = spec[:idx], spec[idx+1:]
+	}
+	parts := strings.Split(spec, ":")
+	target := parts[len(parts)-1]
+	if len(parts) > 1 {
+		port.Published = parts[len(parts)-2]
+	}
+	if strings.Contains(target, "-") {
+		return composePort{}, fmt.Errorf("port range %q is not supported", node.Value)
+	}
+	n, err := strconv.ParseUint(target, 10, 16)
+	if err != nil {
+		return composePort{}, fmt.Errorf("invalid port %q", node.Value)
+	}
+	port.Target = uint16(n)
+	return port, nil
+}
+
+func (p *Project) convertEnvironment(svc *Service, composeName string, env, envFile *yaml.Node) error {
+	vars, err := p.keyValues(composeName, "environment", env)
+	if err != nil {
+		return err
+	}
+	svc.variables = vars
+	var files []string
+	switch envFile.Kind {
+	case 0:
+	case yaml.ScalarNode:
+		files = []string{envFile.Value}
+	default:
+		if err := envFile.Decode(&files); err != nil {
+			return fmt.Errorf(`unmarshal "env_file" of service %q: %w`, composeName, err)
+		}
+	}
+	if len(files) > 0 {
+		svc.envFile = filepath.Clean(files[0])
+	}
+	if len(files) > 1 {
+		p.warnf(`service %q: only the first "env_file" %s is used, as a Copilot service has a single one`, composeName, files[0])
+	}
+	return nil
+}
+
+// keyValues converts a mapping or a list of "KEY=VALUE" strings.
+// Variables without a value, which Compose reads from the shell, can't be converted.
+func (p *Project) keyValues(composeName, field string, node *yaml.Node) (map[string]string, error) {
+	values := make(map[string]string)
+	switch node.Kind {
+	case 0:
+		return nil, nil
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i].Value, node.Content[i+1]
+			if value.Tag == "!!null" {
+				p.warnf(`service %q: %s %q has no value and is ignored, set it in the manifest`, composeName, field, key)
+				continue
+			}
+			values[key] = value.Value
+		}
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			key, value, ok := strings.Cut(item.Value, "=")
+			if !ok {
+				p.warnf(`service %q: %s %q has no value and is ignored, set it in the manifest`, composeName, field, key)
+				continue
+			}
+			values[key] = value
+		}
+	default:
+		return nil, fmt.Errorf(`"%s" of service %q must be a mapping or a list`, field, composeName)
+	}
+	return values, nil
+}
+
+func (p *Project) convertVolumes(svc *Service, composeName string, volumes []yaml.Node) error {
+	for i := range volumes {
+		var vol composeVolume
+		if volumes[i].Kind == yaml.MappingNode {
+			if err := volumes[i].Decode(&vol); err != nil {
+				return fmt.Errorf("unmarshal volume of service %q: %w", composeName, err)
+			}
+		} else {
+			vol = parseVolume(volumes[i].Value)
+		}
+		switch vol.Type {
+		case "volume":
+			name := invalidVolumeChars.ReplaceAllString(vol.Source, "")
+			if name == "" {
+				name = fmt.Sprintf("volume%d", i+1)
+			}
+			svc.volumes = append(svc.volumes, volume{
+				name:     name,
+				path:     vol.Target,
+				readOnly: vol.ReadOnly,
+				efs:      vol.Source != "",
+			})
+		default:
+			p.warnf("service %q: %s mount %s is not supported and is ignored, build the files into the image instead", composeName, vol.Type, vol.Target)
+		}
+	}
+	return nil
+}
+
+// parseVolume parses the short syntax "[source:]target[:mode]" of a volume.
+func parseVolume(spec string) composeVolume {
+	parts := strings.Split(spec, ":")
+	vol := composeVolume{Type: "volume"}
+	switch len(parts) {
+	case 1:
+		vol.Target = parts[0]
+	default:
+		vol.Source, vol.Target = parts[0], parts[1]
+		if len(parts) > 2 {
+			for _, mode := range strings.Split(parts[2], ",") {
+				vol.ReadOnly = vol.ReadOnly || mode == "ro"
+			}
+		}
+	}
+	if strings.HasPrefix(vol.Source, ".") || strings.HasPrefix(vol.Source, "/") || strings.HasPrefix(vol.Source, "~") {
+		vol.Type = "bind"
+	}
+	return vol
+}
+
+func (p *Project) convertHealthCheck(svc *Service, composeName string, in *composeHealthCheck) error {
+	if in == nil || in.Disable {
+		return nil
+	}
+	switch in.Test.Kind {
+	case yaml.ScalarNode:
+		if in.Test.Value == "NONE" {
+			return nil
+		}
+		svc.HealthCheck.Command = []string{"CMD-SHELL", in.Test.Value}
+	case yaml.SequenceNode:
+		if err := in.Test.Decode(&svc.HealthCheck.Command); err != nil {
+			return fmt.Errorf(`unmarshal "healthcheck.test" of service %q: %w`, composeName, err)
+		}
+		if len(svc.HealthCheck.Command) > 0 && svc.HealthCheck.Command[0] == "NONE" {
+			svc.HealthCheck.Command = nil
+			return nil
+		}
+	}
+	// Default to the values of Docker, as the manifest templates render every field of the health check.
+	interval, timeout, startPeriod, retries := 30*time.Second, 30*time.Second, time.Duration(0), 3
+	svc.HealthCheck.Interval, svc.HealthCheck.Timeout, svc.HealthCheck.StartPeriod = &interval, &timeout, &startPeriod
+	svc.HealthCheck.Retries = &retries
+	if in.Retries != nil {
+		svc.HealthCheck.Retries = in.Retries
+	}
+	for _, d := range []struct {
+		field string
+		in    string
+		out   **time.Duration
+	}{
+		{"interval", in.Interval, &svc.HealthCheck.Interval},
+		{"timeout", in.Timeout, &svc.HealthCheck.Timeout},
+		{"start_period", in.StartPeriod, &svc.HealthCheck.StartPeriod},
+	} {
+		if d.in == "" {
+			continue
+		}
+		duration, err := time.ParseDuration(d.in)
+		if err != nil {
+			return fmt.Errorf(`parse "healthcheck.%s" of service %q: %w`, d.field, composeName, err)
+		}
+		*d.out = &duration
+	}
+	return nil
+}
+
+// dependencies returns the names of the services in "depends_on", which is either a list or a mapping.
+func dependencies(node *yaml.Node) []string {
+	var deps []string
+	switch node.Kind {
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			deps = append(deps, item.Value)
+		}
+	case yaml.MappingNode:
+		for i := 0; i < len(node.Content); i += 2 {
+			deps = append(deps, node.Content[i].Value)
+		}
+	}
+	return deps
+}
+
+// ManifestOverrides returns the fields of the manifest of the service that can't be set when the manifest is
+// generated, as a YAML document. rel converts a path relative to the Compose file into a path of the manifest.
+func (s *Service) ManifestOverrides(rel func(path string) (string, error)) ([]byte, error) {
+	doc := &yaml.Node{Kind: yaml.MappingNode}
+	if s.buildContext != "" || len(s.buildArgs) > 0 || s.buildTarget != "" {
+		dockerfile, err := rel(s.Dockerfile)
+		if err != nil {
+			return nil, err
+		}
+		build := &yaml.Node{Kind: yaml.MappingNode}
+		addScalar(build, "dockerfile", dockerfile)
+		if s.buildContext != "" {
+			context, err := rel(s.buildContext)
+			if err != nil {
+				return nil, err
+			}
+			addScalar(build, "context", context)
+		}
+		if len(s.buildArgs) > 0 {
+			addMapping(build, "args", s.buildArgs)
+		}
+		if s.buildTarget != "" {
+			addScalar(build, "target", s.buildTarget)
+		}
+		image := &yaml.Node{Kind: yaml.MappingNode}
+		addNode(image, "build", build)
+		addNode(doc, "image", image)
+	}
+	if s.command != nil {
+		addNode(doc, "command", s.command)
+	}
+	if s.entrypoint != nil {
+		addNode(doc, "entrypoint", s.entrypoint)
+	}
+	if len(s.variables) > 0 {
+		addMapping(doc, "variables", s.variables)
+	}
+	if s.envFile != "" {
+		envFile, err := rel(s.envFile)
+		if err != nil {
+			return nil, err
+		}
+		addScalar(doc, "env_file", envFile)
+	}
+	if len(s.volumes) > 0 {
+		volumes := &yaml.Node{Kind: yaml.MappingNode}
+		for _, v := range s.volumes {
+			vol := &yaml.Node{Kind: yaml.MappingNode}
+			addScalar(vol, "path", v.path)
+			addScalar(vol, "read_only", strconv.FormatBool(v.readOnly))
+			if v.efs {
+				addScalar(vol, "efs", "true")
+			}
+			addNode(volumes, v.name, vol)
+		}
+		storage := &yaml.Node{Kind: yaml.MappingNode}
+		addNode(storage, "volumes", volumes)
+		addNode(doc, "storage", storage)
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	var out bytes.Buffer
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("marshal manifest fields of service %s: %w", s.Name, err)
+	}
+	return out.Bytes(), nil
+}
+
+func (p *Project) warnf(format string, args ...any) {
+	p.Warnings = append(p.Warnings, fmt.Sprintf(format, args...))
+}
+
+func workloadName(composeName string) string {
+	name := strings.ToLower(strings.ReplaceAll(composeName, "_", "-"))
+	name = invalidNameChars.ReplaceAllString(name, "-")
+	return strings.Trim(name, "-")
+}
+
+func addNode(mapping *yaml.Node, key string, value *yaml.Node) {
+	mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, value)
+}
+
+func addScalar(mapping *yaml.Node, key, value string) {
+	addNode(mapping, key, &yaml.Node{Kind: yaml.ScalarNode, Value: value})
+}
+
+func addMapping(mapping *yaml.Node, key string, values map[string]string) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	for _, k := range sortedKeys(values) {
+		// Quote the values so that YAML doesn't reinterpret them, for example "true" or "8080".
+		node.Content = append(node.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: k},
+			&yaml.Node{Kind: yaml.ScalarNode, Value: values[k], Style: yaml.DoubleQuotedStyle})
+	}
+	addNode(mapping, key, node)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,229 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package dockercompose
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	interval, timeout, startPeriod := 30*time.Second, 5*time.Second, time.Duration(0)
+	retries := 3
+	testCases := map[string]struct {
+		in string
+
+		wantedServices []*Service
+		wantedWarnings []string
+		wantedErr      string
+	}{
+		"requires services": {
+			in:        `version: "3.8"`,
+			wantedErr: `Compose file has no "services"`,
+		},
+		"requires an image or a build": {
+			in: `
+services:
+  web:
+    ports: ["80:80"]`,
+			wantedErr: `service "web" must have either "build" or "image"`,
+		},
+		"rejects port ranges": {
+			in: `
+services:
+  web:
+    image: nginx
+    ports: ["8000-8002:8000-8002"]`,
+			wantedErr: `parse port of service "web": port range "8000-8002:8000-8002" is not supported`,
+		},
+		"rejects services with the same name once converted": {
+			in: `
+services:
+  my_api:
+    image: api
+  my-api:
+    image: api`,
+			wantedErr: `services "my_api" and "my-api" both convert to the name "my-api"`,
+		},
+		"converts services": {
+			in: `
+services:
+  web:
+    build: ./web
+    ports:
+      - "8080:80"
+      - "443:443"
+    environment:
+      LOG_LEVEL: debug
+      PORT: 80
+      SHELL_VAR:
+    depends_on: [api, cache]
+    restart: always
+  api:
+    build:
+      context: .
+      dockerfile: api/Dockerfile
+      target: prod
+      args:
+        - GO_VERSION=1.20
+    expose: [3000]
+    env_file: [api.env, local.env]
+    volumes:
+      - uploads:/srv/uploads:ro
+      - ./src:/srv/src
+    healthcheck:
+      test: curl -f http://localhost:3000/ping
+      interval: 30s
+      timeout: 5s
+      retries: 3
+    platform: linux/arm64
+  cache:
+    image: redis:7
+networks:
+  backend: {}
+volumes:
+  uploads:
+    driver: local`,
+			wantedServices: []*Service{
+				{
+					Name:       "web",
+					Type:       manifestinfo.LoadBalancedWebServiceType,
+					Dockerfile: filepath.Join("web", "Dockerfile"),
+					Port:       80,
+					variables:  map[string]string{"LOG_LEVEL": "debug", "PORT": "80"},
+				},
+				{
+					Name:       "api",
+					Type:       manifestinfo.BackendServiceType,
+					Dockerfile: filepath.Join("api", "Dockerfile"),
+					Port:       3000,
+					Platform:   "linux/arm64",
+					HealthCheck: manifest.ContainerHealthCheck{
+						Command:     []string{"CMD-SHELL", "curl -f http://localhost:3000/ping"},
+						Interval:    &interval,
+						Timeout:     &timeout,
+						StartPeriod: &startPeriod,
+						Retries:     &retries,
+					},
+					buildContext: ".",
+					buildArgs:    map[string]string{"GO_VERSION": "1.20"},
+					buildTarget:  "prod",
+					envFile:      "api.env",
+					volumes: []volume{
+						{name: "uploads", path: "/srv/uploads", readOnly: true, efs: true},
+					},
+				},
+				{
+					Name:  "cache",
+					Type:  manifestinfo.BackendServiceType,
+					Image: "redis:7",
+				},
+			},
+			wantedWarnings: []string{
+				`"networks" are not supported: services in a Copilot environment share its network and reach each other with Service Connect`,
+				`the options of volume "uploads" are not supported: the volume is created as an EFS file system`,
+				`service "web": "restart" is not supported and is ignored`,
+				`service "web": only port 80 is exposed, add the other ports to the manifest by hand`,
+				`service "web": environment "SHELL_VAR" has no value and is ignored, set it in the manifest`,
+				`service "web": "depends_on" is not supported, as services are deployed independently: reach api, cache with Service Connect at "http://<name>:<port>" instead`,
+				`service "api": only the first "env_file" api.env is used, as a Copilot service has a single one`,
+				`service "api": bind mount /srv/src is not supported and is ignored, build the files into the image instead`,
+			},
+		},
+		"renames services": {
+			in: `
+services:
+  My_Worker:
+    image: worker`,
+			wantedServices: []*Service{
+				{
+					Name:  "my-worker",
+					Type:  manifestinfo.BackendServiceType,
+					Image: "worker",
+				},
+			},
+			wantedWarnings: []string{
+				`service "My_Worker" is renamed to "my-worker", as Copilot names contain only lowercase letters, digits and hyphens`,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := Parse([]byte(tc.in))
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedWarnings, got.Warnings)
+			require.Len(t, got.Services, len(tc.wantedServices))
+			for i, wanted := range tc.wantedServices {
+				got.Services[i].command, got.Services[i].entrypoint = nil, nil
+				require.Equal(t, wanted, got.Services[i])
+			}
+		})
+	}
+}
+
+func TestService_ManifestOverrides(t *testing.T) {
+	project, err := Parse([]byte(`
+services:
+  api:
+    build:
+      context: .
+      dockerfile: api/Dockerfile
+      args:
+        GO_VERSION: "1.20"
+    command: ["./api", "--verbose"]
+    environment:
+      - ENABLED=true
+    env_file: api.env
+    volumes:
+      - uploads:/srv/uploads
+      - /tmp/cache
+  cache:
+    image: redis:7
+`))
+	require.NoError(t, err)
+	rel := func(path string) (string, error) {
+		return filepath.Join("backend", path), nil
+	}
+
+	// WHEN
+	api, err := project.Services[0].ManifestOverrides(rel)
+	require.NoError(t, err)
+	cache, err := project.Services[1].ManifestOverrides(rel)
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, `image:
+  build:
+    dockerfile: backend/api/Dockerfile
+    context: backend
+    args:
+      GO_VERSION: "1.20"
+command: ["./api", "--verbose"]
+variables:
+  ENABLED: "true"
+env_file: backend/api.env
+storage:
+  volumes:
+    uploads:
+      path: /srv/uploads
+      read_only: false
+      efs: true
+    volume2:
+      path: /tmp/cache
+      read_only: false
+`, string(api))
+	require.Nil(t, cache)
+}
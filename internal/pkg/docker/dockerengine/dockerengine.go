@@ -36,17 +36,35 @@ const (
 	ArchARM64 = "arm64"
 )
 
+// Command line tools that can build and push container images.
+const (
+	BuilderDocker  = "docker"
+	BuilderPodman  = "podman"
+	BuilderNerdctl = "nerdctl"
+	// BuilderBuildx builds images with the current builder instance of `docker buildx`, which can be
+	// a remote BuildKit daemon. Images are pushed by the builder, so no local Docker daemon is required.
+	BuilderBuildx = "buildx"
+)
+
+// Builders are the image builders supported by the client.
+var Builders = []string{BuilderDocker, BuilderPodman, BuilderNerdctl, BuilderBuildx}
+
+// detectableBuilders are the image builders, in order of preference, that are looked for when no builder is specified.
+var detectableBuilders = []string{BuilderDocker, BuilderPodman, BuilderNerdctl}
+
 const (
 	credStoreECRLogin = "ecr-login" // set on `credStore` attribute in docker configuration file
 )
 
 // DockerCmdClient represents the docker client to interact with the server via external commands.
 type DockerCmdClient struct {
-	runner Cmd
+	runner  Cmd
+	builder string // Defaults to "docker" if empty.
 	// Override in unit tests.
 	buf       *bytes.Buffer
 	homePath  string
 	lookupEnv func(string) (string, bool)
+	lookPath  func(string) (string, error)
 }
 
 // New returns CmdClient to make requests against the Docker daemon via external commands.
@@ -55,7 +73,55 @@ func New(cmd Cmd) DockerCmdClient {
 		runner:    cmd,
 		homePath:  userHomeDirectory(),
 		lookupEnv: os.LookupEnv,
+		lookPath:  osexec.LookPath,
+	}
+}
+
+// NewWithBuilder returns CmdClient that builds and pushes images with the builder, one of Builders.
+// If builder is empty, then the first of docker, podman and nerdctl that is installed is used.
+func NewWithBuilder(cmd Cmd, builder string) (DockerCmdClient, error) {
+	c := New(cmd)
+	if builder == "" {
+		builder = c.detectBuilder()
+	}
+	if !contains(builder, Builders) {
+		return DockerCmdClient{}, fmt.Errorf("invalid image builder %q: must be one of %s", builder, strings.Join(Builders, ", "))
+	}
+	c.builder = builder
+	return c, nil
+}
+
+// detectBuilder returns the first installed builder with a local engine, or "docker" if none is found.
+func (c DockerCmdClient) detectBuilder() string {
+	for _, builder := range detectableBuilders {
+		if _, err := c.lookPathFn()(builder); err == nil {
+			return builder
+		}
+	}
+	return BuilderDocker
+}
+
+// Builder returns the name of the image builder used by the client.
+func (c DockerCmdClient) Builder() string {
+	if c.builder == "" {
+		return BuilderDocker
 	}
+	return c.builder
+}
+
+// Binary returns the command that the client runs to build and push images.
+func (c DockerCmdClient) Binary() string {
+	if c.Builder() == BuilderBuildx {
+		return "docker"
+	}
+	return c.Builder()
+}
+
+func (c DockerCmdClient) lookPathFn() func(string) (string, error) {
+	if c.lookPath == nil {
+		return osexec.LookPath
+	}
+	return c.lookPath
 }
 
 // BuildArguments holds the arguments that can be passed while building a container.
@@ -66,7 +132,7 @@ type BuildArguments struct {
 	Context    string            // Optional. Build context directory to pass to `docker build`.
 	Target     string            // Optional. The target build stage to pass to `docker build`.
 	CacheFrom  []string          // Optional. Images to consider as cache sources to pass to `docker build`
-	Platform   string            // Optional. OS/Arch to pass to `docker build`.
+	Platform   string            // Optional. OS/Arch to pass to `docker build`, or a comma-separated list of them to build a multi-platform image.
 	Args       map[string]string // Optional. Build args to pass via `--build-arg` flags. Equivalent to ARG directives in dockerfile.
 	Labels     map[string]string // Required. Set metadata for an image.
 }
@@ -80,6 +146,10 @@ func (in *BuildArguments) GenerateDockerBuildArgs(c DockerCmdClient) ([]string,
 			uri: in.URI,
 		}
 	}
+	// Only buildx pushes a manifest list of the images for every platform.
+	if strings.Contains(in.Platform, ",") && c.Builder() != BuilderBuildx {
+		return nil, fmt.Errorf("image builder %s can't build an image for multiple platforms %s, use %s instead", c.Builder(), in.Platform, BuilderBuildx)
+	}
 	dfDir := in.Context
 	// Context wasn't specified use the Dockerfile's directory as context.
 	if dfDir == "" {
@@ -87,6 +157,10 @@ func (in *BuildArguments) GenerateDockerBuildArgs(c DockerCmdClient) ([]string,
 	}
 
 	args := []string{"build"}
+	if c.Builder() == BuilderBuildx {
+		// The image is pushed by the builder, since a remote builder can't load the image into a local engine.
+		args = []string{"buildx", "build", "--push"}
+	}
 
 	// Add additional image tags to the docker build call.
 	for _, tag := range in.Tags {
@@ -144,13 +218,13 @@ type dockerConfig struct {
 	CredHelpers map[string]string `json:"credHelpers,omitempty"`
 }
 
-// Build will run a `docker build` command for the given ecr repo URI and build arguments.
+// Build will run a `docker build` command, or the build command of the builder, for the given ecr repo URI and build arguments.
 func (c DockerCmdClient) Build(ctx context.Context, in *BuildArguments, w io.Writer) error {
 	args, err := in.GenerateDockerBuildArgs(c)
 	if err != nil {
 		return fmt.Errorf("generate docker build args: %w", err)
 	}
-	if err := c.runner.RunWithContext(ctx, "docker", args, exec.Stdout(w), exec.Stderr(w)); err != nil {
+	if err := c.runner.RunWithContext(ctx, c.Binary(), args, exec.Stdout(w), exec.Stderr(w)); err != nil {
 		return fmt.Errorf("building image: %w", err)
 	}
 	return nil
@@ -158,7 +232,7 @@ func (c DockerCmdClient) Build(ctx context.Context, in *BuildArguments, w io.Wri
 
 // Login will run a `docker login` command against the Service repository URI with the input uri and auth data.
 func (c DockerCmdClient) Login(uri, username, password string) error {
-	err := c.runner.Run("docker",
+	err := c.runner.Run(c.Binary(),
 		[]string{"login", "-u", username, "--password-stdin", uri},
 		exec.Stdin(strings.NewReader(password)))
 
@@ -170,7 +244,11 @@ func (c DockerCmdClient) Login(uri, username, password string) error {
 }
 
 // Push pushes the images with the specified tags and ecr repository URI, and returns the image digest on success.
+// Images built with buildx are already pushed by the builder, so only their digest is retrieved.
 func (c DockerCmdClient) Push(ctx context.Context, uri string, w io.Writer, tags ...string) (digest string, err error) {
+	if c.Builder() == BuilderBuildx {
+		return c.remoteDigest(ctx, uri, tags[0])
+	}
 	images := []string{}
 	for _, tag := range tags {
 		images = append(images, imageName(uri, tag))
@@ -181,8 +259,8 @@ func (c DockerCmdClient) Push(ctx context.Context, uri string, w io.Writer, tags
 	}
 
 	for _, img := range images {
-		if err := c.runner.RunWithContext(ctx, "docker", append([]string{"push", img}, args...), exec.Stdout(w), exec.Stderr(w)); err != nil {
-			return "", fmt.Errorf("docker push %s: %w", img, err)
+		if err := c.runner.RunWithContext(ctx, c.Binary(), append([]string{"push", img}, args...), exec.Stdout(w), exec.Stderr(w)); err != nil {
+			return "", fmt.Errorf("%s push %s: %w", c.Binary(), img, err)
 		}
 	}
 	buf := new(strings.Builder)
@@ -190,7 +268,7 @@ func (c DockerCmdClient) Push(ctx context.Context, uri string, w io.Writer, tags
 	// Pick the first tag and get the image's digest.
 	// For Main container we call  docker inspect --format '{{json (index .RepoDigests 0)}}' uri:latest
 	// For Sidecar container images we call docker inspect --format '{{json (index .RepoDigests 0)}}' uri:<sidecarname>-latest
-	if err := c.runner.RunWithContext(ctx, "docker", []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", imageName(uri, tags[0])}, exec.Stdout(buf)); err != nil {
+	if err := c.runner.RunWithContext(ctx, c.Binary(), []string{"inspect", "--format", "'{{json (index .RepoDigests 0)}}'", imageName(uri, tags[0])}, exec.Stdout(buf)); err != nil {
 		return "", fmt.Errorf("inspect image digest for %s: %w", uri, err)
 	}
 	repoDigest := strings.Trim(strings.TrimSpace(buf.String()), `"'`) // remove new lines and quotes from output
@@ -201,11 +279,63 @@ func (c DockerCmdClient) Push(ctx context.Context, uri string, w io.Writer, tags
 	return parts[1], nil
 }
 
+// remoteDigest returns the digest of the image pushed to the repository by the buildx builder.
+func (c DockerCmdClient) remoteDigest(ctx context.Context, uri, tag string) (string, error) {
+	return c.ImageDigest(ctx, imageName(uri, tag))
+}
+
+// ImageDigest returns the digest of the image in its registry, without pulling it.
+// For a multi-platform image, it's the digest of the manifest list.
+func (c DockerCmdClient) ImageDigest(ctx context.Context, image string) (string, error) {
+	buf := new(strings.Builder)
+	if err := c.runner.RunWithContext(ctx, "docker", []string{"buildx", "imagetools", "inspect", image, "--format", "{{json .Manifest.Digest}}"}, exec.Stdout(buf)); err != nil {
+		return "", fmt.Errorf("inspect image digest for %s: %w", image, err)
+	}
+	digest := strings.Trim(strings.TrimSpace(buf.String()), `"'`)
+	if !strings.HasPrefix(digest, "sha256:") {
+		return "", fmt.Errorf("parse the digest from the image manifest '%s'", digest)
+	}
+	return digest, nil
+}
+
+// CopyImage copies the image manifest referenced by src to the dst repository under the given tags
+// without pulling the layers to the local engine.
+func (c DockerCmdClient) CopyImage(ctx context.Context, src, dst string, tags ...string) error {
+	if len(tags) == 0 {
+		tags = []string{"latest"}
+	}
+	args := []string{"buildx", "imagetools", "create"}
+	for _, tag := range tags {
+		args = append(args, "--tag", imageName(dst, tag))
+	}
+	args = append(args, src)
+	if err := c.runner.RunWithContext(ctx, "docker", args, exec.Stdout(io.Discard)); err != nil {
+		return fmt.Errorf("copy image %s to %s: %w", src, dst, err)
+	}
+	return nil
+}
+
 // CheckDockerEngineRunning will run `docker info` command to check if the docker engine is running.
+// For other builders, it checks that the engine or the buildx builder instance is reachable.
 func (c DockerCmdClient) CheckDockerEngineRunning() error {
-	if _, err := osexec.LookPath("docker"); err != nil {
+	if _, err := c.lookPathFn()(c.Binary()); err != nil {
+		if c.Binary() != BuilderDocker {
+			return &ErrBuilderCommandNotFound{command: c.Binary()}
+		}
 		return ErrDockerCommandNotFound
 	}
+	switch c.Builder() {
+	case BuilderBuildx:
+		if err := c.runner.Run("docker", []string{"buildx", "inspect", "--bootstrap"}, exec.Stdout(io.Discard)); err != nil {
+			return fmt.Errorf("inspect buildx builder: %w", err)
+		}
+		return nil
+	case BuilderPodman, BuilderNerdctl:
+		if err := c.runner.Run(c.Binary(), []string{"info"}, exec.Stdout(io.Discard)); err != nil {
+			return fmt.Errorf("get %s info: %w", c.Binary(), err)
+		}
+		return nil
+	}
 	buf := &bytes.Buffer{}
 	err := c.runner.Run("docker", []string{"info", "-f", "'{{json .}}'"}, exec.Stdout(buf))
 	if err != nil {
@@ -231,7 +361,7 @@ func (c DockerCmdClient) CheckDockerEngineRunning() error {
 
 // GetPlatform will run the `docker version` command to get the OS/Arch.
 func (c DockerCmdClient) GetPlatform() (os, arch string, err error) {
-	if _, err := osexec.LookPath("docker"); err != nil {
+	if _, err := c.lookPathFn()("docker"); err != nil {
 		return "", "", ErrDockerCommandNotFound
 	}
 	buf := &bytes.Buffer{}
@@ -253,6 +383,87 @@ func (c DockerCmdClient) GetPlatform() (os, arch string, err error) {
 	return platform.OS, platform.Arch, nil
 }
 
+// RunOptions holds the options for running a container.
+type RunOptions struct {
+	ImageURI         string            // Required. The image to run.
+	ContainerName    string            // Required. The name of the container.
+	Detach           bool              // Optional. Run the container in the background instead of streaming its output.
+	EnvVars          map[string]string // Optional. Environment variables passed to the container.
+	Secrets          map[string]string // Optional. Secrets passed to the container, whose values are kept out of the command line.
+	ContainerPorts   map[string]string // Optional. Host ports mapped to container ports.
+	ContainerNetwork string            // Optional. Name of a container whose network namespace the container joins.
+	AddHosts         map[string]string // Optional. Extra entries in the container's /etc/hosts, from host name to IP address.
+	EntryPoint       []string          // Optional. Overrides the image's entrypoint.
+	Command          []string          // Optional. Overrides the image's command.
+}
+
+// generateRunArgs returns the command line arguments for `docker run` based on the run options.
+func (in *RunOptions) generateRunArgs() []string {
+	args := []string{"run", "--rm", "--name", in.ContainerName}
+	if in.Detach {
+		args = append(args, "--detach")
+	}
+	for _, k := range sortedKeys(in.EnvVars) {
+		args = append(args, "--env", fmt.Sprintf("%s=%s", k, in.EnvVars[k]))
+	}
+	// Only pass the names of secrets, the values are read from the environment of the docker process.
+	for _, k := range sortedKeys(in.Secrets) {
+		args = append(args, "--env", k)
+	}
+	for _, hostPort := range sortedKeys(in.ContainerPorts) {
+		args = append(args, "--publish", fmt.Sprintf("%s:%s", hostPort, in.ContainerPorts[hostPort]))
+	}
+	if in.ContainerNetwork != "" {
+		args = append(args, "--network", fmt.Sprintf("container:%s", in.ContainerNetwork))
+	}
+	for _, host := range sortedKeys(in.AddHosts) {
+		args = append(args, "--add-host", fmt.Sprintf("%s:%s", host, in.AddHosts[host]))
+	}
+	cmd := in.Command
+	if len(in.EntryPoint) > 0 {
+		// The --entrypoint flag only accepts an executable, the rest of the entrypoint goes before the command.
+		args = append(args, "--entrypoint", in.EntryPoint[0])
+		cmd = append(append([]string{}, in.EntryPoint[1:]...), in.Command...)
+	}
+	args = append(args, in.ImageURI)
+	return append(args, cmd...)
+}
+
+// Run runs a container with the given options.
+// Unless the container is detached, it blocks until the container exits and streams its output to w.
+func (c DockerCmdClient) Run(ctx context.Context, in *RunOptions, w io.Writer) error {
+	opts := []exec.CmdOption{exec.Stdout(w), exec.Stderr(w)}
+	if len(in.Secrets) > 0 {
+		env := os.Environ()
+		for _, k := range sortedKeys(in.Secrets) {
+			env = append(env, fmt.Sprintf("%s=%s", k, in.Secrets[k]))
+		}
+		opts = append(opts, exec.Env(env))
+	}
+	if err := c.runner.RunWithContext(ctx, c.Binary(), in.generateRunArgs(), opts...); err != nil {
+		return fmt.Errorf("run container %s: %w", in.ContainerName, err)
+	}
+	return nil
+}
+
+// Stop stops and removes the container if it exists.
+func (c DockerCmdClient) Stop(ctx context.Context, containerName string) error {
+	buf := &bytes.Buffer{}
+	if err := c.runner.RunWithContext(ctx, c.Binary(), []string{"rm", "--force", containerName}, exec.Stdout(io.Discard), exec.Stderr(buf)); err != nil {
+		return fmt.Errorf("remove container %s: %s: %w", containerName, strings.TrimSpace(buf.String()), err)
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func imageName(uri, tag string) string {
 	return fmt.Sprintf("%s:%s", uri, tag)
 }
@@ -311,6 +522,15 @@ func parseCredFromDockerConfig(config []byte) (*dockerConfig, error) {
 	return &cred, nil
 }
 
+func contains(s string, items []string) bool {
+	for _, item := range items {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
+
 func userHomeDirectory() string {
 	home, err := os.UserHomeDir()
 	if err != nil {
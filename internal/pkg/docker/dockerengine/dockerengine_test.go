@@ -42,8 +42,10 @@ func TestDockerCommand_Build(t *testing.T) {
 		args       map[string]string
 		target     string
 		cacheFrom  []string
+		platform   string
 		envVars    map[string]string
 		labels     map[string]string
+		builder    string
 		setupMocks func(controller *gomock.Controller)
 
 		wantedError error
@@ -193,6 +195,54 @@ func TestDockerCommand_Build(t *testing.T) {
 					"-f", "mockPath/to/mockDockerfile"}, gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
+		"runs podman build with podman builder": {
+			path:    mockPath,
+			tags:    []string{mockTag1},
+			builder: BuilderPodman,
+			setupMocks: func(c *gomock.Controller) {
+				mockCmd = NewMockCmd(c)
+				mockCmd.EXPECT().RunWithContext(ctx, "podman", []string{"build",
+					"-t", mockURI + ":" + mockTag1,
+					filepath.FromSlash("mockPath/to"),
+					"-f", "mockPath/to/mockDockerfile"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"builds and pushes with buildx builder": {
+			path:    mockPath,
+			tags:    []string{mockTag1},
+			builder: BuilderBuildx,
+			setupMocks: func(c *gomock.Controller) {
+				mockCmd = NewMockCmd(c)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "build", "--push",
+					"-t", mockURI + ":" + mockTag1,
+					filepath.FromSlash("mockPath/to"),
+					"-f", "mockPath/to/mockDockerfile"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"builds a multi-platform image with buildx builder": {
+			path:     mockPath,
+			tags:     []string{mockTag1},
+			platform: "linux/amd64,linux/arm64",
+			builder:  BuilderBuildx,
+			setupMocks: func(c *gomock.Controller) {
+				mockCmd = NewMockCmd(c)
+				mockCmd.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "build", "--push",
+					"-t", mockURI + ":" + mockTag1,
+					"--platform", "linux/amd64,linux/arm64",
+					filepath.FromSlash("mockPath/to"),
+					"-f", "mockPath/to/mockDockerfile"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"should error if a builder other than buildx builds for multiple platforms": {
+			path:     mockPath,
+			tags:     []string{mockTag1},
+			platform: "linux/amd64,linux/arm64",
+			builder:  BuilderDocker,
+			setupMocks: func(c *gomock.Controller) {
+				mockCmd = NewMockCmd(c)
+			},
+			wantedError: errors.New("generate docker build args: image builder docker can't build an image for multiple platforms linux/amd64,linux/arm64, use buildx instead"),
+		},
 	}
 
 	for name, tc := range tests {
@@ -200,7 +250,8 @@ func TestDockerCommand_Build(t *testing.T) {
 			controller := gomock.NewController(t)
 			tc.setupMocks(controller)
 			s := DockerCmdClient{
-				runner: mockCmd,
+				runner:  mockCmd,
+				builder: tc.builder,
 				lookupEnv: func(key string) (string, bool) {
 					if val, ok := tc.envVars[key]; ok {
 						return val, true
@@ -215,6 +266,7 @@ func TestDockerCommand_Build(t *testing.T) {
 				Args:       tc.args,
 				Target:     tc.target,
 				CacheFrom:  tc.cacheFrom,
+				Platform:   tc.platform,
 				Tags:       tc.tags,
 				Labels:     tc.labels,
 			}
@@ -277,6 +329,126 @@ func TestDockerCommand_Login(t *testing.T) {
 	}
 }
 
+func TestDockerCommand_Run(t *testing.T) {
+	mockError := errors.New("mockError")
+	tests := map[string]struct {
+		in         *RunOptions
+		setupMocks func(m *MockCmd)
+
+		wantedErr error
+	}{
+		"wrap error returned from RunWithContext()": {
+			in: &RunOptions{
+				ImageURI:      "nginx",
+				ContainerName: "frontend",
+			},
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"run", "--rm", "--name", "frontend", "nginx"}, gomock.Any(), gomock.Any()).Return(mockError)
+			},
+			wantedErr: fmt.Errorf("run container frontend: %w", mockError),
+		},
+		"detached container that publishes ports": {
+			in: &RunOptions{
+				ImageURI:       "public.ecr.aws/amazonlinux/amazonlinux:2023",
+				ContainerName:  "pause",
+				Detach:         true,
+				ContainerPorts: map[string]string{"8080": "8080", "443": "443"},
+				AddHosts:       map[string]string{"api": "host-gateway"},
+				Command:        []string{"sleep", "infinity"},
+			},
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"run", "--rm", "--name", "pause", "--detach",
+					"--publish", "443:443", "--publish", "8080:8080",
+					"--add-host", "api:host-gateway",
+					"public.ecr.aws/amazonlinux/amazonlinux:2023", "sleep", "infinity"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"container with env vars, secrets and entrypoint in a shared network": {
+			in: &RunOptions{
+				ImageURI:         "frontend:local",
+				ContainerName:    "frontend",
+				EnvVars:          map[string]string{"LOG_LEVEL": "debug"},
+				Secrets:          map[string]string{"DB_PASSWORD": "hunter2"},
+				ContainerNetwork: "pause",
+				EntryPoint:       []string{"/bin/sh", "-c"},
+				Command:          []string{"npm start"},
+			},
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"run", "--rm", "--name", "frontend",
+					"--env", "LOG_LEVEL=debug", "--env", "DB_PASSWORD",
+					"--network", "container:pause",
+					"--entrypoint", "/bin/sh", "frontend:local", "-c", "npm start"}, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockCmd := NewMockCmd(ctrl)
+			tc.setupMocks(mockCmd)
+			s := DockerCmdClient{
+				runner: mockCmd,
+			}
+
+			err := s.Run(context.Background(), tc.in, &bytes.Buffer{})
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDockerCommand_Stop(t *testing.T) {
+	tests := map[string]struct {
+		setupMocks func(m *MockCmd)
+
+		wantedErr error
+	}{
+		"wrap error returned from RunWithContext()": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"rm", "--force", "frontend"}, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ []string, opts ...exec.CmdOption) error {
+						cmd := &osexec.Cmd{}
+						for _, opt := range opts {
+							opt(cmd)
+						}
+						_, _ = cmd.Stderr.Write([]byte("permission denied\n"))
+						return errors.New("exit status 1")
+					})
+			},
+			wantedErr: errors.New("remove container frontend: permission denied: exit status 1"),
+		},
+		"success": {
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().RunWithContext(gomock.Any(), "docker", []string{"rm", "--force", "frontend"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockCmd := NewMockCmd(ctrl)
+			tc.setupMocks(mockCmd)
+			s := DockerCmdClient{
+				runner: mockCmd,
+			}
+
+			err := s.Stop(context.Background(), "frontend")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestDockerCommand_Push(t *testing.T) {
 	emptyLookupEnv := func(key string) (string, bool) {
 		return "", false
@@ -400,6 +572,251 @@ func TestDockerCommand_Push(t *testing.T) {
 		// THEN
 		require.EqualError(t, err, "parse the digest from the repo digest ''")
 	})
+	t.Run("pushes with podman builder", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().RunWithContext(ctx, "podman", []string{"push", "uri:latest"}, gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+
+		// WHEN
+		cmd := DockerCmdClient{
+			runner:    m,
+			builder:   BuilderPodman,
+			lookupEnv: emptyLookupEnv,
+		}
+		buf := new(strings.Builder)
+		_, err := cmd.Push(ctx, "uri", buf, "latest")
+
+		// THEN
+		require.EqualError(t, err, "podman push uri:latest: some error")
+	})
+	t.Run("returns the digest of the image pushed by the buildx builder without pushing", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "imagetools", "inspect", "uri:latest", "--format", "{{json .Manifest.Digest}}"}, gomock.Any()).
+			Do(func(ctx context.Context, _ string, _ []string, opt exec.CmdOption) {
+				cmd := &osexec.Cmd{}
+				opt(cmd)
+				_, _ = cmd.Stdout.Write([]byte("\"sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807\"\n"))
+			}).Return(nil)
+
+		// WHEN
+		cmd := DockerCmdClient{
+			runner:    m,
+			builder:   BuilderBuildx,
+			lookupEnv: emptyLookupEnv,
+		}
+		buf := new(strings.Builder)
+		digest, err := cmd.Push(ctx, "uri", buf, "latest", "g123bfc")
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807", digest)
+	})
+	t.Run("returns an error if the digest of the image pushed by the buildx builder cannot be parsed", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().RunWithContext(ctx, "docker", gomock.Any(), gomock.Any()).Return(nil)
+
+		// WHEN
+		cmd := DockerCmdClient{
+			runner:    m,
+			builder:   BuilderBuildx,
+			lookupEnv: emptyLookupEnv,
+		}
+		buf := new(strings.Builder)
+		_, err := cmd.Push(ctx, "uri", buf, "latest")
+
+		// THEN
+		require.EqualError(t, err, "parse the digest from the image manifest ''")
+	})
+}
+
+func TestDockerCommand_ImageDigest(t *testing.T) {
+	ctx := context.Background()
+	const image = "public.ecr.aws/nginx/nginx:1.25"
+	testCases := map[string]struct {
+		out string
+		err error
+
+		wanted      string
+		wantedError string
+	}{
+		"returns the digest of the image": {
+			out:    "\"sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807\"\n",
+			wanted: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+		},
+		"wraps the error": {
+			err:         errors.New("some error"),
+			wantedError: "inspect image digest for public.ecr.aws/nginx/nginx:1.25: some error",
+		},
+		"returns an error if the digest can't be parsed": {
+			out:         "null\n",
+			wantedError: "parse the digest from the image manifest 'null'",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := NewMockCmd(ctrl)
+			m.EXPECT().RunWithContext(ctx, "docker", []string{"buildx", "imagetools", "inspect", image, "--format", "{{json .Manifest.Digest}}"}, gomock.Any()).
+				Do(func(ctx context.Context, _ string, _ []string, opt exec.CmdOption) {
+					cmd := &osexec.Cmd{}
+					opt(cmd)
+					_, _ = cmd.Stdout.Write([]byte(tc.out))
+				}).Return(tc.err)
+			cmd := DockerCmdClient{
+				runner: m,
+			}
+
+			digest, err := cmd.ImageDigest(ctx, image)
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, digest)
+		})
+	}
+}
+
+func TestDockerCommand_CopyImage(t *testing.T) {
+	ctx := context.Background()
+	const (
+		src = "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/api@sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807"
+		dst = "123456789012.dkr.ecr.eu-west-1.amazonaws.com/app/api"
+	)
+	testCases := map[string]struct {
+		inTags     []string
+		wantedArgs []string
+		err        error
+
+		wantedError string
+	}{
+		"tags the copy as latest by default": {
+			wantedArgs: []string{"buildx", "imagetools", "create", "--tag", dst + ":latest", src},
+		},
+		"tags the copy with every tag": {
+			inTags:     []string{"latest", "gitsha"},
+			wantedArgs: []string{"buildx", "imagetools", "create", "--tag", dst + ":latest", "--tag", dst + ":gitsha", src},
+		},
+		"wraps the error": {
+			wantedArgs:  []string{"buildx", "imagetools", "create", "--tag", dst + ":latest", src},
+			err:         errors.New("some error"),
+			wantedError: "copy image " + src + " to " + dst + ": some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := NewMockCmd(ctrl)
+			m.EXPECT().RunWithContext(ctx, "docker", tc.wantedArgs, gomock.Any()).Return(tc.err)
+			cmd := DockerCmdClient{
+				runner: m,
+			}
+
+			err := cmd.CopyImage(ctx, src, dst, tc.inTags...)
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNewWithBuilder(t *testing.T) {
+	testCases := map[string]struct {
+		inBuilder string
+
+		wantedBuilder string
+		wantedBinary  string
+		wantedErr     error
+	}{
+		"uses nerdctl": {
+			inBuilder:     "nerdctl",
+			wantedBuilder: "nerdctl",
+			wantedBinary:  "nerdctl",
+		},
+		"runs docker for buildx": {
+			inBuilder:     "buildx",
+			wantedBuilder: "buildx",
+			wantedBinary:  "docker",
+		},
+		"errors if the builder is not supported": {
+			inBuilder: "kaniko",
+			wantedErr: errors.New(`invalid image builder "kaniko": must be one of docker, podman, nerdctl, buildx`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := NewWithBuilder(nil, tc.inBuilder)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedBuilder, got.Builder())
+			require.Equal(t, tc.wantedBinary, got.Binary())
+		})
+	}
+}
+
+func TestDockerCmdClient_detectBuilder(t *testing.T) {
+	testCases := map[string]struct {
+		installed []string
+
+		wanted string
+	}{
+		"detects docker first": {
+			installed: []string{"nerdctl", "podman", "docker"},
+			wanted:    "docker",
+		},
+		"detects podman if docker is not installed": {
+			installed: []string{"nerdctl", "podman"},
+			wanted:    "podman",
+		},
+		"defaults to docker if no builder is installed": {
+			wanted: "docker",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			client := DockerCmdClient{
+				lookPath: fakeLookPath(tc.installed...),
+			}
+
+			// WHEN
+			got := client.detectBuilder()
+
+			// THEN
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func fakeLookPath(installed ...string) func(string) (string, error) {
+	return func(file string) (string, error) {
+		for _, cmd := range installed {
+			if file == cmd {
+				return "/usr/bin/" + file, nil
+			}
+		}
+		return "", osexec.ErrNotFound
+	}
 }
 
 func TestDockerCommand_CheckDockerEngineRunning(t *testing.T) {
@@ -407,11 +824,58 @@ func TestDockerCommand_CheckDockerEngineRunning(t *testing.T) {
 	var mockCmd *MockCmd
 
 	tests := map[string]struct {
+		builder    string
+		installed  []string
 		setupMocks func(controller *gomock.Controller)
 
 		wantedErr error
 	}{
+		"error if docker is not installed": {
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+			},
+
+			wantedErr: ErrDockerCommandNotFound,
+		},
+		"error if podman is not installed": {
+			builder:   BuilderPodman,
+			installed: []string{"docker"},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+			},
+
+			wantedErr: errors.New("podman: command not found"),
+		},
+		"error running podman info": {
+			builder:   BuilderPodman,
+			installed: []string{"podman"},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().Run("podman", []string{"info"}, gomock.Any()).Return(mockError)
+			},
+
+			wantedErr: fmt.Errorf("get podman info: some error"),
+		},
+		"error if the buildx builder is not reachable": {
+			builder:   BuilderBuildx,
+			installed: []string{"docker"},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().Run("docker", []string{"buildx", "inspect", "--bootstrap"}, gomock.Any()).Return(mockError)
+			},
+
+			wantedErr: fmt.Errorf("inspect buildx builder: some error"),
+		},
+		"success with buildx builder without docker info": {
+			builder:   BuilderBuildx,
+			installed: []string{"docker"},
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().Run("docker", []string{"buildx", "inspect", "--bootstrap"}, gomock.Any()).Return(nil)
+			},
+		},
 		"error running docker info": {
+			installed: []string{"docker"},
 			setupMocks: func(controller *gomock.Controller) {
 				mockCmd = NewMockCmd(controller)
 				mockCmd.EXPECT().Run("docker", []string{"info", "-f", "'{{json .}}'"}, gomock.Any()).Return(mockError)
@@ -420,6 +884,7 @@ func TestDockerCommand_CheckDockerEngineRunning(t *testing.T) {
 			wantedErr: fmt.Errorf("get docker info: some error"),
 		},
 		"return when docker engine is not started": {
+			installed: []string{"docker"},
 			setupMocks: func(controller *gomock.Controller) {
 				mockCmd = NewMockCmd(controller)
 				mockCmd.EXPECT().Run("docker", []string{"info", "-f", "'{{json .}}'"}, gomock.Any()).
@@ -435,6 +900,7 @@ func TestDockerCommand_CheckDockerEngineRunning(t *testing.T) {
 			},
 		},
 		"success": {
+			installed: []string{"docker"},
 			setupMocks: func(controller *gomock.Controller) {
 				mockCmd = NewMockCmd(controller)
 				mockCmd.EXPECT().Run("docker", []string{"info", "-f", "'{{json .}}'"}, gomock.Any()).
@@ -453,7 +919,9 @@ func TestDockerCommand_CheckDockerEngineRunning(t *testing.T) {
 			controller := gomock.NewController(t)
 			tc.setupMocks(controller)
 			s := DockerCmdClient{
-				runner: mockCmd,
+				runner:   mockCmd,
+				builder:  tc.builder,
+				lookPath: fakeLookPath(tc.installed...),
 			}
 
 			err := s.CheckDockerEngineRunning()
@@ -521,7 +989,8 @@ func TestDockerCommand_GetPlatform(t *testing.T) {
 			controller := gomock.NewController(t)
 			tc.setupMocks(controller)
 			s := DockerCmdClient{
-				runner: mockCmd,
+				runner:   mockCmd,
+				lookPath: fakeLookPath("docker"),
 			}
 
 			os, arch, err := s.GetPlatform()
@@ -11,6 +11,15 @@ import (
 // ErrDockerCommandNotFound means the docker command is not found.
 var ErrDockerCommandNotFound = errors.New("docker: command not found")
 
+// ErrBuilderCommandNotFound means the command of an image builder other than docker is not found.
+type ErrBuilderCommandNotFound struct {
+	command string
+}
+
+func (e *ErrBuilderCommandNotFound) Error() string {
+	return fmt.Sprintf("%s: command not found", e.command)
+}
+
 // ErrDockerDaemonNotResponsive means the docker daemon is not responsive.
 type ErrDockerDaemonNotResponsive struct {
 	msg string
@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/pkg/docker/remotebuild/remotebuild.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	io "io"
+	reflect "reflect"
+
+	cloudwatchlogs "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	codebuild "github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// Mockuploader is a mock of uploader interface.
+type Mockuploader struct {
+	ctrl     *gomock.Controller
+	recorder *MockuploaderMockRecorder
+}
+
+// MockuploaderMockRecorder is the mock recorder for Mockuploader.
+type MockuploaderMockRecorder struct {
+	mock *Mockuploader
+}
+
+// NewMockuploader creates a new mock instance.
+func NewMockuploader(ctrl *gomock.Controller) *Mockuploader {
+	mock := &Mockuploader{ctrl: ctrl}
+	mock.recorder = &MockuploaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockuploader) EXPECT() *MockuploaderMockRecorder {
+	return m.recorder
+}
+
+// Upload mocks base method.
+func (m *Mockuploader) Upload(bucket, key string, data io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", bucket, key, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockuploaderMockRecorder) Upload(bucket, key, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*Mockuploader)(nil).Upload), bucket, key, data)
+}
+
+// MockbuildRunner is a mock of buildRunner interface.
+type MockbuildRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockbuildRunnerMockRecorder
+}
+
+// MockbuildRunnerMockRecorder is the mock recorder for MockbuildRunner.
+type MockbuildRunnerMockRecorder struct {
+	mock *MockbuildRunner
+}
+
+// NewMockbuildRunner creates a new mock instance.
+func NewMockbuildRunner(ctrl *gomock.Controller) *MockbuildRunner {
+	mock := &MockbuildRunner{ctrl: ctrl}
+	mock.recorder = &MockbuildRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockbuildRunner) EXPECT() *MockbuildRunnerMockRecorder {
+	return m.recorder
+}
+
+// Build mocks base method.
+func (m *MockbuildRunner) Build(id string) (*codebuild.Build, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Build", id)
+	ret0, _ := ret[0].(*codebuild.Build)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Build indicates an expected call of Build.
+func (mr *MockbuildRunnerMockRecorder) Build(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockbuildRunner)(nil).Build), id)
+}
+
+// StartBuild mocks base method.
+func (m *MockbuildRunner) StartBuild(in *codebuild.StartBuildInput) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartBuild", in)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBuild indicates an expected call of StartBuild.
+func (mr *MockbuildRunnerMockRecorder) StartBuild(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBuild", reflect.TypeOf((*MockbuildRunner)(nil).StartBuild), in)
+}
+
+// StopBuild mocks base method.
+func (m *MockbuildRunner) StopBuild(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopBuild", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StopBuild indicates an expected call of StopBuild.
+func (mr *MockbuildRunnerMockRecorder) StopBuild(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopBuild", reflect.TypeOf((*MockbuildRunner)(nil).StopBuild), id)
+}
+
+// MocklogEventsGetter is a mock of logEventsGetter interface.
+type MocklogEventsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogEventsGetterMockRecorder
+}
+
+// MocklogEventsGetterMockRecorder is the mock recorder for MocklogEventsGetter.
+type MocklogEventsGetterMockRecorder struct {
+	mock *MocklogEventsGetter
+}
+
+// NewMocklogEventsGetter creates a new mock instance.
+func NewMocklogEventsGetter(ctrl *gomock.Controller) *MocklogEventsGetter {
+	mock := &MocklogEventsGetter{ctrl: ctrl}
+	mock.recorder = &MocklogEventsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklogEventsGetter) EXPECT() *MocklogEventsGetterMockRecorder {
+	return m.recorder
+}
+
+// LogEvents mocks base method.
+func (m *MocklogEventsGetter) LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogEvents", opts)
+	ret0, _ := ret[0].(*cloudwatchlogs.LogEventsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogEvents indicates an expected call of LogEvents.
+func (mr *MocklogEventsGetterMockRecorder) LogEvents(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogEvents", reflect.TypeOf((*MocklogEventsGetter)(nil).LogEvents), opts)
+}
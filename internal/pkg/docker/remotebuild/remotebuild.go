@@ -0,0 +1,312 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package remotebuild builds container images with AWS CodeBuild, so that images can be built
+// on machines without a container engine or for another architecture.
+package remotebuild
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// BuildContextDir is the directory of the artifact bucket that build contexts are uploaded to.
+	// The contexts expire with the other local assets of the bucket.
+	BuildContextDir = "local-assets/builds"
+
+	// Name of the Dockerfile in the build context if the Dockerfile is outside the context directory.
+	outOfContextDockerfile = "Dockerfile.copilot"
+
+	digestEnvVar        = "IMAGE_DIGEST"
+	defaultPollInterval = 3 * time.Second
+
+	armBuildImage = "aws/codebuild/amazonlinux2-aarch64-standard:3.0"
+)
+
+var shellSafeRegexp = regexp.MustCompile(`^[a-zA-Z0-9_./:=@%+,-]+$`)
+
+type uploader interface {
+	Upload(bucket, key string, data io.Reader) (string, error)
+}
+
+type buildRunner interface {
+	StartBuild(in *codebuild.StartBuildInput) (string, error)
+	Build(id string) (*codebuild.Build, error)
+	StopBuild(id string) error
+}
+
+type logEventsGetter interface {
+	LogEvents(opts cloudwatchlogs.LogEventsOpts) (*cloudwatchlogs.LogEventsOutput, error)
+}
+
+// Input holds the resources that images are built with.
+type Input struct {
+	ProjectName   string // Name of the CodeBuild project that runs the builds.
+	Bucket        string // Name of the S3 bucket that build contexts are uploaded to.
+	RepositoryURI string // URI of the ECR repository that images are pushed to.
+}
+
+// Builder builds images with CodeBuild and pushes them to an ECR repository.
+type Builder struct {
+	Input
+
+	fs           afero.Fs
+	uploader     uploader
+	builds       buildRunner
+	logs         logEventsGetter
+	pollInterval time.Duration
+}
+
+// New returns a Builder that runs builds with the session, and uploads build contexts with the uploader.
+func New(sess *session.Session, uploader uploader, in Input) *Builder {
+	return &Builder{
+		Input:        in,
+		fs:           afero.NewOsFs(),
+		uploader:     uploader,
+		builds:       codebuild.New(sess),
+		logs:         cloudwatchlogs.New(sess),
+		pollInterval: defaultPollInterval,
+	}
+}
+
+// Login returns the URI of the repository. Builds log in to the repository on their own, so there is nothing to do locally.
+func (b *Builder) Login() (string, error) {
+	return b.RepositoryURI, nil
+}
+
+// BuildAndPush uploads the build context, builds the image with CodeBuild and pushes it to the repository with tags.
+// The logs of the build are written to w as they arrive. It returns the digest of the pushed image.
+func (b *Builder) BuildAndPush(ctx context.Context, args *dockerengine.BuildArguments, w io.Writer) (string, error) {
+	if args.URI == "" {
+		args.URI = b.RepositoryURI
+	}
+	envType, image, err := buildEnvironment(args.Platform)
+	if err != nil {
+		return "", err
+	}
+	contextDir := args.Context
+	if contextDir == "" {
+		contextDir = filepath.Dir(args.Dockerfile)
+	}
+	archive, dockerfile, err := b.archive(contextDir, args.Dockerfile)
+	if err != nil {
+		return "", fmt.Errorf("archive build context %s: %w", contextDir, err)
+	}
+	key := fmt.Sprintf("%s/%x.zip", BuildContextDir, sha256.Sum256(archive))
+	if _, err := b.uploader.Upload(b.Bucket, key, bytes.NewReader(archive)); err != nil {
+		return "", fmt.Errorf("upload build context %s: %w", contextDir, err)
+	}
+	spec, err := buildspec(args, dockerfile)
+	if err != nil {
+		return "", err
+	}
+	id, err := b.builds.StartBuild(&codebuild.StartBuildInput{
+		ProjectName:     b.ProjectName,
+		SourceLocation:  fmt.Sprintf("%s/%s", b.Bucket, key),
+		Buildspec:       spec,
+		EnvironmentType: envType,
+		Image:           image,
+	})
+	if err != nil {
+		return "", err
+	}
+	build, err := b.wait(ctx, id, w)
+	if err != nil {
+		return "", err
+	}
+	if !build.Succeeded() {
+		return "", fmt.Errorf("remote build %s finished with status %s", id, build.Status)
+	}
+	digest := build.ExportedEnvVars[digestEnvVar]
+	if digest == "" {
+		return "", fmt.Errorf("remote build %s did not export the digest of image %s", id, args.URI)
+	}
+	return digest, nil
+}
+
+// wait polls the build until it's done, and writes the new log events of the build to w after each poll.
+// If ctx is canceled, the build is stopped.
+func (b *Builder) wait(ctx context.Context, id string, w io.Writer) (*codebuild.Build, error) {
+	lastEventTime := make(map[string]int64)
+	for {
+		build, err := b.builds.Build(id)
+		if err != nil {
+			return nil, err
+		}
+		if build.LogStream != "" {
+			lastEventTime = b.writeLogs(build, lastEventTime, w)
+		}
+		if build.Done() {
+			return build, nil
+		}
+		select {
+		case <-ctx.Done():
+			if err := b.builds.StopBuild(id); err != nil {
+				return nil, err
+			}
+			return nil, ctx.Err()
+		case <-time.After(b.pollInterval):
+		}
+	}
+}
+
+// writeLogs writes the log events of the build since the last events, and returns the time of the latest event.
+func (b *Builder) writeLogs(build *codebuild.Build, lastEventTime map[string]int64, w io.Writer) map[string]int64 {
+	out, err := b.logs.LogEvents(cloudwatchlogs.LogEventsOpts{
+		LogGroup:               build.LogGroup,
+		LogStreamPrefixFilters: []string{build.LogStream},
+		LogStreamLimit:         1,
+		StreamLastEventTime:    lastEventTime,
+	})
+	if err != nil {
+		// The log stream is created after the build container starts, and the status of the build is what matters.
+		return lastEventTime
+	}
+	for _, event := range out.Events {
+		fmt.Fprintln(w, strings.TrimRight(event.Message, "\n"))
+	}
+	return out.StreamLastEventTime
+}
+
+// archive zips the build context directory, and returns the path of the Dockerfile in the archive.
+// Dockerfiles outside the context directory are added to the root of the archive.
+func (b *Builder) archive(contextDir, dockerfile string) ([]byte, string, error) {
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+	err := afero.Walk(b.fs, contextDir, func(fpath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if info.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel, err := filepath.Rel(contextDir, fpath)
+		if err != nil {
+			return err
+		}
+		return b.addToArchive(zw, fpath, filepath.ToSlash(rel))
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	dfPath, err := filepath.Rel(contextDir, dockerfile)
+	if err != nil || strings.HasPrefix(dfPath, "..") {
+		dfPath = outOfContextDockerfile
+		if err := b.addToArchive(zw, dockerfile, dfPath); err != nil {
+			return nil, "", err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), filepath.ToSlash(dfPath), nil
+}
+
+func (b *Builder) addToArchive(zw *zip.Writer, fpath, name string) error {
+	content, err := afero.ReadFile(b.fs, fpath)
+	if err != nil {
+		return fmt.Errorf("read file %s: %w", fpath, err)
+	}
+	// Leave out the modification time, so that the same context is uploaded only once.
+	f, err := zw.CreateHeader(&zip.FileHeader{
+		Name:   name,
+		Method: zip.Deflate,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(content)
+	return err
+}
+
+// buildEnvironment returns the environment type and image of the build container for the platform of the image.
+// Empty values mean the defaults of the project, which builds linux/x86_64 images.
+func buildEnvironment(platform string) (envType, image string, err error) {
+	osFamily, arch, _ := strings.Cut(platform, "/")
+	if osFamily == dockerengine.OSWindows {
+		return "", "", fmt.Errorf("remote builds do not support platform %s", platform)
+	}
+	switch arch {
+	case dockerengine.ArchARM, dockerengine.ArchARM64:
+		return codebuild.EnvironmentTypeARM, armBuildImage, nil
+	}
+	return "", "", nil
+}
+
+// buildspec returns the buildspec that builds and pushes the image, and exports its digest.
+func buildspec(args *dockerengine.BuildArguments, dockerfile string) (string, error) {
+	remoteArgs := *args
+	remoteArgs.Context = "."
+	remoteArgs.Dockerfile = dockerfile
+	buildArgs, err := remoteArgs.GenerateDockerBuildArgs(dockerengine.New(nil))
+	if err != nil {
+		return "", fmt.Errorf("generate docker build args: %w", err)
+	}
+	registry, _, _ := strings.Cut(args.URI, "/")
+	commands := []string{
+		fmt.Sprintf(`aws ecr get-login-password --region "$AWS_REGION" | docker login --username AWS --password-stdin %s`, registry),
+		shellCommand("docker", buildArgs...),
+	}
+	for _, tag := range args.Tags {
+		commands = append(commands, shellCommand("docker", "push", fmt.Sprintf("%s:%s", args.URI, tag)))
+	}
+	commands = append(commands, fmt.Sprintf(`export %s=$(docker inspect --format '{{index .RepoDigests 0}}' %s | cut -d@ -f2)`,
+		digestEnvVar, shellQuote(fmt.Sprintf("%s:%s", args.URI, args.Tags[0]))))
+
+	type phase struct {
+		Commands []string `yaml:"commands"`
+	}
+	spec := struct {
+		Version string `yaml:"version"`
+		Env     struct {
+			ExportedVariables []string `yaml:"exported-variables"`
+		} `yaml:"env"`
+		Phases struct {
+			Build phase `yaml:"build"`
+		} `yaml:"phases"`
+	}{
+		Version: "0.2",
+	}
+	spec.Env.ExportedVariables = []string{digestEnvVar}
+	spec.Phases.Build.Commands = commands
+	out, err := yaml.Marshal(spec)
+	if err != nil {
+		return "", fmt.Errorf("marshal buildspec: %w", err)
+	}
+	return string(out), nil
+}
+
+func shellCommand(name string, args ...string) string {
+	quoted := []string{name}
+	for _, arg := range args {
+		quoted = append(quoted, shellQuote(arg))
+	}
+	return strings.Join(quoted, " ")
+}
+
+func shellQuote(s string) string {
+	if shellSafeRegexp.MatchString(s) {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -0,0 +1,323 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package remotebuild
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codebuild"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/docker/remotebuild/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+type builderMocks struct {
+	uploader *mocks.Mockuploader
+	builds   *mocks.MockbuildRunner
+	logs     *mocks.MocklogEventsGetter
+}
+
+func TestBuilder_BuildAndPush(t *testing.T) {
+	const (
+		mockURI     = "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend"
+		mockProject = "phonetool-remote-builder"
+		mockBucket  = "mockBucket"
+		mockID      = "phonetool-remote-builder:1234"
+	)
+	defaultArgs := func() *dockerengine.BuildArguments {
+		return &dockerengine.BuildArguments{
+			Dockerfile: "frontend/Dockerfile",
+			Context:    "frontend",
+			Tags:       []string{"latest"},
+		}
+	}
+	testCases := map[string]struct {
+		args       *dockerengine.BuildArguments
+		setupMocks func(m builderMocks)
+
+		wantedDigest string
+		wantedLogs   string
+		wantedError  error
+	}{
+		"returns an error for windows images": {
+			args: &dockerengine.BuildArguments{
+				Dockerfile: "frontend/Dockerfile",
+				Tags:       []string{"latest"},
+				Platform:   "windows/x86_64",
+			},
+			setupMocks:  func(m builderMocks) {},
+			wantedError: errors.New("remote builds do not support platform windows/x86_64"),
+		},
+		"returns a wrapped error if fail to upload the build context": {
+			args: defaultArgs(),
+			setupMocks: func(m builderMocks) {
+				m.uploader.EXPECT().Upload(mockBucket, gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("upload build context frontend: some error"),
+		},
+		"returns the error if fail to start the build": {
+			args: defaultArgs(),
+			setupMocks: func(m builderMocks) {
+				m.uploader.EXPECT().Upload(mockBucket, gomock.Any(), gomock.Any()).Return("", nil)
+				m.builds.EXPECT().StartBuild(gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns an error if the build fails": {
+			args: defaultArgs(),
+			setupMocks: func(m builderMocks) {
+				m.uploader.EXPECT().Upload(mockBucket, gomock.Any(), gomock.Any()).Return("", nil)
+				m.builds.EXPECT().StartBuild(gomock.Any()).Return(mockID, nil)
+				m.builds.EXPECT().Build(mockID).Return(&codebuild.Build{
+					ID:     mockID,
+					Status: "FAILED",
+				}, nil)
+			},
+			wantedError: errors.New("remote build phonetool-remote-builder:1234 finished with status FAILED"),
+		},
+		"returns an error if the build does not export the digest": {
+			args: defaultArgs(),
+			setupMocks: func(m builderMocks) {
+				m.uploader.EXPECT().Upload(mockBucket, gomock.Any(), gomock.Any()).Return("", nil)
+				m.builds.EXPECT().StartBuild(gomock.Any()).Return(mockID, nil)
+				m.builds.EXPECT().Build(mockID).Return(&codebuild.Build{
+					ID:     mockID,
+					Status: "SUCCEEDED",
+				}, nil)
+			},
+			wantedError: errors.New("remote build phonetool-remote-builder:1234 did not export the digest of image 1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend"),
+		},
+		"streams the logs of the build and returns the digest": {
+			args: defaultArgs(),
+			setupMocks: func(m builderMocks) {
+				m.uploader.EXPECT().Upload(mockBucket, gomock.Any(), gomock.Any()).DoAndReturn(func(_, key string, _ io.Reader) (string, error) {
+					require.True(t, strings.HasPrefix(key, "local-assets/builds/"))
+					require.True(t, strings.HasSuffix(key, ".zip"))
+					return "", nil
+				})
+				m.builds.EXPECT().StartBuild(gomock.Any()).DoAndReturn(func(in *codebuild.StartBuildInput) (string, error) {
+					require.Equal(t, mockProject, in.ProjectName)
+					require.True(t, strings.HasPrefix(in.SourceLocation, "mockBucket/local-assets/builds/"))
+					require.Contains(t, in.Buildspec, "docker build -t 1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:latest . -f Dockerfile")
+					require.Contains(t, in.Buildspec, "docker push 1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend:latest")
+					require.Contains(t, in.Buildspec, "--password-stdin 1234.dkr.ecr.us-west-2.amazonaws.com")
+					require.Empty(t, in.EnvironmentType)
+					return mockID, nil
+				})
+				gomock.InOrder(
+					m.builds.EXPECT().Build(mockID).Return(&codebuild.Build{
+						ID:     mockID,
+						Status: "IN_PROGRESS",
+					}, nil),
+					m.builds.EXPECT().Build(mockID).Return(&codebuild.Build{
+						ID:        mockID,
+						Status:    "IN_PROGRESS",
+						LogGroup:  "/aws/codebuild/phonetool-remote-builder",
+						LogStream: "1234",
+					}, nil),
+					m.builds.EXPECT().Build(mockID).Return(&codebuild.Build{
+						ID:        mockID,
+						Status:    "SUCCEEDED",
+						LogGroup:  "/aws/codebuild/phonetool-remote-builder",
+						LogStream: "1234",
+						ExportedEnvVars: map[string]string{
+							"IMAGE_DIGEST": "sha256:1234",
+						},
+					}, nil),
+				)
+				gomock.InOrder(
+					m.logs.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
+						Events: []*cloudwatchlogs.Event{
+							{Message: "Step 1/2 : FROM nginx\n"},
+						},
+						StreamLastEventTime: map[string]int64{"1234": 1},
+					}, nil),
+					m.logs.EXPECT().LogEvents(cloudwatchlogs.LogEventsOpts{
+						LogGroup:               "/aws/codebuild/phonetool-remote-builder",
+						LogStreamPrefixFilters: []string{"1234"},
+						LogStreamLimit:         1,
+						StreamLastEventTime:    map[string]int64{"1234": 1},
+					}).Return(&cloudwatchlogs.LogEventsOutput{
+						Events: []*cloudwatchlogs.Event{
+							{Message: "Step 2/2 : COPY . ."},
+						},
+						StreamLastEventTime: map[string]int64{"1234": 2},
+					}, nil),
+				)
+			},
+			wantedDigest: "sha256:1234",
+			wantedLogs:   "Step 1/2 : FROM nginx\nStep 2/2 : COPY . .\n",
+		},
+		"builds arm images on an arm build container": {
+			args: &dockerengine.BuildArguments{
+				Dockerfile: "frontend/Dockerfile",
+				Tags:       []string{"latest"},
+				Platform:   "linux/arm64",
+			},
+			setupMocks: func(m builderMocks) {
+				m.uploader.EXPECT().Upload(mockBucket, gomock.Any(), gomock.Any()).Return("", nil)
+				m.builds.EXPECT().StartBuild(gomock.Any()).DoAndReturn(func(in *codebuild.StartBuildInput) (string, error) {
+					require.Equal(t, codebuild.EnvironmentTypeARM, in.EnvironmentType)
+					require.Equal(t, armBuildImage, in.Image)
+					require.Contains(t, in.Buildspec, "--platform linux/arm64")
+					return mockID, nil
+				})
+				m.builds.EXPECT().Build(mockID).Return(&codebuild.Build{
+					ID:     mockID,
+					Status: "SUCCEEDED",
+					ExportedEnvVars: map[string]string{
+						"IMAGE_DIGEST": "sha256:1234",
+					},
+				}, nil)
+			},
+			wantedDigest: "sha256:1234",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			t.Setenv("CI", "false")
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := builderMocks{
+				uploader: mocks.NewMockuploader(ctrl),
+				builds:   mocks.NewMockbuildRunner(ctrl),
+				logs:     mocks.NewMocklogEventsGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "frontend/Dockerfile", []byte("FROM nginx"), 0644))
+			b := &Builder{
+				Input: Input{
+					ProjectName:   mockProject,
+					Bucket:        mockBucket,
+					RepositoryURI: mockURI,
+				},
+				fs:       fs,
+				uploader: m.uploader,
+				builds:   m.builds,
+				logs:     m.logs,
+			}
+			logs := &bytes.Buffer{}
+
+			// WHEN
+			digest, err := b.BuildAndPush(context.Background(), tc.args, logs)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDigest, digest)
+			require.Equal(t, tc.wantedLogs, logs.String())
+		})
+	}
+}
+
+func TestBuilder_BuildAndPush_StopsBuildOnCancel(t *testing.T) {
+	// GIVEN
+	t.Setenv("CI", "false")
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := builderMocks{
+		uploader: mocks.NewMockuploader(ctrl),
+		builds:   mocks.NewMockbuildRunner(ctrl),
+		logs:     mocks.NewMocklogEventsGetter(ctrl),
+	}
+	m.uploader.EXPECT().Upload(gomock.Any(), gomock.Any(), gomock.Any()).Return("", nil)
+	m.builds.EXPECT().StartBuild(gomock.Any()).Return("mockID", nil)
+	m.builds.EXPECT().Build("mockID").Return(&codebuild.Build{
+		ID:     "mockID",
+		Status: "IN_PROGRESS",
+	}, nil)
+	m.builds.EXPECT().StopBuild("mockID").Return(nil)
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "frontend/Dockerfile", []byte("FROM nginx"), 0644))
+	b := &Builder{
+		Input: Input{
+			RepositoryURI: "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/frontend",
+		},
+		fs:           fs,
+		uploader:     m.uploader,
+		builds:       m.builds,
+		logs:         m.logs,
+		pollInterval: time.Hour,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// WHEN
+	_, err := b.BuildAndPush(ctx, &dockerengine.BuildArguments{
+		Dockerfile: "frontend/Dockerfile",
+		Tags:       []string{"latest"},
+	}, io.Discard)
+
+	// THEN
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestBuilder_archive(t *testing.T) {
+	testCases := map[string]struct {
+		contextDir string
+		dockerfile string
+
+		wantedFiles      []string
+		wantedDockerfile string
+	}{
+		"archives the context without the git directory": {
+			contextDir:       "frontend",
+			dockerfile:       "frontend/build/Dockerfile",
+			wantedFiles:      []string{"build/Dockerfile", "index.html", "static/logo.png"},
+			wantedDockerfile: "build/Dockerfile",
+		},
+		"adds a Dockerfile outside the context to the archive": {
+			contextDir:       "frontend/static",
+			dockerfile:       "frontend/build/Dockerfile",
+			wantedFiles:      []string{"logo.png", "Dockerfile.copilot"},
+			wantedDockerfile: "Dockerfile.copilot",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "frontend/build/Dockerfile", []byte("FROM nginx"), 0644))
+			require.NoError(t, afero.WriteFile(fs, "frontend/index.html", []byte("hello"), 0644))
+			require.NoError(t, afero.WriteFile(fs, "frontend/.git/HEAD", []byte("ref: main"), 0644))
+			require.NoError(t, afero.WriteFile(fs, "frontend/static/logo.png", []byte("logo"), 0644))
+			b := &Builder{fs: fs}
+
+			// WHEN
+			archive, dockerfile, err := b.archive(tc.contextDir, tc.dockerfile)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDockerfile, dockerfile)
+			zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+			require.NoError(t, err)
+			var files []string
+			for _, f := range zr.File {
+				files = append(files, f.Name)
+			}
+			require.ElementsMatch(t, tc.wantedFiles, files)
+
+			again, _, err := b.archive(tc.contextDir, tc.dockerfile)
+			require.NoError(t, err)
+			require.Equal(t, archive, again, "archives of the same context should be identical")
+		})
+	}
+}
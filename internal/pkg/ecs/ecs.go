@@ -8,12 +8,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
@@ -28,6 +30,9 @@ const (
 	clusterResourceType             = "ecs:cluster"
 	serviceResourceType             = "ecs:service"
 
+	// pausedDesiredCountTagKey tags a paused service with the number of tasks to run once it's resumed.
+	pausedDesiredCountTagKey = "copilot-paused-desired-count"
+
 	taskStopReason = "Task stopped because the underlying CloudFormation stack was deleted."
 )
 
@@ -45,12 +50,20 @@ type ecsClient interface {
 	StoppedServiceTasks(cluster, service string) ([]*ecs.Task, error)
 	StopTasks(tasks []string, opts ...ecs.StopTasksOpts) error
 	TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error)
+	RegisterTaskDefinitionWithImages(taskDefName string, images map[string]string) (string, error)
 	UpdateService(clusterName, serviceName string, opts ...ecs.UpdateServiceOpts) error
+	ServiceTags(serviceARN string) (map[string]string, error)
+	TagService(serviceARN string, tags map[string]string) error
+	UntagService(serviceARN string, keys []string) error
 	DescribeTasks(cluster string, taskARNs []string) ([]*ecs.Task, error)
 	ActiveClusters(arns ...string) ([]string, error)
 	ActiveServices(serviceARNs ...string) ([]string, error)
 }
 
+type capacityGetter interface {
+	ECSServiceCapacity(cluster, service string) (*aas.Capacity, error)
+}
+
 type stepFunctionsClient interface {
 	StateMachineDefinition(stateMachineARN string) (string, error)
 }
@@ -61,8 +74,9 @@ type secretGetter interface {
 
 // EnvVar contains the value of an environment variable
 type EnvVar struct {
-	Name  string
-	Value string
+	Name      string
+	Container string
+	Value     string
 }
 
 // ServiceDesc contains the description of an ECS service.
@@ -79,6 +93,7 @@ type Client struct {
 	ssm            secretGetter
 	secretManager  secretGetter
 	ecsClient      ecsClient
+	capacity       capacityGetter
 	StepFuncClient stepFunctionsClient
 }
 
@@ -87,6 +102,7 @@ func New(sess *session.Session) *Client {
 	return &Client{
 		rgGetter:       resourcegroups.New(sess),
 		ecsClient:      ecs.New(sess),
+		capacity:       aas.New(sess),
 		ssm:            ssm.New(sess),
 		secretManager:  secretsmanager.New(sess),
 		StepFuncClient: stepfunctions.New(sess),
@@ -107,6 +123,102 @@ func (c Client) ForceUpdateService(app, env, svc string) error {
 	return c.ecsClient.UpdateService(clusterName, serviceName, ecs.WithForceUpdate())
 }
 
+// PauseService scales an ECS service down to zero tasks given Copilot service info.
+// The desired count of the service is kept in a tag, so that ResumeService can restore it.
+// Pausing a service that is already paused is a no-op.
+func (c Client) PauseService(app, env, svc string) error {
+	svcARN, err := c.serviceARN(app, env, svc)
+	if err != nil {
+		return err
+	}
+	tags, err := c.ecsClient.ServiceTags(svcARN.String())
+	if err != nil {
+		return err
+	}
+	if _, ok := tags[pausedDesiredCountTagKey]; ok {
+		return nil
+	}
+	service, err := c.ecsClient.Service(svcARN.ClusterName(), svcARN.ServiceName())
+	if err != nil {
+		return fmt.Errorf("get ECS service %s: %w", svcARN.ServiceName(), err)
+	}
+	if err := c.ecsClient.TagService(svcARN.String(), map[string]string{
+		pausedDesiredCountTagKey: strconv.FormatInt(aws.Int64Value(service.DesiredCount), 10),
+	}); err != nil {
+		return err
+	}
+	return c.ecsClient.UpdateService(svcARN.ClusterName(), svcARN.ServiceName(), ecs.WithDesiredCount(0))
+}
+
+// ResumeService restores the desired count of an ECS service paused with PauseService given Copilot service info.
+func (c Client) ResumeService(app, env, svc string) error {
+	svcARN, err := c.serviceARN(app, env, svc)
+	if err != nil {
+		return err
+	}
+	tags, err := c.ecsClient.ServiceTags(svcARN.String())
+	if err != nil {
+		return err
+	}
+	val, ok := tags[pausedDesiredCountTagKey]
+	if !ok {
+		return fmt.Errorf("service %s is not paused in environment %s", svc, env)
+	}
+	count, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		return fmt.Errorf("parse tag %s with value %q of service %s: %w", pausedDesiredCountTagKey, val, svc, err)
+	}
+	if err := c.ecsClient.UpdateService(svcARN.ClusterName(), svcARN.ServiceName(), ecs.WithDesiredCount(count)); err != nil {
+		return err
+	}
+	return c.ecsClient.UntagService(svcARN.String(), []string{pausedDesiredCountTagKey})
+}
+
+// ScaleService sets the number of tasks of an ECS service given Copilot service info, and waits until the service is stable.
+// Scaling a paused service is an error, since resuming it would restore the desired count from before the pause.
+func (c Client) ScaleService(app, env, svc string, count int64) error {
+	svcARN, err := c.serviceARN(app, env, svc)
+	if err != nil {
+		return err
+	}
+	tags, err := c.ecsClient.ServiceTags(svcARN.String())
+	if err != nil {
+		return err
+	}
+	if _, ok := tags[pausedDesiredCountTagKey]; ok {
+		return &ErrServicePaused{svc: svc, env: env}
+	}
+	return c.ecsClient.UpdateService(svcARN.ClusterName(), svcARN.ServiceName(), ecs.WithDesiredCount(count))
+}
+
+// AutoscalingCapacity returns the range of the number of tasks that autoscaling keeps an ECS service in
+// given Copilot service info, or nil if the service doesn't autoscale.
+func (c Client) AutoscalingCapacity(app, env, svc string) (*aas.Capacity, error) {
+	clusterName, serviceName, err := c.fetchAndParseServiceARN(app, env, svc)
+	if err != nil {
+		return nil, err
+	}
+	return c.capacity.ECSServiceCapacity(clusterName, serviceName)
+}
+
+// UpdateServiceImages deploys a new revision of the service's task definition in which the images of the containers
+// are replaced, without going through the CloudFormation stack of the service. It waits until the service is stable.
+func (c Client) UpdateServiceImages(app, env, svc string, images map[string]string) error {
+	clusterName, serviceName, err := c.fetchAndParseServiceARN(app, env, svc)
+	if err != nil {
+		return err
+	}
+	service, err := c.ecsClient.Service(clusterName, serviceName)
+	if err != nil {
+		return fmt.Errorf("get ECS service %s: %w", serviceName, err)
+	}
+	taskDefARN, err := c.ecsClient.RegisterTaskDefinitionWithImages(aws.StringValue(service.TaskDefinition), images)
+	if err != nil {
+		return err
+	}
+	return c.ecsClient.UpdateService(clusterName, serviceName, ecs.WithTaskDefinition(taskDefARN))
+}
+
 // DescribeService returns the description of an ECS service given Copilot service info.
 func (c Client) DescribeService(app, env, svc string) (*ServiceDesc, error) {
 	clusterName, serviceName, err := c.fetchAndParseServiceARN(app, env, svc)
@@ -283,8 +395,9 @@ func (c Client) DecryptedSecrets(secrets []*ecs.ContainerSecret) ([]EnvVar, erro
 			return nil, err
 		}
 		vars = append(vars, EnvVar{
-			Name:  secret.Name,
-			Value: secretValue,
+			Name:      secret.Name,
+			Container: secret.Container,
+			Value:     secretValue,
 		})
 	}
 	return vars, nil
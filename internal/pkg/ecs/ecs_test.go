@@ -11,6 +11,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -620,6 +621,413 @@ func TestClient_ForceUpdateService(t *testing.T) {
 	}
 }
 
+func TestClient_PauseService(t *testing.T) {
+	const (
+		mockApp     = "mockApp"
+		mockEnv     = "mockEnv"
+		mockSvc     = "mockSvc"
+		mockSvcARN  = "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+		mockCluster = "mockCluster"
+		mockService = "mockService"
+	)
+	getRgInput := map[string]string{
+		deploy.AppTagKey:     mockApp,
+		deploy.EnvTagKey:     mockEnv,
+		deploy.ServiceTagKey: mockSvc,
+	}
+	mockServiceARN := func(m clientMocks) {
+		m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+			Return([]*resourcegroups.Resource{
+				{ARN: mockSvcARN},
+			}, nil)
+		m.ecsClient.EXPECT().ActiveServices(mockSvcARN).Return([]string{mockSvcARN}, nil)
+	}
+
+	tests := map[string]struct {
+		setupMocks func(mocks clientMocks)
+
+		wantedError error
+	}{
+		"do nothing if the service is already paused": {
+			setupMocks: func(m clientMocks) {
+				mockServiceARN(m)
+				m.ecsClient.EXPECT().ServiceTags(mockSvcARN).Return(map[string]string{
+					pausedDesiredCountTagKey: "3",
+				}, nil)
+			},
+		},
+		"return error if failed to tag the service": {
+			setupMocks: func(m clientMocks) {
+				mockServiceARN(m)
+				m.ecsClient.EXPECT().ServiceTags(mockSvcARN).Return(nil, nil)
+				m.ecsClient.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{
+					DesiredCount: aws.Int64(3),
+				}, nil)
+				m.ecsClient.EXPECT().TagService(mockSvcARN, gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"keep the desired count in a tag and scale the service to zero": {
+			setupMocks: func(m clientMocks) {
+				mockServiceARN(m)
+				m.ecsClient.EXPECT().ServiceTags(mockSvcARN).Return(nil, nil)
+				m.ecsClient.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{
+					DesiredCount: aws.Int64(3),
+				}, nil)
+				m.ecsClient.EXPECT().TagService(mockSvcARN, map[string]string{
+					pausedDesiredCountTagKey: "3",
+				}).Return(nil)
+				m.ecsClient.EXPECT().UpdateService(mockCluster, mockService, gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// GIVEN
+			mockRgGetter := mocks.NewMockresourceGetter(ctrl)
+			mockECSClient := mocks.NewMockecsClient(ctrl)
+			mocks := clientMocks{
+				resourceGetter: mockRgGetter,
+				ecsClient:      mockECSClient,
+			}
+
+			test.setupMocks(mocks)
+
+			client := Client{
+				rgGetter:  mockRgGetter,
+				ecsClient: mockECSClient,
+			}
+
+			// WHEN
+			err := client.PauseService(mockApp, mockEnv, mockSvc)
+
+			// THEN
+			if test.wantedError != nil {
+				require.EqualError(t, err, test.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestClient_ResumeService(t *testing.T) {
+	const (
+		mockApp     = "mockApp"
+		mockEnv     = "mockEnv"
+		mockSvc     = "mockSvc"
+		mockSvcARN  = "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+		mockCluster = "mockCluster"
+		mockService = "mockService"
+	)
+	getRgInput := map[string]string{
+		deploy.AppTagKey:     mockApp,
+		deploy.EnvTagKey:     mockEnv,
+		deploy.ServiceTagKey: mockSvc,
+	}
+	mockServiceARN := func(m clientMocks) {
+		m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+			Return([]*resourcegroups.Resource{
+				{ARN: mockSvcARN},
+			}, nil)
+		m.ecsClient.EXPECT().ActiveServices(mockSvcARN).Return([]string{mockSvcARN}, nil)
+	}
+
+	tests := map[string]struct {
+		setupMocks func(mocks clientMocks)
+
+		wantedError error
+	}{
+		"return error if the service is not paused": {
+			setupMocks: func(m clientMocks) {
+				mockServiceARN(m)
+				m.ecsClient.EXPECT().ServiceTags(mockSvcARN).Return(map[string]string{}, nil)
+			},
+			wantedError: errors.New("service mockSvc is not paused in environment mockEnv"),
+		},
+		"return error if the desired count can't be parsed": {
+			setupMocks: func(m clientMocks) {
+				mockServiceARN(m)
+				m.ecsClient.EXPECT().ServiceTags(mockSvcARN).Return(map[string]string{
+					pausedDesiredCountTagKey: "three",
+				}, nil)
+			},
+			wantedError: errors.New(`parse tag copilot-paused-desired-count with value "three" of service mockSvc: strconv.ParseInt: parsing "three": invalid syntax`),
+		},
+		"restore the desired count and remove the tag": {
+			setupMocks: func(m clientMocks) {
+				mockServiceARN(m)
+				m.ecsClient.EXPECT().ServiceTags(mockSvcARN).Return(map[string]string{
+					pausedDesiredCountTagKey: "3",
+				}, nil)
+				m.ecsClient.EXPECT().UpdateService(mockCluster, mockService, gomock.Any()).Return(nil)
+				m.ecsClient.EXPECT().UntagService(mockSvcARN, []string{pausedDesiredCountTagKey}).Return(nil)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// GIVEN
+			mockRgGetter := mocks.NewMockresourceGetter(ctrl)
+			mockECSClient := mocks.NewMockecsClient(ctrl)
+			mocks := clientMocks{
+				resourceGetter: mockRgGetter,
+				ecsClient:      mockECSClient,
+			}
+
+			test.setupMocks(mocks)
+
+			client := Client{
+				rgGetter:  mockRgGetter,
+				ecsClient: mockECSClient,
+			}
+
+			// WHEN
+			err := client.ResumeService(mockApp, mockEnv, mockSvc)
+
+			// THEN
+			if test.wantedError != nil {
+				require.EqualError(t, err, test.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestClient_ScaleService(t *testing.T) {
+	const (
+		mockApp     = "mockApp"
+		mockEnv     = "mockEnv"
+		mockSvc     = "mockSvc"
+		mockSvcARN  = "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+		mockCluster = "mockCluster"
+		mockService = "mockService"
+	)
+	getRgInput := map[string]string{
+		deploy.AppTagKey:     mockApp,
+		deploy.EnvTagKey:     mockEnv,
+		deploy.ServiceTagKey: mockSvc,
+	}
+	mockServiceARN := func(m clientMocks) {
+		m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+			Return([]*resourcegroups.Resource{
+				{ARN: mockSvcARN},
+			}, nil)
+		m.ecsClient.EXPECT().ActiveServices(mockSvcARN).Return([]string{mockSvcARN}, nil)
+	}
+
+	tests := map[string]struct {
+		setupMocks func(mocks clientMocks)
+
+		wantedError error
+	}{
+		"return error if the service is paused": {
+			setupMocks: func(m clientMocks) {
+				mockServiceARN(m)
+				m.ecsClient.EXPECT().ServiceTags(mockSvcARN).Return(map[string]string{
+					pausedDesiredCountTagKey: "3",
+				}, nil)
+			},
+			wantedError: errors.New("service mockSvc is paused in environment mockEnv"),
+		},
+		"return error if failed to update the service": {
+			setupMocks: func(m clientMocks) {
+				mockServiceARN(m)
+				m.ecsClient.EXPECT().ServiceTags(mockSvcARN).Return(nil, nil)
+				m.ecsClient.EXPECT().UpdateService(mockCluster, mockService, gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"set the desired count": {
+			setupMocks: func(m clientMocks) {
+				mockServiceARN(m)
+				m.ecsClient.EXPECT().ServiceTags(mockSvcARN).Return(nil, nil)
+				m.ecsClient.EXPECT().UpdateService(mockCluster, mockService, gomock.Any()).
+					DoAndReturn(func(_, _ string, opts ...ecs.UpdateServiceOpts) error {
+						in := &awsecs.UpdateServiceInput{}
+						for _, opt := range opts {
+							opt(in)
+						}
+						require.Equal(t, int64(8), aws.Int64Value(in.DesiredCount))
+						return nil
+					})
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// GIVEN
+			mockRgGetter := mocks.NewMockresourceGetter(ctrl)
+			mockECSClient := mocks.NewMockecsClient(ctrl)
+			mocks := clientMocks{
+				resourceGetter: mockRgGetter,
+				ecsClient:      mockECSClient,
+			}
+
+			test.setupMocks(mocks)
+
+			client := Client{
+				rgGetter:  mockRgGetter,
+				ecsClient: mockECSClient,
+			}
+
+			// WHEN
+			err := client.ScaleService(mockApp, mockEnv, mockSvc, 8)
+
+			// THEN
+			if test.wantedError != nil {
+				require.EqualError(t, err, test.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestClient_AutoscalingCapacity(t *testing.T) {
+	const mockSvcARN = "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRgGetter := mocks.NewMockresourceGetter(ctrl)
+	mockECSClient := mocks.NewMockecsClient(ctrl)
+	mockCapacity := mocks.NewMockcapacityGetter(ctrl)
+	mockRgGetter.EXPECT().GetResourcesByTags(serviceResourceType, map[string]string{
+		deploy.AppTagKey:     "mockApp",
+		deploy.EnvTagKey:     "mockEnv",
+		deploy.ServiceTagKey: "mockSvc",
+	}).Return([]*resourcegroups.Resource{{ARN: mockSvcARN}}, nil)
+	mockECSClient.EXPECT().ActiveServices(mockSvcARN).Return([]string{mockSvcARN}, nil)
+	mockCapacity.EXPECT().ECSServiceCapacity("mockCluster", "mockService").Return(&aas.Capacity{Min: 1, Max: 10}, nil)
+	client := Client{
+		rgGetter:  mockRgGetter,
+		ecsClient: mockECSClient,
+		capacity:  mockCapacity,
+	}
+
+	got, err := client.AutoscalingCapacity("mockApp", "mockEnv", "mockSvc")
+
+	require.NoError(t, err)
+	require.Equal(t, &aas.Capacity{Min: 1, Max: 10}, got)
+}
+
+func TestClient_UpdateServiceImages(t *testing.T) {
+	const (
+		mockApp        = "mockApp"
+		mockEnv        = "mockEnv"
+		mockSvc        = "mockSvc"
+		mockSvcARN     = "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+		mockCluster    = "mockCluster"
+		mockService    = "mockService"
+		mockTaskDef    = "arn:aws:ecs:us-west-2:1234567890:task-definition/mockApp-mockEnv-mockSvc:3"
+		mockNewTaskDef = "arn:aws:ecs:us-west-2:1234567890:task-definition/mockApp-mockEnv-mockSvc:4"
+	)
+	getRgInput := map[string]string{
+		deploy.AppTagKey:     mockApp,
+		deploy.EnvTagKey:     mockEnv,
+		deploy.ServiceTagKey: mockSvc,
+	}
+	images := map[string]string{
+		mockSvc: "repo@sha256:new",
+	}
+
+	tests := map[string]struct {
+		setupMocks func(mocks clientMocks)
+
+		wantedError error
+	}{
+		"return error if failed to get the service": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{
+							{ARN: mockSvcARN},
+						}, nil),
+					m.ecsClient.EXPECT().ActiveServices(mockSvcARN).Return([]string{mockSvcARN}, nil),
+					m.ecsClient.EXPECT().Service(mockCluster, mockService).Return(nil, errors.New("some error")),
+				)
+			},
+			wantedError: fmt.Errorf("get ECS service mockService: some error"),
+		},
+		"return error if failed to register the task definition": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{
+							{ARN: mockSvcARN},
+						}, nil),
+					m.ecsClient.EXPECT().ActiveServices(mockSvcARN).Return([]string{mockSvcARN}, nil),
+					m.ecsClient.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{
+						TaskDefinition: aws.String(mockTaskDef),
+					}, nil),
+					m.ecsClient.EXPECT().RegisterTaskDefinitionWithImages(mockTaskDef, images).Return("", errors.New("some error")),
+				)
+			},
+			wantedError: fmt.Errorf("some error"),
+		},
+		"success": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{
+							{ARN: mockSvcARN},
+						}, nil),
+					m.ecsClient.EXPECT().ActiveServices(mockSvcARN).Return([]string{mockSvcARN}, nil),
+					m.ecsClient.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{
+						TaskDefinition: aws.String(mockTaskDef),
+					}, nil),
+					m.ecsClient.EXPECT().RegisterTaskDefinitionWithImages(mockTaskDef, images).Return(mockNewTaskDef, nil),
+					m.ecsClient.EXPECT().UpdateService(mockCluster, mockService, gomock.Any()).Return(nil),
+				)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// GIVEN
+			mockRgGetter := mocks.NewMockresourceGetter(ctrl)
+			mockECSClient := mocks.NewMockecsClient(ctrl)
+			mocks := clientMocks{
+				resourceGetter: mockRgGetter,
+				ecsClient:      mockECSClient,
+			}
+
+			test.setupMocks(mocks)
+
+			client := Client{
+				rgGetter:  mockRgGetter,
+				ecsClient: mockECSClient,
+			}
+
+			// WHEN
+			err := client.UpdateServiceImages(mockApp, mockEnv, mockSvc, images)
+
+			// THEN
+			if test.wantedError != nil {
+				require.EqualError(t, err, test.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestClient_listActiveCopilotTasks(t *testing.T) {
 	const (
 		mockCluster   = "mockCluster"
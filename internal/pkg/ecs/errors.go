@@ -29,3 +29,13 @@ func (e *ErrExitCode) Error() string {
 func (e *ErrExitCode) ExitCode() int {
 	return e.exitCode
 }
+
+// ErrServicePaused is returned when a service paused with PauseService can't be changed until it's resumed.
+type ErrServicePaused struct {
+	svc string
+	env string
+}
+
+func (e *ErrServicePaused) Error() string {
+	return fmt.Sprintf("service %s is paused in environment %s", e.svc, e.env)
+}
@@ -7,6 +7,7 @@ package mocks
 import (
 	reflect "reflect"
 
+	aas "github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	resourcegroups "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
 	gomock "github.com/golang/mock/gomock"
@@ -156,6 +157,21 @@ func (mr *MockecsClientMockRecorder) NetworkConfiguration(cluster, serviceName i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkConfiguration", reflect.TypeOf((*MockecsClient)(nil).NetworkConfiguration), cluster, serviceName)
 }
 
+// RegisterTaskDefinitionWithImages mocks base method.
+func (m *MockecsClient) RegisterTaskDefinitionWithImages(taskDefName string, images map[string]string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterTaskDefinitionWithImages", taskDefName, images)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterTaskDefinitionWithImages indicates an expected call of RegisterTaskDefinitionWithImages.
+func (mr *MockecsClientMockRecorder) RegisterTaskDefinitionWithImages(taskDefName, images interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTaskDefinitionWithImages", reflect.TypeOf((*MockecsClient)(nil).RegisterTaskDefinitionWithImages), taskDefName, images)
+}
+
 // RunningTasks mocks base method.
 func (m *MockecsClient) RunningTasks(cluster string) ([]*ecs.Task, error) {
 	m.ctrl.T.Helper()
@@ -216,6 +232,21 @@ func (mr *MockecsClientMockRecorder) ServiceRunningTasks(clusterName, serviceNam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceRunningTasks", reflect.TypeOf((*MockecsClient)(nil).ServiceRunningTasks), clusterName, serviceName)
 }
 
+// ServiceTags mocks base method.
+func (m *MockecsClient) ServiceTags(serviceARN string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceTags", serviceARN)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceTags indicates an expected call of ServiceTags.
+func (mr *MockecsClientMockRecorder) ServiceTags(serviceARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceTags", reflect.TypeOf((*MockecsClient)(nil).ServiceTags), serviceARN)
+}
+
 // StopTasks mocks base method.
 func (m *MockecsClient) StopTasks(tasks []string, opts ...ecs.StopTasksOpts) error {
 	m.ctrl.T.Helper()
@@ -250,6 +281,20 @@ func (mr *MockecsClientMockRecorder) StoppedServiceTasks(cluster, service interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoppedServiceTasks", reflect.TypeOf((*MockecsClient)(nil).StoppedServiceTasks), cluster, service)
 }
 
+// TagService mocks base method.
+func (m *MockecsClient) TagService(serviceARN string, tags map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagService", serviceARN, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TagService indicates an expected call of TagService.
+func (mr *MockecsClientMockRecorder) TagService(serviceARN, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagService", reflect.TypeOf((*MockecsClient)(nil).TagService), serviceARN, tags)
+}
+
 // TaskDefinition mocks base method.
 func (m *MockecsClient) TaskDefinition(taskDefName string) (*ecs.TaskDefinition, error) {
 	m.ctrl.T.Helper()
@@ -265,6 +310,20 @@ func (mr *MockecsClientMockRecorder) TaskDefinition(taskDefName interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskDefinition", reflect.TypeOf((*MockecsClient)(nil).TaskDefinition), taskDefName)
 }
 
+// UntagService mocks base method.
+func (m *MockecsClient) UntagService(serviceARN string, keys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagService", serviceARN, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UntagService indicates an expected call of UntagService.
+func (mr *MockecsClientMockRecorder) UntagService(serviceARN, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagService", reflect.TypeOf((*MockecsClient)(nil).UntagService), serviceARN, keys)
+}
+
 // UpdateService mocks base method.
 func (m *MockecsClient) UpdateService(clusterName, serviceName string, opts ...ecs.UpdateServiceOpts) error {
 	m.ctrl.T.Helper()
@@ -284,6 +343,44 @@ func (mr *MockecsClientMockRecorder) UpdateService(clusterName, serviceName inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateService", reflect.TypeOf((*MockecsClient)(nil).UpdateService), varargs...)
 }
 
+// MockcapacityGetter is a mock of capacityGetter interface.
+type MockcapacityGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockcapacityGetterMockRecorder
+}
+
+// MockcapacityGetterMockRecorder is the mock recorder for MockcapacityGetter.
+type MockcapacityGetterMockRecorder struct {
+	mock *MockcapacityGetter
+}
+
+// NewMockcapacityGetter creates a new mock instance.
+func NewMockcapacityGetter(ctrl *gomock.Controller) *MockcapacityGetter {
+	mock := &MockcapacityGetter{ctrl: ctrl}
+	mock.recorder = &MockcapacityGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcapacityGetter) EXPECT() *MockcapacityGetterMockRecorder {
+	return m.recorder
+}
+
+// ECSServiceCapacity mocks base method.
+func (m *MockcapacityGetter) ECSServiceCapacity(cluster, service string) (*aas.Capacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ECSServiceCapacity", cluster, service)
+	ret0, _ := ret[0].(*aas.Capacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ECSServiceCapacity indicates an expected call of ECSServiceCapacity.
+func (mr *MockcapacityGetterMockRecorder) ECSServiceCapacity(cluster, service interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceCapacity", reflect.TypeOf((*MockcapacityGetter)(nil).ECSServiceCapacity), cluster, service)
+}
+
 // MockstepFunctionsClient is a mock of stepFunctionsClient interface.
 type MockstepFunctionsClient struct {
 	ctrl     *gomock.Controller
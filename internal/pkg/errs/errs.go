@@ -0,0 +1,178 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package errs provides errors that tell users what failed and how to fix it.
+package errs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+const docsURL = "https://aws.github.io/copilot-cli/docs/developing/errors/"
+
+// Code identifies a kind of failure. Codes are stable so that scripts can handle the errors of the --json output.
+type Code string
+
+// Error codes.
+const (
+	CodeUnknown                 Code = "unknown"
+	CodeStackFailed             Code = "stack-failed"
+	CodeResourceExists          Code = "resource-exists"
+	CodeAccessDenied            Code = "access-denied"
+	CodeLimitExceeded           Code = "limit-exceeded"
+	CodeCircuitBreakerTriggered Code = "circuit-breaker-triggered"
+	CodeChangeSetFailed         Code = "change-set-failed"
+	CodeInvalidTemplate         Code = "invalid-template"
+)
+
+// Resource is the resource of a CloudFormation stack whose failure caused an error.
+type Resource struct {
+	Stack     string `json:"stack"`
+	LogicalID string `json:"logicalId,omitempty"`
+	Type      string `json:"type,omitempty"`
+	Status    string `json:"status,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// Remediation suggests how to fix an error.
+type Remediation struct {
+	Hint    string `json:"hint,omitempty"`    // What to change.
+	Command string `json:"command,omitempty"` // Command to run to fix or investigate the error.
+	DocsURL string `json:"docsUrl,omitempty"` // Documentation of the error.
+}
+
+// Error is an error with a code, the resource that failed if any, and a suggested remediation.
+type Error struct {
+	Code        Code
+	Message     string
+	Resource    *Resource
+	Remediation Remediation
+	Err         error // Underlying error, if any.
+}
+
+// Error returns the message, followed by the underlying error and the reason of the failed resource.
+func (e *Error) Error() string {
+	var parts []string
+	for _, part := range []string{e.Message, e.errMessage(), e.reason()} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ": ")
+}
+
+// Unwrap returns the underlying error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// RecommendActions returns the remediation of the error.
+func (e *Error) RecommendActions() string {
+	var actions []string
+	if e.Remediation.Hint != "" {
+		actions = append(actions, e.Remediation.Hint)
+	}
+	if e.Remediation.Command != "" {
+		actions = append(actions, fmt.Sprintf("Run %s.", color.HighlightCode(e.Remediation.Command)))
+	}
+	if e.Remediation.DocsURL != "" {
+		actions = append(actions, fmt.Sprintf("See %s.", color.HighlightResource(e.Remediation.DocsURL)))
+	}
+	return strings.Join(actions, "\n")
+}
+
+// MarshalJSON returns the error as a JSON object.
+func (e *Error) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Code        Code         `json:"code"`
+		Message     string       `json:"message"`
+		Resource    *Resource    `json:"resource,omitempty"`
+		Remediation *Remediation `json:"remediation,omitempty"`
+	}{
+		Code:        e.Code,
+		Message:     e.Error(),
+		Resource:    e.Resource,
+		Remediation: e.remediation(),
+	})
+}
+
+func (e *Error) errMessage() string {
+	if e.Err == nil {
+		return ""
+	}
+	return e.Err.Error()
+}
+
+func (e *Error) reason() string {
+	if e.Resource == nil {
+		return ""
+	}
+	return e.Resource.Reason
+}
+
+func (e *Error) remediation() *Remediation {
+	if e.Remediation == (Remediation{}) {
+		return nil
+	}
+	return &e.Remediation
+}
+
+// Docs returns the URL of the documentation of the error code.
+func Docs(code Code) string {
+	return docsURL + "#" + string(code)
+}
+
+// CodeOf returns the code of the error, or CodeUnknown if the error isn't an Error.
+func CodeOf(err error) Code {
+	var structured *Error
+	if !errors.As(err, &structured) {
+		return CodeUnknown
+	}
+	return structured.Code
+}
+
+// WriteJSON writes the error as a JSON object under the "error" key.
+// Errors that aren't an Error are written with the CodeUnknown code.
+func WriteJSON(w io.Writer, err error) error {
+	var structured *Error
+	if !errors.As(err, &structured) {
+		structured = &Error{
+			Code:    CodeUnknown,
+			Message: err.Error(),
+		}
+	}
+	out, marshalErr := json.Marshal(struct {
+		Error *Error `json:"error"`
+	}{
+		Error: structured,
+	})
+	if marshalErr != nil {
+		return fmt.Errorf("marshal error to JSON: %w", marshalErr)
+	}
+	_, writeErr := fmt.Fprintln(w, string(out))
+	return writeErr
+}
+
+// Details returns the code of the error and the resource that failed, to be displayed after the error message.
+// It returns an empty string if the error isn't an Error.
+func Details(err error) string {
+	var structured *Error
+	if !errors.As(err, &structured) {
+		return ""
+	}
+	lines := []string{fmt.Sprintf("Error code: %s", structured.Code)}
+	if r := structured.Resource; r != nil && r.LogicalID != "" {
+		resource := color.HighlightResource(r.LogicalID)
+		if r.Type != "" {
+			resource = fmt.Sprintf("%s (%s)", resource, r.Type)
+		}
+		lines = append(lines, fmt.Sprintf("Failed resource: %s in stack %s", resource, r.Stack))
+	}
+	return strings.Join(lines, "\n")
+}
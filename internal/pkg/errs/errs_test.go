@@ -0,0 +1,85 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestError(t *testing.T) {
+	cause := errors.New("change set failed")
+	err := &Error{
+		Code:    CodeResourceExists,
+		Message: "deploy service api",
+		Resource: &Resource{
+			Stack:     "shop-test-api",
+			LogicalID: "LogGroup",
+			Type:      "AWS::Logs::LogGroup",
+			Status:    "CREATE_FAILED",
+			Reason:    "Resource of type 'AWS::Logs::LogGroup' with identifier '/copilot/shop-test-api' already exists",
+		},
+		Remediation: Remediation{
+			Hint:    "Delete the log group.",
+			Command: "aws logs delete-log-group --log-group-name /copilot/shop-test-api",
+			DocsURL: Docs(CodeResourceExists),
+		},
+		Err: cause,
+	}
+	wrapped := fmt.Errorf("deploy: %w", err)
+
+	require.Equal(t, "deploy service api: change set failed: Resource of type 'AWS::Logs::LogGroup' with identifier '/copilot/shop-test-api' already exists", err.Error())
+	require.ErrorIs(t, wrapped, cause)
+	require.Equal(t, "Delete the log group.\n"+
+		"Run `aws logs delete-log-group --log-group-name /copilot/shop-test-api`.\n"+
+		"See https://aws.github.io/copilot-cli/docs/developing/errors/#resource-exists.", err.RecommendActions())
+	require.Equal(t, `Error code: resource-exists
+Failed resource: LogGroup (AWS::Logs::LogGroup) in stack shop-test-api`, Details(wrapped))
+	require.Empty(t, Details(cause))
+	require.Equal(t, CodeResourceExists, CodeOf(wrapped))
+	require.Equal(t, CodeUnknown, CodeOf(cause))
+}
+
+func TestWriteJSON(t *testing.T) {
+	testCases := map[string]struct {
+		in     error
+		wanted string
+	}{
+		"structured error": {
+			in: fmt.Errorf("deploy: %w", &Error{
+				Code:    CodeStackFailed,
+				Message: "stack shop-test did not complete successfully and exited with status ROLLBACK_COMPLETE",
+				Resource: &Resource{
+					Stack:     "shop-test",
+					LogicalID: "Cluster",
+					Status:    "CREATE_FAILED",
+				},
+				Remediation: Remediation{
+					DocsURL: Docs(CodeStackFailed),
+				},
+			}),
+			wanted: `{"error":{"code":"stack-failed","message":"stack shop-test did not complete successfully and exited with status ROLLBACK_COMPLETE","resource":{"stack":"shop-test","logicalId":"Cluster","status":"CREATE_FAILED"},"remediation":{"docsUrl":"https://aws.github.io/copilot-cli/docs/developing/errors/#stack-failed"}}}
+`,
+		},
+		"other errors": {
+			in: errors.New("some error"),
+			wanted: `{"error":{"code":"unknown","message":"some error"}}
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			b := &strings.Builder{}
+
+			err := WriteJSON(b, tc.in)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, b.String())
+		})
+	}
+}
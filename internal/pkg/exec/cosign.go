@@ -0,0 +1,69 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+const (
+	cosignBinaryName = "cosign"
+	cosignKMSScheme  = "awskms:///"
+)
+
+// CosignCommand signs and verifies container images with the cosign CLI.
+type CosignCommand struct {
+	runner
+	region string // Region of the KMS keys that are not referenced by ARN.
+}
+
+// NewCosignCommand returns a CosignCommand that uses KMS keys in region.
+func NewCosignCommand(region string) CosignCommand {
+	return CosignCommand{
+		runner: NewCmd(),
+		region: region,
+	}
+}
+
+// Sign signs the image, referenced by digest, with the KMS key.
+// If kmsKey is empty, the image is signed keyless with a short-lived certificate issued for the OIDC identity of the caller.
+func (c CosignCommand) Sign(image, kmsKey string) error {
+	args := []string{"sign", "--yes"}
+	if kmsKey != "" {
+		args = append(args, "--key", cosignKMSScheme+kmsKey)
+	}
+	args = append(args, image)
+	if err := c.runner.Run(cosignBinaryName, args, c.env()); err != nil {
+		return fmt.Errorf("sign image %s with %s: %w", image, cosignBinaryName, err)
+	}
+	return nil
+}
+
+// Verify verifies the signature of the image with the KMS key.
+// If kmsKey is empty, the keyless signature of the image is verified against the public transparency log.
+func (c CosignCommand) Verify(image, kmsKey string) error {
+	args := []string{"verify"}
+	if kmsKey != "" {
+		args = append(args, "--key", cosignKMSScheme+kmsKey)
+	} else {
+		args = append(args, "--certificate-identity-regexp", ".*", "--certificate-oidc-issuer-regexp", ".*")
+	}
+	args = append(args, image)
+	var stderr bytes.Buffer
+	if err := c.runner.Run(cosignBinaryName, args, c.env(), Stdout(io.Discard), Stderr(&stderr)); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("verify image %s: %s", image, msg)
+		}
+		return fmt.Errorf("verify image %s: %w", image, err)
+	}
+	return nil
+}
+
+func (c CosignCommand) env() CmdOption {
+	return Env(append(os.Environ(), fmt.Sprintf("AWS_REGION=%s", c.region)))
+}
@@ -0,0 +1,111 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const mockImageDigest = "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:abc"
+
+func TestCosignCommand_Sign(t *testing.T) {
+	tests := map[string]struct {
+		inKMSKey    string
+		setupMocks  func(m *Mockrunner)
+		wantedError error
+	}{
+		"sign with a KMS key": {
+			inKMSKey: "alias/signing",
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run(cosignBinaryName,
+					[]string{"sign", "--yes", "--key", "awskms:///alias/signing", mockImageDigest}, gomock.Any()).Return(nil)
+			},
+		},
+		"sign keyless": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run(cosignBinaryName,
+					[]string{"sign", "--yes", mockImageDigest}, gomock.Any()).Return(nil)
+			},
+		},
+		"wrap the error if signing fails": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run(cosignBinaryName, gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("sign image " + mockImageDigest + " with cosign: some error"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := NewMockrunner(ctrl)
+			tc.setupMocks(m)
+			c := CosignCommand{
+				runner: m,
+				region: "us-west-2",
+			}
+
+			err := c.Sign(mockImageDigest, tc.inKMSKey)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCosignCommand_Verify(t *testing.T) {
+	tests := map[string]struct {
+		inKMSKey    string
+		setupMocks  func(m *Mockrunner)
+		wantedError error
+	}{
+		"verify with a KMS key": {
+			inKMSKey: "alias/signing",
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run(cosignBinaryName,
+					[]string{"verify", "--key", "awskms:///alias/signing", mockImageDigest}, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"verify keyless": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run(cosignBinaryName,
+					[]string{"verify", "--certificate-identity-regexp", ".*", "--certificate-oidc-issuer-regexp", ".*", mockImageDigest},
+					gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"wrap the error if verification fails": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run(cosignBinaryName, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("exit status 1"))
+			},
+			wantedError: errors.New("verify image " + mockImageDigest + ": exit status 1"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := NewMockrunner(ctrl)
+			tc.setupMocks(m)
+			c := CosignCommand{
+				runner: m,
+				region: "us-west-2",
+			}
+
+			err := c.Verify(mockImageDigest, tc.inKMSKey)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -71,6 +71,20 @@ func Stderr(writer io.Writer) CmdOption {
 	}
 }
 
+// Env sets the internal *exec.Cmd's Env field.
+func Env(env []string) CmdOption {
+	return func(c *exec.Cmd) {
+		c.Env = env
+	}
+}
+
+// Dir sets the internal *exec.Cmd's Dir field.
+func Dir(dir string) CmdOption {
+	return func(c *exec.Cmd) {
+		c.Dir = dir
+	}
+}
+
 // Run starts the named command and waits until it finishes.
 func (c *Cmd) Run(name string, args []string, opts ...CmdOption) error {
 	cmd := c.command(context.Background(), name, args, opts...)
@@ -0,0 +1,50 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	osexec "os/exec"
+	"strings"
+)
+
+const (
+	syftBinaryName   = "syft"
+	dockerBinaryName = "docker"
+)
+
+// SBOMCommand generates software bills of materials of container images with syft,
+// or with "docker sbom" if syft is not installed.
+type SBOMCommand struct {
+	runner
+	lookPath func(file string) (string, error)
+}
+
+// NewSBOMCommand returns a SBOMCommand.
+func NewSBOMCommand() SBOMCommand {
+	return SBOMCommand{
+		runner:   NewCmd(),
+		lookPath: osexec.LookPath,
+	}
+}
+
+// Generate writes the SBOM of the image in a registry to w.
+// The format is either "spdx" or "cyclonedx", and the document is encoded as JSON.
+func (c SBOMCommand) Generate(image, format string, w io.Writer) error {
+	output := fmt.Sprintf("%s-json", format)
+	name, args := syftBinaryName, []string{"registry:" + image, "--output", output, "--quiet"}
+	if _, err := c.lookPath(syftBinaryName); err != nil {
+		name, args = dockerBinaryName, []string{"sbom", "--format", output, image}
+	}
+	var stderr bytes.Buffer
+	if err := c.runner.Run(name, args, Stdout(w), Stderr(&stderr)); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("generate sbom of image %s with %s: %s", image, name, msg)
+		}
+		return fmt.Errorf("generate sbom of image %s with %s: %w", image, name, err)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package exec
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSBOMCommand_Generate(t *testing.T) {
+	tests := map[string]struct {
+		inSyftInstalled bool
+		setupMocks      func(m *Mockrunner)
+		wantedError     error
+	}{
+		"generate with syft if it is installed": {
+			inSyftInstalled: true,
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("syft", []string{"registry:" + mockImageDigest, "--output", "spdx-json", "--quiet"}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"fall back to docker sbom": {
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("docker", []string{"sbom", "--format", "spdx-json", mockImageDigest}, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"wrap the error if the sbom can't be generated": {
+			inSyftInstalled: true,
+			setupMocks: func(m *Mockrunner) {
+				m.EXPECT().Run("syft", gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("exit status 1"))
+			},
+			wantedError: errors.New("generate sbom of image " + mockImageDigest + " with syft: exit status 1"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := NewMockrunner(ctrl)
+			tc.setupMocks(m)
+			c := SBOMCommand{
+				runner: m,
+				lookPath: func(file string) (string, error) {
+					if tc.inSyftInstalled {
+						return "/usr/local/bin/" + file, nil
+					}
+					return "", errors.New("not found")
+				},
+			}
+
+			err := c.Generate(mockImageDigest, "spdx", &bytes.Buffer{})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
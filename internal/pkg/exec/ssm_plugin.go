@@ -13,8 +13,10 @@ import (
 	"os"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
 const (
@@ -59,6 +61,29 @@ func (s SSMPluginCommand) StartSession(ssmSess *ecs.Session) error {
 	return nil
 }
 
+// StartPortForwardingSession starts a port forwarding session using the ssm plugin.
+// The plugin keeps the session open and forwards traffic until it is interrupted.
+func (s SSMPluginCommand) StartPortForwardingSession(ssmSess *ssm.StartSessionOutput, in *ssm.StartSessionInput) error {
+	response, err := json.Marshal(ssmSess)
+	if err != nil {
+		return fmt.Errorf("marshal session response: %w", err)
+	}
+	request, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("marshal session request: %w", err)
+	}
+	region := aws.StringValue(s.sess.Config.Region)
+	endpoint, err := endpoints.DefaultResolver().EndpointFor(ssm.EndpointsID, region)
+	if err != nil {
+		return fmt.Errorf("resolve ssm endpoint in region %s: %w", region, err)
+	}
+	if err := s.runner.InteractiveRun(ssmPluginBinaryName,
+		[]string{string(response), region, startSessionAction, "", string(request), endpoint.URL}); err != nil {
+		return fmt.Errorf("start port forwarding session: %w", err)
+	}
+	return nil
+}
+
 func download(client httpClient, filepath string, url string) error {
 	resp, err := client.Get(url)
 	if err != nil {
@@ -0,0 +1,130 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package hooks runs the commands that the hooks section of the copilot/.workspace file declares
+// for the events of the lifecycle of a deployment, such as checking for a change ticket before deploying.
+package hooks
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
+)
+
+// Events of the lifecycle of a deployment.
+const (
+	PrePackage  = "pre-package"  // Before the template of a workload is generated by "package".
+	PostPackage = "post-package" // After the template of a workload is generated by "package".
+	PreDeploy   = "pre-deploy"   // Before a workload is deployed by "deploy".
+	PostDeploy  = "post-deploy"  // After a workload is deployed successfully by "deploy".
+)
+
+// Events is the list of events that hooks can be declared for.
+var Events = []string{PrePackage, PostPackage, PreDeploy, PostDeploy}
+
+// Environment variables that hooks are run with.
+const (
+	EventEnvVar       = "COPILOT_HOOK"
+	AppEnvVar         = "COPILOT_APPLICATION"
+	EnvironmentEnvVar = "COPILOT_ENVIRONMENT"
+	WorkloadEnvVar    = "COPILOT_WORKLOAD"
+)
+
+type summaryReader interface {
+	Summary() (*workspace.Summary, error)
+	Path() string
+}
+
+type cmdRunner interface {
+	Run(name string, args []string, options ...exec.CmdOption) error
+}
+
+// Runner runs the hooks of a workspace. A nil Runner runs no hook.
+type Runner struct {
+	ws  summaryReader
+	cmd cmdRunner
+}
+
+// Input is the deployment that the hooks are run for.
+type Input struct {
+	App         string
+	Environment string
+	Workload    string
+}
+
+// New returns a Runner for the hooks of the workspace.
+func New(ws summaryReader) *Runner {
+	return &Runner{
+		ws:  ws,
+		cmd: exec.NewCmd(),
+	}
+}
+
+// Run runs the commands of the event one after the other from the root of the workspace,
+// and returns an error as soon as one of them fails.
+func (r *Runner) Run(event string, in Input) error {
+	if r == nil {
+		return nil
+	}
+	summary, err := r.ws.Summary()
+	if err != nil {
+		return fmt.Errorf("read hooks of the workspace: %w", err)
+	}
+	if err := validate(summary.Hooks); err != nil {
+		return err
+	}
+	env := append(os.Environ(),
+		EventEnvVar+"="+event,
+		AppEnvVar+"="+in.App,
+		EnvironmentEnvVar+"="+in.Environment,
+		WorkloadEnvVar+"="+in.Workload,
+	)
+	for _, command := range summary.Hooks[event] {
+		log.Infof("Running %s hook %s\n", event, color.HighlightCode(command))
+		name, args := shell(command)
+		if err := r.cmd.Run(name, args, exec.Env(env), exec.Dir(r.ws.Path())); err != nil {
+			return fmt.Errorf("run %s hook %q: %w", event, command, err)
+		}
+	}
+	return nil
+}
+
+func validate(hooks map[string][]string) error {
+	var unknown []string
+	for event := range hooks {
+		if !isEvent(event) {
+			unknown = append(unknown, event)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	sort.Strings(unknown)
+	return fmt.Errorf(`unknown %s %s in the hooks of the workspace: must be one of %s`,
+		english.PluralWord(len(unknown), "event", "events"), strings.Join(unknown, ", "), english.WordSeries(Events, "or"))
+}
+
+func isEvent(name string) bool {
+	for _, event := range Events {
+		if event == name {
+			return true
+		}
+	}
+	return false
+}
+
+// shell returns the command that runs the hook in the shell of the platform.
+func shell(command string) (string, []string) {
+	if runtime.GOOS == "windows" {
+		return "cmd", []string{"/C", command}
+	}
+	return "sh", []string{"-c", command}
+}
@@ -0,0 +1,114 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package hooks
+
+import (
+	"errors"
+	osexec "os/exec"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeWorkspace struct {
+	summary *workspace.Summary
+	err     error
+}
+
+func (ws fakeWorkspace) Summary() (*workspace.Summary, error) { return ws.summary, ws.err }
+func (ws fakeWorkspace) Path() string                         { return "/projects/demo" }
+
+type fakeCmd struct {
+	ran  []*osexec.Cmd
+	fail string
+}
+
+func (c *fakeCmd) Run(name string, args []string, options ...exec.CmdOption) error {
+	cmd := osexec.Command(name, args...)
+	for _, opt := range options {
+		opt(cmd)
+	}
+	c.ran = append(c.ran, cmd)
+	if args[len(args)-1] == c.fail {
+		return errors.New("exit status 1")
+	}
+	return nil
+}
+
+func TestRunner_Run(t *testing.T) {
+	testCases := map[string]struct {
+		ws   fakeWorkspace
+		fail string
+
+		wantedCommands []string
+		wantedErr      string
+	}{
+		"no hooks": {
+			ws: fakeWorkspace{summary: &workspace.Summary{Application: "demo"}},
+		},
+		"hooks of the event in order": {
+			ws: fakeWorkspace{summary: &workspace.Summary{
+				Application: "demo",
+				Hooks: map[string][]string{
+					PreDeploy:  {"./scripts/check-ticket.sh", "echo ok"},
+					PostDeploy: {"./scripts/register.sh"},
+				},
+			}},
+			wantedCommands: []string{"./scripts/check-ticket.sh", "echo ok"},
+		},
+		"stops at the first failure": {
+			ws: fakeWorkspace{summary: &workspace.Summary{
+				Hooks: map[string][]string{
+					PreDeploy: {"./scripts/check-ticket.sh", "echo ok"},
+				},
+			}},
+			fail:           "./scripts/check-ticket.sh",
+			wantedCommands: []string{"./scripts/check-ticket.sh"},
+			wantedErr:      `run pre-deploy hook "./scripts/check-ticket.sh": exit status 1`,
+		},
+		"unknown events": {
+			ws: fakeWorkspace{summary: &workspace.Summary{
+				Hooks: map[string][]string{
+					"predeploy":  {"echo"},
+					"post-build": {"echo"},
+					PreDeploy:    {"echo"},
+				},
+			}},
+			wantedErr: "unknown events post-build, predeploy in the hooks of the workspace: must be one of pre-package, post-package, pre-deploy or post-deploy",
+		},
+		"error reading the workspace": {
+			ws:        fakeWorkspace{err: errors.New("some error")},
+			wantedErr: "read hooks of the workspace: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cmd := &fakeCmd{fail: tc.fail}
+			r := &Runner{ws: tc.ws, cmd: cmd}
+
+			err := r.Run(PreDeploy, Input{App: "demo", Environment: "test", Workload: "api"})
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			var commands []string
+			for _, c := range cmd.ran {
+				commands = append(commands, c.Args[len(c.Args)-1])
+				require.Equal(t, "/projects/demo", c.Dir)
+				require.Subset(t, c.Env, []string{"COPILOT_HOOK=pre-deploy", "COPILOT_APPLICATION=demo", "COPILOT_ENVIRONMENT=test", "COPILOT_WORKLOAD=api"})
+			}
+			require.Equal(t, tc.wantedCommands, commands)
+		})
+	}
+}
+
+func TestRunner_Run_Nil(t *testing.T) {
+	var r *Runner
+
+	require.NoError(t, r.Run(PreDeploy, Input{}))
+}
@@ -12,6 +12,7 @@ import (
 
 type sectionsParser interface {
 	Sections() []*ini.Section
+	GetSection(name string) (*ini.Section, error)
 }
 
 // INI represents a parsed INI file in memory.
@@ -50,3 +51,15 @@ func (i *INI) Sections() []string {
 	}
 	return names
 }
+
+// Get returns the value of the key in the section, and false if the section or the key doesn't exist.
+func (i *INI) Get(section, key string) (string, bool) {
+	sec, err := i.cfg.GetSection(section)
+	if err != nil {
+		return "", false
+	}
+	if !sec.HasKey(key) {
+		return "", false
+	}
+	return sec.Key(key).String(), true
+}
@@ -28,3 +28,24 @@ protocol = http
 	// THEN
 	require.Equal(t, []string{"paths", "server"}, actualNames)
 }
+
+func TestINI_Get(t *testing.T) {
+	// GIVEN
+	content := `[profile admin]
+role_arn = arn:aws:iam::123456789012:role/Admin
+source_profile = default
+`
+	cfg, _ := ini.Load([]byte(content))
+	ini := &INI{cfg: cfg}
+
+	// WHEN
+	roleARN, hasRoleARN := ini.Get("profile admin", "role_arn")
+	_, hasRegion := ini.Get("profile admin", "region")
+	_, hasSection := ini.Get("profile dev", "role_arn")
+
+	// THEN
+	require.Equal(t, "arn:aws:iam::123456789012:role/Admin", roleARN)
+	require.True(t, hasRoleARN)
+	require.False(t, hasRegion)
+	require.False(t, hasSection)
+}
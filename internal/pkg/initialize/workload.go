@@ -5,6 +5,7 @@
 package initialize
 
 import (
+	"bytes"
 	"encoding"
 	"fmt"
 	"os"
@@ -18,6 +19,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -66,6 +68,7 @@ type WorkloadProps struct {
 	Topics                  []manifest.TopicSubscription
 	Queue                   manifest.SQSQueue
 	PrivateOnlyEnvironments []string
+	ImageRetention          *config.ImageRetention // Overrides the lifecycle settings of the application for the workload's ECR repository.
 }
 
 // JobProps contains the information needed to represent a Job.
@@ -85,6 +88,10 @@ type ServiceProps struct {
 	Private     bool
 	appDomain   *string
 	FileUploads []manifest.FileUpload
+
+	// ManifestOverrides is a YAML document merged into the generated manifest,
+	// for the fields that the manifest templates don't render, such as the variables or the storage.
+	ManifestOverrides []byte
 }
 
 // WorkloadInitializer holds the clients necessary to initialize either a
@@ -107,14 +114,18 @@ func (w *WorkloadInitializer) Job(i *JobProps) (string, error) {
 }
 
 func (w *WorkloadInitializer) addWlToApp(app *config.Application, props WorkloadProps, wlType string) error {
+	var opts []cloudformation.AddWorkloadToAppOpt
+	if props.ImageRetention != nil {
+		opts = append(opts, cloudformation.AddWorkloadToAppOptWithImageRetention(props.ImageRetention))
+	}
 	switch wlType {
 	case svcWlType:
 		if props.Type == manifestinfo.StaticSiteType {
 			return w.Deployer.AddServiceToApp(app, props.Name, cloudformation.AddWorkloadToAppOptWithoutECR)
 		}
-		return w.Deployer.AddServiceToApp(app, props.Name)
+		return w.Deployer.AddServiceToApp(app, props.Name, opts...)
 	case jobWlType:
-		return w.Deployer.AddJobToApp(app, props.Name)
+		return w.Deployer.AddJobToApp(app, props.Name, opts...)
 	default:
 		return fmt.Errorf(fmtErrUnrecognizedWlType, wlType)
 	}
@@ -207,6 +218,9 @@ func (w *WorkloadInitializer) initService(props *ServiceProps) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if len(props.ManifestOverrides) > 0 {
+		mf = &overriddenManifest{manifest: mf, overrides: props.ManifestOverrides}
+	}
 	manifestPath, err := w.Ws.WriteServiceManifest(mf, props.Name)
 	if err != nil {
 		e, ok := err.(*workspace.ErrFileExists)
@@ -281,6 +295,17 @@ func newJobManifest(i *JobProps) (encoding.BinaryMarshaler, error) {
 			Timeout:     i.Timeout,
 			Retries:     i.Retries,
 		}), nil
+	case manifestinfo.WorkflowJobType:
+		return manifest.NewWorkflowJob(&manifest.WorkflowJobProps{
+			WorkloadProps: &manifest.WorkloadProps{
+				Name:                    i.Name,
+				Dockerfile:              i.DockerfilePath,
+				Image:                   i.Image,
+				PrivateOnlyEnvironments: i.PrivateOnlyEnvironments,
+			},
+			Platform: i.Platform,
+			Schedule: i.Schedule,
+		}), nil
 	default:
 		return nil, fmt.Errorf("job type %s doesn't have a manifest", i.Type)
 
@@ -299,6 +324,8 @@ func (w *WorkloadInitializer) newServiceManifest(i *ServiceProps) (encoding.Bina
 		return newWorkerServiceManifest(i)
 	case manifestinfo.StaticSiteType:
 		return newStaticSiteServiceManifest(i)
+	case manifestinfo.LambdaServiceType:
+		return newLambdaServiceManifest(i), nil
 	default:
 		return nil, fmt.Errorf("service type %s doesn't have a manifest", i.Type)
 	}
@@ -348,6 +375,20 @@ func newRequestDrivenWebServiceManifest(i *ServiceProps) *manifest.RequestDriven
 	return manifest.NewRequestDrivenWebService(props)
 }
 
+// newLambdaServiceManifest routes the requests to the service name path of the environment load balancer,
+// so that the function doesn't take over the root path of a Load Balanced Web Service.
+func newLambdaServiceManifest(i *ServiceProps) *manifest.LambdaService {
+	return manifest.NewLambdaService(&manifest.LambdaServiceProps{
+		WorkloadProps: &manifest.WorkloadProps{
+			Name:       i.Name,
+			Dockerfile: i.DockerfilePath,
+			Image:      i.Image,
+		},
+		Path:     i.Name,
+		Platform: i.Platform,
+	})
+}
+
 func (w *WorkloadInitializer) newBackendServiceManifest(i *ServiceProps) (*manifest.BackendService, error) {
 	outProps := manifest.BackendServiceProps{
 		WorkloadProps: manifest.WorkloadProps{
@@ -388,6 +429,37 @@ func newStaticSiteServiceManifest(i *ServiceProps) (*manifest.StaticSite, error)
 	}), nil
 }
 
+// overriddenManifest is a manifest with a YAML document merged on top of it.
+type overriddenManifest struct {
+	manifest  encoding.BinaryMarshaler
+	overrides []byte
+}
+
+// MarshalBinary merges the overrides into the marshaled manifest, keeping the comments of the manifest.
+func (m *overriddenManifest) MarshalBinary() ([]byte, error) {
+	raw, err := m.manifest.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	var base, overrides yaml.Node
+	if err := yaml.Unmarshal(raw, &base); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+	if err := yaml.Unmarshal(m.overrides, &overrides); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest overrides: %w", err)
+	}
+	// Keep the document node of the manifest, which holds the comments at the top and the bottom of the file.
+	doc := base
+	doc.Content = []*yaml.Node{manifest.MergeYAML(&base, &overrides)}
+	var out bytes.Buffer
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, fmt.Errorf("marshal manifest with overrides: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
 // Copy of cli.displayPath
 func displayPath(target string) string {
 	if !filepath.IsAbs(target) {
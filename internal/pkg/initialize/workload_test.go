@@ -3,6 +3,7 @@
 package initialize
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
 	"testing"
@@ -110,6 +111,42 @@ func TestWorkloadInitializer_Job(t *testing.T) {
 				}, "resizer")
 			},
 		},
+		"writes Workflow Job manifest with a single step": {
+			inJobType: manifestinfo.WorkflowJobType,
+			inAppName: "app",
+			inJobName: "etl",
+			inImage:   "mockImage",
+
+			inSchedule: "@daily",
+
+			mockWriter: func(m *mocks.MockWorkspace) {
+				m.EXPECT().Rel("/etl/manifest.yml").Return("manifest.yml", nil)
+				m.EXPECT().WriteJobManifest(gomock.Any(), "etl").Do(func(m *manifest.WorkflowJob, _ string) {
+					require.Equal(t, manifestinfo.WorkflowJobType, aws.StringValue(m.Workload.Type))
+					require.Equal(t, "@daily", aws.StringValue(m.On.Schedule))
+					require.Len(t, m.Steps, 1)
+					require.Equal(t, "etl", aws.StringValue(m.Steps[0].Name))
+					require.Equal(t, "mockImage", aws.StringValue(m.Steps[0].ImageConfig.Image.Location))
+				}).Return("/etl/manifest.yml", nil)
+			},
+			mockstore: func(m *mocks.MockStore) {
+				m.EXPECT().CreateJob(&config.Workload{
+					Name: "etl",
+					App:  "app",
+					Type: manifestinfo.WorkflowJobType,
+				}).Return(nil)
+				m.EXPECT().GetApplication("app").Return(&config.Application{
+					Name:      "app",
+					AccountID: "1234",
+				}, nil)
+			},
+			mockappDeployer: func(m *mocks.MockWorkloadAdder) {
+				m.EXPECT().AddJobToApp(&config.Application{
+					Name:      "app",
+					AccountID: "1234",
+				}, "etl")
+			},
+		},
 		"write manifest error": {
 			inJobType:        manifestinfo.ScheduledJobType,
 			inAppName:        "app",
@@ -524,6 +561,47 @@ func TestWorkloadInitializer_Service(t *testing.T) {
 				}, "static", gomock.Any())
 			},
 		},
+		"writes Lambda Service manifest, and creates repositories successfully": {
+			inSvcType:        manifestinfo.LambdaServiceType,
+			inAppName:        "app",
+			inSvcName:        "api",
+			inDockerfilePath: "api/Dockerfile",
+
+			mockWriter: func(m *mocks.MockWorkspace) {
+				// workspace root: "/api"
+				gomock.InOrder(
+					m.EXPECT().Rel("api/Dockerfile").Return("Dockerfile", nil),
+					m.EXPECT().Rel("/api/manifest.yml").Return("manifest.yml", nil))
+				m.EXPECT().WriteServiceManifest(gomock.Any(), "api").
+					Do(func(mft encoding.BinaryMarshaler, _ string) {
+						svc, ok := mft.(*manifest.LambdaService)
+						require.True(t, ok)
+						require.Equal(t, aws.String("api"), svc.HTTP.Path)
+					}).
+					Return("/api/manifest.yml", nil)
+			},
+			mockstore: func(m *mocks.MockStore) {
+				m.EXPECT().CreateService(gomock.Any()).
+					Do(func(app *config.Workload) {
+						require.Equal(t, &config.Workload{
+							Name: "api",
+							App:  "app",
+							Type: manifestinfo.LambdaServiceType,
+						}, app)
+					}).
+					Return(nil)
+				m.EXPECT().GetApplication("app").Return(&config.Application{
+					Name:      "app",
+					AccountID: "1234",
+				}, nil)
+			},
+			mockappDeployer: func(m *mocks.MockWorkloadAdder) {
+				m.EXPECT().AddServiceToApp(&config.Application{
+					Name:      "app",
+					AccountID: "1234",
+				}, "api")
+			},
+		},
 		"app error": {
 			inSvcType:        manifestinfo.LoadBalancedWebServiceType,
 			inAppName:        "app",
@@ -888,3 +966,37 @@ func TestWorkloadInitializer_Service(t *testing.T) {
 		})
 	}
 }
+
+func TestOverriddenManifest_MarshalBinary(t *testing.T) {
+	// GIVEN
+	mft := manifest.NewBackendService(manifest.BackendServiceProps{
+		WorkloadProps: manifest.WorkloadProps{
+			Name:       "api",
+			Dockerfile: "api/Dockerfile",
+		},
+		Port: 3000,
+	})
+	base, err := mft.MarshalBinary()
+	require.NoError(t, err)
+	m := &overriddenManifest{
+		manifest: mft,
+		overrides: []byte(`image:
+  build:
+    dockerfile: api/Dockerfile
+    context: .
+variables:
+  LOG_LEVEL: debug
+`),
+	}
+
+	// WHEN
+	got, err := m.MarshalBinary()
+
+	// THEN
+	require.NoError(t, err)
+	require.Contains(t, string(base), "# The manifest for the \"api\" service.")
+	require.Contains(t, string(got), "# The manifest for the \"api\" service.", "keeps the comments of the manifest")
+	require.Contains(t, string(got), "    dockerfile: api/Dockerfile\n    context: .\n")
+	require.Contains(t, string(got), "  port: 3000")
+	require.Contains(t, string(got), "variables:\n  LOG_LEVEL: debug\n")
+}
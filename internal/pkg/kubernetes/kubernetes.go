@@ -0,0 +1,703 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package kubernetes converts the Deployments of Kubernetes manifests into Copilot services.
+//
+// A Deployment becomes a Load Balanced Web Service if an Ingress or a Service of type LoadBalancer routes traffic to
+// its pods, and a Backend Service otherwise. The ConfigMaps referenced by the containers are inlined as environment
+// variables. Every field that has no equivalent in a Copilot manifest is reported as a warning.
+package kubernetes
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	kindDeployment = "Deployment"
+	kindService    = "Service"
+	kindIngress    = "Ingress"
+	kindConfigMap  = "ConfigMap"
+
+	serviceTypeLoadBalancer = "LoadBalancer"
+)
+
+// Fields of the specs that are converted to manifest fields.
+var (
+	convertedDeploymentFields = map[string]bool{"replicas": true, "selector": true, "template": true}
+	convertedPodFields        = map[string]bool{"containers": true, "volumes": true}
+	convertedContainerFields  = map[string]bool{
+		"name": true, "image": true, "command": true, "args": true, "ports": true, "env": true, "envFrom": true,
+		"resources": true, "livenessProbe": true, "readinessProbe": true, "volumeMounts": true,
+	}
+)
+
+var (
+	// Copilot workload names are lowercase alphanumeric words separated by hyphens.
+	invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+	// Volume names are used in the logical IDs of the CloudFormation resources, so they are alphanumeric only.
+	invalidVolumeChars = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+	// Fargate task sizes, in CPU units.
+	fargateCPUs = []int{256, 512, 1024, 2048, 4096, 8192, 16384}
+)
+
+// Project holds the Deployments of Kubernetes manifests converted to Copilot services.
+type Project struct {
+	Services []*Service
+	Warnings []string // Objects and fields that could not be converted.
+}
+
+// Service is a Deployment converted to a Copilot service.
+type Service struct {
+	Name        string
+	Type        string
+	Image       string
+	Port        uint16
+	HealthCheck manifest.ContainerHealthCheck
+
+	count      *int
+	cpu        int
+	memory     int
+	path       string // Path of the Ingress rule that routes to the service.
+	httpCheck  string // Path of the HTTP probe of the container.
+	command    []string
+	entrypoint []string
+	variables  map[string]string
+	volumes    []volume
+	sidecars   []sidecar
+}
+
+type volume struct {
+	name     string
+	path     string
+	readOnly bool
+	efs      bool
+}
+
+type sidecar struct {
+	name       string
+	image      string
+	port       uint16
+	command    []string
+	entrypoint []string
+	variables  map[string]string
+}
+
+type object struct {
+	Kind     string            `yaml:"kind"`
+	Metadata metadata          `yaml:"metadata"`
+	Spec     yaml.Node         `yaml:"spec"`
+	Data     map[string]string `yaml:"data"`
+}
+
+type metadata struct {
+	Name   string            `yaml:"name"`
+	Labels map[string]string `yaml:"labels"`
+}
+
+type deploymentSpec struct {
+	Replicas *int `yaml:"replicas"`
+	Template struct {
+		Metadata metadata  `yaml:"metadata"`
+		Spec     yaml.Node `yaml:"spec"`
+	} `yaml:"template"`
+}
+
+type podSpec struct {
+	Containers []yaml.Node `yaml:"containers"`
+	Volumes    []podVolume `yaml:"volumes"`
+}
+
+type podVolume struct {
+	Name                  string    `yaml:"name"`
+	EmptyDir              yaml.Node `yaml:"emptyDir"`
+	PersistentVolumeClaim yaml.Node `yaml:"persistentVolumeClaim"`
+}
+
+type container struct {
+	Name    string   `yaml:"name"`
+	Image   string   `yaml:"image"`
+	Command []string `yaml:"command"`
+	Args    []string `yaml:"args"`
+	Ports   []struct {
+		ContainerPort uint16 `yaml:"containerPort"`
+		Name          string `yaml:"name"`
+		Protocol      string `yaml:"protocol"`
+	} `yaml:"ports"`
+	Env []struct {
+		Name      string  `yaml:"name"`
+		Value     *string `yaml:"value"`
+		ValueFrom struct {
+			ConfigMapKeyRef *keyRef `yaml:"configMapKeyRef"`
+			SecretKeyRef    *keyRef `yaml:"secretKeyRef"`
+		} `yaml:"valueFrom"`
+	} `yaml:"env"`
+	EnvFrom []struct {
+		ConfigMapRef *keyRef `yaml:"configMapRef"`
+		SecretRef    *keyRef `yaml:"secretRef"`
+	} `yaml:"envFrom"`
+	Resources struct {
+		Requests map[string]string `yaml:"requests"`
+		Limits   map[string]string `yaml:"limits"`
+	} `yaml:"resources"`
+	LivenessProbe  *probe `yaml:"livenessProbe"`
+	ReadinessProbe *probe `yaml:"readinessProbe"`
+	VolumeMounts   []struct {
+		Name      string `yaml:"name"`
+		MountPath string `yaml:"mountPath"`
+		ReadOnly  bool   `yaml:"readOnly"`
+	} `yaml:"volumeMounts"`
+}
+
+type keyRef struct {
+	Name string `yaml:"name"`
+	Key  string `yaml:"key"`
+}
+
+type probe struct {
+	Exec *struct {
+		Command []string `yaml:"command"`
+	} `yaml:"exec"`
+	HTTPGet *struct {
+		Path string `yaml:"path"`
+	} `yaml:"httpGet"`
+	InitialDelaySeconds *int `yaml:"initialDelaySeconds"`
+	PeriodSeconds       *int `yaml:"periodSeconds"`
+	TimeoutSeconds      *int `yaml:"timeoutSeconds"`
+	FailureThreshold    *int `yaml:"failureThreshold"`
+}
+
+type serviceSpec struct {
+	Type     string            `yaml:"type"`
+	Selector map[string]string `yaml:"selector"`
+	Ports    []struct {
+		Port       uint16    `yaml:"port"`
+		TargetPort yaml.Node `yaml:"targetPort"`
+	} `yaml:"ports"`
+}
+
+type ingressSpec struct {
+	Rules []struct {
+		Host string `yaml:"host"`
+		HTTP struct {
+			Paths []struct {
+				Path    string `yaml:"path"`
+				Backend struct {
+					Service struct {
+						Name string `yaml:"name"`
+					} `yaml:"service"`
+				} `yaml:"backend"`
+			} `yaml:"paths"`
+		} `yaml:"http"`
+	} `yaml:"rules"`
+}
+
+// ingressRoute is a path of an Ingress and the Kubernetes Service that it routes to.
+type ingressRoute struct {
+	ingress string
+	host    string
+	path    string
+}
+
+// Parse converts the Deployments of Kubernetes manifests, each of which can hold several YAML documents.
+func Parse(manifests ...[]byte) (*Project, error) {
+	var objects []object
+	for _, content := range manifests {
+		dec := yaml.NewDecoder(bytes.NewReader(content))
+		for {
+			var obj object
+			err := dec.Decode(&obj)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("unmarshal Kubernetes manifest: %w", err)
+			}
+			if obj.Kind != "" {
+				objects = append(objects, obj)
+			}
+		}
+	}
+	p := &Project{}
+	configMaps := make(map[string]map[string]string)
+	var deployments, services []object
+	routes := make(map[string][]ingressRoute) // Name of the Kubernetes Service to the Ingress paths that route to it.
+	for _, obj := range objects {
+		switch obj.Kind {
+		case kindConfigMap:
+			configMaps[obj.Metadata.Name] = obj.Data
+		case kindDeployment:
+			deployments = append(deployments, obj)
+		case kindService:
+			services = append(services, obj)
+		case kindIngress:
+			var spec ingressSpec
+			if err := obj.Spec.Decode(&spec); err != nil {
+				return nil, fmt.Errorf("unmarshal spec of Ingress %q: %w", obj.Metadata.Name, err)
+			}
+			for _, rule := range spec.Rules {
+				for _, path := range rule.HTTP.Paths {
+					name := path.Backend.Service.Name
+					routes[name] = append(routes[name], ingressRoute{ingress: obj.Metadata.Name, host: rule.Host, path: path.Path})
+				}
+			}
+		default:
+			p.warnf("%s %q is not converted: only Deployments, Services, Ingresses and ConfigMaps are", obj.Kind, obj.Metadata.Name)
+		}
+	}
+	if len(deployments) == 0 {
+		return nil, errors.New("Kubernetes manifests have no Deployment")
+	}
+	names := make(map[string]string)
+	for _, obj := range deployments {
+		svc, err := p.convertDeployment(obj, services, routes, configMaps)
+		if err != nil {
+			return nil, err
+		}
+		if other, ok := names[svc.Name]; ok {
+			return nil, fmt.Errorf("Deployments %q and %q both convert to the name %q", other, obj.Metadata.Name, svc.Name)
+		}
+		names[svc.Name] = obj.Metadata.Name
+		p.Services = append(p.Services, svc)
+	}
+	return p, nil
+}
+
+func (p *Project) convertDeployment(obj object, services []object, routes map[string][]ingressRoute, configMaps map[string]map[string]string) (*Service, error) {
+	name := obj.Metadata.Name
+	var spec deploymentSpec
+	if err := obj.Spec.Decode(&spec); err != nil {
+		return nil, fmt.Errorf("unmarshal spec of Deployment %q: %w", name, err)
+	}
+	p.warnUnconverted(name, "spec", &obj.Spec, convertedDeploymentFields)
+	p.warnUnconverted(name, "spec.template.spec", &spec.Template.Spec, convertedPodFields)
+	var pod podSpec
+	if err := spec.Template.Spec.Decode(&pod); err != nil {
+		return nil, fmt.Errorf("unmarshal pod spec of Deployment %q: %w", name, err)
+	}
+	if len(pod.Containers) == 0 {
+		return nil, fmt.Errorf("Deployment %q has no containers", name)
+	}
+	containers := make([]container, len(pod.Containers))
+	for i := range pod.Containers {
+		if err := pod.Containers[i].Decode(&containers[i]); err != nil {
+			return nil, fmt.Errorf("unmarshal container of Deployment %q: %w", name, err)
+		}
+		p.warnUnconverted(name, fmt.Sprintf("container %q", containers[i].Name), &pod.Containers[i], convertedContainerFields)
+	}
+	main := containers[0]
+	svc := &Service{
+		Name:       workloadName(name),
+		Type:       manifestinfo.BackendServiceType,
+		Image:      main.Image,
+		count:      spec.Replicas,
+		command:    main.Args,
+		entrypoint: main.Command,
+	}
+	if svc.Name != name {
+		p.warnf("Deployment %q is renamed to %q, as Copilot names contain only lowercase letters, digits and hyphens", name, svc.Name)
+	}
+	if len(main.Ports) > 0 {
+		svc.Port = main.Ports[0].ContainerPort
+	}
+	svc.variables = p.variables(name, main, configMaps)
+	if err := p.convertResources(svc, name, main); err != nil {
+		return nil, err
+	}
+	if err := p.convertProbes(svc, name, main); err != nil {
+		return nil, err
+	}
+	p.convertVolumes(svc, name, main, pod.Volumes)
+	for _, c := range containers[1:] {
+		sc := sidecar{
+			name:       c.Name,
+			image:      c.Image,
+			command:    c.Args,
+			entrypoint: c.Command,
+			variables:  p.variables(name, c, configMaps),
+		}
+		if len(c.Ports) > 0 {
+			sc.port = c.Ports[0].ContainerPort
+		}
+		svc.sidecars = append(svc.sidecars, sc)
+	}
+	p.convertNetworking(svc, name, spec.Template.Metadata.Labels, main, services, routes)
+	return svc, nil
+}
+
+// convertNetworking sets the type and the port of the service from the Kubernetes Services that select its pods.
+func (p *Project) convertNetworking(svc *Service, name string, podLabels map[string]string, main container, services []object, routes map[string][]ingressRoute) {
+	for _, obj := range services {
+		var spec serviceSpec
+		if err := obj.Spec.Decode(&spec); err != nil || len(spec.Selector) == 0 || !matches(spec.Selector, podLabels) {
+			continue
+		}
+		if len(spec.Ports) > 0 {
+			if port, ok := targetPort(&spec.Ports[0].TargetPort, spec.Ports[0].Port, main); ok {
+				svc.Port = port
+			}
+		}
+		svcRoutes := routes[obj.Metadata.Name]
+		if len(svcRoutes) == 0 && spec.Type != serviceTypeLoadBalancer {
+			continue
+		}
+		svc.Type = manifestinfo.LoadBalancedWebServiceType
+		if len(svcRoutes) == 0 {
+			return
+		}
+		route := svcRoutes[0]
+		svc.path = strings.TrimPrefix(route.path, "/")
+		if svc.path == "" {
+			svc.path = "/"
+		}
+		if route.host != "" {
+			p.warnf(`Deployment %q: the host %q of Ingress %q is not converted, set "http.alias" once the application has a domain`, name, route.host, route.ingress)
+		}
+		if len(svcRoutes) > 1 {
+			p.warnf(`Deployment %q: only the path %q of Ingress %q is converted, add the other paths to the manifest by hand`, name, route.path, route.ingress)
+		}
+		return
+	}
+}
+
+// targetPort returns the container port of a port of a Kubernetes Service, which can refer to the name of the port.
+func targetPort(node *yaml.Node, port uint16, c container) (uint16, bool) {
+	if node.IsZero() {
+		return port, port != 0
+	}
+	if n, err := strconv.ParseUint(node.Value, 10, 16); err == nil {
+		return uint16(n), true
+	}
+	for _, p := range c.Ports {
+		if p.Name == node.Value {
+			return p.ContainerPort, true
+		}
+	}
+	return 0, false
+}
+
+func (p *Project) variables(deployment string, c container, configMaps map[string]map[string]string) map[string]string {
+	vars := make(map[string]string)
+	for _, from := range c.EnvFrom {
+		switch {
+		case from.ConfigMapRef != nil:
+			data, ok := configMaps[from.ConfigMapRef.Name]
+			if !ok {
+				p.warnf("Deployment %q: ConfigMap %q is not in the manifests, set its variables in the manifest", deployment, from.ConfigMapRef.Name)
+			}
+			for k, v := range data {
+				vars[k] = v
+			}
+		case from.SecretRef != nil:
+			p.warnf(`Deployment %q: Secret %q is not converted, store its values with "copilot secret init" and add them to "secrets"`, deployment, from.SecretRef.Name)
+		}
+	}
+	for _, env := range c.Env {
+		switch ref := env.ValueFrom; {
+		case env.Value != nil:
+			vars[env.Name] = *env.Value
+		case ref.ConfigMapKeyRef != nil:
+			value, ok := configMaps[ref.ConfigMapKeyRef.Name][ref.ConfigMapKeyRef.Key]
+			if !ok {
+				p.warnf("Deployment %q: variable %q refers to a key of ConfigMap %q that is not in the manifests", deployment, env.Name, ref.ConfigMapKeyRef.Name)
+				continue
+			}
+			vars[env.Name] = value
+		case ref.SecretKeyRef != nil:
+			p.warnf(`Deployment %q: variable %q from Secret %q is not converted, store it with "copilot secret init" and add it to "secrets"`, deployment, env.Name, ref.SecretKeyRef.Name)
+		default:
+			p.warnf("Deployment %q: variable %q is not converted, as its value comes from the pod", deployment, env.Name)
+		}
+	}
+	if len(vars) == 0 {
+		return nil
+	}
+	return vars
+}
+
+// convertResources converts the resources of the container to the closest Fargate task size that fits them.
+func (p *Project) convertResources(svc *Service, deployment string, c container) error {
+	resources := c.Resources.Requests
+	if len(resources) == 0 {
+		resources = c.Resources.Limits
+	}
+	cpuIn, memoryIn := resources["cpu"], resources["memory"]
+	if cpuIn == "" && memoryIn == "" {
+		return nil
+	}
+	var cpu, memory int
+	if cpuIn != "" {
+		millicores, err := parseCPU(cpuIn)
+		if err != nil {
+			return fmt.Errorf("parse CPU of Deployment %q: %w", deployment, err)
+		}
+		cpu = (millicores*1024 + 999) / 1000
+	}
+	if memoryIn != "" {
+		mib, err := parseMemory(memoryIn)
+		if err != nil {
+			return fmt.Errorf("parse memory of Deployment %q: %w", deployment, err)
+		}
+		memory = mib
+	}
+	// Fargate requires between 2 and 8 GiB of memory per vCPU, except for the smallest size.
+	if cpu < memory/8 {
+		cpu = memory / 8
+	}
+	svc.cpu = fargateCPUs[len(fargateCPUs)-1]
+	for _, size := range fargateCPUs {
+		if size >= cpu {
+			svc.cpu = size
+			break
+		}
+	}
+	minMemory := 2 * svc.cpu
+	if svc.cpu == fargateCPUs[0] {
+		minMemory = 512
+	}
+	if memory < minMemory {
+		memory = minMemory
+	}
+	svc.memory = (memory + 1023) / 1024 * 1024
+	if memory <= 512 {
+		svc.memory = 512
+	}
+	p.warnf("Deployment %q: the resources of the container are rounded up to the Fargate task size of %d CPU units and %d MiB", deployment, svc.cpu, svc.memory)
+	return nil
+}
+
+// parseCPU returns the millicores of a Kubernetes CPU quantity such as "250m" or "0.5".
+func parseCPU(in string) (int, error) {
+	if strings.HasSuffix(in, "m") {
+		return strconv.Atoi(strings.TrimSuffix(in, "m"))
+	}
+	cores, err := strconv.ParseFloat(in, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid CPU quantity %q", in)
+	}
+	return int(cores * 1000), nil
+}
+
+// parseMemory returns the MiB of a Kubernetes memory quantity such as "512Mi", "1Gi" or "500M".
+func parseMemory(in string) (int, error) {
+	units := []struct {
+		suffix string
+		bytes  float64
+	}{
+		{"Ki", 1 << 10}, {"Mi", 1 << 20}, {"Gi", 1 << 30}, {"Ti", 1 << 40},
+		{"k", 1e3}, {"M", 1e6}, {"G", 1e9}, {"T", 1e12},
+	}
+	multiplier, number := 1.0, in
+	for _, u := range units {
+		if strings.HasSuffix(in, u.suffix) {
+			multiplier, number = u.bytes, strings.TrimSuffix(in, u.suffix)
+			break
+		}
+	}
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid memory quantity %q", in)
+	}
+	mib := value * multiplier / (1 << 20)
+	return int(mib + 0.999), nil
+}
+
+func (p *Project) convertProbes(svc *Service, deployment string, c container) error {
+	for _, pr := range []*probe{c.ReadinessProbe, c.LivenessProbe} {
+		if pr != nil && pr.HTTPGet != nil && svc.httpCheck == "" {
+			svc.httpCheck = pr.HTTPGet.Path
+		}
+	}
+	pr := c.LivenessProbe
+	if pr == nil || pr.Exec == nil {
+		if pr != nil && pr.HTTPGet == nil {
+			p.warnf("Deployment %q: the liveness probe is not converted, only exec and HTTP probes are", deployment)
+		}
+		return nil
+	}
+	svc.HealthCheck.Command = append([]string{"CMD"}, pr.Exec.Command...)
+	// Default to the values of Kubernetes, as the manifest templates render every field of the health check.
+	interval, timeout, startPeriod, retries := seconds(pr.PeriodSeconds, 10), seconds(pr.TimeoutSeconds, 1), seconds(pr.InitialDelaySeconds, 0), 3
+	if pr.FailureThreshold != nil {
+		retries = *pr.FailureThreshold
+	}
+	svc.HealthCheck.Interval, svc.HealthCheck.Timeout, svc.HealthCheck.StartPeriod = &interval, &timeout, &startPeriod
+	svc.HealthCheck.Retries = &retries
+	return nil
+}
+
+func seconds(in *int, defaultValue int) time.Duration {
+	if in == nil {
+		return time.Duration(defaultValue) * time.Second
+	}
+	return time.Duration(*in) * time.Second
+}
+
+func (p *Project) convertVolumes(svc *Service, deployment string, c container, podVolumes []podVolume) {
+	byName := make(map[string]podVolume)
+	for _, v := range podVolumes {
+		byName[v.Name] = v
+	}
+	for _, mount := range c.VolumeMounts {
+		v, ok := byName[mount.Name]
+		switch {
+		case ok && !v.PersistentVolumeClaim.IsZero():
+			svc.volumes = append(svc.volumes, volume{name: volumeName(mount.Name), path: mount.MountPath, readOnly: mount.ReadOnly, efs: true})
+		case ok && !v.EmptyDir.IsZero():
+			svc.volumes = append(svc.volumes, volume{name: volumeName(mount.Name), path: mount.MountPath, readOnly: mount.ReadOnly})
+		default:
+			p.warnf("Deployment %q: volume %q is not converted, only persistent volume claims and empty directories are", deployment, mount.Name)
+		}
+	}
+}
+
+// warnUnconverted warns about the fields of a mapping that aren't converted.
+func (p *Project) warnUnconverted(deployment, field string, node *yaml.Node, converted map[string]bool) {
+	if node.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if key := node.Content[i].Value; !converted[key] {
+			p.warnf("Deployment %q: %s.%s is not converted", deployment, field, key)
+		}
+	}
+}
+
+// ManifestOverrides returns the fields of the manifest of the service that can't be set when the manifest is
+// generated, as a YAML document.
+func (s *Service) ManifestOverrides() ([]byte, error) {
+	doc := &yaml.Node{Kind: yaml.MappingNode}
+	if s.Type == manifestinfo.LoadBalancedWebServiceType && (s.path != "" || s.httpCheck != "") {
+		http := &yaml.Node{Kind: yaml.MappingNode}
+		if s.path != "" {
+			addScalar(http, "path", s.path)
+		}
+		if s.httpCheck != "" {
+			addScalar(http, "healthcheck", s.httpCheck)
+		}
+		addNode(doc, "http", http)
+	}
+	if s.cpu != 0 {
+		addScalar(doc, "cpu", strconv.Itoa(s.cpu))
+		addScalar(doc, "memory", strconv.Itoa(s.memory))
+	}
+	if s.count != nil {
+		addScalar(doc, "count", strconv.Itoa(*s.count))
+	}
+	addList(doc, "entrypoint", s.entrypoint)
+	addList(doc, "command", s.command)
+	addMapping(doc, "variables", s.variables)
+	if len(s.volumes) > 0 {
+		volumes := &yaml.Node{Kind: yaml.MappingNode}
+		for _, v := range s.volumes {
+			vol := &yaml.Node{Kind: yaml.MappingNode}
+			addScalar(vol, "path", v.path)
+			addScalar(vol, "read_only", strconv.FormatBool(v.readOnly))
+			if v.efs {
+				addScalar(vol, "efs", "true")
+			}
+			addNode(volumes, v.name, vol)
+		}
+		storage := &yaml.Node{Kind: yaml.MappingNode}
+		addNode(storage, "volumes", volumes)
+		addNode(doc, "storage", storage)
+	}
+	if len(s.sidecars) > 0 {
+		sidecars := &yaml.Node{Kind: yaml.MappingNode}
+		for _, sc := range s.sidecars {
+			node := &yaml.Node{Kind: yaml.MappingNode}
+			addScalar(node, "image", sc.image)
+			if sc.port != 0 {
+				addScalar(node, "port", strconv.Itoa(int(sc.port)))
+			}
+			addList(node, "entrypoint", sc.entrypoint)
+			addList(node, "command", sc.command)
+			addMapping(node, "variables", sc.variables)
+			addNode(sidecars, sc.name, node)
+		}
+		addNode(doc, "sidecars", sidecars)
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	var out bytes.Buffer
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("marshal manifest fields of service %s: %w", s.Name, err)
+	}
+	return out.Bytes(), nil
+}
+
+func (p *Project) warnf(format string, args ...any) {
+	p.Warnings = append(p.Warnings, fmt.Sprintf(format, args...))
+}
+
+// matches returns true if the labels have every key and value of the selector.
+func matches(selector, labels map[string]string) bool {
+	for k, v := range selector {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func workloadName(name string) string {
+	name = invalidNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	return strings.Trim(name, "-")
+}
+
+func volumeName(name string) string {
+	return invalidVolumeChars.ReplaceAllString(name, "")
+}
+
+func addNode(mapping *yaml.Node, key string, value *yaml.Node) {
+	mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, value)
+}
+
+func addScalar(mapping *yaml.Node, key, value string) {
+	addNode(mapping, key, &yaml.Node{Kind: yaml.ScalarNode, Value: value})
+}
+
+func addList(mapping *yaml.Node, key string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+	node := &yaml.Node{Kind: yaml.SequenceNode, Style: yaml.FlowStyle}
+	for _, v := range values {
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: v, Style: yaml.DoubleQuotedStyle})
+	}
+	addNode(mapping, key, node)
+}
+
+func addMapping(mapping *yaml.Node, key string, values map[string]string) {
+	if len(values) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	for _, k := range keys {
+		// Quote the values so that YAML doesn't reinterpret them, for example "true" or "8080".
+		node.Content = append(node.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: k},
+			&yaml.Node{Kind: yaml.ScalarNode, Value: values[k], Style: yaml.DoubleQuotedStyle})
+	}
+	addNode(mapping, key, node)
+}
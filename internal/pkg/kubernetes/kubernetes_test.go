@@ -0,0 +1,263 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	webDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: 2
+  strategy:
+    type: RollingUpdate
+  selector:
+    matchLabels:
+      app: web
+  template:
+    metadata:
+      labels:
+        app: web
+    spec:
+      nodeSelector:
+        disk: ssd
+      containers:
+        - name: web
+          image: nginx:1.25
+          ports:
+            - name: http
+              containerPort: 8080
+          env:
+            - name: LOG_LEVEL
+              valueFrom:
+                configMapKeyRef:
+                  name: web-config
+                  key: log-level
+            - name: DB_PASSWORD
+              valueFrom:
+                secretKeyRef:
+                  name: db
+                  key: password
+          resources:
+            requests:
+              cpu: 300m
+              memory: 600Mi
+          readinessProbe:
+            httpGet:
+              path: /healthz
+              port: http
+          securityContext:
+            runAsNonRoot: true
+        - name: proxy
+          image: envoyproxy/envoy:v1.28
+          ports:
+            - containerPort: 9901
+`
+	webService = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: web
+spec:
+  selector:
+    app: web
+  ports:
+    - port: 80
+      targetPort: http
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: public
+spec:
+  rules:
+    - host: example.com
+      http:
+        paths:
+          - path: /web
+            pathType: Prefix
+            backend:
+              service:
+                name: web
+                port:
+                  number: 80
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: web-config
+data:
+  log-level: debug
+`
+	workerDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: Queue_Worker
+spec:
+  selector:
+    matchLabels:
+      app: worker
+  template:
+    metadata:
+      labels:
+        app: worker
+    spec:
+      containers:
+        - name: worker
+          image: worker:latest
+          command: ["/bin/worker"]
+          args: ["--concurrency", "4"]
+          envFrom:
+            - configMapRef:
+                name: shared
+          livenessProbe:
+            exec:
+              command: ["cat", "/tmp/healthy"]
+            periodSeconds: 5
+          volumeMounts:
+            - name: cache
+              mountPath: /cache
+            - name: data
+              mountPath: /data
+              readOnly: true
+            - name: certs
+              mountPath: /certs
+      volumes:
+        - name: cache
+          emptyDir: {}
+        - name: data
+          persistentVolumeClaim:
+            claimName: data
+        - name: certs
+          secret:
+            secretName: certs
+---
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: report
+`
+)
+
+func TestParse(t *testing.T) {
+	interval, timeout, startPeriod, retries := 5*time.Second, time.Second, time.Duration(0), 3
+	two := 2
+	testCases := map[string]struct {
+		in []string
+
+		wantedServices []*Service
+		wantedWarnings []string
+		wantedErr      string
+	}{
+		"requires a Deployment": {
+			in:        []string{webService},
+			wantedErr: "Kubernetes manifests have no Deployment",
+		},
+		"converts Deployments": {
+			in: []string{webDeployment, webService, workerDeployment},
+			wantedServices: []*Service{
+				{
+					Name:      "web",
+					Type:      manifestinfo.LoadBalancedWebServiceType,
+					Image:     "nginx:1.25",
+					Port:      8080,
+					count:     &two,
+					cpu:       512,
+					memory:    1024,
+					path:      "web",
+					httpCheck: "/healthz",
+					variables: map[string]string{"LOG_LEVEL": "debug"},
+					sidecars: []sidecar{
+						{name: "proxy", image: "envoyproxy/envoy:v1.28", port: 9901},
+					},
+				},
+				{
+					Name:  "queue-worker",
+					Type:  manifestinfo.BackendServiceType,
+					Image: "worker:latest",
+					HealthCheck: manifest.ContainerHealthCheck{
+						Command:     []string{"CMD", "cat", "/tmp/healthy"},
+						Interval:    &interval,
+						Timeout:     &timeout,
+						StartPeriod: &startPeriod,
+						Retries:     &retries,
+					},
+					command:    []string{"--concurrency", "4"},
+					entrypoint: []string{"/bin/worker"},
+					volumes: []volume{
+						{name: "cache", path: "/cache"},
+						{name: "data", path: "/data", readOnly: true, efs: true},
+					},
+				},
+			},
+			wantedWarnings: []string{
+				`CronJob "report" is not converted: only Deployments, Services, Ingresses and ConfigMaps are`,
+				`Deployment "web": spec.strategy is not converted`,
+				`Deployment "web": spec.template.spec.nodeSelector is not converted`,
+				`Deployment "web": container "web".securityContext is not converted`,
+				`Deployment "web": variable "DB_PASSWORD" from Secret "db" is not converted, store it with "copilot secret init" and add it to "secrets"`,
+				`Deployment "web": the resources of the container are rounded up to the Fargate task size of 512 CPU units and 1024 MiB`,
+				`Deployment "web": the host "example.com" of Ingress "public" is not converted, set "http.alias" once the application has a domain`,
+				`Deployment "Queue_Worker" is renamed to "queue-worker", as Copilot names contain only lowercase letters, digits and hyphens`,
+				`Deployment "Queue_Worker": ConfigMap "shared" is not in the manifests, set its variables in the manifest`,
+				`Deployment "Queue_Worker": volume "certs" is not converted, only persistent volume claims and empty directories are`,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var in [][]byte
+			for _, content := range tc.in {
+				in = append(in, []byte(content))
+			}
+
+			// WHEN
+			got, err := Parse(in...)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedWarnings, got.Warnings)
+			require.Equal(t, tc.wantedServices, got.Services)
+		})
+	}
+}
+
+func TestService_ManifestOverrides(t *testing.T) {
+	project, err := Parse([]byte(webDeployment), []byte(webService))
+	require.NoError(t, err)
+
+	// WHEN
+	got, err := project.Services[0].ManifestOverrides()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, `http:
+  path: web
+  healthcheck: /healthz
+cpu: 512
+memory: 1024
+count: 2
+variables:
+  LOG_LEVEL: "debug"
+sidecars:
+  proxy:
+    image: envoyproxy/envoy:v1.28
+    port: 9901
+`, string(got))
+}
@@ -91,6 +91,20 @@ func (t *TaskClient) WriteEventsUntilStopped() error {
 	}
 }
 
+// WaitUntilStopped waits until all tasks have stopped without writing their events.
+func (t *TaskClient) WaitUntilStopped() error {
+	for {
+		stopped, err := t.allTasksStopped()
+		if err != nil {
+			return err
+		}
+		if stopped {
+			return nil
+		}
+		t.sleep()
+	}
+}
+
 func (t *TaskClient) allTasksStopped() (bool, error) {
 	taskARNs := make([]string, len(t.tasks))
 	for idx, task := range t.tasks {
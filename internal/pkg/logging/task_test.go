@@ -152,3 +152,90 @@ func TestEventsWriter_WriteEventsUntilStopped(t *testing.T) {
 		})
 	}
 }
+
+func TestEventsWriter_WaitUntilStopped(t *testing.T) {
+	const (
+		taskARN1 = "arn:aws:ecs:us-west-2:123456789:task/cluster/task1"
+		taskARN2 = "arn:aws:ecs:us-west-2:123456789:task/cluster/task2"
+	)
+	tasks := []*task.Task{
+		{
+			TaskARN:    taskARN1,
+			ClusterARN: "cluster",
+		},
+		{
+			TaskARN:    taskARN2,
+			ClusterARN: "cluster",
+		},
+	}
+	testCases := map[string]struct {
+		setUpMocks func(m writeEventMocks)
+
+		wantedError error
+	}{
+		"error describing tasks": {
+			setUpMocks: func(m writeEventMocks) {
+				m.describer.EXPECT().DescribeTasks("cluster", []string{taskARN1, taskARN2}).
+					Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe tasks: some error"),
+		},
+		"success without getting log events": {
+			setUpMocks: func(m writeEventMocks) {
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).Times(0)
+				gomock.InOrder(
+					m.describer.EXPECT().DescribeTasks("cluster", []string{taskARN1, taskARN2}).
+						Return([]*ecs.Task{
+							{
+								TaskArn:    aws.String(taskARN1),
+								LastStatus: aws.String(ecs.DesiredStatusStopped),
+							},
+							{
+								TaskArn:    aws.String(taskARN2),
+								ClusterArn: aws.String("cluster"),
+								LastStatus: aws.String("RUNNING"),
+							},
+						}, nil),
+					m.describer.EXPECT().DescribeTasks("cluster", []string{taskARN2}).
+						Return([]*ecs.Task{
+							{
+								TaskArn:    aws.String(taskARN2),
+								LastStatus: aws.String(ecs.DesiredStatusStopped),
+							},
+						}, nil),
+				)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mocks := writeEventMocks{
+				logGetter: mocks.NewMocklogGetter(ctrl),
+				describer: mocks.NewMockTasksDescriber(ctrl),
+			}
+			tc.setUpMocks(mocks)
+
+			ew := &TaskClient{
+				groupName: "my-log-group",
+				tasks:     tasks,
+
+				eventsWriter:  mockWriter{},
+				eventsLogger:  mocks.logGetter,
+				taskDescriber: mocks.describer,
+
+				sleep: func() {}, // no-op.
+			}
+
+			err := ew.WaitUntilStopped()
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ package logging
 import (
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
 	"time"
 
@@ -36,10 +37,11 @@ type serviceARNGetter interface {
 
 // NewWorkloadLoggerOpts contains fields that initiate workloadLogger struct.
 type NewWorkloadLoggerOpts struct {
-	App  string
-	Env  string
-	Name string
-	Sess *session.Session
+	App      string
+	Env      string
+	Name     string
+	LogGroup string // Optional. Name of the log group of the workload if it isn't the default one.
+	Sess     *session.Session
 }
 
 // newWorkloadLogger returns a workloadLogger for the service under env and app.
@@ -49,6 +51,7 @@ func newWorkloadLogger(opts *NewWorkloadLoggerOpts) *workloadLogger {
 		app:          opts.App,
 		env:          opts.Env,
 		name:         opts.Name,
+		logGroup:     opts.LogGroup,
 		eventsGetter: cloudwatchlogs.New(opts.Sess),
 		w:            log.OutputWriter,
 		now:          time.Now,
@@ -56,23 +59,32 @@ func newWorkloadLogger(opts *NewWorkloadLoggerOpts) *workloadLogger {
 }
 
 type workloadLogger struct {
-	app  string
-	env  string
-	name string
+	app      string
+	env      string
+	name     string
+	logGroup string
 
 	eventsGetter logGetter
 	w            io.Writer
 	now          func() time.Time
 }
 
+// defaultLogGroup returns the name of the log group of the workload.
+func (s *workloadLogger) defaultLogGroup() string {
+	if s.logGroup != "" {
+		return s.logGroup
+	}
+	return fmt.Sprintf(fmtWkldLogGroupName, s.app, s.env, s.name)
+}
+
 // WriteLogEvents writes service logs.
-func (s *workloadLogger) writeEventLogs(logEventsOpts cloudwatchlogs.LogEventsOpts, onEvent func(io.Writer, []HumanJSONStringer) error, follow bool) error {
+func (s *workloadLogger) writeEventLogs(logEventsOpts cloudwatchlogs.LogEventsOpts, onEvent func(io.Writer, []HumanJSONStringer) error, follow bool, messagePattern *regexp.Regexp) error {
 	for {
 		logEventsOutput, err := s.eventsGetter.LogEvents(logEventsOpts)
 		if err != nil {
 			return fmt.Errorf("get log events for log group %s: %w", logEventsOpts.LogGroup, err)
 		}
-		if err := onEvent(s.w, cwEventsToHumanJSONStringers(logEventsOutput.Events)); err != nil {
+		if err := onEvent(s.w, cwEventsToHumanJSONStringers(filterEvents(logEventsOutput.Events, messagePattern))); err != nil {
 			return err
 		}
 		if !follow {
@@ -87,19 +99,42 @@ func (s *workloadLogger) writeEventLogs(logEventsOpts cloudwatchlogs.LogEventsOp
 	}
 }
 
-func ecsLogStreamPrefixes(taskIDs []string, service, container string) []string {
-	// By default, we only want logs from copilot task log streams.
-	// This filters out log stream not starting with `copilot/`, or `copilot/datadog` if container is set.
+// filterEvents returns the events whose message matches the pattern. If the pattern is nil, all events are returned.
+func filterEvents(events []*cloudwatchlogs.Event, pattern *regexp.Regexp) []*cloudwatchlogs.Event {
+	if pattern == nil {
+		return events
+	}
+	var filtered []*cloudwatchlogs.Event
+	for _, event := range events {
+		if pattern.MatchString(event.Message) {
+			filtered = append(filtered, event)
+		}
+	}
+	return filtered
+}
+
+func ecsLogStreamPrefixes(taskIDs []string, service string, containers []string) []string {
+	// By default, we only want logs from copilot task log streams, which include the streams of all the containers.
+	// This filters out log stream not starting with `copilot/`, or `copilot/datadog` if a container is set.
 	if len(taskIDs) == 0 {
-		return []string{fmt.Sprintf("%s/%s", wkldLogStreamPrefix, container)}
+		if len(containers) == 0 {
+			return []string{fmt.Sprintf("%s/", wkldLogStreamPrefix)}
+		}
+		var logStreamPrefixes []string
+		for _, container := range containers {
+			logStreamPrefixes = append(logStreamPrefixes, fmt.Sprintf("%s/%s", wkldLogStreamPrefix, container))
+		}
+		return logStreamPrefixes
 	}
-	var logStreamPrefixes []string
-	if container == "" {
-		container = service
+	if len(containers) == 0 {
+		containers = []string{service}
 	}
-	for _, taskID := range taskIDs {
-		prefix := fmt.Sprintf("%s/%s/%s", wkldLogStreamPrefix, container, taskID) // Example: copilot/sidecar/1111 or copilot/web/1111
-		logStreamPrefixes = append(logStreamPrefixes, prefix)
+	var logStreamPrefixes []string
+	for _, container := range containers {
+		for _, taskID := range taskIDs {
+			prefix := fmt.Sprintf("%s/%s/%s", wkldLogStreamPrefix, container, taskID) // Example: copilot/sidecar/1111 or copilot/web/1111
+			logStreamPrefixes = append(logStreamPrefixes, prefix)
+		}
 	}
 	return logStreamPrefixes
 }
@@ -118,7 +153,7 @@ type ECSServiceLogger struct {
 
 // WriteLogEvents writes service logs.
 func (s *ECSServiceLogger) WriteLogEvents(opts WriteLogEventsOpts) error {
-	logGroup := fmt.Sprintf(fmtWkldLogGroupName, s.app, s.env, s.name)
+	logGroup := s.defaultLogGroup()
 	if opts.LogGroup != "" {
 		logGroup = opts.LogGroup
 	}
@@ -129,13 +164,13 @@ func (s *ECSServiceLogger) WriteLogEvents(opts WriteLogEventsOpts) error {
 		EndTime:                opts.EndTime,
 		StreamLastEventTime:    nil,
 		LogStreamLimit:         opts.LogStreamLimit,
-		LogStreamPrefixFilters: s.logStreamPrefixes(opts.TaskIDs, opts.ContainerName),
+		LogStreamPrefixFilters: s.logStreamPrefixes(opts.TaskIDs, opts.ContainerNames),
 	}
-	return s.workloadLogger.writeEventLogs(logEventsOpts, opts.OnEvents, opts.Follow)
+	return s.workloadLogger.writeEventLogs(logEventsOpts, opts.OnEvents, opts.Follow, opts.MessagePattern)
 }
 
-func (s *ECSServiceLogger) logStreamPrefixes(taskIDs []string, container string) []string {
-	return ecsLogStreamPrefixes(taskIDs, s.name, container)
+func (s *ECSServiceLogger) logStreamPrefixes(taskIDs []string, containers []string) []string {
+	return ecsLogStreamPrefixes(taskIDs, s.name, containers)
 }
 
 // NewAppRunnerServiceLoggerOpts contains fields that initiate AppRunnerServiceLoggerOpts struct.
@@ -199,7 +234,7 @@ func (s *AppRunnerServiceLogger) WriteLogEvents(opts WriteLogEventsOpts) error {
 		StreamLastEventTime: nil,
 		LogStreamLimit:      opts.LogStreamLimit,
 	}
-	return s.workloadLogger.writeEventLogs(logEventsOpts, opts.OnEvents, opts.Follow)
+	return s.workloadLogger.writeEventLogs(logEventsOpts, opts.OnEvents, opts.Follow, opts.MessagePattern)
 }
 
 // NewJobLogger returns an JobLogger for the job under env and app.
@@ -220,7 +255,7 @@ func (s *JobLogger) WriteLogEvents(opts WriteLogEventsOpts) error {
 	if opts.IncludeStateMachineLogs {
 		logStreamLimit *= 2
 	}
-	logGroup := fmt.Sprintf(fmtWkldLogGroupName, s.app, s.env, s.name)
+	logGroup := s.defaultLogGroup()
 	if opts.LogGroup != "" {
 		logGroup = opts.LogGroup
 	}
@@ -233,7 +268,7 @@ func (s *JobLogger) WriteLogEvents(opts WriteLogEventsOpts) error {
 		LogStreamLimit:         logStreamLimit,
 		LogStreamPrefixFilters: s.logStreamPrefixes(opts.TaskIDs, opts.IncludeStateMachineLogs),
 	}
-	return s.workloadLogger.writeEventLogs(logEventsOpts, opts.OnEvents, opts.Follow)
+	return s.workloadLogger.writeEventLogs(logEventsOpts, opts.OnEvents, opts.Follow, opts.MessagePattern)
 }
 
 //  The log stream prefixes for a job should be:
@@ -246,7 +281,7 @@ func (s *JobLogger) logStreamPrefixes(taskIDs []string, includeStateMachineLogs
 	if includeStateMachineLogs {
 		return []string{fmt.Sprintf("%s/", wkldLogStreamPrefix), stateMachineLogStreamPrefix}
 	}
-	return ecsLogStreamPrefixes(taskIDs, s.name, "")
+	return ecsLogStreamPrefixes(taskIDs, s.name, nil)
 }
 
 // WriteLogEventsOpts wraps the parameters to call WriteLogEvents.
@@ -258,6 +293,8 @@ type WriteLogEventsOpts struct {
 	// OnEvents is a handler that's invoked when logs are retrieved from the service.
 	OnEvents func(w io.Writer, logs []HumanJSONStringer) error
 	LogGroup string
+	// MessagePattern is an optional filter. If set, only the events whose message matches are written.
+	MessagePattern *regexp.Regexp
 
 	// Job specific options.
 	IncludeStateMachineLogs bool
@@ -266,8 +303,8 @@ type WriteLogEventsOpts struct {
 	LogStreamLimit int
 
 	// ECS specific options.
-	ContainerName string
-	TaskIDs       []string
+	ContainerNames []string // If empty, the logs of all the containers are written.
+	TaskIDs        []string
 }
 
 func (o WriteLogEventsOpts) limit() *int64 {
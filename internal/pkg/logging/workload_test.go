@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -53,13 +54,14 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 	}
 	mockCurrentTimestamp := time.Date(2020, 11, 23, 0, 0, 0, 0, time.UTC) // Copilot GA date :).
 	testCases := map[string]struct {
-		follow        bool
-		limit         *int64
-		startTime     *int64
-		jsonOutput    bool
-		taskIDs       []string
-		containerName string
-		setupMocks    func(mocks workloadLogsMocks)
+		follow         bool
+		limit          *int64
+		startTime      *int64
+		jsonOutput     bool
+		taskIDs        []string
+		containerNames []string
+		messagePattern *regexp.Regexp
+		setupMocks     func(mocks workloadLogsMocks)
 
 		wantedError   error
 		wantedContent string
@@ -158,8 +160,8 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1"
 			wantedContent: logEventsHumanString,
 		},
 		"success when filtered by certain container and certain tasks": {
-			containerName: "datadog",
-			taskIDs:       []string{"mockTaskID"},
+			containerNames: []string{"datadog"},
+			taskIDs:        []string{"mockTaskID"},
 			setupMocks: func(m workloadLogsMocks) {
 				gomock.InOrder(
 					m.logGetter.EXPECT().LogEvents(gomock.Any()).
@@ -175,7 +177,7 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1"
 			wantedContent: logEventsHumanString,
 		},
 		"success when filtered by certain container": {
-			containerName: "datadog",
+			containerNames: []string{"datadog"},
 			setupMocks: func(m workloadLogsMocks) {
 				gomock.InOrder(
 					m.logGetter.EXPECT().LogEvents(gomock.Any()).
@@ -190,6 +192,31 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1"
 			},
 			wantedContent: logEventsHumanString,
 		},
+		"success when filtered by multiple containers and certain tasks": {
+			containerNames: []string{"mockSvc", "datadog"},
+			taskIDs:        []string{"mockTaskID1", "mockTaskID2"},
+			setupMocks: func(m workloadLogsMocks) {
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).
+					Do(func(param cloudwatchlogs.LogEventsOpts) {
+						require.Equal(t, []string{"copilot/mockSvc/mockTaskID1", "copilot/mockSvc/mockTaskID2", "copilot/datadog/mockTaskID1", "copilot/datadog/mockTaskID2"}, param.LogStreamPrefixFilters)
+					}).
+					Return(&cloudwatchlogs.LogEventsOutput{
+						Events: mockLogEvents,
+					}, nil)
+			},
+			wantedContent: logEventsHumanString,
+		},
+		"success when filtered by a message pattern": {
+			messagePattern: regexp.MustCompile(`HTTP/1\.1" [45]\d\d`),
+			setupMocks: func(m workloadLogsMocks) {
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).
+					Return(&cloudwatchlogs.LogEventsOutput{
+						Events: append(append([]*cloudwatchlogs.Event{}, mockLogEvents...), mockMoreLogEvents...),
+					}, nil)
+			},
+			wantedContent: `firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1" 404 -
+`,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -225,13 +252,14 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1"
 				logWriter = WriteJSONLogs
 			}
 			err := svcLogs.WriteLogEvents(WriteLogEventsOpts{
-				Follow:        tc.follow,
-				TaskIDs:       tc.taskIDs,
-				Limit:         tc.limit,
-				StartTime:     tc.startTime,
-				OnEvents:      logWriter,
-				ContainerName: tc.containerName,
-				LogGroup:      mockLogGroupName,
+				Follow:         tc.follow,
+				TaskIDs:        tc.taskIDs,
+				Limit:          tc.limit,
+				StartTime:      tc.startTime,
+				OnEvents:       logWriter,
+				ContainerNames: tc.containerNames,
+				MessagePattern: tc.messagePattern,
+				LogGroup:       mockLogGroupName,
 			})
 
 			// THEN
@@ -0,0 +1,74 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import "github.com/aws/aws-sdk-go/aws"
+
+const (
+	// APIGatewayProtocolHTTP fronts a service with an API Gateway HTTP API.
+	APIGatewayProtocolHTTP = "http"
+	// APIGatewayProtocolWebSocket fronts a service with an API Gateway WebSocket API.
+	APIGatewayProtocolWebSocket = "websocket"
+)
+
+var apiGatewayProtocols = []string{APIGatewayProtocolHTTP, APIGatewayProtocolWebSocket}
+
+// APIGateway holds the configuration to front a Backend Service with an Amazon API Gateway API
+// connected to the service through a VPC link.
+type APIGateway struct {
+	Protocol   *string              `yaml:"protocol"`
+	Routes     []string             `yaml:"routes"` // Route keys of a WebSocket API in addition to $connect, $disconnect and $default.
+	Authorizer APIGatewayAuthorizer `yaml:"authorizer"`
+	Throttling APIGatewayThrottling `yaml:"throttling"`
+}
+
+// APIGatewayAuthorizer holds the configuration to authorize the requests to the API.
+type APIGatewayAuthorizer struct {
+	JWT JWTAuthorizer `yaml:"jwt"`
+}
+
+// JWTAuthorizer holds the configuration to authorize the requests of an HTTP API with JSON web tokens.
+type JWTAuthorizer struct {
+	Issuer   *string  `yaml:"issuer"`
+	Audience []string `yaml:"audience"`
+}
+
+// APIGatewayThrottling holds the throttling limits of the stage of the API.
+type APIGatewayThrottling struct {
+	Rate  *float64 `yaml:"rate"`  // Steady-state requests per second.
+	Burst *int     `yaml:"burst"` // Maximum number of concurrent requests.
+}
+
+// IsEmpty returns true if the API Gateway API is not configured.
+func (a *APIGateway) IsEmpty() bool {
+	return a.Protocol == nil && len(a.Routes) == 0 && a.Authorizer.IsEmpty() && a.Throttling.IsEmpty()
+}
+
+// ProtocolOrDefault returns the protocol of the API, which defaults to "http".
+func (a *APIGateway) ProtocolOrDefault() string {
+	if a.Protocol == nil {
+		return APIGatewayProtocolHTTP
+	}
+	return aws.StringValue(a.Protocol)
+}
+
+// IsWebSocket returns true if the service is fronted by a WebSocket API.
+func (a *APIGateway) IsWebSocket() bool {
+	return !a.IsEmpty() && a.ProtocolOrDefault() == APIGatewayProtocolWebSocket
+}
+
+// IsEmpty returns true if no authorizer is configured.
+func (a *APIGatewayAuthorizer) IsEmpty() bool {
+	return a.JWT.IsEmpty()
+}
+
+// IsEmpty returns true if the JWT authorizer is not configured.
+func (j *JWTAuthorizer) IsEmpty() bool {
+	return j.Issuer == nil && len(j.Audience) == 0
+}
+
+// IsEmpty returns true if no throttling limits are configured.
+func (t *APIGatewayThrottling) IsEmpty() bool {
+	return t.Rate == nil && t.Burst == nil
+}
@@ -27,7 +27,8 @@ type BackendService struct {
 type BackendServiceConfig struct {
 	ImageConfig      ImageWithHealthcheckAndOptionalPort `yaml:"image,flow"`
 	ImageOverride    `yaml:",inline"`
-	HTTP             HTTP `yaml:"http,flow"`
+	HTTP             HTTP       `yaml:"http,flow"`
+	APIGateway       APIGateway `yaml:"api_gateway"`
 	TaskConfig       `yaml:",inline"`
 	Logging          Logging                   `yaml:"logging,flow"`
 	Sidecars         map[string]*SidecarConfig `yaml:"sidecars"` // NOTE: keep the pointers because `mergo` doesn't automatically deep merge map's value unless it's a pointer type.
@@ -36,6 +37,7 @@ type BackendServiceConfig struct {
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
 	DeployConfig     DeploymentConfig          `yaml:"deployment"`
 	Observability    Observability             `yaml:"observability"`
+	Alarms           HTTPAlarmsConfig          `yaml:"alarms"`
 }
 
 // BackendServiceProps represents the configuration needed to create a backend service.
@@ -130,6 +132,46 @@ func (s *BackendService) BuildArgs(contextDir string) (map[string]*DockerBuildAr
 	return buildArgs(contextDir, buildArgsPerContainer, s.Sidecars)
 }
 
+// ImageLocations returns the image location of each container that runs an existing image instead of building one.
+func (s *BackendService) ImageLocations() map[string]string {
+	return imageLocations(aws.StringValue(s.Name), s.ImageConfig.Image, s.Sidecars)
+}
+
+// ImageBuilder returns the builder of the container images, or empty if it's not specified.
+func (s *BackendService) ImageBuilder() string {
+	return s.ImageConfig.Image.GetBuilder()
+}
+
+// ImagePlatforms returns the platforms to build a multi-platform image of the main container for, or nil if it's not specified.
+func (s *BackendService) ImagePlatforms() []string {
+	return s.ImageConfig.Image.Platforms
+}
+
+// ImageSigning returns the configuration to sign the images built for the workload.
+func (s *BackendService) ImageSigning() ImageSigning {
+	return s.ImageConfig.Image.Signing
+}
+
+// ImageScan returns the configuration to gate the deployment on the scan of the images built for the workload.
+func (s *BackendService) ImageScan() ImageScan {
+	return s.ImageConfig.Image.Scan
+}
+
+// ImageSBOM returns the configuration to generate the software bills of materials of the images built for the workload.
+func (s *BackendService) ImageSBOM() ImageSBOM {
+	return s.ImageConfig.Image.SBOM
+}
+
+// IsBlueGreen returns true if the tasks of the service are replaced by a CodeDeploy blue/green deployment.
+func (s *BackendService) IsBlueGreen() bool {
+	return s.DeployConfig.IsBlueGreen()
+}
+
+// DeploymentHooks returns the one-off tasks that run before and after the tasks of the service are replaced.
+func (s *BackendService) DeploymentHooks() DeploymentHooks {
+	return s.DeployConfig.DeploymentHooks
+}
+
 // EnvFiles returns the locations of all env files against the ws root directory.
 // This method returns a map[string]string where the keys are container names
 // and the values are either env file paths or empty strings.
@@ -137,6 +179,12 @@ func (s *BackendService) EnvFiles() map[string]string {
 	return envFiles(s.Name, s.TaskConfig, s.Logging, s.Sidecars)
 }
 
+// VariablesEnvFiles returns the paths of the env files against the ws root directory whose variables are
+// rendered in the containers at package time. The keys are container names.
+func (s *BackendService) VariablesEnvFiles() map[string]string {
+	return variablesEnvFiles(s.Name, s.TaskConfig, s.Sidecars)
+}
+
 func (s *BackendService) subnets() *SubnetListOrArgs {
 	return &s.Network.VPC.Placement.Subnets
 }
@@ -213,6 +261,9 @@ func (b *BackendService) ExposedPorts() (ExposedPortsIndex, error) {
 	for _, rule := range b.HTTP.RoutingRules() {
 		exposedPorts = append(exposedPorts, rule.exposedPorts(exposedPorts, workloadName)...)
 	}
+	for _, port := range b.Network.Connect.Ports {
+		exposedPorts = append(exposedPorts, port.exposedPorts(exposedPorts, workloadName)...)
+	}
 	portsForContainer, containerForPort := prepareParsedExposedPortsMap(sortExposedPorts(exposedPorts))
 	return ExposedPortsIndex{
 		WorkloadName:      workloadName,
@@ -231,6 +231,18 @@ func TestBackendService_RequiredEnvironmentFeatures(t *testing.T) {
 			},
 			wanted: []string{template.NATFeatureName},
 		},
+		"service connect tls feature required": {
+			mft: func(svc *BackendService) {
+				svc.Network = NetworkConfig{
+					Connect: ServiceConnectBoolOrArgs{
+						ServiceConnectArgs: ServiceConnectArgs{
+							TLS: aws.Bool(true),
+						},
+					},
+				}
+			},
+			wanted: []string{template.ServiceConnectTLSFeatureName},
+		},
 		"efs feature required by enabling managed volume": {
 			mft: func(svc *BackendService) {
 				svc.Storage = Storage{
@@ -460,10 +472,10 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 				},
 			},
 			Logging: Logging{
-				Destination: map[string]string{
+				Destination: BasicToUnion[map[string]string, LogDestinationConfig](map[string]string{
 					"Name":            "datadog",
 					"exclude-pattern": "*",
-				},
+				}),
 			},
 		},
 		Environments: map[string]*BackendServiceConfig{
@@ -498,10 +510,10 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 					},
 				},
 				Logging: Logging{
-					Destination: map[string]string{
+					Destination: BasicToUnion[map[string]string, LogDestinationConfig](map[string]string{
 						"include-pattern": "*",
 						"exclude-pattern": "fe/",
-					},
+					}),
 				},
 			},
 		},
@@ -717,11 +729,11 @@ func TestBackendSvc_ApplyEnv(t *testing.T) {
 						},
 					},
 					Logging: Logging{
-						Destination: map[string]string{
+						Destination: BasicToUnion[map[string]string, LogDestinationConfig](map[string]string{
 							"Name":            "datadog",
 							"include-pattern": "*",
 							"exclude-pattern": "fe/",
-						},
+						}),
 					},
 				},
 			},
@@ -1196,6 +1208,58 @@ func TestBackendService_ExposedPorts(t *testing.T) {
 				},
 			},
 		},
+		"expose additional ports through network.connect.ports": {
+			mft: &BackendService{
+				Workload: Workload{
+					Name: aws.String("frontend"),
+				},
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: ImageWithHealthcheckAndOptionalPort{
+						ImageWithOptionalPort: ImageWithOptionalPort{
+							Port: aws.Uint16(50051),
+						},
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"envoy": {
+							Port: aws.String("9901"),
+						},
+					},
+					Network: NetworkConfig{
+						Connect: ServiceConnectBoolOrArgs{
+							ServiceConnectArgs: ServiceConnectArgs{
+								Ports: []ServiceConnectPort{
+									{Port: aws.Uint16(8080)},
+									{Port: aws.Uint16(9901), TargetContainer: aws.String("envoy")},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedExposedPorts: map[string][]ExposedPort{
+				"frontend": {
+					{
+						Port:          8080,
+						ContainerName: "frontend",
+						Protocol:      "tcp",
+					},
+					{
+						Port:                 50051,
+						ContainerName:        "frontend",
+						Protocol:             "tcp",
+						isDefinedByContainer: true,
+					},
+				},
+				"envoy": {
+					{
+						Port:                 9901,
+						ContainerName:        "envoy",
+						Protocol:             "tcp",
+						isDefinedByContainer: true,
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,383 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	yamlErrLineRegexp = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+	// Validation errors wrap each other with the path of the field, for example:
+	// validate "http": validate "healthcheck": ...
+	validatePathRegexp = regexp.MustCompile(`(?:validate|parse|for) "([^"]+)"`)
+	pathIndexRegexp    = regexp.MustCompile(`^([^\[]*)\[([^\]]+)\]$`)
+)
+
+// ValidationError is a problem found in a manifest.
+type ValidationError struct {
+	Line int    // Line of the manifest the problem is found at, or 0 if the line is unknown.
+	Env  string // Name of the environment whose overrides cause the problem, or empty for the base manifest.
+	Err  error
+}
+
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	if e.Line > 0 {
+		fmt.Fprintf(&b, "line %d", e.Line)
+	}
+	if e.Env != "" {
+		if b.Len() > 0 {
+			b.WriteString(" ")
+		}
+		fmt.Fprintf(&b, "(environment %s)", e.Env)
+	}
+	if b.Len() == 0 {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s: %s", b.String(), e.Err.Error())
+}
+
+// Unwrap returns the underlying error of the problem.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// CheckWorkload parses and validates a workload manifest without calling any AWS APIs, and returns every problem found.
+// If envName is not empty, then the manifest is interpolated for the environment and only its overrides are validated.
+// Otherwise, the overrides of every environment in the manifest are validated.
+func CheckWorkload(in []byte, appName, envName string) []*ValidationError {
+	root, verr := parseManifestNode(in, appName, envName)
+	if verr != nil {
+		return []*ValidationError{verr}
+	}
+	var am struct {
+		Type string `yaml:"type"`
+	}
+	if err := root.Decode(&am); err != nil {
+		return yamlDecodeErrors(err)
+	}
+	mft, err := newDefaultWorkloadManifest(am.Type)
+	if err != nil {
+		return []*ValidationError{{
+			Line: lineAt(root, []string{"type"}),
+			Err:  err,
+		}}
+	}
+	checker := &manifestChecker{root: root}
+	checker.checkUnknownFields(root, reflect.TypeOf(mft))
+	if err := root.Decode(mft); err != nil {
+		return append(checker.errs, yamlDecodeErrors(err)...)
+	}
+
+	baseErr := mft.validate()
+	if baseErr != nil {
+		checker.addValidationErr(baseErr, "")
+	}
+	envs := []string{envName}
+	if envName == "" {
+		envs = overriddenEnvs(root)
+	}
+	for _, env := range envs {
+		envMft, err := mft.applyEnv(env)
+		if err != nil {
+			checker.errs = append(checker.errs, &ValidationError{
+				Line: lineAt(root, []string{"environments", env}),
+				Env:  env,
+				Err:  fmt.Errorf("apply overrides: %w", err),
+			})
+			continue
+		}
+		err = envMft.validate()
+		if err == nil || (baseErr != nil && err.Error() == baseErr.Error()) {
+			continue
+		}
+		checker.addValidationErr(err, env)
+	}
+	return checker.errs
+}
+
+// CheckEnvironment parses and validates an environment manifest without calling any AWS APIs, and returns every problem found.
+func CheckEnvironment(in []byte, appName, envName string) []*ValidationError {
+	root, verr := parseManifestNode(in, appName, envName)
+	if verr != nil {
+		return []*ValidationError{verr}
+	}
+	var mft Environment
+	checker := &manifestChecker{root: root}
+	checker.checkUnknownFields(root, reflect.TypeOf(mft))
+	if err := root.Decode(&mft); err != nil {
+		return append(checker.errs, yamlDecodeErrors(err)...)
+	}
+	if err := mft.Validate(); err != nil {
+		checker.addValidationErr(err, "")
+	}
+	return checker.errs
+}
+
+// parseManifestNode parses the manifest into a node tree, interpolated for the environment if envName is not empty.
+func parseManifestNode(in []byte, appName, envName string) (*yaml.Node, *ValidationError) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(in, &root); err != nil {
+		return nil, yamlErrorWithLine(err.Error())
+	}
+	if len(root.Content) == 0 {
+		return nil, &ValidationError{Err: errors.New("manifest is empty")}
+	}
+	if envName == "" {
+		return &root, nil
+	}
+	if err := NewInterpolator(appName, envName).applyInterpolation(&root); err != nil {
+		return nil, &ValidationError{Err: fmt.Errorf("interpolate environment variables: %w", err)}
+	}
+	resetScalarTags(&root)
+	return &root, nil
+}
+
+// resetScalarTags clears the tags of scalars that are not explicitly tagged, so that decoding resolves the type
+// of their interpolated values instead of treating them as the strings they were parsed as.
+func resetScalarTags(node *yaml.Node) {
+	if node.Kind == yaml.ScalarNode && node.Style&yaml.TaggedStyle == 0 {
+		node.Tag = ""
+	}
+	for _, child := range node.Content {
+		resetScalarTags(child)
+	}
+}
+
+func yamlDecodeErrors(err error) []*ValidationError {
+	var typeErr *yaml.TypeError
+	if !errors.As(err, &typeErr) {
+		return []*ValidationError{yamlErrorWithLine(err.Error())}
+	}
+	var errs []*ValidationError
+	for _, msg := range typeErr.Errors {
+		errs = append(errs, yamlErrorWithLine(msg))
+	}
+	return errs
+}
+
+func yamlErrorWithLine(msg string) *ValidationError {
+	matches := yamlErrLineRegexp.FindStringSubmatch(msg)
+	if matches == nil {
+		return &ValidationError{Err: errors.New(msg)}
+	}
+	line, _ := strconv.Atoi(matches[1])
+	return &ValidationError{
+		Line: line,
+		Err:  errors.New(matches[2]),
+	}
+}
+
+// overriddenEnvs returns the sorted names of the environments under the "environments" field of a workload manifest.
+func overriddenEnvs(root *yaml.Node) []string {
+	envs := childNode(documentNode(root), "environments")
+	if envs == nil || envs.Kind != yaml.MappingNode {
+		return nil
+	}
+	var names []string
+	for i := 0; i+1 < len(envs.Content); i += 2 {
+		names = append(names, envs.Content[i].Value)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type manifestChecker struct {
+	root *yaml.Node
+	errs []*ValidationError
+}
+
+// checkUnknownFields reports the keys of mappings that do not match any field of the type the node is decoded into.
+func (c *manifestChecker) checkUnknownFields(node *yaml.Node, typ reflect.Type) {
+	c.errs = append(c.errs, unknownFields(node, typ)...)
+}
+
+func (c *manifestChecker) addValidationErr(err error, env string) {
+	path := validationPath(err)
+	line := 0
+	if env != "" {
+		line = lineAt(c.root, append([]string{"environments", env}, path...))
+	}
+	if line == 0 {
+		line = lineAt(c.root, path)
+	}
+	c.errs = append(c.errs, &ValidationError{
+		Line: line,
+		Env:  env,
+		Err:  err,
+	})
+}
+
+func unknownFields(node *yaml.Node, typ reflect.Type) []*ValidationError {
+	node = documentNode(node)
+	if node == nil || node.Kind == yaml.AliasNode {
+		return nil
+	}
+	typ = indirect(typ)
+	if typ == yamlNodeType || typ.Kind() == reflect.Interface {
+		return nil
+	}
+	if alts, ok := unionAlternatives(typ); ok {
+		// Pick the alternative that the node fits best. Type mismatches are left for the decoder to report.
+		var best []*ValidationError
+		matched := false
+		for _, alt := range alts {
+			if !nodeMatchesKind(node, alt) {
+				continue
+			}
+			errs := unknownFields(node, alt)
+			if !matched || len(errs) < len(best) {
+				best, matched = errs, true
+			}
+		}
+		return best
+	}
+	var errs []*ValidationError
+	switch typ.Kind() {
+	case reflect.Slice, reflect.Array:
+		if node.Kind != yaml.SequenceNode {
+			return nil
+		}
+		for _, item := range node.Content {
+			errs = append(errs, unknownFields(item, typ.Elem())...)
+		}
+	case reflect.Map:
+		if node.Kind != yaml.MappingNode {
+			return nil
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			errs = append(errs, unknownFields(node.Content[i+1], typ.Elem())...)
+		}
+	case reflect.Struct:
+		if node.Kind != yaml.MappingNode {
+			return nil
+		}
+		fields := make(map[string]reflect.Type)
+		for _, f := range yamlFields(typ) {
+			fields[f.name] = f.typ
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			if key.Tag == "!!merge" {
+				continue
+			}
+			fieldType, ok := fields[key.Value]
+			if !ok {
+				errs = append(errs, &ValidationError{
+					Line: key.Line,
+					Err:  fmt.Errorf(`unknown field "%s"`, key.Value),
+				})
+				continue
+			}
+			errs = append(errs, unknownFields(node.Content[i+1], fieldType)...)
+		}
+	}
+	return errs
+}
+
+func nodeMatchesKind(node *yaml.Node, typ reflect.Type) bool {
+	typ = indirect(typ)
+	switch typ.Kind() {
+	case reflect.Struct, reflect.Map:
+		if _, ok := unionAlternatives(typ); ok {
+			return true
+		}
+		return node.Kind == yaml.MappingNode
+	case reflect.Slice, reflect.Array:
+		return node.Kind == yaml.SequenceNode
+	case reflect.Interface:
+		return true
+	default:
+		return node.Kind == yaml.ScalarNode
+	}
+}
+
+// validationPath returns the path of the field that a validation error is about, for example
+// `validate "http": validate "healthcheck[0]"` returns ["http", "healthcheck", "0"].
+func validationPath(err error) []string {
+	var path []string
+	for _, match := range validatePathRegexp.FindAllStringSubmatch(err.Error(), -1) {
+		name := match[1]
+		if words := strings.Fields(name); len(words) > 1 {
+			// Some fields are named in words, like "http config" for the "http" field.
+			name = words[0]
+		}
+		for _, seg := range strings.Split(name, ".") {
+			if m := pathIndexRegexp.FindStringSubmatch(seg); m != nil {
+				path = append(path, m[1], m[2])
+				continue
+			}
+			path = append(path, seg)
+		}
+	}
+	return path
+}
+
+// lineAt returns the line of the deepest node found following the path, or 0 if no node is found.
+// Segments of the path that do not match any node are skipped.
+func lineAt(root *yaml.Node, path []string) int {
+	node := documentNode(root)
+	if node == nil {
+		return 0
+	}
+	line := 0
+	for _, seg := range path {
+		if seg == "" {
+			continue
+		}
+		key, child := childKeyNode(node, seg)
+		if child == nil {
+			continue
+		}
+		line = key.Line
+		node = child
+	}
+	return line
+}
+
+func documentNode(node *yaml.Node) *yaml.Node {
+	if node != nil && node.Kind == yaml.DocumentNode {
+		if len(node.Content) == 0 {
+			return nil
+		}
+		return node.Content[0]
+	}
+	return node
+}
+
+func childNode(node *yaml.Node, seg string) *yaml.Node {
+	_, child := childKeyNode(node, seg)
+	return child
+}
+
+// childKeyNode returns the node of the key or the index, and the node of the value under it.
+func childKeyNode(node *yaml.Node, seg string) (*yaml.Node, *yaml.Node) {
+	if node == nil {
+		return nil, nil
+	}
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == seg {
+				return node.Content[i], node.Content[i+1]
+			}
+		}
+	case yaml.SequenceNode:
+		idx, err := strconv.Atoi(seg)
+		if err != nil || idx < 0 || idx >= len(node.Content) {
+			return nil, nil
+		}
+		return node.Content[idx], node.Content[idx]
+	}
+	return nil, nil
+}
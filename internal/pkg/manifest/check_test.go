@@ -0,0 +1,188 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckWorkload(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest string
+		inEnv      string
+
+		wanted []string
+	}{
+		"returns the line of a syntax error": {
+			inManifest: `name: frontend
+type: Backend Service
+image:
+  location: nginx
+  port: 80
+ cpu: 256
+`,
+			wanted: []string{"line 5: did not find expected key"},
+		},
+		"returns an error for an invalid type": {
+			inManifest: `name: frontend
+type: Frontend Service
+`,
+			wanted: []string{"line 2: invalid manifest type: Frontend Service"},
+		},
+		"returns every unknown field and type error": {
+			inManifest: `name: frontend
+type: Backend Service
+image:
+  location: nginx
+  prot: 80
+cpu: many
+memory: 512
+count:
+  range: 1-10
+  cpu_percantage: 70
+environments:
+  test:
+    cpu: lots
+    varaibles:
+      LOG_LEVEL: debug
+`,
+			wanted: []string{
+				`line 5: unknown field "prot"`,
+				`line 10: unknown field "cpu_percantage"`,
+				`line 14: unknown field "varaibles"`,
+				"line 6: cannot unmarshal !!str `many` into int",
+				"line 13: cannot unmarshal !!str `lots` into int",
+			},
+		},
+		"returns the semantic errors of the manifest and of each environment override": {
+			inManifest: `name: frontend
+type: Backend Service
+image:
+  location: nginx
+  port: 80
+environments:
+  prod:
+    network:
+      vpc:
+        placement: somewhere
+  staging:
+    image:
+      location: nginx
+      port: 80
+      depends_on:
+        frontend: start
+  test:
+    count: 1
+`,
+			wanted: []string{
+				`line 10 (environment prod): validate "network": validate "vpc": validate "placement": "placement" somewhere must be one of public, private`,
+				`line 11 (environment staging): validate container dependencies: container frontend cannot depend on itself`,
+			},
+		},
+		"does not repeat the errors of the manifest for each environment": {
+			inManifest: `name: frontend
+type: Backend Service
+image:
+  location: nginx
+  port: 80
+network:
+  vpc:
+    placement: somewhere
+environments:
+  test:
+    count: 1
+`,
+			wanted: []string{
+				`line 8: validate "network": validate "vpc": validate "placement": "placement" somewhere must be one of public, private`,
+			},
+		},
+		"validates only the overrides of the environment": {
+			inManifest: `name: frontend
+type: Backend Service
+image:
+  location: nginx
+  port: 80
+environments:
+  prod:
+    image:
+      port: 0
+  test:
+    count: 1
+`,
+			inEnv: "test",
+		},
+		"interpolates the environment variables for the environment": {
+			inManifest: `name: frontend
+type: Backend Service
+image:
+  location: nginx:${COPILOT_ENVIRONMENT_NAME}
+  port: 80
+`,
+			inEnv: "test",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			errs := CheckWorkload([]byte(tc.inManifest), "phonetool", tc.inEnv)
+
+			// THEN
+			var got []string
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestCheckEnvironment(t *testing.T) {
+	testCases := map[string]struct {
+		inManifest string
+
+		wanted []string
+	}{
+		"returns unknown fields and semantic errors": {
+			inManifest: `name: test
+type: Environment
+network:
+  vpc:
+    cidr: 10.0.0.0/16
+    subnet:
+      public: []
+http:
+  public:
+    certificates: [not-an-arn]
+`,
+			wanted: []string{
+				`line 6: unknown field "subnet"`,
+				`line 10: validate "http config": validate "public": parse "certificates[0]": arn: invalid prefix`,
+			},
+		},
+		"returns no errors for a valid manifest": {
+			inManifest: `name: test
+type: Environment
+observability:
+  container_insights: true
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			errs := CheckEnvironment([]byte(tc.inManifest), "phonetool", "test")
+
+			// THEN
+			var got []string
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// exclusiveFields are two sets of keys of a mapping that can't be specified together.
+type exclusiveFields [2][]string
+
+// exclusiveYAMLFields are the mutually exclusive fields of the manifest, by the key of the mapping that holds them,
+// following the override transformers. The fields under the "" key are exclusive in any mapping.
+var exclusiveYAMLFields = map[string][]exclusiveFields{
+	"": {
+		{{"from_cfn"}, {"secretsmanager"}},
+	},
+	"image": {
+		{{"build"}, {"location"}},
+	},
+	"count": {
+		{{"spot"}, {"range", "cooldown", "cpu_percentage", "memory_percentage", "requests", "response_time", "queue_delay", "connections", "metrics", "step_scaling", "schedules"}},
+		{{"spot"}, {"capacity"}},
+	},
+	"efs": {
+		{{"id", "root_dir", "auth"}, {"uid", "gid"}},
+	},
+}
+
+// MergeYAML returns the node of the base document overridden by the node of the override document.
+// Mappings are merged key by key, and any other node of the override, such as a sequence, replaces the node of the base.
+// When the override sets one of two mutually exclusive fields, such as "image.build" and "image.location",
+// the other field is removed from the base.
+// Neither of the nodes is modified.
+func MergeYAML(base, override *yaml.Node) *yaml.Node {
+	return mergeYAML(base, override, "")
+}
+
+func mergeYAML(base, override *yaml.Node, key string) *yaml.Node {
+	base, override = documentNode(base), documentNode(override)
+	if base == nil {
+		return override
+	}
+	if override == nil {
+		return base
+	}
+	if base.Kind != yaml.MappingNode || override.Kind != yaml.MappingNode {
+		return override
+	}
+	merged := *base
+	merged.Content = append([]*yaml.Node(nil), base.Content...)
+	for _, fields := range append(exclusiveYAMLFields[""], exclusiveYAMLFields[key]...) {
+		for i, set := range fields {
+			if hasAnyKey(override, set) {
+				removeKeys(&merged, fields[1-i])
+			}
+		}
+	}
+	for i := 0; i+1 < len(override.Content); i += 2 {
+		key, value := override.Content[i], override.Content[i+1]
+		idx := mappingKeyIndex(&merged, key.Value)
+		if idx == -1 {
+			merged.Content = append(merged.Content, key, value)
+			continue
+		}
+		merged.Content[idx+1] = mergeYAML(merged.Content[idx+1], value, key.Value)
+	}
+	return &merged
+}
+
+// RenderWorkloadForEnv returns the workload manifest that is deployed to the environment:
+// the overrides under "environments.<envName>" are merged into the manifest, and the "environments" field is removed.
+func RenderWorkloadForEnv(in []byte, envName string) ([]byte, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(in, &root); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+	doc := documentNode(&root)
+	if doc == nil || doc.Kind != yaml.MappingNode {
+		return nil, errors.New("manifest is not a mapping")
+	}
+	overrides := childNode(doc, "environments")
+	rendered := *doc
+	rendered.Content = nil
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		if doc.Content[i].Value == "environments" {
+			continue
+		}
+		rendered.Content = append(rendered.Content, doc.Content[i], doc.Content[i+1])
+	}
+	if envOverride := childNode(overrides, envName); envOverride != nil && envOverride.Kind == yaml.MappingNode {
+		return marshalYAML(MergeYAML(&rendered, envOverride))
+	}
+	return marshalYAML(&rendered)
+}
+
+// mappingKeyIndex returns the index of the key in the content of a mapping node, or -1 if the key is not in the mapping.
+func mappingKeyIndex(node *yaml.Node, key string) int {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func hasAnyKey(node *yaml.Node, keys []string) bool {
+	for _, key := range keys {
+		if mappingKeyIndex(node, key) != -1 {
+			return true
+		}
+	}
+	return false
+}
+
+// removeKeys removes the keys and their values from the content of a mapping node.
+func removeKeys(node *yaml.Node, keys []string) {
+	for _, key := range keys {
+		if idx := mappingKeyIndex(node, key); idx != -1 {
+			node.Content = append(node.Content[:idx:idx], node.Content[idx+2:]...)
+		}
+	}
+}
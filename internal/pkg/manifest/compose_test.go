@@ -0,0 +1,204 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestMergeYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inBase     string
+		inOverride string
+
+		wanted string
+	}{
+		"merges mappings recursively": {
+			inBase: `
+image:
+  build: Dockerfile
+  port: 80
+cpu: 256
+`,
+			inOverride: `
+image:
+  port: 8080
+memory: 1024
+`,
+			wanted: `image:
+  build: Dockerfile
+  port: 8080
+cpu: 256
+memory: 1024
+`,
+		},
+		"replaces sequences instead of appending to them": {
+			inBase: `
+http:
+  alias: ["a.example.com", "b.example.com"]
+`,
+			inOverride: `
+http:
+  alias: ["c.example.com"]
+`,
+			wanted: `http:
+  alias: ["c.example.com"]
+`,
+		},
+		"replaces a node of a different kind": {
+			inBase: `
+count:
+  range: 1-10
+`,
+			inOverride: `
+count: 2
+`,
+			wanted: `count: 2
+`,
+		},
+		"removes the location of the image when the override builds it": {
+			inBase: `
+image:
+  location: nginx
+  port: 80
+`,
+			inOverride: `
+image:
+  build: Dockerfile
+`,
+			wanted: `image:
+  port: 80
+  build: Dockerfile
+`,
+		},
+		"removes the build of the image when the override sets its location": {
+			inBase: `
+image:
+  build:
+    dockerfile: Dockerfile
+    context: .
+  port: 80
+`,
+			inOverride: `
+image:
+  location: nginx
+`,
+			wanted: `image:
+  port: 80
+  location: nginx
+`,
+		},
+		"removes the autoscaling fields of the count when the override sets spot": {
+			inBase: `
+count:
+  range: 1-10
+  cpu_percentage: 70
+  cooldown:
+    in: 30s
+`,
+			inOverride: `
+count:
+  spot: 2
+`,
+			wanted: `count:
+  spot: 2
+`,
+		},
+		"removes the secret from CloudFormation when the override reads it from Secrets Manager": {
+			inBase: `
+secrets:
+  DB_PASSWORD:
+    from_cfn: dbPassword
+`,
+			inOverride: `
+secrets:
+  DB_PASSWORD:
+    secretsmanager: demo/db
+`,
+			wanted: `secrets:
+  DB_PASSWORD:
+    secretsmanager: demo/db
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			var base, override yaml.Node
+			require.NoError(t, yaml.Unmarshal([]byte(tc.inBase), &base))
+			require.NoError(t, yaml.Unmarshal([]byte(tc.inOverride), &override))
+			original, err := marshalYAML(&base)
+			require.NoError(t, err)
+
+			// WHEN
+			merged := MergeYAML(&base, &override)
+
+			// THEN
+			got, err := marshalYAML(merged)
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(got))
+			unchanged, err := marshalYAML(&base)
+			require.NoError(t, err)
+			require.Equal(t, string(original), string(unchanged), "the base node must not be modified")
+		})
+	}
+}
+
+func TestRenderWorkloadForEnv(t *testing.T) {
+	in := `name: api
+type: Backend Service
+image:
+  build: Dockerfile
+  port: 8080
+count: 1
+environments:
+  prod:
+    count: 3
+    variables:
+      LOG_LEVEL: warn
+`
+	testCases := map[string]struct {
+		inEnv string
+
+		wanted string
+	}{
+		"applies the overrides of the environment": {
+			inEnv: "prod",
+			wanted: `name: api
+type: Backend Service
+image:
+  build: Dockerfile
+  port: 8080
+count: 3
+variables:
+  LOG_LEVEL: warn
+`,
+		},
+		"removes the overrides of the other environments": {
+			inEnv: "test",
+			wanted: `name: api
+type: Backend Service
+image:
+  build: Dockerfile
+  port: 8080
+count: 1
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := RenderWorkloadForEnv([]byte(in), tc.inEnv)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(got))
+		})
+	}
+}
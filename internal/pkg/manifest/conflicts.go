@@ -0,0 +1,370 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"gopkg.in/yaml.v3"
+)
+
+// Sources of the environment variables of a container.
+const (
+	envSourceVariables    = "variables"
+	envSourceSecrets      = "secrets"
+	envSourceEnvFile      = "env_file"
+	envSourceVarsFromFile = "variables_from_env_file"
+)
+
+// ConflictsInput is a workload manifest to check for conflicts, and what it depends on outside of the manifest.
+type ConflictsInput struct {
+	Manifest []byte
+	App      string
+	Env      string
+
+	// EnvFileVariables returns the names of the variables in the env file at the path relative to the workspace root.
+	// Env files are not checked if it's nil.
+	EnvFileVariables func(path string) ([]string, error)
+
+	// Aliases are the Service Connect aliases of the other workloads in the environment, mapped to the names of the workloads.
+	// Aliases are DNS names, so they are compared without case.
+	Aliases map[string]string
+}
+
+// CheckConflicts returns every conflict of the workload manifest in the environment: ports exposed by more than one
+// container, environment variables of a container set by more than one of "variables", "secrets" and env files,
+// and Service Connect aliases that other workloads already use.
+// Manifests that can't be parsed have no conflicts, since CheckWorkload reports why they can't be parsed.
+func CheckConflicts(in ConflictsInput) []*ValidationError {
+	root, verr := parseManifestNode(in.Manifest, in.App, in.Env)
+	if verr != nil {
+		return nil
+	}
+	mft, verr := decodeWorkloadForEnv(root, in.Env)
+	if verr != nil {
+		return nil
+	}
+	c := &conflictChecker{
+		root: root,
+		env:  in.Env,
+	}
+	containers := containersOf(mft)
+	c.checkPorts(containers)
+	for _, ctr := range containers {
+		c.checkVariables(ctr, in.EnvFileVariables)
+	}
+	if connect, ok := serviceConnectOf(mft); ok {
+		c.checkAliases(connect, workloadName(mft), in.Aliases)
+	}
+	return c.errs
+}
+
+// ServiceConnectAliases returns the Service Connect aliases of the workload in the environment.
+// Returns nil if the workload doesn't use Service Connect, or if its manifest is invalid.
+func ServiceConnectAliases(in []byte, appName, envName string) []string {
+	root, verr := parseManifestNode(in, appName, envName)
+	if verr != nil {
+		return nil
+	}
+	mft, verr := decodeWorkloadForEnv(root, envName)
+	if verr != nil {
+		return nil
+	}
+	connect, ok := serviceConnectOf(mft)
+	if !ok {
+		return nil
+	}
+	var aliases []string
+	for _, alias := range connectAliases(connect, workloadName(mft)) {
+		aliases = append(aliases, alias.name)
+	}
+	return aliases
+}
+
+func decodeWorkloadForEnv(root *yaml.Node, envName string) (workloadManifest, *ValidationError) {
+	var am struct {
+		Type string `yaml:"type"`
+	}
+	if err := root.Decode(&am); err != nil {
+		return nil, &ValidationError{Err: err}
+	}
+	mft, err := newDefaultWorkloadManifest(am.Type)
+	if err != nil {
+		return nil, &ValidationError{Line: lineAt(root, []string{"type"}), Err: err}
+	}
+	if err := root.Decode(mft); err != nil {
+		return nil, yamlDecodeErrors(err)[0]
+	}
+	envMft, err := mft.applyEnv(envName)
+	if err != nil {
+		return nil, &ValidationError{
+			Line: lineAt(root, []string{"environments", envName}),
+			Env:  envName,
+			Err:  fmt.Errorf("apply overrides: %w", err),
+		}
+	}
+	return envMft, nil
+}
+
+// containerEnv is what a container of a workload exposes and sets, with the path of the container in the manifest.
+type containerEnv struct {
+	name         string
+	path         []string
+	port         *string
+	portPath     []string
+	variables    map[string]Variable
+	secrets      map[string]Secret
+	envFile      *string
+	varsFromFile *string // The log router has no variables rendered from an env file.
+}
+
+// containersOf returns the main container, the sidecars and the log router of the workload, sorted by their path.
+func containersOf(mft workloadManifest) []containerEnv {
+	var name string
+	var port *uint16
+	var tc TaskConfig
+	var logging Logging
+	var sidecars map[string]*SidecarConfig
+	switch m := mft.(type) {
+	case *LoadBalancedWebService:
+		name, port, tc, logging, sidecars = aws.StringValue(m.Name), m.ImageConfig.Port, m.TaskConfig, m.Logging, m.Sidecars
+	case *BackendService:
+		name, port, tc, logging, sidecars = aws.StringValue(m.Name), m.ImageConfig.Port, m.TaskConfig, m.Logging, m.Sidecars
+	case *WorkerService:
+		name, tc, logging, sidecars = aws.StringValue(m.Name), m.TaskConfig, m.Logging, m.Sidecars
+	case *ScheduledJob:
+		name, tc, logging, sidecars = aws.StringValue(m.Name), m.TaskConfig, m.Logging, m.Sidecars
+	default:
+		return nil
+	}
+	main := containerEnv{
+		name:         name,
+		portPath:     []string{"image", "port"},
+		variables:    tc.Variables,
+		secrets:      tc.Secrets,
+		envFile:      tc.EnvFile,
+		varsFromFile: tc.VariablesFromEnvFile,
+	}
+	if port != nil {
+		main.port = aws.String(strconv.FormatUint(uint64(*port), 10))
+	}
+	containers := []containerEnv{main}
+	names := make([]string, 0, len(sidecars))
+	for name := range sidecars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		sidecar := sidecars[name]
+		if sidecar == nil {
+			continue
+		}
+		path := []string{"sidecars", name}
+		containers = append(containers, containerEnv{
+			name:         name,
+			path:         path,
+			port:         sidecar.Port,
+			portPath:     append(append([]string{}, path...), "port"),
+			variables:    sidecar.Variables,
+			secrets:      sidecar.Secrets,
+			envFile:      sidecar.EnvFile,
+			varsFromFile: sidecar.VariablesFromEnvFile,
+		})
+	}
+	if !logging.IsEmpty() {
+		containers = append(containers, containerEnv{
+			name:      FirelensContainerName,
+			path:      []string{"logging"},
+			variables: logging.Variables,
+			secrets:   logging.Secrets,
+			envFile:   logging.EnvFile,
+		})
+	}
+	return containers
+}
+
+func serviceConnectOf(mft workloadManifest) (ServiceConnectBoolOrArgs, bool) {
+	var connect ServiceConnectBoolOrArgs
+	switch m := mft.(type) {
+	case *LoadBalancedWebService:
+		connect = m.Network.Connect
+	case *BackendService:
+		connect = m.Network.Connect
+	case *WorkerService:
+		connect = m.Network.Connect
+	default:
+		return connect, false
+	}
+	return connect, connect.Enabled()
+}
+
+func workloadName(mft workloadManifest) string {
+	switch m := mft.(type) {
+	case *LoadBalancedWebService:
+		return aws.StringValue(m.Name)
+	case *BackendService:
+		return aws.StringValue(m.Name)
+	case *WorkerService:
+		return aws.StringValue(m.Name)
+	}
+	return ""
+}
+
+type connectAlias struct {
+	name string
+	path []string
+}
+
+// connectAliases returns the DNS names that the workload is reachable at with Service Connect.
+// Ports without an alias use the alias of the service, which defaults to the name of the workload.
+func connectAliases(connect ServiceConnectBoolOrArgs, name string) []connectAlias {
+	main := connectAlias{
+		name: name,
+		path: []string{"network", "connect"},
+	}
+	if connect.Alias != nil {
+		main = connectAlias{
+			name: aws.StringValue(connect.Alias),
+			path: []string{"network", "connect", "alias"},
+		}
+	}
+	aliases := []connectAlias{main}
+	seen := map[string]bool{main.name: true}
+	for i, port := range connect.Ports {
+		alias := aws.StringValue(port.Alias)
+		if alias == "" || seen[alias] { // Ports without an alias use the alias of the service.
+			continue
+		}
+		seen[alias] = true
+		aliases = append(aliases, connectAlias{
+			name: alias,
+			path: []string{"network", "connect", "ports", strconv.Itoa(i), "alias"},
+		})
+	}
+	return aliases
+}
+
+type conflictChecker struct {
+	root *yaml.Node
+	env  string
+	errs []*ValidationError
+}
+
+// add reports the conflict at the line of the field in the overrides of the environment,
+// or in the base manifest if the environment doesn't override the field.
+func (c *conflictChecker) add(path []string, err error) {
+	line := exactLineAt(c.root, append([]string{"environments", c.env}, path...))
+	if line == 0 {
+		line = lineAt(c.root, path)
+	}
+	c.errs = append(c.errs, &ValidationError{
+		Line: line,
+		Env:  c.env,
+		Err:  err,
+	})
+}
+
+// checkPorts reports the containers that expose a port already exposed by another container of the task.
+func (c *conflictChecker) checkPorts(containers []containerEnv) {
+	exposedBy := make(map[string]string)
+	for _, ctr := range containers {
+		if ctr.port == nil {
+			continue
+		}
+		port, _, err := ParsePortMapping(ctr.port)
+		if err != nil || port == nil {
+			continue // Invalid ports are reported by the validation of the manifest.
+		}
+		if other, ok := exposedBy[*port]; ok {
+			c.add(ctr.portPath, fmt.Errorf(`container %q exposes port %s, which container %q already exposes`, ctr.name, *port, other))
+			continue
+		}
+		exposedBy[*port] = ctr.name
+	}
+}
+
+// checkVariables reports the environment variables of the container that more than one source sets.
+func (c *conflictChecker) checkVariables(ctr containerEnv, envFileVariables func(path string) ([]string, error)) {
+	sourceOf := make(map[string]string)
+	set := func(name, source string, path []string) {
+		if other, ok := sourceOf[name]; ok {
+			c.add(path, fmt.Errorf(`environment variable %s of container %q is set in both %q and %q`, name, ctr.name, other, source))
+			return
+		}
+		sourceOf[name] = source
+	}
+	for _, name := range sortedKeys(ctr.variables) {
+		sourceOf[name] = envSourceVariables
+	}
+	for _, name := range sortedKeys(ctr.secrets) {
+		set(name, envSourceSecrets, append(append([]string{}, ctr.path...), envSourceSecrets, name))
+	}
+	if envFileVariables == nil {
+		return
+	}
+	for _, src := range []struct {
+		name string
+		path *string
+	}{
+		{name: envSourceVarsFromFile, path: ctr.varsFromFile},
+		{name: envSourceEnvFile, path: ctr.envFile},
+	} {
+		file := aws.StringValue(src.path)
+		if file == "" {
+			continue
+		}
+		fieldPath := append(append([]string{}, ctr.path...), src.name)
+		names, err := envFileVariables(file)
+		if err != nil {
+			c.add(fieldPath, fmt.Errorf("read env file %s of container %q: %w", file, ctr.name, err))
+			continue
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			set(name, fmt.Sprintf("%s %s", src.name, file), fieldPath)
+		}
+	}
+}
+
+// checkAliases reports the Service Connect aliases of the workload that other workloads of the environment use.
+func (c *conflictChecker) checkAliases(connect ServiceConnectBoolOrArgs, name string, others map[string]string) {
+	usedBy := make(map[string]string, len(others))
+	for alias, wl := range others {
+		usedBy[strings.ToLower(alias)] = wl
+	}
+	for _, alias := range connectAliases(connect, name) {
+		other, ok := usedBy[strings.ToLower(alias.name)]
+		if !ok || other == name {
+			continue
+		}
+		c.add(alias.path, fmt.Errorf(`Service Connect alias %q is already used by %s`, alias.name, other))
+	}
+}
+
+// exactLineAt returns the line of the node at the path, or 0 if a segment of the path does not match any node.
+func exactLineAt(root *yaml.Node, path []string) int {
+	node := documentNode(root)
+	line := 0
+	for _, seg := range path {
+		key, child := childKeyNode(node, seg)
+		if child == nil {
+			return 0
+		}
+		line, node = key.Line, child
+	}
+	return line
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,230 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckConflicts(t *testing.T) {
+	envFiles := map[string][]string{
+		"api.env":    {"LOG_LEVEL", "DB_NAME"},
+		"envoy.env":  {"ADMIN_PORT"},
+		"shared.env": {"API_KEY"},
+	}
+	readEnvFile := func(path string) ([]string, error) {
+		vars, ok := envFiles[path]
+		if !ok {
+			return nil, errors.New("file does not exist")
+		}
+		return vars, nil
+	}
+	testCases := map[string]struct {
+		manifest string
+		env      string
+		aliases  map[string]string
+		noFiles  bool
+
+		wanted []string
+	}{
+		"no conflicts": {
+			manifest: `name: api
+type: Backend Service
+image:
+  location: nginx
+  port: 8080
+variables:
+  LOG_LEVEL: info
+secrets:
+  DB_PASSWORD: /db/password
+sidecars:
+  envoy:
+    image: envoy
+    port: 9901
+    variables:
+      LOG_LEVEL: debug
+network:
+  connect: true
+`,
+			env:     "test",
+			aliases: map[string]string{"frontend": "frontend"},
+		},
+		"every conflict is reported with its line": {
+			manifest: `name: api
+type: Load Balanced Web Service
+image:
+  location: nginx
+  port: 80
+http:
+  path: /
+variables:
+  LOG_LEVEL: info
+  API_KEY: abc
+secrets:
+  LOG_LEVEL: /log/level
+env_file: api.env
+sidecars:
+  envoy:
+    image: envoy
+    port: 80/tcp
+    env_file: envoy.env
+    variables:
+      ADMIN_PORT: "9901"
+  datadog:
+    image: datadog
+    port: "80"
+logging:
+  variables:
+    FLUSH: "5"
+  secrets:
+    FLUSH: /fluent/flush
+network:
+  connect:
+    alias: Backend
+    ports:
+      - port: 9000
+        alias: admin
+`,
+			env:     "test",
+			aliases: map[string]string{"backend": "orders", "admin": "api"},
+			wanted: []string{
+				`line 23 (environment test): container "datadog" exposes port 80, which container "api" already exposes`,
+				`line 17 (environment test): container "envoy" exposes port 80, which container "api" already exposes`,
+				`line 12 (environment test): environment variable LOG_LEVEL of container "api" is set in both "variables" and "secrets"`,
+				`line 13 (environment test): environment variable LOG_LEVEL of container "api" is set in both "variables" and "env_file api.env"`,
+				`line 18 (environment test): environment variable ADMIN_PORT of container "envoy" is set in both "variables" and "env_file envoy.env"`,
+				`line 28 (environment test): environment variable FLUSH of container "firelens_log_router" is set in both "variables" and "secrets"`,
+				`line 31 (environment test): Service Connect alias "Backend" is already used by orders`,
+			},
+		},
+		"conflicts introduced by environment overrides": {
+			manifest: `name: api
+type: Backend Service
+image:
+  location: nginx
+variables_from_env_file: shared.env
+environments:
+  prod:
+    secrets:
+      API_KEY: /api/key
+    env_file: missing.env
+`,
+			env: "prod",
+			wanted: []string{
+				`line 5 (environment prod): environment variable API_KEY of container "api" is set in both "secrets" and "variables_from_env_file shared.env"`,
+				`line 10 (environment prod): read env file missing.env of container "api": file does not exist`,
+			},
+		},
+		"variables of a sidecar from an env file": {
+			manifest: `name: api
+type: Backend Service
+image:
+  location: nginx
+sidecars:
+  envoy:
+    image: envoyproxy/envoy
+    secrets:
+      ADMIN_PORT: /envoy/admin-port
+    variables_from_env_file: envoy.env
+`,
+			env: "test",
+			wanted: []string{
+				`line 10 (environment test): environment variable ADMIN_PORT of container "envoy" is set in both "secrets" and "variables_from_env_file envoy.env"`,
+			},
+		},
+		"env files are skipped without a reader": {
+			manifest: `name: report
+type: Scheduled Job
+image:
+  location: nginx
+on:
+  schedule: "@daily"
+variables:
+  DB_NAME: reports
+env_file: api.env
+`,
+			env:     "test",
+			noFiles: true,
+		},
+		"the default alias is the name of the service": {
+			manifest: `name: api
+type: Backend Service
+image:
+  location: nginx
+  port: 8080
+network:
+  connect: true
+`,
+			env:     "test",
+			aliases: map[string]string{"API": "legacy-api"},
+			wanted: []string{
+				`line 7 (environment test): Service Connect alias "api" is already used by legacy-api`,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			in := ConflictsInput{
+				Manifest:         []byte(tc.manifest),
+				App:              "phonetool",
+				Env:              tc.env,
+				EnvFileVariables: readEnvFile,
+				Aliases:          tc.aliases,
+			}
+			if tc.noFiles {
+				in.EnvFileVariables = nil
+			}
+
+			var got []string
+			for _, err := range CheckConflicts(in) {
+				got = append(got, err.Error())
+			}
+
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestServiceConnectAliases(t *testing.T) {
+	testCases := map[string]struct {
+		manifest string
+		wanted   []string
+	}{
+		"no aliases without Service Connect": {
+			manifest: `name: api
+type: Backend Service
+image:
+  location: nginx
+`,
+		},
+		"aliases of the service and its ports": {
+			manifest: `name: api
+type: Backend Service
+image:
+  location: nginx
+  port: 8080
+network:
+  connect:
+    ports:
+      - port: 9000
+        alias: admin
+      - port: 9001
+environments:
+  test:
+    network:
+      connect:
+        alias: api-test
+`,
+			wanted: []string{"api-test", "admin"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, ServiceConnectAliases([]byte(tc.manifest), "phonetool", "test"))
+		})
+	}
+}
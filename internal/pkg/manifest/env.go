@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -19,6 +20,25 @@ const Environmentmanifestinfo = "Environment"
 
 var environmentManifestPath = "environment/manifest.yml"
 
+const defaultEC2CapacityProviderMaxSize = 10
+
+// Operating systems of the ECS-optimized AMIs that the EC2 instances of an environment can run.
+var ec2OSFamilies = []string{OSLinux, OSWindowsServer2019Core, OSWindowsServer2019Full, OSWindowsServer2022Core, OSWindowsServer2022Full}
+
+// Deployment events that subscribers of an environment can be notified of.
+const (
+	DeploymentEventStarted    = "started"
+	DeploymentEventSucceeded  = "succeeded"
+	DeploymentEventFailed     = "failed"
+	DeploymentEventRolledBack = "rolled_back"
+)
+
+// DeploymentEvents are the deployment events that subscribers can be notified of.
+var DeploymentEvents = []string{DeploymentEventStarted, DeploymentEventSucceeded, DeploymentEventFailed, DeploymentEventRolledBack}
+
+// Placeholders that can be used in the message of a notification.
+var notificationPlaceholders = []string{"app", "env", "event", "stack_id", "status", "reason", "time"}
+
 // Error definitions.
 var (
 	errUnmarshalPortsConfig          = errors.New(`unable to unmarshal ports field into int or a range`)
@@ -95,6 +115,9 @@ type EnvironmentConfig struct {
 	Observability environmentObservability `yaml:"observability,omitempty,flow"`
 	HTTPConfig    EnvironmentHTTPConfig    `yaml:"http,omitempty,flow"`
 	CDNConfig     EnvironmentCDNConfig     `yaml:"cdn,omitempty,flow"`
+	Imports       environmentImports       `yaml:"imports,omitempty,flow"`
+	Compute       environmentCompute       `yaml:"compute,omitempty,flow"`
+	Notifications []Notification           `yaml:"notifications,omitempty"`
 }
 
 // IsPublicLBIngressRestrictedToCDN returns whether an environment has its
@@ -114,7 +137,115 @@ func (mft *EnvironmentConfig) GetPublicALBSourceIPs() []IPNet {
 	return mft.HTTPConfig.Public.Ingress.SourceIPs
 }
 
+// environmentImports holds the existing resources that the environment attaches to instead of creating them.
+type environmentImports struct {
+	Cluster    *string           `yaml:"cluster,omitempty"`     // Name of an existing ECS cluster.
+	HostedZone *string           `yaml:"hosted_zone,omitempty"` // ID of an existing hosted zone for the environment's subdomain.
+	PublicALB  importedPublicALB `yaml:"public_alb,omitempty"`
+}
+
+// IsEmpty returns true if the environment doesn't import any existing resource.
+func (i environmentImports) IsEmpty() bool {
+	return i.Cluster == nil && i.HostedZone == nil && i.PublicALB.IsEmpty()
+}
+
+// importedPublicALB holds an existing internet-facing Application Load Balancer and its listeners.
+type importedPublicALB struct {
+	ARN       *string           `yaml:"arn,omitempty"`
+	Listeners importedListeners `yaml:"listeners,omitempty"`
+}
+
+// IsEmpty returns true if no existing public load balancer is imported.
+func (alb importedPublicALB) IsEmpty() bool {
+	return alb.ARN == nil && alb.Listeners.isEmpty()
+}
+
+type importedListeners struct {
+	HTTP  importedListener `yaml:"http,omitempty"`
+	HTTPS importedListener `yaml:"https,omitempty"`
+}
+
+func (l importedListeners) isEmpty() bool {
+	return l.HTTP.isEmpty() && l.HTTPS.isEmpty()
+}
+
+// importedListener holds an existing listener and the additional certificates to attach to it.
+type importedListener struct {
+	ARN          *string  `yaml:"arn,omitempty"`
+	Certificates []string `yaml:"certificates,omitempty"`
+}
+
+func (l importedListener) isEmpty() bool {
+	return l.ARN == nil && len(l.Certificates) == 0
+}
+
+// environmentCompute holds the compute capacity that the environment provisions for its workloads in addition to Fargate.
+type environmentCompute struct {
+	EC2 EC2CapacityProvider `yaml:"ec2,omitempty"`
+}
+
+// EC2CapacityProvider holds the configuration of an Auto Scaling group of EC2 instances that is registered
+// as a capacity provider of the environment's cluster, for workloads with "compute: ec2".
+type EC2CapacityProvider struct {
+	InstanceType *string `yaml:"instance_type,omitempty"`
+	AMI          *string `yaml:"ami,omitempty"` // Defaults to the latest ECS-optimized AMI of the OS.
+	OS           *string `yaml:"os,omitempty"`  // Operating system of the ECS-optimized AMI, "linux" by default.
+	GPU          *bool   `yaml:"gpu,omitempty"` // Use the GPU variant of the ECS-optimized Amazon Linux 2 AMI.
+	MinSize      *int    `yaml:"min,omitempty"`
+	MaxSize      *int    `yaml:"max,omitempty"`
+}
+
+// IsEmpty returns true if the environment doesn't provision EC2 instances.
+func (c EC2CapacityProvider) IsEmpty() bool {
+	return c.InstanceType == nil && c.AMI == nil && c.OS == nil && c.GPU == nil && c.MinSize == nil && c.MaxSize == nil
+}
+
+// Min returns the minimum number of instances, 0 by default.
+func (c EC2CapacityProvider) Min() int {
+	return aws.IntValue(c.MinSize)
+}
+
+// Max returns the maximum number of instances.
+func (c EC2CapacityProvider) Max() int {
+	if c.MaxSize == nil {
+		return defaultEC2CapacityProviderMaxSize
+	}
+	return aws.IntValue(c.MaxSize)
+}
+
+// IsWindows returns true if the instances run the ECS-optimized AMI of a Windows Server OS.
+func (c EC2CapacityProvider) IsWindows() bool {
+	os := aws.StringValue(c.OS)
+	return os != "" && os != OSLinux
+}
+
+// Notification subscribes a destination to the deployment events of the stacks in the environment.
+type Notification struct {
+	Events  []string `yaml:"events,omitempty"`  // Defaults to every deployment event.
+	SNS     *string  `yaml:"sns,omitempty"`     // ARN of an SNS topic.
+	Slack   *string  `yaml:"slack,omitempty"`   // URL of a Slack incoming webhook.
+	Webhook *string  `yaml:"webhook,omitempty"` // URL that receives the event as a JSON payload.
+	Message *string  `yaml:"message,omitempty"` // Template of the payload, see notificationPlaceholders.
+}
+
+// DeploymentEvents returns the deployment events that the destination is notified of.
+func (n Notification) DeploymentEvents() []string {
+	if len(n.Events) == 0 {
+		return DeploymentEvents
+	}
+	return n.Events
+}
+
+// IP address types of the network of an environment.
+const (
+	IPAddressTypeIPv4      = "ipv4"
+	IPAddressTypeDualStack = "dualstack"
+)
+
+var ipAddressTypes = []string{IPAddressTypeIPv4, IPAddressTypeDualStack}
+
 type environmentNetworkConfig struct {
+	IP  *string              `yaml:"ip,omitempty"` // Either "ipv4" or "dualstack".
 	VPC environmentVPCConfig `yaml:"vpc,omitempty"`
 }
 
@@ -124,6 +255,8 @@ type environmentVPCConfig struct {
 	Subnets             subnetsConfiguration          `yaml:"subnets,omitempty"`
 	SecurityGroupConfig securityGroupConfig           `yaml:"security_group,omitempty"`
 	FlowLogs            Union[*bool, VPCFlowLogsArgs] `yaml:"flow_logs,omitempty"`
+	NAT                 NATConfig                     `yaml:"nat,omitempty"`
+	IPv6                *bool                         `yaml:"ipv6,omitempty"`
 }
 
 type securityGroupConfig struct {
@@ -156,10 +289,14 @@ func (cfg *portsConfig) IsEmpty() bool {
 
 // GetPorts returns the from and to ports of a security group rule.
 func (r securityGroupRule) GetPorts() (from, to int, err error) {
-	if r.Ports.Range == nil {
-		return aws.IntValue(r.Ports.Port), aws.IntValue(r.Ports.Port), nil // a single value is provided for ports.
+	return r.Ports.fromTo()
+}
+
+func (cfg portsConfig) fromTo() (from, to int, err error) {
+	if cfg.Range == nil {
+		return aws.IntValue(cfg.Port), aws.IntValue(cfg.Port), nil // a single value is provided for ports.
 	}
-	return r.Ports.Range.Parse()
+	return cfg.Range.Parse()
 }
 
 // UnmarshalYAML overrides the default YAML unmarshaling logic for the Ports
@@ -187,14 +324,43 @@ func (cfg *portsConfig) UnmarshalYAML(value *yaml.Node) error {
 	return nil
 }
 
+// Destinations of the VPC flow logs.
+const (
+	FlowLogsDestinationCloudWatch = "cloudwatch"
+	FlowLogsDestinationS3         = "s3"
+)
+
+var flowLogsDestinations = []string{FlowLogsDestinationCloudWatch, FlowLogsDestinationS3}
+
+// Strategies to route the IPv4 egress traffic of the private subnets of a managed VPC.
+const (
+	NATStrategyPerAZ  = "per_az"
+	NATStrategySingle = "single"
+	NATStrategyNone   = "none"
+)
+
+var natStrategies = []string{NATStrategyPerAZ, NATStrategySingle, NATStrategyNone}
+
+// NATConfig holds the topology of the NAT devices of a managed VPC.
+type NATConfig struct {
+	Strategy     *string `yaml:"strategy,omitempty"`      // Defaults to a NAT per availability zone.
+	InstanceType *string `yaml:"instance_type,omitempty"` // If set, NAT instances replace the NAT gateways.
+}
+
+// IsEmpty returns true if the NAT topology is left to the default.
+func (c NATConfig) IsEmpty() bool {
+	return c.Strategy == nil && c.InstanceType == nil
+}
+
 // VPCFlowLogsArgs holds the flow logs configuration.
 type VPCFlowLogsArgs struct {
-	Retention *int `yaml:"retention,omitempty"`
+	Retention   *int    `yaml:"retention,omitempty"`
+	Destination *string `yaml:"destination,omitempty"` // Either a CloudWatch log group or a Copilot-managed S3 bucket.
 }
 
 // IsZero implements yaml.IsZeroer.
 func (fl *VPCFlowLogsArgs) IsZero() bool {
-	return fl.Retention == nil
+	return fl.Retention == nil && fl.Destination == nil
 }
 
 // EnvSecurityGroup returns the security group config if the user has set any values.
@@ -237,6 +403,11 @@ func (cfg *EnvironmentConfig) CDNEnabled() bool {
 	return aws.BoolValue(cfg.CDNConfig.Enabled)
 }
 
+// ImportsPublicALB returns true when the environment attaches to an existing public load balancer instead of creating one.
+func (cfg *EnvironmentConfig) ImportsPublicALB() bool {
+	return aws.StringValue(cfg.Imports.PublicALB.ARN) != ""
+}
+
 // HasImportedPublicALBCerts returns true when the environment's ALB
 // is configured with certs for the public listener.
 func (cfg *EnvironmentConfig) HasImportedPublicALBCerts() bool {
@@ -285,7 +456,8 @@ func (cfg CDNStaticConfig) IsEmpty() bool {
 
 // IsEmpty returns true if environmentVPCConfig is not configured.
 func (cfg environmentVPCConfig) IsEmpty() bool {
-	return cfg.ID == nil && cfg.CIDR == nil && cfg.Subnets.IsEmpty() && cfg.FlowLogs.IsZero()
+	return cfg.ID == nil && cfg.CIDR == nil && cfg.Subnets.IsEmpty() && cfg.FlowLogs.IsZero() &&
+		cfg.NAT.IsEmpty() && cfg.IPv6 == nil
 }
 
 func (cfg *environmentVPCConfig) loadVPCConfig(env *config.CustomizeEnv) {
@@ -423,12 +595,29 @@ type subnetConfiguration struct {
 }
 
 type environmentObservability struct {
-	ContainerInsights *bool `yaml:"container_insights,omitempty"`
+	ContainerInsights *bool                            `yaml:"container_insights,omitempty"`
+	StaticSiteLogs    Union[*bool, StaticSiteLogsArgs] `yaml:"static_site_logs,omitempty"`
+}
+
+// StaticSiteLogsArgs holds the configuration of the bucket for the CloudFront access logs of the Static Sites in the environment.
+type StaticSiteLogsArgs struct {
+	Retention *int `yaml:"retention,omitempty"` // Number of days after which the logs are deleted.
 }
 
 // IsEmpty returns true if there is no configuration to the environment's observability.
 func (o *environmentObservability) IsEmpty() bool {
-	return o == nil || o.ContainerInsights == nil
+	return o == nil || (o.ContainerInsights == nil && o.StaticSiteLogs.IsZero())
+}
+
+// DualStack returns true if the load balancers and the tasks of the environment are reachable over both IPv4 and IPv6.
+func (cfg *EnvironmentConfig) DualStack() bool {
+	return aws.StringValue(cfg.Network.IP) == IPAddressTypeDualStack
+}
+
+// StaticSiteLogsEnabled returns true if the Static Sites of the environment log their requests.
+func (cfg *EnvironmentConfig) StaticSiteLogsEnabled() bool {
+	logs := cfg.Observability.StaticSiteLogs
+	return aws.BoolValue(logs.Basic) || logs.IsAdvanced()
 }
 
 func (o *environmentObservability) loadObsConfig(tele *config.Telemetry) {
@@ -472,6 +661,39 @@ type PublicHTTPConfig struct {
 	ELBAccessLogs ELBAccessLogsArgsOrBool           `yaml:"access_logs,omitempty"`
 	Ingress       RestrictiveIngress                `yaml:"ingress,omitempty"`
 	SSLPolicy     *string                           `yaml:"ssl_policy,omitempty"`
+	TrustStore    TrustStoreConfig                  `yaml:"trust_store,omitempty"`
+	WAF           WAF                               `yaml:"waf,omitempty"`
+
+	ManagedCertificates []ManagedCertificate `yaml:"managed_certificates,omitempty"`
+}
+
+// ManagedCertificate represents an ACM certificate that Copilot requests and validates
+// with DNS records in an existing hosted zone that isn't managed by the application.
+type ManagedCertificate struct {
+	Domain           *string  `yaml:"domain"`
+	HostedZone       *string  `yaml:"hosted_zone"`
+	AlternativeNames []string `yaml:"alternative_names"` // Subject alternative names, validated in the same hosted zone.
+}
+
+// Domains returns the domain name of the certificate followed by its subject alternative names.
+func (c ManagedCertificate) Domains() []string {
+	return append([]string{aws.StringValue(c.Domain)}, c.AlternativeNames...)
+}
+
+// HasCertificates returns true if the public load balancer serves certificates that are imported or managed by the environment.
+func (cfg PublicHTTPConfig) HasCertificates() bool {
+	return len(cfg.Certificates) != 0 || len(cfg.ManagedCertificates) != 0
+}
+
+// TrustStoreConfig represents the trust store used by the public HTTPS listener to verify client certificates.
+type TrustStoreConfig struct {
+	CABundle                      *string `yaml:"ca_bundle,omitempty"` // S3 URI of the PEM bundle of the certificate authorities.
+	IgnoreClientCertificateExpiry *bool   `yaml:"ignore_client_certificate_expiry,omitempty"`
+}
+
+// IsEmpty returns true if the trust store is not configured.
+func (t TrustStoreConfig) IsEmpty() bool {
+	return t.CABundle == nil && t.IgnoreClientCertificateExpiry == nil
 }
 
 // ELBAccessLogsArgsOrBool is a custom type which supports unmarshaling yaml which
@@ -514,10 +736,11 @@ func (al *ELBAccessLogsArgsOrBool) UnmarshalYAML(value *yaml.Node) error {
 type ELBAccessLogsArgs struct {
 	BucketName *string `yaml:"bucket_name,omitempty"`
 	Prefix     *string `yaml:"prefix,omitempty"`
+	Retention  *int    `yaml:"retention,omitempty"` // Number of days after which the logs in the Copilot-managed bucket are deleted.
 }
 
 func (al *ELBAccessLogsArgs) isEmpty() bool {
-	return al.BucketName == nil && al.Prefix == nil
+	return al.BucketName == nil && al.Prefix == nil && al.Retention == nil
 }
 
 // ELBAccessLogs returns the access logs config if the user has set any values.
@@ -557,7 +780,8 @@ func (i RestrictiveIngress) IsEmpty() bool {
 
 // IsEmpty returns true if there is no customization to the public ALB.
 func (cfg PublicHTTPConfig) IsEmpty() bool {
-	return len(cfg.Certificates) == 0 && cfg.DeprecatedSG.IsEmpty() && cfg.ELBAccessLogs.isEmpty() && cfg.Ingress.IsEmpty() && cfg.SSLPolicy == nil
+	return len(cfg.Certificates) == 0 && cfg.DeprecatedSG.IsEmpty() && cfg.ELBAccessLogs.isEmpty() && cfg.Ingress.IsEmpty() && cfg.SSLPolicy == nil &&
+		cfg.TrustStore.IsEmpty() && cfg.WAF.IsZero() && len(cfg.ManagedCertificates) == 0
 }
 
 type privateHTTPConfig struct {
@@ -566,11 +790,35 @@ type privateHTTPConfig struct {
 	DeprecatedSG       DeprecatedALBSecurityGroupsConfig `yaml:"security_groups,omitempty"` // Deprecated. This field is now available in Ingress.
 	Ingress            RelaxedIngress                    `yaml:"ingress,omitempty"`
 	SSLPolicy          *string                           `yaml:"ssl_policy,omitempty"`
+	HostedZone         PrivateHostedZoneConfig           `yaml:"hosted_zone,omitempty"`
 }
 
 // IsEmpty returns true if there is no customization to the internal ALB.
 func (cfg privateHTTPConfig) IsEmpty() bool {
-	return len(cfg.InternalALBSubnets) == 0 && len(cfg.Certificates) == 0 && cfg.DeprecatedSG.IsEmpty() && cfg.Ingress.IsEmpty() && cfg.SSLPolicy == nil
+	return len(cfg.InternalALBSubnets) == 0 && len(cfg.Certificates) == 0 && cfg.DeprecatedSG.IsEmpty() && cfg.Ingress.IsEmpty() && cfg.SSLPolicy == nil &&
+		cfg.HostedZone.IsEmpty()
+}
+
+// PrivateHostedZoneConfig represents a Route 53 private hosted zone created for the VPC of the environment,
+// in which the aliases of Backend Services are resolved to the internal load balancer.
+type PrivateHostedZoneConfig struct {
+	Name           *string  `yaml:"name,omitempty"`
+	AssociatedVPCs []string `yaml:"associated_vpcs,omitempty"` // Other VPCs in the region that can resolve the records of the zone.
+}
+
+// IsEmpty returns true if the private hosted zone is not configured.
+func (cfg PrivateHostedZoneConfig) IsEmpty() bool {
+	return cfg.Name == nil && len(cfg.AssociatedVPCs) == 0
+}
+
+// Contains returns true if the domain name is the name of the hosted zone or one of its subdomains.
+func (cfg PrivateHostedZoneConfig) Contains(domain string) bool {
+	zone := strings.TrimSuffix(aws.StringValue(cfg.Name), ".")
+	if zone == "" {
+		return false
+	}
+	domain = strings.TrimSuffix(domain, ".")
+	return domain == zone || strings.HasSuffix(domain, "."+zone)
 }
 
 // HasVPCIngress returns true if the private ALB allows ingress from within the VPC.
@@ -0,0 +1,53 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Environment variable names consist solely of letters, digits, and underscores, and do not begin with a digit.
+var envFileKeyRegExp = regexp.MustCompile(`^[_a-zA-Z][_a-zA-Z0-9]*$`)
+
+// UnmarshalEnvFile parses the content of an env file into environment variables.
+// Each line of the file is formatted as <key>=<value>, where the value can be surrounded by quotes and can refer to
+// ${COPILOT_APPLICATION_NAME}, ${COPILOT_ENVIRONMENT_NAME} or OS environment variables.
+// Empty lines and lines starting with "#" are ignored.
+func UnmarshalEnvFile(content []byte, interpolator *Interpolator) (map[string]string, error) {
+	vars := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected the format <key>=<value>", lineNum)
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if !envFileKeyRegExp.MatchString(key) {
+			return nil, fmt.Errorf("line %d: key %q must contain only letters, numbers and underscores, and must not start with a number", lineNum, key)
+		}
+		if _, ok := vars[key]; ok {
+			return nil, fmt.Errorf("line %d: key %q is defined more than once", lineNum, key)
+		}
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		interpolated, err := interpolator.interpolatePart(value)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNum, err)
+		}
+		vars[key] = interpolated
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return vars, nil
+}
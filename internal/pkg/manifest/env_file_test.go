@@ -0,0 +1,72 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalEnvFile(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+		inEnvVars map[string]string
+
+		wanted      map[string]string
+		wantedError error
+	}{
+		"parse the variables": {
+			inContent: `# Configuration for the ${COPILOT_ENVIRONMENT_NAME} environment.
+LOG_LEVEL=info
+
+API_URL = "https://${COPILOT_ENVIRONMENT_NAME}.${DOMAIN}/api"
+GREETING='hello=world'
+EMPTY=
+`,
+			inEnvVars: map[string]string{
+				"DOMAIN": "example.com",
+			},
+			wanted: map[string]string{
+				"LOG_LEVEL": "info",
+				"API_URL":   "https://prod.example.com/api",
+				"GREETING":  "hello=world",
+				"EMPTY":     "",
+			},
+		},
+		"error if a line is missing the separator": {
+			inContent:   "LOG_LEVEL=info\nAPI_URL",
+			wantedError: errors.New("line 2: expected the format <key>=<value>"),
+		},
+		"error if a key is invalid": {
+			inContent:   "1LOG-LEVEL=info",
+			wantedError: errors.New(`line 1: key "1LOG-LEVEL" must contain only letters, numbers and underscores, and must not start with a number`),
+		},
+		"error if a key is duplicated": {
+			inContent:   "LOG_LEVEL=info\nLOG_LEVEL=debug",
+			wantedError: errors.New(`line 2: key "LOG_LEVEL" is defined more than once`),
+		},
+		"error if a variable is not defined": {
+			inContent:   "API_URL=https://${DOMAIN}",
+			wantedError: errors.New(`line 1: environment variable "DOMAIN" is not defined`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for k, v := range tc.inEnvVars {
+				t.Setenv(k, v)
+			}
+
+			got, err := UnmarshalEnvFile([]byte(tc.inContent), NewInterpolator("phonetool", "prod"))
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
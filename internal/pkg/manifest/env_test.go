@@ -640,6 +640,79 @@ http:
 				},
 			},
 		},
+		"unmarshal with imports": {
+			inContent: `name: prod
+type: Environment
+imports:
+  cluster: shared
+  hosted_zone: Z0123456789ABCDEFGHIJ
+  public_alb:
+    arn: arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/50dc6c495c0c9188
+    listeners:
+      http:
+        arn: arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/shared/50dc6c495c0c9188/f2b3c4d5e6f7a8b9
+      https:
+        arn: arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/shared/50dc6c495c0c9188/a1b2c3d4e5f6a7b8
+        certificates:
+          - arn:aws:acm:us-west-2:123456789012:certificate/prod
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("prod"),
+					Type: aws.String("Environment"),
+				},
+				EnvironmentConfig: EnvironmentConfig{
+					Imports: environmentImports{
+						Cluster:    aws.String("shared"),
+						HostedZone: aws.String("Z0123456789ABCDEFGHIJ"),
+						PublicALB: importedPublicALB{
+							ARN: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/50dc6c495c0c9188"),
+							Listeners: importedListeners{
+								HTTP: importedListener{
+									ARN: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/shared/50dc6c495c0c9188/f2b3c4d5e6f7a8b9"),
+								},
+								HTTPS: importedListener{
+									ARN:          aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/shared/50dc6c495c0c9188/a1b2c3d4e5f6a7b8"),
+									Certificates: []string{"arn:aws:acm:us-west-2:123456789012:certificate/prod"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"unmarshal with waf managed rules": {
+			inContent: `name: prod
+type: Environment
+http:
+  public:
+    waf:
+      managed_rules:
+        - name: AWSManagedRulesCommonRuleSet
+        - name: AWSManagedRulesSQLiRuleSet
+          paths: ['/api']
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("prod"),
+					Type: aws.String("Environment"),
+				},
+				EnvironmentConfig: EnvironmentConfig{
+					HTTPConfig: EnvironmentHTTPConfig{
+						Public: PublicHTTPConfig{
+							WAF: WAF{
+								Union: AdvancedToUnion[string](WAFManagedRules{
+									ManagedRules: []WAFManagedRule{
+										{Name: aws.String("AWSManagedRulesCommonRuleSet")},
+										{Name: aws.String("AWSManagedRulesSQLiRuleSet"), Paths: []string{"/api"}},
+									},
+								}),
+							},
+						},
+					},
+				},
+			},
+		},
 		"fail to unmarshal": {
 			inContent:       `watermelon in easter hay`,
 			wantedErrPrefix: "unmarshal environment manifest: ",
@@ -58,6 +58,8 @@ type HTTP struct {
 	Main                     RoutingRule   `yaml:",inline"`
 	TargetContainerCamelCase *string       `yaml:"targetContainer"` // Deprecated. Maintained for backwards compatibility, use [RoutingRule.TargetContainer] instead.
 	AdditionalRoutingRules   []RoutingRule `yaml:"additional_rules"`
+	StaticRules              []StaticRule  `yaml:"static_rules"`
+	TLS                      HTTPTLSConfig `yaml:"tls"`
 }
 
 // RoutingRules returns main as well as additional routing rules as a list of RoutingRule.
@@ -70,7 +72,25 @@ func (cfg HTTP) RoutingRules() []RoutingRule {
 
 // IsEmpty returns true if HTTP has empty configuration.
 func (r *HTTP) IsEmpty() bool {
-	return r.Main.IsEmpty() && r.TargetContainerCamelCase == nil && len(r.AdditionalRoutingRules) == 0
+	return r.Main.IsEmpty() && r.TargetContainerCamelCase == nil && len(r.AdditionalRoutingRules) == 0 &&
+		len(r.StaticRules) == 0 && r.TLS.IsEmpty()
+}
+
+// Mutual TLS modes of the HTTPS listener of a public load balancer.
+const (
+	ClientAuthVerify      = "verify"
+	ClientAuthPassthrough = "passthrough"
+)
+
+// HTTPTLSConfig holds the TLS settings of the HTTPS listener that the service relies on.
+type HTTPTLSConfig struct {
+	// ClientAuth is the mutual TLS mode of the listener, either "verify" or "passthrough".
+	ClientAuth *string `yaml:"client_auth"`
+}
+
+// IsEmpty returns true if there are no TLS settings.
+func (c HTTPTLSConfig) IsEmpty() bool {
+	return c.ClientAuth == nil
 }
 
 // RoutingRule holds listener rule configuration for ALB.
@@ -88,13 +108,120 @@ type RoutingRule struct {
 	HostedZone       *string `yaml:"hosted_zone"`
 	// RedirectToHTTPS configures a HTTP->HTTPS redirect. If nil, default to true.
 	RedirectToHTTPS *bool `yaml:"redirect_to_https"`
+	// Hosts restricts the rule to requests with one of the host headers.
+	Hosts []string `yaml:"hosts"`
+	// Weight is the share of the requests forwarded to the target of the rule when WeightedTargets are set.
+	Weight          *int             `yaml:"weight"`
+	WeightedTargets []WeightedTarget `yaml:"weighted_targets"`
+	// Auth authenticates the users with an identity provider before the requests are forwarded.
+	Auth HTTPAuth `yaml:"auth"`
 }
 
 // IsEmpty returns true if RoutingRule has empty configuration.
 func (r *RoutingRule) IsEmpty() bool {
 	return r.Path == nil && r.ProtocolVersion == nil && r.HealthCheck.IsZero() && r.Stickiness == nil && r.Alias.IsEmpty() &&
 		r.DeregistrationDelay == nil && r.TargetContainer == nil && r.TargetPort == nil && r.AllowedSourceIps == nil &&
-		r.HostedZone == nil && r.RedirectToHTTPS == nil
+		r.HostedZone == nil && r.RedirectToHTTPS == nil && len(r.Hosts) == 0 && r.Weight == nil && len(r.WeightedTargets) == 0 &&
+		r.Auth.IsEmpty()
+}
+
+// HTTPAuth holds the configuration of the identity provider that the load balancer authenticates users with.
+type HTTPAuth struct {
+	OIDC           OIDCAuth       `yaml:"oidc"`
+	Cognito        CognitoAuth    `yaml:"cognito"`
+	Scope          *string        `yaml:"scope"`
+	SessionTimeout *time.Duration `yaml:"session_timeout"`
+	SessionCookie  *string        `yaml:"session_cookie"`
+}
+
+// IsEmpty returns true if authentication is not configured.
+func (a HTTPAuth) IsEmpty() bool {
+	return a.OIDC.IsEmpty() && a.Cognito.IsEmpty() && a.Scope == nil && a.SessionTimeout == nil && a.SessionCookie == nil
+}
+
+// OIDCAuth holds the settings of an OpenID Connect compliant identity provider.
+type OIDCAuth struct {
+	Issuer                *string `yaml:"issuer"`
+	AuthorizationEndpoint *string `yaml:"authorization_endpoint"`
+	TokenEndpoint         *string `yaml:"token_endpoint"`
+	UserInfoEndpoint      *string `yaml:"user_info_endpoint"`
+	ClientID              *string `yaml:"client_id"`
+	// ClientSecret is the name or ARN of the Secrets Manager secret that holds the client secret.
+	ClientSecret *string `yaml:"client_secret"`
+}
+
+// IsEmpty returns true if the OIDC identity provider is not configured.
+func (a OIDCAuth) IsEmpty() bool {
+	return a.Issuer == nil && a.AuthorizationEndpoint == nil && a.TokenEndpoint == nil && a.UserInfoEndpoint == nil &&
+		a.ClientID == nil && a.ClientSecret == nil
+}
+
+// CognitoAuth holds the settings of an Amazon Cognito user pool.
+type CognitoAuth struct {
+	UserPool *string `yaml:"user_pool"` // ARN of the user pool.
+	ClientID *string `yaml:"client_id"`
+	Domain   *string `yaml:"domain"`
+}
+
+// IsEmpty returns true if the Cognito user pool is not configured.
+func (a CognitoAuth) IsEmpty() bool {
+	return a.UserPool == nil && a.ClientID == nil && a.Domain == nil
+}
+
+// IsGRPC returns true if the load balancer routes the requests to the target with the gRPC protocol version.
+func (r RoutingRule) IsGRPC() bool {
+	return strings.EqualFold(aws.StringValue(r.ProtocolVersion), GRPCProtocol)
+}
+
+// WeightedTarget is an additional target of the service that receives a share of the requests matched by a routing rule.
+type WeightedTarget struct {
+	TargetContainer *string `yaml:"target_container"`
+	TargetPort      *uint16 `yaml:"target_port"`
+	Weight          *int    `yaml:"weight"`
+}
+
+// RoutingRule returns the weighted target as a routing rule so that it's resolved to a container and port the same way.
+func (t WeightedTarget) RoutingRule() RoutingRule {
+	return RoutingRule{
+		TargetContainer: t.TargetContainer,
+		TargetPort:      t.TargetPort,
+	}
+}
+
+// StaticRule holds the configuration of a listener rule that the load balancer answers by itself
+// with a redirect or a fixed response, without forwarding the request to the service.
+type StaticRule struct {
+	Path          *string           `yaml:"path"`
+	Hosts         []string          `yaml:"hosts"`
+	Redirect      HTTPRedirect      `yaml:"redirect"`
+	FixedResponse HTTPFixedResponse `yaml:"fixed_response"`
+}
+
+// HTTPRedirect holds the parts of the URL to redirect a request to. Parts that are not set keep their original value.
+type HTTPRedirect struct {
+	Protocol   *string `yaml:"protocol"`
+	Host       *string `yaml:"host"`
+	Port       *uint16 `yaml:"port"`
+	Path       *string `yaml:"path"`
+	Query      *string `yaml:"query"`
+	StatusCode *int    `yaml:"status_code"`
+}
+
+// IsEmpty returns true if the redirect is not configured.
+func (r HTTPRedirect) IsEmpty() bool {
+	return r.Protocol == nil && r.Host == nil && r.Port == nil && r.Path == nil && r.Query == nil && r.StatusCode == nil
+}
+
+// HTTPFixedResponse holds the response returned by the load balancer.
+type HTTPFixedResponse struct {
+	StatusCode  *int    `yaml:"status_code"`
+	ContentType *string `yaml:"content_type"`
+	Body        *string `yaml:"body"`
+}
+
+// IsEmpty returns true if the fixed response is not configured.
+func (r HTTPFixedResponse) IsEmpty() bool {
+	return r.StatusCode == nil && r.ContentType == nil && r.Body == nil
 }
 
 // IPNet represents an IP network string. For example: 10.1.0.0/16
@@ -187,3 +314,30 @@ func (a *Alias) ToString() string {
 	}
 	return strings.Join(a.StringSliceOrString.StringSlice, ",")
 }
+
+// WAF holds the web ACL that protects a load balancer, either as the ARN of an existing web ACL
+// or as AWS WAF managed rule groups for Copilot to create a web ACL with.
+type WAF struct {
+	Union[string, WAFManagedRules]
+}
+
+// WebACLARN returns the ARN of the existing web ACL, if any.
+func (w WAF) WebACLARN() string {
+	if !w.IsBasic() {
+		return ""
+	}
+	return w.Basic
+}
+
+// WAFManagedRules holds the managed rule groups of a web ACL that Copilot creates.
+type WAFManagedRules struct {
+	ManagedRules []WAFManagedRule `yaml:"managed_rules"`
+}
+
+// WAFManagedRule is a managed rule group evaluated by the web ACL.
+type WAFManagedRule struct {
+	Name   *string `yaml:"name"`
+	Vendor *string `yaml:"vendor"`
+	// Paths scopes the rule group down to the requests for paths starting with any of the values, such as the paths of a service.
+	Paths []string `yaml:"paths"`
+}
@@ -76,12 +76,16 @@ func (i *Interpolator) applyInterpolation(node *yaml.Node) error {
 		var s []string
 		if err = json.Unmarshal([]byte(interpolated), &s); err == nil && len(s) != 0 {
 			seqNode := &yaml.Node{
-				Kind: yaml.SequenceNode,
+				Kind:   yaml.SequenceNode,
+				Line:   node.Line,
+				Column: node.Column,
 			}
 			for _, value := range s {
 				seqNode.Content = append(seqNode.Content, &yaml.Node{
-					Kind:  yaml.ScalarNode,
-					Value: value,
+					Kind:   yaml.ScalarNode,
+					Value:  value,
+					Line:   node.Line,
+					Column: node.Column,
 				})
 			}
 			*node = *seqNode
@@ -4,6 +4,8 @@
 package manifest
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -43,7 +45,17 @@ type ScheduledJobConfig struct {
 
 // JobTriggerConfig represents the configuration for the event that triggers the job.
 type JobTriggerConfig struct {
-	Schedule *string `yaml:"schedule"`
+	Schedule  *string       `yaml:"schedule"`
+	Schedules []JobSchedule `yaml:"schedules"` // Additional schedules run by EventBridge Scheduler.
+	Events    []EventRule   `yaml:"events"`
+}
+
+// JobSchedule represents an additional schedule of the job that can be evaluated in a timezone
+// and invoked within a flexible time window.
+type JobSchedule struct {
+	Schedule       *string        `yaml:"schedule"`
+	Timezone       *string        `yaml:"timezone"`        // IANA timezone such as "America/New_York". Defaults to UTC.
+	FlexibleWindow *time.Duration `yaml:"flexible_window"` // Maximum delay after the scheduled time to invoke the job.
 }
 
 // JobFailureHandlerConfig represents the error handling configuration for the job.
@@ -150,6 +162,36 @@ func (j *ScheduledJob) BuildArgs(contextDir string) (map[string]*DockerBuildArgs
 	return buildArgs(contextDir, buildArgsPerContainer, j.Sidecars)
 }
 
+// ImageLocations returns the image location of each container that runs an existing image instead of building one.
+func (j *ScheduledJob) ImageLocations() map[string]string {
+	return imageLocations(aws.StringValue(j.Name), j.ImageConfig.Image, j.Sidecars)
+}
+
+// ImageBuilder returns the builder of the container images, or empty if it's not specified.
+func (j *ScheduledJob) ImageBuilder() string {
+	return j.ImageConfig.Image.GetBuilder()
+}
+
+// ImagePlatforms returns the platforms to build a multi-platform image of the main container for, or nil if it's not specified.
+func (j *ScheduledJob) ImagePlatforms() []string {
+	return j.ImageConfig.Image.Platforms
+}
+
+// ImageSigning returns the configuration to sign the images built for the workload.
+func (j *ScheduledJob) ImageSigning() ImageSigning {
+	return j.ImageConfig.Image.Signing
+}
+
+// ImageScan returns the configuration to gate the deployment on the scan of the images built for the workload.
+func (j *ScheduledJob) ImageScan() ImageScan {
+	return j.ImageConfig.Image.Scan
+}
+
+// ImageSBOM returns the configuration to generate the software bills of materials of the images built for the workload.
+func (j *ScheduledJob) ImageSBOM() ImageSBOM {
+	return j.ImageConfig.Image.SBOM
+}
+
 // EnvFiles returns the locations of all env files against the ws root directory.
 // This method returns a map[string]string where the keys are container names
 // and the values are either env file paths or empty strings.
@@ -157,6 +199,12 @@ func (j *ScheduledJob) EnvFiles() map[string]string {
 	return envFiles(j.Name, j.TaskConfig, j.Logging, j.Sidecars)
 }
 
+// VariablesEnvFiles returns the paths of the env files against the ws root directory whose variables are
+// rendered in the containers at package time. The keys are container names.
+func (j *ScheduledJob) VariablesEnvFiles() map[string]string {
+	return variablesEnvFiles(j.Name, j.TaskConfig, j.Sidecars)
+}
+
 // newDefaultScheduledJob returns an empty ScheduledJob with only the default values set.
 func newDefaultScheduledJob() *ScheduledJob {
 	return &ScheduledJob{
@@ -0,0 +1,192 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/imdario/mergo"
+)
+
+const (
+	lambdaSvcManifestPath = "workloads/services/lambda/manifest.yml"
+
+	defaultLambdaMemory  = 512
+	defaultLambdaTimeout = 30 * time.Second
+)
+
+// LambdaService holds the configuration to run a container image as an AWS Lambda function.
+type LambdaService struct {
+	Workload            `yaml:",inline"`
+	LambdaServiceConfig `yaml:",inline"`
+	// Use *LambdaServiceConfig because of https://github.com/imdario/mergo/issues/146
+	Environments map[string]*LambdaServiceConfig `yaml:",flow"` // Fields to override per environment.
+
+	parser template.Parser
+}
+
+// LambdaServiceConfig holds the configuration that can be overridden per environments.
+type LambdaServiceConfig struct {
+	ImageConfig ImageWithEntryPointAndCommand `yaml:"image"`
+	Memory      *int                          `yaml:"memory"` // Memory of the function in MiB.
+	Timeout     *time.Duration                `yaml:"timeout"`
+	Platform    PlatformArgsOrString          `yaml:"platform,omitempty"`
+	HTTP        LambdaHTTPConfig              `yaml:"http"`
+	APIGateway  APIGateway                    `yaml:"api_gateway"`
+	Variables   map[string]Variable           `yaml:"variables"`
+	Tags        map[string]string             `yaml:"tags"`
+	Deployment  LambdaDeploymentConfig        `yaml:"deployment"`
+}
+
+// ImageWithEntryPointAndCommand represents a container image with overrides of its entrypoint and command.
+type ImageWithEntryPointAndCommand struct {
+	Image      Image              `yaml:",inline"`
+	EntryPoint EntryPointOverride `yaml:"entrypoint"`
+	Command    CommandOverride    `yaml:"command"`
+}
+
+// LambdaHTTPConfig holds the configuration to route the requests of the environment load balancer to the function.
+type LambdaHTTPConfig struct {
+	Path *string `yaml:"path"`
+}
+
+// IsEmpty returns true if the function doesn't receive requests from the environment load balancer.
+func (c LambdaHTTPConfig) IsEmpty() bool {
+	return c.Path == nil
+}
+
+// LambdaDeploymentConfig represents how the traffic is shifted to a new version of the function.
+type LambdaDeploymentConfig struct {
+	DeploymentControllerConfig `yaml:",inline"`
+	TrafficShifting            TrafficShiftingConfig `yaml:"traffic_shifting"`
+	RollbackAlarms             []string              `yaml:"rollback_alarms"` // Names of alarms that roll back a canary or linear deployment.
+}
+
+// IsTrafficShifting returns true if the traffic is shifted gradually to the new version with CodeDeploy.
+func (d LambdaDeploymentConfig) IsTrafficShifting() bool {
+	rolling := aws.StringValue(d.Rolling)
+	return strings.EqualFold(rolling, ECSCanaryRollingUpdateStrategy) || strings.EqualFold(rolling, ECSLinearRollingUpdateStrategy)
+}
+
+// LambdaServiceProps contains properties for creating a new Lambda service manifest.
+type LambdaServiceProps struct {
+	*WorkloadProps
+	Path     string // Path of the environment load balancer that routes requests to the function.
+	Platform PlatformArgsOrString
+}
+
+// NewLambdaService creates a new Lambda service manifest with default values.
+func NewLambdaService(props *LambdaServiceProps) *LambdaService {
+	svc := newDefaultLambdaService()
+	svc.Name = stringP(props.Name)
+	svc.ImageConfig.Image.Location = stringP(props.Image)
+	svc.ImageConfig.Image.Build.BuildArgs.Dockerfile = stringP(props.Dockerfile)
+	svc.HTTP.Path = stringP(props.Path)
+	svc.Platform = props.Platform
+	svc.parser = template.New()
+	return svc
+}
+
+// MarshalBinary serializes the manifest object into a binary YAML document.
+// Implements the encoding.BinaryMarshaler interface.
+func (s *LambdaService) MarshalBinary() ([]byte, error) {
+	content, err := s.parser.Parse(lambdaSvcManifestPath, *s)
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// ContainerPlatform returns the platform of the function.
+func (s *LambdaService) ContainerPlatform() string {
+	if s.Platform.IsEmpty() {
+		return platformString(OSLinux, ArchAMD64)
+	}
+	return platformString(s.Platform.OS(), s.Platform.Arch())
+}
+
+// BuildArgs returns a docker.BuildArguments object given a context directory.
+func (s *LambdaService) BuildArgs(contextDir string) (map[string]*DockerBuildArgs, error) {
+	required, err := requiresBuild(s.ImageConfig.Image)
+	if err != nil {
+		return nil, err
+	}
+	buildArgsPerContainer := make(map[string]*DockerBuildArgs, 1)
+	if required {
+		buildArgsPerContainer[aws.StringValue(s.Name)] = s.ImageConfig.Image.BuildConfig(contextDir)
+	}
+	return buildArgsPerContainer, nil
+}
+
+// ImageBuilder returns the builder of the container images, or empty if it's not specified.
+func (s *LambdaService) ImageBuilder() string {
+	return s.ImageConfig.Image.GetBuilder()
+}
+
+// ImagePlatforms returns nil, as Lambda functions don't run multi-platform images.
+func (s *LambdaService) ImagePlatforms() []string {
+	return nil
+}
+
+// ImageSigning returns the configuration to sign the images built for the workload.
+func (s *LambdaService) ImageSigning() ImageSigning {
+	return s.ImageConfig.Image.Signing
+}
+
+// ImageScan returns the configuration to gate the deployment on the scan of the images built for the workload.
+func (s *LambdaService) ImageScan() ImageScan {
+	return s.ImageConfig.Image.Scan
+}
+
+// ImageSBOM returns the configuration to generate the software bills of materials of the images built for the workload.
+func (s *LambdaService) ImageSBOM() ImageSBOM {
+	return s.ImageConfig.Image.SBOM
+}
+
+func (s LambdaService) applyEnv(envName string) (workloadManifest, error) {
+	overrideConfig, ok := s.Environments[envName]
+	if !ok || overrideConfig == nil {
+		return &s, nil
+	}
+	// Apply overrides to the original service configuration.
+	for _, t := range defaultTransformers {
+		err := mergo.Merge(&s, LambdaService{
+			LambdaServiceConfig: *overrideConfig,
+		}, mergo.WithOverride, mergo.WithTransformers(t))
+		if err != nil {
+			return nil, err
+		}
+	}
+	s.Environments = nil
+	return &s, nil
+}
+
+// To implement workloadManifest.
+func (s *LambdaService) subnets() *SubnetListOrArgs {
+	return nil
+}
+
+func (s *LambdaService) requiredEnvironmentFeatures() []string {
+	if s.HTTP.IsEmpty() {
+		return nil
+	}
+	return []string{template.ALBFeatureName}
+}
+
+// newDefaultLambdaService returns an empty LambdaService with only the default values set.
+func newDefaultLambdaService() *LambdaService {
+	return &LambdaService{
+		Workload: Workload{
+			Type: aws.String(manifestinfo.LambdaServiceType),
+		},
+		LambdaServiceConfig: LambdaServiceConfig{
+			Memory:  aws.Int(defaultLambdaMemory),
+			Timeout: durationp(defaultLambdaTimeout),
+		},
+	}
+}
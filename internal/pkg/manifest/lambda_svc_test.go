@@ -0,0 +1,203 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLambdaService(t *testing.T) {
+	// GIVEN
+	props := &LambdaServiceProps{
+		WorkloadProps: &WorkloadProps{
+			Name:       "api",
+			Dockerfile: "./api/Dockerfile",
+		},
+		Path: "api",
+	}
+
+	// WHEN
+	svc := NewLambdaService(props)
+	content, err := svc.MarshalBinary()
+	require.NoError(t, err)
+	mft, err := UnmarshalWorkload(content)
+	require.NoError(t, err)
+
+	// THEN
+	got := mft.Manifest().(*LambdaService)
+	require.Equal(t, aws.String("api"), got.Name)
+	require.Equal(t, aws.String(manifestinfo.LambdaServiceType), got.Type)
+	require.Equal(t, aws.String("./api/Dockerfile"), got.ImageConfig.Image.Build.BuildString)
+	require.Equal(t, aws.Int(512), got.Memory)
+	require.Equal(t, durationp(30*time.Second), got.Timeout)
+	require.Equal(t, aws.String("api"), got.HTTP.Path)
+	require.NoError(t, got.validate())
+}
+
+func TestLambdaService_UnmarshalWorkload(t *testing.T) {
+	// GIVEN
+	in := []byte(`
+name: api
+type: Lambda Service
+image:
+  location: 123456789012.dkr.ecr.us-west-2.amazonaws.com/api:latest
+  command: ["app.handler"]
+timeout: 1m
+api_gateway:
+  throttling:
+    rate: 100
+deployment:
+  rolling: canary
+  traffic_shifting:
+    percent: 10
+    interval: 5m
+  rollback_alarms: ["api-errors"]
+`)
+
+	// WHEN
+	mft, err := UnmarshalWorkload(in)
+
+	// THEN
+	require.NoError(t, err)
+	got := mft.Manifest().(*LambdaService)
+	require.Equal(t, aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/api:latest"), got.ImageConfig.Image.Location)
+	require.Equal(t, []string{"app.handler"}, got.ImageConfig.Command.StringSlice)
+	require.Equal(t, aws.Int(512), got.Memory, "memory defaults to 512 MiB")
+	require.Equal(t, durationp(time.Minute), got.Timeout)
+	require.True(t, got.HTTP.IsEmpty())
+	require.Equal(t, aws.Float64(100), got.APIGateway.Throttling.Rate)
+	require.True(t, got.Deployment.IsTrafficShifting())
+	require.Equal(t, TrafficShiftingConfig{Percent: aws.Int(10), Interval: durationp(5 * time.Minute)}, got.Deployment.TrafficShifting)
+	require.Equal(t, []string{"api-errors"}, got.Deployment.RollbackAlarms)
+}
+
+func TestLambdaService_ApplyEnv(t *testing.T) {
+	testCases := map[string]struct {
+		in         *LambdaService
+		envToApply string
+
+		wanted *LambdaService
+	}{
+		"without existing environments": {
+			in: &LambdaService{
+				Workload: Workload{
+					Name: aws.String("api"),
+					Type: aws.String(manifestinfo.LambdaServiceType),
+				},
+				LambdaServiceConfig: LambdaServiceConfig{
+					Memory: aws.Int(512),
+				},
+			},
+			envToApply: "prod",
+
+			wanted: &LambdaService{
+				Workload: Workload{
+					Name: aws.String("api"),
+					Type: aws.String(manifestinfo.LambdaServiceType),
+				},
+				LambdaServiceConfig: LambdaServiceConfig{
+					Memory: aws.Int(512),
+				},
+			},
+		},
+		"with overrides": {
+			in: &LambdaService{
+				Workload: Workload{
+					Name: aws.String("api"),
+					Type: aws.String(manifestinfo.LambdaServiceType),
+				},
+				LambdaServiceConfig: LambdaServiceConfig{
+					Memory:  aws.Int(512),
+					Timeout: durationp(30 * time.Second),
+					HTTP:    LambdaHTTPConfig{Path: aws.String("api")},
+					Variables: map[string]Variable{
+						"LOG_LEVEL": {
+							stringOrFromCFN{Plain: aws.String("debug")},
+						},
+					},
+				},
+				Environments: map[string]*LambdaServiceConfig{
+					"prod": {
+						Memory: aws.Int(2048),
+						Variables: map[string]Variable{
+							"LOG_LEVEL": {
+								stringOrFromCFN{Plain: aws.String("info")},
+							},
+						},
+						Deployment: LambdaDeploymentConfig{
+							DeploymentControllerConfig: DeploymentControllerConfig{Rolling: aws.String("linear")},
+						},
+					},
+				},
+			},
+			envToApply: "prod",
+
+			wanted: &LambdaService{
+				Workload: Workload{
+					Name: aws.String("api"),
+					Type: aws.String(manifestinfo.LambdaServiceType),
+				},
+				LambdaServiceConfig: LambdaServiceConfig{
+					Memory:  aws.Int(2048),
+					Timeout: durationp(30 * time.Second),
+					HTTP:    LambdaHTTPConfig{Path: aws.String("api")},
+					Variables: map[string]Variable{
+						"LOG_LEVEL": {
+							stringOrFromCFN{Plain: aws.String("info")},
+						},
+					},
+					Deployment: LambdaDeploymentConfig{
+						DeploymentControllerConfig: DeploymentControllerConfig{Rolling: aws.String("linear")},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.applyEnv(tc.envToApply)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestLambdaService_RequiredEnvironmentFeatures(t *testing.T) {
+	testCases := map[string]struct {
+		mft    func(svc *LambdaService)
+		wanted []string
+	}{
+		"no feature required when the function is behind an API Gateway": {
+			mft: func(svc *LambdaService) {
+				svc.APIGateway = APIGateway{Protocol: aws.String(APIGatewayProtocolHTTP)}
+			},
+		},
+		"alb feature required when http is configured": {
+			mft: func(svc *LambdaService) {
+				svc.HTTP = LambdaHTTPConfig{Path: aws.String("api")}
+			},
+			wanted: []string{template.ALBFeatureName},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			inSvc := LambdaService{
+				Workload: Workload{
+					Name: aws.String("api"),
+					Type: aws.String(manifestinfo.LambdaServiceType),
+				},
+			}
+			tc.mft(&inSvc)
+			got := inSvc.requiredEnvironmentFeatures()
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -34,6 +34,13 @@ const (
 	commonGRPCPort = uint16(50051)
 )
 
+// Default values for HTTPHealthCheck of a gRPC target group.
+// The load balancer calls a method that doesn't exist and expects the "UNIMPLEMENTED" gRPC status code.
+const (
+	DefaultGRPCHealthCheckPath         = "/AWS.ALB/healthcheck"
+	DefaultGRPCHealthCheckSuccessCodes = "12"
+)
+
 // durationp is a utility function used to convert a time.Duration to a pointer. Useful for YAML unmarshaling
 // and template execution.
 func durationp(v time.Duration) *time.Duration {
@@ -64,6 +71,7 @@ type LoadBalancedWebServiceConfig struct {
 	NLBConfig        NetworkLoadBalancerConfiguration `yaml:"nlb"`
 	DeployConfig     DeploymentConfig                 `yaml:"deployment"`
 	Observability    Observability                    `yaml:"observability"`
+	Alarms           HTTPAlarmsConfig                 `yaml:"alarms"`
 }
 
 // LoadBalancedWebServiceProps contains properties for creating a new load balanced fargate service manifest.
@@ -175,6 +183,12 @@ func (s *LoadBalancedWebService) requiredEnvironmentFeatures() []string {
 	var features []string
 	if !s.HTTPOrBool.Disabled() {
 		features = append(features, template.ALBFeatureName)
+		switch aws.StringValue(s.HTTPOrBool.TLS.ClientAuth) {
+		case ClientAuthVerify:
+			features = append(features, template.MutualTLSVerifyFeatureName)
+		case ClientAuthPassthrough:
+			features = append(features, template.MutualTLSPassthroughFeatureName)
+		}
 	}
 	features = append(features, s.Network.requiredEnvFeatures()...)
 	features = append(features, s.Storage.requiredEnvFeatures()...)
@@ -206,6 +220,46 @@ func (s *LoadBalancedWebService) BuildArgs(contextDir string) (map[string]*Docke
 	return buildArgs(contextDir, buildArgsPerContainer, s.Sidecars)
 }
 
+// ImageLocations returns the image location of each container that runs an existing image instead of building one.
+func (s *LoadBalancedWebService) ImageLocations() map[string]string {
+	return imageLocations(aws.StringValue(s.Name), s.ImageConfig.Image, s.Sidecars)
+}
+
+// ImageBuilder returns the builder of the container images, or empty if it's not specified.
+func (s *LoadBalancedWebService) ImageBuilder() string {
+	return s.ImageConfig.Image.GetBuilder()
+}
+
+// ImagePlatforms returns the platforms to build a multi-platform image of the main container for, or nil if it's not specified.
+func (s *LoadBalancedWebService) ImagePlatforms() []string {
+	return s.ImageConfig.Image.Platforms
+}
+
+// ImageSigning returns the configuration to sign the images built for the workload.
+func (s *LoadBalancedWebService) ImageSigning() ImageSigning {
+	return s.ImageConfig.Image.Signing
+}
+
+// ImageScan returns the configuration to gate the deployment on the scan of the images built for the workload.
+func (s *LoadBalancedWebService) ImageScan() ImageScan {
+	return s.ImageConfig.Image.Scan
+}
+
+// ImageSBOM returns the configuration to generate the software bills of materials of the images built for the workload.
+func (s *LoadBalancedWebService) ImageSBOM() ImageSBOM {
+	return s.ImageConfig.Image.SBOM
+}
+
+// IsBlueGreen returns true if the tasks of the service are replaced by a CodeDeploy blue/green deployment.
+func (s *LoadBalancedWebService) IsBlueGreen() bool {
+	return s.DeployConfig.IsBlueGreen()
+}
+
+// DeploymentHooks returns the one-off tasks that run before and after the tasks of the service are replaced.
+func (s *LoadBalancedWebService) DeploymentHooks() DeploymentHooks {
+	return s.DeployConfig.DeploymentHooks
+}
+
 // EnvFiles returns the locations of all env files against the ws root directory.
 // This method returns a map[string]string where the keys are container names
 // and the values are either env file paths or empty strings.
@@ -213,6 +267,12 @@ func (s *LoadBalancedWebService) EnvFiles() map[string]string {
 	return envFiles(s.Name, s.TaskConfig, s.Logging, s.Sidecars)
 }
 
+// VariablesEnvFiles returns the paths of the env files against the ws root directory whose variables are
+// rendered in the containers at package time. The keys are container names.
+func (s *LoadBalancedWebService) VariablesEnvFiles() map[string]string {
+	return variablesEnvFiles(s.Name, s.TaskConfig, s.Sidecars)
+}
+
 func (s *LoadBalancedWebService) subnets() *SubnetListOrArgs {
 	return &s.Network.VPC.Placement.Subnets
 }
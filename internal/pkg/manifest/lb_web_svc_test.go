@@ -1684,6 +1684,18 @@ func TestLoadBalancedWebService_RequiredEnvironmentFeatures(t *testing.T) {
 			mft:    func(svc *LoadBalancedWebService) {},
 			wanted: []string{template.ALBFeatureName},
 		},
+		"mutual tls feature required": {
+			mft: func(svc *LoadBalancedWebService) {
+				svc.HTTPOrBool = HTTPOrBool{
+					HTTP: HTTP{
+						TLS: HTTPTLSConfig{
+							ClientAuth: aws.String(ClientAuthPassthrough),
+						},
+					},
+				}
+			},
+			wanted: []string{template.ALBFeatureName, template.MutualTLSPassthroughFeatureName},
+		},
 		"nat feature required": {
 			mft: func(svc *LoadBalancedWebService) {
 				svc.Network = NetworkConfig{
@@ -1832,6 +1844,59 @@ func TestLoadBalancedWebService_ExposedPorts(t *testing.T) {
 				},
 			},
 		},
+		"expose the ports of weighted targets": {
+			mft: &LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("frontend"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: ImageWithPortAndHealthcheck{
+						ImageWithPort: ImageWithPort{
+							Port: aws.Uint16(80),
+						},
+					},
+					HTTPOrBool: HTTPOrBool{
+						HTTP: HTTP{
+							Main: RoutingRule{
+								Path:   aws.String("/"),
+								Weight: aws.Int(90),
+								WeightedTargets: []WeightedTarget{
+									{
+										TargetContainer: aws.String("frontend-v2"),
+										TargetPort:      aws.Uint16(81),
+										Weight:          aws.Int(10),
+									},
+								},
+							},
+						},
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"frontend-v2": {
+							Image: Union[*string, ImageLocationOrBuild]{
+								Basic: aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/frontend:v2"),
+							},
+						},
+					},
+				},
+			},
+			wantedExposedPorts: map[string][]ExposedPort{
+				"frontend": {
+					{
+						Port:                 80,
+						ContainerName:        "frontend",
+						Protocol:             "tcp",
+						isDefinedByContainer: true,
+					},
+				},
+				"frontend-v2": {
+					{
+						Port:          81,
+						ContainerName: "frontend-v2",
+						Protocol:      "tcp",
+					},
+				},
+			},
+		},
 		"expose new primary container port through alb target_port": {
 			mft: &LoadBalancedWebService{
 				Workload: Workload{
@@ -2838,6 +2903,46 @@ func TestLoadBalancedWebService_BuildArgs(t *testing.T) {
 	}
 }
 
+func TestLoadBalancedWebService_ImageLocations(t *testing.T) {
+	mft := &LoadBalancedWebService{
+		Workload: Workload{
+			Name: aws.String("mock-svc"),
+		},
+		LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+			ImageConfig: ImageWithPortAndHealthcheck{
+				ImageWithPort: ImageWithPort{
+					Image: Image{
+						ImageLocationOrBuild: ImageLocationOrBuild{
+							Location: aws.String("public.ecr.aws/nginx/nginx:1.25"),
+						},
+					},
+				},
+			},
+			Sidecars: map[string]*SidecarConfig{
+				"envoy": {
+					Image: Union[*string, ImageLocationOrBuild]{
+						Basic: aws.String("public.ecr.aws/appmesh/aws-appmesh-envoy:v1.25"),
+					},
+				},
+				"proxy": {
+					Image: Union[*string, ImageLocationOrBuild]{
+						Advanced: ImageLocationOrBuild{
+							Build: BuildArgsOrString{
+								BuildString: aws.String("proxy/Dockerfile"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, map[string]string{
+		"mock-svc": "public.ecr.aws/nginx/nginx:1.25",
+		"envoy":    "public.ecr.aws/appmesh/aws-appmesh-envoy:v1.25",
+	}, mft.ImageLocations())
+}
+
 func TestNetworkLoadBalancerConfiguration_NLBListeners(t *testing.T) {
 	testCases := map[string]struct {
 		in     NetworkLoadBalancerConfiguration
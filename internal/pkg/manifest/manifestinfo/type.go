@@ -15,8 +15,12 @@ const (
 	WorkerServiceType = "Worker Service"
 	// StaticSiteType is a static site service that manages static assets.
 	StaticSiteType = "Static Site"
+	// LambdaServiceType is a service that runs a container image as an AWS Lambda function.
+	LambdaServiceType = "Lambda Service"
 	// ScheduledJobType is a recurring ECS Fargate task which runs on a schedule.
 	ScheduledJobType = "Scheduled Job"
+	// WorkflowJobType is a job that runs a sequence of ECS Fargate tasks with a Step Functions state machine.
+	WorkflowJobType = "Workflow Job"
 )
 
 // ServiceTypes returns the list of supported service manifest types.
@@ -27,6 +31,7 @@ func ServiceTypes() []string {
 		BackendServiceType,
 		WorkerServiceType,
 		StaticSiteType,
+		LambdaServiceType,
 	}
 }
 
@@ -34,6 +39,7 @@ func ServiceTypes() []string {
 func JobTypes() []string {
 	return []string{
 		ScheduledJobType,
+		WorkflowJobType,
 	}
 }
 
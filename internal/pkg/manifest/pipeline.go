@@ -188,10 +188,38 @@ type Build struct {
 
 // PipelineStage represents a stage in the pipeline manifest
 type PipelineStage struct {
-	Name             string      `yaml:"name"`
-	RequiresApproval bool        `yaml:"requires_approval,omitempty"`
-	TestCommands     []string    `yaml:"test_commands,omitempty"`
-	Deployments      Deployments `yaml:"deployments,omitempty"`
+	Name             string         `yaml:"name"`
+	RequiresApproval bool           `yaml:"requires_approval,omitempty"`
+	Approval         ApprovalConfig `yaml:"approval,omitempty"`
+	TestCommands     []string       `yaml:"test_commands,omitempty"`
+	Test             StageTest      `yaml:"test,omitempty"`
+	Deployments      Deployments    `yaml:"deployments,omitempty"`
+}
+
+// StageTest configures the test action that runs after the deployments of a stage.
+type StageTest struct {
+	Buildspec   string            `yaml:"buildspec,omitempty"` // Path to a buildspec in the repository, instead of test_commands.
+	Image       string            `yaml:"image,omitempty"`
+	ComputeType string            `yaml:"compute_type,omitempty"`
+	Variables   map[string]string `yaml:"variables,omitempty"`
+}
+
+// IsEmpty returns true if the test action is not configured.
+func (t StageTest) IsEmpty() bool {
+	return t.Buildspec == "" && t.Image == "" && t.ComputeType == "" && len(t.Variables) == 0
+}
+
+// ApprovalConfig represents the notifications sent when a stage is waiting for a manual approval.
+type ApprovalConfig struct {
+	Topic   string   `yaml:"topic,omitempty"`   // ARN of an existing SNS topic to notify.
+	Emails  []string `yaml:"emails,omitempty"`  // Email addresses subscribed to a topic created by Copilot.
+	Message string   `yaml:"message,omitempty"` // Additional information for the approvers.
+	Diff    bool     `yaml:"diff,omitempty"`    // Include a summary of the changes to deploy and a link to their diff.
+}
+
+// IsEmpty returns true if there are no approval notifications configured.
+func (a ApprovalConfig) IsEmpty() bool {
+	return a.Topic == "" && len(a.Emails) == 0 && a.Message == "" && !a.Diff
 }
 
 // Deployments represent a directed graph of cloudformation deployments.
@@ -39,16 +39,43 @@ type RequestDrivenWebServiceConfig struct {
 	PublishConfig                     PublishConfig                        `yaml:"publish"`
 	Network                           RequestDrivenWebServiceNetworkConfig `yaml:"network"`
 	Observability                     Observability                        `yaml:"observability"`
-	Count                             *string                              `yaml:"count"`
+	Count                             Union[*string, AppRunnerAutoScaling] `yaml:"count"`
+}
+
+// AppRunnerAutoScaling holds the configuration of the auto scaling configuration created for the service.
+type AppRunnerAutoScaling struct {
+	MaxConcurrency *int `yaml:"max_concurrency"` // Number of concurrent requests an instance handles before scaling out.
+	MinInstances   *int `yaml:"min"`
+	MaxInstances   *int `yaml:"max"`
+}
+
+// IsZero returns true if none of the fields are set.
+func (a AppRunnerAutoScaling) IsZero() bool {
+	return a.MaxConcurrency == nil && a.MinInstances == nil && a.MaxInstances == nil
 }
 
 // Observability holds configuration for observability to the service.
 type Observability struct {
-	Tracing *string `yaml:"tracing"`
+	Tracing   *string             `yaml:"tracing"`
+	Collector OTelCollectorConfig `yaml:"collector"`
 }
 
 func (o *Observability) isEmpty() bool {
-	return o.Tracing == nil
+	return o.Tracing == nil && o.Collector.IsEmpty()
+}
+
+// OTelCollectorConfig represents an AWS Distro for OpenTelemetry collector running as a sidecar of the service.
+type OTelCollectorConfig struct {
+	Image        *string `yaml:"image"`
+	Traces       *string `yaml:"traces"`        // Destination of the traces: "awsxray" or "otlp".
+	Metrics      *string `yaml:"metrics"`       // Destination of the metrics: "cloudwatch" or "otlp".
+	OTLPEndpoint *string `yaml:"otlp_endpoint"` // Endpoint that receives the OTLP exports.
+	Config       *string `yaml:"config"`        // Collector configuration that replaces the generated one.
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (c *OTelCollectorConfig) IsEmpty() bool {
+	return c.Image == nil && c.Traces == nil && c.Metrics == nil && c.OTLPEndpoint == nil && c.Config == nil
 }
 
 // ImageWithPort represents a container image with an exposed port.
@@ -87,6 +114,7 @@ type RequestDrivenWebServiceHttpConfig struct {
 	HealthCheckConfiguration HealthCheckArgsOrString   `yaml:"healthcheck"`
 	Alias                    *string                   `yaml:"alias"`
 	Private                  Union[*bool, VPCEndpoint] `yaml:"private"`
+	WAF                      WAF                       `yaml:"waf"`
 }
 
 // VPCEndpoint is used to configure a pre-existing VPC endpoint.
@@ -168,6 +196,36 @@ func (s *RequestDrivenWebService) BuildArgs(contextDir string) (map[string]*Dock
 	return buildArgsPerContainer, nil
 }
 
+// ImageLocations returns the image location of each container that runs an existing image instead of building one.
+func (s *RequestDrivenWebService) ImageLocations() map[string]string {
+	return imageLocations(aws.StringValue(s.Name), s.ImageConfig.Image, nil)
+}
+
+// ImageBuilder returns the builder of the container images, or empty if it's not specified.
+func (s *RequestDrivenWebService) ImageBuilder() string {
+	return s.ImageConfig.Image.GetBuilder()
+}
+
+// ImagePlatforms returns the platforms to build a multi-platform image of the main container for, or nil if it's not specified.
+func (s *RequestDrivenWebService) ImagePlatforms() []string {
+	return s.ImageConfig.Image.Platforms
+}
+
+// ImageSigning returns the configuration to sign the images built for the workload.
+func (s *RequestDrivenWebService) ImageSigning() ImageSigning {
+	return s.ImageConfig.Image.Signing
+}
+
+// ImageScan returns the configuration to gate the deployment on the scan of the images built for the workload.
+func (s *RequestDrivenWebService) ImageScan() ImageScan {
+	return s.ImageConfig.Image.Scan
+}
+
+// ImageSBOM returns the configuration to generate the software bills of materials of the images built for the workload.
+func (s *RequestDrivenWebService) ImageSBOM() ImageSBOM {
+	return s.ImageConfig.Image.SBOM
+}
+
 func (s RequestDrivenWebService) applyEnv(envName string) (workloadManifest, error) {
 	overrideConfig, ok := s.Environments[envName]
 	if !ok {
@@ -193,6 +193,35 @@ func TestRequestDrivenWebService_UnmarshalYaml(t *testing.T) {
 				},
 			},
 		},
+		"should unmarshal the name of an auto scaling configuration": {
+			inContent: []byte(
+				"count: high-availability/3\n",
+			),
+
+			wantedStruct: RequestDrivenWebService{
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					Count: BasicToUnion[*string, AppRunnerAutoScaling](aws.String("high-availability/3")),
+				},
+			},
+		},
+		"should unmarshal auto scaling configuration": {
+			inContent: []byte(
+				"count:\n" +
+					"  max_concurrency: 50\n" +
+					"  min: 2\n" +
+					"  max: 10\n",
+			),
+
+			wantedStruct: RequestDrivenWebService{
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					Count: AdvancedToUnion[*string](AppRunnerAutoScaling{
+						MaxConcurrency: aws.Int(50),
+						MinInstances:   aws.Int(2),
+						MaxInstances:   aws.Int(10),
+					}),
+				},
+			},
+		},
 		"should unmarshal tags": {
 			inContent: []byte(
 				"tags:\n" +
@@ -254,6 +283,29 @@ func TestRequestDrivenWebService_UnmarshalYaml(t *testing.T) {
 				},
 			},
 		},
+		"should unmarshal waf managed rules": {
+			inContent: []byte(
+				"http:\n" +
+					"  waf:\n" +
+					"    managed_rules:\n" +
+					"      - name: AWSManagedRulesCommonRuleSet\n" +
+					"        paths: [\"/api\"]\n",
+			),
+
+			wantedStruct: RequestDrivenWebService{
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					RequestDrivenWebServiceHttpConfig: RequestDrivenWebServiceHttpConfig{
+						WAF: WAF{
+							Union: AdvancedToUnion[string](WAFManagedRules{
+								ManagedRules: []WAFManagedRule{
+									{Name: aws.String("AWSManagedRulesCommonRuleSet"), Paths: []string{"/api"}},
+								},
+							}),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -617,6 +669,47 @@ func TestRequestDrivenWebService_ApplyEnv(t *testing.T) {
 				},
 			},
 		},
+		"with waf managed rules overridden by an existing web ACL": {
+			in: &RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("phonetool"),
+					Type: aws.String(manifestinfo.RequestDrivenWebServiceType),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					RequestDrivenWebServiceHttpConfig: RequestDrivenWebServiceHttpConfig{
+						WAF: WAF{
+							Union: AdvancedToUnion[string](WAFManagedRules{
+								ManagedRules: []WAFManagedRule{{Name: aws.String("AWSManagedRulesCommonRuleSet")}},
+							}),
+						},
+					},
+				},
+				Environments: map[string]*RequestDrivenWebServiceConfig{
+					"prod-iad": {
+						RequestDrivenWebServiceHttpConfig: RequestDrivenWebServiceHttpConfig{
+							WAF: WAF{
+								Union: BasicToUnion[string, WAFManagedRules]("arn:aws:wafv2:us-east-1:123456789012:regional/webacl/prod/a1b2"),
+							},
+						},
+					},
+				},
+			},
+			envToApply: "prod-iad",
+
+			wanted: &RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("phonetool"),
+					Type: aws.String(manifestinfo.RequestDrivenWebServiceType),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					RequestDrivenWebServiceHttpConfig: RequestDrivenWebServiceHttpConfig{
+						WAF: WAF{
+							Union: BasicToUnion[string, WAFManagedRules]("arn:aws:wafv2:us-east-1:123456789012:regional/webacl/prod/a1b2"),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,200 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const jsonSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+var (
+	yamlUnmarshalerType = reflect.TypeOf((*yaml.Unmarshaler)(nil)).Elem()
+	yamlNodeType        = reflect.TypeOf(yaml.Node{})
+	durationType        = reflect.TypeOf(time.Duration(0))
+
+	nonAlphaNumRegexp = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+)
+
+// WorkloadJSONSchema returns the JSON Schema of the manifest of a workload type, so that editors can validate
+// and autocomplete manifests.
+func WorkloadJSONSchema(typ string) ([]byte, error) {
+	mft, err := newDefaultWorkloadManifest(typ)
+	if err != nil {
+		return nil, err
+	}
+	return jsonSchema(fmt.Sprintf("%s manifest", typ), reflect.TypeOf(mft))
+}
+
+// EnvironmentJSONSchema returns the JSON Schema of environment manifests.
+func EnvironmentJSONSchema() ([]byte, error) {
+	return jsonSchema("Environment manifest", reflect.TypeOf(Environment{}))
+}
+
+func jsonSchema(title string, typ reflect.Type) ([]byte, error) {
+	gen := &schemaGenerator{
+		defs:  make(map[string]any),
+		names: make(map[reflect.Type]string),
+	}
+	root := gen.schema(typ)
+	out := map[string]any{
+		"$schema": jsonSchemaDraft,
+		"title":   title,
+		"$defs":   gen.defs,
+	}
+	for k, v := range root {
+		out[k] = v
+	}
+	content, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal JSON schema: %w", err)
+	}
+	return content, nil
+}
+
+// schemaGenerator builds JSON Schemas from the Go types manifests are unmarshaled into.
+// Named struct types are defined once under "$defs", since manifests are recursive through environment overrides.
+type schemaGenerator struct {
+	defs  map[string]any
+	names map[reflect.Type]string
+}
+
+func (g *schemaGenerator) schema(typ reflect.Type) map[string]any {
+	typ = indirect(typ)
+	switch {
+	case typ == durationType:
+		return map[string]any{"type": "string"}
+	case typ == yamlNodeType:
+		return map[string]any{}
+	}
+	switch typ.Kind() {
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]any{"type": "array", "items": g.schema(typ.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": g.schema(typ.Elem())}
+	case reflect.Struct:
+		return g.ref(typ)
+	default:
+		return map[string]any{}
+	}
+}
+
+func (g *schemaGenerator) ref(typ reflect.Type) map[string]any {
+	if name, ok := g.names[typ]; ok {
+		return map[string]any{"$ref": "#/$defs/" + name}
+	}
+	name := g.defName(typ)
+	g.names[typ] = name
+	g.defs[name] = map[string]any{} // Placeholder for recursive types.
+
+	var def map[string]any
+	if alts, ok := unionAlternatives(typ); ok {
+		var anyOf []any
+		for _, alt := range alts {
+			anyOf = append(anyOf, g.schema(alt))
+		}
+		def = map[string]any{"anyOf": anyOf}
+	} else {
+		props := make(map[string]any)
+		for _, field := range yamlFields(typ) {
+			props[field.name] = g.schema(field.typ)
+		}
+		def = map[string]any{"type": "object", "properties": props}
+	}
+	g.defs[name] = def
+	return map[string]any{"$ref": "#/$defs/" + name}
+}
+
+func (g *schemaGenerator) defName(typ reflect.Type) string {
+	// Names of generic types include their type parameters, for example "Union[...]".
+	base := nonAlphaNumRegexp.ReplaceAllString(strings.Split(typ.Name(), "[")[0], "")
+	if base == "" {
+		base = "Anonymous"
+	}
+	name := base
+	for i := 2; ; i++ {
+		if _, taken := g.defs[name]; !taken {
+			return name
+		}
+		name = fmt.Sprintf("%s%d", base, i)
+	}
+}
+
+type yamlField struct {
+	name string
+	typ  reflect.Type
+}
+
+// yamlFields returns the keys of the YAML mapping that the struct type is unmarshaled from.
+func yamlFields(typ reflect.Type) []yamlField {
+	var fields []yamlField
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag := f.Tag.Get("yaml")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if strings.Contains(opts, "inline") {
+			if inner := indirect(f.Type); inner.Kind() == reflect.Struct {
+				fields = append(fields, yamlFields(inner)...)
+			}
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(f.Name)
+		}
+		fields = append(fields, yamlField{name: name, typ: f.Type})
+	}
+	return fields
+}
+
+// unionAlternatives returns the types a struct can be unmarshaled from, if the struct is a union of types like
+// "BuildArgsOrString". Unions implement their own unmarshaling and hold each alternative in an untagged field.
+func unionAlternatives(typ reflect.Type) ([]reflect.Type, bool) {
+	if typ.Kind() != reflect.Struct || !reflect.PointerTo(typ).Implements(yamlUnmarshalerType) {
+		return nil, false
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		if typ.Field(i).Tag.Get("yaml") != "" {
+			return nil, false
+		}
+	}
+	var alts []reflect.Type
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		if !f.IsExported() && indirect(f.Type).Kind() != reflect.Struct {
+			// Unexported scalars hold the state of the union, like which alternative is set.
+			continue
+		}
+		alts = append(alts, f.Type)
+	}
+	return alts, true
+}
+
+func indirect(typ reflect.Type) reflect.Type {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	return typ
+}
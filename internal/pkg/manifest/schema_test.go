@@ -0,0 +1,75 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkloadJSONSchema(t *testing.T) {
+	t.Run("should return ErrInvalidWorkloadType for an unknown type", func(t *testing.T) {
+		_, err := WorkloadJSONSchema("Frontend Service")
+		require.EqualError(t, err, "invalid manifest type: Frontend Service")
+	})
+	t.Run("should describe the fields and unions of the manifest", func(t *testing.T) {
+		// WHEN
+		content, err := WorkloadJSONSchema(manifestinfo.BackendServiceType)
+		require.NoError(t, err)
+
+		// THEN
+		var schema struct {
+			Schema string                     `json:"$schema"`
+			Title  string                     `json:"title"`
+			Ref    string                     `json:"$ref"`
+			Defs   map[string]json.RawMessage `json:"$defs"`
+		}
+		require.NoError(t, json.Unmarshal(content, &schema))
+		require.Equal(t, "https://json-schema.org/draft/2020-12/schema", schema.Schema)
+		require.Equal(t, "Backend Service manifest", schema.Title)
+		require.Equal(t, "#/$defs/BackendService", schema.Ref)
+
+		var svc struct {
+			Properties map[string]map[string]any `json:"properties"`
+		}
+		require.NoError(t, json.Unmarshal(schema.Defs["BackendService"], &svc))
+		require.Equal(t, map[string]any{"type": "string"}, svc.Properties["name"])
+		require.Equal(t, map[string]any{"type": "integer"}, svc.Properties["cpu"])
+		require.Contains(t, svc.Properties, "image")
+		require.Contains(t, svc.Properties, "environments")
+
+		var buildArgs struct {
+			AnyOf []map[string]any `json:"anyOf"`
+		}
+		require.NoError(t, json.Unmarshal(schema.Defs["BuildArgsOrString"], &buildArgs))
+		require.Len(t, buildArgs.AnyOf, 2)
+		require.Equal(t, map[string]any{"type": "string"}, buildArgs.AnyOf[0])
+	})
+}
+
+func TestEnvironmentJSONSchema(t *testing.T) {
+	// WHEN
+	content, err := EnvironmentJSONSchema()
+	require.NoError(t, err)
+
+	// THEN
+	var schema struct {
+		Title string                     `json:"title"`
+		Ref   string                     `json:"$ref"`
+		Defs  map[string]json.RawMessage `json:"$defs"`
+	}
+	require.NoError(t, json.Unmarshal(content, &schema))
+	require.Equal(t, "Environment manifest", schema.Title)
+	require.Equal(t, "#/$defs/Environment", schema.Ref)
+	var env struct {
+		Properties map[string]any `json:"properties"`
+	}
+	require.NoError(t, json.Unmarshal(schema.Defs["Environment"], &env))
+	require.Contains(t, env.Properties, "network")
+	require.Contains(t, env.Properties, "http")
+	require.Contains(t, env.Properties, "observability")
+}
@@ -4,6 +4,8 @@
 package manifest
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/aws/copilot-cli/internal/pkg/template"
@@ -14,6 +16,18 @@ const (
 	staticSiteManifestPath = "workloads/services/static-site/manifest.yml"
 )
 
+// Default TTLs of the files of a static site in seconds.
+const (
+	defaultStaticSiteCacheTTL = 24 * 60 * 60
+	maxStaticSiteCacheTTL     = 365 * 24 * 60 * 60
+)
+
+// Invalidation strategies of a static site other than a list of paths.
+const (
+	StaticSiteInvalidateAll  = "all"  // Invalidates every file, which is the default.
+	StaticSiteInvalidateNone = "none" // Invalidates nothing.
+)
+
 // StaticSite holds the configuration to configure and upload static assets to the static site service.
 type StaticSite struct {
 	Workload         `yaml:",inline"`
@@ -32,7 +46,78 @@ type StaticSiteConfig struct {
 
 // StaticSiteHTTP defines the http configuration for the static site.
 type StaticSiteHTTP struct {
-	Alias string `yaml:"alias"`
+	Alias         string               `yaml:"alias"`
+	ErrorDocument string               `yaml:"error_document"`
+	Redirects     []StaticSiteRedirect `yaml:"redirects"`
+	CustomHeaders map[string]string    `yaml:"custom_headers"`
+	WAF           *string              `yaml:"waf"` // ARN of an existing web ACL with the CLOUDFRONT scope.
+	Cache         StaticSiteCache      `yaml:"cache"`
+}
+
+// StaticSiteCache represents how CloudFront caches the files of the static site,
+// and which of them are invalidated after each deployment.
+type StaticSiteCache struct {
+	StaticSiteCacheBehavior `yaml:",inline"`
+	Paths                   []StaticSiteCachePath `yaml:"paths"`
+	// Either "all", "none", or the list of paths to invalidate.
+	Invalidation StringSliceOrString `yaml:"invalidation"`
+}
+
+// StaticSiteCachePath represents how CloudFront caches the files that match a path pattern.
+type StaticSiteCachePath struct {
+	Path                    string `yaml:"path"`
+	StaticSiteCacheBehavior `yaml:",inline"`
+}
+
+// StaticSiteCacheBehavior represents the cache and origin request policies of a CloudFront cache behavior.
+type StaticSiteCacheBehavior struct {
+	Policy              *string            `yaml:"policy"` // ID of an existing cache policy.
+	OriginRequestPolicy *string            `yaml:"origin_request_policy"`
+	TTL                 StaticSiteCacheTTL `yaml:"ttl"`
+}
+
+// StaticSiteCacheTTL represents the durations for which CloudFront caches files.
+type StaticSiteCacheTTL struct {
+	Default *time.Duration `yaml:"default"`
+	Min     *time.Duration `yaml:"min"`
+	Max     *time.Duration `yaml:"max"`
+}
+
+// IsEmpty returns true if no TTL is set.
+func (t StaticSiteCacheTTL) IsEmpty() bool {
+	return t.Default == nil && t.Min == nil && t.Max == nil
+}
+
+// Seconds returns the minimum, default and maximum TTLs in seconds.
+// The TTLs that aren't set default to 0 seconds, 1 day and 1 year, within the bounds of the TTLs that are set.
+func (t StaticSiteCacheTTL) Seconds() (min, def, max int64) {
+	if t.Min != nil {
+		min = int64(t.Min.Seconds())
+	}
+	def = defaultStaticSiteCacheTTL
+	switch {
+	case t.Default != nil:
+		def = int64(t.Default.Seconds())
+	case def < min:
+		def = min
+	case t.Max != nil && def > int64(t.Max.Seconds()):
+		def = int64(t.Max.Seconds())
+	}
+	max = maxStaticSiteCacheTTL
+	switch {
+	case t.Max != nil:
+		max = int64(t.Max.Seconds())
+	case max < def:
+		max = def
+	}
+	return min, def, max
+}
+
+// StaticSiteRedirect represents a redirect from a path of the static site to another path or URL.
+type StaticSiteRedirect struct {
+	From       string `yaml:"from"`
+	To         string `yaml:"to"`
+	StatusCode *int   `yaml:"status_code"`
 }
 
 // FileUpload represents the options for file uploading.
@@ -197,6 +197,28 @@ func (c *Count) Desired() (*int, error) {
 	return aws.Int(min), nil
 }
 
+// MaxOnDemand returns the maximum number of tasks that can run on Fargate On-Demand capacity,
+// either the desired count or the upper bound of the autoscaling range up to the tasks placed on spot.
+func (c *Count) MaxOnDemand() (int, error) {
+	if c.AdvancedCount.IsEmpty() {
+		return aws.IntValue(c.Value), nil
+	}
+	if c.AdvancedCount.IgnoreRange() {
+		return 0, nil
+	}
+	_, max, err := c.AdvancedCount.Range.Parse()
+	if err != nil {
+		return 0, fmt.Errorf("parse task count value %s: %w", aws.StringValue((*string)(c.AdvancedCount.Range.Value)), err)
+	}
+	if spotFrom := c.AdvancedCount.Range.RangeConfig.SpotFrom; spotFrom != nil && aws.IntValue(spotFrom)-1 < max {
+		return aws.IntValue(spotFrom) - 1, nil
+	}
+	if capacity := c.AdvancedCount.Capacity; !capacity.IsEmpty() {
+		return capacity.maxOnDemand(max), nil
+	}
+	return max, nil
+}
+
 // Percentage represents a valid percentage integer ranging from 0 to 100.
 type Percentage int
 
@@ -223,16 +245,118 @@ type Cooldown struct {
 type AdvancedCount struct {
 	Spot         *int                            `yaml:"spot"` // mutually exclusive with other fields
 	Range        Range                           `yaml:"range"`
+	Capacity     CapacityStrategy                `yaml:"capacity"` // mutually exclusive with spot and range.spot_from
 	Cooldown     Cooldown                        `yaml:"cooldown"`
 	CPU          ScalingConfigOrT[Percentage]    `yaml:"cpu_percentage"`
 	Memory       ScalingConfigOrT[Percentage]    `yaml:"memory_percentage"`
 	Requests     ScalingConfigOrT[int]           `yaml:"requests"`
 	ResponseTime ScalingConfigOrT[time.Duration] `yaml:"response_time"`
 	QueueScaling QueueScaling                    `yaml:"queue_delay"`
+	Connections  ScalingConfigOrT[int]           `yaml:"connections"`
+	Metrics      []CustomMetricScaling           `yaml:"metrics"`
+	StepScaling  []StepScaling                   `yaml:"step_scaling"`
+	Schedules    []ScheduledScaling              `yaml:"schedules"`
 
 	workloadType string
 }
 
+// CapacityStrategy splits the tasks of a service between Fargate On-Demand and Fargate Spot capacity.
+// The first OnDemandBase tasks always run On-Demand, and the tasks above the base are spread
+// between both capacity providers in proportion to their weights.
+type CapacityStrategy struct {
+	OnDemandBase   *int `yaml:"on_demand_base"`
+	OnDemandWeight *int `yaml:"on_demand_weight"`
+	SpotWeight     *int `yaml:"spot_weight"` // Defaults to 1 if no weight is set.
+}
+
+// IsEmpty returns whether CapacityStrategy is empty.
+func (c *CapacityStrategy) IsEmpty() bool {
+	return c.OnDemandBase == nil && c.OnDemandWeight == nil && c.SpotWeight == nil
+}
+
+// maxOnDemand returns the number of tasks placed on Fargate On-Demand capacity when the service runs count tasks.
+func (c *CapacityStrategy) maxOnDemand(count int) int {
+	base, onDemand, spot := aws.IntValue(c.OnDemandBase), aws.IntValue(c.OnDemandWeight), aws.IntValue(c.SpotWeight)
+	if onDemand == 0 && spot == 0 {
+		spot = 1
+	}
+	if count <= base {
+		return count
+	}
+	return base + int(math.Ceil(float64((count-base)*onDemand)/float64(onDemand+spot)))
+}
+
+// CloudWatchMetric identifies a CloudWatch metric and the statistic to aggregate its data points with.
+type CloudWatchMetric struct {
+	Namespace  *string           `yaml:"namespace"`
+	Name       *string           `yaml:"name"`
+	Dimensions map[string]string `yaml:"dimensions"`
+	Statistic  *string           `yaml:"statistic"` // Defaults to "Average".
+}
+
+// CustomMetricScaling represents a target tracking policy on an arbitrary CloudWatch metric.
+type CustomMetricScaling struct {
+	CloudWatchMetric `yaml:",inline"`
+	Target           *float64 `yaml:"target"`
+	Cooldown         Cooldown `yaml:"cooldown"`
+}
+
+// StepScaling represents a step scaling policy that adjusts the number of tasks by the
+// amount of the step the value of a CloudWatch metric falls into.
+type StepScaling struct {
+	CloudWatchMetric  `yaml:",inline"`
+	Period            *time.Duration `yaml:"period"`             // Defaults to 1 minute.
+	EvaluationPeriods *int           `yaml:"evaluation_periods"` // Defaults to 1.
+	Cooldown          Cooldown       `yaml:"cooldown"`
+	Steps             []ScalingStep  `yaml:"steps"`
+}
+
+// ScalingStep represents a range of values of a metric and the change in the number of tasks when the metric is in range.
+// A positive change scales out the service when the metric is above the lower bound,
+// and a negative change scales in the service when the metric is below the upper bound.
+type ScalingStep struct {
+	Above  *float64 `yaml:"above"`
+	Below  *float64 `yaml:"below"`
+	Change *int     `yaml:"change"`
+}
+
+// ScheduledScaling represents a window of time during which the minimum and maximum number of tasks change.
+type ScheduledScaling struct {
+	Name     *string `yaml:"name"`
+	Schedule *string `yaml:"schedule"`
+	Timezone *string `yaml:"timezone"` // IANA timezone such as "America/New_York". Defaults to UTC.
+	Min      *int    `yaml:"min"`
+	Max      *int    `yaml:"max"`
+}
+
+// ScaleOutSteps returns the steps that increase the number of tasks ordered by their lower bound.
+func (s StepScaling) ScaleOutSteps() []ScalingStep {
+	var steps []ScalingStep
+	for _, step := range s.Steps {
+		if aws.IntValue(step.Change) > 0 {
+			steps = append(steps, step)
+		}
+	}
+	sort.SliceStable(steps, func(i, j int) bool {
+		return aws.Float64Value(steps[i].Above) < aws.Float64Value(steps[j].Above)
+	})
+	return steps
+}
+
+// ScaleInSteps returns the steps that decrease the number of tasks ordered by their upper bound, from highest to lowest.
+func (s StepScaling) ScaleInSteps() []ScalingStep {
+	var steps []ScalingStep
+	for _, step := range s.Steps {
+		if aws.IntValue(step.Change) < 0 {
+			steps = append(steps, step)
+		}
+	}
+	sort.SliceStable(steps, func(i, j int) bool {
+		return aws.Float64Value(steps[i].Below) > aws.Float64Value(steps[j].Below)
+	})
+	return steps
+}
+
 // IsEmpty returns whether ScalingConfigOrT is empty
 func (r *ScalingConfigOrT[_]) IsEmpty() bool {
 	return r.ScalingConfig.IsEmpty() && r.Value == nil
@@ -251,7 +375,8 @@ func (c *Cooldown) IsEmpty() bool {
 // IsEmpty returns whether AdvancedCount is empty.
 func (a *AdvancedCount) IsEmpty() bool {
 	return a.Range.IsEmpty() && a.CPU.IsEmpty() && a.Memory.IsEmpty() && a.Cooldown.IsEmpty() &&
-		a.Requests.IsEmpty() && a.ResponseTime.IsEmpty() && a.Spot == nil && a.QueueScaling.IsEmpty()
+		a.Requests.IsEmpty() && a.ResponseTime.IsEmpty() && a.Spot == nil && a.QueueScaling.IsEmpty() && a.Connections.IsEmpty() &&
+		a.Capacity.IsEmpty() && len(a.Metrics) == 0 && len(a.StepScaling) == 0 && len(a.Schedules) == 0
 }
 
 // IgnoreRange returns whether desiredCount is specified on spot capacity
@@ -259,6 +384,22 @@ func (a *AdvancedCount) IgnoreRange() bool {
 	return a.Spot != nil
 }
 
+// CanScaleToZero returns true if autoscaling can stop every task of the service,
+// either because the range starts at zero or because a schedule sets the minimum to zero.
+func (a *AdvancedCount) CanScaleToZero() bool {
+	if !a.Range.IsEmpty() {
+		if min, _, err := a.Range.Parse(); err == nil && min == 0 {
+			return true
+		}
+	}
+	for _, schedule := range a.Schedules {
+		if schedule.Min != nil && aws.IntValue(schedule.Min) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func (a *AdvancedCount) hasAutoscaling() bool {
 	return !a.Range.IsEmpty() || a.hasScalingFieldsSet()
 }
@@ -266,26 +407,29 @@ func (a *AdvancedCount) hasAutoscaling() bool {
 func (a *AdvancedCount) validScalingFields() []string {
 	switch a.workloadType {
 	case manifestinfo.LoadBalancedWebServiceType:
-		return []string{"cpu_percentage", "memory_percentage", "requests", "response_time"}
+		return []string{"cpu_percentage", "memory_percentage", "requests", "response_time", "metrics", "step_scaling", "schedules"}
 	case manifestinfo.BackendServiceType:
-		return []string{"cpu_percentage", "memory_percentage", "requests", "response_time"}
+		return []string{"cpu_percentage", "memory_percentage", "requests", "response_time", "connections", "metrics", "step_scaling", "schedules"}
 	case manifestinfo.WorkerServiceType:
-		return []string{"cpu_percentage", "memory_percentage", "queue_delay"}
+		return []string{"cpu_percentage", "memory_percentage", "queue_delay", "metrics", "step_scaling", "schedules"}
 	default:
 		return nil
 	}
 }
 
 func (a *AdvancedCount) hasScalingFieldsSet() bool {
+	if len(a.Metrics) != 0 || len(a.StepScaling) != 0 || len(a.Schedules) != 0 {
+		return true
+	}
 	switch a.workloadType {
 	case manifestinfo.LoadBalancedWebServiceType:
 		return !a.CPU.IsEmpty() || !a.Memory.IsEmpty() || !a.Requests.IsEmpty() || !a.ResponseTime.IsEmpty()
 	case manifestinfo.BackendServiceType:
-		return !a.CPU.IsEmpty() || !a.Memory.IsEmpty() || !a.Requests.IsEmpty() || !a.ResponseTime.IsEmpty()
+		return !a.CPU.IsEmpty() || !a.Memory.IsEmpty() || !a.Requests.IsEmpty() || !a.ResponseTime.IsEmpty() || !a.Connections.IsEmpty()
 	case manifestinfo.WorkerServiceType:
 		return !a.CPU.IsEmpty() || !a.Memory.IsEmpty() || !a.QueueScaling.IsEmpty()
 	default:
-		return !a.CPU.IsEmpty() || !a.Memory.IsEmpty() || !a.Requests.IsEmpty() || !a.ResponseTime.IsEmpty() || !a.QueueScaling.IsEmpty()
+		return !a.CPU.IsEmpty() || !a.Memory.IsEmpty() || !a.Requests.IsEmpty() || !a.ResponseTime.IsEmpty() || !a.QueueScaling.IsEmpty() || !a.Connections.IsEmpty()
 	}
 }
 
@@ -297,6 +441,9 @@ func (a *AdvancedCount) getInvalidFieldsSet() []string {
 		if !a.QueueScaling.IsEmpty() {
 			invalidFields = append(invalidFields, "queue_delay")
 		}
+		if !a.Connections.IsEmpty() {
+			invalidFields = append(invalidFields, "connections")
+		}
 	case manifestinfo.BackendServiceType:
 		if !a.QueueScaling.IsEmpty() {
 			invalidFields = append(invalidFields, "queue_delay")
@@ -308,6 +455,9 @@ func (a *AdvancedCount) getInvalidFieldsSet() []string {
 		if !a.ResponseTime.IsEmpty() {
 			invalidFields = append(invalidFields, "response_time")
 		}
+		if !a.Connections.IsEmpty() {
+			invalidFields = append(invalidFields, "connections")
+		}
 	}
 	return invalidFields
 }
@@ -320,6 +470,10 @@ func (a *AdvancedCount) unsetAutoscaling() {
 	a.Requests = ScalingConfigOrT[int]{}
 	a.ResponseTime = ScalingConfigOrT[time.Duration]{}
 	a.QueueScaling = QueueScaling{}
+	a.Connections = ScalingConfigOrT[int]{}
+	a.Metrics = nil
+	a.StepScaling = nil
+	a.Schedules = nil
 }
 
 // QueueScaling represents the configuration to scale a service based on a SQS queue.
@@ -473,6 +627,25 @@ func (cfg ImageWithHealthcheckAndOptionalPort) exposedPorts(workloadName string)
 // exportPorts returns any new ports that should be exposed given the application load balancer
 // configuration that's not part of the existing containerPorts.
 func (rr RoutingRule) exposedPorts(exposedPorts []ExposedPort, workloadName string) []ExposedPort {
+	var out []ExposedPort
+	existing := append([]ExposedPort{}, exposedPorts...)
+	for _, target := range append([]RoutingRule{rr}, rr.weightedTargetRules()...) {
+		ports := target.targetExposedPorts(existing, workloadName)
+		existing = append(existing, ports...)
+		out = append(out, ports...)
+	}
+	return out
+}
+
+func (rr RoutingRule) weightedTargetRules() []RoutingRule {
+	rules := make([]RoutingRule, len(rr.WeightedTargets))
+	for i, target := range rr.WeightedTargets {
+		rules[i] = target.RoutingRule()
+	}
+	return rules
+}
+
+func (rr RoutingRule) targetExposedPorts(exposedPorts []ExposedPort, workloadName string) []ExposedPort {
 	if rr.TargetPort == nil {
 		return nil
 	}
@@ -185,11 +185,11 @@ environments:
 							},
 						},
 						Logging: Logging{
-							Destination: map[string]string{
+							Destination: BasicToUnion[map[string]string, LogDestinationConfig](map[string]string{
 								"exclude-pattern": "^.*[aeiou]$",
 								"include-pattern": "^[a-z][aeiou].*$",
 								"Name":            "cloudwatch",
-							},
+							}),
 							EnableMetadata: aws.Bool(false),
 							ConfigFile:     aws.String("/extra.conf"),
 							SecretOptions: map[string]Secret{
@@ -581,6 +581,70 @@ func TestCount_UnmarshalYAML(t *testing.T) {
 				},
 			},
 		},
+		"With custom metric, step and scheduled scaling": {
+			inContent: []byte(`count:
+  range: 1-10
+  metrics:
+    - namespace: MyApp
+      name: ActiveSessions
+      dimensions:
+        Service: api
+      target: 100
+      cooldown:
+        in: 1m
+  step_scaling:
+    - namespace: AWS/SQS
+      name: ApproximateNumberOfMessagesVisible
+      statistic: Sum
+      steps:
+        - above: 100
+          change: 2
+        - below: 10
+          change: -1
+  schedules:
+    - name: business-hours
+      schedule: "0 9 * * 1-5"
+      timezone: America/New_York
+      min: 10
+`),
+			wantedStruct: Count{
+				AdvancedCount: AdvancedCount{
+					Range: Range{Value: &mockRange},
+					Metrics: []CustomMetricScaling{
+						{
+							CloudWatchMetric: CloudWatchMetric{
+								Namespace:  aws.String("MyApp"),
+								Name:       aws.String("ActiveSessions"),
+								Dimensions: map[string]string{"Service": "api"},
+							},
+							Target:   aws.Float64(100),
+							Cooldown: Cooldown{ScaleInCooldown: &timeMinute},
+						},
+					},
+					StepScaling: []StepScaling{
+						{
+							CloudWatchMetric: CloudWatchMetric{
+								Namespace: aws.String("AWS/SQS"),
+								Name:      aws.String("ApproximateNumberOfMessagesVisible"),
+								Statistic: aws.String("Sum"),
+							},
+							Steps: []ScalingStep{
+								{Above: aws.Float64(100), Change: aws.Int(2)},
+								{Below: aws.Float64(10), Change: aws.Int(-1)},
+							},
+						},
+					},
+					Schedules: []ScheduledScaling{
+						{
+							Name:     aws.String("business-hours"),
+							Schedule: aws.String("0 9 * * 1-5"),
+							Timezone: aws.String("America/New_York"),
+							Min:      aws.Int(10),
+						},
+					},
+				},
+			},
+		},
 		"With spot specified as count": {
 			inContent: []byte(`count:
   spot: 42
@@ -809,6 +873,107 @@ func TestCount_Desired(t *testing.T) {
 	}
 }
 
+func TestCount_MaxOnDemand(t *testing.T) {
+	mockRange := IntRangeBand("1-10")
+	testCases := map[string]struct {
+		input *Count
+
+		expected    int
+		expectedErr error
+	}{
+		"with value": {
+			input: &Count{
+				Value: aws.Int(3),
+			},
+			expected: 3,
+		},
+		"with spot count": {
+			input: &Count{
+				AdvancedCount: AdvancedCount{
+					Spot: aws.Int(31),
+				},
+			},
+			expected: 0,
+		},
+		"with autoscaling range on dedicated capacity": {
+			input: &Count{
+				AdvancedCount: AdvancedCount{
+					Range: Range{
+						Value: &mockRange,
+					},
+				},
+			},
+			expected: 10,
+		},
+		"with autoscaling range with spot capacity": {
+			input: &Count{
+				AdvancedCount: AdvancedCount{
+					Range: Range{
+						RangeConfig: RangeConfig{
+							Min:      aws.Int(2),
+							Max:      aws.Int(10),
+							SpotFrom: aws.Int(5),
+						},
+					},
+				},
+			},
+			expected: 4,
+		},
+		"with autoscaling range with a weighted capacity strategy": {
+			input: &Count{
+				AdvancedCount: AdvancedCount{
+					Range: Range{
+						Value: &mockRange,
+					},
+					Capacity: CapacityStrategy{
+						OnDemandBase:   aws.Int(2),
+						OnDemandWeight: aws.Int(1),
+						SpotWeight:     aws.Int(3),
+					},
+				},
+			},
+			expected: 4,
+		},
+		"with autoscaling range with only an on-demand base": {
+			input: &Count{
+				AdvancedCount: AdvancedCount{
+					Range: Range{
+						Value: &mockRange,
+					},
+					Capacity: CapacityStrategy{
+						OnDemandBase: aws.Int(3),
+					},
+				},
+			},
+			expected: 3,
+		},
+		"with invalid autoscaling range": {
+			input: &Count{
+				AdvancedCount: AdvancedCount{
+					Range: Range{
+						Value: (*IntRangeBand)(aws.String("ten")),
+					},
+				},
+			},
+			expectedErr: errors.New("parse task count value ten: invalid range value ten. Should be in format of ${min}-${max}"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			actual, err := tc.input.MaxOnDemand()
+
+			// THEN
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
 func TestHealthCheckArgsOrString_IsEmpty(t *testing.T) {
 	testCases := map[string]struct {
 		hc     HealthCheckArgsOrString
@@ -374,12 +374,22 @@ func (t advancedCountTransformer) Transformer(typ reflect.Type) func(dst, src re
 
 		if srcStruct.Spot != nil {
 			dstStruct.unsetAutoscaling()
+			dstStruct.Capacity = CapacityStrategy{}
 		}
 
 		if srcStruct.hasAutoscaling() {
 			dstStruct.Spot = nil
 		}
 
+		if srcStruct.Range.RangeConfig.SpotFrom != nil {
+			dstStruct.Capacity = CapacityStrategy{}
+		}
+
+		if !srcStruct.Capacity.IsEmpty() {
+			dstStruct.Spot = nil
+			dstStruct.Range.RangeConfig.SpotFrom = nil
+		}
+
 		if dst.CanSet() { // For extra safety to prevent panicking.
 			dst.Set(reflect.ValueOf(dstStruct))
 		}
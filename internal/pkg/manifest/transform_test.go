@@ -842,6 +842,64 @@ func TestAdvancedCountTransformer_Transformer(t *testing.T) {
 				a.Requests = mockReq
 			},
 		},
+		"spot and spot_from set to empty if capacity is not empty": {
+			original: func(a *AdvancedCount) {
+				a.Range = Range{
+					RangeConfig: RangeConfig{
+						Min:      aws.Int(1),
+						Max:      aws.Int(10),
+						SpotFrom: aws.Int(3),
+					},
+				}
+				a.CPU = mockConfig
+			},
+			override: func(a *AdvancedCount) {
+				a.Capacity = CapacityStrategy{
+					OnDemandBase: aws.Int(2),
+					SpotWeight:   aws.Int(3),
+				}
+			},
+			wanted: func(a *AdvancedCount) {
+				a.Range = Range{
+					RangeConfig: RangeConfig{
+						Min: aws.Int(1),
+						Max: aws.Int(10),
+					},
+				}
+				a.CPU = mockConfig
+				a.Capacity = CapacityStrategy{
+					OnDemandBase: aws.Int(2),
+					SpotWeight:   aws.Int(3),
+				}
+			},
+		},
+		"capacity set to empty if spot_from is not nil": {
+			original: func(a *AdvancedCount) {
+				a.Capacity = CapacityStrategy{
+					SpotWeight: aws.Int(3),
+				}
+			},
+			override: func(a *AdvancedCount) {
+				a.Range = Range{
+					RangeConfig: RangeConfig{
+						Min:      aws.Int(1),
+						Max:      aws.Int(10),
+						SpotFrom: aws.Int(3),
+					},
+				}
+				a.CPU = mockConfig
+			},
+			wanted: func(a *AdvancedCount) {
+				a.Range = Range{
+					RangeConfig: RangeConfig{
+						Min:      aws.Int(1),
+						Max:      aws.Int(10),
+						SpotFrom: aws.Int(3),
+					},
+				}
+				a.CPU = mockConfig
+			},
+		},
 		"auto scaling set to empty if spot is not nil": {
 			original: func(a *AdvancedCount) {
 				a.Range = Range{
@@ -857,6 +915,23 @@ func TestAdvancedCountTransformer_Transformer(t *testing.T) {
 				a.Spot = aws.Int(24)
 			},
 		},
+		"capacity set to empty if spot is not nil": {
+			original: func(a *AdvancedCount) {
+				a.Range = Range{
+					Value: (*IntRangeBand)(aws.String("1-10")),
+				}
+				a.CPU = mockConfig
+				a.Capacity = CapacityStrategy{
+					OnDemandBase: aws.Int(1),
+				}
+			},
+			override: func(a *AdvancedCount) {
+				a.Spot = aws.Int(24)
+			},
+			wanted: func(a *AdvancedCount) {
+				a.Spot = aws.Int(24)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
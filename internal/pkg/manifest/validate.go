@@ -8,14 +8,19 @@ import (
 	"fmt"
 	"net"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	"github.com/aws/copilot-cli/internal/pkg/graph"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/dustin/go-humanize/english"
 )
@@ -44,6 +49,13 @@ const (
 
 	// Tracing vendors.
 	awsXRAY = "awsxray"
+
+	// LogParserJSON is the FireLens parser for logs in the JSON format.
+	LogParserJSON = "json"
+
+	// OpenTelemetry collector destinations.
+	otelCloudWatch = "cloudwatch"
+	otelOTLP       = "otlp"
 )
 
 const (
@@ -51,8 +63,28 @@ const (
 	// Please refer to https://docs.aws.amazon.com/elasticloadbalancing/latest/application/load-balancer-limits.html.
 	maxConditionsPerRule = 5
 	rootPath             = "/"
+
+	// A forward action of a listener rule can route to at most five target groups.
+	maxWeightedTargetsPerRule = 4
+	maxTargetGroupWeight      = 999
+	maxFixedResponseBodyLen   = 1024
+	maxALBAuthSessionTimeout  = 7 * 24 * time.Hour
+)
+
+// Bounds of the memory and timeout of a Lambda function.
+const (
+	minLambdaMemory  = 128
+	maxLambdaMemory  = 10240
+	maxLambdaTimeout = 15 * time.Minute
 )
 
+// CodeDeploy waits at most two days to terminate the original tasks of a blue/green deployment.
+const maxBlueGreenTerminationWait = 48 * time.Hour
+
+// CloudFormation waits on a blue/green deployment through a Lambda function that runs for at most 15 minutes,
+// so the traffic has to be shifted well within that time.
+const maxTrafficShiftingDuration = 10 * time.Minute
+
 var (
 	intRangeBandRegexp  = regexp.MustCompile(`^(\d+)-(\d+)$`)
 	volumesPathRegexp   = regexp.MustCompile(`^[a-zA-Z0-9\-\.\_/]+$`)
@@ -66,13 +98,32 @@ var (
 	nlbValidProtocols                        = []string{TCP, udp, TLS}
 	validContainerProtocols                  = []string{TCP, udp}
 	tracingValidVendors                      = []string{awsXRAY}
+	otelTracesValidDestinations              = []string{awsXRAY, otelOTLP}
+	otelMetricsValidDestinations             = []string{otelCloudWatch, otelOTLP}
 	ecsRollingUpdateStrategies               = []string{ECSDefaultRollingUpdateStrategy, ECSRecreateRollingUpdateStrategy}
+	ecsServiceRollingUpdateStrategies        = []string{ECSDefaultRollingUpdateStrategy, ECSRecreateRollingUpdateStrategy, ECSCanaryRollingUpdateStrategy, ECSLinearRollingUpdateStrategy}
+	ecsDeploymentTypes                       = []string{ECSRollingDeploymentType, ECSBlueGreenDeploymentType}
+	lambdaRollingUpdateStrategies            = []string{ECSDefaultRollingUpdateStrategy, ECSCanaryRollingUpdateStrategy, ECSLinearRollingUpdateStrategy}
 
-	httpProtocolVersions = []string{"GRPC", "HTTP1", "HTTP2"}
+	httpProtocolVersions          = []string{"GRPC", "HTTP1", "HTTP2"}
+	httpRedirectProtocols         = []string{"HTTP", "HTTPS"}
+	httpRedirectStatusCodes       = []string{"301", "302"}
+	httpFixedResponseContentTypes = []string{"text/plain", "text/css", "text/html", "application/javascript", "application/json"}
+	httpClientAuthModes           = []string{ClientAuthVerify, ClientAuthPassthrough}
+	serviceConnectAppProtocols    = []string{ServiceConnectAppProtocolHTTP, ServiceConnectAppProtocolHTTP2, ServiceConnectAppProtocolGRPC}
 
 	invalidTaskDefOverridePathRegexp  = []string{`Family`, `ContainerDefinitions\[\d+\].Name`}
 	validSQSDeduplicationScopeValues  = []string{sqsDeduplicationScopeMessageGroup, sqsDeduplicationScopeQueue}
 	validSQSFIFOThroughputLimitValues = []string{sqsFIFOThroughputLimitPerMessageGroupID, sqsFIFOThroughputLimitPerQueue}
+	validSNSFilterPolicyScopeValues   = []string{snsFilterPolicyScopeMessageAttributes, snsFilterPolicyScopeMessageBody}
+
+	validPipelineTestComputeTypes = []string{"BUILD_GENERAL1_SMALL", "BUILD_GENERAL1_MEDIUM", "BUILD_GENERAL1_LARGE", "BUILD_GENERAL1_2XLARGE"}
+
+	cloudWatchStatistics = []string{"Average", "Minimum", "Maximum", "Sum", "SampleCount"}
+
+	// Bounds of the message retention period of an SQS queue.
+	minSQSRetention = time.Minute
+	maxSQSRetention = 14 * 24 * time.Hour
 )
 
 // Validate returns nil if DynamicLoadBalancedWebService is configured correctly.
@@ -97,6 +148,16 @@ func (l LoadBalancedWebService) validate() error {
 	}); err != nil {
 		return fmt.Errorf(`validate load balancer target for "http": %w`, err)
 	}
+	for idx, target := range l.HTTPOrBool.Main.WeightedTargets {
+		if err = validateTargetContainer(validateTargetContainerOpts{
+			mainContainerName: aws.StringValue(l.Name),
+			mainContainerPort: l.ImageConfig.Port,
+			targetContainer:   target.TargetContainer,
+			sidecarConfig:     l.Sidecars,
+		}); err != nil {
+			return fmt.Errorf(`validate load balancer target for "http.weighted_targets[%d]": %w`, idx, err)
+		}
+	}
 	for idx, rule := range l.HTTPOrBool.AdditionalRoutingRules {
 		if err = validateTargetContainer(validateTargetContainerOpts{
 			mainContainerName: aws.StringValue(l.Name),
@@ -106,6 +167,16 @@ func (l LoadBalancedWebService) validate() error {
 		}); err != nil {
 			return fmt.Errorf(`validate load balancer target for "http.additional_rules[%d]": %w`, idx, err)
 		}
+		for targetIdx, target := range rule.WeightedTargets {
+			if err = validateTargetContainer(validateTargetContainerOpts{
+				mainContainerName: aws.StringValue(l.Name),
+				mainContainerPort: l.ImageConfig.Port,
+				targetContainer:   target.TargetContainer,
+				sidecarConfig:     l.Sidecars,
+			}); err != nil {
+				return fmt.Errorf(`validate load balancer target for "http.additional_rules[%d].weighted_targets[%d]": %w`, idx, targetIdx, err)
+			}
+		}
 	}
 	if err = validateTargetContainer(validateTargetContainerOpts{
 		mainContainerName: aws.StringValue(l.Name),
@@ -128,6 +199,7 @@ func (l LoadBalancedWebService) validate() error {
 	if err = validateContainerDeps(validateDependenciesOpts{
 		sidecarConfig:     l.Sidecars,
 		imageConfig:       l.ImageConfig.Image,
+		healthCheck:       l.ImageConfig.HealthCheck,
 		mainContainerName: aws.StringValue(l.Name),
 		logging:           l.Logging,
 	}); err != nil {
@@ -149,12 +221,90 @@ func (d DeploymentConfig) validate() error {
 	if d.isEmpty() {
 		return nil
 	}
+	if d.Type != nil && !contains(aws.StringValue(d.Type), ecsDeploymentTypes) {
+		return fmt.Errorf("invalid deployment type %q, must be one of %s",
+			aws.StringValue(d.Type),
+			english.WordSeries(ecsDeploymentTypes, "or"))
+	}
 	if err := d.RollbackAlarms.validate(); err != nil {
 		return fmt.Errorf(`validate "rollback_alarms": %w`, err)
 	}
-	if err := d.DeploymentControllerConfig.validate(); err != nil {
+	if err := d.DeploymentControllerConfig.validateStrategy(ecsServiceRollingUpdateStrategies); err != nil {
 		return fmt.Errorf(`validate "rolling": %w`, err)
 	}
+	if err := d.DeploymentHooks.validate(); err != nil {
+		return err
+	}
+	if d.IsTrafficShifting() {
+		if d.Type != nil && aws.StringValue(d.Type) != ECSBlueGreenDeploymentType {
+			return fmt.Errorf(`"rolling" %q requires "type" to be %q`, aws.StringValue(d.Rolling), ECSBlueGreenDeploymentType)
+		}
+		if err := d.TrafficShifting.validateForStrategy(aws.StringValue(d.Rolling)); err != nil {
+			return fmt.Errorf(`validate "traffic_shifting": %w`, err)
+		}
+	} else if !d.TrafficShifting.IsEmpty() {
+		return fmt.Errorf(`"traffic_shifting" can only be specified when "rolling" is %s`,
+			english.WordSeries([]string{ECSCanaryRollingUpdateStrategy, ECSLinearRollingUpdateStrategy}, "or"))
+	}
+	if !d.IsBlueGreen() {
+		if !d.BlueGreen.IsEmpty() {
+			return fmt.Errorf(`"blue_green" can only be specified when "type" is %q`, ECSBlueGreenDeploymentType)
+		}
+		return nil
+	}
+	if d.Rolling != nil && !d.IsTrafficShifting() {
+		return fmt.Errorf(`"rolling" %q cannot be specified when "type" is %q`, aws.StringValue(d.Rolling), ECSBlueGreenDeploymentType)
+	}
+	if err := d.BlueGreen.validate(); err != nil {
+		return fmt.Errorf(`validate "blue_green": %w`, err)
+	}
+	return nil
+}
+
+// validate is a no-op for TrafficShiftingConfig, as its fields depend on the strategy and are validated with validateForStrategy.
+func (TrafficShiftingConfig) validate() error {
+	return nil
+}
+
+func (t TrafficShiftingConfig) validateForStrategy(strategy string) error {
+	if t.Percent != nil {
+		if percent := aws.IntValue(t.Percent); percent < 1 || percent > 99 {
+			return fmt.Errorf(`"percent" %d must be between 1 and 99`, percent)
+		}
+	}
+	if t.Interval != nil {
+		interval := *t.Interval
+		if interval < time.Minute || interval%time.Minute != 0 {
+			return fmt.Errorf(`"interval" %s must be a whole number of minutes`, interval)
+		}
+	}
+	if total := t.Duration(strategy); total > maxTrafficShiftingDuration {
+		return fmt.Errorf("shifting the traffic takes %s, which must be at most %s", total, maxTrafficShiftingDuration)
+	}
+	return nil
+}
+
+func (b BlueGreenDeploymentConfig) validate() error {
+	if b.TestListenerPort != nil {
+		switch port := aws.Uint16Value(b.TestListenerPort); port {
+		case 80, 443:
+			return fmt.Errorf(`"test_listener_port" %d is reserved for the production listener`, port)
+		}
+	}
+	if b.TerminationWait != nil {
+		wait := *b.TerminationWait
+		if wait%time.Minute != 0 {
+			return fmt.Errorf(`"termination_wait" %s must be a whole number of minutes`, wait)
+		}
+		if wait > maxBlueGreenTerminationWait {
+			return fmt.Errorf(`"termination_wait" %s cannot be longer than %s`, wait, maxBlueGreenTerminationWait)
+		}
+	}
+	return nil
+}
+
+// validate is a no-op for BlueGreenHooks, the names of the Lambda functions are only known to CodeDeploy.
+func (BlueGreenHooks) validate() error {
 	return nil
 }
 
@@ -168,19 +318,52 @@ func (w WorkerDeploymentConfig) validate() error {
 	if err := w.DeploymentControllerConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "deployment controller strategy": %w`, err)
 	}
+	if err := w.DeploymentHooks.validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (h DeploymentHooks) validate() error {
+	if err := h.PreDeploy.validate(); err != nil {
+		return fmt.Errorf(`validate "pre_deploy": %w`, err)
+	}
+	if err := h.PostDeploy.validate(); err != nil {
+		return fmt.Errorf(`validate "post_deploy": %w`, err)
+	}
+	return nil
+}
+
+func (h DeploymentHook) validate() error {
+	if h.IsEmpty() {
+		return nil
+	}
+	command, err := h.Command.ToStringSlice()
+	if err != nil {
+		return fmt.Errorf(`convert "command" to string slice: %w`, err)
+	}
+	if len(command) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "command",
+		}
+	}
 	return nil
 }
 
 func (d DeploymentControllerConfig) validate() error {
+	return d.validateStrategy(ecsRollingUpdateStrategies)
+}
+
+func (d DeploymentControllerConfig) validateStrategy(strategies []string) error {
 	if d.Rolling != nil {
-		for _, validStrategy := range ecsRollingUpdateStrategies {
+		for _, validStrategy := range strategies {
 			if strings.EqualFold(aws.StringValue(d.Rolling), validStrategy) {
 				return nil
 			}
 		}
 		return fmt.Errorf("invalid rolling deployment strategy %q, must be one of %s",
 			aws.StringValue(d.Rolling),
-			english.WordSeries(ecsRollingUpdateStrategies, "or"))
+			english.WordSeries(strategies, "or"))
 	}
 	return nil
 }
@@ -193,6 +376,121 @@ func (w WorkerAlarmArgs) validate() error {
 	return nil
 }
 
+// validate returns nil if AlarmsConfig is configured correctly.
+func (a AlarmsConfig) validate() error {
+	if err := validatePercentageThreshold("cpu_utilization", a.CPUUtilization); err != nil {
+		return err
+	}
+	if err := validatePercentageThreshold("memory_utilization", a.MemoryUtilization); err != nil {
+		return err
+	}
+	if err := a.Notify.validate(); err != nil {
+		return fmt.Errorf(`validate "notify": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if HTTPAlarmsConfig is configured correctly.
+func (a HTTPAlarmsConfig) validate() error {
+	if err := a.AlarmsConfig.validate(); err != nil {
+		return err
+	}
+	if err := validatePercentageThreshold("http_5xx_rate", a.HTTP5xxRate); err != nil {
+		return err
+	}
+	if a.ResponseTime != nil && *a.ResponseTime <= 0 {
+		return fmt.Errorf(`"response_time" %s must be greater than 0s`, *a.ResponseTime)
+	}
+	return nil
+}
+
+// validate returns nil if WorkerAlarmsConfig is configured correctly.
+func (a WorkerAlarmsConfig) validate() error {
+	if err := a.AlarmsConfig.validate(); err != nil {
+		return err
+	}
+	if a.QueueDepth != nil && aws.IntValue(a.QueueDepth) <= 0 {
+		return fmt.Errorf(`"queue_depth" %d must be greater than 0`, aws.IntValue(a.QueueDepth))
+	}
+	return nil
+}
+
+// alarmThreshold is the field of the "alarms" section that generates the alarm referred to by a rollback alarm shorthand.
+type alarmThreshold struct {
+	field string
+	isSet bool
+}
+
+func (a AlarmsConfig) thresholds() map[string]alarmThreshold {
+	return map[string]alarmThreshold{
+		RollbackAlarmCPU:    {field: "cpu_utilization", isSet: a.CPUUtilization != nil},
+		RollbackAlarmMemory: {field: "memory_utilization", isSet: a.MemoryUtilization != nil},
+	}
+}
+
+func (a HTTPAlarmsConfig) thresholds() map[string]alarmThreshold {
+	out := a.AlarmsConfig.thresholds()
+	out[RollbackAlarmHTTP5xx] = alarmThreshold{field: "http_5xx_rate", isSet: a.HTTP5xxRate != nil}
+	out[RollbackAlarmResponseTime] = alarmThreshold{field: "response_time", isSet: a.ResponseTime != nil}
+	return out
+}
+
+func (a WorkerAlarmsConfig) thresholds() map[string]alarmThreshold {
+	out := a.AlarmsConfig.thresholds()
+	out[RollbackAlarmQueueDepth] = alarmThreshold{field: "queue_depth", isSet: a.QueueDepth != nil}
+	return out
+}
+
+// validateGeneratedRollbackAlarms returns an error if a shorthand in "deployment.rollback_alarms" refers to
+// an alarm that the "alarms" section of the workload doesn't generate.
+func validateGeneratedRollbackAlarms(names []string, thresholds map[string]alarmThreshold) error {
+	for _, name := range names {
+		if !IsGeneratedRollbackAlarm(name) {
+			continue
+		}
+		threshold, ok := thresholds[name]
+		if !ok {
+			shorthands := make([]string, 0, len(thresholds))
+			for shorthand := range thresholds {
+				shorthands = append(shorthands, shorthand)
+			}
+			sort.Strings(shorthands)
+			return fmt.Errorf(`"deployment.rollback_alarms" %q must be the name of an existing alarm or one of %s`,
+				name, english.WordSeries(quoteStringSlice(shorthands), "or"))
+		}
+		if !threshold.isSet {
+			return fmt.Errorf(`"deployment.rollback_alarms" %q requires "alarms.%s" to be set`, name, threshold.field)
+		}
+	}
+	return nil
+}
+
+// validate returns nil if AlarmNotifyConfig is configured correctly.
+func (n AlarmNotifyConfig) validate() error {
+	for idx, email := range n.Emails {
+		if !strings.Contains(email, "@") {
+			return fmt.Errorf(`"emails[%d]" %q is not a valid email address`, idx, email)
+		}
+	}
+	for idx, topic := range n.Topics {
+		parsed, err := arn.Parse(topic)
+		if err != nil || parsed.Service != "sns" {
+			return fmt.Errorf(`"topics[%d]" %q is not the ARN of an SNS topic`, idx, topic)
+		}
+	}
+	return nil
+}
+
+func validatePercentageThreshold(field string, threshold *float64) error {
+	if threshold == nil {
+		return nil
+	}
+	if v := aws.Float64Value(threshold); v <= 0 || v > 100 {
+		return fmt.Errorf(`%q %v must be greater than 0 and less than or equal to 100`, field, v)
+	}
+	return nil
+}
+
 // validate returns nil if LoadBalancedWebServiceConfig is configured correctly.
 func (l LoadBalancedWebServiceConfig) validate() error {
 	var err error
@@ -219,17 +517,33 @@ func (l LoadBalancedWebServiceConfig) validate() error {
 	if err = l.TaskConfig.validate(); err != nil {
 		return err
 	}
+	if err = validateImagePlatforms(l.ImageConfig.Image, l.Platform); err != nil {
+		return err
+	}
 	if err = l.Logging.validate(); err != nil {
 		return fmt.Errorf(`validate "logging": %w`, err)
 	}
-	for k, v := range l.Sidecars {
-		if err = v.validate(); err != nil {
-			return fmt.Errorf(`validate "sidecars[%s]": %w`, k, err)
-		}
+	if err = validateSidecars(l.Sidecars, l.TaskConfig); err != nil {
+		return err
 	}
 	if err = l.Network.validate(); err != nil {
 		return fmt.Errorf(`validate "network": %w`, err)
 	}
+	if len(l.Network.Connect.Ports) != 0 {
+		return errors.New(`"network.connect.ports" is only supported by Backend Services`)
+	}
+	if err = l.Observability.validate(); err != nil {
+		return fmt.Errorf(`validate "observability": %w`, err)
+	}
+	if err = l.Alarms.validate(); err != nil {
+		return fmt.Errorf(`validate "alarms": %w`, err)
+	}
+	if l.HTTPOrBool.Disabled() && (l.Alarms.HTTP5xxRate != nil || l.Alarms.ResponseTime != nil) {
+		return errors.New(`"alarms.http_5xx_rate" and "alarms.response_time" require "http" to be enabled`)
+	}
+	if err = validateGeneratedRollbackAlarms(l.DeployConfig.RollbackAlarms.Basic, l.Alarms.thresholds()); err != nil {
+		return err
+	}
 	if err = l.PublishConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "publish": %w`, err)
 	}
@@ -246,6 +560,16 @@ func (l LoadBalancedWebServiceConfig) validate() error {
 			return fmt.Errorf("validate Windows: %w", err)
 		}
 	}
+	if l.TaskConfig.OnEC2() {
+		if err = validateEC2(validateEC2Opts{
+			spot:      l.Count.AdvancedCount.Spot,
+			spotFrom:  l.Count.AdvancedCount.Range.RangeConfig.SpotFrom,
+			ephemeral: l.Storage.Ephemeral,
+			placement: l.Network.VPC.Placement,
+		}); err != nil {
+			return fmt.Errorf("validate EC2: %w", err)
+		}
+	}
 	if l.TaskConfig.IsARM() {
 		if err = validateARM(validateARMOpts{
 			Spot:     l.Count.AdvancedCount.Spot,
@@ -260,6 +584,36 @@ func (l LoadBalancedWebServiceConfig) validate() error {
 	if err = l.DeployConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "deployment": %w`, err)
 	}
+	if l.DeployConfig.IsBlueGreen() {
+		if err = l.validateBlueGreen(); err != nil {
+			return fmt.Errorf(`validate "deployment": %w`, err)
+		}
+	}
+	return nil
+}
+
+// validateBlueGreen returns nil if the service can be deployed with CodeDeploy blue/green deployments,
+// which shift the traffic of a single target group of an Application Load Balancer.
+func (l LoadBalancedWebServiceConfig) validateBlueGreen() error {
+	if l.HTTPOrBool.Disabled() {
+		return fmt.Errorf(`"http" must be enabled when "type" is %q`, ECSBlueGreenDeploymentType)
+	}
+	if len(l.HTTPOrBool.AdditionalRoutingRules) != 0 {
+		return fmt.Errorf(`"http.additional_rules" cannot be specified when "type" is %q`, ECSBlueGreenDeploymentType)
+	}
+	if l.HTTPOrBool.Main.RedirectToHTTPS != nil && !aws.BoolValue(l.HTTPOrBool.Main.RedirectToHTTPS) {
+		// CodeDeploy shifts the traffic of a single production listener.
+		return fmt.Errorf(`"http.redirect_to_https" cannot be disabled when "type" is %q`, ECSBlueGreenDeploymentType)
+	}
+	if !l.NLBConfig.IsEmpty() {
+		return fmt.Errorf(`"nlb" cannot be specified when "type" is %q`, ECSBlueGreenDeploymentType)
+	}
+	if l.Network.Connect.Enabled() {
+		return fmt.Errorf(`"network.connect" cannot be enabled when "type" is %q`, ECSBlueGreenDeploymentType)
+	}
+	if len(l.HTTPOrBool.Main.WeightedTargets) != 0 {
+		return fmt.Errorf(`"http.weighted_targets" cannot be specified when "type" is %q`, ECSBlueGreenDeploymentType)
+	}
 	return nil
 }
 
@@ -269,6 +623,12 @@ func (b BackendService) validate() error {
 	if err = b.DeployConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "deployment": %w`, err)
 	}
+	if b.DeployConfig.IsTrafficShifting() {
+		return fmt.Errorf(`validate "deployment": "rolling" %q is only supported by Load Balanced Web Services`, aws.StringValue(b.DeployConfig.Rolling))
+	}
+	if b.DeployConfig.IsBlueGreen() {
+		return fmt.Errorf(`validate "deployment": "type" %q is only supported by Load Balanced Web Services`, ECSBlueGreenDeploymentType)
+	}
 	if err = b.BackendServiceConfig.validate(); err != nil {
 		return err
 	}
@@ -283,6 +643,16 @@ func (b BackendService) validate() error {
 	}); err != nil {
 		return fmt.Errorf(`validate load balancer target for "http": %w`, err)
 	}
+	for idx, target := range b.HTTP.Main.WeightedTargets {
+		if err = validateTargetContainer(validateTargetContainerOpts{
+			mainContainerName: aws.StringValue(b.Name),
+			mainContainerPort: b.ImageConfig.Port,
+			targetContainer:   target.TargetContainer,
+			sidecarConfig:     b.Sidecars,
+		}); err != nil {
+			return fmt.Errorf(`validate load balancer target for "http.weighted_targets[%d]": %w`, idx, err)
+		}
+	}
 	for idx, rule := range b.HTTP.AdditionalRoutingRules {
 		if err = validateTargetContainer(validateTargetContainerOpts{
 			mainContainerName: aws.StringValue(b.Name),
@@ -292,23 +662,81 @@ func (b BackendService) validate() error {
 		}); err != nil {
 			return fmt.Errorf(`validate load balancer target for "http.additional_rules[%d]": %w`, idx, err)
 		}
+		for targetIdx, target := range rule.WeightedTargets {
+			if err = validateTargetContainer(validateTargetContainerOpts{
+				mainContainerName: aws.StringValue(b.Name),
+				mainContainerPort: b.ImageConfig.Port,
+				targetContainer:   target.TargetContainer,
+				sidecarConfig:     b.Sidecars,
+			}); err != nil {
+				return fmt.Errorf(`validate load balancer target for "http.additional_rules[%d].weighted_targets[%d]": %w`, idx, targetIdx, err)
+			}
+		}
+	}
+	for idx, port := range b.Network.Connect.Ports {
+		if err = validateTargetContainer(validateTargetContainerOpts{
+			mainContainerName: aws.StringValue(b.Name),
+			mainContainerPort: b.ImageConfig.Port,
+			targetContainer:   port.TargetContainer,
+			sidecarConfig:     b.Sidecars,
+		}); err != nil {
+			return fmt.Errorf(`validate Service Connect target for "network.connect.ports[%d]": %w`, idx, err)
+		}
 	}
 	if err = validateContainerDeps(validateDependenciesOpts{
 		sidecarConfig:     b.Sidecars,
 		imageConfig:       b.ImageConfig.Image,
+		healthCheck:       b.ImageConfig.HealthCheck,
 		mainContainerName: aws.StringValue(b.Name),
 		logging:           b.Logging,
 	}); err != nil {
 		return fmt.Errorf("validate container dependencies: %w", err)
 	}
 	if err = validateExposedPorts(validateExposedPortsOpts{
-		mainContainerName: aws.StringValue(b.Name),
-		mainContainerPort: b.ImageConfig.Port,
-		sidecarConfig:     b.Sidecars,
-		alb:               &b.HTTP,
+		mainContainerName:   aws.StringValue(b.Name),
+		mainContainerPort:   b.ImageConfig.Port,
+		sidecarConfig:       b.Sidecars,
+		alb:                 &b.HTTP,
+		serviceConnectPorts: b.Network.Connect.Ports,
 	}); err != nil {
 		return fmt.Errorf("validate unique exposed ports: %w", err)
 	}
+	if err = b.validateServiceConnectPorts(); err != nil {
+		return fmt.Errorf(`validate "network.connect.ports": %w`, err)
+	}
+	return nil
+}
+
+// validateServiceConnectPorts returns an error if an additional Service Connect port is the port of the main endpoint,
+// or if the health check of a port would replace the health check command of its container.
+func (b BackendService) validateServiceConnectPorts() error {
+	if len(b.Network.Connect.Ports) == 0 {
+		return nil
+	}
+	exposedPorts, err := b.ExposedPorts()
+	if err != nil {
+		return err
+	}
+	_, targetPort, err := b.HTTP.Main.Target(exposedPorts)
+	if err != nil {
+		return err
+	}
+	for idx, port := range b.Network.Connect.Ports {
+		if strconv.Itoa(int(aws.Uint16Value(port.Port))) == targetPort {
+			return fmt.Errorf(`port %s of "ports[%d]" is already the port of the main Service Connect endpoint`, targetPort, idx)
+		}
+		if port.HealthCheckCommand() == "" {
+			continue
+		}
+		container := exposedPorts.ContainerForPort[aws.Uint16Value(port.Port)]
+		hc := b.ImageConfig.HealthCheck
+		if sidecar, ok := b.Sidecars[container]; ok && sidecar != nil {
+			hc = sidecar.HealthCheck
+		}
+		if len(hc.Command) != 0 {
+			return fmt.Errorf(`"ports[%d].healthcheck" can't be used with the health check command of container %q`, idx, container)
+		}
+	}
 	return nil
 }
 
@@ -324,31 +752,70 @@ func (b BackendServiceConfig) validate() error {
 	if err = b.HTTP.validate(); err != nil {
 		return fmt.Errorf(`validate "http": %w`, err)
 	}
+	if !b.HTTP.TLS.IsEmpty() {
+		return errors.New(`"http.tls" is only supported by the public load balancer of a Load Balanced Web Service`)
+	}
+	for _, rule := range b.HTTP.RoutingRules() {
+		if !rule.Auth.IsEmpty() {
+			return errors.New(`"http.auth" is only supported by the public load balancer of a Load Balanced Web Service`)
+		}
+	}
 	if b.HTTP.IsEmpty() && (!b.Count.AdvancedCount.Requests.IsEmpty() || !b.Count.AdvancedCount.ResponseTime.IsEmpty()) {
 		return &errFieldMustBeSpecified{
 			missingField:      "http",
 			conditionalFields: []string{"count.requests", "count.response_time"},
 		}
 	}
+	if err = b.APIGateway.validate(); err != nil {
+		return fmt.Errorf(`validate "api_gateway": %w`, err)
+	}
+	if !b.Count.AdvancedCount.Connections.IsEmpty() && !b.APIGateway.IsWebSocket() {
+		return fmt.Errorf(`"count.connections" can only be specified if "api_gateway.protocol" is %q`, APIGatewayProtocolWebSocket)
+	}
+	if !b.APIGateway.IsEmpty() && b.ImageConfig.Port == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "image.port",
+			conditionalFields: []string{"api_gateway"},
+		}
+	}
 	if err = b.TaskConfig.validate(); err != nil {
 		return err
 	}
+	if err = validateImagePlatforms(b.ImageConfig.Image, b.Platform); err != nil {
+		return err
+	}
 	if err = b.Logging.validate(); err != nil {
 		return fmt.Errorf(`validate "logging": %w`, err)
 	}
-	for k, v := range b.Sidecars {
-		if err = v.validate(); err != nil {
-			return fmt.Errorf(`validate "sidecars[%s]": %w`, k, err)
-		}
+	if err = validateSidecars(b.Sidecars, b.TaskConfig); err != nil {
+		return err
 	}
 	if err = b.Network.validate(); err != nil {
 		return fmt.Errorf(`validate "network": %w`, err)
 	}
+	if err = b.Observability.validate(); err != nil {
+		return fmt.Errorf(`validate "observability": %w`, err)
+	}
+	if err = b.Alarms.validate(); err != nil {
+		return fmt.Errorf(`validate "alarms": %w`, err)
+	}
+	if b.HTTP.IsEmpty() && (b.Alarms.HTTP5xxRate != nil || b.Alarms.ResponseTime != nil) {
+		return errors.New(`"alarms.http_5xx_rate" and "alarms.response_time" require "http" to be configured`)
+	}
+	if err = validateGeneratedRollbackAlarms(b.DeployConfig.RollbackAlarms.Basic, b.Alarms.thresholds()); err != nil {
+		return err
+	}
+	exposesPorts := b.HTTP.Main.TargetContainer != nil || b.ImageConfig.Port != nil || len(b.Network.Connect.Ports) != 0
 	if b.Network.Connect.Alias != nil {
-		if b.HTTP.Main.TargetContainer == nil && b.ImageConfig.Port == nil {
+		if !exposesPorts {
 			return fmt.Errorf(`cannot set "network.connect.alias" when no ports are exposed`)
 		}
 	}
+	if aws.BoolValue(b.Network.Connect.TLS) {
+		if !exposesPorts {
+			return fmt.Errorf(`cannot enable "network.connect.tls" when no ports are exposed`)
+		}
+	}
 	if err = b.PublishConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "publish": %w`, err)
 	}
@@ -365,6 +832,16 @@ func (b BackendServiceConfig) validate() error {
 			return fmt.Errorf("validate Windows: %w", err)
 		}
 	}
+	if b.TaskConfig.OnEC2() {
+		if err = validateEC2(validateEC2Opts{
+			spot:      b.Count.AdvancedCount.Spot,
+			spotFrom:  b.Count.AdvancedCount.Range.RangeConfig.SpotFrom,
+			ephemeral: b.Storage.Ephemeral,
+			placement: b.Network.VPC.Placement,
+		}); err != nil {
+			return fmt.Errorf("validate EC2: %w", err)
+		}
+	}
 	if b.TaskConfig.IsARM() {
 		if err = validateARM(validateARMOpts{
 			Spot:     b.Count.AdvancedCount.Spot,
@@ -393,6 +870,9 @@ func (r RequestDrivenWebServiceConfig) validate() error {
 	if err = r.InstanceConfig.validate(); err != nil {
 		return err
 	}
+	if err = validateImagePlatforms(r.ImageConfig.Image, r.InstanceConfig.Platform); err != nil {
+		return err
+	}
 	if err = r.RequestDrivenWebServiceHttpConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "http": %w`, err)
 	}
@@ -410,6 +890,17 @@ func (r RequestDrivenWebServiceConfig) validate() error {
 	if err = r.Observability.validate(); err != nil {
 		return fmt.Errorf(`validate "observability": %w`, err)
 	}
+	if !r.Observability.Collector.IsEmpty() {
+		return fmt.Errorf(`"observability.collector" is not supported for %s`, manifestinfo.RequestDrivenWebServiceType)
+	}
+	if err = r.Count.validate(); err != nil {
+		return fmt.Errorf(`validate "count": %w`, err)
+	}
+	for name, secret := range r.Secrets {
+		if secret.IsFile() {
+			return fmt.Errorf(`"as_file" is not supported for secret %s of a %s`, name, manifestinfo.RequestDrivenWebServiceType)
+		}
+	}
 	return nil
 }
 
@@ -425,6 +916,7 @@ func (w WorkerService) validate() error {
 	if err = validateContainerDeps(validateDependenciesOpts{
 		sidecarConfig:     w.Sidecars,
 		imageConfig:       w.ImageConfig.Image,
+		healthCheck:       w.ImageConfig.HealthCheck,
 		mainContainerName: aws.StringValue(w.Name),
 		logging:           w.Logging,
 	}); err != nil {
@@ -453,20 +945,36 @@ func (w WorkerServiceConfig) validate() error {
 	if err = w.TaskConfig.validate(); err != nil {
 		return err
 	}
+	if err = validateImagePlatforms(w.ImageConfig.Image, w.Platform); err != nil {
+		return err
+	}
 	if err = w.Logging.validate(); err != nil {
 		return fmt.Errorf(`validate "logging": %w`, err)
 	}
-	for k, v := range w.Sidecars {
-		if err = v.validate(); err != nil {
-			return fmt.Errorf(`validate "sidecars[%s]": %w`, k, err)
-		}
+	if err = validateSidecars(w.Sidecars, w.TaskConfig); err != nil {
+		return err
 	}
 	if err = w.Network.validate(); err != nil {
 		return fmt.Errorf(`validate "network": %w`, err)
 	}
+	if err = w.Observability.validate(); err != nil {
+		return fmt.Errorf(`validate "observability": %w`, err)
+	}
+	if err = w.Alarms.validate(); err != nil {
+		return fmt.Errorf(`validate "alarms": %w`, err)
+	}
+	if err = validateGeneratedRollbackAlarms(w.DeployConfig.WorkerRollbackAlarms.Basic, w.Alarms.thresholds()); err != nil {
+		return err
+	}
 	if w.Network.Connect.Alias != nil {
 		return fmt.Errorf(`cannot set "network.connect.alias" when no ports are exposed`)
 	}
+	if aws.BoolValue(w.Network.Connect.TLS) {
+		return fmt.Errorf(`cannot enable "network.connect.tls" when no ports are exposed`)
+	}
+	if len(w.Network.Connect.Ports) != 0 {
+		return errors.New(`"network.connect.ports" is only supported by Backend Services`)
+	}
 	if err = w.Subscribe.validate(); err != nil {
 		return fmt.Errorf(`validate "subscribe": %w`, err)
 	}
@@ -486,6 +994,16 @@ func (w WorkerServiceConfig) validate() error {
 			return fmt.Errorf(`validate Windows: %w`, err)
 		}
 	}
+	if w.TaskConfig.OnEC2() {
+		if err = validateEC2(validateEC2Opts{
+			spot:      w.Count.AdvancedCount.Spot,
+			spotFrom:  w.Count.AdvancedCount.Range.RangeConfig.SpotFrom,
+			ephemeral: w.Storage.Ephemeral,
+			placement: w.Network.VPC.Placement,
+		}); err != nil {
+			return fmt.Errorf("validate EC2: %w", err)
+		}
+	}
 	if w.TaskConfig.IsARM() {
 		if err = validateARM(validateARMOpts{
 			Spot:     w.Count.AdvancedCount.Spot,
@@ -509,6 +1027,7 @@ func (s ScheduledJob) validate() error {
 	if err = validateContainerDeps(validateDependenciesOpts{
 		sidecarConfig:     s.Sidecars,
 		imageConfig:       s.ImageConfig.Image,
+		healthCheck:       s.ImageConfig.HealthCheck,
 		mainContainerName: aws.StringValue(s.Name),
 		logging:           s.Logging,
 	}); err != nil {
@@ -534,17 +1053,21 @@ func (s ScheduledJobConfig) validate() error {
 	if err = s.TaskConfig.validate(); err != nil {
 		return err
 	}
+	if err = validateImagePlatforms(s.ImageConfig.Image, s.Platform); err != nil {
+		return err
+	}
 	if err = s.Logging.validate(); err != nil {
 		return fmt.Errorf(`validate "logging": %w`, err)
 	}
-	for k, v := range s.Sidecars {
-		if err = v.validate(); err != nil {
-			return fmt.Errorf(`validate "sidecars[%s]": %w`, k, err)
-		}
+	if err = validateSidecars(s.Sidecars, s.TaskConfig); err != nil {
+		return err
 	}
 	if err = s.Network.validate(); err != nil {
 		return fmt.Errorf(`validate "network": %w`, err)
 	}
+	if len(s.Network.VPC.SecurityGroups.GetIngress()) > 0 {
+		return fmt.Errorf(`"network.vpc.security_groups.ingress" is not supported for %s`, manifestinfo.ScheduledJobType)
+	}
 	if err = s.On.validate(); err != nil {
 		return fmt.Errorf(`validate "on": %w`, err)
 	}
@@ -567,6 +1090,16 @@ func (s ScheduledJobConfig) validate() error {
 			return fmt.Errorf(`validate Windows: %w`, err)
 		}
 	}
+	if s.TaskConfig.OnEC2() {
+		if err = validateEC2(validateEC2Opts{
+			spot:      s.Count.AdvancedCount.Spot,
+			spotFrom:  s.Count.AdvancedCount.Range.RangeConfig.SpotFrom,
+			ephemeral: s.Storage.Ephemeral,
+			placement: s.Network.VPC.Placement,
+		}); err != nil {
+			return fmt.Errorf("validate EC2: %w", err)
+		}
+	}
 	if s.TaskConfig.IsARM() {
 		if err = validateARM(validateARMOpts{
 			Spot:     s.Count.AdvancedCount.Spot,
@@ -587,6 +1120,9 @@ func (s StaticSite) validate() error {
 }
 
 func (s StaticSiteConfig) validate() error {
+	if err := s.HTTP.validate(); err != nil {
+		return fmt.Errorf(`validate "http": %w`, err)
+	}
 	for idx, fileupload := range s.FileUploads {
 		if err := fileupload.validate(); err != nil {
 			return fmt.Errorf(`validate "files[%d]": %w`, idx, err)
@@ -595,15 +1131,258 @@ func (s StaticSiteConfig) validate() error {
 	return nil
 }
 
-func (f FileUpload) validate() error {
-	return f.validateSource()
-}
-
-// validateSource returns nil if Source is configured correctly.
-func (f FileUpload) validateSource() error {
-	if f.Source == "" {
-		return &errFieldMustBeSpecified{
-			missingField: "source",
+// validate returns nil if StaticSiteHTTP is configured correctly.
+func (h StaticSiteHTTP) validate() error {
+	if strings.HasPrefix(h.ErrorDocument, "/") {
+		return fmt.Errorf(`"error_document" %s must be relative to the root of the site`, h.ErrorDocument)
+	}
+	for idx, redirect := range h.Redirects {
+		if err := redirect.validate(); err != nil {
+			return fmt.Errorf(`validate "redirects[%d]": %w`, idx, err)
+		}
+	}
+	for header := range h.CustomHeaders {
+		if strings.TrimSpace(header) == "" {
+			return errors.New(`"custom_headers" must not contain an empty header name`)
+		}
+	}
+	if h.WAF != nil {
+		// CloudFront distributions can only be associated with web ACLs of the CLOUDFRONT scope, which are global.
+		if err := validateWebACLARN(aws.StringValue(h.WAF), "global"); err != nil {
+			return fmt.Errorf(`validate "waf": %w`, err)
+		}
+	}
+	if err := h.Cache.validate(); err != nil {
+		return fmt.Errorf(`validate "cache": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if StaticSiteCache is configured correctly.
+func (c StaticSiteCache) validate() error {
+	if err := c.StaticSiteCacheBehavior.validate(); err != nil {
+		return err
+	}
+	paths := make(map[string]bool)
+	for idx, path := range c.Paths {
+		if err := path.validate(); err != nil {
+			return fmt.Errorf(`validate "paths[%d]": %w`, idx, err)
+		}
+		if paths[path.Path] {
+			return fmt.Errorf(`"paths[%d]" %s is specified more than once`, idx, path.Path)
+		}
+		paths[path.Path] = true
+	}
+	if c.Invalidation.String != nil {
+		switch strategy := aws.StringValue(c.Invalidation.String); strategy {
+		case StaticSiteInvalidateAll, StaticSiteInvalidateNone:
+		default:
+			if !strings.HasPrefix(strategy, "/") {
+				return fmt.Errorf(`"invalidation" %s must be %q, %q, or a path that starts with "/"`, strategy, StaticSiteInvalidateAll, StaticSiteInvalidateNone)
+			}
+		}
+	}
+	for _, path := range c.Invalidation.StringSlice {
+		if !strings.HasPrefix(path, "/") {
+			return fmt.Errorf(`"invalidation" path %s must start with "/"`, path)
+		}
+	}
+	return nil
+}
+
+// validate returns nil if StaticSiteCachePath is configured correctly.
+func (p StaticSiteCachePath) validate() error {
+	if p.Path == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "path",
+		}
+	}
+	if p.Policy == nil && p.TTL.IsEmpty() && p.OriginRequestPolicy == nil {
+		return &errAtLeastOneFieldMustBeSpecified{
+			missingFields: []string{"policy", "origin_request_policy", "ttl"},
+		}
+	}
+	return p.StaticSiteCacheBehavior.validate()
+}
+
+// validate returns nil if StaticSiteCacheBehavior is configured correctly.
+func (b StaticSiteCacheBehavior) validate() error {
+	if b.Policy != nil && !b.TTL.IsEmpty() {
+		return &errFieldMutualExclusive{
+			firstField:  "policy",
+			secondField: "ttl",
+		}
+	}
+	if err := b.TTL.validate(); err != nil {
+		return fmt.Errorf(`validate "ttl": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if StaticSiteCacheTTL is configured correctly.
+func (t StaticSiteCacheTTL) validate() error {
+	for _, field := range []struct {
+		name string
+		ttl  *time.Duration
+	}{{"min", t.Min}, {"default", t.Default}, {"max", t.Max}} {
+		if field.ttl == nil {
+			continue
+		}
+		if ttl := *field.ttl; ttl < 0 || ttl%time.Second != 0 {
+			return fmt.Errorf(`"%s" %s must be a whole number of seconds that is not negative`, field.name, ttl)
+		}
+	}
+	min, def, max := t.Seconds()
+	if min > def || def > max {
+		return fmt.Errorf(`"min" %ds, "default" %ds and "max" %ds must be in increasing order`, min, def, max)
+	}
+	return nil
+}
+
+// validate returns nil if StaticSiteRedirect is configured correctly.
+func (r StaticSiteRedirect) validate() error {
+	if r.From == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "from",
+		}
+	}
+	if r.To == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "to",
+		}
+	}
+	if !strings.HasPrefix(r.From, "/") {
+		return fmt.Errorf(`"from" %s must start with "/"`, r.From)
+	}
+	if idx := strings.Index(r.From, "*"); idx != -1 && idx != len(r.From)-1 {
+		return fmt.Errorf(`"from" %s can only contain a wildcard "*" at the end`, r.From)
+	}
+	if strings.HasSuffix(r.To, "*") && !strings.HasSuffix(r.From, "*") {
+		return fmt.Errorf(`"to" %s can only end with a wildcard "*" if "from" ends with one`, r.To)
+	}
+	if r.StatusCode != nil {
+		switch code := aws.IntValue(r.StatusCode); code {
+		case 301, 302, 307, 308:
+		default:
+			return fmt.Errorf(`"status_code" %d must be one of 301, 302, 307 or 308`, code)
+		}
+	}
+	return nil
+}
+
+func (f FileUpload) validate() error {
+	return f.validateSource()
+}
+
+// validateSource returns nil if Source is configured correctly.
+func (f FileUpload) validateSource() error {
+	if f.Source == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "source",
+		}
+	}
+	return nil
+}
+
+// validate returns nil if LambdaService is configured correctly.
+func (s LambdaService) validate() error {
+	if err := s.LambdaServiceConfig.validate(); err != nil {
+		return err
+	}
+	return s.Workload.validate()
+}
+
+// validate returns nil if LambdaServiceConfig is configured correctly.
+func (s LambdaServiceConfig) validate() error {
+	var err error
+	if err = s.ImageConfig.validate(); err != nil {
+		return fmt.Errorf(`validate "image": %w`, err)
+	}
+	if len(s.ImageConfig.Image.Platforms) != 0 {
+		return fmt.Errorf(`"image.platforms" is not supported for %s`, manifestinfo.LambdaServiceType)
+	}
+	if s.Memory != nil {
+		if memory := aws.IntValue(s.Memory); memory < minLambdaMemory || memory > maxLambdaMemory {
+			return fmt.Errorf(`"memory" %d must be between %d and %d MiB`, memory, minLambdaMemory, maxLambdaMemory)
+		}
+	}
+	if s.Timeout != nil {
+		if timeout := *s.Timeout; timeout < time.Second || timeout > maxLambdaTimeout || timeout%time.Second != 0 {
+			return fmt.Errorf(`"timeout" %s must be a whole number of seconds between 1s and %s`, timeout, maxLambdaTimeout)
+		}
+	}
+	if err = s.Platform.validate(); err != nil {
+		return fmt.Errorf(`validate "platform": %w`, err)
+	}
+	if !s.Platform.IsEmpty() && s.Platform.OS() != OSLinux {
+		return fmt.Errorf(`"platform" %s is not supported for %s, the operating system must be %s`,
+			platformString(s.Platform.OS(), s.Platform.Arch()), manifestinfo.LambdaServiceType, OSLinux)
+	}
+	if err = s.HTTP.validate(); err != nil {
+		return fmt.Errorf(`validate "http": %w`, err)
+	}
+	if err = s.APIGateway.validate(); err != nil {
+		return fmt.Errorf(`validate "api_gateway": %w`, err)
+	}
+	if s.APIGateway.IsWebSocket() {
+		return fmt.Errorf(`"api_gateway.protocol" must be %q for %s`, APIGatewayProtocolHTTP, manifestinfo.LambdaServiceType)
+	}
+	if !s.HTTP.IsEmpty() && !s.APIGateway.IsEmpty() {
+		return &errFieldMutualExclusive{
+			firstField:  "http",
+			secondField: "api_gateway",
+		}
+	}
+	if err = s.Deployment.validate(); err != nil {
+		return fmt.Errorf(`validate "deployment": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if ImageWithEntryPointAndCommand is configured correctly.
+func (i ImageWithEntryPointAndCommand) validate() error {
+	if err := i.Image.validate(); err != nil {
+		return err
+	}
+	if err := i.EntryPoint.validate(); err != nil {
+		return fmt.Errorf(`validate "entrypoint": %w`, err)
+	}
+	if err := i.Command.validate(); err != nil {
+		return fmt.Errorf(`validate "command": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if LambdaHTTPConfig is configured correctly.
+func (c LambdaHTTPConfig) validate() error {
+	if c.Path != nil && aws.StringValue(c.Path) == "" {
+		return fmt.Errorf(`"path" must not be empty`)
+	}
+	return nil
+}
+
+// validate returns nil if LambdaDeploymentConfig is configured correctly.
+func (d LambdaDeploymentConfig) validate() error {
+	if err := d.DeploymentControllerConfig.validateStrategy(lambdaRollingUpdateStrategies); err != nil {
+		return fmt.Errorf(`validate "rolling": %w`, err)
+	}
+	if !d.IsTrafficShifting() {
+		if !d.TrafficShifting.IsEmpty() {
+			return fmt.Errorf(`"traffic_shifting" can only be specified when "rolling" is %s`,
+				english.WordSeries([]string{ECSCanaryRollingUpdateStrategy, ECSLinearRollingUpdateStrategy}, "or"))
+		}
+		if len(d.RollbackAlarms) != 0 {
+			return fmt.Errorf(`"rollback_alarms" can only be specified when "rolling" is %s`,
+				english.WordSeries([]string{ECSCanaryRollingUpdateStrategy, ECSLinearRollingUpdateStrategy}, "or"))
+		}
+		return nil
+	}
+	if err := d.TrafficShifting.validateForStrategy(aws.StringValue(d.Rolling)); err != nil {
+		return fmt.Errorf(`validate "traffic_shifting": %w`, err)
+	}
+	for idx, alarm := range d.RollbackAlarms {
+		if alarm == "" {
+			return fmt.Errorf(`"rollback_alarms[%d]" must not be empty`, idx)
 		}
 	}
 	return nil
@@ -615,6 +1394,12 @@ func (p Pipeline) Validate() error {
 		return fmt.Errorf(`pipeline name '%s' must be shorter than 100 characters`, p.Name)
 	}
 	for _, stg := range p.Stages {
+		if err := stg.validateApproval(); err != nil {
+			return fmt.Errorf(`validate "approval" for pipeline stage %s: %w`, stg.Name, err)
+		}
+		if err := stg.validateTest(); err != nil {
+			return fmt.Errorf(`validate "test" for pipeline stage %s: %w`, stg.Name, err)
+		}
 		if err := stg.Deployments.validate(); err != nil {
 			return fmt.Errorf(`validate "deployments" for pipeline stage %s: %w`, stg.Name, err)
 		}
@@ -622,6 +1407,63 @@ func (p Pipeline) Validate() error {
 	return nil
 }
 
+// validateApproval returns nil if the approval notifications of a pipeline stage are configured correctly.
+func (s PipelineStage) validateApproval() error {
+	if s.Approval.IsEmpty() {
+		return nil
+	}
+	if !s.RequiresApproval {
+		return errors.New(`"requires_approval" must be true to configure approval notifications`)
+	}
+	return s.Approval.validate()
+}
+
+// validateTest returns nil if the test action of a pipeline stage is configured correctly.
+func (s PipelineStage) validateTest() error {
+	if s.Test.IsEmpty() {
+		return nil
+	}
+	if len(s.TestCommands) == 0 && s.Test.Buildspec == "" {
+		return &errFieldMutualExclusive{
+			firstField:  "test_commands",
+			secondField: "test.buildspec",
+			mustExist:   true,
+		}
+	}
+	if len(s.TestCommands) != 0 && s.Test.Buildspec != "" {
+		return &errFieldMutualExclusive{
+			firstField:  "test_commands",
+			secondField: "test.buildspec",
+		}
+	}
+	if s.Test.ComputeType != "" && !contains(s.Test.ComputeType, validPipelineTestComputeTypes) {
+		return fmt.Errorf(`validate "compute_type": %q must be one of %s`, s.Test.ComputeType, english.WordSeries(validPipelineTestComputeTypes, "or"))
+	}
+	return nil
+}
+
+// validate returns nil if ApprovalConfig is configured correctly.
+func (a ApprovalConfig) validate() error {
+	if a.Topic != "" && len(a.Emails) != 0 {
+		return &errFieldMutualExclusive{
+			firstField:  "topic",
+			secondField: "emails",
+		}
+	}
+	if a.Topic != "" {
+		parsed, err := arn.Parse(a.Topic)
+		if err != nil || parsed.Service != "sns" {
+			return fmt.Errorf(`validate "topic": %q is not a valid SNS topic ARN`, a.Topic)
+		}
+	}
+	for _, email := range a.Emails {
+		if !strings.Contains(email, "@") {
+			return fmt.Errorf(`validate "emails": %q is not a valid email address`, email)
+		}
+	}
+	return nil
+}
+
 // validate returns nil if deployments are configured correctly.
 func (d Deployments) validate() error {
 	names := make(map[string]bool)
@@ -714,9 +1556,139 @@ func (i Image) validate() error {
 	if err = i.DependsOn.validate(); err != nil {
 		return fmt.Errorf(`validate "depends_on": %w`, err)
 	}
+	if i.Builder != nil && !contains(aws.StringValue(i.Builder), dockerengine.Builders) {
+		return fmt.Errorf(`validate "builder": invalid image builder %q, must be one of %s`,
+			aws.StringValue(i.Builder),
+			english.WordSeries(dockerengine.Builders, "or"))
+	}
+	if err = i.validatePlatforms(); err != nil {
+		return fmt.Errorf(`validate "platforms": %w`, err)
+	}
+	if err = i.Signing.validate(); err != nil {
+		return fmt.Errorf(`validate "signing": %w`, err)
+	}
+	if i.Signing.Enabled() && i.Location != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "signing",
+			secondField: "location",
+		}
+	}
+	if err = i.Scan.validate(); err != nil {
+		return fmt.Errorf(`validate "scan": %w`, err)
+	}
+	if !i.Scan.IsEmpty() && i.Location != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "scan",
+			secondField: "location",
+		}
+	}
+	if err = i.SBOM.validate(); err != nil {
+		return fmt.Errorf(`validate "sbom": %w`, err)
+	}
+	if !i.SBOM.IsEmpty() && i.Location != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "sbom",
+			secondField: "location",
+		}
+	}
 	return nil
 }
 
+// validate returns nil if ImageSBOM is configured correctly.
+func (s ImageSBOM) validate() error {
+	if s.IsEmpty() {
+		return nil
+	}
+	if !contains(aws.StringValue(s.Format), SBOMFormats) {
+		return fmt.Errorf(`invalid "format" %q, must be one of %s`,
+			aws.StringValue(s.Format),
+			english.WordSeries(SBOMFormats, "or"))
+	}
+	return nil
+}
+
+// validate returns nil if ImageScan is configured correctly.
+func (s ImageScan) validate() error {
+	if s.IsEmpty() {
+		return nil
+	}
+	if !contains(aws.StringValue(s.BlockOn), ImageScanSeverities) {
+		return fmt.Errorf(`invalid "block_on" severity %q, must be one of %s`,
+			aws.StringValue(s.BlockOn),
+			english.WordSeries(ImageScanSeverities, "or"))
+	}
+	return nil
+}
+
+// validate returns nil if ImageSigning is configured correctly.
+func (s ImageSigning) validate() error {
+	if s.IsEmpty() {
+		return nil
+	}
+	if s.KMSKey != nil && aws.BoolValue(s.Keyless) {
+		return &errFieldMutualExclusive{
+			firstField:  "kms_key",
+			secondField: "keyless",
+		}
+	}
+	if s.KMSKey != nil && aws.StringValue(s.KMSKey) == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "kms_key",
+		}
+	}
+	return nil
+}
+
+func (i Image) validatePlatforms() error {
+	if len(i.Platforms) == 0 {
+		return nil
+	}
+	if i.Location != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "platforms",
+			secondField: "location",
+		}
+	}
+	for _, platform := range i.Platforms {
+		if err := PlatformString(platform).validate(); err != nil {
+			return err
+		}
+		if osFamily, _, _ := strings.Cut(strings.ToLower(platform), "/"); osFamily != OSLinux {
+			return fmt.Errorf("platform %q is not supported: multi-platform images can only be built for %s", platform, OSLinux)
+		}
+	}
+	if len(i.Platforms) > 1 && i.GetBuilder() != dockerengine.BuilderBuildx {
+		return fmt.Errorf(`image builder %q can't build an image for multiple platforms, use %q instead`, i.GetBuilder(), dockerengine.BuilderBuildx)
+	}
+	return nil
+}
+
+// validateImagePlatforms returns nil if the image is built for the platform that the tasks of the workload run on.
+func validateImagePlatforms(image Image, platform PlatformArgsOrString) error {
+	if len(image.Platforms) == 0 {
+		return nil
+	}
+	osFamily, arch := OSLinux, ArchX86
+	if !platform.IsEmpty() {
+		osFamily, arch = platform.OS(), platform.Arch()
+	}
+	for _, p := range image.Platforms {
+		imageOS, imageArch, _ := strings.Cut(strings.ToLower(p), "/")
+		if imageOS == osFamily && normalizeArch(imageArch) == normalizeArch(arch) {
+			return nil
+		}
+	}
+	return fmt.Errorf(`"image.platforms" must include %q, the platform of the tasks`, platformString(osFamily, arch))
+}
+
+// normalizeArch returns the architecture with "amd64" and "x86_64" treated as the same.
+func normalizeArch(arch string) string {
+	if arch == ArchAMD64 {
+		return ArchX86
+	}
+	return arch
+}
+
 // validate returns nil if DependsOn is configured correctly.
 func (d DependsOn) validate() error {
 	if d == nil {
@@ -802,6 +1774,40 @@ func (r HTTP) validate() error {
 			return fmt.Errorf(`validate "additional_rules[%d]": %w`, idx, err)
 		}
 	}
+	for idx, rule := range r.StaticRules {
+		if err := rule.validate(); err != nil {
+			return fmt.Errorf(`validate "static_rules[%d]": %w`, idx, err)
+		}
+		if err := r.validateStaticRulePath(rule); err != nil {
+			return fmt.Errorf(`validate "static_rules[%d]": %w`, idx, err)
+		}
+	}
+	if err := r.TLS.validate(); err != nil {
+		return fmt.Errorf(`validate "tls": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if HTTPTLSConfig is configured correctly.
+func (c HTTPTLSConfig) validate() error {
+	if c.ClientAuth != nil && !contains(aws.StringValue(c.ClientAuth), httpClientAuthModes) {
+		return fmt.Errorf(`invalid "client_auth" %q, must be one of %s`, aws.StringValue(c.ClientAuth), english.WordSeries(httpClientAuthModes, "or"))
+	}
+	return nil
+}
+
+// validateStaticRulePath returns an error if a static rule shadows a routing rule of the service.
+// Rules for the root path are evaluated last, so a static rule can only share the root path with a routing rule.
+func (r HTTP) validateStaticRulePath(static StaticRule) error {
+	path := strings.TrimPrefix(aws.StringValue(static.Path), "/")
+	if path == "" {
+		return nil
+	}
+	for _, rule := range r.RoutingRules() {
+		if strings.TrimPrefix(aws.StringValue(rule.Path), "/") == path {
+			return fmt.Errorf(`"path" %q is already routed to the service: only the root path "/" can be shared with a routing rule`, aws.StringValue(static.Path))
+		}
+	}
 	return nil
 }
 
@@ -890,18 +1896,290 @@ func (r RoutingRule) validate() error {
 			return fmt.Errorf(`"version" field value '%s' must be one of %s`, *r.ProtocolVersion, english.WordSeries(httpProtocolVersions, "or"))
 		}
 	}
+	if r.IsGRPC() && r.RedirectToHTTPS != nil && !aws.BoolValue(r.RedirectToHTTPS) {
+		return fmt.Errorf(`"redirect_to_https" must be true if "version" is %s: the load balancer only supports gRPC over HTTPS`, GRPCProtocol)
+	}
+	if err := r.Auth.validate(); err != nil {
+		return fmt.Errorf(`validate "auth": %w`, err)
+	}
+	if !r.Auth.IsEmpty() && r.RedirectToHTTPS != nil && !aws.BoolValue(r.RedirectToHTTPS) {
+		// Requests over HTTP would reach the service without being authenticated.
+		return errors.New(`"redirect_to_https" must be true if "auth" is specified: the load balancer only authenticates users over HTTPS`)
+	}
 	if r.HostedZone != nil && r.Alias.IsEmpty() {
 		return &errFieldMustBeSpecified{
 			missingField:      "alias",
 			conditionalFields: []string{"hosted_zone"},
 		}
 	}
+	for idx, host := range r.Hosts {
+		if host == "" {
+			return fmt.Errorf(`"hosts[%d]" cannot be empty`, idx)
+		}
+	}
+	if len(r.WeightedTargets) == 0 && r.Weight != nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "weighted_targets",
+			conditionalFields: []string{"weight"},
+		}
+	}
+	if len(r.WeightedTargets) != 0 {
+		if err := validateTargetGroupWeight(r.Weight); err != nil {
+			return err
+		}
+		if len(r.WeightedTargets) > maxWeightedTargetsPerRule {
+			return fmt.Errorf(`"weighted_targets" cannot have more than %d targets`, maxWeightedTargetsPerRule)
+		}
+	}
+	for idx, target := range r.WeightedTargets {
+		if err := target.validate(); err != nil {
+			return fmt.Errorf(`validate "weighted_targets[%d]": %w`, idx, err)
+		}
+	}
 	if err := r.validateConditionValuesPerRule(); err != nil {
 		return fmt.Errorf("validate condition values per listener rule: %w", err)
 	}
 	return nil
 }
 
+// validate returns nil if HTTPAuth is configured correctly.
+func (a HTTPAuth) validate() error {
+	if a.IsEmpty() {
+		return nil
+	}
+	if a.OIDC.IsEmpty() == a.Cognito.IsEmpty() {
+		return &errFieldMutualExclusive{
+			firstField:  "oidc",
+			secondField: "cognito",
+			mustExist:   true,
+		}
+	}
+	if err := a.OIDC.validate(); err != nil {
+		return fmt.Errorf(`validate "oidc": %w`, err)
+	}
+	if err := a.Cognito.validate(); err != nil {
+		return fmt.Errorf(`validate "cognito": %w`, err)
+	}
+	if a.SessionTimeout != nil {
+		if timeout := *a.SessionTimeout; timeout < time.Second || timeout > maxALBAuthSessionTimeout {
+			return fmt.Errorf(`"session_timeout" %s must be between 1s and %s`, timeout, maxALBAuthSessionTimeout)
+		}
+	}
+	return nil
+}
+
+// validate returns nil if OIDCAuth is configured correctly.
+func (a OIDCAuth) validate() error {
+	if a.IsEmpty() {
+		return nil
+	}
+	required := []struct {
+		name  string
+		value *string
+	}{
+		{"issuer", a.Issuer},
+		{"authorization_endpoint", a.AuthorizationEndpoint},
+		{"token_endpoint", a.TokenEndpoint},
+		{"user_info_endpoint", a.UserInfoEndpoint},
+		{"client_id", a.ClientID},
+		{"client_secret", a.ClientSecret},
+	}
+	for _, field := range required {
+		if field.value == nil {
+			return &errFieldMustBeSpecified{
+				missingField: field.name,
+			}
+		}
+	}
+	for _, endpoint := range required[:4] {
+		if !strings.HasPrefix(aws.StringValue(endpoint.value), "https://") {
+			return fmt.Errorf(`%q %q must be an HTTPS URL`, endpoint.name, aws.StringValue(endpoint.value))
+		}
+	}
+	return nil
+}
+
+// validate returns nil if CognitoAuth is configured correctly.
+func (a CognitoAuth) validate() error {
+	if a.IsEmpty() {
+		return nil
+	}
+	if a.UserPool == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "user_pool",
+		}
+	}
+	if parsed, err := arn.Parse(aws.StringValue(a.UserPool)); err != nil || parsed.Service != "cognito-idp" {
+		return fmt.Errorf(`"user_pool" %q must be the ARN of a Cognito user pool`, aws.StringValue(a.UserPool))
+	}
+	if a.ClientID == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "client_id",
+		}
+	}
+	if a.Domain == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "domain",
+		}
+	}
+	return nil
+}
+
+// validate returns nil if WeightedTarget is configured correctly.
+func (t WeightedTarget) validate() error {
+	if t.TargetContainer == nil && t.TargetPort == nil {
+		return &errAtLeastOneFieldMustBeSpecified{
+			missingFields: []string{"target_container", "target_port"},
+		}
+	}
+	return validateTargetGroupWeight(t.Weight)
+}
+
+func validateTargetGroupWeight(weight *int) error {
+	if weight == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "weight",
+			conditionalFields: []string{"weighted_targets"},
+		}
+	}
+	if w := aws.IntValue(weight); w < 0 || w > maxTargetGroupWeight {
+		return fmt.Errorf(`"weight" %d must be between 0 and %d`, w, maxTargetGroupWeight)
+	}
+	return nil
+}
+
+// validate returns nil if StaticRule is configured correctly.
+func (r StaticRule) validate() error {
+	if r.Path == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "path",
+		}
+	}
+	for idx, host := range r.Hosts {
+		if host == "" {
+			return fmt.Errorf(`"hosts[%d]" cannot be empty`, idx)
+		}
+	}
+	if r.Redirect.IsEmpty() == r.FixedResponse.IsEmpty() {
+		return &errFieldMutualExclusive{
+			firstField:  "redirect",
+			secondField: "fixed_response",
+			mustExist:   true,
+		}
+	}
+	if err := r.Redirect.validate(); err != nil {
+		return fmt.Errorf(`validate "redirect": %w`, err)
+	}
+	if err := r.FixedResponse.validate(); err != nil {
+		return fmt.Errorf(`validate "fixed_response": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if HTTPRedirect is configured correctly.
+func (r HTTPRedirect) validate() error {
+	if r.IsEmpty() {
+		return nil
+	}
+	if r.Protocol == nil && r.Host == nil && r.Port == nil && r.Path == nil && r.Query == nil {
+		// A redirect to the same URL would loop forever.
+		return &errAtLeastOneFieldMustBeSpecified{
+			missingFields: []string{"protocol", "host", "port", "path", "query"},
+		}
+	}
+	if r.Protocol != nil && !contains(strings.ToUpper(aws.StringValue(r.Protocol)), httpRedirectProtocols) {
+		return fmt.Errorf(`invalid "protocol" %q, must be one of %s`, aws.StringValue(r.Protocol), english.WordSeries(httpRedirectProtocols, "or"))
+	}
+	if r.Path != nil && !strings.HasPrefix(aws.StringValue(r.Path), "/") {
+		return fmt.Errorf(`"path" %q must start with "/"`, aws.StringValue(r.Path))
+	}
+	if r.StatusCode != nil && !contains(strconv.Itoa(aws.IntValue(r.StatusCode)), httpRedirectStatusCodes) {
+		return fmt.Errorf(`invalid "status_code" %d, must be one of %s`, aws.IntValue(r.StatusCode), english.WordSeries(httpRedirectStatusCodes, "or"))
+	}
+	return nil
+}
+
+// validate returns nil if HTTPFixedResponse is configured correctly.
+func (r HTTPFixedResponse) validate() error {
+	if r.IsEmpty() {
+		return nil
+	}
+	if r.StatusCode == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "status_code",
+		}
+	}
+	if code := aws.IntValue(r.StatusCode); code < 200 || code > 599 || (code >= 300 && code < 400) {
+		return fmt.Errorf(`invalid "status_code" %d, must be a 2XX, 4XX or 5XX status code`, code)
+	}
+	if r.ContentType != nil && !contains(aws.StringValue(r.ContentType), httpFixedResponseContentTypes) {
+		return fmt.Errorf(`invalid "content_type" %q, must be one of %s`, aws.StringValue(r.ContentType), english.WordSeries(httpFixedResponseContentTypes, "or"))
+	}
+	if len(aws.StringValue(r.Body)) > maxFixedResponseBodyLen {
+		return fmt.Errorf(`"body" cannot be longer than %d characters`, maxFixedResponseBodyLen)
+	}
+	return nil
+}
+
+// validate returns nil if WAF is configured correctly for a regional resource such as a load balancer.
+func (w WAF) validate() error {
+	if w.IsZero() {
+		return nil
+	}
+	if w.IsBasic() {
+		return validateWebACLARN(w.Basic, "regional")
+	}
+	if len(w.Advanced.ManagedRules) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "managed_rules",
+		}
+	}
+	seen := make(map[string]struct{})
+	for idx, rule := range w.Advanced.ManagedRules {
+		if err := rule.validate(); err != nil {
+			return fmt.Errorf(`validate "managed_rules[%d]": %w`, idx, err)
+		}
+		// The rule groups are named after the managed rule group in the web ACL, and names must be unique.
+		if _, ok := seen[aws.StringValue(rule.Name)]; ok {
+			return fmt.Errorf(`validate "managed_rules[%d]": rule group %q is specified more than once`, idx, aws.StringValue(rule.Name))
+		}
+		seen[aws.StringValue(rule.Name)] = struct{}{}
+	}
+	return nil
+}
+
+// validate is a no-op for WAFManagedRules, its rule groups are validated together with the rest of WAF.
+func (WAFManagedRules) validate() error {
+	return nil
+}
+
+// validate returns nil if WAFManagedRule is configured correctly.
+func (r WAFManagedRule) validate() error {
+	if r.Name == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "name",
+		}
+	}
+	for _, path := range r.Paths {
+		if !strings.HasPrefix(path, "/") {
+			return fmt.Errorf(`"paths" %q must start with "/"`, path)
+		}
+	}
+	return nil
+}
+
+// validateWebACLARN returns nil if in is the ARN of an AWS WAF web ACL with the given scope, "regional" or "global".
+func validateWebACLARN(in, scope string) error {
+	parsed, err := arn.Parse(in)
+	if err != nil {
+		return fmt.Errorf(`parse web ACL ARN %q: %w`, in, err)
+	}
+	if parsed.Service != "wafv2" || !strings.HasPrefix(parsed.Resource, scope+"/webacl/") {
+		return fmt.Errorf(`%q is not the ARN of a %s AWS WAF web ACL`, in, scope)
+	}
+	return nil
+}
+
 // validate returns nil if HTTPHealthCheckArgs is configured correctly.
 func (h HTTPHealthCheckArgs) validate() error {
 	return nil
@@ -1042,12 +2320,79 @@ func (t TaskConfig) validate() error {
 			return fmt.Errorf(`validate "secret": %w`, err)
 		}
 	}
+	if err = validateSecretFiles(t.Secrets); err != nil {
+		return fmt.Errorf(`validate "secrets": %w`, err)
+	}
+	if t.IsWindows() {
+		for name, secret := range t.Secrets {
+			if secret.IsFile() {
+				return fmt.Errorf(`"as_file" is not supported for secret %s on Windows`, name)
+			}
+		}
+	}
 	if t.EnvFile != nil {
 		envFile := aws.StringValue(t.EnvFile)
 		if filepath.Ext(envFile) != envFileExt {
 			return fmt.Errorf("environment file %s must have a %s file extension", envFile, envFileExt)
 		}
 	}
+	if t.VariablesFromEnvFile != nil {
+		envFile := aws.StringValue(t.VariablesFromEnvFile)
+		if filepath.Ext(envFile) != envFileExt {
+			return fmt.Errorf(`validate "variables_from_env_file": environment file %s must have a %s file extension`, envFile, envFileExt)
+		}
+	}
+	if err = validateIAMRoleARN(t.TaskRole); err != nil {
+		return fmt.Errorf(`validate "task_role": %w`, err)
+	}
+	if err = validateIAMRoleARN(t.ExecutionRole); err != nil {
+		return fmt.Errorf(`validate "execution_role": %w`, err)
+	}
+	if err = validatePermissionsBoundary(t.PermissionsBoundary); err != nil {
+		return fmt.Errorf(`validate "permissions_boundary": %w`, err)
+	}
+	if t.Compute != nil && !contains(aws.StringValue(t.Compute), computeOptions) {
+		return fmt.Errorf(`invalid compute %q, must be one of %s`,
+			aws.StringValue(t.Compute),
+			english.WordSeries(computeOptions, "or"))
+	}
+	if t.GPU != nil {
+		if aws.IntValue(t.GPU) <= 0 {
+			return errors.New(`"gpu" must be greater than 0`)
+		}
+		if !t.OnEC2() {
+			return fmt.Errorf(`"gpu" requires "compute" to be %q`, ComputeEC2)
+		}
+	}
+	return nil
+}
+
+func validateIAMRoleARN(role *string) error {
+	if role == nil {
+		return nil
+	}
+	parsed, err := arn.Parse(aws.StringValue(role))
+	if err != nil || parsed.Service != "iam" || !strings.HasPrefix(parsed.Resource, "role/") {
+		return fmt.Errorf("%q is not a valid IAM role ARN", aws.StringValue(role))
+	}
+	return nil
+}
+
+func validatePermissionsBoundary(policy *string) error {
+	if policy == nil {
+		return nil
+	}
+	name := aws.StringValue(policy)
+	if name == "" {
+		return errors.New("policy name cannot be empty")
+	}
+	if !arn.IsARN(name) {
+		return nil
+	}
+	parsed, err := arn.Parse(name)
+	if err != nil || parsed.Service != "iam" || !strings.HasPrefix(parsed.Resource, "policy/") {
+		return fmt.Errorf("%q is not a valid IAM policy ARN", name)
+	}
 	return nil
 }
 
@@ -1132,6 +2477,9 @@ func (a AdvancedCount) validate() error {
 	if err := a.Range.validate(); err != nil {
 		return fmt.Errorf(`validate "range": %w`, err)
 	}
+	if err := a.validateCapacity(); err != nil {
+		return err
+	}
 
 	// validate combinations with "range".
 	if a.Range.IsEmpty() && a.hasScalingFieldsSet() {
@@ -1155,6 +2503,15 @@ func (a AdvancedCount) validate() error {
 		}
 	}
 
+	// Target tracking policies can't add tasks once the service has none running, so another policy has to.
+	if a.CanScaleToZero() && len(a.StepScaling) == 0 && len(a.Schedules) == 0 && a.QueueScaling.IsEmpty() {
+		fields := []string{"step_scaling", "schedules"}
+		if a.workloadType == manifestinfo.WorkerServiceType {
+			fields = []string{"queue_delay", "step_scaling", "schedules"}
+		}
+		return fmt.Errorf(`must specify at least one of %s to scale out from zero tasks if the min of "range" is 0`, english.WordSeries(quoteStringSlice(fields), "or"))
+	}
+
 	// validate individual custom autoscaling options.
 	if err := a.QueueScaling.validate(); err != nil {
 		return fmt.Errorf(`validate "queue_delay": %w`, err)
@@ -1165,7 +2522,169 @@ func (a AdvancedCount) validate() error {
 	if err := a.Memory.validate(); err != nil {
 		return fmt.Errorf(`validate "memory_percentage": %w`, err)
 	}
+	for idx, metric := range a.Metrics {
+		if err := metric.validate(); err != nil {
+			return fmt.Errorf(`validate "metrics[%d]": %w`, idx, err)
+		}
+	}
+	for idx, policy := range a.StepScaling {
+		if err := policy.validate(); err != nil {
+			return fmt.Errorf(`validate "step_scaling[%d]": %w`, idx, err)
+		}
+	}
+	names := make(map[string]bool)
+	for idx, schedule := range a.Schedules {
+		if err := schedule.validate(); err != nil {
+			return fmt.Errorf(`validate "schedules[%d]": %w`, idx, err)
+		}
+		if name := aws.StringValue(schedule.Name); name != "" {
+			if names[name] {
+				return fmt.Errorf(`validate "schedules[%d]": name %q is used by another schedule`, idx, name)
+			}
+			names[name] = true
+		}
+	}
+
+	return nil
+}
+
+// validate returns nil if CloudWatchMetric is configured correctly.
+func (m CloudWatchMetric) validate() error {
+	if m.Namespace == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "namespace",
+		}
+	}
+	if m.Name == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "name",
+		}
+	}
+	if m.Statistic != nil && !contains(aws.StringValue(m.Statistic), cloudWatchStatistics) {
+		return fmt.Errorf(`invalid statistic %q, must be one of %s`, aws.StringValue(m.Statistic), english.WordSeries(cloudWatchStatistics, "or"))
+	}
+	return nil
+}
+
+// validate returns nil if CustomMetricScaling is configured correctly.
+func (c CustomMetricScaling) validate() error {
+	if err := c.CloudWatchMetric.validate(); err != nil {
+		return err
+	}
+	if c.Target == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "target",
+		}
+	}
+	if aws.Float64Value(c.Target) <= 0 {
+		return errors.New(`"target" must be greater than 0`)
+	}
+	return nil
+}
+
+// validate returns nil if StepScaling is configured correctly.
+func (s StepScaling) validate() error {
+	if err := s.CloudWatchMetric.validate(); err != nil {
+		return err
+	}
+	if s.Period != nil {
+		// CloudWatch alarms only evaluate metrics over periods of 10 seconds, 30 seconds or a multiple of 60 seconds.
+		if period := *s.Period; period != 10*time.Second && period != 30*time.Second && (period <= 0 || period%time.Minute != 0) {
+			return fmt.Errorf(`"period" %v must be 10s, 30s or a multiple of 60s`, period)
+		}
+	}
+	if s.EvaluationPeriods != nil && aws.IntValue(s.EvaluationPeriods) < 1 {
+		return errors.New(`"evaluation_periods" must be at least 1`)
+	}
+	if len(s.Steps) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "steps",
+		}
+	}
+	for idx, step := range s.Steps {
+		if err := step.validate(); err != nil {
+			return fmt.Errorf(`validate "steps[%d]": %w`, idx, err)
+		}
+	}
+	// Application Auto Scaling rejects step adjustments that overlap or leave gaps between them,
+	// and the steps must cover every value that breaches the threshold of the alarm.
+	out := s.ScaleOutSteps()
+	for idx := 0; idx < len(out)-1; idx++ {
+		if out[idx].Below == nil || aws.Float64Value(out[idx].Below) != aws.Float64Value(out[idx+1].Above) {
+			return fmt.Errorf(`scale-out step above %v must end where the next step starts at %v`, aws.Float64Value(out[idx].Above), aws.Float64Value(out[idx+1].Above))
+		}
+	}
+	if len(out) > 0 && out[len(out)-1].Below != nil {
+		return errors.New(`the highest scale-out step cannot specify "below"`)
+	}
+	in := s.ScaleInSteps()
+	for idx := 0; idx < len(in)-1; idx++ {
+		if in[idx].Above == nil || aws.Float64Value(in[idx].Above) != aws.Float64Value(in[idx+1].Below) {
+			return fmt.Errorf(`scale-in step below %v must end where the next step starts at %v`, aws.Float64Value(in[idx].Below), aws.Float64Value(in[idx+1].Below))
+		}
+	}
+	if len(in) > 0 && in[len(in)-1].Above != nil {
+		return errors.New(`the lowest scale-in step cannot specify "above"`)
+	}
+	if len(out) > 0 && len(in) > 0 && aws.Float64Value(in[0].Below) > aws.Float64Value(out[0].Above) {
+		return fmt.Errorf(`scale-in steps below %v cannot overlap with scale-out steps above %v`, aws.Float64Value(in[0].Below), aws.Float64Value(out[0].Above))
+	}
+	return nil
+}
+
+// validate returns nil if ScalingStep is configured correctly.
+func (s ScalingStep) validate() error {
+	if s.Change == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "change",
+		}
+	}
+	change := aws.IntValue(s.Change)
+	if change == 0 {
+		return errors.New(`"change" cannot be 0`)
+	}
+	if change > 0 && s.Above == nil {
+		return errors.New(`"above" must be specified for a step with a positive "change"`)
+	}
+	if change < 0 && s.Below == nil {
+		return errors.New(`"below" must be specified for a step with a negative "change"`)
+	}
+	if s.Above != nil && s.Below != nil && aws.Float64Value(s.Above) >= aws.Float64Value(s.Below) {
+		return fmt.Errorf(`"above" value %v must be less than "below" value %v`, aws.Float64Value(s.Above), aws.Float64Value(s.Below))
+	}
+	return nil
+}
 
+// validate returns nil if ScheduledScaling is configured correctly.
+func (s ScheduledScaling) validate() error {
+	if s.Schedule == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "schedule",
+		}
+	}
+	if aws.StringValue(s.Schedule) == "none" {
+		return errors.New(`"schedule" cannot be "none"`)
+	}
+	if s.Min == nil && s.Max == nil {
+		return &errAtLeastOneFieldMustBeSpecified{
+			missingFields:    []string{"min", "max"},
+			conditionalField: "schedule",
+		}
+	}
+	if s.Timezone != nil {
+		if _, err := time.LoadLocation(aws.StringValue(s.Timezone)); err != nil {
+			return fmt.Errorf(`validate "timezone": %q is not a valid IANA timezone`, aws.StringValue(s.Timezone))
+		}
+	}
+	if aws.IntValue(s.Min) < 0 || aws.IntValue(s.Max) < 0 {
+		return errors.New(`"min" and "max" cannot be negative`)
+	}
+	if s.Min != nil && s.Max != nil && aws.IntValue(s.Min) > aws.IntValue(s.Max) {
+		return &errMinGreaterThanMax{
+			min: aws.IntValue(s.Min),
+			max: aws.IntValue(s.Max),
+		}
+	}
 	return nil
 }
 
@@ -1207,6 +2726,46 @@ func (r AdvancedScalingConfig[_]) validate() error {
 	return r.Cooldown.validate()
 }
 
+func (a AdvancedCount) validateCapacity() error {
+	if a.Capacity.IsEmpty() {
+		return nil
+	}
+	if a.Spot != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "spot",
+			secondField: "capacity",
+		}
+	}
+	if a.Range.RangeConfig.SpotFrom != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "range.spot_from",
+			secondField: "capacity",
+		}
+	}
+	if a.Range.IsEmpty() {
+		return &errFieldMustBeSpecified{
+			missingField:      "range",
+			conditionalFields: []string{"capacity"},
+		}
+	}
+	if err := a.Capacity.validate(); err != nil {
+		return fmt.Errorf(`validate "capacity": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if CapacityStrategy is configured correctly.
+func (c CapacityStrategy) validate() error {
+	base, onDemand, spot := aws.IntValue(c.OnDemandBase), aws.IntValue(c.OnDemandWeight), aws.IntValue(c.SpotWeight)
+	if base < 0 || onDemand < 0 || spot < 0 {
+		return fmt.Errorf("on_demand_base value %d, on_demand_weight value %d, and spot_weight value %d must all be positive", base, onDemand, spot)
+	}
+	if (c.OnDemandWeight != nil || c.SpotWeight != nil) && onDemand == 0 && spot == 0 {
+		return errors.New(`at least one of "on_demand_weight" and "spot_weight" must be greater than 0`)
+	}
+	return nil
+}
+
 // Validation is a no-op for Cooldown.
 func (c Cooldown) validate() error {
 	return nil
@@ -1447,6 +3006,121 @@ func (l Logging) validate() error {
 			return fmt.Errorf("environment file %s must have a %s file extension", envFile, envFileExt)
 		}
 	}
+	if l.Parser != nil {
+		if aws.StringValue(l.Parser) != LogParserJSON {
+			return fmt.Errorf(`invalid "parser" %s: the only valid parser is %s`, aws.StringValue(l.Parser), LogParserJSON)
+		}
+		if l.ConfigFile != nil {
+			return &errFieldMutualExclusive{
+				firstField:  "parser",
+				secondField: "configFilePath",
+			}
+		}
+	}
+	if err := l.Destination.validate(); err != nil {
+		return fmt.Errorf(`validate "destination": %w`, err)
+	}
+	if err := validateNoSecretFiles(l.Secrets); err != nil {
+		return fmt.Errorf(`validate "secrets": %w`, err)
+	}
+	if err := validateNoSecretFiles(l.SecretOptions); err != nil {
+		return fmt.Errorf(`validate "secretOptions": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if LogDestinationConfig is configured correctly.
+func (d LogDestinationConfig) validate() error {
+	var configured []string
+	if !d.Datadog.IsEmpty() {
+		configured = append(configured, "datadog")
+	}
+	if !d.Splunk.IsEmpty() {
+		configured = append(configured, "splunk")
+	}
+	if !d.OpenSearch.IsEmpty() {
+		configured = append(configured, "opensearch")
+	}
+	if !d.Firehose.IsEmpty() {
+		configured = append(configured, "firehose")
+	}
+	if len(configured) > 1 {
+		return fmt.Errorf("must specify only one destination, but %s are specified", english.WordSeries(quoteStringSlice(configured), "and"))
+	}
+	if err := d.Datadog.validate(); err != nil {
+		return fmt.Errorf(`validate "datadog": %w`, err)
+	}
+	if err := d.Splunk.validate(); err != nil {
+		return fmt.Errorf(`validate "splunk": %w`, err)
+	}
+	if err := d.OpenSearch.validate(); err != nil {
+		return fmt.Errorf(`validate "opensearch": %w`, err)
+	}
+	if err := d.Firehose.validate(); err != nil {
+		return fmt.Errorf(`validate "firehose": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if DatadogLogDestination is configured correctly.
+func (d DatadogLogDestination) validate() error {
+	if d.IsEmpty() {
+		return nil
+	}
+	if d.APIKey == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "api_key",
+		}
+	}
+	return d.APIKey.validate()
+}
+
+// validate returns nil if SplunkLogDestination is configured correctly.
+func (s SplunkLogDestination) validate() error {
+	if s.IsEmpty() {
+		return nil
+	}
+	if s.Host == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "host",
+		}
+	}
+	if s.Token == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "token",
+		}
+	}
+	return s.Token.validate()
+}
+
+// validate returns nil if OpenSearchLogDestination is configured correctly.
+func (o OpenSearchLogDestination) validate() error {
+	if o.IsEmpty() {
+		return nil
+	}
+	if o.Host == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "host",
+		}
+	}
+	if o.Index == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "index",
+		}
+	}
+	return nil
+}
+
+// validate returns nil if FirehoseLogDestination is configured correctly.
+func (f FirehoseLogDestination) validate() error {
+	if f.IsEmpty() {
+		return nil
+	}
+	if f.DeliveryStream == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "delivery_stream",
+		}
+	}
 	return nil
 }
 
@@ -1483,14 +3157,73 @@ func (s SidecarConfig) validate() error {
 	if err := s.DependsOn.validate(); err != nil {
 		return fmt.Errorf(`validate "depends_on": %w`, err)
 	}
+	for n, v := range s.Variables {
+		if err := v.validate(); err != nil {
+			return fmt.Errorf(`validate %q "variables": %w`, n, err)
+		}
+	}
+	for _, v := range s.Secrets {
+		if err := v.validate(); err != nil {
+			return fmt.Errorf(`validate "secret": %w`, err)
+		}
+	}
+	if err := validateSecretFiles(s.Secrets); err != nil {
+		return fmt.Errorf(`validate "secrets": %w`, err)
+	}
+	if s.CPU != nil && aws.IntValue(s.CPU) <= 0 {
+		return errors.New(`"cpu" must be greater than 0`)
+	}
+	if s.MemoryReservation != nil && aws.IntValue(s.MemoryReservation) <= 0 {
+		return errors.New(`"memory_reservation" must be greater than 0`)
+	}
 	if s.EnvFile != nil {
 		envFile := aws.StringValue(s.EnvFile)
 		if filepath.Ext(envFile) != envFileExt {
 			return fmt.Errorf("environment file %s must have a %s file extension", envFile, envFileExt)
 		}
 	}
+	if s.VariablesFromEnvFile != nil {
+		envFile := aws.StringValue(s.VariablesFromEnvFile)
+		if filepath.Ext(envFile) != envFileExt {
+			return fmt.Errorf(`validate "variables_from_env_file": environment file %s must have a %s file extension`, envFile, envFileExt)
+		}
+	}
 	return s.ImageOverride.validate()
 }
+
+// validateSidecars validates each sidecar against the task, then that the CPU and memory they reserve together fit in the task.
+// The main container gets the rest of the task's CPU and memory.
+func validateSidecars(sidecars map[string]*SidecarConfig, task TaskConfig) error {
+	names := make([]string, 0, len(sidecars))
+	for name := range sidecars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var cpu, memory int
+	for _, name := range names {
+		sidecar := sidecars[name]
+		if err := sidecar.validate(); err != nil {
+			return fmt.Errorf(`validate "sidecars[%s]": %w`, name, err)
+		}
+		if task.IsWindows() {
+			for secretName, secret := range sidecar.Secrets {
+				if secret.IsFile() {
+					return fmt.Errorf(`validate "sidecars[%s]": "as_file" is not supported for secret %s on Windows`, name, secretName)
+				}
+			}
+		}
+		cpu += aws.IntValue(sidecar.CPU)
+		memory += aws.IntValue(sidecar.MemoryReservation)
+	}
+	if task.CPU != nil && cpu > aws.IntValue(task.CPU) {
+		return fmt.Errorf(`sidecars reserve %d CPU units in total, more than the %d of "cpu"`, cpu, aws.IntValue(task.CPU))
+	}
+	if task.Memory != nil && memory > aws.IntValue(task.Memory) {
+		return fmt.Errorf(`sidecars reserve %d MiB of memory in total, more than the %d of "memory"`, memory, aws.IntValue(task.Memory))
+	}
+	return nil
+}
+
 func (s SidecarConfig) validateImage() error {
 	if s.Image.IsZero() {
 		return fmt.Errorf(`must specify one of "image", "image.build, or "image.location"`)
@@ -1513,11 +3246,10 @@ func (s SidecarMountPoint) validate() error {
 
 // validate returns nil if NetworkConfig is configured correctly.
 func (n NetworkConfig) validate() error {
-	if n.IsEmpty() {
-		return nil
-	}
-	if err := n.VPC.validate(); err != nil {
-		return fmt.Errorf(`validate "vpc": %w`, err)
+	if !n.IsEmpty() {
+		if err := n.VPC.validate(); err != nil {
+			return fmt.Errorf(`validate "vpc": %w`, err)
+		}
 	}
 	if err := n.Connect.validate(); err != nil {
 		return fmt.Errorf(`validate "connect": %w`, err)
@@ -1530,8 +3262,43 @@ func (s ServiceConnectBoolOrArgs) validate() error {
 	return s.ServiceConnectArgs.validate()
 }
 
-// validate is a no-op for ServiceConnectArgs.
-func (ServiceConnectArgs) validate() error {
+// validate returns nil if ServiceConnectArgs is configured correctly.
+func (s ServiceConnectArgs) validate() error {
+	ports := make(map[uint16]int)
+	for idx, port := range s.Ports {
+		if err := port.validate(); err != nil {
+			return fmt.Errorf(`validate "ports[%d]": %w`, idx, err)
+		}
+		if prev, ok := ports[aws.Uint16Value(port.Port)]; ok {
+			return fmt.Errorf(`"ports[%d]" and "ports[%d]" both expose port %d`, prev, idx, aws.Uint16Value(port.Port))
+		}
+		ports[aws.Uint16Value(port.Port)] = idx
+	}
+	return nil
+}
+
+// validate returns nil if ServiceConnectPort is configured correctly.
+func (p ServiceConnectPort) validate() error {
+	if p.Port == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "port",
+		}
+	}
+	if p.AppProtocol != nil && !contains(aws.StringValue(p.AppProtocol), serviceConnectAppProtocols) {
+		return fmt.Errorf(`invalid "app_protocol" %q, must be one of %s`, aws.StringValue(p.AppProtocol), english.WordSeries(serviceConnectAppProtocols, "or"))
+	}
+	if p.HealthCheckCommand() == "" {
+		return nil
+	}
+	if p.AppProtocol == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "app_protocol",
+			conditionalFields: []string{"healthcheck"},
+		}
+	}
+	if p.HealthCheck.Advanced.Path != nil && aws.StringValue(p.AppProtocol) == ServiceConnectAppProtocolGRPC {
+		return fmt.Errorf(`"healthcheck.path" can't be used with the %q application protocol`, ServiceConnectAppProtocolGRPC)
+	}
 	return nil
 }
 
@@ -1626,12 +3393,69 @@ func (s SecurityGroupsIDsOrConfig) validate() error {
 	if s.isEmpty() {
 		return nil
 	}
-	return s.AdvancedConfig.validate()
+	return s.AdvancedConfig.validate()
+}
+
+// validate returns nil if SecurityGroupsConfig is configured correctly.
+func (s SecurityGroupsConfig) validate() error {
+	for idx, rule := range s.Ingress {
+		if err := rule.validate(); err != nil {
+			return fmt.Errorf(`validate "ingress[%d]": %w`, idx, err)
+		}
+	}
+	for i := range s.Ingress {
+		for j := i + 1; j < len(s.Ingress); j++ {
+			if s.Ingress[i].overlaps(s.Ingress[j]) {
+				return fmt.Errorf(`"ingress[%d]" overlaps with "ingress[%d]"`, j, i)
+			}
+		}
+	}
+	return nil
+}
+
+// validate returns nil if SecurityGroupIngressRule is configured correctly.
+func (r SecurityGroupIngressRule) validate() error {
+	if r.SourceSecurityGroup.isEmpty() == (r.CIDR == nil) {
+		return &errFieldMutualExclusive{
+			firstField:  "source_security_group",
+			secondField: "cidr",
+			mustExist:   true,
+		}
+	}
+	if r.CIDR != nil {
+		if _, _, err := net.ParseCIDR(aws.StringValue(r.CIDR)); err != nil {
+			return fmt.Errorf(`parse "cidr": %w`, err)
+		}
+	}
+	if name := aws.StringValue(r.SourceSecurityGroup.FromCFN.Name); strings.HasSuffix(name, envSecurityGroupExportSuffix) {
+		return fmt.Errorf(`"source_security_group" %q is the environment security group, whose traffic Copilot already allows`, name)
+	}
+	if err := r.Ports.validate(); err != nil {
+		return err
+	}
+	if r.IPProtocol != nil && !contains(aws.StringValue(r.IPProtocol), ingressProtocols) {
+		return fmt.Errorf(`"ip_protocol" %q must be one of %s`, aws.StringValue(r.IPProtocol), english.WordSeries(quoteStringSlice(ingressProtocols), "or"))
+	}
+	return nil
 }
 
-// validate is a no-op for SecurityGroupsConfig.
-func (SecurityGroupsConfig) validate() error {
-	return nil
+// overlaps returns true if both rules allow the same protocol from the same source on at least one common port.
+func (r SecurityGroupIngressRule) overlaps(other SecurityGroupIngressRule) bool {
+	if r.Protocol() != other.Protocol() {
+		return false
+	}
+	if !reflect.DeepEqual(r.SourceSecurityGroup, other.SourceSecurityGroup) || aws.StringValue(r.CIDR) != aws.StringValue(other.CIDR) {
+		return false
+	}
+	from, to, err := r.GetPorts()
+	if err != nil {
+		return false
+	}
+	otherFrom, otherTo, err := other.GetPorts()
+	if err != nil {
+		return false
+	}
+	return from <= otherTo && otherFrom <= to
 }
 
 // validate returns nil if AppRunnerInstanceConfig is configured correctly.
@@ -1657,6 +3481,9 @@ func (r RequestDrivenWebServiceHttpConfig) validate() error {
 	if err := r.HealthCheckConfiguration.validate(); err != nil {
 		return err
 	}
+	if err := r.WAF.validate(); err != nil {
+		return fmt.Errorf(`validate "waf": %w`, err)
+	}
 	return r.Private.validate()
 }
 
@@ -1664,11 +3491,43 @@ func (v VPCEndpoint) validate() error {
 	return nil
 }
 
+// validate returns nil if AppRunnerAutoScaling is configured correctly.
+func (a AppRunnerAutoScaling) validate() error {
+	if a.MaxConcurrency != nil && (aws.IntValue(a.MaxConcurrency) < 1 || aws.IntValue(a.MaxConcurrency) > 200) {
+		return fmt.Errorf(`"max_concurrency" must be between 1 and 200`)
+	}
+	if a.MinInstances != nil && (aws.IntValue(a.MinInstances) < 1 || aws.IntValue(a.MinInstances) > 25) {
+		return fmt.Errorf(`"min" must be between 1 and 25`)
+	}
+	if a.MaxInstances != nil && aws.IntValue(a.MaxInstances) < 1 {
+		return fmt.Errorf(`"max" must be at least 1`)
+	}
+	if a.MinInstances != nil && a.MaxInstances != nil && aws.IntValue(a.MinInstances) > aws.IntValue(a.MaxInstances) {
+		return &errMinGreaterThanMax{
+			min: aws.IntValue(a.MinInstances),
+			max: aws.IntValue(a.MaxInstances),
+		}
+	}
+	return nil
+}
+
 // validate returns nil if Observability is configured correctly.
 func (o Observability) validate() error {
 	if o.isEmpty() {
 		return nil
 	}
+	if o.Tracing != nil && !o.Collector.IsEmpty() {
+		return &errFieldMutualExclusive{
+			firstField:  "tracing",
+			secondField: "collector",
+		}
+	}
+	if err := o.Collector.validate(); err != nil {
+		return fmt.Errorf(`validate "collector": %w`, err)
+	}
+	if o.Tracing == nil {
+		return nil
+	}
 	for _, validVendor := range tracingValidVendors {
 		if strings.EqualFold(aws.StringValue(o.Tracing), validVendor) {
 			return nil
@@ -1680,13 +3539,95 @@ func (o Observability) validate() error {
 		english.WordSeries(tracingValidVendors, "and"))
 }
 
+// validate returns nil if OTelCollectorConfig is configured correctly.
+func (c OTelCollectorConfig) validate() error {
+	if c.IsEmpty() {
+		return nil
+	}
+	if c.Config != nil {
+		if c.Traces != nil || c.Metrics != nil || c.OTLPEndpoint != nil {
+			return fmt.Errorf(`"config" cannot be specified with "traces", "metrics" or "otlp_endpoint"`)
+		}
+		return nil
+	}
+	if c.Traces != nil && !contains(aws.StringValue(c.Traces), otelTracesValidDestinations) {
+		return fmt.Errorf(`invalid "traces" destination %s: valid destinations are %s`,
+			aws.StringValue(c.Traces), english.WordSeries(otelTracesValidDestinations, "and"))
+	}
+	if c.Metrics != nil && !contains(aws.StringValue(c.Metrics), otelMetricsValidDestinations) {
+		return fmt.Errorf(`invalid "metrics" destination %s: valid destinations are %s`,
+			aws.StringValue(c.Metrics), english.WordSeries(otelMetricsValidDestinations, "and"))
+	}
+	if c.OTLPEndpoint == nil && (aws.StringValue(c.Traces) == otelOTLP || aws.StringValue(c.Metrics) == otelOTLP) {
+		return fmt.Errorf(`"otlp_endpoint" must be specified if "traces" or "metrics" is %q`, otelOTLP)
+	}
+	return nil
+}
+
 // validate returns nil if JobTriggerConfig is configured correctly.
 func (c JobTriggerConfig) validate() error {
-	if c.Schedule == nil {
+	if c.Schedule == nil && len(c.Schedules) == 0 && len(c.Events) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "schedule",
+		}
+	}
+	for idx, schedule := range c.Schedules {
+		if err := schedule.validate(); err != nil {
+			return fmt.Errorf(`validate "schedules[%d]": %w`, idx, err)
+		}
+	}
+	if err := validateEventRules(c.Events); err != nil {
+		return fmt.Errorf(`validate "events": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if JobSchedule is configured correctly.
+func (s JobSchedule) validate() error {
+	if s.Schedule == nil {
 		return &errFieldMustBeSpecified{
 			missingField: "schedule",
 		}
 	}
+	if s.Timezone != nil {
+		if _, err := time.LoadLocation(aws.StringValue(s.Timezone)); err != nil {
+			return fmt.Errorf(`validate "timezone": %q is not a valid IANA timezone`, aws.StringValue(s.Timezone))
+		}
+	}
+	if s.FlexibleWindow != nil {
+		window := *s.FlexibleWindow
+		if window%time.Minute != 0 || window < time.Minute || window > 24*time.Hour {
+			return fmt.Errorf(`validate "flexible_window": %v must be a whole number of minutes between 1m and 24h`, window)
+		}
+	}
+	return nil
+}
+
+func validateEventRules(rules []EventRule) error {
+	names := make(map[string]bool)
+	for idx, rule := range rules {
+		if err := rule.validate(); err != nil {
+			return fmt.Errorf(`validate "events[%d]": %w`, idx, err)
+		}
+		name := aws.StringValue(rule.Name)
+		if names[name] {
+			return fmt.Errorf(`event rule name %q must be unique`, name)
+		}
+		names[name] = true
+	}
+	return nil
+}
+
+// validate returns nil if EventRule is configured correctly.
+func (r EventRule) validate() error {
+	if err := validatePubSubName(aws.StringValue(r.Name)); err != nil {
+		return err
+	}
+	if len(r.Pattern) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "pattern",
+		}
+	}
 	return nil
 }
 
@@ -1695,6 +3636,221 @@ func (JobFailureHandlerConfig) validate() error {
 	return nil
 }
 
+// validate returns nil if WorkflowJob is configured correctly.
+func (j WorkflowJob) validate() error {
+	if err := j.WorkflowJobConfig.validate(); err != nil {
+		return err
+	}
+	return j.Workload.validate()
+}
+
+// validate returns nil if WorkflowJobConfig is configured correctly.
+func (c WorkflowJobConfig) validate() error {
+	var err error
+	if err = c.On.validate(); err != nil {
+		return fmt.Errorf(`validate "on": %w`, err)
+	}
+	if c.Timeout != nil {
+		if timeout := *c.Timeout; timeout < time.Second || timeout%time.Second != 0 {
+			return fmt.Errorf(`"timeout" %s must be a whole number of seconds greater than or equal to 1s`, timeout)
+		}
+	}
+	if err = c.Platform.validate(); err != nil {
+		return fmt.Errorf(`validate "platform": %w`, err)
+	}
+	if !c.Platform.IsEmpty() && c.Platform.OS() != OSLinux {
+		return fmt.Errorf(`"platform" %s is not supported for %s, the operating system must be %s`,
+			platformString(c.Platform.OS(), c.Platform.Arch()), manifestinfo.WorkflowJobType, OSLinux)
+	}
+	if err = c.Network.validate(); err != nil {
+		return fmt.Errorf(`validate "network": %w`, err)
+	}
+	if len(c.Network.VPC.SecurityGroups.GetIngress()) > 0 {
+		return fmt.Errorf(`"network.vpc.security_groups.ingress" is not supported for %s`, manifestinfo.WorkflowJobType)
+	}
+	return validateWorkflowSteps(c.Steps)
+}
+
+// validateWorkflowSteps returns nil if the steps are configured correctly and their transitions
+// reference existing steps without looping forever.
+func validateWorkflowSteps(steps []WorkflowStep) error {
+	if len(steps) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "steps",
+		}
+	}
+	names := make(map[string]int, len(steps))
+	logicalIDs := make(map[string]string, len(steps))
+	for idx, step := range steps {
+		if err := step.validate(); err != nil {
+			return fmt.Errorf(`validate "steps[%d]": %w`, idx, err)
+		}
+		name := aws.StringValue(step.Name)
+		if _, ok := names[name]; ok {
+			return fmt.Errorf(`step name %q must be unique`, name)
+		}
+		names[name] = idx
+		// The resources of each step are named after the alphanumeric characters of its name.
+		id := template.StripNonAlphaNumFunc(name)
+		if other, ok := logicalIDs[id]; ok {
+			return fmt.Errorf(`step names %q and %q must differ by more than hyphens and underscores`, other, name)
+		}
+		logicalIDs[id] = name
+	}
+	for idx, step := range steps {
+		if step.Next != nil {
+			if _, ok := names[aws.StringValue(step.Next)]; !ok {
+				return fmt.Errorf(`"steps[%d].next" %q must be the name of a step`, idx, aws.StringValue(step.Next))
+			}
+		}
+		for catchIdx, catch := range step.Catch {
+			if _, ok := names[aws.StringValue(catch.Next)]; !ok {
+				return fmt.Errorf(`"steps[%d].catch[%d].next" %q must be the name of a step`, idx, catchIdx, aws.StringValue(catch.Next))
+			}
+		}
+	}
+	// Follow the transitions of the successful steps from the first one: the workflow must reach an end.
+	job := WorkflowJob{WorkflowJobConfig: WorkflowJobConfig{Steps: steps}}
+	visited := make(map[int]bool, len(steps))
+	for idx := 0; ; {
+		if visited[idx] {
+			return fmt.Errorf(`step %q must not loop back to itself through "next"`, aws.StringValue(steps[idx].Name))
+		}
+		visited[idx] = true
+		next := job.NextStep(idx)
+		if next == "" {
+			return nil
+		}
+		idx = names[next]
+	}
+}
+
+// validate returns nil if WorkflowStep is configured correctly.
+func (s WorkflowStep) validate() error {
+	var err error
+	if err = validatePubSubName(aws.StringValue(s.Name)); err != nil {
+		return err
+	}
+	if err = s.ImageConfig.validate(); err != nil {
+		return fmt.Errorf(`validate "image": %w`, err)
+	}
+	if len(s.ImageConfig.Image.Platforms) != 0 {
+		return fmt.Errorf(`"image.platforms" is not supported for %s`, manifestinfo.WorkflowJobType)
+	}
+	if s.CPU != nil && aws.IntValue(s.CPU) <= 0 {
+		return fmt.Errorf(`"cpu" %d must be positive`, aws.IntValue(s.CPU))
+	}
+	if s.Memory != nil && aws.IntValue(s.Memory) <= 0 {
+		return fmt.Errorf(`"memory" %d must be positive`, aws.IntValue(s.Memory))
+	}
+	for n, v := range s.Variables {
+		if err = v.validate(); err != nil {
+			return fmt.Errorf(`validate %q "variables": %w`, n, err)
+		}
+	}
+	for _, v := range s.Secrets {
+		if err = v.validate(); err != nil {
+			return fmt.Errorf(`validate "secret": %w`, err)
+		}
+	}
+	if err = validateNoSecretFiles(s.Secrets); err != nil {
+		return fmt.Errorf(`validate "secrets": %w`, err)
+	}
+	if s.Timeout != nil {
+		if timeout := *s.Timeout; timeout < time.Second || timeout%time.Second != 0 {
+			return fmt.Errorf(`"timeout" %s must be a whole number of seconds greater than or equal to 1s`, timeout)
+		}
+	}
+	if s.Retries != nil && aws.IntValue(s.Retries) < 0 {
+		return fmt.Errorf(`"retries" %d must not be negative`, aws.IntValue(s.Retries))
+	}
+	for idx, catch := range s.Catch {
+		if catch.Next == nil {
+			return fmt.Errorf(`validate "catch[%d]": %w`, idx, &errFieldMustBeSpecified{
+				missingField: "next",
+			})
+		}
+	}
+	if s.Next != nil && aws.BoolValue(s.End) {
+		return &errFieldMutualExclusive{
+			firstField:  "next",
+			secondField: "end",
+		}
+	}
+	return nil
+}
+
+// validate returns nil if APIGateway is configured correctly.
+func (a APIGateway) validate() error {
+	if a.IsEmpty() {
+		return nil
+	}
+	if a.Protocol != nil && !contains(aws.StringValue(a.Protocol), apiGatewayProtocols) {
+		return fmt.Errorf(`"protocol" %q must be one of %s`, aws.StringValue(a.Protocol), english.WordSeries(apiGatewayProtocols, "or"))
+	}
+	if len(a.Routes) != 0 && !a.IsWebSocket() {
+		return fmt.Errorf(`"routes" can only be specified if "protocol" is %q`, APIGatewayProtocolWebSocket)
+	}
+	for idx, route := range a.Routes {
+		if route == "" {
+			return fmt.Errorf(`"routes[%d]" must not be empty`, idx)
+		}
+		if strings.HasPrefix(route, "$") {
+			return fmt.Errorf(`"routes[%d]" %s must not start with "$" since the $connect, $disconnect and $default routes are always created`, idx, route)
+		}
+	}
+	if !a.Authorizer.IsEmpty() && a.IsWebSocket() {
+		return fmt.Errorf(`"authorizer" can only be specified if "protocol" is %q`, APIGatewayProtocolHTTP)
+	}
+	if err := a.Authorizer.validate(); err != nil {
+		return fmt.Errorf(`validate "authorizer": %w`, err)
+	}
+	if err := a.Throttling.validate(); err != nil {
+		return fmt.Errorf(`validate "throttling": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if APIGatewayAuthorizer is configured correctly.
+func (a APIGatewayAuthorizer) validate() error {
+	if err := a.JWT.validate(); err != nil {
+		return fmt.Errorf(`validate "jwt": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if JWTAuthorizer is configured correctly.
+func (j JWTAuthorizer) validate() error {
+	if j.IsEmpty() {
+		return nil
+	}
+	if j.Issuer == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "issuer",
+		}
+	}
+	if !strings.HasPrefix(aws.StringValue(j.Issuer), "https://") {
+		return fmt.Errorf(`"issuer" %s must be an HTTPS URL`, aws.StringValue(j.Issuer))
+	}
+	if len(j.Audience) == 0 {
+		return &errFieldMustBeSpecified{
+			missingField: "audience",
+		}
+	}
+	return nil
+}
+
+// validate returns nil if APIGatewayThrottling is configured correctly.
+func (t APIGatewayThrottling) validate() error {
+	if t.Rate != nil && aws.Float64Value(t.Rate) < 0 {
+		return fmt.Errorf(`"rate" %v must be a non-negative number`, aws.Float64Value(t.Rate))
+	}
+	if t.Burst != nil && aws.IntValue(t.Burst) < 0 {
+		return fmt.Errorf(`"burst" %d must be a non-negative integer`, aws.IntValue(t.Burst))
+	}
+	return nil
+}
+
 // validate returns nil if PublishConfig is configured correctly.
 func (p PublishConfig) validate() error {
 	for ind, topic := range p.Topics {
@@ -1710,6 +3866,9 @@ func (t Topic) validate() error {
 	if err := validatePubSubName(aws.StringValue(t.Name)); err != nil {
 		return err
 	}
+	if err := validateKMSKeyARN(t.KMSKey); err != nil {
+		return fmt.Errorf(`validate "kms_key": %w`, err)
+	}
 	return t.FIFO.validate()
 }
 
@@ -1736,6 +3895,9 @@ func (s SubscribeConfig) validate() error {
 			return fmt.Errorf(`validate "topics[%d]": %w`, ind, err)
 		}
 	}
+	if err := validateEventRules(s.Events); err != nil {
+		return fmt.Errorf(`validate "events": %w`, err)
+	}
 	if err := s.Queue.validate(); err != nil {
 		return fmt.Errorf(`validate "queue": %w`, err)
 	}
@@ -1756,6 +3918,17 @@ func (t TopicSubscription) validate() error {
 	if !isValidSubSvcName(svcName) {
 		return fmt.Errorf("service name must start with a letter, contain only lower-case letters, numbers, and hyphens, and have no consecutive or trailing hyphen")
 	}
+	if t.FilterPolicyScope != nil {
+		if t.FilterPolicy == nil {
+			return &errFieldMustBeSpecified{
+				missingField:      "filter_policy",
+				conditionalFields: []string{"filter_policy_scope"},
+			}
+		}
+		if !contains(aws.StringValue(t.FilterPolicyScope), validSNSFilterPolicyScopeValues) {
+			return fmt.Errorf(`validate "filter_policy_scope": filter policy scope value must be one of %s`, english.WordSeries(validSNSFilterPolicyScopeValues, "or"))
+		}
+	}
 	if err := t.Queue.validate(); err != nil {
 		return fmt.Errorf(`validate "queue": %w`, err)
 	}
@@ -1778,6 +3951,9 @@ func (q SQSQueue) validate() error {
 	if err := q.DeadLetter.validate(); err != nil {
 		return fmt.Errorf(`validate "dead_letter": %w`, err)
 	}
+	if err := validateKMSKeyARN(q.KMSKey); err != nil {
+		return fmt.Errorf(`validate "kms_key": %w`, err)
+	}
 	return q.FIFO.validate()
 }
 
@@ -1851,6 +4027,29 @@ func (d DeadLetterQueue) validate() error {
 	if d.IsEmpty() {
 		return nil
 	}
+	if d.Retention == nil {
+		return nil
+	}
+	if d.Tries == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "tries",
+			conditionalFields: []string{"retention"},
+		}
+	}
+	if retention := *d.Retention; retention < minSQSRetention || retention > maxSQSRetention {
+		return errors.New(`validate "retention": retention must be between 1 minute and 14 days`)
+	}
+	return nil
+}
+
+func validateKMSKeyARN(key *string) error {
+	if key == nil {
+		return nil
+	}
+	parsed, err := arn.Parse(aws.StringValue(key))
+	if err != nil || parsed.Service != "kms" {
+		return fmt.Errorf("%q is not a valid KMS key ARN", aws.StringValue(key))
+	}
 	return nil
 }
 
@@ -1870,6 +4069,14 @@ func (v Variable) validate() error {
 	if err := v.FromCFN.validate(); err != nil {
 		return fmt.Errorf(`validate "from_cfn": %w`, err)
 	}
+	if v.cfnRef() != "" {
+		return nil
+	}
+	for _, m := range variableRefRegExp.FindAllStringSubmatch(aws.StringValue(v.Plain), -1) {
+		if m[1] == variableRefSourceCFN {
+			return fmt.Errorf("reference %s to a CloudFormation export must be the entire value", m[0])
+		}
+	}
 	return nil
 }
 
@@ -1892,29 +4099,74 @@ func (cfg fromCFN) validate() error {
 	return nil
 }
 
-// validate is a no-op for Secrets.
+// validate returns nil if Secret is configured correctly.
 func (s Secret) validate() error {
+	if !s.IsFile() {
+		return nil
+	}
+	path := s.FilePath()
+	if !strings.HasPrefix(path, "/") || strings.HasSuffix(path, "/") || filepath.Clean(path) != path {
+		return fmt.Errorf(`validate "as_file": path %q must be an absolute path to a file`, path)
+	}
+	if err := validateVolumePath(path); err != nil {
+		return fmt.Errorf(`validate "as_file": %w`, err)
+	}
+	return nil
+}
+
+// validateSecretFiles returns nil if the secrets written to files don't share a path.
+func validateSecretFiles(secrets map[string]Secret) error {
+	names := make([]string, 0, len(secrets))
+	for name := range secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	secretForPath := make(map[string]string)
+	for _, name := range names {
+		secret := secrets[name]
+		if !secret.IsFile() {
+			continue
+		}
+		if other, ok := secretForPath[secret.FilePath()]; ok {
+			return fmt.Errorf(`secrets %s and %s cannot both be written to %q`, other, name, secret.FilePath())
+		}
+		secretForPath[secret.FilePath()] = name
+	}
+	return nil
+}
+
+// validateNoSecretFiles returns an error if any of the secrets is written to a file.
+// Only the main container and the sidecars of an ECS task support "as_file".
+func validateNoSecretFiles(secrets map[string]Secret) error {
+	for name, secret := range secrets {
+		if secret.IsFile() {
+			return fmt.Errorf(`"as_file" is not supported for secret %s: only the secrets of the main container and of sidecars can be written to files`, name)
+		}
+	}
 	return nil
 }
 
 type validateExposedPortsOpts struct {
-	mainContainerName string
-	mainContainerPort *uint16
-	alb               *HTTP
-	nlb               *NetworkLoadBalancerConfiguration
-	sidecarConfig     map[string]*SidecarConfig
+	mainContainerName   string
+	mainContainerPort   *uint16
+	alb                 *HTTP
+	nlb                 *NetworkLoadBalancerConfiguration
+	sidecarConfig       map[string]*SidecarConfig
+	serviceConnectPorts []ServiceConnectPort
 }
 
 type validateDependenciesOpts struct {
 	mainContainerName string
 	sidecarConfig     map[string]*SidecarConfig
 	imageConfig       Image
+	healthCheck       ContainerHealthCheck
 	logging           Logging
 }
 
 type containerDependency struct {
-	dependsOn   DependsOn
-	isEssential bool
+	dependsOn      DependsOn
+	isEssential    bool
+	hasHealthCheck bool
 }
 
 type validateTargetContainerOpts struct {
@@ -1958,22 +4210,27 @@ func validateTargetContainer(opts validateTargetContainerOpts) error {
 func validateContainerDeps(opts validateDependenciesOpts) error {
 	containerDependencies := make(map[string]containerDependency)
 	containerDependencies[opts.mainContainerName] = containerDependency{
-		dependsOn:   opts.imageConfig.DependsOn,
-		isEssential: true,
+		dependsOn:      opts.imageConfig.DependsOn,
+		isEssential:    true,
+		hasHealthCheck: !opts.healthCheck.IsEmpty(),
 	}
 	if !opts.logging.IsEmpty() {
 		containerDependencies[FirelensContainerName] = containerDependency{}
 	}
 	for name, config := range opts.sidecarConfig {
 		containerDependencies[name] = containerDependency{
-			dependsOn:   config.DependsOn,
-			isEssential: config.Essential == nil || aws.BoolValue(config.Essential),
+			dependsOn:      config.DependsOn,
+			isEssential:    config.Essential == nil || aws.BoolValue(config.Essential),
+			hasHealthCheck: !config.HealthCheck.IsEmpty(),
 		}
 	}
 	if err := validateDepsForEssentialContainers(containerDependencies); err != nil {
 		return err
 	}
-	return validateNoCircularDependencies(containerDependencies)
+	if err := validateNoCircularDependencies(containerDependencies); err != nil {
+		return err
+	}
+	return validateDepsForHealthyStatus(containerDependencies)
 }
 
 func validateDepsForEssentialContainers(deps map[string]containerDependency) error {
@@ -1990,6 +4247,22 @@ func validateDepsForEssentialContainers(deps map[string]containerDependency) err
 	return nil
 }
 
+// validateDepsForHealthyStatus returns an error if a container waits for another container to be healthy,
+// but ECS can't know because the container doesn't have a health check.
+func validateDepsForHealthyStatus(deps map[string]containerDependency) error {
+	for name, containerDep := range deps {
+		for dep, status := range containerDep.dependsOn {
+			if strings.ToUpper(status) != dependsOnHealthy {
+				continue
+			}
+			if target, ok := deps[dep]; ok && !target.hasHealthCheck {
+				return fmt.Errorf(`validate %s container dependencies status: container %s must have a "healthcheck" to be depended on with status %s`, name, dep, dependsOnHealthy)
+			}
+		}
+	}
+	return nil
+}
+
 func validateExposedPorts(opts validateExposedPortsOpts) error {
 	containerNameFor := make(map[uint16]string)
 	populateMainContainerPort(containerNameFor, opts)
@@ -2002,6 +4275,9 @@ func validateExposedPorts(opts validateExposedPortsOpts) error {
 	if err := populateNLBPortsAndValidate(containerNameFor, opts); err != nil {
 		return err
 	}
+	if err := populateServiceConnectPortsAndValidate(containerNameFor, opts); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2045,7 +4321,12 @@ func populateALBPortsAndValidate(containerNameFor map[uint16]string, opts valida
 		return nil
 	}
 	alb := opts.alb
+	var targets []RoutingRule
 	for _, rule := range alb.RoutingRules() {
+		targets = append(targets, rule)
+		targets = append(targets, rule.weightedTargetRules()...)
+	}
+	for _, rule := range targets {
 		if rule.TargetPort == nil {
 			continue
 		}
@@ -2062,6 +4343,23 @@ func populateALBPortsAndValidate(containerNameFor map[uint16]string, opts valida
 	return nil
 }
 
+func populateServiceConnectPortsAndValidate(containerNameFor map[uint16]string, opts validateExposedPortsOpts) error {
+	for _, port := range opts.serviceConnectPorts {
+		if err := validateContainersNotExposingSamePort(containerNameFor, aws.Uint16Value(port.Port), port.TargetContainer); err != nil {
+			return err
+		}
+		if _, ok := containerNameFor[aws.Uint16Value(port.Port)]; ok {
+			continue
+		}
+		targetContainerName := opts.mainContainerName
+		if port.TargetContainer != nil {
+			targetContainerName = aws.StringValue(port.TargetContainer)
+		}
+		containerNameFor[aws.Uint16Value(port.Port)] = targetContainerName
+	}
+	return nil
+}
+
 func validateContainersNotExposingSamePort(containerNameFor map[uint16]string, targetPort uint16, targetContainer *string) error {
 	container, exists := containerNameFor[targetPort]
 	if !exists {
@@ -2220,6 +4518,27 @@ func validateARM(opts validateARMOpts) error {
 	return nil
 }
 
+type validateEC2Opts struct {
+	spot      *int
+	spotFrom  *int
+	ephemeral *int
+	placement PlacementArgOrString
+}
+
+func validateEC2(opts validateEC2Opts) error {
+	if opts.spot != nil || opts.spotFrom != nil {
+		return errors.New(`'Fargate Spot' is not supported when "compute" is "ec2"`)
+	}
+	if opts.ephemeral != nil {
+		return errors.New(`"storage.ephemeral" is not supported when "compute" is "ec2"`)
+	}
+	// Tasks on EC2 instances can't be assigned a public IP address.
+	if opts.placement.IsEmpty() || aws.StringValue((*string)(opts.placement.PlacementString)) == string(PublicSubnetPlacement) {
+		return errors.New(`"network.vpc.placement" must be "private" or a list of subnets when "compute" is "ec2"`)
+	}
+	return nil
+}
+
 func contains(name string, names []string) bool {
 	for _, n := range names {
 		if name == n {
@@ -2253,6 +4572,13 @@ func (r *RoutingRule) validateConditionValuesPerRule() error {
 	for idx, ip := range r.AllowedSourceIps {
 		allowedSourceIps[idx] = string(ip)
 	}
+	if len(r.Hosts) != 0 {
+		// The hosts replace the aliases in the host header condition of the rule.
+		if len(r.Hosts)+len(allowedSourceIps) >= maxConditionsPerRule {
+			return fmt.Errorf(`listener rule for path %q has more than five condition values in "hosts" and "allowed_source_ips"`, aws.StringValue(r.Path))
+		}
+		return nil
+	}
 	if len(aliases)+len(allowedSourceIps) >= maxConditionsPerRule {
 		return &errMaxConditionValuesPerRule{
 			path:             aws.StringValue(r.Path),
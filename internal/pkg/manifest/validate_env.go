@@ -6,16 +6,25 @@ package manifest
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudfront"
+	"github.com/dustin/go-humanize/english"
 )
 
 var (
 	errAZsNotEqual = errors.New("public subnets and private subnets do not span the same availability zones")
 
 	minAZs = 2
+
+	// EventBridge rules can't have more than 5 targets.
+	maxNotificationsPerEvent = 5
+
+	notificationPlaceholderRegexp = regexp.MustCompile(`\$\{([^}]*)\}`)
 )
 
 // Validate returns nil if Environment is configured correctly.
@@ -43,6 +52,31 @@ func (e EnvironmentConfig) validate() error {
 	if err := e.CDNConfig.validate(); err != nil {
 		return fmt.Errorf(`validate "cdn": %w`, err)
 	}
+	if err := e.Imports.validate(); err != nil {
+		return fmt.Errorf(`validate "imports": %w`, err)
+	}
+	if err := e.Compute.validate(); err != nil {
+		return fmt.Errorf(`validate "compute": %w`, err)
+	}
+	if !e.Compute.EC2.IsEmpty() {
+		if e.Imports.Cluster != nil {
+			return &errFieldMutualExclusive{
+				firstField:  "compute.ec2",
+				secondField: "imports.cluster",
+			}
+		}
+		if e.Network.VPC.imported() && len(e.Network.VPC.Subnets.Private) == 0 {
+			return errors.New(`private subnets must be imported to launch the instances of "compute.ec2"`)
+		}
+	}
+	if err := e.validateNotifications(); err != nil {
+		return fmt.Errorf(`validate "notifications": %w`, err)
+	}
+	if e.ImportsPublicALB() {
+		if err := e.validateImportedPublicALB(); err != nil {
+			return err
+		}
+	}
 	if e.IsPublicLBIngressRestrictedToCDN() && !e.CDNEnabled() {
 		return errors.New("CDN must be enabled to limit security group ingress to CloudFront")
 	}
@@ -74,8 +108,212 @@ func (e EnvironmentConfig) validate() error {
 	return nil
 }
 
+func (e EnvironmentConfig) validateImportedPublicALB() error {
+	if !e.Network.VPC.imported() {
+		return errors.New(`"network.vpc.id" must be specified to import the VPC of the load balancer in "imports.public_alb"`)
+	}
+	if !e.HTTPConfig.Public.IsEmpty() {
+		return &errFieldMutualExclusive{
+			firstField:  "imports.public_alb",
+			secondField: "http.public",
+		}
+	}
+	if e.CDNEnabled() {
+		return &errFieldMutualExclusive{
+			firstField:  "imports.public_alb",
+			secondField: "cdn",
+		}
+	}
+	return nil
+}
+
+func (e EnvironmentConfig) validateNotifications() error {
+	targetsPerEvent := make(map[string]int)
+	for idx, n := range e.Notifications {
+		if err := n.validate(); err != nil {
+			return fmt.Errorf(`validate "notifications[%d]": %w`, idx, err)
+		}
+		for _, event := range n.DeploymentEvents() {
+			targetsPerEvent[event]++
+		}
+	}
+	for _, event := range DeploymentEvents {
+		if targetsPerEvent[event] > maxNotificationsPerEvent {
+			return fmt.Errorf("at most %d destinations can be notified of %q events", maxNotificationsPerEvent, event)
+		}
+	}
+	return nil
+}
+
+// validate returns nil if Notification is configured correctly.
+func (n Notification) validate() error {
+	var destinations []string
+	if n.SNS != nil {
+		destinations = append(destinations, "sns")
+	}
+	if n.Slack != nil {
+		destinations = append(destinations, "slack")
+	}
+	if n.Webhook != nil {
+		destinations = append(destinations, "webhook")
+	}
+	if len(destinations) == 0 {
+		return errors.New(`one of "sns", "slack" or "webhook" must be specified`)
+	}
+	if len(destinations) > 1 {
+		return &errFieldMutualExclusive{
+			firstField:  destinations[0],
+			secondField: destinations[1],
+		}
+	}
+	for _, event := range n.Events {
+		if !contains(event, DeploymentEvents) {
+			return fmt.Errorf(`invalid event %q, must be one of %s`, event, english.WordSeries(DeploymentEvents, "or"))
+		}
+	}
+	if n.SNS != nil {
+		if _, err := arn.Parse(aws.StringValue(n.SNS)); err != nil {
+			return fmt.Errorf(`parse "sns" topic ARN %q: %w`, aws.StringValue(n.SNS), err)
+		}
+	}
+	for field, u := range map[string]*string{"slack": n.Slack, "webhook": n.Webhook} {
+		if u == nil {
+			continue
+		}
+		parsed, err := url.Parse(aws.StringValue(u))
+		if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+			return fmt.Errorf(`%q must be an https URL`, field)
+		}
+	}
+	for _, match := range notificationPlaceholderRegexp.FindAllStringSubmatch(aws.StringValue(n.Message), -1) {
+		if !contains(match[1], notificationPlaceholders) {
+			return fmt.Errorf(`unknown placeholder %q in "message", must be one of %s`, match[0], english.WordSeries(notificationPlaceholders, "or"))
+		}
+	}
+	return nil
+}
+
+// validate returns nil if environmentCompute is configured correctly.
+func (c environmentCompute) validate() error {
+	if err := c.EC2.validate(); err != nil {
+		return fmt.Errorf(`validate "ec2": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if EC2CapacityProvider is configured correctly.
+func (c EC2CapacityProvider) validate() error {
+	if c.IsEmpty() {
+		return nil
+	}
+	if c.InstanceType == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "instance_type",
+		}
+	}
+	if c.OS != nil {
+		os := aws.StringValue(c.OS)
+		if !contains(os, ec2OSFamilies) {
+			return fmt.Errorf(`invalid os %q, must be one of %s`, os, english.WordSeries(ec2OSFamilies, "or"))
+		}
+	}
+	if aws.BoolValue(c.GPU) && c.IsWindows() {
+		return fmt.Errorf(`"gpu" cannot be used with %q: GPU-optimized AMIs are only available for %s`, aws.StringValue(c.OS), OSLinux)
+	}
+	if c.AMI != nil && (c.OS != nil || c.GPU != nil) {
+		return errors.New(`"ami" cannot be specified with "os" or "gpu"`)
+	}
+	min, max := c.Min(), c.Max()
+	if min < 0 || max < 0 {
+		return errors.New(`"min" and "max" cannot be negative`)
+	}
+	if min > max {
+		return &errMinGreaterThanMax{
+			min: min,
+			max: max,
+		}
+	}
+	return nil
+}
+
+// validate returns nil if environmentImports is configured correctly.
+func (i environmentImports) validate() error {
+	if i.Cluster != nil && arn.IsARN(aws.StringValue(i.Cluster)) {
+		return errors.New(`"cluster" must be the name of the cluster instead of its ARN`)
+	}
+	if err := i.PublicALB.validate(); err != nil {
+		return fmt.Errorf(`validate "public_alb": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if importedPublicALB is configured correctly.
+func (alb importedPublicALB) validate() error {
+	if alb.IsEmpty() {
+		return nil
+	}
+	if alb.ARN == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "arn",
+		}
+	}
+	if _, err := arn.Parse(aws.StringValue(alb.ARN)); err != nil {
+		return fmt.Errorf(`parse "arn": %w`, err)
+	}
+	if err := alb.Listeners.validate(); err != nil {
+		return fmt.Errorf(`validate "listeners": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if importedListeners is configured correctly.
+func (l importedListeners) validate() error {
+	if l.HTTP.ARN == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "http.arn",
+		}
+	}
+	if len(l.HTTP.Certificates) != 0 {
+		return errors.New(`"http.certificates" cannot be specified because an HTTP listener does not terminate TLS`)
+	}
+	if err := l.HTTP.validate(); err != nil {
+		return fmt.Errorf(`validate "http": %w`, err)
+	}
+	if len(l.HTTPS.Certificates) != 0 && l.HTTPS.ARN == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "https.arn",
+			conditionalFields: []string{"https.certificates"},
+		}
+	}
+	if err := l.HTTPS.validate(); err != nil {
+		return fmt.Errorf(`validate "https": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if importedListener is configured correctly.
+func (l importedListener) validate() error {
+	if l.ARN != nil {
+		if _, err := arn.Parse(aws.StringValue(l.ARN)); err != nil {
+			return fmt.Errorf(`parse "arn": %w`, err)
+		}
+	}
+	for idx, certARN := range l.Certificates {
+		if _, err := arn.Parse(certARN); err != nil {
+			return fmt.Errorf(`parse "certificates[%d]": %w`, idx, err)
+		}
+	}
+	return nil
+}
+
 // validate returns nil if environmentNetworkConfig is configured correctly.
 func (n environmentNetworkConfig) validate() error {
+	if n.IP != nil && !contains(aws.StringValue(n.IP), ipAddressTypes) {
+		return fmt.Errorf(`"ip" %q must be one of %s`, aws.StringValue(n.IP), english.WordSeries(quoteStringSlice(ipAddressTypes), "or"))
+	}
+	if aws.StringValue(n.IP) == IPAddressTypeDualStack && n.VPC.IPv6 != nil && !aws.BoolValue(n.VPC.IPv6) {
+		return fmt.Errorf(`"vpc.ipv6" cannot be disabled when "ip" is %q`, IPAddressTypeDualStack)
+	}
 	if err := n.VPC.validate(); err != nil {
 		return fmt.Errorf(`validate "vpc": %w`, err)
 	}
@@ -103,6 +341,27 @@ func (cfg environmentVPCConfig) validate() error {
 	if err := cfg.FlowLogs.validate(); err != nil {
 		return fmt.Errorf(`validate vpc "flowlogs": %w`, err)
 	}
+	if cfg.imported() && (!cfg.NAT.IsEmpty() || aws.BoolValue(cfg.IPv6)) {
+		return errors.New(`cannot configure "nat" or "ipv6" for an imported VPC`)
+	}
+	if err := cfg.NAT.validate(); err != nil {
+		return fmt.Errorf(`validate "nat": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if NATConfig is configured correctly.
+func (c NATConfig) validate() error {
+	if c.Strategy == nil {
+		return nil
+	}
+	strategy := aws.StringValue(c.Strategy)
+	if !contains(strategy, natStrategies) {
+		return fmt.Errorf(`"strategy" %q must be one of %s`, strategy, english.WordSeries(quoteStringSlice(natStrategies), "or"))
+	}
+	if strategy == NATStrategyNone && c.InstanceType != nil {
+		return fmt.Errorf(`"instance_type" cannot be specified when "strategy" is %q`, NATStrategyNone)
+	}
 	return nil
 }
 
@@ -272,13 +531,30 @@ func (c subnetConfiguration) validate() error {
 	return nil
 }
 
-// validate is a no-op for VPCFlowLogsArgs.
+// validate returns nil if VPCFlowLogsArgs is configured correctly.
 func (fl VPCFlowLogsArgs) validate() error {
+	if fl.Retention != nil && aws.IntValue(fl.Retention) <= 0 {
+		return fmt.Errorf(`"retention" %d must be a positive number of days`, aws.IntValue(fl.Retention))
+	}
+	if fl.Destination != nil && !contains(aws.StringValue(fl.Destination), flowLogsDestinations) {
+		return fmt.Errorf(`"destination" %q must be one of %s`, aws.StringValue(fl.Destination), english.WordSeries(quoteStringSlice(flowLogsDestinations), "or"))
+	}
 	return nil
 }
 
 // validate returns nil if environmentObservability is configured correctly.
 func (o environmentObservability) validate() error {
+	if err := o.StaticSiteLogs.validate(); err != nil {
+		return fmt.Errorf(`validate "static_site_logs": %w`, err)
+	}
+	return nil
+}
+
+// validate returns nil if StaticSiteLogsArgs is configured correctly.
+func (l StaticSiteLogsArgs) validate() error {
+	if l.Retention != nil && aws.IntValue(l.Retention) <= 0 {
+		return fmt.Errorf(`"retention" %d must be a positive number of days`, aws.IntValue(l.Retention))
+	}
 	return nil
 }
 
@@ -306,18 +582,74 @@ func (cfg PublicHTTPConfig) validate() error {
 			return fmt.Errorf(`parse "certificates[%d]": %w`, idx, err)
 		}
 	}
+	seen := make(map[string]struct{})
+	for idx, cert := range cfg.ManagedCertificates {
+		if err := cert.validate(); err != nil {
+			return fmt.Errorf(`validate "managed_certificates[%d]": %w`, idx, err)
+		}
+		for _, domain := range cert.Domains() {
+			if _, ok := seen[domain]; ok {
+				return fmt.Errorf(`validate "managed_certificates[%d]": domain %q is covered by more than one certificate`, idx, domain)
+			}
+			seen[domain] = struct{}{}
+		}
+	}
 	if cfg.DeprecatedSG.DeprecatedIngress.VPCIngress != nil {
 		return fmt.Errorf("a public load balancer already allows vpc ingress")
 	}
 	if err := cfg.ELBAccessLogs.validate(); err != nil {
 		return fmt.Errorf(`validate "access_logs": %w`, err)
 	}
+	if err := cfg.TrustStore.validate(); err != nil {
+		return fmt.Errorf(`validate "trust_store": %w`, err)
+	}
+	if err := cfg.WAF.validate(); err != nil {
+		return fmt.Errorf(`validate "waf": %w`, err)
+	}
 	if err := cfg.DeprecatedSG.validate(); err != nil {
 		return err
 	}
 	return cfg.Ingress.validate()
 }
 
+// validate returns nil if ManagedCertificate is configured correctly.
+func (c ManagedCertificate) validate() error {
+	if c.Domain == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "domain",
+		}
+	}
+	if c.HostedZone == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "hosted_zone",
+		}
+	}
+	for idx, name := range c.AlternativeNames {
+		if name == aws.StringValue(c.Domain) {
+			return fmt.Errorf(`"alternative_names[%d]" %q is the domain of the certificate`, idx, name)
+		}
+	}
+	return nil
+}
+
+// validate returns nil if TrustStoreConfig is configured correctly.
+func (t TrustStoreConfig) validate() error {
+	if t.IsEmpty() {
+		return nil
+	}
+	if t.CABundle == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "ca_bundle",
+		}
+	}
+	bundle := aws.StringValue(t.CABundle)
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(bundle, "s3://"), "/")
+	if !strings.HasPrefix(bundle, "s3://") || bucket == "" || key == "" {
+		return fmt.Errorf(`"ca_bundle" %q must be an S3 URI of the form "s3://bucket/key"`, bundle)
+	}
+	return nil
+}
+
 // validate returns nil if ELBAccessLogsArgsOrBool is configured correctly.
 func (al ELBAccessLogsArgsOrBool) validate() error {
 	if al.isEmpty() {
@@ -326,8 +658,20 @@ func (al ELBAccessLogsArgsOrBool) validate() error {
 	return al.AdvancedConfig.validate()
 }
 
-// validate is a no-op for ELBAccessLogsArgs.
+// validate returns nil if ELBAccessLogsArgs is configured correctly.
 func (al ELBAccessLogsArgs) validate() error {
+	if al.Retention == nil {
+		return nil
+	}
+	if al.BucketName != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "retention",
+			secondField: "bucket_name",
+		}
+	}
+	if aws.IntValue(al.Retention) <= 0 {
+		return fmt.Errorf(`"retention" %d must be a positive number of days`, aws.IntValue(al.Retention))
+	}
 	return nil
 }
 
@@ -355,9 +699,38 @@ func (cfg privateHTTPConfig) validate() error {
 	if err := cfg.DeprecatedSG.validate(); err != nil {
 		return fmt.Errorf(`validate "security_groups: %w`, err)
 	}
+	if err := cfg.HostedZone.validate(); err != nil {
+		return fmt.Errorf(`validate "hosted_zone": %w`, err)
+	}
 	return cfg.Ingress.validate()
 }
 
+// validate returns nil if PrivateHostedZoneConfig is configured correctly.
+func (cfg PrivateHostedZoneConfig) validate() error {
+	if cfg.IsEmpty() {
+		return nil
+	}
+	if cfg.Name == nil {
+		return &errFieldMustBeSpecified{
+			missingField: "name",
+		}
+	}
+	if name := aws.StringValue(cfg.Name); !strings.Contains(strings.Trim(name, "."), ".") {
+		return fmt.Errorf(`"name" %q must be a domain name with at least two labels`, name)
+	}
+	seen := make(map[string]struct{})
+	for idx, vpc := range cfg.AssociatedVPCs {
+		if !strings.HasPrefix(vpc, "vpc-") {
+			return fmt.Errorf(`"associated_vpcs[%d]" %q must be a VPC ID`, idx, vpc)
+		}
+		if _, ok := seen[vpc]; ok {
+			return fmt.Errorf(`"associated_vpcs[%d]" %q is specified more than once`, idx, vpc)
+		}
+		seen[vpc] = struct{}{}
+	}
+	return nil
+}
+
 // validate returns nil if environmentCDNConfig is configured correctly.
 func (cfg EnvironmentCDNConfig) validate() error {
 	if cfg.Config.isEmpty() {
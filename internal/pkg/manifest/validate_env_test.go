@@ -224,6 +224,110 @@ func TestEnvironmentConfig_validate(t *testing.T) {
 				},
 			},
 		},
+		"valid vpc flowlogs published to s3": {
+			in: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						FlowLogs: AdvancedToUnion[*bool](VPCFlowLogsArgs{
+							Retention:   aws.Int(90),
+							Destination: aws.String("s3"),
+						}),
+					},
+				},
+			},
+		},
+		"error if vpc flowlogs destination is invalid": {
+			in: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						FlowLogs: AdvancedToUnion[*bool](VPCFlowLogsArgs{
+							Destination: aws.String("firehose"),
+						}),
+					},
+				},
+			},
+			wantedError: `"destination" "firehose" must be one of "cloudwatch" or "s3"`,
+		},
+		"error if elb access logs retention is set with bucket_name": {
+			in: EnvironmentConfig{
+				HTTPConfig: EnvironmentHTTPConfig{
+					Public: PublicHTTPConfig{
+						ELBAccessLogs: ELBAccessLogsArgsOrBool{
+							AdvancedConfig: ELBAccessLogsArgs{
+								BucketName: aws.String("bucketName"),
+								Retention:  aws.Int(30),
+							},
+						},
+					},
+				},
+			},
+			wantedError: `must specify one, not both, of "retention" and "bucket_name"`,
+		},
+		"error if static site logs retention is not positive": {
+			in: EnvironmentConfig{
+				Observability: environmentObservability{
+					StaticSiteLogs: AdvancedToUnion[*bool](StaticSiteLogsArgs{
+						Retention: aws.Int(0),
+					}),
+				},
+			},
+			wantedError: `validate "static_site_logs": "retention" 0 must be a positive number of days`,
+		},
+		"valid nat instances shared by all availability zones": {
+			in: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						NAT: NATConfig{
+							Strategy:     aws.String("single"),
+							InstanceType: aws.String("t4g.nano"),
+						},
+						IPv6: aws.Bool(true),
+					},
+				},
+			},
+		},
+		"error if nat strategy is invalid": {
+			in: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						NAT: NATConfig{
+							Strategy: aws.String("shared"),
+						},
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": validate "nat": "strategy" "shared" must be one of "per_az", "single" or "none"`,
+		},
+		"error if nat instance type is set without nat": {
+			in: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						NAT: NATConfig{
+							Strategy:     aws.String("none"),
+							InstanceType: aws.String("t4g.nano"),
+						},
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": validate "nat": "instance_type" cannot be specified when "strategy" is "none"`,
+		},
+		"error if nat is configured for an imported vpc": {
+			in: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						ID: aws.String("vpc-1234"),
+						Subnets: subnetsConfiguration{
+							Public: []subnetConfiguration{
+								{SubnetID: aws.String("subnet-1")},
+								{SubnetID: aws.String("subnet-2")},
+							},
+						},
+						IPv6: aws.Bool(true),
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": cannot configure "nat" or "ipv6" for an imported VPC`,
+		},
 		"valid elb access logs config with both bucket_prefix and bucket_name": {
 			in: EnvironmentConfig{
 				HTTPConfig: EnvironmentHTTPConfig{
@@ -363,6 +467,142 @@ func TestEnvironmentConfig_validate(t *testing.T) {
 			},
 			wantedError: "validate \"http config\": validate \"public\": must specify one, not both, of \"public.http.security_groups.ingress\" and \"public.http.ingress\"",
 		},
+		"error if a public load balancer is imported without importing a VPC": {
+			in: EnvironmentConfig{
+				Imports: environmentImports{
+					PublicALB: importedPublicALB{
+						ARN: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/50dc6c495c0c9188"),
+						Listeners: importedListeners{
+							HTTP: importedListener{
+								ARN: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/shared/50dc6c495c0c9188/f2b3c4d5e6f7a8b9"),
+							},
+						},
+					},
+				},
+			},
+			wantedError: `"network.vpc.id" must be specified to import the VPC of the load balancer in "imports.public_alb"`,
+		},
+		"error if a public load balancer is imported and http.public is configured": {
+			in: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						ID: aws.String("vpc-123"),
+						Subnets: subnetsConfiguration{
+							Public: []subnetConfiguration{
+								{SubnetID: aws.String("subnet-1")},
+								{SubnetID: aws.String("subnet-2")},
+							},
+						},
+					},
+				},
+				HTTPConfig: EnvironmentHTTPConfig{
+					Public: PublicHTTPConfig{
+						Certificates: []string{"arn:aws:acm:us-east-1:1111111:certificate/look-like-a-good-arn"},
+					},
+				},
+				Imports: environmentImports{
+					PublicALB: importedPublicALB{
+						ARN: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/50dc6c495c0c9188"),
+						Listeners: importedListeners{
+							HTTP: importedListener{
+								ARN: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/shared/50dc6c495c0c9188/f2b3c4d5e6f7a8b9"),
+							},
+						},
+					},
+				},
+			},
+			wantedError: `must specify one, not both, of "imports.public_alb" and "http.public"`,
+		},
+		"error if a public load balancer is imported with a CDN": {
+			in: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						ID: aws.String("vpc-123"),
+						Subnets: subnetsConfiguration{
+							Public: []subnetConfiguration{
+								{SubnetID: aws.String("subnet-1")},
+								{SubnetID: aws.String("subnet-2")},
+							},
+						},
+					},
+				},
+				CDNConfig: EnvironmentCDNConfig{
+					Enabled: aws.Bool(true),
+				},
+				Imports: environmentImports{
+					PublicALB: importedPublicALB{
+						ARN: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/50dc6c495c0c9188"),
+						Listeners: importedListeners{
+							HTTP: importedListener{
+								ARN: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/shared/50dc6c495c0c9188/f2b3c4d5e6f7a8b9"),
+							},
+						},
+					},
+				},
+			},
+			wantedError: `must specify one, not both, of "imports.public_alb" and "cdn"`,
+		},
+		"error if EC2 instances are provisioned in an imported cluster": {
+			in: EnvironmentConfig{
+				Compute: environmentCompute{
+					EC2: EC2CapacityProvider{
+						InstanceType: aws.String("m5.large"),
+					},
+				},
+				Imports: environmentImports{
+					Cluster: aws.String("shared"),
+				},
+			},
+			wantedError: `must specify one, not both, of "compute.ec2" and "imports.cluster"`,
+		},
+		"error if EC2 instances are provisioned in an imported VPC without private subnets": {
+			in: EnvironmentConfig{
+				Network: environmentNetworkConfig{
+					VPC: environmentVPCConfig{
+						ID: aws.String("vpc-123"),
+						Subnets: subnetsConfiguration{
+							Public: []subnetConfiguration{
+								{SubnetID: aws.String("subnet-1")},
+								{SubnetID: aws.String("subnet-2")},
+							},
+						},
+					},
+				},
+				Compute: environmentCompute{
+					EC2: EC2CapacityProvider{
+						InstanceType: aws.String("m5.large"),
+					},
+				},
+			},
+			wantedError: `private subnets must be imported to launch the instances of "compute.ec2"`,
+		},
+		"error if a notification is invalid": {
+			in: EnvironmentConfig{
+				Notifications: []Notification{
+					{
+						Slack: aws.String("https://hooks.slack.com/services/T0/B0/x"),
+					},
+					{
+						Events: []string{"deleted"},
+						SNS:    aws.String("arn:aws:sns:us-west-2:123456789012:deployments"),
+					},
+				},
+			},
+			wantedError: `validate "notifications": validate "notifications[1]": invalid event "deleted", must be one of started, succeeded, failed or rolled_back`,
+		},
+		"error if too many destinations are notified of the same event": {
+			in: EnvironmentConfig{
+				Notifications: []Notification{
+					{Webhook: aws.String("https://example.com/1")},
+					{Webhook: aws.String("https://example.com/2")},
+					{Webhook: aws.String("https://example.com/3")},
+					{Webhook: aws.String("https://example.com/4")},
+					{Webhook: aws.String("https://example.com/5")},
+					{Webhook: aws.String("https://example.com/6"), Events: []string{"failed"}},
+				},
+			},
+			wantedError: `validate "notifications": at most 5 destinations can be notified of "failed" events`,
+		},
 		"no error when http public config with a new ingress field": {
 			in: EnvironmentConfig{
 				CDNConfig: EnvironmentCDNConfig{
@@ -406,6 +646,26 @@ func TestEnvironmentNetworkConfig_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "vpc": `,
 		},
+		"error if ip is not a valid address type": {
+			in: environmentNetworkConfig{
+				IP: stringP("ipv6"),
+			},
+			wantedErrorMsgPrefix: `"ip" "ipv6" must be one of "ipv4" or "dualstack"`,
+		},
+		"error if ipv6 is disabled in a dualstack environment": {
+			in: environmentNetworkConfig{
+				IP: stringP("dualstack"),
+				VPC: environmentVPCConfig{
+					IPv6: aws.Bool(false),
+				},
+			},
+			wantedErrorMsgPrefix: `"vpc.ipv6" cannot be disabled when "ip" is "dualstack"`,
+		},
+		"succeed on dualstack config": {
+			in: environmentNetworkConfig{
+				IP: stringP("dualstack"),
+			},
+		},
 		"succeed on empty config": {},
 	}
 	for name, tc := range testCases {
@@ -1082,6 +1342,158 @@ func TestEnvironmentHTTPConfig_validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`validate "public": parse IPNet 1.1.1.invalidip: invalid CIDR address: 1.1.1.invalidip`),
 		},
+		"public trust store without a ca bundle": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					TrustStore: TrustStoreConfig{
+						IgnoreClientCertificateExpiry: aws.Bool(true),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": validate "trust_store": "ca_bundle" must be specified`),
+		},
+		"public trust store with a ca bundle that is not an S3 URI": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					TrustStore: TrustStoreConfig{
+						CABundle: aws.String("https://example.com/ca.pem"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": validate "trust_store": "ca_bundle" "https://example.com/ca.pem" must be an S3 URI of the form "s3://bucket/key"`),
+		},
+		"private hosted zone without a name": {
+			in: EnvironmentHTTPConfig{
+				Private: privateHTTPConfig{
+					HostedZone: PrivateHostedZoneConfig{
+						AssociatedVPCs: []string{"vpc-0123"},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "private": validate "hosted_zone": "name" must be specified`),
+		},
+		"private hosted zone with an invalid associated vpc": {
+			in: EnvironmentHTTPConfig{
+				Private: privateHTTPConfig{
+					HostedZone: PrivateHostedZoneConfig{
+						Name:           aws.String("internal.example.com"),
+						AssociatedVPCs: []string{"vpc-0123", "subnet-0123"},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "private": validate "hosted_zone": "associated_vpcs[1]" "subnet-0123" must be a VPC ID`),
+		},
+		"valid private hosted zone": {
+			in: EnvironmentHTTPConfig{
+				Private: privateHTTPConfig{
+					HostedZone: PrivateHostedZoneConfig{
+						Name:           aws.String("internal.example.com"),
+						AssociatedVPCs: []string{"vpc-0123"},
+					},
+				},
+			},
+		},
+		"managed certificate without a hosted zone": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					ManagedCertificates: []ManagedCertificate{
+						{Domain: aws.String("example.com")},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": validate "managed_certificates[0]": "hosted_zone" must be specified`),
+		},
+		"managed certificate with its domain as an alternative name": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					ManagedCertificates: []ManagedCertificate{
+						{
+							Domain:           aws.String("example.com"),
+							HostedZone:       aws.String("Z0123"),
+							AlternativeNames: []string{"www.example.com", "example.com"},
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": validate "managed_certificates[0]": "alternative_names[1]" "example.com" is the domain of the certificate`),
+		},
+		"domain covered by two managed certificates": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					ManagedCertificates: []ManagedCertificate{
+						{Domain: aws.String("example.com"), HostedZone: aws.String("Z0123")},
+						{Domain: aws.String("example.org"), HostedZone: aws.String("Z0456"), AlternativeNames: []string{"example.com"}},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": validate "managed_certificates[1]": domain "example.com" is covered by more than one certificate`),
+		},
+		"success with managed certificates": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					ManagedCertificates: []ManagedCertificate{
+						{Domain: aws.String("example.com"), HostedZone: aws.String("Z0123"), AlternativeNames: []string{"*.example.com"}},
+					},
+				},
+			},
+		},
+		"public waf with a web ACL ARN of the wrong scope": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					WAF: WAF{
+						Union: BasicToUnion[string, WAFManagedRules]("arn:aws:wafv2:us-east-1:123456789012:global/webacl/site/a1b2"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": validate "waf": "arn:aws:wafv2:us-east-1:123456789012:global/webacl/site/a1b2" is not the ARN of a regional AWS WAF web ACL`),
+		},
+		"public waf with a managed rule group specified twice": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					WAF: WAF{
+						Union: AdvancedToUnion[string](WAFManagedRules{
+							ManagedRules: []WAFManagedRule{
+								{Name: aws.String("AWSManagedRulesCommonRuleSet")},
+								{Name: aws.String("AWSManagedRulesCommonRuleSet"), Paths: []string{"/api"}},
+							},
+						}),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": validate "waf": validate "managed_rules[1]": rule group "AWSManagedRulesCommonRuleSet" is specified more than once`),
+		},
+		"public waf with a relative scope-down path": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					WAF: WAF{
+						Union: AdvancedToUnion[string](WAFManagedRules{
+							ManagedRules: []WAFManagedRule{
+								{Name: aws.String("AWSManagedRulesSQLiRuleSet"), Paths: []string{"api"}},
+							},
+						}),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "public": validate "waf": validate "managed_rules[0]": "paths" "api" must start with "/"`),
+		},
+		"success with public waf": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					WAF: WAF{
+						Union: BasicToUnion[string, WAFManagedRules]("arn:aws:wafv2:us-west-2:123456789012:regional/webacl/api/a1b2"),
+					},
+				},
+			},
+		},
+		"success with public trust store": {
+			in: EnvironmentHTTPConfig{
+				Public: PublicHTTPConfig{
+					TrustStore: TrustStoreConfig{
+						CABundle: aws.String("s3://my-bucket/certs/ca.pem"),
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1098,3 +1510,241 @@ func TestEnvironmentHTTPConfig_validate(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironmentImports_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in          environmentImports
+		wantedError string
+	}{
+		"no error if nothing is imported": {},
+		"error if the cluster is an ARN": {
+			in: environmentImports{
+				Cluster: aws.String("arn:aws:ecs:us-west-2:123456789012:cluster/shared"),
+			},
+			wantedError: `"cluster" must be the name of the cluster instead of its ARN`,
+		},
+		"error if the load balancer ARN is missing": {
+			in: environmentImports{
+				PublicALB: importedPublicALB{
+					Listeners: importedListeners{
+						HTTP: importedListener{
+							ARN: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/shared/50dc6c495c0c9188/f2b3c4d5e6f7a8b9"),
+						},
+					},
+				},
+			},
+			wantedError: `validate "public_alb": "arn" must be specified`,
+		},
+		"error if the load balancer ARN is malformed": {
+			in: environmentImports{
+				PublicALB: importedPublicALB{
+					ARN: aws.String("shared"),
+				},
+			},
+			wantedError: `validate "public_alb": parse "arn": arn: invalid prefix`,
+		},
+		"error if the HTTP listener is missing": {
+			in: environmentImports{
+				PublicALB: importedPublicALB{
+					ARN: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/50dc6c495c0c9188"),
+				},
+			},
+			wantedError: `validate "public_alb": validate "listeners": "http.arn" must be specified`,
+		},
+		"error if the HTTP listener has certificates": {
+			in: environmentImports{
+				PublicALB: importedPublicALB{
+					ARN: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/50dc6c495c0c9188"),
+					Listeners: importedListeners{
+						HTTP: importedListener{
+							ARN:          aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/shared/50dc6c495c0c9188/f2b3c4d5e6f7a8b9"),
+							Certificates: []string{"arn:aws:acm:us-west-2:123456789012:certificate/prod"},
+						},
+					},
+				},
+			},
+			wantedError: `validate "public_alb": validate "listeners": "http.certificates" cannot be specified because an HTTP listener does not terminate TLS`,
+		},
+		"error if the certificates of the HTTPS listener are specified without the listener": {
+			in: environmentImports{
+				PublicALB: importedPublicALB{
+					ARN: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/50dc6c495c0c9188"),
+					Listeners: importedListeners{
+						HTTP: importedListener{
+							ARN: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/shared/50dc6c495c0c9188/f2b3c4d5e6f7a8b9"),
+						},
+						HTTPS: importedListener{
+							Certificates: []string{"arn:aws:acm:us-west-2:123456789012:certificate/prod"},
+						},
+					},
+				},
+			},
+			wantedError: `validate "public_alb": validate "listeners": "https.arn" must be specified if "https.certificates" is specified`,
+		},
+		"error if a certificate is malformed": {
+			in: environmentImports{
+				PublicALB: importedPublicALB{
+					ARN: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/50dc6c495c0c9188"),
+					Listeners: importedListeners{
+						HTTP: importedListener{
+							ARN: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/shared/50dc6c495c0c9188/f2b3c4d5e6f7a8b9"),
+						},
+						HTTPS: importedListener{
+							ARN:          aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/shared/50dc6c495c0c9188/a1b2c3d4e5f6a7b8"),
+							Certificates: []string{"arn:aws:weird-little-arn"},
+						},
+					},
+				},
+			},
+			wantedError: `validate "public_alb": validate "listeners": validate "https": parse "certificates[0]": arn: not enough sections`,
+		},
+		"no error with an imported cluster, hosted zone and load balancer": {
+			in: environmentImports{
+				Cluster:    aws.String("shared"),
+				HostedZone: aws.String("Z0123456789ABCDEFGHIJ"),
+				PublicALB: importedPublicALB{
+					ARN: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/shared/50dc6c495c0c9188"),
+					Listeners: importedListeners{
+						HTTP: importedListener{
+							ARN: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/shared/50dc6c495c0c9188/f2b3c4d5e6f7a8b9"),
+						},
+						HTTPS: importedListener{
+							ARN:          aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/shared/50dc6c495c0c9188/a1b2c3d4e5f6a7b8"),
+							Certificates: []string{"arn:aws:acm:us-west-2:123456789012:certificate/prod"},
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.validate()
+			if tc.wantedError != "" {
+				require.EqualError(t, gotErr, tc.wantedError)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestEC2CapacityProvider_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in          EC2CapacityProvider
+		wantedError string
+	}{
+		"no error if no instances are provisioned": {},
+		"error if the instance type is missing": {
+			in: EC2CapacityProvider{
+				MaxSize: aws.Int(4),
+			},
+			wantedError: `"instance_type" must be specified`,
+		},
+		"error if the os is invalid": {
+			in: EC2CapacityProvider{
+				InstanceType: aws.String("m5.large"),
+				OS:           aws.String("windows"),
+			},
+			wantedError: `invalid os "windows", must be one of linux, windows_server_2019_core, windows_server_2019_full, windows_server_2022_core or windows_server_2022_full`,
+		},
+		"error if gpu is enabled on Windows": {
+			in: EC2CapacityProvider{
+				InstanceType: aws.String("g4dn.xlarge"),
+				OS:           aws.String("windows_server_2022_core"),
+				GPU:          aws.Bool(true),
+			},
+			wantedError: `"gpu" cannot be used with "windows_server_2022_core": GPU-optimized AMIs are only available for linux`,
+		},
+		"error if an AMI is specified with the os": {
+			in: EC2CapacityProvider{
+				InstanceType: aws.String("m5.large"),
+				AMI:          aws.String("ami-123"),
+				OS:           aws.String("linux"),
+			},
+			wantedError: `"ami" cannot be specified with "os" or "gpu"`,
+		},
+		"error if min is greater than the default max": {
+			in: EC2CapacityProvider{
+				InstanceType: aws.String("m5.large"),
+				MinSize:      aws.Int(12),
+			},
+			wantedError: `min value 12 cannot be greater than max value 10`,
+		},
+		"valid GPU instances": {
+			in: EC2CapacityProvider{
+				InstanceType: aws.String("g4dn.xlarge"),
+				GPU:          aws.Bool(true),
+				MinSize:      aws.Int(1),
+				MaxSize:      aws.Int(4),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.validate()
+			if tc.wantedError != "" {
+				require.EqualError(t, gotErr, tc.wantedError)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestNotification_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in          Notification
+		wantedError string
+	}{
+		"error if no destination is specified": {
+			in: Notification{
+				Events: []string{"failed"},
+			},
+			wantedError: `one of "sns", "slack" or "webhook" must be specified`,
+		},
+		"error if several destinations are specified": {
+			in: Notification{
+				SNS:   aws.String("arn:aws:sns:us-west-2:123456789012:deployments"),
+				Slack: aws.String("https://hooks.slack.com/services/T0/B0/x"),
+			},
+			wantedError: `must specify one, not both, of "sns" and "slack"`,
+		},
+		"error if the topic is not an ARN": {
+			in: Notification{
+				SNS: aws.String("deployments"),
+			},
+			wantedError: `parse "sns" topic ARN "deployments": arn: invalid prefix`,
+		},
+		"error if the webhook is not served over https": {
+			in: Notification{
+				Webhook: aws.String("http://example.com/hooks"),
+			},
+			wantedError: `"webhook" must be an https URL`,
+		},
+		"error if the message has an unknown placeholder": {
+			in: Notification{
+				Slack:   aws.String("https://hooks.slack.com/services/T0/B0/x"),
+				Message: aws.String("${svc} ${event} in ${env}"),
+			},
+			wantedError: `unknown placeholder "${svc}" in "message", must be one of app, env, event, stack_id, status, reason or time`,
+		},
+		"valid notification": {
+			in: Notification{
+				Events:  []string{"failed", "rolled_back"},
+				Webhook: aws.String("https://example.com/hooks"),
+				Message: aws.String(`{"stack": "${stack_id}", "status": "${status}"}`),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.validate()
+			if tc.wantedError != "" {
+				require.EqualError(t, gotErr, tc.wantedError)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
@@ -6,12 +6,14 @@ package manifest
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestLoadBalancedWebService_validate(t *testing.T) {
@@ -478,6 +480,54 @@ func TestLoadBalancedWebService_validate(t *testing.T) {
 			},
 			wantedError: errors.New(`scaling based on "nlb" requests or response time is not supported`),
 		},
+		"error if http alarms are configured without http": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					HTTPOrBool: HTTPOrBool{
+						Enabled: aws.Bool(false),
+					},
+					NLBConfig: NetworkLoadBalancerConfiguration{
+						Listener: NetworkLoadBalancerListener{
+							Port: aws.String("80"),
+						},
+					},
+					Alarms: HTTPAlarmsConfig{
+						HTTP5xxRate: aws.Float64(5),
+					},
+				},
+			},
+			wantedError: errors.New(`"alarms.http_5xx_rate" and "alarms.response_time" require "http" to be enabled`),
+		},
+		"error if a rollback alarm shorthand refers to an alarm that isn't generated": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					HTTPOrBool: HTTPOrBool{
+						HTTP: HTTP{
+							Main: RoutingRule{
+								Path: stringP("/"),
+							},
+						},
+					},
+					Alarms: HTTPAlarmsConfig{
+						AlarmsConfig: AlarmsConfig{
+							CPUUtilization: aws.Float64(80),
+						},
+					},
+					DeployConfig: DeploymentConfig{
+						RollbackAlarms: BasicToUnion[[]string, AlarmArgs]([]string{"existing-alarm", ".cpu", ".5xx"}),
+					},
+				},
+			},
+			wantedError: errors.New(`"deployment.rollback_alarms" ".5xx" requires "alarms.http_5xx_rate" to be set`),
+		},
 		"error if fail to validate deployment": {
 			lbConfig: LoadBalancedWebService{
 				Workload: Workload{
@@ -500,6 +550,81 @@ func TestLoadBalancedWebService_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "deployment"`,
 		},
+		"error if blue/green deployment with additional routing rules": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					HTTPOrBool: HTTPOrBool{
+						HTTP: HTTP{
+							Main: RoutingRule{
+								Path: stringP("/"),
+							},
+							AdditionalRoutingRules: []RoutingRule{
+								{
+									Path: stringP("/admin"),
+								},
+							},
+						},
+					},
+					DeployConfig: DeploymentConfig{
+						Type: aws.String("blue/green"),
+					},
+				},
+			},
+			wantedError: errors.New(`validate "deployment": "http.additional_rules" cannot be specified when "type" is "blue/green"`),
+		},
+		"error if blue/green deployment with service connect": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					HTTPOrBool: HTTPOrBool{
+						HTTP: HTTP{
+							Main: RoutingRule{
+								Path: stringP("/"),
+							},
+						},
+					},
+					Network: NetworkConfig{
+						Connect: ServiceConnectBoolOrArgs{
+							EnableServiceConnect: aws.Bool(true),
+						},
+					},
+					DeployConfig: DeploymentConfig{
+						Type: aws.String("blue/green"),
+					},
+				},
+			},
+			wantedError: errors.New(`validate "deployment": "network.connect" cannot be enabled when "type" is "blue/green"`),
+		},
+		"ok if blue/green deployment with a single routing rule": {
+			lbConfig: LoadBalancedWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					HTTPOrBool: HTTPOrBool{
+						HTTP: HTTP{
+							Main: RoutingRule{
+								Path: stringP("/"),
+							},
+						},
+					},
+					DeployConfig: DeploymentConfig{
+						Type: aws.String("blue/green"),
+						BlueGreen: BlueGreenDeploymentConfig{
+							TestListenerPort: aws.Uint16(8080),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -569,6 +694,68 @@ func TestBackendService_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "sidecars[foo]": `,
 		},
+		"error if sidecars reserve more cpu than the task": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					TaskConfig: TaskConfig{
+						CPU:    aws.Int(256),
+						Memory: aws.Int(512),
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"nginx": {
+							Image: BasicToUnion[*string, ImageLocationOrBuild](aws.String("public.ecr.aws/nginx/nginx")),
+							CPU:   aws.Int(128),
+						},
+						"xray": {
+							Image: BasicToUnion[*string, ImageLocationOrBuild](aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")),
+							CPU:   aws.Int(256),
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`sidecars reserve 384 CPU units in total, more than the 256 of "cpu"`),
+		},
+		"error if sidecars reserve more memory than the task": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					TaskConfig: TaskConfig{
+						CPU:    aws.Int(256),
+						Memory: aws.Int(512),
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"xray": {
+							Image:             BasicToUnion[*string, ImageLocationOrBuild](aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")),
+							MemoryReservation: aws.Int(1024),
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`sidecars reserve 1024 MiB of memory in total, more than the 512 of "memory"`),
+		},
+		"error if a sidecar writes a secret to a file on Windows": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					TaskConfig: TaskConfig{
+						Platform: PlatformArgsOrString{PlatformString: (*PlatformString)(aws.String("windows/amd64"))},
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"nginx": {
+							Image: BasicToUnion[*string, ImageLocationOrBuild](aws.String("public.ecr.aws/nginx/nginx")),
+							Secrets: map[string]Secret{
+								"TLS_KEY": {
+									fromSecretsManager: secretsManagerSecret{Name: aws.String("demo/tls")},
+									asFile:             aws.String("/etc/ssl/private/key.pem"),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "sidecars[nginx]": "as_file" is not supported for secret TLS_KEY on Windows`),
+		},
 		"error if fail to validate network": {
 			config: BackendService{
 				BackendServiceConfig: BackendServiceConfig{
@@ -680,6 +867,61 @@ func TestBackendService_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate ARM: `,
 		},
+		"error if the tasks on EC2 are placed in public subnets": {
+			config: BackendService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					TaskConfig: TaskConfig{
+						Compute: aws.String("ec2"),
+					},
+				},
+			},
+			wantedError: errors.New(`validate EC2: "network.vpc.placement" must be "private" or a list of subnets when "compute" is "ec2"`),
+		},
+		"error if the tasks on EC2 use Fargate Spot": {
+			config: BackendService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					TaskConfig: TaskConfig{
+						Compute: aws.String("ec2"),
+						Count: Count{
+							AdvancedCount: AdvancedCount{
+								Spot:         aws.Int(2),
+								workloadType: manifestinfo.BackendServiceType,
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate EC2: 'Fargate Spot' is not supported when "compute" is "ec2"`),
+		},
+		"valid tasks on EC2 in private subnets": {
+			config: BackendService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					TaskConfig: TaskConfig{
+						Compute: aws.String("ec2"),
+						GPU:     aws.Int(1),
+					},
+					Network: NetworkConfig{
+						VPC: vpcConfig{
+							Placement: PlacementArgOrString{
+								PlacementString: placementStringP(PrivateSubnetPlacement),
+							},
+						},
+					},
+				},
+			},
+		},
 		"error if fail to validate deployment": {
 			config: BackendService{
 				Workload: Workload{
@@ -704,6 +946,20 @@ func TestBackendService_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "deployment":`,
 		},
+		"error if deployed with blue/green deployments": {
+			config: BackendService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					DeployConfig: DeploymentConfig{
+						Type: aws.String("blue/green"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "deployment": "type" "blue/green" is only supported by Load Balanced Web Services`),
+		},
 		"error if fail to validate http": {
 			config: BackendService{
 				BackendServiceConfig: BackendServiceConfig{
@@ -735,6 +991,49 @@ func TestBackendService_validate(t *testing.T) {
 			},
 			wantedError: errors.New(`"http" must be specified if "count.requests" or "count.response_time" are specified`),
 		},
+		"error if fail to validate api_gateway": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					APIGateway: APIGateway{
+						Protocol: aws.String("grpc"),
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "api_gateway": `,
+		},
+		"error if connection scaling without a websocket api": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					APIGateway: APIGateway{
+						Protocol: aws.String("http"),
+					},
+					TaskConfig: TaskConfig{
+						Count: Count{
+							AdvancedCount: AdvancedCount{
+								workloadType: manifestinfo.BackendServiceType,
+								Connections: ScalingConfigOrT[int]{
+									Value: aws.Int(100),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`"count.connections" can only be specified if "api_gateway.protocol" is "websocket"`),
+		},
+		"error if api_gateway without a port": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					APIGateway: APIGateway{
+						Protocol: aws.String("websocket"),
+					},
+				},
+			},
+			wantedError: errors.New(`"image.port" must be specified if "api_gateway" is specified`),
+		},
 		"error if invalid topic is defined": {
 			config: BackendService{
 				BackendServiceConfig: BackendServiceConfig{
@@ -785,39 +1084,222 @@ func TestBackendService_validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`cannot set "network.connect.alias" when no ports are exposed`),
 		},
-	}
-	for name, tc := range testCases {
-		t.Run(name, func(t *testing.T) {
-			gotErr := tc.config.validate()
-
-			if tc.wantedError != nil {
-				require.EqualError(t, gotErr, tc.wantedError.Error())
-				return
-			}
-			if tc.wantedErrorMsgPrefix != "" {
-				require.Error(t, gotErr)
-				require.Contains(t, gotErr.Error(), tc.wantedErrorMsgPrefix)
-				return
-			}
-			require.NoError(t, gotErr)
-		})
-	}
-}
-
-func TestRequestDrivenWebService_validate(t *testing.T) {
-	testCases := map[string]struct {
-		config RequestDrivenWebService
-
-		wantedError          error
-		wantedErrorMsgPrefix string
-	}{
-		"error if fail to validate image": {
-			config: RequestDrivenWebService{
-				Workload: Workload{
-					Name: aws.String("mockName"),
-				},
-				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
-					ImageConfig: ImageWithPort{
+		"error if service connect tls is enabled without any port exposed": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					Network: NetworkConfig{
+						Connect: ServiceConnectBoolOrArgs{
+							ServiceConnectArgs: ServiceConnectArgs{
+								TLS: aws.Bool(true),
+							},
+						},
+					},
+				},
+				Workload: Workload{
+					Name: aws.String("api"),
+				},
+			},
+			wantedError: fmt.Errorf(`cannot enable "network.connect.tls" when no ports are exposed`),
+		},
+		"error if a service connect port targets a container that doesn't expose a port": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: testImageConfig,
+					Sidecars: map[string]*SidecarConfig{
+						"envoy": {
+							Image: BasicToUnion[*string, ImageLocationOrBuild](aws.String("envoyproxy/envoy")),
+						},
+					},
+					Network: NetworkConfig{
+						Connect: ServiceConnectBoolOrArgs{
+							ServiceConnectArgs: ServiceConnectArgs{
+								Ports: []ServiceConnectPort{
+									{Port: aws.Uint16(9901), TargetContainer: aws.String("envoy")},
+								},
+							},
+						},
+					},
+				},
+				Workload: Workload{
+					Name: aws.String("api"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate Service Connect target for "network.connect.ports[0]": target container "envoy" doesn't expose a port`),
+		},
+		"error if a service connect port is exposed by another container": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: ImageWithHealthcheckAndOptionalPort{
+						ImageWithOptionalPort: ImageWithOptionalPort{
+							Image: testImageConfig.Image,
+							Port:  aws.Uint16(50051),
+						},
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"envoy": {
+							Port:  aws.String("9901"),
+							Image: BasicToUnion[*string, ImageLocationOrBuild](aws.String("envoyproxy/envoy")),
+						},
+					},
+					Network: NetworkConfig{
+						Connect: ServiceConnectBoolOrArgs{
+							ServiceConnectArgs: ServiceConnectArgs{
+								Ports: []ServiceConnectPort{
+									{Port: aws.Uint16(9901), TargetContainer: aws.String("api")},
+								},
+							},
+						},
+					},
+				},
+				Workload: Workload{
+					Name: aws.String("api"),
+				},
+			},
+			wantedErrorMsgPrefix: `validate unique exposed ports: `,
+		},
+		"error if a service connect port is the port of the main endpoint": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: ImageWithHealthcheckAndOptionalPort{
+						ImageWithOptionalPort: ImageWithOptionalPort{
+							Image: testImageConfig.Image,
+							Port:  aws.Uint16(50051),
+						},
+					},
+					Network: NetworkConfig{
+						Connect: ServiceConnectBoolOrArgs{
+							ServiceConnectArgs: ServiceConnectArgs{
+								Ports: []ServiceConnectPort{
+									{Port: aws.Uint16(50051)},
+								},
+							},
+						},
+					},
+				},
+				Workload: Workload{
+					Name: aws.String("api"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "network.connect.ports": port 50051 of "ports[0]" is already the port of the main Service Connect endpoint`),
+		},
+		"error if a service connect port health check replaces the health check command of its container": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: ImageWithHealthcheckAndOptionalPort{
+						ImageWithOptionalPort: ImageWithOptionalPort{
+							Image: testImageConfig.Image,
+							Port:  aws.Uint16(50051),
+						},
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"envoy": {
+							Port:  aws.String("9901"),
+							Image: BasicToUnion[*string, ImageLocationOrBuild](aws.String("envoyproxy/envoy")),
+							HealthCheck: ContainerHealthCheck{
+								Command: []string{"CMD-SHELL", "curl -f http://localhost:9901/ready || exit 1"},
+							},
+						},
+					},
+					Network: NetworkConfig{
+						Connect: ServiceConnectBoolOrArgs{
+							ServiceConnectArgs: ServiceConnectArgs{
+								Ports: []ServiceConnectPort{{
+									Port:            aws.Uint16(9901),
+									TargetContainer: aws.String("envoy"),
+									AppProtocol:     aws.String("http"),
+									HealthCheck:     BasicToUnion[*bool, ServiceConnectPortHealthCheck](aws.Bool(true)),
+								}},
+							},
+						},
+					},
+				},
+				Workload: Workload{
+					Name: aws.String("api"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "network.connect.ports": "ports[0].healthcheck" can't be used with the health check command of container "envoy"`),
+		},
+		"service connect ports exposed by the main container and a sidecar": {
+			config: BackendService{
+				BackendServiceConfig: BackendServiceConfig{
+					ImageConfig: ImageWithHealthcheckAndOptionalPort{
+						ImageWithOptionalPort: ImageWithOptionalPort{
+							Image: testImageConfig.Image,
+							Port:  aws.Uint16(50051),
+						},
+					},
+					HTTP: HTTP{
+						Main: RoutingRule{
+							Path:            aws.String("/"),
+							ProtocolVersion: aws.String("gRPC"),
+						},
+						AdditionalRoutingRules: []RoutingRule{
+							{
+								Path:       aws.String("/admin"),
+								TargetPort: aws.Uint16(8080),
+								HealthCheck: HealthCheckArgsOrString{
+									Union: BasicToUnion[string, HTTPHealthCheckArgs]("/admin/health"),
+								},
+							},
+						},
+					},
+					Sidecars: map[string]*SidecarConfig{
+						"envoy": {
+							Port:  aws.String("9901"),
+							Image: BasicToUnion[*string, ImageLocationOrBuild](aws.String("envoyproxy/envoy")),
+						},
+					},
+					Network: NetworkConfig{
+						Connect: ServiceConnectBoolOrArgs{
+							ServiceConnectArgs: ServiceConnectArgs{
+								Alias: aws.String("api"),
+								Ports: []ServiceConnectPort{
+									{Port: aws.Uint16(8080), Alias: aws.String("api-admin"), AppProtocol: aws.String("http")},
+									{Port: aws.Uint16(9901), TargetContainer: aws.String("envoy")},
+								},
+							},
+						},
+					},
+				},
+				Workload: Workload{
+					Name: aws.String("api"),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.config.validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+				return
+			}
+			if tc.wantedErrorMsgPrefix != "" {
+				require.Error(t, gotErr)
+				require.Contains(t, gotErr.Error(), tc.wantedErrorMsgPrefix)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestRequestDrivenWebService_validate(t *testing.T) {
+	testCases := map[string]struct {
+		config RequestDrivenWebService
+
+		wantedError          error
+		wantedErrorMsgPrefix string
+	}{
+		"error if fail to validate image": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
 						Image: Image{
 							ImageLocationOrBuild: ImageLocationOrBuild{
 								Build:    BuildArgsOrString{BuildString: aws.String("mockBuild")},
@@ -900,6 +1382,74 @@ func TestRequestDrivenWebService_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "observability": `,
 		},
+		"error if otel collector is specified": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							ImageLocationOrBuild: ImageLocationOrBuild{
+								Location: stringP("mockLocation"),
+							},
+						},
+						Port: uint16P(80),
+					},
+					Observability: Observability{
+						Collector: OTelCollectorConfig{
+							Traces: aws.String("awsxray"),
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `"observability.collector" is not supported for Request-Driven Web Service`,
+		},
+		"error if fail to validate count": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							ImageLocationOrBuild: ImageLocationOrBuild{
+								Location: stringP("mockLocation"),
+							},
+						},
+						Port: uint16P(80),
+					},
+					Count: AdvancedToUnion[*string](AppRunnerAutoScaling{
+						MinInstances: aws.Int(5),
+						MaxInstances: aws.Int(2),
+					}),
+				},
+			},
+			wantedErrorMsgPrefix: `validate "count": min value 5 cannot be greater than max value 2`,
+		},
+		"error if the web ACL is not regional": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							ImageLocationOrBuild: ImageLocationOrBuild{
+								Location: stringP("mockLocation"),
+							},
+						},
+						Port: uint16P(80),
+					},
+					RequestDrivenWebServiceHttpConfig: RequestDrivenWebServiceHttpConfig{
+						WAF: WAF{
+							Union: BasicToUnion[string, WAFManagedRules]("arn:aws:wafv2:us-east-1:123456789012:global/webacl/site/a1b2"),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "http": validate "waf": "arn:aws:wafv2:us-east-1:123456789012:global/webacl/site/a1b2" is not the ARN of a regional AWS WAF web ACL`),
+		},
 		"error if name is not set": {
 			config: RequestDrivenWebService{
 				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
@@ -987,6 +1537,17 @@ func TestWorkerService_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "image": `,
 		},
+		"error if a rollback alarm shorthand isn't supported by Worker Services": {
+			config: WorkerService{
+				WorkerServiceConfig: WorkerServiceConfig{
+					ImageConfig: testImageConfig,
+					DeployConfig: WorkerDeploymentConfig{
+						WorkerRollbackAlarms: BasicToUnion[[]string, WorkerAlarmArgs]([]string{".5xx"}),
+					},
+				},
+			},
+			wantedError: errors.New(`"deployment.rollback_alarms" ".5xx" must be the name of an existing alarm or one of ".cpu", ".memory" or ".queue_depth"`),
+		},
 		"error if fail to validate sidecars": {
 			config: WorkerService{
 				WorkerServiceConfig: WorkerServiceConfig{
@@ -1170,6 +1731,42 @@ func TestWorkerService_validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`cannot set "network.connect.alias" when no ports are exposed`),
 		},
+		"error if service connect tls is enabled without any port exposed": {
+			config: WorkerService{
+				WorkerServiceConfig: WorkerServiceConfig{
+					ImageConfig: testImageConfig,
+					Network: NetworkConfig{
+						Connect: ServiceConnectBoolOrArgs{
+							ServiceConnectArgs: ServiceConnectArgs{
+								TLS: aws.Bool(true),
+							},
+						},
+					},
+				},
+				Workload: Workload{
+					Name: aws.String("api"),
+				},
+			},
+			wantedError: fmt.Errorf(`cannot enable "network.connect.tls" when no ports are exposed`),
+		},
+		"error if service connect ports are specified": {
+			config: WorkerService{
+				WorkerServiceConfig: WorkerServiceConfig{
+					ImageConfig: testImageConfig,
+					Network: NetworkConfig{
+						Connect: ServiceConnectBoolOrArgs{
+							ServiceConnectArgs: ServiceConnectArgs{
+								Ports: []ServiceConnectPort{{Port: aws.Uint16(8080)}},
+							},
+						},
+					},
+				},
+				Workload: Workload{
+					Name: aws.String("api"),
+				},
+			},
+			wantedError: fmt.Errorf(`"network.connect.ports" is only supported by Backend Services`),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1396,8 +1993,144 @@ func TestPipelineManifest_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "deployments" for pipeline stage test:`,
 		},
-	}
-	for name, tc := range testCases {
+		"error if approval notifications are configured without requiring approval": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name: "prod",
+						Approval: ApprovalConfig{
+							Emails: []string{"dev@example.com"},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "approval" for pipeline stage prod: "requires_approval" must be true to configure approval notifications`),
+		},
+		"error if both topic and emails are specified": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name:             "prod",
+						RequiresApproval: true,
+						Approval: ApprovalConfig{
+							Topic:  "arn:aws:sns:us-west-2:123456789012:approvals",
+							Emails: []string{"dev@example.com"},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "approval" for pipeline stage prod: must specify one, not both, of "topic" and "emails"`),
+		},
+		"error if topic is not an SNS topic ARN": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name:             "prod",
+						RequiresApproval: true,
+						Approval: ApprovalConfig{
+							Topic: "arn:aws:sqs:us-west-2:123456789012:approvals",
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "approval" for pipeline stage prod: validate "topic": "arn:aws:sqs:us-west-2:123456789012:approvals" is not a valid SNS topic ARN`),
+		},
+		"error if an email address is invalid": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name:             "prod",
+						RequiresApproval: true,
+						Approval: ApprovalConfig{
+							Emails: []string{"dev"},
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "approval" for pipeline stage prod: validate "emails": "dev" is not a valid email address`),
+		},
+		"error if test is configured without test commands or a buildspec": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						Test: StageTest{
+							Image: "aws/codebuild/standard:7.0",
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "test" for pipeline stage test: must specify one of "test_commands" and "test.buildspec"`),
+		},
+		"error if both test commands and a buildspec are specified": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name:         "test",
+						TestCommands: []string{"make smoke-test"},
+						Test: StageTest{
+							Buildspec: "copilot/pipelines/release/smoke.yml",
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "test" for pipeline stage test: must specify one, not both, of "test_commands" and "test.buildspec"`),
+		},
+		"error if compute type is invalid": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name:         "test",
+						TestCommands: []string{"make smoke-test"},
+						Test: StageTest{
+							ComputeType: "BUILD_GENERAL1_HUGE",
+						},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "test" for pipeline stage test: validate "compute_type": "BUILD_GENERAL1_HUGE" must be one of BUILD_GENERAL1_SMALL, BUILD_GENERAL1_MEDIUM, BUILD_GENERAL1_LARGE or BUILD_GENERAL1_2XLARGE`),
+		},
+		"valid test with a buildspec": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						Test: StageTest{
+							Buildspec:   "copilot/pipelines/release/smoke.yml",
+							ComputeType: "BUILD_GENERAL1_MEDIUM",
+							Variables: map[string]string{
+								"ENDPOINT": "https://test.example.com",
+							},
+						},
+					},
+				},
+			},
+		},
+		"valid approval notifications": {
+			Pipeline: Pipeline{
+				Name: "release",
+				Stages: []PipelineStage{
+					{
+						Name:             "prod",
+						RequiresApproval: true,
+						Approval: ApprovalConfig{
+							Emails:  []string{"dev@example.com"},
+							Message: "Check the test environment before approving.",
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
 			gotErr := tc.Pipeline.Validate()
 
@@ -1514,6 +2247,177 @@ func TestImage_validate(t *testing.T) {
 
 			wantedErrorMsgPrefix: `validate "depends_on":`,
 		},
+		"error if builder is not supported": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				Builder: aws.String("kaniko"),
+			},
+			wantedError: fmt.Errorf(`validate "builder": invalid image builder "kaniko", must be one of docker, podman, nerdctl or buildx`),
+		},
+		"success with builder": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				Builder: aws.String("podman"),
+			},
+		},
+		"error if platforms are specified with location": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Location: aws.String("mockLocation"),
+				},
+				Platforms: []string{"linux/amd64"},
+			},
+			wantedError: fmt.Errorf(`validate "platforms": must specify one, not both, of "platforms" and "location"`),
+		},
+		"error if a platform is invalid": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				Platforms: []string{"linux/amd64", "linux"},
+			},
+			wantedError: fmt.Errorf(`validate "platforms": platform 'linux' must be in the format [OS]/[Arch]`),
+		},
+		"error if a platform is not linux": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				Platforms: []string{"linux/amd64", "windows/amd64"},
+			},
+			wantedError: fmt.Errorf(`validate "platforms": platform "windows/amd64" is not supported: multi-platform images can only be built for linux`),
+		},
+		"error if the builder can't build for multiple platforms": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				Builder:   aws.String("docker"),
+				Platforms: []string{"linux/amd64", "linux/arm64"},
+			},
+			wantedError: fmt.Errorf(`validate "platforms": image builder "docker" can't build an image for multiple platforms, use "buildx" instead`),
+		},
+		"success with multiple platforms": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				Platforms: []string{"linux/amd64", "linux/arm64"},
+			},
+		},
+		"error if both a KMS key and keyless signing are specified": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				Signing: ImageSigning{
+					KMSKey:  aws.String("alias/signing"),
+					Keyless: aws.Bool(true),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "signing": must specify one, not both, of "kms_key" and "keyless"`),
+		},
+		"error if signing is enabled for an existing image": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Location: aws.String("mockLocation"),
+				},
+				Signing: ImageSigning{
+					Keyless: aws.Bool(true),
+				},
+			},
+			wantedError: fmt.Errorf(`must specify one, not both, of "signing" and "location"`),
+		},
+		"success with a KMS signing key": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				Signing: ImageSigning{
+					KMSKey: aws.String("alias/signing"),
+				},
+			},
+		},
+		"error if the scan severity is invalid": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				Scan: ImageScan{
+					BlockOn: aws.String("severe"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "scan": invalid "block_on" severity "severe", must be one of critical, high, medium, low or informational`),
+		},
+		"error if an existing image is scanned": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Location: aws.String("mockLocation"),
+				},
+				Scan: ImageScan{
+					BlockOn: aws.String("critical"),
+				},
+			},
+			wantedError: fmt.Errorf(`must specify one, not both, of "scan" and "location"`),
+		},
+		"success with a scan gate": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				Scan: ImageScan{
+					BlockOn: aws.String("high"),
+				},
+			},
+		},
+		"error if the sbom format is invalid": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Build: BuildArgsOrString{
+						BuildString: aws.String("mockBuild"),
+					},
+				},
+				SBOM: ImageSBOM{
+					Format: aws.String("swid"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "sbom": invalid "format" "swid", must be one of spdx or cyclonedx`),
+		},
+		"error if the sbom of an existing image is generated": {
+			Image: Image{
+				ImageLocationOrBuild: ImageLocationOrBuild{
+					Location: aws.String("mockLocation"),
+				},
+				SBOM: ImageSBOM{
+					Format: aws.String("spdx"),
+				},
+			},
+			wantedError: fmt.Errorf(`must specify one, not both, of "sbom" and "location"`),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1583,6 +2487,28 @@ func TestRoutingRule_validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `"version" field value 'quic' must be one of GRPC, HTTP1 or HTTP2`,
 		},
+		"error if grpc is configured without redirecting http to https": {
+			RoutingRule: RoutingRule{
+				Path:            stringP("helloworld.Greeter"),
+				ProtocolVersion: aws.String("grpc"),
+				RedirectToHTTPS: aws.Bool(false),
+			},
+			wantedErrorMsgPrefix: `"redirect_to_https" must be true if "version" is gRPC: the load balancer only supports gRPC over HTTPS`,
+		},
+		"error if auth is configured without redirecting http to https": {
+			RoutingRule: RoutingRule{
+				Path:            stringP("/"),
+				RedirectToHTTPS: aws.Bool(false),
+				Auth: HTTPAuth{
+					Cognito: CognitoAuth{
+						UserPool: aws.String("arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_abc"),
+						ClientID: aws.String("client"),
+						Domain:   aws.String("login"),
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `"redirect_to_https" must be true if "auth" is specified`,
+		},
 		"error if path is missing": {
 			RoutingRule: RoutingRule{
 				ProtocolVersion: aws.String("GRPC"),
@@ -1657,6 +2583,74 @@ func TestRoutingRule_validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`validate condition values per listener rule: listener rule has more than five conditions example.com, v1.example.com, v2.example.com, v3.example.com and v4.example.com `),
 		},
+		"error if there are too many hosts and allowed source ips": {
+			RoutingRule: RoutingRule{
+				Path:             stringP("/"),
+				Hosts:            []string{"example.com", "www.example.com", "api.example.com"},
+				AllowedSourceIps: []IPNet{IPNet("10.1.0.0/24"), IPNet("10.1.1.0/24")},
+			},
+			wantedError: fmt.Errorf(`validate condition values per listener rule: listener rule for path "/" has more than five condition values in "hosts" and "allowed_source_ips"`),
+		},
+		"error if weight is specified without weighted targets": {
+			RoutingRule: RoutingRule{
+				Path:   stringP("/"),
+				Weight: aws.Int(90),
+			},
+			wantedError: fmt.Errorf(`"weighted_targets" must be specified if "weight" is specified`),
+		},
+		"error if weight is missing with weighted targets": {
+			RoutingRule: RoutingRule{
+				Path: stringP("/"),
+				WeightedTargets: []WeightedTarget{
+					{TargetContainer: aws.String("web-v2"), Weight: aws.Int(10)},
+				},
+			},
+			wantedError: fmt.Errorf(`"weight" must be specified if "weighted_targets" is specified`),
+		},
+		"error if weight is out of range": {
+			RoutingRule: RoutingRule{
+				Path:   stringP("/"),
+				Weight: aws.Int(1000),
+				WeightedTargets: []WeightedTarget{
+					{TargetContainer: aws.String("web-v2"), Weight: aws.Int(10)},
+				},
+			},
+			wantedError: fmt.Errorf(`"weight" 1000 must be between 0 and 999`),
+		},
+		"error if a weighted target has no container or port": {
+			RoutingRule: RoutingRule{
+				Path:   stringP("/"),
+				Weight: aws.Int(90),
+				WeightedTargets: []WeightedTarget{
+					{Weight: aws.Int(10)},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "weighted_targets[0]": must specify at least one of "target_container" or "target_port"`),
+		},
+		"error if there are too many weighted targets": {
+			RoutingRule: RoutingRule{
+				Path:   stringP("/"),
+				Weight: aws.Int(1),
+				WeightedTargets: []WeightedTarget{
+					{TargetPort: aws.Uint16(81), Weight: aws.Int(1)},
+					{TargetPort: aws.Uint16(82), Weight: aws.Int(1)},
+					{TargetPort: aws.Uint16(83), Weight: aws.Int(1)},
+					{TargetPort: aws.Uint16(84), Weight: aws.Int(1)},
+					{TargetPort: aws.Uint16(85), Weight: aws.Int(1)},
+				},
+			},
+			wantedError: fmt.Errorf(`"weighted_targets" cannot have more than 4 targets`),
+		},
+		"valid hosts and weighted targets": {
+			RoutingRule: RoutingRule{
+				Path:   stringP("/"),
+				Hosts:  []string{"example.com"},
+				Weight: aws.Int(90),
+				WeightedTargets: []WeightedTarget{
+					{TargetContainer: aws.String("web-v2"), Weight: aws.Int(10)},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1707,28 +2701,338 @@ func TestHTTP_validate(t *testing.T) {
 					Path:            stringP("/"),
 					TargetContainer: aws.String("mockContainer"),
 				},
-				AdditionalRoutingRules: []RoutingRule{
-					{
-						TargetContainer: aws.String("mockContainer"),
-					},
+				AdditionalRoutingRules: []RoutingRule{
+					{
+						TargetContainer: aws.String("mockContainer"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "additional_rules[0]": "path" must be specified`),
+		},
+		"error if a static rule is invalid": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path: stringP("/"),
+				},
+				StaticRules: []StaticRule{
+					{
+						Path: stringP("/"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "static_rules[0]": must specify one of "redirect" and "fixed_response"`),
+		},
+		"error if a static rule shadows a routing rule": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path: stringP("/"),
+				},
+				AdditionalRoutingRules: []RoutingRule{
+					{
+						Path: stringP("/api"),
+					},
+				},
+				StaticRules: []StaticRule{
+					{
+						Path: stringP("api"),
+						FixedResponse: HTTPFixedResponse{
+							StatusCode: aws.Int(503),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "static_rules[0]": "path" "api" is already routed to the service: only the root path "/" can be shared with a routing rule`),
+		},
+		"valid static rules": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path: stringP("/"),
+				},
+				StaticRules: []StaticRule{
+					{
+						Path:  stringP("/"),
+						Hosts: []string{"www.example.com"},
+						Redirect: HTTPRedirect{
+							Protocol: aws.String("https"),
+							Host:     aws.String("example.com"),
+						},
+					},
+					{
+						Path: stringP("/maintenance"),
+						FixedResponse: HTTPFixedResponse{
+							StatusCode:  aws.Int(503),
+							ContentType: aws.String("text/plain"),
+							Body:        aws.String("Down for maintenance"),
+						},
+					},
+				},
+			},
+		},
+		"error if the client auth mode is invalid": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path: stringP("/"),
+				},
+				TLS: HTTPTLSConfig{
+					ClientAuth: aws.String("optional"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "tls": invalid "client_auth" "optional", must be one of verify or passthrough`),
+		},
+		"valid client auth mode": {
+			HTTP: HTTP{
+				Main: RoutingRule{
+					Path: stringP("/"),
+				},
+				TLS: HTTPTLSConfig{
+					ClientAuth: aws.String("verify"),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.HTTP.validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+				return
+			}
+			if tc.wantedErrorMsgPrefix != "" {
+				require.Error(t, gotErr)
+				require.Contains(t, gotErr.Error(), tc.wantedErrorMsgPrefix)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestStaticRule_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in          StaticRule
+		wantedError error
+	}{
+		"error if path is missing": {
+			in: StaticRule{
+				FixedResponse: HTTPFixedResponse{
+					StatusCode: aws.Int(404),
+				},
+			},
+			wantedError: errors.New(`"path" must be specified`),
+		},
+		"error if both redirect and fixed_response are specified": {
+			in: StaticRule{
+				Path: stringP("/"),
+				Redirect: HTTPRedirect{
+					Host: aws.String("example.com"),
+				},
+				FixedResponse: HTTPFixedResponse{
+					StatusCode: aws.Int(404),
+				},
+			},
+			wantedError: errors.New(`must specify one of "redirect" and "fixed_response"`),
+		},
+		"error if the redirect doesn't change the url": {
+			in: StaticRule{
+				Path: stringP("/"),
+				Redirect: HTTPRedirect{
+					StatusCode: aws.Int(302),
+				},
+			},
+			wantedError: errors.New(`validate "redirect": must specify at least one of "protocol", "host", "port", "path" or "query"`),
+		},
+		"error if the redirect protocol is invalid": {
+			in: StaticRule{
+				Path: stringP("/"),
+				Redirect: HTTPRedirect{
+					Protocol: aws.String("ftp"),
+				},
+			},
+			wantedError: errors.New(`validate "redirect": invalid "protocol" "ftp", must be one of HTTP or HTTPS`),
+		},
+		"error if the redirect path is relative": {
+			in: StaticRule{
+				Path: stringP("/"),
+				Redirect: HTTPRedirect{
+					Path: aws.String("home"),
+				},
+			},
+			wantedError: errors.New(`validate "redirect": "path" "home" must start with "/"`),
+		},
+		"error if the redirect status code is invalid": {
+			in: StaticRule{
+				Path: stringP("/"),
+				Redirect: HTTPRedirect{
+					Host:       aws.String("example.com"),
+					StatusCode: aws.Int(307),
+				},
+			},
+			wantedError: errors.New(`validate "redirect": invalid "status_code" 307, must be one of 301 or 302`),
+		},
+		"error if the fixed response status code is missing": {
+			in: StaticRule{
+				Path: stringP("/"),
+				FixedResponse: HTTPFixedResponse{
+					Body: aws.String("hello"),
+				},
+			},
+			wantedError: errors.New(`validate "fixed_response": "status_code" must be specified`),
+		},
+		"error if the fixed response status code is a redirect": {
+			in: StaticRule{
+				Path: stringP("/"),
+				FixedResponse: HTTPFixedResponse{
+					StatusCode: aws.Int(301),
+				},
+			},
+			wantedError: errors.New(`validate "fixed_response": invalid "status_code" 301, must be a 2XX, 4XX or 5XX status code`),
+		},
+		"error if the fixed response content type is invalid": {
+			in: StaticRule{
+				Path: stringP("/"),
+				FixedResponse: HTTPFixedResponse{
+					StatusCode:  aws.Int(200),
+					ContentType: aws.String("image/png"),
+				},
+			},
+			wantedError: errors.New(`validate "fixed_response": invalid "content_type" "image/png", must be one of text/plain, text/css, text/html, application/javascript or application/json`),
+		},
+		"error if the fixed response body is too long": {
+			in: StaticRule{
+				Path: stringP("/"),
+				FixedResponse: HTTPFixedResponse{
+					StatusCode: aws.Int(200),
+					Body:       aws.String(strings.Repeat("a", 1025)),
+				},
+			},
+			wantedError: errors.New(`validate "fixed_response": "body" cannot be longer than 1024 characters`),
+		},
+		"valid redirect": {
+			in: StaticRule{
+				Path:  stringP("/"),
+				Hosts: []string{"www.example.com"},
+				Redirect: HTTPRedirect{
+					Protocol:   aws.String("HTTPS"),
+					Host:       aws.String("example.com"),
+					StatusCode: aws.Int(302),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHTTPAuth_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in          HTTPAuth
+		wantedError error
+	}{
+		"error if both oidc and cognito are specified": {
+			in: HTTPAuth{
+				OIDC: OIDCAuth{
+					Issuer: aws.String("https://idp.example.com"),
+				},
+				Cognito: CognitoAuth{
+					Domain: aws.String("login"),
+				},
+			},
+			wantedError: fmt.Errorf(`must specify one of "oidc" and "cognito"`),
+		},
+		"error if neither oidc nor cognito are specified": {
+			in: HTTPAuth{
+				Scope: aws.String("openid"),
+			},
+			wantedError: fmt.Errorf(`must specify one of "oidc" and "cognito"`),
+		},
+		"error if an oidc field is missing": {
+			in: HTTPAuth{
+				OIDC: OIDCAuth{
+					Issuer:                aws.String("https://idp.example.com"),
+					AuthorizationEndpoint: aws.String("https://idp.example.com/authorize"),
+					TokenEndpoint:         aws.String("https://idp.example.com/token"),
+					UserInfoEndpoint:      aws.String("https://idp.example.com/userinfo"),
+					ClientID:              aws.String("client"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "oidc": "client_secret" must be specified`),
+		},
+		"error if an oidc endpoint is not https": {
+			in: HTTPAuth{
+				OIDC: OIDCAuth{
+					Issuer:                aws.String("https://idp.example.com"),
+					AuthorizationEndpoint: aws.String("https://idp.example.com/authorize"),
+					TokenEndpoint:         aws.String("http://idp.example.com/token"),
+					UserInfoEndpoint:      aws.String("https://idp.example.com/userinfo"),
+					ClientID:              aws.String("client"),
+					ClientSecret:          aws.String("idp-client-secret"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "oidc": "token_endpoint" "http://idp.example.com/token" must be an HTTPS URL`),
+		},
+		"error if the cognito user pool is not an arn": {
+			in: HTTPAuth{
+				Cognito: CognitoAuth{
+					UserPool: aws.String("us-west-2_abc"),
+					ClientID: aws.String("client"),
+					Domain:   aws.String("login"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "cognito": "user_pool" "us-west-2_abc" must be the ARN of a Cognito user pool`),
+		},
+		"error if the cognito domain is missing": {
+			in: HTTPAuth{
+				Cognito: CognitoAuth{
+					UserPool: aws.String("arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_abc"),
+					ClientID: aws.String("client"),
+				},
+			},
+			wantedError: fmt.Errorf(`validate "cognito": "domain" must be specified`),
+		},
+		"error if the session timeout is too long": {
+			in: HTTPAuth{
+				Cognito: CognitoAuth{
+					UserPool: aws.String("arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_abc"),
+					ClientID: aws.String("client"),
+					Domain:   aws.String("login"),
+				},
+				SessionTimeout: durationp(8 * 24 * time.Hour),
+			},
+			wantedError: fmt.Errorf(`"session_timeout" 192h0m0s must be between 1s and 168h0m0s`),
+		},
+		"valid oidc": {
+			in: HTTPAuth{
+				OIDC: OIDCAuth{
+					Issuer:                aws.String("https://idp.example.com"),
+					AuthorizationEndpoint: aws.String("https://idp.example.com/authorize"),
+					TokenEndpoint:         aws.String("https://idp.example.com/token"),
+					UserInfoEndpoint:      aws.String("https://idp.example.com/userinfo"),
+					ClientID:              aws.String("client"),
+					ClientSecret:          aws.String("idp-client-secret"),
 				},
+				Scope:          aws.String("openid email"),
+				SessionTimeout: durationp(8 * time.Hour),
 			},
-			wantedError: fmt.Errorf(`validate "additional_rules[0]": "path" must be specified`),
 		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			gotErr := tc.HTTP.validate()
+			gotErr := tc.in.validate()
 
 			if tc.wantedError != nil {
 				require.EqualError(t, gotErr, tc.wantedError.Error())
 				return
 			}
-			if tc.wantedErrorMsgPrefix != "" {
-				require.Error(t, gotErr)
-				require.Contains(t, gotErr.Error(), tc.wantedErrorMsgPrefix)
-				return
-			}
 			require.NoError(t, gotErr)
 		})
 	}
@@ -2068,6 +3372,119 @@ func TestTaskConfig_validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("environment file foo must have a .env file extension"),
 		},
+		"error if a secret is written to a relative path": {
+			TaskConfig: TaskConfig{
+				Secrets: map[string]Secret{
+					"TLS_KEY": {
+						fromSecretsManager: secretsManagerSecret{Name: aws.String("demo/tls")},
+						asFile:             aws.String("certs/key.pem"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "secret": validate "as_file": path "certs/key.pem" must be an absolute path to a file`),
+		},
+		"error if a secret is written to a path with invalid characters": {
+			TaskConfig: TaskConfig{
+				Secrets: map[string]Secret{
+					"TLS_KEY": {
+						fromSecretsManager: secretsManagerSecret{Name: aws.String("demo/tls")},
+						asFile:             aws.String("/etc/ssl/$(id).pem"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "secret": validate "as_file": path can only contain the characters a-zA-Z0-9.-_/`),
+		},
+		"error if two secrets are written to the same file": {
+			TaskConfig: TaskConfig{
+				Secrets: map[string]Secret{
+					"TLS_KEY": {
+						fromSecretsManager: secretsManagerSecret{Name: aws.String("demo/tls")},
+						asFile:             aws.String("/etc/ssl/private/key.pem"),
+					},
+					"OLD_TLS_KEY": {
+						from:   stringOrFromCFN{Plain: aws.String("/demo/tls")},
+						asFile: aws.String("/etc/ssl/private/key.pem"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "secrets": secrets OLD_TLS_KEY and TLS_KEY cannot both be written to "/etc/ssl/private/key.pem"`),
+		},
+		"error if a secret is written to a file on Windows": {
+			TaskConfig: TaskConfig{
+				Platform: PlatformArgsOrString{
+					PlatformString: (*PlatformString)(aws.String("windows/amd64")),
+				},
+				Secrets: map[string]Secret{
+					"TLS_KEY": {
+						fromSecretsManager: secretsManagerSecret{Name: aws.String("demo/tls")},
+						asFile:             aws.String("/etc/ssl/private/key.pem"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`"as_file" is not supported for secret TLS_KEY on Windows`),
+		},
+		"error if a reference to a CloudFormation export is embedded in a variable": {
+			TaskConfig: TaskConfig{
+				Variables: map[string]Variable{
+					"API_URL": {
+						stringOrFromCFN{
+							Plain: aws.String("https://${cfn:prod-ApiHost}/v1"),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "API_URL" "variables": reference ${cfn:prod-ApiHost} to a CloudFormation export must be the entire value`),
+		},
+		"error if invalid env file for variables": {
+			TaskConfig: TaskConfig{
+				VariablesFromEnvFile: aws.String("config/prod.yml"),
+			},
+			wantedError: fmt.Errorf(`validate "variables_from_env_file": environment file config/prod.yml must have a .env file extension`),
+		},
+		"error if the task role is not an IAM role ARN": {
+			TaskConfig: TaskConfig{
+				TaskRole: aws.String("arn:aws:iam::123456789012:policy/my-policy"),
+			},
+			wantedError: fmt.Errorf(`validate "task_role": "arn:aws:iam::123456789012:policy/my-policy" is not a valid IAM role ARN`),
+		},
+		"error if the execution role is not an ARN": {
+			TaskConfig: TaskConfig{
+				ExecutionRole: aws.String("my-execution-role"),
+			},
+			wantedError: fmt.Errorf(`validate "execution_role": "my-execution-role" is not a valid IAM role ARN`),
+		},
+		"error if the permissions boundary is not an IAM policy ARN": {
+			TaskConfig: TaskConfig{
+				PermissionsBoundary: aws.String("arn:aws:sns:us-west-2:123456789012:my-topic"),
+			},
+			wantedError: fmt.Errorf(`validate "permissions_boundary": "arn:aws:sns:us-west-2:123456789012:my-topic" is not a valid IAM policy ARN`),
+		},
+		"error if compute is invalid": {
+			TaskConfig: TaskConfig{
+				Compute: aws.String("lambda"),
+			},
+			wantedError: fmt.Errorf(`invalid compute "lambda", must be one of fargate or ec2`),
+		},
+		"error if gpu is not positive": {
+			TaskConfig: TaskConfig{
+				Compute: aws.String("ec2"),
+				GPU:     aws.Int(0),
+			},
+			wantedError: fmt.Errorf(`"gpu" must be greater than 0`),
+		},
+		"error if gpu is reserved on Fargate": {
+			TaskConfig: TaskConfig{
+				GPU: aws.Int(1),
+			},
+			wantedError: fmt.Errorf(`"gpu" requires "compute" to be "ec2"`),
+		},
+		"valid existing roles and permissions boundary": {
+			TaskConfig: TaskConfig{
+				TaskRole:            aws.String("arn:aws:iam::123456789012:role/central/my-task-role"),
+				ExecutionRole:       aws.String("arn:aws:iam::123456789012:role/my-execution-role"),
+				PermissionsBoundary: aws.String("my-boundary"),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2302,7 +3719,84 @@ func TestAdvancedCount_validate(t *testing.T) {
 				CPU:          mockConfig,
 				workloadType: manifestinfo.LoadBalancedWebServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify one, not both, of "spot" and "range/cpu_percentage/memory_percentage/requests/response_time"`),
+			wantedError: fmt.Errorf(`must specify one, not both, of "spot" and "range/cpu_percentage/memory_percentage/requests/response_time/metrics/step_scaling/schedules"`),
+		},
+		"error if both spot and capacity are specified": {
+			AdvancedCount: AdvancedCount{
+				Spot: aws.Int(2),
+				Capacity: CapacityStrategy{
+					SpotWeight: aws.Int(1),
+				},
+				workloadType: manifestinfo.LoadBalancedWebServiceType,
+			},
+			wantedError: fmt.Errorf(`must specify one, not both, of "spot" and "capacity"`),
+		},
+		"error if both spot_from and capacity are specified": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					RangeConfig: RangeConfig{
+						Min:      aws.Int(1),
+						Max:      aws.Int(10),
+						SpotFrom: aws.Int(3),
+					},
+				},
+				CPU: mockConfig,
+				Capacity: CapacityStrategy{
+					SpotWeight: aws.Int(1),
+				},
+				workloadType: manifestinfo.LoadBalancedWebServiceType,
+			},
+			wantedError: fmt.Errorf(`must specify one, not both, of "range.spot_from" and "capacity"`),
+		},
+		"error if capacity is specified without range": {
+			AdvancedCount: AdvancedCount{
+				Capacity: CapacityStrategy{
+					OnDemandBase: aws.Int(1),
+				},
+				workloadType: manifestinfo.BackendServiceType,
+			},
+			wantedError: fmt.Errorf(`"range" must be specified if "capacity" is specified`),
+		},
+		"error if a capacity weight is negative": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(aws.String("1-10")),
+				},
+				CPU: mockConfig,
+				Capacity: CapacityStrategy{
+					SpotWeight: aws.Int(-1),
+				},
+				workloadType: manifestinfo.LoadBalancedWebServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "capacity": on_demand_base value 0, on_demand_weight value 0, and spot_weight value -1 must all be positive`),
+		},
+		"error if every capacity weight is zero": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(aws.String("1-10")),
+				},
+				CPU: mockConfig,
+				Capacity: CapacityStrategy{
+					OnDemandWeight: aws.Int(0),
+					SpotWeight:     aws.Int(0),
+				},
+				workloadType: manifestinfo.LoadBalancedWebServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "capacity": at least one of "on_demand_weight" and "spot_weight" must be greater than 0`),
+		},
+		"success with a weighted capacity strategy": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(aws.String("1-10")),
+				},
+				CPU: mockConfig,
+				Capacity: CapacityStrategy{
+					OnDemandBase:   aws.Int(2),
+					OnDemandWeight: aws.Int(1),
+					SpotWeight:     aws.Int(3),
+				},
+				workloadType: manifestinfo.LoadBalancedWebServiceType,
+			},
 		},
 		"error if fail to validate range": {
 			AdvancedCount: AdvancedCount{
@@ -2320,7 +3814,7 @@ func TestAdvancedCount_validate(t *testing.T) {
 				},
 				workloadType: manifestinfo.LoadBalancedWebServiceType,
 			},
-			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage", "memory_percentage", "requests" or "response_time" are specified`),
+			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage", "memory_percentage", "requests", "response_time", "metrics", "step_scaling" or "schedules" are specified`),
 		},
 		"error if range is specified but no autoscaling fields are specified for a Load Balanced Web Service": {
 			AdvancedCount: AdvancedCount{
@@ -2329,7 +3823,7 @@ func TestAdvancedCount_validate(t *testing.T) {
 				},
 				workloadType: manifestinfo.LoadBalancedWebServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests" or "response_time" if "range" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests", "response_time", "metrics", "step_scaling" or "schedules" if "range" is specified`),
 		},
 		"error if range is specified but no autoscaling fields are specified for a Backend Service": {
 			AdvancedCount: AdvancedCount{
@@ -2338,7 +3832,7 @@ func TestAdvancedCount_validate(t *testing.T) {
 				},
 				workloadType: manifestinfo.BackendServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests" or "response_time" if "range" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests", "response_time", "connections", "metrics", "step_scaling" or "schedules" if "range" is specified`),
 		},
 		"error if range is specified but no autoscaling fields are specified for a Worker Service": {
 			AdvancedCount: AdvancedCount{
@@ -2347,42 +3841,52 @@ func TestAdvancedCount_validate(t *testing.T) {
 				},
 				workloadType: manifestinfo.WorkerServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage" or "queue_delay" if "range" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "queue_delay", "metrics", "step_scaling" or "schedules" if "range" is specified`),
 		},
 		"error if cooldown is specified but no autoscaling fields are specified for a Load Balanced Web Service": {
 			AdvancedCount: AdvancedCount{
 				Cooldown:     mockCooldown,
 				workloadType: manifestinfo.LoadBalancedWebServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests" or "response_time" if "cooldown" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests", "response_time", "metrics", "step_scaling" or "schedules" if "cooldown" is specified`),
 		},
 		"error if cooldown is specified but no autoscaling fields are specified for a Backend Service": {
 			AdvancedCount: AdvancedCount{
 				Cooldown:     mockCooldown,
 				workloadType: manifestinfo.BackendServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests" or "response_time" if "cooldown" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "requests", "response_time", "connections", "metrics", "step_scaling" or "schedules" if "cooldown" is specified`),
 		},
 		"error if cooldown is specified but no autoscaling fields are specified for a Worker Service": {
 			AdvancedCount: AdvancedCount{
 				Cooldown:     mockCooldown,
 				workloadType: manifestinfo.WorkerServiceType,
 			},
-			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage" or "queue_delay" if "cooldown" is specified`),
+			wantedError: fmt.Errorf(`must specify at least one of "cpu_percentage", "memory_percentage", "queue_delay", "metrics", "step_scaling" or "schedules" if "cooldown" is specified`),
 		},
 		"error if range is missing when autoscaling fields are set for Backend Service": {
 			AdvancedCount: AdvancedCount{
 				CPU:          mockConfig,
 				workloadType: manifestinfo.BackendServiceType,
 			},
-			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage", "memory_percentage", "requests" or "response_time" are specified`),
+			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage", "memory_percentage", "requests", "response_time", "connections", "metrics", "step_scaling" or "schedules" are specified`),
+		},
+		"error if connections is set for a Load Balanced Web Service": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(aws.String("1-10")),
+				},
+				Connections:  ScalingConfigOrT[int]{Value: aws.Int(100)},
+				workloadType: manifestinfo.LoadBalancedWebServiceType,
+			},
+			wantedError: fmt.Errorf(`autoscaling field "connections" is invalid with workload type Load Balanced Web Service`),
 		},
 		"error if range is missing when autoscaling fields are set for Worker Service": {
 			AdvancedCount: AdvancedCount{
 				CPU:          mockConfig,
 				workloadType: manifestinfo.WorkerServiceType,
 			},
-			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage", "memory_percentage" or "queue_delay" are specified`),
+			wantedError: fmt.Errorf(`"range" must be specified if "cpu_percentage", "memory_percentage", "queue_delay", "metrics", "step_scaling" or "schedules" are specified`),
 		},
 		"wrap error from queue_delay on failure": {
 			AdvancedCount: AdvancedCount{
@@ -2426,10 +3930,249 @@ func TestAdvancedCount_validate(t *testing.T) {
 				Range: Range{
 					Value: (*IntRangeBand)(stringP("1-2")),
 				},
-				Memory:       invalidConfig,
-				workloadType: manifestinfo.LoadBalancedWebServiceType,
+				Memory:       invalidConfig,
+				workloadType: manifestinfo.LoadBalancedWebServiceType,
+			},
+			wantedErrorMsgPrefix: `validate "memory_percentage": `,
+		},
+		"error if a custom metric is missing a target": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				Metrics: []CustomMetricScaling{
+					{
+						CloudWatchMetric: CloudWatchMetric{
+							Namespace: aws.String("MyApp"),
+							Name:      aws.String("ActiveSessions"),
+						},
+					},
+				},
+				workloadType: manifestinfo.LoadBalancedWebServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "metrics[0]": "target" must be specified`),
+		},
+		"error if a custom metric has an invalid statistic": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				Metrics: []CustomMetricScaling{
+					{
+						CloudWatchMetric: CloudWatchMetric{
+							Namespace: aws.String("MyApp"),
+							Name:      aws.String("ActiveSessions"),
+							Statistic: aws.String("p99"),
+						},
+						Target: aws.Float64(100),
+					},
+				},
+				workloadType: manifestinfo.BackendServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "metrics[0]": invalid statistic "p99", must be one of Average, Minimum, Maximum, Sum or SampleCount`),
+		},
+		"error if a scale-out step is missing its lower bound": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				StepScaling: []StepScaling{
+					{
+						CloudWatchMetric: CloudWatchMetric{
+							Namespace: aws.String("AWS/SQS"),
+							Name:      aws.String("ApproximateNumberOfMessagesVisible"),
+						},
+						Steps: []ScalingStep{
+							{Below: aws.Float64(100), Change: aws.Int(2)},
+						},
+					},
+				},
+				workloadType: manifestinfo.WorkerServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "step_scaling[0]": validate "steps[0]": "above" must be specified for a step with a positive "change"`),
+		},
+		"error if there is a gap between scale-out steps": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				StepScaling: []StepScaling{
+					{
+						CloudWatchMetric: CloudWatchMetric{
+							Namespace: aws.String("AWS/SQS"),
+							Name:      aws.String("ApproximateNumberOfMessagesVisible"),
+						},
+						Steps: []ScalingStep{
+							{Above: aws.Float64(500), Change: aws.Int(5)},
+							{Above: aws.Float64(100), Below: aws.Float64(400), Change: aws.Int(2)},
+						},
+					},
+				},
+				workloadType: manifestinfo.WorkerServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "step_scaling[0]": scale-out step above 100 must end where the next step starts at 500`),
+		},
+		"error if scale-in steps overlap with scale-out steps": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				StepScaling: []StepScaling{
+					{
+						CloudWatchMetric: CloudWatchMetric{
+							Namespace: aws.String("AWS/SQS"),
+							Name:      aws.String("ApproximateNumberOfMessagesVisible"),
+						},
+						Steps: []ScalingStep{
+							{Above: aws.Float64(100), Change: aws.Int(2)},
+							{Below: aws.Float64(200), Change: aws.Int(-1)},
+						},
+					},
+				},
+				workloadType: manifestinfo.WorkerServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "step_scaling[0]": scale-in steps below 200 cannot overlap with scale-out steps above 100`),
+		},
+		"error if the period of a step scaling policy is not supported by CloudWatch": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				StepScaling: []StepScaling{
+					{
+						CloudWatchMetric: CloudWatchMetric{
+							Namespace: aws.String("AWS/SQS"),
+							Name:      aws.String("ApproximateNumberOfMessagesVisible"),
+						},
+						Period: durationp(45 * time.Second),
+						Steps: []ScalingStep{
+							{Above: aws.Float64(100), Change: aws.Int(2)},
+						},
+					},
+				},
+				workloadType: manifestinfo.WorkerServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "step_scaling[0]": "period" 45s must be 10s, 30s or a multiple of 60s`),
+		},
+		"error if a schedule sets neither min nor max": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				Schedules: []ScheduledScaling{
+					{Schedule: aws.String("0 9 * * 1-5")},
+				},
+				workloadType: manifestinfo.LoadBalancedWebServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "schedules[0]": must specify at least one of "min" or "max" if "schedule" is specified`),
+		},
+		"error if a schedule has an invalid timezone": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				Schedules: []ScheduledScaling{
+					{Schedule: aws.String("0 9 * * 1-5"), Timezone: aws.String("Mars/Olympus"), Min: aws.Int(10)},
+				},
+				workloadType: manifestinfo.LoadBalancedWebServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "schedules[0]": validate "timezone": "Mars/Olympus" is not a valid IANA timezone`),
+		},
+		"error if two schedules have the same name": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				Schedules: []ScheduledScaling{
+					{Name: aws.String("business-hours"), Schedule: aws.String("0 9 * * 1-5"), Min: aws.Int(10)},
+					{Name: aws.String("business-hours"), Schedule: aws.String("0 18 * * 1-5"), Min: aws.Int(1)},
+				},
+				workloadType: manifestinfo.LoadBalancedWebServiceType,
+			},
+			wantedError: fmt.Errorf(`validate "schedules[1]": name "business-hours" is used by another schedule`),
+		},
+		"error if a backend service scales to zero without a policy to scale out from zero": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("0-10")),
+				},
+				CPU:          mockConfig,
+				workloadType: manifestinfo.BackendServiceType,
+			},
+			wantedError: fmt.Errorf(`must specify at least one of "step_scaling" or "schedules" to scale out from zero tasks if the min of "range" is 0`),
+		},
+		"error if a worker service scales to zero without a policy to scale out from zero": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					RangeConfig: RangeConfig{
+						Min: aws.Int(0),
+						Max: aws.Int(10),
+					},
+				},
+				CPU:          mockConfig,
+				workloadType: manifestinfo.WorkerServiceType,
+			},
+			wantedError: fmt.Errorf(`must specify at least one of "queue_delay", "step_scaling" or "schedules" to scale out from zero tasks if the min of "range" is 0`),
+		},
+		"valid worker service that scales to zero with its queue": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("0-10")),
+				},
+				QueueScaling: QueueScaling{
+					AcceptableLatency: durationp(10 * time.Second),
+					AvgProcessingTime: durationp(1 * time.Second),
+				},
+				workloadType: manifestinfo.WorkerServiceType,
+			},
+		},
+		"valid backend service that scales to zero off-hours": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				CPU: mockConfig,
+				Schedules: []ScheduledScaling{
+					{Schedule: aws.String("0 19 * * 1-5"), Min: aws.Int(0), Max: aws.Int(0)},
+					{Schedule: aws.String("0 7 * * 1-5"), Min: aws.Int(1), Max: aws.Int(10)},
+				},
+				workloadType: manifestinfo.BackendServiceType,
+			},
+		},
+		"valid custom metric, step scaling and scheduled scaling": {
+			AdvancedCount: AdvancedCount{
+				Range: Range{
+					Value: (*IntRangeBand)(stringP("1-10")),
+				},
+				Metrics: []CustomMetricScaling{
+					{
+						CloudWatchMetric: CloudWatchMetric{
+							Namespace:  aws.String("MyApp"),
+							Name:       aws.String("ActiveSessions"),
+							Dimensions: map[string]string{"Service": "api"},
+						},
+						Target: aws.Float64(100),
+					},
+				},
+				StepScaling: []StepScaling{
+					{
+						CloudWatchMetric: CloudWatchMetric{
+							Namespace: aws.String("AWS/SQS"),
+							Name:      aws.String("ApproximateNumberOfMessagesVisible"),
+							Statistic: aws.String("Sum"),
+						},
+						Steps: []ScalingStep{
+							{Above: aws.Float64(500), Change: aws.Int(5)},
+							{Above: aws.Float64(100), Below: aws.Float64(500), Change: aws.Int(2)},
+							{Below: aws.Float64(10), Change: aws.Int(-1)},
+						},
+					},
+				},
+				Schedules: []ScheduledScaling{
+					{Schedule: aws.String("0 9 * * 1-5"), Timezone: aws.String("America/New_York"), Min: aws.Int(10)},
+				},
+				workloadType: manifestinfo.BackendServiceType,
 			},
-			wantedErrorMsgPrefix: `validate "memory_percentage": `,
 		},
 	}
 	for name, tc := range testCases {
@@ -2824,6 +4567,75 @@ func TestSidecarConfig_validate(t *testing.T) {
 			},
 			wantedErrorPrefix: `environment file foo must`,
 		},
+		"error if invalid variables_from_env_file": {
+			config: SidecarConfig{
+				Image:                BasicToUnion[*string, ImageLocationOrBuild](aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")),
+				VariablesFromEnvFile: aws.String("config/xray.yml"),
+			},
+			wantedErrorPrefix: `validate "variables_from_env_file": environment file config/xray.yml must have a .env file extension`,
+		},
+		"error if secrets are written to the same file": {
+			config: SidecarConfig{
+				Image: BasicToUnion[*string, ImageLocationOrBuild](aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")),
+				Secrets: map[string]Secret{
+					"TLS_CERT": {
+						fromSecretsManager: secretsManagerSecret{Name: aws.String("demo/tls-cert")},
+						asFile:             aws.String("/etc/ssl/private/tls.pem"),
+					},
+					"TLS_KEY": {
+						fromSecretsManager: secretsManagerSecret{Name: aws.String("demo/tls-key")},
+						asFile:             aws.String("/etc/ssl/private/tls.pem"),
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "secrets": secrets TLS_CERT and TLS_KEY cannot both be written to "/etc/ssl/private/tls.pem"`,
+		},
+		"valid with a secret written to a file": {
+			config: SidecarConfig{
+				Image: BasicToUnion[*string, ImageLocationOrBuild](aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")),
+				Secrets: map[string]Secret{
+					"TLS_KEY": {
+						fromSecretsManager: secretsManagerSecret{Name: aws.String("demo/tls")},
+						asFile:             aws.String("/etc/ssl/private/key.pem"),
+					},
+				},
+				VariablesFromEnvFile: aws.String("config/xray.env"),
+			},
+		},
+		"error if a variable is invalid": {
+			config: SidecarConfig{
+				Image: BasicToUnion[*string, ImageLocationOrBuild](aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")),
+				Variables: map[string]Variable{
+					"DB_URL": {
+						stringOrFromCFN{
+							Plain: aws.String("postgres://${cfn:dbEndpoint}:5432"),
+						},
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "DB_URL" "variables": reference ${cfn:dbEndpoint} to a CloudFormation export must be the entire value`,
+		},
+		"error if cpu is not positive": {
+			config: SidecarConfig{
+				Image: BasicToUnion[*string, ImageLocationOrBuild](aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")),
+				CPU:   aws.Int(0),
+			},
+			wantedErrorPrefix: `"cpu" must be greater than 0`,
+		},
+		"error if memory_reservation is not positive": {
+			config: SidecarConfig{
+				Image:             BasicToUnion[*string, ImageLocationOrBuild](aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")),
+				MemoryReservation: aws.Int(-1),
+			},
+			wantedErrorPrefix: `"memory_reservation" must be greater than 0`,
+		},
+		"valid with cpu and memory_reservation": {
+			config: SidecarConfig{
+				Image:             BasicToUnion[*string, ImageLocationOrBuild](aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")),
+				CPU:               aws.Int(32),
+				MemoryReservation: aws.Int(256),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2906,6 +4718,65 @@ func TestNetworkConfig_validate(t *testing.T) {
 			},
 			wantedErrorPrefix: `validate "vpc": `,
 		},
+		"error if a service connect port is missing": {
+			config: NetworkConfig{
+				Connect: ServiceConnectBoolOrArgs{
+					ServiceConnectArgs: ServiceConnectArgs{
+						Ports: []ServiceConnectPort{{Alias: aws.String("admin")}},
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "connect": validate "ports[0]": "port" must be specified`,
+		},
+		"error if the app protocol of a service connect port is invalid": {
+			config: NetworkConfig{
+				Connect: ServiceConnectBoolOrArgs{
+					ServiceConnectArgs: ServiceConnectArgs{
+						Ports: []ServiceConnectPort{{Port: aws.Uint16(8080), AppProtocol: aws.String("tcp")}},
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "connect": validate "ports[0]": invalid "app_protocol" "tcp", must be one of http, http2 or grpc`,
+		},
+		"error if a service connect port is specified twice": {
+			config: NetworkConfig{
+				Connect: ServiceConnectBoolOrArgs{
+					ServiceConnectArgs: ServiceConnectArgs{
+						Ports: []ServiceConnectPort{{Port: aws.Uint16(8080)}, {Port: aws.Uint16(8080), AppProtocol: aws.String("grpc")}},
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "connect": "ports[0]" and "ports[1]" both expose port 8080`,
+		},
+		"error if a service connect port is health checked without an app protocol": {
+			config: NetworkConfig{
+				Connect: ServiceConnectBoolOrArgs{
+					ServiceConnectArgs: ServiceConnectArgs{
+						Ports: []ServiceConnectPort{{
+							Port:        aws.Uint16(8080),
+							HealthCheck: BasicToUnion[*bool, ServiceConnectPortHealthCheck](aws.Bool(true)),
+						}},
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "connect": validate "ports[0]": "app_protocol" must be specified if "healthcheck" is specified`,
+		},
+		"error if a grpc service connect port is health checked with a path": {
+			config: NetworkConfig{
+				Connect: ServiceConnectBoolOrArgs{
+					ServiceConnectArgs: ServiceConnectArgs{
+						Ports: []ServiceConnectPort{{
+							Port:        aws.Uint16(50051),
+							AppProtocol: aws.String("grpc"),
+							HealthCheck: AdvancedToUnion[*bool](ServiceConnectPortHealthCheck{
+								Path: aws.String("/health"),
+							}),
+						}},
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "connect": validate "ports[0]": "healthcheck.path" can't be used with the "grpc" application protocol`,
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -2920,6 +4791,200 @@ func TestNetworkConfig_validate(t *testing.T) {
 	}
 }
 
+func TestSecurityGroupsConfig_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedError string
+	}{
+		"error if neither source security group nor cidr is specified": {
+			in: `
+ingress:
+  - ports: 80`,
+			wantedError: `validate "ingress[0]": must specify one of "source_security_group" and "cidr"`,
+		},
+		"error if both source security group and cidr are specified": {
+			in: `
+ingress:
+  - source_security_group: sg-1234
+    cidr: 10.0.0.0/16
+    ports: 80`,
+			wantedError: `validate "ingress[0]": must specify one of "source_security_group" and "cidr"`,
+		},
+		"error if cidr is malformed": {
+			in: `
+ingress:
+  - cidr: 10.0.0.0
+    ports: 80`,
+			wantedError: `validate "ingress[0]": parse "cidr": invalid CIDR address: 10.0.0.0`,
+		},
+		"error if ports are missing": {
+			in: `
+ingress:
+  - cidr: 10.0.0.0/16`,
+			wantedError: `validate "ingress[0]": "ports" must be specified`,
+		},
+		"error if ip protocol is not supported": {
+			in: `
+ingress:
+  - cidr: 10.0.0.0/16
+    ports: 80
+    ip_protocol: icmp`,
+			wantedError: `validate "ingress[0]": "ip_protocol" "icmp" must be one of "tcp" or "udp"`,
+		},
+		"error if the source is the environment security group": {
+			in: `
+ingress:
+  - source_security_group:
+      from_cfn: demo-test-EnvironmentSecurityGroup
+    ports: 80`,
+			wantedError: `validate "ingress[0]": "source_security_group" "demo-test-EnvironmentSecurityGroup" is the environment security group, whose traffic Copilot already allows`,
+		},
+		"error if two rules overlap": {
+			in: `
+ingress:
+  - cidr: 10.0.0.0/16
+    ports: 8000-8080
+  - source_security_group: sg-1234
+    ports: 8080
+  - cidr: 10.0.0.0/16
+    ports: 8080-8090`,
+			wantedError: `"ingress[2]" overlaps with "ingress[0]"`,
+		},
+		"success with rules on different protocols": {
+			in: `
+ingress:
+  - cidr: 10.0.0.0/16
+    ports: 53
+  - cidr: 10.0.0.0/16
+    ports: 53
+    ip_protocol: udp`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var cfg SecurityGroupsConfig
+			require.NoError(t, yaml.Unmarshal([]byte(tc.in), &cfg))
+
+			gotErr := cfg.validate()
+
+			if tc.wantedError != "" {
+				require.EqualError(t, gotErr, tc.wantedError)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestHTTPAlarmsConfig_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in HTTPAlarmsConfig
+
+		wantedError string
+	}{
+		"error if cpu utilization is not a percentage": {
+			in: HTTPAlarmsConfig{
+				AlarmsConfig: AlarmsConfig{
+					CPUUtilization: aws.Float64(120),
+				},
+			},
+			wantedError: `"cpu_utilization" 120 must be greater than 0 and less than or equal to 100`,
+		},
+		"error if 5xx rate is not a percentage": {
+			in: HTTPAlarmsConfig{
+				HTTP5xxRate: aws.Float64(0),
+			},
+			wantedError: `"http_5xx_rate" 0 must be greater than 0 and less than or equal to 100`,
+		},
+		"error if response time is not positive": {
+			in: HTTPAlarmsConfig{
+				ResponseTime: durationp(0),
+			},
+			wantedError: `"response_time" 0s must be greater than 0s`,
+		},
+		"error if an email is invalid": {
+			in: HTTPAlarmsConfig{
+				AlarmsConfig: AlarmsConfig{
+					Notify: AlarmNotifyConfig{
+						Emails: []string{"oncall"},
+					},
+				},
+			},
+			wantedError: `validate "notify": "emails[0]" "oncall" is not a valid email address`,
+		},
+		"error if a topic is not an sns topic": {
+			in: HTTPAlarmsConfig{
+				AlarmsConfig: AlarmsConfig{
+					Notify: AlarmNotifyConfig{
+						Topics: []string{"arn:aws:sqs:us-west-2:123456789012:queue"},
+					},
+				},
+			},
+			wantedError: `validate "notify": "topics[0]" "arn:aws:sqs:us-west-2:123456789012:queue" is not the ARN of an SNS topic`,
+		},
+		"success": {
+			in: HTTPAlarmsConfig{
+				AlarmsConfig: AlarmsConfig{
+					CPUUtilization:    aws.Float64(80),
+					MemoryUtilization: aws.Float64(90.5),
+					Notify: AlarmNotifyConfig{
+						Emails: []string{"oncall@example.com"},
+						Topics: []string{"arn:aws:sns:us-west-2:123456789012:alarms"},
+					},
+				},
+				HTTP5xxRate:  aws.Float64(5),
+				ResponseTime: durationp(2 * time.Second),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.validate()
+
+			if tc.wantedError != "" {
+				require.EqualError(t, gotErr, tc.wantedError)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestWorkerAlarmsConfig_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in WorkerAlarmsConfig
+
+		wantedError string
+	}{
+		"error if queue depth is not positive": {
+			in: WorkerAlarmsConfig{
+				QueueDepth: aws.Int(0),
+			},
+			wantedError: `"queue_depth" 0 must be greater than 0`,
+		},
+		"success": {
+			in: WorkerAlarmsConfig{
+				AlarmsConfig: AlarmsConfig{
+					MemoryUtilization: aws.Float64(80),
+				},
+				QueueDepth: aws.Int(1000),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.validate()
+
+			if tc.wantedError != "" {
+				require.EqualError(t, gotErr, tc.wantedError)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
 func TestRequestDrivenWebServiceNetworkConfig_validate(t *testing.T) {
 	testCases := map[string]struct {
 		config RequestDrivenWebServiceNetworkConfig
@@ -3105,6 +5170,194 @@ func TestAppRunnerInstanceConfig_validate(t *testing.T) {
 	}
 }
 
+func TestAppRunnerAutoScaling_validate(t *testing.T) {
+	testCases := map[string]struct {
+		config      AppRunnerAutoScaling
+		wantedError string
+	}{
+		"error if max_concurrency is out of range": {
+			config:      AppRunnerAutoScaling{MaxConcurrency: aws.Int(201)},
+			wantedError: `"max_concurrency" must be between 1 and 200`,
+		},
+		"error if min is out of range": {
+			config:      AppRunnerAutoScaling{MinInstances: aws.Int(0)},
+			wantedError: `"min" must be between 1 and 25`,
+		},
+		"error if max is less than 1": {
+			config:      AppRunnerAutoScaling{MaxInstances: aws.Int(0)},
+			wantedError: `"max" must be at least 1`,
+		},
+		"error if min is greater than max": {
+			config:      AppRunnerAutoScaling{MinInstances: aws.Int(3), MaxInstances: aws.Int(2)},
+			wantedError: "min value 3 cannot be greater than max value 2",
+		},
+		"ok": {
+			config: AppRunnerAutoScaling{MaxConcurrency: aws.Int(50), MinInstances: aws.Int(2), MaxInstances: aws.Int(10)},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.config.validate()
+
+			if tc.wantedError != "" {
+				require.EqualError(t, gotErr, tc.wantedError)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestLambdaServiceConfig_validate(t *testing.T) {
+	image := ImageWithEntryPointAndCommand{
+		Image: Image{
+			ImageLocationOrBuild: ImageLocationOrBuild{
+				Location: aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/api:latest"),
+			},
+		},
+	}
+	testCases := map[string]struct {
+		config      LambdaServiceConfig
+		wantedError string
+	}{
+		"error if image.platforms is specified": {
+			config: LambdaServiceConfig{
+				ImageConfig: ImageWithEntryPointAndCommand{
+					Image: Image{
+						ImageLocationOrBuild: ImageLocationOrBuild{
+							Build: BuildArgsOrString{BuildString: aws.String("Dockerfile")},
+						},
+						Platforms: []string{"linux/amd64", "linux/arm64"},
+					},
+				},
+			},
+			wantedError: `"image.platforms" is not supported for Lambda Service`,
+		},
+		"error if memory is out of range": {
+			config: LambdaServiceConfig{
+				ImageConfig: image,
+				Memory:      aws.Int(64),
+			},
+			wantedError: `"memory" 64 must be between 128 and 10240 MiB`,
+		},
+		"error if timeout is not a whole number of seconds": {
+			config: LambdaServiceConfig{
+				ImageConfig: image,
+				Timeout:     durationp(1500 * time.Millisecond),
+			},
+			wantedError: `"timeout" 1.5s must be a whole number of seconds between 1s and 15m0s`,
+		},
+		"error if timeout is longer than 15 minutes": {
+			config: LambdaServiceConfig{
+				ImageConfig: image,
+				Timeout:     durationp(16 * time.Minute),
+			},
+			wantedError: `"timeout" 16m0s must be a whole number of seconds between 1s and 15m0s`,
+		},
+		"error if the platform is windows": {
+			config: LambdaServiceConfig{
+				ImageConfig: image,
+				Platform:    PlatformArgsOrString{PlatformString: (*PlatformString)(aws.String("windows/x86_64"))},
+			},
+			wantedError: `"platform" windows/x86_64 is not supported for Lambda Service, the operating system must be linux`,
+		},
+		"error if http.path is empty": {
+			config: LambdaServiceConfig{
+				ImageConfig: image,
+				HTTP:        LambdaHTTPConfig{Path: aws.String("")},
+			},
+			wantedError: `validate "http": "path" must not be empty`,
+		},
+		"error if both http and api_gateway are specified": {
+			config: LambdaServiceConfig{
+				ImageConfig: image,
+				HTTP:        LambdaHTTPConfig{Path: aws.String("api")},
+				APIGateway:  APIGateway{Protocol: aws.String(APIGatewayProtocolHTTP)},
+			},
+			wantedError: `must specify one, not both, of "http" and "api_gateway"`,
+		},
+		"ok": {
+			config: LambdaServiceConfig{
+				ImageConfig: image,
+				Memory:      aws.Int(1024),
+				Timeout:     durationp(time.Minute),
+				Platform:    PlatformArgsOrString{PlatformString: (*PlatformString)(aws.String("linux/arm64"))},
+				HTTP:        LambdaHTTPConfig{Path: aws.String("api")},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.config.validate()
+
+			if tc.wantedError != "" {
+				require.EqualError(t, gotErr, tc.wantedError)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
+func TestLambdaDeploymentConfig_validate(t *testing.T) {
+	testCases := map[string]struct {
+		config      LambdaDeploymentConfig
+		wantedError string
+	}{
+		"error if the strategy is not supported": {
+			config: LambdaDeploymentConfig{
+				DeploymentControllerConfig: DeploymentControllerConfig{Rolling: aws.String("blue_green")},
+			},
+			wantedError: `validate "rolling": invalid rolling deployment strategy "blue_green", must be one of default, canary or linear`,
+		},
+		"error if traffic_shifting is specified without canary or linear": {
+			config: LambdaDeploymentConfig{
+				TrafficShifting: TrafficShiftingConfig{Percent: aws.Int(10)},
+			},
+			wantedError: `"traffic_shifting" can only be specified when "rolling" is canary or linear`,
+		},
+		"error if rollback_alarms is specified without canary or linear": {
+			config: LambdaDeploymentConfig{
+				DeploymentControllerConfig: DeploymentControllerConfig{Rolling: aws.String("default")},
+				RollbackAlarms:             []string{"api-errors"},
+			},
+			wantedError: `"rollback_alarms" can only be specified when "rolling" is canary or linear`,
+		},
+		"error if the traffic is not shifted in whole minutes": {
+			config: LambdaDeploymentConfig{
+				DeploymentControllerConfig: DeploymentControllerConfig{Rolling: aws.String("canary")},
+				TrafficShifting:            TrafficShiftingConfig{Interval: durationp(90 * time.Second)},
+			},
+			wantedError: `validate "traffic_shifting": "interval" 1m30s must be a whole number of minutes`,
+		},
+		"error if a rollback alarm is empty": {
+			config: LambdaDeploymentConfig{
+				DeploymentControllerConfig: DeploymentControllerConfig{Rolling: aws.String("linear")},
+				RollbackAlarms:             []string{"api-errors", ""},
+			},
+			wantedError: `"rollback_alarms[1]" must not be empty`,
+		},
+		"ok with a canary deployment": {
+			config: LambdaDeploymentConfig{
+				DeploymentControllerConfig: DeploymentControllerConfig{Rolling: aws.String("canary")},
+				TrafficShifting:            TrafficShiftingConfig{Percent: aws.Int(10), Interval: durationp(5 * time.Minute)},
+				RollbackAlarms:             []string{"api-errors"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.config.validate()
+
+			if tc.wantedError != "" {
+				require.EqualError(t, gotErr, tc.wantedError)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
+
 func TestObservability_validate(t *testing.T) {
 	testCases := map[string]struct {
 		config            Observability
@@ -3114,15 +5367,74 @@ func TestObservability_validate(t *testing.T) {
 			config: Observability{
 				Tracing: aws.String("unknown-vendor"),
 			},
-			wantedErrorPrefix: `invalid tracing vendor unknown-vendor: `,
+			wantedErrorPrefix: `invalid tracing vendor unknown-vendor: `,
+		},
+		"ok if tracing is aws-xray": {
+			config: Observability{
+				Tracing: aws.String("awsxray"),
+			},
+		},
+		"ok if observability is empty": {
+			config: Observability{},
+		},
+		"error if both tracing and collector are specified": {
+			config: Observability{
+				Tracing: aws.String("awsxray"),
+				Collector: OTelCollectorConfig{
+					Traces: aws.String("awsxray"),
+				},
+			},
+			wantedErrorPrefix: `must specify one, not both, of "tracing" and "collector"`,
+		},
+		"error if collector has an invalid traces destination": {
+			config: Observability{
+				Collector: OTelCollectorConfig{
+					Traces: aws.String("jaeger"),
+				},
+			},
+			wantedErrorPrefix: `validate "collector": invalid "traces" destination jaeger: valid destinations are awsxray and otlp`,
+		},
+		"error if collector has an invalid metrics destination": {
+			config: Observability{
+				Collector: OTelCollectorConfig{
+					Metrics: aws.String("prometheus"),
+				},
+			},
+			wantedErrorPrefix: `validate "collector": invalid "metrics" destination prometheus: valid destinations are cloudwatch and otlp`,
+		},
+		"error if collector exports to otlp without an endpoint": {
+			config: Observability{
+				Collector: OTelCollectorConfig{
+					Metrics: aws.String("otlp"),
+				},
+			},
+			wantedErrorPrefix: `validate "collector": "otlp_endpoint" must be specified if "traces" or "metrics" is "otlp"`,
+		},
+		"error if collector config is specified with destinations": {
+			config: Observability{
+				Collector: OTelCollectorConfig{
+					Config: aws.String("receivers: {}"),
+					Traces: aws.String("awsxray"),
+				},
+			},
+			wantedErrorPrefix: `validate "collector": "config" cannot be specified with "traces", "metrics" or "otlp_endpoint"`,
 		},
-		"ok if tracing is aws-xray": {
+		"ok if collector exports to an otlp endpoint": {
 			config: Observability{
-				Tracing: aws.String("awsxray"),
+				Collector: OTelCollectorConfig{
+					Traces:       aws.String("otlp"),
+					Metrics:      aws.String("cloudwatch"),
+					OTLPEndpoint: aws.String("https://otlp.example.com:4317"),
+				},
 			},
 		},
-		"ok if observability is empty": {
-			config: Observability{},
+		"ok if collector uses a custom config": {
+			config: Observability{
+				Collector: OTelCollectorConfig{
+					Image:  aws.String("public.ecr.aws/aws-observability/aws-otel-collector:latest"),
+					Config: aws.String("receivers: {}"),
+				},
+			},
 		},
 	}
 	for name, tc := range testCases {
@@ -3140,6 +5452,9 @@ func TestObservability_validate(t *testing.T) {
 }
 
 func TestJobTriggerConfig_validate(t *testing.T) {
+	mockPattern := map[string]interface{}{
+		"source": []interface{}{"com.example.orders"},
+	}
 	testCases := map[string]struct {
 		in     *JobTriggerConfig
 		wanted error
@@ -3148,6 +5463,109 @@ func TestJobTriggerConfig_validate(t *testing.T) {
 			in:     &JobTriggerConfig{},
 			wanted: errors.New(`"schedule" must be specified`),
 		},
+		"should not return an error if only additional schedules are specified": {
+			in: &JobTriggerConfig{
+				Schedules: []JobSchedule{
+					{
+						Schedule:       aws.String("0 9 * * MON-FRI"),
+						Timezone:       aws.String("America/New_York"),
+						FlexibleWindow: durationp(15 * time.Minute),
+					},
+				},
+			},
+		},
+		"should return an error if an additional schedule has no expression": {
+			in: &JobTriggerConfig{
+				Schedule: aws.String("@daily"),
+				Schedules: []JobSchedule{
+					{
+						Timezone: aws.String("Europe/London"),
+					},
+				},
+			},
+			wanted: errors.New(`validate "schedules[0]": "schedule" must be specified`),
+		},
+		"should return an error if the timezone is invalid": {
+			in: &JobTriggerConfig{
+				Schedules: []JobSchedule{
+					{
+						Schedule: aws.String("@daily"),
+						Timezone: aws.String("Mars/Olympus_Mons"),
+					},
+				},
+			},
+			wanted: errors.New(`validate "schedules[0]": validate "timezone": "Mars/Olympus_Mons" is not a valid IANA timezone`),
+		},
+		"should return an error if the flexible window is not a whole number of minutes": {
+			in: &JobTriggerConfig{
+				Schedules: []JobSchedule{
+					{
+						Schedule:       aws.String("@daily"),
+						FlexibleWindow: durationp(90 * time.Second),
+					},
+				},
+			},
+			wanted: errors.New(`validate "schedules[0]": validate "flexible_window": 1m30s must be a whole number of minutes between 1m and 24h`),
+		},
+		"should return an error if the flexible window is longer than a day": {
+			in: &JobTriggerConfig{
+				Schedules: []JobSchedule{
+					{
+						Schedule:       aws.String("@daily"),
+						FlexibleWindow: durationp(25 * time.Hour),
+					},
+				},
+			},
+			wanted: errors.New(`validate "schedules[0]": validate "flexible_window": 25h0m0s must be a whole number of minutes between 1m and 24h`),
+		},
+		"should not return an error if only events are specified": {
+			in: &JobTriggerConfig{
+				Events: []EventRule{
+					{
+						Name:    aws.String("orders"),
+						Pattern: mockPattern,
+					},
+				},
+			},
+		},
+		"should return an error if an event rule has no pattern": {
+			in: &JobTriggerConfig{
+				Schedule: aws.String("@daily"),
+				Events: []EventRule{
+					{
+						Name: aws.String("orders"),
+					},
+				},
+			},
+			wanted: errors.New(`validate "events": validate "events[0]": "pattern" must be specified`),
+		},
+		"should return an error if an event rule has an invalid name": {
+			in: &JobTriggerConfig{
+				Events: []EventRule{
+					{
+						Name:    aws.String("orders!"),
+						Pattern: mockPattern,
+					},
+				},
+			},
+			wanted: errors.New(`validate "events": validate "events[0]": "name" can only contain letters, numbers, underscores, and hyphens`),
+		},
+		"should return an error if event rule names are not unique": {
+			in: &JobTriggerConfig{
+				Events: []EventRule{
+					{
+						Name:    aws.String("orders"),
+						Pattern: mockPattern,
+					},
+					{
+						Name:    aws.String("orders"),
+						Bus:     aws.String("custom"),
+						Pattern: mockPattern,
+					},
+				},
+			},
+			wanted: errors.New(`validate "events": event rule name "orders" must be unique`),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -3251,6 +5669,20 @@ func TestTopic_validate(t *testing.T) {
 			},
 			wanted: nil,
 		},
+		"should not return an error if kms key is a KMS key ARN": {
+			in: Topic{
+				Name:   aws.String("validtopic"),
+				KMSKey: aws.String("arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+			},
+			wanted: nil,
+		},
+		"should return an error if kms key is not a KMS key ARN": {
+			in: Topic{
+				Name:   aws.String("validtopic"),
+				KMSKey: aws.String("arn:aws:s3:::bucket"),
+			},
+			wanted: errors.New(`validate "kms_key": "arn:aws:s3:::bucket" is not a valid KMS key ARN`),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -3281,6 +5713,16 @@ func TestSubscribeConfig_validate(t *testing.T) {
 			},
 			wantedErrorPrefix: `validate "topics[0]": `,
 		},
+		"error if fail to validate events": {
+			config: SubscribeConfig{
+				Events: []EventRule{
+					{
+						Name: aws.String("orders"),
+					},
+				},
+			},
+			wantedErrorPrefix: `validate "events": `,
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -3325,6 +5767,71 @@ func TestTopicSubscription_validate(t *testing.T) {
 			},
 			wanted: nil,
 		},
+		"should return an error if filter policy scope is set without a filter policy": {
+			in: TopicSubscription{
+				Name:              aws.String("mockTopic"),
+				Service:           aws.String("mockservice"),
+				FilterPolicyScope: aws.String("MessageBody"),
+			},
+			wanted: errors.New(`"filter_policy" must be specified if "filter_policy_scope" is specified`),
+		},
+		"should return an error if filter policy scope is invalid": {
+			in: TopicSubscription{
+				Name:              aws.String("mockTopic"),
+				Service:           aws.String("mockservice"),
+				FilterPolicy:      map[string]interface{}{"store": []interface{}{"example_corp"}},
+				FilterPolicyScope: aws.String("MessageHeaders"),
+			},
+			wanted: errors.New(`validate "filter_policy_scope": filter policy scope value must be one of MessageAttributes or MessageBody`),
+		},
+		"should not return an error if filter policy scope is valid": {
+			in: TopicSubscription{
+				Name:              aws.String("mockTopic"),
+				Service:           aws.String("mockservice"),
+				FilterPolicy:      map[string]interface{}{"store": []interface{}{"example_corp"}},
+				FilterPolicyScope: aws.String("MessageBody"),
+			},
+			wanted: nil,
+		},
+		"should return an error if dead letter retention is set without tries": {
+			in: TopicSubscription{
+				Name:    aws.String("mockTopic"),
+				Service: aws.String("mockservice"),
+				Queue: SQSQueueOrBool{
+					Advanced: SQSQueue{
+						DeadLetter: DeadLetterQueue{Retention: &duration111Seconds},
+					},
+				},
+			},
+			wanted: errors.New(`validate "queue": validate "dead_letter": "tries" must be specified if "retention" is specified`),
+		},
+		"should return an error if dead letter retention is out of bounds": {
+			in: TopicSubscription{
+				Name:    aws.String("mockTopic"),
+				Service: aws.String("mockservice"),
+				Queue: SQSQueueOrBool{
+					Advanced: SQSQueue{
+						DeadLetter: DeadLetterQueue{
+							Tries:     aws.Uint16(10),
+							Retention: durationp(15 * 24 * time.Hour),
+						},
+					},
+				},
+			},
+			wanted: errors.New(`validate "queue": validate "dead_letter": validate "retention": retention must be between 1 minute and 14 days`),
+		},
+		"should return an error if queue kms key is not a KMS key ARN": {
+			in: TopicSubscription{
+				Name:    aws.String("mockTopic"),
+				Service: aws.String("mockservice"),
+				Queue: SQSQueueOrBool{
+					Advanced: SQSQueue{
+						KMSKey: aws.String("alias/my-key"),
+					},
+				},
+			},
+			wanted: errors.New(`validate "queue": validate "kms_key": "alias/my-key" is not a valid KMS key ARN`),
+		},
 		"should not return error if standard queue is enabled": {
 			in: TopicSubscription{
 				Name:    aws.String("mockTopic"),
@@ -3613,12 +6120,93 @@ func TestValidateLogging(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("environment file path/to/envFile.sh must have a .env file extension"),
 		},
+		"should return an error if the parser is not supported": {
+			in: Logging{
+				Parser: aws.String("logfmt"),
+			},
+			wantedError: fmt.Errorf(`invalid "parser" logfmt: the only valid parser is json`),
+		},
+		"should return an error if parser and configFilePath are both specified": {
+			in: Logging{
+				Parser:     aws.String("json"),
+				ConfigFile: aws.String("/extra.conf"),
+			},
+			wantedError: fmt.Errorf(`must specify one, not both, of "parser" and "configFilePath"`),
+		},
+		"should return an error if more than one destination is specified": {
+			in: Logging{
+				Destination: AdvancedToUnion[map[string]string](LogDestinationConfig{
+					Datadog: DatadogLogDestination{
+						Site: aws.String("datadoghq.eu"),
+					},
+					Firehose: FirehoseLogDestination{
+						DeliveryStream: aws.String("my-stream"),
+					},
+				}),
+			},
+			wantedError: fmt.Errorf(`validate "destination": must specify only one destination, but "datadog" and "firehose" are specified`),
+		},
+		"should return an error if the datadog api key is missing": {
+			in: Logging{
+				Destination: AdvancedToUnion[map[string]string](LogDestinationConfig{
+					Datadog: DatadogLogDestination{
+						Site: aws.String("datadoghq.eu"),
+					},
+				}),
+			},
+			wantedError: fmt.Errorf(`validate "destination": validate "datadog": "api_key" must be specified`),
+		},
+		"should return an error if the splunk token is missing": {
+			in: Logging{
+				Destination: AdvancedToUnion[map[string]string](LogDestinationConfig{
+					Splunk: SplunkLogDestination{
+						Host: aws.String("splunk.example.com"),
+					},
+				}),
+			},
+			wantedError: fmt.Errorf(`validate "destination": validate "splunk": "token" must be specified`),
+		},
+		"should return an error if the opensearch index is missing": {
+			in: Logging{
+				Destination: AdvancedToUnion[map[string]string](LogDestinationConfig{
+					OpenSearch: OpenSearchLogDestination{
+						Host: aws.String("search-logs.us-west-2.es.amazonaws.com"),
+					},
+				}),
+			},
+			wantedError: fmt.Errorf(`validate "destination": validate "opensearch": "index" must be specified`),
+		},
+		"should return an error if the firehose delivery stream is missing": {
+			in: Logging{
+				Destination: AdvancedToUnion[map[string]string](LogDestinationConfig{
+					Firehose: FirehoseLogDestination{
+						Region: aws.String("us-west-2"),
+					},
+				}),
+			},
+			wantedError: fmt.Errorf(`validate "destination": validate "firehose": "delivery_stream" must be specified`),
+		},
 		"success": {
 			in: Logging{
 				EnvFile: aws.String("test.env"),
 			},
 			wantedError: nil,
 		},
+		"success with a destination preset": {
+			in: Logging{
+				Parser: aws.String("json"),
+				Destination: AdvancedToUnion[map[string]string](LogDestinationConfig{
+					Splunk: SplunkLogDestination{
+						Host: aws.String("splunk.example.com"),
+						Token: &Secret{
+							from: stringOrFromCFN{
+								Plain: aws.String("/copilot/splunk/token"),
+							},
+						},
+					},
+				}),
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -3736,6 +6324,41 @@ func TestValidateContainerDeps(t *testing.T) {
 			},
 			wanted: fmt.Errorf("circular container dependency chain includes the following containers: [alpha beta gamma]"),
 		},
+		"should return an error if a container depends on a sidecar without a healthcheck to be healthy": {
+			in: validateDependenciesOpts{
+				mainContainerName: "mockMainContainer",
+				imageConfig: Image{
+					DependsOn: DependsOn{
+						"envoy": "healthy",
+					},
+				},
+				sidecarConfig: map[string]*SidecarConfig{
+					"envoy": {},
+				},
+			},
+			wanted: fmt.Errorf(`validate mockMainContainer container dependencies status: container envoy must have a "healthcheck" to be depended on with status HEALTHY`),
+		},
+		"success with a healthy dependency on a sidecar and an init container": {
+			in: validateDependenciesOpts{
+				mainContainerName: "mockMainContainer",
+				imageConfig: Image{
+					DependsOn: DependsOn{
+						"envoy":   "healthy",
+						"migrate": "success",
+					},
+				},
+				sidecarConfig: map[string]*SidecarConfig{
+					"envoy": {
+						HealthCheck: ContainerHealthCheck{
+							Command: []string{"CMD-SHELL", "curl -f http://localhost:9901/ready || exit 1"},
+						},
+					},
+					"migrate": {
+						Essential: aws.Bool(false),
+					},
+				},
+			},
+		},
 		"success": {
 			in: validateDependenciesOpts{
 				mainContainerName: "alpha",
@@ -3859,6 +6482,60 @@ func TestValidateARM(t *testing.T) {
 	}
 }
 
+func TestValidateImagePlatforms(t *testing.T) {
+	multiPlatformImage := Image{
+		Platforms: []string{"linux/amd64", "linux/arm64"},
+	}
+	testCases := map[string]struct {
+		image    Image
+		platform PlatformArgsOrString
+
+		wantedError error
+	}{
+		"should return nil if the image has no platforms": {
+			platform: PlatformArgsOrString{PlatformString: (*PlatformString)(aws.String("linux/arm64"))},
+		},
+		"should return nil if the platforms include the default platform of the tasks": {
+			image: multiPlatformImage,
+		},
+		"should treat amd64 and x86_64 as the same architecture": {
+			image:    multiPlatformImage,
+			platform: PlatformArgsOrString{PlatformString: (*PlatformString)(aws.String("linux/x86_64"))},
+		},
+		"should return nil if the platforms include the advanced platform of the tasks": {
+			image: multiPlatformImage,
+			platform: PlatformArgsOrString{
+				PlatformArgs: PlatformArgs{
+					OSFamily: aws.String("linux"),
+					Arch:     aws.String("arm64"),
+				},
+			},
+		},
+		"should return an error if the platforms don't include the platform of the tasks": {
+			image: Image{
+				Platforms: []string{"linux/arm64"},
+			},
+			wantedError: errors.New(`"image.platforms" must include "linux/x86_64", the platform of the tasks`),
+		},
+		"should return an error for windows tasks": {
+			image:       multiPlatformImage,
+			platform:    PlatformArgsOrString{PlatformString: (*PlatformString)(aws.String("windows/x86_64"))},
+			wantedError: errors.New(`"image.platforms" must include "windows/x86_64", the platform of the tasks`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateImagePlatforms(tc.image, tc.platform)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestDeploymentConfig_validate(t *testing.T) {
 	testCases := map[string]struct {
 		deployConfig DeploymentConfig
@@ -3867,28 +6544,201 @@ func TestDeploymentConfig_validate(t *testing.T) {
 		"error if deploy config has invalid rolling strategy": {
 			deployConfig: DeploymentConfig{
 				DeploymentControllerConfig: DeploymentControllerConfig{
-					Rolling: aws.String("unknown"),
-				}},
-			wanted: `invalid rolling deployment strategy "unknown", must be one of default or recreate`,
+					Rolling: aws.String("unknown"),
+				}},
+			wanted: `invalid rolling deployment strategy "unknown", must be one of default, recreate, canary or linear`,
+		},
+		"ok if deployment strategy is recreate": {
+			deployConfig: DeploymentConfig{
+				DeploymentControllerConfig: DeploymentControllerConfig{
+					Rolling: aws.String("recreate"),
+				}},
+		},
+		"ok if deployment strategy is default": {
+			deployConfig: DeploymentConfig{
+				DeploymentControllerConfig: DeploymentControllerConfig{
+					Rolling: aws.String("default"),
+				}},
+		},
+		"ok if deployment is empty": {
+			deployConfig: DeploymentConfig{},
+		},
+		"ok if deployment strategy is empty but alarm indicated": {
+			deployConfig: DeploymentConfig{
+				RollbackAlarms: BasicToUnion[[]string, AlarmArgs]([]string{"alarmName"})},
+		},
+		"error if deployment type is invalid": {
+			deployConfig: DeploymentConfig{
+				Type: aws.String("canary"),
+			},
+			wanted: `invalid deployment type "canary", must be one of rolling or blue/green`,
+		},
+		"error if blue_green is specified with a rolling deployment type": {
+			deployConfig: DeploymentConfig{
+				BlueGreen: BlueGreenDeploymentConfig{
+					TestListenerPort: aws.Uint16(8080),
+				},
+			},
+			wanted: `"blue_green" can only be specified when "type" is "blue/green"`,
+		},
+		"error if rolling is specified with a blue/green deployment type": {
+			deployConfig: DeploymentConfig{
+				Type: aws.String("blue/green"),
+				DeploymentControllerConfig: DeploymentControllerConfig{
+					Rolling: aws.String("recreate"),
+				},
+			},
+			wanted: `"rolling" "recreate" cannot be specified when "type" is "blue/green"`,
+		},
+		"ok if rollback_alarms is specified with a blue/green deployment type": {
+			deployConfig: DeploymentConfig{
+				Type:           aws.String("blue/green"),
+				RollbackAlarms: BasicToUnion[[]string, AlarmArgs]([]string{"alarmName"}),
+			},
+		},
+		"error if canary rolling update is specified with a rolling deployment type": {
+			deployConfig: DeploymentConfig{
+				Type: aws.String("rolling"),
+				DeploymentControllerConfig: DeploymentControllerConfig{
+					Rolling: aws.String("canary"),
+				},
+			},
+			wanted: `"rolling" "canary" requires "type" to be "blue/green"`,
+		},
+		"error if traffic_shifting is specified without a canary or linear rolling update": {
+			deployConfig: DeploymentConfig{
+				TrafficShifting: TrafficShiftingConfig{
+					Percent: aws.Int(20),
+				},
+			},
+			wanted: `"traffic_shifting" can only be specified when "rolling" is canary or linear`,
+		},
+		"error if traffic shifting percent is out of range": {
+			deployConfig: DeploymentConfig{
+				DeploymentControllerConfig: DeploymentControllerConfig{
+					Rolling: aws.String("canary"),
+				},
+				TrafficShifting: TrafficShiftingConfig{
+					Percent: aws.Int(100),
+				},
+			},
+			wanted: `validate "traffic_shifting": "percent" 100 must be between 1 and 99`,
+		},
+		"error if traffic shifting interval is not a whole number of minutes": {
+			deployConfig: DeploymentConfig{
+				DeploymentControllerConfig: DeploymentControllerConfig{
+					Rolling: aws.String("linear"),
+				},
+				TrafficShifting: TrafficShiftingConfig{
+					Interval: durationp(30 * time.Second),
+				},
+			},
+			wanted: `validate "traffic_shifting": "interval" 30s must be a whole number of minutes`,
 		},
-		"ok if deployment strategy is recreate": {
+		"error if shifting the traffic takes too long": {
 			deployConfig: DeploymentConfig{
 				DeploymentControllerConfig: DeploymentControllerConfig{
-					Rolling: aws.String("recreate"),
-				}},
+					Rolling: aws.String("linear"),
+				},
+				TrafficShifting: TrafficShiftingConfig{
+					Percent:  aws.Int(20),
+					Interval: durationp(3 * time.Minute),
+				},
+			},
+			wanted: `validate "traffic_shifting": shifting the traffic takes 12m0s, which must be at most 10m0s`,
 		},
-		"ok if deployment strategy is default": {
+		"ok if canary rolling update with rollback alarms": {
 			deployConfig: DeploymentConfig{
 				DeploymentControllerConfig: DeploymentControllerConfig{
-					Rolling: aws.String("default"),
-				}},
+					Rolling: aws.String("canary"),
+				},
+				TrafficShifting: TrafficShiftingConfig{
+					Percent:  aws.Int(20),
+					Interval: durationp(10 * time.Minute),
+				},
+				RollbackAlarms: AdvancedToUnion[[]string, AlarmArgs](AlarmArgs{
+					CPUUtilization: aws.Float64(70),
+				}),
+			},
 		},
-		"ok if deployment is empty": {
-			deployConfig: DeploymentConfig{},
+		"error if the test listener port is the production listener port": {
+			deployConfig: DeploymentConfig{
+				Type: aws.String("blue/green"),
+				BlueGreen: BlueGreenDeploymentConfig{
+					TestListenerPort: aws.Uint16(443),
+				},
+			},
+			wanted: `validate "blue_green": "test_listener_port" 443 is reserved for the production listener`,
 		},
-		"ok if deployment strategy is empty but alarm indicated": {
+		"error if termination_wait is not a whole number of minutes": {
 			deployConfig: DeploymentConfig{
-				RollbackAlarms: BasicToUnion[[]string, AlarmArgs]([]string{"alarmName"})},
+				Type: aws.String("blue/green"),
+				BlueGreen: BlueGreenDeploymentConfig{
+					TerminationWait: durationp(90 * time.Second),
+				},
+			},
+			wanted: `validate "blue_green": "termination_wait" 1m30s must be a whole number of minutes`,
+		},
+		"error if termination_wait is longer than two days": {
+			deployConfig: DeploymentConfig{
+				Type: aws.String("blue/green"),
+				BlueGreen: BlueGreenDeploymentConfig{
+					TerminationWait: durationp(49 * time.Hour),
+				},
+			},
+			wanted: `validate "blue_green": "termination_wait" 49h0m0s cannot be longer than 48h0m0s`,
+		},
+		"ok if blue/green deployment is configured": {
+			deployConfig: DeploymentConfig{
+				Type: aws.String("blue/green"),
+				BlueGreen: BlueGreenDeploymentConfig{
+					TestListenerPort: aws.Uint16(8080),
+					TerminationWait:  durationp(time.Hour),
+					Hooks: BlueGreenHooks{
+						AfterAllowTestTraffic: aws.String("validate"),
+					},
+				},
+			},
+		},
+		"error if pre_deploy command is empty": {
+			deployConfig: DeploymentConfig{
+				DeploymentHooks: DeploymentHooks{
+					PreDeploy: DeploymentHook{
+						Command: CommandOverride{
+							String: aws.String(""),
+						},
+					},
+				},
+			},
+			wanted: `validate "pre_deploy": "command" must be specified`,
+		},
+		"error if post_deploy command cannot be parsed": {
+			deployConfig: DeploymentConfig{
+				DeploymentHooks: DeploymentHooks{
+					PostDeploy: DeploymentHook{
+						Command: CommandOverride{
+							String: aws.String(`echo "hello`),
+						},
+					},
+				},
+			},
+			wanted: `validate "post_deploy": convert "command" to string slice`,
+		},
+		"ok if deployment hooks are configured": {
+			deployConfig: DeploymentConfig{
+				DeploymentHooks: DeploymentHooks{
+					PreDeploy: DeploymentHook{
+						Command: CommandOverride{
+							StringSlice: []string{"rake", "db:migrate"},
+						},
+					},
+					PostDeploy: DeploymentHook{
+						Command: CommandOverride{
+							String: aws.String("./smoke-test.sh"),
+						},
+					},
+				},
+			},
 		},
 	}
 	for name, tc := range testCases {
@@ -4279,3 +7129,452 @@ func TestImageLocationOrBuild_validate(t *testing.T) {
 		})
 	}
 }
+
+func TestStaticSiteHTTP_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in          StaticSiteHTTP
+		wantedError error
+	}{
+		"error if error_document is an absolute path": {
+			in: StaticSiteHTTP{
+				ErrorDocument: "/404.html",
+			},
+			wantedError: errors.New(`"error_document" /404.html must be relative to the root of the site`),
+		},
+		"error if a redirect is invalid": {
+			in: StaticSiteHTTP{
+				Redirects: []StaticSiteRedirect{
+					{From: "/old", To: "/new"},
+					{From: "/docs"},
+				},
+			},
+			wantedError: errors.New(`validate "redirects[1]": "to" must be specified`),
+		},
+		"error if a custom header name is empty": {
+			in: StaticSiteHTTP{
+				CustomHeaders: map[string]string{
+					" ": "value",
+				},
+			},
+			wantedError: errors.New(`"custom_headers" must not contain an empty header name`),
+		},
+		"error if the web ACL is not global": {
+			in: StaticSiteHTTP{
+				WAF: aws.String("arn:aws:wafv2:us-west-2:123456789012:regional/webacl/api/a1b2"),
+			},
+			wantedError: errors.New(`validate "waf": "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/api/a1b2" is not the ARN of a global AWS WAF web ACL`),
+		},
+		"valid": {
+			in: StaticSiteHTTP{
+				Alias:         "example.com",
+				ErrorDocument: "errors/404.html",
+				Redirects: []StaticSiteRedirect{
+					{From: "/blog/*", To: "https://blog.example.com/*", StatusCode: aws.Int(308)},
+				},
+				CustomHeaders: map[string]string{
+					"Cache-Control": "max-age=3600",
+				},
+				WAF: aws.String("arn:aws:wafv2:us-east-1:123456789012:global/webacl/site/a1b2"),
+				Cache: StaticSiteCache{
+					StaticSiteCacheBehavior: StaticSiteCacheBehavior{
+						TTL: StaticSiteCacheTTL{Default: durationp(time.Hour)},
+					},
+					Paths: []StaticSiteCachePath{
+						{Path: "/assets/*", StaticSiteCacheBehavior: StaticSiteCacheBehavior{Policy: aws.String("658327ea-f89d-4fab-a63d-7e88639e58f6")}},
+					},
+					Invalidation: StringSliceOrString{StringSlice: []string{"/index.html", "/css/*"}},
+				},
+			},
+		},
+		"error if the cache is invalid": {
+			in: StaticSiteHTTP{
+				Cache: StaticSiteCache{
+					Invalidation: StringSliceOrString{String: aws.String("some")},
+				},
+			},
+			wantedError: errors.New(`validate "cache": "invalidation" some must be "all", "none", or a path that starts with "/"`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStaticSiteCache_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in          StaticSiteCache
+		wantedError error
+	}{
+		"error if both a policy and TTLs are specified": {
+			in: StaticSiteCache{
+				StaticSiteCacheBehavior: StaticSiteCacheBehavior{
+					Policy: aws.String("id"),
+					TTL:    StaticSiteCacheTTL{Max: durationp(time.Hour)},
+				},
+			},
+			wantedError: errors.New(`must specify one, not both, of "policy" and "ttl"`),
+		},
+		"error if a TTL isn't a whole number of seconds": {
+			in: StaticSiteCache{
+				StaticSiteCacheBehavior: StaticSiteCacheBehavior{
+					TTL: StaticSiteCacheTTL{Default: durationp(1500 * time.Millisecond)},
+				},
+			},
+			wantedError: errors.New(`validate "ttl": "default" 1.5s must be a whole number of seconds that is not negative`),
+		},
+		"error if the TTLs aren't in increasing order": {
+			in: StaticSiteCache{
+				StaticSiteCacheBehavior: StaticSiteCacheBehavior{
+					TTL: StaticSiteCacheTTL{Min: durationp(time.Hour), Max: durationp(time.Minute)},
+				},
+			},
+			wantedError: errors.New(`validate "ttl": "min" 3600s, "default" 60s and "max" 60s must be in increasing order`),
+		},
+		"error if a path has no pattern": {
+			in: StaticSiteCache{
+				Paths: []StaticSiteCachePath{
+					{StaticSiteCacheBehavior: StaticSiteCacheBehavior{Policy: aws.String("id")}},
+				},
+			},
+			wantedError: errors.New(`validate "paths[0]": "path" must be specified`),
+		},
+		"error if a path configures nothing": {
+			in: StaticSiteCache{
+				Paths: []StaticSiteCachePath{
+					{Path: "/assets/*"},
+				},
+			},
+			wantedError: errors.New(`validate "paths[0]": must specify at least one of "policy", "origin_request_policy" or "ttl"`),
+		},
+		"error if a path is specified twice": {
+			in: StaticSiteCache{
+				Paths: []StaticSiteCachePath{
+					{Path: "/assets/*", StaticSiteCacheBehavior: StaticSiteCacheBehavior{Policy: aws.String("id")}},
+					{Path: "/assets/*", StaticSiteCacheBehavior: StaticSiteCacheBehavior{OriginRequestPolicy: aws.String("id")}},
+				},
+			},
+			wantedError: errors.New(`"paths[1]" /assets/* is specified more than once`),
+		},
+		"error if an invalidation path is relative": {
+			in: StaticSiteCache{
+				Invalidation: StringSliceOrString{StringSlice: []string{"/index.html", "css/*"}},
+			},
+			wantedError: errors.New(`"invalidation" path css/* must start with "/"`),
+		},
+		"no invalidation": {
+			in: StaticSiteCache{
+				Invalidation: StringSliceOrString{String: aws.String("none")},
+			},
+		},
+		"single invalidation path": {
+			in: StaticSiteCache{
+				Invalidation: StringSliceOrString{String: aws.String("/index.html")},
+			},
+		},
+		"only the min TTL raises the default TTL": {
+			in: StaticSiteCache{
+				StaticSiteCacheBehavior: StaticSiteCacheBehavior{
+					TTL: StaticSiteCacheTTL{Min: durationp(48 * time.Hour)},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStaticSiteRedirect_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in          StaticSiteRedirect
+		wantedError error
+	}{
+		"error if from is not specified": {
+			in: StaticSiteRedirect{
+				To: "/new",
+			},
+			wantedError: errors.New(`"from" must be specified`),
+		},
+		"error if from does not start with a slash": {
+			in: StaticSiteRedirect{
+				From: "old",
+				To:   "/new",
+			},
+			wantedError: errors.New(`"from" old must start with "/"`),
+		},
+		"error if from has a wildcard in the middle": {
+			in: StaticSiteRedirect{
+				From: "/old/*/index.html",
+				To:   "/new",
+			},
+			wantedError: errors.New(`"from" /old/*/index.html can only contain a wildcard "*" at the end`),
+		},
+		"error if to has a wildcard but from does not": {
+			in: StaticSiteRedirect{
+				From: "/old",
+				To:   "/new/*",
+			},
+			wantedError: errors.New(`"to" /new/* can only end with a wildcard "*" if "from" ends with one`),
+		},
+		"error if the status code is not a redirect": {
+			in: StaticSiteRedirect{
+				From:       "/old",
+				To:         "/new",
+				StatusCode: aws.Int(200),
+			},
+			wantedError: errors.New(`"status_code" 200 must be one of 301, 302, 307 or 308`),
+		},
+		"valid wildcard redirect": {
+			in: StaticSiteRedirect{
+				From:       "/old/*",
+				To:         "/new/*",
+				StatusCode: aws.Int(302),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAPIGateway_validate(t *testing.T) {
+	testCases := map[string]struct {
+		in          APIGateway
+		wantedError error
+	}{
+		"valid if empty": {},
+		"error if the protocol is invalid": {
+			in: APIGateway{
+				Protocol: aws.String("rest"),
+			},
+			wantedError: errors.New(`"protocol" "rest" must be one of http or websocket`),
+		},
+		"error if routes are specified for an http api": {
+			in: APIGateway{
+				Routes: []string{"sendmessage"},
+			},
+			wantedError: errors.New(`"routes" can only be specified if "protocol" is "websocket"`),
+		},
+		"error if a route is reserved": {
+			in: APIGateway{
+				Protocol: aws.String("websocket"),
+				Routes:   []string{"sendmessage", "$connect"},
+			},
+			wantedError: errors.New(`"routes[1]" $connect must not start with "$" since the $connect, $disconnect and $default routes are always created`),
+		},
+		"error if an authorizer is specified for a websocket api": {
+			in: APIGateway{
+				Protocol: aws.String("websocket"),
+				Authorizer: APIGatewayAuthorizer{
+					JWT: JWTAuthorizer{
+						Issuer:   aws.String("https://cognito-idp.us-west-2.amazonaws.com/us-west-2_abc"),
+						Audience: []string{"client"},
+					},
+				},
+			},
+			wantedError: errors.New(`"authorizer" can only be specified if "protocol" is "http"`),
+		},
+		"error if the jwt issuer is missing": {
+			in: APIGateway{
+				Authorizer: APIGatewayAuthorizer{
+					JWT: JWTAuthorizer{
+						Audience: []string{"client"},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "authorizer": validate "jwt": "issuer" must be specified`),
+		},
+		"error if the jwt issuer is not an https url": {
+			in: APIGateway{
+				Authorizer: APIGatewayAuthorizer{
+					JWT: JWTAuthorizer{
+						Issuer:   aws.String("example.com"),
+						Audience: []string{"client"},
+					},
+				},
+			},
+			wantedError: errors.New(`validate "authorizer": validate "jwt": "issuer" example.com must be an HTTPS URL`),
+		},
+		"error if the jwt audience is missing": {
+			in: APIGateway{
+				Authorizer: APIGatewayAuthorizer{
+					JWT: JWTAuthorizer{
+						Issuer: aws.String("https://example.com"),
+					},
+				},
+			},
+			wantedError: errors.New(`validate "authorizer": validate "jwt": "audience" must be specified`),
+		},
+		"error if the throttling rate is negative": {
+			in: APIGateway{
+				Throttling: APIGatewayThrottling{
+					Rate: aws.Float64(-1),
+				},
+			},
+			wantedError: errors.New(`validate "throttling": "rate" -1 must be a non-negative number`),
+		},
+		"valid http api": {
+			in: APIGateway{
+				Protocol: aws.String("http"),
+				Authorizer: APIGatewayAuthorizer{
+					JWT: JWTAuthorizer{
+						Issuer:   aws.String("https://example.com"),
+						Audience: []string{"client"},
+					},
+				},
+				Throttling: APIGatewayThrottling{
+					Rate:  aws.Float64(100),
+					Burst: aws.Int(50),
+				},
+			},
+		},
+		"valid websocket api": {
+			in: APIGateway{
+				Protocol: aws.String("websocket"),
+				Routes:   []string{"sendmessage"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestWorkflowJobConfig_validate(t *testing.T) {
+	step := func(name string) WorkflowStep {
+		return WorkflowStep{
+			Name: aws.String(name),
+			ImageConfig: ImageWithEntryPointAndCommand{
+				Image: Image{
+					ImageLocationOrBuild: ImageLocationOrBuild{
+						Build: BuildArgsOrString{BuildString: aws.String(name + "/Dockerfile")},
+					},
+				},
+			},
+		}
+	}
+	withNext := func(s WorkflowStep, next string) WorkflowStep {
+		s.Next = aws.String(next)
+		return s
+	}
+	testCases := map[string]struct {
+		config      WorkflowJobConfig
+		wantedError string
+	}{
+		"error if there are no steps": {
+			config: WorkflowJobConfig{
+				On: JobTriggerConfig{Schedule: aws.String("@daily")},
+			},
+			wantedError: `"steps" must be specified`,
+		},
+		"error if step names are not unique": {
+			config: WorkflowJobConfig{
+				On:    JobTriggerConfig{Schedule: aws.String("@daily")},
+				Steps: []WorkflowStep{step("extract"), step("extract")},
+			},
+			wantedError: `step name "extract" must be unique`,
+		},
+		"error if step names only differ by hyphens": {
+			config: WorkflowJobConfig{
+				On:    JobTriggerConfig{Schedule: aws.String("@daily")},
+				Steps: []WorkflowStep{step("extract-all"), step("extractall")},
+			},
+			wantedError: `step names "extract-all" and "extractall" must differ by more than hyphens and underscores`,
+		},
+		"error if next is not a step": {
+			config: WorkflowJobConfig{
+				On:    JobTriggerConfig{Schedule: aws.String("@daily")},
+				Steps: []WorkflowStep{withNext(step("extract"), "load")},
+			},
+			wantedError: `"steps[0].next" "load" must be the name of a step`,
+		},
+		"error if a step loops back to itself": {
+			config: WorkflowJobConfig{
+				On:    JobTriggerConfig{Schedule: aws.String("@daily")},
+				Steps: []WorkflowStep{step("extract"), withNext(step("load"), "extract")},
+			},
+			wantedError: `step "extract" must not loop back to itself through "next"`,
+		},
+		"error if a catch has no next": {
+			config: WorkflowJobConfig{
+				On: JobTriggerConfig{Schedule: aws.String("@daily")},
+				Steps: []WorkflowStep{func() WorkflowStep {
+					s := step("extract")
+					s.Catch = []WorkflowCatch{{Errors: []string{"States.TaskFailed"}}}
+					return s
+				}()},
+			},
+			wantedError: `validate "steps[0]": validate "catch[0]": "next" must be specified`,
+		},
+		"error if both next and end are specified": {
+			config: WorkflowJobConfig{
+				On: JobTriggerConfig{Schedule: aws.String("@daily")},
+				Steps: []WorkflowStep{func() WorkflowStep {
+					s := withNext(step("extract"), "load")
+					s.End = aws.Bool(true)
+					return s
+				}(), step("load")},
+			},
+			wantedError: `validate "steps[0]": must specify one, not both, of "next" and "end"`,
+		},
+		"ok": {
+			config: WorkflowJobConfig{
+				On:      JobTriggerConfig{Schedule: aws.String("@daily")},
+				Timeout: durationp(time.Hour),
+				Steps: []WorkflowStep{
+					step("extract"),
+					func() WorkflowStep {
+						s := step("load")
+						s.Catch = []WorkflowCatch{{Next: aws.String("notify")}}
+						s.End = aws.Bool(true)
+						return s
+					}(),
+					step("notify"),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.config.validate()
+
+			if tc.wantedError != "" {
+				require.EqualError(t, gotErr, tc.wantedError)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
@@ -55,25 +55,28 @@ type WorkerServiceConfig struct {
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
 	DeployConfig     WorkerDeploymentConfig    `yaml:"deployment"`
 	Observability    Observability             `yaml:"observability"`
+	Alarms           WorkerAlarmsConfig        `yaml:"alarms"`
 }
 
 // SubscribeConfig represents the configurable options for setting up subscriptions.
 type SubscribeConfig struct {
 	Topics []TopicSubscription `yaml:"topics"`
+	Events []EventRule         `yaml:"events"`
 	Queue  SQSQueue            `yaml:"queue"`
 }
 
 // IsEmpty returns empty if the struct has all zero members.
 func (s *SubscribeConfig) IsEmpty() bool {
-	return s.Topics == nil && s.Queue.IsEmpty()
+	return s.Topics == nil && s.Events == nil && s.Queue.IsEmpty()
 }
 
 // TopicSubscription represents the configurable options for setting up a SNS Topic Subscription.
 type TopicSubscription struct {
-	Name         *string                `yaml:"name"`
-	Service      *string                `yaml:"service"`
-	FilterPolicy map[string]interface{} `yaml:"filter_policy"`
-	Queue        SQSQueueOrBool         `yaml:"queue"`
+	Name              *string                `yaml:"name"`
+	Service           *string                `yaml:"service"`
+	FilterPolicy      map[string]interface{} `yaml:"filter_policy"`
+	FilterPolicyScope *string                `yaml:"filter_policy_scope"` // Either "MessageAttributes" or "MessageBody".
+	Queue             SQSQueueOrBool         `yaml:"queue"`
 }
 
 // SQSQueueOrBool is a custom type which supports unmarshaling yaml which
@@ -117,6 +120,7 @@ type SQSQueue struct {
 	Timeout    *time.Duration          `yaml:"timeout"`
 	DeadLetter DeadLetterQueue         `yaml:"dead_letter"`
 	FIFO       FIFOAdvanceConfigOrBool `yaml:"fifo"`
+	KMSKey     *string                 `yaml:"kms_key"` // ARN of a customer managed KMS key to encrypt the messages.
 }
 
 // FIFOAdvanceConfigOrBool represents the configurable options for fifo queues.
@@ -171,17 +175,18 @@ func (t *FIFOAdvanceConfigOrBool) UnmarshalYAML(value *yaml.Node) error {
 // IsEmpty returns empty if the struct has all zero members.
 func (q *SQSQueue) IsEmpty() bool {
 	return q.Retention == nil && q.Delay == nil && q.Timeout == nil &&
-		q.DeadLetter.IsEmpty() && q.FIFO.IsEmpty()
+		q.DeadLetter.IsEmpty() && q.FIFO.IsEmpty() && q.KMSKey == nil
 }
 
 // DeadLetterQueue represents the configurable options for setting up a Dead-Letter Queue.
 type DeadLetterQueue struct {
-	Tries *uint16 `yaml:"tries"`
+	Tries     *uint16        `yaml:"tries"`
+	Retention *time.Duration `yaml:"retention"`
 }
 
 // IsEmpty returns empty if the struct has all zero members.
 func (q *DeadLetterQueue) IsEmpty() bool {
-	return q.Tries == nil
+	return q.Tries == nil && q.Retention == nil
 }
 
 // WorkerServiceProps represents the configuration needed to create a worker service.
@@ -285,6 +290,41 @@ func (s *WorkerService) BuildArgs(contextDir string) (map[string]*DockerBuildArg
 	return buildArgs(contextDir, buildArgsPerContainer, s.Sidecars)
 }
 
+// ImageLocations returns the image location of each container that runs an existing image instead of building one.
+func (s *WorkerService) ImageLocations() map[string]string {
+	return imageLocations(aws.StringValue(s.Name), s.ImageConfig.Image, s.Sidecars)
+}
+
+// ImageBuilder returns the builder of the container images, or empty if it's not specified.
+func (s *WorkerService) ImageBuilder() string {
+	return s.ImageConfig.Image.GetBuilder()
+}
+
+// ImagePlatforms returns the platforms to build a multi-platform image of the main container for, or nil if it's not specified.
+func (s *WorkerService) ImagePlatforms() []string {
+	return s.ImageConfig.Image.Platforms
+}
+
+// ImageSigning returns the configuration to sign the images built for the workload.
+func (s *WorkerService) ImageSigning() ImageSigning {
+	return s.ImageConfig.Image.Signing
+}
+
+// ImageScan returns the configuration to gate the deployment on the scan of the images built for the workload.
+func (s *WorkerService) ImageScan() ImageScan {
+	return s.ImageConfig.Image.Scan
+}
+
+// ImageSBOM returns the configuration to generate the software bills of materials of the images built for the workload.
+func (s *WorkerService) ImageSBOM() ImageSBOM {
+	return s.ImageConfig.Image.SBOM
+}
+
+// DeploymentHooks returns the one-off tasks that run before and after the tasks of the service are replaced.
+func (s *WorkerService) DeploymentHooks() DeploymentHooks {
+	return s.DeployConfig.DeploymentHooks
+}
+
 // EnvFiles returns the locations of all env files against the ws root directory.
 // This method returns a map[string]string where the keys are container names
 // and the values are either env file paths or empty strings.
@@ -292,6 +332,12 @@ func (s *WorkerService) EnvFiles() map[string]string {
 	return envFiles(s.Name, s.TaskConfig, s.Logging, s.Sidecars)
 }
 
+// VariablesEnvFiles returns the paths of the env files against the ws root directory whose variables are
+// rendered in the containers at package time. The keys are container names.
+func (s *WorkerService) VariablesEnvFiles() map[string]string {
+	return variablesEnvFiles(s.Name, s.TaskConfig, s.Sidecars)
+}
+
 // Subscriptions returns a list of TopicSubscriotion objects which represent the SNS topics the service
 // receives messages from. This method also appends ".fifo" to the topics and returns a new set of subs.
 func (s *WorkerService) Subscriptions() []TopicSubscription {
@@ -489,10 +489,10 @@ func TestWorkerSvc_ApplyEnv(t *testing.T) {
 				},
 			},
 			Logging: Logging{
-				Destination: map[string]string{
+				Destination: BasicToUnion[map[string]string, LogDestinationConfig](map[string]string{
 					"Name":            "datadog",
 					"exclude-pattern": "*",
-				},
+				}),
 			},
 			Subscribe: SubscribeConfig{
 				Topics: []TopicSubscription{
@@ -533,10 +533,10 @@ func TestWorkerSvc_ApplyEnv(t *testing.T) {
 					},
 				},
 				Logging: Logging{
-					Destination: map[string]string{
+					Destination: BasicToUnion[map[string]string, LogDestinationConfig](map[string]string{
 						"include-pattern": "*",
 						"exclude-pattern": "fe/",
-					},
+					}),
 				},
 				Subscribe: SubscribeConfig{
 					Topics: []TopicSubscription{
@@ -931,11 +931,11 @@ func TestWorkerSvc_ApplyEnv(t *testing.T) {
 						},
 					},
 					Logging: Logging{
-						Destination: map[string]string{
+						Destination: BasicToUnion[map[string]string, LogDestinationConfig](map[string]string{
 							"Name":            "datadog",
 							"include-pattern": "*",
 							"exclude-pattern": "fe/",
-						},
+						}),
 					},
 					Subscribe: SubscribeConfig{
 						Topics: []TopicSubscription{
@@ -1457,16 +1457,20 @@ func TestSQSQueueOrBool_UnmarshalYAML(t *testing.T) {
   retention: 5s
   delay: 1m
   timeout: 5m
+  kms_key: arn:aws:kms:us-west-2:123456789012:key/1234abcd
   dead_letter:
-    tries: 10`),
+    tries: 10
+    retention: 336h`),
 
 			wantedStruct: SQSQueueOrBool{
 				Advanced: SQSQueue{
 					Retention: durationp(5 * time.Second),
 					Delay:     durationp(1 * time.Minute),
 					Timeout:   durationp(5 * time.Minute),
+					KMSKey:    aws.String("arn:aws:kms:us-west-2:123456789012:key/1234abcd"),
 					DeadLetter: DeadLetterQueue{
-						Tries: uint16P(10),
+						Tries:     uint16P(10),
+						Retention: durationp(14 * 24 * time.Hour),
 					},
 				},
 			},
@@ -1491,6 +1495,7 @@ func TestSQSQueueOrBool_UnmarshalYAML(t *testing.T) {
 				require.Equal(t, tc.wantedStruct.Advanced.Delay, sc.Queue.Advanced.Delay)
 				require.Equal(t, tc.wantedStruct.Advanced.Retention, sc.Queue.Advanced.Retention)
 				require.Equal(t, tc.wantedStruct.Advanced.Timeout, sc.Queue.Advanced.Timeout)
+				require.Equal(t, tc.wantedStruct.Advanced.KMSKey, sc.Queue.Advanced.KMSKey)
 			}
 		})
 	}
@@ -0,0 +1,209 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/imdario/mergo"
+)
+
+const (
+	workflowJobManifestPath = "workloads/jobs/workflow-job/manifest.yml"
+
+	defaultWorkflowStepCPU    = 256
+	defaultWorkflowStepMemory = 512
+)
+
+// WorkflowJob holds the configuration to run a sequence of containerized steps, each as an ECS task,
+// orchestrated by a Step Functions state machine.
+type WorkflowJob struct {
+	Workload          `yaml:",inline"`
+	WorkflowJobConfig `yaml:",inline"`
+	// Use *WorkflowJobConfig because of https://github.com/imdario/mergo/issues/146
+	Environments map[string]*WorkflowJobConfig `yaml:",flow"` // Fields to override per environment.
+
+	parser template.Parser
+}
+
+// WorkflowJobConfig holds the configuration for a workflow job that can be overridden per environments.
+type WorkflowJobConfig struct {
+	On       JobTriggerConfig     `yaml:"on,flow"`
+	Timeout  *time.Duration       `yaml:"timeout"` // Maximum duration of an execution of the whole workflow.
+	Steps    []WorkflowStep       `yaml:"steps"`
+	Platform PlatformArgsOrString `yaml:"platform,omitempty"`
+	Network  NetworkConfig        `yaml:"network"`
+}
+
+// WorkflowStep represents a step of the workflow that runs a container as an ECS task.
+type WorkflowStep struct {
+	Name        *string                       `yaml:"name"`
+	ImageConfig ImageWithEntryPointAndCommand `yaml:"image"`
+	CPU         *int                          `yaml:"cpu"`
+	Memory      *int                          `yaml:"memory"`
+	Variables   map[string]Variable           `yaml:"variables"`
+	Secrets     map[string]Secret             `yaml:"secrets"`
+
+	// JSONPath expressions that select the input of the step, where to place the result of its task, and its output.
+	InputPath  *string `yaml:"input_path"`
+	ResultPath *string `yaml:"result_path"`
+	OutputPath *string `yaml:"output_path"`
+
+	Timeout *time.Duration  `yaml:"timeout"`
+	Retries *int            `yaml:"retries"`
+	Catch   []WorkflowCatch `yaml:"catch"`
+
+	// Transition to the next step. Defaults to the following step in the list, or to the end of the workflow for the last step.
+	Next *string `yaml:"next"`
+	End  *bool   `yaml:"end"`
+}
+
+// WorkflowCatch represents a fallback step when the task of a step fails.
+type WorkflowCatch struct {
+	Errors     []string `yaml:"errors"` // Names of the errors to catch. Defaults to all the errors.
+	Next       *string  `yaml:"next"`
+	ResultPath *string  `yaml:"result_path"`
+}
+
+// WorkflowJobProps contains properties for creating a new workflow job manifest.
+type WorkflowJobProps struct {
+	*WorkloadProps
+	Schedule string
+	Platform PlatformArgsOrString // Optional platform configuration.
+}
+
+// NewWorkflowJob creates a new workflow job manifest with a single step that runs the image of the job.
+func NewWorkflowJob(props *WorkflowJobProps) *WorkflowJob {
+	job := newDefaultWorkflowJob()
+	job.Name = stringP(props.Name)
+	job.On.Schedule = stringP(props.Schedule)
+	job.Platform = props.Platform
+	step := WorkflowStep{
+		Name:   stringP(props.Name),
+		CPU:    aws.Int(defaultWorkflowStepCPU),
+		Memory: aws.Int(defaultWorkflowStepMemory),
+	}
+	step.ImageConfig.Image.Build.BuildArgs.Dockerfile = stringP(props.Dockerfile)
+	step.ImageConfig.Image.Location = stringP(props.Image)
+	job.Steps = []WorkflowStep{step}
+	for _, envName := range props.PrivateOnlyEnvironments {
+		job.Environments[envName] = &WorkflowJobConfig{
+			Network: NetworkConfig{
+				VPC: vpcConfig{
+					Placement: PlacementArgOrString{
+						PlacementString: placementStringP(PrivateSubnetPlacement),
+					},
+				},
+			},
+		}
+	}
+	job.parser = template.New()
+	return job
+}
+
+// MarshalBinary serializes the manifest object into a binary YAML document.
+// Implements the encoding.BinaryMarshaler interface.
+func (j *WorkflowJob) MarshalBinary() ([]byte, error) {
+	content, err := j.parser.Parse(workflowJobManifestPath, *j)
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+func (j WorkflowJob) applyEnv(envName string) (workloadManifest, error) {
+	overrideConfig, ok := j.Environments[envName]
+	if !ok || overrideConfig == nil {
+		return &j, nil
+	}
+	// Apply overrides to the original job configuration.
+	for _, t := range defaultTransformers {
+		err := mergo.Merge(&j, WorkflowJob{
+			WorkflowJobConfig: *overrideConfig,
+		}, mergo.WithOverride, mergo.WithTransformers(t))
+		if err != nil {
+			return nil, err
+		}
+	}
+	j.Environments = nil
+	return &j, nil
+}
+
+func (j *WorkflowJob) subnets() *SubnetListOrArgs {
+	return &j.Network.VPC.Placement.Subnets
+}
+
+func (j *WorkflowJob) requiredEnvironmentFeatures() []string {
+	return j.Network.requiredEnvFeatures()
+}
+
+// NextStep returns the name of the step that runs after the step at the given index succeeds,
+// or an empty string if the workflow ends with the step.
+func (j *WorkflowJob) NextStep(idx int) string {
+	step := j.Steps[idx]
+	switch {
+	case step.Next != nil:
+		return aws.StringValue(step.Next)
+	case aws.BoolValue(step.End), idx == len(j.Steps)-1:
+		return ""
+	default:
+		return aws.StringValue(j.Steps[idx+1].Name)
+	}
+}
+
+// ContainerPlatform returns the platform of the tasks of the steps.
+func (j *WorkflowJob) ContainerPlatform() string {
+	if j.Platform.IsEmpty() {
+		return ""
+	}
+	return platformString(j.Platform.OS(), j.Platform.Arch())
+}
+
+// BuildArgs returns a docker.BuildArguments object per step given a context directory.
+func (j *WorkflowJob) BuildArgs(contextDir string) (map[string]*DockerBuildArgs, error) {
+	buildArgsPerStep := make(map[string]*DockerBuildArgs, len(j.Steps))
+	for _, step := range j.Steps {
+		required, err := requiresBuild(step.ImageConfig.Image)
+		if err != nil {
+			return nil, err
+		}
+		if required {
+			buildArgsPerStep[aws.StringValue(step.Name)] = step.ImageConfig.Image.BuildConfig(contextDir)
+		}
+	}
+	return buildArgsPerStep, nil
+}
+
+// ImageBuilder returns the builder of the container images of the first step that specifies one,
+// or empty if none is specified.
+func (j *WorkflowJob) ImageBuilder() string {
+	for _, step := range j.Steps {
+		if builder := step.ImageConfig.Image.GetBuilder(); builder != "" {
+			return builder
+		}
+	}
+	return ""
+}
+
+// newDefaultWorkflowJob returns an empty WorkflowJob with only the default values set.
+func newDefaultWorkflowJob() *WorkflowJob {
+	return &WorkflowJob{
+		Workload: Workload{
+			Type: aws.String(manifestinfo.WorkflowJobType),
+		},
+		WorkflowJobConfig: WorkflowJobConfig{
+			Network: NetworkConfig{
+				VPC: vpcConfig{
+					Placement: PlacementArgOrString{
+						PlacementString: placementStringP(PublicSubnetPlacement),
+					},
+				},
+			},
+		},
+		Environments: map[string]*WorkflowJobConfig{},
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWorkflowJob(t *testing.T) {
+	// GIVEN
+	props := &WorkflowJobProps{
+		WorkloadProps: &WorkloadProps{
+			Name:                    "etl",
+			Dockerfile:              "./etl/Dockerfile",
+			PrivateOnlyEnvironments: []string{"prod"},
+		},
+		Schedule: "@daily",
+	}
+
+	// WHEN
+	job := NewWorkflowJob(props)
+	content, err := job.MarshalBinary()
+	require.NoError(t, err)
+	mft, err := UnmarshalWorkload(content)
+	require.NoError(t, err)
+
+	// THEN
+	got := mft.Manifest().(*WorkflowJob)
+	require.Equal(t, aws.String("etl"), got.Name)
+	require.Equal(t, aws.String(manifestinfo.WorkflowJobType), got.Type)
+	require.Equal(t, aws.String("@daily"), got.On.Schedule)
+	require.Len(t, got.Steps, 1)
+	require.Equal(t, aws.String("etl"), got.Steps[0].Name)
+	require.Equal(t, aws.String("./etl/Dockerfile"), got.Steps[0].ImageConfig.Image.Build.BuildString)
+	require.Equal(t, aws.Int(256), got.Steps[0].CPU)
+	require.Equal(t, aws.Int(512), got.Steps[0].Memory)
+	require.Equal(t, PrivateSubnetPlacement, *got.Environments["prod"].Network.VPC.Placement.PlacementString)
+	require.NoError(t, got.validate())
+}
+
+func TestWorkflowJob_UnmarshalWorkload(t *testing.T) {
+	// GIVEN
+	in := []byte(`
+name: etl
+type: Workflow Job
+on:
+  schedule: "@daily"
+timeout: 2h
+steps:
+  - name: extract
+    image:
+      build: ./extract/Dockerfile
+    cpu: 1024
+    memory: 2048
+    retries: 2
+    timeout: 30m
+    result_path: $.extract
+  - name: load
+    image:
+      location: public.ecr.aws/example/load:latest
+      command: ["load", "--all"]
+    input_path: $.extract
+    catch:
+      - errors: ["States.TaskFailed"]
+        next: notify
+        result_path: $.error
+    end: true
+  - name: notify
+    image:
+      location: public.ecr.aws/example/notify:latest
+    variables:
+      CHANNEL: etl
+`)
+
+	// WHEN
+	mft, err := UnmarshalWorkload(in)
+
+	// THEN
+	require.NoError(t, err)
+	got := mft.Manifest().(*WorkflowJob)
+	require.Equal(t, durationp(2*time.Hour), got.Timeout)
+	require.Equal(t, PublicSubnetPlacement, *got.Network.VPC.Placement.PlacementString, "steps run in public subnets by default")
+	require.Len(t, got.Steps, 3)
+	require.Equal(t, aws.Int(2), got.Steps[0].Retries)
+	require.Equal(t, durationp(30*time.Minute), got.Steps[0].Timeout)
+	require.Equal(t, aws.String("$.extract"), got.Steps[0].ResultPath)
+	require.Equal(t, []string{"load", "--all"}, got.Steps[1].ImageConfig.Command.StringSlice)
+	require.Equal(t, []WorkflowCatch{
+		{
+			Errors:     []string{"States.TaskFailed"},
+			Next:       aws.String("notify"),
+			ResultPath: aws.String("$.error"),
+		},
+	}, got.Steps[1].Catch)
+	require.NoError(t, got.validate())
+
+	buildArgs, err := got.BuildArgs("/ws")
+	require.NoError(t, err)
+	require.Equal(t, []string{"extract"}, func() []string {
+		var names []string
+		for name := range buildArgs {
+			names = append(names, name)
+		}
+		return names
+	}(), "only the steps with a build are built")
+}
+
+func TestWorkflowJob_NextStep(t *testing.T) {
+	job := &WorkflowJob{
+		WorkflowJobConfig: WorkflowJobConfig{
+			Steps: []WorkflowStep{
+				{Name: aws.String("extract")},
+				{Name: aws.String("transform"), Next: aws.String("notify")},
+				{Name: aws.String("load"), End: aws.Bool(true)},
+				{Name: aws.String("notify")},
+			},
+		},
+	}
+
+	require.Equal(t, "transform", job.NextStep(0), "defaults to the following step")
+	require.Equal(t, "notify", job.NextStep(1))
+	require.Equal(t, "", job.NextStep(2))
+	require.Equal(t, "", job.NextStep(3), "the last step ends the workflow")
+}
+
+func TestWorkflowJob_ApplyEnv(t *testing.T) {
+	testCases := map[string]struct {
+		in         *WorkflowJob
+		envToApply string
+
+		wanted *WorkflowJob
+	}{
+		"without existing environments": {
+			in: &WorkflowJob{
+				Workload: Workload{
+					Name: aws.String("etl"),
+					Type: aws.String(manifestinfo.WorkflowJobType),
+				},
+				WorkflowJobConfig: WorkflowJobConfig{
+					On: JobTriggerConfig{Schedule: aws.String("@daily")},
+				},
+			},
+			envToApply: "prod",
+
+			wanted: &WorkflowJob{
+				Workload: Workload{
+					Name: aws.String("etl"),
+					Type: aws.String(manifestinfo.WorkflowJobType),
+				},
+				WorkflowJobConfig: WorkflowJobConfig{
+					On: JobTriggerConfig{Schedule: aws.String("@daily")},
+				},
+			},
+		},
+		"with overrides": {
+			in: &WorkflowJob{
+				Workload: Workload{
+					Name: aws.String("etl"),
+					Type: aws.String(manifestinfo.WorkflowJobType),
+				},
+				WorkflowJobConfig: WorkflowJobConfig{
+					On:      JobTriggerConfig{Schedule: aws.String("@daily")},
+					Timeout: durationp(time.Hour),
+					Steps: []WorkflowStep{
+						{Name: aws.String("extract")},
+					},
+				},
+				Environments: map[string]*WorkflowJobConfig{
+					"prod": {
+						On:      JobTriggerConfig{Schedule: aws.String("@hourly")},
+						Timeout: durationp(2 * time.Hour),
+					},
+				},
+			},
+			envToApply: "prod",
+
+			wanted: &WorkflowJob{
+				Workload: Workload{
+					Name: aws.String("etl"),
+					Type: aws.String(manifestinfo.WorkflowJobType),
+				},
+				WorkflowJobConfig: WorkflowJobConfig{
+					On:      JobTriggerConfig{Schedule: aws.String("@hourly")},
+					Timeout: durationp(2 * time.Hour),
+					Steps: []WorkflowStep{
+						{Name: aws.String("extract")},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.in.applyEnv(tc.envToApply)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
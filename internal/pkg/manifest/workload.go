@@ -33,6 +33,12 @@ const (
 	sqsDeduplicationScopeQueue              = "queue"
 )
 
+// SNS Topic Subscription field options.
+const (
+	snsFilterPolicyScopeMessageAttributes = "MessageAttributes"
+	snsFilterPolicyScopeMessageBody       = "MessageBody"
+)
+
 // AWS VPC subnet placement options.
 const (
 	PublicSubnetPlacement  = PlacementString("public")
@@ -44,9 +50,18 @@ var (
 	subnetPlacements = []string{string(PublicSubnetPlacement), string(PrivateSubnetPlacement)}
 )
 
+// Compute options to run the tasks of a workload.
+const (
+	ComputeFargate = "fargate"
+	ComputeEC2     = "ec2"
+)
+
+var computeOptions = []string{ComputeFargate, ComputeEC2}
+
 // Error definitions.
 var (
 	ErrAppRunnerInvalidPlatformWindows = errors.New("Windows is not supported for App Runner services")
+	ErrLambdaInvalidPlatformWindows    = errors.New("Windows is not supported for Lambda services")
 
 	errUnmarshalBuildOpts          = errors.New("unable to unmarshal build field into string or compose-style map")
 	errUnmarshalPlatformOpts       = errors.New("unable to unmarshal platform field into string or compose-style map")
@@ -88,27 +103,39 @@ func UnmarshalWorkload(in []byte) (DynamicWorkload, error) {
 		return nil, fmt.Errorf("unmarshal to workload manifest: %w", err)
 	}
 	typeVal := aws.StringValue(am.Type)
-	var m workloadManifest
+	m, err := newDefaultWorkloadManifest(typeVal)
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(in, m); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest for %s: %w", typeVal, err)
+	}
+	return newDynamicWorkloadManifest(m), nil
+}
+
+// newDefaultWorkloadManifest returns a manifest of the workload type with default values.
+// If the workload type is invalid, then returns an ErrInvalidWorkloadType.
+func newDefaultWorkloadManifest(typeVal string) (workloadManifest, error) {
 	switch typeVal {
 	case manifestinfo.LoadBalancedWebServiceType:
-		m = newDefaultLoadBalancedWebService()
+		return newDefaultLoadBalancedWebService(), nil
 	case manifestinfo.RequestDrivenWebServiceType:
-		m = newDefaultRequestDrivenWebService()
+		return newDefaultRequestDrivenWebService(), nil
 	case manifestinfo.BackendServiceType:
-		m = newDefaultBackendService()
+		return newDefaultBackendService(), nil
 	case manifestinfo.WorkerServiceType:
-		m = newDefaultWorkerService()
+		return newDefaultWorkerService(), nil
 	case manifestinfo.StaticSiteType:
-		m = newDefaultStaticSite()
+		return newDefaultStaticSite(), nil
+	case manifestinfo.LambdaServiceType:
+		return newDefaultLambdaService(), nil
 	case manifestinfo.ScheduledJobType:
-		m = newDefaultScheduledJob()
+		return newDefaultScheduledJob(), nil
+	case manifestinfo.WorkflowJobType:
+		return newDefaultWorkflowJob(), nil
 	default:
 		return nil, &ErrInvalidWorkloadType{Type: typeVal}
 	}
-	if err := yaml.Unmarshal(in, m); err != nil {
-		return nil, fmt.Errorf("unmarshal manifest for %s: %w", typeVal, err)
-	}
-	return newDynamicWorkloadManifest(m), nil
 }
 
 // WorkloadProps contains properties for creating a new workload manifest.
@@ -131,6 +158,53 @@ type Image struct {
 	Credentials          *string           `yaml:"credentials"`     // ARN of the secret containing the private repository credentials.
 	DockerLabels         map[string]string `yaml:"labels,flow"`     // Apply Docker labels to the container at runtime.
 	DependsOn            DependsOn         `yaml:"depends_on,flow"` // Add any sidecar dependencies.
+	Builder              *string           `yaml:"builder"`         // Tool to build the images of the workload with.
+	Platforms            []string          `yaml:"platforms"`       // Platforms to build a multi-platform image for.
+	Signing              ImageSigning      `yaml:"signing"`         // Sign the images built for the workload.
+	Scan                 ImageScan         `yaml:"scan"`            // Gate deployments on the vulnerabilities found in the images.
+	SBOM                 ImageSBOM         `yaml:"sbom"`            // Generate software bills of materials of the images.
+}
+
+// SBOMFormats are the formats of the software bills of materials that can be generated for an image.
+var SBOMFormats = []string{"spdx", "cyclonedx"}
+
+// ImageSBOM represents the configuration to generate the software bills of materials of the images built for a workload.
+type ImageSBOM struct {
+	Format *string `yaml:"format"` // One of SBOMFormats.
+}
+
+// IsEmpty returns true if SBOM generation isn't configured.
+func (s ImageSBOM) IsEmpty() bool {
+	return s.Format == nil
+}
+
+// ImageScanSeverities are the severities of vulnerabilities found by ECR image scanning, from the most to the least severe.
+var ImageScanSeverities = []string{"critical", "high", "medium", "low", "informational"}
+
+// ImageScan represents the configuration to wait for the ECR scan of the images built for a workload before deploying them.
+type ImageScan struct {
+	BlockOn *string `yaml:"block_on"` // Lowest severity of the vulnerabilities that fail the deployment.
+}
+
+// IsEmpty returns true if scanning isn't configured.
+func (s ImageScan) IsEmpty() bool {
+	return s.BlockOn == nil
+}
+
+// ImageSigning represents the configuration to sign the images built for a workload with cosign.
+type ImageSigning struct {
+	KMSKey  *string `yaml:"kms_key"` // ID, ARN, or alias of the AWS KMS key to sign images with.
+	Keyless *bool   `yaml:"keyless"` // Sign images with a short-lived certificate bound to an OIDC identity.
+}
+
+// IsEmpty returns true if signing isn't configured.
+func (s ImageSigning) IsEmpty() bool {
+	return s.KMSKey == nil && s.Keyless == nil
+}
+
+// Enabled returns true if the images should be signed.
+func (s ImageSigning) Enabled() bool {
+	return s.KMSKey != nil || aws.BoolValue(s.Keyless)
 }
 
 // ImageLocationOrBuild represents the docker build arguments and location of the existing image.
@@ -165,6 +239,15 @@ func (i Image) GetLocation() string {
 	return aws.StringValue(i.Location)
 }
 
+// GetBuilder returns the builder of the images, or empty if it's not specified.
+// Images for multiple platforms are built with buildx unless another builder is specified.
+func (i Image) GetBuilder() string {
+	if i.Builder == nil && len(i.Platforms) > 1 {
+		return dockerengine.BuilderBuildx
+	}
+	return aws.StringValue(i.Builder)
+}
+
 // BuildConfig populates a docker.BuildArguments struct from the fields available in the manifest.
 // Prefer the following hierarchy:
 // 1. Specific dockerfile, specific context
@@ -406,6 +489,14 @@ func (b *DockerBuildArgs) isEmpty() bool {
 	return false
 }
 
+// EventRule represents the configurable options for setting up an EventBridge rule
+// that forwards the matching events to the workload.
+type EventRule struct {
+	Name    *string                `yaml:"name"`
+	Bus     *string                `yaml:"bus"` // Name or ARN of the event bus. Defaults to the default event bus of the account.
+	Pattern map[string]interface{} `yaml:"pattern"`
+}
+
 // PublishConfig represents the configurable options for setting up publishers.
 type PublishConfig struct {
 	Topics []Topic `yaml:"topics"`
@@ -413,8 +504,9 @@ type PublishConfig struct {
 
 // Topic represents the configurable options for setting up a SNS Topic.
 type Topic struct {
-	Name *string                      `yaml:"name"`
-	FIFO FIFOTopicAdvanceConfigOrBool `yaml:"fifo"`
+	Name   *string                      `yaml:"name"`
+	FIFO   FIFOTopicAdvanceConfigOrBool `yaml:"fifo"`
+	KMSKey *string                      `yaml:"kms_key"` // ARN of a customer managed KMS key to encrypt the messages.
 }
 
 // FIFOTopicAdvanceConfigOrBool represents the configurable options for fifo topics.
@@ -474,10 +566,14 @@ func (c *NetworkConfig) IsEmpty() bool {
 }
 
 func (c *NetworkConfig) requiredEnvFeatures() []string {
+	var features []string
 	if aws.StringValue((*string)(c.VPC.Placement.PlacementString)) == string(PrivateSubnetPlacement) {
-		return []string{template.NATFeatureName}
+		features = append(features, template.NATFeatureName)
 	}
-	return nil
+	if aws.BoolValue(c.Connect.TLS) {
+		features = append(features, template.ServiceConnectTLSFeatureName)
+	}
+	return features
 }
 
 // ServiceConnectBoolOrArgs represents ECS Service Connect configuration.
@@ -514,10 +610,66 @@ func (s *ServiceConnectBoolOrArgs) UnmarshalYAML(value *yaml.Node) error {
 // ServiceConnectArgs includes the advanced configuration for ECS Service Connect.
 type ServiceConnectArgs struct {
 	Alias *string
+	TLS   *bool                `yaml:"tls"`
+	Ports []ServiceConnectPort `yaml:"ports"`
 }
 
 func (s *ServiceConnectArgs) isEmpty() bool {
-	return s.Alias == nil
+	return s.Alias == nil && s.TLS == nil && len(s.Ports) == 0
+}
+
+// Application protocols of the ports exposed with Service Connect.
+const (
+	ServiceConnectAppProtocolHTTP  = "http"
+	ServiceConnectAppProtocolHTTP2 = "http2"
+	ServiceConnectAppProtocolGRPC  = "grpc"
+)
+
+// ServiceConnectPort is a container port exposed as an additional Service Connect endpoint of the service.
+type ServiceConnectPort struct {
+	Port            *uint16 `yaml:"port"`
+	TargetContainer *string `yaml:"target_container"`
+	// Alias is the DNS name of the endpoint. Defaults to the alias of the service.
+	Alias *string `yaml:"alias"`
+	// AppProtocol lets Service Connect collect protocol-specific metrics and retry failed requests.
+	AppProtocol *string `yaml:"app_protocol"`
+	// HealthCheck checks the port from the container with a tool that speaks its application protocol.
+	HealthCheck Union[*bool, ServiceConnectPortHealthCheck] `yaml:"healthcheck"`
+}
+
+// ServiceConnectPortHealthCheck holds the advanced configuration of the health check of a Service Connect port.
+type ServiceConnectPortHealthCheck struct {
+	Path *string `yaml:"path"` // Path requested on the "http" and "http2" ports. Defaults to "/".
+}
+
+// HealthCheckCommand returns the shell command that checks the health of the port with the tool of its application protocol,
+// or an empty string if the port isn't health checked.
+func (p ServiceConnectPort) HealthCheckCommand() string {
+	if !p.HealthCheck.IsAdvanced() && !aws.BoolValue(p.HealthCheck.Basic) {
+		return ""
+	}
+	port := aws.Uint16Value(p.Port)
+	path := DefaultHealthCheckPath
+	if p.HealthCheck.Advanced.Path != nil {
+		path = aws.StringValue(p.HealthCheck.Advanced.Path)
+	}
+	switch aws.StringValue(p.AppProtocol) {
+	case ServiceConnectAppProtocolGRPC:
+		return fmt.Sprintf("grpc_health_probe -addr=localhost:%d", port)
+	case ServiceConnectAppProtocolHTTP2:
+		return fmt.Sprintf("curl -fs --http2-prior-knowledge http://localhost:%d%s", port, path)
+	default:
+		return fmt.Sprintf("curl -fs http://localhost:%d%s", port, path)
+	}
+}
+
+// exposedPorts returns the port if it's not already exposed by a container.
+func (p ServiceConnectPort) exposedPorts(exposedPorts []ExposedPort, workloadName string) []ExposedPort {
+	rule := RoutingRule{
+		TargetContainer: p.TargetContainer,
+		TargetPort:      p.Port,
+	}
+	return rule.targetExposedPorts(exposedPorts, workloadName)
 }
 
 // PlacementArgOrString represents where to place tasks.
@@ -651,14 +803,48 @@ func (s *SecurityGroupsIDsOrConfig) isEmpty() bool {
 // SecurityGroupsConfig represents which security groups are attached to a task
 // and if default security group is applied.
 type SecurityGroupsConfig struct {
-	SecurityGroups []stringOrFromCFN `yaml:"groups"`
-	DenyDefault    *bool             `yaml:"deny_default"`
+	SecurityGroups []stringOrFromCFN          `yaml:"groups"`
+	DenyDefault    *bool                      `yaml:"deny_default"`
+	Ingress        []SecurityGroupIngressRule `yaml:"ingress"`
 }
 
 func (s *SecurityGroupsConfig) isEmpty() bool {
-	return len(s.SecurityGroups) == 0 && s.DenyDefault == nil
+	return len(s.SecurityGroups) == 0 && s.DenyDefault == nil && len(s.Ingress) == 0
+}
+
+// SecurityGroupIngressRule represents an inbound rule of the security group that Copilot creates for a service.
+// The traffic is allowed either from another security group or from a CIDR block.
+type SecurityGroupIngressRule struct {
+	SourceSecurityGroup stringOrFromCFN `yaml:"source_security_group"`
+	CIDR                *string         `yaml:"cidr"`
+	Ports               portsConfig     `yaml:"ports"`
+	IPProtocol          *string         `yaml:"ip_protocol"`
+}
+
+// GetPorts returns the from and to ports of the ingress rule.
+func (r SecurityGroupIngressRule) GetPorts() (from, to int, err error) {
+	return r.Ports.fromTo()
+}
+
+// Protocol returns the IP protocol of the ingress rule, which defaults to TCP.
+func (r SecurityGroupIngressRule) Protocol() string {
+	if r.IPProtocol == nil {
+		return IngressProtocolTCP
+	}
+	return aws.StringValue(r.IPProtocol)
 }
 
+// IP protocols of the ingress rules of a service security group.
+const (
+	IngressProtocolTCP = "tcp"
+	IngressProtocolUDP = "udp"
+)
+
+var ingressProtocols = []string{IngressProtocolTCP, IngressProtocolUDP}
+
+// envSecurityGroupExportSuffix is the suffix of the name that an environment exports its security group under.
+const envSecurityGroupExportSuffix = "-EnvironmentSecurityGroup"
+
 // UnmarshalYAML overrides the default YAML unmarshalling logic for the SecurityGroupsIDsOrConfig
 // struct, allowing it to be unmarshalled into a string slice or a string.
 // This method implements the yaml.Unmarshaler (v3) interface.
@@ -691,6 +877,12 @@ func (s *SecurityGroupsIDsOrConfig) GetIDs() []stringOrFromCFN {
 	return s.IDs
 }
 
+// GetIngress returns the inbound rules of the security group that Copilot creates for the tasks.
+// nil is returned if no rules are specified.
+func (s *SecurityGroupsIDsOrConfig) GetIngress() []SecurityGroupIngressRule {
+	return s.AdvancedConfig.Ingress
+}
+
 // IsDefaultSecurityGroupDenied returns true if DenyDefault is set to true
 // in SecurityGroupsIDsOrConfig.AdvancedConfig. Otherwise, false is returned.
 func (s *SecurityGroupsIDsOrConfig) IsDefaultSecurityGroupDenied() bool {
@@ -798,6 +990,9 @@ func RedirectPlatform(os, arch, wlType string) (platform string, err error) {
 	if wlType == manifestinfo.RequestDrivenWebServiceType && os == OSWindows {
 		return "", ErrAppRunnerInvalidPlatformWindows
 	}
+	if wlType == manifestinfo.LambdaServiceType && os == OSWindows {
+		return "", ErrLambdaInvalidPlatformWindows
+	}
 	// All architectures default to 'x86_64' (though 'arm64' is now also supported); leave OS as is.
 	// If a string is returned, the platform is not the default platform but is supported (except for more obscure platforms).
 	return platformString(os, dockerengine.ArchX86), nil
@@ -6,7 +6,9 @@ package manifest
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -41,6 +43,17 @@ const (
 	// deployment strategies
 	ECSDefaultRollingUpdateStrategy  = "default"
 	ECSRecreateRollingUpdateStrategy = "recreate"
+	ECSCanaryRollingUpdateStrategy   = "canary"
+	ECSLinearRollingUpdateStrategy   = "linear"
+
+	// traffic shifting defaults of canary and linear deployments
+	defaultTrafficShiftingPercent        = 10
+	defaultCanaryTrafficShiftingInterval = 5 * time.Minute
+	defaultLinearTrafficShiftingInterval = time.Minute
+
+	// deployment types
+	ECSRollingDeploymentType   = "rolling"
+	ECSBlueGreenDeploymentType = "blue/green"
 )
 
 // Platform related settings.
@@ -97,6 +110,67 @@ type WorkerAlarmArgs struct {
 	MessagesDelayed *int `yaml:"messages_delayed"`
 }
 
+// Shorthands in "deployment.rollback_alarms" that refer to the alarms generated from the "alarms" section.
+const (
+	RollbackAlarmCPU          = ".cpu"
+	RollbackAlarmMemory       = ".memory"
+	RollbackAlarmHTTP5xx      = ".5xx"
+	RollbackAlarmResponseTime = ".response_time"
+	RollbackAlarmQueueDepth   = ".queue_depth"
+)
+
+// IsGeneratedRollbackAlarm returns true if the rollback alarm is a shorthand for an alarm generated from
+// the "alarms" section, instead of the name of an existing alarm.
+func IsGeneratedRollbackAlarm(name string) bool {
+	return strings.HasPrefix(name, ".")
+}
+
+// AlarmsConfig represents the CloudWatch alarms that monitor the tasks of a service.
+type AlarmsConfig struct {
+	CPUUtilization    *float64          `yaml:"cpu_utilization"`
+	MemoryUtilization *float64          `yaml:"memory_utilization"`
+	Notify            AlarmNotifyConfig `yaml:"notify"`
+}
+
+// IsEmpty returns true if no alarms are configured.
+func (a *AlarmsConfig) IsEmpty() bool {
+	return a.CPUUtilization == nil && a.MemoryUtilization == nil && a.Notify.IsEmpty()
+}
+
+// HTTPAlarmsConfig represents the CloudWatch alarms that monitor a service behind a load balancer.
+type HTTPAlarmsConfig struct {
+	AlarmsConfig `yaml:",inline"`
+	HTTP5xxRate  *float64       `yaml:"http_5xx_rate"`
+	ResponseTime *time.Duration `yaml:"response_time"`
+}
+
+// IsEmpty returns true if no alarms are configured.
+func (a *HTTPAlarmsConfig) IsEmpty() bool {
+	return a.AlarmsConfig.IsEmpty() && a.HTTP5xxRate == nil && a.ResponseTime == nil
+}
+
+// WorkerAlarmsConfig represents the CloudWatch alarms that monitor a Worker Service.
+type WorkerAlarmsConfig struct {
+	AlarmsConfig `yaml:",inline"`
+	QueueDepth   *int `yaml:"queue_depth"`
+}
+
+// IsEmpty returns true if no alarms are configured.
+func (a *WorkerAlarmsConfig) IsEmpty() bool {
+	return a.AlarmsConfig.IsEmpty() && a.QueueDepth == nil
+}
+
+// AlarmNotifyConfig represents the SNS targets that are notified when an alarm goes off.
+type AlarmNotifyConfig struct {
+	Emails []string `yaml:"emails"`
+	Topics []string `yaml:"topics"`
+}
+
+// IsEmpty returns true if nobody is notified.
+func (n *AlarmNotifyConfig) IsEmpty() bool {
+	return len(n.Emails) == 0 && len(n.Topics) == 0
+}
+
 // DeploymentControllerConfig represents deployment strategies for a service.
 type DeploymentControllerConfig struct {
 	Rolling *string `yaml:"rolling"`
@@ -104,18 +178,124 @@ type DeploymentControllerConfig struct {
 
 // DeploymentConfig represents the deployment config for an ECS service.
 type DeploymentConfig struct {
+	Type                       *string `yaml:"type"`
 	DeploymentControllerConfig `yaml:",inline"`
 	RollbackAlarms             Union[[]string, AlarmArgs] `yaml:"rollback_alarms"`
+	BlueGreen                  BlueGreenDeploymentConfig  `yaml:"blue_green"`
+	TrafficShifting            TrafficShiftingConfig      `yaml:"traffic_shifting"`
+	DeploymentHooks            `yaml:",inline"`
+}
+
+// DeploymentHooks represents the one-off tasks that run before and after the tasks of a service are replaced.
+type DeploymentHooks struct {
+	PreDeploy  DeploymentHook `yaml:"pre_deploy"`
+	PostDeploy DeploymentHook `yaml:"post_deploy"`
+}
+
+// DeploymentHook represents a one-off task that runs the main container of the service with a command.
+// The deployment fails if the task exits with a non-zero exit code.
+type DeploymentHook struct {
+	Command CommandOverride `yaml:"command"`
+}
+
+// IsEmpty returns true if neither of the hooks are set.
+func (h DeploymentHooks) IsEmpty() bool {
+	return h.PreDeploy.IsEmpty() && h.PostDeploy.IsEmpty()
+}
+
+// IsEmpty returns true if the hook doesn't run a command.
+func (h DeploymentHook) IsEmpty() bool {
+	return (*StringSliceOrString)(&h.Command).isEmpty()
+}
+
+// TrafficShiftingConfig represents how the traffic is shifted to the new tasks of a canary or linear deployment.
+type TrafficShiftingConfig struct {
+	Percent  *int           `yaml:"percent"`
+	Interval *time.Duration `yaml:"interval"`
+}
+
+// BlueGreenDeploymentConfig represents the configuration of a blue/green deployment with CodeDeploy.
+type BlueGreenDeploymentConfig struct {
+	TestListenerPort *uint16        `yaml:"test_listener_port"`
+	TerminationWait  *time.Duration `yaml:"termination_wait"`
+	Hooks            BlueGreenHooks `yaml:"hooks"`
+}
+
+// BlueGreenHooks represents the Lambda functions that CodeDeploy invokes to validate a blue/green deployment.
+type BlueGreenHooks struct {
+	BeforeInstall         *string `yaml:"before_install"`
+	AfterInstall          *string `yaml:"after_install"`
+	AfterAllowTestTraffic *string `yaml:"after_allow_test_traffic"`
+	BeforeAllowTraffic    *string `yaml:"before_allow_traffic"`
+	AfterAllowTraffic     *string `yaml:"after_allow_traffic"`
+}
+
+// IsBlueGreen returns true if the service is deployed with CodeDeploy blue/green deployments.
+// Canary and linear rolling updates are blue/green deployments that shift the traffic gradually.
+func (d *DeploymentConfig) IsBlueGreen() bool {
+	return d != nil && (aws.StringValue(d.Type) == ECSBlueGreenDeploymentType || d.IsTrafficShifting())
+}
+
+// IsTrafficShifting returns true if the traffic is shifted gradually to the new tasks with a canary or linear rolling update.
+func (d *DeploymentConfig) IsTrafficShifting() bool {
+	if d == nil {
+		return false
+	}
+	rolling := aws.StringValue(d.Rolling)
+	return strings.EqualFold(rolling, ECSCanaryRollingUpdateStrategy) || strings.EqualFold(rolling, ECSLinearRollingUpdateStrategy)
+}
+
+// IsEmpty returns true if the traffic shifting configuration is not set.
+func (t TrafficShiftingConfig) IsEmpty() bool {
+	return t.Percent == nil && t.Interval == nil
+}
+
+// PercentAndInterval returns the percentage of traffic shifted at each step of a canary or linear deployment,
+// and the time between two steps. Unset values default to CodeDeploy's predefined ECS deployment configurations.
+func (t TrafficShiftingConfig) PercentAndInterval(strategy string) (int, time.Duration) {
+	percent, interval := defaultTrafficShiftingPercent, defaultLinearTrafficShiftingInterval
+	if strings.EqualFold(strategy, ECSCanaryRollingUpdateStrategy) {
+		interval = defaultCanaryTrafficShiftingInterval
+	}
+	if t.Percent != nil {
+		percent = aws.IntValue(t.Percent)
+	}
+	if t.Interval != nil {
+		interval = *t.Interval
+	}
+	return percent, interval
+}
+
+// Duration returns how long it takes to shift all the traffic to the new tasks.
+func (t TrafficShiftingConfig) Duration(strategy string) time.Duration {
+	percent, interval := t.PercentAndInterval(strategy)
+	if strings.EqualFold(strategy, ECSCanaryRollingUpdateStrategy) || percent <= 0 {
+		return interval
+	}
+	steps := (100 + percent - 1) / percent // The last step shifts the remaining traffic without waiting.
+	return time.Duration(steps-1) * interval
+}
+
+// IsEmpty returns true if the blue/green deployment configuration is not set.
+func (b BlueGreenDeploymentConfig) IsEmpty() bool {
+	return b.TestListenerPort == nil && b.TerminationWait == nil && b.Hooks.IsEmpty()
+}
+
+// IsEmpty returns true if none of the hooks are set.
+func (h BlueGreenHooks) IsEmpty() bool {
+	return h.BeforeInstall == nil && h.AfterInstall == nil && h.AfterAllowTestTraffic == nil &&
+		h.BeforeAllowTraffic == nil && h.AfterAllowTraffic == nil
 }
 
 // WorkerDeploymentConfig represents the deployment strategies for a worker service.
 type WorkerDeploymentConfig struct {
 	DeploymentControllerConfig `yaml:",inline"`
 	WorkerRollbackAlarms       Union[[]string, WorkerAlarmArgs] `yaml:"rollback_alarms"`
+	DeploymentHooks            `yaml:",inline"`
 }
 
 func (d *DeploymentConfig) isEmpty() bool {
-	return d == nil || (d.DeploymentControllerConfig.isEmpty() && d.RollbackAlarms.IsZero())
+	return d == nil || (d.Type == nil && d.DeploymentControllerConfig.isEmpty() && d.RollbackAlarms.IsZero() && d.BlueGreen.IsEmpty() && d.TrafficShifting.IsEmpty() && d.DeploymentHooks.IsEmpty())
 }
 
 func (d *DeploymentControllerConfig) isEmpty() bool {
@@ -123,7 +303,7 @@ func (d *DeploymentControllerConfig) isEmpty() bool {
 }
 
 func (w *WorkerDeploymentConfig) isEmpty() bool {
-	return w == nil || (w.DeploymentControllerConfig.Rolling == nil && w.WorkerRollbackAlarms.IsZero())
+	return w == nil || (w.DeploymentControllerConfig.Rolling == nil && w.WorkerRollbackAlarms.IsZero() && w.DeploymentHooks.IsEmpty())
 }
 
 // ExposedPort will hold the port mapping configuration.
@@ -155,9 +335,33 @@ type TaskConfig struct {
 	ExecuteCommand ExecuteCommand       `yaml:"exec"`
 	Variables      map[string]Variable  `yaml:"variables"`
 	EnvFile        *string              `yaml:"env_file"`
-	Secrets        map[string]Secret    `yaml:"secrets"`
-	Storage        Storage              `yaml:"storage"`
-}
+	// VariablesFromEnvFile is a path to an env file, relative to the workspace root,
+	// that is read at package time and rendered as environment variables of the main container.
+	VariablesFromEnvFile *string           `yaml:"variables_from_env_file"`
+	Secrets              map[string]Secret `yaml:"secrets"`
+	Storage              Storage           `yaml:"storage"`
+	// TaskRole and ExecutionRole are the ARNs of existing IAM roles that replace the roles generated by Copilot.
+	TaskRole      *string `yaml:"task_role"`
+	ExecutionRole *string `yaml:"execution_role"`
+	// PermissionsBoundary is the name or ARN of an IAM managed policy that overrides the permissions boundary
+	// of the application for the roles generated by Copilot.
+	PermissionsBoundary *string `yaml:"permissions_boundary"`
+	// Compute is "ec2" to run the tasks on the EC2 capacity provider of the environment instead of Fargate.
+	Compute *string `yaml:"compute"`
+	GPU     *int    `yaml:"gpu"` // Number of GPUs reserved for the main container.
+}
+
+// Sources of the values that a variable can refer to, formatted as ${<source>:<id>}.
+const (
+	variableRefSourceSSM            = "ssm"
+	variableRefSourceSecretsManager = "secretsmanager"
+	variableRefSourceCFN            = "cfn"
+)
+
+// variableRefRegExp matches the references to SSM parameters, Secrets Manager secrets and CloudFormation exports
+// in the value of a variable, such as ${ssm:/myapp/prod/api-url}.
+var variableRefRegExp = regexp.MustCompile(fmt.Sprintf(`\$\{(%s|%s|%s):([^{}]+)\}`,
+	variableRefSourceSSM, variableRefSourceSecretsManager, variableRefSourceCFN))
 
 // Variable represents an identifier for the value of an environment variable.
 type Variable struct {
@@ -174,15 +378,36 @@ func (v *Variable) UnmarshalYAML(value *yaml.Node) error {
 
 // RequiresImport returns true if the value is imported from an environment.
 func (v *Variable) RequiresImport() bool {
-	return !v.FromCFN.isEmpty()
+	return !v.FromCFN.isEmpty() || v.cfnRef() != ""
 }
 
 // Value returns the value, whether it is used for import or not.
+// References to SSM parameters and Secrets Manager secrets are rendered as CloudFormation dynamic references,
+// so that they are resolved when the stack is deployed.
 func (v *Variable) Value() string {
-	if v.RequiresImport() {
+	if !v.FromCFN.isEmpty() {
 		return aws.StringValue(v.FromCFN.Name)
 	}
-	return aws.StringValue(v.Plain)
+	if name := v.cfnRef(); name != "" {
+		return name
+	}
+	return variableRefRegExp.ReplaceAllStringFunc(aws.StringValue(v.Plain), func(ref string) string {
+		m := variableRefRegExp.FindStringSubmatch(ref)
+		if m[1] == variableRefSourceCFN {
+			// Validation ensures that a CloudFormation export is the entire value.
+			return ref
+		}
+		return fmt.Sprintf("{{resolve:%s:%s}}", m[1], m[2])
+	})
+}
+
+// cfnRef returns the name of the CloudFormation export if the entire value is a reference to it, such as ${cfn:MyExport}.
+func (v *Variable) cfnRef() string {
+	m := variableRefRegExp.FindStringSubmatch(aws.StringValue(v.Plain))
+	if m == nil || m[0] != aws.StringValue(v.Plain) || m[1] != variableRefSourceCFN {
+		return ""
+	}
+	return m[2]
 }
 
 // ContainerPlatform returns the platform for the service.
@@ -196,6 +421,11 @@ func (t *TaskConfig) ContainerPlatform() string {
 	return platformString(t.Platform.OS(), t.Platform.Arch())
 }
 
+// OnEC2 returns true if the tasks run on the EC2 capacity provider of the environment.
+func (t TaskConfig) OnEC2() bool {
+	return aws.StringValue(t.Compute) == ComputeEC2
+}
+
 // IsWindows returns whether or not the service is building with a Windows OS.
 func (t TaskConfig) IsWindows() bool {
 	return isWindowsPlatform(t.Platform)
@@ -210,10 +440,28 @@ func (t TaskConfig) IsARM() bool {
 type Secret struct {
 	from               stringOrFromCFN      // SSM Parameter name or ARN to a secret or secret ARN imported from another CloudFormation stack.
 	fromSecretsManager secretsManagerSecret // Conveniently fetch from a secretsmanager secret name instead of ARN.
+	asFile             *string              // Path of the file that holds the secret in the container, instead of an environment variable.
+}
+
+// secretFile represents the advanced form of a secret that is written to a file.
+type secretFile struct {
+	From   *string `yaml:"from"` // SSM Parameter name or ARN, the other sources have their own keys.
+	AsFile *string `yaml:"as_file"`
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler (v3) interface to override the default YAML unmarshaling logic.
 func (s *Secret) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.MappingNode {
+		var file secretFile
+		if err := value.Decode(&file); err != nil {
+			return err
+		}
+		s.asFile = file.AsFile
+		if file.From != nil {
+			s.from.Plain = file.From
+			return nil
+		}
+	}
 	if err := value.Decode(&s.fromSecretsManager); err != nil {
 		switch err.(type) {
 		case *yaml.TypeError:
@@ -241,6 +489,16 @@ func (s *Secret) RequiresImport() bool {
 	return !s.from.FromCFN.isEmpty()
 }
 
+// IsFile returns true if the secret is written to a file in the container instead of an environment variable.
+func (s *Secret) IsFile() bool {
+	return s.asFile != nil
+}
+
+// FilePath returns the path of the file that holds the secret in the container, if any.
+func (s *Secret) FilePath() string {
+	return aws.StringValue(s.asFile)
+}
+
 // Value returns the secret value provided by clients.
 func (s *Secret) Value() string {
 	if !s.fromSecretsManager.IsEmpty() {
@@ -263,21 +521,80 @@ func (s secretsManagerSecret) IsEmpty() bool {
 
 // Logging holds configuration for Firelens to route your logs.
 type Logging struct {
-	Retention      *int                `yaml:"retention"`
-	Image          *string             `yaml:"image"`
-	Destination    map[string]string   `yaml:"destination,flow"`
-	EnableMetadata *bool               `yaml:"enableMetadata"`
-	SecretOptions  map[string]Secret   `yaml:"secretOptions"`
-	ConfigFile     *string             `yaml:"configFilePath"`
-	Variables      map[string]Variable `yaml:"variables"`
-	Secrets        map[string]Secret   `yaml:"secrets"`
-	EnvFile        *string             `yaml:"env_file"`
+	Retention      *int                                           `yaml:"retention"`
+	Image          *string                                        `yaml:"image"`
+	Destination    Union[map[string]string, LogDestinationConfig] `yaml:"destination,flow"`
+	EnableMetadata *bool                                          `yaml:"enableMetadata"`
+	SecretOptions  map[string]Secret                              `yaml:"secretOptions"`
+	ConfigFile     *string                                        `yaml:"configFilePath"`
+	Variables      map[string]Variable                            `yaml:"variables"`
+	Secrets        map[string]Secret                              `yaml:"secrets"`
+	EnvFile        *string                                        `yaml:"env_file"`
+	Parser         *string                                        `yaml:"parser"` // Parser of the log lines, only "json" is supported.
 }
 
 // IsEmpty returns empty if the struct has all zero members.
 func (lc *Logging) IsEmpty() bool {
-	return lc.Image == nil && lc.Destination == nil && lc.EnableMetadata == nil && lc.SecretOptions == nil &&
-		lc.ConfigFile == nil && lc.Variables == nil && lc.Secrets == nil && lc.EnvFile == nil
+	return lc.Image == nil && lc.Destination.IsZero() && lc.EnableMetadata == nil && lc.SecretOptions == nil &&
+		lc.ConfigFile == nil && lc.Variables == nil && lc.Secrets == nil && lc.EnvFile == nil && lc.Parser == nil
+}
+
+// LogDestinationConfig represents a third-party destination that FireLens routes the logs to.
+// It's the advanced form of "destination", Copilot generates the Fluent Bit output options from it.
+type LogDestinationConfig struct {
+	Datadog    DatadogLogDestination    `yaml:"datadog"`
+	Splunk     SplunkLogDestination     `yaml:"splunk"`
+	OpenSearch OpenSearchLogDestination `yaml:"opensearch"`
+	Firehose   FirehoseLogDestination   `yaml:"firehose"`
+}
+
+// DatadogLogDestination holds the configuration to send logs to Datadog.
+type DatadogLogDestination struct {
+	APIKey  *Secret `yaml:"api_key"`
+	Site    *string `yaml:"site"`
+	Service *string `yaml:"service"`
+	Source  *string `yaml:"source"`
+	Tags    *string `yaml:"tags"`
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (d *DatadogLogDestination) IsEmpty() bool {
+	return d.APIKey == nil && d.Site == nil && d.Service == nil && d.Source == nil && d.Tags == nil
+}
+
+// SplunkLogDestination holds the configuration to send logs to a Splunk HTTP Event Collector.
+type SplunkLogDestination struct {
+	Host  *string `yaml:"host"`
+	Port  *uint16 `yaml:"port"`
+	Token *Secret `yaml:"token"`
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (s *SplunkLogDestination) IsEmpty() bool {
+	return s.Host == nil && s.Port == nil && s.Token == nil
+}
+
+// OpenSearchLogDestination holds the configuration to send logs to an Amazon OpenSearch Service domain.
+type OpenSearchLogDestination struct {
+	Host   *string `yaml:"host"`
+	Index  *string `yaml:"index"`
+	Region *string `yaml:"region"`
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (o *OpenSearchLogDestination) IsEmpty() bool {
+	return o.Host == nil && o.Index == nil && o.Region == nil
+}
+
+// FirehoseLogDestination holds the configuration to send logs to a Kinesis Data Firehose delivery stream.
+type FirehoseLogDestination struct {
+	DeliveryStream *string `yaml:"delivery_stream"`
+	Region         *string `yaml:"region"`
+}
+
+// IsEmpty returns empty if the struct has all zero members.
+func (f *FirehoseLogDestination) IsEmpty() bool {
+	return f.DeliveryStream == nil && f.Region == nil
 }
 
 // LogImage returns the default Fluent Bit image if not otherwise configured.
@@ -299,18 +616,21 @@ func (lc *Logging) GetEnableMetadata() *string {
 
 // SidecarConfig represents the configurable options for setting up a sidecar container.
 type SidecarConfig struct {
-	Port          *string                              `yaml:"port"`
-	Image         Union[*string, ImageLocationOrBuild] `yaml:"image"`
-	Essential     *bool                                `yaml:"essential"`
-	CredsParam    *string                              `yaml:"credentialsParameter"`
-	Variables     map[string]Variable                  `yaml:"variables"`
-	EnvFile       *string                              `yaml:"env_file"`
-	Secrets       map[string]Secret                    `yaml:"secrets"`
-	MountPoints   []SidecarMountPoint                  `yaml:"mount_points"`
-	DockerLabels  map[string]string                    `yaml:"labels"`
-	DependsOn     DependsOn                            `yaml:"depends_on"`
-	HealthCheck   ContainerHealthCheck                 `yaml:"healthcheck"`
-	ImageOverride `yaml:",inline"`
+	Port                 *string                              `yaml:"port"`
+	Image                Union[*string, ImageLocationOrBuild] `yaml:"image"`
+	Essential            *bool                                `yaml:"essential"`
+	CredsParam           *string                              `yaml:"credentialsParameter"`
+	Variables            map[string]Variable                  `yaml:"variables"`
+	EnvFile              *string                              `yaml:"env_file"`
+	VariablesFromEnvFile *string                              `yaml:"variables_from_env_file"`
+	Secrets              map[string]Secret                    `yaml:"secrets"`
+	MountPoints          []SidecarMountPoint                  `yaml:"mount_points"`
+	DockerLabels         map[string]string                    `yaml:"labels"`
+	DependsOn            DependsOn                            `yaml:"depends_on"`
+	HealthCheck          ContainerHealthCheck                 `yaml:"healthcheck"`
+	CPU                  *int                                 `yaml:"cpu"`                // CPU units reserved for the container out of the task's.
+	MemoryReservation    *int                                 `yaml:"memory_reservation"` // Memory in MiB reserved for the container out of the task's.
+	ImageOverride        `yaml:",inline"`
 }
 
 // ImageURI returns the location of the image if one is set.
@@ -430,6 +750,21 @@ func envFiles(name *string, tc TaskConfig, lc Logging, sc map[string]*SidecarCon
 	return envFiles
 }
 
+// variablesEnvFiles returns the paths of the env files whose variables are rendered in the containers, keyed by container name.
+// Containers without "variables_from_env_file" are left out.
+func variablesEnvFiles(name *string, tc TaskConfig, sc map[string]*SidecarConfig) map[string]string {
+	envFiles := make(map[string]string)
+	if tc.VariablesFromEnvFile != nil {
+		envFiles[aws.StringValue(name)] = aws.StringValue(tc.VariablesFromEnvFile)
+	}
+	for sidecarName, sidecar := range sc {
+		if sidecar != nil && sidecar.VariablesFromEnvFile != nil {
+			envFiles[sidecarName] = aws.StringValue(sidecar.VariablesFromEnvFile)
+		}
+	}
+	return envFiles
+}
+
 func buildArgs(contextDir string, buildArgs map[string]*DockerBuildArgs, sc map[string]*SidecarConfig) (map[string]*DockerBuildArgs, error) {
 	for name, config := range sc {
 		if _, ok := config.ImageURI(); !ok {
@@ -438,3 +773,17 @@ func buildArgs(contextDir string, buildArgs map[string]*DockerBuildArgs, sc map[
 	}
 	return buildArgs, nil
 }
+
+// imageLocations returns the image locations of the main container and of the sidecars that aren't built.
+func imageLocations(name string, img Image, sc map[string]*SidecarConfig) map[string]string {
+	locations := make(map[string]string, len(sc)+1)
+	if img.Location != nil {
+		locations[name] = aws.StringValue(img.Location)
+	}
+	for sidecar, config := range sc {
+		if uri, ok := config.ImageURI(); ok {
+			locations[sidecar] = uri
+		}
+	}
+	return locations
+}
@@ -6,6 +6,7 @@ package manifest
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
@@ -160,6 +161,14 @@ func TestVariable_RequiresImport(t *testing.T) {
 			},
 			wanted: true,
 		},
+		"requires import if the entire value is a reference to a CloudFormation export": {
+			in: Variable{
+				stringOrFromCFN{
+					Plain: stringP("${cfn:prod-MyDB}"),
+				},
+			},
+			wanted: true,
+		},
 		"does not require import if it is a plain value": {
 			in: Variable{
 				stringOrFromCFN{
@@ -167,6 +176,13 @@ func TestVariable_RequiresImport(t *testing.T) {
 				},
 			},
 		},
+		"does not require import if it refers to an SSM parameter": {
+			in: Variable{
+				stringOrFromCFN{
+					Plain: stringP("${ssm:/myapp/prod/api-url}"),
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -198,6 +214,30 @@ func TestVariable_Value(t *testing.T) {
 			},
 			wanted: "plain",
 		},
+		"returns the name of the CloudFormation export": {
+			in: Variable{
+				stringOrFromCFN{
+					Plain: stringP("${cfn:prod-MyDB}"),
+				},
+			},
+			wanted: "prod-MyDB",
+		},
+		"renders references to SSM parameters and secrets as dynamic references": {
+			in: Variable{
+				stringOrFromCFN{
+					Plain: stringP("https://${ssm:/myapp/prod/api-host}/v1?key=${secretsmanager:myapp/api:SecretString:key}"),
+				},
+			},
+			wanted: "https://{{resolve:ssm:/myapp/prod/api-host}}/v1?key={{resolve:secretsmanager:myapp/api:SecretString:key}}",
+		},
+		"leaves unknown references untouched": {
+			in: Variable{
+				stringOrFromCFN{
+					Plain: stringP("${vault:secret/api}"),
+				},
+			},
+			wanted: "${vault:secret/api}",
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -247,6 +287,36 @@ func TestSecret_UnmarshalYAML(t *testing.T) {
 			in:     "secretsmanager: aes128-1a2b3c",
 			wanted: Secret{fromSecretsManager: secretsManagerSecret{Name: aws.String("aes128-1a2b3c")}},
 		},
+		"should be able to unmarshal a SecretsManager name written to a file": {
+			in: `secretsmanager: demo/tls
+as_file: /etc/ssl/private/key.pem`,
+			wanted: Secret{
+				fromSecretsManager: secretsManagerSecret{Name: aws.String("demo/tls")},
+				asFile:             aws.String("/etc/ssl/private/key.pem"),
+			},
+		},
+		"should be able to unmarshal an SSM parameter name written to a file": {
+			in: `from: /copilot/demo/test/secrets/kubeconfig
+as_file: /root/.kube/config`,
+			wanted: Secret{
+				from: stringOrFromCFN{
+					Plain: aws.String("/copilot/demo/test/secrets/kubeconfig"),
+				},
+				asFile: aws.String("/root/.kube/config"),
+			},
+		},
+		"should be able to unmarshal an imported secret written to a file": {
+			in: `from_cfn: stack-TLSKeyARN
+as_file: /etc/ssl/private/key.pem`,
+			wanted: Secret{
+				from: stringOrFromCFN{
+					FromCFN: fromCFN{
+						Name: aws.String("stack-TLSKeyARN"),
+					},
+				},
+				asFile: aws.String("/etc/ssl/private/key.pem"),
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -390,6 +460,71 @@ func TestSecretsManagerSecret_IsEmpty(t *testing.T) {
 	}
 }
 
+func TestLogging_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted Logging
+	}{
+		"destination with FireLens output options": {
+			in: `
+destination:
+  Name: cloudwatch
+  region: us-west-2`,
+			wanted: Logging{
+				Destination: BasicToUnion[map[string]string, LogDestinationConfig](map[string]string{
+					"Name":   "cloudwatch",
+					"region": "us-west-2",
+				}),
+			},
+		},
+		"datadog destination": {
+			in: `
+parser: json
+destination:
+  datadog:
+    api_key: /copilot/datadog/key
+    site: datadoghq.eu
+    service: api`,
+			wanted: Logging{
+				Parser: aws.String("json"),
+				Destination: AdvancedToUnion[map[string]string](LogDestinationConfig{
+					Datadog: DatadogLogDestination{
+						APIKey: &Secret{
+							from: stringOrFromCFN{
+								Plain: aws.String("/copilot/datadog/key"),
+							},
+						},
+						Site:    aws.String("datadoghq.eu"),
+						Service: aws.String("api"),
+					},
+				}),
+			},
+		},
+		"firehose destination": {
+			in: `
+destination:
+  firehose:
+    delivery_stream: my-stream`,
+			wanted: Logging{
+				Destination: AdvancedToUnion[map[string]string](LogDestinationConfig{
+					Firehose: FirehoseLogDestination{
+						DeliveryStream: aws.String("my-stream"),
+					},
+				}),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var got Logging
+			err := yaml.Unmarshal([]byte(tc.in), &got)
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func TestLogging_IsEmpty(t *testing.T) {
 	testCases := map[string]struct {
 		in     Logging
@@ -518,3 +653,85 @@ func Test_ImageURI(t *testing.T) {
 		})
 	}
 }
+
+func TestTrafficShiftingConfig_Duration(t *testing.T) {
+	testCases := map[string]struct {
+		in       TrafficShiftingConfig
+		strategy string
+
+		wantedPercent  int
+		wantedInterval time.Duration
+		wantedDuration time.Duration
+	}{
+		"canary defaults": {
+			strategy: ECSCanaryRollingUpdateStrategy,
+
+			wantedPercent:  10,
+			wantedInterval: 5 * time.Minute,
+			wantedDuration: 5 * time.Minute,
+		},
+		"linear defaults": {
+			strategy: ECSLinearRollingUpdateStrategy,
+
+			wantedPercent:  10,
+			wantedInterval: time.Minute,
+			wantedDuration: 9 * time.Minute,
+		},
+		"linear with a percentage that doesn't divide 100": {
+			in: TrafficShiftingConfig{
+				Percent:  aws.Int(30),
+				Interval: durationp(2 * time.Minute),
+			},
+			strategy: ECSLinearRollingUpdateStrategy,
+
+			wantedPercent:  30,
+			wantedInterval: 2 * time.Minute,
+			wantedDuration: 6 * time.Minute,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			percent, interval := tc.in.PercentAndInterval(tc.strategy)
+			require.Equal(t, tc.wantedPercent, percent)
+			require.Equal(t, tc.wantedInterval, interval)
+			require.Equal(t, tc.wantedDuration, tc.in.Duration(tc.strategy))
+		})
+	}
+}
+
+func TestDeploymentConfig_UnmarshalHooks(t *testing.T) {
+	// GIVEN
+	in := []byte(`
+rolling: default
+pre_deploy:
+  command: ["rake", "db:migrate"]
+post_deploy:
+  command: ./smoke-test.sh
+`)
+
+	// WHEN
+	var got DeploymentConfig
+	err := yaml.Unmarshal(in, &got)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, DeploymentConfig{
+		DeploymentControllerConfig: DeploymentControllerConfig{
+			Rolling: aws.String("default"),
+		},
+		DeploymentHooks: DeploymentHooks{
+			PreDeploy: DeploymentHook{
+				Command: CommandOverride{
+					StringSlice: []string{"rake", "db:migrate"},
+				},
+			},
+			PostDeploy: DeploymentHook{
+				Command: CommandOverride{
+					String: aws.String("./smoke-test.sh"),
+				},
+			},
+		},
+	}, got)
+	require.False(t, got.DeploymentHooks.IsEmpty())
+	require.True(t, DeploymentHooks{}.IsEmpty())
+}
@@ -45,6 +45,37 @@ location: mockLocation`),
 	}
 }
 
+func TestImage_GetBuilder(t *testing.T) {
+	testCases := map[string]struct {
+		in     Image
+		wanted string
+	}{
+		"empty if not specified": {
+			in: Image{
+				Platforms: []string{"linux/arm64"},
+			},
+		},
+		"buildx if not specified for multiple platforms": {
+			in: Image{
+				Platforms: []string{"linux/amd64", "linux/arm64"},
+			},
+			wanted: "buildx",
+		},
+		"the specified builder": {
+			in: Image{
+				Builder:   aws.String("podman"),
+				Platforms: []string{"linux/amd64", "linux/arm64"},
+			},
+			wanted: "podman",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.GetBuilder())
+		})
+	}
+}
+
 func TestEntryPointOverride_UnmarshalYAML(t *testing.T) {
 	testCases := map[string]struct {
 		inContent []byte
@@ -422,6 +453,44 @@ func TestServiceConnect_UnmarshalYAML(t *testing.T) {
 				},
 			},
 		},
+		"success with tls": {
+			inContent: []byte(`connect:
+  tls: true`),
+			wantedStruct: ServiceConnectBoolOrArgs{
+				ServiceConnectArgs: ServiceConnectArgs{
+					TLS: aws.Bool(true),
+				},
+			},
+		},
+		"success with the health checks of ports": {
+			inContent: []byte(`connect:
+  ports:
+    - port: 50051
+      app_protocol: grpc
+      healthcheck: true
+    - port: 8080
+      app_protocol: http
+      healthcheck:
+        path: /admin/health`),
+			wantedStruct: ServiceConnectBoolOrArgs{
+				ServiceConnectArgs: ServiceConnectArgs{
+					Ports: []ServiceConnectPort{
+						{
+							Port:        aws.Uint16(50051),
+							AppProtocol: aws.String("grpc"),
+							HealthCheck: BasicToUnion[*bool, ServiceConnectPortHealthCheck](aws.Bool(true)),
+						},
+						{
+							Port:        aws.Uint16(8080),
+							AppProtocol: aws.String("http"),
+							HealthCheck: AdvancedToUnion[*bool](ServiceConnectPortHealthCheck{
+								Path: aws.String("/admin/health"),
+							}),
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -437,6 +506,58 @@ func TestServiceConnect_UnmarshalYAML(t *testing.T) {
 	}
 }
 
+func TestServiceConnectPort_HealthCheckCommand(t *testing.T) {
+	testCases := map[string]struct {
+		in     ServiceConnectPort
+		wanted string
+	}{
+		"no health check": {
+			in: ServiceConnectPort{
+				Port:        aws.Uint16(8080),
+				AppProtocol: aws.String("http"),
+			},
+		},
+		"health check disabled": {
+			in: ServiceConnectPort{
+				Port:        aws.Uint16(8080),
+				AppProtocol: aws.String("http"),
+				HealthCheck: BasicToUnion[*bool, ServiceConnectPortHealthCheck](aws.Bool(false)),
+			},
+		},
+		"http port with the default path": {
+			in: ServiceConnectPort{
+				Port:        aws.Uint16(8080),
+				AppProtocol: aws.String("http"),
+				HealthCheck: BasicToUnion[*bool, ServiceConnectPortHealthCheck](aws.Bool(true)),
+			},
+			wanted: "curl -fs http://localhost:8080/",
+		},
+		"http2 port with a path": {
+			in: ServiceConnectPort{
+				Port:        aws.Uint16(8443),
+				AppProtocol: aws.String("http2"),
+				HealthCheck: AdvancedToUnion[*bool](ServiceConnectPortHealthCheck{
+					Path: aws.String("/healthz"),
+				}),
+			},
+			wanted: "curl -fs --http2-prior-knowledge http://localhost:8443/healthz",
+		},
+		"grpc port": {
+			in: ServiceConnectPort{
+				Port:        aws.Uint16(50051),
+				AppProtocol: aws.String("grpc"),
+				HealthCheck: BasicToUnion[*bool, ServiceConnectPortHealthCheck](aws.Bool(true)),
+			},
+			wanted: "grpc_health_probe -addr=localhost:50051",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.HealthCheckCommand())
+		})
+	}
+}
+
 func TestPlacementArgOrString_UnmarshalYAML(t *testing.T) {
 	testCases := map[string]struct {
 		inContent []byte
@@ -1151,6 +1272,44 @@ network:
 				},
 			},
 		},
+		"unmarshal is successful for ingress rules": {
+			data: `
+network:
+  vpc:
+    security_groups:
+      ingress:
+        - source_security_group: sg-1234
+          ports: 8080
+        - cidr: 10.0.0.0/16
+          ports: 9000-9010
+          ip_protocol: udp
+`,
+			wantedConfig: &NetworkConfig{
+				VPC: vpcConfig{
+					SecurityGroups: SecurityGroupsIDsOrConfig{
+						AdvancedConfig: SecurityGroupsConfig{
+							Ingress: []SecurityGroupIngressRule{
+								{
+									SourceSecurityGroup: stringOrFromCFN{
+										Plain: aws.String("sg-1234"),
+									},
+									Ports: portsConfig{
+										Port: aws.Int(8080),
+									},
+								},
+								{
+									CIDR: aws.String("10.0.0.0/16"),
+									Ports: portsConfig{
+										Range: (*IntRangeBand)(aws.String("9000-9010")),
+									},
+									IPProtocol: aws.String("udp"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,303 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package override
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	strategicMergeFile = "cfn.merge.yml"
+
+	// mergeDirectiveKey is the key of a directive that changes how a map is merged.
+	mergeDirectiveKey = "$patch"
+	// mergeDirectiveReplace replaces the map in the template instead of merging into it.
+	mergeDirectiveReplace = "replace"
+	// mergeDirectiveDelete deletes the matching item of a list in the template.
+	mergeDirectiveDelete = "delete"
+)
+
+// mergeKeys are the keys that identify an item in a list of maps, so that the items of a patch
+// are merged into the items of the template with the same "Name" or "Key" instead of replacing the list.
+var mergeKeys = []string{"Name", "Key"}
+
+// ScaffoldWithMerge sets up a strategic merge patch in dir/ to apply to the
+// Copilot generated CloudFormation template.
+func ScaffoldWithMerge(fs afero.Fs, dir string) error {
+	exists, _ := afero.Exists(fs, dir)
+	isEmpty, _ := afero.IsEmpty(fs, dir)
+	if exists && !isEmpty {
+		return fmt.Errorf("directory %q is not empty", dir)
+	}
+
+	return templates.WalkOverridesMergeDir(writeFilesToDir(dir, fs))
+}
+
+// Merge applies overrides configured as a strategic merge patch.
+// The patch is a map from the logical IDs of resources to the attributes to merge into them:
+// maps are merged key by key, a null value deletes the key, and lists of maps are merged
+// item by item when their items have a "Name" or "Key". Any other value replaces the value in the template.
+type Merge struct {
+	filePath string   // Absolute path to the overrides/ directory.
+	fs       afero.Fs // OS file system.
+}
+
+// MergeOpts is optional configuration for initializing a Merge Overrider.
+type MergeOpts struct {
+	FS afero.Fs // File system interface. If nil, defaults to the OS file system.
+}
+
+// WithMerge instantiates a new Merge Overrider with root being the path to the overrides/ directory.
+// It supports a single file (cfn.merge.yml) with the patch.
+func WithMerge(filePath string, opts MergeOpts) *Merge {
+	fs := afero.NewOsFs()
+	if opts.FS != nil {
+		fs = opts.FS
+	}
+
+	return &Merge{
+		filePath: filePath,
+		fs:       fs,
+	}
+}
+
+// Override returns the overriden CloudFormation template body
+// after merging the patch into its resources.
+// An error is returned if the overriden template is not a valid CloudFormation template.
+func (m *Merge) Override(body []byte) ([]byte, error) {
+	patch, err := unmarshalMergePatch(m.filePath, m.fs)
+	if err != nil {
+		return nil, err
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(body, &root); err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	if root.Kind != yaml.DocumentNode || len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("invalid template: expected a mapping")
+	}
+	resources := mappingValue(root.Content[0], "Resources")
+	if resources == nil || resources.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("invalid template: %q must be a mapping", "Resources")
+	}
+
+	for i := 0; i+1 < len(patch.Content); i += 2 {
+		logicalID, value := patch.Content[i].Value, patch.Content[i+1]
+		if err := mergeResource(resources, logicalID, value); err != nil {
+			return nil, fmt.Errorf("merge resource %q: %w", logicalID, err)
+		}
+	}
+
+	addOverrideDescription(&root, "strategic merge patches")
+	out, err := yaml.Marshal(&root)
+	if err != nil {
+		return nil, fmt.Errorf("unable to return modified document to []byte: %w", err)
+	}
+	if err := ValidateTemplate(out); err != nil {
+		return nil, fmt.Errorf("validate overridden template: %w", err)
+	}
+	return out, nil
+}
+
+// unmarshalMergePatch returns the mapping node of the patch, or an empty mapping if the file has no patch.
+func unmarshalMergePatch(path string, fs afero.Fs) (*yaml.Node, error) {
+	path = filepath.Join(path, strategicMergeFile)
+	content, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("read file at %q: %w", path, err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("file at %q does not conform to the strategic merge patch schema: %w", path, err)
+	}
+	if len(doc.Content) == 0 {
+		return &yaml.Node{Kind: yaml.MappingNode}, nil // The file only has comments.
+	}
+	if doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("file at %q does not conform to the strategic merge patch schema: expected a map of logical IDs to resources", path)
+	}
+	return doc.Content[0], nil
+}
+
+// mergeResource merges value into the resource with the logical ID.
+// If the resource doesn't exist, the value is added as a new resource as long as it has a type.
+func mergeResource(resources *yaml.Node, logicalID string, value *yaml.Node) error {
+	for i := 0; i+1 < len(resources.Content); i += 2 {
+		if resources.Content[i].Value != logicalID {
+			continue
+		}
+		if isNull(value) {
+			resources.Content = append(resources.Content[:i], resources.Content[i+2:]...)
+			return nil
+		}
+		merged, err := mergeNodes(resources.Content[i+1], value, nil)
+		if err != nil {
+			return err
+		}
+		resources.Content[i+1] = merged
+		return nil
+	}
+	if value.Kind != yaml.MappingNode || mappingValue(value, "Type") == nil {
+		return fmt.Errorf("resource not found in the template: specify %q to add a new resource", "Type")
+	}
+	resources.Content = append(resources.Content, &yaml.Node{
+		Kind:  yaml.ScalarNode,
+		Tag:   "!!str",
+		Value: logicalID,
+	}, value)
+	return nil
+}
+
+// mergeNodes returns the result of merging src into dst.
+func mergeNodes(dst, src *yaml.Node, traversed pointer) (*yaml.Node, error) {
+	switch {
+	case isPlainMapping(dst) && isPlainMapping(src):
+		return mergeMappings(dst, src, traversed)
+	case isPlainSequence(dst) && isPlainSequence(src) && isMergeableSequence(src):
+		return mergeSequences(dst, src, traversed)
+	default:
+		return withoutDirectives(src), nil
+	}
+}
+
+func mergeMappings(dst, src *yaml.Node, traversed pointer) (*yaml.Node, error) {
+	directive := mappingValue(src, mergeDirectiveKey)
+	if directive != nil {
+		if directive.Value != mergeDirectiveReplace {
+			return nil, fmt.Errorf("key %q: unsupported directive %q in a map: only %q is supported", strings.Join(traversed, jsonPointerSeparator), directive.Value, mergeDirectiveReplace)
+		}
+		return withoutDirectives(src), nil
+	}
+	for i := 0; i+1 < len(src.Content); i += 2 {
+		key, value := src.Content[i].Value, src.Content[i+1]
+		idx, err := findInMap(dst, key, traversed)
+		switch {
+		case err != nil && isNull(value):
+			// Nothing to delete.
+		case err != nil:
+			dst.Content = append(dst.Content, src.Content[i], withoutDirectives(value))
+		case isNull(value):
+			dst.Content = append(dst.Content[:idx], dst.Content[idx+2:]...)
+		default:
+			merged, err := mergeNodes(dst.Content[idx+1], value, append(traversed, key))
+			if err != nil {
+				return nil, err
+			}
+			dst.Content[idx+1] = merged
+		}
+	}
+	return dst, nil
+}
+
+func mergeSequences(dst, src *yaml.Node, traversed pointer) (*yaml.Node, error) {
+	for _, item := range src.Content {
+		key, id := mergeKey(item)
+		idx := -1
+		for i, existing := range dst.Content {
+			if v := mappingValue(existing, key); v != nil && v.Kind == yaml.ScalarNode && v.Value == id {
+				idx = i
+				break
+			}
+		}
+		directive := mappingValue(item, mergeDirectiveKey)
+		switch {
+		case directive != nil && directive.Value == mergeDirectiveDelete:
+			if idx == -1 {
+				return nil, fmt.Errorf("key %q: no item with %s %q to delete", strings.Join(traversed, jsonPointerSeparator), key, id)
+			}
+			dst.Content = append(dst.Content[:idx], dst.Content[idx+1:]...)
+		case idx == -1:
+			dst.Content = append(dst.Content, withoutDirectives(item))
+		default:
+			merged, err := mergeNodes(dst.Content[idx], item, append(traversed, strconv.Itoa(idx)))
+			if err != nil {
+				return nil, err
+			}
+			dst.Content[idx] = merged
+		}
+	}
+	return dst, nil
+}
+
+// isMergeableSequence returns true if every item of the sequence is a map identified by a merge key.
+// Other sequences replace the sequence in the template.
+func isMergeableSequence(node *yaml.Node) bool {
+	if len(node.Content) == 0 {
+		return false
+	}
+	for _, item := range node.Content {
+		if key, _ := mergeKey(item); key == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeKey returns the first merge key of an item and its value, or empty strings if the item has none.
+func mergeKey(item *yaml.Node) (key, value string) {
+	if item.Kind != yaml.MappingNode {
+		return "", ""
+	}
+	for _, k := range mergeKeys {
+		if v := mappingValue(item, k); v != nil && v.Kind == yaml.ScalarNode {
+			return k, v.Value
+		}
+	}
+	return "", ""
+}
+
+// withoutDirectives removes the merge directives from a node that is copied from the patch to the template.
+func withoutDirectives(node *yaml.Node) *yaml.Node {
+	switch node.Kind {
+	case yaml.MappingNode:
+		var content []*yaml.Node
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == mergeDirectiveKey {
+				continue
+			}
+			content = append(content, node.Content[i], withoutDirectives(node.Content[i+1]))
+		}
+		node.Content = content
+	case yaml.SequenceNode:
+		for i := range node.Content {
+			node.Content[i] = withoutDirectives(node.Content[i])
+		}
+	}
+	return node
+}
+
+// mappingValue returns the value under key in a mapping node, or nil if the key doesn't exist.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// isPlainMapping returns true if the node is a map that isn't an intrinsic function written in the short form.
+func isPlainMapping(node *yaml.Node) bool {
+	return node.Kind == yaml.MappingNode && node.Tag == "!!map"
+}
+
+// isPlainSequence returns true if the node is a list that isn't an intrinsic function written in the short form.
+func isPlainSequence(node *yaml.Node) bool {
+	return node.Kind == yaml.SequenceNode && node.Tag == "!!seq"
+}
+
+func isNull(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.Tag == "!!null"
+}
@@ -0,0 +1,222 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package override
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestScaffoldWithMerge(t *testing.T) {
+	t.Run("scaffolds files in an empty directory", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		dir := filepath.Join("copilot", "frontend", "overrides")
+
+		err := ScaffoldWithMerge(fs, dir)
+		require.NoError(t, err)
+
+		ok, _ := afero.Exists(fs, filepath.Join(dir, "README.md"))
+		require.True(t, ok, "README.md should exist")
+
+		ok, _ = afero.Exists(fs, filepath.Join(dir, strategicMergeFile))
+		require.True(t, ok, "cfn.merge.yml should exist")
+	})
+	t.Run("should return an error if the directory is not empty", func(t *testing.T) {
+		fs := afero.NewMemMapFs()
+		dir := filepath.Join("copilot", "frontend", "overrides")
+
+		_ = fs.MkdirAll(dir, 0755)
+		_ = afero.WriteFile(fs, filepath.Join(dir, "random.txt"), []byte("content"), 0644)
+
+		err := ScaffoldWithMerge(fs, dir)
+		require.EqualError(t, err, fmt.Sprintf("directory %q is not empty", dir))
+	})
+}
+
+func TestMerge_Override(t *testing.T) {
+	const template = `
+Description: CloudFormation template that represents a backend service on Amazon ECS.
+Parameters:
+  ContainerImage:
+    Type: String
+Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 30
+  TaskRole:
+    Type: AWS::IAM::Role
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      TaskRoleArn: !GetAtt TaskRole.Arn
+      ContainerDefinitions:
+        - Name: frontend
+          Image: !Ref ContainerImage
+          Environment:
+            - Name: LOG_LEVEL
+              Value: info
+        - Name: nginx
+          Image: nginx`
+
+	tests := map[string]struct {
+		overrides   string
+		expected    string
+		expectedErr string
+	}{
+		"merge into a map and delete keys with null": {
+			overrides: `
+LogGroup:
+  DeletionPolicy: Retain
+  Properties:
+    RetentionInDays: null
+    LogGroupName: frontend`,
+			expected: `
+Description: CloudFormation template that represents a backend service on Amazon ECS using AWS Copilot with strategic merge patches.
+Parameters:
+  ContainerImage:
+    Type: String
+Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      LogGroupName: frontend
+    DeletionPolicy: Retain
+  TaskRole:
+    Type: AWS::IAM::Role
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      TaskRoleArn: !GetAtt TaskRole.Arn
+      ContainerDefinitions:
+        - Name: frontend
+          Image: !Ref ContainerImage
+          Environment:
+            - Name: LOG_LEVEL
+              Value: info
+        - Name: nginx
+          Image: nginx`,
+		},
+		"merge into list items by name, delete and append items": {
+			overrides: `
+TaskDefinition:
+  Properties:
+    ContainerDefinitions:
+      - Name: frontend
+        Environment:
+          - Name: LOG_LEVEL
+            Value: debug
+          - Name: REGION
+            Value: us-west-2
+      - Name: nginx
+        $patch: delete
+      - Name: envoy
+        Image: envoyproxy/envoy`,
+			expected: `
+Description: CloudFormation template that represents a backend service on Amazon ECS using AWS Copilot with strategic merge patches.
+Parameters:
+  ContainerImage:
+    Type: String
+Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 30
+  TaskRole:
+    Type: AWS::IAM::Role
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      TaskRoleArn: !GetAtt TaskRole.Arn
+      ContainerDefinitions:
+        - Name: frontend
+          Image: !Ref ContainerImage
+          Environment:
+            - Name: LOG_LEVEL
+              Value: debug
+            - Name: REGION
+              Value: us-west-2
+        - Name: envoy
+          Image: envoyproxy/envoy`,
+		},
+		"replace a map and add a new resource": {
+			overrides: `
+TaskDefinition:
+  Properties:
+    $patch: replace
+    TaskRoleArn: arn:aws:iam::123456789012:role/MyTaskRole
+Bucket:
+  Type: AWS::S3::Bucket`,
+			expected: `
+Description: CloudFormation template that represents a backend service on Amazon ECS using AWS Copilot with strategic merge patches.
+Parameters:
+  ContainerImage:
+    Type: String
+Resources:
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 30
+  TaskRole:
+    Type: AWS::IAM::Role
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      TaskRoleArn: arn:aws:iam::123456789012:role/MyTaskRole
+  Bucket:
+    Type: AWS::S3::Bucket`,
+		},
+		"error if the resource doesn't exist and has no type": {
+			overrides: `
+Service:
+  Properties:
+    DesiredCount: 2`,
+			expectedErr: `merge resource "Service": resource not found in the template: specify "Type" to add a new resource`,
+		},
+		"error if an item to delete doesn't exist": {
+			overrides: `
+TaskDefinition:
+  Properties:
+    ContainerDefinitions:
+      - Name: envoy
+        $patch: delete`,
+			expectedErr: `merge resource "TaskDefinition": key "Properties/ContainerDefinitions": no item with Name "envoy" to delete`,
+		},
+		"error if the overridden template is invalid": {
+			overrides: `
+TaskRole: null`,
+			expectedErr: `validate overridden template: line 13: resource "TaskDefinition" gets an attribute of the resource "TaskRole" that doesn't exist`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(fs, "/"+strategicMergeFile, []byte(strings.TrimSpace(tc.overrides)), 0644))
+
+			m := WithMerge("/", MergeOpts{
+				FS: fs,
+			})
+
+			out, err := m.Override([]byte(strings.TrimSpace(template)))
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var expected yaml.Node
+			require.NoError(t, yaml.Unmarshal([]byte(strings.TrimSpace(tc.expected)), &expected))
+			wanted, err := yaml.Marshal(&expected)
+			require.NoError(t, err)
+			require.Equal(t, string(wanted), string(out))
+		})
+	}
+}
@@ -20,6 +20,13 @@ type Info struct {
 	mode overriderMode
 }
 
+func strategicMergeInfo(path string) Info {
+	return Info{
+		path: path,
+		mode: strategicMergeOverrider,
+	}
+}
+
 func cdkInfo(path string) Info {
 	return Info{
 		path: path,
@@ -39,13 +46,14 @@ type overriderMode int
 const (
 	cdkOverrider overriderMode = iota + 1
 	yamlPatchOverrider
+	strategicMergeOverrider
 )
 
 var templates = template.New()
 
 // Path returns the path to the overrider.
 // For CDK applications, returns the root of the CDK directory.
-// For YAML patch documents, returns the path to the file.
+// For YAML patch documents and strategic merge patches, returns the path to the directory of the file.
 func (i Info) Path() string {
 	return i.path
 }
@@ -60,9 +68,15 @@ func (i Info) IsYAMLPatch() bool {
 	return i.mode == yamlPatchOverrider
 }
 
-// Lookup returns information indicating if the overrider is a CDK application or YAML Patches.
+// IsStrategicMerge returns true if the overrider is a strategic merge patch.
+func (i Info) IsStrategicMerge() bool {
+	return i.mode == strategicMergeOverrider
+}
+
+// Lookup returns information indicating if the overrider is a CDK application, YAML Patches, or a strategic merge patch.
 // If path does not exist, then return an ErrNotExist.
 // If path is a directory that contains cfn.patches.yml, then IsYAMLPatch evaluates to true.
+// If path is a directory that contains cfn.merge.yml, then IsStrategicMerge evaluates to true.
 // If path is a directory that contains a cdk.json file, then IsCDK evaluates to true.
 func Lookup(path string, fs afero.Fs) (Info, error) {
 	_, err := fs.Stat(path)
@@ -82,6 +96,9 @@ func Lookup(path string, fs afero.Fs) (Info, error) {
 	if err == nil { // return yaml info if no error
 		return info, nil
 	}
+	if info, err := lookupStrategicMerge(path, fs); err == nil {
+		return info, nil
+	}
 
 	return lookupCDK(path, fs)
 }
@@ -94,6 +111,14 @@ func lookupYAMLPatch(path string, fs afero.Fs) (Info, error) {
 	return yamlPatchInfo(path), nil
 }
 
+func lookupStrategicMerge(path string, fs afero.Fs) (Info, error) {
+	ok, _ := afero.Exists(fs, filepath.Join(path, strategicMergeFile))
+	if !ok {
+		return Info{}, fmt.Errorf(`%s does not exist under %q`, strategicMergeFile, path)
+	}
+	return strategicMergeInfo(path), nil
+}
+
 func lookupCDK(path string, fs afero.Fs) (Info, error) {
 	ok, _ := afero.Exists(fs, filepath.Join(path, "cdk.json"))
 	if !ok {
@@ -118,4 +118,21 @@ func TestLookup(t *testing.T) {
 		require.False(t, info.IsCDK())
 		require.Equal(t, root, info.Path())
 	})
+	t.Run("should detect a strategic merge patch", func(t *testing.T) {
+		// GIVEN
+		fs := afero.NewMemMapFs()
+		root := filepath.Join("copilot", "frontend", "overrides")
+		_ = fs.MkdirAll(root, 0755)
+		_ = afero.WriteFile(fs, filepath.Join(root, strategicMergeFile), []byte("LogGroup: {DeletionPolicy: Retain}"), 0755)
+
+		// WHEN
+		info, err := Lookup(root, fs)
+
+		// THEN
+		require.NoError(t, err)
+		require.True(t, info.IsStrategicMerge())
+		require.False(t, info.IsYAMLPatch())
+		require.False(t, info.IsCDK())
+		require.Equal(t, root, info.Path())
+	})
 }
@@ -89,7 +89,7 @@ func (p *Patch) Override(body []byte) ([]byte, error) {
 		}
 	}
 
-	addYAMLPatchDescription(&root)
+	addOverrideDescription(&root, "YAML patches")
 	out, err := yaml.Marshal(&root)
 	if err != nil {
 		return nil, fmt.Errorf("unable to return modified document to []byte: %w", err)
@@ -112,9 +112,9 @@ func unmarshalPatches(path string, fs afero.Fs) ([]yamlPatch, error) {
 	return patches, nil
 }
 
-// addYAMLPatchDescription updates the Description field of a CloudFormation
-// to indicate it has been overriden with YAML patches for us to keep track of usage metrics.
-func addYAMLPatchDescription(body *yaml.Node) {
+// addOverrideDescription updates the Description field of a CloudFormation
+// to indicate it has been overriden with the given method for us to keep track of usage metrics.
+func addOverrideDescription(body *yaml.Node, method string) {
 	if body.Kind != yaml.DocumentNode || len(body.Content) == 0 {
 		return
 	}
@@ -123,8 +123,8 @@ func addYAMLPatchDescription(body *yaml.Node) {
 		if body.Content[i].Value != "Description" {
 			continue
 		}
-		body.Content[i+1].Value = fmt.Sprintf("%s using AWS Copilot with YAML patches.",
-			strings.TrimSuffix(body.Content[i+1].Value, "."))
+		body.Content[i+1].Value = fmt.Sprintf("%s using AWS Copilot with %s.",
+			strings.TrimSuffix(body.Content[i+1].Value, "."), method)
 		break
 	}
 }
@@ -0,0 +1,202 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package override
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/template-anatomy.html.
+	templateSections = []string{
+		"AWSTemplateFormatVersion", "Description", "Metadata", "Parameters", "Rules",
+		"Mappings", "Conditions", "Transform", "Resources", "Outputs",
+	}
+	// See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-product-attribute-reference.html.
+	resourceAttributes = []string{
+		"Type", "Properties", "Condition", "DependsOn", "Metadata",
+		"DeletionPolicy", "UpdateReplacePolicy", "UpdatePolicy", "CreationPolicy",
+	}
+	deletionPolicies = []string{"Delete", "Retain", "RetainExceptOnDelete", "Snapshot"}
+
+	resourceTypeRegexp = regexp.MustCompile(`^[A-Za-z0-9]+(::[A-Za-z0-9]+)+$`)
+)
+
+// ValidateTemplate returns an error if the CloudFormation template body doesn't follow the template schema, for example
+// if a resource has no type, has an unknown attribute, or references a resource, parameter, or condition that doesn't exist.
+// All the violations found are returned together.
+func ValidateTemplate(body []byte) error {
+	var root yaml.Node
+	if err := yaml.Unmarshal(body, &root); err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+	if len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		return errors.New("invalid template: expected a mapping")
+	}
+	tpl := root.Content[0]
+
+	var errs []error
+	for i := 0; i+1 < len(tpl.Content); i += 2 {
+		if section := tpl.Content[i].Value; !contains(templateSections, section) {
+			errs = append(errs, fmt.Errorf("line %d: unknown section %q", tpl.Content[i].Line, section))
+		}
+	}
+	resources := mappingValue(tpl, "Resources")
+	if resources == nil || resources.Kind != yaml.MappingNode || len(resources.Content) == 0 {
+		return errors.Join(append(errs, fmt.Errorf("%q must be a non-empty mapping", "Resources"))...)
+	}
+
+	v := &templateValidator{
+		resources:  mappingKeys(resources),
+		parameters: mappingKeys(mappingValue(tpl, "Parameters")),
+		conditions: mappingKeys(mappingValue(tpl, "Conditions")),
+	}
+	for i := 0; i+1 < len(resources.Content); i += 2 {
+		v.validateResource(resources.Content[i], resources.Content[i+1])
+	}
+	if outputs := mappingValue(tpl, "Outputs"); outputs != nil {
+		v.validateReferences("Outputs", outputs)
+	}
+	return errors.Join(append(errs, v.errs...)...)
+}
+
+type templateValidator struct {
+	resources  []string
+	parameters []string
+	conditions []string
+
+	errs []error
+}
+
+func (v *templateValidator) validateResource(key, resource *yaml.Node) {
+	logicalID := key.Value
+	if resource.Kind != yaml.MappingNode {
+		v.errorf(key, "resource %q must be a mapping", logicalID)
+		return
+	}
+	typ := mappingValue(resource, "Type")
+	switch {
+	case typ == nil:
+		v.errorf(key, "resource %q must have a %q", logicalID, "Type")
+	case typ.Kind != yaml.ScalarNode || !resourceTypeRegexp.MatchString(typ.Value):
+		v.errorf(typ, "resource %q has an invalid type %q", logicalID, typ.Value)
+	}
+	for i := 0; i+1 < len(resource.Content); i += 2 {
+		attr, value := resource.Content[i].Value, resource.Content[i+1]
+		switch {
+		case !contains(resourceAttributes, attr):
+			v.errorf(resource.Content[i], "resource %q has an unknown attribute %q", logicalID, attr)
+		case attr == "Properties" && value.Kind != yaml.MappingNode:
+			v.errorf(value, "%q of resource %q must be a mapping", attr, logicalID)
+		case attr == "DeletionPolicy" || attr == "UpdateReplacePolicy":
+			if value.Kind == yaml.ScalarNode && !contains(deletionPolicies, value.Value) {
+				v.errorf(value, "%q of resource %q must be one of %s", attr, logicalID, strings.Join(deletionPolicies, ", "))
+			}
+		case attr == "DependsOn":
+			v.validateDependsOn(logicalID, value)
+		case attr == "Condition":
+			if value.Kind == yaml.ScalarNode && !contains(v.conditions, value.Value) {
+				v.errorf(value, "resource %q uses the condition %q that doesn't exist", logicalID, value.Value)
+			}
+		}
+	}
+	v.validateReferences(fmt.Sprintf("resource %q", logicalID), resource)
+}
+
+func (v *templateValidator) validateDependsOn(logicalID string, node *yaml.Node) {
+	deps := []*yaml.Node{node}
+	if node.Kind == yaml.SequenceNode {
+		deps = node.Content
+	}
+	for _, dep := range deps {
+		if dep.Kind == yaml.ScalarNode && !contains(v.resources, dep.Value) {
+			v.errorf(dep, "resource %q depends on the resource %q that doesn't exist", logicalID, dep.Value)
+		}
+	}
+}
+
+// validateReferences walks the node and checks that the targets of "Ref", "Fn::GetAtt", and "Condition" exist.
+func (v *templateValidator) validateReferences(owner string, node *yaml.Node) {
+	switch node.Tag {
+	case "!Ref":
+		v.validateRef(owner, node)
+	case "!GetAtt":
+		v.validateGetAtt(owner, node)
+	case "!Condition":
+		v.validateCondition(owner, node)
+	}
+	if node.Kind == yaml.MappingNode && len(node.Content) == 2 {
+		switch node.Content[0].Value {
+		case "Ref":
+			v.validateRef(owner, node.Content[1])
+		case "Fn::GetAtt":
+			v.validateGetAtt(owner, node.Content[1])
+		}
+	}
+	for _, child := range node.Content {
+		v.validateReferences(owner, child)
+	}
+}
+
+func (v *templateValidator) validateRef(owner string, node *yaml.Node) {
+	if node.Kind != yaml.ScalarNode || strings.HasPrefix(node.Value, "AWS::") {
+		return // Pseudo parameters, or a reference computed by another intrinsic function.
+	}
+	if !contains(v.resources, node.Value) && !contains(v.parameters, node.Value) {
+		v.errorf(node, "%s references %q that is neither a resource nor a parameter", owner, node.Value)
+	}
+}
+
+func (v *templateValidator) validateGetAtt(owner string, node *yaml.Node) {
+	var logicalID string
+	switch node.Kind {
+	case yaml.ScalarNode:
+		logicalID, _, _ = strings.Cut(node.Value, ".")
+	case yaml.SequenceNode:
+		if len(node.Content) == 0 || node.Content[0].Kind != yaml.ScalarNode {
+			return
+		}
+		logicalID = node.Content[0].Value
+	default:
+		return
+	}
+	if !contains(v.resources, logicalID) {
+		v.errorf(node, "%s gets an attribute of the resource %q that doesn't exist", owner, logicalID)
+	}
+}
+
+func (v *templateValidator) validateCondition(owner string, node *yaml.Node) {
+	if node.Kind == yaml.ScalarNode && !contains(v.conditions, node.Value) {
+		v.errorf(node, "%s uses the condition %q that doesn't exist", owner, node.Value)
+	}
+}
+
+func (v *templateValidator) errorf(node *yaml.Node, format string, args ...any) {
+	v.errs = append(v.errs, fmt.Errorf("line %d: %s", node.Line, fmt.Sprintf(format, args...)))
+}
+
+func mappingKeys(node *yaml.Node) []string {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	keys := make([]string, 0, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keys = append(keys, node.Content[i].Value)
+	}
+	return keys
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
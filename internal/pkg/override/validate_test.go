@@ -0,0 +1,97 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package override
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTemplate(t *testing.T) {
+	tests := map[string]struct {
+		template    string
+		expectedErr string
+	}{
+		"valid template": {
+			template: `
+Parameters:
+  Env:
+    Type: String
+Conditions:
+  IsProd: !Equals [!Ref Env, prod]
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+    Condition: IsProd
+    DeletionPolicy: Retain
+  Policy:
+    Type: AWS::IAM::ManagedPolicy
+    DependsOn: [Queue]
+    Properties:
+      Description: !Sub "${AWS::StackName} policy"
+      PolicyDocument:
+        Statement:
+          - Resource:
+              Fn::GetAtt: [Queue, Arn]
+          - Resource: !Ref AWS::NoValue
+Outputs:
+  QueueURL:
+    Value: !Ref Queue`,
+		},
+		"error if the template isn't a map": {
+			template:    `- Resources`,
+			expectedErr: "invalid template: expected a mapping",
+		},
+		"error if there are no resources": {
+			template: `
+Description: empty
+Resouces:
+  Queue:
+    Type: AWS::SQS::Queue`,
+			expectedErr: `line 2: unknown section "Resouces"
+"Resources" must be a non-empty mapping`,
+		},
+		"report every invalid resource": {
+			template: `
+Resources:
+  Queue:
+    Properties:
+      QueueName: !Ref QueueName
+  Topic:
+    Type: SNS Topic
+    properties: {}
+    DeletionPolicy: Keep
+    DependsOn: Subscription
+  Bucket:
+    Type: AWS::S3::Bucket
+    Condition: IsProd
+    Properties: !GetAtt Topic.TopicName
+Outputs:
+  BucketArn:
+    Value: !GetAtt MyBucket.Arn`,
+			expectedErr: `line 2: resource "Queue" must have a "Type"
+line 4: resource "Queue" references "QueueName" that is neither a resource nor a parameter
+line 6: resource "Topic" has an invalid type "SNS Topic"
+line 7: resource "Topic" has an unknown attribute "properties"
+line 8: "DeletionPolicy" of resource "Topic" must be one of Delete, Retain, RetainExceptOnDelete, Snapshot
+line 9: resource "Topic" depends on the resource "Subscription" that doesn't exist
+line 12: resource "Bucket" uses the condition "IsProd" that doesn't exist
+line 13: "Properties" of resource "Bucket" must be a mapping
+line 16: Outputs gets an attribute of the resource "MyBucket" that doesn't exist`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateTemplate([]byte(strings.TrimSpace(tc.template)))
+			if tc.expectedErr != "" {
+				require.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package plugin runs the executables named copilot-<name> on the PATH as the "copilot <name>" command,
+// so that teams can add their own commands without changing the CLI.
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+)
+
+// Prefix is the prefix of the name of plugin executables.
+const Prefix = "copilot-"
+
+// ExecutableEnvVar is the environment variable that holds the path of the copilot executable for plugins to call back.
+const ExecutableEnvVar = "COPILOT_EXECUTABLE"
+
+var validName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]*$`)
+
+// Overridden in tests.
+var lookPath = exec.LookPath
+
+// Find returns the path of the plugin that the arguments of copilot run, or false if they don't run a plugin:
+// the first argument must be the name of a plugin on the PATH and not one of the builtin commands.
+func Find(args []string, builtins []string) (string, bool) {
+	if len(args) == 0 || !validName.MatchString(args[0]) {
+		return "", false
+	}
+	for _, builtin := range builtins {
+		if args[0] == builtin {
+			return "", false
+		}
+	}
+	path, err := lookPath(Prefix + args[0])
+	if err != nil {
+		return "", false
+	}
+	return path, true
+}
+
+// Run runs the plugin with the arguments in the terminal and returns its exit code.
+func Run(path string, args []string) (int, error) {
+	cmd := exec.Command(path, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = os.Environ()
+	if self, err := os.Executable(); err == nil {
+		cmd.Env = append(cmd.Env, ExecutableEnvVar+"="+self)
+	}
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return exitErr.ExitCode(), nil
+		}
+		return 1, fmt.Errorf("run plugin %s: %w", path, err)
+	}
+	return 0, nil
+}
@@ -0,0 +1,76 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package plugin
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFind(t *testing.T) {
+	testCases := map[string]struct {
+		args       []string
+		onPath     []string
+		wantedPath string
+		wantedOK   bool
+	}{
+		"no arguments": {},
+		"flag": {
+			args: []string{"--help"},
+		},
+		"builtin command": {
+			args:   []string{"svc", "deploy"},
+			onPath: []string{"copilot-svc"},
+		},
+		"plugin on the PATH": {
+			args:       []string{"ticket", "check"},
+			onPath:     []string{"copilot-ticket"},
+			wantedPath: "/usr/local/bin/copilot-ticket",
+			wantedOK:   true,
+		},
+		"plugin not on the PATH": {
+			args: []string{"ticket"},
+		},
+		"path in the name": {
+			args:   []string{"../ticket"},
+			onPath: []string{"copilot-../ticket"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defer func(orig func(string) (string, error)) { lookPath = orig }(lookPath)
+			lookPath = func(file string) (string, error) {
+				for _, exe := range tc.onPath {
+					if exe == file {
+						return "/usr/local/bin/" + file, nil
+					}
+				}
+				return "", errors.New("not found")
+			}
+
+			path, ok := Find(tc.args, []string{"svc", "env"})
+
+			require.Equal(t, tc.wantedOK, ok)
+			require.Equal(t, tc.wantedPath, path)
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are shell scripts in this test")
+	}
+	path := filepath.Join(t.TempDir(), "copilot-ticket")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\ntest \"$1\" = check && test -n \"$COPILOT_EXECUTABLE\" && exit 3\n"), 0755))
+
+	code, err := Run(path, []string{"check"})
+
+	require.NoError(t, err)
+	require.Equal(t, 3, code)
+}
@@ -36,23 +36,41 @@ type Repository struct {
 	docker   ContainerLoginBuildPusher
 }
 
+// Option configures a Repository.
+type Option func(*Repository)
+
+// WithDocker sets the client that logs in to the repository, and builds and pushes images to it.
+func WithDocker(docker ContainerLoginBuildPusher) Option {
+	return func(r *Repository) {
+		r.docker = docker
+	}
+}
+
 // New instantiates a new Repository.
-func New(registry Registry, name string) *Repository {
-	return &Repository{
+func New(registry Registry, name string, opts ...Option) *Repository {
+	r := &Repository{
 		name:     name,
 		registry: registry,
 		docker:   dockerengine.New(exec.NewCmd()),
 	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
 }
 
 // NewWithURI instantiates a new Repository with uri being set.
-func NewWithURI(registry Registry, name, uri string) *Repository {
-	return &Repository{
+func NewWithURI(registry Registry, name, uri string, opts ...Option) *Repository {
+	r := &Repository{
 		name:     name,
 		registry: registry,
 		uri:      uri,
 		docker:   dockerengine.New(exec.NewCmd()),
 	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
 }
 
 // BuildAndPush builds the image from Dockerfile and pushes it to the repository with tags.
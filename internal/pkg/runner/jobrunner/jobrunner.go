@@ -6,17 +6,29 @@ package jobrunner
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 )
 
+const (
+	ruleRoleLogicalID     = "RuleRole"
+	maxScheduleNameLength = 64
+)
+
 // StateMachineExecutor is the interface that implements the Execute method to invoke a state machine.
 type StateMachineExecutor interface {
 	Execute(stateMachineARN string) error
 }
 
+// OneTimeScheduler is the interface that implements the ScheduleOnce method to invoke a target at a later time.
+type OneTimeScheduler interface {
+	ScheduleOnce(name string, at time.Time, targetARN, roleARN string) error
+}
+
 // CFNStackResourceLister is the interface to list CloudFormation stack resources.
 type CFNStackResourceLister interface {
 	StackResources(name string) ([]*cloudformation.StackResource, error)
@@ -30,6 +42,7 @@ type JobRunner struct {
 
 	cfn          CFNStackResourceLister
 	stateMachine StateMachineExecutor
+	scheduler    OneTimeScheduler
 }
 
 // Config hold the data needed to create a JobRunner.
@@ -41,6 +54,7 @@ type Config struct {
 	// Dependencies to invoke a job.
 	CFN          CFNStackResourceLister // CloudFormation client to list stack resources.
 	StateMachine StateMachineExecutor   // StepFunction client to execute a state machine.
+	Scheduler    OneTimeScheduler       // EventBridge Scheduler client to execute a state machine later.
 }
 
 // New creates a new JobRunner.
@@ -51,6 +65,7 @@ func New(cfg *Config) *JobRunner {
 		job:          cfg.Job,
 		cfn:          cfg.CFN,
 		stateMachine: cfg.StateMachine,
+		scheduler:    cfg.Scheduler,
 	}
 
 }
@@ -58,23 +73,80 @@ func New(cfg *Config) *JobRunner {
 // Run invokes a job.
 // An error is returned if the state machine's ARN can not be derived from the job, or the execution fails.
 func (job *JobRunner) Run() error {
-	resources, err := job.cfn.StackResources(stack.NameForWorkload(job.app, job.env, job.job))
+	resources, err := job.stackResources()
 	if err != nil {
-		return fmt.Errorf("describe stack %q: %v", stack.NameForWorkload(job.app, job.env, job.job), err)
+		return err
+	}
+	arn, err := job.stateMachineARN(resources)
+	if err != nil {
+		return err
+	}
+	if err := job.stateMachine.Execute(arn); err != nil {
+		return fmt.Errorf("execute state machine %q: %v", arn, err)
 	}
+	return nil
+}
 
-	var arn string
+// RunAt schedules a one-off invocation of a job at the given time.
+// The invocation assumes the role of the job's event rules, which must trust EventBridge Scheduler.
+func (job *JobRunner) RunAt(at time.Time) error {
+	resources, err := job.stackResources()
+	if err != nil {
+		return err
+	}
+	stateMachineARN, err := job.stateMachineARN(resources)
+	if err != nil {
+		return err
+	}
+	var roleName string
 	for _, resource := range resources {
-		if aws.StringValue(resource.ResourceType) == "AWS::StepFunctions::StateMachine" {
-			arn = aws.StringValue(resource.PhysicalResourceId)
+		if aws.StringValue(resource.LogicalResourceId) == ruleRoleLogicalID {
+			roleName = aws.StringValue(resource.PhysicalResourceId)
 			break
 		}
 	}
-	if arn == "" {
-		return fmt.Errorf("state machine for job %q is not found in environment %q and application %q", job.job, job.env, job.app)
+	if roleName == "" {
+		return fmt.Errorf("role to invoke job %q is not found in environment %q and application %q", job.job, job.env, job.app)
 	}
-	if err := job.stateMachine.Execute(arn); err != nil {
-		return fmt.Errorf("execute state machine %q: %v", arn, err)
+	parsed, err := arn.Parse(stateMachineARN)
+	if err != nil {
+		return fmt.Errorf("parse state machine ARN %q: %w", stateMachineARN, err)
+	}
+	roleARN := arn.ARN{
+		Partition: parsed.Partition,
+		Service:   "iam",
+		AccountID: parsed.AccountID,
+		Resource:  "role/" + roleName,
+	}.String()
+	if err := job.scheduler.ScheduleOnce(job.scheduleName(at), at, stateMachineARN, roleARN); err != nil {
+		return fmt.Errorf("schedule state machine %q: %v", stateMachineARN, err)
 	}
 	return nil
 }
+
+func (job *JobRunner) stackResources() ([]*cloudformation.StackResource, error) {
+	resources, err := job.cfn.StackResources(stack.NameForWorkload(job.app, job.env, job.job))
+	if err != nil {
+		return nil, fmt.Errorf("describe stack %q: %v", stack.NameForWorkload(job.app, job.env, job.job), err)
+	}
+	return resources, nil
+}
+
+func (job *JobRunner) stateMachineARN(resources []*cloudformation.StackResource) (string, error) {
+	for _, resource := range resources {
+		if aws.StringValue(resource.ResourceType) == "AWS::StepFunctions::StateMachine" {
+			return aws.StringValue(resource.PhysicalResourceId), nil
+		}
+	}
+	return "", fmt.Errorf("state machine for job %q is not found in environment %q and application %q", job.job, job.env, job.app)
+}
+
+// scheduleName returns a unique name for a one-off invocation within the limit of 64 characters.
+func (job *JobRunner) scheduleName(at time.Time) string {
+	suffix := fmt.Sprintf("-%d", at.Unix())
+	prefix := fmt.Sprintf("%s-%s-%s", job.app, job.env, job.job)
+	if len(prefix)+len(suffix) > maxScheduleNameLength {
+		prefix = prefix[:maxScheduleNameLength-len(suffix)]
+	}
+	return prefix + suffix
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
@@ -125,3 +126,108 @@ func TestJobRunner_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestJobRunner_RunAt(t *testing.T) {
+	mockAt := time.Date(2023, time.October, 15, 9, 30, 0, 0, time.UTC)
+	mockStateMachine := &cloudformation.StackResource{
+		LogicalResourceId:  aws.String("StateMachine"),
+		ResourceType:       aws.String("AWS::StepFunctions::StateMachine"),
+		PhysicalResourceId: aws.String("arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job"),
+	}
+	testCases := map[string]struct {
+		Job string
+
+		MockCFN       func(m *mocks.MockCFNStackResourceLister)
+		MockScheduler func(m *mocks.MockOneTimeScheduler)
+
+		wantedError error
+	}{
+		"missing rule role resource": {
+			Job: "jobname",
+			MockCFN: func(m *mocks.MockCFNStackResourceLister) {
+				m.EXPECT().StackResources("appname-envname-jobname").Return([]*cloudformation.StackResource{mockStateMachine}, nil)
+			},
+			MockScheduler: func(m *mocks.MockOneTimeScheduler) {},
+			wantedError:   errors.New(`role to invoke job "jobname" is not found in environment "envname" and application "appname"`),
+		},
+		"failed to create the schedule": {
+			Job: "jobname",
+			MockCFN: func(m *mocks.MockCFNStackResourceLister) {
+				m.EXPECT().StackResources("appname-envname-jobname").Return([]*cloudformation.StackResource{
+					mockStateMachine,
+					{
+						LogicalResourceId:  aws.String("RuleRole"),
+						ResourceType:       aws.String("AWS::IAM::Role"),
+						PhysicalResourceId: aws.String("appname-envname-jobname-RuleRole-ABC"),
+					},
+				}, nil)
+			},
+			MockScheduler: func(m *mocks.MockOneTimeScheduler) {
+				m.EXPECT().ScheduleOnce(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New(`schedule state machine "arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job": some error`),
+		},
+		"schedule success": {
+			Job: "jobname",
+			MockCFN: func(m *mocks.MockCFNStackResourceLister) {
+				m.EXPECT().StackResources("appname-envname-jobname").Return([]*cloudformation.StackResource{
+					mockStateMachine,
+					{
+						LogicalResourceId:  aws.String("RuleRole"),
+						ResourceType:       aws.String("AWS::IAM::Role"),
+						PhysicalResourceId: aws.String("appname-envname-jobname-RuleRole-ABC"),
+					},
+				}, nil)
+			},
+			MockScheduler: func(m *mocks.MockOneTimeScheduler) {
+				m.EXPECT().ScheduleOnce("appname-envname-jobname-1697362200", mockAt,
+					"arn:aws:states:us-east-1:111111111111:stateMachine:app-env-job",
+					"arn:aws:iam::111111111111:role/appname-envname-jobname-RuleRole-ABC").Return(nil)
+			},
+		},
+		"truncate the schedule name of a long job name": {
+			Job: "a-job-with-a-very-long-name-that-does-not-fit-in-the-schedule",
+			MockCFN: func(m *mocks.MockCFNStackResourceLister) {
+				m.EXPECT().StackResources(gomock.Any()).Return([]*cloudformation.StackResource{
+					mockStateMachine,
+					{
+						LogicalResourceId:  aws.String("RuleRole"),
+						PhysicalResourceId: aws.String("RuleRole-ABC"),
+					},
+				}, nil)
+			},
+			MockScheduler: func(m *mocks.MockOneTimeScheduler) {
+				m.EXPECT().ScheduleOnce("appname-envname-a-job-with-a-very-long-name-that-does-1697362200", mockAt, gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			cfn := mocks.NewMockCFNStackResourceLister(ctrl)
+			scheduler := mocks.NewMockOneTimeScheduler(ctrl)
+
+			tc.MockCFN(cfn)
+			tc.MockScheduler(scheduler)
+
+			jobRunner := JobRunner{
+				app:       "appname",
+				env:       "envname",
+				job:       tc.Job,
+				cfn:       cfn,
+				scheduler: scheduler,
+			}
+
+			err := jobRunner.RunAt(mockAt)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
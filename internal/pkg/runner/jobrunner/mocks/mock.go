@@ -6,6 +6,7 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	gomock "github.com/golang/mock/gomock"
@@ -48,6 +49,43 @@ func (mr *MockStateMachineExecutorMockRecorder) Execute(stateMachineARN interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockStateMachineExecutor)(nil).Execute), stateMachineARN)
 }
 
+// MockOneTimeScheduler is a mock of OneTimeScheduler interface.
+type MockOneTimeScheduler struct {
+	ctrl     *gomock.Controller
+	recorder *MockOneTimeSchedulerMockRecorder
+}
+
+// MockOneTimeSchedulerMockRecorder is the mock recorder for MockOneTimeScheduler.
+type MockOneTimeSchedulerMockRecorder struct {
+	mock *MockOneTimeScheduler
+}
+
+// NewMockOneTimeScheduler creates a new mock instance.
+func NewMockOneTimeScheduler(ctrl *gomock.Controller) *MockOneTimeScheduler {
+	mock := &MockOneTimeScheduler{ctrl: ctrl}
+	mock.recorder = &MockOneTimeSchedulerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOneTimeScheduler) EXPECT() *MockOneTimeSchedulerMockRecorder {
+	return m.recorder
+}
+
+// ScheduleOnce mocks base method.
+func (m *MockOneTimeScheduler) ScheduleOnce(name string, at time.Time, targetARN, roleARN string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleOnce", name, at, targetARN, roleARN)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScheduleOnce indicates an expected call of ScheduleOnce.
+func (mr *MockOneTimeSchedulerMockRecorder) ScheduleOnce(name, at, targetARN, roleARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleOnce", reflect.TypeOf((*MockOneTimeScheduler)(nil).ScheduleOnce), name, at, targetARN, roleARN)
+}
+
 // MockCFNStackResourceLister is a mock of CFNStackResourceLister interface.
 type MockCFNStackResourceLister struct {
 	ctrl     *gomock.Controller
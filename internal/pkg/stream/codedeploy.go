@@ -0,0 +1,127 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+)
+
+// CodeDeployDescriber is the interface to describe the deployments of a CodeDeploy deployment group.
+type CodeDeployDescriber interface {
+	LatestDeployment(app, deploymentGroup string, since time.Time) (*codedeploy.Deployment, error)
+}
+
+// BlueGreenDeployment is a description of a CodeDeploy blue/green deployment of an ECS service.
+type BlueGreenDeployment struct {
+	ID              string
+	Status          string
+	ErrorMessage    string
+	TrafficShifted  bool
+	LifecycleEvents []codedeploy.LifecycleEvent
+}
+
+// BlueGreenDeploymentStreamer is a Streamer for the CodeDeploy deployment of a deployment group
+// until the traffic is shifted to the replacement tasks, or the deployment is completed.
+type BlueGreenDeploymentStreamer struct {
+	client          CodeDeployDescriber
+	clock           clock
+	rand            func(n int) int
+	app             string
+	deploymentGroup string
+	since           time.Time
+
+	subscribers   []chan BlueGreenDeployment
+	isDone        bool
+	eventsToFlush []BlueGreenDeployment
+	mu            sync.Mutex
+
+	retries int
+}
+
+// NewBlueGreenDeploymentStreamer creates a new BlueGreenDeploymentStreamer that streams the latest deployment
+// of the deployment group created after the input time.
+func NewBlueGreenDeploymentStreamer(cd CodeDeployDescriber, app, deploymentGroup string, since time.Time) *BlueGreenDeploymentStreamer {
+	return &BlueGreenDeploymentStreamer{
+		client:          cd,
+		clock:           realClock{},
+		rand:            rand.Intn,
+		app:             app,
+		deploymentGroup: deploymentGroup,
+		since:           since,
+	}
+}
+
+// Subscribe returns a read-only channel that will receive deployment descriptions from the BlueGreenDeploymentStreamer.
+func (s *BlueGreenDeploymentStreamer) Subscribe() <-chan BlueGreenDeployment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := make(chan BlueGreenDeployment)
+	s.subscribers = append(s.subscribers, c)
+	if s.isDone {
+		// If the streamer is already done streaming, any new subscription requests should just return a closed channel.
+		close(c)
+	}
+	return c
+}
+
+// Fetch retrieves and stores the latest deployment of the deployment group.
+// The streamer is done once all the traffic is routed to the replacement tasks, or the deployment is completed.
+// The original tasks are terminated after the traffic is shifted, which can take a while, hence it's not waited for.
+// If the deployment is not created yet, Fetch is attempted again later.
+func (s *BlueGreenDeploymentStreamer) Fetch() (next time.Time, done bool, err error) {
+	out, err := s.client.LatestDeployment(s.app, s.deploymentGroup, s.since)
+	if err != nil {
+		if request.IsErrorThrottle(err) {
+			s.retries += 1
+			return nextFetchDate(s.clock, s.rand, s.retries), false, nil
+		}
+		return next, false, fmt.Errorf("fetch deployment: %w", err)
+	}
+	s.retries = 0
+	if out == nil {
+		return nextFetchDate(s.clock, s.rand, 0), false, nil
+	}
+	s.eventsToFlush = append(s.eventsToFlush, BlueGreenDeployment{
+		ID:              out.ID,
+		Status:          out.Status,
+		ErrorMessage:    out.ErrorMessage,
+		TrafficShifted:  out.TrafficShifted,
+		LifecycleEvents: out.LifecycleEvents,
+	})
+	return nextFetchDate(s.clock, s.rand, 0), out.Done() || out.TrafficShifted, nil
+}
+
+// Notify flushes all new events to the streamer's subscribers.
+func (s *BlueGreenDeploymentStreamer) Notify() {
+	// Copy current list of subscribers over, so that we can we add more subscribers while
+	// notifying previous subscribers of older events.
+	s.mu.Lock()
+	var subs []chan BlueGreenDeployment
+	subs = append(subs, s.subscribers...)
+	s.mu.Unlock()
+
+	for _, event := range s.eventsToFlush {
+		for _, sub := range subs {
+			sub <- event
+		}
+	}
+	s.eventsToFlush = nil // reset after flushing all events.
+}
+
+// Close closes all subscribed channels notifying them that no more events will be sent.
+func (s *BlueGreenDeploymentStreamer) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sub := range s.subscribers {
+		close(sub)
+	}
+	s.isDone = true
+}
@@ -0,0 +1,125 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+	"github.com/stretchr/testify/require"
+)
+
+type mockCodeDeploy struct {
+	out *codedeploy.Deployment
+	err error
+}
+
+func (m mockCodeDeploy) LatestDeployment(app, deploymentGroup string, since time.Time) (*codedeploy.Deployment, error) {
+	return m.out, m.err
+}
+
+func TestBlueGreenDeploymentStreamer_Subscribe(t *testing.T) {
+	t.Run("allow new subscriptions if the streamer is still active", func(t *testing.T) {
+		// GIVEN
+		streamer := &BlueGreenDeploymentStreamer{}
+
+		// WHEN
+		_ = streamer.Subscribe()
+		_ = streamer.Subscribe()
+
+		// THEN
+		require.Equal(t, 2, len(streamer.subscribers), "expected number of subscribers to match")
+	})
+	t.Run("new subscriptions on a finished streamer should return closed channels", func(t *testing.T) {
+		// GIVEN
+		streamer := &BlueGreenDeploymentStreamer{isDone: true}
+
+		// WHEN
+		ch := streamer.Subscribe()
+		_, ok := <-ch
+
+		// THEN
+		require.False(t, ok, "channel should be closed")
+	})
+}
+
+func TestBlueGreenDeploymentStreamer_Fetch(t *testing.T) {
+	t.Run("returns a wrapped error on describe deployment call failure", func(t *testing.T) {
+		// GIVEN
+		streamer := NewBlueGreenDeploymentStreamer(mockCodeDeploy{err: errors.New("some error")}, "app", "group", time.Now())
+
+		// WHEN
+		_, _, err := streamer.Fetch()
+
+		// THEN
+		require.EqualError(t, err, "fetch deployment: some error")
+	})
+	t.Run("keeps fetching until the deployment is created", func(t *testing.T) {
+		// GIVEN
+		streamer := NewBlueGreenDeploymentStreamer(mockCodeDeploy{}, "app", "group", time.Now())
+
+		// WHEN
+		_, done, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.False(t, done)
+		require.Empty(t, streamer.eventsToFlush)
+	})
+	testCases := map[string]struct {
+		deployment *codedeploy.Deployment
+		wantedDone bool
+	}{
+		"is not done while the traffic is shifting": {
+			deployment: &codedeploy.Deployment{
+				ID:     "d-1",
+				Status: "InProgress",
+				LifecycleEvents: []codedeploy.LifecycleEvent{
+					{Name: "Install", Status: "Succeeded"},
+					{Name: "AllowTraffic", Status: "InProgress"},
+				},
+			},
+		},
+		"is done once the traffic is shifted": {
+			deployment: &codedeploy.Deployment{
+				ID:             "d-1",
+				Status:         "InProgress",
+				TrafficShifted: true,
+			},
+			wantedDone: true,
+		},
+		"is done if the deployment fails": {
+			deployment: &codedeploy.Deployment{
+				ID:           "d-1",
+				Status:       "Failed",
+				ErrorMessage: "some message",
+			},
+			wantedDone: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			streamer := NewBlueGreenDeploymentStreamer(mockCodeDeploy{out: tc.deployment}, "app", "group", time.Now())
+
+			// WHEN
+			_, done, err := streamer.Fetch()
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDone, done)
+			require.Equal(t, []BlueGreenDeployment{
+				{
+					ID:              tc.deployment.ID,
+					Status:          tc.deployment.Status,
+					ErrorMessage:    tc.deployment.ErrorMessage,
+					TrafficShifted:  tc.deployment.TrafficShifted,
+					LifecycleEvents: tc.deployment.LifecycleEvents,
+				},
+			}, streamer.eventsToFlush)
+		})
+	}
+}
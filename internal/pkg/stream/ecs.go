@@ -22,6 +22,8 @@ const (
 	rollOutCompleted           = "COMPLETED"
 	rollOutFailed              = "FAILED"
 	rollOutEmpty               = ""
+
+	fmtSpotInterruptionMsg = "Task %s was interrupted by Fargate Spot."
 )
 
 var ecsEventFailureKeywords = []string{"fail", "unhealthy", "error", "throttle", "unable", "missing", "alarm detected", "rolling back"}
@@ -29,6 +31,7 @@ var ecsEventFailureKeywords = []string{"fail", "unhealthy", "error", "throttle",
 // ECSServiceDescriber is the interface to describe an ECS service.
 type ECSServiceDescriber interface {
 	Service(clusterName, serviceName string) (*ecs.Service, error)
+	StoppedServiceTasks(cluster, service string) ([]*ecs.Task, error)
 }
 
 // CloudWatchDescriber is the interface to describe CW alarms.
@@ -38,21 +41,27 @@ type CloudWatchDescriber interface {
 
 // ECSDeployment represent an ECS rolling update deployment.
 type ECSDeployment struct {
-	Status          string
-	TaskDefRevision string
-	DesiredCount    int
-	RunningCount    int
-	FailedCount     int
-	PendingCount    int
-	RolloutState    string
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
+	Status             string
+	TaskDefRevision    string
+	DesiredCount       int
+	RunningCount       int
+	FailedCount        int
+	PendingCount       int
+	RolloutState       string
+	RolloutStateReason string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
 }
 
 func (d ECSDeployment) isPrimary() bool {
 	return d.Status == ecsPrimaryDeploymentStatus
 }
 
+// Failed returns true if the deployment failed, in which case ECS rolls back to the last completed deployment.
+func (d ECSDeployment) Failed() bool {
+	return d.RolloutState == rollOutFailed
+}
+
 func (d ECSDeployment) done() bool {
 	switch d.RolloutState {
 	case rollOutFailed:
@@ -69,6 +78,7 @@ type ECSService struct {
 	Deployments         []ECSDeployment
 	LatestFailureEvents []string
 	Alarms              []cloudwatch.AlarmStatus
+	SpotInterruptions   []string // Messages for the tasks interrupted by Fargate Spot since the last description.
 }
 
 // ECSDeploymentStreamer is a Streamer for ECSService descriptions until the deployment is completed.
@@ -81,11 +91,12 @@ type ECSDeploymentStreamer struct {
 	service                string
 	deploymentCreationTime time.Time
 
-	subscribers   []chan ECSService
-	isDone        bool
-	pastEventIDs  map[string]bool
-	eventsToFlush []ECSService
-	mu            sync.Mutex
+	subscribers             []chan ECSService
+	isDone                  bool
+	pastEventIDs            map[string]bool
+	pastInterruptedTaskARNs map[string]bool
+	eventsToFlush           []ECSService
+	mu                      sync.Mutex
 
 	ecsRetries int
 	cwRetries  int
@@ -95,14 +106,15 @@ type ECSDeploymentStreamer struct {
 // since the deployment creation time and until the primary deployment is completed.
 func NewECSDeploymentStreamer(ecs ECSServiceDescriber, cw CloudWatchDescriber, cluster, service string, deploymentCreationTime time.Time) *ECSDeploymentStreamer {
 	return &ECSDeploymentStreamer{
-		client:                 ecs,
-		cw:                     cw,
-		clock:                  realClock{},
-		rand:                   rand.Intn,
-		cluster:                cluster,
-		service:                service,
-		deploymentCreationTime: deploymentCreationTime,
-		pastEventIDs:           make(map[string]bool),
+		client:                  ecs,
+		cw:                      cw,
+		clock:                   realClock{},
+		rand:                    rand.Intn,
+		cluster:                 cluster,
+		service:                 service,
+		deploymentCreationTime:  deploymentCreationTime,
+		pastEventIDs:            make(map[string]bool),
+		pastInterruptedTaskARNs: make(map[string]bool),
 	}
 }
 
@@ -139,15 +151,16 @@ func (s *ECSDeploymentStreamer) Fetch() (next time.Time, done bool, err error) {
 		status := aws.StringValue(deployment.Status)
 		desiredCount, runningCount := aws.Int64Value(deployment.DesiredCount), aws.Int64Value(deployment.RunningCount)
 		rollingDeploy := ECSDeployment{
-			Status:          status,
-			TaskDefRevision: parseRevisionFromTaskDefARN(aws.StringValue(deployment.TaskDefinition)),
-			DesiredCount:    int(desiredCount),
-			RunningCount:    int(runningCount),
-			FailedCount:     int(aws.Int64Value(deployment.FailedTasks)),
-			PendingCount:    int(aws.Int64Value(deployment.PendingCount)),
-			RolloutState:    aws.StringValue(deployment.RolloutState),
-			CreatedAt:       aws.TimeValue(deployment.CreatedAt),
-			UpdatedAt:       aws.TimeValue(deployment.UpdatedAt),
+			Status:             status,
+			TaskDefRevision:    parseRevisionFromTaskDefARN(aws.StringValue(deployment.TaskDefinition)),
+			DesiredCount:       int(desiredCount),
+			RunningCount:       int(runningCount),
+			FailedCount:        int(aws.Int64Value(deployment.FailedTasks)),
+			PendingCount:       int(aws.Int64Value(deployment.PendingCount)),
+			RolloutState:       aws.StringValue(deployment.RolloutState),
+			RolloutStateReason: aws.StringValue(deployment.RolloutStateReason),
+			CreatedAt:          aws.TimeValue(deployment.CreatedAt),
+			UpdatedAt:          aws.TimeValue(deployment.UpdatedAt),
 		}
 		deployments = append(deployments, rollingDeploy)
 		if isDeploymentDone(rollingDeploy, s.deploymentCreationTime) {
@@ -183,14 +196,50 @@ func (s *ECSDeploymentStreamer) Fetch() (next time.Time, done bool, err error) {
 		s.cwRetries = 0
 	}
 
+	var interruptions []string
+	if usesFargateSpot(out) {
+		tasks, err := s.client.StoppedServiceTasks(s.cluster, s.service)
+		if err != nil {
+			if request.IsErrorThrottle(err) {
+				s.ecsRetries += 1
+				return nextFetchDate(s.clock, s.rand, s.ecsRetries), false, nil
+			}
+			return next, false, fmt.Errorf("list stopped tasks of service %s: %w", s.service, err)
+		}
+		interruptions = s.newSpotInterruptions(tasks)
+	}
+
 	s.eventsToFlush = append(s.eventsToFlush, ECSService{
 		Deployments:         deployments,
 		LatestFailureEvents: failureMsgs,
 		Alarms:              alarms,
+		SpotInterruptions:   interruptions,
 	})
 	return nextFetchDate(s.clock, s.rand, 0), done, nil
 }
 
+// newSpotInterruptions returns a message for each task interrupted by Fargate Spot since the deployment started
+// that wasn't reported by a previous fetch.
+func (s *ECSDeploymentStreamer) newSpotInterruptions(tasks []*ecs.Task) []string {
+	var msgs []string
+	for _, task := range tasks {
+		arn := aws.StringValue(task.TaskArn)
+		if !task.SpotInterrupted() || s.pastInterruptedTaskARNs[arn] {
+			continue
+		}
+		if aws.TimeValue(task.StoppedAt).Before(s.deploymentCreationTime) {
+			continue
+		}
+		s.pastInterruptedTaskARNs[arn] = true
+		id, err := ecs.TaskID(arn)
+		if err != nil {
+			id = arn
+		}
+		msgs = append(msgs, fmt.Sprintf(fmtSpotInterruptionMsg, id))
+	}
+	return msgs
+}
+
 // Notify flushes all new events to the streamer's subscribers.
 func (s *ECSDeploymentStreamer) Notify() {
 	// Copy current list of subscribers over, so that we can we add more subscribers while
@@ -227,6 +276,15 @@ func parseRevisionFromTaskDefARN(arn string) string {
 	return strings.Split(familyName, ":")[1]
 }
 
+func usesFargateSpot(svc *ecs.Service) bool {
+	for _, strategy := range svc.CapacityProviderStrategy {
+		if aws.StringValue(strategy.CapacityProvider) == ecs.TaskCapacityProviderFargateSpot {
+			return true
+		}
+	}
+	return false
+}
+
 func isFailureServiceEvent(msg string) bool {
 	for _, kw := range ecsEventFailureKeywords {
 		if strings.Contains(msg, kw) {
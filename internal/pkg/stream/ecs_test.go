@@ -18,6 +18,9 @@ import (
 type mockECS struct {
 	out *ecs.Service
 	err error
+
+	stoppedTasks    []*ecs.Task
+	stoppedTasksErr error
 }
 
 type mockCW struct {
@@ -29,6 +32,10 @@ func (m mockECS) Service(clusterName, serviceName string) (*ecs.Service, error)
 	return m.out, m.err
 }
 
+func (m mockECS) StoppedServiceTasks(cluster, service string) ([]*ecs.Task, error) {
+	return m.stoppedTasks, m.stoppedTasksErr
+}
+
 func (m mockCW) AlarmStatuses(opts ...cloudwatch.DescribeAlarmOpts) ([]cloudwatch.AlarmStatus, error) {
 	return m.out, m.err
 }
@@ -115,14 +122,15 @@ func TestECSDeploymentStreamer_Fetch(t *testing.T) {
 						UpdatedAt:      aws.Time(startDate),
 					},
 					{
-						DesiredCount:   aws.Int64(10),
-						FailedTasks:    aws.Int64(10),
-						PendingCount:   aws.Int64(0),
-						RolloutState:   aws.String("FAILED"),
-						RunningCount:   aws.Int64(0),
-						Status:         aws.String("ACTIVE"),
-						TaskDefinition: aws.String("arn:aws:ecs:us-west-2:1111:task-definition/myapp-test-mysvc:1"),
-						UpdatedAt:      aws.Time(oldStartDate),
+						DesiredCount:       aws.Int64(10),
+						FailedTasks:        aws.Int64(10),
+						PendingCount:       aws.Int64(0),
+						RolloutState:       aws.String("FAILED"),
+						RolloutStateReason: aws.String("ECS deployment circuit breaker: alarm detected."),
+						RunningCount:       aws.Int64(0),
+						Status:             aws.String("ACTIVE"),
+						TaskDefinition:     aws.String("arn:aws:ecs:us-west-2:1111:task-definition/myapp-test-mysvc:1"),
+						UpdatedAt:          aws.Time(oldStartDate),
 					},
 				},
 				DeploymentConfiguration: &awsecs.DeploymentConfiguration{
@@ -179,14 +187,15 @@ func TestECSDeploymentStreamer_Fetch(t *testing.T) {
 						UpdatedAt:       startDate,
 					},
 					{
-						Status:          "ACTIVE",
-						TaskDefRevision: "1",
-						DesiredCount:    10,
-						RunningCount:    0,
-						FailedCount:     10,
-						PendingCount:    0,
-						RolloutState:    "FAILED",
-						UpdatedAt:       oldStartDate,
+						Status:             "ACTIVE",
+						TaskDefRevision:    "1",
+						DesiredCount:       10,
+						RunningCount:       0,
+						FailedCount:        10,
+						PendingCount:       0,
+						RolloutState:       "FAILED",
+						RolloutStateReason: "ECS deployment circuit breaker: alarm detected.",
+						UpdatedAt:          oldStartDate,
 					},
 				},
 				Alarms: []cloudwatch.AlarmStatus{
@@ -437,6 +446,76 @@ func TestECSDeploymentStreamer_Fetch(t *testing.T) {
 		require.Equal(t, 1, len(streamer.eventsToFlush), "should have only one event to flush")
 		require.Nil(t, streamer.eventsToFlush[0].LatestFailureEvents, "there should be no failed events emitted")
 	})
+	t.Run("returns a wrapped error on listing stopped tasks failure for services on Fargate Spot", func(t *testing.T) {
+		// GIVEN
+		m := mockECS{
+			out: &ecs.Service{
+				CapacityProviderStrategy: []*awsecs.CapacityProviderStrategyItem{
+					{
+						CapacityProvider: aws.String("FARGATE_SPOT"),
+						Weight:           aws.Int64(1),
+					},
+				},
+			},
+			stoppedTasksErr: errors.New("some error"),
+		}
+		streamer := NewECSDeploymentStreamer(m, mockCW{}, "my-cluster", "my-svc", time.Now())
+
+		// WHEN
+		_, _, err := streamer.Fetch()
+
+		// THEN
+		require.EqualError(t, err, "list stopped tasks of service my-svc: some error")
+	})
+	t.Run("stores new spot interruptions since the deployment creation time", func(t *testing.T) {
+		// GIVEN
+		startDate := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+		m := mockECS{
+			out: &ecs.Service{
+				CapacityProviderStrategy: []*awsecs.CapacityProviderStrategyItem{
+					{
+						CapacityProvider: aws.String("FARGATE_SPOT"),
+						Weight:           aws.Int64(1),
+					},
+				},
+			},
+			stoppedTasks: []*ecs.Task{
+				{
+					// Interrupted before the deployment.
+					TaskArn:   aws.String("arn:aws:ecs:us-west-2:1111:task/my-cluster/1111"),
+					StopCode:  aws.String("SpotInterruption"),
+					StoppedAt: aws.Time(startDate.Add(-1 * time.Minute)),
+				},
+				{
+					// Already reported.
+					TaskArn:   aws.String("arn:aws:ecs:us-west-2:1111:task/my-cluster/2222"),
+					StopCode:  aws.String("SpotInterruption"),
+					StoppedAt: aws.Time(startDate.Add(1 * time.Minute)),
+				},
+				{
+					// Stopped for another reason.
+					TaskArn:   aws.String("arn:aws:ecs:us-west-2:1111:task/my-cluster/3333"),
+					StopCode:  aws.String("EssentialContainerExited"),
+					StoppedAt: aws.Time(startDate.Add(1 * time.Minute)),
+				},
+				{
+					TaskArn:   aws.String("arn:aws:ecs:us-west-2:1111:task/my-cluster/4444"),
+					StopCode:  aws.String("SpotInterruption"),
+					StoppedAt: aws.Time(startDate.Add(2 * time.Minute)),
+				},
+			},
+		}
+		streamer := NewECSDeploymentStreamer(m, mockCW{}, "my-cluster", "my-svc", startDate)
+		streamer.pastInterruptedTaskARNs["arn:aws:ecs:us-west-2:1111:task/my-cluster/2222"] = true
+
+		// WHEN
+		_, _, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, 1, len(streamer.eventsToFlush), "should have only one event to flush")
+		require.Equal(t, []string{"Task 4444 was interrupted by Fargate Spot."}, streamer.eventsToFlush[0].SpotInterruptions)
+	})
 }
 
 func TestECSDeploymentStreamer_Notify(t *testing.T) {
@@ -0,0 +1,75 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetry
+
+import (
+	"sort"
+	"time"
+)
+
+// CommandStats summarizes the runs of a command.
+type CommandStats struct {
+	Command  string         `json:"command"`
+	Runs     int            `json:"runs"`
+	Failures map[string]int `json:"failures,omitempty"` // Number of failures by error code.
+	MedianMS int64          `json:"medianMs"`
+	P90MS    int64          `json:"p90Ms"`
+	MaxMS    int64          `json:"maxMs"`
+}
+
+// FailureCount returns the number of runs that failed.
+func (s CommandStats) FailureCount() int {
+	var count int
+	for _, n := range s.Failures {
+		count += n
+	}
+	return count
+}
+
+// Summarize returns the statistics of each command of the events since the time, from the most run command to the least.
+func Summarize(events []Event, since time.Time) []CommandStats {
+	durations := make(map[string][]int64)
+	failures := make(map[string]map[string]int)
+	for _, event := range events {
+		if event.Time.Before(since) {
+			continue
+		}
+		durations[event.Command] = append(durations[event.Command], event.DurationMS)
+		if event.Result != ResultFailure {
+			continue
+		}
+		if failures[event.Command] == nil {
+			failures[event.Command] = make(map[string]int)
+		}
+		failures[event.Command][event.Failure]++
+	}
+	stats := make([]CommandStats, 0, len(durations))
+	for command, ds := range durations {
+		sort.Slice(ds, func(i, j int) bool { return ds[i] < ds[j] })
+		stats = append(stats, CommandStats{
+			Command:  command,
+			Runs:     len(ds),
+			Failures: failures[command],
+			MedianMS: percentile(ds, 50),
+			P90MS:    percentile(ds, 90),
+			MaxMS:    ds[len(ds)-1],
+		})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Runs != stats[j].Runs {
+			return stats[i].Runs > stats[j].Runs
+		}
+		return stats[i].Command < stats[j].Command
+	})
+	return stats
+}
+
+// percentile returns the nearest-rank percentile of the sorted values.
+func percentile(sorted []int64, p int) int64 {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
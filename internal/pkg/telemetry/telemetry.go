@@ -0,0 +1,240 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package telemetry records how long the commands of the user take and how they fail, once the user opts in.
+// Events are written to a file in the user's config directory, and sent to the endpoint that the user configures if any.
+// They never hold the names of resources or the values of flags, only the command, the names of the flags that were set,
+// the duration, and the code of the error.
+package telemetry
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	dirName          = "telemetry"
+	settingsFileName = "settings.yml"
+	eventsFileName   = "events.jsonl"
+
+	sendTimeout = 2 * time.Second
+)
+
+// maxFileSize is the size of the file of events above which the oldest events are dropped. Overridden in tests.
+var maxFileSize int64 = 4 << 20
+
+// Results of a command.
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+// Settings is the choice of the user about telemetry.
+type Settings struct {
+	Enabled  bool   `yaml:"enabled"`
+	Endpoint string `yaml:"endpoint,omitempty"` // URL that events are POSTed to, in addition to the local file.
+	ID       string `yaml:"id,omitempty"`       // Random identifier of the installation, to tell users apart without knowing who they are.
+}
+
+// Event is a run of a command.
+type Event struct {
+	Time       time.Time `json:"time"`
+	ID         string    `json:"id,omitempty"`
+	Command    string    `json:"command"`         // Such as "svc deploy".
+	Flags      []string  `json:"flags,omitempty"` // Names of the flags that were set.
+	DurationMS int64     `json:"durationMs"`
+	Result     string    `json:"result"`
+	Failure    string    `json:"failure,omitempty"` // Code of the error if the command failed.
+	Version    string    `json:"version"`
+	OS         string    `json:"os"`
+}
+
+type httpClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Recorder records events in a directory. A nil Recorder records nothing.
+type Recorder struct {
+	fs     afero.Fs
+	dir    string
+	client httpClient
+}
+
+// New returns the recorder in the user's config directory, such as $XDG_CONFIG_HOME/copilot/telemetry on Linux.
+// Returns nil if the user has no config directory.
+func New() *Recorder {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return nil
+	}
+	return &Recorder{
+		fs:     afero.NewOsFs(),
+		dir:    filepath.Join(dir, "copilot", dirName),
+		client: &http.Client{Timeout: sendTimeout},
+	}
+}
+
+// Settings returns the settings of the user. Telemetry is disabled if the user never enabled it.
+func (r *Recorder) Settings() (Settings, error) {
+	var settings Settings
+	if r == nil {
+		return settings, nil
+	}
+	data, err := afero.ReadFile(r.fs, filepath.Join(r.dir, settingsFileName))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return settings, nil
+		}
+		return settings, fmt.Errorf("read telemetry settings: %w", err)
+	}
+	if err := yaml.Unmarshal(data, &settings); err != nil {
+		return settings, fmt.Errorf("unmarshal telemetry settings: %w", err)
+	}
+	return settings, nil
+}
+
+// SetSettings saves the settings of the user, and generates the identifier of the installation if there is none.
+func (r *Recorder) SetSettings(settings Settings) error {
+	if r == nil {
+		return errors.New("no config directory to save the telemetry settings in")
+	}
+	if settings.ID == "" {
+		id := make([]byte, 8)
+		if _, err := rand.Read(id); err != nil {
+			return fmt.Errorf("generate installation ID: %w", err)
+		}
+		settings.ID = hex.EncodeToString(id)
+	}
+	data, err := yaml.Marshal(settings)
+	if err != nil {
+		return err
+	}
+	if err := r.fs.MkdirAll(r.dir, 0700); err != nil {
+		return err
+	}
+	return afero.WriteFile(r.fs, filepath.Join(r.dir, settingsFileName), data, 0600)
+}
+
+// Record appends the event to the local file and sends it to the endpoint of the settings, if telemetry is enabled.
+func (r *Recorder) Record(event Event) error {
+	settings, err := r.Settings()
+	if err != nil || !settings.Enabled {
+		return err
+	}
+	event.ID = settings.ID
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if err := r.append(line); err != nil {
+		return fmt.Errorf("record telemetry event: %w", err)
+	}
+	if settings.Endpoint == "" {
+		return nil
+	}
+	return r.send(settings.Endpoint, line)
+}
+
+// Events returns the recorded events, from the oldest to the most recent.
+func (r *Recorder) Events() ([]Event, error) {
+	if r == nil {
+		return nil, nil
+	}
+	data, err := afero.ReadFile(r.fs, filepath.Join(r.dir, eventsFileName))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read telemetry events: %w", err)
+	}
+	var events []Event
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var event Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			continue // Skip the lines that were cut short, such as when the disk was full.
+		}
+		events = append(events, event)
+	}
+	return events, scanner.Err()
+}
+
+// Clear removes the recorded events.
+func (r *Recorder) Clear() error {
+	if r == nil {
+		return nil
+	}
+	if err := r.fs.Remove(filepath.Join(r.dir, eventsFileName)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (r *Recorder) append(line []byte) error {
+	if err := r.fs.MkdirAll(r.dir, 0700); err != nil {
+		return err
+	}
+	path := filepath.Join(r.dir, eventsFileName)
+	f, err := r.fs.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(append(line, '\n'))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return r.truncate(path)
+}
+
+// truncate drops the oldest half of the events once the file is larger than maxFileSize.
+func (r *Recorder) truncate(path string) error {
+	info, err := r.fs.Stat(path)
+	if err != nil || info.Size() <= maxFileSize {
+		return err
+	}
+	data, err := afero.ReadFile(r.fs, path)
+	if err != nil {
+		return err
+	}
+	data = data[len(data)/2:]
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		data = data[i+1:]
+	}
+	return afero.WriteFile(r.fs, path, data, 0600)
+}
+
+func (r *Recorder) send(endpoint string, event []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(event))
+	if err != nil {
+		return fmt.Errorf("create request to telemetry endpoint: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send telemetry event to %s: %w", endpoint, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("send telemetry event to %s: status %s", endpoint, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package telemetry
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClient struct {
+	requests []string
+	err      error
+}
+
+func (c *fakeClient) Do(req *http.Request) (*http.Response, error) {
+	body, _ := io.ReadAll(req.Body)
+	c.requests = append(c.requests, req.URL.String()+" "+string(body))
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &http.Response{StatusCode: http.StatusAccepted, Status: "202 Accepted", Body: io.NopCloser(strings.NewReader(""))}, nil
+}
+
+func TestRecorder_Record(t *testing.T) {
+	event := Event{
+		Time:       time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+		Command:    "svc deploy",
+		Flags:      []string{"env", "name"},
+		DurationMS: 120000,
+		Result:     ResultSuccess,
+		Version:    "v1.0.0",
+		OS:         "linux",
+	}
+	testCases := map[string]struct {
+		settings  *Settings
+		clientErr error
+
+		wantedEvents   []Event
+		wantedRequests []string
+		wantedErr      string
+	}{
+		"disabled by default": {},
+		"disabled": {
+			settings: &Settings{Enabled: false, ID: "abc"},
+		},
+		"enabled": {
+			settings: &Settings{Enabled: true, ID: "abc"},
+			wantedEvents: []Event{func() Event {
+				e := event
+				e.ID = "abc"
+				return e
+			}()},
+		},
+		"enabled with an endpoint": {
+			settings: &Settings{Enabled: true, ID: "abc", Endpoint: "https://metrics.example.com/copilot"},
+			wantedEvents: []Event{func() Event {
+				e := event
+				e.ID = "abc"
+				return e
+			}()},
+			wantedRequests: []string{`https://metrics.example.com/copilot {"time":"2026-10-01T12:00:00Z","id":"abc","command":"svc deploy","flags":["env","name"],"durationMs":120000,"result":"success","version":"v1.0.0","os":"linux"}`},
+		},
+		"endpoint unavailable": {
+			settings:  &Settings{Enabled: true, ID: "abc", Endpoint: "https://metrics.example.com/copilot"},
+			clientErr: errors.New("connection refused"),
+			wantedEvents: []Event{func() Event {
+				e := event
+				e.ID = "abc"
+				return e
+			}()},
+			wantedRequests: []string{`https://metrics.example.com/copilot {"time":"2026-10-01T12:00:00Z","id":"abc","command":"svc deploy","flags":["env","name"],"durationMs":120000,"result":"success","version":"v1.0.0","os":"linux"}`},
+			wantedErr:      "send telemetry event to https://metrics.example.com/copilot: connection refused",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := &fakeClient{err: tc.clientErr}
+			r := &Recorder{fs: afero.NewMemMapFs(), dir: "/config/copilot/telemetry", client: client}
+			if tc.settings != nil {
+				require.NoError(t, r.SetSettings(*tc.settings))
+			}
+
+			err := r.Record(event)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			events, err := r.Events()
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEvents, events)
+			require.Equal(t, tc.wantedRequests, client.requests)
+		})
+	}
+}
+
+func TestRecorder_SetSettings(t *testing.T) {
+	r := &Recorder{fs: afero.NewMemMapFs(), dir: "/config/copilot/telemetry"}
+
+	require.NoError(t, r.SetSettings(Settings{Enabled: true}))
+
+	settings, err := r.Settings()
+	require.NoError(t, err)
+	require.True(t, settings.Enabled)
+	require.Len(t, settings.ID, 16)
+}
+
+func TestRecorder_append(t *testing.T) {
+	defer func(orig int64) { maxFileSize = orig }(maxFileSize)
+	maxFileSize = 1000
+	r := &Recorder{fs: afero.NewMemMapFs(), dir: "/config/copilot/telemetry"}
+	require.NoError(t, r.SetSettings(Settings{Enabled: true, ID: "abc"}))
+	for i := 0; i < 100; i++ {
+		require.NoError(t, r.Record(Event{Command: "svc ls", DurationMS: int64(i)}))
+	}
+
+	events, err := r.Events()
+
+	require.NoError(t, err)
+	require.NotEmpty(t, events)
+	require.Less(t, len(events), 100)
+	require.Equal(t, int64(99), events[len(events)-1].DurationMS)
+	for i := 1; i < len(events); i++ {
+		require.Equal(t, events[i-1].DurationMS+1, events[i].DurationMS)
+	}
+}
+
+func TestRecorder_Clear(t *testing.T) {
+	r := &Recorder{fs: afero.NewMemMapFs(), dir: "/config/copilot/telemetry"}
+	require.NoError(t, r.Clear())
+	require.NoError(t, r.SetSettings(Settings{Enabled: true}))
+	require.NoError(t, r.Record(Event{Command: "svc ls"}))
+
+	require.NoError(t, r.Clear())
+
+	events, err := r.Events()
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
+
+func TestSummarize(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	var events []Event
+	for i := 1; i <= 10; i++ {
+		events = append(events, Event{Time: now, Command: "svc deploy", DurationMS: int64(i * 1000), Result: ResultSuccess})
+	}
+	events = append(events,
+		Event{Time: now, Command: "svc deploy", DurationMS: 60000, Result: ResultFailure, Failure: "circuit-breaker-triggered"},
+		Event{Time: now, Command: "svc ls", DurationMS: 500, Result: ResultFailure, Failure: "unknown"},
+		Event{Time: now.Add(-48 * time.Hour), Command: "env deploy", DurationMS: 500, Result: ResultSuccess},
+	)
+
+	stats := Summarize(events, now.Add(-24*time.Hour))
+
+	require.Equal(t, []CommandStats{
+		{
+			Command:  "svc deploy",
+			Runs:     11,
+			Failures: map[string]int{"circuit-breaker-triggered": 1},
+			MedianMS: 6000,
+			P90MS:    10000,
+			MaxMS:    60000,
+		},
+		{
+			Command:  "svc ls",
+			Runs:     1,
+			Failures: map[string]int{"unknown": 1},
+			MedianMS: 500,
+			P90MS:    500,
+			MaxMS:    500,
+		},
+	}, stats)
+	require.Equal(t, 1, stats[0].FailureCount())
+}
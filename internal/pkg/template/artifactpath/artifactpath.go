@@ -20,6 +20,8 @@ const (
 	s3ScriptsDirName            = "scripts"
 	s3CustomResourcesDirName    = "custom-resources"
 	s3EnvironmentsAddonsDirName = "environments"
+	s3DeploymentsDirName        = "deployments"
+	s3SBOMsDirName              = "sbom"
 )
 
 // MkdirSHA256 prefixes the key with the SHA256 hash of the contents of "manual/<hash>/key".
@@ -69,3 +71,33 @@ func EnvFiles(key string, content []byte) string {
 func CustomResource(key string, zipFile []byte) string {
 	return path.Join(s3ArtifactDirName, s3ScriptsDirName, s3CustomResourcesDirName, key, fmt.Sprintf("%x.zip", sha256.Sum256(zipFile)))
 }
+
+// Deployments returns the path under which the deployment revisions of a stack are stored.
+// Example: manual/deployments/key/.
+func Deployments(key string) string {
+	return path.Join(s3ArtifactDirName, s3DeploymentsDirName, key) + "/"
+}
+
+// Deployment returns the path to store a deployment revision of a stack.
+// Example: manual/deployments/key/20230102-150405.json.
+func Deployment(key, id string) string {
+	return path.Join(s3ArtifactDirName, s3DeploymentsDirName, key, fmt.Sprintf("%s.json", id))
+}
+
+// DeploymentLog returns the path to store the log of a deployment of a stack, whether it succeeded or failed.
+// Example: manual/deployments/key/20230102-150405.log.
+func DeploymentLog(key, id string) string {
+	return path.Join(s3ArtifactDirName, s3DeploymentsDirName, key, fmt.Sprintf("%s.log", id))
+}
+
+// SBOMs returns the path under which the software bills of materials of the images of a stack are stored.
+// Example: manual/sbom/key/.
+func SBOMs(key string) string {
+	return path.Join(s3ArtifactDirName, s3SBOMsDirName, key) + "/"
+}
+
+// SBOM returns the path to store the software bill of materials of the image of a container.
+// Example: manual/sbom/key/container.json.
+func SBOM(key, container string) string {
+	return path.Join(s3ArtifactDirName, s3SBOMsDirName, key, fmt.Sprintf("%s.json", container))
+}
@@ -20,3 +20,23 @@ func TestEnvironmentAddons(t *testing.T) {
 func TestEnvironmentAddonsAsset(t *testing.T) {
 	require.Equal(t, "manual/addons/environments/assets/hash", EnvironmentAddonAsset("hash"))
 }
+
+func TestDeployments(t *testing.T) {
+	require.Equal(t, "manual/deployments/phonetool-test-frontend/", Deployments("phonetool-test-frontend"))
+}
+
+func TestDeployment(t *testing.T) {
+	require.Equal(t, "manual/deployments/phonetool-test-frontend/20230102-150405.json", Deployment("phonetool-test-frontend", "20230102-150405"))
+}
+
+func TestDeploymentLog(t *testing.T) {
+	require.Equal(t, "manual/deployments/phonetool-test-frontend/20230102-150405.log", DeploymentLog("phonetool-test-frontend", "20230102-150405"))
+}
+
+func TestSBOMs(t *testing.T) {
+	require.Equal(t, "manual/sbom/phonetool-test-frontend/", SBOMs("phonetool-test-frontend"))
+}
+
+func TestSBOM(t *testing.T) {
+	require.Equal(t, "manual/sbom/phonetool-test-frontend/nginx.json", SBOM("phonetool-test-frontend", "nginx"))
+}
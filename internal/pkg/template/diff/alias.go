@@ -0,0 +1,74 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// AliasMode determines how YAML aliases, such as "*anchor", are compared.
+type AliasMode int
+
+const (
+	// AliasModeAnchors compares the anchored values where the anchors are defined, and the aliases by their anchor names.
+	// An alias that is replaced by a value, or vice versa, is compared by the value of its anchor.
+	AliasModeAnchors AliasMode = iota
+	// AliasModeExpand replaces the aliases with the values of their anchors before comparison, so that a change to
+	// an anchored value is shown wherever it is referenced.
+	AliasModeExpand
+)
+
+// ParseWithAliasMode is similar to ParseWithCFNOverriders, except that the YAML aliases are compared according to mode.
+// ParseWithCFNOverriders is the same as ParseWithAliasMode with AliasModeAnchors.
+func (from From) ParseWithAliasMode(to []byte, mode AliasMode) (Tree, error) {
+	toNode, fromNode, err := from.unmarshal(to)
+	if err != nil {
+		return Tree{}, err
+	}
+	if mode == AliasModeExpand {
+		expandAliases(fromNode)
+		expandAliases(toNode)
+	}
+	return parseDocuments(fromNode, toNode, cfnOverriders(cfnIdentityKeys...)...)
+}
+
+// resolveAlias replaces the alias with the value of its anchor if exactly one of from and to is an alias.
+// Two aliases are left as is, so that they are compared by their anchor names.
+func resolveAlias(from, to *yaml.Node) (*yaml.Node, *yaml.Node) {
+	if from == nil || to == nil {
+		return from, to
+	}
+	switch {
+	case from.Kind == yaml.AliasNode && to.Kind != yaml.AliasNode && from.Alias != nil:
+		return from.Alias, to
+	case to.Kind == yaml.AliasNode && from.Kind != yaml.AliasNode && to.Alias != nil:
+		return from, to.Alias
+	}
+	return from, to
+}
+
+// expandAliases replaces each alias under the node with a copy of its anchored value, and removes all the anchors.
+func expandAliases(node *yaml.Node) {
+	node.Anchor = ""
+	for i, child := range node.Content {
+		if child.Kind == yaml.AliasNode && child.Alias != nil {
+			node.Content[i] = copyNode(child.Alias)
+		}
+		expandAliases(node.Content[i])
+	}
+}
+
+// copyNode returns a deep copy of the node in which the aliases are replaced with copies of their anchored values.
+func copyNode(node *yaml.Node) *yaml.Node {
+	if node.Kind == yaml.AliasNode && node.Alias != nil {
+		return copyNode(node.Alias)
+	}
+	copied := *node
+	copied.Anchor = ""
+	copied.Content = nil
+	for _, child := range node.Content {
+		copied.Content = append(copied.Content, copyNode(child))
+	}
+	return &copied
+}
@@ -0,0 +1,160 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Integration_ParseWithAliasMode_Write(t *testing.T) {
+	testCases := map[string]struct {
+		old  string
+		curr string
+
+		wantedAnchors string
+		wantedExpand  string
+	}{
+		"change the anchored value": {
+			old: `
+Defaults: &defaults
+  Timeout: 10
+Service:
+  Config: *defaults`,
+			curr: `
+Defaults: &defaults
+  Timeout: 20
+Service:
+  Config: *defaults`,
+			wantedAnchors: `
+~ Defaults:
+    ~ Timeout: 10 -> 20
+`,
+			wantedExpand: `
+~ Defaults:
+    ~ Timeout: 10 -> 20
+~ Service/Config:
+    ~ Timeout: 10 -> 20
+`,
+		},
+		"alias-only edit to an anchor with the same value": {
+			old: `
+Primary: &primary
+  Timeout: 10
+Secondary: &secondary
+  Timeout: 10
+Service:
+  Config: *primary`,
+			curr: `
+Primary: &primary
+  Timeout: 10
+Secondary: &secondary
+  Timeout: 10
+Service:
+  Config: *secondary`,
+			wantedAnchors: `
+~ Service:
+    ~ Config: *primary -> *secondary
+`,
+		},
+		"alias-only edit to an anchor with a different value": {
+			old: `
+Primary: &primary
+  Timeout: 10
+Secondary: &secondary
+  Timeout: 20
+Service:
+  Config: *primary`,
+			curr: `
+Primary: &primary
+  Timeout: 10
+Secondary: &secondary
+  Timeout: 20
+Service:
+  Config: *secondary`,
+			wantedAnchors: `
+~ Service:
+    ~ Config: *primary -> *secondary
+`,
+			wantedExpand: `
+~ Service/Config:
+    ~ Timeout: 10 -> 20
+`,
+		},
+		"replace an alias with the same value": {
+			old: `
+Defaults: &defaults
+  Timeout: 10
+Service:
+  Config: *defaults`,
+			curr: `
+Defaults: &defaults
+  Timeout: 10
+Service:
+  Config:
+    Timeout: 10`,
+		},
+		"replace an alias with a different value": {
+			old: `
+Defaults: &defaults
+  Timeout: 10
+Service:
+  Config: *defaults`,
+			curr: `
+Defaults: &defaults
+  Timeout: 10
+Service:
+  Config:
+    Timeout: 30`,
+			wantedAnchors: `
+~ Service/Config:
+    ~ Timeout: 10 -> 30
+`,
+			wantedExpand: `
+~ Service/Config:
+    ~ Timeout: 10 -> 30
+`,
+		},
+		"change the anchored value of a merge key": {
+			old: `
+Defaults: &defaults
+  Timeout: 10
+Service:
+  <<: *defaults
+  Port: 80`,
+			curr: `
+Defaults: &defaults
+  Timeout: 20
+Service:
+  <<: *defaults
+  Port: 80`,
+			wantedAnchors: `
+~ Defaults:
+    ~ Timeout: 10 -> 20
+~ Service:
+    ~ Timeout: 10 -> 20
+`,
+			wantedExpand: `
+~ Defaults:
+    ~ Timeout: 10 -> 20
+~ Service:
+    ~ Timeout: 10 -> 20
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			for mode, wanted := range map[AliasMode]string{AliasModeAnchors: tc.wantedAnchors, AliasModeExpand: tc.wantedExpand} {
+				gotTree, err := From(tc.old).ParseWithAliasMode([]byte(tc.curr), mode)
+				require.NoError(t, err)
+
+				buf := strings.Builder{}
+				require.NoError(t, gotTree.Write(&buf))
+				require.Equal(t, strings.TrimPrefix(wanted, "\n"), buf.String(), "alias mode %d", mode)
+			}
+		})
+	}
+}
@@ -2,9 +2,11 @@
 // SPDX-License-Identifier: Apache-2.0
 
 // Package diff provides functionalities to compare two YAML documents.
+// Since JSON is a subset of YAML, JSON documents can be compared as well.
 package diff
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"sort"
@@ -14,11 +16,20 @@ import (
 
 // Tree represents a difference tree between two YAML documents.
 type Tree struct {
-	root diffNode
+	root          diffNode
+	resourceTypes map[string]string // The types of the CFN resources in the documents keyed by their logical IDs.
 }
 
-func (t Tree) Write(w io.Writer) error {
-	tw := &treeWriter{t, w}
+// Write writes the string representation of the diff tree to w.
+func (t Tree) Write(w io.Writer, opts ...WriteOption) error {
+	tw := newTreeWriter(w, opts...)
+	tw.tree = t
+	if tw.replacements == nil {
+		tw.replacements = make(map[string]bool)
+		for _, id := range t.Replacements() {
+			tw.replacements[id] = true
+		}
+	}
 	return tw.write()
 }
 
@@ -55,8 +66,10 @@ func (n *keyNode) children() []diffNode {
 	return n.childNodes
 }
 
+// unchangedNode represents consecutive items in a sequence that are the same in both documents.
 type unchangedNode struct {
 	count int
+	items []yaml.Node // The unchanged items, used to display the context around the changes.
 }
 
 func (n *unchangedNode) children() []diffNode {
@@ -79,19 +92,63 @@ func (n *unchangedNode) unchangedCount() int {
 	return n.count
 }
 
+func (n *unchangedNode) unchangedItems() []yaml.Node {
+	return n.items
+}
+
 type seqItemNode struct {
 	keyNode
 }
 
+// cfnIdentityKeys are the default keys that identify an item in a list of maps in a CFN document.
+// For example, "Name" identifies a container definition or an environment variable, and "Key" identifies a tag.
+var cfnIdentityKeys = []string{"Name", "Key"}
+
 // From is the YAML document that another YAML document is compared against.
 type From []byte
 
+// ParseOption configures how a diff tree is parsed.
+type ParseOption func(opts *parseOpts)
+
+type parseOpts struct {
+	identityKeys []string
+	overriders   []overrider
+}
+
+// WithIdentityKeys pairs the items in a list of maps by the value under any of the keys, instead of by their position,
+// so that an item that is moved or modified isn't shown as removed and added.
+// ParseWithCFNOverriders pairs the items by "Name" and "Key" by default; pass no keys to pair them by position only.
+func WithIdentityKeys(keys ...string) ParseOption {
+	return func(opts *parseOpts) {
+		opts.identityKeys = keys
+	}
+}
+
+// withOverriders adds overriders that change how the nodes of the documents are compared.
+func withOverriders(overriders ...overrider) ParseOption {
+	return func(opts *parseOpts) {
+		opts.overriders = append(opts.overriders, overriders...)
+	}
+}
+
 // ParseWithCFNOverriders constructs a diff tree that represent the differences of a YAML document against the From document with 
 // overriders designed for CFN documents, including:
 // 1. An ignorer that ignores diffs under "Metadata.Manifest".
 // 2. An overrider that is able to compare intrinsic functions with full/short form correctly.
-func (from From) ParseWithCFNOverriders(to []byte) (Tree, error) {
-	return from.Parse(to,
+// 3. An identifier that pairs the items in a list of maps by their identity keys, "Name" and "Key" unless WithIdentityKeys is set.
+func (from From) ParseWithCFNOverriders(to []byte, opts ...ParseOption) (Tree, error) {
+	parsed := parseOpts{
+		identityKeys: cfnIdentityKeys,
+	}
+	for _, opt := range opts {
+		opt(&parsed)
+	}
+	return from.parse(to, append(cfnOverriders(parsed.identityKeys...), parsed.overriders...)...)
+}
+
+// cfnOverriders returns the overriders for CFN documents, where the items in a list of maps are paired by the identity keys.
+func cfnOverriders(identityKeys ...string) []overrider {
+	overriders := []overrider{
 		&ignorer{
 			curr: &ignoreSegment{
 				key: "Metadata",
@@ -101,18 +158,81 @@ func (from From) ParseWithCFNOverriders(to []byte) (Tree, error) {
 			},
 		},
 		&getAttConverter{},
-		&intrinsicFuncMapTagConverter{})
+		&intrinsicFuncMapTagConverter{},
+	}
+	if len(identityKeys) > 0 {
+		overriders = append(overriders, &identityKeyMatcher{keys: identityKeys})
+	}
+	return overriders
+}
+
+// ParseWithFilters is similar to ParseWithCFNOverriders, except that the values under any of the ignored paths are
+// excluded from the comparison.
+// An ignored path is a JSON pointer (https://datatracker.ietf.org/doc/html/rfc6901), for example "/Metadata/Version".
+// A "*" segment matches any key in a map or any item in a list, for example "/Resources/*/Properties/Description".
+func (from From) ParseWithFilters(to []byte, ignorePaths ...string) (Tree, error) {
+	var filters []pathFilter
+	for _, path := range ignorePaths {
+		filter, err := newPathFilter(path)
+		if err != nil {
+			return Tree{}, err
+		}
+		filters = append(filters, filter)
+	}
+	toNode, fromNode, err := from.unmarshal(to)
+	if err != nil {
+		return Tree{}, err
+	}
+	for _, filter := range filters {
+		filter.remove(fromNode)
+		filter.remove(toNode)
+	}
+	return parseDocuments(fromNode, toNode, cfnOverriders(cfnIdentityKeys...)...)
 }
 
 // Parse constructs a diff tree that represent the differences of a YAML document against the From document.
-func (from From) Parse(to []byte, overriders ...overrider) (Tree, error) {
-	var toNode, fromNode yaml.Node
-	if err := yaml.Unmarshal(to, &toNode); err != nil {
-		return Tree{}, fmt.Errorf("unmarshal current template: %w", err)
+// Either document can be written in JSON.
+func (from From) Parse(to []byte, opts ...ParseOption) (Tree, error) {
+	var parsed parseOpts
+	for _, opt := range opts {
+		opt(&parsed)
 	}
-	if err := yaml.Unmarshal(from, &fromNode); err != nil {
-		return Tree{}, fmt.Errorf("unmarshal old template: %w", err)
+	overriders := parsed.overriders
+	if len(parsed.identityKeys) > 0 {
+		overriders = append(overriders, &identityKeyMatcher{keys: parsed.identityKeys})
 	}
+	return from.parse(to, overriders...)
+}
+
+func (from From) parse(to []byte, overriders ...overrider) (Tree, error) {
+	toNode, fromNode, err := from.unmarshal(to)
+	if err != nil {
+		return Tree{}, err
+	}
+	return parseDocuments(fromNode, toNode, overriders...)
+}
+
+// unmarshal decodes both documents concurrently, since decoding takes most of the time to diff large templates.
+func (from From) unmarshal(to []byte) (toNode, fromNode *yaml.Node, err error) {
+	toNode, fromNode = &yaml.Node{}, &yaml.Node{}
+	var fromErr error
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fromErr = unmarshal(from, fromNode)
+	}()
+	toErr := unmarshal(to, toNode)
+	<-done
+	if toErr != nil {
+		return nil, nil, fmt.Errorf("unmarshal current template: %w", toErr)
+	}
+	if fromErr != nil {
+		return nil, nil, fmt.Errorf("unmarshal old template: %w", fromErr)
+	}
+	return toNode, fromNode, nil
+}
+
+func parseDocuments(fromNode, toNode *yaml.Node, overriders ...overrider) (Tree, error) {
 	var root diffNode
 	var err error
 	switch {
@@ -120,11 +240,11 @@ func (from From) Parse(to []byte, overriders ...overrider) (Tree, error) {
 	case fromNode.Kind == 0 && toNode.Kind == 0:
 		return Tree{}, nil
 	case fromNode.Kind == 0:
-		root, err = parse(nil, &toNode, "", overriders...)
+		root, err = parse(nil, toNode, "", overriders...)
 	case toNode.Kind == 0:
-		root, err = parse(&fromNode, nil, "", overriders...)
+		root, err = parse(fromNode, nil, "", overriders...)
 	default:
-		root, err = parse(&fromNode, &toNode, "", overriders...)
+		root, err = parse(fromNode, toNode, "", overriders...)
 	}
 	if err != nil {
 		return Tree{}, err
@@ -133,16 +253,38 @@ func (from From) Parse(to []byte, overriders ...overrider) (Tree, error) {
 		return Tree{}, nil
 	}
 	return Tree{
-		root: root,
+		root:          root,
+		resourceTypes: cfnResourceTypes(toNode, fromNode),
 	}, nil
 }
 
+// unmarshal decodes a YAML or JSON document into a node.
+// The styles of a JSON document are reset, so that its diff is rendered in block style just like that of a YAML document.
+func unmarshal(doc []byte, node *yaml.Node) error {
+	if err := yaml.Unmarshal(doc, node); err != nil {
+		return err
+	}
+	if json.Valid(doc) {
+		resetStyle(node)
+	}
+	return nil
+}
+
+// resetStyle clears the styles of a node and all of its descendants.
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
+
 func parse(from, to *yaml.Node, key string, overriders ...overrider) (diffNode, error) {
 	for _, overrider := range overriders {
 		if overrider.match(from, to, key, overrider) {
 			return overrider.parse(from, to, key, overrider)
 		}
 	}
+	from, to = resolveAlias(from, to)
 	// Handle base cases.
 	if to == nil || from == nil || to.Kind != from.Kind {
 		return &keyNode{
@@ -202,53 +344,72 @@ func parseSequence(fromNode, toNode *yaml.Node, overriders ...overrider) ([]diff
 		node diffNode
 		err  error
 	}
-	cachedDiff := make(map[string]cachedEntry)
+	cachedDiff := make(map[lcsIndex]cachedEntry)
+	identifiers := seqItemIdentifiers(overriders)
 	lcsIndices := longestCommonSubsequence(fromSeq, toSeq, func(idxFrom, idxTo int) bool {
 		// Note: This function passed as `eq` should be a pure function. Therefore, its output is the same
 		// given the same `idxFrom` and `idxTo`. Hence, it is not necessary to parse the nodes again.
 		// In `lcs.go`, `eq` can be called twice on the same indices: once when computing LCS length, and
 		// once when back-tracing to construct the LCS.
-		if diff, ok := cachedDiff[cacheKey(idxFrom, idxTo)]; ok {
-			return diff.err == nil && diff.node == nil
+		if diff, ok := cachedDiff[lcsIndex{idxFrom, idxTo}]; ok {
+			return (diff.err == nil && diff.node == nil) || sameSeqItem(&(fromSeq[idxFrom]), &(toSeq[idxTo]), identifiers)
+		}
+		// Identical items have no diff, so there is no need to build their diff tree to find it out.
+		if equalNodes(&(fromSeq[idxFrom]), &(toSeq[idxTo])) {
+			return true
 		}
 		diff, err := parse(&(fromSeq[idxFrom]), &(toSeq[idxTo]), "", overriders...)
 		if diff != nil { // NOTE: cache the diff only if a modification could have happened at this position.
-			cachedDiff[cacheKey(idxFrom, idxTo)] = cachedEntry{
+			cachedDiff[lcsIndex{idxFrom, idxTo}] = cachedEntry{
 				node: diff,
 				err:  err,
 			}
 		}
-		return err == nil && diff == nil
+		return (err == nil && diff == nil) || sameSeqItem(&(fromSeq[idxFrom]), &(toSeq[idxTo]), identifiers)
 	})
-	// No difference if the two sequences have the same size and the LCS is the entire sequence.
-	if len(fromSeq) == len(toSeq) && len(lcsIndices) == len(fromSeq) {
+	// No difference if the two sequences have the same size and the LCS is the entire sequence without any modified item.
+	if len(fromSeq) == len(toSeq) && len(lcsIndices) == len(fromSeq) && !hasModifiedMatch(lcsIndices, cachedDiff) {
 		return nil, nil
 	}
 	var children []diffNode
-	var matchCount int
+	var matched []yaml.Node
+	flushUnchanged := func() {
+		if len(matched) == 0 {
+			return
+		}
+		children = append(children, &unchangedNode{count: len(matched), items: matched})
+		matched = nil
+	}
 	inspector := newLCSStateMachine(fromSeq, toSeq, lcsIndices)
 	for action := inspector.action(); action != actionDone; action = inspector.action() {
 		switch action {
 		case actionMatch:
-			matchCount++
-			if action := inspector.peek(); action != actionMatch {
-				children = append(children, &unchangedNode{count: matchCount})
-				matchCount = 0
+			diff, ok := cachedDiff[lcsIndex{inspector.fromIndex(), inspector.toIndex()}]
+			if !ok {
+				matched = append(matched, inspector.toItem())
+				break
 			}
+			// The items are identified as the same item by an identity key, but their content is modified.
+			flushUnchanged()
+			if diff.err != nil {
+				return nil, diff.err
+			}
+			children = append(children, newSeqItemModNode(diff.node))
 		case actionMod:
-			diff := cachedDiff[cacheKey(inspector.fromIndex(), inspector.toIndex())]
+			flushUnchanged()
+			fromItem, toItem := inspector.fromItem(), inspector.toItem()
+			if distinctSeqItems(&fromItem, &toItem, identifiers) {
+				// The items at these positions are known to be different items, hence it's a deletion followed by an insertion.
+				children = append(children, &seqItemNode{keyNode{oldV: &fromItem}}, &seqItemNode{keyNode{newV: &toItem}})
+				break
+			}
+			diff := cachedDiff[lcsIndex{inspector.fromIndex(), inspector.toIndex()}]
 			if diff.err != nil {
 				return nil, diff.err
 			}
-			children = append(children, &seqItemNode{
-				keyNode{
-					keyValue:   diff.node.key(),
-					childNodes: diff.node.children(),
-					oldV:       diff.node.oldYAML(),
-					newV:       diff.node.newYAML(),
-				},
-			})
+			children = append(children, newSeqItemModNode(diff.node))
 		case actionDel:
+			flushUnchanged()
 			item := inspector.fromItem()
 			children = append(children, &seqItemNode{
 				keyNode{
@@ -256,6 +417,7 @@ func parseSequence(fromNode, toNode *yaml.Node, overriders ...overrider) ([]diff
 				},
 			})
 		case actionInsert:
+			flushUnchanged()
 			item := inspector.toItem()
 			children = append(children, &seqItemNode{
 				keyNode{
@@ -265,15 +427,37 @@ func parseSequence(fromNode, toNode *yaml.Node, overriders ...overrider) ([]diff
 		}
 		inspector.next()
 	}
+	flushUnchanged()
 	return children, nil
 }
 
+func newSeqItemModNode(diff diffNode) *seqItemNode {
+	return &seqItemNode{
+		keyNode{
+			keyValue:   diff.key(),
+			childNodes: diff.children(),
+			oldV:       diff.oldYAML(),
+			newV:       diff.newYAML(),
+		},
+	}
+}
+
+func hasModifiedMatch[T any](lcsIndices []lcsIndex, cachedDiff map[lcsIndex]T) bool {
+	for _, idx := range lcsIndices {
+		if _, ok := cachedDiff[idx]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 func parseMap(from, to *yaml.Node, overriders ...overrider) ([]diffNode, error) {
-	currMap, oldMap := make(map[string]yaml.Node), make(map[string]yaml.Node)
-	if err := to.Decode(currMap); err != nil {
+	currMap, err := mapEntries(to)
+	if err != nil {
 		return nil, err
 	}
-	if err := from.Decode(oldMap); err != nil {
+	oldMap, err := mapEntries(from)
+	if err != nil {
 		return nil, err
 	}
 	keys := unionOfKeys(currMap, oldMap)
@@ -314,6 +498,52 @@ func unionOfKeys[T any](a, b map[string]T) []string {
 	return keySlice
 }
 
-func cacheKey(inFrom, inTo int) string {
-	return fmt.Sprintf("%d,%d", inFrom, inTo)
+// mapEntries returns the values of a mapping or document node keyed by their keys, as if it was decoded into a map.
+// The node is only decoded if some keys need to be resolved, such as merge keys and aliases, since decoding is slow.
+func mapEntries(node *yaml.Node) (map[string]yaml.Node, error) {
+	mapping := node
+	if node.Kind == yaml.DocumentNode && len(node.Content) == 1 {
+		mapping = node.Content[0]
+	}
+	if entries, ok := plainMapEntries(mapping); ok {
+		return entries, nil
+	}
+	entries := make(map[string]yaml.Node)
+	if err := node.Decode(entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func plainMapEntries(node *yaml.Node) (map[string]yaml.Node, bool) {
+	if node.Kind != yaml.MappingNode {
+		return nil, false
+	}
+	entries := make(map[string]yaml.Node, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := node.Content[i]
+		if key.Kind != yaml.ScalarNode {
+			return nil, false
+		}
+		switch key.ShortTag() {
+		case "!!str", "!!int", "!!float", "!!bool":
+		default: // Such as "!!merge" and "!!null", which aren't decoded as their values.
+			return nil, false
+		}
+		entries[key.Value] = *node.Content[i+1]
+	}
+	return entries, true
+}
+
+// equalNodes returns true if a and b have the same kind, tag, value and content, regardless of their styles and positions.
+func equalNodes(a, b *yaml.Node) bool {
+	if a.Kind != b.Kind || a.Tag != b.Tag || a.Value != b.Value || a.Alias != b.Alias || len(a.Content) != len(b.Content) {
+		return false
+	}
+	for i := range a.Content {
+		if !equalNodes(a.Content[i], b.Content[i]) {
+			return false
+		}
+	}
+	return true
 }
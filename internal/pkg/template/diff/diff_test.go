@@ -5,6 +5,10 @@ package diff
 
 import (
 	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -217,7 +221,7 @@ func TestFrom_Parse(t *testing.T) {
 						newV: yamlScalarNode("ellipse"),
 					},
 				}
-				unchangedTri, unchangedRec := &unchangedNode{1}, &unchangedNode{1}
+				unchangedTri, unchangedRec := &unchangedNode{count: 1}, &unchangedNode{count: 1}
 				return &keyNode{
 					childNodes: []diffNode{
 						&keyNode{
@@ -339,7 +343,7 @@ Dog: (pleased) "ikr"`, t),
 				require.EqualError(t, err, tc.wantedError.Error())
 			} else {
 				require.NoError(t, err)
-				require.True(t, equalTree(got, Tree{tc.wanted()}, t), "should get the expected tree")
+				require.True(t, equalTree(got, Tree{root: tc.wanted()}, t), "should get the expected tree")
 			}
 		})
 	}
@@ -431,7 +435,7 @@ Metadata:
 				require.EqualError(t, err, tc.wantedError.Error())
 			} else {
 				require.NoError(t, err)
-				require.True(t, equalTree(got, Tree{tc.wanted()}, t), "should get the expected tree")
+				require.True(t, equalTree(got, Tree{root: tc.wanted()}, t), "should get the expected tree")
 			}
 		})
 	}
@@ -502,3 +506,61 @@ func equalLeaves(a, b diffNode, t *testing.T) bool {
 	require.NoError(t, err)
 	return string(aNew) == string(bNew) && string(aOld) == string(bOld)
 }
+
+// BenchmarkParseWrite measures the diff of pairs of templates generated by Copilot, scaled up to about 1MB each.
+func BenchmarkParseWrite(b *testing.B) {
+	testCases := map[string]struct {
+		old  string
+		curr string
+	}{
+		"load balanced web service": {
+			old:  "lbws-old.yml",
+			curr: "lbws-new.yml",
+		},
+		"environment": {
+			old:  "env-old.yml",
+			curr: "env-new.yml",
+		},
+	}
+	for name, tc := range testCases {
+		old, curr := benchmarkTemplate(b, tc.old), benchmarkTemplate(b, tc.curr)
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(len(old) + len(curr)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				tree, err := From(old).ParseWithCFNOverriders(curr)
+				require.NoError(b, err)
+				require.NoError(b, tree.Write(io.Discard))
+			}
+		})
+	}
+}
+
+// benchmarkTemplate reads the template in testdata/benchmark, and copies its resources under new logical IDs until
+// the template is at least 1MB.
+func benchmarkTemplate(b *testing.B, name string) []byte {
+	const size = 1 << 20
+	raw, err := os.ReadFile(filepath.Join("testdata", "benchmark", name))
+	require.NoError(b, err)
+	var doc yaml.Node
+	require.NoError(b, yaml.Unmarshal(raw, &doc))
+	var resources *yaml.Node
+	root := doc.Content[0]
+	for i := 0; i < len(root.Content); i += 2 {
+		if root.Content[i].Value == "Resources" {
+			resources = root.Content[i+1]
+		}
+	}
+	require.NotNil(b, resources)
+	original := resources.Content
+	for copies := size/len(raw) + 1; copies > 0; copies-- {
+		for i := 0; i < len(original); i += 2 {
+			key := *original[i]
+			key.Value = fmt.Sprintf("%sCopy%d", key.Value, copies)
+			resources.Content = append(resources.Content, &key, original[i+1])
+		}
+	}
+	out, err := yaml.Marshal(&doc)
+	require.NoError(b, err)
+	return out
+}
@@ -0,0 +1,84 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const wildcardSegment = "*"
+
+// pathFilter removes the values under a path from a YAML document.
+type pathFilter struct {
+	segments []string
+}
+
+func newPathFilter(path string) (pathFilter, error) {
+	if !strings.HasPrefix(path, "/") || path == "/" {
+		return pathFilter{}, fmt.Errorf(`invalid ignored path %q: must be a JSON pointer that starts with "/"`, path)
+	}
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = unescapeJSONPointer(segment)
+	}
+	return pathFilter{segments: segments}, nil
+}
+
+// remove deletes all values that match the path filter from the node.
+func (f pathFilter) remove(node *yaml.Node) {
+	if node.Kind == yaml.DocumentNode {
+		for _, content := range node.Content {
+			f.remove(content)
+		}
+		return
+	}
+	removeSegments(node, f.segments)
+}
+
+func removeSegments(node *yaml.Node, segments []string) {
+	if len(segments) == 0 {
+		return
+	}
+	curr, rest := segments[0], segments[1:]
+	switch node.Kind {
+	case yaml.MappingNode:
+		var content []*yaml.Node
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			k, v := node.Content[i], node.Content[i+1]
+			if curr != wildcardSegment && k.Value != curr {
+				content = append(content, k, v)
+				continue
+			}
+			if len(rest) == 0 {
+				continue // Remove the matched key and value.
+			}
+			removeSegments(v, rest)
+			content = append(content, k, v)
+		}
+		node.Content = content
+	case yaml.SequenceNode:
+		var content []*yaml.Node
+		for idx, item := range node.Content {
+			if curr != wildcardSegment && strconv.Itoa(idx) != curr {
+				content = append(content, item)
+				continue
+			}
+			if len(rest) == 0 {
+				continue // Remove the matched item.
+			}
+			removeSegments(item, rest)
+			content = append(content, item)
+		}
+		node.Content = content
+	}
+}
+
+// unescapeJSONPointer unescapes a reference token in a JSON pointer. See https://datatracker.ietf.org/doc/html/rfc6901#section-4.
+func unescapeJSONPointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+}
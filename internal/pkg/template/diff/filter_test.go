@@ -0,0 +1,118 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrom_ParseWithFilters(t *testing.T) {
+	testCases := map[string]struct {
+		old         string
+		curr        string
+		ignorePaths []string
+		wanted      string
+		wantedErr   string
+	}{
+		"error if a path is not a JSON pointer": {
+			ignorePaths: []string{"Metadata.Version"},
+			wantedErr:   `invalid ignored path "Metadata.Version": must be a JSON pointer that starts with "/"`,
+		},
+		"error if a path is the root": {
+			ignorePaths: []string{"/"},
+			wantedErr:   `invalid ignored path "/": must be a JSON pointer that starts with "/"`,
+		},
+		"ignore a key": {
+			old: `
+Description: CloudFormation template that represents a load balanced web service on Amazon ECS.
+Metadata:
+  Version: v1.29.0
+  Manifest: I don't see any difference.`,
+			curr: `
+Description: CloudFormation template that represents a load balanced web service on Amazon ECS.
+Metadata:
+  Version: v1.30.0
+  Manifest: There is definitely a difference.`,
+			ignorePaths: []string{"/Metadata/Version"},
+		},
+		"ignore keys under wildcards while keeping other diffs": {
+			old: `
+Resources:
+  LogGroup:
+    Properties:
+      Description: generated at 10:00
+      RetentionInDays: 30
+  Service:
+    Properties:
+      Description: generated at 10:00
+      DesiredCount: 1`,
+			curr: `
+Resources:
+  LogGroup:
+    Properties:
+      Description: generated at 11:00
+      RetentionInDays: 30
+  Service:
+    Properties:
+      Description: generated at 11:00
+      DesiredCount: 2`,
+			ignorePaths: []string{"/Resources/*/Properties/Description"},
+			wanted: `
+~ Resources/Service/Properties:
+    ~ DesiredCount: 1 -> 2
+`,
+		},
+		"ignore items in a list": {
+			old: `
+Tags:
+  - Key: generated-at
+    Value: "10:00"
+  - Key: team
+    Value: bear`,
+			curr: `
+Tags:
+  - Key: generated-at
+    Value: "11:00"
+  - Key: team
+    Value: dog`,
+			ignorePaths: []string{"/Tags/0"},
+			wanted: `
+~ Tags:
+    ~ - (changed item)
+      ~ Value: bear -> dog
+`,
+		},
+		"ignore an escaped key in a newly added map": {
+			old: `Outputs: {}`,
+			curr: `
+Outputs:
+  Path/To:
+    Value: generated
+  Name:
+    Value: api`,
+			ignorePaths: []string{"/Outputs/Path~1To"},
+			wanted: `
+~ Outputs:
+    + Name:
+    +     Value: api
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tree, err := From(tc.old).ParseWithFilters([]byte(tc.curr), tc.ignorePaths...)
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			buf := strings.Builder{}
+			require.NoError(t, tree.Write(&buf))
+			require.Equal(t, strings.TrimPrefix(tc.wanted, "\n"), buf.String())
+		})
+	}
+}
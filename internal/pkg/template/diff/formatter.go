@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"gopkg.in/yaml.v3"
 )
 
@@ -21,6 +20,8 @@ type formatter interface {
 
 type seqItemFormatter struct {
 	indent int
+	faint  func(a ...interface{}) string
+	prefix prefixes
 }
 
 func (f *seqItemFormatter) formatDel(node diffNode) (string, error) {
@@ -32,7 +33,7 @@ func (f *seqItemFormatter) formatDel(node diffNode) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return processMultiline(string(raw), prefixByFn(prefixDel), indentByFn(f.indent)), nil
+	return processMultiline(string(raw), prefixByFn(f.prefix.del), indentByFn(f.indent)), nil
 }
 
 func (f *seqItemFormatter) formatInsert(node diffNode) (string, error) {
@@ -44,7 +45,19 @@ func (f *seqItemFormatter) formatInsert(node diffNode) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return processMultiline(string(raw), prefixByFn(prefixAdd), indentByFn(f.indent)), nil
+	return processMultiline(string(raw), prefixByFn(f.prefix.add), indentByFn(f.indent)), nil
+}
+
+func (f *seqItemFormatter) formatUnchanged(item *yaml.Node) (string, error) {
+	raw, err := yaml.Marshal(&yaml.Node{
+		Kind:    yaml.SequenceNode,
+		Tag:     "!!seq",
+		Content: []*yaml.Node{item},
+	})
+	if err != nil {
+		return "", err
+	}
+	return processMultiline(string(raw), prefixByFn(f.prefix.unchanged), indentByFn(f.indent)), nil
 }
 
 func (f *seqItemFormatter) formatMod(node diffNode) (string, error) {
@@ -53,11 +66,11 @@ func (f *seqItemFormatter) formatMod(node diffNode) (string, error) {
 		return "", err
 	}
 	content := fmt.Sprintf("- %s -> %s", oldValue, newValue)
-	return processMultiline(content, prefixByFn(prefixMod), indentByFn(f.indent)), nil
+	return processMultiline(content, prefixByFn(f.prefix.mod), indentByFn(f.indent)), nil
 }
 
 func (f *seqItemFormatter) formatPath(node diffNode) string {
-	return process(color.Faint.Sprint("- (changed item)"), prefixByFn(prefixMod), indentByFn(f.indent)) + "\n"
+	return process(f.faint("- (changed item)"), prefixByFn(f.prefix.mod), indentByFn(f.indent)) + "\n"
 }
 
 func (f *seqItemFormatter) nextIndent() int {
@@ -72,6 +85,7 @@ func (f *seqItemFormatter) nextIndent() int {
 
 type keyedFormatter struct {
 	indent int
+	prefix prefixes
 }
 
 func (f *keyedFormatter) formatDel(node diffNode) (string, error) {
@@ -90,7 +104,7 @@ func (f *keyedFormatter) formatDel(node diffNode) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return processMultiline(string(raw), prefixByFn(prefixDel), indentByFn(f.indent)), nil
+	return processMultiline(string(raw), prefixByFn(f.prefix.del), indentByFn(f.indent)), nil
 }
 
 func (f *keyedFormatter) formatInsert(node diffNode) (string, error) {
@@ -109,7 +123,7 @@ func (f *keyedFormatter) formatInsert(node diffNode) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return processMultiline(string(raw), prefixByFn(prefixAdd), indentByFn(f.indent)), nil
+	return processMultiline(string(raw), prefixByFn(f.prefix.add), indentByFn(f.indent)), nil
 }
 
 func (f *keyedFormatter) formatMod(node diffNode) (string, error) {
@@ -118,18 +132,20 @@ func (f *keyedFormatter) formatMod(node diffNode) (string, error) {
 		return "", err
 	}
 	content := fmt.Sprintf("%s: %s -> %s", node.key(), oldValue, newValue)
-	return processMultiline(content, prefixByFn(prefixMod), indentByFn(f.indent)), nil
+	return processMultiline(content, prefixByFn(f.prefix.mod), indentByFn(f.indent)), nil
 }
 
 func (f *keyedFormatter) formatPath(node diffNode) string {
-	return process(node.key()+":"+"\n", prefixByFn(prefixMod), indentByFn(f.indent))
+	return process(node.key()+":"+"\n", prefixByFn(f.prefix.mod), indentByFn(f.indent))
 }
 
 func (f *keyedFormatter) nextIndent() int {
 	return f.indent + indentInc
 }
 
-type documentFormatter struct{}
+type documentFormatter struct {
+	prefix prefixes
+}
 
 func (f *documentFormatter) formatMod(_ diffNode) (string, error) {
 	return "", nil
@@ -140,7 +156,7 @@ func (f *documentFormatter) formatDel(node diffNode) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return processMultiline(string(raw), prefixByFn(prefixDel), indentByFn(0)), nil
+	return processMultiline(string(raw), prefixByFn(f.prefix.del), indentByFn(0)), nil
 }
 
 func (f *documentFormatter) formatInsert(node diffNode) (string, error) {
@@ -148,7 +164,7 @@ func (f *documentFormatter) formatInsert(node diffNode) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return processMultiline(string(raw), prefixByFn(prefixAdd), indentByFn(0)), nil
+	return processMultiline(string(raw), prefixByFn(f.prefix.add), indentByFn(0)), nil
 }
 
 func (f *documentFormatter) formatPath(_ diffNode) string {
@@ -78,14 +78,6 @@ func (sm *lcsStateMachine) action() action {
 	return action
 }
 
-func (sm *lcsStateMachine) peek() action {
-	lcsIdxOld, fromIdxOld, toIdxOld, actionOld := sm.lcsIndices.index, sm.from.index, sm.to.index, sm.currAction
-	sm.next()
-	next := sm.action()
-	sm.lcsIndices.index, sm.from.index, sm.to.index, sm.currAction = lcsIdxOld, fromIdxOld, toIdxOld, actionOld
-	return next
-}
-
 func (sm *lcsStateMachine) next() {
 	switch sm.currAction {
 	case actionMatch:
@@ -0,0 +1,234 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Change actions in the JSON representation of a diff tree.
+const (
+	ChangeActionAdd    = "add"
+	ChangeActionRemove = "remove"
+	ChangeActionModify = "modify"
+)
+
+// JSON Patch operations. See https://datatracker.ietf.org/doc/html/rfc6902#section-4.
+const (
+	patchOpAdd     = "add"
+	patchOpRemove  = "remove"
+	patchOpReplace = "replace"
+)
+
+// Change represents a single leaf difference between two documents.
+type Change struct {
+	Action string      `json:"action"`
+	Path   string      `json:"path"` // A JSON pointer to the changed value. See https://datatracker.ietf.org/doc/html/rfc6901.
+	Old    interface{} `json:"old,omitempty"`
+	New    interface{} `json:"new,omitempty"`
+}
+
+type patchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"` // Ignored by "remove" operations.
+}
+
+// WriteJSON writes the diff tree as a JSON array of changes.
+// The index of a sequence item in a path is its position in the document after all the preceding changes are applied.
+// Sensitive values are redacted like in Write, with the patterns of WithRedactedKeys in opts; other options are ignored.
+func (t Tree) WriteJSON(w io.Writer, opts ...WriteOption) error {
+	changes, err := t.redactedChanges(opts...)
+	if err != nil {
+		return err
+	}
+	if changes == nil {
+		changes = []Change{}
+	}
+	return json.NewEncoder(w).Encode(changes)
+}
+
+// WritePatch writes the diff tree as a JSON Patch document that transforms the From document into the current document.
+// See https://datatracker.ietf.org/doc/html/rfc6902.
+// Paths to intrinsic functions are written in their full form, for example "Fn::GetAtt", regardless of the form in the documents.
+// Sensitive values are redacted like in WriteJSON.
+func (t Tree) WritePatch(w io.Writer, opts ...WriteOption) error {
+	changes, err := t.redactedChanges(opts...)
+	if err != nil {
+		return err
+	}
+	ops := make([]patchOperation, len(changes))
+	for i, change := range changes {
+		switch change.Action {
+		case ChangeActionAdd:
+			ops[i] = patchOperation{Op: patchOpAdd, Path: change.Path, Value: change.New}
+		case ChangeActionRemove:
+			ops[i] = patchOperation{Op: patchOpRemove, Path: change.Path}
+		default:
+			ops[i] = patchOperation{Op: patchOpReplace, Path: change.Path, Value: change.New}
+		}
+	}
+	return json.NewEncoder(w).Encode(ops)
+}
+
+// Changes returns all the leaf differences in the diff tree, in the order of their appearance.
+func (t Tree) Changes() ([]Change, error) {
+	if t.root == nil {
+		return nil, nil
+	}
+	if len(t.root.children()) == 0 {
+		return leafChanges(t.root, "")
+	}
+	return t.changes(t.root, "")
+}
+
+// redactedChanges returns the changes of the diff tree with their sensitive values hidden.
+func (t Tree) redactedChanges(opts ...WriteOption) ([]Change, error) {
+	changes, err := t.Changes()
+	if err != nil {
+		return nil, err
+	}
+	r := newTreeWriter(io.Discard, opts...).redactor
+	for i, change := range changes {
+		changes[i] = r.redactChange(change)
+	}
+	return changes, nil
+}
+
+func (t Tree) changes(node diffNode, path string) ([]Change, error) {
+	var changes []Change
+	var seqIdx int
+	for _, child := range node.children() {
+		var childPath string
+		switch child := child.(type) {
+		case *unchangedNode:
+			seqIdx += child.unchangedCount()
+			continue
+		case *seqItemNode:
+			childPath = path + "/" + strconv.Itoa(seqIdx)
+			if child.newYAML() != nil || len(child.children()) != 0 {
+				seqIdx++ // The item still exists after an insertion or a modification.
+			}
+		default:
+			childPath = path + "/" + escapeJSONPointer(child.key())
+		}
+		var childChanges []Change
+		var err error
+		if len(child.children()) == 0 {
+			childChanges, err = leafChanges(child, childPath)
+		} else {
+			childChanges, err = t.changes(child, childPath)
+		}
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, childChanges...)
+	}
+	return changes, nil
+}
+
+func leafChanges(node diffNode, path string) ([]Change, error) {
+	oldV, err := jsonValue(node.oldYAML())
+	if err != nil {
+		return nil, fmt.Errorf("convert old value at %q to JSON: %w", path, err)
+	}
+	newV, err := jsonValue(node.newYAML())
+	if err != nil {
+		return nil, fmt.Errorf("convert new value at %q to JSON: %w", path, err)
+	}
+	switch {
+	case node.oldYAML() != nil && node.newYAML() != nil:
+		return []Change{{Action: ChangeActionModify, Path: path, Old: oldV, New: newV}}, nil
+	case node.oldYAML() != nil:
+		return []Change{{Action: ChangeActionRemove, Path: path, Old: oldV}}, nil
+	default:
+		return []Change{{Action: ChangeActionAdd, Path: path, New: newV}}, nil
+	}
+}
+
+// JSONValue parses a YAML document, such as a CloudFormation template, into a value that can be marshaled to JSON.
+// Intrinsic functions written in short form are converted to their full form, e.g. "!Ref Foo" becomes {"Ref": "Foo"}.
+func JSONValue(doc []byte) (interface{}, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(doc, &node); err != nil {
+		return nil, err
+	}
+	return jsonValue(&node)
+}
+
+// jsonValue converts a YAML node to a value that can be marshaled to JSON.
+// Intrinsic functions written in short form are converted to their full form, e.g. "!Ref Foo" becomes {"Ref": "Foo"}.
+func jsonValue(node *yaml.Node) (interface{}, error) {
+	if node == nil {
+		return nil, nil
+	}
+	if _, ok := intrinsicFunctionShortNames[node.Tag]; ok && strings.HasPrefix(node.Tag, "!") {
+		v, err := jsonValue(stripTag(node))
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{intrinsicFuncFullName(node.Tag): v}, nil
+	}
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+		return jsonValue(node.Content[0])
+	case yaml.AliasNode:
+		return jsonValue(node.Alias)
+	case yaml.MappingNode:
+		m := make(map[string]interface{}, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			v, err := jsonValue(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			m[node.Content[i].Value] = v
+		}
+		return m, nil
+	case yaml.SequenceNode:
+		seq := make([]interface{}, len(node.Content))
+		for i, item := range node.Content {
+			v, err := jsonValue(item)
+			if err != nil {
+				return nil, err
+			}
+			seq[i] = v
+		}
+		return seq, nil
+	}
+	var v interface{}
+	if strings.HasPrefix(node.Tag, "!!") || node.Tag == "" {
+		if err := node.Decode(&v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+	// A scalar with a custom tag is decoded as its implicit type.
+	if err := stripTag(node).Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// intrinsicFuncFullName returns the full name of an intrinsic function given its short form tag, e.g. "!GetAtt" becomes "Fn::GetAtt".
+func intrinsicFuncFullName(tag string) string {
+	name := strings.TrimPrefix(tag, "!")
+	if name == "Ref" || name == "Condition" {
+		return name
+	}
+	return "Fn::" + name
+}
+
+// escapeJSONPointer escapes a reference token in a JSON pointer. See https://datatracker.ietf.org/doc/html/rfc6901#section-3.
+func escapeJSONPointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
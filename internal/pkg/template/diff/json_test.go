@@ -0,0 +1,183 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTree_WriteJSON(t *testing.T) {
+	testCases := map[string]struct {
+		curr   string
+		old    string
+		inOpts []WriteOption
+		wanted string
+	}{
+		"no diff": {
+			old:    `Mary: likes animals`,
+			curr:   `Mary: likes animals`,
+			wanted: `[]`,
+		},
+		"from is empty": {
+			curr:   `Mary: likes animals`,
+			wanted: `[{"action":"add","path":"","new":{"Mary":"likes animals"}}]`,
+		},
+		"changes in maps": {
+			old: `
+Mary:
+  Height:
+    cm: 190
+  Weight: 52
+  Likes/Dislikes: bears`,
+			curr: `
+Mary:
+  Height:
+    cm: 168
+  Likes/Dislikes: bears and dogs
+  Hobby: [dancing]`,
+			wanted: `[
+{"action":"modify","path":"/Mary/Height/cm","old":190,"new":168},
+{"action":"add","path":"/Mary/Hobby","new":["dancing"]},
+{"action":"modify","path":"/Mary/Likes~1Dislikes","old":"bears","new":"bears and dogs"},
+{"action":"remove","path":"/Mary/Weight","old":52}]`,
+		},
+		"changes in lists": {
+			old:  `DogsFavoriteShape: [irregular,triangle,circle,rectangle]`,
+			curr: `DogsFavoriteShape: [triangle,ellipse,rectangle,food-shape]`,
+			wanted: `[
+{"action":"remove","path":"/DogsFavoriteShape/0","old":"irregular"},
+{"action":"modify","path":"/DogsFavoriteShape/1","old":"circle","new":"ellipse"},
+{"action":"add","path":"/DogsFavoriteShape/3","new":"food-shape"}]`,
+		},
+		"changes in intrinsic functions": {
+			old: `
+Resources:
+  Service:
+    Properties:
+      Cluster: !GetAtt Cluster.Arn
+      ServiceConnectConfiguration: !If [IsGovCloud, !Ref AWS::NoValue, {Enabled: False}]`,
+			curr: `
+Resources:
+  Service:
+    Properties:
+      Cluster:
+        Fn::GetAtt: [Cluster, Name]`,
+			wanted: `[
+{"action":"modify","path":"/Resources/Service/Properties/Cluster/Fn::GetAtt/1","old":"Arn","new":"Name"},
+{"action":"remove","path":"/Resources/Service/Properties/ServiceConnectConfiguration","old":{"Fn::If":["IsGovCloud",{"Ref":"AWS::NoValue"},{"Enabled":false}]}}]`,
+		},
+		"redacts sensitive values": {
+			old: `
+Resources:
+  DBSecret:
+    Properties:
+      MasterUserPassword: hunter2
+      Port: 5432`,
+			curr: `
+Resources:
+  DBSecret:
+    Properties:
+      MasterUserPassword: hunter3
+      Port: 5433
+  Webhook:
+    Properties:
+      Headers: [{Name: X-Api, ApiKey: abc}]
+      GitHubToken: xyz`,
+			inOpts: []WriteOption{WithRedactedKeys("*Port")},
+			wanted: `[
+{"action":"modify","path":"/Resources/DBSecret/Properties/MasterUserPassword","old":"(sensitive value)","new":"(sensitive value)"},
+{"action":"modify","path":"/Resources/DBSecret/Properties/Port","old":"(sensitive value)","new":"(sensitive value)"},
+{"action":"add","path":"/Resources/Webhook","new":{"Properties":{"Headers":[{"Name":"X-Api","ApiKey":"(sensitive value)"}],"GitHubToken":"(sensitive value)"}}}]`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tree, err := From(tc.old).ParseWithCFNOverriders([]byte(tc.curr))
+			require.NoError(t, err)
+			buf := strings.Builder{}
+			require.NoError(t, tree.WriteJSON(&buf, tc.inOpts...))
+			require.JSONEq(t, tc.wanted, buf.String())
+		})
+	}
+}
+
+func TestTree_WritePatch(t *testing.T) {
+	testCases := map[string]struct {
+		curr   string
+		old    string
+		wanted string
+	}{
+		"no diff": {
+			old:    `Mary: likes animals`,
+			curr:   `Mary: likes animals`,
+			wanted: `[]`,
+		},
+		"to is empty": {
+			old:    `Mary: likes animals`,
+			wanted: `[{"op":"remove","path":"","value":null}]`,
+		},
+		"changes in maps and lists": {
+			old: `
+Mary:
+  Weight: 52
+  Hobby: [swimming, dancing, singing]`,
+			curr: `
+Mary:
+  Height: 168
+  Hobby: [dancing, painting]`,
+			wanted: `[
+{"op":"add","path":"/Mary/Height","value":168},
+{"op":"remove","path":"/Mary/Hobby/0","value":null},
+{"op":"replace","path":"/Mary/Hobby/1","value":"painting"},
+{"op":"remove","path":"/Mary/Weight","value":null}]`,
+		},
+		"redacts sensitive values": {
+			old:    `Mary: {Password: hunter2}`,
+			curr:   `Mary: {Password: hunter3}`,
+			wanted: `[{"op":"replace","path":"/Mary/Password","value":"(sensitive value)"}]`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tree, err := From(tc.old).Parse([]byte(tc.curr))
+			require.NoError(t, err)
+			buf := strings.Builder{}
+			require.NoError(t, tree.WritePatch(&buf))
+			require.JSONEq(t, tc.wanted, buf.String())
+		})
+	}
+}
+
+func TestJSONValue(t *testing.T) {
+	got, err := JSONValue([]byte(`
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      BucketName: !Sub '${AWS::StackName}-assets'
+      Tags:
+        - Key: arn
+          Value: !GetAtt Role.Arn
+        - Key: env
+          Value: !Ref Env`))
+
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"Resources": map[string]interface{}{
+			"Bucket": map[string]interface{}{
+				"Type": "AWS::S3::Bucket",
+				"Properties": map[string]interface{}{
+					"BucketName": map[string]interface{}{"Fn::Sub": "${AWS::StackName}-assets"},
+					"Tags": []interface{}{
+						map[string]interface{}{"Key": "arn", "Value": map[string]interface{}{"Fn::GetAtt": "Role.Arn"}},
+						map[string]interface{}{"Key": "env", "Value": map[string]interface{}{"Ref": "Env"}},
+					},
+				},
+			},
+		},
+	}, got)
+}
@@ -18,25 +18,37 @@ type lcsIndex struct {
 //   One LCS is ["a","c","d"].
 //   "a" is input_a[0] and input_b[0], "c" is in input_a[1] and input_b[3], "d" is input_a[4] and input_b[5].
 //   Therefore, the output will be [0,1,4], [0,3,5]
-// Note that the parameter function `eq` is guaranteed to be called on every combination of a's and b's items.
+// Note that the parameter function `eq` is guaranteed to be called on every combination of a's and b's items after
+// their common prefix, and on each pair of items in the common prefix.
 func longestCommonSubsequence[T any](a []T, b []T, eq eqFunc) []lcsIndex {
 	if len(a) == 0 || len(b) == 0 {
 		return nil
 	}
-	// Initialize the matrix
-	lcs := make([][]int, len(a)+1)
-	for i := 0; i < len(a)+1; i++ {
-		lcs[i] = make([]int, len(b)+1)
-		lcs[i][len(b)] = 0
+	// The backtrace below matches a[i] with b[j] whenever they are equal, starting from the first items.
+	// Hence, the common prefix is always part of the LCS, and the matrix only needs to cover the items after it.
+	var lcsIndices []lcsIndex
+	var prefix int
+	for prefix < len(a) && prefix < len(b) && eq(prefix, prefix) {
+		lcsIndices = append(lcsIndices, lcsIndex{
+			inA: prefix,
+			inB: prefix,
+		})
+		prefix++
+	}
+	if prefix == len(a) || prefix == len(b) {
+		return lcsIndices
 	}
-	for j := 0; j < len(b)+1; j++ {
-		lcs[len(a)][j] = 0
+	// Initialize the matrix, where lcs[i][j] is the length of the LCS of a[prefix+i:] and b[prefix+j:].
+	lenA, lenB := len(a)-prefix, len(b)-prefix
+	lcs := make([][]int, lenA+1)
+	for i := 0; i < lenA+1; i++ {
+		lcs[i] = make([]int, lenB+1)
 	}
 	// Compute the lengths of the LCS for all sub lists.
-	for i := len(a) - 1; i >= 0; i-- {
-		for j := len(b) - 1; j >= 0; j-- {
+	for i := lenA - 1; i >= 0; i-- {
+		for j := lenB - 1; j >= 0; j-- {
 			switch {
-			case eq(i, j):
+			case eq(prefix+i, prefix+j):
 				lcs[i][j] = 1 + lcs[i+1][j+1]
 			case lcs[i+1][j] < lcs[i][j+1]:
 				lcs[i][j] = lcs[i][j+1]
@@ -47,16 +59,15 @@ func longestCommonSubsequence[T any](a []T, b []T, eq eqFunc) []lcsIndex {
 	}
 	// Backtrace to construct the LCS.
 	var i, j int
-	var lcsIndices []lcsIndex
 	for {
-		if i >= len(a) || j >= len(b) {
+		if i >= lenA || j >= lenB {
 			break
 		}
 		switch {
-		case eq(i, j):
+		case eq(prefix+i, prefix+j):
 			lcsIndices = append(lcsIndices, lcsIndex{
-				inA: i,
-				inB: j,
+				inA: prefix + i,
+				inB: prefix + j,
 			})
 			i++
 			j++
@@ -43,6 +43,87 @@ func (m *ignorer) parse(_, _ *yaml.Node, _ string, _ overrider) (diffNode, error
 	return nil, nil
 }
 
+// seqItemIdentifier identifies whether two items in a sequence represent the same entity, even if their contents differ.
+type seqItemIdentifier interface {
+	sameItem(from, to *yaml.Node) (same bool, identifiable bool)
+}
+
+// identityKeyMatcher identifies items in a sequence of maps by the value under any of its identity keys.
+// For example, two container definitions with the same "Name" are the same item, so that their changes are
+// rendered as a modification rather than a deletion followed by an insertion.
+type identityKeyMatcher struct {
+	keys []string
+}
+
+// match always returns false, because an identityKeyMatcher doesn't override how the nodes are parsed.
+func (*identityKeyMatcher) match(_, _ *yaml.Node, _ string, _ overrider) bool {
+	return false
+}
+
+// parse is a no-op for an identityKeyMatcher.
+func (*identityKeyMatcher) parse(_, _ *yaml.Node, _ string, _ overrider) (diffNode, error) {
+	return nil, nil
+}
+
+// sameItem compares from and to by the scalar value under the first identity key that is present in both of them.
+// It returns whether they are the same item, and whether they are identifiable by any identity key at all.
+// Example1: "{Name: a, Value: 1}" and "{Name: a, Value: 2}" are the same item if "Name" is an identity key.
+// Example2: "{Name: a}" and "{Name: b}" are identifiable, but are different items.
+// Example3: "{Name: a}" and "{Key: a}" are not identifiable because they don't share any identity key.
+func (m *identityKeyMatcher) sameItem(from, to *yaml.Node) (same bool, identifiable bool) {
+	if from == nil || to == nil || from.Kind != yaml.MappingNode || to.Kind != yaml.MappingNode {
+		return false, false
+	}
+	for _, k := range m.keys {
+		fromV, toV := mapValue(from, k), mapValue(to, k)
+		if fromV == nil || toV == nil || fromV.Kind != yaml.ScalarNode || toV.Kind != yaml.ScalarNode {
+			continue
+		}
+		return fromV.Value == toV.Value, true
+	}
+	return false, false
+}
+
+// mapValue returns the value node under key in a mapping node, or nil if the key doesn't exist.
+func mapValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func seqItemIdentifiers(overriders []overrider) []seqItemIdentifier {
+	var identifiers []seqItemIdentifier
+	for _, o := range overriders {
+		if identifier, ok := o.(seqItemIdentifier); ok {
+			identifiers = append(identifiers, identifier)
+		}
+	}
+	return identifiers
+}
+
+// sameSeqItem returns true if any of the identifiers considers from and to to be the same item.
+func sameSeqItem(from, to *yaml.Node, identifiers []seqItemIdentifier) bool {
+	for _, identifier := range identifiers {
+		if same, _ := identifier.sameItem(from, to); same {
+			return true
+		}
+	}
+	return false
+}
+
+// distinctSeqItems returns true if any of the identifiers can tell that from and to are different items.
+func distinctSeqItems(from, to *yaml.Node, identifiers []seqItemIdentifier) bool {
+	for _, identifier := range identifiers {
+		if same, identifiable := identifier.sameItem(from, to); identifiable && !same {
+			return true
+		}
+	}
+	return false
+}
+
 // Check https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/intrinsic-function-reference.html for
 // a complete list of intrinsic functions. Some are not included here as they do not need an overrider.
 var (
@@ -385,7 +385,7 @@ TestJoin:
 							childNodes: []diffNode{
 								&keyNode{
 									keyValue:   "Fn::Select",
-									childNodes: []diffNode{leaf, &unchangedNode{1}},
+									childNodes: []diffNode{leaf, &unchangedNode{count: 1}},
 								},
 							},
 						},
@@ -461,7 +461,7 @@ TestSub:
 							childNodes: []diffNode{
 								&keyNode{
 									keyValue:   "Fn::Sub",
-									childNodes: []diffNode{leaf, &unchangedNode{1}},
+									childNodes: []diffNode{leaf, &unchangedNode{count: 1}},
 								},
 							},
 						},
@@ -549,14 +549,60 @@ MyOrCondition:
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
-			got, err := From(tc.old).Parse([]byte(tc.curr), &getAttConverter{}, &intrinsicFuncMapTagConverter{})
+			got, err := From(tc.old).Parse([]byte(tc.curr), withOverriders(&getAttConverter{}, &intrinsicFuncMapTagConverter{}))
 			require.NoError(t, err)
 			got.Write(os.Stdout)
 			if tc.wanted != nil {
-				require.True(t, equalTree(got, Tree{tc.wanted()}, t), "should get the expected tree")
+				require.True(t, equalTree(got, Tree{root: tc.wanted()}, t), "should get the expected tree")
 			} else {
 				require.True(t, equalTree(got, Tree{}, t), "should get the expected tree")
 			}
 		})
 	}
 }
+
+func TestIdentityKeyMatcher_sameItem(t *testing.T) {
+	testCases := map[string]struct {
+		from               string
+		to                 string
+		wantedSame         bool
+		wantedIdentifiable bool
+	}{
+		"same identity with different content": {
+			from:               `{Name: nginx, Image: nginx:1.24}`,
+			to:                 `{Name: nginx, Image: nginx:1.25}`,
+			wantedSame:         true,
+			wantedIdentifiable: true,
+		},
+		"different identities": {
+			from:               `{Name: nginx}`,
+			to:                 `{Name: envoy}`,
+			wantedIdentifiable: true,
+		},
+		"no shared identity key": {
+			from: `{Name: nginx}`,
+			to:   `{Key: nginx}`,
+		},
+		"the first shared identity key decides": {
+			from:               `{Name: nginx, Key: a}`,
+			to:                 `{Name: envoy, Key: a}`,
+			wantedIdentifiable: true,
+		},
+		"identity value is not a scalar": {
+			from: `{Name: [nginx]}`,
+			to:   `{Name: [nginx]}`,
+		},
+		"not maps": {
+			from: `nginx`,
+			to:   `nginx`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			matcher := &identityKeyMatcher{keys: []string{"Name", "Key"}}
+			same, identifiable := matcher.sameItem(yamlNode(tc.from, t), yamlNode(tc.to, t))
+			require.Equal(t, tc.wantedSame, same)
+			require.Equal(t, tc.wantedIdentifiable, identifiable)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"path"
+	"strconv"
+	"strings"
+)
+
+const (
+	redactedChange = "(sensitive value changed)"
+	redactedValue  = "(sensitive value)"
+)
+
+// defaultRedactedKeys are the patterns of the keys whose values are hidden by default.
+var defaultRedactedKeys = []string{"*Password", "*Secret", "*SecretString", "*Token", "*ApiKey"}
+
+// redactor decides whether the values under a key are sensitive.
+type redactor struct {
+	patterns []string
+}
+
+func newRedactor() redactor {
+	return redactor{
+		patterns: append([]string(nil), defaultRedactedKeys...),
+	}
+}
+
+// redacts returns true if any segment in the key of a key node matches a pattern.
+// The key of a node can have multiple segments if the node is collapsed from its descendants, e.g. "Properties/DBPassword".
+// The logical IDs of the resources are not sensitive, hence never matched. parentPath is the slash-separated keys
+// from the root to the parent of the node.
+func (r redactor) redacts(parentPath string, node diffNode) bool {
+	kn, ok := node.(*keyNode)
+	if !ok {
+		return false
+	}
+	segments := strings.Split(kn.key(), "/")
+	if parentPath != "" {
+		segments = append(strings.Split(strings.TrimPrefix(parentPath, "/"), "/"), segments...)
+	}
+	depth := strings.Count(parentPath, "/")
+	for i, segment := range segments {
+		if i < depth {
+			continue // Only the segments of the node itself are matched.
+		}
+		if i == 1 && segments[0] == resourcesKey {
+			continue
+		}
+		if r.matches(segment) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r redactor) matches(key string) bool {
+	for _, pattern := range r.patterns {
+		if matched, _ := path.Match(strings.ToLower(pattern), strings.ToLower(key)); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// redactChange hides the sensitive values of a change. The values at a sensitive path are replaced entirely,
+// while the values of an added or removed subtree are hidden under the sensitive keys within.
+func (r redactor) redactChange(change Change) Change {
+	segments := pointerSegments(change.Path)
+	if r.redactsSegments(segments) {
+		if change.Old != nil {
+			change.Old = redactedValue
+		}
+		if change.New != nil {
+			change.New = redactedValue
+		}
+		return change
+	}
+	change.Old = r.redactValue(segments, change.Old)
+	change.New = r.redactValue(segments, change.New)
+	return change
+}
+
+// redactsSegments returns true if any segment of a path matches a pattern, other than the logical ID of a resource.
+func (r redactor) redactsSegments(segments []string) bool {
+	for i, segment := range segments {
+		if i == 1 && segments[0] == resourcesKey {
+			continue
+		}
+		if r.matches(segment) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactValue returns a copy of the JSON value at the path with the values under the sensitive keys hidden.
+func (r redactor) redactValue(segments []string, v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			childSegments := append(append([]string(nil), segments...), key)
+			if r.redactsSegments(childSegments) {
+				redacted[key] = redactedValue
+				continue
+			}
+			redacted[key] = r.redactValue(childSegments, child)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(v))
+		for i, item := range v {
+			redacted[i] = r.redactValue(append(append([]string(nil), segments...), strconv.Itoa(i)), item)
+		}
+		return redacted
+	}
+	return v
+}
+
+// pointerSegments returns the unescaped reference tokens of a JSON pointer.
+func pointerSegments(pointer string) []string {
+	if pointer == "" {
+		return nil
+	}
+	segments := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+	}
+	return segments
+}
@@ -0,0 +1,119 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Integration_Parse_Write_WithRedaction(t *testing.T) {
+	old := `
+Resources:
+  Database:
+    Type: AWS::RDS::DBCluster
+    Properties:
+      MasterUsername: admin
+      MasterUserPassword: hunter2
+  Secret:
+    Type: AWS::SecretsManager::Secret
+    Properties:
+      SecretString: '{"password":"hunter2"}'
+  Webhook:
+    Type: Custom::Webhook
+    Properties:
+      authToken: abc
+      Credentials: foo`
+	curr := `
+Resources:
+  Database:
+    Type: AWS::RDS::DBCluster
+    Properties:
+      MasterUsername: root
+      MasterUserPassword:
+        Ref: PasswordParam
+  Secret:
+    Type: AWS::SecretsManager::Secret
+    Properties:
+      SecretString: '{"password":"correct horse battery staple"}'
+      ClientSecret: shh
+  Webhook:
+    Type: Custom::Webhook
+    Properties:
+      Credentials: bar`
+	testCases := map[string]struct {
+		opts   []WriteOption
+		wanted string
+	}{
+		"redact the values under the keys that match the default patterns": {
+			wanted: `
+~ Resources:
+    ~ Database/Properties:
+        ~ MasterUserPassword: (sensitive value changed)
+        ~ MasterUsername: admin -> root
+    ~ Secret/Properties:
+        + ClientSecret: (sensitive value)
+        ~ SecretString: (sensitive value changed)
+    ~ Webhook/Properties:
+        ~ Credentials: foo -> bar
+        - authToken: (sensitive value)
+`,
+		},
+		"redact the values under the keys that match additional patterns": {
+			opts: []WriteOption{WithRedactedKeys("*credentials")},
+			wanted: `
+~ Resources:
+    ~ Database/Properties:
+        ~ MasterUserPassword: (sensitive value changed)
+        ~ MasterUsername: admin -> root
+    ~ Secret/Properties:
+        + ClientSecret: (sensitive value)
+        ~ SecretString: (sensitive value changed)
+    ~ Webhook/Properties:
+        ~ Credentials: (sensitive value changed)
+        - authToken: (sensitive value)
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotTree, err := From(old).ParseWithCFNOverriders([]byte(curr))
+			require.NoError(t, err)
+
+			buf := strings.Builder{}
+			require.NoError(t, gotTree.Write(&buf, tc.opts...))
+			require.Equal(t, strings.TrimPrefix(tc.wanted, "\n"), buf.String())
+			require.NotContains(t, buf.String(), "hunter2")
+		})
+	}
+}
+
+func Test_Integration_Parse_Write_WithRedactionOfCollapsedPath(t *testing.T) {
+	old := `
+Resources:
+  Database:
+    Properties:
+      DBPassword:
+        Ref: OldPassword`
+	curr := `
+Resources:
+  Database:
+    Properties:
+      DBPassword:
+        Ref: NewPassword`
+	gotTree, err := From(old).Parse([]byte(curr))
+	require.NoError(t, err)
+
+	buf := strings.Builder{}
+	require.NoError(t, gotTree.Write(&buf))
+	require.Equal(t, "~ Resources/Database/Properties/DBPassword: (sensitive value changed)\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, gotTree.WriteSideBySide(&buf, 80))
+	require.Contains(t, buf.String(), redactedChange)
+	require.NotContains(t, buf.String(), "OldPassword")
+	require.NotContains(t, buf.String(), "NewPassword")
+}
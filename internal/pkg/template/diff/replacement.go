@@ -0,0 +1,159 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	typeKey       = "Type"
+	propertiesKey = "Properties"
+
+	markerReplacement = "!! replacement"
+)
+
+// replacementProperties are the properties of a resource type whose update requires CloudFormation to replace the resource.
+// See the "Update requires: Replacement" properties in https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-template-resource-type-ref.html.
+var replacementProperties = map[string][]string{
+	"AWS::ApplicationAutoScaling::ScalableTarget": {"ResourceId", "ScalableDimension", "ServiceNamespace"},
+	"AWS::DynamoDB::Table":                        {"KeySchema", "TableName"},
+	"AWS::EC2::SecurityGroup":                     {"GroupDescription", "GroupName", "VpcId"},
+	"AWS::EC2::Subnet":                            {"AvailabilityZone", "CidrBlock", "VpcId"},
+	"AWS::EC2::VPC":                               {"CidrBlock", "InstanceTenancy"},
+	"AWS::ECR::Repository":                        {"RepositoryName"},
+	"AWS::ECS::Cluster":                           {"ClusterName"},
+	"AWS::ECS::Service":                           {"Cluster", "LaunchType", "Role", "SchedulingStrategy", "ServiceName"},
+	"AWS::ECS::TaskDefinition": {
+		"ContainerDefinitions", "Cpu", "EphemeralStorage", "ExecutionRoleArn", "Family", "Memory", "NetworkMode",
+		"PlacementConstraints", "RequiresCompatibilities", "RuntimePlatform", "TaskRoleArn", "Volumes",
+	},
+	"AWS::EFS::FileSystem":                       {"Encrypted", "KmsKeyId", "PerformanceMode"},
+	"AWS::ElasticLoadBalancingV2::LoadBalancer":  {"Name", "Scheme", "Type"},
+	"AWS::ElasticLoadBalancingV2::TargetGroup":   {"Name", "Port", "Protocol", "ProtocolVersion", "TargetType", "VpcId"},
+	"AWS::Events::Rule":                          {"EventBusName", "Name"},
+	"AWS::IAM::Role":                             {"Path", "RoleName"},
+	"AWS::Lambda::Function":                      {"FunctionName", "PackageType"},
+	"AWS::Logs::LogGroup":                        {"LogGroupName"},
+	"AWS::RDS::DBCluster":                        {"DBClusterIdentifier", "DatabaseName", "Engine", "KmsKeyId", "StorageEncrypted"},
+	"AWS::S3::Bucket":                            {"BucketName"},
+	"AWS::SNS::Topic":                            {"FifoTopic", "TopicName"},
+	"AWS::SQS::Queue":                            {"FifoQueue", "QueueName"},
+	"AWS::ServiceDiscovery::Service":             {"Name", "NamespaceId"},
+	"AWS::ServiceDiscovery::PrivateDnsNamespace": {"Name", "Vpc"},
+}
+
+// Replacements returns the sorted logical IDs of the modified resources that CloudFormation replaces, according to
+// the properties that require replacement upon update. A resource is also replaced if its type is changed.
+func (t Tree) Replacements() []string {
+	var ids []string
+	for _, resource := range t.resourceNodes() {
+		if requiresReplacement(resource, replacementProperties[t.resourceTypes[resource.key()]]) {
+			ids = append(ids, resource.key())
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// resourceNodes returns the diff nodes of the modified resources under "Resources".
+func (t Tree) resourceNodes() []diffNode {
+	if t.root == nil {
+		return nil
+	}
+	for _, child := range t.root.children() {
+		if child.key() == resourcesKey {
+			return child.children()
+		}
+	}
+	return nil
+}
+
+// requiresReplacement returns true if the type of the resource or any of the properties that require replacement is modified.
+func requiresReplacement(resource diffNode, properties []string) bool {
+	for _, child := range resource.children() {
+		switch child.key() {
+		case typeKey:
+			if child.oldYAML() != nil && child.newYAML() != nil {
+				return true
+			}
+		case propertiesKey:
+			for _, name := range properties {
+				if hasModifiedProperty(child, name) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// hasModifiedProperty returns true if the property is modified, or added/removed along with the entire "Properties" map.
+func hasModifiedProperty(properties diffNode, name string) bool {
+	if len(properties.children()) == 0 {
+		for _, node := range []*yaml.Node{properties.oldYAML(), properties.newYAML()} {
+			if node != nil && node.Kind == yaml.MappingNode && mapValue(node, name) != nil {
+				return true
+			}
+		}
+		return false
+	}
+	for _, property := range properties.children() {
+		if property.key() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// cfnResourceTypes returns the types of the resources in the documents keyed by their logical IDs.
+// If a resource exists in multiple documents, the type in the earlier document takes precedence.
+func cfnResourceTypes(docs ...*yaml.Node) map[string]string {
+	var types map[string]string
+	for i := len(docs) - 1; i >= 0; i-- {
+		doc := docs[i]
+		if doc == nil {
+			continue
+		}
+		if doc.Kind == yaml.DocumentNode && len(doc.Content) > 0 {
+			doc = doc.Content[0]
+		}
+		if doc.Kind != yaml.MappingNode {
+			continue
+		}
+		resources := mapValue(doc, resourcesKey)
+		if resources == nil || resources.Kind != yaml.MappingNode {
+			continue
+		}
+		for j := 0; j+1 < len(resources.Content); j += 2 {
+			if resource := resources.Content[j+1]; resource.Kind == yaml.MappingNode {
+				if typ := mapValue(resource, typeKey); typ != nil && typ.Kind == yaml.ScalarNode {
+					if types == nil {
+						types = make(map[string]string)
+					}
+					types[resources.Content[j].Value] = typ.Value
+				}
+			}
+		}
+	}
+	return types
+}
+
+// isReplacedResource returns true if the path, written after a parent path, is the first one that reaches into
+// a replaced resource. For example, given the parent path "/Resources", the path "Service/Properties" reaches into
+// the resource "Service".
+func isReplacedResource(parentPath, path string, replacements map[string]bool) bool {
+	var parent []string
+	if parentPath != "" {
+		parent = strings.Split(strings.TrimPrefix(parentPath, "/"), "/")
+	}
+	full := append(parent, strings.Split(path, "/")...)
+	if len(parent) >= 2 || len(full) < 2 || full[0] != resourcesKey {
+		return false
+	}
+	return replacements[full[1]]
+}
@@ -0,0 +1,70 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTree_Replacements(t *testing.T) {
+	testCases := map[string]struct {
+		old    string
+		curr   string
+		wanted []string
+	}{
+		"no diff": {
+			old:  `Resources: {Bucket: {Type: AWS::S3::Bucket, Properties: {BucketName: logs}}}`,
+			curr: `Resources: {Bucket: {Type: AWS::S3::Bucket, Properties: {BucketName: logs}}}`,
+		},
+		"no replacement if only the properties that can be updated in place are modified": {
+			old:  `Resources: {Service: {Type: AWS::ECS::Service, Properties: {DesiredCount: 1, ServiceName: api}}}`,
+			curr: `Resources: {Service: {Type: AWS::ECS::Service, Properties: {DesiredCount: 2, ServiceName: api}}}`,
+		},
+		"no replacement for added or removed resources": {
+			old:  `Resources: {Bucket: {Type: AWS::S3::Bucket, Properties: {BucketName: logs}}}`,
+			curr: `Resources: {Cluster: {Type: AWS::ECS::Cluster}}`,
+		},
+		"no replacement for an unknown resource type": {
+			old:  `Resources: {Custom: {Type: Custom::Resource, Properties: {Name: a}}}`,
+			curr: `Resources: {Custom: {Type: Custom::Resource, Properties: {Name: b}}}`,
+		},
+		"replaced if a property that requires replacement is modified, added, or removed": {
+			old: `
+Resources:
+  Cluster:
+    Type: AWS::ECS::Cluster
+    Properties: {ClusterName: test}
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties: {BucketName: logs}
+  Queue:
+    Type: AWS::SQS::Queue`,
+			curr: `
+Resources:
+  Cluster:
+    Type: AWS::ECS::Cluster
+    Properties: {ClusterName: prod}
+  Bucket:
+    Type: AWS::S3::Bucket
+  Queue:
+    Type: AWS::SQS::Queue
+    Properties: {QueueName: events}`,
+			wanted: []string{"Bucket", "Cluster", "Queue"},
+		},
+		"replaced if the type is modified": {
+			old:    `Resources: {Queue: {Type: AWS::SQS::Queue}}`,
+			curr:   `Resources: {Queue: {Type: AWS::SNS::Topic}}`,
+			wanted: []string{"Queue"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tree, err := From(tc.old).ParseWithCFNOverriders([]byte(tc.curr))
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, tree.Replacements())
+		})
+	}
+}
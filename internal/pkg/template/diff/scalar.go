@@ -0,0 +1,293 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/dustin/go-humanize/english"
+	"gopkg.in/yaml.v3"
+)
+
+// longScalarLen is the length from which a single-line string is compared word by word.
+const longScalarLen = 80
+
+// Markers around the changed words when the diff is not colored, similar to "git diff --word-diff=plain".
+const (
+	markerDelStart    = "[-"
+	markerDelEnd      = "-]"
+	markerInsertStart = "{+"
+	markerInsertEnd   = "+}"
+)
+
+// WithWordDiff enables or disables highlighting only the changed words of a modified string that spans multiple
+// lines or is longer than 80 characters, instead of writing the whole old and new strings. A multi-line string is
+// compared line by line first, and its unchanged lines are collapsed like the unchanged items of a list.
+// By default, word diff is enabled.
+func WithWordDiff(enabled bool) WriteOption {
+	return func(tw *treeWriter) {
+		tw.wordDiff = enabled
+	}
+}
+
+type segmentKind int
+
+const (
+	segmentUnchanged segmentKind = iota
+	segmentDel
+	segmentInsert
+)
+
+// segment is a run of words in a word diff.
+type segment struct {
+	kind segmentKind
+	text string
+}
+
+// lineDiff is a line in the diff of two multi-line strings. A modified line has both an old and a new value.
+type lineDiff struct {
+	old *string
+	new *string
+}
+
+func (l lineDiff) unchanged() bool {
+	return l.old != nil && l.new != nil && *l.old == *l.new
+}
+
+// wordDiffable returns true if the node is a modified string that should be compared word by word.
+func wordDiffable(node diffNode, formatter formatter) bool {
+	switch formatter.(type) {
+	case *keyedFormatter, *seqItemFormatter:
+	default:
+		return false
+	}
+	from, to := node.oldYAML(), node.newYAML()
+	if from.Kind != yaml.ScalarNode || to.Kind != yaml.ScalarNode || from.ShortTag() != to.ShortTag() {
+		return false
+	}
+	if from.ShortTag() == "!!binary" {
+		return false
+	}
+	return strings.Contains(from.Value, "\n") || strings.Contains(to.Value, "\n") ||
+		len(from.Value) > longScalarLen || len(to.Value) > longScalarLen
+}
+
+// writeWordDiff writes a modified string with only its changed words highlighted.
+func (s *treeWriter) writeWordDiff(node diffNode, formatter formatter) error {
+	label, indent := "-", 0
+	switch f := formatter.(type) {
+	case *keyedFormatter:
+		label, indent = node.key()+":", f.indent
+	case *seqItemFormatter:
+		indent = f.indent
+	}
+	if tag := node.newYAML().ShortTag(); !strings.HasPrefix(tag, "!!") {
+		label = label + " " + tag // Keep custom tags such as "!Sub".
+	}
+	from, to := node.oldYAML().Value, node.newYAML().Value
+	if !strings.Contains(from, "\n") && !strings.Contains(to, "\n") {
+		line := process(label+" ", prefixByFn(s.prefix.mod), indentByFn(indent))
+		return s.writeLine(s.palette.mod(line) + s.paintWords(diffWords(from, to)))
+	}
+	header := process(label+"\n", prefixByFn(s.prefix.mod), indentByFn(indent))
+	if _, err := s.writer.Write([]byte(header)); err != nil {
+		return err
+	}
+	return s.writeLineDiffs(diffLines(splitLines(from), splitLines(to)), formatter.nextIndent())
+}
+
+// writeLineDiffs writes the changed lines of a multi-line string, and the unchanged lines that are within the context
+// of the changes. The rest of the unchanged lines are collapsed.
+func (s *treeWriter) writeLineDiffs(lines []lineDiff, indent int) error {
+	for start := 0; start < len(lines); {
+		if !lines[start].unchanged() {
+			if err := s.writeChangedLine(lines[start], indent); err != nil {
+				return err
+			}
+			start++
+			continue
+		}
+		end := start
+		for end < len(lines) && lines[end].unchanged() {
+			end++
+		}
+		head, tail := s.contextLines, s.contextLines
+		if start == 0 {
+			head = 0
+		}
+		if end == len(lines) {
+			tail = 0
+		}
+		if s.fullLists || head+tail >= end-start {
+			head, tail = end-start, 0
+		}
+		for i := start; i < start+head; i++ {
+			if err := s.writeLine(s.palette.faint(process(*lines[i].new, prefixByFn(s.prefix.unchanged), indentByFn(indent)))); err != nil {
+				return err
+			}
+		}
+		if collapsed := end - start - head - tail; collapsed > 0 {
+			content := fmt.Sprintf("(%s)", english.Plural(collapsed, "unchanged line", "unchanged lines"))
+			if err := s.writeLine(s.palette.faint(process(content, indentByFn(indent)))); err != nil {
+				return err
+			}
+		}
+		for i := end - tail; i < end; i++ {
+			if err := s.writeLine(s.palette.faint(process(*lines[i].new, prefixByFn(s.prefix.unchanged), indentByFn(indent)))); err != nil {
+				return err
+			}
+		}
+		start = end
+	}
+	return nil
+}
+
+func (s *treeWriter) writeChangedLine(line lineDiff, indent int) error {
+	switch {
+	case line.old != nil && line.new != nil:
+		words := diffWords(*line.old, *line.new)
+		if hasUnchangedWords(words) {
+			return s.writeLine(s.palette.mod(process("", prefixByFn(s.prefix.mod), indentByFn(indent))) + s.paintWords(words))
+		}
+		// The lines have nothing in common, hence they are written as a deletion followed by an insertion.
+		if err := s.writeLine(s.palette.del(process(*line.old, prefixByFn(s.prefix.del), indentByFn(indent)))); err != nil {
+			return err
+		}
+		return s.writeLine(s.palette.insert(process(*line.new, prefixByFn(s.prefix.add), indentByFn(indent))))
+	case line.old != nil:
+		return s.writeLine(s.palette.del(process(*line.old, prefixByFn(s.prefix.del), indentByFn(indent))))
+	default:
+		return s.writeLine(s.palette.insert(process(*line.new, prefixByFn(s.prefix.add), indentByFn(indent))))
+	}
+}
+
+// paintWords paints the deleted and inserted words. The words are also surrounded by markers if colors are disabled
+// or if the lines are labeled.
+func (s *treeWriter) paintWords(segments []segment) string {
+	bracketed := !s.palette.enabled || s.prefix == labelPrefixes
+	var sb strings.Builder
+	for _, seg := range segments {
+		switch {
+		case seg.kind == segmentDel && bracketed:
+			sb.WriteString(s.palette.del(markerDelStart + seg.text + markerDelEnd))
+		case seg.kind == segmentDel:
+			sb.WriteString(s.palette.del(seg.text))
+		case seg.kind == segmentInsert && bracketed:
+			sb.WriteString(s.palette.insert(markerInsertStart + seg.text + markerInsertEnd))
+		case seg.kind == segmentInsert:
+			sb.WriteString(s.palette.insert(seg.text))
+		default:
+			sb.WriteString(s.palette.mod(seg.text))
+		}
+	}
+	return sb.String()
+}
+
+func (s *treeWriter) writeLine(line string) error {
+	_, err := s.writer.Write([]byte(line + "\n"))
+	return err
+}
+
+// diffLines pairs up the lines of two strings. Lines that are deleted and inserted at the same place are paired
+// up as modified lines.
+func diffLines(from, to []string) []lineDiff {
+	matches := longestCommonSubsequence(from, to, func(i, j int) bool {
+		return from[i] == to[j]
+	})
+	var diffs []lineDiff
+	var i, j int
+	changesUntil := func(untilI, untilJ int) {
+		for ; i < untilI && j < untilJ; i, j = i+1, j+1 {
+			diffs = append(diffs, lineDiff{old: &from[i], new: &to[j]})
+		}
+		for ; i < untilI; i++ {
+			diffs = append(diffs, lineDiff{old: &from[i]})
+		}
+		for ; j < untilJ; j++ {
+			diffs = append(diffs, lineDiff{new: &to[j]})
+		}
+	}
+	for _, match := range matches {
+		changesUntil(match.inA, match.inB)
+		diffs = append(diffs, lineDiff{old: &from[i], new: &to[j]})
+		i, j = i+1, j+1
+	}
+	changesUntil(len(from), len(to))
+	return diffs
+}
+
+// diffWords compares two strings word by word, and returns the runs of unchanged, deleted and inserted words.
+func diffWords(from, to string) []segment {
+	a, b := splitWords(from), splitWords(to)
+	matches := longestCommonSubsequence(a, b, func(i, j int) bool {
+		return a[i] == b[j]
+	})
+	var segments []segment
+	add := func(kind segmentKind, words []string) {
+		if len(words) == 0 {
+			return
+		}
+		text := strings.Join(words, "")
+		if n := len(segments); n > 0 && segments[n-1].kind == kind {
+			segments[n-1].text += text
+			return
+		}
+		segments = append(segments, segment{kind: kind, text: text})
+	}
+	var i, j int
+	for _, match := range matches {
+		add(segmentDel, a[i:match.inA])
+		add(segmentInsert, b[j:match.inB])
+		add(segmentUnchanged, a[match.inA:match.inA+1])
+		i, j = match.inA+1, match.inB+1
+	}
+	add(segmentDel, a[i:])
+	add(segmentInsert, b[j:])
+	return segments
+}
+
+func hasUnchangedWords(segments []segment) bool {
+	for _, seg := range segments {
+		if seg.kind == segmentUnchanged && strings.TrimSpace(seg.text) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// splitWords splits a string into runs of letters and digits, runs of spaces, and single punctuation characters,
+// so that a change to a value in an inline JSON document or a shell command is highlighted on its own.
+func splitWords(s string) []string {
+	var words []string
+	start := 0
+	class := func(r rune) int {
+		switch {
+		case unicode.IsSpace(r):
+			return 0
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_':
+			return 1
+		default:
+			return 2
+		}
+	}
+	prev := -1
+	for i, r := range s {
+		curr := class(r)
+		if i > start && (curr != prev || curr == 2) {
+			words = append(words, s[start:i])
+			start = i
+		}
+		prev = curr
+	}
+	if start < len(s) {
+		words = append(words, s[start:])
+	}
+	return words
+}
+
+func splitLines(s string) []string {
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,199 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Integration_Parse_Write_WithWordDiff(t *testing.T) {
+	old := `
+Resources:
+  Instance:
+    Properties:
+      UserData: |
+        #!/bin/bash
+        yum update -y
+        yum install -y httpd
+        systemctl enable httpd
+        systemctl start httpd
+        echo done
+      PolicyDocument: '{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}'
+      Tags:
+        - !Sub "arn:aws:s3:::${AWS::AccountId}-very-long-bucket-name-for-the-application-artifacts/old/*"
+      Description: short`
+	curr := `
+Resources:
+  Instance:
+    Properties:
+      UserData: |
+        #!/bin/bash
+        yum update -y
+        yum install -y nginx
+        systemctl enable httpd
+        systemctl start httpd
+        echo finished
+        exit 0
+      PolicyDocument: '{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}'
+      Tags:
+        - !Sub "arn:aws:s3:::${AWS::AccountId}-very-long-bucket-name-for-the-application-artifacts/new/*"
+      Description: longer`
+	testCases := map[string]struct {
+		opts   []WriteOption
+		wanted string
+	}{
+		"highlight the changed words and collapse the unchanged lines by default": {
+			wanted: `
+~ Resources/Instance/Properties:
+    ~ Description: short -> longer
+    ~ PolicyDocument: {"Version":"2012-10-17","Statement":[{"Effect":"[-Allow-]{+Deny+}","Action":"s3:GetObject","Resource":"*"}]}
+    ~ Tags:
+        ~ - !Sub arn:aws:s3:::${AWS::AccountId}-very-long-bucket-name-for-the-application-artifacts/[-old-]{+new+}/*
+    ~ UserData:
+        (2 unchanged lines)
+        ~ yum install -y [-httpd-]{+nginx+}
+        (2 unchanged lines)
+        ~ echo [-done-]{+finished+}
+        + exit 0
+`,
+		},
+		"show unchanged lines around the changes": {
+			opts: []WriteOption{WithContext(1)},
+			wanted: `
+~ Resources/Instance/Properties:
+    ~ Description: short -> longer
+    ~ PolicyDocument: {"Version":"2012-10-17","Statement":[{"Effect":"[-Allow-]{+Deny+}","Action":"s3:GetObject","Resource":"*"}]}
+    ~ Tags:
+        ~ - !Sub arn:aws:s3:::${AWS::AccountId}-very-long-bucket-name-for-the-application-artifacts/[-old-]{+new+}/*
+    ~ UserData:
+        (1 unchanged line)
+          yum update -y
+        ~ yum install -y [-httpd-]{+nginx+}
+          systemctl enable httpd
+          systemctl start httpd
+        ~ echo [-done-]{+finished+}
+        + exit 0
+`,
+		},
+		"write the whole strings if word diff is disabled": {
+			opts: []WriteOption{WithWordDiff(false)},
+			wanted: `
+~ Resources/Instance/Properties:
+    ~ Description: short -> longer
+    ~ PolicyDocument: '{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}' -> '{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}'
+    ~ Tags:
+        ~ - !Sub "arn:aws:s3:::${AWS::AccountId}-very-long-bucket-name-for-the-application-artifacts/old/*" -> !Sub "arn:aws:s3:::${AWS::AccountId}-very-long-bucket-name-for-the-application-artifacts/new/*"
+    ~ UserData: |
+    ~     #!/bin/bash
+    ~     yum update -y
+    ~     yum install -y httpd
+    ~     systemctl enable httpd
+    ~     systemctl start httpd
+    ~     echo done -> |
+    ~     #!/bin/bash
+    ~     yum update -y
+    ~     yum install -y nginx
+    ~     systemctl enable httpd
+    ~     systemctl start httpd
+    ~     echo finished
+    ~     exit 0
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotTree, err := From(old).ParseWithCFNOverriders([]byte(curr))
+			require.NoError(t, err)
+
+			buf := strings.Builder{}
+			require.NoError(t, gotTree.Write(&buf, append([]WriteOption{WithColor(false)}, tc.opts...)...))
+			require.Equal(t, strings.TrimPrefix(tc.wanted, "\n"), buf.String())
+		})
+	}
+}
+
+func Test_Integration_Parse_Write_WithWordDiffAndColor(t *testing.T) {
+	old := `
+Script: |
+  echo hello
+  echo world`
+	curr := `
+Script: |
+  echo hi
+  echo world`
+	wanted := "~ Script:\n" +
+		"\x1b[93m    ~ \x1b[0m\x1b[93mecho \x1b[0m\x1b[91mhello\x1b[0m\x1b[92mhi\x1b[0m\n" +
+		"\x1b[2m    (1 unchanged line)\x1b[0m\n"
+	gotTree, err := From(old).Parse([]byte(curr))
+	require.NoError(t, err)
+
+	buf := strings.Builder{}
+	require.NoError(t, gotTree.Write(&buf, WithColor(true)))
+	require.Equal(t, wanted, buf.String())
+}
+
+func Test_Integration_Parse_Write_WithWordDiffAndLabels(t *testing.T) {
+	old := `
+Script: |
+  echo hello
+  echo world`
+	curr := `
+Script: |
+  echo hi
+  echo world`
+	wanted := "CHANGED Script:\n" +
+		"\x1b[93m    CHANGED \x1b[0m\x1b[93mecho \x1b[0m\x1b[91m[-hello-]\x1b[0m\x1b[92m{+hi+}\x1b[0m\n" +
+		"\x1b[2m    (1 unchanged line)\x1b[0m\n"
+	gotTree, err := From(old).Parse([]byte(curr))
+	require.NoError(t, err)
+
+	buf := strings.Builder{}
+	require.NoError(t, gotTree.Write(&buf, WithColor(true), WithLabels()))
+	require.Equal(t, wanted, buf.String())
+}
+
+func Test_diffWords(t *testing.T) {
+	testCases := map[string]struct {
+		from   string
+		to     string
+		wanted []segment
+	}{
+		"change a word in the middle": {
+			from: "yum install -y httpd now",
+			to:   "yum install -y nginx now",
+			wanted: []segment{
+				{kind: segmentUnchanged, text: "yum install -y "},
+				{kind: segmentDel, text: "httpd"},
+				{kind: segmentInsert, text: "nginx"},
+				{kind: segmentUnchanged, text: " now"},
+			},
+		},
+		"append words": {
+			from: "echo",
+			to:   "echo done",
+			wanted: []segment{
+				{kind: segmentUnchanged, text: "echo"},
+				{kind: segmentInsert, text: " done"},
+			},
+		},
+		"split punctuation into its own words": {
+			from: `{"a":1}`,
+			to:   `{"a":2}`,
+			wanted: []segment{
+				{kind: segmentUnchanged, text: `{"a":`},
+				{kind: segmentDel, text: "1"},
+				{kind: segmentInsert, text: "2"},
+				{kind: segmentUnchanged, text: "}"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, diffWords(tc.from, tc.to))
+		})
+	}
+}
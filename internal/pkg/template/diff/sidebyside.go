@@ -0,0 +1,218 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/dustin/go-humanize/english"
+	"golang.org/x/term"
+	"gopkg.in/yaml.v3"
+)
+
+// Gutters in between the two columns of a side-by-side diff, similar to the ones of "sdiff".
+const (
+	gutterUnchanged = "|"
+	gutterMod       = "~"
+	gutterDel       = "<"
+	gutterInsert    = ">"
+)
+
+const (
+	defaultSideBySideWidth = 160
+	minSideBySideWidth     = 40
+)
+
+// WriteSideBySide writes the diff tree to w in two columns, with the old values on the left and the new values on the right.
+// Lines that are longer than a column are wrapped. If width is not positive, the width of the terminal is detected
+// if w is a terminal; otherwise, the width defaults to 160 characters. Only the color and the redaction options apply.
+func (t Tree) WriteSideBySide(w io.Writer, width int, opts ...WriteOption) error {
+	if width <= 0 {
+		width = terminalWidth(w)
+	}
+	if width < minSideBySideWidth {
+		width = minSideBySideWidth
+	}
+	tw := newTreeWriter(w, opts...)
+	sw := &sideBySideWriter{
+		writer:      w,
+		palette:     tw.palette,
+		redactor:    tw.redactor,
+		columnWidth: (width - len(" | ")) / 2,
+	}
+	if t.root == nil {
+		return nil
+	}
+	if len(t.root.children()) == 0 {
+		return sw.writeLeaf(t.root, 0, "")
+	}
+	for _, child := range t.root.children() {
+		if err := sw.writeTree(child, 0, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func terminalWidth(w io.Writer) int {
+	f, ok := w.(*os.File)
+	if !ok || !term.IsTerminal(int(f.Fd())) {
+		return defaultSideBySideWidth
+	}
+	width, _, err := term.GetSize(int(f.Fd()))
+	if err != nil {
+		return defaultSideBySideWidth
+	}
+	return width
+}
+
+// sideBySideWriter writes the string representation of a diff tree in two columns.
+type sideBySideWriter struct {
+	writer      io.Writer
+	palette     palette
+	redactor    redactor
+	columnWidth int
+}
+
+func (s *sideBySideWriter) writeTree(node diffNode, indent int, parentPath string) error {
+	if unchanged, ok := node.(*unchangedNode); ok {
+		content := indentByFn(indent)(fmt.Sprintf("(%s)", english.Plural(unchanged.unchangedCount(), "unchanged item", "unchanged items")))
+		return s.writeRows([]string{content}, []string{content}, gutterUnchanged, s.palette.faint)
+	}
+	if len(node.children()) == 0 {
+		return s.writeLeaf(node, indent, parentPath)
+	}
+	_, isSeqItem := node.(*seqItemNode)
+	label, nextIndent := "- (changed item)", indent+2
+	if !isSeqItem {
+		if kn, ok := node.(*keyNode); ok { // Collapse all key nodes with exactly one diff.
+			node = joinNodes(kn)
+		}
+		if s.redactor.redacts(parentPath, node) {
+			return s.writeLeaf(node, indent, parentPath)
+		}
+		label, nextIndent = node.key()+":", indent+indentInc
+	}
+	label = indentByFn(indent)(label)
+	if err := s.writeRows([]string{label}, []string{label}, gutterUnchanged, fmt.Sprint); err != nil {
+		return err
+	}
+	for _, child := range node.children() {
+		if err := s.writeTree(child, nextIndent, parentPath+"/"+node.key()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sideBySideWriter) writeLeaf(node diffNode, indent int, parentPath string) error {
+	if s.redactor.redacts(parentPath, node) {
+		return s.writeRedacted(node, indent)
+	}
+	_, isSeqItem := node.(*seqItemNode)
+	left, err := sideLines(node.key(), node.oldYAML(), isSeqItem, indent)
+	if err != nil {
+		return err
+	}
+	right, err := sideLines(node.key(), node.newYAML(), isSeqItem, indent)
+	if err != nil {
+		return err
+	}
+	switch {
+	case left != nil && right != nil:
+		return s.writeRows(left, right, gutterMod, s.palette.mod)
+	case left != nil:
+		return s.writeRows(left, right, gutterDel, s.palette.del)
+	default:
+		return s.writeRows(left, right, gutterInsert, s.palette.insert)
+	}
+}
+
+// writeRedacted writes the key of a sensitive node in both columns without its values.
+func (s *sideBySideWriter) writeRedacted(node diffNode, indent int) error {
+	line := indentByFn(indent)(fmt.Sprintf("%s: %s", node.key(), redactedValue))
+	switch {
+	case len(node.children()) != 0 || node.oldYAML() != nil && node.newYAML() != nil:
+		changed := indentByFn(indent)(fmt.Sprintf("%s: %s", node.key(), redactedChange))
+		return s.writeRows([]string{changed}, []string{changed}, gutterMod, s.palette.mod)
+	case node.oldYAML() != nil:
+		return s.writeRows([]string{line}, nil, gutterDel, s.palette.del)
+	default:
+		return s.writeRows(nil, []string{line}, gutterInsert, s.palette.insert)
+	}
+}
+
+// writeRows writes the left and the right lines next to each other, after wrapping them to fit in their columns.
+func (s *sideBySideWriter) writeRows(left, right []string, gutter string, paint func(a ...interface{}) string) error {
+	left, right = wrapLines(left, s.columnWidth), wrapLines(right, s.columnWidth)
+	rows := len(left)
+	if len(right) > rows {
+		rows = len(right)
+	}
+	for i := 0; i < rows; i++ {
+		var l, r string
+		if i < len(left) {
+			l = left[i]
+		}
+		if i < len(right) {
+			r = right[i]
+		}
+		row := strings.TrimRight(fmt.Sprintf("%-*s %s %s", s.columnWidth, l, gutter, r), " ")
+		if _, err := fmt.Fprintln(s.writer, paint(row)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sideLines returns the indented lines of a value in one of the columns, or nil if the value doesn't exist.
+func sideLines(key string, value *yaml.Node, isSeqItem bool, indent int) ([]string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	node := value
+	switch {
+	case isSeqItem:
+		node = &yaml.Node{
+			Kind:    yaml.SequenceNode,
+			Tag:     "!!seq",
+			Content: []*yaml.Node{value},
+		}
+	case key != "":
+		node = &yaml.Node{
+			Kind: yaml.MappingNode,
+			Tag:  "!!map",
+			Content: []*yaml.Node{
+				{
+					Kind:  yaml.ScalarNode,
+					Tag:   "!!str",
+					Value: key,
+				},
+				value,
+			},
+		}
+	}
+	raw, err := yaml.Marshal(node)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Split(processMultiline(string(raw), indentByFn(indent)), "\n"), nil
+}
+
+// wrapLines splits the lines that are longer than width into multiple lines.
+func wrapLines(lines []string, width int) []string {
+	var wrapped []string
+	for _, line := range lines {
+		runes := []rune(line)
+		for len(runes) > width {
+			wrapped = append(wrapped, string(runes[:width]))
+			runes = runes[width:]
+		}
+		wrapped = append(wrapped, string(runes))
+	}
+	return wrapped
+}
@@ -0,0 +1,81 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Integration_Parse_WriteSideBySide(t *testing.T) {
+	testCases := map[string]struct {
+		old    string
+		curr   string
+		width  int
+		wanted string
+	}{
+		"no diff": {
+			old:   `Mary: {Height: 168}`,
+			curr:  `Mary: {Height: 168}`,
+			width: 60,
+		},
+		"document is added": {
+			curr:  `Mary: {Height: 168}`,
+			width: 60,
+			wanted: `
+                             > Mary: {Height: 168}
+`,
+		},
+		"modified, added, and removed entries": {
+			old: `
+Mary:
+  Height: 190
+  Hobby: [swimming, dancing, reading]
+  Weight:
+    kg: 52`,
+			curr: `
+Mary:
+  Height: 168
+  Hobby: [swimming, singing, reading]
+  Likes: bears`,
+			width: 60,
+			wanted: `
+Mary:                        | Mary:
+    Height: 190              ~     Height: 168
+    Hobby:                   |     Hobby:
+        (1 unchanged item)   |         (1 unchanged item)
+        - dancing            ~         - singing
+        (1 unchanged item)   |         (1 unchanged item)
+                             >     Likes: bears
+    Weight:                  <
+        kg: 52               <
+`,
+		},
+		"wrap long values": {
+			old:   `Policy: '{"Effect":"Allow","Action":"s3:GetObject"}'`,
+			curr:  `Policy: '{"Effect":"Deny","Action":"s3:GetObject"}'`,
+			width: 60,
+			wanted: `
+Policy: '{"Effect":"Allow"," ~ Policy: '{"Effect":"Deny","A
+Action":"s3:GetObject"}'     ~ ction":"s3:GetObject"}'
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotTree, err := From(tc.old).Parse([]byte(tc.curr))
+			require.NoError(t, err)
+
+			buf := strings.Builder{}
+			require.NoError(t, gotTree.WriteSideBySide(&buf, tc.width))
+			require.Equal(t, strings.TrimPrefix(tc.wanted, "\n"), buf.String())
+		})
+	}
+}
+
+func TestTerminalWidth(t *testing.T) {
+	require.Equal(t, defaultSideBySideWidth, terminalWidth(&strings.Builder{}))
+}
@@ -0,0 +1,147 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"io"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// StreamWithCFNOverriders writes the differences of a YAML document against the From document to w, with the same
+// overriders as ParseWithCFNOverriders. Unlike ParseWithCFNOverriders followed by Write, the entries under each
+// top-level mapping, such as each resource under "Resources", are compared independently and written as soon as
+// they are compared. Hence, only the diff of one entry is kept in memory at a time.
+// The output is the same as that of Write.
+func (from From) StreamWithCFNOverriders(to []byte, w io.Writer, opts ...WriteOption) error {
+	toNode, fromNode, err := from.unmarshal(to)
+	if err != nil {
+		return err
+	}
+	fromMap, toMap := documentMapping(fromNode), documentMapping(toNode)
+	if fromMap == nil || toMap == nil {
+		// There is nothing to stream if either document is not a mapping.
+		tree, err := parseDocuments(fromNode, toNode, cfnOverriders(cfnIdentityKeys...)...)
+		if err != nil {
+			return err
+		}
+		return tree.Write(w, opts...)
+	}
+	tw := newTreeWriter(w, opts...)
+	sw := &streamWriter{
+		treeWriter:      tw,
+		overriders:      cfnOverriders(cfnIdentityKeys...),
+		resourceTypes:   cfnResourceTypes(toNode, fromNode),
+		autoReplacement: tw.replacements == nil,
+	}
+	if sw.autoReplacement {
+		tw.replacements = make(map[string]bool)
+	}
+	for _, key := range sortedKeys(fromMap, toMap) {
+		if err := sw.streamKey(key, mapValue(fromMap, key), mapValue(toMap, key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const mergeKey = "<<"
+
+// streamWriter compares and writes the entries of a document one at a time.
+type streamWriter struct {
+	*treeWriter
+	overriders      []overrider
+	resourceTypes   map[string]string
+	autoReplacement bool // True if the replaced resources are detected by their properties.
+}
+
+func (s *streamWriter) streamKey(key string, from, to *yaml.Node) error {
+	if !s.streamable(key, from, to) {
+		diff, err := parse(from, to, key, s.overriders...)
+		if err != nil || diff == nil {
+			return err
+		}
+		return s.writeTree(diff, 0, "")
+	}
+	// The first diff is held until the next one is found, so that a single diff is collapsed into its parent path just like Write.
+	var pending diffNode
+	var written bool
+	for _, entryKey := range sortedKeys(from, to) {
+		diff, err := parse(mapValue(from, entryKey), mapValue(to, entryKey), entryKey, s.overriders...)
+		if err != nil {
+			return err
+		}
+		if diff == nil {
+			continue
+		}
+		if key == resourcesKey && s.autoReplacement && requiresReplacement(diff, replacementProperties[s.resourceTypes[entryKey]]) {
+			s.replacements[entryKey] = true
+		}
+		if pending == nil && !written {
+			pending = diff
+			continue
+		}
+		if !written {
+			formatter := &keyedFormatter{prefix: s.prefix}
+			if _, err := s.writer.Write([]byte(formatter.formatPath(&keyNode{keyValue: key}))); err != nil {
+				return err
+			}
+			if err := s.writeTree(pending, formatter.nextIndent(), "/"+key); err != nil {
+				return err
+			}
+			pending, written = nil, true
+		}
+		if err := s.writeTree(diff, indentInc, "/"+key); err != nil {
+			return err
+		}
+	}
+	if pending == nil {
+		return nil
+	}
+	return s.writeTree(&keyNode{keyValue: key, childNodes: []diffNode{pending}}, 0, "")
+}
+
+// streamable returns true if the entries of the mappings under the key can be compared independently.
+func (s *streamWriter) streamable(key string, from, to *yaml.Node) bool {
+	if from == nil || to == nil || from.Kind != yaml.MappingNode || to.Kind != yaml.MappingNode {
+		return false
+	}
+	if mapValue(from, mergeKey) != nil || mapValue(to, mergeKey) != nil {
+		return false // The merged entries are only resolved when the mapping is decoded as a whole.
+	}
+	for _, overrider := range s.overriders {
+		if overrider.match(from, to, key, overrider) {
+			return false
+		}
+	}
+	return true
+}
+
+// documentMapping returns the top-level mapping of a document, or nil if the document is not a mapping.
+func documentMapping(doc *yaml.Node) *yaml.Node {
+	if doc.Kind == yaml.DocumentNode && len(doc.Content) > 0 {
+		doc = doc.Content[0]
+	}
+	if doc.Kind != yaml.MappingNode {
+		return nil
+	}
+	return doc
+}
+
+// sortedKeys returns the sorted union of the keys in the mapping nodes.
+func sortedKeys(a, b *yaml.Node) []string {
+	keys := make(map[string]struct{})
+	for _, node := range []*yaml.Node{a, b} {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			keys[node.Content[i].Value] = struct{}{}
+		}
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
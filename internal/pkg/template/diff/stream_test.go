@@ -0,0 +1,169 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrom_StreamWithCFNOverriders(t *testing.T) {
+	testCases := map[string]struct {
+		old  string
+		curr string
+		opts []WriteOption
+	}{
+		"no diff": {
+			old:  `Resources: {Bucket: {Type: AWS::S3::Bucket}}`,
+			curr: `Resources: {Bucket: {Type: AWS::S3::Bucket}}`,
+		},
+		"old document is empty": {
+			curr: `Resources: {Bucket: {Type: AWS::S3::Bucket}}`,
+		},
+		"single modified resource is collapsed into its path": {
+			old: `
+Resources:
+  Cluster:
+    Type: AWS::ECS::Cluster
+    Properties:
+      ClusterName: test
+  Bucket:
+    Type: AWS::S3::Bucket`,
+			curr: `
+Resources:
+  Cluster:
+    Type: AWS::ECS::Cluster
+    Properties:
+      ClusterName: prod
+  Bucket:
+    Type: AWS::S3::Bucket`,
+		},
+		"multiple top-level keys and resources": {
+			old: `
+AWSTemplateFormatVersion: '2010-09-09'
+Description: old
+Metadata:
+  Manifest: |
+    name: api
+Parameters:
+  EnvName:
+    Type: String
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 1
+      ServiceName: api
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      ContainerDefinitions:
+        - Name: api
+          Image: nginx:1
+        - Name: sidecar
+          Image: envoy
+  Queue:
+    Type: AWS::SQS::Queue
+Outputs:
+  ServiceName:
+    Value: !GetAtt Service.Name`,
+			curr: `
+AWSTemplateFormatVersion: '2010-09-09'
+Description: new
+Metadata:
+  Manifest: |
+    name: web
+Parameters:
+  EnvName:
+    Type: String
+  AppName:
+    Type: String
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 2
+      ServiceName: web
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      ContainerDefinitions:
+        - Name: api
+          Image: nginx:2
+        - Name: sidecar
+          Image: envoy
+  Topic:
+    Type: AWS::SNS::Topic
+Outputs:
+  ServiceName:
+    Value:
+      Fn::GetAtt: [Service, Name]`,
+		},
+		"merge keys": {
+			old: `
+Defaults: &defaults
+  Timeout: 10
+Resources:
+  <<: *defaults
+  Bucket: {Type: AWS::S3::Bucket}`,
+			curr: `
+Defaults: &defaults
+  Timeout: 20
+Resources:
+  <<: *defaults
+  Bucket: {Type: AWS::S3::Bucket}`,
+		},
+		"with write options": {
+			old:  `Resources: {Service: {Type: AWS::ECS::Service}, Queue: {Type: AWS::SQS::Queue, Properties: {Tags: [a, b, c, d]}}}`,
+			curr: `Resources: {Service: {Type: AWS::ECS::Service, Properties: {Cpu: 256}}, Queue: {Type: AWS::SQS::Queue, Properties: {Tags: [a, b, C, d]}}}`,
+			opts: []WriteOption{WithContext(1), WithReplacements("Queue"), WithColor(true)},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tree, err := From(tc.old).ParseWithCFNOverriders([]byte(tc.curr))
+			require.NoError(t, err)
+			wanted := strings.Builder{}
+			require.NoError(t, tree.Write(&wanted, tc.opts...))
+
+			got := strings.Builder{}
+			require.NoError(t, From(tc.old).StreamWithCFNOverriders([]byte(tc.curr), &got, tc.opts...))
+			require.Equal(t, wanted.String(), got.String())
+		})
+	}
+}
+
+func BenchmarkFrom_StreamWithCFNOverriders(b *testing.B) {
+	old, curr := largeTemplate(2000, "nginx:1"), largeTemplate(2000, "nginx:2")
+	b.Run("parse and write", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			tree, err := From(old).ParseWithCFNOverriders([]byte(curr))
+			require.NoError(b, err)
+			require.NoError(b, tree.Write(&strings.Builder{}))
+		}
+	})
+	b.Run("stream", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			require.NoError(b, From(old).StreamWithCFNOverriders([]byte(curr), &strings.Builder{}))
+		}
+	})
+}
+
+func largeTemplate(resources int, image string) string {
+	var sb strings.Builder
+	sb.WriteString("Resources:\n")
+	for i := 0; i < resources; i++ {
+		fmt.Fprintf(&sb, `  TaskDefinition%d:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      ContainerDefinitions:
+        - Name: main
+          Image: %s
+`, i, image)
+	}
+	return sb.String()
+}
@@ -0,0 +1,166 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"fmt"
+
+	"github.com/dustin/go-humanize/english"
+	"gopkg.in/yaml.v3"
+)
+
+const resourcesKey = "Resources"
+
+// ChangeCount is the number of added, removed, and modified entries.
+type ChangeCount struct {
+	Added    int
+	Removed  int
+	Modified int
+}
+
+// IsEmpty returns true if nothing is changed.
+func (c ChangeCount) IsEmpty() bool {
+	return c.Added == 0 && c.Removed == 0 && c.Modified == 0
+}
+
+// Summary is the number of changed entries grouped by the top-level keys of a document.
+// For example, Summary["Resources"] counts the resources that are added, removed, or modified in a CloudFormation template.
+// A top-level key with a scalar or a list value counts as one entry.
+type Summary map[string]ChangeCount
+
+// IsEmpty returns true if there is no change in the summary.
+func (s Summary) IsEmpty() bool {
+	for _, count := range s {
+		if !count.IsEmpty() {
+			return false
+		}
+	}
+	return true
+}
+
+// Resources returns the number of changed CloudFormation resources.
+func (s Summary) Resources() ChangeCount {
+	return s[resourcesKey]
+}
+
+// String returns a one-line description of the changed CloudFormation resources.
+// For example, "3 resources added, 1 modified, 0 removed".
+func (s Summary) String() string {
+	count := s.Resources()
+	return fmt.Sprintf("%s added, %d modified, %d removed",
+		english.Plural(count.Added, "resource", "resources"), count.Modified, count.Removed)
+}
+
+// HasChanges returns true if there is any difference between the documents.
+func (t Tree) HasChanges() bool {
+	return t.root != nil
+}
+
+// ChangeKinds returns the distinct actions of the leaf changes in the tree,
+// in the order of ChangeActionAdd, ChangeActionRemove, and ChangeActionModify.
+func (t Tree) ChangeKinds() []string {
+	found := make(map[string]bool)
+	collectChangeKinds(t.root, found)
+	var kinds []string
+	for _, kind := range []string{ChangeActionAdd, ChangeActionRemove, ChangeActionModify} {
+		if found[kind] {
+			kinds = append(kinds, kind)
+		}
+	}
+	return kinds
+}
+
+func collectChangeKinds(node diffNode, found map[string]bool) {
+	if node == nil {
+		return
+	}
+	if _, ok := node.(*unchangedNode); ok {
+		return
+	}
+	if len(node.children()) != 0 {
+		for _, child := range node.children() {
+			collectChangeKinds(child, found)
+		}
+		return
+	}
+	switch {
+	case node.oldYAML() != nil && node.newYAML() != nil:
+		found[ChangeActionModify] = true
+	case node.oldYAML() != nil:
+		found[ChangeActionRemove] = true
+	default:
+		found[ChangeActionAdd] = true
+	}
+}
+
+// Summary returns the number of changed entries under each top-level key of the document.
+func (t Tree) Summary() Summary {
+	summary := make(Summary)
+	if t.root == nil {
+		return summary
+	}
+	if len(t.root.children()) == 0 {
+		// The entire document is added or removed.
+		countDocument(summary, t.root.oldYAML(), func(c *ChangeCount) { c.Removed++ })
+		countDocument(summary, t.root.newYAML(), func(c *ChangeCount) { c.Added++ })
+		return summary
+	}
+	for _, group := range t.root.children() {
+		var count ChangeCount
+		if len(group.children()) == 0 {
+			count = countLeaf(group)
+		}
+		for _, entry := range group.children() {
+			if len(entry.children()) != 0 {
+				count.Modified++
+				continue
+			}
+			leaf := countLeaf(entry)
+			count.Added += leaf.Added
+			count.Removed += leaf.Removed
+			count.Modified += leaf.Modified
+		}
+		summary[group.key()] = count
+	}
+	return summary
+}
+
+// countLeaf counts a leaf node as one added, removed, or modified entry.
+// If an entire map is added or removed, each of its entries is counted instead.
+func countLeaf(node diffNode) ChangeCount {
+	switch {
+	case node.oldYAML() != nil && node.newYAML() != nil:
+		return ChangeCount{Modified: 1}
+	case node.oldYAML() != nil:
+		return ChangeCount{Removed: countEntries(node.oldYAML())}
+	default:
+		return ChangeCount{Added: countEntries(node.newYAML())}
+	}
+}
+
+func countEntries(node *yaml.Node) int {
+	if node.Kind == yaml.MappingNode {
+		return len(node.Content) / 2
+	}
+	return 1
+}
+
+func countDocument(summary Summary, doc *yaml.Node, inc func(c *ChangeCount)) {
+	if doc == nil {
+		return
+	}
+	if doc.Kind == yaml.DocumentNode && len(doc.Content) > 0 {
+		doc = doc.Content[0]
+	}
+	if doc.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		count := summary[doc.Content[i].Value]
+		for j := 0; j < countEntries(doc.Content[i+1]); j++ {
+			inc(&count)
+		}
+		summary[doc.Content[i].Value] = count
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTree_Summary(t *testing.T) {
+	testCases := map[string]struct {
+		old          string
+		curr         string
+		wanted       Summary
+		wantedString string
+	}{
+		"no diff": {
+			old:          `Resources: {Bucket: {Type: AWS::S3::Bucket}}`,
+			curr:         `Resources: {Bucket: {Type: AWS::S3::Bucket}}`,
+			wanted:       Summary{},
+			wantedString: "0 resources added, 0 modified, 0 removed",
+		},
+		"from is empty": {
+			curr: `
+Description: a template
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+  Queue:
+    Type: AWS::SQS::Queue`,
+			wanted: Summary{
+				"Description": {Added: 1},
+				"Resources":   {Added: 2},
+			},
+			wantedString: "2 resources added, 0 modified, 0 removed",
+		},
+		"changes grouped by top-level keys": {
+			old: `
+Description: old template
+Parameters:
+  AppName:
+    Type: String
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 1
+  Queue:
+    Type: AWS::SQS::Queue`,
+			curr: `
+Description: new template
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 2
+  Topic:
+    Type: AWS::SNS::Topic
+  Function:
+    Type: AWS::Lambda::Function
+Outputs:
+  TopicArn:
+    Value: !Ref Topic`,
+			wanted: Summary{
+				"Description": {Modified: 1},
+				"Outputs":     {Added: 1},
+				"Parameters":  {Removed: 1},
+				"Resources":   {Added: 2, Modified: 1, Removed: 1},
+			},
+			wantedString: "2 resources added, 1 modified, 1 removed",
+		},
+		"a resource is changed to a scalar": {
+			old:  `Resources: {Bucket: {Type: AWS::S3::Bucket}}`,
+			curr: `Resources: {Bucket: oops}`,
+			wanted: Summary{
+				"Resources": {Modified: 1},
+			},
+			wantedString: "0 resources added, 1 modified, 0 removed",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tree, err := From(tc.old).ParseWithCFNOverriders([]byte(tc.curr))
+			require.NoError(t, err)
+
+			got := tree.Summary()
+			require.Equal(t, tc.wanted, got)
+			require.Equal(t, tc.wantedString, got.String())
+			require.Equal(t, len(tc.wanted) == 0, got.IsEmpty())
+		})
+	}
+}
+
+func TestTree_HasChanges_ChangeKinds(t *testing.T) {
+	testCases := map[string]struct {
+		old  string
+		curr string
+
+		wantedHasChanges bool
+		wantedKinds      []string
+	}{
+		"no diff": {
+			old:  `Mary: {Height: 168, Hobby: [swimming]}`,
+			curr: `Mary: {Height: 168, Hobby: [swimming]}`,
+		},
+		"only modifications": {
+			old:              `Mary: {Height: 168, Hobby: [swimming, dancing]}`,
+			curr:             `Mary: {Height: 190, Hobby: [swimming, singing]}`,
+			wantedHasChanges: true,
+			wantedKinds:      []string{ChangeActionModify},
+		},
+		"all kinds of changes": {
+			old:              `Mary: {Height: 168, Weight: 52, Hobby: [swimming]}`,
+			curr:             `Mary: {Height: 190, Likes: bears, Hobby: [swimming]}`,
+			wantedHasChanges: true,
+			wantedKinds:      []string{ChangeActionAdd, ChangeActionRemove, ChangeActionModify},
+		},
+		"document is removed": {
+			old:              `Mary: {Height: 168}`,
+			wantedHasChanges: true,
+			wantedKinds:      []string{ChangeActionRemove},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tree, err := From(tc.old).Parse([]byte(tc.curr))
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHasChanges, tree.HasChanges())
+			require.Equal(t, tc.wantedKinds, tree.ChangeKinds())
+		})
+	}
+}
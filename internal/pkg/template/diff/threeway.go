@@ -0,0 +1,104 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+const (
+	headerDrift = "Changes made outside of Copilot:"
+	headerLocal = "Changes from your manifest:"
+)
+
+// ThreeWayTree represents the differences of a deployed document and a current document against their common base document.
+// For example, the base document is the template that Copilot last generated, the deployed document is the template of
+// the deployed stack, and the current document is the template generated from the updated manifest.
+type ThreeWayTree struct {
+	drift Tree
+	local Tree
+}
+
+// ParseThreeWay constructs the diff trees of a deployed and a current YAML document against the From document, which is
+// their common base, with the same overriders as ParseWithCFNOverriders.
+func (base From) ParseThreeWay(deployed, curr []byte) (ThreeWayTree, error) {
+	drift, err := base.ParseWithCFNOverriders(deployed)
+	if err != nil {
+		return ThreeWayTree{}, fmt.Errorf("parse the diff of the deployed document: %w", err)
+	}
+	local, err := base.ParseWithCFNOverriders(curr)
+	if err != nil {
+		return ThreeWayTree{}, fmt.Errorf("parse the diff of the current document: %w", err)
+	}
+	return ThreeWayTree{
+		drift: drift,
+		local: local,
+	}, nil
+}
+
+// Drift returns the diff tree of the deployed document against the base document, i.e. the changes made out of band.
+func (t ThreeWayTree) Drift() Tree {
+	return t.drift
+}
+
+// Local returns the diff tree of the current document against the base document.
+func (t ThreeWayTree) Local() Tree {
+	return t.local
+}
+
+// DriftedResources returns the sorted logical IDs of the resources that are changed in the deployed document.
+func (t ThreeWayTree) DriftedResources() []string {
+	return resourceIDs(t.drift)
+}
+
+// Conflicts returns the sorted logical IDs of the resources that are changed in both the deployed and the current
+// document. Deploying the current document overwrites the out-of-band changes to these resources.
+func (t ThreeWayTree) Conflicts() []string {
+	local := make(map[string]bool)
+	for _, id := range resourceIDs(t.local) {
+		local[id] = true
+	}
+	var ids []string
+	for _, id := range resourceIDs(t.drift) {
+		if local[id] {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// Write writes the out-of-band changes followed by the changes in the current document to w.
+// A section is omitted if there is no change in it.
+func (t ThreeWayTree) Write(w io.Writer, opts ...WriteOption) error {
+	sections := []struct {
+		header string
+		tree   Tree
+	}{
+		{header: headerDrift, tree: t.drift},
+		{header: headerLocal, tree: t.local},
+	}
+	for _, section := range sections {
+		if section.tree.root == nil {
+			continue
+		}
+		if _, err := fmt.Fprintln(w, section.header); err != nil {
+			return err
+		}
+		if err := section.tree.Write(w, opts...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceIDs(t Tree) []string {
+	var ids []string
+	for _, resource := range t.resourceNodes() {
+		ids = append(ids, resource.key())
+	}
+	sort.Strings(ids)
+	return ids
+}
@@ -0,0 +1,116 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrom_ParseThreeWay(t *testing.T) {
+	base := `
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 1
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 30
+  Queue:
+    Type: AWS::SQS::Queue`
+	testCases := map[string]struct {
+		deployed string
+		curr     string
+
+		wantedDrifted   []string
+		wantedConflicts []string
+		wanted          string
+	}{
+		"no changes": {
+			deployed: base,
+			curr:     base,
+		},
+		"only changes from the manifest": {
+			deployed: base,
+			curr: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 2
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 30
+  Queue:
+    Type: AWS::SQS::Queue`,
+			wanted: `
+Changes from your manifest:
+~ Resources/Service/Properties:
+    ~ DesiredCount: 1 -> 2
+`,
+		},
+		"separate out-of-band changes from changes from the manifest": {
+			deployed: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 3
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 7
+  Queue:
+    Type: AWS::SQS::Queue`,
+			curr: `
+Resources:
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 2
+  LogGroup:
+    Type: AWS::Logs::LogGroup
+    Properties:
+      RetentionInDays: 30`,
+			wantedDrifted:   []string{"LogGroup", "Service"},
+			wantedConflicts: []string{"Service"},
+			wanted: `
+Changes made outside of Copilot:
+~ Resources:
+    ~ LogGroup/Properties:
+        ~ RetentionInDays: 30 -> 7
+    ~ Service/Properties:
+        ~ DesiredCount: 1 -> 3
+Changes from your manifest:
+~ Resources:
+    - Queue:
+    -     Type: AWS::SQS::Queue
+    ~ Service/Properties:
+        ~ DesiredCount: 1 -> 2
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := From(base).ParseThreeWay([]byte(tc.deployed), []byte(tc.curr))
+			require.NoError(t, err)
+
+			require.Equal(t, tc.wantedDrifted, got.DriftedResources())
+			require.Equal(t, tc.wantedConflicts, got.Conflicts())
+			buf := strings.Builder{}
+			require.NoError(t, got.Write(&buf))
+			require.Equal(t, strings.TrimPrefix(tc.wanted, "\n"), buf.String())
+		})
+	}
+}
+
+func TestFrom_ParseThreeWay_Error(t *testing.T) {
+	_, err := From(`Resources: {}`).ParseThreeWay([]byte(`!!!???what a weird template`), []byte(`Resources: {}`))
+	require.ErrorContains(t, err, "parse the diff of the deployed document")
+}
@@ -6,23 +6,142 @@ package diff
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/dustin/go-humanize/english"
+	fcolor "github.com/fatih/color"
+	"gopkg.in/yaml.v3"
 )
 
-const (
-	prefixAdd = "+"
-	prefixDel = "-"
-	prefixMod = "~"
+const indentInc = 4
+
+// prefixes denote the kind of change of the lines of a diff.
+type prefixes struct {
+	add, del, mod, unchanged string
+}
+
+var (
+	symbolPrefixes = prefixes{add: "+", del: "-", mod: "~", unchanged: " "}
+	// labelPrefixes are padded to the same width, so that the lines of a diff stay aligned.
+	labelPrefixes = prefixes{add: "ADDED  ", del: "REMOVED", mod: "CHANGED", unchanged: "       "}
 )
 
-const indentInc = 4
+// WriteOption configures how a diff tree is written.
+type WriteOption func(tw *treeWriter)
+
+// WithColor enables or disables coloring the lines of a diff: insertions are green, deletions are red and
+// modifications are yellow. By default, lines are colored only if the writer is a terminal that supports colors.
+func WithColor(enabled bool) WriteOption {
+	return func(tw *treeWriter) {
+		tw.palette = newPalette(enabled)
+	}
+}
+
+// WithLabels prefixes the lines of a diff with the words "ADDED", "REMOVED" and "CHANGED" instead of the symbols
+// "+", "-" and "~", and brackets the changed words of long strings even if they're colored, so that screen readers
+// announce the kind of every change. By default, lines are labeled only in the accessibility mode.
+func WithLabels() WriteOption {
+	return func(tw *treeWriter) {
+		tw.prefix = labelPrefixes
+	}
+}
+
+// WithContext shows up to n unchanged items before and after each change in a list, similar to "git diff -U<n>".
+// The rest of the unchanged items are collapsed. By default, all unchanged items are collapsed.
+func WithContext(n int) WriteOption {
+	return func(tw *treeWriter) {
+		tw.contextLines = n
+	}
+}
+
+// WithFullLists shows all the unchanged items in a list instead of collapsing them.
+func WithFullLists() WriteOption {
+	return func(tw *treeWriter) {
+		tw.fullLists = true
+	}
+}
+
+// WithReplacements marks the resources with the logical IDs as replaced, for example according to the changes of a
+// CloudFormation change set, instead of the properties that are known to require replacement upon update.
+func WithReplacements(logicalIDs ...string) WriteOption {
+	return func(tw *treeWriter) {
+		tw.replacements = make(map[string]bool)
+		for _, id := range logicalIDs {
+			tw.replacements[id] = true
+		}
+	}
+}
+
+// WithRedactedKeys hides the values under the keys that match any of the patterns, in addition to the default
+// patterns "*Password", "*Secret", "*SecretString", "*Token", and "*ApiKey". The patterns are matched case-insensitively
+// with the syntax of path.Match, for example "*Credentials".
+func WithRedactedKeys(patterns ...string) WriteOption {
+	return func(tw *treeWriter) {
+		tw.redactor.patterns = append(tw.redactor.patterns, patterns...)
+	}
+}
+
+// palette paints the lines of a diff.
+type palette struct {
+	insert func(a ...interface{}) string
+	del    func(a ...interface{}) string
+	mod    func(a ...interface{}) string
+	faint  func(a ...interface{}) string
+
+	enabled bool // True if the palette paints with colors.
+}
+
+func newPalette(enabled bool) palette {
+	if !enabled {
+		return palette{insert: fmt.Sprint, del: fmt.Sprint, mod: fmt.Sprint, faint: fmt.Sprint}
+	}
+	return palette{
+		insert:  alwaysColor(color.Green),
+		del:     alwaysColor(color.Red),
+		mod:     alwaysColor(color.Yellow),
+		faint:   alwaysColor(color.Faint),
+		enabled: true,
+	}
+}
+
+// alwaysColor returns a function that colors its input with c even if colors are disabled for stdout.
+func alwaysColor(c *fcolor.Color) func(a ...interface{}) string {
+	always := *c
+	always.EnableColor()
+	return always.SprintFunc()
+}
 
 // treeWriter writes the string representation of a diff tree.
 type treeWriter struct {
-	tree   Tree
-	writer io.Writer
+	tree     Tree
+	writer   io.Writer
+	palette  palette
+	prefix   prefixes
+	redactor redactor
+
+	contextLines int
+	fullLists    bool
+	wordDiff     bool
+	replacements map[string]bool // The logical IDs of the resources to mark as replaced.
+}
+
+// newTreeWriter returns a treeWriter that writes to w with the default settings overridden by opts.
+func newTreeWriter(w io.Writer, opts ...WriteOption) *treeWriter {
+	tw := &treeWriter{
+		writer:   w,
+		palette:  newPalette(color.EnabledFor(w)),
+		prefix:   symbolPrefixes,
+		redactor: newRedactor(),
+		wordDiff: true,
+	}
+	if color.Accessible() {
+		tw.prefix = labelPrefixes
+	}
+	for _, opt := range opts {
+		opt(tw)
+	}
+	return tw
 }
 
 // write uses the writer to writeTree the string representation of the diff tree stemmed from the root.
@@ -31,43 +150,59 @@ func (s *treeWriter) write() error {
 		return nil // Return without writing anything.
 	}
 	if len(s.tree.root.children()) == 0 {
-		return s.writeLeaf(s.tree.root, &documentFormatter{})
+		return s.writeLeaf(s.tree.root, &documentFormatter{prefix: s.prefix})
 	}
 	for _, child := range s.tree.root.children() {
-		if err := s.writeTree(child, 0); err != nil {
+		if err := s.writeTree(child, 0, ""); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (s *treeWriter) writeTree(node diffNode, indent int) error {
+// writeTree writes the node and its descendants. parentPath is the slash-separated keys from the root to the parent of the node.
+func (s *treeWriter) writeTree(node diffNode, indent int, parentPath string) error {
 	if node == nil {
 		return nil
 	}
 	var formatter formatter
 	switch node := node.(type) {
 	case *unchangedNode:
-		content := fmt.Sprintf("(%s)", english.Plural(node.unchangedCount(), "unchanged item", "unchanged items"))
-		content = process(content, indentByFn(indent))
-		_, err := s.writer.Write([]byte(color.Faint.Sprint(content + "\n")))
-		return err
+		return s.writeUnchanged(node, &seqItemFormatter{indent: indent, faint: s.palette.faint, prefix: s.prefix}, false, false)
 	case *seqItemNode:
-		formatter = &seqItemFormatter{indent}
+		formatter = &seqItemFormatter{indent: indent, faint: s.palette.faint, prefix: s.prefix}
 	default:
-		formatter = &keyedFormatter{indent}
+		formatter = &keyedFormatter{indent: indent, prefix: s.prefix}
+	}
+	if s.redactor.redacts(parentPath, node) {
+		return s.writeRedacted(node, indent)
 	}
 	if len(node.children()) == 0 {
 		return s.writeLeaf(node, formatter)
 	}
+	replaced := false
 	if kn, ok := node.(*keyNode); ok { // Collapse all key nodes with exactly one diff.
 		node = joinNodes(kn)
+		if s.redactor.redacts(parentPath, node) {
+			return s.writeRedacted(node, indent)
+		}
+		replaced = isReplacedResource(parentPath, node.key(), s.replacements)
+	}
+	path := formatter.formatPath(node)
+	if replaced {
+		path = strings.TrimSuffix(path, "\n") + " " + s.palette.del(markerReplacement) + "\n"
 	}
-	if _, err := s.writer.Write([]byte(formatter.formatPath(node))); err != nil {
+	if _, err := s.writer.Write([]byte(path)); err != nil {
 		return err
 	}
-	for _, child := range node.children() {
-		err := s.writeTree(child, formatter.nextIndent())
+	for idx, child := range node.children() {
+		var err error
+		if unchanged, ok := child.(*unchangedNode); ok {
+			isFirst, isLast := idx == 0, idx == len(node.children())-1
+			err = s.writeUnchanged(unchanged, &seqItemFormatter{indent: formatter.nextIndent(), faint: s.palette.faint, prefix: s.prefix}, isFirst, isLast)
+		} else {
+			err = s.writeTree(child, formatter.nextIndent(), parentPath+"/"+node.key())
+		}
 		if err != nil {
 			return err
 		}
@@ -75,6 +210,52 @@ func (s *treeWriter) writeTree(node diffNode, indent int) error {
 	return nil
 }
 
+// writeUnchanged writes the unchanged items in a list that are within the context of the changes, and collapses the rest.
+// isFirst and isLast denote whether the items are at the beginning and the end of the list, where there is no change
+// before or after the items respectively.
+func (s *treeWriter) writeUnchanged(node *unchangedNode, formatter *seqItemFormatter, isFirst, isLast bool) error {
+	items := node.unchangedItems()
+	head, tail := s.contextLines, s.contextLines
+	if isFirst {
+		head = 0
+	}
+	if isLast {
+		tail = 0
+	}
+	if len(items) != node.unchangedCount() {
+		// The items are not available, hence they can only be collapsed.
+		head, tail = 0, 0
+	} else if s.fullLists || head+tail >= len(items) {
+		head, tail = len(items), 0
+	}
+	for i := 0; i < head; i++ {
+		if err := s.writeUnchangedItem(&items[i], formatter); err != nil {
+			return err
+		}
+	}
+	if collapsed := node.unchangedCount() - head - tail; collapsed > 0 {
+		content := fmt.Sprintf("(%s)", english.Plural(collapsed, "unchanged item", "unchanged items"))
+		content = process(content, indentByFn(formatter.indent))
+		if err := s.writeLines(content, s.palette.faint); err != nil {
+			return err
+		}
+	}
+	for i := len(items) - tail; i < len(items); i++ {
+		if err := s.writeUnchangedItem(&items[i], formatter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *treeWriter) writeUnchangedItem(item *yaml.Node, formatter *seqItemFormatter) error {
+	content, err := formatter.formatUnchanged(item)
+	if err != nil {
+		return err
+	}
+	return s.writeLines(content, s.palette.faint)
+}
+
 func (s *treeWriter) writeLeaf(node diffNode, formatter formatter) error {
 	switch {
 	case node.oldYAML() != nil && node.newYAML() != nil:
@@ -93,12 +274,14 @@ func (s *treeWriter) writeMod(node diffNode, formatter formatter) error {
 		}
 		return s.writeInsert(node, formatter)
 	}
+	if s.wordDiff && wordDiffable(node, formatter) {
+		return s.writeWordDiff(node, formatter)
+	}
 	content, err := formatter.formatMod(node)
 	if err != nil {
 		return err
 	}
-	_, err = s.writer.Write([]byte(color.Yellow.Sprint(content + "\n")))
-	return err
+	return s.writeLines(content, s.palette.mod)
 }
 
 func (s *treeWriter) writeDel(node diffNode, formatter formatter) error {
@@ -106,8 +289,7 @@ func (s *treeWriter) writeDel(node diffNode, formatter formatter) error {
 	if err != nil {
 		return err
 	}
-	_, err = s.writer.Write([]byte(color.Red.Sprint(content + "\n")))
-	return err
+	return s.writeLines(content, s.palette.del)
 }
 
 func (s *treeWriter) writeInsert(node diffNode, formatter formatter) error {
@@ -115,7 +297,31 @@ func (s *treeWriter) writeInsert(node diffNode, formatter formatter) error {
 	if err != nil {
 		return err
 	}
-	_, err = s.writer.Write([]byte(color.Green.Sprint(content + "\n")))
+	return s.writeLines(content, s.palette.insert)
+}
+
+// writeRedacted writes the key of a sensitive node without its values.
+func (s *treeWriter) writeRedacted(node diffNode, indent int) error {
+	switch {
+	case len(node.children()) != 0 || node.oldYAML() != nil && node.newYAML() != nil:
+		content := process(fmt.Sprintf("%s: %s", node.key(), redactedChange), prefixByFn(s.prefix.mod), indentByFn(indent))
+		return s.writeLines(content, s.palette.mod)
+	case node.oldYAML() != nil:
+		content := process(fmt.Sprintf("%s: %s", node.key(), redactedValue), prefixByFn(s.prefix.del), indentByFn(indent))
+		return s.writeLines(content, s.palette.del)
+	default:
+		content := process(fmt.Sprintf("%s: %s", node.key(), redactedValue), prefixByFn(s.prefix.add), indentByFn(indent))
+		return s.writeLines(content, s.palette.insert)
+	}
+}
+
+// writeLines paints each line of the content separately, so that the colors are preserved when the output is paged.
+func (s *treeWriter) writeLines(content string, paint func(a ...interface{}) string) error {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		lines[i] = paint(line)
+	}
+	_, err := s.writer.Write([]byte(strings.Join(lines, "\n") + "\n"))
 	return err
 }
 
@@ -404,3 +404,495 @@ Metadata:
 		})
 	}
 }
+
+func Test_Integration_Parse_Write_WithIdentityKeys(t *testing.T) {
+	testCases := map[string]struct {
+		curr   string
+		old    string
+		wanted string
+	}{
+		"modify an item in a list of maps after an insertion": {
+			old: `
+ContainerDefinitions:
+  - Name: nginx
+    Image: nginx:1.24
+  - Name: envoy
+    Image: envoy:v1.25
+    Essential: true`,
+			curr: `
+ContainerDefinitions:
+  - Name: nginx
+    Image: nginx:1.24
+  - Name: firelens
+    Image: fluent-bit:2.1
+  - Name: envoy
+    Image: envoy:v1.26
+    Essential: true`,
+			wanted: `
+~ ContainerDefinitions:
+    (1 unchanged item)
+    + - Name: firelens
+    +   Image: fluent-bit:2.1
+    ~ - (changed item)
+      ~ Image: envoy:v1.25 -> envoy:v1.26
+`,
+		},
+		"modify an item in a list of maps after a deletion": {
+			old: `
+Environment:
+  - Name: LOG_LEVEL
+    Value: info
+  - Name: COPILOT_APPLICATION_NAME
+    Value: phonetool
+  - Name: COPILOT_ENVIRONMENT_NAME
+    Value: test`,
+			curr: `
+Environment:
+  - Name: COPILOT_APPLICATION_NAME
+    Value: phonetool
+  - Name: COPILOT_ENVIRONMENT_NAME
+    Value: prod`,
+			wanted: `
+~ Environment:
+    - - Name: LOG_LEVEL
+    -   Value: info
+    (1 unchanged item)
+    ~ - (changed item)
+      ~ Value: test -> prod
+`,
+		},
+		"items with different identities are not paired": {
+			old: `
+Tags:
+  - Key: copilot-application
+    Value: phonetool`,
+			curr: `
+Tags:
+  - Key: copilot-environment
+    Value: phonetool`,
+			wanted: `
+~ Tags:
+    - - Key: copilot-application
+    -   Value: phonetool
+    + - Key: copilot-environment
+    +   Value: phonetool
+`,
+		},
+		"no diff": {
+			old: `
+Tags:
+  - Key: copilot-application
+    Value: phonetool`,
+			curr: `
+Tags:
+  - Key: copilot-application
+    Value: phonetool`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotTree, err := From(tc.old).Parse([]byte(tc.curr), WithIdentityKeys("Name", "Key"))
+			require.NoError(t, err)
+			buf := strings.Builder{}
+			err = gotTree.Write(&buf)
+			require.NoError(t, err)
+			require.Equal(t, strings.TrimPrefix(tc.wanted, "\n"), buf.String())
+		})
+	}
+}
+
+func Test_Integration_ParseWithCFNOverriders_Write_WithIdentityKeys(t *testing.T) {
+	old := `
+Rules:
+  - Id: expire-logs
+    Prefix: logs/
+  - Id: expire-tmp
+    Prefix: tmp/`
+	curr := `
+Rules:
+  - Id: archive
+    Prefix: archive/
+  - Id: expire-tmp
+    Prefix: temp/`
+	testCases := map[string]struct {
+		opts   []ParseOption
+		wanted string
+	}{
+		"pair the items by custom identity keys": {
+			opts: []ParseOption{WithIdentityKeys("Id")},
+			wanted: `
+~ Rules:
+    - - Id: expire-logs
+    -   Prefix: logs/
+    + - Id: archive
+    +   Prefix: archive/
+    ~ - (changed item)
+      ~ Prefix: tmp/ -> temp/
+`,
+		},
+		"pair the items by position without identity keys": {
+			opts: []ParseOption{WithIdentityKeys()},
+			wanted: `
+~ Rules:
+    ~ - (changed item)
+      ~ Id: expire-logs -> archive
+      ~ Prefix: logs/ -> archive/
+    ~ - (changed item)
+      ~ Prefix: tmp/ -> temp/
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotTree, err := From(old).ParseWithCFNOverriders([]byte(curr), tc.opts...)
+			require.NoError(t, err)
+			buf := strings.Builder{}
+			err = gotTree.Write(&buf)
+			require.NoError(t, err)
+			require.Equal(t, strings.TrimPrefix(tc.wanted, "\n"), buf.String())
+		})
+	}
+}
+
+func Test_Integration_Parse_Write_JSON(t *testing.T) {
+	testCases := map[string]struct {
+		curr   string
+		old    string
+		wanted string
+	}{
+		"both documents are JSON": {
+			old: `{
+  "Resources": {
+    "Bucket": {
+      "Type": "AWS::S3::Bucket",
+      "Properties": {
+        "BucketName": "phonetool-assets",
+        "Tags": [{"Key": "team", "Value": "bear"}]
+      }
+    }
+  }
+}`,
+			curr: `{
+  "Resources": {
+    "Bucket": {
+      "Type": "AWS::S3::Bucket",
+      "Properties": {
+        "BucketName": "phonetool-assets",
+        "Tags": [{"Key": "team", "Value": "bear"}, {"Key": "port", "Value": "8080"}],
+        "VersioningConfiguration": {"Status": "Enabled"}
+      }
+    }
+  }
+}`,
+			wanted: `
+~ Resources/Bucket/Properties:
+    ~ Tags:
+        (1 unchanged item)
+        + - Key: port
+        +   Value: "8080"
+    + VersioningConfiguration:
+    +     Status: Enabled
+`,
+		},
+		"JSON old document against YAML current document": {
+			old: `{"Resources": {"Bucket": {"Type": "AWS::S3::Bucket", "Properties": {"BucketName": "phonetool-assets"}}}}`,
+			curr: `
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      BucketName: phonetool-logs`,
+			wanted: `
+~ Resources/Bucket/Properties: !! replacement
+    ~ BucketName: phonetool-assets -> phonetool-logs
+`,
+		},
+		"no diff between the same document in JSON and YAML": {
+			old: `{"Resources": {"Bucket": {"Type": "AWS::S3::Bucket", "Properties": {"Tags": [{"Key": "team", "Value": "bear"}]}}}}`,
+			curr: `
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      Tags:
+        - Key: team
+          Value: bear`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotTree, err := From(tc.old).ParseWithCFNOverriders([]byte(tc.curr))
+			require.NoError(t, err)
+			buf := strings.Builder{}
+			err = gotTree.Write(&buf)
+			require.NoError(t, err)
+			require.Equal(t, strings.TrimPrefix(tc.wanted, "\n"), buf.String())
+		})
+	}
+}
+
+func Test_Integration_Parse_Write_WithColor(t *testing.T) {
+	old := `
+Mary:
+  Height: 190
+  Hobby: [swimming, dancing]
+  Weight:
+    kg: 52`
+	curr := `
+Mary:
+  Height: 168
+  Hobby: [swimming, singing]
+  Likes:
+    - bears
+    - dogs`
+	wanted := "~ Mary:\n" +
+		"\x1b[93m    ~ Height: 190 -> 168\x1b[0m\n" +
+		"    ~ Hobby:\n" +
+		"\x1b[2m        (1 unchanged item)\x1b[0m\n" +
+		"\x1b[93m        ~ - dancing -> singing\x1b[0m\n" +
+		"\x1b[92m    + Likes:\x1b[0m\n" +
+		"\x1b[92m    +     - bears\x1b[0m\n" +
+		"\x1b[92m    +     - dogs\x1b[0m\n" +
+		"\x1b[91m    - Weight:\x1b[0m\n" +
+		"\x1b[91m    -     kg: 52\x1b[0m\n"
+	gotTree, err := From(old).Parse([]byte(curr))
+	require.NoError(t, err)
+
+	buf := strings.Builder{}
+	require.NoError(t, gotTree.Write(&buf, WithColor(true)))
+	require.Equal(t, wanted, buf.String())
+}
+
+func Test_Integration_Parse_Write_WithLabels(t *testing.T) {
+	old := `
+Mary:
+  Height: 190
+  Hobby: [swimming, dancing]
+  Weight:
+    kg: 52`
+	curr := `
+Mary:
+  Height: 168
+  Hobby: [swimming, singing]
+  Likes:
+    - bears
+    - dogs`
+	wanted := `
+CHANGED Mary:
+    CHANGED Height: 190 -> 168
+    CHANGED Hobby:
+        (1 unchanged item)
+        CHANGED - dancing -> singing
+    ADDED   Likes:
+    ADDED       - bears
+    ADDED       - dogs
+    REMOVED Weight:
+    REMOVED     kg: 52
+`
+	gotTree, err := From(old).Parse([]byte(curr))
+	require.NoError(t, err)
+
+	buf := strings.Builder{}
+	require.NoError(t, gotTree.Write(&buf, WithLabels()))
+	require.Equal(t, strings.TrimPrefix(wanted, "\n"), buf.String())
+}
+
+func Test_Integration_Parse_Write_WithContext(t *testing.T) {
+	old := `Alphabet: [a,b,c,d,e,f,g,h,i]`
+	curr := `Alphabet: [a,b,c,d,E,f,g,h,i]`
+	testCases := map[string]struct {
+		opts   []WriteOption
+		wanted string
+	}{
+		"collapse all unchanged items by default": {
+			wanted: `
+~ Alphabet:
+    (4 unchanged items)
+    ~ - e -> E
+    (4 unchanged items)
+`,
+		},
+		"show unchanged items around the change": {
+			opts: []WriteOption{WithContext(2)},
+			wanted: `
+~ Alphabet:
+    (2 unchanged items)
+      - c
+      - d
+    ~ - e -> E
+      - f
+      - g
+    (2 unchanged items)
+`,
+		},
+		"show all unchanged items if the context covers them": {
+			opts: []WriteOption{WithContext(4)},
+			wanted: `
+~ Alphabet:
+      - a
+      - b
+      - c
+      - d
+    ~ - e -> E
+      - f
+      - g
+      - h
+      - i
+`,
+		},
+		"show full lists": {
+			opts: []WriteOption{WithFullLists()},
+			wanted: `
+~ Alphabet:
+      - a
+      - b
+      - c
+      - d
+    ~ - e -> E
+      - f
+      - g
+      - h
+      - i
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotTree, err := From(old).Parse([]byte(curr))
+			require.NoError(t, err)
+			buf := strings.Builder{}
+			require.NoError(t, gotTree.Write(&buf, tc.opts...))
+			require.Equal(t, strings.TrimPrefix(tc.wanted, "\n"), buf.String())
+		})
+	}
+}
+
+func Test_Integration_Parse_Write_WithContextBetweenChanges(t *testing.T) {
+	old := `
+Containers:
+  - Name: nginx
+    Ports: [80]
+  - Name: sidecar-a
+  - Name: sidecar-b
+  - Name: sidecar-c
+  - Name: envoy
+    Ports: [9901]`
+	curr := `
+Containers:
+  - Name: nginx
+    Ports: [8080]
+  - Name: sidecar-a
+  - Name: sidecar-b
+  - Name: sidecar-c
+  - Name: envoy
+    Ports: [9902]`
+	wanted := `
+~ Containers:
+    ~ - (changed item)
+      ~ Ports:
+          ~ - 80 -> 8080
+      - Name: sidecar-a
+    (1 unchanged item)
+      - Name: sidecar-c
+    ~ - (changed item)
+      ~ Ports:
+          ~ - 9901 -> 9902
+`
+	gotTree, err := From(old).Parse([]byte(curr))
+	require.NoError(t, err)
+	buf := strings.Builder{}
+	require.NoError(t, gotTree.Write(&buf, WithContext(1)))
+	require.Equal(t, strings.TrimPrefix(wanted, "\n"), buf.String())
+}
+
+func Test_Integration_Parse_Write_WithReplacements(t *testing.T) {
+	old := `
+Resources:
+  Cluster:
+    Type: AWS::ECS::Cluster
+    Properties:
+      ClusterName: phonetool-test
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 1
+      ServiceName: frontend
+  Queue:
+    Type: AWS::SQS::Queue`
+	curr := `
+Resources:
+  Cluster:
+    Type: AWS::ECS::Cluster
+    Properties:
+      ClusterName: phonetool-prod
+  Service:
+    Type: AWS::ECS::Service
+    Properties:
+      DesiredCount: 2
+      ServiceName: frontend
+  Queue:
+    Type: AWS::SNS::Topic`
+	testCases := map[string]struct {
+		opts   []WriteOption
+		wanted string
+	}{
+		"mark the resources whose properties require replacement": {
+			wanted: `
+~ Resources:
+    ~ Cluster/Properties: !! replacement
+        ~ ClusterName: phonetool-test -> phonetool-prod
+    ~ Queue: !! replacement
+        ~ Type: AWS::SQS::Queue -> AWS::SNS::Topic
+    ~ Service/Properties:
+        ~ DesiredCount: 1 -> 2
+`,
+		},
+		"mark the resources according to the given logical IDs": {
+			opts: []WriteOption{WithReplacements("Service")},
+			wanted: `
+~ Resources:
+    ~ Cluster/Properties:
+        ~ ClusterName: phonetool-test -> phonetool-prod
+    ~ Queue:
+        ~ Type: AWS::SQS::Queue -> AWS::SNS::Topic
+    ~ Service/Properties: !! replacement
+        ~ DesiredCount: 1 -> 2
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotTree, err := From(old).ParseWithCFNOverriders([]byte(curr))
+			require.NoError(t, err)
+
+			buf := strings.Builder{}
+			require.NoError(t, gotTree.Write(&buf, tc.opts...))
+			require.Equal(t, strings.TrimPrefix(tc.wanted, "\n"), buf.String())
+		})
+	}
+}
+
+func Test_Integration_Parse_Write_WithReplacementOfSingleResource(t *testing.T) {
+	old := `
+Resources:
+  Cluster:
+    Type: AWS::ECS::Cluster
+    Properties:
+      ClusterName: phonetool-test`
+	curr := `
+Resources:
+  Cluster:
+    Type: AWS::ECS::Cluster
+    Properties:
+      ClusterName: phonetool-prod`
+	wanted := `
+~ Resources/Cluster/Properties: !! replacement
+    ~ ClusterName: phonetool-test -> phonetool-prod
+`
+	gotTree, err := From(old).ParseWithCFNOverriders([]byte(curr))
+	require.NoError(t, err)
+
+	buf := strings.Builder{}
+	require.NoError(t, gotTree.Write(&buf))
+	require.Equal(t, strings.TrimPrefix(wanted, "\n"), buf.String())
+}
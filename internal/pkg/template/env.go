@@ -26,6 +26,9 @@ const (
 	InternalALBFeatureName             = "InternalALBWorkloads"
 	AliasesFeatureName                 = "Aliases"
 	AppRunnerPrivateServiceFeatureName = "AppRunnerPrivateWorkloads"
+	ServiceConnectTLSFeatureName       = "ServiceConnectTLSWorkloads"
+	MutualTLSVerifyFeatureName         = "MutualTLSVerifyWorkloads"
+	MutualTLSPassthroughFeatureName    = "MutualTLSPassthroughWorkloads"
 )
 
 // LastForceDeployIDOutputName is the logical ID of the deployment controller output.
@@ -38,6 +41,9 @@ var friendlyEnvFeatureName = map[string]string{
 	InternalALBFeatureName:             "Internal ALB",
 	AliasesFeatureName:                 "Aliases",
 	AppRunnerPrivateServiceFeatureName: "App Runner Private Services",
+	ServiceConnectTLSFeatureName:       "Service Connect TLS",
+	MutualTLSVerifyFeatureName:         "Mutual TLS (verify)",
+	MutualTLSPassthroughFeatureName:    "Mutual TLS (passthrough)",
 }
 
 var leastVersionForFeature = map[string]string{
@@ -47,11 +53,15 @@ var leastVersionForFeature = map[string]string{
 	InternalALBFeatureName:             "v1.10.0",
 	AliasesFeatureName:                 "v1.4.0",
 	AppRunnerPrivateServiceFeatureName: "v1.23.0",
+	ServiceConnectTLSFeatureName:       "v1.30.0",
+	MutualTLSVerifyFeatureName:         "v1.43.0",
+	MutualTLSPassthroughFeatureName:    "v1.43.0",
 }
 
 // AvailableEnvFeatures returns a list of the latest available feature, named after their corresponding parameter names.
 func AvailableEnvFeatures() []string {
-	return []string{ALBFeatureName, EFSFeatureName, NATFeatureName, InternalALBFeatureName, AliasesFeatureName, AppRunnerPrivateServiceFeatureName}
+	return []string{ALBFeatureName, EFSFeatureName, NATFeatureName, InternalALBFeatureName, AliasesFeatureName, AppRunnerPrivateServiceFeatureName, ServiceConnectTLSFeatureName,
+		MutualTLSVerifyFeatureName, MutualTLSPassthroughFeatureName}
 }
 
 // FriendlyEnvFeatureName returns a user-friendly feature name given a env-controller managed parameter name.
@@ -84,6 +94,11 @@ var (
 		"elb-access-logs",
 		"mappings-regional-configs",
 		"ar-vpc-connector",
+		"service-connect-tls",
+		"ec2-capacity-provider",
+		"waf",
+		"notifications",
+		"static-site-logs",
 	}
 )
 
@@ -100,6 +115,7 @@ var (
 type Addons struct {
 	URL         string
 	ExtraParams string
+	Outputs     []string // Outputs of the addons stack that the environment stack exports for workloads.
 }
 
 // EnvOpts holds data that can be provided to enable features in an environment stack template.
@@ -127,24 +143,122 @@ type EnvOpts struct {
 	Telemetry         *Telemetry
 	CDNConfig         *CDNConfig
 
+	ImportedCluster    string // If not empty, the name of an existing ECS cluster to use instead of creating one.
+	ClusterName        string // If not empty, the physical name of the cluster that the environment creates.
+	ImportedHostedZone string // If not empty, the ID of an existing hosted zone for the environment's subdomain.
+
+	EC2CapacityProvider *EC2CapacityProvider // If not-nil, register an Auto Scaling group of EC2 instances with the cluster.
+	Notifications       *Notifications       // If not-nil, notify subscribers of the deployments of the stacks in the environment.
+	StaticSiteLogs      *StaticSiteLogs      // If not-nil, create a bucket for the CloudFront access logs of static sites.
+
 	SerializedManifest string // Serialized manifest used to render the environment template.
 	ForceUpdateID      string
 
 	DelegateDNS bool
 }
 
+// EC2CapacityProvider holds the configuration of the EC2 instances that run the tasks of workloads with "compute: ec2".
+type EC2CapacityProvider struct {
+	InstanceType string
+	ImageID      string // ID of the AMI, or a dynamic reference to the SSM parameter of an ECS-optimized AMI.
+	MinSize      int
+	MaxSize      int
+	Windows      bool
+}
+
+// Notifications holds the EventBridge rules that forward the status changes of the environment's stacks to subscribers.
+type Notifications struct {
+	Rules        []NotificationRule
+	Destinations []NotificationDestination // HTTPS endpoints invoked through EventBridge API destinations.
+}
+
+// NotificationRule matches the CloudFormation stack statuses of a deployment event.
+type NotificationRule struct {
+	Event    string // Name of the event used in logical IDs, such as "RolledBack".
+	Statuses []string
+	Targets  []NotificationTarget
+}
+
+// NotificationTarget is either an SNS topic or an API destination that receives the payload of a notification.
+type NotificationTarget struct {
+	ID            string
+	TopicARN      string
+	DestinationID string // ID of a NotificationDestination, set if TopicARN is empty.
+	InputTemplate string // Payload with "<stack_id>", "<status>", "<reason>" and "<time>" placeholders.
+}
+
+// NotificationDestination is an HTTPS endpoint that receives notifications.
+type NotificationDestination struct {
+	ID  string
+	URL string
+}
+
 // PublicHTTPConfig represents configuration for a public facing Load Balancer.
 type PublicHTTPConfig struct {
 	HTTPConfig
 	PublicALBSourceIPs []string
 	CIDRPrefixListIDs  []string
 	ELBAccessLogs      *ELBAccessLogs
+	ImportedALB        *ImportedALB // If not-nil, use the imported load balancer instead of creating one.
+	TrustStore         *TrustStore  // If not-nil, the HTTPS listener can verify client certificates against the trust store.
+	WAF                *WAF         // If not-nil, associate a web ACL with the load balancer.
+	LoadBalancerName   string       // If not empty, the physical name of the load balancer.
+
+	ManagedCertificates []ManagedCertificate // Certificates requested by the environment and attached to the HTTPS listener.
+}
+
+// ManagedCertificate holds an ACM certificate validated with DNS records in an existing hosted zone.
+type ManagedCertificate struct {
+	Domain           string
+	HostedZoneID     string
+	AlternativeNames []string
+}
+
+// WAF holds the web ACL of a load balancer or an App Runner service.
+// If WebACLARN is empty, a web ACL is created with the ManagedRules.
+type WAF struct {
+	WebACLARN    string
+	ManagedRules []WAFManagedRule
+}
+
+// WAFManagedRule is an AWS WAF managed rule group, optionally scoped down to requests for some paths.
+type WAFManagedRule struct {
+	Vendor         string
+	Name           string
+	ScopeDownPaths []string
+}
+
+// TrustStore holds the location of the certificate authorities bundle trusted by the HTTPS listener for mutual TLS.
+type TrustStore struct {
+	CABundleBucket                string
+	CABundleKey                   string
+	IgnoreClientCertificateExpiry bool
+}
+
+// ImportedALB holds the fields of an existing Application Load Balancer and its listeners.
+type ImportedALB struct {
+	ARN              string
+	FullName         string
+	DNSName          string
+	HostedZoneID     string
+	SecurityGroups   []string
+	HTTPListenerARN  string
+	HTTPSListenerARN string
 }
 
 // PrivateHTTPConfig represents configuration for an internal Load Balancer.
 type PrivateHTTPConfig struct {
 	HTTPConfig
 	CustomALBSubnets []string
+	HostedZone       *PrivateHostedZone
+	LoadBalancerName string // If not empty, the physical name of the load balancer.
+}
+
+// PrivateHostedZone represents a private hosted zone associated with the VPC of the environment
+// and with any additional VPCs in the same region.
+type PrivateHostedZone struct {
+	Name           string
+	AssociatedVPCs []string
 }
 
 // HasImportedCerts returns true if any https certificates have been
@@ -165,6 +279,7 @@ type HTTPConfig struct {
 type ELBAccessLogs struct {
 	BucketName string
 	Prefix     string
+	Retention  *int // If not-nil, number of days after which the logs expire from the bucket created by Copilot.
 }
 
 // ShouldCreateBucket returns true if copilot should create bucket on behalf of customer.
@@ -196,6 +311,7 @@ type VPCConfig struct {
 	AllowVPCIngress     bool
 	SecurityGroupConfig *SecurityGroupConfig
 	FlowLogs            *VPCFlowLogs
+	DualStack           bool // If true, the public load balancer and the containers accept both IPv4 and IPv6 traffic.
 }
 
 // ImportVPC holds the fields to import VPC resources.
@@ -211,6 +327,34 @@ type ManagedVPC struct {
 	AZs                []string
 	PublicSubnetCIDRs  []string
 	PrivateSubnetCIDRs []string
+	NAT                NAT
+	IPv6               bool // If true, assign IPv6 addresses to the subnets and route the IPv6 egress traffic of private subnets through an egress-only internet gateway.
+	AssignIPv6         bool // If true, the network interfaces created in the subnets get an IPv6 address.
+}
+
+// PrivateSubnetIPv6Index returns the index of the /64 IPv6 block of the private subnet at index idx.
+// Public subnets take the first blocks of the IPv6 CIDR of the VPC, and private subnets the following ones.
+func (vpc ManagedVPC) PrivateSubnetIPv6Index(idx int) int {
+	return len(vpc.PublicSubnetCIDRs) + idx
+}
+
+// HasPrivateRouteTables returns true if the private subnets are associated with route tables created by Copilot.
+func (vpc ManagedVPC) HasPrivateRouteTables() bool {
+	return !vpc.NAT.None || vpc.IPv6
+}
+
+// NAT holds the topology of the NAT devices that route the IPv4 egress traffic of private subnets.
+// The zero value creates a NAT gateway in each availability zone.
+type NAT struct {
+	Single   bool         // If true, all private subnets route through the NAT of the first availability zone.
+	None     bool         // If true, private subnets have no IPv4 route to the internet.
+	Instance *NATInstance // If not-nil, EC2 instances replace the NAT gateways.
+}
+
+// NATInstance holds the configuration of an EC2 instance acting as a NAT.
+type NATInstance struct {
+	InstanceType string
+	ImageID      string // Dynamic reference to the SSM parameter of an Amazon Linux AMI.
 }
 
 // Telemetry represents optional observability and monitoring configuration.
@@ -235,6 +379,12 @@ type SecurityGroupRule struct {
 // VPCFlowLogs holds the fields to configure logging IP traffic using VPC flow logs.
 type VPCFlowLogs struct {
 	Retention *int
+	S3        bool // If true, publish the flow logs to an S3 bucket instead of a CloudWatch log group.
+}
+
+// StaticSiteLogs holds the S3 bucket that receives the CloudFront access logs of the static sites in the environment.
+type StaticSiteLogs struct {
+	Retention *int // If not-nil, number of days after which the logs expire.
 }
 
 // ParseEnv parses an environment's CloudFormation template with the specified data object and returns its content.
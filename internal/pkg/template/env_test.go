@@ -28,6 +28,11 @@ func TestTemplate_ParseEnv(t *testing.T) {
 	_ = afero.WriteFile(fs, "templates/environment/partials/elb-access-logs.yml", []byte("elb-access-logs"), 0644)
 	_ = afero.WriteFile(fs, "templates/environment/partials/mappings-regional-configs.yml", []byte("mappings-regional-configs"), 0644)
 	_ = afero.WriteFile(fs, "templates/environment/partials/ar-vpc-connector.yml", []byte("ar-vpc-connector"), 0644)
+	_ = afero.WriteFile(fs, "templates/environment/partials/service-connect-tls.yml", []byte("service-connect-tls"), 0644)
+	_ = afero.WriteFile(fs, "templates/environment/partials/ec2-capacity-provider.yml", []byte("ec2-capacity-provider"), 0644)
+	_ = afero.WriteFile(fs, "templates/environment/partials/waf.yml", []byte("waf"), 0644)
+	_ = afero.WriteFile(fs, "templates/environment/partials/notifications.yml", []byte("notifications"), 0644)
+	_ = afero.WriteFile(fs, "templates/environment/partials/static-site-logs.yml", []byte("static-site-logs"), 0644)
 	tpl := &Template{
 		fs: &mockFS{
 			Fs: fs,
@@ -0,0 +1,51 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"encoding/json"
+)
+
+const cdkExportTemplatesPath = "export/cdk"
+
+// CDKExportParameter is a parameter of an exported CloudFormation template, surfaced as a prop of the CDK stack.
+type CDKExportParameter struct {
+	Name     string // Name of the CloudFormation parameter, such as "ContainerImage".
+	PropName string // Name of the prop of the CDK stack, such as "containerImage".
+	Value    string // Default value of the prop.
+}
+
+// CDKExportTag is a tag applied to the exported CDK stack.
+type CDKExportTag struct {
+	Key   string
+	Value string
+}
+
+// CDKExportOpts holds the data to render the CDK application that deploys a CloudFormation template.
+type CDKExportOpts struct {
+	StackName    string
+	TemplateFile string // Path of the CloudFormation template relative to the CDK application.
+	Parameters   []CDKExportParameter
+	Tags         []CDKExportTag
+}
+
+// WalkExportCDKDir walks through the export/cdk templates and calls fn for each parsed template file.
+func (t *Template) WalkExportCDKDir(opts CDKExportOpts, fn WalkDirFunc) error {
+	type metadata struct {
+		CDKExportOpts
+		Version           string
+		ConstructsVersion string
+	}
+	return t.walkDir(cdkExportTemplatesPath, cdkExportTemplatesPath, metadata{
+		CDKExportOpts:     opts,
+		Version:           cdkVersion,
+		ConstructsVersion: cdkConstructsMinVersion,
+	}, fn, WithFuncs(map[string]interface{}{
+		// quote returns s as a TypeScript string literal.
+		"quote": func(s string) (string, error) {
+			out, err := json.Marshal(s)
+			return string(out), err
+		},
+	}))
+}
@@ -0,0 +1,381 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package lint checks CloudFormation templates for mistakes and for the limits of CloudFormation,
+// so that they are reported before CloudFormation rejects the template.
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/aws/copilot-cli/internal/pkg/errs"
+)
+
+// Maximum sizes of a template, see https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/cloudformation-limits.html.
+const (
+	MaxTemplateBodySize = 51200   // Maximum size in bytes of a template passed inline.
+	MaxTemplateURLSize  = 1048576 // Maximum size in bytes of a template uploaded to S3.
+)
+
+const maxLogicalIDLength = 255
+
+// sectionLimits are the maximum numbers of entries in the sections of a template.
+var sectionLimits = []struct {
+	section string
+	noun    string
+	max     int
+}{
+	{section: "Parameters", noun: "parameters", max: 200},
+	{section: "Mappings", noun: "mappings", max: 200},
+	{section: "Resources", noun: "resources", max: 500},
+	{section: "Outputs", noun: "outputs", max: 200},
+}
+
+// Sections whose keys are logical IDs.
+var logicalIDSections = []string{"Parameters", "Mappings", "Conditions", "Resources", "Outputs"}
+
+var pseudoParameters = map[string]bool{
+	"AWS::AccountId":        true,
+	"AWS::NotificationARNs": true,
+	"AWS::NoValue":          true,
+	"AWS::Partition":        true,
+	"AWS::Region":           true,
+	"AWS::StackId":          true,
+	"AWS::StackName":        true,
+	"AWS::URLSuffix":        true,
+}
+
+var (
+	logicalIDRegExp = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+	subVarRegExp    = regexp.MustCompile(`\$\{([^!}][^}]*)\}`)
+)
+
+// Issue is a mistake in a template, or a limit of CloudFormation that the template exceeds.
+type Issue struct {
+	Path    string // Path of the field with the issue, such as "Resources.Service.DependsOn".
+	Message string
+	Limit   bool // Whether the template exceeds a limit of CloudFormation.
+}
+
+// String returns the path of the issue followed by its message.
+func (i Issue) String() string {
+	if i.Path == "" {
+		return i.Message
+	}
+	return fmt.Sprintf("%s: %s", i.Path, i.Message)
+}
+
+// Template returns the issues of a template that CloudFormation accepts up to maxSize bytes.
+// The references to parameters, resources and conditions are not checked if the template declares a transform,
+// since transforms create resources that are not in the template.
+func Template(tmpl string, maxSize int) []Issue {
+	var issues []Issue
+	if len(tmpl) > maxSize {
+		issues = append(issues, Issue{
+			Message: fmt.Sprintf("template is %d bytes, more than the maximum of %d", len(tmpl), maxSize),
+			Limit:   true,
+		})
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(tmpl), &doc); err != nil {
+		return append(issues, Issue{Message: fmt.Sprintf("parse template: %v", err)})
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return append(issues, Issue{Message: "template must be a map"})
+	}
+	l := newLinter(doc.Content[0])
+	l.lint()
+	return append(issues, l.issues...)
+}
+
+// Validate returns an error that lists the issues of the template of a stack, or nil if the template has none.
+func Validate(stackName, tmpl string, maxSize int) error {
+	issues := Template(tmpl, maxSize)
+	if len(issues) == 0 {
+		return nil
+	}
+	msgs := make([]string, len(issues))
+	hint := "Fix the overrides or addons that introduced the mistakes, then retry."
+	for i, issue := range issues {
+		msgs[i] = issue.String()
+		if issue.Limit {
+			hint = "Move resources to addons, which are deployed in a nested stack with limits of its own, or split the stack."
+		}
+	}
+	return &errs.Error{
+		Code:    errs.CodeInvalidTemplate,
+		Message: fmt.Sprintf("template of stack %s is invalid", stackName),
+		Err:     errors.New(strings.Join(msgs, "; ")),
+		Remediation: errs.Remediation{
+			Hint:    hint,
+			DocsURL: errs.Docs(errs.CodeInvalidTemplate),
+		},
+	}
+}
+
+type linter struct {
+	sections   map[string]*yaml.Node
+	parameters map[string]bool
+	resources  map[string]bool
+	conditions map[string]bool
+	issues     []Issue
+}
+
+func newLinter(root *yaml.Node) *linter {
+	l := &linter{sections: make(map[string]*yaml.Node)}
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		l.sections[root.Content[i].Value] = root.Content[i+1]
+	}
+	l.parameters = l.names("Parameters")
+	l.resources = l.names("Resources")
+	l.conditions = l.names("Conditions")
+	return l
+}
+
+func (l *linter) lint() {
+	if len(l.resources) == 0 {
+		l.add("Resources", "must declare at least one resource")
+	}
+	for _, limit := range sectionLimits {
+		if n := len(l.names(limit.section)); n > limit.max {
+			l.issues = append(l.issues, Issue{
+				Path:    limit.section,
+				Message: fmt.Sprintf("%d %s, more than the maximum of %d", n, limit.noun, limit.max),
+				Limit:   true,
+			})
+		}
+	}
+	for _, section := range logicalIDSections {
+		for _, id := range sortedKeys(l.names(section)) {
+			switch {
+			case len(id) > maxLogicalIDLength:
+				l.add(section+"."+id, fmt.Sprintf("logical ID is longer than %d characters", maxLogicalIDLength))
+			case !logicalIDRegExp.MatchString(id):
+				l.add(section+"."+id, "logical ID must be alphanumeric")
+			}
+		}
+	}
+	_, hasTransform := l.sections["Transform"]
+	l.lintResources(!hasTransform)
+	l.lintOutputs(!hasTransform)
+	if hasTransform {
+		return
+	}
+	eachEntry(l.sections["Conditions"], func(name string, cond *yaml.Node) {
+		l.lintReferences("Conditions."+name, cond)
+	})
+}
+
+func (l *linter) lintResources(checkRefs bool) {
+	eachEntry(l.sections["Resources"], func(id string, resource *yaml.Node) {
+		path := "Resources." + id
+		if resource.Kind != yaml.MappingNode {
+			l.add(path, "resource must be a map")
+			return
+		}
+		if typ := mappingValue(resource, "Type"); typ == nil || typ.Kind != yaml.ScalarNode || typ.Value == "" {
+			l.add(path, `"Type" must be specified`)
+		}
+		if !checkRefs {
+			return
+		}
+		if cond := mappingValue(resource, "Condition"); cond != nil {
+			l.checkCondition(path+".Condition", cond.Value)
+		}
+		if deps := mappingValue(resource, "DependsOn"); deps != nil {
+			targets := []*yaml.Node{deps}
+			if deps.Kind == yaml.SequenceNode {
+				targets = deps.Content
+			}
+			for _, target := range targets {
+				switch {
+				case target.Value == id:
+					l.add(path+".DependsOn", "resource can't depend on itself")
+				case !l.resources[target.Value]:
+					l.add(path+".DependsOn", fmt.Sprintf("resource %s does not exist", target.Value))
+				}
+			}
+		}
+		if props := mappingValue(resource, "Properties"); props != nil {
+			l.lintReferences(path+".Properties", props)
+		}
+	})
+}
+
+func (l *linter) lintOutputs(checkRefs bool) {
+	eachEntry(l.sections["Outputs"], func(id string, output *yaml.Node) {
+		path := "Outputs." + id
+		if output.Kind != yaml.MappingNode || mappingValue(output, "Value") == nil {
+			l.add(path, `"Value" must be specified`)
+			return
+		}
+		if !checkRefs {
+			return
+		}
+		if cond := mappingValue(output, "Condition"); cond != nil {
+			l.checkCondition(path+".Condition", cond.Value)
+		}
+		l.lintReferences(path+".Value", mappingValue(output, "Value"))
+		if export := mappingValue(output, "Export"); export != nil {
+			l.lintReferences(path+".Export", export)
+		}
+	})
+}
+
+// lintReferences checks that the intrinsic functions under node refer to parameters, resources and conditions
+// that exist in the template.
+func (l *linter) lintReferences(path string, node *yaml.Node) {
+	switch node.Tag {
+	case "!Ref":
+		l.checkRef(path, node.Value)
+	case "!GetAtt":
+		l.checkGetAtt(path, node)
+	case "!Sub":
+		l.checkSub(path, node)
+	case "!If":
+		if node.Kind == yaml.SequenceNode && len(node.Content) > 0 {
+			l.checkCondition(path, node.Content[0].Value)
+		}
+	case "!Condition":
+		l.checkCondition(path, node.Value)
+	}
+	if node.Kind == yaml.MappingNode && len(node.Content) == 2 {
+		key, arg := node.Content[0].Value, node.Content[1]
+		switch key {
+		case "Ref":
+			if arg.Kind == yaml.ScalarNode {
+				l.checkRef(path, arg.Value)
+			}
+		case "Fn::GetAtt":
+			l.checkGetAtt(path, arg)
+		case "Fn::Sub":
+			l.checkSub(path, arg)
+		case "Fn::If":
+			if arg.Kind == yaml.SequenceNode && len(arg.Content) > 0 {
+				l.checkCondition(path, arg.Content[0].Value)
+			}
+		}
+	}
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			l.lintReferences(path+"."+node.Content[i].Value, node.Content[i+1])
+		}
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			l.lintReferences(fmt.Sprintf("%s[%d]", path, i), child)
+		}
+	}
+}
+
+func (l *linter) checkRef(path, name string) {
+	if !l.parameters[name] && !l.resources[name] && !pseudoParameters[name] {
+		l.add(path, fmt.Sprintf("Ref to %s, which is not a parameter or a resource", name))
+	}
+}
+
+func (l *linter) checkGetAtt(path string, arg *yaml.Node) {
+	var resource string
+	switch arg.Kind {
+	case yaml.ScalarNode:
+		resource = strings.SplitN(arg.Value, ".", 2)[0]
+	case yaml.SequenceNode:
+		if len(arg.Content) == 0 || arg.Content[0].Kind != yaml.ScalarNode {
+			return
+		}
+		resource = arg.Content[0].Value
+	default:
+		return
+	}
+	if !l.resources[resource] {
+		l.add(path, fmt.Sprintf("Fn::GetAtt of %s, which is not a resource", resource))
+	}
+}
+
+func (l *linter) checkSub(path string, arg *yaml.Node) {
+	str, locals := arg, map[string]bool{}
+	if arg.Kind == yaml.SequenceNode {
+		if len(arg.Content) == 0 {
+			return
+		}
+		str = arg.Content[0]
+		if len(arg.Content) > 1 {
+			locals = keys(arg.Content[1])
+		}
+	}
+	if str.Kind != yaml.ScalarNode {
+		return
+	}
+	for _, m := range subVarRegExp.FindAllStringSubmatch(str.Value, -1) {
+		name := strings.TrimSpace(m[1])
+		if locals[name] || pseudoParameters[name] {
+			continue
+		}
+		if resource, _, ok := strings.Cut(name, "."); ok {
+			if !l.resources[resource] {
+				l.add(path, fmt.Sprintf("Fn::Sub of ${%s}, which is not an attribute of a resource", name))
+			}
+			continue
+		}
+		if !l.parameters[name] && !l.resources[name] {
+			l.add(path, fmt.Sprintf("Fn::Sub of ${%s}, which is not a parameter or a resource", name))
+		}
+	}
+}
+
+func (l *linter) checkCondition(path, name string) {
+	if !l.conditions[name] {
+		l.add(path, fmt.Sprintf("condition %s does not exist", name))
+	}
+}
+
+func (l *linter) add(path, msg string) {
+	l.issues = append(l.issues, Issue{Path: path, Message: msg})
+}
+
+func (l *linter) names(section string) map[string]bool {
+	return keys(l.sections[section])
+}
+
+// keys returns the keys of a mapping node, or an empty map if node is not a mapping.
+func keys(node *yaml.Node) map[string]bool {
+	out := make(map[string]bool)
+	eachEntry(node, func(key string, _ *yaml.Node) {
+		out[key] = true
+	})
+	return out
+}
+
+// eachEntry calls fn with each key and value of a mapping node in order.
+func eachEntry(node *yaml.Node, fn func(key string, value *yaml.Node)) {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		fn(node.Content[i].Value, node.Content[i+1])
+	}
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
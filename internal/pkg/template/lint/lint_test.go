@@ -0,0 +1,171 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package lint
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/copilot-cli/internal/pkg/errs"
+)
+
+func TestTemplate(t *testing.T) {
+	manyResources := new(strings.Builder)
+	manyResources.WriteString("Resources:\n")
+	for i := 0; i < 501; i++ {
+		fmt.Fprintf(manyResources, "  Topic%d:\n    Type: AWS::SNS::Topic\n", i)
+	}
+	testCases := map[string]struct {
+		in      string
+		maxSize int
+
+		wanted []Issue
+	}{
+		"no issues in a valid template": {
+			in: `Parameters:
+  Env:
+    Type: String
+Conditions:
+  IsProd: !Equals [!Ref Env, prod]
+Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+    Condition: IsProd
+  Topic:
+    Type: AWS::SNS::Topic
+    DependsOn: [Queue]
+    Properties:
+      TopicName: !Sub '${AWS::StackName}-${Env}-${Queue.QueueName}-${!Literal}'
+      KmsMasterKeyId: !If [IsProd, !GetAtt Queue.Arn, !Ref AWS::NoValue]
+      Tags:
+        - Key: queue
+          Value:
+            Fn::Sub:
+              - '${Name}'
+              - Name: !Ref Queue
+Outputs:
+  TopicArn:
+    Value:
+      Ref: Topic
+    Export:
+      Name: !Sub ${AWS::StackName}-TopicArn
+`,
+			maxSize: MaxTemplateBodySize,
+		},
+		"JSON templates are linted too": {
+			in:      `{"Resources": {"Topic": {"Type": "AWS::SNS::Topic", "Properties": {"TopicName": {"Ref": "Name"}}}}}`,
+			maxSize: MaxTemplateBodySize,
+			wanted: []Issue{
+				{Path: "Resources.Topic.Properties.TopicName", Message: "Ref to Name, which is not a parameter or a resource"},
+			},
+		},
+		"template larger than the maximum size": {
+			in:      "Resources:\n  Topic:\n    Type: AWS::SNS::Topic\n",
+			maxSize: 10,
+			wanted: []Issue{
+				{Message: "template is 46 bytes, more than the maximum of 10", Limit: true},
+			},
+		},
+		"template that is not a map": {
+			in:      "hello",
+			maxSize: MaxTemplateBodySize,
+			wanted: []Issue{
+				{Message: "template must be a map"},
+			},
+		},
+		"template without resources": {
+			in:      "Outputs:\n  Name:\n    Value: hello\n",
+			maxSize: MaxTemplateBodySize,
+			wanted: []Issue{
+				{Path: "Resources", Message: "must declare at least one resource"},
+			},
+		},
+		"more resources than the maximum": {
+			in:      manyResources.String(),
+			maxSize: MaxTemplateURLSize,
+			wanted: []Issue{
+				{Path: "Resources", Message: "501 resources, more than the maximum of 500", Limit: true},
+			},
+		},
+		"malformed resources and outputs": {
+			in: `Resources:
+  my-topic:
+    Type: AWS::SNS::Topic
+  Queue:
+    Properties:
+      QueueName: hello
+Outputs:
+  Arn:
+    Description: no value
+`,
+			maxSize: MaxTemplateBodySize,
+			wanted: []Issue{
+				{Path: "Resources.my-topic", Message: "logical ID must be alphanumeric"},
+				{Path: "Resources.Queue", Message: `"Type" must be specified`},
+				{Path: "Outputs.Arn", Message: `"Value" must be specified`},
+			},
+		},
+		"references to missing parameters, resources and conditions": {
+			in: `Resources:
+  Topic:
+    Type: AWS::SNS::Topic
+    Condition: IsProd
+    DependsOn: [Topic, Queue]
+    Properties:
+      TopicName: !Sub '${Env}-${Bucket.Arn}'
+      KmsMasterKeyId: !GetAtt [Key, Arn]
+Outputs:
+  TopicArn:
+    Value: !If [HasTopic, !Ref Topic, '']
+`,
+			maxSize: MaxTemplateBodySize,
+			wanted: []Issue{
+				{Path: "Resources.Topic.Condition", Message: "condition IsProd does not exist"},
+				{Path: "Resources.Topic.DependsOn", Message: "resource can't depend on itself"},
+				{Path: "Resources.Topic.DependsOn", Message: "resource Queue does not exist"},
+				{Path: "Resources.Topic.Properties.TopicName", Message: "Fn::Sub of ${Env}, which is not a parameter or a resource"},
+				{Path: "Resources.Topic.Properties.TopicName", Message: "Fn::Sub of ${Bucket.Arn}, which is not an attribute of a resource"},
+				{Path: "Resources.Topic.Properties.KmsMasterKeyId", Message: "Fn::GetAtt of Key, which is not a resource"},
+				{Path: "Outputs.TopicArn.Value", Message: "condition HasTopic does not exist"},
+			},
+		},
+		"references are not checked with a transform": {
+			in: `Transform: AWS::Serverless-2016-10-31
+Resources:
+  Function:
+    Type: AWS::Serverless::Function
+    Properties:
+      Role: !GetAtt FunctionRole.Arn
+`,
+			maxSize: MaxTemplateBodySize,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, Template(tc.in, tc.maxSize))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("returns nil for a valid template", func(t *testing.T) {
+		require.NoError(t, Validate("phonetool-test", "Resources:\n  Topic:\n    Type: AWS::SNS::Topic\n", MaxTemplateBodySize))
+	})
+	t.Run("returns an error with the issues of the template", func(t *testing.T) {
+		err := Validate("phonetool-test", "Resources:\n  Topic:\n    Type: AWS::SNS::Topic\n    DependsOn: Queue\n", MaxTemplateBodySize)
+
+		require.EqualError(t, err, "template of stack phonetool-test is invalid: Resources.Topic.DependsOn: resource Queue does not exist")
+		require.Equal(t, errs.CodeInvalidTemplate, errs.CodeOf(err))
+	})
+	t.Run("recommends moving resources to addons if the template exceeds a limit", func(t *testing.T) {
+		err := Validate("phonetool-test", "Resources:\n  Topic:\n    Type: AWS::SNS::Topic\n", 10)
+
+		var structured *errs.Error
+		require.ErrorAs(t, err, &structured)
+		require.Contains(t, structured.Remediation.Hint, "Move resources to addons")
+	})
+}
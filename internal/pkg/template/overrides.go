@@ -16,6 +16,7 @@ const (
 	cdkTemplatesPath        = "overrides/cdk"
 
 	yamlPatchTemplatesPath = "overrides/yamlpatch"
+	mergeTemplatesPath     = "overrides/merge"
 )
 
 var (
@@ -139,3 +140,8 @@ func (t *Template) WalkOverridesCDKDir(resources []CFNResource, fn WalkDirFunc,
 func (t *Template) WalkOverridesPatchDir(fn WalkDirFunc) error {
 	return t.walkDir(yamlPatchTemplatesPath, yamlPatchTemplatesPath, struct{}{}, fn)
 }
+
+// WalkOverridesMergeDir walks through the overrides/merge templates and calls fn for each parsed template file.
+func (t *Template) WalkOverridesMergeDir(fn WalkDirFunc) error {
+	return t.walkDir(mergeTemplatesPath, mergeTemplatesPath, struct{}{}, fn)
+}
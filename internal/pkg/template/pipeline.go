@@ -6,6 +6,7 @@ package template
 import (
 	"bytes"
 	"fmt"
+	"strconv"
 	"text/template"
 )
 
@@ -50,6 +51,7 @@ func withPipelineParsingFuncs() ParseOption {
 				return ok
 			},
 			"logicalIDSafe": ReplaceDashesFunc,
+			"quote":         strconv.Quote,
 		})
 	}
 }
@@ -0,0 +1,144 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package policy checks CloudFormation templates against built-in guardrail rules.
+package policy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/template/diff"
+)
+
+// Names of the built-in rules.
+const (
+	RuleNoPublicS3Buckets     = "no-public-s3-buckets"
+	RuleContainerMemoryLimits = "container-memory-limits"
+	RuleNoWildcardIAMActions  = "no-wildcard-iam-actions"
+	RuleEncryptedStorage      = "encrypted-storage"
+)
+
+// Rule is a built-in check of the resources of a template.
+type Rule struct {
+	Name        string
+	Description string
+
+	check func(resource map[string]interface{}) []string // Returns the reasons the resource violates the rule.
+	types []string                                       // Resource types the rule applies to.
+}
+
+var rules = []Rule{
+	{
+		Name:        RuleNoPublicS3Buckets,
+		Description: "S3 buckets block all public access and don't grant public ACLs.",
+		types:       []string{"AWS::S3::Bucket"},
+		check:       checkNoPublicS3Bucket,
+	},
+	{
+		Name:        RuleContainerMemoryLimits,
+		Description: "ECS task definitions set a memory limit for the task or for each of its containers.",
+		types:       []string{"AWS::ECS::TaskDefinition"},
+		check:       checkContainerMemoryLimits,
+	},
+	{
+		Name:        RuleNoWildcardIAMActions,
+		Description: `IAM policies don't allow the "*" action.`,
+		types:       []string{"AWS::IAM::Role", "AWS::IAM::Policy", "AWS::IAM::ManagedPolicy"},
+		check:       checkNoWildcardIAMActions,
+	},
+	{
+		Name:        RuleEncryptedStorage,
+		Description: "EFS file systems and RDS clusters and instances encrypt their data at rest.",
+		types:       []string{"AWS::EFS::FileSystem", "AWS::RDS::DBCluster", "AWS::RDS::DBInstance"},
+		check:       checkEncryptedStorage,
+	},
+}
+
+// Validate returns an error if any of the names isn't a built-in rule.
+func Validate(names []string) error {
+	for _, name := range names {
+		if _, ok := ruleByName(name); !ok {
+			return fmt.Errorf("unknown rule %q: must be one of %s", name, strings.Join(ruleNames(), ", "))
+		}
+	}
+	return nil
+}
+
+// Violation is a resource of a template that doesn't comply with a rule.
+type Violation struct {
+	Rule     string
+	Resource string // Logical ID of the resource.
+	Reason   string
+}
+
+// String returns a human readable description of the violation.
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s %s", v.Rule, v.Resource, v.Reason)
+}
+
+// Check returns the violations of the named rules by the resources of the template.
+// Violations are sorted by rule and then by resource.
+func Check(template string, names []string) ([]Violation, error) {
+	if err := Validate(names); err != nil {
+		return nil, err
+	}
+	doc, err := diff.JSONValue([]byte(template))
+	if err != nil {
+		return nil, fmt.Errorf("parse template: %w", err)
+	}
+	tpl, _ := doc.(map[string]interface{})
+	resources, _ := tpl["Resources"].(map[string]interface{})
+	var violations []Violation
+	for _, name := range names {
+		rule, _ := ruleByName(name)
+		for logicalID, raw := range resources {
+			resource, ok := raw.(map[string]interface{})
+			if !ok || !rule.appliesTo(resource) {
+				continue
+			}
+			for _, reason := range rule.check(resource) {
+				violations = append(violations, Violation{
+					Rule:     rule.Name,
+					Resource: logicalID,
+					Reason:   reason,
+				})
+			}
+		}
+	}
+	sort.SliceStable(violations, func(i, j int) bool {
+		if violations[i].Rule != violations[j].Rule {
+			return violations[i].Rule < violations[j].Rule
+		}
+		return violations[i].Resource < violations[j].Resource
+	})
+	return violations, nil
+}
+
+func (r Rule) appliesTo(resource map[string]interface{}) bool {
+	typ, _ := resource["Type"].(string)
+	for _, t := range r.types {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
+
+func ruleByName(name string) (Rule, bool) {
+	for _, r := range rules {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return Rule{}, false
+}
+
+func ruleNames() []string {
+	names := make([]string, len(rules))
+	for i, r := range rules {
+		names[i] = r.Name
+	}
+	return names
+}
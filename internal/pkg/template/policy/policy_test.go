@@ -0,0 +1,150 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	testCases := map[string]struct {
+		template string
+		rules    []string
+
+		wanted      []Violation
+		wantedError error
+	}{
+		"unknown rule": {
+			rules:       []string{"no-public-buckets"},
+			wantedError: errors.New(`unknown rule "no-public-buckets": must be one of no-public-s3-buckets, container-memory-limits, no-wildcard-iam-actions, encrypted-storage`),
+		},
+		"compliant resources": {
+			template: `
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      BucketName: !Sub '${AWS::StackName}-assets'
+      PublicAccessBlockConfiguration:
+        BlockPublicAcls: true
+        BlockPublicPolicy: true
+        IgnorePublicAcls: true
+        RestrictPublicBuckets: true
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      Memory: !Ref TaskMemory
+      ContainerDefinitions:
+        - Name: api
+  FileSystem:
+    Type: AWS::EFS::FileSystem
+    Properties:
+      Encrypted: true
+  TaskRole:
+    Type: AWS::IAM::Role
+    Properties:
+      Policies:
+        - PolicyName: DenyAll
+          PolicyDocument:
+            Statement:
+              - Effect: Deny
+                Action: '*'
+                Resource: '*'
+`,
+			rules: []string{RuleNoPublicS3Buckets, RuleContainerMemoryLimits, RuleNoWildcardIAMActions, RuleEncryptedStorage},
+		},
+		"non-compliant resources": {
+			template: `
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      AccessControl: PublicRead
+      PublicAccessBlockConfiguration:
+        BlockPublicAcls: false
+        IgnorePublicAcls: true
+        RestrictPublicBuckets: true
+  TaskDefinition:
+    Type: AWS::ECS::TaskDefinition
+    Properties:
+      ContainerDefinitions:
+        - Name: api
+          MemoryReservation: 512
+        - Name: firelens
+  Database:
+    Type: AWS::RDS::DBCluster
+    Properties:
+      Engine: aurora-postgresql
+  AdminPolicy:
+    Type: AWS::IAM::ManagedPolicy
+    Properties:
+      PolicyDocument:
+        Statement:
+          Effect: Allow
+          Action: ['s3:GetObject', '*']
+          Resource: '*'
+`,
+			rules: []string{RuleNoPublicS3Buckets, RuleContainerMemoryLimits, RuleNoWildcardIAMActions, RuleEncryptedStorage},
+			wanted: []Violation{
+				{
+					Rule:     RuleContainerMemoryLimits,
+					Resource: "TaskDefinition",
+					Reason:   `doesn't set a memory limit for the task or for container "firelens"`,
+				},
+				{
+					Rule:     RuleEncryptedStorage,
+					Resource: "Database",
+					Reason:   "must set StorageEncrypted to true",
+				},
+				{
+					Rule:     RuleNoPublicS3Buckets,
+					Resource: "Bucket",
+					Reason:   "must set BlockPublicAcls, BlockPublicPolicy to true in PublicAccessBlockConfiguration",
+				},
+				{
+					Rule:     RuleNoPublicS3Buckets,
+					Resource: "Bucket",
+					Reason:   `grants the public canned ACL "PublicRead"`,
+				},
+				{
+					Rule:     RuleNoWildcardIAMActions,
+					Resource: "AdminPolicy",
+					Reason:   `allows the "*" action`,
+				},
+			},
+		},
+		"only the requested rules are checked": {
+			template: `
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+`,
+			rules: []string{RuleEncryptedStorage},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Check(tc.template, tc.rules)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestViolation_String(t *testing.T) {
+	require.Equal(t, `no-wildcard-iam-actions: AdminPolicy allows the "*" action`, Violation{
+		Rule:     RuleNoWildcardIAMActions,
+		Resource: "AdminPolicy",
+		Reason:   `allows the "*" action`,
+	}.String())
+}
@@ -0,0 +1,129 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package policy
+
+import (
+	"fmt"
+	"strings"
+)
+
+var (
+	publicAccessBlockSettings = []string{"BlockPublicAcls", "BlockPublicPolicy", "IgnorePublicAcls", "RestrictPublicBuckets"}
+	publicCannedACLs          = []string{"PublicRead", "PublicReadWrite", "AuthenticatedRead"}
+)
+
+func checkNoPublicS3Bucket(resource map[string]interface{}) []string {
+	props := properties(resource)
+	var reasons []string
+	block, _ := props["PublicAccessBlockConfiguration"].(map[string]interface{})
+	var unset []string
+	for _, setting := range publicAccessBlockSettings {
+		if !isTrue(block[setting]) {
+			unset = append(unset, setting)
+		}
+	}
+	if len(unset) > 0 {
+		reasons = append(reasons, fmt.Sprintf("must set %s to true in PublicAccessBlockConfiguration", strings.Join(unset, ", ")))
+	}
+	if acl, ok := props["AccessControl"].(string); ok && contains(publicCannedACLs, acl) {
+		reasons = append(reasons, fmt.Sprintf("grants the public canned ACL %q", acl))
+	}
+	return reasons
+}
+
+func checkContainerMemoryLimits(resource map[string]interface{}) []string {
+	props := properties(resource)
+	if props["Memory"] != nil {
+		return nil
+	}
+	containers, _ := props["ContainerDefinitions"].([]interface{})
+	var reasons []string
+	for i, raw := range containers {
+		container, _ := raw.(map[string]interface{})
+		if container["Memory"] != nil || container["MemoryReservation"] != nil {
+			continue
+		}
+		name, ok := container["Name"].(string)
+		if !ok {
+			name = fmt.Sprintf("#%d", i+1)
+		}
+		reasons = append(reasons, fmt.Sprintf("doesn't set a memory limit for the task or for container %q", name))
+	}
+	return reasons
+}
+
+func checkNoWildcardIAMActions(resource map[string]interface{}) []string {
+	props := properties(resource)
+	docs := []interface{}{props["PolicyDocument"]}
+	if policies, ok := props["Policies"].([]interface{}); ok {
+		for _, raw := range policies {
+			policy, _ := raw.(map[string]interface{})
+			docs = append(docs, policy["PolicyDocument"])
+		}
+	}
+	for _, raw := range docs {
+		doc, _ := raw.(map[string]interface{})
+		for _, stmt := range asList(doc["Statement"]) {
+			statement, _ := stmt.(map[string]interface{})
+			if statement["Effect"] != "Allow" {
+				continue
+			}
+			for _, action := range asList(statement["Action"]) {
+				if action == "*" {
+					return []string{`allows the "*" action`}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func checkEncryptedStorage(resource map[string]interface{}) []string {
+	props := properties(resource)
+	setting := "StorageEncrypted"
+	if resource["Type"] == "AWS::EFS::FileSystem" {
+		setting = "Encrypted"
+	}
+	if isTrue(props[setting]) {
+		return nil
+	}
+	return []string{fmt.Sprintf("must set %s to true", setting)}
+}
+
+func properties(resource map[string]interface{}) map[string]interface{} {
+	props, _ := resource["Properties"].(map[string]interface{})
+	return props
+}
+
+// isTrue returns true if the value is the boolean true, written as a boolean or a string.
+func isTrue(v interface{}) bool {
+	switch val := v.(type) {
+	case bool:
+		return val
+	case string:
+		return strings.EqualFold(val, "true")
+	}
+	return false
+}
+
+// asList returns the value if it's a list, or a list of the value otherwise.
+// IAM policy documents accept both a single statement or action and a list of them.
+func asList(v interface{}) []interface{} {
+	switch val := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		return val
+	}
+	return []interface{}{v}
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
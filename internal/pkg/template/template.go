@@ -18,7 +18,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/template/artifactpath"
 )
 
-//go:embed templates templates/overrides/cdk/.gitignore
+//go:embed templates templates/overrides/cdk/.gitignore templates/export/cdk/.gitignore
 var templateFS embed.FS
 
 // File names under "templates/".
@@ -251,7 +251,7 @@ func (t *Template) walkDir(basePath, curPath string, data any, fn WalkDirFunc, p
 	for _, entry := range entries {
 		targetPath := path.Join(curPath, entry.Name())
 		if entry.IsDir() {
-			if err := t.walkDir(basePath, targetPath, data, fn); err != nil {
+			if err := t.walkDir(basePath, targetPath, data, fn, parseOpts...); err != nil {
 				return err
 			}
 			continue
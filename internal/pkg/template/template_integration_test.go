@@ -107,6 +107,20 @@ func TestTemplate_ParseScheduledJob(t *testing.T) {
 				Version:                  "v1.28.0",
 			},
 		},
+		"renders with existing task and execution roles": {
+			opts: template.WorkloadOpts{
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				TaskRoleARN:              "arn:aws:iam::123456789012:role/my-task-role",
+				ExecutionRoleARN:         "arn:aws:iam::123456789012:role/my-execution-role",
+				ServiceDiscoveryEndpoint: "test.app.local",
+				CustomResources:          customResources,
+				EnvVersion:               "v1.42.0",
+				Version:                  "v1.28.0",
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -143,6 +157,7 @@ func TestTemplate_ParseLoadBalancedWebService(t *testing.T) {
 		"RulePriorityFunction":        fakeS3Object,
 		"NLBCustomDomainFunction":     fakeS3Object,
 		"NLBCertValidatorFunction":    fakeS3Object,
+		"BlueGreenDeploymentFunction": fakeS3Object,
 	}
 
 	testCases := map[string]struct {
@@ -496,6 +511,41 @@ DiscoveryServiceArn:
 				Version:                  "v1.28.0",
 			},
 		},
+		"renders a valid template with blue/green deployments": {
+			opts: template.WorkloadOpts{
+				ALBListener: &template.ALBListener{
+					Rules: []template.ALBListenerRule{
+						{
+							Path:            "/",
+							TargetPort:      "8080",
+							TargetContainer: "main",
+							HTTPHealthCheck: defaultHttpHealthCheck,
+							Stickiness:      "false",
+						},
+					},
+				},
+				Network: template.NetworkOpts{
+					AssignPublicIP: template.EnablePublicIP,
+					SubnetsType:    template.PublicSubnetsPlacement,
+				},
+				DeploymentConfiguration: template.DeploymentConfigurationOpts{
+					MinHealthyPercent: 100,
+					MaxPercent:        200,
+					BlueGreen: &template.BlueGreenDeploymentOpts{
+						TestListenerPort:       aws.Uint16(8080),
+						TerminationWaitMinutes: 5,
+						Hooks: []template.BlueGreenHook{
+							{Event: "AfterAllowTestTraffic", Function: "validate"},
+						},
+					},
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+				ALBEnabled:               true,
+				CustomResources:          customResources,
+				EnvVersion:               "v1.42.0",
+				Version:                  "v1.28.0",
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,18 @@
+#!/usr/bin/env node
+import * as cdk from 'aws-cdk-lib';
+import { CopilotStack } from '../stack';
+
+const app = new cdk.App();
+new CopilotStack(app, 'CopilotStack', {
+    stackName: {{quote .StackName}},
+    {{- range $param := .Parameters }}
+    {{$param.PropName}}: {{quote $param.Value}},
+    {{- end }}
+    {{- if .Tags }}
+    tags: {
+        {{- range $tag := .Tags }}
+        {{quote $tag.Key}}: {{quote $tag.Value}},
+        {{- end }}
+    },
+    {{- end }}
+});
@@ -0,0 +1,25 @@
+import * as cdk from 'aws-cdk-lib';
+import { Construct } from 'constructs';
+import * as path from 'path';
+
+export interface CopilotStackProps extends cdk.StackProps {
+    {{- range $param := .Parameters }}
+    readonly {{$param.PropName}}: string;
+    {{- end }}
+}
+
+export class CopilotStack extends cdk.Stack {
+    public readonly template: cdk.cloudformation_include.CfnInclude;
+
+    constructor (scope: Construct, id: string, props: CopilotStackProps) {
+        super(scope, id, props);
+        this.template = new cdk.cloudformation_include.CfnInclude(this, 'Template', {
+            templateFile: path.join(__dirname, {{quote .TemplateFile}}),
+            parameters: {
+                {{- range $param := .Parameters }}
+                {{quote $param.Name}}: props.{{$param.PropName}},
+                {{- end }}
+            },
+        });
+    }
+}
@@ -76,6 +76,20 @@ func (fs Stub) ParseWorkerService(_ template.WorkloadOpts) (*template.Content, e
 	}, nil
 }
 
+// ParseLambdaService returns a dummy template.Content with "data" in it.
+func (fs Stub) ParseLambdaService(_ template.WorkloadOpts) (*template.Content, error) {
+	return &template.Content{
+		Buffer: bytes.NewBufferString("data"),
+	}, nil
+}
+
+// ParseWorkflowJob returns a dummy template.Content with "data" in it.
+func (fs Stub) ParseWorkflowJob(_ template.WorkloadOpts) (*template.Content, error) {
+	return &template.Content{
+		Buffer: bytes.NewBufferString("data"),
+	}, nil
+}
+
 // ParseStaticSite returns a dummy template.Content with "data" in it.
 func (fs Stub) ParseStaticSite(_ template.WorkloadOpts) (*template.Content, error) {
 	return &template.Content{
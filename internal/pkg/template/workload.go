@@ -7,7 +7,9 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"fmt"
+	"path"
 	"strconv"
+	"strings"
 	"text/template"
 
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
@@ -35,7 +37,9 @@ const (
 	backendSvcTplName   = "backend"
 	workerSvcTplName    = "worker"
 	staticSiteTplName   = "static-site"
+	lambdaSvcTplName    = "lambda"
 	scheduledJobTplName = "scheduled-job"
+	workflowJobTplName  = "workflow-job"
 )
 
 // Constants for workload options.
@@ -86,6 +90,8 @@ var (
 		"workload-container",
 		"fargate-taskdef-base-properties",
 		"service-base-properties",
+		"service-connect-tls-role",
+		"otel-collector",
 		"servicediscovery",
 		"addons",
 		"sidecars",
@@ -107,9 +113,17 @@ var (
 		"publish",
 		"subscribe",
 		"nlb",
+		"api-gateway",
 		"vpc-connector",
 		"alb",
+		"alb-static-rule-action",
+		"alb-authenticate-action",
 		"rollback-alarms",
+		"blue-green",
+		"deployment-hooks",
+		"network-configuration",
+		"ingress-security-group",
+		"alarms",
 	}
 
 	// Operating systems to determine Fargate platform versions.
@@ -143,6 +157,9 @@ type SidecarOpts struct {
 	Command      []string
 	HealthCheck  *ContainerHealthCheck
 	PortMappings []*PortMapping
+
+	CPU               *int // Reserved CPU units.
+	MemoryReservation *int // Reserved memory in MiB.
 }
 
 // PortMapping holds container port mapping configuration.
@@ -167,6 +184,85 @@ type StorageOpts struct {
 	ManagedVolumeInfo *ManagedVolumeCreationInfo // Used for delegating CreationInfo for Copilot-managed EFS.
 }
 
+// secretFilesInitDir is the directory of the secret files container under which the volumes shared with
+// the other containers are mounted.
+const secretFilesInitDir = "/copilot/secrets"
+
+// SecretFilesOpts holds configuration for the container that writes secrets to files before the other containers start.
+type SecretFilesOpts struct {
+	Files []SecretFile // Sorted by container and name so that the rendered template is stable.
+}
+
+// SecretFile holds a secret that is written to a file of a container instead of an environment variable.
+type SecretFile struct {
+	Name      string // Name of the environment variable that holds the secret in the secret files container.
+	Container string // Name of the sidecar that reads the file, or empty for the main container.
+	Path      string // Absolute path of the file in the container.
+	Secret    Secret
+}
+
+// SecretFilesVolume holds a scratch volume shared between the secret files container and the container that reads the files.
+type SecretFilesVolume struct {
+	Name      string
+	Container string // Name of the sidecar that mounts the volume, or empty for the main container.
+	Dir       string // Directory of the container where the volume is mounted read-only.
+	InitDir   string // Directory of the secret files container where the volume is mounted.
+}
+
+// Volumes returns one volume for each directory of each container that holds secret files.
+func (s SecretFilesOpts) Volumes() []SecretFilesVolume {
+	var volumes []SecretFilesVolume
+	seen := make(map[[2]string]bool)
+	for _, file := range s.Files {
+		key := [2]string{file.Container, path.Dir(file.Path)}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		name := fmt.Sprintf("copilot-secret-files-%d", len(volumes))
+		volumes = append(volumes, SecretFilesVolume{
+			Name:      name,
+			Container: file.Container,
+			Dir:       key[1],
+			InitDir:   path.Join(secretFilesInitDir, name),
+		})
+	}
+	return volumes
+}
+
+// VolumesFor returns the volumes mounted by the container, where the empty name is the main container.
+func (s *SecretFilesOpts) VolumesFor(container string) []SecretFilesVolume {
+	if s == nil {
+		return nil
+	}
+	var volumes []SecretFilesVolume
+	for _, vol := range s.Volumes() {
+		if vol.Container == container {
+			volumes = append(volumes, vol)
+		}
+	}
+	return volumes
+}
+
+// HasFilesFor returns true if secrets are written to files of the container, where the empty name is the main container.
+func (s *SecretFilesOpts) HasFilesFor(container string) bool {
+	return len(s.VolumesFor(container)) > 0
+}
+
+// Script returns the shell command that writes the secrets to the volumes shared with the other containers.
+func (s SecretFilesOpts) Script() string {
+	initDirFor := make(map[[2]string]string)
+	for _, vol := range s.Volumes() {
+		initDirFor[[2]string{vol.Container, vol.Dir}] = vol.InitDir
+	}
+	cmds := make([]string, len(s.Files))
+	for i, file := range s.Files {
+		dst := path.Join(initDirFor[[2]string{file.Container, path.Dir(file.Path)}], path.Base(file.Path))
+		cmds[i] = fmt.Sprintf(`printf '%%s' "$%s" > %s`, file.Name, dst)
+	}
+	return strings.Join(cmds, " && ")
+}
+
 // requiresEFSCreation returns true if managed volume information is specified; false otherwise.
 func (s *StorageOpts) requiresEFSCreation() bool {
 	return s.ManagedVolumeInfo != nil
@@ -222,6 +318,16 @@ type LogConfigOpts struct {
 	ConfigFile     *string
 	Variables      map[string]Variable
 	Secrets        map[string]Secret
+
+	// Resources that the log router writes to with the task role.
+	FirehoseDeliveryStream *LogDestinationResource
+	OpenSearchDomain       *LogDestinationResource
+}
+
+// LogDestinationResource represents an AWS resource in a region that FireLens routes the logs to.
+type LogDestinationResource struct {
+	Name   string
+	Region string
 }
 
 // HTTPTargetContainer represents the target group of a load balancer that points to a container.
@@ -448,14 +554,95 @@ type ALBListenerRule struct {
 	HTTPVersion         string
 	RedirectToHTTPS     bool // Only relevant if HTTPSListener is true.
 	DeregistrationDelay *int64
+	Hosts               []string // Replaces the aliases in the host header condition if set.
+
+	// Weight is the share of the requests routed to the target of the rule if there are WeightedTargets.
+	Weight          int
+	WeightedTargets []ALBTarget
+
+	// Auth authenticates the users with an identity provider before the requests are forwarded over HTTPS.
+	Auth *ALBAuth
+}
+
+// ALBAuth holds configuration for an authenticate-oidc or authenticate-cognito action.
+type ALBAuth struct {
+	OIDC              *ALBOIDCAuth
+	Cognito           *ALBCognitoAuth
+	Scope             string
+	SessionTimeout    string // In seconds.
+	SessionCookieName string
+}
+
+// ALBOIDCAuth holds the identity provider settings of an authenticate-oidc action.
+type ALBOIDCAuth struct {
+	Issuer                string
+	AuthorizationEndpoint string
+	TokenEndpoint         string
+	UserInfoEndpoint      string
+	ClientID              string
+	ClientSecret          string // Dynamic reference to the secret in Secrets Manager.
+}
+
+// ALBCognitoAuth holds the user pool settings of an authenticate-cognito action.
+type ALBCognitoAuth struct {
+	UserPoolARN string
+	ClientID    string
+	Domain      string
+}
+
+// ALBTarget is a container port of the service that receives a share of the requests matched by a listener rule.
+type ALBTarget struct {
+	TargetContainer string
+	TargetPort      string
+	Weight          int
+}
+
+// Targets returns the target of the rule followed by its weighted targets.
+// The target group of the i-th target is suffixed with "Weighted{i}", except for the target of the rule.
+func (r ALBListenerRule) Targets() []ALBTarget {
+	return append([]ALBTarget{{
+		TargetContainer: r.TargetContainer,
+		TargetPort:      r.TargetPort,
+		Weight:          r.Weight,
+	}}, r.WeightedTargets...)
+}
+
+// ALBStaticRule holds configuration for a listener rule that is answered by the load balancer
+// with either a redirect or a fixed response.
+type ALBStaticRule struct {
+	Path          string
+	Hosts         []string
+	Redirect      *ALBRedirect
+	FixedResponse *ALBFixedResponse
+}
+
+// ALBRedirect holds the URL parts of a redirect action. Unchanged parts are set to their "#{...}" placeholder.
+type ALBRedirect struct {
+	Protocol   string
+	Host       string
+	Port       string
+	Path       string
+	Query      string
+	StatusCode string
+}
+
+// ALBFixedResponse holds the configuration of a fixed-response action.
+type ALBFixedResponse struct {
+	StatusCode  string
+	ContentType string
+	Body        string
 }
 
 // ALBListener holds configuration that's needed for an Application Load Balancer Listener.
 type ALBListener struct {
 	Rules             []ALBListenerRule
+	StaticRules       []ALBStaticRule
 	HostedZoneAliases AliasesForHostedZone
 	IsHTTPS           bool // True if the listener listening on port 443.
 	MainContainerPort string
+	ClientAuth        string // The mutual TLS mode that the service requires from the HTTPS listener of the environment, if any.
+
+	PrivateHostedZoneAliases []string // Aliases resolved to the internal load balancer in the private hosted zone of the environment.
 }
 
 // Aliases return all the unique aliases specified across all the routing rules in ALB.
@@ -480,12 +667,61 @@ func (cfg *ALBListener) RulePaths() []string {
 	for _, rule := range cfg.Rules {
 		rulePaths = append(rulePaths, rule.Path)
 	}
+	for _, rule := range cfg.StaticRules {
+		rulePaths = append(rulePaths, rule.Path)
+	}
 	return rulePaths
 }
 
+// StaticRulePriorityIndex returns the index of the priority assigned to the i-th static rule,
+// since the priorities of the static rules follow the ones of the forwarding rules.
+func (cfg *ALBListener) StaticRulePriorityIndex(i int) int {
+	return len(cfg.Rules) + i
+}
+
+// APIGatewayOpts holds configuration for an API Gateway API that fronts a service through a VPC link.
+// HTTP APIs integrate with the service discovery service, whereas WebSocket APIs integrate with an internal Network Load Balancer.
+type APIGatewayOpts struct {
+	IsWebSocket     bool
+	Routes          []string // Route keys of a WebSocket API in addition to $connect, $disconnect and $default.
+	JWTAuthorizer   *JWTAuthorizerOpts
+	ThrottlingRate  *float64
+	ThrottlingBurst *int
+}
+
+// JWTAuthorizerOpts holds configuration for a JWT authorizer of an HTTP API.
+type JWTAuthorizerOpts struct {
+	Issuer   string
+	Audience []string
+}
+
 // ServiceConnect holds configuration for ECS Service Connect.
 type ServiceConnect struct {
 	Alias *string
+	TLS   bool
+	Ports []ServiceConnectPort // Additional endpoints of the service.
+}
+
+// ServiceConnectPort holds configuration for a container port exposed as an additional Service Connect endpoint.
+type ServiceConnectPort struct {
+	Name          string // Name of the port mapping.
+	ContainerName string
+	Port          uint16
+	Alias         string // Empty means the name of the workload.
+	AppProtocol   string
+}
+
+// PortMapping returns the Service Connect endpoint of the port of a container, or nil if the port is not exposed with Service Connect.
+func (sc *ServiceConnect) PortMapping(container string, port uint16) *ServiceConnectPort {
+	if sc == nil {
+		return nil
+	}
+	for i := range sc.Ports {
+		if sc.Ports[i].ContainerName == container && sc.Ports[i].Port == port {
+			return &sc.Ports[i]
+		}
+	}
+	return nil
 }
 
 // AdvancedCount holds configuration for autoscaling and capacity provider
@@ -527,12 +763,68 @@ type AutoscalingOpts struct {
 	Memory             *float64
 	Requests           *float64
 	ResponseTime       *float64
+	Connections        *float64
 	CPUCooldown        Cooldown
 	MemCooldown        Cooldown
 	ReqCooldown        Cooldown
 	RespTimeCooldown   Cooldown
+	ConnCooldown       Cooldown
 	QueueDelayCooldown Cooldown
 	QueueDelay         *AutoscalingQueueDelayOpts
+	CustomMetrics      []CustomMetricScalingOpts
+	StepScaling        []StepScalingOpts
+	Schedules          []ScheduledScalingOpts
+
+	// ScaleToZero is true if the service can have no running tasks, either from its range or from a schedule.
+	ScaleToZero bool
+}
+
+// CloudWatchMetricOpts holds configuration to identify a CloudWatch metric.
+type CloudWatchMetricOpts struct {
+	Namespace  string
+	Name       string
+	Dimensions map[string]string
+	Statistic  string
+}
+
+// CustomMetricScalingOpts holds configuration for a target tracking policy on a CloudWatch metric.
+type CustomMetricScalingOpts struct {
+	Metric   CloudWatchMetricOpts
+	Target   float64
+	Cooldown Cooldown
+}
+
+// StepScalingOpts holds configuration for the step scaling policies and the alarms on a CloudWatch metric.
+type StepScalingOpts struct {
+	Metric            CloudWatchMetricOpts
+	PeriodSeconds     int
+	EvaluationPeriods int
+	Cooldown          Cooldown
+	ScaleOut          *StepScalingPolicyOpts
+	ScaleIn           *StepScalingPolicyOpts
+}
+
+// StepScalingPolicyOpts holds the threshold of the alarm of a step scaling policy
+// and the step adjustments relative to that threshold.
+type StepScalingPolicyOpts struct {
+	Threshold float64
+	Steps     []StepAdjustmentOpts
+}
+
+// StepAdjustmentOpts holds the bounds, relative to the alarm threshold, and the change in the number of tasks of a step.
+type StepAdjustmentOpts struct {
+	LowerBound *float64
+	UpperBound *float64
+	Change     int
+}
+
+// ScheduledScalingOpts holds configuration for a scheduled action that changes the capacity of the service.
+type ScheduledScalingOpts struct {
+	Name        string
+	Expression  string
+	Timezone    *string
+	MinCapacity *int
+	MaxCapacity *int
 }
 
 // AliasesForHostedZone maps hosted zone IDs to aliases that belong to it.
@@ -545,7 +837,24 @@ type AutoscalingQueueDelayOpts struct {
 
 // ObservabilityOpts holds configurations for observability.
 type ObservabilityOpts struct {
-	Tracing string // The name of the vendor used for tracing.
+	Tracing   string // The name of the vendor used for tracing.
+	Collector *OTelCollectorOpts
+}
+
+// AppRunnerAutoScalingOpts holds configuration for the auto scaling configuration of an App Runner service.
+type AppRunnerAutoScalingOpts struct {
+	MaxConcurrency *int
+	MinSize        *int
+	MaxSize        *int
+}
+
+// OTelCollectorOpts holds configuration for an AWS Distro for OpenTelemetry collector sidecar.
+type OTelCollectorOpts struct {
+	Image        string
+	Traces       string // Destination of the traces, either "AWSXRAY" or "OTLP". Empty if traces aren't exported.
+	Metrics      string // Destination of the metrics, either "CLOUDWATCH" or "OTLP". Empty if metrics aren't exported.
+	OTLPEndpoint string
+	Config       string // User-supplied collector configuration that replaces the generated one.
 }
 
 // DeploymentConfigurationOpts holds configuration for rolling deployments.
@@ -555,11 +864,81 @@ type DeploymentConfigurationOpts struct {
 	// The upper limit on the number of tasks that should be running during a service deployment or when a container instance is draining.
 	MaxPercent int
 	Rollback   RollingUpdateRollbackConfig
+
+	// Configuration for blue/green deployments with CodeDeploy. If nil, the service uses rolling deployments.
+	BlueGreen *BlueGreenDeploymentOpts
+
+	// One-off tasks to run before and after the tasks of the service are replaced.
+	PreDeploy  *DeploymentHookOpts
+	PostDeploy *DeploymentHookOpts
+}
+
+// HasHooks returns true if a one-off task runs before or after the deployment of the service.
+func (d DeploymentConfigurationOpts) HasHooks() bool {
+	return d.PreDeploy != nil || d.PostDeploy != nil
+}
+
+// DeploymentHookOpts holds configuration for a one-off task that runs the main container of the service with a command.
+type DeploymentHookOpts struct {
+	Command []string
+}
+
+// BlueGreenDeploymentOpts holds configuration for blue/green deployments with CodeDeploy.
+type BlueGreenDeploymentOpts struct {
+	TestListenerPort       *uint16         // Port of the listener to validate the replacement tasks before they receive production traffic.
+	TerminationWaitMinutes int             // Time to wait before terminating the original tasks once the traffic is shifted.
+	Hooks                  []BlueGreenHook // Lambda functions to invoke during the deployment.
+
+	// Configuration to shift the traffic gradually. If nil, all the traffic is shifted at once.
+	TrafficRouting *TrafficRoutingOpts
+}
+
+// Types of traffic routing of CodeDeploy deployment configurations.
+const (
+	TimeBasedCanaryTrafficRouting = "TimeBasedCanary"
+	TimeBasedLinearTrafficRouting = "TimeBasedLinear"
+)
+
+// TrafficRoutingOpts holds configuration to shift the traffic of a blue/green deployment in increments.
+type TrafficRoutingOpts struct {
+	Type            string // Either TimeBasedCanaryTrafficRouting or TimeBasedLinearTrafficRouting.
+	Percent         int    // Percentage of traffic to shift in each increment.
+	IntervalMinutes int    // Minutes between two increments.
+}
+
+// BlueGreenHook represents a Lambda function that CodeDeploy invokes at a lifecycle event of a blue/green deployment.
+type BlueGreenHook struct {
+	Event    string // Name of the lifecycle event, such as "BeforeAllowTraffic".
+	Function string // Name or ARN of the Lambda function.
+}
+
+// IsARN returns true if the hook's function is referred to by its ARN instead of its name.
+func (h BlueGreenHook) IsARN() bool {
+	return strings.HasPrefix(h.Function, "arn:")
+}
+
+// Logical IDs of the alarms rendered from AlarmsOpts.
+const (
+	CPUUtilizationAlarmLogicalID    = "CPUUtilizationAlarm"
+	MemoryUtilizationAlarmLogicalID = "MemoryUtilizationAlarm"
+	HTTP5xxRateAlarmLogicalID       = "HTTP5xxRateAlarm"
+	ResponseTimeAlarmLogicalID      = "ResponseTimeAlarm"
+	QueueDepthAlarmLogicalID        = "QueueDepthAlarm"
+)
+
+// alarmTypes maps the logical ID of an alarm rendered from AlarmsOpts to the suffix of its name.
+var alarmTypes = map[string]string{
+	CPUUtilizationAlarmLogicalID:    "CopilotCPUAlarm",
+	MemoryUtilizationAlarmLogicalID: "CopilotMemAlarm",
+	HTTP5xxRateAlarmLogicalID:       "Copilot5xxAlarm",
+	ResponseTimeAlarmLogicalID:      "CopilotResponseTimeAlarm",
+	QueueDepthAlarmLogicalID:        "CopilotQueueDepthAlarm",
 }
 
 // RollingUpdateRollbackConfig holds config for rollback alarms.
 type RollingUpdateRollbackConfig struct {
-	AlarmNames []string // Names of existing alarms.
+	AlarmNames      []string // Names of existing alarms.
+	GeneratedAlarms []string // Logical IDs of the alarms rendered from AlarmsOpts.
 
 	// Custom alarms to create.
 	CPUUtilization    *float64
@@ -569,7 +948,7 @@ type RollingUpdateRollbackConfig struct {
 
 // HasRollbackAlarms returns true if the client is using ABR.
 func (cfg RollingUpdateRollbackConfig) HasRollbackAlarms() bool {
-	return len(cfg.AlarmNames) > 0 || cfg.HasCustomAlarms()
+	return len(cfg.AlarmNames) > 0 || len(cfg.GeneratedAlarms) > 0 || cfg.HasCustomAlarms()
 }
 
 // HasCustomAlarms returns true if the client is using Copilot-generated alarms for alarm-based rollbacks.
@@ -579,6 +958,16 @@ func (cfg RollingUpdateRollbackConfig) HasCustomAlarms() bool {
 
 // TruncateAlarmName ensures that alarm names don't exceed the 255 character limit.
 func (cfg RollingUpdateRollbackConfig) TruncateAlarmName(app, env, svc, alarmType string) string {
+	return truncateAlarmName(app, env, svc, alarmType)
+}
+
+// GeneratedAlarmName returns the name of an alarm rendered from AlarmsOpts given its logical ID.
+// The ECS service refers to the alarms by name, since the alarms depend on the service.
+func (cfg RollingUpdateRollbackConfig) GeneratedAlarmName(app, env, svc, logicalID string) string {
+	return truncateAlarmName(app, env, svc, alarmTypes[logicalID])
+}
+
+func truncateAlarmName(app, env, svc, alarmType string) string {
 	if len(app)+len(env)+len(svc)+len(alarmType) <= 255 {
 		return fmt.Sprintf("%s-%s-%s-%s", app, env, svc, alarmType)
 	}
@@ -586,6 +975,28 @@ func (cfg RollingUpdateRollbackConfig) TruncateAlarmName(app, env, svc, alarmTyp
 	return fmt.Sprintf("%s-%s-%s-%s", app[:maxSubstringLength], env[:maxSubstringLength], svc[:maxSubstringLength], alarmType)
 }
 
+// AlarmsOpts holds the CloudWatch alarms that monitor a service, and the SNS targets that they notify.
+type AlarmsOpts struct {
+	CPUUtilization    *float64
+	MemoryUtilization *float64
+	HTTP5xxRate       *float64
+	ResponseTime      *float64 // Target response time in seconds.
+	QueueDepth        *int
+
+	Emails []string // Email addresses subscribed to a topic that Copilot creates.
+	Topics []string // ARNs of existing SNS topics.
+}
+
+// HasActions returns true if the alarms notify at least one SNS topic.
+func (a AlarmsOpts) HasActions() bool {
+	return len(a.Emails) > 0 || len(a.Topics) > 0
+}
+
+// AlarmName returns the name of an alarm of the service, truncated to the 255 character limit.
+func (a AlarmsOpts) AlarmName(app, env, svc, alarmType string) string {
+	return truncateAlarmName(app, env, svc, alarmType)
+}
+
 // ExecuteCommandOpts holds configuration that's needed for ECS Execute Command.
 type ExecuteCommandOpts struct{}
 
@@ -600,10 +1011,20 @@ type PublishOpts struct {
 	Topics []*Topic
 }
 
+// KMSKeys returns the unique ARNs of the customer managed KMS keys that encrypt the topics.
+func (p *PublishOpts) KMSKeys() []string {
+	var keys []string
+	for _, t := range p.Topics {
+		keys = appendUniqueKMSKey(keys, t.KMSKey)
+	}
+	return keys
+}
+
 // Topic holds information needed to render a SNSTopic in a container definition.
 type Topic struct {
 	Name            *string
 	FIFOTopicConfig *FIFOTopicConfig
+	KMSKey          *string
 
 	Region    string
 	Partition string
@@ -621,6 +1042,7 @@ type FIFOTopicConfig struct {
 // SubscribeOpts holds configuration needed if the service has subscriptions.
 type SubscribeOpts struct {
 	Topics []*TopicSubscription
+	Events []*EventRule
 	Queue  *SQSQueue
 }
 
@@ -634,12 +1056,53 @@ func (s *SubscribeOpts) HasTopicQueues() bool {
 	return false
 }
 
+// KMSKeys returns the unique ARNs of the customer managed KMS keys that encrypt the queues.
+func (s *SubscribeOpts) KMSKeys() []string {
+	var keys []string
+	if s.Queue != nil {
+		keys = appendUniqueKMSKey(keys, s.Queue.KMSKey)
+	}
+	for _, t := range s.Topics {
+		if t.Queue != nil {
+			keys = appendUniqueKMSKey(keys, t.Queue.KMSKey)
+		}
+	}
+	return keys
+}
+
+func appendUniqueKMSKey(keys []string, key *string) []string {
+	if key == nil {
+		return keys
+	}
+	for _, k := range keys {
+		if k == *key {
+			return keys
+		}
+	}
+	return append(keys, *key)
+}
+
+// EventRule holds information needed to render an EventBridge rule that targets the workload.
+type EventRule struct {
+	Name    string
+	Bus     *string
+	Pattern string // JSON-encoded event pattern.
+}
+
+// JobSchedule holds information needed to render an EventBridge Scheduler schedule that invokes a job.
+type JobSchedule struct {
+	Expression            string
+	Timezone              *string
+	FlexibleWindowMinutes *int64
+}
+
 // TopicSubscription holds information needed to render a SNS Topic Subscription in a container definition.
 type TopicSubscription struct {
-	Name         *string
-	Service      *string
-	FilterPolicy *string
-	Queue        *SQSQueue
+	Name              *string
+	Service           *string
+	FilterPolicy      *string
+	FilterPolicyScope *string
+	Queue             *SQSQueue
 }
 
 // SQSQueue holds information needed to render a SQS Queue in a container definition.
@@ -649,6 +1112,7 @@ type SQSQueue struct {
 	Timeout         *int64
 	DeadLetter      *DeadLetterQueue
 	FIFOQueueConfig *FIFOQueueConfig
+	KMSKey          *string
 }
 
 // FIFOQueueConfig holds information needed to render a FIFO SQS Queue in a container definition.
@@ -660,7 +1124,8 @@ type FIFOQueueConfig struct {
 
 // DeadLetterQueue holds information needed to render a dead-letter SQS Queue in a container definition.
 type DeadLetterQueue struct {
-	Tries *uint16
+	Tries     *uint16
+	Retention *int64
 }
 
 // NetworkOpts holds AWS networking configuration for the workloads.
@@ -671,6 +1136,17 @@ type NetworkOpts struct {
 	SubnetsType              string
 	SubnetIDs                []string
 	DenyDefaultSecurityGroup bool
+	Ingress                  []IngressRule
+}
+
+// IngressRule holds an inbound rule of the security group that is created for the tasks of a service.
+// SourceSecurityGroup and CIDR are mutually exclusive.
+type IngressRule struct {
+	SourceSecurityGroup SecurityGroup
+	CIDR                string
+	IPProtocol          string
+	FromPort            int
+	ToPort              int
 }
 
 // SecurityGroup represents the ID of an additional security group associated with the tasks.
@@ -753,8 +1229,10 @@ type WorkloadOpts struct {
 	EnvName            string
 	WorkloadName       string
 	SerializedManifest string // Raw manifest file used to deploy the workload.
+	RenderedManifest   string // Manifest after the substitution of its environment variables, if any.
 	EnvVersion         string
 	Version            string
+	LogGroupName       string // If not empty, the physical name of the workload's log group.
 
 	// Configuration for the main container.
 	PortMappings []*PortMapping
@@ -762,6 +1240,7 @@ type WorkloadOpts struct {
 	Secrets      map[string]Secret
 	EntryPoint   []string
 	Command      []string
+	GPU          *int
 
 	// Additional options that are common between **all** workload templates.
 	Tags                     map[string]string        // Used by App Runner workloads to tag App Runner service resources
@@ -771,8 +1250,10 @@ type WorkloadOpts struct {
 	LogConfig                *LogConfigOpts
 	Autoscaling              *AutoscalingOpts
 	CapacityProviders        []*CapacityProviderStrategy
+	EC2CapacityProvider      bool // Places the tasks on the EC2 capacity provider of the environment instead of Fargate.
 	DesiredCountOnSpot       *int
 	Storage                  *StorageOpts
+	SecretFiles              *SecretFilesOpts // Secrets of the main container that are written to files.
 	Network                  NetworkOpts
 	ExecuteCommand           *ExecuteCommandOpts
 	Platform                 RuntimePlatformOpts
@@ -783,6 +1264,8 @@ type WorkloadOpts struct {
 	ALBEnabled               bool
 	CredentialsParameter     string
 	PermissionsBoundary      string
+	TaskRoleARN              string // Existing IAM role assumed by the containers instead of the generated task role.
+	ExecutionRoleARN         string // Existing IAM role used by the ECS agent instead of the generated execution role.
 
 	// Additional options for service templates.
 	WorkloadType            string
@@ -791,14 +1274,19 @@ type WorkloadOpts struct {
 	GracePeriod             *int64
 	NLB                     *NetworkLoadBalancer
 	ALBListener             *ALBListener
+	APIGateway              *APIGatewayOpts
 	DeploymentConfiguration DeploymentConfigurationOpts
 	ServiceConnect          *ServiceConnect
+	DualStack               bool // If true, the public load balancers of the service are reachable over IPv4 and IPv6.
+	Alarms                  *AlarmsOpts
 
 	// Custom Resources backed by Lambda functions.
 	CustomResources map[string]S3ObjectLocation
 
 	// Additional options for job templates.
 	ScheduleExpression string
+	Schedules          []*JobSchedule
+	EventRules         []*EventRule
 	StateMachine       *StateMachineOpts
 
 	// Additional options for request driven web service templates.
@@ -807,7 +1295,9 @@ type WorkloadOpts struct {
 	Observability        ObservabilityOpts
 	Private              bool
 	AppRunnerVPCEndpoint *string
-	Count                *string
+	Count                *string // Name and revision of an existing auto scaling configuration.
+	AutoScaling          *AppRunnerAutoScalingOpts
+	WAF                  *WAF // If not-nil, associate a web ACL with the service.
 
 	// Input needed for the custom resource that adds a custom domain to the service.
 	Alias                *string
@@ -819,9 +1309,136 @@ type WorkloadOpts struct {
 	Subscribe *SubscribeOpts
 
 	// Additional options for static site template.
-	AssetMappingFileBucket string
-	AssetMappingFilePath   string
-	StaticSiteAlias        string
+	AssetMappingFileBucket    string
+	AssetMappingFilePath      string
+	StaticSiteAlias           string
+	StaticSiteErrorDocument   string
+	StaticSiteRedirects       []StaticSiteRedirect
+	StaticSiteResponseHeaders *StaticSiteResponseHeaders
+	StaticSiteWebACLARN       string // ARN of the web ACL associated with the CloudFront distribution, if any.
+	StaticSiteLogs            bool   // If true, CloudFront writes access logs to the bucket of the environment.
+	StaticSiteCache           StaticSiteCache
+
+	// Additional options for Lambda service templates.
+	Lambda *LambdaOpts
+
+	// Additional options for workflow job templates.
+	Workflow *WorkflowOpts
+}
+
+// LambdaOpts holds configuration for the function of a Lambda service.
+type LambdaOpts struct {
+	MemorySize   int
+	Timeout      int    // Maximum duration of an invocation in seconds.
+	Architecture string // Either "x86_64" or "arm64".
+	ConfigHash   string // Changes with the image and the configuration of the function so that a new version is published.
+
+	RulePath string // Path of the environment load balancer that routes requests to the function, if any.
+	HTTPS    bool   // True if the requests are routed from the HTTPS listener of the environment load balancer.
+
+	// Configuration to shift the traffic of the alias to a new version in increments. If nil, all the traffic is shifted at once.
+	TrafficRouting *TrafficRoutingOpts
+	RollbackAlarms []string // Names of the alarms that roll back the traffic shifting.
+}
+
+// WorkflowOpts holds configuration for the state machine of a workflow job.
+type WorkflowOpts struct {
+	Timeout *int   // Maximum duration of an execution in seconds.
+	StartAt string // Name of the first step.
+	Steps   []*WorkflowStepOpts
+}
+
+// WorkflowStepOpts holds configuration for a step of a workflow job, which runs a container as an ECS task.
+type WorkflowStepOpts struct {
+	Name       string
+	Image      string
+	CPU        int
+	Memory     int
+	Variables  map[string]Variable
+	Secrets    map[string]Secret
+	EntryPoint []string
+	Command    []string
+
+	InputPath  *string
+	ResultPath *string // If nil, the result of the task is discarded so that the step passes its input along.
+	OutputPath *string
+	Timeout    *int // Maximum duration of the task in seconds.
+	Retries    *int
+	Catch      []*WorkflowCatchOpts
+	Next       string // Name of the next step, or empty if the workflow ends with this step.
+}
+
+// WorkflowCatchOpts holds configuration for the fallback step of a failed step.
+type WorkflowCatchOpts struct {
+	Errors     []string
+	Next       string
+	ResultPath *string
+}
+
+// StaticSiteRedirect holds configuration to redirect the requests to a path of a static site.
+type StaticSiteRedirect struct {
+	From              string // Path to redirect, or the prefix of the paths if HasWildcard is true.
+	To                string
+	HasWildcard       bool
+	KeepSuffix        bool // If true, the rest of the path after the From prefix is appended to To.
+	StatusCode        int
+	StatusDescription string
+}
+
+// StaticSiteCache holds the cache behaviors of the CloudFront distribution of a static site,
+// and the paths invalidated after each deployment.
+type StaticSiteCache struct {
+	Default StaticSiteCacheBehavior
+	Paths   []StaticSiteCacheBehavior
+
+	InvalidationPaths   []string // Defaults to "/*" if empty.
+	DisableInvalidation bool
+}
+
+// StaticSiteCacheBehavior holds the policies of a CloudFront cache behavior.
+type StaticSiteCacheBehavior struct {
+	PathPattern           string // Empty for the default cache behavior.
+	CachePolicyID         string // Defaults to the managed CachingOptimized policy if empty and TTL is nil.
+	OriginRequestPolicyID string
+	TTL                   *StaticSiteCacheTTL // If not nil, Copilot creates a cache policy with these TTLs.
+}
+
+// StaticSiteCacheTTL holds the TTLs in seconds of a cache policy.
+type StaticSiteCacheTTL struct {
+	Min     int64
+	Default int64
+	Max     int64
+}
+
+// StaticSiteResponseHeaders holds the headers that CloudFront adds to the responses of a static site.
+type StaticSiteResponseHeaders struct {
+	ContentSecurityPolicy   string
+	StrictTransportSecurity *StrictTransportSecurityOpts
+	ContentTypeOptions      bool
+	FrameOption             string
+	ReferrerPolicy          string
+	XSSProtection           *XSSProtectionOpts
+	Custom                  map[string]string
+}
+
+// HasSecurityHeaders returns true if any of the headers are security headers.
+func (h StaticSiteResponseHeaders) HasSecurityHeaders() bool {
+	return h.ContentSecurityPolicy != "" || h.StrictTransportSecurity != nil || h.ContentTypeOptions ||
+		h.FrameOption != "" || h.ReferrerPolicy != "" || h.XSSProtection != nil
+}
+
+// StrictTransportSecurityOpts holds the directives of the Strict-Transport-Security header.
+type StrictTransportSecurityOpts struct {
+	MaxAgeSec         int64
+	IncludeSubdomains bool
+	Preload           bool
+}
+
+// XSSProtectionOpts holds the directives of the X-XSS-Protection header.
+type XSSProtectionOpts struct {
+	Protection bool
+	ModeBlock  bool
+	ReportURI  string
 }
 
 // HealthCheckProtocol returns the protocol for the Load Balancer health check,
@@ -869,11 +1486,21 @@ func (t *Template) ParseStaticSite(data WorkloadOpts) (*Content, error) {
 	return t.parseSvc(staticSiteTplName, data, withSvcParsingFuncs())
 }
 
+// ParseLambdaService parses a Lambda service's CloudFormation template with the specified data object and returns its content.
+func (t *Template) ParseLambdaService(data WorkloadOpts) (*Content, error) {
+	return t.parseSvc(lambdaSvcTplName, data, withSvcParsingFuncs())
+}
+
 // ParseScheduledJob parses a scheduled job's Cloudformation Template
 func (t *Template) ParseScheduledJob(data WorkloadOpts) (*Content, error) {
 	return t.parseJob(scheduledJobTplName, data, withSvcParsingFuncs())
 }
 
+// ParseWorkflowJob parses a workflow job's CloudFormation template with the specified data object and returns its content.
+func (t *Template) ParseWorkflowJob(data WorkloadOpts) (*Content, error) {
+	return t.parseJob(workflowJobTplName, data, withSvcParsingFuncs())
+}
+
 // parseSvc parses a service's CloudFormation template with the specified data object and returns its content.
 func (t *Template) parseSvc(name string, data interface{}, options ...ParseOption) (*Content, error) {
 	return t.parseWkld(name, servicesDirName, data, options...)
@@ -965,6 +1592,14 @@ func envControllerParameters(o WorkloadOpts) []string {
 		if o.ALBEnabled {
 			parameters = append(parameters, "ALBWorkloads,")
 		}
+		if o.ALBListener != nil {
+			switch o.ALBListener.ClientAuth {
+			case "verify":
+				parameters = append(parameters, "MutualTLSVerifyWorkloads,")
+			case "passthrough":
+				parameters = append(parameters, "MutualTLSPassthroughWorkloads,")
+			}
+		}
 		parameters = append(parameters, "Aliases,") // YAML needs the comma separator; resolved in EnvContr.
 	}
 	if o.WorkloadType == "Backend Service" {
@@ -972,6 +1607,11 @@ func envControllerParameters(o WorkloadOpts) []string {
 			parameters = append(parameters, "InternalALBWorkloads,")
 		}
 	}
+	if o.WorkloadType == "Lambda Service" {
+		if o.ALBEnabled {
+			parameters = append(parameters, "ALBWorkloads,")
+		}
+	}
 	if o.WorkloadType == "Request-Driven Web Service" {
 		if o.Private && o.AppRunnerVPCEndpoint == nil {
 			parameters = append(parameters, "AppRunnerPrivateWorkloads,")
@@ -983,6 +1623,9 @@ func envControllerParameters(o WorkloadOpts) []string {
 	if o.Storage != nil && o.Storage.requiresEFSCreation() {
 		parameters = append(parameters, "EFSWorkloads,")
 	}
+	if o.ServiceConnect != nil && o.ServiceConnect.TLS {
+		parameters = append(parameters, "ServiceConnectTLSWorkloads,")
+	}
 	return parameters
 }
 
@@ -42,6 +42,8 @@ func TestTemplate_ParseSvc(t *testing.T) {
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/workload-container.yml", []byte("workload-container"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/fargate-taskdef-base-properties.yml", []byte("fargate-taskdef-base-properties"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/service-base-properties.yml", []byte("service-base-properties"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/service-connect-tls-role.yml", []byte("service-connect-tls-role"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/otel-collector.yml", []byte("otel-collector"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/servicediscovery.yml", []byte("servicediscovery"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/addons.yml", []byte("addons"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/sidecars.yml", []byte("sidecars"), 0644)
@@ -63,9 +65,17 @@ func TestTemplate_ParseSvc(t *testing.T) {
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/publish.yml", []byte("publish"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/subscribe.yml", []byte("subscribe"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/nlb.yml", []byte("nlb"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/api-gateway.yml", []byte("api-gateway"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/vpc-connector.yml", []byte("vpc-connector"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/alb.yml", []byte("alb"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/alb-static-rule-action.yml", []byte("alb-static-rule-action"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/alb-authenticate-action.yml", []byte("alb-authenticate-action"), 0644)
 				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/rollback-alarms.yml", []byte("rollback-alarms"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/blue-green.yml", []byte("blue-green"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/deployment-hooks.yml", []byte("deployment-hooks"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/network-configuration.yml", []byte("network-configuration"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/ingress-security-group.yml", []byte("ingress-security-group"), 0644)
+				_ = afero.WriteFile(fs, "templates/workloads/partials/cf/alarms.yml", []byte("alarms"), 0644)
 
 				return fs
 			},
@@ -78,6 +88,8 @@ func TestTemplate_ParseSvc(t *testing.T) {
   workload-container
   fargate-taskdef-base-properties
   service-base-properties
+  service-connect-tls-role
+  otel-collector
   servicediscovery
   addons
   sidecars
@@ -99,9 +111,17 @@ func TestTemplate_ParseSvc(t *testing.T) {
   publish
   subscribe
   nlb
+  api-gateway
   vpc-connector
   alb
+  alb-static-rule-action
+  alb-authenticate-action
   rollback-alarms
+  blue-green
+  deployment-hooks
+  network-configuration
+  ingress-security-group
+  alarms
 `,
 		},
 	}
@@ -392,6 +412,16 @@ func TestEnvControllerParameters(t *testing.T) {
 			},
 			expected: []string{"ALBWorkloads,", "Aliases,", "NATWorkloads,", "EFSWorkloads,"},
 		},
+		"LBWS with ALB and mutual TLS": {
+			opts: WorkloadOpts{
+				WorkloadType: "Load Balanced Web Service",
+				ALBEnabled:   true,
+				ALBListener: &ALBListener{
+					ClientAuth: "verify",
+				},
+			},
+			expected: []string{"ALBWorkloads,", "MutualTLSVerifyWorkloads,", "Aliases,"},
+		},
 		"Backend": {
 			opts: WorkloadOpts{
 				WorkloadType: "Backend Service",
@@ -405,6 +435,15 @@ func TestEnvControllerParameters(t *testing.T) {
 			},
 			expected: []string{"InternalALBWorkloads,"},
 		},
+		"Backend with Service Connect TLS": {
+			opts: WorkloadOpts{
+				WorkloadType: "Backend Service",
+				ServiceConnect: &ServiceConnect{
+					TLS: true,
+				},
+			},
+			expected: []string{"ServiceConnectTLSWorkloads,"},
+		},
 		"RDWS": {
 			opts: WorkloadOpts{
 				WorkloadType: "Request-Driven Web Service",
@@ -426,6 +465,19 @@ func TestEnvControllerParameters(t *testing.T) {
 			},
 			expected: []string{},
 		},
+		"Lambda": {
+			opts: WorkloadOpts{
+				WorkloadType: "Lambda Service",
+			},
+			expected: []string{},
+		},
+		"Lambda with ALB": {
+			opts: WorkloadOpts{
+				WorkloadType: "Lambda Service",
+				ALBEnabled:   true,
+			},
+			expected: []string{"ALBWorkloads,"},
+		},
 	}
 
 	for name, tc := range tests {
@@ -466,6 +518,17 @@ func TestRollingUpdateRollbackConfig_TruncateAlarmName(t *testing.T) {
 	}
 }
 
+func TestRollingUpdateRollbackConfig_GeneratedAlarmName(t *testing.T) {
+	cfg := RollingUpdateRollbackConfig{
+		GeneratedAlarms: []string{CPUUtilizationAlarmLogicalID, QueueDepthAlarmLogicalID},
+	}
+
+	require.True(t, cfg.HasRollbackAlarms())
+	require.False(t, cfg.HasCustomAlarms())
+	require.Equal(t, "phonetool-test-api-CopilotCPUAlarm", cfg.GeneratedAlarmName("phonetool", "test", "api", CPUUtilizationAlarmLogicalID))
+	require.Equal(t, "phonetool-test-worker-CopilotQueueDepthAlarm", cfg.GeneratedAlarmName("phonetool", "test", "worker", QueueDepthAlarmLogicalID))
+}
+
 func TestApplicationLoadBalancer_Aliases(t *testing.T) {
 	tests := map[string]struct {
 		opts     ALBListener
@@ -504,6 +567,37 @@ func TestApplicationLoadBalancer_Aliases(t *testing.T) {
 	}
 }
 
+func TestSubscribeOpts_KMSKeys(t *testing.T) {
+	tests := map[string]struct {
+		opts     SubscribeOpts
+		expected []string
+	}{
+		"unique keys of the default queue and the topic queues": {
+			opts: SubscribeOpts{
+				Queue: &SQSQueue{KMSKey: aws.String("arn:aws:kms:us-west-2:123456789012:key/1")},
+				Topics: []*TopicSubscription{
+					{Queue: &SQSQueue{KMSKey: aws.String("arn:aws:kms:us-west-2:123456789012:key/2")}},
+					{Queue: &SQSQueue{KMSKey: aws.String("arn:aws:kms:us-west-2:123456789012:key/1")}},
+					{Queue: &SQSQueue{}},
+					{},
+				},
+			},
+			expected: []string{"arn:aws:kms:us-west-2:123456789012:key/1", "arn:aws:kms:us-west-2:123456789012:key/2"},
+		},
+		"no customer managed keys": {
+			opts: SubscribeOpts{
+				Topics: []*TopicSubscription{{}},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.opts.KMSKeys())
+		})
+	}
+}
+
 func Test_trancateWithHashPadding(t *testing.T) {
 	tests := map[string]struct {
 		inString  string
@@ -531,3 +625,30 @@ func Test_trancateWithHashPadding(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretFilesOpts(t *testing.T) {
+	opts := &SecretFilesOpts{
+		Files: []SecretFile{
+			{Name: "KUBECONFIG", Path: "/root/.kube/config", Secret: SecretFromPlainSSMOrARN("/demo/kubeconfig")},
+			{Name: "TLS_CERT", Path: "/etc/ssl/private/cert.pem", Secret: SecretFromSecretsManager("demo/tls-cert")},
+			{Name: "TLS_KEY", Path: "/etc/ssl/private/key.pem", Secret: SecretFromSecretsManager("demo/tls-key")},
+			{Name: "TLS_KEY_FOR_NGINX", Container: "nginx", Path: "/etc/ssl/private/key.pem", Secret: SecretFromSecretsManager("demo/nginx-tls-key")},
+		},
+	}
+
+	require.Equal(t, []SecretFilesVolume{
+		{Name: "copilot-secret-files-0", Dir: "/root/.kube", InitDir: "/copilot/secrets/copilot-secret-files-0"},
+		{Name: "copilot-secret-files-1", Dir: "/etc/ssl/private", InitDir: "/copilot/secrets/copilot-secret-files-1"},
+		{Name: "copilot-secret-files-2", Container: "nginx", Dir: "/etc/ssl/private", InitDir: "/copilot/secrets/copilot-secret-files-2"},
+	}, opts.Volumes())
+	require.Equal(t, []SecretFilesVolume{
+		{Name: "copilot-secret-files-2", Container: "nginx", Dir: "/etc/ssl/private", InitDir: "/copilot/secrets/copilot-secret-files-2"},
+	}, opts.VolumesFor("nginx"))
+	require.True(t, opts.HasFilesFor(""))
+	require.False(t, opts.HasFilesFor("xray"))
+	require.False(t, (*SecretFilesOpts)(nil).HasFilesFor(""))
+	require.Equal(t, `printf '%s' "$KUBECONFIG" > /copilot/secrets/copilot-secret-files-0/config && `+
+		`printf '%s' "$TLS_CERT" > /copilot/secrets/copilot-secret-files-1/cert.pem && `+
+		`printf '%s' "$TLS_KEY" > /copilot/secrets/copilot-secret-files-1/key.pem && `+
+		`printf '%s' "$TLS_KEY_FOR_NGINX" > /copilot/secrets/copilot-secret-files-2/key.pem`, opts.Script())
+}
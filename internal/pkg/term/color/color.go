@@ -5,11 +5,14 @@
 package color
 
 import (
+	"fmt"
+	"io"
 	"os"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2/core"
 	"github.com/fatih/color"
+	"golang.org/x/term"
 )
 
 // Predefined colors.
@@ -36,40 +39,134 @@ var (
 	BoldFgYellow = color.New(color.FgYellow).Add(color.Bold)
 )
 
-const colorEnvVar = "COLOR"
+// Environment variables that control whether colors are enabled.
+// See https://no-color.org and https://bixense.com/clicolors for the conventions of NO_COLOR, CLICOLOR and CLICOLOR_FORCE.
+const (
+	colorEnvVar         = "COLOR"
+	noColorEnvVar       = "NO_COLOR"
+	cliColorEnvVar      = "CLICOLOR"
+	cliColorForceEnvVar = "CLICOLOR_FORCE"
+)
+
+// accessibleEnvVar turns on the accessibility mode if it's set to "true", like the --accessible flag.
+const accessibleEnvVar = "COPILOT_ACCESSIBLE"
+
+// Modes of the --color flag.
+const (
+	ModeAuto   = "auto"
+	ModeAlways = "always"
+	ModeNever  = "never"
+)
 
-var lookupEnv = os.LookupEnv
+var (
+	lookupEnv  = os.LookupEnv
+	isTerminal = term.IsTerminal
+
+	mode       = ModeAuto
+	accessible = false
+)
 
-// DisableColorBasedOnEnvVar determines whether the CLI will produce color
-// output based on the environment variable, COLOR.
+// SetMode sets whether colors are enabled regardless of the environment variables, such as from the --color flag.
+// The mode must be one of "auto", "always", or "never". In "auto" mode, the environment variables and the terminal decide.
+func SetMode(m string) error {
+	switch strings.ToLower(m) {
+	case ModeAuto, ModeAlways, ModeNever:
+		mode = strings.ToLower(m)
+		return nil
+	}
+	return fmt.Errorf("invalid color mode %q, must be one of %s, %s, or %s", m, ModeAuto, ModeAlways, ModeNever)
+}
+
+// SetAccessible turns the accessibility mode on or off regardless of the environment variable, such as from the --accessible flag.
+func SetAccessible(enabled bool) {
+	accessible = enabled
+}
+
+// Accessible returns true if the output should be friendly to screen readers: the progress of long operations
+// is written as sequential lines instead of being redrawn in-place, and changes are labeled with words
+// instead of being conveyed through colors alone.
+// The mode is on if it's set by SetAccessible or if the environment variable COPILOT_ACCESSIBLE is "true".
+func Accessible() bool {
+	if accessible {
+		return true
+	}
+	value, _ := lookupEnv(accessibleEnvVar)
+	return strings.ToLower(value) == "true"
+}
+
+// DisableColorBasedOnEnvVar determines whether the CLI will produce color output.
+// The first of the following rules that applies decides:
+//  1. The mode set by SetMode, unless it's "auto".
+//  2. The environment variable COLOR, if it's set to "true" or "false".
+//  3. Colors are disabled if NO_COLOR is set to a non-empty value.
+//  4. Colors are enabled if CLICOLOR_FORCE is set to a value other than "0".
+//  5. Colors are disabled if CLICOLOR is set to "0".
+//  6. Otherwise, follow the settings in the color library, since it's dynamically set based on the type of terminal
+//     and whether stdout is connected to a terminal or not.
 func DisableColorBasedOnEnvVar() {
-	value, exists := lookupEnv(colorEnvVar)
-	if !exists {
-		// if the COLOR environment variable is not set
-		// then follow the settings in the color library
-		// since it's dynamically set based on the type of terminal
-		// and whether stdout is connected to a terminal or not.
+	enabled, decided := enabledByConfig()
+	if !decided {
 		core.DisableColor = color.NoColor
 		return
 	}
+	core.DisableColor = !enabled
+	color.NoColor = !enabled
+}
+
+// EnabledFor returns true if colored text should be written to w.
+// The same rules as DisableColorBasedOnEnvVar apply, except that if none of the flag and the environment variables
+// decides, colors are enabled only if w is a terminal that supports colors.
+func EnabledFor(w io.Writer) bool {
+	if enabled, decided := enabledByConfig(); decided {
+		return enabled
+	}
+	if value, _ := lookupEnv("TERM"); value == "dumb" {
+		return false
+	}
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	return isTerminal(int(f.Fd()))
+}
 
-	if strings.ToLower(value) == "false" {
-		core.DisableColor = true
-		color.NoColor = true
-	} else if strings.ToLower(value) == "true" {
-		core.DisableColor = false
-		color.NoColor = false
+// enabledByConfig returns whether colors are enabled by the mode or the environment variables.
+// decided is false if none of them decides.
+func enabledByConfig() (enabled bool, decided bool) {
+	switch mode {
+	case ModeAlways:
+		return true, true
+	case ModeNever:
+		return false, true
+	}
+	if value, exists := lookupEnv(colorEnvVar); exists {
+		switch strings.ToLower(value) {
+		case "true":
+			return true, true
+		case "false":
+			return false, true
+		}
+	}
+	if value, _ := lookupEnv(noColorEnvVar); value != "" {
+		return false, true
+	}
+	if value, exists := lookupEnv(cliColorForceEnvVar); exists && value != "" && value != "0" {
+		return true, true
+	}
+	if value, _ := lookupEnv(cliColorEnvVar); value == "0" {
+		return false, true
 	}
+	return false, false
 }
 
 // Help colors the string to denote that it's auxiliary helpful information, and returns it.
 func Help(s string) string {
-	return Faint.Sprint(s)
+	return currentTheme.Help.Sprint(s)
 }
 
 // Emphasize colors the string to denote that it as important, and returns it.
 func Emphasize(s string) string {
-	return Bold.Sprint(s)
+	return currentTheme.Emphasis.Sprint(s)
 }
 
 // HighlightUserInput colors the string to denote it as an input from standard input, and returns it.
@@ -79,20 +176,20 @@ func HighlightUserInput(s string) string {
 
 // HighlightResource colors the string to denote it as a resource created by the CLI, and returns it.
 func HighlightResource(s string) string {
-	return HiBlue.Sprint(s)
+	return currentTheme.Resource.Sprint(s)
 }
 
 // HighlightCode wraps the string s with the ` character, colors it to denote it's code, and returns it.
 func HighlightCode(s string) string {
-	return HiCyan.Sprintf("`%s`", s)
+	return currentTheme.Code.Sprintf("`%s`", s)
 }
 
 // HighlightCodeBlock wraps the string s with ``` characters, colors it to denote it's a multi-line code block, and returns it.
 func HighlightCodeBlock(s string) string {
-	return HiCyan.Sprintf("```\n%s\n```", s)
+	return currentTheme.Code.Sprintf("```\n%s\n```", s)
 }
 
 // Prod colors the string to mark it is a prod environment.
 func Prod(s string) string {
-	return BoldFgYellow.Sprint(s)
+	return currentTheme.Prod.Sprint(s)
 }
@@ -4,11 +4,15 @@
 package color
 
 import (
+	"bytes"
+	"io"
+	"os"
 	"testing"
 
 	"github.com/AlecAivazis/survey/v2/core"
 	"github.com/fatih/color"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/term"
 )
 
 type envVar struct {
@@ -54,3 +58,155 @@ func TestColorEnvVarNotSet(t *testing.T) {
 
 	require.Equal(t, core.DisableColor, color.NoColor, "expected to be the same as color.NoColor")
 }
+
+func TestEnabledFor(t *testing.T) {
+	defer func() {
+		isTerminal = term.IsTerminal
+	}()
+	testCases := map[string]struct {
+		env        map[string]string
+		w          io.Writer
+		isTerminal bool
+		wanted     bool
+	}{
+		"COLOR is set to true for a non-terminal writer": {
+			env:    map[string]string{colorEnvVar: "true"},
+			w:      &bytes.Buffer{},
+			wanted: true,
+		},
+		"COLOR is set to false for a terminal": {
+			env:        map[string]string{colorEnvVar: "FALSE"},
+			w:          os.Stdout,
+			isTerminal: true,
+		},
+		"dumb terminal": {
+			env:        map[string]string{"TERM": "dumb"},
+			w:          os.Stdout,
+			isTerminal: true,
+		},
+		"terminal": {
+			env:        map[string]string{},
+			w:          os.Stdout,
+			isTerminal: true,
+			wanted:     true,
+		},
+		"file that is not a terminal": {
+			env: map[string]string{},
+			w:   os.Stdout,
+		},
+		"writer that is not a file": {
+			env: map[string]string{},
+			w:   &bytes.Buffer{},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			lookupEnv = (&envVar{env: tc.env}).lookupEnv
+			isTerminal = func(int) bool {
+				return tc.isTerminal
+			}
+
+			require.Equal(t, tc.wanted, EnabledFor(tc.w))
+		})
+	}
+}
+
+func TestDisableColorBasedOnEnvVar_Precedence(t *testing.T) {
+	defer func() {
+		mode = ModeAuto
+	}()
+	testCases := map[string]struct {
+		mode string
+		env  map[string]string
+
+		wantedDisabled bool
+	}{
+		"--color=always overrides COLOR": {
+			mode: ModeAlways,
+			env:  map[string]string{colorEnvVar: "false", noColorEnvVar: "1"},
+		},
+		"--color=never overrides CLICOLOR_FORCE": {
+			mode:           ModeNever,
+			env:            map[string]string{cliColorForceEnvVar: "1"},
+			wantedDisabled: true,
+		},
+		"COLOR overrides NO_COLOR": {
+			mode: ModeAuto,
+			env:  map[string]string{colorEnvVar: "true", noColorEnvVar: "1"},
+		},
+		"NO_COLOR overrides CLICOLOR_FORCE": {
+			mode:           ModeAuto,
+			env:            map[string]string{noColorEnvVar: "1", cliColorForceEnvVar: "1"},
+			wantedDisabled: true,
+		},
+		"empty NO_COLOR is ignored": {
+			mode: ModeAuto,
+			env:  map[string]string{noColorEnvVar: "", cliColorForceEnvVar: "1"},
+		},
+		"CLICOLOR_FORCE overrides CLICOLOR": {
+			mode: ModeAuto,
+			env:  map[string]string{cliColorForceEnvVar: "1", cliColorEnvVar: "0"},
+		},
+		"CLICOLOR_FORCE set to 0 is ignored": {
+			mode:           ModeAuto,
+			env:            map[string]string{cliColorForceEnvVar: "0", cliColorEnvVar: "0"},
+			wantedDisabled: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, SetMode(tc.mode))
+			lookupEnv = (&envVar{env: tc.env}).lookupEnv
+
+			DisableColorBasedOnEnvVar()
+
+			require.Equal(t, tc.wantedDisabled, core.DisableColor)
+			require.Equal(t, tc.wantedDisabled, color.NoColor)
+			require.Equal(t, !tc.wantedDisabled, EnabledFor(&bytes.Buffer{}))
+		})
+	}
+}
+
+func TestSetMode(t *testing.T) {
+	defer func() {
+		mode = ModeAuto
+	}()
+	require.NoError(t, SetMode("ALWAYS"))
+	require.Equal(t, ModeAlways, mode)
+	require.EqualError(t, SetMode("sometimes"), `invalid color mode "sometimes", must be one of auto, always, or never`)
+	require.Equal(t, ModeAlways, mode)
+}
+
+func TestAccessible(t *testing.T) {
+	testCases := map[string]struct {
+		flag bool
+		env  map[string]string
+
+		wanted bool
+	}{
+		"off by default": {},
+		"on if set by the flag": {
+			flag:   true,
+			wanted: true,
+		},
+		"on if COPILOT_ACCESSIBLE is true": {
+			env:    map[string]string{"COPILOT_ACCESSIBLE": "TRUE"},
+			wanted: true,
+		},
+		"off if COPILOT_ACCESSIBLE is not true": {
+			env: map[string]string{"COPILOT_ACCESSIBLE": "1"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				lookupEnv = os.LookupEnv
+				accessible = false
+			}()
+			lookupEnv = (&envVar{env: tc.env}).lookupEnv
+			SetAccessible(tc.flag)
+
+			require.Equal(t, tc.wanted, Accessible())
+		})
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package color
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+const themeEnvVar = "COPILOT_THEME"
+
+// Names of the preset themes.
+const (
+	ThemeDark         = "dark"
+	ThemeLight        = "light"
+	ThemeHighContrast = "high-contrast"
+)
+
+// Theme assigns a color to each role of the text displayed by the CLI.
+type Theme struct {
+	Success  *color.Color // Successful operations.
+	Warning  *color.Color // Notes and warnings.
+	Error    *color.Color // Failures.
+	Resource *color.Color // Resources created by the CLI.
+	Code     *color.Color // Commands and code snippets.
+	Help     *color.Color // Auxiliary helpful information.
+	Emphasis *color.Color // Important text and user input.
+	Prod     *color.Color // Production environments.
+}
+
+type colorDepth int
+
+const (
+	depthBasic     colorDepth = iota // The 16 ANSI colors, which follow the palette of the terminal.
+	depth256                         // The 256 xterm colors.
+	depthTrueColor                   // 24-bit RGB colors.
+)
+
+type rgb struct {
+	r, g, b int
+}
+
+// style defines the color of a role in every color depth.
+type style struct {
+	basic []color.Attribute // Used if the terminal only supports the basic colors, or if rgb is not defined.
+	rgb   *rgb              // Used if the terminal supports 256 colors or truecolor.
+	attrs []color.Attribute // Added in every color depth, such as color.Bold.
+}
+
+type themeStyles struct {
+	success, warning, err, resource, code, help, emphasis, prod style
+}
+
+// presetThemes are the styles of the preset themes.
+// The dark theme doesn't define RGB colors so that it follows the palette of the terminal.
+var presetThemes = map[string]themeStyles{
+	ThemeDark: {
+		success:  style{basic: []color.Attribute{color.FgHiGreen}},
+		warning:  style{basic: []color.Attribute{color.FgYellow}},
+		err:      style{basic: []color.Attribute{color.FgHiRed}},
+		resource: style{basic: []color.Attribute{color.FgHiBlue}},
+		code:     style{basic: []color.Attribute{color.FgHiCyan}},
+		help:     style{basic: []color.Attribute{color.Faint}},
+		emphasis: style{basic: []color.Attribute{color.Bold}},
+		prod:     style{basic: []color.Attribute{color.FgYellow, color.Bold}},
+	},
+	ThemeLight: {
+		success:  style{basic: []color.Attribute{color.FgGreen}, rgb: &rgb{0, 135, 0}},
+		warning:  style{basic: []color.Attribute{color.FgMagenta}, rgb: &rgb{175, 95, 0}},
+		err:      style{basic: []color.Attribute{color.FgRed}, rgb: &rgb{175, 0, 0}},
+		resource: style{basic: []color.Attribute{color.FgBlue}, rgb: &rgb{0, 0, 175}},
+		code:     style{basic: []color.Attribute{color.FgCyan}, rgb: &rgb{0, 95, 135}},
+		help:     style{basic: []color.Attribute{color.FgBlack}, rgb: &rgb{88, 88, 88}},
+		emphasis: style{basic: []color.Attribute{color.Bold}},
+		prod:     style{basic: []color.Attribute{color.FgMagenta}, rgb: &rgb{175, 95, 0}, attrs: []color.Attribute{color.Bold}},
+	},
+	ThemeHighContrast: {
+		success:  style{basic: []color.Attribute{color.FgHiGreen}, rgb: &rgb{0, 255, 0}, attrs: []color.Attribute{color.Bold}},
+		warning:  style{basic: []color.Attribute{color.FgHiYellow}, rgb: &rgb{255, 255, 0}, attrs: []color.Attribute{color.Bold}},
+		err:      style{basic: []color.Attribute{color.FgHiRed}, rgb: &rgb{255, 0, 0}, attrs: []color.Attribute{color.Bold}},
+		resource: style{basic: []color.Attribute{color.FgHiCyan}, rgb: &rgb{0, 255, 255}, attrs: []color.Attribute{color.Bold}},
+		code:     style{basic: []color.Attribute{color.FgHiMagenta}, rgb: &rgb{255, 0, 255}, attrs: []color.Attribute{color.Bold}},
+		help:     style{basic: []color.Attribute{color.FgHiWhite}, rgb: &rgb{255, 255, 255}},
+		emphasis: style{basic: []color.Attribute{color.Bold, color.Underline}},
+		prod:     style{basic: []color.Attribute{color.FgHiYellow}, rgb: &rgb{255, 255, 0}, attrs: []color.Attribute{color.Bold, color.Underline}},
+	},
+}
+
+var currentTheme = mustTheme(ThemeDark, depthBasic)
+
+// CurrentTheme returns the theme that the CLI displays text with.
+func CurrentTheme() Theme {
+	return currentTheme
+}
+
+// SetTheme changes the theme to the preset with the name. The colors are chosen according to the color depth that
+// the terminal supports: 24-bit colors if the COLORTERM environment variable is "truecolor" or "24bit", 256 colors if
+// the TERM environment variable contains "256color", and the basic ANSI colors otherwise.
+func SetTheme(name string) error {
+	theme, err := newTheme(name, detectColorDepth())
+	if err != nil {
+		return err
+	}
+	currentTheme = theme
+	return nil
+}
+
+// SetThemeBasedOnEnvVar changes the theme to the preset named by the environment variable, COPILOT_THEME.
+// The theme is left unchanged if the environment variable is not set.
+func SetThemeBasedOnEnvVar() error {
+	name, exists := lookupEnv(themeEnvVar)
+	if !exists || name == "" {
+		return nil
+	}
+	if err := SetTheme(name); err != nil {
+		return fmt.Errorf("environment variable %s: %w", themeEnvVar, err)
+	}
+	return nil
+}
+
+// ThemeNames returns the sorted names of the preset themes.
+func ThemeNames() []string {
+	var names []string
+	for name := range presetThemes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func newTheme(name string, depth colorDepth) (Theme, error) {
+	styles, ok := presetThemes[strings.ToLower(name)]
+	if !ok {
+		return Theme{}, fmt.Errorf("theme %q does not exist, must be one of %s", name, strings.Join(ThemeNames(), ", "))
+	}
+	return Theme{
+		Success:  styles.success.color(depth),
+		Warning:  styles.warning.color(depth),
+		Error:    styles.err.color(depth),
+		Resource: styles.resource.color(depth),
+		Code:     styles.code.color(depth),
+		Help:     styles.help.color(depth),
+		Emphasis: styles.emphasis.color(depth),
+		Prod:     styles.prod.color(depth),
+	}, nil
+}
+
+func mustTheme(name string, depth colorDepth) Theme {
+	theme, err := newTheme(name, depth)
+	if err != nil {
+		panic(err)
+	}
+	return theme
+}
+
+func detectColorDepth() colorDepth {
+	if value, _ := lookupEnv("COLORTERM"); strings.EqualFold(value, "truecolor") || strings.EqualFold(value, "24bit") {
+		return depthTrueColor
+	}
+	if value, _ := lookupEnv("TERM"); strings.Contains(value, "256color") {
+		return depth256
+	}
+	return depthBasic
+}
+
+func (s style) color(depth colorDepth) *color.Color {
+	c := color.New()
+	switch {
+	case s.rgb != nil && depth == depthTrueColor:
+		c.Add(38, 2, color.Attribute(s.rgb.r), color.Attribute(s.rgb.g), color.Attribute(s.rgb.b))
+	case s.rgb != nil && depth == depth256:
+		c.Add(38, 5, color.Attribute(s.rgb.xterm256()))
+	default:
+		c.Add(s.basic...)
+	}
+	return c.Add(s.attrs...)
+}
+
+// xterm256 returns the closest color in the 6x6x6 cube of the 256 xterm colors.
+func (c rgb) xterm256() int {
+	levels := []int{0, 95, 135, 175, 215, 255} // The intensities of each component in the cube.
+	closest := func(v int) int {
+		idx := 0
+		for i, level := range levels {
+			if abs(v-level) < abs(v-levels[idx]) {
+				idx = i
+			}
+		}
+		return idx
+	}
+	return 16 + 36*closest(c.r) + 6*closest(c.g) + closest(c.b)
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
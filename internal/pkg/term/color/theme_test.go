@@ -0,0 +1,77 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package color
+
+import (
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetThemeBasedOnEnvVar(t *testing.T) {
+	defer func() {
+		currentTheme = mustTheme(ThemeDark, depthBasic)
+	}()
+	testCases := map[string]struct {
+		env map[string]string
+
+		wantedSuccess *color.Color
+		wantedCode    *color.Color
+		wantedErr     string
+	}{
+		"keep the dark theme if COPILOT_THEME is not set": {
+			env:           map[string]string{},
+			wantedSuccess: color.New(color.FgHiGreen),
+			wantedCode:    color.New(color.FgHiCyan),
+		},
+		"light theme with basic colors": {
+			env:           map[string]string{themeEnvVar: "light"},
+			wantedSuccess: color.New(color.FgGreen),
+			wantedCode:    color.New(color.FgCyan),
+		},
+		"light theme with 256 colors": {
+			env:           map[string]string{themeEnvVar: "Light", "TERM": "xterm-256color"},
+			wantedSuccess: color.New(38, 5, 28),
+			wantedCode:    color.New(38, 5, 24),
+		},
+		"high-contrast theme with truecolor": {
+			env:           map[string]string{themeEnvVar: "high-contrast", "COLORTERM": "truecolor"},
+			wantedSuccess: color.New(38, 2, 0, 255, 0, color.Bold),
+			wantedCode:    color.New(38, 2, 255, 0, 255, color.Bold),
+		},
+		"dark theme follows the palette of the terminal even with truecolor": {
+			env:           map[string]string{themeEnvVar: "dark", "COLORTERM": "24bit"},
+			wantedSuccess: color.New(color.FgHiGreen),
+			wantedCode:    color.New(color.FgHiCyan),
+		},
+		"error if the theme does not exist": {
+			env:       map[string]string{themeEnvVar: "solarized"},
+			wantedErr: `environment variable COPILOT_THEME: theme "solarized" does not exist, must be one of dark, high-contrast, light`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			currentTheme = mustTheme(ThemeDark, depthBasic)
+			lookupEnv = (&envVar{env: tc.env}).lookupEnv
+
+			err := SetThemeBasedOnEnvVar()
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, tc.wantedSuccess.Equals(CurrentTheme().Success))
+			require.True(t, tc.wantedCode.Equals(CurrentTheme().Code))
+		})
+	}
+}
+
+func TestRGB_xterm256(t *testing.T) {
+	require.Equal(t, 16, rgb{0, 0, 0}.xterm256())
+	require.Equal(t, 231, rgb{255, 255, 255}.xterm256())
+	require.Equal(t, 196, rgb{255, 0, 0}.xterm256())
+	require.Equal(t, 130, rgb{175, 95, 0}.xterm256())
+}
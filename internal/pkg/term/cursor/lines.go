@@ -0,0 +1,63 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cursor
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"golang.org/x/term"
+)
+
+const tabWidth = 8
+
+var escapeSequence = regexp.MustCompile(`\x1b\[[0-9;?]*[a-zA-Z]`)
+
+// TerminalWidth returns the number of columns of the terminal that fw writes to, or 0 if fw is not a terminal.
+func TerminalWidth(fw terminal.FileWriter) int {
+	if !term.IsTerminal(int(fw.Fd())) {
+		return 0
+	}
+	width, _, err := term.GetSize(int(fw.Fd()))
+	if err != nil {
+		return 0
+	}
+	return width
+}
+
+// RenderedLines returns the number of lines that the cursor moves down by when content is written to a terminal
+// that is width columns wide, including the lines that wrap because they're wider than the terminal.
+// The lines don't wrap if width is not positive.
+func RenderedLines(content string, width int) int {
+	lines := strings.Split(content, "\n")
+	n := len(lines) - 1
+	if width <= 0 {
+		return n
+	}
+	for _, line := range lines {
+		if cols := columns(line); cols > width {
+			n += (cols - 1) / width
+		}
+	}
+	return n
+}
+
+// columns returns the number of columns that the line takes on a terminal.
+func columns(line string) int {
+	line = escapeSequence.ReplaceAllString(line, "")
+	var cols int
+	for _, r := range line {
+		if r == '\t' {
+			cols += tabWidth - cols%tabWidth
+			continue
+		}
+		if r == utf8.RuneError || r < ' ' {
+			continue
+		}
+		cols++
+	}
+	return cols
+}
@@ -0,0 +1,59 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cursor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderedLines(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+		inWidth   int
+
+		wanted int
+	}{
+		"count the new lines if the terminal width is unknown": {
+			inContent: "Task Summary\n\n  Running  ██████████  1/1 desired tasks are running\n",
+			wanted:    3,
+		},
+		"lines as wide as the terminal don't wrap": {
+			inContent: "0123456789\n0123456789\n",
+			inWidth:   10,
+			wanted:    2,
+		},
+		"count the lines wider than the terminal once per row": {
+			inContent: "0123456789abcdefghij0\nshort\n",
+			inWidth:   10,
+			wanted:    4,
+		},
+		"count the wrapped rows of the last line without a new line": {
+			inContent: "Refreshed\n0123456789abc",
+			inWidth:   10,
+			wanted:    2,
+		},
+		"ignore color escape sequences": {
+			inContent: "\x1b[1mTask Summary\x1b[0m\n",
+			inWidth:   12,
+			wanted:    1,
+		},
+		"expand tabs": {
+			inContent: "\tabcd\n",
+			inWidth:   10,
+			wanted:    2,
+		},
+		"count multi-byte characters as one column": {
+			inContent: "██████████\n",
+			inWidth:   10,
+			wanted:    1,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, RenderedLines(tc.inContent, tc.inWidth))
+		})
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package i18n translates the messages that the CLI displays to the language selected with COPILOT_LANG.
+//
+// The catalogs of translations are keyed by the English messages, so a message without a translation is displayed in
+// English. A key can contain fmt verbs: a message formatted from it is translated by substituting the formatted
+// arguments into the translation, which keeps the colors and emphasis of the arguments. Translations can reorder the
+// arguments with explicit indexes such as "%[2]s".
+package i18n
+
+import (
+	"embed"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+const langEnvVar = "COPILOT_LANG"
+
+// Languages of the CLI.
+const (
+	LangEnglish  = "en"
+	LangJapanese = "ja"
+	LangSpanish  = "es"
+)
+
+//go:embed catalogs
+var catalogFS embed.FS
+
+var (
+	lookupEnv = os.LookupEnv
+
+	verbRegexp = regexp.MustCompile(`%(\[\d+\])?[-+# 0]*\d*(\.\d+)?[a-zA-Z%]`)
+)
+
+// current is the catalog of the selected language, nil if the messages are displayed in English.
+var current *catalog
+
+type catalog struct {
+	messages map[string]string // Translations keyed by the English message.
+	patterns []pattern         // Translations of the messages with fmt verbs.
+}
+
+type pattern struct {
+	re          *regexp.Regexp // Matches the messages formatted from the key, and captures their arguments.
+	translation string
+}
+
+// SetLanguage changes the language of the messages. The name can be a locale such as "ja_JP.UTF-8", in which case
+// only its language is considered.
+func SetLanguage(name string) error {
+	lang := strings.ToLower(name)
+	if i := strings.IndexAny(lang, "_-.@"); i != -1 {
+		lang = lang[:i]
+	}
+	if lang == LangEnglish {
+		current = nil
+		return nil
+	}
+	cat, err := loadCatalog(lang)
+	if err != nil {
+		return fmt.Errorf("language %q is not supported, must be one of %s", name, strings.Join(Languages(), ", "))
+	}
+	current = cat
+	return nil
+}
+
+// SetLanguageBasedOnEnvVar changes the language of the messages to the one of the environment variable, COPILOT_LANG.
+// The messages are left in English if the environment variable is not set.
+func SetLanguageBasedOnEnvVar() error {
+	name, exists := lookupEnv(langEnvVar)
+	if !exists || name == "" {
+		return nil
+	}
+	if err := SetLanguage(name); err != nil {
+		return fmt.Errorf("environment variable %s: %w", langEnvVar, err)
+	}
+	return nil
+}
+
+// Languages returns the sorted names of the languages of the CLI.
+func Languages() []string {
+	langs := []string{LangEnglish}
+	entries, _ := catalogFS.ReadDir("catalogs")
+	for _, entry := range entries {
+		langs = append(langs, strings.TrimSuffix(entry.Name(), ".yml"))
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// T returns the translation of a message, which may have been formatted from a key of the catalog.
+// The leading and trailing white spaces of the message are kept.
+func T(msg string) string {
+	if current == nil {
+		return msg
+	}
+	return withSpaces(msg, current.translate)
+}
+
+// Format returns the translation of a format specifier, to be formatted by the caller.
+func Format(format string) string {
+	if current == nil {
+		return format
+	}
+	return withSpaces(format, func(key string) string {
+		if translation, ok := current.messages[key]; ok {
+			return translation
+		}
+		return key
+	})
+}
+
+// Sprintf formats the translation of the format specifier.
+func Sprintf(format string, a ...interface{}) string {
+	return fmt.Sprintf(Format(format), a...)
+}
+
+// Error returns the message of an error, with each of the messages of the wrapped errors translated.
+func Error(err error) string {
+	msg := err.Error()
+	if current == nil {
+		return msg
+	}
+	if translated := T(msg); translated != msg {
+		return translated
+	}
+	parts := strings.Split(msg, ": ")
+	for i, part := range parts {
+		parts[i] = T(part)
+	}
+	return strings.Join(parts, ": ")
+}
+
+func (c *catalog) translate(msg string) string {
+	if translation, ok := c.messages[msg]; ok {
+		return translation
+	}
+	for _, p := range c.patterns {
+		args := p.re.FindStringSubmatch(msg)
+		if args == nil {
+			continue
+		}
+		return substitute(p.translation, args[1:])
+	}
+	return msg
+}
+
+func loadCatalog(lang string) (*catalog, error) {
+	raw, err := catalogFS.ReadFile(fmt.Sprintf("catalogs/%s.yml", lang))
+	if err != nil {
+		return nil, err
+	}
+	var messages map[string]string
+	if err := yaml.Unmarshal(raw, &messages); err != nil {
+		return nil, fmt.Errorf("unmarshal catalog %s: %w", lang, err)
+	}
+	cat := &catalog{
+		messages: make(map[string]string),
+	}
+	for key, translation := range messages {
+		key, translation = strings.TrimSpace(key), strings.TrimSpace(translation)
+		cat.messages[key] = translation
+		if re := keyRegexp(key); re != nil {
+			cat.patterns = append(cat.patterns, pattern{re: re, translation: translation})
+		}
+	}
+	// Try the most specific keys first, so that a key made of a prefix of another one doesn't shadow it.
+	sort.SliceStable(cat.patterns, func(i, j int) bool {
+		return len(cat.patterns[i].re.String()) > len(cat.patterns[j].re.String())
+	})
+	return cat, nil
+}
+
+// keyRegexp returns the regular expression that matches the messages formatted from the key,
+// or nil if the key has no fmt verb.
+func keyRegexp(key string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString(`(?s)^`)
+	last, hasArgs := 0, false
+	for _, loc := range verbRegexp.FindAllStringIndex(key, -1) {
+		b.WriteString(regexp.QuoteMeta(key[last:loc[0]]))
+		if verb := key[loc[0]:loc[1]]; verb == "%%" {
+			b.WriteString("%")
+		} else {
+			b.WriteString(`(.*?)`)
+			hasArgs = true
+		}
+		last = loc[1]
+	}
+	if !hasArgs {
+		return nil
+	}
+	b.WriteString(regexp.QuoteMeta(key[last:]))
+	b.WriteString(`$`)
+	return regexp.MustCompile(b.String())
+}
+
+// substitute replaces the fmt verbs of the translation with the formatted arguments, following the rules of fmt
+// for explicit argument indexes.
+func substitute(translation string, args []string) string {
+	next := 0
+	return verbRegexp.ReplaceAllStringFunc(translation, func(verb string) string {
+		if verb == "%%" {
+			return "%"
+		}
+		idx := next
+		if strings.HasPrefix(verb, "%[") {
+			n, _ := strconv.Atoi(verb[2:strings.Index(verb, "]")])
+			idx = n - 1
+		}
+		next = idx + 1
+		if idx < 0 || idx >= len(args) {
+			return verb
+		}
+		return args[idx]
+	})
+}
+
+// withSpaces translates the message without its leading and trailing white spaces, and adds them back.
+func withSpaces(msg string, translate func(string) string) string {
+	start := len(msg) - len(strings.TrimLeftFunc(msg, unicode.IsSpace))
+	end := len(strings.TrimRightFunc(msg, unicode.IsSpace))
+	if start >= end {
+		return msg
+	}
+	return msg[:start] + translate(msg[start:end]) + msg[end:]
+}
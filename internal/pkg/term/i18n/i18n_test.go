@@ -0,0 +1,161 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestSetLanguage(t *testing.T) {
+	testCases := map[string]struct {
+		inName string
+
+		wantedEnglish bool
+		wantedError   error
+	}{
+		"english": {
+			inName:        "en",
+			wantedEnglish: true,
+		},
+		"language of a locale": {
+			inName: "ja_JP.UTF-8",
+		},
+		"language of a tag": {
+			inName: "es-MX",
+		},
+		"unsupported language": {
+			inName:      "fr_FR",
+			wantedError: errors.New(`language "fr_FR" is not supported, must be one of en, es, ja`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defer func() { current = nil }()
+
+			err := SetLanguage(tc.inName)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEnglish, current == nil)
+		})
+	}
+}
+
+func TestSetLanguageBasedOnEnvVar(t *testing.T) {
+	defaultLookupEnv := lookupEnv
+	defer func() {
+		current = nil
+		lookupEnv = defaultLookupEnv
+	}()
+
+	lookupEnv = func(key string) (string, bool) {
+		require.Equal(t, "COPILOT_LANG", key)
+		return "de", true
+	}
+	require.EqualError(t, SetLanguageBasedOnEnvVar(), `environment variable COPILOT_LANG: language "de" is not supported, must be one of en, es, ja`)
+
+	lookupEnv = func(string) (string, bool) { return "ja", true }
+	require.NoError(t, SetLanguageBasedOnEnvVar())
+	require.Equal(t, "注意:", T("Note:"))
+}
+
+func TestT(t *testing.T) {
+	require.NoError(t, SetLanguage(LangJapanese))
+	defer func() { current = nil }()
+	highlight := color.New(color.FgCyan)
+	highlight.EnableColor()
+
+	testCases := map[string]struct {
+		in     string
+		wanted string
+	}{
+		"message without a translation": {
+			in:     "Which environment would you like to deploy to?",
+			wanted: "Which environment would you like to deploy to?",
+		},
+		"message with a translation keeps its white spaces": {
+			in:     "\nContinue with the deployment?\n",
+			wanted: "\nデプロイを続行しますか?\n",
+		},
+		"formatted message keeps the colors of its arguments": {
+			in:     fmt.Sprintf("Uploaded the environment files and addons of %s to S3.", highlight.Sprint("api")),
+			wanted: fmt.Sprintf("%s の環境ファイルとアドオンを S3 にアップロードしました。", highlight.Sprint("api")),
+		},
+		"formatted message with reordered arguments": {
+			in:     fmt.Sprintf("Are you sure you want to delete environment %q from application %q?", "test", "shop"),
+			wanted: `アプリケーション "shop" から環境 "test" を削除してもよろしいですか?`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, T(tc.in))
+		})
+	}
+}
+
+func TestSprintf(t *testing.T) {
+	require.Equal(t, "Forcing an update for service api from environment test",
+		Sprintf("Forcing an update for service %s from environment %s", "api", "test"))
+
+	require.NoError(t, SetLanguage(LangSpanish))
+	defer func() { current = nil }()
+	require.Equal(t, "Forzando una actualización del servicio api en el entorno test\n",
+		Sprintf("Forcing an update for service %s from environment %s\n", "api", "test"))
+}
+
+func TestError(t *testing.T) {
+	err := fmt.Errorf("select VPC: %w", errors.New("no existing VPCs found"))
+	require.Equal(t, "select VPC: no existing VPCs found", Error(err))
+
+	require.NoError(t, SetLanguage(LangSpanish))
+	defer func() { current = nil }()
+	require.Equal(t, "select VPC: no se encontró ninguna VPC existente", Error(err))
+}
+
+func TestCatalogs(t *testing.T) {
+	entries, err := catalogFS.ReadDir("catalogs")
+	require.NoError(t, err)
+	var keys []string
+	for i, entry := range entries {
+		raw, err := catalogFS.ReadFile("catalogs/" + entry.Name())
+		require.NoError(t, err)
+		var messages map[string]string
+		require.NoError(t, yaml.Unmarshal(raw, &messages))
+
+		var catalogKeys []string
+		for key, translation := range messages {
+			require.Equal(t, verbs(key), verbs(translation), "translation of %q in %s must contain the same fmt verbs", key, entry.Name())
+			catalogKeys = append(catalogKeys, key)
+		}
+		sort.Strings(catalogKeys)
+		if i == 0 {
+			keys = catalogKeys
+			continue
+		}
+		require.Equal(t, keys, catalogKeys, "catalog %s must translate the same messages as %s", entry.Name(), entries[0].Name())
+	}
+}
+
+var argIndexRegexp = regexp.MustCompile(`\[\d+\]`)
+
+// verbs returns the sorted fmt verbs of the format, without their explicit argument indexes.
+func verbs(format string) []string {
+	var out []string
+	for _, verb := range verbRegexp.FindAllString(format, -1) {
+		out = append(out, argIndexRegexp.ReplaceAllString(verb, ""))
+	}
+	sort.Strings(out)
+	return out
+}
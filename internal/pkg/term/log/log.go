@@ -8,21 +8,29 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/fatih/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/i18n"
+	fcolor "github.com/fatih/color"
 )
 
 // Decorated io.Writers around standard error and standard output that work on Windows.
 var (
-	DiagnosticWriter = color.Error
-	OutputWriter     = color.Output
+	DiagnosticWriter = fcolor.Error
+	OutputWriter     = fcolor.Output
 )
 
-// Colored string formatting functions.
+// Colored string formatting functions, with the colors of the current theme.
 var (
-	successSprintf = color.HiGreenString
-	errorSprintf   = color.HiRedString
-	warningSprintf = color.YellowString
-	debugSprintf   = color.New(color.Faint).Sprintf
+	successSprintf = func(format string, a ...interface{}) string {
+		return color.CurrentTheme().Success.Sprintf(format, a...)
+	}
+	errorSprintf = func(format string, a ...interface{}) string {
+		return color.CurrentTheme().Error.Sprintf(format, a...)
+	}
+	warningSprintf = func(format string, a ...interface{}) string {
+		return color.CurrentTheme().Warning.Sprintf(format, a...)
+	}
+	debugSprintf = fcolor.New(fcolor.Faint).Sprintf
 )
 
 // Log message prefixes.
@@ -47,12 +55,12 @@ func Successf(format string, args ...interface{}) {
 
 // Ssuccess prefixes the message with a green "✔ Success!", and returns it.
 func Ssuccess(args ...interface{}) string {
-	return fmt.Sprintf("%s %s", successSprintf(successPrefix), fmt.Sprint(args...))
+	return fmt.Sprintf("%s %s", successSprintf(successPrefix), i18n.T(fmt.Sprint(args...)))
 }
 
 // Ssuccessln prefixes the message with a green "✔ Success!", appends a new line, and returns it.
 func Ssuccessln(args ...interface{}) string {
-	msg := fmt.Sprintf("%s %s", successSprintf(successPrefix), fmt.Sprint(args...))
+	msg := fmt.Sprintf("%s %s", successSprintf(successPrefix), i18n.T(fmt.Sprint(args...)))
 	return fmt.Sprintln(msg)
 }
 
@@ -79,12 +87,12 @@ func Errorf(format string, args ...interface{}) {
 
 // Serror prefixes the message with a red "✘ Error!", and returns it.
 func Serror(args ...interface{}) string {
-	return fmt.Sprintf("%s %s", errorSprintf(errorPrefix), fmt.Sprint(args...))
+	return fmt.Sprintf("%s %s", errorSprintf(errorPrefix), i18n.T(fmt.Sprint(args...)))
 }
 
 // Serrorln prefixes the message with a red "✘ Error!", appends a new line, and returns it.
 func Serrorln(args ...interface{}) string {
-	msg := fmt.Sprintf("%s %s", errorSprintf(errorPrefix), fmt.Sprint(args...))
+	msg := fmt.Sprintf("%s %s", errorSprintf(errorPrefix), i18n.T(fmt.Sprint(args...)))
 	return fmt.Sprintln(msg)
 }
 
@@ -109,6 +117,12 @@ func Warningf(format string, args ...interface{}) {
 	warningf(DiagnosticWriter, format, args...)
 }
 
+// Swarningf formats according to the specifier, prefixes the message with a "Note:", colors the *entire* message in yellow, and returns it.
+func Swarningf(format string, args ...interface{}) string {
+	wrappedFormat := fmt.Sprintf("%s %s", i18n.T(warningPrefix), i18n.Format(format))
+	return warningSprintf(wrappedFormat, args...)
+}
+
 // Info writes the message to standard error with the default color.
 func Info(args ...interface{}) {
 	info(DiagnosticWriter, args...)
@@ -140,12 +154,12 @@ func Debugf(format string, args ...interface{}) {
 }
 
 func success(w io.Writer, args ...interface{}) {
-	msg := fmt.Sprintf("%s %s", successSprintf(successPrefix), fmt.Sprint(args...))
+	msg := fmt.Sprintf("%s %s", successSprintf(successPrefix), i18n.T(fmt.Sprint(args...)))
 	fmt.Fprint(w, msg)
 }
 
 func successln(w io.Writer, args ...interface{}) {
-	msg := fmt.Sprintf("%s %s", successSprintf(successPrefix), fmt.Sprint(args...))
+	msg := fmt.Sprintf("%s %s", successSprintf(successPrefix), i18n.T(fmt.Sprint(args...)))
 	fmt.Fprintln(w, msg)
 }
 
@@ -155,12 +169,12 @@ func successf(w io.Writer, format string, args ...interface{}) {
 }
 
 func err(w io.Writer, args ...interface{}) {
-	msg := fmt.Sprintf("%s %s", errorSprintf(errorPrefix), fmt.Sprint(args...))
+	msg := fmt.Sprintf("%s %s", errorSprintf(errorPrefix), i18n.T(fmt.Sprint(args...)))
 	fmt.Fprint(w, msg)
 }
 
 func errln(w io.Writer, args ...interface{}) {
-	msg := fmt.Sprintf("%s %s", errorSprintf(errorPrefix), fmt.Sprint(args...))
+	msg := fmt.Sprintf("%s %s", errorSprintf(errorPrefix), i18n.T(fmt.Sprint(args...)))
 	fmt.Fprintln(w, msg)
 }
 
@@ -170,40 +184,40 @@ func errf(w io.Writer, format string, args ...interface{}) {
 }
 
 func warning(w io.Writer, args ...interface{}) {
-	msg := fmt.Sprint(args...)
-	fmt.Fprint(w, warningSprintf(fmt.Sprintf("%s %s", warningPrefix, msg)))
+	msg := i18n.T(fmt.Sprint(args...))
+	fmt.Fprint(w, warningSprintf(fmt.Sprintf("%s %s", i18n.T(warningPrefix), msg)))
 }
 
 func warningln(w io.Writer, args ...interface{}) {
-	msg := fmt.Sprint(args...)
-	fmt.Fprintln(w, warningSprintf(fmt.Sprintf("%s %s", warningPrefix, msg)))
+	msg := i18n.T(fmt.Sprint(args...))
+	fmt.Fprintln(w, warningSprintf(fmt.Sprintf("%s %s", i18n.T(warningPrefix), msg)))
 }
 
 func warningf(w io.Writer, format string, args ...interface{}) {
-	wrappedFormat := fmt.Sprintf("%s %s", warningPrefix, format)
+	wrappedFormat := fmt.Sprintf("%s %s", i18n.T(warningPrefix), i18n.Format(format))
 	fmt.Fprint(w, warningSprintf(wrappedFormat, args...))
 }
 
 func info(w io.Writer, args ...interface{}) {
-	fmt.Fprint(w, args...)
+	fmt.Fprint(w, i18n.T(fmt.Sprint(args...)))
 }
 
 func infoln(w io.Writer, args ...interface{}) {
-	fmt.Fprintln(w, args...)
+	fmt.Fprint(w, i18n.T(fmt.Sprintln(args...)))
 }
 
 func infof(w io.Writer, format string, args ...interface{}) {
-	fmt.Fprintf(w, format, args...)
+	fmt.Fprintf(w, i18n.Format(format), args...)
 }
 
 func debug(w io.Writer, args ...interface{}) {
-	fmt.Fprint(w, debugSprintf(fmt.Sprint(args...)))
+	fmt.Fprint(w, debugSprintf(i18n.T(fmt.Sprint(args...))))
 }
 
 func debugln(w io.Writer, args ...interface{}) {
-	fmt.Fprintln(w, debugSprintf(fmt.Sprint(args...)))
+	fmt.Fprintln(w, debugSprintf(i18n.T(fmt.Sprint(args...))))
 }
 
 func debugf(w io.Writer, format string, args ...interface{}) {
-	fmt.Fprint(w, debugSprintf(format, args...))
+	fmt.Fprint(w, debugSprintf(i18n.Format(format), args...))
 }
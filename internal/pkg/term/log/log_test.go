@@ -159,6 +159,12 @@ func TestWarningf(t *testing.T) {
 	require.Contains(t, b.String(), "hello world\n")
 }
 
+func TestSwarningf(t *testing.T) {
+	s := Swarningf("%s %s\n", "hello", "world")
+
+	require.Contains(t, s, fmt.Sprintf("%s hello world\n", warningPrefix))
+}
+
 func TestInfo(t *testing.T) {
 	// GIVEN
 	b := &strings.Builder{}
@@ -0,0 +1,51 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package output writes the results of commands to standard output, either for humans or as JSON for scripts.
+// Progress and diagnostic messages are written separately to standard error by the log and progress packages,
+// so that the results can be parsed reliably.
+package output
+
+import (
+	"fmt"
+	"io"
+)
+
+// HumanJSONStringer is a result that can be written for humans or as JSON.
+type HumanJSONStringer interface {
+	HumanString() string
+	JSONString() (string, error)
+}
+
+// Writer writes results to a writer in the format of its mode.
+type Writer struct {
+	w    io.Writer
+	json bool
+}
+
+// New returns a Writer that writes to w, as JSON if asJSON is true.
+func New(w io.Writer, asJSON bool) *Writer {
+	return &Writer{
+		w:    w,
+		json: asJSON,
+	}
+}
+
+// JSON returns true if the Writer writes results as JSON.
+func (w *Writer) JSON() bool {
+	return w.json
+}
+
+// Write writes the result in the format of the Writer.
+func (w *Writer) Write(result HumanJSONStringer) error {
+	if !w.json {
+		_, err := fmt.Fprint(w.w, result.HumanString())
+		return err
+	}
+	data, err := result.JSONString()
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprint(w.w, data)
+	return err
+}
@@ -0,0 +1,61 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package output
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type mockResult struct {
+	jsonErr error
+}
+
+func (r mockResult) HumanString() string {
+	return "human\n"
+}
+
+func (r mockResult) JSONString() (string, error) {
+	return "{\"json\":true}\n", r.jsonErr
+}
+
+func TestWriter_Write(t *testing.T) {
+	testCases := map[string]struct {
+		asJSON bool
+		result mockResult
+
+		wanted    string
+		wantedErr string
+	}{
+		"write for humans by default": {
+			wanted: "human\n",
+		},
+		"write JSON if requested by the command": {
+			asJSON: true,
+			wanted: "{\"json\":true}\n",
+		},
+		"error if the result cannot be marshaled": {
+			asJSON:    true,
+			result:    mockResult{jsonErr: errors.New("some error")},
+			wantedErr: "some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+
+			err := New(buf, tc.asJSON).Write(tc.result)
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, buf.String())
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// BlueGreenDeploymentSubscriber is the interface to subscribe channels to CodeDeploy blue/green deployment descriptions.
+type BlueGreenDeploymentSubscriber interface {
+	Subscribe() <-chan stream.BlueGreenDeployment
+}
+
+// BlueGreenDeploymentConfig holds the required parameters to create a blue/green deployment component.
+type BlueGreenDeploymentConfig struct {
+	// Common configuration.
+	Description string
+	RenderOpts  RenderOptions
+
+	// Blue/green deployment action configuration.
+	ActionStreamer  StackSubscriber
+	ActionLogicalID string
+
+	// CodeDeploy deployment configuration.
+	DeploymentStreamer     BlueGreenDeploymentSubscriber
+	CancelDeploymentStream context.CancelFunc
+}
+
+// ListeningBlueGreenDeploymentRenderer returns a component that listens and can render CloudFormation resource events
+// from the BlueGreenDeploymentAction and the CodeDeploy deployment that it triggers.
+func ListeningBlueGreenDeploymentRenderer(conf BlueGreenDeploymentConfig) DynamicRenderer {
+	c := &blueGreenDeploymentComponent{
+		cancelDeploymentStream: conf.CancelDeploymentStream,
+		actionComponent: listeningResourceComponent(
+			conf.ActionStreamer,
+			conf.ActionLogicalID,
+			conf.Description,
+			ResourceRendererOpts{
+				RenderOpts: conf.RenderOpts,
+			}),
+		deploymentComponent: &codeDeployDeploymentComponent{
+			padding: NestedRenderOptions(conf.RenderOpts).Padding,
+			stream:  conf.DeploymentStreamer.Subscribe(),
+			done:    make(chan struct{}),
+		},
+	}
+	go c.deploymentComponent.Listen()
+	return c
+}
+
+type blueGreenDeploymentComponent struct {
+	cancelDeploymentStream context.CancelFunc // Function that cancels streaming the CodeDeploy deployment.
+
+	actionComponent     *regularResourceComponent
+	deploymentComponent *codeDeployDeploymentComponent
+}
+
+// Render renders the BlueGreenDeploymentAction as a resource component followed by
+// the CodeDeploy deployment if one was started.
+func (c *blueGreenDeploymentComponent) Render(out io.Writer) (numLines int, err error) {
+	buf := new(bytes.Buffer)
+	nl, err := c.actionComponent.Render(buf)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
+	sw := &suffixWriter{
+		buf:    buf,
+		suffix: []byte{'\t', '\t'}, // Add two columns to the deployment renderer so that it aligns with resources.
+	}
+	nl, err = c.deploymentComponent.Render(sw)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
+	if _, err = buf.WriteTo(out); err != nil {
+		return 0, err
+	}
+	return numLines, nil
+}
+
+// Done returns a channel that's closed when both the BlueGreenDeploymentAction and the CodeDeploy deployment are done.
+// If the action is done before the deployment streamer, for example when no new deployment is needed,
+// the deployment streamer is notified to stop.
+func (c *blueGreenDeploymentComponent) Done() <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		<-c.actionComponent.Done()
+		c.cancelDeploymentStream()
+		<-c.deploymentComponent.Done()
+		close(done)
+	}()
+	return done
+}
+
+type codeDeployDeploymentComponent struct {
+	deployment *stream.BlueGreenDeployment // Latest description of the deployment.
+
+	padding int
+
+	stream <-chan stream.BlueGreenDeployment // Channel where deployment events are received.
+	done   chan struct{}                     // Channel that's closed when there are no more events to listen on.
+	mu     sync.Mutex                        // Lock used to mutate data to render.
+}
+
+// Listen updates the deployment as events are streamed.
+func (c *codeDeployDeploymentComponent) Listen() {
+	for ev := range c.stream {
+		ev := ev
+		c.mu.Lock()
+		c.deployment = &ev
+		c.mu.Unlock()
+	}
+	close(c.done)
+}
+
+// Render prints the deployment status followed by its lifecycle events as a tableComponent.
+// If no deployment was received, nothing is rendered.
+func (c *codeDeployDeploymentComponent) Render(out io.Writer) (numLines int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.deployment == nil {
+		return 0, nil
+	}
+	components := []Renderer{
+		&singleLineComponent{
+			Text:    fmt.Sprintf("%s %s", color.Faint.Sprintf("CodeDeploy deployment %s", c.deployment.ID), prettifyCodeDeployStatus(c.deployment.Status)),
+			Padding: c.padding,
+		},
+	}
+	if c.deployment.ErrorMessage != "" {
+		for _, text := range splitByLength(c.deployment.ErrorMessage, maxCellLength) {
+			components = append(components, &singleLineComponent{
+				Text:    colorFailureReason(text),
+				Padding: c.padding + nestedComponentPadding,
+			})
+		}
+	}
+	if len(c.deployment.LifecycleEvents) != 0 {
+		header := []string{"Event", "Status"}
+		var rows [][]string
+		for _, ev := range c.deployment.LifecycleEvents {
+			rows = append(rows, []string{ev.Name, prettifyCodeDeployStatus(ev.Status)})
+		}
+		table := newTableComponent(color.Faint.Sprintf("Lifecycle events"), header, rows)
+		table.Padding = c.padding
+		components = append(components, &singleLineComponent{}, table)
+	}
+	return renderComponents(out, components)
+}
+
+// Done returns a channel that's closed when there are no more events to listen.
+func (c *codeDeployDeploymentComponent) Done() <-chan struct{} {
+	return c.done
+}
+
+func prettifyCodeDeployStatus(status string) string {
+	pretty := fmt.Sprintf("[%s]", strings.ToLower(status))
+	switch status {
+	case codedeploy.DeploymentStatusSucceeded:
+		return color.Green.Sprint(pretty)
+	case codedeploy.DeploymentStatusFailed, codedeploy.DeploymentStatusStopped:
+		return color.Red.Sprint(pretty)
+	}
+	return pretty
+}
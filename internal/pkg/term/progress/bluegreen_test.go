@@ -0,0 +1,110 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeDeployDeploymentComponent_Render(t *testing.T) {
+	testCases := map[string]struct {
+		inDeployment *stream.BlueGreenDeployment
+
+		wantedNumLines int
+		wantedOut      string
+	}{
+		"should render nothing if there is no deployment": {},
+		"should render the deployment and its lifecycle events": {
+			inDeployment: &stream.BlueGreenDeployment{
+				ID:     "d-1",
+				Status: "InProgress",
+				LifecycleEvents: []codedeploy.LifecycleEvent{
+					{Name: "Install", Status: "Succeeded"},
+					{Name: "AllowTraffic", Status: "InProgress"},
+				},
+			},
+
+			wantedNumLines: 6,
+			wantedOut: `CodeDeploy deployment d-1 [inprogress]
+
+Lifecycle events
+  Event         Status
+  Install       [succeeded]
+  AllowTraffic  [inprogress]
+`,
+		},
+		"should render the error message of a failed deployment": {
+			inDeployment: &stream.BlueGreenDeployment{
+				ID:           "d-1",
+				Status:       "Failed",
+				ErrorMessage: "The deployment timed out while waiting for the replacement task set to become healthy.",
+			},
+
+			wantedNumLines: 3,
+			wantedOut: `CodeDeploy deployment d-1 [failed]
+  The deployment timed out while waiting for the replacement task set to
+   become healthy.
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			c := &codeDeployDeploymentComponent{
+				deployment: tc.inDeployment,
+			}
+			buf := new(strings.Builder)
+
+			// WHEN
+			nl, err := c.Render(buf)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNumLines, nl)
+			require.Equal(t, tc.wantedOut, buf.String())
+		})
+	}
+}
+
+func TestBlueGreenDeploymentComponent_Done(t *testing.T) {
+	t.Run("should cancel the deployment streamer once the action is done", func(t *testing.T) {
+		// GIVEN
+		actionDone := make(chan struct{})
+		deploymentDone := make(chan struct{})
+		var isCanceled bool
+		c := &blueGreenDeploymentComponent{
+			cancelDeploymentStream: func() {
+				isCanceled = true
+				close(deploymentDone)
+			},
+			actionComponent: &regularResourceComponent{
+				done: actionDone,
+			},
+			deploymentComponent: &codeDeployDeploymentComponent{
+				done: deploymentDone,
+			},
+		}
+
+		// WHEN
+		done := c.Done()
+		go func() {
+			close(actionDone)
+		}()
+
+		// THEN
+		select {
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "done channel is not closed, test deadline exceeded")
+		case <-done:
+			require.True(t, isCanceled, "deployment streamer should have been canceled when the action is done")
+		}
+	})
+}
@@ -57,6 +57,7 @@ func ListeningChangeSetRenderer(streamer StackSubscriber, stackName, description
 			RenderOpts: opts,
 		}),
 		Children: changes,
+		Collapse: opts.Collapse,
 	}
 }
 
@@ -87,6 +88,16 @@ func ListeningResourceRenderer(streamer StackSubscriber, logicalID, description
 	return listeningResourceComponent(streamer, logicalID, description, opts)
 }
 
+// ListeningCertificateResourceRenderer is a ListeningResourceRenderer for an ACM certificate that also renders
+// the latest reason of an in-progress event, such as the DNS record that CloudFormation waits on to validate the certificate.
+func ListeningCertificateResourceRenderer(streamer StackSubscriber, logicalID, description string, opts ResourceRendererOpts) DynamicRenderer {
+	comp := listeningResourceComponent(streamer, logicalID, description, opts)
+	comp.mu.Lock()
+	defer comp.mu.Unlock()
+	comp.showInProgressReason = true
+	return comp
+}
+
 // ListeningECSServiceResourceRenderer is a ListeningResourceRenderer for the ECS service cloudformation resource
 // and a ListeningRollingUpdateRenderer to render deployments.
 func ListeningECSServiceResourceRenderer(cfg ECSServiceRendererCfg, opts ECSServiceRendererOpts) DynamicRenderer {
@@ -126,6 +137,8 @@ type regularResourceComponent struct {
 	padding   int  // Leading spaces before rendering the resource.
 	separator rune // Character used to separate columns of text.
 
+	showInProgressReason bool // Whether to render the reason of the latest event while the resource is in progress.
+
 	stream <-chan stream.StackEvent
 	done   chan struct{}
 	mu     sync.Mutex
@@ -174,6 +187,17 @@ func (c *regularResourceComponent) Render(out io.Writer) (numLines int, err erro
 	defer c.mu.Unlock()
 
 	components := cfnLineItemComponents(c.description, c.separator, c.statuses, c.stopWatch, c.padding)
+	if c.showInProgressReason {
+		for _, text := range splitByLength(inProgressReason(c.statuses), maxCellLength) {
+			if text == "" {
+				continue
+			}
+			components = append(components, &singleLineComponent{
+				Text:    strings.Join([]string{colorInProgressReason(text), "", ""}, string(c.separator)),
+				Padding: c.padding + nestedComponentPadding,
+			})
+		}
+	}
 	return renderComponents(out, components)
 }
 
@@ -182,6 +206,19 @@ func (c *regularResourceComponent) Done() <-chan struct{} {
 	return c.done
 }
 
+// failed returns true if the resource went through a failed status.
+func (c *regularResourceComponent) failed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, status := range c.statuses {
+		if status.value.IsFailure() {
+			return true
+		}
+	}
+	return false
+}
+
 // stackComponent is a DynamicRenderer that can display CloudFormation stack events as they stream in.
 type stackComponent struct {
 	// Required inputs.
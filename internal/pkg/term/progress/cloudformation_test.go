@@ -403,6 +403,42 @@ func TestRegularResourceComponent_Render(t *testing.T) {
 		require.Equal(t, 1, nl, "expected to be rendered as a single line component")
 		require.Equal(t, "- An ECS cluster to hold your services\t[create in progress]\t[10.0s]\n", buf.String())
 	})
+	t.Run("renders the reason of a certificate that is in progress", func(t *testing.T) {
+		// GIVEN
+		comp := &regularResourceComponent{
+			description: "A certificate for the load balancer",
+			statuses: []cfnStatus{
+				notStartedStackStatus,
+				{
+					value: cloudformation.StackStatus("CREATE_IN_PROGRESS"),
+				},
+				{
+					value:  cloudformation.StackStatus("CREATE_IN_PROGRESS"),
+					reason: "Content of DNS Record is: {Name: _x1.example.com.,Type: CNAME,Value: _x2.acm-validations.aws.}",
+				},
+			},
+			stopWatch: &stopWatch{
+				startTime: testDate,
+				started:   true,
+				clock: &fakeClock{
+					wantedValues: []time.Time{testDate.Add(10 * time.Second)},
+				},
+			},
+			separator:            '\t',
+			showInProgressReason: true,
+		}
+		buf := new(strings.Builder)
+
+		// WHEN
+		nl, err := comp.Render(buf)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, 3, nl)
+		require.Equal(t, "- A certificate for the load balancer\t[create in progress]\t[10.0s]\n"+
+			"  Content of DNS Record is: {Name: _x1.example.com.,Type: CNAME,Value: _\t\t\n"+
+			"  x2.acm-validations.aws.}\t\t\n", buf.String())
+	})
 	t.Run("splits long failure reason into multiple lines", func(t *testing.T) {
 		// GIVEN
 		comp := &regularResourceComponent{
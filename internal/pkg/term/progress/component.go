@@ -64,10 +64,16 @@ func (c *treeComponent) Render(out io.Writer) (numLines int, err error) {
 type dynamicTreeComponent struct {
 	Root     DynamicRenderer
 	Children []Renderer
+
+	Collapse bool // Render only the Root once it's done without failures.
 }
 
 // Render creates a treeComponent and renders it.
+// If the tree is collapsible and the Root is done without failures, only the Root is rendered.
 func (c *dynamicTreeComponent) Render(out io.Writer) (numLines int, err error) {
+	if c.Collapse && c.rootSucceeded() {
+		return c.Root.Render(out)
+	}
 	comp := &treeComponent{
 		Root:     c.Root,
 		Children: c.Children,
@@ -75,6 +81,16 @@ func (c *dynamicTreeComponent) Render(out io.Writer) (numLines int, err error) {
 	return comp.Render(out)
 }
 
+func (c *dynamicTreeComponent) rootSucceeded() bool {
+	select {
+	case <-c.Root.Done():
+	default:
+		return false
+	}
+	r, ok := c.Root.(interface{ failed() bool })
+	return ok && !r.failed()
+}
+
 // Done return a channel that is closed when the children and root are done.
 func (c *dynamicTreeComponent) Done() <-chan struct{} {
 	done := make(chan struct{})
@@ -130,6 +130,67 @@ func TestDynamicTreeComponent_Render(t *testing.T) {
 	require.Equal(t, "hello world", buf.String())
 }
 
+type mockResourceRenderer struct {
+	mockDynamicRenderer
+	hasFailed bool
+}
+
+func (m *mockResourceRenderer) failed() bool {
+	return m.hasFailed
+}
+
+func TestDynamicTreeComponent_RenderCollapsed(t *testing.T) {
+	done := make(chan struct{})
+	close(done)
+	testCases := map[string]struct {
+		root *mockResourceRenderer
+
+		wantedOut string
+	}{
+		"renders the children while the root is not done": {
+			root: &mockResourceRenderer{
+				mockDynamicRenderer: mockDynamicRenderer{content: "hello", done: make(chan struct{})},
+			},
+			wantedOut: "hello world",
+		},
+		"renders the children if the root failed": {
+			root: &mockResourceRenderer{
+				mockDynamicRenderer: mockDynamicRenderer{content: "hello", done: done},
+				hasFailed:           true,
+			},
+			wantedOut: "hello world",
+		},
+		"renders only the root once it's done without failures": {
+			root: &mockResourceRenderer{
+				mockDynamicRenderer: mockDynamicRenderer{content: "hello", done: done},
+			},
+			wantedOut: "hello",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			comp := dynamicTreeComponent{
+				Root: tc.root,
+				Children: []Renderer{
+					&mockDynamicRenderer{
+						content: " world",
+					},
+				},
+				Collapse: true,
+			}
+			buf := new(strings.Builder)
+
+			// WHEN
+			_, err := comp.Render(buf)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOut, buf.String())
+		})
+	}
+}
+
 func TestDynamicTreeComponent_Done(t *testing.T) {
 	// GIVEN
 	root := &mockDynamicRenderer{
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
@@ -40,9 +41,10 @@ func ListeningRollingUpdateRenderer(streamer ECSServiceSubscriber, opts RenderOp
 
 type rollingUpdateComponent struct {
 	// Data to render.
-	deployments []stream.ECSDeployment
-	failureMsgs []string
-	alarms      []cloudwatch.AlarmStatus
+	deployments       []stream.ECSDeployment
+	failureMsgs       []string
+	spotInterruptions []string
+	alarms            []cloudwatch.AlarmStatus
 
 	// Style configuration for the component.
 	padding           int
@@ -62,6 +64,10 @@ func (c *rollingUpdateComponent) Listen() {
 		if len(c.failureMsgs) > c.maxLenFailureMsgs {
 			c.failureMsgs = c.failureMsgs[len(c.failureMsgs)-c.maxLenFailureMsgs:]
 		}
+		c.spotInterruptions = append(c.spotInterruptions, ev.SpotInterruptions...)
+		if len(c.spotInterruptions) > c.maxLenFailureMsgs {
+			c.spotInterruptions = c.spotInterruptions[len(c.spotInterruptions)-c.maxLenFailureMsgs:]
+		}
 		c.alarms = ev.Alarms
 		c.mu.Unlock()
 	}
@@ -86,6 +92,18 @@ func (c *rollingUpdateComponent) Render(out io.Writer) (numLines int, err error)
 	}
 	numLines += nl
 
+	nl, err = c.renderSpotInterruptions(buf)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
+	nl, err = c.renderRollbackCause(buf)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
 	nl, err = c.renderAlarms(buf)
 	if err != nil {
 		return 0, err
@@ -159,6 +177,79 @@ func (c *rollingUpdateComponent) renderFailureMsgs(out io.Writer) (numLines int,
 	return renderComponents(out, components)
 }
 
+// renderSpotInterruptions lists the latest tasks that Fargate Spot stopped during the deployment.
+func (c *rollingUpdateComponent) renderSpotInterruptions(out io.Writer) (numLines int, err error) {
+	if len(c.spotInterruptions) == 0 {
+		return 0, nil
+	}
+	title := "Latest Spot interruption"
+	if l := len(c.spotInterruptions); l > 1 {
+		title = fmt.Sprintf("Latest %d Spot interruptions", l)
+	}
+	components := []Renderer{
+		&singleLineComponent{}, // Add an empty line before rendering the interruptions.
+		&singleLineComponent{
+			Text:    fmt.Sprintf("%s%s", color.Yellow.Sprintf("! "), color.Faint.Sprintf(title)),
+			Padding: c.padding,
+		},
+	}
+	for _, msg := range reverseStrings(c.spotInterruptions) {
+		for i, truncated := range splitByLength(msg, maxCellLength) {
+			pretty := fmt.Sprintf("  %s", truncated)
+			if i == 0 {
+				pretty = fmt.Sprintf("- %s", truncated)
+			}
+			components = append(components, &singleLineComponent{
+				Text:    pretty,
+				Padding: c.padding + nestedComponentPadding,
+			})
+		}
+	}
+	return renderComponents(out, components)
+}
+
+// renderRollbackCause explains why ECS rolled back a failed deployment, along with the alarms that went off if any.
+func (c *rollingUpdateComponent) renderRollbackCause(out io.Writer) (numLines int, err error) {
+	var reasons []string
+	for _, d := range c.deployments {
+		if d.Failed() && d.RolloutStateReason != "" {
+			reasons = append(reasons, d.RolloutStateReason)
+		}
+	}
+	if len(reasons) == 0 {
+		return 0, nil
+	}
+	var inAlarm []string
+	for _, a := range c.alarms {
+		if a.Status == inAlarmState {
+			inAlarm = append(inAlarm, a.Name)
+		}
+	}
+	if len(inAlarm) > 0 {
+		reasons = append(reasons, fmt.Sprintf("Alarms in %s state: %s", inAlarmState, strings.Join(inAlarm, ", ")))
+	}
+	components := []Renderer{
+		&singleLineComponent{}, // Add an empty line before rendering the rollback cause.
+		&singleLineComponent{
+			Text:    fmt.Sprintf("%s%s", color.DullRed.Sprintf("✘ "), color.Faint.Sprintf("Rollback cause")),
+			Padding: c.padding,
+		},
+	}
+	for _, reason := range reasons {
+		for i, truncated := range splitByLength(reason, maxCellLength) {
+			pretty := fmt.Sprintf("  %s", truncated)
+			if i == 0 {
+				pretty = fmt.Sprintf("- %s", truncated)
+			}
+			components = append(components, &singleLineComponent{
+				Text:    pretty,
+				Padding: c.padding + nestedComponentPadding,
+			})
+		}
+	}
+	return renderComponents(out, components)
+}
+
 func (c *rollingUpdateComponent) renderAlarms(out io.Writer) (numLines int, err error) {
 	if len(c.alarms) == 0 {
 		return 0, nil
@@ -56,6 +56,7 @@ func TestRollingUpdateComponent_Listen(t *testing.T) {
 					},
 				},
 				LatestFailureEvents: []string{"event4"},
+				SpotInterruptions:   []string{"interruption1"},
 			}
 			close(events)
 		}()
@@ -72,14 +73,16 @@ func TestRollingUpdateComponent_Listen(t *testing.T) {
 			},
 		}, c.deployments, "expected only the latest deployment to be stored")
 		require.Equal(t, []string{"event3", "event4"}, c.failureMsgs, "expected max len failure msgs to be respected")
+		require.Equal(t, []string{"interruption1"}, c.spotInterruptions)
 	})
 }
 
 func TestRollingUpdateComponent_Render(t *testing.T) {
 	testCases := map[string]struct {
-		inDeployments []stream.ECSDeployment
-		inFailureMsgs []string
-		inAlarms      []cloudwatch.AlarmStatus
+		inDeployments       []stream.ECSDeployment
+		inFailureMsgs       []string
+		inSpotInterruptions []string
+		inAlarms            []cloudwatch.AlarmStatus
 
 		wantedNumLines int
 		wantedOut      string
@@ -132,6 +135,18 @@ func TestRollingUpdateComponent_Render(t *testing.T) {
 ✘ Latest 2 failure events
   - (service my-svc) (task 5678) failed container health checks.
   - (service my-svc) (task 1234) failed container health checks.
+`,
+		},
+		"should render spot interruptions in reverse order": {
+			inSpotInterruptions: []string{
+				"Task 1234 was interrupted by Fargate Spot.",
+				"Task 5678 was interrupted by Fargate Spot.",
+			},
+			wantedNumLines: 4,
+			wantedOut: `
+! Latest 2 Spot interruptions
+  - Task 5678 was interrupted by Fargate Spot.
+  - Task 1234 was interrupted by Fargate Spot.
 `,
 		},
 		"should render rollback alarms and their statuses": {
@@ -147,6 +162,41 @@ func TestRollingUpdateComponent_Render(t *testing.T) {
 			},
 			wantedNumLines: 5,
 			wantedOut: `
+Alarms
+  Name    State
+  alarm1  [OK]
+  alarm2  [ALARM]
+`,
+		},
+		"should render the cause of a rollback with the alarms that went off": {
+			inDeployments: []stream.ECSDeployment{
+				{
+					Status:             "PRIMARY",
+					TaskDefRevision:    "3",
+					DesiredCount:       1,
+					RolloutState:       "FAILED",
+					RolloutStateReason: "ECS deployment circuit breaker: alarm detected.",
+				},
+			},
+			inAlarms: []cloudwatch.AlarmStatus{
+				{
+					Name:   "alarm1",
+					Status: "OK",
+				},
+				{
+					Name:   "alarm2",
+					Status: "ALARM",
+				},
+			},
+			wantedNumLines: 12,
+			wantedOut: `Deployments
+           Revision  Rollout   Desired  Running  Failed  Pending
+  PRIMARY  3         [failed]  1        0        0       0
+
+✘ Rollback cause
+  - ECS deployment circuit breaker: alarm detected.
+  - Alarms in ALARM state: alarm2
+
 Alarms
   Name    State
   alarm1  [OK]
@@ -160,9 +210,10 @@ Alarms
 			// GIVEN
 			buf := new(strings.Builder)
 			c := &rollingUpdateComponent{
-				deployments: tc.inDeployments,
-				failureMsgs: tc.inFailureMsgs,
-				alarms:      tc.inAlarms,
+				deployments:       tc.inDeployments,
+				failureMsgs:       tc.inFailureMsgs,
+				spotInterruptions: tc.inSpotInterruptions,
+				alarms:            tc.inAlarms,
 			}
 
 			// WHEN
@@ -0,0 +1,66 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// ETARenderer returns a DynamicRenderer that renders r followed by the estimated time remaining until r is done.
+// The estimate is the expected duration of the whole operation, such as the average of the previous deployments.
+// The estimate is only rendered in "tree" mode while r is not done.
+func ETARenderer(r DynamicRenderer, estimate time.Duration, opts RenderOptions) DynamicRenderer {
+	sw := newStopWatch()
+	sw.start()
+	return &etaComponent{
+		renderer:  r,
+		estimate:  estimate,
+		stopWatch: sw,
+		padding:   opts.Padding,
+	}
+}
+
+// etaComponent can display a component followed by the time remaining until it's done.
+type etaComponent struct {
+	renderer  DynamicRenderer
+	estimate  time.Duration
+	stopWatch *stopWatch
+	padding   int
+}
+
+// Render writes the component and then the estimated time remaining if the component isn't done.
+func (c *etaComponent) Render(out io.Writer) (int, error) {
+	numLines, err := c.renderer.Render(out)
+	if err != nil {
+		return 0, err
+	}
+	if mode != ModeTree {
+		return numLines, nil
+	}
+	select {
+	case <-c.renderer.Done():
+		return numLines, nil
+	default:
+	}
+	elapsed, _ := c.stopWatch.elapsed()
+	remaining := c.estimate - elapsed
+	text := color.Faint.Sprintf("ETA %s based on previous deployments", remaining.Round(time.Second))
+	if remaining <= 0 {
+		text = color.Faint.Sprintf("Taking longer than previous deployments, which took %s on average", c.estimate.Round(time.Second))
+	}
+	nl, err := LineRenderer(fmt.Sprintf("%s\t\t", text), c.padding).Render(out)
+	if err != nil {
+		return 0, err
+	}
+	return numLines + nl, nil
+}
+
+// Done returns a channel that's closed when the component is done.
+func (c *etaComponent) Done() <-chan struct{} {
+	return c.renderer.Done()
+}
@@ -0,0 +1,92 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestETAComponent_Render(t *testing.T) {
+	startTime := time.Date(2021, 1, 6, 0, 0, 0, 0, time.UTC)
+	done := make(chan struct{})
+	close(done)
+	testCases := map[string]struct {
+		inMode     string
+		inDone     chan struct{}
+		inElapsed  time.Duration
+		inEstimate time.Duration
+
+		wantedNumLines int
+		wantedOut      string
+	}{
+		"renders the time remaining": {
+			inMode:     ModeTree,
+			inDone:     make(chan struct{}),
+			inElapsed:  20 * time.Second,
+			inEstimate: 90 * time.Second,
+
+			wantedNumLines: 2,
+			wantedOut:      "hi\nETA 1m10s based on previous deployments\t\t\n",
+		},
+		"renders that the operation takes longer than the estimate": {
+			inMode:     ModeTree,
+			inDone:     make(chan struct{}),
+			inElapsed:  2 * time.Minute,
+			inEstimate: 90 * time.Second,
+
+			wantedNumLines: 2,
+			wantedOut:      "hi\nTaking longer than previous deployments, which took 1m30s on average\t\t\n",
+		},
+		"does not render the estimate once done": {
+			inMode:     ModeTree,
+			inDone:     done,
+			inEstimate: 90 * time.Second,
+
+			wantedNumLines: 1,
+			wantedOut:      "hi\n",
+		},
+		"does not render the estimate in plain mode": {
+			inMode:     ModePlain,
+			inDone:     make(chan struct{}),
+			inEstimate: 90 * time.Second,
+
+			wantedNumLines: 1,
+			wantedOut:      "hi\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mode = tc.inMode
+			defer func() { mode = ModeTree }()
+			sw := &stopWatch{
+				clock: &fakeClock{
+					wantedValues: []time.Time{startTime, startTime.Add(tc.inElapsed)},
+				},
+			}
+			sw.start()
+			comp := &etaComponent{
+				renderer: &mockDynamicRenderer{
+					content: "hi\n",
+					done:    tc.inDone,
+				},
+				estimate:  tc.inEstimate,
+				stopWatch: sw,
+			}
+			buf := new(strings.Builder)
+
+			// WHEN
+			nl, err := comp.Render(buf)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNumLines, nl)
+			require.Equal(t, tc.wantedOut, buf.String())
+		})
+	}
+}
@@ -4,13 +4,48 @@
 package progress
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"io"
+	"regexp"
+	"strings"
 	"time"
 
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/cursor"
 )
 
+// Modes of the --progress flag.
+const (
+	ModeTree  = "tree"  // Update the components in-place.
+	ModePlain = "plain" // Append a line every time a component changes, for logs that can't be rewritten.
+	ModeQuiet = "quiet" // Don't render the components.
+)
+
+var (
+	mode = ModeTree
+
+	// elapsedTimeSuffix matches the elapsed time column of a line, so that a line isn't printed again in plain mode only because time passed.
+	elapsedTimeSuffix = regexp.MustCompile(`\s*(\x1b\[[0-9;]*m)?\[\d+(\.\d+)?s\](\x1b\[[0-9;]*m)?\s*$`)
+)
+
+// SetMode sets how Render displays the progress of components, such as from the --progress flag.
+// The mode must be one of "tree", "plain", or "quiet".
+func SetMode(m string) error {
+	switch strings.ToLower(m) {
+	case ModeTree, ModePlain, ModeQuiet:
+		mode = strings.ToLower(m)
+		return nil
+	}
+	return fmt.Errorf("invalid progress mode %q, must be one of %s, %s, or %s", m, ModeTree, ModePlain, ModeQuiet)
+}
+
+// Mode returns how Render displays the progress of components.
+func Mode() string {
+	return mode
+}
+
 // Renderer is the interface to print a component to a writer.
 // It returns the number of lines printed and the error if any.
 type Renderer interface {
@@ -26,7 +61,8 @@ type DynamicRenderer interface {
 
 // RenderOptions holds optional style configuration for renderers.
 type RenderOptions struct {
-	Padding int // Leading spaces before rendering the component.
+	Padding  int  // Leading spaces before rendering the component.
+	Collapse bool // Hide the children of a tree component once it's done without failures.
 }
 
 // NestedRenderOptions takes a RenderOptions and returns the same RenderOptions but with additional padding.
@@ -39,7 +75,15 @@ func NestedRenderOptions(opts RenderOptions) RenderOptions {
 // Render renders r periodically to out and returns the last number of lines written to out.
 // Render stops when there the ctx is canceled or r is done listening to new events.
 // While Render is executing, the terminal cursor is hidden and updates are written in-place.
+// If the mode is "plain", only the lines that changed are appended to out instead; if the mode is "quiet", nothing is written.
+// The "tree" mode falls back to "plain" in the accessibility mode, since screen readers can't follow lines updated in-place.
 func Render(ctx context.Context, out FileWriteFlusher, r DynamicRenderer) (int, error) {
+	switch {
+	case mode == ModeQuiet:
+		return renderQuiet(ctx, r)
+	case mode == ModePlain, color.Accessible():
+		return renderPlain(ctx, out, r)
+	}
 	defer out.Flush() // Make sure every buffered text in out is written before exiting.
 
 	cursor := cursor.NewWithWriter(out)
@@ -63,6 +107,61 @@ func Render(ctx context.Context, out FileWriteFlusher, r DynamicRenderer) (int,
 	}
 }
 
+// renderQuiet waits until the ctx is canceled or r is done listening to new events without writing anything.
+func renderQuiet(ctx context.Context, r DynamicRenderer) (int, error) {
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case <-r.Done():
+		return 0, nil
+	}
+}
+
+// renderPlain renders r periodically and appends to out the lines that weren't written before.
+// Lines that only differ by their elapsed time are written once, so that the output can be read as a log.
+func renderPlain(ctx context.Context, out FileWriteFlusher, r DynamicRenderer) (int, error) {
+	defer out.Flush()
+
+	seen := make(map[string]bool)
+	var writtenLines int
+	for {
+		select {
+		case <-ctx.Done():
+			return writtenLines, ctx.Err()
+		case <-r.Done():
+			nl, err := appendNewLines(out, r, seen)
+			return writtenLines + nl, err
+		case <-time.After(renderInterval):
+			nl, err := appendNewLines(out, r, seen)
+			if err != nil {
+				return writtenLines, err
+			}
+			writtenLines += nl
+		}
+	}
+}
+
+// appendNewLines renders r and writes to out the lines that aren't in seen.
+func appendNewLines(out FileWriteFlusher, r Renderer, seen map[string]bool) (int, error) {
+	buf := new(bytes.Buffer)
+	if _, err := r.Render(buf); err != nil {
+		return 0, err
+	}
+	var numLines int
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n") {
+		key := elapsedTimeSuffix.ReplaceAllString(line, "")
+		if strings.TrimSpace(key) == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		if _, err := fmt.Fprintln(out, line); err != nil {
+			return 0, err
+		}
+		numLines++
+	}
+	return numLines, out.Flush()
+}
+
 // EraseAndRender erases prevNumLines from out and then renders r.
 func EraseAndRender(out FileWriteFlusher, r Renderer, prevNumLines int) (int, error) {
 	cursor.EraseLinesAbove(out, prevNumLines)
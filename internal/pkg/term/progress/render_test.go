@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/cursor"
 	"github.com/stretchr/testify/require"
 )
@@ -267,3 +268,121 @@ func TestMultiRenderer(t *testing.T) {
 		}
 	})
 }
+
+func TestRender_Modes(t *testing.T) {
+	renderInterval = 100 * time.Millisecond
+	defer func() { mode = ModeTree }()
+
+	t.Run("appends only the lines that changed in plain mode", func(t *testing.T) {
+		// GIVEN
+		mode = ModePlain
+		actual := new(strings.Builder)
+		done := make(chan struct{})
+		r := &mockSequenceRenderer{
+			frames: []string{
+				"- stack\t[in progress]\t[0.1s]\n",
+				"- stack\t[in progress]\t[0.2s]\n",
+				"- stack\t[in progress]\t[0.3s]\n  - queue\t[create complete]\t[0.1s]\n",
+			},
+			done: done,
+		}
+		out := &mockFileWriteFlusher{
+			wrapper: actual,
+		}
+		go func() {
+			<-time.After(350 * time.Millisecond)
+			close(done)
+		}()
+
+		// WHEN
+		nl, err := Render(context.Background(), out, r)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, 2, nl)
+		require.Equal(t, "- stack\t[in progress]\t[0.1s]\n  - queue\t[create complete]\t[0.1s]\n", actual.String())
+	})
+	t.Run("appends lines instead of updating them in-place in the accessibility mode", func(t *testing.T) {
+		// GIVEN
+		mode = ModeTree
+		color.SetAccessible(true)
+		defer color.SetAccessible(false)
+		actual := new(strings.Builder)
+		done := make(chan struct{})
+		r := &mockSequenceRenderer{
+			frames: []string{
+				"- stack\t[in progress]\t[0.1s]\n",
+				"- stack\t[create complete]\t[0.2s]\n",
+			},
+			done: done,
+		}
+		out := &mockFileWriteFlusher{
+			wrapper: actual,
+		}
+		go func() {
+			<-time.After(250 * time.Millisecond)
+			close(done)
+		}()
+
+		// WHEN
+		nl, err := Render(context.Background(), out, r)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, 2, nl)
+		require.Equal(t, "- stack\t[in progress]\t[0.1s]\n- stack\t[create complete]\t[0.2s]\n", actual.String())
+	})
+	t.Run("writes nothing in quiet mode", func(t *testing.T) {
+		// GIVEN
+		mode = ModeQuiet
+		actual := new(strings.Builder)
+		done := make(chan struct{})
+		r := &mockDynamicRenderer{
+			content: "hi\n",
+			done:    done,
+		}
+		out := &mockFileWriteFlusher{
+			wrapper: actual,
+		}
+		go func() {
+			<-time.After(250 * time.Millisecond)
+			close(done)
+		}()
+
+		// WHEN
+		nl, err := Render(context.Background(), out, r)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, 0, nl)
+		require.Empty(t, actual.String())
+	})
+}
+
+// mockSequenceRenderer renders the next frame every time it's rendered, and then repeats the last frame.
+type mockSequenceRenderer struct {
+	frames []string
+	next   int
+	done   chan struct{}
+}
+
+func (m *mockSequenceRenderer) Render(out io.Writer) (int, error) {
+	frame := m.frames[m.next]
+	if m.next < len(m.frames)-1 {
+		m.next++
+	}
+	out.Write([]byte(frame))
+	return strings.Count(frame, "\n"), nil
+}
+
+func (m *mockSequenceRenderer) Done() <-chan struct{} {
+	return m.done
+}
+
+func TestSetMode(t *testing.T) {
+	defer func() { mode = ModeTree }()
+
+	require.NoError(t, SetMode("PLAIN"))
+	require.Equal(t, ModePlain, Mode())
+	require.EqualError(t, SetMode("fancy"), `invalid progress mode "fancy", must be one of tree, plain, or quiet`)
+}
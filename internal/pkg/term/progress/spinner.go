@@ -7,9 +7,14 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/i18n"
 	"github.com/briandowns/spinner"
+	"golang.org/x/term"
 )
 
 // Events display settings.
@@ -22,12 +27,30 @@ const (
 	maxCellLength          = 70 // Number of characters we want to display at most in a cell before wrapping it to the next line.
 )
 
+// plainTimestampFormat is the format of the timestamps that prefix the lines of a spinner in plain mode.
+const plainTimestampFormat = "15:04:05"
+
+// Progress is the interface to report that a long operation is taking place.
+// Subsystems that upload, push, or compute something on behalf of a command report into it,
+// so that their progress is displayed the same way as the rest of the command.
+type Progress interface {
+	// Start starts displaying progress with a label.
+	Start(label string)
+	// Stop ends displaying progress with a label.
+	Stop(label string)
+}
+
+// isTerminal is overridden in tests.
+var isTerminal = term.IsTerminal
+
 // startStopper is the interface to interact with the spinner.
 type startStopper interface {
 	Start()
 	Stop()
 }
 
+var _ Progress = (*Spinner)(nil)
+
 // Spinner represents an indicator that an asynchronous operation is taking place.
 //
 // For short operations, less than 4 seconds, display only the spinner with the Start and Stop methods.
@@ -37,7 +60,18 @@ type Spinner struct {
 }
 
 // NewSpinner returns a spinner that outputs to w.
+// If w is a file that isn't a terminal, such as the output of a CI job, if the accessibility mode is on,
+// or if the progress mode is "plain", the spinner doesn't animate and instead writes a timestamped line
+// when it starts and when it stops.
 func NewSpinner(w io.Writer) *Spinner {
+	if f, ok := w.(FileWriter); ok && !isTerminal(int(f.Fd())) || color.Accessible() || mode == ModePlain {
+		return &Spinner{
+			spin: &plainSpinner{
+				w:     w,
+				clock: realClock{},
+			},
+		}
+	}
 	interval := 125 * time.Millisecond
 	if os.Getenv("CI") == "true" {
 		interval = 30 * time.Second
@@ -51,40 +85,84 @@ func NewSpinner(w io.Writer) *Spinner {
 
 // Start starts the spinner suffixed with a label.
 func (s *Spinner) Start(label string) {
-	s.suffix(fmt.Sprintf(" %s", label))
+	s.suffix(fmt.Sprintf(" %s", i18n.T(label)))
 	s.spin.Start()
 }
 
 // Stop stops the spinner and replaces it with a label.
 func (s *Spinner) Stop(label string) {
-	s.finalMSG(fmt.Sprint(label))
+	s.finalMSG(i18n.T(label))
 	s.spin.Stop()
 }
 
 func (s *Spinner) lock() {
-	if spinner, ok := s.spin.(*spinner.Spinner); ok {
-		spinner.Lock()
+	switch sp := s.spin.(type) {
+	case *spinner.Spinner:
+		sp.Lock()
+	case *plainSpinner:
+		sp.mu.Lock()
 	}
 }
 
 func (s *Spinner) unlock() {
-	if spinner, ok := s.spin.(*spinner.Spinner); ok {
-		spinner.Unlock()
+	switch sp := s.spin.(type) {
+	case *spinner.Spinner:
+		sp.Unlock()
+	case *plainSpinner:
+		sp.mu.Unlock()
 	}
 }
 
 func (s *Spinner) suffix(label string) {
 	s.lock()
 	defer s.unlock()
-	if spinner, ok := s.spin.(*spinner.Spinner); ok {
-		spinner.Suffix = label
+	switch sp := s.spin.(type) {
+	case *spinner.Spinner:
+		sp.Suffix = label
+	case *plainSpinner:
+		sp.label = label
 	}
 }
 
 func (s *Spinner) finalMSG(label string) {
 	s.lock()
 	defer s.unlock()
-	if spinner, ok := s.spin.(*spinner.Spinner); ok {
-		spinner.FinalMSG = label
+	switch sp := s.spin.(type) {
+	case *spinner.Spinner:
+		sp.FinalMSG = label
+	case *plainSpinner:
+		sp.finalMSG = label
+	}
+}
+
+// plainSpinner writes line-oriented status updates prefixed with a timestamp instead of
+// redrawing a line with control characters, which is unreadable when the output is saved to a log.
+type plainSpinner struct {
+	mu       sync.Mutex
+	w        io.Writer
+	clock    clock
+	label    string
+	finalMSG string
+}
+
+// Start writes the label of the spinner.
+func (s *plainSpinner) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.writeLine(s.label)
+}
+
+// Stop writes the final message of the spinner, if any.
+func (s *plainSpinner) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.writeLine(s.finalMSG)
+}
+
+func (s *plainSpinner) writeLine(msg string) {
+	msg = strings.TrimSpace(msg)
+	if msg == "" {
+		return
 	}
+	fmt.Fprintf(s.w, "[%s] %s\n", s.clock.now().Format(plainTimestampFormat), msg)
 }
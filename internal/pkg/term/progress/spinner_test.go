@@ -9,10 +9,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress/mocks"
 	spin "github.com/briandowns/spinner"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/term"
 )
 
 func TestNew(t *testing.T) {
@@ -58,3 +60,45 @@ func TestSpinner_Stop(t *testing.T) {
 	// WHEN
 	s.Stop("stop")
 }
+
+type fakeFileWriter struct {
+	strings.Builder
+}
+
+func (w *fakeFileWriter) Fd() uintptr {
+	return 0
+}
+
+func TestSpinner_Plain(t *testing.T) {
+	defer func() { isTerminal = term.IsTerminal }()
+	isTerminal = func(fd int) bool { return false }
+	w := &fakeFileWriter{}
+	s := NewSpinner(w)
+	plain, ok := s.spin.(*plainSpinner)
+	require.True(t, ok)
+	plain.clock = &fakeClock{
+		wantedValues: []time.Time{
+			time.Date(2023, time.March, 1, 10, 0, 0, 0, time.UTC),
+			time.Date(2023, time.March, 1, 10, 0, 42, 0, time.UTC),
+		},
+	}
+
+	s.Start("Uploading artifacts")
+	s.Stop("✔ Uploaded artifacts\n")
+	s.Start("Computing the diff")
+	s.Stop("")
+
+	require.Equal(t, "[10:00:00] Uploading artifacts\n[10:00:42] ✔ Uploaded artifacts\n[10:00:00] Computing the diff\n", w.String())
+}
+
+func TestSpinner_Accessible(t *testing.T) {
+	defer func() { isTerminal = term.IsTerminal }()
+	isTerminal = func(fd int) bool { return true }
+	color.SetAccessible(true)
+	defer color.SetAccessible(false)
+
+	s := NewSpinner(&fakeFileWriter{})
+
+	_, ok := s.spin.(*plainSpinner)
+	require.True(t, ok, "the spinner should not animate in the accessibility mode")
+}
@@ -101,6 +101,15 @@ func failureReasons(statuses []cfnStatus) []string {
 	return reasons
 }
 
+// inProgressReason returns the reason of the latest status if the resource is still in progress.
+func inProgressReason(statuses []cfnStatus) string {
+	latest := statuses[len(statuses)-1]
+	if !latest.value.InProgress() {
+		return ""
+	}
+	return latest.reason
+}
+
 func splitByLength(s string, maxLength int) []string {
 	numItems := len(s)/maxLength + 1
 	var ss []string
@@ -140,3 +149,7 @@ func colorStackStatus(statuses []cfnStatus) func(format string, a ...interface{}
 func colorFailureReason(text string) string {
 	return color.DullRed.Sprint(text)
 }
+
+func colorInProgressReason(text string) string {
+	return color.Faint.Sprint(text)
+}
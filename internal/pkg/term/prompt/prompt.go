@@ -14,79 +14,81 @@ import (
 	"github.com/AlecAivazis/survey/v2/core"
 	"github.com/AlecAivazis/survey/v2/terminal"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/i18n"
 )
 
 func init() {
 	survey.ConfirmQuestionTemplate = `{{if not .Answer}}
 {{end}}
-{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }}{{$lines := split .Help "\n"}}{{range $i, $line := $lines}}
+{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }}{{$lines := split (translate .Help) "\n"}}{{range $i, $line := $lines}}
 {{- if eq $i 0}}  {{ $line }}
 {{ else }}  {{ $line }}
 {{ end }}{{- end }}{{color "reset"}}{{end}}
 {{- color .Config.Icons.Question.Format }}{{if not .Answer}}  {{ .Config.Icons.Question.Text }}{{else}}{{ .Config.Icons.Question.Text }}{{end}}{{color "reset"}}
-{{- color "default"}}{{ .Message }} {{color "reset"}}
+{{- color "default"}}{{ translate .Message }} {{color "reset"}}
 {{- if .Answer}}
   {{- color "default"}}{{.Answer}}{{color "reset"}}{{"\n"}}
 {{- else }}
-  {{- if and .Help (not .ShowHelp)}}{{color "white"}}[{{ .Config.HelpInput }} for help]{{color "reset"}} {{end}}
+  {{- if and .Help (not .ShowHelp)}}{{color "white"}}[{{ .Config.HelpInput }} {{ translate "for help" }}]{{color "reset"}} {{end}}
   {{- color "default"}}{{if .Default}}(Y/n) {{else}}(y/N) {{end}}{{color "reset"}}
 {{- end}}`
 
 	survey.SelectQuestionTemplate = `{{if not .Answer}}
 {{end}}
-{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }}{{$lines := split .Help "\n"}}{{range $i, $line := $lines}}
+{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }}{{$lines := split (translate .Help) "\n"}}{{range $i, $line := $lines}}
 {{- if eq $i 0}}  {{ $line }}
 {{ else }}  {{ $line }}
 {{ end }}{{- end }}{{color "reset"}}{{end}}
 {{- color .Config.Icons.Question.Format }}{{if not .ShowAnswer}}  {{ .Config.Icons.Question.Text }}{{else}}{{ .Config.Icons.Question.Text }}{{end}}{{color "reset"}}
-{{- color "default"}}{{ .Message }}{{ .FilterMessage }}{{color "reset"}}
+{{- color "default"}}{{ translate .Message }}{{ .FilterMessage }}{{color "reset"}}
 {{- if .ShowAnswer}}{{color "default"}} {{parseAnswer .Answer}}{{color "reset"}}{{"\n"}}
 {{- else}}
-  {{- "  "}}{{- color "white"}}[Use arrows to move, type to filter{{- if and .Help (not .ShowHelp)}}, {{ .Config.HelpInput }} for more help{{end}}]{{color "reset"}}
+  {{- "  "}}{{- color "white"}}[{{ translate "Use arrows to move, type to filter" }}{{- if and .Help (not .ShowHelp)}}, {{ .Config.HelpInput }} {{ translate "for more help" }}{{end}}]{{color "reset"}}
   {{- "\n"}}
   {{- range $ix, $choice := .PageEntries}}
     {{- if eq $ix $.SelectedIndex }}{{color "default+b" }}  {{ $.Config.Icons.SelectFocus.Text }} {{else}}{{color "default"}}    {{end}}
     {{- $choice.Value}}
     {{- color "reset"}}{{"\n"}}
   {{- end}}
+  {{- preview .PageEntries .SelectedIndex}}
 {{- end}}`
 
 	survey.InputQuestionTemplate = `{{if not .ShowAnswer}}
 {{end}}
-{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }}{{$lines := split .Help "\n"}}{{range $i, $line := $lines}}
+{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }}{{$lines := split (translate .Help) "\n"}}{{range $i, $line := $lines}}
 {{- if eq $i 0}}  {{ $line }}
 {{ else }}  {{ $line }}
 {{ end }}{{- end }}{{color "reset"}}{{end}}
 {{- color .Config.Icons.Question.Format }}{{if not .ShowAnswer}}  {{ .Config.Icons.Question.Text }}{{else}}{{ .Config.Icons.Question.Text }}{{end}}{{color "reset"}}
-{{- color "default"}}{{ .Message }} {{color "reset"}}
+{{- color "default"}}{{ translate .Message }} {{color "reset"}}
 {{- if .ShowAnswer}}
   {{- color "default"}}{{.Answer}}{{color "reset"}}{{"\n"}}
 {{- else }}
-  {{- if and .Help (not .ShowHelp)}}{{color "white"}}[{{ print .Config.HelpInput }} for help]{{color "reset"}} {{end}}
+  {{- if and .Help (not .ShowHelp)}}{{color "white"}}[{{ print .Config.HelpInput }} {{ translate "for help" }}]{{color "reset"}} {{end}}
   {{- if .Default}}{{color "default"}}({{.Default}}) {{color "reset"}}{{end}}
   {{- .Answer -}}
 {{- end}}`
 
 	survey.PasswordQuestionTemplate = `
-{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }}{{$lines := split .Help "\n"}}{{range $i, $line := $lines}}
+{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }}{{$lines := split (translate .Help) "\n"}}{{range $i, $line := $lines}}
 {{- if eq $i 0}}  {{ $line }}
 {{ else }}  {{ $line }}
 {{ end }}{{- end }}{{color "reset"}}{{end}}
 {{- color .Config.Icons.Question.Format }}  {{ .Config.Icons.Question.Text }}{{color "reset"}}
-{{- color "default"}}{{ .Message }} {{color "reset"}}
-{{- if and .Help (not .ShowHelp)}}{{color "white"}}[{{ .Config.HelpInput }} for help]{{color "reset"}} {{end}}`
+{{- color "default"}}{{ translate .Message }} {{color "reset"}}
+{{- if and .Help (not .ShowHelp)}}{{color "white"}}[{{ .Config.HelpInput }} {{ translate "for help" }}]{{color "reset"}} {{end}}`
 
 	survey.MultiSelectQuestionTemplate = `{{if not .Answer}}
 {{end}}
-{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }}{{$lines := split .Help "\n"}}{{range $i, $line := $lines}}
+{{- if .ShowHelp }}{{- color .Config.Icons.Help.Format }}{{ .Config.Icons.Help.Text }}{{$lines := split (translate .Help) "\n"}}{{range $i, $line := $lines}}
 {{- if eq $i 0}}  {{ $line }}
 {{ else }}  {{ $line }}
 {{ end }}{{- end }}{{color "reset"}}{{end}}
 {{- color .Config.Icons.Question.Format }}{{if not .ShowAnswer}}  {{ .Config.Icons.Question.Text }}{{else}}{{ .Config.Icons.Question.Text }}{{end}}{{color "reset"}}
-{{- color "default"}}{{ .Message }}{{ .FilterMessage }}{{color "reset"}}
+{{- color "default"}}{{ translate .Message }}{{ .FilterMessage }}{{color "reset"}}
 {{- if .ShowAnswer}}{{color "default"}} {{parseAnswers .Answer}}{{color "reset"}}{{"\n"}}
 {{- else }}
-	{{- "  "}}{{- color "white"}}[Use arrows to move, space to select, type to filter{{- if and .Help (not .ShowHelp)}}, {{ .Config.HelpInput }} for more help{{end}}]{{color "reset"}}
+	{{- "  "}}{{- color "white"}}[{{ translate "Use arrows to move, space to select, type to filter" }}{{- if and .Help (not .ShowHelp)}}, {{ .Config.HelpInput }} {{ translate "for more help" }}{{end}}]{{color "reset"}}
   {{- "\n"}}
   {{- range $ix, $option := .PageEntries}}
     {{- if eq $ix $.SelectedIndex }}{{color "default+b" }}  {{ $.Config.Icons.SelectFocus.Text }}{{color "reset"}}{{else}} {{end}}
@@ -94,6 +96,7 @@ func init() {
     {{- color "reset"}}
     {{- " "}}{{$option.Value}}{{"\n"}}
   {{- end}}
+  {{- preview .PageEntries .SelectedIndex}}
 {{- end}}`
 
 	split := func(s string, sep string) []string {
@@ -105,6 +108,11 @@ func init() {
 	core.TemplateFuncsNoColor["split"] = split
 	core.TemplateFuncsNoColor["parseAnswer"] = parseValueFromOptionFmt
 	core.TemplateFuncsNoColor["parseAnswers"] = parseValuesFromOptions
+	core.TemplateFuncsWithColor["preview"] = renderPreview
+	core.TemplateFuncsNoColor["preview"] = renderPreview
+	// Messages are translated when they're displayed, so that the answers files keep matching the English messages.
+	core.TemplateFuncsWithColor["translate"] = i18n.T
+	core.TemplateFuncsNoColor["translate"] = i18n.T
 }
 
 // ErrEmptyOptions indicates the input options list was empty.
@@ -117,8 +125,11 @@ type Prompt func(survey.Prompt, interface{}, ...survey.AskOpt) error
 type ValidatorFunc func(interface{}) error
 
 // New returns a Prompt with default configuration.
+// The prompts are answered from the answers file or with their default values if scripting is enabled.
 func New() Prompt {
-	return survey.AskOne
+	return func(p survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
+		return scripted.ask(survey.AskOne, p, response, opts...)
+	}
 }
 
 type prompter interface {
@@ -130,7 +141,18 @@ type prompter interface {
 
 type prompt struct {
 	prompter
-	FinalMessage string // Text to display after the user selects an answer.
+	FinalMessage string                    // Text to display after the user selects an answer.
+	preview      func(value string) string // Preview of the focused option of a select prompt.
+}
+
+// Prompt displays the prompt, along with the preview of the focused option if there is one.
+func (p *prompt) Prompt(config *survey.PromptConfig) (interface{}, error) {
+	if p.preview != nil {
+		// The question templates are global, so the preview of the prompt being displayed is too.
+		activePreview = p.preview
+		defer func() { activePreview = nil }()
+	}
+	return p.prompter.Prompt(config)
 }
 
 // Cleanup does a final render with the user's chosen value.
@@ -195,7 +217,7 @@ func (pp *passwordPrompt) Cleanup(config *survey.PromptConfig, val interface{})
 	// that behaves as if the question is answered.
 	return pp.Password.Render(`
 {{- color .Config.Icons.Question.Format }}{{ .Config.Icons.Question.Text }}{{color "reset"}}
-{{- color "default"}}{{ .Message }} {{color "reset"}}
+{{- color "default"}}{{ translate .Message }} {{color "reset"}}
 `,
 		survey.PasswordTemplateData{
 			Password: *pp.Password,
@@ -283,6 +305,21 @@ func WithDefaultSelections(options []string) PromptConfig {
 	}
 }
 
+// WithPreview displays the text returned by preview below the options of a select prompt,
+// for the option that has the focus. The preview of each option is computed once, when it first gets the focus.
+func WithPreview(preview func(value string) string) PromptConfig {
+	return func(p *prompt) {
+		previews := make(map[string]string)
+		p.preview = func(value string) string {
+			if text, ok := previews[value]; ok {
+				return text
+			}
+			previews[value] = preview(value)
+			return previews[value]
+		}
+	}
+}
+
 // WithTrueDefault sets the default for a confirm prompt to true.
 func WithTrueDefault() PromptConfig {
 	return func(p *prompt) {
@@ -0,0 +1,270 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package prompt
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/AlecAivazis/survey/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// script answers prompts without user input, so that interactive commands can run in automation.
+type script struct {
+	acceptDefaults bool                   // Answer the prompts that aren't in answers with their default value.
+	answers        map[string]interface{} // Answers keyed by prompt message.
+	recordPath     string                 // File to record the answers to, if set.
+
+	mu       sync.Mutex
+	recorded map[string]interface{}
+}
+
+var scripted = &script{}
+
+// AcceptDefaults answers every prompt without an answer from LoadAnswers with its default value, such as from the --yes flag.
+// Prompts that don't have a default value result in an error instead of waiting for user input.
+func AcceptDefaults() {
+	scripted.acceptDefaults = true
+}
+
+// LoadAnswers reads answers to prompts from a YAML file that maps the message of a prompt to its answer,
+// such as from the --answers flag.
+func LoadAnswers(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read answers file %s: %w", path, err)
+	}
+	var answers map[string]interface{}
+	if err := yaml.Unmarshal(content, &answers); err != nil {
+		return fmt.Errorf("unmarshal answers file %s: %w", path, err)
+	}
+	scripted.answers = make(map[string]interface{}, len(answers))
+	for msg, answer := range answers {
+		scripted.answers[normalizeMessage(msg)] = answer
+	}
+	return nil
+}
+
+// RecordAnswers writes the answers to prompts to a YAML file that can be passed to LoadAnswers, such as from the --record-answers flag.
+// Answers to secret prompts are never recorded.
+func RecordAnswers(path string) {
+	scripted.recordPath = path
+	scripted.recorded = make(map[string]interface{})
+}
+
+// question is the part of a prompt needed to answer it without user input.
+type question struct {
+	kind       string
+	message    string
+	defaultVal interface{}
+	options    []string
+}
+
+const (
+	inputQuestion       = "input"
+	secretQuestion      = "secret"
+	confirmQuestion     = "confirm"
+	selectQuestion      = "select"
+	multiSelectQuestion = "multiselect"
+)
+
+// ask answers p from the script if possible, and otherwise delegates to askOne.
+func (s *script) ask(askOne Prompt, p survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
+	q, ok := newQuestion(p)
+	if !ok {
+		return askOne(p, response, opts...)
+	}
+	answer, ok := s.answers[q.message]
+	var val interface{}
+	var err error
+	switch {
+	case ok:
+		val, err = q.parse(answer)
+		if err != nil {
+			return fmt.Errorf("answer to prompt %q: %w", q.message, err)
+		}
+	case s.acceptDefaults:
+		val, err = q.defaultAnswer()
+		if err != nil {
+			return err
+		}
+	default:
+		if err := askOne(p, response, opts...); err != nil {
+			return err
+		}
+		return s.record(q, response)
+	}
+	if err := q.validate(val, opts...); err != nil {
+		return fmt.Errorf("answer %v to prompt %q: %w", val, q.message, err)
+	}
+	if err := setResponse(response, val); err != nil {
+		return err
+	}
+	return s.record(q, response)
+}
+
+// record writes the answer to the recording file so that the file is complete even if the command fails later.
+func (s *script) record(q question, response interface{}) error {
+	if s.recordPath == "" || q.kind == secretQuestion {
+		return nil
+	}
+	var answer interface{}
+	switch r := response.(type) {
+	case *string:
+		answer = *r
+		if q.kind == selectQuestion {
+			answer = parseValueFromOptionFmt(*r)
+		}
+	case *bool:
+		answer = *r
+	case *[]string:
+		values := make([]string, len(*r))
+		for i, option := range *r {
+			values[i] = parseValueFromOptionFmt(option)
+		}
+		answer = values
+	default:
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.recorded[q.message] = answer
+	content, err := yaml.Marshal(s.recorded)
+	if err != nil {
+		return fmt.Errorf("marshal recorded answers: %w", err)
+	}
+	if err := os.WriteFile(s.recordPath, content, 0644); err != nil {
+		return fmt.Errorf("write recorded answers to %s: %w", s.recordPath, err)
+	}
+	return nil
+}
+
+func newQuestion(p survey.Prompt) (question, bool) {
+	if wrapper, ok := p.(*prompt); ok {
+		p = wrapper.prompter
+	}
+	switch typed := p.(type) {
+	case *survey.Input:
+		return question{kind: inputQuestion, message: normalizeMessage(typed.Message), defaultVal: typed.Default}, true
+	case *passwordPrompt:
+		return question{kind: secretQuestion, message: normalizeMessage(typed.Message)}, true
+	case *survey.Confirm:
+		return question{kind: confirmQuestion, message: normalizeMessage(typed.Message), defaultVal: typed.Default}, true
+	case *survey.Select:
+		return question{kind: selectQuestion, message: normalizeMessage(typed.Message), defaultVal: typed.Default, options: typed.Options}, true
+	case *survey.MultiSelect:
+		return question{kind: multiSelectQuestion, message: normalizeMessage(typed.Message), defaultVal: typed.Default, options: typed.Options}, true
+	}
+	return question{}, false
+}
+
+// parse converts an answer from the answers file to the type of the prompt's response.
+func (q question) parse(answer interface{}) (interface{}, error) {
+	switch q.kind {
+	case confirmQuestion:
+		switch typed := answer.(type) {
+		case bool:
+			return typed, nil
+		case string:
+			switch strings.ToLower(typed) {
+			case "y", "yes":
+				return true, nil
+			case "n", "no":
+				return false, nil
+			}
+			return strconv.ParseBool(typed)
+		}
+		return nil, fmt.Errorf("must be true or false")
+	case selectQuestion:
+		return q.option(fmt.Sprint(answer))
+	case multiSelectQuestion:
+		values, ok := answer.([]interface{})
+		if !ok {
+			values = []interface{}{answer}
+		}
+		selected := make([]string, len(values))
+		for i, value := range values {
+			option, err := q.option(fmt.Sprint(value))
+			if err != nil {
+				return nil, err
+			}
+			selected[i] = option
+		}
+		return selected, nil
+	}
+	return fmt.Sprint(answer), nil
+}
+
+// option returns the option of a select prompt that matches the answer, with or without its hint.
+func (q question) option(answer string) (string, error) {
+	for _, option := range q.options {
+		if option == answer || parseValueFromOptionFmt(option) == answer {
+			return option, nil
+		}
+	}
+	return "", fmt.Errorf("%q is not one of the options", answer)
+}
+
+// defaultAnswer returns the answer selected when the user presses enter.
+func (q question) defaultAnswer() (interface{}, error) {
+	switch q.kind {
+	case confirmQuestion:
+		return q.defaultVal, nil
+	case selectQuestion:
+		return fmt.Sprint(q.defaultVal), nil
+	case multiSelectQuestion:
+		switch typed := q.defaultVal.(type) {
+		case []string:
+			return typed, nil
+		case string:
+			return []string{typed}, nil
+		}
+		return []string{}, nil
+	}
+	if s, ok := q.defaultVal.(string); ok && s != "" {
+		return s, nil
+	}
+	return nil, fmt.Errorf("prompt %q has no default value, provide its answer with a flag or an answers file", q.message)
+}
+
+// validate runs the validators of free-form text prompts against the answer.
+func (q question) validate(val interface{}, opts ...survey.AskOpt) error {
+	if q.kind != inputQuestion {
+		return nil
+	}
+	var options survey.AskOptions
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return err
+		}
+	}
+	for _, validator := range options.Validators {
+		if err := validator(val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setResponse(response, val interface{}) error {
+	switch r := response.(type) {
+	case *string:
+		*r = val.(string)
+	case *bool:
+		*r = val.(bool)
+	case *[]string:
+		*r = val.([]string)
+	default:
+		return fmt.Errorf("unsupported response type %T", response)
+	}
+	return nil
+}
+
+func normalizeMessage(msg string) string {
+	return strings.TrimSpace(regexpSGR.ReplaceAllString(msg, ""))
+}
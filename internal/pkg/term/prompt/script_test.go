@@ -0,0 +1,124 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package prompt
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScript_Ask(t *testing.T) {
+	askUser := func(answer interface{}) Prompt {
+		return func(p survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
+			return setResponse(response, answer)
+		}
+	}
+	failIfAsked := func(t *testing.T) Prompt {
+		return func(p survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
+			require.FailNow(t, "the user should not be prompted")
+			return nil
+		}
+	}
+
+	t.Run("answers free-form text from the answers file", func(t *testing.T) {
+		s := &script{answers: map[string]interface{}{"What's your name?": 42}}
+
+		got, err := Prompt(func(p survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
+			return s.ask(failIfAsked(t), p, response, opts...)
+		}).Get("What's your name?", "", nil)
+
+		require.NoError(t, err)
+		require.Equal(t, "42", got)
+	})
+	t.Run("returns an error if the answer doesn't pass the validator", func(t *testing.T) {
+		s := &script{answers: map[string]interface{}{"What's your name?": "x"}}
+
+		_, err := Prompt(func(p survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
+			return s.ask(failIfAsked(t), p, response, opts...)
+		}).Get("What's your name?", "", func(interface{}) error { return errors.New("too short") })
+
+		require.EqualError(t, err, `answer x to prompt "What's your name?": too short`)
+	})
+	t.Run("selects an option by its value without the hint", func(t *testing.T) {
+		s := &script{answers: map[string]interface{}{"Which type?": "Backend Service"}}
+
+		got, err := Prompt(func(p survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
+			return s.ask(failIfAsked(t), p, response, opts...)
+		}).SelectOption("Which type?", "", []Option{
+			{Value: "Load Balanced Web Service", Hint: "Internet to ECS on Fargate"},
+			{Value: "Backend Service", Hint: "ECS on Fargate"},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "Backend Service", got)
+	})
+	t.Run("returns an error if the answer isn't an option", func(t *testing.T) {
+		s := &script{answers: map[string]interface{}{"Which env?": "prod"}}
+
+		_, err := Prompt(func(p survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
+			return s.ask(failIfAsked(t), p, response, opts...)
+		}).SelectOne("Which env?", "", []string{"test", "staging"})
+
+		require.EqualError(t, err, `answer to prompt "Which env?": "prod" is not one of the options`)
+	})
+	t.Run("confirms with the default value when accepting defaults", func(t *testing.T) {
+		s := &script{acceptDefaults: true}
+
+		got, err := Prompt(func(p survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
+			return s.ask(failIfAsked(t), p, response, opts...)
+		}).Confirm("Deploy?", "", WithTrueDefault())
+
+		require.NoError(t, err)
+		require.True(t, got)
+	})
+	t.Run("returns an error when accepting defaults for a prompt without a default", func(t *testing.T) {
+		s := &script{acceptDefaults: true}
+
+		_, err := Prompt(func(p survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
+			return s.ask(failIfAsked(t), p, response, opts...)
+		}).Get("What's your name?", "", nil)
+
+		require.EqualError(t, err, `prompt "What's your name?" has no default value, provide its answer with a flag or an answers file`)
+	})
+	t.Run("records the answers of the user except secrets", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "answers.yml")
+		s := &script{recordPath: path, recorded: make(map[string]interface{})}
+		ask := func(answer interface{}) Prompt {
+			return func(p survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
+				return s.ask(askUser(answer), p, response, opts...)
+			}
+		}
+
+		_, err := ask("my-app").Get("What's your name?", "", nil)
+		require.NoError(t, err)
+		_, err = ask("hunter2").GetSecret("Password?", "")
+		require.NoError(t, err)
+		_, err = ask([]string{"a", "b"}).MultiSelect("Which ones?", "", []string{"a", "b", "c"}, nil)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, `What's your name?: my-app
+Which ones?:
+    - a
+    - b
+`, string(content))
+	})
+}
+
+func TestLoadAnswers(t *testing.T) {
+	defer func() { scripted = &script{} }()
+	path := filepath.Join(t.TempDir(), "answers.yml")
+	require.NoError(t, os.WriteFile(path, []byte(`"  \x1b[1mDeploy?\x1b[0m ": yes
+`), 0644))
+
+	require.NoError(t, LoadAnswers(path))
+
+	require.Equal(t, map[string]interface{}{"Deploy?": "yes"}, scripted.answers)
+}
@@ -8,8 +8,10 @@ import (
 	"regexp"
 	"strings"
 	"text/tabwriter"
+	"unicode/utf8"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/core"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 )
 
@@ -38,11 +40,18 @@ var (
 )
 var regexpSGR = regexp.MustCompile(sgr)
 
+// previewIndent is the indentation of the preview below the options of a select prompt.
+const previewIndent = "    "
+
+// activePreview computes the preview of the focused option of the select prompt being displayed, if any.
+var activePreview func(value string) string
+
 // Option represents a choice with a hint for clarification.
 type Option struct {
 	Value        string // The actual value represented by the option.
 	FriendlyText string // An optional FriendlyText displayed in place of Value.
 	Hint         string // An optional Hint displayed alongside the Value or FriendlyText.
+	Preview      string // An optional Preview displayed below the options while the option has the focus.
 }
 
 // String implements the fmt.Stringer interface.
@@ -67,6 +76,9 @@ func (p Prompt) SelectOption(message, help string, opts []Option, promptCfgs ...
 	if err != nil {
 		return "", err
 	}
+	if cfg := prettified.previews(); cfg != nil {
+		promptCfgs = append([]PromptConfig{cfg}, promptCfgs...)
+	}
 	result, err := p.SelectOne(message, help, prettified.choices, promptCfgs...)
 	if err != nil {
 		return "", err
@@ -84,6 +96,9 @@ func (p Prompt) MultiSelectOptions(message, help string, opts []Option, promptCf
 	if err != nil {
 		return nil, err
 	}
+	if cfg := prettified.previews(); cfg != nil {
+		promptCfgs = append([]PromptConfig{cfg}, promptCfgs...)
+	}
 	choices, err := p.MultiSelect(message, help, prettified.choices, nil, promptCfgs...)
 	if err != nil {
 		return nil, err
@@ -105,6 +120,7 @@ func (p Prompt) SelectOne(message, help string, options []string, promptCfgs ...
 		Message: message,
 		Options: options,
 		Default: options[0],
+		Filter:  fuzzyFilter,
 	}
 	if help != "" {
 		sel.Help = color.Help(help)
@@ -132,6 +148,7 @@ func (p Prompt) MultiSelect(message, help string, options []string, validator Va
 		Message: message,
 		Options: options,
 		Default: options[0],
+		Filter:  fuzzyFilter,
 	}
 	if help != "" {
 		multiselect.Help = color.Help(help)
@@ -155,8 +172,19 @@ func (p Prompt) MultiSelect(message, help string, options []string, validator Va
 }
 
 type prettyOptions struct {
-	choices      []string
-	choice2Value map[string]string
+	choices        []string
+	choice2Value   map[string]string
+	choice2Preview map[string]string
+}
+
+// previews returns the configuration to display the previews of the options, or nil if none of them has one.
+func (o prettyOptions) previews() PromptConfig {
+	if len(o.choice2Preview) == 0 {
+		return nil
+	}
+	return WithPreview(func(choice string) string {
+		return o.choice2Preview[choice]
+	})
 }
 
 func prettifyOptions(opts []Option) (prettyOptions, error) {
@@ -174,15 +202,51 @@ func prettifyOptions(opts []Option) (prettyOptions, error) {
 	}
 	choices := strings.Split(buf.String(), "\n")
 	choice2Value := make(map[string]string)
+	choice2Preview := make(map[string]string)
 	for idx, choice := range choices {
 		choice2Value[choice] = opts[idx].Value
+		if opts[idx].Preview != "" {
+			choice2Preview[choice] = opts[idx].Preview
+		}
 	}
 	return prettyOptions{
-		choices:      choices,
-		choice2Value: choice2Value,
+		choices:        choices,
+		choice2Value:   choice2Value,
+		choice2Preview: choice2Preview,
 	}, nil
 }
 
+// fuzzyFilter keeps the options that contain the characters of the filter in the same order, ignoring case.
+// For example, "fe" keeps both "frontend" and "feed-api".
+func fuzzyFilter(filter, value string, _ int) bool {
+	value = strings.ToLower(regexpSGR.ReplaceAllString(value, ""))
+	for _, r := range strings.ToLower(filter) {
+		idx := strings.IndexRune(value, r)
+		if idx == -1 {
+			return false
+		}
+		value = value[idx+utf8.RuneLen(r):]
+	}
+	return true
+}
+
+// renderPreview returns the preview of the focused option of the select prompt being displayed,
+// indented below the options, or an empty string if there is none.
+func renderPreview(entries []core.OptionAnswer, selected int) string {
+	if activePreview == nil || selected < 0 || selected >= len(entries) {
+		return ""
+	}
+	preview := strings.TrimRight(activePreview(entries[selected].Value), "\n")
+	if preview == "" {
+		return ""
+	}
+	lines := strings.Split(preview, "\n")
+	for i, line := range lines {
+		lines[i] = previewIndent + line
+	}
+	return "\n" + color.Faint.Sprint(strings.Join(lines, "\n")) + "\n"
+}
+
 func parseValueFromOptionFmt(formatted string) string {
 	if idx := strings.Index(formatted, "("); idx != -1 {
 		s := regexpSGR.ReplaceAllString(formatted[:idx], "")
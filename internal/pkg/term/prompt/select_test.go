@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/core"
 	"github.com/stretchr/testify/require"
 )
 
@@ -346,3 +347,59 @@ func TestPrompt_MultiSelect(t *testing.T) {
 		})
 	}
 }
+
+func TestFuzzyFilter(t *testing.T) {
+	testCases := map[string]struct {
+		filter string
+		value  string
+		wanted bool
+	}{
+		"matches characters in order": {
+			filter: "fe",
+			value:  "frontend",
+			wanted: true,
+		},
+		"ignores case and hints": {
+			filter: "APIte",
+			value:  "api\t\x1b[2m(test)\x1b[0m",
+			wanted: true,
+		},
+		"rejects characters out of order": {
+			filter: "ef",
+			value:  "frontend",
+			wanted: false,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, fuzzyFilter(tc.filter, tc.value, 0))
+		})
+	}
+}
+
+func TestSelectQuestionTemplate_Preview(t *testing.T) {
+	calls := 0
+	p := &prompt{
+		prompter: &survey.Select{Message: "Which service?", Options: []string{"api", "frontend"}},
+	}
+	WithPreview(func(value string) string {
+		calls++
+		return fmt.Sprintf("Type: %s\nEnvironments: test", value)
+	})(p)
+	activePreview = p.preview
+	defer func() { activePreview = nil }()
+	data := survey.SelectTemplateData{
+		Select:        *p.prompter.(*survey.Select),
+		PageEntries:   core.OptionAnswerList([]string{"api", "frontend"}),
+		SelectedIndex: 1,
+		Config:        &survey.PromptConfig{},
+	}
+
+	out, _, err := core.RunTemplate(survey.SelectQuestionTemplate, data)
+	require.NoError(t, err)
+	_, _, err = core.RunTemplate(survey.SelectQuestionTemplate, data)
+	require.NoError(t, err)
+
+	require.Contains(t, out, "    Type: frontend\n    Environments: test\n")
+	require.Equal(t, 1, calls, "the preview of an option is computed once")
+}
@@ -6,6 +6,7 @@ package selector
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 
@@ -42,14 +43,19 @@ func (s *EC2Select) VPC(msg, help string) (string, error) {
 		return "", ErrVPCNotFound
 	}
 	var options []string
+	vpcByOption := make(map[string]ec2.VPC, len(vpcs))
 	for _, vpc := range vpcs {
 		stringifiedVPC := vpc.String()
 		options = append(options, stringifiedVPC)
+		vpcByOption[stringifiedVPC] = vpc
 	}
 	vpc, err := s.prompt.SelectOne(
 		msg, help,
 		options,
-		prompt.WithFinalMessage("VPC:"))
+		prompt.WithFinalMessage("VPC:"),
+		prompt.WithPreview(func(option string) string {
+			return s.vpcPreview(vpcByOption[option])
+		}))
 	if err != nil {
 		return "", fmt.Errorf("select VPC: %w", err)
 	}
@@ -60,6 +66,33 @@ func (s *EC2Select) VPC(msg, help string) (string, error) {
 	return extractedVPC.ID, nil
 }
 
+// vpcPreview describes the CIDR block and the subnets of the VPC.
+func (s *EC2Select) vpcPreview(vpc ec2.VPC) string {
+	var lines []string
+	if vpc.CIDRBlock != "" {
+		lines = append(lines, fmt.Sprintf("CIDR block: %s", vpc.CIDRBlock))
+	}
+	subnets, err := s.ec2Svc.ListVPCSubnets(vpc.ID)
+	if err != nil {
+		return strings.Join(append(lines, "Subnets: unavailable"), "\n")
+	}
+	lines = append(lines,
+		fmt.Sprintf("Public subnets: %s", describeSubnets(subnets.Public)),
+		fmt.Sprintf("Private subnets: %s", describeSubnets(subnets.Private)))
+	return strings.Join(lines, "\n")
+}
+
+func describeSubnets(subnets []ec2.Subnet) string {
+	if len(subnets) == 0 {
+		return "none"
+	}
+	descriptions := make([]string, len(subnets))
+	for i, subnet := range subnets {
+		descriptions[i] = fmt.Sprintf("%s %s", subnet.ID, subnet.CIDRBlock)
+	}
+	return strings.Join(descriptions, ", ")
+}
+
 // SubnetsInput holds the arguments for the subnet selector.
 type SubnetsInput struct {
 	Msg   string
@@ -111,6 +111,28 @@ func TestEc2Select_VPC(t *testing.T) {
 	}
 }
 
+func TestEc2Select_vpcPreview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ec2Svc := mocks.NewMockVPCSubnetLister(ctrl)
+	ec2Svc.EXPECT().ListVPCSubnets("vpc-1").Return(&ec2.VPCSubnets{
+		Public: []ec2.Subnet{
+			{Resource: ec2.Resource{ID: "subnet-1"}, CIDRBlock: "10.0.0.0/24"},
+			{Resource: ec2.Resource{ID: "subnet-2"}, CIDRBlock: "10.0.1.0/24"},
+		},
+	}, nil)
+	sel := NewEC2Select(mocks.NewMockPrompter(ctrl), ec2Svc)
+
+	preview := sel.vpcPreview(ec2.VPC{
+		Resource:  ec2.Resource{ID: "vpc-1"},
+		CIDRBlock: "10.0.0.0/16",
+	})
+
+	require.Equal(t, `CIDR block: 10.0.0.0/16
+Public subnets: subnet-1 10.0.0.0/24, subnet-2 10.0.1.0/24
+Private subnets: none`, preview)
+}
+
 func TestEc2Select_Subnets(t *testing.T) {
 	mockErr := errors.New("some error")
 	mockVPC := "mockVPC"
@@ -275,6 +275,7 @@ type TaskSelector struct {
 	defaultCluster bool
 	taskGroup      string
 	taskID         string
+	allTasks       bool
 }
 
 // NewAppEnvSelector returns a selector that chooses applications or environments.
@@ -388,6 +389,14 @@ func WithTaskID(id string) TaskOpts {
 	}
 }
 
+// WithAllTasks lists every running task in the cluster, such as the tasks of services,
+// instead of only the one-off tasks run by Copilot.
+func WithAllTasks() TaskOpts {
+	return func(in *TaskSelector) {
+		in.allTasks = true
+	}
+}
+
 // RunningTask has the user select a running task. Callers can provide either app and env names,
 // or use default cluster.
 func (s *TaskSelector) RunningTask(msg, help string, opts ...TaskOpts) (*awsecs.Task, error) {
@@ -399,7 +408,7 @@ func (s *TaskSelector) RunningTask(msg, help string, opts ...TaskOpts) (*awsecs.
 	filter := ecs.ListTasksFilter{
 		TaskGroup:   s.taskGroup,
 		TaskID:      s.taskID,
-		CopilotOnly: true,
+		CopilotOnly: !s.allTasks,
 	}
 	if s.defaultCluster {
 		tasks, err = s.lister.ListActiveDefaultClusterTasks(filter)
@@ -707,9 +716,11 @@ func (s *DeploySelector) deployedWorkload(workloadType string, msg, help string,
 
 	wkldEnvNames := make([]string, len(wkldEnvs))
 	wkldEnvNameMap := map[string]*DeployedWorkload{}
+	deployedEnvs := map[string][]string{}
 	for i, svc := range wkldEnvs {
 		wkldEnvNames[i] = svc.String()
 		wkldEnvNameMap[wkldEnvNames[i]] = svc
+		deployedEnvs[svc.Name] = append(deployedEnvs[svc.Name], svc.Env)
 	}
 
 	wkldEnvName, err := s.prompt.SelectOne(
@@ -717,6 +728,13 @@ func (s *DeploySelector) deployedWorkload(workloadType string, msg, help string,
 		help,
 		wkldEnvNames,
 		prompt.WithFinalMessage(finalMessage),
+		prompt.WithPreview(func(option string) string {
+			wkld := wkldEnvNameMap[option]
+			if wkld == nil {
+				return ""
+			}
+			return fmt.Sprintf("Type: %s\nDeployed in: %s", wkld.Type, strings.Join(deployedEnvs[wkld.Name], ", "))
+		}),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("select deployed %ss for application %s: %w", workloadType, app, err)
@@ -856,6 +874,49 @@ func (s *LocalEnvironmentSelector) LocalEnvironment(msg, help string) (wl string
 	return selectedEnvName, nil
 }
 
+// Service fetches all services in the workspace and then prompts the user to select one.
+// Unlike LocalWorkloadSelector, it does not filter out services that are not in the config store.
+func (s *WorkspaceSelector) Service(msg, help string) (string, error) {
+	names, err := s.ws.ListServices()
+	if err != nil {
+		return "", fmt.Errorf("retrieve services from workspace: %w", err)
+	}
+	return s.selectOne(msg, help, "service", names, svcNameFinalMsg)
+}
+
+// Job fetches all jobs in the workspace and then prompts the user to select one.
+func (s *WorkspaceSelector) Job(msg, help string) (string, error) {
+	names, err := s.ws.ListJobs()
+	if err != nil {
+		return "", fmt.Errorf("retrieve jobs from workspace: %w", err)
+	}
+	return s.selectOne(msg, help, "job", names, jobNameFinalMsg)
+}
+
+// Environment fetches all environments in the workspace and then prompts the user to select one.
+func (s *WorkspaceSelector) Environment(msg, help string) (string, error) {
+	names, err := s.ws.ListEnvironments()
+	if err != nil {
+		return "", fmt.Errorf("retrieve environments from workspace: %w", err)
+	}
+	return s.selectOne(msg, help, "environment", names, envNameFinalMessage)
+}
+
+func (s *WorkspaceSelector) selectOne(msg, help, kind string, names []string, finalMsg string) (string, error) {
+	if len(names) == 0 {
+		return "", fmt.Errorf("no %ss found in the workspace", kind)
+	}
+	if len(names) == 1 {
+		log.Infof("Only found one %s, defaulting to: %s\n", kind, color.HighlightUserInput(names[0]))
+		return names[0], nil
+	}
+	selected, err := s.prompt.SelectOne(msg, help, names, prompt.WithFinalMessage(finalMsg))
+	if err != nil {
+		return "", fmt.Errorf("select %s: %w", kind, err)
+	}
+	return selected, nil
+}
+
 func filterEnvsByName(envs []*config.Environment, wantedNames []string) []string {
 	// TODO: refactor this and `filterWlsByName`  when generic supports using common struct fields: https://github.com/golang/go/issues/48522
 	isWanted := make(map[string]bool)
@@ -957,7 +1018,7 @@ func (s *ConfigSelector) Service(msg, help, app string) (string, error) {
 		log.Infof("Only found one service, defaulting to: %s\n", color.HighlightUserInput(services[0]))
 		return services[0], nil
 	}
-	selectedSvcName, err := s.prompt.SelectOne(msg, help, services, prompt.WithFinalMessage(svcNameFinalMsg))
+	selectedSvcName, err := s.prompt.SelectOne(msg, help, services, prompt.WithFinalMessage(svcNameFinalMsg), s.workloadTypePreview(app))
 	if err != nil {
 		return "", fmt.Errorf("select service: %w", err)
 	}
@@ -977,7 +1038,7 @@ func (s *ConfigSelector) Job(msg, help, app string) (string, error) {
 		log.Infof("Only found one job, defaulting to: %s\n", color.HighlightUserInput(jobs[0]))
 		return jobs[0], nil
 	}
-	selectedJobName, err := s.prompt.SelectOne(msg, help, jobs, prompt.WithFinalMessage(jobNameFinalMsg))
+	selectedJobName, err := s.prompt.SelectOne(msg, help, jobs, prompt.WithFinalMessage(jobNameFinalMsg), s.workloadTypePreview(app))
 	if err != nil {
 		return "", fmt.Errorf("select job: %w", err)
 	}
@@ -1003,7 +1064,7 @@ func (s *ConfigSelector) Workload(msg, help, app string) (string, error) {
 		log.Infof("Only found one workload, defaulting to: %s\n", color.HighlightUserInput(workloads[0]))
 		return workloads[0], nil
 	}
-	selectedWorkloadName, err := s.prompt.SelectOne(msg, help, workloads, prompt.WithFinalMessage("Workload name:"))
+	selectedWorkloadName, err := s.prompt.SelectOne(msg, help, workloads, prompt.WithFinalMessage("Workload name:"), s.workloadTypePreview(app))
 	if err != nil {
 		return "", fmt.Errorf("select workload: %w", err)
 	}
@@ -1196,6 +1257,28 @@ func (s *AppEnvSelector) retrieveEnvironments(app string) ([]string, error) {
 	return envsNames, nil
 }
 
+// workloadTypePreview returns the configuration to preview the type of the focused workload of the application.
+// The workloads are only listed once a workload gets the focus.
+func (s *ConfigSelector) workloadTypePreview(app string) prompt.PromptConfig {
+	var types map[string]string
+	return prompt.WithPreview(func(name string) string {
+		if types == nil {
+			types = make(map[string]string)
+			wklds, err := s.workloadLister.ListWorkloads(app)
+			if err != nil {
+				return ""
+			}
+			for _, wkld := range wklds {
+				types[wkld.Name] = wkld.Type
+			}
+		}
+		if wkldType, ok := types[name]; ok {
+			return fmt.Sprintf("Type: %s", wkldType)
+		}
+		return ""
+	})
+}
+
 func (s *ConfigSelector) retrieveServices(app string) ([]string, error) {
 	services, err := s.workloadLister.ListServices(app)
 	if err != nil {
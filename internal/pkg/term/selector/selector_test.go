@@ -1626,6 +1626,117 @@ func TestWorkspaceSelect_EnvironmentsInWorkspace(t *testing.T) {
 	}
 }
 
+func TestWorkspaceSelector_Service(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(mocks workspaceSelectMocks)
+		wantErr    error
+		want       string
+	}{
+		"fail to list services in workspace": {
+			setupMocks: func(m workspaceSelectMocks) {
+				m.ws.EXPECT().ListServices().Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("retrieve services from workspace: some error"),
+		},
+		"fail if there are no services in workspace": {
+			setupMocks: func(m workspaceSelectMocks) {
+				m.ws.EXPECT().ListServices().Return([]string{}, nil)
+			},
+			wantErr: errors.New("no services found in the workspace"),
+		},
+		"default to the only service in workspace without prompting": {
+			setupMocks: func(m workspaceSelectMocks) {
+				m.ws.EXPECT().ListServices().Return([]string{"mockSvc"}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			want: "mockSvc",
+		},
+		"fail to select a service": {
+			setupMocks: func(m workspaceSelectMocks) {
+				m.ws.EXPECT().ListServices().Return([]string{"mockSvc1", "mockSvc2"}, nil)
+				m.prompt.EXPECT().SelectOne(gomock.Any(), gomock.Any(), []string{"mockSvc1", "mockSvc2"}, gomock.Any()).
+					Return("", errors.New("some error"))
+			},
+			wantErr: errors.New("select service: some error"),
+		},
+		"select a service from workspace even if it is not in the store": {
+			setupMocks: func(m workspaceSelectMocks) {
+				m.ws.EXPECT().ListServices().Return([]string{"mockSvc1", "mockSvc2"}, nil)
+				m.prompt.EXPECT().SelectOne("Select a service", "Help text", []string{"mockSvc1", "mockSvc2"}, gomock.Any()).
+					Return("mockSvc2", nil)
+			},
+			want: "mockSvc2",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := workspaceSelectMocks{
+				ws:     mocks.NewMockworkspaceRetriever(ctrl),
+				prompt: mocks.NewMockPrompter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			sel := NewWorkspaceSelector(m.prompt, m.ws)
+			got, err := sel.Service("Select a service", "Help text")
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestWorkspaceSelector_Environment(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(mocks workspaceSelectMocks)
+		wantErr    error
+		want       string
+	}{
+		"fail to list environments in workspace": {
+			setupMocks: func(m workspaceSelectMocks) {
+				m.ws.EXPECT().ListEnvironments().Return(nil, errors.New("some error"))
+			},
+			wantErr: errors.New("retrieve environments from workspace: some error"),
+		},
+		"select an environment from workspace": {
+			setupMocks: func(m workspaceSelectMocks) {
+				m.ws.EXPECT().ListEnvironments().Return([]string{"mockEnv1", "mockEnv2"}, nil)
+				m.prompt.EXPECT().SelectOne("Select an environment", "Help text", []string{"mockEnv1", "mockEnv2"}, gomock.Any()).
+					Return("mockEnv1", nil)
+			},
+			want: "mockEnv1",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := workspaceSelectMocks{
+				ws:     mocks.NewMockworkspaceRetriever(ctrl),
+				prompt: mocks.NewMockPrompter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			sel := NewWorkspaceSelector(m.prompt, m.ws)
+			got, err := sel.Environment("Select an environment", "Help text")
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
+
 type configSelectMocks struct {
 	workloadLister *mocks.MockconfigLister
 	prompt         *mocks.MockPrompter
@@ -2851,6 +2962,7 @@ func TestTaskSelect_Task(t *testing.T) {
 		app        string
 		env        string
 		useDefault bool
+		allTasks   bool
 
 		wantErr  error
 		wantTask *awsecs.Task
@@ -2922,6 +3034,18 @@ func TestTaskSelect_Task(t *testing.T) {
 			},
 			wantTask: mockTask1,
 		},
+		"list the tasks of services too": {
+			app:      mockApp,
+			env:      mockEnv,
+			allTasks: true,
+			setupMocks: func(m taskSelectMocks) {
+				m.taskLister.EXPECT().ListActiveAppEnvTasks(ecs.ListActiveAppEnvTasksOpts{
+					App: mockApp,
+					Env: mockEnv,
+				}).Return([]*awsecs.Task{mockTask1}, nil)
+			},
+			wantTask: mockTask1,
+		},
 		"success": {
 			app: mockApp,
 			env: mockEnv,
@@ -2959,15 +3083,14 @@ func TestTaskSelect_Task(t *testing.T) {
 				lister: mocktaskLister,
 				prompt: mockprompt,
 			}
-			var gotTask *awsecs.Task
-			var err error
+			opts := []TaskOpts{WithAppEnv(tc.app, tc.env)}
 			if tc.useDefault {
-				gotTask, err = sel.RunningTask(mockPromptText, mockHelpText,
-					WithAppEnv(tc.app, tc.env), WithDefault())
-			} else {
-				gotTask, err = sel.RunningTask(mockPromptText, mockHelpText,
-					WithAppEnv(tc.app, tc.env))
+				opts = append(opts, WithDefault())
+			}
+			if tc.allTasks {
+				opts = append(opts, WithAllTasks())
 			}
+			gotTask, err := sel.RunningTask(mockPromptText, mockHelpText, opts...)
 			if tc.wantErr != nil {
 				require.EqualError(t, err, tc.wantErr.Error())
 			} else {
@@ -0,0 +1,191 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ExtendsKey is the field of a workload manifest with the path to the manifest that it extends.
+	ExtendsKey = "extends"
+
+	wkldEnvOverridesDirName  = "environments"
+	wkldEnvOverridesFileName = "overrides.yml"
+)
+
+// composeWorkloadManifest returns the manifest of a workload with the manifests that it extends and
+// the override files of its environments merged in, in increasing order of precedence:
+//  1. The manifest named by "extends", recursively.
+//  2. The manifest file of the workload.
+//  3. The copilot/{name}/environments/{env}/overrides.yml files, merged into the "environments.{env}" field.
+//
+// The manifest is returned unchanged if it doesn't extend another manifest and has no override files.
+func (ws *Workspace) composeWorkloadManifest(mftDirName string, raw []byte) ([]byte, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(raw, &root); err != nil {
+		// Leave the error to the code that parses the manifest.
+		return raw, nil
+	}
+	envOverrides, err := ws.listWorkloadEnvOverrides(mftDirName)
+	if err != nil {
+		return nil, err
+	}
+	doc := mappingDocument(&root)
+	if doc == nil || (mappingValue(doc, ExtendsKey) == nil && len(envOverrides) == 0) {
+		return raw, nil
+	}
+	mftPath := filepath.Join(ws.CopilotDirAbs, mftDirName, manifestFileName)
+	composed, err := ws.resolveExtends(mftPath, doc, map[string]bool{mftPath: true})
+	if err != nil {
+		return nil, err
+	}
+	for _, env := range envOverrides {
+		path := filepath.Join(ws.CopilotDirAbs, mftDirName, wkldEnvOverridesDirName, env, wkldEnvOverridesFileName)
+		override, err := ws.readYAMLMapping(path)
+		if err != nil {
+			return nil, err
+		}
+		composed = setEnvOverride(composed, env, override)
+	}
+	var out bytes.Buffer
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+	if err := enc.Encode(composed); err != nil {
+		return nil, fmt.Errorf("marshal manifest of %s: %w", mftDirName, err)
+	}
+	return out.Bytes(), nil
+}
+
+// resolveExtends merges the manifest at path on top of the manifests that it extends.
+// visited holds the paths of the manifests of the chain, to detect cycles.
+func (ws *Workspace) resolveExtends(path string, doc *yaml.Node, visited map[string]bool) (*yaml.Node, error) {
+	ext := mappingValue(doc, ExtendsKey)
+	if ext == nil {
+		return doc, nil
+	}
+	if ext.Kind != yaml.ScalarNode || ext.Value == "" {
+		return nil, fmt.Errorf(`"%s" in manifest %s must be the path to a manifest file`, ExtendsKey, path)
+	}
+	basePath := ext.Value
+	if !filepath.IsAbs(basePath) {
+		basePath = filepath.Join(filepath.Dir(path), basePath)
+	}
+	if visited[basePath] {
+		return nil, fmt.Errorf("manifest %s extends itself through %s", basePath, path)
+	}
+	visited[basePath] = true
+	base, err := ws.readYAMLMapping(basePath)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest extended by %s: %w", path, err)
+	}
+	base, err = ws.resolveExtends(basePath, base, visited)
+	if err != nil {
+		return nil, err
+	}
+	child := *doc
+	child.Content = nil
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		if doc.Content[i].Value != ExtendsKey {
+			child.Content = append(child.Content, doc.Content[i], doc.Content[i+1])
+		}
+	}
+	return manifest.MergeYAML(base, &child), nil
+}
+
+// listWorkloadEnvOverrides returns the sorted names of the environments that have an override file for the workload.
+func (ws *Workspace) listWorkloadEnvOverrides(mftDirName string) ([]string, error) {
+	dir := filepath.Join(ws.CopilotDirAbs, mftDirName, wkldEnvOverridesDirName)
+	if exists, _ := ws.fs.DirExists(dir); !exists {
+		return nil, nil
+	}
+	entries, err := ws.fs.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read directory %s: %w", dir, err)
+	}
+	var envs []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if exists, _ := ws.fs.Exists(filepath.Join(dir, entry.Name(), wkldEnvOverridesFileName)); exists {
+			envs = append(envs, entry.Name())
+		}
+	}
+	sort.Strings(envs)
+	return envs, nil
+}
+
+func (ws *Workspace) readYAMLMapping(path string) (*yaml.Node, error) {
+	exists, err := ws.fs.Exists(path)
+	if err != nil {
+		return nil, fmt.Errorf("check if file %s exists: %w", path, err)
+	}
+	if !exists {
+		return nil, &ErrFileNotExists{FileName: path}
+	}
+	data, err := ws.fs.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read file %s: %w", path, err)
+	}
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", path, err)
+	}
+	doc := mappingDocument(&root)
+	if doc == nil {
+		return nil, fmt.Errorf("%s must be a YAML mapping", path)
+	}
+	return doc, nil
+}
+
+// setEnvOverride returns the manifest with the override merged into its "environments.{env}" field.
+func setEnvOverride(doc *yaml.Node, env string, override *yaml.Node) *yaml.Node {
+	patch := &yaml.Node{
+		Kind: yaml.MappingNode,
+		Tag:  "!!map",
+		Content: []*yaml.Node{
+			{Kind: yaml.ScalarNode, Tag: "!!str", Value: "environments"},
+			{
+				Kind: yaml.MappingNode,
+				Tag:  "!!map",
+				Content: []*yaml.Node{
+					{Kind: yaml.ScalarNode, Tag: "!!str", Value: env},
+					override,
+				},
+			},
+		},
+	}
+	return manifest.MergeYAML(doc, patch)
+}
+
+// mappingDocument returns the mapping at the root of a YAML document, or nil if the document isn't a mapping.
+func mappingDocument(root *yaml.Node) *yaml.Node {
+	node := root
+	if node.Kind == yaml.DocumentNode {
+		if len(node.Content) == 0 {
+			return nil
+		}
+		node = node.Content[0]
+	}
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	return node
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
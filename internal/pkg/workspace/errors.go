@@ -103,3 +103,22 @@ func IsEmptyErr(err error) bool {
 	}
 	return errors.As(err, &emptyWs)
 }
+
+// ErrWorkspaceNotLocated means that a workspace name didn't match any directory or any workspace of the index file.
+type ErrWorkspaceNotLocated struct {
+	name  string
+	index string // Path to the index file, empty if there is none.
+}
+
+func (e *ErrWorkspaceNotLocated) Error() string {
+	if e.index == "" {
+		return fmt.Sprintf("couldn't find workspace %q: it must be a directory that contains a %s directory, or a workspace listed in a %s file",
+			e.name, CopilotDirName, IndexFileName)
+	}
+	return fmt.Sprintf("couldn't find workspace %q in %s", e.name, e.index)
+}
+
+// RecommendActions suggests steps clients can take to find the workspaces of the index file.
+func (e *ErrWorkspaceNotLocated) RecommendActions() string {
+	return fmt.Sprintf("Run %s to list the workspaces.", color.HighlightCode("copilot app ls --local"))
+}
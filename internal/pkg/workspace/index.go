@@ -0,0 +1,202 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+// IndexFileName is the name of the file at the root of a monorepo that lists the directories of its workspaces.
+const IndexFileName = "copilot-workspaces.yml"
+
+// Monorepos tend to nest workspaces deeper than a single application's repository.
+const maximumParentDirsToSearchIndex = 10
+
+// Index lists the workspaces of a monorepo.
+//
+//	workspaces:
+//	  - payments          (directory that contains a copilot directory)
+//	  - platform/*        (glob pattern of directories that contain a copilot directory)
+type Index struct {
+	Workspaces []string `yaml:"workspaces"` // Directories or glob patterns, relative to the index file.
+	Path       string   `yaml:"-"`          // Absolute path to the index file.
+}
+
+// Local is a workspace found on the local file system.
+type Local struct {
+	Application string // Name of the application associated with the workspace.
+	Dir         string // Absolute path to the directory that contains the copilot directory.
+}
+
+// FindIndex searches for the index file from the current working directory, up to 10 levels above.
+// It returns an ErrTargetNotFound error if there is no index file.
+func FindIndex(fs afero.Fs) (*Index, error) {
+	wd, err := getWd()
+	if err != nil {
+		return nil, fmt.Errorf("get working directory: %w", err)
+	}
+	afs := &afero.Afero{Fs: fs}
+	path, err := TraverseUp(wd, maximumParentDirsToSearchIndex, func(dir string) (string, error) {
+		path := filepath.Join(dir, IndexFileName)
+		exists, err := afs.Exists(path)
+		if err != nil {
+			return "", err
+		}
+		if exists {
+			return path, ErrTraverseUpShouldStop
+		}
+		return "", nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	raw, err := afs.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	index := &Index{
+		Path: path,
+	}
+	if err := yaml.Unmarshal(raw, index); err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", path, err)
+	}
+	return index, nil
+}
+
+// Locals returns the workspaces listed in the index, sorted by directory.
+// Directories matched by a glob pattern are skipped if they don't contain a workspace,
+// whereas directories listed explicitly must contain one.
+func (idx *Index) Locals(fs afero.Fs) ([]Local, error) {
+	root := filepath.Dir(idx.Path)
+	seen := make(map[string]bool)
+	var locals []Local
+	for _, entry := range idx.Workspaces {
+		pattern := filepath.Join(root, entry)
+		isGlob := strings.ContainsAny(entry, "*?[")
+		dirs := []string{pattern}
+		if isGlob {
+			matches, err := afero.Glob(fs, pattern)
+			if err != nil {
+				return nil, fmt.Errorf("match workspaces %q in %s: %w", entry, idx.Path, err)
+			}
+			dirs = matches
+		}
+		for _, dir := range dirs {
+			if seen[dir] {
+				continue
+			}
+			app, err := readApplication(fs, dir)
+			var errNoApp *ErrNoAssociatedApplication
+			if isGlob && errors.As(err, &errNoApp) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("read workspace %q in %s: %w", entry, idx.Path, err)
+			}
+			seen[dir] = true
+			locals = append(locals, Local{
+				Application: app,
+				Dir:         dir,
+			})
+		}
+	}
+	sort.Slice(locals, func(i, j int) bool {
+		return locals[i].Dir < locals[j].Dir
+	})
+	return locals, nil
+}
+
+// List returns the workspaces listed in the index file.
+// If there is no index file, it returns the workspace of the current working directory, if any.
+func List(fs afero.Fs) ([]Local, error) {
+	index, err := FindIndex(fs)
+	if err == nil {
+		return index.Locals(fs)
+	}
+	var errNotFound *ErrTargetNotFound
+	if !errors.As(err, &errNotFound) {
+		return nil, err
+	}
+	ws, err := Use(fs)
+	if err != nil {
+		var errNoWS *ErrWorkspaceNotFound
+		if errors.As(err, &errNoWS) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	summary, _ := ws.Summary() // Use already verified that the summary can be read.
+	return []Local{
+		{
+			Application: summary.Application,
+			Dir:         ws.ProjectRoot(),
+		},
+	}, nil
+}
+
+// Locate returns the absolute path to the directory of the workspace named name.
+// The name is either a path to a directory that contains a copilot directory,
+// or the application or directory of a workspace listed in the index file.
+func Locate(fs afero.Fs, name string) (string, error) {
+	wd, err := getWd()
+	if err != nil {
+		return "", fmt.Errorf("get working directory: %w", err)
+	}
+	dir := name
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(wd, dir)
+	}
+	if exists, _ := afero.DirExists(fs, filepath.Join(dir, CopilotDirName)); exists {
+		return dir, nil
+	}
+	index, err := FindIndex(fs)
+	if err != nil {
+		var errNotFound *ErrTargetNotFound
+		if errors.As(err, &errNotFound) {
+			return "", &ErrWorkspaceNotLocated{name: name}
+		}
+		return "", err
+	}
+	locals, err := index.Locals(fs)
+	if err != nil {
+		return "", err
+	}
+	root := filepath.Dir(index.Path)
+	var matches []string
+	for _, local := range locals {
+		if local.Application == name || local.Dir == filepath.Join(root, name) {
+			matches = append(matches, local.Dir)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", &ErrWorkspaceNotLocated{name: name, index: index.Path}
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("application %s has %d workspaces in %s: use the path to one of them instead", name, len(matches), index.Path)
+	}
+}
+
+func readApplication(fs afero.Fs, dir string) (string, error) {
+	raw, err := afero.ReadFile(fs, filepath.Join(dir, CopilotDirName, SummaryFileName))
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return "", &ErrNoAssociatedApplication{}
+		}
+		return "", err
+	}
+	var summary Summary
+	if err := yaml.Unmarshal(raw, &summary); err != nil {
+		return "", fmt.Errorf("unmarshal workspace summary: %w", err)
+	}
+	return summary.Application, nil
+}
@@ -0,0 +1,132 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func monorepoFS(t *testing.T, index string) afero.Fs {
+	fs := afero.NewMemMapFs()
+	files := map[string]string{
+		"/monorepo/payments/copilot/.workspace":          "application: payments\n",
+		"/monorepo/platform/identity/copilot/.workspace": "application: identity\n",
+		"/monorepo/platform/gateway/copilot/.workspace":  "application: gateway\n",
+		"/monorepo/platform/docs/README.md":              "# Docs\n",
+	}
+	if index != "" {
+		files["/monorepo/"+IndexFileName] = index
+	}
+	for path, content := range files {
+		require.NoError(t, afero.WriteFile(fs, path, []byte(content), 0644))
+	}
+	return fs
+}
+
+func TestList(t *testing.T) {
+	testCases := map[string]struct {
+		inIndex string
+		inWd    string
+
+		wanted      []Local
+		wantedError error
+	}{
+		"lists the workspaces of the index from a subdirectory": {
+			inIndex: "workspaces:\n  - payments\n  - platform/*\n",
+			inWd:    "/monorepo/payments/src",
+			wanted: []Local{
+				{Application: "payments", Dir: "/monorepo/payments"},
+				{Application: "gateway", Dir: "/monorepo/platform/gateway"},
+				{Application: "identity", Dir: "/monorepo/platform/identity"},
+			},
+		},
+		"errors if a directory listed explicitly isn't a workspace": {
+			inIndex:     "workspaces:\n  - platform/docs\n",
+			inWd:        "/monorepo",
+			wantedError: errors.New(`read workspace "platform/docs" in /monorepo/copilot-workspaces.yml: couldn't find an application associated with this workspace`),
+		},
+		"falls back to the current workspace without an index": {
+			inWd: "/monorepo/platform/identity",
+			wanted: []Local{
+				{Application: "identity", Dir: "/monorepo/platform/identity"},
+			},
+		},
+		"returns nothing outside of a workspace without an index": {
+			inWd: "/monorepo/platform",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defer func() { getWd = os.Getwd }()
+			getWd = func() (string, error) {
+				return tc.inWd, nil
+			}
+
+			got, err := List(monorepoFS(t, tc.inIndex))
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestLocate(t *testing.T) {
+	testCases := map[string]struct {
+		inIndex string
+		inName  string
+
+		wanted      string
+		wantedError error
+	}{
+		"locates a directory that contains a copilot directory": {
+			inName: "payments",
+			wanted: "/monorepo/payments",
+		},
+		"locates a workspace of the index by application": {
+			inIndex: "workspaces:\n  - payments\n  - platform/*\n",
+			inName:  "identity",
+			wanted:  "/monorepo/platform/identity",
+		},
+		"locates a workspace of the index by directory": {
+			inIndex: "workspaces:\n  - platform/*\n",
+			inName:  "platform/gateway",
+			wanted:  "/monorepo/platform/gateway",
+		},
+		"errors if the application isn't in the index": {
+			inIndex:     "workspaces:\n  - platform/*\n",
+			inName:      "billing",
+			wantedError: errors.New(`couldn't find workspace "billing" in /monorepo/copilot-workspaces.yml`),
+		},
+		"errors if there is no index": {
+			inName:      "identity",
+			wantedError: errors.New(`couldn't find workspace "identity": it must be a directory that contains a copilot directory, or a workspace listed in a copilot-workspaces.yml file`),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			defer func() { getWd = os.Getwd }()
+			getWd = func() (string, error) {
+				return "/monorepo", nil
+			}
+
+			got, err := Locate(monorepoFS(t, tc.inIndex), tc.inName)
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -55,6 +55,9 @@ const (
 	legacyPipelineFileName    = "pipeline.yml"
 	manifestFileName          = "manifest.yml"
 	buildspecFileName         = "buildspec.yml"
+
+	githubWorkflowsDirName = ".github/workflows"
+	fmtGitHubWorkflowFile  = "copilot-%s.yml"
 )
 
 // ErrTraverseUpShouldStop signals that TraverseUp should stop.
@@ -89,8 +92,17 @@ func TraverseUp(startDir string, maxLevels int, process TraverseUpProcessFn) (st
 
 // Summary is a description of what's associated with this workspace.
 type Summary struct {
-	Application string `yaml:"application"` // Name of the application.
-	Path        string `yaml:"-"`           // Absolute path to the summary file.
+	Application  string                            `yaml:"application"`            // Name of the application.
+	Hooks        map[string][]string               `yaml:"hooks,omitempty"`        // Commands to run for each event of the lifecycle of a deployment.
+	Environments map[string]EnvironmentCredentials `yaml:"environments,omitempty"` // Credentials to manage each environment with.
+	Path         string                            `yaml:"-"`                      // Absolute path to the summary file.
+}
+
+// EnvironmentCredentials is where the credentials to assume the manager role of an environment come from.
+// The default credentials are used if neither field is set.
+type EnvironmentCredentials struct {
+	Profile string `yaml:"profile,omitempty"` // Named AWS profile, such as a profile that logs in with SSO.
+	Role    string `yaml:"role,omitempty"`    // ARN of a role to assume with the default credentials first.
 }
 
 // Workspace typically represents a Git repository where the user has its infrastructure-as-code files as well as source files.
@@ -116,6 +128,19 @@ func Use(fs afero.Fs) (*Workspace, error) {
 	if err != nil {
 		return nil, fmt.Errorf("get working directory: %w", err)
 	}
+	return use(fs, workingDirAbs)
+}
+
+// UseDir returns an existing workspace like Use, but searches for the copilot/ directory from dir instead of the current wd.
+func UseDir(fs afero.Fs, dir string) (*Workspace, error) {
+	workingDirAbs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("get absolute path of %s: %w", dir, err)
+	}
+	return use(fs, workingDirAbs)
+}
+
+func use(fs afero.Fs, workingDirAbs string) (*Workspace, error) {
 	ws := &Workspace{
 		workingDirAbs: workingDirAbs,
 		fs:            &afero.Afero{Fs: fs},
@@ -382,7 +407,11 @@ func (ws *Workspace) ReadWorkloadManifest(mftDirName string) (WorkloadManifest,
 	if err != nil {
 		return nil, err
 	}
-	mft := WorkloadManifest(raw)
+	composed, err := ws.composeWorkloadManifest(mftDirName, raw)
+	if err != nil {
+		return nil, err
+	}
+	mft := WorkloadManifest(composed)
 	if err := ws.manifestNameMatchWithDir(mft, mftDirName); err != nil {
 		return nil, err
 	}
@@ -438,6 +467,42 @@ func (ws *Workspace) WriteServiceManifest(marshaler encoding.BinaryMarshaler, na
 	return ws.write(data, name, manifestFileName)
 }
 
+// EditWorkloadManifest replaces the workload's manifest under copilot/{name}/manifest.yml with the content returned by edit.
+// The content passed to edit is the manifest file as written, before it is composed.
+func (ws *Workspace) EditWorkloadManifest(name string, edit func(raw []byte) ([]byte, error)) (string, error) {
+	raw, err := ws.read(name, manifestFileName)
+	if err != nil {
+		return "", err
+	}
+	edited, err := edit(raw)
+	if err != nil {
+		return "", fmt.Errorf("edit manifest of %s: %w", name, err)
+	}
+	filename := filepath.Join(ws.CopilotDirAbs, name, manifestFileName)
+	if err := ws.fs.WriteFile(filename, edited, 0644 /* -rw-r--r-- */); err != nil {
+		return "", fmt.Errorf("write manifest file: %w", err)
+	}
+	return filename, nil
+}
+
+// EditWorkloadEnvOverrides replaces the override file of an environment under copilot/{name}/environments/{env}/overrides.yml
+// with the content returned by edit. It returns an ErrFileNotExists error if the workload has no override file for the environment.
+func (ws *Workspace) EditWorkloadEnvOverrides(name, env string, edit func(raw []byte) ([]byte, error)) (string, error) {
+	raw, err := ws.read(name, wkldEnvOverridesDirName, env, wkldEnvOverridesFileName)
+	if err != nil {
+		return "", err
+	}
+	edited, err := edit(raw)
+	if err != nil {
+		return "", fmt.Errorf("edit overrides of %s for environment %s: %w", name, env, err)
+	}
+	filename := filepath.Join(ws.CopilotDirAbs, name, wkldEnvOverridesDirName, env, wkldEnvOverridesFileName)
+	if err := ws.fs.WriteFile(filename, edited, 0644 /* -rw-r--r-- */); err != nil {
+		return "", fmt.Errorf("write overrides file: %w", err)
+	}
+	return filename, nil
+}
+
 // WriteJobManifest writes the job's manifest under the copilot/{name}/ directory.
 func (ws *Workspace) WriteJobManifest(marshaler encoding.BinaryMarshaler, name string) (string, error) {
 	data, err := marshaler.MarshalBinary()
@@ -467,6 +532,17 @@ func (ws *Workspace) WritePipelineManifest(marshaler encoding.BinaryMarshaler, n
 	return ws.write(data, pipelinesDirName, name, manifestFileName)
 }
 
+// WriteGitHubWorkflow writes the GitHub Actions workflow of a pipeline under the .github/workflows/ directory of the project root.
+// If successful returns the full path of the file, otherwise returns an empty string and the error.
+func (ws *Workspace) WriteGitHubWorkflow(marshaler encoding.BinaryMarshaler, name string) (string, error) {
+	data, err := marshaler.MarshalBinary()
+	if err != nil {
+		return "", fmt.Errorf("marshal GitHub Actions workflow to binary: %w", err)
+	}
+	// The workflow must be under the project root instead of the copilot directory for GitHub to pick it up.
+	return ws.write(data, "..", filepath.FromSlash(githubWorkflowsDirName), fmt.Sprintf(fmtGitHubWorkflowFile, name))
+}
+
 // WriteEnvironmentManifest writes the environment manifest under the copilot/environments/{name}/ directory.
 // If successful returns the full path of the file, otherwise returns an empty string and the error.
 func (ws *Workspace) WriteEnvironmentManifest(marshaler encoding.BinaryMarshaler, name string) (string, error) {
@@ -483,6 +559,16 @@ func (ws *Workspace) DeleteWorkspaceFile() error {
 	return ws.fs.Remove(filepath.Join(ws.CopilotDirAbs, SummaryFileName))
 }
 
+// DeleteEnvAddonFile removes an addon file for environments.
+func (ws *Workspace) DeleteEnvAddonFile(fName string) error {
+	return ws.fs.Remove(ws.EnvAddonFileAbsPath(fName))
+}
+
+// DeleteWorkloadAddonFile removes an addon file of a given workload.
+func (ws *Workspace) DeleteWorkloadAddonFile(wkldName, fName string) error {
+	return ws.fs.Remove(ws.WorkloadAddonFileAbsPath(wkldName, fName))
+}
+
 // EnvAddonsAbsPath returns the absolute path for the addons/ directory of environments.
 func (ws *Workspace) EnvAddonsAbsPath() string {
 	return filepath.Join(ws.CopilotDirAbs, environmentsDirName, addonsDirName)
@@ -111,6 +111,27 @@ func TestWorkspace_Summary(t *testing.T) {
 				Path:        filepath.FromSlash("test/copilot/.workspace"),
 			},
 		},
+		"workspace summary with the credentials of environments": {
+			workingDir: "test/",
+			mockFileSystem: func(fs afero.Fs) {
+				fs.MkdirAll("test/copilot", 0755)
+				afero.WriteFile(fs, "test/copilot/.workspace", []byte(`application: DavidsApp
+environments:
+  prod:
+    profile: prod-admin
+  test:
+    role: arn:aws:iam::123456789012:role/deployer
+`), 0644)
+			},
+			expectedSummary: Summary{
+				Application: "DavidsApp",
+				Environments: map[string]EnvironmentCredentials{
+					"prod": {Profile: "prod-admin"},
+					"test": {Role: "arn:aws:iam::123456789012:role/deployer"},
+				},
+				Path: filepath.FromSlash("test/copilot/.workspace"),
+			},
+		},
 		"no existing workspace summary": {
 			workingDir: "test/",
 			mockFileSystem: func(fs afero.Fs) {
@@ -1001,6 +1022,18 @@ func TestWorkspace_EnvAddonFilePath(t *testing.T) {
 	require.Equal(t, filepath.FromSlash("environments/addons/db.yml"), ws.EnvAddonFilePath("db.yml"))
 }
 
+func TestWorkspace_UseDir(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	_ = fs.MkdirAll("/projects/demo/copilot/api", 0755)
+	_ = afero.WriteFile(fs, "/projects/demo/copilot/.workspace", []byte("---\napplication: demo"), 0644)
+
+	ws, err := UseDir(fs, "/projects/demo/copilot/api")
+
+	require.NoError(t, err)
+	require.Equal(t, "/projects/demo/copilot", ws.CopilotDirAbs)
+	require.Equal(t, "/projects/demo", ws.ProjectRoot())
+}
+
 func TestWorkspace_EnvOverridesPath(t *testing.T) {
 	// GIVEN
 	defer func() { getWd = os.Getwd }()
@@ -1292,6 +1325,190 @@ func TestWorkspace_Write(t *testing.T) {
 	}
 }
 
+func TestWorkspace_EditWorkloadManifest(t *testing.T) {
+	testCases := map[string]struct {
+		edit func(raw []byte) ([]byte, error)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"replaces the manifest with the edited content": {
+			edit: func(raw []byte) ([]byte, error) {
+				require.Equal(t, "name: api\ncount: 1\n", string(raw))
+				return []byte("name: api\ncount: 3\n"), nil
+			},
+			wantedContent: "name: api\ncount: 3\n",
+		},
+		"leaves the manifest unchanged if the edit fails": {
+			edit: func(raw []byte) ([]byte, error) {
+				return nil, errors.New("some error")
+			},
+			wantedContent: "name: api\ncount: 1\n",
+			wantedErr:     errors.New("edit manifest of api: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			utils := &afero.Afero{
+				Fs: fs,
+			}
+			mftPath := filepath.Join("/", "copilot", "api", "manifest.yml")
+			require.NoError(t, utils.WriteFile(mftPath, []byte("name: api\ncount: 1\n"), 0644))
+			ws := &Workspace{
+				workingDirAbs: "/",
+				CopilotDirAbs: "/copilot",
+				fs:            utils,
+			}
+
+			// WHEN
+			actualPath, actualErr := ws.EditWorkloadManifest("api", tc.edit)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, actualErr, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, actualErr)
+				require.Equal(t, mftPath, actualPath)
+			}
+			out, err := utils.ReadFile(mftPath)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, string(out))
+		})
+	}
+}
+
+func TestWorkspace_EditWorkloadEnvOverrides(t *testing.T) {
+	overridesPath := filepath.Join("/", "copilot", "api", "environments", "prod", "overrides.yml")
+	testCases := map[string]struct {
+		mockFS func(fs afero.Fs)
+		edit   func(raw []byte) ([]byte, error)
+
+		wantedContent string
+		wantedErr     error
+	}{
+		"replaces the override file with the edited content": {
+			mockFS: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, overridesPath, []byte("count: 1\n"), 0644)
+			},
+			edit: func(raw []byte) ([]byte, error) {
+				require.Equal(t, "count: 1\n", string(raw))
+				return []byte("count: 3\n"), nil
+			},
+			wantedContent: "count: 3\n",
+		},
+		"leaves the override file unchanged if the edit fails": {
+			mockFS: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, overridesPath, []byte("count: 1\n"), 0644)
+			},
+			edit: func(raw []byte) ([]byte, error) {
+				return nil, errors.New("some error")
+			},
+			wantedContent: "count: 1\n",
+			wantedErr:     errors.New("edit overrides of api for environment prod: some error"),
+		},
+		"returns ErrFileNotExists if the environment has no override file": {
+			mockFS: func(fs afero.Fs) {},
+			edit: func(raw []byte) ([]byte, error) {
+				return nil, errors.New("should not be called")
+			},
+			wantedErr: &ErrFileNotExists{FileName: overridesPath},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			tc.mockFS(fs)
+			ws := &Workspace{
+				CopilotDirAbs: filepath.Join("/", "copilot"),
+				fs:            &afero.Afero{Fs: fs},
+			}
+
+			// WHEN
+			actualPath, actualErr := ws.EditWorkloadEnvOverrides("api", "prod", tc.edit)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, actualErr, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, actualErr)
+			require.Equal(t, overridesPath, actualPath)
+			out, err := afero.ReadFile(fs, overridesPath)
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedContent, string(out))
+		})
+	}
+}
+
+func TestWorkspace_WriteGitHubWorkflow(t *testing.T) {
+	testCases := map[string]struct {
+		marshaler mockBinaryMarshaler
+		mockFS    func(fs afero.Fs)
+
+		wantedPath string
+		wantedErr  error
+	}{
+		"writes the workflow under the project root": {
+			marshaler: mockBinaryMarshaler{
+				content: []byte("name: release"),
+			},
+			mockFS:     func(fs afero.Fs) {},
+			wantedPath: filepath.FromSlash("/.github/workflows/copilot-release.yml"),
+		},
+		"wraps error if cannot marshal to binary": {
+			marshaler: mockBinaryMarshaler{
+				err: errors.New("some error"),
+			},
+			mockFS:    func(fs afero.Fs) {},
+			wantedErr: errors.New("marshal GitHub Actions workflow to binary: some error"),
+		},
+		"returns an error if the workflow already exists": {
+			marshaler: mockBinaryMarshaler{
+				content: []byte("name: release"),
+			},
+			mockFS: func(fs afero.Fs) {
+				_ = afero.WriteFile(fs, "/.github/workflows/copilot-release.yml", []byte("name: old"), 0644)
+			},
+			wantedErr: &ErrFileExists{FileName: filepath.FromSlash("/.github/workflows/copilot-release.yml")},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := afero.NewMemMapFs()
+			tc.mockFS(fs)
+			utils := &afero.Afero{
+				Fs: fs,
+			}
+			utils.MkdirAll(filepath.Join("/", "copilot"), 0755)
+			ws := &Workspace{
+				workingDirAbs: "/",
+				CopilotDirAbs: "/copilot",
+				fs:            utils,
+			}
+
+			// WHEN
+			actualPath, actualErr := ws.WriteGitHubWorkflow(tc.marshaler, "release")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, actualErr, tc.wantedErr.Error(), "expected the same error")
+			} else {
+				require.Equal(t, tc.wantedPath, actualPath, "expected the same path")
+				out, err := utils.ReadFile(tc.wantedPath)
+				require.NoError(t, err)
+				require.Equal(t, tc.marshaler.content, out, "expected the contents of the file to match")
+			}
+		})
+	}
+}
+
 func TestWorkspace_ReadWorkloadManifest(t *testing.T) {
 	const (
 		mockCopilotDir   = "/copilot"
@@ -1350,6 +1567,97 @@ flavor: vanilla`))
 type: Load Balanced Web Service
 flavor: vanilla`),
 		},
+		"compose the manifest with the manifests it extends and its override files": {
+			mockFS: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				_ = afero.WriteFile(fs, "/copilot/shared/base.yml", []byte(`type: Load Balanced Web Service
+image:
+  port: 80
+cpu: 256
+`), 0644)
+				_ = afero.WriteFile(fs, "/copilot/shared/web.yml", []byte(`extends: base.yml
+http:
+  path: /
+cpu: 512
+`), 0644)
+				_ = afero.WriteFile(fs, "/copilot/webhook/manifest.yml", []byte(`extends: ../shared/web.yml
+name: webhook
+image:
+  build: Dockerfile # Built from the workspace.
+environments:
+  prod:
+    count: 2
+    cpu: 1024
+`), 0644)
+				_ = afero.WriteFile(fs, "/copilot/webhook/environments/prod/overrides.yml", []byte(`count: 3
+`), 0644)
+				_ = afero.WriteFile(fs, "/copilot/webhook/environments/test/overrides.yml", []byte(`http:
+  alias: test.example.com
+`), 0644)
+				return fs
+			},
+
+			wantedData: []byte(`type: Load Balanced Web Service
+image:
+  port: 80
+  build: Dockerfile # Built from the workspace.
+cpu: 512
+http:
+  path: /
+name: webhook
+environments:
+  prod:
+    count: 3
+    cpu: 1024
+  test:
+    http:
+      alias: test.example.com
+`),
+		},
+		"drop the build of the extended manifest when the manifest switches to an image location": {
+			mockFS: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				_ = afero.WriteFile(fs, "/copilot/webhook/base.yml", []byte(`type: Load Balanced Web Service
+image:
+  build: Dockerfile
+  port: 80
+`), 0644)
+				_ = afero.WriteFile(fs, "/copilot/webhook/manifest.yml", []byte(`extends: base.yml
+name: webhook
+image:
+  location: nginx
+`), 0644)
+				return fs
+			},
+
+			wantedData: []byte(`type: Load Balanced Web Service
+image:
+  port: 80
+  location: nginx
+name: webhook
+`),
+		},
+		"return error if the extended manifest does not exist": {
+			mockFS: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				_ = afero.WriteFile(fs, "/copilot/webhook/manifest.yml", []byte(`extends: base.yml
+name: webhook`), 0644)
+				return fs
+			},
+			wantedErr: fmt.Errorf("read manifest extended by %s: file %s does not exists",
+				filepath.FromSlash("/copilot/webhook/manifest.yml"), filepath.FromSlash("/copilot/webhook/base.yml")),
+		},
+		"return error if the manifest extends itself": {
+			mockFS: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				_ = afero.WriteFile(fs, "/copilot/webhook/manifest.yml", []byte(`extends: base.yml
+name: webhook`), 0644)
+				_ = afero.WriteFile(fs, "/copilot/webhook/base.yml", []byte(`extends: manifest.yml`), 0644)
+				return fs
+			},
+			wantedErr: fmt.Errorf("manifest %s extends itself through %s",
+				filepath.FromSlash("/copilot/webhook/manifest.yml"), filepath.FromSlash("/copilot/webhook/base.yml")),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -1581,6 +1889,123 @@ func TestWorkspace_DeleteWorkspaceFile(t *testing.T) {
 	}
 }
 
+func TestWorkspace_DeleteEnvAddonFile(t *testing.T) {
+	testCases := map[string]struct {
+		fName string
+		fs    func() afero.Fs
+
+		wantedErr error
+	}{
+		"delete the addon file": {
+			fName: "my-fs.yml",
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/environments/addons", 0755)
+				fs.Create("/copilot/environments/addons/my-fs.yml")
+				fs.Create("/copilot/environments/addons/addons.parameters.yml")
+				return fs
+			},
+		},
+		"return an error if the file does not exist": {
+			fName: "my-fs.yml",
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/environments/addons", 0755)
+				return fs
+			},
+			wantedErr: &os.PathError{
+				Op:   "remove",
+				Path: "/copilot/environments/addons/my-fs.yml",
+				Err:  os.ErrNotExist,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := tc.fs()
+			ws := &Workspace{
+				CopilotDirAbs: "/copilot",
+				fs: &afero.Afero{
+					Fs: fs,
+				},
+			}
+
+			// WHEN
+			err := ws.DeleteEnvAddonFile(tc.fName)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			exists, _ := afero.Exists(fs, "/copilot/environments/addons/my-fs.yml")
+			require.False(t, exists)
+			exists, _ = afero.Exists(fs, "/copilot/environments/addons/addons.parameters.yml")
+			require.True(t, exists)
+		})
+	}
+}
+
+func TestWorkspace_DeleteWorkloadAddonFile(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedErr error
+	}{
+		"delete the addon file": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/api/addons", 0755)
+				fs.Create("/copilot/api/addons/my-table.yml")
+				fs.Create("/copilot/api/addons/addons.parameters.yml")
+				return fs
+			},
+		},
+		"return an error if the file does not exist": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/api/addons", 0755)
+				return fs
+			},
+			wantedErr: &os.PathError{
+				Op:   "remove",
+				Path: "/copilot/api/addons/my-table.yml",
+				Err:  os.ErrNotExist,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			fs := tc.fs()
+			ws := &Workspace{
+				CopilotDirAbs: "/copilot",
+				fs: &afero.Afero{
+					Fs: fs,
+				},
+			}
+
+			// WHEN
+			err := ws.DeleteWorkloadAddonFile("api", "my-table.yml")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			exists, _ := afero.Exists(fs, "/copilot/api/addons/my-table.yml")
+			require.False(t, exists)
+			exists, _ = afero.Exists(fs, "/copilot/api/addons/addons.parameters.yml")
+			require.True(t, exists)
+		})
+	}
+}
+
 func TestWorkspace_read(t *testing.T) {
 	testCases := map[string]struct {
 		elems []string
@@ -0,0 +1,50 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package deploy deploys the services of a workspace like the CLI does, from Go programs.
+package deploy
+
+import (
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	pkgworkspace "github.com/aws/copilot-cli/pkg/workspace"
+	"github.com/spf13/afero"
+)
+
+// ServiceInput is the configuration of the deployment of a service.
+type ServiceInput struct {
+	App             string            // Name of the application. Defaults to the application of the workspace.
+	Name            string            // Name of the service.
+	Env             string            // Name of the environment to deploy to.
+	ImageTag        string            // Tag of the container images pushed for the service.
+	ResourceTags    map[string]string // Additional tags applied to the stack.
+	Force           bool              // Force a new deployment even if there are no changes.
+	DisableRollback bool              // Leave failed stacks in place instead of rolling them back.
+	WaitForLock     bool              // Wait for another deployment of the service to finish instead of failing.
+	LockTimeout     time.Duration     // Maximum duration to wait with WaitForLock. Zero waits indefinitely.
+	OverrideFreeze  string            // Reason to deploy in spite of the deploy windows and freezes of the environment.
+}
+
+// Service builds and pushes the images of a service of the workspace and deploys it to an environment,
+// with the same checks as "copilot svc deploy": deploy windows, quotas and concurrent deployments.
+// It uses the default AWS credentials, and writes its progress to stderr.
+func Service(ws *pkgworkspace.Workspace, in ServiceInput) error {
+	internalWs, err := workspace.UseDir(afero.NewOsFs(), ws.Path())
+	if err != nil {
+		return err
+	}
+	return cli.DeployService(internalWs, cli.DeployServiceInput{
+		App:             in.App,
+		Name:            in.Name,
+		Env:             in.Env,
+		ImageTag:        in.ImageTag,
+		ResourceTags:    in.ResourceTags,
+		Force:           in.Force,
+		DisableRollback: in.DisableRollback,
+		WaitForLock:     in.WaitForLock,
+		LockTimeout:     in.LockTimeout,
+		OverrideFreeze:  in.OverrideFreeze,
+	})
+}
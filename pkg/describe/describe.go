@@ -0,0 +1,121 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package describe returns the deployed configuration of the services of an application,
+// the same information as "copilot svc show --json" but as Go values.
+package describe
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+)
+
+// ServiceDescription is the deployed configuration of a service in each of its environments.
+type ServiceDescription struct {
+	Service        string          `json:"service"`
+	Type           string          `json:"type"`
+	Application    string          `json:"application"`
+	Configurations []Configuration `json:"configurations"`
+	Routes         []Route         `json:"routes"`
+	Variables      []Variable      `json:"variables"`
+
+	// Raw is the complete description, whose fields depend on the type of the service.
+	Raw json.RawMessage `json:"-"`
+}
+
+// Configuration is the size of a service in an environment.
+type Configuration struct {
+	Environment string `json:"environment"`
+	Port        string `json:"port"`
+	CPU         string `json:"cpu"`
+	Memory      string `json:"memory"`
+	Platform    string `json:"platform,omitempty"`
+	Tasks       string `json:"tasks,omitempty"`
+}
+
+// Route is the URL of a service in an environment.
+type Route struct {
+	Environment string `json:"environment"`
+	URL         string `json:"url"`
+}
+
+// Variable is an environment variable of a container of a service.
+type Variable struct {
+	Environment string `json:"environment"`
+	Container   string `json:"container,omitempty"`
+	Name        string `json:"name"`
+	Value       string `json:"value"`
+}
+
+type describer interface {
+	Describe() (describe.HumanJSONStringer, error)
+}
+
+// Service returns the description of a service of an application, looked up with the default AWS credentials.
+func Service(app, name string) (*ServiceDescription, error) {
+	sessProvider := sessions.ImmutableProvider(sessions.UserAgentExtras("sdk describe"))
+	defaultSess, err := sessProvider.Default()
+	if err != nil {
+		return nil, fmt.Errorf("default session: %w", err)
+	}
+	ssmStore := config.NewSSMStore(identity.New(defaultSess), ssm.New(defaultSess), aws.StringValue(defaultSess.Config.Region))
+	deployStore, err := deploy.NewStore(sessProvider, ssmStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	svc, err := ssmStore.GetService(app, name)
+	if err != nil {
+		return nil, err
+	}
+	cfg := describe.NewServiceConfig{
+		App:         app,
+		Svc:         name,
+		ConfigStore: ssmStore,
+		DeployStore: deployStore,
+	}
+	var d describer
+	switch svc.Type {
+	case manifestinfo.LoadBalancedWebServiceType:
+		d, err = describe.NewLBWebServiceDescriber(cfg)
+	case manifestinfo.RequestDrivenWebServiceType:
+		d, err = describe.NewRDWebServiceDescriber(cfg)
+	case manifestinfo.BackendServiceType:
+		d, err = describe.NewBackendServiceDescriber(cfg)
+	case manifestinfo.WorkerServiceType:
+		d, err = describe.NewWorkerServiceDescriber(cfg)
+	case manifestinfo.StaticSiteType:
+		d, err = describe.NewStaticSiteDescriber(cfg)
+	default:
+		return nil, fmt.Errorf("service type %q can't be described", svc.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create describer for service %s in application %s: %w", name, app, err)
+	}
+	return describeService(d)
+}
+
+func describeService(d describer) (*ServiceDescription, error) {
+	desc, err := d.Describe()
+	if err != nil {
+		return nil, fmt.Errorf("describe service: %w", err)
+	}
+	data, err := desc.JSONString()
+	if err != nil {
+		return nil, err
+	}
+	var out ServiceDescription
+	if err := json.Unmarshal([]byte(data), &out); err != nil {
+		return nil, fmt.Errorf("unmarshal description of the service: %w", err)
+	}
+	out.Raw = json.RawMessage(data)
+	return &out, nil
+}
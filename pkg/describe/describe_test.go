@@ -0,0 +1,69 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDescription string
+
+func (d fakeDescription) HumanString() string         { return "" }
+func (d fakeDescription) JSONString() (string, error) { return string(d), nil }
+
+type fakeDescriber struct {
+	desc describe.HumanJSONStringer
+	err  error
+}
+
+func (d fakeDescriber) Describe() (describe.HumanJSONStringer, error) {
+	return d.desc, d.err
+}
+
+func TestDescribeService(t *testing.T) {
+	const data = `{"service":"api","type":"Load Balanced Web Service","application":"demo",` +
+		`"configurations":[{"environment":"test","port":"80","cpu":"256","memory":"512","platform":"LINUX/X86_64","tasks":"1"}],` +
+		`"routes":[{"environment":"test","url":"http://demo.example.com"}],` +
+		`"variables":[{"environment":"test","container":"api","name":"COPILOT_ENVIRONMENT_NAME","value":"test"}]}`
+	testCases := map[string]struct {
+		describer describer
+		wanted    *ServiceDescription
+		wantedErr string
+	}{
+		"description": {
+			describer: fakeDescriber{desc: fakeDescription(data)},
+			wanted: &ServiceDescription{
+				Service:     "api",
+				Type:        "Load Balanced Web Service",
+				Application: "demo",
+				Configurations: []Configuration{
+					{Environment: "test", Port: "80", CPU: "256", Memory: "512", Platform: "LINUX/X86_64", Tasks: "1"},
+				},
+				Routes:    []Route{{Environment: "test", URL: "http://demo.example.com"}},
+				Variables: []Variable{{Environment: "test", Container: "api", Name: "COPILOT_ENVIRONMENT_NAME", Value: "test"}},
+				Raw:       json.RawMessage(data),
+			},
+		},
+		"error describing the service": {
+			describer: fakeDescriber{err: errors.New("some error")},
+			wantedErr: "describe service: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := describeService(tc.describer)
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
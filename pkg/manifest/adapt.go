@@ -0,0 +1,110 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+)
+
+// newWorkload returns the workload with the values of the manifest that Copilot read.
+func newWorkload(mft any) (*Workload, error) {
+	w := &Workload{}
+	switch mft := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		w.setWorkload(mft.Workload)
+		w.setImage(mft.ImageConfig.Image, mft.ImageConfig.Port)
+		w.setTask(mft.TaskConfig)
+	case *manifest.BackendService:
+		w.setWorkload(mft.Workload)
+		w.setImage(mft.ImageConfig.Image, mft.ImageConfig.Port)
+		w.setTask(mft.TaskConfig)
+	case *manifest.WorkerService:
+		w.setWorkload(mft.Workload)
+		w.setImage(mft.ImageConfig.Image, nil)
+		w.setTask(mft.TaskConfig)
+	case *manifest.ScheduledJob:
+		w.setWorkload(mft.Workload)
+		w.setImage(mft.ImageConfig.Image, nil)
+		w.setTask(mft.TaskConfig)
+	case *manifest.RequestDrivenWebService:
+		w.setWorkload(mft.Workload)
+		w.setImage(mft.ImageConfig.Image, mft.ImageConfig.Port)
+		w.CPU, w.Memory = mft.InstanceConfig.CPU, mft.InstanceConfig.Memory
+		w.Platform = platform(mft.InstanceConfig.Platform)
+		w.Variables = plainVariables(mft.Variables)
+	case *manifest.LambdaService:
+		w.setWorkload(mft.Workload)
+		w.setImage(mft.ImageConfig.Image, nil)
+		w.Memory = mft.Memory
+		w.Platform = platform(mft.Platform)
+		w.Variables = plainVariables(mft.Variables)
+	case *manifest.StaticSite:
+		w.setWorkload(mft.Workload)
+	case *manifest.WorkflowJob:
+		w.setWorkload(mft.Workload)
+		w.Platform = platform(mft.Platform)
+	default:
+		return nil, fmt.Errorf("manifest of type %T is not supported", mft)
+	}
+	return w, nil
+}
+
+func (w *Workload) setWorkload(in manifest.Workload) {
+	w.Name, w.Type = aws.StringValue(in.Name), aws.StringValue(in.Type)
+}
+
+func (w *Workload) setImage(in manifest.Image, port *uint16) {
+	w.Image = Image{
+		Build:    aws.StringValue(in.Build.BuildString),
+		Location: aws.StringValue(in.Location),
+		Port:     port,
+	}
+	if in.Build.BuildArgs.Dockerfile != nil {
+		w.Image.Build = aws.StringValue(in.Build.BuildArgs.Dockerfile)
+	}
+}
+
+func (w *Workload) setTask(in manifest.TaskConfig) {
+	w.CPU, w.Memory = in.CPU, in.Memory
+	if in.Count.AdvancedCount.IsEmpty() {
+		w.Count = in.Count.Value
+	}
+	w.Platform = platform(in.Platform)
+	w.Variables = plainVariables(in.Variables)
+}
+
+func platform(in manifest.PlatformArgsOrString) string {
+	if in.IsEmpty() {
+		return ""
+	}
+	return in.OS() + "/" + in.Arch()
+}
+
+func plainVariables(in map[string]manifest.Variable) map[string]string {
+	var out map[string]string
+	for name, v := range in {
+		if v.Plain == nil {
+			continue
+		}
+		if out == nil {
+			out = make(map[string]string)
+		}
+		out[name] = aws.StringValue(v.Plain)
+	}
+	return out
+}
+
+// newEnvironment returns the environment with the values of the manifest that Copilot read.
+func newEnvironment(mft *manifest.Environment) *Environment {
+	return &Environment{
+		Name:              aws.StringValue(mft.Name),
+		VPCID:             aws.StringValue(mft.Network.VPC.ID),
+		CIDR:              string(aws.StringValue((*string)(mft.Network.VPC.CIDR))),
+		Certificates:      mft.HTTPConfig.Public.Certificates,
+		ContainerInsights: mft.Observability.ContainerInsights,
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package manifest reads, modifies and writes the Copilot manifests of workloads and environments from Go programs.
+package manifest
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/manifest/manifestinfo"
+	"gopkg.in/yaml.v3"
+)
+
+// Workload types.
+const (
+	LoadBalancedWebServiceType  = manifestinfo.LoadBalancedWebServiceType
+	RequestDrivenWebServiceType = manifestinfo.RequestDrivenWebServiceType
+	BackendServiceType          = manifestinfo.BackendServiceType
+	WorkerServiceType           = manifestinfo.WorkerServiceType
+	StaticSiteType              = manifestinfo.StaticSiteType
+	LambdaServiceType           = manifestinfo.LambdaServiceType
+	ScheduledJobType            = manifestinfo.ScheduledJobType
+	WorkflowJobType             = manifestinfo.WorkflowJobType
+)
+
+// Workload is the manifest of a service or job.
+// The fields hold the values of the manifest with the defaults of its type applied, but without the overrides
+// of the environments. The fields that aren't listed are kept as written when the manifest is marshaled.
+type Workload struct {
+	Name      string
+	Type      string
+	Image     Image
+	CPU       *int              // CPU units of the task, like 256 for 0.25 vCPU. Nil if the type has no CPU setting.
+	Memory    *int              // Memory in MiB.
+	Count     *int              // Number of tasks. Nil if the count is a range or the type has no count.
+	Platform  string            // Operating system and architecture, like "linux/arm64". Empty for the default platform.
+	Variables map[string]string // Environment variables with a plain value. The variables imported from stacks aren't listed.
+
+	doc  yaml.Node // Manifest as written, where the changes are made.
+	read *Workload // Values when the manifest was unmarshaled, to only write the fields that changed.
+}
+
+// Image is the container image of the main container of a workload.
+type Image struct {
+	Build    string  // Path of the Dockerfile to build the image from.
+	Location string  // URI of an existing image to use instead of building one.
+	Port     *uint16 // Port exposed by the container.
+}
+
+// Environment is the manifest of an environment.
+// The fields that aren't listed are kept as written when the manifest is marshaled.
+type Environment struct {
+	Name              string
+	VPCID             string   // ID of the imported VPC. Empty if Copilot creates the VPC.
+	CIDR              string   // CIDR block of the VPC that Copilot creates.
+	Certificates      []string // ARNs of the certificates of the public load balancer.
+	ContainerInsights *bool
+
+	doc  yaml.Node
+	read *Environment
+}
+
+// UnmarshalWorkload returns the manifest of a workload.
+func UnmarshalWorkload(in []byte) (*Workload, error) {
+	mft, err := manifest.UnmarshalWorkload(in)
+	if err != nil {
+		return nil, err
+	}
+	w, err := newWorkload(mft.Manifest())
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(in, &w.doc); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+	read := w.clone()
+	w.read = &read
+	return w, nil
+}
+
+// UnmarshalEnvironment returns the manifest of an environment.
+func UnmarshalEnvironment(in []byte) (*Environment, error) {
+	mft, err := manifest.UnmarshalEnvironment(in)
+	if err != nil {
+		return nil, err
+	}
+	env := newEnvironment(mft)
+	if err := yaml.Unmarshal(in, &env.doc); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+	read := env.clone()
+	env.read = &read
+	return env, nil
+}
+
+// ValidateWorkload returns an error if the manifest of a workload, once the overrides of the environment are applied,
+// is invalid. Leave env empty to validate the manifest without overrides.
+func ValidateWorkload(in []byte, env string) error {
+	mft, err := manifest.UnmarshalWorkload(in)
+	if err != nil {
+		return err
+	}
+	if env != "" {
+		if mft, err = mft.ApplyEnv(env); err != nil {
+			return err
+		}
+	}
+	return mft.Validate()
+}
+
+// Marshal returns the YAML of the manifest. Only the fields that changed since the manifest was unmarshaled are written,
+// so the defaults stay implicit, and the other fields and the comments are kept as written.
+func (w *Workload) Marshal() ([]byte, error) {
+	var read Workload
+	if w.read != nil {
+		read = *w.read
+	}
+	doc := cloneNode(&w.doc)
+	fields := []struct {
+		path      []string
+		got, read any
+	}{
+		{[]string{"name"}, w.Name, read.Name},
+		{[]string{"type"}, w.Type, read.Type},
+		{[]string{"image", "location"}, w.Image.Location, read.Image.Location},
+		{[]string{"image", "port"}, w.Image.Port, read.Image.Port},
+		{[]string{"cpu"}, w.CPU, read.CPU},
+		{[]string{"memory"}, w.Memory, read.Memory},
+		{[]string{"count"}, w.Count, read.Count},
+		{[]string{"platform"}, w.Platform, read.Platform},
+	}
+	for _, f := range fields {
+		if err := writeChange(doc, f.path, f.got, f.read); err != nil {
+			return nil, err
+		}
+	}
+	if w.Image.Build != read.Image.Build {
+		if err := writeBuild(doc, w.Image.Build); err != nil {
+			return nil, err
+		}
+	}
+	writeMapChanges(doc, []string{"variables"}, w.Variables, read.Variables)
+	return encode(doc)
+}
+
+// Marshal returns the YAML of the manifest. Only the fields that changed since the manifest was unmarshaled are written,
+// and the other fields and the comments are kept as written.
+func (env *Environment) Marshal() ([]byte, error) {
+	var read Environment
+	if env.read != nil {
+		read = *env.read
+	}
+	doc := cloneNode(&env.doc)
+	if env.read == nil {
+		// Manifests created from scratch need the type to be read back.
+		if err := writeChange(doc, []string{"type"}, manifest.Environmentmanifestinfo, ""); err != nil {
+			return nil, err
+		}
+	}
+	fields := []struct {
+		path      []string
+		got, read any
+	}{
+		{[]string{"name"}, env.Name, read.Name},
+		{[]string{"network", "vpc", "id"}, env.VPCID, read.VPCID},
+		{[]string{"network", "vpc", "cidr"}, env.CIDR, read.CIDR},
+		{[]string{"http", "public", "certificates"}, env.Certificates, read.Certificates},
+		{[]string{"observability", "container_insights"}, env.ContainerInsights, read.ContainerInsights},
+	}
+	for _, f := range fields {
+		if err := writeChange(doc, f.path, f.got, f.read); err != nil {
+			return nil, err
+		}
+	}
+	return encode(doc)
+}
+
+func (w *Workload) clone() Workload {
+	out := *w
+	out.doc, out.read = yaml.Node{}, nil
+	out.Image.Port = clonePtr(w.Image.Port)
+	out.CPU, out.Memory, out.Count = clonePtr(w.CPU), clonePtr(w.Memory), clonePtr(w.Count)
+	if w.Variables != nil {
+		out.Variables = make(map[string]string, len(w.Variables))
+		for k, v := range w.Variables {
+			out.Variables[k] = v
+		}
+	}
+	return out
+}
+
+func (env *Environment) clone() Environment {
+	out := *env
+	out.doc, out.read = yaml.Node{}, nil
+	out.Certificates = append([]string(nil), env.Certificates...)
+	out.ContainerInsights = clonePtr(env.ContainerInsights)
+	return out
+}
+
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
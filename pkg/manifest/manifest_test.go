@@ -0,0 +1,272 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalWorkload(t *testing.T) {
+	testCases := map[string]struct {
+		in     string
+		wanted Workload
+	}{
+		"load balanced web service with the defaults of its type": {
+			in: `name: web
+type: Load Balanced Web Service
+image:
+  build: web/Dockerfile
+  port: 8080
+http:
+  path: /
+variables:
+  LOG_LEVEL: info
+  DB_NAME:
+    from_cfn: demo-test-db
+`,
+			wanted: Workload{
+				Name:      "web",
+				Type:      LoadBalancedWebServiceType,
+				Image:     Image{Build: "web/Dockerfile", Port: aws.Uint16(8080)},
+				CPU:       aws.Int(256),
+				Memory:    aws.Int(512),
+				Count:     aws.Int(1),
+				Variables: map[string]string{"LOG_LEVEL": "info"},
+			},
+		},
+		"backend service with a range of tasks and an advanced build": {
+			in: `name: api
+type: Backend Service
+image:
+  build:
+    dockerfile: api/Dockerfile
+    context: api
+cpu: 1024
+memory: 2048
+platform: linux/arm64
+count:
+  range: 1-10
+  cpu_percentage: 70
+`,
+			wanted: Workload{
+				Name:     "api",
+				Type:     BackendServiceType,
+				Image:    Image{Build: "api/Dockerfile"},
+				CPU:      aws.Int(1024),
+				Memory:   aws.Int(2048),
+				Platform: "linux/arm64",
+			},
+		},
+		"request-driven web service": {
+			in: `name: front
+type: Request-Driven Web Service
+image:
+  location: nginx
+  port: 80
+`,
+			wanted: Workload{
+				Name:   "front",
+				Type:   RequestDrivenWebServiceType,
+				Image:  Image{Location: "nginx", Port: aws.Uint16(80)},
+				CPU:    aws.Int(1024),
+				Memory: aws.Int(2048),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := UnmarshalWorkload([]byte(tc.in))
+
+			require.NoError(t, err)
+			got.doc, got.read = tc.wanted.doc, nil
+			require.Equal(t, tc.wanted, *got)
+		})
+	}
+}
+
+func TestWorkload_Marshal(t *testing.T) {
+	const mft = `# The API of the shop.
+name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 8080 # Port of the server.
+count:
+  range: 1-10
+  cpu_percentage: 70
+variables:
+  LOG_LEVEL: info
+  DB_NAME:
+    from_cfn: demo-test-db
+`
+	testCases := map[string]struct {
+		change func(w *Workload)
+		wanted string
+	}{
+		"without changes the manifest is written as it was read": {
+			change: func(w *Workload) {},
+			wanted: mft,
+		},
+		"changes are written and the rest is kept": {
+			change: func(w *Workload) {
+				w.CPU = aws.Int(1024)
+				*w.Image.Port = 80
+				w.Variables["LOG_LEVEL"] = "debug"
+				w.Variables["REGION"] = "us-west-2"
+			},
+			wanted: `# The API of the shop.
+name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 80 # Port of the server.
+count:
+  range: 1-10
+  cpu_percentage: 70
+variables:
+  LOG_LEVEL: debug
+  DB_NAME:
+    from_cfn: demo-test-db
+  REGION: us-west-2
+cpu: 1024
+`,
+		},
+		"fields set to their zero value are removed": {
+			change: func(w *Workload) {
+				w.Image.Port = nil
+				delete(w.Variables, "LOG_LEVEL")
+			},
+			wanted: `# The API of the shop.
+name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+count:
+  range: 1-10
+  cpu_percentage: 70
+variables:
+  DB_NAME:
+    from_cfn: demo-test-db
+`,
+		},
+		"a count replaces the range": {
+			change: func(w *Workload) {
+				w.Count = aws.Int(0)
+			},
+			wanted: `# The API of the shop.
+name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 8080 # Port of the server.
+count: 0
+variables:
+  LOG_LEVEL: info
+  DB_NAME:
+    from_cfn: demo-test-db
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			w, err := UnmarshalWorkload([]byte(mft))
+			require.NoError(t, err)
+			tc.change(w)
+
+			out, err := w.Marshal()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(out))
+			_, err = UnmarshalWorkload(out)
+			require.NoError(t, err, "the manifest is read back")
+		})
+	}
+}
+
+func TestWorkload_Marshal_New(t *testing.T) {
+	w := &Workload{
+		Name:      "worker",
+		Type:      WorkerServiceType,
+		Image:     Image{Location: "nginx"},
+		Variables: map[string]string{"QUEUE": "orders"},
+	}
+
+	out, err := w.Marshal()
+
+	require.NoError(t, err)
+	require.Equal(t, `name: worker
+type: Worker Service
+image:
+  location: nginx
+variables:
+  QUEUE: orders
+`, string(out))
+}
+
+func TestEnvironment_Marshal(t *testing.T) {
+	const mft = `name: test
+type: Environment
+network:
+  vpc:
+    id: vpc-1234
+observability:
+  container_insights: false
+`
+	testCases := map[string]struct {
+		change func(env *Environment)
+		wanted string
+	}{
+		"without changes the manifest is written as it was read": {
+			change: func(env *Environment) {},
+			wanted: mft,
+		},
+		"changes are written and the rest is kept": {
+			change: func(env *Environment) {
+				env.Certificates = []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"}
+				*env.ContainerInsights = true
+			},
+			wanted: `name: test
+type: Environment
+network:
+  vpc:
+    id: vpc-1234
+observability:
+  container_insights: true
+http:
+  public:
+    certificates:
+      - arn:aws:acm:us-west-2:123456789012:certificate/abc
+`,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			env, err := UnmarshalEnvironment([]byte(mft))
+			require.NoError(t, err)
+			require.Equal(t, "vpc-1234", env.VPCID)
+			tc.change(env)
+
+			out, err := env.Marshal()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, string(out))
+		})
+	}
+}
+
+func TestValidateWorkload(t *testing.T) {
+	mft := `name: api
+type: Backend Service
+image:
+  location: nginx
+environments:
+  test:
+    platform: windows/arm64
+`
+	require.NoError(t, ValidateWorkload([]byte(mft), ""))
+	require.ErrorContains(t, ValidateWorkload([]byte(mft), "test"), "platform")
+}
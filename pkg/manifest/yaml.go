@@ -0,0 +1,184 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// cloneNode returns a deep copy of the document, or an empty document if the node is empty.
+func cloneNode(in *yaml.Node) *yaml.Node {
+	if in.Kind == 0 {
+		return &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	out := *in
+	out.Content = make([]*yaml.Node, len(in.Content))
+	for i, child := range in.Content {
+		out.Content[i] = cloneNode(child)
+	}
+	return &out
+}
+
+// writeChange sets the value at the path of the document if it changed since it was read,
+// or removes the key if the value is now empty.
+func writeChange(doc *yaml.Node, path []string, got, read any) error {
+	if reflect.DeepEqual(got, read) {
+		return nil
+	}
+	if isEmpty(got) {
+		remove(root(doc), path)
+		return nil
+	}
+	val := &yaml.Node{}
+	if err := val.Encode(got); err != nil {
+		return fmt.Errorf("marshal %v: %w", path, err)
+	}
+	set(root(doc), path, val)
+	return nil
+}
+
+// writeBuild sets the Dockerfile of the image, in the advanced form of "build" if that's the form used by the manifest.
+func writeBuild(doc *yaml.Node, dockerfile string) error {
+	if build := lookup(root(doc), []string{"image", "build"}); build != nil && build.Kind == yaml.MappingNode {
+		return writeChange(doc, []string{"image", "build", "dockerfile"}, dockerfile, nil)
+	}
+	return writeChange(doc, []string{"image", "build"}, dockerfile, nil)
+}
+
+// writeMapChanges sets the keys of the mapping at path that were added or changed, and removes the ones that were deleted.
+// The keys that were never part of the map, like variables imported from stacks, are left as written.
+func writeMapChanges(doc *yaml.Node, path []string, got, read map[string]string) {
+	keys := make(map[string]bool)
+	for k := range got {
+		keys[k] = true
+	}
+	for k := range read {
+		keys[k] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+	for _, k := range sorted {
+		g, ok := got[k]
+		r, wasRead := read[k]
+		if ok == wasRead && g == r {
+			continue
+		}
+		keyPath := append(append([]string(nil), path...), k)
+		if !ok {
+			remove(root(doc), keyPath)
+			continue
+		}
+		set(root(doc), keyPath, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: g})
+	}
+}
+
+func encode(doc *yaml.Node) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("marshal manifest: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("marshal manifest: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// root returns the mapping at the root of the document.
+func root(doc *yaml.Node) *yaml.Node {
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		doc.Content = []*yaml.Node{{Kind: yaml.MappingNode}}
+	}
+	return doc.Content[0]
+}
+
+// lookup returns the value at the path of the mapping, or nil if there is none.
+func lookup(mapping *yaml.Node, path []string) *yaml.Node {
+	node := mapping
+	for _, key := range path {
+		if node.Kind != yaml.MappingNode {
+			return nil
+		}
+		_, node = field(node, key)
+		if node == nil {
+			return nil
+		}
+	}
+	return node
+}
+
+// set replaces the value at the path of the mapping, and creates the mappings on the way that don't exist.
+func set(mapping *yaml.Node, path []string, val *yaml.Node) {
+	node := mapping
+	for _, key := range path[:len(path)-1] {
+		i, next := field(node, key)
+		if next == nil || next.Kind != yaml.MappingNode {
+			// The basic form of a field, like "build: Dockerfile", is replaced by its advanced form.
+			next = &yaml.Node{Kind: yaml.MappingNode}
+			if i == -1 {
+				node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, next)
+			} else {
+				node.Content[i+1] = next
+			}
+		}
+		node = next
+	}
+	key := path[len(path)-1]
+	if i, _ := field(node, key); i != -1 {
+		// Keep the comments of the value that is replaced.
+		val.HeadComment, val.LineComment, val.FootComment = node.Content[i+1].HeadComment, node.Content[i+1].LineComment, node.Content[i+1].FootComment
+		node.Content[i+1] = val
+		return
+	}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, val)
+}
+
+// remove deletes the key at the path of the mapping, and the mappings on the way that become empty.
+func remove(mapping *yaml.Node, path []string) {
+	i, val := field(mapping, path[0])
+	if val == nil {
+		return
+	}
+	if len(path) > 1 {
+		if val.Kind != yaml.MappingNode {
+			return
+		}
+		remove(val, path[1:])
+		if len(val.Content) > 0 {
+			return
+		}
+	}
+	mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+}
+
+// field returns the index of the key in the mapping and its value, or -1 and nil if the mapping doesn't have the key.
+func field(mapping *yaml.Node, key string) (int, *yaml.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return i, mapping.Content[i+1]
+		}
+	}
+	return -1, nil
+}
+
+// isEmpty returns true if the value would remove the field from the manifest.
+func isEmpty(v any) bool {
+	val := reflect.ValueOf(v)
+	switch val.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Pointer, reflect.Slice, reflect.Map:
+		return val.IsNil() || (val.Kind() != reflect.Pointer && val.Len() == 0)
+	}
+	return val.IsZero()
+}
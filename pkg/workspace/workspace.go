@@ -0,0 +1,96 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package workspace loads the copilot/ directory of a repository: its application, workloads and environments.
+package workspace
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/aws/copilot-cli/pkg/manifest"
+	"github.com/spf13/afero"
+)
+
+// Workspace is a repository with a copilot/ directory.
+type Workspace struct {
+	ws *workspace.Workspace
+}
+
+// Open returns the workspace that dir belongs to, searching for a copilot/ directory from dir up to 5 levels above.
+func Open(dir string) (*Workspace, error) {
+	return open(afero.NewOsFs(), dir)
+}
+
+func open(fs afero.Fs, dir string) (*Workspace, error) {
+	ws, err := workspace.UseDir(fs, dir)
+	if err != nil {
+		return nil, err
+	}
+	return &Workspace{ws: ws}, nil
+}
+
+// Path returns the absolute path of the root of the workspace, the directory that holds copilot/.
+func (ws *Workspace) Path() string {
+	return ws.ws.ProjectRoot()
+}
+
+// Application returns the name of the application of the workspace.
+func (ws *Workspace) Application() (string, error) {
+	summary, err := ws.ws.Summary()
+	if err != nil {
+		return "", err
+	}
+	return summary.Application, nil
+}
+
+// Services returns the names of the services of the workspace.
+func (ws *Workspace) Services() ([]string, error) {
+	return ws.ws.ListServices()
+}
+
+// Jobs returns the names of the jobs of the workspace.
+func (ws *Workspace) Jobs() ([]string, error) {
+	return ws.ws.ListJobs()
+}
+
+// Environments returns the names of the environments that have a manifest in the workspace.
+func (ws *Workspace) Environments() ([]string, error) {
+	return ws.ws.ListEnvironments()
+}
+
+// WorkloadManifest returns the manifest of a service or job as written in the workspace, composed with the files it includes.
+func (ws *Workspace) WorkloadManifest(name string) ([]byte, error) {
+	return ws.ws.ReadWorkloadManifest(name)
+}
+
+// Workload returns the manifest of a service or job.
+func (ws *Workspace) Workload(name string) (*manifest.Workload, error) {
+	raw, err := ws.WorkloadManifest(name)
+	if err != nil {
+		return nil, err
+	}
+	mft, err := manifest.UnmarshalWorkload(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest of %s: %w", name, err)
+	}
+	return mft, nil
+}
+
+// EnvironmentManifest returns the manifest of an environment as written in the workspace.
+func (ws *Workspace) EnvironmentManifest(name string) ([]byte, error) {
+	return ws.ws.ReadEnvironmentManifest(name)
+}
+
+// Environment returns the manifest of an environment.
+func (ws *Workspace) Environment(name string) (*manifest.Environment, error) {
+	raw, err := ws.EnvironmentManifest(name)
+	if err != nil {
+		return nil, err
+	}
+	mft, err := manifest.UnmarshalEnvironment(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal manifest of environment %s: %w", name, err)
+	}
+	return mft, nil
+}
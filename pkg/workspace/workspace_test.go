@@ -0,0 +1,66 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"testing"
+
+	"github.com/aws/copilot-cli/pkg/manifest"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspace(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	require.NoError(t, afero.WriteFile(fs, "/projects/demo/copilot/.workspace", []byte("application: demo\n"), 0644))
+	require.NoError(t, afero.WriteFile(fs, "/projects/demo/copilot/api/manifest.yml", []byte(`name: api
+type: Backend Service
+image:
+  location: nginx
+`), 0644))
+	require.NoError(t, afero.WriteFile(fs, "/projects/demo/copilot/report/manifest.yml", []byte(`name: report
+type: Scheduled Job
+image:
+  location: nginx
+on:
+  schedule: "@daily"
+`), 0644))
+	require.NoError(t, afero.WriteFile(fs, "/projects/demo/copilot/environments/test/manifest.yml", []byte(`name: test
+type: Environment
+`), 0644))
+
+	ws, err := open(fs, "/projects/demo/api")
+	require.NoError(t, err)
+
+	require.Equal(t, "/projects/demo", ws.Path())
+	app, err := ws.Application()
+	require.NoError(t, err)
+	require.Equal(t, "demo", app)
+	services, err := ws.Services()
+	require.NoError(t, err)
+	require.Equal(t, []string{"api"}, services)
+	jobs, err := ws.Jobs()
+	require.NoError(t, err)
+	require.Equal(t, []string{"report"}, jobs)
+	envs, err := ws.Environments()
+	require.NoError(t, err)
+	require.Equal(t, []string{"test"}, envs)
+
+	mft, err := ws.Workload("api")
+	require.NoError(t, err)
+	require.Equal(t, manifest.BackendServiceType, mft.Type)
+	require.Equal(t, "nginx", mft.Image.Location)
+	env, err := ws.Environment("test")
+	require.NoError(t, err)
+	require.Equal(t, "test", env.Name)
+
+	_, err = ws.Workload("web")
+	require.Error(t, err)
+}
+
+func TestOpen_NotFound(t *testing.T) {
+	_, err := open(afero.NewMemMapFs(), "/projects/demo")
+
+	require.Error(t, err)
+}